@@ -44,6 +44,15 @@ function unittest() {
     )
 }
 
+function telemetry() {
+    echo "Running go vet and go test of the telemetry module ..."
+    (
+        cd v2/telemetry
+        go vet ./...
+        go test -v -race ./...
+    )
+}
+
 function integration() {
     echo "Running integration test ..."
     cd v2
@@ -0,0 +1,153 @@
+package binance
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/adshao/go-binance/v2/common"
+	"github.com/adshao/go-binance/v2/common/websocket"
+)
+
+// AccountCommissionWsService gets commission rates of a symbol
+type AccountCommissionWsService struct {
+	c          websocket.Client
+	ApiKey     string
+	SecretKey  string
+	KeyType    string
+	Signer     common.Signer       // signs requests instead of SecretKey when set
+	signers    *common.SignerCache // parses SecretKey once
+	TimeOffset int64
+}
+
+// NewAccountCommissionWsService init AccountCommissionWsService
+func NewAccountCommissionWsService(apiKey, secretKey string) (*AccountCommissionWsService, error) {
+	conn, err := websocket.NewConnection(WsApiInitReadWriteConn, WebsocketKeepalive, WebsocketTimeoutReadWriteConnection)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := websocket.NewClient(conn)
+	if err != nil {
+		return nil, err
+	}
+
+	return &AccountCommissionWsService{
+		c:         client,
+		ApiKey:    apiKey,
+		SecretKey: secretKey,
+		KeyType:   common.KeyTypeHmac,
+		signers:   new(common.SignerCache),
+	}, nil
+}
+
+// AccountCommissionWsRequest parameters for 'account.commission' websocket API
+type AccountCommissionWsRequest struct {
+	symbol string
+}
+
+// NewAccountCommissionWsRequest init AccountCommissionWsRequest
+func NewAccountCommissionWsRequest() *AccountCommissionWsRequest {
+	return &AccountCommissionWsRequest{}
+}
+
+func (s *AccountCommissionWsRequest) GetParams() map[string]interface{} {
+	return s.buildParams()
+}
+
+// buildParams builds params
+func (s *AccountCommissionWsRequest) buildParams() params {
+	m := params{
+		"symbol": s.symbol,
+	}
+	return m
+}
+
+// Do - sends 'account.commission' request
+func (s *AccountCommissionWsService) Do(requestID string, request *AccountCommissionWsRequest) error {
+	rawData, err := websocket.CreateRequest(
+		websocket.NewRequestData(
+			requestID,
+			s.ApiKey,
+			s.SecretKey,
+			s.TimeOffset,
+			s.KeyType,
+		).WithSigner(s.Signer).WithSignerCache(s.signers),
+		websocket.AccountCommissionWsApiMethod,
+		request.buildParams(),
+	)
+	if err != nil {
+		return err
+	}
+
+	if err := s.c.Write(requestID, rawData); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// SyncDo - sends 'account.commission' request and receives response
+func (s *AccountCommissionWsService) SyncDo(requestID string, request *AccountCommissionWsRequest) (*AccountCommissionWsResponse, error) {
+	rawData, err := websocket.CreateRequest(
+		websocket.NewRequestData(
+			requestID,
+			s.ApiKey,
+			s.SecretKey,
+			s.TimeOffset,
+			s.KeyType,
+		).WithSigner(s.Signer).WithSignerCache(s.signers),
+		websocket.AccountCommissionWsApiMethod,
+		request.buildParams(),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := s.c.WriteSync(requestID, rawData, websocket.WriteSyncWsTimeout)
+	if err != nil {
+		return nil, err
+	}
+
+	accountCommissionWsResponse := &AccountCommissionWsResponse{}
+	if err := json.Unmarshal(response, accountCommissionWsResponse); err != nil {
+		return nil, err
+	}
+
+	return accountCommissionWsResponse, nil
+}
+
+// ReceiveAllDataBeforeStop waits until all responses will be received from websocket until timeout expired
+func (s *AccountCommissionWsService) ReceiveAllDataBeforeStop(timeout time.Duration) {
+	s.c.Wait(timeout)
+}
+
+// GetReadChannel returns channel with API response data (including API errors)
+func (s *AccountCommissionWsService) GetReadChannel() <-chan []byte {
+	return s.c.GetReadChannel()
+}
+
+// GetReadErrorChannel returns channel with errors which are occurred while reading websocket connection
+func (s *AccountCommissionWsService) GetReadErrorChannel() <-chan error {
+	return s.c.GetReadErrorChannel()
+}
+
+// GetReconnectCount returns count of reconnect attempts by client
+func (s *AccountCommissionWsService) GetReconnectCount() int64 {
+	return s.c.GetReconnectCount()
+}
+
+// Symbol set symbol
+func (s *AccountCommissionWsRequest) Symbol(symbol string) *AccountCommissionWsRequest {
+	s.symbol = symbol
+	return s
+}
+
+// AccountCommissionWsResponse define 'account.commission' websocket API response
+type AccountCommissionWsResponse struct {
+	Id     string                  `json:"id"`
+	Status int                     `json:"status"`
+	Result CommissionRatesResponse `json:"result"`
+
+	// error response
+	Error *common.APIError `json:"error,omitempty"`
+}
@@ -0,0 +1,97 @@
+package binance
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/adshao/go-binance/v2/common/websocket"
+	"github.com/adshao/go-binance/v2/common/websocket/mock"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/suite"
+)
+
+type accountCommissionServiceWsTestSuite struct {
+	suite.Suite
+
+	ctrl   *gomock.Controller
+	client *mock.MockClient
+
+	requestID string
+
+	service *AccountCommissionWsService
+	request *AccountCommissionWsRequest
+}
+
+func (s *accountCommissionServiceWsTestSuite) SetupTest() {
+	s.requestID = "e2a85d9f-07a5-4f94-8d5f-789dc3deb098"
+
+	s.ctrl = gomock.NewController(s.T())
+	s.client = mock.NewMockClient(s.ctrl)
+
+	s.service = &AccountCommissionWsService{
+		c:         s.client,
+		ApiKey:    "dummyApiKey",
+		SecretKey: "dummySecretKey",
+		KeyType:   "HMAC",
+	}
+
+	s.request = NewAccountCommissionWsRequest().Symbol("BTCUSDT")
+}
+
+func (s *accountCommissionServiceWsTestSuite) TearDownTest() {
+	s.ctrl.Finish()
+}
+
+func TestAccountCommissionWsService(t *testing.T) {
+	suite.Run(t, new(accountCommissionServiceWsTestSuite))
+}
+
+func (s *accountCommissionServiceWsTestSuite) TestDo() {
+	var data []byte
+	s.client.EXPECT().Write(s.requestID, gomock.Any()).DoAndReturn(func(id string, raw []byte) error {
+		data = raw
+		return nil
+	}).Times(1)
+
+	err := s.service.Do(s.requestID, s.request)
+	s.Require().NoError(err)
+
+	req := websocket.WsApiRequest{}
+	s.Require().NoError(json.Unmarshal(data, &req))
+	s.Equal(s.requestID, req.Id)
+	s.Equal(websocket.WsApiMethodType("account.commission"), req.Method)
+	for k, v := range map[string]interface{}{"symbol": "BTCUSDT"} {
+		s.Equal(v, req.Params[k], k)
+	}
+	s.Contains(req.Params, "signature")
+}
+
+func (s *accountCommissionServiceWsTestSuite) TestDo_EmptyApiKey() {
+	s.service.ApiKey = ""
+	s.client.EXPECT().Write(gomock.Any(), gomock.Any()).Times(0)
+
+	err := s.service.Do(s.requestID, s.request)
+	s.ErrorIs(err, websocket.ErrorApiKeyIsNotSet)
+}
+
+func (s *accountCommissionServiceWsTestSuite) TestSyncDo() {
+	rawResponseData := []byte(fmt.Sprintf(`{"id": "%s", "status": 200, "result": {"symbol": "BTCUSDT", "standardCommission": {"maker": "0.00000010", "taker": "0.00000020", "buyer": "0.00000030", "seller": "0.00000040"}, "taxCommission": {"maker": "0.00000112"}, "discount": {"enabledForAccount": true, "enabledForSymbol": true, "discountAsset": "BNB", "discount": "0.75000000"}}}`, s.requestID))
+	s.client.EXPECT().WriteSync(s.requestID, gomock.Any(), gomock.Any()).Return(rawResponseData, nil).Times(1)
+
+	response, err := s.service.SyncDo(s.requestID, s.request)
+	s.Require().NoError(err)
+	s.Equal(s.requestID, response.Id)
+	s.Equal("BTCUSDT", response.Result.Symbol)
+	s.Equal("0.00000020", response.Result.StandardCommission.Taker)
+	s.Equal("0.00000112", response.Result.TaxCommission.Maker)
+	s.Equal("BNB", response.Result.Discount.DiscountAsset)
+}
+
+func (s *accountCommissionServiceWsTestSuite) TestSyncDo_EmptyRequestID() {
+	s.client.EXPECT().WriteSync(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+	response, err := s.service.SyncDo("", s.request)
+	s.Nil(response)
+	s.ErrorIs(err, websocket.ErrorRequestIDNotSet)
+}
@@ -0,0 +1,154 @@
+package binance
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/adshao/go-binance/v2/common"
+	"github.com/adshao/go-binance/v2/common/websocket"
+)
+
+// AccountRateLimitsOrdersWsService gets unfilled order counts
+type AccountRateLimitsOrdersWsService struct {
+	c          websocket.Client
+	ApiKey     string
+	SecretKey  string
+	KeyType    string
+	Signer     common.Signer       // signs requests instead of SecretKey when set
+	signers    *common.SignerCache // parses SecretKey once
+	TimeOffset int64
+}
+
+// NewAccountRateLimitsOrdersWsService init AccountRateLimitsOrdersWsService
+func NewAccountRateLimitsOrdersWsService(apiKey, secretKey string) (*AccountRateLimitsOrdersWsService, error) {
+	conn, err := websocket.NewConnection(WsApiInitReadWriteConn, WebsocketKeepalive, WebsocketTimeoutReadWriteConnection)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := websocket.NewClient(conn)
+	if err != nil {
+		return nil, err
+	}
+
+	return &AccountRateLimitsOrdersWsService{
+		c:         client,
+		ApiKey:    apiKey,
+		SecretKey: secretKey,
+		KeyType:   common.KeyTypeHmac,
+		signers:   new(common.SignerCache),
+	}, nil
+}
+
+// AccountRateLimitsOrdersWsRequest parameters for 'account.rateLimits.orders' websocket API
+type AccountRateLimitsOrdersWsRequest struct {
+	recvWindow *uint16
+}
+
+// NewAccountRateLimitsOrdersWsRequest init AccountRateLimitsOrdersWsRequest
+func NewAccountRateLimitsOrdersWsRequest() *AccountRateLimitsOrdersWsRequest {
+	return &AccountRateLimitsOrdersWsRequest{}
+}
+
+func (s *AccountRateLimitsOrdersWsRequest) GetParams() map[string]interface{} {
+	return s.buildParams()
+}
+
+// buildParams builds params
+func (s *AccountRateLimitsOrdersWsRequest) buildParams() params {
+	m := params{}
+	if s.recvWindow != nil {
+		m["recvWindow"] = *s.recvWindow
+	}
+	return m
+}
+
+// Do - sends 'account.rateLimits.orders' request
+func (s *AccountRateLimitsOrdersWsService) Do(requestID string, request *AccountRateLimitsOrdersWsRequest) error {
+	rawData, err := websocket.CreateRequest(
+		websocket.NewRequestData(
+			requestID,
+			s.ApiKey,
+			s.SecretKey,
+			s.TimeOffset,
+			s.KeyType,
+		).WithSigner(s.Signer).WithSignerCache(s.signers),
+		websocket.AccountRateLimitsOrdersWsApiMethod,
+		request.buildParams(),
+	)
+	if err != nil {
+		return err
+	}
+
+	if err := s.c.Write(requestID, rawData); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// SyncDo - sends 'account.rateLimits.orders' request and receives response
+func (s *AccountRateLimitsOrdersWsService) SyncDo(requestID string, request *AccountRateLimitsOrdersWsRequest) (*AccountRateLimitsOrdersWsResponse, error) {
+	rawData, err := websocket.CreateRequest(
+		websocket.NewRequestData(
+			requestID,
+			s.ApiKey,
+			s.SecretKey,
+			s.TimeOffset,
+			s.KeyType,
+		).WithSigner(s.Signer).WithSignerCache(s.signers),
+		websocket.AccountRateLimitsOrdersWsApiMethod,
+		request.buildParams(),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := s.c.WriteSync(requestID, rawData, websocket.WriteSyncWsTimeout)
+	if err != nil {
+		return nil, err
+	}
+
+	accountRateLimitsOrdersWsResponse := &AccountRateLimitsOrdersWsResponse{}
+	if err := json.Unmarshal(response, accountRateLimitsOrdersWsResponse); err != nil {
+		return nil, err
+	}
+
+	return accountRateLimitsOrdersWsResponse, nil
+}
+
+// ReceiveAllDataBeforeStop waits until all responses will be received from websocket until timeout expired
+func (s *AccountRateLimitsOrdersWsService) ReceiveAllDataBeforeStop(timeout time.Duration) {
+	s.c.Wait(timeout)
+}
+
+// GetReadChannel returns channel with API response data (including API errors)
+func (s *AccountRateLimitsOrdersWsService) GetReadChannel() <-chan []byte {
+	return s.c.GetReadChannel()
+}
+
+// GetReadErrorChannel returns channel with errors which are occurred while reading websocket connection
+func (s *AccountRateLimitsOrdersWsService) GetReadErrorChannel() <-chan error {
+	return s.c.GetReadErrorChannel()
+}
+
+// GetReconnectCount returns count of reconnect attempts by client
+func (s *AccountRateLimitsOrdersWsService) GetReconnectCount() int64 {
+	return s.c.GetReconnectCount()
+}
+
+// RecvWindow set recvWindow
+func (s *AccountRateLimitsOrdersWsRequest) RecvWindow(recvWindow uint16) *AccountRateLimitsOrdersWsRequest {
+	s.recvWindow = &recvWindow
+	return s
+}
+
+// AccountRateLimitsOrdersWsResponse define 'account.rateLimits.orders' websocket API response
+type AccountRateLimitsOrdersWsResponse struct {
+	Id     string           `json:"id"`
+	Status int              `json:"status"`
+	Result []*RateLimitFull `json:"result"`
+
+	// error response
+	Error *common.APIError `json:"error,omitempty"`
+}
@@ -0,0 +1,93 @@
+package binance
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/adshao/go-binance/v2/common/websocket"
+	"github.com/adshao/go-binance/v2/common/websocket/mock"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/suite"
+)
+
+type accountRateLimitsOrdersServiceWsTestSuite struct {
+	suite.Suite
+
+	ctrl   *gomock.Controller
+	client *mock.MockClient
+
+	requestID string
+
+	service *AccountRateLimitsOrdersWsService
+	request *AccountRateLimitsOrdersWsRequest
+}
+
+func (s *accountRateLimitsOrdersServiceWsTestSuite) SetupTest() {
+	s.requestID = "e2a85d9f-07a5-4f94-8d5f-789dc3deb098"
+
+	s.ctrl = gomock.NewController(s.T())
+	s.client = mock.NewMockClient(s.ctrl)
+
+	s.service = &AccountRateLimitsOrdersWsService{
+		c:         s.client,
+		ApiKey:    "dummyApiKey",
+		SecretKey: "dummySecretKey",
+		KeyType:   "HMAC",
+	}
+
+	s.request = NewAccountRateLimitsOrdersWsRequest()
+}
+
+func (s *accountRateLimitsOrdersServiceWsTestSuite) TearDownTest() {
+	s.ctrl.Finish()
+}
+
+func TestAccountRateLimitsOrdersWsService(t *testing.T) {
+	suite.Run(t, new(accountRateLimitsOrdersServiceWsTestSuite))
+}
+
+func (s *accountRateLimitsOrdersServiceWsTestSuite) TestDo() {
+	var data []byte
+	s.client.EXPECT().Write(s.requestID, gomock.Any()).DoAndReturn(func(id string, raw []byte) error {
+		data = raw
+		return nil
+	}).Times(1)
+
+	err := s.service.Do(s.requestID, s.request)
+	s.Require().NoError(err)
+
+	req := websocket.WsApiRequest{}
+	s.Require().NoError(json.Unmarshal(data, &req))
+	s.Equal(s.requestID, req.Id)
+	s.Equal(websocket.WsApiMethodType("account.rateLimits.orders"), req.Method)
+	s.Contains(req.Params, "signature")
+}
+
+func (s *accountRateLimitsOrdersServiceWsTestSuite) TestDo_EmptyApiKey() {
+	s.service.ApiKey = ""
+	s.client.EXPECT().Write(gomock.Any(), gomock.Any()).Times(0)
+
+	err := s.service.Do(s.requestID, s.request)
+	s.ErrorIs(err, websocket.ErrorApiKeyIsNotSet)
+}
+
+func (s *accountRateLimitsOrdersServiceWsTestSuite) TestSyncDo() {
+	rawResponseData := []byte(fmt.Sprintf(`{"id": "%s", "status": 200, "result": [{"rateLimitType": "ORDERS", "interval": "SECOND", "intervalNum": 10, "limit": 50, "count": 0}, {"rateLimitType": "ORDERS", "interval": "DAY", "intervalNum": 1, "limit": 160000, "count": 0}]}`, s.requestID))
+	s.client.EXPECT().WriteSync(s.requestID, gomock.Any(), gomock.Any()).Return(rawResponseData, nil).Times(1)
+
+	response, err := s.service.SyncDo(s.requestID, s.request)
+	s.Require().NoError(err)
+	s.Equal(s.requestID, response.Id)
+	s.Require().Len(response.Result, 2)
+	s.Equal(RateLimitIntervalDay, response.Result[1].Interval)
+	s.Equal(160000, response.Result[1].Limit)
+}
+
+func (s *accountRateLimitsOrdersServiceWsTestSuite) TestSyncDo_EmptyRequestID() {
+	s.client.EXPECT().WriteSync(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+	response, err := s.service.SyncDo("", s.request)
+	s.Nil(response)
+	s.ErrorIs(err, websocket.ErrorRequestIDNotSet)
+}
@@ -14,6 +14,8 @@ type AccountStatusWsService struct {
 	ApiKey     string
 	SecretKey  string
 	KeyType    string
+	Signer     common.Signer       // signs requests instead of SecretKey when set
+	signers    *common.SignerCache // parses SecretKey once
 	TimeOffset int64
 }
 
@@ -34,6 +36,7 @@ func NewAccountStatusWsService(apiKey, secretKey string) (*AccountStatusWsServic
 		ApiKey:    apiKey,
 		SecretKey: secretKey,
 		KeyType:   common.KeyTypeHmac,
+		signers:   new(common.SignerCache),
 	}, nil
 }
 
@@ -84,7 +87,7 @@ func (s *AccountStatusWsService) Do(requestID string, request *AccountStatusWsRe
 			s.SecretKey,
 			s.TimeOffset,
 			s.KeyType,
-		),
+		).WithSigner(s.Signer).WithSignerCache(s.signers),
 		websocket.AccountStatusWsApiMethod,
 		request.buildParams(),
 	)
@@ -108,7 +111,7 @@ func (s *AccountStatusWsService) SyncDo(requestID string, request *AccountStatus
 			s.SecretKey,
 			s.TimeOffset,
 			s.KeyType,
-		),
+		).WithSigner(s.Signer).WithSignerCache(s.signers),
 		websocket.AccountStatusWsApiMethod,
 		request.buildParams(),
 	)
@@ -219,11 +219,11 @@ func (s *assetDetailServiceTestSuite) TestGetFundingAsset() {
 	res, err := s.client.NewGetFundingAssetService().Do(newContext())
 	s.r().NoError(err)
 	s.assertFundingAssetEqual(FundingAsset{
-		Asset: "BTC",
-		Free:  "1",
-		Locked: "0",
-		Freeze: "0",
-		Withdrawing: "0",
+		Asset:        "BTC",
+		Free:         "1",
+		Locked:       "0",
+		Freeze:       "0",
+		Withdrawing:  "0",
 		BtcValuation: "0",
 	}, res[0])
 }
@@ -236,4 +236,4 @@ func (s *assetDetailServiceTestSuite) assertFundingAssetEqual(e, a FundingAsset)
 	r.Equal(e.Freeze, a.Freeze, "Freeze")
 	r.Equal(e.Withdrawing, a.Withdrawing, "Withdrawing")
 	r.Equal(e.BtcValuation, a.BtcValuation, "BtcValuation")
-}
\ No newline at end of file
+}
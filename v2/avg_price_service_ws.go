@@ -0,0 +1,131 @@
+package binance
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/adshao/go-binance/v2/common"
+	"github.com/adshao/go-binance/v2/common/websocket"
+)
+
+// AvgPriceWsService gets current average price
+type AvgPriceWsService struct {
+	c websocket.Client
+}
+
+// NewAvgPriceWsService init AvgPriceWsService
+func NewAvgPriceWsService() (*AvgPriceWsService, error) {
+	conn, err := websocket.NewConnection(WsApiInitReadWriteConn, WebsocketKeepalive, WebsocketTimeoutReadWriteConnection)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := websocket.NewClient(conn)
+	if err != nil {
+		return nil, err
+	}
+
+	return &AvgPriceWsService{
+		c: client,
+	}, nil
+}
+
+// AvgPriceWsRequest parameters for 'avgPrice' websocket API
+type AvgPriceWsRequest struct {
+	symbol string
+}
+
+// NewAvgPriceWsRequest init AvgPriceWsRequest
+func NewAvgPriceWsRequest() *AvgPriceWsRequest {
+	return &AvgPriceWsRequest{}
+}
+
+func (s *AvgPriceWsRequest) GetParams() map[string]interface{} {
+	return s.buildParams()
+}
+
+// buildParams builds params
+func (s *AvgPriceWsRequest) buildParams() params {
+	m := params{
+		"symbol": s.symbol,
+	}
+	return m
+}
+
+// Do - sends 'avgPrice' request
+func (s *AvgPriceWsService) Do(requestID string, request *AvgPriceWsRequest) error {
+	rawData, err := websocket.CreateRequestWithSigned(
+		requestID,
+		websocket.AvgPriceSpotWsApiMethod,
+		request.buildParams(),
+	)
+	if err != nil {
+		return err
+	}
+
+	if err := s.c.Write(requestID, rawData); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// SyncDo - sends 'avgPrice' request and receives response
+func (s *AvgPriceWsService) SyncDo(requestID string, request *AvgPriceWsRequest) (*AvgPriceWsResponse, error) {
+	rawData, err := websocket.CreateRequestWithSigned(
+		requestID,
+		websocket.AvgPriceSpotWsApiMethod,
+		request.buildParams(),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := s.c.WriteSync(requestID, rawData, websocket.WriteSyncWsTimeout)
+	if err != nil {
+		return nil, err
+	}
+
+	avgPriceWsResponse := &AvgPriceWsResponse{}
+	if err := json.Unmarshal(response, avgPriceWsResponse); err != nil {
+		return nil, err
+	}
+
+	return avgPriceWsResponse, nil
+}
+
+// ReceiveAllDataBeforeStop waits until all responses will be received from websocket until timeout expired
+func (s *AvgPriceWsService) ReceiveAllDataBeforeStop(timeout time.Duration) {
+	s.c.Wait(timeout)
+}
+
+// GetReadChannel returns channel with API response data (including API errors)
+func (s *AvgPriceWsService) GetReadChannel() <-chan []byte {
+	return s.c.GetReadChannel()
+}
+
+// GetReadErrorChannel returns channel with errors which are occurred while reading websocket connection
+func (s *AvgPriceWsService) GetReadErrorChannel() <-chan error {
+	return s.c.GetReadErrorChannel()
+}
+
+// GetReconnectCount returns count of reconnect attempts by client
+func (s *AvgPriceWsService) GetReconnectCount() int64 {
+	return s.c.GetReconnectCount()
+}
+
+// Symbol set symbol
+func (s *AvgPriceWsRequest) Symbol(symbol string) *AvgPriceWsRequest {
+	s.symbol = symbol
+	return s
+}
+
+// AvgPriceWsResponse define 'avgPrice' websocket API response
+type AvgPriceWsResponse struct {
+	Id     string   `json:"id"`
+	Status int      `json:"status"`
+	Result AvgPrice `json:"result"`
+
+	// error response
+	Error *common.APIError `json:"error,omitempty"`
+}
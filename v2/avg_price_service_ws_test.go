@@ -0,0 +1,75 @@
+package binance
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/adshao/go-binance/v2/common/websocket"
+	"github.com/adshao/go-binance/v2/common/websocket/mock"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/suite"
+)
+
+type avgPriceServiceWsTestSuite struct {
+	suite.Suite
+
+	ctrl   *gomock.Controller
+	client *mock.MockClient
+
+	requestID string
+
+	service *AvgPriceWsService
+	request *AvgPriceWsRequest
+}
+
+func (s *avgPriceServiceWsTestSuite) SetupTest() {
+	s.requestID = "e2a85d9f-07a5-4f94-8d5f-789dc3deb098"
+
+	s.ctrl = gomock.NewController(s.T())
+	s.client = mock.NewMockClient(s.ctrl)
+
+	s.service = &AvgPriceWsService{
+		c: s.client,
+	}
+
+	s.request = NewAvgPriceWsRequest().Symbol("BTCUSDT")
+}
+
+func (s *avgPriceServiceWsTestSuite) TearDownTest() {
+	s.ctrl.Finish()
+}
+
+func TestAvgPriceWsService(t *testing.T) {
+	suite.Run(t, new(avgPriceServiceWsTestSuite))
+}
+
+func (s *avgPriceServiceWsTestSuite) TestDo() {
+	var data []byte
+	s.client.EXPECT().Write(s.requestID, gomock.Any()).DoAndReturn(func(id string, raw []byte) error {
+		data = raw
+		return nil
+	}).Times(1)
+
+	err := s.service.Do(s.requestID, s.request)
+	s.Require().NoError(err)
+
+	req := websocket.WsApiRequest{}
+	s.Require().NoError(json.Unmarshal(data, &req))
+	s.Equal(s.requestID, req.Id)
+	s.Equal(websocket.WsApiMethodType("avgPrice"), req.Method)
+	for k, v := range map[string]interface{}{"symbol": "BTCUSDT"} {
+		s.Equal(v, req.Params[k], k)
+	}
+	s.NotContains(req.Params, "apiKey")
+}
+
+func (s *avgPriceServiceWsTestSuite) TestSyncDo() {
+	rawResponseData := []byte(fmt.Sprintf(`{"id": "%s", "status": 200, "result": {"mins": 5, "price": "9.35751834", "closeTime": 1694061154503}}`, s.requestID))
+	s.client.EXPECT().WriteSync(s.requestID, gomock.Any(), gomock.Any()).Return(rawResponseData, nil).Times(1)
+
+	response, err := s.service.SyncDo(s.requestID, s.request)
+	s.Require().NoError(err)
+	s.Equal(s.requestID, response.Id)
+	s.Equal(AvgPrice{Mins: 5, Price: "9.35751834", CloseTime: 1694061154503}, response.Result)
+}
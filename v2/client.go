@@ -12,6 +12,7 @@ import (
 	"net/url"
 	"os"
 	"strings"
+	"sync/atomic"
 	"time"
 
 	"github.com/bitly/go-simplejson"
@@ -138,7 +139,10 @@ type MarginAccountBorrowRepayType string
 
 type OrderCancelRestrictionsType string
 
-// UseTestnet switch all the API endpoints from production to the testnet
+// UseTestnet switch all the API endpoints from production to the testnet, it only applies to
+// the clients of NewClient and to the streams without options.
+//
+// Deprecated: use NewClientWithEnvironment(TestnetEnvironment()) and its WsOptions.
 var UseTestnet = false
 
 // Global enums
@@ -361,31 +365,34 @@ func newJSON(data []byte) (j *simplejson.Json, err error) {
 	return j, nil
 }
 
-// getAPIEndpoint return the base endpoint of the Rest API according the UseTestnet flag
-func getAPIEndpoint() string {
-	if UseTestnet {
-		return BaseAPITestnetURL
-	}
-	return BaseAPIMainURL
-}
-
 // NewClient initialize an API client instance with API key and secret key.
 // You should always call this function before using this SDK.
 // Services will be created by the form client.NewXXXService().
 func NewClient(apiKey, secretKey string) *Client {
+	return NewClientWithEnvironment(apiKey, secretKey, defaultEnvironment())
+}
+
+// NewClientWithEnvironment initialize an API client instance for the endpoints of env
+func NewClientWithEnvironment(apiKey, secretKey string, env Environment) *Client {
 	return &Client{
-		APIKey:     apiKey,
-		SecretKey:  secretKey,
-		KeyType:    common.KeyTypeHmac,
-		BaseURL:    getAPIEndpoint(),
-		UserAgent:  "Binance/golang",
-		HTTPClient: http.DefaultClient,
-		Logger:     log.New(os.Stderr, "Binance-golang ", log.LstdFlags),
+		APIKey:      apiKey,
+		SecretKey:   secretKey,
+		KeyType:     common.KeyTypeHmac,
+		BaseURL:     env.APIURL,
+		Environment: env,
+		UserAgent:   "Binance/golang",
+		HTTPClient:  http.DefaultClient,
+		Logger:      log.New(os.Stderr, "Binance-golang ", log.LstdFlags),
 	}
 }
 
 // NewProxiedClient passing a proxy url
 func NewProxiedClient(apiKey, secretKey, proxyUrl string) *Client {
+	return NewProxiedClientWithEnvironment(apiKey, secretKey, proxyUrl, defaultEnvironment())
+}
+
+// NewProxiedClientWithEnvironment passing a proxy url, for the endpoints of env
+func NewProxiedClientWithEnvironment(apiKey, secretKey, proxyUrl string, env Environment) *Client {
 	proxy, err := url.Parse(proxyUrl)
 	if err != nil {
 		log.Fatal(err)
@@ -395,11 +402,12 @@ func NewProxiedClient(apiKey, secretKey, proxyUrl string) *Client {
 		TLSClientConfig: &tls.Config{InsecureSkipVerify: true},
 	}
 	return &Client{
-		APIKey:    apiKey,
-		SecretKey: secretKey,
-		KeyType:   common.KeyTypeHmac,
-		BaseURL:   getAPIEndpoint(),
-		UserAgent: "Binance/golang",
+		APIKey:      apiKey,
+		SecretKey:   secretKey,
+		KeyType:     common.KeyTypeHmac,
+		BaseURL:     env.APIURL,
+		Environment: env,
+		UserAgent:   "Binance/golang",
 		HTTPClient: &http.Client{
 			Transport: tr,
 		},
@@ -422,8 +430,21 @@ func NewOptionsClient(apiKey, secretKey string) *options.Client {
 	return options.NewClient(apiKey, secretKey)
 }
 
+// NewFuturesClientWithEnvironment initialize client for futures API in env
+func NewFuturesClientWithEnvironment(apiKey, secretKey string, env futures.Environment) *futures.Client {
+	return futures.NewClientWithEnvironment(apiKey, secretKey, env)
+}
+
+// NewDeliveryClientWithEnvironment initialize client for coin-M futures API in env
+func NewDeliveryClientWithEnvironment(apiKey, secretKey string, env delivery.Environment) *delivery.Client {
+	return delivery.NewClientWithEnvironment(apiKey, secretKey, env)
+}
+
 type doFunc func(req *http.Request) (*http.Response, error)
 
+//go:generate go run github.com/adshao/go-binance/v2/internal/servicegen -output service_api.go
+//go:generate mockgen -source service_api.go -destination mock/service_api.go -package mock
+
 // Client define API client
 type Client struct {
 	APIKey     string
@@ -437,8 +458,37 @@ type Client struct {
 	TimeOffset int64
 	do         doFunc
 
+	// Environment is the environment of the client, its WsOptions connect the streams and
+	// the Websocket API to the same environment
+	Environment Environment
+
+	// hostPool routes the calls instead of BaseURL when set, see SetHostPool
+	hostPool *HostPool
+
+	// Middlewares wrap every REST API call, see Use
+	Middlewares   []common.Middleware
+	logMiddleware common.Middleware
+
+	// Signer signs requests instead of SecretKey when set, see SetSigner
+	Signer  common.Signer
+	signers common.SignerCache
+
 	UsedWeight common.UsedWeight
 	OrderCount common.OrderCount
+	// lastResponse is the unix nano time of the last response, updated with UsedWeight
+	lastResponse int64
+
+	// parent is the client a host client was copied from, see onHost
+	parent *Client
+}
+
+// root returns the client c was copied from by onHost, c otherwise. It holds the signer
+// cache and the usage counters of the copies.
+func (c *Client) root() *Client {
+	if c.parent != nil {
+		return c.parent
+	}
+	return c
 }
 
 func (c *Client) debug(format string, v ...interface{}) {
@@ -482,22 +532,17 @@ func (c *Client) parseRequest(r *request, opts ...RequestOption) (err error) {
 	if r.secType == secTypeAPIKey || r.secType == secTypeSigned {
 		header.Set("X-MBX-APIKEY", c.APIKey)
 	}
-	kt := c.KeyType
-	if kt == "" {
-		kt = common.KeyTypeHmac
-	}
-	sf, err := common.SignFunc(kt)
-	if err != nil {
-		return err
-	}
 	if r.secType == secTypeSigned {
-		raw := fmt.Sprintf("%s%s", queryString, bodyString)
-		sign, err := sf(c.SecretKey, raw)
+		signer, err := c.signer()
+		if err != nil {
+			return err
+		}
+		sign, err := signer.Sign([]byte(queryString + bodyString))
 		if err != nil {
 			return err
 		}
 		v := url.Values{}
-		v.Set(signatureKey, *sign)
+		v.Set(signatureKey, sign)
 		if queryString == "" {
 			queryString = v.Encode()
 		} else {
@@ -507,7 +552,7 @@ func (c *Client) parseRequest(r *request, opts ...RequestOption) (err error) {
 	if queryString != "" {
 		fullURL = fmt.Sprintf("%s?%s", fullURL, queryString)
 	}
-	c.debug("full url: %s, body: %s\n", fullURL, bodyString)
+	c.debug("full url: %s, body: %s\n", common.RedactString(fullURL), common.RedactString(bodyString))
 
 	r.fullURL = fullURL
 	r.header = header
@@ -526,22 +571,42 @@ func (c *Client) callAPI(ctx context.Context, r *request, opts ...RequestOption)
 	}
 	req = req.WithContext(ctx)
 	req.Header = r.header
-	c.debug("request: %#v\n", req)
+	send := common.Handler(c.send)
+	if c.hostPool != nil {
+		send = c.failover(r, send)
+	}
+	if c.logMiddleware != nil {
+		send = c.logMiddleware(send)
+	}
+	res, err := common.Chain(send, c.Middlewares...)(req)
+	if err != nil {
+		if res != nil {
+			return nil, err
+		}
+		return []byte{}, err
+	}
+	return res.Body, nil
+}
+
+// send performs a built request, it is the innermost handler of the middleware chain
+func (c *Client) send(req *http.Request) (resp *common.Response, err error) {
+	c.debug("request: %s %s, header: %v\n", req.Method, common.RedactString(req.URL.String()), common.RedactHeader(req.Header))
 	f := c.do
 	if f == nil {
 		f = c.HTTPClient.Do
 	}
 	res, err := f(req)
 	if err != nil {
-		return []byte{}, err
+		return nil, err
 	}
+	root := c.root()
+	root.UsedWeight.UpdateByHeader(res.Header)
+	root.OrderCount.UpdateByHeader(res.Header)
+	atomic.StoreInt64(&root.lastResponse, time.Now().UnixNano())
 
-	c.UsedWeight.UpdateByHeader(res.Header)
-	c.OrderCount.UpdateByHeader(res.Header)
-
-	data, err = io.ReadAll(res.Body)
+	data, err := io.ReadAll(res.Body)
 	if err != nil {
-		return []byte{}, err
+		return nil, err
 	}
 	defer func() {
 		cerr := res.Body.Close()
@@ -551,10 +616,15 @@ func (c *Client) callAPI(ctx context.Context, r *request, opts ...RequestOption)
 			err = cerr
 		}
 	}()
-	c.debug("response: %#v\n", res)
+	c.debug("response header: %v\n", res.Header)
 	c.debug("response body: %s\n", string(data))
 	c.debug("response status code: %d\n", res.StatusCode)
 
+	resp = &common.Response{
+		StatusCode: res.StatusCode,
+		Header:     res.Header,
+		Body:       data,
+	}
 	if res.StatusCode >= http.StatusBadRequest {
 		apiErr := new(common.APIError)
 		e := json.Unmarshal(data, apiErr)
@@ -564,9 +634,32 @@ func (c *Client) callAPI(ctx context.Context, r *request, opts ...RequestOption)
 		if !apiErr.IsValid() {
 			apiErr.Response = data
 		}
-		return nil, apiErr
+		return resp, apiErr
+	}
+	return resp, nil
+}
+
+// SetSigner set the signer of requests, e.g. an external KMS or HSM signer,
+// SecretKey is not used to sign requests anymore
+func (c *Client) SetSigner(signer common.Signer) *Client {
+	c.Signer = signer
+	c.KeyType = signer.KeyType()
+	return c
+}
+
+// signer returns Signer when set, else the signer of SecretKey, parsed once
+func (c *Client) signer() (common.Signer, error) {
+	if c.Signer != nil {
+		return c.Signer, nil
 	}
-	return data, nil
+	return c.root().signers.Get(c.KeyType, c.SecretKey)
+}
+
+// Use appends middlewares wrapping every REST API call of the client, the first
+// middleware being the outermost. It must not be called concurrently with requests.
+func (c *Client) Use(middlewares ...common.Middleware) *Client {
+	c.Middlewares = append(c.Middlewares, middlewares...)
+	return c
 }
 
 // SetApiEndpoint set api Endpoint
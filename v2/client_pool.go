@@ -0,0 +1,98 @@
+package binance
+
+import (
+	"fmt"
+	"net"
+	"net/http"
+	"sync/atomic"
+	"time"
+)
+
+// usageWindow is how long the used weight and order count of a client are considered live
+// after its last response, the longest window of the counters the pool balances on
+const usageWindow = time.Minute
+
+// ClientPool spreads the read-only calls over the clients of several API keys, and pins the
+// order placement to one of them, which is left out of the reads so that they do not use the
+// request weight of the orders. Request weight is counted per IP and orders per account, so
+// the keys may be bound to different source IPs, see NewSourceIPHTTPClient.
+//
+//	pool := binance.NewClientPool(orderClient, readClient1, readClient2)
+//	depth, err := pool.Read().NewDepthService().Symbol("BTCUSDT").Do(ctx)
+//	order, err := pool.Order().NewCreateOrderService().Symbol("BTCUSDT")...Do(ctx)
+type ClientPool struct {
+	order   *Client
+	clients []*Client
+	next    uint64
+}
+
+// NewClientPool init a ClientPool placing the orders with order, and reading with clients,
+// or with order when there are none
+func NewClientPool(order *Client, clients ...*Client) *ClientPool {
+	all := append([]*Client{order}, clients...)
+	return &ClientPool{
+		order:   order,
+		clients: all,
+	}
+}
+
+// Order returns the client placing the orders
+func (p *ClientPool) Order() *Client {
+	return p.order
+}
+
+// Clients returns the clients of the pool, the order client first
+func (p *ClientPool) Clients() []*Client {
+	return append([]*Client(nil), p.clients...)
+}
+
+// readers returns the clients of the read-only calls, the order client only when alone
+func (p *ClientPool) readers() []*Client {
+	if len(p.clients) > 1 {
+		return p.clients[1:]
+	}
+	return p.clients
+}
+
+// Read returns the client for a read-only call: the read client with the lowest used weight,
+// then the lowest order count, as reported by the last responses to each client. The counters
+// of a client without response for a minute are considered reset. Ties are taken in turn.
+func (p *ClientPool) Read() *Client {
+	now := time.Now()
+	clients := p.readers()
+	start := int(atomic.AddUint64(&p.next, 1) % uint64(len(clients)))
+	var best *Client
+	var bestWeight, bestOrders int64
+	for n := 0; n < len(clients); n++ {
+		c := clients[(start+n)%len(clients)]
+		weight, orders := usage(c, now)
+		if best == nil || weight < bestWeight || weight == bestWeight && orders < bestOrders {
+			best, bestWeight, bestOrders = c, weight, orders
+		}
+	}
+	return best
+}
+
+// usage returns the live used weight and order count of c
+func usage(c *Client, now time.Time) (weight, orders int64) {
+	if last := atomic.LoadInt64(&c.lastResponse); now.Sub(time.Unix(0, last)) > usageWindow {
+		return 0, 0
+	}
+	return atomic.LoadInt64(&c.UsedWeight.Used1M), atomic.LoadInt64(&c.OrderCount.Count10s)
+}
+
+// NewSourceIPHTTPClient returns an HTTP client whose connections are made from the local
+// address ip, so that the request weight of a key is counted on its own IP
+func NewSourceIPHTTPClient(ip string) (*http.Client, error) {
+	addr := net.ParseIP(ip)
+	if addr == nil {
+		return nil, fmt.Errorf("invalid source IP %q", ip)
+	}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.DialContext = (&net.Dialer{
+		LocalAddr: &net.TCPAddr{IP: addr},
+		Timeout:   30 * time.Second,
+		KeepAlive: 30 * time.Second,
+	}).DialContext
+	return &http.Client{Transport: transport}, nil
+}
@@ -0,0 +1,128 @@
+package binance
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type clientPoolTestSuite struct {
+	suite.Suite
+	server *httptest.Server
+	weight map[string]string // used weight reported for each API key
+	orders map[string]string // order count reported for each API key
+}
+
+func TestClientPool(t *testing.T) {
+	suite.Run(t, new(clientPoolTestSuite))
+}
+
+func (s *clientPoolTestSuite) SetupTest() {
+	s.weight = map[string]string{"order": "100", "a": "900", "b": "10"}
+	s.orders = map[string]string{"order": "5", "a": "0", "b": "0"}
+	s.server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		key := r.Header.Get("X-MBX-APIKEY")
+		w.Header().Set("X-Mbx-Used-Weight-1m", s.weight[key])
+		w.Header().Set("X-Mbx-Order-Count-10s", s.orders[key])
+		w.Write([]byte(`{}`))
+	}))
+}
+
+func (s *clientPoolTestSuite) TearDownTest() {
+	s.server.Close()
+}
+
+func (s *clientPoolTestSuite) client(key string) *Client {
+	return NewClientWithEnvironment(key, "secret", ProductionEnvironment().WithAPIURL(s.server.URL))
+}
+
+// call makes a call with each client, to update its usage
+func (s *clientPoolTestSuite) call(clients ...*Client) {
+	for _, c := range clients {
+		_, err := c.NewGetAccountService().Do(context.Background())
+		s.Require().NoError(err)
+	}
+}
+
+func (s *clientPoolTestSuite) TestOrderPinned() {
+	order := s.client("order")
+	pool := NewClientPool(order, s.client("a"), s.client("b"))
+	s.Same(order, pool.Order())
+	s.Len(pool.Clients(), 3)
+	s.Same(order, pool.Clients()[0])
+}
+
+func (s *clientPoolTestSuite) TestReadLeastUsed() {
+	order, a, b := s.client("order"), s.client("a"), s.client("b")
+	pool := NewClientPool(order, a, b)
+	s.call(order, a, b)
+	for i := 0; i < 3; i++ {
+		s.Same(b, pool.Read())
+	}
+
+	// the order count breaks the ties of used weight
+	s.weight["a"] = "100"
+	s.weight["b"] = "100"
+	s.call(a, b)
+	s.orders["b"] = "10"
+	s.call(b)
+	s.Same(a, pool.Read())
+}
+
+func (s *clientPoolTestSuite) TestReadInTurn() {
+	order, a, b := s.client("order"), s.client("a"), s.client("b")
+	pool := NewClientPool(order, a, b)
+	seen := map[*Client]bool{}
+	for i := 0; i < 4; i++ {
+		seen[pool.Read()] = true
+	}
+	s.Equal(map[*Client]bool{a: true, b: true}, seen)
+}
+
+func (s *clientPoolTestSuite) TestStaleUsage() {
+	a, b := s.client("a"), s.client("b")
+	pool := NewClientPool(s.client("order"), a, b)
+	s.call(a, b)
+	s.Same(b, pool.Read())
+
+	// the weight of a was reported more than a minute ago
+	a.lastResponse = time.Now().Add(-2 * usageWindow).UnixNano()
+	s.weight["b"] = "50"
+	s.call(b)
+	s.Same(a, pool.Read())
+
+	// picking a client does not refresh its usage, its next response does
+	s.Same(a, pool.Read())
+	s.call(a)
+	s.Same(b, pool.Read())
+}
+
+func (s *clientPoolTestSuite) TestReadExcludesOrder() {
+	order, a := s.client("order"), s.client("a")
+	pool := NewClientPool(order, a)
+	s.weight["order"] = "0"
+	s.call(order, a)
+	for i := 0; i < 3; i++ {
+		s.Same(a, pool.Read())
+	}
+
+	// the order client reads when alone
+	pool = NewClientPool(order)
+	s.Same(order, pool.Read())
+}
+
+func (s *clientPoolTestSuite) TestSourceIPHTTPClient() {
+	httpClient, err := NewSourceIPHTTPClient("127.0.0.1")
+	s.Require().NoError(err)
+	c := s.client("a")
+	c.HTTPClient = httpClient
+	s.call(c)
+	s.Equal(int64(900), c.UsedWeight.Used1M)
+
+	_, err = NewSourceIPHTTPClient("not an ip")
+	s.Error(err)
+}
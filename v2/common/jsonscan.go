@@ -0,0 +1,274 @@
+package common
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// ErrInvalidJSON is returned by the scan functions when the data is not valid JSON
+var ErrInvalidJSON = errors.New("common: invalid JSON")
+
+// ScanObject calls fn with the key and the raw value of each field of the JSON object data,
+// in order. Keys and values are substrings of data, nothing is decoded nor allocated, so
+// converting a message to a string once makes every field decoded from it share its memory.
+// Keys are expected without escape sequences, which holds for the exchange field names.
+func ScanObject(data string, fn func(key, value string) error) error {
+	return scan(data, '{', '}', func(i int) (int, error) {
+		if i >= len(data) || data[i] != '"' {
+			return 0, syntaxError(data, i)
+		}
+		end, err := stringEnd(data, i)
+		if err != nil {
+			return 0, err
+		}
+		key := data[i+1 : end-1]
+		i = skipSpace(data, end)
+		if i >= len(data) || data[i] != ':' {
+			return 0, syntaxError(data, i)
+		}
+		i = skipSpace(data, i+1)
+		end, err = valueEnd(data, i)
+		if err != nil {
+			return 0, err
+		}
+		return end, fn(key, data[i:end])
+	})
+}
+
+// ScanArray calls fn with the raw value of each element of the JSON array data, in order
+func ScanArray(data string, fn func(value string) error) error {
+	return scan(data, '[', ']', func(i int) (int, error) {
+		end, err := valueEnd(data, i)
+		if err != nil {
+			return 0, err
+		}
+		return end, fn(data[i:end])
+	})
+}
+
+// scan iterates the members of a JSON object or array, member parses the member starting at
+// the given index and returns the index after it
+func scan(data string, open, close byte, member func(i int) (int, error)) error {
+	i := skipSpace(data, 0)
+	if i >= len(data) || data[i] != open {
+		return syntaxError(data, i)
+	}
+	i = skipSpace(data, i+1)
+	if i < len(data) && data[i] == close {
+		return nil
+	}
+	for {
+		end, err := member(i)
+		if err != nil {
+			return err
+		}
+		i = skipSpace(data, end)
+		if i >= len(data) {
+			return syntaxError(data, i)
+		}
+		switch data[i] {
+		case ',':
+			i = skipSpace(data, i+1)
+		case close:
+			return nil
+		default:
+			return syntaxError(data, i)
+		}
+	}
+}
+
+// SplitCombinedMessage returns the stream name and the raw data of a message of a combined
+// stream, {"stream":"<name>","data":<data>}
+func SplitCombinedMessage(message string) (stream, data string, err error) {
+	err = ScanObject(message, func(key, value string) error {
+		switch key {
+		case "stream":
+			stream, err = JSONString(value)
+			return err
+		case "data":
+			data = value
+		}
+		return nil
+	})
+	if err == nil && data == "" {
+		err = fmt.Errorf("%w: no data in combined stream message", ErrInvalidJSON)
+	}
+	return stream, data, err
+}
+
+// JSONString returns the string of a raw JSON value, "" for null. Strings without escape
+// sequences are returned as substrings of raw.
+func JSONString(raw string) (string, error) {
+	if raw == "null" {
+		return "", nil
+	}
+	if len(raw) < 2 || raw[0] != '"' || raw[len(raw)-1] != '"' {
+		return "", fmt.Errorf("%w: %q is not a string", ErrInvalidJSON, raw)
+	}
+	if strings.IndexByte(raw, '\\') < 0 {
+		return raw[1 : len(raw)-1], nil
+	}
+	var s string
+	err := json.Unmarshal([]byte(raw), &s)
+	return s, err
+}
+
+// JSONInt64 returns the integer of a raw JSON number or numeric string, 0 for null
+func JSONInt64(raw string) (int64, error) {
+	if raw == "null" {
+		return 0, nil
+	}
+	if len(raw) >= 2 && raw[0] == '"' && raw[len(raw)-1] == '"' {
+		raw = raw[1 : len(raw)-1]
+	}
+	return strconv.ParseInt(raw, 10, 64)
+}
+
+// JSONBool returns the boolean of a raw JSON value, false for null
+func JSONBool(raw string) (bool, error) {
+	switch raw {
+	case "true":
+		return true, nil
+	case "false", "null":
+		return false, nil
+	}
+	return false, fmt.Errorf("%w: %q is not a boolean", ErrInvalidJSON, raw)
+}
+
+// AppendPriceLevels appends to levels the [price, quantity] pairs of the raw JSON array,
+// reusing the capacity of levels
+func AppendPriceLevels(levels []PriceLevel, raw string) ([]PriceLevel, error) {
+	if raw == "null" {
+		return levels, nil
+	}
+	err := ScanArray(raw, func(value string) error {
+		var level PriceLevel
+		n := 0
+		err := ScanArray(value, func(value string) error {
+			var err error
+			switch n {
+			case 0:
+				level.Price, err = JSONString(value)
+			case 1:
+				level.Quantity, err = JSONString(value)
+			}
+			n++
+			return err
+		})
+		if err != nil {
+			return err
+		}
+		levels = append(levels, level)
+		return nil
+	})
+	return levels, err
+}
+
+// EventPool recycle the events of a stream to spare an allocation per message. A nil
+// EventPool allocates a new event each time and recycles nothing.
+type EventPool[T any] struct {
+	pool sync.Pool
+}
+
+// Get returns an event of the pool, or a new one. The fields of a recycled event keep the
+// values of its previous message.
+func (p *EventPool[T]) Get() *T {
+	if p == nil {
+		return new(T)
+	}
+	if e, ok := p.pool.Get().(*T); ok {
+		return e
+	}
+	return new(T)
+}
+
+// Put recycles event, it must not be used afterwards
+func (p *EventPool[T]) Put(event *T) {
+	if p == nil || event == nil {
+		return
+	}
+	p.pool.Put(event)
+}
+
+func skipSpace(data string, i int) int {
+	for i < len(data) {
+		switch data[i] {
+		case ' ', '\t', '\n', '\r':
+			i++
+		default:
+			return i
+		}
+	}
+	return i
+}
+
+// stringEnd returns the index after the JSON string starting at i
+func stringEnd(data string, i int) (int, error) {
+	for j := i + 1; j < len(data); j++ {
+		switch data[j] {
+		case '\\':
+			j++
+		case '"':
+			return j + 1, nil
+		}
+	}
+	return 0, syntaxError(data, len(data))
+}
+
+// valueEnd returns the index after the JSON value starting at i, nested objects and arrays
+// are skipped without being parsed
+func valueEnd(data string, i int) (int, error) {
+	if i >= len(data) {
+		return 0, syntaxError(data, i)
+	}
+	switch data[i] {
+	case '"':
+		return stringEnd(data, i)
+	case '{', '[':
+		depth := 0
+		for j := i; j < len(data); j++ {
+			switch data[j] {
+			case '"':
+				end, err := stringEnd(data, j)
+				if err != nil {
+					return 0, err
+				}
+				j = end - 1
+			case '{', '[':
+				depth++
+			case '}', ']':
+				depth--
+				if depth == 0 {
+					return j + 1, nil
+				}
+			}
+		}
+		return 0, syntaxError(data, len(data))
+	case ',', ':', '}', ']':
+		return 0, syntaxError(data, i)
+	}
+	j := i
+	for j < len(data) && !isDelimiter(data[j]) {
+		j++
+	}
+	return j, nil
+}
+
+func isDelimiter(c byte) bool {
+	switch c {
+	case ',', '}', ']', ' ', '\t', '\n', '\r':
+		return true
+	}
+	return false
+}
+
+func syntaxError(data string, i int) error {
+	if i >= len(data) {
+		return fmt.Errorf("%w: unexpected end of data", ErrInvalidJSON)
+	}
+	return fmt.Errorf("%w: unexpected %q at offset %d", ErrInvalidJSON, data[i], i)
+}
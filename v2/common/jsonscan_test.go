@@ -0,0 +1,125 @@
+package common
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestScanObject(t *testing.T) {
+	assert := assert.New(t)
+	data := ` { "e" : "depthUpdate", "E":1, "n":null, "m":true, "o":{"a":[1,"]"]}, "b":[["1.0","2"],["3","4"]], "q":"a\"b" } `
+	var keys, values []string
+	err := ScanObject(data, func(key, value string) error {
+		keys = append(keys, key)
+		values = append(values, value)
+		return nil
+	})
+	assert.NoError(err)
+	assert.Equal([]string{"e", "E", "n", "m", "o", "b", "q"}, keys)
+	assert.Equal([]string{`"depthUpdate"`, "1", "null", "true", `{"a":[1,"]"]}`, `[["1.0","2"],["3","4"]]`, `"a\"b"`}, values)
+
+	assert.NoError(ScanObject(`{}`, func(key, value string) error {
+		t.Fatal("unexpected field")
+		return nil
+	}))
+
+	stop := errors.New("stop")
+	assert.Equal(stop, ScanObject(`{"a":1,"b":2}`, func(key, value string) error {
+		return stop
+	}))
+
+	for _, data := range []string{``, `[]`, `{"a"}`, `{"a":1`, `{"a":1 "b":2}`, `{"a":"1}`, `{"a":[1,2}`, `{a:1}`, `{"a":}`} {
+		err := ScanObject(data, func(key, value string) error { return nil })
+		assert.ErrorIs(err, ErrInvalidJSON, data)
+	}
+}
+
+func TestScanArray(t *testing.T) {
+	assert := assert.New(t)
+	var values []string
+	err := ScanArray(`[1, "a", {"b":[2]}, [], null]`, func(value string) error {
+		values = append(values, value)
+		return nil
+	})
+	assert.NoError(err)
+	assert.Equal([]string{"1", `"a"`, `{"b":[2]}`, "[]", "null"}, values)
+	assert.ErrorIs(ScanArray(`[1,`, func(value string) error { return nil }), ErrInvalidJSON)
+}
+
+func TestSplitCombinedMessage(t *testing.T) {
+	assert := assert.New(t)
+	stream, data, err := SplitCombinedMessage(`{"stream":"btcusdt@depth","data":{"e":"depthUpdate"}}`)
+	assert.NoError(err)
+	assert.Equal("btcusdt@depth", stream)
+	assert.Equal(`{"e":"depthUpdate"}`, data)
+
+	_, _, err = SplitCombinedMessage(`{"stream":"btcusdt@depth"}`)
+	assert.ErrorIs(err, ErrInvalidJSON)
+}
+
+func TestJSONValues(t *testing.T) {
+	assert := assert.New(t)
+	s, err := JSONString(`"BTCUSDT"`)
+	assert.NoError(err)
+	assert.Equal("BTCUSDT", s)
+	s, err = JSONString(`"a\"bé"`)
+	assert.NoError(err)
+	assert.Equal(`a"bé`, s)
+	s, err = JSONString(`null`)
+	assert.NoError(err)
+	assert.Equal("", s)
+	_, err = JSONString(`1`)
+	assert.ErrorIs(err, ErrInvalidJSON)
+
+	i, err := JSONInt64(`1499404630606`)
+	assert.NoError(err)
+	assert.Equal(int64(1499404630606), i)
+	i, err = JSONInt64(`"-12"`)
+	assert.NoError(err)
+	assert.Equal(int64(-12), i)
+	i, err = JSONInt64(`null`)
+	assert.NoError(err)
+	assert.Equal(int64(0), i)
+	_, err = JSONInt64(`"a"`)
+	assert.Error(err)
+
+	b, err := JSONBool(`true`)
+	assert.NoError(err)
+	assert.True(b)
+	b, err = JSONBool(`null`)
+	assert.NoError(err)
+	assert.False(b)
+	_, err = JSONBool(`1`)
+	assert.ErrorIs(err, ErrInvalidJSON)
+}
+
+func TestAppendPriceLevels(t *testing.T) {
+	assert := assert.New(t)
+	levels := make([]PriceLevel, 0, 4)
+	levels, err := AppendPriceLevels(levels, `[["0.0024","10"],["0.0025","0",[]]]`)
+	assert.NoError(err)
+	assert.Equal([]PriceLevel{{Price: "0.0024", Quantity: "10"}, {Price: "0.0025", Quantity: "0"}}, levels)
+	assert.Equal(4, cap(levels))
+
+	levels, err = AppendPriceLevels(levels[:0], `[]`)
+	assert.NoError(err)
+	assert.Empty(levels)
+
+	_, err = AppendPriceLevels(nil, `[[1,"2"]]`)
+	assert.ErrorIs(err, ErrInvalidJSON)
+}
+
+func TestEventPool(t *testing.T) {
+	assert := assert.New(t)
+	var nilPool *EventPool[PriceLevel]
+	assert.NotNil(nilPool.Get())
+	nilPool.Put(&PriceLevel{})
+
+	pool := new(EventPool[PriceLevel])
+	e := pool.Get()
+	assert.NotNil(e)
+	pool.Put(e)
+	pool.Put(nil)
+}
@@ -0,0 +1,122 @@
+//go:build go1.21
+
+package common
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// redactingHandler define a slog.Handler redacting sensitive attributes
+type redactingHandler struct {
+	next slog.Handler
+}
+
+// NewRedactingHandler wraps next so that sensitive attributes are redacted: attributes named
+// after an API key, a secret or a signature, http.Header and url.Values values, and sensitive
+// parameters found in the message or string values
+func NewRedactingHandler(next slog.Handler) slog.Handler {
+	if _, ok := next.(*redactingHandler); ok {
+		return next
+	}
+	return &redactingHandler{next: next}
+}
+
+// Enabled implements slog.Handler
+func (h *redactingHandler) Enabled(ctx context.Context, level slog.Level) bool {
+	return h.next.Enabled(ctx, level)
+}
+
+// Handle implements slog.Handler
+func (h *redactingHandler) Handle(ctx context.Context, r slog.Record) error {
+	res := slog.NewRecord(r.Time, r.Level, RedactString(r.Message), r.PC)
+	r.Attrs(func(a slog.Attr) bool {
+		res.AddAttrs(redactAttr(a))
+		return true
+	})
+	return h.next.Handle(ctx, res)
+}
+
+// WithAttrs implements slog.Handler
+func (h *redactingHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	redacted := make([]slog.Attr, len(attrs))
+	for i, a := range attrs {
+		redacted[i] = redactAttr(a)
+	}
+	return &redactingHandler{next: h.next.WithAttrs(redacted)}
+}
+
+// WithGroup implements slog.Handler
+func (h *redactingHandler) WithGroup(name string) slog.Handler {
+	return &redactingHandler{next: h.next.WithGroup(name)}
+}
+
+func redactAttr(a slog.Attr) slog.Attr {
+	if IsSensitiveKey(a.Key) {
+		return slog.String(a.Key, Redacted)
+	}
+	v := a.Value.Resolve()
+	switch v.Kind() {
+	case slog.KindGroup:
+		group := v.Group()
+		attrs := make([]any, len(group))
+		for i, g := range group {
+			attrs[i] = redactAttr(g)
+		}
+		return slog.Group(a.Key, attrs...)
+	case slog.KindString:
+		return slog.String(a.Key, RedactString(v.String()))
+	case slog.KindAny:
+		switch x := v.Any().(type) {
+		case http.Header:
+			return slog.Any(a.Key, RedactHeader(x))
+		case url.Values:
+			return slog.Any(a.Key, RedactValues(x))
+		case *url.URL:
+			return slog.String(a.Key, RedactURL(x.String()))
+		}
+	}
+	return slog.Attr{Key: a.Key, Value: v}
+}
+
+// LogMiddleware returns a middleware logging each REST API call: requests and responses at
+// debug level, API errors at warn level and transport errors at error level.
+// Sensitive values are redacted.
+func LogMiddleware(logger *slog.Logger) Middleware {
+	logger = slog.New(NewRedactingHandler(logger.Handler()))
+	return func(next Handler) Handler {
+		return func(req *http.Request) (*Response, error) {
+			ctx := req.Context()
+			logger.LogAttrs(ctx, slog.LevelDebug, "binance request",
+				slog.String("method", req.Method),
+				slog.String("url", req.URL.String()),
+				slog.Any("header", req.Header),
+			)
+			start := time.Now()
+			res, err := next(req)
+			attrs := []slog.Attr{
+				slog.String("method", req.Method),
+				slog.String("endpoint", req.URL.Path),
+				slog.Duration("elapsed", time.Since(start)),
+			}
+			if res != nil {
+				attrs = append(attrs, slog.Int("status", res.StatusCode))
+				if w := res.Header.Get("X-Mbx-Used-Weight-1m"); w != "" {
+					attrs = append(attrs, slog.String("usedWeight1m", w))
+				}
+			}
+			switch {
+			case err == nil:
+				logger.LogAttrs(ctx, slog.LevelDebug, "binance response", attrs...)
+			case res != nil:
+				logger.LogAttrs(ctx, slog.LevelWarn, "binance api error", append(attrs, slog.String("error", err.Error()))...)
+			default:
+				logger.LogAttrs(ctx, slog.LevelError, "binance request failed", append(attrs, slog.String("error", err.Error()))...)
+			}
+			return res, err
+		}
+	}
+}
@@ -0,0 +1,60 @@
+//go:build go1.21
+
+package common
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"log/slog"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRedactingHandler(t *testing.T) {
+	assert := assert.New(t)
+	buf := &bytes.Buffer{}
+	logger := slog.New(NewRedactingHandler(slog.NewTextHandler(buf, &slog.HandlerOptions{Level: slog.LevelDebug})))
+	h := http.Header{}
+	h.Set("X-MBX-APIKEY", "my-api-key")
+	logger.With("secretKey", "my-secret").Debug("signed request signature=my-signature",
+		"url", "https://api.binance.com/api/v3/order?signature=my-signature",
+		"header", h,
+		slog.Group("params", "apiKey", "my-api-key", "symbol", "BTCUSDT"),
+	)
+	out := buf.String()
+	assert.NotContains(out, "my-api-key")
+	assert.NotContains(out, "my-secret")
+	assert.NotContains(out, "my-signature")
+	assert.Contains(out, "BTCUSDT")
+	assert.Contains(out, Redacted)
+}
+
+func TestLogMiddleware(t *testing.T) {
+	assert := assert.New(t)
+	buf := &bytes.Buffer{}
+	logger := slog.New(slog.NewJSONHandler(buf, &slog.HandlerOptions{Level: slog.LevelDebug}))
+
+	req, _ := http.NewRequestWithContext(context.Background(), http.MethodGet, "https://api.binance.com/api/v3/account?timestamp=1&signature=my-signature", nil)
+	req.Header.Set("X-MBX-APIKEY", "my-api-key")
+	h := Chain(func(req *http.Request) (*Response, error) {
+		return &Response{StatusCode: http.StatusBadRequest, Header: http.Header{}}, &APIError{Code: -1022, Message: "Signature for this request is not valid."}
+	}, LogMiddleware(logger))
+	_, err := h(req)
+	assert.Error(err)
+	out := buf.String()
+	assert.Contains(out, `"level":"DEBUG","msg":"binance request"`)
+	assert.Contains(out, `"level":"WARN","msg":"binance api error"`)
+	assert.NotContains(out, "my-api-key")
+	assert.NotContains(out, "my-signature")
+
+	buf.Reset()
+	h = Chain(func(req *http.Request) (*Response, error) {
+		return nil, errors.New("dummy error")
+	}, LogMiddleware(logger))
+	_, err = h(req)
+	assert.Error(err)
+	assert.Contains(buf.String(), `"level":"ERROR","msg":"binance request failed"`)
+}
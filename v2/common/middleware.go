@@ -0,0 +1,29 @@
+package common
+
+import (
+	"net/http"
+)
+
+// Response define the result of a REST API call as seen by middlewares
+type Response struct {
+	StatusCode int
+	Header     http.Header
+	Body       []byte
+}
+
+// Handler sends a built and signed REST API request and returns its response.
+// When the API answers with an error status, both the response and the decoded
+// error are returned.
+type Handler func(req *http.Request) (*Response, error)
+
+// Middleware wraps a Handler, e.g. to add tracing, metrics, rate limiting or audit logging.
+// A middleware may modify the request before calling next, or skip next entirely.
+type Middleware func(next Handler) Handler
+
+// Chain wraps h with middlewares, the first middleware being the outermost
+func Chain(h Handler, middlewares ...Middleware) Handler {
+	for i := len(middlewares) - 1; i >= 0; i-- {
+		h = middlewares[i](h)
+	}
+	return h
+}
@@ -0,0 +1,71 @@
+package common
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestChain(t *testing.T) {
+	assert := assert.New(t)
+	var calls []string
+	record := func(name string) Middleware {
+		return func(next Handler) Handler {
+			return func(req *http.Request) (*Response, error) {
+				calls = append(calls, name)
+				return next(req)
+			}
+		}
+	}
+	res := &Response{StatusCode: http.StatusOK, Body: []byte(`{}`)}
+	h := Chain(func(req *http.Request) (*Response, error) {
+		calls = append(calls, "handler")
+		return res, nil
+	}, record("outer"), record("inner"))
+
+	req, _ := http.NewRequest(http.MethodGet, "https://api.binance.com/test", nil)
+	actual, err := h(req)
+	assert.NoError(err)
+	assert.Same(res, actual)
+	assert.Equal([]string{"outer", "inner", "handler"}, calls)
+}
+
+func TestChainAPIError(t *testing.T) {
+	assert := assert.New(t)
+	apiErr := &APIError{Code: -1121, Message: "Invalid symbol."}
+	res := &Response{StatusCode: http.StatusBadRequest}
+	var seen *Response
+	var seenErr error
+	h := Chain(func(req *http.Request) (*Response, error) {
+		return res, apiErr
+	}, func(next Handler) Handler {
+		return func(req *http.Request) (*Response, error) {
+			seen, seenErr = next(req)
+			return seen, seenErr
+		}
+	})
+
+	req, _ := http.NewRequest(http.MethodGet, "https://api.binance.com/test", nil)
+	_, err := h(req)
+	assert.Equal(apiErr, err)
+	assert.Equal(apiErr, seenErr)
+	assert.Same(res, seen)
+}
+
+func TestChainShortCircuit(t *testing.T) {
+	blocked := errors.New("blocked")
+	h := Chain(func(req *http.Request) (*Response, error) {
+		t.Fatal("handler called")
+		return nil, nil
+	}, func(next Handler) Handler {
+		return func(req *http.Request) (*Response, error) {
+			return nil, blocked
+		}
+	})
+
+	req, _ := http.NewRequest(http.MethodGet, "https://api.binance.com/test", nil)
+	_, err := h(req)
+	assert.ErrorIs(t, err, blocked)
+}
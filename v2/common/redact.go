@@ -0,0 +1,78 @@
+package common
+
+import (
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+)
+
+// Redacted replaces sensitive values in logs
+const Redacted = "[REDACTED]"
+
+// sensitiveKeys define the lower-cased attribute, header and parameter names redacted from logs
+var sensitiveKeys = map[string]struct{}{
+	"x-mbx-apikey": {},
+	"apikey":       {},
+	"api_key":      {},
+	"signature":    {},
+	"secret":       {},
+	"secretkey":    {},
+	"secret_key":   {},
+}
+
+var (
+	// sensitiveParam matches sensitive query or form parameters, e.g. signature=abc
+	sensitiveParam = regexp.MustCompile(`(?i)\b(x-mbx-apikey|apikey|api_key|signature|secret|secretkey|secret_key)=[^&\s"']*`)
+	// sensitiveField matches sensitive JSON fields, e.g. "signature":"abc"
+	sensitiveField = regexp.MustCompile(`(?i)"(x-mbx-apikey|apikey|api_key|signature|secret|secretkey|secret_key)"\s*:\s*"[^"]*"`)
+)
+
+// IsSensitiveKey check if values of key must not be logged
+func IsSensitiveKey(key string) bool {
+	_, ok := sensitiveKeys[strings.ToLower(key)]
+	return ok
+}
+
+// RedactValues returns a copy of v with sensitive values redacted
+func RedactValues(v url.Values) url.Values {
+	res := make(url.Values, len(v))
+	for k, vs := range v {
+		if IsSensitiveKey(k) {
+			res[k] = []string{Redacted}
+			continue
+		}
+		res[k] = vs
+	}
+	return res
+}
+
+// RedactHeader returns a copy of h with sensitive values redacted
+func RedactHeader(h http.Header) http.Header {
+	res := make(http.Header, len(h))
+	for k, vs := range h {
+		if IsSensitiveKey(k) {
+			res[k] = []string{Redacted}
+			continue
+		}
+		res[k] = vs
+	}
+	return res
+}
+
+// RedactURL returns rawURL with sensitive query parameters redacted
+func RedactURL(rawURL string) string {
+	u, err := url.Parse(rawURL)
+	if err != nil || u.RawQuery == "" {
+		return rawURL
+	}
+	u.RawQuery = RedactValues(u.Query()).Encode()
+	return u.String()
+}
+
+// RedactString redacts sensitive query parameters and JSON fields found in s,
+// e.g. a full URL, a form body or a WebSocket API request
+func RedactString(s string) string {
+	s = sensitiveParam.ReplaceAllString(s, "$1="+Redacted)
+	return sensitiveField.ReplaceAllString(s, `"$1":"`+Redacted+`"`)
+}
@@ -0,0 +1,34 @@
+package common
+
+import (
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRedactString(t *testing.T) {
+	assert := assert.New(t)
+	assert.Equal("https://api.binance.com/api/v3/order?symbol=BTCUSDT&timestamp=1&signature=[REDACTED]",
+		RedactString("https://api.binance.com/api/v3/order?symbol=BTCUSDT&timestamp=1&signature=abcdef"))
+	assert.Equal(`{"params":{"apiKey":"[REDACTED]","signature":"[REDACTED]","symbol":"BTCUSDT"}}`,
+		RedactString(`{"params":{"apiKey":"key","signature":"sig","symbol":"BTCUSDT"}}`))
+	assert.Equal("nothing to hide", RedactString("nothing to hide"))
+}
+
+func TestRedactHeaderAndValues(t *testing.T) {
+	assert := assert.New(t)
+	h := http.Header{}
+	h.Set("X-MBX-APIKEY", "key")
+	h.Set("Content-Type", "application/json")
+	redacted := RedactHeader(h)
+	assert.Equal(Redacted, redacted.Get("X-MBX-APIKEY"))
+	assert.Equal("application/json", redacted.Get("Content-Type"))
+	assert.Equal("key", h.Get("X-MBX-APIKEY"))
+
+	v := url.Values{"signature": {"sig"}, "symbol": {"BTCUSDT"}}
+	assert.Equal(url.Values{"signature": {Redacted}, "symbol": {"BTCUSDT"}}, RedactValues(v))
+	assert.Equal("https://api.binance.com/api/v3/account?signature=%5BREDACTED%5D&timestamp=1",
+		RedactURL("https://api.binance.com/api/v3/account?timestamp=1&signature=sig"))
+}
@@ -9,9 +9,11 @@ import (
 	"crypto/sha256"
 	"crypto/x509"
 	"encoding/base64"
+	"encoding/hex"
 	"encoding/pem"
 	"errors"
 	"fmt"
+	"sync"
 )
 
 const (
@@ -20,31 +22,60 @@ const (
 	KeyTypeEd25519 = "ED25519"
 )
 
-func SignFunc(keyType string) (func(string, string) (*string, error), error) {
-	switch {
-	case keyType == KeyTypeHmac:
-		return Hmac, nil
-	case keyType == KeyTypeRsa:
-		return Rsa, nil
-	case keyType == KeyTypeEd25519:
-		return Ed25519, nil
+// Signer signs request payloads. Implementations must be safe for concurrent use.
+type Signer interface {
+	// Sign returns the encoded signature of payload, as sent in the signature parameter
+	Sign(payload []byte) (string, error)
+	// KeyType returns the key type, one of KeyTypeHmac, KeyTypeRsa or KeyTypeEd25519
+	KeyType() string
+}
+
+// NewSigner init the signer of a key type from its secret, the key is parsed once
+func NewSigner(keyType string, secretKey string) (Signer, error) {
+	switch keyType {
+	case KeyTypeHmac:
+		return NewHmacSigner(secretKey), nil
+	case KeyTypeRsa:
+		return NewRsaSigner(secretKey)
+	case KeyTypeEd25519:
+		return NewEd25519Signer(secretKey)
 	default:
 		return nil, fmt.Errorf("unsupported keyType=%s", keyType)
 	}
 }
 
-func Hmac(secretKey string, data string) (*string, error) {
-	mac := hmac.New(sha256.New, []byte(secretKey))
-	_, err := mac.Write([]byte(data))
-	if err != nil {
-		return nil, err
+// HmacSigner define a HMAC-SHA256 signer
+type HmacSigner struct {
+	key []byte
+}
+
+// NewHmacSigner init a HMAC-SHA256 signer
+func NewHmacSigner(secretKey string) *HmacSigner {
+	return &HmacSigner{key: []byte(secretKey)}
+}
+
+// Sign returns the hex encoded signature of payload
+func (s *HmacSigner) Sign(payload []byte) (string, error) {
+	mac := hmac.New(sha256.New, s.key)
+	if _, err := mac.Write(payload); err != nil {
+		return "", err
 	}
-	encodeData := fmt.Sprintf("%x", (mac.Sum(nil)))
-	return &encodeData, nil
+	return hex.EncodeToString(mac.Sum(nil)), nil
 }
 
-func Rsa(secretKey string, data string) (*string, error) {
-	block, _ := pem.Decode([]byte(secretKey))
+// KeyType returns KeyTypeHmac
+func (s *HmacSigner) KeyType() string {
+	return KeyTypeHmac
+}
+
+// RsaSigner define a RSA PKCS#1 v1.5 SHA-256 signer
+type RsaSigner struct {
+	key *rsa.PrivateKey
+}
+
+// NewRsaSigner init a RSA signer from a PEM encoded PKCS#8 private key
+func NewRsaSigner(pemKey string) (*RsaSigner, error) {
+	block, _ := pem.Decode([]byte(pemKey))
 	if block == nil {
 		return nil, errors.New("Rsa pem.Decode failed, invalid pem format secretKey")
 	}
@@ -56,17 +87,32 @@ func Rsa(secretKey string, data string) (*string, error) {
 	if !ok {
 		return nil, fmt.Errorf("Rsa convert PrivateKey failed")
 	}
-	hashed := sha256.Sum256([]byte(data))
-	signature, err := rsa.SignPKCS1v15(rand.Reader, rsaPrivateKey, crypto.SHA256, hashed[:])
+	return &RsaSigner{key: rsaPrivateKey}, nil
+}
+
+// Sign returns the base64 encoded signature of payload
+func (s *RsaSigner) Sign(payload []byte) (string, error) {
+	hashed := sha256.Sum256(payload)
+	signature, err := rsa.SignPKCS1v15(rand.Reader, s.key, crypto.SHA256, hashed[:])
 	if err != nil {
-		return nil, err
+		return "", err
 	}
-	encodedSignature := base64.StdEncoding.EncodeToString(signature)
-	return &encodedSignature, nil
+	return base64.StdEncoding.EncodeToString(signature), nil
 }
 
-func Ed25519(secretKey string, data string) (*string, error) {
-	block, _ := pem.Decode([]byte(secretKey))
+// KeyType returns KeyTypeRsa
+func (s *RsaSigner) KeyType() string {
+	return KeyTypeRsa
+}
+
+// Ed25519Signer define an Ed25519 signer
+type Ed25519Signer struct {
+	key ed25519.PrivateKey
+}
+
+// NewEd25519Signer init an Ed25519 signer from a PEM encoded PKCS#8 private key
+func NewEd25519Signer(pemKey string) (*Ed25519Signer, error) {
+	block, _ := pem.Decode([]byte(pemKey))
 	if block == nil {
 		return nil, fmt.Errorf("Ed25519 pem.Decode failed, invalid pem format secretKey")
 	}
@@ -78,8 +124,121 @@ func Ed25519(secretKey string, data string) (*string, error) {
 	if !ok {
 		return nil, fmt.Errorf("Ed25519 convert PrivateKey failed")
 	}
-	pk := ed25519.PrivateKey(ed25519PrivateKey)
-	signature := ed25519.Sign(pk, []byte(data))
-	encodedSignature := base64.StdEncoding.EncodeToString(signature)
-	return &encodedSignature, nil
+	return &Ed25519Signer{key: ed25519PrivateKey}, nil
+}
+
+// Sign returns the base64 encoded signature of payload
+func (s *Ed25519Signer) Sign(payload []byte) (string, error) {
+	return base64.StdEncoding.EncodeToString(ed25519.Sign(s.key, payload)), nil
+}
+
+// KeyType returns KeyTypeEd25519
+func (s *Ed25519Signer) KeyType() string {
+	return KeyTypeEd25519
+}
+
+// ExternalSignFunc returns the raw signature of payload computed outside of the process,
+// e.g. by a KMS or an HSM. RSA keys must sign the SHA-256 digest with PKCS#1 v1.5.
+type ExternalSignFunc func(payload []byte) ([]byte, error)
+
+// externalSigner define a signer delegating to an ExternalSignFunc
+type externalSigner struct {
+	keyType string
+	sign    ExternalSignFunc
+}
+
+// NewExternalSigner init a signer delegating signatures to sign, so that the secret never
+// lives in the process. Raw signatures are hex encoded for HMAC keys and base64 encoded otherwise.
+func NewExternalSigner(keyType string, sign ExternalSignFunc) (Signer, error) {
+	switch keyType {
+	case KeyTypeHmac, KeyTypeRsa, KeyTypeEd25519:
+	default:
+		return nil, fmt.Errorf("unsupported keyType=%s", keyType)
+	}
+	return &externalSigner{keyType: keyType, sign: sign}, nil
+}
+
+// Sign returns the encoded external signature of payload
+func (s *externalSigner) Sign(payload []byte) (string, error) {
+	raw, err := s.sign(payload)
+	if err != nil {
+		return "", err
+	}
+	if s.keyType == KeyTypeHmac {
+		return hex.EncodeToString(raw), nil
+	}
+	return base64.StdEncoding.EncodeToString(raw), nil
+}
+
+// KeyType returns the key type
+func (s *externalSigner) KeyType() string {
+	return s.keyType
+}
+
+// SignerCache parses a secret once and reuses its signer as long as the key type and the
+// secret do not change. It is safe for concurrent use.
+type SignerCache struct {
+	mu        sync.Mutex
+	keyType   string
+	secretKey string
+	signer    Signer
+}
+
+// Get returns the signer of keyType and secretKey, HMAC when keyType is empty
+func (c *SignerCache) Get(keyType string, secretKey string) (Signer, error) {
+	if keyType == "" {
+		keyType = KeyTypeHmac
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.signer != nil && c.keyType == keyType && c.secretKey == secretKey {
+		return c.signer, nil
+	}
+	signer, err := NewSigner(keyType, secretKey)
+	if err != nil {
+		return nil, err
+	}
+	c.keyType, c.secretKey, c.signer = keyType, secretKey, signer
+	return signer, nil
+}
+
+func SignFunc(keyType string) (func(string, string) (*string, error), error) {
+	switch {
+	case keyType == KeyTypeHmac:
+		return Hmac, nil
+	case keyType == KeyTypeRsa:
+		return Rsa, nil
+	case keyType == KeyTypeEd25519:
+		return Ed25519, nil
+	default:
+		return nil, fmt.Errorf("unsupported keyType=%s", keyType)
+	}
+}
+
+// Hmac signs data with secretKey, prefer a HmacSigner to sign several payloads
+func Hmac(secretKey string, data string) (*string, error) {
+	return sign(NewHmacSigner(secretKey), nil, data)
+}
+
+// Rsa signs data with secretKey, prefer a RsaSigner to avoid parsing the key on each call
+func Rsa(secretKey string, data string) (*string, error) {
+	signer, err := NewRsaSigner(secretKey)
+	return sign(signer, err, data)
+}
+
+// Ed25519 signs data with secretKey, prefer an Ed25519Signer to avoid parsing the key on each call
+func Ed25519(secretKey string, data string) (*string, error) {
+	signer, err := NewEd25519Signer(secretKey)
+	return sign(signer, err, data)
+}
+
+func sign(signer Signer, err error, data string) (*string, error) {
+	if err != nil {
+		return nil, err
+	}
+	signature, err := signer.Sign([]byte(data))
+	if err != nil {
+		return nil, err
+	}
+	return &signature, nil
 }
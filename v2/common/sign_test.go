@@ -0,0 +1,141 @@
+package common
+
+import (
+	"crypto"
+	"crypto/ed25519"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func encodePKCS8(t *testing.T, key interface{}) string {
+	der, err := x509.MarshalPKCS8PrivateKey(key)
+	require.NoError(t, err)
+	return string(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der}))
+}
+
+func TestHmacSigner(t *testing.T) {
+	// example of the Binance API documentation
+	secret := "NhqPtmdSJYdKjVHjA7PZj4Mge3R5YNiP1e3UZjInClVN65XAbvqqM6A7H5fATj0j"
+	payload := "symbol=LTCBTC&side=BUY&type=LIMIT&timeInForce=GTC&quantity=1&price=0.1&recvWindow=5000&timestamp=1499827319559"
+	expected := "c8db56825ae71d6d79447849e617115f4a920fa2acdcab2b053c4b2838bd6b71"
+
+	signer, err := NewSigner(KeyTypeHmac, secret)
+	require.NoError(t, err)
+	assert.Equal(t, KeyTypeHmac, signer.KeyType())
+	signature, err := signer.Sign([]byte(payload))
+	require.NoError(t, err)
+	assert.Equal(t, expected, signature)
+
+	legacy, err := Hmac(secret, payload)
+	require.NoError(t, err)
+	assert.Equal(t, expected, *legacy)
+}
+
+func TestRsaSigner(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	signer, err := NewSigner(KeyTypeRsa, encodePKCS8(t, key))
+	require.NoError(t, err)
+	assert.Equal(t, KeyTypeRsa, signer.KeyType())
+
+	payload := []byte("symbol=BTCUSDT&timestamp=1")
+	signature, err := signer.Sign(payload)
+	require.NoError(t, err)
+	raw, err := base64.StdEncoding.DecodeString(signature)
+	require.NoError(t, err)
+	hashed := sha256.Sum256(payload)
+	assert.NoError(t, rsa.VerifyPKCS1v15(&key.PublicKey, crypto.SHA256, hashed[:], raw))
+
+	_, err = NewRsaSigner("invalid")
+	assert.Error(t, err)
+}
+
+func TestEd25519Signer(t *testing.T) {
+	pub, key, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	pemKey := encodePKCS8(t, key)
+	signer, err := NewSigner(KeyTypeEd25519, pemKey)
+	require.NoError(t, err)
+	assert.Equal(t, KeyTypeEd25519, signer.KeyType())
+
+	payload := []byte("symbol=BTCUSDT&timestamp=1")
+	signature, err := signer.Sign(payload)
+	require.NoError(t, err)
+	raw, err := base64.StdEncoding.DecodeString(signature)
+	require.NoError(t, err)
+	assert.True(t, ed25519.Verify(pub, payload, raw))
+
+	legacy, err := Ed25519(pemKey, string(payload))
+	require.NoError(t, err)
+	assert.Equal(t, signature, *legacy)
+
+	// a RSA key is not an Ed25519 key
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 1024)
+	require.NoError(t, err)
+	_, err = NewEd25519Signer(encodePKCS8(t, rsaKey))
+	assert.Error(t, err)
+}
+
+func TestExternalSigner(t *testing.T) {
+	secret := []byte("dummySecretKey")
+	var payloads []string
+	signer, err := NewExternalSigner(KeyTypeHmac, func(payload []byte) ([]byte, error) {
+		payloads = append(payloads, string(payload))
+		mac := hmac.New(sha256.New, secret)
+		mac.Write(payload)
+		return mac.Sum(nil), nil
+	})
+	require.NoError(t, err)
+	assert.Equal(t, KeyTypeHmac, signer.KeyType())
+	signature, err := signer.Sign([]byte("timestamp=1"))
+	require.NoError(t, err)
+	expected, err := NewHmacSigner(string(secret)).Sign([]byte("timestamp=1"))
+	require.NoError(t, err)
+	assert.Equal(t, expected, signature)
+	assert.Equal(t, []string{"timestamp=1"}, payloads)
+
+	signer, err = NewExternalSigner(KeyTypeEd25519, func(payload []byte) ([]byte, error) {
+		return []byte{1, 2, 3}, nil
+	})
+	require.NoError(t, err)
+	signature, err = signer.Sign([]byte("timestamp=1"))
+	require.NoError(t, err)
+	assert.Equal(t, "AQID", signature)
+
+	signer, err = NewExternalSigner(KeyTypeRsa, func(payload []byte) ([]byte, error) {
+		return nil, errors.New("kms unavailable")
+	})
+	require.NoError(t, err)
+	_, err = signer.Sign([]byte("timestamp=1"))
+	assert.EqualError(t, err, "kms unavailable")
+
+	_, err = NewExternalSigner("DSA", nil)
+	assert.Error(t, err)
+}
+
+func TestSignerCache(t *testing.T) {
+	cache := &SignerCache{}
+	first, err := cache.Get("", "secret")
+	require.NoError(t, err)
+	assert.Equal(t, KeyTypeHmac, first.KeyType())
+	second, err := cache.Get(KeyTypeHmac, "secret")
+	require.NoError(t, err)
+	assert.Same(t, first, second)
+
+	third, err := cache.Get(KeyTypeHmac, "other")
+	require.NoError(t, err)
+	assert.NotSame(t, first, third)
+
+	_, err = cache.Get(KeyTypeRsa, "invalid")
+	assert.Error(t, err)
+}
@@ -39,6 +39,16 @@ var (
 	WaitCheckInternal = 300 * time.Millisecond
 )
 
+// logLevel define the level of a record of the client, the values of the slog levels
+type logLevel int
+
+const (
+	logLevelDebug logLevel = -4
+	logLevelInfo  logLevel = 0
+	logLevelWarn  logLevel = 4
+	logLevelError logLevel = 8
+)
+
 // messageId define id field of request/response
 type messageId struct {
 	Id string `json:"id"`
@@ -48,6 +58,7 @@ type messageId struct {
 type client struct {
 	Debug                       bool
 	logger                      *log.Logger
+	logRecord                   func(level logLevel, msg string)
 	conn                        Connection
 	connMu                      sync.Mutex
 	reconnectSignal             chan struct{}
@@ -59,17 +70,25 @@ type client struct {
 }
 
 func (c *client) debug(format string, v ...interface{}) {
+	c.log(logLevelDebug, format, v...)
+}
+
+// log writes a record to the log handler and, in debug mode, to the legacy logger
+func (c *client) log(level logLevel, format string, v ...interface{}) {
 	if c.Debug {
 		c.logger.Println(fmt.Sprintf(format, v...))
 	}
+	if c.logRecord != nil {
+		c.logRecord(level, fmt.Sprintf(format, v...))
+	}
 }
 
 func (c *client) Close() error {
 	return c.conn.Close()
 }
 
-// NewClient init client
-func NewClient(conn Connection) (Client, error) {
+// NewClient init client, wrapped by the WrapClient of opts when set
+func NewClient(conn Connection, opts ...Options) (Client, error) {
 	client := &client{
 		logger:                      log.New(os.Stderr, "Binance-golang ", log.LstdFlags),
 		conn:                        conn,
@@ -80,10 +99,15 @@ func NewClient(conn Connection) (Client, error) {
 		readErrChan:                 make(chan error, 1),
 		readC:                       make(chan []byte),
 	}
+	o := MergeOptions(opts...)
+	client.logRecord = newLogRecord(o.Logger)
 
 	go client.handleReconnect()
 	go client.read()
 
+	if wrap := o.WrapClient; wrap != nil {
+		return wrap(client), nil
+	}
 	return client, nil
 }
 
@@ -107,7 +131,7 @@ func (c *client) Write(id string, data []byte) error {
 	}
 
 	if err := c.conn.WriteMessage(websocket.TextMessage, data); err != nil {
-		c.debug("write: unable to write message into websocket conn '%v'", err)
+		c.log(logLevelError, "write: unable to write message into websocket conn '%v'", err)
 		return err
 	}
 
@@ -123,7 +147,7 @@ func (c *client) WriteSync(id string, data []byte, timeout time.Duration) ([]byt
 	defer c.connMu.Unlock()
 
 	if err := c.conn.WriteMessage(websocket.TextMessage, data); err != nil {
-		c.debug("write sync: unable to write message into websocket conn '%v'", err)
+		c.log(logLevelError, "write sync: unable to write message into websocket conn '%v'", err)
 		return nil, err
 	}
 
@@ -133,7 +157,7 @@ func (c *client) WriteSync(id string, data []byte, timeout time.Duration) ([]byt
 	for {
 		select {
 		case <-ctx.Done():
-			c.debug("write sync: timeout expired")
+			c.log(logLevelWarn, "write sync: timeout expired")
 			return nil, ErrorWsReadConnectionTimeout
 		case rawData := <-c.readC:
 			// check that the correct response from websocket has been read
@@ -149,7 +173,7 @@ func (c *client) WriteSync(id string, data []byte, timeout time.Duration) ([]byt
 
 			return rawData, nil
 		case err := <-c.readErrChan:
-			c.debug("write sync: error read '%v'", err)
+			c.log(logLevelWarn, "write sync: error read '%v'", err)
 			return nil, err
 		}
 	}
@@ -180,7 +204,7 @@ func (c *client) read() {
 		c.debug("read: waiting for message")
 		_, message, err := c.conn.ReadMessage()
 		if err != nil {
-			c.debug("read: error reading message '%v'", err)
+			c.log(logLevelWarn, "read: error reading message '%v'", err)
 			c.reconnectSignal <- struct{}{}
 			c.readErrChan <- err
 
@@ -198,7 +222,7 @@ func (c *client) read() {
 		msg := messageId{}
 		err = json.Unmarshal(message, &msg)
 		if err != nil {
-			c.debug("read: error unmarshalling message '%v'", err)
+			c.log(logLevelWarn, "read: error unmarshalling message '%v'", err)
 			c.readErrChan <- err
 			continue
 		}
@@ -252,7 +276,7 @@ func (c *client) handleReconnect() {
 		c.conn = conn
 		c.connMu.Unlock()
 
-		c.debug("reconnect: connected")
+		c.log(logLevelInfo, "reconnect: connected")
 		c.connectionEstablishedSignal <- struct{}{}
 	}
 }
@@ -264,7 +288,7 @@ func (c *client) startReconnect(b *backoff.Backoff) Connection {
 		conn, err := c.conn.RestoreConnection()
 		if err != nil {
 			delay := b.Duration()
-			c.debug("reconnect: error while reconnecting. try in %s", delay.Round(time.Millisecond))
+			c.log(logLevelWarn, "reconnect: error while reconnecting. try in %s", delay.Round(time.Millisecond))
 			time.Sleep(delay)
 			continue
 		}
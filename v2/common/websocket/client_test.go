@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"log"
 	"net"
 	"net/http"
@@ -264,3 +265,91 @@ func startWsTestServer(stopCh chan struct{}, readyCh chan struct{}) {
 	}
 	log.Println("Graceful shutdown complete.")
 }
+
+// failingConnection define a connection whose writes always fail
+type failingConnection struct {
+	closeC chan struct{}
+}
+
+func (c *failingConnection) WriteMessage(messageType int, data []byte) error {
+	return errors.New("dummy error")
+}
+
+func (c *failingConnection) ReadMessage() (int, []byte, error) {
+	<-c.closeC
+	return 0, nil, errors.New("closed")
+}
+
+func (c *failingConnection) RestoreConnection() (Connection, error) {
+	return c, nil
+}
+
+func (c *failingConnection) Close() error {
+	return nil
+}
+
+// wrappedClient define a Client recording its writes
+type wrappedClient struct {
+	Client
+	ids []string
+}
+
+func (c *wrappedClient) Write(id string, data []byte) error {
+	c.ids = append(c.ids, id)
+	return c.Client.Write(id, data)
+}
+
+func (s *clientTestSuite) TestWrapClient() {
+	var wrapped *wrappedClient
+	conn := &failingConnection{closeC: make(chan struct{})}
+	c, err := NewClient(conn, Options{}, Options{WrapClient: func(c Client) Client {
+		wrapped = &wrappedClient{Client: c}
+		return wrapped
+	}})
+	s.Require().NoError(err)
+	s.Same(wrapped, c)
+
+	s.Error(c.Write("1", []byte(`{"id":"1"}`)))
+	s.Equal([]string{"1"}, wrapped.ids)
+
+	// clients created without the option are not wrapped
+	other, err := NewClient(&failingConnection{closeC: make(chan struct{})})
+	s.Require().NoError(err)
+	s.IsType(&client{}, other)
+}
+
+// queuedConnection define a connection reading its queued messages, then blocking
+type queuedConnection struct {
+	failingConnection
+	messages chan []byte
+}
+
+func (c *queuedConnection) ReadMessage() (int, []byte, error) {
+	select {
+	case m := <-c.messages:
+		return websocket.TextMessage, m, nil
+	case <-c.closeC:
+		return 0, nil, errors.New("closed")
+	}
+}
+
+func (s *clientTestSuite) TestSlowReaderNoDrop() {
+	conn := &queuedConnection{failingConnection: failingConnection{closeC: make(chan struct{})}, messages: make(chan []byte, 100)}
+	defer close(conn.closeC)
+	for i := 0; i < 100; i++ {
+		conn.messages <- []byte(fmt.Sprintf(`{"id":"%d"}`, i))
+	}
+	c, err := NewClient(conn, Options{Delivery: &DeliveryConfig{Mode: DeliveryModeBuffered, QueueSize: 1}})
+	s.Require().NoError(err)
+
+	// the responses wait for a slow reader, none of them is dropped
+	time.Sleep(50 * time.Millisecond)
+	for i := 0; i < 100; i++ {
+		select {
+		case m := <-c.GetReadChannel():
+			s.Equal(fmt.Sprintf(`{"id":"%d"}`, i), string(m))
+		case <-time.After(time.Second):
+			s.FailNow("response dropped", "id %d", i)
+		}
+	}
+}
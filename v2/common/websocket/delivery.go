@@ -0,0 +1,285 @@
+package websocket
+
+import (
+	"bytes"
+	"hash/fnv"
+	"sync"
+	"sync/atomic"
+)
+
+// DeliveryMode define how messages read from a connection are delivered to the handler
+type DeliveryMode string
+
+// OverflowPolicy define which message is dropped when a delivery queue is full
+type OverflowPolicy string
+
+// Global enums
+const (
+	// DeliveryModeSync calls the handler in the read loop, a slow handler stalls reading
+	DeliveryModeSync DeliveryMode = "SYNC"
+	// DeliveryModeBuffered calls the handler from one goroutine fed by a bounded queue
+	DeliveryModeBuffered DeliveryMode = "BUFFERED"
+	// DeliveryModeWorkerPool calls the handler from several goroutines, each fed by a bounded
+	// queue. The messages of a key are delivered by the same worker, in order.
+	DeliveryModeWorkerPool DeliveryMode = "WORKER_POOL"
+
+	// OverflowPolicyDropOldest drops the oldest queued message to queue the new one
+	OverflowPolicyDropOldest OverflowPolicy = "DROP_OLDEST"
+	// OverflowPolicyDropNewest drops the new message
+	OverflowPolicyDropNewest OverflowPolicy = "DROP_NEWEST"
+	// OverflowPolicyCoalesce replaces the queued message of the same key by the new one, at
+	// any queue depth, so only the latest message of a symbol waits in the queue. Messages
+	// without key are queued like with OverflowPolicyDropOldest.
+	OverflowPolicyCoalesce OverflowPolicy = "COALESCE"
+
+	// DefaultDeliveryQueueSize is the queue size of buffered modes when none is set
+	DefaultDeliveryQueueSize = 1000
+)
+
+// DeliveryConfig define the delivery of messages, the zero value delivers synchronously
+type DeliveryConfig struct {
+	Mode DeliveryMode
+	// QueueSize is the capacity of the queue of each worker, DefaultDeliveryQueueSize when 0
+	QueueSize int
+	// Policy is OverflowPolicyDropOldest when empty
+	Policy OverflowPolicy
+	// Workers is the number of workers of DeliveryModeWorkerPool, 1 when not positive
+	Workers int
+	// Key returns the key of a message to coalesce and to route it to a worker, MessageSymbol
+	// when nil
+	Key func(message []byte) string
+	// Stats, when set, is updated with the counters of the delivery
+	Stats *DeliveryStats
+}
+
+// DeliveryStats define the counters of a delivery, safe for concurrent use. One DeliveryStats
+// may be shared by several connections to count them together.
+type DeliveryStats struct {
+	received   uint64
+	delivered  uint64
+	dropped    uint64
+	coalesced  uint64
+	queueDepth int64
+}
+
+// Received returns the number of messages read
+func (s *DeliveryStats) Received() uint64 {
+	return atomic.LoadUint64(&s.received)
+}
+
+// Delivered returns the number of messages passed to the handler
+func (s *DeliveryStats) Delivered() uint64 {
+	return atomic.LoadUint64(&s.delivered)
+}
+
+// Dropped returns the number of messages dropped because a queue was full
+func (s *DeliveryStats) Dropped() uint64 {
+	return atomic.LoadUint64(&s.dropped)
+}
+
+// Coalesced returns the number of queued messages replaced by a newer message of their key
+func (s *DeliveryStats) Coalesced() uint64 {
+	return atomic.LoadUint64(&s.coalesced)
+}
+
+// QueueDepth returns the number of messages waiting in the queues
+func (s *DeliveryStats) QueueDepth() int64 {
+	return atomic.LoadInt64(&s.queueDepth)
+}
+
+var symbolField = []byte(`"s":"`)
+
+// MessageSymbol returns the value of the first "s" field of a stream message, which is the
+// symbol of market streams, also in combined streams. It returns "" when there is none.
+func MessageSymbol(message []byte) string {
+	i := bytes.Index(message, symbolField)
+	if i < 0 {
+		return ""
+	}
+	rest := message[i+len(symbolField):]
+	j := bytes.IndexByte(rest, '"')
+	if j < 0 {
+		return ""
+	}
+	return string(rest[:j])
+}
+
+// Dispatcher delivers messages to a handler according to a DeliveryConfig
+type Dispatcher struct {
+	handler func(message []byte)
+	cfg     DeliveryConfig
+	stats   *DeliveryStats
+	queues  []*deliveryQueue
+	wg      sync.WaitGroup
+}
+
+// NewDispatcher init Dispatcher, starting the workers of buffered modes
+func NewDispatcher(cfg DeliveryConfig, handler func(message []byte)) *Dispatcher {
+	d := &Dispatcher{handler: handler, cfg: cfg, stats: cfg.Stats}
+	if d.stats == nil {
+		d.stats = new(DeliveryStats)
+	}
+	if d.cfg.Key == nil {
+		d.cfg.Key = MessageSymbol
+	}
+	if d.cfg.Policy == "" {
+		d.cfg.Policy = OverflowPolicyDropOldest
+	}
+	if d.cfg.QueueSize <= 0 {
+		d.cfg.QueueSize = DefaultDeliveryQueueSize
+	}
+	workers := 0
+	switch cfg.Mode {
+	case DeliveryModeBuffered:
+		workers = 1
+	case DeliveryModeWorkerPool:
+		workers = cfg.Workers
+		if workers <= 0 {
+			workers = 1
+		}
+	}
+	for i := 0; i < workers; i++ {
+		q := newDeliveryQueue(d.cfg.QueueSize)
+		d.queues = append(d.queues, q)
+		d.wg.Add(1)
+		go d.work(q)
+	}
+	return d
+}
+
+// Stats returns the counters of the dispatcher
+func (d *Dispatcher) Stats() *DeliveryStats {
+	return d.stats
+}
+
+// Dispatch delivers message, it blocks only in DeliveryModeSync
+func (d *Dispatcher) Dispatch(message []byte) {
+	atomic.AddUint64(&d.stats.received, 1)
+	if len(d.queues) == 0 {
+		d.handler(message)
+		atomic.AddUint64(&d.stats.delivered, 1)
+		return
+	}
+	key := ""
+	if d.cfg.Policy == OverflowPolicyCoalesce || len(d.queues) > 1 {
+		key = d.cfg.Key(message)
+	}
+	q := d.queues[0]
+	if len(d.queues) > 1 {
+		h := fnv.New32a()
+		h.Write([]byte(key))
+		q = d.queues[h.Sum32()%uint32(len(d.queues))]
+	}
+	q.push(message, key, d.cfg.Policy, d.stats)
+}
+
+// Close delivers the queued messages and stops the workers, Dispatch must not be called
+// afterwards
+func (d *Dispatcher) Close() {
+	for _, q := range d.queues {
+		q.close()
+	}
+	d.wg.Wait()
+}
+
+func (d *Dispatcher) work(q *deliveryQueue) {
+	defer d.wg.Done()
+	for {
+		message, ok := q.pop(d.stats)
+		if !ok {
+			return
+		}
+		d.handler(message)
+		atomic.AddUint64(&d.stats.delivered, 1)
+	}
+}
+
+type queuedMessage struct {
+	key     string
+	message []byte
+}
+
+// deliveryQueue is a bounded FIFO queue of messages in a ring buffer
+type deliveryQueue struct {
+	mu     sync.Mutex
+	cond   *sync.Cond
+	items  []queuedMessage
+	head   int
+	len    int
+	keys   map[string]int // ring index of the queued message of a key
+	closed bool
+}
+
+func newDeliveryQueue(size int) *deliveryQueue {
+	q := &deliveryQueue{items: make([]queuedMessage, size), keys: map[string]int{}}
+	q.cond = sync.NewCond(&q.mu)
+	return q
+}
+
+func (q *deliveryQueue) push(message []byte, key string, policy OverflowPolicy, stats *DeliveryStats) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	if q.closed {
+		atomic.AddUint64(&stats.dropped, 1)
+		return
+	}
+	coalesce := policy == OverflowPolicyCoalesce && key != ""
+	if coalesce {
+		if i, ok := q.keys[key]; ok {
+			q.items[i].message = message
+			atomic.AddUint64(&stats.coalesced, 1)
+			return
+		}
+	}
+	if q.len == len(q.items) {
+		atomic.AddUint64(&stats.dropped, 1)
+		if policy == OverflowPolicyDropNewest {
+			return
+		}
+		q.remove()
+		atomic.AddInt64(&stats.queueDepth, -1)
+	}
+	i := (q.head + q.len) % len(q.items)
+	q.items[i] = queuedMessage{key: key, message: message}
+	if coalesce {
+		q.keys[key] = i
+	}
+	q.len++
+	atomic.AddInt64(&stats.queueDepth, 1)
+	q.cond.Signal()
+}
+
+// remove removes the oldest message
+func (q *deliveryQueue) remove() []byte {
+	item := q.items[q.head]
+	if i, ok := q.keys[item.key]; ok && i == q.head {
+		delete(q.keys, item.key)
+	}
+	q.items[q.head] = queuedMessage{}
+	q.head = (q.head + 1) % len(q.items)
+	q.len--
+	return item.message
+}
+
+// pop returns the oldest message, waiting for one, ok is false once the queue is closed and
+// empty
+func (q *deliveryQueue) pop(stats *DeliveryStats) (message []byte, ok bool) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	for q.len == 0 && !q.closed {
+		q.cond.Wait()
+	}
+	if q.len == 0 {
+		return nil, false
+	}
+	message = q.remove()
+	atomic.AddInt64(&stats.queueDepth, -1)
+	return message, true
+}
+
+func (q *deliveryQueue) close() {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	q.closed = true
+	q.cond.Broadcast()
+}
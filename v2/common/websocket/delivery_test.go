@@ -0,0 +1,140 @@
+package websocket
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type dispatcherTestSuite struct {
+	suite.Suite
+
+	mu        sync.Mutex
+	delivered []string
+	started   chan struct{}
+	gate      chan struct{}
+}
+
+func TestDispatcher(t *testing.T) {
+	suite.Run(t, new(dispatcherTestSuite))
+}
+
+func (s *dispatcherTestSuite) SetupTest() {
+	s.delivered = nil
+	s.started = make(chan struct{}, 100)
+	s.gate = nil
+}
+
+func (s *dispatcherTestSuite) handler(message []byte) {
+	s.started <- struct{}{}
+	if s.gate != nil {
+		<-s.gate
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.delivered = append(s.delivered, string(message))
+}
+
+func (s *dispatcherTestSuite) message(symbol string, id int) []byte {
+	return []byte(fmt.Sprintf(`{"e":"depthUpdate","E":1,"s":"%s","u":%d}`, symbol, id))
+}
+
+// block starts a dispatcher whose worker is blocked on the message of the symbol X
+func (s *dispatcherTestSuite) block(cfg DeliveryConfig) *Dispatcher {
+	s.gate = make(chan struct{})
+	d := NewDispatcher(cfg, s.handler)
+	d.Dispatch(s.message("X", 0))
+	<-s.started
+	return d
+}
+
+func (s *dispatcherTestSuite) TestSync() {
+	d := NewDispatcher(DeliveryConfig{}, s.handler)
+	d.Dispatch([]byte("a"))
+	s.Equal([]string{"a"}, s.delivered)
+	d.Close()
+	s.Equal(uint64(1), d.Stats().Received())
+	s.Equal(uint64(1), d.Stats().Delivered())
+}
+
+func (s *dispatcherTestSuite) TestDropOldest() {
+	d := s.block(DeliveryConfig{Mode: DeliveryModeBuffered, QueueSize: 2})
+	for i := 1; i <= 4; i++ {
+		d.Dispatch(s.message("A", i))
+	}
+	s.Equal(int64(2), d.Stats().QueueDepth())
+	s.Equal(uint64(2), d.Stats().Dropped())
+	close(s.gate)
+	d.Close()
+	s.Equal([]string{string(s.message("X", 0)), string(s.message("A", 3)), string(s.message("A", 4))}, s.delivered)
+	s.Equal(int64(0), d.Stats().QueueDepth())
+	s.Equal(uint64(5), d.Stats().Received())
+	s.Equal(uint64(3), d.Stats().Delivered())
+}
+
+func (s *dispatcherTestSuite) TestDropNewest() {
+	d := s.block(DeliveryConfig{Mode: DeliveryModeBuffered, QueueSize: 2, Policy: OverflowPolicyDropNewest})
+	for i := 1; i <= 4; i++ {
+		d.Dispatch(s.message("A", i))
+	}
+	close(s.gate)
+	d.Close()
+	s.Equal([]string{string(s.message("X", 0)), string(s.message("A", 1)), string(s.message("A", 2))}, s.delivered)
+	s.Equal(uint64(2), d.Stats().Dropped())
+}
+
+func (s *dispatcherTestSuite) TestCoalesce() {
+	stats := new(DeliveryStats)
+	d := s.block(DeliveryConfig{Mode: DeliveryModeBuffered, QueueSize: 2, Policy: OverflowPolicyCoalesce, Stats: stats})
+	d.Dispatch(s.message("A", 1))
+	d.Dispatch(s.message("B", 1))
+	d.Dispatch(s.message("A", 2))
+	d.Dispatch(s.message("B", 2))
+	d.Dispatch(s.message("A", 3))
+	s.Equal(int64(2), stats.QueueDepth())
+	s.Equal(uint64(3), stats.Coalesced())
+	// the queue is full, the oldest symbol is dropped
+	d.Dispatch(s.message("C", 1))
+	s.Equal(uint64(1), stats.Dropped())
+	close(s.gate)
+	d.Close()
+	s.Equal([]string{string(s.message("X", 0)), string(s.message("B", 2)), string(s.message("C", 1))}, s.delivered)
+	s.Equal(stats.Received(), stats.Delivered()+stats.Dropped()+stats.Coalesced())
+}
+
+func (s *dispatcherTestSuite) TestWorkerPool() {
+	d := NewDispatcher(DeliveryConfig{Mode: DeliveryModeWorkerPool, Workers: 4}, s.handler)
+	expected := map[string][]string{}
+	for i := 0; i < 100; i++ {
+		symbol := fmt.Sprintf("S%d", i%5)
+		d.Dispatch(s.message(symbol, i))
+		expected[symbol] = append(expected[symbol], string(s.message(symbol, i)))
+	}
+	d.Close()
+	s.Len(s.delivered, 100)
+	// the messages of a symbol are delivered in order
+	delivered := map[string][]string{}
+	for _, m := range s.delivered {
+		symbol := MessageSymbol([]byte(m))
+		delivered[symbol] = append(delivered[symbol], m)
+	}
+	s.Equal(expected, delivered)
+	s.Equal(uint64(100), d.Stats().Delivered())
+}
+
+func (s *dispatcherTestSuite) TestDispatchAfterClose() {
+	d := NewDispatcher(DeliveryConfig{Mode: DeliveryModeBuffered}, s.handler)
+	d.Close()
+	d.Dispatch([]byte("a"))
+	s.Empty(s.delivered)
+	s.Equal(uint64(1), d.Stats().Dropped())
+}
+
+func (s *dispatcherTestSuite) TestMessageSymbol() {
+	s.Equal("BTCUSDT", MessageSymbol([]byte(`{"e":"bookTicker","s":"BTCUSDT","b":"1"}`)))
+	s.Equal("ETHUSDT", MessageSymbol([]byte(`{"stream":"ethusdt@depth","data":{"e":"depthUpdate","s":"ETHUSDT"}}`)))
+	s.Equal("", MessageSymbol([]byte(`{"e":"ACCOUNT_UPDATE"}`)))
+	s.Equal("", MessageSymbol([]byte(`{"s":"BTC`)))
+}
@@ -0,0 +1,28 @@
+//go:build go1.21
+
+package websocket
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/adshao/go-binance/v2/common"
+)
+
+// Logger define the structured logger of the Websocket API client
+type Logger = slog.Logger
+
+// newLogRecord returns the function writing the records of a client to logger, sensitive
+// values are redacted, nil when logger is not set
+func newLogRecord(logger *Logger) func(level logLevel, msg string) {
+	if logger == nil {
+		return nil
+	}
+	logger = slog.New(common.NewRedactingHandler(logger.Handler()))
+	return func(level logLevel, msg string) {
+		ctx := context.Background()
+		if logger.Enabled(ctx, slog.Level(level)) {
+			logger.Log(ctx, slog.Level(level), msg)
+		}
+	}
+}
@@ -0,0 +1,11 @@
+//go:build !go1.21
+
+package websocket
+
+// Logger define the structured logger of the Websocket API client, log/slog requires go1.21
+type Logger struct{}
+
+// newLogRecord returns nil, log/slog requires go1.21
+func newLogRecord(logger *Logger) func(level logLevel, msg string) {
+	return nil
+}
@@ -0,0 +1,43 @@
+//go:build go1.21
+
+package websocket
+
+import (
+	"bytes"
+	"log/slog"
+	"sync"
+)
+
+func (s *clientTestSuite) TestLogger() {
+	buf := &syncBuffer{}
+	logger := slog.New(slog.NewTextHandler(buf, &slog.HandlerOptions{Level: slog.LevelWarn}))
+
+	conn := &failingConnection{closeC: make(chan struct{})}
+	client, err := NewClient(conn, Options{Logger: logger})
+	s.Require().NoError(err)
+
+	err = client.Write("1", []byte(`{"id":"1","method":"order.place","params":{"apiKey":"key","signature":"sig"}}`))
+	s.Require().Error(err)
+	out := buf.String()
+	s.Contains(out, "level=ERROR")
+	s.Contains(out, "write: unable to write message")
+	s.NotContains(out, "read: waiting for message")
+}
+
+// syncBuffer define a bytes.Buffer safe for concurrent use
+type syncBuffer struct {
+	mu  sync.Mutex
+	buf bytes.Buffer
+}
+
+func (b *syncBuffer) Write(p []byte) (int, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.buf.Write(p)
+}
+
+func (b *syncBuffer) String() string {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.buf.String()
+}
@@ -0,0 +1,283 @@
+package websocket
+
+import (
+	"context"
+	"crypto/tls"
+	"net"
+	"net/http"
+	"net/url"
+	"sync/atomic"
+	"time"
+
+	"github.com/gorilla/websocket"
+)
+
+// KeepaliveStrategy define how the connection of a stream is kept alive
+type KeepaliveStrategy string
+
+// Global enums
+const (
+	// KeepalivePong answers the pings of the server and closes the connection when the server
+	// sent none for the keepalive interval
+	KeepalivePong KeepaliveStrategy = "PONG"
+	// KeepalivePing sends a ping every keepalive interval and closes the connection when no
+	// pong was received for the keepalive interval plus the keepalive timeout
+	KeepalivePing KeepaliveStrategy = "PING"
+	// KeepaliveNone leaves the detection of dead connections to the server
+	KeepaliveNone KeepaliveStrategy = "NONE"
+
+	// DefaultHandshakeTimeout is the handshake timeout of the connections when none is set
+	DefaultHandshakeTimeout = 45 * time.Second
+)
+
+// Options define the connection options of a stream or of a Websocket API client. The zero
+// value of a field keeps the default of the package, so that configurations such as
+// mainnet and testnet can run side by side without changing package variables.
+type Options struct {
+	// BaseURL replaces the base endpoint of the streams, e.g. wss://stream.binance.com:9443/ws
+	BaseURL string
+	// CombinedBaseURL replaces the base endpoint of the combined streams, e.g.
+	// wss://stream.binance.com:9443/stream?streams=
+	CombinedBaseURL string
+	// APIURL replaces the endpoint of the Websocket API, e.g. wss://ws-api.binance.com:443/ws-api/v3
+	APIURL string
+	// ProxyURL replaces the proxy of the package
+	ProxyURL string
+	// NetDialer dials the TCP connections, e.g. to bind a local address
+	NetDialer *net.Dialer
+	// TLSConfig is the TLS configuration of the connections
+	TLSConfig *tls.Config
+	// Header is added to the headers of the handshake
+	Header http.Header
+	// HandshakeTimeout is DefaultHandshakeTimeout when 0
+	HandshakeTimeout time.Duration
+	// ReadLimit is the maximum size in bytes of a message read
+	ReadLimit int64
+	// Compression, when set, enables or disables the per message compression
+	Compression *bool
+	// Keepalive is the keepalive strategy
+	Keepalive KeepaliveStrategy
+	// KeepaliveInterval is the ping interval of KeepalivePing, the longest time without ping
+	// of KeepalivePong
+	KeepaliveInterval time.Duration
+	// KeepaliveTimeout is the write deadline of pings and pongs, and the grace period for a
+	// pong of KeepalivePing
+	KeepaliveTimeout time.Duration
+	// Delivery is the delivery of the messages of a stream, synchronous in the read loop when
+	// nil. The Websocket API client ignores it and never drops a response.
+	Delivery *DeliveryConfig
+	// Context, when set, stops the streams once done, without passing the error of their
+	// closed connection to the error handler
+	Context context.Context
+	// ReuseEvents makes the streams of depth diffs, book tickers, aggregate trades and mark
+	// prices recycle their events once the handler returns, instead of allocating one per
+	// message. Handlers must then copy what they keep of an event, including its slices.
+	ReuseEvents bool
+	// WrapClient, when set, wraps the Websocket API client returned by NewClient, e.g. to
+	// instrument its requests
+	WrapClient func(Client) Client
+	// Logger, when set, receives the leveled records of the Websocket API client, sensitive
+	// values are redacted. It is a *slog.Logger, available with go1.21 or later.
+	Logger *Logger
+}
+
+// MergeOptions returns the options with the fields set in opts, a field set in several of
+// them takes the value of the last one. Headers are merged.
+func MergeOptions(opts ...Options) Options {
+	var o Options
+	for _, opt := range opts {
+		if opt.BaseURL != "" {
+			o.BaseURL = opt.BaseURL
+		}
+		if opt.CombinedBaseURL != "" {
+			o.CombinedBaseURL = opt.CombinedBaseURL
+		}
+		if opt.APIURL != "" {
+			o.APIURL = opt.APIURL
+		}
+		if opt.ProxyURL != "" {
+			o.ProxyURL = opt.ProxyURL
+		}
+		if opt.NetDialer != nil {
+			o.NetDialer = opt.NetDialer
+		}
+		if opt.TLSConfig != nil {
+			o.TLSConfig = opt.TLSConfig
+		}
+		for k, v := range opt.Header {
+			if o.Header == nil {
+				o.Header = make(http.Header)
+			}
+			o.Header[k] = append(o.Header[k], v...)
+		}
+		if opt.HandshakeTimeout > 0 {
+			o.HandshakeTimeout = opt.HandshakeTimeout
+		}
+		if opt.ReadLimit > 0 {
+			o.ReadLimit = opt.ReadLimit
+		}
+		if opt.Compression != nil {
+			o.Compression = opt.Compression
+		}
+		if opt.Keepalive != "" {
+			o.Keepalive = opt.Keepalive
+		}
+		if opt.KeepaliveInterval > 0 {
+			o.KeepaliveInterval = opt.KeepaliveInterval
+		}
+		if opt.KeepaliveTimeout > 0 {
+			o.KeepaliveTimeout = opt.KeepaliveTimeout
+		}
+		if opt.Delivery != nil {
+			o.Delivery = opt.Delivery
+		}
+		if opt.Context != nil {
+			o.Context = opt.Context
+		}
+		if opt.ReuseEvents {
+			o.ReuseEvents = true
+		}
+		if opt.WrapClient != nil {
+			o.WrapClient = opt.WrapClient
+		}
+		if opt.Logger != nil {
+			o.Logger = opt.Logger
+		}
+	}
+	return o
+}
+
+// StreamContext returns Context, context.Background() when the options leave it unset
+func (o Options) StreamContext() context.Context {
+	if o.Context != nil {
+		return o.Context
+	}
+	return context.Background()
+}
+
+// Dialer returns the dialer of the options, compression is the per message compression when
+// the options leave it unset
+func (o Options) Dialer(proxy func(*http.Request) (*url.URL, error), compression bool) *websocket.Dialer {
+	d := &websocket.Dialer{
+		Proxy:             proxy,
+		HandshakeTimeout:  DefaultHandshakeTimeout,
+		EnableCompression: compression,
+		TLSClientConfig:   o.TLSConfig,
+	}
+	if o.HandshakeTimeout > 0 {
+		d.HandshakeTimeout = o.HandshakeTimeout
+	}
+	if o.Compression != nil {
+		d.EnableCompression = *o.Compression
+	}
+	if o.NetDialer != nil {
+		d.NetDialContext = o.NetDialer.DialContext
+	}
+	return d
+}
+
+// SetReadLimit set the read limit of the options on c, limit when the options leave it unset.
+// A limit of 0 leaves c unlimited.
+func (o Options) SetReadLimit(c *websocket.Conn, limit int64) {
+	if o.ReadLimit > 0 {
+		limit = o.ReadLimit
+	}
+	if limit > 0 {
+		c.SetReadLimit(limit)
+	}
+}
+
+// KeepAlive keeps c alive with the strategy of the options until ctx is done. The strategy,
+// interval and timeout are the given package defaults when the options leave them unset.
+func (o Options) KeepAlive(ctx context.Context, c *websocket.Conn, strategy KeepaliveStrategy, interval, timeout time.Duration) {
+	if o.Keepalive != "" {
+		strategy = o.Keepalive
+	}
+	if o.KeepaliveInterval > 0 {
+		interval = o.KeepaliveInterval
+	}
+	if o.KeepaliveTimeout > 0 {
+		timeout = o.KeepaliveTimeout
+	}
+	switch strategy {
+	case KeepalivePing:
+		KeepAliveWithPing(ctx, c, interval, timeout)
+	case KeepalivePong:
+		KeepAliveWithPong(ctx, c, interval, timeout)
+	}
+}
+
+// APIKeepAlive returns whether and with which timeout a Websocket API connection is kept
+// alive, enabled and timeout are the package defaults
+func (o Options) APIKeepAlive(enabled bool, timeout time.Duration) (bool, time.Duration) {
+	switch o.Keepalive {
+	case KeepaliveNone:
+		enabled = false
+	case KeepalivePing, KeepalivePong:
+		enabled = true
+	}
+	if o.KeepaliveInterval > 0 {
+		timeout = o.KeepaliveInterval
+	}
+	return enabled, timeout
+}
+
+// KeepAliveWithPing sends a ping every interval and closes c when no pong was received for
+// interval plus timeout, until ctx is done
+func KeepAliveWithPing(ctx context.Context, c *websocket.Conn, interval, timeout time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	var lastResponse int64
+	atomic.StoreInt64(&lastResponse, time.Now().UnixNano())
+	c.SetPongHandler(func(appData string) error {
+		atomic.StoreInt64(&lastResponse, time.Now().UnixNano())
+		return nil
+	})
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if time.Since(time.Unix(0, atomic.LoadInt64(&lastResponse))) > interval+timeout {
+				c.Close()
+				return
+			}
+			if err := c.WriteControl(websocket.PingMessage, []byte{}, time.Now().Add(timeout)); err != nil {
+				return
+			}
+		}
+	}
+}
+
+// KeepAliveWithPong answers the pings of the server with pongs written within timeout and
+// closes c when the server sent no ping for interval, until ctx is done
+func KeepAliveWithPong(ctx context.Context, c *websocket.Conn, interval, timeout time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	var lastResponse int64
+	atomic.StoreInt64(&lastResponse, time.Now().UnixNano())
+	c.SetPingHandler(func(pingData string) error {
+		// Respond with Pong using the server's PING payload
+		err := c.WriteControl(websocket.PongMessage, []byte(pingData), time.Now().Add(timeout))
+		if err != nil {
+			return err
+		}
+		atomic.StoreInt64(&lastResponse, time.Now().UnixNano())
+		return nil
+	})
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if time.Since(time.Unix(0, atomic.LoadInt64(&lastResponse))) > interval {
+				c.Close()
+				return
+			}
+		}
+	}
+}
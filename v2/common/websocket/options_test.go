@@ -0,0 +1,121 @@
+package websocket
+
+import (
+	"context"
+	"crypto/tls"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/suite"
+)
+
+type optionsTestSuite struct {
+	suite.Suite
+}
+
+func TestOptions(t *testing.T) {
+	suite.Run(t, new(optionsTestSuite))
+}
+
+func (s *optionsTestSuite) TestMergeOptions() {
+	disabled := false
+	o := MergeOptions(
+		Options{BaseURL: "wss://a/ws", ReadLimit: 10, Header: http.Header{"X-A": []string{"1"}}},
+		Options{BaseURL: "wss://b/ws", Compression: &disabled, Header: http.Header{"X-A": []string{"2"}, "X-B": []string{"3"}}},
+		Options{Keepalive: KeepalivePing, ReuseEvents: true},
+		Options{},
+	)
+	s.Equal("wss://b/ws", o.BaseURL)
+	s.Equal(int64(10), o.ReadLimit)
+	s.Equal(&disabled, o.Compression)
+	s.Equal(KeepalivePing, o.Keepalive)
+	s.True(o.ReuseEvents)
+	s.Equal(http.Header{"X-A": []string{"1", "2"}, "X-B": []string{"3"}}, o.Header)
+	s.Equal(Options{}, MergeOptions())
+}
+
+func (s *optionsTestSuite) TestDialer() {
+	d := Options{}.Dialer(nil, true)
+	s.Equal(DefaultHandshakeTimeout, d.HandshakeTimeout)
+	s.True(d.EnableCompression)
+	s.Nil(d.TLSClientConfig)
+	s.Nil(d.NetDialContext)
+
+	disabled := false
+	tlsConfig := &tls.Config{ServerName: "testnet"}
+	d = Options{
+		TLSConfig:        tlsConfig,
+		NetDialer:        &net.Dialer{Timeout: time.Second},
+		HandshakeTimeout: time.Second,
+		Compression:      &disabled,
+	}.Dialer(nil, true)
+	s.Equal(time.Second, d.HandshakeTimeout)
+	s.False(d.EnableCompression)
+	s.Equal(tlsConfig, d.TLSClientConfig)
+	s.NotNil(d.NetDialContext)
+}
+
+func (s *optionsTestSuite) TestAPIKeepAlive() {
+	enabled, timeout := Options{}.APIKeepAlive(true, time.Minute)
+	s.True(enabled)
+	s.Equal(time.Minute, timeout)
+
+	enabled, _ = Options{Keepalive: KeepaliveNone}.APIKeepAlive(true, time.Minute)
+	s.False(enabled)
+
+	enabled, timeout = Options{Keepalive: KeepalivePing, KeepaliveInterval: time.Second}.APIKeepAlive(false, time.Minute)
+	s.True(enabled)
+	s.Equal(time.Second, timeout)
+}
+
+func (s *optionsTestSuite) TestKeepAliveWithPing() {
+	// the server never reads, so it never answers the pings
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		upgrader := websocket.Upgrader{}
+		c, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+		defer c.Close()
+		<-r.Context().Done()
+	}))
+	defer server.Close()
+
+	c, _, err := websocket.DefaultDialer.Dial("ws"+strings.TrimPrefix(server.URL, "http"), nil)
+	s.Require().NoError(err)
+	defer c.Close()
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		Options{Keepalive: KeepalivePing}.KeepAlive(context.Background(), c, KeepaliveNone, 10*time.Millisecond, 10*time.Millisecond)
+	}()
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		s.FailNow("connection without pong not closed")
+	}
+	_, _, err = c.ReadMessage()
+	s.Error(err)
+}
+
+func (s *optionsTestSuite) TestKeepAliveStopsWithContext() {
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		// no connection is touched before the first tick
+		KeepAliveWithPong(ctx, new(websocket.Conn), time.Hour, time.Second)
+	}()
+	cancel()
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		s.FailNow("keepalive not stopped")
+	}
+}
@@ -0,0 +1,138 @@
+package websocket
+
+import (
+	"context"
+	"sync"
+
+	"github.com/gorilla/websocket"
+)
+
+// ServeConn reads the messages of c until ctx is done or reading fails, and delivers them to
+// handler according to delivery. Read errors are passed to errHandler unless ctx is done.
+// keepalive, when not nil, runs until the connection stops. The returned channel is closed
+// once c is closed and the queued messages are delivered.
+func ServeConn(ctx context.Context, c *websocket.Conn, delivery DeliveryConfig, handler func(message []byte), errHandler func(err error), keepalive func(ctx context.Context, c *websocket.Conn)) (doneC chan struct{}) {
+	doneC = make(chan struct{})
+	go func() {
+		defer close(doneC)
+
+		// The queued messages are delivered before doneC is closed
+		dispatcher := NewDispatcher(delivery, handler)
+		defer dispatcher.Close()
+
+		connCtx, cancel := context.WithCancel(ctx)
+		defer cancel()
+		if keepalive != nil {
+			go keepalive(connCtx, c)
+		}
+		// ReadMessage blocks, closing the connection unblocks it once ctx is done
+		go func() {
+			<-connCtx.Done()
+			c.Close()
+		}()
+		for {
+			_, message, err := c.ReadMessage()
+			if err != nil {
+				if ctx.Err() == nil {
+					errHandler(err)
+				}
+				return
+			}
+			dispatcher.Dispatch(message)
+		}
+	}()
+	return doneC
+}
+
+// ServeFunc starts a websocket stream stopped once ctx is done, reporting its errors to
+// errHandler. The returned channel is closed once the stream is stopped.
+type ServeFunc func(ctx context.Context, errHandler func(err error)) (doneC <-chan struct{}, err error)
+
+// Stream is the handle of a running websocket stream, Close can be called any number of
+// times from any goroutine
+type Stream struct {
+	doneC     chan struct{}
+	closeC    chan struct{}
+	closeOnce sync.Once
+
+	mu      sync.Mutex
+	err     error
+	stopped bool
+}
+
+// ServeStream starts the stream of serve, stopped when ctx is done or Close is called.
+// errHandler may be nil.
+func ServeStream(ctx context.Context, serve ServeFunc, errHandler func(err error)) (*Stream, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	s := &Stream{
+		doneC:  make(chan struct{}),
+		closeC: make(chan struct{}),
+	}
+	streamCtx, cancel := context.WithCancel(ctx)
+	doneC, err := serve(streamCtx, func(err error) {
+		s.mu.Lock()
+		if !s.stopped {
+			s.err = err
+		}
+		s.mu.Unlock()
+		if errHandler != nil {
+			errHandler(err)
+		}
+	})
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+	go s.run(ctx, cancel, doneC)
+	return s, nil
+}
+
+// run stops the stream when ctx is done or Close is called
+func (s *Stream) run(ctx context.Context, cancel context.CancelFunc, doneC <-chan struct{}) {
+	defer close(s.doneC)
+	defer cancel()
+	select {
+	case <-doneC:
+		return
+	case <-ctx.Done():
+		s.stop(ctx.Err())
+	case <-s.closeC:
+		s.stop(nil)
+	}
+	cancel()
+	<-doneC
+}
+
+func (s *Stream) stop(err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.err = err
+	s.stopped = true
+}
+
+// Done returns a channel closed once the stream is stopped
+func (s *Stream) Done() <-chan struct{} {
+	return s.doneC
+}
+
+// Err returns why the stream stopped: nil while it runs or when stopped by Close, the
+// context error when its context is done, else the last error of the connection
+func (s *Stream) Err() error {
+	select {
+	case <-s.doneC:
+	default:
+		return nil
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.err
+}
+
+// Close stops the stream without waiting, use Done to wait until it is stopped
+func (s *Stream) Close() {
+	s.closeOnce.Do(func() {
+		close(s.closeC)
+	})
+}
@@ -0,0 +1,114 @@
+package websocket
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type streamTestSuite struct {
+	suite.Suite
+	doneC      chan struct{}
+	errHandler func(err error)
+	serveErr   error
+	serves     int
+}
+
+func TestStream(t *testing.T) {
+	suite.Run(t, new(streamTestSuite))
+}
+
+func (s *streamTestSuite) SetupTest() {
+	s.doneC = make(chan struct{})
+	s.errHandler = nil
+	s.serveErr = nil
+	s.serves = 0
+}
+
+// serve mimics a stream, the connection stops when ctx is done
+func (s *streamTestSuite) serve(ctx context.Context, errHandler func(err error)) (<-chan struct{}, error) {
+	s.serves++
+	if s.serveErr != nil {
+		return nil, s.serveErr
+	}
+	s.errHandler = errHandler
+	go func() {
+		<-ctx.Done()
+		close(s.doneC)
+	}()
+	return s.doneC, nil
+}
+
+func (s *streamTestSuite) waitDone(stream *Stream) {
+	select {
+	case <-stream.Done():
+	case <-time.After(time.Second):
+		s.FailNow("stream not stopped")
+	}
+}
+
+func (s *streamTestSuite) TestClose() {
+	stream, err := ServeStream(context.Background(), s.serve, nil)
+	s.Require().NoError(err)
+	s.Nil(stream.Err())
+
+	stream.Close()
+	stream.Close()
+	s.waitDone(stream)
+	s.NoError(stream.Err())
+	select {
+	case <-s.doneC:
+	default:
+		s.Fail("connection not stopped")
+	}
+	stream.Close()
+}
+
+func (s *streamTestSuite) TestContextDone() {
+	ctx, cancel := context.WithCancel(context.Background())
+	stream, err := ServeStream(ctx, s.serve, nil)
+	s.Require().NoError(err)
+
+	cancel()
+	s.waitDone(stream)
+	s.ErrorIs(stream.Err(), context.Canceled)
+	stream.Close()
+}
+
+func (s *streamTestSuite) TestConnectionError() {
+	var errs []error
+	stream, err := ServeStream(context.Background(), func(ctx context.Context, errHandler func(err error)) (<-chan struct{}, error) {
+		s.errHandler = errHandler
+		return s.doneC, nil
+	}, func(err error) {
+		errs = append(errs, err)
+	})
+	s.Require().NoError(err)
+
+	readErr := errors.New("unexpected EOF")
+	s.errHandler(readErr)
+	close(s.doneC)
+	s.waitDone(stream)
+	s.Equal(readErr, stream.Err())
+	s.Equal([]error{readErr}, errs)
+	stream.Close()
+}
+
+func (s *streamTestSuite) TestServeError() {
+	s.serveErr = errors.New("dial error")
+	stream, err := ServeStream(context.Background(), s.serve, nil)
+	s.Nil(stream)
+	s.Equal(s.serveErr, err)
+}
+
+func (s *streamTestSuite) TestContextAlreadyDone() {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	stream, err := ServeStream(ctx, s.serve, nil)
+	s.Nil(stream)
+	s.ErrorIs(err, context.Canceled)
+	s.Zero(s.serves)
+}
@@ -83,6 +83,72 @@ const (
 	// SorOrderTestSpotWsApiMethod define method for SOR order testing via websocket API
 	SorOrderTestSpotWsApiMethod WsApiMethodType = "sor.order.test"
 
+	// OrderTestSpotWsApiMethod define method for order testing via websocket API
+	OrderTestSpotWsApiMethod WsApiMethodType = "order.test"
+
+	// OrderCancelReplaceSpotWsApiMethod define method for cancel and replace order via websocket API
+	OrderCancelReplaceSpotWsApiMethod WsApiMethodType = "order.cancelReplace"
+
+	// OpenOrdersCancelAllSpotWsApiMethod define method for cancel all open orders via websocket API
+	OpenOrdersCancelAllSpotWsApiMethod WsApiMethodType = "openOrders.cancelAll"
+
+	// DepthSpotWsApiMethod define method for order book via websocket API
+	DepthSpotWsApiMethod WsApiMethodType = "depth"
+
+	// TradesRecentSpotWsApiMethod define method for recent trades via websocket API
+	TradesRecentSpotWsApiMethod WsApiMethodType = "trades.recent"
+
+	// TradesHistoricalSpotWsApiMethod define method for historical trades via websocket API
+	TradesHistoricalSpotWsApiMethod WsApiMethodType = "trades.historical"
+
+	// TradesAggregateSpotWsApiMethod define method for aggregate trades via websocket API
+	TradesAggregateSpotWsApiMethod WsApiMethodType = "trades.aggregate"
+
+	// KlinesSpotWsApiMethod define method for klines via websocket API
+	KlinesSpotWsApiMethod WsApiMethodType = "klines"
+
+	// UiKlinesSpotWsApiMethod define method for UI klines via websocket API
+	UiKlinesSpotWsApiMethod WsApiMethodType = "uiKlines"
+
+	// AvgPriceSpotWsApiMethod define method for current average price via websocket API
+	AvgPriceSpotWsApiMethod WsApiMethodType = "avgPrice"
+
+	// Ticker24hrSpotWsApiMethod define method for 24hr ticker via websocket API
+	Ticker24hrSpotWsApiMethod WsApiMethodType = "ticker.24hr"
+
+	// TickerTradingDaySpotWsApiMethod define method for trading day ticker via websocket API
+	TickerTradingDaySpotWsApiMethod WsApiMethodType = "ticker.tradingDay"
+
+	// TickerSpotWsApiMethod define method for rolling window ticker via websocket API
+	TickerSpotWsApiMethod WsApiMethodType = "ticker"
+
+	// TickerPriceSpotWsApiMethod define method for price ticker via websocket API
+	TickerPriceSpotWsApiMethod WsApiMethodType = "ticker.price"
+
+	// TickerBookSpotWsApiMethod define method for book ticker via websocket API
+	TickerBookSpotWsApiMethod WsApiMethodType = "ticker.book"
+
+	// MyTradesSpotWsApiMethod define method for account trades via websocket API
+	MyTradesSpotWsApiMethod WsApiMethodType = "myTrades"
+
+	// MyPreventedMatchesSpotWsApiMethod define method for prevented matches via websocket API
+	MyPreventedMatchesSpotWsApiMethod WsApiMethodType = "myPreventedMatches"
+
+	// AccountCommissionWsApiMethod define method for account commission rates via websocket API
+	AccountCommissionWsApiMethod WsApiMethodType = "account.commission"
+
+	// AccountRateLimitsOrdersWsApiMethod define method for unfilled order counts via websocket API
+	AccountRateLimitsOrdersWsApiMethod WsApiMethodType = "account.rateLimits.orders"
+
+	// UserDataStreamStartSpotWsApiMethod define method for starting user data stream via websocket API
+	UserDataStreamStartSpotWsApiMethod WsApiMethodType = "userDataStream.start"
+
+	// UserDataStreamPingSpotWsApiMethod define method for keeping alive user data stream via websocket API
+	UserDataStreamPingSpotWsApiMethod WsApiMethodType = "userDataStream.ping"
+
+	// UserDataStreamStopSpotWsApiMethod define method for closing user data stream via websocket API
+	UserDataStreamStopSpotWsApiMethod WsApiMethodType = "userDataStream.stop"
+
 	// FUTURES
 
 	// OrderPlaceFuturesWsApiMethod define method for creation order via websocket API
@@ -94,6 +160,35 @@ const (
 	// OrderStatusFuturesWsApiMethod define method for query order via websocket API
 	OrderStatusFuturesWsApiMethod WsApiMethodType = "order.status"
 
+	// OrderModifyFuturesWsApiMethod define method for modify order via websocket API
+	OrderModifyFuturesWsApiMethod WsApiMethodType = "order.modify"
+
+	// AccountPositionFuturesWsApiMethod define method for position information via websocket API
+	AccountPositionFuturesWsApiMethod WsApiMethodType = "v2/account.position"
+
+	// DepthFuturesWsApiMethod define method for order book via websocket API
+	DepthFuturesWsApiMethod WsApiMethodType = "depth"
+
+	// TickerPriceFuturesWsApiMethod define method for latest price via websocket API
+	TickerPriceFuturesWsApiMethod WsApiMethodType = "ticker.price"
+
+	// TickerBookFuturesWsApiMethod define method for order book ticker via websocket API
+	TickerBookFuturesWsApiMethod WsApiMethodType = "ticker.book"
+
+	// UserDataStreamStartFuturesWsApiMethod define method for starting user data stream via websocket API
+	UserDataStreamStartFuturesWsApiMethod WsApiMethodType = "userDataStream.start"
+
+	// UserDataStreamPingFuturesWsApiMethod define method for keeping alive user data stream via websocket API
+	UserDataStreamPingFuturesWsApiMethod WsApiMethodType = "userDataStream.ping"
+
+	// UserDataStreamStopFuturesWsApiMethod define method for closing user data stream via websocket API
+	UserDataStreamStopFuturesWsApiMethod WsApiMethodType = "userDataStream.stop"
+
+	// DELIVERY
+
+	// OrderPlaceDeliveryWsApiMethod define method for creation order via websocket API
+	OrderPlaceDeliveryWsApiMethod WsApiMethodType = "order.place"
+
 	// ExchangeInfo Permission Types
 	PermissionTypeSPOT      WsExchangeInfoPermissionType = "SPOT"
 	PermissionTypeMARGIN    WsExchangeInfoPermissionType = "MARGIN"
@@ -162,6 +257,36 @@ type RequestData struct {
 	secretKey  string
 	timeOffset int64
 	keyType    string
+	signer     common.Signer
+	signers    *common.SignerCache
+}
+
+// WithSigner returns a copy of the request data signed by signer instead of the secret key,
+// nil signers are ignored
+func (d RequestData) WithSigner(signer common.Signer) RequestData {
+	if signer != nil {
+		d.signer = signer
+		d.keyType = signer.KeyType()
+	}
+	return d
+}
+
+// WithSignerCache returns a copy of the request data parsing the secret key with cache, so
+// that a client parses its key once. Without cache the key is parsed for every request.
+func (d RequestData) WithSignerCache(cache *common.SignerCache) RequestData {
+	d.signers = cache
+	return d
+}
+
+// getSigner returns the signer of the request data
+func (d RequestData) getSigner() (common.Signer, error) {
+	if d.signer != nil {
+		return d.signer, nil
+	}
+	if d.signers != nil {
+		return d.signers.Get(d.keyType, d.secretKey)
+	}
+	return common.NewSigner(d.keyType, d.secretKey)
 }
 
 // CreateRequest creates signed ws request
@@ -174,18 +299,18 @@ func CreateRequest(reqData RequestData, method WsApiMethodType, params map[strin
 		return nil, ErrorApiKeyIsNotSet
 	}
 
-	if reqData.secretKey == "" {
+	if reqData.secretKey == "" && reqData.signer == nil {
 		return nil, ErrorSecretKeyIsNotSet
 	}
 
 	params[apiKey] = reqData.apiKey
 	params[timestampKey] = timestamp(reqData.timeOffset)
 
-	sf, err := common.SignFunc(reqData.keyType)
+	signer, err := reqData.getSigner()
 	if err != nil {
 		return nil, err
 	}
-	signature, err := sf(reqData.secretKey, encodeParams(params))
+	signature, err := signer.Sign([]byte(encodeParams(params)))
 	if err != nil {
 		return nil, err
 	}
@@ -221,6 +346,21 @@ func CreateRequestWithSigned(requestID string, method WsApiMethodType, params ma
 	return rawData, nil
 }
 
+// CreateRequestWithApiKey creates ws request authenticated by api key only
+func CreateRequestWithApiKey(requestID, key string, method WsApiMethodType, params map[string]interface{}) ([]byte, error) {
+	if requestID == "" {
+		return nil, ErrorRequestIDNotSet
+	}
+
+	if key == "" {
+		return nil, ErrorApiKeyIsNotSet
+	}
+
+	params[apiKey] = key
+
+	return CreateRequestWithSigned(requestID, method, params)
+}
+
 // encode encodes the parameters to a URL encoded string
 func encodeParams(p map[string]interface{}) string {
 	queryValues := url.Values{}
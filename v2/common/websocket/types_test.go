@@ -0,0 +1,97 @@
+package websocket
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/adshao/go-binance/v2/common"
+)
+
+// decodeTestApiRequest decodes numbers as json.Number so that params encode as sent
+func decodeTestApiRequest(t *testing.T, rawData []byte) testApiRequest {
+	req := testApiRequest{}
+	dec := json.NewDecoder(bytes.NewReader(rawData))
+	dec.UseNumber()
+	require.NoError(t, dec.Decode(&req))
+	return req
+}
+
+func TestCreateRequest(t *testing.T) {
+	rawData, err := CreateRequest(
+		NewRequestData("1", "dummyApiKey", "dummySecretKey", 0, common.KeyTypeHmac),
+		OrderStatusSpotWsApiMethod,
+		map[string]interface{}{"symbol": "BTCUSDT"},
+	)
+	require.NoError(t, err)
+	req := decodeTestApiRequest(t, rawData)
+	assert.Equal(t, "dummyApiKey", req.Params["apiKey"])
+	signature := req.Params["signature"]
+	delete(req.Params, "signature")
+	expected, err := common.NewHmacSigner("dummySecretKey").Sign([]byte(encodeParams(req.Params)))
+	require.NoError(t, err)
+	assert.Equal(t, expected, signature)
+
+	_, err = CreateRequest(
+		NewRequestData("1", "dummyApiKey", "", 0, common.KeyTypeHmac),
+		OrderStatusSpotWsApiMethod,
+		map[string]interface{}{},
+	)
+	assert.Equal(t, ErrorSecretKeyIsNotSet, err)
+}
+
+func TestCreateRequestWithSigner(t *testing.T) {
+	var payloads []string
+	signer, err := common.NewExternalSigner(common.KeyTypeEd25519, func(payload []byte) ([]byte, error) {
+		payloads = append(payloads, string(payload))
+		return []byte{1, 2, 3}, nil
+	})
+	require.NoError(t, err)
+
+	// the secret key is not required with a signer
+	rawData, err := CreateRequest(
+		NewRequestData("1", "dummyApiKey", "", 0, common.KeyTypeHmac).WithSigner(signer),
+		OrderStatusSpotWsApiMethod,
+		map[string]interface{}{"symbol": "BTCUSDT"},
+	)
+	require.NoError(t, err)
+	req := decodeTestApiRequest(t, rawData)
+	assert.Equal(t, "AQID", req.Params["signature"])
+	delete(req.Params, "signature")
+	assert.Equal(t, []string{encodeParams(req.Params)}, payloads)
+
+	// nil signers are ignored
+	_, err = CreateRequest(
+		NewRequestData("1", "dummyApiKey", "", 0, common.KeyTypeHmac).WithSigner(nil),
+		OrderStatusSpotWsApiMethod,
+		map[string]interface{}{},
+	)
+	assert.Equal(t, ErrorSecretKeyIsNotSet, err)
+}
+
+func TestCreateRequestWithSignerCache(t *testing.T) {
+	cache := new(common.SignerCache)
+	signer, err := cache.Get(common.KeyTypeHmac, "dummySecretKey")
+	require.NoError(t, err)
+
+	rawData, err := CreateRequest(
+		NewRequestData("1", "dummyApiKey", "dummySecretKey", 0, common.KeyTypeHmac).WithSignerCache(cache),
+		OrderStatusSpotWsApiMethod,
+		map[string]interface{}{"symbol": "BTCUSDT"},
+	)
+	require.NoError(t, err)
+	req := decodeTestApiRequest(t, rawData)
+	signature := req.Params["signature"]
+	delete(req.Params, "signature")
+	expected, err := signer.Sign([]byte(encodeParams(req.Params)))
+	require.NoError(t, err)
+	assert.Equal(t, expected, signature)
+
+	// the key parsed for the request is kept by the cache of the client only
+	cached, err := cache.Get(common.KeyTypeHmac, "dummySecretKey")
+	require.NoError(t, err)
+	assert.Same(t, signer, cached)
+}
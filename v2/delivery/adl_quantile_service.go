@@ -0,0 +1,57 @@
+package delivery
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+)
+
+// GetADLQuantileService get the auto-deleveraging quantile estimation of positions
+type GetADLQuantileService struct {
+	c      *Client
+	symbol string
+}
+
+// Symbol set symbol
+func (s *GetADLQuantileService) Symbol(symbol string) *GetADLQuantileService {
+	s.symbol = symbol
+	return s
+}
+
+// Do send request
+func (s *GetADLQuantileService) Do(ctx context.Context, opts ...RequestOption) (res []*ADLQuantile, err error) {
+	r := &request{
+		method:   http.MethodGet,
+		endpoint: "/dapi/v1/adlQuantile",
+		secType:  secTypeSigned,
+	}
+	if s.symbol != "" {
+		r.setParam("symbol", s.symbol)
+	}
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return []*ADLQuantile{}, err
+	}
+	res = make([]*ADLQuantile, 0)
+	err = json.Unmarshal(data, &res)
+	if err != nil {
+		return []*ADLQuantile{}, err
+	}
+	return res, nil
+}
+
+// ADLQuantile define the ADL quantiles of a symbol, from 0 to 4, the higher the more likely
+// the position is auto-deleveraged
+type ADLQuantile struct {
+	Symbol      string             `json:"symbol"`
+	ADLQuantile ADLQuantilePerSide `json:"adlQuantile"`
+}
+
+// ADLQuantilePerSide define the ADL quantiles per position side, HEDGE being the quantile of
+// the side with the lowest quantile in hedge mode, BOTH the quantile in one-way mode
+type ADLQuantilePerSide struct {
+	Long  int `json:"LONG"`
+	Short int `json:"SHORT"`
+	Hedge int `json:"HEDGE"`
+	Both  int `json:"BOTH"`
+}
@@ -0,0 +1,50 @@
+package delivery
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type adlQuantileServiceTestSuite struct {
+	baseTestSuite
+}
+
+func TestADLQuantileService(t *testing.T) {
+	suite.Run(t, new(adlQuantileServiceTestSuite))
+}
+
+func (s *adlQuantileServiceTestSuite) TestGetADLQuantile() {
+	data := []byte(`[
+		{
+			"symbol": "BTCUSD_200925",
+			"adlQuantile": {
+				"LONG": 0,
+				"SHORT": 0,
+				"HEDGE": 0
+			}
+		},
+		{
+			"symbol": "BTCUSD_201225",
+			"adlQuantile": {
+				"LONG": 1,
+				"SHORT": 2,
+				"BOTH": 0
+			}
+		}
+	]`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+
+	s.assertReq(func(r *request) {
+		s.r().Equal("/dapi/v1/adlQuantile", r.endpoint)
+		s.assertRequestEqual(newSignedRequest(), r)
+	})
+	res, err := s.client.NewGetADLQuantileService().Do(newContext())
+	r := s.r()
+	r.NoError(err)
+	r.Equal([]*ADLQuantile{
+		{Symbol: "BTCUSD_200925"},
+		{Symbol: "BTCUSD_201225", ADLQuantile: ADLQuantilePerSide{Long: 1, Short: 2}},
+	}, res)
+}
@@ -63,6 +63,18 @@ type UserDataEventType string
 // UserDataEventReasonType define reason type for user data event
 type UserDataEventReasonType string
 
+// PriceMatchType define priceMatch type
+type PriceMatchType string
+
+// SelfTradePreventionMode define self trade prevention strategy
+type SelfTradePreventionMode string
+
+// ForceOrderCloseType define reason type for force order
+type ForceOrderCloseType string
+
+// DownloadType define the type of an asynchronous download
+type DownloadType string
+
 // Endpoints
 var (
 	BaseApiMainUrl    = "https://dapi.binance.com"
@@ -137,6 +149,28 @@ const (
 	MarginTypeIsolated MarginType = "ISOLATED"
 	MarginTypeCrossed  MarginType = "CROSSED"
 
+	PriceMatchTypeOpponent   PriceMatchType = "OPPONENT"
+	PriceMatchTypeOpponent5  PriceMatchType = "OPPONENT_5"
+	PriceMatchTypeOpponent10 PriceMatchType = "OPPONENT_10"
+	PriceMatchTypeOpponent20 PriceMatchType = "OPPONENT_20"
+	PriceMatchTypeQueue      PriceMatchType = "QUEUE"
+	PriceMatchTypeQueue5     PriceMatchType = "QUEUE_5"
+	PriceMatchTypeQueue10    PriceMatchType = "QUEUE_10"
+	PriceMatchTypeQueue20    PriceMatchType = "QUEUE_20"
+	PriceMatchTypeNone       PriceMatchType = "NONE"
+
+	SelfTradePreventionModeNone        SelfTradePreventionMode = "NONE"
+	SelfTradePreventionModeExpireTaker SelfTradePreventionMode = "EXPIRE_TAKER"
+	SelfTradePreventionModeExpireBoth  SelfTradePreventionMode = "EXPIRE_BOTH"
+	SelfTradePreventionModeExpireMaker SelfTradePreventionMode = "EXPIRE_MAKER"
+
+	ForceOrderCloseTypeLiquidation ForceOrderCloseType = "LIQUIDATION"
+	ForceOrderCloseTypeADL         ForceOrderCloseType = "ADL"
+
+	DownloadTypeIncome DownloadType = "income"
+	DownloadTypeOrder  DownloadType = "order"
+	DownloadTypeTrade  DownloadType = "trade"
+
 	UserDataEventTypeListenKeyExpired    UserDataEventType = "listenKeyExpired"
 	UserDataEventTypeMarginCall          UserDataEventType = "MARGIN_CALL"
 	UserDataEventTypeAccountUpdate       UserDataEventType = "ACCOUNT_UPDATE"
@@ -175,30 +209,33 @@ func newJSON(data []byte) (j *simplejson.Json, err error) {
 	return j, nil
 }
 
-// getApiEndpoint return the base endpoint of the WS according the UseTestnet flag
-func getApiEndpoint() string {
-	if UseTestnet {
-		return BaseApiTestnetUrl
-	}
-	return BaseApiMainUrl
-}
-
 // NewClient initialize an API client instance with API key and secret key.
 // You should always call this function before using this SDK.
 // Services will be created by the form client.NewXXXService().
 func NewClient(apiKey, secretKey string) *Client {
+	return NewClientWithEnvironment(apiKey, secretKey, defaultEnvironment())
+}
+
+// NewClientWithEnvironment initialize an API client instance for the endpoints of env
+func NewClientWithEnvironment(apiKey, secretKey string, env Environment) *Client {
 	return &Client{
-		APIKey:     apiKey,
-		SecretKey:  secretKey,
-		KeyType:    common.KeyTypeHmac,
-		BaseURL:    getApiEndpoint(),
-		UserAgent:  "Binance/golang",
-		HTTPClient: http.DefaultClient,
-		Logger:     log.New(os.Stderr, "Binance-golang ", log.LstdFlags),
+		APIKey:      apiKey,
+		SecretKey:   secretKey,
+		KeyType:     common.KeyTypeHmac,
+		BaseURL:     env.APIURL,
+		Environment: env,
+		UserAgent:   "Binance/golang",
+		HTTPClient:  http.DefaultClient,
+		Logger:      log.New(os.Stderr, "Binance-golang ", log.LstdFlags),
 	}
 }
 
 func NewProxiedClient(apiKey, secretKey, proxyUrl string) *Client {
+	return NewProxiedClientWithEnvironment(apiKey, secretKey, proxyUrl, defaultEnvironment())
+}
+
+// NewProxiedClientWithEnvironment passing a proxy url, for the endpoints of env
+func NewProxiedClientWithEnvironment(apiKey, secretKey, proxyUrl string, env Environment) *Client {
 	proxy, err := url.Parse(proxyUrl)
 	if err != nil {
 		log.Fatal(err)
@@ -208,11 +245,12 @@ func NewProxiedClient(apiKey, secretKey, proxyUrl string) *Client {
 		TLSClientConfig: &tls.Config{InsecureSkipVerify: true},
 	}
 	return &Client{
-		APIKey:    apiKey,
-		SecretKey: secretKey,
-		KeyType:   common.KeyTypeHmac,
-		BaseURL:   getApiEndpoint(),
-		UserAgent: "Binance/golang",
+		APIKey:      apiKey,
+		SecretKey:   secretKey,
+		KeyType:     common.KeyTypeHmac,
+		BaseURL:     env.APIURL,
+		Environment: env,
+		UserAgent:   "Binance/golang",
 		HTTPClient: &http.Client{
 			Transport: tr,
 		},
@@ -222,6 +260,9 @@ func NewProxiedClient(apiKey, secretKey, proxyUrl string) *Client {
 
 type doFunc func(req *http.Request) (*http.Response, error)
 
+//go:generate go run github.com/adshao/go-binance/v2/internal/servicegen -output service_api.go
+//go:generate mockgen -source service_api.go -destination mock/service_api.go -package mock
+
 // Client define API client
 type Client struct {
 	APIKey     string
@@ -235,6 +276,18 @@ type Client struct {
 	TimeOffset int64
 	do         doFunc
 
+	// Environment is the environment of the client, its WsOptions connect the streams and
+	// the Websocket API to the same environment
+	Environment Environment
+
+	// Middlewares wrap every REST API call, see Use
+	Middlewares   []common.Middleware
+	logMiddleware common.Middleware
+
+	// Signer signs requests instead of SecretKey when set, see SetSigner
+	Signer  common.Signer
+	signers common.SignerCache
+
 	UsedWeight common.UsedWeight
 	OrderCount common.OrderCount
 }
@@ -276,22 +329,17 @@ func (c *Client) parseRequest(r *request, opts ...RequestOption) (err error) {
 	if r.secType == secTypeAPIKey || r.secType == secTypeSigned {
 		header.Set("X-MBX-APIKEY", c.APIKey)
 	}
-	kt := c.KeyType
-	if kt == "" {
-		kt = common.KeyTypeHmac
-	}
-	sf, err := common.SignFunc(kt)
-	if err != nil {
-		return err
-	}
 	if r.secType == secTypeSigned {
-		raw := fmt.Sprintf("%s%s", queryString, bodyString)
-		sign, err := sf(c.SecretKey, raw)
+		signer, err := c.signer()
+		if err != nil {
+			return err
+		}
+		sign, err := signer.Sign([]byte(queryString + bodyString))
 		if err != nil {
 			return err
 		}
 		v := url.Values{}
-		v.Set(signatureKey, *sign)
+		v.Set(signatureKey, sign)
 		if queryString == "" {
 			queryString = v.Encode()
 		} else {
@@ -301,7 +349,7 @@ func (c *Client) parseRequest(r *request, opts ...RequestOption) (err error) {
 	if queryString != "" {
 		fullURL = fmt.Sprintf("%s?%s", fullURL, queryString)
 	}
-	c.debug("full url: %s, body: %s\n", fullURL, bodyString)
+	c.debug("full url: %s, body: %s\n", common.RedactString(fullURL), common.RedactString(bodyString))
 
 	r.fullURL = fullURL
 	r.header = header
@@ -320,20 +368,37 @@ func (c *Client) callAPI(ctx context.Context, r *request, opts ...RequestOption)
 	}
 	req = req.WithContext(ctx)
 	req.Header = r.header
-	c.debug("request: %#v\n", req)
+	send := c.send
+	if c.logMiddleware != nil {
+		send = c.logMiddleware(send)
+	}
+	res, err := common.Chain(send, c.Middlewares...)(req)
+	if err != nil {
+		if res != nil {
+			return nil, err
+		}
+		return []byte{}, err
+	}
+	return res.Body, nil
+}
+
+// send performs a built request, it is the innermost handler of the middleware chain
+func (c *Client) send(req *http.Request) (resp *common.Response, err error) {
+	c.debug("request: %s %s, header: %v\n", req.Method, common.RedactString(req.URL.String()), common.RedactHeader(req.Header))
 	f := c.do
 	if f == nil {
 		f = c.HTTPClient.Do
 	}
 	res, err := f(req)
 	if err != nil {
-		return []byte{}, err
+		return nil, err
 	}
 	c.UsedWeight.UpdateByHeader(res.Header)
 	c.OrderCount.UpdateByHeader(res.Header)
-	data, err = io.ReadAll(res.Body)
+
+	data, err := io.ReadAll(res.Body)
 	if err != nil {
-		return []byte{}, err
+		return nil, err
 	}
 	defer func() {
 		cerr := res.Body.Close()
@@ -343,10 +408,15 @@ func (c *Client) callAPI(ctx context.Context, r *request, opts ...RequestOption)
 			err = cerr
 		}
 	}()
-	c.debug("response: %#v\n", res)
+	c.debug("response header: %v\n", res.Header)
 	c.debug("response body: %s\n", string(data))
 	c.debug("response status code: %d\n", res.StatusCode)
 
+	resp = &common.Response{
+		StatusCode: res.StatusCode,
+		Header:     res.Header,
+		Body:       data,
+	}
 	if res.StatusCode >= http.StatusBadRequest {
 		apiErr := new(common.APIError)
 		e := json.Unmarshal(data, apiErr)
@@ -356,9 +426,32 @@ func (c *Client) callAPI(ctx context.Context, r *request, opts ...RequestOption)
 		if !apiErr.IsValid() {
 			apiErr.Response = data
 		}
-		return nil, apiErr
+		return resp, apiErr
 	}
-	return data, nil
+	return resp, nil
+}
+
+// SetSigner set the signer of requests, e.g. an external KMS or HSM signer,
+// SecretKey is not used to sign requests anymore
+func (c *Client) SetSigner(signer common.Signer) *Client {
+	c.Signer = signer
+	c.KeyType = signer.KeyType()
+	return c
+}
+
+// signer returns Signer when set, else the signer of SecretKey, parsed once
+func (c *Client) signer() (common.Signer, error) {
+	if c.Signer != nil {
+		return c.Signer, nil
+	}
+	return c.signers.Get(c.KeyType, c.SecretKey)
+}
+
+// Use appends middlewares wrapping every REST API call of the client, the first
+// middleware being the outermost. It must not be called concurrently with requests.
+func (c *Client) Use(middlewares ...common.Middleware) *Client {
+	c.Middlewares = append(c.Middlewares, middlewares...)
+	return c
 }
 
 // SetApiEndpoint set api Endpoint
@@ -427,6 +520,21 @@ func (c *Client) NewCreateOrderService() *CreateOrderService {
 	return &CreateOrderService{c: c}
 }
 
+// NewModifyOrderService init modify order service
+func (c *Client) NewModifyOrderService() *ModifyOrderService {
+	return &ModifyOrderService{c: c}
+}
+
+// NewCreateBatchOrdersService init create batch orders service
+func (c *Client) NewCreateBatchOrdersService() *CreateBatchOrdersService {
+	return &CreateBatchOrdersService{c: c}
+}
+
+// NewModifyBatchOrdersService init modify batch orders service
+func (c *Client) NewModifyBatchOrdersService() *ModifyBatchOrdersService {
+	return &ModifyBatchOrdersService{c: c}
+}
+
 // NewGetOrderService init get order service
 func (c *Client) NewGetOrderService() *GetOrderService {
 	return &GetOrderService{c: c}
@@ -442,6 +550,21 @@ func (c *Client) NewCancelAllOpenOrdersService() *CancelAllOpenOrdersService {
 	return &CancelAllOpenOrdersService{c: c}
 }
 
+// NewCancelMultipleOrdersService init cancel multiple orders service
+func (c *Client) NewCancelMultipleOrdersService() *CancelMultiplesOrdersService {
+	return &CancelMultiplesOrdersService{c: c}
+}
+
+// NewCountdownCancelAllService init countdown cancel all service
+func (c *Client) NewCountdownCancelAllService() *CountdownCancelAllService {
+	return &CountdownCancelAllService{c: c}
+}
+
+// NewGetOpenOrderService init get open order service
+func (c *Client) NewGetOpenOrderService() *GetOpenOrderService {
+	return &GetOpenOrderService{c: c}
+}
+
 // NewListOpenOrdersService init list open orders service
 func (c *Client) NewListOpenOrdersService() *ListOpenOrdersService {
 	return &ListOpenOrdersService{c: c}
@@ -457,6 +580,36 @@ func (c *Client) NewListLiquidationOrdersService() *ListLiquidationOrdersService
 	return &ListLiquidationOrdersService{c: c}
 }
 
+// NewListUserLiquidationOrdersService init list user's liquidation orders service
+func (c *Client) NewListUserLiquidationOrdersService() *ListUserLiquidationOrdersService {
+	return &ListUserLiquidationOrdersService{c: c}
+}
+
+// NewListAccountTradeService init account trade list service
+func (c *Client) NewListAccountTradeService() *ListAccountTradeService {
+	return &ListAccountTradeService{c: c}
+}
+
+// NewGetIncomeHistoryService init income history service
+func (c *Client) NewGetIncomeHistoryService() *GetIncomeHistoryService {
+	return &GetIncomeHistoryService{c: c}
+}
+
+// NewCommissionRateService init commission rate service
+func (c *Client) NewCommissionRateService() *CommissionRateService {
+	return &CommissionRateService{c: c}
+}
+
+// NewGetDownloadIDService init service getting the download id of income, order or trade history
+func (c *Client) NewGetDownloadIDService() *GetDownloadIDService {
+	return &GetDownloadIDService{c: c}
+}
+
+// NewGetDownloadLinkService init service getting the download link of income, order or trade history
+func (c *Client) NewGetDownloadLinkService() *GetDownloadLinkService {
+	return &GetDownloadLinkService{c: c}
+}
+
 // NewGetAccountService init account service
 func (c *Client) NewGetAccountService() *GetAccountService {
 	return &GetAccountService{c: c}
@@ -477,6 +630,16 @@ func (c *Client) NewChangeLeverageService() *ChangeLeverageService {
 	return &ChangeLeverageService{c: c}
 }
 
+// NewGetLeverageBracketService init leverage bracket service
+func (c *Client) NewGetLeverageBracketService() *GetLeverageBracketService {
+	return &GetLeverageBracketService{c: c}
+}
+
+// NewGetADLQuantileService init position ADL quantile service
+func (c *Client) NewGetADLQuantileService() *GetADLQuantileService {
+	return &GetADLQuantileService{c: c}
+}
+
 // NewChangeMarginTypeService init change margin type service
 func (c *Client) NewChangeMarginTypeService() *ChangeMarginTypeService {
 	return &ChangeMarginTypeService{c: c}
@@ -487,6 +650,11 @@ func (c *Client) NewUpdatePositionMarginService() *UpdatePositionMarginService {
 	return &UpdatePositionMarginService{c: c}
 }
 
+// NewGetPositionMarginHistoryService init position margin history service
+func (c *Client) NewGetPositionMarginHistoryService() *GetPositionMarginHistoryService {
+	return &GetPositionMarginHistoryService{c: c}
+}
+
 // NewChangePositionModeService init change position mode service
 func (c *Client) NewChangePositionModeService() *ChangePositionModeService {
 	return &ChangePositionModeService{c: c}
@@ -122,6 +122,8 @@ func newMockedClient(apiKey, secretKey string) *mockedClient {
 func (m *mockedClient) do(req *http.Request) (*http.Response, error) {
 	if m.assertReq != nil {
 		r := newRequest()
+		r.method = req.Method
+		r.endpoint = req.URL.Path
 		r.query = req.URL.Query()
 		if req.Body != nil {
 			bs := make([]byte, req.ContentLength)
@@ -0,0 +1,46 @@
+package delivery
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+)
+
+// CommissionRateService get the user commission rate of a symbol
+type CommissionRateService struct {
+	c      *Client
+	symbol string
+}
+
+// Symbol set symbol
+func (s *CommissionRateService) Symbol(symbol string) *CommissionRateService {
+	s.symbol = symbol
+	return s
+}
+
+// Do send request
+func (s *CommissionRateService) Do(ctx context.Context, opts ...RequestOption) (res *CommissionRate, err error) {
+	r := &request{
+		method:   http.MethodGet,
+		endpoint: "/dapi/v1/commissionRate",
+		secType:  secTypeSigned,
+	}
+	r.setParam("symbol", s.symbol)
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(CommissionRate)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// CommissionRate define commission rate
+type CommissionRate struct {
+	Symbol              string `json:"symbol"`
+	MakerCommissionRate string `json:"makerCommissionRate"`
+	TakerCommissionRate string `json:"takerCommissionRate"`
+}
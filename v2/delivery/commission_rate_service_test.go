@@ -0,0 +1,39 @@
+package delivery
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type commissionRateServiceTestSuite struct {
+	baseTestSuite
+}
+
+func TestCommissionRateService(t *testing.T) {
+	suite.Run(t, new(commissionRateServiceTestSuite))
+}
+
+func (s *commissionRateServiceTestSuite) TestCommissionRate() {
+	data := []byte(`{
+		"symbol": "BTCUSD_PERP",
+		"makerCommissionRate": "0.00015",
+		"takerCommissionRate": "0.00040"
+	}`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+
+	s.assertReq(func(r *request) {
+		s.r().Equal("/dapi/v1/commissionRate", r.endpoint)
+		e := newSignedRequest().setParam("symbol", "BTCUSD_PERP")
+		s.assertRequestEqual(e, r)
+	})
+	res, err := s.client.NewCommissionRateService().Symbol("BTCUSD_PERP").Do(newContext())
+	r := s.r()
+	r.NoError(err)
+	r.Equal(&CommissionRate{
+		Symbol:              "BTCUSD_PERP",
+		MakerCommissionRate: "0.00015",
+		TakerCommissionRate: "0.00040",
+	}, res)
+}
@@ -0,0 +1,114 @@
+package delivery
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// GetDownloadIDService request the asynchronous generation of the income, order or trade history
+// of the account, the period between startTime and endTime being at most one year
+type GetDownloadIDService struct {
+	c            *Client
+	downloadType DownloadType
+	startTime    int64
+	endTime      int64
+}
+
+// Type set the download type, one of DownloadTypeIncome, DownloadTypeOrder or DownloadTypeTrade
+func (s *GetDownloadIDService) Type(downloadType DownloadType) *GetDownloadIDService {
+	s.downloadType = downloadType
+	return s
+}
+
+// StartTime set startTime
+func (s *GetDownloadIDService) StartTime(startTime int64) *GetDownloadIDService {
+	s.startTime = startTime
+	return s
+}
+
+// EndTime set endTime
+func (s *GetDownloadIDService) EndTime(endTime int64) *GetDownloadIDService {
+	s.endTime = endTime
+	return s
+}
+
+// Do send request
+func (s *GetDownloadIDService) Do(ctx context.Context, opts ...RequestOption) (res *DownloadID, err error) {
+	r := &request{
+		method:   http.MethodGet,
+		endpoint: fmt.Sprintf("/dapi/v1/%s/asyn", s.downloadType),
+		secType:  secTypeSigned,
+	}
+	r.setParams(params{
+		"startTime": s.startTime,
+		"endTime":   s.endTime,
+	})
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(DownloadID)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// DownloadID define the id of an asynchronous download
+type DownloadID struct {
+	AvgCostTimestampOfLast30d int64  `json:"avgCostTimestampOfLast30d"`
+	DownloadID                string `json:"downloadId"`
+}
+
+// GetDownloadLinkService get the link of an asynchronous download of the income, order or trade history
+type GetDownloadLinkService struct {
+	c            *Client
+	downloadType DownloadType
+	downloadID   string
+}
+
+// Type set the download type, one of DownloadTypeIncome, DownloadTypeOrder or DownloadTypeTrade
+func (s *GetDownloadLinkService) Type(downloadType DownloadType) *GetDownloadLinkService {
+	s.downloadType = downloadType
+	return s
+}
+
+// DownloadID set downloadID
+func (s *GetDownloadLinkService) DownloadID(downloadID string) *GetDownloadLinkService {
+	s.downloadID = downloadID
+	return s
+}
+
+// Do send request
+func (s *GetDownloadLinkService) Do(ctx context.Context, opts ...RequestOption) (res *DownloadLink, err error) {
+	r := &request{
+		method:   http.MethodGet,
+		endpoint: fmt.Sprintf("/dapi/v1/%s/asyn/id", s.downloadType),
+		secType:  secTypeSigned,
+	}
+	r.setParam("downloadId", s.downloadID)
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(DownloadLink)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// DownloadLink define the link of an asynchronous download, the status being "processing"
+// until the link is available and "completed" then
+type DownloadLink struct {
+	DownloadID          string `json:"downloadId"`
+	Status              string `json:"status"`
+	URL                 string `json:"url"`
+	Notified            bool   `json:"notified"`
+	ExpirationTimestamp int64  `json:"expirationTimestamp"`
+	IsExpired           *bool  `json:"isExpired"`
+}
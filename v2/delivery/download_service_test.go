@@ -0,0 +1,68 @@
+package delivery
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type downloadServiceTestSuite struct {
+	baseTestSuite
+}
+
+func TestDownloadService(t *testing.T) {
+	suite.Run(t, new(downloadServiceTestSuite))
+}
+
+func (s *downloadServiceTestSuite) TestGetDownloadID() {
+	data := []byte(`{
+		"avgCostTimestampOfLast30d": 7241837,
+		"downloadId": "546975389218332672"
+	}`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+
+	s.assertReq(func(r *request) {
+		s.r().Equal("/dapi/v1/trade/asyn", r.endpoint)
+		e := newSignedRequest().setParams(params{
+			"startTime": int64(1640995200000),
+			"endTime":   int64(1643673600000),
+		})
+		s.assertRequestEqual(e, r)
+	})
+	res, err := s.client.NewGetDownloadIDService().Type(DownloadTypeTrade).
+		StartTime(1640995200000).EndTime(1643673600000).Do(newContext())
+	r := s.r()
+	r.NoError(err)
+	r.Equal(&DownloadID{AvgCostTimestampOfLast30d: 7241837, DownloadID: "546975389218332672"}, res)
+}
+
+func (s *downloadServiceTestSuite) TestGetDownloadLink() {
+	data := []byte(`{
+		"downloadId": "545923594199212032",
+		"status": "completed",
+		"url": "www.binance.com",
+		"notified": true,
+		"expirationTimestamp": 1645009771000,
+		"isExpired": null
+	}`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+
+	s.assertReq(func(r *request) {
+		s.r().Equal("/dapi/v1/income/asyn/id", r.endpoint)
+		e := newSignedRequest().setParam("downloadId", "545923594199212032")
+		s.assertRequestEqual(e, r)
+	})
+	res, err := s.client.NewGetDownloadLinkService().Type(DownloadTypeIncome).
+		DownloadID("545923594199212032").Do(newContext())
+	r := s.r()
+	r.NoError(err)
+	r.Equal(&DownloadLink{
+		DownloadID:          "545923594199212032",
+		Status:              "completed",
+		URL:                 "www.binance.com",
+		Notified:            true,
+		ExpirationTimestamp: 1645009771000,
+	}, res)
+}
@@ -0,0 +1,60 @@
+package delivery
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type incomeHistoryServiceTestSuite struct {
+	baseTestSuite
+}
+
+func TestIncomeHistoryService(t *testing.T) {
+	suite.Run(t, new(incomeHistoryServiceTestSuite))
+}
+
+func (s *incomeHistoryServiceTestSuite) TestGetIncomeHistory() {
+	data := []byte(`[
+		{
+			"symbol": "BTCUSD_200925",
+			"incomeType": "COMMISSION",
+			"income": "-0.01000000",
+			"asset": "BTC",
+			"info": "",
+			"time": 1570636800000,
+			"tranId": 9689322392,
+			"tradeId": "2059192"
+		}
+	]`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+
+	s.assertReq(func(r *request) {
+		s.r().Equal("/dapi/v1/income", r.endpoint)
+		e := newSignedRequest().setParams(params{
+			"symbol":     "BTCUSD_200925",
+			"incomeType": "COMMISSION",
+			"startTime":  int64(1570608000000),
+			"endTime":    int64(1570694400000),
+			"page":       2,
+			"limit":      int64(100),
+		})
+		s.assertRequestEqual(e, r)
+	})
+	res, err := s.client.NewGetIncomeHistoryService().Symbol("BTCUSD_200925").IncomeType("COMMISSION").
+		StartTime(1570608000000).EndTime(1570694400000).Page(2).Limit(100).Do(newContext())
+	r := s.r()
+	r.NoError(err)
+	r.Equal([]*IncomeHistory{
+		{
+			Symbol:     "BTCUSD_200925",
+			IncomeType: "COMMISSION",
+			Income:     "-0.01000000",
+			Asset:      "BTC",
+			Time:       1570636800000,
+			TranID:     9689322392,
+			TradeID:    "2059192",
+		},
+	}, res)
+}
@@ -0,0 +1,60 @@
+package delivery
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"github.com/adshao/go-binance/v2/common"
+)
+
+// GetLeverageBracketService get the notional and leverage brackets of symbols
+type GetLeverageBracketService struct {
+	c      *Client
+	symbol string
+}
+
+// Symbol set symbol
+func (s *GetLeverageBracketService) Symbol(symbol string) *GetLeverageBracketService {
+	s.symbol = symbol
+	return s
+}
+
+// Do send request
+func (s *GetLeverageBracketService) Do(ctx context.Context, opts ...RequestOption) (res []*LeverageBracket, err error) {
+	r := &request{
+		method:   http.MethodGet,
+		endpoint: "/dapi/v2/leverageBracket",
+		secType:  secTypeSigned,
+	}
+	if s.symbol != "" {
+		r.setParam("symbol", s.symbol)
+	}
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return []*LeverageBracket{}, err
+	}
+	res = make([]*LeverageBracket, 0)
+	err = json.Unmarshal(common.ToJSONList(data), &res)
+	if err != nil {
+		return []*LeverageBracket{}, err
+	}
+	return res, nil
+}
+
+// LeverageBracket define the leverage brackets of a symbol
+type LeverageBracket struct {
+	Symbol       string    `json:"symbol"`
+	NotionalCoef float64   `json:"notionalCoef"`
+	Brackets     []Bracket `json:"brackets"`
+}
+
+// Bracket define a leverage bracket, caps and floors are quantities of contracts
+type Bracket struct {
+	Bracket          int     `json:"bracket"`
+	InitialLeverage  int     `json:"initialLeverage"`
+	QtyCap           float64 `json:"qtyCap"`
+	QtyFloor         float64 `json:"qtyFloor"`
+	MaintMarginRatio float64 `json:"maintMarginRatio"`
+	Cum              float64 `json:"cum"`
+}
@@ -0,0 +1,59 @@
+package delivery
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type leverageBracketServiceTestSuite struct {
+	baseTestSuite
+}
+
+func TestLeverageBracketService(t *testing.T) {
+	suite.Run(t, new(leverageBracketServiceTestSuite))
+}
+
+func (s *leverageBracketServiceTestSuite) TestGetLeverageBracket() {
+	data := []byte(`[
+		{
+			"symbol": "BTCUSD_PERP",
+			"notionalCoef": 1.50,
+			"brackets": [
+				{
+					"bracket": 1,
+					"initialLeverage": 125,
+					"qtyCap": 50,
+					"qtyFloor": 0,
+					"maintMarginRatio": 0.004,
+					"cum": 0.0
+				}
+			]
+		}
+	]`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+
+	s.assertReq(func(r *request) {
+		s.r().Equal("/dapi/v2/leverageBracket", r.endpoint)
+		e := newSignedRequest().setParam("symbol", "BTCUSD_PERP")
+		s.assertRequestEqual(e, r)
+	})
+	res, err := s.client.NewGetLeverageBracketService().Symbol("BTCUSD_PERP").Do(newContext())
+	r := s.r()
+	r.NoError(err)
+	r.Equal([]*LeverageBracket{
+		{
+			Symbol:       "BTCUSD_PERP",
+			NotionalCoef: 1.5,
+			Brackets: []Bracket{
+				{
+					Bracket:          1,
+					InitialLeverage:  125,
+					QtyCap:           50,
+					MaintMarginRatio: 0.004,
+				},
+			},
+		},
+	}, res)
+}
@@ -0,0 +1,16 @@
+//go:build go1.21
+
+package delivery
+
+import (
+	"log/slog"
+
+	"github.com/adshao/go-binance/v2/common"
+)
+
+// SetLogHandler set a slog handler receiving structured records of every REST API call,
+// API keys, secrets and signatures are redacted
+func (c *Client) SetLogHandler(h slog.Handler) *Client {
+	c.logMiddleware = common.LogMiddleware(slog.New(h))
+	return c
+}
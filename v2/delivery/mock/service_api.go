@@ -0,0 +1,4076 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: service_api.go
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	delivery "github.com/adshao/go-binance/v2/delivery"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockClientAPI is a mock of ClientAPI interface.
+type MockClientAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockClientAPIMockRecorder
+}
+
+// MockClientAPIMockRecorder is the mock recorder for MockClientAPI.
+type MockClientAPIMockRecorder struct {
+	mock *MockClientAPI
+}
+
+// NewMockClientAPI creates a new mock instance.
+func NewMockClientAPI(ctrl *gomock.Controller) *MockClientAPI {
+	mock := &MockClientAPI{ctrl: ctrl}
+	mock.recorder = &MockClientAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockClientAPI) EXPECT() *MockClientAPIMockRecorder {
+	return m.recorder
+}
+
+// NewCancelAllOpenOrdersService mocks base method.
+func (m *MockClientAPI) NewCancelAllOpenOrdersService() delivery.CancelAllOpenOrdersServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewCancelAllOpenOrdersService")
+	ret0, _ := ret[0].(delivery.CancelAllOpenOrdersServiceAPI)
+	return ret0
+}
+
+// NewCancelAllOpenOrdersService indicates an expected call of NewCancelAllOpenOrdersService.
+func (mr *MockClientAPIMockRecorder) NewCancelAllOpenOrdersService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewCancelAllOpenOrdersService", reflect.TypeOf((*MockClientAPI)(nil).NewCancelAllOpenOrdersService))
+}
+
+// NewCancelMultipleOrdersService mocks base method.
+func (m *MockClientAPI) NewCancelMultipleOrdersService() delivery.CancelMultiplesOrdersServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewCancelMultipleOrdersService")
+	ret0, _ := ret[0].(delivery.CancelMultiplesOrdersServiceAPI)
+	return ret0
+}
+
+// NewCancelMultipleOrdersService indicates an expected call of NewCancelMultipleOrdersService.
+func (mr *MockClientAPIMockRecorder) NewCancelMultipleOrdersService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewCancelMultipleOrdersService", reflect.TypeOf((*MockClientAPI)(nil).NewCancelMultipleOrdersService))
+}
+
+// NewCancelOrderService mocks base method.
+func (m *MockClientAPI) NewCancelOrderService() delivery.CancelOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewCancelOrderService")
+	ret0, _ := ret[0].(delivery.CancelOrderServiceAPI)
+	return ret0
+}
+
+// NewCancelOrderService indicates an expected call of NewCancelOrderService.
+func (mr *MockClientAPIMockRecorder) NewCancelOrderService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewCancelOrderService", reflect.TypeOf((*MockClientAPI)(nil).NewCancelOrderService))
+}
+
+// NewChangeLeverageService mocks base method.
+func (m *MockClientAPI) NewChangeLeverageService() delivery.ChangeLeverageServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewChangeLeverageService")
+	ret0, _ := ret[0].(delivery.ChangeLeverageServiceAPI)
+	return ret0
+}
+
+// NewChangeLeverageService indicates an expected call of NewChangeLeverageService.
+func (mr *MockClientAPIMockRecorder) NewChangeLeverageService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewChangeLeverageService", reflect.TypeOf((*MockClientAPI)(nil).NewChangeLeverageService))
+}
+
+// NewChangeMarginTypeService mocks base method.
+func (m *MockClientAPI) NewChangeMarginTypeService() delivery.ChangeMarginTypeServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewChangeMarginTypeService")
+	ret0, _ := ret[0].(delivery.ChangeMarginTypeServiceAPI)
+	return ret0
+}
+
+// NewChangeMarginTypeService indicates an expected call of NewChangeMarginTypeService.
+func (mr *MockClientAPIMockRecorder) NewChangeMarginTypeService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewChangeMarginTypeService", reflect.TypeOf((*MockClientAPI)(nil).NewChangeMarginTypeService))
+}
+
+// NewChangePositionModeService mocks base method.
+func (m *MockClientAPI) NewChangePositionModeService() delivery.ChangePositionModeServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewChangePositionModeService")
+	ret0, _ := ret[0].(delivery.ChangePositionModeServiceAPI)
+	return ret0
+}
+
+// NewChangePositionModeService indicates an expected call of NewChangePositionModeService.
+func (mr *MockClientAPIMockRecorder) NewChangePositionModeService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewChangePositionModeService", reflect.TypeOf((*MockClientAPI)(nil).NewChangePositionModeService))
+}
+
+// NewCloseUserStreamService mocks base method.
+func (m *MockClientAPI) NewCloseUserStreamService() delivery.CloseUserStreamServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewCloseUserStreamService")
+	ret0, _ := ret[0].(delivery.CloseUserStreamServiceAPI)
+	return ret0
+}
+
+// NewCloseUserStreamService indicates an expected call of NewCloseUserStreamService.
+func (mr *MockClientAPIMockRecorder) NewCloseUserStreamService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewCloseUserStreamService", reflect.TypeOf((*MockClientAPI)(nil).NewCloseUserStreamService))
+}
+
+// NewCommissionRateService mocks base method.
+func (m *MockClientAPI) NewCommissionRateService() delivery.CommissionRateServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewCommissionRateService")
+	ret0, _ := ret[0].(delivery.CommissionRateServiceAPI)
+	return ret0
+}
+
+// NewCommissionRateService indicates an expected call of NewCommissionRateService.
+func (mr *MockClientAPIMockRecorder) NewCommissionRateService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewCommissionRateService", reflect.TypeOf((*MockClientAPI)(nil).NewCommissionRateService))
+}
+
+// NewCountdownCancelAllService mocks base method.
+func (m *MockClientAPI) NewCountdownCancelAllService() delivery.CountdownCancelAllServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewCountdownCancelAllService")
+	ret0, _ := ret[0].(delivery.CountdownCancelAllServiceAPI)
+	return ret0
+}
+
+// NewCountdownCancelAllService indicates an expected call of NewCountdownCancelAllService.
+func (mr *MockClientAPIMockRecorder) NewCountdownCancelAllService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewCountdownCancelAllService", reflect.TypeOf((*MockClientAPI)(nil).NewCountdownCancelAllService))
+}
+
+// NewCreateBatchOrdersService mocks base method.
+func (m *MockClientAPI) NewCreateBatchOrdersService() delivery.CreateBatchOrdersServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewCreateBatchOrdersService")
+	ret0, _ := ret[0].(delivery.CreateBatchOrdersServiceAPI)
+	return ret0
+}
+
+// NewCreateBatchOrdersService indicates an expected call of NewCreateBatchOrdersService.
+func (mr *MockClientAPIMockRecorder) NewCreateBatchOrdersService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewCreateBatchOrdersService", reflect.TypeOf((*MockClientAPI)(nil).NewCreateBatchOrdersService))
+}
+
+// NewCreateOrderService mocks base method.
+func (m *MockClientAPI) NewCreateOrderService() delivery.CreateOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewCreateOrderService")
+	ret0, _ := ret[0].(delivery.CreateOrderServiceAPI)
+	return ret0
+}
+
+// NewCreateOrderService indicates an expected call of NewCreateOrderService.
+func (mr *MockClientAPIMockRecorder) NewCreateOrderService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewCreateOrderService", reflect.TypeOf((*MockClientAPI)(nil).NewCreateOrderService))
+}
+
+// NewExchangeInfoService mocks base method.
+func (m *MockClientAPI) NewExchangeInfoService() delivery.ExchangeInfoServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewExchangeInfoService")
+	ret0, _ := ret[0].(delivery.ExchangeInfoServiceAPI)
+	return ret0
+}
+
+// NewExchangeInfoService indicates an expected call of NewExchangeInfoService.
+func (mr *MockClientAPIMockRecorder) NewExchangeInfoService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewExchangeInfoService", reflect.TypeOf((*MockClientAPI)(nil).NewExchangeInfoService))
+}
+
+// NewFundingRateService mocks base method.
+func (m *MockClientAPI) NewFundingRateService() delivery.FundingRateServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewFundingRateService")
+	ret0, _ := ret[0].(delivery.FundingRateServiceAPI)
+	return ret0
+}
+
+// NewFundingRateService indicates an expected call of NewFundingRateService.
+func (mr *MockClientAPIMockRecorder) NewFundingRateService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewFundingRateService", reflect.TypeOf((*MockClientAPI)(nil).NewFundingRateService))
+}
+
+// NewGetADLQuantileService mocks base method.
+func (m *MockClientAPI) NewGetADLQuantileService() delivery.GetADLQuantileServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewGetADLQuantileService")
+	ret0, _ := ret[0].(delivery.GetADLQuantileServiceAPI)
+	return ret0
+}
+
+// NewGetADLQuantileService indicates an expected call of NewGetADLQuantileService.
+func (mr *MockClientAPIMockRecorder) NewGetADLQuantileService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewGetADLQuantileService", reflect.TypeOf((*MockClientAPI)(nil).NewGetADLQuantileService))
+}
+
+// NewGetAccountService mocks base method.
+func (m *MockClientAPI) NewGetAccountService() delivery.GetAccountServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewGetAccountService")
+	ret0, _ := ret[0].(delivery.GetAccountServiceAPI)
+	return ret0
+}
+
+// NewGetAccountService indicates an expected call of NewGetAccountService.
+func (mr *MockClientAPIMockRecorder) NewGetAccountService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewGetAccountService", reflect.TypeOf((*MockClientAPI)(nil).NewGetAccountService))
+}
+
+// NewGetBalanceService mocks base method.
+func (m *MockClientAPI) NewGetBalanceService() delivery.GetBalanceServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewGetBalanceService")
+	ret0, _ := ret[0].(delivery.GetBalanceServiceAPI)
+	return ret0
+}
+
+// NewGetBalanceService indicates an expected call of NewGetBalanceService.
+func (mr *MockClientAPIMockRecorder) NewGetBalanceService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewGetBalanceService", reflect.TypeOf((*MockClientAPI)(nil).NewGetBalanceService))
+}
+
+// NewGetDownloadIDService mocks base method.
+func (m *MockClientAPI) NewGetDownloadIDService() delivery.GetDownloadIDServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewGetDownloadIDService")
+	ret0, _ := ret[0].(delivery.GetDownloadIDServiceAPI)
+	return ret0
+}
+
+// NewGetDownloadIDService indicates an expected call of NewGetDownloadIDService.
+func (mr *MockClientAPIMockRecorder) NewGetDownloadIDService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewGetDownloadIDService", reflect.TypeOf((*MockClientAPI)(nil).NewGetDownloadIDService))
+}
+
+// NewGetDownloadLinkService mocks base method.
+func (m *MockClientAPI) NewGetDownloadLinkService() delivery.GetDownloadLinkServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewGetDownloadLinkService")
+	ret0, _ := ret[0].(delivery.GetDownloadLinkServiceAPI)
+	return ret0
+}
+
+// NewGetDownloadLinkService indicates an expected call of NewGetDownloadLinkService.
+func (mr *MockClientAPIMockRecorder) NewGetDownloadLinkService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewGetDownloadLinkService", reflect.TypeOf((*MockClientAPI)(nil).NewGetDownloadLinkService))
+}
+
+// NewGetFundingInfoService mocks base method.
+func (m *MockClientAPI) NewGetFundingInfoService() delivery.GetFundingInfoServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewGetFundingInfoService")
+	ret0, _ := ret[0].(delivery.GetFundingInfoServiceAPI)
+	return ret0
+}
+
+// NewGetFundingInfoService indicates an expected call of NewGetFundingInfoService.
+func (mr *MockClientAPIMockRecorder) NewGetFundingInfoService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewGetFundingInfoService", reflect.TypeOf((*MockClientAPI)(nil).NewGetFundingInfoService))
+}
+
+// NewGetIncomeHistoryService mocks base method.
+func (m *MockClientAPI) NewGetIncomeHistoryService() delivery.GetIncomeHistoryServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewGetIncomeHistoryService")
+	ret0, _ := ret[0].(delivery.GetIncomeHistoryServiceAPI)
+	return ret0
+}
+
+// NewGetIncomeHistoryService indicates an expected call of NewGetIncomeHistoryService.
+func (mr *MockClientAPIMockRecorder) NewGetIncomeHistoryService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewGetIncomeHistoryService", reflect.TypeOf((*MockClientAPI)(nil).NewGetIncomeHistoryService))
+}
+
+// NewGetLeverageBracketService mocks base method.
+func (m *MockClientAPI) NewGetLeverageBracketService() delivery.GetLeverageBracketServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewGetLeverageBracketService")
+	ret0, _ := ret[0].(delivery.GetLeverageBracketServiceAPI)
+	return ret0
+}
+
+// NewGetLeverageBracketService indicates an expected call of NewGetLeverageBracketService.
+func (mr *MockClientAPIMockRecorder) NewGetLeverageBracketService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewGetLeverageBracketService", reflect.TypeOf((*MockClientAPI)(nil).NewGetLeverageBracketService))
+}
+
+// NewGetOpenOrderService mocks base method.
+func (m *MockClientAPI) NewGetOpenOrderService() delivery.GetOpenOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewGetOpenOrderService")
+	ret0, _ := ret[0].(delivery.GetOpenOrderServiceAPI)
+	return ret0
+}
+
+// NewGetOpenOrderService indicates an expected call of NewGetOpenOrderService.
+func (mr *MockClientAPIMockRecorder) NewGetOpenOrderService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewGetOpenOrderService", reflect.TypeOf((*MockClientAPI)(nil).NewGetOpenOrderService))
+}
+
+// NewGetOrderService mocks base method.
+func (m *MockClientAPI) NewGetOrderService() delivery.GetOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewGetOrderService")
+	ret0, _ := ret[0].(delivery.GetOrderServiceAPI)
+	return ret0
+}
+
+// NewGetOrderService indicates an expected call of NewGetOrderService.
+func (mr *MockClientAPIMockRecorder) NewGetOrderService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewGetOrderService", reflect.TypeOf((*MockClientAPI)(nil).NewGetOrderService))
+}
+
+// NewGetPositionMarginHistoryService mocks base method.
+func (m *MockClientAPI) NewGetPositionMarginHistoryService() delivery.GetPositionMarginHistoryServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewGetPositionMarginHistoryService")
+	ret0, _ := ret[0].(delivery.GetPositionMarginHistoryServiceAPI)
+	return ret0
+}
+
+// NewGetPositionMarginHistoryService indicates an expected call of NewGetPositionMarginHistoryService.
+func (mr *MockClientAPIMockRecorder) NewGetPositionMarginHistoryService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewGetPositionMarginHistoryService", reflect.TypeOf((*MockClientAPI)(nil).NewGetPositionMarginHistoryService))
+}
+
+// NewGetPositionModeService mocks base method.
+func (m *MockClientAPI) NewGetPositionModeService() delivery.GetPositionModeServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewGetPositionModeService")
+	ret0, _ := ret[0].(delivery.GetPositionModeServiceAPI)
+	return ret0
+}
+
+// NewGetPositionModeService indicates an expected call of NewGetPositionModeService.
+func (mr *MockClientAPIMockRecorder) NewGetPositionModeService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewGetPositionModeService", reflect.TypeOf((*MockClientAPI)(nil).NewGetPositionModeService))
+}
+
+// NewGetPositionRiskService mocks base method.
+func (m *MockClientAPI) NewGetPositionRiskService() delivery.GetPositionRiskServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewGetPositionRiskService")
+	ret0, _ := ret[0].(delivery.GetPositionRiskServiceAPI)
+	return ret0
+}
+
+// NewGetPositionRiskService indicates an expected call of NewGetPositionRiskService.
+func (mr *MockClientAPIMockRecorder) NewGetPositionRiskService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewGetPositionRiskService", reflect.TypeOf((*MockClientAPI)(nil).NewGetPositionRiskService))
+}
+
+// NewKeepaliveUserStreamService mocks base method.
+func (m *MockClientAPI) NewKeepaliveUserStreamService() delivery.KeepaliveUserStreamServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewKeepaliveUserStreamService")
+	ret0, _ := ret[0].(delivery.KeepaliveUserStreamServiceAPI)
+	return ret0
+}
+
+// NewKeepaliveUserStreamService indicates an expected call of NewKeepaliveUserStreamService.
+func (mr *MockClientAPIMockRecorder) NewKeepaliveUserStreamService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewKeepaliveUserStreamService", reflect.TypeOf((*MockClientAPI)(nil).NewKeepaliveUserStreamService))
+}
+
+// NewKlinesService mocks base method.
+func (m *MockClientAPI) NewKlinesService() delivery.KlinesServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewKlinesService")
+	ret0, _ := ret[0].(delivery.KlinesServiceAPI)
+	return ret0
+}
+
+// NewKlinesService indicates an expected call of NewKlinesService.
+func (mr *MockClientAPIMockRecorder) NewKlinesService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewKlinesService", reflect.TypeOf((*MockClientAPI)(nil).NewKlinesService))
+}
+
+// NewListAccountTradeService mocks base method.
+func (m *MockClientAPI) NewListAccountTradeService() delivery.ListAccountTradeServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewListAccountTradeService")
+	ret0, _ := ret[0].(delivery.ListAccountTradeServiceAPI)
+	return ret0
+}
+
+// NewListAccountTradeService indicates an expected call of NewListAccountTradeService.
+func (mr *MockClientAPIMockRecorder) NewListAccountTradeService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewListAccountTradeService", reflect.TypeOf((*MockClientAPI)(nil).NewListAccountTradeService))
+}
+
+// NewListBookTickersService mocks base method.
+func (m *MockClientAPI) NewListBookTickersService() delivery.ListBookTickersServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewListBookTickersService")
+	ret0, _ := ret[0].(delivery.ListBookTickersServiceAPI)
+	return ret0
+}
+
+// NewListBookTickersService indicates an expected call of NewListBookTickersService.
+func (mr *MockClientAPIMockRecorder) NewListBookTickersService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewListBookTickersService", reflect.TypeOf((*MockClientAPI)(nil).NewListBookTickersService))
+}
+
+// NewListLiquidationOrdersService mocks base method.
+func (m *MockClientAPI) NewListLiquidationOrdersService() delivery.ListLiquidationOrdersServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewListLiquidationOrdersService")
+	ret0, _ := ret[0].(delivery.ListLiquidationOrdersServiceAPI)
+	return ret0
+}
+
+// NewListLiquidationOrdersService indicates an expected call of NewListLiquidationOrdersService.
+func (mr *MockClientAPIMockRecorder) NewListLiquidationOrdersService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewListLiquidationOrdersService", reflect.TypeOf((*MockClientAPI)(nil).NewListLiquidationOrdersService))
+}
+
+// NewListOpenOrdersService mocks base method.
+func (m *MockClientAPI) NewListOpenOrdersService() delivery.ListOpenOrdersServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewListOpenOrdersService")
+	ret0, _ := ret[0].(delivery.ListOpenOrdersServiceAPI)
+	return ret0
+}
+
+// NewListOpenOrdersService indicates an expected call of NewListOpenOrdersService.
+func (mr *MockClientAPIMockRecorder) NewListOpenOrdersService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewListOpenOrdersService", reflect.TypeOf((*MockClientAPI)(nil).NewListOpenOrdersService))
+}
+
+// NewListOrdersService mocks base method.
+func (m *MockClientAPI) NewListOrdersService() delivery.ListOrdersServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewListOrdersService")
+	ret0, _ := ret[0].(delivery.ListOrdersServiceAPI)
+	return ret0
+}
+
+// NewListOrdersService indicates an expected call of NewListOrdersService.
+func (mr *MockClientAPIMockRecorder) NewListOrdersService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewListOrdersService", reflect.TypeOf((*MockClientAPI)(nil).NewListOrdersService))
+}
+
+// NewListPriceChangeStatsService mocks base method.
+func (m *MockClientAPI) NewListPriceChangeStatsService() delivery.ListPriceChangeStatsServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewListPriceChangeStatsService")
+	ret0, _ := ret[0].(delivery.ListPriceChangeStatsServiceAPI)
+	return ret0
+}
+
+// NewListPriceChangeStatsService indicates an expected call of NewListPriceChangeStatsService.
+func (mr *MockClientAPIMockRecorder) NewListPriceChangeStatsService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewListPriceChangeStatsService", reflect.TypeOf((*MockClientAPI)(nil).NewListPriceChangeStatsService))
+}
+
+// NewListPricesService mocks base method.
+func (m *MockClientAPI) NewListPricesService() delivery.ListPricesServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewListPricesService")
+	ret0, _ := ret[0].(delivery.ListPricesServiceAPI)
+	return ret0
+}
+
+// NewListPricesService indicates an expected call of NewListPricesService.
+func (mr *MockClientAPIMockRecorder) NewListPricesService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewListPricesService", reflect.TypeOf((*MockClientAPI)(nil).NewListPricesService))
+}
+
+// NewListUserLiquidationOrdersService mocks base method.
+func (m *MockClientAPI) NewListUserLiquidationOrdersService() delivery.ListUserLiquidationOrdersServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewListUserLiquidationOrdersService")
+	ret0, _ := ret[0].(delivery.ListUserLiquidationOrdersServiceAPI)
+	return ret0
+}
+
+// NewListUserLiquidationOrdersService indicates an expected call of NewListUserLiquidationOrdersService.
+func (mr *MockClientAPIMockRecorder) NewListUserLiquidationOrdersService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewListUserLiquidationOrdersService", reflect.TypeOf((*MockClientAPI)(nil).NewListUserLiquidationOrdersService))
+}
+
+// NewModifyBatchOrdersService mocks base method.
+func (m *MockClientAPI) NewModifyBatchOrdersService() delivery.ModifyBatchOrdersServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewModifyBatchOrdersService")
+	ret0, _ := ret[0].(delivery.ModifyBatchOrdersServiceAPI)
+	return ret0
+}
+
+// NewModifyBatchOrdersService indicates an expected call of NewModifyBatchOrdersService.
+func (mr *MockClientAPIMockRecorder) NewModifyBatchOrdersService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewModifyBatchOrdersService", reflect.TypeOf((*MockClientAPI)(nil).NewModifyBatchOrdersService))
+}
+
+// NewModifyOrderService mocks base method.
+func (m *MockClientAPI) NewModifyOrderService() delivery.ModifyOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewModifyOrderService")
+	ret0, _ := ret[0].(delivery.ModifyOrderServiceAPI)
+	return ret0
+}
+
+// NewModifyOrderService indicates an expected call of NewModifyOrderService.
+func (mr *MockClientAPIMockRecorder) NewModifyOrderService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewModifyOrderService", reflect.TypeOf((*MockClientAPI)(nil).NewModifyOrderService))
+}
+
+// NewPingService mocks base method.
+func (m *MockClientAPI) NewPingService() delivery.PingServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewPingService")
+	ret0, _ := ret[0].(delivery.PingServiceAPI)
+	return ret0
+}
+
+// NewPingService indicates an expected call of NewPingService.
+func (mr *MockClientAPIMockRecorder) NewPingService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewPingService", reflect.TypeOf((*MockClientAPI)(nil).NewPingService))
+}
+
+// NewServerTimeService mocks base method.
+func (m *MockClientAPI) NewServerTimeService() delivery.ServerTimeServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewServerTimeService")
+	ret0, _ := ret[0].(delivery.ServerTimeServiceAPI)
+	return ret0
+}
+
+// NewServerTimeService indicates an expected call of NewServerTimeService.
+func (mr *MockClientAPIMockRecorder) NewServerTimeService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewServerTimeService", reflect.TypeOf((*MockClientAPI)(nil).NewServerTimeService))
+}
+
+// NewSetServerTimeService mocks base method.
+func (m *MockClientAPI) NewSetServerTimeService() delivery.SetServerTimeServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewSetServerTimeService")
+	ret0, _ := ret[0].(delivery.SetServerTimeServiceAPI)
+	return ret0
+}
+
+// NewSetServerTimeService indicates an expected call of NewSetServerTimeService.
+func (mr *MockClientAPIMockRecorder) NewSetServerTimeService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewSetServerTimeService", reflect.TypeOf((*MockClientAPI)(nil).NewSetServerTimeService))
+}
+
+// NewStartUserStreamService mocks base method.
+func (m *MockClientAPI) NewStartUserStreamService() delivery.StartUserStreamServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewStartUserStreamService")
+	ret0, _ := ret[0].(delivery.StartUserStreamServiceAPI)
+	return ret0
+}
+
+// NewStartUserStreamService indicates an expected call of NewStartUserStreamService.
+func (mr *MockClientAPIMockRecorder) NewStartUserStreamService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewStartUserStreamService", reflect.TypeOf((*MockClientAPI)(nil).NewStartUserStreamService))
+}
+
+// NewUpdatePositionMarginService mocks base method.
+func (m *MockClientAPI) NewUpdatePositionMarginService() delivery.UpdatePositionMarginServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewUpdatePositionMarginService")
+	ret0, _ := ret[0].(delivery.UpdatePositionMarginServiceAPI)
+	return ret0
+}
+
+// NewUpdatePositionMarginService indicates an expected call of NewUpdatePositionMarginService.
+func (mr *MockClientAPIMockRecorder) NewUpdatePositionMarginService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewUpdatePositionMarginService", reflect.TypeOf((*MockClientAPI)(nil).NewUpdatePositionMarginService))
+}
+
+// MockPingServiceAPI is a mock of PingServiceAPI interface.
+type MockPingServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockPingServiceAPIMockRecorder
+}
+
+// MockPingServiceAPIMockRecorder is the mock recorder for MockPingServiceAPI.
+type MockPingServiceAPIMockRecorder struct {
+	mock *MockPingServiceAPI
+}
+
+// NewMockPingServiceAPI creates a new mock instance.
+func NewMockPingServiceAPI(ctrl *gomock.Controller) *MockPingServiceAPI {
+	mock := &MockPingServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockPingServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPingServiceAPI) EXPECT() *MockPingServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockPingServiceAPI) Do(ctx context.Context, opts ...delivery.RequestOption) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockPingServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockPingServiceAPI)(nil).Do), varargs...)
+}
+
+// MockServerTimeServiceAPI is a mock of ServerTimeServiceAPI interface.
+type MockServerTimeServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockServerTimeServiceAPIMockRecorder
+}
+
+// MockServerTimeServiceAPIMockRecorder is the mock recorder for MockServerTimeServiceAPI.
+type MockServerTimeServiceAPIMockRecorder struct {
+	mock *MockServerTimeServiceAPI
+}
+
+// NewMockServerTimeServiceAPI creates a new mock instance.
+func NewMockServerTimeServiceAPI(ctrl *gomock.Controller) *MockServerTimeServiceAPI {
+	mock := &MockServerTimeServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockServerTimeServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockServerTimeServiceAPI) EXPECT() *MockServerTimeServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockServerTimeServiceAPI) Do(ctx context.Context, opts ...delivery.RequestOption) (int64, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockServerTimeServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockServerTimeServiceAPI)(nil).Do), varargs...)
+}
+
+// MockSetServerTimeServiceAPI is a mock of SetServerTimeServiceAPI interface.
+type MockSetServerTimeServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockSetServerTimeServiceAPIMockRecorder
+}
+
+// MockSetServerTimeServiceAPIMockRecorder is the mock recorder for MockSetServerTimeServiceAPI.
+type MockSetServerTimeServiceAPIMockRecorder struct {
+	mock *MockSetServerTimeServiceAPI
+}
+
+// NewMockSetServerTimeServiceAPI creates a new mock instance.
+func NewMockSetServerTimeServiceAPI(ctrl *gomock.Controller) *MockSetServerTimeServiceAPI {
+	mock := &MockSetServerTimeServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockSetServerTimeServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSetServerTimeServiceAPI) EXPECT() *MockSetServerTimeServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockSetServerTimeServiceAPI) Do(ctx context.Context, opts ...delivery.RequestOption) (int64, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockSetServerTimeServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockSetServerTimeServiceAPI)(nil).Do), varargs...)
+}
+
+// MockKlinesServiceAPI is a mock of KlinesServiceAPI interface.
+type MockKlinesServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockKlinesServiceAPIMockRecorder
+}
+
+// MockKlinesServiceAPIMockRecorder is the mock recorder for MockKlinesServiceAPI.
+type MockKlinesServiceAPIMockRecorder struct {
+	mock *MockKlinesServiceAPI
+}
+
+// NewMockKlinesServiceAPI creates a new mock instance.
+func NewMockKlinesServiceAPI(ctrl *gomock.Controller) *MockKlinesServiceAPI {
+	mock := &MockKlinesServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockKlinesServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockKlinesServiceAPI) EXPECT() *MockKlinesServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockKlinesServiceAPI) Do(ctx context.Context, opts ...delivery.RequestOption) ([]*delivery.Kline, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].([]*delivery.Kline)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockKlinesServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockKlinesServiceAPI)(nil).Do), varargs...)
+}
+
+// EndTime mocks base method.
+func (m *MockKlinesServiceAPI) EndTime(endTime int64) delivery.KlinesServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EndTime", endTime)
+	ret0, _ := ret[0].(delivery.KlinesServiceAPI)
+	return ret0
+}
+
+// EndTime indicates an expected call of EndTime.
+func (mr *MockKlinesServiceAPIMockRecorder) EndTime(endTime interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EndTime", reflect.TypeOf((*MockKlinesServiceAPI)(nil).EndTime), endTime)
+}
+
+// Interval mocks base method.
+func (m *MockKlinesServiceAPI) Interval(interval string) delivery.KlinesServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Interval", interval)
+	ret0, _ := ret[0].(delivery.KlinesServiceAPI)
+	return ret0
+}
+
+// Interval indicates an expected call of Interval.
+func (mr *MockKlinesServiceAPIMockRecorder) Interval(interval interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Interval", reflect.TypeOf((*MockKlinesServiceAPI)(nil).Interval), interval)
+}
+
+// Limit mocks base method.
+func (m *MockKlinesServiceAPI) Limit(limit int) delivery.KlinesServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Limit", limit)
+	ret0, _ := ret[0].(delivery.KlinesServiceAPI)
+	return ret0
+}
+
+// Limit indicates an expected call of Limit.
+func (mr *MockKlinesServiceAPIMockRecorder) Limit(limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Limit", reflect.TypeOf((*MockKlinesServiceAPI)(nil).Limit), limit)
+}
+
+// StartTime mocks base method.
+func (m *MockKlinesServiceAPI) StartTime(startTime int64) delivery.KlinesServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartTime", startTime)
+	ret0, _ := ret[0].(delivery.KlinesServiceAPI)
+	return ret0
+}
+
+// StartTime indicates an expected call of StartTime.
+func (mr *MockKlinesServiceAPIMockRecorder) StartTime(startTime interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartTime", reflect.TypeOf((*MockKlinesServiceAPI)(nil).StartTime), startTime)
+}
+
+// Symbol mocks base method.
+func (m *MockKlinesServiceAPI) Symbol(symbol string) delivery.KlinesServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Symbol", symbol)
+	ret0, _ := ret[0].(delivery.KlinesServiceAPI)
+	return ret0
+}
+
+// Symbol indicates an expected call of Symbol.
+func (mr *MockKlinesServiceAPIMockRecorder) Symbol(symbol interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Symbol", reflect.TypeOf((*MockKlinesServiceAPI)(nil).Symbol), symbol)
+}
+
+// MockListPriceChangeStatsServiceAPI is a mock of ListPriceChangeStatsServiceAPI interface.
+type MockListPriceChangeStatsServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockListPriceChangeStatsServiceAPIMockRecorder
+}
+
+// MockListPriceChangeStatsServiceAPIMockRecorder is the mock recorder for MockListPriceChangeStatsServiceAPI.
+type MockListPriceChangeStatsServiceAPIMockRecorder struct {
+	mock *MockListPriceChangeStatsServiceAPI
+}
+
+// NewMockListPriceChangeStatsServiceAPI creates a new mock instance.
+func NewMockListPriceChangeStatsServiceAPI(ctrl *gomock.Controller) *MockListPriceChangeStatsServiceAPI {
+	mock := &MockListPriceChangeStatsServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockListPriceChangeStatsServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockListPriceChangeStatsServiceAPI) EXPECT() *MockListPriceChangeStatsServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockListPriceChangeStatsServiceAPI) Do(ctx context.Context, opts ...delivery.RequestOption) ([]*delivery.PriceChangeStats, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].([]*delivery.PriceChangeStats)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockListPriceChangeStatsServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockListPriceChangeStatsServiceAPI)(nil).Do), varargs...)
+}
+
+// Pair mocks base method.
+func (m *MockListPriceChangeStatsServiceAPI) Pair(pair string) delivery.ListPriceChangeStatsServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Pair", pair)
+	ret0, _ := ret[0].(delivery.ListPriceChangeStatsServiceAPI)
+	return ret0
+}
+
+// Pair indicates an expected call of Pair.
+func (mr *MockListPriceChangeStatsServiceAPIMockRecorder) Pair(pair interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Pair", reflect.TypeOf((*MockListPriceChangeStatsServiceAPI)(nil).Pair), pair)
+}
+
+// Symbol mocks base method.
+func (m *MockListPriceChangeStatsServiceAPI) Symbol(symbol string) delivery.ListPriceChangeStatsServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Symbol", symbol)
+	ret0, _ := ret[0].(delivery.ListPriceChangeStatsServiceAPI)
+	return ret0
+}
+
+// Symbol indicates an expected call of Symbol.
+func (mr *MockListPriceChangeStatsServiceAPIMockRecorder) Symbol(symbol interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Symbol", reflect.TypeOf((*MockListPriceChangeStatsServiceAPI)(nil).Symbol), symbol)
+}
+
+// MockListPricesServiceAPI is a mock of ListPricesServiceAPI interface.
+type MockListPricesServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockListPricesServiceAPIMockRecorder
+}
+
+// MockListPricesServiceAPIMockRecorder is the mock recorder for MockListPricesServiceAPI.
+type MockListPricesServiceAPIMockRecorder struct {
+	mock *MockListPricesServiceAPI
+}
+
+// NewMockListPricesServiceAPI creates a new mock instance.
+func NewMockListPricesServiceAPI(ctrl *gomock.Controller) *MockListPricesServiceAPI {
+	mock := &MockListPricesServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockListPricesServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockListPricesServiceAPI) EXPECT() *MockListPricesServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockListPricesServiceAPI) Do(ctx context.Context, opts ...delivery.RequestOption) ([]*delivery.SymbolPrice, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].([]*delivery.SymbolPrice)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockListPricesServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockListPricesServiceAPI)(nil).Do), varargs...)
+}
+
+// Pair mocks base method.
+func (m *MockListPricesServiceAPI) Pair(pair string) delivery.ListPricesServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Pair", pair)
+	ret0, _ := ret[0].(delivery.ListPricesServiceAPI)
+	return ret0
+}
+
+// Pair indicates an expected call of Pair.
+func (mr *MockListPricesServiceAPIMockRecorder) Pair(pair interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Pair", reflect.TypeOf((*MockListPricesServiceAPI)(nil).Pair), pair)
+}
+
+// Symbol mocks base method.
+func (m *MockListPricesServiceAPI) Symbol(symbol string) delivery.ListPricesServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Symbol", symbol)
+	ret0, _ := ret[0].(delivery.ListPricesServiceAPI)
+	return ret0
+}
+
+// Symbol indicates an expected call of Symbol.
+func (mr *MockListPricesServiceAPIMockRecorder) Symbol(symbol interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Symbol", reflect.TypeOf((*MockListPricesServiceAPI)(nil).Symbol), symbol)
+}
+
+// MockListBookTickersServiceAPI is a mock of ListBookTickersServiceAPI interface.
+type MockListBookTickersServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockListBookTickersServiceAPIMockRecorder
+}
+
+// MockListBookTickersServiceAPIMockRecorder is the mock recorder for MockListBookTickersServiceAPI.
+type MockListBookTickersServiceAPIMockRecorder struct {
+	mock *MockListBookTickersServiceAPI
+}
+
+// NewMockListBookTickersServiceAPI creates a new mock instance.
+func NewMockListBookTickersServiceAPI(ctrl *gomock.Controller) *MockListBookTickersServiceAPI {
+	mock := &MockListBookTickersServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockListBookTickersServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockListBookTickersServiceAPI) EXPECT() *MockListBookTickersServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockListBookTickersServiceAPI) Do(ctx context.Context, opts ...delivery.RequestOption) ([]*delivery.BookTicker, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].([]*delivery.BookTicker)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockListBookTickersServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockListBookTickersServiceAPI)(nil).Do), varargs...)
+}
+
+// Pair mocks base method.
+func (m *MockListBookTickersServiceAPI) Pair(pair string) delivery.ListBookTickersServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Pair", pair)
+	ret0, _ := ret[0].(delivery.ListBookTickersServiceAPI)
+	return ret0
+}
+
+// Pair indicates an expected call of Pair.
+func (mr *MockListBookTickersServiceAPIMockRecorder) Pair(pair interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Pair", reflect.TypeOf((*MockListBookTickersServiceAPI)(nil).Pair), pair)
+}
+
+// Symbol mocks base method.
+func (m *MockListBookTickersServiceAPI) Symbol(symbol string) delivery.ListBookTickersServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Symbol", symbol)
+	ret0, _ := ret[0].(delivery.ListBookTickersServiceAPI)
+	return ret0
+}
+
+// Symbol indicates an expected call of Symbol.
+func (mr *MockListBookTickersServiceAPIMockRecorder) Symbol(symbol interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Symbol", reflect.TypeOf((*MockListBookTickersServiceAPI)(nil).Symbol), symbol)
+}
+
+// MockStartUserStreamServiceAPI is a mock of StartUserStreamServiceAPI interface.
+type MockStartUserStreamServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockStartUserStreamServiceAPIMockRecorder
+}
+
+// MockStartUserStreamServiceAPIMockRecorder is the mock recorder for MockStartUserStreamServiceAPI.
+type MockStartUserStreamServiceAPIMockRecorder struct {
+	mock *MockStartUserStreamServiceAPI
+}
+
+// NewMockStartUserStreamServiceAPI creates a new mock instance.
+func NewMockStartUserStreamServiceAPI(ctrl *gomock.Controller) *MockStartUserStreamServiceAPI {
+	mock := &MockStartUserStreamServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockStartUserStreamServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockStartUserStreamServiceAPI) EXPECT() *MockStartUserStreamServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockStartUserStreamServiceAPI) Do(ctx context.Context, opts ...delivery.RequestOption) (string, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockStartUserStreamServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockStartUserStreamServiceAPI)(nil).Do), varargs...)
+}
+
+// MockKeepaliveUserStreamServiceAPI is a mock of KeepaliveUserStreamServiceAPI interface.
+type MockKeepaliveUserStreamServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockKeepaliveUserStreamServiceAPIMockRecorder
+}
+
+// MockKeepaliveUserStreamServiceAPIMockRecorder is the mock recorder for MockKeepaliveUserStreamServiceAPI.
+type MockKeepaliveUserStreamServiceAPIMockRecorder struct {
+	mock *MockKeepaliveUserStreamServiceAPI
+}
+
+// NewMockKeepaliveUserStreamServiceAPI creates a new mock instance.
+func NewMockKeepaliveUserStreamServiceAPI(ctrl *gomock.Controller) *MockKeepaliveUserStreamServiceAPI {
+	mock := &MockKeepaliveUserStreamServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockKeepaliveUserStreamServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockKeepaliveUserStreamServiceAPI) EXPECT() *MockKeepaliveUserStreamServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockKeepaliveUserStreamServiceAPI) Do(ctx context.Context, opts ...delivery.RequestOption) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockKeepaliveUserStreamServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockKeepaliveUserStreamServiceAPI)(nil).Do), varargs...)
+}
+
+// ListenKey mocks base method.
+func (m *MockKeepaliveUserStreamServiceAPI) ListenKey(listenKey string) delivery.KeepaliveUserStreamServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListenKey", listenKey)
+	ret0, _ := ret[0].(delivery.KeepaliveUserStreamServiceAPI)
+	return ret0
+}
+
+// ListenKey indicates an expected call of ListenKey.
+func (mr *MockKeepaliveUserStreamServiceAPIMockRecorder) ListenKey(listenKey interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListenKey", reflect.TypeOf((*MockKeepaliveUserStreamServiceAPI)(nil).ListenKey), listenKey)
+}
+
+// MockCloseUserStreamServiceAPI is a mock of CloseUserStreamServiceAPI interface.
+type MockCloseUserStreamServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockCloseUserStreamServiceAPIMockRecorder
+}
+
+// MockCloseUserStreamServiceAPIMockRecorder is the mock recorder for MockCloseUserStreamServiceAPI.
+type MockCloseUserStreamServiceAPIMockRecorder struct {
+	mock *MockCloseUserStreamServiceAPI
+}
+
+// NewMockCloseUserStreamServiceAPI creates a new mock instance.
+func NewMockCloseUserStreamServiceAPI(ctrl *gomock.Controller) *MockCloseUserStreamServiceAPI {
+	mock := &MockCloseUserStreamServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockCloseUserStreamServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCloseUserStreamServiceAPI) EXPECT() *MockCloseUserStreamServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockCloseUserStreamServiceAPI) Do(ctx context.Context, opts ...delivery.RequestOption) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockCloseUserStreamServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockCloseUserStreamServiceAPI)(nil).Do), varargs...)
+}
+
+// ListenKey mocks base method.
+func (m *MockCloseUserStreamServiceAPI) ListenKey(listenKey string) delivery.CloseUserStreamServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListenKey", listenKey)
+	ret0, _ := ret[0].(delivery.CloseUserStreamServiceAPI)
+	return ret0
+}
+
+// ListenKey indicates an expected call of ListenKey.
+func (mr *MockCloseUserStreamServiceAPIMockRecorder) ListenKey(listenKey interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListenKey", reflect.TypeOf((*MockCloseUserStreamServiceAPI)(nil).ListenKey), listenKey)
+}
+
+// MockExchangeInfoServiceAPI is a mock of ExchangeInfoServiceAPI interface.
+type MockExchangeInfoServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockExchangeInfoServiceAPIMockRecorder
+}
+
+// MockExchangeInfoServiceAPIMockRecorder is the mock recorder for MockExchangeInfoServiceAPI.
+type MockExchangeInfoServiceAPIMockRecorder struct {
+	mock *MockExchangeInfoServiceAPI
+}
+
+// NewMockExchangeInfoServiceAPI creates a new mock instance.
+func NewMockExchangeInfoServiceAPI(ctrl *gomock.Controller) *MockExchangeInfoServiceAPI {
+	mock := &MockExchangeInfoServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockExchangeInfoServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockExchangeInfoServiceAPI) EXPECT() *MockExchangeInfoServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockExchangeInfoServiceAPI) Do(ctx context.Context, opts ...delivery.RequestOption) (*delivery.ExchangeInfo, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*delivery.ExchangeInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockExchangeInfoServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockExchangeInfoServiceAPI)(nil).Do), varargs...)
+}
+
+// MockCreateOrderServiceAPI is a mock of CreateOrderServiceAPI interface.
+type MockCreateOrderServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockCreateOrderServiceAPIMockRecorder
+}
+
+// MockCreateOrderServiceAPIMockRecorder is the mock recorder for MockCreateOrderServiceAPI.
+type MockCreateOrderServiceAPIMockRecorder struct {
+	mock *MockCreateOrderServiceAPI
+}
+
+// NewMockCreateOrderServiceAPI creates a new mock instance.
+func NewMockCreateOrderServiceAPI(ctrl *gomock.Controller) *MockCreateOrderServiceAPI {
+	mock := &MockCreateOrderServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockCreateOrderServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCreateOrderServiceAPI) EXPECT() *MockCreateOrderServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// ActivationPrice mocks base method.
+func (m *MockCreateOrderServiceAPI) ActivationPrice(activationPrice string) delivery.CreateOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ActivationPrice", activationPrice)
+	ret0, _ := ret[0].(delivery.CreateOrderServiceAPI)
+	return ret0
+}
+
+// ActivationPrice indicates an expected call of ActivationPrice.
+func (mr *MockCreateOrderServiceAPIMockRecorder) ActivationPrice(activationPrice interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ActivationPrice", reflect.TypeOf((*MockCreateOrderServiceAPI)(nil).ActivationPrice), activationPrice)
+}
+
+// CallbackRate mocks base method.
+func (m *MockCreateOrderServiceAPI) CallbackRate(callbackRate string) delivery.CreateOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CallbackRate", callbackRate)
+	ret0, _ := ret[0].(delivery.CreateOrderServiceAPI)
+	return ret0
+}
+
+// CallbackRate indicates an expected call of CallbackRate.
+func (mr *MockCreateOrderServiceAPIMockRecorder) CallbackRate(callbackRate interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CallbackRate", reflect.TypeOf((*MockCreateOrderServiceAPI)(nil).CallbackRate), callbackRate)
+}
+
+// ClosePosition mocks base method.
+func (m *MockCreateOrderServiceAPI) ClosePosition(closePosition bool) delivery.CreateOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClosePosition", closePosition)
+	ret0, _ := ret[0].(delivery.CreateOrderServiceAPI)
+	return ret0
+}
+
+// ClosePosition indicates an expected call of ClosePosition.
+func (mr *MockCreateOrderServiceAPIMockRecorder) ClosePosition(closePosition interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClosePosition", reflect.TypeOf((*MockCreateOrderServiceAPI)(nil).ClosePosition), closePosition)
+}
+
+// Do mocks base method.
+func (m *MockCreateOrderServiceAPI) Do(ctx context.Context, opts ...delivery.RequestOption) (*delivery.CreateOrderResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*delivery.CreateOrderResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockCreateOrderServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockCreateOrderServiceAPI)(nil).Do), varargs...)
+}
+
+// NewClientOrderID mocks base method.
+func (m *MockCreateOrderServiceAPI) NewClientOrderID(newClientOrderID string) delivery.CreateOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewClientOrderID", newClientOrderID)
+	ret0, _ := ret[0].(delivery.CreateOrderServiceAPI)
+	return ret0
+}
+
+// NewClientOrderID indicates an expected call of NewClientOrderID.
+func (mr *MockCreateOrderServiceAPIMockRecorder) NewClientOrderID(newClientOrderID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewClientOrderID", reflect.TypeOf((*MockCreateOrderServiceAPI)(nil).NewClientOrderID), newClientOrderID)
+}
+
+// NewOrderResponseType mocks base method.
+func (m *MockCreateOrderServiceAPI) NewOrderResponseType(newOrderResponseType delivery.NewOrderRespType) delivery.CreateOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewOrderResponseType", newOrderResponseType)
+	ret0, _ := ret[0].(delivery.CreateOrderServiceAPI)
+	return ret0
+}
+
+// NewOrderResponseType indicates an expected call of NewOrderResponseType.
+func (mr *MockCreateOrderServiceAPIMockRecorder) NewOrderResponseType(newOrderResponseType interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewOrderResponseType", reflect.TypeOf((*MockCreateOrderServiceAPI)(nil).NewOrderResponseType), newOrderResponseType)
+}
+
+// PositionSide mocks base method.
+func (m *MockCreateOrderServiceAPI) PositionSide(positionSide delivery.PositionSideType) delivery.CreateOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PositionSide", positionSide)
+	ret0, _ := ret[0].(delivery.CreateOrderServiceAPI)
+	return ret0
+}
+
+// PositionSide indicates an expected call of PositionSide.
+func (mr *MockCreateOrderServiceAPIMockRecorder) PositionSide(positionSide interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PositionSide", reflect.TypeOf((*MockCreateOrderServiceAPI)(nil).PositionSide), positionSide)
+}
+
+// Price mocks base method.
+func (m *MockCreateOrderServiceAPI) Price(price string) delivery.CreateOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Price", price)
+	ret0, _ := ret[0].(delivery.CreateOrderServiceAPI)
+	return ret0
+}
+
+// Price indicates an expected call of Price.
+func (mr *MockCreateOrderServiceAPIMockRecorder) Price(price interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Price", reflect.TypeOf((*MockCreateOrderServiceAPI)(nil).Price), price)
+}
+
+// PriceMatch mocks base method.
+func (m *MockCreateOrderServiceAPI) PriceMatch(priceMatch delivery.PriceMatchType) delivery.CreateOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PriceMatch", priceMatch)
+	ret0, _ := ret[0].(delivery.CreateOrderServiceAPI)
+	return ret0
+}
+
+// PriceMatch indicates an expected call of PriceMatch.
+func (mr *MockCreateOrderServiceAPIMockRecorder) PriceMatch(priceMatch interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PriceMatch", reflect.TypeOf((*MockCreateOrderServiceAPI)(nil).PriceMatch), priceMatch)
+}
+
+// PriceProtect mocks base method.
+func (m *MockCreateOrderServiceAPI) PriceProtect(priceProtect bool) delivery.CreateOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PriceProtect", priceProtect)
+	ret0, _ := ret[0].(delivery.CreateOrderServiceAPI)
+	return ret0
+}
+
+// PriceProtect indicates an expected call of PriceProtect.
+func (mr *MockCreateOrderServiceAPIMockRecorder) PriceProtect(priceProtect interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PriceProtect", reflect.TypeOf((*MockCreateOrderServiceAPI)(nil).PriceProtect), priceProtect)
+}
+
+// Quantity mocks base method.
+func (m *MockCreateOrderServiceAPI) Quantity(quantity string) delivery.CreateOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Quantity", quantity)
+	ret0, _ := ret[0].(delivery.CreateOrderServiceAPI)
+	return ret0
+}
+
+// Quantity indicates an expected call of Quantity.
+func (mr *MockCreateOrderServiceAPIMockRecorder) Quantity(quantity interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Quantity", reflect.TypeOf((*MockCreateOrderServiceAPI)(nil).Quantity), quantity)
+}
+
+// ReduceOnly mocks base method.
+func (m *MockCreateOrderServiceAPI) ReduceOnly(reduceOnly bool) delivery.CreateOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReduceOnly", reduceOnly)
+	ret0, _ := ret[0].(delivery.CreateOrderServiceAPI)
+	return ret0
+}
+
+// ReduceOnly indicates an expected call of ReduceOnly.
+func (mr *MockCreateOrderServiceAPIMockRecorder) ReduceOnly(reduceOnly interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReduceOnly", reflect.TypeOf((*MockCreateOrderServiceAPI)(nil).ReduceOnly), reduceOnly)
+}
+
+// SelfTradePreventionMode mocks base method.
+func (m *MockCreateOrderServiceAPI) SelfTradePreventionMode(selfTradePreventionMode delivery.SelfTradePreventionMode) delivery.CreateOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SelfTradePreventionMode", selfTradePreventionMode)
+	ret0, _ := ret[0].(delivery.CreateOrderServiceAPI)
+	return ret0
+}
+
+// SelfTradePreventionMode indicates an expected call of SelfTradePreventionMode.
+func (mr *MockCreateOrderServiceAPIMockRecorder) SelfTradePreventionMode(selfTradePreventionMode interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelfTradePreventionMode", reflect.TypeOf((*MockCreateOrderServiceAPI)(nil).SelfTradePreventionMode), selfTradePreventionMode)
+}
+
+// Side mocks base method.
+func (m *MockCreateOrderServiceAPI) Side(side delivery.SideType) delivery.CreateOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Side", side)
+	ret0, _ := ret[0].(delivery.CreateOrderServiceAPI)
+	return ret0
+}
+
+// Side indicates an expected call of Side.
+func (mr *MockCreateOrderServiceAPIMockRecorder) Side(side interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Side", reflect.TypeOf((*MockCreateOrderServiceAPI)(nil).Side), side)
+}
+
+// StopPrice mocks base method.
+func (m *MockCreateOrderServiceAPI) StopPrice(stopPrice string) delivery.CreateOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StopPrice", stopPrice)
+	ret0, _ := ret[0].(delivery.CreateOrderServiceAPI)
+	return ret0
+}
+
+// StopPrice indicates an expected call of StopPrice.
+func (mr *MockCreateOrderServiceAPIMockRecorder) StopPrice(stopPrice interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StopPrice", reflect.TypeOf((*MockCreateOrderServiceAPI)(nil).StopPrice), stopPrice)
+}
+
+// Symbol mocks base method.
+func (m *MockCreateOrderServiceAPI) Symbol(symbol string) delivery.CreateOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Symbol", symbol)
+	ret0, _ := ret[0].(delivery.CreateOrderServiceAPI)
+	return ret0
+}
+
+// Symbol indicates an expected call of Symbol.
+func (mr *MockCreateOrderServiceAPIMockRecorder) Symbol(symbol interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Symbol", reflect.TypeOf((*MockCreateOrderServiceAPI)(nil).Symbol), symbol)
+}
+
+// TimeInForce mocks base method.
+func (m *MockCreateOrderServiceAPI) TimeInForce(timeInForce delivery.TimeInForceType) delivery.CreateOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TimeInForce", timeInForce)
+	ret0, _ := ret[0].(delivery.CreateOrderServiceAPI)
+	return ret0
+}
+
+// TimeInForce indicates an expected call of TimeInForce.
+func (mr *MockCreateOrderServiceAPIMockRecorder) TimeInForce(timeInForce interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TimeInForce", reflect.TypeOf((*MockCreateOrderServiceAPI)(nil).TimeInForce), timeInForce)
+}
+
+// Type mocks base method.
+func (m *MockCreateOrderServiceAPI) Type(orderType delivery.OrderType) delivery.CreateOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Type", orderType)
+	ret0, _ := ret[0].(delivery.CreateOrderServiceAPI)
+	return ret0
+}
+
+// Type indicates an expected call of Type.
+func (mr *MockCreateOrderServiceAPIMockRecorder) Type(orderType interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Type", reflect.TypeOf((*MockCreateOrderServiceAPI)(nil).Type), orderType)
+}
+
+// WorkingType mocks base method.
+func (m *MockCreateOrderServiceAPI) WorkingType(workingType delivery.WorkingType) delivery.CreateOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WorkingType", workingType)
+	ret0, _ := ret[0].(delivery.CreateOrderServiceAPI)
+	return ret0
+}
+
+// WorkingType indicates an expected call of WorkingType.
+func (mr *MockCreateOrderServiceAPIMockRecorder) WorkingType(workingType interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WorkingType", reflect.TypeOf((*MockCreateOrderServiceAPI)(nil).WorkingType), workingType)
+}
+
+// MockModifyOrderServiceAPI is a mock of ModifyOrderServiceAPI interface.
+type MockModifyOrderServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockModifyOrderServiceAPIMockRecorder
+}
+
+// MockModifyOrderServiceAPIMockRecorder is the mock recorder for MockModifyOrderServiceAPI.
+type MockModifyOrderServiceAPIMockRecorder struct {
+	mock *MockModifyOrderServiceAPI
+}
+
+// NewMockModifyOrderServiceAPI creates a new mock instance.
+func NewMockModifyOrderServiceAPI(ctrl *gomock.Controller) *MockModifyOrderServiceAPI {
+	mock := &MockModifyOrderServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockModifyOrderServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockModifyOrderServiceAPI) EXPECT() *MockModifyOrderServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockModifyOrderServiceAPI) Do(ctx context.Context, opts ...delivery.RequestOption) (*delivery.Order, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*delivery.Order)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockModifyOrderServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockModifyOrderServiceAPI)(nil).Do), varargs...)
+}
+
+// OrderID mocks base method.
+func (m *MockModifyOrderServiceAPI) OrderID(orderID int64) delivery.ModifyOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OrderID", orderID)
+	ret0, _ := ret[0].(delivery.ModifyOrderServiceAPI)
+	return ret0
+}
+
+// OrderID indicates an expected call of OrderID.
+func (mr *MockModifyOrderServiceAPIMockRecorder) OrderID(orderID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OrderID", reflect.TypeOf((*MockModifyOrderServiceAPI)(nil).OrderID), orderID)
+}
+
+// OrigClientOrderID mocks base method.
+func (m *MockModifyOrderServiceAPI) OrigClientOrderID(origClientOrderID string) delivery.ModifyOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OrigClientOrderID", origClientOrderID)
+	ret0, _ := ret[0].(delivery.ModifyOrderServiceAPI)
+	return ret0
+}
+
+// OrigClientOrderID indicates an expected call of OrigClientOrderID.
+func (mr *MockModifyOrderServiceAPIMockRecorder) OrigClientOrderID(origClientOrderID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OrigClientOrderID", reflect.TypeOf((*MockModifyOrderServiceAPI)(nil).OrigClientOrderID), origClientOrderID)
+}
+
+// Price mocks base method.
+func (m *MockModifyOrderServiceAPI) Price(price string) delivery.ModifyOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Price", price)
+	ret0, _ := ret[0].(delivery.ModifyOrderServiceAPI)
+	return ret0
+}
+
+// Price indicates an expected call of Price.
+func (mr *MockModifyOrderServiceAPIMockRecorder) Price(price interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Price", reflect.TypeOf((*MockModifyOrderServiceAPI)(nil).Price), price)
+}
+
+// PriceMatch mocks base method.
+func (m *MockModifyOrderServiceAPI) PriceMatch(priceMatch delivery.PriceMatchType) delivery.ModifyOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PriceMatch", priceMatch)
+	ret0, _ := ret[0].(delivery.ModifyOrderServiceAPI)
+	return ret0
+}
+
+// PriceMatch indicates an expected call of PriceMatch.
+func (mr *MockModifyOrderServiceAPIMockRecorder) PriceMatch(priceMatch interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PriceMatch", reflect.TypeOf((*MockModifyOrderServiceAPI)(nil).PriceMatch), priceMatch)
+}
+
+// Quantity mocks base method.
+func (m *MockModifyOrderServiceAPI) Quantity(quantity string) delivery.ModifyOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Quantity", quantity)
+	ret0, _ := ret[0].(delivery.ModifyOrderServiceAPI)
+	return ret0
+}
+
+// Quantity indicates an expected call of Quantity.
+func (mr *MockModifyOrderServiceAPIMockRecorder) Quantity(quantity interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Quantity", reflect.TypeOf((*MockModifyOrderServiceAPI)(nil).Quantity), quantity)
+}
+
+// Side mocks base method.
+func (m *MockModifyOrderServiceAPI) Side(side delivery.SideType) delivery.ModifyOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Side", side)
+	ret0, _ := ret[0].(delivery.ModifyOrderServiceAPI)
+	return ret0
+}
+
+// Side indicates an expected call of Side.
+func (mr *MockModifyOrderServiceAPIMockRecorder) Side(side interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Side", reflect.TypeOf((*MockModifyOrderServiceAPI)(nil).Side), side)
+}
+
+// Symbol mocks base method.
+func (m *MockModifyOrderServiceAPI) Symbol(symbol string) delivery.ModifyOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Symbol", symbol)
+	ret0, _ := ret[0].(delivery.ModifyOrderServiceAPI)
+	return ret0
+}
+
+// Symbol indicates an expected call of Symbol.
+func (mr *MockModifyOrderServiceAPIMockRecorder) Symbol(symbol interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Symbol", reflect.TypeOf((*MockModifyOrderServiceAPI)(nil).Symbol), symbol)
+}
+
+// MockCreateBatchOrdersServiceAPI is a mock of CreateBatchOrdersServiceAPI interface.
+type MockCreateBatchOrdersServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockCreateBatchOrdersServiceAPIMockRecorder
+}
+
+// MockCreateBatchOrdersServiceAPIMockRecorder is the mock recorder for MockCreateBatchOrdersServiceAPI.
+type MockCreateBatchOrdersServiceAPIMockRecorder struct {
+	mock *MockCreateBatchOrdersServiceAPI
+}
+
+// NewMockCreateBatchOrdersServiceAPI creates a new mock instance.
+func NewMockCreateBatchOrdersServiceAPI(ctrl *gomock.Controller) *MockCreateBatchOrdersServiceAPI {
+	mock := &MockCreateBatchOrdersServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockCreateBatchOrdersServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCreateBatchOrdersServiceAPI) EXPECT() *MockCreateBatchOrdersServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockCreateBatchOrdersServiceAPI) Do(ctx context.Context, opts ...delivery.RequestOption) (*delivery.CreateBatchOrdersResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*delivery.CreateBatchOrdersResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockCreateBatchOrdersServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockCreateBatchOrdersServiceAPI)(nil).Do), varargs...)
+}
+
+// OrderList mocks base method.
+func (m *MockCreateBatchOrdersServiceAPI) OrderList(orders []*delivery.CreateOrderService) delivery.CreateBatchOrdersServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OrderList", orders)
+	ret0, _ := ret[0].(delivery.CreateBatchOrdersServiceAPI)
+	return ret0
+}
+
+// OrderList indicates an expected call of OrderList.
+func (mr *MockCreateBatchOrdersServiceAPIMockRecorder) OrderList(orders interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OrderList", reflect.TypeOf((*MockCreateBatchOrdersServiceAPI)(nil).OrderList), orders)
+}
+
+// MockModifyBatchOrdersServiceAPI is a mock of ModifyBatchOrdersServiceAPI interface.
+type MockModifyBatchOrdersServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockModifyBatchOrdersServiceAPIMockRecorder
+}
+
+// MockModifyBatchOrdersServiceAPIMockRecorder is the mock recorder for MockModifyBatchOrdersServiceAPI.
+type MockModifyBatchOrdersServiceAPIMockRecorder struct {
+	mock *MockModifyBatchOrdersServiceAPI
+}
+
+// NewMockModifyBatchOrdersServiceAPI creates a new mock instance.
+func NewMockModifyBatchOrdersServiceAPI(ctrl *gomock.Controller) *MockModifyBatchOrdersServiceAPI {
+	mock := &MockModifyBatchOrdersServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockModifyBatchOrdersServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockModifyBatchOrdersServiceAPI) EXPECT() *MockModifyBatchOrdersServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockModifyBatchOrdersServiceAPI) Do(ctx context.Context, opts ...delivery.RequestOption) (*delivery.ModifyBatchOrdersResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*delivery.ModifyBatchOrdersResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockModifyBatchOrdersServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockModifyBatchOrdersServiceAPI)(nil).Do), varargs...)
+}
+
+// OrderList mocks base method.
+func (m *MockModifyBatchOrdersServiceAPI) OrderList(orders []*delivery.ModifyOrder) delivery.ModifyBatchOrdersServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OrderList", orders)
+	ret0, _ := ret[0].(delivery.ModifyBatchOrdersServiceAPI)
+	return ret0
+}
+
+// OrderList indicates an expected call of OrderList.
+func (mr *MockModifyBatchOrdersServiceAPIMockRecorder) OrderList(orders interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OrderList", reflect.TypeOf((*MockModifyBatchOrdersServiceAPI)(nil).OrderList), orders)
+}
+
+// MockGetOrderServiceAPI is a mock of GetOrderServiceAPI interface.
+type MockGetOrderServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockGetOrderServiceAPIMockRecorder
+}
+
+// MockGetOrderServiceAPIMockRecorder is the mock recorder for MockGetOrderServiceAPI.
+type MockGetOrderServiceAPIMockRecorder struct {
+	mock *MockGetOrderServiceAPI
+}
+
+// NewMockGetOrderServiceAPI creates a new mock instance.
+func NewMockGetOrderServiceAPI(ctrl *gomock.Controller) *MockGetOrderServiceAPI {
+	mock := &MockGetOrderServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockGetOrderServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGetOrderServiceAPI) EXPECT() *MockGetOrderServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockGetOrderServiceAPI) Do(ctx context.Context, opts ...delivery.RequestOption) (*delivery.Order, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*delivery.Order)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockGetOrderServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockGetOrderServiceAPI)(nil).Do), varargs...)
+}
+
+// OrderID mocks base method.
+func (m *MockGetOrderServiceAPI) OrderID(orderID int64) delivery.GetOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OrderID", orderID)
+	ret0, _ := ret[0].(delivery.GetOrderServiceAPI)
+	return ret0
+}
+
+// OrderID indicates an expected call of OrderID.
+func (mr *MockGetOrderServiceAPIMockRecorder) OrderID(orderID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OrderID", reflect.TypeOf((*MockGetOrderServiceAPI)(nil).OrderID), orderID)
+}
+
+// OrigClientOrderID mocks base method.
+func (m *MockGetOrderServiceAPI) OrigClientOrderID(origClientOrderID string) delivery.GetOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OrigClientOrderID", origClientOrderID)
+	ret0, _ := ret[0].(delivery.GetOrderServiceAPI)
+	return ret0
+}
+
+// OrigClientOrderID indicates an expected call of OrigClientOrderID.
+func (mr *MockGetOrderServiceAPIMockRecorder) OrigClientOrderID(origClientOrderID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OrigClientOrderID", reflect.TypeOf((*MockGetOrderServiceAPI)(nil).OrigClientOrderID), origClientOrderID)
+}
+
+// Symbol mocks base method.
+func (m *MockGetOrderServiceAPI) Symbol(symbol string) delivery.GetOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Symbol", symbol)
+	ret0, _ := ret[0].(delivery.GetOrderServiceAPI)
+	return ret0
+}
+
+// Symbol indicates an expected call of Symbol.
+func (mr *MockGetOrderServiceAPIMockRecorder) Symbol(symbol interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Symbol", reflect.TypeOf((*MockGetOrderServiceAPI)(nil).Symbol), symbol)
+}
+
+// MockCancelOrderServiceAPI is a mock of CancelOrderServiceAPI interface.
+type MockCancelOrderServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockCancelOrderServiceAPIMockRecorder
+}
+
+// MockCancelOrderServiceAPIMockRecorder is the mock recorder for MockCancelOrderServiceAPI.
+type MockCancelOrderServiceAPIMockRecorder struct {
+	mock *MockCancelOrderServiceAPI
+}
+
+// NewMockCancelOrderServiceAPI creates a new mock instance.
+func NewMockCancelOrderServiceAPI(ctrl *gomock.Controller) *MockCancelOrderServiceAPI {
+	mock := &MockCancelOrderServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockCancelOrderServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCancelOrderServiceAPI) EXPECT() *MockCancelOrderServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockCancelOrderServiceAPI) Do(ctx context.Context, opts ...delivery.RequestOption) (*delivery.CancelOrderResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*delivery.CancelOrderResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockCancelOrderServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockCancelOrderServiceAPI)(nil).Do), varargs...)
+}
+
+// OrderID mocks base method.
+func (m *MockCancelOrderServiceAPI) OrderID(orderID int64) delivery.CancelOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OrderID", orderID)
+	ret0, _ := ret[0].(delivery.CancelOrderServiceAPI)
+	return ret0
+}
+
+// OrderID indicates an expected call of OrderID.
+func (mr *MockCancelOrderServiceAPIMockRecorder) OrderID(orderID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OrderID", reflect.TypeOf((*MockCancelOrderServiceAPI)(nil).OrderID), orderID)
+}
+
+// OrigClientOrderID mocks base method.
+func (m *MockCancelOrderServiceAPI) OrigClientOrderID(origClientOrderID string) delivery.CancelOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OrigClientOrderID", origClientOrderID)
+	ret0, _ := ret[0].(delivery.CancelOrderServiceAPI)
+	return ret0
+}
+
+// OrigClientOrderID indicates an expected call of OrigClientOrderID.
+func (mr *MockCancelOrderServiceAPIMockRecorder) OrigClientOrderID(origClientOrderID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OrigClientOrderID", reflect.TypeOf((*MockCancelOrderServiceAPI)(nil).OrigClientOrderID), origClientOrderID)
+}
+
+// Symbol mocks base method.
+func (m *MockCancelOrderServiceAPI) Symbol(symbol string) delivery.CancelOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Symbol", symbol)
+	ret0, _ := ret[0].(delivery.CancelOrderServiceAPI)
+	return ret0
+}
+
+// Symbol indicates an expected call of Symbol.
+func (mr *MockCancelOrderServiceAPIMockRecorder) Symbol(symbol interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Symbol", reflect.TypeOf((*MockCancelOrderServiceAPI)(nil).Symbol), symbol)
+}
+
+// MockCancelAllOpenOrdersServiceAPI is a mock of CancelAllOpenOrdersServiceAPI interface.
+type MockCancelAllOpenOrdersServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockCancelAllOpenOrdersServiceAPIMockRecorder
+}
+
+// MockCancelAllOpenOrdersServiceAPIMockRecorder is the mock recorder for MockCancelAllOpenOrdersServiceAPI.
+type MockCancelAllOpenOrdersServiceAPIMockRecorder struct {
+	mock *MockCancelAllOpenOrdersServiceAPI
+}
+
+// NewMockCancelAllOpenOrdersServiceAPI creates a new mock instance.
+func NewMockCancelAllOpenOrdersServiceAPI(ctrl *gomock.Controller) *MockCancelAllOpenOrdersServiceAPI {
+	mock := &MockCancelAllOpenOrdersServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockCancelAllOpenOrdersServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCancelAllOpenOrdersServiceAPI) EXPECT() *MockCancelAllOpenOrdersServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockCancelAllOpenOrdersServiceAPI) Do(ctx context.Context, opts ...delivery.RequestOption) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockCancelAllOpenOrdersServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockCancelAllOpenOrdersServiceAPI)(nil).Do), varargs...)
+}
+
+// Symbol mocks base method.
+func (m *MockCancelAllOpenOrdersServiceAPI) Symbol(symbol string) delivery.CancelAllOpenOrdersServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Symbol", symbol)
+	ret0, _ := ret[0].(delivery.CancelAllOpenOrdersServiceAPI)
+	return ret0
+}
+
+// Symbol indicates an expected call of Symbol.
+func (mr *MockCancelAllOpenOrdersServiceAPIMockRecorder) Symbol(symbol interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Symbol", reflect.TypeOf((*MockCancelAllOpenOrdersServiceAPI)(nil).Symbol), symbol)
+}
+
+// MockCancelMultiplesOrdersServiceAPI is a mock of CancelMultiplesOrdersServiceAPI interface.
+type MockCancelMultiplesOrdersServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockCancelMultiplesOrdersServiceAPIMockRecorder
+}
+
+// MockCancelMultiplesOrdersServiceAPIMockRecorder is the mock recorder for MockCancelMultiplesOrdersServiceAPI.
+type MockCancelMultiplesOrdersServiceAPIMockRecorder struct {
+	mock *MockCancelMultiplesOrdersServiceAPI
+}
+
+// NewMockCancelMultiplesOrdersServiceAPI creates a new mock instance.
+func NewMockCancelMultiplesOrdersServiceAPI(ctrl *gomock.Controller) *MockCancelMultiplesOrdersServiceAPI {
+	mock := &MockCancelMultiplesOrdersServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockCancelMultiplesOrdersServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCancelMultiplesOrdersServiceAPI) EXPECT() *MockCancelMultiplesOrdersServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockCancelMultiplesOrdersServiceAPI) Do(ctx context.Context, opts ...delivery.RequestOption) ([]*delivery.CancelOrderResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].([]*delivery.CancelOrderResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockCancelMultiplesOrdersServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockCancelMultiplesOrdersServiceAPI)(nil).Do), varargs...)
+}
+
+// OrderIDList mocks base method.
+func (m *MockCancelMultiplesOrdersServiceAPI) OrderIDList(orderIDList []int64) delivery.CancelMultiplesOrdersServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OrderIDList", orderIDList)
+	ret0, _ := ret[0].(delivery.CancelMultiplesOrdersServiceAPI)
+	return ret0
+}
+
+// OrderIDList indicates an expected call of OrderIDList.
+func (mr *MockCancelMultiplesOrdersServiceAPIMockRecorder) OrderIDList(orderIDList interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OrderIDList", reflect.TypeOf((*MockCancelMultiplesOrdersServiceAPI)(nil).OrderIDList), orderIDList)
+}
+
+// OrigClientOrderIDList mocks base method.
+func (m *MockCancelMultiplesOrdersServiceAPI) OrigClientOrderIDList(origClientOrderIDList []string) delivery.CancelMultiplesOrdersServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OrigClientOrderIDList", origClientOrderIDList)
+	ret0, _ := ret[0].(delivery.CancelMultiplesOrdersServiceAPI)
+	return ret0
+}
+
+// OrigClientOrderIDList indicates an expected call of OrigClientOrderIDList.
+func (mr *MockCancelMultiplesOrdersServiceAPIMockRecorder) OrigClientOrderIDList(origClientOrderIDList interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OrigClientOrderIDList", reflect.TypeOf((*MockCancelMultiplesOrdersServiceAPI)(nil).OrigClientOrderIDList), origClientOrderIDList)
+}
+
+// Symbol mocks base method.
+func (m *MockCancelMultiplesOrdersServiceAPI) Symbol(symbol string) delivery.CancelMultiplesOrdersServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Symbol", symbol)
+	ret0, _ := ret[0].(delivery.CancelMultiplesOrdersServiceAPI)
+	return ret0
+}
+
+// Symbol indicates an expected call of Symbol.
+func (mr *MockCancelMultiplesOrdersServiceAPIMockRecorder) Symbol(symbol interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Symbol", reflect.TypeOf((*MockCancelMultiplesOrdersServiceAPI)(nil).Symbol), symbol)
+}
+
+// MockCountdownCancelAllServiceAPI is a mock of CountdownCancelAllServiceAPI interface.
+type MockCountdownCancelAllServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockCountdownCancelAllServiceAPIMockRecorder
+}
+
+// MockCountdownCancelAllServiceAPIMockRecorder is the mock recorder for MockCountdownCancelAllServiceAPI.
+type MockCountdownCancelAllServiceAPIMockRecorder struct {
+	mock *MockCountdownCancelAllServiceAPI
+}
+
+// NewMockCountdownCancelAllServiceAPI creates a new mock instance.
+func NewMockCountdownCancelAllServiceAPI(ctrl *gomock.Controller) *MockCountdownCancelAllServiceAPI {
+	mock := &MockCountdownCancelAllServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockCountdownCancelAllServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCountdownCancelAllServiceAPI) EXPECT() *MockCountdownCancelAllServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// CountdownTime mocks base method.
+func (m *MockCountdownCancelAllServiceAPI) CountdownTime(countdownTime int64) delivery.CountdownCancelAllServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountdownTime", countdownTime)
+	ret0, _ := ret[0].(delivery.CountdownCancelAllServiceAPI)
+	return ret0
+}
+
+// CountdownTime indicates an expected call of CountdownTime.
+func (mr *MockCountdownCancelAllServiceAPIMockRecorder) CountdownTime(countdownTime interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountdownTime", reflect.TypeOf((*MockCountdownCancelAllServiceAPI)(nil).CountdownTime), countdownTime)
+}
+
+// Do mocks base method.
+func (m *MockCountdownCancelAllServiceAPI) Do(ctx context.Context, opts ...delivery.RequestOption) (*delivery.CountdownCancelAllResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*delivery.CountdownCancelAllResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockCountdownCancelAllServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockCountdownCancelAllServiceAPI)(nil).Do), varargs...)
+}
+
+// Symbol mocks base method.
+func (m *MockCountdownCancelAllServiceAPI) Symbol(symbol string) delivery.CountdownCancelAllServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Symbol", symbol)
+	ret0, _ := ret[0].(delivery.CountdownCancelAllServiceAPI)
+	return ret0
+}
+
+// Symbol indicates an expected call of Symbol.
+func (mr *MockCountdownCancelAllServiceAPIMockRecorder) Symbol(symbol interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Symbol", reflect.TypeOf((*MockCountdownCancelAllServiceAPI)(nil).Symbol), symbol)
+}
+
+// MockGetOpenOrderServiceAPI is a mock of GetOpenOrderServiceAPI interface.
+type MockGetOpenOrderServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockGetOpenOrderServiceAPIMockRecorder
+}
+
+// MockGetOpenOrderServiceAPIMockRecorder is the mock recorder for MockGetOpenOrderServiceAPI.
+type MockGetOpenOrderServiceAPIMockRecorder struct {
+	mock *MockGetOpenOrderServiceAPI
+}
+
+// NewMockGetOpenOrderServiceAPI creates a new mock instance.
+func NewMockGetOpenOrderServiceAPI(ctrl *gomock.Controller) *MockGetOpenOrderServiceAPI {
+	mock := &MockGetOpenOrderServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockGetOpenOrderServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGetOpenOrderServiceAPI) EXPECT() *MockGetOpenOrderServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockGetOpenOrderServiceAPI) Do(ctx context.Context, opts ...delivery.RequestOption) (*delivery.Order, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*delivery.Order)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockGetOpenOrderServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockGetOpenOrderServiceAPI)(nil).Do), varargs...)
+}
+
+// OrderID mocks base method.
+func (m *MockGetOpenOrderServiceAPI) OrderID(orderID int64) delivery.GetOpenOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OrderID", orderID)
+	ret0, _ := ret[0].(delivery.GetOpenOrderServiceAPI)
+	return ret0
+}
+
+// OrderID indicates an expected call of OrderID.
+func (mr *MockGetOpenOrderServiceAPIMockRecorder) OrderID(orderID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OrderID", reflect.TypeOf((*MockGetOpenOrderServiceAPI)(nil).OrderID), orderID)
+}
+
+// OrigClientOrderID mocks base method.
+func (m *MockGetOpenOrderServiceAPI) OrigClientOrderID(origClientOrderID string) delivery.GetOpenOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OrigClientOrderID", origClientOrderID)
+	ret0, _ := ret[0].(delivery.GetOpenOrderServiceAPI)
+	return ret0
+}
+
+// OrigClientOrderID indicates an expected call of OrigClientOrderID.
+func (mr *MockGetOpenOrderServiceAPIMockRecorder) OrigClientOrderID(origClientOrderID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OrigClientOrderID", reflect.TypeOf((*MockGetOpenOrderServiceAPI)(nil).OrigClientOrderID), origClientOrderID)
+}
+
+// Symbol mocks base method.
+func (m *MockGetOpenOrderServiceAPI) Symbol(symbol string) delivery.GetOpenOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Symbol", symbol)
+	ret0, _ := ret[0].(delivery.GetOpenOrderServiceAPI)
+	return ret0
+}
+
+// Symbol indicates an expected call of Symbol.
+func (mr *MockGetOpenOrderServiceAPIMockRecorder) Symbol(symbol interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Symbol", reflect.TypeOf((*MockGetOpenOrderServiceAPI)(nil).Symbol), symbol)
+}
+
+// MockListOpenOrdersServiceAPI is a mock of ListOpenOrdersServiceAPI interface.
+type MockListOpenOrdersServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockListOpenOrdersServiceAPIMockRecorder
+}
+
+// MockListOpenOrdersServiceAPIMockRecorder is the mock recorder for MockListOpenOrdersServiceAPI.
+type MockListOpenOrdersServiceAPIMockRecorder struct {
+	mock *MockListOpenOrdersServiceAPI
+}
+
+// NewMockListOpenOrdersServiceAPI creates a new mock instance.
+func NewMockListOpenOrdersServiceAPI(ctrl *gomock.Controller) *MockListOpenOrdersServiceAPI {
+	mock := &MockListOpenOrdersServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockListOpenOrdersServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockListOpenOrdersServiceAPI) EXPECT() *MockListOpenOrdersServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockListOpenOrdersServiceAPI) Do(ctx context.Context, opts ...delivery.RequestOption) ([]*delivery.Order, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].([]*delivery.Order)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockListOpenOrdersServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockListOpenOrdersServiceAPI)(nil).Do), varargs...)
+}
+
+// Pair mocks base method.
+func (m *MockListOpenOrdersServiceAPI) Pair(pair string) delivery.ListOpenOrdersServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Pair", pair)
+	ret0, _ := ret[0].(delivery.ListOpenOrdersServiceAPI)
+	return ret0
+}
+
+// Pair indicates an expected call of Pair.
+func (mr *MockListOpenOrdersServiceAPIMockRecorder) Pair(pair interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Pair", reflect.TypeOf((*MockListOpenOrdersServiceAPI)(nil).Pair), pair)
+}
+
+// Symbol mocks base method.
+func (m *MockListOpenOrdersServiceAPI) Symbol(symbol string) delivery.ListOpenOrdersServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Symbol", symbol)
+	ret0, _ := ret[0].(delivery.ListOpenOrdersServiceAPI)
+	return ret0
+}
+
+// Symbol indicates an expected call of Symbol.
+func (mr *MockListOpenOrdersServiceAPIMockRecorder) Symbol(symbol interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Symbol", reflect.TypeOf((*MockListOpenOrdersServiceAPI)(nil).Symbol), symbol)
+}
+
+// MockListOrdersServiceAPI is a mock of ListOrdersServiceAPI interface.
+type MockListOrdersServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockListOrdersServiceAPIMockRecorder
+}
+
+// MockListOrdersServiceAPIMockRecorder is the mock recorder for MockListOrdersServiceAPI.
+type MockListOrdersServiceAPIMockRecorder struct {
+	mock *MockListOrdersServiceAPI
+}
+
+// NewMockListOrdersServiceAPI creates a new mock instance.
+func NewMockListOrdersServiceAPI(ctrl *gomock.Controller) *MockListOrdersServiceAPI {
+	mock := &MockListOrdersServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockListOrdersServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockListOrdersServiceAPI) EXPECT() *MockListOrdersServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockListOrdersServiceAPI) Do(ctx context.Context, opts ...delivery.RequestOption) ([]*delivery.Order, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].([]*delivery.Order)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockListOrdersServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockListOrdersServiceAPI)(nil).Do), varargs...)
+}
+
+// EndTime mocks base method.
+func (m *MockListOrdersServiceAPI) EndTime(endTime int64) delivery.ListOrdersServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EndTime", endTime)
+	ret0, _ := ret[0].(delivery.ListOrdersServiceAPI)
+	return ret0
+}
+
+// EndTime indicates an expected call of EndTime.
+func (mr *MockListOrdersServiceAPIMockRecorder) EndTime(endTime interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EndTime", reflect.TypeOf((*MockListOrdersServiceAPI)(nil).EndTime), endTime)
+}
+
+// Limit mocks base method.
+func (m *MockListOrdersServiceAPI) Limit(limit int) delivery.ListOrdersServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Limit", limit)
+	ret0, _ := ret[0].(delivery.ListOrdersServiceAPI)
+	return ret0
+}
+
+// Limit indicates an expected call of Limit.
+func (mr *MockListOrdersServiceAPIMockRecorder) Limit(limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Limit", reflect.TypeOf((*MockListOrdersServiceAPI)(nil).Limit), limit)
+}
+
+// OrderID mocks base method.
+func (m *MockListOrdersServiceAPI) OrderID(orderID int64) delivery.ListOrdersServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OrderID", orderID)
+	ret0, _ := ret[0].(delivery.ListOrdersServiceAPI)
+	return ret0
+}
+
+// OrderID indicates an expected call of OrderID.
+func (mr *MockListOrdersServiceAPIMockRecorder) OrderID(orderID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OrderID", reflect.TypeOf((*MockListOrdersServiceAPI)(nil).OrderID), orderID)
+}
+
+// Pair mocks base method.
+func (m *MockListOrdersServiceAPI) Pair(pair string) delivery.ListOrdersServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Pair", pair)
+	ret0, _ := ret[0].(delivery.ListOrdersServiceAPI)
+	return ret0
+}
+
+// Pair indicates an expected call of Pair.
+func (mr *MockListOrdersServiceAPIMockRecorder) Pair(pair interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Pair", reflect.TypeOf((*MockListOrdersServiceAPI)(nil).Pair), pair)
+}
+
+// StartTime mocks base method.
+func (m *MockListOrdersServiceAPI) StartTime(startTime int64) delivery.ListOrdersServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartTime", startTime)
+	ret0, _ := ret[0].(delivery.ListOrdersServiceAPI)
+	return ret0
+}
+
+// StartTime indicates an expected call of StartTime.
+func (mr *MockListOrdersServiceAPIMockRecorder) StartTime(startTime interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartTime", reflect.TypeOf((*MockListOrdersServiceAPI)(nil).StartTime), startTime)
+}
+
+// Symbol mocks base method.
+func (m *MockListOrdersServiceAPI) Symbol(symbol string) delivery.ListOrdersServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Symbol", symbol)
+	ret0, _ := ret[0].(delivery.ListOrdersServiceAPI)
+	return ret0
+}
+
+// Symbol indicates an expected call of Symbol.
+func (mr *MockListOrdersServiceAPIMockRecorder) Symbol(symbol interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Symbol", reflect.TypeOf((*MockListOrdersServiceAPI)(nil).Symbol), symbol)
+}
+
+// MockListLiquidationOrdersServiceAPI is a mock of ListLiquidationOrdersServiceAPI interface.
+type MockListLiquidationOrdersServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockListLiquidationOrdersServiceAPIMockRecorder
+}
+
+// MockListLiquidationOrdersServiceAPIMockRecorder is the mock recorder for MockListLiquidationOrdersServiceAPI.
+type MockListLiquidationOrdersServiceAPIMockRecorder struct {
+	mock *MockListLiquidationOrdersServiceAPI
+}
+
+// NewMockListLiquidationOrdersServiceAPI creates a new mock instance.
+func NewMockListLiquidationOrdersServiceAPI(ctrl *gomock.Controller) *MockListLiquidationOrdersServiceAPI {
+	mock := &MockListLiquidationOrdersServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockListLiquidationOrdersServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockListLiquidationOrdersServiceAPI) EXPECT() *MockListLiquidationOrdersServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockListLiquidationOrdersServiceAPI) Do(ctx context.Context, opts ...delivery.RequestOption) ([]*delivery.LiquidationOrder, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].([]*delivery.LiquidationOrder)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockListLiquidationOrdersServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockListLiquidationOrdersServiceAPI)(nil).Do), varargs...)
+}
+
+// EndTime mocks base method.
+func (m *MockListLiquidationOrdersServiceAPI) EndTime(endTime int64) delivery.ListLiquidationOrdersServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EndTime", endTime)
+	ret0, _ := ret[0].(delivery.ListLiquidationOrdersServiceAPI)
+	return ret0
+}
+
+// EndTime indicates an expected call of EndTime.
+func (mr *MockListLiquidationOrdersServiceAPIMockRecorder) EndTime(endTime interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EndTime", reflect.TypeOf((*MockListLiquidationOrdersServiceAPI)(nil).EndTime), endTime)
+}
+
+// Limit mocks base method.
+func (m *MockListLiquidationOrdersServiceAPI) Limit(limit int) delivery.ListLiquidationOrdersServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Limit", limit)
+	ret0, _ := ret[0].(delivery.ListLiquidationOrdersServiceAPI)
+	return ret0
+}
+
+// Limit indicates an expected call of Limit.
+func (mr *MockListLiquidationOrdersServiceAPIMockRecorder) Limit(limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Limit", reflect.TypeOf((*MockListLiquidationOrdersServiceAPI)(nil).Limit), limit)
+}
+
+// Pair mocks base method.
+func (m *MockListLiquidationOrdersServiceAPI) Pair(pair string) delivery.ListLiquidationOrdersServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Pair", pair)
+	ret0, _ := ret[0].(delivery.ListLiquidationOrdersServiceAPI)
+	return ret0
+}
+
+// Pair indicates an expected call of Pair.
+func (mr *MockListLiquidationOrdersServiceAPIMockRecorder) Pair(pair interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Pair", reflect.TypeOf((*MockListLiquidationOrdersServiceAPI)(nil).Pair), pair)
+}
+
+// StartTime mocks base method.
+func (m *MockListLiquidationOrdersServiceAPI) StartTime(startTime int64) delivery.ListLiquidationOrdersServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartTime", startTime)
+	ret0, _ := ret[0].(delivery.ListLiquidationOrdersServiceAPI)
+	return ret0
+}
+
+// StartTime indicates an expected call of StartTime.
+func (mr *MockListLiquidationOrdersServiceAPIMockRecorder) StartTime(startTime interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartTime", reflect.TypeOf((*MockListLiquidationOrdersServiceAPI)(nil).StartTime), startTime)
+}
+
+// Symbol mocks base method.
+func (m *MockListLiquidationOrdersServiceAPI) Symbol(symbol string) delivery.ListLiquidationOrdersServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Symbol", symbol)
+	ret0, _ := ret[0].(delivery.ListLiquidationOrdersServiceAPI)
+	return ret0
+}
+
+// Symbol indicates an expected call of Symbol.
+func (mr *MockListLiquidationOrdersServiceAPIMockRecorder) Symbol(symbol interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Symbol", reflect.TypeOf((*MockListLiquidationOrdersServiceAPI)(nil).Symbol), symbol)
+}
+
+// MockListUserLiquidationOrdersServiceAPI is a mock of ListUserLiquidationOrdersServiceAPI interface.
+type MockListUserLiquidationOrdersServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockListUserLiquidationOrdersServiceAPIMockRecorder
+}
+
+// MockListUserLiquidationOrdersServiceAPIMockRecorder is the mock recorder for MockListUserLiquidationOrdersServiceAPI.
+type MockListUserLiquidationOrdersServiceAPIMockRecorder struct {
+	mock *MockListUserLiquidationOrdersServiceAPI
+}
+
+// NewMockListUserLiquidationOrdersServiceAPI creates a new mock instance.
+func NewMockListUserLiquidationOrdersServiceAPI(ctrl *gomock.Controller) *MockListUserLiquidationOrdersServiceAPI {
+	mock := &MockListUserLiquidationOrdersServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockListUserLiquidationOrdersServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockListUserLiquidationOrdersServiceAPI) EXPECT() *MockListUserLiquidationOrdersServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// AutoCloseType mocks base method.
+func (m *MockListUserLiquidationOrdersServiceAPI) AutoCloseType(autoCloseType delivery.ForceOrderCloseType) delivery.ListUserLiquidationOrdersServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AutoCloseType", autoCloseType)
+	ret0, _ := ret[0].(delivery.ListUserLiquidationOrdersServiceAPI)
+	return ret0
+}
+
+// AutoCloseType indicates an expected call of AutoCloseType.
+func (mr *MockListUserLiquidationOrdersServiceAPIMockRecorder) AutoCloseType(autoCloseType interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AutoCloseType", reflect.TypeOf((*MockListUserLiquidationOrdersServiceAPI)(nil).AutoCloseType), autoCloseType)
+}
+
+// Do mocks base method.
+func (m *MockListUserLiquidationOrdersServiceAPI) Do(ctx context.Context, opts ...delivery.RequestOption) ([]*delivery.UserLiquidationOrder, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].([]*delivery.UserLiquidationOrder)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockListUserLiquidationOrdersServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockListUserLiquidationOrdersServiceAPI)(nil).Do), varargs...)
+}
+
+// EndTime mocks base method.
+func (m *MockListUserLiquidationOrdersServiceAPI) EndTime(endTime int64) delivery.ListUserLiquidationOrdersServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EndTime", endTime)
+	ret0, _ := ret[0].(delivery.ListUserLiquidationOrdersServiceAPI)
+	return ret0
+}
+
+// EndTime indicates an expected call of EndTime.
+func (mr *MockListUserLiquidationOrdersServiceAPIMockRecorder) EndTime(endTime interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EndTime", reflect.TypeOf((*MockListUserLiquidationOrdersServiceAPI)(nil).EndTime), endTime)
+}
+
+// Limit mocks base method.
+func (m *MockListUserLiquidationOrdersServiceAPI) Limit(limit int) delivery.ListUserLiquidationOrdersServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Limit", limit)
+	ret0, _ := ret[0].(delivery.ListUserLiquidationOrdersServiceAPI)
+	return ret0
+}
+
+// Limit indicates an expected call of Limit.
+func (mr *MockListUserLiquidationOrdersServiceAPIMockRecorder) Limit(limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Limit", reflect.TypeOf((*MockListUserLiquidationOrdersServiceAPI)(nil).Limit), limit)
+}
+
+// StartTime mocks base method.
+func (m *MockListUserLiquidationOrdersServiceAPI) StartTime(startTime int64) delivery.ListUserLiquidationOrdersServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartTime", startTime)
+	ret0, _ := ret[0].(delivery.ListUserLiquidationOrdersServiceAPI)
+	return ret0
+}
+
+// StartTime indicates an expected call of StartTime.
+func (mr *MockListUserLiquidationOrdersServiceAPIMockRecorder) StartTime(startTime interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartTime", reflect.TypeOf((*MockListUserLiquidationOrdersServiceAPI)(nil).StartTime), startTime)
+}
+
+// Symbol mocks base method.
+func (m *MockListUserLiquidationOrdersServiceAPI) Symbol(symbol string) delivery.ListUserLiquidationOrdersServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Symbol", symbol)
+	ret0, _ := ret[0].(delivery.ListUserLiquidationOrdersServiceAPI)
+	return ret0
+}
+
+// Symbol indicates an expected call of Symbol.
+func (mr *MockListUserLiquidationOrdersServiceAPIMockRecorder) Symbol(symbol interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Symbol", reflect.TypeOf((*MockListUserLiquidationOrdersServiceAPI)(nil).Symbol), symbol)
+}
+
+// MockListAccountTradeServiceAPI is a mock of ListAccountTradeServiceAPI interface.
+type MockListAccountTradeServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockListAccountTradeServiceAPIMockRecorder
+}
+
+// MockListAccountTradeServiceAPIMockRecorder is the mock recorder for MockListAccountTradeServiceAPI.
+type MockListAccountTradeServiceAPIMockRecorder struct {
+	mock *MockListAccountTradeServiceAPI
+}
+
+// NewMockListAccountTradeServiceAPI creates a new mock instance.
+func NewMockListAccountTradeServiceAPI(ctrl *gomock.Controller) *MockListAccountTradeServiceAPI {
+	mock := &MockListAccountTradeServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockListAccountTradeServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockListAccountTradeServiceAPI) EXPECT() *MockListAccountTradeServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockListAccountTradeServiceAPI) Do(ctx context.Context, opts ...delivery.RequestOption) ([]*delivery.AccountTrade, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].([]*delivery.AccountTrade)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockListAccountTradeServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockListAccountTradeServiceAPI)(nil).Do), varargs...)
+}
+
+// EndTime mocks base method.
+func (m *MockListAccountTradeServiceAPI) EndTime(endTime int64) delivery.ListAccountTradeServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EndTime", endTime)
+	ret0, _ := ret[0].(delivery.ListAccountTradeServiceAPI)
+	return ret0
+}
+
+// EndTime indicates an expected call of EndTime.
+func (mr *MockListAccountTradeServiceAPIMockRecorder) EndTime(endTime interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EndTime", reflect.TypeOf((*MockListAccountTradeServiceAPI)(nil).EndTime), endTime)
+}
+
+// FromID mocks base method.
+func (m *MockListAccountTradeServiceAPI) FromID(fromID int64) delivery.ListAccountTradeServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FromID", fromID)
+	ret0, _ := ret[0].(delivery.ListAccountTradeServiceAPI)
+	return ret0
+}
+
+// FromID indicates an expected call of FromID.
+func (mr *MockListAccountTradeServiceAPIMockRecorder) FromID(fromID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FromID", reflect.TypeOf((*MockListAccountTradeServiceAPI)(nil).FromID), fromID)
+}
+
+// Limit mocks base method.
+func (m *MockListAccountTradeServiceAPI) Limit(limit int) delivery.ListAccountTradeServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Limit", limit)
+	ret0, _ := ret[0].(delivery.ListAccountTradeServiceAPI)
+	return ret0
+}
+
+// Limit indicates an expected call of Limit.
+func (mr *MockListAccountTradeServiceAPIMockRecorder) Limit(limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Limit", reflect.TypeOf((*MockListAccountTradeServiceAPI)(nil).Limit), limit)
+}
+
+// OrderID mocks base method.
+func (m *MockListAccountTradeServiceAPI) OrderID(orderID int64) delivery.ListAccountTradeServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OrderID", orderID)
+	ret0, _ := ret[0].(delivery.ListAccountTradeServiceAPI)
+	return ret0
+}
+
+// OrderID indicates an expected call of OrderID.
+func (mr *MockListAccountTradeServiceAPIMockRecorder) OrderID(orderID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OrderID", reflect.TypeOf((*MockListAccountTradeServiceAPI)(nil).OrderID), orderID)
+}
+
+// Pair mocks base method.
+func (m *MockListAccountTradeServiceAPI) Pair(pair string) delivery.ListAccountTradeServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Pair", pair)
+	ret0, _ := ret[0].(delivery.ListAccountTradeServiceAPI)
+	return ret0
+}
+
+// Pair indicates an expected call of Pair.
+func (mr *MockListAccountTradeServiceAPIMockRecorder) Pair(pair interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Pair", reflect.TypeOf((*MockListAccountTradeServiceAPI)(nil).Pair), pair)
+}
+
+// StartTime mocks base method.
+func (m *MockListAccountTradeServiceAPI) StartTime(startTime int64) delivery.ListAccountTradeServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartTime", startTime)
+	ret0, _ := ret[0].(delivery.ListAccountTradeServiceAPI)
+	return ret0
+}
+
+// StartTime indicates an expected call of StartTime.
+func (mr *MockListAccountTradeServiceAPIMockRecorder) StartTime(startTime interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartTime", reflect.TypeOf((*MockListAccountTradeServiceAPI)(nil).StartTime), startTime)
+}
+
+// Symbol mocks base method.
+func (m *MockListAccountTradeServiceAPI) Symbol(symbol string) delivery.ListAccountTradeServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Symbol", symbol)
+	ret0, _ := ret[0].(delivery.ListAccountTradeServiceAPI)
+	return ret0
+}
+
+// Symbol indicates an expected call of Symbol.
+func (mr *MockListAccountTradeServiceAPIMockRecorder) Symbol(symbol interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Symbol", reflect.TypeOf((*MockListAccountTradeServiceAPI)(nil).Symbol), symbol)
+}
+
+// MockGetIncomeHistoryServiceAPI is a mock of GetIncomeHistoryServiceAPI interface.
+type MockGetIncomeHistoryServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockGetIncomeHistoryServiceAPIMockRecorder
+}
+
+// MockGetIncomeHistoryServiceAPIMockRecorder is the mock recorder for MockGetIncomeHistoryServiceAPI.
+type MockGetIncomeHistoryServiceAPIMockRecorder struct {
+	mock *MockGetIncomeHistoryServiceAPI
+}
+
+// NewMockGetIncomeHistoryServiceAPI creates a new mock instance.
+func NewMockGetIncomeHistoryServiceAPI(ctrl *gomock.Controller) *MockGetIncomeHistoryServiceAPI {
+	mock := &MockGetIncomeHistoryServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockGetIncomeHistoryServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGetIncomeHistoryServiceAPI) EXPECT() *MockGetIncomeHistoryServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockGetIncomeHistoryServiceAPI) Do(ctx context.Context, opts ...delivery.RequestOption) ([]*delivery.IncomeHistory, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].([]*delivery.IncomeHistory)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockGetIncomeHistoryServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockGetIncomeHistoryServiceAPI)(nil).Do), varargs...)
+}
+
+// EndTime mocks base method.
+func (m *MockGetIncomeHistoryServiceAPI) EndTime(endTime int64) delivery.GetIncomeHistoryServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EndTime", endTime)
+	ret0, _ := ret[0].(delivery.GetIncomeHistoryServiceAPI)
+	return ret0
+}
+
+// EndTime indicates an expected call of EndTime.
+func (mr *MockGetIncomeHistoryServiceAPIMockRecorder) EndTime(endTime interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EndTime", reflect.TypeOf((*MockGetIncomeHistoryServiceAPI)(nil).EndTime), endTime)
+}
+
+// IncomeType mocks base method.
+func (m *MockGetIncomeHistoryServiceAPI) IncomeType(incomeType string) delivery.GetIncomeHistoryServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IncomeType", incomeType)
+	ret0, _ := ret[0].(delivery.GetIncomeHistoryServiceAPI)
+	return ret0
+}
+
+// IncomeType indicates an expected call of IncomeType.
+func (mr *MockGetIncomeHistoryServiceAPIMockRecorder) IncomeType(incomeType interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IncomeType", reflect.TypeOf((*MockGetIncomeHistoryServiceAPI)(nil).IncomeType), incomeType)
+}
+
+// Limit mocks base method.
+func (m *MockGetIncomeHistoryServiceAPI) Limit(limit int64) delivery.GetIncomeHistoryServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Limit", limit)
+	ret0, _ := ret[0].(delivery.GetIncomeHistoryServiceAPI)
+	return ret0
+}
+
+// Limit indicates an expected call of Limit.
+func (mr *MockGetIncomeHistoryServiceAPIMockRecorder) Limit(limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Limit", reflect.TypeOf((*MockGetIncomeHistoryServiceAPI)(nil).Limit), limit)
+}
+
+// Page mocks base method.
+func (m *MockGetIncomeHistoryServiceAPI) Page(page int) delivery.GetIncomeHistoryServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Page", page)
+	ret0, _ := ret[0].(delivery.GetIncomeHistoryServiceAPI)
+	return ret0
+}
+
+// Page indicates an expected call of Page.
+func (mr *MockGetIncomeHistoryServiceAPIMockRecorder) Page(page interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Page", reflect.TypeOf((*MockGetIncomeHistoryServiceAPI)(nil).Page), page)
+}
+
+// StartTime mocks base method.
+func (m *MockGetIncomeHistoryServiceAPI) StartTime(startTime int64) delivery.GetIncomeHistoryServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartTime", startTime)
+	ret0, _ := ret[0].(delivery.GetIncomeHistoryServiceAPI)
+	return ret0
+}
+
+// StartTime indicates an expected call of StartTime.
+func (mr *MockGetIncomeHistoryServiceAPIMockRecorder) StartTime(startTime interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartTime", reflect.TypeOf((*MockGetIncomeHistoryServiceAPI)(nil).StartTime), startTime)
+}
+
+// Symbol mocks base method.
+func (m *MockGetIncomeHistoryServiceAPI) Symbol(symbol string) delivery.GetIncomeHistoryServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Symbol", symbol)
+	ret0, _ := ret[0].(delivery.GetIncomeHistoryServiceAPI)
+	return ret0
+}
+
+// Symbol indicates an expected call of Symbol.
+func (mr *MockGetIncomeHistoryServiceAPIMockRecorder) Symbol(symbol interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Symbol", reflect.TypeOf((*MockGetIncomeHistoryServiceAPI)(nil).Symbol), symbol)
+}
+
+// MockCommissionRateServiceAPI is a mock of CommissionRateServiceAPI interface.
+type MockCommissionRateServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockCommissionRateServiceAPIMockRecorder
+}
+
+// MockCommissionRateServiceAPIMockRecorder is the mock recorder for MockCommissionRateServiceAPI.
+type MockCommissionRateServiceAPIMockRecorder struct {
+	mock *MockCommissionRateServiceAPI
+}
+
+// NewMockCommissionRateServiceAPI creates a new mock instance.
+func NewMockCommissionRateServiceAPI(ctrl *gomock.Controller) *MockCommissionRateServiceAPI {
+	mock := &MockCommissionRateServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockCommissionRateServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCommissionRateServiceAPI) EXPECT() *MockCommissionRateServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockCommissionRateServiceAPI) Do(ctx context.Context, opts ...delivery.RequestOption) (*delivery.CommissionRate, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*delivery.CommissionRate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockCommissionRateServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockCommissionRateServiceAPI)(nil).Do), varargs...)
+}
+
+// Symbol mocks base method.
+func (m *MockCommissionRateServiceAPI) Symbol(symbol string) delivery.CommissionRateServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Symbol", symbol)
+	ret0, _ := ret[0].(delivery.CommissionRateServiceAPI)
+	return ret0
+}
+
+// Symbol indicates an expected call of Symbol.
+func (mr *MockCommissionRateServiceAPIMockRecorder) Symbol(symbol interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Symbol", reflect.TypeOf((*MockCommissionRateServiceAPI)(nil).Symbol), symbol)
+}
+
+// MockGetDownloadIDServiceAPI is a mock of GetDownloadIDServiceAPI interface.
+type MockGetDownloadIDServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockGetDownloadIDServiceAPIMockRecorder
+}
+
+// MockGetDownloadIDServiceAPIMockRecorder is the mock recorder for MockGetDownloadIDServiceAPI.
+type MockGetDownloadIDServiceAPIMockRecorder struct {
+	mock *MockGetDownloadIDServiceAPI
+}
+
+// NewMockGetDownloadIDServiceAPI creates a new mock instance.
+func NewMockGetDownloadIDServiceAPI(ctrl *gomock.Controller) *MockGetDownloadIDServiceAPI {
+	mock := &MockGetDownloadIDServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockGetDownloadIDServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGetDownloadIDServiceAPI) EXPECT() *MockGetDownloadIDServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockGetDownloadIDServiceAPI) Do(ctx context.Context, opts ...delivery.RequestOption) (*delivery.DownloadID, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*delivery.DownloadID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockGetDownloadIDServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockGetDownloadIDServiceAPI)(nil).Do), varargs...)
+}
+
+// EndTime mocks base method.
+func (m *MockGetDownloadIDServiceAPI) EndTime(endTime int64) delivery.GetDownloadIDServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EndTime", endTime)
+	ret0, _ := ret[0].(delivery.GetDownloadIDServiceAPI)
+	return ret0
+}
+
+// EndTime indicates an expected call of EndTime.
+func (mr *MockGetDownloadIDServiceAPIMockRecorder) EndTime(endTime interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EndTime", reflect.TypeOf((*MockGetDownloadIDServiceAPI)(nil).EndTime), endTime)
+}
+
+// StartTime mocks base method.
+func (m *MockGetDownloadIDServiceAPI) StartTime(startTime int64) delivery.GetDownloadIDServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartTime", startTime)
+	ret0, _ := ret[0].(delivery.GetDownloadIDServiceAPI)
+	return ret0
+}
+
+// StartTime indicates an expected call of StartTime.
+func (mr *MockGetDownloadIDServiceAPIMockRecorder) StartTime(startTime interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartTime", reflect.TypeOf((*MockGetDownloadIDServiceAPI)(nil).StartTime), startTime)
+}
+
+// Type mocks base method.
+func (m *MockGetDownloadIDServiceAPI) Type(downloadType delivery.DownloadType) delivery.GetDownloadIDServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Type", downloadType)
+	ret0, _ := ret[0].(delivery.GetDownloadIDServiceAPI)
+	return ret0
+}
+
+// Type indicates an expected call of Type.
+func (mr *MockGetDownloadIDServiceAPIMockRecorder) Type(downloadType interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Type", reflect.TypeOf((*MockGetDownloadIDServiceAPI)(nil).Type), downloadType)
+}
+
+// MockGetDownloadLinkServiceAPI is a mock of GetDownloadLinkServiceAPI interface.
+type MockGetDownloadLinkServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockGetDownloadLinkServiceAPIMockRecorder
+}
+
+// MockGetDownloadLinkServiceAPIMockRecorder is the mock recorder for MockGetDownloadLinkServiceAPI.
+type MockGetDownloadLinkServiceAPIMockRecorder struct {
+	mock *MockGetDownloadLinkServiceAPI
+}
+
+// NewMockGetDownloadLinkServiceAPI creates a new mock instance.
+func NewMockGetDownloadLinkServiceAPI(ctrl *gomock.Controller) *MockGetDownloadLinkServiceAPI {
+	mock := &MockGetDownloadLinkServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockGetDownloadLinkServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGetDownloadLinkServiceAPI) EXPECT() *MockGetDownloadLinkServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockGetDownloadLinkServiceAPI) Do(ctx context.Context, opts ...delivery.RequestOption) (*delivery.DownloadLink, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*delivery.DownloadLink)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockGetDownloadLinkServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockGetDownloadLinkServiceAPI)(nil).Do), varargs...)
+}
+
+// DownloadID mocks base method.
+func (m *MockGetDownloadLinkServiceAPI) DownloadID(downloadID string) delivery.GetDownloadLinkServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DownloadID", downloadID)
+	ret0, _ := ret[0].(delivery.GetDownloadLinkServiceAPI)
+	return ret0
+}
+
+// DownloadID indicates an expected call of DownloadID.
+func (mr *MockGetDownloadLinkServiceAPIMockRecorder) DownloadID(downloadID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DownloadID", reflect.TypeOf((*MockGetDownloadLinkServiceAPI)(nil).DownloadID), downloadID)
+}
+
+// Type mocks base method.
+func (m *MockGetDownloadLinkServiceAPI) Type(downloadType delivery.DownloadType) delivery.GetDownloadLinkServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Type", downloadType)
+	ret0, _ := ret[0].(delivery.GetDownloadLinkServiceAPI)
+	return ret0
+}
+
+// Type indicates an expected call of Type.
+func (mr *MockGetDownloadLinkServiceAPIMockRecorder) Type(downloadType interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Type", reflect.TypeOf((*MockGetDownloadLinkServiceAPI)(nil).Type), downloadType)
+}
+
+// MockGetAccountServiceAPI is a mock of GetAccountServiceAPI interface.
+type MockGetAccountServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockGetAccountServiceAPIMockRecorder
+}
+
+// MockGetAccountServiceAPIMockRecorder is the mock recorder for MockGetAccountServiceAPI.
+type MockGetAccountServiceAPIMockRecorder struct {
+	mock *MockGetAccountServiceAPI
+}
+
+// NewMockGetAccountServiceAPI creates a new mock instance.
+func NewMockGetAccountServiceAPI(ctrl *gomock.Controller) *MockGetAccountServiceAPI {
+	mock := &MockGetAccountServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockGetAccountServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGetAccountServiceAPI) EXPECT() *MockGetAccountServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockGetAccountServiceAPI) Do(ctx context.Context, opts ...delivery.RequestOption) (*delivery.Account, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*delivery.Account)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockGetAccountServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockGetAccountServiceAPI)(nil).Do), varargs...)
+}
+
+// MockGetBalanceServiceAPI is a mock of GetBalanceServiceAPI interface.
+type MockGetBalanceServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockGetBalanceServiceAPIMockRecorder
+}
+
+// MockGetBalanceServiceAPIMockRecorder is the mock recorder for MockGetBalanceServiceAPI.
+type MockGetBalanceServiceAPIMockRecorder struct {
+	mock *MockGetBalanceServiceAPI
+}
+
+// NewMockGetBalanceServiceAPI creates a new mock instance.
+func NewMockGetBalanceServiceAPI(ctrl *gomock.Controller) *MockGetBalanceServiceAPI {
+	mock := &MockGetBalanceServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockGetBalanceServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGetBalanceServiceAPI) EXPECT() *MockGetBalanceServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockGetBalanceServiceAPI) Do(ctx context.Context, opts ...delivery.RequestOption) ([]*delivery.Balance, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].([]*delivery.Balance)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockGetBalanceServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockGetBalanceServiceAPI)(nil).Do), varargs...)
+}
+
+// MockGetPositionRiskServiceAPI is a mock of GetPositionRiskServiceAPI interface.
+type MockGetPositionRiskServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockGetPositionRiskServiceAPIMockRecorder
+}
+
+// MockGetPositionRiskServiceAPIMockRecorder is the mock recorder for MockGetPositionRiskServiceAPI.
+type MockGetPositionRiskServiceAPIMockRecorder struct {
+	mock *MockGetPositionRiskServiceAPI
+}
+
+// NewMockGetPositionRiskServiceAPI creates a new mock instance.
+func NewMockGetPositionRiskServiceAPI(ctrl *gomock.Controller) *MockGetPositionRiskServiceAPI {
+	mock := &MockGetPositionRiskServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockGetPositionRiskServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGetPositionRiskServiceAPI) EXPECT() *MockGetPositionRiskServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockGetPositionRiskServiceAPI) Do(ctx context.Context, opts ...delivery.RequestOption) ([]*delivery.PositionRisk, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].([]*delivery.PositionRisk)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockGetPositionRiskServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockGetPositionRiskServiceAPI)(nil).Do), varargs...)
+}
+
+// MarginAsset mocks base method.
+func (m *MockGetPositionRiskServiceAPI) MarginAsset(marginAsset string) delivery.GetPositionRiskServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarginAsset", marginAsset)
+	ret0, _ := ret[0].(delivery.GetPositionRiskServiceAPI)
+	return ret0
+}
+
+// MarginAsset indicates an expected call of MarginAsset.
+func (mr *MockGetPositionRiskServiceAPIMockRecorder) MarginAsset(marginAsset interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarginAsset", reflect.TypeOf((*MockGetPositionRiskServiceAPI)(nil).MarginAsset), marginAsset)
+}
+
+// Pair mocks base method.
+func (m *MockGetPositionRiskServiceAPI) Pair(pair string) delivery.GetPositionRiskServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Pair", pair)
+	ret0, _ := ret[0].(delivery.GetPositionRiskServiceAPI)
+	return ret0
+}
+
+// Pair indicates an expected call of Pair.
+func (mr *MockGetPositionRiskServiceAPIMockRecorder) Pair(pair interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Pair", reflect.TypeOf((*MockGetPositionRiskServiceAPI)(nil).Pair), pair)
+}
+
+// MockChangeLeverageServiceAPI is a mock of ChangeLeverageServiceAPI interface.
+type MockChangeLeverageServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockChangeLeverageServiceAPIMockRecorder
+}
+
+// MockChangeLeverageServiceAPIMockRecorder is the mock recorder for MockChangeLeverageServiceAPI.
+type MockChangeLeverageServiceAPIMockRecorder struct {
+	mock *MockChangeLeverageServiceAPI
+}
+
+// NewMockChangeLeverageServiceAPI creates a new mock instance.
+func NewMockChangeLeverageServiceAPI(ctrl *gomock.Controller) *MockChangeLeverageServiceAPI {
+	mock := &MockChangeLeverageServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockChangeLeverageServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockChangeLeverageServiceAPI) EXPECT() *MockChangeLeverageServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockChangeLeverageServiceAPI) Do(ctx context.Context, opts ...delivery.RequestOption) (*delivery.SymbolLeverage, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*delivery.SymbolLeverage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockChangeLeverageServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockChangeLeverageServiceAPI)(nil).Do), varargs...)
+}
+
+// Leverage mocks base method.
+func (m *MockChangeLeverageServiceAPI) Leverage(leverage int) delivery.ChangeLeverageServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Leverage", leverage)
+	ret0, _ := ret[0].(delivery.ChangeLeverageServiceAPI)
+	return ret0
+}
+
+// Leverage indicates an expected call of Leverage.
+func (mr *MockChangeLeverageServiceAPIMockRecorder) Leverage(leverage interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Leverage", reflect.TypeOf((*MockChangeLeverageServiceAPI)(nil).Leverage), leverage)
+}
+
+// Symbol mocks base method.
+func (m *MockChangeLeverageServiceAPI) Symbol(symbol string) delivery.ChangeLeverageServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Symbol", symbol)
+	ret0, _ := ret[0].(delivery.ChangeLeverageServiceAPI)
+	return ret0
+}
+
+// Symbol indicates an expected call of Symbol.
+func (mr *MockChangeLeverageServiceAPIMockRecorder) Symbol(symbol interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Symbol", reflect.TypeOf((*MockChangeLeverageServiceAPI)(nil).Symbol), symbol)
+}
+
+// MockGetLeverageBracketServiceAPI is a mock of GetLeverageBracketServiceAPI interface.
+type MockGetLeverageBracketServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockGetLeverageBracketServiceAPIMockRecorder
+}
+
+// MockGetLeverageBracketServiceAPIMockRecorder is the mock recorder for MockGetLeverageBracketServiceAPI.
+type MockGetLeverageBracketServiceAPIMockRecorder struct {
+	mock *MockGetLeverageBracketServiceAPI
+}
+
+// NewMockGetLeverageBracketServiceAPI creates a new mock instance.
+func NewMockGetLeverageBracketServiceAPI(ctrl *gomock.Controller) *MockGetLeverageBracketServiceAPI {
+	mock := &MockGetLeverageBracketServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockGetLeverageBracketServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGetLeverageBracketServiceAPI) EXPECT() *MockGetLeverageBracketServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockGetLeverageBracketServiceAPI) Do(ctx context.Context, opts ...delivery.RequestOption) ([]*delivery.LeverageBracket, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].([]*delivery.LeverageBracket)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockGetLeverageBracketServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockGetLeverageBracketServiceAPI)(nil).Do), varargs...)
+}
+
+// Symbol mocks base method.
+func (m *MockGetLeverageBracketServiceAPI) Symbol(symbol string) delivery.GetLeverageBracketServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Symbol", symbol)
+	ret0, _ := ret[0].(delivery.GetLeverageBracketServiceAPI)
+	return ret0
+}
+
+// Symbol indicates an expected call of Symbol.
+func (mr *MockGetLeverageBracketServiceAPIMockRecorder) Symbol(symbol interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Symbol", reflect.TypeOf((*MockGetLeverageBracketServiceAPI)(nil).Symbol), symbol)
+}
+
+// MockGetADLQuantileServiceAPI is a mock of GetADLQuantileServiceAPI interface.
+type MockGetADLQuantileServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockGetADLQuantileServiceAPIMockRecorder
+}
+
+// MockGetADLQuantileServiceAPIMockRecorder is the mock recorder for MockGetADLQuantileServiceAPI.
+type MockGetADLQuantileServiceAPIMockRecorder struct {
+	mock *MockGetADLQuantileServiceAPI
+}
+
+// NewMockGetADLQuantileServiceAPI creates a new mock instance.
+func NewMockGetADLQuantileServiceAPI(ctrl *gomock.Controller) *MockGetADLQuantileServiceAPI {
+	mock := &MockGetADLQuantileServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockGetADLQuantileServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGetADLQuantileServiceAPI) EXPECT() *MockGetADLQuantileServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockGetADLQuantileServiceAPI) Do(ctx context.Context, opts ...delivery.RequestOption) ([]*delivery.ADLQuantile, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].([]*delivery.ADLQuantile)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockGetADLQuantileServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockGetADLQuantileServiceAPI)(nil).Do), varargs...)
+}
+
+// Symbol mocks base method.
+func (m *MockGetADLQuantileServiceAPI) Symbol(symbol string) delivery.GetADLQuantileServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Symbol", symbol)
+	ret0, _ := ret[0].(delivery.GetADLQuantileServiceAPI)
+	return ret0
+}
+
+// Symbol indicates an expected call of Symbol.
+func (mr *MockGetADLQuantileServiceAPIMockRecorder) Symbol(symbol interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Symbol", reflect.TypeOf((*MockGetADLQuantileServiceAPI)(nil).Symbol), symbol)
+}
+
+// MockChangeMarginTypeServiceAPI is a mock of ChangeMarginTypeServiceAPI interface.
+type MockChangeMarginTypeServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockChangeMarginTypeServiceAPIMockRecorder
+}
+
+// MockChangeMarginTypeServiceAPIMockRecorder is the mock recorder for MockChangeMarginTypeServiceAPI.
+type MockChangeMarginTypeServiceAPIMockRecorder struct {
+	mock *MockChangeMarginTypeServiceAPI
+}
+
+// NewMockChangeMarginTypeServiceAPI creates a new mock instance.
+func NewMockChangeMarginTypeServiceAPI(ctrl *gomock.Controller) *MockChangeMarginTypeServiceAPI {
+	mock := &MockChangeMarginTypeServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockChangeMarginTypeServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockChangeMarginTypeServiceAPI) EXPECT() *MockChangeMarginTypeServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockChangeMarginTypeServiceAPI) Do(ctx context.Context, opts ...delivery.RequestOption) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockChangeMarginTypeServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockChangeMarginTypeServiceAPI)(nil).Do), varargs...)
+}
+
+// MarginType mocks base method.
+func (m *MockChangeMarginTypeServiceAPI) MarginType(marginType delivery.MarginType) delivery.ChangeMarginTypeServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarginType", marginType)
+	ret0, _ := ret[0].(delivery.ChangeMarginTypeServiceAPI)
+	return ret0
+}
+
+// MarginType indicates an expected call of MarginType.
+func (mr *MockChangeMarginTypeServiceAPIMockRecorder) MarginType(marginType interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarginType", reflect.TypeOf((*MockChangeMarginTypeServiceAPI)(nil).MarginType), marginType)
+}
+
+// Symbol mocks base method.
+func (m *MockChangeMarginTypeServiceAPI) Symbol(symbol string) delivery.ChangeMarginTypeServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Symbol", symbol)
+	ret0, _ := ret[0].(delivery.ChangeMarginTypeServiceAPI)
+	return ret0
+}
+
+// Symbol indicates an expected call of Symbol.
+func (mr *MockChangeMarginTypeServiceAPIMockRecorder) Symbol(symbol interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Symbol", reflect.TypeOf((*MockChangeMarginTypeServiceAPI)(nil).Symbol), symbol)
+}
+
+// MockUpdatePositionMarginServiceAPI is a mock of UpdatePositionMarginServiceAPI interface.
+type MockUpdatePositionMarginServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockUpdatePositionMarginServiceAPIMockRecorder
+}
+
+// MockUpdatePositionMarginServiceAPIMockRecorder is the mock recorder for MockUpdatePositionMarginServiceAPI.
+type MockUpdatePositionMarginServiceAPIMockRecorder struct {
+	mock *MockUpdatePositionMarginServiceAPI
+}
+
+// NewMockUpdatePositionMarginServiceAPI creates a new mock instance.
+func NewMockUpdatePositionMarginServiceAPI(ctrl *gomock.Controller) *MockUpdatePositionMarginServiceAPI {
+	mock := &MockUpdatePositionMarginServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockUpdatePositionMarginServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUpdatePositionMarginServiceAPI) EXPECT() *MockUpdatePositionMarginServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Amount mocks base method.
+func (m *MockUpdatePositionMarginServiceAPI) Amount(amount string) delivery.UpdatePositionMarginServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Amount", amount)
+	ret0, _ := ret[0].(delivery.UpdatePositionMarginServiceAPI)
+	return ret0
+}
+
+// Amount indicates an expected call of Amount.
+func (mr *MockUpdatePositionMarginServiceAPIMockRecorder) Amount(amount interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Amount", reflect.TypeOf((*MockUpdatePositionMarginServiceAPI)(nil).Amount), amount)
+}
+
+// Do mocks base method.
+func (m *MockUpdatePositionMarginServiceAPI) Do(ctx context.Context, opts ...delivery.RequestOption) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockUpdatePositionMarginServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockUpdatePositionMarginServiceAPI)(nil).Do), varargs...)
+}
+
+// PositionSide mocks base method.
+func (m *MockUpdatePositionMarginServiceAPI) PositionSide(positionSide delivery.PositionSideType) delivery.UpdatePositionMarginServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PositionSide", positionSide)
+	ret0, _ := ret[0].(delivery.UpdatePositionMarginServiceAPI)
+	return ret0
+}
+
+// PositionSide indicates an expected call of PositionSide.
+func (mr *MockUpdatePositionMarginServiceAPIMockRecorder) PositionSide(positionSide interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PositionSide", reflect.TypeOf((*MockUpdatePositionMarginServiceAPI)(nil).PositionSide), positionSide)
+}
+
+// Symbol mocks base method.
+func (m *MockUpdatePositionMarginServiceAPI) Symbol(symbol string) delivery.UpdatePositionMarginServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Symbol", symbol)
+	ret0, _ := ret[0].(delivery.UpdatePositionMarginServiceAPI)
+	return ret0
+}
+
+// Symbol indicates an expected call of Symbol.
+func (mr *MockUpdatePositionMarginServiceAPIMockRecorder) Symbol(symbol interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Symbol", reflect.TypeOf((*MockUpdatePositionMarginServiceAPI)(nil).Symbol), symbol)
+}
+
+// Type mocks base method.
+func (m *MockUpdatePositionMarginServiceAPI) Type(actionType int) delivery.UpdatePositionMarginServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Type", actionType)
+	ret0, _ := ret[0].(delivery.UpdatePositionMarginServiceAPI)
+	return ret0
+}
+
+// Type indicates an expected call of Type.
+func (mr *MockUpdatePositionMarginServiceAPIMockRecorder) Type(actionType interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Type", reflect.TypeOf((*MockUpdatePositionMarginServiceAPI)(nil).Type), actionType)
+}
+
+// MockGetPositionMarginHistoryServiceAPI is a mock of GetPositionMarginHistoryServiceAPI interface.
+type MockGetPositionMarginHistoryServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockGetPositionMarginHistoryServiceAPIMockRecorder
+}
+
+// MockGetPositionMarginHistoryServiceAPIMockRecorder is the mock recorder for MockGetPositionMarginHistoryServiceAPI.
+type MockGetPositionMarginHistoryServiceAPIMockRecorder struct {
+	mock *MockGetPositionMarginHistoryServiceAPI
+}
+
+// NewMockGetPositionMarginHistoryServiceAPI creates a new mock instance.
+func NewMockGetPositionMarginHistoryServiceAPI(ctrl *gomock.Controller) *MockGetPositionMarginHistoryServiceAPI {
+	mock := &MockGetPositionMarginHistoryServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockGetPositionMarginHistoryServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGetPositionMarginHistoryServiceAPI) EXPECT() *MockGetPositionMarginHistoryServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockGetPositionMarginHistoryServiceAPI) Do(ctx context.Context, opts ...delivery.RequestOption) ([]*delivery.PositionMarginHistory, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].([]*delivery.PositionMarginHistory)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockGetPositionMarginHistoryServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockGetPositionMarginHistoryServiceAPI)(nil).Do), varargs...)
+}
+
+// EndTime mocks base method.
+func (m *MockGetPositionMarginHistoryServiceAPI) EndTime(endTime int64) delivery.GetPositionMarginHistoryServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EndTime", endTime)
+	ret0, _ := ret[0].(delivery.GetPositionMarginHistoryServiceAPI)
+	return ret0
+}
+
+// EndTime indicates an expected call of EndTime.
+func (mr *MockGetPositionMarginHistoryServiceAPIMockRecorder) EndTime(endTime interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EndTime", reflect.TypeOf((*MockGetPositionMarginHistoryServiceAPI)(nil).EndTime), endTime)
+}
+
+// Limit mocks base method.
+func (m *MockGetPositionMarginHistoryServiceAPI) Limit(limit int64) delivery.GetPositionMarginHistoryServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Limit", limit)
+	ret0, _ := ret[0].(delivery.GetPositionMarginHistoryServiceAPI)
+	return ret0
+}
+
+// Limit indicates an expected call of Limit.
+func (mr *MockGetPositionMarginHistoryServiceAPIMockRecorder) Limit(limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Limit", reflect.TypeOf((*MockGetPositionMarginHistoryServiceAPI)(nil).Limit), limit)
+}
+
+// StartTime mocks base method.
+func (m *MockGetPositionMarginHistoryServiceAPI) StartTime(startTime int64) delivery.GetPositionMarginHistoryServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartTime", startTime)
+	ret0, _ := ret[0].(delivery.GetPositionMarginHistoryServiceAPI)
+	return ret0
+}
+
+// StartTime indicates an expected call of StartTime.
+func (mr *MockGetPositionMarginHistoryServiceAPIMockRecorder) StartTime(startTime interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartTime", reflect.TypeOf((*MockGetPositionMarginHistoryServiceAPI)(nil).StartTime), startTime)
+}
+
+// Symbol mocks base method.
+func (m *MockGetPositionMarginHistoryServiceAPI) Symbol(symbol string) delivery.GetPositionMarginHistoryServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Symbol", symbol)
+	ret0, _ := ret[0].(delivery.GetPositionMarginHistoryServiceAPI)
+	return ret0
+}
+
+// Symbol indicates an expected call of Symbol.
+func (mr *MockGetPositionMarginHistoryServiceAPIMockRecorder) Symbol(symbol interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Symbol", reflect.TypeOf((*MockGetPositionMarginHistoryServiceAPI)(nil).Symbol), symbol)
+}
+
+// Type mocks base method.
+func (m *MockGetPositionMarginHistoryServiceAPI) Type(_type int) delivery.GetPositionMarginHistoryServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Type", _type)
+	ret0, _ := ret[0].(delivery.GetPositionMarginHistoryServiceAPI)
+	return ret0
+}
+
+// Type indicates an expected call of Type.
+func (mr *MockGetPositionMarginHistoryServiceAPIMockRecorder) Type(_type interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Type", reflect.TypeOf((*MockGetPositionMarginHistoryServiceAPI)(nil).Type), _type)
+}
+
+// MockChangePositionModeServiceAPI is a mock of ChangePositionModeServiceAPI interface.
+type MockChangePositionModeServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockChangePositionModeServiceAPIMockRecorder
+}
+
+// MockChangePositionModeServiceAPIMockRecorder is the mock recorder for MockChangePositionModeServiceAPI.
+type MockChangePositionModeServiceAPIMockRecorder struct {
+	mock *MockChangePositionModeServiceAPI
+}
+
+// NewMockChangePositionModeServiceAPI creates a new mock instance.
+func NewMockChangePositionModeServiceAPI(ctrl *gomock.Controller) *MockChangePositionModeServiceAPI {
+	mock := &MockChangePositionModeServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockChangePositionModeServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockChangePositionModeServiceAPI) EXPECT() *MockChangePositionModeServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockChangePositionModeServiceAPI) Do(ctx context.Context, opts ...delivery.RequestOption) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockChangePositionModeServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockChangePositionModeServiceAPI)(nil).Do), varargs...)
+}
+
+// DualSide mocks base method.
+func (m *MockChangePositionModeServiceAPI) DualSide(dualSide bool) delivery.ChangePositionModeServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DualSide", dualSide)
+	ret0, _ := ret[0].(delivery.ChangePositionModeServiceAPI)
+	return ret0
+}
+
+// DualSide indicates an expected call of DualSide.
+func (mr *MockChangePositionModeServiceAPIMockRecorder) DualSide(dualSide interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DualSide", reflect.TypeOf((*MockChangePositionModeServiceAPI)(nil).DualSide), dualSide)
+}
+
+// MockGetPositionModeServiceAPI is a mock of GetPositionModeServiceAPI interface.
+type MockGetPositionModeServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockGetPositionModeServiceAPIMockRecorder
+}
+
+// MockGetPositionModeServiceAPIMockRecorder is the mock recorder for MockGetPositionModeServiceAPI.
+type MockGetPositionModeServiceAPIMockRecorder struct {
+	mock *MockGetPositionModeServiceAPI
+}
+
+// NewMockGetPositionModeServiceAPI creates a new mock instance.
+func NewMockGetPositionModeServiceAPI(ctrl *gomock.Controller) *MockGetPositionModeServiceAPI {
+	mock := &MockGetPositionModeServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockGetPositionModeServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGetPositionModeServiceAPI) EXPECT() *MockGetPositionModeServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockGetPositionModeServiceAPI) Do(ctx context.Context, opts ...delivery.RequestOption) (*delivery.PositionMode, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*delivery.PositionMode)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockGetPositionModeServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockGetPositionModeServiceAPI)(nil).Do), varargs...)
+}
+
+// MockGetFundingInfoServiceAPI is a mock of GetFundingInfoServiceAPI interface.
+type MockGetFundingInfoServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockGetFundingInfoServiceAPIMockRecorder
+}
+
+// MockGetFundingInfoServiceAPIMockRecorder is the mock recorder for MockGetFundingInfoServiceAPI.
+type MockGetFundingInfoServiceAPIMockRecorder struct {
+	mock *MockGetFundingInfoServiceAPI
+}
+
+// NewMockGetFundingInfoServiceAPI creates a new mock instance.
+func NewMockGetFundingInfoServiceAPI(ctrl *gomock.Controller) *MockGetFundingInfoServiceAPI {
+	mock := &MockGetFundingInfoServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockGetFundingInfoServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGetFundingInfoServiceAPI) EXPECT() *MockGetFundingInfoServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockGetFundingInfoServiceAPI) Do(ctx context.Context, opts ...delivery.RequestOption) ([]*delivery.FundingInfo, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].([]*delivery.FundingInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockGetFundingInfoServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockGetFundingInfoServiceAPI)(nil).Do), varargs...)
+}
+
+// MockFundingRateServiceAPI is a mock of FundingRateServiceAPI interface.
+type MockFundingRateServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockFundingRateServiceAPIMockRecorder
+}
+
+// MockFundingRateServiceAPIMockRecorder is the mock recorder for MockFundingRateServiceAPI.
+type MockFundingRateServiceAPIMockRecorder struct {
+	mock *MockFundingRateServiceAPI
+}
+
+// NewMockFundingRateServiceAPI creates a new mock instance.
+func NewMockFundingRateServiceAPI(ctrl *gomock.Controller) *MockFundingRateServiceAPI {
+	mock := &MockFundingRateServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockFundingRateServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockFundingRateServiceAPI) EXPECT() *MockFundingRateServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockFundingRateServiceAPI) Do(ctx context.Context, opts ...delivery.RequestOption) ([]*delivery.FundingRate, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].([]*delivery.FundingRate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockFundingRateServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockFundingRateServiceAPI)(nil).Do), varargs...)
+}
+
+// EndTime mocks base method.
+func (m *MockFundingRateServiceAPI) EndTime(endTime int64) delivery.FundingRateServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EndTime", endTime)
+	ret0, _ := ret[0].(delivery.FundingRateServiceAPI)
+	return ret0
+}
+
+// EndTime indicates an expected call of EndTime.
+func (mr *MockFundingRateServiceAPIMockRecorder) EndTime(endTime interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EndTime", reflect.TypeOf((*MockFundingRateServiceAPI)(nil).EndTime), endTime)
+}
+
+// Limit mocks base method.
+func (m *MockFundingRateServiceAPI) Limit(limit int) delivery.FundingRateServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Limit", limit)
+	ret0, _ := ret[0].(delivery.FundingRateServiceAPI)
+	return ret0
+}
+
+// Limit indicates an expected call of Limit.
+func (mr *MockFundingRateServiceAPIMockRecorder) Limit(limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Limit", reflect.TypeOf((*MockFundingRateServiceAPI)(nil).Limit), limit)
+}
+
+// StartTime mocks base method.
+func (m *MockFundingRateServiceAPI) StartTime(startTime int64) delivery.FundingRateServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartTime", startTime)
+	ret0, _ := ret[0].(delivery.FundingRateServiceAPI)
+	return ret0
+}
+
+// StartTime indicates an expected call of StartTime.
+func (mr *MockFundingRateServiceAPIMockRecorder) StartTime(startTime interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartTime", reflect.TypeOf((*MockFundingRateServiceAPI)(nil).StartTime), startTime)
+}
+
+// Symbol mocks base method.
+func (m *MockFundingRateServiceAPI) Symbol(symbol string) delivery.FundingRateServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Symbol", symbol)
+	ret0, _ := ret[0].(delivery.FundingRateServiceAPI)
+	return ret0
+}
+
+// Symbol indicates an expected call of Symbol.
+func (mr *MockFundingRateServiceAPIMockRecorder) Symbol(symbol interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Symbol", reflect.TypeOf((*MockFundingRateServiceAPI)(nil).Symbol), symbol)
+}
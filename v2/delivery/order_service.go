@@ -3,6 +3,7 @@ package delivery
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"net/http"
 	"strconv"
 
@@ -11,23 +12,25 @@ import (
 
 // CreateOrderService create order
 type CreateOrderService struct {
-	c                *Client
-	symbol           string
-	side             SideType
-	positionSide     *PositionSideType
-	orderType        OrderType
-	timeInForce      *TimeInForceType
-	quantity         string
-	reduceOnly       *string
-	price            *string
-	newClientOrderID *string
-	stopPrice        *string
-	closePosition    *string
-	activationPrice  *string
-	callbackRate     *string
-	workingType      *WorkingType
-	priceProtect     *string
-	newOrderRespType NewOrderRespType
+	c                       *Client
+	symbol                  string
+	side                    SideType
+	positionSide            *PositionSideType
+	orderType               OrderType
+	timeInForce             *TimeInForceType
+	quantity                string
+	reduceOnly              *string
+	price                   *string
+	newClientOrderID        *string
+	stopPrice               *string
+	closePosition           *string
+	activationPrice         *string
+	callbackRate            *string
+	workingType             *WorkingType
+	priceProtect            *string
+	newOrderRespType        NewOrderRespType
+	priceMatch              *PriceMatchType
+	selfTradePreventionMode *SelfTradePreventionMode
 }
 
 // Symbol set symbol
@@ -129,12 +132,20 @@ func (s *CreateOrderService) ClosePosition(closePosition bool) *CreateOrderServi
 	return s
 }
 
-func (s *CreateOrderService) createOrder(ctx context.Context, endpoint string, opts ...RequestOption) (data []byte, err error) {
-	r := &request{
-		method:   http.MethodPost,
-		endpoint: endpoint,
-		secType:  secTypeSigned,
-	}
+// PriceMatch set priceMatch
+func (s *CreateOrderService) PriceMatch(priceMatch PriceMatchType) *CreateOrderService {
+	s.priceMatch = &priceMatch
+	return s
+}
+
+// SelfTradePreventionMode set selfTradePreventionMode
+func (s *CreateOrderService) SelfTradePreventionMode(selfTradePreventionMode SelfTradePreventionMode) *CreateOrderService {
+	s.selfTradePreventionMode = &selfTradePreventionMode
+	return s
+}
+
+// params returns the parameters of the order
+func (s *CreateOrderService) params() params {
 	m := params{
 		"symbol":           s.symbol,
 		"side":             s.side,
@@ -177,6 +188,22 @@ func (s *CreateOrderService) createOrder(ctx context.Context, endpoint string, o
 	if s.closePosition != nil {
 		m["closePosition"] = *s.closePosition
 	}
+	if s.priceMatch != nil {
+		m["priceMatch"] = *s.priceMatch
+	}
+	if s.selfTradePreventionMode != nil {
+		m["selfTradePreventionMode"] = *s.selfTradePreventionMode
+	}
+	return m
+}
+
+func (s *CreateOrderService) createOrder(ctx context.Context, endpoint string, opts ...RequestOption) (data []byte, err error) {
+	r := &request{
+		method:   http.MethodPost,
+		endpoint: endpoint,
+		secType:  secTypeSigned,
+	}
+	m := s.params()
 	r.setFormParams(m)
 	data, err = s.c.callAPI(ctx, r, opts...)
 	if err != nil {
@@ -629,3 +656,542 @@ type LiquidationOrder struct {
 	Side             SideType        `json:"side"`
 	Time             int64           `json:"time"`
 }
+
+// ModifyOrder contains parameters for order modification request
+type ModifyOrder struct {
+	orderID           *int64
+	origClientOrderID *string
+	symbol            string
+	side              SideType
+	quantity          *string
+	price             *string
+	priceMatch        *PriceMatchType
+}
+
+// NewModifyOrder init an order modification of ModifyBatchOrdersService
+func NewModifyOrder() *ModifyOrder {
+	return &ModifyOrder{}
+}
+
+// Symbol set symbol
+func (s *ModifyOrder) Symbol(symbol string) *ModifyOrder {
+	s.symbol = symbol
+	return s
+}
+
+// OrderID will prevail over OrigClientOrderID
+func (s *ModifyOrder) OrderID(orderID int64) *ModifyOrder {
+	s.orderID = &orderID
+	return s
+}
+
+// OrigClientOrderID is not necessary if OrderID is provided
+func (s *ModifyOrder) OrigClientOrderID(origClientOrderID string) *ModifyOrder {
+	s.origClientOrderID = &origClientOrderID
+	return s
+}
+
+// Side set side
+func (s *ModifyOrder) Side(side SideType) *ModifyOrder {
+	s.side = side
+	return s
+}
+
+// Quantity set quantity
+func (s *ModifyOrder) Quantity(quantity string) *ModifyOrder {
+	s.quantity = &quantity
+	return s
+}
+
+// Price set price
+func (s *ModifyOrder) Price(price string) *ModifyOrder {
+	s.price = &price
+	return s
+}
+
+// PriceMatch set priceMatch
+func (s *ModifyOrder) PriceMatch(priceMatch PriceMatchType) *ModifyOrder {
+	s.priceMatch = &priceMatch
+	return s
+}
+
+// params returns the parameters of the modification
+func (s *ModifyOrder) params() params {
+	m := params{
+		"symbol": s.symbol,
+		"side":   s.side,
+	}
+	if s.orderID != nil {
+		m["orderId"] = *s.orderID
+	}
+	if s.origClientOrderID != nil {
+		m["origClientOrderId"] = *s.origClientOrderID
+	}
+	if s.quantity != nil {
+		m["quantity"] = *s.quantity
+	}
+	if s.price != nil {
+		m["price"] = *s.price
+	}
+	if s.priceMatch != nil {
+		m["priceMatch"] = *s.priceMatch
+	}
+	return m
+}
+
+// ModifyOrderService modify an order
+type ModifyOrderService struct {
+	c     *Client
+	order ModifyOrder
+}
+
+// Symbol set symbol
+func (s *ModifyOrderService) Symbol(symbol string) *ModifyOrderService {
+	s.order.Symbol(symbol)
+	return s
+}
+
+// OrderID will prevail over OrigClientOrderID
+func (s *ModifyOrderService) OrderID(orderID int64) *ModifyOrderService {
+	s.order.OrderID(orderID)
+	return s
+}
+
+// OrigClientOrderID is not necessary if OrderID is provided
+func (s *ModifyOrderService) OrigClientOrderID(origClientOrderID string) *ModifyOrderService {
+	s.order.OrigClientOrderID(origClientOrderID)
+	return s
+}
+
+// Side set side
+func (s *ModifyOrderService) Side(side SideType) *ModifyOrderService {
+	s.order.Side(side)
+	return s
+}
+
+// Quantity set quantity
+func (s *ModifyOrderService) Quantity(quantity string) *ModifyOrderService {
+	s.order.Quantity(quantity)
+	return s
+}
+
+// Price set price
+func (s *ModifyOrderService) Price(price string) *ModifyOrderService {
+	s.order.Price(price)
+	return s
+}
+
+// PriceMatch set priceMatch
+func (s *ModifyOrderService) PriceMatch(priceMatch PriceMatchType) *ModifyOrderService {
+	s.order.PriceMatch(priceMatch)
+	return s
+}
+
+// Do send request:
+//   - Either orderId or origClientOrderId must be sent, and the orderId will prevail if both are sent
+//   - Either quantity or price must be sent
+//   - When the new quantity or price doesn't satisfy the symbol filters, the amendment will be rejected
+//     and the order will stay as it is
+//   - One order can only be modified for less than 10000 times
+func (s *ModifyOrderService) Do(ctx context.Context, opts ...RequestOption) (res *Order, err error) {
+	r := &request{
+		method:   http.MethodPut,
+		endpoint: "/dapi/v1/order",
+		secType:  secTypeSigned,
+	}
+	r.setFormParams(s.order.params())
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(Order)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// CreateBatchOrdersService create up to 5 orders at once
+type CreateBatchOrdersService struct {
+	c      *Client
+	orders []*CreateOrderService
+}
+
+// CreateBatchOrdersResponse contains the response from CreateBatchOrders operation
+type CreateBatchOrdersResponse struct {
+	// Total number of messages in the response
+	N int
+	// List of orders which were placed successfully which can have a length between 0 and N
+	Orders []*Order
+	// List of errors of length N, where each item corresponds to a nil value if
+	// the order from that specific index was placed successfully OR an non-nil *APIError if there was an error with
+	// the order at that index
+	Errors []error
+}
+
+// OrderList set the orders to create
+func (s *CreateBatchOrdersService) OrderList(orders []*CreateOrderService) *CreateBatchOrdersService {
+	s.orders = orders
+	return s
+}
+
+// Do send request
+func (s *CreateBatchOrdersService) Do(ctx context.Context, opts ...RequestOption) (res *CreateBatchOrdersResponse, err error) {
+	orders := make([]params, 0, len(s.orders))
+	for _, order := range s.orders {
+		orders = append(orders, order.params())
+	}
+	n, rawOrders, errs, err := s.c.batchOrders(ctx, http.MethodPost, orders, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &CreateBatchOrdersResponse{N: n, Orders: rawOrders, Errors: errs}, nil
+}
+
+// ModifyBatchOrdersService modify up to 5 orders at once
+type ModifyBatchOrdersService struct {
+	c      *Client
+	orders []*ModifyOrder
+}
+
+// ModifyBatchOrdersResponse contains the response from ModifyBatchOrders operation
+type ModifyBatchOrdersResponse struct {
+	// Total number of messages in the response
+	N int
+	// List of orders which were modified successfully which can have a length between 0 and N
+	Orders []*Order
+	// List of errors of length N, where each item corresponds to a nil value if
+	// the order from that specific index was modified successfully OR an non-nil *APIError if there was an error with
+	// the order at that index
+	Errors []error
+}
+
+// OrderList set the orders to modify
+func (s *ModifyBatchOrdersService) OrderList(orders []*ModifyOrder) *ModifyBatchOrdersService {
+	s.orders = orders
+	return s
+}
+
+// Do send request
+func (s *ModifyBatchOrdersService) Do(ctx context.Context, opts ...RequestOption) (res *ModifyBatchOrdersResponse, err error) {
+	orders := make([]params, 0, len(s.orders))
+	for _, order := range s.orders {
+		m := order.params()
+		// orderId is sent as a string to avoid API error with code -1102
+		if order.orderID != nil {
+			m["orderId"] = strconv.FormatInt(*order.orderID, 10)
+		}
+		orders = append(orders, m)
+	}
+	n, rawOrders, errs, err := s.c.batchOrders(ctx, http.MethodPut, orders, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &ModifyBatchOrdersResponse{N: n, Orders: rawOrders, Errors: errs}, nil
+}
+
+// batchOrders sends orders to the batch orders endpoint, each item of the response being either an order or an API error
+func (c *Client) batchOrders(ctx context.Context, method string, orders []params, opts ...RequestOption) (n int, res []*Order, errs []error, err error) {
+	r := &request{
+		method:   method,
+		endpoint: "/dapi/v1/batchOrders",
+		secType:  secTypeSigned,
+	}
+	b, err := json.Marshal(orders)
+	if err != nil {
+		return 0, nil, nil, err
+	}
+	r.setFormParam("batchOrders", string(b))
+	data, err := c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return 0, nil, nil, err
+	}
+	rawMessages := make([]*json.RawMessage, 0)
+	err = json.Unmarshal(data, &rawMessages)
+	if err != nil {
+		return 0, nil, nil, err
+	}
+	errs = make([]error, len(rawMessages))
+	for i, j := range rawMessages {
+		// check if response is an API error
+		e := new(common.APIError)
+		if err := json.Unmarshal(*j, e); err != nil {
+			return 0, nil, nil, err
+		}
+		if e.Code > 0 || e.Message != "" {
+			errs[i] = e
+			continue
+		}
+		o := new(Order)
+		if err := json.Unmarshal(*j, o); err != nil {
+			return 0, nil, nil, err
+		}
+		res = append(res, o)
+	}
+	return len(rawMessages), res, errs, nil
+}
+
+// CancelMultiplesOrdersService cancel a list of orders
+type CancelMultiplesOrdersService struct {
+	c                     *Client
+	symbol                string
+	orderIDList           []int64
+	origClientOrderIDList []string
+}
+
+// Symbol set symbol
+func (s *CancelMultiplesOrdersService) Symbol(symbol string) *CancelMultiplesOrdersService {
+	s.symbol = symbol
+	return s
+}
+
+// OrderIDList set orderIDList
+func (s *CancelMultiplesOrdersService) OrderIDList(orderIDList []int64) *CancelMultiplesOrdersService {
+	s.orderIDList = orderIDList
+	return s
+}
+
+// OrigClientOrderIDList set origClientOrderIDList
+func (s *CancelMultiplesOrdersService) OrigClientOrderIDList(origClientOrderIDList []string) *CancelMultiplesOrdersService {
+	s.origClientOrderIDList = origClientOrderIDList
+	return s
+}
+
+// Do send request
+func (s *CancelMultiplesOrdersService) Do(ctx context.Context, opts ...RequestOption) (res []*CancelOrderResponse, err error) {
+	r := &request{
+		method:   http.MethodDelete,
+		endpoint: "/dapi/v1/batchOrders",
+		secType:  secTypeSigned,
+	}
+	r.setFormParam("symbol", s.symbol)
+	if s.orderIDList != nil {
+		b, err := json.Marshal(s.orderIDList)
+		if err != nil {
+			return nil, err
+		}
+		r.setFormParam("orderIdList", string(b))
+	}
+	if s.origClientOrderIDList != nil {
+		b, err := json.Marshal(s.origClientOrderIDList)
+		if err != nil {
+			return nil, err
+		}
+		r.setFormParam("origClientOrderIdList", string(b))
+	}
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = make([]*CancelOrderResponse, 0)
+	err = json.Unmarshal(data, &res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// CountdownCancelAllService cancel all open orders of a symbol at the end of a countdown,
+// the countdown being reset by each call
+type CountdownCancelAllService struct {
+	c             *Client
+	symbol        string
+	countdownTime int64
+}
+
+// Symbol set symbol
+func (s *CountdownCancelAllService) Symbol(symbol string) *CountdownCancelAllService {
+	s.symbol = symbol
+	return s
+}
+
+// CountdownTime set countdownTime in milliseconds, 0 cancels the countdown
+func (s *CountdownCancelAllService) CountdownTime(countdownTime int64) *CountdownCancelAllService {
+	s.countdownTime = countdownTime
+	return s
+}
+
+// Do send request
+func (s *CountdownCancelAllService) Do(ctx context.Context, opts ...RequestOption) (res *CountdownCancelAllResponse, err error) {
+	r := &request{
+		method:   http.MethodPost,
+		endpoint: "/dapi/v1/countdownCancelAll",
+		secType:  secTypeSigned,
+	}
+	r.setFormParams(params{
+		"symbol":        s.symbol,
+		"countdownTime": s.countdownTime,
+	})
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(CountdownCancelAllResponse)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// CountdownCancelAllResponse define countdown cancel all response
+type CountdownCancelAllResponse struct {
+	Symbol        string `json:"symbol"`
+	CountdownTime string `json:"countdownTime"`
+}
+
+// GetOpenOrderService query current open order
+type GetOpenOrderService struct {
+	c                 *Client
+	symbol            string
+	orderID           *int64
+	origClientOrderID *string
+}
+
+// Symbol set symbol
+func (s *GetOpenOrderService) Symbol(symbol string) *GetOpenOrderService {
+	s.symbol = symbol
+	return s
+}
+
+// OrderID set orderID
+func (s *GetOpenOrderService) OrderID(orderID int64) *GetOpenOrderService {
+	s.orderID = &orderID
+	return s
+}
+
+// OrigClientOrderID set origClientOrderID
+func (s *GetOpenOrderService) OrigClientOrderID(origClientOrderID string) *GetOpenOrderService {
+	s.origClientOrderID = &origClientOrderID
+	return s
+}
+
+// Do send request
+func (s *GetOpenOrderService) Do(ctx context.Context, opts ...RequestOption) (res *Order, err error) {
+	if s.orderID == nil && s.origClientOrderID == nil {
+		return nil, errors.New("either orderId or origClientOrderId must be sent")
+	}
+	r := &request{
+		method:   http.MethodGet,
+		endpoint: "/dapi/v1/openOrder",
+		secType:  secTypeSigned,
+	}
+	r.setParam("symbol", s.symbol)
+	if s.orderID != nil {
+		r.setParam("orderId", *s.orderID)
+	}
+	if s.origClientOrderID != nil {
+		r.setParam("origClientOrderId", *s.origClientOrderID)
+	}
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(Order)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// ListUserLiquidationOrdersService lists user's liquidation orders
+type ListUserLiquidationOrdersService struct {
+	c             *Client
+	symbol        *string
+	autoCloseType *ForceOrderCloseType
+	startTime     *int64
+	endTime       *int64
+	limit         *int
+}
+
+// Symbol set symbol
+func (s *ListUserLiquidationOrdersService) Symbol(symbol string) *ListUserLiquidationOrdersService {
+	s.symbol = &symbol
+	return s
+}
+
+// AutoCloseType set autoCloseType
+func (s *ListUserLiquidationOrdersService) AutoCloseType(autoCloseType ForceOrderCloseType) *ListUserLiquidationOrdersService {
+	s.autoCloseType = &autoCloseType
+	return s
+}
+
+// StartTime set startTime
+func (s *ListUserLiquidationOrdersService) StartTime(startTime int64) *ListUserLiquidationOrdersService {
+	s.startTime = &startTime
+	return s
+}
+
+// EndTime set endTime
+func (s *ListUserLiquidationOrdersService) EndTime(endTime int64) *ListUserLiquidationOrdersService {
+	s.endTime = &endTime
+	return s
+}
+
+// Limit set limit
+func (s *ListUserLiquidationOrdersService) Limit(limit int) *ListUserLiquidationOrdersService {
+	s.limit = &limit
+	return s
+}
+
+// Do send request
+func (s *ListUserLiquidationOrdersService) Do(ctx context.Context, opts ...RequestOption) (res []*UserLiquidationOrder, err error) {
+	r := &request{
+		method:   http.MethodGet,
+		endpoint: "/dapi/v1/forceOrders",
+		secType:  secTypeSigned,
+	}
+	if s.symbol != nil {
+		r.setParam("symbol", *s.symbol)
+	}
+	if s.autoCloseType != nil {
+		r.setParam("autoCloseType", *s.autoCloseType)
+	}
+	if s.startTime != nil {
+		r.setParam("startTime", *s.startTime)
+	}
+	if s.endTime != nil {
+		r.setParam("endTime", *s.endTime)
+	}
+	if s.limit != nil {
+		r.setParam("limit", *s.limit)
+	}
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return []*UserLiquidationOrder{}, err
+	}
+	res = make([]*UserLiquidationOrder, 0)
+	err = json.Unmarshal(data, &res)
+	if err != nil {
+		return []*UserLiquidationOrder{}, err
+	}
+	return res, nil
+}
+
+// UserLiquidationOrder defines user's liquidation order
+type UserLiquidationOrder struct {
+	OrderID          int64            `json:"orderId"`
+	Symbol           string           `json:"symbol"`
+	Pair             string           `json:"pair"`
+	Status           OrderStatusType  `json:"status"`
+	ClientOrderID    string           `json:"clientOrderId"`
+	Price            string           `json:"price"`
+	AveragePrice     string           `json:"avgPrice"`
+	OrigQuantity     string           `json:"origQty"`
+	ExecutedQuantity string           `json:"executedQty"`
+	CumBase          string           `json:"cumBase"`
+	TimeInForce      TimeInForceType  `json:"timeInForce"`
+	Type             OrderType        `json:"type"`
+	ReduceOnly       bool             `json:"reduceOnly"`
+	ClosePosition    bool             `json:"closePosition"`
+	Side             SideType         `json:"side"`
+	PositionSide     PositionSideType `json:"positionSide"`
+	StopPrice        string           `json:"stopPrice"`
+	WorkingType      WorkingType      `json:"workingType"`
+	PriceProtect     bool             `json:"priceProtect"`
+	OrigType         OrderType        `json:"origType"`
+	Time             int64            `json:"time"`
+	UpdateTime       int64            `json:"updateTime"`
+}
@@ -1,6 +1,7 @@
 package delivery
 
 import (
+	"net/http"
 	"strconv"
 	"testing"
 
@@ -599,3 +600,284 @@ func (s *orderServiceTestSuite) assertLiquidationEqual(e, a *LiquidationOrder) {
 	r.Equal(e.Side, a.Side, "Side")
 	r.Equal(e.Time, a.Time, "Time")
 }
+
+func (s *orderServiceTestSuite) TestModifyOrder() {
+	data := []byte(`{
+		"orderId": 20072994037,
+		"symbol": "BTCUSD_PERP",
+		"pair": "BTCUSD",
+		"status": "NEW",
+		"clientOrderId": "LJ9R4QZDihCaS8UAOOLpgW",
+		"price": "30005",
+		"avgPrice": "0.0",
+		"origQty": "1",
+		"executedQty": "0",
+		"cumBase": "0",
+		"timeInForce": "GTC",
+		"type": "LIMIT",
+		"reduceOnly": false,
+		"side": "BUY",
+		"positionSide": "LONG",
+		"stopPrice": "0",
+		"workingType": "CONTRACT_PRICE",
+		"priceProtect": false,
+		"origType": "LIMIT",
+		"updateTime": 1629182711600
+	}`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+
+	s.assertReq(func(r *request) {
+		s.r().Equal("/dapi/v1/order", r.endpoint)
+		e := newSignedRequest().setFormParams(params{
+			"symbol":   "BTCUSD_PERP",
+			"side":     SideTypeBuy,
+			"orderId":  20072994037,
+			"quantity": "1",
+			"price":    "30005",
+		})
+		s.assertRequestEqual(e, r)
+	})
+	res, err := s.client.NewModifyOrderService().Symbol("BTCUSD_PERP").Side(SideTypeBuy).
+		OrderID(20072994037).Quantity("1").Price("30005").Do(newContext())
+	r := s.r()
+	r.NoError(err)
+	r.Equal(int64(20072994037), res.OrderID)
+	r.Equal("30005", res.Price)
+	r.Equal(PositionSideTypeLong, res.PositionSide)
+	r.Equal(int64(1629182711600), res.UpdateTime)
+}
+
+func (s *orderServiceTestSuite) TestCreateBatchOrders() {
+	data := []byte(`[
+		{
+			"clientOrderId": "testOrder",
+			"orderId": 22542179,
+			"origQty": "10",
+			"price": "9000",
+			"side": "BUY",
+			"positionSide": "BOTH",
+			"status": "NEW",
+			"symbol": "BTCUSD_PERP",
+			"pair": "BTCUSD",
+			"timeInForce": "GTC",
+			"type": "LIMIT",
+			"updateTime": 1566818724722
+		},
+		{
+			"code": -2022,
+			"msg": "ReduceOnly Order is rejected."
+		}
+	]`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+
+	s.assertReq(func(r *request) {
+		s.r().Equal("/dapi/v1/batchOrders", r.endpoint)
+		s.r().Equal(`[{"newClientOrderId":"testOrder","newOrderRespType":"","price":"9000","quantity":"10","selfTradePreventionMode":"EXPIRE_TAKER","side":"BUY","symbol":"BTCUSD_PERP","timeInForce":"GTC","type":"LIMIT"},{"newClientOrderId":"reduce","newOrderRespType":"","quantity":"1","reduceOnly":"true","side":"SELL","symbol":"BTCUSD_PERP","type":"MARKET"}]`,
+			r.form.Get("batchOrders"))
+	})
+	res, err := s.client.NewCreateBatchOrdersService().OrderList([]*CreateOrderService{
+		s.client.NewCreateOrderService().Symbol("BTCUSD_PERP").Side(SideTypeBuy).Type(OrderTypeLimit).
+			TimeInForce(TimeInForceTypeGTC).Quantity("10").Price("9000").NewClientOrderID("testOrder").
+			SelfTradePreventionMode(SelfTradePreventionModeExpireTaker),
+		s.client.NewCreateOrderService().Symbol("BTCUSD_PERP").Side(SideTypeSell).Type(OrderTypeMarket).
+			Quantity("1").ReduceOnly(true).NewClientOrderID("reduce"),
+	}).Do(newContext())
+	r := s.r()
+	r.NoError(err)
+	r.Equal(2, res.N)
+	r.Len(res.Orders, 1)
+	r.Equal(int64(22542179), res.Orders[0].OrderID)
+	r.Len(res.Errors, 2)
+	r.Nil(res.Errors[0])
+	r.EqualError(res.Errors[1], "<APIError> code=-2022, msg=ReduceOnly Order is rejected.")
+}
+
+func (s *orderServiceTestSuite) TestModifyBatchOrders() {
+	data := []byte(`[
+		{
+			"orderId": 20072994037,
+			"symbol": "BTCUSD_PERP",
+			"status": "NEW",
+			"price": "30005",
+			"origQty": "1",
+			"side": "BUY",
+			"updateTime": 1629182711600
+		}
+	]`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+
+	s.assertReq(func(r *request) {
+		s.r().Equal(http.MethodPut, r.method)
+		s.r().Equal(`[{"orderId":"20072994037","price":"30005","quantity":"1","side":"BUY","symbol":"BTCUSD_PERP"}]`,
+			r.form.Get("batchOrders"))
+	})
+	res, err := s.client.NewModifyBatchOrdersService().OrderList([]*ModifyOrder{
+		NewModifyOrder().Symbol("BTCUSD_PERP").Side(SideTypeBuy).OrderID(20072994037).Quantity("1").Price("30005"),
+	}).Do(newContext())
+	r := s.r()
+	r.NoError(err)
+	r.Equal(1, res.N)
+	r.Len(res.Orders, 1)
+	r.Equal("30005", res.Orders[0].Price)
+	r.Equal([]error{nil}, res.Errors)
+}
+
+func (s *orderServiceTestSuite) TestCancelMultipleOrders() {
+	data := []byte(`[
+		{
+			"clientOrderId": "myOrder1",
+			"orderId": 283194212,
+			"origQty": "11",
+			"price": "0",
+			"side": "BUY",
+			"status": "CANCELED",
+			"symbol": "BTCUSD_200925",
+			"pair": "BTCUSD",
+			"type": "TRAILING_STOP_MARKET",
+			"updateTime": 1571110484038
+		}
+	]`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+
+	s.assertReq(func(r *request) {
+		e := newSignedRequest().setFormParams(params{
+			"symbol":                "BTCUSD_200925",
+			"orderIdList":           "[283194212,283194213]",
+			"origClientOrderIdList": `["myOrder1"]`,
+		})
+		s.assertRequestEqual(e, r)
+	})
+	res, err := s.client.NewCancelMultipleOrdersService().Symbol("BTCUSD_200925").
+		OrderIDList([]int64{283194212, 283194213}).OrigClientOrderIDList([]string{"myOrder1"}).Do(newContext())
+	r := s.r()
+	r.NoError(err)
+	r.Len(res, 1)
+	r.Equal(int64(283194212), res[0].OrderID)
+	r.Equal(OrderStatusTypeCanceled, res[0].Status)
+}
+
+func (s *orderServiceTestSuite) TestCountdownCancelAll() {
+	data := []byte(`{
+		"symbol": "BTCUSD_200925",
+		"countdownTime": "100000"
+	}`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+
+	s.assertReq(func(r *request) {
+		e := newSignedRequest().setFormParams(params{
+			"symbol":        "BTCUSD_200925",
+			"countdownTime": 100000,
+		})
+		s.assertRequestEqual(e, r)
+	})
+	res, err := s.client.NewCountdownCancelAllService().Symbol("BTCUSD_200925").CountdownTime(100000).Do(newContext())
+	r := s.r()
+	r.NoError(err)
+	r.Equal(&CountdownCancelAllResponse{Symbol: "BTCUSD_200925", CountdownTime: "100000"}, res)
+}
+
+func (s *orderServiceTestSuite) TestGetOpenOrder() {
+	data := []byte(`{
+		"avgPrice": "0.0",
+		"clientOrderId": "abc",
+		"orderId": 1917641,
+		"origQty": "0.40",
+		"price": "0",
+		"side": "BUY",
+		"status": "NEW",
+		"symbol": "BTCUSD_200925",
+		"time": 1579276756075,
+		"type": "LIMIT"
+	}`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+
+	s.assertReq(func(r *request) {
+		s.r().Equal("/dapi/v1/openOrder", r.endpoint)
+		e := newSignedRequest().setParams(params{
+			"symbol":  "BTCUSD_200925",
+			"orderId": 1917641,
+		})
+		s.assertRequestEqual(e, r)
+	})
+	res, err := s.client.NewGetOpenOrderService().Symbol("BTCUSD_200925").OrderID(1917641).Do(newContext())
+	r := s.r()
+	r.NoError(err)
+	r.Equal(int64(1917641), res.OrderID)
+	r.Equal("abc", res.ClientOrderID)
+
+	_, err = s.client.NewGetOpenOrderService().Symbol("BTCUSD_200925").Do(newContext())
+	r.Error(err)
+}
+
+func (s *orderServiceTestSuite) TestListUserLiquidationOrders() {
+	data := []byte(`[
+		{
+			"orderId": 165123080,
+			"symbol": "BTCUSD_200925",
+			"pair": "BTCUSD",
+			"status": "FILLED",
+			"clientOrderId": "autoclose-1596542005017000006",
+			"price": "11326.9",
+			"avgPrice": "11326.9",
+			"origQty": "1",
+			"executedQty": "1",
+			"cumBase": "0.00882854",
+			"timeInForce": "IOC",
+			"type": "LIMIT",
+			"reduceOnly": false,
+			"closePosition": false,
+			"side": "SELL",
+			"positionSide": "BOTH",
+			"stopPrice": "0",
+			"workingType": "CONTRACT_PRICE",
+			"priceProtect": false,
+			"origType": "LIMIT",
+			"time": 1596542005019,
+			"updateTime": 1596542005050
+		}
+	]`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+
+	s.assertReq(func(r *request) {
+		e := newSignedRequest().setParams(params{
+			"symbol":        "BTCUSD_200925",
+			"autoCloseType": ForceOrderCloseTypeLiquidation,
+			"limit":         10,
+		})
+		s.assertRequestEqual(e, r)
+	})
+	res, err := s.client.NewListUserLiquidationOrdersService().Symbol("BTCUSD_200925").
+		AutoCloseType(ForceOrderCloseTypeLiquidation).Limit(10).Do(newContext())
+	r := s.r()
+	r.NoError(err)
+	r.Equal([]*UserLiquidationOrder{
+		{
+			OrderID:          165123080,
+			Symbol:           "BTCUSD_200925",
+			Pair:             "BTCUSD",
+			Status:           OrderStatusTypeFilled,
+			ClientOrderID:    "autoclose-1596542005017000006",
+			Price:            "11326.9",
+			AveragePrice:     "11326.9",
+			OrigQuantity:     "1",
+			ExecutedQuantity: "1",
+			CumBase:          "0.00882854",
+			TimeInForce:      TimeInForceTypeIOC,
+			Type:             OrderTypeLimit,
+			Side:             SideTypeSell,
+			PositionSide:     PositionSideTypeBoth,
+			StopPrice:        "0",
+			WorkingType:      WorkingTypeContractPrice,
+			OrigType:         OrderTypeLimit,
+			Time:             1596542005019,
+			UpdateTime:       1596542005050,
+		},
+	}, res)
+}
@@ -0,0 +1,53 @@
+package delivery
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type positionMarginHistoryServiceTestSuite struct {
+	baseTestSuite
+}
+
+func TestPositionMarginHistoryService(t *testing.T) {
+	suite.Run(t, new(positionMarginHistoryServiceTestSuite))
+}
+
+func (s *positionMarginHistoryServiceTestSuite) TestGetPositionMarginHistory() {
+	data := []byte(`[
+		{
+			"amount": "23.36332311",
+			"asset": "BTC",
+			"symbol": "BTCUSD_200925",
+			"time": 1578047897183,
+			"type": 1,
+			"positionSide": "BOTH"
+		}
+	]`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+
+	s.assertReq(func(r *request) {
+		e := newSignedRequest().setParams(params{
+			"symbol": "BTCUSD_200925",
+			"type":   1,
+			"limit":  int64(10),
+		})
+		s.assertRequestEqual(e, r)
+	})
+	res, err := s.client.NewGetPositionMarginHistoryService().Symbol("BTCUSD_200925").
+		Type(1).Limit(10).Do(newContext())
+	r := s.r()
+	r.NoError(err)
+	r.Equal([]*PositionMarginHistory{
+		{
+			Amount:       "23.36332311",
+			Asset:        "BTC",
+			Symbol:       "BTCUSD_200925",
+			Time:         1578047897183,
+			Type:         1,
+			PositionSide: PositionSideTypeBoth,
+		},
+	}, res)
+}
@@ -0,0 +1,1276 @@
+// Code generated by servicegen. DO NOT EDIT.
+
+package delivery
+
+import (
+	"context"
+)
+
+// ClientAPI is the interface of the REST services of Client, see NewClientAPI
+type ClientAPI interface {
+	NewPingService() PingServiceAPI
+	NewServerTimeService() ServerTimeServiceAPI
+	NewSetServerTimeService() SetServerTimeServiceAPI
+	NewKlinesService() KlinesServiceAPI
+	NewListPriceChangeStatsService() ListPriceChangeStatsServiceAPI
+	NewListPricesService() ListPricesServiceAPI
+	NewListBookTickersService() ListBookTickersServiceAPI
+	NewStartUserStreamService() StartUserStreamServiceAPI
+	NewKeepaliveUserStreamService() KeepaliveUserStreamServiceAPI
+	NewCloseUserStreamService() CloseUserStreamServiceAPI
+	NewExchangeInfoService() ExchangeInfoServiceAPI
+	NewCreateOrderService() CreateOrderServiceAPI
+	NewModifyOrderService() ModifyOrderServiceAPI
+	NewCreateBatchOrdersService() CreateBatchOrdersServiceAPI
+	NewModifyBatchOrdersService() ModifyBatchOrdersServiceAPI
+	NewGetOrderService() GetOrderServiceAPI
+	NewCancelOrderService() CancelOrderServiceAPI
+	NewCancelAllOpenOrdersService() CancelAllOpenOrdersServiceAPI
+	NewCancelMultipleOrdersService() CancelMultiplesOrdersServiceAPI
+	NewCountdownCancelAllService() CountdownCancelAllServiceAPI
+	NewGetOpenOrderService() GetOpenOrderServiceAPI
+	NewListOpenOrdersService() ListOpenOrdersServiceAPI
+	NewListOrdersService() ListOrdersServiceAPI
+	NewListLiquidationOrdersService() ListLiquidationOrdersServiceAPI
+	NewListUserLiquidationOrdersService() ListUserLiquidationOrdersServiceAPI
+	NewListAccountTradeService() ListAccountTradeServiceAPI
+	NewGetIncomeHistoryService() GetIncomeHistoryServiceAPI
+	NewCommissionRateService() CommissionRateServiceAPI
+	NewGetDownloadIDService() GetDownloadIDServiceAPI
+	NewGetDownloadLinkService() GetDownloadLinkServiceAPI
+	NewGetAccountService() GetAccountServiceAPI
+	NewGetBalanceService() GetBalanceServiceAPI
+	NewGetPositionRiskService() GetPositionRiskServiceAPI
+	NewChangeLeverageService() ChangeLeverageServiceAPI
+	NewGetLeverageBracketService() GetLeverageBracketServiceAPI
+	NewGetADLQuantileService() GetADLQuantileServiceAPI
+	NewChangeMarginTypeService() ChangeMarginTypeServiceAPI
+	NewUpdatePositionMarginService() UpdatePositionMarginServiceAPI
+	NewGetPositionMarginHistoryService() GetPositionMarginHistoryServiceAPI
+	NewChangePositionModeService() ChangePositionModeServiceAPI
+	NewGetPositionModeService() GetPositionModeServiceAPI
+	NewGetFundingInfoService() GetFundingInfoServiceAPI
+	NewFundingRateService() FundingRateServiceAPI
+}
+
+// NewClientAPI returns c as a ClientAPI
+func NewClientAPI(c *Client) ClientAPI {
+	return (*clientAPI)(c)
+}
+
+type clientAPI Client
+
+func (a *clientAPI) NewPingService() PingServiceAPI {
+	return (*pingServiceAPI)((*Client)(a).NewPingService())
+}
+
+func (a *clientAPI) NewServerTimeService() ServerTimeServiceAPI {
+	return (*serverTimeServiceAPI)((*Client)(a).NewServerTimeService())
+}
+
+func (a *clientAPI) NewSetServerTimeService() SetServerTimeServiceAPI {
+	return (*setServerTimeServiceAPI)((*Client)(a).NewSetServerTimeService())
+}
+
+func (a *clientAPI) NewKlinesService() KlinesServiceAPI {
+	return (*klinesServiceAPI)((*Client)(a).NewKlinesService())
+}
+
+func (a *clientAPI) NewListPriceChangeStatsService() ListPriceChangeStatsServiceAPI {
+	return (*listPriceChangeStatsServiceAPI)((*Client)(a).NewListPriceChangeStatsService())
+}
+
+func (a *clientAPI) NewListPricesService() ListPricesServiceAPI {
+	return (*listPricesServiceAPI)((*Client)(a).NewListPricesService())
+}
+
+func (a *clientAPI) NewListBookTickersService() ListBookTickersServiceAPI {
+	return (*listBookTickersServiceAPI)((*Client)(a).NewListBookTickersService())
+}
+
+func (a *clientAPI) NewStartUserStreamService() StartUserStreamServiceAPI {
+	return (*startUserStreamServiceAPI)((*Client)(a).NewStartUserStreamService())
+}
+
+func (a *clientAPI) NewKeepaliveUserStreamService() KeepaliveUserStreamServiceAPI {
+	return (*keepaliveUserStreamServiceAPI)((*Client)(a).NewKeepaliveUserStreamService())
+}
+
+func (a *clientAPI) NewCloseUserStreamService() CloseUserStreamServiceAPI {
+	return (*closeUserStreamServiceAPI)((*Client)(a).NewCloseUserStreamService())
+}
+
+func (a *clientAPI) NewExchangeInfoService() ExchangeInfoServiceAPI {
+	return (*exchangeInfoServiceAPI)((*Client)(a).NewExchangeInfoService())
+}
+
+func (a *clientAPI) NewCreateOrderService() CreateOrderServiceAPI {
+	return (*createOrderServiceAPI)((*Client)(a).NewCreateOrderService())
+}
+
+func (a *clientAPI) NewModifyOrderService() ModifyOrderServiceAPI {
+	return (*modifyOrderServiceAPI)((*Client)(a).NewModifyOrderService())
+}
+
+func (a *clientAPI) NewCreateBatchOrdersService() CreateBatchOrdersServiceAPI {
+	return (*createBatchOrdersServiceAPI)((*Client)(a).NewCreateBatchOrdersService())
+}
+
+func (a *clientAPI) NewModifyBatchOrdersService() ModifyBatchOrdersServiceAPI {
+	return (*modifyBatchOrdersServiceAPI)((*Client)(a).NewModifyBatchOrdersService())
+}
+
+func (a *clientAPI) NewGetOrderService() GetOrderServiceAPI {
+	return (*getOrderServiceAPI)((*Client)(a).NewGetOrderService())
+}
+
+func (a *clientAPI) NewCancelOrderService() CancelOrderServiceAPI {
+	return (*cancelOrderServiceAPI)((*Client)(a).NewCancelOrderService())
+}
+
+func (a *clientAPI) NewCancelAllOpenOrdersService() CancelAllOpenOrdersServiceAPI {
+	return (*cancelAllOpenOrdersServiceAPI)((*Client)(a).NewCancelAllOpenOrdersService())
+}
+
+func (a *clientAPI) NewCancelMultipleOrdersService() CancelMultiplesOrdersServiceAPI {
+	return (*cancelMultiplesOrdersServiceAPI)((*Client)(a).NewCancelMultipleOrdersService())
+}
+
+func (a *clientAPI) NewCountdownCancelAllService() CountdownCancelAllServiceAPI {
+	return (*countdownCancelAllServiceAPI)((*Client)(a).NewCountdownCancelAllService())
+}
+
+func (a *clientAPI) NewGetOpenOrderService() GetOpenOrderServiceAPI {
+	return (*getOpenOrderServiceAPI)((*Client)(a).NewGetOpenOrderService())
+}
+
+func (a *clientAPI) NewListOpenOrdersService() ListOpenOrdersServiceAPI {
+	return (*listOpenOrdersServiceAPI)((*Client)(a).NewListOpenOrdersService())
+}
+
+func (a *clientAPI) NewListOrdersService() ListOrdersServiceAPI {
+	return (*listOrdersServiceAPI)((*Client)(a).NewListOrdersService())
+}
+
+func (a *clientAPI) NewListLiquidationOrdersService() ListLiquidationOrdersServiceAPI {
+	return (*listLiquidationOrdersServiceAPI)((*Client)(a).NewListLiquidationOrdersService())
+}
+
+func (a *clientAPI) NewListUserLiquidationOrdersService() ListUserLiquidationOrdersServiceAPI {
+	return (*listUserLiquidationOrdersServiceAPI)((*Client)(a).NewListUserLiquidationOrdersService())
+}
+
+func (a *clientAPI) NewListAccountTradeService() ListAccountTradeServiceAPI {
+	return (*listAccountTradeServiceAPI)((*Client)(a).NewListAccountTradeService())
+}
+
+func (a *clientAPI) NewGetIncomeHistoryService() GetIncomeHistoryServiceAPI {
+	return (*getIncomeHistoryServiceAPI)((*Client)(a).NewGetIncomeHistoryService())
+}
+
+func (a *clientAPI) NewCommissionRateService() CommissionRateServiceAPI {
+	return (*commissionRateServiceAPI)((*Client)(a).NewCommissionRateService())
+}
+
+func (a *clientAPI) NewGetDownloadIDService() GetDownloadIDServiceAPI {
+	return (*getDownloadIDServiceAPI)((*Client)(a).NewGetDownloadIDService())
+}
+
+func (a *clientAPI) NewGetDownloadLinkService() GetDownloadLinkServiceAPI {
+	return (*getDownloadLinkServiceAPI)((*Client)(a).NewGetDownloadLinkService())
+}
+
+func (a *clientAPI) NewGetAccountService() GetAccountServiceAPI {
+	return (*getAccountServiceAPI)((*Client)(a).NewGetAccountService())
+}
+
+func (a *clientAPI) NewGetBalanceService() GetBalanceServiceAPI {
+	return (*getBalanceServiceAPI)((*Client)(a).NewGetBalanceService())
+}
+
+func (a *clientAPI) NewGetPositionRiskService() GetPositionRiskServiceAPI {
+	return (*getPositionRiskServiceAPI)((*Client)(a).NewGetPositionRiskService())
+}
+
+func (a *clientAPI) NewChangeLeverageService() ChangeLeverageServiceAPI {
+	return (*changeLeverageServiceAPI)((*Client)(a).NewChangeLeverageService())
+}
+
+func (a *clientAPI) NewGetLeverageBracketService() GetLeverageBracketServiceAPI {
+	return (*getLeverageBracketServiceAPI)((*Client)(a).NewGetLeverageBracketService())
+}
+
+func (a *clientAPI) NewGetADLQuantileService() GetADLQuantileServiceAPI {
+	return (*getADLQuantileServiceAPI)((*Client)(a).NewGetADLQuantileService())
+}
+
+func (a *clientAPI) NewChangeMarginTypeService() ChangeMarginTypeServiceAPI {
+	return (*changeMarginTypeServiceAPI)((*Client)(a).NewChangeMarginTypeService())
+}
+
+func (a *clientAPI) NewUpdatePositionMarginService() UpdatePositionMarginServiceAPI {
+	return (*updatePositionMarginServiceAPI)((*Client)(a).NewUpdatePositionMarginService())
+}
+
+func (a *clientAPI) NewGetPositionMarginHistoryService() GetPositionMarginHistoryServiceAPI {
+	return (*getPositionMarginHistoryServiceAPI)((*Client)(a).NewGetPositionMarginHistoryService())
+}
+
+func (a *clientAPI) NewChangePositionModeService() ChangePositionModeServiceAPI {
+	return (*changePositionModeServiceAPI)((*Client)(a).NewChangePositionModeService())
+}
+
+func (a *clientAPI) NewGetPositionModeService() GetPositionModeServiceAPI {
+	return (*getPositionModeServiceAPI)((*Client)(a).NewGetPositionModeService())
+}
+
+func (a *clientAPI) NewGetFundingInfoService() GetFundingInfoServiceAPI {
+	return (*getFundingInfoServiceAPI)((*Client)(a).NewGetFundingInfoService())
+}
+
+func (a *clientAPI) NewFundingRateService() FundingRateServiceAPI {
+	return (*fundingRateServiceAPI)((*Client)(a).NewFundingRateService())
+}
+
+// PingServiceAPI is the interface of PingService
+type PingServiceAPI interface {
+	Do(ctx context.Context, opts ...RequestOption) error
+}
+
+type pingServiceAPI PingService
+
+func (a *pingServiceAPI) Do(ctx context.Context, opts ...RequestOption) error {
+	return (*PingService)(a).Do(ctx, opts...)
+}
+
+// ServerTimeServiceAPI is the interface of ServerTimeService
+type ServerTimeServiceAPI interface {
+	Do(ctx context.Context, opts ...RequestOption) (int64, error)
+}
+
+type serverTimeServiceAPI ServerTimeService
+
+func (a *serverTimeServiceAPI) Do(ctx context.Context, opts ...RequestOption) (int64, error) {
+	return (*ServerTimeService)(a).Do(ctx, opts...)
+}
+
+// SetServerTimeServiceAPI is the interface of SetServerTimeService
+type SetServerTimeServiceAPI interface {
+	Do(ctx context.Context, opts ...RequestOption) (int64, error)
+}
+
+type setServerTimeServiceAPI SetServerTimeService
+
+func (a *setServerTimeServiceAPI) Do(ctx context.Context, opts ...RequestOption) (int64, error) {
+	return (*SetServerTimeService)(a).Do(ctx, opts...)
+}
+
+// KlinesServiceAPI is the interface of KlinesService
+type KlinesServiceAPI interface {
+	Symbol(symbol string) KlinesServiceAPI
+	Interval(interval string) KlinesServiceAPI
+	Limit(limit int) KlinesServiceAPI
+	StartTime(startTime int64) KlinesServiceAPI
+	EndTime(endTime int64) KlinesServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) ([]*Kline, error)
+}
+
+type klinesServiceAPI KlinesService
+
+func (a *klinesServiceAPI) Symbol(symbol string) KlinesServiceAPI {
+	return (*klinesServiceAPI)((*KlinesService)(a).Symbol(symbol))
+}
+
+func (a *klinesServiceAPI) Interval(interval string) KlinesServiceAPI {
+	return (*klinesServiceAPI)((*KlinesService)(a).Interval(interval))
+}
+
+func (a *klinesServiceAPI) Limit(limit int) KlinesServiceAPI {
+	return (*klinesServiceAPI)((*KlinesService)(a).Limit(limit))
+}
+
+func (a *klinesServiceAPI) StartTime(startTime int64) KlinesServiceAPI {
+	return (*klinesServiceAPI)((*KlinesService)(a).StartTime(startTime))
+}
+
+func (a *klinesServiceAPI) EndTime(endTime int64) KlinesServiceAPI {
+	return (*klinesServiceAPI)((*KlinesService)(a).EndTime(endTime))
+}
+
+func (a *klinesServiceAPI) Do(ctx context.Context, opts ...RequestOption) ([]*Kline, error) {
+	return (*KlinesService)(a).Do(ctx, opts...)
+}
+
+// ListPriceChangeStatsServiceAPI is the interface of ListPriceChangeStatsService
+type ListPriceChangeStatsServiceAPI interface {
+	Symbol(symbol string) ListPriceChangeStatsServiceAPI
+	Pair(pair string) ListPriceChangeStatsServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) ([]*PriceChangeStats, error)
+}
+
+type listPriceChangeStatsServiceAPI ListPriceChangeStatsService
+
+func (a *listPriceChangeStatsServiceAPI) Symbol(symbol string) ListPriceChangeStatsServiceAPI {
+	return (*listPriceChangeStatsServiceAPI)((*ListPriceChangeStatsService)(a).Symbol(symbol))
+}
+
+func (a *listPriceChangeStatsServiceAPI) Pair(pair string) ListPriceChangeStatsServiceAPI {
+	return (*listPriceChangeStatsServiceAPI)((*ListPriceChangeStatsService)(a).Pair(pair))
+}
+
+func (a *listPriceChangeStatsServiceAPI) Do(ctx context.Context, opts ...RequestOption) ([]*PriceChangeStats, error) {
+	return (*ListPriceChangeStatsService)(a).Do(ctx, opts...)
+}
+
+// ListPricesServiceAPI is the interface of ListPricesService
+type ListPricesServiceAPI interface {
+	Symbol(symbol string) ListPricesServiceAPI
+	Pair(pair string) ListPricesServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) ([]*SymbolPrice, error)
+}
+
+type listPricesServiceAPI ListPricesService
+
+func (a *listPricesServiceAPI) Symbol(symbol string) ListPricesServiceAPI {
+	return (*listPricesServiceAPI)((*ListPricesService)(a).Symbol(symbol))
+}
+
+func (a *listPricesServiceAPI) Pair(pair string) ListPricesServiceAPI {
+	return (*listPricesServiceAPI)((*ListPricesService)(a).Pair(pair))
+}
+
+func (a *listPricesServiceAPI) Do(ctx context.Context, opts ...RequestOption) ([]*SymbolPrice, error) {
+	return (*ListPricesService)(a).Do(ctx, opts...)
+}
+
+// ListBookTickersServiceAPI is the interface of ListBookTickersService
+type ListBookTickersServiceAPI interface {
+	Symbol(symbol string) ListBookTickersServiceAPI
+	Pair(pair string) ListBookTickersServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) ([]*BookTicker, error)
+}
+
+type listBookTickersServiceAPI ListBookTickersService
+
+func (a *listBookTickersServiceAPI) Symbol(symbol string) ListBookTickersServiceAPI {
+	return (*listBookTickersServiceAPI)((*ListBookTickersService)(a).Symbol(symbol))
+}
+
+func (a *listBookTickersServiceAPI) Pair(pair string) ListBookTickersServiceAPI {
+	return (*listBookTickersServiceAPI)((*ListBookTickersService)(a).Pair(pair))
+}
+
+func (a *listBookTickersServiceAPI) Do(ctx context.Context, opts ...RequestOption) ([]*BookTicker, error) {
+	return (*ListBookTickersService)(a).Do(ctx, opts...)
+}
+
+// StartUserStreamServiceAPI is the interface of StartUserStreamService
+type StartUserStreamServiceAPI interface {
+	Do(ctx context.Context, opts ...RequestOption) (string, error)
+}
+
+type startUserStreamServiceAPI StartUserStreamService
+
+func (a *startUserStreamServiceAPI) Do(ctx context.Context, opts ...RequestOption) (string, error) {
+	return (*StartUserStreamService)(a).Do(ctx, opts...)
+}
+
+// KeepaliveUserStreamServiceAPI is the interface of KeepaliveUserStreamService
+type KeepaliveUserStreamServiceAPI interface {
+	ListenKey(listenKey string) KeepaliveUserStreamServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) error
+}
+
+type keepaliveUserStreamServiceAPI KeepaliveUserStreamService
+
+func (a *keepaliveUserStreamServiceAPI) ListenKey(listenKey string) KeepaliveUserStreamServiceAPI {
+	return (*keepaliveUserStreamServiceAPI)((*KeepaliveUserStreamService)(a).ListenKey(listenKey))
+}
+
+func (a *keepaliveUserStreamServiceAPI) Do(ctx context.Context, opts ...RequestOption) error {
+	return (*KeepaliveUserStreamService)(a).Do(ctx, opts...)
+}
+
+// CloseUserStreamServiceAPI is the interface of CloseUserStreamService
+type CloseUserStreamServiceAPI interface {
+	ListenKey(listenKey string) CloseUserStreamServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) error
+}
+
+type closeUserStreamServiceAPI CloseUserStreamService
+
+func (a *closeUserStreamServiceAPI) ListenKey(listenKey string) CloseUserStreamServiceAPI {
+	return (*closeUserStreamServiceAPI)((*CloseUserStreamService)(a).ListenKey(listenKey))
+}
+
+func (a *closeUserStreamServiceAPI) Do(ctx context.Context, opts ...RequestOption) error {
+	return (*CloseUserStreamService)(a).Do(ctx, opts...)
+}
+
+// ExchangeInfoServiceAPI is the interface of ExchangeInfoService
+type ExchangeInfoServiceAPI interface {
+	Do(ctx context.Context, opts ...RequestOption) (*ExchangeInfo, error)
+}
+
+type exchangeInfoServiceAPI ExchangeInfoService
+
+func (a *exchangeInfoServiceAPI) Do(ctx context.Context, opts ...RequestOption) (*ExchangeInfo, error) {
+	return (*ExchangeInfoService)(a).Do(ctx, opts...)
+}
+
+// CreateOrderServiceAPI is the interface of CreateOrderService
+type CreateOrderServiceAPI interface {
+	Symbol(symbol string) CreateOrderServiceAPI
+	Side(side SideType) CreateOrderServiceAPI
+	PositionSide(positionSide PositionSideType) CreateOrderServiceAPI
+	Type(orderType OrderType) CreateOrderServiceAPI
+	TimeInForce(timeInForce TimeInForceType) CreateOrderServiceAPI
+	Quantity(quantity string) CreateOrderServiceAPI
+	ReduceOnly(reduceOnly bool) CreateOrderServiceAPI
+	Price(price string) CreateOrderServiceAPI
+	NewClientOrderID(newClientOrderID string) CreateOrderServiceAPI
+	StopPrice(stopPrice string) CreateOrderServiceAPI
+	WorkingType(workingType WorkingType) CreateOrderServiceAPI
+	ActivationPrice(activationPrice string) CreateOrderServiceAPI
+	CallbackRate(callbackRate string) CreateOrderServiceAPI
+	PriceProtect(priceProtect bool) CreateOrderServiceAPI
+	NewOrderResponseType(newOrderResponseType NewOrderRespType) CreateOrderServiceAPI
+	ClosePosition(closePosition bool) CreateOrderServiceAPI
+	PriceMatch(priceMatch PriceMatchType) CreateOrderServiceAPI
+	SelfTradePreventionMode(selfTradePreventionMode SelfTradePreventionMode) CreateOrderServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) (*CreateOrderResponse, error)
+}
+
+type createOrderServiceAPI CreateOrderService
+
+func (a *createOrderServiceAPI) Symbol(symbol string) CreateOrderServiceAPI {
+	return (*createOrderServiceAPI)((*CreateOrderService)(a).Symbol(symbol))
+}
+
+func (a *createOrderServiceAPI) Side(side SideType) CreateOrderServiceAPI {
+	return (*createOrderServiceAPI)((*CreateOrderService)(a).Side(side))
+}
+
+func (a *createOrderServiceAPI) PositionSide(positionSide PositionSideType) CreateOrderServiceAPI {
+	return (*createOrderServiceAPI)((*CreateOrderService)(a).PositionSide(positionSide))
+}
+
+func (a *createOrderServiceAPI) Type(orderType OrderType) CreateOrderServiceAPI {
+	return (*createOrderServiceAPI)((*CreateOrderService)(a).Type(orderType))
+}
+
+func (a *createOrderServiceAPI) TimeInForce(timeInForce TimeInForceType) CreateOrderServiceAPI {
+	return (*createOrderServiceAPI)((*CreateOrderService)(a).TimeInForce(timeInForce))
+}
+
+func (a *createOrderServiceAPI) Quantity(quantity string) CreateOrderServiceAPI {
+	return (*createOrderServiceAPI)((*CreateOrderService)(a).Quantity(quantity))
+}
+
+func (a *createOrderServiceAPI) ReduceOnly(reduceOnly bool) CreateOrderServiceAPI {
+	return (*createOrderServiceAPI)((*CreateOrderService)(a).ReduceOnly(reduceOnly))
+}
+
+func (a *createOrderServiceAPI) Price(price string) CreateOrderServiceAPI {
+	return (*createOrderServiceAPI)((*CreateOrderService)(a).Price(price))
+}
+
+func (a *createOrderServiceAPI) NewClientOrderID(newClientOrderID string) CreateOrderServiceAPI {
+	return (*createOrderServiceAPI)((*CreateOrderService)(a).NewClientOrderID(newClientOrderID))
+}
+
+func (a *createOrderServiceAPI) StopPrice(stopPrice string) CreateOrderServiceAPI {
+	return (*createOrderServiceAPI)((*CreateOrderService)(a).StopPrice(stopPrice))
+}
+
+func (a *createOrderServiceAPI) WorkingType(workingType WorkingType) CreateOrderServiceAPI {
+	return (*createOrderServiceAPI)((*CreateOrderService)(a).WorkingType(workingType))
+}
+
+func (a *createOrderServiceAPI) ActivationPrice(activationPrice string) CreateOrderServiceAPI {
+	return (*createOrderServiceAPI)((*CreateOrderService)(a).ActivationPrice(activationPrice))
+}
+
+func (a *createOrderServiceAPI) CallbackRate(callbackRate string) CreateOrderServiceAPI {
+	return (*createOrderServiceAPI)((*CreateOrderService)(a).CallbackRate(callbackRate))
+}
+
+func (a *createOrderServiceAPI) PriceProtect(priceProtect bool) CreateOrderServiceAPI {
+	return (*createOrderServiceAPI)((*CreateOrderService)(a).PriceProtect(priceProtect))
+}
+
+func (a *createOrderServiceAPI) NewOrderResponseType(newOrderResponseType NewOrderRespType) CreateOrderServiceAPI {
+	return (*createOrderServiceAPI)((*CreateOrderService)(a).NewOrderResponseType(newOrderResponseType))
+}
+
+func (a *createOrderServiceAPI) ClosePosition(closePosition bool) CreateOrderServiceAPI {
+	return (*createOrderServiceAPI)((*CreateOrderService)(a).ClosePosition(closePosition))
+}
+
+func (a *createOrderServiceAPI) PriceMatch(priceMatch PriceMatchType) CreateOrderServiceAPI {
+	return (*createOrderServiceAPI)((*CreateOrderService)(a).PriceMatch(priceMatch))
+}
+
+func (a *createOrderServiceAPI) SelfTradePreventionMode(selfTradePreventionMode SelfTradePreventionMode) CreateOrderServiceAPI {
+	return (*createOrderServiceAPI)((*CreateOrderService)(a).SelfTradePreventionMode(selfTradePreventionMode))
+}
+
+func (a *createOrderServiceAPI) Do(ctx context.Context, opts ...RequestOption) (*CreateOrderResponse, error) {
+	return (*CreateOrderService)(a).Do(ctx, opts...)
+}
+
+// ModifyOrderServiceAPI is the interface of ModifyOrderService
+type ModifyOrderServiceAPI interface {
+	Symbol(symbol string) ModifyOrderServiceAPI
+	OrderID(orderID int64) ModifyOrderServiceAPI
+	OrigClientOrderID(origClientOrderID string) ModifyOrderServiceAPI
+	Side(side SideType) ModifyOrderServiceAPI
+	Quantity(quantity string) ModifyOrderServiceAPI
+	Price(price string) ModifyOrderServiceAPI
+	PriceMatch(priceMatch PriceMatchType) ModifyOrderServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) (*Order, error)
+}
+
+type modifyOrderServiceAPI ModifyOrderService
+
+func (a *modifyOrderServiceAPI) Symbol(symbol string) ModifyOrderServiceAPI {
+	return (*modifyOrderServiceAPI)((*ModifyOrderService)(a).Symbol(symbol))
+}
+
+func (a *modifyOrderServiceAPI) OrderID(orderID int64) ModifyOrderServiceAPI {
+	return (*modifyOrderServiceAPI)((*ModifyOrderService)(a).OrderID(orderID))
+}
+
+func (a *modifyOrderServiceAPI) OrigClientOrderID(origClientOrderID string) ModifyOrderServiceAPI {
+	return (*modifyOrderServiceAPI)((*ModifyOrderService)(a).OrigClientOrderID(origClientOrderID))
+}
+
+func (a *modifyOrderServiceAPI) Side(side SideType) ModifyOrderServiceAPI {
+	return (*modifyOrderServiceAPI)((*ModifyOrderService)(a).Side(side))
+}
+
+func (a *modifyOrderServiceAPI) Quantity(quantity string) ModifyOrderServiceAPI {
+	return (*modifyOrderServiceAPI)((*ModifyOrderService)(a).Quantity(quantity))
+}
+
+func (a *modifyOrderServiceAPI) Price(price string) ModifyOrderServiceAPI {
+	return (*modifyOrderServiceAPI)((*ModifyOrderService)(a).Price(price))
+}
+
+func (a *modifyOrderServiceAPI) PriceMatch(priceMatch PriceMatchType) ModifyOrderServiceAPI {
+	return (*modifyOrderServiceAPI)((*ModifyOrderService)(a).PriceMatch(priceMatch))
+}
+
+func (a *modifyOrderServiceAPI) Do(ctx context.Context, opts ...RequestOption) (*Order, error) {
+	return (*ModifyOrderService)(a).Do(ctx, opts...)
+}
+
+// CreateBatchOrdersServiceAPI is the interface of CreateBatchOrdersService
+type CreateBatchOrdersServiceAPI interface {
+	OrderList(orders []*CreateOrderService) CreateBatchOrdersServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) (*CreateBatchOrdersResponse, error)
+}
+
+type createBatchOrdersServiceAPI CreateBatchOrdersService
+
+func (a *createBatchOrdersServiceAPI) OrderList(orders []*CreateOrderService) CreateBatchOrdersServiceAPI {
+	return (*createBatchOrdersServiceAPI)((*CreateBatchOrdersService)(a).OrderList(orders))
+}
+
+func (a *createBatchOrdersServiceAPI) Do(ctx context.Context, opts ...RequestOption) (*CreateBatchOrdersResponse, error) {
+	return (*CreateBatchOrdersService)(a).Do(ctx, opts...)
+}
+
+// ModifyBatchOrdersServiceAPI is the interface of ModifyBatchOrdersService
+type ModifyBatchOrdersServiceAPI interface {
+	OrderList(orders []*ModifyOrder) ModifyBatchOrdersServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) (*ModifyBatchOrdersResponse, error)
+}
+
+type modifyBatchOrdersServiceAPI ModifyBatchOrdersService
+
+func (a *modifyBatchOrdersServiceAPI) OrderList(orders []*ModifyOrder) ModifyBatchOrdersServiceAPI {
+	return (*modifyBatchOrdersServiceAPI)((*ModifyBatchOrdersService)(a).OrderList(orders))
+}
+
+func (a *modifyBatchOrdersServiceAPI) Do(ctx context.Context, opts ...RequestOption) (*ModifyBatchOrdersResponse, error) {
+	return (*ModifyBatchOrdersService)(a).Do(ctx, opts...)
+}
+
+// GetOrderServiceAPI is the interface of GetOrderService
+type GetOrderServiceAPI interface {
+	Symbol(symbol string) GetOrderServiceAPI
+	OrderID(orderID int64) GetOrderServiceAPI
+	OrigClientOrderID(origClientOrderID string) GetOrderServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) (*Order, error)
+}
+
+type getOrderServiceAPI GetOrderService
+
+func (a *getOrderServiceAPI) Symbol(symbol string) GetOrderServiceAPI {
+	return (*getOrderServiceAPI)((*GetOrderService)(a).Symbol(symbol))
+}
+
+func (a *getOrderServiceAPI) OrderID(orderID int64) GetOrderServiceAPI {
+	return (*getOrderServiceAPI)((*GetOrderService)(a).OrderID(orderID))
+}
+
+func (a *getOrderServiceAPI) OrigClientOrderID(origClientOrderID string) GetOrderServiceAPI {
+	return (*getOrderServiceAPI)((*GetOrderService)(a).OrigClientOrderID(origClientOrderID))
+}
+
+func (a *getOrderServiceAPI) Do(ctx context.Context, opts ...RequestOption) (*Order, error) {
+	return (*GetOrderService)(a).Do(ctx, opts...)
+}
+
+// CancelOrderServiceAPI is the interface of CancelOrderService
+type CancelOrderServiceAPI interface {
+	Symbol(symbol string) CancelOrderServiceAPI
+	OrderID(orderID int64) CancelOrderServiceAPI
+	OrigClientOrderID(origClientOrderID string) CancelOrderServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) (*CancelOrderResponse, error)
+}
+
+type cancelOrderServiceAPI CancelOrderService
+
+func (a *cancelOrderServiceAPI) Symbol(symbol string) CancelOrderServiceAPI {
+	return (*cancelOrderServiceAPI)((*CancelOrderService)(a).Symbol(symbol))
+}
+
+func (a *cancelOrderServiceAPI) OrderID(orderID int64) CancelOrderServiceAPI {
+	return (*cancelOrderServiceAPI)((*CancelOrderService)(a).OrderID(orderID))
+}
+
+func (a *cancelOrderServiceAPI) OrigClientOrderID(origClientOrderID string) CancelOrderServiceAPI {
+	return (*cancelOrderServiceAPI)((*CancelOrderService)(a).OrigClientOrderID(origClientOrderID))
+}
+
+func (a *cancelOrderServiceAPI) Do(ctx context.Context, opts ...RequestOption) (*CancelOrderResponse, error) {
+	return (*CancelOrderService)(a).Do(ctx, opts...)
+}
+
+// CancelAllOpenOrdersServiceAPI is the interface of CancelAllOpenOrdersService
+type CancelAllOpenOrdersServiceAPI interface {
+	Symbol(symbol string) CancelAllOpenOrdersServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) error
+}
+
+type cancelAllOpenOrdersServiceAPI CancelAllOpenOrdersService
+
+func (a *cancelAllOpenOrdersServiceAPI) Symbol(symbol string) CancelAllOpenOrdersServiceAPI {
+	return (*cancelAllOpenOrdersServiceAPI)((*CancelAllOpenOrdersService)(a).Symbol(symbol))
+}
+
+func (a *cancelAllOpenOrdersServiceAPI) Do(ctx context.Context, opts ...RequestOption) error {
+	return (*CancelAllOpenOrdersService)(a).Do(ctx, opts...)
+}
+
+// CancelMultiplesOrdersServiceAPI is the interface of CancelMultiplesOrdersService
+type CancelMultiplesOrdersServiceAPI interface {
+	Symbol(symbol string) CancelMultiplesOrdersServiceAPI
+	OrderIDList(orderIDList []int64) CancelMultiplesOrdersServiceAPI
+	OrigClientOrderIDList(origClientOrderIDList []string) CancelMultiplesOrdersServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) ([]*CancelOrderResponse, error)
+}
+
+type cancelMultiplesOrdersServiceAPI CancelMultiplesOrdersService
+
+func (a *cancelMultiplesOrdersServiceAPI) Symbol(symbol string) CancelMultiplesOrdersServiceAPI {
+	return (*cancelMultiplesOrdersServiceAPI)((*CancelMultiplesOrdersService)(a).Symbol(symbol))
+}
+
+func (a *cancelMultiplesOrdersServiceAPI) OrderIDList(orderIDList []int64) CancelMultiplesOrdersServiceAPI {
+	return (*cancelMultiplesOrdersServiceAPI)((*CancelMultiplesOrdersService)(a).OrderIDList(orderIDList))
+}
+
+func (a *cancelMultiplesOrdersServiceAPI) OrigClientOrderIDList(origClientOrderIDList []string) CancelMultiplesOrdersServiceAPI {
+	return (*cancelMultiplesOrdersServiceAPI)((*CancelMultiplesOrdersService)(a).OrigClientOrderIDList(origClientOrderIDList))
+}
+
+func (a *cancelMultiplesOrdersServiceAPI) Do(ctx context.Context, opts ...RequestOption) ([]*CancelOrderResponse, error) {
+	return (*CancelMultiplesOrdersService)(a).Do(ctx, opts...)
+}
+
+// CountdownCancelAllServiceAPI is the interface of CountdownCancelAllService
+type CountdownCancelAllServiceAPI interface {
+	Symbol(symbol string) CountdownCancelAllServiceAPI
+	CountdownTime(countdownTime int64) CountdownCancelAllServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) (*CountdownCancelAllResponse, error)
+}
+
+type countdownCancelAllServiceAPI CountdownCancelAllService
+
+func (a *countdownCancelAllServiceAPI) Symbol(symbol string) CountdownCancelAllServiceAPI {
+	return (*countdownCancelAllServiceAPI)((*CountdownCancelAllService)(a).Symbol(symbol))
+}
+
+func (a *countdownCancelAllServiceAPI) CountdownTime(countdownTime int64) CountdownCancelAllServiceAPI {
+	return (*countdownCancelAllServiceAPI)((*CountdownCancelAllService)(a).CountdownTime(countdownTime))
+}
+
+func (a *countdownCancelAllServiceAPI) Do(ctx context.Context, opts ...RequestOption) (*CountdownCancelAllResponse, error) {
+	return (*CountdownCancelAllService)(a).Do(ctx, opts...)
+}
+
+// GetOpenOrderServiceAPI is the interface of GetOpenOrderService
+type GetOpenOrderServiceAPI interface {
+	Symbol(symbol string) GetOpenOrderServiceAPI
+	OrderID(orderID int64) GetOpenOrderServiceAPI
+	OrigClientOrderID(origClientOrderID string) GetOpenOrderServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) (*Order, error)
+}
+
+type getOpenOrderServiceAPI GetOpenOrderService
+
+func (a *getOpenOrderServiceAPI) Symbol(symbol string) GetOpenOrderServiceAPI {
+	return (*getOpenOrderServiceAPI)((*GetOpenOrderService)(a).Symbol(symbol))
+}
+
+func (a *getOpenOrderServiceAPI) OrderID(orderID int64) GetOpenOrderServiceAPI {
+	return (*getOpenOrderServiceAPI)((*GetOpenOrderService)(a).OrderID(orderID))
+}
+
+func (a *getOpenOrderServiceAPI) OrigClientOrderID(origClientOrderID string) GetOpenOrderServiceAPI {
+	return (*getOpenOrderServiceAPI)((*GetOpenOrderService)(a).OrigClientOrderID(origClientOrderID))
+}
+
+func (a *getOpenOrderServiceAPI) Do(ctx context.Context, opts ...RequestOption) (*Order, error) {
+	return (*GetOpenOrderService)(a).Do(ctx, opts...)
+}
+
+// ListOpenOrdersServiceAPI is the interface of ListOpenOrdersService
+type ListOpenOrdersServiceAPI interface {
+	Symbol(symbol string) ListOpenOrdersServiceAPI
+	Pair(pair string) ListOpenOrdersServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) ([]*Order, error)
+}
+
+type listOpenOrdersServiceAPI ListOpenOrdersService
+
+func (a *listOpenOrdersServiceAPI) Symbol(symbol string) ListOpenOrdersServiceAPI {
+	return (*listOpenOrdersServiceAPI)((*ListOpenOrdersService)(a).Symbol(symbol))
+}
+
+func (a *listOpenOrdersServiceAPI) Pair(pair string) ListOpenOrdersServiceAPI {
+	return (*listOpenOrdersServiceAPI)((*ListOpenOrdersService)(a).Pair(pair))
+}
+
+func (a *listOpenOrdersServiceAPI) Do(ctx context.Context, opts ...RequestOption) ([]*Order, error) {
+	return (*ListOpenOrdersService)(a).Do(ctx, opts...)
+}
+
+// ListOrdersServiceAPI is the interface of ListOrdersService
+type ListOrdersServiceAPI interface {
+	Symbol(symbol string) ListOrdersServiceAPI
+	Pair(pair string) ListOrdersServiceAPI
+	OrderID(orderID int64) ListOrdersServiceAPI
+	StartTime(startTime int64) ListOrdersServiceAPI
+	EndTime(endTime int64) ListOrdersServiceAPI
+	Limit(limit int) ListOrdersServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) ([]*Order, error)
+}
+
+type listOrdersServiceAPI ListOrdersService
+
+func (a *listOrdersServiceAPI) Symbol(symbol string) ListOrdersServiceAPI {
+	return (*listOrdersServiceAPI)((*ListOrdersService)(a).Symbol(symbol))
+}
+
+func (a *listOrdersServiceAPI) Pair(pair string) ListOrdersServiceAPI {
+	return (*listOrdersServiceAPI)((*ListOrdersService)(a).Pair(pair))
+}
+
+func (a *listOrdersServiceAPI) OrderID(orderID int64) ListOrdersServiceAPI {
+	return (*listOrdersServiceAPI)((*ListOrdersService)(a).OrderID(orderID))
+}
+
+func (a *listOrdersServiceAPI) StartTime(startTime int64) ListOrdersServiceAPI {
+	return (*listOrdersServiceAPI)((*ListOrdersService)(a).StartTime(startTime))
+}
+
+func (a *listOrdersServiceAPI) EndTime(endTime int64) ListOrdersServiceAPI {
+	return (*listOrdersServiceAPI)((*ListOrdersService)(a).EndTime(endTime))
+}
+
+func (a *listOrdersServiceAPI) Limit(limit int) ListOrdersServiceAPI {
+	return (*listOrdersServiceAPI)((*ListOrdersService)(a).Limit(limit))
+}
+
+func (a *listOrdersServiceAPI) Do(ctx context.Context, opts ...RequestOption) ([]*Order, error) {
+	return (*ListOrdersService)(a).Do(ctx, opts...)
+}
+
+// ListLiquidationOrdersServiceAPI is the interface of ListLiquidationOrdersService
+type ListLiquidationOrdersServiceAPI interface {
+	Symbol(symbol string) ListLiquidationOrdersServiceAPI
+	Pair(pair string) ListLiquidationOrdersServiceAPI
+	StartTime(startTime int64) ListLiquidationOrdersServiceAPI
+	EndTime(endTime int64) ListLiquidationOrdersServiceAPI
+	Limit(limit int) ListLiquidationOrdersServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) ([]*LiquidationOrder, error)
+}
+
+type listLiquidationOrdersServiceAPI ListLiquidationOrdersService
+
+func (a *listLiquidationOrdersServiceAPI) Symbol(symbol string) ListLiquidationOrdersServiceAPI {
+	return (*listLiquidationOrdersServiceAPI)((*ListLiquidationOrdersService)(a).Symbol(symbol))
+}
+
+func (a *listLiquidationOrdersServiceAPI) Pair(pair string) ListLiquidationOrdersServiceAPI {
+	return (*listLiquidationOrdersServiceAPI)((*ListLiquidationOrdersService)(a).Pair(pair))
+}
+
+func (a *listLiquidationOrdersServiceAPI) StartTime(startTime int64) ListLiquidationOrdersServiceAPI {
+	return (*listLiquidationOrdersServiceAPI)((*ListLiquidationOrdersService)(a).StartTime(startTime))
+}
+
+func (a *listLiquidationOrdersServiceAPI) EndTime(endTime int64) ListLiquidationOrdersServiceAPI {
+	return (*listLiquidationOrdersServiceAPI)((*ListLiquidationOrdersService)(a).EndTime(endTime))
+}
+
+func (a *listLiquidationOrdersServiceAPI) Limit(limit int) ListLiquidationOrdersServiceAPI {
+	return (*listLiquidationOrdersServiceAPI)((*ListLiquidationOrdersService)(a).Limit(limit))
+}
+
+func (a *listLiquidationOrdersServiceAPI) Do(ctx context.Context, opts ...RequestOption) ([]*LiquidationOrder, error) {
+	return (*ListLiquidationOrdersService)(a).Do(ctx, opts...)
+}
+
+// ListUserLiquidationOrdersServiceAPI is the interface of ListUserLiquidationOrdersService
+type ListUserLiquidationOrdersServiceAPI interface {
+	Symbol(symbol string) ListUserLiquidationOrdersServiceAPI
+	AutoCloseType(autoCloseType ForceOrderCloseType) ListUserLiquidationOrdersServiceAPI
+	StartTime(startTime int64) ListUserLiquidationOrdersServiceAPI
+	EndTime(endTime int64) ListUserLiquidationOrdersServiceAPI
+	Limit(limit int) ListUserLiquidationOrdersServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) ([]*UserLiquidationOrder, error)
+}
+
+type listUserLiquidationOrdersServiceAPI ListUserLiquidationOrdersService
+
+func (a *listUserLiquidationOrdersServiceAPI) Symbol(symbol string) ListUserLiquidationOrdersServiceAPI {
+	return (*listUserLiquidationOrdersServiceAPI)((*ListUserLiquidationOrdersService)(a).Symbol(symbol))
+}
+
+func (a *listUserLiquidationOrdersServiceAPI) AutoCloseType(autoCloseType ForceOrderCloseType) ListUserLiquidationOrdersServiceAPI {
+	return (*listUserLiquidationOrdersServiceAPI)((*ListUserLiquidationOrdersService)(a).AutoCloseType(autoCloseType))
+}
+
+func (a *listUserLiquidationOrdersServiceAPI) StartTime(startTime int64) ListUserLiquidationOrdersServiceAPI {
+	return (*listUserLiquidationOrdersServiceAPI)((*ListUserLiquidationOrdersService)(a).StartTime(startTime))
+}
+
+func (a *listUserLiquidationOrdersServiceAPI) EndTime(endTime int64) ListUserLiquidationOrdersServiceAPI {
+	return (*listUserLiquidationOrdersServiceAPI)((*ListUserLiquidationOrdersService)(a).EndTime(endTime))
+}
+
+func (a *listUserLiquidationOrdersServiceAPI) Limit(limit int) ListUserLiquidationOrdersServiceAPI {
+	return (*listUserLiquidationOrdersServiceAPI)((*ListUserLiquidationOrdersService)(a).Limit(limit))
+}
+
+func (a *listUserLiquidationOrdersServiceAPI) Do(ctx context.Context, opts ...RequestOption) ([]*UserLiquidationOrder, error) {
+	return (*ListUserLiquidationOrdersService)(a).Do(ctx, opts...)
+}
+
+// ListAccountTradeServiceAPI is the interface of ListAccountTradeService
+type ListAccountTradeServiceAPI interface {
+	Symbol(symbol string) ListAccountTradeServiceAPI
+	Pair(pair string) ListAccountTradeServiceAPI
+	OrderID(orderID int64) ListAccountTradeServiceAPI
+	StartTime(startTime int64) ListAccountTradeServiceAPI
+	EndTime(endTime int64) ListAccountTradeServiceAPI
+	FromID(fromID int64) ListAccountTradeServiceAPI
+	Limit(limit int) ListAccountTradeServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) ([]*AccountTrade, error)
+}
+
+type listAccountTradeServiceAPI ListAccountTradeService
+
+func (a *listAccountTradeServiceAPI) Symbol(symbol string) ListAccountTradeServiceAPI {
+	return (*listAccountTradeServiceAPI)((*ListAccountTradeService)(a).Symbol(symbol))
+}
+
+func (a *listAccountTradeServiceAPI) Pair(pair string) ListAccountTradeServiceAPI {
+	return (*listAccountTradeServiceAPI)((*ListAccountTradeService)(a).Pair(pair))
+}
+
+func (a *listAccountTradeServiceAPI) OrderID(orderID int64) ListAccountTradeServiceAPI {
+	return (*listAccountTradeServiceAPI)((*ListAccountTradeService)(a).OrderID(orderID))
+}
+
+func (a *listAccountTradeServiceAPI) StartTime(startTime int64) ListAccountTradeServiceAPI {
+	return (*listAccountTradeServiceAPI)((*ListAccountTradeService)(a).StartTime(startTime))
+}
+
+func (a *listAccountTradeServiceAPI) EndTime(endTime int64) ListAccountTradeServiceAPI {
+	return (*listAccountTradeServiceAPI)((*ListAccountTradeService)(a).EndTime(endTime))
+}
+
+func (a *listAccountTradeServiceAPI) FromID(fromID int64) ListAccountTradeServiceAPI {
+	return (*listAccountTradeServiceAPI)((*ListAccountTradeService)(a).FromID(fromID))
+}
+
+func (a *listAccountTradeServiceAPI) Limit(limit int) ListAccountTradeServiceAPI {
+	return (*listAccountTradeServiceAPI)((*ListAccountTradeService)(a).Limit(limit))
+}
+
+func (a *listAccountTradeServiceAPI) Do(ctx context.Context, opts ...RequestOption) ([]*AccountTrade, error) {
+	return (*ListAccountTradeService)(a).Do(ctx, opts...)
+}
+
+// GetIncomeHistoryServiceAPI is the interface of GetIncomeHistoryService
+type GetIncomeHistoryServiceAPI interface {
+	Symbol(symbol string) GetIncomeHistoryServiceAPI
+	IncomeType(incomeType string) GetIncomeHistoryServiceAPI
+	StartTime(startTime int64) GetIncomeHistoryServiceAPI
+	EndTime(endTime int64) GetIncomeHistoryServiceAPI
+	Page(page int) GetIncomeHistoryServiceAPI
+	Limit(limit int64) GetIncomeHistoryServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) ([]*IncomeHistory, error)
+}
+
+type getIncomeHistoryServiceAPI GetIncomeHistoryService
+
+func (a *getIncomeHistoryServiceAPI) Symbol(symbol string) GetIncomeHistoryServiceAPI {
+	return (*getIncomeHistoryServiceAPI)((*GetIncomeHistoryService)(a).Symbol(symbol))
+}
+
+func (a *getIncomeHistoryServiceAPI) IncomeType(incomeType string) GetIncomeHistoryServiceAPI {
+	return (*getIncomeHistoryServiceAPI)((*GetIncomeHistoryService)(a).IncomeType(incomeType))
+}
+
+func (a *getIncomeHistoryServiceAPI) StartTime(startTime int64) GetIncomeHistoryServiceAPI {
+	return (*getIncomeHistoryServiceAPI)((*GetIncomeHistoryService)(a).StartTime(startTime))
+}
+
+func (a *getIncomeHistoryServiceAPI) EndTime(endTime int64) GetIncomeHistoryServiceAPI {
+	return (*getIncomeHistoryServiceAPI)((*GetIncomeHistoryService)(a).EndTime(endTime))
+}
+
+func (a *getIncomeHistoryServiceAPI) Page(page int) GetIncomeHistoryServiceAPI {
+	return (*getIncomeHistoryServiceAPI)((*GetIncomeHistoryService)(a).Page(page))
+}
+
+func (a *getIncomeHistoryServiceAPI) Limit(limit int64) GetIncomeHistoryServiceAPI {
+	return (*getIncomeHistoryServiceAPI)((*GetIncomeHistoryService)(a).Limit(limit))
+}
+
+func (a *getIncomeHistoryServiceAPI) Do(ctx context.Context, opts ...RequestOption) ([]*IncomeHistory, error) {
+	return (*GetIncomeHistoryService)(a).Do(ctx, opts...)
+}
+
+// CommissionRateServiceAPI is the interface of CommissionRateService
+type CommissionRateServiceAPI interface {
+	Symbol(symbol string) CommissionRateServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) (*CommissionRate, error)
+}
+
+type commissionRateServiceAPI CommissionRateService
+
+func (a *commissionRateServiceAPI) Symbol(symbol string) CommissionRateServiceAPI {
+	return (*commissionRateServiceAPI)((*CommissionRateService)(a).Symbol(symbol))
+}
+
+func (a *commissionRateServiceAPI) Do(ctx context.Context, opts ...RequestOption) (*CommissionRate, error) {
+	return (*CommissionRateService)(a).Do(ctx, opts...)
+}
+
+// GetDownloadIDServiceAPI is the interface of GetDownloadIDService
+type GetDownloadIDServiceAPI interface {
+	Type(downloadType DownloadType) GetDownloadIDServiceAPI
+	StartTime(startTime int64) GetDownloadIDServiceAPI
+	EndTime(endTime int64) GetDownloadIDServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) (*DownloadID, error)
+}
+
+type getDownloadIDServiceAPI GetDownloadIDService
+
+func (a *getDownloadIDServiceAPI) Type(downloadType DownloadType) GetDownloadIDServiceAPI {
+	return (*getDownloadIDServiceAPI)((*GetDownloadIDService)(a).Type(downloadType))
+}
+
+func (a *getDownloadIDServiceAPI) StartTime(startTime int64) GetDownloadIDServiceAPI {
+	return (*getDownloadIDServiceAPI)((*GetDownloadIDService)(a).StartTime(startTime))
+}
+
+func (a *getDownloadIDServiceAPI) EndTime(endTime int64) GetDownloadIDServiceAPI {
+	return (*getDownloadIDServiceAPI)((*GetDownloadIDService)(a).EndTime(endTime))
+}
+
+func (a *getDownloadIDServiceAPI) Do(ctx context.Context, opts ...RequestOption) (*DownloadID, error) {
+	return (*GetDownloadIDService)(a).Do(ctx, opts...)
+}
+
+// GetDownloadLinkServiceAPI is the interface of GetDownloadLinkService
+type GetDownloadLinkServiceAPI interface {
+	Type(downloadType DownloadType) GetDownloadLinkServiceAPI
+	DownloadID(downloadID string) GetDownloadLinkServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) (*DownloadLink, error)
+}
+
+type getDownloadLinkServiceAPI GetDownloadLinkService
+
+func (a *getDownloadLinkServiceAPI) Type(downloadType DownloadType) GetDownloadLinkServiceAPI {
+	return (*getDownloadLinkServiceAPI)((*GetDownloadLinkService)(a).Type(downloadType))
+}
+
+func (a *getDownloadLinkServiceAPI) DownloadID(downloadID string) GetDownloadLinkServiceAPI {
+	return (*getDownloadLinkServiceAPI)((*GetDownloadLinkService)(a).DownloadID(downloadID))
+}
+
+func (a *getDownloadLinkServiceAPI) Do(ctx context.Context, opts ...RequestOption) (*DownloadLink, error) {
+	return (*GetDownloadLinkService)(a).Do(ctx, opts...)
+}
+
+// GetAccountServiceAPI is the interface of GetAccountService
+type GetAccountServiceAPI interface {
+	Do(ctx context.Context, opts ...RequestOption) (*Account, error)
+}
+
+type getAccountServiceAPI GetAccountService
+
+func (a *getAccountServiceAPI) Do(ctx context.Context, opts ...RequestOption) (*Account, error) {
+	return (*GetAccountService)(a).Do(ctx, opts...)
+}
+
+// GetBalanceServiceAPI is the interface of GetBalanceService
+type GetBalanceServiceAPI interface {
+	Do(ctx context.Context, opts ...RequestOption) ([]*Balance, error)
+}
+
+type getBalanceServiceAPI GetBalanceService
+
+func (a *getBalanceServiceAPI) Do(ctx context.Context, opts ...RequestOption) ([]*Balance, error) {
+	return (*GetBalanceService)(a).Do(ctx, opts...)
+}
+
+// GetPositionRiskServiceAPI is the interface of GetPositionRiskService
+type GetPositionRiskServiceAPI interface {
+	MarginAsset(marginAsset string) GetPositionRiskServiceAPI
+	Pair(pair string) GetPositionRiskServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) ([]*PositionRisk, error)
+}
+
+type getPositionRiskServiceAPI GetPositionRiskService
+
+func (a *getPositionRiskServiceAPI) MarginAsset(marginAsset string) GetPositionRiskServiceAPI {
+	return (*getPositionRiskServiceAPI)((*GetPositionRiskService)(a).MarginAsset(marginAsset))
+}
+
+func (a *getPositionRiskServiceAPI) Pair(pair string) GetPositionRiskServiceAPI {
+	return (*getPositionRiskServiceAPI)((*GetPositionRiskService)(a).Pair(pair))
+}
+
+func (a *getPositionRiskServiceAPI) Do(ctx context.Context, opts ...RequestOption) ([]*PositionRisk, error) {
+	return (*GetPositionRiskService)(a).Do(ctx, opts...)
+}
+
+// ChangeLeverageServiceAPI is the interface of ChangeLeverageService
+type ChangeLeverageServiceAPI interface {
+	Symbol(symbol string) ChangeLeverageServiceAPI
+	Leverage(leverage int) ChangeLeverageServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) (*SymbolLeverage, error)
+}
+
+type changeLeverageServiceAPI ChangeLeverageService
+
+func (a *changeLeverageServiceAPI) Symbol(symbol string) ChangeLeverageServiceAPI {
+	return (*changeLeverageServiceAPI)((*ChangeLeverageService)(a).Symbol(symbol))
+}
+
+func (a *changeLeverageServiceAPI) Leverage(leverage int) ChangeLeverageServiceAPI {
+	return (*changeLeverageServiceAPI)((*ChangeLeverageService)(a).Leverage(leverage))
+}
+
+func (a *changeLeverageServiceAPI) Do(ctx context.Context, opts ...RequestOption) (*SymbolLeverage, error) {
+	return (*ChangeLeverageService)(a).Do(ctx, opts...)
+}
+
+// GetLeverageBracketServiceAPI is the interface of GetLeverageBracketService
+type GetLeverageBracketServiceAPI interface {
+	Symbol(symbol string) GetLeverageBracketServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) ([]*LeverageBracket, error)
+}
+
+type getLeverageBracketServiceAPI GetLeverageBracketService
+
+func (a *getLeverageBracketServiceAPI) Symbol(symbol string) GetLeverageBracketServiceAPI {
+	return (*getLeverageBracketServiceAPI)((*GetLeverageBracketService)(a).Symbol(symbol))
+}
+
+func (a *getLeverageBracketServiceAPI) Do(ctx context.Context, opts ...RequestOption) ([]*LeverageBracket, error) {
+	return (*GetLeverageBracketService)(a).Do(ctx, opts...)
+}
+
+// GetADLQuantileServiceAPI is the interface of GetADLQuantileService
+type GetADLQuantileServiceAPI interface {
+	Symbol(symbol string) GetADLQuantileServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) ([]*ADLQuantile, error)
+}
+
+type getADLQuantileServiceAPI GetADLQuantileService
+
+func (a *getADLQuantileServiceAPI) Symbol(symbol string) GetADLQuantileServiceAPI {
+	return (*getADLQuantileServiceAPI)((*GetADLQuantileService)(a).Symbol(symbol))
+}
+
+func (a *getADLQuantileServiceAPI) Do(ctx context.Context, opts ...RequestOption) ([]*ADLQuantile, error) {
+	return (*GetADLQuantileService)(a).Do(ctx, opts...)
+}
+
+// ChangeMarginTypeServiceAPI is the interface of ChangeMarginTypeService
+type ChangeMarginTypeServiceAPI interface {
+	Symbol(symbol string) ChangeMarginTypeServiceAPI
+	MarginType(marginType MarginType) ChangeMarginTypeServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) error
+}
+
+type changeMarginTypeServiceAPI ChangeMarginTypeService
+
+func (a *changeMarginTypeServiceAPI) Symbol(symbol string) ChangeMarginTypeServiceAPI {
+	return (*changeMarginTypeServiceAPI)((*ChangeMarginTypeService)(a).Symbol(symbol))
+}
+
+func (a *changeMarginTypeServiceAPI) MarginType(marginType MarginType) ChangeMarginTypeServiceAPI {
+	return (*changeMarginTypeServiceAPI)((*ChangeMarginTypeService)(a).MarginType(marginType))
+}
+
+func (a *changeMarginTypeServiceAPI) Do(ctx context.Context, opts ...RequestOption) error {
+	return (*ChangeMarginTypeService)(a).Do(ctx, opts...)
+}
+
+// UpdatePositionMarginServiceAPI is the interface of UpdatePositionMarginService
+type UpdatePositionMarginServiceAPI interface {
+	Symbol(symbol string) UpdatePositionMarginServiceAPI
+	PositionSide(positionSide PositionSideType) UpdatePositionMarginServiceAPI
+	Amount(amount string) UpdatePositionMarginServiceAPI
+	Type(actionType int) UpdatePositionMarginServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) error
+}
+
+type updatePositionMarginServiceAPI UpdatePositionMarginService
+
+func (a *updatePositionMarginServiceAPI) Symbol(symbol string) UpdatePositionMarginServiceAPI {
+	return (*updatePositionMarginServiceAPI)((*UpdatePositionMarginService)(a).Symbol(symbol))
+}
+
+func (a *updatePositionMarginServiceAPI) PositionSide(positionSide PositionSideType) UpdatePositionMarginServiceAPI {
+	return (*updatePositionMarginServiceAPI)((*UpdatePositionMarginService)(a).PositionSide(positionSide))
+}
+
+func (a *updatePositionMarginServiceAPI) Amount(amount string) UpdatePositionMarginServiceAPI {
+	return (*updatePositionMarginServiceAPI)((*UpdatePositionMarginService)(a).Amount(amount))
+}
+
+func (a *updatePositionMarginServiceAPI) Type(actionType int) UpdatePositionMarginServiceAPI {
+	return (*updatePositionMarginServiceAPI)((*UpdatePositionMarginService)(a).Type(actionType))
+}
+
+func (a *updatePositionMarginServiceAPI) Do(ctx context.Context, opts ...RequestOption) error {
+	return (*UpdatePositionMarginService)(a).Do(ctx, opts...)
+}
+
+// GetPositionMarginHistoryServiceAPI is the interface of GetPositionMarginHistoryService
+type GetPositionMarginHistoryServiceAPI interface {
+	Symbol(symbol string) GetPositionMarginHistoryServiceAPI
+	Type(_type int) GetPositionMarginHistoryServiceAPI
+	StartTime(startTime int64) GetPositionMarginHistoryServiceAPI
+	EndTime(endTime int64) GetPositionMarginHistoryServiceAPI
+	Limit(limit int64) GetPositionMarginHistoryServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) ([]*PositionMarginHistory, error)
+}
+
+type getPositionMarginHistoryServiceAPI GetPositionMarginHistoryService
+
+func (a *getPositionMarginHistoryServiceAPI) Symbol(symbol string) GetPositionMarginHistoryServiceAPI {
+	return (*getPositionMarginHistoryServiceAPI)((*GetPositionMarginHistoryService)(a).Symbol(symbol))
+}
+
+func (a *getPositionMarginHistoryServiceAPI) Type(_type int) GetPositionMarginHistoryServiceAPI {
+	return (*getPositionMarginHistoryServiceAPI)((*GetPositionMarginHistoryService)(a).Type(_type))
+}
+
+func (a *getPositionMarginHistoryServiceAPI) StartTime(startTime int64) GetPositionMarginHistoryServiceAPI {
+	return (*getPositionMarginHistoryServiceAPI)((*GetPositionMarginHistoryService)(a).StartTime(startTime))
+}
+
+func (a *getPositionMarginHistoryServiceAPI) EndTime(endTime int64) GetPositionMarginHistoryServiceAPI {
+	return (*getPositionMarginHistoryServiceAPI)((*GetPositionMarginHistoryService)(a).EndTime(endTime))
+}
+
+func (a *getPositionMarginHistoryServiceAPI) Limit(limit int64) GetPositionMarginHistoryServiceAPI {
+	return (*getPositionMarginHistoryServiceAPI)((*GetPositionMarginHistoryService)(a).Limit(limit))
+}
+
+func (a *getPositionMarginHistoryServiceAPI) Do(ctx context.Context, opts ...RequestOption) ([]*PositionMarginHistory, error) {
+	return (*GetPositionMarginHistoryService)(a).Do(ctx, opts...)
+}
+
+// ChangePositionModeServiceAPI is the interface of ChangePositionModeService
+type ChangePositionModeServiceAPI interface {
+	DualSide(dualSide bool) ChangePositionModeServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) error
+}
+
+type changePositionModeServiceAPI ChangePositionModeService
+
+func (a *changePositionModeServiceAPI) DualSide(dualSide bool) ChangePositionModeServiceAPI {
+	return (*changePositionModeServiceAPI)((*ChangePositionModeService)(a).DualSide(dualSide))
+}
+
+func (a *changePositionModeServiceAPI) Do(ctx context.Context, opts ...RequestOption) error {
+	return (*ChangePositionModeService)(a).Do(ctx, opts...)
+}
+
+// GetPositionModeServiceAPI is the interface of GetPositionModeService
+type GetPositionModeServiceAPI interface {
+	Do(ctx context.Context, opts ...RequestOption) (*PositionMode, error)
+}
+
+type getPositionModeServiceAPI GetPositionModeService
+
+func (a *getPositionModeServiceAPI) Do(ctx context.Context, opts ...RequestOption) (*PositionMode, error) {
+	return (*GetPositionModeService)(a).Do(ctx, opts...)
+}
+
+// GetFundingInfoServiceAPI is the interface of GetFundingInfoService
+type GetFundingInfoServiceAPI interface {
+	Do(ctx context.Context, opts ...RequestOption) ([]*FundingInfo, error)
+}
+
+type getFundingInfoServiceAPI GetFundingInfoService
+
+func (a *getFundingInfoServiceAPI) Do(ctx context.Context, opts ...RequestOption) ([]*FundingInfo, error) {
+	return (*GetFundingInfoService)(a).Do(ctx, opts...)
+}
+
+// FundingRateServiceAPI is the interface of FundingRateService
+type FundingRateServiceAPI interface {
+	Symbol(symbol string) FundingRateServiceAPI
+	StartTime(startTime int64) FundingRateServiceAPI
+	EndTime(endTime int64) FundingRateServiceAPI
+	Limit(limit int) FundingRateServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) ([]*FundingRate, error)
+}
+
+type fundingRateServiceAPI FundingRateService
+
+func (a *fundingRateServiceAPI) Symbol(symbol string) FundingRateServiceAPI {
+	return (*fundingRateServiceAPI)((*FundingRateService)(a).Symbol(symbol))
+}
+
+func (a *fundingRateServiceAPI) StartTime(startTime int64) FundingRateServiceAPI {
+	return (*fundingRateServiceAPI)((*FundingRateService)(a).StartTime(startTime))
+}
+
+func (a *fundingRateServiceAPI) EndTime(endTime int64) FundingRateServiceAPI {
+	return (*fundingRateServiceAPI)((*FundingRateService)(a).EndTime(endTime))
+}
+
+func (a *fundingRateServiceAPI) Limit(limit int) FundingRateServiceAPI {
+	return (*fundingRateServiceAPI)((*FundingRateService)(a).Limit(limit))
+}
+
+func (a *fundingRateServiceAPI) Do(ctx context.Context, opts ...RequestOption) ([]*FundingRate, error) {
+	return (*FundingRateService)(a).Do(ctx, opts...)
+}
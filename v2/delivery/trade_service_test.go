@@ -0,0 +1,75 @@
+package delivery
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type tradeServiceTestSuite struct {
+	baseTestSuite
+}
+
+func TestTradeService(t *testing.T) {
+	suite.Run(t, new(tradeServiceTestSuite))
+}
+
+func (s *tradeServiceTestSuite) TestListAccountTrades() {
+	data := []byte(`[
+		{
+			"symbol": "BTCUSD_200626",
+			"id": 6,
+			"orderId": 28,
+			"pair": "BTCUSD",
+			"side": "SELL",
+			"price": "8800",
+			"qty": "1",
+			"realizedPnl": "0",
+			"marginAsset": "BTC",
+			"baseQty": "0.01136364",
+			"commission": "0.00000454",
+			"commissionAsset": "BTC",
+			"time": 1590743483586,
+			"positionSide": "BOTH",
+			"buyer": false,
+			"maker": false
+		}
+	]`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+
+	symbol := "BTCUSD_200626"
+	s.assertReq(func(r *request) {
+		s.r().Equal("/dapi/v1/userTrades", r.endpoint)
+		e := newSignedRequest().setParams(params{
+			"symbol":    symbol,
+			"startTime": int64(1590743483000),
+			"endTime":   int64(1590743484000),
+			"fromId":    int64(5),
+			"limit":     10,
+		})
+		s.assertRequestEqual(e, r)
+	})
+	res, err := s.client.NewListAccountTradeService().Symbol(symbol).
+		StartTime(1590743483000).EndTime(1590743484000).FromID(5).Limit(10).Do(newContext())
+	r := s.r()
+	r.NoError(err)
+	r.Equal([]*AccountTrade{
+		{
+			Symbol:          symbol,
+			ID:              6,
+			OrderID:         28,
+			Pair:            "BTCUSD",
+			Side:            SideTypeSell,
+			Price:           "8800",
+			Quantity:        "1",
+			RealizedPnl:     "0",
+			MarginAsset:     "BTC",
+			BaseQuantity:    "0.01136364",
+			Commission:      "0.00000454",
+			CommissionAsset: "BTC",
+			Time:            1590743483586,
+			PositionSide:    PositionSideTypeBoth,
+		},
+	}, res)
+}
@@ -0,0 +1,145 @@
+package delivery
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type websocketDecodeTestSuite struct {
+	suite.Suite
+}
+
+func TestWebsocketDecode(t *testing.T) {
+	suite.Run(t, new(websocketDecodeTestSuite))
+}
+
+// benchDepthMessage returns a depth diff message of n bids and n asks
+func benchDepthMessage(n int) []byte {
+	levels := make([]string, n)
+	for i := range levels {
+		levels[i] = fmt.Sprintf(`["%d.1","%d"]`, 60000+i, i)
+	}
+	return []byte(fmt.Sprintf(`{"e":"depthUpdate","E":1591270260907,"T":1591270260891,"s":"BTCUSD_200626","ps":"BTCUSD","U":17285681,"u":17285702,"pu":17285675,"b":[%s],"a":[%s]}`,
+		strings.Join(levels, ","), strings.Join(levels, ",")))
+}
+
+var (
+	benchAggTradeMessage   = []byte(`{"e":"aggTrade","E":1591261134288,"a":424951,"s":"BTCUSD_200626","p":"9643.5","q":"2","f":606073,"l":606073,"T":1591261134199,"m":false}`)
+	benchMarkPriceMessage  = []byte(`{"e":"markPriceUpdate","E":1596095725000,"s":"BTCUSD_201225","p":"10934.62615417","P":"10962.17178236","r":"","T":0}`)
+	benchBookTickerMessage = []byte(`{"e":"bookTicker","u":17242169,"s":"BTCUSD_200626","ps":"BTCUSD","b":"9548.1","B":"52","a":"9548.5","A":"11","T":1591268628155,"E":1591268628166}`)
+)
+
+// simplejsonDepthEvent is the depth parser the fast decoder replaces
+func simplejsonDepthEvent(message []byte) (*WsDepthEvent, error) {
+	j, err := newJSON(message)
+	if err != nil {
+		return nil, err
+	}
+	event := new(WsDepthEvent)
+	event.Event = j.Get("e").MustString()
+	event.Time = j.Get("E").MustInt64()
+	event.TransactionTime = j.Get("T").MustInt64()
+	event.Symbol = j.Get("s").MustString()
+	event.Pair = j.Get("ps").MustString()
+	event.FirstUpdateID = j.Get("U").MustInt64()
+	event.LastUpdateID = j.Get("u").MustInt64()
+	event.PrevLastUpdateID = j.Get("pu").MustInt64()
+	bidsLen := len(j.Get("b").MustArray())
+	event.Bids = make([]Bid, bidsLen)
+	for i := 0; i < bidsLen; i++ {
+		item := j.Get("b").GetIndex(i)
+		event.Bids[i] = Bid{
+			Price:    item.GetIndex(0).MustString(),
+			Quantity: item.GetIndex(1).MustString(),
+		}
+	}
+	asksLen := len(j.Get("a").MustArray())
+	event.Asks = make([]Ask, asksLen)
+	for i := 0; i < asksLen; i++ {
+		item := j.Get("a").GetIndex(i)
+		event.Asks[i] = Ask{
+			Price:    item.GetIndex(0).MustString(),
+			Quantity: item.GetIndex(1).MustString(),
+		}
+	}
+	return event, nil
+}
+
+func (s *websocketDecodeTestSuite) TestDepthEvent() {
+	message := benchDepthMessage(3)
+	expected, err := simplejsonDepthEvent(message)
+	s.Require().NoError(err)
+	event := new(WsDepthEvent)
+	s.Require().NoError(decodeWsDepthEvent(string(message), event))
+	s.Equal(expected, event)
+	s.Error(decodeWsDepthEvent(`{"e":"depthUpdate","b":[["1"`, event))
+}
+
+func (s *websocketDecodeTestSuite) TestAggTradeEvent() {
+	expected := new(WsAggTradeEvent)
+	s.Require().NoError(json.Unmarshal(benchAggTradeMessage, expected))
+	event := new(WsAggTradeEvent)
+	s.Require().NoError(decodeWsAggTradeEvent(string(benchAggTradeMessage), event))
+	s.Equal(expected, event)
+}
+
+func (s *websocketDecodeTestSuite) TestPairMarkPriceEvent() {
+	message := fmt.Sprintf(`[%s,%s]`, benchMarkPriceMessage, strings.Replace(string(benchMarkPriceMessage), "BTCUSD_201225", "BTCUSD_PERP", 1))
+	var expected WsPairMarkPriceEvent
+	s.Require().NoError(json.Unmarshal([]byte(message), &expected))
+	event := new(WsPairMarkPriceEvent)
+	s.Require().NoError(decodeWsPairMarkPriceEvent(message, event))
+	s.Equal(expected, *event)
+}
+
+func (s *websocketDecodeTestSuite) TestBookTickerEvent() {
+	expected := new(WsBookTickerEvent)
+	s.Require().NoError(json.Unmarshal(benchBookTickerMessage, expected))
+	event := new(WsBookTickerEvent)
+	s.Require().NoError(decodeWsBookTickerEvent(string(benchBookTickerMessage), event))
+	s.Equal(expected, event)
+}
+
+func BenchmarkDepthEventSimpleJSON(b *testing.B) {
+	message := benchDepthMessage(20)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		if _, err := simplejsonDepthEvent(message); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkDepthEventDecodeReuse(b *testing.B) {
+	message := benchDepthMessage(20)
+	event := new(WsDepthEvent)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		if err := decodeWsDepthEvent(string(message), event); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkMarkPriceEventUnmarshal(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		if err := json.Unmarshal(benchMarkPriceMessage, new(WsMarkPriceEvent)); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkMarkPriceEventDecode(b *testing.B) {
+	event := new(WsMarkPriceEvent)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		if err := decodeWsMarkPriceEvent(string(benchMarkPriceMessage), event); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
@@ -7,12 +7,16 @@ import (
 	"strconv"
 	"strings"
 	"time"
+
+	commonws "github.com/adshao/go-binance/v2/common/websocket"
 )
 
 // Endpoints
 var (
-	BaseWsMainUrl    = "wss://dstream.binance.com/ws"
-	BaseWsTestnetUrl = "wss://dstream.binancefuture.com/ws"
+	BaseWsMainUrl       = "wss://dstream.binance.com/ws"
+	BaseWsTestnetUrl    = "wss://dstream.binancefuture.com/ws"
+	BaseWsApiMainURL    = "wss://ws-dapi.binance.com/ws-dapi/v1"
+	BaseWsApiTestnetURL = "wss://testnet.binancefuture.com/ws-dapi/v1"
 )
 
 var (
@@ -22,19 +26,39 @@ var (
 	WebsocketPongTimeout = time.Second * 10
 	// WebsocketKeepalive enables sending ping/pong messages to check the connection stability
 	WebsocketKeepalive = true
-	// UseTestnet switch all the WS streams from production to the testnet
+	// UseTestnet switch all the WS streams from production to the testnet, it only applies to
+	// the clients of NewClient and to the streams without options.
+	//
+	// Deprecated: use NewClientWithEnvironment(TestnetEnvironment()) and its WsOptions.
 	UseTestnet = false
-	ProxyUrl   = ""
+	// WebsocketTimeoutReadWriteConnection is an interval for sending ping/pong messages if WebsocketKeepalive is enabled
+	// using for websocket API (read/write)
+	WebsocketTimeoutReadWriteConnection = time.Second * 10
+	ProxyUrl                            = ""
 )
 
-// getWsEndpoint return the base endpoint of the WS according the UseTestnet flag
-func getWsEndpoint() string {
+// getWsEndpoint return the base endpoint of the WS of opts, according the UseTestnet flag when they set none
+func getWsEndpoint(opts ...WsOptions) string {
+	if o := commonws.MergeOptions(opts...); o.BaseURL != "" {
+		return o.BaseURL
+	}
 	if UseTestnet {
 		return BaseWsTestnetUrl
 	}
 	return BaseWsMainUrl
 }
 
+// getWsApiEndpoint return the base endpoint of the API WS of opts, according the UseTestnet flag when they set none
+func getWsApiEndpoint(opts ...WsOptions) string {
+	if o := commonws.MergeOptions(opts...); o.APIURL != "" {
+		return o.APIURL
+	}
+	if UseTestnet {
+		return BaseWsApiTestnetURL
+	}
+	return BaseWsApiMainURL
+}
+
 func getWsProxyUrl() *string {
 	if ProxyUrl == "" {
 		return nil
@@ -64,18 +88,10 @@ type WsAggTradeEvent struct {
 type WsAggTradeHandler func(event *WsAggTradeEvent)
 
 // WsAggTradeServe serve websocket that push trade information that is aggregated for a single taker order.
-func WsAggTradeServe(symbol string, handler WsAggTradeHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
-	endpoint := fmt.Sprintf("%s/%s@aggTrade", getWsEndpoint(), strings.ToLower(symbol))
-	cfg := newWsConfig(endpoint)
-	wsHandler := func(message []byte) {
-		event := new(WsAggTradeEvent)
-		err := json.Unmarshal(message, &event)
-		if err != nil {
-			errHandler(err)
-			return
-		}
-		handler(event)
-	}
+func WsAggTradeServe(symbol string, handler WsAggTradeHandler, errHandler ErrHandler, opts ...WsOptions) (doneC, stopC chan struct{}, err error) {
+	endpoint := fmt.Sprintf("%s/%s@aggTrade", getWsEndpoint(opts...), strings.ToLower(symbol))
+	cfg := newWsConfig(endpoint, opts...)
+	wsHandler := decodeHandler(cfg.Options.ReuseEvents, &wsAggTradeEvents, decodeWsAggTradeEvent, handler, errHandler)
 	return wsServe(cfg, wsHandler, errHandler)
 }
 
@@ -91,9 +107,9 @@ type WsIndexPriceEvent struct {
 type WsIndexPriceHandler func(event *WsIndexPriceEvent)
 
 // WsIndexPriceServe serve websocket that pushes index price for a pair.
-func WsIndexPriceServe(symbol string, handler WsIndexPriceHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
-	endpoint := fmt.Sprintf("%s/%s@indexPrice", getWsEndpoint(), strings.ToLower(symbol))
-	cfg := newWsConfig(endpoint)
+func WsIndexPriceServe(symbol string, handler WsIndexPriceHandler, errHandler ErrHandler, opts ...WsOptions) (doneC, stopC chan struct{}, err error) {
+	endpoint := fmt.Sprintf("%s/%s@indexPrice", getWsEndpoint(opts...), strings.ToLower(symbol))
+	cfg := newWsConfig(endpoint, opts...)
 	wsHandler := func(message []byte) {
 		event := new(WsIndexPriceEvent)
 		err := json.Unmarshal(message, &event)
@@ -121,18 +137,10 @@ type WsMarkPriceEvent struct {
 type WsMarkPriceHandler func(event *WsMarkPriceEvent)
 
 // WsMarkPriceServe serve websocket that pushes price and funding rate for a single symbol.
-func WsMarkPriceServe(symbol string, handler WsMarkPriceHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
-	endpoint := fmt.Sprintf("%s/%s@markPrice", getWsEndpoint(), strings.ToLower(symbol))
-	cfg := newWsConfig(endpoint)
-	wsHandler := func(message []byte) {
-		event := new(WsMarkPriceEvent)
-		err := json.Unmarshal(message, &event)
-		if err != nil {
-			errHandler(err)
-			return
-		}
-		handler(event)
-	}
+func WsMarkPriceServe(symbol string, handler WsMarkPriceHandler, errHandler ErrHandler, opts ...WsOptions) (doneC, stopC chan struct{}, err error) {
+	endpoint := fmt.Sprintf("%s/%s@markPrice", getWsEndpoint(opts...), strings.ToLower(symbol))
+	cfg := newWsConfig(endpoint, opts...)
+	wsHandler := decodeHandler(cfg.Options.ReuseEvents, &wsMarkPriceEvents, decodeWsMarkPriceEvent, handler, errHandler)
 	return wsServe(cfg, wsHandler, errHandler)
 }
 
@@ -143,18 +151,12 @@ type WsPairMarkPriceEvent []*WsMarkPriceEvent
 type WsPairMarkPriceHandler func(event WsPairMarkPriceEvent)
 
 // WsPairMarkPriceServe serve websocket that pushes price and funding rate for all symbol.
-func WsPairMarkPriceServe(handler WsPairMarkPriceHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
-	endpoint := fmt.Sprintf("%s/markPrice@arr", getWsEndpoint())
-	cfg := newWsConfig(endpoint)
-	wsHandler := func(message []byte) {
-		var event WsPairMarkPriceEvent
-		err := json.Unmarshal(message, &event)
-		if err != nil {
-			errHandler(err)
-			return
-		}
-		handler(event)
-	}
+func WsPairMarkPriceServe(handler WsPairMarkPriceHandler, errHandler ErrHandler, opts ...WsOptions) (doneC, stopC chan struct{}, err error) {
+	endpoint := fmt.Sprintf("%s/markPrice@arr", getWsEndpoint(opts...))
+	cfg := newWsConfig(endpoint, opts...)
+	wsHandler := decodeHandler(cfg.Options.ReuseEvents, &wsPairMarkPriceEvents, decodeWsPairMarkPriceEvent, func(event *WsPairMarkPriceEvent) {
+		handler(*event)
+	}, errHandler)
 	return wsServe(cfg, wsHandler, errHandler)
 }
 
@@ -190,9 +192,9 @@ type WsKline struct {
 type WsKlineHandler func(event *WsKlineEvent)
 
 // WsKlineServe serve websocket kline handler with a symbol and interval like 15m, 30s
-func WsKlineServe(symbol string, interval string, handler WsKlineHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
-	endpoint := fmt.Sprintf("%s/%s@kline_%s", getWsEndpoint(), strings.ToLower(symbol), interval)
-	cfg := newWsConfig(endpoint)
+func WsKlineServe(symbol string, interval string, handler WsKlineHandler, errHandler ErrHandler, opts ...WsOptions) (doneC, stopC chan struct{}, err error) {
+	endpoint := fmt.Sprintf("%s/%s@kline_%s", getWsEndpoint(opts...), strings.ToLower(symbol), interval)
+	cfg := newWsConfig(endpoint, opts...)
 	wsHandler := func(message []byte) {
 		event := new(WsKlineEvent)
 		err := json.Unmarshal(message, event)
@@ -237,9 +239,9 @@ type WsContinuousKline struct {
 type WsContinuousKlineHandler func(event *WsContinuousKlineEvent)
 
 // WsContinuousKlineServe serve websocket kline handler with a pair, a contract type and interval like 15m, 30s
-func WsContinuousKlineServe(pair string, contractType string, interval string, handler WsContinuousKlineHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
-	endpoint := fmt.Sprintf("%s/%s_%s@continuousKline_%s", getWsEndpoint(), strings.ToLower(pair), strings.ToLower(contractType), interval)
-	cfg := newWsConfig(endpoint)
+func WsContinuousKlineServe(pair string, contractType string, interval string, handler WsContinuousKlineHandler, errHandler ErrHandler, opts ...WsOptions) (doneC, stopC chan struct{}, err error) {
+	endpoint := fmt.Sprintf("%s/%s_%s@continuousKline_%s", getWsEndpoint(opts...), strings.ToLower(pair), strings.ToLower(contractType), interval)
+	cfg := newWsConfig(endpoint, opts...)
 	wsHandler := func(message []byte) {
 		event := new(WsContinuousKlineEvent)
 		err := json.Unmarshal(message, event)
@@ -278,9 +280,9 @@ type WsIndexPriceKline struct {
 type WsIndexPriceKlineHandler func(event *WsIndexPriceKlineEvent)
 
 // WsIndexPriceKlineServe serve websocket kline handler with a pair and interval like 15m, 30s
-func WsIndexPriceKlineServe(pair string, interval string, handler WsIndexPriceKlineHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
-	endpoint := fmt.Sprintf("%s/%s@indexPriceKline_%s", getWsEndpoint(), strings.ToLower(pair), interval)
-	cfg := newWsConfig(endpoint)
+func WsIndexPriceKlineServe(pair string, interval string, handler WsIndexPriceKlineHandler, errHandler ErrHandler, opts ...WsOptions) (doneC, stopC chan struct{}, err error) {
+	endpoint := fmt.Sprintf("%s/%s@indexPriceKline_%s", getWsEndpoint(opts...), strings.ToLower(pair), interval)
+	cfg := newWsConfig(endpoint, opts...)
 	wsHandler := func(message []byte) {
 		event := new(WsIndexPriceKlineEvent)
 		err := json.Unmarshal(message, event)
@@ -320,9 +322,9 @@ type WsMarkPriceKline struct {
 type WsMarkPriceKlineHandler func(event *WsMarkPriceKlineEvent)
 
 // WsMarkPriceKlineServe serve websocket kline handler with a symbol and interval like 15m, 30s
-func WsMarkPriceKlineServe(symbol string, interval string, handler WsMarkPriceKlineHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
-	endpoint := fmt.Sprintf("%s/%s@markPriceKline_%s", getWsEndpoint(), strings.ToLower(symbol), interval)
-	cfg := newWsConfig(endpoint)
+func WsMarkPriceKlineServe(symbol string, interval string, handler WsMarkPriceKlineHandler, errHandler ErrHandler, opts ...WsOptions) (doneC, stopC chan struct{}, err error) {
+	endpoint := fmt.Sprintf("%s/%s@markPriceKline_%s", getWsEndpoint(opts...), strings.ToLower(symbol), interval)
+	cfg := newWsConfig(endpoint, opts...)
 	wsHandler := func(message []byte) {
 		event := new(WsMarkPriceKlineEvent)
 		err := json.Unmarshal(message, event)
@@ -353,9 +355,9 @@ type WsMiniMarketTickerEvent struct {
 type WsMiniMarketTickerHandler func(event *WsMiniMarketTickerEvent)
 
 // WsMiniMarketTickerServe serve websocket that pushes 24hr rolling window mini-ticker statistics for a single symbol.
-func WsMiniMarketTickerServe(symbol string, handler WsMiniMarketTickerHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
-	endpoint := fmt.Sprintf("%s/%s@miniTicker", getWsEndpoint(), strings.ToLower(symbol))
-	cfg := newWsConfig(endpoint)
+func WsMiniMarketTickerServe(symbol string, handler WsMiniMarketTickerHandler, errHandler ErrHandler, opts ...WsOptions) (doneC, stopC chan struct{}, err error) {
+	endpoint := fmt.Sprintf("%s/%s@miniTicker", getWsEndpoint(opts...), strings.ToLower(symbol))
+	cfg := newWsConfig(endpoint, opts...)
 	wsHandler := func(message []byte) {
 		event := new(WsMiniMarketTickerEvent)
 		err := json.Unmarshal(message, &event)
@@ -375,9 +377,9 @@ type WsAllMiniMarketTickerEvent []*WsMiniMarketTickerEvent
 type WsAllMiniMarketTickerHandler func(event WsAllMiniMarketTickerEvent)
 
 // WsAllMiniMarketTickerServe serve websocket that pushes price and funding rate for all markets.
-func WsAllMiniMarketTickerServe(handler WsAllMiniMarketTickerHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
-	endpoint := fmt.Sprintf("%s/!miniTicker@arr", getWsEndpoint())
-	cfg := newWsConfig(endpoint)
+func WsAllMiniMarketTickerServe(handler WsAllMiniMarketTickerHandler, errHandler ErrHandler, opts ...WsOptions) (doneC, stopC chan struct{}, err error) {
+	endpoint := fmt.Sprintf("%s/!miniTicker@arr", getWsEndpoint(opts...))
+	cfg := newWsConfig(endpoint, opts...)
 	wsHandler := func(message []byte) {
 		var event WsAllMiniMarketTickerEvent
 		err := json.Unmarshal(message, &event)
@@ -417,9 +419,9 @@ type WsMarketTickerEvent struct {
 type WsMarketTickerHandler func(event *WsMarketTickerEvent)
 
 // WsMarketTickerServe serve websocket that pushes 24hr rolling window mini-ticker statistics for a single symbol.
-func WsMarketTickerServe(symbol string, handler WsMarketTickerHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
-	endpoint := fmt.Sprintf("%s/%s@ticker", getWsEndpoint(), strings.ToLower(symbol))
-	cfg := newWsConfig(endpoint)
+func WsMarketTickerServe(symbol string, handler WsMarketTickerHandler, errHandler ErrHandler, opts ...WsOptions) (doneC, stopC chan struct{}, err error) {
+	endpoint := fmt.Sprintf("%s/%s@ticker", getWsEndpoint(opts...), strings.ToLower(symbol))
+	cfg := newWsConfig(endpoint, opts...)
 	wsHandler := func(message []byte) {
 		event := new(WsMarketTickerEvent)
 		err := json.Unmarshal(message, &event)
@@ -439,9 +441,9 @@ type WsAllMarketTickerEvent []*WsMarketTickerEvent
 type WsAllMarketTickerHandler func(event WsAllMarketTickerEvent)
 
 // WsAllMarketTickerServe serve websocket that pushes price and funding rate for all markets.
-func WsAllMarketTickerServe(handler WsAllMarketTickerHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
-	endpoint := fmt.Sprintf("%s/!ticker@arr", getWsEndpoint())
-	cfg := newWsConfig(endpoint)
+func WsAllMarketTickerServe(handler WsAllMarketTickerHandler, errHandler ErrHandler, opts ...WsOptions) (doneC, stopC chan struct{}, err error) {
+	endpoint := fmt.Sprintf("%s/!ticker@arr", getWsEndpoint(opts...))
+	cfg := newWsConfig(endpoint, opts...)
 	wsHandler := func(message []byte) {
 		var event WsAllMarketTickerEvent
 		err := json.Unmarshal(message, &event)
@@ -472,34 +474,18 @@ type WsBookTickerEvent struct {
 type WsBookTickerHandler func(event *WsBookTickerEvent)
 
 // WsBookTickerServe serve websocket that pushes updates to the best bid or ask price or quantity in real-time for a specified symbol.
-func WsBookTickerServe(symbol string, handler WsBookTickerHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
-	endpoint := fmt.Sprintf("%s/%s@bookTicker", getWsEndpoint(), strings.ToLower(symbol))
-	cfg := newWsConfig(endpoint)
-	wsHandler := func(message []byte) {
-		event := new(WsBookTickerEvent)
-		err := json.Unmarshal(message, &event)
-		if err != nil {
-			errHandler(err)
-			return
-		}
-		handler(event)
-	}
+func WsBookTickerServe(symbol string, handler WsBookTickerHandler, errHandler ErrHandler, opts ...WsOptions) (doneC, stopC chan struct{}, err error) {
+	endpoint := fmt.Sprintf("%s/%s@bookTicker", getWsEndpoint(opts...), strings.ToLower(symbol))
+	cfg := newWsConfig(endpoint, opts...)
+	wsHandler := decodeHandler(cfg.Options.ReuseEvents, &wsBookTickerEvents, decodeWsBookTickerEvent, handler, errHandler)
 	return wsServe(cfg, wsHandler, errHandler)
 }
 
 // WsAllBookTickerServe serve websocket that pushes updates to the best bid or ask price or quantity in real-time for all symbols.
-func WsAllBookTickerServe(handler WsBookTickerHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
-	endpoint := fmt.Sprintf("%s/!bookTicker", getWsEndpoint())
-	cfg := newWsConfig(endpoint)
-	wsHandler := func(message []byte) {
-		event := new(WsBookTickerEvent)
-		err := json.Unmarshal(message, &event)
-		if err != nil {
-			errHandler(err)
-			return
-		}
-		handler(event)
-	}
+func WsAllBookTickerServe(handler WsBookTickerHandler, errHandler ErrHandler, opts ...WsOptions) (doneC, stopC chan struct{}, err error) {
+	endpoint := fmt.Sprintf("%s/!bookTicker", getWsEndpoint(opts...))
+	cfg := newWsConfig(endpoint, opts...)
+	wsHandler := decodeHandler(cfg.Options.ReuseEvents, &wsBookTickerEvents, decodeWsBookTickerEvent, handler, errHandler)
 	return wsServe(cfg, wsHandler, errHandler)
 }
 
@@ -530,9 +516,9 @@ type WsLiquidationOrder struct {
 type WsLiquidationOrderHandler func(event *WsLiquidationOrderEvent)
 
 // WsLiquidationOrderServe serve websocket that pushes force liquidation order information for specific symbol.
-func WsLiquidationOrderServe(symbol string, handler WsLiquidationOrderHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
-	endpoint := fmt.Sprintf("%s/%s@forceOrder", getWsEndpoint(), strings.ToLower(symbol))
-	cfg := newWsConfig(endpoint)
+func WsLiquidationOrderServe(symbol string, handler WsLiquidationOrderHandler, errHandler ErrHandler, opts ...WsOptions) (doneC, stopC chan struct{}, err error) {
+	endpoint := fmt.Sprintf("%s/%s@forceOrder", getWsEndpoint(opts...), strings.ToLower(symbol))
+	cfg := newWsConfig(endpoint, opts...)
 	wsHandler := func(message []byte) {
 		event := new(WsLiquidationOrderEvent)
 		err := json.Unmarshal(message, &event)
@@ -546,9 +532,9 @@ func WsLiquidationOrderServe(symbol string, handler WsLiquidationOrderHandler, e
 }
 
 // WsAllLiquidationOrderServe serve websocket that pushes force liquidation order information for all symbols.
-func WsAllLiquidationOrderServe(handler WsLiquidationOrderHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
-	endpoint := fmt.Sprintf("%s/!forceOrder@arr", getWsEndpoint())
-	cfg := newWsConfig(endpoint)
+func WsAllLiquidationOrderServe(handler WsLiquidationOrderHandler, errHandler ErrHandler, opts ...WsOptions) (doneC, stopC chan struct{}, err error) {
+	endpoint := fmt.Sprintf("%s/!forceOrder@arr", getWsEndpoint(opts...))
+	cfg := newWsConfig(endpoint, opts...)
 	wsHandler := func(message []byte) {
 		event := new(WsLiquidationOrderEvent)
 		err := json.Unmarshal(message, &event)
@@ -578,35 +564,35 @@ type WsDepthEvent struct {
 // WsDepthHandler handle websocket depth event
 type WsDepthHandler func(event *WsDepthEvent)
 
-func wsPartialDepthServe(symbol string, levels int, rate *time.Duration, handler WsDepthHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+func wsPartialDepthServe(symbol string, levels int, rate *time.Duration, handler WsDepthHandler, errHandler ErrHandler, opts ...WsOptions) (doneC, stopC chan struct{}, err error) {
 	if levels != 5 && levels != 10 && levels != 20 {
 		return nil, nil, errors.New("Invalid levels")
 	}
 	levelsStr := fmt.Sprintf("%d", levels)
-	return wsDepthServe(symbol, levelsStr, rate, handler, errHandler)
+	return wsDepthServe(symbol, levelsStr, rate, handler, errHandler, opts...)
 }
 
 // WsPartialDepthServe serve websocket partial depth handler.
-func WsPartialDepthServe(symbol string, levels int, handler WsDepthHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
-	return wsPartialDepthServe(symbol, levels, nil, handler, errHandler)
+func WsPartialDepthServe(symbol string, levels int, handler WsDepthHandler, errHandler ErrHandler, opts ...WsOptions) (doneC, stopC chan struct{}, err error) {
+	return wsPartialDepthServe(symbol, levels, nil, handler, errHandler, opts...)
 }
 
 // WsPartialDepthServeWithRate serve websocket partial depth handler with rate.
-func WsPartialDepthServeWithRate(symbol string, levels int, rate *time.Duration, handler WsDepthHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
-	return wsPartialDepthServe(symbol, levels, rate, handler, errHandler)
+func WsPartialDepthServeWithRate(symbol string, levels int, rate *time.Duration, handler WsDepthHandler, errHandler ErrHandler, opts ...WsOptions) (doneC, stopC chan struct{}, err error) {
+	return wsPartialDepthServe(symbol, levels, rate, handler, errHandler, opts...)
 }
 
 // WsDiffDepthServe serve websocket diff. depth handler.
-func WsDiffDepthServe(symbol string, handler WsDepthHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
-	return wsDepthServe(symbol, "", nil, handler, errHandler)
+func WsDiffDepthServe(symbol string, handler WsDepthHandler, errHandler ErrHandler, opts ...WsOptions) (doneC, stopC chan struct{}, err error) {
+	return wsDepthServe(symbol, "", nil, handler, errHandler, opts...)
 }
 
 // WsDiffDepthServe serve websocket diff. depth handler with rate.
-func WsDiffDepthServeWithRate(symbol string, rate *time.Duration, handler WsDepthHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
-	return wsDepthServe(symbol, "", rate, handler, errHandler)
+func WsDiffDepthServeWithRate(symbol string, rate *time.Duration, handler WsDepthHandler, errHandler ErrHandler, opts ...WsOptions) (doneC, stopC chan struct{}, err error) {
+	return wsDepthServe(symbol, "", rate, handler, errHandler, opts...)
 }
 
-func wsDepthServe(symbol string, levels string, rate *time.Duration, handler WsDepthHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+func wsDepthServe(symbol string, levels string, rate *time.Duration, handler WsDepthHandler, errHandler ErrHandler, opts ...WsOptions) (doneC, stopC chan struct{}, err error) {
 	var rateStr string
 	if rate != nil {
 		switch *rate {
@@ -621,44 +607,10 @@ func wsDepthServe(symbol string, levels string, rate *time.Duration, handler WsD
 		}
 	}
 
-	endpoint := fmt.Sprintf("%s/%s@depth%s%s", getWsEndpoint(), strings.ToLower(symbol), levels, rateStr)
-	cfg := newWsConfig(endpoint)
+	endpoint := fmt.Sprintf("%s/%s@depth%s%s", getWsEndpoint(opts...), strings.ToLower(symbol), levels, rateStr)
+	cfg := newWsConfig(endpoint, opts...)
 
-	wsHandler := func(message []byte) {
-		j, err := newJSON(message)
-		if err != nil {
-			errHandler(err)
-			return
-		}
-		event := new(WsDepthEvent)
-		event.Event = j.Get("e").MustString()
-		event.Time = j.Get("E").MustInt64()
-		event.TransactionTime = j.Get("T").MustInt64()
-		event.Symbol = j.Get("s").MustString()
-		event.Pair = j.Get("ps").MustString()
-		event.FirstUpdateID = j.Get("U").MustInt64()
-		event.LastUpdateID = j.Get("u").MustInt64()
-		event.PrevLastUpdateID = j.Get("pu").MustInt64()
-		bidsLen := len(j.Get("b").MustArray())
-		event.Bids = make([]Bid, bidsLen)
-		for i := 0; i < bidsLen; i++ {
-			item := j.Get("b").GetIndex(i)
-			event.Bids[i] = Bid{
-				Price:    item.GetIndex(0).MustString(),
-				Quantity: item.GetIndex(1).MustString(),
-			}
-		}
-		asksLen := len(j.Get("a").MustArray())
-		event.Asks = make([]Ask, asksLen)
-		for i := 0; i < asksLen; i++ {
-			item := j.Get("a").GetIndex(i)
-			event.Asks[i] = Ask{
-				Price:    item.GetIndex(0).MustString(),
-				Quantity: item.GetIndex(1).MustString(),
-			}
-		}
-		handler(event)
-	}
+	wsHandler := decodeHandler(cfg.Options.ReuseEvents, &wsDepthEvents, decodeWsDepthEvent, handler, errHandler)
 	return wsServe(cfg, wsHandler, errHandler)
 }
 
@@ -786,9 +738,9 @@ type WsAccountConfigUpdate struct {
 type WsUserDataHandler func(event *WsUserDataEvent)
 
 // WsUserDataServe serve user data handler with listen key
-func WsUserDataServe(listenKey string, handler WsUserDataHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
-	endpoint := fmt.Sprintf("%s/%s", getWsEndpoint(), listenKey)
-	cfg := newWsConfig(endpoint)
+func WsUserDataServe(listenKey string, handler WsUserDataHandler, errHandler ErrHandler, opts ...WsOptions) (doneC, stopC chan struct{}, err error) {
+	endpoint := fmt.Sprintf("%s/%s", getWsEndpoint(opts...), listenKey)
+	cfg := newWsConfig(endpoint, opts...)
 	wsHandler := func(message []byte) {
 		event := new(WsUserDataEvent)
 		err := json.Unmarshal(message, event)
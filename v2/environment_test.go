@@ -0,0 +1,86 @@
+package binance
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type environmentTestSuite struct {
+	suite.Suite
+}
+
+func TestEnvironment(t *testing.T) {
+	suite.Run(t, new(environmentTestSuite))
+}
+
+func (s *environmentTestSuite) TestEnvironments() {
+	s.Equal(Environment{
+		Type:        EnvironmentTypeProduction,
+		APIURL:      BaseAPIMainURL,
+		WsURL:       BaseWsMainURL,
+		CombinedURL: BaseCombinedMainURL,
+		WsApiURL:    BaseWsApiMainURL,
+	}, ProductionEnvironment())
+	s.Equal(EnvironmentTypeTestnet, TestnetEnvironment().Type)
+	s.Equal(BaseAPITestnetURL, TestnetEnvironment().APIURL)
+	s.Equal(BaseWsApiDemoURL, DemoEnvironment().WsApiURL)
+
+	custom := ProductionEnvironment().WithAPIURL("https://api1.binance.com")
+	s.Equal(EnvironmentTypeCustom, custom.Type)
+	s.Equal("https://api1.binance.com", custom.APIURL)
+	s.Equal(BaseWsMainURL, custom.WsURL)
+}
+
+func (s *environmentTestSuite) TestClient() {
+	c := NewClientWithEnvironment("key", "secret", TestnetEnvironment())
+	s.Equal(BaseAPITestnetURL, c.BaseURL)
+	s.Equal(TestnetEnvironment(), c.Environment)
+
+	c = NewClient("key", "secret")
+	s.Equal(BaseAPIMainURL, c.BaseURL)
+	s.Equal(ProductionEnvironment(), c.Environment)
+}
+
+func (s *environmentTestSuite) TestWsOptions() {
+	opts := TestnetEnvironment().WsOptions()
+	s.Equal(BaseWsTestnetURL+"/btcusdt@depth", newWsConfig(getWsEndpoint(opts)+"/btcusdt@depth", opts).Endpoint)
+	s.Equal(BaseCombinedTestnetURL+"btcusdt@depth", newWsConfig(getCombinedEndpoint(opts)+"btcusdt@depth", opts).Endpoint)
+	s.Equal(BaseWsApiTestnetURL, newWsConfig(getWsApiEndpoint(opts), opts).Endpoint)
+	s.Equal(BaseWsMainURL+"/btcusdt@depth", newWsConfig(getWsEndpoint()+"/btcusdt@depth").Endpoint)
+}
+
+func (s *environmentTestSuite) TestConcurrentClients() {
+	var mainnet, testnet int64
+	server := func(count *int64) *httptest.Server {
+		return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			atomic.AddInt64(count, 1)
+			w.Write([]byte(`{}`))
+		}))
+	}
+	mainnetServer, testnetServer := server(&mainnet), server(&testnet)
+	defer mainnetServer.Close()
+	defer testnetServer.Close()
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		for _, env := range []Environment{
+			ProductionEnvironment().WithAPIURL(mainnetServer.URL),
+			TestnetEnvironment().WithAPIURL(testnetServer.URL),
+		} {
+			wg.Add(1)
+			go func(env Environment) {
+				defer wg.Done()
+				s.NoError(NewClientWithEnvironment("key", "secret", env).NewPingService().Do(context.Background()))
+			}(env)
+		}
+	}
+	wg.Wait()
+	s.Equal(int64(10), atomic.LoadInt64(&mainnet))
+	s.Equal(int64(10), atomic.LoadInt64(&testnet))
+}
@@ -0,0 +1,501 @@
+// Package execution slices parent spot orders into child orders with
+// client-side execution algorithms (TWAP, volume participation and passive
+// iceberg). Child orders are routed through an OrderPlacer, which can be backed
+// by the REST CreateOrderService or by the websocket API 'order.place' method.
+package execution
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/shopspring/decimal"
+
+	"github.com/adshao/go-binance/v2"
+	"github.com/adshao/go-binance/v2/common"
+	"github.com/adshao/go-binance/v2/internal/idempotency"
+)
+
+// AlgoType define execution algorithm type
+type AlgoType string
+
+// StatusType define execution status type
+type StatusType string
+
+// Global enums
+const (
+	AlgoTypeTwap    AlgoType = "TWAP"
+	AlgoTypePov     AlgoType = "POV"
+	AlgoTypeIceberg AlgoType = "ICEBERG"
+
+	StatusTypeWorking   StatusType = "WORKING"
+	StatusTypeFinished  StatusType = "FINISHED"
+	StatusTypeCancelled StatusType = "CANCELLED"
+	StatusTypeExpired   StatusType = "EXPIRED"
+	StatusTypeFailed    StatusType = "FAILED"
+)
+
+var (
+	// ErrInvalidSymbol defines that the parent order symbol is not set
+	ErrInvalidSymbol = errors.New("execution: symbol is not set")
+
+	// ErrInvalidSide defines that the parent order side is not BUY or SELL
+	ErrInvalidSide = errors.New("execution: side must be BUY or SELL")
+
+	// ErrInvalidQuantity defines that the parent order quantity is not a positive number
+	ErrInvalidQuantity = errors.New("execution: quantity must be positive")
+
+	// ErrInvalidDuration defines that the execution horizon is not set
+	ErrInvalidDuration = errors.New("execution: duration must be positive")
+
+	// ErrInvalidParticipationRate defines that the participation rate is not in (0, 1]
+	ErrInvalidParticipationRate = errors.New("execution: participation rate must be in (0, 1]")
+
+	// ErrInvalidDisplayQuantity defines that the iceberg display quantity is not a positive number
+	ErrInvalidDisplayQuantity = errors.New("execution: display quantity must be positive")
+
+	// ErrMarketDataClosed defines that the market data stream driving the execution was closed
+	ErrMarketDataClosed = errors.New("execution: market data stream closed")
+
+	// ErrTooManyChildErrors defines that child orders kept failing and the execution gave up
+	ErrTooManyChildErrors = errors.New("execution: too many consecutive child order errors")
+
+	// ErrChildOrderUnknown defines that a child order failed without being rejected and could not be found
+	ErrChildOrderUnknown = errors.New("execution: child order status unknown")
+)
+
+var (
+	// DefaultMaxChildErrors is the number of consecutive child order errors tolerated before an execution fails
+	DefaultMaxChildErrors = 3
+
+	// DefaultPollInterval is the interval used to poll resting child orders for fills
+	DefaultPollInterval = time.Second
+
+	// DefaultCancelTimeout bounds the time spent cancelling the resting child order when an execution stops
+	DefaultCancelTimeout = 5 * time.Second
+)
+
+// AggTradeServeFunc serve aggregate trades for a symbol, binance.WsAggTradeServe by default
+type AggTradeServeFunc func(symbol string, handler binance.WsAggTradeHandler, errHandler binance.ErrHandler) (doneC, stopC chan struct{}, err error)
+
+// BookTickerServeFunc serve best bid/ask updates for a symbol, binance.WsBookTickerServe by default
+type BookTickerServeFunc func(symbol string, handler binance.WsBookTickerHandler, errHandler binance.ErrHandler) (doneC, stopC chan struct{}, err error)
+
+// ProgressHandler is called after every change of an execution's state
+type ProgressHandler func(progress *Progress)
+
+// Executor runs execution algorithms against a single order placer
+type Executor struct {
+	placer          OrderPlacer
+	aggTradeServe   AggTradeServeFunc
+	bookTickerServe BookTickerServeFunc
+	errHandler      binance.ErrHandler
+}
+
+// NewExecutor init an Executor routing child orders through placer.
+// Market data is taken from binance.WsAggTradeServe and binance.WsBookTickerServe.
+func NewExecutor(placer OrderPlacer) *Executor {
+	return &Executor{
+		placer: placer,
+		aggTradeServe: func(symbol string, handler binance.WsAggTradeHandler, errHandler binance.ErrHandler) (doneC, stopC chan struct{}, err error) {
+			return binance.WsAggTradeServe(symbol, handler, errHandler)
+		},
+		bookTickerServe: func(symbol string, handler binance.WsBookTickerHandler, errHandler binance.ErrHandler) (doneC, stopC chan struct{}, err error) {
+			return binance.WsBookTickerServe(symbol, handler, errHandler)
+		},
+		errHandler: func(err error) {},
+	}
+}
+
+// AggTradeServe set the aggregate trade stream used by volume participation
+func (e *Executor) AggTradeServe(f AggTradeServeFunc) *Executor {
+	e.aggTradeServe = f
+	return e
+}
+
+// BookTickerServe set the book ticker stream used by iceberg price pegging
+func (e *Executor) BookTickerServe(f BookTickerServeFunc) *Executor {
+	e.bookTickerServe = f
+	return e
+}
+
+// ErrHandler set the handler receiving market data stream errors
+func (e *Executor) ErrHandler(errHandler binance.ErrHandler) *Executor {
+	e.errHandler = errHandler
+	return e
+}
+
+// NewTwapService init TWAP execution service
+func (e *Executor) NewTwapService() *TwapService {
+	return &TwapService{e: e}
+}
+
+// NewPovService init volume participation execution service
+func (e *Executor) NewPovService() *PovService {
+	return &PovService{e: e}
+}
+
+// NewIcebergService init passive iceberg execution service
+func (e *Executor) NewIcebergService() *IcebergService {
+	return &IcebergService{e: e}
+}
+
+// Progress define the state of an execution
+type Progress struct {
+	ID                       string
+	Algo                     AlgoType
+	Symbol                   string
+	Side                     binance.SideType
+	Status                   StatusType
+	TotalQuantity            string
+	ExecutedQuantity         string
+	CummulativeQuoteQuantity string
+	AvgPrice                 string
+	ChildOrders              int
+	LastChild                *ChildOrderResult
+	StartTime                int64
+	UpdateTime               int64
+	Err                      error
+}
+
+// Execution is the handle of a running execution algorithm
+type Execution struct {
+	cancel context.CancelFunc
+	doneC  chan struct{}
+
+	mu       sync.Mutex
+	progress Progress
+}
+
+// ID returns execution id, which is also the prefix of every child client order id
+func (x *Execution) ID() string {
+	x.mu.Lock()
+	defer x.mu.Unlock()
+	return x.progress.ID
+}
+
+// Cancel stops the execution and cancels its resting child order
+func (x *Execution) Cancel() {
+	x.cancel()
+}
+
+// Done returns a channel closed when the execution has stopped
+func (x *Execution) Done() <-chan struct{} {
+	return x.doneC
+}
+
+// Wait blocks until the execution stops or ctx expires, returning the final progress
+func (x *Execution) Wait(ctx context.Context) (*Progress, error) {
+	select {
+	case <-x.doneC:
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+	p := x.Progress()
+	return p, p.Err
+}
+
+// Progress returns a snapshot of the execution state
+func (x *Execution) Progress() *Progress {
+	x.mu.Lock()
+	defer x.mu.Unlock()
+	p := x.progress
+	return &p
+}
+
+// parentOrder define parameters shared by all execution algorithms
+type parentOrder struct {
+	symbol      string
+	side        binance.SideType
+	quantity    string
+	limitPrice  *string
+	stepSize    *string
+	tickSize    *string
+	minQuantity *string
+	onProgress  ProgressHandler
+	maxErrors   int
+}
+
+func (p *parentOrder) validate() error {
+	if p.symbol == "" {
+		return ErrInvalidSymbol
+	}
+	if p.side != binance.SideTypeBuy && p.side != binance.SideTypeSell {
+		return ErrInvalidSide
+	}
+	q, err := decimal.NewFromString(p.quantity)
+	if err != nil || !q.IsPositive() {
+		return ErrInvalidQuantity
+	}
+	for _, v := range []*string{p.limitPrice, p.stepSize, p.tickSize, p.minQuantity} {
+		if v == nil {
+			continue
+		}
+		if _, err := decimal.NewFromString(*v); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// run holds the mutable state of an execution while its algorithm runs
+type run struct {
+	parentOrder
+	x           *Execution
+	placer      OrderPlacer
+	total       decimal.Decimal
+	executed    decimal.Decimal
+	quote       decimal.Decimal
+	limit       *decimal.Decimal
+	step        decimal.Decimal
+	tick        decimal.Decimal
+	minQty      decimal.Decimal
+	seq         int
+	childErrors int
+}
+
+func (e *Executor) start(ctx context.Context, algo AlgoType, p parentOrder, f func(ctx context.Context, r *run) error) (*Execution, error) {
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+	if p.maxErrors <= 0 {
+		p.maxErrors = DefaultMaxChildErrors
+	}
+	r := &run{
+		parentOrder: p,
+		placer:      e.placer,
+		total:       decimal.RequireFromString(p.quantity),
+	}
+	if p.limitPrice != nil {
+		l := decimal.RequireFromString(*p.limitPrice)
+		r.limit = &l
+	}
+	if p.stepSize != nil {
+		r.step = decimal.RequireFromString(*p.stepSize)
+	}
+	if p.tickSize != nil {
+		r.tick = decimal.RequireFromString(*p.tickSize)
+	}
+	if p.minQuantity != nil {
+		r.minQty = decimal.RequireFromString(*p.minQuantity)
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	now := time.Now().UnixMilli()
+	r.x = &Execution{
+		cancel: cancel,
+		doneC:  make(chan struct{}),
+		progress: Progress{
+			ID:                       common.Uuid22()[:16],
+			Algo:                     algo,
+			Symbol:                   p.symbol,
+			Side:                     p.side,
+			Status:                   StatusTypeWorking,
+			TotalQuantity:            r.total.String(),
+			ExecutedQuantity:         "0",
+			CummulativeQuoteQuantity: "0",
+			AvgPrice:                 "0",
+			StartTime:                now,
+			UpdateTime:               now,
+		},
+	}
+
+	go func() {
+		defer close(r.x.doneC)
+		defer cancel()
+		err := f(ctx, r)
+		status := StatusTypeFinished
+		switch {
+		case err == nil && r.remaining().IsPositive():
+			status = StatusTypeExpired
+		case errors.Is(err, context.Canceled):
+			status, err = StatusTypeCancelled, nil
+		case errors.Is(err, context.DeadlineExceeded):
+			status, err = StatusTypeExpired, nil
+		case err != nil:
+			status = StatusTypeFailed
+		}
+		r.update(func(p *Progress) {
+			p.Status = status
+			p.Err = err
+		})
+	}()
+	return r.x, nil
+}
+
+// remaining returns the quantity left to execute
+func (r *run) remaining() decimal.Decimal {
+	return r.total.Sub(r.executed)
+}
+
+// roundQuantity truncates quantity to the step size
+func (r *run) roundQuantity(q decimal.Decimal) decimal.Decimal {
+	if r.step.IsPositive() {
+		q = q.Div(r.step).Floor().Mul(r.step)
+	}
+	return q
+}
+
+// roundPrice rounds price to the tick size, never in the aggressive direction
+func (r *run) roundPrice(p decimal.Decimal) decimal.Decimal {
+	if !r.tick.IsPositive() {
+		return p
+	}
+	n := p.Div(r.tick)
+	if r.side == binance.SideTypeBuy {
+		n = n.Floor()
+	} else {
+		n = n.Ceil()
+	}
+	return n.Mul(r.tick)
+}
+
+// guardPrice clamps price so that it never crosses the parent limit price
+func (r *run) guardPrice(p decimal.Decimal) decimal.Decimal {
+	if r.limit == nil {
+		return p
+	}
+	if r.side == binance.SideTypeBuy && p.GreaterThan(*r.limit) {
+		return *r.limit
+	}
+	if r.side == binance.SideTypeSell && p.LessThan(*r.limit) {
+		return *r.limit
+	}
+	return p
+}
+
+// sliceQuantity returns q rounded to the step size and capped to the remaining quantity,
+// or zero when the result is below the minimum quantity
+func (r *run) sliceQuantity(q decimal.Decimal) decimal.Decimal {
+	if rem := r.remaining(); q.GreaterThan(rem) {
+		q = rem
+	}
+	q = r.roundQuantity(q)
+	if !q.IsPositive() || q.LessThan(r.minQty) {
+		return decimal.Zero
+	}
+	return q
+}
+
+// nextClientOrderID returns a unique client order id for the next child order
+func (r *run) nextClientOrderID() string {
+	r.seq++
+	return fmt.Sprintf("%s%s-%d", common.SPOT_ORDER_PREFIX, r.x.ID(), r.seq)
+}
+
+// newChild builds the next child order: a LIMIT IOC at the limit price when a limit is set,
+// otherwise a MARKET order
+func (r *run) newChild(q decimal.Decimal) *ChildOrder {
+	child := &ChildOrder{
+		Symbol:        r.symbol,
+		Side:          r.side,
+		Type:          binance.OrderTypeMarket,
+		Quantity:      q.String(),
+		ClientOrderID: r.nextClientOrderID(),
+	}
+	if r.limit != nil {
+		child.Type = binance.OrderTypeLimit
+		child.TimeInForce = binance.TimeInForceTypeIOC
+		child.Price = r.roundPrice(*r.limit).String()
+	}
+	return child
+}
+
+// place sends a child order and accounts for its immediate fills. A child order failed
+// without being rejected by the exchange is looked up by its client order id, the execution
+// fails when it cannot be found. Only rejected child orders count as child errors.
+func (r *run) place(ctx context.Context, child *ChildOrder) (*ChildOrderResult, error) {
+	res, err := r.placer.PlaceOrder(ctx, child)
+	if err != nil && !idempotency.Rejected(err) {
+		found, e := r.queryChild(child.ClientOrderID)
+		switch {
+		case e == nil:
+			res, err = found, nil
+		case ctx.Err() == nil:
+			return nil, fmt.Errorf("%w: %s: %v", ErrChildOrderUnknown, child.ClientOrderID, err)
+		}
+	}
+	if err != nil {
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+		return nil, r.childError(err)
+	}
+	r.childErrors = 0
+	r.fill(res, decimal.Zero, decimal.Zero)
+	return res, nil
+}
+
+// childError counts consecutive child order errors, returning a terminal error when the limit is reached
+func (r *run) childError(err error) error {
+	r.childErrors++
+	r.update(func(p *Progress) {
+		p.Err = err
+	})
+	if r.childErrors >= r.maxErrors {
+		return fmt.Errorf("%w: %v", ErrTooManyChildErrors, err)
+	}
+	return nil
+}
+
+// fill accounts for the execution of a child order, given what was already accounted before
+func (r *run) fill(res *ChildOrderResult, prevQty, prevQuote decimal.Decimal) {
+	qty, _ := decimal.NewFromString(res.ExecutedQuantity)
+	quote, _ := decimal.NewFromString(res.CummulativeQuoteQuantity)
+	r.executed = r.executed.Add(qty.Sub(prevQty))
+	r.quote = r.quote.Add(quote.Sub(prevQuote))
+	r.update(func(p *Progress) {
+		p.ChildOrders = r.seq
+		p.LastChild = res
+		p.Err = nil
+	})
+}
+
+// update applies f to the progress and notifies the progress handler
+func (r *run) update(f func(p *Progress)) {
+	r.x.mu.Lock()
+	p := &r.x.progress
+	f(p)
+	p.ExecutedQuantity = r.executed.String()
+	p.CummulativeQuoteQuantity = r.quote.String()
+	if r.executed.IsPositive() {
+		p.AvgPrice = r.quote.Div(r.executed).String()
+	}
+	p.UpdateTime = time.Now().UnixMilli()
+	snapshot := *p
+	r.x.mu.Unlock()
+	if r.onProgress != nil {
+		r.onProgress(&snapshot)
+	}
+}
+
+// queryChild queries a child order, detached from the execution context
+func (r *run) queryChild(clientOrderID string) (*ChildOrderResult, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), DefaultCancelTimeout)
+	defer cancel()
+	return r.placer.QueryOrder(ctx, r.symbol, clientOrderID)
+}
+
+// cancelChild cancels a resting child order, detached from the execution context
+func (r *run) cancelChild(child *ChildOrderResult) (*ChildOrderResult, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), DefaultCancelTimeout)
+	defer cancel()
+	return r.placer.CancelOrder(ctx, r.symbol, child.ClientOrderID)
+}
+
+// sleep waits for d or until ctx is done
+func sleep(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return ctx.Err()
+	}
+	t := time.NewTimer(d)
+	defer t.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-t.C:
+		return nil
+	}
+}
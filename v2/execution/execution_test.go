@@ -0,0 +1,495 @@
+package execution
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/adshao/go-binance/v2"
+	"github.com/adshao/go-binance/v2/common"
+)
+
+// fakePlacer fills market and IOC orders immediately at a fixed price and rests LIMIT_MAKER orders
+type fakePlacer struct {
+	mu       sync.Mutex
+	price    decimal.Decimal
+	placed   []*ChildOrder
+	open     map[string]*ChildOrderResult
+	canceled []string
+	placeErr error // returned without placing the order
+	lostErr  error // returned after placing the order
+	queryErr error
+	hold     bool // LIMIT_MAKER orders are rested, then returned once their context is done
+}
+
+func newFakePlacer(price string) *fakePlacer {
+	return &fakePlacer{price: decimal.RequireFromString(price), open: map[string]*ChildOrderResult{}}
+}
+
+func (p *fakePlacer) PlaceOrder(ctx context.Context, order *ChildOrder) (*ChildOrderResult, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.placeErr != nil {
+		return nil, p.placeErr
+	}
+	p.placed = append(p.placed, order)
+	res := &ChildOrderResult{
+		Symbol:                   order.Symbol,
+		OrderID:                  int64(len(p.placed)),
+		ClientOrderID:            order.ClientOrderID,
+		Price:                    order.Price,
+		OrigQuantity:             order.Quantity,
+		ExecutedQuantity:         "0",
+		CummulativeQuoteQuantity: "0",
+		Status:                   binance.OrderStatusTypeNew,
+	}
+	if order.Type == binance.OrderTypeLimitMaker {
+		c := *res
+		p.open[order.ClientOrderID] = &c
+		if p.hold {
+			p.mu.Unlock()
+			<-ctx.Done()
+			p.mu.Lock()
+			return nil, ctx.Err()
+		}
+		return res, nil
+	}
+	q := decimal.RequireFromString(order.Quantity)
+	res.ExecutedQuantity = q.String()
+	res.CummulativeQuoteQuantity = q.Mul(p.price).String()
+	res.Status = binance.OrderStatusTypeFilled
+	if p.lostErr != nil {
+		return nil, p.lostErr
+	}
+	return res, nil
+}
+
+func (p *fakePlacer) CancelOrder(ctx context.Context, symbol, clientOrderID string) (*ChildOrderResult, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	res, ok := p.open[clientOrderID]
+	if !ok {
+		return nil, &common.APIError{Code: -2011, Message: "Unknown order sent."}
+	}
+	delete(p.open, clientOrderID)
+	p.canceled = append(p.canceled, clientOrderID)
+	c := *res
+	c.Status = binance.OrderStatusTypeCanceled
+	return &c, nil
+}
+
+func (p *fakePlacer) QueryOrder(ctx context.Context, symbol, clientOrderID string) (*ChildOrderResult, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.queryErr != nil {
+		return nil, p.queryErr
+	}
+	if res, ok := p.open[clientOrderID]; ok {
+		c := *res
+		return &c, nil
+	}
+	for _, o := range p.placed {
+		if o.ClientOrderID == clientOrderID {
+			price := p.price
+			if o.Price != "" {
+				price = decimal.RequireFromString(o.Price)
+			}
+			return &ChildOrderResult{
+				Symbol:                   symbol,
+				ClientOrderID:            clientOrderID,
+				ExecutedQuantity:         o.Quantity,
+				CummulativeQuoteQuantity: decimal.RequireFromString(o.Quantity).Mul(price).String(),
+				Status:                   binance.OrderStatusTypeFilled,
+			}, nil
+		}
+	}
+	return nil, &common.APIError{Code: -2013, Message: "Order does not exist."}
+}
+
+// fillOpen fully fills the resting order with the given client order id
+func (p *fakePlacer) fillOpen(clientOrderID string) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	res := p.open[clientOrderID]
+	q := decimal.RequireFromString(res.OrigQuantity)
+	res.ExecutedQuantity = q.String()
+	res.CummulativeQuoteQuantity = q.Mul(decimal.RequireFromString(res.Price)).String()
+	res.Status = binance.OrderStatusTypeFilled
+	delete(p.open, clientOrderID)
+}
+
+func (p *fakePlacer) placedOrders() []*ChildOrder {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return append([]*ChildOrder(nil), p.placed...)
+}
+
+func (p *fakePlacer) openOrders() []*ChildOrderResult {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	var res []*ChildOrderResult
+	for _, o := range p.open {
+		c := *o
+		res = append(res, &c)
+	}
+	return res
+}
+
+type executionTestSuite struct {
+	suite.Suite
+	placer *fakePlacer
+}
+
+func TestExecution(t *testing.T) {
+	suite.Run(t, new(executionTestSuite))
+}
+
+func (s *executionTestSuite) SetupTest() {
+	s.placer = newFakePlacer("100")
+}
+
+func (s *executionTestSuite) wait(x *Execution) *Progress {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	p, _ := x.Wait(ctx)
+	s.Require().NotNil(p)
+	return p
+}
+
+func (s *executionTestSuite) TestTwap() {
+	var updates []*Progress
+	x, err := NewExecutor(s.placer).NewTwapService().
+		Symbol("BTCUSDT").
+		Side(binance.SideTypeBuy).
+		Quantity("1").
+		StepSize("0.001").
+		Duration(40 * time.Millisecond).
+		Slices(3).
+		OnProgress(func(p *Progress) { updates = append(updates, p) }).
+		Do(context.Background())
+	s.Require().NoError(err)
+
+	p := s.wait(x)
+	r := s.Require()
+	r.Equal(StatusTypeFinished, p.Status)
+	r.Equal("1", p.ExecutedQuantity)
+	r.Equal("100", p.AvgPrice)
+	r.Equal(3, p.ChildOrders)
+	placed := s.placer.placedOrders()
+	r.Len(placed, 3)
+	r.Equal("0.333", placed[0].Quantity)
+	r.Equal("0.333", placed[1].Quantity)
+	r.Equal("0.334", placed[2].Quantity)
+	r.Equal(binance.OrderTypeMarket, placed[0].Type)
+	r.Contains(placed[0].ClientOrderID, x.ID())
+	r.Equal(StatusTypeFinished, updates[len(updates)-1].Status)
+}
+
+func (s *executionTestSuite) TestTwapLimitPrice() {
+	x, err := NewExecutor(s.placer).NewTwapService().
+		Symbol("BTCUSDT").
+		Side(binance.SideTypeSell).
+		Quantity("2").
+		LimitPrice("100.05").
+		TickSize("0.1").
+		Duration(10 * time.Millisecond).
+		Slices(2).
+		Do(context.Background())
+	s.Require().NoError(err)
+
+	p := s.wait(x)
+	placed := s.placer.placedOrders()
+	r := s.Require()
+	r.Equal(StatusTypeFinished, p.Status)
+	r.Len(placed, 2)
+	r.Equal(binance.OrderTypeLimit, placed[0].Type)
+	r.Equal(binance.TimeInForceTypeIOC, placed[0].TimeInForce)
+	r.Equal("100.1", placed[0].Price)
+}
+
+func (s *executionTestSuite) TestTwapCancel() {
+	x, err := NewExecutor(s.placer).NewTwapService().
+		Symbol("BTCUSDT").
+		Side(binance.SideTypeBuy).
+		Quantity("1").
+		Duration(time.Hour).
+		Slices(4).
+		Do(context.Background())
+	s.Require().NoError(err)
+
+	time.Sleep(20 * time.Millisecond)
+	x.Cancel()
+	p := s.wait(x)
+	r := s.Require()
+	r.Equal(StatusTypeCancelled, p.Status)
+	r.NoError(p.Err)
+	r.Equal("0.25", p.ExecutedQuantity)
+}
+
+func (s *executionTestSuite) TestTwapChildErrors() {
+	s.placer.placeErr = &common.APIError{Code: -2010, Message: "Account has insufficient balance for requested action."}
+	x, err := NewExecutor(s.placer).NewTwapService().
+		Symbol("BTCUSDT").
+		Side(binance.SideTypeBuy).
+		Quantity("1").
+		Duration(10 * time.Millisecond).
+		Slices(5).
+		MaxChildErrors(2).
+		Do(context.Background())
+	s.Require().NoError(err)
+
+	p := s.wait(x)
+	r := s.Require()
+	r.Equal(StatusTypeFailed, p.Status)
+	r.ErrorIs(p.Err, ErrTooManyChildErrors)
+}
+
+func (s *executionTestSuite) TestTwapLostResponse() {
+	s.placer.lostErr = errors.New("connection reset")
+	x, err := NewExecutor(s.placer).NewTwapService().
+		Symbol("BTCUSDT").
+		Side(binance.SideTypeBuy).
+		Quantity("1").
+		Duration(10 * time.Millisecond).
+		Slices(2).
+		Do(context.Background())
+	s.Require().NoError(err)
+
+	// the children placed are found by their client order id
+	p := s.wait(x)
+	r := s.Require()
+	r.Equal(StatusTypeFinished, p.Status)
+	r.NoError(p.Err)
+	r.Equal("1", p.ExecutedQuantity)
+	r.Equal("100", p.AvgPrice)
+	r.Len(s.placer.placedOrders(), 2)
+}
+
+func (s *executionTestSuite) TestTwapChildUnknown() {
+	s.placer.placeErr = errors.New("connection reset")
+	x, err := NewExecutor(s.placer).NewTwapService().
+		Symbol("BTCUSDT").
+		Side(binance.SideTypeBuy).
+		Quantity("1").
+		Duration(10 * time.Millisecond).
+		Slices(5).
+		MaxChildErrors(5).
+		Do(context.Background())
+	s.Require().NoError(err)
+
+	// a child failed without rejection and not found stops the execution
+	p := s.wait(x)
+	r := s.Require()
+	r.Equal(StatusTypeFailed, p.Status)
+	r.ErrorIs(p.Err, ErrChildOrderUnknown)
+	r.ErrorContains(p.Err, "connection reset")
+}
+
+func (s *executionTestSuite) TestPov() {
+	var handler binance.WsAggTradeHandler
+	ready := make(chan struct{})
+	serve := func(symbol string, h binance.WsAggTradeHandler, errHandler binance.ErrHandler) (doneC, stopC chan struct{}, err error) {
+		s.Equal("BTCUSDT", symbol)
+		handler = h
+		close(ready)
+		return make(chan struct{}), make(chan struct{}), nil
+	}
+	x, err := NewExecutor(s.placer).AggTradeServe(serve).NewPovService().
+		Symbol("BTCUSDT").
+		Side(binance.SideTypeBuy).
+		Quantity("3").
+		LimitPrice("101").
+		ParticipationRate("0.1").
+		Interval(5 * time.Millisecond).
+		Do(context.Background())
+	s.Require().NoError(err)
+
+	<-ready
+	now := time.Now().UnixMilli() + 1
+	// a trade above the limit price is not counted
+	handler(&binance.WsAggTradeEvent{Price: "102", Quantity: "100", TradeTime: now})
+	handler(&binance.WsAggTradeEvent{Price: "100", Quantity: "10", TradeTime: now})
+	time.Sleep(30 * time.Millisecond)
+	s.Equal("1", x.Progress().ExecutedQuantity)
+	// the fill of the child order is not counted as market volume
+	handler(&binance.WsAggTradeEvent{Price: "100", Quantity: "1", TradeTime: now})
+	time.Sleep(30 * time.Millisecond)
+	s.Equal("1", x.Progress().ExecutedQuantity)
+	handler(&binance.WsAggTradeEvent{Price: "100.5", Quantity: "40", TradeTime: now})
+
+	p := s.wait(x)
+	r := s.Require()
+	r.Equal(StatusTypeFinished, p.Status)
+	r.Equal("3", p.ExecutedQuantity)
+	placed := s.placer.placedOrders()
+	r.Len(placed, 2)
+	r.Equal("2", placed[1].Quantity)
+	r.Equal("101", placed[1].Price)
+}
+
+func (s *executionTestSuite) TestPovInvalidRate() {
+	_, err := NewExecutor(s.placer).NewPovService().
+		Symbol("BTCUSDT").
+		Side(binance.SideTypeBuy).
+		Quantity("1").
+		ParticipationRate("1.5").
+		Do(context.Background())
+	s.Require().ErrorIs(err, ErrInvalidParticipationRate)
+}
+
+func (s *executionTestSuite) TestIceberg() {
+	var handler binance.WsBookTickerHandler
+	ready := make(chan struct{})
+	serve := func(symbol string, h binance.WsBookTickerHandler, errHandler binance.ErrHandler) (doneC, stopC chan struct{}, err error) {
+		handler = h
+		close(ready)
+		return make(chan struct{}), make(chan struct{}), nil
+	}
+	x, err := NewExecutor(s.placer).BookTickerServe(serve).NewIcebergService().
+		Symbol("BTCUSDT").
+		Side(binance.SideTypeBuy).
+		Quantity("2").
+		DisplayQuantity("1").
+		LimitPrice("100.3").
+		TickSize("0.1").
+		PegOffset(1).
+		PollInterval(5 * time.Millisecond).
+		Do(context.Background())
+	s.Require().NoError(err)
+	<-ready
+
+	r := s.Require()
+	handler(&binance.WsBookTickerEvent{BestBidPrice: "100", BestAskPrice: "100.5"})
+	r.Eventually(func() bool { return len(s.placer.openOrders()) == 1 }, time.Second, time.Millisecond)
+	r.Equal("99.9", s.placer.openOrders()[0].Price)
+
+	// the bid moves up, the child is replaced but never above the limit price
+	handler(&binance.WsBookTickerEvent{BestBidPrice: "100.6", BestAskPrice: "100.7"})
+	r.Eventually(func() bool {
+		open := s.placer.openOrders()
+		return len(open) == 1 && open[0].Price == "100.3"
+	}, time.Second, time.Millisecond)
+
+	s.placer.fillOpen(s.placer.openOrders()[0].ClientOrderID)
+	r.Eventually(func() bool { return x.Progress().ExecutedQuantity == "1" }, time.Second, time.Millisecond)
+	r.Eventually(func() bool { return len(s.placer.openOrders()) == 1 }, time.Second, time.Millisecond)
+
+	x.Cancel()
+	p := s.wait(x)
+	r.Equal(StatusTypeCancelled, p.Status)
+	r.Equal("1", p.ExecutedQuantity)
+	r.Equal("100.3", p.AvgPrice)
+	r.Empty(s.placer.openOrders())
+	r.Len(s.placer.canceled, 2)
+}
+
+func (s *executionTestSuite) TestIcebergCancelPlacing() {
+	var handler binance.WsBookTickerHandler
+	ready := make(chan struct{})
+	serve := func(symbol string, h binance.WsBookTickerHandler, errHandler binance.ErrHandler) (doneC, stopC chan struct{}, err error) {
+		handler = h
+		close(ready)
+		return make(chan struct{}), make(chan struct{}), nil
+	}
+	s.placer.hold = true
+	s.placer.queryErr = errors.New("connection reset")
+	x, err := NewExecutor(s.placer).BookTickerServe(serve).NewIcebergService().
+		Symbol("BTCUSDT").
+		Side(binance.SideTypeBuy).
+		Quantity("2").
+		DisplayQuantity("1").
+		Do(context.Background())
+	s.Require().NoError(err)
+	<-ready
+
+	r := s.Require()
+	handler(&binance.WsBookTickerEvent{BestBidPrice: "100", BestAskPrice: "100.5"})
+	r.Eventually(func() bool { return len(s.placer.openOrders()) == 1 }, time.Second, time.Millisecond)
+	id := s.placer.openOrders()[0].ClientOrderID
+
+	// the child rested while its placement was cancelled is not orphaned
+	x.Cancel()
+	p := s.wait(x)
+	r.Equal(StatusTypeCancelled, p.Status)
+	r.Empty(s.placer.openOrders())
+	r.Equal([]string{id}, s.placer.canceled)
+	r.Equal("0", p.ExecutedQuantity)
+}
+
+func (s *executionTestSuite) TestIcebergMarketDataClosed() {
+	doneC := make(chan struct{})
+	serve := func(symbol string, h binance.WsBookTickerHandler, errHandler binance.ErrHandler) (chan struct{}, chan struct{}, error) {
+		return doneC, make(chan struct{}), nil
+	}
+	x, err := NewExecutor(s.placer).BookTickerServe(serve).NewIcebergService().
+		Symbol("BTCUSDT").
+		Side(binance.SideTypeSell).
+		Quantity("2").
+		DisplayQuantity("1").
+		Do(context.Background())
+	s.Require().NoError(err)
+	close(doneC)
+
+	p := s.wait(x)
+	s.Require().Equal(StatusTypeFailed, p.Status)
+	s.Require().ErrorIs(p.Err, ErrMarketDataClosed)
+}
+
+func (s *executionTestSuite) TestValidate() {
+	e := NewExecutor(s.placer)
+	_, err := e.NewTwapService().Side(binance.SideTypeBuy).Quantity("1").Duration(time.Second).Slices(1).Do(context.Background())
+	s.Require().ErrorIs(err, ErrInvalidSymbol)
+	_, err = e.NewTwapService().Symbol("BTCUSDT").Quantity("1").Duration(time.Second).Slices(1).Do(context.Background())
+	s.Require().ErrorIs(err, ErrInvalidSide)
+	_, err = e.NewTwapService().Symbol("BTCUSDT").Side(binance.SideTypeBuy).Quantity("0").Duration(time.Second).Slices(1).Do(context.Background())
+	s.Require().ErrorIs(err, ErrInvalidQuantity)
+	_, err = e.NewTwapService().Symbol("BTCUSDT").Side(binance.SideTypeBuy).Quantity("1").Do(context.Background())
+	s.Require().ErrorIs(err, ErrInvalidDuration)
+	_, err = e.NewIcebergService().Symbol("BTCUSDT").Side(binance.SideTypeBuy).Quantity("1").Do(context.Background())
+	s.Require().ErrorIs(err, ErrInvalidDisplayQuantity)
+}
+
+func (s *executionTestSuite) TestWsOrderPlacerContext() {
+	p := NewWsOrderPlacer(nil, nil, nil)
+	ctx, cancel := context.WithCancel(context.Background())
+	startedC, releaseC := make(chan struct{}), make(chan struct{})
+	errC := make(chan error, 1)
+	go func() {
+		errC <- p.do(ctx, func() error {
+			close(startedC)
+			<-releaseC
+			return nil
+		})
+	}()
+	<-startedC
+	cancel()
+
+	// a request waiting for its turn stops with its context
+	waitCtx, waitCancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer waitCancel()
+	s.Require().ErrorIs(p.do(waitCtx, func() error {
+		return errors.New("request sent")
+	}), context.DeadlineExceeded)
+
+	// a request sent waits for its response despite its context
+	select {
+	case err := <-errC:
+		s.FailNow("request abandoned", "%v", err)
+	case <-time.After(10 * time.Millisecond):
+	}
+	close(releaseC)
+	select {
+	case err := <-errC:
+		s.Require().NoError(err)
+	case <-time.After(5 * time.Second):
+		s.FailNow("response not returned")
+	}
+
+	// a request whose context is done is not sent
+	s.ErrorIs(p.do(ctx, func() error { return errors.New("request sent") }), context.Canceled)
+}
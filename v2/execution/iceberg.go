@@ -0,0 +1,277 @@
+package execution
+
+import (
+	"context"
+	"time"
+
+	"github.com/shopspring/decimal"
+
+	"github.com/adshao/go-binance/v2"
+)
+
+// IcebergService works a parent order passively, showing at most the display quantity at
+// a time. Each child is a LIMIT_MAKER order pegged to the top of book reported by the
+// book ticker stream; when the peg price moves, the child is cancelled and replaced at
+// the new price. Child prices never cross the parent limit price.
+type IcebergService struct {
+	e *Executor
+	parentOrder
+	displayQuantity string
+	pegOffset       int
+	duration        time.Duration
+	pollInterval    time.Duration
+}
+
+// Symbol set symbol
+func (s *IcebergService) Symbol(symbol string) *IcebergService {
+	s.symbol = symbol
+	return s
+}
+
+// Side set side
+func (s *IcebergService) Side(side binance.SideType) *IcebergService {
+	s.side = side
+	return s
+}
+
+// Quantity set total quantity of the parent order
+func (s *IcebergService) Quantity(quantity string) *IcebergService {
+	s.quantity = quantity
+	return s
+}
+
+// LimitPrice set the worst price accepted for any child order, the peg price is clamped to it
+func (s *IcebergService) LimitPrice(limitPrice string) *IcebergService {
+	s.limitPrice = &limitPrice
+	return s
+}
+
+// StepSize set the LOT_SIZE step size child quantities are truncated to
+func (s *IcebergService) StepSize(stepSize string) *IcebergService {
+	s.stepSize = &stepSize
+	return s
+}
+
+// TickSize set the PRICE_FILTER tick size, required for a non-zero peg offset
+func (s *IcebergService) TickSize(tickSize string) *IcebergService {
+	s.tickSize = &tickSize
+	return s
+}
+
+// MinQuantity set the minimum child quantity
+func (s *IcebergService) MinQuantity(minQuantity string) *IcebergService {
+	s.minQuantity = &minQuantity
+	return s
+}
+
+// MaxChildErrors set the number of consecutive child order errors tolerated
+func (s *IcebergService) MaxChildErrors(maxErrors int) *IcebergService {
+	s.maxErrors = maxErrors
+	return s
+}
+
+// OnProgress set progress handler
+func (s *IcebergService) OnProgress(handler ProgressHandler) *IcebergService {
+	s.onProgress = handler
+	return s
+}
+
+// DisplayQuantity set the quantity of each visible child order
+func (s *IcebergService) DisplayQuantity(displayQuantity string) *IcebergService {
+	s.displayQuantity = displayQuantity
+	return s
+}
+
+// PegOffset set the distance in ticks behind the top of book of the child price,
+// 0 joins the best bid (buy) or the best ask (sell)
+func (s *IcebergService) PegOffset(ticks int) *IcebergService {
+	s.pegOffset = ticks
+	return s
+}
+
+// Duration set the maximum execution horizon, the execution expires afterwards.
+// By default the execution runs until filled or cancelled.
+func (s *IcebergService) Duration(duration time.Duration) *IcebergService {
+	s.duration = duration
+	return s
+}
+
+// PollInterval set the interval used to query the resting child order for fills
+func (s *IcebergService) PollInterval(pollInterval time.Duration) *IcebergService {
+	s.pollInterval = pollInterval
+	return s
+}
+
+// restingChild define the child order currently working on the book
+type restingChild struct {
+	res   *ChildOrderResult
+	price decimal.Decimal
+	qty   decimal.Decimal
+	quote decimal.Decimal
+}
+
+// Do start the execution
+func (s *IcebergService) Do(ctx context.Context) (*Execution, error) {
+	display, err := decimal.NewFromString(s.displayQuantity)
+	if err != nil || !display.IsPositive() {
+		return nil, ErrInvalidDisplayQuantity
+	}
+	pollInterval := s.pollInterval
+	if pollInterval <= 0 {
+		pollInterval = DefaultPollInterval
+	}
+	duration, pegOffset := s.duration, s.pegOffset
+	return s.e.start(ctx, AlgoTypeIceberg, s.parentOrder, func(ctx context.Context, r *run) (err error) {
+		quoteC := make(chan *binance.WsBookTickerEvent, 1)
+		doneC, stopC, err := s.e.bookTickerServe(r.symbol, func(event *binance.WsBookTickerEvent) {
+			// keep only the latest quote
+			for {
+				select {
+				case quoteC <- event:
+					return
+				default:
+				}
+				select {
+				case <-quoteC:
+				default:
+				}
+			}
+		}, s.e.errHandler)
+		if err != nil {
+			return err
+		}
+		defer close(stopC)
+
+		var child *restingChild
+		defer func() {
+			if child != nil {
+				if e := r.settle(child, true); err == nil && e != nil {
+					err = e
+				}
+			}
+		}()
+
+		var deadline <-chan time.Time
+		if duration > 0 {
+			t := time.NewTimer(duration)
+			defer t.Stop()
+			deadline = t.C
+		}
+		poll := time.NewTicker(pollInterval)
+		defer poll.Stop()
+
+		var peg *decimal.Decimal
+		for {
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-deadline:
+				return nil
+			case <-doneC:
+				return ErrMarketDataClosed
+			case event := <-quoteC:
+				p, ok := r.pegPrice(event, pegOffset)
+				if !ok {
+					continue
+				}
+				peg = &p
+				if child != nil && !child.price.Equal(p) {
+					if err := r.settle(child, true); err != nil {
+						return err
+					}
+					if child.res.IsFinal() {
+						child = nil
+					}
+				}
+			case <-poll.C:
+				if child != nil {
+					if err := r.settle(child, false); err != nil {
+						return err
+					}
+					if child.res.IsFinal() {
+						child = nil
+					}
+				}
+			}
+			if !r.remaining().IsPositive() {
+				return nil
+			}
+			if child != nil || peg == nil {
+				continue
+			}
+			q := r.sliceQuantity(decimal.Min(display, r.remaining()))
+			if q.IsZero() {
+				return nil
+			}
+			order := &ChildOrder{
+				Symbol:        r.symbol,
+				Side:          r.side,
+				Type:          binance.OrderTypeLimitMaker,
+				Quantity:      q.String(),
+				Price:         peg.String(),
+				ClientOrderID: r.nextClientOrderID(),
+			}
+			// the child is pending until placed, so that an interrupted placement is
+			// cancelled and settled on exit
+			child = &restingChild{res: &ChildOrderResult{Symbol: r.symbol, ClientOrderID: order.ClientOrderID}, price: *peg}
+			res, err := r.place(ctx, order)
+			if err != nil {
+				return err
+			}
+			child = nil
+			if res != nil && !res.IsFinal() {
+				child = &restingChild{res: res, price: *peg}
+				child.qty, _ = decimal.NewFromString(res.ExecutedQuantity)
+				child.quote, _ = decimal.NewFromString(res.CummulativeQuoteQuantity)
+			}
+			if !r.remaining().IsPositive() {
+				return nil
+			}
+		}
+	})
+}
+
+// pegPrice computes the child price from the top of book
+func (r *run) pegPrice(event *binance.WsBookTickerEvent, offset int) (decimal.Decimal, bool) {
+	price := event.BestBidPrice
+	if r.side == binance.SideTypeSell {
+		price = event.BestAskPrice
+	}
+	p, err := decimal.NewFromString(price)
+	if err != nil || !p.IsPositive() {
+		return decimal.Zero, false
+	}
+	if offset != 0 {
+		shift := r.tick.Mul(decimal.NewFromInt(int64(offset)))
+		if r.side == binance.SideTypeBuy {
+			p = p.Sub(shift)
+		} else {
+			p = p.Add(shift)
+		}
+	}
+	p = r.roundPrice(r.guardPrice(p))
+	return p, p.IsPositive()
+}
+
+// settle refreshes the state of a resting child order, cancelling it first if cancel is set,
+// and accounts for the fills since the last refresh
+func (r *run) settle(child *restingChild, cancel bool) error {
+	var res *ChildOrderResult
+	var err error
+	if cancel {
+		res, err = r.cancelChild(child.res)
+	}
+	if !cancel || err != nil {
+		// the order may be already filled when the cancel failed, query its final state
+		res, err = r.queryChild(child.res.ClientOrderID)
+	}
+	if err != nil {
+		return r.childError(err)
+	}
+	r.childErrors = 0
+	r.fill(res, child.qty, child.quote)
+	child.res = res
+	child.qty, _ = decimal.NewFromString(res.ExecutedQuantity)
+	child.quote, _ = decimal.NewFromString(res.CummulativeQuoteQuantity)
+	return nil
+}
@@ -0,0 +1,238 @@
+package execution
+
+import (
+	"context"
+
+	"github.com/adshao/go-binance/v2"
+	"github.com/adshao/go-binance/v2/common"
+)
+
+// ChildOrder define a child order sliced from a parent order
+type ChildOrder struct {
+	Symbol        string
+	Side          binance.SideType
+	Type          binance.OrderType
+	TimeInForce   binance.TimeInForceType
+	Quantity      string
+	Price         string
+	ClientOrderID string
+}
+
+// ChildOrderResult define the exchange state of a child order
+type ChildOrderResult struct {
+	Symbol                   string
+	OrderID                  int64
+	ClientOrderID            string
+	Price                    string
+	OrigQuantity             string
+	ExecutedQuantity         string
+	CummulativeQuoteQuantity string
+	Status                   binance.OrderStatusType
+}
+
+// IsFinal reports whether the child order can no longer be filled
+func (r *ChildOrderResult) IsFinal() bool {
+	switch r.Status {
+	case binance.OrderStatusTypeNew, binance.OrderStatusTypePartiallyFilled, binance.OrderStatusTypePendingCancel:
+		return false
+	}
+	return true
+}
+
+// OrderPlacer places, cancels and queries child orders.
+// Implementations are provided for the REST API and the websocket API.
+type OrderPlacer interface {
+	PlaceOrder(ctx context.Context, order *ChildOrder) (*ChildOrderResult, error)
+	CancelOrder(ctx context.Context, symbol, clientOrderID string) (*ChildOrderResult, error)
+	QueryOrder(ctx context.Context, symbol, clientOrderID string) (*ChildOrderResult, error)
+}
+
+// RestOrderPlacer routes child orders through CreateOrderService, CancelOrderService and GetOrderService
+type RestOrderPlacer struct {
+	c *binance.Client
+}
+
+// NewRestOrderPlacer init RestOrderPlacer
+func NewRestOrderPlacer(c *binance.Client) *RestOrderPlacer {
+	return &RestOrderPlacer{c: c}
+}
+
+// PlaceOrder send child order with a FULL response
+func (p *RestOrderPlacer) PlaceOrder(ctx context.Context, order *ChildOrder) (*ChildOrderResult, error) {
+	s := p.c.NewCreateOrderService().
+		Symbol(order.Symbol).
+		Side(order.Side).
+		Type(order.Type).
+		Quantity(order.Quantity).
+		NewClientOrderID(order.ClientOrderID).
+		NewOrderRespType(binance.NewOrderRespTypeFULL)
+	if order.TimeInForce != "" {
+		s.TimeInForce(order.TimeInForce)
+	}
+	if order.Price != "" {
+		s.Price(order.Price)
+	}
+	res, err := s.Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &ChildOrderResult{
+		Symbol:                   res.Symbol,
+		OrderID:                  res.OrderID,
+		ClientOrderID:            res.ClientOrderID,
+		Price:                    res.Price,
+		OrigQuantity:             res.OrigQuantity,
+		ExecutedQuantity:         res.ExecutedQuantity,
+		CummulativeQuoteQuantity: res.CummulativeQuoteQuantity,
+		Status:                   res.Status,
+	}, nil
+}
+
+// CancelOrder cancel child order by client order id
+func (p *RestOrderPlacer) CancelOrder(ctx context.Context, symbol, clientOrderID string) (*ChildOrderResult, error) {
+	res, err := p.c.NewCancelOrderService().Symbol(symbol).OrigClientOrderID(clientOrderID).Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return cancelOrderResult(res), nil
+}
+
+// QueryOrder get child order by client order id
+func (p *RestOrderPlacer) QueryOrder(ctx context.Context, symbol, clientOrderID string) (*ChildOrderResult, error) {
+	res, err := p.c.NewGetOrderService().Symbol(symbol).OrigClientOrderID(clientOrderID).Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return orderResult(res), nil
+}
+
+// WsOrderPlacer routes child orders through the websocket API 'order.place', 'order.cancel' and 'order.status' methods
+type WsOrderPlacer struct {
+	create *binance.OrderCreateWsService
+	cancel *binance.OrderCancelWsService
+	status *binance.OrderStatusWsService
+
+	// sem serializes the requests
+	sem chan struct{}
+}
+
+// NewWsOrderPlacer init WsOrderPlacer. The services must not be used concurrently elsewhere,
+// since responses are read synchronously. A request whose context is done before it is sent
+// is not sent, a request sent waits for its response or its timeout whatever its context, so
+// that an order placed is always reported.
+func NewWsOrderPlacer(create *binance.OrderCreateWsService, cancel *binance.OrderCancelWsService, status *binance.OrderStatusWsService) *WsOrderPlacer {
+	return &WsOrderPlacer{create: create, cancel: cancel, status: status, sem: make(chan struct{}, 1)}
+}
+
+// PlaceOrder send child order with a FULL response
+func (p *WsOrderPlacer) PlaceOrder(ctx context.Context, order *ChildOrder) (*ChildOrderResult, error) {
+	req := binance.NewOrderCreateWsRequest().
+		Symbol(order.Symbol).
+		Side(order.Side).
+		Type(order.Type).
+		Quantity(order.Quantity).
+		NewClientOrderID(order.ClientOrderID).
+		NewOrderRespType(binance.NewOrderRespTypeFULL)
+	if order.TimeInForce != "" {
+		req.TimeInForce(order.TimeInForce)
+	}
+	if order.Price != "" {
+		req.Price(order.Price)
+	}
+	var res *binance.CreateOrderWsResponse
+	err := p.do(ctx, func() (err error) {
+		res, err = p.create.SyncDo(common.BaseUID(), req)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	if res.Error != nil {
+		return nil, res.Error
+	}
+	return &ChildOrderResult{
+		Symbol:                   res.Result.Symbol,
+		OrderID:                  res.Result.OrderID,
+		ClientOrderID:            res.Result.ClientOrderID,
+		Price:                    res.Result.Price,
+		OrigQuantity:             res.Result.OrigQuantity,
+		ExecutedQuantity:         res.Result.ExecutedQuantity,
+		CummulativeQuoteQuantity: res.Result.CummulativeQuoteQuantity,
+		Status:                   res.Result.Status,
+	}, nil
+}
+
+// CancelOrder cancel child order by client order id
+func (p *WsOrderPlacer) CancelOrder(ctx context.Context, symbol, clientOrderID string) (*ChildOrderResult, error) {
+	req := binance.NewOrderCancelWsRequest().Symbol(symbol).OrigClientOrderID(&clientOrderID)
+	var res *binance.CancelOrderWsResponse
+	err := p.do(ctx, func() (err error) {
+		res, err = p.cancel.SyncDo(common.BaseUID(), req)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	if res.Error != nil {
+		return nil, res.Error
+	}
+	return cancelOrderResult(&res.Result), nil
+}
+
+// QueryOrder get child order by client order id
+func (p *WsOrderPlacer) QueryOrder(ctx context.Context, symbol, clientOrderID string) (*ChildOrderResult, error) {
+	req := binance.NewOrderStatusWsRequest().Symbol(symbol).OrigClientOrderID(&clientOrderID)
+	var res *binance.StatusOrderWsResponse
+	err := p.do(ctx, func() (err error) {
+		res, err = p.status.SyncDo(common.BaseUID(), req)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	if res.Error != nil {
+		return nil, res.Error
+	}
+	return orderResult(&res.Result), nil
+}
+
+// do runs the synchronous request f once the previous requests are done, unless ctx is done
+// first. Once sent, f is not abandoned: its response is awaited until the request timeout.
+func (p *WsOrderPlacer) do(ctx context.Context, f func() error) error {
+	select {
+	case p.sem <- struct{}{}:
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+	defer func() { <-p.sem }()
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	return f()
+}
+
+func cancelOrderResult(res *binance.CancelOrderResponse) *ChildOrderResult {
+	return &ChildOrderResult{
+		Symbol:                   res.Symbol,
+		OrderID:                  res.OrderID,
+		ClientOrderID:            res.OrigClientOrderID,
+		Price:                    res.Price,
+		OrigQuantity:             res.OrigQuantity,
+		ExecutedQuantity:         res.ExecutedQuantity,
+		CummulativeQuoteQuantity: res.CummulativeQuoteQuantity,
+		Status:                   res.Status,
+	}
+}
+
+func orderResult(res *binance.Order) *ChildOrderResult {
+	return &ChildOrderResult{
+		Symbol:                   res.Symbol,
+		OrderID:                  res.OrderID,
+		ClientOrderID:            res.ClientOrderID,
+		Price:                    res.Price,
+		OrigQuantity:             res.OrigQuantity,
+		ExecutedQuantity:         res.ExecutedQuantity,
+		CummulativeQuoteQuantity: res.CummulativeQuoteQuantity,
+		Status:                   res.Status,
+	}
+}
@@ -0,0 +1,197 @@
+package execution
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/shopspring/decimal"
+
+	"github.com/adshao/go-binance/v2"
+)
+
+// DefaultPovInterval is the minimum interval between two volume participation child orders
+var DefaultPovInterval = time.Second
+
+// PovService targets a fixed share of the market volume reported by the aggregate trade
+// stream. Whenever the executed quantity falls behind rate * market volume since the
+// start, a child order for the difference is sent. The market volume excludes the
+// executed quantity, so that the child orders do not drive their own participation.
+// When a limit price is set, only volume traded at or better than the limit price is
+// counted.
+type PovService struct {
+	e *Executor
+	parentOrder
+	rate             string
+	duration         time.Duration
+	interval         time.Duration
+	maxChildQuantity *string
+}
+
+// Symbol set symbol
+func (s *PovService) Symbol(symbol string) *PovService {
+	s.symbol = symbol
+	return s
+}
+
+// Side set side
+func (s *PovService) Side(side binance.SideType) *PovService {
+	s.side = side
+	return s
+}
+
+// Quantity set total quantity of the parent order
+func (s *PovService) Quantity(quantity string) *PovService {
+	s.quantity = quantity
+	return s
+}
+
+// LimitPrice set the worst price accepted for any child order.
+// Child orders are sent as LIMIT IOC at this price instead of MARKET.
+func (s *PovService) LimitPrice(limitPrice string) *PovService {
+	s.limitPrice = &limitPrice
+	return s
+}
+
+// StepSize set the LOT_SIZE step size child quantities are truncated to
+func (s *PovService) StepSize(stepSize string) *PovService {
+	s.stepSize = &stepSize
+	return s
+}
+
+// TickSize set the PRICE_FILTER tick size child prices are rounded to
+func (s *PovService) TickSize(tickSize string) *PovService {
+	s.tickSize = &tickSize
+	return s
+}
+
+// MinQuantity set the minimum child quantity, smaller amounts wait for more volume
+func (s *PovService) MinQuantity(minQuantity string) *PovService {
+	s.minQuantity = &minQuantity
+	return s
+}
+
+// MaxChildErrors set the number of consecutive child order errors tolerated
+func (s *PovService) MaxChildErrors(maxErrors int) *PovService {
+	s.maxErrors = maxErrors
+	return s
+}
+
+// OnProgress set progress handler
+func (s *PovService) OnProgress(handler ProgressHandler) *PovService {
+	s.onProgress = handler
+	return s
+}
+
+// ParticipationRate set the targeted share of market volume, e.g. "0.1" for 10%
+func (s *PovService) ParticipationRate(rate string) *PovService {
+	s.rate = rate
+	return s
+}
+
+// Duration set the maximum execution horizon, the execution expires afterwards.
+// By default the execution runs until filled or cancelled.
+func (s *PovService) Duration(duration time.Duration) *PovService {
+	s.duration = duration
+	return s
+}
+
+// Interval set the minimum interval between two child orders
+func (s *PovService) Interval(interval time.Duration) *PovService {
+	s.interval = interval
+	return s
+}
+
+// MaxChildQuantity set the maximum quantity of a single child order
+func (s *PovService) MaxChildQuantity(maxChildQuantity string) *PovService {
+	s.maxChildQuantity = &maxChildQuantity
+	return s
+}
+
+// Do start the execution
+func (s *PovService) Do(ctx context.Context) (*Execution, error) {
+	rate, err := decimal.NewFromString(s.rate)
+	if err != nil || !rate.IsPositive() || rate.GreaterThan(decimal.NewFromInt(1)) {
+		return nil, ErrInvalidParticipationRate
+	}
+	var maxChild *decimal.Decimal
+	if s.maxChildQuantity != nil {
+		m, err := decimal.NewFromString(*s.maxChildQuantity)
+		if err != nil {
+			return nil, err
+		}
+		maxChild = &m
+	}
+	interval := s.interval
+	if interval <= 0 {
+		interval = DefaultPovInterval
+	}
+	duration := s.duration
+	return s.e.start(ctx, AlgoTypePov, s.parentOrder, func(ctx context.Context, r *run) error {
+		startTime := time.Now().UnixMilli()
+		var mu sync.Mutex
+		volume := decimal.Zero
+		doneC, stopC, err := s.e.aggTradeServe(r.symbol, func(event *binance.WsAggTradeEvent) {
+			if event.TradeTime < startTime {
+				return
+			}
+			price, err := decimal.NewFromString(event.Price)
+			if err != nil {
+				return
+			}
+			if r.limit != nil && !price.Equal(r.guardPrice(price)) {
+				return
+			}
+			q, err := decimal.NewFromString(event.Quantity)
+			if err != nil {
+				return
+			}
+			mu.Lock()
+			volume = volume.Add(q)
+			mu.Unlock()
+		}, s.e.errHandler)
+		if err != nil {
+			return err
+		}
+		defer close(stopC)
+
+		var deadline <-chan time.Time
+		if duration > 0 {
+			t := time.NewTimer(duration)
+			defer t.Stop()
+			deadline = t.C
+		}
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-deadline:
+				return nil
+			case <-doneC:
+				return ErrMarketDataClosed
+			case <-ticker.C:
+			}
+			// The stream reports the fills of the child orders as well, until they are
+			// reported the volume of the others is underestimated, never overestimated
+			mu.Lock()
+			others := volume.Sub(r.executed)
+			mu.Unlock()
+			due := others.Mul(rate).Sub(r.executed)
+			if maxChild != nil && due.GreaterThan(*maxChild) {
+				due = *maxChild
+			}
+			q := r.sliceQuantity(due)
+			if q.IsZero() {
+				continue
+			}
+			if _, err := r.place(ctx, r.newChild(q)); err != nil {
+				return err
+			}
+			if !r.remaining().IsPositive() {
+				return nil
+			}
+		}
+	})
+}
@@ -0,0 +1,119 @@
+package execution
+
+import (
+	"context"
+	"time"
+
+	"github.com/shopspring/decimal"
+
+	"github.com/adshao/go-binance/v2"
+)
+
+// TwapService slices a parent order into equally sized child orders sent at regular
+// intervals over a time horizon. Quantity left unfilled by a slice is spread over the
+// following slices.
+type TwapService struct {
+	e *Executor
+	parentOrder
+	duration time.Duration
+	slices   int
+}
+
+// Symbol set symbol
+func (s *TwapService) Symbol(symbol string) *TwapService {
+	s.symbol = symbol
+	return s
+}
+
+// Side set side
+func (s *TwapService) Side(side binance.SideType) *TwapService {
+	s.side = side
+	return s
+}
+
+// Quantity set total quantity of the parent order
+func (s *TwapService) Quantity(quantity string) *TwapService {
+	s.quantity = quantity
+	return s
+}
+
+// LimitPrice set the worst price accepted for any child order.
+// Child orders are sent as LIMIT IOC at this price instead of MARKET.
+func (s *TwapService) LimitPrice(limitPrice string) *TwapService {
+	s.limitPrice = &limitPrice
+	return s
+}
+
+// StepSize set the LOT_SIZE step size child quantities are truncated to
+func (s *TwapService) StepSize(stepSize string) *TwapService {
+	s.stepSize = &stepSize
+	return s
+}
+
+// TickSize set the PRICE_FILTER tick size child prices are rounded to
+func (s *TwapService) TickSize(tickSize string) *TwapService {
+	s.tickSize = &tickSize
+	return s
+}
+
+// MinQuantity set the minimum child quantity, smaller slices are carried over
+func (s *TwapService) MinQuantity(minQuantity string) *TwapService {
+	s.minQuantity = &minQuantity
+	return s
+}
+
+// MaxChildErrors set the number of consecutive child order errors tolerated
+func (s *TwapService) MaxChildErrors(maxErrors int) *TwapService {
+	s.maxErrors = maxErrors
+	return s
+}
+
+// OnProgress set progress handler
+func (s *TwapService) OnProgress(handler ProgressHandler) *TwapService {
+	s.onProgress = handler
+	return s
+}
+
+// Duration set the execution horizon
+func (s *TwapService) Duration(duration time.Duration) *TwapService {
+	s.duration = duration
+	return s
+}
+
+// Slices set the number of child orders
+func (s *TwapService) Slices(slices int) *TwapService {
+	s.slices = slices
+	return s
+}
+
+// Do start the execution
+func (s *TwapService) Do(ctx context.Context) (*Execution, error) {
+	if s.duration <= 0 || s.slices <= 0 {
+		return nil, ErrInvalidDuration
+	}
+	duration, slices := s.duration, s.slices
+	return s.e.start(ctx, AlgoTypeTwap, s.parentOrder, func(ctx context.Context, r *run) error {
+		start := time.Now()
+		interval := duration / time.Duration(slices)
+		for i := 0; i < slices; i++ {
+			if err := sleep(ctx, time.Until(start.Add(interval*time.Duration(i)))); err != nil {
+				return err
+			}
+			q := r.remaining()
+			if i < slices-1 {
+				q = q.Div(decimal.NewFromInt(int64(slices - i)))
+			}
+			q = r.sliceQuantity(q)
+			if q.IsZero() {
+				continue
+			}
+			if _, err := r.place(ctx, r.newChild(q)); err != nil {
+				return err
+			}
+			if !r.remaining().IsPositive() {
+				return nil
+			}
+		}
+		return nil
+	})
+}
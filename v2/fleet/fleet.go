@@ -0,0 +1,314 @@
+// Package fleet manages the sub-accounts of a master account as a fleet: it enumerates
+// the sub-accounts, snapshots their spot and futures balances and positions concurrently,
+// and executes declarative rebalancing plans as universal transfers.
+//
+//	m := fleet.NewManager(masterClient)
+//	snapshot, err := m.Snapshot(ctx)
+//	report, err := m.Rebalance(ctx, &fleet.Plan{ID: "daily", Targets: targets, DryRun: true})
+package fleet
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/shopspring/decimal"
+
+	"github.com/adshao/go-binance/v2"
+	"github.com/adshao/go-binance/v2/internal/decparse"
+	"github.com/adshao/go-binance/v2/internal/multierr"
+)
+
+// AccountType define the account of a sub-account, as named by universal transfers
+type AccountType string
+
+// Global enums
+const (
+	AccountTypeSpot        AccountType = "SPOT"
+	AccountTypeUSDTFutures AccountType = "USDT_FUTURE"
+	AccountTypeCoinFutures AccountType = "COIN_FUTURE"
+
+	defaultConcurrency = 4
+	maxListLimit       = 200
+
+	futuresTypeUSDT int32 = 1
+	futuresTypeCoin int32 = 2
+)
+
+// Manager manage the sub-accounts of the master account of Client
+type Manager struct {
+	Client *binance.Client
+	// Concurrency is the maximum number of concurrent requests, 4 when not set
+	Concurrency int
+	// AccountTypes are the accounts snapshotted, all when empty
+	AccountTypes []AccountType
+	// Store tracks the executed transfers, in memory when not set
+	Store Store
+
+	now func() time.Time
+}
+
+// NewManager init a manager of the sub-accounts of the master account of c
+func NewManager(c *binance.Client) *Manager {
+	return &Manager{
+		Client: c,
+		Store:  NewMemoryStore(),
+		now:    time.Now,
+	}
+}
+
+// List return all the sub-accounts, requesting every page
+func (m *Manager) List(ctx context.Context) ([]binance.SubAccount, error) {
+	var accounts []binance.SubAccount
+	for page := 1; ; page++ {
+		res, err := m.Client.NewSubAccountListService().Page(page).Limit(maxListLimit).Do(ctx)
+		if err != nil {
+			return nil, err
+		}
+		accounts = append(accounts, res.SubAccounts...)
+		if len(res.SubAccounts) < maxListLimit {
+			return accounts, nil
+		}
+	}
+}
+
+// Balance define the balance of an asset in an account of a sub-account
+type Balance struct {
+	AccountType AccountType
+	Asset       string
+	Free        decimal.Decimal // transferable amount
+	Locked      decimal.Decimal
+	Total       decimal.Decimal // wallet balance for futures accounts
+}
+
+// Position define an open futures position of a sub-account
+type Position struct {
+	AccountType   AccountType
+	Symbol        string
+	Side          string
+	Amount        decimal.Decimal
+	EntryPrice    decimal.Decimal
+	MarkPrice     decimal.Decimal
+	UnrealizedPnL decimal.Decimal
+}
+
+// AccountSnapshot define the balances and positions of a sub-account
+type AccountSnapshot struct {
+	Email     string
+	Balances  []Balance
+	Positions []Position
+	// Errors are the failures by account, e.g. futures not enabled
+	Errors map[AccountType]error
+}
+
+// Balance return the balance of asset in the account
+func (s *AccountSnapshot) Balance(accountType AccountType, asset string) (Balance, bool) {
+	for _, b := range s.Balances {
+		if b.AccountType == accountType && b.Asset == asset {
+			return b, true
+		}
+	}
+	return Balance{}, false
+}
+
+// Snapshot define the balances and positions of the fleet
+type Snapshot struct {
+	Time     time.Time
+	Accounts []*AccountSnapshot // ordered by email
+}
+
+// Account return the snapshot of the sub-account
+func (s *Snapshot) Account(email string) (*AccountSnapshot, bool) {
+	i := sort.Search(len(s.Accounts), func(i int) bool { return s.Accounts[i].Email >= email })
+	if i < len(s.Accounts) && s.Accounts[i].Email == email {
+		return s.Accounts[i], true
+	}
+	return nil, false
+}
+
+// Err return the failures of all the accounts joined
+func (s *Snapshot) Err() error {
+	var errs []error
+	for _, a := range s.Accounts {
+		for _, t := range []AccountType{AccountTypeSpot, AccountTypeUSDTFutures, AccountTypeCoinFutures} {
+			if err, ok := a.Errors[t]; ok {
+				errs = append(errs, fmt.Errorf("fleet: %s %s: %w", a.Email, t, err))
+			}
+		}
+	}
+	return multierr.Join(errs...)
+}
+
+func (m *Manager) accountTypes() []AccountType {
+	if len(m.AccountTypes) == 0 {
+		return []AccountType{AccountTypeSpot, AccountTypeUSDTFutures, AccountTypeCoinFutures}
+	}
+	return m.AccountTypes
+}
+
+// Snapshot snapshot the balances and positions of the sub-accounts, all the ones listed
+// when no email is given. A failure to fetch an account is recorded in its Errors.
+func (m *Manager) Snapshot(ctx context.Context, emails ...string) (*Snapshot, error) {
+	if len(emails) == 0 {
+		accounts, err := m.List(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, a := range accounts {
+			emails = append(emails, a.Email)
+		}
+	}
+	concurrency := m.Concurrency
+	if concurrency <= 0 {
+		concurrency = defaultConcurrency
+	}
+	snapshot := &Snapshot{Time: m.now()}
+	sem := make(chan struct{}, concurrency)
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	for _, email := range emails {
+		account := &AccountSnapshot{Email: email, Errors: map[AccountType]error{}}
+		snapshot.Accounts = append(snapshot.Accounts, account)
+		for _, accountType := range m.accountTypes() {
+			accountType := accountType
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				select {
+				case sem <- struct{}{}:
+				case <-ctx.Done():
+					return
+				}
+				defer func() { <-sem }()
+				balances, positions, err := m.fetch(ctx, account.Email, accountType)
+				mu.Lock()
+				defer mu.Unlock()
+				if err != nil {
+					account.Errors[accountType] = err
+					return
+				}
+				account.Balances = append(account.Balances, balances...)
+				account.Positions = append(account.Positions, positions...)
+			}()
+		}
+	}
+	wg.Wait()
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	for _, a := range snapshot.Accounts {
+		sort.Slice(a.Balances, func(i, j int) bool {
+			if a.Balances[i].AccountType != a.Balances[j].AccountType {
+				return a.Balances[i].AccountType < a.Balances[j].AccountType
+			}
+			return a.Balances[i].Asset < a.Balances[j].Asset
+		})
+		sort.Slice(a.Positions, func(i, j int) bool {
+			if a.Positions[i].AccountType != a.Positions[j].AccountType {
+				return a.Positions[i].AccountType < a.Positions[j].AccountType
+			}
+			return a.Positions[i].Symbol < a.Positions[j].Symbol
+		})
+	}
+	sort.Slice(snapshot.Accounts, func(i, j int) bool { return snapshot.Accounts[i].Email < snapshot.Accounts[j].Email })
+	return snapshot, nil
+}
+
+func (m *Manager) fetch(ctx context.Context, email string, accountType AccountType) ([]Balance, []Position, error) {
+	switch accountType {
+	case AccountTypeSpot:
+		return m.fetchSpot(ctx, email)
+	case AccountTypeUSDTFutures:
+		return m.fetchFutures(ctx, email, accountType, futuresTypeUSDT)
+	case AccountTypeCoinFutures:
+		return m.fetchFutures(ctx, email, accountType, futuresTypeCoin)
+	}
+	return nil, nil, fmt.Errorf("unsupported account type %s", accountType)
+}
+
+func (m *Manager) fetchSpot(ctx context.Context, email string) ([]Balance, []Position, error) {
+	res, err := m.Client.NewSubAccountAssetService().Email(email).Do(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	p := new(decparse.Parser)
+	var balances []Balance
+	for _, b := range res.Balances {
+		free, locked := p.Dec("free", b.Free), p.Dec("locked", b.Locked)
+		if free.IsZero() && locked.IsZero() {
+			continue
+		}
+		balances = append(balances, Balance{
+			AccountType: AccountTypeSpot,
+			Asset:       b.Asset,
+			Free:        free,
+			Locked:      locked,
+			Total:       free.Add(locked),
+		})
+	}
+	return balances, nil, p.Err
+}
+
+func (m *Manager) fetchFutures(ctx context.Context, email string, accountType AccountType, futuresType int32) ([]Balance, []Position, error) {
+	account, err := m.Client.NewSubAccountFuturesAccountV2Service().Email(email).FuturesType(futuresType).Do(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	res, err := m.Client.NewSubAccountFuturesPositionsService().Email(email).FuturesType(futuresType).Do(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	var assets []*binance.FuturesAsset
+	if account.FutureAccountResp != nil {
+		assets = account.FutureAccountResp.Assets
+	}
+	if account.DeliveryAccountResp != nil {
+		assets = account.DeliveryAccountResp.Assets
+	}
+	p := new(decparse.Parser)
+	var balances []Balance
+	for _, a := range assets {
+		b := Balance{
+			AccountType: accountType,
+			Asset:       a.Asset,
+			Free:        p.Dec("maxWithdrawAmount", a.MaxWithdrawAmount),
+			Total:       p.Dec("walletBalance", a.WalletBalance),
+		}
+		if b.Total.IsZero() {
+			continue
+		}
+		b.Locked = b.Total.Sub(b.Free)
+		balances = append(balances, b)
+	}
+	var positions []Position
+	for _, r := range res.FuturePositionRiskVos {
+		positions = append(positions, Position{
+			AccountType:   accountType,
+			Symbol:        r.Symbol,
+			Amount:        p.Dec("positionAmount", r.PositionAmount),
+			EntryPrice:    p.Dec("entryPrice", r.EntryPrice),
+			MarkPrice:     p.Dec("markPrice", r.MarkPrice),
+			UnrealizedPnL: p.Dec("unrealizedProfit", r.UnrealizedProfit),
+		})
+	}
+	for _, r := range res.DeliveryPositionRiskVos {
+		positions = append(positions, Position{
+			AccountType:   accountType,
+			Symbol:        r.Symbol,
+			Side:          r.PositionSide,
+			Amount:        p.Dec("positionAmount", r.PositionAmount),
+			EntryPrice:    p.Dec("entryPrice", r.EntryPrice),
+			MarkPrice:     p.Dec("markPrice", r.MarkPrice),
+			UnrealizedPnL: p.Dec("unrealizedProfit", r.UnrealizedProfit),
+		})
+	}
+	open := positions[:0]
+	for _, pos := range positions {
+		if !pos.Amount.IsZero() {
+			open = append(open, pos)
+		}
+	}
+	return balances, open, p.Err
+}
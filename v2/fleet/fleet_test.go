@@ -0,0 +1,166 @@
+package fleet
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/adshao/go-binance/v2"
+)
+
+// fleetServer serve the sub-account endpoints from per-email responses
+type fleetServer struct {
+	*httptest.Server
+	mu        sync.Mutex
+	requests  []url.Values
+	paths     []string
+	respond   func(path string, params url.Values) (int, string)
+	transfers int64
+}
+
+func newFleetServer(respond func(path string, params url.Values) (int, string)) *fleetServer {
+	s := &fleetServer{respond: respond}
+	s.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_ = r.ParseForm()
+		s.mu.Lock()
+		s.paths = append(s.paths, r.Method+" "+r.URL.Path)
+		s.requests = append(s.requests, r.Form)
+		s.mu.Unlock()
+		code, body := s.respond(r.Method+" "+r.URL.Path, r.Form)
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(code)
+		_, _ = w.Write([]byte(body))
+	}))
+	return s
+}
+
+type fleetTestSuite struct {
+	suite.Suite
+	server *fleetServer
+	m      *Manager
+}
+
+func TestFleet(t *testing.T) {
+	suite.Run(t, new(fleetTestSuite))
+}
+
+func (s *fleetTestSuite) SetupTest() {
+	s.server = newFleetServer(s.respond)
+	c := binance.NewClient("dummy", "dummy")
+	c.BaseURL = s.server.URL
+	s.m = NewManager(c)
+	s.m.now = func() time.Time { return time.Unix(1700000000, 0) }
+}
+
+func (s *fleetTestSuite) TearDownTest() {
+	s.server.Close()
+}
+
+func (s *fleetTestSuite) respond(path string, params url.Values) (int, string) {
+	email := params.Get("email")
+	switch path {
+	case "GET /sapi/v1/sub-account/list":
+		if params.Get("page") == "1" {
+			accounts := make([]string, maxListLimit)
+			for i := range accounts {
+				accounts[i] = fmt.Sprintf(`{"email": "sub%03d@test.com"}`, i)
+			}
+			return http.StatusOK, `{"subAccounts": [` + strings.Join(accounts, ",") + `]}`
+		}
+		return http.StatusOK, `{"subAccounts": [{"email": "a@test.com"}]}`
+	case "GET /sapi/v4/sub-account/assets":
+		if email == "a@test.com" {
+			return http.StatusOK, `{"balances": [
+				{"asset": "USDT", "free": "100", "locked": "10"},
+				{"asset": "BTC", "free": "0", "locked": "0"}
+			]}`
+		}
+		return http.StatusOK, `{"balances": []}`
+	case "GET /sapi/v2/sub-account/futures/account":
+		if email != "a@test.com" {
+			return http.StatusBadRequest, `{"code": -12022, "msg": "futures not enabled"}`
+		}
+		if params.Get("futuresType") == "1" {
+			return http.StatusOK, `{"futureAccountResp": {"email": "a@test.com", "assets": [
+				{"asset": "USDT", "walletBalance": "500", "maxWithdrawAmount": "400"},
+				{"asset": "BUSD", "walletBalance": "0", "maxWithdrawAmount": "0"}
+			]}}`
+		}
+		return http.StatusOK, `{"deliveryAccountResp": {"email": "a@test.com", "assets": [
+			{"asset": "BTC", "walletBalance": "0.5", "maxWithdrawAmount": "0.5"}
+		]}}`
+	case "GET /sapi/v2/sub-account/futures/positionRisk":
+		if params.Get("futuresType") == "1" {
+			return http.StatusOK, `{"futurePositionRiskVos": [
+				{"symbol": "BTCUSDT", "positionAmount": "0.1", "entryPrice": "60000", "markPrice": "61000", "unrealizedProfit": "100"},
+				{"symbol": "ETHUSDT", "positionAmount": "0", "entryPrice": "0", "markPrice": "3000", "unrealizedProfit": "0"}
+			]}`
+		}
+		return http.StatusOK, `{"deliveryPositionRiskVos": [
+			{"symbol": "BTCUSD_PERP", "positionAmount": "-2", "positionSide": "SHORT", "entryPrice": "60000", "markPrice": "61000", "unrealizedProfit": "-0.0001"}
+		]}`
+	}
+	return http.StatusNotFound, `{"code": -1000, "msg": "not found"}`
+}
+
+func (s *fleetTestSuite) dec(v string) decimal.Decimal {
+	return decimal.RequireFromString(v)
+}
+
+func (s *fleetTestSuite) TestList() {
+	accounts, err := s.m.List(context.Background())
+	s.Require().NoError(err)
+	s.Len(accounts, maxListLimit+1)
+	s.Equal("a@test.com", accounts[maxListLimit].Email)
+	s.Require().Len(s.server.requests, 2)
+	s.Equal("200", s.server.requests[0].Get("limit"))
+	s.Equal("2", s.server.requests[1].Get("page"))
+}
+
+func (s *fleetTestSuite) TestSnapshot() {
+	snapshot, err := s.m.Snapshot(context.Background(), "b@test.com", "a@test.com")
+	s.Require().NoError(err)
+	s.Equal(time.Unix(1700000000, 0), snapshot.Time)
+	s.Require().Len(snapshot.Accounts, 2)
+
+	a, ok := snapshot.Account("a@test.com")
+	s.Require().True(ok)
+	s.Empty(a.Errors)
+	s.Equal([]Balance{
+		{AccountType: AccountTypeCoinFutures, Asset: "BTC", Free: s.dec("0.5"), Locked: s.dec("0.5").Sub(s.dec("0.5")), Total: s.dec("0.5")},
+		{AccountType: AccountTypeSpot, Asset: "USDT", Free: s.dec("100"), Locked: s.dec("10"), Total: s.dec("110")},
+		{AccountType: AccountTypeUSDTFutures, Asset: "USDT", Free: s.dec("400"), Locked: s.dec("100"), Total: s.dec("500")},
+	}, a.Balances)
+	s.Equal([]Position{
+		{AccountType: AccountTypeCoinFutures, Symbol: "BTCUSD_PERP", Side: "SHORT", Amount: s.dec("-2"), EntryPrice: s.dec("60000"), MarkPrice: s.dec("61000"), UnrealizedPnL: s.dec("-0.0001")},
+		{AccountType: AccountTypeUSDTFutures, Symbol: "BTCUSDT", Amount: s.dec("0.1"), EntryPrice: s.dec("60000"), MarkPrice: s.dec("61000"), UnrealizedPnL: s.dec("100")},
+	}, a.Positions)
+
+	b, ok := snapshot.Account("b@test.com")
+	s.Require().True(ok)
+	s.Empty(b.Balances)
+	s.Len(b.Errors, 2)
+	s.NotContains(b.Errors, AccountTypeSpot)
+	s.ErrorContains(snapshot.Err(), "fleet: b@test.com USDT_FUTURE")
+	_, ok = snapshot.Account("c@test.com")
+	s.False(ok)
+}
+
+func (s *fleetTestSuite) TestSnapshotAll() {
+	s.m.AccountTypes = []AccountType{AccountTypeSpot}
+	s.m.Concurrency = 2
+	snapshot, err := s.m.Snapshot(context.Background())
+	s.Require().NoError(err)
+	s.Len(snapshot.Accounts, maxListLimit+1)
+	s.NoError(snapshot.Err())
+	s.Len(s.server.paths, 2+maxListLimit+1)
+}
@@ -0,0 +1,384 @@
+package fleet
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/shopspring/decimal"
+
+	"github.com/adshao/go-binance/v2/internal/idempotency"
+	"github.com/adshao/go-binance/v2/internal/multierr"
+)
+
+// TransferStatus define the status of a transfer in a report
+type TransferStatus string
+
+// Global enums
+const (
+	TransferStatusPlanned  TransferStatus = "PLANNED"  // dry run
+	TransferStatusExecuted TransferStatus = "EXECUTED" // transferred by this run
+	TransferStatusSkipped  TransferStatus = "SKIPPED"  // already transferred by a previous run
+	TransferStatusFailed   TransferStatus = "FAILED"
+	TransferStatusAborted  TransferStatus = "ABORTED" // not attempted after a failure
+
+	clientTranIDLength = 32
+)
+
+// ErrInvalidPlan is returned when a plan can not be executed
+var ErrInvalidPlan = errors.New("fleet: invalid plan")
+
+// Target define the balance wanted for an asset in an account of a sub-account
+type Target struct {
+	Email       string
+	AccountType AccountType
+	Asset       string
+	Amount      string
+}
+
+// Plan define a declarative rebalancing of the fleet. Deficits are funded first by the
+// surpluses of other sub-accounts, then by the treasury account of the master account,
+// which receives the remaining surpluses.
+type Plan struct {
+	// ID identifies a rebalancing and scopes the idempotency keys of its transfers, a
+	// re-run with the same ID does not repeat the transfers already executed
+	ID      string
+	Targets []Target
+	// Treasury is the master account funding the deficits, SPOT when not set
+	Treasury AccountType
+	// MinAmount is the smallest transfer executed, smaller differences are ignored
+	MinAmount string
+	DryRun    bool
+}
+
+// Transfer define a universal transfer, an empty email is the master account
+type Transfer struct {
+	ClientTranID    string
+	FromEmail       string
+	FromAccountType AccountType
+	ToEmail         string
+	ToAccountType   AccountType
+	Asset           string
+	Amount          decimal.Decimal
+}
+
+// TransferResult define the outcome of a transfer
+type TransferResult struct {
+	Transfer Transfer
+	Status   TransferStatus
+	TranID   int64
+	Err      error
+}
+
+// Report define the outcome of a rebalancing
+type Report struct {
+	PlanID    string
+	DryRun    bool
+	StartTime time.Time
+	EndTime   time.Time
+	Results   []TransferResult
+}
+
+// Count return the number of transfers with status
+func (r *Report) Count(status TransferStatus) int {
+	n := 0
+	for _, res := range r.Results {
+		if res.Status == status {
+			n++
+		}
+	}
+	return n
+}
+
+// Err return the failures of the transfers joined
+func (r *Report) Err() error {
+	var errs []error
+	for _, res := range r.Results {
+		if res.Err != nil {
+			errs = append(errs, res.Err)
+		}
+	}
+	return multierr.Join(errs...)
+}
+
+// String return a summary of the report
+func (r *Report) String() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "plan %s: %d executed, %d skipped, %d failed, %d aborted, %d planned",
+		r.PlanID,
+		r.Count(TransferStatusExecuted),
+		r.Count(TransferStatusSkipped),
+		r.Count(TransferStatusFailed),
+		r.Count(TransferStatusAborted),
+		r.Count(TransferStatusPlanned),
+	)
+	for _, res := range r.Results {
+		t := res.Transfer
+		fmt.Fprintf(&b, "\n%s %s %s %s:%s -> %s:%s", res.Status, t.Amount, t.Asset,
+			accountName(t.FromEmail), t.FromAccountType, accountName(t.ToEmail), t.ToAccountType)
+		if res.Err != nil {
+			fmt.Fprintf(&b, ": %v", res.Err)
+		}
+	}
+	return b.String()
+}
+
+func accountName(email string) string {
+	if email == "" {
+		return "master"
+	}
+	return email
+}
+
+// Store define the storage of the executed transfers, keyed by client transfer id
+type Store interface {
+	Load(ctx context.Context, clientTranID string) (tranID int64, ok bool, err error)
+	Save(ctx context.Context, clientTranID string, tranID int64) error
+}
+
+// MemoryStore store the executed transfers in memory
+type MemoryStore struct {
+	transfers idempotency.Map[int64]
+}
+
+// NewMemoryStore init an empty memory store
+func NewMemoryStore() *MemoryStore {
+	return new(MemoryStore)
+}
+
+// Load return the transfer id of an executed transfer
+func (s *MemoryStore) Load(ctx context.Context, clientTranID string) (int64, bool, error) {
+	tranID, ok := s.transfers.Load(clientTranID)
+	return tranID, ok, nil
+}
+
+// Save record an executed transfer
+func (s *MemoryStore) Save(ctx context.Context, clientTranID string, tranID int64) error {
+	s.transfers.Store(clientTranID, tranID)
+	return nil
+}
+
+// clientTranID return the idempotency key of a transfer of plan
+func clientTranID(planID string, t Transfer) string {
+	h := sha256.Sum256([]byte(strings.Join([]string{
+		planID, t.FromEmail, string(t.FromAccountType), t.ToEmail, string(t.ToAccountType), t.Asset, t.Amount.String(),
+	}, "|")))
+	return hex.EncodeToString(h[:])[:clientTranIDLength]
+}
+
+// delta define the difference to a target, positive for a deficit
+type delta struct {
+	email       string
+	accountType AccountType
+	amount      decimal.Decimal
+}
+
+// PlanTransfers return the transfers reaching the targets of plan from snapshot
+func (m *Manager) PlanTransfers(snapshot *Snapshot, plan *Plan) ([]Transfer, error) {
+	minAmount := decimal.Zero
+	if plan.MinAmount != "" {
+		var err error
+		if minAmount, err = decimal.NewFromString(plan.MinAmount); err != nil {
+			return nil, fmt.Errorf("%w: min amount %q: %v", ErrInvalidPlan, plan.MinAmount, err)
+		}
+	}
+	treasury := plan.Treasury
+	if treasury == "" {
+		treasury = AccountTypeSpot
+	}
+	surpluses := map[string][]delta{}
+	deficits := map[string][]delta{}
+	seen := map[Target]bool{}
+	for _, t := range plan.Targets {
+		key := Target{Email: t.Email, AccountType: t.AccountType, Asset: t.Asset}
+		if t.Email == "" || t.AccountType == "" || t.Asset == "" || seen[key] {
+			return nil, fmt.Errorf("%w: target %+v", ErrInvalidPlan, t)
+		}
+		seen[key] = true
+		target, err := decimal.NewFromString(t.Amount)
+		if err != nil || target.IsNegative() {
+			return nil, fmt.Errorf("%w: target amount %q", ErrInvalidPlan, t.Amount)
+		}
+		account, ok := snapshot.Account(t.Email)
+		if !ok {
+			return nil, fmt.Errorf("%w: %s not in snapshot", ErrInvalidPlan, t.Email)
+		}
+		if err := account.Errors[t.AccountType]; err != nil {
+			return nil, fmt.Errorf("fleet: %s %s: %w", t.Email, t.AccountType, err)
+		}
+		b, _ := account.Balance(t.AccountType, t.Asset)
+		d := delta{email: t.Email, accountType: t.AccountType, amount: target.Sub(b.Total)}
+		if d.amount.IsNegative() {
+			// only the free balance can be transferred out
+			d.amount = decimal.Min(d.amount.Neg(), b.Free)
+			if d.amount.GreaterThanOrEqual(minAmount) && d.amount.IsPositive() {
+				surpluses[t.Asset] = append(surpluses[t.Asset], d)
+			}
+		} else if d.amount.GreaterThanOrEqual(minAmount) && d.amount.IsPositive() {
+			deficits[t.Asset] = append(deficits[t.Asset], d)
+		}
+	}
+	assets := make([]string, 0, len(seen))
+	for key := range seen {
+		assets = append(assets, key.Asset)
+	}
+	sort.Strings(assets)
+	var transfers, funding []Transfer
+	for i, asset := range assets {
+		if i > 0 && assets[i-1] == asset {
+			continue
+		}
+		out, in := surpluses[asset], deficits[asset]
+		for len(out) > 0 && len(in) > 0 {
+			amount := decimal.Min(out[0].amount, in[0].amount)
+			transfers = append(transfers, Transfer{
+				FromEmail:       out[0].email,
+				FromAccountType: out[0].accountType,
+				ToEmail:         in[0].email,
+				ToAccountType:   in[0].accountType,
+				Asset:           asset,
+				Amount:          amount,
+			})
+			out[0].amount = out[0].amount.Sub(amount)
+			in[0].amount = in[0].amount.Sub(amount)
+			if out[0].amount.IsZero() {
+				out = out[1:]
+			}
+			if in[0].amount.IsZero() {
+				in = in[1:]
+			}
+		}
+		for _, d := range out {
+			transfers = append(transfers, Transfer{
+				FromEmail:       d.email,
+				FromAccountType: d.accountType,
+				ToAccountType:   treasury,
+				Asset:           asset,
+				Amount:          d.amount,
+			})
+		}
+		for _, d := range in {
+			funding = append(funding, Transfer{
+				FromAccountType: treasury,
+				ToEmail:         d.email,
+				ToAccountType:   d.accountType,
+				Asset:           asset,
+				Amount:          d.amount,
+			})
+		}
+	}
+	// surpluses are collected before the treasury funds the deficits
+	transfers = append(transfers, funding...)
+	for i := range transfers {
+		transfers[i].ClientTranID = clientTranID(plan.ID, transfers[i])
+	}
+	return transfers, nil
+}
+
+// Rebalance snapshot the sub-accounts of plan and execute the transfers reaching its
+// targets, in order, stopping at the first failure. Transfers already executed by a
+// previous run of the same plan, as recorded by Store, are skipped.
+func (m *Manager) Rebalance(ctx context.Context, plan *Plan) (*Report, error) {
+	if plan.ID == "" {
+		return nil, fmt.Errorf("%w: no id", ErrInvalidPlan)
+	}
+	var emails []string
+	seen := map[string]bool{}
+	for _, t := range plan.Targets {
+		if !seen[t.Email] {
+			seen[t.Email] = true
+			emails = append(emails, t.Email)
+		}
+	}
+	snapshot, err := m.Snapshot(ctx, emails...)
+	if err != nil {
+		return nil, err
+	}
+	transfers, err := m.PlanTransfers(snapshot, plan)
+	if err != nil {
+		return nil, err
+	}
+	return m.Execute(ctx, plan, transfers), nil
+}
+
+// Execute execute transfers planned for plan, in order, stopping at the first failure
+func (m *Manager) Execute(ctx context.Context, plan *Plan, transfers []Transfer) *Report {
+	report := &Report{PlanID: plan.ID, DryRun: plan.DryRun, StartTime: m.now()}
+	failed := false
+	for _, t := range transfers {
+		res := TransferResult{Transfer: t}
+		switch {
+		case plan.DryRun:
+			res.Status = TransferStatusPlanned
+		case failed:
+			res.Status = TransferStatusAborted
+		default:
+			m.execute(ctx, &res)
+			failed = res.Status == TransferStatusFailed
+		}
+		report.Results = append(report.Results, res)
+	}
+	report.EndTime = m.now()
+	return report
+}
+
+func (m *Manager) execute(ctx context.Context, res *TransferResult) {
+	t := res.Transfer
+	tranID, ok, err := m.Store.Load(ctx, t.ClientTranID)
+	if err != nil {
+		res.Status, res.Err = TransferStatusFailed, err
+		return
+	}
+	if ok {
+		res.Status, res.TranID = TransferStatusSkipped, tranID
+		return
+	}
+	s := m.Client.NewSubAccountUniversalTransferService().
+		FromAccountType(string(t.FromAccountType)).
+		ToAccountType(string(t.ToAccountType)).
+		ClientTranId(t.ClientTranID).
+		Asset(t.Asset).
+		Amount(t.Amount.String())
+	if t.FromEmail != "" {
+		s.FromEmail(t.FromEmail)
+	}
+	if t.ToEmail != "" {
+		s.ToEmail(t.ToEmail)
+	}
+	transfer, err := s.Do(ctx)
+	if err != nil {
+		if idempotency.Rejected(err) {
+			res.Status, res.Err = TransferStatusFailed, err
+			return
+		}
+		if tranID, ok = m.lookup(ctx, t); !ok {
+			res.Status, res.Err = TransferStatusFailed, err
+			return
+		}
+	} else {
+		tranID = transfer.TranId
+	}
+	res.Status, res.TranID = TransferStatusExecuted, tranID
+	if err := m.Store.Save(ctx, t.ClientTranID, tranID); err != nil {
+		res.Err = err
+	}
+}
+
+// lookup return the transfer id of a transfer found in the transfer history
+func (m *Manager) lookup(ctx context.Context, t Transfer) (int64, bool) {
+	res, err := m.Client.NewSubAccUniversalTransferHistoryService().ClientTranId(t.ClientTranID).Do(ctx)
+	if err != nil {
+		return 0, false
+	}
+	for _, r := range res.Result {
+		if r.ClientTranId == t.ClientTranID {
+			return r.TranId, true
+		}
+	}
+	return 0, false
+}
@@ -0,0 +1,248 @@
+package fleet
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/adshao/go-binance/v2"
+)
+
+type rebalanceTestSuite struct {
+	suite.Suite
+	server *fleetServer
+	m      *Manager
+	plan   *Plan
+
+	mu        sync.Mutex
+	transfers []url.Values
+	history   []string
+	fail      map[string]func() (int, string) // transfer responses by amount
+}
+
+func TestRebalance(t *testing.T) {
+	suite.Run(t, new(rebalanceTestSuite))
+}
+
+func (s *rebalanceTestSuite) SetupTest() {
+	s.transfers = nil
+	s.history = nil
+	s.fail = map[string]func() (int, string){}
+	s.server = newFleetServer(s.respond)
+	c := binance.NewClient("dummy", "dummy")
+	c.BaseURL = s.server.URL
+	s.m = NewManager(c)
+	s.m.AccountTypes = []AccountType{AccountTypeSpot, AccountTypeUSDTFutures}
+	s.m.now = func() time.Time { return time.Unix(1700000000, 0) }
+	s.plan = &Plan{
+		ID: "daily-20231114",
+		Targets: []Target{
+			{Email: "a@test.com", AccountType: AccountTypeSpot, Asset: "USDT", Amount: "50"},
+			{Email: "b@test.com", AccountType: AccountTypeSpot, Asset: "USDT", Amount: "100"},
+			{Email: "a@test.com", AccountType: AccountTypeUSDTFutures, Asset: "USDT", Amount: "300"},
+			{Email: "b@test.com", AccountType: AccountTypeSpot, Asset: "BTC", Amount: "0.1"},
+			{Email: "a@test.com", AccountType: AccountTypeSpot, Asset: "BNB", Amount: "1.005"},
+		},
+		MinAmount: "0.01",
+	}
+}
+
+func (s *rebalanceTestSuite) TearDownTest() {
+	s.server.Close()
+}
+
+func (s *rebalanceTestSuite) respond(path string, params url.Values) (int, string) {
+	switch path {
+	case "GET /sapi/v4/sub-account/assets":
+		if params.Get("email") == "a@test.com" {
+			return http.StatusOK, `{"balances": [
+				{"asset": "USDT", "free": "100", "locked": "10"},
+				{"asset": "BNB", "free": "1", "locked": "0"}
+			]}`
+		}
+		return http.StatusOK, `{"balances": []}`
+	case "GET /sapi/v2/sub-account/futures/account":
+		if params.Get("email") != "a@test.com" {
+			return http.StatusBadRequest, `{"code": -12022, "msg": "futures not enabled"}`
+		}
+		return http.StatusOK, `{"futureAccountResp": {"assets": [{"asset": "USDT", "walletBalance": "500", "maxWithdrawAmount": "400"}]}}`
+	case "GET /sapi/v2/sub-account/futures/positionRisk":
+		return http.StatusOK, `{"futurePositionRiskVos": []}`
+	case "POST /sapi/v1/sub-account/universalTransfer":
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		if fail, ok := s.fail[params.Get("amount")]; ok {
+			return fail()
+		}
+		s.transfers = append(s.transfers, params)
+		return http.StatusOK, fmt.Sprintf(`{"tranId": %d, "clientTranId": %q}`, len(s.transfers), params.Get("clientTranId"))
+	case "GET /sapi/v1/sub-account/universalTransfer":
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		s.history = append(s.history, params.Get("clientTranId"))
+		for i, t := range s.transfers {
+			if t.Get("clientTranId") == params.Get("clientTranId") {
+				return http.StatusOK, fmt.Sprintf(`{"result": [{"tranId": %d, "clientTranId": %q}], "totalCount": 1}`, i+1, t.Get("clientTranId"))
+			}
+		}
+		return http.StatusOK, `{"result": [], "totalCount": 0}`
+	}
+	return http.StatusNotFound, `{"code": -1000, "msg": "not found"}`
+}
+
+func (s *rebalanceTestSuite) dec(v string) decimal.Decimal {
+	return decimal.RequireFromString(v)
+}
+
+func (s *rebalanceTestSuite) TestPlanTransfers() {
+	snapshot, err := s.m.Snapshot(context.Background(), "a@test.com", "b@test.com")
+	s.Require().NoError(err)
+	transfers, err := s.m.PlanTransfers(snapshot, s.plan)
+	s.Require().NoError(err)
+	s.Require().Len(transfers, 4)
+	ids := map[string]bool{}
+	for i := range transfers {
+		s.Len(transfers[i].ClientTranID, clientTranIDLength)
+		ids[transfers[i].ClientTranID] = true
+		transfers[i].ClientTranID = ""
+	}
+	s.Len(ids, 4)
+	s.Equal([]Transfer{
+		{FromEmail: "a@test.com", FromAccountType: AccountTypeSpot, ToEmail: "b@test.com", ToAccountType: AccountTypeSpot, Asset: "USDT", Amount: s.dec("60")},
+		{FromEmail: "a@test.com", FromAccountType: AccountTypeUSDTFutures, ToEmail: "b@test.com", ToAccountType: AccountTypeSpot, Asset: "USDT", Amount: s.dec("40")},
+		{FromEmail: "a@test.com", FromAccountType: AccountTypeUSDTFutures, ToAccountType: AccountTypeSpot, Asset: "USDT", Amount: s.dec("160")},
+		{FromAccountType: AccountTypeSpot, ToEmail: "b@test.com", ToAccountType: AccountTypeSpot, Asset: "BTC", Amount: s.dec("0.1")},
+	}, transfers)
+}
+
+func (s *rebalanceTestSuite) TestPlanTransfersInvalid() {
+	snapshot, err := s.m.Snapshot(context.Background(), "a@test.com", "b@test.com")
+	s.Require().NoError(err)
+	for _, targets := range [][]Target{
+		{{Email: "a@test.com", AccountType: AccountTypeSpot, Asset: "USDT", Amount: "-1"}},
+		{{Email: "a@test.com", AccountType: AccountTypeSpot, Asset: "USDT", Amount: "x"}},
+		{{Email: "c@test.com", AccountType: AccountTypeSpot, Asset: "USDT", Amount: "1"}},
+		{{AccountType: AccountTypeSpot, Asset: "USDT", Amount: "1"}},
+		{
+			{Email: "a@test.com", AccountType: AccountTypeSpot, Asset: "USDT", Amount: "1"},
+			{Email: "a@test.com", AccountType: AccountTypeSpot, Asset: "USDT", Amount: "2"},
+		},
+	} {
+		_, err := s.m.PlanTransfers(snapshot, &Plan{ID: "x", Targets: targets})
+		s.ErrorIs(err, ErrInvalidPlan)
+	}
+	// the account failed in the snapshot
+	_, err = s.m.PlanTransfers(snapshot, &Plan{ID: "x", Targets: []Target{
+		{Email: "b@test.com", AccountType: AccountTypeUSDTFutures, Asset: "USDT", Amount: "1"},
+	}})
+	s.ErrorContains(err, "futures not enabled")
+}
+
+func (s *rebalanceTestSuite) TestRebalanceDryRun() {
+	s.plan.DryRun = true
+	report, err := s.m.Rebalance(context.Background(), s.plan)
+	s.Require().NoError(err)
+	s.True(report.DryRun)
+	s.Equal(4, report.Count(TransferStatusPlanned))
+	s.Empty(s.transfers)
+	s.NoError(report.Err())
+}
+
+func (s *rebalanceTestSuite) TestRebalance() {
+	report, err := s.m.Rebalance(context.Background(), s.plan)
+	s.Require().NoError(err)
+	s.Equal("daily-20231114", report.PlanID)
+	s.Equal(time.Unix(1700000000, 0), report.StartTime)
+	s.Equal(4, report.Count(TransferStatusExecuted))
+	s.NoError(report.Err())
+	s.Require().Len(s.transfers, 4)
+	for i, res := range report.Results {
+		s.Equal(int64(i+1), res.TranID)
+		s.Equal(res.Transfer.ClientTranID, s.transfers[i].Get("clientTranId"))
+	}
+	s.Equal("a@test.com", s.transfers[2].Get("fromEmail"))
+	s.Equal("USDT_FUTURE", s.transfers[2].Get("fromAccountType"))
+	s.False(s.transfers[2].Has("toEmail"))
+	s.Equal("SPOT", s.transfers[2].Get("toAccountType"))
+	s.Equal("160", s.transfers[2].Get("amount"))
+	s.False(s.transfers[3].Has("fromEmail"))
+	s.Equal("b@test.com", s.transfers[3].Get("toEmail"))
+	s.Equal("BTC", s.transfers[3].Get("asset"))
+
+	// the balances are not updated yet, the transfers are not repeated
+	report, err = s.m.Rebalance(context.Background(), s.plan)
+	s.Require().NoError(err)
+	s.Equal(4, report.Count(TransferStatusSkipped))
+	s.Len(s.transfers, 4)
+	s.Contains(report.String(), "plan daily-20231114: 0 executed, 4 skipped")
+}
+
+func (s *rebalanceTestSuite) TestRebalanceFailure() {
+	s.fail["40"] = func() (int, string) {
+		return http.StatusBadRequest, `{"code": -9000, "msg": "insufficient balance"}`
+	}
+	report, err := s.m.Rebalance(context.Background(), s.plan)
+	s.Require().NoError(err)
+	s.Equal([]TransferStatus{TransferStatusExecuted, TransferStatusFailed, TransferStatusAborted, TransferStatusAborted},
+		[]TransferStatus{report.Results[0].Status, report.Results[1].Status, report.Results[2].Status, report.Results[3].Status})
+	s.ErrorContains(report.Err(), "insufficient balance")
+	s.Contains(report.String(), "FAILED 40 USDT a@test.com:USDT_FUTURE -> b@test.com:SPOT: <APIError> code=-9000")
+	s.Empty(s.history)
+
+	delete(s.fail, "40")
+	report, err = s.m.Rebalance(context.Background(), s.plan)
+	s.Require().NoError(err)
+	s.Equal(TransferStatusSkipped, report.Results[0].Status)
+	s.Equal(3, report.Count(TransferStatusExecuted))
+	s.Len(s.transfers, 4)
+}
+
+func (s *rebalanceTestSuite) TestRebalanceUnknownOutcome() {
+	// the transfer is executed but the response is lost
+	s.fail["60"] = func() (int, string) {
+		delete(s.fail, "60")
+		s.transfers = append(s.transfers, url.Values{"clientTranId": {s.transfers0ClientTranID()}})
+		return http.StatusServiceUnavailable, `<html>gateway timeout</html>`
+	}
+	report, err := s.m.Rebalance(context.Background(), s.plan)
+	s.Require().NoError(err)
+	s.Equal(4, report.Count(TransferStatusExecuted))
+	s.Equal(int64(1), report.Results[0].TranID)
+	s.Len(s.history, 1)
+}
+
+func (s *rebalanceTestSuite) TestRebalanceUnknownOutcomeNotFound() {
+	s.fail["60"] = func() (int, string) {
+		return http.StatusBadRequest, `{"code": -1007, "msg": "timeout"}`
+	}
+	report, err := s.m.Rebalance(context.Background(), s.plan)
+	s.Require().NoError(err)
+	s.Equal(TransferStatusFailed, report.Results[0].Status)
+	s.Equal(3, report.Count(TransferStatusAborted))
+	s.Len(s.history, 1)
+}
+
+// transfers0ClientTranID return the client transfer id of the first planned transfer
+func (s *rebalanceTestSuite) transfers0ClientTranID() string {
+	return clientTranID(s.plan.ID, Transfer{
+		FromEmail:       "a@test.com",
+		FromAccountType: AccountTypeSpot,
+		ToEmail:         "b@test.com",
+		ToAccountType:   AccountTypeSpot,
+		Asset:           "USDT",
+		Amount:          s.dec("60"),
+	})
+}
+
+func (s *rebalanceTestSuite) TestRebalanceNoID() {
+	s.plan.ID = ""
+	_, err := s.m.Rebalance(context.Background(), s.plan)
+	s.ErrorIs(err, ErrInvalidPlan)
+}
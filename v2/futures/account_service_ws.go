@@ -14,6 +14,8 @@ type WsAccountService struct {
 	ApiKey     string
 	SecretKey  string
 	KeyType    string
+	Signer     common.Signer       // signs requests instead of SecretKey when set
+	signers    *common.SignerCache // parses SecretKey once
 	TimeOffset int64
 	RecvWindow int64
 }
@@ -39,6 +41,7 @@ func NewWsAccountService(apiKey, secretKey string, recvWindow ...int64) (*WsAcco
 		ApiKey:     apiKey,
 		SecretKey:  secretKey,
 		KeyType:    common.KeyTypeHmac,
+		signers:    new(common.SignerCache),
 		RecvWindow: window,
 	}, nil
 }
@@ -144,7 +147,7 @@ func (s *WsAccountService) buildRequest(requestID string, method websocket.WsApi
 			s.SecretKey,
 			s.TimeOffset,
 			s.KeyType,
-		),
+		).WithSigner(s.Signer).WithSignerCache(s.signers),
 		method,
 		map[string]interface{}{
 			"recvWindow": s.RecvWindow,
@@ -0,0 +1,164 @@
+package futures
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/adshao/go-binance/v2/common"
+	"github.com/adshao/go-binance/v2/common/websocket"
+)
+
+// AccountPositionWsService gets current position information
+type AccountPositionWsService struct {
+	c          websocket.Client
+	ApiKey     string
+	SecretKey  string
+	KeyType    string
+	Signer     common.Signer       // signs requests instead of SecretKey when set
+	signers    *common.SignerCache // parses SecretKey once
+	TimeOffset int64
+}
+
+// NewAccountPositionWsService init AccountPositionWsService
+func NewAccountPositionWsService(apiKey, secretKey string) (*AccountPositionWsService, error) {
+	conn, err := websocket.NewConnection(WsApiInitReadWriteConn, WebsocketKeepalive, WebsocketTimeoutReadWriteConnection)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := websocket.NewClient(conn)
+	if err != nil {
+		return nil, err
+	}
+
+	return &AccountPositionWsService{
+		c:         client,
+		ApiKey:    apiKey,
+		SecretKey: secretKey,
+		KeyType:   common.KeyTypeHmac,
+		signers:   new(common.SignerCache),
+	}, nil
+}
+
+// AccountPositionWsRequest parameters for 'v2/account.position' websocket API
+type AccountPositionWsRequest struct {
+	symbol     *string
+	recvWindow *int64
+}
+
+// NewAccountPositionWsRequest init AccountPositionWsRequest
+func NewAccountPositionWsRequest() *AccountPositionWsRequest {
+	return &AccountPositionWsRequest{}
+}
+
+func (s *AccountPositionWsRequest) GetParams() map[string]interface{} {
+	return s.buildParams()
+}
+
+// buildParams builds params
+func (s *AccountPositionWsRequest) buildParams() params {
+	m := params{}
+	if s.symbol != nil {
+		m["symbol"] = *s.symbol
+	}
+	if s.recvWindow != nil {
+		m["recvWindow"] = *s.recvWindow
+	}
+	return m
+}
+
+// Do - sends 'v2/account.position' request
+func (s *AccountPositionWsService) Do(requestID string, request *AccountPositionWsRequest) error {
+	rawData, err := websocket.CreateRequest(
+		websocket.NewRequestData(
+			requestID,
+			s.ApiKey,
+			s.SecretKey,
+			s.TimeOffset,
+			s.KeyType,
+		).WithSigner(s.Signer).WithSignerCache(s.signers),
+		websocket.AccountPositionFuturesWsApiMethod,
+		request.buildParams(),
+	)
+	if err != nil {
+		return err
+	}
+
+	if err := s.c.Write(requestID, rawData); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// SyncDo - sends 'v2/account.position' request and receives response
+func (s *AccountPositionWsService) SyncDo(requestID string, request *AccountPositionWsRequest) (*AccountPositionWsResponse, error) {
+	rawData, err := websocket.CreateRequest(
+		websocket.NewRequestData(
+			requestID,
+			s.ApiKey,
+			s.SecretKey,
+			s.TimeOffset,
+			s.KeyType,
+		).WithSigner(s.Signer).WithSignerCache(s.signers),
+		websocket.AccountPositionFuturesWsApiMethod,
+		request.buildParams(),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := s.c.WriteSync(requestID, rawData, websocket.WriteSyncWsTimeout)
+	if err != nil {
+		return nil, err
+	}
+
+	accountPositionWsResponse := &AccountPositionWsResponse{}
+	if err := json.Unmarshal(response, accountPositionWsResponse); err != nil {
+		return nil, err
+	}
+
+	return accountPositionWsResponse, nil
+}
+
+// ReceiveAllDataBeforeStop waits until all responses will be received from websocket until timeout expired
+func (s *AccountPositionWsService) ReceiveAllDataBeforeStop(timeout time.Duration) {
+	s.c.Wait(timeout)
+}
+
+// GetReadChannel returns channel with API response data (including API errors)
+func (s *AccountPositionWsService) GetReadChannel() <-chan []byte {
+	return s.c.GetReadChannel()
+}
+
+// GetReadErrorChannel returns channel with errors which are occurred while reading websocket connection
+func (s *AccountPositionWsService) GetReadErrorChannel() <-chan error {
+	return s.c.GetReadErrorChannel()
+}
+
+// GetReconnectCount returns count of reconnect attempts by client
+func (s *AccountPositionWsService) GetReconnectCount() int64 {
+	return s.c.GetReconnectCount()
+}
+
+// Symbol set symbol
+func (s *AccountPositionWsRequest) Symbol(symbol string) *AccountPositionWsRequest {
+	s.symbol = &symbol
+	return s
+}
+
+// RecvWindow set recvWindow
+func (s *AccountPositionWsRequest) RecvWindow(recvWindow int64) *AccountPositionWsRequest {
+	s.recvWindow = &recvWindow
+	return s
+}
+
+// AccountPositionWsResponse define 'v2/account.position' websocket API response
+type AccountPositionWsResponse struct {
+	Id     string            `json:"id"`
+	Status int               `json:"status"`
+	Result []*PositionRiskV3 `json:"result"`
+
+	// error response
+	Error *common.APIError `json:"error,omitempty"`
+}
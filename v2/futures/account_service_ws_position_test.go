@@ -0,0 +1,96 @@
+package futures
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/adshao/go-binance/v2/common/websocket"
+	"github.com/adshao/go-binance/v2/common/websocket/mock"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/suite"
+)
+
+type accountPositionServiceWsTestSuite struct {
+	suite.Suite
+
+	ctrl   *gomock.Controller
+	client *mock.MockClient
+
+	requestID string
+
+	service *AccountPositionWsService
+	request *AccountPositionWsRequest
+}
+
+func (s *accountPositionServiceWsTestSuite) SetupTest() {
+	s.requestID = "e2a85d9f-07a5-4f94-8d5f-789dc3deb098"
+
+	s.ctrl = gomock.NewController(s.T())
+	s.client = mock.NewMockClient(s.ctrl)
+
+	s.service = &AccountPositionWsService{
+		c:         s.client,
+		ApiKey:    "dummyApiKey",
+		SecretKey: "dummySecretKey",
+		KeyType:   "HMAC",
+	}
+
+	s.request = NewAccountPositionWsRequest().Symbol("BTCUSDT")
+}
+
+func (s *accountPositionServiceWsTestSuite) TearDownTest() {
+	s.ctrl.Finish()
+}
+
+func TestAccountPositionWsService(t *testing.T) {
+	suite.Run(t, new(accountPositionServiceWsTestSuite))
+}
+
+func (s *accountPositionServiceWsTestSuite) TestDo() {
+	var data []byte
+	s.client.EXPECT().Write(s.requestID, gomock.Any()).DoAndReturn(func(id string, raw []byte) error {
+		data = raw
+		return nil
+	}).Times(1)
+
+	err := s.service.Do(s.requestID, s.request)
+	s.Require().NoError(err)
+
+	req := websocket.WsApiRequest{}
+	s.Require().NoError(json.Unmarshal(data, &req))
+	s.Equal(s.requestID, req.Id)
+	s.Equal(websocket.WsApiMethodType("v2/account.position"), req.Method)
+	for k, v := range map[string]interface{}{"symbol": "BTCUSDT"} {
+		s.Equal(v, req.Params[k], k)
+	}
+	s.Contains(req.Params, "signature")
+}
+
+func (s *accountPositionServiceWsTestSuite) TestDo_EmptyApiKey() {
+	s.service.ApiKey = ""
+	s.client.EXPECT().Write(gomock.Any(), gomock.Any()).Times(0)
+
+	err := s.service.Do(s.requestID, s.request)
+	s.ErrorIs(err, websocket.ErrorApiKeyIsNotSet)
+}
+
+func (s *accountPositionServiceWsTestSuite) TestSyncDo() {
+	rawResponseData := []byte(fmt.Sprintf(`{"id": "%s", "status": 200, "result": [{"symbol": "BTCUSDT", "positionSide": "BOTH", "positionAmt": "1.000", "entryPrice": "0.00000", "markPrice": "6679.50671178", "unRealizedProfit": "0.00000000", "adl": 2, "updateTime": 1720736417660}]}`, s.requestID))
+	s.client.EXPECT().WriteSync(s.requestID, gomock.Any(), gomock.Any()).Return(rawResponseData, nil).Times(1)
+
+	response, err := s.service.SyncDo(s.requestID, s.request)
+	s.Require().NoError(err)
+	s.Equal(s.requestID, response.Id)
+	s.Require().Len(response.Result, 1)
+	s.Equal("1.000", response.Result[0].PositionAmt)
+	s.Equal(int64(2), response.Result[0].Adl)
+}
+
+func (s *accountPositionServiceWsTestSuite) TestSyncDo_EmptyRequestID() {
+	s.client.EXPECT().WriteSync(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+	response, err := s.service.SyncDo("", s.request)
+	s.Nil(response)
+	s.ErrorIs(err, websocket.ErrorRequestIDNotSet)
+}
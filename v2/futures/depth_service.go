@@ -40,6 +40,11 @@ func (s *DepthService) Do(ctx context.Context, opts ...RequestOption) (res *Dept
 	if err != nil {
 		return nil, err
 	}
+	return parseDepthResponse(data)
+}
+
+// parseDepthResponse decode the order book, the bids and asks are arrays of price and quantity
+func parseDepthResponse(data []byte) (res *DepthResponse, err error) {
 	j, err := newJSON(data)
 	if err != nil {
 		return nil, err
@@ -0,0 +1,81 @@
+package futures
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/adshao/go-binance/v2/common/websocket"
+	"github.com/adshao/go-binance/v2/common/websocket/mock"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/suite"
+)
+
+type depthServiceWsTestSuite struct {
+	suite.Suite
+
+	ctrl   *gomock.Controller
+	client *mock.MockClient
+
+	requestID string
+
+	service *DepthWsService
+	request *DepthWsRequest
+}
+
+func (s *depthServiceWsTestSuite) SetupTest() {
+	s.requestID = "e2a85d9f-07a5-4f94-8d5f-789dc3deb098"
+
+	s.ctrl = gomock.NewController(s.T())
+	s.client = mock.NewMockClient(s.ctrl)
+
+	s.service = &DepthWsService{
+		c: s.client,
+	}
+
+	s.request = NewDepthWsRequest().Symbol("BTCUSDT").Limit(5)
+}
+
+func (s *depthServiceWsTestSuite) TearDownTest() {
+	s.ctrl.Finish()
+}
+
+func TestDepthWsService(t *testing.T) {
+	suite.Run(t, new(depthServiceWsTestSuite))
+}
+
+func (s *depthServiceWsTestSuite) TestDo() {
+	var data []byte
+	s.client.EXPECT().Write(s.requestID, gomock.Any()).DoAndReturn(func(id string, raw []byte) error {
+		data = raw
+		return nil
+	}).Times(1)
+
+	err := s.service.Do(s.requestID, s.request)
+	s.Require().NoError(err)
+
+	req := websocket.WsApiRequest{}
+	s.Require().NoError(json.Unmarshal(data, &req))
+	s.Equal(s.requestID, req.Id)
+	s.Equal(websocket.WsApiMethodType("depth"), req.Method)
+	for k, v := range map[string]interface{}{"symbol": "BTCUSDT", "limit": float64(5)} {
+		s.Equal(v, req.Params[k], k)
+	}
+	s.NotContains(req.Params, "apiKey")
+}
+
+func (s *depthServiceWsTestSuite) TestSyncDo() {
+	rawResponseData := []byte(fmt.Sprintf(`{"id": "%s", "status": 200, "result": {"lastUpdateId": 1027024, "E": 1589436922972, "T": 1589436922959, "bids": [["4.00000000", "431.00000000"]], "asks": [["4.00000200", "12.00000000"]]}}`, s.requestID))
+	s.client.EXPECT().WriteSync(s.requestID, gomock.Any(), gomock.Any()).Return(rawResponseData, nil).Times(1)
+
+	response, err := s.service.SyncDo(s.requestID, s.request)
+	s.Require().NoError(err)
+	s.Equal(s.requestID, response.Id)
+	s.Equal(&DepthResponse{
+		LastUpdateID: 1027024,
+		Time:         1589436922972,
+		TradeTime:    1589436922959,
+		Bids:         []Bid{{Price: "4.00000000", Quantity: "431.00000000"}},
+		Asks:         []Ask{{Price: "4.00000200", Quantity: "12.00000000"}},
+	}, response.Result)
+}
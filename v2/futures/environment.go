@@ -0,0 +1,87 @@
+package futures
+
+// EnvironmentType define the type of an environment
+type EnvironmentType string
+
+// Environment types
+const (
+	EnvironmentTypeProduction EnvironmentType = "PRODUCTION"
+	EnvironmentTypeTestnet    EnvironmentType = "TESTNET"
+	EnvironmentTypeDemo       EnvironmentType = "DEMO"
+	EnvironmentTypeCustom     EnvironmentType = "CUSTOM"
+)
+
+// BaseApiDemoUrl is the REST endpoint of demo trading, whose streams and Websocket API are
+// those of the testnet
+var BaseApiDemoUrl = "https://demo-fapi.binance.com"
+
+// Environment define the endpoints of the REST API, the streams and the Websocket API of an
+// environment. Clients keep their own copy of it, so that clients of different environments
+// can run side by side.
+type Environment struct {
+	Type        EnvironmentType
+	APIURL      string
+	WsURL       string
+	CombinedURL string
+	WsApiURL    string
+}
+
+// ProductionEnvironment returns the production environment
+func ProductionEnvironment() Environment {
+	return Environment{
+		Type:        EnvironmentTypeProduction,
+		APIURL:      BaseApiMainUrl,
+		WsURL:       BaseWsMainUrl,
+		CombinedURL: BaseCombinedMainURL,
+		WsApiURL:    BaseWsApiMainURL,
+	}
+}
+
+// TestnetEnvironment returns the testnet environment
+func TestnetEnvironment() Environment {
+	return Environment{
+		Type:        EnvironmentTypeTestnet,
+		APIURL:      BaseApiTestnetUrl,
+		WsURL:       BaseWsTestnetUrl,
+		CombinedURL: BaseCombinedTestnetURL,
+		WsApiURL:    BaseWsApiTestnetURL,
+	}
+}
+
+// DemoEnvironment returns the demo trading environment
+func DemoEnvironment() Environment {
+	return Environment{
+		Type:        EnvironmentTypeDemo,
+		APIURL:      BaseApiDemoUrl,
+		WsURL:       BaseWsTestnetUrl,
+		CombinedURL: BaseCombinedTestnetURL,
+		WsApiURL:    BaseWsApiTestnetURL,
+	}
+}
+
+// defaultEnvironment returns the environment of NewClient, selected by the deprecated
+// UseTestnet flag, the other constructors and the WsOptions of an Environment never read it
+func defaultEnvironment() Environment {
+	if UseTestnet {
+		return TestnetEnvironment()
+	}
+	return ProductionEnvironment()
+}
+
+// WithAPIURL returns a custom environment with the endpoints of e and the REST API at url,
+// e.g. a regional gateway
+func (e Environment) WithAPIURL(url string) Environment {
+	e.Type = EnvironmentTypeCustom
+	e.APIURL = url
+	return e
+}
+
+// WsOptions returns the websocket options connecting the streams and the Websocket API to
+// the endpoints of e, to be passed to the stream functions
+func (e Environment) WsOptions() WsOptions {
+	return WsOptions{
+		BaseURL:         e.WsURL,
+		CombinedBaseURL: e.CombinedURL,
+		APIURL:          e.WsApiURL,
+	}
+}
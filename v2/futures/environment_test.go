@@ -0,0 +1,36 @@
+package futures
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type environmentTestSuite struct {
+	suite.Suite
+}
+
+func TestEnvironment(t *testing.T) {
+	suite.Run(t, new(environmentTestSuite))
+}
+
+func (s *environmentTestSuite) TestClient() {
+	c := NewClientWithEnvironment("key", "secret", DemoEnvironment())
+	s.Equal(BaseApiDemoUrl, c.BaseURL)
+	s.Equal(EnvironmentTypeDemo, c.Environment.Type)
+
+	c = NewClient("key", "secret")
+	s.Equal(BaseApiMainUrl, c.BaseURL)
+	s.Equal(ProductionEnvironment(), c.Environment)
+
+	custom := ProductionEnvironment().WithAPIURL("https://gateway.example.com")
+	s.Equal(EnvironmentTypeCustom, custom.Type)
+	s.Equal("https://gateway.example.com", NewProxiedClientWithEnvironment("key", "secret", "http://localhost:3128", custom).BaseURL)
+}
+
+func (s *environmentTestSuite) TestWsOptions() {
+	opts := TestnetEnvironment().WsOptions()
+	s.Equal(BaseWsTestnetUrl+"/btcusdt@aggTrade", newWsConfig(getWsEndpoint(opts)+"/btcusdt@aggTrade", opts).Endpoint)
+	s.Equal(BaseCombinedTestnetURL+"btcusdt@aggTrade", newWsConfig(getCombinedEndpoint(opts)+"btcusdt@aggTrade", opts).Endpoint)
+	s.Equal(BaseWsApiTestnetURL, newWsConfig(getWsApiEndpoint(opts), opts).Endpoint)
+}
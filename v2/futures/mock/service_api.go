@@ -0,0 +1,6933 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: service_api.go
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	futures "github.com/adshao/go-binance/v2/futures"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockClientAPI is a mock of ClientAPI interface.
+type MockClientAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockClientAPIMockRecorder
+}
+
+// MockClientAPIMockRecorder is the mock recorder for MockClientAPI.
+type MockClientAPIMockRecorder struct {
+	mock *MockClientAPI
+}
+
+// NewMockClientAPI creates a new mock instance.
+func NewMockClientAPI(ctrl *gomock.Controller) *MockClientAPI {
+	mock := &MockClientAPI{ctrl: ctrl}
+	mock.recorder = &MockClientAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockClientAPI) EXPECT() *MockClientAPIMockRecorder {
+	return m.recorder
+}
+
+// NewAggTradesService mocks base method.
+func (m *MockClientAPI) NewAggTradesService() futures.AggTradesServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewAggTradesService")
+	ret0, _ := ret[0].(futures.AggTradesServiceAPI)
+	return ret0
+}
+
+// NewAggTradesService indicates an expected call of NewAggTradesService.
+func (mr *MockClientAPIMockRecorder) NewAggTradesService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewAggTradesService", reflect.TypeOf((*MockClientAPI)(nil).NewAggTradesService))
+}
+
+// NewApiTradingStatusService mocks base method.
+func (m *MockClientAPI) NewApiTradingStatusService() futures.ApiTradingStatusServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewApiTradingStatusService")
+	ret0, _ := ret[0].(futures.ApiTradingStatusServiceAPI)
+	return ret0
+}
+
+// NewApiTradingStatusService indicates an expected call of NewApiTradingStatusService.
+func (mr *MockClientAPIMockRecorder) NewApiTradingStatusService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewApiTradingStatusService", reflect.TypeOf((*MockClientAPI)(nil).NewApiTradingStatusService))
+}
+
+// NewAssetIndexService mocks base method.
+func (m *MockClientAPI) NewAssetIndexService() futures.AssetIndexServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewAssetIndexService")
+	ret0, _ := ret[0].(futures.AssetIndexServiceAPI)
+	return ret0
+}
+
+// NewAssetIndexService indicates an expected call of NewAssetIndexService.
+func (mr *MockClientAPIMockRecorder) NewAssetIndexService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewAssetIndexService", reflect.TypeOf((*MockClientAPI)(nil).NewAssetIndexService))
+}
+
+// NewBasisService mocks base method.
+func (m *MockClientAPI) NewBasisService() futures.BasisServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewBasisService")
+	ret0, _ := ret[0].(futures.BasisServiceAPI)
+	return ret0
+}
+
+// NewBasisService indicates an expected call of NewBasisService.
+func (mr *MockClientAPIMockRecorder) NewBasisService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewBasisService", reflect.TypeOf((*MockClientAPI)(nil).NewBasisService))
+}
+
+// NewCancelAllOpenOrdersService mocks base method.
+func (m *MockClientAPI) NewCancelAllOpenOrdersService() futures.CancelAllOpenOrdersServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewCancelAllOpenOrdersService")
+	ret0, _ := ret[0].(futures.CancelAllOpenOrdersServiceAPI)
+	return ret0
+}
+
+// NewCancelAllOpenOrdersService indicates an expected call of NewCancelAllOpenOrdersService.
+func (mr *MockClientAPIMockRecorder) NewCancelAllOpenOrdersService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewCancelAllOpenOrdersService", reflect.TypeOf((*MockClientAPI)(nil).NewCancelAllOpenOrdersService))
+}
+
+// NewCancelMultipleOrdersService mocks base method.
+func (m *MockClientAPI) NewCancelMultipleOrdersService() futures.CancelMultiplesOrdersServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewCancelMultipleOrdersService")
+	ret0, _ := ret[0].(futures.CancelMultiplesOrdersServiceAPI)
+	return ret0
+}
+
+// NewCancelMultipleOrdersService indicates an expected call of NewCancelMultipleOrdersService.
+func (mr *MockClientAPIMockRecorder) NewCancelMultipleOrdersService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewCancelMultipleOrdersService", reflect.TypeOf((*MockClientAPI)(nil).NewCancelMultipleOrdersService))
+}
+
+// NewCancelOrderService mocks base method.
+func (m *MockClientAPI) NewCancelOrderService() futures.CancelOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewCancelOrderService")
+	ret0, _ := ret[0].(futures.CancelOrderServiceAPI)
+	return ret0
+}
+
+// NewCancelOrderService indicates an expected call of NewCancelOrderService.
+func (mr *MockClientAPIMockRecorder) NewCancelOrderService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewCancelOrderService", reflect.TypeOf((*MockClientAPI)(nil).NewCancelOrderService))
+}
+
+// NewChangeLeverageService mocks base method.
+func (m *MockClientAPI) NewChangeLeverageService() futures.ChangeLeverageServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewChangeLeverageService")
+	ret0, _ := ret[0].(futures.ChangeLeverageServiceAPI)
+	return ret0
+}
+
+// NewChangeLeverageService indicates an expected call of NewChangeLeverageService.
+func (mr *MockClientAPIMockRecorder) NewChangeLeverageService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewChangeLeverageService", reflect.TypeOf((*MockClientAPI)(nil).NewChangeLeverageService))
+}
+
+// NewChangeMarginTypeService mocks base method.
+func (m *MockClientAPI) NewChangeMarginTypeService() futures.ChangeMarginTypeServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewChangeMarginTypeService")
+	ret0, _ := ret[0].(futures.ChangeMarginTypeServiceAPI)
+	return ret0
+}
+
+// NewChangeMarginTypeService indicates an expected call of NewChangeMarginTypeService.
+func (mr *MockClientAPIMockRecorder) NewChangeMarginTypeService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewChangeMarginTypeService", reflect.TypeOf((*MockClientAPI)(nil).NewChangeMarginTypeService))
+}
+
+// NewChangeMultiAssetModeService mocks base method.
+func (m *MockClientAPI) NewChangeMultiAssetModeService() futures.ChangeMultiAssetModeServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewChangeMultiAssetModeService")
+	ret0, _ := ret[0].(futures.ChangeMultiAssetModeServiceAPI)
+	return ret0
+}
+
+// NewChangeMultiAssetModeService indicates an expected call of NewChangeMultiAssetModeService.
+func (mr *MockClientAPIMockRecorder) NewChangeMultiAssetModeService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewChangeMultiAssetModeService", reflect.TypeOf((*MockClientAPI)(nil).NewChangeMultiAssetModeService))
+}
+
+// NewChangePositionModeService mocks base method.
+func (m *MockClientAPI) NewChangePositionModeService() futures.ChangePositionModeServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewChangePositionModeService")
+	ret0, _ := ret[0].(futures.ChangePositionModeServiceAPI)
+	return ret0
+}
+
+// NewChangePositionModeService indicates an expected call of NewChangePositionModeService.
+func (mr *MockClientAPIMockRecorder) NewChangePositionModeService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewChangePositionModeService", reflect.TypeOf((*MockClientAPI)(nil).NewChangePositionModeService))
+}
+
+// NewCloseUserStreamService mocks base method.
+func (m *MockClientAPI) NewCloseUserStreamService() futures.CloseUserStreamServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewCloseUserStreamService")
+	ret0, _ := ret[0].(futures.CloseUserStreamServiceAPI)
+	return ret0
+}
+
+// NewCloseUserStreamService indicates an expected call of NewCloseUserStreamService.
+func (mr *MockClientAPIMockRecorder) NewCloseUserStreamService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewCloseUserStreamService", reflect.TypeOf((*MockClientAPI)(nil).NewCloseUserStreamService))
+}
+
+// NewCommissionRateService mocks base method.
+func (m *MockClientAPI) NewCommissionRateService() futures.CommissionRateServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewCommissionRateService")
+	ret0, _ := ret[0].(futures.CommissionRateServiceAPI)
+	return ret0
+}
+
+// NewCommissionRateService indicates an expected call of NewCommissionRateService.
+func (mr *MockClientAPIMockRecorder) NewCommissionRateService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewCommissionRateService", reflect.TypeOf((*MockClientAPI)(nil).NewCommissionRateService))
+}
+
+// NewConstituentsService mocks base method.
+func (m *MockClientAPI) NewConstituentsService() futures.ConstituentsServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewConstituentsService")
+	ret0, _ := ret[0].(futures.ConstituentsServiceAPI)
+	return ret0
+}
+
+// NewConstituentsService indicates an expected call of NewConstituentsService.
+func (mr *MockClientAPIMockRecorder) NewConstituentsService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewConstituentsService", reflect.TypeOf((*MockClientAPI)(nil).NewConstituentsService))
+}
+
+// NewContinuousKlinesService mocks base method.
+func (m *MockClientAPI) NewContinuousKlinesService() futures.ContinuousKlinesServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewContinuousKlinesService")
+	ret0, _ := ret[0].(futures.ContinuousKlinesServiceAPI)
+	return ret0
+}
+
+// NewContinuousKlinesService indicates an expected call of NewContinuousKlinesService.
+func (mr *MockClientAPIMockRecorder) NewContinuousKlinesService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewContinuousKlinesService", reflect.TypeOf((*MockClientAPI)(nil).NewContinuousKlinesService))
+}
+
+// NewConvertAcceptService mocks base method.
+func (m *MockClientAPI) NewConvertAcceptService() futures.ConvertAcceptServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewConvertAcceptService")
+	ret0, _ := ret[0].(futures.ConvertAcceptServiceAPI)
+	return ret0
+}
+
+// NewConvertAcceptService indicates an expected call of NewConvertAcceptService.
+func (mr *MockClientAPIMockRecorder) NewConvertAcceptService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewConvertAcceptService", reflect.TypeOf((*MockClientAPI)(nil).NewConvertAcceptService))
+}
+
+// NewCreateBatchOrdersService mocks base method.
+func (m *MockClientAPI) NewCreateBatchOrdersService() futures.CreateBatchOrdersServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewCreateBatchOrdersService")
+	ret0, _ := ret[0].(futures.CreateBatchOrdersServiceAPI)
+	return ret0
+}
+
+// NewCreateBatchOrdersService indicates an expected call of NewCreateBatchOrdersService.
+func (mr *MockClientAPIMockRecorder) NewCreateBatchOrdersService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewCreateBatchOrdersService", reflect.TypeOf((*MockClientAPI)(nil).NewCreateBatchOrdersService))
+}
+
+// NewCreateConvertQuoteService mocks base method.
+func (m *MockClientAPI) NewCreateConvertQuoteService() futures.CreateConvertQuoteServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewCreateConvertQuoteService")
+	ret0, _ := ret[0].(futures.CreateConvertQuoteServiceAPI)
+	return ret0
+}
+
+// NewCreateConvertQuoteService indicates an expected call of NewCreateConvertQuoteService.
+func (mr *MockClientAPIMockRecorder) NewCreateConvertQuoteService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewCreateConvertQuoteService", reflect.TypeOf((*MockClientAPI)(nil).NewCreateConvertQuoteService))
+}
+
+// NewCreateOrderService mocks base method.
+func (m *MockClientAPI) NewCreateOrderService() futures.CreateOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewCreateOrderService")
+	ret0, _ := ret[0].(futures.CreateOrderServiceAPI)
+	return ret0
+}
+
+// NewCreateOrderService indicates an expected call of NewCreateOrderService.
+func (mr *MockClientAPIMockRecorder) NewCreateOrderService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewCreateOrderService", reflect.TypeOf((*MockClientAPI)(nil).NewCreateOrderService))
+}
+
+// NewDeliveryPriceService mocks base method.
+func (m *MockClientAPI) NewDeliveryPriceService() futures.DeliveryPriceServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewDeliveryPriceService")
+	ret0, _ := ret[0].(futures.DeliveryPriceServiceAPI)
+	return ret0
+}
+
+// NewDeliveryPriceService indicates an expected call of NewDeliveryPriceService.
+func (mr *MockClientAPIMockRecorder) NewDeliveryPriceService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewDeliveryPriceService", reflect.TypeOf((*MockClientAPI)(nil).NewDeliveryPriceService))
+}
+
+// NewDepthService mocks base method.
+func (m *MockClientAPI) NewDepthService() futures.DepthServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewDepthService")
+	ret0, _ := ret[0].(futures.DepthServiceAPI)
+	return ret0
+}
+
+// NewDepthService indicates an expected call of NewDepthService.
+func (mr *MockClientAPIMockRecorder) NewDepthService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewDepthService", reflect.TypeOf((*MockClientAPI)(nil).NewDepthService))
+}
+
+// NewExchangeInfoService mocks base method.
+func (m *MockClientAPI) NewExchangeInfoService() futures.ExchangeInfoServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewExchangeInfoService")
+	ret0, _ := ret[0].(futures.ExchangeInfoServiceAPI)
+	return ret0
+}
+
+// NewExchangeInfoService indicates an expected call of NewExchangeInfoService.
+func (mr *MockClientAPIMockRecorder) NewExchangeInfoService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewExchangeInfoService", reflect.TypeOf((*MockClientAPI)(nil).NewExchangeInfoService))
+}
+
+// NewFeeBurnService mocks base method.
+func (m *MockClientAPI) NewFeeBurnService() futures.FeeBurnServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewFeeBurnService")
+	ret0, _ := ret[0].(futures.FeeBurnServiceAPI)
+	return ret0
+}
+
+// NewFeeBurnService indicates an expected call of NewFeeBurnService.
+func (mr *MockClientAPIMockRecorder) NewFeeBurnService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewFeeBurnService", reflect.TypeOf((*MockClientAPI)(nil).NewFeeBurnService))
+}
+
+// NewFundingRateInfoService mocks base method.
+func (m *MockClientAPI) NewFundingRateInfoService() futures.FundingRateInfoServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewFundingRateInfoService")
+	ret0, _ := ret[0].(futures.FundingRateInfoServiceAPI)
+	return ret0
+}
+
+// NewFundingRateInfoService indicates an expected call of NewFundingRateInfoService.
+func (mr *MockClientAPIMockRecorder) NewFundingRateInfoService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewFundingRateInfoService", reflect.TypeOf((*MockClientAPI)(nil).NewFundingRateInfoService))
+}
+
+// NewFundingRateService mocks base method.
+func (m *MockClientAPI) NewFundingRateService() futures.FundingRateServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewFundingRateService")
+	ret0, _ := ret[0].(futures.FundingRateServiceAPI)
+	return ret0
+}
+
+// NewFundingRateService indicates an expected call of NewFundingRateService.
+func (mr *MockClientAPIMockRecorder) NewFundingRateService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewFundingRateService", reflect.TypeOf((*MockClientAPI)(nil).NewFundingRateService))
+}
+
+// NewGetAccountConfigService mocks base method.
+func (m *MockClientAPI) NewGetAccountConfigService() futures.AccountConfigServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewGetAccountConfigService")
+	ret0, _ := ret[0].(futures.AccountConfigServiceAPI)
+	return ret0
+}
+
+// NewGetAccountConfigService indicates an expected call of NewGetAccountConfigService.
+func (mr *MockClientAPIMockRecorder) NewGetAccountConfigService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewGetAccountConfigService", reflect.TypeOf((*MockClientAPI)(nil).NewGetAccountConfigService))
+}
+
+// NewGetAccountService mocks base method.
+func (m *MockClientAPI) NewGetAccountService() futures.GetAccountServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewGetAccountService")
+	ret0, _ := ret[0].(futures.GetAccountServiceAPI)
+	return ret0
+}
+
+// NewGetAccountService indicates an expected call of NewGetAccountService.
+func (mr *MockClientAPIMockRecorder) NewGetAccountService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewGetAccountService", reflect.TypeOf((*MockClientAPI)(nil).NewGetAccountService))
+}
+
+// NewGetAccountV3Service mocks base method.
+func (m *MockClientAPI) NewGetAccountV3Service() futures.GetAccountV3ServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewGetAccountV3Service")
+	ret0, _ := ret[0].(futures.GetAccountV3ServiceAPI)
+	return ret0
+}
+
+// NewGetAccountV3Service indicates an expected call of NewGetAccountV3Service.
+func (mr *MockClientAPIMockRecorder) NewGetAccountV3Service() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewGetAccountV3Service", reflect.TypeOf((*MockClientAPI)(nil).NewGetAccountV3Service))
+}
+
+// NewGetBalanceService mocks base method.
+func (m *MockClientAPI) NewGetBalanceService() futures.GetBalanceServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewGetBalanceService")
+	ret0, _ := ret[0].(futures.GetBalanceServiceAPI)
+	return ret0
+}
+
+// NewGetBalanceService indicates an expected call of NewGetBalanceService.
+func (mr *MockClientAPIMockRecorder) NewGetBalanceService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewGetBalanceService", reflect.TypeOf((*MockClientAPI)(nil).NewGetBalanceService))
+}
+
+// NewGetConvertStatusService mocks base method.
+func (m *MockClientAPI) NewGetConvertStatusService() futures.ConvertStatusServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewGetConvertStatusService")
+	ret0, _ := ret[0].(futures.ConvertStatusServiceAPI)
+	return ret0
+}
+
+// NewGetConvertStatusService indicates an expected call of NewGetConvertStatusService.
+func (mr *MockClientAPIMockRecorder) NewGetConvertStatusService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewGetConvertStatusService", reflect.TypeOf((*MockClientAPI)(nil).NewGetConvertStatusService))
+}
+
+// NewGetFeeBurnService mocks base method.
+func (m *MockClientAPI) NewGetFeeBurnService() futures.GetFeeBurnServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewGetFeeBurnService")
+	ret0, _ := ret[0].(futures.GetFeeBurnServiceAPI)
+	return ret0
+}
+
+// NewGetFeeBurnService indicates an expected call of NewGetFeeBurnService.
+func (mr *MockClientAPIMockRecorder) NewGetFeeBurnService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewGetFeeBurnService", reflect.TypeOf((*MockClientAPI)(nil).NewGetFeeBurnService))
+}
+
+// NewGetIncomeHistoryService mocks base method.
+func (m *MockClientAPI) NewGetIncomeHistoryService() futures.GetIncomeHistoryServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewGetIncomeHistoryService")
+	ret0, _ := ret[0].(futures.GetIncomeHistoryServiceAPI)
+	return ret0
+}
+
+// NewGetIncomeHistoryService indicates an expected call of NewGetIncomeHistoryService.
+func (mr *MockClientAPIMockRecorder) NewGetIncomeHistoryService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewGetIncomeHistoryService", reflect.TypeOf((*MockClientAPI)(nil).NewGetIncomeHistoryService))
+}
+
+// NewGetLeverageBracketService mocks base method.
+func (m *MockClientAPI) NewGetLeverageBracketService() futures.GetLeverageBracketServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewGetLeverageBracketService")
+	ret0, _ := ret[0].(futures.GetLeverageBracketServiceAPI)
+	return ret0
+}
+
+// NewGetLeverageBracketService indicates an expected call of NewGetLeverageBracketService.
+func (mr *MockClientAPIMockRecorder) NewGetLeverageBracketService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewGetLeverageBracketService", reflect.TypeOf((*MockClientAPI)(nil).NewGetLeverageBracketService))
+}
+
+// NewGetMultiAssetModeService mocks base method.
+func (m *MockClientAPI) NewGetMultiAssetModeService() futures.GetMultiAssetModeServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewGetMultiAssetModeService")
+	ret0, _ := ret[0].(futures.GetMultiAssetModeServiceAPI)
+	return ret0
+}
+
+// NewGetMultiAssetModeService indicates an expected call of NewGetMultiAssetModeService.
+func (mr *MockClientAPIMockRecorder) NewGetMultiAssetModeService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewGetMultiAssetModeService", reflect.TypeOf((*MockClientAPI)(nil).NewGetMultiAssetModeService))
+}
+
+// NewGetOpenInterestService mocks base method.
+func (m *MockClientAPI) NewGetOpenInterestService() futures.GetOpenInterestServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewGetOpenInterestService")
+	ret0, _ := ret[0].(futures.GetOpenInterestServiceAPI)
+	return ret0
+}
+
+// NewGetOpenInterestService indicates an expected call of NewGetOpenInterestService.
+func (mr *MockClientAPIMockRecorder) NewGetOpenInterestService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewGetOpenInterestService", reflect.TypeOf((*MockClientAPI)(nil).NewGetOpenInterestService))
+}
+
+// NewGetOpenOrderService mocks base method.
+func (m *MockClientAPI) NewGetOpenOrderService() futures.GetOpenOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewGetOpenOrderService")
+	ret0, _ := ret[0].(futures.GetOpenOrderServiceAPI)
+	return ret0
+}
+
+// NewGetOpenOrderService indicates an expected call of NewGetOpenOrderService.
+func (mr *MockClientAPIMockRecorder) NewGetOpenOrderService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewGetOpenOrderService", reflect.TypeOf((*MockClientAPI)(nil).NewGetOpenOrderService))
+}
+
+// NewGetOrderService mocks base method.
+func (m *MockClientAPI) NewGetOrderService() futures.GetOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewGetOrderService")
+	ret0, _ := ret[0].(futures.GetOrderServiceAPI)
+	return ret0
+}
+
+// NewGetOrderService indicates an expected call of NewGetOrderService.
+func (mr *MockClientAPIMockRecorder) NewGetOrderService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewGetOrderService", reflect.TypeOf((*MockClientAPI)(nil).NewGetOrderService))
+}
+
+// NewGetPositionMarginHistoryService mocks base method.
+func (m *MockClientAPI) NewGetPositionMarginHistoryService() futures.GetPositionMarginHistoryServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewGetPositionMarginHistoryService")
+	ret0, _ := ret[0].(futures.GetPositionMarginHistoryServiceAPI)
+	return ret0
+}
+
+// NewGetPositionMarginHistoryService indicates an expected call of NewGetPositionMarginHistoryService.
+func (mr *MockClientAPIMockRecorder) NewGetPositionMarginHistoryService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewGetPositionMarginHistoryService", reflect.TypeOf((*MockClientAPI)(nil).NewGetPositionMarginHistoryService))
+}
+
+// NewGetPositionModeService mocks base method.
+func (m *MockClientAPI) NewGetPositionModeService() futures.GetPositionModeServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewGetPositionModeService")
+	ret0, _ := ret[0].(futures.GetPositionModeServiceAPI)
+	return ret0
+}
+
+// NewGetPositionModeService indicates an expected call of NewGetPositionModeService.
+func (mr *MockClientAPIMockRecorder) NewGetPositionModeService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewGetPositionModeService", reflect.TypeOf((*MockClientAPI)(nil).NewGetPositionModeService))
+}
+
+// NewGetPositionRiskService mocks base method.
+func (m *MockClientAPI) NewGetPositionRiskService() futures.GetPositionRiskServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewGetPositionRiskService")
+	ret0, _ := ret[0].(futures.GetPositionRiskServiceAPI)
+	return ret0
+}
+
+// NewGetPositionRiskService indicates an expected call of NewGetPositionRiskService.
+func (mr *MockClientAPIMockRecorder) NewGetPositionRiskService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewGetPositionRiskService", reflect.TypeOf((*MockClientAPI)(nil).NewGetPositionRiskService))
+}
+
+// NewGetPositionRiskV3Service mocks base method.
+func (m *MockClientAPI) NewGetPositionRiskV3Service() futures.GetPositionRiskV3ServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewGetPositionRiskV3Service")
+	ret0, _ := ret[0].(futures.GetPositionRiskV3ServiceAPI)
+	return ret0
+}
+
+// NewGetPositionRiskV3Service indicates an expected call of NewGetPositionRiskV3Service.
+func (mr *MockClientAPIMockRecorder) NewGetPositionRiskV3Service() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewGetPositionRiskV3Service", reflect.TypeOf((*MockClientAPI)(nil).NewGetPositionRiskV3Service))
+}
+
+// NewGetRebateNewUserService mocks base method.
+func (m *MockClientAPI) NewGetRebateNewUserService() futures.GetRebateNewUserServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewGetRebateNewUserService")
+	ret0, _ := ret[0].(futures.GetRebateNewUserServiceAPI)
+	return ret0
+}
+
+// NewGetRebateNewUserService indicates an expected call of NewGetRebateNewUserService.
+func (mr *MockClientAPIMockRecorder) NewGetRebateNewUserService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewGetRebateNewUserService", reflect.TypeOf((*MockClientAPI)(nil).NewGetRebateNewUserService))
+}
+
+// NewGetSymbolConfigService mocks base method.
+func (m *MockClientAPI) NewGetSymbolConfigService() futures.SymbolConfigServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewGetSymbolConfigService")
+	ret0, _ := ret[0].(futures.SymbolConfigServiceAPI)
+	return ret0
+}
+
+// NewGetSymbolConfigService indicates an expected call of NewGetSymbolConfigService.
+func (mr *MockClientAPIMockRecorder) NewGetSymbolConfigService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewGetSymbolConfigService", reflect.TypeOf((*MockClientAPI)(nil).NewGetSymbolConfigService))
+}
+
+// NewHistoricalTradesService mocks base method.
+func (m *MockClientAPI) NewHistoricalTradesService() futures.HistoricalTradesServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewHistoricalTradesService")
+	ret0, _ := ret[0].(futures.HistoricalTradesServiceAPI)
+	return ret0
+}
+
+// NewHistoricalTradesService indicates an expected call of NewHistoricalTradesService.
+func (mr *MockClientAPIMockRecorder) NewHistoricalTradesService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewHistoricalTradesService", reflect.TypeOf((*MockClientAPI)(nil).NewHistoricalTradesService))
+}
+
+// NewIndexInfoService mocks base method.
+func (m *MockClientAPI) NewIndexInfoService() futures.IndexInfoServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewIndexInfoService")
+	ret0, _ := ret[0].(futures.IndexInfoServiceAPI)
+	return ret0
+}
+
+// NewIndexInfoService indicates an expected call of NewIndexInfoService.
+func (mr *MockClientAPIMockRecorder) NewIndexInfoService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewIndexInfoService", reflect.TypeOf((*MockClientAPI)(nil).NewIndexInfoService))
+}
+
+// NewIndexPriceKlinesService mocks base method.
+func (m *MockClientAPI) NewIndexPriceKlinesService() futures.IndexPriceKlinesServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewIndexPriceKlinesService")
+	ret0, _ := ret[0].(futures.IndexPriceKlinesServiceAPI)
+	return ret0
+}
+
+// NewIndexPriceKlinesService indicates an expected call of NewIndexPriceKlinesService.
+func (mr *MockClientAPIMockRecorder) NewIndexPriceKlinesService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewIndexPriceKlinesService", reflect.TypeOf((*MockClientAPI)(nil).NewIndexPriceKlinesService))
+}
+
+// NewKeepaliveUserStreamService mocks base method.
+func (m *MockClientAPI) NewKeepaliveUserStreamService() futures.KeepaliveUserStreamServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewKeepaliveUserStreamService")
+	ret0, _ := ret[0].(futures.KeepaliveUserStreamServiceAPI)
+	return ret0
+}
+
+// NewKeepaliveUserStreamService indicates an expected call of NewKeepaliveUserStreamService.
+func (mr *MockClientAPIMockRecorder) NewKeepaliveUserStreamService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewKeepaliveUserStreamService", reflect.TypeOf((*MockClientAPI)(nil).NewKeepaliveUserStreamService))
+}
+
+// NewKlinesService mocks base method.
+func (m *MockClientAPI) NewKlinesService() futures.KlinesServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewKlinesService")
+	ret0, _ := ret[0].(futures.KlinesServiceAPI)
+	return ret0
+}
+
+// NewKlinesService indicates an expected call of NewKlinesService.
+func (mr *MockClientAPIMockRecorder) NewKlinesService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewKlinesService", reflect.TypeOf((*MockClientAPI)(nil).NewKlinesService))
+}
+
+// NewListAccountTradeService mocks base method.
+func (m *MockClientAPI) NewListAccountTradeService() futures.ListAccountTradeServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewListAccountTradeService")
+	ret0, _ := ret[0].(futures.ListAccountTradeServiceAPI)
+	return ret0
+}
+
+// NewListAccountTradeService indicates an expected call of NewListAccountTradeService.
+func (mr *MockClientAPIMockRecorder) NewListAccountTradeService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewListAccountTradeService", reflect.TypeOf((*MockClientAPI)(nil).NewListAccountTradeService))
+}
+
+// NewListBookTickersService mocks base method.
+func (m *MockClientAPI) NewListBookTickersService() futures.ListBookTickersServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewListBookTickersService")
+	ret0, _ := ret[0].(futures.ListBookTickersServiceAPI)
+	return ret0
+}
+
+// NewListBookTickersService indicates an expected call of NewListBookTickersService.
+func (mr *MockClientAPIMockRecorder) NewListBookTickersService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewListBookTickersService", reflect.TypeOf((*MockClientAPI)(nil).NewListBookTickersService))
+}
+
+// NewListConvertExchangeInfoService mocks base method.
+func (m *MockClientAPI) NewListConvertExchangeInfoService() futures.ListConvertExchangeInfoServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewListConvertExchangeInfoService")
+	ret0, _ := ret[0].(futures.ListConvertExchangeInfoServiceAPI)
+	return ret0
+}
+
+// NewListConvertExchangeInfoService indicates an expected call of NewListConvertExchangeInfoService.
+func (mr *MockClientAPIMockRecorder) NewListConvertExchangeInfoService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewListConvertExchangeInfoService", reflect.TypeOf((*MockClientAPI)(nil).NewListConvertExchangeInfoService))
+}
+
+// NewListLiquidationOrdersService mocks base method.
+func (m *MockClientAPI) NewListLiquidationOrdersService() futures.ListLiquidationOrdersServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewListLiquidationOrdersService")
+	ret0, _ := ret[0].(futures.ListLiquidationOrdersServiceAPI)
+	return ret0
+}
+
+// NewListLiquidationOrdersService indicates an expected call of NewListLiquidationOrdersService.
+func (mr *MockClientAPIMockRecorder) NewListLiquidationOrdersService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewListLiquidationOrdersService", reflect.TypeOf((*MockClientAPI)(nil).NewListLiquidationOrdersService))
+}
+
+// NewListOpenOrdersService mocks base method.
+func (m *MockClientAPI) NewListOpenOrdersService() futures.ListOpenOrdersServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewListOpenOrdersService")
+	ret0, _ := ret[0].(futures.ListOpenOrdersServiceAPI)
+	return ret0
+}
+
+// NewListOpenOrdersService indicates an expected call of NewListOpenOrdersService.
+func (mr *MockClientAPIMockRecorder) NewListOpenOrdersService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewListOpenOrdersService", reflect.TypeOf((*MockClientAPI)(nil).NewListOpenOrdersService))
+}
+
+// NewListOrdersService mocks base method.
+func (m *MockClientAPI) NewListOrdersService() futures.ListOrdersServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewListOrdersService")
+	ret0, _ := ret[0].(futures.ListOrdersServiceAPI)
+	return ret0
+}
+
+// NewListOrdersService indicates an expected call of NewListOrdersService.
+func (mr *MockClientAPIMockRecorder) NewListOrdersService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewListOrdersService", reflect.TypeOf((*MockClientAPI)(nil).NewListOrdersService))
+}
+
+// NewListPriceChangeStatsService mocks base method.
+func (m *MockClientAPI) NewListPriceChangeStatsService() futures.ListPriceChangeStatsServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewListPriceChangeStatsService")
+	ret0, _ := ret[0].(futures.ListPriceChangeStatsServiceAPI)
+	return ret0
+}
+
+// NewListPriceChangeStatsService indicates an expected call of NewListPriceChangeStatsService.
+func (mr *MockClientAPIMockRecorder) NewListPriceChangeStatsService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewListPriceChangeStatsService", reflect.TypeOf((*MockClientAPI)(nil).NewListPriceChangeStatsService))
+}
+
+// NewListPricesService mocks base method.
+func (m *MockClientAPI) NewListPricesService() futures.ListPricesServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewListPricesService")
+	ret0, _ := ret[0].(futures.ListPricesServiceAPI)
+	return ret0
+}
+
+// NewListPricesService indicates an expected call of NewListPricesService.
+func (mr *MockClientAPIMockRecorder) NewListPricesService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewListPricesService", reflect.TypeOf((*MockClientAPI)(nil).NewListPricesService))
+}
+
+// NewListUserLiquidationOrdersService mocks base method.
+func (m *MockClientAPI) NewListUserLiquidationOrdersService() futures.ListUserLiquidationOrdersServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewListUserLiquidationOrdersService")
+	ret0, _ := ret[0].(futures.ListUserLiquidationOrdersServiceAPI)
+	return ret0
+}
+
+// NewListUserLiquidationOrdersService indicates an expected call of NewListUserLiquidationOrdersService.
+func (mr *MockClientAPIMockRecorder) NewListUserLiquidationOrdersService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewListUserLiquidationOrdersService", reflect.TypeOf((*MockClientAPI)(nil).NewListUserLiquidationOrdersService))
+}
+
+// NewLongShortRatioService mocks base method.
+func (m *MockClientAPI) NewLongShortRatioService() futures.LongShortRatioServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewLongShortRatioService")
+	ret0, _ := ret[0].(futures.LongShortRatioServiceAPI)
+	return ret0
+}
+
+// NewLongShortRatioService indicates an expected call of NewLongShortRatioService.
+func (mr *MockClientAPIMockRecorder) NewLongShortRatioService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewLongShortRatioService", reflect.TypeOf((*MockClientAPI)(nil).NewLongShortRatioService))
+}
+
+// NewLvtKlinesService mocks base method.
+func (m *MockClientAPI) NewLvtKlinesService() futures.LvtKlinesServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewLvtKlinesService")
+	ret0, _ := ret[0].(futures.LvtKlinesServiceAPI)
+	return ret0
+}
+
+// NewLvtKlinesService indicates an expected call of NewLvtKlinesService.
+func (mr *MockClientAPIMockRecorder) NewLvtKlinesService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewLvtKlinesService", reflect.TypeOf((*MockClientAPI)(nil).NewLvtKlinesService))
+}
+
+// NewMarkPriceKlinesService mocks base method.
+func (m *MockClientAPI) NewMarkPriceKlinesService() futures.MarkPriceKlinesServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewMarkPriceKlinesService")
+	ret0, _ := ret[0].(futures.MarkPriceKlinesServiceAPI)
+	return ret0
+}
+
+// NewMarkPriceKlinesService indicates an expected call of NewMarkPriceKlinesService.
+func (mr *MockClientAPIMockRecorder) NewMarkPriceKlinesService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewMarkPriceKlinesService", reflect.TypeOf((*MockClientAPI)(nil).NewMarkPriceKlinesService))
+}
+
+// NewModifyBatchOrdersService mocks base method.
+func (m *MockClientAPI) NewModifyBatchOrdersService() futures.ModifyBatchOrdersServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewModifyBatchOrdersService")
+	ret0, _ := ret[0].(futures.ModifyBatchOrdersServiceAPI)
+	return ret0
+}
+
+// NewModifyBatchOrdersService indicates an expected call of NewModifyBatchOrdersService.
+func (mr *MockClientAPIMockRecorder) NewModifyBatchOrdersService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewModifyBatchOrdersService", reflect.TypeOf((*MockClientAPI)(nil).NewModifyBatchOrdersService))
+}
+
+// NewModifyOrderService mocks base method.
+func (m *MockClientAPI) NewModifyOrderService() futures.ModifyOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewModifyOrderService")
+	ret0, _ := ret[0].(futures.ModifyOrderServiceAPI)
+	return ret0
+}
+
+// NewModifyOrderService indicates an expected call of NewModifyOrderService.
+func (mr *MockClientAPIMockRecorder) NewModifyOrderService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewModifyOrderService", reflect.TypeOf((*MockClientAPI)(nil).NewModifyOrderService))
+}
+
+// NewOpenInterestStatisticsService mocks base method.
+func (m *MockClientAPI) NewOpenInterestStatisticsService() futures.OpenInterestStatisticsServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewOpenInterestStatisticsService")
+	ret0, _ := ret[0].(futures.OpenInterestStatisticsServiceAPI)
+	return ret0
+}
+
+// NewOpenInterestStatisticsService indicates an expected call of NewOpenInterestStatisticsService.
+func (mr *MockClientAPIMockRecorder) NewOpenInterestStatisticsService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewOpenInterestStatisticsService", reflect.TypeOf((*MockClientAPI)(nil).NewOpenInterestStatisticsService))
+}
+
+// NewPingService mocks base method.
+func (m *MockClientAPI) NewPingService() futures.PingServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewPingService")
+	ret0, _ := ret[0].(futures.PingServiceAPI)
+	return ret0
+}
+
+// NewPingService indicates an expected call of NewPingService.
+func (mr *MockClientAPIMockRecorder) NewPingService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewPingService", reflect.TypeOf((*MockClientAPI)(nil).NewPingService))
+}
+
+// NewPremiumIndexKlinesService mocks base method.
+func (m *MockClientAPI) NewPremiumIndexKlinesService() futures.PremiumIndexKlinesServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewPremiumIndexKlinesService")
+	ret0, _ := ret[0].(futures.PremiumIndexKlinesServiceAPI)
+	return ret0
+}
+
+// NewPremiumIndexKlinesService indicates an expected call of NewPremiumIndexKlinesService.
+func (mr *MockClientAPIMockRecorder) NewPremiumIndexKlinesService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewPremiumIndexKlinesService", reflect.TypeOf((*MockClientAPI)(nil).NewPremiumIndexKlinesService))
+}
+
+// NewPremiumIndexService mocks base method.
+func (m *MockClientAPI) NewPremiumIndexService() futures.PremiumIndexServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewPremiumIndexService")
+	ret0, _ := ret[0].(futures.PremiumIndexServiceAPI)
+	return ret0
+}
+
+// NewPremiumIndexService indicates an expected call of NewPremiumIndexService.
+func (mr *MockClientAPIMockRecorder) NewPremiumIndexService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewPremiumIndexService", reflect.TypeOf((*MockClientAPI)(nil).NewPremiumIndexService))
+}
+
+// NewRecentTradesService mocks base method.
+func (m *MockClientAPI) NewRecentTradesService() futures.RecentTradesServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewRecentTradesService")
+	ret0, _ := ret[0].(futures.RecentTradesServiceAPI)
+	return ret0
+}
+
+// NewRecentTradesService indicates an expected call of NewRecentTradesService.
+func (mr *MockClientAPIMockRecorder) NewRecentTradesService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewRecentTradesService", reflect.TypeOf((*MockClientAPI)(nil).NewRecentTradesService))
+}
+
+// NewServerTimeService mocks base method.
+func (m *MockClientAPI) NewServerTimeService() futures.ServerTimeServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewServerTimeService")
+	ret0, _ := ret[0].(futures.ServerTimeServiceAPI)
+	return ret0
+}
+
+// NewServerTimeService indicates an expected call of NewServerTimeService.
+func (mr *MockClientAPIMockRecorder) NewServerTimeService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewServerTimeService", reflect.TypeOf((*MockClientAPI)(nil).NewServerTimeService))
+}
+
+// NewSetServerTimeService mocks base method.
+func (m *MockClientAPI) NewSetServerTimeService() futures.SetServerTimeServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewSetServerTimeService")
+	ret0, _ := ret[0].(futures.SetServerTimeServiceAPI)
+	return ret0
+}
+
+// NewSetServerTimeService indicates an expected call of NewSetServerTimeService.
+func (mr *MockClientAPIMockRecorder) NewSetServerTimeService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewSetServerTimeService", reflect.TypeOf((*MockClientAPI)(nil).NewSetServerTimeService))
+}
+
+// NewStartUserStreamService mocks base method.
+func (m *MockClientAPI) NewStartUserStreamService() futures.StartUserStreamServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewStartUserStreamService")
+	ret0, _ := ret[0].(futures.StartUserStreamServiceAPI)
+	return ret0
+}
+
+// NewStartUserStreamService indicates an expected call of NewStartUserStreamService.
+func (mr *MockClientAPIMockRecorder) NewStartUserStreamService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewStartUserStreamService", reflect.TypeOf((*MockClientAPI)(nil).NewStartUserStreamService))
+}
+
+// NewTakerLongShortRatioService mocks base method.
+func (m *MockClientAPI) NewTakerLongShortRatioService() futures.TakerLongShortRatioServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewTakerLongShortRatioService")
+	ret0, _ := ret[0].(futures.TakerLongShortRatioServiceAPI)
+	return ret0
+}
+
+// NewTakerLongShortRatioService indicates an expected call of NewTakerLongShortRatioService.
+func (mr *MockClientAPIMockRecorder) NewTakerLongShortRatioService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewTakerLongShortRatioService", reflect.TypeOf((*MockClientAPI)(nil).NewTakerLongShortRatioService))
+}
+
+// NewTopLongShortAccountRatioService mocks base method.
+func (m *MockClientAPI) NewTopLongShortAccountRatioService() futures.TopLongShortAccountRatioServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewTopLongShortAccountRatioService")
+	ret0, _ := ret[0].(futures.TopLongShortAccountRatioServiceAPI)
+	return ret0
+}
+
+// NewTopLongShortAccountRatioService indicates an expected call of NewTopLongShortAccountRatioService.
+func (mr *MockClientAPIMockRecorder) NewTopLongShortAccountRatioService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewTopLongShortAccountRatioService", reflect.TypeOf((*MockClientAPI)(nil).NewTopLongShortAccountRatioService))
+}
+
+// NewTopLongShortPositionRatioService mocks base method.
+func (m *MockClientAPI) NewTopLongShortPositionRatioService() futures.TopLongShortPositionRatioServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewTopLongShortPositionRatioService")
+	ret0, _ := ret[0].(futures.TopLongShortPositionRatioServiceAPI)
+	return ret0
+}
+
+// NewTopLongShortPositionRatioService indicates an expected call of NewTopLongShortPositionRatioService.
+func (mr *MockClientAPIMockRecorder) NewTopLongShortPositionRatioService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewTopLongShortPositionRatioService", reflect.TypeOf((*MockClientAPI)(nil).NewTopLongShortPositionRatioService))
+}
+
+// NewUpdatePositionMarginService mocks base method.
+func (m *MockClientAPI) NewUpdatePositionMarginService() futures.UpdatePositionMarginServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewUpdatePositionMarginService")
+	ret0, _ := ret[0].(futures.UpdatePositionMarginServiceAPI)
+	return ret0
+}
+
+// NewUpdatePositionMarginService indicates an expected call of NewUpdatePositionMarginService.
+func (mr *MockClientAPIMockRecorder) NewUpdatePositionMarginService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewUpdatePositionMarginService", reflect.TypeOf((*MockClientAPI)(nil).NewUpdatePositionMarginService))
+}
+
+// MockPingServiceAPI is a mock of PingServiceAPI interface.
+type MockPingServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockPingServiceAPIMockRecorder
+}
+
+// MockPingServiceAPIMockRecorder is the mock recorder for MockPingServiceAPI.
+type MockPingServiceAPIMockRecorder struct {
+	mock *MockPingServiceAPI
+}
+
+// NewMockPingServiceAPI creates a new mock instance.
+func NewMockPingServiceAPI(ctrl *gomock.Controller) *MockPingServiceAPI {
+	mock := &MockPingServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockPingServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPingServiceAPI) EXPECT() *MockPingServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockPingServiceAPI) Do(ctx context.Context, opts ...futures.RequestOption) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockPingServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockPingServiceAPI)(nil).Do), varargs...)
+}
+
+// MockServerTimeServiceAPI is a mock of ServerTimeServiceAPI interface.
+type MockServerTimeServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockServerTimeServiceAPIMockRecorder
+}
+
+// MockServerTimeServiceAPIMockRecorder is the mock recorder for MockServerTimeServiceAPI.
+type MockServerTimeServiceAPIMockRecorder struct {
+	mock *MockServerTimeServiceAPI
+}
+
+// NewMockServerTimeServiceAPI creates a new mock instance.
+func NewMockServerTimeServiceAPI(ctrl *gomock.Controller) *MockServerTimeServiceAPI {
+	mock := &MockServerTimeServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockServerTimeServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockServerTimeServiceAPI) EXPECT() *MockServerTimeServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockServerTimeServiceAPI) Do(ctx context.Context, opts ...futures.RequestOption) (int64, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockServerTimeServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockServerTimeServiceAPI)(nil).Do), varargs...)
+}
+
+// MockSetServerTimeServiceAPI is a mock of SetServerTimeServiceAPI interface.
+type MockSetServerTimeServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockSetServerTimeServiceAPIMockRecorder
+}
+
+// MockSetServerTimeServiceAPIMockRecorder is the mock recorder for MockSetServerTimeServiceAPI.
+type MockSetServerTimeServiceAPIMockRecorder struct {
+	mock *MockSetServerTimeServiceAPI
+}
+
+// NewMockSetServerTimeServiceAPI creates a new mock instance.
+func NewMockSetServerTimeServiceAPI(ctrl *gomock.Controller) *MockSetServerTimeServiceAPI {
+	mock := &MockSetServerTimeServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockSetServerTimeServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSetServerTimeServiceAPI) EXPECT() *MockSetServerTimeServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockSetServerTimeServiceAPI) Do(ctx context.Context, opts ...futures.RequestOption) (int64, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockSetServerTimeServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockSetServerTimeServiceAPI)(nil).Do), varargs...)
+}
+
+// MockDepthServiceAPI is a mock of DepthServiceAPI interface.
+type MockDepthServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockDepthServiceAPIMockRecorder
+}
+
+// MockDepthServiceAPIMockRecorder is the mock recorder for MockDepthServiceAPI.
+type MockDepthServiceAPIMockRecorder struct {
+	mock *MockDepthServiceAPI
+}
+
+// NewMockDepthServiceAPI creates a new mock instance.
+func NewMockDepthServiceAPI(ctrl *gomock.Controller) *MockDepthServiceAPI {
+	mock := &MockDepthServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockDepthServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDepthServiceAPI) EXPECT() *MockDepthServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockDepthServiceAPI) Do(ctx context.Context, opts ...futures.RequestOption) (*futures.DepthResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*futures.DepthResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockDepthServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockDepthServiceAPI)(nil).Do), varargs...)
+}
+
+// Limit mocks base method.
+func (m *MockDepthServiceAPI) Limit(limit int) futures.DepthServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Limit", limit)
+	ret0, _ := ret[0].(futures.DepthServiceAPI)
+	return ret0
+}
+
+// Limit indicates an expected call of Limit.
+func (mr *MockDepthServiceAPIMockRecorder) Limit(limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Limit", reflect.TypeOf((*MockDepthServiceAPI)(nil).Limit), limit)
+}
+
+// Symbol mocks base method.
+func (m *MockDepthServiceAPI) Symbol(symbol string) futures.DepthServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Symbol", symbol)
+	ret0, _ := ret[0].(futures.DepthServiceAPI)
+	return ret0
+}
+
+// Symbol indicates an expected call of Symbol.
+func (mr *MockDepthServiceAPIMockRecorder) Symbol(symbol interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Symbol", reflect.TypeOf((*MockDepthServiceAPI)(nil).Symbol), symbol)
+}
+
+// MockAggTradesServiceAPI is a mock of AggTradesServiceAPI interface.
+type MockAggTradesServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockAggTradesServiceAPIMockRecorder
+}
+
+// MockAggTradesServiceAPIMockRecorder is the mock recorder for MockAggTradesServiceAPI.
+type MockAggTradesServiceAPIMockRecorder struct {
+	mock *MockAggTradesServiceAPI
+}
+
+// NewMockAggTradesServiceAPI creates a new mock instance.
+func NewMockAggTradesServiceAPI(ctrl *gomock.Controller) *MockAggTradesServiceAPI {
+	mock := &MockAggTradesServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockAggTradesServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAggTradesServiceAPI) EXPECT() *MockAggTradesServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockAggTradesServiceAPI) Do(ctx context.Context, opts ...futures.RequestOption) ([]*futures.AggTrade, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].([]*futures.AggTrade)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockAggTradesServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockAggTradesServiceAPI)(nil).Do), varargs...)
+}
+
+// EndTime mocks base method.
+func (m *MockAggTradesServiceAPI) EndTime(endTime int64) futures.AggTradesServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EndTime", endTime)
+	ret0, _ := ret[0].(futures.AggTradesServiceAPI)
+	return ret0
+}
+
+// EndTime indicates an expected call of EndTime.
+func (mr *MockAggTradesServiceAPIMockRecorder) EndTime(endTime interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EndTime", reflect.TypeOf((*MockAggTradesServiceAPI)(nil).EndTime), endTime)
+}
+
+// FromID mocks base method.
+func (m *MockAggTradesServiceAPI) FromID(fromID int64) futures.AggTradesServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FromID", fromID)
+	ret0, _ := ret[0].(futures.AggTradesServiceAPI)
+	return ret0
+}
+
+// FromID indicates an expected call of FromID.
+func (mr *MockAggTradesServiceAPIMockRecorder) FromID(fromID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FromID", reflect.TypeOf((*MockAggTradesServiceAPI)(nil).FromID), fromID)
+}
+
+// Limit mocks base method.
+func (m *MockAggTradesServiceAPI) Limit(limit int) futures.AggTradesServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Limit", limit)
+	ret0, _ := ret[0].(futures.AggTradesServiceAPI)
+	return ret0
+}
+
+// Limit indicates an expected call of Limit.
+func (mr *MockAggTradesServiceAPIMockRecorder) Limit(limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Limit", reflect.TypeOf((*MockAggTradesServiceAPI)(nil).Limit), limit)
+}
+
+// StartTime mocks base method.
+func (m *MockAggTradesServiceAPI) StartTime(startTime int64) futures.AggTradesServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartTime", startTime)
+	ret0, _ := ret[0].(futures.AggTradesServiceAPI)
+	return ret0
+}
+
+// StartTime indicates an expected call of StartTime.
+func (mr *MockAggTradesServiceAPIMockRecorder) StartTime(startTime interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartTime", reflect.TypeOf((*MockAggTradesServiceAPI)(nil).StartTime), startTime)
+}
+
+// Symbol mocks base method.
+func (m *MockAggTradesServiceAPI) Symbol(symbol string) futures.AggTradesServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Symbol", symbol)
+	ret0, _ := ret[0].(futures.AggTradesServiceAPI)
+	return ret0
+}
+
+// Symbol indicates an expected call of Symbol.
+func (mr *MockAggTradesServiceAPIMockRecorder) Symbol(symbol interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Symbol", reflect.TypeOf((*MockAggTradesServiceAPI)(nil).Symbol), symbol)
+}
+
+// MockRecentTradesServiceAPI is a mock of RecentTradesServiceAPI interface.
+type MockRecentTradesServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockRecentTradesServiceAPIMockRecorder
+}
+
+// MockRecentTradesServiceAPIMockRecorder is the mock recorder for MockRecentTradesServiceAPI.
+type MockRecentTradesServiceAPIMockRecorder struct {
+	mock *MockRecentTradesServiceAPI
+}
+
+// NewMockRecentTradesServiceAPI creates a new mock instance.
+func NewMockRecentTradesServiceAPI(ctrl *gomock.Controller) *MockRecentTradesServiceAPI {
+	mock := &MockRecentTradesServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockRecentTradesServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRecentTradesServiceAPI) EXPECT() *MockRecentTradesServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockRecentTradesServiceAPI) Do(ctx context.Context, opts ...futures.RequestOption) ([]*futures.Trade, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].([]*futures.Trade)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockRecentTradesServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockRecentTradesServiceAPI)(nil).Do), varargs...)
+}
+
+// Limit mocks base method.
+func (m *MockRecentTradesServiceAPI) Limit(limit int) futures.RecentTradesServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Limit", limit)
+	ret0, _ := ret[0].(futures.RecentTradesServiceAPI)
+	return ret0
+}
+
+// Limit indicates an expected call of Limit.
+func (mr *MockRecentTradesServiceAPIMockRecorder) Limit(limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Limit", reflect.TypeOf((*MockRecentTradesServiceAPI)(nil).Limit), limit)
+}
+
+// Symbol mocks base method.
+func (m *MockRecentTradesServiceAPI) Symbol(symbol string) futures.RecentTradesServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Symbol", symbol)
+	ret0, _ := ret[0].(futures.RecentTradesServiceAPI)
+	return ret0
+}
+
+// Symbol indicates an expected call of Symbol.
+func (mr *MockRecentTradesServiceAPIMockRecorder) Symbol(symbol interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Symbol", reflect.TypeOf((*MockRecentTradesServiceAPI)(nil).Symbol), symbol)
+}
+
+// MockKlinesServiceAPI is a mock of KlinesServiceAPI interface.
+type MockKlinesServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockKlinesServiceAPIMockRecorder
+}
+
+// MockKlinesServiceAPIMockRecorder is the mock recorder for MockKlinesServiceAPI.
+type MockKlinesServiceAPIMockRecorder struct {
+	mock *MockKlinesServiceAPI
+}
+
+// NewMockKlinesServiceAPI creates a new mock instance.
+func NewMockKlinesServiceAPI(ctrl *gomock.Controller) *MockKlinesServiceAPI {
+	mock := &MockKlinesServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockKlinesServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockKlinesServiceAPI) EXPECT() *MockKlinesServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockKlinesServiceAPI) Do(ctx context.Context, opts ...futures.RequestOption) ([]*futures.Kline, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].([]*futures.Kline)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockKlinesServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockKlinesServiceAPI)(nil).Do), varargs...)
+}
+
+// EndTime mocks base method.
+func (m *MockKlinesServiceAPI) EndTime(endTime int64) futures.KlinesServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EndTime", endTime)
+	ret0, _ := ret[0].(futures.KlinesServiceAPI)
+	return ret0
+}
+
+// EndTime indicates an expected call of EndTime.
+func (mr *MockKlinesServiceAPIMockRecorder) EndTime(endTime interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EndTime", reflect.TypeOf((*MockKlinesServiceAPI)(nil).EndTime), endTime)
+}
+
+// Interval mocks base method.
+func (m *MockKlinesServiceAPI) Interval(interval string) futures.KlinesServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Interval", interval)
+	ret0, _ := ret[0].(futures.KlinesServiceAPI)
+	return ret0
+}
+
+// Interval indicates an expected call of Interval.
+func (mr *MockKlinesServiceAPIMockRecorder) Interval(interval interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Interval", reflect.TypeOf((*MockKlinesServiceAPI)(nil).Interval), interval)
+}
+
+// Limit mocks base method.
+func (m *MockKlinesServiceAPI) Limit(limit int) futures.KlinesServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Limit", limit)
+	ret0, _ := ret[0].(futures.KlinesServiceAPI)
+	return ret0
+}
+
+// Limit indicates an expected call of Limit.
+func (mr *MockKlinesServiceAPIMockRecorder) Limit(limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Limit", reflect.TypeOf((*MockKlinesServiceAPI)(nil).Limit), limit)
+}
+
+// StartTime mocks base method.
+func (m *MockKlinesServiceAPI) StartTime(startTime int64) futures.KlinesServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartTime", startTime)
+	ret0, _ := ret[0].(futures.KlinesServiceAPI)
+	return ret0
+}
+
+// StartTime indicates an expected call of StartTime.
+func (mr *MockKlinesServiceAPIMockRecorder) StartTime(startTime interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartTime", reflect.TypeOf((*MockKlinesServiceAPI)(nil).StartTime), startTime)
+}
+
+// Symbol mocks base method.
+func (m *MockKlinesServiceAPI) Symbol(symbol string) futures.KlinesServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Symbol", symbol)
+	ret0, _ := ret[0].(futures.KlinesServiceAPI)
+	return ret0
+}
+
+// Symbol indicates an expected call of Symbol.
+func (mr *MockKlinesServiceAPIMockRecorder) Symbol(symbol interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Symbol", reflect.TypeOf((*MockKlinesServiceAPI)(nil).Symbol), symbol)
+}
+
+// MockContinuousKlinesServiceAPI is a mock of ContinuousKlinesServiceAPI interface.
+type MockContinuousKlinesServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockContinuousKlinesServiceAPIMockRecorder
+}
+
+// MockContinuousKlinesServiceAPIMockRecorder is the mock recorder for MockContinuousKlinesServiceAPI.
+type MockContinuousKlinesServiceAPIMockRecorder struct {
+	mock *MockContinuousKlinesServiceAPI
+}
+
+// NewMockContinuousKlinesServiceAPI creates a new mock instance.
+func NewMockContinuousKlinesServiceAPI(ctrl *gomock.Controller) *MockContinuousKlinesServiceAPI {
+	mock := &MockContinuousKlinesServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockContinuousKlinesServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockContinuousKlinesServiceAPI) EXPECT() *MockContinuousKlinesServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// ContractType mocks base method.
+func (m *MockContinuousKlinesServiceAPI) ContractType(contractType string) futures.ContinuousKlinesServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ContractType", contractType)
+	ret0, _ := ret[0].(futures.ContinuousKlinesServiceAPI)
+	return ret0
+}
+
+// ContractType indicates an expected call of ContractType.
+func (mr *MockContinuousKlinesServiceAPIMockRecorder) ContractType(contractType interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ContractType", reflect.TypeOf((*MockContinuousKlinesServiceAPI)(nil).ContractType), contractType)
+}
+
+// Do mocks base method.
+func (m *MockContinuousKlinesServiceAPI) Do(ctx context.Context, opts ...futures.RequestOption) ([]*futures.ContinuousKline, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].([]*futures.ContinuousKline)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockContinuousKlinesServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockContinuousKlinesServiceAPI)(nil).Do), varargs...)
+}
+
+// EndTime mocks base method.
+func (m *MockContinuousKlinesServiceAPI) EndTime(endTime int64) futures.ContinuousKlinesServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EndTime", endTime)
+	ret0, _ := ret[0].(futures.ContinuousKlinesServiceAPI)
+	return ret0
+}
+
+// EndTime indicates an expected call of EndTime.
+func (mr *MockContinuousKlinesServiceAPIMockRecorder) EndTime(endTime interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EndTime", reflect.TypeOf((*MockContinuousKlinesServiceAPI)(nil).EndTime), endTime)
+}
+
+// Interval mocks base method.
+func (m *MockContinuousKlinesServiceAPI) Interval(interval string) futures.ContinuousKlinesServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Interval", interval)
+	ret0, _ := ret[0].(futures.ContinuousKlinesServiceAPI)
+	return ret0
+}
+
+// Interval indicates an expected call of Interval.
+func (mr *MockContinuousKlinesServiceAPIMockRecorder) Interval(interval interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Interval", reflect.TypeOf((*MockContinuousKlinesServiceAPI)(nil).Interval), interval)
+}
+
+// Limit mocks base method.
+func (m *MockContinuousKlinesServiceAPI) Limit(limit int) futures.ContinuousKlinesServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Limit", limit)
+	ret0, _ := ret[0].(futures.ContinuousKlinesServiceAPI)
+	return ret0
+}
+
+// Limit indicates an expected call of Limit.
+func (mr *MockContinuousKlinesServiceAPIMockRecorder) Limit(limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Limit", reflect.TypeOf((*MockContinuousKlinesServiceAPI)(nil).Limit), limit)
+}
+
+// Pair mocks base method.
+func (m *MockContinuousKlinesServiceAPI) Pair(pair string) futures.ContinuousKlinesServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Pair", pair)
+	ret0, _ := ret[0].(futures.ContinuousKlinesServiceAPI)
+	return ret0
+}
+
+// Pair indicates an expected call of Pair.
+func (mr *MockContinuousKlinesServiceAPIMockRecorder) Pair(pair interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Pair", reflect.TypeOf((*MockContinuousKlinesServiceAPI)(nil).Pair), pair)
+}
+
+// StartTime mocks base method.
+func (m *MockContinuousKlinesServiceAPI) StartTime(startTime int64) futures.ContinuousKlinesServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartTime", startTime)
+	ret0, _ := ret[0].(futures.ContinuousKlinesServiceAPI)
+	return ret0
+}
+
+// StartTime indicates an expected call of StartTime.
+func (mr *MockContinuousKlinesServiceAPIMockRecorder) StartTime(startTime interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartTime", reflect.TypeOf((*MockContinuousKlinesServiceAPI)(nil).StartTime), startTime)
+}
+
+// MockIndexPriceKlinesServiceAPI is a mock of IndexPriceKlinesServiceAPI interface.
+type MockIndexPriceKlinesServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockIndexPriceKlinesServiceAPIMockRecorder
+}
+
+// MockIndexPriceKlinesServiceAPIMockRecorder is the mock recorder for MockIndexPriceKlinesServiceAPI.
+type MockIndexPriceKlinesServiceAPIMockRecorder struct {
+	mock *MockIndexPriceKlinesServiceAPI
+}
+
+// NewMockIndexPriceKlinesServiceAPI creates a new mock instance.
+func NewMockIndexPriceKlinesServiceAPI(ctrl *gomock.Controller) *MockIndexPriceKlinesServiceAPI {
+	mock := &MockIndexPriceKlinesServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockIndexPriceKlinesServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIndexPriceKlinesServiceAPI) EXPECT() *MockIndexPriceKlinesServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockIndexPriceKlinesServiceAPI) Do(ctx context.Context, opts ...futures.RequestOption) ([]*futures.Kline, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].([]*futures.Kline)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockIndexPriceKlinesServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockIndexPriceKlinesServiceAPI)(nil).Do), varargs...)
+}
+
+// EndTime mocks base method.
+func (m *MockIndexPriceKlinesServiceAPI) EndTime(endTime int64) futures.IndexPriceKlinesServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EndTime", endTime)
+	ret0, _ := ret[0].(futures.IndexPriceKlinesServiceAPI)
+	return ret0
+}
+
+// EndTime indicates an expected call of EndTime.
+func (mr *MockIndexPriceKlinesServiceAPIMockRecorder) EndTime(endTime interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EndTime", reflect.TypeOf((*MockIndexPriceKlinesServiceAPI)(nil).EndTime), endTime)
+}
+
+// Interval mocks base method.
+func (m *MockIndexPriceKlinesServiceAPI) Interval(interval string) futures.IndexPriceKlinesServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Interval", interval)
+	ret0, _ := ret[0].(futures.IndexPriceKlinesServiceAPI)
+	return ret0
+}
+
+// Interval indicates an expected call of Interval.
+func (mr *MockIndexPriceKlinesServiceAPIMockRecorder) Interval(interval interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Interval", reflect.TypeOf((*MockIndexPriceKlinesServiceAPI)(nil).Interval), interval)
+}
+
+// Limit mocks base method.
+func (m *MockIndexPriceKlinesServiceAPI) Limit(limit int) futures.IndexPriceKlinesServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Limit", limit)
+	ret0, _ := ret[0].(futures.IndexPriceKlinesServiceAPI)
+	return ret0
+}
+
+// Limit indicates an expected call of Limit.
+func (mr *MockIndexPriceKlinesServiceAPIMockRecorder) Limit(limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Limit", reflect.TypeOf((*MockIndexPriceKlinesServiceAPI)(nil).Limit), limit)
+}
+
+// Pair mocks base method.
+func (m *MockIndexPriceKlinesServiceAPI) Pair(pair string) futures.IndexPriceKlinesServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Pair", pair)
+	ret0, _ := ret[0].(futures.IndexPriceKlinesServiceAPI)
+	return ret0
+}
+
+// Pair indicates an expected call of Pair.
+func (mr *MockIndexPriceKlinesServiceAPIMockRecorder) Pair(pair interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Pair", reflect.TypeOf((*MockIndexPriceKlinesServiceAPI)(nil).Pair), pair)
+}
+
+// StartTime mocks base method.
+func (m *MockIndexPriceKlinesServiceAPI) StartTime(startTime int64) futures.IndexPriceKlinesServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartTime", startTime)
+	ret0, _ := ret[0].(futures.IndexPriceKlinesServiceAPI)
+	return ret0
+}
+
+// StartTime indicates an expected call of StartTime.
+func (mr *MockIndexPriceKlinesServiceAPIMockRecorder) StartTime(startTime interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartTime", reflect.TypeOf((*MockIndexPriceKlinesServiceAPI)(nil).StartTime), startTime)
+}
+
+// MockMarkPriceKlinesServiceAPI is a mock of MarkPriceKlinesServiceAPI interface.
+type MockMarkPriceKlinesServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockMarkPriceKlinesServiceAPIMockRecorder
+}
+
+// MockMarkPriceKlinesServiceAPIMockRecorder is the mock recorder for MockMarkPriceKlinesServiceAPI.
+type MockMarkPriceKlinesServiceAPIMockRecorder struct {
+	mock *MockMarkPriceKlinesServiceAPI
+}
+
+// NewMockMarkPriceKlinesServiceAPI creates a new mock instance.
+func NewMockMarkPriceKlinesServiceAPI(ctrl *gomock.Controller) *MockMarkPriceKlinesServiceAPI {
+	mock := &MockMarkPriceKlinesServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockMarkPriceKlinesServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockMarkPriceKlinesServiceAPI) EXPECT() *MockMarkPriceKlinesServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockMarkPriceKlinesServiceAPI) Do(ctx context.Context, opts ...futures.RequestOption) ([]*futures.Kline, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].([]*futures.Kline)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockMarkPriceKlinesServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockMarkPriceKlinesServiceAPI)(nil).Do), varargs...)
+}
+
+// EndTime mocks base method.
+func (m *MockMarkPriceKlinesServiceAPI) EndTime(endTime int64) futures.MarkPriceKlinesServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EndTime", endTime)
+	ret0, _ := ret[0].(futures.MarkPriceKlinesServiceAPI)
+	return ret0
+}
+
+// EndTime indicates an expected call of EndTime.
+func (mr *MockMarkPriceKlinesServiceAPIMockRecorder) EndTime(endTime interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EndTime", reflect.TypeOf((*MockMarkPriceKlinesServiceAPI)(nil).EndTime), endTime)
+}
+
+// Interval mocks base method.
+func (m *MockMarkPriceKlinesServiceAPI) Interval(interval string) futures.MarkPriceKlinesServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Interval", interval)
+	ret0, _ := ret[0].(futures.MarkPriceKlinesServiceAPI)
+	return ret0
+}
+
+// Interval indicates an expected call of Interval.
+func (mr *MockMarkPriceKlinesServiceAPIMockRecorder) Interval(interval interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Interval", reflect.TypeOf((*MockMarkPriceKlinesServiceAPI)(nil).Interval), interval)
+}
+
+// Limit mocks base method.
+func (m *MockMarkPriceKlinesServiceAPI) Limit(limit int) futures.MarkPriceKlinesServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Limit", limit)
+	ret0, _ := ret[0].(futures.MarkPriceKlinesServiceAPI)
+	return ret0
+}
+
+// Limit indicates an expected call of Limit.
+func (mr *MockMarkPriceKlinesServiceAPIMockRecorder) Limit(limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Limit", reflect.TypeOf((*MockMarkPriceKlinesServiceAPI)(nil).Limit), limit)
+}
+
+// StartTime mocks base method.
+func (m *MockMarkPriceKlinesServiceAPI) StartTime(startTime int64) futures.MarkPriceKlinesServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartTime", startTime)
+	ret0, _ := ret[0].(futures.MarkPriceKlinesServiceAPI)
+	return ret0
+}
+
+// StartTime indicates an expected call of StartTime.
+func (mr *MockMarkPriceKlinesServiceAPIMockRecorder) StartTime(startTime interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartTime", reflect.TypeOf((*MockMarkPriceKlinesServiceAPI)(nil).StartTime), startTime)
+}
+
+// Symbol mocks base method.
+func (m *MockMarkPriceKlinesServiceAPI) Symbol(symbol string) futures.MarkPriceKlinesServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Symbol", symbol)
+	ret0, _ := ret[0].(futures.MarkPriceKlinesServiceAPI)
+	return ret0
+}
+
+// Symbol indicates an expected call of Symbol.
+func (mr *MockMarkPriceKlinesServiceAPIMockRecorder) Symbol(symbol interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Symbol", reflect.TypeOf((*MockMarkPriceKlinesServiceAPI)(nil).Symbol), symbol)
+}
+
+// MockListPriceChangeStatsServiceAPI is a mock of ListPriceChangeStatsServiceAPI interface.
+type MockListPriceChangeStatsServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockListPriceChangeStatsServiceAPIMockRecorder
+}
+
+// MockListPriceChangeStatsServiceAPIMockRecorder is the mock recorder for MockListPriceChangeStatsServiceAPI.
+type MockListPriceChangeStatsServiceAPIMockRecorder struct {
+	mock *MockListPriceChangeStatsServiceAPI
+}
+
+// NewMockListPriceChangeStatsServiceAPI creates a new mock instance.
+func NewMockListPriceChangeStatsServiceAPI(ctrl *gomock.Controller) *MockListPriceChangeStatsServiceAPI {
+	mock := &MockListPriceChangeStatsServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockListPriceChangeStatsServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockListPriceChangeStatsServiceAPI) EXPECT() *MockListPriceChangeStatsServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockListPriceChangeStatsServiceAPI) Do(ctx context.Context, opts ...futures.RequestOption) ([]*futures.PriceChangeStats, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].([]*futures.PriceChangeStats)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockListPriceChangeStatsServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockListPriceChangeStatsServiceAPI)(nil).Do), varargs...)
+}
+
+// Symbol mocks base method.
+func (m *MockListPriceChangeStatsServiceAPI) Symbol(symbol string) futures.ListPriceChangeStatsServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Symbol", symbol)
+	ret0, _ := ret[0].(futures.ListPriceChangeStatsServiceAPI)
+	return ret0
+}
+
+// Symbol indicates an expected call of Symbol.
+func (mr *MockListPriceChangeStatsServiceAPIMockRecorder) Symbol(symbol interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Symbol", reflect.TypeOf((*MockListPriceChangeStatsServiceAPI)(nil).Symbol), symbol)
+}
+
+// MockListPricesServiceAPI is a mock of ListPricesServiceAPI interface.
+type MockListPricesServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockListPricesServiceAPIMockRecorder
+}
+
+// MockListPricesServiceAPIMockRecorder is the mock recorder for MockListPricesServiceAPI.
+type MockListPricesServiceAPIMockRecorder struct {
+	mock *MockListPricesServiceAPI
+}
+
+// NewMockListPricesServiceAPI creates a new mock instance.
+func NewMockListPricesServiceAPI(ctrl *gomock.Controller) *MockListPricesServiceAPI {
+	mock := &MockListPricesServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockListPricesServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockListPricesServiceAPI) EXPECT() *MockListPricesServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockListPricesServiceAPI) Do(ctx context.Context, opts ...futures.RequestOption) ([]*futures.SymbolPrice, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].([]*futures.SymbolPrice)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockListPricesServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockListPricesServiceAPI)(nil).Do), varargs...)
+}
+
+// Symbol mocks base method.
+func (m *MockListPricesServiceAPI) Symbol(symbol string) futures.ListPricesServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Symbol", symbol)
+	ret0, _ := ret[0].(futures.ListPricesServiceAPI)
+	return ret0
+}
+
+// Symbol indicates an expected call of Symbol.
+func (mr *MockListPricesServiceAPIMockRecorder) Symbol(symbol interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Symbol", reflect.TypeOf((*MockListPricesServiceAPI)(nil).Symbol), symbol)
+}
+
+// MockListBookTickersServiceAPI is a mock of ListBookTickersServiceAPI interface.
+type MockListBookTickersServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockListBookTickersServiceAPIMockRecorder
+}
+
+// MockListBookTickersServiceAPIMockRecorder is the mock recorder for MockListBookTickersServiceAPI.
+type MockListBookTickersServiceAPIMockRecorder struct {
+	mock *MockListBookTickersServiceAPI
+}
+
+// NewMockListBookTickersServiceAPI creates a new mock instance.
+func NewMockListBookTickersServiceAPI(ctrl *gomock.Controller) *MockListBookTickersServiceAPI {
+	mock := &MockListBookTickersServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockListBookTickersServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockListBookTickersServiceAPI) EXPECT() *MockListBookTickersServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockListBookTickersServiceAPI) Do(ctx context.Context, opts ...futures.RequestOption) ([]*futures.BookTicker, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].([]*futures.BookTicker)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockListBookTickersServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockListBookTickersServiceAPI)(nil).Do), varargs...)
+}
+
+// Symbol mocks base method.
+func (m *MockListBookTickersServiceAPI) Symbol(symbol string) futures.ListBookTickersServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Symbol", symbol)
+	ret0, _ := ret[0].(futures.ListBookTickersServiceAPI)
+	return ret0
+}
+
+// Symbol indicates an expected call of Symbol.
+func (mr *MockListBookTickersServiceAPIMockRecorder) Symbol(symbol interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Symbol", reflect.TypeOf((*MockListBookTickersServiceAPI)(nil).Symbol), symbol)
+}
+
+// MockCreateOrderServiceAPI is a mock of CreateOrderServiceAPI interface.
+type MockCreateOrderServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockCreateOrderServiceAPIMockRecorder
+}
+
+// MockCreateOrderServiceAPIMockRecorder is the mock recorder for MockCreateOrderServiceAPI.
+type MockCreateOrderServiceAPIMockRecorder struct {
+	mock *MockCreateOrderServiceAPI
+}
+
+// NewMockCreateOrderServiceAPI creates a new mock instance.
+func NewMockCreateOrderServiceAPI(ctrl *gomock.Controller) *MockCreateOrderServiceAPI {
+	mock := &MockCreateOrderServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockCreateOrderServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCreateOrderServiceAPI) EXPECT() *MockCreateOrderServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// ActivationPrice mocks base method.
+func (m *MockCreateOrderServiceAPI) ActivationPrice(activationPrice string) futures.CreateOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ActivationPrice", activationPrice)
+	ret0, _ := ret[0].(futures.CreateOrderServiceAPI)
+	return ret0
+}
+
+// ActivationPrice indicates an expected call of ActivationPrice.
+func (mr *MockCreateOrderServiceAPIMockRecorder) ActivationPrice(activationPrice interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ActivationPrice", reflect.TypeOf((*MockCreateOrderServiceAPI)(nil).ActivationPrice), activationPrice)
+}
+
+// CallbackRate mocks base method.
+func (m *MockCreateOrderServiceAPI) CallbackRate(callbackRate string) futures.CreateOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CallbackRate", callbackRate)
+	ret0, _ := ret[0].(futures.CreateOrderServiceAPI)
+	return ret0
+}
+
+// CallbackRate indicates an expected call of CallbackRate.
+func (mr *MockCreateOrderServiceAPIMockRecorder) CallbackRate(callbackRate interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CallbackRate", reflect.TypeOf((*MockCreateOrderServiceAPI)(nil).CallbackRate), callbackRate)
+}
+
+// ClosePosition mocks base method.
+func (m *MockCreateOrderServiceAPI) ClosePosition(closePosition bool) futures.CreateOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClosePosition", closePosition)
+	ret0, _ := ret[0].(futures.CreateOrderServiceAPI)
+	return ret0
+}
+
+// ClosePosition indicates an expected call of ClosePosition.
+func (mr *MockCreateOrderServiceAPIMockRecorder) ClosePosition(closePosition interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClosePosition", reflect.TypeOf((*MockCreateOrderServiceAPI)(nil).ClosePosition), closePosition)
+}
+
+// Do mocks base method.
+func (m *MockCreateOrderServiceAPI) Do(ctx context.Context, opts ...futures.RequestOption) (*futures.CreateOrderResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*futures.CreateOrderResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockCreateOrderServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockCreateOrderServiceAPI)(nil).Do), varargs...)
+}
+
+// GoodTillDate mocks base method.
+func (m *MockCreateOrderServiceAPI) GoodTillDate(goodTillDate int64) futures.CreateOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GoodTillDate", goodTillDate)
+	ret0, _ := ret[0].(futures.CreateOrderServiceAPI)
+	return ret0
+}
+
+// GoodTillDate indicates an expected call of GoodTillDate.
+func (mr *MockCreateOrderServiceAPIMockRecorder) GoodTillDate(goodTillDate interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GoodTillDate", reflect.TypeOf((*MockCreateOrderServiceAPI)(nil).GoodTillDate), goodTillDate)
+}
+
+// NewClientOrderID mocks base method.
+func (m *MockCreateOrderServiceAPI) NewClientOrderID(newClientOrderID string) futures.CreateOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewClientOrderID", newClientOrderID)
+	ret0, _ := ret[0].(futures.CreateOrderServiceAPI)
+	return ret0
+}
+
+// NewClientOrderID indicates an expected call of NewClientOrderID.
+func (mr *MockCreateOrderServiceAPIMockRecorder) NewClientOrderID(newClientOrderID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewClientOrderID", reflect.TypeOf((*MockCreateOrderServiceAPI)(nil).NewClientOrderID), newClientOrderID)
+}
+
+// NewOrderResponseType mocks base method.
+func (m *MockCreateOrderServiceAPI) NewOrderResponseType(newOrderResponseType futures.NewOrderRespType) futures.CreateOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewOrderResponseType", newOrderResponseType)
+	ret0, _ := ret[0].(futures.CreateOrderServiceAPI)
+	return ret0
+}
+
+// NewOrderResponseType indicates an expected call of NewOrderResponseType.
+func (mr *MockCreateOrderServiceAPIMockRecorder) NewOrderResponseType(newOrderResponseType interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewOrderResponseType", reflect.TypeOf((*MockCreateOrderServiceAPI)(nil).NewOrderResponseType), newOrderResponseType)
+}
+
+// PositionSide mocks base method.
+func (m *MockCreateOrderServiceAPI) PositionSide(positionSide futures.PositionSideType) futures.CreateOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PositionSide", positionSide)
+	ret0, _ := ret[0].(futures.CreateOrderServiceAPI)
+	return ret0
+}
+
+// PositionSide indicates an expected call of PositionSide.
+func (mr *MockCreateOrderServiceAPIMockRecorder) PositionSide(positionSide interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PositionSide", reflect.TypeOf((*MockCreateOrderServiceAPI)(nil).PositionSide), positionSide)
+}
+
+// Price mocks base method.
+func (m *MockCreateOrderServiceAPI) Price(price string) futures.CreateOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Price", price)
+	ret0, _ := ret[0].(futures.CreateOrderServiceAPI)
+	return ret0
+}
+
+// Price indicates an expected call of Price.
+func (mr *MockCreateOrderServiceAPIMockRecorder) Price(price interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Price", reflect.TypeOf((*MockCreateOrderServiceAPI)(nil).Price), price)
+}
+
+// PriceMatch mocks base method.
+func (m *MockCreateOrderServiceAPI) PriceMatch(priceMatch futures.PriceMatchType) futures.CreateOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PriceMatch", priceMatch)
+	ret0, _ := ret[0].(futures.CreateOrderServiceAPI)
+	return ret0
+}
+
+// PriceMatch indicates an expected call of PriceMatch.
+func (mr *MockCreateOrderServiceAPIMockRecorder) PriceMatch(priceMatch interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PriceMatch", reflect.TypeOf((*MockCreateOrderServiceAPI)(nil).PriceMatch), priceMatch)
+}
+
+// PriceProtect mocks base method.
+func (m *MockCreateOrderServiceAPI) PriceProtect(priceProtect bool) futures.CreateOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PriceProtect", priceProtect)
+	ret0, _ := ret[0].(futures.CreateOrderServiceAPI)
+	return ret0
+}
+
+// PriceProtect indicates an expected call of PriceProtect.
+func (mr *MockCreateOrderServiceAPIMockRecorder) PriceProtect(priceProtect interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PriceProtect", reflect.TypeOf((*MockCreateOrderServiceAPI)(nil).PriceProtect), priceProtect)
+}
+
+// Quantity mocks base method.
+func (m *MockCreateOrderServiceAPI) Quantity(quantity string) futures.CreateOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Quantity", quantity)
+	ret0, _ := ret[0].(futures.CreateOrderServiceAPI)
+	return ret0
+}
+
+// Quantity indicates an expected call of Quantity.
+func (mr *MockCreateOrderServiceAPIMockRecorder) Quantity(quantity interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Quantity", reflect.TypeOf((*MockCreateOrderServiceAPI)(nil).Quantity), quantity)
+}
+
+// ReduceOnly mocks base method.
+func (m *MockCreateOrderServiceAPI) ReduceOnly(reduceOnly bool) futures.CreateOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReduceOnly", reduceOnly)
+	ret0, _ := ret[0].(futures.CreateOrderServiceAPI)
+	return ret0
+}
+
+// ReduceOnly indicates an expected call of ReduceOnly.
+func (mr *MockCreateOrderServiceAPIMockRecorder) ReduceOnly(reduceOnly interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReduceOnly", reflect.TypeOf((*MockCreateOrderServiceAPI)(nil).ReduceOnly), reduceOnly)
+}
+
+// SelfTradePreventionMode mocks base method.
+func (m *MockCreateOrderServiceAPI) SelfTradePreventionMode(selfTradePreventionMode futures.SelfTradePreventionMode) futures.CreateOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SelfTradePreventionMode", selfTradePreventionMode)
+	ret0, _ := ret[0].(futures.CreateOrderServiceAPI)
+	return ret0
+}
+
+// SelfTradePreventionMode indicates an expected call of SelfTradePreventionMode.
+func (mr *MockCreateOrderServiceAPIMockRecorder) SelfTradePreventionMode(selfTradePreventionMode interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelfTradePreventionMode", reflect.TypeOf((*MockCreateOrderServiceAPI)(nil).SelfTradePreventionMode), selfTradePreventionMode)
+}
+
+// Side mocks base method.
+func (m *MockCreateOrderServiceAPI) Side(side futures.SideType) futures.CreateOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Side", side)
+	ret0, _ := ret[0].(futures.CreateOrderServiceAPI)
+	return ret0
+}
+
+// Side indicates an expected call of Side.
+func (mr *MockCreateOrderServiceAPIMockRecorder) Side(side interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Side", reflect.TypeOf((*MockCreateOrderServiceAPI)(nil).Side), side)
+}
+
+// StopPrice mocks base method.
+func (m *MockCreateOrderServiceAPI) StopPrice(stopPrice string) futures.CreateOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StopPrice", stopPrice)
+	ret0, _ := ret[0].(futures.CreateOrderServiceAPI)
+	return ret0
+}
+
+// StopPrice indicates an expected call of StopPrice.
+func (mr *MockCreateOrderServiceAPIMockRecorder) StopPrice(stopPrice interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StopPrice", reflect.TypeOf((*MockCreateOrderServiceAPI)(nil).StopPrice), stopPrice)
+}
+
+// Symbol mocks base method.
+func (m *MockCreateOrderServiceAPI) Symbol(symbol string) futures.CreateOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Symbol", symbol)
+	ret0, _ := ret[0].(futures.CreateOrderServiceAPI)
+	return ret0
+}
+
+// Symbol indicates an expected call of Symbol.
+func (mr *MockCreateOrderServiceAPIMockRecorder) Symbol(symbol interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Symbol", reflect.TypeOf((*MockCreateOrderServiceAPI)(nil).Symbol), symbol)
+}
+
+// TimeInForce mocks base method.
+func (m *MockCreateOrderServiceAPI) TimeInForce(timeInForce futures.TimeInForceType) futures.CreateOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TimeInForce", timeInForce)
+	ret0, _ := ret[0].(futures.CreateOrderServiceAPI)
+	return ret0
+}
+
+// TimeInForce indicates an expected call of TimeInForce.
+func (mr *MockCreateOrderServiceAPIMockRecorder) TimeInForce(timeInForce interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TimeInForce", reflect.TypeOf((*MockCreateOrderServiceAPI)(nil).TimeInForce), timeInForce)
+}
+
+// Type mocks base method.
+func (m *MockCreateOrderServiceAPI) Type(orderType futures.OrderType) futures.CreateOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Type", orderType)
+	ret0, _ := ret[0].(futures.CreateOrderServiceAPI)
+	return ret0
+}
+
+// Type indicates an expected call of Type.
+func (mr *MockCreateOrderServiceAPIMockRecorder) Type(orderType interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Type", reflect.TypeOf((*MockCreateOrderServiceAPI)(nil).Type), orderType)
+}
+
+// WorkingType mocks base method.
+func (m *MockCreateOrderServiceAPI) WorkingType(workingType futures.WorkingType) futures.CreateOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WorkingType", workingType)
+	ret0, _ := ret[0].(futures.CreateOrderServiceAPI)
+	return ret0
+}
+
+// WorkingType indicates an expected call of WorkingType.
+func (mr *MockCreateOrderServiceAPIMockRecorder) WorkingType(workingType interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WorkingType", reflect.TypeOf((*MockCreateOrderServiceAPI)(nil).WorkingType), workingType)
+}
+
+// MockModifyOrderServiceAPI is a mock of ModifyOrderServiceAPI interface.
+type MockModifyOrderServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockModifyOrderServiceAPIMockRecorder
+}
+
+// MockModifyOrderServiceAPIMockRecorder is the mock recorder for MockModifyOrderServiceAPI.
+type MockModifyOrderServiceAPIMockRecorder struct {
+	mock *MockModifyOrderServiceAPI
+}
+
+// NewMockModifyOrderServiceAPI creates a new mock instance.
+func NewMockModifyOrderServiceAPI(ctrl *gomock.Controller) *MockModifyOrderServiceAPI {
+	mock := &MockModifyOrderServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockModifyOrderServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockModifyOrderServiceAPI) EXPECT() *MockModifyOrderServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockModifyOrderServiceAPI) Do(ctx context.Context, opts ...futures.RequestOption) (*futures.ModifyOrderResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*futures.ModifyOrderResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockModifyOrderServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockModifyOrderServiceAPI)(nil).Do), varargs...)
+}
+
+// OrderID mocks base method.
+func (m *MockModifyOrderServiceAPI) OrderID(orderID int64) futures.ModifyOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OrderID", orderID)
+	ret0, _ := ret[0].(futures.ModifyOrderServiceAPI)
+	return ret0
+}
+
+// OrderID indicates an expected call of OrderID.
+func (mr *MockModifyOrderServiceAPIMockRecorder) OrderID(orderID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OrderID", reflect.TypeOf((*MockModifyOrderServiceAPI)(nil).OrderID), orderID)
+}
+
+// OrigClientOrderID mocks base method.
+func (m *MockModifyOrderServiceAPI) OrigClientOrderID(origClientOrderID string) futures.ModifyOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OrigClientOrderID", origClientOrderID)
+	ret0, _ := ret[0].(futures.ModifyOrderServiceAPI)
+	return ret0
+}
+
+// OrigClientOrderID indicates an expected call of OrigClientOrderID.
+func (mr *MockModifyOrderServiceAPIMockRecorder) OrigClientOrderID(origClientOrderID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OrigClientOrderID", reflect.TypeOf((*MockModifyOrderServiceAPI)(nil).OrigClientOrderID), origClientOrderID)
+}
+
+// Price mocks base method.
+func (m *MockModifyOrderServiceAPI) Price(price string) futures.ModifyOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Price", price)
+	ret0, _ := ret[0].(futures.ModifyOrderServiceAPI)
+	return ret0
+}
+
+// Price indicates an expected call of Price.
+func (mr *MockModifyOrderServiceAPIMockRecorder) Price(price interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Price", reflect.TypeOf((*MockModifyOrderServiceAPI)(nil).Price), price)
+}
+
+// PriceMatch mocks base method.
+func (m *MockModifyOrderServiceAPI) PriceMatch(priceMatch futures.PriceMatchType) futures.ModifyOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PriceMatch", priceMatch)
+	ret0, _ := ret[0].(futures.ModifyOrderServiceAPI)
+	return ret0
+}
+
+// PriceMatch indicates an expected call of PriceMatch.
+func (mr *MockModifyOrderServiceAPIMockRecorder) PriceMatch(priceMatch interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PriceMatch", reflect.TypeOf((*MockModifyOrderServiceAPI)(nil).PriceMatch), priceMatch)
+}
+
+// Quantity mocks base method.
+func (m *MockModifyOrderServiceAPI) Quantity(quantity string) futures.ModifyOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Quantity", quantity)
+	ret0, _ := ret[0].(futures.ModifyOrderServiceAPI)
+	return ret0
+}
+
+// Quantity indicates an expected call of Quantity.
+func (mr *MockModifyOrderServiceAPIMockRecorder) Quantity(quantity interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Quantity", reflect.TypeOf((*MockModifyOrderServiceAPI)(nil).Quantity), quantity)
+}
+
+// Side mocks base method.
+func (m *MockModifyOrderServiceAPI) Side(side futures.SideType) futures.ModifyOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Side", side)
+	ret0, _ := ret[0].(futures.ModifyOrderServiceAPI)
+	return ret0
+}
+
+// Side indicates an expected call of Side.
+func (mr *MockModifyOrderServiceAPIMockRecorder) Side(side interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Side", reflect.TypeOf((*MockModifyOrderServiceAPI)(nil).Side), side)
+}
+
+// Symbol mocks base method.
+func (m *MockModifyOrderServiceAPI) Symbol(symbol string) futures.ModifyOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Symbol", symbol)
+	ret0, _ := ret[0].(futures.ModifyOrderServiceAPI)
+	return ret0
+}
+
+// Symbol indicates an expected call of Symbol.
+func (mr *MockModifyOrderServiceAPIMockRecorder) Symbol(symbol interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Symbol", reflect.TypeOf((*MockModifyOrderServiceAPI)(nil).Symbol), symbol)
+}
+
+// MockCreateBatchOrdersServiceAPI is a mock of CreateBatchOrdersServiceAPI interface.
+type MockCreateBatchOrdersServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockCreateBatchOrdersServiceAPIMockRecorder
+}
+
+// MockCreateBatchOrdersServiceAPIMockRecorder is the mock recorder for MockCreateBatchOrdersServiceAPI.
+type MockCreateBatchOrdersServiceAPIMockRecorder struct {
+	mock *MockCreateBatchOrdersServiceAPI
+}
+
+// NewMockCreateBatchOrdersServiceAPI creates a new mock instance.
+func NewMockCreateBatchOrdersServiceAPI(ctrl *gomock.Controller) *MockCreateBatchOrdersServiceAPI {
+	mock := &MockCreateBatchOrdersServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockCreateBatchOrdersServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCreateBatchOrdersServiceAPI) EXPECT() *MockCreateBatchOrdersServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockCreateBatchOrdersServiceAPI) Do(ctx context.Context, opts ...futures.RequestOption) (*futures.CreateBatchOrdersResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*futures.CreateBatchOrdersResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockCreateBatchOrdersServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockCreateBatchOrdersServiceAPI)(nil).Do), varargs...)
+}
+
+// OrderList mocks base method.
+func (m *MockCreateBatchOrdersServiceAPI) OrderList(orders []*futures.CreateOrderService) futures.CreateBatchOrdersServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OrderList", orders)
+	ret0, _ := ret[0].(futures.CreateBatchOrdersServiceAPI)
+	return ret0
+}
+
+// OrderList indicates an expected call of OrderList.
+func (mr *MockCreateBatchOrdersServiceAPIMockRecorder) OrderList(orders interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OrderList", reflect.TypeOf((*MockCreateBatchOrdersServiceAPI)(nil).OrderList), orders)
+}
+
+// MockModifyBatchOrdersServiceAPI is a mock of ModifyBatchOrdersServiceAPI interface.
+type MockModifyBatchOrdersServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockModifyBatchOrdersServiceAPIMockRecorder
+}
+
+// MockModifyBatchOrdersServiceAPIMockRecorder is the mock recorder for MockModifyBatchOrdersServiceAPI.
+type MockModifyBatchOrdersServiceAPIMockRecorder struct {
+	mock *MockModifyBatchOrdersServiceAPI
+}
+
+// NewMockModifyBatchOrdersServiceAPI creates a new mock instance.
+func NewMockModifyBatchOrdersServiceAPI(ctrl *gomock.Controller) *MockModifyBatchOrdersServiceAPI {
+	mock := &MockModifyBatchOrdersServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockModifyBatchOrdersServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockModifyBatchOrdersServiceAPI) EXPECT() *MockModifyBatchOrdersServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockModifyBatchOrdersServiceAPI) Do(ctx context.Context, opts ...futures.RequestOption) (*futures.ModifyBatchOrdersResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*futures.ModifyBatchOrdersResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockModifyBatchOrdersServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockModifyBatchOrdersServiceAPI)(nil).Do), varargs...)
+}
+
+// OrderList mocks base method.
+func (m *MockModifyBatchOrdersServiceAPI) OrderList(orders []*futures.ModifyOrder) futures.ModifyBatchOrdersServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OrderList", orders)
+	ret0, _ := ret[0].(futures.ModifyBatchOrdersServiceAPI)
+	return ret0
+}
+
+// OrderList indicates an expected call of OrderList.
+func (mr *MockModifyBatchOrdersServiceAPIMockRecorder) OrderList(orders interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OrderList", reflect.TypeOf((*MockModifyBatchOrdersServiceAPI)(nil).OrderList), orders)
+}
+
+// MockGetOrderServiceAPI is a mock of GetOrderServiceAPI interface.
+type MockGetOrderServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockGetOrderServiceAPIMockRecorder
+}
+
+// MockGetOrderServiceAPIMockRecorder is the mock recorder for MockGetOrderServiceAPI.
+type MockGetOrderServiceAPIMockRecorder struct {
+	mock *MockGetOrderServiceAPI
+}
+
+// NewMockGetOrderServiceAPI creates a new mock instance.
+func NewMockGetOrderServiceAPI(ctrl *gomock.Controller) *MockGetOrderServiceAPI {
+	mock := &MockGetOrderServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockGetOrderServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGetOrderServiceAPI) EXPECT() *MockGetOrderServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockGetOrderServiceAPI) Do(ctx context.Context, opts ...futures.RequestOption) (*futures.Order, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*futures.Order)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockGetOrderServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockGetOrderServiceAPI)(nil).Do), varargs...)
+}
+
+// OrderID mocks base method.
+func (m *MockGetOrderServiceAPI) OrderID(orderID int64) futures.GetOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OrderID", orderID)
+	ret0, _ := ret[0].(futures.GetOrderServiceAPI)
+	return ret0
+}
+
+// OrderID indicates an expected call of OrderID.
+func (mr *MockGetOrderServiceAPIMockRecorder) OrderID(orderID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OrderID", reflect.TypeOf((*MockGetOrderServiceAPI)(nil).OrderID), orderID)
+}
+
+// OrigClientOrderID mocks base method.
+func (m *MockGetOrderServiceAPI) OrigClientOrderID(origClientOrderID string) futures.GetOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OrigClientOrderID", origClientOrderID)
+	ret0, _ := ret[0].(futures.GetOrderServiceAPI)
+	return ret0
+}
+
+// OrigClientOrderID indicates an expected call of OrigClientOrderID.
+func (mr *MockGetOrderServiceAPIMockRecorder) OrigClientOrderID(origClientOrderID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OrigClientOrderID", reflect.TypeOf((*MockGetOrderServiceAPI)(nil).OrigClientOrderID), origClientOrderID)
+}
+
+// Symbol mocks base method.
+func (m *MockGetOrderServiceAPI) Symbol(symbol string) futures.GetOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Symbol", symbol)
+	ret0, _ := ret[0].(futures.GetOrderServiceAPI)
+	return ret0
+}
+
+// Symbol indicates an expected call of Symbol.
+func (mr *MockGetOrderServiceAPIMockRecorder) Symbol(symbol interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Symbol", reflect.TypeOf((*MockGetOrderServiceAPI)(nil).Symbol), symbol)
+}
+
+// MockCancelOrderServiceAPI is a mock of CancelOrderServiceAPI interface.
+type MockCancelOrderServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockCancelOrderServiceAPIMockRecorder
+}
+
+// MockCancelOrderServiceAPIMockRecorder is the mock recorder for MockCancelOrderServiceAPI.
+type MockCancelOrderServiceAPIMockRecorder struct {
+	mock *MockCancelOrderServiceAPI
+}
+
+// NewMockCancelOrderServiceAPI creates a new mock instance.
+func NewMockCancelOrderServiceAPI(ctrl *gomock.Controller) *MockCancelOrderServiceAPI {
+	mock := &MockCancelOrderServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockCancelOrderServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCancelOrderServiceAPI) EXPECT() *MockCancelOrderServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockCancelOrderServiceAPI) Do(ctx context.Context, opts ...futures.RequestOption) (*futures.CancelOrderResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*futures.CancelOrderResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockCancelOrderServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockCancelOrderServiceAPI)(nil).Do), varargs...)
+}
+
+// OrderID mocks base method.
+func (m *MockCancelOrderServiceAPI) OrderID(orderID int64) futures.CancelOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OrderID", orderID)
+	ret0, _ := ret[0].(futures.CancelOrderServiceAPI)
+	return ret0
+}
+
+// OrderID indicates an expected call of OrderID.
+func (mr *MockCancelOrderServiceAPIMockRecorder) OrderID(orderID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OrderID", reflect.TypeOf((*MockCancelOrderServiceAPI)(nil).OrderID), orderID)
+}
+
+// OrigClientOrderID mocks base method.
+func (m *MockCancelOrderServiceAPI) OrigClientOrderID(origClientOrderID string) futures.CancelOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OrigClientOrderID", origClientOrderID)
+	ret0, _ := ret[0].(futures.CancelOrderServiceAPI)
+	return ret0
+}
+
+// OrigClientOrderID indicates an expected call of OrigClientOrderID.
+func (mr *MockCancelOrderServiceAPIMockRecorder) OrigClientOrderID(origClientOrderID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OrigClientOrderID", reflect.TypeOf((*MockCancelOrderServiceAPI)(nil).OrigClientOrderID), origClientOrderID)
+}
+
+// Symbol mocks base method.
+func (m *MockCancelOrderServiceAPI) Symbol(symbol string) futures.CancelOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Symbol", symbol)
+	ret0, _ := ret[0].(futures.CancelOrderServiceAPI)
+	return ret0
+}
+
+// Symbol indicates an expected call of Symbol.
+func (mr *MockCancelOrderServiceAPIMockRecorder) Symbol(symbol interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Symbol", reflect.TypeOf((*MockCancelOrderServiceAPI)(nil).Symbol), symbol)
+}
+
+// MockCancelAllOpenOrdersServiceAPI is a mock of CancelAllOpenOrdersServiceAPI interface.
+type MockCancelAllOpenOrdersServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockCancelAllOpenOrdersServiceAPIMockRecorder
+}
+
+// MockCancelAllOpenOrdersServiceAPIMockRecorder is the mock recorder for MockCancelAllOpenOrdersServiceAPI.
+type MockCancelAllOpenOrdersServiceAPIMockRecorder struct {
+	mock *MockCancelAllOpenOrdersServiceAPI
+}
+
+// NewMockCancelAllOpenOrdersServiceAPI creates a new mock instance.
+func NewMockCancelAllOpenOrdersServiceAPI(ctrl *gomock.Controller) *MockCancelAllOpenOrdersServiceAPI {
+	mock := &MockCancelAllOpenOrdersServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockCancelAllOpenOrdersServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCancelAllOpenOrdersServiceAPI) EXPECT() *MockCancelAllOpenOrdersServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockCancelAllOpenOrdersServiceAPI) Do(ctx context.Context, opts ...futures.RequestOption) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockCancelAllOpenOrdersServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockCancelAllOpenOrdersServiceAPI)(nil).Do), varargs...)
+}
+
+// Symbol mocks base method.
+func (m *MockCancelAllOpenOrdersServiceAPI) Symbol(symbol string) futures.CancelAllOpenOrdersServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Symbol", symbol)
+	ret0, _ := ret[0].(futures.CancelAllOpenOrdersServiceAPI)
+	return ret0
+}
+
+// Symbol indicates an expected call of Symbol.
+func (mr *MockCancelAllOpenOrdersServiceAPIMockRecorder) Symbol(symbol interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Symbol", reflect.TypeOf((*MockCancelAllOpenOrdersServiceAPI)(nil).Symbol), symbol)
+}
+
+// MockCancelMultiplesOrdersServiceAPI is a mock of CancelMultiplesOrdersServiceAPI interface.
+type MockCancelMultiplesOrdersServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockCancelMultiplesOrdersServiceAPIMockRecorder
+}
+
+// MockCancelMultiplesOrdersServiceAPIMockRecorder is the mock recorder for MockCancelMultiplesOrdersServiceAPI.
+type MockCancelMultiplesOrdersServiceAPIMockRecorder struct {
+	mock *MockCancelMultiplesOrdersServiceAPI
+}
+
+// NewMockCancelMultiplesOrdersServiceAPI creates a new mock instance.
+func NewMockCancelMultiplesOrdersServiceAPI(ctrl *gomock.Controller) *MockCancelMultiplesOrdersServiceAPI {
+	mock := &MockCancelMultiplesOrdersServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockCancelMultiplesOrdersServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCancelMultiplesOrdersServiceAPI) EXPECT() *MockCancelMultiplesOrdersServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockCancelMultiplesOrdersServiceAPI) Do(ctx context.Context, opts ...futures.RequestOption) ([]*futures.CancelOrderResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].([]*futures.CancelOrderResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockCancelMultiplesOrdersServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockCancelMultiplesOrdersServiceAPI)(nil).Do), varargs...)
+}
+
+// OrderIDList mocks base method.
+func (m *MockCancelMultiplesOrdersServiceAPI) OrderIDList(orderIDList []int64) futures.CancelMultiplesOrdersServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OrderIDList", orderIDList)
+	ret0, _ := ret[0].(futures.CancelMultiplesOrdersServiceAPI)
+	return ret0
+}
+
+// OrderIDList indicates an expected call of OrderIDList.
+func (mr *MockCancelMultiplesOrdersServiceAPIMockRecorder) OrderIDList(orderIDList interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OrderIDList", reflect.TypeOf((*MockCancelMultiplesOrdersServiceAPI)(nil).OrderIDList), orderIDList)
+}
+
+// OrigClientOrderIDList mocks base method.
+func (m *MockCancelMultiplesOrdersServiceAPI) OrigClientOrderIDList(origClientOrderIDList []string) futures.CancelMultiplesOrdersServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OrigClientOrderIDList", origClientOrderIDList)
+	ret0, _ := ret[0].(futures.CancelMultiplesOrdersServiceAPI)
+	return ret0
+}
+
+// OrigClientOrderIDList indicates an expected call of OrigClientOrderIDList.
+func (mr *MockCancelMultiplesOrdersServiceAPIMockRecorder) OrigClientOrderIDList(origClientOrderIDList interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OrigClientOrderIDList", reflect.TypeOf((*MockCancelMultiplesOrdersServiceAPI)(nil).OrigClientOrderIDList), origClientOrderIDList)
+}
+
+// Symbol mocks base method.
+func (m *MockCancelMultiplesOrdersServiceAPI) Symbol(symbol string) futures.CancelMultiplesOrdersServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Symbol", symbol)
+	ret0, _ := ret[0].(futures.CancelMultiplesOrdersServiceAPI)
+	return ret0
+}
+
+// Symbol indicates an expected call of Symbol.
+func (mr *MockCancelMultiplesOrdersServiceAPIMockRecorder) Symbol(symbol interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Symbol", reflect.TypeOf((*MockCancelMultiplesOrdersServiceAPI)(nil).Symbol), symbol)
+}
+
+// MockGetOpenOrderServiceAPI is a mock of GetOpenOrderServiceAPI interface.
+type MockGetOpenOrderServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockGetOpenOrderServiceAPIMockRecorder
+}
+
+// MockGetOpenOrderServiceAPIMockRecorder is the mock recorder for MockGetOpenOrderServiceAPI.
+type MockGetOpenOrderServiceAPIMockRecorder struct {
+	mock *MockGetOpenOrderServiceAPI
+}
+
+// NewMockGetOpenOrderServiceAPI creates a new mock instance.
+func NewMockGetOpenOrderServiceAPI(ctrl *gomock.Controller) *MockGetOpenOrderServiceAPI {
+	mock := &MockGetOpenOrderServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockGetOpenOrderServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGetOpenOrderServiceAPI) EXPECT() *MockGetOpenOrderServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockGetOpenOrderServiceAPI) Do(ctx context.Context, opts ...futures.RequestOption) (*futures.Order, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*futures.Order)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockGetOpenOrderServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockGetOpenOrderServiceAPI)(nil).Do), varargs...)
+}
+
+// OrderID mocks base method.
+func (m *MockGetOpenOrderServiceAPI) OrderID(orderID int64) futures.GetOpenOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OrderID", orderID)
+	ret0, _ := ret[0].(futures.GetOpenOrderServiceAPI)
+	return ret0
+}
+
+// OrderID indicates an expected call of OrderID.
+func (mr *MockGetOpenOrderServiceAPIMockRecorder) OrderID(orderID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OrderID", reflect.TypeOf((*MockGetOpenOrderServiceAPI)(nil).OrderID), orderID)
+}
+
+// OrigClientOrderID mocks base method.
+func (m *MockGetOpenOrderServiceAPI) OrigClientOrderID(origClientOrderID string) futures.GetOpenOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OrigClientOrderID", origClientOrderID)
+	ret0, _ := ret[0].(futures.GetOpenOrderServiceAPI)
+	return ret0
+}
+
+// OrigClientOrderID indicates an expected call of OrigClientOrderID.
+func (mr *MockGetOpenOrderServiceAPIMockRecorder) OrigClientOrderID(origClientOrderID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OrigClientOrderID", reflect.TypeOf((*MockGetOpenOrderServiceAPI)(nil).OrigClientOrderID), origClientOrderID)
+}
+
+// Symbol mocks base method.
+func (m *MockGetOpenOrderServiceAPI) Symbol(symbol string) futures.GetOpenOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Symbol", symbol)
+	ret0, _ := ret[0].(futures.GetOpenOrderServiceAPI)
+	return ret0
+}
+
+// Symbol indicates an expected call of Symbol.
+func (mr *MockGetOpenOrderServiceAPIMockRecorder) Symbol(symbol interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Symbol", reflect.TypeOf((*MockGetOpenOrderServiceAPI)(nil).Symbol), symbol)
+}
+
+// MockListOpenOrdersServiceAPI is a mock of ListOpenOrdersServiceAPI interface.
+type MockListOpenOrdersServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockListOpenOrdersServiceAPIMockRecorder
+}
+
+// MockListOpenOrdersServiceAPIMockRecorder is the mock recorder for MockListOpenOrdersServiceAPI.
+type MockListOpenOrdersServiceAPIMockRecorder struct {
+	mock *MockListOpenOrdersServiceAPI
+}
+
+// NewMockListOpenOrdersServiceAPI creates a new mock instance.
+func NewMockListOpenOrdersServiceAPI(ctrl *gomock.Controller) *MockListOpenOrdersServiceAPI {
+	mock := &MockListOpenOrdersServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockListOpenOrdersServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockListOpenOrdersServiceAPI) EXPECT() *MockListOpenOrdersServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockListOpenOrdersServiceAPI) Do(ctx context.Context, opts ...futures.RequestOption) ([]*futures.Order, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].([]*futures.Order)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockListOpenOrdersServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockListOpenOrdersServiceAPI)(nil).Do), varargs...)
+}
+
+// Symbol mocks base method.
+func (m *MockListOpenOrdersServiceAPI) Symbol(symbol string) futures.ListOpenOrdersServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Symbol", symbol)
+	ret0, _ := ret[0].(futures.ListOpenOrdersServiceAPI)
+	return ret0
+}
+
+// Symbol indicates an expected call of Symbol.
+func (mr *MockListOpenOrdersServiceAPIMockRecorder) Symbol(symbol interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Symbol", reflect.TypeOf((*MockListOpenOrdersServiceAPI)(nil).Symbol), symbol)
+}
+
+// MockListOrdersServiceAPI is a mock of ListOrdersServiceAPI interface.
+type MockListOrdersServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockListOrdersServiceAPIMockRecorder
+}
+
+// MockListOrdersServiceAPIMockRecorder is the mock recorder for MockListOrdersServiceAPI.
+type MockListOrdersServiceAPIMockRecorder struct {
+	mock *MockListOrdersServiceAPI
+}
+
+// NewMockListOrdersServiceAPI creates a new mock instance.
+func NewMockListOrdersServiceAPI(ctrl *gomock.Controller) *MockListOrdersServiceAPI {
+	mock := &MockListOrdersServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockListOrdersServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockListOrdersServiceAPI) EXPECT() *MockListOrdersServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockListOrdersServiceAPI) Do(ctx context.Context, opts ...futures.RequestOption) ([]*futures.Order, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].([]*futures.Order)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockListOrdersServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockListOrdersServiceAPI)(nil).Do), varargs...)
+}
+
+// EndTime mocks base method.
+func (m *MockListOrdersServiceAPI) EndTime(endTime int64) futures.ListOrdersServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EndTime", endTime)
+	ret0, _ := ret[0].(futures.ListOrdersServiceAPI)
+	return ret0
+}
+
+// EndTime indicates an expected call of EndTime.
+func (mr *MockListOrdersServiceAPIMockRecorder) EndTime(endTime interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EndTime", reflect.TypeOf((*MockListOrdersServiceAPI)(nil).EndTime), endTime)
+}
+
+// Limit mocks base method.
+func (m *MockListOrdersServiceAPI) Limit(limit int) futures.ListOrdersServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Limit", limit)
+	ret0, _ := ret[0].(futures.ListOrdersServiceAPI)
+	return ret0
+}
+
+// Limit indicates an expected call of Limit.
+func (mr *MockListOrdersServiceAPIMockRecorder) Limit(limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Limit", reflect.TypeOf((*MockListOrdersServiceAPI)(nil).Limit), limit)
+}
+
+// OrderID mocks base method.
+func (m *MockListOrdersServiceAPI) OrderID(orderID int64) futures.ListOrdersServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OrderID", orderID)
+	ret0, _ := ret[0].(futures.ListOrdersServiceAPI)
+	return ret0
+}
+
+// OrderID indicates an expected call of OrderID.
+func (mr *MockListOrdersServiceAPIMockRecorder) OrderID(orderID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OrderID", reflect.TypeOf((*MockListOrdersServiceAPI)(nil).OrderID), orderID)
+}
+
+// StartTime mocks base method.
+func (m *MockListOrdersServiceAPI) StartTime(startTime int64) futures.ListOrdersServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartTime", startTime)
+	ret0, _ := ret[0].(futures.ListOrdersServiceAPI)
+	return ret0
+}
+
+// StartTime indicates an expected call of StartTime.
+func (mr *MockListOrdersServiceAPIMockRecorder) StartTime(startTime interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartTime", reflect.TypeOf((*MockListOrdersServiceAPI)(nil).StartTime), startTime)
+}
+
+// Symbol mocks base method.
+func (m *MockListOrdersServiceAPI) Symbol(symbol string) futures.ListOrdersServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Symbol", symbol)
+	ret0, _ := ret[0].(futures.ListOrdersServiceAPI)
+	return ret0
+}
+
+// Symbol indicates an expected call of Symbol.
+func (mr *MockListOrdersServiceAPIMockRecorder) Symbol(symbol interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Symbol", reflect.TypeOf((*MockListOrdersServiceAPI)(nil).Symbol), symbol)
+}
+
+// MockGetAccountServiceAPI is a mock of GetAccountServiceAPI interface.
+type MockGetAccountServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockGetAccountServiceAPIMockRecorder
+}
+
+// MockGetAccountServiceAPIMockRecorder is the mock recorder for MockGetAccountServiceAPI.
+type MockGetAccountServiceAPIMockRecorder struct {
+	mock *MockGetAccountServiceAPI
+}
+
+// NewMockGetAccountServiceAPI creates a new mock instance.
+func NewMockGetAccountServiceAPI(ctrl *gomock.Controller) *MockGetAccountServiceAPI {
+	mock := &MockGetAccountServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockGetAccountServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGetAccountServiceAPI) EXPECT() *MockGetAccountServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockGetAccountServiceAPI) Do(ctx context.Context, opts ...futures.RequestOption) (*futures.Account, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*futures.Account)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockGetAccountServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockGetAccountServiceAPI)(nil).Do), varargs...)
+}
+
+// MockGetAccountV3ServiceAPI is a mock of GetAccountV3ServiceAPI interface.
+type MockGetAccountV3ServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockGetAccountV3ServiceAPIMockRecorder
+}
+
+// MockGetAccountV3ServiceAPIMockRecorder is the mock recorder for MockGetAccountV3ServiceAPI.
+type MockGetAccountV3ServiceAPIMockRecorder struct {
+	mock *MockGetAccountV3ServiceAPI
+}
+
+// NewMockGetAccountV3ServiceAPI creates a new mock instance.
+func NewMockGetAccountV3ServiceAPI(ctrl *gomock.Controller) *MockGetAccountV3ServiceAPI {
+	mock := &MockGetAccountV3ServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockGetAccountV3ServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGetAccountV3ServiceAPI) EXPECT() *MockGetAccountV3ServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockGetAccountV3ServiceAPI) Do(ctx context.Context, opts ...futures.RequestOption) (*futures.AccountV3, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*futures.AccountV3)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockGetAccountV3ServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockGetAccountV3ServiceAPI)(nil).Do), varargs...)
+}
+
+// MockGetBalanceServiceAPI is a mock of GetBalanceServiceAPI interface.
+type MockGetBalanceServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockGetBalanceServiceAPIMockRecorder
+}
+
+// MockGetBalanceServiceAPIMockRecorder is the mock recorder for MockGetBalanceServiceAPI.
+type MockGetBalanceServiceAPIMockRecorder struct {
+	mock *MockGetBalanceServiceAPI
+}
+
+// NewMockGetBalanceServiceAPI creates a new mock instance.
+func NewMockGetBalanceServiceAPI(ctrl *gomock.Controller) *MockGetBalanceServiceAPI {
+	mock := &MockGetBalanceServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockGetBalanceServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGetBalanceServiceAPI) EXPECT() *MockGetBalanceServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockGetBalanceServiceAPI) Do(ctx context.Context, opts ...futures.RequestOption) ([]*futures.Balance, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].([]*futures.Balance)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockGetBalanceServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockGetBalanceServiceAPI)(nil).Do), varargs...)
+}
+
+// MockAccountConfigServiceAPI is a mock of AccountConfigServiceAPI interface.
+type MockAccountConfigServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockAccountConfigServiceAPIMockRecorder
+}
+
+// MockAccountConfigServiceAPIMockRecorder is the mock recorder for MockAccountConfigServiceAPI.
+type MockAccountConfigServiceAPIMockRecorder struct {
+	mock *MockAccountConfigServiceAPI
+}
+
+// NewMockAccountConfigServiceAPI creates a new mock instance.
+func NewMockAccountConfigServiceAPI(ctrl *gomock.Controller) *MockAccountConfigServiceAPI {
+	mock := &MockAccountConfigServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockAccountConfigServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAccountConfigServiceAPI) EXPECT() *MockAccountConfigServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockAccountConfigServiceAPI) Do(ctx context.Context, opts ...futures.RequestOption) (*futures.AccountConfig, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*futures.AccountConfig)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockAccountConfigServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockAccountConfigServiceAPI)(nil).Do), varargs...)
+}
+
+// MockSymbolConfigServiceAPI is a mock of SymbolConfigServiceAPI interface.
+type MockSymbolConfigServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockSymbolConfigServiceAPIMockRecorder
+}
+
+// MockSymbolConfigServiceAPIMockRecorder is the mock recorder for MockSymbolConfigServiceAPI.
+type MockSymbolConfigServiceAPIMockRecorder struct {
+	mock *MockSymbolConfigServiceAPI
+}
+
+// NewMockSymbolConfigServiceAPI creates a new mock instance.
+func NewMockSymbolConfigServiceAPI(ctrl *gomock.Controller) *MockSymbolConfigServiceAPI {
+	mock := &MockSymbolConfigServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockSymbolConfigServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSymbolConfigServiceAPI) EXPECT() *MockSymbolConfigServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockSymbolConfigServiceAPI) Do(ctx context.Context, opts ...futures.RequestOption) ([]*futures.SymbolConfig, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].([]*futures.SymbolConfig)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockSymbolConfigServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockSymbolConfigServiceAPI)(nil).Do), varargs...)
+}
+
+// Symbol mocks base method.
+func (m *MockSymbolConfigServiceAPI) Symbol(symbol string) futures.SymbolConfigServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Symbol", symbol)
+	ret0, _ := ret[0].(futures.SymbolConfigServiceAPI)
+	return ret0
+}
+
+// Symbol indicates an expected call of Symbol.
+func (mr *MockSymbolConfigServiceAPIMockRecorder) Symbol(symbol interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Symbol", reflect.TypeOf((*MockSymbolConfigServiceAPI)(nil).Symbol), symbol)
+}
+
+// MockGetPositionRiskServiceAPI is a mock of GetPositionRiskServiceAPI interface.
+type MockGetPositionRiskServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockGetPositionRiskServiceAPIMockRecorder
+}
+
+// MockGetPositionRiskServiceAPIMockRecorder is the mock recorder for MockGetPositionRiskServiceAPI.
+type MockGetPositionRiskServiceAPIMockRecorder struct {
+	mock *MockGetPositionRiskServiceAPI
+}
+
+// NewMockGetPositionRiskServiceAPI creates a new mock instance.
+func NewMockGetPositionRiskServiceAPI(ctrl *gomock.Controller) *MockGetPositionRiskServiceAPI {
+	mock := &MockGetPositionRiskServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockGetPositionRiskServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGetPositionRiskServiceAPI) EXPECT() *MockGetPositionRiskServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockGetPositionRiskServiceAPI) Do(ctx context.Context, opts ...futures.RequestOption) ([]*futures.PositionRisk, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].([]*futures.PositionRisk)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockGetPositionRiskServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockGetPositionRiskServiceAPI)(nil).Do), varargs...)
+}
+
+// Symbol mocks base method.
+func (m *MockGetPositionRiskServiceAPI) Symbol(symbol string) futures.GetPositionRiskServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Symbol", symbol)
+	ret0, _ := ret[0].(futures.GetPositionRiskServiceAPI)
+	return ret0
+}
+
+// Symbol indicates an expected call of Symbol.
+func (mr *MockGetPositionRiskServiceAPIMockRecorder) Symbol(symbol interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Symbol", reflect.TypeOf((*MockGetPositionRiskServiceAPI)(nil).Symbol), symbol)
+}
+
+// MockGetPositionRiskV3ServiceAPI is a mock of GetPositionRiskV3ServiceAPI interface.
+type MockGetPositionRiskV3ServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockGetPositionRiskV3ServiceAPIMockRecorder
+}
+
+// MockGetPositionRiskV3ServiceAPIMockRecorder is the mock recorder for MockGetPositionRiskV3ServiceAPI.
+type MockGetPositionRiskV3ServiceAPIMockRecorder struct {
+	mock *MockGetPositionRiskV3ServiceAPI
+}
+
+// NewMockGetPositionRiskV3ServiceAPI creates a new mock instance.
+func NewMockGetPositionRiskV3ServiceAPI(ctrl *gomock.Controller) *MockGetPositionRiskV3ServiceAPI {
+	mock := &MockGetPositionRiskV3ServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockGetPositionRiskV3ServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGetPositionRiskV3ServiceAPI) EXPECT() *MockGetPositionRiskV3ServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockGetPositionRiskV3ServiceAPI) Do(ctx context.Context, opts ...futures.RequestOption) ([]*futures.PositionRiskV3, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].([]*futures.PositionRiskV3)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockGetPositionRiskV3ServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockGetPositionRiskV3ServiceAPI)(nil).Do), varargs...)
+}
+
+// RecvWindow mocks base method.
+func (m *MockGetPositionRiskV3ServiceAPI) RecvWindow(rw int64) futures.GetPositionRiskV3ServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecvWindow", rw)
+	ret0, _ := ret[0].(futures.GetPositionRiskV3ServiceAPI)
+	return ret0
+}
+
+// RecvWindow indicates an expected call of RecvWindow.
+func (mr *MockGetPositionRiskV3ServiceAPIMockRecorder) RecvWindow(rw interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvWindow", reflect.TypeOf((*MockGetPositionRiskV3ServiceAPI)(nil).RecvWindow), rw)
+}
+
+// Symbol mocks base method.
+func (m *MockGetPositionRiskV3ServiceAPI) Symbol(symbol string) futures.GetPositionRiskV3ServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Symbol", symbol)
+	ret0, _ := ret[0].(futures.GetPositionRiskV3ServiceAPI)
+	return ret0
+}
+
+// Symbol indicates an expected call of Symbol.
+func (mr *MockGetPositionRiskV3ServiceAPIMockRecorder) Symbol(symbol interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Symbol", reflect.TypeOf((*MockGetPositionRiskV3ServiceAPI)(nil).Symbol), symbol)
+}
+
+// MockGetPositionMarginHistoryServiceAPI is a mock of GetPositionMarginHistoryServiceAPI interface.
+type MockGetPositionMarginHistoryServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockGetPositionMarginHistoryServiceAPIMockRecorder
+}
+
+// MockGetPositionMarginHistoryServiceAPIMockRecorder is the mock recorder for MockGetPositionMarginHistoryServiceAPI.
+type MockGetPositionMarginHistoryServiceAPIMockRecorder struct {
+	mock *MockGetPositionMarginHistoryServiceAPI
+}
+
+// NewMockGetPositionMarginHistoryServiceAPI creates a new mock instance.
+func NewMockGetPositionMarginHistoryServiceAPI(ctrl *gomock.Controller) *MockGetPositionMarginHistoryServiceAPI {
+	mock := &MockGetPositionMarginHistoryServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockGetPositionMarginHistoryServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGetPositionMarginHistoryServiceAPI) EXPECT() *MockGetPositionMarginHistoryServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockGetPositionMarginHistoryServiceAPI) Do(ctx context.Context, opts ...futures.RequestOption) ([]*futures.PositionMarginHistory, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].([]*futures.PositionMarginHistory)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockGetPositionMarginHistoryServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockGetPositionMarginHistoryServiceAPI)(nil).Do), varargs...)
+}
+
+// EndTime mocks base method.
+func (m *MockGetPositionMarginHistoryServiceAPI) EndTime(endTime int64) futures.GetPositionMarginHistoryServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EndTime", endTime)
+	ret0, _ := ret[0].(futures.GetPositionMarginHistoryServiceAPI)
+	return ret0
+}
+
+// EndTime indicates an expected call of EndTime.
+func (mr *MockGetPositionMarginHistoryServiceAPIMockRecorder) EndTime(endTime interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EndTime", reflect.TypeOf((*MockGetPositionMarginHistoryServiceAPI)(nil).EndTime), endTime)
+}
+
+// Limit mocks base method.
+func (m *MockGetPositionMarginHistoryServiceAPI) Limit(limit int64) futures.GetPositionMarginHistoryServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Limit", limit)
+	ret0, _ := ret[0].(futures.GetPositionMarginHistoryServiceAPI)
+	return ret0
+}
+
+// Limit indicates an expected call of Limit.
+func (mr *MockGetPositionMarginHistoryServiceAPIMockRecorder) Limit(limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Limit", reflect.TypeOf((*MockGetPositionMarginHistoryServiceAPI)(nil).Limit), limit)
+}
+
+// StartTime mocks base method.
+func (m *MockGetPositionMarginHistoryServiceAPI) StartTime(startTime int64) futures.GetPositionMarginHistoryServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartTime", startTime)
+	ret0, _ := ret[0].(futures.GetPositionMarginHistoryServiceAPI)
+	return ret0
+}
+
+// StartTime indicates an expected call of StartTime.
+func (mr *MockGetPositionMarginHistoryServiceAPIMockRecorder) StartTime(startTime interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartTime", reflect.TypeOf((*MockGetPositionMarginHistoryServiceAPI)(nil).StartTime), startTime)
+}
+
+// Symbol mocks base method.
+func (m *MockGetPositionMarginHistoryServiceAPI) Symbol(symbol string) futures.GetPositionMarginHistoryServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Symbol", symbol)
+	ret0, _ := ret[0].(futures.GetPositionMarginHistoryServiceAPI)
+	return ret0
+}
+
+// Symbol indicates an expected call of Symbol.
+func (mr *MockGetPositionMarginHistoryServiceAPIMockRecorder) Symbol(symbol interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Symbol", reflect.TypeOf((*MockGetPositionMarginHistoryServiceAPI)(nil).Symbol), symbol)
+}
+
+// Type mocks base method.
+func (m *MockGetPositionMarginHistoryServiceAPI) Type(_type int) futures.GetPositionMarginHistoryServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Type", _type)
+	ret0, _ := ret[0].(futures.GetPositionMarginHistoryServiceAPI)
+	return ret0
+}
+
+// Type indicates an expected call of Type.
+func (mr *MockGetPositionMarginHistoryServiceAPIMockRecorder) Type(_type interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Type", reflect.TypeOf((*MockGetPositionMarginHistoryServiceAPI)(nil).Type), _type)
+}
+
+// MockGetIncomeHistoryServiceAPI is a mock of GetIncomeHistoryServiceAPI interface.
+type MockGetIncomeHistoryServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockGetIncomeHistoryServiceAPIMockRecorder
+}
+
+// MockGetIncomeHistoryServiceAPIMockRecorder is the mock recorder for MockGetIncomeHistoryServiceAPI.
+type MockGetIncomeHistoryServiceAPIMockRecorder struct {
+	mock *MockGetIncomeHistoryServiceAPI
+}
+
+// NewMockGetIncomeHistoryServiceAPI creates a new mock instance.
+func NewMockGetIncomeHistoryServiceAPI(ctrl *gomock.Controller) *MockGetIncomeHistoryServiceAPI {
+	mock := &MockGetIncomeHistoryServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockGetIncomeHistoryServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGetIncomeHistoryServiceAPI) EXPECT() *MockGetIncomeHistoryServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockGetIncomeHistoryServiceAPI) Do(ctx context.Context, opts ...futures.RequestOption) ([]*futures.IncomeHistory, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].([]*futures.IncomeHistory)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockGetIncomeHistoryServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockGetIncomeHistoryServiceAPI)(nil).Do), varargs...)
+}
+
+// EndTime mocks base method.
+func (m *MockGetIncomeHistoryServiceAPI) EndTime(endTime int64) futures.GetIncomeHistoryServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EndTime", endTime)
+	ret0, _ := ret[0].(futures.GetIncomeHistoryServiceAPI)
+	return ret0
+}
+
+// EndTime indicates an expected call of EndTime.
+func (mr *MockGetIncomeHistoryServiceAPIMockRecorder) EndTime(endTime interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EndTime", reflect.TypeOf((*MockGetIncomeHistoryServiceAPI)(nil).EndTime), endTime)
+}
+
+// IncomeType mocks base method.
+func (m *MockGetIncomeHistoryServiceAPI) IncomeType(incomeType string) futures.GetIncomeHistoryServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IncomeType", incomeType)
+	ret0, _ := ret[0].(futures.GetIncomeHistoryServiceAPI)
+	return ret0
+}
+
+// IncomeType indicates an expected call of IncomeType.
+func (mr *MockGetIncomeHistoryServiceAPIMockRecorder) IncomeType(incomeType interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IncomeType", reflect.TypeOf((*MockGetIncomeHistoryServiceAPI)(nil).IncomeType), incomeType)
+}
+
+// Limit mocks base method.
+func (m *MockGetIncomeHistoryServiceAPI) Limit(limit int64) futures.GetIncomeHistoryServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Limit", limit)
+	ret0, _ := ret[0].(futures.GetIncomeHistoryServiceAPI)
+	return ret0
+}
+
+// Limit indicates an expected call of Limit.
+func (mr *MockGetIncomeHistoryServiceAPIMockRecorder) Limit(limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Limit", reflect.TypeOf((*MockGetIncomeHistoryServiceAPI)(nil).Limit), limit)
+}
+
+// StartTime mocks base method.
+func (m *MockGetIncomeHistoryServiceAPI) StartTime(startTime int64) futures.GetIncomeHistoryServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartTime", startTime)
+	ret0, _ := ret[0].(futures.GetIncomeHistoryServiceAPI)
+	return ret0
+}
+
+// StartTime indicates an expected call of StartTime.
+func (mr *MockGetIncomeHistoryServiceAPIMockRecorder) StartTime(startTime interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartTime", reflect.TypeOf((*MockGetIncomeHistoryServiceAPI)(nil).StartTime), startTime)
+}
+
+// Symbol mocks base method.
+func (m *MockGetIncomeHistoryServiceAPI) Symbol(symbol string) futures.GetIncomeHistoryServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Symbol", symbol)
+	ret0, _ := ret[0].(futures.GetIncomeHistoryServiceAPI)
+	return ret0
+}
+
+// Symbol indicates an expected call of Symbol.
+func (mr *MockGetIncomeHistoryServiceAPIMockRecorder) Symbol(symbol interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Symbol", reflect.TypeOf((*MockGetIncomeHistoryServiceAPI)(nil).Symbol), symbol)
+}
+
+// MockHistoricalTradesServiceAPI is a mock of HistoricalTradesServiceAPI interface.
+type MockHistoricalTradesServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockHistoricalTradesServiceAPIMockRecorder
+}
+
+// MockHistoricalTradesServiceAPIMockRecorder is the mock recorder for MockHistoricalTradesServiceAPI.
+type MockHistoricalTradesServiceAPIMockRecorder struct {
+	mock *MockHistoricalTradesServiceAPI
+}
+
+// NewMockHistoricalTradesServiceAPI creates a new mock instance.
+func NewMockHistoricalTradesServiceAPI(ctrl *gomock.Controller) *MockHistoricalTradesServiceAPI {
+	mock := &MockHistoricalTradesServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockHistoricalTradesServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockHistoricalTradesServiceAPI) EXPECT() *MockHistoricalTradesServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockHistoricalTradesServiceAPI) Do(ctx context.Context, opts ...futures.RequestOption) ([]*futures.Trade, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].([]*futures.Trade)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockHistoricalTradesServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockHistoricalTradesServiceAPI)(nil).Do), varargs...)
+}
+
+// FromID mocks base method.
+func (m *MockHistoricalTradesServiceAPI) FromID(fromID int64) futures.HistoricalTradesServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FromID", fromID)
+	ret0, _ := ret[0].(futures.HistoricalTradesServiceAPI)
+	return ret0
+}
+
+// FromID indicates an expected call of FromID.
+func (mr *MockHistoricalTradesServiceAPIMockRecorder) FromID(fromID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FromID", reflect.TypeOf((*MockHistoricalTradesServiceAPI)(nil).FromID), fromID)
+}
+
+// Limit mocks base method.
+func (m *MockHistoricalTradesServiceAPI) Limit(limit int) futures.HistoricalTradesServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Limit", limit)
+	ret0, _ := ret[0].(futures.HistoricalTradesServiceAPI)
+	return ret0
+}
+
+// Limit indicates an expected call of Limit.
+func (mr *MockHistoricalTradesServiceAPIMockRecorder) Limit(limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Limit", reflect.TypeOf((*MockHistoricalTradesServiceAPI)(nil).Limit), limit)
+}
+
+// Symbol mocks base method.
+func (m *MockHistoricalTradesServiceAPI) Symbol(symbol string) futures.HistoricalTradesServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Symbol", symbol)
+	ret0, _ := ret[0].(futures.HistoricalTradesServiceAPI)
+	return ret0
+}
+
+// Symbol indicates an expected call of Symbol.
+func (mr *MockHistoricalTradesServiceAPIMockRecorder) Symbol(symbol interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Symbol", reflect.TypeOf((*MockHistoricalTradesServiceAPI)(nil).Symbol), symbol)
+}
+
+// MockListAccountTradeServiceAPI is a mock of ListAccountTradeServiceAPI interface.
+type MockListAccountTradeServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockListAccountTradeServiceAPIMockRecorder
+}
+
+// MockListAccountTradeServiceAPIMockRecorder is the mock recorder for MockListAccountTradeServiceAPI.
+type MockListAccountTradeServiceAPIMockRecorder struct {
+	mock *MockListAccountTradeServiceAPI
+}
+
+// NewMockListAccountTradeServiceAPI creates a new mock instance.
+func NewMockListAccountTradeServiceAPI(ctrl *gomock.Controller) *MockListAccountTradeServiceAPI {
+	mock := &MockListAccountTradeServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockListAccountTradeServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockListAccountTradeServiceAPI) EXPECT() *MockListAccountTradeServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockListAccountTradeServiceAPI) Do(ctx context.Context, opts ...futures.RequestOption) ([]*futures.AccountTrade, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].([]*futures.AccountTrade)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockListAccountTradeServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockListAccountTradeServiceAPI)(nil).Do), varargs...)
+}
+
+// EndTime mocks base method.
+func (m *MockListAccountTradeServiceAPI) EndTime(endTime int64) futures.ListAccountTradeServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EndTime", endTime)
+	ret0, _ := ret[0].(futures.ListAccountTradeServiceAPI)
+	return ret0
+}
+
+// EndTime indicates an expected call of EndTime.
+func (mr *MockListAccountTradeServiceAPIMockRecorder) EndTime(endTime interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EndTime", reflect.TypeOf((*MockListAccountTradeServiceAPI)(nil).EndTime), endTime)
+}
+
+// FromID mocks base method.
+func (m *MockListAccountTradeServiceAPI) FromID(fromID int64) futures.ListAccountTradeServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FromID", fromID)
+	ret0, _ := ret[0].(futures.ListAccountTradeServiceAPI)
+	return ret0
+}
+
+// FromID indicates an expected call of FromID.
+func (mr *MockListAccountTradeServiceAPIMockRecorder) FromID(fromID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FromID", reflect.TypeOf((*MockListAccountTradeServiceAPI)(nil).FromID), fromID)
+}
+
+// Limit mocks base method.
+func (m *MockListAccountTradeServiceAPI) Limit(limit int) futures.ListAccountTradeServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Limit", limit)
+	ret0, _ := ret[0].(futures.ListAccountTradeServiceAPI)
+	return ret0
+}
+
+// Limit indicates an expected call of Limit.
+func (mr *MockListAccountTradeServiceAPIMockRecorder) Limit(limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Limit", reflect.TypeOf((*MockListAccountTradeServiceAPI)(nil).Limit), limit)
+}
+
+// OrderID mocks base method.
+func (m *MockListAccountTradeServiceAPI) OrderID(orderID int64) futures.ListAccountTradeServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OrderID", orderID)
+	ret0, _ := ret[0].(futures.ListAccountTradeServiceAPI)
+	return ret0
+}
+
+// OrderID indicates an expected call of OrderID.
+func (mr *MockListAccountTradeServiceAPIMockRecorder) OrderID(orderID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OrderID", reflect.TypeOf((*MockListAccountTradeServiceAPI)(nil).OrderID), orderID)
+}
+
+// StartTime mocks base method.
+func (m *MockListAccountTradeServiceAPI) StartTime(startTime int64) futures.ListAccountTradeServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartTime", startTime)
+	ret0, _ := ret[0].(futures.ListAccountTradeServiceAPI)
+	return ret0
+}
+
+// StartTime indicates an expected call of StartTime.
+func (mr *MockListAccountTradeServiceAPIMockRecorder) StartTime(startTime interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartTime", reflect.TypeOf((*MockListAccountTradeServiceAPI)(nil).StartTime), startTime)
+}
+
+// Symbol mocks base method.
+func (m *MockListAccountTradeServiceAPI) Symbol(symbol string) futures.ListAccountTradeServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Symbol", symbol)
+	ret0, _ := ret[0].(futures.ListAccountTradeServiceAPI)
+	return ret0
+}
+
+// Symbol indicates an expected call of Symbol.
+func (mr *MockListAccountTradeServiceAPIMockRecorder) Symbol(symbol interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Symbol", reflect.TypeOf((*MockListAccountTradeServiceAPI)(nil).Symbol), symbol)
+}
+
+// MockStartUserStreamServiceAPI is a mock of StartUserStreamServiceAPI interface.
+type MockStartUserStreamServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockStartUserStreamServiceAPIMockRecorder
+}
+
+// MockStartUserStreamServiceAPIMockRecorder is the mock recorder for MockStartUserStreamServiceAPI.
+type MockStartUserStreamServiceAPIMockRecorder struct {
+	mock *MockStartUserStreamServiceAPI
+}
+
+// NewMockStartUserStreamServiceAPI creates a new mock instance.
+func NewMockStartUserStreamServiceAPI(ctrl *gomock.Controller) *MockStartUserStreamServiceAPI {
+	mock := &MockStartUserStreamServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockStartUserStreamServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockStartUserStreamServiceAPI) EXPECT() *MockStartUserStreamServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockStartUserStreamServiceAPI) Do(ctx context.Context, opts ...futures.RequestOption) (string, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockStartUserStreamServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockStartUserStreamServiceAPI)(nil).Do), varargs...)
+}
+
+// MockKeepaliveUserStreamServiceAPI is a mock of KeepaliveUserStreamServiceAPI interface.
+type MockKeepaliveUserStreamServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockKeepaliveUserStreamServiceAPIMockRecorder
+}
+
+// MockKeepaliveUserStreamServiceAPIMockRecorder is the mock recorder for MockKeepaliveUserStreamServiceAPI.
+type MockKeepaliveUserStreamServiceAPIMockRecorder struct {
+	mock *MockKeepaliveUserStreamServiceAPI
+}
+
+// NewMockKeepaliveUserStreamServiceAPI creates a new mock instance.
+func NewMockKeepaliveUserStreamServiceAPI(ctrl *gomock.Controller) *MockKeepaliveUserStreamServiceAPI {
+	mock := &MockKeepaliveUserStreamServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockKeepaliveUserStreamServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockKeepaliveUserStreamServiceAPI) EXPECT() *MockKeepaliveUserStreamServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockKeepaliveUserStreamServiceAPI) Do(ctx context.Context, opts ...futures.RequestOption) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockKeepaliveUserStreamServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockKeepaliveUserStreamServiceAPI)(nil).Do), varargs...)
+}
+
+// ListenKey mocks base method.
+func (m *MockKeepaliveUserStreamServiceAPI) ListenKey(listenKey string) futures.KeepaliveUserStreamServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListenKey", listenKey)
+	ret0, _ := ret[0].(futures.KeepaliveUserStreamServiceAPI)
+	return ret0
+}
+
+// ListenKey indicates an expected call of ListenKey.
+func (mr *MockKeepaliveUserStreamServiceAPIMockRecorder) ListenKey(listenKey interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListenKey", reflect.TypeOf((*MockKeepaliveUserStreamServiceAPI)(nil).ListenKey), listenKey)
+}
+
+// MockCloseUserStreamServiceAPI is a mock of CloseUserStreamServiceAPI interface.
+type MockCloseUserStreamServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockCloseUserStreamServiceAPIMockRecorder
+}
+
+// MockCloseUserStreamServiceAPIMockRecorder is the mock recorder for MockCloseUserStreamServiceAPI.
+type MockCloseUserStreamServiceAPIMockRecorder struct {
+	mock *MockCloseUserStreamServiceAPI
+}
+
+// NewMockCloseUserStreamServiceAPI creates a new mock instance.
+func NewMockCloseUserStreamServiceAPI(ctrl *gomock.Controller) *MockCloseUserStreamServiceAPI {
+	mock := &MockCloseUserStreamServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockCloseUserStreamServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCloseUserStreamServiceAPI) EXPECT() *MockCloseUserStreamServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockCloseUserStreamServiceAPI) Do(ctx context.Context, opts ...futures.RequestOption) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockCloseUserStreamServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockCloseUserStreamServiceAPI)(nil).Do), varargs...)
+}
+
+// ListenKey mocks base method.
+func (m *MockCloseUserStreamServiceAPI) ListenKey(listenKey string) futures.CloseUserStreamServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListenKey", listenKey)
+	ret0, _ := ret[0].(futures.CloseUserStreamServiceAPI)
+	return ret0
+}
+
+// ListenKey indicates an expected call of ListenKey.
+func (mr *MockCloseUserStreamServiceAPIMockRecorder) ListenKey(listenKey interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListenKey", reflect.TypeOf((*MockCloseUserStreamServiceAPI)(nil).ListenKey), listenKey)
+}
+
+// MockExchangeInfoServiceAPI is a mock of ExchangeInfoServiceAPI interface.
+type MockExchangeInfoServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockExchangeInfoServiceAPIMockRecorder
+}
+
+// MockExchangeInfoServiceAPIMockRecorder is the mock recorder for MockExchangeInfoServiceAPI.
+type MockExchangeInfoServiceAPIMockRecorder struct {
+	mock *MockExchangeInfoServiceAPI
+}
+
+// NewMockExchangeInfoServiceAPI creates a new mock instance.
+func NewMockExchangeInfoServiceAPI(ctrl *gomock.Controller) *MockExchangeInfoServiceAPI {
+	mock := &MockExchangeInfoServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockExchangeInfoServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockExchangeInfoServiceAPI) EXPECT() *MockExchangeInfoServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockExchangeInfoServiceAPI) Do(ctx context.Context, opts ...futures.RequestOption) (*futures.ExchangeInfo, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*futures.ExchangeInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockExchangeInfoServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockExchangeInfoServiceAPI)(nil).Do), varargs...)
+}
+
+// MockPremiumIndexServiceAPI is a mock of PremiumIndexServiceAPI interface.
+type MockPremiumIndexServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockPremiumIndexServiceAPIMockRecorder
+}
+
+// MockPremiumIndexServiceAPIMockRecorder is the mock recorder for MockPremiumIndexServiceAPI.
+type MockPremiumIndexServiceAPIMockRecorder struct {
+	mock *MockPremiumIndexServiceAPI
+}
+
+// NewMockPremiumIndexServiceAPI creates a new mock instance.
+func NewMockPremiumIndexServiceAPI(ctrl *gomock.Controller) *MockPremiumIndexServiceAPI {
+	mock := &MockPremiumIndexServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockPremiumIndexServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPremiumIndexServiceAPI) EXPECT() *MockPremiumIndexServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockPremiumIndexServiceAPI) Do(ctx context.Context, opts ...futures.RequestOption) ([]*futures.PremiumIndex, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].([]*futures.PremiumIndex)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockPremiumIndexServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockPremiumIndexServiceAPI)(nil).Do), varargs...)
+}
+
+// Symbol mocks base method.
+func (m *MockPremiumIndexServiceAPI) Symbol(symbol string) futures.PremiumIndexServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Symbol", symbol)
+	ret0, _ := ret[0].(futures.PremiumIndexServiceAPI)
+	return ret0
+}
+
+// Symbol indicates an expected call of Symbol.
+func (mr *MockPremiumIndexServiceAPIMockRecorder) Symbol(symbol interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Symbol", reflect.TypeOf((*MockPremiumIndexServiceAPI)(nil).Symbol), symbol)
+}
+
+// MockPremiumIndexKlinesServiceAPI is a mock of PremiumIndexKlinesServiceAPI interface.
+type MockPremiumIndexKlinesServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockPremiumIndexKlinesServiceAPIMockRecorder
+}
+
+// MockPremiumIndexKlinesServiceAPIMockRecorder is the mock recorder for MockPremiumIndexKlinesServiceAPI.
+type MockPremiumIndexKlinesServiceAPIMockRecorder struct {
+	mock *MockPremiumIndexKlinesServiceAPI
+}
+
+// NewMockPremiumIndexKlinesServiceAPI creates a new mock instance.
+func NewMockPremiumIndexKlinesServiceAPI(ctrl *gomock.Controller) *MockPremiumIndexKlinesServiceAPI {
+	mock := &MockPremiumIndexKlinesServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockPremiumIndexKlinesServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPremiumIndexKlinesServiceAPI) EXPECT() *MockPremiumIndexKlinesServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockPremiumIndexKlinesServiceAPI) Do(ctx context.Context, opts ...futures.RequestOption) ([]*futures.Kline, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].([]*futures.Kline)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockPremiumIndexKlinesServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockPremiumIndexKlinesServiceAPI)(nil).Do), varargs...)
+}
+
+// EndTime mocks base method.
+func (m *MockPremiumIndexKlinesServiceAPI) EndTime(endTime int64) futures.PremiumIndexKlinesServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EndTime", endTime)
+	ret0, _ := ret[0].(futures.PremiumIndexKlinesServiceAPI)
+	return ret0
+}
+
+// EndTime indicates an expected call of EndTime.
+func (mr *MockPremiumIndexKlinesServiceAPIMockRecorder) EndTime(endTime interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EndTime", reflect.TypeOf((*MockPremiumIndexKlinesServiceAPI)(nil).EndTime), endTime)
+}
+
+// Interval mocks base method.
+func (m *MockPremiumIndexKlinesServiceAPI) Interval(interval string) futures.PremiumIndexKlinesServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Interval", interval)
+	ret0, _ := ret[0].(futures.PremiumIndexKlinesServiceAPI)
+	return ret0
+}
+
+// Interval indicates an expected call of Interval.
+func (mr *MockPremiumIndexKlinesServiceAPIMockRecorder) Interval(interval interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Interval", reflect.TypeOf((*MockPremiumIndexKlinesServiceAPI)(nil).Interval), interval)
+}
+
+// Limit mocks base method.
+func (m *MockPremiumIndexKlinesServiceAPI) Limit(limit int) futures.PremiumIndexKlinesServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Limit", limit)
+	ret0, _ := ret[0].(futures.PremiumIndexKlinesServiceAPI)
+	return ret0
+}
+
+// Limit indicates an expected call of Limit.
+func (mr *MockPremiumIndexKlinesServiceAPIMockRecorder) Limit(limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Limit", reflect.TypeOf((*MockPremiumIndexKlinesServiceAPI)(nil).Limit), limit)
+}
+
+// StartTime mocks base method.
+func (m *MockPremiumIndexKlinesServiceAPI) StartTime(startTime int64) futures.PremiumIndexKlinesServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartTime", startTime)
+	ret0, _ := ret[0].(futures.PremiumIndexKlinesServiceAPI)
+	return ret0
+}
+
+// StartTime indicates an expected call of StartTime.
+func (mr *MockPremiumIndexKlinesServiceAPIMockRecorder) StartTime(startTime interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartTime", reflect.TypeOf((*MockPremiumIndexKlinesServiceAPI)(nil).StartTime), startTime)
+}
+
+// Symbol mocks base method.
+func (m *MockPremiumIndexKlinesServiceAPI) Symbol(symbol string) futures.PremiumIndexKlinesServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Symbol", symbol)
+	ret0, _ := ret[0].(futures.PremiumIndexKlinesServiceAPI)
+	return ret0
+}
+
+// Symbol indicates an expected call of Symbol.
+func (mr *MockPremiumIndexKlinesServiceAPIMockRecorder) Symbol(symbol interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Symbol", reflect.TypeOf((*MockPremiumIndexKlinesServiceAPI)(nil).Symbol), symbol)
+}
+
+// MockFundingRateServiceAPI is a mock of FundingRateServiceAPI interface.
+type MockFundingRateServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockFundingRateServiceAPIMockRecorder
+}
+
+// MockFundingRateServiceAPIMockRecorder is the mock recorder for MockFundingRateServiceAPI.
+type MockFundingRateServiceAPIMockRecorder struct {
+	mock *MockFundingRateServiceAPI
+}
+
+// NewMockFundingRateServiceAPI creates a new mock instance.
+func NewMockFundingRateServiceAPI(ctrl *gomock.Controller) *MockFundingRateServiceAPI {
+	mock := &MockFundingRateServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockFundingRateServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockFundingRateServiceAPI) EXPECT() *MockFundingRateServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockFundingRateServiceAPI) Do(ctx context.Context, opts ...futures.RequestOption) ([]*futures.FundingRate, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].([]*futures.FundingRate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockFundingRateServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockFundingRateServiceAPI)(nil).Do), varargs...)
+}
+
+// EndTime mocks base method.
+func (m *MockFundingRateServiceAPI) EndTime(endTime int64) futures.FundingRateServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EndTime", endTime)
+	ret0, _ := ret[0].(futures.FundingRateServiceAPI)
+	return ret0
+}
+
+// EndTime indicates an expected call of EndTime.
+func (mr *MockFundingRateServiceAPIMockRecorder) EndTime(endTime interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EndTime", reflect.TypeOf((*MockFundingRateServiceAPI)(nil).EndTime), endTime)
+}
+
+// Limit mocks base method.
+func (m *MockFundingRateServiceAPI) Limit(limit int) futures.FundingRateServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Limit", limit)
+	ret0, _ := ret[0].(futures.FundingRateServiceAPI)
+	return ret0
+}
+
+// Limit indicates an expected call of Limit.
+func (mr *MockFundingRateServiceAPIMockRecorder) Limit(limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Limit", reflect.TypeOf((*MockFundingRateServiceAPI)(nil).Limit), limit)
+}
+
+// StartTime mocks base method.
+func (m *MockFundingRateServiceAPI) StartTime(startTime int64) futures.FundingRateServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartTime", startTime)
+	ret0, _ := ret[0].(futures.FundingRateServiceAPI)
+	return ret0
+}
+
+// StartTime indicates an expected call of StartTime.
+func (mr *MockFundingRateServiceAPIMockRecorder) StartTime(startTime interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartTime", reflect.TypeOf((*MockFundingRateServiceAPI)(nil).StartTime), startTime)
+}
+
+// Symbol mocks base method.
+func (m *MockFundingRateServiceAPI) Symbol(symbol string) futures.FundingRateServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Symbol", symbol)
+	ret0, _ := ret[0].(futures.FundingRateServiceAPI)
+	return ret0
+}
+
+// Symbol indicates an expected call of Symbol.
+func (mr *MockFundingRateServiceAPIMockRecorder) Symbol(symbol interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Symbol", reflect.TypeOf((*MockFundingRateServiceAPI)(nil).Symbol), symbol)
+}
+
+// MockFundingRateInfoServiceAPI is a mock of FundingRateInfoServiceAPI interface.
+type MockFundingRateInfoServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockFundingRateInfoServiceAPIMockRecorder
+}
+
+// MockFundingRateInfoServiceAPIMockRecorder is the mock recorder for MockFundingRateInfoServiceAPI.
+type MockFundingRateInfoServiceAPIMockRecorder struct {
+	mock *MockFundingRateInfoServiceAPI
+}
+
+// NewMockFundingRateInfoServiceAPI creates a new mock instance.
+func NewMockFundingRateInfoServiceAPI(ctrl *gomock.Controller) *MockFundingRateInfoServiceAPI {
+	mock := &MockFundingRateInfoServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockFundingRateInfoServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockFundingRateInfoServiceAPI) EXPECT() *MockFundingRateInfoServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockFundingRateInfoServiceAPI) Do(ctx context.Context, opts ...futures.RequestOption) ([]*futures.FundingRateInfo, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].([]*futures.FundingRateInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockFundingRateInfoServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockFundingRateInfoServiceAPI)(nil).Do), varargs...)
+}
+
+// MockListUserLiquidationOrdersServiceAPI is a mock of ListUserLiquidationOrdersServiceAPI interface.
+type MockListUserLiquidationOrdersServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockListUserLiquidationOrdersServiceAPIMockRecorder
+}
+
+// MockListUserLiquidationOrdersServiceAPIMockRecorder is the mock recorder for MockListUserLiquidationOrdersServiceAPI.
+type MockListUserLiquidationOrdersServiceAPIMockRecorder struct {
+	mock *MockListUserLiquidationOrdersServiceAPI
+}
+
+// NewMockListUserLiquidationOrdersServiceAPI creates a new mock instance.
+func NewMockListUserLiquidationOrdersServiceAPI(ctrl *gomock.Controller) *MockListUserLiquidationOrdersServiceAPI {
+	mock := &MockListUserLiquidationOrdersServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockListUserLiquidationOrdersServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockListUserLiquidationOrdersServiceAPI) EXPECT() *MockListUserLiquidationOrdersServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// AutoCloseType mocks base method.
+func (m *MockListUserLiquidationOrdersServiceAPI) AutoCloseType(autoCloseType futures.ForceOrderCloseType) futures.ListUserLiquidationOrdersServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AutoCloseType", autoCloseType)
+	ret0, _ := ret[0].(futures.ListUserLiquidationOrdersServiceAPI)
+	return ret0
+}
+
+// AutoCloseType indicates an expected call of AutoCloseType.
+func (mr *MockListUserLiquidationOrdersServiceAPIMockRecorder) AutoCloseType(autoCloseType interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AutoCloseType", reflect.TypeOf((*MockListUserLiquidationOrdersServiceAPI)(nil).AutoCloseType), autoCloseType)
+}
+
+// Do mocks base method.
+func (m *MockListUserLiquidationOrdersServiceAPI) Do(ctx context.Context, opts ...futures.RequestOption) ([]*futures.UserLiquidationOrder, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].([]*futures.UserLiquidationOrder)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockListUserLiquidationOrdersServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockListUserLiquidationOrdersServiceAPI)(nil).Do), varargs...)
+}
+
+// EndTime mocks base method.
+func (m *MockListUserLiquidationOrdersServiceAPI) EndTime(endTime int64) futures.ListUserLiquidationOrdersServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EndTime", endTime)
+	ret0, _ := ret[0].(futures.ListUserLiquidationOrdersServiceAPI)
+	return ret0
+}
+
+// EndTime indicates an expected call of EndTime.
+func (mr *MockListUserLiquidationOrdersServiceAPIMockRecorder) EndTime(endTime interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EndTime", reflect.TypeOf((*MockListUserLiquidationOrdersServiceAPI)(nil).EndTime), endTime)
+}
+
+// Limit mocks base method.
+func (m *MockListUserLiquidationOrdersServiceAPI) Limit(limit int) futures.ListUserLiquidationOrdersServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Limit", limit)
+	ret0, _ := ret[0].(futures.ListUserLiquidationOrdersServiceAPI)
+	return ret0
+}
+
+// Limit indicates an expected call of Limit.
+func (mr *MockListUserLiquidationOrdersServiceAPIMockRecorder) Limit(limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Limit", reflect.TypeOf((*MockListUserLiquidationOrdersServiceAPI)(nil).Limit), limit)
+}
+
+// StartTime mocks base method.
+func (m *MockListUserLiquidationOrdersServiceAPI) StartTime(startTime int64) futures.ListUserLiquidationOrdersServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartTime", startTime)
+	ret0, _ := ret[0].(futures.ListUserLiquidationOrdersServiceAPI)
+	return ret0
+}
+
+// StartTime indicates an expected call of StartTime.
+func (mr *MockListUserLiquidationOrdersServiceAPIMockRecorder) StartTime(startTime interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartTime", reflect.TypeOf((*MockListUserLiquidationOrdersServiceAPI)(nil).StartTime), startTime)
+}
+
+// Symbol mocks base method.
+func (m *MockListUserLiquidationOrdersServiceAPI) Symbol(symbol string) futures.ListUserLiquidationOrdersServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Symbol", symbol)
+	ret0, _ := ret[0].(futures.ListUserLiquidationOrdersServiceAPI)
+	return ret0
+}
+
+// Symbol indicates an expected call of Symbol.
+func (mr *MockListUserLiquidationOrdersServiceAPIMockRecorder) Symbol(symbol interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Symbol", reflect.TypeOf((*MockListUserLiquidationOrdersServiceAPI)(nil).Symbol), symbol)
+}
+
+// MockListLiquidationOrdersServiceAPI is a mock of ListLiquidationOrdersServiceAPI interface.
+type MockListLiquidationOrdersServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockListLiquidationOrdersServiceAPIMockRecorder
+}
+
+// MockListLiquidationOrdersServiceAPIMockRecorder is the mock recorder for MockListLiquidationOrdersServiceAPI.
+type MockListLiquidationOrdersServiceAPIMockRecorder struct {
+	mock *MockListLiquidationOrdersServiceAPI
+}
+
+// NewMockListLiquidationOrdersServiceAPI creates a new mock instance.
+func NewMockListLiquidationOrdersServiceAPI(ctrl *gomock.Controller) *MockListLiquidationOrdersServiceAPI {
+	mock := &MockListLiquidationOrdersServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockListLiquidationOrdersServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockListLiquidationOrdersServiceAPI) EXPECT() *MockListLiquidationOrdersServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockListLiquidationOrdersServiceAPI) Do(ctx context.Context, opts ...futures.RequestOption) ([]*futures.LiquidationOrder, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].([]*futures.LiquidationOrder)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockListLiquidationOrdersServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockListLiquidationOrdersServiceAPI)(nil).Do), varargs...)
+}
+
+// EndTime mocks base method.
+func (m *MockListLiquidationOrdersServiceAPI) EndTime(endTime int64) futures.ListLiquidationOrdersServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EndTime", endTime)
+	ret0, _ := ret[0].(futures.ListLiquidationOrdersServiceAPI)
+	return ret0
+}
+
+// EndTime indicates an expected call of EndTime.
+func (mr *MockListLiquidationOrdersServiceAPIMockRecorder) EndTime(endTime interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EndTime", reflect.TypeOf((*MockListLiquidationOrdersServiceAPI)(nil).EndTime), endTime)
+}
+
+// Limit mocks base method.
+func (m *MockListLiquidationOrdersServiceAPI) Limit(limit int) futures.ListLiquidationOrdersServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Limit", limit)
+	ret0, _ := ret[0].(futures.ListLiquidationOrdersServiceAPI)
+	return ret0
+}
+
+// Limit indicates an expected call of Limit.
+func (mr *MockListLiquidationOrdersServiceAPIMockRecorder) Limit(limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Limit", reflect.TypeOf((*MockListLiquidationOrdersServiceAPI)(nil).Limit), limit)
+}
+
+// StartTime mocks base method.
+func (m *MockListLiquidationOrdersServiceAPI) StartTime(startTime int64) futures.ListLiquidationOrdersServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartTime", startTime)
+	ret0, _ := ret[0].(futures.ListLiquidationOrdersServiceAPI)
+	return ret0
+}
+
+// StartTime indicates an expected call of StartTime.
+func (mr *MockListLiquidationOrdersServiceAPIMockRecorder) StartTime(startTime interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartTime", reflect.TypeOf((*MockListLiquidationOrdersServiceAPI)(nil).StartTime), startTime)
+}
+
+// Symbol mocks base method.
+func (m *MockListLiquidationOrdersServiceAPI) Symbol(symbol string) futures.ListLiquidationOrdersServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Symbol", symbol)
+	ret0, _ := ret[0].(futures.ListLiquidationOrdersServiceAPI)
+	return ret0
+}
+
+// Symbol indicates an expected call of Symbol.
+func (mr *MockListLiquidationOrdersServiceAPIMockRecorder) Symbol(symbol interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Symbol", reflect.TypeOf((*MockListLiquidationOrdersServiceAPI)(nil).Symbol), symbol)
+}
+
+// MockChangeLeverageServiceAPI is a mock of ChangeLeverageServiceAPI interface.
+type MockChangeLeverageServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockChangeLeverageServiceAPIMockRecorder
+}
+
+// MockChangeLeverageServiceAPIMockRecorder is the mock recorder for MockChangeLeverageServiceAPI.
+type MockChangeLeverageServiceAPIMockRecorder struct {
+	mock *MockChangeLeverageServiceAPI
+}
+
+// NewMockChangeLeverageServiceAPI creates a new mock instance.
+func NewMockChangeLeverageServiceAPI(ctrl *gomock.Controller) *MockChangeLeverageServiceAPI {
+	mock := &MockChangeLeverageServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockChangeLeverageServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockChangeLeverageServiceAPI) EXPECT() *MockChangeLeverageServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockChangeLeverageServiceAPI) Do(ctx context.Context, opts ...futures.RequestOption) (*futures.SymbolLeverage, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*futures.SymbolLeverage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockChangeLeverageServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockChangeLeverageServiceAPI)(nil).Do), varargs...)
+}
+
+// Leverage mocks base method.
+func (m *MockChangeLeverageServiceAPI) Leverage(leverage int) futures.ChangeLeverageServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Leverage", leverage)
+	ret0, _ := ret[0].(futures.ChangeLeverageServiceAPI)
+	return ret0
+}
+
+// Leverage indicates an expected call of Leverage.
+func (mr *MockChangeLeverageServiceAPIMockRecorder) Leverage(leverage interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Leverage", reflect.TypeOf((*MockChangeLeverageServiceAPI)(nil).Leverage), leverage)
+}
+
+// Symbol mocks base method.
+func (m *MockChangeLeverageServiceAPI) Symbol(symbol string) futures.ChangeLeverageServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Symbol", symbol)
+	ret0, _ := ret[0].(futures.ChangeLeverageServiceAPI)
+	return ret0
+}
+
+// Symbol indicates an expected call of Symbol.
+func (mr *MockChangeLeverageServiceAPIMockRecorder) Symbol(symbol interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Symbol", reflect.TypeOf((*MockChangeLeverageServiceAPI)(nil).Symbol), symbol)
+}
+
+// MockGetLeverageBracketServiceAPI is a mock of GetLeverageBracketServiceAPI interface.
+type MockGetLeverageBracketServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockGetLeverageBracketServiceAPIMockRecorder
+}
+
+// MockGetLeverageBracketServiceAPIMockRecorder is the mock recorder for MockGetLeverageBracketServiceAPI.
+type MockGetLeverageBracketServiceAPIMockRecorder struct {
+	mock *MockGetLeverageBracketServiceAPI
+}
+
+// NewMockGetLeverageBracketServiceAPI creates a new mock instance.
+func NewMockGetLeverageBracketServiceAPI(ctrl *gomock.Controller) *MockGetLeverageBracketServiceAPI {
+	mock := &MockGetLeverageBracketServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockGetLeverageBracketServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGetLeverageBracketServiceAPI) EXPECT() *MockGetLeverageBracketServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockGetLeverageBracketServiceAPI) Do(ctx context.Context, opts ...futures.RequestOption) ([]*futures.LeverageBracket, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].([]*futures.LeverageBracket)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockGetLeverageBracketServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockGetLeverageBracketServiceAPI)(nil).Do), varargs...)
+}
+
+// Symbol mocks base method.
+func (m *MockGetLeverageBracketServiceAPI) Symbol(symbol string) futures.GetLeverageBracketServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Symbol", symbol)
+	ret0, _ := ret[0].(futures.GetLeverageBracketServiceAPI)
+	return ret0
+}
+
+// Symbol indicates an expected call of Symbol.
+func (mr *MockGetLeverageBracketServiceAPIMockRecorder) Symbol(symbol interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Symbol", reflect.TypeOf((*MockGetLeverageBracketServiceAPI)(nil).Symbol), symbol)
+}
+
+// MockChangeMarginTypeServiceAPI is a mock of ChangeMarginTypeServiceAPI interface.
+type MockChangeMarginTypeServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockChangeMarginTypeServiceAPIMockRecorder
+}
+
+// MockChangeMarginTypeServiceAPIMockRecorder is the mock recorder for MockChangeMarginTypeServiceAPI.
+type MockChangeMarginTypeServiceAPIMockRecorder struct {
+	mock *MockChangeMarginTypeServiceAPI
+}
+
+// NewMockChangeMarginTypeServiceAPI creates a new mock instance.
+func NewMockChangeMarginTypeServiceAPI(ctrl *gomock.Controller) *MockChangeMarginTypeServiceAPI {
+	mock := &MockChangeMarginTypeServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockChangeMarginTypeServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockChangeMarginTypeServiceAPI) EXPECT() *MockChangeMarginTypeServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockChangeMarginTypeServiceAPI) Do(ctx context.Context, opts ...futures.RequestOption) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockChangeMarginTypeServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockChangeMarginTypeServiceAPI)(nil).Do), varargs...)
+}
+
+// MarginType mocks base method.
+func (m *MockChangeMarginTypeServiceAPI) MarginType(marginType futures.MarginType) futures.ChangeMarginTypeServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarginType", marginType)
+	ret0, _ := ret[0].(futures.ChangeMarginTypeServiceAPI)
+	return ret0
+}
+
+// MarginType indicates an expected call of MarginType.
+func (mr *MockChangeMarginTypeServiceAPIMockRecorder) MarginType(marginType interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarginType", reflect.TypeOf((*MockChangeMarginTypeServiceAPI)(nil).MarginType), marginType)
+}
+
+// Symbol mocks base method.
+func (m *MockChangeMarginTypeServiceAPI) Symbol(symbol string) futures.ChangeMarginTypeServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Symbol", symbol)
+	ret0, _ := ret[0].(futures.ChangeMarginTypeServiceAPI)
+	return ret0
+}
+
+// Symbol indicates an expected call of Symbol.
+func (mr *MockChangeMarginTypeServiceAPIMockRecorder) Symbol(symbol interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Symbol", reflect.TypeOf((*MockChangeMarginTypeServiceAPI)(nil).Symbol), symbol)
+}
+
+// MockUpdatePositionMarginServiceAPI is a mock of UpdatePositionMarginServiceAPI interface.
+type MockUpdatePositionMarginServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockUpdatePositionMarginServiceAPIMockRecorder
+}
+
+// MockUpdatePositionMarginServiceAPIMockRecorder is the mock recorder for MockUpdatePositionMarginServiceAPI.
+type MockUpdatePositionMarginServiceAPIMockRecorder struct {
+	mock *MockUpdatePositionMarginServiceAPI
+}
+
+// NewMockUpdatePositionMarginServiceAPI creates a new mock instance.
+func NewMockUpdatePositionMarginServiceAPI(ctrl *gomock.Controller) *MockUpdatePositionMarginServiceAPI {
+	mock := &MockUpdatePositionMarginServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockUpdatePositionMarginServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUpdatePositionMarginServiceAPI) EXPECT() *MockUpdatePositionMarginServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Amount mocks base method.
+func (m *MockUpdatePositionMarginServiceAPI) Amount(amount string) futures.UpdatePositionMarginServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Amount", amount)
+	ret0, _ := ret[0].(futures.UpdatePositionMarginServiceAPI)
+	return ret0
+}
+
+// Amount indicates an expected call of Amount.
+func (mr *MockUpdatePositionMarginServiceAPIMockRecorder) Amount(amount interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Amount", reflect.TypeOf((*MockUpdatePositionMarginServiceAPI)(nil).Amount), amount)
+}
+
+// Do mocks base method.
+func (m *MockUpdatePositionMarginServiceAPI) Do(ctx context.Context, opts ...futures.RequestOption) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockUpdatePositionMarginServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockUpdatePositionMarginServiceAPI)(nil).Do), varargs...)
+}
+
+// PositionSide mocks base method.
+func (m *MockUpdatePositionMarginServiceAPI) PositionSide(positionSide futures.PositionSideType) futures.UpdatePositionMarginServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PositionSide", positionSide)
+	ret0, _ := ret[0].(futures.UpdatePositionMarginServiceAPI)
+	return ret0
+}
+
+// PositionSide indicates an expected call of PositionSide.
+func (mr *MockUpdatePositionMarginServiceAPIMockRecorder) PositionSide(positionSide interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PositionSide", reflect.TypeOf((*MockUpdatePositionMarginServiceAPI)(nil).PositionSide), positionSide)
+}
+
+// Symbol mocks base method.
+func (m *MockUpdatePositionMarginServiceAPI) Symbol(symbol string) futures.UpdatePositionMarginServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Symbol", symbol)
+	ret0, _ := ret[0].(futures.UpdatePositionMarginServiceAPI)
+	return ret0
+}
+
+// Symbol indicates an expected call of Symbol.
+func (mr *MockUpdatePositionMarginServiceAPIMockRecorder) Symbol(symbol interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Symbol", reflect.TypeOf((*MockUpdatePositionMarginServiceAPI)(nil).Symbol), symbol)
+}
+
+// Type mocks base method.
+func (m *MockUpdatePositionMarginServiceAPI) Type(actionType int) futures.UpdatePositionMarginServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Type", actionType)
+	ret0, _ := ret[0].(futures.UpdatePositionMarginServiceAPI)
+	return ret0
+}
+
+// Type indicates an expected call of Type.
+func (mr *MockUpdatePositionMarginServiceAPIMockRecorder) Type(actionType interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Type", reflect.TypeOf((*MockUpdatePositionMarginServiceAPI)(nil).Type), actionType)
+}
+
+// MockChangePositionModeServiceAPI is a mock of ChangePositionModeServiceAPI interface.
+type MockChangePositionModeServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockChangePositionModeServiceAPIMockRecorder
+}
+
+// MockChangePositionModeServiceAPIMockRecorder is the mock recorder for MockChangePositionModeServiceAPI.
+type MockChangePositionModeServiceAPIMockRecorder struct {
+	mock *MockChangePositionModeServiceAPI
+}
+
+// NewMockChangePositionModeServiceAPI creates a new mock instance.
+func NewMockChangePositionModeServiceAPI(ctrl *gomock.Controller) *MockChangePositionModeServiceAPI {
+	mock := &MockChangePositionModeServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockChangePositionModeServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockChangePositionModeServiceAPI) EXPECT() *MockChangePositionModeServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockChangePositionModeServiceAPI) Do(ctx context.Context, opts ...futures.RequestOption) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockChangePositionModeServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockChangePositionModeServiceAPI)(nil).Do), varargs...)
+}
+
+// DualSide mocks base method.
+func (m *MockChangePositionModeServiceAPI) DualSide(dualSide bool) futures.ChangePositionModeServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DualSide", dualSide)
+	ret0, _ := ret[0].(futures.ChangePositionModeServiceAPI)
+	return ret0
+}
+
+// DualSide indicates an expected call of DualSide.
+func (mr *MockChangePositionModeServiceAPIMockRecorder) DualSide(dualSide interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DualSide", reflect.TypeOf((*MockChangePositionModeServiceAPI)(nil).DualSide), dualSide)
+}
+
+// MockGetPositionModeServiceAPI is a mock of GetPositionModeServiceAPI interface.
+type MockGetPositionModeServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockGetPositionModeServiceAPIMockRecorder
+}
+
+// MockGetPositionModeServiceAPIMockRecorder is the mock recorder for MockGetPositionModeServiceAPI.
+type MockGetPositionModeServiceAPIMockRecorder struct {
+	mock *MockGetPositionModeServiceAPI
+}
+
+// NewMockGetPositionModeServiceAPI creates a new mock instance.
+func NewMockGetPositionModeServiceAPI(ctrl *gomock.Controller) *MockGetPositionModeServiceAPI {
+	mock := &MockGetPositionModeServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockGetPositionModeServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGetPositionModeServiceAPI) EXPECT() *MockGetPositionModeServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockGetPositionModeServiceAPI) Do(ctx context.Context, opts ...futures.RequestOption) (*futures.PositionMode, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*futures.PositionMode)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockGetPositionModeServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockGetPositionModeServiceAPI)(nil).Do), varargs...)
+}
+
+// MockChangeMultiAssetModeServiceAPI is a mock of ChangeMultiAssetModeServiceAPI interface.
+type MockChangeMultiAssetModeServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockChangeMultiAssetModeServiceAPIMockRecorder
+}
+
+// MockChangeMultiAssetModeServiceAPIMockRecorder is the mock recorder for MockChangeMultiAssetModeServiceAPI.
+type MockChangeMultiAssetModeServiceAPIMockRecorder struct {
+	mock *MockChangeMultiAssetModeServiceAPI
+}
+
+// NewMockChangeMultiAssetModeServiceAPI creates a new mock instance.
+func NewMockChangeMultiAssetModeServiceAPI(ctrl *gomock.Controller) *MockChangeMultiAssetModeServiceAPI {
+	mock := &MockChangeMultiAssetModeServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockChangeMultiAssetModeServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockChangeMultiAssetModeServiceAPI) EXPECT() *MockChangeMultiAssetModeServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockChangeMultiAssetModeServiceAPI) Do(ctx context.Context, opts ...futures.RequestOption) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockChangeMultiAssetModeServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockChangeMultiAssetModeServiceAPI)(nil).Do), varargs...)
+}
+
+// MultiAssetsMargin mocks base method.
+func (m *MockChangeMultiAssetModeServiceAPI) MultiAssetsMargin(multiAssetsMargin bool) futures.ChangeMultiAssetModeServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MultiAssetsMargin", multiAssetsMargin)
+	ret0, _ := ret[0].(futures.ChangeMultiAssetModeServiceAPI)
+	return ret0
+}
+
+// MultiAssetsMargin indicates an expected call of MultiAssetsMargin.
+func (mr *MockChangeMultiAssetModeServiceAPIMockRecorder) MultiAssetsMargin(multiAssetsMargin interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MultiAssetsMargin", reflect.TypeOf((*MockChangeMultiAssetModeServiceAPI)(nil).MultiAssetsMargin), multiAssetsMargin)
+}
+
+// MockGetMultiAssetModeServiceAPI is a mock of GetMultiAssetModeServiceAPI interface.
+type MockGetMultiAssetModeServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockGetMultiAssetModeServiceAPIMockRecorder
+}
+
+// MockGetMultiAssetModeServiceAPIMockRecorder is the mock recorder for MockGetMultiAssetModeServiceAPI.
+type MockGetMultiAssetModeServiceAPIMockRecorder struct {
+	mock *MockGetMultiAssetModeServiceAPI
+}
+
+// NewMockGetMultiAssetModeServiceAPI creates a new mock instance.
+func NewMockGetMultiAssetModeServiceAPI(ctrl *gomock.Controller) *MockGetMultiAssetModeServiceAPI {
+	mock := &MockGetMultiAssetModeServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockGetMultiAssetModeServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGetMultiAssetModeServiceAPI) EXPECT() *MockGetMultiAssetModeServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockGetMultiAssetModeServiceAPI) Do(ctx context.Context, opts ...futures.RequestOption) (*futures.MultiAssetMode, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*futures.MultiAssetMode)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockGetMultiAssetModeServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockGetMultiAssetModeServiceAPI)(nil).Do), varargs...)
+}
+
+// MockGetRebateNewUserServiceAPI is a mock of GetRebateNewUserServiceAPI interface.
+type MockGetRebateNewUserServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockGetRebateNewUserServiceAPIMockRecorder
+}
+
+// MockGetRebateNewUserServiceAPIMockRecorder is the mock recorder for MockGetRebateNewUserServiceAPI.
+type MockGetRebateNewUserServiceAPIMockRecorder struct {
+	mock *MockGetRebateNewUserServiceAPI
+}
+
+// NewMockGetRebateNewUserServiceAPI creates a new mock instance.
+func NewMockGetRebateNewUserServiceAPI(ctrl *gomock.Controller) *MockGetRebateNewUserServiceAPI {
+	mock := &MockGetRebateNewUserServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockGetRebateNewUserServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGetRebateNewUserServiceAPI) EXPECT() *MockGetRebateNewUserServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// BrokerageID mocks base method.
+func (m *MockGetRebateNewUserServiceAPI) BrokerageID(brokerageID string) futures.GetRebateNewUserServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BrokerageID", brokerageID)
+	ret0, _ := ret[0].(futures.GetRebateNewUserServiceAPI)
+	return ret0
+}
+
+// BrokerageID indicates an expected call of BrokerageID.
+func (mr *MockGetRebateNewUserServiceAPIMockRecorder) BrokerageID(brokerageID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BrokerageID", reflect.TypeOf((*MockGetRebateNewUserServiceAPI)(nil).BrokerageID), brokerageID)
+}
+
+// Do mocks base method.
+func (m *MockGetRebateNewUserServiceAPI) Do(ctx context.Context, opts ...futures.RequestOption) (*futures.RebateNewUser, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*futures.RebateNewUser)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockGetRebateNewUserServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockGetRebateNewUserServiceAPI)(nil).Do), varargs...)
+}
+
+// Type mocks base method.
+func (m *MockGetRebateNewUserServiceAPI) Type(type_future int) futures.GetRebateNewUserServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Type", type_future)
+	ret0, _ := ret[0].(futures.GetRebateNewUserServiceAPI)
+	return ret0
+}
+
+// Type indicates an expected call of Type.
+func (mr *MockGetRebateNewUserServiceAPIMockRecorder) Type(type_future interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Type", reflect.TypeOf((*MockGetRebateNewUserServiceAPI)(nil).Type), type_future)
+}
+
+// MockCommissionRateServiceAPI is a mock of CommissionRateServiceAPI interface.
+type MockCommissionRateServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockCommissionRateServiceAPIMockRecorder
+}
+
+// MockCommissionRateServiceAPIMockRecorder is the mock recorder for MockCommissionRateServiceAPI.
+type MockCommissionRateServiceAPIMockRecorder struct {
+	mock *MockCommissionRateServiceAPI
+}
+
+// NewMockCommissionRateServiceAPI creates a new mock instance.
+func NewMockCommissionRateServiceAPI(ctrl *gomock.Controller) *MockCommissionRateServiceAPI {
+	mock := &MockCommissionRateServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockCommissionRateServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCommissionRateServiceAPI) EXPECT() *MockCommissionRateServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockCommissionRateServiceAPI) Do(ctx context.Context, opts ...futures.RequestOption) (*futures.CommissionRate, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*futures.CommissionRate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockCommissionRateServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockCommissionRateServiceAPI)(nil).Do), varargs...)
+}
+
+// Symbol mocks base method.
+func (m *MockCommissionRateServiceAPI) Symbol(symbol string) futures.CommissionRateServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Symbol", symbol)
+	ret0, _ := ret[0].(futures.CommissionRateServiceAPI)
+	return ret0
+}
+
+// Symbol indicates an expected call of Symbol.
+func (mr *MockCommissionRateServiceAPIMockRecorder) Symbol(symbol interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Symbol", reflect.TypeOf((*MockCommissionRateServiceAPI)(nil).Symbol), symbol)
+}
+
+// MockGetOpenInterestServiceAPI is a mock of GetOpenInterestServiceAPI interface.
+type MockGetOpenInterestServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockGetOpenInterestServiceAPIMockRecorder
+}
+
+// MockGetOpenInterestServiceAPIMockRecorder is the mock recorder for MockGetOpenInterestServiceAPI.
+type MockGetOpenInterestServiceAPIMockRecorder struct {
+	mock *MockGetOpenInterestServiceAPI
+}
+
+// NewMockGetOpenInterestServiceAPI creates a new mock instance.
+func NewMockGetOpenInterestServiceAPI(ctrl *gomock.Controller) *MockGetOpenInterestServiceAPI {
+	mock := &MockGetOpenInterestServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockGetOpenInterestServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGetOpenInterestServiceAPI) EXPECT() *MockGetOpenInterestServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockGetOpenInterestServiceAPI) Do(ctx context.Context, opts ...futures.RequestOption) (*futures.OpenInterest, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*futures.OpenInterest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockGetOpenInterestServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockGetOpenInterestServiceAPI)(nil).Do), varargs...)
+}
+
+// Symbol mocks base method.
+func (m *MockGetOpenInterestServiceAPI) Symbol(symbol string) futures.GetOpenInterestServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Symbol", symbol)
+	ret0, _ := ret[0].(futures.GetOpenInterestServiceAPI)
+	return ret0
+}
+
+// Symbol indicates an expected call of Symbol.
+func (mr *MockGetOpenInterestServiceAPIMockRecorder) Symbol(symbol interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Symbol", reflect.TypeOf((*MockGetOpenInterestServiceAPI)(nil).Symbol), symbol)
+}
+
+// MockOpenInterestStatisticsServiceAPI is a mock of OpenInterestStatisticsServiceAPI interface.
+type MockOpenInterestStatisticsServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockOpenInterestStatisticsServiceAPIMockRecorder
+}
+
+// MockOpenInterestStatisticsServiceAPIMockRecorder is the mock recorder for MockOpenInterestStatisticsServiceAPI.
+type MockOpenInterestStatisticsServiceAPIMockRecorder struct {
+	mock *MockOpenInterestStatisticsServiceAPI
+}
+
+// NewMockOpenInterestStatisticsServiceAPI creates a new mock instance.
+func NewMockOpenInterestStatisticsServiceAPI(ctrl *gomock.Controller) *MockOpenInterestStatisticsServiceAPI {
+	mock := &MockOpenInterestStatisticsServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockOpenInterestStatisticsServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockOpenInterestStatisticsServiceAPI) EXPECT() *MockOpenInterestStatisticsServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockOpenInterestStatisticsServiceAPI) Do(ctx context.Context, opts ...futures.RequestOption) ([]*futures.OpenInterestStatistic, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].([]*futures.OpenInterestStatistic)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockOpenInterestStatisticsServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockOpenInterestStatisticsServiceAPI)(nil).Do), varargs...)
+}
+
+// EndTime mocks base method.
+func (m *MockOpenInterestStatisticsServiceAPI) EndTime(endTime int64) futures.OpenInterestStatisticsServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EndTime", endTime)
+	ret0, _ := ret[0].(futures.OpenInterestStatisticsServiceAPI)
+	return ret0
+}
+
+// EndTime indicates an expected call of EndTime.
+func (mr *MockOpenInterestStatisticsServiceAPIMockRecorder) EndTime(endTime interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EndTime", reflect.TypeOf((*MockOpenInterestStatisticsServiceAPI)(nil).EndTime), endTime)
+}
+
+// Limit mocks base method.
+func (m *MockOpenInterestStatisticsServiceAPI) Limit(limit int) futures.OpenInterestStatisticsServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Limit", limit)
+	ret0, _ := ret[0].(futures.OpenInterestStatisticsServiceAPI)
+	return ret0
+}
+
+// Limit indicates an expected call of Limit.
+func (mr *MockOpenInterestStatisticsServiceAPIMockRecorder) Limit(limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Limit", reflect.TypeOf((*MockOpenInterestStatisticsServiceAPI)(nil).Limit), limit)
+}
+
+// Period mocks base method.
+func (m *MockOpenInterestStatisticsServiceAPI) Period(period string) futures.OpenInterestStatisticsServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Period", period)
+	ret0, _ := ret[0].(futures.OpenInterestStatisticsServiceAPI)
+	return ret0
+}
+
+// Period indicates an expected call of Period.
+func (mr *MockOpenInterestStatisticsServiceAPIMockRecorder) Period(period interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Period", reflect.TypeOf((*MockOpenInterestStatisticsServiceAPI)(nil).Period), period)
+}
+
+// StartTime mocks base method.
+func (m *MockOpenInterestStatisticsServiceAPI) StartTime(startTime int64) futures.OpenInterestStatisticsServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartTime", startTime)
+	ret0, _ := ret[0].(futures.OpenInterestStatisticsServiceAPI)
+	return ret0
+}
+
+// StartTime indicates an expected call of StartTime.
+func (mr *MockOpenInterestStatisticsServiceAPIMockRecorder) StartTime(startTime interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartTime", reflect.TypeOf((*MockOpenInterestStatisticsServiceAPI)(nil).StartTime), startTime)
+}
+
+// Symbol mocks base method.
+func (m *MockOpenInterestStatisticsServiceAPI) Symbol(symbol string) futures.OpenInterestStatisticsServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Symbol", symbol)
+	ret0, _ := ret[0].(futures.OpenInterestStatisticsServiceAPI)
+	return ret0
+}
+
+// Symbol indicates an expected call of Symbol.
+func (mr *MockOpenInterestStatisticsServiceAPIMockRecorder) Symbol(symbol interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Symbol", reflect.TypeOf((*MockOpenInterestStatisticsServiceAPI)(nil).Symbol), symbol)
+}
+
+// MockLongShortRatioServiceAPI is a mock of LongShortRatioServiceAPI interface.
+type MockLongShortRatioServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockLongShortRatioServiceAPIMockRecorder
+}
+
+// MockLongShortRatioServiceAPIMockRecorder is the mock recorder for MockLongShortRatioServiceAPI.
+type MockLongShortRatioServiceAPIMockRecorder struct {
+	mock *MockLongShortRatioServiceAPI
+}
+
+// NewMockLongShortRatioServiceAPI creates a new mock instance.
+func NewMockLongShortRatioServiceAPI(ctrl *gomock.Controller) *MockLongShortRatioServiceAPI {
+	mock := &MockLongShortRatioServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockLongShortRatioServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockLongShortRatioServiceAPI) EXPECT() *MockLongShortRatioServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockLongShortRatioServiceAPI) Do(ctx context.Context, opts ...futures.RequestOption) ([]*futures.LongShortRatio, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].([]*futures.LongShortRatio)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockLongShortRatioServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockLongShortRatioServiceAPI)(nil).Do), varargs...)
+}
+
+// EndTime mocks base method.
+func (m *MockLongShortRatioServiceAPI) EndTime(endTime int64) futures.LongShortRatioServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EndTime", endTime)
+	ret0, _ := ret[0].(futures.LongShortRatioServiceAPI)
+	return ret0
+}
+
+// EndTime indicates an expected call of EndTime.
+func (mr *MockLongShortRatioServiceAPIMockRecorder) EndTime(endTime interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EndTime", reflect.TypeOf((*MockLongShortRatioServiceAPI)(nil).EndTime), endTime)
+}
+
+// Limit mocks base method.
+func (m *MockLongShortRatioServiceAPI) Limit(limit int) futures.LongShortRatioServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Limit", limit)
+	ret0, _ := ret[0].(futures.LongShortRatioServiceAPI)
+	return ret0
+}
+
+// Limit indicates an expected call of Limit.
+func (mr *MockLongShortRatioServiceAPIMockRecorder) Limit(limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Limit", reflect.TypeOf((*MockLongShortRatioServiceAPI)(nil).Limit), limit)
+}
+
+// Period mocks base method.
+func (m *MockLongShortRatioServiceAPI) Period(period string) futures.LongShortRatioServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Period", period)
+	ret0, _ := ret[0].(futures.LongShortRatioServiceAPI)
+	return ret0
+}
+
+// Period indicates an expected call of Period.
+func (mr *MockLongShortRatioServiceAPIMockRecorder) Period(period interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Period", reflect.TypeOf((*MockLongShortRatioServiceAPI)(nil).Period), period)
+}
+
+// StartTime mocks base method.
+func (m *MockLongShortRatioServiceAPI) StartTime(startTime int64) futures.LongShortRatioServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartTime", startTime)
+	ret0, _ := ret[0].(futures.LongShortRatioServiceAPI)
+	return ret0
+}
+
+// StartTime indicates an expected call of StartTime.
+func (mr *MockLongShortRatioServiceAPIMockRecorder) StartTime(startTime interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartTime", reflect.TypeOf((*MockLongShortRatioServiceAPI)(nil).StartTime), startTime)
+}
+
+// Symbol mocks base method.
+func (m *MockLongShortRatioServiceAPI) Symbol(symbol string) futures.LongShortRatioServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Symbol", symbol)
+	ret0, _ := ret[0].(futures.LongShortRatioServiceAPI)
+	return ret0
+}
+
+// Symbol indicates an expected call of Symbol.
+func (mr *MockLongShortRatioServiceAPIMockRecorder) Symbol(symbol interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Symbol", reflect.TypeOf((*MockLongShortRatioServiceAPI)(nil).Symbol), symbol)
+}
+
+// MockDeliveryPriceServiceAPI is a mock of DeliveryPriceServiceAPI interface.
+type MockDeliveryPriceServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockDeliveryPriceServiceAPIMockRecorder
+}
+
+// MockDeliveryPriceServiceAPIMockRecorder is the mock recorder for MockDeliveryPriceServiceAPI.
+type MockDeliveryPriceServiceAPIMockRecorder struct {
+	mock *MockDeliveryPriceServiceAPI
+}
+
+// NewMockDeliveryPriceServiceAPI creates a new mock instance.
+func NewMockDeliveryPriceServiceAPI(ctrl *gomock.Controller) *MockDeliveryPriceServiceAPI {
+	mock := &MockDeliveryPriceServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockDeliveryPriceServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDeliveryPriceServiceAPI) EXPECT() *MockDeliveryPriceServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockDeliveryPriceServiceAPI) Do(ctx context.Context, opts ...futures.RequestOption) ([]*futures.DeliveryPrice, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].([]*futures.DeliveryPrice)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockDeliveryPriceServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockDeliveryPriceServiceAPI)(nil).Do), varargs...)
+}
+
+// Pair mocks base method.
+func (m *MockDeliveryPriceServiceAPI) Pair(pair string) futures.DeliveryPriceServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Pair", pair)
+	ret0, _ := ret[0].(futures.DeliveryPriceServiceAPI)
+	return ret0
+}
+
+// Pair indicates an expected call of Pair.
+func (mr *MockDeliveryPriceServiceAPIMockRecorder) Pair(pair interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Pair", reflect.TypeOf((*MockDeliveryPriceServiceAPI)(nil).Pair), pair)
+}
+
+// MockTopLongShortAccountRatioServiceAPI is a mock of TopLongShortAccountRatioServiceAPI interface.
+type MockTopLongShortAccountRatioServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockTopLongShortAccountRatioServiceAPIMockRecorder
+}
+
+// MockTopLongShortAccountRatioServiceAPIMockRecorder is the mock recorder for MockTopLongShortAccountRatioServiceAPI.
+type MockTopLongShortAccountRatioServiceAPIMockRecorder struct {
+	mock *MockTopLongShortAccountRatioServiceAPI
+}
+
+// NewMockTopLongShortAccountRatioServiceAPI creates a new mock instance.
+func NewMockTopLongShortAccountRatioServiceAPI(ctrl *gomock.Controller) *MockTopLongShortAccountRatioServiceAPI {
+	mock := &MockTopLongShortAccountRatioServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockTopLongShortAccountRatioServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTopLongShortAccountRatioServiceAPI) EXPECT() *MockTopLongShortAccountRatioServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockTopLongShortAccountRatioServiceAPI) Do(ctx context.Context, opts ...futures.RequestOption) ([]*futures.TopLongShortAccountRatio, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].([]*futures.TopLongShortAccountRatio)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockTopLongShortAccountRatioServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockTopLongShortAccountRatioServiceAPI)(nil).Do), varargs...)
+}
+
+// EndTime mocks base method.
+func (m *MockTopLongShortAccountRatioServiceAPI) EndTime(endTime uint64) futures.TopLongShortAccountRatioServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EndTime", endTime)
+	ret0, _ := ret[0].(futures.TopLongShortAccountRatioServiceAPI)
+	return ret0
+}
+
+// EndTime indicates an expected call of EndTime.
+func (mr *MockTopLongShortAccountRatioServiceAPIMockRecorder) EndTime(endTime interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EndTime", reflect.TypeOf((*MockTopLongShortAccountRatioServiceAPI)(nil).EndTime), endTime)
+}
+
+// Limit mocks base method.
+func (m *MockTopLongShortAccountRatioServiceAPI) Limit(limit uint32) futures.TopLongShortAccountRatioServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Limit", limit)
+	ret0, _ := ret[0].(futures.TopLongShortAccountRatioServiceAPI)
+	return ret0
+}
+
+// Limit indicates an expected call of Limit.
+func (mr *MockTopLongShortAccountRatioServiceAPIMockRecorder) Limit(limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Limit", reflect.TypeOf((*MockTopLongShortAccountRatioServiceAPI)(nil).Limit), limit)
+}
+
+// Period mocks base method.
+func (m *MockTopLongShortAccountRatioServiceAPI) Period(period string) futures.TopLongShortAccountRatioServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Period", period)
+	ret0, _ := ret[0].(futures.TopLongShortAccountRatioServiceAPI)
+	return ret0
+}
+
+// Period indicates an expected call of Period.
+func (mr *MockTopLongShortAccountRatioServiceAPIMockRecorder) Period(period interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Period", reflect.TypeOf((*MockTopLongShortAccountRatioServiceAPI)(nil).Period), period)
+}
+
+// StartTime mocks base method.
+func (m *MockTopLongShortAccountRatioServiceAPI) StartTime(startTime uint64) futures.TopLongShortAccountRatioServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartTime", startTime)
+	ret0, _ := ret[0].(futures.TopLongShortAccountRatioServiceAPI)
+	return ret0
+}
+
+// StartTime indicates an expected call of StartTime.
+func (mr *MockTopLongShortAccountRatioServiceAPIMockRecorder) StartTime(startTime interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartTime", reflect.TypeOf((*MockTopLongShortAccountRatioServiceAPI)(nil).StartTime), startTime)
+}
+
+// Symbol mocks base method.
+func (m *MockTopLongShortAccountRatioServiceAPI) Symbol(symbol string) futures.TopLongShortAccountRatioServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Symbol", symbol)
+	ret0, _ := ret[0].(futures.TopLongShortAccountRatioServiceAPI)
+	return ret0
+}
+
+// Symbol indicates an expected call of Symbol.
+func (mr *MockTopLongShortAccountRatioServiceAPIMockRecorder) Symbol(symbol interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Symbol", reflect.TypeOf((*MockTopLongShortAccountRatioServiceAPI)(nil).Symbol), symbol)
+}
+
+// MockTopLongShortPositionRatioServiceAPI is a mock of TopLongShortPositionRatioServiceAPI interface.
+type MockTopLongShortPositionRatioServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockTopLongShortPositionRatioServiceAPIMockRecorder
+}
+
+// MockTopLongShortPositionRatioServiceAPIMockRecorder is the mock recorder for MockTopLongShortPositionRatioServiceAPI.
+type MockTopLongShortPositionRatioServiceAPIMockRecorder struct {
+	mock *MockTopLongShortPositionRatioServiceAPI
+}
+
+// NewMockTopLongShortPositionRatioServiceAPI creates a new mock instance.
+func NewMockTopLongShortPositionRatioServiceAPI(ctrl *gomock.Controller) *MockTopLongShortPositionRatioServiceAPI {
+	mock := &MockTopLongShortPositionRatioServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockTopLongShortPositionRatioServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTopLongShortPositionRatioServiceAPI) EXPECT() *MockTopLongShortPositionRatioServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockTopLongShortPositionRatioServiceAPI) Do(ctx context.Context, opts ...futures.RequestOption) ([]*futures.TopLongShortPositionRatio, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].([]*futures.TopLongShortPositionRatio)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockTopLongShortPositionRatioServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockTopLongShortPositionRatioServiceAPI)(nil).Do), varargs...)
+}
+
+// EndTime mocks base method.
+func (m *MockTopLongShortPositionRatioServiceAPI) EndTime(endTime uint64) futures.TopLongShortPositionRatioServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EndTime", endTime)
+	ret0, _ := ret[0].(futures.TopLongShortPositionRatioServiceAPI)
+	return ret0
+}
+
+// EndTime indicates an expected call of EndTime.
+func (mr *MockTopLongShortPositionRatioServiceAPIMockRecorder) EndTime(endTime interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EndTime", reflect.TypeOf((*MockTopLongShortPositionRatioServiceAPI)(nil).EndTime), endTime)
+}
+
+// Limit mocks base method.
+func (m *MockTopLongShortPositionRatioServiceAPI) Limit(limit uint32) futures.TopLongShortPositionRatioServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Limit", limit)
+	ret0, _ := ret[0].(futures.TopLongShortPositionRatioServiceAPI)
+	return ret0
+}
+
+// Limit indicates an expected call of Limit.
+func (mr *MockTopLongShortPositionRatioServiceAPIMockRecorder) Limit(limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Limit", reflect.TypeOf((*MockTopLongShortPositionRatioServiceAPI)(nil).Limit), limit)
+}
+
+// Period mocks base method.
+func (m *MockTopLongShortPositionRatioServiceAPI) Period(period string) futures.TopLongShortPositionRatioServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Period", period)
+	ret0, _ := ret[0].(futures.TopLongShortPositionRatioServiceAPI)
+	return ret0
+}
+
+// Period indicates an expected call of Period.
+func (mr *MockTopLongShortPositionRatioServiceAPIMockRecorder) Period(period interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Period", reflect.TypeOf((*MockTopLongShortPositionRatioServiceAPI)(nil).Period), period)
+}
+
+// StartTime mocks base method.
+func (m *MockTopLongShortPositionRatioServiceAPI) StartTime(startTime uint64) futures.TopLongShortPositionRatioServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartTime", startTime)
+	ret0, _ := ret[0].(futures.TopLongShortPositionRatioServiceAPI)
+	return ret0
+}
+
+// StartTime indicates an expected call of StartTime.
+func (mr *MockTopLongShortPositionRatioServiceAPIMockRecorder) StartTime(startTime interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartTime", reflect.TypeOf((*MockTopLongShortPositionRatioServiceAPI)(nil).StartTime), startTime)
+}
+
+// Symbol mocks base method.
+func (m *MockTopLongShortPositionRatioServiceAPI) Symbol(symbol string) futures.TopLongShortPositionRatioServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Symbol", symbol)
+	ret0, _ := ret[0].(futures.TopLongShortPositionRatioServiceAPI)
+	return ret0
+}
+
+// Symbol indicates an expected call of Symbol.
+func (mr *MockTopLongShortPositionRatioServiceAPIMockRecorder) Symbol(symbol interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Symbol", reflect.TypeOf((*MockTopLongShortPositionRatioServiceAPI)(nil).Symbol), symbol)
+}
+
+// MockTakerLongShortRatioServiceAPI is a mock of TakerLongShortRatioServiceAPI interface.
+type MockTakerLongShortRatioServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockTakerLongShortRatioServiceAPIMockRecorder
+}
+
+// MockTakerLongShortRatioServiceAPIMockRecorder is the mock recorder for MockTakerLongShortRatioServiceAPI.
+type MockTakerLongShortRatioServiceAPIMockRecorder struct {
+	mock *MockTakerLongShortRatioServiceAPI
+}
+
+// NewMockTakerLongShortRatioServiceAPI creates a new mock instance.
+func NewMockTakerLongShortRatioServiceAPI(ctrl *gomock.Controller) *MockTakerLongShortRatioServiceAPI {
+	mock := &MockTakerLongShortRatioServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockTakerLongShortRatioServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTakerLongShortRatioServiceAPI) EXPECT() *MockTakerLongShortRatioServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockTakerLongShortRatioServiceAPI) Do(ctx context.Context, opts ...futures.RequestOption) ([]*futures.TakerLongShortRatio, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].([]*futures.TakerLongShortRatio)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockTakerLongShortRatioServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockTakerLongShortRatioServiceAPI)(nil).Do), varargs...)
+}
+
+// EndTime mocks base method.
+func (m *MockTakerLongShortRatioServiceAPI) EndTime(endTime uint64) futures.TakerLongShortRatioServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EndTime", endTime)
+	ret0, _ := ret[0].(futures.TakerLongShortRatioServiceAPI)
+	return ret0
+}
+
+// EndTime indicates an expected call of EndTime.
+func (mr *MockTakerLongShortRatioServiceAPIMockRecorder) EndTime(endTime interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EndTime", reflect.TypeOf((*MockTakerLongShortRatioServiceAPI)(nil).EndTime), endTime)
+}
+
+// Limit mocks base method.
+func (m *MockTakerLongShortRatioServiceAPI) Limit(limit uint32) futures.TakerLongShortRatioServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Limit", limit)
+	ret0, _ := ret[0].(futures.TakerLongShortRatioServiceAPI)
+	return ret0
+}
+
+// Limit indicates an expected call of Limit.
+func (mr *MockTakerLongShortRatioServiceAPIMockRecorder) Limit(limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Limit", reflect.TypeOf((*MockTakerLongShortRatioServiceAPI)(nil).Limit), limit)
+}
+
+// Period mocks base method.
+func (m *MockTakerLongShortRatioServiceAPI) Period(period string) futures.TakerLongShortRatioServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Period", period)
+	ret0, _ := ret[0].(futures.TakerLongShortRatioServiceAPI)
+	return ret0
+}
+
+// Period indicates an expected call of Period.
+func (mr *MockTakerLongShortRatioServiceAPIMockRecorder) Period(period interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Period", reflect.TypeOf((*MockTakerLongShortRatioServiceAPI)(nil).Period), period)
+}
+
+// StartTime mocks base method.
+func (m *MockTakerLongShortRatioServiceAPI) StartTime(startTime uint64) futures.TakerLongShortRatioServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartTime", startTime)
+	ret0, _ := ret[0].(futures.TakerLongShortRatioServiceAPI)
+	return ret0
+}
+
+// StartTime indicates an expected call of StartTime.
+func (mr *MockTakerLongShortRatioServiceAPIMockRecorder) StartTime(startTime interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartTime", reflect.TypeOf((*MockTakerLongShortRatioServiceAPI)(nil).StartTime), startTime)
+}
+
+// Symbol mocks base method.
+func (m *MockTakerLongShortRatioServiceAPI) Symbol(symbol string) futures.TakerLongShortRatioServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Symbol", symbol)
+	ret0, _ := ret[0].(futures.TakerLongShortRatioServiceAPI)
+	return ret0
+}
+
+// Symbol indicates an expected call of Symbol.
+func (mr *MockTakerLongShortRatioServiceAPIMockRecorder) Symbol(symbol interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Symbol", reflect.TypeOf((*MockTakerLongShortRatioServiceAPI)(nil).Symbol), symbol)
+}
+
+// MockBasisServiceAPI is a mock of BasisServiceAPI interface.
+type MockBasisServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockBasisServiceAPIMockRecorder
+}
+
+// MockBasisServiceAPIMockRecorder is the mock recorder for MockBasisServiceAPI.
+type MockBasisServiceAPIMockRecorder struct {
+	mock *MockBasisServiceAPI
+}
+
+// NewMockBasisServiceAPI creates a new mock instance.
+func NewMockBasisServiceAPI(ctrl *gomock.Controller) *MockBasisServiceAPI {
+	mock := &MockBasisServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockBasisServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockBasisServiceAPI) EXPECT() *MockBasisServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// ContractType mocks base method.
+func (m *MockBasisServiceAPI) ContractType(contractType string) futures.BasisServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ContractType", contractType)
+	ret0, _ := ret[0].(futures.BasisServiceAPI)
+	return ret0
+}
+
+// ContractType indicates an expected call of ContractType.
+func (mr *MockBasisServiceAPIMockRecorder) ContractType(contractType interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ContractType", reflect.TypeOf((*MockBasisServiceAPI)(nil).ContractType), contractType)
+}
+
+// Do mocks base method.
+func (m *MockBasisServiceAPI) Do(ctx context.Context, opts ...futures.RequestOption) ([]*futures.Basis, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].([]*futures.Basis)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockBasisServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockBasisServiceAPI)(nil).Do), varargs...)
+}
+
+// EndTime mocks base method.
+func (m *MockBasisServiceAPI) EndTime(endTime uint64) futures.BasisServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EndTime", endTime)
+	ret0, _ := ret[0].(futures.BasisServiceAPI)
+	return ret0
+}
+
+// EndTime indicates an expected call of EndTime.
+func (mr *MockBasisServiceAPIMockRecorder) EndTime(endTime interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EndTime", reflect.TypeOf((*MockBasisServiceAPI)(nil).EndTime), endTime)
+}
+
+// Limit mocks base method.
+func (m *MockBasisServiceAPI) Limit(limit uint32) futures.BasisServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Limit", limit)
+	ret0, _ := ret[0].(futures.BasisServiceAPI)
+	return ret0
+}
+
+// Limit indicates an expected call of Limit.
+func (mr *MockBasisServiceAPIMockRecorder) Limit(limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Limit", reflect.TypeOf((*MockBasisServiceAPI)(nil).Limit), limit)
+}
+
+// Pair mocks base method.
+func (m *MockBasisServiceAPI) Pair(pair string) futures.BasisServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Pair", pair)
+	ret0, _ := ret[0].(futures.BasisServiceAPI)
+	return ret0
+}
+
+// Pair indicates an expected call of Pair.
+func (mr *MockBasisServiceAPIMockRecorder) Pair(pair interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Pair", reflect.TypeOf((*MockBasisServiceAPI)(nil).Pair), pair)
+}
+
+// Period mocks base method.
+func (m *MockBasisServiceAPI) Period(period string) futures.BasisServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Period", period)
+	ret0, _ := ret[0].(futures.BasisServiceAPI)
+	return ret0
+}
+
+// Period indicates an expected call of Period.
+func (mr *MockBasisServiceAPIMockRecorder) Period(period interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Period", reflect.TypeOf((*MockBasisServiceAPI)(nil).Period), period)
+}
+
+// StartTime mocks base method.
+func (m *MockBasisServiceAPI) StartTime(startTime uint64) futures.BasisServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartTime", startTime)
+	ret0, _ := ret[0].(futures.BasisServiceAPI)
+	return ret0
+}
+
+// StartTime indicates an expected call of StartTime.
+func (mr *MockBasisServiceAPIMockRecorder) StartTime(startTime interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartTime", reflect.TypeOf((*MockBasisServiceAPI)(nil).StartTime), startTime)
+}
+
+// MockIndexInfoServiceAPI is a mock of IndexInfoServiceAPI interface.
+type MockIndexInfoServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockIndexInfoServiceAPIMockRecorder
+}
+
+// MockIndexInfoServiceAPIMockRecorder is the mock recorder for MockIndexInfoServiceAPI.
+type MockIndexInfoServiceAPIMockRecorder struct {
+	mock *MockIndexInfoServiceAPI
+}
+
+// NewMockIndexInfoServiceAPI creates a new mock instance.
+func NewMockIndexInfoServiceAPI(ctrl *gomock.Controller) *MockIndexInfoServiceAPI {
+	mock := &MockIndexInfoServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockIndexInfoServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIndexInfoServiceAPI) EXPECT() *MockIndexInfoServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockIndexInfoServiceAPI) Do(ctx context.Context, opts ...futures.RequestOption) ([]*futures.IndexInfo, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].([]*futures.IndexInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockIndexInfoServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockIndexInfoServiceAPI)(nil).Do), varargs...)
+}
+
+// Symbol mocks base method.
+func (m *MockIndexInfoServiceAPI) Symbol(symbol string) futures.IndexInfoServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Symbol", symbol)
+	ret0, _ := ret[0].(futures.IndexInfoServiceAPI)
+	return ret0
+}
+
+// Symbol indicates an expected call of Symbol.
+func (mr *MockIndexInfoServiceAPIMockRecorder) Symbol(symbol interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Symbol", reflect.TypeOf((*MockIndexInfoServiceAPI)(nil).Symbol), symbol)
+}
+
+// MockAssetIndexServiceAPI is a mock of AssetIndexServiceAPI interface.
+type MockAssetIndexServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockAssetIndexServiceAPIMockRecorder
+}
+
+// MockAssetIndexServiceAPIMockRecorder is the mock recorder for MockAssetIndexServiceAPI.
+type MockAssetIndexServiceAPIMockRecorder struct {
+	mock *MockAssetIndexServiceAPI
+}
+
+// NewMockAssetIndexServiceAPI creates a new mock instance.
+func NewMockAssetIndexServiceAPI(ctrl *gomock.Controller) *MockAssetIndexServiceAPI {
+	mock := &MockAssetIndexServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockAssetIndexServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAssetIndexServiceAPI) EXPECT() *MockAssetIndexServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockAssetIndexServiceAPI) Do(ctx context.Context, opts ...futures.RequestOption) ([]*futures.AssetIndex, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].([]*futures.AssetIndex)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockAssetIndexServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockAssetIndexServiceAPI)(nil).Do), varargs...)
+}
+
+// Symbol mocks base method.
+func (m *MockAssetIndexServiceAPI) Symbol(symbol string) futures.AssetIndexServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Symbol", symbol)
+	ret0, _ := ret[0].(futures.AssetIndexServiceAPI)
+	return ret0
+}
+
+// Symbol indicates an expected call of Symbol.
+func (mr *MockAssetIndexServiceAPIMockRecorder) Symbol(symbol interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Symbol", reflect.TypeOf((*MockAssetIndexServiceAPI)(nil).Symbol), symbol)
+}
+
+// MockConstituentsServiceAPI is a mock of ConstituentsServiceAPI interface.
+type MockConstituentsServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockConstituentsServiceAPIMockRecorder
+}
+
+// MockConstituentsServiceAPIMockRecorder is the mock recorder for MockConstituentsServiceAPI.
+type MockConstituentsServiceAPIMockRecorder struct {
+	mock *MockConstituentsServiceAPI
+}
+
+// NewMockConstituentsServiceAPI creates a new mock instance.
+func NewMockConstituentsServiceAPI(ctrl *gomock.Controller) *MockConstituentsServiceAPI {
+	mock := &MockConstituentsServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockConstituentsServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockConstituentsServiceAPI) EXPECT() *MockConstituentsServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockConstituentsServiceAPI) Do(ctx context.Context, opts ...futures.RequestOption) (*futures.ConstituentsServiceRsp, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*futures.ConstituentsServiceRsp)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockConstituentsServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockConstituentsServiceAPI)(nil).Do), varargs...)
+}
+
+// Symbol mocks base method.
+func (m *MockConstituentsServiceAPI) Symbol(symbol string) futures.ConstituentsServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Symbol", symbol)
+	ret0, _ := ret[0].(futures.ConstituentsServiceAPI)
+	return ret0
+}
+
+// Symbol indicates an expected call of Symbol.
+func (mr *MockConstituentsServiceAPIMockRecorder) Symbol(symbol interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Symbol", reflect.TypeOf((*MockConstituentsServiceAPI)(nil).Symbol), symbol)
+}
+
+// MockLvtKlinesServiceAPI is a mock of LvtKlinesServiceAPI interface.
+type MockLvtKlinesServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockLvtKlinesServiceAPIMockRecorder
+}
+
+// MockLvtKlinesServiceAPIMockRecorder is the mock recorder for MockLvtKlinesServiceAPI.
+type MockLvtKlinesServiceAPIMockRecorder struct {
+	mock *MockLvtKlinesServiceAPI
+}
+
+// NewMockLvtKlinesServiceAPI creates a new mock instance.
+func NewMockLvtKlinesServiceAPI(ctrl *gomock.Controller) *MockLvtKlinesServiceAPI {
+	mock := &MockLvtKlinesServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockLvtKlinesServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockLvtKlinesServiceAPI) EXPECT() *MockLvtKlinesServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockLvtKlinesServiceAPI) Do(ctx context.Context, opts ...futures.RequestOption) ([]*futures.LvtKline, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].([]*futures.LvtKline)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockLvtKlinesServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockLvtKlinesServiceAPI)(nil).Do), varargs...)
+}
+
+// EndTime mocks base method.
+func (m *MockLvtKlinesServiceAPI) EndTime(endTime uint64) futures.LvtKlinesServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EndTime", endTime)
+	ret0, _ := ret[0].(futures.LvtKlinesServiceAPI)
+	return ret0
+}
+
+// EndTime indicates an expected call of EndTime.
+func (mr *MockLvtKlinesServiceAPIMockRecorder) EndTime(endTime interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EndTime", reflect.TypeOf((*MockLvtKlinesServiceAPI)(nil).EndTime), endTime)
+}
+
+// Interval mocks base method.
+func (m *MockLvtKlinesServiceAPI) Interval(interval string) futures.LvtKlinesServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Interval", interval)
+	ret0, _ := ret[0].(futures.LvtKlinesServiceAPI)
+	return ret0
+}
+
+// Interval indicates an expected call of Interval.
+func (mr *MockLvtKlinesServiceAPIMockRecorder) Interval(interval interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Interval", reflect.TypeOf((*MockLvtKlinesServiceAPI)(nil).Interval), interval)
+}
+
+// Limit mocks base method.
+func (m *MockLvtKlinesServiceAPI) Limit(limit uint32) futures.LvtKlinesServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Limit", limit)
+	ret0, _ := ret[0].(futures.LvtKlinesServiceAPI)
+	return ret0
+}
+
+// Limit indicates an expected call of Limit.
+func (mr *MockLvtKlinesServiceAPIMockRecorder) Limit(limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Limit", reflect.TypeOf((*MockLvtKlinesServiceAPI)(nil).Limit), limit)
+}
+
+// StartTime mocks base method.
+func (m *MockLvtKlinesServiceAPI) StartTime(startTime uint64) futures.LvtKlinesServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartTime", startTime)
+	ret0, _ := ret[0].(futures.LvtKlinesServiceAPI)
+	return ret0
+}
+
+// StartTime indicates an expected call of StartTime.
+func (mr *MockLvtKlinesServiceAPIMockRecorder) StartTime(startTime interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartTime", reflect.TypeOf((*MockLvtKlinesServiceAPI)(nil).StartTime), startTime)
+}
+
+// Symbol mocks base method.
+func (m *MockLvtKlinesServiceAPI) Symbol(symbol string) futures.LvtKlinesServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Symbol", symbol)
+	ret0, _ := ret[0].(futures.LvtKlinesServiceAPI)
+	return ret0
+}
+
+// Symbol indicates an expected call of Symbol.
+func (mr *MockLvtKlinesServiceAPIMockRecorder) Symbol(symbol interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Symbol", reflect.TypeOf((*MockLvtKlinesServiceAPI)(nil).Symbol), symbol)
+}
+
+// MockGetFeeBurnServiceAPI is a mock of GetFeeBurnServiceAPI interface.
+type MockGetFeeBurnServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockGetFeeBurnServiceAPIMockRecorder
+}
+
+// MockGetFeeBurnServiceAPIMockRecorder is the mock recorder for MockGetFeeBurnServiceAPI.
+type MockGetFeeBurnServiceAPIMockRecorder struct {
+	mock *MockGetFeeBurnServiceAPI
+}
+
+// NewMockGetFeeBurnServiceAPI creates a new mock instance.
+func NewMockGetFeeBurnServiceAPI(ctrl *gomock.Controller) *MockGetFeeBurnServiceAPI {
+	mock := &MockGetFeeBurnServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockGetFeeBurnServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGetFeeBurnServiceAPI) EXPECT() *MockGetFeeBurnServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockGetFeeBurnServiceAPI) Do(ctx context.Context, opts ...futures.RequestOption) (*futures.FeeBurn, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*futures.FeeBurn)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockGetFeeBurnServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockGetFeeBurnServiceAPI)(nil).Do), varargs...)
+}
+
+// MockFeeBurnServiceAPI is a mock of FeeBurnServiceAPI interface.
+type MockFeeBurnServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockFeeBurnServiceAPIMockRecorder
+}
+
+// MockFeeBurnServiceAPIMockRecorder is the mock recorder for MockFeeBurnServiceAPI.
+type MockFeeBurnServiceAPIMockRecorder struct {
+	mock *MockFeeBurnServiceAPI
+}
+
+// NewMockFeeBurnServiceAPI creates a new mock instance.
+func NewMockFeeBurnServiceAPI(ctrl *gomock.Controller) *MockFeeBurnServiceAPI {
+	mock := &MockFeeBurnServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockFeeBurnServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockFeeBurnServiceAPI) EXPECT() *MockFeeBurnServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Disable mocks base method.
+func (m *MockFeeBurnServiceAPI) Disable() futures.FeeBurnServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Disable")
+	ret0, _ := ret[0].(futures.FeeBurnServiceAPI)
+	return ret0
+}
+
+// Disable indicates an expected call of Disable.
+func (mr *MockFeeBurnServiceAPIMockRecorder) Disable() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Disable", reflect.TypeOf((*MockFeeBurnServiceAPI)(nil).Disable))
+}
+
+// Do mocks base method.
+func (m *MockFeeBurnServiceAPI) Do(ctx context.Context, opts ...futures.RequestOption) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockFeeBurnServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockFeeBurnServiceAPI)(nil).Do), varargs...)
+}
+
+// Enable mocks base method.
+func (m *MockFeeBurnServiceAPI) Enable() futures.FeeBurnServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Enable")
+	ret0, _ := ret[0].(futures.FeeBurnServiceAPI)
+	return ret0
+}
+
+// Enable indicates an expected call of Enable.
+func (mr *MockFeeBurnServiceAPIMockRecorder) Enable() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Enable", reflect.TypeOf((*MockFeeBurnServiceAPI)(nil).Enable))
+}
+
+// MockListConvertExchangeInfoServiceAPI is a mock of ListConvertExchangeInfoServiceAPI interface.
+type MockListConvertExchangeInfoServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockListConvertExchangeInfoServiceAPIMockRecorder
+}
+
+// MockListConvertExchangeInfoServiceAPIMockRecorder is the mock recorder for MockListConvertExchangeInfoServiceAPI.
+type MockListConvertExchangeInfoServiceAPIMockRecorder struct {
+	mock *MockListConvertExchangeInfoServiceAPI
+}
+
+// NewMockListConvertExchangeInfoServiceAPI creates a new mock instance.
+func NewMockListConvertExchangeInfoServiceAPI(ctrl *gomock.Controller) *MockListConvertExchangeInfoServiceAPI {
+	mock := &MockListConvertExchangeInfoServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockListConvertExchangeInfoServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockListConvertExchangeInfoServiceAPI) EXPECT() *MockListConvertExchangeInfoServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockListConvertExchangeInfoServiceAPI) Do(ctx context.Context, opts ...futures.RequestOption) ([]futures.ConvertExchangeInfo, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].([]futures.ConvertExchangeInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockListConvertExchangeInfoServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockListConvertExchangeInfoServiceAPI)(nil).Do), varargs...)
+}
+
+// FromAsset mocks base method.
+func (m *MockListConvertExchangeInfoServiceAPI) FromAsset(fromAsset string) futures.ListConvertExchangeInfoServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FromAsset", fromAsset)
+	ret0, _ := ret[0].(futures.ListConvertExchangeInfoServiceAPI)
+	return ret0
+}
+
+// FromAsset indicates an expected call of FromAsset.
+func (mr *MockListConvertExchangeInfoServiceAPIMockRecorder) FromAsset(fromAsset interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FromAsset", reflect.TypeOf((*MockListConvertExchangeInfoServiceAPI)(nil).FromAsset), fromAsset)
+}
+
+// ToAsset mocks base method.
+func (m *MockListConvertExchangeInfoServiceAPI) ToAsset(toAsset string) futures.ListConvertExchangeInfoServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ToAsset", toAsset)
+	ret0, _ := ret[0].(futures.ListConvertExchangeInfoServiceAPI)
+	return ret0
+}
+
+// ToAsset indicates an expected call of ToAsset.
+func (mr *MockListConvertExchangeInfoServiceAPIMockRecorder) ToAsset(toAsset interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ToAsset", reflect.TypeOf((*MockListConvertExchangeInfoServiceAPI)(nil).ToAsset), toAsset)
+}
+
+// MockCreateConvertQuoteServiceAPI is a mock of CreateConvertQuoteServiceAPI interface.
+type MockCreateConvertQuoteServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockCreateConvertQuoteServiceAPIMockRecorder
+}
+
+// MockCreateConvertQuoteServiceAPIMockRecorder is the mock recorder for MockCreateConvertQuoteServiceAPI.
+type MockCreateConvertQuoteServiceAPIMockRecorder struct {
+	mock *MockCreateConvertQuoteServiceAPI
+}
+
+// NewMockCreateConvertQuoteServiceAPI creates a new mock instance.
+func NewMockCreateConvertQuoteServiceAPI(ctrl *gomock.Controller) *MockCreateConvertQuoteServiceAPI {
+	mock := &MockCreateConvertQuoteServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockCreateConvertQuoteServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCreateConvertQuoteServiceAPI) EXPECT() *MockCreateConvertQuoteServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockCreateConvertQuoteServiceAPI) Do(ctx context.Context, opts ...futures.RequestOption) (*futures.ConvertQuote, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*futures.ConvertQuote)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockCreateConvertQuoteServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockCreateConvertQuoteServiceAPI)(nil).Do), varargs...)
+}
+
+// FromAmount mocks base method.
+func (m *MockCreateConvertQuoteServiceAPI) FromAmount(fromAmount string) futures.CreateConvertQuoteServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FromAmount", fromAmount)
+	ret0, _ := ret[0].(futures.CreateConvertQuoteServiceAPI)
+	return ret0
+}
+
+// FromAmount indicates an expected call of FromAmount.
+func (mr *MockCreateConvertQuoteServiceAPIMockRecorder) FromAmount(fromAmount interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FromAmount", reflect.TypeOf((*MockCreateConvertQuoteServiceAPI)(nil).FromAmount), fromAmount)
+}
+
+// FromAsset mocks base method.
+func (m *MockCreateConvertQuoteServiceAPI) FromAsset(fromAsset string) futures.CreateConvertQuoteServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FromAsset", fromAsset)
+	ret0, _ := ret[0].(futures.CreateConvertQuoteServiceAPI)
+	return ret0
+}
+
+// FromAsset indicates an expected call of FromAsset.
+func (mr *MockCreateConvertQuoteServiceAPIMockRecorder) FromAsset(fromAsset interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FromAsset", reflect.TypeOf((*MockCreateConvertQuoteServiceAPI)(nil).FromAsset), fromAsset)
+}
+
+// ToAmount mocks base method.
+func (m *MockCreateConvertQuoteServiceAPI) ToAmount(toAmount string) futures.CreateConvertQuoteServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ToAmount", toAmount)
+	ret0, _ := ret[0].(futures.CreateConvertQuoteServiceAPI)
+	return ret0
+}
+
+// ToAmount indicates an expected call of ToAmount.
+func (mr *MockCreateConvertQuoteServiceAPIMockRecorder) ToAmount(toAmount interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ToAmount", reflect.TypeOf((*MockCreateConvertQuoteServiceAPI)(nil).ToAmount), toAmount)
+}
+
+// ToAsset mocks base method.
+func (m *MockCreateConvertQuoteServiceAPI) ToAsset(toAsset string) futures.CreateConvertQuoteServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ToAsset", toAsset)
+	ret0, _ := ret[0].(futures.CreateConvertQuoteServiceAPI)
+	return ret0
+}
+
+// ToAsset indicates an expected call of ToAsset.
+func (mr *MockCreateConvertQuoteServiceAPIMockRecorder) ToAsset(toAsset interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ToAsset", reflect.TypeOf((*MockCreateConvertQuoteServiceAPI)(nil).ToAsset), toAsset)
+}
+
+// ValidTime mocks base method.
+func (m *MockCreateConvertQuoteServiceAPI) ValidTime(validTime futures.ConvertValidTime) futures.CreateConvertQuoteServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ValidTime", validTime)
+	ret0, _ := ret[0].(futures.CreateConvertQuoteServiceAPI)
+	return ret0
+}
+
+// ValidTime indicates an expected call of ValidTime.
+func (mr *MockCreateConvertQuoteServiceAPIMockRecorder) ValidTime(validTime interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidTime", reflect.TypeOf((*MockCreateConvertQuoteServiceAPI)(nil).ValidTime), validTime)
+}
+
+// MockConvertAcceptServiceAPI is a mock of ConvertAcceptServiceAPI interface.
+type MockConvertAcceptServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockConvertAcceptServiceAPIMockRecorder
+}
+
+// MockConvertAcceptServiceAPIMockRecorder is the mock recorder for MockConvertAcceptServiceAPI.
+type MockConvertAcceptServiceAPIMockRecorder struct {
+	mock *MockConvertAcceptServiceAPI
+}
+
+// NewMockConvertAcceptServiceAPI creates a new mock instance.
+func NewMockConvertAcceptServiceAPI(ctrl *gomock.Controller) *MockConvertAcceptServiceAPI {
+	mock := &MockConvertAcceptServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockConvertAcceptServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockConvertAcceptServiceAPI) EXPECT() *MockConvertAcceptServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockConvertAcceptServiceAPI) Do(ctx context.Context, opts ...futures.RequestOption) (*futures.ConvertResult, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*futures.ConvertResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockConvertAcceptServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockConvertAcceptServiceAPI)(nil).Do), varargs...)
+}
+
+// QuoteId mocks base method.
+func (m *MockConvertAcceptServiceAPI) QuoteId(quoteId string) futures.ConvertAcceptServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "QuoteId", quoteId)
+	ret0, _ := ret[0].(futures.ConvertAcceptServiceAPI)
+	return ret0
+}
+
+// QuoteId indicates an expected call of QuoteId.
+func (mr *MockConvertAcceptServiceAPIMockRecorder) QuoteId(quoteId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QuoteId", reflect.TypeOf((*MockConvertAcceptServiceAPI)(nil).QuoteId), quoteId)
+}
+
+// MockConvertStatusServiceAPI is a mock of ConvertStatusServiceAPI interface.
+type MockConvertStatusServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockConvertStatusServiceAPIMockRecorder
+}
+
+// MockConvertStatusServiceAPIMockRecorder is the mock recorder for MockConvertStatusServiceAPI.
+type MockConvertStatusServiceAPIMockRecorder struct {
+	mock *MockConvertStatusServiceAPI
+}
+
+// NewMockConvertStatusServiceAPI creates a new mock instance.
+func NewMockConvertStatusServiceAPI(ctrl *gomock.Controller) *MockConvertStatusServiceAPI {
+	mock := &MockConvertStatusServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockConvertStatusServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockConvertStatusServiceAPI) EXPECT() *MockConvertStatusServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockConvertStatusServiceAPI) Do(ctx context.Context, opts ...futures.RequestOption) (*futures.ConvertStatusResult, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*futures.ConvertStatusResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockConvertStatusServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockConvertStatusServiceAPI)(nil).Do), varargs...)
+}
+
+// OrderId mocks base method.
+func (m *MockConvertStatusServiceAPI) OrderId(orderId string) futures.ConvertStatusServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OrderId", orderId)
+	ret0, _ := ret[0].(futures.ConvertStatusServiceAPI)
+	return ret0
+}
+
+// OrderId indicates an expected call of OrderId.
+func (mr *MockConvertStatusServiceAPIMockRecorder) OrderId(orderId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OrderId", reflect.TypeOf((*MockConvertStatusServiceAPI)(nil).OrderId), orderId)
+}
+
+// QuoteId mocks base method.
+func (m *MockConvertStatusServiceAPI) QuoteId(quoteId string) futures.ConvertStatusServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "QuoteId", quoteId)
+	ret0, _ := ret[0].(futures.ConvertStatusServiceAPI)
+	return ret0
+}
+
+// QuoteId indicates an expected call of QuoteId.
+func (mr *MockConvertStatusServiceAPIMockRecorder) QuoteId(quoteId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QuoteId", reflect.TypeOf((*MockConvertStatusServiceAPI)(nil).QuoteId), quoteId)
+}
+
+// MockApiTradingStatusServiceAPI is a mock of ApiTradingStatusServiceAPI interface.
+type MockApiTradingStatusServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockApiTradingStatusServiceAPIMockRecorder
+}
+
+// MockApiTradingStatusServiceAPIMockRecorder is the mock recorder for MockApiTradingStatusServiceAPI.
+type MockApiTradingStatusServiceAPIMockRecorder struct {
+	mock *MockApiTradingStatusServiceAPI
+}
+
+// NewMockApiTradingStatusServiceAPI creates a new mock instance.
+func NewMockApiTradingStatusServiceAPI(ctrl *gomock.Controller) *MockApiTradingStatusServiceAPI {
+	mock := &MockApiTradingStatusServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockApiTradingStatusServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockApiTradingStatusServiceAPI) EXPECT() *MockApiTradingStatusServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockApiTradingStatusServiceAPI) Do(ctx context.Context, opts ...futures.RequestOption) (*futures.TradingStatusIndicators, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*futures.TradingStatusIndicators)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockApiTradingStatusServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockApiTradingStatusServiceAPI)(nil).Do), varargs...)
+}
+
+// Symbol mocks base method.
+func (m *MockApiTradingStatusServiceAPI) Symbol(symbol string) futures.ApiTradingStatusServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Symbol", symbol)
+	ret0, _ := ret[0].(futures.ApiTradingStatusServiceAPI)
+	return ret0
+}
+
+// Symbol indicates an expected call of Symbol.
+func (mr *MockApiTradingStatusServiceAPIMockRecorder) Symbol(symbol interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Symbol", reflect.TypeOf((*MockApiTradingStatusServiceAPI)(nil).Symbol), symbol)
+}
@@ -0,0 +1,68 @@
+package mock
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/adshao/go-binance/v2/futures"
+)
+
+type serviceAPITestSuite struct {
+	suite.Suite
+}
+
+func TestServiceAPI(t *testing.T) {
+	suite.Run(t, new(serviceAPITestSuite))
+}
+
+// cancelOrder is code under test, canceling an order through the services of c
+func cancelOrder(ctx context.Context, c futures.ClientAPI, symbol string, orderID int64) (futures.OrderStatusType, error) {
+	res, err := c.NewCancelOrderService().Symbol(symbol).OrderID(orderID).Do(ctx)
+	if err != nil {
+		return "", err
+	}
+	return res.Status, nil
+}
+
+func (s *serviceAPITestSuite) TestMockServices() {
+	ctrl := gomock.NewController(s.T())
+	defer ctrl.Finish()
+
+	client := NewMockClientAPI(ctrl)
+	service := NewMockCancelOrderServiceAPI(ctrl)
+	client.EXPECT().NewCancelOrderService().Return(service)
+	service.EXPECT().Symbol("BTCUSDT").Return(service)
+	service.EXPECT().OrderID(int64(42)).Return(service)
+	service.EXPECT().Do(gomock.Any()).Return(&futures.CancelOrderResponse{Status: futures.OrderStatusTypeCanceled}, nil)
+
+	status, err := cancelOrder(context.Background(), client, "BTCUSDT", 42)
+	s.Require().NoError(err)
+	s.Equal(futures.OrderStatusTypeCanceled, status)
+}
+
+func (s *serviceAPITestSuite) TestClientAPI() {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		s.Equal(http.MethodDelete, r.Method)
+		s.Equal("/fapi/v1/order", r.URL.Path)
+		body, err := io.ReadAll(r.Body)
+		s.Require().NoError(err)
+		form, err := url.ParseQuery(string(body))
+		s.Require().NoError(err)
+		s.Equal("BTCUSDT", form.Get("symbol"))
+		s.Equal("7", form.Get("orderId"))
+		w.Write([]byte(`{"symbol":"BTCUSDT","orderId":7,"status":"CANCELED"}`))
+	}))
+	defer server.Close()
+
+	c := futures.NewClientWithEnvironment("key", "secret", futures.ProductionEnvironment().WithAPIURL(server.URL))
+	status, err := cancelOrder(context.Background(), futures.NewClientAPI(c), "BTCUSDT", 7)
+	s.Require().NoError(err)
+	s.Equal(futures.OrderStatusTypeCanceled, status)
+}
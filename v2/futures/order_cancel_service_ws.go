@@ -80,6 +80,8 @@ type OrderCancelWsService struct {
 	ApiKey     string
 	SecretKey  string
 	KeyType    string
+	Signer     common.Signer       // signs requests instead of SecretKey when set
+	signers    *common.SignerCache // parses SecretKey once
 	TimeOffset int64
 }
 
@@ -100,6 +102,7 @@ func NewOrderCancelWsService(apiKey, secretKey string) (*OrderCancelWsService, e
 		ApiKey:    apiKey,
 		SecretKey: secretKey,
 		KeyType:   common.KeyTypeHmac,
+		signers:   new(common.SignerCache),
 	}, nil
 }
 
@@ -112,7 +115,7 @@ func (s *OrderCancelWsService) Do(requestID string, request *OrderCancelRequest)
 			s.SecretKey,
 			s.TimeOffset,
 			s.KeyType,
-		),
+		).WithSigner(s.Signer).WithSignerCache(s.signers),
 		websocket.CancelFuturesWsApiMethod,
 		request.buildParams(),
 	)
@@ -136,7 +139,7 @@ func (s *OrderCancelWsService) SyncDo(requestID string, request *OrderCancelRequ
 			s.SecretKey,
 			s.TimeOffset,
 			s.KeyType,
-		),
+		).WithSigner(s.Signer).WithSignerCache(s.signers),
 		websocket.CancelFuturesWsApiMethod,
 		request.buildParams(),
 	)
@@ -0,0 +1,97 @@
+package futures
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/adshao/go-binance/v2/common/websocket"
+	"github.com/adshao/go-binance/v2/common/websocket/mock"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/suite"
+)
+
+type orderModifyServiceWsTestSuite struct {
+	suite.Suite
+
+	ctrl   *gomock.Controller
+	client *mock.MockClient
+
+	requestID string
+
+	service *OrderModifyWsService
+	request *OrderModifyWsRequest
+}
+
+func (s *orderModifyServiceWsTestSuite) SetupTest() {
+	s.requestID = "e2a85d9f-07a5-4f94-8d5f-789dc3deb098"
+
+	s.ctrl = gomock.NewController(s.T())
+	s.client = mock.NewMockClient(s.ctrl)
+
+	s.service = &OrderModifyWsService{
+		c:         s.client,
+		ApiKey:    "dummyApiKey",
+		SecretKey: "dummySecretKey",
+		KeyType:   "HMAC",
+	}
+
+	s.request = NewOrderModifyWsRequest().Symbol("BTCUSDT").Side(SideTypeBuy).Quantity("1").OrderID(328971409).Price("59000")
+}
+
+func (s *orderModifyServiceWsTestSuite) TearDownTest() {
+	s.ctrl.Finish()
+}
+
+func TestOrderModifyWsService(t *testing.T) {
+	suite.Run(t, new(orderModifyServiceWsTestSuite))
+}
+
+func (s *orderModifyServiceWsTestSuite) TestDo() {
+	var data []byte
+	s.client.EXPECT().Write(s.requestID, gomock.Any()).DoAndReturn(func(id string, raw []byte) error {
+		data = raw
+		return nil
+	}).Times(1)
+
+	err := s.service.Do(s.requestID, s.request)
+	s.Require().NoError(err)
+
+	req := websocket.WsApiRequest{}
+	s.Require().NoError(json.Unmarshal(data, &req))
+	s.Equal(s.requestID, req.Id)
+	s.Equal(websocket.WsApiMethodType("order.modify"), req.Method)
+	for k, v := range map[string]interface{}{"symbol": "BTCUSDT", "side": "BUY", "quantity": "1", "orderId": float64(328971409), "price": "59000"} {
+		s.Equal(v, req.Params[k], k)
+	}
+	s.Contains(req.Params, "signature")
+}
+
+func (s *orderModifyServiceWsTestSuite) TestDo_EmptyApiKey() {
+	s.service.ApiKey = ""
+	s.client.EXPECT().Write(gomock.Any(), gomock.Any()).Times(0)
+
+	err := s.service.Do(s.requestID, s.request)
+	s.ErrorIs(err, websocket.ErrorApiKeyIsNotSet)
+}
+
+func (s *orderModifyServiceWsTestSuite) TestSyncDo() {
+	rawResponseData := []byte(fmt.Sprintf(`{"id": "%s", "status": 200, "result": {"orderId": 328971409, "symbol": "BTCUSDT", "status": "NEW", "clientOrderId": "xGHfltUMExx0TbQstQQfRX", "price": "59000", "origQty": "1", "executedQty": "0", "timeInForce": "GTC", "type": "LIMIT", "side": "BUY", "priceMatch": "NONE", "updateTime": 1728416138285}}`, s.requestID))
+	s.client.EXPECT().WriteSync(s.requestID, gomock.Any(), gomock.Any()).Return(rawResponseData, nil).Times(1)
+
+	response, err := s.service.SyncDo(s.requestID, s.request)
+	s.Require().NoError(err)
+	s.Equal(s.requestID, response.Id)
+	s.Equal(int64(328971409), response.Result.OrderID)
+	s.Equal(OrderStatusTypeNew, response.Result.Status)
+	s.Equal("59000", response.Result.Price)
+	s.Equal(PriceMatchTypeNone, response.Result.PriceMatch)
+}
+
+func (s *orderModifyServiceWsTestSuite) TestSyncDo_EmptyRequestID() {
+	s.client.EXPECT().WriteSync(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+	response, err := s.service.SyncDo("", s.request)
+	s.Nil(response)
+	s.ErrorIs(err, websocket.ErrorRequestIDNotSet)
+}
@@ -14,6 +14,8 @@ type OrderPlaceWsService struct {
 	ApiKey     string
 	SecretKey  string
 	KeyType    string
+	Signer     common.Signer       // signs requests instead of SecretKey when set
+	signers    *common.SignerCache // parses SecretKey once
 	TimeOffset int64
 }
 
@@ -34,6 +36,7 @@ func NewOrderPlaceWsService(apiKey, secretKey string) (*OrderPlaceWsService, err
 		ApiKey:    apiKey,
 		SecretKey: secretKey,
 		KeyType:   common.KeyTypeHmac,
+		signers:   new(common.SignerCache),
 	}, nil
 }
 
@@ -246,7 +249,7 @@ func (s *OrderPlaceWsService) Do(requestID string, request *OrderPlaceWsRequest)
 			s.SecretKey,
 			s.TimeOffset,
 			s.KeyType,
-		),
+		).WithSigner(s.Signer).WithSignerCache(s.signers),
 		websocket.OrderPlaceFuturesWsApiMethod,
 		request.buildParams(),
 	)
@@ -270,7 +273,7 @@ func (s *OrderPlaceWsService) SyncDo(requestID string, request *OrderPlaceWsRequ
 			s.SecretKey,
 			s.TimeOffset,
 			s.KeyType,
-		),
+		).WithSigner(s.Signer).WithSignerCache(s.signers),
 		websocket.OrderPlaceFuturesWsApiMethod,
 		request.buildParams(),
 	)
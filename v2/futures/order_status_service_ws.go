@@ -14,6 +14,8 @@ type OrderStatusWsService struct {
 	ApiKey     string
 	SecretKey  string
 	KeyType    string
+	Signer     common.Signer       // signs requests instead of SecretKey when set
+	signers    *common.SignerCache // parses SecretKey once
 	TimeOffset int64
 }
 
@@ -34,6 +36,7 @@ func NewOrderStatusWsService(apiKey, secretKey string) (*OrderStatusWsService, e
 		ApiKey:    apiKey,
 		SecretKey: secretKey,
 		KeyType:   common.KeyTypeHmac,
+		signers:   new(common.SignerCache),
 	}, nil
 }
 
@@ -136,7 +139,7 @@ func (s *OrderStatusWsService) Do(requestID string, request *OrderStatusWsReques
 			s.SecretKey,
 			s.TimeOffset,
 			s.KeyType,
-		),
+		).WithSigner(s.Signer).WithSignerCache(s.signers),
 		websocket.OrderStatusFuturesWsApiMethod,
 		request.buildParams(),
 	)
@@ -160,7 +163,7 @@ func (s *OrderStatusWsService) SyncDo(requestID string, request *OrderStatusWsRe
 			s.SecretKey,
 			s.TimeOffset,
 			s.KeyType,
-		),
+		).WithSigner(s.Signer).WithSignerCache(s.signers),
 		websocket.OrderStatusFuturesWsApiMethod,
 		request.buildParams(),
 	)
@@ -0,0 +1,2162 @@
+// Code generated by servicegen. DO NOT EDIT.
+
+package futures
+
+import (
+	"context"
+)
+
+// ClientAPI is the interface of the REST services of Client, see NewClientAPI
+type ClientAPI interface {
+	NewPingService() PingServiceAPI
+	NewServerTimeService() ServerTimeServiceAPI
+	NewSetServerTimeService() SetServerTimeServiceAPI
+	NewDepthService() DepthServiceAPI
+	NewAggTradesService() AggTradesServiceAPI
+	NewRecentTradesService() RecentTradesServiceAPI
+	NewKlinesService() KlinesServiceAPI
+	NewContinuousKlinesService() ContinuousKlinesServiceAPI
+	NewIndexPriceKlinesService() IndexPriceKlinesServiceAPI
+	NewMarkPriceKlinesService() MarkPriceKlinesServiceAPI
+	NewListPriceChangeStatsService() ListPriceChangeStatsServiceAPI
+	NewListPricesService() ListPricesServiceAPI
+	NewListBookTickersService() ListBookTickersServiceAPI
+	NewCreateOrderService() CreateOrderServiceAPI
+	NewModifyOrderService() ModifyOrderServiceAPI
+	NewCreateBatchOrdersService() CreateBatchOrdersServiceAPI
+	NewModifyBatchOrdersService() ModifyBatchOrdersServiceAPI
+	NewGetOrderService() GetOrderServiceAPI
+	NewCancelOrderService() CancelOrderServiceAPI
+	NewCancelAllOpenOrdersService() CancelAllOpenOrdersServiceAPI
+	NewCancelMultipleOrdersService() CancelMultiplesOrdersServiceAPI
+	NewGetOpenOrderService() GetOpenOrderServiceAPI
+	NewListOpenOrdersService() ListOpenOrdersServiceAPI
+	NewListOrdersService() ListOrdersServiceAPI
+	NewGetAccountService() GetAccountServiceAPI
+	NewGetAccountV3Service() GetAccountV3ServiceAPI
+	NewGetBalanceService() GetBalanceServiceAPI
+	NewGetAccountConfigService() AccountConfigServiceAPI
+	NewGetSymbolConfigService() SymbolConfigServiceAPI
+	NewGetPositionRiskService() GetPositionRiskServiceAPI
+	NewGetPositionRiskV3Service() GetPositionRiskV3ServiceAPI
+	NewGetPositionMarginHistoryService() GetPositionMarginHistoryServiceAPI
+	NewGetIncomeHistoryService() GetIncomeHistoryServiceAPI
+	NewHistoricalTradesService() HistoricalTradesServiceAPI
+	NewListAccountTradeService() ListAccountTradeServiceAPI
+	NewStartUserStreamService() StartUserStreamServiceAPI
+	NewKeepaliveUserStreamService() KeepaliveUserStreamServiceAPI
+	NewCloseUserStreamService() CloseUserStreamServiceAPI
+	NewExchangeInfoService() ExchangeInfoServiceAPI
+	NewPremiumIndexService() PremiumIndexServiceAPI
+	NewPremiumIndexKlinesService() PremiumIndexKlinesServiceAPI
+	NewFundingRateService() FundingRateServiceAPI
+	NewFundingRateInfoService() FundingRateInfoServiceAPI
+	NewListUserLiquidationOrdersService() ListUserLiquidationOrdersServiceAPI
+	NewListLiquidationOrdersService() ListLiquidationOrdersServiceAPI
+	NewChangeLeverageService() ChangeLeverageServiceAPI
+	NewGetLeverageBracketService() GetLeverageBracketServiceAPI
+	NewChangeMarginTypeService() ChangeMarginTypeServiceAPI
+	NewUpdatePositionMarginService() UpdatePositionMarginServiceAPI
+	NewChangePositionModeService() ChangePositionModeServiceAPI
+	NewGetPositionModeService() GetPositionModeServiceAPI
+	NewChangeMultiAssetModeService() ChangeMultiAssetModeServiceAPI
+	NewGetMultiAssetModeService() GetMultiAssetModeServiceAPI
+	NewGetRebateNewUserService() GetRebateNewUserServiceAPI
+	NewCommissionRateService() CommissionRateServiceAPI
+	NewGetOpenInterestService() GetOpenInterestServiceAPI
+	NewOpenInterestStatisticsService() OpenInterestStatisticsServiceAPI
+	NewLongShortRatioService() LongShortRatioServiceAPI
+	NewDeliveryPriceService() DeliveryPriceServiceAPI
+	NewTopLongShortAccountRatioService() TopLongShortAccountRatioServiceAPI
+	NewTopLongShortPositionRatioService() TopLongShortPositionRatioServiceAPI
+	NewTakerLongShortRatioService() TakerLongShortRatioServiceAPI
+	NewBasisService() BasisServiceAPI
+	NewIndexInfoService() IndexInfoServiceAPI
+	NewAssetIndexService() AssetIndexServiceAPI
+	NewConstituentsService() ConstituentsServiceAPI
+	NewLvtKlinesService() LvtKlinesServiceAPI
+	NewGetFeeBurnService() GetFeeBurnServiceAPI
+	NewFeeBurnService() FeeBurnServiceAPI
+	NewListConvertExchangeInfoService() ListConvertExchangeInfoServiceAPI
+	NewCreateConvertQuoteService() CreateConvertQuoteServiceAPI
+	NewConvertAcceptService() ConvertAcceptServiceAPI
+	NewGetConvertStatusService() ConvertStatusServiceAPI
+	NewApiTradingStatusService() ApiTradingStatusServiceAPI
+}
+
+// NewClientAPI returns c as a ClientAPI
+func NewClientAPI(c *Client) ClientAPI {
+	return (*clientAPI)(c)
+}
+
+type clientAPI Client
+
+func (a *clientAPI) NewPingService() PingServiceAPI {
+	return (*pingServiceAPI)((*Client)(a).NewPingService())
+}
+
+func (a *clientAPI) NewServerTimeService() ServerTimeServiceAPI {
+	return (*serverTimeServiceAPI)((*Client)(a).NewServerTimeService())
+}
+
+func (a *clientAPI) NewSetServerTimeService() SetServerTimeServiceAPI {
+	return (*setServerTimeServiceAPI)((*Client)(a).NewSetServerTimeService())
+}
+
+func (a *clientAPI) NewDepthService() DepthServiceAPI {
+	return (*depthServiceAPI)((*Client)(a).NewDepthService())
+}
+
+func (a *clientAPI) NewAggTradesService() AggTradesServiceAPI {
+	return (*aggTradesServiceAPI)((*Client)(a).NewAggTradesService())
+}
+
+func (a *clientAPI) NewRecentTradesService() RecentTradesServiceAPI {
+	return (*recentTradesServiceAPI)((*Client)(a).NewRecentTradesService())
+}
+
+func (a *clientAPI) NewKlinesService() KlinesServiceAPI {
+	return (*klinesServiceAPI)((*Client)(a).NewKlinesService())
+}
+
+func (a *clientAPI) NewContinuousKlinesService() ContinuousKlinesServiceAPI {
+	return (*continuousKlinesServiceAPI)((*Client)(a).NewContinuousKlinesService())
+}
+
+func (a *clientAPI) NewIndexPriceKlinesService() IndexPriceKlinesServiceAPI {
+	return (*indexPriceKlinesServiceAPI)((*Client)(a).NewIndexPriceKlinesService())
+}
+
+func (a *clientAPI) NewMarkPriceKlinesService() MarkPriceKlinesServiceAPI {
+	return (*markPriceKlinesServiceAPI)((*Client)(a).NewMarkPriceKlinesService())
+}
+
+func (a *clientAPI) NewListPriceChangeStatsService() ListPriceChangeStatsServiceAPI {
+	return (*listPriceChangeStatsServiceAPI)((*Client)(a).NewListPriceChangeStatsService())
+}
+
+func (a *clientAPI) NewListPricesService() ListPricesServiceAPI {
+	return (*listPricesServiceAPI)((*Client)(a).NewListPricesService())
+}
+
+func (a *clientAPI) NewListBookTickersService() ListBookTickersServiceAPI {
+	return (*listBookTickersServiceAPI)((*Client)(a).NewListBookTickersService())
+}
+
+func (a *clientAPI) NewCreateOrderService() CreateOrderServiceAPI {
+	return (*createOrderServiceAPI)((*Client)(a).NewCreateOrderService())
+}
+
+func (a *clientAPI) NewModifyOrderService() ModifyOrderServiceAPI {
+	return (*modifyOrderServiceAPI)((*Client)(a).NewModifyOrderService())
+}
+
+func (a *clientAPI) NewCreateBatchOrdersService() CreateBatchOrdersServiceAPI {
+	return (*createBatchOrdersServiceAPI)((*Client)(a).NewCreateBatchOrdersService())
+}
+
+func (a *clientAPI) NewModifyBatchOrdersService() ModifyBatchOrdersServiceAPI {
+	return (*modifyBatchOrdersServiceAPI)((*Client)(a).NewModifyBatchOrdersService())
+}
+
+func (a *clientAPI) NewGetOrderService() GetOrderServiceAPI {
+	return (*getOrderServiceAPI)((*Client)(a).NewGetOrderService())
+}
+
+func (a *clientAPI) NewCancelOrderService() CancelOrderServiceAPI {
+	return (*cancelOrderServiceAPI)((*Client)(a).NewCancelOrderService())
+}
+
+func (a *clientAPI) NewCancelAllOpenOrdersService() CancelAllOpenOrdersServiceAPI {
+	return (*cancelAllOpenOrdersServiceAPI)((*Client)(a).NewCancelAllOpenOrdersService())
+}
+
+func (a *clientAPI) NewCancelMultipleOrdersService() CancelMultiplesOrdersServiceAPI {
+	return (*cancelMultiplesOrdersServiceAPI)((*Client)(a).NewCancelMultipleOrdersService())
+}
+
+func (a *clientAPI) NewGetOpenOrderService() GetOpenOrderServiceAPI {
+	return (*getOpenOrderServiceAPI)((*Client)(a).NewGetOpenOrderService())
+}
+
+func (a *clientAPI) NewListOpenOrdersService() ListOpenOrdersServiceAPI {
+	return (*listOpenOrdersServiceAPI)((*Client)(a).NewListOpenOrdersService())
+}
+
+func (a *clientAPI) NewListOrdersService() ListOrdersServiceAPI {
+	return (*listOrdersServiceAPI)((*Client)(a).NewListOrdersService())
+}
+
+func (a *clientAPI) NewGetAccountService() GetAccountServiceAPI {
+	return (*getAccountServiceAPI)((*Client)(a).NewGetAccountService())
+}
+
+func (a *clientAPI) NewGetAccountV3Service() GetAccountV3ServiceAPI {
+	return (*getAccountV3ServiceAPI)((*Client)(a).NewGetAccountV3Service())
+}
+
+func (a *clientAPI) NewGetBalanceService() GetBalanceServiceAPI {
+	return (*getBalanceServiceAPI)((*Client)(a).NewGetBalanceService())
+}
+
+func (a *clientAPI) NewGetAccountConfigService() AccountConfigServiceAPI {
+	return (*accountConfigServiceAPI)((*Client)(a).NewGetAccountConfigService())
+}
+
+func (a *clientAPI) NewGetSymbolConfigService() SymbolConfigServiceAPI {
+	return (*symbolConfigServiceAPI)((*Client)(a).NewGetSymbolConfigService())
+}
+
+func (a *clientAPI) NewGetPositionRiskService() GetPositionRiskServiceAPI {
+	return (*getPositionRiskServiceAPI)((*Client)(a).NewGetPositionRiskService())
+}
+
+func (a *clientAPI) NewGetPositionRiskV3Service() GetPositionRiskV3ServiceAPI {
+	return (*getPositionRiskV3ServiceAPI)((*Client)(a).NewGetPositionRiskV3Service())
+}
+
+func (a *clientAPI) NewGetPositionMarginHistoryService() GetPositionMarginHistoryServiceAPI {
+	return (*getPositionMarginHistoryServiceAPI)((*Client)(a).NewGetPositionMarginHistoryService())
+}
+
+func (a *clientAPI) NewGetIncomeHistoryService() GetIncomeHistoryServiceAPI {
+	return (*getIncomeHistoryServiceAPI)((*Client)(a).NewGetIncomeHistoryService())
+}
+
+func (a *clientAPI) NewHistoricalTradesService() HistoricalTradesServiceAPI {
+	return (*historicalTradesServiceAPI)((*Client)(a).NewHistoricalTradesService())
+}
+
+func (a *clientAPI) NewListAccountTradeService() ListAccountTradeServiceAPI {
+	return (*listAccountTradeServiceAPI)((*Client)(a).NewListAccountTradeService())
+}
+
+func (a *clientAPI) NewStartUserStreamService() StartUserStreamServiceAPI {
+	return (*startUserStreamServiceAPI)((*Client)(a).NewStartUserStreamService())
+}
+
+func (a *clientAPI) NewKeepaliveUserStreamService() KeepaliveUserStreamServiceAPI {
+	return (*keepaliveUserStreamServiceAPI)((*Client)(a).NewKeepaliveUserStreamService())
+}
+
+func (a *clientAPI) NewCloseUserStreamService() CloseUserStreamServiceAPI {
+	return (*closeUserStreamServiceAPI)((*Client)(a).NewCloseUserStreamService())
+}
+
+func (a *clientAPI) NewExchangeInfoService() ExchangeInfoServiceAPI {
+	return (*exchangeInfoServiceAPI)((*Client)(a).NewExchangeInfoService())
+}
+
+func (a *clientAPI) NewPremiumIndexService() PremiumIndexServiceAPI {
+	return (*premiumIndexServiceAPI)((*Client)(a).NewPremiumIndexService())
+}
+
+func (a *clientAPI) NewPremiumIndexKlinesService() PremiumIndexKlinesServiceAPI {
+	return (*premiumIndexKlinesServiceAPI)((*Client)(a).NewPremiumIndexKlinesService())
+}
+
+func (a *clientAPI) NewFundingRateService() FundingRateServiceAPI {
+	return (*fundingRateServiceAPI)((*Client)(a).NewFundingRateService())
+}
+
+func (a *clientAPI) NewFundingRateInfoService() FundingRateInfoServiceAPI {
+	return (*fundingRateInfoServiceAPI)((*Client)(a).NewFundingRateInfoService())
+}
+
+func (a *clientAPI) NewListUserLiquidationOrdersService() ListUserLiquidationOrdersServiceAPI {
+	return (*listUserLiquidationOrdersServiceAPI)((*Client)(a).NewListUserLiquidationOrdersService())
+}
+
+func (a *clientAPI) NewListLiquidationOrdersService() ListLiquidationOrdersServiceAPI {
+	return (*listLiquidationOrdersServiceAPI)((*Client)(a).NewListLiquidationOrdersService())
+}
+
+func (a *clientAPI) NewChangeLeverageService() ChangeLeverageServiceAPI {
+	return (*changeLeverageServiceAPI)((*Client)(a).NewChangeLeverageService())
+}
+
+func (a *clientAPI) NewGetLeverageBracketService() GetLeverageBracketServiceAPI {
+	return (*getLeverageBracketServiceAPI)((*Client)(a).NewGetLeverageBracketService())
+}
+
+func (a *clientAPI) NewChangeMarginTypeService() ChangeMarginTypeServiceAPI {
+	return (*changeMarginTypeServiceAPI)((*Client)(a).NewChangeMarginTypeService())
+}
+
+func (a *clientAPI) NewUpdatePositionMarginService() UpdatePositionMarginServiceAPI {
+	return (*updatePositionMarginServiceAPI)((*Client)(a).NewUpdatePositionMarginService())
+}
+
+func (a *clientAPI) NewChangePositionModeService() ChangePositionModeServiceAPI {
+	return (*changePositionModeServiceAPI)((*Client)(a).NewChangePositionModeService())
+}
+
+func (a *clientAPI) NewGetPositionModeService() GetPositionModeServiceAPI {
+	return (*getPositionModeServiceAPI)((*Client)(a).NewGetPositionModeService())
+}
+
+func (a *clientAPI) NewChangeMultiAssetModeService() ChangeMultiAssetModeServiceAPI {
+	return (*changeMultiAssetModeServiceAPI)((*Client)(a).NewChangeMultiAssetModeService())
+}
+
+func (a *clientAPI) NewGetMultiAssetModeService() GetMultiAssetModeServiceAPI {
+	return (*getMultiAssetModeServiceAPI)((*Client)(a).NewGetMultiAssetModeService())
+}
+
+func (a *clientAPI) NewGetRebateNewUserService() GetRebateNewUserServiceAPI {
+	return (*getRebateNewUserServiceAPI)((*Client)(a).NewGetRebateNewUserService())
+}
+
+func (a *clientAPI) NewCommissionRateService() CommissionRateServiceAPI {
+	return (*commissionRateServiceAPI)((*Client)(a).NewCommissionRateService())
+}
+
+func (a *clientAPI) NewGetOpenInterestService() GetOpenInterestServiceAPI {
+	return (*getOpenInterestServiceAPI)((*Client)(a).NewGetOpenInterestService())
+}
+
+func (a *clientAPI) NewOpenInterestStatisticsService() OpenInterestStatisticsServiceAPI {
+	return (*openInterestStatisticsServiceAPI)((*Client)(a).NewOpenInterestStatisticsService())
+}
+
+func (a *clientAPI) NewLongShortRatioService() LongShortRatioServiceAPI {
+	return (*longShortRatioServiceAPI)((*Client)(a).NewLongShortRatioService())
+}
+
+func (a *clientAPI) NewDeliveryPriceService() DeliveryPriceServiceAPI {
+	return (*deliveryPriceServiceAPI)((*Client)(a).NewDeliveryPriceService())
+}
+
+func (a *clientAPI) NewTopLongShortAccountRatioService() TopLongShortAccountRatioServiceAPI {
+	return (*topLongShortAccountRatioServiceAPI)((*Client)(a).NewTopLongShortAccountRatioService())
+}
+
+func (a *clientAPI) NewTopLongShortPositionRatioService() TopLongShortPositionRatioServiceAPI {
+	return (*topLongShortPositionRatioServiceAPI)((*Client)(a).NewTopLongShortPositionRatioService())
+}
+
+func (a *clientAPI) NewTakerLongShortRatioService() TakerLongShortRatioServiceAPI {
+	return (*takerLongShortRatioServiceAPI)((*Client)(a).NewTakerLongShortRatioService())
+}
+
+func (a *clientAPI) NewBasisService() BasisServiceAPI {
+	return (*basisServiceAPI)((*Client)(a).NewBasisService())
+}
+
+func (a *clientAPI) NewIndexInfoService() IndexInfoServiceAPI {
+	return (*indexInfoServiceAPI)((*Client)(a).NewIndexInfoService())
+}
+
+func (a *clientAPI) NewAssetIndexService() AssetIndexServiceAPI {
+	return (*assetIndexServiceAPI)((*Client)(a).NewAssetIndexService())
+}
+
+func (a *clientAPI) NewConstituentsService() ConstituentsServiceAPI {
+	return (*constituentsServiceAPI)((*Client)(a).NewConstituentsService())
+}
+
+func (a *clientAPI) NewLvtKlinesService() LvtKlinesServiceAPI {
+	return (*lvtKlinesServiceAPI)((*Client)(a).NewLvtKlinesService())
+}
+
+func (a *clientAPI) NewGetFeeBurnService() GetFeeBurnServiceAPI {
+	return (*getFeeBurnServiceAPI)((*Client)(a).NewGetFeeBurnService())
+}
+
+func (a *clientAPI) NewFeeBurnService() FeeBurnServiceAPI {
+	return (*feeBurnServiceAPI)((*Client)(a).NewFeeBurnService())
+}
+
+func (a *clientAPI) NewListConvertExchangeInfoService() ListConvertExchangeInfoServiceAPI {
+	return (*listConvertExchangeInfoServiceAPI)((*Client)(a).NewListConvertExchangeInfoService())
+}
+
+func (a *clientAPI) NewCreateConvertQuoteService() CreateConvertQuoteServiceAPI {
+	return (*createConvertQuoteServiceAPI)((*Client)(a).NewCreateConvertQuoteService())
+}
+
+func (a *clientAPI) NewConvertAcceptService() ConvertAcceptServiceAPI {
+	return (*convertAcceptServiceAPI)((*Client)(a).NewConvertAcceptService())
+}
+
+func (a *clientAPI) NewGetConvertStatusService() ConvertStatusServiceAPI {
+	return (*convertStatusServiceAPI)((*Client)(a).NewGetConvertStatusService())
+}
+
+func (a *clientAPI) NewApiTradingStatusService() ApiTradingStatusServiceAPI {
+	return (*apiTradingStatusServiceAPI)((*Client)(a).NewApiTradingStatusService())
+}
+
+// PingServiceAPI is the interface of PingService
+type PingServiceAPI interface {
+	Do(ctx context.Context, opts ...RequestOption) error
+}
+
+type pingServiceAPI PingService
+
+func (a *pingServiceAPI) Do(ctx context.Context, opts ...RequestOption) error {
+	return (*PingService)(a).Do(ctx, opts...)
+}
+
+// ServerTimeServiceAPI is the interface of ServerTimeService
+type ServerTimeServiceAPI interface {
+	Do(ctx context.Context, opts ...RequestOption) (int64, error)
+}
+
+type serverTimeServiceAPI ServerTimeService
+
+func (a *serverTimeServiceAPI) Do(ctx context.Context, opts ...RequestOption) (int64, error) {
+	return (*ServerTimeService)(a).Do(ctx, opts...)
+}
+
+// SetServerTimeServiceAPI is the interface of SetServerTimeService
+type SetServerTimeServiceAPI interface {
+	Do(ctx context.Context, opts ...RequestOption) (int64, error)
+}
+
+type setServerTimeServiceAPI SetServerTimeService
+
+func (a *setServerTimeServiceAPI) Do(ctx context.Context, opts ...RequestOption) (int64, error) {
+	return (*SetServerTimeService)(a).Do(ctx, opts...)
+}
+
+// DepthServiceAPI is the interface of DepthService
+type DepthServiceAPI interface {
+	Symbol(symbol string) DepthServiceAPI
+	Limit(limit int) DepthServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) (*DepthResponse, error)
+}
+
+type depthServiceAPI DepthService
+
+func (a *depthServiceAPI) Symbol(symbol string) DepthServiceAPI {
+	return (*depthServiceAPI)((*DepthService)(a).Symbol(symbol))
+}
+
+func (a *depthServiceAPI) Limit(limit int) DepthServiceAPI {
+	return (*depthServiceAPI)((*DepthService)(a).Limit(limit))
+}
+
+func (a *depthServiceAPI) Do(ctx context.Context, opts ...RequestOption) (*DepthResponse, error) {
+	return (*DepthService)(a).Do(ctx, opts...)
+}
+
+// AggTradesServiceAPI is the interface of AggTradesService
+type AggTradesServiceAPI interface {
+	Symbol(symbol string) AggTradesServiceAPI
+	FromID(fromID int64) AggTradesServiceAPI
+	StartTime(startTime int64) AggTradesServiceAPI
+	EndTime(endTime int64) AggTradesServiceAPI
+	Limit(limit int) AggTradesServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) ([]*AggTrade, error)
+}
+
+type aggTradesServiceAPI AggTradesService
+
+func (a *aggTradesServiceAPI) Symbol(symbol string) AggTradesServiceAPI {
+	return (*aggTradesServiceAPI)((*AggTradesService)(a).Symbol(symbol))
+}
+
+func (a *aggTradesServiceAPI) FromID(fromID int64) AggTradesServiceAPI {
+	return (*aggTradesServiceAPI)((*AggTradesService)(a).FromID(fromID))
+}
+
+func (a *aggTradesServiceAPI) StartTime(startTime int64) AggTradesServiceAPI {
+	return (*aggTradesServiceAPI)((*AggTradesService)(a).StartTime(startTime))
+}
+
+func (a *aggTradesServiceAPI) EndTime(endTime int64) AggTradesServiceAPI {
+	return (*aggTradesServiceAPI)((*AggTradesService)(a).EndTime(endTime))
+}
+
+func (a *aggTradesServiceAPI) Limit(limit int) AggTradesServiceAPI {
+	return (*aggTradesServiceAPI)((*AggTradesService)(a).Limit(limit))
+}
+
+func (a *aggTradesServiceAPI) Do(ctx context.Context, opts ...RequestOption) ([]*AggTrade, error) {
+	return (*AggTradesService)(a).Do(ctx, opts...)
+}
+
+// RecentTradesServiceAPI is the interface of RecentTradesService
+type RecentTradesServiceAPI interface {
+	Symbol(symbol string) RecentTradesServiceAPI
+	Limit(limit int) RecentTradesServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) ([]*Trade, error)
+}
+
+type recentTradesServiceAPI RecentTradesService
+
+func (a *recentTradesServiceAPI) Symbol(symbol string) RecentTradesServiceAPI {
+	return (*recentTradesServiceAPI)((*RecentTradesService)(a).Symbol(symbol))
+}
+
+func (a *recentTradesServiceAPI) Limit(limit int) RecentTradesServiceAPI {
+	return (*recentTradesServiceAPI)((*RecentTradesService)(a).Limit(limit))
+}
+
+func (a *recentTradesServiceAPI) Do(ctx context.Context, opts ...RequestOption) ([]*Trade, error) {
+	return (*RecentTradesService)(a).Do(ctx, opts...)
+}
+
+// KlinesServiceAPI is the interface of KlinesService
+type KlinesServiceAPI interface {
+	Symbol(symbol string) KlinesServiceAPI
+	Interval(interval string) KlinesServiceAPI
+	Limit(limit int) KlinesServiceAPI
+	StartTime(startTime int64) KlinesServiceAPI
+	EndTime(endTime int64) KlinesServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) ([]*Kline, error)
+}
+
+type klinesServiceAPI KlinesService
+
+func (a *klinesServiceAPI) Symbol(symbol string) KlinesServiceAPI {
+	return (*klinesServiceAPI)((*KlinesService)(a).Symbol(symbol))
+}
+
+func (a *klinesServiceAPI) Interval(interval string) KlinesServiceAPI {
+	return (*klinesServiceAPI)((*KlinesService)(a).Interval(interval))
+}
+
+func (a *klinesServiceAPI) Limit(limit int) KlinesServiceAPI {
+	return (*klinesServiceAPI)((*KlinesService)(a).Limit(limit))
+}
+
+func (a *klinesServiceAPI) StartTime(startTime int64) KlinesServiceAPI {
+	return (*klinesServiceAPI)((*KlinesService)(a).StartTime(startTime))
+}
+
+func (a *klinesServiceAPI) EndTime(endTime int64) KlinesServiceAPI {
+	return (*klinesServiceAPI)((*KlinesService)(a).EndTime(endTime))
+}
+
+func (a *klinesServiceAPI) Do(ctx context.Context, opts ...RequestOption) ([]*Kline, error) {
+	return (*KlinesService)(a).Do(ctx, opts...)
+}
+
+// ContinuousKlinesServiceAPI is the interface of ContinuousKlinesService
+type ContinuousKlinesServiceAPI interface {
+	Pair(pair string) ContinuousKlinesServiceAPI
+	ContractType(contractType string) ContinuousKlinesServiceAPI
+	Interval(interval string) ContinuousKlinesServiceAPI
+	Limit(limit int) ContinuousKlinesServiceAPI
+	StartTime(startTime int64) ContinuousKlinesServiceAPI
+	EndTime(endTime int64) ContinuousKlinesServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) ([]*ContinuousKline, error)
+}
+
+type continuousKlinesServiceAPI ContinuousKlinesService
+
+func (a *continuousKlinesServiceAPI) Pair(pair string) ContinuousKlinesServiceAPI {
+	return (*continuousKlinesServiceAPI)((*ContinuousKlinesService)(a).Pair(pair))
+}
+
+func (a *continuousKlinesServiceAPI) ContractType(contractType string) ContinuousKlinesServiceAPI {
+	return (*continuousKlinesServiceAPI)((*ContinuousKlinesService)(a).ContractType(contractType))
+}
+
+func (a *continuousKlinesServiceAPI) Interval(interval string) ContinuousKlinesServiceAPI {
+	return (*continuousKlinesServiceAPI)((*ContinuousKlinesService)(a).Interval(interval))
+}
+
+func (a *continuousKlinesServiceAPI) Limit(limit int) ContinuousKlinesServiceAPI {
+	return (*continuousKlinesServiceAPI)((*ContinuousKlinesService)(a).Limit(limit))
+}
+
+func (a *continuousKlinesServiceAPI) StartTime(startTime int64) ContinuousKlinesServiceAPI {
+	return (*continuousKlinesServiceAPI)((*ContinuousKlinesService)(a).StartTime(startTime))
+}
+
+func (a *continuousKlinesServiceAPI) EndTime(endTime int64) ContinuousKlinesServiceAPI {
+	return (*continuousKlinesServiceAPI)((*ContinuousKlinesService)(a).EndTime(endTime))
+}
+
+func (a *continuousKlinesServiceAPI) Do(ctx context.Context, opts ...RequestOption) ([]*ContinuousKline, error) {
+	return (*ContinuousKlinesService)(a).Do(ctx, opts...)
+}
+
+// IndexPriceKlinesServiceAPI is the interface of IndexPriceKlinesService
+type IndexPriceKlinesServiceAPI interface {
+	Pair(pair string) IndexPriceKlinesServiceAPI
+	Interval(interval string) IndexPriceKlinesServiceAPI
+	Limit(limit int) IndexPriceKlinesServiceAPI
+	StartTime(startTime int64) IndexPriceKlinesServiceAPI
+	EndTime(endTime int64) IndexPriceKlinesServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) ([]*Kline, error)
+}
+
+type indexPriceKlinesServiceAPI IndexPriceKlinesService
+
+func (a *indexPriceKlinesServiceAPI) Pair(pair string) IndexPriceKlinesServiceAPI {
+	return (*indexPriceKlinesServiceAPI)((*IndexPriceKlinesService)(a).Pair(pair))
+}
+
+func (a *indexPriceKlinesServiceAPI) Interval(interval string) IndexPriceKlinesServiceAPI {
+	return (*indexPriceKlinesServiceAPI)((*IndexPriceKlinesService)(a).Interval(interval))
+}
+
+func (a *indexPriceKlinesServiceAPI) Limit(limit int) IndexPriceKlinesServiceAPI {
+	return (*indexPriceKlinesServiceAPI)((*IndexPriceKlinesService)(a).Limit(limit))
+}
+
+func (a *indexPriceKlinesServiceAPI) StartTime(startTime int64) IndexPriceKlinesServiceAPI {
+	return (*indexPriceKlinesServiceAPI)((*IndexPriceKlinesService)(a).StartTime(startTime))
+}
+
+func (a *indexPriceKlinesServiceAPI) EndTime(endTime int64) IndexPriceKlinesServiceAPI {
+	return (*indexPriceKlinesServiceAPI)((*IndexPriceKlinesService)(a).EndTime(endTime))
+}
+
+func (a *indexPriceKlinesServiceAPI) Do(ctx context.Context, opts ...RequestOption) ([]*Kline, error) {
+	return (*IndexPriceKlinesService)(a).Do(ctx, opts...)
+}
+
+// MarkPriceKlinesServiceAPI is the interface of MarkPriceKlinesService
+type MarkPriceKlinesServiceAPI interface {
+	Symbol(symbol string) MarkPriceKlinesServiceAPI
+	Interval(interval string) MarkPriceKlinesServiceAPI
+	Limit(limit int) MarkPriceKlinesServiceAPI
+	StartTime(startTime int64) MarkPriceKlinesServiceAPI
+	EndTime(endTime int64) MarkPriceKlinesServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) ([]*Kline, error)
+}
+
+type markPriceKlinesServiceAPI MarkPriceKlinesService
+
+func (a *markPriceKlinesServiceAPI) Symbol(symbol string) MarkPriceKlinesServiceAPI {
+	return (*markPriceKlinesServiceAPI)((*MarkPriceKlinesService)(a).Symbol(symbol))
+}
+
+func (a *markPriceKlinesServiceAPI) Interval(interval string) MarkPriceKlinesServiceAPI {
+	return (*markPriceKlinesServiceAPI)((*MarkPriceKlinesService)(a).Interval(interval))
+}
+
+func (a *markPriceKlinesServiceAPI) Limit(limit int) MarkPriceKlinesServiceAPI {
+	return (*markPriceKlinesServiceAPI)((*MarkPriceKlinesService)(a).Limit(limit))
+}
+
+func (a *markPriceKlinesServiceAPI) StartTime(startTime int64) MarkPriceKlinesServiceAPI {
+	return (*markPriceKlinesServiceAPI)((*MarkPriceKlinesService)(a).StartTime(startTime))
+}
+
+func (a *markPriceKlinesServiceAPI) EndTime(endTime int64) MarkPriceKlinesServiceAPI {
+	return (*markPriceKlinesServiceAPI)((*MarkPriceKlinesService)(a).EndTime(endTime))
+}
+
+func (a *markPriceKlinesServiceAPI) Do(ctx context.Context, opts ...RequestOption) ([]*Kline, error) {
+	return (*MarkPriceKlinesService)(a).Do(ctx, opts...)
+}
+
+// ListPriceChangeStatsServiceAPI is the interface of ListPriceChangeStatsService
+type ListPriceChangeStatsServiceAPI interface {
+	Symbol(symbol string) ListPriceChangeStatsServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) ([]*PriceChangeStats, error)
+}
+
+type listPriceChangeStatsServiceAPI ListPriceChangeStatsService
+
+func (a *listPriceChangeStatsServiceAPI) Symbol(symbol string) ListPriceChangeStatsServiceAPI {
+	return (*listPriceChangeStatsServiceAPI)((*ListPriceChangeStatsService)(a).Symbol(symbol))
+}
+
+func (a *listPriceChangeStatsServiceAPI) Do(ctx context.Context, opts ...RequestOption) ([]*PriceChangeStats, error) {
+	return (*ListPriceChangeStatsService)(a).Do(ctx, opts...)
+}
+
+// ListPricesServiceAPI is the interface of ListPricesService
+type ListPricesServiceAPI interface {
+	Symbol(symbol string) ListPricesServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) ([]*SymbolPrice, error)
+}
+
+type listPricesServiceAPI ListPricesService
+
+func (a *listPricesServiceAPI) Symbol(symbol string) ListPricesServiceAPI {
+	return (*listPricesServiceAPI)((*ListPricesService)(a).Symbol(symbol))
+}
+
+func (a *listPricesServiceAPI) Do(ctx context.Context, opts ...RequestOption) ([]*SymbolPrice, error) {
+	return (*ListPricesService)(a).Do(ctx, opts...)
+}
+
+// ListBookTickersServiceAPI is the interface of ListBookTickersService
+type ListBookTickersServiceAPI interface {
+	Symbol(symbol string) ListBookTickersServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) ([]*BookTicker, error)
+}
+
+type listBookTickersServiceAPI ListBookTickersService
+
+func (a *listBookTickersServiceAPI) Symbol(symbol string) ListBookTickersServiceAPI {
+	return (*listBookTickersServiceAPI)((*ListBookTickersService)(a).Symbol(symbol))
+}
+
+func (a *listBookTickersServiceAPI) Do(ctx context.Context, opts ...RequestOption) ([]*BookTicker, error) {
+	return (*ListBookTickersService)(a).Do(ctx, opts...)
+}
+
+// CreateOrderServiceAPI is the interface of CreateOrderService
+type CreateOrderServiceAPI interface {
+	Symbol(symbol string) CreateOrderServiceAPI
+	Side(side SideType) CreateOrderServiceAPI
+	PositionSide(positionSide PositionSideType) CreateOrderServiceAPI
+	Type(orderType OrderType) CreateOrderServiceAPI
+	TimeInForce(timeInForce TimeInForceType) CreateOrderServiceAPI
+	Quantity(quantity string) CreateOrderServiceAPI
+	ReduceOnly(reduceOnly bool) CreateOrderServiceAPI
+	Price(price string) CreateOrderServiceAPI
+	PriceMatch(priceMatch PriceMatchType) CreateOrderServiceAPI
+	NewClientOrderID(newClientOrderID string) CreateOrderServiceAPI
+	StopPrice(stopPrice string) CreateOrderServiceAPI
+	WorkingType(workingType WorkingType) CreateOrderServiceAPI
+	ActivationPrice(activationPrice string) CreateOrderServiceAPI
+	CallbackRate(callbackRate string) CreateOrderServiceAPI
+	PriceProtect(priceProtect bool) CreateOrderServiceAPI
+	NewOrderResponseType(newOrderResponseType NewOrderRespType) CreateOrderServiceAPI
+	ClosePosition(closePosition bool) CreateOrderServiceAPI
+	SelfTradePreventionMode(selfTradePreventionMode SelfTradePreventionMode) CreateOrderServiceAPI
+	GoodTillDate(goodTillDate int64) CreateOrderServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) (*CreateOrderResponse, error)
+}
+
+type createOrderServiceAPI CreateOrderService
+
+func (a *createOrderServiceAPI) Symbol(symbol string) CreateOrderServiceAPI {
+	return (*createOrderServiceAPI)((*CreateOrderService)(a).Symbol(symbol))
+}
+
+func (a *createOrderServiceAPI) Side(side SideType) CreateOrderServiceAPI {
+	return (*createOrderServiceAPI)((*CreateOrderService)(a).Side(side))
+}
+
+func (a *createOrderServiceAPI) PositionSide(positionSide PositionSideType) CreateOrderServiceAPI {
+	return (*createOrderServiceAPI)((*CreateOrderService)(a).PositionSide(positionSide))
+}
+
+func (a *createOrderServiceAPI) Type(orderType OrderType) CreateOrderServiceAPI {
+	return (*createOrderServiceAPI)((*CreateOrderService)(a).Type(orderType))
+}
+
+func (a *createOrderServiceAPI) TimeInForce(timeInForce TimeInForceType) CreateOrderServiceAPI {
+	return (*createOrderServiceAPI)((*CreateOrderService)(a).TimeInForce(timeInForce))
+}
+
+func (a *createOrderServiceAPI) Quantity(quantity string) CreateOrderServiceAPI {
+	return (*createOrderServiceAPI)((*CreateOrderService)(a).Quantity(quantity))
+}
+
+func (a *createOrderServiceAPI) ReduceOnly(reduceOnly bool) CreateOrderServiceAPI {
+	return (*createOrderServiceAPI)((*CreateOrderService)(a).ReduceOnly(reduceOnly))
+}
+
+func (a *createOrderServiceAPI) Price(price string) CreateOrderServiceAPI {
+	return (*createOrderServiceAPI)((*CreateOrderService)(a).Price(price))
+}
+
+func (a *createOrderServiceAPI) PriceMatch(priceMatch PriceMatchType) CreateOrderServiceAPI {
+	return (*createOrderServiceAPI)((*CreateOrderService)(a).PriceMatch(priceMatch))
+}
+
+func (a *createOrderServiceAPI) NewClientOrderID(newClientOrderID string) CreateOrderServiceAPI {
+	return (*createOrderServiceAPI)((*CreateOrderService)(a).NewClientOrderID(newClientOrderID))
+}
+
+func (a *createOrderServiceAPI) StopPrice(stopPrice string) CreateOrderServiceAPI {
+	return (*createOrderServiceAPI)((*CreateOrderService)(a).StopPrice(stopPrice))
+}
+
+func (a *createOrderServiceAPI) WorkingType(workingType WorkingType) CreateOrderServiceAPI {
+	return (*createOrderServiceAPI)((*CreateOrderService)(a).WorkingType(workingType))
+}
+
+func (a *createOrderServiceAPI) ActivationPrice(activationPrice string) CreateOrderServiceAPI {
+	return (*createOrderServiceAPI)((*CreateOrderService)(a).ActivationPrice(activationPrice))
+}
+
+func (a *createOrderServiceAPI) CallbackRate(callbackRate string) CreateOrderServiceAPI {
+	return (*createOrderServiceAPI)((*CreateOrderService)(a).CallbackRate(callbackRate))
+}
+
+func (a *createOrderServiceAPI) PriceProtect(priceProtect bool) CreateOrderServiceAPI {
+	return (*createOrderServiceAPI)((*CreateOrderService)(a).PriceProtect(priceProtect))
+}
+
+func (a *createOrderServiceAPI) NewOrderResponseType(newOrderResponseType NewOrderRespType) CreateOrderServiceAPI {
+	return (*createOrderServiceAPI)((*CreateOrderService)(a).NewOrderResponseType(newOrderResponseType))
+}
+
+func (a *createOrderServiceAPI) ClosePosition(closePosition bool) CreateOrderServiceAPI {
+	return (*createOrderServiceAPI)((*CreateOrderService)(a).ClosePosition(closePosition))
+}
+
+func (a *createOrderServiceAPI) SelfTradePreventionMode(selfTradePreventionMode SelfTradePreventionMode) CreateOrderServiceAPI {
+	return (*createOrderServiceAPI)((*CreateOrderService)(a).SelfTradePreventionMode(selfTradePreventionMode))
+}
+
+func (a *createOrderServiceAPI) GoodTillDate(goodTillDate int64) CreateOrderServiceAPI {
+	return (*createOrderServiceAPI)((*CreateOrderService)(a).GoodTillDate(goodTillDate))
+}
+
+func (a *createOrderServiceAPI) Do(ctx context.Context, opts ...RequestOption) (*CreateOrderResponse, error) {
+	return (*CreateOrderService)(a).Do(ctx, opts...)
+}
+
+// ModifyOrderServiceAPI is the interface of ModifyOrderService
+type ModifyOrderServiceAPI interface {
+	Symbol(symbol string) ModifyOrderServiceAPI
+	OrderID(orderID int64) ModifyOrderServiceAPI
+	OrigClientOrderID(origClientOrderID string) ModifyOrderServiceAPI
+	Side(side SideType) ModifyOrderServiceAPI
+	Quantity(quantity string) ModifyOrderServiceAPI
+	Price(price string) ModifyOrderServiceAPI
+	PriceMatch(priceMatch PriceMatchType) ModifyOrderServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) (*ModifyOrderResponse, error)
+}
+
+type modifyOrderServiceAPI ModifyOrderService
+
+func (a *modifyOrderServiceAPI) Symbol(symbol string) ModifyOrderServiceAPI {
+	return (*modifyOrderServiceAPI)((*ModifyOrderService)(a).Symbol(symbol))
+}
+
+func (a *modifyOrderServiceAPI) OrderID(orderID int64) ModifyOrderServiceAPI {
+	return (*modifyOrderServiceAPI)((*ModifyOrderService)(a).OrderID(orderID))
+}
+
+func (a *modifyOrderServiceAPI) OrigClientOrderID(origClientOrderID string) ModifyOrderServiceAPI {
+	return (*modifyOrderServiceAPI)((*ModifyOrderService)(a).OrigClientOrderID(origClientOrderID))
+}
+
+func (a *modifyOrderServiceAPI) Side(side SideType) ModifyOrderServiceAPI {
+	return (*modifyOrderServiceAPI)((*ModifyOrderService)(a).Side(side))
+}
+
+func (a *modifyOrderServiceAPI) Quantity(quantity string) ModifyOrderServiceAPI {
+	return (*modifyOrderServiceAPI)((*ModifyOrderService)(a).Quantity(quantity))
+}
+
+func (a *modifyOrderServiceAPI) Price(price string) ModifyOrderServiceAPI {
+	return (*modifyOrderServiceAPI)((*ModifyOrderService)(a).Price(price))
+}
+
+func (a *modifyOrderServiceAPI) PriceMatch(priceMatch PriceMatchType) ModifyOrderServiceAPI {
+	return (*modifyOrderServiceAPI)((*ModifyOrderService)(a).PriceMatch(priceMatch))
+}
+
+func (a *modifyOrderServiceAPI) Do(ctx context.Context, opts ...RequestOption) (*ModifyOrderResponse, error) {
+	return (*ModifyOrderService)(a).Do(ctx, opts...)
+}
+
+// CreateBatchOrdersServiceAPI is the interface of CreateBatchOrdersService
+type CreateBatchOrdersServiceAPI interface {
+	OrderList(orders []*CreateOrderService) CreateBatchOrdersServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) (*CreateBatchOrdersResponse, error)
+}
+
+type createBatchOrdersServiceAPI CreateBatchOrdersService
+
+func (a *createBatchOrdersServiceAPI) OrderList(orders []*CreateOrderService) CreateBatchOrdersServiceAPI {
+	return (*createBatchOrdersServiceAPI)((*CreateBatchOrdersService)(a).OrderList(orders))
+}
+
+func (a *createBatchOrdersServiceAPI) Do(ctx context.Context, opts ...RequestOption) (*CreateBatchOrdersResponse, error) {
+	return (*CreateBatchOrdersService)(a).Do(ctx, opts...)
+}
+
+// ModifyBatchOrdersServiceAPI is the interface of ModifyBatchOrdersService
+type ModifyBatchOrdersServiceAPI interface {
+	OrderList(orders []*ModifyOrder) ModifyBatchOrdersServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) (*ModifyBatchOrdersResponse, error)
+}
+
+type modifyBatchOrdersServiceAPI ModifyBatchOrdersService
+
+func (a *modifyBatchOrdersServiceAPI) OrderList(orders []*ModifyOrder) ModifyBatchOrdersServiceAPI {
+	return (*modifyBatchOrdersServiceAPI)((*ModifyBatchOrdersService)(a).OrderList(orders))
+}
+
+func (a *modifyBatchOrdersServiceAPI) Do(ctx context.Context, opts ...RequestOption) (*ModifyBatchOrdersResponse, error) {
+	return (*ModifyBatchOrdersService)(a).Do(ctx, opts...)
+}
+
+// GetOrderServiceAPI is the interface of GetOrderService
+type GetOrderServiceAPI interface {
+	Symbol(symbol string) GetOrderServiceAPI
+	OrderID(orderID int64) GetOrderServiceAPI
+	OrigClientOrderID(origClientOrderID string) GetOrderServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) (*Order, error)
+}
+
+type getOrderServiceAPI GetOrderService
+
+func (a *getOrderServiceAPI) Symbol(symbol string) GetOrderServiceAPI {
+	return (*getOrderServiceAPI)((*GetOrderService)(a).Symbol(symbol))
+}
+
+func (a *getOrderServiceAPI) OrderID(orderID int64) GetOrderServiceAPI {
+	return (*getOrderServiceAPI)((*GetOrderService)(a).OrderID(orderID))
+}
+
+func (a *getOrderServiceAPI) OrigClientOrderID(origClientOrderID string) GetOrderServiceAPI {
+	return (*getOrderServiceAPI)((*GetOrderService)(a).OrigClientOrderID(origClientOrderID))
+}
+
+func (a *getOrderServiceAPI) Do(ctx context.Context, opts ...RequestOption) (*Order, error) {
+	return (*GetOrderService)(a).Do(ctx, opts...)
+}
+
+// CancelOrderServiceAPI is the interface of CancelOrderService
+type CancelOrderServiceAPI interface {
+	Symbol(symbol string) CancelOrderServiceAPI
+	OrderID(orderID int64) CancelOrderServiceAPI
+	OrigClientOrderID(origClientOrderID string) CancelOrderServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) (*CancelOrderResponse, error)
+}
+
+type cancelOrderServiceAPI CancelOrderService
+
+func (a *cancelOrderServiceAPI) Symbol(symbol string) CancelOrderServiceAPI {
+	return (*cancelOrderServiceAPI)((*CancelOrderService)(a).Symbol(symbol))
+}
+
+func (a *cancelOrderServiceAPI) OrderID(orderID int64) CancelOrderServiceAPI {
+	return (*cancelOrderServiceAPI)((*CancelOrderService)(a).OrderID(orderID))
+}
+
+func (a *cancelOrderServiceAPI) OrigClientOrderID(origClientOrderID string) CancelOrderServiceAPI {
+	return (*cancelOrderServiceAPI)((*CancelOrderService)(a).OrigClientOrderID(origClientOrderID))
+}
+
+func (a *cancelOrderServiceAPI) Do(ctx context.Context, opts ...RequestOption) (*CancelOrderResponse, error) {
+	return (*CancelOrderService)(a).Do(ctx, opts...)
+}
+
+// CancelAllOpenOrdersServiceAPI is the interface of CancelAllOpenOrdersService
+type CancelAllOpenOrdersServiceAPI interface {
+	Symbol(symbol string) CancelAllOpenOrdersServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) error
+}
+
+type cancelAllOpenOrdersServiceAPI CancelAllOpenOrdersService
+
+func (a *cancelAllOpenOrdersServiceAPI) Symbol(symbol string) CancelAllOpenOrdersServiceAPI {
+	return (*cancelAllOpenOrdersServiceAPI)((*CancelAllOpenOrdersService)(a).Symbol(symbol))
+}
+
+func (a *cancelAllOpenOrdersServiceAPI) Do(ctx context.Context, opts ...RequestOption) error {
+	return (*CancelAllOpenOrdersService)(a).Do(ctx, opts...)
+}
+
+// CancelMultiplesOrdersServiceAPI is the interface of CancelMultiplesOrdersService
+type CancelMultiplesOrdersServiceAPI interface {
+	Symbol(symbol string) CancelMultiplesOrdersServiceAPI
+	OrderIDList(orderIDList []int64) CancelMultiplesOrdersServiceAPI
+	OrigClientOrderIDList(origClientOrderIDList []string) CancelMultiplesOrdersServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) ([]*CancelOrderResponse, error)
+}
+
+type cancelMultiplesOrdersServiceAPI CancelMultiplesOrdersService
+
+func (a *cancelMultiplesOrdersServiceAPI) Symbol(symbol string) CancelMultiplesOrdersServiceAPI {
+	return (*cancelMultiplesOrdersServiceAPI)((*CancelMultiplesOrdersService)(a).Symbol(symbol))
+}
+
+func (a *cancelMultiplesOrdersServiceAPI) OrderIDList(orderIDList []int64) CancelMultiplesOrdersServiceAPI {
+	return (*cancelMultiplesOrdersServiceAPI)((*CancelMultiplesOrdersService)(a).OrderIDList(orderIDList))
+}
+
+func (a *cancelMultiplesOrdersServiceAPI) OrigClientOrderIDList(origClientOrderIDList []string) CancelMultiplesOrdersServiceAPI {
+	return (*cancelMultiplesOrdersServiceAPI)((*CancelMultiplesOrdersService)(a).OrigClientOrderIDList(origClientOrderIDList))
+}
+
+func (a *cancelMultiplesOrdersServiceAPI) Do(ctx context.Context, opts ...RequestOption) ([]*CancelOrderResponse, error) {
+	return (*CancelMultiplesOrdersService)(a).Do(ctx, opts...)
+}
+
+// GetOpenOrderServiceAPI is the interface of GetOpenOrderService
+type GetOpenOrderServiceAPI interface {
+	Symbol(symbol string) GetOpenOrderServiceAPI
+	OrderID(orderID int64) GetOpenOrderServiceAPI
+	OrigClientOrderID(origClientOrderID string) GetOpenOrderServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) (*Order, error)
+}
+
+type getOpenOrderServiceAPI GetOpenOrderService
+
+func (a *getOpenOrderServiceAPI) Symbol(symbol string) GetOpenOrderServiceAPI {
+	return (*getOpenOrderServiceAPI)((*GetOpenOrderService)(a).Symbol(symbol))
+}
+
+func (a *getOpenOrderServiceAPI) OrderID(orderID int64) GetOpenOrderServiceAPI {
+	return (*getOpenOrderServiceAPI)((*GetOpenOrderService)(a).OrderID(orderID))
+}
+
+func (a *getOpenOrderServiceAPI) OrigClientOrderID(origClientOrderID string) GetOpenOrderServiceAPI {
+	return (*getOpenOrderServiceAPI)((*GetOpenOrderService)(a).OrigClientOrderID(origClientOrderID))
+}
+
+func (a *getOpenOrderServiceAPI) Do(ctx context.Context, opts ...RequestOption) (*Order, error) {
+	return (*GetOpenOrderService)(a).Do(ctx, opts...)
+}
+
+// ListOpenOrdersServiceAPI is the interface of ListOpenOrdersService
+type ListOpenOrdersServiceAPI interface {
+	Symbol(symbol string) ListOpenOrdersServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) ([]*Order, error)
+}
+
+type listOpenOrdersServiceAPI ListOpenOrdersService
+
+func (a *listOpenOrdersServiceAPI) Symbol(symbol string) ListOpenOrdersServiceAPI {
+	return (*listOpenOrdersServiceAPI)((*ListOpenOrdersService)(a).Symbol(symbol))
+}
+
+func (a *listOpenOrdersServiceAPI) Do(ctx context.Context, opts ...RequestOption) ([]*Order, error) {
+	return (*ListOpenOrdersService)(a).Do(ctx, opts...)
+}
+
+// ListOrdersServiceAPI is the interface of ListOrdersService
+type ListOrdersServiceAPI interface {
+	Symbol(symbol string) ListOrdersServiceAPI
+	OrderID(orderID int64) ListOrdersServiceAPI
+	StartTime(startTime int64) ListOrdersServiceAPI
+	EndTime(endTime int64) ListOrdersServiceAPI
+	Limit(limit int) ListOrdersServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) ([]*Order, error)
+}
+
+type listOrdersServiceAPI ListOrdersService
+
+func (a *listOrdersServiceAPI) Symbol(symbol string) ListOrdersServiceAPI {
+	return (*listOrdersServiceAPI)((*ListOrdersService)(a).Symbol(symbol))
+}
+
+func (a *listOrdersServiceAPI) OrderID(orderID int64) ListOrdersServiceAPI {
+	return (*listOrdersServiceAPI)((*ListOrdersService)(a).OrderID(orderID))
+}
+
+func (a *listOrdersServiceAPI) StartTime(startTime int64) ListOrdersServiceAPI {
+	return (*listOrdersServiceAPI)((*ListOrdersService)(a).StartTime(startTime))
+}
+
+func (a *listOrdersServiceAPI) EndTime(endTime int64) ListOrdersServiceAPI {
+	return (*listOrdersServiceAPI)((*ListOrdersService)(a).EndTime(endTime))
+}
+
+func (a *listOrdersServiceAPI) Limit(limit int) ListOrdersServiceAPI {
+	return (*listOrdersServiceAPI)((*ListOrdersService)(a).Limit(limit))
+}
+
+func (a *listOrdersServiceAPI) Do(ctx context.Context, opts ...RequestOption) ([]*Order, error) {
+	return (*ListOrdersService)(a).Do(ctx, opts...)
+}
+
+// GetAccountServiceAPI is the interface of GetAccountService
+type GetAccountServiceAPI interface {
+	Do(ctx context.Context, opts ...RequestOption) (*Account, error)
+}
+
+type getAccountServiceAPI GetAccountService
+
+func (a *getAccountServiceAPI) Do(ctx context.Context, opts ...RequestOption) (*Account, error) {
+	return (*GetAccountService)(a).Do(ctx, opts...)
+}
+
+// GetAccountV3ServiceAPI is the interface of GetAccountV3Service
+type GetAccountV3ServiceAPI interface {
+	Do(ctx context.Context, opts ...RequestOption) (*AccountV3, error)
+}
+
+type getAccountV3ServiceAPI GetAccountV3Service
+
+func (a *getAccountV3ServiceAPI) Do(ctx context.Context, opts ...RequestOption) (*AccountV3, error) {
+	return (*GetAccountV3Service)(a).Do(ctx, opts...)
+}
+
+// GetBalanceServiceAPI is the interface of GetBalanceService
+type GetBalanceServiceAPI interface {
+	Do(ctx context.Context, opts ...RequestOption) ([]*Balance, error)
+}
+
+type getBalanceServiceAPI GetBalanceService
+
+func (a *getBalanceServiceAPI) Do(ctx context.Context, opts ...RequestOption) ([]*Balance, error) {
+	return (*GetBalanceService)(a).Do(ctx, opts...)
+}
+
+// AccountConfigServiceAPI is the interface of AccountConfigService
+type AccountConfigServiceAPI interface {
+	Do(ctx context.Context, opts ...RequestOption) (*AccountConfig, error)
+}
+
+type accountConfigServiceAPI AccountConfigService
+
+func (a *accountConfigServiceAPI) Do(ctx context.Context, opts ...RequestOption) (*AccountConfig, error) {
+	return (*AccountConfigService)(a).Do(ctx, opts...)
+}
+
+// SymbolConfigServiceAPI is the interface of SymbolConfigService
+type SymbolConfigServiceAPI interface {
+	Symbol(symbol string) SymbolConfigServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) ([]*SymbolConfig, error)
+}
+
+type symbolConfigServiceAPI SymbolConfigService
+
+func (a *symbolConfigServiceAPI) Symbol(symbol string) SymbolConfigServiceAPI {
+	return (*symbolConfigServiceAPI)((*SymbolConfigService)(a).Symbol(symbol))
+}
+
+func (a *symbolConfigServiceAPI) Do(ctx context.Context, opts ...RequestOption) ([]*SymbolConfig, error) {
+	return (*SymbolConfigService)(a).Do(ctx, opts...)
+}
+
+// GetPositionRiskServiceAPI is the interface of GetPositionRiskService
+type GetPositionRiskServiceAPI interface {
+	Symbol(symbol string) GetPositionRiskServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) ([]*PositionRisk, error)
+}
+
+type getPositionRiskServiceAPI GetPositionRiskService
+
+func (a *getPositionRiskServiceAPI) Symbol(symbol string) GetPositionRiskServiceAPI {
+	return (*getPositionRiskServiceAPI)((*GetPositionRiskService)(a).Symbol(symbol))
+}
+
+func (a *getPositionRiskServiceAPI) Do(ctx context.Context, opts ...RequestOption) ([]*PositionRisk, error) {
+	return (*GetPositionRiskService)(a).Do(ctx, opts...)
+}
+
+// GetPositionRiskV3ServiceAPI is the interface of GetPositionRiskV3Service
+type GetPositionRiskV3ServiceAPI interface {
+	Symbol(symbol string) GetPositionRiskV3ServiceAPI
+	RecvWindow(rw int64) GetPositionRiskV3ServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) ([]*PositionRiskV3, error)
+}
+
+type getPositionRiskV3ServiceAPI GetPositionRiskV3Service
+
+func (a *getPositionRiskV3ServiceAPI) Symbol(symbol string) GetPositionRiskV3ServiceAPI {
+	return (*getPositionRiskV3ServiceAPI)((*GetPositionRiskV3Service)(a).Symbol(symbol))
+}
+
+func (a *getPositionRiskV3ServiceAPI) RecvWindow(rw int64) GetPositionRiskV3ServiceAPI {
+	return (*getPositionRiskV3ServiceAPI)((*GetPositionRiskV3Service)(a).RecvWindow(rw))
+}
+
+func (a *getPositionRiskV3ServiceAPI) Do(ctx context.Context, opts ...RequestOption) ([]*PositionRiskV3, error) {
+	return (*GetPositionRiskV3Service)(a).Do(ctx, opts...)
+}
+
+// GetPositionMarginHistoryServiceAPI is the interface of GetPositionMarginHistoryService
+type GetPositionMarginHistoryServiceAPI interface {
+	Symbol(symbol string) GetPositionMarginHistoryServiceAPI
+	Type(_type int) GetPositionMarginHistoryServiceAPI
+	StartTime(startTime int64) GetPositionMarginHistoryServiceAPI
+	EndTime(endTime int64) GetPositionMarginHistoryServiceAPI
+	Limit(limit int64) GetPositionMarginHistoryServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) ([]*PositionMarginHistory, error)
+}
+
+type getPositionMarginHistoryServiceAPI GetPositionMarginHistoryService
+
+func (a *getPositionMarginHistoryServiceAPI) Symbol(symbol string) GetPositionMarginHistoryServiceAPI {
+	return (*getPositionMarginHistoryServiceAPI)((*GetPositionMarginHistoryService)(a).Symbol(symbol))
+}
+
+func (a *getPositionMarginHistoryServiceAPI) Type(_type int) GetPositionMarginHistoryServiceAPI {
+	return (*getPositionMarginHistoryServiceAPI)((*GetPositionMarginHistoryService)(a).Type(_type))
+}
+
+func (a *getPositionMarginHistoryServiceAPI) StartTime(startTime int64) GetPositionMarginHistoryServiceAPI {
+	return (*getPositionMarginHistoryServiceAPI)((*GetPositionMarginHistoryService)(a).StartTime(startTime))
+}
+
+func (a *getPositionMarginHistoryServiceAPI) EndTime(endTime int64) GetPositionMarginHistoryServiceAPI {
+	return (*getPositionMarginHistoryServiceAPI)((*GetPositionMarginHistoryService)(a).EndTime(endTime))
+}
+
+func (a *getPositionMarginHistoryServiceAPI) Limit(limit int64) GetPositionMarginHistoryServiceAPI {
+	return (*getPositionMarginHistoryServiceAPI)((*GetPositionMarginHistoryService)(a).Limit(limit))
+}
+
+func (a *getPositionMarginHistoryServiceAPI) Do(ctx context.Context, opts ...RequestOption) ([]*PositionMarginHistory, error) {
+	return (*GetPositionMarginHistoryService)(a).Do(ctx, opts...)
+}
+
+// GetIncomeHistoryServiceAPI is the interface of GetIncomeHistoryService
+type GetIncomeHistoryServiceAPI interface {
+	Symbol(symbol string) GetIncomeHistoryServiceAPI
+	IncomeType(incomeType string) GetIncomeHistoryServiceAPI
+	StartTime(startTime int64) GetIncomeHistoryServiceAPI
+	EndTime(endTime int64) GetIncomeHistoryServiceAPI
+	Limit(limit int64) GetIncomeHistoryServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) ([]*IncomeHistory, error)
+}
+
+type getIncomeHistoryServiceAPI GetIncomeHistoryService
+
+func (a *getIncomeHistoryServiceAPI) Symbol(symbol string) GetIncomeHistoryServiceAPI {
+	return (*getIncomeHistoryServiceAPI)((*GetIncomeHistoryService)(a).Symbol(symbol))
+}
+
+func (a *getIncomeHistoryServiceAPI) IncomeType(incomeType string) GetIncomeHistoryServiceAPI {
+	return (*getIncomeHistoryServiceAPI)((*GetIncomeHistoryService)(a).IncomeType(incomeType))
+}
+
+func (a *getIncomeHistoryServiceAPI) StartTime(startTime int64) GetIncomeHistoryServiceAPI {
+	return (*getIncomeHistoryServiceAPI)((*GetIncomeHistoryService)(a).StartTime(startTime))
+}
+
+func (a *getIncomeHistoryServiceAPI) EndTime(endTime int64) GetIncomeHistoryServiceAPI {
+	return (*getIncomeHistoryServiceAPI)((*GetIncomeHistoryService)(a).EndTime(endTime))
+}
+
+func (a *getIncomeHistoryServiceAPI) Limit(limit int64) GetIncomeHistoryServiceAPI {
+	return (*getIncomeHistoryServiceAPI)((*GetIncomeHistoryService)(a).Limit(limit))
+}
+
+func (a *getIncomeHistoryServiceAPI) Do(ctx context.Context, opts ...RequestOption) ([]*IncomeHistory, error) {
+	return (*GetIncomeHistoryService)(a).Do(ctx, opts...)
+}
+
+// HistoricalTradesServiceAPI is the interface of HistoricalTradesService
+type HistoricalTradesServiceAPI interface {
+	Symbol(symbol string) HistoricalTradesServiceAPI
+	Limit(limit int) HistoricalTradesServiceAPI
+	FromID(fromID int64) HistoricalTradesServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) ([]*Trade, error)
+}
+
+type historicalTradesServiceAPI HistoricalTradesService
+
+func (a *historicalTradesServiceAPI) Symbol(symbol string) HistoricalTradesServiceAPI {
+	return (*historicalTradesServiceAPI)((*HistoricalTradesService)(a).Symbol(symbol))
+}
+
+func (a *historicalTradesServiceAPI) Limit(limit int) HistoricalTradesServiceAPI {
+	return (*historicalTradesServiceAPI)((*HistoricalTradesService)(a).Limit(limit))
+}
+
+func (a *historicalTradesServiceAPI) FromID(fromID int64) HistoricalTradesServiceAPI {
+	return (*historicalTradesServiceAPI)((*HistoricalTradesService)(a).FromID(fromID))
+}
+
+func (a *historicalTradesServiceAPI) Do(ctx context.Context, opts ...RequestOption) ([]*Trade, error) {
+	return (*HistoricalTradesService)(a).Do(ctx, opts...)
+}
+
+// ListAccountTradeServiceAPI is the interface of ListAccountTradeService
+type ListAccountTradeServiceAPI interface {
+	Symbol(symbol string) ListAccountTradeServiceAPI
+	OrderID(orderID int64) ListAccountTradeServiceAPI
+	StartTime(startTime int64) ListAccountTradeServiceAPI
+	EndTime(endTime int64) ListAccountTradeServiceAPI
+	FromID(fromID int64) ListAccountTradeServiceAPI
+	Limit(limit int) ListAccountTradeServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) ([]*AccountTrade, error)
+}
+
+type listAccountTradeServiceAPI ListAccountTradeService
+
+func (a *listAccountTradeServiceAPI) Symbol(symbol string) ListAccountTradeServiceAPI {
+	return (*listAccountTradeServiceAPI)((*ListAccountTradeService)(a).Symbol(symbol))
+}
+
+func (a *listAccountTradeServiceAPI) OrderID(orderID int64) ListAccountTradeServiceAPI {
+	return (*listAccountTradeServiceAPI)((*ListAccountTradeService)(a).OrderID(orderID))
+}
+
+func (a *listAccountTradeServiceAPI) StartTime(startTime int64) ListAccountTradeServiceAPI {
+	return (*listAccountTradeServiceAPI)((*ListAccountTradeService)(a).StartTime(startTime))
+}
+
+func (a *listAccountTradeServiceAPI) EndTime(endTime int64) ListAccountTradeServiceAPI {
+	return (*listAccountTradeServiceAPI)((*ListAccountTradeService)(a).EndTime(endTime))
+}
+
+func (a *listAccountTradeServiceAPI) FromID(fromID int64) ListAccountTradeServiceAPI {
+	return (*listAccountTradeServiceAPI)((*ListAccountTradeService)(a).FromID(fromID))
+}
+
+func (a *listAccountTradeServiceAPI) Limit(limit int) ListAccountTradeServiceAPI {
+	return (*listAccountTradeServiceAPI)((*ListAccountTradeService)(a).Limit(limit))
+}
+
+func (a *listAccountTradeServiceAPI) Do(ctx context.Context, opts ...RequestOption) ([]*AccountTrade, error) {
+	return (*ListAccountTradeService)(a).Do(ctx, opts...)
+}
+
+// StartUserStreamServiceAPI is the interface of StartUserStreamService
+type StartUserStreamServiceAPI interface {
+	Do(ctx context.Context, opts ...RequestOption) (string, error)
+}
+
+type startUserStreamServiceAPI StartUserStreamService
+
+func (a *startUserStreamServiceAPI) Do(ctx context.Context, opts ...RequestOption) (string, error) {
+	return (*StartUserStreamService)(a).Do(ctx, opts...)
+}
+
+// KeepaliveUserStreamServiceAPI is the interface of KeepaliveUserStreamService
+type KeepaliveUserStreamServiceAPI interface {
+	ListenKey(listenKey string) KeepaliveUserStreamServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) error
+}
+
+type keepaliveUserStreamServiceAPI KeepaliveUserStreamService
+
+func (a *keepaliveUserStreamServiceAPI) ListenKey(listenKey string) KeepaliveUserStreamServiceAPI {
+	return (*keepaliveUserStreamServiceAPI)((*KeepaliveUserStreamService)(a).ListenKey(listenKey))
+}
+
+func (a *keepaliveUserStreamServiceAPI) Do(ctx context.Context, opts ...RequestOption) error {
+	return (*KeepaliveUserStreamService)(a).Do(ctx, opts...)
+}
+
+// CloseUserStreamServiceAPI is the interface of CloseUserStreamService
+type CloseUserStreamServiceAPI interface {
+	ListenKey(listenKey string) CloseUserStreamServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) error
+}
+
+type closeUserStreamServiceAPI CloseUserStreamService
+
+func (a *closeUserStreamServiceAPI) ListenKey(listenKey string) CloseUserStreamServiceAPI {
+	return (*closeUserStreamServiceAPI)((*CloseUserStreamService)(a).ListenKey(listenKey))
+}
+
+func (a *closeUserStreamServiceAPI) Do(ctx context.Context, opts ...RequestOption) error {
+	return (*CloseUserStreamService)(a).Do(ctx, opts...)
+}
+
+// ExchangeInfoServiceAPI is the interface of ExchangeInfoService
+type ExchangeInfoServiceAPI interface {
+	Do(ctx context.Context, opts ...RequestOption) (*ExchangeInfo, error)
+}
+
+type exchangeInfoServiceAPI ExchangeInfoService
+
+func (a *exchangeInfoServiceAPI) Do(ctx context.Context, opts ...RequestOption) (*ExchangeInfo, error) {
+	return (*ExchangeInfoService)(a).Do(ctx, opts...)
+}
+
+// PremiumIndexServiceAPI is the interface of PremiumIndexService
+type PremiumIndexServiceAPI interface {
+	Symbol(symbol string) PremiumIndexServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) ([]*PremiumIndex, error)
+}
+
+type premiumIndexServiceAPI PremiumIndexService
+
+func (a *premiumIndexServiceAPI) Symbol(symbol string) PremiumIndexServiceAPI {
+	return (*premiumIndexServiceAPI)((*PremiumIndexService)(a).Symbol(symbol))
+}
+
+func (a *premiumIndexServiceAPI) Do(ctx context.Context, opts ...RequestOption) ([]*PremiumIndex, error) {
+	return (*PremiumIndexService)(a).Do(ctx, opts...)
+}
+
+// PremiumIndexKlinesServiceAPI is the interface of PremiumIndexKlinesService
+type PremiumIndexKlinesServiceAPI interface {
+	Symbol(symbol string) PremiumIndexKlinesServiceAPI
+	Interval(interval string) PremiumIndexKlinesServiceAPI
+	Limit(limit int) PremiumIndexKlinesServiceAPI
+	StartTime(startTime int64) PremiumIndexKlinesServiceAPI
+	EndTime(endTime int64) PremiumIndexKlinesServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) ([]*Kline, error)
+}
+
+type premiumIndexKlinesServiceAPI PremiumIndexKlinesService
+
+func (a *premiumIndexKlinesServiceAPI) Symbol(symbol string) PremiumIndexKlinesServiceAPI {
+	return (*premiumIndexKlinesServiceAPI)((*PremiumIndexKlinesService)(a).Symbol(symbol))
+}
+
+func (a *premiumIndexKlinesServiceAPI) Interval(interval string) PremiumIndexKlinesServiceAPI {
+	return (*premiumIndexKlinesServiceAPI)((*PremiumIndexKlinesService)(a).Interval(interval))
+}
+
+func (a *premiumIndexKlinesServiceAPI) Limit(limit int) PremiumIndexKlinesServiceAPI {
+	return (*premiumIndexKlinesServiceAPI)((*PremiumIndexKlinesService)(a).Limit(limit))
+}
+
+func (a *premiumIndexKlinesServiceAPI) StartTime(startTime int64) PremiumIndexKlinesServiceAPI {
+	return (*premiumIndexKlinesServiceAPI)((*PremiumIndexKlinesService)(a).StartTime(startTime))
+}
+
+func (a *premiumIndexKlinesServiceAPI) EndTime(endTime int64) PremiumIndexKlinesServiceAPI {
+	return (*premiumIndexKlinesServiceAPI)((*PremiumIndexKlinesService)(a).EndTime(endTime))
+}
+
+func (a *premiumIndexKlinesServiceAPI) Do(ctx context.Context, opts ...RequestOption) ([]*Kline, error) {
+	return (*PremiumIndexKlinesService)(a).Do(ctx, opts...)
+}
+
+// FundingRateServiceAPI is the interface of FundingRateService
+type FundingRateServiceAPI interface {
+	Symbol(symbol string) FundingRateServiceAPI
+	StartTime(startTime int64) FundingRateServiceAPI
+	EndTime(endTime int64) FundingRateServiceAPI
+	Limit(limit int) FundingRateServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) ([]*FundingRate, error)
+}
+
+type fundingRateServiceAPI FundingRateService
+
+func (a *fundingRateServiceAPI) Symbol(symbol string) FundingRateServiceAPI {
+	return (*fundingRateServiceAPI)((*FundingRateService)(a).Symbol(symbol))
+}
+
+func (a *fundingRateServiceAPI) StartTime(startTime int64) FundingRateServiceAPI {
+	return (*fundingRateServiceAPI)((*FundingRateService)(a).StartTime(startTime))
+}
+
+func (a *fundingRateServiceAPI) EndTime(endTime int64) FundingRateServiceAPI {
+	return (*fundingRateServiceAPI)((*FundingRateService)(a).EndTime(endTime))
+}
+
+func (a *fundingRateServiceAPI) Limit(limit int) FundingRateServiceAPI {
+	return (*fundingRateServiceAPI)((*FundingRateService)(a).Limit(limit))
+}
+
+func (a *fundingRateServiceAPI) Do(ctx context.Context, opts ...RequestOption) ([]*FundingRate, error) {
+	return (*FundingRateService)(a).Do(ctx, opts...)
+}
+
+// FundingRateInfoServiceAPI is the interface of FundingRateInfoService
+type FundingRateInfoServiceAPI interface {
+	Do(ctx context.Context, opts ...RequestOption) ([]*FundingRateInfo, error)
+}
+
+type fundingRateInfoServiceAPI FundingRateInfoService
+
+func (a *fundingRateInfoServiceAPI) Do(ctx context.Context, opts ...RequestOption) ([]*FundingRateInfo, error) {
+	return (*FundingRateInfoService)(a).Do(ctx, opts...)
+}
+
+// ListUserLiquidationOrdersServiceAPI is the interface of ListUserLiquidationOrdersService
+type ListUserLiquidationOrdersServiceAPI interface {
+	Symbol(symbol string) ListUserLiquidationOrdersServiceAPI
+	AutoCloseType(autoCloseType ForceOrderCloseType) ListUserLiquidationOrdersServiceAPI
+	StartTime(startTime int64) ListUserLiquidationOrdersServiceAPI
+	EndTime(endTime int64) ListUserLiquidationOrdersServiceAPI
+	Limit(limit int) ListUserLiquidationOrdersServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) ([]*UserLiquidationOrder, error)
+}
+
+type listUserLiquidationOrdersServiceAPI ListUserLiquidationOrdersService
+
+func (a *listUserLiquidationOrdersServiceAPI) Symbol(symbol string) ListUserLiquidationOrdersServiceAPI {
+	return (*listUserLiquidationOrdersServiceAPI)((*ListUserLiquidationOrdersService)(a).Symbol(symbol))
+}
+
+func (a *listUserLiquidationOrdersServiceAPI) AutoCloseType(autoCloseType ForceOrderCloseType) ListUserLiquidationOrdersServiceAPI {
+	return (*listUserLiquidationOrdersServiceAPI)((*ListUserLiquidationOrdersService)(a).AutoCloseType(autoCloseType))
+}
+
+func (a *listUserLiquidationOrdersServiceAPI) StartTime(startTime int64) ListUserLiquidationOrdersServiceAPI {
+	return (*listUserLiquidationOrdersServiceAPI)((*ListUserLiquidationOrdersService)(a).StartTime(startTime))
+}
+
+func (a *listUserLiquidationOrdersServiceAPI) EndTime(endTime int64) ListUserLiquidationOrdersServiceAPI {
+	return (*listUserLiquidationOrdersServiceAPI)((*ListUserLiquidationOrdersService)(a).EndTime(endTime))
+}
+
+func (a *listUserLiquidationOrdersServiceAPI) Limit(limit int) ListUserLiquidationOrdersServiceAPI {
+	return (*listUserLiquidationOrdersServiceAPI)((*ListUserLiquidationOrdersService)(a).Limit(limit))
+}
+
+func (a *listUserLiquidationOrdersServiceAPI) Do(ctx context.Context, opts ...RequestOption) ([]*UserLiquidationOrder, error) {
+	return (*ListUserLiquidationOrdersService)(a).Do(ctx, opts...)
+}
+
+// ListLiquidationOrdersServiceAPI is the interface of ListLiquidationOrdersService
+type ListLiquidationOrdersServiceAPI interface {
+	Symbol(symbol string) ListLiquidationOrdersServiceAPI
+	StartTime(startTime int64) ListLiquidationOrdersServiceAPI
+	EndTime(endTime int64) ListLiquidationOrdersServiceAPI
+	Limit(limit int) ListLiquidationOrdersServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) ([]*LiquidationOrder, error)
+}
+
+type listLiquidationOrdersServiceAPI ListLiquidationOrdersService
+
+func (a *listLiquidationOrdersServiceAPI) Symbol(symbol string) ListLiquidationOrdersServiceAPI {
+	return (*listLiquidationOrdersServiceAPI)((*ListLiquidationOrdersService)(a).Symbol(symbol))
+}
+
+func (a *listLiquidationOrdersServiceAPI) StartTime(startTime int64) ListLiquidationOrdersServiceAPI {
+	return (*listLiquidationOrdersServiceAPI)((*ListLiquidationOrdersService)(a).StartTime(startTime))
+}
+
+func (a *listLiquidationOrdersServiceAPI) EndTime(endTime int64) ListLiquidationOrdersServiceAPI {
+	return (*listLiquidationOrdersServiceAPI)((*ListLiquidationOrdersService)(a).EndTime(endTime))
+}
+
+func (a *listLiquidationOrdersServiceAPI) Limit(limit int) ListLiquidationOrdersServiceAPI {
+	return (*listLiquidationOrdersServiceAPI)((*ListLiquidationOrdersService)(a).Limit(limit))
+}
+
+func (a *listLiquidationOrdersServiceAPI) Do(ctx context.Context, opts ...RequestOption) ([]*LiquidationOrder, error) {
+	return (*ListLiquidationOrdersService)(a).Do(ctx, opts...)
+}
+
+// ChangeLeverageServiceAPI is the interface of ChangeLeverageService
+type ChangeLeverageServiceAPI interface {
+	Symbol(symbol string) ChangeLeverageServiceAPI
+	Leverage(leverage int) ChangeLeverageServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) (*SymbolLeverage, error)
+}
+
+type changeLeverageServiceAPI ChangeLeverageService
+
+func (a *changeLeverageServiceAPI) Symbol(symbol string) ChangeLeverageServiceAPI {
+	return (*changeLeverageServiceAPI)((*ChangeLeverageService)(a).Symbol(symbol))
+}
+
+func (a *changeLeverageServiceAPI) Leverage(leverage int) ChangeLeverageServiceAPI {
+	return (*changeLeverageServiceAPI)((*ChangeLeverageService)(a).Leverage(leverage))
+}
+
+func (a *changeLeverageServiceAPI) Do(ctx context.Context, opts ...RequestOption) (*SymbolLeverage, error) {
+	return (*ChangeLeverageService)(a).Do(ctx, opts...)
+}
+
+// GetLeverageBracketServiceAPI is the interface of GetLeverageBracketService
+type GetLeverageBracketServiceAPI interface {
+	Symbol(symbol string) GetLeverageBracketServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) ([]*LeverageBracket, error)
+}
+
+type getLeverageBracketServiceAPI GetLeverageBracketService
+
+func (a *getLeverageBracketServiceAPI) Symbol(symbol string) GetLeverageBracketServiceAPI {
+	return (*getLeverageBracketServiceAPI)((*GetLeverageBracketService)(a).Symbol(symbol))
+}
+
+func (a *getLeverageBracketServiceAPI) Do(ctx context.Context, opts ...RequestOption) ([]*LeverageBracket, error) {
+	return (*GetLeverageBracketService)(a).Do(ctx, opts...)
+}
+
+// ChangeMarginTypeServiceAPI is the interface of ChangeMarginTypeService
+type ChangeMarginTypeServiceAPI interface {
+	Symbol(symbol string) ChangeMarginTypeServiceAPI
+	MarginType(marginType MarginType) ChangeMarginTypeServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) error
+}
+
+type changeMarginTypeServiceAPI ChangeMarginTypeService
+
+func (a *changeMarginTypeServiceAPI) Symbol(symbol string) ChangeMarginTypeServiceAPI {
+	return (*changeMarginTypeServiceAPI)((*ChangeMarginTypeService)(a).Symbol(symbol))
+}
+
+func (a *changeMarginTypeServiceAPI) MarginType(marginType MarginType) ChangeMarginTypeServiceAPI {
+	return (*changeMarginTypeServiceAPI)((*ChangeMarginTypeService)(a).MarginType(marginType))
+}
+
+func (a *changeMarginTypeServiceAPI) Do(ctx context.Context, opts ...RequestOption) error {
+	return (*ChangeMarginTypeService)(a).Do(ctx, opts...)
+}
+
+// UpdatePositionMarginServiceAPI is the interface of UpdatePositionMarginService
+type UpdatePositionMarginServiceAPI interface {
+	Symbol(symbol string) UpdatePositionMarginServiceAPI
+	PositionSide(positionSide PositionSideType) UpdatePositionMarginServiceAPI
+	Amount(amount string) UpdatePositionMarginServiceAPI
+	Type(actionType int) UpdatePositionMarginServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) error
+}
+
+type updatePositionMarginServiceAPI UpdatePositionMarginService
+
+func (a *updatePositionMarginServiceAPI) Symbol(symbol string) UpdatePositionMarginServiceAPI {
+	return (*updatePositionMarginServiceAPI)((*UpdatePositionMarginService)(a).Symbol(symbol))
+}
+
+func (a *updatePositionMarginServiceAPI) PositionSide(positionSide PositionSideType) UpdatePositionMarginServiceAPI {
+	return (*updatePositionMarginServiceAPI)((*UpdatePositionMarginService)(a).PositionSide(positionSide))
+}
+
+func (a *updatePositionMarginServiceAPI) Amount(amount string) UpdatePositionMarginServiceAPI {
+	return (*updatePositionMarginServiceAPI)((*UpdatePositionMarginService)(a).Amount(amount))
+}
+
+func (a *updatePositionMarginServiceAPI) Type(actionType int) UpdatePositionMarginServiceAPI {
+	return (*updatePositionMarginServiceAPI)((*UpdatePositionMarginService)(a).Type(actionType))
+}
+
+func (a *updatePositionMarginServiceAPI) Do(ctx context.Context, opts ...RequestOption) error {
+	return (*UpdatePositionMarginService)(a).Do(ctx, opts...)
+}
+
+// ChangePositionModeServiceAPI is the interface of ChangePositionModeService
+type ChangePositionModeServiceAPI interface {
+	DualSide(dualSide bool) ChangePositionModeServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) error
+}
+
+type changePositionModeServiceAPI ChangePositionModeService
+
+func (a *changePositionModeServiceAPI) DualSide(dualSide bool) ChangePositionModeServiceAPI {
+	return (*changePositionModeServiceAPI)((*ChangePositionModeService)(a).DualSide(dualSide))
+}
+
+func (a *changePositionModeServiceAPI) Do(ctx context.Context, opts ...RequestOption) error {
+	return (*ChangePositionModeService)(a).Do(ctx, opts...)
+}
+
+// GetPositionModeServiceAPI is the interface of GetPositionModeService
+type GetPositionModeServiceAPI interface {
+	Do(ctx context.Context, opts ...RequestOption) (*PositionMode, error)
+}
+
+type getPositionModeServiceAPI GetPositionModeService
+
+func (a *getPositionModeServiceAPI) Do(ctx context.Context, opts ...RequestOption) (*PositionMode, error) {
+	return (*GetPositionModeService)(a).Do(ctx, opts...)
+}
+
+// ChangeMultiAssetModeServiceAPI is the interface of ChangeMultiAssetModeService
+type ChangeMultiAssetModeServiceAPI interface {
+	MultiAssetsMargin(multiAssetsMargin bool) ChangeMultiAssetModeServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) error
+}
+
+type changeMultiAssetModeServiceAPI ChangeMultiAssetModeService
+
+func (a *changeMultiAssetModeServiceAPI) MultiAssetsMargin(multiAssetsMargin bool) ChangeMultiAssetModeServiceAPI {
+	return (*changeMultiAssetModeServiceAPI)((*ChangeMultiAssetModeService)(a).MultiAssetsMargin(multiAssetsMargin))
+}
+
+func (a *changeMultiAssetModeServiceAPI) Do(ctx context.Context, opts ...RequestOption) error {
+	return (*ChangeMultiAssetModeService)(a).Do(ctx, opts...)
+}
+
+// GetMultiAssetModeServiceAPI is the interface of GetMultiAssetModeService
+type GetMultiAssetModeServiceAPI interface {
+	Do(ctx context.Context, opts ...RequestOption) (*MultiAssetMode, error)
+}
+
+type getMultiAssetModeServiceAPI GetMultiAssetModeService
+
+func (a *getMultiAssetModeServiceAPI) Do(ctx context.Context, opts ...RequestOption) (*MultiAssetMode, error) {
+	return (*GetMultiAssetModeService)(a).Do(ctx, opts...)
+}
+
+// GetRebateNewUserServiceAPI is the interface of GetRebateNewUserService
+type GetRebateNewUserServiceAPI interface {
+	BrokerageID(brokerageID string) GetRebateNewUserServiceAPI
+	Type(type_future int) GetRebateNewUserServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) (*RebateNewUser, error)
+}
+
+type getRebateNewUserServiceAPI GetRebateNewUserService
+
+func (a *getRebateNewUserServiceAPI) BrokerageID(brokerageID string) GetRebateNewUserServiceAPI {
+	return (*getRebateNewUserServiceAPI)((*GetRebateNewUserService)(a).BrokerageID(brokerageID))
+}
+
+func (a *getRebateNewUserServiceAPI) Type(type_future int) GetRebateNewUserServiceAPI {
+	return (*getRebateNewUserServiceAPI)((*GetRebateNewUserService)(a).Type(type_future))
+}
+
+func (a *getRebateNewUserServiceAPI) Do(ctx context.Context, opts ...RequestOption) (*RebateNewUser, error) {
+	return (*GetRebateNewUserService)(a).Do(ctx, opts...)
+}
+
+// CommissionRateServiceAPI is the interface of CommissionRateService
+type CommissionRateServiceAPI interface {
+	Symbol(symbol string) CommissionRateServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) (*CommissionRate, error)
+}
+
+type commissionRateServiceAPI CommissionRateService
+
+func (a *commissionRateServiceAPI) Symbol(symbol string) CommissionRateServiceAPI {
+	return (*commissionRateServiceAPI)((*CommissionRateService)(a).Symbol(symbol))
+}
+
+func (a *commissionRateServiceAPI) Do(ctx context.Context, opts ...RequestOption) (*CommissionRate, error) {
+	return (*CommissionRateService)(a).Do(ctx, opts...)
+}
+
+// GetOpenInterestServiceAPI is the interface of GetOpenInterestService
+type GetOpenInterestServiceAPI interface {
+	Symbol(symbol string) GetOpenInterestServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) (*OpenInterest, error)
+}
+
+type getOpenInterestServiceAPI GetOpenInterestService
+
+func (a *getOpenInterestServiceAPI) Symbol(symbol string) GetOpenInterestServiceAPI {
+	return (*getOpenInterestServiceAPI)((*GetOpenInterestService)(a).Symbol(symbol))
+}
+
+func (a *getOpenInterestServiceAPI) Do(ctx context.Context, opts ...RequestOption) (*OpenInterest, error) {
+	return (*GetOpenInterestService)(a).Do(ctx, opts...)
+}
+
+// OpenInterestStatisticsServiceAPI is the interface of OpenInterestStatisticsService
+type OpenInterestStatisticsServiceAPI interface {
+	Symbol(symbol string) OpenInterestStatisticsServiceAPI
+	Period(period string) OpenInterestStatisticsServiceAPI
+	Limit(limit int) OpenInterestStatisticsServiceAPI
+	StartTime(startTime int64) OpenInterestStatisticsServiceAPI
+	EndTime(endTime int64) OpenInterestStatisticsServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) ([]*OpenInterestStatistic, error)
+}
+
+type openInterestStatisticsServiceAPI OpenInterestStatisticsService
+
+func (a *openInterestStatisticsServiceAPI) Symbol(symbol string) OpenInterestStatisticsServiceAPI {
+	return (*openInterestStatisticsServiceAPI)((*OpenInterestStatisticsService)(a).Symbol(symbol))
+}
+
+func (a *openInterestStatisticsServiceAPI) Period(period string) OpenInterestStatisticsServiceAPI {
+	return (*openInterestStatisticsServiceAPI)((*OpenInterestStatisticsService)(a).Period(period))
+}
+
+func (a *openInterestStatisticsServiceAPI) Limit(limit int) OpenInterestStatisticsServiceAPI {
+	return (*openInterestStatisticsServiceAPI)((*OpenInterestStatisticsService)(a).Limit(limit))
+}
+
+func (a *openInterestStatisticsServiceAPI) StartTime(startTime int64) OpenInterestStatisticsServiceAPI {
+	return (*openInterestStatisticsServiceAPI)((*OpenInterestStatisticsService)(a).StartTime(startTime))
+}
+
+func (a *openInterestStatisticsServiceAPI) EndTime(endTime int64) OpenInterestStatisticsServiceAPI {
+	return (*openInterestStatisticsServiceAPI)((*OpenInterestStatisticsService)(a).EndTime(endTime))
+}
+
+func (a *openInterestStatisticsServiceAPI) Do(ctx context.Context, opts ...RequestOption) ([]*OpenInterestStatistic, error) {
+	return (*OpenInterestStatisticsService)(a).Do(ctx, opts...)
+}
+
+// LongShortRatioServiceAPI is the interface of LongShortRatioService
+type LongShortRatioServiceAPI interface {
+	Symbol(symbol string) LongShortRatioServiceAPI
+	Period(period string) LongShortRatioServiceAPI
+	Limit(limit int) LongShortRatioServiceAPI
+	StartTime(startTime int64) LongShortRatioServiceAPI
+	EndTime(endTime int64) LongShortRatioServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) ([]*LongShortRatio, error)
+}
+
+type longShortRatioServiceAPI LongShortRatioService
+
+func (a *longShortRatioServiceAPI) Symbol(symbol string) LongShortRatioServiceAPI {
+	return (*longShortRatioServiceAPI)((*LongShortRatioService)(a).Symbol(symbol))
+}
+
+func (a *longShortRatioServiceAPI) Period(period string) LongShortRatioServiceAPI {
+	return (*longShortRatioServiceAPI)((*LongShortRatioService)(a).Period(period))
+}
+
+func (a *longShortRatioServiceAPI) Limit(limit int) LongShortRatioServiceAPI {
+	return (*longShortRatioServiceAPI)((*LongShortRatioService)(a).Limit(limit))
+}
+
+func (a *longShortRatioServiceAPI) StartTime(startTime int64) LongShortRatioServiceAPI {
+	return (*longShortRatioServiceAPI)((*LongShortRatioService)(a).StartTime(startTime))
+}
+
+func (a *longShortRatioServiceAPI) EndTime(endTime int64) LongShortRatioServiceAPI {
+	return (*longShortRatioServiceAPI)((*LongShortRatioService)(a).EndTime(endTime))
+}
+
+func (a *longShortRatioServiceAPI) Do(ctx context.Context, opts ...RequestOption) ([]*LongShortRatio, error) {
+	return (*LongShortRatioService)(a).Do(ctx, opts...)
+}
+
+// DeliveryPriceServiceAPI is the interface of DeliveryPriceService
+type DeliveryPriceServiceAPI interface {
+	Pair(pair string) DeliveryPriceServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) ([]*DeliveryPrice, error)
+}
+
+type deliveryPriceServiceAPI DeliveryPriceService
+
+func (a *deliveryPriceServiceAPI) Pair(pair string) DeliveryPriceServiceAPI {
+	return (*deliveryPriceServiceAPI)((*DeliveryPriceService)(a).Pair(pair))
+}
+
+func (a *deliveryPriceServiceAPI) Do(ctx context.Context, opts ...RequestOption) ([]*DeliveryPrice, error) {
+	return (*DeliveryPriceService)(a).Do(ctx, opts...)
+}
+
+// TopLongShortAccountRatioServiceAPI is the interface of TopLongShortAccountRatioService
+type TopLongShortAccountRatioServiceAPI interface {
+	Symbol(symbol string) TopLongShortAccountRatioServiceAPI
+	Period(period string) TopLongShortAccountRatioServiceAPI
+	Limit(limit uint32) TopLongShortAccountRatioServiceAPI
+	StartTime(startTime uint64) TopLongShortAccountRatioServiceAPI
+	EndTime(endTime uint64) TopLongShortAccountRatioServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) ([]*TopLongShortAccountRatio, error)
+}
+
+type topLongShortAccountRatioServiceAPI TopLongShortAccountRatioService
+
+func (a *topLongShortAccountRatioServiceAPI) Symbol(symbol string) TopLongShortAccountRatioServiceAPI {
+	return (*topLongShortAccountRatioServiceAPI)((*TopLongShortAccountRatioService)(a).Symbol(symbol))
+}
+
+func (a *topLongShortAccountRatioServiceAPI) Period(period string) TopLongShortAccountRatioServiceAPI {
+	return (*topLongShortAccountRatioServiceAPI)((*TopLongShortAccountRatioService)(a).Period(period))
+}
+
+func (a *topLongShortAccountRatioServiceAPI) Limit(limit uint32) TopLongShortAccountRatioServiceAPI {
+	return (*topLongShortAccountRatioServiceAPI)((*TopLongShortAccountRatioService)(a).Limit(limit))
+}
+
+func (a *topLongShortAccountRatioServiceAPI) StartTime(startTime uint64) TopLongShortAccountRatioServiceAPI {
+	return (*topLongShortAccountRatioServiceAPI)((*TopLongShortAccountRatioService)(a).StartTime(startTime))
+}
+
+func (a *topLongShortAccountRatioServiceAPI) EndTime(endTime uint64) TopLongShortAccountRatioServiceAPI {
+	return (*topLongShortAccountRatioServiceAPI)((*TopLongShortAccountRatioService)(a).EndTime(endTime))
+}
+
+func (a *topLongShortAccountRatioServiceAPI) Do(ctx context.Context, opts ...RequestOption) ([]*TopLongShortAccountRatio, error) {
+	return (*TopLongShortAccountRatioService)(a).Do(ctx, opts...)
+}
+
+// TopLongShortPositionRatioServiceAPI is the interface of TopLongShortPositionRatioService
+type TopLongShortPositionRatioServiceAPI interface {
+	Symbol(symbol string) TopLongShortPositionRatioServiceAPI
+	Period(period string) TopLongShortPositionRatioServiceAPI
+	Limit(limit uint32) TopLongShortPositionRatioServiceAPI
+	StartTime(startTime uint64) TopLongShortPositionRatioServiceAPI
+	EndTime(endTime uint64) TopLongShortPositionRatioServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) ([]*TopLongShortPositionRatio, error)
+}
+
+type topLongShortPositionRatioServiceAPI TopLongShortPositionRatioService
+
+func (a *topLongShortPositionRatioServiceAPI) Symbol(symbol string) TopLongShortPositionRatioServiceAPI {
+	return (*topLongShortPositionRatioServiceAPI)((*TopLongShortPositionRatioService)(a).Symbol(symbol))
+}
+
+func (a *topLongShortPositionRatioServiceAPI) Period(period string) TopLongShortPositionRatioServiceAPI {
+	return (*topLongShortPositionRatioServiceAPI)((*TopLongShortPositionRatioService)(a).Period(period))
+}
+
+func (a *topLongShortPositionRatioServiceAPI) Limit(limit uint32) TopLongShortPositionRatioServiceAPI {
+	return (*topLongShortPositionRatioServiceAPI)((*TopLongShortPositionRatioService)(a).Limit(limit))
+}
+
+func (a *topLongShortPositionRatioServiceAPI) StartTime(startTime uint64) TopLongShortPositionRatioServiceAPI {
+	return (*topLongShortPositionRatioServiceAPI)((*TopLongShortPositionRatioService)(a).StartTime(startTime))
+}
+
+func (a *topLongShortPositionRatioServiceAPI) EndTime(endTime uint64) TopLongShortPositionRatioServiceAPI {
+	return (*topLongShortPositionRatioServiceAPI)((*TopLongShortPositionRatioService)(a).EndTime(endTime))
+}
+
+func (a *topLongShortPositionRatioServiceAPI) Do(ctx context.Context, opts ...RequestOption) ([]*TopLongShortPositionRatio, error) {
+	return (*TopLongShortPositionRatioService)(a).Do(ctx, opts...)
+}
+
+// TakerLongShortRatioServiceAPI is the interface of TakerLongShortRatioService
+type TakerLongShortRatioServiceAPI interface {
+	Symbol(symbol string) TakerLongShortRatioServiceAPI
+	Period(period string) TakerLongShortRatioServiceAPI
+	Limit(limit uint32) TakerLongShortRatioServiceAPI
+	StartTime(startTime uint64) TakerLongShortRatioServiceAPI
+	EndTime(endTime uint64) TakerLongShortRatioServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) ([]*TakerLongShortRatio, error)
+}
+
+type takerLongShortRatioServiceAPI TakerLongShortRatioService
+
+func (a *takerLongShortRatioServiceAPI) Symbol(symbol string) TakerLongShortRatioServiceAPI {
+	return (*takerLongShortRatioServiceAPI)((*TakerLongShortRatioService)(a).Symbol(symbol))
+}
+
+func (a *takerLongShortRatioServiceAPI) Period(period string) TakerLongShortRatioServiceAPI {
+	return (*takerLongShortRatioServiceAPI)((*TakerLongShortRatioService)(a).Period(period))
+}
+
+func (a *takerLongShortRatioServiceAPI) Limit(limit uint32) TakerLongShortRatioServiceAPI {
+	return (*takerLongShortRatioServiceAPI)((*TakerLongShortRatioService)(a).Limit(limit))
+}
+
+func (a *takerLongShortRatioServiceAPI) StartTime(startTime uint64) TakerLongShortRatioServiceAPI {
+	return (*takerLongShortRatioServiceAPI)((*TakerLongShortRatioService)(a).StartTime(startTime))
+}
+
+func (a *takerLongShortRatioServiceAPI) EndTime(endTime uint64) TakerLongShortRatioServiceAPI {
+	return (*takerLongShortRatioServiceAPI)((*TakerLongShortRatioService)(a).EndTime(endTime))
+}
+
+func (a *takerLongShortRatioServiceAPI) Do(ctx context.Context, opts ...RequestOption) ([]*TakerLongShortRatio, error) {
+	return (*TakerLongShortRatioService)(a).Do(ctx, opts...)
+}
+
+// BasisServiceAPI is the interface of BasisService
+type BasisServiceAPI interface {
+	Pair(pair string) BasisServiceAPI
+	ContractType(contractType string) BasisServiceAPI
+	Period(period string) BasisServiceAPI
+	Limit(limit uint32) BasisServiceAPI
+	StartTime(startTime uint64) BasisServiceAPI
+	EndTime(endTime uint64) BasisServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) ([]*Basis, error)
+}
+
+type basisServiceAPI BasisService
+
+func (a *basisServiceAPI) Pair(pair string) BasisServiceAPI {
+	return (*basisServiceAPI)((*BasisService)(a).Pair(pair))
+}
+
+func (a *basisServiceAPI) ContractType(contractType string) BasisServiceAPI {
+	return (*basisServiceAPI)((*BasisService)(a).ContractType(contractType))
+}
+
+func (a *basisServiceAPI) Period(period string) BasisServiceAPI {
+	return (*basisServiceAPI)((*BasisService)(a).Period(period))
+}
+
+func (a *basisServiceAPI) Limit(limit uint32) BasisServiceAPI {
+	return (*basisServiceAPI)((*BasisService)(a).Limit(limit))
+}
+
+func (a *basisServiceAPI) StartTime(startTime uint64) BasisServiceAPI {
+	return (*basisServiceAPI)((*BasisService)(a).StartTime(startTime))
+}
+
+func (a *basisServiceAPI) EndTime(endTime uint64) BasisServiceAPI {
+	return (*basisServiceAPI)((*BasisService)(a).EndTime(endTime))
+}
+
+func (a *basisServiceAPI) Do(ctx context.Context, opts ...RequestOption) ([]*Basis, error) {
+	return (*BasisService)(a).Do(ctx, opts...)
+}
+
+// IndexInfoServiceAPI is the interface of IndexInfoService
+type IndexInfoServiceAPI interface {
+	Symbol(symbol string) IndexInfoServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) ([]*IndexInfo, error)
+}
+
+type indexInfoServiceAPI IndexInfoService
+
+func (a *indexInfoServiceAPI) Symbol(symbol string) IndexInfoServiceAPI {
+	return (*indexInfoServiceAPI)((*IndexInfoService)(a).Symbol(symbol))
+}
+
+func (a *indexInfoServiceAPI) Do(ctx context.Context, opts ...RequestOption) ([]*IndexInfo, error) {
+	return (*IndexInfoService)(a).Do(ctx, opts...)
+}
+
+// AssetIndexServiceAPI is the interface of AssetIndexService
+type AssetIndexServiceAPI interface {
+	Symbol(symbol string) AssetIndexServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) ([]*AssetIndex, error)
+}
+
+type assetIndexServiceAPI AssetIndexService
+
+func (a *assetIndexServiceAPI) Symbol(symbol string) AssetIndexServiceAPI {
+	return (*assetIndexServiceAPI)((*AssetIndexService)(a).Symbol(symbol))
+}
+
+func (a *assetIndexServiceAPI) Do(ctx context.Context, opts ...RequestOption) ([]*AssetIndex, error) {
+	return (*AssetIndexService)(a).Do(ctx, opts...)
+}
+
+// ConstituentsServiceAPI is the interface of ConstituentsService
+type ConstituentsServiceAPI interface {
+	Symbol(symbol string) ConstituentsServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) (*ConstituentsServiceRsp, error)
+}
+
+type constituentsServiceAPI ConstituentsService
+
+func (a *constituentsServiceAPI) Symbol(symbol string) ConstituentsServiceAPI {
+	return (*constituentsServiceAPI)((*ConstituentsService)(a).Symbol(symbol))
+}
+
+func (a *constituentsServiceAPI) Do(ctx context.Context, opts ...RequestOption) (*ConstituentsServiceRsp, error) {
+	return (*ConstituentsService)(a).Do(ctx, opts...)
+}
+
+// LvtKlinesServiceAPI is the interface of LvtKlinesService
+type LvtKlinesServiceAPI interface {
+	Symbol(symbol string) LvtKlinesServiceAPI
+	Interval(interval string) LvtKlinesServiceAPI
+	StartTime(startTime uint64) LvtKlinesServiceAPI
+	EndTime(endTime uint64) LvtKlinesServiceAPI
+	Limit(limit uint32) LvtKlinesServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) ([]*LvtKline, error)
+}
+
+type lvtKlinesServiceAPI LvtKlinesService
+
+func (a *lvtKlinesServiceAPI) Symbol(symbol string) LvtKlinesServiceAPI {
+	return (*lvtKlinesServiceAPI)((*LvtKlinesService)(a).Symbol(symbol))
+}
+
+func (a *lvtKlinesServiceAPI) Interval(interval string) LvtKlinesServiceAPI {
+	return (*lvtKlinesServiceAPI)((*LvtKlinesService)(a).Interval(interval))
+}
+
+func (a *lvtKlinesServiceAPI) StartTime(startTime uint64) LvtKlinesServiceAPI {
+	return (*lvtKlinesServiceAPI)((*LvtKlinesService)(a).StartTime(startTime))
+}
+
+func (a *lvtKlinesServiceAPI) EndTime(endTime uint64) LvtKlinesServiceAPI {
+	return (*lvtKlinesServiceAPI)((*LvtKlinesService)(a).EndTime(endTime))
+}
+
+func (a *lvtKlinesServiceAPI) Limit(limit uint32) LvtKlinesServiceAPI {
+	return (*lvtKlinesServiceAPI)((*LvtKlinesService)(a).Limit(limit))
+}
+
+func (a *lvtKlinesServiceAPI) Do(ctx context.Context, opts ...RequestOption) ([]*LvtKline, error) {
+	return (*LvtKlinesService)(a).Do(ctx, opts...)
+}
+
+// GetFeeBurnServiceAPI is the interface of GetFeeBurnService
+type GetFeeBurnServiceAPI interface {
+	Do(ctx context.Context, opts ...RequestOption) (*FeeBurn, error)
+}
+
+type getFeeBurnServiceAPI GetFeeBurnService
+
+func (a *getFeeBurnServiceAPI) Do(ctx context.Context, opts ...RequestOption) (*FeeBurn, error) {
+	return (*GetFeeBurnService)(a).Do(ctx, opts...)
+}
+
+// FeeBurnServiceAPI is the interface of FeeBurnService
+type FeeBurnServiceAPI interface {
+	Enable() FeeBurnServiceAPI
+	Disable() FeeBurnServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) error
+}
+
+type feeBurnServiceAPI FeeBurnService
+
+func (a *feeBurnServiceAPI) Enable() FeeBurnServiceAPI {
+	return (*feeBurnServiceAPI)((*FeeBurnService)(a).Enable())
+}
+
+func (a *feeBurnServiceAPI) Disable() FeeBurnServiceAPI {
+	return (*feeBurnServiceAPI)((*FeeBurnService)(a).Disable())
+}
+
+func (a *feeBurnServiceAPI) Do(ctx context.Context, opts ...RequestOption) error {
+	return (*FeeBurnService)(a).Do(ctx, opts...)
+}
+
+// ListConvertExchangeInfoServiceAPI is the interface of ListConvertExchangeInfoService
+type ListConvertExchangeInfoServiceAPI interface {
+	FromAsset(fromAsset string) ListConvertExchangeInfoServiceAPI
+	ToAsset(toAsset string) ListConvertExchangeInfoServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) ([]ConvertExchangeInfo, error)
+}
+
+type listConvertExchangeInfoServiceAPI ListConvertExchangeInfoService
+
+func (a *listConvertExchangeInfoServiceAPI) FromAsset(fromAsset string) ListConvertExchangeInfoServiceAPI {
+	return (*listConvertExchangeInfoServiceAPI)((*ListConvertExchangeInfoService)(a).FromAsset(fromAsset))
+}
+
+func (a *listConvertExchangeInfoServiceAPI) ToAsset(toAsset string) ListConvertExchangeInfoServiceAPI {
+	return (*listConvertExchangeInfoServiceAPI)((*ListConvertExchangeInfoService)(a).ToAsset(toAsset))
+}
+
+func (a *listConvertExchangeInfoServiceAPI) Do(ctx context.Context, opts ...RequestOption) ([]ConvertExchangeInfo, error) {
+	return (*ListConvertExchangeInfoService)(a).Do(ctx, opts...)
+}
+
+// CreateConvertQuoteServiceAPI is the interface of CreateConvertQuoteService
+type CreateConvertQuoteServiceAPI interface {
+	FromAsset(fromAsset string) CreateConvertQuoteServiceAPI
+	ToAsset(toAsset string) CreateConvertQuoteServiceAPI
+	FromAmount(fromAmount string) CreateConvertQuoteServiceAPI
+	ToAmount(toAmount string) CreateConvertQuoteServiceAPI
+	ValidTime(validTime ConvertValidTime) CreateConvertQuoteServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) (*ConvertQuote, error)
+}
+
+type createConvertQuoteServiceAPI CreateConvertQuoteService
+
+func (a *createConvertQuoteServiceAPI) FromAsset(fromAsset string) CreateConvertQuoteServiceAPI {
+	return (*createConvertQuoteServiceAPI)((*CreateConvertQuoteService)(a).FromAsset(fromAsset))
+}
+
+func (a *createConvertQuoteServiceAPI) ToAsset(toAsset string) CreateConvertQuoteServiceAPI {
+	return (*createConvertQuoteServiceAPI)((*CreateConvertQuoteService)(a).ToAsset(toAsset))
+}
+
+func (a *createConvertQuoteServiceAPI) FromAmount(fromAmount string) CreateConvertQuoteServiceAPI {
+	return (*createConvertQuoteServiceAPI)((*CreateConvertQuoteService)(a).FromAmount(fromAmount))
+}
+
+func (a *createConvertQuoteServiceAPI) ToAmount(toAmount string) CreateConvertQuoteServiceAPI {
+	return (*createConvertQuoteServiceAPI)((*CreateConvertQuoteService)(a).ToAmount(toAmount))
+}
+
+func (a *createConvertQuoteServiceAPI) ValidTime(validTime ConvertValidTime) CreateConvertQuoteServiceAPI {
+	return (*createConvertQuoteServiceAPI)((*CreateConvertQuoteService)(a).ValidTime(validTime))
+}
+
+func (a *createConvertQuoteServiceAPI) Do(ctx context.Context, opts ...RequestOption) (*ConvertQuote, error) {
+	return (*CreateConvertQuoteService)(a).Do(ctx, opts...)
+}
+
+// ConvertAcceptServiceAPI is the interface of ConvertAcceptService
+type ConvertAcceptServiceAPI interface {
+	QuoteId(quoteId string) ConvertAcceptServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) (*ConvertResult, error)
+}
+
+type convertAcceptServiceAPI ConvertAcceptService
+
+func (a *convertAcceptServiceAPI) QuoteId(quoteId string) ConvertAcceptServiceAPI {
+	return (*convertAcceptServiceAPI)((*ConvertAcceptService)(a).QuoteId(quoteId))
+}
+
+func (a *convertAcceptServiceAPI) Do(ctx context.Context, opts ...RequestOption) (*ConvertResult, error) {
+	return (*ConvertAcceptService)(a).Do(ctx, opts...)
+}
+
+// ConvertStatusServiceAPI is the interface of ConvertStatusService
+type ConvertStatusServiceAPI interface {
+	QuoteId(quoteId string) ConvertStatusServiceAPI
+	OrderId(orderId string) ConvertStatusServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) (*ConvertStatusResult, error)
+}
+
+type convertStatusServiceAPI ConvertStatusService
+
+func (a *convertStatusServiceAPI) QuoteId(quoteId string) ConvertStatusServiceAPI {
+	return (*convertStatusServiceAPI)((*ConvertStatusService)(a).QuoteId(quoteId))
+}
+
+func (a *convertStatusServiceAPI) OrderId(orderId string) ConvertStatusServiceAPI {
+	return (*convertStatusServiceAPI)((*ConvertStatusService)(a).OrderId(orderId))
+}
+
+func (a *convertStatusServiceAPI) Do(ctx context.Context, opts ...RequestOption) (*ConvertStatusResult, error) {
+	return (*ConvertStatusService)(a).Do(ctx, opts...)
+}
+
+// ApiTradingStatusServiceAPI is the interface of ApiTradingStatusService
+type ApiTradingStatusServiceAPI interface {
+	Symbol(symbol string) ApiTradingStatusServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) (*TradingStatusIndicators, error)
+}
+
+type apiTradingStatusServiceAPI ApiTradingStatusService
+
+func (a *apiTradingStatusServiceAPI) Symbol(symbol string) ApiTradingStatusServiceAPI {
+	return (*apiTradingStatusServiceAPI)((*ApiTradingStatusService)(a).Symbol(symbol))
+}
+
+func (a *apiTradingStatusServiceAPI) Do(ctx context.Context, opts ...RequestOption) (*TradingStatusIndicators, error) {
+	return (*ApiTradingStatusService)(a).Do(ctx, opts...)
+}
@@ -0,0 +1,31 @@
+package futures
+
+import (
+	"context"
+
+	commonws "github.com/adshao/go-binance/v2/common/websocket"
+)
+
+// ServeFunc starts a websocket stream with the options of opt, like the Ws*Serve functions
+type ServeFunc func(errHandler ErrHandler, opt WsOptions) (doneC, stopC chan struct{}, err error)
+
+// Stream is the handle of a running websocket stream. Unlike closing the stopC channel
+// of the Ws*Serve functions, Close can be called any number of times from any goroutine.
+type Stream = commonws.Stream
+
+// ServeStream starts the stream of serve, stopped when ctx is done or Close is called.
+// errHandler may be nil.
+//
+//	stream, err := futures.ServeStream(ctx, func(errHandler futures.ErrHandler, opt futures.WsOptions) (chan struct{}, chan struct{}, error) {
+//		return futures.WsAggTradeServe("BTCUSDT", handler, errHandler, opt)
+//	}, nil)
+//	if err != nil {
+//		return err
+//	}
+//	defer stream.Close()
+func ServeStream(ctx context.Context, serve ServeFunc, errHandler ErrHandler) (*Stream, error) {
+	return commonws.ServeStream(ctx, func(ctx context.Context, errHandler func(err error)) (<-chan struct{}, error) {
+		doneC, _, err := serve(errHandler, WsOptions{Context: ctx})
+		return doneC, err
+	}, errHandler)
+}
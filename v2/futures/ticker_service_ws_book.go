@@ -0,0 +1,147 @@
+package futures
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/adshao/go-binance/v2/common"
+	"github.com/adshao/go-binance/v2/common/websocket"
+)
+
+// TickerBookWsService gets best prices and quantities of the order book
+type TickerBookWsService struct {
+	c websocket.Client
+}
+
+// NewTickerBookWsService init TickerBookWsService
+func NewTickerBookWsService() (*TickerBookWsService, error) {
+	conn, err := websocket.NewConnection(WsApiInitReadWriteConn, WebsocketKeepalive, WebsocketTimeoutReadWriteConnection)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := websocket.NewClient(conn)
+	if err != nil {
+		return nil, err
+	}
+
+	return &TickerBookWsService{
+		c: client,
+	}, nil
+}
+
+// TickerBookWsRequest parameters for 'ticker.book' websocket API
+type TickerBookWsRequest struct {
+	symbol *string
+}
+
+// NewTickerBookWsRequest init TickerBookWsRequest
+func NewTickerBookWsRequest() *TickerBookWsRequest {
+	return &TickerBookWsRequest{}
+}
+
+func (s *TickerBookWsRequest) GetParams() map[string]interface{} {
+	return s.buildParams()
+}
+
+// buildParams builds params
+func (s *TickerBookWsRequest) buildParams() params {
+	m := params{}
+	if s.symbol != nil {
+		m["symbol"] = *s.symbol
+	}
+	return m
+}
+
+// Do - sends 'ticker.book' request
+func (s *TickerBookWsService) Do(requestID string, request *TickerBookWsRequest) error {
+	rawData, err := websocket.CreateRequestWithSigned(
+		requestID,
+		websocket.TickerBookFuturesWsApiMethod,
+		request.buildParams(),
+	)
+	if err != nil {
+		return err
+	}
+
+	if err := s.c.Write(requestID, rawData); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// SyncDo - sends 'ticker.book' request and receives response
+func (s *TickerBookWsService) SyncDo(requestID string, request *TickerBookWsRequest) (*TickerBookWsResponse, error) {
+	rawData, err := websocket.CreateRequestWithSigned(
+		requestID,
+		websocket.TickerBookFuturesWsApiMethod,
+		request.buildParams(),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := s.c.WriteSync(requestID, rawData, websocket.WriteSyncWsTimeout)
+	if err != nil {
+		return nil, err
+	}
+
+	tickerBookWsResponse := &TickerBookWsResponse{}
+	if err := json.Unmarshal(response, tickerBookWsResponse); err != nil {
+		return nil, err
+	}
+
+	return tickerBookWsResponse, nil
+}
+
+// ReceiveAllDataBeforeStop waits until all responses will be received from websocket until timeout expired
+func (s *TickerBookWsService) ReceiveAllDataBeforeStop(timeout time.Duration) {
+	s.c.Wait(timeout)
+}
+
+// GetReadChannel returns channel with API response data (including API errors)
+func (s *TickerBookWsService) GetReadChannel() <-chan []byte {
+	return s.c.GetReadChannel()
+}
+
+// GetReadErrorChannel returns channel with errors which are occurred while reading websocket connection
+func (s *TickerBookWsService) GetReadErrorChannel() <-chan error {
+	return s.c.GetReadErrorChannel()
+}
+
+// GetReconnectCount returns count of reconnect attempts by client
+func (s *TickerBookWsService) GetReconnectCount() int64 {
+	return s.c.GetReconnectCount()
+}
+
+// Symbol set symbol
+func (s *TickerBookWsRequest) Symbol(symbol string) *TickerBookWsRequest {
+	s.symbol = &symbol
+	return s
+}
+
+// TickerBookWsResponse define 'ticker.book' websocket API response
+type TickerBookWsResponse struct {
+	Id     string        `json:"id"`
+	Status int           `json:"status"`
+	Result []*BookTicker `json:"result"`
+
+	// error response
+	Error *common.APIError `json:"error,omitempty"`
+}
+
+// UnmarshalJSON decode the response, the result is not a JSON object
+func (r *TickerBookWsResponse) UnmarshalJSON(data []byte) error {
+	raw := wsApiRawResponse{}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+	r.Id, r.Status, r.Error = raw.Id, raw.Status, raw.Error
+	if raw.isEmpty() {
+		return nil
+	}
+	var err error
+	r.Result, err = unmarshalOneOrMany[BookTicker](raw.Result)
+	return err
+}
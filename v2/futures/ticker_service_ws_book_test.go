@@ -0,0 +1,80 @@
+package futures
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/adshao/go-binance/v2/common/websocket"
+	"github.com/adshao/go-binance/v2/common/websocket/mock"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/suite"
+)
+
+type tickerBookServiceWsTestSuite struct {
+	suite.Suite
+
+	ctrl   *gomock.Controller
+	client *mock.MockClient
+
+	requestID string
+
+	service *TickerBookWsService
+	request *TickerBookWsRequest
+}
+
+func (s *tickerBookServiceWsTestSuite) SetupTest() {
+	s.requestID = "e2a85d9f-07a5-4f94-8d5f-789dc3deb098"
+
+	s.ctrl = gomock.NewController(s.T())
+	s.client = mock.NewMockClient(s.ctrl)
+
+	s.service = &TickerBookWsService{
+		c: s.client,
+	}
+
+	s.request = NewTickerBookWsRequest()
+}
+
+func (s *tickerBookServiceWsTestSuite) TearDownTest() {
+	s.ctrl.Finish()
+}
+
+func TestTickerBookWsService(t *testing.T) {
+	suite.Run(t, new(tickerBookServiceWsTestSuite))
+}
+
+func (s *tickerBookServiceWsTestSuite) TestDo() {
+	var data []byte
+	s.client.EXPECT().Write(s.requestID, gomock.Any()).DoAndReturn(func(id string, raw []byte) error {
+		data = raw
+		return nil
+	}).Times(1)
+
+	err := s.service.Do(s.requestID, s.request)
+	s.Require().NoError(err)
+
+	req := websocket.WsApiRequest{}
+	s.Require().NoError(json.Unmarshal(data, &req))
+	s.Equal(s.requestID, req.Id)
+	s.Equal(websocket.WsApiMethodType("ticker.book"), req.Method)
+	s.NotContains(req.Params, "apiKey")
+}
+
+func (s *tickerBookServiceWsTestSuite) TestSyncDo() {
+	rawResponseData := []byte(fmt.Sprintf(`{"id": "%s", "status": 200, "result": [{"lastUpdateId": 1027024, "symbol": "BTCUSDT", "bidPrice": "4.00000000", "bidQty": "431.00000000", "askPrice": "4.00000200", "askQty": "9.00000000", "time": 1589437530011}]}`, s.requestID))
+	s.client.EXPECT().WriteSync(s.requestID, gomock.Any(), gomock.Any()).Return(rawResponseData, nil).Times(1)
+
+	response, err := s.service.SyncDo(s.requestID, s.request)
+	s.Require().NoError(err)
+	s.Equal(s.requestID, response.Id)
+	s.Equal([]*BookTicker{{
+		Symbol:       "BTCUSDT",
+		BidPrice:     "4.00000000",
+		BidQuantity:  "431.00000000",
+		AskPrice:     "4.00000200",
+		AskQuantity:  "9.00000000",
+		Time:         1589437530011,
+		LastUpdateId: 1027024,
+	}}, response.Result)
+}
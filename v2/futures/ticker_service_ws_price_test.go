@@ -0,0 +1,75 @@
+package futures
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/adshao/go-binance/v2/common/websocket"
+	"github.com/adshao/go-binance/v2/common/websocket/mock"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/suite"
+)
+
+type tickerPriceServiceWsTestSuite struct {
+	suite.Suite
+
+	ctrl   *gomock.Controller
+	client *mock.MockClient
+
+	requestID string
+
+	service *TickerPriceWsService
+	request *TickerPriceWsRequest
+}
+
+func (s *tickerPriceServiceWsTestSuite) SetupTest() {
+	s.requestID = "e2a85d9f-07a5-4f94-8d5f-789dc3deb098"
+
+	s.ctrl = gomock.NewController(s.T())
+	s.client = mock.NewMockClient(s.ctrl)
+
+	s.service = &TickerPriceWsService{
+		c: s.client,
+	}
+
+	s.request = NewTickerPriceWsRequest().Symbol("BTCUSDT")
+}
+
+func (s *tickerPriceServiceWsTestSuite) TearDownTest() {
+	s.ctrl.Finish()
+}
+
+func TestTickerPriceWsService(t *testing.T) {
+	suite.Run(t, new(tickerPriceServiceWsTestSuite))
+}
+
+func (s *tickerPriceServiceWsTestSuite) TestDo() {
+	var data []byte
+	s.client.EXPECT().Write(s.requestID, gomock.Any()).DoAndReturn(func(id string, raw []byte) error {
+		data = raw
+		return nil
+	}).Times(1)
+
+	err := s.service.Do(s.requestID, s.request)
+	s.Require().NoError(err)
+
+	req := websocket.WsApiRequest{}
+	s.Require().NoError(json.Unmarshal(data, &req))
+	s.Equal(s.requestID, req.Id)
+	s.Equal(websocket.WsApiMethodType("ticker.price"), req.Method)
+	for k, v := range map[string]interface{}{"symbol": "BTCUSDT"} {
+		s.Equal(v, req.Params[k], k)
+	}
+	s.NotContains(req.Params, "apiKey")
+}
+
+func (s *tickerPriceServiceWsTestSuite) TestSyncDo() {
+	rawResponseData := []byte(fmt.Sprintf(`{"id": "%s", "status": 200, "result": {"symbol": "BTCUSDT", "price": "6000.01", "time": 1589437530011}}`, s.requestID))
+	s.client.EXPECT().WriteSync(s.requestID, gomock.Any(), gomock.Any()).Return(rawResponseData, nil).Times(1)
+
+	response, err := s.service.SyncDo(s.requestID, s.request)
+	s.Require().NoError(err)
+	s.Equal(s.requestID, response.Id)
+	s.Equal([]*SymbolPrice{{Symbol: "BTCUSDT", Price: "6000.01"}}, response.Result)
+}
@@ -0,0 +1,125 @@
+package futures
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/adshao/go-binance/v2/common"
+	"github.com/adshao/go-binance/v2/common/websocket"
+)
+
+// UserDataStreamPingWsService keeps the user data stream alive
+type UserDataStreamPingWsService struct {
+	c      websocket.Client
+	ApiKey string
+}
+
+// NewUserDataStreamPingWsService init UserDataStreamPingWsService
+func NewUserDataStreamPingWsService(apiKey string) (*UserDataStreamPingWsService, error) {
+	conn, err := websocket.NewConnection(WsApiInitReadWriteConn, WebsocketKeepalive, WebsocketTimeoutReadWriteConnection)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := websocket.NewClient(conn)
+	if err != nil {
+		return nil, err
+	}
+
+	return &UserDataStreamPingWsService{
+		c:      client,
+		ApiKey: apiKey,
+	}, nil
+}
+
+// UserDataStreamPingWsRequest parameters for 'userDataStream.ping' websocket API
+type UserDataStreamPingWsRequest struct{}
+
+// NewUserDataStreamPingWsRequest init UserDataStreamPingWsRequest
+func NewUserDataStreamPingWsRequest() *UserDataStreamPingWsRequest {
+	return &UserDataStreamPingWsRequest{}
+}
+
+func (s *UserDataStreamPingWsRequest) GetParams() map[string]interface{} {
+	return s.buildParams()
+}
+
+// buildParams builds params
+func (s *UserDataStreamPingWsRequest) buildParams() params {
+	m := params{}
+	return m
+}
+
+// Do - sends 'userDataStream.ping' request
+func (s *UserDataStreamPingWsService) Do(requestID string, request *UserDataStreamPingWsRequest) error {
+	rawData, err := websocket.CreateRequestWithApiKey(
+		requestID,
+		s.ApiKey,
+		websocket.UserDataStreamPingFuturesWsApiMethod,
+		request.buildParams(),
+	)
+	if err != nil {
+		return err
+	}
+
+	if err := s.c.Write(requestID, rawData); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// SyncDo - sends 'userDataStream.ping' request and receives response
+func (s *UserDataStreamPingWsService) SyncDo(requestID string, request *UserDataStreamPingWsRequest) (*UserDataStreamPingWsResponse, error) {
+	rawData, err := websocket.CreateRequestWithApiKey(
+		requestID,
+		s.ApiKey,
+		websocket.UserDataStreamPingFuturesWsApiMethod,
+		request.buildParams(),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := s.c.WriteSync(requestID, rawData, websocket.WriteSyncWsTimeout)
+	if err != nil {
+		return nil, err
+	}
+
+	userDataStreamPingWsResponse := &UserDataStreamPingWsResponse{}
+	if err := json.Unmarshal(response, userDataStreamPingWsResponse); err != nil {
+		return nil, err
+	}
+
+	return userDataStreamPingWsResponse, nil
+}
+
+// ReceiveAllDataBeforeStop waits until all responses will be received from websocket until timeout expired
+func (s *UserDataStreamPingWsService) ReceiveAllDataBeforeStop(timeout time.Duration) {
+	s.c.Wait(timeout)
+}
+
+// GetReadChannel returns channel with API response data (including API errors)
+func (s *UserDataStreamPingWsService) GetReadChannel() <-chan []byte {
+	return s.c.GetReadChannel()
+}
+
+// GetReadErrorChannel returns channel with errors which are occurred while reading websocket connection
+func (s *UserDataStreamPingWsService) GetReadErrorChannel() <-chan error {
+	return s.c.GetReadErrorChannel()
+}
+
+// GetReconnectCount returns count of reconnect attempts by client
+func (s *UserDataStreamPingWsService) GetReconnectCount() int64 {
+	return s.c.GetReconnectCount()
+}
+
+// UserDataStreamPingWsResponse define 'userDataStream.ping' websocket API response
+type UserDataStreamPingWsResponse struct {
+	Id     string               `json:"id"`
+	Status int                  `json:"status"`
+	Result UserDataStreamResult `json:"result"`
+
+	// error response
+	Error *common.APIError `json:"error,omitempty"`
+}
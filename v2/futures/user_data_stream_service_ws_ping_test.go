@@ -0,0 +1,92 @@
+package futures
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/adshao/go-binance/v2/common/websocket"
+	"github.com/adshao/go-binance/v2/common/websocket/mock"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/suite"
+)
+
+type userDataStreamPingServiceWsTestSuite struct {
+	suite.Suite
+
+	ctrl   *gomock.Controller
+	client *mock.MockClient
+
+	requestID string
+
+	service *UserDataStreamPingWsService
+	request *UserDataStreamPingWsRequest
+}
+
+func (s *userDataStreamPingServiceWsTestSuite) SetupTest() {
+	s.requestID = "e2a85d9f-07a5-4f94-8d5f-789dc3deb098"
+
+	s.ctrl = gomock.NewController(s.T())
+	s.client = mock.NewMockClient(s.ctrl)
+
+	s.service = &UserDataStreamPingWsService{
+		c:      s.client,
+		ApiKey: "dummyApiKey",
+	}
+
+	s.request = NewUserDataStreamPingWsRequest()
+}
+
+func (s *userDataStreamPingServiceWsTestSuite) TearDownTest() {
+	s.ctrl.Finish()
+}
+
+func TestUserDataStreamPingWsService(t *testing.T) {
+	suite.Run(t, new(userDataStreamPingServiceWsTestSuite))
+}
+
+func (s *userDataStreamPingServiceWsTestSuite) TestDo() {
+	var data []byte
+	s.client.EXPECT().Write(s.requestID, gomock.Any()).DoAndReturn(func(id string, raw []byte) error {
+		data = raw
+		return nil
+	}).Times(1)
+
+	err := s.service.Do(s.requestID, s.request)
+	s.Require().NoError(err)
+
+	req := websocket.WsApiRequest{}
+	s.Require().NoError(json.Unmarshal(data, &req))
+	s.Equal(s.requestID, req.Id)
+	s.Equal(websocket.WsApiMethodType("userDataStream.ping"), req.Method)
+	for k, v := range map[string]interface{}{"apiKey": "dummyApiKey"} {
+		s.Equal(v, req.Params[k], k)
+	}
+	s.NotContains(req.Params, "signature")
+}
+
+func (s *userDataStreamPingServiceWsTestSuite) TestDo_EmptyApiKey() {
+	s.service.ApiKey = ""
+	s.client.EXPECT().Write(gomock.Any(), gomock.Any()).Times(0)
+
+	err := s.service.Do(s.requestID, s.request)
+	s.ErrorIs(err, websocket.ErrorApiKeyIsNotSet)
+}
+
+func (s *userDataStreamPingServiceWsTestSuite) TestSyncDo() {
+	rawResponseData := []byte(fmt.Sprintf(`{"id": "%s", "status": 200, "result": {"listenKey": "3HBntNTepshgEdjIwSUIBgB9keLyOCg5qv3n6bYAtktG8ejcaW5HXz9Vx1JgIieg"}}`, s.requestID))
+	s.client.EXPECT().WriteSync(s.requestID, gomock.Any(), gomock.Any()).Return(rawResponseData, nil).Times(1)
+
+	response, err := s.service.SyncDo(s.requestID, s.request)
+	s.Require().NoError(err)
+	s.Equal(s.requestID, response.Id)
+	s.Equal("3HBntNTepshgEdjIwSUIBgB9keLyOCg5qv3n6bYAtktG8ejcaW5HXz9Vx1JgIieg", response.Result.ListenKey)
+}
+
+func (s *userDataStreamPingServiceWsTestSuite) TestSyncDo_EmptyRequestID() {
+	s.client.EXPECT().WriteSync(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+	response, err := s.service.SyncDo("", s.request)
+	s.Nil(response)
+	s.ErrorIs(err, websocket.ErrorRequestIDNotSet)
+}
@@ -0,0 +1,130 @@
+package futures
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/adshao/go-binance/v2/common"
+	"github.com/adshao/go-binance/v2/common/websocket"
+)
+
+// UserDataStreamStartWsService starts a user data stream
+type UserDataStreamStartWsService struct {
+	c      websocket.Client
+	ApiKey string
+}
+
+// NewUserDataStreamStartWsService init UserDataStreamStartWsService
+func NewUserDataStreamStartWsService(apiKey string) (*UserDataStreamStartWsService, error) {
+	conn, err := websocket.NewConnection(WsApiInitReadWriteConn, WebsocketKeepalive, WebsocketTimeoutReadWriteConnection)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := websocket.NewClient(conn)
+	if err != nil {
+		return nil, err
+	}
+
+	return &UserDataStreamStartWsService{
+		c:      client,
+		ApiKey: apiKey,
+	}, nil
+}
+
+// UserDataStreamStartWsRequest parameters for 'userDataStream.start' websocket API
+type UserDataStreamStartWsRequest struct{}
+
+// NewUserDataStreamStartWsRequest init UserDataStreamStartWsRequest
+func NewUserDataStreamStartWsRequest() *UserDataStreamStartWsRequest {
+	return &UserDataStreamStartWsRequest{}
+}
+
+func (s *UserDataStreamStartWsRequest) GetParams() map[string]interface{} {
+	return s.buildParams()
+}
+
+// buildParams builds params
+func (s *UserDataStreamStartWsRequest) buildParams() params {
+	m := params{}
+	return m
+}
+
+// Do - sends 'userDataStream.start' request
+func (s *UserDataStreamStartWsService) Do(requestID string, request *UserDataStreamStartWsRequest) error {
+	rawData, err := websocket.CreateRequestWithApiKey(
+		requestID,
+		s.ApiKey,
+		websocket.UserDataStreamStartFuturesWsApiMethod,
+		request.buildParams(),
+	)
+	if err != nil {
+		return err
+	}
+
+	if err := s.c.Write(requestID, rawData); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// SyncDo - sends 'userDataStream.start' request and receives response
+func (s *UserDataStreamStartWsService) SyncDo(requestID string, request *UserDataStreamStartWsRequest) (*UserDataStreamStartWsResponse, error) {
+	rawData, err := websocket.CreateRequestWithApiKey(
+		requestID,
+		s.ApiKey,
+		websocket.UserDataStreamStartFuturesWsApiMethod,
+		request.buildParams(),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := s.c.WriteSync(requestID, rawData, websocket.WriteSyncWsTimeout)
+	if err != nil {
+		return nil, err
+	}
+
+	userDataStreamStartWsResponse := &UserDataStreamStartWsResponse{}
+	if err := json.Unmarshal(response, userDataStreamStartWsResponse); err != nil {
+		return nil, err
+	}
+
+	return userDataStreamStartWsResponse, nil
+}
+
+// ReceiveAllDataBeforeStop waits until all responses will be received from websocket until timeout expired
+func (s *UserDataStreamStartWsService) ReceiveAllDataBeforeStop(timeout time.Duration) {
+	s.c.Wait(timeout)
+}
+
+// GetReadChannel returns channel with API response data (including API errors)
+func (s *UserDataStreamStartWsService) GetReadChannel() <-chan []byte {
+	return s.c.GetReadChannel()
+}
+
+// GetReadErrorChannel returns channel with errors which are occurred while reading websocket connection
+func (s *UserDataStreamStartWsService) GetReadErrorChannel() <-chan error {
+	return s.c.GetReadErrorChannel()
+}
+
+// GetReconnectCount returns count of reconnect attempts by client
+func (s *UserDataStreamStartWsService) GetReconnectCount() int64 {
+	return s.c.GetReconnectCount()
+}
+
+// UserDataStreamResult define 'userDataStream.start' and 'userDataStream.ping' result
+type UserDataStreamResult struct {
+	ListenKey string `json:"listenKey"`
+}
+
+// UserDataStreamStartWsResponse define 'userDataStream.start' websocket API response
+type UserDataStreamStartWsResponse struct {
+	Id     string               `json:"id"`
+	Status int                  `json:"status"`
+	Result UserDataStreamResult `json:"result"`
+
+	// error response
+	Error *common.APIError `json:"error,omitempty"`
+}
@@ -1,11 +1,13 @@
 package futures
 
 import (
+	"context"
 	"net/http"
 	"net/url"
-	"time"
 
 	"github.com/gorilla/websocket"
+
+	commonws "github.com/adshao/go-binance/v2/common/websocket"
 )
 
 // WsHandler handle raw websocket message
@@ -14,106 +16,82 @@ type WsHandler func(message []byte)
 // ErrHandler handles errors
 type ErrHandler func(err error)
 
+// WsOptions define the connection options accepted by the streams and the Websocket API client, they take
+// precedence over the package variables
+type WsOptions = commonws.Options
+
 // WsConfig webservice configuration
 type WsConfig struct {
 	Endpoint string
+	Header   http.Header
 	Proxy    *string
+	Delivery commonws.DeliveryConfig
+	Options  WsOptions
 }
 
-func newWsConfig(endpoint string) *WsConfig {
-	return &WsConfig{
+func newWsConfig(endpoint string, opts ...WsOptions) *WsConfig {
+	o := commonws.MergeOptions(opts...)
+	cfg := &WsConfig{
 		Endpoint: endpoint,
 		Proxy:    getWsProxyUrl(),
+		Header:   o.Header,
+		Options:  o,
+	}
+	if o.ProxyURL != "" {
+		cfg.Proxy = &o.ProxyURL
 	}
+	if o.Delivery != nil {
+		cfg.Delivery = *o.Delivery
+	}
+	return cfg
 }
 
-var wsServe = func(cfg *WsConfig, handler WsHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
-	proxy := http.ProxyFromEnvironment
-	if cfg.Proxy != nil {
-		u, err := url.Parse(*cfg.Proxy)
-		if err != nil {
-			return nil, nil, err
-		}
-		proxy = http.ProxyURL(u)
-	}
-	Dialer := websocket.Dialer{
-		Proxy:             proxy,
-		HandshakeTimeout:  45 * time.Second,
-		EnableCompression: true,
+// keepaliveStrategy returns the keepalive strategy of the streams without options
+func keepaliveStrategy() commonws.KeepaliveStrategy {
+	if WebsocketKeepalive {
+		return commonws.KeepalivePong
 	}
+	return commonws.KeepaliveNone
+}
 
-	c, _, err := Dialer.Dial(cfg.Endpoint, nil)
+var wsServe = func(cfg *WsConfig, handler WsHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+	ctx, cancel := context.WithCancel(cfg.Options.StreamContext())
+	doneC, err = wsServeContext(ctx, cfg, handler, errHandler)
 	if err != nil {
+		cancel()
 		return nil, nil, err
 	}
-	c.SetReadLimit(655350)
-	doneC = make(chan struct{})
 	stopC = make(chan struct{})
 	go func() {
-		// This function will exit either on error from
-		// websocket.Conn.ReadMessage or when the stopC channel is
-		// closed by the client.
-		defer close(doneC)
-		if WebsocketKeepalive {
-			keepAlive(c, WebsocketTimeout)
-		}
-		// Wait for the stopC channel to be closed.  We do that in a
-		// separate goroutine because ReadMessage is a blocking
-		// operation.
-		silent := false
-		go func() {
-			select {
-			case <-stopC:
-				silent = true
-			case <-doneC:
-			}
-			c.Close()
-		}()
-		for {
-			_, message, err := c.ReadMessage()
-			if err != nil {
-				if !silent {
-					errHandler(err)
-				}
-				return
-			}
-			handler(message)
+		// The connection stops when the client closes stopC
+		select {
+		case <-stopC:
+		case <-doneC:
 		}
+		cancel()
 	}()
-	return
+	return doneC, stopC, nil
 }
 
-func keepAlive(c *websocket.Conn, timeout time.Duration) {
-	ticker := time.NewTicker(timeout)
-
-	lastResponse := time.Now()
-
-	c.SetPingHandler(func(pingData string) error {
-		// Respond with Pong using the server's PING payload
-		err := c.WriteControl(
-			websocket.PongMessage,
-			[]byte(pingData),
-			time.Now().Add(WebsocketPongTimeout), // Short deadline to ensure timely response
-		)
+// wsServeContext serves the websocket of cfg until ctx is done
+func wsServeContext(ctx context.Context, cfg *WsConfig, handler WsHandler, errHandler ErrHandler) (doneC chan struct{}, err error) {
+	proxy := http.ProxyFromEnvironment
+	if cfg.Proxy != nil {
+		u, err := url.Parse(*cfg.Proxy)
 		if err != nil {
-			return err
-		}
-
-		lastResponse = time.Now()
-
-		return nil
-	})
-
-	go func() {
-		defer ticker.Stop()
-		for {
-			<-ticker.C
-			if time.Since(lastResponse) > timeout {
-				c.Close()
-				return
-			}
+			return nil, err
 		}
-	}()
+		proxy = http.ProxyURL(u)
+	}
+	c, _, err := cfg.Options.Dialer(proxy, true).DialContext(ctx, cfg.Endpoint, cfg.Header)
+	if err != nil {
+		return nil, err
+	}
+	cfg.Options.SetReadLimit(c, 655350)
+	// The pong strategy overwrites the default ping frame handler
+	return commonws.ServeConn(ctx, c, cfg.Delivery, handler, errHandler, func(ctx context.Context, c *websocket.Conn) {
+		cfg.Options.KeepAlive(ctx, c, keepaliveStrategy(), WebsocketTimeout, WebsocketPongTimeout)
+	}), nil
 }
 
 var WsGetReadWriteConnection = func(cfg *WsConfig) (*websocket.Conn, error) {
@@ -126,13 +104,7 @@ var WsGetReadWriteConnection = func(cfg *WsConfig) (*websocket.Conn, error) {
 		proxy = http.ProxyURL(u)
 	}
 
-	Dialer := websocket.Dialer{
-		Proxy:             proxy,
-		HandshakeTimeout:  45 * time.Second,
-		EnableCompression: false,
-	}
-
-	c, _, err := Dialer.Dial(cfg.Endpoint, nil)
+	c, _, err := cfg.Options.Dialer(proxy, false).Dial(cfg.Endpoint, cfg.Header)
 	if err != nil {
 		return nil, err
 	}
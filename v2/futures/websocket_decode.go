@@ -0,0 +1,194 @@
+package futures
+
+import (
+	"strings"
+
+	"github.com/adshao/go-binance/v2/common"
+)
+
+// The events of the high volume streams are decoded by scanning the message once, without
+// building a simplejson tree nor going through reflection. Each message is converted to a
+// string once and the string fields of its event are substrings of it.
+
+var (
+	wsDepthEvents        common.EventPool[WsDepthEvent]
+	wsAggTradeEvents     common.EventPool[WsAggTradeEvent]
+	wsMarkPriceEvents    common.EventPool[WsMarkPriceEvent]
+	wsAllMarkPriceEvents common.EventPool[WsAllMarkPriceEvent]
+	wsBookTickerEvents   common.EventPool[WsBookTickerEvent]
+)
+
+// eventPool returns pool when reuse is set, nil otherwise
+func eventPool[T any](reuse bool, pool *common.EventPool[T]) *common.EventPool[T] {
+	if reuse {
+		return pool
+	}
+	return nil
+}
+
+// decodeHandler returns a WsHandler decoding messages into events of pool and passing them
+// to handler, the events are recycled once handler returns when reuse is set
+func decodeHandler[T any](reuse bool, pool *common.EventPool[T], decode func(message string, event *T) error, handler func(event *T), errHandler ErrHandler) WsHandler {
+	pool = eventPool(reuse, pool)
+	return func(message []byte) {
+		event := pool.Get()
+		defer pool.Put(event)
+		if err := decode(string(message), event); err != nil {
+			errHandler(err)
+			return
+		}
+		handler(event)
+	}
+}
+
+// combinedData returns decode applied to the data of the messages of a combined stream
+func combinedData[T any](decode func(message string, event *T) error) func(message string, event *T) error {
+	return func(message string, event *T) error {
+		_, data, err := common.SplitCombinedMessage(message)
+		if err != nil {
+			return err
+		}
+		return decode(data, event)
+	}
+}
+
+func decodeWsDepthEvent(message string, event *WsDepthEvent) error {
+	*event = WsDepthEvent{Bids: event.Bids[:0], Asks: event.Asks[:0]}
+	return common.ScanObject(message, func(key, value string) (err error) {
+		switch key {
+		case "e":
+			event.Event, err = common.JSONString(value)
+		case "E":
+			event.Time, err = common.JSONInt64(value)
+		case "T":
+			event.TransactionTime, err = common.JSONInt64(value)
+		case "s":
+			event.Symbol, err = common.JSONString(value)
+		case "U":
+			event.FirstUpdateID, err = common.JSONInt64(value)
+		case "u":
+			event.LastUpdateID, err = common.JSONInt64(value)
+		case "pu":
+			event.PrevLastUpdateID, err = common.JSONInt64(value)
+		case "b":
+			event.Bids, err = common.AppendPriceLevels(event.Bids, value)
+		case "a":
+			event.Asks, err = common.AppendPriceLevels(event.Asks, value)
+		}
+		return err
+	})
+}
+
+func decodeWsAggTradeEvent(message string, event *WsAggTradeEvent) error {
+	*event = WsAggTradeEvent{}
+	return common.ScanObject(message, func(key, value string) (err error) {
+		switch key {
+		case "e":
+			event.Event, err = common.JSONString(value)
+		case "E":
+			event.Time, err = common.JSONInt64(value)
+		case "s":
+			event.Symbol, err = common.JSONString(value)
+		case "a":
+			event.AggregateTradeID, err = common.JSONInt64(value)
+		case "p":
+			event.Price, err = common.JSONString(value)
+		case "q":
+			event.Quantity, err = common.JSONString(value)
+		case "f":
+			event.FirstTradeID, err = common.JSONInt64(value)
+		case "l":
+			event.LastTradeID, err = common.JSONInt64(value)
+		case "T":
+			event.TradeTime, err = common.JSONInt64(value)
+		case "m":
+			event.Maker, err = common.JSONBool(value)
+		}
+		return err
+	})
+}
+
+func decodeWsCombinedAggTradeEvent(message string, event *WsAggTradeEvent) error {
+	stream, data, err := common.SplitCombinedMessage(message)
+	if err != nil {
+		return err
+	}
+	if err := decodeWsAggTradeEvent(data, event); err != nil {
+		return err
+	}
+	if i := strings.IndexByte(stream, '@'); i >= 0 {
+		stream = stream[:i]
+	}
+	event.Symbol = strings.ToUpper(stream)
+	return nil
+}
+
+func decodeWsMarkPriceEvent(message string, event *WsMarkPriceEvent) error {
+	*event = WsMarkPriceEvent{}
+	return common.ScanObject(message, func(key, value string) (err error) {
+		switch key {
+		case "e":
+			event.Event, err = common.JSONString(value)
+		case "E":
+			event.Time, err = common.JSONInt64(value)
+		case "s":
+			event.Symbol, err = common.JSONString(value)
+		case "p":
+			event.MarkPrice, err = common.JSONString(value)
+		case "i":
+			event.IndexPrice, err = common.JSONString(value)
+		case "P":
+			event.EstimatedSettlePrice, err = common.JSONString(value)
+		case "r":
+			event.FundingRate, err = common.JSONString(value)
+		case "T":
+			event.NextFundingTime, err = common.JSONInt64(value)
+		}
+		return err
+	})
+}
+
+// decodeWsAllMarkPriceEvent decodes the array of mark prices, reusing the events of event
+func decodeWsAllMarkPriceEvent(message string, event *WsAllMarkPriceEvent) error {
+	events := (*event)[:0]
+	err := common.ScanArray(message, func(value string) error {
+		var e *WsMarkPriceEvent
+		if len(events) < cap(events) {
+			e = events[:len(events)+1][len(events)]
+		}
+		if e == nil {
+			e = new(WsMarkPriceEvent)
+		}
+		events = append(events, e)
+		return decodeWsMarkPriceEvent(value, e)
+	})
+	*event = events
+	return err
+}
+
+func decodeWsBookTickerEvent(message string, event *WsBookTickerEvent) error {
+	*event = WsBookTickerEvent{}
+	return common.ScanObject(message, func(key, value string) (err error) {
+		switch key {
+		case "e":
+			event.Event, err = common.JSONString(value)
+		case "u":
+			event.UpdateID, err = common.JSONInt64(value)
+		case "E":
+			event.Time, err = common.JSONInt64(value)
+		case "T":
+			event.TransactionTime, err = common.JSONInt64(value)
+		case "s":
+			event.Symbol, err = common.JSONString(value)
+		case "b":
+			event.BestBidPrice, err = common.JSONString(value)
+		case "B":
+			event.BestBidQty, err = common.JSONString(value)
+		case "a":
+			event.BestAskPrice, err = common.JSONString(value)
+		case "A":
+			event.BestAskQty, err = common.JSONString(value)
+		}
+		return err
+	})
+}
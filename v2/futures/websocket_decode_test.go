@@ -0,0 +1,280 @@
+package futures
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/adshao/go-binance/v2/common"
+	"github.com/stretchr/testify/suite"
+)
+
+type websocketDecodeTestSuite struct {
+	suite.Suite
+}
+
+func TestWebsocketDecode(t *testing.T) {
+	suite.Run(t, new(websocketDecodeTestSuite))
+}
+
+// benchDepthMessage returns a depth diff message of n bids and n asks
+func benchDepthMessage(n int) []byte {
+	levels := make([]string, n)
+	for i := range levels {
+		levels[i] = fmt.Sprintf(`["%d.10","%d.500"]`, 60000+i, i)
+	}
+	return []byte(fmt.Sprintf(`{"e":"depthUpdate","E":1672515782136,"T":1672515782130,"s":"BTCUSDT","U":157,"u":160,"pu":149,"b":[%s],"a":[%s]}`,
+		strings.Join(levels, ","), strings.Join(levels, ",")))
+}
+
+var (
+	benchAggTradeMessage   = []byte(`{"e":"aggTrade","E":1672515782136,"s":"BTCUSDT","a":5933014,"p":"0.001","q":"100","f":100,"l":105,"T":1672515782136,"m":true}`)
+	benchMarkPriceMessage  = []byte(`{"e":"markPriceUpdate","E":1562305380000,"s":"BTCUSDT","p":"11794.15000000","i":"11784.62659091","P":"11784.25641265","r":"0.00038167","T":1562306400000}`)
+	benchBookTickerMessage = []byte(`{"e":"bookTicker","u":400900217,"E":1568014460893,"T":1568014460891,"s":"BNBUSDT","b":"25.35190000","B":"31.21000000","a":"25.36520000","A":"40.66000000"}`)
+)
+
+// simplejsonDepthEvent is the depth parser the fast decoder replaces
+func simplejsonDepthEvent(message []byte) (*WsDepthEvent, error) {
+	j, err := newJSON(message)
+	if err != nil {
+		return nil, err
+	}
+	event := new(WsDepthEvent)
+	event.Event = j.Get("e").MustString()
+	event.Time = j.Get("E").MustInt64()
+	event.TransactionTime = j.Get("T").MustInt64()
+	event.Symbol = j.Get("s").MustString()
+	event.FirstUpdateID = j.Get("U").MustInt64()
+	event.LastUpdateID = j.Get("u").MustInt64()
+	event.PrevLastUpdateID = j.Get("pu").MustInt64()
+	bidsLen := len(j.Get("b").MustArray())
+	event.Bids = make([]Bid, bidsLen)
+	for i := 0; i < bidsLen; i++ {
+		item := j.Get("b").GetIndex(i)
+		event.Bids[i] = Bid{
+			Price:    item.GetIndex(0).MustString(),
+			Quantity: item.GetIndex(1).MustString(),
+		}
+	}
+	asksLen := len(j.Get("a").MustArray())
+	event.Asks = make([]Ask, asksLen)
+	for i := 0; i < asksLen; i++ {
+		item := j.Get("a").GetIndex(i)
+		event.Asks[i] = Ask{
+			Price:    item.GetIndex(0).MustString(),
+			Quantity: item.GetIndex(1).MustString(),
+		}
+	}
+	return event, nil
+}
+
+// simplejsonCombinedMarkPriceEvent is the combined mark price parser the fast decoder replaces
+func simplejsonCombinedMarkPriceEvent(message []byte) (*WsMarkPriceEvent, error) {
+	j, err := newJSON(message)
+	if err != nil {
+		return nil, err
+	}
+	data := j.Get("data").MustMap()
+	jsonData, _ := json.Marshal(data)
+	event := new(WsMarkPriceEvent)
+	err = json.Unmarshal(jsonData, event)
+	return event, err
+}
+
+func (s *websocketDecodeTestSuite) TestDepthEvent() {
+	message := benchDepthMessage(3)
+	expected, err := simplejsonDepthEvent(message)
+	s.Require().NoError(err)
+	event := new(WsDepthEvent)
+	s.Require().NoError(decodeWsDepthEvent(string(message), event))
+	s.Equal(expected, event)
+
+	combined := fmt.Sprintf(`{"stream":"btcusdt@depth","data":%s}`, message)
+	event = new(WsDepthEvent)
+	s.Require().NoError(combinedData(decodeWsDepthEvent)(combined, event))
+	s.Equal(expected, event)
+
+	s.Error(decodeWsDepthEvent(`{"e":"depthUpdate","pu":true}`, event))
+	s.Error(combinedData(decodeWsDepthEvent)(`{"stream":"btcusdt@depth"}`, event))
+}
+
+func (s *websocketDecodeTestSuite) TestAggTradeEvent() {
+	expected := new(WsAggTradeEvent)
+	s.Require().NoError(json.Unmarshal(benchAggTradeMessage, expected))
+	event := new(WsAggTradeEvent)
+	s.Require().NoError(decodeWsAggTradeEvent(string(benchAggTradeMessage), event))
+	s.Equal(expected, event)
+
+	combined := fmt.Sprintf(`{"stream":"btcusdt@aggTrade","data":%s}`, benchAggTradeMessage)
+	s.Require().NoError(decodeWsCombinedAggTradeEvent(combined, event))
+	s.Equal(expected, event)
+}
+
+func (s *websocketDecodeTestSuite) TestMarkPriceEvent() {
+	combined := []byte(fmt.Sprintf(`{"stream":"btcusdt@markPrice","data":%s}`, benchMarkPriceMessage))
+	expected, err := simplejsonCombinedMarkPriceEvent(combined)
+	s.Require().NoError(err)
+	event := new(WsMarkPriceEvent)
+	s.Require().NoError(decodeWsMarkPriceEvent(string(benchMarkPriceMessage), event))
+	s.Equal(expected, event)
+	s.Require().NoError(combinedData(decodeWsMarkPriceEvent)(string(combined), event))
+	s.Equal(expected, event)
+}
+
+func (s *websocketDecodeTestSuite) TestAllMarkPriceEvent() {
+	message := fmt.Sprintf(`[%s,%s]`, benchMarkPriceMessage, strings.Replace(string(benchMarkPriceMessage), "BTCUSDT", "ETHUSDT", 1))
+	var expected WsAllMarkPriceEvent
+	s.Require().NoError(json.Unmarshal([]byte(message), &expected))
+	event := new(WsAllMarkPriceEvent)
+	s.Require().NoError(decodeWsAllMarkPriceEvent(message, event))
+	s.Equal(expected, *event)
+
+	// the events of a recycled array are reused
+	first := (*event)[0]
+	s.Require().NoError(decodeWsAllMarkPriceEvent(fmt.Sprintf(`[%s]`, benchMarkPriceMessage), event))
+	s.Len(*event, 1)
+	s.Same(first, (*event)[0])
+	s.Equal(expected[0], first)
+}
+
+func (s *websocketDecodeTestSuite) TestBookTickerEvent() {
+	expected := new(WsBookTickerEvent)
+	s.Require().NoError(json.Unmarshal(benchBookTickerMessage, expected))
+	event := new(WsBookTickerEvent)
+	s.Require().NoError(decodeWsBookTickerEvent(string(benchBookTickerMessage), event))
+	s.Equal(expected, event)
+}
+
+func (s *websocketDecodeTestSuite) TestReuseEvents() {
+	pool := new(common.EventPool[WsMarkPriceEvent])
+	s.Nil(eventPool(false, pool))
+	s.Same(pool, eventPool(true, pool))
+
+	// handlers of recycled events copy what they keep
+	var prices []string
+	var errs []error
+	wsHandler := decodeHandler(true, pool, decodeWsMarkPriceEvent, func(event *WsMarkPriceEvent) {
+		prices = append(prices, event.MarkPrice)
+	}, func(err error) {
+		errs = append(errs, err)
+	})
+	wsHandler(benchMarkPriceMessage)
+	wsHandler([]byte(`{"p":`))
+	s.Equal([]string{"11794.15000000"}, prices)
+	s.Len(errs, 1)
+	s.ErrorIs(errs[0], common.ErrInvalidJSON)
+}
+
+func BenchmarkDepthEventSimpleJSON(b *testing.B) {
+	message := benchDepthMessage(20)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		if _, err := simplejsonDepthEvent(message); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkDepthEventDecode(b *testing.B) {
+	message := benchDepthMessage(20)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		if err := decodeWsDepthEvent(string(message), new(WsDepthEvent)); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkDepthEventDecodeReuse(b *testing.B) {
+	message := benchDepthMessage(20)
+	event := new(WsDepthEvent)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		if err := decodeWsDepthEvent(string(message), event); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkAggTradeEventUnmarshal(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		if err := json.Unmarshal(benchAggTradeMessage, new(WsAggTradeEvent)); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkAggTradeEventDecode(b *testing.B) {
+	event := new(WsAggTradeEvent)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		if err := decodeWsAggTradeEvent(string(benchAggTradeMessage), event); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkCombinedMarkPriceEventSimpleJSON(b *testing.B) {
+	message := []byte(fmt.Sprintf(`{"stream":"btcusdt@markPrice","data":%s}`, benchMarkPriceMessage))
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		if _, err := simplejsonCombinedMarkPriceEvent(message); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkCombinedMarkPriceEventDecode(b *testing.B) {
+	message := []byte(fmt.Sprintf(`{"stream":"btcusdt@markPrice","data":%s}`, benchMarkPriceMessage))
+	decode := combinedData(decodeWsMarkPriceEvent)
+	event := new(WsMarkPriceEvent)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		if err := decode(string(message), event); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkAllMarkPriceEventUnmarshal(b *testing.B) {
+	message := []byte(fmt.Sprintf(`[%s]`, strings.Repeat(string(benchMarkPriceMessage)+",", 99)+string(benchMarkPriceMessage)))
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		var event WsAllMarkPriceEvent
+		if err := json.Unmarshal(message, &event); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkAllMarkPriceEventDecode(b *testing.B) {
+	message := []byte(fmt.Sprintf(`[%s]`, strings.Repeat(string(benchMarkPriceMessage)+",", 99)+string(benchMarkPriceMessage)))
+	event := new(WsAllMarkPriceEvent)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		if err := decodeWsAllMarkPriceEvent(string(message), event); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkBookTickerEventUnmarshal(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		if err := json.Unmarshal(benchBookTickerMessage, new(WsBookTickerEvent)); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkBookTickerEventDecode(b *testing.B) {
+	event := new(WsBookTickerEvent)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		if err := decodeWsBookTickerEvent(string(benchBookTickerMessage), event); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
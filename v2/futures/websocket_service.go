@@ -9,6 +9,8 @@ import (
 
 	"github.com/bitly/go-simplejson"
 	"github.com/gorilla/websocket"
+
+	commonws "github.com/adshao/go-binance/v2/common/websocket"
 )
 
 // Endpoints
@@ -28,7 +30,10 @@ var (
 	WebsocketPongTimeout = time.Second * 10
 	// WebsocketKeepalive enables sending ping/pong messages to check the connection stability
 	WebsocketKeepalive = true
-	// UseTestnet switch all the WS streams from production to the testnet
+	// UseTestnet switch all the WS streams from production to the testnet, it only applies to
+	// the clients of NewClient and to the streams without options.
+	//
+	// Deprecated: use NewClientWithEnvironment(TestnetEnvironment()) and its WsOptions.
 	UseTestnet = false
 	// WebsocketTimeoutReadWriteConnection is an interval for sending ping/pong messages if WebsocketKeepalive is enabled
 	// using for websocket API (read/write)
@@ -47,16 +52,22 @@ func SetWsProxyUrl(url string) {
 	ProxyUrl = url
 }
 
-// getWsEndpoint return the base endpoint of the WS according the UseTestnet flag
-func getWsEndpoint() string {
+// getWsEndpoint return the base endpoint of the WS of opts, according the UseTestnet flag when they set none
+func getWsEndpoint(opts ...WsOptions) string {
+	if o := commonws.MergeOptions(opts...); o.BaseURL != "" {
+		return o.BaseURL
+	}
 	if UseTestnet {
 		return BaseWsTestnetUrl
 	}
 	return BaseWsMainUrl
 }
 
-// getCombinedEndpoint return the base endpoint of the combined stream according the UseTestnet flag
-func getCombinedEndpoint() string {
+// getCombinedEndpoint return the base endpoint of the combined stream of opts, according the UseTestnet flag when they set none
+func getCombinedEndpoint(opts ...WsOptions) string {
+	if o := commonws.MergeOptions(opts...); o.CombinedBaseURL != "" {
+		return o.CombinedBaseURL
+	}
 	if UseTestnet {
 		return BaseCombinedTestnetURL
 	}
@@ -81,53 +92,22 @@ type WsAggTradeEvent struct {
 type WsAggTradeHandler func(event *WsAggTradeEvent)
 
 // WsAggTradeServe serve websocket that push trade information that is aggregated for a single taker order.
-func WsAggTradeServe(symbol string, handler WsAggTradeHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
-	endpoint := fmt.Sprintf("%s/%s@aggTrade", getWsEndpoint(), strings.ToLower(symbol))
-	cfg := newWsConfig(endpoint)
-	wsHandler := func(message []byte) {
-		event := new(WsAggTradeEvent)
-		err := json.Unmarshal(message, &event)
-		if err != nil {
-			errHandler(err)
-			return
-		}
-		handler(event)
-	}
+func WsAggTradeServe(symbol string, handler WsAggTradeHandler, errHandler ErrHandler, opts ...WsOptions) (doneC, stopC chan struct{}, err error) {
+	endpoint := fmt.Sprintf("%s/%s@aggTrade", getWsEndpoint(opts...), strings.ToLower(symbol))
+	cfg := newWsConfig(endpoint, opts...)
+	wsHandler := decodeHandler(cfg.Options.ReuseEvents, &wsAggTradeEvents, decodeWsAggTradeEvent, handler, errHandler)
 	return wsServe(cfg, wsHandler, errHandler)
 }
 
 // WsCombinedAggTradeServe is similar to WsAggTradeServe, but it handles multiple symbols
-func WsCombinedAggTradeServe(symbols []string, handler WsAggTradeHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
-	endpoint := getCombinedEndpoint()
+func WsCombinedAggTradeServe(symbols []string, handler WsAggTradeHandler, errHandler ErrHandler, opts ...WsOptions) (doneC, stopC chan struct{}, err error) {
+	endpoint := getCombinedEndpoint(opts...)
 	for _, s := range symbols {
 		endpoint += fmt.Sprintf("%s@aggTrade", strings.ToLower(s)) + "/"
 	}
 	endpoint = endpoint[:len(endpoint)-1]
-	cfg := newWsConfig(endpoint)
-	wsHandler := func(message []byte) {
-		j, err := newJSON(message)
-		if err != nil {
-			errHandler(err)
-			return
-		}
-
-		stream := j.Get("stream").MustString()
-		data := j.Get("data").MustMap()
-
-		symbol := strings.Split(stream, "@")[0]
-
-		jsonData, _ := json.Marshal(data)
-
-		event := new(WsAggTradeEvent)
-		err = json.Unmarshal(jsonData, event)
-		if err != nil {
-			errHandler(err)
-			return
-		}
-		event.Symbol = strings.ToUpper(symbol)
-
-		handler(event)
-	}
+	cfg := newWsConfig(endpoint, opts...)
+	wsHandler := decodeHandler(cfg.Options.ReuseEvents, &wsAggTradeEvents, decodeWsCombinedAggTradeEvent, handler, errHandler)
 	return wsServe(cfg, wsHandler, errHandler)
 }
 
@@ -146,28 +126,20 @@ type WsMarkPriceEvent struct {
 // WsMarkPriceHandler handle websocket that pushes price and funding rate for a single symbol.
 type WsMarkPriceHandler func(event *WsMarkPriceEvent)
 
-func wsMarkPriceServe(endpoint string, handler WsMarkPriceHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
-	cfg := newWsConfig(endpoint)
-	wsHandler := func(message []byte) {
-		event := new(WsMarkPriceEvent)
-		err := json.Unmarshal(message, &event)
-		if err != nil {
-			errHandler(err)
-			return
-		}
-		handler(event)
-	}
+func wsMarkPriceServe(endpoint string, handler WsMarkPriceHandler, errHandler ErrHandler, opts ...WsOptions) (doneC, stopC chan struct{}, err error) {
+	cfg := newWsConfig(endpoint, opts...)
+	wsHandler := decodeHandler(cfg.Options.ReuseEvents, &wsMarkPriceEvents, decodeWsMarkPriceEvent, handler, errHandler)
 	return wsServe(cfg, wsHandler, errHandler)
 }
 
 // WsMarkPriceServe serve websocket that pushes price and funding rate for a single symbol.
-func WsMarkPriceServe(symbol string, handler WsMarkPriceHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
-	endpoint := fmt.Sprintf("%s/%s@markPrice", getWsEndpoint(), strings.ToLower(symbol))
-	return wsMarkPriceServe(endpoint, handler, errHandler)
+func WsMarkPriceServe(symbol string, handler WsMarkPriceHandler, errHandler ErrHandler, opts ...WsOptions) (doneC, stopC chan struct{}, err error) {
+	endpoint := fmt.Sprintf("%s/%s@markPrice", getWsEndpoint(opts...), strings.ToLower(symbol))
+	return wsMarkPriceServe(endpoint, handler, errHandler, opts...)
 }
 
 // WsMarkPriceServeWithRate serve websocket that pushes price and funding rate for a single symbol and rate.
-func WsMarkPriceServeWithRate(symbol string, rate time.Duration, handler WsMarkPriceHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+func WsMarkPriceServeWithRate(symbol string, rate time.Duration, handler WsMarkPriceHandler, errHandler ErrHandler, opts ...WsOptions) (doneC, stopC chan struct{}, err error) {
 	var rateStr string
 	switch rate {
 	case 3 * time.Second:
@@ -177,49 +149,30 @@ func WsMarkPriceServeWithRate(symbol string, rate time.Duration, handler WsMarkP
 	default:
 		return nil, nil, errors.New("Invalid rate")
 	}
-	endpoint := fmt.Sprintf("%s/%s@markPrice%s", getWsEndpoint(), strings.ToLower(symbol), rateStr)
-	return wsMarkPriceServe(endpoint, handler, errHandler)
+	endpoint := fmt.Sprintf("%s/%s@markPrice%s", getWsEndpoint(opts...), strings.ToLower(symbol), rateStr)
+	return wsMarkPriceServe(endpoint, handler, errHandler, opts...)
 }
 
-func wsCombinedMarkPriceServe(endpoint string, handler WsMarkPriceHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
-	cfg := newWsConfig(endpoint)
-	wsHandler := func(message []byte) {
-		j, err := newJSON(message)
-		if err != nil {
-			errHandler(err)
-			return
-		}
-
-		data := j.Get("data").MustMap()
-		jsonData, _ := json.Marshal(data)
-
-		event := new(WsMarkPriceEvent)
-		err = json.Unmarshal(jsonData, event)
-		if err != nil {
-			errHandler(err)
-			return
-		}
-
-		handler(event)
-	}
-
+func wsCombinedMarkPriceServe(endpoint string, handler WsMarkPriceHandler, errHandler ErrHandler, opts ...WsOptions) (doneC, stopC chan struct{}, err error) {
+	cfg := newWsConfig(endpoint, opts...)
+	wsHandler := decodeHandler(cfg.Options.ReuseEvents, &wsMarkPriceEvents, combinedData(decodeWsMarkPriceEvent), handler, errHandler)
 	return wsServe(cfg, wsHandler, errHandler)
 }
 
 // WsCombinedMarkPriceServe is similar to WsMarkPriceServe, but it handles multiple symbols
-func WsCombinedMarkPriceServe(symbols []string, handler WsMarkPriceHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
-	endpoint := getCombinedEndpoint()
+func WsCombinedMarkPriceServe(symbols []string, handler WsMarkPriceHandler, errHandler ErrHandler, opts ...WsOptions) (doneC, stopC chan struct{}, err error) {
+	endpoint := getCombinedEndpoint(opts...)
 	for _, s := range symbols {
 		endpoint += fmt.Sprintf("%s@markPrice", strings.ToLower(s)) + "/"
 	}
 	endpoint = endpoint[:len(endpoint)-1]
 
-	return wsCombinedMarkPriceServe(endpoint, handler, errHandler)
+	return wsCombinedMarkPriceServe(endpoint, handler, errHandler, opts...)
 }
 
 // WsCombinedMarkPriceServeWithRate is similar to WsMarkPriceServeWithRate, but it for multiple symbols
-func WsCombinedMarkPriceServeWithRate(symbolLevels map[string]time.Duration, handler WsMarkPriceHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
-	endpoint := getCombinedEndpoint()
+func WsCombinedMarkPriceServeWithRate(symbolLevels map[string]time.Duration, handler WsMarkPriceHandler, errHandler ErrHandler, opts ...WsOptions) (doneC, stopC chan struct{}, err error) {
+	endpoint := getCombinedEndpoint(opts...)
 	for symbol, rate := range symbolLevels {
 		var rateStr string
 		switch rate {
@@ -236,7 +189,7 @@ func WsCombinedMarkPriceServeWithRate(symbolLevels map[string]time.Duration, han
 
 	endpoint = endpoint[:len(endpoint)-1]
 
-	return wsCombinedMarkPriceServe(endpoint, handler, errHandler)
+	return wsCombinedMarkPriceServe(endpoint, handler, errHandler, opts...)
 }
 
 // WsAllMarkPriceEvent defines an array of websocket markPriceUpdate events.
@@ -245,28 +198,22 @@ type WsAllMarkPriceEvent []*WsMarkPriceEvent
 // WsAllMarkPriceHandler handle websocket that pushes price and funding rate for all symbol.
 type WsAllMarkPriceHandler func(event WsAllMarkPriceEvent)
 
-func wsAllMarkPriceServe(endpoint string, handler WsAllMarkPriceHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
-	cfg := newWsConfig(endpoint)
-	wsHandler := func(message []byte) {
-		var event WsAllMarkPriceEvent
-		err := json.Unmarshal(message, &event)
-		if err != nil {
-			errHandler(err)
-			return
-		}
-		handler(event)
-	}
+func wsAllMarkPriceServe(endpoint string, handler WsAllMarkPriceHandler, errHandler ErrHandler, opts ...WsOptions) (doneC, stopC chan struct{}, err error) {
+	cfg := newWsConfig(endpoint, opts...)
+	wsHandler := decodeHandler(cfg.Options.ReuseEvents, &wsAllMarkPriceEvents, decodeWsAllMarkPriceEvent, func(event *WsAllMarkPriceEvent) {
+		handler(*event)
+	}, errHandler)
 	return wsServe(cfg, wsHandler, errHandler)
 }
 
 // WsAllMarkPriceServe serve websocket that pushes price and funding rate for all symbol.
-func WsAllMarkPriceServe(handler WsAllMarkPriceHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
-	endpoint := fmt.Sprintf("%s/!markPrice@arr", getWsEndpoint())
-	return wsAllMarkPriceServe(endpoint, handler, errHandler)
+func WsAllMarkPriceServe(handler WsAllMarkPriceHandler, errHandler ErrHandler, opts ...WsOptions) (doneC, stopC chan struct{}, err error) {
+	endpoint := fmt.Sprintf("%s/!markPrice@arr", getWsEndpoint(opts...))
+	return wsAllMarkPriceServe(endpoint, handler, errHandler, opts...)
 }
 
 // WsAllMarkPriceServeWithRate serve websocket that pushes price and funding rate for all symbol and rate.
-func WsAllMarkPriceServeWithRate(rate time.Duration, handler WsAllMarkPriceHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+func WsAllMarkPriceServeWithRate(rate time.Duration, handler WsAllMarkPriceHandler, errHandler ErrHandler, opts ...WsOptions) (doneC, stopC chan struct{}, err error) {
 	var rateStr string
 	switch rate {
 	case 3 * time.Second:
@@ -276,8 +223,8 @@ func WsAllMarkPriceServeWithRate(rate time.Duration, handler WsAllMarkPriceHandl
 	default:
 		return nil, nil, errors.New("Invalid rate")
 	}
-	endpoint := fmt.Sprintf("%s/!markPrice@arr%s", getWsEndpoint(), rateStr)
-	return wsAllMarkPriceServe(endpoint, handler, errHandler)
+	endpoint := fmt.Sprintf("%s/!markPrice@arr%s", getWsEndpoint(opts...), rateStr)
+	return wsAllMarkPriceServe(endpoint, handler, errHandler, opts...)
 }
 
 // WsKlineEvent define websocket kline event
@@ -312,9 +259,9 @@ type WsKline struct {
 type WsKlineHandler func(event *WsKlineEvent)
 
 // WsKlineServe serve websocket kline handler with a symbol and interval like 15m, 30s
-func WsKlineServe(symbol string, interval string, handler WsKlineHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
-	endpoint := fmt.Sprintf("%s/%s@kline_%s", getWsEndpoint(), strings.ToLower(symbol), interval)
-	cfg := newWsConfig(endpoint)
+func WsKlineServe(symbol string, interval string, handler WsKlineHandler, errHandler ErrHandler, opts ...WsOptions) (doneC, stopC chan struct{}, err error) {
+	endpoint := fmt.Sprintf("%s/%s@kline_%s", getWsEndpoint(opts...), strings.ToLower(symbol), interval)
+	cfg := newWsConfig(endpoint, opts...)
 	wsHandler := func(message []byte) {
 		event := new(WsKlineEvent)
 		err := json.Unmarshal(message, event)
@@ -328,13 +275,13 @@ func WsKlineServe(symbol string, interval string, handler WsKlineHandler, errHan
 }
 
 // WsCombinedKlineServe is similar to WsKlineServe, but it handles multiple symbols with it interval
-func WsCombinedKlineServe(symbolIntervalPair map[string]string, handler WsKlineHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
-	endpoint := getCombinedEndpoint()
+func WsCombinedKlineServe(symbolIntervalPair map[string]string, handler WsKlineHandler, errHandler ErrHandler, opts ...WsOptions) (doneC, stopC chan struct{}, err error) {
+	endpoint := getCombinedEndpoint(opts...)
 	for symbol, interval := range symbolIntervalPair {
 		endpoint += fmt.Sprintf("%s@kline_%s", strings.ToLower(symbol), interval) + "/"
 	}
 	endpoint = endpoint[:len(endpoint)-1]
-	cfg := newWsConfig(endpoint)
+	cfg := newWsConfig(endpoint, opts...)
 	wsHandler := func(message []byte) {
 		j, err := newJSON(message)
 		if err != nil {
@@ -363,15 +310,15 @@ func WsCombinedKlineServe(symbolIntervalPair map[string]string, handler WsKlineH
 }
 
 // WsCombinedKlineServeMultiInterval is similar to WsCombinedKlineServe, but it supports multiple intervals per symbol
-func WsCombinedKlineServeMultiInterval(symbolIntervals map[string][]string, handler WsKlineHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
-	endpoint := getCombinedEndpoint()
+func WsCombinedKlineServeMultiInterval(symbolIntervals map[string][]string, handler WsKlineHandler, errHandler ErrHandler, opts ...WsOptions) (doneC, stopC chan struct{}, err error) {
+	endpoint := getCombinedEndpoint(opts...)
 	for symbol, intervals := range symbolIntervals {
 		for _, interval := range intervals {
 			endpoint += fmt.Sprintf("%s@kline_%s", strings.ToLower(symbol), interval) + "/"
 		}
 	}
 	endpoint = endpoint[:len(endpoint)-1]
-	cfg := newWsConfig(endpoint)
+	cfg := newWsConfig(endpoint, opts...)
 	wsHandler := func(message []byte) {
 		j, err := newJSON(message)
 		if err != nil {
@@ -439,10 +386,10 @@ type WsContinuousKlineHandler func(event *WsContinuousKlineEvent)
 
 // WsContinuousKlineServe serve websocket continuous kline handler with a pair and contractType and interval like 15m, 30s
 func WsContinuousKlineServe(subscribeArgs *WsContinuousKlineSubscribeArgs, handler WsContinuousKlineHandler,
-	errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
-	endpoint := fmt.Sprintf("%s/%s_%s@continuousKline_%s", getWsEndpoint(), strings.ToLower(subscribeArgs.Pair),
+	errHandler ErrHandler, opts ...WsOptions) (doneC, stopC chan struct{}, err error) {
+	endpoint := fmt.Sprintf("%s/%s_%s@continuousKline_%s", getWsEndpoint(opts...), strings.ToLower(subscribeArgs.Pair),
 		strings.ToLower(subscribeArgs.ContractType), subscribeArgs.Interval)
-	cfg := newWsConfig(endpoint)
+	cfg := newWsConfig(endpoint, opts...)
 	wsHandler := func(message []byte) {
 		event := new(WsContinuousKlineEvent)
 		err := json.Unmarshal(message, event)
@@ -457,14 +404,14 @@ func WsContinuousKlineServe(subscribeArgs *WsContinuousKlineSubscribeArgs, handl
 
 // WsCombinedContinuousKlineServe is similar to WsContinuousKlineServe, but it handles multiple pairs of different contractType with its interval
 func WsCombinedContinuousKlineServe(subscribeArgsList []*WsContinuousKlineSubscribeArgs,
-	handler WsContinuousKlineHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
-	endpoint := getCombinedEndpoint()
+	handler WsContinuousKlineHandler, errHandler ErrHandler, opts ...WsOptions) (doneC, stopC chan struct{}, err error) {
+	endpoint := getCombinedEndpoint(opts...)
 	for _, val := range subscribeArgsList {
 		endpoint += fmt.Sprintf("%s_%s@continuousKline_%s", strings.ToLower(val.Pair),
 			strings.ToLower(val.ContractType), val.Interval) + "/"
 	}
 	endpoint = endpoint[:len(endpoint)-1]
-	cfg := newWsConfig(endpoint)
+	cfg := newWsConfig(endpoint, opts...)
 	wsHandler := func(message []byte) {
 		j, err := newJSON(message)
 		if err != nil {
@@ -505,9 +452,9 @@ type WsMiniMarketTickerEvent struct {
 type WsMiniMarketTickerHandler func(event *WsMiniMarketTickerEvent)
 
 // WsMiniMarketTickerServe serve websocket that pushes 24hr rolling window mini-ticker statistics for a single symbol.
-func WsMiniMarketTickerServe(symbol string, handler WsMiniMarketTickerHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
-	endpoint := fmt.Sprintf("%s/%s@miniTicker", getWsEndpoint(), strings.ToLower(symbol))
-	cfg := newWsConfig(endpoint)
+func WsMiniMarketTickerServe(symbol string, handler WsMiniMarketTickerHandler, errHandler ErrHandler, opts ...WsOptions) (doneC, stopC chan struct{}, err error) {
+	endpoint := fmt.Sprintf("%s/%s@miniTicker", getWsEndpoint(opts...), strings.ToLower(symbol))
+	cfg := newWsConfig(endpoint, opts...)
 	wsHandler := func(message []byte) {
 		event := new(WsMiniMarketTickerEvent)
 		err := json.Unmarshal(message, &event)
@@ -527,9 +474,9 @@ type WsAllMiniMarketTickerEvent []*WsMiniMarketTickerEvent
 type WsAllMiniMarketTickerHandler func(event WsAllMiniMarketTickerEvent)
 
 // WsAllMiniMarketTickerServe serve websocket that pushes price and funding rate for all markets.
-func WsAllMiniMarketTickerServe(handler WsAllMiniMarketTickerHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
-	endpoint := fmt.Sprintf("%s/!miniTicker@arr", getWsEndpoint())
-	cfg := newWsConfig(endpoint)
+func WsAllMiniMarketTickerServe(handler WsAllMiniMarketTickerHandler, errHandler ErrHandler, opts ...WsOptions) (doneC, stopC chan struct{}, err error) {
+	endpoint := fmt.Sprintf("%s/!miniTicker@arr", getWsEndpoint(opts...))
+	cfg := newWsConfig(endpoint, opts...)
 	wsHandler := func(message []byte) {
 		var event WsAllMiniMarketTickerEvent
 		err := json.Unmarshal(message, &event)
@@ -568,9 +515,9 @@ type WsMarketTickerEvent struct {
 type WsMarketTickerHandler func(event *WsMarketTickerEvent)
 
 // WsMarketTickerServe serve websocket that pushes 24hr rolling window mini-ticker statistics for a single symbol.
-func WsMarketTickerServe(symbol string, handler WsMarketTickerHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
-	endpoint := fmt.Sprintf("%s/%s@ticker", getWsEndpoint(), strings.ToLower(symbol))
-	cfg := newWsConfig(endpoint)
+func WsMarketTickerServe(symbol string, handler WsMarketTickerHandler, errHandler ErrHandler, opts ...WsOptions) (doneC, stopC chan struct{}, err error) {
+	endpoint := fmt.Sprintf("%s/%s@ticker", getWsEndpoint(opts...), strings.ToLower(symbol))
+	cfg := newWsConfig(endpoint, opts...)
 	wsHandler := func(message []byte) {
 		event := new(WsMarketTickerEvent)
 		err := json.Unmarshal(message, &event)
@@ -590,9 +537,9 @@ type WsAllMarketTickerEvent []*WsMarketTickerEvent
 type WsAllMarketTickerHandler func(event WsAllMarketTickerEvent)
 
 // WsAllMarketTickerServe serve websocket that pushes price and funding rate for all markets.
-func WsAllMarketTickerServe(handler WsAllMarketTickerHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
-	endpoint := fmt.Sprintf("%s/!ticker@arr", getWsEndpoint())
-	cfg := newWsConfig(endpoint)
+func WsAllMarketTickerServe(handler WsAllMarketTickerHandler, errHandler ErrHandler, opts ...WsOptions) (doneC, stopC chan struct{}, err error) {
+	endpoint := fmt.Sprintf("%s/!ticker@arr", getWsEndpoint(opts...))
+	cfg := newWsConfig(endpoint, opts...)
 	wsHandler := func(message []byte) {
 		var event WsAllMarketTickerEvent
 		err := json.Unmarshal(message, &event)
@@ -627,53 +574,29 @@ type WsCombinedBookTickerEvent struct {
 type WsBookTickerHandler func(event *WsBookTickerEvent)
 
 // WsBookTickerServe serve websocket that pushes updates to the best bid or ask price or quantity in real-time for a specified symbol.
-func WsBookTickerServe(symbol string, handler WsBookTickerHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
-	endpoint := fmt.Sprintf("%s/%s@bookTicker", getWsEndpoint(), strings.ToLower(symbol))
-	cfg := newWsConfig(endpoint)
-	wsHandler := func(message []byte) {
-		event := new(WsBookTickerEvent)
-		err := json.Unmarshal(message, &event)
-		if err != nil {
-			errHandler(err)
-			return
-		}
-		handler(event)
-	}
+func WsBookTickerServe(symbol string, handler WsBookTickerHandler, errHandler ErrHandler, opts ...WsOptions) (doneC, stopC chan struct{}, err error) {
+	endpoint := fmt.Sprintf("%s/%s@bookTicker", getWsEndpoint(opts...), strings.ToLower(symbol))
+	cfg := newWsConfig(endpoint, opts...)
+	wsHandler := decodeHandler(cfg.Options.ReuseEvents, &wsBookTickerEvents, decodeWsBookTickerEvent, handler, errHandler)
 	return wsServe(cfg, wsHandler, errHandler)
 }
 
-func WsCombinedBookTickerServe(symbols []string, handler WsBookTickerHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
-	endpoint := getCombinedEndpoint()
+func WsCombinedBookTickerServe(symbols []string, handler WsBookTickerHandler, errHandler ErrHandler, opts ...WsOptions) (doneC, stopC chan struct{}, err error) {
+	endpoint := getCombinedEndpoint(opts...)
 	for _, s := range symbols {
 		endpoint += fmt.Sprintf("%s@bookTicker", strings.ToLower(s)) + "/"
 	}
 	endpoint = endpoint[:len(endpoint)-1]
-	cfg := newWsConfig(endpoint)
-	wsHandler := func(message []byte) {
-		event := new(WsCombinedBookTickerEvent)
-		err := json.Unmarshal(message, event)
-		if err != nil {
-			errHandler(err)
-			return
-		}
-		handler(event.Data)
-	}
+	cfg := newWsConfig(endpoint, opts...)
+	wsHandler := decodeHandler(cfg.Options.ReuseEvents, &wsBookTickerEvents, combinedData(decodeWsBookTickerEvent), handler, errHandler)
 	return wsServe(cfg, wsHandler, errHandler)
 }
 
 // WsAllBookTickerServe serve websocket that pushes updates to the best bid or ask price or quantity in real-time for all symbols.
-func WsAllBookTickerServe(handler WsBookTickerHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
-	endpoint := fmt.Sprintf("%s/!bookTicker", getWsEndpoint())
-	cfg := newWsConfig(endpoint)
-	wsHandler := func(message []byte) {
-		event := new(WsBookTickerEvent)
-		err := json.Unmarshal(message, &event)
-		if err != nil {
-			errHandler(err)
-			return
-		}
-		handler(event)
-	}
+func WsAllBookTickerServe(handler WsBookTickerHandler, errHandler ErrHandler, opts ...WsOptions) (doneC, stopC chan struct{}, err error) {
+	endpoint := fmt.Sprintf("%s/!bookTicker", getWsEndpoint(opts...))
+	cfg := newWsConfig(endpoint, opts...)
+	wsHandler := decodeHandler(cfg.Options.ReuseEvents, &wsBookTickerEvents, decodeWsBookTickerEvent, handler, errHandler)
 	return wsServe(cfg, wsHandler, errHandler)
 }
 
@@ -703,9 +626,9 @@ type WsLiquidationOrder struct {
 type WsLiquidationOrderHandler func(event *WsLiquidationOrderEvent)
 
 // WsLiquidationOrderServe serve websocket that pushes force liquidation order information for specific symbol.
-func WsLiquidationOrderServe(symbol string, handler WsLiquidationOrderHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
-	endpoint := fmt.Sprintf("%s/%s@forceOrder", getWsEndpoint(), strings.ToLower(symbol))
-	cfg := newWsConfig(endpoint)
+func WsLiquidationOrderServe(symbol string, handler WsLiquidationOrderHandler, errHandler ErrHandler, opts ...WsOptions) (doneC, stopC chan struct{}, err error) {
+	endpoint := fmt.Sprintf("%s/%s@forceOrder", getWsEndpoint(opts...), strings.ToLower(symbol))
+	cfg := newWsConfig(endpoint, opts...)
 	wsHandler := func(message []byte) {
 		event := new(WsLiquidationOrderEvent)
 		err := json.Unmarshal(message, &event)
@@ -719,9 +642,9 @@ func WsLiquidationOrderServe(symbol string, handler WsLiquidationOrderHandler, e
 }
 
 // WsAllLiquidationOrderServe serve websocket that pushes force liquidation order information for all symbols.
-func WsAllLiquidationOrderServe(handler WsLiquidationOrderHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
-	endpoint := fmt.Sprintf("%s/!forceOrder@arr", getWsEndpoint())
-	cfg := newWsConfig(endpoint)
+func WsAllLiquidationOrderServe(handler WsLiquidationOrderHandler, errHandler ErrHandler, opts ...WsOptions) (doneC, stopC chan struct{}, err error) {
+	endpoint := fmt.Sprintf("%s/!forceOrder@arr", getWsEndpoint(opts...))
+	cfg := newWsConfig(endpoint, opts...)
 	wsHandler := func(message []byte) {
 		event := new(WsLiquidationOrderEvent)
 		err := json.Unmarshal(message, &event)
@@ -750,127 +673,59 @@ type WsDepthEvent struct {
 // WsDepthHandler handle websocket depth event
 type WsDepthHandler func(event *WsDepthEvent)
 
-func wsPartialDepthServe(symbol string, levels int, rate *time.Duration, handler WsDepthHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+func wsPartialDepthServe(symbol string, levels int, rate *time.Duration, handler WsDepthHandler, errHandler ErrHandler, opts ...WsOptions) (doneC, stopC chan struct{}, err error) {
 	if levels != 5 && levels != 10 && levels != 20 {
 		return nil, nil, errors.New("Invalid levels")
 	}
 	levelsStr := fmt.Sprintf("%d", levels)
-	return wsDepthServe(symbol, levelsStr, rate, handler, errHandler)
+	return wsDepthServe(symbol, levelsStr, rate, handler, errHandler, opts...)
 }
 
 // WsPartialDepthServe serve websocket partial depth handler.
-func WsPartialDepthServe(symbol string, levels int, handler WsDepthHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
-	return wsPartialDepthServe(symbol, levels, nil, handler, errHandler)
+func WsPartialDepthServe(symbol string, levels int, handler WsDepthHandler, errHandler ErrHandler, opts ...WsOptions) (doneC, stopC chan struct{}, err error) {
+	return wsPartialDepthServe(symbol, levels, nil, handler, errHandler, opts...)
 }
 
 // WsPartialDepthServeWithRate serve websocket partial depth handler with rate.
-func WsPartialDepthServeWithRate(symbol string, levels int, rate time.Duration, handler WsDepthHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
-	return wsPartialDepthServe(symbol, levels, &rate, handler, errHandler)
+func WsPartialDepthServeWithRate(symbol string, levels int, rate time.Duration, handler WsDepthHandler, errHandler ErrHandler, opts ...WsOptions) (doneC, stopC chan struct{}, err error) {
+	return wsPartialDepthServe(symbol, levels, &rate, handler, errHandler, opts...)
 }
 
 // WsDiffDepthServe serve websocket diff. depth handler.
-func WsDiffDepthServe(symbol string, handler WsDepthHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
-	return wsDepthServe(symbol, "", nil, handler, errHandler)
+func WsDiffDepthServe(symbol string, handler WsDepthHandler, errHandler ErrHandler, opts ...WsOptions) (doneC, stopC chan struct{}, err error) {
+	return wsDepthServe(symbol, "", nil, handler, errHandler, opts...)
 }
 
 // WsCombinedDepthServe is similar to WsPartialDepthServe, but it for multiple symbols
-func WsCombinedDepthServe(symbolLevels map[string]string, handler WsDepthHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
-	endpoint := getCombinedEndpoint()
+func WsCombinedDepthServe(symbolLevels map[string]string, handler WsDepthHandler, errHandler ErrHandler, opts ...WsOptions) (doneC, stopC chan struct{}, err error) {
+	endpoint := getCombinedEndpoint(opts...)
 	for s, l := range symbolLevels {
 		endpoint += fmt.Sprintf("%s@depth%s", strings.ToLower(s), l) + "/"
 	}
 	endpoint = endpoint[:len(endpoint)-1]
-	cfg := newWsConfig(endpoint)
-	wsHandler := func(message []byte) {
-		j, err := newJSON(message)
-		if err != nil {
-			errHandler(err)
-			return
-		}
-		event := new(WsDepthEvent)
-		data := j.Get("data").MustMap()
-		event.Event = data["e"].(string)
-		event.Time, _ = data["E"].(json.Number).Int64()
-		event.TransactionTime, _ = data["T"].(json.Number).Int64()
-		event.Symbol = data["s"].(string)
-		event.FirstUpdateID, _ = data["U"].(json.Number).Int64()
-		event.LastUpdateID, _ = data["u"].(json.Number).Int64()
-		event.PrevLastUpdateID, _ = data["pu"].(json.Number).Int64()
-		bidsLen := len(data["b"].([]interface{}))
-		event.Bids = make([]Bid, bidsLen)
-		for i := 0; i < bidsLen; i++ {
-			item := data["b"].([]interface{})[i].([]interface{})
-			event.Bids[i] = Bid{
-				Price:    item[0].(string),
-				Quantity: item[1].(string),
-			}
-		}
-		asksLen := len(data["a"].([]interface{}))
-		event.Asks = make([]Ask, asksLen)
-		for i := 0; i < asksLen; i++ {
-			item := data["a"].([]interface{})[i].([]interface{})
-			event.Asks[i] = Ask{
-				Price:    item[0].(string),
-				Quantity: item[1].(string),
-			}
-		}
-		handler(event)
-	}
+	cfg := newWsConfig(endpoint, opts...)
+	wsHandler := decodeHandler(cfg.Options.ReuseEvents, &wsDepthEvents, combinedData(decodeWsDepthEvent), handler, errHandler)
 	return wsServe(cfg, wsHandler, errHandler)
 }
 
 // WsCombinedDiffDepthServe is similar to WsDiffDepthServe, but it for multiple symbols
-func WsCombinedDiffDepthServe(symbols []string, handler WsDepthHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
-	endpoint := getCombinedEndpoint()
+func WsCombinedDiffDepthServe(symbols []string, handler WsDepthHandler, errHandler ErrHandler, opts ...WsOptions) (doneC, stopC chan struct{}, err error) {
+	endpoint := getCombinedEndpoint(opts...)
 	for _, s := range symbols {
 		endpoint += fmt.Sprintf("%s@depth", strings.ToLower(s)) + "/"
 	}
 	endpoint = endpoint[:len(endpoint)-1]
-	cfg := newWsConfig(endpoint)
-	wsHandler := func(message []byte) {
-		j, err := newJSON(message)
-		if err != nil {
-			errHandler(err)
-			return
-		}
-		event := new(WsDepthEvent)
-		data := j.Get("data").MustMap()
-		event.Event = data["e"].(string)
-		event.Time, _ = data["E"].(json.Number).Int64()
-		event.TransactionTime, _ = data["T"].(json.Number).Int64()
-		event.Symbol = data["s"].(string)
-		event.FirstUpdateID, _ = data["U"].(json.Number).Int64()
-		event.LastUpdateID, _ = data["u"].(json.Number).Int64()
-		event.PrevLastUpdateID, _ = data["pu"].(json.Number).Int64()
-		bidsLen := len(data["b"].([]interface{}))
-		event.Bids = make([]Bid, bidsLen)
-		for i := 0; i < bidsLen; i++ {
-			item := data["b"].([]interface{})[i].([]interface{})
-			event.Bids[i] = Bid{
-				Price:    item[0].(string),
-				Quantity: item[1].(string),
-			}
-		}
-		asksLen := len(data["a"].([]interface{}))
-		event.Asks = make([]Ask, asksLen)
-		for i := 0; i < asksLen; i++ {
-			item := data["a"].([]interface{})[i].([]interface{})
-			event.Asks[i] = Ask{
-				Price:    item[0].(string),
-				Quantity: item[1].(string),
-			}
-		}
-		handler(event)
-	}
+	cfg := newWsConfig(endpoint, opts...)
+	wsHandler := decodeHandler(cfg.Options.ReuseEvents, &wsDepthEvents, combinedData(decodeWsDepthEvent), handler, errHandler)
 	return wsServe(cfg, wsHandler, errHandler)
 }
 
 // WsDiffDepthServeWithRate serve websocket diff. depth handler with rate.
-func WsDiffDepthServeWithRate(symbol string, rate time.Duration, handler WsDepthHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
-	return wsDepthServe(symbol, "", &rate, handler, errHandler)
+func WsDiffDepthServeWithRate(symbol string, rate time.Duration, handler WsDepthHandler, errHandler ErrHandler, opts ...WsOptions) (doneC, stopC chan struct{}, err error) {
+	return wsDepthServe(symbol, "", &rate, handler, errHandler, opts...)
 }
 
-func wsDepthServe(symbol string, levels string, rate *time.Duration, handler WsDepthHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+func wsDepthServe(symbol string, levels string, rate *time.Duration, handler WsDepthHandler, errHandler ErrHandler, opts ...WsOptions) (doneC, stopC chan struct{}, err error) {
 	var rateStr string
 	if rate != nil {
 		switch *rate {
@@ -884,42 +739,9 @@ func wsDepthServe(symbol string, levels string, rate *time.Duration, handler WsD
 			return nil, nil, errors.New("Invalid rate")
 		}
 	}
-	endpoint := fmt.Sprintf("%s/%s@depth%s%s", getWsEndpoint(), strings.ToLower(symbol), levels, rateStr)
-	cfg := newWsConfig(endpoint)
-	wsHandler := func(message []byte) {
-		j, err := newJSON(message)
-		if err != nil {
-			errHandler(err)
-			return
-		}
-		event := new(WsDepthEvent)
-		event.Event = j.Get("e").MustString()
-		event.Time = j.Get("E").MustInt64()
-		event.TransactionTime = j.Get("T").MustInt64()
-		event.Symbol = j.Get("s").MustString()
-		event.FirstUpdateID = j.Get("U").MustInt64()
-		event.LastUpdateID = j.Get("u").MustInt64()
-		event.PrevLastUpdateID = j.Get("pu").MustInt64()
-		bidsLen := len(j.Get("b").MustArray())
-		event.Bids = make([]Bid, bidsLen)
-		for i := 0; i < bidsLen; i++ {
-			item := j.Get("b").GetIndex(i)
-			event.Bids[i] = Bid{
-				Price:    item.GetIndex(0).MustString(),
-				Quantity: item.GetIndex(1).MustString(),
-			}
-		}
-		asksLen := len(j.Get("a").MustArray())
-		event.Asks = make([]Ask, asksLen)
-		for i := 0; i < asksLen; i++ {
-			item := j.Get("a").GetIndex(i)
-			event.Asks[i] = Ask{
-				Price:    item.GetIndex(0).MustString(),
-				Quantity: item.GetIndex(1).MustString(),
-			}
-		}
-		handler(event)
-	}
+	endpoint := fmt.Sprintf("%s/%s@depth%s%s", getWsEndpoint(opts...), strings.ToLower(symbol), levels, rateStr)
+	cfg := newWsConfig(endpoint, opts...)
+	wsHandler := decodeHandler(cfg.Options.ReuseEvents, &wsDepthEvents, decodeWsDepthEvent, handler, errHandler)
 	return wsServe(cfg, wsHandler, errHandler)
 }
 
@@ -946,9 +768,9 @@ type WsBLVTBasket struct {
 type WsBLVTInfoHandler func(event *WsBLVTInfoEvent)
 
 // WsBLVTInfoServe serve BLVT info stream
-func WsBLVTInfoServe(name string, handler WsBLVTInfoHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
-	endpoint := fmt.Sprintf("%s/%s@tokenNav", getWsEndpoint(), strings.ToUpper(name))
-	cfg := newWsConfig(endpoint)
+func WsBLVTInfoServe(name string, handler WsBLVTInfoHandler, errHandler ErrHandler, opts ...WsOptions) (doneC, stopC chan struct{}, err error) {
+	endpoint := fmt.Sprintf("%s/%s@tokenNav", getWsEndpoint(opts...), strings.ToUpper(name))
+	cfg := newWsConfig(endpoint, opts...)
 	wsHandler := func(message []byte) {
 		event := new(WsBLVTInfoEvent)
 		err := json.Unmarshal(message, &event)
@@ -989,9 +811,9 @@ type WsBLVTKline struct {
 type WsBLVTKlineHandler func(event *WsBLVTKlineEvent)
 
 // WsBLVTKlineServe serve BLVT kline stream
-func WsBLVTKlineServe(name string, interval string, handler WsBLVTKlineHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
-	endpoint := fmt.Sprintf("%s/%s@nav_Kline_%s", getWsEndpoint(), strings.ToUpper(name), interval)
-	cfg := newWsConfig(endpoint)
+func WsBLVTKlineServe(name string, interval string, handler WsBLVTKlineHandler, errHandler ErrHandler, opts ...WsOptions) (doneC, stopC chan struct{}, err error) {
+	endpoint := fmt.Sprintf("%s/%s@nav_Kline_%s", getWsEndpoint(opts...), strings.ToUpper(name), interval)
+	cfg := newWsConfig(endpoint, opts...)
 	wsHandler := func(message []byte) {
 		event := new(WsBLVTKlineEvent)
 		err := json.Unmarshal(message, event)
@@ -1024,9 +846,9 @@ type WsComposition struct {
 type WsCompositeIndexHandler func(event *WsCompositeIndexEvent)
 
 // WsCompositiveIndexServe serve composite index information for index symbols
-func WsCompositiveIndexServe(symbol string, handler WsCompositeIndexHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
-	endpoint := fmt.Sprintf("%s/%s@compositeIndex", getWsEndpoint(), strings.ToLower(symbol))
-	cfg := newWsConfig(endpoint)
+func WsCompositiveIndexServe(symbol string, handler WsCompositeIndexHandler, errHandler ErrHandler, opts ...WsOptions) (doneC, stopC chan struct{}, err error) {
+	endpoint := fmt.Sprintf("%s/%s@compositeIndex", getWsEndpoint(opts...), strings.ToLower(symbol))
+	cfg := newWsConfig(endpoint, opts...)
 	wsHandler := func(message []byte) {
 		event := new(WsCompositeIndexEvent)
 		err := json.Unmarshal(message, event)
@@ -1234,9 +1056,9 @@ type WsConditionalOrderTriggerReject struct {
 type WsUserDataHandler func(event *WsUserDataEvent)
 
 // WsUserDataServe serve user data handler with listen key
-func WsUserDataServe(listenKey string, handler WsUserDataHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
-	endpoint := fmt.Sprintf("%s/%s", getWsEndpoint(), listenKey)
-	cfg := newWsConfig(endpoint)
+func WsUserDataServe(listenKey string, handler WsUserDataHandler, errHandler ErrHandler, opts ...WsOptions) (doneC, stopC chan struct{}, err error) {
+	endpoint := fmt.Sprintf("%s/%s", getWsEndpoint(opts...), listenKey)
+	cfg := newWsConfig(endpoint, opts...)
 	wsHandler := func(message []byte) {
 		event := new(WsUserDataEvent)
 		err := json.Unmarshal(message, event)
@@ -1260,8 +1082,20 @@ func WsApiInitReadWriteConn() (*websocket.Conn, error) {
 	return conn, err
 }
 
-// getWsApiEndpoint return the base endpoint of the API WS according the UseTestnet flag
-func getWsApiEndpoint() string {
+// newWsApiConnection create a websocket API connection dialed with the options of opts
+func newWsApiConnection(opts ...WsOptions) (commonws.Connection, error) {
+	o := commonws.MergeOptions(opts...)
+	keepalive, timeout := o.APIKeepAlive(WebsocketKeepalive, WebsocketTimeoutReadWriteConnection)
+	return commonws.NewConnection(func() (*websocket.Conn, error) {
+		return WsGetReadWriteConnection(newWsConfig(getWsApiEndpoint(opts...), o))
+	}, keepalive, timeout)
+}
+
+// getWsApiEndpoint return the base endpoint of the API WS of opts, according the UseTestnet flag when they set none
+func getWsApiEndpoint(opts ...WsOptions) string {
+	if o := commonws.MergeOptions(opts...); o.APIURL != "" {
+		return o.APIURL
+	}
 	if UseTestnet {
 		return BaseWsApiTestnetURL
 	}
@@ -0,0 +1,196 @@
+package futures
+
+import (
+	"time"
+
+	"github.com/adshao/go-binance/v2/common"
+	"github.com/adshao/go-binance/v2/common/websocket"
+)
+
+// WsApiClient shares one authenticated websocket API connection between the websocket
+// API services, the responses of every service are read from the same channels
+type WsApiClient struct {
+	c          websocket.Client
+	ApiKey     string
+	SecretKey  string
+	KeyType    string
+	Signer     common.Signer // signs requests instead of SecretKey when set
+	signers    *common.SignerCache
+	TimeOffset int64
+	RecvWindow int64
+}
+
+// NewWsApiClient init WsApiClient with a new connection, dialed with the options of opts
+func NewWsApiClient(apiKey, secretKey string, opts ...WsOptions) (*WsApiClient, error) {
+	conn, err := newWsApiConnection(opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := websocket.NewClient(conn, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewWsApiClientWithClient(client, apiKey, secretKey), nil
+}
+
+// NewWsApiClientWithClient init WsApiClient on an existing websocket client
+func NewWsApiClientWithClient(client websocket.Client, apiKey, secretKey string) *WsApiClient {
+	return &WsApiClient{
+		c:          client,
+		ApiKey:     apiKey,
+		SecretKey:  secretKey,
+		KeyType:    common.KeyTypeHmac,
+		signers:    new(common.SignerCache),
+		RecvWindow: 5000,
+	}
+}
+
+// NewWsApiClient init WsApiClient with the keys, the signer and in the environment of the client, opts
+// take precedence over the environment
+func (c *Client) NewWsApiClient(opts ...WsOptions) (*WsApiClient, error) {
+	client, err := NewWsApiClient(c.APIKey, c.SecretKey, append([]WsOptions{c.Environment.WsOptions()}, opts...)...)
+	if err != nil {
+		return nil, err
+	}
+	client.KeyType = c.KeyType
+	client.Signer = c.Signer
+	client.signers = &c.signers
+	client.TimeOffset = c.TimeOffset
+	return client, nil
+}
+
+// Close closes the connection
+func (c *WsApiClient) Close() error {
+	return c.c.Close()
+}
+
+// ReceiveAllDataBeforeStop waits until all responses will be received from websocket until timeout expired
+func (c *WsApiClient) ReceiveAllDataBeforeStop(timeout time.Duration) {
+	c.c.Wait(timeout)
+}
+
+// GetReadChannel returns channel with API response data (including API errors)
+func (c *WsApiClient) GetReadChannel() <-chan []byte {
+	return c.c.GetReadChannel()
+}
+
+// GetReadErrorChannel returns channel with errors which are occurred while reading websocket connection
+func (c *WsApiClient) GetReadErrorChannel() <-chan error {
+	return c.c.GetReadErrorChannel()
+}
+
+// GetReconnectCount returns count of reconnect attempts by client
+func (c *WsApiClient) GetReconnectCount() int64 {
+	return c.c.GetReconnectCount()
+}
+
+// NewDepthWsService init DepthWsService on the connection of the client
+func (c *WsApiClient) NewDepthWsService() *DepthWsService {
+	return &DepthWsService{c: c.c}
+}
+
+// NewTickerBookWsService init TickerBookWsService on the connection of the client
+func (c *WsApiClient) NewTickerBookWsService() *TickerBookWsService {
+	return &TickerBookWsService{c: c.c}
+}
+
+// NewTickerPriceWsService init TickerPriceWsService on the connection of the client
+func (c *WsApiClient) NewTickerPriceWsService() *TickerPriceWsService {
+	return &TickerPriceWsService{c: c.c}
+}
+
+// NewOrderPlaceWsService init OrderPlaceWsService on the connection of the client
+func (c *WsApiClient) NewOrderPlaceWsService() *OrderPlaceWsService {
+	return &OrderPlaceWsService{
+		c:          c.c,
+		ApiKey:     c.ApiKey,
+		SecretKey:  c.SecretKey,
+		KeyType:    c.KeyType,
+		Signer:     c.Signer,
+		signers:    c.signers,
+		TimeOffset: c.TimeOffset,
+	}
+}
+
+// NewOrderCancelWsService init OrderCancelWsService on the connection of the client
+func (c *WsApiClient) NewOrderCancelWsService() *OrderCancelWsService {
+	return &OrderCancelWsService{
+		c:          c.c,
+		ApiKey:     c.ApiKey,
+		SecretKey:  c.SecretKey,
+		KeyType:    c.KeyType,
+		Signer:     c.Signer,
+		signers:    c.signers,
+		TimeOffset: c.TimeOffset,
+	}
+}
+
+// NewOrderStatusWsService init OrderStatusWsService on the connection of the client
+func (c *WsApiClient) NewOrderStatusWsService() *OrderStatusWsService {
+	return &OrderStatusWsService{
+		c:          c.c,
+		ApiKey:     c.ApiKey,
+		SecretKey:  c.SecretKey,
+		KeyType:    c.KeyType,
+		Signer:     c.Signer,
+		signers:    c.signers,
+		TimeOffset: c.TimeOffset,
+	}
+}
+
+// NewOrderModifyWsService init OrderModifyWsService on the connection of the client
+func (c *WsApiClient) NewOrderModifyWsService() *OrderModifyWsService {
+	return &OrderModifyWsService{
+		c:          c.c,
+		ApiKey:     c.ApiKey,
+		SecretKey:  c.SecretKey,
+		KeyType:    c.KeyType,
+		Signer:     c.Signer,
+		signers:    c.signers,
+		TimeOffset: c.TimeOffset,
+	}
+}
+
+// NewAccountPositionWsService init AccountPositionWsService on the connection of the client
+func (c *WsApiClient) NewAccountPositionWsService() *AccountPositionWsService {
+	return &AccountPositionWsService{
+		c:          c.c,
+		ApiKey:     c.ApiKey,
+		SecretKey:  c.SecretKey,
+		KeyType:    c.KeyType,
+		Signer:     c.Signer,
+		signers:    c.signers,
+		TimeOffset: c.TimeOffset,
+	}
+}
+
+// NewWsAccountService init WsAccountService on the connection of the client
+func (c *WsApiClient) NewWsAccountService() *WsAccountService {
+	return &WsAccountService{
+		c:          c.c,
+		ApiKey:     c.ApiKey,
+		SecretKey:  c.SecretKey,
+		KeyType:    c.KeyType,
+		Signer:     c.Signer,
+		signers:    c.signers,
+		TimeOffset: c.TimeOffset,
+		RecvWindow: c.RecvWindow,
+	}
+}
+
+// NewUserDataStreamStartWsService init UserDataStreamStartWsService on the connection of the client
+func (c *WsApiClient) NewUserDataStreamStartWsService() *UserDataStreamStartWsService {
+	return &UserDataStreamStartWsService{c: c.c, ApiKey: c.ApiKey}
+}
+
+// NewUserDataStreamPingWsService init UserDataStreamPingWsService on the connection of the client
+func (c *WsApiClient) NewUserDataStreamPingWsService() *UserDataStreamPingWsService {
+	return &UserDataStreamPingWsService{c: c.c, ApiKey: c.ApiKey}
+}
+
+// NewUserDataStreamStopWsService init UserDataStreamStopWsService on the connection of the client
+func (c *WsApiClient) NewUserDataStreamStopWsService() *UserDataStreamStopWsService {
+	return &UserDataStreamStopWsService{c: c.c, ApiKey: c.ApiKey}
+}
@@ -0,0 +1,59 @@
+package futures
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/adshao/go-binance/v2/common/websocket"
+	"github.com/adshao/go-binance/v2/common/websocket/mock"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/suite"
+)
+
+type wsApiClientTestSuite struct {
+	suite.Suite
+
+	ctrl   *gomock.Controller
+	client *mock.MockClient
+	c      *WsApiClient
+}
+
+func (s *wsApiClientTestSuite) SetupTest() {
+	s.ctrl = gomock.NewController(s.T())
+	s.client = mock.NewMockClient(s.ctrl)
+	s.c = NewWsApiClientWithClient(s.client, "dummyApiKey", "dummySecretKey")
+}
+
+func (s *wsApiClientTestSuite) TearDownTest() {
+	s.ctrl.Finish()
+}
+
+func TestWsApiClient(t *testing.T) {
+	suite.Run(t, new(wsApiClientTestSuite))
+}
+
+func (s *wsApiClientTestSuite) TestSharedConnection() {
+	var requests []websocket.WsApiRequest
+	s.client.EXPECT().Write(gomock.Any(), gomock.Any()).DoAndReturn(func(id string, raw []byte) error {
+		req := websocket.WsApiRequest{}
+		s.Require().NoError(json.Unmarshal(raw, &req))
+		requests = append(requests, req)
+		return nil
+	}).Times(4)
+
+	s.Require().NoError(s.c.NewTickerBookWsService().Do("1", NewTickerBookWsRequest().Symbol("BTCUSDT")))
+	s.Require().NoError(s.c.NewOrderModifyWsService().Do("2", NewOrderModifyWsRequest().
+		Symbol("BTCUSDT").Side(SideTypeSell).Quantity("1").OrderID(1).Price("60000")))
+	s.Require().NoError(s.c.NewWsAccountService().GetAccountInfo("3"))
+	s.Require().NoError(s.c.NewUserDataStreamPingWsService().Do("4", NewUserDataStreamPingWsRequest()))
+
+	s.Require().Len(requests, 4)
+	s.Equal(websocket.TickerBookFuturesWsApiMethod, requests[0].Method)
+	s.NotContains(requests[0].Params, "apiKey")
+	s.Equal(websocket.OrderModifyFuturesWsApiMethod, requests[1].Method)
+	s.Equal("dummyApiKey", requests[1].Params["apiKey"])
+	s.Contains(requests[1].Params, "signature")
+	s.Equal(float64(5000), requests[2].Params["recvWindow"])
+	s.Equal(websocket.UserDataStreamPingFuturesWsApiMethod, requests[3].Method)
+	s.NotContains(requests[3].Params, "signature")
+}
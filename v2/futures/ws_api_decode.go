@@ -0,0 +1,40 @@
+package futures
+
+import (
+	"bytes"
+	"encoding/json"
+
+	"github.com/adshao/go-binance/v2/common"
+)
+
+// wsApiRawResponse define websocket API response with the result not decoded yet
+type wsApiRawResponse struct {
+	Id     string          `json:"id"`
+	Status int             `json:"status"`
+	Result json.RawMessage `json:"result"`
+
+	// error response
+	Error *common.APIError `json:"error,omitempty"`
+}
+
+// isEmpty return whether the response has no result, like error responses
+func (r *wsApiRawResponse) isEmpty() bool {
+	return len(r.Result) == 0 || string(r.Result) == "null"
+}
+
+// unmarshalOneOrMany decode a result which is an object when one symbol is requested
+// and an array otherwise
+func unmarshalOneOrMany[T any](data []byte) ([]*T, error) {
+	if trimmed := bytes.TrimSpace(data); len(trimmed) > 0 && trimmed[0] == '{' {
+		v := new(T)
+		if err := json.Unmarshal(trimmed, v); err != nil {
+			return nil, err
+		}
+		return []*T{v}, nil
+	}
+	res := make([]*T, 0)
+	if err := json.Unmarshal(data, &res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
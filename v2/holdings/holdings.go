@@ -0,0 +1,328 @@
+// Package holdings aggregates the balances, positions and earn holdings of the spot,
+// margin, USD-M futures, COIN-M futures, options, simple earn and portfolio margin
+// accounts into one normalized view, valued in a quote asset.
+//
+// An Aggregator fetches every configured account concurrently, with bounded concurrency,
+// and values the holdings with the spot prices of ListPricesService:
+//
+//	a := holdings.NewAggregator(spotClient, "USDT")
+//	a.Futures = futuresClient
+//	view, err := a.Fetch(ctx)
+//
+// A failing account does not fail the others, Fetch returns the partial view together
+// with an error joining one *SourceError per failed account.
+package holdings
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/shopspring/decimal"
+
+	"github.com/adshao/go-binance/v2"
+	"github.com/adshao/go-binance/v2/delivery"
+	"github.com/adshao/go-binance/v2/futures"
+	"github.com/adshao/go-binance/v2/internal/multierr"
+	"github.com/adshao/go-binance/v2/options"
+	"github.com/adshao/go-binance/v2/portfolio"
+)
+
+// Source define the account a holding is fetched from
+type Source string
+
+// Global enums
+const (
+	SourceSpot         Source = "SPOT"
+	SourceMargin       Source = "MARGIN"
+	SourceUSDM         Source = "USDM"
+	SourceCOINM        Source = "COINM"
+	SourceOptions      Source = "OPTIONS"
+	SourceEarnFlexible Source = "EARN_FLEXIBLE"
+	SourceEarnLocked   Source = "EARN_LOCKED"
+	SourcePortfolio    Source = "PORTFOLIO"
+
+	defaultConcurrency = 4
+)
+
+// SourceError define the failure to fetch an account
+type SourceError struct {
+	Source Source
+	Err    error
+}
+
+// Error return source and error
+func (e *SourceError) Error() string {
+	return fmt.Sprintf("holdings: fetch %s: %v", e.Source, e.Err)
+}
+
+// Unwrap return the error of the fetch
+func (e *SourceError) Unwrap() error {
+	return e.Err
+}
+
+// Balance define the balance of an asset in an account. Total is the net amount held:
+// free and locked minus borrowed for spot and margin accounts, the wallet balance for
+// futures, options and portfolio margin accounts. Unrealized PnL is kept apart.
+type Balance struct {
+	Source        Source
+	Asset         string
+	Free          decimal.Decimal
+	Locked        decimal.Decimal
+	Borrowed      decimal.Decimal // including interest
+	UnrealizedPnL decimal.Decimal
+	Total         decimal.Decimal
+}
+
+// Equity return the total including the unrealized PnL
+func (b Balance) Equity() decimal.Decimal {
+	return b.Total.Add(b.UnrealizedPnL)
+}
+
+// Position define an open derivatives position, UnrealizedPnL is in the margin asset
+type Position struct {
+	Source        Source
+	Symbol        string
+	Side          string
+	Amount        decimal.Decimal // negative when short in one-way mode
+	EntryPrice    decimal.Decimal
+	MarkPrice     decimal.Decimal // zero when not reported by the account
+	UnrealizedPnL decimal.Decimal
+}
+
+// EarnHolding define an asset subscribed to a simple earn product
+type EarnHolding struct {
+	Source    Source
+	Asset     string
+	ProductID string
+	Amount    decimal.Decimal
+}
+
+// Asset define the holdings of an asset over all accounts
+type Asset struct {
+	Asset  string
+	Amount decimal.Decimal // sum of the balance equities and earn amounts
+	Price  decimal.Decimal // in the quote asset
+	Value  decimal.Decimal // amount valued in the quote asset
+	Priced bool            // false when no price path to the quote asset was found
+}
+
+// View define the normalized holdings of all the accounts
+type View struct {
+	Quote      string
+	Time       time.Time
+	Balances   []Balance
+	Positions  []Position
+	Earn       []EarnHolding
+	Assets     []Asset // ordered by asset
+	TotalValue decimal.Decimal
+	Unpriced   []string // assets without price, not included in TotalValue
+}
+
+// Asset return the holdings of asset
+func (v *View) Asset(asset string) (Asset, bool) {
+	i := sort.Search(len(v.Assets), func(i int) bool { return v.Assets[i].Asset >= asset })
+	if i < len(v.Assets) && v.Assets[i].Asset == asset {
+		return v.Assets[i], true
+	}
+	return Asset{}, false
+}
+
+// Aggregator fetch the holdings of the configured clients. Spot is required, it fetches
+// the prices and the spot, margin and simple earn accounts unless disabled by Sources.
+// With a Portfolio client the margin account is not fetched, the portfolio margin
+// balances already hold it.
+type Aggregator struct {
+	Spot      *binance.Client
+	Futures   *futures.Client
+	Delivery  *delivery.Client
+	Options   *options.Client
+	Portfolio *portfolio.Client
+
+	// Quote is the asset holdings are valued in, e.g. USDT
+	Quote string
+	// Sources restricts the fetched accounts, all the ones with a client when empty
+	Sources []Source
+	// Concurrency is the maximum number of concurrent requests, 4 when not set
+	Concurrency int
+	// Bridges are the assets used to price an asset without a direct quote symbol
+	Bridges []string
+}
+
+// NewAggregator init an aggregator of the spot, margin and simple earn accounts of spot,
+// valued in quote
+func NewAggregator(spot *binance.Client, quote string) *Aggregator {
+	return &Aggregator{
+		Spot:    spot,
+		Quote:   quote,
+		Bridges: []string{"USDT", "BTC", "BNB", "ETH", "FDUSD", "USDC"},
+	}
+}
+
+// result collect the holdings fetched concurrently
+type result struct {
+	mu        sync.Mutex
+	balances  []Balance
+	positions []Position
+	earn      []EarnHolding
+	errs      []error
+}
+
+func (r *result) add(balances []Balance, positions []Position, earn []EarnHolding) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.balances = append(r.balances, balances...)
+	r.positions = append(r.positions, positions...)
+	r.earn = append(r.earn, earn...)
+}
+
+func (r *result) fail(source Source, err error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.errs = append(r.errs, &SourceError{Source: source, Err: err})
+}
+
+// fetcher fetch the holdings of a source
+type fetcher func(ctx context.Context) ([]Balance, []Position, []EarnHolding, error)
+
+// fetchers return the fetchers of the enabled sources having a client
+func (a *Aggregator) fetchers() map[Source]fetcher {
+	all := map[Source]fetcher{}
+	if a.Spot != nil {
+		all[SourceSpot] = a.fetchSpot
+		all[SourceMargin] = a.fetchMargin
+		all[SourceEarnFlexible] = a.fetchEarnFlexible
+		all[SourceEarnLocked] = a.fetchEarnLocked
+	}
+	if a.Futures != nil {
+		all[SourceUSDM] = a.fetchUSDM
+	}
+	if a.Delivery != nil {
+		all[SourceCOINM] = a.fetchCOINM
+	}
+	if a.Options != nil {
+		all[SourceOptions] = a.fetchOptions
+	}
+	if a.Portfolio != nil {
+		// The portfolio margin balances include the cross margin ones
+		all[SourcePortfolio] = a.fetchPortfolio
+		delete(all, SourceMargin)
+	}
+	if len(a.Sources) == 0 {
+		return all
+	}
+	enabled := make(map[Source]fetcher, len(a.Sources))
+	for _, source := range a.Sources {
+		if f, ok := all[source]; ok {
+			enabled[source] = f
+		}
+	}
+	return enabled
+}
+
+// Fetch fetch and value the holdings of all the accounts. On partial failure, the view of
+// the accounts fetched is returned together with the error.
+func (a *Aggregator) Fetch(ctx context.Context) (*View, error) {
+	if a.Spot == nil {
+		return nil, errors.New("holdings: no spot client")
+	}
+	concurrency := a.Concurrency
+	if concurrency <= 0 {
+		concurrency = defaultConcurrency
+	}
+	sem := make(chan struct{}, concurrency)
+	res := new(result)
+	var prices map[string]decimal.Decimal
+	var pricesErr error
+	var wg sync.WaitGroup
+	run := func(f func()) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			select {
+			case sem <- struct{}{}:
+			case <-ctx.Done():
+				return
+			}
+			defer func() { <-sem }()
+			f()
+		}()
+	}
+	run(func() { prices, pricesErr = a.fetchPrices(ctx) })
+	for source, f := range a.fetchers() {
+		source, f := source, f
+		run(func() {
+			balances, positions, earn, err := f(ctx)
+			if err != nil {
+				res.fail(source, err)
+				return
+			}
+			res.add(balances, positions, earn)
+		})
+	}
+	wg.Wait()
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	if pricesErr != nil {
+		return nil, fmt.Errorf("holdings: fetch prices: %w", pricesErr)
+	}
+	view := a.newView(res, prices)
+	return view, multierr.Join(res.errs...)
+}
+
+func (a *Aggregator) newView(res *result, prices map[string]decimal.Decimal) *View {
+	sortBalances(res.balances)
+	sort.SliceStable(res.positions, func(i, j int) bool {
+		if res.positions[i].Source != res.positions[j].Source {
+			return res.positions[i].Source < res.positions[j].Source
+		}
+		return res.positions[i].Symbol < res.positions[j].Symbol
+	})
+	sort.SliceStable(res.earn, func(i, j int) bool {
+		if res.earn[i].Source != res.earn[j].Source {
+			return res.earn[i].Source < res.earn[j].Source
+		}
+		return res.earn[i].Asset < res.earn[j].Asset
+	})
+	view := &View{
+		Quote:     a.Quote,
+		Time:      time.Now(),
+		Balances:  res.balances,
+		Positions: res.positions,
+		Earn:      res.earn,
+	}
+	amounts := make(map[string]decimal.Decimal)
+	for _, b := range res.balances {
+		amounts[b.Asset] = amounts[b.Asset].Add(b.Equity())
+	}
+	for _, e := range res.earn {
+		amounts[e.Asset] = amounts[e.Asset].Add(e.Amount)
+	}
+	for asset, amount := range amounts {
+		h := Asset{Asset: asset, Amount: amount}
+		h.Price, h.Priced = a.price(prices, asset)
+		if h.Priced {
+			h.Value = amount.Mul(h.Price)
+			view.TotalValue = view.TotalValue.Add(h.Value)
+		} else if !amount.IsZero() {
+			view.Unpriced = append(view.Unpriced, asset)
+		}
+		view.Assets = append(view.Assets, h)
+	}
+	sort.Slice(view.Assets, func(i, j int) bool { return view.Assets[i].Asset < view.Assets[j].Asset })
+	sort.Strings(view.Unpriced)
+	return view
+}
+
+func sortBalances(balances []Balance) {
+	sort.SliceStable(balances, func(i, j int) bool {
+		if balances[i].Source != balances[j].Source {
+			return balances[i].Source < balances[j].Source
+		}
+		return balances[i].Asset < balances[j].Asset
+	})
+}
@@ -0,0 +1,300 @@
+package holdings
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/adshao/go-binance/v2"
+	"github.com/adshao/go-binance/v2/common"
+	"github.com/adshao/go-binance/v2/delivery"
+	"github.com/adshao/go-binance/v2/futures"
+	"github.com/adshao/go-binance/v2/options"
+	"github.com/adshao/go-binance/v2/portfolio"
+)
+
+type holdingsTestSuite struct {
+	suite.Suite
+	server    *httptest.Server
+	mu        sync.Mutex
+	paths     []string
+	responses map[string]string
+	pages     map[string]string // flexible earn responses by page
+	a         *Aggregator
+}
+
+func TestHoldings(t *testing.T) {
+	suite.Run(t, new(holdingsTestSuite))
+}
+
+func (s *holdingsTestSuite) SetupTest() {
+	s.paths = nil
+	s.pages = nil
+	s.responses = map[string]string{
+		"/api/v3/ticker/price": `[
+			{"symbol": "BTCUSDT", "price": "60000"},
+			{"symbol": "ETHBTC", "price": "0.05"},
+			{"symbol": "USDTBRL", "price": "5"},
+			{"symbol": "BNBUSDT", "price": "500"}
+		]`,
+		"/api/v3/account": `{"balances": [
+			{"asset": "BTC", "free": "1", "locked": "0.5"},
+			{"asset": "USDT", "free": "100", "locked": "0"},
+			{"asset": "XYZ", "free": "10", "locked": "0"},
+			{"asset": "LTC", "free": "0", "locked": "0"}
+		]}`,
+		"/sapi/v1/margin/account": `{"userAssets": [
+			{"asset": "USDT", "borrowed": "50", "free": "200", "interest": "1", "locked": "0", "netAsset": "149"},
+			{"asset": "BNB", "borrowed": "0", "free": "0", "interest": "0", "locked": "0", "netAsset": "0"}
+		]}`,
+		"/sapi/v1/simple-earn/flexible/position": `{"rows": [
+			{"asset": "USDT", "totalAmount": "1000", "productId": "USDT001"}
+		], "total": 1}`,
+		"/sapi/v1/simple-earn/locked/position": `{"rows": [
+			{"asset": "BNB", "amount": "2", "projectId": "BNB*90"}
+		], "total": 1}`,
+		"/fapi/v2/account": `{"assets": [
+			{"asset": "USDT", "walletBalance": "500", "crossUnPnl": "-20", "unrealizedProfit": "-30", "availableBalance": "400"},
+			{"asset": "BUSD", "walletBalance": "0", "crossUnPnl": "0", "unrealizedProfit": "0", "availableBalance": "0"}
+		]}`,
+		"/fapi/v2/positionRisk": `[
+			{"symbol": "BTCUSDT", "positionAmt": "-0.1", "entryPrice": "59800", "markPrice": "60000", "unRealizedProfit": "-20", "positionSide": "BOTH"},
+			{"symbol": "ETHUSDT", "positionAmt": "0", "entryPrice": "0", "markPrice": "3000", "unRealizedProfit": "0", "positionSide": "BOTH"},
+			{"symbol": "BNBUSDT", "positionAmt": "2", "entryPrice": "505", "markPrice": "500", "unRealizedProfit": "-10", "positionSide": "BOTH", "marginType": "isolated"}
+		]`,
+		"/dapi/v1/account": `{
+			"assets": [{"asset": "ETH", "walletBalance": "2", "unrealizedProfit": "0.1", "availableBalance": "1"}],
+			"positions": [{"symbol": "ETHUSD_PERP", "positionAmt": "10", "entryPrice": "2900", "unrealizedProfit": "0.1", "positionSide": "LONG"}]
+		}`,
+		"/eapi/v1/account": `{"asset": [
+			{"asset": "USDT", "marginBalance": "300", "equity": "310", "available": "250", "locked": "50", "unrealizedPNL": "10"}
+		]}`,
+		"/papi/v1/balance": `[
+			{"asset": "BTC", "totalWalletBalance": "0.2", "crossMarginBorrowed": "0.05", "crossMarginInterest": "0", "crossMarginFree": "0.1", "crossMarginLocked": "0", "umUnrealizedPNL": "0", "cmUnrealizedPNL": "0.01"}
+		]`,
+	}
+	s.server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		s.mu.Lock()
+		s.paths = append(s.paths, r.URL.Path)
+		response, ok := s.responses[r.URL.Path]
+		if page, found := s.pages[r.URL.Query().Get("current")]; found {
+			response = page
+		}
+		s.mu.Unlock()
+		w.Header().Set("Content-Type", "application/json")
+		if !ok {
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(`{"code": -1000, "msg": "unknown"}`))
+			return
+		}
+		_, _ = w.Write([]byte(response))
+	}))
+
+	spot := binance.NewClient("dummy", "dummy")
+	spot.BaseURL = s.server.URL
+	s.a = NewAggregator(spot, "USDT")
+	s.a.Futures = futures.NewClient("dummy", "dummy")
+	s.a.Futures.BaseURL = s.server.URL
+	s.a.Delivery = delivery.NewClient("dummy", "dummy")
+	s.a.Delivery.BaseURL = s.server.URL
+	s.a.Options = options.NewClient("dummy", "dummy")
+	s.a.Options.BaseURL = s.server.URL
+	s.a.Portfolio = portfolio.NewClient("dummy", "dummy")
+	s.a.Portfolio.BaseURL = s.server.URL
+}
+
+func (s *holdingsTestSuite) TearDownTest() {
+	s.server.Close()
+}
+
+func (s *holdingsTestSuite) dec(v string) decimal.Decimal {
+	return decimal.RequireFromString(v)
+}
+
+func (s *holdingsTestSuite) assertDecimal(expected string, actual decimal.Decimal) {
+	s.Require().True(s.dec(expected).Equal(actual), "expected %s, got %s", expected, actual)
+}
+
+func (s *holdingsTestSuite) TestFetch() {
+	view, err := s.a.Fetch(context.Background())
+	s.Require().NoError(err)
+	s.Equal("USDT", view.Quote)
+	// the margin account is held by the portfolio margin one
+	s.Len(s.paths, 9)
+	s.NotContains(s.paths, "/sapi/v1/margin/account")
+
+	s.Require().Len(view.Balances, 7)
+	b := view.Balances[0]
+	s.Equal(SourceCOINM, b.Source)
+	s.Equal("ETH", b.Asset)
+	s.assertDecimal("2.1", b.Equity())
+	b = view.Balances[1]
+	s.Equal(SourceOptions, b.Source)
+	s.assertDecimal("300", b.Total)
+	s.assertDecimal("10", b.UnrealizedPnL)
+	b = view.Balances[2]
+	s.Equal(SourcePortfolio, b.Source)
+	s.assertDecimal("0.15", b.Total)
+	s.assertDecimal("0.01", b.UnrealizedPnL)
+	s.Equal(SourceSpot, view.Balances[3].Source)
+	s.Equal("BTC", view.Balances[3].Asset)
+	s.assertDecimal("1.5", view.Balances[3].Total)
+	b = view.Balances[6]
+	s.Equal(SourceUSDM, b.Source)
+	// the unrealized PnL of the isolated positions is included
+	s.assertDecimal("-30", b.UnrealizedPnL)
+	s.assertDecimal("470", b.Equity())
+
+	s.Require().Len(view.Positions, 3)
+	s.Equal(Position{
+		Source:        SourceCOINM,
+		Symbol:        "ETHUSD_PERP",
+		Side:          "LONG",
+		Amount:        s.dec("10"),
+		EntryPrice:    s.dec("2900"),
+		UnrealizedPnL: s.dec("0.1"),
+	}, view.Positions[0])
+	s.Equal("BNBUSDT", view.Positions[1].Symbol)
+	s.assertDecimal("-10", view.Positions[1].UnrealizedPnL)
+	s.Equal("BTCUSDT", view.Positions[2].Symbol)
+	s.assertDecimal("-0.1", view.Positions[2].Amount)
+	s.assertDecimal("60000", view.Positions[2].MarkPrice)
+
+	s.Require().Len(view.Earn, 2)
+	s.Equal(EarnHolding{Source: SourceEarnFlexible, Asset: "USDT", ProductID: "USDT001", Amount: s.dec("1000")}, view.Earn[0])
+	s.Equal(EarnHolding{Source: SourceEarnLocked, Asset: "BNB", ProductID: "BNB*90", Amount: s.dec("2")}, view.Earn[1])
+
+	btc, ok := view.Asset("BTC")
+	s.Require().True(ok)
+	s.assertDecimal("1.66", btc.Amount)
+	s.assertDecimal("60000", btc.Price)
+	s.assertDecimal("99600", btc.Value)
+	eth, ok := view.Asset("ETH")
+	s.Require().True(ok)
+	s.True(eth.Priced)
+	s.assertDecimal("3000", eth.Price)
+	s.assertDecimal("6300", eth.Value)
+	usdt, ok := view.Asset("USDT")
+	s.Require().True(ok)
+	s.assertDecimal("1880", usdt.Amount)
+	s.assertDecimal("1", usdt.Price)
+	bnb, _ := view.Asset("BNB")
+	s.assertDecimal("1000", bnb.Value)
+	xyz, ok := view.Asset("XYZ")
+	s.Require().True(ok)
+	s.False(xyz.Priced)
+	s.Equal([]string{"XYZ"}, view.Unpriced)
+	_, ok = view.Asset("LTC")
+	s.False(ok)
+
+	s.assertDecimal("108780", view.TotalValue)
+}
+
+func (s *holdingsTestSuite) TestFetchMargin() {
+	s.a.Portfolio = nil
+	s.a.Sources = []Source{SourceMargin}
+	view, err := s.a.Fetch(context.Background())
+	s.Require().NoError(err)
+	s.ElementsMatch([]string{"/api/v3/ticker/price", "/sapi/v1/margin/account"}, s.paths)
+	s.Require().Len(view.Balances, 1)
+	b := view.Balances[0]
+	s.Equal(SourceMargin, b.Source)
+	s.assertDecimal("51", b.Borrowed)
+	s.assertDecimal("149", b.Total)
+}
+
+func (s *holdingsTestSuite) TestFetchPortfolioMargin() {
+	s.a.Sources = []Source{SourceMargin, SourcePortfolio}
+	view, err := s.a.Fetch(context.Background())
+	s.Require().NoError(err)
+	s.ElementsMatch([]string{"/api/v3/ticker/price", "/papi/v1/balance"}, s.paths)
+	s.Require().Len(view.Balances, 1)
+	s.Equal(SourcePortfolio, view.Balances[0].Source)
+}
+
+func (s *holdingsTestSuite) TestFetchSources() {
+	s.a.Sources = []Source{SourceSpot, SourceUSDM}
+	s.a.Concurrency = 1
+	view, err := s.a.Fetch(context.Background())
+	s.Require().NoError(err)
+	s.ElementsMatch([]string{"/api/v3/ticker/price", "/api/v3/account", "/fapi/v2/account", "/fapi/v2/positionRisk"}, s.paths)
+	s.Len(view.Balances, 4)
+	s.Len(view.Positions, 2)
+	s.Empty(view.Earn)
+}
+
+func (s *holdingsTestSuite) TestFetchPartialFailure() {
+	delete(s.responses, "/eapi/v1/account")
+	delete(s.responses, "/papi/v1/balance")
+	view, err := s.a.Fetch(context.Background())
+	s.Require().Error(err)
+	s.Require().NotNil(view)
+	for _, b := range view.Balances {
+		s.NotEqual(SourceOptions, b.Source)
+		s.NotEqual(SourcePortfolio, b.Source)
+	}
+	var sourceErr *SourceError
+	s.Require().True(errors.As(err, &sourceErr))
+	s.Contains([]Source{SourceOptions, SourcePortfolio}, sourceErr.Source)
+	var apiErr *common.APIError
+	s.True(errors.As(err, &apiErr))
+	s.Contains(err.Error(), "holdings: fetch OPTIONS")
+	s.Contains(err.Error(), "holdings: fetch PORTFOLIO")
+}
+
+func (s *holdingsTestSuite) TestFetchPricesFailure() {
+	delete(s.responses, "/api/v3/ticker/price")
+	view, err := s.a.Fetch(context.Background())
+	s.Nil(view)
+	s.ErrorContains(err, "holdings: fetch prices")
+}
+
+func (s *holdingsTestSuite) TestFetchEarnPages() {
+	rows := strings.TrimSuffix(strings.Repeat(`{"asset": "USDT", "totalAmount": "1", "productId": "USDT001"},`, earnPageSize), ",")
+	s.pages = map[string]string{
+		"1": `{"rows": [` + rows + `], "total": 102}`,
+		"2": `{"rows": [` + rows[:strings.Index(rows, "},")+1] + `, {"asset": "BTC", "totalAmount": "1", "productId": "BTC001"}], "total": 102}`,
+	}
+	s.a.Sources = []Source{SourceEarnFlexible}
+	view, err := s.a.Fetch(context.Background())
+	s.Require().NoError(err)
+	s.Len(view.Earn, 102)
+	s.ElementsMatch([]string{"/api/v3/ticker/price", "/sapi/v1/simple-earn/flexible/position", "/sapi/v1/simple-earn/flexible/position"}, s.paths)
+	usdt, _ := view.Asset("USDT")
+	s.assertDecimal("101", usdt.Value)
+	btc, _ := view.Asset("BTC")
+	s.assertDecimal("60000", btc.Value)
+}
+
+func (s *holdingsTestSuite) TestPrice() {
+	prices := map[string]decimal.Decimal{
+		"BTCUSDT": s.dec("60000"),
+		"USDTBRL": s.dec("5"),
+		"ETHBTC":  s.dec("0.05"),
+	}
+	s.a.Quote = "BRL"
+	p, ok := s.a.price(prices, "USDT")
+	s.True(ok)
+	s.assertDecimal("5", p)
+	p, ok = s.a.price(prices, "BTC")
+	s.True(ok)
+	s.assertDecimal("300000", p)
+	_, ok = s.a.price(prices, "ETH")
+	s.False(ok)
+	s.a.Quote = "BTC"
+	p, ok = s.a.price(prices, "USDT")
+	s.True(ok)
+	s.assertDecimal("0.0000166667", p.Round(10))
+}
+
+func (s *holdingsTestSuite) TestNoSpotClient() {
+	_, err := new(Aggregator).Fetch(context.Background())
+	s.Error(err)
+}
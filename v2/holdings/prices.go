@@ -0,0 +1,59 @@
+package holdings
+
+import (
+	"context"
+
+	"github.com/shopspring/decimal"
+)
+
+func (a *Aggregator) fetchPrices(ctx context.Context) (map[string]decimal.Decimal, error) {
+	res, err := a.Spot.NewListPricesService().Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	prices := make(map[string]decimal.Decimal, len(res))
+	for _, p := range res {
+		price, err := decimal.NewFromString(p.Price)
+		if err != nil || !price.IsPositive() {
+			continue
+		}
+		prices[p.Symbol] = price
+	}
+	return prices, nil
+}
+
+// direct return the price of asset in quote from the asset+quote symbol or the inverse
+// of the quote+asset symbol
+func direct(prices map[string]decimal.Decimal, asset, quote string) (decimal.Decimal, bool) {
+	if asset == quote {
+		return decimal.NewFromInt(1), true
+	}
+	if p, ok := prices[asset+quote]; ok {
+		return p, true
+	}
+	if p, ok := prices[quote+asset]; ok {
+		return decimal.NewFromInt(1).Div(p), true
+	}
+	return decimal.Zero, false
+}
+
+// price return the price of asset in the quote asset, directly or through the first
+// bridge asset priced both ways
+func (a *Aggregator) price(prices map[string]decimal.Decimal, asset string) (decimal.Decimal, bool) {
+	if p, ok := direct(prices, asset, a.Quote); ok {
+		return p, true
+	}
+	for _, bridge := range a.Bridges {
+		if bridge == asset || bridge == a.Quote {
+			continue
+		}
+		toBridge, ok := direct(prices, asset, bridge)
+		if !ok {
+			continue
+		}
+		if toQuote, ok := direct(prices, bridge, a.Quote); ok {
+			return toBridge.Mul(toQuote), true
+		}
+	}
+	return decimal.Zero, false
+}
@@ -0,0 +1,235 @@
+package holdings
+
+import (
+	"context"
+
+	"github.com/adshao/go-binance/v2/internal/decparse"
+)
+
+const earnPageSize = 100
+
+func (a *Aggregator) fetchSpot(ctx context.Context) ([]Balance, []Position, []EarnHolding, error) {
+	account, err := a.Spot.NewGetAccountService().OmitZeroBalances(true).Do(ctx)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	p := new(decparse.Parser)
+	var balances []Balance
+	for _, b := range account.Balances {
+		free, locked := p.Dec("free", b.Free), p.Dec("locked", b.Locked)
+		if free.IsZero() && locked.IsZero() {
+			continue
+		}
+		balances = append(balances, Balance{
+			Source: SourceSpot,
+			Asset:  b.Asset,
+			Free:   free,
+			Locked: locked,
+			Total:  free.Add(locked),
+		})
+	}
+	return balances, nil, nil, p.Err
+}
+
+func (a *Aggregator) fetchMargin(ctx context.Context) ([]Balance, []Position, []EarnHolding, error) {
+	account, err := a.Spot.NewGetMarginAccountService().Do(ctx)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	p := new(decparse.Parser)
+	var balances []Balance
+	for _, b := range account.UserAssets {
+		b := Balance{
+			Source:   SourceMargin,
+			Asset:    b.Asset,
+			Free:     p.Dec("free", b.Free),
+			Locked:   p.Dec("locked", b.Locked),
+			Borrowed: p.Dec("borrowed", b.Borrowed).Add(p.Dec("interest", b.Interest)),
+			Total:    p.Dec("netAsset", b.NetAsset),
+		}
+		if b.Free.IsZero() && b.Locked.IsZero() && b.Borrowed.IsZero() {
+			continue
+		}
+		balances = append(balances, b)
+	}
+	return balances, nil, nil, p.Err
+}
+
+func (a *Aggregator) fetchEarnFlexible(ctx context.Context) ([]Balance, []Position, []EarnHolding, error) {
+	p := new(decparse.Parser)
+	var earn []EarnHolding
+	for current := 1; ; current++ {
+		res, err := a.Spot.NewSimpleEarnService().FlexibleService().GetPosition().
+			Current(current).Size(earnPageSize).Do(ctx)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		for _, row := range res.Rows {
+			earn = append(earn, EarnHolding{
+				Source:    SourceEarnFlexible,
+				Asset:     row.Asset,
+				ProductID: row.ProductId,
+				Amount:    p.Dec("totalAmount", row.TotalAmount),
+			})
+		}
+		if len(res.Rows) < earnPageSize || len(earn) >= res.Total {
+			return nil, nil, earn, p.Err
+		}
+	}
+}
+
+func (a *Aggregator) fetchEarnLocked(ctx context.Context) ([]Balance, []Position, []EarnHolding, error) {
+	p := new(decparse.Parser)
+	var earn []EarnHolding
+	for current := int64(1); ; current++ {
+		res, err := a.Spot.NewSimpleEarnService().LockedService().GetPosition().
+			Current(current).Size(earnPageSize).Do(ctx)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		for _, row := range res.Rows {
+			earn = append(earn, EarnHolding{
+				Source:    SourceEarnLocked,
+				Asset:     row.Asset,
+				ProductID: row.ProjectId,
+				Amount:    p.Dec("amount", row.Amount),
+			})
+		}
+		if len(res.Rows) < earnPageSize || len(earn) >= res.Total {
+			return nil, nil, earn, p.Err
+		}
+	}
+}
+
+func (a *Aggregator) fetchUSDM(ctx context.Context) ([]Balance, []Position, []EarnHolding, error) {
+	// The account assets hold the unrealized PnL of both the cross and isolated positions
+	account, err := a.Futures.NewGetAccountService().Do(ctx)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	risks, err := a.Futures.NewGetPositionRiskService().Do(ctx)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	p := new(decparse.Parser)
+	var balances []Balance
+	for _, b := range account.Assets {
+		b := Balance{
+			Source:        SourceUSDM,
+			Asset:         b.Asset,
+			Free:          p.Dec("availableBalance", b.AvailableBalance),
+			Total:         p.Dec("walletBalance", b.WalletBalance),
+			UnrealizedPnL: p.Dec("unrealizedProfit", b.UnrealizedProfit),
+		}
+		if b.Total.IsZero() && b.UnrealizedPnL.IsZero() {
+			continue
+		}
+		balances = append(balances, b)
+	}
+	var positions []Position
+	for _, r := range risks {
+		amount := p.Dec("positionAmt", r.PositionAmt)
+		if amount.IsZero() {
+			continue
+		}
+		positions = append(positions, Position{
+			Source:        SourceUSDM,
+			Symbol:        r.Symbol,
+			Side:          r.PositionSide,
+			Amount:        amount,
+			EntryPrice:    p.Dec("entryPrice", r.EntryPrice),
+			MarkPrice:     p.Dec("markPrice", r.MarkPrice),
+			UnrealizedPnL: p.Dec("unRealizedProfit", r.UnRealizedProfit),
+		})
+	}
+	return balances, positions, nil, p.Err
+}
+
+func (a *Aggregator) fetchCOINM(ctx context.Context) ([]Balance, []Position, []EarnHolding, error) {
+	account, err := a.Delivery.NewGetAccountService().Do(ctx)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	p := new(decparse.Parser)
+	var balances []Balance
+	for _, b := range account.Assets {
+		b := Balance{
+			Source:        SourceCOINM,
+			Asset:         b.Asset,
+			Free:          p.Dec("availableBalance", b.AvailableBalance),
+			Total:         p.Dec("walletBalance", b.WalletBalance),
+			UnrealizedPnL: p.Dec("unrealizedProfit", b.UnrealizedProfit),
+		}
+		if b.Total.IsZero() && b.UnrealizedPnL.IsZero() {
+			continue
+		}
+		balances = append(balances, b)
+	}
+	var positions []Position
+	for _, r := range account.Positions {
+		amount := p.Dec("positionAmt", r.PositionAmt)
+		if amount.IsZero() {
+			continue
+		}
+		positions = append(positions, Position{
+			Source:        SourceCOINM,
+			Symbol:        r.Symbol,
+			Side:          r.PositionSide,
+			Amount:        amount,
+			EntryPrice:    p.Dec("entryPrice", r.EntryPrice),
+			UnrealizedPnL: p.Dec("unrealizedProfit", r.UnrealizedProfit),
+		})
+	}
+	return balances, positions, nil, p.Err
+}
+
+func (a *Aggregator) fetchOptions(ctx context.Context) ([]Balance, []Position, []EarnHolding, error) {
+	account, err := a.Options.NewAccountService().Do(ctx)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	p := new(decparse.Parser)
+	var balances []Balance
+	for _, b := range account.Asset {
+		pnl := p.Dec("unrealizedPNL", b.UnrealizedPNL)
+		b := Balance{
+			Source:        SourceOptions,
+			Asset:         b.Asset,
+			Free:          p.Dec("available", b.Available),
+			Locked:        p.Dec("locked", b.Locked),
+			Total:         p.Dec("equity", b.Equity).Sub(pnl),
+			UnrealizedPnL: pnl,
+		}
+		if b.Total.IsZero() && b.UnrealizedPnL.IsZero() {
+			continue
+		}
+		balances = append(balances, b)
+	}
+	return balances, nil, nil, p.Err
+}
+
+func (a *Aggregator) fetchPortfolio(ctx context.Context) ([]Balance, []Position, []EarnHolding, error) {
+	res, err := a.Portfolio.NewGetBalanceService().Do(ctx)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	p := new(decparse.Parser)
+	var balances []Balance
+	for _, b := range res {
+		borrowed := p.Dec("crossMarginBorrowed", b.CrossMarginBorrowed).Add(p.Dec("crossMarginInterest", b.CrossMarginInterest))
+		b := Balance{
+			Source:        SourcePortfolio,
+			Asset:         b.Asset,
+			Free:          p.Dec("crossMarginFree", b.CrossMarginFree),
+			Locked:        p.Dec("crossMarginLocked", b.CrossMarginLocked),
+			Borrowed:      borrowed,
+			Total:         p.Dec("totalWalletBalance", b.TotalWalletBalance).Sub(borrowed),
+			UnrealizedPnL: p.Dec("umUnrealizedPNL", b.UMUnrealizedPNL).Add(p.Dec("cmUnrealizedPNL", b.CMUnrealizedPNL)),
+		}
+		if b.Total.IsZero() && b.Borrowed.IsZero() && b.UnrealizedPnL.IsZero() {
+			continue
+		}
+		balances = append(balances, b)
+	}
+	return balances, nil, nil, p.Err
+}
@@ -0,0 +1,334 @@
+package binance
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/adshao/go-binance/v2/common"
+)
+
+// BaseAPIMainURLs are the equivalent hosts of the production REST API
+var BaseAPIMainURLs = []string{
+	"https://api.binance.com",
+	"https://api-gcp.binance.com",
+	"https://api1.binance.com",
+	"https://api2.binance.com",
+	"https://api3.binance.com",
+	"https://api4.binance.com",
+}
+
+// orderNotFoundCode is the error code of an order unknown to GetOrderService
+const orderNotFoundCode = -2013
+
+// defaultHostCooldown is how long a failed host is avoided by default
+const defaultHostCooldown = 30 * time.Second
+
+// OrderSubmittedError is returned when the host an order was sent to failed, and the order was
+// then found with GetOrderService: it was submitted, and it is not sent again
+type OrderSubmittedError struct {
+	Order *Order
+	Err   error // error of the host the order was sent to
+}
+
+func (e *OrderSubmittedError) Error() string {
+	return fmt.Sprintf("order %s submitted despite: %v", e.Order.ClientOrderID, e.Err)
+}
+
+func (e *OrderSubmittedError) Unwrap() error {
+	return e.Err
+}
+
+// OrderStatusUnknownError is returned when the host an order was sent to failed, and the order
+// was not found with GetOrderService right after. It may still be in flight and filled later,
+// so it is not sent again: the caller may look it up by its client order ID before resending.
+type OrderStatusUnknownError struct {
+	Symbol        string
+	ClientOrderID string
+	Err           error // error of the host the order was sent to
+}
+
+func (e *OrderStatusUnknownError) Error() string {
+	return fmt.Sprintf("order %s status unknown: %v", e.ClientOrderID, e.Err)
+}
+
+func (e *OrderStatusUnknownError) Unwrap() error {
+	return e.Err
+}
+
+// HostStatus define the status of a host of a HostPool
+type HostStatus struct {
+	URL      string
+	Latency  time.Duration // round trip of the last probe, 0 before the first one
+	Failures int           // consecutive failures
+	Healthy  bool
+}
+
+type hostState struct {
+	url       string
+	latency   time.Duration
+	failures  int
+	downUntil time.Time
+}
+
+// HostPool routes the REST API calls of a client to the healthiest of several equivalent hosts.
+// A host is failed by connection errors and 5xx responses, and avoided until its cooldown ends.
+// Only GET calls are sent again to the next host. An order creation is looked up by its
+// client order ID on the next host instead, and returns an *OrderSubmittedError or an
+// *OrderStatusUnknownError, so that an order is never submitted twice. It is safe for
+// concurrent use and may be shared by clients.
+type HostPool struct {
+	mu       sync.Mutex
+	hosts    []*hostState
+	cooldown time.Duration
+}
+
+// NewHostPool init a HostPool of urls, BaseAPIMainURLs when none is given
+func NewHostPool(urls ...string) *HostPool {
+	if len(urls) == 0 {
+		urls = BaseAPIMainURLs
+	}
+	p := &HostPool{cooldown: defaultHostCooldown}
+	for _, u := range urls {
+		p.hosts = append(p.hosts, &hostState{url: strings.TrimSuffix(u, "/")})
+	}
+	return p
+}
+
+// Cooldown set how long a failed host is avoided, 30 seconds by default
+func (p *HostPool) Cooldown(cooldown time.Duration) *HostPool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.cooldown = cooldown
+	return p
+}
+
+// Hosts returns the status of the hosts, in the order they are tried
+func (p *HostPool) Hosts() []HostStatus {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	now := time.Now()
+	hosts := make([]HostStatus, 0, len(p.hosts))
+	for _, h := range p.sorted(now) {
+		hosts = append(hosts, HostStatus{
+			URL:      h.url,
+			Latency:  h.latency,
+			Failures: h.failures,
+			Healthy:  !now.Before(h.downUntil),
+		})
+	}
+	return hosts
+}
+
+// order returns the urls of the hosts in the order they are tried
+func (p *HostPool) order() []string {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	sorted := p.sorted(time.Now())
+	urls := make([]string, len(sorted))
+	for i, h := range sorted {
+		urls[i] = h.url
+	}
+	return urls
+}
+
+// sorted returns the healthy hosts by latency, the hosts not probed yet last, then the failed
+// hosts by the end of their cooldown
+func (p *HostPool) sorted(now time.Time) []*hostState {
+	sorted := append([]*hostState(nil), p.hosts...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		a, b := sorted[i], sorted[j]
+		aUp, bUp := !now.Before(a.downUntil), !now.Before(b.downUntil)
+		switch {
+		case aUp != bUp:
+			return aUp
+		case !aUp:
+			return a.downUntil.Before(b.downUntil)
+		case (a.latency == 0) != (b.latency == 0):
+			return a.latency != 0
+		}
+		return a.latency < b.latency
+	})
+	return sorted
+}
+
+func (p *HostPool) host(url string) *hostState {
+	for _, h := range p.hosts {
+		if h.url == url {
+			return h
+		}
+	}
+	return nil
+}
+
+// succeed marks url as healthy, with latency when it is not 0
+func (p *HostPool) succeed(url string, latency time.Duration) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if h := p.host(url); h != nil {
+		h.failures = 0
+		h.downUntil = time.Time{}
+		if latency > 0 {
+			h.latency = latency
+		}
+	}
+}
+
+// fail marks url as failed for the cooldown
+func (p *HostPool) fail(url string) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if h := p.host(url); h != nil {
+		h.failures++
+		h.downUntil = time.Now().Add(p.cooldown)
+	}
+}
+
+// Probe measures the latency of every host with PingService, through the HTTP client of c.
+// Unreachable hosts are marked as failed.
+func (p *HostPool) Probe(ctx context.Context, c *Client) {
+	var wg sync.WaitGroup
+	for _, u := range p.order() {
+		wg.Add(1)
+		go func(u string) {
+			defer wg.Done()
+			start := time.Now()
+			if err := c.onHost(u).NewPingService().Do(ctx); err != nil {
+				if ctx.Err() == nil {
+					p.fail(u)
+				}
+				return
+			}
+			p.succeed(u, time.Since(start))
+		}(u)
+	}
+	wg.Wait()
+}
+
+// Run probes the hosts every interval until ctx is done
+func (p *HostPool) Run(ctx context.Context, c *Client, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		p.Probe(ctx, c)
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// SetHostPool routes the REST API calls of the client to the hosts of pool instead of BaseURL,
+// see HostPool
+func (c *Client) SetHostPool(pool *HostPool) *Client {
+	c.hostPool = pool
+	return c
+}
+
+// failover returns a handler sending the request of r to the hosts of the pool in turn, until
+// one of them does not fail or the request cannot be sent again
+func (c *Client) failover(r *request, next common.Handler) common.Handler {
+	return func(req *http.Request) (res *common.Response, err error) {
+		for i, host := range c.hostPool.order() {
+			if i > 0 {
+				if err = c.checkResend(req.Context(), r, host, err); err != nil {
+					return nil, err
+				}
+			}
+			attempt, e := withHost(req, c.BaseURL, host)
+			if e != nil {
+				return nil, e
+			}
+			res, err = next(attempt)
+			if req.Context().Err() != nil {
+				return res, err
+			}
+			if !hostFailed(res, err) {
+				c.hostPool.succeed(host, 0)
+				return res, err
+			}
+			c.hostPool.fail(host)
+		}
+		return res, err
+	}
+}
+
+// hostFailed returns whether the host of a call failed: the connection failed or the host
+// answered with a 5xx status
+func hostFailed(res *common.Response, err error) bool {
+	if res == nil {
+		return err != nil
+	}
+	return res.StatusCode >= http.StatusInternalServerError
+}
+
+// onHost returns a client calling url directly instead of the pool, with the settings of c.
+// It parses the secret key with the signer cache of c and updates the usage counters of c.
+func (c *Client) onHost(url string) *Client {
+	return &Client{
+		APIKey:        c.APIKey,
+		SecretKey:     c.SecretKey,
+		KeyType:       c.KeyType,
+		BaseURL:       url,
+		UserAgent:     c.UserAgent,
+		HTTPClient:    c.HTTPClient,
+		Debug:         c.Debug,
+		Logger:        c.Logger,
+		TimeOffset:    c.TimeOffset,
+		do:            c.do,
+		Environment:   c.Environment,
+		Middlewares:   c.Middlewares,
+		logMiddleware: c.logMiddleware,
+		Signer:        c.Signer,
+		parent:        c.root(),
+	}
+}
+
+// checkResend returns nil when the request of r, which failed with err, can be sent to host,
+// the error to return otherwise. An order creation is looked up on host and never sent again.
+func (c *Client) checkResend(ctx context.Context, r *request, host string, err error) error {
+	if r.method == http.MethodGet {
+		return nil
+	}
+	if r.method != http.MethodPost || r.endpoint != "/api/v3/order" {
+		return err
+	}
+	symbol, clientOrderID := r.form.Get("symbol"), r.form.Get("newClientOrderId")
+	if symbol == "" || clientOrderID == "" {
+		return err
+	}
+	order, e := c.onHost(host).NewGetOrderService().Symbol(symbol).OrigClientOrderID(clientOrderID).Do(ctx)
+	if e == nil {
+		return &OrderSubmittedError{Order: order, Err: err}
+	}
+	var apiErr *common.APIError
+	if errors.As(e, &apiErr) && apiErr.Code == orderNotFoundCode {
+		// a timed out order may not have reached the matching engine yet
+		return &OrderStatusUnknownError{Symbol: symbol, ClientOrderID: clientOrderID, Err: err}
+	}
+	return err
+}
+
+// withHost returns a copy of req sent to host instead of base
+func withHost(req *http.Request, base, host string) (*http.Request, error) {
+	u, err := url.Parse(host + strings.TrimPrefix(req.URL.String(), base))
+	if err != nil {
+		return nil, err
+	}
+	attempt := req.Clone(req.Context())
+	attempt.URL = u
+	attempt.Host = ""
+	if req.GetBody != nil {
+		if attempt.Body, err = req.GetBody(); err != nil {
+			return nil, err
+		}
+	}
+	return attempt, nil
+}
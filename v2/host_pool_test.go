@@ -0,0 +1,238 @@
+package binance
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/adshao/go-binance/v2/common"
+)
+
+type hostPoolTestSuite struct {
+	suite.Suite
+	mu      sync.Mutex
+	calls   map[string][]string // "METHOD path" by host name
+	servers map[string]*httptest.Server
+}
+
+func TestHostPool(t *testing.T) {
+	suite.Run(t, new(hostPoolTestSuite))
+}
+
+func (s *hostPoolTestSuite) SetupTest() {
+	s.calls = map[string][]string{}
+	s.servers = map[string]*httptest.Server{}
+}
+
+func (s *hostPoolTestSuite) TearDownTest() {
+	for _, server := range s.servers {
+		server.Close()
+	}
+}
+
+// server starts a host answering with handler, and records its calls
+func (s *hostPoolTestSuite) server(name string, handler http.HandlerFunc) string {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		s.mu.Lock()
+		s.calls[name] = append(s.calls[name], r.Method+" "+r.URL.Path)
+		s.mu.Unlock()
+		handler(w, r)
+	}))
+	s.servers[name] = server
+	return server.URL
+}
+
+// down returns the url of a host refusing connections
+func (s *hostPoolTestSuite) down() string {
+	server := httptest.NewServer(http.NotFoundHandler())
+	server.Close()
+	return server.URL
+}
+
+func (s *hostPoolTestSuite) hostCalls(name string) []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.calls[name]
+}
+
+func unavailable(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusServiceUnavailable)
+	w.Write([]byte(`{"code":-1000,"msg":"Unknown error, please check your request or try again later."}`))
+}
+
+func okHandler(body string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(body))
+	}
+}
+
+func (s *hostPoolTestSuite) client(pool *HostPool) *Client {
+	return NewClient("key", "secret").SetHostPool(pool)
+}
+
+func (s *hostPoolTestSuite) TestGetFailover() {
+	down := s.down()
+	failing := s.server("failing", unavailable)
+	healthy := s.server("healthy", okHandler(`{"serverTime":1499827319559}`))
+	pool := NewHostPool(down, failing, healthy)
+
+	serverTime, err := s.client(pool).NewServerTimeService().Do(context.Background())
+	s.Require().NoError(err)
+	s.Equal(int64(1499827319559), serverTime)
+	s.Equal([]string{"GET /api/v3/time"}, s.hostCalls("failing"))
+
+	hosts := pool.Hosts()
+	s.Equal(healthy, hosts[0].URL)
+	s.True(hosts[0].Healthy)
+	s.Equal(down, hosts[1].URL)
+	s.False(hosts[1].Healthy)
+	s.Equal(1, hosts[1].Failures)
+	s.Equal(failing, hosts[2].URL)
+
+	// the failed hosts are avoided during their cooldown
+	_, err = s.client(pool).NewServerTimeService().Do(context.Background())
+	s.Require().NoError(err)
+	s.Len(s.hostCalls("failing"), 1)
+	s.Len(s.hostCalls("healthy"), 2)
+}
+
+func (s *hostPoolTestSuite) TestClientErrorNotFailedOver() {
+	invalid := s.server("invalid", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(`{"code":-1121,"msg":"Invalid symbol."}`))
+	})
+	other := s.server("other", okHandler(`{}`))
+
+	_, err := s.client(NewHostPool(invalid, other)).NewListPricesService().Symbol("XXX").Do(context.Background())
+	s.True(common.IsAPIError(err))
+	s.Empty(s.hostCalls("other"))
+}
+
+func (s *hostPoolTestSuite) TestOrderSubmitted() {
+	failing := s.server("failing", unavailable)
+	other := s.server("other", func(w http.ResponseWriter, r *http.Request) {
+		s.Equal("my-order", r.URL.Query().Get("origClientOrderId"))
+		okHandler(`{"symbol":"BTCUSDT","orderId":1,"clientOrderId":"my-order","status":"FILLED"}`)(w, r)
+	})
+
+	_, err := s.client(NewHostPool(failing, other)).NewCreateOrderService().Symbol("BTCUSDT").
+		Side(SideTypeBuy).Type(OrderTypeMarket).Quantity("1").NewClientOrderID("my-order").
+		Do(context.Background())
+	var submitted *OrderSubmittedError
+	s.Require().True(errors.As(err, &submitted))
+	s.Equal(int64(1), submitted.Order.OrderID)
+	s.True(common.IsAPIError(errors.Unwrap(err)))
+	// the order is checked, never sent again
+	s.Equal([]string{"GET /api/v3/order"}, s.hostCalls("other"))
+}
+
+func (s *hostPoolTestSuite) TestOrderStatusUnknown() {
+	failing := s.server("failing", unavailable)
+	other := s.server("other", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(`{"code":-2013,"msg":"Order does not exist."}`))
+	})
+
+	_, err := s.client(NewHostPool(failing, other)).NewCreateOrderService().Symbol("BTCUSDT").
+		Side(SideTypeBuy).Type(OrderTypeMarket).Quantity("1").NewClientOrderID("my-order").
+		Do(context.Background())
+	var unknown *OrderStatusUnknownError
+	s.Require().True(errors.As(err, &unknown))
+	s.Equal("BTCUSDT", unknown.Symbol)
+	s.Equal("my-order", unknown.ClientOrderID)
+	s.True(common.IsAPIError(errors.Unwrap(err)))
+	// the order may still be in flight, it is never sent again
+	s.Equal([]string{"GET /api/v3/order"}, s.hostCalls("other"))
+}
+
+func (s *hostPoolTestSuite) TestOrderLookupNotFailedOver() {
+	failing := s.server("failing", unavailable)
+	lookup := s.server("lookup", unavailable)
+	other := s.server("other", okHandler(`{}`))
+	pool := NewHostPool(failing, lookup, other)
+	pool.succeed(failing, time.Millisecond)
+	pool.succeed(lookup, 2*time.Millisecond)
+	pool.succeed(other, 3*time.Millisecond)
+
+	_, err := s.client(pool).NewCreateOrderService().Symbol("BTCUSDT").
+		Side(SideTypeBuy).Type(OrderTypeMarket).Quantity("1").NewClientOrderID("my-order").
+		Do(context.Background())
+	s.True(common.IsAPIError(err))
+	s.Equal([]string{"GET /api/v3/order"}, s.hostCalls("lookup"))
+	s.Empty(s.hostCalls("other"))
+}
+
+func (s *hostPoolTestSuite) TestHostClientShared() {
+	failing := s.server("failing", unavailable)
+	other := s.server("other", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-Mbx-Used-Weight-1m", "42")
+		w.Header().Set("X-Mbx-Order-Count-10s", "3")
+		okHandler(`{"symbol":"BTCUSDT","orderId":1,"clientOrderId":"my-order","status":"FILLED"}`)(w, r)
+	})
+	c := s.client(NewHostPool(failing, other))
+
+	_, err := c.NewCreateOrderService().Symbol("BTCUSDT").
+		Side(SideTypeBuy).Type(OrderTypeMarket).Quantity("1").NewClientOrderID("my-order").
+		Do(context.Background())
+	var submitted *OrderSubmittedError
+	s.Require().True(errors.As(err, &submitted))
+	// the usage reported to the lookup is counted on the client
+	s.Equal(int64(42), c.UsedWeight.Used1M)
+	s.Equal(int64(3), c.OrderCount.Count10s)
+	s.NotZero(c.lastResponse)
+
+	// the secret key is parsed once for the client and its host clients
+	signer, err := c.signer()
+	s.Require().NoError(err)
+	hostSigner, err := c.onHost(other).onHost(failing).signer()
+	s.Require().NoError(err)
+	s.Same(signer, hostSigner)
+}
+
+func (s *hostPoolTestSuite) TestProbeMiddlewares() {
+	fast := s.server("fast", okHandler(`{}`))
+	var called []string
+	c := NewClient("", "")
+	c.Use(func(next common.Handler) common.Handler {
+		return func(req *http.Request) (*common.Response, error) {
+			called = append(called, req.URL.Path)
+			return next(req)
+		}
+	})
+
+	NewHostPool(fast).Probe(context.Background(), c)
+	s.Equal([]string{"/api/v3/ping"}, called)
+}
+
+func (s *hostPoolTestSuite) TestUncheckableNotResent() {
+	failing := s.server("failing", unavailable)
+	other := s.server("other", okHandler(`{}`))
+
+	_, err := s.client(NewHostPool(failing, other)).NewCancelOrderService().Symbol("BTCUSDT").
+		OrderID(1).Do(context.Background())
+	s.True(common.IsAPIError(err))
+	s.Empty(s.hostCalls("other"))
+}
+
+func (s *hostPoolTestSuite) TestProbe() {
+	slow := s.server("slow", func(w http.ResponseWriter, r *http.Request) {
+		time.Sleep(50 * time.Millisecond)
+		okHandler(`{}`)(w, r)
+	})
+	fast := s.server("fast", okHandler(`{}`))
+	down := s.down()
+	pool := NewHostPool(down, slow, fast)
+
+	pool.Probe(context.Background(), NewClient("", ""))
+	hosts := pool.Hosts()
+	s.Equal([]string{fast, slow, down}, []string{hosts[0].URL, hosts[1].URL, hosts[2].URL})
+	s.Less(hosts[0].Latency, hosts[1].Latency)
+	s.False(hosts[2].Healthy)
+	s.Equal([]string{"GET /api/v3/ping"}, s.hostCalls("fast"))
+}
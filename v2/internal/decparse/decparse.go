@@ -0,0 +1,25 @@
+// Package decparse parses the decimal string fields of API responses
+package decparse
+
+import (
+	"fmt"
+
+	"github.com/shopspring/decimal"
+)
+
+// Parser parse decimal fields, keeping the first error in Err
+type Parser struct {
+	Err error
+}
+
+// Dec returns the decimal of the field v, zero when v is empty or invalid
+func (p *Parser) Dec(field, v string) decimal.Decimal {
+	if v == "" {
+		return decimal.Zero
+	}
+	d, err := decimal.NewFromString(v)
+	if err != nil && p.Err == nil {
+		p.Err = fmt.Errorf("invalid %s %q: %w", field, v, err)
+	}
+	return d
+}
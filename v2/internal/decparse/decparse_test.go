@@ -0,0 +1,19 @@
+package decparse
+
+import (
+	"testing"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParser(t *testing.T) {
+	p := new(Parser)
+	assert.True(t, decimal.RequireFromString("1.5").Equal(p.Dec("qty", "1.5")))
+	assert.True(t, p.Dec("price", "").IsZero())
+	assert.NoError(t, p.Err)
+
+	assert.True(t, p.Dec("price", "abc").IsZero())
+	p.Dec("qty", "x")
+	assert.EqualError(t, p.Err, `invalid price "abc": can't convert abc to decimal`)
+}
@@ -0,0 +1,75 @@
+// Package idempotency helps sending a request at most once: it tells the requests rejected by
+// the exchange from the requests of unknown outcome, and keeps the keys of the requests sent
+package idempotency
+
+import (
+	"errors"
+	"sync"
+
+	"github.com/adshao/go-binance/v2/common"
+)
+
+// unknownCodes define the errors after which the request may have been executed: unknown
+// and internal errors, unexpected response and execution timeout
+var unknownCodes = map[int64]struct{}{
+	-1000: {},
+	-1001: {},
+	-1006: {},
+	-1007: {},
+}
+
+// Rejected return whether err rejects a request, otherwise its outcome is unknown. Transport
+// errors and responses without a valid APIError, e.g. a 5xx from a proxy, are unknown.
+func Rejected(err error) bool {
+	var apiErr *common.APIError
+	if !errors.As(err, &apiErr) || !apiErr.IsValid() {
+		return false
+	}
+	_, unknown := unknownCodes[apiErr.Code]
+	return !unknown
+}
+
+// Map define the values of keys, safe for concurrent use. The zero value is empty.
+type Map[V any] struct {
+	mu     sync.Mutex
+	values map[string]V
+}
+
+// Load return the value of key
+func (m *Map[V]) Load(key string) (V, bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	v, ok := m.values[key]
+	return v, ok
+}
+
+// Store set the value of key
+func (m *Map[V]) Store(key string, v V) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.values == nil {
+		m.values = map[string]V{}
+	}
+	m.values[key] = v
+}
+
+// StoreNew set the value of key, false when key already has one
+func (m *Map[V]) StoreNew(key string, v V) bool {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if _, ok := m.values[key]; ok {
+		return false
+	}
+	if m.values == nil {
+		m.values = map[string]V{}
+	}
+	m.values[key] = v
+	return true
+}
+
+// Delete remove the value of key
+func (m *Map[V]) Delete(key string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	delete(m.values, key)
+}
@@ -0,0 +1,40 @@
+package idempotency
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/adshao/go-binance/v2/common"
+)
+
+func TestRejected(t *testing.T) {
+	assert.True(t, Rejected(&common.APIError{Code: -2010, Message: "insufficient balance"}))
+	assert.True(t, Rejected(fmt.Errorf("transfer: %w", &common.APIError{Code: -1102})))
+	assert.False(t, Rejected(&common.APIError{Code: -1007, Message: "timeout"}))
+	assert.False(t, Rejected(&common.APIError{Code: -1006, Message: "unexpected response"}))
+	assert.False(t, Rejected(&common.APIError{Code: -1001, Message: "internal error"}))
+	assert.False(t, Rejected(&common.APIError{Response: []byte("bad gateway")}))
+	assert.False(t, Rejected(errors.New("connection reset")))
+}
+
+func TestMap(t *testing.T) {
+	var m Map[int64]
+	_, ok := m.Load("a")
+	assert.False(t, ok)
+
+	assert.True(t, m.StoreNew("a", 1))
+	assert.False(t, m.StoreNew("a", 2))
+	v, ok := m.Load("a")
+	assert.True(t, ok)
+	assert.Equal(t, int64(1), v)
+
+	m.Store("a", 3)
+	v, _ = m.Load("a")
+	assert.Equal(t, int64(3), v)
+
+	m.Delete("a")
+	assert.True(t, m.StoreNew("a", 4))
+}
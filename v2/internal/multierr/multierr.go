@@ -0,0 +1,60 @@
+// Package multierr joins errors like errors.Join, which requires go1.20
+package multierr
+
+import (
+	"errors"
+	"strings"
+)
+
+// joinError define the errors joined by Join
+type joinError struct {
+	errs []error
+}
+
+// Join returns an error wrapping the non-nil errs, nil when there is none. Its message is
+// the messages of errs separated by newlines, and errors.Is and errors.As match any of errs.
+func Join(errs ...error) error {
+	var joined []error
+	for _, err := range errs {
+		if err != nil {
+			joined = append(joined, err)
+		}
+	}
+	if len(joined) == 0 {
+		return nil
+	}
+	return &joinError{errs: joined}
+}
+
+func (e *joinError) Error() string {
+	msgs := make([]string, len(e.errs))
+	for i, err := range e.errs {
+		msgs[i] = err.Error()
+	}
+	return strings.Join(msgs, "\n")
+}
+
+// Is reports whether one of the errors matches target
+func (e *joinError) Is(target error) bool {
+	for _, err := range e.errs {
+		if errors.Is(err, target) {
+			return true
+		}
+	}
+	return false
+}
+
+// As finds the first of the errors matching target
+func (e *joinError) As(target interface{}) bool {
+	for _, err := range e.errs {
+		if errors.As(err, target) {
+			return true
+		}
+	}
+	return false
+}
+
+// Unwrap returns the errors
+func (e *joinError) Unwrap() []error {
+	return e.errs
+}
@@ -0,0 +1,32 @@
+package multierr
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type codeError struct {
+	code int
+}
+
+func (e *codeError) Error() string {
+	return fmt.Sprintf("code %d", e.code)
+}
+
+func TestJoin(t *testing.T) {
+	assert := assert.New(t)
+	assert.NoError(Join())
+	assert.NoError(Join(nil, nil))
+
+	err := Join(errors.New("first"), nil, fmt.Errorf("second: %w", &codeError{code: 2}), context.Canceled)
+	assert.Equal("first\nsecond: code 2\ncontext canceled", err.Error())
+	assert.ErrorIs(err, context.Canceled)
+	assert.NotErrorIs(err, context.DeadlineExceeded)
+	var code *codeError
+	assert.True(errors.As(err, &code))
+	assert.Equal(2, code.code)
+}
@@ -0,0 +1,452 @@
+// Command servicegen generates the interfaces of the REST services of a package, so that
+// the code calling them can be tested with mocks. It is run by go generate in the directory
+// of the package:
+//
+//	//go:generate go run github.com/adshao/go-binance/v2/internal/servicegen -output service_api.go
+//
+// For every service returned by a New...() method of Client, and every service returned by
+// the methods of a service, it generates an interface named after the service with the API
+// suffix, whose builder methods return the interface, and an adapter implementing it on the
+// service. ClientAPI is the interface of the service constructors of Client, NewClientAPI
+// adapts a Client to it. Methods whose signature uses unexported types are left out, as
+// they cannot be implemented outside of the package.
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/printer"
+	"go/token"
+	"log"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+var builtinTypes = map[string]bool{
+	"any": true, "bool": true, "byte": true, "error": true, "rune": true, "string": true,
+	"float32": true, "float64": true, "int": true, "int8": true, "int16": true, "int32": true,
+	"int64": true, "uint": true, "uint8": true, "uint16": true, "uint32": true, "uint64": true,
+	"uintptr": true,
+}
+
+type method struct {
+	name string
+	decl *ast.FuncDecl
+	file *ast.File
+}
+
+type generator struct {
+	fset     *token.FileSet
+	pkg      string
+	types    map[string]bool      // struct types of the package
+	methods  map[string][]*method // exported methods by receiver type
+	services []string             // service types, in the order they are found
+	isSvc    map[string]bool
+	imports  map[string]string // path by name of the imports used
+}
+
+func main() {
+	output := flag.String("output", "service_api.go", "output file")
+	flag.Parse()
+
+	g := &generator{
+		fset:    token.NewFileSet(),
+		types:   map[string]bool{},
+		methods: map[string][]*method{},
+		isSvc:   map[string]bool{},
+		imports: map[string]string{},
+	}
+	if err := g.parse(".", *output); err != nil {
+		log.Fatal(err)
+	}
+	src, err := g.generate()
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := os.WriteFile(*output, src, 0o644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// parse collects the struct types and exported methods of the package in dir
+func (g *generator) parse(dir, output string) error {
+	pkgs, err := parser.ParseDir(g.fset, dir, func(fi os.FileInfo) bool {
+		return !strings.HasSuffix(fi.Name(), "_test.go") && fi.Name() != output
+	}, parser.ParseComments)
+	if err != nil {
+		return err
+	}
+	if len(pkgs) != 1 {
+		return fmt.Errorf("expected one package in %s, found %d", dir, len(pkgs))
+	}
+	for name, pkg := range pkgs {
+		g.pkg = name
+		files := make([]string, 0, len(pkg.Files))
+		for f := range pkg.Files {
+			files = append(files, f)
+		}
+		sort.Strings(files)
+		for _, f := range files {
+			g.parseFile(pkg.Files[f])
+		}
+	}
+	return nil
+}
+
+func (g *generator) parseFile(file *ast.File) {
+	for _, decl := range file.Decls {
+		switch d := decl.(type) {
+		case *ast.GenDecl:
+			for _, spec := range d.Specs {
+				if ts, ok := spec.(*ast.TypeSpec); ok {
+					if _, ok := ts.Type.(*ast.StructType); ok && ts.TypeParams == nil {
+						g.types[ts.Name.Name] = true
+					}
+				}
+			}
+		case *ast.FuncDecl:
+			if d.Recv == nil || !d.Name.IsExported() || d.Type.TypeParams != nil {
+				continue
+			}
+			recv := d.Recv.List[0].Type
+			if star, ok := recv.(*ast.StarExpr); ok {
+				recv = star.X
+			}
+			if ident, ok := recv.(*ast.Ident); ok {
+				g.methods[ident.Name] = append(g.methods[ident.Name], &method{name: d.Name.Name, decl: d, file: file})
+			}
+		}
+	}
+}
+
+// serviceResult returns the service type returned by fn, which returns a single pointer to a
+// struct type with methods
+func (g *generator) serviceResult(fn *ast.FuncType) (string, bool) {
+	if fn.Results == nil || len(fn.Results.List) != 1 || len(fn.Results.List[0].Names) > 1 {
+		return "", false
+	}
+	star, ok := fn.Results.List[0].Type.(*ast.StarExpr)
+	if !ok {
+		return "", false
+	}
+	ident, ok := star.X.(*ast.Ident)
+	if !ok || !g.types[ident.Name] || len(g.methods[ident.Name]) == 0 || !ident.IsExported() {
+		return "", false
+	}
+	return ident.Name, true
+}
+
+// collect finds the services of the constructors of Client and of the services
+func (g *generator) collect() []*method {
+	var constructors []*method
+	for _, m := range g.methods["Client"] {
+		if !strings.HasPrefix(m.name, "New") || m.decl.Type.Params.NumFields() != 0 {
+			continue
+		}
+		if svc, ok := g.serviceResult(m.decl.Type); ok {
+			constructors = append(constructors, m)
+			g.addService(svc)
+		}
+	}
+	for i := 0; i < len(g.services); i++ {
+		for _, m := range g.methods[g.services[i]] {
+			if svc, ok := g.serviceResult(m.decl.Type); ok {
+				g.addService(svc)
+			}
+		}
+	}
+	return constructors
+}
+
+func (g *generator) addService(name string) {
+	if name != "Client" && !g.isSvc[name] {
+		g.isSvc[name] = true
+		g.services = append(g.services, name)
+	}
+}
+
+// exportable returns whether the signature of fn only uses exported or builtin types
+func (g *generator) exportable(fn *ast.FuncType) bool {
+	var fields []*ast.Field
+	fields = append(fields, fn.Params.List...)
+	if fn.Results != nil {
+		fields = append(fields, fn.Results.List...)
+	}
+	for _, f := range fields {
+		ok := true
+		ast.Inspect(f.Type, func(n ast.Node) bool {
+			switch x := n.(type) {
+			case *ast.SelectorExpr:
+				return false
+			case *ast.Ident:
+				ok = ok && !g.unexportedType(x)
+			}
+			return true
+		})
+		if !ok {
+			return false
+		}
+	}
+	return true
+}
+
+func (g *generator) unexportedType(id *ast.Ident) bool {
+	return !builtinTypes[id.Name] && id.Name != "_" && !unicode.IsUpper([]rune(id.Name)[0])
+}
+
+func apiName(svc string) string {
+	return svc + "API"
+}
+
+func adapterName(svc string) string {
+	r := []rune(svc)
+	i := 0
+	for i < len(r) && unicode.IsUpper(r[i]) {
+		i++
+	}
+	// lower the leading initialism, e.g. GetAPIKeyPermission into getAPIKeyPermission
+	if i > 1 && i < len(r) {
+		i--
+	}
+	if i == 0 {
+		i = 1
+	}
+	return strings.ToLower(string(r[:i])) + string(r[i:]) + "API"
+}
+
+func (g *generator) expr(e ast.Expr) string {
+	var buf bytes.Buffer
+	if err := printer.Fprint(&buf, g.fset, e); err != nil {
+		log.Fatal(err)
+	}
+	return buf.String()
+}
+
+// useImports records the imports of file used by e
+func (g *generator) useImports(file *ast.File, e ast.Node) {
+	ast.Inspect(e, func(n ast.Node) bool {
+		sel, ok := n.(*ast.SelectorExpr)
+		if !ok {
+			return true
+		}
+		if id, ok := sel.X.(*ast.Ident); ok {
+			for _, imp := range file.Imports {
+				path, _ := strconv.Unquote(imp.Path.Value)
+				name := path[strings.LastIndex(path, "/")+1:]
+				if imp.Name != nil {
+					name = imp.Name.Name
+				}
+				if name == id.Name || path == "github.com/adshao/go-binance/v2" && id.Name == "binance" {
+					g.imports[id.Name] = imp.Path.Value
+				}
+			}
+		}
+		return false
+	})
+}
+
+type param struct {
+	name     string
+	typ      string
+	variadic bool
+}
+
+// params returns the parameters of fn, named p0, p1... when unnamed
+func (g *generator) params(fn *ast.FuncType) []param {
+	var params []param
+	for _, f := range fn.Params.List {
+		typ := f.Type
+		variadic := false
+		if ell, ok := typ.(*ast.Ellipsis); ok {
+			typ, variadic = ell.Elt, true
+		}
+		names := f.Names
+		if len(names) == 0 {
+			names = []*ast.Ident{nil}
+		}
+		for _, n := range names {
+			name := fmt.Sprintf("p%d", len(params))
+			if n != nil && n.Name != "_" {
+				name = n.Name
+			}
+			params = append(params, param{name: name, typ: g.expr(typ), variadic: variadic})
+		}
+	}
+	return params
+}
+
+// results returns the types of the results of fn, services being replaced by their interface
+func (g *generator) results(fn *ast.FuncType) (types []string, services []string) {
+	if fn.Results == nil {
+		return nil, nil
+	}
+	for _, f := range fn.Results.List {
+		n := len(f.Names)
+		if n == 0 {
+			n = 1
+		}
+		for i := 0; i < n; i++ {
+			svc := ""
+			if star, ok := f.Type.(*ast.StarExpr); ok {
+				if id, ok := star.X.(*ast.Ident); ok && g.isSvc[id.Name] {
+					svc = id.Name
+				}
+			}
+			if svc != "" {
+				types = append(types, apiName(svc))
+			} else {
+				types = append(types, g.expr(f.Type))
+			}
+			services = append(services, svc)
+		}
+	}
+	return types, services
+}
+
+func signature(params []param, results []string) string {
+	var b strings.Builder
+	b.WriteString("(")
+	for i, p := range params {
+		if i > 0 {
+			b.WriteString(", ")
+		}
+		b.WriteString(p.name + " ")
+		if p.variadic {
+			b.WriteString("...")
+		}
+		b.WriteString(p.typ)
+	}
+	b.WriteString(")")
+	switch len(results) {
+	case 0:
+	case 1:
+		b.WriteString(" " + results[0])
+	default:
+		b.WriteString(" (" + strings.Join(results, ", ") + ")")
+	}
+	return b.String()
+}
+
+func call(params []param) string {
+	args := make([]string, len(params))
+	for i, p := range params {
+		args[i] = p.name
+		if p.variadic {
+			args[i] += "..."
+		}
+	}
+	return "(" + strings.Join(args, ", ") + ")"
+}
+
+// forward writes the body of an adapter method calling the method of recv
+func forward(b *bytes.Buffer, recv, name string, params []param, services []string) {
+	wraps := false
+	for _, s := range services {
+		wraps = wraps || s != ""
+	}
+	invocation := recv + "." + name + call(params)
+	switch {
+	case len(services) == 0:
+		fmt.Fprintf(b, "\t%s\n", invocation)
+	case !wraps:
+		fmt.Fprintf(b, "\treturn %s\n", invocation)
+	case len(services) == 1:
+		fmt.Fprintf(b, "\treturn (*%s)(%s)\n", adapterName(services[0]), invocation)
+	default:
+		vars := make([]string, len(services))
+		for i := range vars {
+			vars[i] = fmt.Sprintf("r%d", i)
+		}
+		fmt.Fprintf(b, "\t%s := %s\n", strings.Join(vars, ", "), invocation)
+		for i, s := range services {
+			if s != "" {
+				vars[i] = fmt.Sprintf("(*%s)(%s)", adapterName(s), vars[i])
+			}
+		}
+		fmt.Fprintf(b, "\treturn %s\n", strings.Join(vars, ", "))
+	}
+}
+
+func (g *generator) generate() ([]byte, error) {
+	constructors := g.collect()
+
+	var body bytes.Buffer
+	body.WriteString("// ClientAPI is the interface of the REST services of Client, see NewClientAPI\n")
+	body.WriteString("type ClientAPI interface {\n")
+	for _, m := range constructors {
+		svc, _ := g.serviceResult(m.decl.Type)
+		fmt.Fprintf(&body, "\t%s() %s\n", m.name, apiName(svc))
+	}
+	body.WriteString("}\n\n")
+	body.WriteString("// NewClientAPI returns c as a ClientAPI\n")
+	body.WriteString("func NewClientAPI(c *Client) ClientAPI {\n\treturn (*clientAPI)(c)\n}\n\n")
+	body.WriteString("type clientAPI Client\n")
+	for _, m := range constructors {
+		svc, _ := g.serviceResult(m.decl.Type)
+		fmt.Fprintf(&body, "\nfunc (a *clientAPI) %s() %s {\n", m.name, apiName(svc))
+		forward(&body, "(*Client)(a)", m.name, nil, []string{svc})
+		body.WriteString("}\n")
+	}
+
+	for _, svc := range g.services {
+		var methods []*method
+		for _, m := range g.methods[svc] {
+			if g.exportable(m.decl.Type) {
+				methods = append(methods, m)
+				g.useImports(m.file, m.decl.Type)
+			}
+		}
+		fmt.Fprintf(&body, "\n// %s is the interface of %s\n", apiName(svc), svc)
+		fmt.Fprintf(&body, "type %s interface {\n", apiName(svc))
+		for _, m := range methods {
+			results, _ := g.results(m.decl.Type)
+			fmt.Fprintf(&body, "\t%s%s\n", m.name, signature(g.params(m.decl.Type), results))
+		}
+		body.WriteString("}\n\n")
+		fmt.Fprintf(&body, "type %s %s\n", adapterName(svc), svc)
+		for _, m := range methods {
+			params := g.params(m.decl.Type)
+			results, services := g.results(m.decl.Type)
+			fmt.Fprintf(&body, "\nfunc (a *%s) %s%s {\n", adapterName(svc), m.name, signature(params, results))
+			forward(&body, fmt.Sprintf("(*%s)(a)", svc), m.name, params, services)
+			body.WriteString("}\n")
+		}
+	}
+
+	var out bytes.Buffer
+	out.WriteString("// Code generated by servicegen. DO NOT EDIT.\n\n")
+	fmt.Fprintf(&out, "package %s\n\n", g.pkg)
+	if len(g.imports) > 0 {
+		names := make([]string, 0, len(g.imports))
+		for name := range g.imports {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		// the standard library first, then the other imports
+		sort.SliceStable(names, func(i, j int) bool {
+			return !strings.Contains(g.imports[names[i]], ".") && strings.Contains(g.imports[names[j]], ".")
+		})
+		out.WriteString("import (\n")
+		for i, name := range names {
+			path, _ := strconv.Unquote(g.imports[name])
+			if i > 0 && strings.Contains(path, ".") && !strings.Contains(g.imports[names[i-1]], ".") {
+				out.WriteString("\n")
+			}
+			if path[strings.LastIndex(path, "/")+1:] == name {
+				fmt.Fprintf(&out, "\t%s\n", g.imports[name])
+			} else {
+				fmt.Fprintf(&out, "\t%s %s\n", name, g.imports[name])
+			}
+		}
+		out.WriteString(")\n\n")
+	}
+	out.Write(body.Bytes())
+	return format.Source(out.Bytes())
+}
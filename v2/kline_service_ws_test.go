@@ -0,0 +1,88 @@
+package binance
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/adshao/go-binance/v2/common/websocket"
+	"github.com/adshao/go-binance/v2/common/websocket/mock"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/suite"
+)
+
+type klinesServiceWsTestSuite struct {
+	suite.Suite
+
+	ctrl   *gomock.Controller
+	client *mock.MockClient
+
+	requestID string
+
+	service *KlinesWsService
+	request *KlinesWsRequest
+}
+
+func (s *klinesServiceWsTestSuite) SetupTest() {
+	s.requestID = "e2a85d9f-07a5-4f94-8d5f-789dc3deb098"
+
+	s.ctrl = gomock.NewController(s.T())
+	s.client = mock.NewMockClient(s.ctrl)
+
+	s.service = &KlinesWsService{
+		c: s.client,
+	}
+
+	s.request = NewKlinesWsRequest().Symbol("BTCUSDT").Interval("1h").StartTime(1655969280000).Limit(1)
+}
+
+func (s *klinesServiceWsTestSuite) TearDownTest() {
+	s.ctrl.Finish()
+}
+
+func TestKlinesWsService(t *testing.T) {
+	suite.Run(t, new(klinesServiceWsTestSuite))
+}
+
+func (s *klinesServiceWsTestSuite) TestDo() {
+	var data []byte
+	s.client.EXPECT().Write(s.requestID, gomock.Any()).DoAndReturn(func(id string, raw []byte) error {
+		data = raw
+		return nil
+	}).Times(1)
+
+	err := s.service.Do(s.requestID, s.request)
+	s.Require().NoError(err)
+
+	req := websocket.WsApiRequest{}
+	s.Require().NoError(json.Unmarshal(data, &req))
+	s.Equal(s.requestID, req.Id)
+	s.Equal(websocket.WsApiMethodType("klines"), req.Method)
+	for k, v := range map[string]interface{}{"symbol": "BTCUSDT", "interval": "1h", "startTime": float64(1655969280000), "limit": float64(1)} {
+		s.Equal(v, req.Params[k], k)
+	}
+	s.NotContains(req.Params, "apiKey")
+}
+
+func (s *klinesServiceWsTestSuite) TestSyncDo() {
+	rawResponseData := []byte(fmt.Sprintf(`{"id": "%s", "status": 200, "result": [[1655971200000, "0.01086000", "0.01086600", "0.01083600", "0.01083800", "2290.53800000", 1655974799999, "24.85074442", 2283, "1171.64000000", "12.71225884", "0"]]}`, s.requestID))
+	s.client.EXPECT().WriteSync(s.requestID, gomock.Any(), gomock.Any()).Return(rawResponseData, nil).Times(1)
+
+	response, err := s.service.SyncDo(s.requestID, s.request)
+	s.Require().NoError(err)
+	s.Equal(s.requestID, response.Id)
+	s.Require().Len(response.Result, 1)
+	s.Equal(&Kline{
+		OpenTime:                 1655971200000,
+		Open:                     "0.01086000",
+		High:                     "0.01086600",
+		Low:                      "0.01083600",
+		Close:                    "0.01083800",
+		Volume:                   "2290.53800000",
+		CloseTime:                1655974799999,
+		QuoteAssetVolume:         "24.85074442",
+		TradeNum:                 2283,
+		TakerBuyBaseAssetVolume:  "1171.64000000",
+		TakerBuyQuoteAssetVolume: "12.71225884",
+	}, response.Result[0])
+}
@@ -0,0 +1,178 @@
+package ledger
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/adshao/go-binance/v2"
+	"github.com/adshao/go-binance/v2/futures"
+	"github.com/adshao/go-binance/v2/internal/multierr"
+)
+
+// Source define a transaction history exported
+type Source string
+
+// Global enums
+const (
+	SourceTrades         Source = "TRADES"
+	SourceDeposits       Source = "DEPOSITS"
+	SourceWithdrawals    Source = "WITHDRAWALS"
+	SourceDust           Source = "DUST"
+	SourceDividends      Source = "DIVIDENDS"
+	SourceConvert        Source = "CONVERT"
+	SourceC2C            Source = "C2C"
+	SourceFiat           Source = "FIAT"
+	SourcePay            Source = "PAY"
+	SourceFuturesIncome  Source = "FUTURES_INCOME"
+	SourceMarginInterest Source = "MARGIN_INTEREST"
+	SourceEarnRewards    Source = "EARN_REWARDS"
+)
+
+// Exporter export the transaction history of an account
+type Exporter struct {
+	Client *binance.Client
+	// Futures is required by the futures income
+	Futures *futures.Client
+	// Symbols are the spot symbols whose trades are exported
+	Symbols []string
+	// Sources restricts the exported histories, all the ones with a client when empty
+	Sources []Source
+	// Strict fails the export when a transaction can not be normalized, otherwise it is
+	// recorded in the Skipped transactions of the ledger
+	Strict bool
+}
+
+// NewExporter init an exporter of the history of the account of c
+func NewExporter(c *binance.Client) *Exporter {
+	return &Exporter{Client: c}
+}
+
+// SourceError define the failure to export a history
+type SourceError struct {
+	Source Source
+	Err    error
+}
+
+// Error return source and error
+func (e *SourceError) Error() string {
+	return fmt.Sprintf("ledger: export %s: %v", e.Source, e.Err)
+}
+
+// Unwrap return the error of the export
+func (e *SourceError) Unwrap() error {
+	return e.Err
+}
+
+type exportFunc func(ctx context.Context, start, end time.Time) ([]Transaction, error)
+
+func (e *Exporter) exporters() []struct {
+	source Source
+	export exportFunc
+} {
+	all := []struct {
+		source Source
+		export exportFunc
+	}{
+		{SourceTrades, e.trades},
+		{SourceDeposits, e.deposits},
+		{SourceWithdrawals, e.withdrawals},
+		{SourceDust, e.dust},
+		{SourceDividends, e.dividends},
+		{SourceConvert, e.convert},
+		{SourceC2C, e.c2c},
+		{SourceFiat, e.fiat},
+		{SourcePay, e.pay},
+		{SourceMarginInterest, e.marginInterest},
+		{SourceEarnRewards, e.earnRewards},
+	}
+	if e.Futures != nil {
+		all = append(all, struct {
+			source Source
+			export exportFunc
+		}{SourceFuturesIncome, e.futuresIncome})
+	}
+	if len(e.Sources) == 0 {
+		return all
+	}
+	enabled := map[Source]bool{}
+	for _, s := range e.Sources {
+		enabled[s] = true
+	}
+	res := all[:0]
+	for _, x := range all {
+		if enabled[x.source] {
+			res = append(res, x)
+		}
+	}
+	return res
+}
+
+// Export export the transactions between start and end. The histories are requested in
+// sequence to stay within the request weight limits. On failure of a history, the ledger
+// of the others is returned together with an error joining one *SourceError per history.
+func (e *Exporter) Export(ctx context.Context, start, end time.Time) (*Ledger, error) {
+	if !start.Before(end) {
+		return nil, errors.New("ledger: start not before end")
+	}
+	l := &Ledger{Start: start, End: end}
+	seen := map[string]bool{}
+	var errs []error
+	for _, x := range e.exporters() {
+		txs, err := x.export(ctx, start, end)
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+		if err != nil {
+			errs = append(errs, &SourceError{Source: x.source, Err: err})
+			continue
+		}
+		for i := range txs {
+			txs[i].Source = x.source
+		}
+		l.add(seen, txs...)
+	}
+	l.sort()
+	return l, multierr.Join(errs...)
+}
+
+// fetchWindow fetch the records of [start, end], more reports a full page which may
+// leave records out
+type fetchWindow[T any] func(ctx context.Context, start, end int64) (records []T, more bool, err error)
+
+// collect fetch the records of [start, end) in windows of at most size, splitting in
+// halves the windows with more records than a page
+func collect[T any](ctx context.Context, start, end time.Time, size time.Duration, fetch fetchWindow[T]) ([]T, error) {
+	var all []T
+	for s := start; s.Before(end); s = s.Add(size) {
+		e := s.Add(size)
+		if e.After(end) {
+			e = end
+		}
+		records, err := split(ctx, s.UnixMilli(), e.UnixMilli(), fetch)
+		if err != nil {
+			return nil, err
+		}
+		all = append(all, records...)
+	}
+	return all, nil
+}
+
+// split fetch the records of [start, end) in milliseconds
+func split[T any](ctx context.Context, start, end int64, fetch fetchWindow[T]) ([]T, error) {
+	records, more, err := fetch(ctx, start, end-1)
+	if err != nil || !more || end-start <= 1 {
+		return records, err
+	}
+	mid := start + (end-start)/2
+	left, err := split(ctx, start, mid, fetch)
+	if err != nil {
+		return nil, err
+	}
+	right, err := split(ctx, mid, end, fetch)
+	if err != nil {
+		return nil, err
+	}
+	return append(left, right...), nil
+}
@@ -0,0 +1,262 @@
+package ledger
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/adshao/go-binance/v2"
+	"github.com/adshao/go-binance/v2/futures"
+)
+
+type exportTestSuite struct {
+	suite.Suite
+	server *httptest.Server
+	e      *Exporter
+	start  time.Time
+	end    time.Time
+
+	mu     sync.Mutex
+	paths  []string
+	trades [][2]int64 // trade windows requested
+	fail   map[string]string
+	amount string // deposit amount
+}
+
+func TestExport(t *testing.T) {
+	suite.Run(t, new(exportTestSuite))
+}
+
+func (s *exportTestSuite) SetupTest() {
+	s.paths = nil
+	s.trades = nil
+	s.fail = map[string]string{}
+	s.amount = "1000"
+	s.server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_ = r.ParseForm()
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		s.paths = append(s.paths, r.URL.Path)
+		w.Header().Set("Content-Type", "application/json")
+		if body, ok := s.fail[r.URL.Path]; ok {
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(body))
+			return
+		}
+		_, _ = w.Write([]byte(s.respond(r.URL.Path, r.Form)))
+	}))
+	c := binance.NewClient("dummy", "dummy")
+	c.BaseURL = s.server.URL
+	s.e = NewExporter(c)
+	s.e.Symbols = []string{"BTCUSDT"}
+	s.e.Futures = futures.NewClient("dummy", "dummy")
+	s.e.Futures.BaseURL = s.server.URL
+	s.start = time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	s.end = s.start.Add(24 * time.Hour)
+}
+
+func (s *exportTestSuite) TearDownTest() {
+	s.server.Close()
+}
+
+func (s *exportTestSuite) ms(d time.Duration) int64 {
+	return s.start.Add(d).UnixMilli()
+}
+
+func (s *exportTestSuite) respond(path string, params url.Values) string {
+	switch path {
+	case "/api/v3/exchangeInfo":
+		return `{"symbols": [{"symbol": "BTCUSDT", "baseAsset": "BTC", "quoteAsset": "USDT"}]}`
+	case "/api/v3/myTrades":
+		start, _ := strconv.ParseInt(params.Get("startTime"), 10, 64)
+		end, _ := strconv.ParseInt(params.Get("endTime"), 10, 64)
+		s.trades = append(s.trades, [2]int64{start, end})
+		if end-start+1 == (24 * time.Hour).Milliseconds() {
+			// a full page, the window is split
+			trades := make([]string, tradesLimit)
+			for i := range trades {
+				trades[i] = fmt.Sprintf(`{"id": %d, "symbol": "BTCUSDT", "qty": "1", "quoteQty": "1", "time": %d}`, 100+i, start)
+			}
+			return "[" + strings.Join(trades, ",") + "]"
+		}
+		if start == s.ms(0) {
+			return fmt.Sprintf(`[{"id": 1, "orderId": 10, "symbol": "BTCUSDT", "qty": "0.1", "quoteQty": "3000",
+				"commission": "0.0001", "commissionAsset": "BTC", "time": %d, "isBuyer": true}]`, s.ms(time.Hour))
+		}
+		return fmt.Sprintf(`[{"id": 2, "orderId": 11, "symbol": "BTCUSDT", "qty": "0.05", "quoteQty": "1600",
+			"commission": "1.6", "commissionAsset": "USDT", "time": %d, "isBuyer": false}]`, s.ms(13*time.Hour))
+	case "/sapi/v1/capital/deposit/hisrec":
+		return fmt.Sprintf(`[
+			{"amount": %q, "coin": "USDT", "network": "TRX", "status": 1, "txId": "0xdeposit", "insertTime": %d},
+			{"amount": "5", "coin": "USDT", "network": "TRX", "status": 0, "txId": "0xpending", "insertTime": %d}
+		]`, s.amount, s.ms(0), s.ms(time.Hour))
+	case "/sapi/v1/capital/withdraw/history":
+		return `[{"id": "w1", "amount": "0.01", "transactionFee": "0.0005", "coin": "BTC", "network": "BTC",
+			"status": 6, "txId": "0xwithdraw", "applyTime": "2024-01-01 02:00:00"}]`
+	case "/sapi/v1/asset/dribblet":
+		return fmt.Sprintf(`{"total": 1, "userAssetDribblets": [{"operateTime": %d, "transId": 7, "userAssetDribbletDetails": [
+			{"transId": 7, "serviceChargeAmount": "0.00001", "amount": "0.001", "transferedAmount": "0.0002", "fromAsset": "ETH"},
+			{"transId": 7, "serviceChargeAmount": "0.00001", "amount": "1", "transferedAmount": "0.0003", "fromAsset": "ADA"}
+		]}]}`, s.ms(3*time.Hour))
+	case "/sapi/v1/asset/assetDividend":
+		return fmt.Sprintf(`{"rows": [{"id": 3, "amount": "0.01", "asset": "BNB", "divTime": %d, "enInfo": "BNB distribution", "tranId": 30}], "total": 1}`, s.ms(4*time.Hour))
+	case "/sapi/v1/convert/tradeFlow":
+		return fmt.Sprintf(`{"list": [
+			{"orderId": 4, "orderStatus": "SUCCESS", "fromAsset": "USDT", "fromAmount": "100", "toAsset": "BNB", "toAmount": "0.5", "createTime": %d},
+			{"orderId": 5, "orderStatus": "PROCESS", "fromAsset": "USDT", "fromAmount": "100", "toAsset": "BNB", "toAmount": "0.5", "createTime": %d}
+		], "moreData": false}`, s.ms(5*time.Hour), s.ms(5*time.Hour))
+	case "/sapi/v1/c2c/orderMatch/listUserOrderHistory":
+		if params.Get("tradeType") != "BUY" {
+			return `{"data": [], "total": 0}`
+		}
+		return fmt.Sprintf(`{"data": [{"orderNumber": "c1", "tradeType": "BUY", "asset": "USDT", "fiat": "EUR", "amount": "200",
+			"totalPrice": "180", "orderStatus": "COMPLETED", "createTime": %d, "commission": "0"}], "total": 1}`, s.ms(6*time.Hour))
+	case "/sapi/v1/fiat/orders":
+		if params.Get("transactionType") != "0" {
+			return `{"data": [], "total": 0}`
+		}
+		return fmt.Sprintf(`{"data": [{"orderNo": "f1", "fiatCurrency": "EUR", "indicatedAmount": "101", "amount": "100",
+			"totalFee": "1", "method": "card", "status": "Successful", "createTime": %d}], "total": 1}`, s.ms(7*time.Hour))
+	case "/sapi/v1/fiat/payments":
+		if params.Get("transactionType") != "0" {
+			return `{"data": [], "total": 0}`
+		}
+		return fmt.Sprintf(`{"data": [{"orderNo": "p1", "sourceAmount": "50", "fiatCurrency": "EUR", "obtainAmount": "0.001",
+			"cryptoCurrency": "BTC", "totalFee": "1", "status": "Completed", "createTime": %d}], "total": 1}`, s.ms(8*time.Hour))
+	case "/sapi/v1/pay/transactions":
+		return fmt.Sprintf(`{"data": [{"orderType": "C2C", "transactionId": "t1", "transactionTime": %d, "amount": "-10", "currency": "USDT"}]}`, s.ms(9*time.Hour))
+	case "/sapi/v1/margin/interestHistory":
+		return fmt.Sprintf(`{"rows": [{"txId": 8, "interestAccuredTime": %d, "asset": "USDT", "interest": "0.5", "type": "PERIODIC"}], "total": 1}`, s.ms(10*time.Hour))
+	case "/sapi/v1/simple-earn/flexible/history/rewardsRecord":
+		return fmt.Sprintf(`{"rows": [{"asset": "USDT", "rewards": "0.1", "projectId": "USDT001", "type": "REALTIME", "time": %d}], "total": 1}`, s.ms(11*time.Hour))
+	case "/sapi/v1/simple-earn/locked/history/rewardsRecord":
+		return fmt.Sprintf(`{"rows": [{"positionId": "9", "time": %d, "asset": "BNB", "lockPeriod": "30", "amount": "0.2", "type": "Locked Rewards"}], "total": 1}`, s.ms(11*time.Hour))
+	case "/fapi/v1/income":
+		return fmt.Sprintf(`[
+			{"symbol": "BTCUSDT", "incomeType": "REALIZED_PNL", "income": "50", "asset": "USDT", "time": %d, "tranId": 1},
+			{"symbol": "BTCUSDT", "incomeType": "COMMISSION", "income": "-2", "asset": "USDT", "time": %d, "tranId": 2},
+			{"incomeType": "TRANSFER", "income": "1000", "asset": "USDT", "time": %d, "tranId": 3}
+		]`, s.ms(12*time.Hour), s.ms(12*time.Hour), s.ms(12*time.Hour))
+	}
+	return `[]`
+}
+
+func (s *exportTestSuite) dec(v string) decimal.Decimal {
+	return decimal.RequireFromString(v)
+}
+
+func (s *exportTestSuite) assertBalances(expected map[Account]map[string]string, actual map[Account]map[string]decimal.Decimal) {
+	s.Require().Len(actual, len(expected))
+	for account, assets := range expected {
+		s.Require().Len(actual[account], len(assets), string(account))
+		for asset, amount := range assets {
+			s.True(s.dec(amount).Equal(actual[account][asset]), "%s %s: %s", account, asset, actual[account][asset])
+		}
+	}
+}
+
+func (s *exportTestSuite) TestExport() {
+	l, err := s.e.Export(context.Background(), s.start, s.end)
+	s.Require().NoError(err)
+	s.Len(l.Transactions, 17)
+	for i, t := range l.Transactions {
+		s.NoError(t.Balanced())
+		if i > 0 {
+			s.False(t.Time.Before(l.Transactions[i-1].Time))
+		}
+	}
+	s.assertBalances(map[Account]map[string]string{
+		AccountSpot: {
+			"BTC":  "0.0404",
+			"USDT": "-311.6",
+			"ETH":  "-0.001",
+			"ADA":  "-1",
+			"BNB":  "0.5105",
+			"EUR":  "100",
+		},
+		AccountMargin:  {"USDT": "-0.5"},
+		AccountEarn:    {"USDT": "0.1", "BNB": "0.2"},
+		AccountFutures: {"USDT": "48"},
+	}, l.Balances())
+
+	// the full window is split in halves
+	s.Equal([][2]int64{
+		{s.ms(0), s.ms(24*time.Hour) - 1},
+		{s.ms(0), s.ms(12*time.Hour) - 1},
+		{s.ms(12 * time.Hour), s.ms(24*time.Hour) - 1},
+	}, s.trades)
+
+	withdrawal := l.Transactions[2]
+	s.Equal("withdrawal:w1", withdrawal.ID)
+	s.Equal(SourceWithdrawals, withdrawal.Source)
+	s.Equal(time.Date(2024, 1, 1, 2, 0, 0, 0, time.UTC), withdrawal.Time)
+	s.Equal("0xwithdraw", withdrawal.TxHash)
+}
+
+func (s *exportTestSuite) TestExportSources() {
+	s.e.Sources = []Source{SourceDeposits, SourceFuturesIncome}
+	l, err := s.e.Export(context.Background(), s.start, s.end)
+	s.Require().NoError(err)
+	s.Len(l.Transactions, 3)
+	s.Equal([]string{"/sapi/v1/capital/deposit/hisrec", "/fapi/v1/income"}, s.paths)
+
+	s.e.Futures = nil
+	s.paths = nil
+	_, err = s.e.Export(context.Background(), s.start, s.end)
+	s.Require().NoError(err)
+	s.Equal([]string{"/sapi/v1/capital/deposit/hisrec"}, s.paths)
+}
+
+func (s *exportTestSuite) TestExportWindows() {
+	s.e.Sources = []Source{SourceDeposits}
+	_, err := s.e.Export(context.Background(), s.start, s.start.Add(200*24*time.Hour))
+	s.Require().NoError(err)
+	s.Len(s.paths, 3)
+}
+
+func (s *exportTestSuite) TestExportSourceError() {
+	s.fail["/sapi/v1/asset/assetDividend"] = `{"code": -1002, "msg": "unauthorized"}`
+	l, err := s.e.Export(context.Background(), s.start, s.end)
+	var sourceErr *SourceError
+	s.Require().True(errors.As(err, &sourceErr))
+	s.Equal(SourceDividends, sourceErr.Source)
+	s.ErrorContains(err, "ledger: export DIVIDENDS: <APIError> code=-1002")
+	s.Len(l.Transactions, 16)
+}
+
+func (s *exportTestSuite) TestExportSkipped() {
+	s.amount = "x"
+	l, err := s.e.Export(context.Background(), s.start, s.end)
+	s.Require().NoError(err)
+	s.Len(l.Transactions, 16)
+	s.Require().Len(l.Skipped, 1)
+	s.Equal("deposit:0xdeposit:USDT", l.Skipped[0].Transaction.ID)
+	s.Equal(SourceDeposits, l.Skipped[0].Transaction.Source)
+	s.ErrorContains(l.Skipped[0].Err, `ledger: transaction deposit:0xdeposit:USDT: invalid amount "x"`)
+}
+
+func (s *exportTestSuite) TestExportInvalid() {
+	s.amount = "x"
+	s.e.Sources = []Source{SourceDeposits}
+	l, err := s.e.Export(context.Background(), s.start, s.end)
+	s.Require().NoError(err)
+	s.Empty(l.Transactions)
+
+	s.e.Strict = true
+	_, err = s.e.Export(context.Background(), s.start, s.end)
+	s.ErrorContains(err, `ledger: export DEPOSITS: ledger: transaction deposit:0xdeposit:USDT: invalid amount "x"`)
+
+	_, err = s.e.Export(context.Background(), s.end, s.start)
+	s.Error(err)
+}
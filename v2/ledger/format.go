@@ -0,0 +1,205 @@
+package ledger
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// Format define the format of a written ledger
+type Format string
+
+// Global enums
+const (
+	// FormatLedger writes a CSV row per entry
+	FormatLedger Format = "LEDGER"
+	// FormatKoinly writes the Koinly universal CSV
+	FormatKoinly Format = "KOINLY"
+	// FormatCoinTracking writes the CoinTracking CSV import
+	FormatCoinTracking Format = "COINTRACKING"
+	// FormatJSON writes the transactions as a JSON array
+	FormatJSON Format = "JSON"
+)
+
+const (
+	exchangeName       = "Binance"
+	koinlyTimeLayout   = "2006-01-02 15:04 UTC"
+	csvTimeLayout      = "2006-01-02 15:04:05"
+	coinTrackingLayout = "02.01.2006 15:04:05"
+)
+
+var (
+	ledgerHeader       = []string{"ID", "Time", "Source", "Kind", "Account", "Asset", "Amount", "Description", "TxHash"}
+	koinlyHeader       = []string{"Date", "Sent Amount", "Sent Currency", "Received Amount", "Received Currency", "Fee Amount", "Fee Currency", "Net Worth Amount", "Net Worth Currency", "Label", "Description", "TxHash"}
+	coinTrackingHeader = []string{"Type", "Buy Amount", "Buy Currency", "Sell Amount", "Sell Currency", "Fee", "Fee Currency", "Exchange", "Trade-Group", "Comment", "Date", "Tx-ID"}
+)
+
+// Write write the ledger to w in format
+func (l *Ledger) Write(w io.Writer, format Format) error {
+	switch format {
+	case FormatLedger:
+		return l.writeCSV(w, ledgerHeader, l.ledgerRows)
+	case FormatKoinly:
+		return l.writeCSV(w, koinlyHeader, koinlyRows)
+	case FormatCoinTracking:
+		return l.writeCSV(w, coinTrackingHeader, coinTrackingRows)
+	case FormatJSON:
+		enc := json.NewEncoder(w)
+		enc.SetIndent("", "  ")
+		return enc.Encode(l.Transactions)
+	}
+	return fmt.Errorf("ledger: unknown format %q", format)
+}
+
+func (l *Ledger) writeCSV(w io.Writer, header []string, rows func(t *Transaction) [][]string) error {
+	cw := csv.NewWriter(w)
+	if err := cw.Write(header); err != nil {
+		return err
+	}
+	for i := range l.Transactions {
+		if err := cw.WriteAll(rows(&l.Transactions[i])); err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+func (l *Ledger) ledgerRows(t *Transaction) [][]string {
+	rows := make([][]string, 0, len(t.Entries))
+	for _, e := range t.Entries {
+		rows = append(rows, []string{
+			t.ID, t.Time.UTC().Format(csvTimeLayout), string(t.Source), string(t.Kind),
+			string(e.Account), e.Asset, e.Amount.String(), t.Description, t.TxHash,
+		})
+	}
+	return rows
+}
+
+// koinlyLabel return the Koinly label of a transaction, empty for the trades and transfers
+func koinlyLabel(k Kind, received bool) string {
+	switch k {
+	case KindDividend, KindFundingFee, KindFuturesIncome:
+		if received {
+			return "income"
+		}
+		return "cost"
+	case KindEarnReward:
+		return "reward"
+	case KindFuturesPnL:
+		return "realized gain"
+	case KindMarginInterest:
+		return "loan fee"
+	case KindFuturesFee:
+		return "cost"
+	}
+	return ""
+}
+
+// coinTrackingType return the CoinTracking type of a transaction
+func coinTrackingType(k Kind, received, sent bool) string {
+	switch k {
+	case KindDividend, KindFuturesIncome:
+		if received {
+			return "Income"
+		}
+		return "Other Fee"
+	case KindEarnReward:
+		return "Staking"
+	case KindFundingFee, KindFuturesPnL:
+		if received {
+			return "Derivatives / Futures Profit"
+		}
+		return "Derivatives / Futures Loss"
+	case KindMarginInterest:
+		return "Margin Fee"
+	case KindFuturesFee:
+		return "Other Fee"
+	}
+	switch {
+	case received && sent:
+		return "Trade"
+	case received:
+		return "Deposit"
+	}
+	return "Withdrawal"
+}
+
+// flowRows return the flows of t as rows of a sent, a received and a fee flow. A single
+// row holds a transaction of at most one asset sent and one received, others are written
+// as a row per flow with the fees on the first one.
+func flowRows(t *Transaction) [][3]Flow {
+	received, sent, fees := t.Flows()
+	if len(received) <= 1 && len(sent) <= 1 && len(fees) <= 1 {
+		var row [3]Flow
+		if len(sent) == 1 {
+			row[0] = sent[0]
+		}
+		if len(received) == 1 {
+			row[1] = received[0]
+		}
+		if len(fees) == 1 {
+			row[2] = fees[0]
+		}
+		return [][3]Flow{row}
+	}
+	var rows [][3]Flow
+	for _, f := range sent {
+		rows = append(rows, [3]Flow{f, {}, {}})
+	}
+	for _, f := range received {
+		rows = append(rows, [3]Flow{{}, f, {}})
+	}
+	for i, f := range fees {
+		if i < len(rows) {
+			rows[i][2] = f
+		} else {
+			rows = append(rows, [3]Flow{{}, {}, f})
+		}
+	}
+	return rows
+}
+
+func amount(f Flow) string {
+	if f.Asset == "" {
+		return ""
+	}
+	return f.Amount.String()
+}
+
+func koinlyRows(t *Transaction) [][]string {
+	var rows [][]string
+	for _, r := range flowRows(t) {
+		sent, received, fee := r[0], r[1], r[2]
+		rows = append(rows, []string{
+			t.Time.UTC().Format(koinlyTimeLayout), amount(sent), sent.Asset, amount(received), received.Asset,
+			amount(fee), fee.Asset, "", "", koinlyLabel(t.Kind, received.Asset != ""), t.Description, t.TxHash,
+		})
+	}
+	return rows
+}
+
+func coinTrackingRows(t *Transaction) [][]string {
+	var rows [][]string
+	for i, r := range flowRows(t) {
+		sent, received, fee := r[0], r[1], r[2]
+		// the transaction id identifies the rows on later imports
+		id := t.ID
+		if i > 0 {
+			id = fmt.Sprintf("%s:%d", t.ID, i)
+		}
+		typ := coinTrackingType(t.Kind, received.Asset != "", sent.Asset != "")
+		if sent.Asset == "" && received.Asset == "" {
+			typ = "Other Fee"
+			sent, fee = fee, Flow{}
+		}
+		rows = append(rows, []string{
+			typ, amount(received), received.Asset, amount(sent), sent.Asset, amount(fee), fee.Asset,
+			exchangeName, string(t.Kind), strings.TrimSpace(t.Description + " " + t.TxHash),
+			t.Time.UTC().Format(coinTrackingLayout), id,
+		})
+	}
+	return rows
+}
@@ -0,0 +1,226 @@
+// Package ledger exports the transaction history of a Binance account as a double-entry
+// ledger: spot trades, deposits and withdrawals, dust conversions, asset dividends,
+// convert trades, C2C orders, fiat deposits, withdrawals and payments, Binance Pay,
+// USD-M futures income, margin interest and Simple Earn rewards.
+//
+// Every transaction is made of entries moving assets between accounts, the entries of
+// each asset summing to zero. Wallet accounts (spot, margin, futures, earn) hold the
+// assets, nominal accounts (external, trading, income, fees, interest) are their
+// counterparts:
+//
+//	e := ledger.NewExporter(client)
+//	e.Symbols = []string{"BTCUSDT", "ETHUSDT"}
+//	l, err := e.Export(ctx, start, end)
+//	err = l.Write(file, ledger.FormatKoinly)
+package ledger
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// Kind define the kind of a transaction
+type Kind string
+
+// Account define an account of the ledger
+type Account string
+
+// Global enums
+const (
+	KindTrade          Kind = "TRADE"
+	KindConvert        Kind = "CONVERT"
+	KindDust           Kind = "DUST"
+	KindDeposit        Kind = "DEPOSIT"
+	KindWithdrawal     Kind = "WITHDRAWAL"
+	KindDividend       Kind = "DIVIDEND"
+	KindC2C            Kind = "C2C"
+	KindFiatDeposit    Kind = "FIAT_DEPOSIT"
+	KindFiatWithdrawal Kind = "FIAT_WITHDRAWAL"
+	KindFiatPayment    Kind = "FIAT_PAYMENT"
+	KindPay            Kind = "PAY"
+	KindFuturesPnL     Kind = "FUTURES_PNL"
+	KindFundingFee     Kind = "FUNDING_FEE"
+	KindFuturesFee     Kind = "FUTURES_FEE"
+	KindFuturesIncome  Kind = "FUTURES_INCOME"
+	KindMarginInterest Kind = "MARGIN_INTEREST"
+	KindEarnReward     Kind = "EARN_REWARD"
+
+	AccountSpot    Account = "spot"
+	AccountMargin  Account = "margin"
+	AccountFutures Account = "futures"
+	AccountEarn    Account = "earn"
+
+	AccountExternal Account = "external"
+	AccountTrading  Account = "trading"
+	AccountIncome   Account = "income"
+	AccountFees     Account = "fees"
+	AccountInterest Account = "interest"
+)
+
+// IsWallet return whether the account holds assets of the user
+func (a Account) IsWallet() bool {
+	switch a {
+	case AccountSpot, AccountMargin, AccountFutures, AccountEarn:
+		return true
+	}
+	return false
+}
+
+// Entry define the change of the balance of an asset in an account
+type Entry struct {
+	Account Account
+	Asset   string
+	Amount  decimal.Decimal
+}
+
+// Transaction define a balanced set of entries
+type Transaction struct {
+	ID          string // unique, prefixed by the source
+	Time        time.Time
+	Source      Source
+	Kind        Kind
+	Description string
+	TxHash      string
+	// Entries come in pairs moving an amount from the first account to the second
+	Entries []Entry
+
+	err error // normalization failure of a skipped transaction
+}
+
+// SkippedTransaction define a transaction left out of a ledger as it could not be normalized
+type SkippedTransaction struct {
+	Transaction Transaction
+	Err         error
+}
+
+// move add the entries moving amount of asset from an account to another, negative
+// amounts move the other way
+func (t *Transaction) move(from, to Account, asset string, amount decimal.Decimal) *Transaction {
+	if amount.IsZero() {
+		return t
+	}
+	t.Entries = append(t.Entries,
+		Entry{Account: from, Asset: asset, Amount: amount.Neg()},
+		Entry{Account: to, Asset: asset, Amount: amount},
+	)
+	return t
+}
+
+// Balanced return an error when the entries of an asset do not sum to zero
+func (t *Transaction) Balanced() error {
+	sums := map[string]decimal.Decimal{}
+	for _, e := range t.Entries {
+		sums[e.Asset] = sums[e.Asset].Add(e.Amount)
+	}
+	for asset, sum := range sums {
+		if !sum.IsZero() {
+			return fmt.Errorf("ledger: transaction %s unbalanced by %s %s", t.ID, sum, asset)
+		}
+	}
+	return nil
+}
+
+// Flow define the amount of an asset
+type Flow struct {
+	Asset  string
+	Amount decimal.Decimal
+}
+
+// Flows return the assets received and sent by the user, and the fees paid, netted per
+// asset. The user holds the wallet accounts, and the external account where it trades
+// with the trading account, like the fiat paid outside of Binance in C2C orders.
+// Fees are not included in sent.
+func (t *Transaction) Flows() (received, sent, fees []Flow) {
+	net := map[string]decimal.Decimal{}
+	fee := map[string]decimal.Decimal{}
+	var assets []string
+	seen := map[string]bool{}
+	own := func(a, counterpart Account) bool {
+		return a.IsWallet() || a == AccountExternal && counterpart == AccountTrading
+	}
+	// entries come in pairs moving an amount from the first account to the second
+	for i := 0; i+1 < len(t.Entries); i += 2 {
+		from, to := t.Entries[i], t.Entries[i+1]
+		if !seen[to.Asset] {
+			seen[to.Asset] = true
+			assets = append(assets, to.Asset)
+		}
+		switch {
+		case to.Account == AccountFees:
+			fee[to.Asset] = fee[to.Asset].Add(to.Amount)
+		case from.Account == AccountFees:
+			fee[to.Asset] = fee[to.Asset].Sub(to.Amount)
+		case own(from.Account, to.Account) && !own(to.Account, from.Account):
+			net[to.Asset] = net[to.Asset].Sub(to.Amount)
+		case own(to.Account, from.Account) && !own(from.Account, to.Account):
+			net[to.Asset] = net[to.Asset].Add(to.Amount)
+		}
+	}
+	for _, asset := range assets {
+		if n := net[asset]; n.IsPositive() {
+			received = append(received, Flow{Asset: asset, Amount: n})
+		} else if n.IsNegative() {
+			sent = append(sent, Flow{Asset: asset, Amount: n.Neg()})
+		}
+		if fee[asset].IsPositive() {
+			fees = append(fees, Flow{Asset: asset, Amount: fee[asset]})
+		}
+	}
+	return received, sent, fees
+}
+
+// Ledger define the transactions of an account, ordered by time
+type Ledger struct {
+	Start        time.Time
+	End          time.Time
+	Transactions []Transaction
+	// Skipped are the transactions that could not be normalized, when the export is not strict
+	Skipped []SkippedTransaction
+}
+
+// add append transactions, ignoring the ones already in the ledger
+func (l *Ledger) add(seen map[string]bool, txs ...Transaction) {
+	for _, t := range txs {
+		if t.err != nil {
+			err := t.err
+			t.err = nil
+			l.Skipped = append(l.Skipped, SkippedTransaction{Transaction: t, Err: err})
+			continue
+		}
+		if seen[t.ID] || len(t.Entries) == 0 {
+			continue
+		}
+		seen[t.ID] = true
+		l.Transactions = append(l.Transactions, t)
+	}
+}
+
+func (l *Ledger) sort() {
+	sort.SliceStable(l.Transactions, func(i, j int) bool {
+		a, b := l.Transactions[i], l.Transactions[j]
+		if !a.Time.Equal(b.Time) {
+			return a.Time.Before(b.Time)
+		}
+		return a.ID < b.ID
+	})
+}
+
+// Balances return the net change of every asset in the wallet accounts
+func (l *Ledger) Balances() map[Account]map[string]decimal.Decimal {
+	res := map[Account]map[string]decimal.Decimal{}
+	for _, t := range l.Transactions {
+		for _, e := range t.Entries {
+			if !e.Account.IsWallet() {
+				continue
+			}
+			if res[e.Account] == nil {
+				res[e.Account] = map[string]decimal.Decimal{}
+			}
+			res[e.Account][e.Asset] = res[e.Account][e.Asset].Add(e.Amount)
+		}
+	}
+	return res
+}
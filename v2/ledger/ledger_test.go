@@ -0,0 +1,123 @@
+package ledger
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/suite"
+)
+
+type ledgerTestSuite struct {
+	suite.Suite
+	l *Ledger
+}
+
+func TestLedger(t *testing.T) {
+	suite.Run(t, new(ledgerTestSuite))
+}
+
+func (s *ledgerTestSuite) dec(v string) decimal.Decimal {
+	return decimal.RequireFromString(v)
+}
+
+func (s *ledgerTestSuite) SetupTest() {
+	at := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	trade := Transaction{ID: "trade:BTCUSDT:1", Time: at, Source: SourceTrades, Kind: KindTrade, Description: "BTCUSDT order 10"}
+	trade.move(AccountTrading, AccountSpot, "BTC", s.dec("0.1")).
+		move(AccountSpot, AccountTrading, "USDT", s.dec("3000")).
+		move(AccountSpot, AccountFees, "BNB", s.dec("0.01"))
+	deposit := Transaction{ID: "deposit:0xabc:USDT", Time: at.Add(-time.Hour), Source: SourceDeposits, Kind: KindDeposit, TxHash: "0xabc"}
+	deposit.move(AccountExternal, AccountSpot, "USDT", s.dec("5000"))
+	payment := Transaction{ID: "fiat-payment:p1", Time: at.Add(time.Hour), Source: SourceFiat, Kind: KindFiatPayment}
+	payment.move(AccountExternal, AccountTrading, "EUR", s.dec("49")).
+		move(AccountExternal, AccountFees, "EUR", s.dec("1")).
+		move(AccountTrading, AccountSpot, "BTC", s.dec("0.001"))
+	reward := Transaction{ID: "dividend:3", Time: at.Add(2 * time.Hour), Source: SourceDividends, Kind: KindDividend}
+	reward.move(AccountIncome, AccountSpot, "BNB", s.dec("0.02"))
+	s.l = &Ledger{}
+	s.l.add(map[string]bool{}, trade, deposit, payment, reward, Transaction{ID: "empty"})
+	s.l.sort()
+}
+
+func (s *ledgerTestSuite) TestLedger() {
+	s.Require().Len(s.l.Transactions, 4)
+	s.Equal("deposit:0xabc:USDT", s.l.Transactions[0].ID)
+	balances := s.l.Balances()
+	s.True(s.dec("0.101").Equal(balances[AccountSpot]["BTC"]))
+	s.True(s.dec("2000").Equal(balances[AccountSpot]["USDT"]))
+	s.True(s.dec("0.01").Equal(balances[AccountSpot]["BNB"]))
+	s.NotContains(balances, AccountTrading)
+}
+
+func (s *ledgerTestSuite) TestBalanced() {
+	t := s.l.Transactions[1]
+	s.NoError(t.Balanced())
+	t.Entries = append(t.Entries, Entry{Account: AccountSpot, Asset: "BTC", Amount: s.dec("1")})
+	s.EqualError(t.Balanced(), "ledger: transaction trade:BTCUSDT:1 unbalanced by 1 BTC")
+}
+
+func (s *ledgerTestSuite) TestFlows() {
+	received, sent, fees := s.l.Transactions[1].Flows()
+	s.Equal([]Flow{{Asset: "BTC", Amount: s.dec("0.1")}}, received)
+	s.Equal([]Flow{{Asset: "USDT", Amount: s.dec("3000")}}, sent)
+	s.Equal([]Flow{{Asset: "BNB", Amount: s.dec("0.01")}}, fees)
+
+	// the fiat is paid from outside of Binance
+	received, sent, fees = s.l.Transactions[2].Flows()
+	s.Equal([]Flow{{Asset: "BTC", Amount: s.dec("0.001")}}, received)
+	s.Equal([]Flow{{Asset: "EUR", Amount: s.dec("49")}}, sent)
+	s.Equal([]Flow{{Asset: "EUR", Amount: s.dec("1")}}, fees)
+
+	received, sent, fees = s.l.Transactions[0].Flows()
+	s.Equal([]Flow{{Asset: "USDT", Amount: s.dec("5000")}}, received)
+	s.Empty(sent)
+	s.Empty(fees)
+}
+
+func (s *ledgerTestSuite) TestWriteLedger() {
+	var buf bytes.Buffer
+	s.Require().NoError(s.l.Write(&buf, FormatLedger))
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	s.Len(lines, 1+2+6+6+2)
+	s.Equal("ID,Time,Source,Kind,Account,Asset,Amount,Description,TxHash", lines[0])
+	s.Equal("deposit:0xabc:USDT,2024-01-02 02:04:05,DEPOSITS,DEPOSIT,external,USDT,-5000,,0xabc", lines[1])
+	s.Equal("trade:BTCUSDT:1,2024-01-02 03:04:05,TRADES,TRADE,spot,BTC,0.1,BTCUSDT order 10,", lines[4])
+}
+
+func (s *ledgerTestSuite) TestWriteKoinly() {
+	var buf bytes.Buffer
+	s.Require().NoError(s.l.Write(&buf, FormatKoinly))
+	s.Equal(strings.Join([]string{
+		"Date,Sent Amount,Sent Currency,Received Amount,Received Currency,Fee Amount,Fee Currency,Net Worth Amount,Net Worth Currency,Label,Description,TxHash",
+		"2024-01-02 02:04 UTC,,,5000,USDT,,,,,,,0xabc",
+		"2024-01-02 03:04 UTC,3000,USDT,0.1,BTC,0.01,BNB,,,,BTCUSDT order 10,",
+		"2024-01-02 04:04 UTC,49,EUR,0.001,BTC,1,EUR,,,,,",
+		"2024-01-02 05:04 UTC,,,0.02,BNB,,,,,income,,",
+	}, "\n")+"\n", buf.String())
+}
+
+func (s *ledgerTestSuite) TestWriteCoinTracking() {
+	var buf bytes.Buffer
+	s.Require().NoError(s.l.Write(&buf, FormatCoinTracking))
+	s.Equal(strings.Join([]string{
+		"Type,Buy Amount,Buy Currency,Sell Amount,Sell Currency,Fee,Fee Currency,Exchange,Trade-Group,Comment,Date,Tx-ID",
+		"Deposit,5000,USDT,,,,,Binance,DEPOSIT,0xabc,02.01.2024 02:04:05,deposit:0xabc:USDT",
+		"Trade,0.1,BTC,3000,USDT,0.01,BNB,Binance,TRADE,BTCUSDT order 10,02.01.2024 03:04:05,trade:BTCUSDT:1",
+		"Trade,0.001,BTC,49,EUR,1,EUR,Binance,FIAT_PAYMENT,,02.01.2024 04:04:05,fiat-payment:p1",
+		"Income,0.02,BNB,,,,,Binance,DIVIDEND,,02.01.2024 05:04:05,dividend:3",
+	}, "\n")+"\n", buf.String())
+}
+
+func (s *ledgerTestSuite) TestWriteJSON() {
+	var buf bytes.Buffer
+	s.Require().NoError(s.l.Write(&buf, FormatJSON))
+	var txs []Transaction
+	s.Require().NoError(json.Unmarshal(buf.Bytes(), &txs))
+	s.Equal(s.l.Transactions, txs)
+
+	s.EqualError(s.l.Write(&buf, "XLS"), `ledger: unknown format "XLS"`)
+}
@@ -0,0 +1,623 @@
+package ledger
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/adshao/go-binance/v2"
+	"github.com/adshao/go-binance/v2/futures"
+	"github.com/adshao/go-binance/v2/internal/decparse"
+)
+
+const (
+	day = 24 * time.Hour
+
+	tradesWindow   = day
+	tradesLimit    = 1000
+	walletWindow   = 90 * day
+	walletLimit    = 1000
+	dustLimit      = 100
+	dividendWindow = 180 * day
+	dividendLimit  = 500
+	convertWindow  = 30 * day
+	convertLimit   = 1000
+	c2cWindow      = 30 * day
+	fiatWindow     = 90 * day
+	pageRows       = 100
+	payLimit       = 100
+	incomeWindow   = 7 * day
+	incomeLimit    = 1000
+	interestWindow = 30 * day
+	rewardsWindow  = 90 * day
+
+	depositStatusSuccess        = 1
+	depositStatusCreditedLocked = 6
+	withdrawStatusCompleted     = 6
+
+	withdrawTimeLayout = "2006-01-02 15:04:05"
+)
+
+// keep append t, its normalization failure fails the export when strict, otherwise it
+// is appended with the failure to be recorded as skipped
+func (e *Exporter) keep(txs []Transaction, t *Transaction, err error) ([]Transaction, error) {
+	if err != nil {
+		err = fmt.Errorf("ledger: transaction %s: %w", t.ID, err)
+	} else {
+		err = t.Balanced()
+	}
+	if err != nil && e.Strict {
+		return nil, err
+	}
+	t.err = err
+	return append(txs, *t), nil
+}
+
+func millis(ms int64) time.Time {
+	return time.UnixMilli(ms).UTC()
+}
+
+func (e *Exporter) trades(ctx context.Context, start, end time.Time) ([]Transaction, error) {
+	var txs []Transaction
+	for _, symbol := range e.Symbols {
+		trades, err := collect(ctx, start, end, tradesWindow, func(ctx context.Context, start, end int64) ([]*binance.TradeV3, bool, error) {
+			res, err := e.Client.NewListTradesService().Symbol(symbol).StartTime(start).EndTime(end).Limit(tradesLimit).Do(ctx)
+			return res, len(res) >= tradesLimit, err
+		})
+		if err != nil {
+			return nil, err
+		}
+		base, quote := e.splitSymbol(ctx, symbol)
+		for _, trade := range trades {
+			t, err := tradeTransaction(trade, base, quote)
+			if txs, err = e.keep(txs, t, err); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return txs, nil
+}
+
+// splitSymbol return the base and quote assets of symbol from the exchange info
+func (e *Exporter) splitSymbol(ctx context.Context, symbol string) (string, string) {
+	info, err := e.Client.NewExchangeInfoService().Symbol(symbol).Do(ctx)
+	if err == nil {
+		for _, s := range info.Symbols {
+			if s.Symbol == symbol {
+				return s.BaseAsset, s.QuoteAsset
+			}
+		}
+	}
+	// fall back to the most common quote assets
+	for _, quote := range []string{"USDT", "FDUSD", "USDC", "BTC", "ETH", "BNB", "TRY", "EUR", "BRL"} {
+		if strings.HasSuffix(symbol, quote) && len(symbol) > len(quote) {
+			return strings.TrimSuffix(symbol, quote), quote
+		}
+	}
+	return symbol, ""
+}
+
+func tradeTransaction(trade *binance.TradeV3, base, quote string) (*Transaction, error) {
+	t := &Transaction{
+		ID:          fmt.Sprintf("trade:%s:%d", trade.Symbol, trade.ID),
+		Time:        millis(trade.Time),
+		Kind:        KindTrade,
+		Description: fmt.Sprintf("%s order %d", trade.Symbol, trade.OrderID),
+	}
+	if quote == "" {
+		return t, fmt.Errorf("unknown quote asset of %s", trade.Symbol)
+	}
+	p := new(decparse.Parser)
+	qty, quoteQty := p.Dec("qty", trade.Quantity), p.Dec("quoteQty", trade.QuoteQuantity)
+	if trade.IsBuyer {
+		t.move(AccountTrading, AccountSpot, base, qty)
+		t.move(AccountSpot, AccountTrading, quote, quoteQty)
+	} else {
+		t.move(AccountSpot, AccountTrading, base, qty)
+		t.move(AccountTrading, AccountSpot, quote, quoteQty)
+	}
+	t.move(AccountSpot, AccountFees, trade.CommissionAsset, p.Dec("commission", trade.Commission))
+	return t, p.Err
+}
+
+func (e *Exporter) deposits(ctx context.Context, start, end time.Time) ([]Transaction, error) {
+	deposits, err := collect(ctx, start, end, walletWindow, func(ctx context.Context, start, end int64) ([]*binance.Deposit, bool, error) {
+		var all []*binance.Deposit
+		for offset := 0; ; offset += walletLimit {
+			res, err := e.Client.NewListDepositsService().StartTime(start).EndTime(end).Offset(offset).Limit(walletLimit).Do(ctx)
+			if err != nil {
+				return nil, false, err
+			}
+			all = append(all, res...)
+			if len(res) < walletLimit {
+				return all, false, nil
+			}
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+	var txs []Transaction
+	for _, d := range deposits {
+		if d.Status != depositStatusSuccess && d.Status != depositStatusCreditedLocked {
+			continue
+		}
+		p := new(decparse.Parser)
+		t := &Transaction{
+			ID:          "deposit:" + d.TxID + ":" + d.Coin,
+			Time:        millis(d.InsertTime),
+			Kind:        KindDeposit,
+			Description: fmt.Sprintf("%s deposit on %s", d.Coin, d.Network),
+			TxHash:      d.TxID,
+		}
+		t.move(AccountExternal, AccountSpot, d.Coin, p.Dec("amount", d.Amount))
+		if txs, err = e.keep(txs, t, p.Err); err != nil {
+			return nil, err
+		}
+	}
+	return txs, nil
+}
+
+func (e *Exporter) withdrawals(ctx context.Context, start, end time.Time) ([]Transaction, error) {
+	withdraws, err := collect(ctx, start, end, walletWindow, func(ctx context.Context, start, end int64) ([]*binance.Withdraw, bool, error) {
+		var all []*binance.Withdraw
+		for offset := 0; ; offset += walletLimit {
+			res, err := e.Client.NewListWithdrawsService().StartTime(start).EndTime(end).Offset(offset).Limit(walletLimit).Do(ctx)
+			if err != nil {
+				return nil, false, err
+			}
+			all = append(all, res...)
+			if len(res) < walletLimit {
+				return all, false, nil
+			}
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+	var txs []Transaction
+	for _, w := range withdraws {
+		if w.Status != withdrawStatusCompleted {
+			continue
+		}
+		p := new(decparse.Parser)
+		t := &Transaction{
+			ID:          "withdrawal:" + w.ID,
+			Kind:        KindWithdrawal,
+			Description: fmt.Sprintf("%s withdrawal on %s", w.Coin, w.Network),
+			TxHash:      w.TxID,
+		}
+		applyTime, err := time.Parse(withdrawTimeLayout, w.ApplyTime)
+		if err != nil {
+			p.Err = fmt.Errorf("invalid applyTime %q: %w", w.ApplyTime, err)
+		}
+		t.Time = applyTime
+		t.move(AccountSpot, AccountExternal, w.Coin, p.Dec("amount", w.Amount))
+		t.move(AccountSpot, AccountFees, w.Coin, p.Dec("transactionFee", w.TransactionFee))
+		if txs, err = e.keep(txs, t, p.Err); err != nil {
+			return nil, err
+		}
+	}
+	return txs, nil
+}
+
+func (e *Exporter) dust(ctx context.Context, start, end time.Time) ([]Transaction, error) {
+	dribblets, err := collect(ctx, start, end, walletWindow, func(ctx context.Context, start, end int64) ([]binance.UserAssetDribblet, bool, error) {
+		res, err := e.Client.NewListDustLogService().StartTime(start).EndTime(end).Do(ctx)
+		if err != nil {
+			return nil, false, err
+		}
+		return res.UserAssetDribblets, len(res.UserAssetDribblets) >= dustLimit, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	var txs []Transaction
+	// a transaction per converted asset, as the assets are sold separately
+	for _, d := range dribblets {
+		for _, detail := range d.UserAssetDribbletDetails {
+			p := new(decparse.Parser)
+			t := &Transaction{
+				ID:          fmt.Sprintf("dust:%d:%s", d.TransID, detail.FromAsset),
+				Time:        millis(d.OperateTime),
+				Kind:        KindDust,
+				Description: fmt.Sprintf("%s dust converted to BNB", detail.FromAsset),
+			}
+			fee := p.Dec("serviceChargeAmount", detail.ServiceChargeAmount)
+			t.move(AccountSpot, AccountTrading, detail.FromAsset, p.Dec("amount", detail.Amount))
+			t.move(AccountTrading, AccountSpot, "BNB", p.Dec("transferedAmount", detail.TransferedAmount).Add(fee))
+			t.move(AccountSpot, AccountFees, "BNB", fee)
+			if txs, err = e.keep(txs, t, p.Err); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return txs, nil
+}
+
+func (e *Exporter) dividends(ctx context.Context, start, end time.Time) ([]Transaction, error) {
+	dividends, err := collect(ctx, start, end, dividendWindow, func(ctx context.Context, start, end int64) ([]*binance.DividendResponse, bool, error) {
+		res, err := e.Client.NewAssetDividendService().StartTime(start).EndTime(end).Limit(dividendLimit).Do(ctx)
+		if err != nil {
+			return nil, false, err
+		}
+		return res.Rows, len(res.Rows) >= dividendLimit, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	var txs []Transaction
+	for _, d := range dividends {
+		p := new(decparse.Parser)
+		t := &Transaction{
+			ID:          fmt.Sprintf("dividend:%d", d.ID),
+			Time:        millis(d.Time),
+			Kind:        KindDividend,
+			Description: d.Info,
+		}
+		t.move(AccountIncome, AccountSpot, d.Asset, p.Dec("amount", d.Amount))
+		if txs, err = e.keep(txs, t, p.Err); err != nil {
+			return nil, err
+		}
+	}
+	return txs, nil
+}
+
+func (e *Exporter) convert(ctx context.Context, start, end time.Time) ([]Transaction, error) {
+	items, err := collect(ctx, start, end, convertWindow, func(ctx context.Context, start, end int64) ([]binance.ConvertTradeHistoryItem, bool, error) {
+		res, err := e.Client.NewConvertTradeHistoryService().StartTime(start).EndTime(end).Limit(convertLimit).Do(ctx)
+		if err != nil {
+			return nil, false, err
+		}
+		return res.List, res.MoreData, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	var txs []Transaction
+	for _, c := range items {
+		if c.OrderStatus != "SUCCESS" {
+			continue
+		}
+		p := new(decparse.Parser)
+		t := &Transaction{
+			ID:          fmt.Sprintf("convert:%d", c.OrderId),
+			Time:        millis(c.CreateTime),
+			Kind:        KindConvert,
+			Description: fmt.Sprintf("convert %s to %s", c.FromAsset, c.ToAsset),
+		}
+		t.move(AccountSpot, AccountTrading, c.FromAsset, p.Dec("fromAmount", c.FromAmount))
+		t.move(AccountTrading, AccountSpot, c.ToAsset, p.Dec("toAmount", c.ToAmount))
+		if txs, err = e.keep(txs, t, p.Err); err != nil {
+			return nil, err
+		}
+	}
+	return txs, nil
+}
+
+func (e *Exporter) c2c(ctx context.Context, start, end time.Time) ([]Transaction, error) {
+	var txs []Transaction
+	for _, side := range []binance.SideType{binance.SideTypeBuy, binance.SideTypeSell} {
+		records, err := collect(ctx, start, end, c2cWindow, func(ctx context.Context, start, end int64) ([]binance.C2CRecord, bool, error) {
+			var all []binance.C2CRecord
+			for page := int32(1); ; page++ {
+				res, err := e.Client.NewC2CTradeHistoryService().TradeType(side).
+					StartTimestamp(start).EndTime(end).Page(page).Rows(pageRows).Do(ctx)
+				if err != nil {
+					return nil, false, err
+				}
+				all = append(all, res.Data...)
+				if len(res.Data) < pageRows || int64(len(all)) >= res.Total {
+					return all, false, nil
+				}
+			}
+		})
+		if err != nil {
+			return nil, err
+		}
+		for _, c := range records {
+			if c.OrderStatus != "COMPLETED" {
+				continue
+			}
+			p := new(decparse.Parser)
+			t := &Transaction{
+				ID:          "c2c:" + c.OrderNumber,
+				Time:        millis(c.CreateTime),
+				Kind:        KindC2C,
+				Description: fmt.Sprintf("C2C %s %s for %s", strings.ToLower(c.TradeType), c.Asset, c.Fiat),
+			}
+			amount, total := p.Dec("amount", c.Amount), p.Dec("totalPrice", c.TotalPrice)
+			// the fiat is paid outside of Binance
+			if c.TradeType == string(binance.SideTypeBuy) {
+				t.move(AccountTrading, AccountSpot, c.Asset, amount)
+				t.move(AccountExternal, AccountTrading, c.Fiat, total)
+			} else {
+				t.move(AccountSpot, AccountTrading, c.Asset, amount)
+				t.move(AccountTrading, AccountExternal, c.Fiat, total)
+			}
+			t.move(AccountSpot, AccountFees, c.Asset, p.Dec("commission", c.Commission))
+			if txs, err = e.keep(txs, t, p.Err); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return txs, nil
+}
+
+// fiatCompleted return whether a fiat order status is final and successful
+func fiatCompleted(status string) bool {
+	switch status {
+	case "Successful", "Finished", "Completed":
+		return true
+	}
+	return false
+}
+
+func (e *Exporter) fiat(ctx context.Context, start, end time.Time) ([]Transaction, error) {
+	var txs []Transaction
+	for _, transactionType := range []binance.TransactionType{binance.TransactionTypeDeposit, binance.TransactionTypeWithdraw} {
+		items, err := collect(ctx, start, end, fiatWindow, func(ctx context.Context, start, end int64) ([]binance.FiatDepositWithdrawHistoryItem, bool, error) {
+			var all []binance.FiatDepositWithdrawHistoryItem
+			for page := int32(1); ; page++ {
+				res, err := e.Client.NewFiatDepositWithdrawHistoryService().TransactionType(transactionType).
+					BeginTime(start).EndTime(end).Page(page).Rows(pageRows).Do(ctx)
+				if err != nil {
+					return nil, false, err
+				}
+				all = append(all, res.Data...)
+				if len(res.Data) < pageRows || len(all) >= int(res.Total) {
+					return all, false, nil
+				}
+			}
+		})
+		if err != nil {
+			return nil, err
+		}
+		for _, f := range items {
+			if !fiatCompleted(f.Status) {
+				continue
+			}
+			p := new(decparse.Parser)
+			t := &Transaction{
+				ID:          "fiat:" + f.OrderNo,
+				Time:        millis(f.CreateTime),
+				Description: fmt.Sprintf("%s via %s", f.FiatCurrency, f.Method),
+			}
+			amount, fee := p.Dec("amount", f.Amount), p.Dec("totalFee", f.TotalFee)
+			if transactionType == binance.TransactionTypeDeposit {
+				t.Kind = KindFiatDeposit
+				t.move(AccountExternal, AccountSpot, f.FiatCurrency, amount.Add(fee))
+			} else {
+				t.Kind = KindFiatWithdrawal
+				t.move(AccountSpot, AccountExternal, f.FiatCurrency, amount)
+			}
+			t.move(AccountSpot, AccountFees, f.FiatCurrency, fee)
+			if txs, err = e.keep(txs, t, p.Err); err != nil {
+				return nil, err
+			}
+		}
+	}
+	for _, transactionType := range []binance.TransactionType{binance.TransactionTypeBuy, binance.TransactionTypeSell} {
+		items, err := collect(ctx, start, end, fiatWindow, func(ctx context.Context, start, end int64) ([]binance.FiatPaymentsHistoryItem, bool, error) {
+			var all []binance.FiatPaymentsHistoryItem
+			for page := int32(1); ; page++ {
+				res, err := e.Client.NewFiatPaymentsHistoryService().TransactionType(transactionType).
+					BeginTime(start).EndTime(end).Page(page).Rows(pageRows).Do(ctx)
+				if err != nil {
+					return nil, false, err
+				}
+				all = append(all, res.Data...)
+				if len(res.Data) < pageRows || len(all) >= int(res.Total) {
+					return all, false, nil
+				}
+			}
+		})
+		if err != nil {
+			return nil, err
+		}
+		for _, f := range items {
+			if !fiatCompleted(f.Status) {
+				continue
+			}
+			p := new(decparse.Parser)
+			t := &Transaction{
+				ID:   "fiat-payment:" + f.OrderNo,
+				Time: millis(f.CreateTime),
+				Kind: KindFiatPayment,
+			}
+			source, obtain, fee := p.Dec("sourceAmount", f.SourceAmount), p.Dec("obtainAmount", f.ObtainAmount), p.Dec("totalFee", f.TotalFee)
+			// the fee is paid in fiat, outside of Binance
+			if transactionType == binance.TransactionTypeBuy {
+				t.Description = fmt.Sprintf("buy %s with %s", f.CryptoCurrency, f.FiatCurrency)
+				t.move(AccountExternal, AccountTrading, f.FiatCurrency, source.Sub(fee))
+				t.move(AccountExternal, AccountFees, f.FiatCurrency, fee)
+				t.move(AccountTrading, AccountSpot, f.CryptoCurrency, obtain)
+			} else {
+				t.Description = fmt.Sprintf("sell %s for %s", f.CryptoCurrency, f.FiatCurrency)
+				t.move(AccountSpot, AccountTrading, f.CryptoCurrency, source)
+				t.move(AccountTrading, AccountExternal, f.FiatCurrency, obtain.Add(fee))
+				t.move(AccountExternal, AccountFees, f.FiatCurrency, fee)
+			}
+			if txs, err = e.keep(txs, t, p.Err); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return txs, nil
+}
+
+func (e *Exporter) pay(ctx context.Context, start, end time.Time) ([]Transaction, error) {
+	items, err := collect(ctx, start, end, walletWindow, func(ctx context.Context, start, end int64) ([]binance.PayTradeItem, bool, error) {
+		res, err := e.Client.NewPayTradeHistoryService().StartTimestamp(start).EndTimestamp(end).Limit(payLimit).Do(ctx)
+		if err != nil {
+			return nil, false, err
+		}
+		return res.Data, len(res.Data) >= payLimit, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	var txs []Transaction
+	for _, item := range items {
+		p := new(decparse.Parser)
+		t := &Transaction{
+			ID:          "pay:" + item.TransactionID,
+			Time:        millis(item.TransactionTime),
+			Kind:        KindPay,
+			Description: "Binance Pay " + item.OrderType,
+		}
+		// negative amounts are paid
+		t.move(AccountExternal, AccountSpot, item.Currency, p.Dec("amount", item.Amount))
+		if txs, err = e.keep(txs, t, p.Err); err != nil {
+			return nil, err
+		}
+	}
+	return txs, nil
+}
+
+func (e *Exporter) futuresIncome(ctx context.Context, start, end time.Time) ([]Transaction, error) {
+	var txs []Transaction
+	incomes, err := collect(ctx, start, end, incomeWindow, func(ctx context.Context, start, end int64) ([]*futures.IncomeHistory, bool, error) {
+		res, err := e.Futures.NewGetIncomeHistoryService().StartTime(start).EndTime(end).Limit(incomeLimit).Do(ctx)
+		if err != nil {
+			return nil, false, err
+		}
+		return res, len(res) >= incomeLimit, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, income := range incomes {
+		p := new(decparse.Parser)
+		t := &Transaction{
+			ID:          fmt.Sprintf("futures:%s:%d", income.IncomeType, income.TranID),
+			Time:        millis(income.Time),
+			Description: strings.TrimSpace(income.Symbol + " " + income.Info),
+		}
+		amount := p.Dec("income", income.Income)
+		switch income.IncomeType {
+		case "TRANSFER", "INTERNAL_TRANSFER":
+			// transfers between the accounts of the user are not taxable events
+			continue
+		case "REALIZED_PNL":
+			t.Kind = KindFuturesPnL
+			t.move(AccountTrading, AccountFutures, income.Asset, amount)
+		case "FUNDING_FEE":
+			t.Kind = KindFundingFee
+			t.move(AccountIncome, AccountFutures, income.Asset, amount)
+		case "COMMISSION":
+			t.Kind = KindFuturesFee
+			t.move(AccountFutures, AccountFees, income.Asset, amount.Neg())
+		default:
+			t.Kind = KindFuturesIncome
+			t.move(AccountIncome, AccountFutures, income.Asset, amount)
+		}
+		if txs, err = e.keep(txs, t, p.Err); err != nil {
+			return nil, err
+		}
+	}
+	return txs, nil
+}
+
+func (e *Exporter) marginInterest(ctx context.Context, start, end time.Time) ([]Transaction, error) {
+	rows, err := collect(ctx, start, end, interestWindow, func(ctx context.Context, start, end int64) ([]binance.MarginInterestHistoryRow, bool, error) {
+		var all []binance.MarginInterestHistoryRow
+		for current := int64(1); ; current++ {
+			res, err := e.Client.NewMarginInterestHistoryService().StartTime(start).EndTime(end).Current(current).Size(pageRows).Do(ctx)
+			if err != nil {
+				return nil, false, err
+			}
+			all = append(all, res.Rows...)
+			if len(res.Rows) < pageRows || int64(len(all)) >= res.Total {
+				return all, false, nil
+			}
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+	var txs []Transaction
+	for _, r := range rows {
+		p := new(decparse.Parser)
+		t := &Transaction{
+			ID:          fmt.Sprintf("margin-interest:%d", r.TxId),
+			Time:        millis(r.InterestAccuredTime),
+			Kind:        KindMarginInterest,
+			Description: strings.TrimSpace("margin interest " + r.IsolatedSymbol),
+		}
+		t.move(AccountMargin, AccountInterest, r.Asset, p.Dec("interest", r.Interest))
+		if txs, err = e.keep(txs, t, p.Err); err != nil {
+			return nil, err
+		}
+	}
+	return txs, nil
+}
+
+func (e *Exporter) earnRewards(ctx context.Context, start, end time.Time) ([]Transaction, error) {
+	earn := e.Client.NewSimpleEarnService()
+	flexible, err := collect(ctx, start, end, rewardsWindow, func(ctx context.Context, start, end int64) ([]binance.SimpleEarnFlexibleReward, bool, error) {
+		var all []binance.SimpleEarnFlexibleReward
+		for current := 1; ; current++ {
+			res, err := earn.FlexibleService().GetRewardsHistory().Type(binance.SimpleEarnFlexibleRewardTypeAll).
+				StartTime(start).EndTime(end).Current(current).Size(pageRows).Do(ctx)
+			if err != nil {
+				return nil, false, err
+			}
+			all = append(all, res.Rows...)
+			if len(res.Rows) < pageRows || len(all) >= res.Total {
+				return all, false, nil
+			}
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+	locked, err := collect(ctx, start, end, rewardsWindow, func(ctx context.Context, start, end int64) ([]binance.SimpleEarnLockedReward, bool, error) {
+		var all []binance.SimpleEarnLockedReward
+		for current := 1; ; current++ {
+			res, err := earn.LockedService().GetRewardsHistory().
+				StartTime(start).EndTime(end).Current(current).Size(pageRows).Do(ctx)
+			if err != nil {
+				return nil, false, err
+			}
+			all = append(all, res.Rows...)
+			if len(res.Rows) < pageRows || len(all) >= res.Total {
+				return all, false, nil
+			}
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+	var txs []Transaction
+	// the rewards have no id, they are identified by product, type and time
+	for _, r := range flexible {
+		p := new(decparse.Parser)
+		t := &Transaction{
+			ID:          "earn-flexible:" + r.ProjectId + ":" + r.Type + ":" + strconv.FormatInt(r.Time, 10),
+			Time:        millis(r.Time),
+			Kind:        KindEarnReward,
+			Description: fmt.Sprintf("Simple Earn flexible %s %s rewards", r.ProjectId, strings.ToLower(r.Type)),
+		}
+		t.move(AccountIncome, AccountEarn, r.Asset, p.Dec("rewards", r.Rewards))
+		if txs, err = e.keep(txs, t, p.Err); err != nil {
+			return nil, err
+		}
+	}
+	for _, r := range locked {
+		p := new(decparse.Parser)
+		t := &Transaction{
+			ID:          "earn-locked:" + r.PositionId + ":" + r.Type + ":" + strconv.FormatInt(r.Time, 10),
+			Time:        millis(r.Time),
+			Kind:        KindEarnReward,
+			Description: fmt.Sprintf("Simple Earn locked %s days %s", r.LockPeriod, strings.ToLower(r.Type)),
+		}
+		t.move(AccountIncome, AccountEarn, r.Asset, p.Dec("amount", r.Amount))
+		if txs, err = e.keep(txs, t, p.Err); err != nil {
+			return nil, err
+		}
+	}
+	return txs, nil
+}
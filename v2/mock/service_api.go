@@ -0,0 +1,21205 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: service_api.go
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	binance "github.com/adshao/go-binance/v2"
+	futures "github.com/adshao/go-binance/v2/futures"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockClientAPI is a mock of ClientAPI interface.
+type MockClientAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockClientAPIMockRecorder
+}
+
+// MockClientAPIMockRecorder is the mock recorder for MockClientAPI.
+type MockClientAPIMockRecorder struct {
+	mock *MockClientAPI
+}
+
+// NewMockClientAPI creates a new mock instance.
+func NewMockClientAPI(ctrl *gomock.Controller) *MockClientAPI {
+	mock := &MockClientAPI{ctrl: ctrl}
+	mock.recorder = &MockClientAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockClientAPI) EXPECT() *MockClientAPIMockRecorder {
+	return m.recorder
+}
+
+// NewAddLiquidityPreviewService mocks base method.
+func (m *MockClientAPI) NewAddLiquidityPreviewService() binance.AddLiquidityPreviewServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewAddLiquidityPreviewService")
+	ret0, _ := ret[0].(binance.AddLiquidityPreviewServiceAPI)
+	return ret0
+}
+
+// NewAddLiquidityPreviewService indicates an expected call of NewAddLiquidityPreviewService.
+func (mr *MockClientAPIMockRecorder) NewAddLiquidityPreviewService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewAddLiquidityPreviewService", reflect.TypeOf((*MockClientAPI)(nil).NewAddLiquidityPreviewService))
+}
+
+// NewAddLiquidityService mocks base method.
+func (m *MockClientAPI) NewAddLiquidityService() binance.AddLiquidityServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewAddLiquidityService")
+	ret0, _ := ret[0].(binance.AddLiquidityServiceAPI)
+	return ret0
+}
+
+// NewAddLiquidityService indicates an expected call of NewAddLiquidityService.
+func (mr *MockClientAPIMockRecorder) NewAddLiquidityService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewAddLiquidityService", reflect.TypeOf((*MockClientAPI)(nil).NewAddLiquidityService))
+}
+
+// NewAggTradesService mocks base method.
+func (m *MockClientAPI) NewAggTradesService() binance.AggTradesServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewAggTradesService")
+	ret0, _ := ret[0].(binance.AggTradesServiceAPI)
+	return ret0
+}
+
+// NewAggTradesService indicates an expected call of NewAggTradesService.
+func (mr *MockClientAPIMockRecorder) NewAggTradesService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewAggTradesService", reflect.TypeOf((*MockClientAPI)(nil).NewAggTradesService))
+}
+
+// NewAssetDividendService mocks base method.
+func (m *MockClientAPI) NewAssetDividendService() binance.AssetDividendServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewAssetDividendService")
+	ret0, _ := ret[0].(binance.AssetDividendServiceAPI)
+	return ret0
+}
+
+// NewAssetDividendService indicates an expected call of NewAssetDividendService.
+func (mr *MockClientAPIMockRecorder) NewAssetDividendService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewAssetDividendService", reflect.TypeOf((*MockClientAPI)(nil).NewAssetDividendService))
+}
+
+// NewAveragePriceService mocks base method.
+func (m *MockClientAPI) NewAveragePriceService() binance.AveragePriceServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewAveragePriceService")
+	ret0, _ := ret[0].(binance.AveragePriceServiceAPI)
+	return ret0
+}
+
+// NewAveragePriceService indicates an expected call of NewAveragePriceService.
+func (mr *MockClientAPIMockRecorder) NewAveragePriceService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewAveragePriceService", reflect.TypeOf((*MockClientAPI)(nil).NewAveragePriceService))
+}
+
+// NewC2CTradeHistoryService mocks base method.
+func (m *MockClientAPI) NewC2CTradeHistoryService() binance.C2CTradeHistoryServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewC2CTradeHistoryService")
+	ret0, _ := ret[0].(binance.C2CTradeHistoryServiceAPI)
+	return ret0
+}
+
+// NewC2CTradeHistoryService indicates an expected call of NewC2CTradeHistoryService.
+func (mr *MockClientAPIMockRecorder) NewC2CTradeHistoryService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewC2CTradeHistoryService", reflect.TypeOf((*MockClientAPI)(nil).NewC2CTradeHistoryService))
+}
+
+// NewCancelAllMarginOrdersService mocks base method.
+func (m *MockClientAPI) NewCancelAllMarginOrdersService() binance.CancelAllMarginOrdersServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewCancelAllMarginOrdersService")
+	ret0, _ := ret[0].(binance.CancelAllMarginOrdersServiceAPI)
+	return ret0
+}
+
+// NewCancelAllMarginOrdersService indicates an expected call of NewCancelAllMarginOrdersService.
+func (mr *MockClientAPIMockRecorder) NewCancelAllMarginOrdersService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewCancelAllMarginOrdersService", reflect.TypeOf((*MockClientAPI)(nil).NewCancelAllMarginOrdersService))
+}
+
+// NewCancelFuturesAlgoOrderService mocks base method.
+func (m *MockClientAPI) NewCancelFuturesAlgoOrderService() binance.CancelFuturesAlgoOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewCancelFuturesAlgoOrderService")
+	ret0, _ := ret[0].(binance.CancelFuturesAlgoOrderServiceAPI)
+	return ret0
+}
+
+// NewCancelFuturesAlgoOrderService indicates an expected call of NewCancelFuturesAlgoOrderService.
+func (mr *MockClientAPIMockRecorder) NewCancelFuturesAlgoOrderService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewCancelFuturesAlgoOrderService", reflect.TypeOf((*MockClientAPI)(nil).NewCancelFuturesAlgoOrderService))
+}
+
+// NewCancelMarginOCOService mocks base method.
+func (m *MockClientAPI) NewCancelMarginOCOService() binance.CancelMarginOCOServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewCancelMarginOCOService")
+	ret0, _ := ret[0].(binance.CancelMarginOCOServiceAPI)
+	return ret0
+}
+
+// NewCancelMarginOCOService indicates an expected call of NewCancelMarginOCOService.
+func (mr *MockClientAPIMockRecorder) NewCancelMarginOCOService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewCancelMarginOCOService", reflect.TypeOf((*MockClientAPI)(nil).NewCancelMarginOCOService))
+}
+
+// NewCancelMarginOrderService mocks base method.
+func (m *MockClientAPI) NewCancelMarginOrderService() binance.CancelMarginOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewCancelMarginOrderService")
+	ret0, _ := ret[0].(binance.CancelMarginOrderServiceAPI)
+	return ret0
+}
+
+// NewCancelMarginOrderService indicates an expected call of NewCancelMarginOrderService.
+func (mr *MockClientAPIMockRecorder) NewCancelMarginOrderService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewCancelMarginOrderService", reflect.TypeOf((*MockClientAPI)(nil).NewCancelMarginOrderService))
+}
+
+// NewCancelOCOService mocks base method.
+func (m *MockClientAPI) NewCancelOCOService() binance.CancelOCOServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewCancelOCOService")
+	ret0, _ := ret[0].(binance.CancelOCOServiceAPI)
+	return ret0
+}
+
+// NewCancelOCOService indicates an expected call of NewCancelOCOService.
+func (mr *MockClientAPIMockRecorder) NewCancelOCOService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewCancelOCOService", reflect.TypeOf((*MockClientAPI)(nil).NewCancelOCOService))
+}
+
+// NewCancelOpenOrdersService mocks base method.
+func (m *MockClientAPI) NewCancelOpenOrdersService() binance.CancelOpenOrdersServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewCancelOpenOrdersService")
+	ret0, _ := ret[0].(binance.CancelOpenOrdersServiceAPI)
+	return ret0
+}
+
+// NewCancelOpenOrdersService indicates an expected call of NewCancelOpenOrdersService.
+func (mr *MockClientAPIMockRecorder) NewCancelOpenOrdersService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewCancelOpenOrdersService", reflect.TypeOf((*MockClientAPI)(nil).NewCancelOpenOrdersService))
+}
+
+// NewCancelOrderService mocks base method.
+func (m *MockClientAPI) NewCancelOrderService() binance.CancelOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewCancelOrderService")
+	ret0, _ := ret[0].(binance.CancelOrderServiceAPI)
+	return ret0
+}
+
+// NewCancelOrderService indicates an expected call of NewCancelOrderService.
+func (mr *MockClientAPIMockRecorder) NewCancelOrderService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewCancelOrderService", reflect.TypeOf((*MockClientAPI)(nil).NewCancelOrderService))
+}
+
+// NewCancelReplaceOrderService mocks base method.
+func (m *MockClientAPI) NewCancelReplaceOrderService() binance.CancelReplaceOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewCancelReplaceOrderService")
+	ret0, _ := ret[0].(binance.CancelReplaceOrderServiceAPI)
+	return ret0
+}
+
+// NewCancelReplaceOrderService indicates an expected call of NewCancelReplaceOrderService.
+func (mr *MockClientAPIMockRecorder) NewCancelReplaceOrderService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewCancelReplaceOrderService", reflect.TypeOf((*MockClientAPI)(nil).NewCancelReplaceOrderService))
+}
+
+// NewClaimRewardService mocks base method.
+func (m *MockClientAPI) NewClaimRewardService() binance.ClaimRewardServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewClaimRewardService")
+	ret0, _ := ret[0].(binance.ClaimRewardServiceAPI)
+	return ret0
+}
+
+// NewClaimRewardService indicates an expected call of NewClaimRewardService.
+func (mr *MockClientAPIMockRecorder) NewClaimRewardService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewClaimRewardService", reflect.TypeOf((*MockClientAPI)(nil).NewClaimRewardService))
+}
+
+// NewCloseIsolatedMarginUserStreamService mocks base method.
+func (m *MockClientAPI) NewCloseIsolatedMarginUserStreamService() binance.CloseIsolatedMarginUserStreamServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewCloseIsolatedMarginUserStreamService")
+	ret0, _ := ret[0].(binance.CloseIsolatedMarginUserStreamServiceAPI)
+	return ret0
+}
+
+// NewCloseIsolatedMarginUserStreamService indicates an expected call of NewCloseIsolatedMarginUserStreamService.
+func (mr *MockClientAPIMockRecorder) NewCloseIsolatedMarginUserStreamService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewCloseIsolatedMarginUserStreamService", reflect.TypeOf((*MockClientAPI)(nil).NewCloseIsolatedMarginUserStreamService))
+}
+
+// NewCloseMarginUserStreamService mocks base method.
+func (m *MockClientAPI) NewCloseMarginUserStreamService() binance.CloseMarginUserStreamServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewCloseMarginUserStreamService")
+	ret0, _ := ret[0].(binance.CloseMarginUserStreamServiceAPI)
+	return ret0
+}
+
+// NewCloseMarginUserStreamService indicates an expected call of NewCloseMarginUserStreamService.
+func (mr *MockClientAPIMockRecorder) NewCloseMarginUserStreamService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewCloseMarginUserStreamService", reflect.TypeOf((*MockClientAPI)(nil).NewCloseMarginUserStreamService))
+}
+
+// NewCloseUserStreamService mocks base method.
+func (m *MockClientAPI) NewCloseUserStreamService() binance.CloseUserStreamServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewCloseUserStreamService")
+	ret0, _ := ret[0].(binance.CloseUserStreamServiceAPI)
+	return ret0
+}
+
+// NewCloseUserStreamService indicates an expected call of NewCloseUserStreamService.
+func (mr *MockClientAPIMockRecorder) NewCloseUserStreamService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewCloseUserStreamService", reflect.TypeOf((*MockClientAPI)(nil).NewCloseUserStreamService))
+}
+
+// NewConvertAcceptQuoteService mocks base method.
+func (m *MockClientAPI) NewConvertAcceptQuoteService() binance.ConvertAcceptQuoteServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewConvertAcceptQuoteService")
+	ret0, _ := ret[0].(binance.ConvertAcceptQuoteServiceAPI)
+	return ret0
+}
+
+// NewConvertAcceptQuoteService indicates an expected call of NewConvertAcceptQuoteService.
+func (mr *MockClientAPIMockRecorder) NewConvertAcceptQuoteService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewConvertAcceptQuoteService", reflect.TypeOf((*MockClientAPI)(nil).NewConvertAcceptQuoteService))
+}
+
+// NewConvertAssetInfoService mocks base method.
+func (m *MockClientAPI) NewConvertAssetInfoService() binance.ConvertAssetInfoServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewConvertAssetInfoService")
+	ret0, _ := ret[0].(binance.ConvertAssetInfoServiceAPI)
+	return ret0
+}
+
+// NewConvertAssetInfoService indicates an expected call of NewConvertAssetInfoService.
+func (mr *MockClientAPIMockRecorder) NewConvertAssetInfoService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewConvertAssetInfoService", reflect.TypeOf((*MockClientAPI)(nil).NewConvertAssetInfoService))
+}
+
+// NewConvertExchangeInfoService mocks base method.
+func (m *MockClientAPI) NewConvertExchangeInfoService() binance.ConvertExchangeInfoServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewConvertExchangeInfoService")
+	ret0, _ := ret[0].(binance.ConvertExchangeInfoServiceAPI)
+	return ret0
+}
+
+// NewConvertExchangeInfoService indicates an expected call of NewConvertExchangeInfoService.
+func (mr *MockClientAPIMockRecorder) NewConvertExchangeInfoService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewConvertExchangeInfoService", reflect.TypeOf((*MockClientAPI)(nil).NewConvertExchangeInfoService))
+}
+
+// NewConvertOrderStatusService mocks base method.
+func (m *MockClientAPI) NewConvertOrderStatusService() binance.ConvertOrderStatusServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewConvertOrderStatusService")
+	ret0, _ := ret[0].(binance.ConvertOrderStatusServiceAPI)
+	return ret0
+}
+
+// NewConvertOrderStatusService indicates an expected call of NewConvertOrderStatusService.
+func (mr *MockClientAPIMockRecorder) NewConvertOrderStatusService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewConvertOrderStatusService", reflect.TypeOf((*MockClientAPI)(nil).NewConvertOrderStatusService))
+}
+
+// NewConvertQuoteService mocks base method.
+func (m *MockClientAPI) NewConvertQuoteService() binance.ConvertGetQuoteServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewConvertQuoteService")
+	ret0, _ := ret[0].(binance.ConvertGetQuoteServiceAPI)
+	return ret0
+}
+
+// NewConvertQuoteService indicates an expected call of NewConvertQuoteService.
+func (mr *MockClientAPIMockRecorder) NewConvertQuoteService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewConvertQuoteService", reflect.TypeOf((*MockClientAPI)(nil).NewConvertQuoteService))
+}
+
+// NewConvertTradeHistoryService mocks base method.
+func (m *MockClientAPI) NewConvertTradeHistoryService() binance.ConvertTradeHistoryServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewConvertTradeHistoryService")
+	ret0, _ := ret[0].(binance.ConvertTradeHistoryServiceAPI)
+	return ret0
+}
+
+// NewConvertTradeHistoryService indicates an expected call of NewConvertTradeHistoryService.
+func (mr *MockClientAPIMockRecorder) NewConvertTradeHistoryService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewConvertTradeHistoryService", reflect.TypeOf((*MockClientAPI)(nil).NewConvertTradeHistoryService))
+}
+
+// NewCreateFuturesAlgoTwapOrderService mocks base method.
+func (m *MockClientAPI) NewCreateFuturesAlgoTwapOrderService() binance.CreateFuturesAlgoTwapOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewCreateFuturesAlgoTwapOrderService")
+	ret0, _ := ret[0].(binance.CreateFuturesAlgoTwapOrderServiceAPI)
+	return ret0
+}
+
+// NewCreateFuturesAlgoTwapOrderService indicates an expected call of NewCreateFuturesAlgoTwapOrderService.
+func (mr *MockClientAPIMockRecorder) NewCreateFuturesAlgoTwapOrderService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewCreateFuturesAlgoTwapOrderService", reflect.TypeOf((*MockClientAPI)(nil).NewCreateFuturesAlgoTwapOrderService))
+}
+
+// NewCreateFuturesAlgoVpOrderService mocks base method.
+func (m *MockClientAPI) NewCreateFuturesAlgoVpOrderService() binance.CreateFuturesAlgoVpOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewCreateFuturesAlgoVpOrderService")
+	ret0, _ := ret[0].(binance.CreateFuturesAlgoVpOrderServiceAPI)
+	return ret0
+}
+
+// NewCreateFuturesAlgoVpOrderService indicates an expected call of NewCreateFuturesAlgoVpOrderService.
+func (mr *MockClientAPIMockRecorder) NewCreateFuturesAlgoVpOrderService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewCreateFuturesAlgoVpOrderService", reflect.TypeOf((*MockClientAPI)(nil).NewCreateFuturesAlgoVpOrderService))
+}
+
+// NewCreateMarginOCOService mocks base method.
+func (m *MockClientAPI) NewCreateMarginOCOService() binance.CreateMarginOCOServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewCreateMarginOCOService")
+	ret0, _ := ret[0].(binance.CreateMarginOCOServiceAPI)
+	return ret0
+}
+
+// NewCreateMarginOCOService indicates an expected call of NewCreateMarginOCOService.
+func (mr *MockClientAPIMockRecorder) NewCreateMarginOCOService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewCreateMarginOCOService", reflect.TypeOf((*MockClientAPI)(nil).NewCreateMarginOCOService))
+}
+
+// NewCreateMarginOrderService mocks base method.
+func (m *MockClientAPI) NewCreateMarginOrderService() binance.CreateMarginOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewCreateMarginOrderService")
+	ret0, _ := ret[0].(binance.CreateMarginOrderServiceAPI)
+	return ret0
+}
+
+// NewCreateMarginOrderService indicates an expected call of NewCreateMarginOrderService.
+func (mr *MockClientAPIMockRecorder) NewCreateMarginOrderService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewCreateMarginOrderService", reflect.TypeOf((*MockClientAPI)(nil).NewCreateMarginOrderService))
+}
+
+// NewCreateOCOService mocks base method.
+func (m *MockClientAPI) NewCreateOCOService() binance.CreateOCOServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewCreateOCOService")
+	ret0, _ := ret[0].(binance.CreateOCOServiceAPI)
+	return ret0
+}
+
+// NewCreateOCOService indicates an expected call of NewCreateOCOService.
+func (mr *MockClientAPIMockRecorder) NewCreateOCOService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewCreateOCOService", reflect.TypeOf((*MockClientAPI)(nil).NewCreateOCOService))
+}
+
+// NewCreateOrderService mocks base method.
+func (m *MockClientAPI) NewCreateOrderService() binance.CreateOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewCreateOrderService")
+	ret0, _ := ret[0].(binance.CreateOrderServiceAPI)
+	return ret0
+}
+
+// NewCreateOrderService indicates an expected call of NewCreateOrderService.
+func (mr *MockClientAPIMockRecorder) NewCreateOrderService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewCreateOrderService", reflect.TypeOf((*MockClientAPI)(nil).NewCreateOrderService))
+}
+
+// NewCreateVirtualSubAccountService mocks base method.
+func (m *MockClientAPI) NewCreateVirtualSubAccountService() binance.CreateVirtualSubAccountServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewCreateVirtualSubAccountService")
+	ret0, _ := ret[0].(binance.CreateVirtualSubAccountServiceAPI)
+	return ret0
+}
+
+// NewCreateVirtualSubAccountService indicates an expected call of NewCreateVirtualSubAccountService.
+func (mr *MockClientAPIMockRecorder) NewCreateVirtualSubAccountService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewCreateVirtualSubAccountService", reflect.TypeOf((*MockClientAPI)(nil).NewCreateVirtualSubAccountService))
+}
+
+// NewCreateWithdrawService mocks base method.
+func (m *MockClientAPI) NewCreateWithdrawService() binance.CreateWithdrawServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewCreateWithdrawService")
+	ret0, _ := ret[0].(binance.CreateWithdrawServiceAPI)
+	return ret0
+}
+
+// NewCreateWithdrawService indicates an expected call of NewCreateWithdrawService.
+func (mr *MockClientAPIMockRecorder) NewCreateWithdrawService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewCreateWithdrawService", reflect.TypeOf((*MockClientAPI)(nil).NewCreateWithdrawService))
+}
+
+// NewDepthService mocks base method.
+func (m *MockClientAPI) NewDepthService() binance.DepthServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewDepthService")
+	ret0, _ := ret[0].(binance.DepthServiceAPI)
+	return ret0
+}
+
+// NewDepthService indicates an expected call of NewDepthService.
+func (mr *MockClientAPIMockRecorder) NewDepthService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewDepthService", reflect.TypeOf((*MockClientAPI)(nil).NewDepthService))
+}
+
+// NewDualInvestmentService mocks base method.
+func (m *MockClientAPI) NewDualInvestmentService() binance.DualInvestmentServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewDualInvestmentService")
+	ret0, _ := ret[0].(binance.DualInvestmentServiceAPI)
+	return ret0
+}
+
+// NewDualInvestmentService indicates an expected call of NewDualInvestmentService.
+func (mr *MockClientAPIMockRecorder) NewDualInvestmentService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewDualInvestmentService", reflect.TypeOf((*MockClientAPI)(nil).NewDualInvestmentService))
+}
+
+// NewDustTransferService mocks base method.
+func (m *MockClientAPI) NewDustTransferService() binance.DustTransferServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewDustTransferService")
+	ret0, _ := ret[0].(binance.DustTransferServiceAPI)
+	return ret0
+}
+
+// NewDustTransferService indicates an expected call of NewDustTransferService.
+func (mr *MockClientAPIMockRecorder) NewDustTransferService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewDustTransferService", reflect.TypeOf((*MockClientAPI)(nil).NewDustTransferService))
+}
+
+// NewExchangeInfoService mocks base method.
+func (m *MockClientAPI) NewExchangeInfoService() binance.ExchangeInfoServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewExchangeInfoService")
+	ret0, _ := ret[0].(binance.ExchangeInfoServiceAPI)
+	return ret0
+}
+
+// NewExchangeInfoService indicates an expected call of NewExchangeInfoService.
+func (mr *MockClientAPIMockRecorder) NewExchangeInfoService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewExchangeInfoService", reflect.TypeOf((*MockClientAPI)(nil).NewExchangeInfoService))
+}
+
+// NewFiatDepositWithdrawHistoryService mocks base method.
+func (m *MockClientAPI) NewFiatDepositWithdrawHistoryService() binance.FiatDepositWithdrawHistoryServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewFiatDepositWithdrawHistoryService")
+	ret0, _ := ret[0].(binance.FiatDepositWithdrawHistoryServiceAPI)
+	return ret0
+}
+
+// NewFiatDepositWithdrawHistoryService indicates an expected call of NewFiatDepositWithdrawHistoryService.
+func (mr *MockClientAPIMockRecorder) NewFiatDepositWithdrawHistoryService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewFiatDepositWithdrawHistoryService", reflect.TypeOf((*MockClientAPI)(nil).NewFiatDepositWithdrawHistoryService))
+}
+
+// NewFiatPaymentsHistoryService mocks base method.
+func (m *MockClientAPI) NewFiatPaymentsHistoryService() binance.FiatPaymentsHistoryServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewFiatPaymentsHistoryService")
+	ret0, _ := ret[0].(binance.FiatPaymentsHistoryServiceAPI)
+	return ret0
+}
+
+// NewFiatPaymentsHistoryService indicates an expected call of NewFiatPaymentsHistoryService.
+func (mr *MockClientAPIMockRecorder) NewFiatPaymentsHistoryService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewFiatPaymentsHistoryService", reflect.TypeOf((*MockClientAPI)(nil).NewFiatPaymentsHistoryService))
+}
+
+// NewFuturesOrderBookHistoryService mocks base method.
+func (m *MockClientAPI) NewFuturesOrderBookHistoryService() binance.FuturesOrderBookHistoryServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewFuturesOrderBookHistoryService")
+	ret0, _ := ret[0].(binance.FuturesOrderBookHistoryServiceAPI)
+	return ret0
+}
+
+// NewFuturesOrderBookHistoryService indicates an expected call of NewFuturesOrderBookHistoryService.
+func (mr *MockClientAPIMockRecorder) NewFuturesOrderBookHistoryService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewFuturesOrderBookHistoryService", reflect.TypeOf((*MockClientAPI)(nil).NewFuturesOrderBookHistoryService))
+}
+
+// NewFuturesTransferService mocks base method.
+func (m *MockClientAPI) NewFuturesTransferService() binance.FuturesTransferServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewFuturesTransferService")
+	ret0, _ := ret[0].(binance.FuturesTransferServiceAPI)
+	return ret0
+}
+
+// NewFuturesTransferService indicates an expected call of NewFuturesTransferService.
+func (mr *MockClientAPIMockRecorder) NewFuturesTransferService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewFuturesTransferService", reflect.TypeOf((*MockClientAPI)(nil).NewFuturesTransferService))
+}
+
+// NewGetAPIKeyPermission mocks base method.
+func (m *MockClientAPI) NewGetAPIKeyPermission() binance.GetAPIKeyPermissionAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewGetAPIKeyPermission")
+	ret0, _ := ret[0].(binance.GetAPIKeyPermissionAPI)
+	return ret0
+}
+
+// NewGetAPIKeyPermission indicates an expected call of NewGetAPIKeyPermission.
+func (mr *MockClientAPIMockRecorder) NewGetAPIKeyPermission() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewGetAPIKeyPermission", reflect.TypeOf((*MockClientAPI)(nil).NewGetAPIKeyPermission))
+}
+
+// NewGetAccountService mocks base method.
+func (m *MockClientAPI) NewGetAccountService() binance.GetAccountServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewGetAccountService")
+	ret0, _ := ret[0].(binance.GetAccountServiceAPI)
+	return ret0
+}
+
+// NewGetAccountService indicates an expected call of NewGetAccountService.
+func (mr *MockClientAPIMockRecorder) NewGetAccountService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewGetAccountService", reflect.TypeOf((*MockClientAPI)(nil).NewGetAccountService))
+}
+
+// NewGetAccountSnapshotService mocks base method.
+func (m *MockClientAPI) NewGetAccountSnapshotService() binance.GetAccountSnapshotServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewGetAccountSnapshotService")
+	ret0, _ := ret[0].(binance.GetAccountSnapshotServiceAPI)
+	return ret0
+}
+
+// NewGetAccountSnapshotService indicates an expected call of NewGetAccountSnapshotService.
+func (mr *MockClientAPIMockRecorder) NewGetAccountSnapshotService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewGetAccountSnapshotService", reflect.TypeOf((*MockClientAPI)(nil).NewGetAccountSnapshotService))
+}
+
+// NewGetAllCoinsInfoService mocks base method.
+func (m *MockClientAPI) NewGetAllCoinsInfoService() binance.GetAllCoinsInfoServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewGetAllCoinsInfoService")
+	ret0, _ := ret[0].(binance.GetAllCoinsInfoServiceAPI)
+	return ret0
+}
+
+// NewGetAllCoinsInfoService indicates an expected call of NewGetAllCoinsInfoService.
+func (mr *MockClientAPIMockRecorder) NewGetAllCoinsInfoService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewGetAllCoinsInfoService", reflect.TypeOf((*MockClientAPI)(nil).NewGetAllCoinsInfoService))
+}
+
+// NewGetAllLiquidityPoolService mocks base method.
+func (m *MockClientAPI) NewGetAllLiquidityPoolService() binance.GetAllLiquidityPoolServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewGetAllLiquidityPoolService")
+	ret0, _ := ret[0].(binance.GetAllLiquidityPoolServiceAPI)
+	return ret0
+}
+
+// NewGetAllLiquidityPoolService indicates an expected call of NewGetAllLiquidityPoolService.
+func (mr *MockClientAPIMockRecorder) NewGetAllLiquidityPoolService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewGetAllLiquidityPoolService", reflect.TypeOf((*MockClientAPI)(nil).NewGetAllLiquidityPoolService))
+}
+
+// NewGetAllMarginAssetsService mocks base method.
+func (m *MockClientAPI) NewGetAllMarginAssetsService() binance.GetAllMarginAssetsServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewGetAllMarginAssetsService")
+	ret0, _ := ret[0].(binance.GetAllMarginAssetsServiceAPI)
+	return ret0
+}
+
+// NewGetAllMarginAssetsService indicates an expected call of NewGetAllMarginAssetsService.
+func (mr *MockClientAPIMockRecorder) NewGetAllMarginAssetsService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewGetAllMarginAssetsService", reflect.TypeOf((*MockClientAPI)(nil).NewGetAllMarginAssetsService))
+}
+
+// NewGetAssetDetailService mocks base method.
+func (m *MockClientAPI) NewGetAssetDetailService() binance.GetAssetDetailServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewGetAssetDetailService")
+	ret0, _ := ret[0].(binance.GetAssetDetailServiceAPI)
+	return ret0
+}
+
+// NewGetAssetDetailService indicates an expected call of NewGetAssetDetailService.
+func (mr *MockClientAPIMockRecorder) NewGetAssetDetailService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewGetAssetDetailService", reflect.TypeOf((*MockClientAPI)(nil).NewGetAssetDetailService))
+}
+
+// NewGetBNBBurnService mocks base method.
+func (m *MockClientAPI) NewGetBNBBurnService() binance.GetBNBBurnServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewGetBNBBurnService")
+	ret0, _ := ret[0].(binance.GetBNBBurnServiceAPI)
+	return ret0
+}
+
+// NewGetBNBBurnService indicates an expected call of NewGetBNBBurnService.
+func (mr *MockClientAPIMockRecorder) NewGetBNBBurnService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewGetBNBBurnService", reflect.TypeOf((*MockClientAPI)(nil).NewGetBNBBurnService))
+}
+
+// NewGetCommissionRatesService mocks base method.
+func (m *MockClientAPI) NewGetCommissionRatesService() binance.GetCommissionRatesServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewGetCommissionRatesService")
+	ret0, _ := ret[0].(binance.GetCommissionRatesServiceAPI)
+	return ret0
+}
+
+// NewGetCommissionRatesService indicates an expected call of NewGetCommissionRatesService.
+func (mr *MockClientAPIMockRecorder) NewGetCommissionRatesService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewGetCommissionRatesService", reflect.TypeOf((*MockClientAPI)(nil).NewGetCommissionRatesService))
+}
+
+// NewGetDepositAddressService mocks base method.
+func (m *MockClientAPI) NewGetDepositAddressService() binance.GetDepositsAddressServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewGetDepositAddressService")
+	ret0, _ := ret[0].(binance.GetDepositsAddressServiceAPI)
+	return ret0
+}
+
+// NewGetDepositAddressService indicates an expected call of NewGetDepositAddressService.
+func (mr *MockClientAPIMockRecorder) NewGetDepositAddressService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewGetDepositAddressService", reflect.TypeOf((*MockClientAPI)(nil).NewGetDepositAddressService))
+}
+
+// NewGetFundingAssetService mocks base method.
+func (m *MockClientAPI) NewGetFundingAssetService() binance.GetFundingAssetServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewGetFundingAssetService")
+	ret0, _ := ret[0].(binance.GetFundingAssetServiceAPI)
+	return ret0
+}
+
+// NewGetFundingAssetService indicates an expected call of NewGetFundingAssetService.
+func (mr *MockClientAPIMockRecorder) NewGetFundingAssetService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewGetFundingAssetService", reflect.TypeOf((*MockClientAPI)(nil).NewGetFundingAssetService))
+}
+
+// NewGetFuturesAlgoSubOrdersService mocks base method.
+func (m *MockClientAPI) NewGetFuturesAlgoSubOrdersService() binance.GetFuturesAlgoSubOrdersServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewGetFuturesAlgoSubOrdersService")
+	ret0, _ := ret[0].(binance.GetFuturesAlgoSubOrdersServiceAPI)
+	return ret0
+}
+
+// NewGetFuturesAlgoSubOrdersService indicates an expected call of NewGetFuturesAlgoSubOrdersService.
+func (mr *MockClientAPIMockRecorder) NewGetFuturesAlgoSubOrdersService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewGetFuturesAlgoSubOrdersService", reflect.TypeOf((*MockClientAPI)(nil).NewGetFuturesAlgoSubOrdersService))
+}
+
+// NewGetIsolatedMarginAccountService mocks base method.
+func (m *MockClientAPI) NewGetIsolatedMarginAccountService() binance.GetIsolatedMarginAccountServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewGetIsolatedMarginAccountService")
+	ret0, _ := ret[0].(binance.GetIsolatedMarginAccountServiceAPI)
+	return ret0
+}
+
+// NewGetIsolatedMarginAccountService indicates an expected call of NewGetIsolatedMarginAccountService.
+func (mr *MockClientAPIMockRecorder) NewGetIsolatedMarginAccountService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewGetIsolatedMarginAccountService", reflect.TypeOf((*MockClientAPI)(nil).NewGetIsolatedMarginAccountService))
+}
+
+// NewGetIsolatedMarginAllPairsService mocks base method.
+func (m *MockClientAPI) NewGetIsolatedMarginAllPairsService() binance.GetIsolatedMarginAllPairsServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewGetIsolatedMarginAllPairsService")
+	ret0, _ := ret[0].(binance.GetIsolatedMarginAllPairsServiceAPI)
+	return ret0
+}
+
+// NewGetIsolatedMarginAllPairsService indicates an expected call of NewGetIsolatedMarginAllPairsService.
+func (mr *MockClientAPIMockRecorder) NewGetIsolatedMarginAllPairsService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewGetIsolatedMarginAllPairsService", reflect.TypeOf((*MockClientAPI)(nil).NewGetIsolatedMarginAllPairsService))
+}
+
+// NewGetLiquidityPoolDetailService mocks base method.
+func (m *MockClientAPI) NewGetLiquidityPoolDetailService() binance.GetLiquidityPoolDetailServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewGetLiquidityPoolDetailService")
+	ret0, _ := ret[0].(binance.GetLiquidityPoolDetailServiceAPI)
+	return ret0
+}
+
+// NewGetLiquidityPoolDetailService indicates an expected call of NewGetLiquidityPoolDetailService.
+func (mr *MockClientAPIMockRecorder) NewGetLiquidityPoolDetailService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewGetLiquidityPoolDetailService", reflect.TypeOf((*MockClientAPI)(nil).NewGetLiquidityPoolDetailService))
+}
+
+// NewGetMarginAccountService mocks base method.
+func (m *MockClientAPI) NewGetMarginAccountService() binance.GetMarginAccountServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewGetMarginAccountService")
+	ret0, _ := ret[0].(binance.GetMarginAccountServiceAPI)
+	return ret0
+}
+
+// NewGetMarginAccountService indicates an expected call of NewGetMarginAccountService.
+func (mr *MockClientAPIMockRecorder) NewGetMarginAccountService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewGetMarginAccountService", reflect.TypeOf((*MockClientAPI)(nil).NewGetMarginAccountService))
+}
+
+// NewGetMarginAllPairsService mocks base method.
+func (m *MockClientAPI) NewGetMarginAllPairsService() binance.GetMarginAllPairsServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewGetMarginAllPairsService")
+	ret0, _ := ret[0].(binance.GetMarginAllPairsServiceAPI)
+	return ret0
+}
+
+// NewGetMarginAllPairsService indicates an expected call of NewGetMarginAllPairsService.
+func (mr *MockClientAPIMockRecorder) NewGetMarginAllPairsService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewGetMarginAllPairsService", reflect.TypeOf((*MockClientAPI)(nil).NewGetMarginAllPairsService))
+}
+
+// NewGetMarginAssetService mocks base method.
+func (m *MockClientAPI) NewGetMarginAssetService() binance.GetMarginAssetServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewGetMarginAssetService")
+	ret0, _ := ret[0].(binance.GetMarginAssetServiceAPI)
+	return ret0
+}
+
+// NewGetMarginAssetService indicates an expected call of NewGetMarginAssetService.
+func (mr *MockClientAPIMockRecorder) NewGetMarginAssetService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewGetMarginAssetService", reflect.TypeOf((*MockClientAPI)(nil).NewGetMarginAssetService))
+}
+
+// NewGetMarginOrderService mocks base method.
+func (m *MockClientAPI) NewGetMarginOrderService() binance.GetMarginOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewGetMarginOrderService")
+	ret0, _ := ret[0].(binance.GetMarginOrderServiceAPI)
+	return ret0
+}
+
+// NewGetMarginOrderService indicates an expected call of NewGetMarginOrderService.
+func (mr *MockClientAPIMockRecorder) NewGetMarginOrderService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewGetMarginOrderService", reflect.TypeOf((*MockClientAPI)(nil).NewGetMarginOrderService))
+}
+
+// NewGetMarginPairService mocks base method.
+func (m *MockClientAPI) NewGetMarginPairService() binance.GetMarginPairServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewGetMarginPairService")
+	ret0, _ := ret[0].(binance.GetMarginPairServiceAPI)
+	return ret0
+}
+
+// NewGetMarginPairService indicates an expected call of NewGetMarginPairService.
+func (mr *MockClientAPIMockRecorder) NewGetMarginPairService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewGetMarginPairService", reflect.TypeOf((*MockClientAPI)(nil).NewGetMarginPairService))
+}
+
+// NewGetMarginPriceIndexService mocks base method.
+func (m *MockClientAPI) NewGetMarginPriceIndexService() binance.GetMarginPriceIndexServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewGetMarginPriceIndexService")
+	ret0, _ := ret[0].(binance.GetMarginPriceIndexServiceAPI)
+	return ret0
+}
+
+// NewGetMarginPriceIndexService indicates an expected call of NewGetMarginPriceIndexService.
+func (mr *MockClientAPIMockRecorder) NewGetMarginPriceIndexService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewGetMarginPriceIndexService", reflect.TypeOf((*MockClientAPI)(nil).NewGetMarginPriceIndexService))
+}
+
+// NewGetMaxBorrowableService mocks base method.
+func (m *MockClientAPI) NewGetMaxBorrowableService() binance.GetMaxBorrowableServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewGetMaxBorrowableService")
+	ret0, _ := ret[0].(binance.GetMaxBorrowableServiceAPI)
+	return ret0
+}
+
+// NewGetMaxBorrowableService indicates an expected call of NewGetMaxBorrowableService.
+func (mr *MockClientAPIMockRecorder) NewGetMaxBorrowableService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewGetMaxBorrowableService", reflect.TypeOf((*MockClientAPI)(nil).NewGetMaxBorrowableService))
+}
+
+// NewGetMaxTransferableService mocks base method.
+func (m *MockClientAPI) NewGetMaxTransferableService() binance.GetMaxTransferableServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewGetMaxTransferableService")
+	ret0, _ := ret[0].(binance.GetMaxTransferableServiceAPI)
+	return ret0
+}
+
+// NewGetMaxTransferableService indicates an expected call of NewGetMaxTransferableService.
+func (mr *MockClientAPIMockRecorder) NewGetMaxTransferableService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewGetMaxTransferableService", reflect.TypeOf((*MockClientAPI)(nil).NewGetMaxTransferableService))
+}
+
+// NewGetOrderService mocks base method.
+func (m *MockClientAPI) NewGetOrderService() binance.GetOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewGetOrderService")
+	ret0, _ := ret[0].(binance.GetOrderServiceAPI)
+	return ret0
+}
+
+// NewGetOrderService indicates an expected call of NewGetOrderService.
+func (mr *MockClientAPIMockRecorder) NewGetOrderService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewGetOrderService", reflect.TypeOf((*MockClientAPI)(nil).NewGetOrderService))
+}
+
+// NewGetSwapQuoteService mocks base method.
+func (m *MockClientAPI) NewGetSwapQuoteService() binance.GetSwapQuoteServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewGetSwapQuoteService")
+	ret0, _ := ret[0].(binance.GetSwapQuoteServiceAPI)
+	return ret0
+}
+
+// NewGetSwapQuoteService indicates an expected call of NewGetSwapQuoteService.
+func (mr *MockClientAPIMockRecorder) NewGetSwapQuoteService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewGetSwapQuoteService", reflect.TypeOf((*MockClientAPI)(nil).NewGetSwapQuoteService))
+}
+
+// NewGetUserAsset mocks base method.
+func (m *MockClientAPI) NewGetUserAsset() binance.GetUserAssetServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewGetUserAsset")
+	ret0, _ := ret[0].(binance.GetUserAssetServiceAPI)
+	return ret0
+}
+
+// NewGetUserAsset indicates an expected call of NewGetUserAsset.
+func (mr *MockClientAPIMockRecorder) NewGetUserAsset() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewGetUserAsset", reflect.TypeOf((*MockClientAPI)(nil).NewGetUserAsset))
+}
+
+// NewGetUserSwapRecordsService mocks base method.
+func (m *MockClientAPI) NewGetUserSwapRecordsService() binance.GetUserSwapRecordsServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewGetUserSwapRecordsService")
+	ret0, _ := ret[0].(binance.GetUserSwapRecordsServiceAPI)
+	return ret0
+}
+
+// NewGetUserSwapRecordsService indicates an expected call of NewGetUserSwapRecordsService.
+func (mr *MockClientAPIMockRecorder) NewGetUserSwapRecordsService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewGetUserSwapRecordsService", reflect.TypeOf((*MockClientAPI)(nil).NewGetUserSwapRecordsService))
+}
+
+// NewHistoricalTradesService mocks base method.
+func (m *MockClientAPI) NewHistoricalTradesService() binance.HistoricalTradesServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewHistoricalTradesService")
+	ret0, _ := ret[0].(binance.HistoricalTradesServiceAPI)
+	return ret0
+}
+
+// NewHistoricalTradesService indicates an expected call of NewHistoricalTradesService.
+func (mr *MockClientAPIMockRecorder) NewHistoricalTradesService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewHistoricalTradesService", reflect.TypeOf((*MockClientAPI)(nil).NewHistoricalTradesService))
+}
+
+// NewInterestHistoryService mocks base method.
+func (m *MockClientAPI) NewInterestHistoryService() binance.InterestHistoryServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewInterestHistoryService")
+	ret0, _ := ret[0].(binance.InterestHistoryServiceAPI)
+	return ret0
+}
+
+// NewInterestHistoryService indicates an expected call of NewInterestHistoryService.
+func (mr *MockClientAPIMockRecorder) NewInterestHistoryService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewInterestHistoryService", reflect.TypeOf((*MockClientAPI)(nil).NewInterestHistoryService))
+}
+
+// NewInternalUniversalTransferHistoryService mocks base method.
+func (m *MockClientAPI) NewInternalUniversalTransferHistoryService() binance.InternalUniversalTransferHistoryServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewInternalUniversalTransferHistoryService")
+	ret0, _ := ret[0].(binance.InternalUniversalTransferHistoryServiceAPI)
+	return ret0
+}
+
+// NewInternalUniversalTransferHistoryService indicates an expected call of NewInternalUniversalTransferHistoryService.
+func (mr *MockClientAPIMockRecorder) NewInternalUniversalTransferHistoryService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewInternalUniversalTransferHistoryService", reflect.TypeOf((*MockClientAPI)(nil).NewInternalUniversalTransferHistoryService))
+}
+
+// NewInternalUniversalTransferService mocks base method.
+func (m *MockClientAPI) NewInternalUniversalTransferService() binance.InternalUniversalTransferServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewInternalUniversalTransferService")
+	ret0, _ := ret[0].(binance.InternalUniversalTransferServiceAPI)
+	return ret0
+}
+
+// NewInternalUniversalTransferService indicates an expected call of NewInternalUniversalTransferService.
+func (mr *MockClientAPIMockRecorder) NewInternalUniversalTransferService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewInternalUniversalTransferService", reflect.TypeOf((*MockClientAPI)(nil).NewInternalUniversalTransferService))
+}
+
+// NewIsolatedMarginTransferService mocks base method.
+func (m *MockClientAPI) NewIsolatedMarginTransferService() binance.IsolatedMarginTransferServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewIsolatedMarginTransferService")
+	ret0, _ := ret[0].(binance.IsolatedMarginTransferServiceAPI)
+	return ret0
+}
+
+// NewIsolatedMarginTransferService indicates an expected call of NewIsolatedMarginTransferService.
+func (mr *MockClientAPIMockRecorder) NewIsolatedMarginTransferService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewIsolatedMarginTransferService", reflect.TypeOf((*MockClientAPI)(nil).NewIsolatedMarginTransferService))
+}
+
+// NewKeepaliveIsolatedMarginUserStreamService mocks base method.
+func (m *MockClientAPI) NewKeepaliveIsolatedMarginUserStreamService() binance.KeepaliveIsolatedMarginUserStreamServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewKeepaliveIsolatedMarginUserStreamService")
+	ret0, _ := ret[0].(binance.KeepaliveIsolatedMarginUserStreamServiceAPI)
+	return ret0
+}
+
+// NewKeepaliveIsolatedMarginUserStreamService indicates an expected call of NewKeepaliveIsolatedMarginUserStreamService.
+func (mr *MockClientAPIMockRecorder) NewKeepaliveIsolatedMarginUserStreamService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewKeepaliveIsolatedMarginUserStreamService", reflect.TypeOf((*MockClientAPI)(nil).NewKeepaliveIsolatedMarginUserStreamService))
+}
+
+// NewKeepaliveMarginUserStreamService mocks base method.
+func (m *MockClientAPI) NewKeepaliveMarginUserStreamService() binance.KeepaliveMarginUserStreamServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewKeepaliveMarginUserStreamService")
+	ret0, _ := ret[0].(binance.KeepaliveMarginUserStreamServiceAPI)
+	return ret0
+}
+
+// NewKeepaliveMarginUserStreamService indicates an expected call of NewKeepaliveMarginUserStreamService.
+func (mr *MockClientAPIMockRecorder) NewKeepaliveMarginUserStreamService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewKeepaliveMarginUserStreamService", reflect.TypeOf((*MockClientAPI)(nil).NewKeepaliveMarginUserStreamService))
+}
+
+// NewKeepaliveUserStreamService mocks base method.
+func (m *MockClientAPI) NewKeepaliveUserStreamService() binance.KeepaliveUserStreamServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewKeepaliveUserStreamService")
+	ret0, _ := ret[0].(binance.KeepaliveUserStreamServiceAPI)
+	return ret0
+}
+
+// NewKeepaliveUserStreamService indicates an expected call of NewKeepaliveUserStreamService.
+func (mr *MockClientAPIMockRecorder) NewKeepaliveUserStreamService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewKeepaliveUserStreamService", reflect.TypeOf((*MockClientAPI)(nil).NewKeepaliveUserStreamService))
+}
+
+// NewKlinesService mocks base method.
+func (m *MockClientAPI) NewKlinesService() binance.KlinesServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewKlinesService")
+	ret0, _ := ret[0].(binance.KlinesServiceAPI)
+	return ret0
+}
+
+// NewKlinesService indicates an expected call of NewKlinesService.
+func (mr *MockClientAPIMockRecorder) NewKlinesService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewKlinesService", reflect.TypeOf((*MockClientAPI)(nil).NewKlinesService))
+}
+
+// NewListBookTickersService mocks base method.
+func (m *MockClientAPI) NewListBookTickersService() binance.ListBookTickersServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewListBookTickersService")
+	ret0, _ := ret[0].(binance.ListBookTickersServiceAPI)
+	return ret0
+}
+
+// NewListBookTickersService indicates an expected call of NewListBookTickersService.
+func (mr *MockClientAPIMockRecorder) NewListBookTickersService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewListBookTickersService", reflect.TypeOf((*MockClientAPI)(nil).NewListBookTickersService))
+}
+
+// NewListDepositsService mocks base method.
+func (m *MockClientAPI) NewListDepositsService() binance.ListDepositsServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewListDepositsService")
+	ret0, _ := ret[0].(binance.ListDepositsServiceAPI)
+	return ret0
+}
+
+// NewListDepositsService indicates an expected call of NewListDepositsService.
+func (mr *MockClientAPIMockRecorder) NewListDepositsService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewListDepositsService", reflect.TypeOf((*MockClientAPI)(nil).NewListDepositsService))
+}
+
+// NewListDustLogService mocks base method.
+func (m *MockClientAPI) NewListDustLogService() binance.ListDustLogServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewListDustLogService")
+	ret0, _ := ret[0].(binance.ListDustLogServiceAPI)
+	return ret0
+}
+
+// NewListDustLogService indicates an expected call of NewListDustLogService.
+func (mr *MockClientAPIMockRecorder) NewListDustLogService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewListDustLogService", reflect.TypeOf((*MockClientAPI)(nil).NewListDustLogService))
+}
+
+// NewListDustService mocks base method.
+func (m *MockClientAPI) NewListDustService() binance.ListDustServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewListDustService")
+	ret0, _ := ret[0].(binance.ListDustServiceAPI)
+	return ret0
+}
+
+// NewListDustService indicates an expected call of NewListDustService.
+func (mr *MockClientAPIMockRecorder) NewListDustService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewListDustService", reflect.TypeOf((*MockClientAPI)(nil).NewListDustService))
+}
+
+// NewListFuturesTransferService mocks base method.
+func (m *MockClientAPI) NewListFuturesTransferService() binance.ListFuturesTransferServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewListFuturesTransferService")
+	ret0, _ := ret[0].(binance.ListFuturesTransferServiceAPI)
+	return ret0
+}
+
+// NewListFuturesTransferService indicates an expected call of NewListFuturesTransferService.
+func (mr *MockClientAPIMockRecorder) NewListFuturesTransferService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewListFuturesTransferService", reflect.TypeOf((*MockClientAPI)(nil).NewListFuturesTransferService))
+}
+
+// NewListHistoryFuturesAlgoOrdersService mocks base method.
+func (m *MockClientAPI) NewListHistoryFuturesAlgoOrdersService() binance.ListHistoryFuturesAlgoOrdersServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewListHistoryFuturesAlgoOrdersService")
+	ret0, _ := ret[0].(binance.ListHistoryFuturesAlgoOrdersServiceAPI)
+	return ret0
+}
+
+// NewListHistoryFuturesAlgoOrdersService indicates an expected call of NewListHistoryFuturesAlgoOrdersService.
+func (mr *MockClientAPIMockRecorder) NewListHistoryFuturesAlgoOrdersService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewListHistoryFuturesAlgoOrdersService", reflect.TypeOf((*MockClientAPI)(nil).NewListHistoryFuturesAlgoOrdersService))
+}
+
+// NewListMarginBorrowRepayService mocks base method.
+func (m *MockClientAPI) NewListMarginBorrowRepayService() binance.ListMarginBorrowRepayServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewListMarginBorrowRepayService")
+	ret0, _ := ret[0].(binance.ListMarginBorrowRepayServiceAPI)
+	return ret0
+}
+
+// NewListMarginBorrowRepayService indicates an expected call of NewListMarginBorrowRepayService.
+func (mr *MockClientAPIMockRecorder) NewListMarginBorrowRepayService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewListMarginBorrowRepayService", reflect.TypeOf((*MockClientAPI)(nil).NewListMarginBorrowRepayService))
+}
+
+// NewListMarginLoansService mocks base method.
+func (m *MockClientAPI) NewListMarginLoansService() binance.ListMarginLoansServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewListMarginLoansService")
+	ret0, _ := ret[0].(binance.ListMarginLoansServiceAPI)
+	return ret0
+}
+
+// NewListMarginLoansService indicates an expected call of NewListMarginLoansService.
+func (mr *MockClientAPIMockRecorder) NewListMarginLoansService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewListMarginLoansService", reflect.TypeOf((*MockClientAPI)(nil).NewListMarginLoansService))
+}
+
+// NewListMarginOpenOrdersService mocks base method.
+func (m *MockClientAPI) NewListMarginOpenOrdersService() binance.ListMarginOpenOrdersServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewListMarginOpenOrdersService")
+	ret0, _ := ret[0].(binance.ListMarginOpenOrdersServiceAPI)
+	return ret0
+}
+
+// NewListMarginOpenOrdersService indicates an expected call of NewListMarginOpenOrdersService.
+func (mr *MockClientAPIMockRecorder) NewListMarginOpenOrdersService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewListMarginOpenOrdersService", reflect.TypeOf((*MockClientAPI)(nil).NewListMarginOpenOrdersService))
+}
+
+// NewListMarginOrdersService mocks base method.
+func (m *MockClientAPI) NewListMarginOrdersService() binance.ListMarginOrdersServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewListMarginOrdersService")
+	ret0, _ := ret[0].(binance.ListMarginOrdersServiceAPI)
+	return ret0
+}
+
+// NewListMarginOrdersService indicates an expected call of NewListMarginOrdersService.
+func (mr *MockClientAPIMockRecorder) NewListMarginOrdersService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewListMarginOrdersService", reflect.TypeOf((*MockClientAPI)(nil).NewListMarginOrdersService))
+}
+
+// NewListMarginRepaysService mocks base method.
+func (m *MockClientAPI) NewListMarginRepaysService() binance.ListMarginRepaysServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewListMarginRepaysService")
+	ret0, _ := ret[0].(binance.ListMarginRepaysServiceAPI)
+	return ret0
+}
+
+// NewListMarginRepaysService indicates an expected call of NewListMarginRepaysService.
+func (mr *MockClientAPIMockRecorder) NewListMarginRepaysService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewListMarginRepaysService", reflect.TypeOf((*MockClientAPI)(nil).NewListMarginRepaysService))
+}
+
+// NewListMarginTradesService mocks base method.
+func (m *MockClientAPI) NewListMarginTradesService() binance.ListMarginTradesServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewListMarginTradesService")
+	ret0, _ := ret[0].(binance.ListMarginTradesServiceAPI)
+	return ret0
+}
+
+// NewListMarginTradesService indicates an expected call of NewListMarginTradesService.
+func (mr *MockClientAPIMockRecorder) NewListMarginTradesService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewListMarginTradesService", reflect.TypeOf((*MockClientAPI)(nil).NewListMarginTradesService))
+}
+
+// NewListOpenFuturesAlgoOrdersService mocks base method.
+func (m *MockClientAPI) NewListOpenFuturesAlgoOrdersService() binance.ListOpenFuturesAlgoOrdersServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewListOpenFuturesAlgoOrdersService")
+	ret0, _ := ret[0].(binance.ListOpenFuturesAlgoOrdersServiceAPI)
+	return ret0
+}
+
+// NewListOpenFuturesAlgoOrdersService indicates an expected call of NewListOpenFuturesAlgoOrdersService.
+func (mr *MockClientAPIMockRecorder) NewListOpenFuturesAlgoOrdersService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewListOpenFuturesAlgoOrdersService", reflect.TypeOf((*MockClientAPI)(nil).NewListOpenFuturesAlgoOrdersService))
+}
+
+// NewListOpenOcoService mocks base method.
+func (m *MockClientAPI) NewListOpenOcoService() binance.ListOpenOcoServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewListOpenOcoService")
+	ret0, _ := ret[0].(binance.ListOpenOcoServiceAPI)
+	return ret0
+}
+
+// NewListOpenOcoService indicates an expected call of NewListOpenOcoService.
+func (mr *MockClientAPIMockRecorder) NewListOpenOcoService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewListOpenOcoService", reflect.TypeOf((*MockClientAPI)(nil).NewListOpenOcoService))
+}
+
+// NewListOpenOrdersService mocks base method.
+func (m *MockClientAPI) NewListOpenOrdersService() binance.ListOpenOrdersServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewListOpenOrdersService")
+	ret0, _ := ret[0].(binance.ListOpenOrdersServiceAPI)
+	return ret0
+}
+
+// NewListOpenOrdersService indicates an expected call of NewListOpenOrdersService.
+func (mr *MockClientAPIMockRecorder) NewListOpenOrdersService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewListOpenOrdersService", reflect.TypeOf((*MockClientAPI)(nil).NewListOpenOrdersService))
+}
+
+// NewListOrdersService mocks base method.
+func (m *MockClientAPI) NewListOrdersService() binance.ListOrdersServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewListOrdersService")
+	ret0, _ := ret[0].(binance.ListOrdersServiceAPI)
+	return ret0
+}
+
+// NewListOrdersService indicates an expected call of NewListOrdersService.
+func (mr *MockClientAPIMockRecorder) NewListOrdersService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewListOrdersService", reflect.TypeOf((*MockClientAPI)(nil).NewListOrdersService))
+}
+
+// NewListPriceChangeStatsService mocks base method.
+func (m *MockClientAPI) NewListPriceChangeStatsService() binance.ListPriceChangeStatsServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewListPriceChangeStatsService")
+	ret0, _ := ret[0].(binance.ListPriceChangeStatsServiceAPI)
+	return ret0
+}
+
+// NewListPriceChangeStatsService indicates an expected call of NewListPriceChangeStatsService.
+func (mr *MockClientAPIMockRecorder) NewListPriceChangeStatsService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewListPriceChangeStatsService", reflect.TypeOf((*MockClientAPI)(nil).NewListPriceChangeStatsService))
+}
+
+// NewListPricesService mocks base method.
+func (m *MockClientAPI) NewListPricesService() binance.ListPricesServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewListPricesService")
+	ret0, _ := ret[0].(binance.ListPricesServiceAPI)
+	return ret0
+}
+
+// NewListPricesService indicates an expected call of NewListPricesService.
+func (mr *MockClientAPIMockRecorder) NewListPricesService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewListPricesService", reflect.TypeOf((*MockClientAPI)(nil).NewListPricesService))
+}
+
+// NewListSavingsFixedAndActivityProductsService mocks base method.
+func (m *MockClientAPI) NewListSavingsFixedAndActivityProductsService() binance.ListSavingsFixedAndActivityProductsServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewListSavingsFixedAndActivityProductsService")
+	ret0, _ := ret[0].(binance.ListSavingsFixedAndActivityProductsServiceAPI)
+	return ret0
+}
+
+// NewListSavingsFixedAndActivityProductsService indicates an expected call of NewListSavingsFixedAndActivityProductsService.
+func (mr *MockClientAPIMockRecorder) NewListSavingsFixedAndActivityProductsService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewListSavingsFixedAndActivityProductsService", reflect.TypeOf((*MockClientAPI)(nil).NewListSavingsFixedAndActivityProductsService))
+}
+
+// NewListSavingsFlexibleProductsService mocks base method.
+func (m *MockClientAPI) NewListSavingsFlexibleProductsService() binance.ListSavingsFlexibleProductsServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewListSavingsFlexibleProductsService")
+	ret0, _ := ret[0].(binance.ListSavingsFlexibleProductsServiceAPI)
+	return ret0
+}
+
+// NewListSavingsFlexibleProductsService indicates an expected call of NewListSavingsFlexibleProductsService.
+func (mr *MockClientAPIMockRecorder) NewListSavingsFlexibleProductsService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewListSavingsFlexibleProductsService", reflect.TypeOf((*MockClientAPI)(nil).NewListSavingsFlexibleProductsService))
+}
+
+// NewListSymbolTickerService mocks base method.
+func (m *MockClientAPI) NewListSymbolTickerService() binance.ListSymbolTickerServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewListSymbolTickerService")
+	ret0, _ := ret[0].(binance.ListSymbolTickerServiceAPI)
+	return ret0
+}
+
+// NewListSymbolTickerService indicates an expected call of NewListSymbolTickerService.
+func (mr *MockClientAPIMockRecorder) NewListSymbolTickerService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewListSymbolTickerService", reflect.TypeOf((*MockClientAPI)(nil).NewListSymbolTickerService))
+}
+
+// NewListTradesService mocks base method.
+func (m *MockClientAPI) NewListTradesService() binance.ListTradesServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewListTradesService")
+	ret0, _ := ret[0].(binance.ListTradesServiceAPI)
+	return ret0
+}
+
+// NewListTradesService indicates an expected call of NewListTradesService.
+func (mr *MockClientAPIMockRecorder) NewListTradesService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewListTradesService", reflect.TypeOf((*MockClientAPI)(nil).NewListTradesService))
+}
+
+// NewListUserUniversalTransferService mocks base method.
+func (m *MockClientAPI) NewListUserUniversalTransferService() binance.ListUserUniversalTransferServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewListUserUniversalTransferService")
+	ret0, _ := ret[0].(binance.ListUserUniversalTransferServiceAPI)
+	return ret0
+}
+
+// NewListUserUniversalTransferService indicates an expected call of NewListUserUniversalTransferService.
+func (mr *MockClientAPIMockRecorder) NewListUserUniversalTransferService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewListUserUniversalTransferService", reflect.TypeOf((*MockClientAPI)(nil).NewListUserUniversalTransferService))
+}
+
+// NewListWithdrawsService mocks base method.
+func (m *MockClientAPI) NewListWithdrawsService() binance.ListWithdrawsServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewListWithdrawsService")
+	ret0, _ := ret[0].(binance.ListWithdrawsServiceAPI)
+	return ret0
+}
+
+// NewListWithdrawsService indicates an expected call of NewListWithdrawsService.
+func (mr *MockClientAPIMockRecorder) NewListWithdrawsService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewListWithdrawsService", reflect.TypeOf((*MockClientAPI)(nil).NewListWithdrawsService))
+}
+
+// NewManagedSubAccountAssetsService mocks base method.
+func (m *MockClientAPI) NewManagedSubAccountAssetsService() binance.ManagedSubAccountAssetsServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewManagedSubAccountAssetsService")
+	ret0, _ := ret[0].(binance.ManagedSubAccountAssetsServiceAPI)
+	return ret0
+}
+
+// NewManagedSubAccountAssetsService indicates an expected call of NewManagedSubAccountAssetsService.
+func (mr *MockClientAPIMockRecorder) NewManagedSubAccountAssetsService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewManagedSubAccountAssetsService", reflect.TypeOf((*MockClientAPI)(nil).NewManagedSubAccountAssetsService))
+}
+
+// NewManagedSubAccountDepositAddressService mocks base method.
+func (m *MockClientAPI) NewManagedSubAccountDepositAddressService() binance.ManagedSubAccountDepositAddressServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewManagedSubAccountDepositAddressService")
+	ret0, _ := ret[0].(binance.ManagedSubAccountDepositAddressServiceAPI)
+	return ret0
+}
+
+// NewManagedSubAccountDepositAddressService indicates an expected call of NewManagedSubAccountDepositAddressService.
+func (mr *MockClientAPIMockRecorder) NewManagedSubAccountDepositAddressService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewManagedSubAccountDepositAddressService", reflect.TypeOf((*MockClientAPI)(nil).NewManagedSubAccountDepositAddressService))
+}
+
+// NewManagedSubAccountDepositService mocks base method.
+func (m *MockClientAPI) NewManagedSubAccountDepositService() binance.ManagedSubAccountDepositServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewManagedSubAccountDepositService")
+	ret0, _ := ret[0].(binance.ManagedSubAccountDepositServiceAPI)
+	return ret0
+}
+
+// NewManagedSubAccountDepositService indicates an expected call of NewManagedSubAccountDepositService.
+func (mr *MockClientAPIMockRecorder) NewManagedSubAccountDepositService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewManagedSubAccountDepositService", reflect.TypeOf((*MockClientAPI)(nil).NewManagedSubAccountDepositService))
+}
+
+// NewManagedSubAccountInfoService mocks base method.
+func (m *MockClientAPI) NewManagedSubAccountInfoService() binance.ManagedSubAccountInfoServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewManagedSubAccountInfoService")
+	ret0, _ := ret[0].(binance.ManagedSubAccountInfoServiceAPI)
+	return ret0
+}
+
+// NewManagedSubAccountInfoService indicates an expected call of NewManagedSubAccountInfoService.
+func (mr *MockClientAPIMockRecorder) NewManagedSubAccountInfoService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewManagedSubAccountInfoService", reflect.TypeOf((*MockClientAPI)(nil).NewManagedSubAccountInfoService))
+}
+
+// NewManagedSubAccountQueryFuturesAssetService mocks base method.
+func (m *MockClientAPI) NewManagedSubAccountQueryFuturesAssetService() binance.ManagedSubAccountQueryFuturesAssetServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewManagedSubAccountQueryFuturesAssetService")
+	ret0, _ := ret[0].(binance.ManagedSubAccountQueryFuturesAssetServiceAPI)
+	return ret0
+}
+
+// NewManagedSubAccountQueryFuturesAssetService indicates an expected call of NewManagedSubAccountQueryFuturesAssetService.
+func (mr *MockClientAPIMockRecorder) NewManagedSubAccountQueryFuturesAssetService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewManagedSubAccountQueryFuturesAssetService", reflect.TypeOf((*MockClientAPI)(nil).NewManagedSubAccountQueryFuturesAssetService))
+}
+
+// NewManagedSubAccountQueryMarginAssetService mocks base method.
+func (m *MockClientAPI) NewManagedSubAccountQueryMarginAssetService() binance.ManagedSubAccountQueryMarginAssetServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewManagedSubAccountQueryMarginAssetService")
+	ret0, _ := ret[0].(binance.ManagedSubAccountQueryMarginAssetServiceAPI)
+	return ret0
+}
+
+// NewManagedSubAccountQueryMarginAssetService indicates an expected call of NewManagedSubAccountQueryMarginAssetService.
+func (mr *MockClientAPIMockRecorder) NewManagedSubAccountQueryMarginAssetService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewManagedSubAccountQueryMarginAssetService", reflect.TypeOf((*MockClientAPI)(nil).NewManagedSubAccountQueryMarginAssetService))
+}
+
+// NewManagedSubAccountQueryTransferLogForInvestorService mocks base method.
+func (m *MockClientAPI) NewManagedSubAccountQueryTransferLogForInvestorService() binance.ManagedSubAccountQueryTransferLogForInvestorServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewManagedSubAccountQueryTransferLogForInvestorService")
+	ret0, _ := ret[0].(binance.ManagedSubAccountQueryTransferLogForInvestorServiceAPI)
+	return ret0
+}
+
+// NewManagedSubAccountQueryTransferLogForInvestorService indicates an expected call of NewManagedSubAccountQueryTransferLogForInvestorService.
+func (mr *MockClientAPIMockRecorder) NewManagedSubAccountQueryTransferLogForInvestorService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewManagedSubAccountQueryTransferLogForInvestorService", reflect.TypeOf((*MockClientAPI)(nil).NewManagedSubAccountQueryTransferLogForInvestorService))
+}
+
+// NewManagedSubAccountQueryTransferLogForTradeParentService mocks base method.
+func (m *MockClientAPI) NewManagedSubAccountQueryTransferLogForTradeParentService() binance.ManagedSubAccountQueryTransferLogForTradeParentServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewManagedSubAccountQueryTransferLogForTradeParentService")
+	ret0, _ := ret[0].(binance.ManagedSubAccountQueryTransferLogForTradeParentServiceAPI)
+	return ret0
+}
+
+// NewManagedSubAccountQueryTransferLogForTradeParentService indicates an expected call of NewManagedSubAccountQueryTransferLogForTradeParentService.
+func (mr *MockClientAPIMockRecorder) NewManagedSubAccountQueryTransferLogForTradeParentService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewManagedSubAccountQueryTransferLogForTradeParentService", reflect.TypeOf((*MockClientAPI)(nil).NewManagedSubAccountQueryTransferLogForTradeParentService))
+}
+
+// NewManagedSubAccountQueryTransferLogService mocks base method.
+func (m *MockClientAPI) NewManagedSubAccountQueryTransferLogService() binance.ManagedSubAccountQueryTransferLogServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewManagedSubAccountQueryTransferLogService")
+	ret0, _ := ret[0].(binance.ManagedSubAccountQueryTransferLogServiceAPI)
+	return ret0
+}
+
+// NewManagedSubAccountQueryTransferLogService indicates an expected call of NewManagedSubAccountQueryTransferLogService.
+func (mr *MockClientAPIMockRecorder) NewManagedSubAccountQueryTransferLogService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewManagedSubAccountQueryTransferLogService", reflect.TypeOf((*MockClientAPI)(nil).NewManagedSubAccountQueryTransferLogService))
+}
+
+// NewManagedSubAccountSnapshotService mocks base method.
+func (m *MockClientAPI) NewManagedSubAccountSnapshotService() binance.ManagedSubAccountSnapshotServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewManagedSubAccountSnapshotService")
+	ret0, _ := ret[0].(binance.ManagedSubAccountSnapshotServiceAPI)
+	return ret0
+}
+
+// NewManagedSubAccountSnapshotService indicates an expected call of NewManagedSubAccountSnapshotService.
+func (mr *MockClientAPIMockRecorder) NewManagedSubAccountSnapshotService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewManagedSubAccountSnapshotService", reflect.TypeOf((*MockClientAPI)(nil).NewManagedSubAccountSnapshotService))
+}
+
+// NewManagedSubAccountWithdrawService mocks base method.
+func (m *MockClientAPI) NewManagedSubAccountWithdrawService() binance.ManagedSubAccountWithdrawServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewManagedSubAccountWithdrawService")
+	ret0, _ := ret[0].(binance.ManagedSubAccountWithdrawServiceAPI)
+	return ret0
+}
+
+// NewManagedSubAccountWithdrawService indicates an expected call of NewManagedSubAccountWithdrawService.
+func (mr *MockClientAPIMockRecorder) NewManagedSubAccountWithdrawService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewManagedSubAccountWithdrawService", reflect.TypeOf((*MockClientAPI)(nil).NewManagedSubAccountWithdrawService))
+}
+
+// NewManagedSubAccountWithdrawalService mocks base method.
+func (m *MockClientAPI) NewManagedSubAccountWithdrawalService() binance.ManagedSubAccountWithdrawalServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewManagedSubAccountWithdrawalService")
+	ret0, _ := ret[0].(binance.ManagedSubAccountWithdrawalServiceAPI)
+	return ret0
+}
+
+// NewManagedSubAccountWithdrawalService indicates an expected call of NewManagedSubAccountWithdrawalService.
+func (mr *MockClientAPIMockRecorder) NewManagedSubAccountWithdrawalService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewManagedSubAccountWithdrawalService", reflect.TypeOf((*MockClientAPI)(nil).NewManagedSubAccountWithdrawalService))
+}
+
+// NewMarginAvailableInventoryService mocks base method.
+func (m *MockClientAPI) NewMarginAvailableInventoryService() binance.MarginAvailableInventoryServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewMarginAvailableInventoryService")
+	ret0, _ := ret[0].(binance.MarginAvailableInventoryServiceAPI)
+	return ret0
+}
+
+// NewMarginAvailableInventoryService indicates an expected call of NewMarginAvailableInventoryService.
+func (mr *MockClientAPIMockRecorder) NewMarginAvailableInventoryService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewMarginAvailableInventoryService", reflect.TypeOf((*MockClientAPI)(nil).NewMarginAvailableInventoryService))
+}
+
+// NewMarginBorrowRepayService mocks base method.
+func (m *MockClientAPI) NewMarginBorrowRepayService() binance.MarginBorrowRepayServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewMarginBorrowRepayService")
+	ret0, _ := ret[0].(binance.MarginBorrowRepayServiceAPI)
+	return ret0
+}
+
+// NewMarginBorrowRepayService indicates an expected call of NewMarginBorrowRepayService.
+func (mr *MockClientAPIMockRecorder) NewMarginBorrowRepayService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewMarginBorrowRepayService", reflect.TypeOf((*MockClientAPI)(nil).NewMarginBorrowRepayService))
+}
+
+// NewMarginInterestHistoryService mocks base method.
+func (m *MockClientAPI) NewMarginInterestHistoryService() binance.MarginInterestHistoryServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewMarginInterestHistoryService")
+	ret0, _ := ret[0].(binance.MarginInterestHistoryServiceAPI)
+	return ret0
+}
+
+// NewMarginInterestHistoryService indicates an expected call of NewMarginInterestHistoryService.
+func (mr *MockClientAPIMockRecorder) NewMarginInterestHistoryService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewMarginInterestHistoryService", reflect.TypeOf((*MockClientAPI)(nil).NewMarginInterestHistoryService))
+}
+
+// NewMarginInterestRateHistoryService mocks base method.
+func (m *MockClientAPI) NewMarginInterestRateHistoryService() binance.MarginInterestRateHistoryServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewMarginInterestRateHistoryService")
+	ret0, _ := ret[0].(binance.MarginInterestRateHistoryServiceAPI)
+	return ret0
+}
+
+// NewMarginInterestRateHistoryService indicates an expected call of NewMarginInterestRateHistoryService.
+func (mr *MockClientAPIMockRecorder) NewMarginInterestRateHistoryService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewMarginInterestRateHistoryService", reflect.TypeOf((*MockClientAPI)(nil).NewMarginInterestRateHistoryService))
+}
+
+// NewMarginLoanService mocks base method.
+func (m *MockClientAPI) NewMarginLoanService() binance.MarginLoanServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewMarginLoanService")
+	ret0, _ := ret[0].(binance.MarginLoanServiceAPI)
+	return ret0
+}
+
+// NewMarginLoanService indicates an expected call of NewMarginLoanService.
+func (mr *MockClientAPIMockRecorder) NewMarginLoanService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewMarginLoanService", reflect.TypeOf((*MockClientAPI)(nil).NewMarginLoanService))
+}
+
+// NewMarginNextHourlyInterestRateService mocks base method.
+func (m *MockClientAPI) NewMarginNextHourlyInterestRateService() binance.MarginNextHourlyInterestRateServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewMarginNextHourlyInterestRateService")
+	ret0, _ := ret[0].(binance.MarginNextHourlyInterestRateServiceAPI)
+	return ret0
+}
+
+// NewMarginNextHourlyInterestRateService indicates an expected call of NewMarginNextHourlyInterestRateService.
+func (mr *MockClientAPIMockRecorder) NewMarginNextHourlyInterestRateService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewMarginNextHourlyInterestRateService", reflect.TypeOf((*MockClientAPI)(nil).NewMarginNextHourlyInterestRateService))
+}
+
+// NewMarginRepayService mocks base method.
+func (m *MockClientAPI) NewMarginRepayService() binance.MarginRepayServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewMarginRepayService")
+	ret0, _ := ret[0].(binance.MarginRepayServiceAPI)
+	return ret0
+}
+
+// NewMarginRepayService indicates an expected call of NewMarginRepayService.
+func (mr *MockClientAPIMockRecorder) NewMarginRepayService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewMarginRepayService", reflect.TypeOf((*MockClientAPI)(nil).NewMarginRepayService))
+}
+
+// NewMarginTransferService mocks base method.
+func (m *MockClientAPI) NewMarginTransferService() binance.MarginTransferServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewMarginTransferService")
+	ret0, _ := ret[0].(binance.MarginTransferServiceAPI)
+	return ret0
+}
+
+// NewMarginTransferService indicates an expected call of NewMarginTransferService.
+func (mr *MockClientAPIMockRecorder) NewMarginTransferService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewMarginTransferService", reflect.TypeOf((*MockClientAPI)(nil).NewMarginTransferService))
+}
+
+// NewPayTradeHistoryService mocks base method.
+func (m *MockClientAPI) NewPayTradeHistoryService() binance.PayTradeHistoryServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewPayTradeHistoryService")
+	ret0, _ := ret[0].(binance.PayTradeHistoryServiceAPI)
+	return ret0
+}
+
+// NewPayTradeHistoryService indicates an expected call of NewPayTradeHistoryService.
+func (mr *MockClientAPIMockRecorder) NewPayTradeHistoryService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewPayTradeHistoryService", reflect.TypeOf((*MockClientAPI)(nil).NewPayTradeHistoryService))
+}
+
+// NewPingService mocks base method.
+func (m *MockClientAPI) NewPingService() binance.PingServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewPingService")
+	ret0, _ := ret[0].(binance.PingServiceAPI)
+	return ret0
+}
+
+// NewPingService indicates an expected call of NewPingService.
+func (mr *MockClientAPIMockRecorder) NewPingService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewPingService", reflect.TypeOf((*MockClientAPI)(nil).NewPingService))
+}
+
+// NewPurchaseSavingsFlexibleProductService mocks base method.
+func (m *MockClientAPI) NewPurchaseSavingsFlexibleProductService() binance.PurchaseSavingsFlexibleProductServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewPurchaseSavingsFlexibleProductService")
+	ret0, _ := ret[0].(binance.PurchaseSavingsFlexibleProductServiceAPI)
+	return ret0
+}
+
+// NewPurchaseSavingsFlexibleProductService indicates an expected call of NewPurchaseSavingsFlexibleProductService.
+func (mr *MockClientAPIMockRecorder) NewPurchaseSavingsFlexibleProductService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewPurchaseSavingsFlexibleProductService", reflect.TypeOf((*MockClientAPI)(nil).NewPurchaseSavingsFlexibleProductService))
+}
+
+// NewQueryClaimedRewardHistoryService mocks base method.
+func (m *MockClientAPI) NewQueryClaimedRewardHistoryService() binance.QueryClaimedRewardHistoryServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewQueryClaimedRewardHistoryService")
+	ret0, _ := ret[0].(binance.QueryClaimedRewardHistoryServiceAPI)
+	return ret0
+}
+
+// NewQueryClaimedRewardHistoryService indicates an expected call of NewQueryClaimedRewardHistoryService.
+func (mr *MockClientAPIMockRecorder) NewQueryClaimedRewardHistoryService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewQueryClaimedRewardHistoryService", reflect.TypeOf((*MockClientAPI)(nil).NewQueryClaimedRewardHistoryService))
+}
+
+// NewRateLimitService mocks base method.
+func (m *MockClientAPI) NewRateLimitService() binance.RateLimitServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewRateLimitService")
+	ret0, _ := ret[0].(binance.RateLimitServiceAPI)
+	return ret0
+}
+
+// NewRateLimitService indicates an expected call of NewRateLimitService.
+func (mr *MockClientAPIMockRecorder) NewRateLimitService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewRateLimitService", reflect.TypeOf((*MockClientAPI)(nil).NewRateLimitService))
+}
+
+// NewRecentTradesService mocks base method.
+func (m *MockClientAPI) NewRecentTradesService() binance.RecentTradesServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewRecentTradesService")
+	ret0, _ := ret[0].(binance.RecentTradesServiceAPI)
+	return ret0
+}
+
+// NewRecentTradesService indicates an expected call of NewRecentTradesService.
+func (mr *MockClientAPIMockRecorder) NewRecentTradesService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewRecentTradesService", reflect.TypeOf((*MockClientAPI)(nil).NewRecentTradesService))
+}
+
+// NewRedeemSavingsFlexibleProductService mocks base method.
+func (m *MockClientAPI) NewRedeemSavingsFlexibleProductService() binance.RedeemSavingsFlexibleProductServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewRedeemSavingsFlexibleProductService")
+	ret0, _ := ret[0].(binance.RedeemSavingsFlexibleProductServiceAPI)
+	return ret0
+}
+
+// NewRedeemSavingsFlexibleProductService indicates an expected call of NewRedeemSavingsFlexibleProductService.
+func (mr *MockClientAPIMockRecorder) NewRedeemSavingsFlexibleProductService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewRedeemSavingsFlexibleProductService", reflect.TypeOf((*MockClientAPI)(nil).NewRedeemSavingsFlexibleProductService))
+}
+
+// NewRemoveLiquidityService mocks base method.
+func (m *MockClientAPI) NewRemoveLiquidityService() binance.RemoveLiquidityServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewRemoveLiquidityService")
+	ret0, _ := ret[0].(binance.RemoveLiquidityServiceAPI)
+	return ret0
+}
+
+// NewRemoveLiquidityService indicates an expected call of NewRemoveLiquidityService.
+func (mr *MockClientAPIMockRecorder) NewRemoveLiquidityService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewRemoveLiquidityService", reflect.TypeOf((*MockClientAPI)(nil).NewRemoveLiquidityService))
+}
+
+// NewSavingFixedProjectPositionsService mocks base method.
+func (m *MockClientAPI) NewSavingFixedProjectPositionsService() binance.SavingFixedProjectPositionsServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewSavingFixedProjectPositionsService")
+	ret0, _ := ret[0].(binance.SavingFixedProjectPositionsServiceAPI)
+	return ret0
+}
+
+// NewSavingFixedProjectPositionsService indicates an expected call of NewSavingFixedProjectPositionsService.
+func (mr *MockClientAPIMockRecorder) NewSavingFixedProjectPositionsService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewSavingFixedProjectPositionsService", reflect.TypeOf((*MockClientAPI)(nil).NewSavingFixedProjectPositionsService))
+}
+
+// NewSavingFlexibleProductPositionsService mocks base method.
+func (m *MockClientAPI) NewSavingFlexibleProductPositionsService() binance.SavingFlexibleProductPositionsServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewSavingFlexibleProductPositionsService")
+	ret0, _ := ret[0].(binance.SavingFlexibleProductPositionsServiceAPI)
+	return ret0
+}
+
+// NewSavingFlexibleProductPositionsService indicates an expected call of NewSavingFlexibleProductPositionsService.
+func (mr *MockClientAPIMockRecorder) NewSavingFlexibleProductPositionsService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewSavingFlexibleProductPositionsService", reflect.TypeOf((*MockClientAPI)(nil).NewSavingFlexibleProductPositionsService))
+}
+
+// NewServerTimeService mocks base method.
+func (m *MockClientAPI) NewServerTimeService() binance.ServerTimeServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewServerTimeService")
+	ret0, _ := ret[0].(binance.ServerTimeServiceAPI)
+	return ret0
+}
+
+// NewServerTimeService indicates an expected call of NewServerTimeService.
+func (mr *MockClientAPIMockRecorder) NewServerTimeService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewServerTimeService", reflect.TypeOf((*MockClientAPI)(nil).NewServerTimeService))
+}
+
+// NewSetServerTimeService mocks base method.
+func (m *MockClientAPI) NewSetServerTimeService() binance.SetServerTimeServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewSetServerTimeService")
+	ret0, _ := ret[0].(binance.SetServerTimeServiceAPI)
+	return ret0
+}
+
+// NewSetServerTimeService indicates an expected call of NewSetServerTimeService.
+func (mr *MockClientAPIMockRecorder) NewSetServerTimeService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewSetServerTimeService", reflect.TypeOf((*MockClientAPI)(nil).NewSetServerTimeService))
+}
+
+// NewSimpleEarnService mocks base method.
+func (m *MockClientAPI) NewSimpleEarnService() binance.SimpleEarnServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewSimpleEarnService")
+	ret0, _ := ret[0].(binance.SimpleEarnServiceAPI)
+	return ret0
+}
+
+// NewSimpleEarnService indicates an expected call of NewSimpleEarnService.
+func (mr *MockClientAPIMockRecorder) NewSimpleEarnService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewSimpleEarnService", reflect.TypeOf((*MockClientAPI)(nil).NewSimpleEarnService))
+}
+
+// NewSpotRebateHistoryService mocks base method.
+func (m *MockClientAPI) NewSpotRebateHistoryService() binance.SpotRebateHistoryServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewSpotRebateHistoryService")
+	ret0, _ := ret[0].(binance.SpotRebateHistoryServiceAPI)
+	return ret0
+}
+
+// NewSpotRebateHistoryService indicates an expected call of NewSpotRebateHistoryService.
+func (mr *MockClientAPIMockRecorder) NewSpotRebateHistoryService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewSpotRebateHistoryService", reflect.TypeOf((*MockClientAPI)(nil).NewSpotRebateHistoryService))
+}
+
+// NewStakingHistoryService mocks base method.
+func (m *MockClientAPI) NewStakingHistoryService() binance.StakingHistoryServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewStakingHistoryService")
+	ret0, _ := ret[0].(binance.StakingHistoryServiceAPI)
+	return ret0
+}
+
+// NewStakingHistoryService indicates an expected call of NewStakingHistoryService.
+func (mr *MockClientAPIMockRecorder) NewStakingHistoryService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewStakingHistoryService", reflect.TypeOf((*MockClientAPI)(nil).NewStakingHistoryService))
+}
+
+// NewStakingProductPositionService mocks base method.
+func (m *MockClientAPI) NewStakingProductPositionService() binance.StakingProductPositionServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewStakingProductPositionService")
+	ret0, _ := ret[0].(binance.StakingProductPositionServiceAPI)
+	return ret0
+}
+
+// NewStakingProductPositionService indicates an expected call of NewStakingProductPositionService.
+func (mr *MockClientAPIMockRecorder) NewStakingProductPositionService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewStakingProductPositionService", reflect.TypeOf((*MockClientAPI)(nil).NewStakingProductPositionService))
+}
+
+// NewStartIsolatedMarginUserStreamService mocks base method.
+func (m *MockClientAPI) NewStartIsolatedMarginUserStreamService() binance.StartIsolatedMarginUserStreamServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewStartIsolatedMarginUserStreamService")
+	ret0, _ := ret[0].(binance.StartIsolatedMarginUserStreamServiceAPI)
+	return ret0
+}
+
+// NewStartIsolatedMarginUserStreamService indicates an expected call of NewStartIsolatedMarginUserStreamService.
+func (mr *MockClientAPIMockRecorder) NewStartIsolatedMarginUserStreamService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewStartIsolatedMarginUserStreamService", reflect.TypeOf((*MockClientAPI)(nil).NewStartIsolatedMarginUserStreamService))
+}
+
+// NewStartMarginUserStreamService mocks base method.
+func (m *MockClientAPI) NewStartMarginUserStreamService() binance.StartMarginUserStreamServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewStartMarginUserStreamService")
+	ret0, _ := ret[0].(binance.StartMarginUserStreamServiceAPI)
+	return ret0
+}
+
+// NewStartMarginUserStreamService indicates an expected call of NewStartMarginUserStreamService.
+func (mr *MockClientAPIMockRecorder) NewStartMarginUserStreamService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewStartMarginUserStreamService", reflect.TypeOf((*MockClientAPI)(nil).NewStartMarginUserStreamService))
+}
+
+// NewStartUserStreamService mocks base method.
+func (m *MockClientAPI) NewStartUserStreamService() binance.StartUserStreamServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewStartUserStreamService")
+	ret0, _ := ret[0].(binance.StartUserStreamServiceAPI)
+	return ret0
+}
+
+// NewStartUserStreamService indicates an expected call of NewStartUserStreamService.
+func (mr *MockClientAPIMockRecorder) NewStartUserStreamService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewStartUserStreamService", reflect.TypeOf((*MockClientAPI)(nil).NewStartUserStreamService))
+}
+
+// NewSubAccUniversalTransferHistoryService mocks base method.
+func (m *MockClientAPI) NewSubAccUniversalTransferHistoryService() binance.SubAccUniversalTransferHistoryServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewSubAccUniversalTransferHistoryService")
+	ret0, _ := ret[0].(binance.SubAccUniversalTransferHistoryServiceAPI)
+	return ret0
+}
+
+// NewSubAccUniversalTransferHistoryService indicates an expected call of NewSubAccUniversalTransferHistoryService.
+func (mr *MockClientAPIMockRecorder) NewSubAccUniversalTransferHistoryService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewSubAccUniversalTransferHistoryService", reflect.TypeOf((*MockClientAPI)(nil).NewSubAccUniversalTransferHistoryService))
+}
+
+// NewSubAccountApiAddIpRestrictionService mocks base method.
+func (m *MockClientAPI) NewSubAccountApiAddIpRestrictionService() binance.SubAccountApiAddIpRestrictionServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewSubAccountApiAddIpRestrictionService")
+	ret0, _ := ret[0].(binance.SubAccountApiAddIpRestrictionServiceAPI)
+	return ret0
+}
+
+// NewSubAccountApiAddIpRestrictionService indicates an expected call of NewSubAccountApiAddIpRestrictionService.
+func (mr *MockClientAPIMockRecorder) NewSubAccountApiAddIpRestrictionService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewSubAccountApiAddIpRestrictionService", reflect.TypeOf((*MockClientAPI)(nil).NewSubAccountApiAddIpRestrictionService))
+}
+
+// NewSubAccountApiDeleteIpRestrictionService mocks base method.
+func (m *MockClientAPI) NewSubAccountApiDeleteIpRestrictionService() binance.SubAccountApiDeleteIpRestrictionServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewSubAccountApiDeleteIpRestrictionService")
+	ret0, _ := ret[0].(binance.SubAccountApiDeleteIpRestrictionServiceAPI)
+	return ret0
+}
+
+// NewSubAccountApiDeleteIpRestrictionService indicates an expected call of NewSubAccountApiDeleteIpRestrictionService.
+func (mr *MockClientAPIMockRecorder) NewSubAccountApiDeleteIpRestrictionService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewSubAccountApiDeleteIpRestrictionService", reflect.TypeOf((*MockClientAPI)(nil).NewSubAccountApiDeleteIpRestrictionService))
+}
+
+// NewSubAccountApiIpRestrictionService mocks base method.
+func (m *MockClientAPI) NewSubAccountApiIpRestrictionService() binance.SubAccountApiIpRestrictionServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewSubAccountApiIpRestrictionService")
+	ret0, _ := ret[0].(binance.SubAccountApiIpRestrictionServiceAPI)
+	return ret0
+}
+
+// NewSubAccountApiIpRestrictionService indicates an expected call of NewSubAccountApiIpRestrictionService.
+func (mr *MockClientAPIMockRecorder) NewSubAccountApiIpRestrictionService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewSubAccountApiIpRestrictionService", reflect.TypeOf((*MockClientAPI)(nil).NewSubAccountApiIpRestrictionService))
+}
+
+// NewSubAccountAssetService mocks base method.
+func (m *MockClientAPI) NewSubAccountAssetService() binance.SubAccountAssetServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewSubAccountAssetService")
+	ret0, _ := ret[0].(binance.SubAccountAssetServiceAPI)
+	return ret0
+}
+
+// NewSubAccountAssetService indicates an expected call of NewSubAccountAssetService.
+func (mr *MockClientAPIMockRecorder) NewSubAccountAssetService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewSubAccountAssetService", reflect.TypeOf((*MockClientAPI)(nil).NewSubAccountAssetService))
+}
+
+// NewSubAccountBlvtEnableService mocks base method.
+func (m *MockClientAPI) NewSubAccountBlvtEnableService() binance.SubAccountBlvtEnableServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewSubAccountBlvtEnableService")
+	ret0, _ := ret[0].(binance.SubAccountBlvtEnableServiceAPI)
+	return ret0
+}
+
+// NewSubAccountBlvtEnableService indicates an expected call of NewSubAccountBlvtEnableService.
+func (mr *MockClientAPIMockRecorder) NewSubAccountBlvtEnableService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewSubAccountBlvtEnableService", reflect.TypeOf((*MockClientAPI)(nil).NewSubAccountBlvtEnableService))
+}
+
+// NewSubAccountDepositRecordService mocks base method.
+func (m *MockClientAPI) NewSubAccountDepositRecordService() binance.SubAccountDepositRecordServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewSubAccountDepositRecordService")
+	ret0, _ := ret[0].(binance.SubAccountDepositRecordServiceAPI)
+	return ret0
+}
+
+// NewSubAccountDepositRecordService indicates an expected call of NewSubAccountDepositRecordService.
+func (mr *MockClientAPIMockRecorder) NewSubAccountDepositRecordService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewSubAccountDepositRecordService", reflect.TypeOf((*MockClientAPI)(nil).NewSubAccountDepositRecordService))
+}
+
+// NewSubAccountFuturesAccountService mocks base method.
+func (m *MockClientAPI) NewSubAccountFuturesAccountService() binance.SubAccountFuturesAccountServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewSubAccountFuturesAccountService")
+	ret0, _ := ret[0].(binance.SubAccountFuturesAccountServiceAPI)
+	return ret0
+}
+
+// NewSubAccountFuturesAccountService indicates an expected call of NewSubAccountFuturesAccountService.
+func (mr *MockClientAPIMockRecorder) NewSubAccountFuturesAccountService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewSubAccountFuturesAccountService", reflect.TypeOf((*MockClientAPI)(nil).NewSubAccountFuturesAccountService))
+}
+
+// NewSubAccountFuturesAccountSummaryService mocks base method.
+func (m *MockClientAPI) NewSubAccountFuturesAccountSummaryService() binance.SubAccountFuturesAccountSummaryServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewSubAccountFuturesAccountSummaryService")
+	ret0, _ := ret[0].(binance.SubAccountFuturesAccountSummaryServiceAPI)
+	return ret0
+}
+
+// NewSubAccountFuturesAccountSummaryService indicates an expected call of NewSubAccountFuturesAccountSummaryService.
+func (mr *MockClientAPIMockRecorder) NewSubAccountFuturesAccountSummaryService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewSubAccountFuturesAccountSummaryService", reflect.TypeOf((*MockClientAPI)(nil).NewSubAccountFuturesAccountSummaryService))
+}
+
+// NewSubAccountFuturesAccountV2Service mocks base method.
+func (m *MockClientAPI) NewSubAccountFuturesAccountV2Service() binance.SubAccountFuturesAccountV2ServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewSubAccountFuturesAccountV2Service")
+	ret0, _ := ret[0].(binance.SubAccountFuturesAccountV2ServiceAPI)
+	return ret0
+}
+
+// NewSubAccountFuturesAccountV2Service indicates an expected call of NewSubAccountFuturesAccountV2Service.
+func (mr *MockClientAPIMockRecorder) NewSubAccountFuturesAccountV2Service() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewSubAccountFuturesAccountV2Service", reflect.TypeOf((*MockClientAPI)(nil).NewSubAccountFuturesAccountV2Service))
+}
+
+// NewSubAccountFuturesEnableService mocks base method.
+func (m *MockClientAPI) NewSubAccountFuturesEnableService() binance.SubAccountFuturesEnableServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewSubAccountFuturesEnableService")
+	ret0, _ := ret[0].(binance.SubAccountFuturesEnableServiceAPI)
+	return ret0
+}
+
+// NewSubAccountFuturesEnableService indicates an expected call of NewSubAccountFuturesEnableService.
+func (mr *MockClientAPIMockRecorder) NewSubAccountFuturesEnableService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewSubAccountFuturesEnableService", reflect.TypeOf((*MockClientAPI)(nil).NewSubAccountFuturesEnableService))
+}
+
+// NewSubAccountFuturesInternalTransferService mocks base method.
+func (m *MockClientAPI) NewSubAccountFuturesInternalTransferService() binance.SubAccountFuturesInternalTransferServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewSubAccountFuturesInternalTransferService")
+	ret0, _ := ret[0].(binance.SubAccountFuturesInternalTransferServiceAPI)
+	return ret0
+}
+
+// NewSubAccountFuturesInternalTransferService indicates an expected call of NewSubAccountFuturesInternalTransferService.
+func (mr *MockClientAPIMockRecorder) NewSubAccountFuturesInternalTransferService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewSubAccountFuturesInternalTransferService", reflect.TypeOf((*MockClientAPI)(nil).NewSubAccountFuturesInternalTransferService))
+}
+
+// NewSubAccountFuturesPositionsService mocks base method.
+func (m *MockClientAPI) NewSubAccountFuturesPositionsService() binance.SubAccountFuturesPositionsServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewSubAccountFuturesPositionsService")
+	ret0, _ := ret[0].(binance.SubAccountFuturesPositionsServiceAPI)
+	return ret0
+}
+
+// NewSubAccountFuturesPositionsService indicates an expected call of NewSubAccountFuturesPositionsService.
+func (mr *MockClientAPIMockRecorder) NewSubAccountFuturesPositionsService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewSubAccountFuturesPositionsService", reflect.TypeOf((*MockClientAPI)(nil).NewSubAccountFuturesPositionsService))
+}
+
+// NewSubAccountFuturesSummaryV1Service mocks base method.
+func (m *MockClientAPI) NewSubAccountFuturesSummaryV1Service() binance.SubAccountFuturesSummaryV1ServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewSubAccountFuturesSummaryV1Service")
+	ret0, _ := ret[0].(binance.SubAccountFuturesSummaryV1ServiceAPI)
+	return ret0
+}
+
+// NewSubAccountFuturesSummaryV1Service indicates an expected call of NewSubAccountFuturesSummaryV1Service.
+func (mr *MockClientAPIMockRecorder) NewSubAccountFuturesSummaryV1Service() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewSubAccountFuturesSummaryV1Service", reflect.TypeOf((*MockClientAPI)(nil).NewSubAccountFuturesSummaryV1Service))
+}
+
+// NewSubAccountFuturesTransferHistoryService mocks base method.
+func (m *MockClientAPI) NewSubAccountFuturesTransferHistoryService() binance.SubAccountFuturesTransferHistoryServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewSubAccountFuturesTransferHistoryService")
+	ret0, _ := ret[0].(binance.SubAccountFuturesTransferHistoryServiceAPI)
+	return ret0
+}
+
+// NewSubAccountFuturesTransferHistoryService indicates an expected call of NewSubAccountFuturesTransferHistoryService.
+func (mr *MockClientAPIMockRecorder) NewSubAccountFuturesTransferHistoryService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewSubAccountFuturesTransferHistoryService", reflect.TypeOf((*MockClientAPI)(nil).NewSubAccountFuturesTransferHistoryService))
+}
+
+// NewSubAccountFuturesTransferV1Service mocks base method.
+func (m *MockClientAPI) NewSubAccountFuturesTransferV1Service() binance.SubAccountFuturesTransferV1ServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewSubAccountFuturesTransferV1Service")
+	ret0, _ := ret[0].(binance.SubAccountFuturesTransferV1ServiceAPI)
+	return ret0
+}
+
+// NewSubAccountFuturesTransferV1Service indicates an expected call of NewSubAccountFuturesTransferV1Service.
+func (mr *MockClientAPIMockRecorder) NewSubAccountFuturesTransferV1Service() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewSubAccountFuturesTransferV1Service", reflect.TypeOf((*MockClientAPI)(nil).NewSubAccountFuturesTransferV1Service))
+}
+
+// NewSubAccountListService mocks base method.
+func (m *MockClientAPI) NewSubAccountListService() binance.SubAccountListServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewSubAccountListService")
+	ret0, _ := ret[0].(binance.SubAccountListServiceAPI)
+	return ret0
+}
+
+// NewSubAccountListService indicates an expected call of NewSubAccountListService.
+func (mr *MockClientAPIMockRecorder) NewSubAccountListService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewSubAccountListService", reflect.TypeOf((*MockClientAPI)(nil).NewSubAccountListService))
+}
+
+// NewSubAccountMarginAccountInfoService mocks base method.
+func (m *MockClientAPI) NewSubAccountMarginAccountInfoService() binance.SubAccountMarginAccountInfoServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewSubAccountMarginAccountInfoService")
+	ret0, _ := ret[0].(binance.SubAccountMarginAccountInfoServiceAPI)
+	return ret0
+}
+
+// NewSubAccountMarginAccountInfoService indicates an expected call of NewSubAccountMarginAccountInfoService.
+func (mr *MockClientAPIMockRecorder) NewSubAccountMarginAccountInfoService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewSubAccountMarginAccountInfoService", reflect.TypeOf((*MockClientAPI)(nil).NewSubAccountMarginAccountInfoService))
+}
+
+// NewSubAccountMarginAccountSummaryService mocks base method.
+func (m *MockClientAPI) NewSubAccountMarginAccountSummaryService() binance.SubAccountMarginAccountSummaryServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewSubAccountMarginAccountSummaryService")
+	ret0, _ := ret[0].(binance.SubAccountMarginAccountSummaryServiceAPI)
+	return ret0
+}
+
+// NewSubAccountMarginAccountSummaryService indicates an expected call of NewSubAccountMarginAccountSummaryService.
+func (mr *MockClientAPIMockRecorder) NewSubAccountMarginAccountSummaryService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewSubAccountMarginAccountSummaryService", reflect.TypeOf((*MockClientAPI)(nil).NewSubAccountMarginAccountSummaryService))
+}
+
+// NewSubAccountMarginEnableService mocks base method.
+func (m *MockClientAPI) NewSubAccountMarginEnableService() binance.SubAccountMarginEnableServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewSubAccountMarginEnableService")
+	ret0, _ := ret[0].(binance.SubAccountMarginEnableServiceAPI)
+	return ret0
+}
+
+// NewSubAccountMarginEnableService indicates an expected call of NewSubAccountMarginEnableService.
+func (mr *MockClientAPIMockRecorder) NewSubAccountMarginEnableService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewSubAccountMarginEnableService", reflect.TypeOf((*MockClientAPI)(nil).NewSubAccountMarginEnableService))
+}
+
+// NewSubAccountMarginFuturesStatusService mocks base method.
+func (m *MockClientAPI) NewSubAccountMarginFuturesStatusService() binance.SubAccountMarginFuturesStatusServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewSubAccountMarginFuturesStatusService")
+	ret0, _ := ret[0].(binance.SubAccountMarginFuturesStatusServiceAPI)
+	return ret0
+}
+
+// NewSubAccountMarginFuturesStatusService indicates an expected call of NewSubAccountMarginFuturesStatusService.
+func (mr *MockClientAPIMockRecorder) NewSubAccountMarginFuturesStatusService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewSubAccountMarginFuturesStatusService", reflect.TypeOf((*MockClientAPI)(nil).NewSubAccountMarginFuturesStatusService))
+}
+
+// NewSubAccountMarginTransferService mocks base method.
+func (m *MockClientAPI) NewSubAccountMarginTransferService() binance.SubAccountMarginTransferServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewSubAccountMarginTransferService")
+	ret0, _ := ret[0].(binance.SubAccountMarginTransferServiceAPI)
+	return ret0
+}
+
+// NewSubAccountMarginTransferService indicates an expected call of NewSubAccountMarginTransferService.
+func (mr *MockClientAPIMockRecorder) NewSubAccountMarginTransferService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewSubAccountMarginTransferService", reflect.TypeOf((*MockClientAPI)(nil).NewSubAccountMarginTransferService))
+}
+
+// NewSubAccountOptionsEnableService mocks base method.
+func (m *MockClientAPI) NewSubAccountOptionsEnableService() binance.SubAccountOptionsEnableServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewSubAccountOptionsEnableService")
+	ret0, _ := ret[0].(binance.SubAccountOptionsEnableServiceAPI)
+	return ret0
+}
+
+// NewSubAccountOptionsEnableService indicates an expected call of NewSubAccountOptionsEnableService.
+func (mr *MockClientAPIMockRecorder) NewSubAccountOptionsEnableService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewSubAccountOptionsEnableService", reflect.TypeOf((*MockClientAPI)(nil).NewSubAccountOptionsEnableService))
+}
+
+// NewSubAccountSpotTransferHistoryService mocks base method.
+func (m *MockClientAPI) NewSubAccountSpotTransferHistoryService() binance.SubAccountSpotTransferHistoryServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewSubAccountSpotTransferHistoryService")
+	ret0, _ := ret[0].(binance.SubAccountSpotTransferHistoryServiceAPI)
+	return ret0
+}
+
+// NewSubAccountSpotTransferHistoryService indicates an expected call of NewSubAccountSpotTransferHistoryService.
+func (mr *MockClientAPIMockRecorder) NewSubAccountSpotTransferHistoryService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewSubAccountSpotTransferHistoryService", reflect.TypeOf((*MockClientAPI)(nil).NewSubAccountSpotTransferHistoryService))
+}
+
+// NewSubAccountTransactionStatisticsService mocks base method.
+func (m *MockClientAPI) NewSubAccountTransactionStatisticsService() binance.SubAccountTransactionStatisticsServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewSubAccountTransactionStatisticsService")
+	ret0, _ := ret[0].(binance.SubAccountTransactionStatisticsServiceAPI)
+	return ret0
+}
+
+// NewSubAccountTransactionStatisticsService indicates an expected call of NewSubAccountTransactionStatisticsService.
+func (mr *MockClientAPIMockRecorder) NewSubAccountTransactionStatisticsService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewSubAccountTransactionStatisticsService", reflect.TypeOf((*MockClientAPI)(nil).NewSubAccountTransactionStatisticsService))
+}
+
+// NewSubAccountTransferHistoryService mocks base method.
+func (m *MockClientAPI) NewSubAccountTransferHistoryService() binance.SubAccountTransferHistoryServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewSubAccountTransferHistoryService")
+	ret0, _ := ret[0].(binance.SubAccountTransferHistoryServiceAPI)
+	return ret0
+}
+
+// NewSubAccountTransferHistoryService indicates an expected call of NewSubAccountTransferHistoryService.
+func (mr *MockClientAPIMockRecorder) NewSubAccountTransferHistoryService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewSubAccountTransferHistoryService", reflect.TypeOf((*MockClientAPI)(nil).NewSubAccountTransferHistoryService))
+}
+
+// NewSubAccountTransferSubToMasterService mocks base method.
+func (m *MockClientAPI) NewSubAccountTransferSubToMasterService() binance.SubAccountTransferSubToMasterServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewSubAccountTransferSubToMasterService")
+	ret0, _ := ret[0].(binance.SubAccountTransferSubToMasterServiceAPI)
+	return ret0
+}
+
+// NewSubAccountTransferSubToMasterService indicates an expected call of NewSubAccountTransferSubToMasterService.
+func (mr *MockClientAPIMockRecorder) NewSubAccountTransferSubToMasterService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewSubAccountTransferSubToMasterService", reflect.TypeOf((*MockClientAPI)(nil).NewSubAccountTransferSubToMasterService))
+}
+
+// NewSubAccountUniversalTransferService mocks base method.
+func (m *MockClientAPI) NewSubAccountUniversalTransferService() binance.SubAccountUniversalTransferServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewSubAccountUniversalTransferService")
+	ret0, _ := ret[0].(binance.SubAccountUniversalTransferServiceAPI)
+	return ret0
+}
+
+// NewSubAccountUniversalTransferService indicates an expected call of NewSubAccountUniversalTransferService.
+func (mr *MockClientAPIMockRecorder) NewSubAccountUniversalTransferService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewSubAccountUniversalTransferService", reflect.TypeOf((*MockClientAPI)(nil).NewSubAccountUniversalTransferService))
+}
+
+// NewSubaccountAssetsService mocks base method.
+func (m *MockClientAPI) NewSubaccountAssetsService() binance.SubaccountAssetsServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewSubaccountAssetsService")
+	ret0, _ := ret[0].(binance.SubaccountAssetsServiceAPI)
+	return ret0
+}
+
+// NewSubaccountAssetsService indicates an expected call of NewSubaccountAssetsService.
+func (mr *MockClientAPIMockRecorder) NewSubaccountAssetsService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewSubaccountAssetsService", reflect.TypeOf((*MockClientAPI)(nil).NewSubaccountAssetsService))
+}
+
+// NewSubaccountDepositAddressService mocks base method.
+func (m *MockClientAPI) NewSubaccountDepositAddressService() binance.SubaccountDepositAddressServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewSubaccountDepositAddressService")
+	ret0, _ := ret[0].(binance.SubaccountDepositAddressServiceAPI)
+	return ret0
+}
+
+// NewSubaccountDepositAddressService indicates an expected call of NewSubaccountDepositAddressService.
+func (mr *MockClientAPIMockRecorder) NewSubaccountDepositAddressService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewSubaccountDepositAddressService", reflect.TypeOf((*MockClientAPI)(nil).NewSubaccountDepositAddressService))
+}
+
+// NewSubaccountSpotSummaryService mocks base method.
+func (m *MockClientAPI) NewSubaccountSpotSummaryService() binance.SubaccountSpotSummaryServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewSubaccountSpotSummaryService")
+	ret0, _ := ret[0].(binance.SubaccountSpotSummaryServiceAPI)
+	return ret0
+}
+
+// NewSubaccountSpotSummaryService indicates an expected call of NewSubaccountSpotSummaryService.
+func (mr *MockClientAPIMockRecorder) NewSubaccountSpotSummaryService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewSubaccountSpotSummaryService", reflect.TypeOf((*MockClientAPI)(nil).NewSubaccountSpotSummaryService))
+}
+
+// NewSwapService mocks base method.
+func (m *MockClientAPI) NewSwapService() binance.SwapServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewSwapService")
+	ret0, _ := ret[0].(binance.SwapServiceAPI)
+	return ret0
+}
+
+// NewSwapService indicates an expected call of NewSwapService.
+func (mr *MockClientAPIMockRecorder) NewSwapService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewSwapService", reflect.TypeOf((*MockClientAPI)(nil).NewSwapService))
+}
+
+// NewToggleBNBBurnService mocks base method.
+func (m *MockClientAPI) NewToggleBNBBurnService() binance.ToggleBNBBurnServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewToggleBNBBurnService")
+	ret0, _ := ret[0].(binance.ToggleBNBBurnServiceAPI)
+	return ret0
+}
+
+// NewToggleBNBBurnService indicates an expected call of NewToggleBNBBurnService.
+func (mr *MockClientAPIMockRecorder) NewToggleBNBBurnService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewToggleBNBBurnService", reflect.TypeOf((*MockClientAPI)(nil).NewToggleBNBBurnService))
+}
+
+// NewTradeFeeService mocks base method.
+func (m *MockClientAPI) NewTradeFeeService() binance.TradeFeeServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewTradeFeeService")
+	ret0, _ := ret[0].(binance.TradeFeeServiceAPI)
+	return ret0
+}
+
+// NewTradeFeeService indicates an expected call of NewTradeFeeService.
+func (mr *MockClientAPIMockRecorder) NewTradeFeeService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewTradeFeeService", reflect.TypeOf((*MockClientAPI)(nil).NewTradeFeeService))
+}
+
+// NewTradingDayTickerService mocks base method.
+func (m *MockClientAPI) NewTradingDayTickerService() binance.TradingDayTickerServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewTradingDayTickerService")
+	ret0, _ := ret[0].(binance.TradingDayTickerServiceAPI)
+	return ret0
+}
+
+// NewTradingDayTickerService indicates an expected call of NewTradingDayTickerService.
+func (mr *MockClientAPIMockRecorder) NewTradingDayTickerService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewTradingDayTickerService", reflect.TypeOf((*MockClientAPI)(nil).NewTradingDayTickerService))
+}
+
+// NewTransferToSubAccountService mocks base method.
+func (m *MockClientAPI) NewTransferToSubAccountService() binance.TransferToSubAccountServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewTransferToSubAccountService")
+	ret0, _ := ret[0].(binance.TransferToSubAccountServiceAPI)
+	return ret0
+}
+
+// NewTransferToSubAccountService indicates an expected call of NewTransferToSubAccountService.
+func (mr *MockClientAPIMockRecorder) NewTransferToSubAccountService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewTransferToSubAccountService", reflect.TypeOf((*MockClientAPI)(nil).NewTransferToSubAccountService))
+}
+
+// NewUiKlinesService mocks base method.
+func (m *MockClientAPI) NewUiKlinesService() binance.UiKlinesServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewUiKlinesService")
+	ret0, _ := ret[0].(binance.UiKlinesServiceAPI)
+	return ret0
+}
+
+// NewUiKlinesService indicates an expected call of NewUiKlinesService.
+func (mr *MockClientAPIMockRecorder) NewUiKlinesService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewUiKlinesService", reflect.TypeOf((*MockClientAPI)(nil).NewUiKlinesService))
+}
+
+// NewUserUniversalTransferService mocks base method.
+func (m *MockClientAPI) NewUserUniversalTransferService() binance.CreateUserUniversalTransferServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewUserUniversalTransferService")
+	ret0, _ := ret[0].(binance.CreateUserUniversalTransferServiceAPI)
+	return ret0
+}
+
+// NewUserUniversalTransferService indicates an expected call of NewUserUniversalTransferService.
+func (mr *MockClientAPIMockRecorder) NewUserUniversalTransferService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewUserUniversalTransferService", reflect.TypeOf((*MockClientAPI)(nil).NewUserUniversalTransferService))
+}
+
+// NewWalletBalanceService mocks base method.
+func (m *MockClientAPI) NewWalletBalanceService() binance.WalletBalanceServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewWalletBalanceService")
+	ret0, _ := ret[0].(binance.WalletBalanceServiceAPI)
+	return ret0
+}
+
+// NewWalletBalanceService indicates an expected call of NewWalletBalanceService.
+func (mr *MockClientAPIMockRecorder) NewWalletBalanceService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewWalletBalanceService", reflect.TypeOf((*MockClientAPI)(nil).NewWalletBalanceService))
+}
+
+// MockPingServiceAPI is a mock of PingServiceAPI interface.
+type MockPingServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockPingServiceAPIMockRecorder
+}
+
+// MockPingServiceAPIMockRecorder is the mock recorder for MockPingServiceAPI.
+type MockPingServiceAPIMockRecorder struct {
+	mock *MockPingServiceAPI
+}
+
+// NewMockPingServiceAPI creates a new mock instance.
+func NewMockPingServiceAPI(ctrl *gomock.Controller) *MockPingServiceAPI {
+	mock := &MockPingServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockPingServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPingServiceAPI) EXPECT() *MockPingServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockPingServiceAPI) Do(ctx context.Context, opts ...binance.RequestOption) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockPingServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockPingServiceAPI)(nil).Do), varargs...)
+}
+
+// MockServerTimeServiceAPI is a mock of ServerTimeServiceAPI interface.
+type MockServerTimeServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockServerTimeServiceAPIMockRecorder
+}
+
+// MockServerTimeServiceAPIMockRecorder is the mock recorder for MockServerTimeServiceAPI.
+type MockServerTimeServiceAPIMockRecorder struct {
+	mock *MockServerTimeServiceAPI
+}
+
+// NewMockServerTimeServiceAPI creates a new mock instance.
+func NewMockServerTimeServiceAPI(ctrl *gomock.Controller) *MockServerTimeServiceAPI {
+	mock := &MockServerTimeServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockServerTimeServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockServerTimeServiceAPI) EXPECT() *MockServerTimeServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockServerTimeServiceAPI) Do(ctx context.Context, opts ...binance.RequestOption) (int64, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockServerTimeServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockServerTimeServiceAPI)(nil).Do), varargs...)
+}
+
+// MockSetServerTimeServiceAPI is a mock of SetServerTimeServiceAPI interface.
+type MockSetServerTimeServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockSetServerTimeServiceAPIMockRecorder
+}
+
+// MockSetServerTimeServiceAPIMockRecorder is the mock recorder for MockSetServerTimeServiceAPI.
+type MockSetServerTimeServiceAPIMockRecorder struct {
+	mock *MockSetServerTimeServiceAPI
+}
+
+// NewMockSetServerTimeServiceAPI creates a new mock instance.
+func NewMockSetServerTimeServiceAPI(ctrl *gomock.Controller) *MockSetServerTimeServiceAPI {
+	mock := &MockSetServerTimeServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockSetServerTimeServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSetServerTimeServiceAPI) EXPECT() *MockSetServerTimeServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockSetServerTimeServiceAPI) Do(ctx context.Context, opts ...binance.RequestOption) (int64, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockSetServerTimeServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockSetServerTimeServiceAPI)(nil).Do), varargs...)
+}
+
+// MockDepthServiceAPI is a mock of DepthServiceAPI interface.
+type MockDepthServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockDepthServiceAPIMockRecorder
+}
+
+// MockDepthServiceAPIMockRecorder is the mock recorder for MockDepthServiceAPI.
+type MockDepthServiceAPIMockRecorder struct {
+	mock *MockDepthServiceAPI
+}
+
+// NewMockDepthServiceAPI creates a new mock instance.
+func NewMockDepthServiceAPI(ctrl *gomock.Controller) *MockDepthServiceAPI {
+	mock := &MockDepthServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockDepthServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDepthServiceAPI) EXPECT() *MockDepthServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockDepthServiceAPI) Do(ctx context.Context, opts ...binance.RequestOption) (*binance.DepthResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*binance.DepthResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockDepthServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockDepthServiceAPI)(nil).Do), varargs...)
+}
+
+// Limit mocks base method.
+func (m *MockDepthServiceAPI) Limit(limit int) binance.DepthServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Limit", limit)
+	ret0, _ := ret[0].(binance.DepthServiceAPI)
+	return ret0
+}
+
+// Limit indicates an expected call of Limit.
+func (mr *MockDepthServiceAPIMockRecorder) Limit(limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Limit", reflect.TypeOf((*MockDepthServiceAPI)(nil).Limit), limit)
+}
+
+// Symbol mocks base method.
+func (m *MockDepthServiceAPI) Symbol(symbol string) binance.DepthServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Symbol", symbol)
+	ret0, _ := ret[0].(binance.DepthServiceAPI)
+	return ret0
+}
+
+// Symbol indicates an expected call of Symbol.
+func (mr *MockDepthServiceAPIMockRecorder) Symbol(symbol interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Symbol", reflect.TypeOf((*MockDepthServiceAPI)(nil).Symbol), symbol)
+}
+
+// MockAggTradesServiceAPI is a mock of AggTradesServiceAPI interface.
+type MockAggTradesServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockAggTradesServiceAPIMockRecorder
+}
+
+// MockAggTradesServiceAPIMockRecorder is the mock recorder for MockAggTradesServiceAPI.
+type MockAggTradesServiceAPIMockRecorder struct {
+	mock *MockAggTradesServiceAPI
+}
+
+// NewMockAggTradesServiceAPI creates a new mock instance.
+func NewMockAggTradesServiceAPI(ctrl *gomock.Controller) *MockAggTradesServiceAPI {
+	mock := &MockAggTradesServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockAggTradesServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAggTradesServiceAPI) EXPECT() *MockAggTradesServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockAggTradesServiceAPI) Do(ctx context.Context, opts ...binance.RequestOption) ([]*binance.AggTrade, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].([]*binance.AggTrade)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockAggTradesServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockAggTradesServiceAPI)(nil).Do), varargs...)
+}
+
+// EndTime mocks base method.
+func (m *MockAggTradesServiceAPI) EndTime(endTime int64) binance.AggTradesServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EndTime", endTime)
+	ret0, _ := ret[0].(binance.AggTradesServiceAPI)
+	return ret0
+}
+
+// EndTime indicates an expected call of EndTime.
+func (mr *MockAggTradesServiceAPIMockRecorder) EndTime(endTime interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EndTime", reflect.TypeOf((*MockAggTradesServiceAPI)(nil).EndTime), endTime)
+}
+
+// FromID mocks base method.
+func (m *MockAggTradesServiceAPI) FromID(fromID int64) binance.AggTradesServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FromID", fromID)
+	ret0, _ := ret[0].(binance.AggTradesServiceAPI)
+	return ret0
+}
+
+// FromID indicates an expected call of FromID.
+func (mr *MockAggTradesServiceAPIMockRecorder) FromID(fromID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FromID", reflect.TypeOf((*MockAggTradesServiceAPI)(nil).FromID), fromID)
+}
+
+// Limit mocks base method.
+func (m *MockAggTradesServiceAPI) Limit(limit int) binance.AggTradesServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Limit", limit)
+	ret0, _ := ret[0].(binance.AggTradesServiceAPI)
+	return ret0
+}
+
+// Limit indicates an expected call of Limit.
+func (mr *MockAggTradesServiceAPIMockRecorder) Limit(limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Limit", reflect.TypeOf((*MockAggTradesServiceAPI)(nil).Limit), limit)
+}
+
+// StartTime mocks base method.
+func (m *MockAggTradesServiceAPI) StartTime(startTime int64) binance.AggTradesServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartTime", startTime)
+	ret0, _ := ret[0].(binance.AggTradesServiceAPI)
+	return ret0
+}
+
+// StartTime indicates an expected call of StartTime.
+func (mr *MockAggTradesServiceAPIMockRecorder) StartTime(startTime interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartTime", reflect.TypeOf((*MockAggTradesServiceAPI)(nil).StartTime), startTime)
+}
+
+// Symbol mocks base method.
+func (m *MockAggTradesServiceAPI) Symbol(symbol string) binance.AggTradesServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Symbol", symbol)
+	ret0, _ := ret[0].(binance.AggTradesServiceAPI)
+	return ret0
+}
+
+// Symbol indicates an expected call of Symbol.
+func (mr *MockAggTradesServiceAPIMockRecorder) Symbol(symbol interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Symbol", reflect.TypeOf((*MockAggTradesServiceAPI)(nil).Symbol), symbol)
+}
+
+// MockRecentTradesServiceAPI is a mock of RecentTradesServiceAPI interface.
+type MockRecentTradesServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockRecentTradesServiceAPIMockRecorder
+}
+
+// MockRecentTradesServiceAPIMockRecorder is the mock recorder for MockRecentTradesServiceAPI.
+type MockRecentTradesServiceAPIMockRecorder struct {
+	mock *MockRecentTradesServiceAPI
+}
+
+// NewMockRecentTradesServiceAPI creates a new mock instance.
+func NewMockRecentTradesServiceAPI(ctrl *gomock.Controller) *MockRecentTradesServiceAPI {
+	mock := &MockRecentTradesServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockRecentTradesServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRecentTradesServiceAPI) EXPECT() *MockRecentTradesServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockRecentTradesServiceAPI) Do(ctx context.Context, opts ...binance.RequestOption) ([]*binance.Trade, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].([]*binance.Trade)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockRecentTradesServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockRecentTradesServiceAPI)(nil).Do), varargs...)
+}
+
+// Limit mocks base method.
+func (m *MockRecentTradesServiceAPI) Limit(limit int) binance.RecentTradesServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Limit", limit)
+	ret0, _ := ret[0].(binance.RecentTradesServiceAPI)
+	return ret0
+}
+
+// Limit indicates an expected call of Limit.
+func (mr *MockRecentTradesServiceAPIMockRecorder) Limit(limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Limit", reflect.TypeOf((*MockRecentTradesServiceAPI)(nil).Limit), limit)
+}
+
+// Symbol mocks base method.
+func (m *MockRecentTradesServiceAPI) Symbol(symbol string) binance.RecentTradesServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Symbol", symbol)
+	ret0, _ := ret[0].(binance.RecentTradesServiceAPI)
+	return ret0
+}
+
+// Symbol indicates an expected call of Symbol.
+func (mr *MockRecentTradesServiceAPIMockRecorder) Symbol(symbol interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Symbol", reflect.TypeOf((*MockRecentTradesServiceAPI)(nil).Symbol), symbol)
+}
+
+// MockKlinesServiceAPI is a mock of KlinesServiceAPI interface.
+type MockKlinesServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockKlinesServiceAPIMockRecorder
+}
+
+// MockKlinesServiceAPIMockRecorder is the mock recorder for MockKlinesServiceAPI.
+type MockKlinesServiceAPIMockRecorder struct {
+	mock *MockKlinesServiceAPI
+}
+
+// NewMockKlinesServiceAPI creates a new mock instance.
+func NewMockKlinesServiceAPI(ctrl *gomock.Controller) *MockKlinesServiceAPI {
+	mock := &MockKlinesServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockKlinesServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockKlinesServiceAPI) EXPECT() *MockKlinesServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockKlinesServiceAPI) Do(ctx context.Context, opts ...binance.RequestOption) ([]*binance.Kline, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].([]*binance.Kline)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockKlinesServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockKlinesServiceAPI)(nil).Do), varargs...)
+}
+
+// EndTime mocks base method.
+func (m *MockKlinesServiceAPI) EndTime(endTime int64) binance.KlinesServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EndTime", endTime)
+	ret0, _ := ret[0].(binance.KlinesServiceAPI)
+	return ret0
+}
+
+// EndTime indicates an expected call of EndTime.
+func (mr *MockKlinesServiceAPIMockRecorder) EndTime(endTime interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EndTime", reflect.TypeOf((*MockKlinesServiceAPI)(nil).EndTime), endTime)
+}
+
+// Interval mocks base method.
+func (m *MockKlinesServiceAPI) Interval(interval string) binance.KlinesServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Interval", interval)
+	ret0, _ := ret[0].(binance.KlinesServiceAPI)
+	return ret0
+}
+
+// Interval indicates an expected call of Interval.
+func (mr *MockKlinesServiceAPIMockRecorder) Interval(interval interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Interval", reflect.TypeOf((*MockKlinesServiceAPI)(nil).Interval), interval)
+}
+
+// Limit mocks base method.
+func (m *MockKlinesServiceAPI) Limit(limit int) binance.KlinesServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Limit", limit)
+	ret0, _ := ret[0].(binance.KlinesServiceAPI)
+	return ret0
+}
+
+// Limit indicates an expected call of Limit.
+func (mr *MockKlinesServiceAPIMockRecorder) Limit(limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Limit", reflect.TypeOf((*MockKlinesServiceAPI)(nil).Limit), limit)
+}
+
+// StartTime mocks base method.
+func (m *MockKlinesServiceAPI) StartTime(startTime int64) binance.KlinesServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartTime", startTime)
+	ret0, _ := ret[0].(binance.KlinesServiceAPI)
+	return ret0
+}
+
+// StartTime indicates an expected call of StartTime.
+func (mr *MockKlinesServiceAPIMockRecorder) StartTime(startTime interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartTime", reflect.TypeOf((*MockKlinesServiceAPI)(nil).StartTime), startTime)
+}
+
+// Symbol mocks base method.
+func (m *MockKlinesServiceAPI) Symbol(symbol string) binance.KlinesServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Symbol", symbol)
+	ret0, _ := ret[0].(binance.KlinesServiceAPI)
+	return ret0
+}
+
+// Symbol indicates an expected call of Symbol.
+func (mr *MockKlinesServiceAPIMockRecorder) Symbol(symbol interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Symbol", reflect.TypeOf((*MockKlinesServiceAPI)(nil).Symbol), symbol)
+}
+
+// TimeZone mocks base method.
+func (m *MockKlinesServiceAPI) TimeZone(timeZone string) binance.KlinesServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TimeZone", timeZone)
+	ret0, _ := ret[0].(binance.KlinesServiceAPI)
+	return ret0
+}
+
+// TimeZone indicates an expected call of TimeZone.
+func (mr *MockKlinesServiceAPIMockRecorder) TimeZone(timeZone interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TimeZone", reflect.TypeOf((*MockKlinesServiceAPI)(nil).TimeZone), timeZone)
+}
+
+// MockUiKlinesServiceAPI is a mock of UiKlinesServiceAPI interface.
+type MockUiKlinesServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockUiKlinesServiceAPIMockRecorder
+}
+
+// MockUiKlinesServiceAPIMockRecorder is the mock recorder for MockUiKlinesServiceAPI.
+type MockUiKlinesServiceAPIMockRecorder struct {
+	mock *MockUiKlinesServiceAPI
+}
+
+// NewMockUiKlinesServiceAPI creates a new mock instance.
+func NewMockUiKlinesServiceAPI(ctrl *gomock.Controller) *MockUiKlinesServiceAPI {
+	mock := &MockUiKlinesServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockUiKlinesServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUiKlinesServiceAPI) EXPECT() *MockUiKlinesServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockUiKlinesServiceAPI) Do(ctx context.Context, opts ...binance.RequestOption) ([]*binance.UiKline, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].([]*binance.UiKline)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockUiKlinesServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockUiKlinesServiceAPI)(nil).Do), varargs...)
+}
+
+// EndTime mocks base method.
+func (m *MockUiKlinesServiceAPI) EndTime(endTime uint64) binance.UiKlinesServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EndTime", endTime)
+	ret0, _ := ret[0].(binance.UiKlinesServiceAPI)
+	return ret0
+}
+
+// EndTime indicates an expected call of EndTime.
+func (mr *MockUiKlinesServiceAPIMockRecorder) EndTime(endTime interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EndTime", reflect.TypeOf((*MockUiKlinesServiceAPI)(nil).EndTime), endTime)
+}
+
+// Interval mocks base method.
+func (m *MockUiKlinesServiceAPI) Interval(interval string) binance.UiKlinesServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Interval", interval)
+	ret0, _ := ret[0].(binance.UiKlinesServiceAPI)
+	return ret0
+}
+
+// Interval indicates an expected call of Interval.
+func (mr *MockUiKlinesServiceAPIMockRecorder) Interval(interval interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Interval", reflect.TypeOf((*MockUiKlinesServiceAPI)(nil).Interval), interval)
+}
+
+// Limit mocks base method.
+func (m *MockUiKlinesServiceAPI) Limit(limit uint32) binance.UiKlinesServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Limit", limit)
+	ret0, _ := ret[0].(binance.UiKlinesServiceAPI)
+	return ret0
+}
+
+// Limit indicates an expected call of Limit.
+func (mr *MockUiKlinesServiceAPIMockRecorder) Limit(limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Limit", reflect.TypeOf((*MockUiKlinesServiceAPI)(nil).Limit), limit)
+}
+
+// StartTime mocks base method.
+func (m *MockUiKlinesServiceAPI) StartTime(startTime uint64) binance.UiKlinesServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartTime", startTime)
+	ret0, _ := ret[0].(binance.UiKlinesServiceAPI)
+	return ret0
+}
+
+// StartTime indicates an expected call of StartTime.
+func (mr *MockUiKlinesServiceAPIMockRecorder) StartTime(startTime interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartTime", reflect.TypeOf((*MockUiKlinesServiceAPI)(nil).StartTime), startTime)
+}
+
+// Symbol mocks base method.
+func (m *MockUiKlinesServiceAPI) Symbol(symbol string) binance.UiKlinesServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Symbol", symbol)
+	ret0, _ := ret[0].(binance.UiKlinesServiceAPI)
+	return ret0
+}
+
+// Symbol indicates an expected call of Symbol.
+func (mr *MockUiKlinesServiceAPIMockRecorder) Symbol(symbol interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Symbol", reflect.TypeOf((*MockUiKlinesServiceAPI)(nil).Symbol), symbol)
+}
+
+// TimeZone mocks base method.
+func (m *MockUiKlinesServiceAPI) TimeZone(timeZone string) binance.UiKlinesServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TimeZone", timeZone)
+	ret0, _ := ret[0].(binance.UiKlinesServiceAPI)
+	return ret0
+}
+
+// TimeZone indicates an expected call of TimeZone.
+func (mr *MockUiKlinesServiceAPIMockRecorder) TimeZone(timeZone interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TimeZone", reflect.TypeOf((*MockUiKlinesServiceAPI)(nil).TimeZone), timeZone)
+}
+
+// MockListPriceChangeStatsServiceAPI is a mock of ListPriceChangeStatsServiceAPI interface.
+type MockListPriceChangeStatsServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockListPriceChangeStatsServiceAPIMockRecorder
+}
+
+// MockListPriceChangeStatsServiceAPIMockRecorder is the mock recorder for MockListPriceChangeStatsServiceAPI.
+type MockListPriceChangeStatsServiceAPIMockRecorder struct {
+	mock *MockListPriceChangeStatsServiceAPI
+}
+
+// NewMockListPriceChangeStatsServiceAPI creates a new mock instance.
+func NewMockListPriceChangeStatsServiceAPI(ctrl *gomock.Controller) *MockListPriceChangeStatsServiceAPI {
+	mock := &MockListPriceChangeStatsServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockListPriceChangeStatsServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockListPriceChangeStatsServiceAPI) EXPECT() *MockListPriceChangeStatsServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockListPriceChangeStatsServiceAPI) Do(ctx context.Context, opts ...binance.RequestOption) ([]*binance.PriceChangeStats, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].([]*binance.PriceChangeStats)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockListPriceChangeStatsServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockListPriceChangeStatsServiceAPI)(nil).Do), varargs...)
+}
+
+// Symbol mocks base method.
+func (m *MockListPriceChangeStatsServiceAPI) Symbol(symbol string) binance.ListPriceChangeStatsServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Symbol", symbol)
+	ret0, _ := ret[0].(binance.ListPriceChangeStatsServiceAPI)
+	return ret0
+}
+
+// Symbol indicates an expected call of Symbol.
+func (mr *MockListPriceChangeStatsServiceAPIMockRecorder) Symbol(symbol interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Symbol", reflect.TypeOf((*MockListPriceChangeStatsServiceAPI)(nil).Symbol), symbol)
+}
+
+// Symbols mocks base method.
+func (m *MockListPriceChangeStatsServiceAPI) Symbols(symbols []string) binance.ListPriceChangeStatsServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Symbols", symbols)
+	ret0, _ := ret[0].(binance.ListPriceChangeStatsServiceAPI)
+	return ret0
+}
+
+// Symbols indicates an expected call of Symbols.
+func (mr *MockListPriceChangeStatsServiceAPIMockRecorder) Symbols(symbols interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Symbols", reflect.TypeOf((*MockListPriceChangeStatsServiceAPI)(nil).Symbols), symbols)
+}
+
+// MockListPricesServiceAPI is a mock of ListPricesServiceAPI interface.
+type MockListPricesServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockListPricesServiceAPIMockRecorder
+}
+
+// MockListPricesServiceAPIMockRecorder is the mock recorder for MockListPricesServiceAPI.
+type MockListPricesServiceAPIMockRecorder struct {
+	mock *MockListPricesServiceAPI
+}
+
+// NewMockListPricesServiceAPI creates a new mock instance.
+func NewMockListPricesServiceAPI(ctrl *gomock.Controller) *MockListPricesServiceAPI {
+	mock := &MockListPricesServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockListPricesServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockListPricesServiceAPI) EXPECT() *MockListPricesServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockListPricesServiceAPI) Do(ctx context.Context, opts ...binance.RequestOption) ([]*binance.SymbolPrice, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].([]*binance.SymbolPrice)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockListPricesServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockListPricesServiceAPI)(nil).Do), varargs...)
+}
+
+// Symbol mocks base method.
+func (m *MockListPricesServiceAPI) Symbol(symbol string) binance.ListPricesServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Symbol", symbol)
+	ret0, _ := ret[0].(binance.ListPricesServiceAPI)
+	return ret0
+}
+
+// Symbol indicates an expected call of Symbol.
+func (mr *MockListPricesServiceAPIMockRecorder) Symbol(symbol interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Symbol", reflect.TypeOf((*MockListPricesServiceAPI)(nil).Symbol), symbol)
+}
+
+// Symbols mocks base method.
+func (m *MockListPricesServiceAPI) Symbols(symbols []string) binance.ListPricesServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Symbols", symbols)
+	ret0, _ := ret[0].(binance.ListPricesServiceAPI)
+	return ret0
+}
+
+// Symbols indicates an expected call of Symbols.
+func (mr *MockListPricesServiceAPIMockRecorder) Symbols(symbols interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Symbols", reflect.TypeOf((*MockListPricesServiceAPI)(nil).Symbols), symbols)
+}
+
+// MockTradingDayTickerServiceAPI is a mock of TradingDayTickerServiceAPI interface.
+type MockTradingDayTickerServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockTradingDayTickerServiceAPIMockRecorder
+}
+
+// MockTradingDayTickerServiceAPIMockRecorder is the mock recorder for MockTradingDayTickerServiceAPI.
+type MockTradingDayTickerServiceAPIMockRecorder struct {
+	mock *MockTradingDayTickerServiceAPI
+}
+
+// NewMockTradingDayTickerServiceAPI creates a new mock instance.
+func NewMockTradingDayTickerServiceAPI(ctrl *gomock.Controller) *MockTradingDayTickerServiceAPI {
+	mock := &MockTradingDayTickerServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockTradingDayTickerServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTradingDayTickerServiceAPI) EXPECT() *MockTradingDayTickerServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockTradingDayTickerServiceAPI) Do(ctx context.Context, opts ...binance.RequestOption) ([]*binance.TradingDayTicker, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].([]*binance.TradingDayTicker)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockTradingDayTickerServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockTradingDayTickerServiceAPI)(nil).Do), varargs...)
+}
+
+// Symbol mocks base method.
+func (m *MockTradingDayTickerServiceAPI) Symbol(symbol string) binance.TradingDayTickerServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Symbol", symbol)
+	ret0, _ := ret[0].(binance.TradingDayTickerServiceAPI)
+	return ret0
+}
+
+// Symbol indicates an expected call of Symbol.
+func (mr *MockTradingDayTickerServiceAPIMockRecorder) Symbol(symbol interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Symbol", reflect.TypeOf((*MockTradingDayTickerServiceAPI)(nil).Symbol), symbol)
+}
+
+// Symbols mocks base method.
+func (m *MockTradingDayTickerServiceAPI) Symbols(symbols []string) binance.TradingDayTickerServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Symbols", symbols)
+	ret0, _ := ret[0].(binance.TradingDayTickerServiceAPI)
+	return ret0
+}
+
+// Symbols indicates an expected call of Symbols.
+func (mr *MockTradingDayTickerServiceAPIMockRecorder) Symbols(symbols interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Symbols", reflect.TypeOf((*MockTradingDayTickerServiceAPI)(nil).Symbols), symbols)
+}
+
+// TickerType mocks base method.
+func (m *MockTradingDayTickerServiceAPI) TickerType(tickerType string) binance.TradingDayTickerServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TickerType", tickerType)
+	ret0, _ := ret[0].(binance.TradingDayTickerServiceAPI)
+	return ret0
+}
+
+// TickerType indicates an expected call of TickerType.
+func (mr *MockTradingDayTickerServiceAPIMockRecorder) TickerType(tickerType interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TickerType", reflect.TypeOf((*MockTradingDayTickerServiceAPI)(nil).TickerType), tickerType)
+}
+
+// TimeZone mocks base method.
+func (m *MockTradingDayTickerServiceAPI) TimeZone(timeZone string) binance.TradingDayTickerServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TimeZone", timeZone)
+	ret0, _ := ret[0].(binance.TradingDayTickerServiceAPI)
+	return ret0
+}
+
+// TimeZone indicates an expected call of TimeZone.
+func (mr *MockTradingDayTickerServiceAPIMockRecorder) TimeZone(timeZone interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TimeZone", reflect.TypeOf((*MockTradingDayTickerServiceAPI)(nil).TimeZone), timeZone)
+}
+
+// MockListBookTickersServiceAPI is a mock of ListBookTickersServiceAPI interface.
+type MockListBookTickersServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockListBookTickersServiceAPIMockRecorder
+}
+
+// MockListBookTickersServiceAPIMockRecorder is the mock recorder for MockListBookTickersServiceAPI.
+type MockListBookTickersServiceAPIMockRecorder struct {
+	mock *MockListBookTickersServiceAPI
+}
+
+// NewMockListBookTickersServiceAPI creates a new mock instance.
+func NewMockListBookTickersServiceAPI(ctrl *gomock.Controller) *MockListBookTickersServiceAPI {
+	mock := &MockListBookTickersServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockListBookTickersServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockListBookTickersServiceAPI) EXPECT() *MockListBookTickersServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockListBookTickersServiceAPI) Do(ctx context.Context, opts ...binance.RequestOption) ([]*binance.BookTicker, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].([]*binance.BookTicker)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockListBookTickersServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockListBookTickersServiceAPI)(nil).Do), varargs...)
+}
+
+// Symbol mocks base method.
+func (m *MockListBookTickersServiceAPI) Symbol(symbol string) binance.ListBookTickersServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Symbol", symbol)
+	ret0, _ := ret[0].(binance.ListBookTickersServiceAPI)
+	return ret0
+}
+
+// Symbol indicates an expected call of Symbol.
+func (mr *MockListBookTickersServiceAPIMockRecorder) Symbol(symbol interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Symbol", reflect.TypeOf((*MockListBookTickersServiceAPI)(nil).Symbol), symbol)
+}
+
+// Symbols mocks base method.
+func (m *MockListBookTickersServiceAPI) Symbols(symbols ...string) binance.ListBookTickersServiceAPI {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{}
+	for _, a := range symbols {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Symbols", varargs...)
+	ret0, _ := ret[0].(binance.ListBookTickersServiceAPI)
+	return ret0
+}
+
+// Symbols indicates an expected call of Symbols.
+func (mr *MockListBookTickersServiceAPIMockRecorder) Symbols(symbols ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Symbols", reflect.TypeOf((*MockListBookTickersServiceAPI)(nil).Symbols), symbols...)
+}
+
+// MockListSymbolTickerServiceAPI is a mock of ListSymbolTickerServiceAPI interface.
+type MockListSymbolTickerServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockListSymbolTickerServiceAPIMockRecorder
+}
+
+// MockListSymbolTickerServiceAPIMockRecorder is the mock recorder for MockListSymbolTickerServiceAPI.
+type MockListSymbolTickerServiceAPIMockRecorder struct {
+	mock *MockListSymbolTickerServiceAPI
+}
+
+// NewMockListSymbolTickerServiceAPI creates a new mock instance.
+func NewMockListSymbolTickerServiceAPI(ctrl *gomock.Controller) *MockListSymbolTickerServiceAPI {
+	mock := &MockListSymbolTickerServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockListSymbolTickerServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockListSymbolTickerServiceAPI) EXPECT() *MockListSymbolTickerServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockListSymbolTickerServiceAPI) Do(ctx context.Context, opts ...binance.RequestOption) ([]*binance.SymbolTicker, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].([]*binance.SymbolTicker)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockListSymbolTickerServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockListSymbolTickerServiceAPI)(nil).Do), varargs...)
+}
+
+// Symbol mocks base method.
+func (m *MockListSymbolTickerServiceAPI) Symbol(symbol string) binance.ListSymbolTickerServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Symbol", symbol)
+	ret0, _ := ret[0].(binance.ListSymbolTickerServiceAPI)
+	return ret0
+}
+
+// Symbol indicates an expected call of Symbol.
+func (mr *MockListSymbolTickerServiceAPIMockRecorder) Symbol(symbol interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Symbol", reflect.TypeOf((*MockListSymbolTickerServiceAPI)(nil).Symbol), symbol)
+}
+
+// Symbols mocks base method.
+func (m *MockListSymbolTickerServiceAPI) Symbols(symbols []string) binance.ListSymbolTickerServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Symbols", symbols)
+	ret0, _ := ret[0].(binance.ListSymbolTickerServiceAPI)
+	return ret0
+}
+
+// Symbols indicates an expected call of Symbols.
+func (mr *MockListSymbolTickerServiceAPIMockRecorder) Symbols(symbols interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Symbols", reflect.TypeOf((*MockListSymbolTickerServiceAPI)(nil).Symbols), symbols)
+}
+
+// WindowSize mocks base method.
+func (m *MockListSymbolTickerServiceAPI) WindowSize(windowSize string) binance.ListSymbolTickerServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WindowSize", windowSize)
+	ret0, _ := ret[0].(binance.ListSymbolTickerServiceAPI)
+	return ret0
+}
+
+// WindowSize indicates an expected call of WindowSize.
+func (mr *MockListSymbolTickerServiceAPIMockRecorder) WindowSize(windowSize interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WindowSize", reflect.TypeOf((*MockListSymbolTickerServiceAPI)(nil).WindowSize), windowSize)
+}
+
+// MockCreateOrderServiceAPI is a mock of CreateOrderServiceAPI interface.
+type MockCreateOrderServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockCreateOrderServiceAPIMockRecorder
+}
+
+// MockCreateOrderServiceAPIMockRecorder is the mock recorder for MockCreateOrderServiceAPI.
+type MockCreateOrderServiceAPIMockRecorder struct {
+	mock *MockCreateOrderServiceAPI
+}
+
+// NewMockCreateOrderServiceAPI creates a new mock instance.
+func NewMockCreateOrderServiceAPI(ctrl *gomock.Controller) *MockCreateOrderServiceAPI {
+	mock := &MockCreateOrderServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockCreateOrderServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCreateOrderServiceAPI) EXPECT() *MockCreateOrderServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockCreateOrderServiceAPI) Do(ctx context.Context, opts ...binance.RequestOption) (*binance.CreateOrderResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*binance.CreateOrderResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockCreateOrderServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockCreateOrderServiceAPI)(nil).Do), varargs...)
+}
+
+// IcebergQuantity mocks base method.
+func (m *MockCreateOrderServiceAPI) IcebergQuantity(icebergQuantity string) binance.CreateOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IcebergQuantity", icebergQuantity)
+	ret0, _ := ret[0].(binance.CreateOrderServiceAPI)
+	return ret0
+}
+
+// IcebergQuantity indicates an expected call of IcebergQuantity.
+func (mr *MockCreateOrderServiceAPIMockRecorder) IcebergQuantity(icebergQuantity interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IcebergQuantity", reflect.TypeOf((*MockCreateOrderServiceAPI)(nil).IcebergQuantity), icebergQuantity)
+}
+
+// NewClientOrderID mocks base method.
+func (m *MockCreateOrderServiceAPI) NewClientOrderID(newClientOrderID string) binance.CreateOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewClientOrderID", newClientOrderID)
+	ret0, _ := ret[0].(binance.CreateOrderServiceAPI)
+	return ret0
+}
+
+// NewClientOrderID indicates an expected call of NewClientOrderID.
+func (mr *MockCreateOrderServiceAPIMockRecorder) NewClientOrderID(newClientOrderID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewClientOrderID", reflect.TypeOf((*MockCreateOrderServiceAPI)(nil).NewClientOrderID), newClientOrderID)
+}
+
+// NewOrderRespType mocks base method.
+func (m *MockCreateOrderServiceAPI) NewOrderRespType(newOrderRespType binance.NewOrderRespType) binance.CreateOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewOrderRespType", newOrderRespType)
+	ret0, _ := ret[0].(binance.CreateOrderServiceAPI)
+	return ret0
+}
+
+// NewOrderRespType indicates an expected call of NewOrderRespType.
+func (mr *MockCreateOrderServiceAPIMockRecorder) NewOrderRespType(newOrderRespType interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewOrderRespType", reflect.TypeOf((*MockCreateOrderServiceAPI)(nil).NewOrderRespType), newOrderRespType)
+}
+
+// Price mocks base method.
+func (m *MockCreateOrderServiceAPI) Price(price string) binance.CreateOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Price", price)
+	ret0, _ := ret[0].(binance.CreateOrderServiceAPI)
+	return ret0
+}
+
+// Price indicates an expected call of Price.
+func (mr *MockCreateOrderServiceAPIMockRecorder) Price(price interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Price", reflect.TypeOf((*MockCreateOrderServiceAPI)(nil).Price), price)
+}
+
+// Quantity mocks base method.
+func (m *MockCreateOrderServiceAPI) Quantity(quantity string) binance.CreateOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Quantity", quantity)
+	ret0, _ := ret[0].(binance.CreateOrderServiceAPI)
+	return ret0
+}
+
+// Quantity indicates an expected call of Quantity.
+func (mr *MockCreateOrderServiceAPIMockRecorder) Quantity(quantity interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Quantity", reflect.TypeOf((*MockCreateOrderServiceAPI)(nil).Quantity), quantity)
+}
+
+// QuoteOrderQty mocks base method.
+func (m *MockCreateOrderServiceAPI) QuoteOrderQty(quoteOrderQty string) binance.CreateOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "QuoteOrderQty", quoteOrderQty)
+	ret0, _ := ret[0].(binance.CreateOrderServiceAPI)
+	return ret0
+}
+
+// QuoteOrderQty indicates an expected call of QuoteOrderQty.
+func (mr *MockCreateOrderServiceAPIMockRecorder) QuoteOrderQty(quoteOrderQty interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QuoteOrderQty", reflect.TypeOf((*MockCreateOrderServiceAPI)(nil).QuoteOrderQty), quoteOrderQty)
+}
+
+// SelfTradePreventionMode mocks base method.
+func (m *MockCreateOrderServiceAPI) SelfTradePreventionMode(selfTradePreventionMode binance.SelfTradePreventionMode) binance.CreateOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SelfTradePreventionMode", selfTradePreventionMode)
+	ret0, _ := ret[0].(binance.CreateOrderServiceAPI)
+	return ret0
+}
+
+// SelfTradePreventionMode indicates an expected call of SelfTradePreventionMode.
+func (mr *MockCreateOrderServiceAPIMockRecorder) SelfTradePreventionMode(selfTradePreventionMode interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelfTradePreventionMode", reflect.TypeOf((*MockCreateOrderServiceAPI)(nil).SelfTradePreventionMode), selfTradePreventionMode)
+}
+
+// Side mocks base method.
+func (m *MockCreateOrderServiceAPI) Side(side binance.SideType) binance.CreateOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Side", side)
+	ret0, _ := ret[0].(binance.CreateOrderServiceAPI)
+	return ret0
+}
+
+// Side indicates an expected call of Side.
+func (mr *MockCreateOrderServiceAPIMockRecorder) Side(side interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Side", reflect.TypeOf((*MockCreateOrderServiceAPI)(nil).Side), side)
+}
+
+// StopPrice mocks base method.
+func (m *MockCreateOrderServiceAPI) StopPrice(stopPrice string) binance.CreateOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StopPrice", stopPrice)
+	ret0, _ := ret[0].(binance.CreateOrderServiceAPI)
+	return ret0
+}
+
+// StopPrice indicates an expected call of StopPrice.
+func (mr *MockCreateOrderServiceAPIMockRecorder) StopPrice(stopPrice interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StopPrice", reflect.TypeOf((*MockCreateOrderServiceAPI)(nil).StopPrice), stopPrice)
+}
+
+// Symbol mocks base method.
+func (m *MockCreateOrderServiceAPI) Symbol(symbol string) binance.CreateOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Symbol", symbol)
+	ret0, _ := ret[0].(binance.CreateOrderServiceAPI)
+	return ret0
+}
+
+// Symbol indicates an expected call of Symbol.
+func (mr *MockCreateOrderServiceAPIMockRecorder) Symbol(symbol interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Symbol", reflect.TypeOf((*MockCreateOrderServiceAPI)(nil).Symbol), symbol)
+}
+
+// Test mocks base method.
+func (m *MockCreateOrderServiceAPI) Test(ctx context.Context, opts ...binance.RequestOption) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Test", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Test indicates an expected call of Test.
+func (mr *MockCreateOrderServiceAPIMockRecorder) Test(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Test", reflect.TypeOf((*MockCreateOrderServiceAPI)(nil).Test), varargs...)
+}
+
+// TimeInForce mocks base method.
+func (m *MockCreateOrderServiceAPI) TimeInForce(timeInForce binance.TimeInForceType) binance.CreateOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TimeInForce", timeInForce)
+	ret0, _ := ret[0].(binance.CreateOrderServiceAPI)
+	return ret0
+}
+
+// TimeInForce indicates an expected call of TimeInForce.
+func (mr *MockCreateOrderServiceAPIMockRecorder) TimeInForce(timeInForce interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TimeInForce", reflect.TypeOf((*MockCreateOrderServiceAPI)(nil).TimeInForce), timeInForce)
+}
+
+// TrailingDelta mocks base method.
+func (m *MockCreateOrderServiceAPI) TrailingDelta(trailingDelta string) binance.CreateOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TrailingDelta", trailingDelta)
+	ret0, _ := ret[0].(binance.CreateOrderServiceAPI)
+	return ret0
+}
+
+// TrailingDelta indicates an expected call of TrailingDelta.
+func (mr *MockCreateOrderServiceAPIMockRecorder) TrailingDelta(trailingDelta interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TrailingDelta", reflect.TypeOf((*MockCreateOrderServiceAPI)(nil).TrailingDelta), trailingDelta)
+}
+
+// Type mocks base method.
+func (m *MockCreateOrderServiceAPI) Type(orderType binance.OrderType) binance.CreateOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Type", orderType)
+	ret0, _ := ret[0].(binance.CreateOrderServiceAPI)
+	return ret0
+}
+
+// Type indicates an expected call of Type.
+func (mr *MockCreateOrderServiceAPIMockRecorder) Type(orderType interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Type", reflect.TypeOf((*MockCreateOrderServiceAPI)(nil).Type), orderType)
+}
+
+// MockCreateOCOServiceAPI is a mock of CreateOCOServiceAPI interface.
+type MockCreateOCOServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockCreateOCOServiceAPIMockRecorder
+}
+
+// MockCreateOCOServiceAPIMockRecorder is the mock recorder for MockCreateOCOServiceAPI.
+type MockCreateOCOServiceAPIMockRecorder struct {
+	mock *MockCreateOCOServiceAPI
+}
+
+// NewMockCreateOCOServiceAPI creates a new mock instance.
+func NewMockCreateOCOServiceAPI(ctrl *gomock.Controller) *MockCreateOCOServiceAPI {
+	mock := &MockCreateOCOServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockCreateOCOServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCreateOCOServiceAPI) EXPECT() *MockCreateOCOServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockCreateOCOServiceAPI) Do(ctx context.Context, opts ...binance.RequestOption) (*binance.CreateOCOResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*binance.CreateOCOResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockCreateOCOServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockCreateOCOServiceAPI)(nil).Do), varargs...)
+}
+
+// LimitClientOrderID mocks base method.
+func (m *MockCreateOCOServiceAPI) LimitClientOrderID(limitClientOrderID string) binance.CreateOCOServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LimitClientOrderID", limitClientOrderID)
+	ret0, _ := ret[0].(binance.CreateOCOServiceAPI)
+	return ret0
+}
+
+// LimitClientOrderID indicates an expected call of LimitClientOrderID.
+func (mr *MockCreateOCOServiceAPIMockRecorder) LimitClientOrderID(limitClientOrderID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LimitClientOrderID", reflect.TypeOf((*MockCreateOCOServiceAPI)(nil).LimitClientOrderID), limitClientOrderID)
+}
+
+// LimitIcebergQuantity mocks base method.
+func (m *MockCreateOCOServiceAPI) LimitIcebergQuantity(limitIcebergQty string) binance.CreateOCOServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LimitIcebergQuantity", limitIcebergQty)
+	ret0, _ := ret[0].(binance.CreateOCOServiceAPI)
+	return ret0
+}
+
+// LimitIcebergQuantity indicates an expected call of LimitIcebergQuantity.
+func (mr *MockCreateOCOServiceAPIMockRecorder) LimitIcebergQuantity(limitIcebergQty interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LimitIcebergQuantity", reflect.TypeOf((*MockCreateOCOServiceAPI)(nil).LimitIcebergQuantity), limitIcebergQty)
+}
+
+// ListClientOrderID mocks base method.
+func (m *MockCreateOCOServiceAPI) ListClientOrderID(listClientOrderID string) binance.CreateOCOServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListClientOrderID", listClientOrderID)
+	ret0, _ := ret[0].(binance.CreateOCOServiceAPI)
+	return ret0
+}
+
+// ListClientOrderID indicates an expected call of ListClientOrderID.
+func (mr *MockCreateOCOServiceAPIMockRecorder) ListClientOrderID(listClientOrderID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListClientOrderID", reflect.TypeOf((*MockCreateOCOServiceAPI)(nil).ListClientOrderID), listClientOrderID)
+}
+
+// NewOrderRespType mocks base method.
+func (m *MockCreateOCOServiceAPI) NewOrderRespType(newOrderRespType binance.NewOrderRespType) binance.CreateOCOServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewOrderRespType", newOrderRespType)
+	ret0, _ := ret[0].(binance.CreateOCOServiceAPI)
+	return ret0
+}
+
+// NewOrderRespType indicates an expected call of NewOrderRespType.
+func (mr *MockCreateOCOServiceAPIMockRecorder) NewOrderRespType(newOrderRespType interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewOrderRespType", reflect.TypeOf((*MockCreateOCOServiceAPI)(nil).NewOrderRespType), newOrderRespType)
+}
+
+// Price mocks base method.
+func (m *MockCreateOCOServiceAPI) Price(price string) binance.CreateOCOServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Price", price)
+	ret0, _ := ret[0].(binance.CreateOCOServiceAPI)
+	return ret0
+}
+
+// Price indicates an expected call of Price.
+func (mr *MockCreateOCOServiceAPIMockRecorder) Price(price interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Price", reflect.TypeOf((*MockCreateOCOServiceAPI)(nil).Price), price)
+}
+
+// Quantity mocks base method.
+func (m *MockCreateOCOServiceAPI) Quantity(quantity string) binance.CreateOCOServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Quantity", quantity)
+	ret0, _ := ret[0].(binance.CreateOCOServiceAPI)
+	return ret0
+}
+
+// Quantity indicates an expected call of Quantity.
+func (mr *MockCreateOCOServiceAPIMockRecorder) Quantity(quantity interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Quantity", reflect.TypeOf((*MockCreateOCOServiceAPI)(nil).Quantity), quantity)
+}
+
+// Side mocks base method.
+func (m *MockCreateOCOServiceAPI) Side(side binance.SideType) binance.CreateOCOServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Side", side)
+	ret0, _ := ret[0].(binance.CreateOCOServiceAPI)
+	return ret0
+}
+
+// Side indicates an expected call of Side.
+func (mr *MockCreateOCOServiceAPIMockRecorder) Side(side interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Side", reflect.TypeOf((*MockCreateOCOServiceAPI)(nil).Side), side)
+}
+
+// StopClientOrderID mocks base method.
+func (m *MockCreateOCOServiceAPI) StopClientOrderID(stopClientOrderID string) binance.CreateOCOServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StopClientOrderID", stopClientOrderID)
+	ret0, _ := ret[0].(binance.CreateOCOServiceAPI)
+	return ret0
+}
+
+// StopClientOrderID indicates an expected call of StopClientOrderID.
+func (mr *MockCreateOCOServiceAPIMockRecorder) StopClientOrderID(stopClientOrderID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StopClientOrderID", reflect.TypeOf((*MockCreateOCOServiceAPI)(nil).StopClientOrderID), stopClientOrderID)
+}
+
+// StopIcebergQty mocks base method.
+func (m *MockCreateOCOServiceAPI) StopIcebergQty(stopIcebergQty string) binance.CreateOCOServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StopIcebergQty", stopIcebergQty)
+	ret0, _ := ret[0].(binance.CreateOCOServiceAPI)
+	return ret0
+}
+
+// StopIcebergQty indicates an expected call of StopIcebergQty.
+func (mr *MockCreateOCOServiceAPIMockRecorder) StopIcebergQty(stopIcebergQty interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StopIcebergQty", reflect.TypeOf((*MockCreateOCOServiceAPI)(nil).StopIcebergQty), stopIcebergQty)
+}
+
+// StopLimitPrice mocks base method.
+func (m *MockCreateOCOServiceAPI) StopLimitPrice(stopLimitPrice string) binance.CreateOCOServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StopLimitPrice", stopLimitPrice)
+	ret0, _ := ret[0].(binance.CreateOCOServiceAPI)
+	return ret0
+}
+
+// StopLimitPrice indicates an expected call of StopLimitPrice.
+func (mr *MockCreateOCOServiceAPIMockRecorder) StopLimitPrice(stopLimitPrice interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StopLimitPrice", reflect.TypeOf((*MockCreateOCOServiceAPI)(nil).StopLimitPrice), stopLimitPrice)
+}
+
+// StopLimitTimeInForce mocks base method.
+func (m *MockCreateOCOServiceAPI) StopLimitTimeInForce(stopLimitTimeInForce binance.TimeInForceType) binance.CreateOCOServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StopLimitTimeInForce", stopLimitTimeInForce)
+	ret0, _ := ret[0].(binance.CreateOCOServiceAPI)
+	return ret0
+}
+
+// StopLimitTimeInForce indicates an expected call of StopLimitTimeInForce.
+func (mr *MockCreateOCOServiceAPIMockRecorder) StopLimitTimeInForce(stopLimitTimeInForce interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StopLimitTimeInForce", reflect.TypeOf((*MockCreateOCOServiceAPI)(nil).StopLimitTimeInForce), stopLimitTimeInForce)
+}
+
+// StopPrice mocks base method.
+func (m *MockCreateOCOServiceAPI) StopPrice(stopPrice string) binance.CreateOCOServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StopPrice", stopPrice)
+	ret0, _ := ret[0].(binance.CreateOCOServiceAPI)
+	return ret0
+}
+
+// StopPrice indicates an expected call of StopPrice.
+func (mr *MockCreateOCOServiceAPIMockRecorder) StopPrice(stopPrice interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StopPrice", reflect.TypeOf((*MockCreateOCOServiceAPI)(nil).StopPrice), stopPrice)
+}
+
+// Symbol mocks base method.
+func (m *MockCreateOCOServiceAPI) Symbol(symbol string) binance.CreateOCOServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Symbol", symbol)
+	ret0, _ := ret[0].(binance.CreateOCOServiceAPI)
+	return ret0
+}
+
+// Symbol indicates an expected call of Symbol.
+func (mr *MockCreateOCOServiceAPIMockRecorder) Symbol(symbol interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Symbol", reflect.TypeOf((*MockCreateOCOServiceAPI)(nil).Symbol), symbol)
+}
+
+// MockCancelOCOServiceAPI is a mock of CancelOCOServiceAPI interface.
+type MockCancelOCOServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockCancelOCOServiceAPIMockRecorder
+}
+
+// MockCancelOCOServiceAPIMockRecorder is the mock recorder for MockCancelOCOServiceAPI.
+type MockCancelOCOServiceAPIMockRecorder struct {
+	mock *MockCancelOCOServiceAPI
+}
+
+// NewMockCancelOCOServiceAPI creates a new mock instance.
+func NewMockCancelOCOServiceAPI(ctrl *gomock.Controller) *MockCancelOCOServiceAPI {
+	mock := &MockCancelOCOServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockCancelOCOServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCancelOCOServiceAPI) EXPECT() *MockCancelOCOServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockCancelOCOServiceAPI) Do(ctx context.Context, opts ...binance.RequestOption) (*binance.CancelOCOResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*binance.CancelOCOResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockCancelOCOServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockCancelOCOServiceAPI)(nil).Do), varargs...)
+}
+
+// ListClientOrderID mocks base method.
+func (m *MockCancelOCOServiceAPI) ListClientOrderID(listClientOrderID string) binance.CancelOCOServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListClientOrderID", listClientOrderID)
+	ret0, _ := ret[0].(binance.CancelOCOServiceAPI)
+	return ret0
+}
+
+// ListClientOrderID indicates an expected call of ListClientOrderID.
+func (mr *MockCancelOCOServiceAPIMockRecorder) ListClientOrderID(listClientOrderID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListClientOrderID", reflect.TypeOf((*MockCancelOCOServiceAPI)(nil).ListClientOrderID), listClientOrderID)
+}
+
+// NewClientOrderID mocks base method.
+func (m *MockCancelOCOServiceAPI) NewClientOrderID(newClientOrderID string) binance.CancelOCOServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewClientOrderID", newClientOrderID)
+	ret0, _ := ret[0].(binance.CancelOCOServiceAPI)
+	return ret0
+}
+
+// NewClientOrderID indicates an expected call of NewClientOrderID.
+func (mr *MockCancelOCOServiceAPIMockRecorder) NewClientOrderID(newClientOrderID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewClientOrderID", reflect.TypeOf((*MockCancelOCOServiceAPI)(nil).NewClientOrderID), newClientOrderID)
+}
+
+// OrderListID mocks base method.
+func (m *MockCancelOCOServiceAPI) OrderListID(orderListID int64) binance.CancelOCOServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OrderListID", orderListID)
+	ret0, _ := ret[0].(binance.CancelOCOServiceAPI)
+	return ret0
+}
+
+// OrderListID indicates an expected call of OrderListID.
+func (mr *MockCancelOCOServiceAPIMockRecorder) OrderListID(orderListID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OrderListID", reflect.TypeOf((*MockCancelOCOServiceAPI)(nil).OrderListID), orderListID)
+}
+
+// Symbol mocks base method.
+func (m *MockCancelOCOServiceAPI) Symbol(symbol string) binance.CancelOCOServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Symbol", symbol)
+	ret0, _ := ret[0].(binance.CancelOCOServiceAPI)
+	return ret0
+}
+
+// Symbol indicates an expected call of Symbol.
+func (mr *MockCancelOCOServiceAPIMockRecorder) Symbol(symbol interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Symbol", reflect.TypeOf((*MockCancelOCOServiceAPI)(nil).Symbol), symbol)
+}
+
+// MockGetOrderServiceAPI is a mock of GetOrderServiceAPI interface.
+type MockGetOrderServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockGetOrderServiceAPIMockRecorder
+}
+
+// MockGetOrderServiceAPIMockRecorder is the mock recorder for MockGetOrderServiceAPI.
+type MockGetOrderServiceAPIMockRecorder struct {
+	mock *MockGetOrderServiceAPI
+}
+
+// NewMockGetOrderServiceAPI creates a new mock instance.
+func NewMockGetOrderServiceAPI(ctrl *gomock.Controller) *MockGetOrderServiceAPI {
+	mock := &MockGetOrderServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockGetOrderServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGetOrderServiceAPI) EXPECT() *MockGetOrderServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockGetOrderServiceAPI) Do(ctx context.Context, opts ...binance.RequestOption) (*binance.Order, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*binance.Order)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockGetOrderServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockGetOrderServiceAPI)(nil).Do), varargs...)
+}
+
+// OrderID mocks base method.
+func (m *MockGetOrderServiceAPI) OrderID(orderID int64) binance.GetOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OrderID", orderID)
+	ret0, _ := ret[0].(binance.GetOrderServiceAPI)
+	return ret0
+}
+
+// OrderID indicates an expected call of OrderID.
+func (mr *MockGetOrderServiceAPIMockRecorder) OrderID(orderID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OrderID", reflect.TypeOf((*MockGetOrderServiceAPI)(nil).OrderID), orderID)
+}
+
+// OrigClientOrderID mocks base method.
+func (m *MockGetOrderServiceAPI) OrigClientOrderID(origClientOrderID string) binance.GetOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OrigClientOrderID", origClientOrderID)
+	ret0, _ := ret[0].(binance.GetOrderServiceAPI)
+	return ret0
+}
+
+// OrigClientOrderID indicates an expected call of OrigClientOrderID.
+func (mr *MockGetOrderServiceAPIMockRecorder) OrigClientOrderID(origClientOrderID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OrigClientOrderID", reflect.TypeOf((*MockGetOrderServiceAPI)(nil).OrigClientOrderID), origClientOrderID)
+}
+
+// Symbol mocks base method.
+func (m *MockGetOrderServiceAPI) Symbol(symbol string) binance.GetOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Symbol", symbol)
+	ret0, _ := ret[0].(binance.GetOrderServiceAPI)
+	return ret0
+}
+
+// Symbol indicates an expected call of Symbol.
+func (mr *MockGetOrderServiceAPIMockRecorder) Symbol(symbol interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Symbol", reflect.TypeOf((*MockGetOrderServiceAPI)(nil).Symbol), symbol)
+}
+
+// MockCancelOrderServiceAPI is a mock of CancelOrderServiceAPI interface.
+type MockCancelOrderServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockCancelOrderServiceAPIMockRecorder
+}
+
+// MockCancelOrderServiceAPIMockRecorder is the mock recorder for MockCancelOrderServiceAPI.
+type MockCancelOrderServiceAPIMockRecorder struct {
+	mock *MockCancelOrderServiceAPI
+}
+
+// NewMockCancelOrderServiceAPI creates a new mock instance.
+func NewMockCancelOrderServiceAPI(ctrl *gomock.Controller) *MockCancelOrderServiceAPI {
+	mock := &MockCancelOrderServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockCancelOrderServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCancelOrderServiceAPI) EXPECT() *MockCancelOrderServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockCancelOrderServiceAPI) Do(ctx context.Context, opts ...binance.RequestOption) (*binance.CancelOrderResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*binance.CancelOrderResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockCancelOrderServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockCancelOrderServiceAPI)(nil).Do), varargs...)
+}
+
+// NewClientOrderID mocks base method.
+func (m *MockCancelOrderServiceAPI) NewClientOrderID(newClientOrderID string) binance.CancelOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewClientOrderID", newClientOrderID)
+	ret0, _ := ret[0].(binance.CancelOrderServiceAPI)
+	return ret0
+}
+
+// NewClientOrderID indicates an expected call of NewClientOrderID.
+func (mr *MockCancelOrderServiceAPIMockRecorder) NewClientOrderID(newClientOrderID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewClientOrderID", reflect.TypeOf((*MockCancelOrderServiceAPI)(nil).NewClientOrderID), newClientOrderID)
+}
+
+// OrderID mocks base method.
+func (m *MockCancelOrderServiceAPI) OrderID(orderID int64) binance.CancelOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OrderID", orderID)
+	ret0, _ := ret[0].(binance.CancelOrderServiceAPI)
+	return ret0
+}
+
+// OrderID indicates an expected call of OrderID.
+func (mr *MockCancelOrderServiceAPIMockRecorder) OrderID(orderID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OrderID", reflect.TypeOf((*MockCancelOrderServiceAPI)(nil).OrderID), orderID)
+}
+
+// OrigClientOrderID mocks base method.
+func (m *MockCancelOrderServiceAPI) OrigClientOrderID(origClientOrderID string) binance.CancelOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OrigClientOrderID", origClientOrderID)
+	ret0, _ := ret[0].(binance.CancelOrderServiceAPI)
+	return ret0
+}
+
+// OrigClientOrderID indicates an expected call of OrigClientOrderID.
+func (mr *MockCancelOrderServiceAPIMockRecorder) OrigClientOrderID(origClientOrderID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OrigClientOrderID", reflect.TypeOf((*MockCancelOrderServiceAPI)(nil).OrigClientOrderID), origClientOrderID)
+}
+
+// Symbol mocks base method.
+func (m *MockCancelOrderServiceAPI) Symbol(symbol string) binance.CancelOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Symbol", symbol)
+	ret0, _ := ret[0].(binance.CancelOrderServiceAPI)
+	return ret0
+}
+
+// Symbol indicates an expected call of Symbol.
+func (mr *MockCancelOrderServiceAPIMockRecorder) Symbol(symbol interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Symbol", reflect.TypeOf((*MockCancelOrderServiceAPI)(nil).Symbol), symbol)
+}
+
+// MockCancelReplaceOrderServiceAPI is a mock of CancelReplaceOrderServiceAPI interface.
+type MockCancelReplaceOrderServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockCancelReplaceOrderServiceAPIMockRecorder
+}
+
+// MockCancelReplaceOrderServiceAPIMockRecorder is the mock recorder for MockCancelReplaceOrderServiceAPI.
+type MockCancelReplaceOrderServiceAPIMockRecorder struct {
+	mock *MockCancelReplaceOrderServiceAPI
+}
+
+// NewMockCancelReplaceOrderServiceAPI creates a new mock instance.
+func NewMockCancelReplaceOrderServiceAPI(ctrl *gomock.Controller) *MockCancelReplaceOrderServiceAPI {
+	mock := &MockCancelReplaceOrderServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockCancelReplaceOrderServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCancelReplaceOrderServiceAPI) EXPECT() *MockCancelReplaceOrderServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// CancelNewClientOrderID mocks base method.
+func (m *MockCancelReplaceOrderServiceAPI) CancelNewClientOrderID(cancelNewClientOrderID string) binance.CancelReplaceOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelNewClientOrderID", cancelNewClientOrderID)
+	ret0, _ := ret[0].(binance.CancelReplaceOrderServiceAPI)
+	return ret0
+}
+
+// CancelNewClientOrderID indicates an expected call of CancelNewClientOrderID.
+func (mr *MockCancelReplaceOrderServiceAPIMockRecorder) CancelNewClientOrderID(cancelNewClientOrderID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelNewClientOrderID", reflect.TypeOf((*MockCancelReplaceOrderServiceAPI)(nil).CancelNewClientOrderID), cancelNewClientOrderID)
+}
+
+// CancelOrderID mocks base method.
+func (m *MockCancelReplaceOrderServiceAPI) CancelOrderID(cancelOrderID int64) binance.CancelReplaceOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelOrderID", cancelOrderID)
+	ret0, _ := ret[0].(binance.CancelReplaceOrderServiceAPI)
+	return ret0
+}
+
+// CancelOrderID indicates an expected call of CancelOrderID.
+func (mr *MockCancelReplaceOrderServiceAPIMockRecorder) CancelOrderID(cancelOrderID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelOrderID", reflect.TypeOf((*MockCancelReplaceOrderServiceAPI)(nil).CancelOrderID), cancelOrderID)
+}
+
+// CancelOrigClientOrderID mocks base method.
+func (m *MockCancelReplaceOrderServiceAPI) CancelOrigClientOrderID(cancelOrigClientOrderID string) binance.CancelReplaceOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelOrigClientOrderID", cancelOrigClientOrderID)
+	ret0, _ := ret[0].(binance.CancelReplaceOrderServiceAPI)
+	return ret0
+}
+
+// CancelOrigClientOrderID indicates an expected call of CancelOrigClientOrderID.
+func (mr *MockCancelReplaceOrderServiceAPIMockRecorder) CancelOrigClientOrderID(cancelOrigClientOrderID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelOrigClientOrderID", reflect.TypeOf((*MockCancelReplaceOrderServiceAPI)(nil).CancelOrigClientOrderID), cancelOrigClientOrderID)
+}
+
+// CancelReplaceMode mocks base method.
+func (m *MockCancelReplaceOrderServiceAPI) CancelReplaceMode(cancelReplaceMode binance.CancelReplaceMode) binance.CancelReplaceOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelReplaceMode", cancelReplaceMode)
+	ret0, _ := ret[0].(binance.CancelReplaceOrderServiceAPI)
+	return ret0
+}
+
+// CancelReplaceMode indicates an expected call of CancelReplaceMode.
+func (mr *MockCancelReplaceOrderServiceAPIMockRecorder) CancelReplaceMode(cancelReplaceMode interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelReplaceMode", reflect.TypeOf((*MockCancelReplaceOrderServiceAPI)(nil).CancelReplaceMode), cancelReplaceMode)
+}
+
+// Do mocks base method.
+func (m *MockCancelReplaceOrderServiceAPI) Do(ctx context.Context, opts ...binance.RequestOption) (*binance.CancelReplaceOrderResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*binance.CancelReplaceOrderResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockCancelReplaceOrderServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockCancelReplaceOrderServiceAPI)(nil).Do), varargs...)
+}
+
+// IcebergQuantity mocks base method.
+func (m *MockCancelReplaceOrderServiceAPI) IcebergQuantity(icebergQuantity string) binance.CancelReplaceOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IcebergQuantity", icebergQuantity)
+	ret0, _ := ret[0].(binance.CancelReplaceOrderServiceAPI)
+	return ret0
+}
+
+// IcebergQuantity indicates an expected call of IcebergQuantity.
+func (mr *MockCancelReplaceOrderServiceAPIMockRecorder) IcebergQuantity(icebergQuantity interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IcebergQuantity", reflect.TypeOf((*MockCancelReplaceOrderServiceAPI)(nil).IcebergQuantity), icebergQuantity)
+}
+
+// NewClientOrderID mocks base method.
+func (m *MockCancelReplaceOrderServiceAPI) NewClientOrderID(newClientOrderID string) binance.CancelReplaceOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewClientOrderID", newClientOrderID)
+	ret0, _ := ret[0].(binance.CancelReplaceOrderServiceAPI)
+	return ret0
+}
+
+// NewClientOrderID indicates an expected call of NewClientOrderID.
+func (mr *MockCancelReplaceOrderServiceAPIMockRecorder) NewClientOrderID(newClientOrderID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewClientOrderID", reflect.TypeOf((*MockCancelReplaceOrderServiceAPI)(nil).NewClientOrderID), newClientOrderID)
+}
+
+// NewOrderRespType mocks base method.
+func (m *MockCancelReplaceOrderServiceAPI) NewOrderRespType(newOrderRespType binance.NewOrderRespType) binance.CancelReplaceOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewOrderRespType", newOrderRespType)
+	ret0, _ := ret[0].(binance.CancelReplaceOrderServiceAPI)
+	return ret0
+}
+
+// NewOrderRespType indicates an expected call of NewOrderRespType.
+func (mr *MockCancelReplaceOrderServiceAPIMockRecorder) NewOrderRespType(newOrderRespType interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewOrderRespType", reflect.TypeOf((*MockCancelReplaceOrderServiceAPI)(nil).NewOrderRespType), newOrderRespType)
+}
+
+// Price mocks base method.
+func (m *MockCancelReplaceOrderServiceAPI) Price(price string) binance.CancelReplaceOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Price", price)
+	ret0, _ := ret[0].(binance.CancelReplaceOrderServiceAPI)
+	return ret0
+}
+
+// Price indicates an expected call of Price.
+func (mr *MockCancelReplaceOrderServiceAPIMockRecorder) Price(price interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Price", reflect.TypeOf((*MockCancelReplaceOrderServiceAPI)(nil).Price), price)
+}
+
+// Quantity mocks base method.
+func (m *MockCancelReplaceOrderServiceAPI) Quantity(quantity string) binance.CancelReplaceOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Quantity", quantity)
+	ret0, _ := ret[0].(binance.CancelReplaceOrderServiceAPI)
+	return ret0
+}
+
+// Quantity indicates an expected call of Quantity.
+func (mr *MockCancelReplaceOrderServiceAPIMockRecorder) Quantity(quantity interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Quantity", reflect.TypeOf((*MockCancelReplaceOrderServiceAPI)(nil).Quantity), quantity)
+}
+
+// QuoteOrderQty mocks base method.
+func (m *MockCancelReplaceOrderServiceAPI) QuoteOrderQty(quoteOrderQty string) binance.CancelReplaceOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "QuoteOrderQty", quoteOrderQty)
+	ret0, _ := ret[0].(binance.CancelReplaceOrderServiceAPI)
+	return ret0
+}
+
+// QuoteOrderQty indicates an expected call of QuoteOrderQty.
+func (mr *MockCancelReplaceOrderServiceAPIMockRecorder) QuoteOrderQty(quoteOrderQty interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QuoteOrderQty", reflect.TypeOf((*MockCancelReplaceOrderServiceAPI)(nil).QuoteOrderQty), quoteOrderQty)
+}
+
+// SelfTradePreventionMode mocks base method.
+func (m *MockCancelReplaceOrderServiceAPI) SelfTradePreventionMode(selfTradePreventionMode binance.SelfTradePreventionMode) binance.CancelReplaceOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SelfTradePreventionMode", selfTradePreventionMode)
+	ret0, _ := ret[0].(binance.CancelReplaceOrderServiceAPI)
+	return ret0
+}
+
+// SelfTradePreventionMode indicates an expected call of SelfTradePreventionMode.
+func (mr *MockCancelReplaceOrderServiceAPIMockRecorder) SelfTradePreventionMode(selfTradePreventionMode interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelfTradePreventionMode", reflect.TypeOf((*MockCancelReplaceOrderServiceAPI)(nil).SelfTradePreventionMode), selfTradePreventionMode)
+}
+
+// Side mocks base method.
+func (m *MockCancelReplaceOrderServiceAPI) Side(side binance.SideType) binance.CancelReplaceOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Side", side)
+	ret0, _ := ret[0].(binance.CancelReplaceOrderServiceAPI)
+	return ret0
+}
+
+// Side indicates an expected call of Side.
+func (mr *MockCancelReplaceOrderServiceAPIMockRecorder) Side(side interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Side", reflect.TypeOf((*MockCancelReplaceOrderServiceAPI)(nil).Side), side)
+}
+
+// StopPrice mocks base method.
+func (m *MockCancelReplaceOrderServiceAPI) StopPrice(stopPrice string) binance.CancelReplaceOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StopPrice", stopPrice)
+	ret0, _ := ret[0].(binance.CancelReplaceOrderServiceAPI)
+	return ret0
+}
+
+// StopPrice indicates an expected call of StopPrice.
+func (mr *MockCancelReplaceOrderServiceAPIMockRecorder) StopPrice(stopPrice interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StopPrice", reflect.TypeOf((*MockCancelReplaceOrderServiceAPI)(nil).StopPrice), stopPrice)
+}
+
+// StrategyId mocks base method.
+func (m *MockCancelReplaceOrderServiceAPI) StrategyId(strategyId int64) binance.CancelReplaceOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StrategyId", strategyId)
+	ret0, _ := ret[0].(binance.CancelReplaceOrderServiceAPI)
+	return ret0
+}
+
+// StrategyId indicates an expected call of StrategyId.
+func (mr *MockCancelReplaceOrderServiceAPIMockRecorder) StrategyId(strategyId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StrategyId", reflect.TypeOf((*MockCancelReplaceOrderServiceAPI)(nil).StrategyId), strategyId)
+}
+
+// StrategyType mocks base method.
+func (m *MockCancelReplaceOrderServiceAPI) StrategyType(strategyType int64) binance.CancelReplaceOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StrategyType", strategyType)
+	ret0, _ := ret[0].(binance.CancelReplaceOrderServiceAPI)
+	return ret0
+}
+
+// StrategyType indicates an expected call of StrategyType.
+func (mr *MockCancelReplaceOrderServiceAPIMockRecorder) StrategyType(strategyType interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StrategyType", reflect.TypeOf((*MockCancelReplaceOrderServiceAPI)(nil).StrategyType), strategyType)
+}
+
+// Symbol mocks base method.
+func (m *MockCancelReplaceOrderServiceAPI) Symbol(symbol string) binance.CancelReplaceOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Symbol", symbol)
+	ret0, _ := ret[0].(binance.CancelReplaceOrderServiceAPI)
+	return ret0
+}
+
+// Symbol indicates an expected call of Symbol.
+func (mr *MockCancelReplaceOrderServiceAPIMockRecorder) Symbol(symbol interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Symbol", reflect.TypeOf((*MockCancelReplaceOrderServiceAPI)(nil).Symbol), symbol)
+}
+
+// TimeInForce mocks base method.
+func (m *MockCancelReplaceOrderServiceAPI) TimeInForce(timeInForce binance.TimeInForceType) binance.CancelReplaceOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TimeInForce", timeInForce)
+	ret0, _ := ret[0].(binance.CancelReplaceOrderServiceAPI)
+	return ret0
+}
+
+// TimeInForce indicates an expected call of TimeInForce.
+func (mr *MockCancelReplaceOrderServiceAPIMockRecorder) TimeInForce(timeInForce interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TimeInForce", reflect.TypeOf((*MockCancelReplaceOrderServiceAPI)(nil).TimeInForce), timeInForce)
+}
+
+// TrailingDelta mocks base method.
+func (m *MockCancelReplaceOrderServiceAPI) TrailingDelta(trailingDelta string) binance.CancelReplaceOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TrailingDelta", trailingDelta)
+	ret0, _ := ret[0].(binance.CancelReplaceOrderServiceAPI)
+	return ret0
+}
+
+// TrailingDelta indicates an expected call of TrailingDelta.
+func (mr *MockCancelReplaceOrderServiceAPIMockRecorder) TrailingDelta(trailingDelta interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TrailingDelta", reflect.TypeOf((*MockCancelReplaceOrderServiceAPI)(nil).TrailingDelta), trailingDelta)
+}
+
+// Type mocks base method.
+func (m *MockCancelReplaceOrderServiceAPI) Type(orderType binance.OrderType) binance.CancelReplaceOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Type", orderType)
+	ret0, _ := ret[0].(binance.CancelReplaceOrderServiceAPI)
+	return ret0
+}
+
+// Type indicates an expected call of Type.
+func (mr *MockCancelReplaceOrderServiceAPIMockRecorder) Type(orderType interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Type", reflect.TypeOf((*MockCancelReplaceOrderServiceAPI)(nil).Type), orderType)
+}
+
+// MockCancelOpenOrdersServiceAPI is a mock of CancelOpenOrdersServiceAPI interface.
+type MockCancelOpenOrdersServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockCancelOpenOrdersServiceAPIMockRecorder
+}
+
+// MockCancelOpenOrdersServiceAPIMockRecorder is the mock recorder for MockCancelOpenOrdersServiceAPI.
+type MockCancelOpenOrdersServiceAPIMockRecorder struct {
+	mock *MockCancelOpenOrdersServiceAPI
+}
+
+// NewMockCancelOpenOrdersServiceAPI creates a new mock instance.
+func NewMockCancelOpenOrdersServiceAPI(ctrl *gomock.Controller) *MockCancelOpenOrdersServiceAPI {
+	mock := &MockCancelOpenOrdersServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockCancelOpenOrdersServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCancelOpenOrdersServiceAPI) EXPECT() *MockCancelOpenOrdersServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockCancelOpenOrdersServiceAPI) Do(ctx context.Context, opts ...binance.RequestOption) (*binance.CancelOpenOrdersResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*binance.CancelOpenOrdersResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockCancelOpenOrdersServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockCancelOpenOrdersServiceAPI)(nil).Do), varargs...)
+}
+
+// Symbol mocks base method.
+func (m *MockCancelOpenOrdersServiceAPI) Symbol(symbol string) binance.CancelOpenOrdersServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Symbol", symbol)
+	ret0, _ := ret[0].(binance.CancelOpenOrdersServiceAPI)
+	return ret0
+}
+
+// Symbol indicates an expected call of Symbol.
+func (mr *MockCancelOpenOrdersServiceAPIMockRecorder) Symbol(symbol interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Symbol", reflect.TypeOf((*MockCancelOpenOrdersServiceAPI)(nil).Symbol), symbol)
+}
+
+// MockListOpenOrdersServiceAPI is a mock of ListOpenOrdersServiceAPI interface.
+type MockListOpenOrdersServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockListOpenOrdersServiceAPIMockRecorder
+}
+
+// MockListOpenOrdersServiceAPIMockRecorder is the mock recorder for MockListOpenOrdersServiceAPI.
+type MockListOpenOrdersServiceAPIMockRecorder struct {
+	mock *MockListOpenOrdersServiceAPI
+}
+
+// NewMockListOpenOrdersServiceAPI creates a new mock instance.
+func NewMockListOpenOrdersServiceAPI(ctrl *gomock.Controller) *MockListOpenOrdersServiceAPI {
+	mock := &MockListOpenOrdersServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockListOpenOrdersServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockListOpenOrdersServiceAPI) EXPECT() *MockListOpenOrdersServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockListOpenOrdersServiceAPI) Do(ctx context.Context, opts ...binance.RequestOption) ([]*binance.Order, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].([]*binance.Order)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockListOpenOrdersServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockListOpenOrdersServiceAPI)(nil).Do), varargs...)
+}
+
+// Symbol mocks base method.
+func (m *MockListOpenOrdersServiceAPI) Symbol(symbol string) binance.ListOpenOrdersServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Symbol", symbol)
+	ret0, _ := ret[0].(binance.ListOpenOrdersServiceAPI)
+	return ret0
+}
+
+// Symbol indicates an expected call of Symbol.
+func (mr *MockListOpenOrdersServiceAPIMockRecorder) Symbol(symbol interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Symbol", reflect.TypeOf((*MockListOpenOrdersServiceAPI)(nil).Symbol), symbol)
+}
+
+// MockListOpenOcoServiceAPI is a mock of ListOpenOcoServiceAPI interface.
+type MockListOpenOcoServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockListOpenOcoServiceAPIMockRecorder
+}
+
+// MockListOpenOcoServiceAPIMockRecorder is the mock recorder for MockListOpenOcoServiceAPI.
+type MockListOpenOcoServiceAPIMockRecorder struct {
+	mock *MockListOpenOcoServiceAPI
+}
+
+// NewMockListOpenOcoServiceAPI creates a new mock instance.
+func NewMockListOpenOcoServiceAPI(ctrl *gomock.Controller) *MockListOpenOcoServiceAPI {
+	mock := &MockListOpenOcoServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockListOpenOcoServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockListOpenOcoServiceAPI) EXPECT() *MockListOpenOcoServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockListOpenOcoServiceAPI) Do(ctx context.Context, opts ...binance.RequestOption) ([]*binance.Oco, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].([]*binance.Oco)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockListOpenOcoServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockListOpenOcoServiceAPI)(nil).Do), varargs...)
+}
+
+// MockListOrdersServiceAPI is a mock of ListOrdersServiceAPI interface.
+type MockListOrdersServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockListOrdersServiceAPIMockRecorder
+}
+
+// MockListOrdersServiceAPIMockRecorder is the mock recorder for MockListOrdersServiceAPI.
+type MockListOrdersServiceAPIMockRecorder struct {
+	mock *MockListOrdersServiceAPI
+}
+
+// NewMockListOrdersServiceAPI creates a new mock instance.
+func NewMockListOrdersServiceAPI(ctrl *gomock.Controller) *MockListOrdersServiceAPI {
+	mock := &MockListOrdersServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockListOrdersServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockListOrdersServiceAPI) EXPECT() *MockListOrdersServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockListOrdersServiceAPI) Do(ctx context.Context, opts ...binance.RequestOption) ([]*binance.Order, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].([]*binance.Order)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockListOrdersServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockListOrdersServiceAPI)(nil).Do), varargs...)
+}
+
+// EndTime mocks base method.
+func (m *MockListOrdersServiceAPI) EndTime(endTime int64) binance.ListOrdersServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EndTime", endTime)
+	ret0, _ := ret[0].(binance.ListOrdersServiceAPI)
+	return ret0
+}
+
+// EndTime indicates an expected call of EndTime.
+func (mr *MockListOrdersServiceAPIMockRecorder) EndTime(endTime interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EndTime", reflect.TypeOf((*MockListOrdersServiceAPI)(nil).EndTime), endTime)
+}
+
+// Limit mocks base method.
+func (m *MockListOrdersServiceAPI) Limit(limit int) binance.ListOrdersServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Limit", limit)
+	ret0, _ := ret[0].(binance.ListOrdersServiceAPI)
+	return ret0
+}
+
+// Limit indicates an expected call of Limit.
+func (mr *MockListOrdersServiceAPIMockRecorder) Limit(limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Limit", reflect.TypeOf((*MockListOrdersServiceAPI)(nil).Limit), limit)
+}
+
+// OrderID mocks base method.
+func (m *MockListOrdersServiceAPI) OrderID(orderID int64) binance.ListOrdersServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OrderID", orderID)
+	ret0, _ := ret[0].(binance.ListOrdersServiceAPI)
+	return ret0
+}
+
+// OrderID indicates an expected call of OrderID.
+func (mr *MockListOrdersServiceAPIMockRecorder) OrderID(orderID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OrderID", reflect.TypeOf((*MockListOrdersServiceAPI)(nil).OrderID), orderID)
+}
+
+// StartTime mocks base method.
+func (m *MockListOrdersServiceAPI) StartTime(startTime int64) binance.ListOrdersServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartTime", startTime)
+	ret0, _ := ret[0].(binance.ListOrdersServiceAPI)
+	return ret0
+}
+
+// StartTime indicates an expected call of StartTime.
+func (mr *MockListOrdersServiceAPIMockRecorder) StartTime(startTime interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartTime", reflect.TypeOf((*MockListOrdersServiceAPI)(nil).StartTime), startTime)
+}
+
+// Symbol mocks base method.
+func (m *MockListOrdersServiceAPI) Symbol(symbol string) binance.ListOrdersServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Symbol", symbol)
+	ret0, _ := ret[0].(binance.ListOrdersServiceAPI)
+	return ret0
+}
+
+// Symbol indicates an expected call of Symbol.
+func (mr *MockListOrdersServiceAPIMockRecorder) Symbol(symbol interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Symbol", reflect.TypeOf((*MockListOrdersServiceAPI)(nil).Symbol), symbol)
+}
+
+// MockGetAccountServiceAPI is a mock of GetAccountServiceAPI interface.
+type MockGetAccountServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockGetAccountServiceAPIMockRecorder
+}
+
+// MockGetAccountServiceAPIMockRecorder is the mock recorder for MockGetAccountServiceAPI.
+type MockGetAccountServiceAPIMockRecorder struct {
+	mock *MockGetAccountServiceAPI
+}
+
+// NewMockGetAccountServiceAPI creates a new mock instance.
+func NewMockGetAccountServiceAPI(ctrl *gomock.Controller) *MockGetAccountServiceAPI {
+	mock := &MockGetAccountServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockGetAccountServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGetAccountServiceAPI) EXPECT() *MockGetAccountServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockGetAccountServiceAPI) Do(ctx context.Context, opts ...binance.RequestOption) (*binance.Account, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*binance.Account)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockGetAccountServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockGetAccountServiceAPI)(nil).Do), varargs...)
+}
+
+// OmitZeroBalances mocks base method.
+func (m *MockGetAccountServiceAPI) OmitZeroBalances(v bool) binance.GetAccountServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OmitZeroBalances", v)
+	ret0, _ := ret[0].(binance.GetAccountServiceAPI)
+	return ret0
+}
+
+// OmitZeroBalances indicates an expected call of OmitZeroBalances.
+func (mr *MockGetAccountServiceAPIMockRecorder) OmitZeroBalances(v interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OmitZeroBalances", reflect.TypeOf((*MockGetAccountServiceAPI)(nil).OmitZeroBalances), v)
+}
+
+// MockGetCommissionRatesServiceAPI is a mock of GetCommissionRatesServiceAPI interface.
+type MockGetCommissionRatesServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockGetCommissionRatesServiceAPIMockRecorder
+}
+
+// MockGetCommissionRatesServiceAPIMockRecorder is the mock recorder for MockGetCommissionRatesServiceAPI.
+type MockGetCommissionRatesServiceAPIMockRecorder struct {
+	mock *MockGetCommissionRatesServiceAPI
+}
+
+// NewMockGetCommissionRatesServiceAPI creates a new mock instance.
+func NewMockGetCommissionRatesServiceAPI(ctrl *gomock.Controller) *MockGetCommissionRatesServiceAPI {
+	mock := &MockGetCommissionRatesServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockGetCommissionRatesServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGetCommissionRatesServiceAPI) EXPECT() *MockGetCommissionRatesServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockGetCommissionRatesServiceAPI) Do(ctx context.Context, opts ...binance.RequestOption) (*binance.CommissionRatesResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*binance.CommissionRatesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockGetCommissionRatesServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockGetCommissionRatesServiceAPI)(nil).Do), varargs...)
+}
+
+// Symbol mocks base method.
+func (m *MockGetCommissionRatesServiceAPI) Symbol(symbol string) binance.GetCommissionRatesServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Symbol", symbol)
+	ret0, _ := ret[0].(binance.GetCommissionRatesServiceAPI)
+	return ret0
+}
+
+// Symbol indicates an expected call of Symbol.
+func (mr *MockGetCommissionRatesServiceAPIMockRecorder) Symbol(symbol interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Symbol", reflect.TypeOf((*MockGetCommissionRatesServiceAPI)(nil).Symbol), symbol)
+}
+
+// MockGetAPIKeyPermissionAPI is a mock of GetAPIKeyPermissionAPI interface.
+type MockGetAPIKeyPermissionAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockGetAPIKeyPermissionAPIMockRecorder
+}
+
+// MockGetAPIKeyPermissionAPIMockRecorder is the mock recorder for MockGetAPIKeyPermissionAPI.
+type MockGetAPIKeyPermissionAPIMockRecorder struct {
+	mock *MockGetAPIKeyPermissionAPI
+}
+
+// NewMockGetAPIKeyPermissionAPI creates a new mock instance.
+func NewMockGetAPIKeyPermissionAPI(ctrl *gomock.Controller) *MockGetAPIKeyPermissionAPI {
+	mock := &MockGetAPIKeyPermissionAPI{ctrl: ctrl}
+	mock.recorder = &MockGetAPIKeyPermissionAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGetAPIKeyPermissionAPI) EXPECT() *MockGetAPIKeyPermissionAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockGetAPIKeyPermissionAPI) Do(ctx context.Context, opts ...binance.RequestOption) (*binance.APIKeyPermission, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*binance.APIKeyPermission)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockGetAPIKeyPermissionAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockGetAPIKeyPermissionAPI)(nil).Do), varargs...)
+}
+
+// MockSavingFlexibleProductPositionsServiceAPI is a mock of SavingFlexibleProductPositionsServiceAPI interface.
+type MockSavingFlexibleProductPositionsServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockSavingFlexibleProductPositionsServiceAPIMockRecorder
+}
+
+// MockSavingFlexibleProductPositionsServiceAPIMockRecorder is the mock recorder for MockSavingFlexibleProductPositionsServiceAPI.
+type MockSavingFlexibleProductPositionsServiceAPIMockRecorder struct {
+	mock *MockSavingFlexibleProductPositionsServiceAPI
+}
+
+// NewMockSavingFlexibleProductPositionsServiceAPI creates a new mock instance.
+func NewMockSavingFlexibleProductPositionsServiceAPI(ctrl *gomock.Controller) *MockSavingFlexibleProductPositionsServiceAPI {
+	mock := &MockSavingFlexibleProductPositionsServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockSavingFlexibleProductPositionsServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSavingFlexibleProductPositionsServiceAPI) EXPECT() *MockSavingFlexibleProductPositionsServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Asset mocks base method.
+func (m *MockSavingFlexibleProductPositionsServiceAPI) Asset(asset string) binance.SavingFlexibleProductPositionsServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Asset", asset)
+	ret0, _ := ret[0].(binance.SavingFlexibleProductPositionsServiceAPI)
+	return ret0
+}
+
+// Asset indicates an expected call of Asset.
+func (mr *MockSavingFlexibleProductPositionsServiceAPIMockRecorder) Asset(asset interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Asset", reflect.TypeOf((*MockSavingFlexibleProductPositionsServiceAPI)(nil).Asset), asset)
+}
+
+// Do mocks base method.
+func (m *MockSavingFlexibleProductPositionsServiceAPI) Do(ctx context.Context, opts ...binance.RequestOption) ([]*binance.SavingFlexibleProductPosition, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].([]*binance.SavingFlexibleProductPosition)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockSavingFlexibleProductPositionsServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockSavingFlexibleProductPositionsServiceAPI)(nil).Do), varargs...)
+}
+
+// MockSavingFixedProjectPositionsServiceAPI is a mock of SavingFixedProjectPositionsServiceAPI interface.
+type MockSavingFixedProjectPositionsServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockSavingFixedProjectPositionsServiceAPIMockRecorder
+}
+
+// MockSavingFixedProjectPositionsServiceAPIMockRecorder is the mock recorder for MockSavingFixedProjectPositionsServiceAPI.
+type MockSavingFixedProjectPositionsServiceAPIMockRecorder struct {
+	mock *MockSavingFixedProjectPositionsServiceAPI
+}
+
+// NewMockSavingFixedProjectPositionsServiceAPI creates a new mock instance.
+func NewMockSavingFixedProjectPositionsServiceAPI(ctrl *gomock.Controller) *MockSavingFixedProjectPositionsServiceAPI {
+	mock := &MockSavingFixedProjectPositionsServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockSavingFixedProjectPositionsServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSavingFixedProjectPositionsServiceAPI) EXPECT() *MockSavingFixedProjectPositionsServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Asset mocks base method.
+func (m *MockSavingFixedProjectPositionsServiceAPI) Asset(asset string) binance.SavingFixedProjectPositionsServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Asset", asset)
+	ret0, _ := ret[0].(binance.SavingFixedProjectPositionsServiceAPI)
+	return ret0
+}
+
+// Asset indicates an expected call of Asset.
+func (mr *MockSavingFixedProjectPositionsServiceAPIMockRecorder) Asset(asset interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Asset", reflect.TypeOf((*MockSavingFixedProjectPositionsServiceAPI)(nil).Asset), asset)
+}
+
+// Do mocks base method.
+func (m *MockSavingFixedProjectPositionsServiceAPI) Do(ctx context.Context, opts ...binance.RequestOption) ([]*binance.SavingFixedProjectPosition, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].([]*binance.SavingFixedProjectPosition)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockSavingFixedProjectPositionsServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockSavingFixedProjectPositionsServiceAPI)(nil).Do), varargs...)
+}
+
+// ProjectID mocks base method.
+func (m *MockSavingFixedProjectPositionsServiceAPI) ProjectID(projectId string) binance.SavingFixedProjectPositionsServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ProjectID", projectId)
+	ret0, _ := ret[0].(binance.SavingFixedProjectPositionsServiceAPI)
+	return ret0
+}
+
+// ProjectID indicates an expected call of ProjectID.
+func (mr *MockSavingFixedProjectPositionsServiceAPIMockRecorder) ProjectID(projectId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ProjectID", reflect.TypeOf((*MockSavingFixedProjectPositionsServiceAPI)(nil).ProjectID), projectId)
+}
+
+// Status mocks base method.
+func (m *MockSavingFixedProjectPositionsServiceAPI) Status(status string) binance.SavingFixedProjectPositionsServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Status", status)
+	ret0, _ := ret[0].(binance.SavingFixedProjectPositionsServiceAPI)
+	return ret0
+}
+
+// Status indicates an expected call of Status.
+func (mr *MockSavingFixedProjectPositionsServiceAPIMockRecorder) Status(status interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Status", reflect.TypeOf((*MockSavingFixedProjectPositionsServiceAPI)(nil).Status), status)
+}
+
+// MockListSavingsFlexibleProductsServiceAPI is a mock of ListSavingsFlexibleProductsServiceAPI interface.
+type MockListSavingsFlexibleProductsServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockListSavingsFlexibleProductsServiceAPIMockRecorder
+}
+
+// MockListSavingsFlexibleProductsServiceAPIMockRecorder is the mock recorder for MockListSavingsFlexibleProductsServiceAPI.
+type MockListSavingsFlexibleProductsServiceAPIMockRecorder struct {
+	mock *MockListSavingsFlexibleProductsServiceAPI
+}
+
+// NewMockListSavingsFlexibleProductsServiceAPI creates a new mock instance.
+func NewMockListSavingsFlexibleProductsServiceAPI(ctrl *gomock.Controller) *MockListSavingsFlexibleProductsServiceAPI {
+	mock := &MockListSavingsFlexibleProductsServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockListSavingsFlexibleProductsServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockListSavingsFlexibleProductsServiceAPI) EXPECT() *MockListSavingsFlexibleProductsServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Current mocks base method.
+func (m *MockListSavingsFlexibleProductsServiceAPI) Current(current int64) binance.ListSavingsFlexibleProductsServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Current", current)
+	ret0, _ := ret[0].(binance.ListSavingsFlexibleProductsServiceAPI)
+	return ret0
+}
+
+// Current indicates an expected call of Current.
+func (mr *MockListSavingsFlexibleProductsServiceAPIMockRecorder) Current(current interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Current", reflect.TypeOf((*MockListSavingsFlexibleProductsServiceAPI)(nil).Current), current)
+}
+
+// Do mocks base method.
+func (m *MockListSavingsFlexibleProductsServiceAPI) Do(ctx context.Context, opts ...binance.RequestOption) ([]*binance.SavingsFlexibleProduct, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].([]*binance.SavingsFlexibleProduct)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockListSavingsFlexibleProductsServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockListSavingsFlexibleProductsServiceAPI)(nil).Do), varargs...)
+}
+
+// Featured mocks base method.
+func (m *MockListSavingsFlexibleProductsServiceAPI) Featured(featured string) binance.ListSavingsFlexibleProductsServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Featured", featured)
+	ret0, _ := ret[0].(binance.ListSavingsFlexibleProductsServiceAPI)
+	return ret0
+}
+
+// Featured indicates an expected call of Featured.
+func (mr *MockListSavingsFlexibleProductsServiceAPIMockRecorder) Featured(featured interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Featured", reflect.TypeOf((*MockListSavingsFlexibleProductsServiceAPI)(nil).Featured), featured)
+}
+
+// Size mocks base method.
+func (m *MockListSavingsFlexibleProductsServiceAPI) Size(size int64) binance.ListSavingsFlexibleProductsServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Size", size)
+	ret0, _ := ret[0].(binance.ListSavingsFlexibleProductsServiceAPI)
+	return ret0
+}
+
+// Size indicates an expected call of Size.
+func (mr *MockListSavingsFlexibleProductsServiceAPIMockRecorder) Size(size interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Size", reflect.TypeOf((*MockListSavingsFlexibleProductsServiceAPI)(nil).Size), size)
+}
+
+// Status mocks base method.
+func (m *MockListSavingsFlexibleProductsServiceAPI) Status(status string) binance.ListSavingsFlexibleProductsServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Status", status)
+	ret0, _ := ret[0].(binance.ListSavingsFlexibleProductsServiceAPI)
+	return ret0
+}
+
+// Status indicates an expected call of Status.
+func (mr *MockListSavingsFlexibleProductsServiceAPIMockRecorder) Status(status interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Status", reflect.TypeOf((*MockListSavingsFlexibleProductsServiceAPI)(nil).Status), status)
+}
+
+// MockPurchaseSavingsFlexibleProductServiceAPI is a mock of PurchaseSavingsFlexibleProductServiceAPI interface.
+type MockPurchaseSavingsFlexibleProductServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockPurchaseSavingsFlexibleProductServiceAPIMockRecorder
+}
+
+// MockPurchaseSavingsFlexibleProductServiceAPIMockRecorder is the mock recorder for MockPurchaseSavingsFlexibleProductServiceAPI.
+type MockPurchaseSavingsFlexibleProductServiceAPIMockRecorder struct {
+	mock *MockPurchaseSavingsFlexibleProductServiceAPI
+}
+
+// NewMockPurchaseSavingsFlexibleProductServiceAPI creates a new mock instance.
+func NewMockPurchaseSavingsFlexibleProductServiceAPI(ctrl *gomock.Controller) *MockPurchaseSavingsFlexibleProductServiceAPI {
+	mock := &MockPurchaseSavingsFlexibleProductServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockPurchaseSavingsFlexibleProductServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPurchaseSavingsFlexibleProductServiceAPI) EXPECT() *MockPurchaseSavingsFlexibleProductServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Amount mocks base method.
+func (m *MockPurchaseSavingsFlexibleProductServiceAPI) Amount(amount float64) binance.PurchaseSavingsFlexibleProductServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Amount", amount)
+	ret0, _ := ret[0].(binance.PurchaseSavingsFlexibleProductServiceAPI)
+	return ret0
+}
+
+// Amount indicates an expected call of Amount.
+func (mr *MockPurchaseSavingsFlexibleProductServiceAPIMockRecorder) Amount(amount interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Amount", reflect.TypeOf((*MockPurchaseSavingsFlexibleProductServiceAPI)(nil).Amount), amount)
+}
+
+// Do mocks base method.
+func (m *MockPurchaseSavingsFlexibleProductServiceAPI) Do(ctx context.Context, opts ...binance.RequestOption) (uint64, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockPurchaseSavingsFlexibleProductServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockPurchaseSavingsFlexibleProductServiceAPI)(nil).Do), varargs...)
+}
+
+// ProductId mocks base method.
+func (m *MockPurchaseSavingsFlexibleProductServiceAPI) ProductId(productId string) binance.PurchaseSavingsFlexibleProductServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ProductId", productId)
+	ret0, _ := ret[0].(binance.PurchaseSavingsFlexibleProductServiceAPI)
+	return ret0
+}
+
+// ProductId indicates an expected call of ProductId.
+func (mr *MockPurchaseSavingsFlexibleProductServiceAPIMockRecorder) ProductId(productId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ProductId", reflect.TypeOf((*MockPurchaseSavingsFlexibleProductServiceAPI)(nil).ProductId), productId)
+}
+
+// MockRedeemSavingsFlexibleProductServiceAPI is a mock of RedeemSavingsFlexibleProductServiceAPI interface.
+type MockRedeemSavingsFlexibleProductServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockRedeemSavingsFlexibleProductServiceAPIMockRecorder
+}
+
+// MockRedeemSavingsFlexibleProductServiceAPIMockRecorder is the mock recorder for MockRedeemSavingsFlexibleProductServiceAPI.
+type MockRedeemSavingsFlexibleProductServiceAPIMockRecorder struct {
+	mock *MockRedeemSavingsFlexibleProductServiceAPI
+}
+
+// NewMockRedeemSavingsFlexibleProductServiceAPI creates a new mock instance.
+func NewMockRedeemSavingsFlexibleProductServiceAPI(ctrl *gomock.Controller) *MockRedeemSavingsFlexibleProductServiceAPI {
+	mock := &MockRedeemSavingsFlexibleProductServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockRedeemSavingsFlexibleProductServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRedeemSavingsFlexibleProductServiceAPI) EXPECT() *MockRedeemSavingsFlexibleProductServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Amount mocks base method.
+func (m *MockRedeemSavingsFlexibleProductServiceAPI) Amount(amount float64) binance.RedeemSavingsFlexibleProductServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Amount", amount)
+	ret0, _ := ret[0].(binance.RedeemSavingsFlexibleProductServiceAPI)
+	return ret0
+}
+
+// Amount indicates an expected call of Amount.
+func (mr *MockRedeemSavingsFlexibleProductServiceAPIMockRecorder) Amount(amount interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Amount", reflect.TypeOf((*MockRedeemSavingsFlexibleProductServiceAPI)(nil).Amount), amount)
+}
+
+// Do mocks base method.
+func (m *MockRedeemSavingsFlexibleProductServiceAPI) Do(ctx context.Context, opts ...binance.RequestOption) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockRedeemSavingsFlexibleProductServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockRedeemSavingsFlexibleProductServiceAPI)(nil).Do), varargs...)
+}
+
+// ProductId mocks base method.
+func (m *MockRedeemSavingsFlexibleProductServiceAPI) ProductId(productId string) binance.RedeemSavingsFlexibleProductServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ProductId", productId)
+	ret0, _ := ret[0].(binance.RedeemSavingsFlexibleProductServiceAPI)
+	return ret0
+}
+
+// ProductId indicates an expected call of ProductId.
+func (mr *MockRedeemSavingsFlexibleProductServiceAPIMockRecorder) ProductId(productId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ProductId", reflect.TypeOf((*MockRedeemSavingsFlexibleProductServiceAPI)(nil).ProductId), productId)
+}
+
+// Type mocks base method.
+func (m *MockRedeemSavingsFlexibleProductServiceAPI) Type(redeemType string) binance.RedeemSavingsFlexibleProductServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Type", redeemType)
+	ret0, _ := ret[0].(binance.RedeemSavingsFlexibleProductServiceAPI)
+	return ret0
+}
+
+// Type indicates an expected call of Type.
+func (mr *MockRedeemSavingsFlexibleProductServiceAPIMockRecorder) Type(redeemType interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Type", reflect.TypeOf((*MockRedeemSavingsFlexibleProductServiceAPI)(nil).Type), redeemType)
+}
+
+// MockListSavingsFixedAndActivityProductsServiceAPI is a mock of ListSavingsFixedAndActivityProductsServiceAPI interface.
+type MockListSavingsFixedAndActivityProductsServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockListSavingsFixedAndActivityProductsServiceAPIMockRecorder
+}
+
+// MockListSavingsFixedAndActivityProductsServiceAPIMockRecorder is the mock recorder for MockListSavingsFixedAndActivityProductsServiceAPI.
+type MockListSavingsFixedAndActivityProductsServiceAPIMockRecorder struct {
+	mock *MockListSavingsFixedAndActivityProductsServiceAPI
+}
+
+// NewMockListSavingsFixedAndActivityProductsServiceAPI creates a new mock instance.
+func NewMockListSavingsFixedAndActivityProductsServiceAPI(ctrl *gomock.Controller) *MockListSavingsFixedAndActivityProductsServiceAPI {
+	mock := &MockListSavingsFixedAndActivityProductsServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockListSavingsFixedAndActivityProductsServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockListSavingsFixedAndActivityProductsServiceAPI) EXPECT() *MockListSavingsFixedAndActivityProductsServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Asset mocks base method.
+func (m *MockListSavingsFixedAndActivityProductsServiceAPI) Asset(asset string) binance.ListSavingsFixedAndActivityProductsServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Asset", asset)
+	ret0, _ := ret[0].(binance.ListSavingsFixedAndActivityProductsServiceAPI)
+	return ret0
+}
+
+// Asset indicates an expected call of Asset.
+func (mr *MockListSavingsFixedAndActivityProductsServiceAPIMockRecorder) Asset(asset interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Asset", reflect.TypeOf((*MockListSavingsFixedAndActivityProductsServiceAPI)(nil).Asset), asset)
+}
+
+// Current mocks base method.
+func (m *MockListSavingsFixedAndActivityProductsServiceAPI) Current(current int64) binance.ListSavingsFixedAndActivityProductsServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Current", current)
+	ret0, _ := ret[0].(binance.ListSavingsFixedAndActivityProductsServiceAPI)
+	return ret0
+}
+
+// Current indicates an expected call of Current.
+func (mr *MockListSavingsFixedAndActivityProductsServiceAPIMockRecorder) Current(current interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Current", reflect.TypeOf((*MockListSavingsFixedAndActivityProductsServiceAPI)(nil).Current), current)
+}
+
+// Do mocks base method.
+func (m *MockListSavingsFixedAndActivityProductsServiceAPI) Do(ctx context.Context, opts ...binance.RequestOption) ([]*binance.SavingsFixedProduct, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].([]*binance.SavingsFixedProduct)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockListSavingsFixedAndActivityProductsServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockListSavingsFixedAndActivityProductsServiceAPI)(nil).Do), varargs...)
+}
+
+// IsSortAsc mocks base method.
+func (m *MockListSavingsFixedAndActivityProductsServiceAPI) IsSortAsc(isSortAsc bool) binance.ListSavingsFixedAndActivityProductsServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsSortAsc", isSortAsc)
+	ret0, _ := ret[0].(binance.ListSavingsFixedAndActivityProductsServiceAPI)
+	return ret0
+}
+
+// IsSortAsc indicates an expected call of IsSortAsc.
+func (mr *MockListSavingsFixedAndActivityProductsServiceAPIMockRecorder) IsSortAsc(isSortAsc interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsSortAsc", reflect.TypeOf((*MockListSavingsFixedAndActivityProductsServiceAPI)(nil).IsSortAsc), isSortAsc)
+}
+
+// Size mocks base method.
+func (m *MockListSavingsFixedAndActivityProductsServiceAPI) Size(size int64) binance.ListSavingsFixedAndActivityProductsServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Size", size)
+	ret0, _ := ret[0].(binance.ListSavingsFixedAndActivityProductsServiceAPI)
+	return ret0
+}
+
+// Size indicates an expected call of Size.
+func (mr *MockListSavingsFixedAndActivityProductsServiceAPIMockRecorder) Size(size interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Size", reflect.TypeOf((*MockListSavingsFixedAndActivityProductsServiceAPI)(nil).Size), size)
+}
+
+// SortBy mocks base method.
+func (m *MockListSavingsFixedAndActivityProductsServiceAPI) SortBy(sortBy string) binance.ListSavingsFixedAndActivityProductsServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SortBy", sortBy)
+	ret0, _ := ret[0].(binance.ListSavingsFixedAndActivityProductsServiceAPI)
+	return ret0
+}
+
+// SortBy indicates an expected call of SortBy.
+func (mr *MockListSavingsFixedAndActivityProductsServiceAPIMockRecorder) SortBy(sortBy interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SortBy", reflect.TypeOf((*MockListSavingsFixedAndActivityProductsServiceAPI)(nil).SortBy), sortBy)
+}
+
+// Status mocks base method.
+func (m *MockListSavingsFixedAndActivityProductsServiceAPI) Status(status string) binance.ListSavingsFixedAndActivityProductsServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Status", status)
+	ret0, _ := ret[0].(binance.ListSavingsFixedAndActivityProductsServiceAPI)
+	return ret0
+}
+
+// Status indicates an expected call of Status.
+func (mr *MockListSavingsFixedAndActivityProductsServiceAPIMockRecorder) Status(status interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Status", reflect.TypeOf((*MockListSavingsFixedAndActivityProductsServiceAPI)(nil).Status), status)
+}
+
+// Type mocks base method.
+func (m *MockListSavingsFixedAndActivityProductsServiceAPI) Type(projectType string) binance.ListSavingsFixedAndActivityProductsServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Type", projectType)
+	ret0, _ := ret[0].(binance.ListSavingsFixedAndActivityProductsServiceAPI)
+	return ret0
+}
+
+// Type indicates an expected call of Type.
+func (mr *MockListSavingsFixedAndActivityProductsServiceAPIMockRecorder) Type(projectType interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Type", reflect.TypeOf((*MockListSavingsFixedAndActivityProductsServiceAPI)(nil).Type), projectType)
+}
+
+// MockGetAccountSnapshotServiceAPI is a mock of GetAccountSnapshotServiceAPI interface.
+type MockGetAccountSnapshotServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockGetAccountSnapshotServiceAPIMockRecorder
+}
+
+// MockGetAccountSnapshotServiceAPIMockRecorder is the mock recorder for MockGetAccountSnapshotServiceAPI.
+type MockGetAccountSnapshotServiceAPIMockRecorder struct {
+	mock *MockGetAccountSnapshotServiceAPI
+}
+
+// NewMockGetAccountSnapshotServiceAPI creates a new mock instance.
+func NewMockGetAccountSnapshotServiceAPI(ctrl *gomock.Controller) *MockGetAccountSnapshotServiceAPI {
+	mock := &MockGetAccountSnapshotServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockGetAccountSnapshotServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGetAccountSnapshotServiceAPI) EXPECT() *MockGetAccountSnapshotServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockGetAccountSnapshotServiceAPI) Do(ctx context.Context, opts ...binance.RequestOption) (*binance.Snapshot, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*binance.Snapshot)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockGetAccountSnapshotServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockGetAccountSnapshotServiceAPI)(nil).Do), varargs...)
+}
+
+// EndTime mocks base method.
+func (m *MockGetAccountSnapshotServiceAPI) EndTime(endTime int64) binance.GetAccountSnapshotServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EndTime", endTime)
+	ret0, _ := ret[0].(binance.GetAccountSnapshotServiceAPI)
+	return ret0
+}
+
+// EndTime indicates an expected call of EndTime.
+func (mr *MockGetAccountSnapshotServiceAPIMockRecorder) EndTime(endTime interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EndTime", reflect.TypeOf((*MockGetAccountSnapshotServiceAPI)(nil).EndTime), endTime)
+}
+
+// Limit mocks base method.
+func (m *MockGetAccountSnapshotServiceAPI) Limit(limit int) binance.GetAccountSnapshotServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Limit", limit)
+	ret0, _ := ret[0].(binance.GetAccountSnapshotServiceAPI)
+	return ret0
+}
+
+// Limit indicates an expected call of Limit.
+func (mr *MockGetAccountSnapshotServiceAPIMockRecorder) Limit(limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Limit", reflect.TypeOf((*MockGetAccountSnapshotServiceAPI)(nil).Limit), limit)
+}
+
+// StartTime mocks base method.
+func (m *MockGetAccountSnapshotServiceAPI) StartTime(startTime int64) binance.GetAccountSnapshotServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartTime", startTime)
+	ret0, _ := ret[0].(binance.GetAccountSnapshotServiceAPI)
+	return ret0
+}
+
+// StartTime indicates an expected call of StartTime.
+func (mr *MockGetAccountSnapshotServiceAPIMockRecorder) StartTime(startTime interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartTime", reflect.TypeOf((*MockGetAccountSnapshotServiceAPI)(nil).StartTime), startTime)
+}
+
+// Type mocks base method.
+func (m *MockGetAccountSnapshotServiceAPI) Type(accountType string) binance.GetAccountSnapshotServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Type", accountType)
+	ret0, _ := ret[0].(binance.GetAccountSnapshotServiceAPI)
+	return ret0
+}
+
+// Type indicates an expected call of Type.
+func (mr *MockGetAccountSnapshotServiceAPIMockRecorder) Type(accountType interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Type", reflect.TypeOf((*MockGetAccountSnapshotServiceAPI)(nil).Type), accountType)
+}
+
+// MockListTradesServiceAPI is a mock of ListTradesServiceAPI interface.
+type MockListTradesServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockListTradesServiceAPIMockRecorder
+}
+
+// MockListTradesServiceAPIMockRecorder is the mock recorder for MockListTradesServiceAPI.
+type MockListTradesServiceAPIMockRecorder struct {
+	mock *MockListTradesServiceAPI
+}
+
+// NewMockListTradesServiceAPI creates a new mock instance.
+func NewMockListTradesServiceAPI(ctrl *gomock.Controller) *MockListTradesServiceAPI {
+	mock := &MockListTradesServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockListTradesServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockListTradesServiceAPI) EXPECT() *MockListTradesServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockListTradesServiceAPI) Do(ctx context.Context, opts ...binance.RequestOption) ([]*binance.TradeV3, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].([]*binance.TradeV3)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockListTradesServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockListTradesServiceAPI)(nil).Do), varargs...)
+}
+
+// EndTime mocks base method.
+func (m *MockListTradesServiceAPI) EndTime(endTime int64) binance.ListTradesServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EndTime", endTime)
+	ret0, _ := ret[0].(binance.ListTradesServiceAPI)
+	return ret0
+}
+
+// EndTime indicates an expected call of EndTime.
+func (mr *MockListTradesServiceAPIMockRecorder) EndTime(endTime interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EndTime", reflect.TypeOf((*MockListTradesServiceAPI)(nil).EndTime), endTime)
+}
+
+// FromID mocks base method.
+func (m *MockListTradesServiceAPI) FromID(fromID int64) binance.ListTradesServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FromID", fromID)
+	ret0, _ := ret[0].(binance.ListTradesServiceAPI)
+	return ret0
+}
+
+// FromID indicates an expected call of FromID.
+func (mr *MockListTradesServiceAPIMockRecorder) FromID(fromID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FromID", reflect.TypeOf((*MockListTradesServiceAPI)(nil).FromID), fromID)
+}
+
+// Limit mocks base method.
+func (m *MockListTradesServiceAPI) Limit(limit int) binance.ListTradesServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Limit", limit)
+	ret0, _ := ret[0].(binance.ListTradesServiceAPI)
+	return ret0
+}
+
+// Limit indicates an expected call of Limit.
+func (mr *MockListTradesServiceAPIMockRecorder) Limit(limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Limit", reflect.TypeOf((*MockListTradesServiceAPI)(nil).Limit), limit)
+}
+
+// OrderId mocks base method.
+func (m *MockListTradesServiceAPI) OrderId(OrderId int64) binance.ListTradesServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OrderId", OrderId)
+	ret0, _ := ret[0].(binance.ListTradesServiceAPI)
+	return ret0
+}
+
+// OrderId indicates an expected call of OrderId.
+func (mr *MockListTradesServiceAPIMockRecorder) OrderId(OrderId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OrderId", reflect.TypeOf((*MockListTradesServiceAPI)(nil).OrderId), OrderId)
+}
+
+// StartTime mocks base method.
+func (m *MockListTradesServiceAPI) StartTime(startTime int64) binance.ListTradesServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartTime", startTime)
+	ret0, _ := ret[0].(binance.ListTradesServiceAPI)
+	return ret0
+}
+
+// StartTime indicates an expected call of StartTime.
+func (mr *MockListTradesServiceAPIMockRecorder) StartTime(startTime interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartTime", reflect.TypeOf((*MockListTradesServiceAPI)(nil).StartTime), startTime)
+}
+
+// Symbol mocks base method.
+func (m *MockListTradesServiceAPI) Symbol(symbol string) binance.ListTradesServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Symbol", symbol)
+	ret0, _ := ret[0].(binance.ListTradesServiceAPI)
+	return ret0
+}
+
+// Symbol indicates an expected call of Symbol.
+func (mr *MockListTradesServiceAPIMockRecorder) Symbol(symbol interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Symbol", reflect.TypeOf((*MockListTradesServiceAPI)(nil).Symbol), symbol)
+}
+
+// MockHistoricalTradesServiceAPI is a mock of HistoricalTradesServiceAPI interface.
+type MockHistoricalTradesServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockHistoricalTradesServiceAPIMockRecorder
+}
+
+// MockHistoricalTradesServiceAPIMockRecorder is the mock recorder for MockHistoricalTradesServiceAPI.
+type MockHistoricalTradesServiceAPIMockRecorder struct {
+	mock *MockHistoricalTradesServiceAPI
+}
+
+// NewMockHistoricalTradesServiceAPI creates a new mock instance.
+func NewMockHistoricalTradesServiceAPI(ctrl *gomock.Controller) *MockHistoricalTradesServiceAPI {
+	mock := &MockHistoricalTradesServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockHistoricalTradesServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockHistoricalTradesServiceAPI) EXPECT() *MockHistoricalTradesServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockHistoricalTradesServiceAPI) Do(ctx context.Context, opts ...binance.RequestOption) ([]*binance.Trade, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].([]*binance.Trade)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockHistoricalTradesServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockHistoricalTradesServiceAPI)(nil).Do), varargs...)
+}
+
+// FromID mocks base method.
+func (m *MockHistoricalTradesServiceAPI) FromID(fromID int64) binance.HistoricalTradesServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FromID", fromID)
+	ret0, _ := ret[0].(binance.HistoricalTradesServiceAPI)
+	return ret0
+}
+
+// FromID indicates an expected call of FromID.
+func (mr *MockHistoricalTradesServiceAPIMockRecorder) FromID(fromID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FromID", reflect.TypeOf((*MockHistoricalTradesServiceAPI)(nil).FromID), fromID)
+}
+
+// Limit mocks base method.
+func (m *MockHistoricalTradesServiceAPI) Limit(limit int) binance.HistoricalTradesServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Limit", limit)
+	ret0, _ := ret[0].(binance.HistoricalTradesServiceAPI)
+	return ret0
+}
+
+// Limit indicates an expected call of Limit.
+func (mr *MockHistoricalTradesServiceAPIMockRecorder) Limit(limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Limit", reflect.TypeOf((*MockHistoricalTradesServiceAPI)(nil).Limit), limit)
+}
+
+// Symbol mocks base method.
+func (m *MockHistoricalTradesServiceAPI) Symbol(symbol string) binance.HistoricalTradesServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Symbol", symbol)
+	ret0, _ := ret[0].(binance.HistoricalTradesServiceAPI)
+	return ret0
+}
+
+// Symbol indicates an expected call of Symbol.
+func (mr *MockHistoricalTradesServiceAPIMockRecorder) Symbol(symbol interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Symbol", reflect.TypeOf((*MockHistoricalTradesServiceAPI)(nil).Symbol), symbol)
+}
+
+// MockListDepositsServiceAPI is a mock of ListDepositsServiceAPI interface.
+type MockListDepositsServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockListDepositsServiceAPIMockRecorder
+}
+
+// MockListDepositsServiceAPIMockRecorder is the mock recorder for MockListDepositsServiceAPI.
+type MockListDepositsServiceAPIMockRecorder struct {
+	mock *MockListDepositsServiceAPI
+}
+
+// NewMockListDepositsServiceAPI creates a new mock instance.
+func NewMockListDepositsServiceAPI(ctrl *gomock.Controller) *MockListDepositsServiceAPI {
+	mock := &MockListDepositsServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockListDepositsServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockListDepositsServiceAPI) EXPECT() *MockListDepositsServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Coin mocks base method.
+func (m *MockListDepositsServiceAPI) Coin(coin string) binance.ListDepositsServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Coin", coin)
+	ret0, _ := ret[0].(binance.ListDepositsServiceAPI)
+	return ret0
+}
+
+// Coin indicates an expected call of Coin.
+func (mr *MockListDepositsServiceAPIMockRecorder) Coin(coin interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Coin", reflect.TypeOf((*MockListDepositsServiceAPI)(nil).Coin), coin)
+}
+
+// Do mocks base method.
+func (m *MockListDepositsServiceAPI) Do(ctx context.Context, opts ...binance.RequestOption) ([]*binance.Deposit, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].([]*binance.Deposit)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockListDepositsServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockListDepositsServiceAPI)(nil).Do), varargs...)
+}
+
+// EndTime mocks base method.
+func (m *MockListDepositsServiceAPI) EndTime(endTime int64) binance.ListDepositsServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EndTime", endTime)
+	ret0, _ := ret[0].(binance.ListDepositsServiceAPI)
+	return ret0
+}
+
+// EndTime indicates an expected call of EndTime.
+func (mr *MockListDepositsServiceAPIMockRecorder) EndTime(endTime interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EndTime", reflect.TypeOf((*MockListDepositsServiceAPI)(nil).EndTime), endTime)
+}
+
+// Limit mocks base method.
+func (m *MockListDepositsServiceAPI) Limit(limit int) binance.ListDepositsServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Limit", limit)
+	ret0, _ := ret[0].(binance.ListDepositsServiceAPI)
+	return ret0
+}
+
+// Limit indicates an expected call of Limit.
+func (mr *MockListDepositsServiceAPIMockRecorder) Limit(limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Limit", reflect.TypeOf((*MockListDepositsServiceAPI)(nil).Limit), limit)
+}
+
+// Offset mocks base method.
+func (m *MockListDepositsServiceAPI) Offset(offset int) binance.ListDepositsServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Offset", offset)
+	ret0, _ := ret[0].(binance.ListDepositsServiceAPI)
+	return ret0
+}
+
+// Offset indicates an expected call of Offset.
+func (mr *MockListDepositsServiceAPIMockRecorder) Offset(offset interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Offset", reflect.TypeOf((*MockListDepositsServiceAPI)(nil).Offset), offset)
+}
+
+// StartTime mocks base method.
+func (m *MockListDepositsServiceAPI) StartTime(startTime int64) binance.ListDepositsServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartTime", startTime)
+	ret0, _ := ret[0].(binance.ListDepositsServiceAPI)
+	return ret0
+}
+
+// StartTime indicates an expected call of StartTime.
+func (mr *MockListDepositsServiceAPIMockRecorder) StartTime(startTime interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartTime", reflect.TypeOf((*MockListDepositsServiceAPI)(nil).StartTime), startTime)
+}
+
+// Status mocks base method.
+func (m *MockListDepositsServiceAPI) Status(status int) binance.ListDepositsServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Status", status)
+	ret0, _ := ret[0].(binance.ListDepositsServiceAPI)
+	return ret0
+}
+
+// Status indicates an expected call of Status.
+func (mr *MockListDepositsServiceAPIMockRecorder) Status(status interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Status", reflect.TypeOf((*MockListDepositsServiceAPI)(nil).Status), status)
+}
+
+// TxID mocks base method.
+func (m *MockListDepositsServiceAPI) TxID(id string) binance.ListDepositsServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TxID", id)
+	ret0, _ := ret[0].(binance.ListDepositsServiceAPI)
+	return ret0
+}
+
+// TxID indicates an expected call of TxID.
+func (mr *MockListDepositsServiceAPIMockRecorder) TxID(id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TxID", reflect.TypeOf((*MockListDepositsServiceAPI)(nil).TxID), id)
+}
+
+// MockGetDepositsAddressServiceAPI is a mock of GetDepositsAddressServiceAPI interface.
+type MockGetDepositsAddressServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockGetDepositsAddressServiceAPIMockRecorder
+}
+
+// MockGetDepositsAddressServiceAPIMockRecorder is the mock recorder for MockGetDepositsAddressServiceAPI.
+type MockGetDepositsAddressServiceAPIMockRecorder struct {
+	mock *MockGetDepositsAddressServiceAPI
+}
+
+// NewMockGetDepositsAddressServiceAPI creates a new mock instance.
+func NewMockGetDepositsAddressServiceAPI(ctrl *gomock.Controller) *MockGetDepositsAddressServiceAPI {
+	mock := &MockGetDepositsAddressServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockGetDepositsAddressServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGetDepositsAddressServiceAPI) EXPECT() *MockGetDepositsAddressServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Coin mocks base method.
+func (m *MockGetDepositsAddressServiceAPI) Coin(coin string) binance.GetDepositsAddressServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Coin", coin)
+	ret0, _ := ret[0].(binance.GetDepositsAddressServiceAPI)
+	return ret0
+}
+
+// Coin indicates an expected call of Coin.
+func (mr *MockGetDepositsAddressServiceAPIMockRecorder) Coin(coin interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Coin", reflect.TypeOf((*MockGetDepositsAddressServiceAPI)(nil).Coin), coin)
+}
+
+// Do mocks base method.
+func (m *MockGetDepositsAddressServiceAPI) Do(ctx context.Context, opts ...binance.RequestOption) (*binance.GetDepositAddressResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*binance.GetDepositAddressResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockGetDepositsAddressServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockGetDepositsAddressServiceAPI)(nil).Do), varargs...)
+}
+
+// Network mocks base method.
+func (m *MockGetDepositsAddressServiceAPI) Network(network string) binance.GetDepositsAddressServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Network", network)
+	ret0, _ := ret[0].(binance.GetDepositsAddressServiceAPI)
+	return ret0
+}
+
+// Network indicates an expected call of Network.
+func (mr *MockGetDepositsAddressServiceAPIMockRecorder) Network(network interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Network", reflect.TypeOf((*MockGetDepositsAddressServiceAPI)(nil).Network), network)
+}
+
+// MockCreateWithdrawServiceAPI is a mock of CreateWithdrawServiceAPI interface.
+type MockCreateWithdrawServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockCreateWithdrawServiceAPIMockRecorder
+}
+
+// MockCreateWithdrawServiceAPIMockRecorder is the mock recorder for MockCreateWithdrawServiceAPI.
+type MockCreateWithdrawServiceAPIMockRecorder struct {
+	mock *MockCreateWithdrawServiceAPI
+}
+
+// NewMockCreateWithdrawServiceAPI creates a new mock instance.
+func NewMockCreateWithdrawServiceAPI(ctrl *gomock.Controller) *MockCreateWithdrawServiceAPI {
+	mock := &MockCreateWithdrawServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockCreateWithdrawServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCreateWithdrawServiceAPI) EXPECT() *MockCreateWithdrawServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Address mocks base method.
+func (m *MockCreateWithdrawServiceAPI) Address(v string) binance.CreateWithdrawServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Address", v)
+	ret0, _ := ret[0].(binance.CreateWithdrawServiceAPI)
+	return ret0
+}
+
+// Address indicates an expected call of Address.
+func (mr *MockCreateWithdrawServiceAPIMockRecorder) Address(v interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Address", reflect.TypeOf((*MockCreateWithdrawServiceAPI)(nil).Address), v)
+}
+
+// AddressTag mocks base method.
+func (m *MockCreateWithdrawServiceAPI) AddressTag(v string) binance.CreateWithdrawServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddressTag", v)
+	ret0, _ := ret[0].(binance.CreateWithdrawServiceAPI)
+	return ret0
+}
+
+// AddressTag indicates an expected call of AddressTag.
+func (mr *MockCreateWithdrawServiceAPIMockRecorder) AddressTag(v interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddressTag", reflect.TypeOf((*MockCreateWithdrawServiceAPI)(nil).AddressTag), v)
+}
+
+// Amount mocks base method.
+func (m *MockCreateWithdrawServiceAPI) Amount(v string) binance.CreateWithdrawServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Amount", v)
+	ret0, _ := ret[0].(binance.CreateWithdrawServiceAPI)
+	return ret0
+}
+
+// Amount indicates an expected call of Amount.
+func (mr *MockCreateWithdrawServiceAPIMockRecorder) Amount(v interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Amount", reflect.TypeOf((*MockCreateWithdrawServiceAPI)(nil).Amount), v)
+}
+
+// Coin mocks base method.
+func (m *MockCreateWithdrawServiceAPI) Coin(v string) binance.CreateWithdrawServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Coin", v)
+	ret0, _ := ret[0].(binance.CreateWithdrawServiceAPI)
+	return ret0
+}
+
+// Coin indicates an expected call of Coin.
+func (mr *MockCreateWithdrawServiceAPIMockRecorder) Coin(v interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Coin", reflect.TypeOf((*MockCreateWithdrawServiceAPI)(nil).Coin), v)
+}
+
+// Do mocks base method.
+func (m *MockCreateWithdrawServiceAPI) Do(ctx context.Context, opts ...binance.RequestOption) (*binance.CreateWithdrawResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*binance.CreateWithdrawResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockCreateWithdrawServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockCreateWithdrawServiceAPI)(nil).Do), varargs...)
+}
+
+// Name mocks base method.
+func (m *MockCreateWithdrawServiceAPI) Name(v string) binance.CreateWithdrawServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Name", v)
+	ret0, _ := ret[0].(binance.CreateWithdrawServiceAPI)
+	return ret0
+}
+
+// Name indicates an expected call of Name.
+func (mr *MockCreateWithdrawServiceAPIMockRecorder) Name(v interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Name", reflect.TypeOf((*MockCreateWithdrawServiceAPI)(nil).Name), v)
+}
+
+// Network mocks base method.
+func (m *MockCreateWithdrawServiceAPI) Network(v string) binance.CreateWithdrawServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Network", v)
+	ret0, _ := ret[0].(binance.CreateWithdrawServiceAPI)
+	return ret0
+}
+
+// Network indicates an expected call of Network.
+func (mr *MockCreateWithdrawServiceAPIMockRecorder) Network(v interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Network", reflect.TypeOf((*MockCreateWithdrawServiceAPI)(nil).Network), v)
+}
+
+// TransactionFeeFlag mocks base method.
+func (m *MockCreateWithdrawServiceAPI) TransactionFeeFlag(v bool) binance.CreateWithdrawServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TransactionFeeFlag", v)
+	ret0, _ := ret[0].(binance.CreateWithdrawServiceAPI)
+	return ret0
+}
+
+// TransactionFeeFlag indicates an expected call of TransactionFeeFlag.
+func (mr *MockCreateWithdrawServiceAPIMockRecorder) TransactionFeeFlag(v interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TransactionFeeFlag", reflect.TypeOf((*MockCreateWithdrawServiceAPI)(nil).TransactionFeeFlag), v)
+}
+
+// WalletType mocks base method.
+func (m *MockCreateWithdrawServiceAPI) WalletType(walletType int) binance.CreateWithdrawServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WalletType", walletType)
+	ret0, _ := ret[0].(binance.CreateWithdrawServiceAPI)
+	return ret0
+}
+
+// WalletType indicates an expected call of WalletType.
+func (mr *MockCreateWithdrawServiceAPIMockRecorder) WalletType(walletType interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WalletType", reflect.TypeOf((*MockCreateWithdrawServiceAPI)(nil).WalletType), walletType)
+}
+
+// WithdrawOrderID mocks base method.
+func (m *MockCreateWithdrawServiceAPI) WithdrawOrderID(v string) binance.CreateWithdrawServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WithdrawOrderID", v)
+	ret0, _ := ret[0].(binance.CreateWithdrawServiceAPI)
+	return ret0
+}
+
+// WithdrawOrderID indicates an expected call of WithdrawOrderID.
+func (mr *MockCreateWithdrawServiceAPIMockRecorder) WithdrawOrderID(v interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WithdrawOrderID", reflect.TypeOf((*MockCreateWithdrawServiceAPI)(nil).WithdrawOrderID), v)
+}
+
+// MockListWithdrawsServiceAPI is a mock of ListWithdrawsServiceAPI interface.
+type MockListWithdrawsServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockListWithdrawsServiceAPIMockRecorder
+}
+
+// MockListWithdrawsServiceAPIMockRecorder is the mock recorder for MockListWithdrawsServiceAPI.
+type MockListWithdrawsServiceAPIMockRecorder struct {
+	mock *MockListWithdrawsServiceAPI
+}
+
+// NewMockListWithdrawsServiceAPI creates a new mock instance.
+func NewMockListWithdrawsServiceAPI(ctrl *gomock.Controller) *MockListWithdrawsServiceAPI {
+	mock := &MockListWithdrawsServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockListWithdrawsServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockListWithdrawsServiceAPI) EXPECT() *MockListWithdrawsServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Coin mocks base method.
+func (m *MockListWithdrawsServiceAPI) Coin(coin string) binance.ListWithdrawsServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Coin", coin)
+	ret0, _ := ret[0].(binance.ListWithdrawsServiceAPI)
+	return ret0
+}
+
+// Coin indicates an expected call of Coin.
+func (mr *MockListWithdrawsServiceAPIMockRecorder) Coin(coin interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Coin", reflect.TypeOf((*MockListWithdrawsServiceAPI)(nil).Coin), coin)
+}
+
+// Do mocks base method.
+func (m *MockListWithdrawsServiceAPI) Do(ctx context.Context, opts ...binance.RequestOption) ([]*binance.Withdraw, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].([]*binance.Withdraw)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockListWithdrawsServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockListWithdrawsServiceAPI)(nil).Do), varargs...)
+}
+
+// EndTime mocks base method.
+func (m *MockListWithdrawsServiceAPI) EndTime(endTime int64) binance.ListWithdrawsServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EndTime", endTime)
+	ret0, _ := ret[0].(binance.ListWithdrawsServiceAPI)
+	return ret0
+}
+
+// EndTime indicates an expected call of EndTime.
+func (mr *MockListWithdrawsServiceAPIMockRecorder) EndTime(endTime interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EndTime", reflect.TypeOf((*MockListWithdrawsServiceAPI)(nil).EndTime), endTime)
+}
+
+// IdList mocks base method.
+func (m *MockListWithdrawsServiceAPI) IdList(ids string) binance.ListWithdrawsServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IdList", ids)
+	ret0, _ := ret[0].(binance.ListWithdrawsServiceAPI)
+	return ret0
+}
+
+// IdList indicates an expected call of IdList.
+func (mr *MockListWithdrawsServiceAPIMockRecorder) IdList(ids interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IdList", reflect.TypeOf((*MockListWithdrawsServiceAPI)(nil).IdList), ids)
+}
+
+// Limit mocks base method.
+func (m *MockListWithdrawsServiceAPI) Limit(limit int) binance.ListWithdrawsServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Limit", limit)
+	ret0, _ := ret[0].(binance.ListWithdrawsServiceAPI)
+	return ret0
+}
+
+// Limit indicates an expected call of Limit.
+func (mr *MockListWithdrawsServiceAPIMockRecorder) Limit(limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Limit", reflect.TypeOf((*MockListWithdrawsServiceAPI)(nil).Limit), limit)
+}
+
+// Offset mocks base method.
+func (m *MockListWithdrawsServiceAPI) Offset(offset int) binance.ListWithdrawsServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Offset", offset)
+	ret0, _ := ret[0].(binance.ListWithdrawsServiceAPI)
+	return ret0
+}
+
+// Offset indicates an expected call of Offset.
+func (mr *MockListWithdrawsServiceAPIMockRecorder) Offset(offset interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Offset", reflect.TypeOf((*MockListWithdrawsServiceAPI)(nil).Offset), offset)
+}
+
+// StartTime mocks base method.
+func (m *MockListWithdrawsServiceAPI) StartTime(startTime int64) binance.ListWithdrawsServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartTime", startTime)
+	ret0, _ := ret[0].(binance.ListWithdrawsServiceAPI)
+	return ret0
+}
+
+// StartTime indicates an expected call of StartTime.
+func (mr *MockListWithdrawsServiceAPIMockRecorder) StartTime(startTime interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartTime", reflect.TypeOf((*MockListWithdrawsServiceAPI)(nil).StartTime), startTime)
+}
+
+// Status mocks base method.
+func (m *MockListWithdrawsServiceAPI) Status(status int) binance.ListWithdrawsServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Status", status)
+	ret0, _ := ret[0].(binance.ListWithdrawsServiceAPI)
+	return ret0
+}
+
+// Status indicates an expected call of Status.
+func (mr *MockListWithdrawsServiceAPIMockRecorder) Status(status interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Status", reflect.TypeOf((*MockListWithdrawsServiceAPI)(nil).Status), status)
+}
+
+// WithdrawOrderId mocks base method.
+func (m *MockListWithdrawsServiceAPI) WithdrawOrderId(withdrawOrderId string) binance.ListWithdrawsServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WithdrawOrderId", withdrawOrderId)
+	ret0, _ := ret[0].(binance.ListWithdrawsServiceAPI)
+	return ret0
+}
+
+// WithdrawOrderId indicates an expected call of WithdrawOrderId.
+func (mr *MockListWithdrawsServiceAPIMockRecorder) WithdrawOrderId(withdrawOrderId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WithdrawOrderId", reflect.TypeOf((*MockListWithdrawsServiceAPI)(nil).WithdrawOrderId), withdrawOrderId)
+}
+
+// MockStartUserStreamServiceAPI is a mock of StartUserStreamServiceAPI interface.
+type MockStartUserStreamServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockStartUserStreamServiceAPIMockRecorder
+}
+
+// MockStartUserStreamServiceAPIMockRecorder is the mock recorder for MockStartUserStreamServiceAPI.
+type MockStartUserStreamServiceAPIMockRecorder struct {
+	mock *MockStartUserStreamServiceAPI
+}
+
+// NewMockStartUserStreamServiceAPI creates a new mock instance.
+func NewMockStartUserStreamServiceAPI(ctrl *gomock.Controller) *MockStartUserStreamServiceAPI {
+	mock := &MockStartUserStreamServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockStartUserStreamServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockStartUserStreamServiceAPI) EXPECT() *MockStartUserStreamServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockStartUserStreamServiceAPI) Do(ctx context.Context, opts ...binance.RequestOption) (string, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockStartUserStreamServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockStartUserStreamServiceAPI)(nil).Do), varargs...)
+}
+
+// MockKeepaliveUserStreamServiceAPI is a mock of KeepaliveUserStreamServiceAPI interface.
+type MockKeepaliveUserStreamServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockKeepaliveUserStreamServiceAPIMockRecorder
+}
+
+// MockKeepaliveUserStreamServiceAPIMockRecorder is the mock recorder for MockKeepaliveUserStreamServiceAPI.
+type MockKeepaliveUserStreamServiceAPIMockRecorder struct {
+	mock *MockKeepaliveUserStreamServiceAPI
+}
+
+// NewMockKeepaliveUserStreamServiceAPI creates a new mock instance.
+func NewMockKeepaliveUserStreamServiceAPI(ctrl *gomock.Controller) *MockKeepaliveUserStreamServiceAPI {
+	mock := &MockKeepaliveUserStreamServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockKeepaliveUserStreamServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockKeepaliveUserStreamServiceAPI) EXPECT() *MockKeepaliveUserStreamServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockKeepaliveUserStreamServiceAPI) Do(ctx context.Context, opts ...binance.RequestOption) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockKeepaliveUserStreamServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockKeepaliveUserStreamServiceAPI)(nil).Do), varargs...)
+}
+
+// ListenKey mocks base method.
+func (m *MockKeepaliveUserStreamServiceAPI) ListenKey(listenKey string) binance.KeepaliveUserStreamServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListenKey", listenKey)
+	ret0, _ := ret[0].(binance.KeepaliveUserStreamServiceAPI)
+	return ret0
+}
+
+// ListenKey indicates an expected call of ListenKey.
+func (mr *MockKeepaliveUserStreamServiceAPIMockRecorder) ListenKey(listenKey interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListenKey", reflect.TypeOf((*MockKeepaliveUserStreamServiceAPI)(nil).ListenKey), listenKey)
+}
+
+// MockCloseUserStreamServiceAPI is a mock of CloseUserStreamServiceAPI interface.
+type MockCloseUserStreamServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockCloseUserStreamServiceAPIMockRecorder
+}
+
+// MockCloseUserStreamServiceAPIMockRecorder is the mock recorder for MockCloseUserStreamServiceAPI.
+type MockCloseUserStreamServiceAPIMockRecorder struct {
+	mock *MockCloseUserStreamServiceAPI
+}
+
+// NewMockCloseUserStreamServiceAPI creates a new mock instance.
+func NewMockCloseUserStreamServiceAPI(ctrl *gomock.Controller) *MockCloseUserStreamServiceAPI {
+	mock := &MockCloseUserStreamServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockCloseUserStreamServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCloseUserStreamServiceAPI) EXPECT() *MockCloseUserStreamServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockCloseUserStreamServiceAPI) Do(ctx context.Context, opts ...binance.RequestOption) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockCloseUserStreamServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockCloseUserStreamServiceAPI)(nil).Do), varargs...)
+}
+
+// ListenKey mocks base method.
+func (m *MockCloseUserStreamServiceAPI) ListenKey(listenKey string) binance.CloseUserStreamServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListenKey", listenKey)
+	ret0, _ := ret[0].(binance.CloseUserStreamServiceAPI)
+	return ret0
+}
+
+// ListenKey indicates an expected call of ListenKey.
+func (mr *MockCloseUserStreamServiceAPIMockRecorder) ListenKey(listenKey interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListenKey", reflect.TypeOf((*MockCloseUserStreamServiceAPI)(nil).ListenKey), listenKey)
+}
+
+// MockExchangeInfoServiceAPI is a mock of ExchangeInfoServiceAPI interface.
+type MockExchangeInfoServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockExchangeInfoServiceAPIMockRecorder
+}
+
+// MockExchangeInfoServiceAPIMockRecorder is the mock recorder for MockExchangeInfoServiceAPI.
+type MockExchangeInfoServiceAPIMockRecorder struct {
+	mock *MockExchangeInfoServiceAPI
+}
+
+// NewMockExchangeInfoServiceAPI creates a new mock instance.
+func NewMockExchangeInfoServiceAPI(ctrl *gomock.Controller) *MockExchangeInfoServiceAPI {
+	mock := &MockExchangeInfoServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockExchangeInfoServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockExchangeInfoServiceAPI) EXPECT() *MockExchangeInfoServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockExchangeInfoServiceAPI) Do(ctx context.Context, opts ...binance.RequestOption) (*binance.ExchangeInfo, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*binance.ExchangeInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockExchangeInfoServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockExchangeInfoServiceAPI)(nil).Do), varargs...)
+}
+
+// Permissions mocks base method.
+func (m *MockExchangeInfoServiceAPI) Permissions(permissions ...string) binance.ExchangeInfoServiceAPI {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{}
+	for _, a := range permissions {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Permissions", varargs...)
+	ret0, _ := ret[0].(binance.ExchangeInfoServiceAPI)
+	return ret0
+}
+
+// Permissions indicates an expected call of Permissions.
+func (mr *MockExchangeInfoServiceAPIMockRecorder) Permissions(permissions ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Permissions", reflect.TypeOf((*MockExchangeInfoServiceAPI)(nil).Permissions), permissions...)
+}
+
+// ShowPermissionSets mocks base method.
+func (m *MockExchangeInfoServiceAPI) ShowPermissionSets(showPermissionSets *bool) binance.ExchangeInfoServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ShowPermissionSets", showPermissionSets)
+	ret0, _ := ret[0].(binance.ExchangeInfoServiceAPI)
+	return ret0
+}
+
+// ShowPermissionSets indicates an expected call of ShowPermissionSets.
+func (mr *MockExchangeInfoServiceAPIMockRecorder) ShowPermissionSets(showPermissionSets interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ShowPermissionSets", reflect.TypeOf((*MockExchangeInfoServiceAPI)(nil).ShowPermissionSets), showPermissionSets)
+}
+
+// Symbol mocks base method.
+func (m *MockExchangeInfoServiceAPI) Symbol(symbol string) binance.ExchangeInfoServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Symbol", symbol)
+	ret0, _ := ret[0].(binance.ExchangeInfoServiceAPI)
+	return ret0
+}
+
+// Symbol indicates an expected call of Symbol.
+func (mr *MockExchangeInfoServiceAPIMockRecorder) Symbol(symbol interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Symbol", reflect.TypeOf((*MockExchangeInfoServiceAPI)(nil).Symbol), symbol)
+}
+
+// Symbols mocks base method.
+func (m *MockExchangeInfoServiceAPI) Symbols(symbols ...string) binance.ExchangeInfoServiceAPI {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{}
+	for _, a := range symbols {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Symbols", varargs...)
+	ret0, _ := ret[0].(binance.ExchangeInfoServiceAPI)
+	return ret0
+}
+
+// Symbols indicates an expected call of Symbols.
+func (mr *MockExchangeInfoServiceAPIMockRecorder) Symbols(symbols ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Symbols", reflect.TypeOf((*MockExchangeInfoServiceAPI)(nil).Symbols), symbols...)
+}
+
+// MockRateLimitServiceAPI is a mock of RateLimitServiceAPI interface.
+type MockRateLimitServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockRateLimitServiceAPIMockRecorder
+}
+
+// MockRateLimitServiceAPIMockRecorder is the mock recorder for MockRateLimitServiceAPI.
+type MockRateLimitServiceAPIMockRecorder struct {
+	mock *MockRateLimitServiceAPI
+}
+
+// NewMockRateLimitServiceAPI creates a new mock instance.
+func NewMockRateLimitServiceAPI(ctrl *gomock.Controller) *MockRateLimitServiceAPI {
+	mock := &MockRateLimitServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockRateLimitServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRateLimitServiceAPI) EXPECT() *MockRateLimitServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockRateLimitServiceAPI) Do(ctx context.Context, opts ...binance.RequestOption) ([]*binance.RateLimitFull, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].([]*binance.RateLimitFull)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockRateLimitServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockRateLimitServiceAPI)(nil).Do), varargs...)
+}
+
+// MockGetAssetDetailServiceAPI is a mock of GetAssetDetailServiceAPI interface.
+type MockGetAssetDetailServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockGetAssetDetailServiceAPIMockRecorder
+}
+
+// MockGetAssetDetailServiceAPIMockRecorder is the mock recorder for MockGetAssetDetailServiceAPI.
+type MockGetAssetDetailServiceAPIMockRecorder struct {
+	mock *MockGetAssetDetailServiceAPI
+}
+
+// NewMockGetAssetDetailServiceAPI creates a new mock instance.
+func NewMockGetAssetDetailServiceAPI(ctrl *gomock.Controller) *MockGetAssetDetailServiceAPI {
+	mock := &MockGetAssetDetailServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockGetAssetDetailServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGetAssetDetailServiceAPI) EXPECT() *MockGetAssetDetailServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Asset mocks base method.
+func (m *MockGetAssetDetailServiceAPI) Asset(asset string) binance.GetAssetDetailServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Asset", asset)
+	ret0, _ := ret[0].(binance.GetAssetDetailServiceAPI)
+	return ret0
+}
+
+// Asset indicates an expected call of Asset.
+func (mr *MockGetAssetDetailServiceAPIMockRecorder) Asset(asset interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Asset", reflect.TypeOf((*MockGetAssetDetailServiceAPI)(nil).Asset), asset)
+}
+
+// Do mocks base method.
+func (m *MockGetAssetDetailServiceAPI) Do(ctx context.Context) (map[string]binance.AssetDetail, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Do", ctx)
+	ret0, _ := ret[0].(map[string]binance.AssetDetail)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockGetAssetDetailServiceAPIMockRecorder) Do(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockGetAssetDetailServiceAPI)(nil).Do), ctx)
+}
+
+// MockWalletBalanceServiceAPI is a mock of WalletBalanceServiceAPI interface.
+type MockWalletBalanceServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockWalletBalanceServiceAPIMockRecorder
+}
+
+// MockWalletBalanceServiceAPIMockRecorder is the mock recorder for MockWalletBalanceServiceAPI.
+type MockWalletBalanceServiceAPIMockRecorder struct {
+	mock *MockWalletBalanceServiceAPI
+}
+
+// NewMockWalletBalanceServiceAPI creates a new mock instance.
+func NewMockWalletBalanceServiceAPI(ctrl *gomock.Controller) *MockWalletBalanceServiceAPI {
+	mock := &MockWalletBalanceServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockWalletBalanceServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockWalletBalanceServiceAPI) EXPECT() *MockWalletBalanceServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockWalletBalanceServiceAPI) Do(ctx context.Context, opts ...binance.RequestOption) ([]*binance.WalletBalance, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].([]*binance.WalletBalance)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockWalletBalanceServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockWalletBalanceServiceAPI)(nil).Do), varargs...)
+}
+
+// QuoteAsset mocks base method.
+func (m *MockWalletBalanceServiceAPI) QuoteAsset(asset string) binance.WalletBalanceServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "QuoteAsset", asset)
+	ret0, _ := ret[0].(binance.WalletBalanceServiceAPI)
+	return ret0
+}
+
+// QuoteAsset indicates an expected call of QuoteAsset.
+func (mr *MockWalletBalanceServiceAPIMockRecorder) QuoteAsset(asset interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QuoteAsset", reflect.TypeOf((*MockWalletBalanceServiceAPI)(nil).QuoteAsset), asset)
+}
+
+// MockGetFundingAssetServiceAPI is a mock of GetFundingAssetServiceAPI interface.
+type MockGetFundingAssetServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockGetFundingAssetServiceAPIMockRecorder
+}
+
+// MockGetFundingAssetServiceAPIMockRecorder is the mock recorder for MockGetFundingAssetServiceAPI.
+type MockGetFundingAssetServiceAPIMockRecorder struct {
+	mock *MockGetFundingAssetServiceAPI
+}
+
+// NewMockGetFundingAssetServiceAPI creates a new mock instance.
+func NewMockGetFundingAssetServiceAPI(ctrl *gomock.Controller) *MockGetFundingAssetServiceAPI {
+	mock := &MockGetFundingAssetServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockGetFundingAssetServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGetFundingAssetServiceAPI) EXPECT() *MockGetFundingAssetServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Asset mocks base method.
+func (m *MockGetFundingAssetServiceAPI) Asset(asset string) binance.GetFundingAssetServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Asset", asset)
+	ret0, _ := ret[0].(binance.GetFundingAssetServiceAPI)
+	return ret0
+}
+
+// Asset indicates an expected call of Asset.
+func (mr *MockGetFundingAssetServiceAPIMockRecorder) Asset(asset interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Asset", reflect.TypeOf((*MockGetFundingAssetServiceAPI)(nil).Asset), asset)
+}
+
+// Do mocks base method.
+func (m *MockGetFundingAssetServiceAPI) Do(ctx context.Context) ([]binance.FundingAsset, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Do", ctx)
+	ret0, _ := ret[0].([]binance.FundingAsset)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockGetFundingAssetServiceAPIMockRecorder) Do(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockGetFundingAssetServiceAPI)(nil).Do), ctx)
+}
+
+// NeedBtcValuation mocks base method.
+func (m *MockGetFundingAssetServiceAPI) NeedBtcValuation(needBtcValuation string) binance.GetFundingAssetServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NeedBtcValuation", needBtcValuation)
+	ret0, _ := ret[0].(binance.GetFundingAssetServiceAPI)
+	return ret0
+}
+
+// NeedBtcValuation indicates an expected call of NeedBtcValuation.
+func (mr *MockGetFundingAssetServiceAPIMockRecorder) NeedBtcValuation(needBtcValuation interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NeedBtcValuation", reflect.TypeOf((*MockGetFundingAssetServiceAPI)(nil).NeedBtcValuation), needBtcValuation)
+}
+
+// MockAveragePriceServiceAPI is a mock of AveragePriceServiceAPI interface.
+type MockAveragePriceServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockAveragePriceServiceAPIMockRecorder
+}
+
+// MockAveragePriceServiceAPIMockRecorder is the mock recorder for MockAveragePriceServiceAPI.
+type MockAveragePriceServiceAPIMockRecorder struct {
+	mock *MockAveragePriceServiceAPI
+}
+
+// NewMockAveragePriceServiceAPI creates a new mock instance.
+func NewMockAveragePriceServiceAPI(ctrl *gomock.Controller) *MockAveragePriceServiceAPI {
+	mock := &MockAveragePriceServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockAveragePriceServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAveragePriceServiceAPI) EXPECT() *MockAveragePriceServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockAveragePriceServiceAPI) Do(ctx context.Context, opts ...binance.RequestOption) (*binance.AvgPrice, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*binance.AvgPrice)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockAveragePriceServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockAveragePriceServiceAPI)(nil).Do), varargs...)
+}
+
+// Symbol mocks base method.
+func (m *MockAveragePriceServiceAPI) Symbol(symbol string) binance.AveragePriceServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Symbol", symbol)
+	ret0, _ := ret[0].(binance.AveragePriceServiceAPI)
+	return ret0
+}
+
+// Symbol indicates an expected call of Symbol.
+func (mr *MockAveragePriceServiceAPIMockRecorder) Symbol(symbol interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Symbol", reflect.TypeOf((*MockAveragePriceServiceAPI)(nil).Symbol), symbol)
+}
+
+// MockMarginTransferServiceAPI is a mock of MarginTransferServiceAPI interface.
+type MockMarginTransferServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockMarginTransferServiceAPIMockRecorder
+}
+
+// MockMarginTransferServiceAPIMockRecorder is the mock recorder for MockMarginTransferServiceAPI.
+type MockMarginTransferServiceAPIMockRecorder struct {
+	mock *MockMarginTransferServiceAPI
+}
+
+// NewMockMarginTransferServiceAPI creates a new mock instance.
+func NewMockMarginTransferServiceAPI(ctrl *gomock.Controller) *MockMarginTransferServiceAPI {
+	mock := &MockMarginTransferServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockMarginTransferServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockMarginTransferServiceAPI) EXPECT() *MockMarginTransferServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Amount mocks base method.
+func (m *MockMarginTransferServiceAPI) Amount(amount string) binance.MarginTransferServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Amount", amount)
+	ret0, _ := ret[0].(binance.MarginTransferServiceAPI)
+	return ret0
+}
+
+// Amount indicates an expected call of Amount.
+func (mr *MockMarginTransferServiceAPIMockRecorder) Amount(amount interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Amount", reflect.TypeOf((*MockMarginTransferServiceAPI)(nil).Amount), amount)
+}
+
+// Asset mocks base method.
+func (m *MockMarginTransferServiceAPI) Asset(asset string) binance.MarginTransferServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Asset", asset)
+	ret0, _ := ret[0].(binance.MarginTransferServiceAPI)
+	return ret0
+}
+
+// Asset indicates an expected call of Asset.
+func (mr *MockMarginTransferServiceAPIMockRecorder) Asset(asset interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Asset", reflect.TypeOf((*MockMarginTransferServiceAPI)(nil).Asset), asset)
+}
+
+// Do mocks base method.
+func (m *MockMarginTransferServiceAPI) Do(ctx context.Context, opts ...binance.RequestOption) (*binance.TransactionResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*binance.TransactionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockMarginTransferServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockMarginTransferServiceAPI)(nil).Do), varargs...)
+}
+
+// Type mocks base method.
+func (m *MockMarginTransferServiceAPI) Type(transferType binance.MarginTransferType) binance.MarginTransferServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Type", transferType)
+	ret0, _ := ret[0].(binance.MarginTransferServiceAPI)
+	return ret0
+}
+
+// Type indicates an expected call of Type.
+func (mr *MockMarginTransferServiceAPIMockRecorder) Type(transferType interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Type", reflect.TypeOf((*MockMarginTransferServiceAPI)(nil).Type), transferType)
+}
+
+// MockMarginLoanServiceAPI is a mock of MarginLoanServiceAPI interface.
+type MockMarginLoanServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockMarginLoanServiceAPIMockRecorder
+}
+
+// MockMarginLoanServiceAPIMockRecorder is the mock recorder for MockMarginLoanServiceAPI.
+type MockMarginLoanServiceAPIMockRecorder struct {
+	mock *MockMarginLoanServiceAPI
+}
+
+// NewMockMarginLoanServiceAPI creates a new mock instance.
+func NewMockMarginLoanServiceAPI(ctrl *gomock.Controller) *MockMarginLoanServiceAPI {
+	mock := &MockMarginLoanServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockMarginLoanServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockMarginLoanServiceAPI) EXPECT() *MockMarginLoanServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Amount mocks base method.
+func (m *MockMarginLoanServiceAPI) Amount(amount string) binance.MarginLoanServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Amount", amount)
+	ret0, _ := ret[0].(binance.MarginLoanServiceAPI)
+	return ret0
+}
+
+// Amount indicates an expected call of Amount.
+func (mr *MockMarginLoanServiceAPIMockRecorder) Amount(amount interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Amount", reflect.TypeOf((*MockMarginLoanServiceAPI)(nil).Amount), amount)
+}
+
+// Asset mocks base method.
+func (m *MockMarginLoanServiceAPI) Asset(asset string) binance.MarginLoanServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Asset", asset)
+	ret0, _ := ret[0].(binance.MarginLoanServiceAPI)
+	return ret0
+}
+
+// Asset indicates an expected call of Asset.
+func (mr *MockMarginLoanServiceAPIMockRecorder) Asset(asset interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Asset", reflect.TypeOf((*MockMarginLoanServiceAPI)(nil).Asset), asset)
+}
+
+// Do mocks base method.
+func (m *MockMarginLoanServiceAPI) Do(ctx context.Context, opts ...binance.RequestOption) (*binance.TransactionResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*binance.TransactionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockMarginLoanServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockMarginLoanServiceAPI)(nil).Do), varargs...)
+}
+
+// IsIsolated mocks base method.
+func (m *MockMarginLoanServiceAPI) IsIsolated(isIsolated bool) binance.MarginLoanServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsIsolated", isIsolated)
+	ret0, _ := ret[0].(binance.MarginLoanServiceAPI)
+	return ret0
+}
+
+// IsIsolated indicates an expected call of IsIsolated.
+func (mr *MockMarginLoanServiceAPIMockRecorder) IsIsolated(isIsolated interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsIsolated", reflect.TypeOf((*MockMarginLoanServiceAPI)(nil).IsIsolated), isIsolated)
+}
+
+// Symbol mocks base method.
+func (m *MockMarginLoanServiceAPI) Symbol(symbol string) binance.MarginLoanServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Symbol", symbol)
+	ret0, _ := ret[0].(binance.MarginLoanServiceAPI)
+	return ret0
+}
+
+// Symbol indicates an expected call of Symbol.
+func (mr *MockMarginLoanServiceAPIMockRecorder) Symbol(symbol interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Symbol", reflect.TypeOf((*MockMarginLoanServiceAPI)(nil).Symbol), symbol)
+}
+
+// MockMarginRepayServiceAPI is a mock of MarginRepayServiceAPI interface.
+type MockMarginRepayServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockMarginRepayServiceAPIMockRecorder
+}
+
+// MockMarginRepayServiceAPIMockRecorder is the mock recorder for MockMarginRepayServiceAPI.
+type MockMarginRepayServiceAPIMockRecorder struct {
+	mock *MockMarginRepayServiceAPI
+}
+
+// NewMockMarginRepayServiceAPI creates a new mock instance.
+func NewMockMarginRepayServiceAPI(ctrl *gomock.Controller) *MockMarginRepayServiceAPI {
+	mock := &MockMarginRepayServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockMarginRepayServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockMarginRepayServiceAPI) EXPECT() *MockMarginRepayServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Amount mocks base method.
+func (m *MockMarginRepayServiceAPI) Amount(amount string) binance.MarginRepayServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Amount", amount)
+	ret0, _ := ret[0].(binance.MarginRepayServiceAPI)
+	return ret0
+}
+
+// Amount indicates an expected call of Amount.
+func (mr *MockMarginRepayServiceAPIMockRecorder) Amount(amount interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Amount", reflect.TypeOf((*MockMarginRepayServiceAPI)(nil).Amount), amount)
+}
+
+// Asset mocks base method.
+func (m *MockMarginRepayServiceAPI) Asset(asset string) binance.MarginRepayServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Asset", asset)
+	ret0, _ := ret[0].(binance.MarginRepayServiceAPI)
+	return ret0
+}
+
+// Asset indicates an expected call of Asset.
+func (mr *MockMarginRepayServiceAPIMockRecorder) Asset(asset interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Asset", reflect.TypeOf((*MockMarginRepayServiceAPI)(nil).Asset), asset)
+}
+
+// Do mocks base method.
+func (m *MockMarginRepayServiceAPI) Do(ctx context.Context, opts ...binance.RequestOption) (*binance.TransactionResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*binance.TransactionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockMarginRepayServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockMarginRepayServiceAPI)(nil).Do), varargs...)
+}
+
+// IsIsolated mocks base method.
+func (m *MockMarginRepayServiceAPI) IsIsolated(isIsolated bool) binance.MarginRepayServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsIsolated", isIsolated)
+	ret0, _ := ret[0].(binance.MarginRepayServiceAPI)
+	return ret0
+}
+
+// IsIsolated indicates an expected call of IsIsolated.
+func (mr *MockMarginRepayServiceAPIMockRecorder) IsIsolated(isIsolated interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsIsolated", reflect.TypeOf((*MockMarginRepayServiceAPI)(nil).IsIsolated), isIsolated)
+}
+
+// Symbol mocks base method.
+func (m *MockMarginRepayServiceAPI) Symbol(symbol string) binance.MarginRepayServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Symbol", symbol)
+	ret0, _ := ret[0].(binance.MarginRepayServiceAPI)
+	return ret0
+}
+
+// Symbol indicates an expected call of Symbol.
+func (mr *MockMarginRepayServiceAPIMockRecorder) Symbol(symbol interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Symbol", reflect.TypeOf((*MockMarginRepayServiceAPI)(nil).Symbol), symbol)
+}
+
+// MockMarginBorrowRepayServiceAPI is a mock of MarginBorrowRepayServiceAPI interface.
+type MockMarginBorrowRepayServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockMarginBorrowRepayServiceAPIMockRecorder
+}
+
+// MockMarginBorrowRepayServiceAPIMockRecorder is the mock recorder for MockMarginBorrowRepayServiceAPI.
+type MockMarginBorrowRepayServiceAPIMockRecorder struct {
+	mock *MockMarginBorrowRepayServiceAPI
+}
+
+// NewMockMarginBorrowRepayServiceAPI creates a new mock instance.
+func NewMockMarginBorrowRepayServiceAPI(ctrl *gomock.Controller) *MockMarginBorrowRepayServiceAPI {
+	mock := &MockMarginBorrowRepayServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockMarginBorrowRepayServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockMarginBorrowRepayServiceAPI) EXPECT() *MockMarginBorrowRepayServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Amount mocks base method.
+func (m *MockMarginBorrowRepayServiceAPI) Amount(amount string) binance.MarginBorrowRepayServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Amount", amount)
+	ret0, _ := ret[0].(binance.MarginBorrowRepayServiceAPI)
+	return ret0
+}
+
+// Amount indicates an expected call of Amount.
+func (mr *MockMarginBorrowRepayServiceAPIMockRecorder) Amount(amount interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Amount", reflect.TypeOf((*MockMarginBorrowRepayServiceAPI)(nil).Amount), amount)
+}
+
+// Asset mocks base method.
+func (m *MockMarginBorrowRepayServiceAPI) Asset(asset string) binance.MarginBorrowRepayServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Asset", asset)
+	ret0, _ := ret[0].(binance.MarginBorrowRepayServiceAPI)
+	return ret0
+}
+
+// Asset indicates an expected call of Asset.
+func (mr *MockMarginBorrowRepayServiceAPIMockRecorder) Asset(asset interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Asset", reflect.TypeOf((*MockMarginBorrowRepayServiceAPI)(nil).Asset), asset)
+}
+
+// Do mocks base method.
+func (m *MockMarginBorrowRepayServiceAPI) Do(ctx context.Context, opts ...binance.RequestOption) (*binance.TransactionResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*binance.TransactionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockMarginBorrowRepayServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockMarginBorrowRepayServiceAPI)(nil).Do), varargs...)
+}
+
+// IsIsolated mocks base method.
+func (m *MockMarginBorrowRepayServiceAPI) IsIsolated(isIsolated bool) binance.MarginBorrowRepayServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsIsolated", isIsolated)
+	ret0, _ := ret[0].(binance.MarginBorrowRepayServiceAPI)
+	return ret0
+}
+
+// IsIsolated indicates an expected call of IsIsolated.
+func (mr *MockMarginBorrowRepayServiceAPIMockRecorder) IsIsolated(isIsolated interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsIsolated", reflect.TypeOf((*MockMarginBorrowRepayServiceAPI)(nil).IsIsolated), isIsolated)
+}
+
+// Symbol mocks base method.
+func (m *MockMarginBorrowRepayServiceAPI) Symbol(symbol string) binance.MarginBorrowRepayServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Symbol", symbol)
+	ret0, _ := ret[0].(binance.MarginBorrowRepayServiceAPI)
+	return ret0
+}
+
+// Symbol indicates an expected call of Symbol.
+func (mr *MockMarginBorrowRepayServiceAPIMockRecorder) Symbol(symbol interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Symbol", reflect.TypeOf((*MockMarginBorrowRepayServiceAPI)(nil).Symbol), symbol)
+}
+
+// Type mocks base method.
+func (m *MockMarginBorrowRepayServiceAPI) Type(marginBorrowRepayType binance.MarginAccountBorrowRepayType) binance.MarginBorrowRepayServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Type", marginBorrowRepayType)
+	ret0, _ := ret[0].(binance.MarginBorrowRepayServiceAPI)
+	return ret0
+}
+
+// Type indicates an expected call of Type.
+func (mr *MockMarginBorrowRepayServiceAPIMockRecorder) Type(marginBorrowRepayType interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Type", reflect.TypeOf((*MockMarginBorrowRepayServiceAPI)(nil).Type), marginBorrowRepayType)
+}
+
+// MockListMarginBorrowRepayServiceAPI is a mock of ListMarginBorrowRepayServiceAPI interface.
+type MockListMarginBorrowRepayServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockListMarginBorrowRepayServiceAPIMockRecorder
+}
+
+// MockListMarginBorrowRepayServiceAPIMockRecorder is the mock recorder for MockListMarginBorrowRepayServiceAPI.
+type MockListMarginBorrowRepayServiceAPIMockRecorder struct {
+	mock *MockListMarginBorrowRepayServiceAPI
+}
+
+// NewMockListMarginBorrowRepayServiceAPI creates a new mock instance.
+func NewMockListMarginBorrowRepayServiceAPI(ctrl *gomock.Controller) *MockListMarginBorrowRepayServiceAPI {
+	mock := &MockListMarginBorrowRepayServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockListMarginBorrowRepayServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockListMarginBorrowRepayServiceAPI) EXPECT() *MockListMarginBorrowRepayServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Asset mocks base method.
+func (m *MockListMarginBorrowRepayServiceAPI) Asset(asset string) binance.ListMarginBorrowRepayServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Asset", asset)
+	ret0, _ := ret[0].(binance.ListMarginBorrowRepayServiceAPI)
+	return ret0
+}
+
+// Asset indicates an expected call of Asset.
+func (mr *MockListMarginBorrowRepayServiceAPIMockRecorder) Asset(asset interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Asset", reflect.TypeOf((*MockListMarginBorrowRepayServiceAPI)(nil).Asset), asset)
+}
+
+// Current mocks base method.
+func (m *MockListMarginBorrowRepayServiceAPI) Current(current int64) binance.ListMarginBorrowRepayServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Current", current)
+	ret0, _ := ret[0].(binance.ListMarginBorrowRepayServiceAPI)
+	return ret0
+}
+
+// Current indicates an expected call of Current.
+func (mr *MockListMarginBorrowRepayServiceAPIMockRecorder) Current(current interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Current", reflect.TypeOf((*MockListMarginBorrowRepayServiceAPI)(nil).Current), current)
+}
+
+// Do mocks base method.
+func (m *MockListMarginBorrowRepayServiceAPI) Do(ctx context.Context, opts ...binance.RequestOption) (*binance.MarginBorrowRepayResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*binance.MarginBorrowRepayResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockListMarginBorrowRepayServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockListMarginBorrowRepayServiceAPI)(nil).Do), varargs...)
+}
+
+// EndTime mocks base method.
+func (m *MockListMarginBorrowRepayServiceAPI) EndTime(endTime int64) binance.ListMarginBorrowRepayServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EndTime", endTime)
+	ret0, _ := ret[0].(binance.ListMarginBorrowRepayServiceAPI)
+	return ret0
+}
+
+// EndTime indicates an expected call of EndTime.
+func (mr *MockListMarginBorrowRepayServiceAPIMockRecorder) EndTime(endTime interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EndTime", reflect.TypeOf((*MockListMarginBorrowRepayServiceAPI)(nil).EndTime), endTime)
+}
+
+// IsolatedSymbol mocks base method.
+func (m *MockListMarginBorrowRepayServiceAPI) IsolatedSymbol(isolatedSymbol string) binance.ListMarginBorrowRepayServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsolatedSymbol", isolatedSymbol)
+	ret0, _ := ret[0].(binance.ListMarginBorrowRepayServiceAPI)
+	return ret0
+}
+
+// IsolatedSymbol indicates an expected call of IsolatedSymbol.
+func (mr *MockListMarginBorrowRepayServiceAPIMockRecorder) IsolatedSymbol(isolatedSymbol interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsolatedSymbol", reflect.TypeOf((*MockListMarginBorrowRepayServiceAPI)(nil).IsolatedSymbol), isolatedSymbol)
+}
+
+// Size mocks base method.
+func (m *MockListMarginBorrowRepayServiceAPI) Size(size int64) binance.ListMarginBorrowRepayServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Size", size)
+	ret0, _ := ret[0].(binance.ListMarginBorrowRepayServiceAPI)
+	return ret0
+}
+
+// Size indicates an expected call of Size.
+func (mr *MockListMarginBorrowRepayServiceAPIMockRecorder) Size(size interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Size", reflect.TypeOf((*MockListMarginBorrowRepayServiceAPI)(nil).Size), size)
+}
+
+// StartTime mocks base method.
+func (m *MockListMarginBorrowRepayServiceAPI) StartTime(startTime int64) binance.ListMarginBorrowRepayServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartTime", startTime)
+	ret0, _ := ret[0].(binance.ListMarginBorrowRepayServiceAPI)
+	return ret0
+}
+
+// StartTime indicates an expected call of StartTime.
+func (mr *MockListMarginBorrowRepayServiceAPIMockRecorder) StartTime(startTime interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartTime", reflect.TypeOf((*MockListMarginBorrowRepayServiceAPI)(nil).StartTime), startTime)
+}
+
+// TxId mocks base method.
+func (m *MockListMarginBorrowRepayServiceAPI) TxId(txId int64) binance.ListMarginBorrowRepayServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TxId", txId)
+	ret0, _ := ret[0].(binance.ListMarginBorrowRepayServiceAPI)
+	return ret0
+}
+
+// TxId indicates an expected call of TxId.
+func (mr *MockListMarginBorrowRepayServiceAPIMockRecorder) TxId(txId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TxId", reflect.TypeOf((*MockListMarginBorrowRepayServiceAPI)(nil).TxId), txId)
+}
+
+// Type mocks base method.
+func (m *MockListMarginBorrowRepayServiceAPI) Type(marginBorrowRepayType binance.MarginAccountBorrowRepayType) binance.ListMarginBorrowRepayServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Type", marginBorrowRepayType)
+	ret0, _ := ret[0].(binance.ListMarginBorrowRepayServiceAPI)
+	return ret0
+}
+
+// Type indicates an expected call of Type.
+func (mr *MockListMarginBorrowRepayServiceAPIMockRecorder) Type(marginBorrowRepayType interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Type", reflect.TypeOf((*MockListMarginBorrowRepayServiceAPI)(nil).Type), marginBorrowRepayType)
+}
+
+// MockCreateMarginOrderServiceAPI is a mock of CreateMarginOrderServiceAPI interface.
+type MockCreateMarginOrderServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockCreateMarginOrderServiceAPIMockRecorder
+}
+
+// MockCreateMarginOrderServiceAPIMockRecorder is the mock recorder for MockCreateMarginOrderServiceAPI.
+type MockCreateMarginOrderServiceAPIMockRecorder struct {
+	mock *MockCreateMarginOrderServiceAPI
+}
+
+// NewMockCreateMarginOrderServiceAPI creates a new mock instance.
+func NewMockCreateMarginOrderServiceAPI(ctrl *gomock.Controller) *MockCreateMarginOrderServiceAPI {
+	mock := &MockCreateMarginOrderServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockCreateMarginOrderServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCreateMarginOrderServiceAPI) EXPECT() *MockCreateMarginOrderServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// AutoRepayAtCancel mocks base method.
+func (m *MockCreateMarginOrderServiceAPI) AutoRepayAtCancel(autoRepayAtCancel bool) binance.CreateMarginOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AutoRepayAtCancel", autoRepayAtCancel)
+	ret0, _ := ret[0].(binance.CreateMarginOrderServiceAPI)
+	return ret0
+}
+
+// AutoRepayAtCancel indicates an expected call of AutoRepayAtCancel.
+func (mr *MockCreateMarginOrderServiceAPIMockRecorder) AutoRepayAtCancel(autoRepayAtCancel interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AutoRepayAtCancel", reflect.TypeOf((*MockCreateMarginOrderServiceAPI)(nil).AutoRepayAtCancel), autoRepayAtCancel)
+}
+
+// Do mocks base method.
+func (m *MockCreateMarginOrderServiceAPI) Do(ctx context.Context, opts ...binance.RequestOption) (*binance.CreateOrderResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*binance.CreateOrderResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockCreateMarginOrderServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockCreateMarginOrderServiceAPI)(nil).Do), varargs...)
+}
+
+// IcebergQuantity mocks base method.
+func (m *MockCreateMarginOrderServiceAPI) IcebergQuantity(icebergQuantity string) binance.CreateMarginOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IcebergQuantity", icebergQuantity)
+	ret0, _ := ret[0].(binance.CreateMarginOrderServiceAPI)
+	return ret0
+}
+
+// IcebergQuantity indicates an expected call of IcebergQuantity.
+func (mr *MockCreateMarginOrderServiceAPIMockRecorder) IcebergQuantity(icebergQuantity interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IcebergQuantity", reflect.TypeOf((*MockCreateMarginOrderServiceAPI)(nil).IcebergQuantity), icebergQuantity)
+}
+
+// IsIsolated mocks base method.
+func (m *MockCreateMarginOrderServiceAPI) IsIsolated(isIsolated bool) binance.CreateMarginOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsIsolated", isIsolated)
+	ret0, _ := ret[0].(binance.CreateMarginOrderServiceAPI)
+	return ret0
+}
+
+// IsIsolated indicates an expected call of IsIsolated.
+func (mr *MockCreateMarginOrderServiceAPIMockRecorder) IsIsolated(isIsolated interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsIsolated", reflect.TypeOf((*MockCreateMarginOrderServiceAPI)(nil).IsIsolated), isIsolated)
+}
+
+// NewClientOrderID mocks base method.
+func (m *MockCreateMarginOrderServiceAPI) NewClientOrderID(newClientOrderID string) binance.CreateMarginOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewClientOrderID", newClientOrderID)
+	ret0, _ := ret[0].(binance.CreateMarginOrderServiceAPI)
+	return ret0
+}
+
+// NewClientOrderID indicates an expected call of NewClientOrderID.
+func (mr *MockCreateMarginOrderServiceAPIMockRecorder) NewClientOrderID(newClientOrderID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewClientOrderID", reflect.TypeOf((*MockCreateMarginOrderServiceAPI)(nil).NewClientOrderID), newClientOrderID)
+}
+
+// NewOrderRespType mocks base method.
+func (m *MockCreateMarginOrderServiceAPI) NewOrderRespType(newOrderRespType binance.NewOrderRespType) binance.CreateMarginOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewOrderRespType", newOrderRespType)
+	ret0, _ := ret[0].(binance.CreateMarginOrderServiceAPI)
+	return ret0
+}
+
+// NewOrderRespType indicates an expected call of NewOrderRespType.
+func (mr *MockCreateMarginOrderServiceAPIMockRecorder) NewOrderRespType(newOrderRespType interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewOrderRespType", reflect.TypeOf((*MockCreateMarginOrderServiceAPI)(nil).NewOrderRespType), newOrderRespType)
+}
+
+// Price mocks base method.
+func (m *MockCreateMarginOrderServiceAPI) Price(price string) binance.CreateMarginOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Price", price)
+	ret0, _ := ret[0].(binance.CreateMarginOrderServiceAPI)
+	return ret0
+}
+
+// Price indicates an expected call of Price.
+func (mr *MockCreateMarginOrderServiceAPIMockRecorder) Price(price interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Price", reflect.TypeOf((*MockCreateMarginOrderServiceAPI)(nil).Price), price)
+}
+
+// Quantity mocks base method.
+func (m *MockCreateMarginOrderServiceAPI) Quantity(quantity string) binance.CreateMarginOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Quantity", quantity)
+	ret0, _ := ret[0].(binance.CreateMarginOrderServiceAPI)
+	return ret0
+}
+
+// Quantity indicates an expected call of Quantity.
+func (mr *MockCreateMarginOrderServiceAPIMockRecorder) Quantity(quantity interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Quantity", reflect.TypeOf((*MockCreateMarginOrderServiceAPI)(nil).Quantity), quantity)
+}
+
+// QuoteOrderQty mocks base method.
+func (m *MockCreateMarginOrderServiceAPI) QuoteOrderQty(quoteOrderQty string) binance.CreateMarginOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "QuoteOrderQty", quoteOrderQty)
+	ret0, _ := ret[0].(binance.CreateMarginOrderServiceAPI)
+	return ret0
+}
+
+// QuoteOrderQty indicates an expected call of QuoteOrderQty.
+func (mr *MockCreateMarginOrderServiceAPIMockRecorder) QuoteOrderQty(quoteOrderQty interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QuoteOrderQty", reflect.TypeOf((*MockCreateMarginOrderServiceAPI)(nil).QuoteOrderQty), quoteOrderQty)
+}
+
+// SelfTradePreventionMode mocks base method.
+func (m *MockCreateMarginOrderServiceAPI) SelfTradePreventionMode(selfTradePreventionMode binance.SelfTradePreventionMode) binance.CreateMarginOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SelfTradePreventionMode", selfTradePreventionMode)
+	ret0, _ := ret[0].(binance.CreateMarginOrderServiceAPI)
+	return ret0
+}
+
+// SelfTradePreventionMode indicates an expected call of SelfTradePreventionMode.
+func (mr *MockCreateMarginOrderServiceAPIMockRecorder) SelfTradePreventionMode(selfTradePreventionMode interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelfTradePreventionMode", reflect.TypeOf((*MockCreateMarginOrderServiceAPI)(nil).SelfTradePreventionMode), selfTradePreventionMode)
+}
+
+// Side mocks base method.
+func (m *MockCreateMarginOrderServiceAPI) Side(side binance.SideType) binance.CreateMarginOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Side", side)
+	ret0, _ := ret[0].(binance.CreateMarginOrderServiceAPI)
+	return ret0
+}
+
+// Side indicates an expected call of Side.
+func (mr *MockCreateMarginOrderServiceAPIMockRecorder) Side(side interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Side", reflect.TypeOf((*MockCreateMarginOrderServiceAPI)(nil).Side), side)
+}
+
+// SideEffectType mocks base method.
+func (m *MockCreateMarginOrderServiceAPI) SideEffectType(sideEffectType binance.SideEffectType) binance.CreateMarginOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SideEffectType", sideEffectType)
+	ret0, _ := ret[0].(binance.CreateMarginOrderServiceAPI)
+	return ret0
+}
+
+// SideEffectType indicates an expected call of SideEffectType.
+func (mr *MockCreateMarginOrderServiceAPIMockRecorder) SideEffectType(sideEffectType interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SideEffectType", reflect.TypeOf((*MockCreateMarginOrderServiceAPI)(nil).SideEffectType), sideEffectType)
+}
+
+// StopPrice mocks base method.
+func (m *MockCreateMarginOrderServiceAPI) StopPrice(stopPrice string) binance.CreateMarginOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StopPrice", stopPrice)
+	ret0, _ := ret[0].(binance.CreateMarginOrderServiceAPI)
+	return ret0
+}
+
+// StopPrice indicates an expected call of StopPrice.
+func (mr *MockCreateMarginOrderServiceAPIMockRecorder) StopPrice(stopPrice interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StopPrice", reflect.TypeOf((*MockCreateMarginOrderServiceAPI)(nil).StopPrice), stopPrice)
+}
+
+// Symbol mocks base method.
+func (m *MockCreateMarginOrderServiceAPI) Symbol(symbol string) binance.CreateMarginOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Symbol", symbol)
+	ret0, _ := ret[0].(binance.CreateMarginOrderServiceAPI)
+	return ret0
+}
+
+// Symbol indicates an expected call of Symbol.
+func (mr *MockCreateMarginOrderServiceAPIMockRecorder) Symbol(symbol interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Symbol", reflect.TypeOf((*MockCreateMarginOrderServiceAPI)(nil).Symbol), symbol)
+}
+
+// TimeInForce mocks base method.
+func (m *MockCreateMarginOrderServiceAPI) TimeInForce(timeInForce binance.TimeInForceType) binance.CreateMarginOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TimeInForce", timeInForce)
+	ret0, _ := ret[0].(binance.CreateMarginOrderServiceAPI)
+	return ret0
+}
+
+// TimeInForce indicates an expected call of TimeInForce.
+func (mr *MockCreateMarginOrderServiceAPIMockRecorder) TimeInForce(timeInForce interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TimeInForce", reflect.TypeOf((*MockCreateMarginOrderServiceAPI)(nil).TimeInForce), timeInForce)
+}
+
+// Type mocks base method.
+func (m *MockCreateMarginOrderServiceAPI) Type(orderType binance.OrderType) binance.CreateMarginOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Type", orderType)
+	ret0, _ := ret[0].(binance.CreateMarginOrderServiceAPI)
+	return ret0
+}
+
+// Type indicates an expected call of Type.
+func (mr *MockCreateMarginOrderServiceAPIMockRecorder) Type(orderType interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Type", reflect.TypeOf((*MockCreateMarginOrderServiceAPI)(nil).Type), orderType)
+}
+
+// MockCancelMarginOrderServiceAPI is a mock of CancelMarginOrderServiceAPI interface.
+type MockCancelMarginOrderServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockCancelMarginOrderServiceAPIMockRecorder
+}
+
+// MockCancelMarginOrderServiceAPIMockRecorder is the mock recorder for MockCancelMarginOrderServiceAPI.
+type MockCancelMarginOrderServiceAPIMockRecorder struct {
+	mock *MockCancelMarginOrderServiceAPI
+}
+
+// NewMockCancelMarginOrderServiceAPI creates a new mock instance.
+func NewMockCancelMarginOrderServiceAPI(ctrl *gomock.Controller) *MockCancelMarginOrderServiceAPI {
+	mock := &MockCancelMarginOrderServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockCancelMarginOrderServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCancelMarginOrderServiceAPI) EXPECT() *MockCancelMarginOrderServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockCancelMarginOrderServiceAPI) Do(ctx context.Context, opts ...binance.RequestOption) (*binance.CancelMarginOrderResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*binance.CancelMarginOrderResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockCancelMarginOrderServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockCancelMarginOrderServiceAPI)(nil).Do), varargs...)
+}
+
+// IsIsolated mocks base method.
+func (m *MockCancelMarginOrderServiceAPI) IsIsolated(isIsolated bool) binance.CancelMarginOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsIsolated", isIsolated)
+	ret0, _ := ret[0].(binance.CancelMarginOrderServiceAPI)
+	return ret0
+}
+
+// IsIsolated indicates an expected call of IsIsolated.
+func (mr *MockCancelMarginOrderServiceAPIMockRecorder) IsIsolated(isIsolated interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsIsolated", reflect.TypeOf((*MockCancelMarginOrderServiceAPI)(nil).IsIsolated), isIsolated)
+}
+
+// NewClientOrderID mocks base method.
+func (m *MockCancelMarginOrderServiceAPI) NewClientOrderID(newClientOrderID string) binance.CancelMarginOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewClientOrderID", newClientOrderID)
+	ret0, _ := ret[0].(binance.CancelMarginOrderServiceAPI)
+	return ret0
+}
+
+// NewClientOrderID indicates an expected call of NewClientOrderID.
+func (mr *MockCancelMarginOrderServiceAPIMockRecorder) NewClientOrderID(newClientOrderID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewClientOrderID", reflect.TypeOf((*MockCancelMarginOrderServiceAPI)(nil).NewClientOrderID), newClientOrderID)
+}
+
+// OrderID mocks base method.
+func (m *MockCancelMarginOrderServiceAPI) OrderID(orderID int64) binance.CancelMarginOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OrderID", orderID)
+	ret0, _ := ret[0].(binance.CancelMarginOrderServiceAPI)
+	return ret0
+}
+
+// OrderID indicates an expected call of OrderID.
+func (mr *MockCancelMarginOrderServiceAPIMockRecorder) OrderID(orderID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OrderID", reflect.TypeOf((*MockCancelMarginOrderServiceAPI)(nil).OrderID), orderID)
+}
+
+// OrigClientOrderID mocks base method.
+func (m *MockCancelMarginOrderServiceAPI) OrigClientOrderID(origClientOrderID string) binance.CancelMarginOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OrigClientOrderID", origClientOrderID)
+	ret0, _ := ret[0].(binance.CancelMarginOrderServiceAPI)
+	return ret0
+}
+
+// OrigClientOrderID indicates an expected call of OrigClientOrderID.
+func (mr *MockCancelMarginOrderServiceAPIMockRecorder) OrigClientOrderID(origClientOrderID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OrigClientOrderID", reflect.TypeOf((*MockCancelMarginOrderServiceAPI)(nil).OrigClientOrderID), origClientOrderID)
+}
+
+// Symbol mocks base method.
+func (m *MockCancelMarginOrderServiceAPI) Symbol(symbol string) binance.CancelMarginOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Symbol", symbol)
+	ret0, _ := ret[0].(binance.CancelMarginOrderServiceAPI)
+	return ret0
+}
+
+// Symbol indicates an expected call of Symbol.
+func (mr *MockCancelMarginOrderServiceAPIMockRecorder) Symbol(symbol interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Symbol", reflect.TypeOf((*MockCancelMarginOrderServiceAPI)(nil).Symbol), symbol)
+}
+
+// MockCancelAllMarginOrdersServiceAPI is a mock of CancelAllMarginOrdersServiceAPI interface.
+type MockCancelAllMarginOrdersServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockCancelAllMarginOrdersServiceAPIMockRecorder
+}
+
+// MockCancelAllMarginOrdersServiceAPIMockRecorder is the mock recorder for MockCancelAllMarginOrdersServiceAPI.
+type MockCancelAllMarginOrdersServiceAPIMockRecorder struct {
+	mock *MockCancelAllMarginOrdersServiceAPI
+}
+
+// NewMockCancelAllMarginOrdersServiceAPI creates a new mock instance.
+func NewMockCancelAllMarginOrdersServiceAPI(ctrl *gomock.Controller) *MockCancelAllMarginOrdersServiceAPI {
+	mock := &MockCancelAllMarginOrdersServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockCancelAllMarginOrdersServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCancelAllMarginOrdersServiceAPI) EXPECT() *MockCancelAllMarginOrdersServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockCancelAllMarginOrdersServiceAPI) Do(ctx context.Context, opts ...binance.RequestOption) ([]*binance.CancelAllMarginOrdersResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].([]*binance.CancelAllMarginOrdersResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockCancelAllMarginOrdersServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockCancelAllMarginOrdersServiceAPI)(nil).Do), varargs...)
+}
+
+// IsIsolated mocks base method.
+func (m *MockCancelAllMarginOrdersServiceAPI) IsIsolated(isIsolated bool) binance.CancelAllMarginOrdersServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsIsolated", isIsolated)
+	ret0, _ := ret[0].(binance.CancelAllMarginOrdersServiceAPI)
+	return ret0
+}
+
+// IsIsolated indicates an expected call of IsIsolated.
+func (mr *MockCancelAllMarginOrdersServiceAPIMockRecorder) IsIsolated(isIsolated interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsIsolated", reflect.TypeOf((*MockCancelAllMarginOrdersServiceAPI)(nil).IsIsolated), isIsolated)
+}
+
+// Symbol mocks base method.
+func (m *MockCancelAllMarginOrdersServiceAPI) Symbol(symbol string) binance.CancelAllMarginOrdersServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Symbol", symbol)
+	ret0, _ := ret[0].(binance.CancelAllMarginOrdersServiceAPI)
+	return ret0
+}
+
+// Symbol indicates an expected call of Symbol.
+func (mr *MockCancelAllMarginOrdersServiceAPIMockRecorder) Symbol(symbol interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Symbol", reflect.TypeOf((*MockCancelAllMarginOrdersServiceAPI)(nil).Symbol), symbol)
+}
+
+// MockCreateMarginOCOServiceAPI is a mock of CreateMarginOCOServiceAPI interface.
+type MockCreateMarginOCOServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockCreateMarginOCOServiceAPIMockRecorder
+}
+
+// MockCreateMarginOCOServiceAPIMockRecorder is the mock recorder for MockCreateMarginOCOServiceAPI.
+type MockCreateMarginOCOServiceAPIMockRecorder struct {
+	mock *MockCreateMarginOCOServiceAPI
+}
+
+// NewMockCreateMarginOCOServiceAPI creates a new mock instance.
+func NewMockCreateMarginOCOServiceAPI(ctrl *gomock.Controller) *MockCreateMarginOCOServiceAPI {
+	mock := &MockCreateMarginOCOServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockCreateMarginOCOServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCreateMarginOCOServiceAPI) EXPECT() *MockCreateMarginOCOServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockCreateMarginOCOServiceAPI) Do(ctx context.Context, opts ...binance.RequestOption) (*binance.CreateMarginOCOResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*binance.CreateMarginOCOResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockCreateMarginOCOServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockCreateMarginOCOServiceAPI)(nil).Do), varargs...)
+}
+
+// IsIsolated mocks base method.
+func (m *MockCreateMarginOCOServiceAPI) IsIsolated(isIsolated bool) binance.CreateMarginOCOServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsIsolated", isIsolated)
+	ret0, _ := ret[0].(binance.CreateMarginOCOServiceAPI)
+	return ret0
+}
+
+// IsIsolated indicates an expected call of IsIsolated.
+func (mr *MockCreateMarginOCOServiceAPIMockRecorder) IsIsolated(isIsolated interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsIsolated", reflect.TypeOf((*MockCreateMarginOCOServiceAPI)(nil).IsIsolated), isIsolated)
+}
+
+// LimitClientOrderID mocks base method.
+func (m *MockCreateMarginOCOServiceAPI) LimitClientOrderID(limitClientOrderID string) binance.CreateMarginOCOServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LimitClientOrderID", limitClientOrderID)
+	ret0, _ := ret[0].(binance.CreateMarginOCOServiceAPI)
+	return ret0
+}
+
+// LimitClientOrderID indicates an expected call of LimitClientOrderID.
+func (mr *MockCreateMarginOCOServiceAPIMockRecorder) LimitClientOrderID(limitClientOrderID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LimitClientOrderID", reflect.TypeOf((*MockCreateMarginOCOServiceAPI)(nil).LimitClientOrderID), limitClientOrderID)
+}
+
+// LimitIcebergQuantity mocks base method.
+func (m *MockCreateMarginOCOServiceAPI) LimitIcebergQuantity(limitIcebergQty string) binance.CreateMarginOCOServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LimitIcebergQuantity", limitIcebergQty)
+	ret0, _ := ret[0].(binance.CreateMarginOCOServiceAPI)
+	return ret0
+}
+
+// LimitIcebergQuantity indicates an expected call of LimitIcebergQuantity.
+func (mr *MockCreateMarginOCOServiceAPIMockRecorder) LimitIcebergQuantity(limitIcebergQty interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LimitIcebergQuantity", reflect.TypeOf((*MockCreateMarginOCOServiceAPI)(nil).LimitIcebergQuantity), limitIcebergQty)
+}
+
+// ListClientOrderID mocks base method.
+func (m *MockCreateMarginOCOServiceAPI) ListClientOrderID(listClientOrderID string) binance.CreateMarginOCOServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListClientOrderID", listClientOrderID)
+	ret0, _ := ret[0].(binance.CreateMarginOCOServiceAPI)
+	return ret0
+}
+
+// ListClientOrderID indicates an expected call of ListClientOrderID.
+func (mr *MockCreateMarginOCOServiceAPIMockRecorder) ListClientOrderID(listClientOrderID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListClientOrderID", reflect.TypeOf((*MockCreateMarginOCOServiceAPI)(nil).ListClientOrderID), listClientOrderID)
+}
+
+// NewOrderRespType mocks base method.
+func (m *MockCreateMarginOCOServiceAPI) NewOrderRespType(newOrderRespType binance.NewOrderRespType) binance.CreateMarginOCOServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewOrderRespType", newOrderRespType)
+	ret0, _ := ret[0].(binance.CreateMarginOCOServiceAPI)
+	return ret0
+}
+
+// NewOrderRespType indicates an expected call of NewOrderRespType.
+func (mr *MockCreateMarginOCOServiceAPIMockRecorder) NewOrderRespType(newOrderRespType interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewOrderRespType", reflect.TypeOf((*MockCreateMarginOCOServiceAPI)(nil).NewOrderRespType), newOrderRespType)
+}
+
+// Price mocks base method.
+func (m *MockCreateMarginOCOServiceAPI) Price(price string) binance.CreateMarginOCOServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Price", price)
+	ret0, _ := ret[0].(binance.CreateMarginOCOServiceAPI)
+	return ret0
+}
+
+// Price indicates an expected call of Price.
+func (mr *MockCreateMarginOCOServiceAPIMockRecorder) Price(price interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Price", reflect.TypeOf((*MockCreateMarginOCOServiceAPI)(nil).Price), price)
+}
+
+// Quantity mocks base method.
+func (m *MockCreateMarginOCOServiceAPI) Quantity(quantity string) binance.CreateMarginOCOServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Quantity", quantity)
+	ret0, _ := ret[0].(binance.CreateMarginOCOServiceAPI)
+	return ret0
+}
+
+// Quantity indicates an expected call of Quantity.
+func (mr *MockCreateMarginOCOServiceAPIMockRecorder) Quantity(quantity interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Quantity", reflect.TypeOf((*MockCreateMarginOCOServiceAPI)(nil).Quantity), quantity)
+}
+
+// Side mocks base method.
+func (m *MockCreateMarginOCOServiceAPI) Side(side binance.SideType) binance.CreateMarginOCOServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Side", side)
+	ret0, _ := ret[0].(binance.CreateMarginOCOServiceAPI)
+	return ret0
+}
+
+// Side indicates an expected call of Side.
+func (mr *MockCreateMarginOCOServiceAPIMockRecorder) Side(side interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Side", reflect.TypeOf((*MockCreateMarginOCOServiceAPI)(nil).Side), side)
+}
+
+// SideEffectType mocks base method.
+func (m *MockCreateMarginOCOServiceAPI) SideEffectType(sideEffectType binance.SideEffectType) binance.CreateMarginOCOServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SideEffectType", sideEffectType)
+	ret0, _ := ret[0].(binance.CreateMarginOCOServiceAPI)
+	return ret0
+}
+
+// SideEffectType indicates an expected call of SideEffectType.
+func (mr *MockCreateMarginOCOServiceAPIMockRecorder) SideEffectType(sideEffectType interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SideEffectType", reflect.TypeOf((*MockCreateMarginOCOServiceAPI)(nil).SideEffectType), sideEffectType)
+}
+
+// StopClientOrderID mocks base method.
+func (m *MockCreateMarginOCOServiceAPI) StopClientOrderID(stopClientOrderID string) binance.CreateMarginOCOServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StopClientOrderID", stopClientOrderID)
+	ret0, _ := ret[0].(binance.CreateMarginOCOServiceAPI)
+	return ret0
+}
+
+// StopClientOrderID indicates an expected call of StopClientOrderID.
+func (mr *MockCreateMarginOCOServiceAPIMockRecorder) StopClientOrderID(stopClientOrderID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StopClientOrderID", reflect.TypeOf((*MockCreateMarginOCOServiceAPI)(nil).StopClientOrderID), stopClientOrderID)
+}
+
+// StopIcebergQty mocks base method.
+func (m *MockCreateMarginOCOServiceAPI) StopIcebergQty(stopIcebergQty string) binance.CreateMarginOCOServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StopIcebergQty", stopIcebergQty)
+	ret0, _ := ret[0].(binance.CreateMarginOCOServiceAPI)
+	return ret0
+}
+
+// StopIcebergQty indicates an expected call of StopIcebergQty.
+func (mr *MockCreateMarginOCOServiceAPIMockRecorder) StopIcebergQty(stopIcebergQty interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StopIcebergQty", reflect.TypeOf((*MockCreateMarginOCOServiceAPI)(nil).StopIcebergQty), stopIcebergQty)
+}
+
+// StopLimitPrice mocks base method.
+func (m *MockCreateMarginOCOServiceAPI) StopLimitPrice(stopLimitPrice string) binance.CreateMarginOCOServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StopLimitPrice", stopLimitPrice)
+	ret0, _ := ret[0].(binance.CreateMarginOCOServiceAPI)
+	return ret0
+}
+
+// StopLimitPrice indicates an expected call of StopLimitPrice.
+func (mr *MockCreateMarginOCOServiceAPIMockRecorder) StopLimitPrice(stopLimitPrice interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StopLimitPrice", reflect.TypeOf((*MockCreateMarginOCOServiceAPI)(nil).StopLimitPrice), stopLimitPrice)
+}
+
+// StopLimitTimeInForce mocks base method.
+func (m *MockCreateMarginOCOServiceAPI) StopLimitTimeInForce(stopLimitTimeInForce binance.TimeInForceType) binance.CreateMarginOCOServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StopLimitTimeInForce", stopLimitTimeInForce)
+	ret0, _ := ret[0].(binance.CreateMarginOCOServiceAPI)
+	return ret0
+}
+
+// StopLimitTimeInForce indicates an expected call of StopLimitTimeInForce.
+func (mr *MockCreateMarginOCOServiceAPIMockRecorder) StopLimitTimeInForce(stopLimitTimeInForce interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StopLimitTimeInForce", reflect.TypeOf((*MockCreateMarginOCOServiceAPI)(nil).StopLimitTimeInForce), stopLimitTimeInForce)
+}
+
+// StopPrice mocks base method.
+func (m *MockCreateMarginOCOServiceAPI) StopPrice(stopPrice string) binance.CreateMarginOCOServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StopPrice", stopPrice)
+	ret0, _ := ret[0].(binance.CreateMarginOCOServiceAPI)
+	return ret0
+}
+
+// StopPrice indicates an expected call of StopPrice.
+func (mr *MockCreateMarginOCOServiceAPIMockRecorder) StopPrice(stopPrice interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StopPrice", reflect.TypeOf((*MockCreateMarginOCOServiceAPI)(nil).StopPrice), stopPrice)
+}
+
+// Symbol mocks base method.
+func (m *MockCreateMarginOCOServiceAPI) Symbol(symbol string) binance.CreateMarginOCOServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Symbol", symbol)
+	ret0, _ := ret[0].(binance.CreateMarginOCOServiceAPI)
+	return ret0
+}
+
+// Symbol indicates an expected call of Symbol.
+func (mr *MockCreateMarginOCOServiceAPIMockRecorder) Symbol(symbol interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Symbol", reflect.TypeOf((*MockCreateMarginOCOServiceAPI)(nil).Symbol), symbol)
+}
+
+// MockCancelMarginOCOServiceAPI is a mock of CancelMarginOCOServiceAPI interface.
+type MockCancelMarginOCOServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockCancelMarginOCOServiceAPIMockRecorder
+}
+
+// MockCancelMarginOCOServiceAPIMockRecorder is the mock recorder for MockCancelMarginOCOServiceAPI.
+type MockCancelMarginOCOServiceAPIMockRecorder struct {
+	mock *MockCancelMarginOCOServiceAPI
+}
+
+// NewMockCancelMarginOCOServiceAPI creates a new mock instance.
+func NewMockCancelMarginOCOServiceAPI(ctrl *gomock.Controller) *MockCancelMarginOCOServiceAPI {
+	mock := &MockCancelMarginOCOServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockCancelMarginOCOServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCancelMarginOCOServiceAPI) EXPECT() *MockCancelMarginOCOServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockCancelMarginOCOServiceAPI) Do(ctx context.Context, opts ...binance.RequestOption) (*binance.CancelMarginOCOResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*binance.CancelMarginOCOResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockCancelMarginOCOServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockCancelMarginOCOServiceAPI)(nil).Do), varargs...)
+}
+
+// IsIsolated mocks base method.
+func (m *MockCancelMarginOCOServiceAPI) IsIsolated(isIsolated bool) binance.CancelMarginOCOServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsIsolated", isIsolated)
+	ret0, _ := ret[0].(binance.CancelMarginOCOServiceAPI)
+	return ret0
+}
+
+// IsIsolated indicates an expected call of IsIsolated.
+func (mr *MockCancelMarginOCOServiceAPIMockRecorder) IsIsolated(isIsolated interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsIsolated", reflect.TypeOf((*MockCancelMarginOCOServiceAPI)(nil).IsIsolated), isIsolated)
+}
+
+// ListClientOrderID mocks base method.
+func (m *MockCancelMarginOCOServiceAPI) ListClientOrderID(listClientOrderID string) binance.CancelMarginOCOServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListClientOrderID", listClientOrderID)
+	ret0, _ := ret[0].(binance.CancelMarginOCOServiceAPI)
+	return ret0
+}
+
+// ListClientOrderID indicates an expected call of ListClientOrderID.
+func (mr *MockCancelMarginOCOServiceAPIMockRecorder) ListClientOrderID(listClientOrderID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListClientOrderID", reflect.TypeOf((*MockCancelMarginOCOServiceAPI)(nil).ListClientOrderID), listClientOrderID)
+}
+
+// NewClientOrderID mocks base method.
+func (m *MockCancelMarginOCOServiceAPI) NewClientOrderID(newClientOrderID string) binance.CancelMarginOCOServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewClientOrderID", newClientOrderID)
+	ret0, _ := ret[0].(binance.CancelMarginOCOServiceAPI)
+	return ret0
+}
+
+// NewClientOrderID indicates an expected call of NewClientOrderID.
+func (mr *MockCancelMarginOCOServiceAPIMockRecorder) NewClientOrderID(newClientOrderID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewClientOrderID", reflect.TypeOf((*MockCancelMarginOCOServiceAPI)(nil).NewClientOrderID), newClientOrderID)
+}
+
+// OrderListID mocks base method.
+func (m *MockCancelMarginOCOServiceAPI) OrderListID(orderListID int64) binance.CancelMarginOCOServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OrderListID", orderListID)
+	ret0, _ := ret[0].(binance.CancelMarginOCOServiceAPI)
+	return ret0
+}
+
+// OrderListID indicates an expected call of OrderListID.
+func (mr *MockCancelMarginOCOServiceAPIMockRecorder) OrderListID(orderListID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OrderListID", reflect.TypeOf((*MockCancelMarginOCOServiceAPI)(nil).OrderListID), orderListID)
+}
+
+// Symbol mocks base method.
+func (m *MockCancelMarginOCOServiceAPI) Symbol(symbol string) binance.CancelMarginOCOServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Symbol", symbol)
+	ret0, _ := ret[0].(binance.CancelMarginOCOServiceAPI)
+	return ret0
+}
+
+// Symbol indicates an expected call of Symbol.
+func (mr *MockCancelMarginOCOServiceAPIMockRecorder) Symbol(symbol interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Symbol", reflect.TypeOf((*MockCancelMarginOCOServiceAPI)(nil).Symbol), symbol)
+}
+
+// MockGetMarginOrderServiceAPI is a mock of GetMarginOrderServiceAPI interface.
+type MockGetMarginOrderServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockGetMarginOrderServiceAPIMockRecorder
+}
+
+// MockGetMarginOrderServiceAPIMockRecorder is the mock recorder for MockGetMarginOrderServiceAPI.
+type MockGetMarginOrderServiceAPIMockRecorder struct {
+	mock *MockGetMarginOrderServiceAPI
+}
+
+// NewMockGetMarginOrderServiceAPI creates a new mock instance.
+func NewMockGetMarginOrderServiceAPI(ctrl *gomock.Controller) *MockGetMarginOrderServiceAPI {
+	mock := &MockGetMarginOrderServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockGetMarginOrderServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGetMarginOrderServiceAPI) EXPECT() *MockGetMarginOrderServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockGetMarginOrderServiceAPI) Do(ctx context.Context, opts ...binance.RequestOption) (*binance.Order, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*binance.Order)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockGetMarginOrderServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockGetMarginOrderServiceAPI)(nil).Do), varargs...)
+}
+
+// IsIsolated mocks base method.
+func (m *MockGetMarginOrderServiceAPI) IsIsolated(isIsolated bool) binance.GetMarginOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsIsolated", isIsolated)
+	ret0, _ := ret[0].(binance.GetMarginOrderServiceAPI)
+	return ret0
+}
+
+// IsIsolated indicates an expected call of IsIsolated.
+func (mr *MockGetMarginOrderServiceAPIMockRecorder) IsIsolated(isIsolated interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsIsolated", reflect.TypeOf((*MockGetMarginOrderServiceAPI)(nil).IsIsolated), isIsolated)
+}
+
+// OrderID mocks base method.
+func (m *MockGetMarginOrderServiceAPI) OrderID(orderID int64) binance.GetMarginOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OrderID", orderID)
+	ret0, _ := ret[0].(binance.GetMarginOrderServiceAPI)
+	return ret0
+}
+
+// OrderID indicates an expected call of OrderID.
+func (mr *MockGetMarginOrderServiceAPIMockRecorder) OrderID(orderID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OrderID", reflect.TypeOf((*MockGetMarginOrderServiceAPI)(nil).OrderID), orderID)
+}
+
+// OrigClientOrderID mocks base method.
+func (m *MockGetMarginOrderServiceAPI) OrigClientOrderID(origClientOrderID string) binance.GetMarginOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OrigClientOrderID", origClientOrderID)
+	ret0, _ := ret[0].(binance.GetMarginOrderServiceAPI)
+	return ret0
+}
+
+// OrigClientOrderID indicates an expected call of OrigClientOrderID.
+func (mr *MockGetMarginOrderServiceAPIMockRecorder) OrigClientOrderID(origClientOrderID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OrigClientOrderID", reflect.TypeOf((*MockGetMarginOrderServiceAPI)(nil).OrigClientOrderID), origClientOrderID)
+}
+
+// Symbol mocks base method.
+func (m *MockGetMarginOrderServiceAPI) Symbol(symbol string) binance.GetMarginOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Symbol", symbol)
+	ret0, _ := ret[0].(binance.GetMarginOrderServiceAPI)
+	return ret0
+}
+
+// Symbol indicates an expected call of Symbol.
+func (mr *MockGetMarginOrderServiceAPIMockRecorder) Symbol(symbol interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Symbol", reflect.TypeOf((*MockGetMarginOrderServiceAPI)(nil).Symbol), symbol)
+}
+
+// MockListMarginLoansServiceAPI is a mock of ListMarginLoansServiceAPI interface.
+type MockListMarginLoansServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockListMarginLoansServiceAPIMockRecorder
+}
+
+// MockListMarginLoansServiceAPIMockRecorder is the mock recorder for MockListMarginLoansServiceAPI.
+type MockListMarginLoansServiceAPIMockRecorder struct {
+	mock *MockListMarginLoansServiceAPI
+}
+
+// NewMockListMarginLoansServiceAPI creates a new mock instance.
+func NewMockListMarginLoansServiceAPI(ctrl *gomock.Controller) *MockListMarginLoansServiceAPI {
+	mock := &MockListMarginLoansServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockListMarginLoansServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockListMarginLoansServiceAPI) EXPECT() *MockListMarginLoansServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Asset mocks base method.
+func (m *MockListMarginLoansServiceAPI) Asset(asset string) binance.ListMarginLoansServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Asset", asset)
+	ret0, _ := ret[0].(binance.ListMarginLoansServiceAPI)
+	return ret0
+}
+
+// Asset indicates an expected call of Asset.
+func (mr *MockListMarginLoansServiceAPIMockRecorder) Asset(asset interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Asset", reflect.TypeOf((*MockListMarginLoansServiceAPI)(nil).Asset), asset)
+}
+
+// Current mocks base method.
+func (m *MockListMarginLoansServiceAPI) Current(current int64) binance.ListMarginLoansServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Current", current)
+	ret0, _ := ret[0].(binance.ListMarginLoansServiceAPI)
+	return ret0
+}
+
+// Current indicates an expected call of Current.
+func (mr *MockListMarginLoansServiceAPIMockRecorder) Current(current interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Current", reflect.TypeOf((*MockListMarginLoansServiceAPI)(nil).Current), current)
+}
+
+// Do mocks base method.
+func (m *MockListMarginLoansServiceAPI) Do(ctx context.Context, opts ...binance.RequestOption) (*binance.MarginLoanResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*binance.MarginLoanResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockListMarginLoansServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockListMarginLoansServiceAPI)(nil).Do), varargs...)
+}
+
+// EndTime mocks base method.
+func (m *MockListMarginLoansServiceAPI) EndTime(endTime int64) binance.ListMarginLoansServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EndTime", endTime)
+	ret0, _ := ret[0].(binance.ListMarginLoansServiceAPI)
+	return ret0
+}
+
+// EndTime indicates an expected call of EndTime.
+func (mr *MockListMarginLoansServiceAPIMockRecorder) EndTime(endTime interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EndTime", reflect.TypeOf((*MockListMarginLoansServiceAPI)(nil).EndTime), endTime)
+}
+
+// Size mocks base method.
+func (m *MockListMarginLoansServiceAPI) Size(size int64) binance.ListMarginLoansServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Size", size)
+	ret0, _ := ret[0].(binance.ListMarginLoansServiceAPI)
+	return ret0
+}
+
+// Size indicates an expected call of Size.
+func (mr *MockListMarginLoansServiceAPIMockRecorder) Size(size interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Size", reflect.TypeOf((*MockListMarginLoansServiceAPI)(nil).Size), size)
+}
+
+// StartTime mocks base method.
+func (m *MockListMarginLoansServiceAPI) StartTime(startTime int64) binance.ListMarginLoansServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartTime", startTime)
+	ret0, _ := ret[0].(binance.ListMarginLoansServiceAPI)
+	return ret0
+}
+
+// StartTime indicates an expected call of StartTime.
+func (mr *MockListMarginLoansServiceAPIMockRecorder) StartTime(startTime interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartTime", reflect.TypeOf((*MockListMarginLoansServiceAPI)(nil).StartTime), startTime)
+}
+
+// TxID mocks base method.
+func (m *MockListMarginLoansServiceAPI) TxID(txID int64) binance.ListMarginLoansServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TxID", txID)
+	ret0, _ := ret[0].(binance.ListMarginLoansServiceAPI)
+	return ret0
+}
+
+// TxID indicates an expected call of TxID.
+func (mr *MockListMarginLoansServiceAPIMockRecorder) TxID(txID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TxID", reflect.TypeOf((*MockListMarginLoansServiceAPI)(nil).TxID), txID)
+}
+
+// MockListMarginRepaysServiceAPI is a mock of ListMarginRepaysServiceAPI interface.
+type MockListMarginRepaysServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockListMarginRepaysServiceAPIMockRecorder
+}
+
+// MockListMarginRepaysServiceAPIMockRecorder is the mock recorder for MockListMarginRepaysServiceAPI.
+type MockListMarginRepaysServiceAPIMockRecorder struct {
+	mock *MockListMarginRepaysServiceAPI
+}
+
+// NewMockListMarginRepaysServiceAPI creates a new mock instance.
+func NewMockListMarginRepaysServiceAPI(ctrl *gomock.Controller) *MockListMarginRepaysServiceAPI {
+	mock := &MockListMarginRepaysServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockListMarginRepaysServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockListMarginRepaysServiceAPI) EXPECT() *MockListMarginRepaysServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Asset mocks base method.
+func (m *MockListMarginRepaysServiceAPI) Asset(asset string) binance.ListMarginRepaysServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Asset", asset)
+	ret0, _ := ret[0].(binance.ListMarginRepaysServiceAPI)
+	return ret0
+}
+
+// Asset indicates an expected call of Asset.
+func (mr *MockListMarginRepaysServiceAPIMockRecorder) Asset(asset interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Asset", reflect.TypeOf((*MockListMarginRepaysServiceAPI)(nil).Asset), asset)
+}
+
+// Current mocks base method.
+func (m *MockListMarginRepaysServiceAPI) Current(current int64) binance.ListMarginRepaysServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Current", current)
+	ret0, _ := ret[0].(binance.ListMarginRepaysServiceAPI)
+	return ret0
+}
+
+// Current indicates an expected call of Current.
+func (mr *MockListMarginRepaysServiceAPIMockRecorder) Current(current interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Current", reflect.TypeOf((*MockListMarginRepaysServiceAPI)(nil).Current), current)
+}
+
+// Do mocks base method.
+func (m *MockListMarginRepaysServiceAPI) Do(ctx context.Context, opts ...binance.RequestOption) (*binance.MarginRepayResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*binance.MarginRepayResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockListMarginRepaysServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockListMarginRepaysServiceAPI)(nil).Do), varargs...)
+}
+
+// EndTime mocks base method.
+func (m *MockListMarginRepaysServiceAPI) EndTime(endTime int64) binance.ListMarginRepaysServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EndTime", endTime)
+	ret0, _ := ret[0].(binance.ListMarginRepaysServiceAPI)
+	return ret0
+}
+
+// EndTime indicates an expected call of EndTime.
+func (mr *MockListMarginRepaysServiceAPIMockRecorder) EndTime(endTime interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EndTime", reflect.TypeOf((*MockListMarginRepaysServiceAPI)(nil).EndTime), endTime)
+}
+
+// Size mocks base method.
+func (m *MockListMarginRepaysServiceAPI) Size(size int64) binance.ListMarginRepaysServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Size", size)
+	ret0, _ := ret[0].(binance.ListMarginRepaysServiceAPI)
+	return ret0
+}
+
+// Size indicates an expected call of Size.
+func (mr *MockListMarginRepaysServiceAPIMockRecorder) Size(size interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Size", reflect.TypeOf((*MockListMarginRepaysServiceAPI)(nil).Size), size)
+}
+
+// StartTime mocks base method.
+func (m *MockListMarginRepaysServiceAPI) StartTime(startTime int64) binance.ListMarginRepaysServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartTime", startTime)
+	ret0, _ := ret[0].(binance.ListMarginRepaysServiceAPI)
+	return ret0
+}
+
+// StartTime indicates an expected call of StartTime.
+func (mr *MockListMarginRepaysServiceAPIMockRecorder) StartTime(startTime interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartTime", reflect.TypeOf((*MockListMarginRepaysServiceAPI)(nil).StartTime), startTime)
+}
+
+// TxID mocks base method.
+func (m *MockListMarginRepaysServiceAPI) TxID(txID int64) binance.ListMarginRepaysServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TxID", txID)
+	ret0, _ := ret[0].(binance.ListMarginRepaysServiceAPI)
+	return ret0
+}
+
+// TxID indicates an expected call of TxID.
+func (mr *MockListMarginRepaysServiceAPIMockRecorder) TxID(txID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TxID", reflect.TypeOf((*MockListMarginRepaysServiceAPI)(nil).TxID), txID)
+}
+
+// MockGetMarginAccountServiceAPI is a mock of GetMarginAccountServiceAPI interface.
+type MockGetMarginAccountServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockGetMarginAccountServiceAPIMockRecorder
+}
+
+// MockGetMarginAccountServiceAPIMockRecorder is the mock recorder for MockGetMarginAccountServiceAPI.
+type MockGetMarginAccountServiceAPIMockRecorder struct {
+	mock *MockGetMarginAccountServiceAPI
+}
+
+// NewMockGetMarginAccountServiceAPI creates a new mock instance.
+func NewMockGetMarginAccountServiceAPI(ctrl *gomock.Controller) *MockGetMarginAccountServiceAPI {
+	mock := &MockGetMarginAccountServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockGetMarginAccountServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGetMarginAccountServiceAPI) EXPECT() *MockGetMarginAccountServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockGetMarginAccountServiceAPI) Do(ctx context.Context, opts ...binance.RequestOption) (*binance.MarginAccount, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*binance.MarginAccount)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockGetMarginAccountServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockGetMarginAccountServiceAPI)(nil).Do), varargs...)
+}
+
+// MockGetIsolatedMarginAccountServiceAPI is a mock of GetIsolatedMarginAccountServiceAPI interface.
+type MockGetIsolatedMarginAccountServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockGetIsolatedMarginAccountServiceAPIMockRecorder
+}
+
+// MockGetIsolatedMarginAccountServiceAPIMockRecorder is the mock recorder for MockGetIsolatedMarginAccountServiceAPI.
+type MockGetIsolatedMarginAccountServiceAPIMockRecorder struct {
+	mock *MockGetIsolatedMarginAccountServiceAPI
+}
+
+// NewMockGetIsolatedMarginAccountServiceAPI creates a new mock instance.
+func NewMockGetIsolatedMarginAccountServiceAPI(ctrl *gomock.Controller) *MockGetIsolatedMarginAccountServiceAPI {
+	mock := &MockGetIsolatedMarginAccountServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockGetIsolatedMarginAccountServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGetIsolatedMarginAccountServiceAPI) EXPECT() *MockGetIsolatedMarginAccountServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockGetIsolatedMarginAccountServiceAPI) Do(ctx context.Context, opts ...binance.RequestOption) (*binance.IsolatedMarginAccount, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*binance.IsolatedMarginAccount)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockGetIsolatedMarginAccountServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockGetIsolatedMarginAccountServiceAPI)(nil).Do), varargs...)
+}
+
+// Symbols mocks base method.
+func (m *MockGetIsolatedMarginAccountServiceAPI) Symbols(symbols ...string) binance.GetIsolatedMarginAccountServiceAPI {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{}
+	for _, a := range symbols {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Symbols", varargs...)
+	ret0, _ := ret[0].(binance.GetIsolatedMarginAccountServiceAPI)
+	return ret0
+}
+
+// Symbols indicates an expected call of Symbols.
+func (mr *MockGetIsolatedMarginAccountServiceAPIMockRecorder) Symbols(symbols ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Symbols", reflect.TypeOf((*MockGetIsolatedMarginAccountServiceAPI)(nil).Symbols), symbols...)
+}
+
+// MockIsolatedMarginTransferServiceAPI is a mock of IsolatedMarginTransferServiceAPI interface.
+type MockIsolatedMarginTransferServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockIsolatedMarginTransferServiceAPIMockRecorder
+}
+
+// MockIsolatedMarginTransferServiceAPIMockRecorder is the mock recorder for MockIsolatedMarginTransferServiceAPI.
+type MockIsolatedMarginTransferServiceAPIMockRecorder struct {
+	mock *MockIsolatedMarginTransferServiceAPI
+}
+
+// NewMockIsolatedMarginTransferServiceAPI creates a new mock instance.
+func NewMockIsolatedMarginTransferServiceAPI(ctrl *gomock.Controller) *MockIsolatedMarginTransferServiceAPI {
+	mock := &MockIsolatedMarginTransferServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockIsolatedMarginTransferServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIsolatedMarginTransferServiceAPI) EXPECT() *MockIsolatedMarginTransferServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Amount mocks base method.
+func (m *MockIsolatedMarginTransferServiceAPI) Amount(amount string) binance.IsolatedMarginTransferServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Amount", amount)
+	ret0, _ := ret[0].(binance.IsolatedMarginTransferServiceAPI)
+	return ret0
+}
+
+// Amount indicates an expected call of Amount.
+func (mr *MockIsolatedMarginTransferServiceAPIMockRecorder) Amount(amount interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Amount", reflect.TypeOf((*MockIsolatedMarginTransferServiceAPI)(nil).Amount), amount)
+}
+
+// Asset mocks base method.
+func (m *MockIsolatedMarginTransferServiceAPI) Asset(asset string) binance.IsolatedMarginTransferServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Asset", asset)
+	ret0, _ := ret[0].(binance.IsolatedMarginTransferServiceAPI)
+	return ret0
+}
+
+// Asset indicates an expected call of Asset.
+func (mr *MockIsolatedMarginTransferServiceAPIMockRecorder) Asset(asset interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Asset", reflect.TypeOf((*MockIsolatedMarginTransferServiceAPI)(nil).Asset), asset)
+}
+
+// Do mocks base method.
+func (m *MockIsolatedMarginTransferServiceAPI) Do(ctx context.Context, opts ...binance.RequestOption) (*binance.TransactionResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*binance.TransactionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockIsolatedMarginTransferServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockIsolatedMarginTransferServiceAPI)(nil).Do), varargs...)
+}
+
+// Symbol mocks base method.
+func (m *MockIsolatedMarginTransferServiceAPI) Symbol(symbol string) binance.IsolatedMarginTransferServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Symbol", symbol)
+	ret0, _ := ret[0].(binance.IsolatedMarginTransferServiceAPI)
+	return ret0
+}
+
+// Symbol indicates an expected call of Symbol.
+func (mr *MockIsolatedMarginTransferServiceAPIMockRecorder) Symbol(symbol interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Symbol", reflect.TypeOf((*MockIsolatedMarginTransferServiceAPI)(nil).Symbol), symbol)
+}
+
+// TransFrom mocks base method.
+func (m *MockIsolatedMarginTransferServiceAPI) TransFrom(transFrom binance.AccountType) binance.IsolatedMarginTransferServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TransFrom", transFrom)
+	ret0, _ := ret[0].(binance.IsolatedMarginTransferServiceAPI)
+	return ret0
+}
+
+// TransFrom indicates an expected call of TransFrom.
+func (mr *MockIsolatedMarginTransferServiceAPIMockRecorder) TransFrom(transFrom interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TransFrom", reflect.TypeOf((*MockIsolatedMarginTransferServiceAPI)(nil).TransFrom), transFrom)
+}
+
+// TransTo mocks base method.
+func (m *MockIsolatedMarginTransferServiceAPI) TransTo(transTo binance.AccountType) binance.IsolatedMarginTransferServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TransTo", transTo)
+	ret0, _ := ret[0].(binance.IsolatedMarginTransferServiceAPI)
+	return ret0
+}
+
+// TransTo indicates an expected call of TransTo.
+func (mr *MockIsolatedMarginTransferServiceAPIMockRecorder) TransTo(transTo interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TransTo", reflect.TypeOf((*MockIsolatedMarginTransferServiceAPI)(nil).TransTo), transTo)
+}
+
+// MockGetMarginAssetServiceAPI is a mock of GetMarginAssetServiceAPI interface.
+type MockGetMarginAssetServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockGetMarginAssetServiceAPIMockRecorder
+}
+
+// MockGetMarginAssetServiceAPIMockRecorder is the mock recorder for MockGetMarginAssetServiceAPI.
+type MockGetMarginAssetServiceAPIMockRecorder struct {
+	mock *MockGetMarginAssetServiceAPI
+}
+
+// NewMockGetMarginAssetServiceAPI creates a new mock instance.
+func NewMockGetMarginAssetServiceAPI(ctrl *gomock.Controller) *MockGetMarginAssetServiceAPI {
+	mock := &MockGetMarginAssetServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockGetMarginAssetServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGetMarginAssetServiceAPI) EXPECT() *MockGetMarginAssetServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Asset mocks base method.
+func (m *MockGetMarginAssetServiceAPI) Asset(asset string) binance.GetMarginAssetServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Asset", asset)
+	ret0, _ := ret[0].(binance.GetMarginAssetServiceAPI)
+	return ret0
+}
+
+// Asset indicates an expected call of Asset.
+func (mr *MockGetMarginAssetServiceAPIMockRecorder) Asset(asset interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Asset", reflect.TypeOf((*MockGetMarginAssetServiceAPI)(nil).Asset), asset)
+}
+
+// Do mocks base method.
+func (m *MockGetMarginAssetServiceAPI) Do(ctx context.Context, opts ...binance.RequestOption) (*binance.MarginAsset, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*binance.MarginAsset)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockGetMarginAssetServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockGetMarginAssetServiceAPI)(nil).Do), varargs...)
+}
+
+// MockGetMarginPairServiceAPI is a mock of GetMarginPairServiceAPI interface.
+type MockGetMarginPairServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockGetMarginPairServiceAPIMockRecorder
+}
+
+// MockGetMarginPairServiceAPIMockRecorder is the mock recorder for MockGetMarginPairServiceAPI.
+type MockGetMarginPairServiceAPIMockRecorder struct {
+	mock *MockGetMarginPairServiceAPI
+}
+
+// NewMockGetMarginPairServiceAPI creates a new mock instance.
+func NewMockGetMarginPairServiceAPI(ctrl *gomock.Controller) *MockGetMarginPairServiceAPI {
+	mock := &MockGetMarginPairServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockGetMarginPairServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGetMarginPairServiceAPI) EXPECT() *MockGetMarginPairServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockGetMarginPairServiceAPI) Do(ctx context.Context, opts ...binance.RequestOption) (*binance.MarginPair, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*binance.MarginPair)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockGetMarginPairServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockGetMarginPairServiceAPI)(nil).Do), varargs...)
+}
+
+// Symbol mocks base method.
+func (m *MockGetMarginPairServiceAPI) Symbol(symbol string) binance.GetMarginPairServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Symbol", symbol)
+	ret0, _ := ret[0].(binance.GetMarginPairServiceAPI)
+	return ret0
+}
+
+// Symbol indicates an expected call of Symbol.
+func (mr *MockGetMarginPairServiceAPIMockRecorder) Symbol(symbol interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Symbol", reflect.TypeOf((*MockGetMarginPairServiceAPI)(nil).Symbol), symbol)
+}
+
+// MockGetMarginAllPairsServiceAPI is a mock of GetMarginAllPairsServiceAPI interface.
+type MockGetMarginAllPairsServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockGetMarginAllPairsServiceAPIMockRecorder
+}
+
+// MockGetMarginAllPairsServiceAPIMockRecorder is the mock recorder for MockGetMarginAllPairsServiceAPI.
+type MockGetMarginAllPairsServiceAPIMockRecorder struct {
+	mock *MockGetMarginAllPairsServiceAPI
+}
+
+// NewMockGetMarginAllPairsServiceAPI creates a new mock instance.
+func NewMockGetMarginAllPairsServiceAPI(ctrl *gomock.Controller) *MockGetMarginAllPairsServiceAPI {
+	mock := &MockGetMarginAllPairsServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockGetMarginAllPairsServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGetMarginAllPairsServiceAPI) EXPECT() *MockGetMarginAllPairsServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockGetMarginAllPairsServiceAPI) Do(ctx context.Context, opts ...binance.RequestOption) ([]*binance.MarginAllPair, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].([]*binance.MarginAllPair)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockGetMarginAllPairsServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockGetMarginAllPairsServiceAPI)(nil).Do), varargs...)
+}
+
+// MockGetMarginPriceIndexServiceAPI is a mock of GetMarginPriceIndexServiceAPI interface.
+type MockGetMarginPriceIndexServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockGetMarginPriceIndexServiceAPIMockRecorder
+}
+
+// MockGetMarginPriceIndexServiceAPIMockRecorder is the mock recorder for MockGetMarginPriceIndexServiceAPI.
+type MockGetMarginPriceIndexServiceAPIMockRecorder struct {
+	mock *MockGetMarginPriceIndexServiceAPI
+}
+
+// NewMockGetMarginPriceIndexServiceAPI creates a new mock instance.
+func NewMockGetMarginPriceIndexServiceAPI(ctrl *gomock.Controller) *MockGetMarginPriceIndexServiceAPI {
+	mock := &MockGetMarginPriceIndexServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockGetMarginPriceIndexServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGetMarginPriceIndexServiceAPI) EXPECT() *MockGetMarginPriceIndexServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockGetMarginPriceIndexServiceAPI) Do(ctx context.Context, opts ...binance.RequestOption) (*binance.MarginPriceIndex, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*binance.MarginPriceIndex)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockGetMarginPriceIndexServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockGetMarginPriceIndexServiceAPI)(nil).Do), varargs...)
+}
+
+// Symbol mocks base method.
+func (m *MockGetMarginPriceIndexServiceAPI) Symbol(symbol string) binance.GetMarginPriceIndexServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Symbol", symbol)
+	ret0, _ := ret[0].(binance.GetMarginPriceIndexServiceAPI)
+	return ret0
+}
+
+// Symbol indicates an expected call of Symbol.
+func (mr *MockGetMarginPriceIndexServiceAPIMockRecorder) Symbol(symbol interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Symbol", reflect.TypeOf((*MockGetMarginPriceIndexServiceAPI)(nil).Symbol), symbol)
+}
+
+// MockListMarginOpenOrdersServiceAPI is a mock of ListMarginOpenOrdersServiceAPI interface.
+type MockListMarginOpenOrdersServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockListMarginOpenOrdersServiceAPIMockRecorder
+}
+
+// MockListMarginOpenOrdersServiceAPIMockRecorder is the mock recorder for MockListMarginOpenOrdersServiceAPI.
+type MockListMarginOpenOrdersServiceAPIMockRecorder struct {
+	mock *MockListMarginOpenOrdersServiceAPI
+}
+
+// NewMockListMarginOpenOrdersServiceAPI creates a new mock instance.
+func NewMockListMarginOpenOrdersServiceAPI(ctrl *gomock.Controller) *MockListMarginOpenOrdersServiceAPI {
+	mock := &MockListMarginOpenOrdersServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockListMarginOpenOrdersServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockListMarginOpenOrdersServiceAPI) EXPECT() *MockListMarginOpenOrdersServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockListMarginOpenOrdersServiceAPI) Do(ctx context.Context, opts ...binance.RequestOption) ([]*binance.Order, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].([]*binance.Order)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockListMarginOpenOrdersServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockListMarginOpenOrdersServiceAPI)(nil).Do), varargs...)
+}
+
+// IsIsolated mocks base method.
+func (m *MockListMarginOpenOrdersServiceAPI) IsIsolated(isIsolated bool) binance.ListMarginOpenOrdersServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsIsolated", isIsolated)
+	ret0, _ := ret[0].(binance.ListMarginOpenOrdersServiceAPI)
+	return ret0
+}
+
+// IsIsolated indicates an expected call of IsIsolated.
+func (mr *MockListMarginOpenOrdersServiceAPIMockRecorder) IsIsolated(isIsolated interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsIsolated", reflect.TypeOf((*MockListMarginOpenOrdersServiceAPI)(nil).IsIsolated), isIsolated)
+}
+
+// Symbol mocks base method.
+func (m *MockListMarginOpenOrdersServiceAPI) Symbol(symbol string) binance.ListMarginOpenOrdersServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Symbol", symbol)
+	ret0, _ := ret[0].(binance.ListMarginOpenOrdersServiceAPI)
+	return ret0
+}
+
+// Symbol indicates an expected call of Symbol.
+func (mr *MockListMarginOpenOrdersServiceAPIMockRecorder) Symbol(symbol interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Symbol", reflect.TypeOf((*MockListMarginOpenOrdersServiceAPI)(nil).Symbol), symbol)
+}
+
+// MockListMarginOrdersServiceAPI is a mock of ListMarginOrdersServiceAPI interface.
+type MockListMarginOrdersServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockListMarginOrdersServiceAPIMockRecorder
+}
+
+// MockListMarginOrdersServiceAPIMockRecorder is the mock recorder for MockListMarginOrdersServiceAPI.
+type MockListMarginOrdersServiceAPIMockRecorder struct {
+	mock *MockListMarginOrdersServiceAPI
+}
+
+// NewMockListMarginOrdersServiceAPI creates a new mock instance.
+func NewMockListMarginOrdersServiceAPI(ctrl *gomock.Controller) *MockListMarginOrdersServiceAPI {
+	mock := &MockListMarginOrdersServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockListMarginOrdersServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockListMarginOrdersServiceAPI) EXPECT() *MockListMarginOrdersServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockListMarginOrdersServiceAPI) Do(ctx context.Context, opts ...binance.RequestOption) ([]*binance.Order, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].([]*binance.Order)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockListMarginOrdersServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockListMarginOrdersServiceAPI)(nil).Do), varargs...)
+}
+
+// EndTime mocks base method.
+func (m *MockListMarginOrdersServiceAPI) EndTime(endTime int64) binance.ListMarginOrdersServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EndTime", endTime)
+	ret0, _ := ret[0].(binance.ListMarginOrdersServiceAPI)
+	return ret0
+}
+
+// EndTime indicates an expected call of EndTime.
+func (mr *MockListMarginOrdersServiceAPIMockRecorder) EndTime(endTime interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EndTime", reflect.TypeOf((*MockListMarginOrdersServiceAPI)(nil).EndTime), endTime)
+}
+
+// IsIsolated mocks base method.
+func (m *MockListMarginOrdersServiceAPI) IsIsolated(isIsolated bool) binance.ListMarginOrdersServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsIsolated", isIsolated)
+	ret0, _ := ret[0].(binance.ListMarginOrdersServiceAPI)
+	return ret0
+}
+
+// IsIsolated indicates an expected call of IsIsolated.
+func (mr *MockListMarginOrdersServiceAPIMockRecorder) IsIsolated(isIsolated interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsIsolated", reflect.TypeOf((*MockListMarginOrdersServiceAPI)(nil).IsIsolated), isIsolated)
+}
+
+// Limit mocks base method.
+func (m *MockListMarginOrdersServiceAPI) Limit(limit int) binance.ListMarginOrdersServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Limit", limit)
+	ret0, _ := ret[0].(binance.ListMarginOrdersServiceAPI)
+	return ret0
+}
+
+// Limit indicates an expected call of Limit.
+func (mr *MockListMarginOrdersServiceAPIMockRecorder) Limit(limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Limit", reflect.TypeOf((*MockListMarginOrdersServiceAPI)(nil).Limit), limit)
+}
+
+// OrderID mocks base method.
+func (m *MockListMarginOrdersServiceAPI) OrderID(orderID int64) binance.ListMarginOrdersServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OrderID", orderID)
+	ret0, _ := ret[0].(binance.ListMarginOrdersServiceAPI)
+	return ret0
+}
+
+// OrderID indicates an expected call of OrderID.
+func (mr *MockListMarginOrdersServiceAPIMockRecorder) OrderID(orderID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OrderID", reflect.TypeOf((*MockListMarginOrdersServiceAPI)(nil).OrderID), orderID)
+}
+
+// StartTime mocks base method.
+func (m *MockListMarginOrdersServiceAPI) StartTime(startTime int64) binance.ListMarginOrdersServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartTime", startTime)
+	ret0, _ := ret[0].(binance.ListMarginOrdersServiceAPI)
+	return ret0
+}
+
+// StartTime indicates an expected call of StartTime.
+func (mr *MockListMarginOrdersServiceAPIMockRecorder) StartTime(startTime interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartTime", reflect.TypeOf((*MockListMarginOrdersServiceAPI)(nil).StartTime), startTime)
+}
+
+// Symbol mocks base method.
+func (m *MockListMarginOrdersServiceAPI) Symbol(symbol string) binance.ListMarginOrdersServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Symbol", symbol)
+	ret0, _ := ret[0].(binance.ListMarginOrdersServiceAPI)
+	return ret0
+}
+
+// Symbol indicates an expected call of Symbol.
+func (mr *MockListMarginOrdersServiceAPIMockRecorder) Symbol(symbol interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Symbol", reflect.TypeOf((*MockListMarginOrdersServiceAPI)(nil).Symbol), symbol)
+}
+
+// MockListMarginTradesServiceAPI is a mock of ListMarginTradesServiceAPI interface.
+type MockListMarginTradesServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockListMarginTradesServiceAPIMockRecorder
+}
+
+// MockListMarginTradesServiceAPIMockRecorder is the mock recorder for MockListMarginTradesServiceAPI.
+type MockListMarginTradesServiceAPIMockRecorder struct {
+	mock *MockListMarginTradesServiceAPI
+}
+
+// NewMockListMarginTradesServiceAPI creates a new mock instance.
+func NewMockListMarginTradesServiceAPI(ctrl *gomock.Controller) *MockListMarginTradesServiceAPI {
+	mock := &MockListMarginTradesServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockListMarginTradesServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockListMarginTradesServiceAPI) EXPECT() *MockListMarginTradesServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockListMarginTradesServiceAPI) Do(ctx context.Context, opts ...binance.RequestOption) ([]*binance.TradeV3, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].([]*binance.TradeV3)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockListMarginTradesServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockListMarginTradesServiceAPI)(nil).Do), varargs...)
+}
+
+// EndTime mocks base method.
+func (m *MockListMarginTradesServiceAPI) EndTime(endTime int64) binance.ListMarginTradesServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EndTime", endTime)
+	ret0, _ := ret[0].(binance.ListMarginTradesServiceAPI)
+	return ret0
+}
+
+// EndTime indicates an expected call of EndTime.
+func (mr *MockListMarginTradesServiceAPIMockRecorder) EndTime(endTime interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EndTime", reflect.TypeOf((*MockListMarginTradesServiceAPI)(nil).EndTime), endTime)
+}
+
+// FromID mocks base method.
+func (m *MockListMarginTradesServiceAPI) FromID(fromID int64) binance.ListMarginTradesServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FromID", fromID)
+	ret0, _ := ret[0].(binance.ListMarginTradesServiceAPI)
+	return ret0
+}
+
+// FromID indicates an expected call of FromID.
+func (mr *MockListMarginTradesServiceAPIMockRecorder) FromID(fromID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FromID", reflect.TypeOf((*MockListMarginTradesServiceAPI)(nil).FromID), fromID)
+}
+
+// IsIsolated mocks base method.
+func (m *MockListMarginTradesServiceAPI) IsIsolated(isIsolated bool) binance.ListMarginTradesServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsIsolated", isIsolated)
+	ret0, _ := ret[0].(binance.ListMarginTradesServiceAPI)
+	return ret0
+}
+
+// IsIsolated indicates an expected call of IsIsolated.
+func (mr *MockListMarginTradesServiceAPIMockRecorder) IsIsolated(isIsolated interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsIsolated", reflect.TypeOf((*MockListMarginTradesServiceAPI)(nil).IsIsolated), isIsolated)
+}
+
+// Limit mocks base method.
+func (m *MockListMarginTradesServiceAPI) Limit(limit int) binance.ListMarginTradesServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Limit", limit)
+	ret0, _ := ret[0].(binance.ListMarginTradesServiceAPI)
+	return ret0
+}
+
+// Limit indicates an expected call of Limit.
+func (mr *MockListMarginTradesServiceAPIMockRecorder) Limit(limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Limit", reflect.TypeOf((*MockListMarginTradesServiceAPI)(nil).Limit), limit)
+}
+
+// StartTime mocks base method.
+func (m *MockListMarginTradesServiceAPI) StartTime(startTime int64) binance.ListMarginTradesServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartTime", startTime)
+	ret0, _ := ret[0].(binance.ListMarginTradesServiceAPI)
+	return ret0
+}
+
+// StartTime indicates an expected call of StartTime.
+func (mr *MockListMarginTradesServiceAPIMockRecorder) StartTime(startTime interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartTime", reflect.TypeOf((*MockListMarginTradesServiceAPI)(nil).StartTime), startTime)
+}
+
+// Symbol mocks base method.
+func (m *MockListMarginTradesServiceAPI) Symbol(symbol string) binance.ListMarginTradesServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Symbol", symbol)
+	ret0, _ := ret[0].(binance.ListMarginTradesServiceAPI)
+	return ret0
+}
+
+// Symbol indicates an expected call of Symbol.
+func (mr *MockListMarginTradesServiceAPIMockRecorder) Symbol(symbol interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Symbol", reflect.TypeOf((*MockListMarginTradesServiceAPI)(nil).Symbol), symbol)
+}
+
+// MockGetMaxBorrowableServiceAPI is a mock of GetMaxBorrowableServiceAPI interface.
+type MockGetMaxBorrowableServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockGetMaxBorrowableServiceAPIMockRecorder
+}
+
+// MockGetMaxBorrowableServiceAPIMockRecorder is the mock recorder for MockGetMaxBorrowableServiceAPI.
+type MockGetMaxBorrowableServiceAPIMockRecorder struct {
+	mock *MockGetMaxBorrowableServiceAPI
+}
+
+// NewMockGetMaxBorrowableServiceAPI creates a new mock instance.
+func NewMockGetMaxBorrowableServiceAPI(ctrl *gomock.Controller) *MockGetMaxBorrowableServiceAPI {
+	mock := &MockGetMaxBorrowableServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockGetMaxBorrowableServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGetMaxBorrowableServiceAPI) EXPECT() *MockGetMaxBorrowableServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Asset mocks base method.
+func (m *MockGetMaxBorrowableServiceAPI) Asset(asset string) binance.GetMaxBorrowableServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Asset", asset)
+	ret0, _ := ret[0].(binance.GetMaxBorrowableServiceAPI)
+	return ret0
+}
+
+// Asset indicates an expected call of Asset.
+func (mr *MockGetMaxBorrowableServiceAPIMockRecorder) Asset(asset interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Asset", reflect.TypeOf((*MockGetMaxBorrowableServiceAPI)(nil).Asset), asset)
+}
+
+// Do mocks base method.
+func (m *MockGetMaxBorrowableServiceAPI) Do(ctx context.Context, opts ...binance.RequestOption) (*binance.MaxBorrowable, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*binance.MaxBorrowable)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockGetMaxBorrowableServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockGetMaxBorrowableServiceAPI)(nil).Do), varargs...)
+}
+
+// IsolatedSymbol mocks base method.
+func (m *MockGetMaxBorrowableServiceAPI) IsolatedSymbol(isolatedSymbol string) binance.GetMaxBorrowableServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsolatedSymbol", isolatedSymbol)
+	ret0, _ := ret[0].(binance.GetMaxBorrowableServiceAPI)
+	return ret0
+}
+
+// IsolatedSymbol indicates an expected call of IsolatedSymbol.
+func (mr *MockGetMaxBorrowableServiceAPIMockRecorder) IsolatedSymbol(isolatedSymbol interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsolatedSymbol", reflect.TypeOf((*MockGetMaxBorrowableServiceAPI)(nil).IsolatedSymbol), isolatedSymbol)
+}
+
+// MockGetMaxTransferableServiceAPI is a mock of GetMaxTransferableServiceAPI interface.
+type MockGetMaxTransferableServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockGetMaxTransferableServiceAPIMockRecorder
+}
+
+// MockGetMaxTransferableServiceAPIMockRecorder is the mock recorder for MockGetMaxTransferableServiceAPI.
+type MockGetMaxTransferableServiceAPIMockRecorder struct {
+	mock *MockGetMaxTransferableServiceAPI
+}
+
+// NewMockGetMaxTransferableServiceAPI creates a new mock instance.
+func NewMockGetMaxTransferableServiceAPI(ctrl *gomock.Controller) *MockGetMaxTransferableServiceAPI {
+	mock := &MockGetMaxTransferableServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockGetMaxTransferableServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGetMaxTransferableServiceAPI) EXPECT() *MockGetMaxTransferableServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Asset mocks base method.
+func (m *MockGetMaxTransferableServiceAPI) Asset(asset string) binance.GetMaxTransferableServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Asset", asset)
+	ret0, _ := ret[0].(binance.GetMaxTransferableServiceAPI)
+	return ret0
+}
+
+// Asset indicates an expected call of Asset.
+func (mr *MockGetMaxTransferableServiceAPIMockRecorder) Asset(asset interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Asset", reflect.TypeOf((*MockGetMaxTransferableServiceAPI)(nil).Asset), asset)
+}
+
+// Do mocks base method.
+func (m *MockGetMaxTransferableServiceAPI) Do(ctx context.Context, opts ...binance.RequestOption) (*binance.MaxTransferable, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*binance.MaxTransferable)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockGetMaxTransferableServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockGetMaxTransferableServiceAPI)(nil).Do), varargs...)
+}
+
+// MockStartMarginUserStreamServiceAPI is a mock of StartMarginUserStreamServiceAPI interface.
+type MockStartMarginUserStreamServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockStartMarginUserStreamServiceAPIMockRecorder
+}
+
+// MockStartMarginUserStreamServiceAPIMockRecorder is the mock recorder for MockStartMarginUserStreamServiceAPI.
+type MockStartMarginUserStreamServiceAPIMockRecorder struct {
+	mock *MockStartMarginUserStreamServiceAPI
+}
+
+// NewMockStartMarginUserStreamServiceAPI creates a new mock instance.
+func NewMockStartMarginUserStreamServiceAPI(ctrl *gomock.Controller) *MockStartMarginUserStreamServiceAPI {
+	mock := &MockStartMarginUserStreamServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockStartMarginUserStreamServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockStartMarginUserStreamServiceAPI) EXPECT() *MockStartMarginUserStreamServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockStartMarginUserStreamServiceAPI) Do(ctx context.Context, opts ...binance.RequestOption) (string, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockStartMarginUserStreamServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockStartMarginUserStreamServiceAPI)(nil).Do), varargs...)
+}
+
+// MockKeepaliveMarginUserStreamServiceAPI is a mock of KeepaliveMarginUserStreamServiceAPI interface.
+type MockKeepaliveMarginUserStreamServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockKeepaliveMarginUserStreamServiceAPIMockRecorder
+}
+
+// MockKeepaliveMarginUserStreamServiceAPIMockRecorder is the mock recorder for MockKeepaliveMarginUserStreamServiceAPI.
+type MockKeepaliveMarginUserStreamServiceAPIMockRecorder struct {
+	mock *MockKeepaliveMarginUserStreamServiceAPI
+}
+
+// NewMockKeepaliveMarginUserStreamServiceAPI creates a new mock instance.
+func NewMockKeepaliveMarginUserStreamServiceAPI(ctrl *gomock.Controller) *MockKeepaliveMarginUserStreamServiceAPI {
+	mock := &MockKeepaliveMarginUserStreamServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockKeepaliveMarginUserStreamServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockKeepaliveMarginUserStreamServiceAPI) EXPECT() *MockKeepaliveMarginUserStreamServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockKeepaliveMarginUserStreamServiceAPI) Do(ctx context.Context, opts ...binance.RequestOption) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockKeepaliveMarginUserStreamServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockKeepaliveMarginUserStreamServiceAPI)(nil).Do), varargs...)
+}
+
+// ListenKey mocks base method.
+func (m *MockKeepaliveMarginUserStreamServiceAPI) ListenKey(listenKey string) binance.KeepaliveMarginUserStreamServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListenKey", listenKey)
+	ret0, _ := ret[0].(binance.KeepaliveMarginUserStreamServiceAPI)
+	return ret0
+}
+
+// ListenKey indicates an expected call of ListenKey.
+func (mr *MockKeepaliveMarginUserStreamServiceAPIMockRecorder) ListenKey(listenKey interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListenKey", reflect.TypeOf((*MockKeepaliveMarginUserStreamServiceAPI)(nil).ListenKey), listenKey)
+}
+
+// MockCloseMarginUserStreamServiceAPI is a mock of CloseMarginUserStreamServiceAPI interface.
+type MockCloseMarginUserStreamServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockCloseMarginUserStreamServiceAPIMockRecorder
+}
+
+// MockCloseMarginUserStreamServiceAPIMockRecorder is the mock recorder for MockCloseMarginUserStreamServiceAPI.
+type MockCloseMarginUserStreamServiceAPIMockRecorder struct {
+	mock *MockCloseMarginUserStreamServiceAPI
+}
+
+// NewMockCloseMarginUserStreamServiceAPI creates a new mock instance.
+func NewMockCloseMarginUserStreamServiceAPI(ctrl *gomock.Controller) *MockCloseMarginUserStreamServiceAPI {
+	mock := &MockCloseMarginUserStreamServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockCloseMarginUserStreamServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCloseMarginUserStreamServiceAPI) EXPECT() *MockCloseMarginUserStreamServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockCloseMarginUserStreamServiceAPI) Do(ctx context.Context, opts ...binance.RequestOption) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockCloseMarginUserStreamServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockCloseMarginUserStreamServiceAPI)(nil).Do), varargs...)
+}
+
+// ListenKey mocks base method.
+func (m *MockCloseMarginUserStreamServiceAPI) ListenKey(listenKey string) binance.CloseMarginUserStreamServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListenKey", listenKey)
+	ret0, _ := ret[0].(binance.CloseMarginUserStreamServiceAPI)
+	return ret0
+}
+
+// ListenKey indicates an expected call of ListenKey.
+func (mr *MockCloseMarginUserStreamServiceAPIMockRecorder) ListenKey(listenKey interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListenKey", reflect.TypeOf((*MockCloseMarginUserStreamServiceAPI)(nil).ListenKey), listenKey)
+}
+
+// MockStartIsolatedMarginUserStreamServiceAPI is a mock of StartIsolatedMarginUserStreamServiceAPI interface.
+type MockStartIsolatedMarginUserStreamServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockStartIsolatedMarginUserStreamServiceAPIMockRecorder
+}
+
+// MockStartIsolatedMarginUserStreamServiceAPIMockRecorder is the mock recorder for MockStartIsolatedMarginUserStreamServiceAPI.
+type MockStartIsolatedMarginUserStreamServiceAPIMockRecorder struct {
+	mock *MockStartIsolatedMarginUserStreamServiceAPI
+}
+
+// NewMockStartIsolatedMarginUserStreamServiceAPI creates a new mock instance.
+func NewMockStartIsolatedMarginUserStreamServiceAPI(ctrl *gomock.Controller) *MockStartIsolatedMarginUserStreamServiceAPI {
+	mock := &MockStartIsolatedMarginUserStreamServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockStartIsolatedMarginUserStreamServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockStartIsolatedMarginUserStreamServiceAPI) EXPECT() *MockStartIsolatedMarginUserStreamServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockStartIsolatedMarginUserStreamServiceAPI) Do(ctx context.Context, opts ...binance.RequestOption) (string, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockStartIsolatedMarginUserStreamServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockStartIsolatedMarginUserStreamServiceAPI)(nil).Do), varargs...)
+}
+
+// Symbol mocks base method.
+func (m *MockStartIsolatedMarginUserStreamServiceAPI) Symbol(symbol string) binance.StartIsolatedMarginUserStreamServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Symbol", symbol)
+	ret0, _ := ret[0].(binance.StartIsolatedMarginUserStreamServiceAPI)
+	return ret0
+}
+
+// Symbol indicates an expected call of Symbol.
+func (mr *MockStartIsolatedMarginUserStreamServiceAPIMockRecorder) Symbol(symbol interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Symbol", reflect.TypeOf((*MockStartIsolatedMarginUserStreamServiceAPI)(nil).Symbol), symbol)
+}
+
+// MockKeepaliveIsolatedMarginUserStreamServiceAPI is a mock of KeepaliveIsolatedMarginUserStreamServiceAPI interface.
+type MockKeepaliveIsolatedMarginUserStreamServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockKeepaliveIsolatedMarginUserStreamServiceAPIMockRecorder
+}
+
+// MockKeepaliveIsolatedMarginUserStreamServiceAPIMockRecorder is the mock recorder for MockKeepaliveIsolatedMarginUserStreamServiceAPI.
+type MockKeepaliveIsolatedMarginUserStreamServiceAPIMockRecorder struct {
+	mock *MockKeepaliveIsolatedMarginUserStreamServiceAPI
+}
+
+// NewMockKeepaliveIsolatedMarginUserStreamServiceAPI creates a new mock instance.
+func NewMockKeepaliveIsolatedMarginUserStreamServiceAPI(ctrl *gomock.Controller) *MockKeepaliveIsolatedMarginUserStreamServiceAPI {
+	mock := &MockKeepaliveIsolatedMarginUserStreamServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockKeepaliveIsolatedMarginUserStreamServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockKeepaliveIsolatedMarginUserStreamServiceAPI) EXPECT() *MockKeepaliveIsolatedMarginUserStreamServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockKeepaliveIsolatedMarginUserStreamServiceAPI) Do(ctx context.Context, opts ...binance.RequestOption) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockKeepaliveIsolatedMarginUserStreamServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockKeepaliveIsolatedMarginUserStreamServiceAPI)(nil).Do), varargs...)
+}
+
+// ListenKey mocks base method.
+func (m *MockKeepaliveIsolatedMarginUserStreamServiceAPI) ListenKey(listenKey string) binance.KeepaliveIsolatedMarginUserStreamServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListenKey", listenKey)
+	ret0, _ := ret[0].(binance.KeepaliveIsolatedMarginUserStreamServiceAPI)
+	return ret0
+}
+
+// ListenKey indicates an expected call of ListenKey.
+func (mr *MockKeepaliveIsolatedMarginUserStreamServiceAPIMockRecorder) ListenKey(listenKey interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListenKey", reflect.TypeOf((*MockKeepaliveIsolatedMarginUserStreamServiceAPI)(nil).ListenKey), listenKey)
+}
+
+// Symbol mocks base method.
+func (m *MockKeepaliveIsolatedMarginUserStreamServiceAPI) Symbol(symbol string) binance.KeepaliveIsolatedMarginUserStreamServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Symbol", symbol)
+	ret0, _ := ret[0].(binance.KeepaliveIsolatedMarginUserStreamServiceAPI)
+	return ret0
+}
+
+// Symbol indicates an expected call of Symbol.
+func (mr *MockKeepaliveIsolatedMarginUserStreamServiceAPIMockRecorder) Symbol(symbol interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Symbol", reflect.TypeOf((*MockKeepaliveIsolatedMarginUserStreamServiceAPI)(nil).Symbol), symbol)
+}
+
+// MockCloseIsolatedMarginUserStreamServiceAPI is a mock of CloseIsolatedMarginUserStreamServiceAPI interface.
+type MockCloseIsolatedMarginUserStreamServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockCloseIsolatedMarginUserStreamServiceAPIMockRecorder
+}
+
+// MockCloseIsolatedMarginUserStreamServiceAPIMockRecorder is the mock recorder for MockCloseIsolatedMarginUserStreamServiceAPI.
+type MockCloseIsolatedMarginUserStreamServiceAPIMockRecorder struct {
+	mock *MockCloseIsolatedMarginUserStreamServiceAPI
+}
+
+// NewMockCloseIsolatedMarginUserStreamServiceAPI creates a new mock instance.
+func NewMockCloseIsolatedMarginUserStreamServiceAPI(ctrl *gomock.Controller) *MockCloseIsolatedMarginUserStreamServiceAPI {
+	mock := &MockCloseIsolatedMarginUserStreamServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockCloseIsolatedMarginUserStreamServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCloseIsolatedMarginUserStreamServiceAPI) EXPECT() *MockCloseIsolatedMarginUserStreamServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockCloseIsolatedMarginUserStreamServiceAPI) Do(ctx context.Context, opts ...binance.RequestOption) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockCloseIsolatedMarginUserStreamServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockCloseIsolatedMarginUserStreamServiceAPI)(nil).Do), varargs...)
+}
+
+// ListenKey mocks base method.
+func (m *MockCloseIsolatedMarginUserStreamServiceAPI) ListenKey(listenKey string) binance.CloseIsolatedMarginUserStreamServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListenKey", listenKey)
+	ret0, _ := ret[0].(binance.CloseIsolatedMarginUserStreamServiceAPI)
+	return ret0
+}
+
+// ListenKey indicates an expected call of ListenKey.
+func (mr *MockCloseIsolatedMarginUserStreamServiceAPIMockRecorder) ListenKey(listenKey interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListenKey", reflect.TypeOf((*MockCloseIsolatedMarginUserStreamServiceAPI)(nil).ListenKey), listenKey)
+}
+
+// Symbol mocks base method.
+func (m *MockCloseIsolatedMarginUserStreamServiceAPI) Symbol(symbol string) binance.CloseIsolatedMarginUserStreamServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Symbol", symbol)
+	ret0, _ := ret[0].(binance.CloseIsolatedMarginUserStreamServiceAPI)
+	return ret0
+}
+
+// Symbol indicates an expected call of Symbol.
+func (mr *MockCloseIsolatedMarginUserStreamServiceAPIMockRecorder) Symbol(symbol interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Symbol", reflect.TypeOf((*MockCloseIsolatedMarginUserStreamServiceAPI)(nil).Symbol), symbol)
+}
+
+// MockMarginInterestHistoryServiceAPI is a mock of MarginInterestHistoryServiceAPI interface.
+type MockMarginInterestHistoryServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockMarginInterestHistoryServiceAPIMockRecorder
+}
+
+// MockMarginInterestHistoryServiceAPIMockRecorder is the mock recorder for MockMarginInterestHistoryServiceAPI.
+type MockMarginInterestHistoryServiceAPIMockRecorder struct {
+	mock *MockMarginInterestHistoryServiceAPI
+}
+
+// NewMockMarginInterestHistoryServiceAPI creates a new mock instance.
+func NewMockMarginInterestHistoryServiceAPI(ctrl *gomock.Controller) *MockMarginInterestHistoryServiceAPI {
+	mock := &MockMarginInterestHistoryServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockMarginInterestHistoryServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockMarginInterestHistoryServiceAPI) EXPECT() *MockMarginInterestHistoryServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Asset mocks base method.
+func (m *MockMarginInterestHistoryServiceAPI) Asset(asset string) binance.MarginInterestHistoryServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Asset", asset)
+	ret0, _ := ret[0].(binance.MarginInterestHistoryServiceAPI)
+	return ret0
+}
+
+// Asset indicates an expected call of Asset.
+func (mr *MockMarginInterestHistoryServiceAPIMockRecorder) Asset(asset interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Asset", reflect.TypeOf((*MockMarginInterestHistoryServiceAPI)(nil).Asset), asset)
+}
+
+// Current mocks base method.
+func (m *MockMarginInterestHistoryServiceAPI) Current(current int64) binance.MarginInterestHistoryServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Current", current)
+	ret0, _ := ret[0].(binance.MarginInterestHistoryServiceAPI)
+	return ret0
+}
+
+// Current indicates an expected call of Current.
+func (mr *MockMarginInterestHistoryServiceAPIMockRecorder) Current(current interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Current", reflect.TypeOf((*MockMarginInterestHistoryServiceAPI)(nil).Current), current)
+}
+
+// Do mocks base method.
+func (m *MockMarginInterestHistoryServiceAPI) Do(ctx context.Context) (*binance.MarginInterestHistory, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Do", ctx)
+	ret0, _ := ret[0].(*binance.MarginInterestHistory)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockMarginInterestHistoryServiceAPIMockRecorder) Do(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockMarginInterestHistoryServiceAPI)(nil).Do), ctx)
+}
+
+// EndTime mocks base method.
+func (m *MockMarginInterestHistoryServiceAPI) EndTime(endTime int64) binance.MarginInterestHistoryServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EndTime", endTime)
+	ret0, _ := ret[0].(binance.MarginInterestHistoryServiceAPI)
+	return ret0
+}
+
+// EndTime indicates an expected call of EndTime.
+func (mr *MockMarginInterestHistoryServiceAPIMockRecorder) EndTime(endTime interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EndTime", reflect.TypeOf((*MockMarginInterestHistoryServiceAPI)(nil).EndTime), endTime)
+}
+
+// IsolatedSymbol mocks base method.
+func (m *MockMarginInterestHistoryServiceAPI) IsolatedSymbol(symbol string) binance.MarginInterestHistoryServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsolatedSymbol", symbol)
+	ret0, _ := ret[0].(binance.MarginInterestHistoryServiceAPI)
+	return ret0
+}
+
+// IsolatedSymbol indicates an expected call of IsolatedSymbol.
+func (mr *MockMarginInterestHistoryServiceAPIMockRecorder) IsolatedSymbol(symbol interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsolatedSymbol", reflect.TypeOf((*MockMarginInterestHistoryServiceAPI)(nil).IsolatedSymbol), symbol)
+}
+
+// Size mocks base method.
+func (m *MockMarginInterestHistoryServiceAPI) Size(size int64) binance.MarginInterestHistoryServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Size", size)
+	ret0, _ := ret[0].(binance.MarginInterestHistoryServiceAPI)
+	return ret0
+}
+
+// Size indicates an expected call of Size.
+func (mr *MockMarginInterestHistoryServiceAPIMockRecorder) Size(size interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Size", reflect.TypeOf((*MockMarginInterestHistoryServiceAPI)(nil).Size), size)
+}
+
+// StartTime mocks base method.
+func (m *MockMarginInterestHistoryServiceAPI) StartTime(startTime int64) binance.MarginInterestHistoryServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartTime", startTime)
+	ret0, _ := ret[0].(binance.MarginInterestHistoryServiceAPI)
+	return ret0
+}
+
+// StartTime indicates an expected call of StartTime.
+func (mr *MockMarginInterestHistoryServiceAPIMockRecorder) StartTime(startTime interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartTime", reflect.TypeOf((*MockMarginInterestHistoryServiceAPI)(nil).StartTime), startTime)
+}
+
+// MockMarginInterestRateHistoryServiceAPI is a mock of MarginInterestRateHistoryServiceAPI interface.
+type MockMarginInterestRateHistoryServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockMarginInterestRateHistoryServiceAPIMockRecorder
+}
+
+// MockMarginInterestRateHistoryServiceAPIMockRecorder is the mock recorder for MockMarginInterestRateHistoryServiceAPI.
+type MockMarginInterestRateHistoryServiceAPIMockRecorder struct {
+	mock *MockMarginInterestRateHistoryServiceAPI
+}
+
+// NewMockMarginInterestRateHistoryServiceAPI creates a new mock instance.
+func NewMockMarginInterestRateHistoryServiceAPI(ctrl *gomock.Controller) *MockMarginInterestRateHistoryServiceAPI {
+	mock := &MockMarginInterestRateHistoryServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockMarginInterestRateHistoryServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockMarginInterestRateHistoryServiceAPI) EXPECT() *MockMarginInterestRateHistoryServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Asset mocks base method.
+func (m *MockMarginInterestRateHistoryServiceAPI) Asset(asset string) binance.MarginInterestRateHistoryServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Asset", asset)
+	ret0, _ := ret[0].(binance.MarginInterestRateHistoryServiceAPI)
+	return ret0
+}
+
+// Asset indicates an expected call of Asset.
+func (mr *MockMarginInterestRateHistoryServiceAPIMockRecorder) Asset(asset interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Asset", reflect.TypeOf((*MockMarginInterestRateHistoryServiceAPI)(nil).Asset), asset)
+}
+
+// Do mocks base method.
+func (m *MockMarginInterestRateHistoryServiceAPI) Do(ctx context.Context) (*binance.MarginInterestRateHistory, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Do", ctx)
+	ret0, _ := ret[0].(*binance.MarginInterestRateHistory)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockMarginInterestRateHistoryServiceAPIMockRecorder) Do(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockMarginInterestRateHistoryServiceAPI)(nil).Do), ctx)
+}
+
+// EndTime mocks base method.
+func (m *MockMarginInterestRateHistoryServiceAPI) EndTime(endTime int64) binance.MarginInterestRateHistoryServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EndTime", endTime)
+	ret0, _ := ret[0].(binance.MarginInterestRateHistoryServiceAPI)
+	return ret0
+}
+
+// EndTime indicates an expected call of EndTime.
+func (mr *MockMarginInterestRateHistoryServiceAPIMockRecorder) EndTime(endTime interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EndTime", reflect.TypeOf((*MockMarginInterestRateHistoryServiceAPI)(nil).EndTime), endTime)
+}
+
+// StartTime mocks base method.
+func (m *MockMarginInterestRateHistoryServiceAPI) StartTime(startTime int64) binance.MarginInterestRateHistoryServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartTime", startTime)
+	ret0, _ := ret[0].(binance.MarginInterestRateHistoryServiceAPI)
+	return ret0
+}
+
+// StartTime indicates an expected call of StartTime.
+func (mr *MockMarginInterestRateHistoryServiceAPIMockRecorder) StartTime(startTime interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartTime", reflect.TypeOf((*MockMarginInterestRateHistoryServiceAPI)(nil).StartTime), startTime)
+}
+
+// VipLevel mocks base method.
+func (m *MockMarginInterestRateHistoryServiceAPI) VipLevel(vipLevel int64) binance.MarginInterestRateHistoryServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VipLevel", vipLevel)
+	ret0, _ := ret[0].(binance.MarginInterestRateHistoryServiceAPI)
+	return ret0
+}
+
+// VipLevel indicates an expected call of VipLevel.
+func (mr *MockMarginInterestRateHistoryServiceAPIMockRecorder) VipLevel(vipLevel interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VipLevel", reflect.TypeOf((*MockMarginInterestRateHistoryServiceAPI)(nil).VipLevel), vipLevel)
+}
+
+// MockMarginNextHourlyInterestRateServiceAPI is a mock of MarginNextHourlyInterestRateServiceAPI interface.
+type MockMarginNextHourlyInterestRateServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockMarginNextHourlyInterestRateServiceAPIMockRecorder
+}
+
+// MockMarginNextHourlyInterestRateServiceAPIMockRecorder is the mock recorder for MockMarginNextHourlyInterestRateServiceAPI.
+type MockMarginNextHourlyInterestRateServiceAPIMockRecorder struct {
+	mock *MockMarginNextHourlyInterestRateServiceAPI
+}
+
+// NewMockMarginNextHourlyInterestRateServiceAPI creates a new mock instance.
+func NewMockMarginNextHourlyInterestRateServiceAPI(ctrl *gomock.Controller) *MockMarginNextHourlyInterestRateServiceAPI {
+	mock := &MockMarginNextHourlyInterestRateServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockMarginNextHourlyInterestRateServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockMarginNextHourlyInterestRateServiceAPI) EXPECT() *MockMarginNextHourlyInterestRateServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Assets mocks base method.
+func (m *MockMarginNextHourlyInterestRateServiceAPI) Assets(assets string) binance.MarginNextHourlyInterestRateServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Assets", assets)
+	ret0, _ := ret[0].(binance.MarginNextHourlyInterestRateServiceAPI)
+	return ret0
+}
+
+// Assets indicates an expected call of Assets.
+func (mr *MockMarginNextHourlyInterestRateServiceAPIMockRecorder) Assets(assets interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Assets", reflect.TypeOf((*MockMarginNextHourlyInterestRateServiceAPI)(nil).Assets), assets)
+}
+
+// Do mocks base method.
+func (m *MockMarginNextHourlyInterestRateServiceAPI) Do(ctx context.Context) (*binance.MarginNextHourlyInterestRate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Do", ctx)
+	ret0, _ := ret[0].(*binance.MarginNextHourlyInterestRate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockMarginNextHourlyInterestRateServiceAPIMockRecorder) Do(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockMarginNextHourlyInterestRateServiceAPI)(nil).Do), ctx)
+}
+
+// Isolated mocks base method.
+func (m *MockMarginNextHourlyInterestRateServiceAPI) Isolated(isolated bool) binance.MarginNextHourlyInterestRateServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Isolated", isolated)
+	ret0, _ := ret[0].(binance.MarginNextHourlyInterestRateServiceAPI)
+	return ret0
+}
+
+// Isolated indicates an expected call of Isolated.
+func (mr *MockMarginNextHourlyInterestRateServiceAPIMockRecorder) Isolated(isolated interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Isolated", reflect.TypeOf((*MockMarginNextHourlyInterestRateServiceAPI)(nil).Isolated), isolated)
+}
+
+// MockMarginAvailableInventoryServiceAPI is a mock of MarginAvailableInventoryServiceAPI interface.
+type MockMarginAvailableInventoryServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockMarginAvailableInventoryServiceAPIMockRecorder
+}
+
+// MockMarginAvailableInventoryServiceAPIMockRecorder is the mock recorder for MockMarginAvailableInventoryServiceAPI.
+type MockMarginAvailableInventoryServiceAPIMockRecorder struct {
+	mock *MockMarginAvailableInventoryServiceAPI
+}
+
+// NewMockMarginAvailableInventoryServiceAPI creates a new mock instance.
+func NewMockMarginAvailableInventoryServiceAPI(ctrl *gomock.Controller) *MockMarginAvailableInventoryServiceAPI {
+	mock := &MockMarginAvailableInventoryServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockMarginAvailableInventoryServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockMarginAvailableInventoryServiceAPI) EXPECT() *MockMarginAvailableInventoryServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// MarginType mocks base method.
+func (m *MockMarginAvailableInventoryServiceAPI) MarginType(marginType string) binance.MarginAvailableInventoryServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarginType", marginType)
+	ret0, _ := ret[0].(binance.MarginAvailableInventoryServiceAPI)
+	return ret0
+}
+
+// MarginType indicates an expected call of MarginType.
+func (mr *MockMarginAvailableInventoryServiceAPIMockRecorder) MarginType(marginType interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarginType", reflect.TypeOf((*MockMarginAvailableInventoryServiceAPI)(nil).MarginType), marginType)
+}
+
+// MockFuturesTransferServiceAPI is a mock of FuturesTransferServiceAPI interface.
+type MockFuturesTransferServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockFuturesTransferServiceAPIMockRecorder
+}
+
+// MockFuturesTransferServiceAPIMockRecorder is the mock recorder for MockFuturesTransferServiceAPI.
+type MockFuturesTransferServiceAPIMockRecorder struct {
+	mock *MockFuturesTransferServiceAPI
+}
+
+// NewMockFuturesTransferServiceAPI creates a new mock instance.
+func NewMockFuturesTransferServiceAPI(ctrl *gomock.Controller) *MockFuturesTransferServiceAPI {
+	mock := &MockFuturesTransferServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockFuturesTransferServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockFuturesTransferServiceAPI) EXPECT() *MockFuturesTransferServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Amount mocks base method.
+func (m *MockFuturesTransferServiceAPI) Amount(amount string) binance.FuturesTransferServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Amount", amount)
+	ret0, _ := ret[0].(binance.FuturesTransferServiceAPI)
+	return ret0
+}
+
+// Amount indicates an expected call of Amount.
+func (mr *MockFuturesTransferServiceAPIMockRecorder) Amount(amount interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Amount", reflect.TypeOf((*MockFuturesTransferServiceAPI)(nil).Amount), amount)
+}
+
+// Asset mocks base method.
+func (m *MockFuturesTransferServiceAPI) Asset(asset string) binance.FuturesTransferServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Asset", asset)
+	ret0, _ := ret[0].(binance.FuturesTransferServiceAPI)
+	return ret0
+}
+
+// Asset indicates an expected call of Asset.
+func (mr *MockFuturesTransferServiceAPIMockRecorder) Asset(asset interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Asset", reflect.TypeOf((*MockFuturesTransferServiceAPI)(nil).Asset), asset)
+}
+
+// Do mocks base method.
+func (m *MockFuturesTransferServiceAPI) Do(ctx context.Context, opts ...binance.RequestOption) (*binance.TransactionResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*binance.TransactionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockFuturesTransferServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockFuturesTransferServiceAPI)(nil).Do), varargs...)
+}
+
+// Type mocks base method.
+func (m *MockFuturesTransferServiceAPI) Type(transferType binance.FuturesTransferType) binance.FuturesTransferServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Type", transferType)
+	ret0, _ := ret[0].(binance.FuturesTransferServiceAPI)
+	return ret0
+}
+
+// Type indicates an expected call of Type.
+func (mr *MockFuturesTransferServiceAPIMockRecorder) Type(transferType interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Type", reflect.TypeOf((*MockFuturesTransferServiceAPI)(nil).Type), transferType)
+}
+
+// MockListFuturesTransferServiceAPI is a mock of ListFuturesTransferServiceAPI interface.
+type MockListFuturesTransferServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockListFuturesTransferServiceAPIMockRecorder
+}
+
+// MockListFuturesTransferServiceAPIMockRecorder is the mock recorder for MockListFuturesTransferServiceAPI.
+type MockListFuturesTransferServiceAPIMockRecorder struct {
+	mock *MockListFuturesTransferServiceAPI
+}
+
+// NewMockListFuturesTransferServiceAPI creates a new mock instance.
+func NewMockListFuturesTransferServiceAPI(ctrl *gomock.Controller) *MockListFuturesTransferServiceAPI {
+	mock := &MockListFuturesTransferServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockListFuturesTransferServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockListFuturesTransferServiceAPI) EXPECT() *MockListFuturesTransferServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Asset mocks base method.
+func (m *MockListFuturesTransferServiceAPI) Asset(asset string) binance.ListFuturesTransferServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Asset", asset)
+	ret0, _ := ret[0].(binance.ListFuturesTransferServiceAPI)
+	return ret0
+}
+
+// Asset indicates an expected call of Asset.
+func (mr *MockListFuturesTransferServiceAPIMockRecorder) Asset(asset interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Asset", reflect.TypeOf((*MockListFuturesTransferServiceAPI)(nil).Asset), asset)
+}
+
+// Current mocks base method.
+func (m *MockListFuturesTransferServiceAPI) Current(current int64) binance.ListFuturesTransferServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Current", current)
+	ret0, _ := ret[0].(binance.ListFuturesTransferServiceAPI)
+	return ret0
+}
+
+// Current indicates an expected call of Current.
+func (mr *MockListFuturesTransferServiceAPIMockRecorder) Current(current interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Current", reflect.TypeOf((*MockListFuturesTransferServiceAPI)(nil).Current), current)
+}
+
+// Do mocks base method.
+func (m *MockListFuturesTransferServiceAPI) Do(ctx context.Context, opts ...binance.RequestOption) (*binance.FuturesTransferHistory, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*binance.FuturesTransferHistory)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockListFuturesTransferServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockListFuturesTransferServiceAPI)(nil).Do), varargs...)
+}
+
+// EndTime mocks base method.
+func (m *MockListFuturesTransferServiceAPI) EndTime(endTime int64) binance.ListFuturesTransferServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EndTime", endTime)
+	ret0, _ := ret[0].(binance.ListFuturesTransferServiceAPI)
+	return ret0
+}
+
+// EndTime indicates an expected call of EndTime.
+func (mr *MockListFuturesTransferServiceAPIMockRecorder) EndTime(endTime interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EndTime", reflect.TypeOf((*MockListFuturesTransferServiceAPI)(nil).EndTime), endTime)
+}
+
+// Size mocks base method.
+func (m *MockListFuturesTransferServiceAPI) Size(size int64) binance.ListFuturesTransferServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Size", size)
+	ret0, _ := ret[0].(binance.ListFuturesTransferServiceAPI)
+	return ret0
+}
+
+// Size indicates an expected call of Size.
+func (mr *MockListFuturesTransferServiceAPIMockRecorder) Size(size interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Size", reflect.TypeOf((*MockListFuturesTransferServiceAPI)(nil).Size), size)
+}
+
+// StartTime mocks base method.
+func (m *MockListFuturesTransferServiceAPI) StartTime(startTime int64) binance.ListFuturesTransferServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartTime", startTime)
+	ret0, _ := ret[0].(binance.ListFuturesTransferServiceAPI)
+	return ret0
+}
+
+// StartTime indicates an expected call of StartTime.
+func (mr *MockListFuturesTransferServiceAPIMockRecorder) StartTime(startTime interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartTime", reflect.TypeOf((*MockListFuturesTransferServiceAPI)(nil).StartTime), startTime)
+}
+
+// MockListDustLogServiceAPI is a mock of ListDustLogServiceAPI interface.
+type MockListDustLogServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockListDustLogServiceAPIMockRecorder
+}
+
+// MockListDustLogServiceAPIMockRecorder is the mock recorder for MockListDustLogServiceAPI.
+type MockListDustLogServiceAPIMockRecorder struct {
+	mock *MockListDustLogServiceAPI
+}
+
+// NewMockListDustLogServiceAPI creates a new mock instance.
+func NewMockListDustLogServiceAPI(ctrl *gomock.Controller) *MockListDustLogServiceAPI {
+	mock := &MockListDustLogServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockListDustLogServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockListDustLogServiceAPI) EXPECT() *MockListDustLogServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockListDustLogServiceAPI) Do(ctx context.Context) (*binance.DustResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Do", ctx)
+	ret0, _ := ret[0].(*binance.DustResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockListDustLogServiceAPIMockRecorder) Do(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockListDustLogServiceAPI)(nil).Do), ctx)
+}
+
+// EndTime mocks base method.
+func (m *MockListDustLogServiceAPI) EndTime(endTime int64) binance.ListDustLogServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EndTime", endTime)
+	ret0, _ := ret[0].(binance.ListDustLogServiceAPI)
+	return ret0
+}
+
+// EndTime indicates an expected call of EndTime.
+func (mr *MockListDustLogServiceAPIMockRecorder) EndTime(endTime interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EndTime", reflect.TypeOf((*MockListDustLogServiceAPI)(nil).EndTime), endTime)
+}
+
+// StartTime mocks base method.
+func (m *MockListDustLogServiceAPI) StartTime(startTime int64) binance.ListDustLogServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartTime", startTime)
+	ret0, _ := ret[0].(binance.ListDustLogServiceAPI)
+	return ret0
+}
+
+// StartTime indicates an expected call of StartTime.
+func (mr *MockListDustLogServiceAPIMockRecorder) StartTime(startTime interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartTime", reflect.TypeOf((*MockListDustLogServiceAPI)(nil).StartTime), startTime)
+}
+
+// MockDustTransferServiceAPI is a mock of DustTransferServiceAPI interface.
+type MockDustTransferServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockDustTransferServiceAPIMockRecorder
+}
+
+// MockDustTransferServiceAPIMockRecorder is the mock recorder for MockDustTransferServiceAPI.
+type MockDustTransferServiceAPIMockRecorder struct {
+	mock *MockDustTransferServiceAPI
+}
+
+// NewMockDustTransferServiceAPI creates a new mock instance.
+func NewMockDustTransferServiceAPI(ctrl *gomock.Controller) *MockDustTransferServiceAPI {
+	mock := &MockDustTransferServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockDustTransferServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDustTransferServiceAPI) EXPECT() *MockDustTransferServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Asset mocks base method.
+func (m *MockDustTransferServiceAPI) Asset(asset []string) binance.DustTransferServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Asset", asset)
+	ret0, _ := ret[0].(binance.DustTransferServiceAPI)
+	return ret0
+}
+
+// Asset indicates an expected call of Asset.
+func (mr *MockDustTransferServiceAPIMockRecorder) Asset(asset interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Asset", reflect.TypeOf((*MockDustTransferServiceAPI)(nil).Asset), asset)
+}
+
+// Do mocks base method.
+func (m *MockDustTransferServiceAPI) Do(ctx context.Context) (*binance.DustTransferResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Do", ctx)
+	ret0, _ := ret[0].(*binance.DustTransferResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockDustTransferServiceAPIMockRecorder) Do(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockDustTransferServiceAPI)(nil).Do), ctx)
+}
+
+// MockListDustServiceAPI is a mock of ListDustServiceAPI interface.
+type MockListDustServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockListDustServiceAPIMockRecorder
+}
+
+// MockListDustServiceAPIMockRecorder is the mock recorder for MockListDustServiceAPI.
+type MockListDustServiceAPIMockRecorder struct {
+	mock *MockListDustServiceAPI
+}
+
+// NewMockListDustServiceAPI creates a new mock instance.
+func NewMockListDustServiceAPI(ctrl *gomock.Controller) *MockListDustServiceAPI {
+	mock := &MockListDustServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockListDustServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockListDustServiceAPI) EXPECT() *MockListDustServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockListDustServiceAPI) Do(ctx context.Context) (*binance.ListDustResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Do", ctx)
+	ret0, _ := ret[0].(*binance.ListDustResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockListDustServiceAPIMockRecorder) Do(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockListDustServiceAPI)(nil).Do), ctx)
+}
+
+// MockTransferToSubAccountServiceAPI is a mock of TransferToSubAccountServiceAPI interface.
+type MockTransferToSubAccountServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockTransferToSubAccountServiceAPIMockRecorder
+}
+
+// MockTransferToSubAccountServiceAPIMockRecorder is the mock recorder for MockTransferToSubAccountServiceAPI.
+type MockTransferToSubAccountServiceAPIMockRecorder struct {
+	mock *MockTransferToSubAccountServiceAPI
+}
+
+// NewMockTransferToSubAccountServiceAPI creates a new mock instance.
+func NewMockTransferToSubAccountServiceAPI(ctrl *gomock.Controller) *MockTransferToSubAccountServiceAPI {
+	mock := &MockTransferToSubAccountServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockTransferToSubAccountServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTransferToSubAccountServiceAPI) EXPECT() *MockTransferToSubAccountServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Amount mocks base method.
+func (m *MockTransferToSubAccountServiceAPI) Amount(amount string) binance.TransferToSubAccountServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Amount", amount)
+	ret0, _ := ret[0].(binance.TransferToSubAccountServiceAPI)
+	return ret0
+}
+
+// Amount indicates an expected call of Amount.
+func (mr *MockTransferToSubAccountServiceAPIMockRecorder) Amount(amount interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Amount", reflect.TypeOf((*MockTransferToSubAccountServiceAPI)(nil).Amount), amount)
+}
+
+// Asset mocks base method.
+func (m *MockTransferToSubAccountServiceAPI) Asset(asset string) binance.TransferToSubAccountServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Asset", asset)
+	ret0, _ := ret[0].(binance.TransferToSubAccountServiceAPI)
+	return ret0
+}
+
+// Asset indicates an expected call of Asset.
+func (mr *MockTransferToSubAccountServiceAPIMockRecorder) Asset(asset interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Asset", reflect.TypeOf((*MockTransferToSubAccountServiceAPI)(nil).Asset), asset)
+}
+
+// Do mocks base method.
+func (m *MockTransferToSubAccountServiceAPI) Do(ctx context.Context, opts ...binance.RequestOption) (*binance.TransferToSubAccountResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*binance.TransferToSubAccountResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockTransferToSubAccountServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockTransferToSubAccountServiceAPI)(nil).Do), varargs...)
+}
+
+// ToEmail mocks base method.
+func (m *MockTransferToSubAccountServiceAPI) ToEmail(toEmail string) binance.TransferToSubAccountServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ToEmail", toEmail)
+	ret0, _ := ret[0].(binance.TransferToSubAccountServiceAPI)
+	return ret0
+}
+
+// ToEmail indicates an expected call of ToEmail.
+func (mr *MockTransferToSubAccountServiceAPIMockRecorder) ToEmail(toEmail interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ToEmail", reflect.TypeOf((*MockTransferToSubAccountServiceAPI)(nil).ToEmail), toEmail)
+}
+
+// MockSubaccountAssetsServiceAPI is a mock of SubaccountAssetsServiceAPI interface.
+type MockSubaccountAssetsServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockSubaccountAssetsServiceAPIMockRecorder
+}
+
+// MockSubaccountAssetsServiceAPIMockRecorder is the mock recorder for MockSubaccountAssetsServiceAPI.
+type MockSubaccountAssetsServiceAPIMockRecorder struct {
+	mock *MockSubaccountAssetsServiceAPI
+}
+
+// NewMockSubaccountAssetsServiceAPI creates a new mock instance.
+func NewMockSubaccountAssetsServiceAPI(ctrl *gomock.Controller) *MockSubaccountAssetsServiceAPI {
+	mock := &MockSubaccountAssetsServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockSubaccountAssetsServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSubaccountAssetsServiceAPI) EXPECT() *MockSubaccountAssetsServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockSubaccountAssetsServiceAPI) Do(ctx context.Context, opts ...binance.RequestOption) (*binance.SubaccountAssetsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*binance.SubaccountAssetsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockSubaccountAssetsServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockSubaccountAssetsServiceAPI)(nil).Do), varargs...)
+}
+
+// Email mocks base method.
+func (m *MockSubaccountAssetsServiceAPI) Email(email string) binance.SubaccountAssetsServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Email", email)
+	ret0, _ := ret[0].(binance.SubaccountAssetsServiceAPI)
+	return ret0
+}
+
+// Email indicates an expected call of Email.
+func (mr *MockSubaccountAssetsServiceAPIMockRecorder) Email(email interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Email", reflect.TypeOf((*MockSubaccountAssetsServiceAPI)(nil).Email), email)
+}
+
+// MockSubaccountSpotSummaryServiceAPI is a mock of SubaccountSpotSummaryServiceAPI interface.
+type MockSubaccountSpotSummaryServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockSubaccountSpotSummaryServiceAPIMockRecorder
+}
+
+// MockSubaccountSpotSummaryServiceAPIMockRecorder is the mock recorder for MockSubaccountSpotSummaryServiceAPI.
+type MockSubaccountSpotSummaryServiceAPIMockRecorder struct {
+	mock *MockSubaccountSpotSummaryServiceAPI
+}
+
+// NewMockSubaccountSpotSummaryServiceAPI creates a new mock instance.
+func NewMockSubaccountSpotSummaryServiceAPI(ctrl *gomock.Controller) *MockSubaccountSpotSummaryServiceAPI {
+	mock := &MockSubaccountSpotSummaryServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockSubaccountSpotSummaryServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSubaccountSpotSummaryServiceAPI) EXPECT() *MockSubaccountSpotSummaryServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockSubaccountSpotSummaryServiceAPI) Do(ctx context.Context, opts ...binance.RequestOption) (*binance.SubaccountSpotSummaryResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*binance.SubaccountSpotSummaryResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockSubaccountSpotSummaryServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockSubaccountSpotSummaryServiceAPI)(nil).Do), varargs...)
+}
+
+// Email mocks base method.
+func (m *MockSubaccountSpotSummaryServiceAPI) Email(email string) binance.SubaccountSpotSummaryServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Email", email)
+	ret0, _ := ret[0].(binance.SubaccountSpotSummaryServiceAPI)
+	return ret0
+}
+
+// Email indicates an expected call of Email.
+func (mr *MockSubaccountSpotSummaryServiceAPIMockRecorder) Email(email interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Email", reflect.TypeOf((*MockSubaccountSpotSummaryServiceAPI)(nil).Email), email)
+}
+
+// Page mocks base method.
+func (m *MockSubaccountSpotSummaryServiceAPI) Page(page int32) binance.SubaccountSpotSummaryServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Page", page)
+	ret0, _ := ret[0].(binance.SubaccountSpotSummaryServiceAPI)
+	return ret0
+}
+
+// Page indicates an expected call of Page.
+func (mr *MockSubaccountSpotSummaryServiceAPIMockRecorder) Page(page interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Page", reflect.TypeOf((*MockSubaccountSpotSummaryServiceAPI)(nil).Page), page)
+}
+
+// Size mocks base method.
+func (m *MockSubaccountSpotSummaryServiceAPI) Size(size int32) binance.SubaccountSpotSummaryServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Size", size)
+	ret0, _ := ret[0].(binance.SubaccountSpotSummaryServiceAPI)
+	return ret0
+}
+
+// Size indicates an expected call of Size.
+func (mr *MockSubaccountSpotSummaryServiceAPIMockRecorder) Size(size interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Size", reflect.TypeOf((*MockSubaccountSpotSummaryServiceAPI)(nil).Size), size)
+}
+
+// MockSubaccountDepositAddressServiceAPI is a mock of SubaccountDepositAddressServiceAPI interface.
+type MockSubaccountDepositAddressServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockSubaccountDepositAddressServiceAPIMockRecorder
+}
+
+// MockSubaccountDepositAddressServiceAPIMockRecorder is the mock recorder for MockSubaccountDepositAddressServiceAPI.
+type MockSubaccountDepositAddressServiceAPIMockRecorder struct {
+	mock *MockSubaccountDepositAddressServiceAPI
+}
+
+// NewMockSubaccountDepositAddressServiceAPI creates a new mock instance.
+func NewMockSubaccountDepositAddressServiceAPI(ctrl *gomock.Controller) *MockSubaccountDepositAddressServiceAPI {
+	mock := &MockSubaccountDepositAddressServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockSubaccountDepositAddressServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSubaccountDepositAddressServiceAPI) EXPECT() *MockSubaccountDepositAddressServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Coin mocks base method.
+func (m *MockSubaccountDepositAddressServiceAPI) Coin(coin string) binance.SubaccountDepositAddressServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Coin", coin)
+	ret0, _ := ret[0].(binance.SubaccountDepositAddressServiceAPI)
+	return ret0
+}
+
+// Coin indicates an expected call of Coin.
+func (mr *MockSubaccountDepositAddressServiceAPIMockRecorder) Coin(coin interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Coin", reflect.TypeOf((*MockSubaccountDepositAddressServiceAPI)(nil).Coin), coin)
+}
+
+// Do mocks base method.
+func (m *MockSubaccountDepositAddressServiceAPI) Do(ctx context.Context, opts ...binance.RequestOption) (*binance.SubaccountDepositAddressResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*binance.SubaccountDepositAddressResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockSubaccountDepositAddressServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockSubaccountDepositAddressServiceAPI)(nil).Do), varargs...)
+}
+
+// Email mocks base method.
+func (m *MockSubaccountDepositAddressServiceAPI) Email(email string) binance.SubaccountDepositAddressServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Email", email)
+	ret0, _ := ret[0].(binance.SubaccountDepositAddressServiceAPI)
+	return ret0
+}
+
+// Email indicates an expected call of Email.
+func (mr *MockSubaccountDepositAddressServiceAPIMockRecorder) Email(email interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Email", reflect.TypeOf((*MockSubaccountDepositAddressServiceAPI)(nil).Email), email)
+}
+
+// Network mocks base method.
+func (m *MockSubaccountDepositAddressServiceAPI) Network(network string) binance.SubaccountDepositAddressServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Network", network)
+	ret0, _ := ret[0].(binance.SubaccountDepositAddressServiceAPI)
+	return ret0
+}
+
+// Network indicates an expected call of Network.
+func (mr *MockSubaccountDepositAddressServiceAPIMockRecorder) Network(network interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Network", reflect.TypeOf((*MockSubaccountDepositAddressServiceAPI)(nil).Network), network)
+}
+
+// MockAssetDividendServiceAPI is a mock of AssetDividendServiceAPI interface.
+type MockAssetDividendServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockAssetDividendServiceAPIMockRecorder
+}
+
+// MockAssetDividendServiceAPIMockRecorder is the mock recorder for MockAssetDividendServiceAPI.
+type MockAssetDividendServiceAPIMockRecorder struct {
+	mock *MockAssetDividendServiceAPI
+}
+
+// NewMockAssetDividendServiceAPI creates a new mock instance.
+func NewMockAssetDividendServiceAPI(ctrl *gomock.Controller) *MockAssetDividendServiceAPI {
+	mock := &MockAssetDividendServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockAssetDividendServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAssetDividendServiceAPI) EXPECT() *MockAssetDividendServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Asset mocks base method.
+func (m *MockAssetDividendServiceAPI) Asset(asset string) binance.AssetDividendServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Asset", asset)
+	ret0, _ := ret[0].(binance.AssetDividendServiceAPI)
+	return ret0
+}
+
+// Asset indicates an expected call of Asset.
+func (mr *MockAssetDividendServiceAPIMockRecorder) Asset(asset interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Asset", reflect.TypeOf((*MockAssetDividendServiceAPI)(nil).Asset), asset)
+}
+
+// Do mocks base method.
+func (m *MockAssetDividendServiceAPI) Do(ctx context.Context) (*binance.DividendResponseWrapper, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Do", ctx)
+	ret0, _ := ret[0].(*binance.DividendResponseWrapper)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockAssetDividendServiceAPIMockRecorder) Do(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockAssetDividendServiceAPI)(nil).Do), ctx)
+}
+
+// EndTime mocks base method.
+func (m *MockAssetDividendServiceAPI) EndTime(endTime int64) binance.AssetDividendServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EndTime", endTime)
+	ret0, _ := ret[0].(binance.AssetDividendServiceAPI)
+	return ret0
+}
+
+// EndTime indicates an expected call of EndTime.
+func (mr *MockAssetDividendServiceAPIMockRecorder) EndTime(endTime interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EndTime", reflect.TypeOf((*MockAssetDividendServiceAPI)(nil).EndTime), endTime)
+}
+
+// Limit mocks base method.
+func (m *MockAssetDividendServiceAPI) Limit(limit int) binance.AssetDividendServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Limit", limit)
+	ret0, _ := ret[0].(binance.AssetDividendServiceAPI)
+	return ret0
+}
+
+// Limit indicates an expected call of Limit.
+func (mr *MockAssetDividendServiceAPIMockRecorder) Limit(limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Limit", reflect.TypeOf((*MockAssetDividendServiceAPI)(nil).Limit), limit)
+}
+
+// StartTime mocks base method.
+func (m *MockAssetDividendServiceAPI) StartTime(startTime int64) binance.AssetDividendServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartTime", startTime)
+	ret0, _ := ret[0].(binance.AssetDividendServiceAPI)
+	return ret0
+}
+
+// StartTime indicates an expected call of StartTime.
+func (mr *MockAssetDividendServiceAPIMockRecorder) StartTime(startTime interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartTime", reflect.TypeOf((*MockAssetDividendServiceAPI)(nil).StartTime), startTime)
+}
+
+// MockCreateUserUniversalTransferServiceAPI is a mock of CreateUserUniversalTransferServiceAPI interface.
+type MockCreateUserUniversalTransferServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockCreateUserUniversalTransferServiceAPIMockRecorder
+}
+
+// MockCreateUserUniversalTransferServiceAPIMockRecorder is the mock recorder for MockCreateUserUniversalTransferServiceAPI.
+type MockCreateUserUniversalTransferServiceAPIMockRecorder struct {
+	mock *MockCreateUserUniversalTransferServiceAPI
+}
+
+// NewMockCreateUserUniversalTransferServiceAPI creates a new mock instance.
+func NewMockCreateUserUniversalTransferServiceAPI(ctrl *gomock.Controller) *MockCreateUserUniversalTransferServiceAPI {
+	mock := &MockCreateUserUniversalTransferServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockCreateUserUniversalTransferServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCreateUserUniversalTransferServiceAPI) EXPECT() *MockCreateUserUniversalTransferServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Amount mocks base method.
+func (m *MockCreateUserUniversalTransferServiceAPI) Amount(v string) binance.CreateUserUniversalTransferServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Amount", v)
+	ret0, _ := ret[0].(binance.CreateUserUniversalTransferServiceAPI)
+	return ret0
+}
+
+// Amount indicates an expected call of Amount.
+func (mr *MockCreateUserUniversalTransferServiceAPIMockRecorder) Amount(v interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Amount", reflect.TypeOf((*MockCreateUserUniversalTransferServiceAPI)(nil).Amount), v)
+}
+
+// Asset mocks base method.
+func (m *MockCreateUserUniversalTransferServiceAPI) Asset(v string) binance.CreateUserUniversalTransferServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Asset", v)
+	ret0, _ := ret[0].(binance.CreateUserUniversalTransferServiceAPI)
+	return ret0
+}
+
+// Asset indicates an expected call of Asset.
+func (mr *MockCreateUserUniversalTransferServiceAPIMockRecorder) Asset(v interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Asset", reflect.TypeOf((*MockCreateUserUniversalTransferServiceAPI)(nil).Asset), v)
+}
+
+// Do mocks base method.
+func (m *MockCreateUserUniversalTransferServiceAPI) Do(ctx context.Context) (*binance.CreateUserUniversalTransferResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Do", ctx)
+	ret0, _ := ret[0].(*binance.CreateUserUniversalTransferResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockCreateUserUniversalTransferServiceAPIMockRecorder) Do(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockCreateUserUniversalTransferServiceAPI)(nil).Do), ctx)
+}
+
+// FromSymbol mocks base method.
+func (m *MockCreateUserUniversalTransferServiceAPI) FromSymbol(v string) binance.CreateUserUniversalTransferServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FromSymbol", v)
+	ret0, _ := ret[0].(binance.CreateUserUniversalTransferServiceAPI)
+	return ret0
+}
+
+// FromSymbol indicates an expected call of FromSymbol.
+func (mr *MockCreateUserUniversalTransferServiceAPIMockRecorder) FromSymbol(v interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FromSymbol", reflect.TypeOf((*MockCreateUserUniversalTransferServiceAPI)(nil).FromSymbol), v)
+}
+
+// ToSymbol mocks base method.
+func (m *MockCreateUserUniversalTransferServiceAPI) ToSymbol(v string) binance.CreateUserUniversalTransferServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ToSymbol", v)
+	ret0, _ := ret[0].(binance.CreateUserUniversalTransferServiceAPI)
+	return ret0
+}
+
+// ToSymbol indicates an expected call of ToSymbol.
+func (mr *MockCreateUserUniversalTransferServiceAPIMockRecorder) ToSymbol(v interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ToSymbol", reflect.TypeOf((*MockCreateUserUniversalTransferServiceAPI)(nil).ToSymbol), v)
+}
+
+// Type mocks base method.
+func (m *MockCreateUserUniversalTransferServiceAPI) Type(v binance.UserUniversalTransferType) binance.CreateUserUniversalTransferServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Type", v)
+	ret0, _ := ret[0].(binance.CreateUserUniversalTransferServiceAPI)
+	return ret0
+}
+
+// Type indicates an expected call of Type.
+func (mr *MockCreateUserUniversalTransferServiceAPIMockRecorder) Type(v interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Type", reflect.TypeOf((*MockCreateUserUniversalTransferServiceAPI)(nil).Type), v)
+}
+
+// MockGetAllCoinsInfoServiceAPI is a mock of GetAllCoinsInfoServiceAPI interface.
+type MockGetAllCoinsInfoServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockGetAllCoinsInfoServiceAPIMockRecorder
+}
+
+// MockGetAllCoinsInfoServiceAPIMockRecorder is the mock recorder for MockGetAllCoinsInfoServiceAPI.
+type MockGetAllCoinsInfoServiceAPIMockRecorder struct {
+	mock *MockGetAllCoinsInfoServiceAPI
+}
+
+// NewMockGetAllCoinsInfoServiceAPI creates a new mock instance.
+func NewMockGetAllCoinsInfoServiceAPI(ctrl *gomock.Controller) *MockGetAllCoinsInfoServiceAPI {
+	mock := &MockGetAllCoinsInfoServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockGetAllCoinsInfoServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGetAllCoinsInfoServiceAPI) EXPECT() *MockGetAllCoinsInfoServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockGetAllCoinsInfoServiceAPI) Do(ctx context.Context) ([]*binance.CoinInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Do", ctx)
+	ret0, _ := ret[0].([]*binance.CoinInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockGetAllCoinsInfoServiceAPIMockRecorder) Do(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockGetAllCoinsInfoServiceAPI)(nil).Do), ctx)
+}
+
+// MockGetAllMarginAssetsServiceAPI is a mock of GetAllMarginAssetsServiceAPI interface.
+type MockGetAllMarginAssetsServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockGetAllMarginAssetsServiceAPIMockRecorder
+}
+
+// MockGetAllMarginAssetsServiceAPIMockRecorder is the mock recorder for MockGetAllMarginAssetsServiceAPI.
+type MockGetAllMarginAssetsServiceAPIMockRecorder struct {
+	mock *MockGetAllMarginAssetsServiceAPI
+}
+
+// NewMockGetAllMarginAssetsServiceAPI creates a new mock instance.
+func NewMockGetAllMarginAssetsServiceAPI(ctrl *gomock.Controller) *MockGetAllMarginAssetsServiceAPI {
+	mock := &MockGetAllMarginAssetsServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockGetAllMarginAssetsServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGetAllMarginAssetsServiceAPI) EXPECT() *MockGetAllMarginAssetsServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockGetAllMarginAssetsServiceAPI) Do(ctx context.Context, opts ...binance.RequestOption) ([]*binance.MarginAsset, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].([]*binance.MarginAsset)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockGetAllMarginAssetsServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockGetAllMarginAssetsServiceAPI)(nil).Do), varargs...)
+}
+
+// MockFiatDepositWithdrawHistoryServiceAPI is a mock of FiatDepositWithdrawHistoryServiceAPI interface.
+type MockFiatDepositWithdrawHistoryServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockFiatDepositWithdrawHistoryServiceAPIMockRecorder
+}
+
+// MockFiatDepositWithdrawHistoryServiceAPIMockRecorder is the mock recorder for MockFiatDepositWithdrawHistoryServiceAPI.
+type MockFiatDepositWithdrawHistoryServiceAPIMockRecorder struct {
+	mock *MockFiatDepositWithdrawHistoryServiceAPI
+}
+
+// NewMockFiatDepositWithdrawHistoryServiceAPI creates a new mock instance.
+func NewMockFiatDepositWithdrawHistoryServiceAPI(ctrl *gomock.Controller) *MockFiatDepositWithdrawHistoryServiceAPI {
+	mock := &MockFiatDepositWithdrawHistoryServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockFiatDepositWithdrawHistoryServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockFiatDepositWithdrawHistoryServiceAPI) EXPECT() *MockFiatDepositWithdrawHistoryServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// BeginTime mocks base method.
+func (m *MockFiatDepositWithdrawHistoryServiceAPI) BeginTime(beginTime int64) binance.FiatDepositWithdrawHistoryServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BeginTime", beginTime)
+	ret0, _ := ret[0].(binance.FiatDepositWithdrawHistoryServiceAPI)
+	return ret0
+}
+
+// BeginTime indicates an expected call of BeginTime.
+func (mr *MockFiatDepositWithdrawHistoryServiceAPIMockRecorder) BeginTime(beginTime interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BeginTime", reflect.TypeOf((*MockFiatDepositWithdrawHistoryServiceAPI)(nil).BeginTime), beginTime)
+}
+
+// Do mocks base method.
+func (m *MockFiatDepositWithdrawHistoryServiceAPI) Do(ctx context.Context, opts ...binance.RequestOption) (*binance.FiatDepositWithdrawHistory, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*binance.FiatDepositWithdrawHistory)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockFiatDepositWithdrawHistoryServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockFiatDepositWithdrawHistoryServiceAPI)(nil).Do), varargs...)
+}
+
+// EndTime mocks base method.
+func (m *MockFiatDepositWithdrawHistoryServiceAPI) EndTime(endTime int64) binance.FiatDepositWithdrawHistoryServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EndTime", endTime)
+	ret0, _ := ret[0].(binance.FiatDepositWithdrawHistoryServiceAPI)
+	return ret0
+}
+
+// EndTime indicates an expected call of EndTime.
+func (mr *MockFiatDepositWithdrawHistoryServiceAPIMockRecorder) EndTime(endTime interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EndTime", reflect.TypeOf((*MockFiatDepositWithdrawHistoryServiceAPI)(nil).EndTime), endTime)
+}
+
+// Page mocks base method.
+func (m *MockFiatDepositWithdrawHistoryServiceAPI) Page(page int32) binance.FiatDepositWithdrawHistoryServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Page", page)
+	ret0, _ := ret[0].(binance.FiatDepositWithdrawHistoryServiceAPI)
+	return ret0
+}
+
+// Page indicates an expected call of Page.
+func (mr *MockFiatDepositWithdrawHistoryServiceAPIMockRecorder) Page(page interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Page", reflect.TypeOf((*MockFiatDepositWithdrawHistoryServiceAPI)(nil).Page), page)
+}
+
+// Rows mocks base method.
+func (m *MockFiatDepositWithdrawHistoryServiceAPI) Rows(rows int32) binance.FiatDepositWithdrawHistoryServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Rows", rows)
+	ret0, _ := ret[0].(binance.FiatDepositWithdrawHistoryServiceAPI)
+	return ret0
+}
+
+// Rows indicates an expected call of Rows.
+func (mr *MockFiatDepositWithdrawHistoryServiceAPIMockRecorder) Rows(rows interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Rows", reflect.TypeOf((*MockFiatDepositWithdrawHistoryServiceAPI)(nil).Rows), rows)
+}
+
+// TransactionType mocks base method.
+func (m *MockFiatDepositWithdrawHistoryServiceAPI) TransactionType(transactionType binance.TransactionType) binance.FiatDepositWithdrawHistoryServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TransactionType", transactionType)
+	ret0, _ := ret[0].(binance.FiatDepositWithdrawHistoryServiceAPI)
+	return ret0
+}
+
+// TransactionType indicates an expected call of TransactionType.
+func (mr *MockFiatDepositWithdrawHistoryServiceAPIMockRecorder) TransactionType(transactionType interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TransactionType", reflect.TypeOf((*MockFiatDepositWithdrawHistoryServiceAPI)(nil).TransactionType), transactionType)
+}
+
+// MockFiatPaymentsHistoryServiceAPI is a mock of FiatPaymentsHistoryServiceAPI interface.
+type MockFiatPaymentsHistoryServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockFiatPaymentsHistoryServiceAPIMockRecorder
+}
+
+// MockFiatPaymentsHistoryServiceAPIMockRecorder is the mock recorder for MockFiatPaymentsHistoryServiceAPI.
+type MockFiatPaymentsHistoryServiceAPIMockRecorder struct {
+	mock *MockFiatPaymentsHistoryServiceAPI
+}
+
+// NewMockFiatPaymentsHistoryServiceAPI creates a new mock instance.
+func NewMockFiatPaymentsHistoryServiceAPI(ctrl *gomock.Controller) *MockFiatPaymentsHistoryServiceAPI {
+	mock := &MockFiatPaymentsHistoryServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockFiatPaymentsHistoryServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockFiatPaymentsHistoryServiceAPI) EXPECT() *MockFiatPaymentsHistoryServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// BeginTime mocks base method.
+func (m *MockFiatPaymentsHistoryServiceAPI) BeginTime(beginTime int64) binance.FiatPaymentsHistoryServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BeginTime", beginTime)
+	ret0, _ := ret[0].(binance.FiatPaymentsHistoryServiceAPI)
+	return ret0
+}
+
+// BeginTime indicates an expected call of BeginTime.
+func (mr *MockFiatPaymentsHistoryServiceAPIMockRecorder) BeginTime(beginTime interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BeginTime", reflect.TypeOf((*MockFiatPaymentsHistoryServiceAPI)(nil).BeginTime), beginTime)
+}
+
+// Do mocks base method.
+func (m *MockFiatPaymentsHistoryServiceAPI) Do(ctx context.Context, opts ...binance.RequestOption) (*binance.FiatPaymentsHistory, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*binance.FiatPaymentsHistory)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockFiatPaymentsHistoryServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockFiatPaymentsHistoryServiceAPI)(nil).Do), varargs...)
+}
+
+// EndTime mocks base method.
+func (m *MockFiatPaymentsHistoryServiceAPI) EndTime(endTime int64) binance.FiatPaymentsHistoryServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EndTime", endTime)
+	ret0, _ := ret[0].(binance.FiatPaymentsHistoryServiceAPI)
+	return ret0
+}
+
+// EndTime indicates an expected call of EndTime.
+func (mr *MockFiatPaymentsHistoryServiceAPIMockRecorder) EndTime(endTime interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EndTime", reflect.TypeOf((*MockFiatPaymentsHistoryServiceAPI)(nil).EndTime), endTime)
+}
+
+// Page mocks base method.
+func (m *MockFiatPaymentsHistoryServiceAPI) Page(page int32) binance.FiatPaymentsHistoryServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Page", page)
+	ret0, _ := ret[0].(binance.FiatPaymentsHistoryServiceAPI)
+	return ret0
+}
+
+// Page indicates an expected call of Page.
+func (mr *MockFiatPaymentsHistoryServiceAPIMockRecorder) Page(page interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Page", reflect.TypeOf((*MockFiatPaymentsHistoryServiceAPI)(nil).Page), page)
+}
+
+// Rows mocks base method.
+func (m *MockFiatPaymentsHistoryServiceAPI) Rows(rows int32) binance.FiatPaymentsHistoryServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Rows", rows)
+	ret0, _ := ret[0].(binance.FiatPaymentsHistoryServiceAPI)
+	return ret0
+}
+
+// Rows indicates an expected call of Rows.
+func (mr *MockFiatPaymentsHistoryServiceAPIMockRecorder) Rows(rows interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Rows", reflect.TypeOf((*MockFiatPaymentsHistoryServiceAPI)(nil).Rows), rows)
+}
+
+// TransactionType mocks base method.
+func (m *MockFiatPaymentsHistoryServiceAPI) TransactionType(transactionType binance.TransactionType) binance.FiatPaymentsHistoryServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TransactionType", transactionType)
+	ret0, _ := ret[0].(binance.FiatPaymentsHistoryServiceAPI)
+	return ret0
+}
+
+// TransactionType indicates an expected call of TransactionType.
+func (mr *MockFiatPaymentsHistoryServiceAPIMockRecorder) TransactionType(transactionType interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TransactionType", reflect.TypeOf((*MockFiatPaymentsHistoryServiceAPI)(nil).TransactionType), transactionType)
+}
+
+// MockPayTradeHistoryServiceAPI is a mock of PayTradeHistoryServiceAPI interface.
+type MockPayTradeHistoryServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockPayTradeHistoryServiceAPIMockRecorder
+}
+
+// MockPayTradeHistoryServiceAPIMockRecorder is the mock recorder for MockPayTradeHistoryServiceAPI.
+type MockPayTradeHistoryServiceAPIMockRecorder struct {
+	mock *MockPayTradeHistoryServiceAPI
+}
+
+// NewMockPayTradeHistoryServiceAPI creates a new mock instance.
+func NewMockPayTradeHistoryServiceAPI(ctrl *gomock.Controller) *MockPayTradeHistoryServiceAPI {
+	mock := &MockPayTradeHistoryServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockPayTradeHistoryServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPayTradeHistoryServiceAPI) EXPECT() *MockPayTradeHistoryServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockPayTradeHistoryServiceAPI) Do(ctx context.Context, opts ...binance.RequestOption) (*binance.PayTradeHistory, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*binance.PayTradeHistory)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockPayTradeHistoryServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockPayTradeHistoryServiceAPI)(nil).Do), varargs...)
+}
+
+// EndTimestamp mocks base method.
+func (m *MockPayTradeHistoryServiceAPI) EndTimestamp(endTimestamp int64) binance.PayTradeHistoryServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EndTimestamp", endTimestamp)
+	ret0, _ := ret[0].(binance.PayTradeHistoryServiceAPI)
+	return ret0
+}
+
+// EndTimestamp indicates an expected call of EndTimestamp.
+func (mr *MockPayTradeHistoryServiceAPIMockRecorder) EndTimestamp(endTimestamp interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EndTimestamp", reflect.TypeOf((*MockPayTradeHistoryServiceAPI)(nil).EndTimestamp), endTimestamp)
+}
+
+// Limit mocks base method.
+func (m *MockPayTradeHistoryServiceAPI) Limit(limit int32) binance.PayTradeHistoryServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Limit", limit)
+	ret0, _ := ret[0].(binance.PayTradeHistoryServiceAPI)
+	return ret0
+}
+
+// Limit indicates an expected call of Limit.
+func (mr *MockPayTradeHistoryServiceAPIMockRecorder) Limit(limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Limit", reflect.TypeOf((*MockPayTradeHistoryServiceAPI)(nil).Limit), limit)
+}
+
+// StartTimestamp mocks base method.
+func (m *MockPayTradeHistoryServiceAPI) StartTimestamp(startTimestamp int64) binance.PayTradeHistoryServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartTimestamp", startTimestamp)
+	ret0, _ := ret[0].(binance.PayTradeHistoryServiceAPI)
+	return ret0
+}
+
+// StartTimestamp indicates an expected call of StartTimestamp.
+func (mr *MockPayTradeHistoryServiceAPIMockRecorder) StartTimestamp(startTimestamp interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartTimestamp", reflect.TypeOf((*MockPayTradeHistoryServiceAPI)(nil).StartTimestamp), startTimestamp)
+}
+
+// MockSpotRebateHistoryServiceAPI is a mock of SpotRebateHistoryServiceAPI interface.
+type MockSpotRebateHistoryServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockSpotRebateHistoryServiceAPIMockRecorder
+}
+
+// MockSpotRebateHistoryServiceAPIMockRecorder is the mock recorder for MockSpotRebateHistoryServiceAPI.
+type MockSpotRebateHistoryServiceAPIMockRecorder struct {
+	mock *MockSpotRebateHistoryServiceAPI
+}
+
+// NewMockSpotRebateHistoryServiceAPI creates a new mock instance.
+func NewMockSpotRebateHistoryServiceAPI(ctrl *gomock.Controller) *MockSpotRebateHistoryServiceAPI {
+	mock := &MockSpotRebateHistoryServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockSpotRebateHistoryServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSpotRebateHistoryServiceAPI) EXPECT() *MockSpotRebateHistoryServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockSpotRebateHistoryServiceAPI) Do(ctx context.Context, opts ...binance.RequestOption) (*binance.SpotRebateHistory, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*binance.SpotRebateHistory)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockSpotRebateHistoryServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockSpotRebateHistoryServiceAPI)(nil).Do), varargs...)
+}
+
+// EndTime mocks base method.
+func (m *MockSpotRebateHistoryServiceAPI) EndTime(endTime int64) binance.SpotRebateHistoryServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EndTime", endTime)
+	ret0, _ := ret[0].(binance.SpotRebateHistoryServiceAPI)
+	return ret0
+}
+
+// EndTime indicates an expected call of EndTime.
+func (mr *MockSpotRebateHistoryServiceAPIMockRecorder) EndTime(endTime interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EndTime", reflect.TypeOf((*MockSpotRebateHistoryServiceAPI)(nil).EndTime), endTime)
+}
+
+// Page mocks base method.
+func (m *MockSpotRebateHistoryServiceAPI) Page(page int32) binance.SpotRebateHistoryServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Page", page)
+	ret0, _ := ret[0].(binance.SpotRebateHistoryServiceAPI)
+	return ret0
+}
+
+// Page indicates an expected call of Page.
+func (mr *MockSpotRebateHistoryServiceAPIMockRecorder) Page(page interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Page", reflect.TypeOf((*MockSpotRebateHistoryServiceAPI)(nil).Page), page)
+}
+
+// StartTime mocks base method.
+func (m *MockSpotRebateHistoryServiceAPI) StartTime(startTime int64) binance.SpotRebateHistoryServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartTime", startTime)
+	ret0, _ := ret[0].(binance.SpotRebateHistoryServiceAPI)
+	return ret0
+}
+
+// StartTime indicates an expected call of StartTime.
+func (mr *MockSpotRebateHistoryServiceAPIMockRecorder) StartTime(startTime interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartTime", reflect.TypeOf((*MockSpotRebateHistoryServiceAPI)(nil).StartTime), startTime)
+}
+
+// MockConvertTradeHistoryServiceAPI is a mock of ConvertTradeHistoryServiceAPI interface.
+type MockConvertTradeHistoryServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockConvertTradeHistoryServiceAPIMockRecorder
+}
+
+// MockConvertTradeHistoryServiceAPIMockRecorder is the mock recorder for MockConvertTradeHistoryServiceAPI.
+type MockConvertTradeHistoryServiceAPIMockRecorder struct {
+	mock *MockConvertTradeHistoryServiceAPI
+}
+
+// NewMockConvertTradeHistoryServiceAPI creates a new mock instance.
+func NewMockConvertTradeHistoryServiceAPI(ctrl *gomock.Controller) *MockConvertTradeHistoryServiceAPI {
+	mock := &MockConvertTradeHistoryServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockConvertTradeHistoryServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockConvertTradeHistoryServiceAPI) EXPECT() *MockConvertTradeHistoryServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockConvertTradeHistoryServiceAPI) Do(ctx context.Context, opts ...binance.RequestOption) (*binance.ConvertTradeHistory, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*binance.ConvertTradeHistory)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockConvertTradeHistoryServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockConvertTradeHistoryServiceAPI)(nil).Do), varargs...)
+}
+
+// EndTime mocks base method.
+func (m *MockConvertTradeHistoryServiceAPI) EndTime(endTime int64) binance.ConvertTradeHistoryServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EndTime", endTime)
+	ret0, _ := ret[0].(binance.ConvertTradeHistoryServiceAPI)
+	return ret0
+}
+
+// EndTime indicates an expected call of EndTime.
+func (mr *MockConvertTradeHistoryServiceAPIMockRecorder) EndTime(endTime interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EndTime", reflect.TypeOf((*MockConvertTradeHistoryServiceAPI)(nil).EndTime), endTime)
+}
+
+// Limit mocks base method.
+func (m *MockConvertTradeHistoryServiceAPI) Limit(limit int32) binance.ConvertTradeHistoryServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Limit", limit)
+	ret0, _ := ret[0].(binance.ConvertTradeHistoryServiceAPI)
+	return ret0
+}
+
+// Limit indicates an expected call of Limit.
+func (mr *MockConvertTradeHistoryServiceAPIMockRecorder) Limit(limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Limit", reflect.TypeOf((*MockConvertTradeHistoryServiceAPI)(nil).Limit), limit)
+}
+
+// StartTime mocks base method.
+func (m *MockConvertTradeHistoryServiceAPI) StartTime(startTime int64) binance.ConvertTradeHistoryServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartTime", startTime)
+	ret0, _ := ret[0].(binance.ConvertTradeHistoryServiceAPI)
+	return ret0
+}
+
+// StartTime indicates an expected call of StartTime.
+func (mr *MockConvertTradeHistoryServiceAPIMockRecorder) StartTime(startTime interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartTime", reflect.TypeOf((*MockConvertTradeHistoryServiceAPI)(nil).StartTime), startTime)
+}
+
+// MockConvertExchangeInfoServiceAPI is a mock of ConvertExchangeInfoServiceAPI interface.
+type MockConvertExchangeInfoServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockConvertExchangeInfoServiceAPIMockRecorder
+}
+
+// MockConvertExchangeInfoServiceAPIMockRecorder is the mock recorder for MockConvertExchangeInfoServiceAPI.
+type MockConvertExchangeInfoServiceAPIMockRecorder struct {
+	mock *MockConvertExchangeInfoServiceAPI
+}
+
+// NewMockConvertExchangeInfoServiceAPI creates a new mock instance.
+func NewMockConvertExchangeInfoServiceAPI(ctrl *gomock.Controller) *MockConvertExchangeInfoServiceAPI {
+	mock := &MockConvertExchangeInfoServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockConvertExchangeInfoServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockConvertExchangeInfoServiceAPI) EXPECT() *MockConvertExchangeInfoServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockConvertExchangeInfoServiceAPI) Do(ctx context.Context, opts ...binance.RequestOption) ([]*binance.ConvertExchangeInfo, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].([]*binance.ConvertExchangeInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockConvertExchangeInfoServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockConvertExchangeInfoServiceAPI)(nil).Do), varargs...)
+}
+
+// FromAsset mocks base method.
+func (m *MockConvertExchangeInfoServiceAPI) FromAsset(fromAsset string) binance.ConvertExchangeInfoServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FromAsset", fromAsset)
+	ret0, _ := ret[0].(binance.ConvertExchangeInfoServiceAPI)
+	return ret0
+}
+
+// FromAsset indicates an expected call of FromAsset.
+func (mr *MockConvertExchangeInfoServiceAPIMockRecorder) FromAsset(fromAsset interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FromAsset", reflect.TypeOf((*MockConvertExchangeInfoServiceAPI)(nil).FromAsset), fromAsset)
+}
+
+// ToAsset mocks base method.
+func (m *MockConvertExchangeInfoServiceAPI) ToAsset(toAsset string) binance.ConvertExchangeInfoServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ToAsset", toAsset)
+	ret0, _ := ret[0].(binance.ConvertExchangeInfoServiceAPI)
+	return ret0
+}
+
+// ToAsset indicates an expected call of ToAsset.
+func (mr *MockConvertExchangeInfoServiceAPIMockRecorder) ToAsset(toAsset interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ToAsset", reflect.TypeOf((*MockConvertExchangeInfoServiceAPI)(nil).ToAsset), toAsset)
+}
+
+// MockConvertAssetInfoServiceAPI is a mock of ConvertAssetInfoServiceAPI interface.
+type MockConvertAssetInfoServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockConvertAssetInfoServiceAPIMockRecorder
+}
+
+// MockConvertAssetInfoServiceAPIMockRecorder is the mock recorder for MockConvertAssetInfoServiceAPI.
+type MockConvertAssetInfoServiceAPIMockRecorder struct {
+	mock *MockConvertAssetInfoServiceAPI
+}
+
+// NewMockConvertAssetInfoServiceAPI creates a new mock instance.
+func NewMockConvertAssetInfoServiceAPI(ctrl *gomock.Controller) *MockConvertAssetInfoServiceAPI {
+	mock := &MockConvertAssetInfoServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockConvertAssetInfoServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockConvertAssetInfoServiceAPI) EXPECT() *MockConvertAssetInfoServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockConvertAssetInfoServiceAPI) Do(ctx context.Context, opts ...binance.RequestOption) ([]*binance.ConvertAssetInfo, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].([]*binance.ConvertAssetInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockConvertAssetInfoServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockConvertAssetInfoServiceAPI)(nil).Do), varargs...)
+}
+
+// MockConvertGetQuoteServiceAPI is a mock of ConvertGetQuoteServiceAPI interface.
+type MockConvertGetQuoteServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockConvertGetQuoteServiceAPIMockRecorder
+}
+
+// MockConvertGetQuoteServiceAPIMockRecorder is the mock recorder for MockConvertGetQuoteServiceAPI.
+type MockConvertGetQuoteServiceAPIMockRecorder struct {
+	mock *MockConvertGetQuoteServiceAPI
+}
+
+// NewMockConvertGetQuoteServiceAPI creates a new mock instance.
+func NewMockConvertGetQuoteServiceAPI(ctrl *gomock.Controller) *MockConvertGetQuoteServiceAPI {
+	mock := &MockConvertGetQuoteServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockConvertGetQuoteServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockConvertGetQuoteServiceAPI) EXPECT() *MockConvertGetQuoteServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockConvertGetQuoteServiceAPI) Do(ctx context.Context, opts ...binance.RequestOption) (*binance.ConvertQuote, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*binance.ConvertQuote)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockConvertGetQuoteServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockConvertGetQuoteServiceAPI)(nil).Do), varargs...)
+}
+
+// FromAmount mocks base method.
+func (m *MockConvertGetQuoteServiceAPI) FromAmount(fromAmount string) binance.ConvertGetQuoteServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FromAmount", fromAmount)
+	ret0, _ := ret[0].(binance.ConvertGetQuoteServiceAPI)
+	return ret0
+}
+
+// FromAmount indicates an expected call of FromAmount.
+func (mr *MockConvertGetQuoteServiceAPIMockRecorder) FromAmount(fromAmount interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FromAmount", reflect.TypeOf((*MockConvertGetQuoteServiceAPI)(nil).FromAmount), fromAmount)
+}
+
+// FromAsset mocks base method.
+func (m *MockConvertGetQuoteServiceAPI) FromAsset(fromAsset string) binance.ConvertGetQuoteServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FromAsset", fromAsset)
+	ret0, _ := ret[0].(binance.ConvertGetQuoteServiceAPI)
+	return ret0
+}
+
+// FromAsset indicates an expected call of FromAsset.
+func (mr *MockConvertGetQuoteServiceAPIMockRecorder) FromAsset(fromAsset interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FromAsset", reflect.TypeOf((*MockConvertGetQuoteServiceAPI)(nil).FromAsset), fromAsset)
+}
+
+// ToAmount mocks base method.
+func (m *MockConvertGetQuoteServiceAPI) ToAmount(toAmount string) binance.ConvertGetQuoteServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ToAmount", toAmount)
+	ret0, _ := ret[0].(binance.ConvertGetQuoteServiceAPI)
+	return ret0
+}
+
+// ToAmount indicates an expected call of ToAmount.
+func (mr *MockConvertGetQuoteServiceAPIMockRecorder) ToAmount(toAmount interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ToAmount", reflect.TypeOf((*MockConvertGetQuoteServiceAPI)(nil).ToAmount), toAmount)
+}
+
+// ToAsset mocks base method.
+func (m *MockConvertGetQuoteServiceAPI) ToAsset(toAsset string) binance.ConvertGetQuoteServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ToAsset", toAsset)
+	ret0, _ := ret[0].(binance.ConvertGetQuoteServiceAPI)
+	return ret0
+}
+
+// ToAsset indicates an expected call of ToAsset.
+func (mr *MockConvertGetQuoteServiceAPIMockRecorder) ToAsset(toAsset interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ToAsset", reflect.TypeOf((*MockConvertGetQuoteServiceAPI)(nil).ToAsset), toAsset)
+}
+
+// ValidTime mocks base method.
+func (m *MockConvertGetQuoteServiceAPI) ValidTime(validTime string) binance.ConvertGetQuoteServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ValidTime", validTime)
+	ret0, _ := ret[0].(binance.ConvertGetQuoteServiceAPI)
+	return ret0
+}
+
+// ValidTime indicates an expected call of ValidTime.
+func (mr *MockConvertGetQuoteServiceAPIMockRecorder) ValidTime(validTime interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidTime", reflect.TypeOf((*MockConvertGetQuoteServiceAPI)(nil).ValidTime), validTime)
+}
+
+// WalletType mocks base method.
+func (m *MockConvertGetQuoteServiceAPI) WalletType(walletType string) binance.ConvertGetQuoteServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WalletType", walletType)
+	ret0, _ := ret[0].(binance.ConvertGetQuoteServiceAPI)
+	return ret0
+}
+
+// WalletType indicates an expected call of WalletType.
+func (mr *MockConvertGetQuoteServiceAPIMockRecorder) WalletType(walletType interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WalletType", reflect.TypeOf((*MockConvertGetQuoteServiceAPI)(nil).WalletType), walletType)
+}
+
+// MockConvertAcceptQuoteServiceAPI is a mock of ConvertAcceptQuoteServiceAPI interface.
+type MockConvertAcceptQuoteServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockConvertAcceptQuoteServiceAPIMockRecorder
+}
+
+// MockConvertAcceptQuoteServiceAPIMockRecorder is the mock recorder for MockConvertAcceptQuoteServiceAPI.
+type MockConvertAcceptQuoteServiceAPIMockRecorder struct {
+	mock *MockConvertAcceptQuoteServiceAPI
+}
+
+// NewMockConvertAcceptQuoteServiceAPI creates a new mock instance.
+func NewMockConvertAcceptQuoteServiceAPI(ctrl *gomock.Controller) *MockConvertAcceptQuoteServiceAPI {
+	mock := &MockConvertAcceptQuoteServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockConvertAcceptQuoteServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockConvertAcceptQuoteServiceAPI) EXPECT() *MockConvertAcceptQuoteServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockConvertAcceptQuoteServiceAPI) Do(ctx context.Context, opts ...binance.RequestOption) (*binance.ConvertAcceptQuote, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*binance.ConvertAcceptQuote)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockConvertAcceptQuoteServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockConvertAcceptQuoteServiceAPI)(nil).Do), varargs...)
+}
+
+// QuoteId mocks base method.
+func (m *MockConvertAcceptQuoteServiceAPI) QuoteId(quoteId string) binance.ConvertAcceptQuoteServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "QuoteId", quoteId)
+	ret0, _ := ret[0].(binance.ConvertAcceptQuoteServiceAPI)
+	return ret0
+}
+
+// QuoteId indicates an expected call of QuoteId.
+func (mr *MockConvertAcceptQuoteServiceAPIMockRecorder) QuoteId(quoteId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QuoteId", reflect.TypeOf((*MockConvertAcceptQuoteServiceAPI)(nil).QuoteId), quoteId)
+}
+
+// MockConvertOrderStatusServiceAPI is a mock of ConvertOrderStatusServiceAPI interface.
+type MockConvertOrderStatusServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockConvertOrderStatusServiceAPIMockRecorder
+}
+
+// MockConvertOrderStatusServiceAPIMockRecorder is the mock recorder for MockConvertOrderStatusServiceAPI.
+type MockConvertOrderStatusServiceAPIMockRecorder struct {
+	mock *MockConvertOrderStatusServiceAPI
+}
+
+// NewMockConvertOrderStatusServiceAPI creates a new mock instance.
+func NewMockConvertOrderStatusServiceAPI(ctrl *gomock.Controller) *MockConvertOrderStatusServiceAPI {
+	mock := &MockConvertOrderStatusServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockConvertOrderStatusServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockConvertOrderStatusServiceAPI) EXPECT() *MockConvertOrderStatusServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockConvertOrderStatusServiceAPI) Do(ctx context.Context, opts ...binance.RequestOption) (*binance.ConvertOrderStatus, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*binance.ConvertOrderStatus)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockConvertOrderStatusServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockConvertOrderStatusServiceAPI)(nil).Do), varargs...)
+}
+
+// OrderId mocks base method.
+func (m *MockConvertOrderStatusServiceAPI) OrderId(orderId string) binance.ConvertOrderStatusServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OrderId", orderId)
+	ret0, _ := ret[0].(binance.ConvertOrderStatusServiceAPI)
+	return ret0
+}
+
+// OrderId indicates an expected call of OrderId.
+func (mr *MockConvertOrderStatusServiceAPIMockRecorder) OrderId(orderId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OrderId", reflect.TypeOf((*MockConvertOrderStatusServiceAPI)(nil).OrderId), orderId)
+}
+
+// QuoteId mocks base method.
+func (m *MockConvertOrderStatusServiceAPI) QuoteId(quoteId string) binance.ConvertOrderStatusServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "QuoteId", quoteId)
+	ret0, _ := ret[0].(binance.ConvertOrderStatusServiceAPI)
+	return ret0
+}
+
+// QuoteId indicates an expected call of QuoteId.
+func (mr *MockConvertOrderStatusServiceAPIMockRecorder) QuoteId(quoteId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QuoteId", reflect.TypeOf((*MockConvertOrderStatusServiceAPI)(nil).QuoteId), quoteId)
+}
+
+// MockGetIsolatedMarginAllPairsServiceAPI is a mock of GetIsolatedMarginAllPairsServiceAPI interface.
+type MockGetIsolatedMarginAllPairsServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockGetIsolatedMarginAllPairsServiceAPIMockRecorder
+}
+
+// MockGetIsolatedMarginAllPairsServiceAPIMockRecorder is the mock recorder for MockGetIsolatedMarginAllPairsServiceAPI.
+type MockGetIsolatedMarginAllPairsServiceAPIMockRecorder struct {
+	mock *MockGetIsolatedMarginAllPairsServiceAPI
+}
+
+// NewMockGetIsolatedMarginAllPairsServiceAPI creates a new mock instance.
+func NewMockGetIsolatedMarginAllPairsServiceAPI(ctrl *gomock.Controller) *MockGetIsolatedMarginAllPairsServiceAPI {
+	mock := &MockGetIsolatedMarginAllPairsServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockGetIsolatedMarginAllPairsServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGetIsolatedMarginAllPairsServiceAPI) EXPECT() *MockGetIsolatedMarginAllPairsServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockGetIsolatedMarginAllPairsServiceAPI) Do(ctx context.Context, opts ...binance.RequestOption) ([]*binance.IsolatedMarginAllPair, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].([]*binance.IsolatedMarginAllPair)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockGetIsolatedMarginAllPairsServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockGetIsolatedMarginAllPairsServiceAPI)(nil).Do), varargs...)
+}
+
+// MockInterestHistoryServiceAPI is a mock of InterestHistoryServiceAPI interface.
+type MockInterestHistoryServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockInterestHistoryServiceAPIMockRecorder
+}
+
+// MockInterestHistoryServiceAPIMockRecorder is the mock recorder for MockInterestHistoryServiceAPI.
+type MockInterestHistoryServiceAPIMockRecorder struct {
+	mock *MockInterestHistoryServiceAPI
+}
+
+// NewMockInterestHistoryServiceAPI creates a new mock instance.
+func NewMockInterestHistoryServiceAPI(ctrl *gomock.Controller) *MockInterestHistoryServiceAPI {
+	mock := &MockInterestHistoryServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockInterestHistoryServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockInterestHistoryServiceAPI) EXPECT() *MockInterestHistoryServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Asset mocks base method.
+func (m *MockInterestHistoryServiceAPI) Asset(asset string) binance.InterestHistoryServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Asset", asset)
+	ret0, _ := ret[0].(binance.InterestHistoryServiceAPI)
+	return ret0
+}
+
+// Asset indicates an expected call of Asset.
+func (mr *MockInterestHistoryServiceAPIMockRecorder) Asset(asset interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Asset", reflect.TypeOf((*MockInterestHistoryServiceAPI)(nil).Asset), asset)
+}
+
+// Current mocks base method.
+func (m *MockInterestHistoryServiceAPI) Current(current int32) binance.InterestHistoryServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Current", current)
+	ret0, _ := ret[0].(binance.InterestHistoryServiceAPI)
+	return ret0
+}
+
+// Current indicates an expected call of Current.
+func (mr *MockInterestHistoryServiceAPIMockRecorder) Current(current interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Current", reflect.TypeOf((*MockInterestHistoryServiceAPI)(nil).Current), current)
+}
+
+// Do mocks base method.
+func (m *MockInterestHistoryServiceAPI) Do(ctx context.Context) (*binance.InterestHistory, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Do", ctx)
+	ret0, _ := ret[0].(*binance.InterestHistory)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockInterestHistoryServiceAPIMockRecorder) Do(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockInterestHistoryServiceAPI)(nil).Do), ctx)
+}
+
+// EndTime mocks base method.
+func (m *MockInterestHistoryServiceAPI) EndTime(endTime int64) binance.InterestHistoryServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EndTime", endTime)
+	ret0, _ := ret[0].(binance.InterestHistoryServiceAPI)
+	return ret0
+}
+
+// EndTime indicates an expected call of EndTime.
+func (mr *MockInterestHistoryServiceAPIMockRecorder) EndTime(endTime interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EndTime", reflect.TypeOf((*MockInterestHistoryServiceAPI)(nil).EndTime), endTime)
+}
+
+// LendingType mocks base method.
+func (m *MockInterestHistoryServiceAPI) LendingType(lendingType binance.LendingType) binance.InterestHistoryServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LendingType", lendingType)
+	ret0, _ := ret[0].(binance.InterestHistoryServiceAPI)
+	return ret0
+}
+
+// LendingType indicates an expected call of LendingType.
+func (mr *MockInterestHistoryServiceAPIMockRecorder) LendingType(lendingType interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LendingType", reflect.TypeOf((*MockInterestHistoryServiceAPI)(nil).LendingType), lendingType)
+}
+
+// Size mocks base method.
+func (m *MockInterestHistoryServiceAPI) Size(size int32) binance.InterestHistoryServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Size", size)
+	ret0, _ := ret[0].(binance.InterestHistoryServiceAPI)
+	return ret0
+}
+
+// Size indicates an expected call of Size.
+func (mr *MockInterestHistoryServiceAPIMockRecorder) Size(size interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Size", reflect.TypeOf((*MockInterestHistoryServiceAPI)(nil).Size), size)
+}
+
+// StartTime mocks base method.
+func (m *MockInterestHistoryServiceAPI) StartTime(startTime int64) binance.InterestHistoryServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartTime", startTime)
+	ret0, _ := ret[0].(binance.InterestHistoryServiceAPI)
+	return ret0
+}
+
+// StartTime indicates an expected call of StartTime.
+func (mr *MockInterestHistoryServiceAPIMockRecorder) StartTime(startTime interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartTime", reflect.TypeOf((*MockInterestHistoryServiceAPI)(nil).StartTime), startTime)
+}
+
+// MockTradeFeeServiceAPI is a mock of TradeFeeServiceAPI interface.
+type MockTradeFeeServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockTradeFeeServiceAPIMockRecorder
+}
+
+// MockTradeFeeServiceAPIMockRecorder is the mock recorder for MockTradeFeeServiceAPI.
+type MockTradeFeeServiceAPIMockRecorder struct {
+	mock *MockTradeFeeServiceAPI
+}
+
+// NewMockTradeFeeServiceAPI creates a new mock instance.
+func NewMockTradeFeeServiceAPI(ctrl *gomock.Controller) *MockTradeFeeServiceAPI {
+	mock := &MockTradeFeeServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockTradeFeeServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTradeFeeServiceAPI) EXPECT() *MockTradeFeeServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockTradeFeeServiceAPI) Do(ctx context.Context) ([]*binance.TradeFeeDetails, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Do", ctx)
+	ret0, _ := ret[0].([]*binance.TradeFeeDetails)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockTradeFeeServiceAPIMockRecorder) Do(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockTradeFeeServiceAPI)(nil).Do), ctx)
+}
+
+// Symbol mocks base method.
+func (m *MockTradeFeeServiceAPI) Symbol(symbol string) binance.TradeFeeServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Symbol", symbol)
+	ret0, _ := ret[0].(binance.TradeFeeServiceAPI)
+	return ret0
+}
+
+// Symbol indicates an expected call of Symbol.
+func (mr *MockTradeFeeServiceAPIMockRecorder) Symbol(symbol interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Symbol", reflect.TypeOf((*MockTradeFeeServiceAPI)(nil).Symbol), symbol)
+}
+
+// MockC2CTradeHistoryServiceAPI is a mock of C2CTradeHistoryServiceAPI interface.
+type MockC2CTradeHistoryServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockC2CTradeHistoryServiceAPIMockRecorder
+}
+
+// MockC2CTradeHistoryServiceAPIMockRecorder is the mock recorder for MockC2CTradeHistoryServiceAPI.
+type MockC2CTradeHistoryServiceAPIMockRecorder struct {
+	mock *MockC2CTradeHistoryServiceAPI
+}
+
+// NewMockC2CTradeHistoryServiceAPI creates a new mock instance.
+func NewMockC2CTradeHistoryServiceAPI(ctrl *gomock.Controller) *MockC2CTradeHistoryServiceAPI {
+	mock := &MockC2CTradeHistoryServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockC2CTradeHistoryServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockC2CTradeHistoryServiceAPI) EXPECT() *MockC2CTradeHistoryServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockC2CTradeHistoryServiceAPI) Do(ctx context.Context, opts ...binance.RequestOption) (*binance.C2CTradeHistory, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*binance.C2CTradeHistory)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockC2CTradeHistoryServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockC2CTradeHistoryServiceAPI)(nil).Do), varargs...)
+}
+
+// EndTime mocks base method.
+func (m *MockC2CTradeHistoryServiceAPI) EndTime(endTimestamp int64) binance.C2CTradeHistoryServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EndTime", endTimestamp)
+	ret0, _ := ret[0].(binance.C2CTradeHistoryServiceAPI)
+	return ret0
+}
+
+// EndTime indicates an expected call of EndTime.
+func (mr *MockC2CTradeHistoryServiceAPIMockRecorder) EndTime(endTimestamp interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EndTime", reflect.TypeOf((*MockC2CTradeHistoryServiceAPI)(nil).EndTime), endTimestamp)
+}
+
+// Page mocks base method.
+func (m *MockC2CTradeHistoryServiceAPI) Page(page int32) binance.C2CTradeHistoryServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Page", page)
+	ret0, _ := ret[0].(binance.C2CTradeHistoryServiceAPI)
+	return ret0
+}
+
+// Page indicates an expected call of Page.
+func (mr *MockC2CTradeHistoryServiceAPIMockRecorder) Page(page interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Page", reflect.TypeOf((*MockC2CTradeHistoryServiceAPI)(nil).Page), page)
+}
+
+// Rows mocks base method.
+func (m *MockC2CTradeHistoryServiceAPI) Rows(rows int32) binance.C2CTradeHistoryServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Rows", rows)
+	ret0, _ := ret[0].(binance.C2CTradeHistoryServiceAPI)
+	return ret0
+}
+
+// Rows indicates an expected call of Rows.
+func (mr *MockC2CTradeHistoryServiceAPIMockRecorder) Rows(rows interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Rows", reflect.TypeOf((*MockC2CTradeHistoryServiceAPI)(nil).Rows), rows)
+}
+
+// StartTimestamp mocks base method.
+func (m *MockC2CTradeHistoryServiceAPI) StartTimestamp(startTimestamp int64) binance.C2CTradeHistoryServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartTimestamp", startTimestamp)
+	ret0, _ := ret[0].(binance.C2CTradeHistoryServiceAPI)
+	return ret0
+}
+
+// StartTimestamp indicates an expected call of StartTimestamp.
+func (mr *MockC2CTradeHistoryServiceAPIMockRecorder) StartTimestamp(startTimestamp interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartTimestamp", reflect.TypeOf((*MockC2CTradeHistoryServiceAPI)(nil).StartTimestamp), startTimestamp)
+}
+
+// TradeType mocks base method.
+func (m *MockC2CTradeHistoryServiceAPI) TradeType(tradeType binance.SideType) binance.C2CTradeHistoryServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TradeType", tradeType)
+	ret0, _ := ret[0].(binance.C2CTradeHistoryServiceAPI)
+	return ret0
+}
+
+// TradeType indicates an expected call of TradeType.
+func (mr *MockC2CTradeHistoryServiceAPIMockRecorder) TradeType(tradeType interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TradeType", reflect.TypeOf((*MockC2CTradeHistoryServiceAPI)(nil).TradeType), tradeType)
+}
+
+// MockStakingProductPositionServiceAPI is a mock of StakingProductPositionServiceAPI interface.
+type MockStakingProductPositionServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockStakingProductPositionServiceAPIMockRecorder
+}
+
+// MockStakingProductPositionServiceAPIMockRecorder is the mock recorder for MockStakingProductPositionServiceAPI.
+type MockStakingProductPositionServiceAPIMockRecorder struct {
+	mock *MockStakingProductPositionServiceAPI
+}
+
+// NewMockStakingProductPositionServiceAPI creates a new mock instance.
+func NewMockStakingProductPositionServiceAPI(ctrl *gomock.Controller) *MockStakingProductPositionServiceAPI {
+	mock := &MockStakingProductPositionServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockStakingProductPositionServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockStakingProductPositionServiceAPI) EXPECT() *MockStakingProductPositionServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Asset mocks base method.
+func (m *MockStakingProductPositionServiceAPI) Asset(asset string) binance.StakingProductPositionServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Asset", asset)
+	ret0, _ := ret[0].(binance.StakingProductPositionServiceAPI)
+	return ret0
+}
+
+// Asset indicates an expected call of Asset.
+func (mr *MockStakingProductPositionServiceAPIMockRecorder) Asset(asset interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Asset", reflect.TypeOf((*MockStakingProductPositionServiceAPI)(nil).Asset), asset)
+}
+
+// Current mocks base method.
+func (m *MockStakingProductPositionServiceAPI) Current(current int32) binance.StakingProductPositionServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Current", current)
+	ret0, _ := ret[0].(binance.StakingProductPositionServiceAPI)
+	return ret0
+}
+
+// Current indicates an expected call of Current.
+func (mr *MockStakingProductPositionServiceAPIMockRecorder) Current(current interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Current", reflect.TypeOf((*MockStakingProductPositionServiceAPI)(nil).Current), current)
+}
+
+// Do mocks base method.
+func (m *MockStakingProductPositionServiceAPI) Do(ctx context.Context) (*binance.StakingProductPositions, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Do", ctx)
+	ret0, _ := ret[0].(*binance.StakingProductPositions)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockStakingProductPositionServiceAPIMockRecorder) Do(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockStakingProductPositionServiceAPI)(nil).Do), ctx)
+}
+
+// Product mocks base method.
+func (m *MockStakingProductPositionServiceAPI) Product(product binance.StakingProduct) binance.StakingProductPositionServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Product", product)
+	ret0, _ := ret[0].(binance.StakingProductPositionServiceAPI)
+	return ret0
+}
+
+// Product indicates an expected call of Product.
+func (mr *MockStakingProductPositionServiceAPIMockRecorder) Product(product interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Product", reflect.TypeOf((*MockStakingProductPositionServiceAPI)(nil).Product), product)
+}
+
+// ProductId mocks base method.
+func (m *MockStakingProductPositionServiceAPI) ProductId(productId string) binance.StakingProductPositionServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ProductId", productId)
+	ret0, _ := ret[0].(binance.StakingProductPositionServiceAPI)
+	return ret0
+}
+
+// ProductId indicates an expected call of ProductId.
+func (mr *MockStakingProductPositionServiceAPIMockRecorder) ProductId(productId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ProductId", reflect.TypeOf((*MockStakingProductPositionServiceAPI)(nil).ProductId), productId)
+}
+
+// Size mocks base method.
+func (m *MockStakingProductPositionServiceAPI) Size(size int32) binance.StakingProductPositionServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Size", size)
+	ret0, _ := ret[0].(binance.StakingProductPositionServiceAPI)
+	return ret0
+}
+
+// Size indicates an expected call of Size.
+func (mr *MockStakingProductPositionServiceAPIMockRecorder) Size(size interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Size", reflect.TypeOf((*MockStakingProductPositionServiceAPI)(nil).Size), size)
+}
+
+// MockStakingHistoryServiceAPI is a mock of StakingHistoryServiceAPI interface.
+type MockStakingHistoryServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockStakingHistoryServiceAPIMockRecorder
+}
+
+// MockStakingHistoryServiceAPIMockRecorder is the mock recorder for MockStakingHistoryServiceAPI.
+type MockStakingHistoryServiceAPIMockRecorder struct {
+	mock *MockStakingHistoryServiceAPI
+}
+
+// NewMockStakingHistoryServiceAPI creates a new mock instance.
+func NewMockStakingHistoryServiceAPI(ctrl *gomock.Controller) *MockStakingHistoryServiceAPI {
+	mock := &MockStakingHistoryServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockStakingHistoryServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockStakingHistoryServiceAPI) EXPECT() *MockStakingHistoryServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Asset mocks base method.
+func (m *MockStakingHistoryServiceAPI) Asset(asset string) binance.StakingHistoryServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Asset", asset)
+	ret0, _ := ret[0].(binance.StakingHistoryServiceAPI)
+	return ret0
+}
+
+// Asset indicates an expected call of Asset.
+func (mr *MockStakingHistoryServiceAPIMockRecorder) Asset(asset interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Asset", reflect.TypeOf((*MockStakingHistoryServiceAPI)(nil).Asset), asset)
+}
+
+// Current mocks base method.
+func (m *MockStakingHistoryServiceAPI) Current(current int32) binance.StakingHistoryServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Current", current)
+	ret0, _ := ret[0].(binance.StakingHistoryServiceAPI)
+	return ret0
+}
+
+// Current indicates an expected call of Current.
+func (mr *MockStakingHistoryServiceAPIMockRecorder) Current(current interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Current", reflect.TypeOf((*MockStakingHistoryServiceAPI)(nil).Current), current)
+}
+
+// Do mocks base method.
+func (m *MockStakingHistoryServiceAPI) Do(ctx context.Context) (*binance.StakingHistory, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Do", ctx)
+	ret0, _ := ret[0].(*binance.StakingHistory)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockStakingHistoryServiceAPIMockRecorder) Do(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockStakingHistoryServiceAPI)(nil).Do), ctx)
+}
+
+// EndTime mocks base method.
+func (m *MockStakingHistoryServiceAPI) EndTime(endTime int64) binance.StakingHistoryServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EndTime", endTime)
+	ret0, _ := ret[0].(binance.StakingHistoryServiceAPI)
+	return ret0
+}
+
+// EndTime indicates an expected call of EndTime.
+func (mr *MockStakingHistoryServiceAPIMockRecorder) EndTime(endTime interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EndTime", reflect.TypeOf((*MockStakingHistoryServiceAPI)(nil).EndTime), endTime)
+}
+
+// Product mocks base method.
+func (m *MockStakingHistoryServiceAPI) Product(product binance.StakingProduct) binance.StakingHistoryServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Product", product)
+	ret0, _ := ret[0].(binance.StakingHistoryServiceAPI)
+	return ret0
+}
+
+// Product indicates an expected call of Product.
+func (mr *MockStakingHistoryServiceAPIMockRecorder) Product(product interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Product", reflect.TypeOf((*MockStakingHistoryServiceAPI)(nil).Product), product)
+}
+
+// Size mocks base method.
+func (m *MockStakingHistoryServiceAPI) Size(size int32) binance.StakingHistoryServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Size", size)
+	ret0, _ := ret[0].(binance.StakingHistoryServiceAPI)
+	return ret0
+}
+
+// Size indicates an expected call of Size.
+func (mr *MockStakingHistoryServiceAPIMockRecorder) Size(size interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Size", reflect.TypeOf((*MockStakingHistoryServiceAPI)(nil).Size), size)
+}
+
+// StartTime mocks base method.
+func (m *MockStakingHistoryServiceAPI) StartTime(startTime int64) binance.StakingHistoryServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartTime", startTime)
+	ret0, _ := ret[0].(binance.StakingHistoryServiceAPI)
+	return ret0
+}
+
+// StartTime indicates an expected call of StartTime.
+func (mr *MockStakingHistoryServiceAPIMockRecorder) StartTime(startTime interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartTime", reflect.TypeOf((*MockStakingHistoryServiceAPI)(nil).StartTime), startTime)
+}
+
+// TransactionType mocks base method.
+func (m *MockStakingHistoryServiceAPI) TransactionType(transactionType binance.StakingTransactionType) binance.StakingHistoryServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TransactionType", transactionType)
+	ret0, _ := ret[0].(binance.StakingHistoryServiceAPI)
+	return ret0
+}
+
+// TransactionType indicates an expected call of TransactionType.
+func (mr *MockStakingHistoryServiceAPIMockRecorder) TransactionType(transactionType interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TransactionType", reflect.TypeOf((*MockStakingHistoryServiceAPI)(nil).TransactionType), transactionType)
+}
+
+// MockGetAllLiquidityPoolServiceAPI is a mock of GetAllLiquidityPoolServiceAPI interface.
+type MockGetAllLiquidityPoolServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockGetAllLiquidityPoolServiceAPIMockRecorder
+}
+
+// MockGetAllLiquidityPoolServiceAPIMockRecorder is the mock recorder for MockGetAllLiquidityPoolServiceAPI.
+type MockGetAllLiquidityPoolServiceAPIMockRecorder struct {
+	mock *MockGetAllLiquidityPoolServiceAPI
+}
+
+// NewMockGetAllLiquidityPoolServiceAPI creates a new mock instance.
+func NewMockGetAllLiquidityPoolServiceAPI(ctrl *gomock.Controller) *MockGetAllLiquidityPoolServiceAPI {
+	mock := &MockGetAllLiquidityPoolServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockGetAllLiquidityPoolServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGetAllLiquidityPoolServiceAPI) EXPECT() *MockGetAllLiquidityPoolServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockGetAllLiquidityPoolServiceAPI) Do(ctx context.Context, opts ...binance.RequestOption) ([]*binance.LiquidityPool, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].([]*binance.LiquidityPool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockGetAllLiquidityPoolServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockGetAllLiquidityPoolServiceAPI)(nil).Do), varargs...)
+}
+
+// MockGetLiquidityPoolDetailServiceAPI is a mock of GetLiquidityPoolDetailServiceAPI interface.
+type MockGetLiquidityPoolDetailServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockGetLiquidityPoolDetailServiceAPIMockRecorder
+}
+
+// MockGetLiquidityPoolDetailServiceAPIMockRecorder is the mock recorder for MockGetLiquidityPoolDetailServiceAPI.
+type MockGetLiquidityPoolDetailServiceAPIMockRecorder struct {
+	mock *MockGetLiquidityPoolDetailServiceAPI
+}
+
+// NewMockGetLiquidityPoolDetailServiceAPI creates a new mock instance.
+func NewMockGetLiquidityPoolDetailServiceAPI(ctrl *gomock.Controller) *MockGetLiquidityPoolDetailServiceAPI {
+	mock := &MockGetLiquidityPoolDetailServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockGetLiquidityPoolDetailServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGetLiquidityPoolDetailServiceAPI) EXPECT() *MockGetLiquidityPoolDetailServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockGetLiquidityPoolDetailServiceAPI) Do(ctx context.Context) ([]*binance.LiquidityPoolDetail, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Do", ctx)
+	ret0, _ := ret[0].([]*binance.LiquidityPoolDetail)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockGetLiquidityPoolDetailServiceAPIMockRecorder) Do(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockGetLiquidityPoolDetailServiceAPI)(nil).Do), ctx)
+}
+
+// PoolId mocks base method.
+func (m *MockGetLiquidityPoolDetailServiceAPI) PoolId(poolId int64) binance.GetLiquidityPoolDetailServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PoolId", poolId)
+	ret0, _ := ret[0].(binance.GetLiquidityPoolDetailServiceAPI)
+	return ret0
+}
+
+// PoolId indicates an expected call of PoolId.
+func (mr *MockGetLiquidityPoolDetailServiceAPIMockRecorder) PoolId(poolId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PoolId", reflect.TypeOf((*MockGetLiquidityPoolDetailServiceAPI)(nil).PoolId), poolId)
+}
+
+// MockAddLiquidityPreviewServiceAPI is a mock of AddLiquidityPreviewServiceAPI interface.
+type MockAddLiquidityPreviewServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockAddLiquidityPreviewServiceAPIMockRecorder
+}
+
+// MockAddLiquidityPreviewServiceAPIMockRecorder is the mock recorder for MockAddLiquidityPreviewServiceAPI.
+type MockAddLiquidityPreviewServiceAPIMockRecorder struct {
+	mock *MockAddLiquidityPreviewServiceAPI
+}
+
+// NewMockAddLiquidityPreviewServiceAPI creates a new mock instance.
+func NewMockAddLiquidityPreviewServiceAPI(ctrl *gomock.Controller) *MockAddLiquidityPreviewServiceAPI {
+	mock := &MockAddLiquidityPreviewServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockAddLiquidityPreviewServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAddLiquidityPreviewServiceAPI) EXPECT() *MockAddLiquidityPreviewServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockAddLiquidityPreviewServiceAPI) Do(ctx context.Context) (*binance.AddLiquidityPreviewResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Do", ctx)
+	ret0, _ := ret[0].(*binance.AddLiquidityPreviewResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockAddLiquidityPreviewServiceAPIMockRecorder) Do(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockAddLiquidityPreviewServiceAPI)(nil).Do), ctx)
+}
+
+// OperationType mocks base method.
+func (m *MockAddLiquidityPreviewServiceAPI) OperationType(operationType binance.LiquidityOperationType) binance.AddLiquidityPreviewServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OperationType", operationType)
+	ret0, _ := ret[0].(binance.AddLiquidityPreviewServiceAPI)
+	return ret0
+}
+
+// OperationType indicates an expected call of OperationType.
+func (mr *MockAddLiquidityPreviewServiceAPIMockRecorder) OperationType(operationType interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OperationType", reflect.TypeOf((*MockAddLiquidityPreviewServiceAPI)(nil).OperationType), operationType)
+}
+
+// PoolId mocks base method.
+func (m *MockAddLiquidityPreviewServiceAPI) PoolId(poolId int64) binance.AddLiquidityPreviewServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PoolId", poolId)
+	ret0, _ := ret[0].(binance.AddLiquidityPreviewServiceAPI)
+	return ret0
+}
+
+// PoolId indicates an expected call of PoolId.
+func (mr *MockAddLiquidityPreviewServiceAPIMockRecorder) PoolId(poolId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PoolId", reflect.TypeOf((*MockAddLiquidityPreviewServiceAPI)(nil).PoolId), poolId)
+}
+
+// QuoteAsset mocks base method.
+func (m *MockAddLiquidityPreviewServiceAPI) QuoteAsset(quoteAsset string) binance.AddLiquidityPreviewServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "QuoteAsset", quoteAsset)
+	ret0, _ := ret[0].(binance.AddLiquidityPreviewServiceAPI)
+	return ret0
+}
+
+// QuoteAsset indicates an expected call of QuoteAsset.
+func (mr *MockAddLiquidityPreviewServiceAPIMockRecorder) QuoteAsset(quoteAsset interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QuoteAsset", reflect.TypeOf((*MockAddLiquidityPreviewServiceAPI)(nil).QuoteAsset), quoteAsset)
+}
+
+// QuoteQty mocks base method.
+func (m *MockAddLiquidityPreviewServiceAPI) QuoteQty(quoteQty float64) binance.AddLiquidityPreviewServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "QuoteQty", quoteQty)
+	ret0, _ := ret[0].(binance.AddLiquidityPreviewServiceAPI)
+	return ret0
+}
+
+// QuoteQty indicates an expected call of QuoteQty.
+func (mr *MockAddLiquidityPreviewServiceAPIMockRecorder) QuoteQty(quoteQty interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QuoteQty", reflect.TypeOf((*MockAddLiquidityPreviewServiceAPI)(nil).QuoteQty), quoteQty)
+}
+
+// MockGetSwapQuoteServiceAPI is a mock of GetSwapQuoteServiceAPI interface.
+type MockGetSwapQuoteServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockGetSwapQuoteServiceAPIMockRecorder
+}
+
+// MockGetSwapQuoteServiceAPIMockRecorder is the mock recorder for MockGetSwapQuoteServiceAPI.
+type MockGetSwapQuoteServiceAPIMockRecorder struct {
+	mock *MockGetSwapQuoteServiceAPI
+}
+
+// NewMockGetSwapQuoteServiceAPI creates a new mock instance.
+func NewMockGetSwapQuoteServiceAPI(ctrl *gomock.Controller) *MockGetSwapQuoteServiceAPI {
+	mock := &MockGetSwapQuoteServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockGetSwapQuoteServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGetSwapQuoteServiceAPI) EXPECT() *MockGetSwapQuoteServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// BaseAsset mocks base method.
+func (m *MockGetSwapQuoteServiceAPI) BaseAsset(baseAsset string) binance.GetSwapQuoteServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BaseAsset", baseAsset)
+	ret0, _ := ret[0].(binance.GetSwapQuoteServiceAPI)
+	return ret0
+}
+
+// BaseAsset indicates an expected call of BaseAsset.
+func (mr *MockGetSwapQuoteServiceAPIMockRecorder) BaseAsset(baseAsset interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BaseAsset", reflect.TypeOf((*MockGetSwapQuoteServiceAPI)(nil).BaseAsset), baseAsset)
+}
+
+// Do mocks base method.
+func (m *MockGetSwapQuoteServiceAPI) Do(ctx context.Context) (*binance.GetSwapQuoteResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Do", ctx)
+	ret0, _ := ret[0].(*binance.GetSwapQuoteResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockGetSwapQuoteServiceAPIMockRecorder) Do(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockGetSwapQuoteServiceAPI)(nil).Do), ctx)
+}
+
+// QuoteAsset mocks base method.
+func (m *MockGetSwapQuoteServiceAPI) QuoteAsset(quoteAsset string) binance.GetSwapQuoteServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "QuoteAsset", quoteAsset)
+	ret0, _ := ret[0].(binance.GetSwapQuoteServiceAPI)
+	return ret0
+}
+
+// QuoteAsset indicates an expected call of QuoteAsset.
+func (mr *MockGetSwapQuoteServiceAPIMockRecorder) QuoteAsset(quoteAsset interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QuoteAsset", reflect.TypeOf((*MockGetSwapQuoteServiceAPI)(nil).QuoteAsset), quoteAsset)
+}
+
+// QuoteQty mocks base method.
+func (m *MockGetSwapQuoteServiceAPI) QuoteQty(quoteQty float64) binance.GetSwapQuoteServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "QuoteQty", quoteQty)
+	ret0, _ := ret[0].(binance.GetSwapQuoteServiceAPI)
+	return ret0
+}
+
+// QuoteQty indicates an expected call of QuoteQty.
+func (mr *MockGetSwapQuoteServiceAPIMockRecorder) QuoteQty(quoteQty interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QuoteQty", reflect.TypeOf((*MockGetSwapQuoteServiceAPI)(nil).QuoteQty), quoteQty)
+}
+
+// MockSwapServiceAPI is a mock of SwapServiceAPI interface.
+type MockSwapServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockSwapServiceAPIMockRecorder
+}
+
+// MockSwapServiceAPIMockRecorder is the mock recorder for MockSwapServiceAPI.
+type MockSwapServiceAPIMockRecorder struct {
+	mock *MockSwapServiceAPI
+}
+
+// NewMockSwapServiceAPI creates a new mock instance.
+func NewMockSwapServiceAPI(ctrl *gomock.Controller) *MockSwapServiceAPI {
+	mock := &MockSwapServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockSwapServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSwapServiceAPI) EXPECT() *MockSwapServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// BaseAsset mocks base method.
+func (m *MockSwapServiceAPI) BaseAsset(baseAsset string) binance.SwapServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BaseAsset", baseAsset)
+	ret0, _ := ret[0].(binance.SwapServiceAPI)
+	return ret0
+}
+
+// BaseAsset indicates an expected call of BaseAsset.
+func (mr *MockSwapServiceAPIMockRecorder) BaseAsset(baseAsset interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BaseAsset", reflect.TypeOf((*MockSwapServiceAPI)(nil).BaseAsset), baseAsset)
+}
+
+// Do mocks base method.
+func (m *MockSwapServiceAPI) Do(ctx context.Context) (*binance.SwapResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Do", ctx)
+	ret0, _ := ret[0].(*binance.SwapResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockSwapServiceAPIMockRecorder) Do(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockSwapServiceAPI)(nil).Do), ctx)
+}
+
+// QuoteAsset mocks base method.
+func (m *MockSwapServiceAPI) QuoteAsset(quoteAsset string) binance.SwapServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "QuoteAsset", quoteAsset)
+	ret0, _ := ret[0].(binance.SwapServiceAPI)
+	return ret0
+}
+
+// QuoteAsset indicates an expected call of QuoteAsset.
+func (mr *MockSwapServiceAPIMockRecorder) QuoteAsset(quoteAsset interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QuoteAsset", reflect.TypeOf((*MockSwapServiceAPI)(nil).QuoteAsset), quoteAsset)
+}
+
+// QuoteQty mocks base method.
+func (m *MockSwapServiceAPI) QuoteQty(quoteQty float64) binance.SwapServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "QuoteQty", quoteQty)
+	ret0, _ := ret[0].(binance.SwapServiceAPI)
+	return ret0
+}
+
+// QuoteQty indicates an expected call of QuoteQty.
+func (mr *MockSwapServiceAPIMockRecorder) QuoteQty(quoteQty interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QuoteQty", reflect.TypeOf((*MockSwapServiceAPI)(nil).QuoteQty), quoteQty)
+}
+
+// MockAddLiquidityServiceAPI is a mock of AddLiquidityServiceAPI interface.
+type MockAddLiquidityServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockAddLiquidityServiceAPIMockRecorder
+}
+
+// MockAddLiquidityServiceAPIMockRecorder is the mock recorder for MockAddLiquidityServiceAPI.
+type MockAddLiquidityServiceAPIMockRecorder struct {
+	mock *MockAddLiquidityServiceAPI
+}
+
+// NewMockAddLiquidityServiceAPI creates a new mock instance.
+func NewMockAddLiquidityServiceAPI(ctrl *gomock.Controller) *MockAddLiquidityServiceAPI {
+	mock := &MockAddLiquidityServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockAddLiquidityServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAddLiquidityServiceAPI) EXPECT() *MockAddLiquidityServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockAddLiquidityServiceAPI) Do(ctx context.Context) (*binance.AddLiquidityResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Do", ctx)
+	ret0, _ := ret[0].(*binance.AddLiquidityResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockAddLiquidityServiceAPIMockRecorder) Do(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockAddLiquidityServiceAPI)(nil).Do), ctx)
+}
+
+// OperationType mocks base method.
+func (m *MockAddLiquidityServiceAPI) OperationType(operationType binance.LiquidityOperationType) binance.AddLiquidityServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OperationType", operationType)
+	ret0, _ := ret[0].(binance.AddLiquidityServiceAPI)
+	return ret0
+}
+
+// OperationType indicates an expected call of OperationType.
+func (mr *MockAddLiquidityServiceAPIMockRecorder) OperationType(operationType interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OperationType", reflect.TypeOf((*MockAddLiquidityServiceAPI)(nil).OperationType), operationType)
+}
+
+// PoolId mocks base method.
+func (m *MockAddLiquidityServiceAPI) PoolId(poolId int64) binance.AddLiquidityServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PoolId", poolId)
+	ret0, _ := ret[0].(binance.AddLiquidityServiceAPI)
+	return ret0
+}
+
+// PoolId indicates an expected call of PoolId.
+func (mr *MockAddLiquidityServiceAPIMockRecorder) PoolId(poolId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PoolId", reflect.TypeOf((*MockAddLiquidityServiceAPI)(nil).PoolId), poolId)
+}
+
+// QuoteAsset mocks base method.
+func (m *MockAddLiquidityServiceAPI) QuoteAsset(quoteAsset string) binance.AddLiquidityServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "QuoteAsset", quoteAsset)
+	ret0, _ := ret[0].(binance.AddLiquidityServiceAPI)
+	return ret0
+}
+
+// QuoteAsset indicates an expected call of QuoteAsset.
+func (mr *MockAddLiquidityServiceAPIMockRecorder) QuoteAsset(quoteAsset interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QuoteAsset", reflect.TypeOf((*MockAddLiquidityServiceAPI)(nil).QuoteAsset), quoteAsset)
+}
+
+// QuoteQty mocks base method.
+func (m *MockAddLiquidityServiceAPI) QuoteQty(quoteQty float64) binance.AddLiquidityServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "QuoteQty", quoteQty)
+	ret0, _ := ret[0].(binance.AddLiquidityServiceAPI)
+	return ret0
+}
+
+// QuoteQty indicates an expected call of QuoteQty.
+func (mr *MockAddLiquidityServiceAPIMockRecorder) QuoteQty(quoteQty interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QuoteQty", reflect.TypeOf((*MockAddLiquidityServiceAPI)(nil).QuoteQty), quoteQty)
+}
+
+// MockGetUserSwapRecordsServiceAPI is a mock of GetUserSwapRecordsServiceAPI interface.
+type MockGetUserSwapRecordsServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockGetUserSwapRecordsServiceAPIMockRecorder
+}
+
+// MockGetUserSwapRecordsServiceAPIMockRecorder is the mock recorder for MockGetUserSwapRecordsServiceAPI.
+type MockGetUserSwapRecordsServiceAPIMockRecorder struct {
+	mock *MockGetUserSwapRecordsServiceAPI
+}
+
+// NewMockGetUserSwapRecordsServiceAPI creates a new mock instance.
+func NewMockGetUserSwapRecordsServiceAPI(ctrl *gomock.Controller) *MockGetUserSwapRecordsServiceAPI {
+	mock := &MockGetUserSwapRecordsServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockGetUserSwapRecordsServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGetUserSwapRecordsServiceAPI) EXPECT() *MockGetUserSwapRecordsServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// BaseAsset mocks base method.
+func (m *MockGetUserSwapRecordsServiceAPI) BaseAsset(baseAsset string) binance.GetUserSwapRecordsServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BaseAsset", baseAsset)
+	ret0, _ := ret[0].(binance.GetUserSwapRecordsServiceAPI)
+	return ret0
+}
+
+// BaseAsset indicates an expected call of BaseAsset.
+func (mr *MockGetUserSwapRecordsServiceAPIMockRecorder) BaseAsset(baseAsset interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BaseAsset", reflect.TypeOf((*MockGetUserSwapRecordsServiceAPI)(nil).BaseAsset), baseAsset)
+}
+
+// Do mocks base method.
+func (m *MockGetUserSwapRecordsServiceAPI) Do(ctx context.Context) ([]*binance.SwapRecord, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Do", ctx)
+	ret0, _ := ret[0].([]*binance.SwapRecord)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockGetUserSwapRecordsServiceAPIMockRecorder) Do(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockGetUserSwapRecordsServiceAPI)(nil).Do), ctx)
+}
+
+// EndTime mocks base method.
+func (m *MockGetUserSwapRecordsServiceAPI) EndTime(endTime int64) binance.GetUserSwapRecordsServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EndTime", endTime)
+	ret0, _ := ret[0].(binance.GetUserSwapRecordsServiceAPI)
+	return ret0
+}
+
+// EndTime indicates an expected call of EndTime.
+func (mr *MockGetUserSwapRecordsServiceAPIMockRecorder) EndTime(endTime interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EndTime", reflect.TypeOf((*MockGetUserSwapRecordsServiceAPI)(nil).EndTime), endTime)
+}
+
+// QuoteAsset mocks base method.
+func (m *MockGetUserSwapRecordsServiceAPI) QuoteAsset(quoteAsset string) binance.GetUserSwapRecordsServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "QuoteAsset", quoteAsset)
+	ret0, _ := ret[0].(binance.GetUserSwapRecordsServiceAPI)
+	return ret0
+}
+
+// QuoteAsset indicates an expected call of QuoteAsset.
+func (mr *MockGetUserSwapRecordsServiceAPIMockRecorder) QuoteAsset(quoteAsset interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QuoteAsset", reflect.TypeOf((*MockGetUserSwapRecordsServiceAPI)(nil).QuoteAsset), quoteAsset)
+}
+
+// ResultSize mocks base method.
+func (m *MockGetUserSwapRecordsServiceAPI) ResultSize(resultSize int64) binance.GetUserSwapRecordsServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResultSize", resultSize)
+	ret0, _ := ret[0].(binance.GetUserSwapRecordsServiceAPI)
+	return ret0
+}
+
+// ResultSize indicates an expected call of ResultSize.
+func (mr *MockGetUserSwapRecordsServiceAPIMockRecorder) ResultSize(resultSize interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResultSize", reflect.TypeOf((*MockGetUserSwapRecordsServiceAPI)(nil).ResultSize), resultSize)
+}
+
+// StartTime mocks base method.
+func (m *MockGetUserSwapRecordsServiceAPI) StartTime(startTime int64) binance.GetUserSwapRecordsServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartTime", startTime)
+	ret0, _ := ret[0].(binance.GetUserSwapRecordsServiceAPI)
+	return ret0
+}
+
+// StartTime indicates an expected call of StartTime.
+func (mr *MockGetUserSwapRecordsServiceAPIMockRecorder) StartTime(startTime interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartTime", reflect.TypeOf((*MockGetUserSwapRecordsServiceAPI)(nil).StartTime), startTime)
+}
+
+// Status mocks base method.
+func (m *MockGetUserSwapRecordsServiceAPI) Status(status binance.SwappingStatus) binance.GetUserSwapRecordsServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Status", status)
+	ret0, _ := ret[0].(binance.GetUserSwapRecordsServiceAPI)
+	return ret0
+}
+
+// Status indicates an expected call of Status.
+func (mr *MockGetUserSwapRecordsServiceAPIMockRecorder) Status(status interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Status", reflect.TypeOf((*MockGetUserSwapRecordsServiceAPI)(nil).Status), status)
+}
+
+// SwapId mocks base method.
+func (m *MockGetUserSwapRecordsServiceAPI) SwapId(swapId int64) binance.GetUserSwapRecordsServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SwapId", swapId)
+	ret0, _ := ret[0].(binance.GetUserSwapRecordsServiceAPI)
+	return ret0
+}
+
+// SwapId indicates an expected call of SwapId.
+func (mr *MockGetUserSwapRecordsServiceAPIMockRecorder) SwapId(swapId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SwapId", reflect.TypeOf((*MockGetUserSwapRecordsServiceAPI)(nil).SwapId), swapId)
+}
+
+// MockClaimRewardServiceAPI is a mock of ClaimRewardServiceAPI interface.
+type MockClaimRewardServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockClaimRewardServiceAPIMockRecorder
+}
+
+// MockClaimRewardServiceAPIMockRecorder is the mock recorder for MockClaimRewardServiceAPI.
+type MockClaimRewardServiceAPIMockRecorder struct {
+	mock *MockClaimRewardServiceAPI
+}
+
+// NewMockClaimRewardServiceAPI creates a new mock instance.
+func NewMockClaimRewardServiceAPI(ctrl *gomock.Controller) *MockClaimRewardServiceAPI {
+	mock := &MockClaimRewardServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockClaimRewardServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockClaimRewardServiceAPI) EXPECT() *MockClaimRewardServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockClaimRewardServiceAPI) Do(ctx context.Context) (*binance.ClaimRewardResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Do", ctx)
+	ret0, _ := ret[0].(*binance.ClaimRewardResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockClaimRewardServiceAPIMockRecorder) Do(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockClaimRewardServiceAPI)(nil).Do), ctx)
+}
+
+// RewardType mocks base method.
+func (m *MockClaimRewardServiceAPI) RewardType(t binance.LiquidityRewardType) binance.ClaimRewardServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RewardType", t)
+	ret0, _ := ret[0].(binance.ClaimRewardServiceAPI)
+	return ret0
+}
+
+// RewardType indicates an expected call of RewardType.
+func (mr *MockClaimRewardServiceAPIMockRecorder) RewardType(t interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RewardType", reflect.TypeOf((*MockClaimRewardServiceAPI)(nil).RewardType), t)
+}
+
+// MockRemoveLiquidityServiceAPI is a mock of RemoveLiquidityServiceAPI interface.
+type MockRemoveLiquidityServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockRemoveLiquidityServiceAPIMockRecorder
+}
+
+// MockRemoveLiquidityServiceAPIMockRecorder is the mock recorder for MockRemoveLiquidityServiceAPI.
+type MockRemoveLiquidityServiceAPIMockRecorder struct {
+	mock *MockRemoveLiquidityServiceAPI
+}
+
+// NewMockRemoveLiquidityServiceAPI creates a new mock instance.
+func NewMockRemoveLiquidityServiceAPI(ctrl *gomock.Controller) *MockRemoveLiquidityServiceAPI {
+	mock := &MockRemoveLiquidityServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockRemoveLiquidityServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRemoveLiquidityServiceAPI) EXPECT() *MockRemoveLiquidityServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// AddAesst mocks base method.
+func (m *MockRemoveLiquidityServiceAPI) AddAesst(asset string) binance.RemoveLiquidityServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddAesst", asset)
+	ret0, _ := ret[0].(binance.RemoveLiquidityServiceAPI)
+	return ret0
+}
+
+// AddAesst indicates an expected call of AddAesst.
+func (mr *MockRemoveLiquidityServiceAPIMockRecorder) AddAesst(asset interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddAesst", reflect.TypeOf((*MockRemoveLiquidityServiceAPI)(nil).AddAesst), asset)
+}
+
+// Do mocks base method.
+func (m *MockRemoveLiquidityServiceAPI) Do(ctx context.Context) (*binance.RemoveLiquidityResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Do", ctx)
+	ret0, _ := ret[0].(*binance.RemoveLiquidityResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockRemoveLiquidityServiceAPIMockRecorder) Do(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockRemoveLiquidityServiceAPI)(nil).Do), ctx)
+}
+
+// OperationType mocks base method.
+func (m *MockRemoveLiquidityServiceAPI) OperationType(operationType binance.LiquidityOperationType) binance.RemoveLiquidityServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OperationType", operationType)
+	ret0, _ := ret[0].(binance.RemoveLiquidityServiceAPI)
+	return ret0
+}
+
+// OperationType indicates an expected call of OperationType.
+func (mr *MockRemoveLiquidityServiceAPIMockRecorder) OperationType(operationType interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OperationType", reflect.TypeOf((*MockRemoveLiquidityServiceAPI)(nil).OperationType), operationType)
+}
+
+// PoolId mocks base method.
+func (m *MockRemoveLiquidityServiceAPI) PoolId(poolId int64) binance.RemoveLiquidityServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PoolId", poolId)
+	ret0, _ := ret[0].(binance.RemoveLiquidityServiceAPI)
+	return ret0
+}
+
+// PoolId indicates an expected call of PoolId.
+func (mr *MockRemoveLiquidityServiceAPIMockRecorder) PoolId(poolId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PoolId", reflect.TypeOf((*MockRemoveLiquidityServiceAPI)(nil).PoolId), poolId)
+}
+
+// ShareAmount mocks base method.
+func (m *MockRemoveLiquidityServiceAPI) ShareAmount(amt float64) binance.RemoveLiquidityServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ShareAmount", amt)
+	ret0, _ := ret[0].(binance.RemoveLiquidityServiceAPI)
+	return ret0
+}
+
+// ShareAmount indicates an expected call of ShareAmount.
+func (mr *MockRemoveLiquidityServiceAPIMockRecorder) ShareAmount(amt interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ShareAmount", reflect.TypeOf((*MockRemoveLiquidityServiceAPI)(nil).ShareAmount), amt)
+}
+
+// MockQueryClaimedRewardHistoryServiceAPI is a mock of QueryClaimedRewardHistoryServiceAPI interface.
+type MockQueryClaimedRewardHistoryServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockQueryClaimedRewardHistoryServiceAPIMockRecorder
+}
+
+// MockQueryClaimedRewardHistoryServiceAPIMockRecorder is the mock recorder for MockQueryClaimedRewardHistoryServiceAPI.
+type MockQueryClaimedRewardHistoryServiceAPIMockRecorder struct {
+	mock *MockQueryClaimedRewardHistoryServiceAPI
+}
+
+// NewMockQueryClaimedRewardHistoryServiceAPI creates a new mock instance.
+func NewMockQueryClaimedRewardHistoryServiceAPI(ctrl *gomock.Controller) *MockQueryClaimedRewardHistoryServiceAPI {
+	mock := &MockQueryClaimedRewardHistoryServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockQueryClaimedRewardHistoryServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockQueryClaimedRewardHistoryServiceAPI) EXPECT() *MockQueryClaimedRewardHistoryServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// AssetRewards mocks base method.
+func (m *MockQueryClaimedRewardHistoryServiceAPI) AssetRewards(assetRewards string) binance.QueryClaimedRewardHistoryServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AssetRewards", assetRewards)
+	ret0, _ := ret[0].(binance.QueryClaimedRewardHistoryServiceAPI)
+	return ret0
+}
+
+// AssetRewards indicates an expected call of AssetRewards.
+func (mr *MockQueryClaimedRewardHistoryServiceAPIMockRecorder) AssetRewards(assetRewards interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AssetRewards", reflect.TypeOf((*MockQueryClaimedRewardHistoryServiceAPI)(nil).AssetRewards), assetRewards)
+}
+
+// Do mocks base method.
+func (m *MockQueryClaimedRewardHistoryServiceAPI) Do(ctx context.Context) ([]*binance.ClaimedRewardHistory, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Do", ctx)
+	ret0, _ := ret[0].([]*binance.ClaimedRewardHistory)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockQueryClaimedRewardHistoryServiceAPIMockRecorder) Do(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockQueryClaimedRewardHistoryServiceAPI)(nil).Do), ctx)
+}
+
+// EndTime mocks base method.
+func (m *MockQueryClaimedRewardHistoryServiceAPI) EndTime(endTime int64) binance.QueryClaimedRewardHistoryServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EndTime", endTime)
+	ret0, _ := ret[0].(binance.QueryClaimedRewardHistoryServiceAPI)
+	return ret0
+}
+
+// EndTime indicates an expected call of EndTime.
+func (mr *MockQueryClaimedRewardHistoryServiceAPIMockRecorder) EndTime(endTime interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EndTime", reflect.TypeOf((*MockQueryClaimedRewardHistoryServiceAPI)(nil).EndTime), endTime)
+}
+
+// PoolId mocks base method.
+func (m *MockQueryClaimedRewardHistoryServiceAPI) PoolId(poolId int64) binance.QueryClaimedRewardHistoryServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PoolId", poolId)
+	ret0, _ := ret[0].(binance.QueryClaimedRewardHistoryServiceAPI)
+	return ret0
+}
+
+// PoolId indicates an expected call of PoolId.
+func (mr *MockQueryClaimedRewardHistoryServiceAPIMockRecorder) PoolId(poolId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PoolId", reflect.TypeOf((*MockQueryClaimedRewardHistoryServiceAPI)(nil).PoolId), poolId)
+}
+
+// ResultSize mocks base method.
+func (m *MockQueryClaimedRewardHistoryServiceAPI) ResultSize(resultSize int64) binance.QueryClaimedRewardHistoryServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResultSize", resultSize)
+	ret0, _ := ret[0].(binance.QueryClaimedRewardHistoryServiceAPI)
+	return ret0
+}
+
+// ResultSize indicates an expected call of ResultSize.
+func (mr *MockQueryClaimedRewardHistoryServiceAPIMockRecorder) ResultSize(resultSize interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResultSize", reflect.TypeOf((*MockQueryClaimedRewardHistoryServiceAPI)(nil).ResultSize), resultSize)
+}
+
+// RewardType mocks base method.
+func (m *MockQueryClaimedRewardHistoryServiceAPI) RewardType(t binance.LiquidityRewardType) binance.QueryClaimedRewardHistoryServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RewardType", t)
+	ret0, _ := ret[0].(binance.QueryClaimedRewardHistoryServiceAPI)
+	return ret0
+}
+
+// RewardType indicates an expected call of RewardType.
+func (mr *MockQueryClaimedRewardHistoryServiceAPIMockRecorder) RewardType(t interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RewardType", reflect.TypeOf((*MockQueryClaimedRewardHistoryServiceAPI)(nil).RewardType), t)
+}
+
+// StartTime mocks base method.
+func (m *MockQueryClaimedRewardHistoryServiceAPI) StartTime(startTime int64) binance.QueryClaimedRewardHistoryServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartTime", startTime)
+	ret0, _ := ret[0].(binance.QueryClaimedRewardHistoryServiceAPI)
+	return ret0
+}
+
+// StartTime indicates an expected call of StartTime.
+func (mr *MockQueryClaimedRewardHistoryServiceAPIMockRecorder) StartTime(startTime interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartTime", reflect.TypeOf((*MockQueryClaimedRewardHistoryServiceAPI)(nil).StartTime), startTime)
+}
+
+// MockGetBNBBurnServiceAPI is a mock of GetBNBBurnServiceAPI interface.
+type MockGetBNBBurnServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockGetBNBBurnServiceAPIMockRecorder
+}
+
+// MockGetBNBBurnServiceAPIMockRecorder is the mock recorder for MockGetBNBBurnServiceAPI.
+type MockGetBNBBurnServiceAPIMockRecorder struct {
+	mock *MockGetBNBBurnServiceAPI
+}
+
+// NewMockGetBNBBurnServiceAPI creates a new mock instance.
+func NewMockGetBNBBurnServiceAPI(ctrl *gomock.Controller) *MockGetBNBBurnServiceAPI {
+	mock := &MockGetBNBBurnServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockGetBNBBurnServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGetBNBBurnServiceAPI) EXPECT() *MockGetBNBBurnServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockGetBNBBurnServiceAPI) Do(ctx context.Context, opts ...binance.RequestOption) (*binance.BNBBurn, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*binance.BNBBurn)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockGetBNBBurnServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockGetBNBBurnServiceAPI)(nil).Do), varargs...)
+}
+
+// MockToggleBNBBurnServiceAPI is a mock of ToggleBNBBurnServiceAPI interface.
+type MockToggleBNBBurnServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockToggleBNBBurnServiceAPIMockRecorder
+}
+
+// MockToggleBNBBurnServiceAPIMockRecorder is the mock recorder for MockToggleBNBBurnServiceAPI.
+type MockToggleBNBBurnServiceAPIMockRecorder struct {
+	mock *MockToggleBNBBurnServiceAPI
+}
+
+// NewMockToggleBNBBurnServiceAPI creates a new mock instance.
+func NewMockToggleBNBBurnServiceAPI(ctrl *gomock.Controller) *MockToggleBNBBurnServiceAPI {
+	mock := &MockToggleBNBBurnServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockToggleBNBBurnServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockToggleBNBBurnServiceAPI) EXPECT() *MockToggleBNBBurnServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockToggleBNBBurnServiceAPI) Do(ctx context.Context, opts ...binance.RequestOption) (*binance.BNBBurn, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*binance.BNBBurn)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockToggleBNBBurnServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockToggleBNBBurnServiceAPI)(nil).Do), varargs...)
+}
+
+// InterestBNBBurn mocks base method.
+func (m *MockToggleBNBBurnServiceAPI) InterestBNBBurn(v bool) binance.ToggleBNBBurnServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InterestBNBBurn", v)
+	ret0, _ := ret[0].(binance.ToggleBNBBurnServiceAPI)
+	return ret0
+}
+
+// InterestBNBBurn indicates an expected call of InterestBNBBurn.
+func (mr *MockToggleBNBBurnServiceAPIMockRecorder) InterestBNBBurn(v interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InterestBNBBurn", reflect.TypeOf((*MockToggleBNBBurnServiceAPI)(nil).InterestBNBBurn), v)
+}
+
+// SpotBNBBurn mocks base method.
+func (m *MockToggleBNBBurnServiceAPI) SpotBNBBurn(v bool) binance.ToggleBNBBurnServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SpotBNBBurn", v)
+	ret0, _ := ret[0].(binance.ToggleBNBBurnServiceAPI)
+	return ret0
+}
+
+// SpotBNBBurn indicates an expected call of SpotBNBBurn.
+func (mr *MockToggleBNBBurnServiceAPIMockRecorder) SpotBNBBurn(v interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SpotBNBBurn", reflect.TypeOf((*MockToggleBNBBurnServiceAPI)(nil).SpotBNBBurn), v)
+}
+
+// MockInternalUniversalTransferServiceAPI is a mock of InternalUniversalTransferServiceAPI interface.
+type MockInternalUniversalTransferServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockInternalUniversalTransferServiceAPIMockRecorder
+}
+
+// MockInternalUniversalTransferServiceAPIMockRecorder is the mock recorder for MockInternalUniversalTransferServiceAPI.
+type MockInternalUniversalTransferServiceAPIMockRecorder struct {
+	mock *MockInternalUniversalTransferServiceAPI
+}
+
+// NewMockInternalUniversalTransferServiceAPI creates a new mock instance.
+func NewMockInternalUniversalTransferServiceAPI(ctrl *gomock.Controller) *MockInternalUniversalTransferServiceAPI {
+	mock := &MockInternalUniversalTransferServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockInternalUniversalTransferServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockInternalUniversalTransferServiceAPI) EXPECT() *MockInternalUniversalTransferServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Amount mocks base method.
+func (m *MockInternalUniversalTransferServiceAPI) Amount(v string) binance.InternalUniversalTransferServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Amount", v)
+	ret0, _ := ret[0].(binance.InternalUniversalTransferServiceAPI)
+	return ret0
+}
+
+// Amount indicates an expected call of Amount.
+func (mr *MockInternalUniversalTransferServiceAPIMockRecorder) Amount(v interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Amount", reflect.TypeOf((*MockInternalUniversalTransferServiceAPI)(nil).Amount), v)
+}
+
+// Asset mocks base method.
+func (m *MockInternalUniversalTransferServiceAPI) Asset(v string) binance.InternalUniversalTransferServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Asset", v)
+	ret0, _ := ret[0].(binance.InternalUniversalTransferServiceAPI)
+	return ret0
+}
+
+// Asset indicates an expected call of Asset.
+func (mr *MockInternalUniversalTransferServiceAPIMockRecorder) Asset(v interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Asset", reflect.TypeOf((*MockInternalUniversalTransferServiceAPI)(nil).Asset), v)
+}
+
+// ClientTranId mocks base method.
+func (m *MockInternalUniversalTransferServiceAPI) ClientTranId(v string) binance.InternalUniversalTransferServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClientTranId", v)
+	ret0, _ := ret[0].(binance.InternalUniversalTransferServiceAPI)
+	return ret0
+}
+
+// ClientTranId indicates an expected call of ClientTranId.
+func (mr *MockInternalUniversalTransferServiceAPIMockRecorder) ClientTranId(v interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClientTranId", reflect.TypeOf((*MockInternalUniversalTransferServiceAPI)(nil).ClientTranId), v)
+}
+
+// Do mocks base method.
+func (m *MockInternalUniversalTransferServiceAPI) Do(ctx context.Context, opts ...binance.RequestOption) (*binance.InternalUniversalTransferResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*binance.InternalUniversalTransferResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockInternalUniversalTransferServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockInternalUniversalTransferServiceAPI)(nil).Do), varargs...)
+}
+
+// FromAccountType mocks base method.
+func (m *MockInternalUniversalTransferServiceAPI) FromAccountType(v string) binance.InternalUniversalTransferServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FromAccountType", v)
+	ret0, _ := ret[0].(binance.InternalUniversalTransferServiceAPI)
+	return ret0
+}
+
+// FromAccountType indicates an expected call of FromAccountType.
+func (mr *MockInternalUniversalTransferServiceAPIMockRecorder) FromAccountType(v interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FromAccountType", reflect.TypeOf((*MockInternalUniversalTransferServiceAPI)(nil).FromAccountType), v)
+}
+
+// FromEmail mocks base method.
+func (m *MockInternalUniversalTransferServiceAPI) FromEmail(v string) binance.InternalUniversalTransferServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FromEmail", v)
+	ret0, _ := ret[0].(binance.InternalUniversalTransferServiceAPI)
+	return ret0
+}
+
+// FromEmail indicates an expected call of FromEmail.
+func (mr *MockInternalUniversalTransferServiceAPIMockRecorder) FromEmail(v interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FromEmail", reflect.TypeOf((*MockInternalUniversalTransferServiceAPI)(nil).FromEmail), v)
+}
+
+// Symbol mocks base method.
+func (m *MockInternalUniversalTransferServiceAPI) Symbol(v string) binance.InternalUniversalTransferServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Symbol", v)
+	ret0, _ := ret[0].(binance.InternalUniversalTransferServiceAPI)
+	return ret0
+}
+
+// Symbol indicates an expected call of Symbol.
+func (mr *MockInternalUniversalTransferServiceAPIMockRecorder) Symbol(v interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Symbol", reflect.TypeOf((*MockInternalUniversalTransferServiceAPI)(nil).Symbol), v)
+}
+
+// ToAccountType mocks base method.
+func (m *MockInternalUniversalTransferServiceAPI) ToAccountType(v string) binance.InternalUniversalTransferServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ToAccountType", v)
+	ret0, _ := ret[0].(binance.InternalUniversalTransferServiceAPI)
+	return ret0
+}
+
+// ToAccountType indicates an expected call of ToAccountType.
+func (mr *MockInternalUniversalTransferServiceAPIMockRecorder) ToAccountType(v interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ToAccountType", reflect.TypeOf((*MockInternalUniversalTransferServiceAPI)(nil).ToAccountType), v)
+}
+
+// ToEmail mocks base method.
+func (m *MockInternalUniversalTransferServiceAPI) ToEmail(v string) binance.InternalUniversalTransferServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ToEmail", v)
+	ret0, _ := ret[0].(binance.InternalUniversalTransferServiceAPI)
+	return ret0
+}
+
+// ToEmail indicates an expected call of ToEmail.
+func (mr *MockInternalUniversalTransferServiceAPIMockRecorder) ToEmail(v interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ToEmail", reflect.TypeOf((*MockInternalUniversalTransferServiceAPI)(nil).ToEmail), v)
+}
+
+// MockInternalUniversalTransferHistoryServiceAPI is a mock of InternalUniversalTransferHistoryServiceAPI interface.
+type MockInternalUniversalTransferHistoryServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockInternalUniversalTransferHistoryServiceAPIMockRecorder
+}
+
+// MockInternalUniversalTransferHistoryServiceAPIMockRecorder is the mock recorder for MockInternalUniversalTransferHistoryServiceAPI.
+type MockInternalUniversalTransferHistoryServiceAPIMockRecorder struct {
+	mock *MockInternalUniversalTransferHistoryServiceAPI
+}
+
+// NewMockInternalUniversalTransferHistoryServiceAPI creates a new mock instance.
+func NewMockInternalUniversalTransferHistoryServiceAPI(ctrl *gomock.Controller) *MockInternalUniversalTransferHistoryServiceAPI {
+	mock := &MockInternalUniversalTransferHistoryServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockInternalUniversalTransferHistoryServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockInternalUniversalTransferHistoryServiceAPI) EXPECT() *MockInternalUniversalTransferHistoryServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// ClientTranId mocks base method.
+func (m *MockInternalUniversalTransferHistoryServiceAPI) ClientTranId(v string) binance.InternalUniversalTransferHistoryServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClientTranId", v)
+	ret0, _ := ret[0].(binance.InternalUniversalTransferHistoryServiceAPI)
+	return ret0
+}
+
+// ClientTranId indicates an expected call of ClientTranId.
+func (mr *MockInternalUniversalTransferHistoryServiceAPIMockRecorder) ClientTranId(v interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClientTranId", reflect.TypeOf((*MockInternalUniversalTransferHistoryServiceAPI)(nil).ClientTranId), v)
+}
+
+// Do mocks base method.
+func (m *MockInternalUniversalTransferHistoryServiceAPI) Do(ctx context.Context, opts ...binance.RequestOption) (binance.InternalUniversalTransferHistoryResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(binance.InternalUniversalTransferHistoryResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockInternalUniversalTransferHistoryServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockInternalUniversalTransferHistoryServiceAPI)(nil).Do), varargs...)
+}
+
+// EndTime mocks base method.
+func (m *MockInternalUniversalTransferHistoryServiceAPI) EndTime(v int64) binance.InternalUniversalTransferHistoryServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EndTime", v)
+	ret0, _ := ret[0].(binance.InternalUniversalTransferHistoryServiceAPI)
+	return ret0
+}
+
+// EndTime indicates an expected call of EndTime.
+func (mr *MockInternalUniversalTransferHistoryServiceAPIMockRecorder) EndTime(v interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EndTime", reflect.TypeOf((*MockInternalUniversalTransferHistoryServiceAPI)(nil).EndTime), v)
+}
+
+// FromEmail mocks base method.
+func (m *MockInternalUniversalTransferHistoryServiceAPI) FromEmail(v string) binance.InternalUniversalTransferHistoryServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FromEmail", v)
+	ret0, _ := ret[0].(binance.InternalUniversalTransferHistoryServiceAPI)
+	return ret0
+}
+
+// FromEmail indicates an expected call of FromEmail.
+func (mr *MockInternalUniversalTransferHistoryServiceAPIMockRecorder) FromEmail(v interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FromEmail", reflect.TypeOf((*MockInternalUniversalTransferHistoryServiceAPI)(nil).FromEmail), v)
+}
+
+// Limit mocks base method.
+func (m *MockInternalUniversalTransferHistoryServiceAPI) Limit(v int) binance.InternalUniversalTransferHistoryServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Limit", v)
+	ret0, _ := ret[0].(binance.InternalUniversalTransferHistoryServiceAPI)
+	return ret0
+}
+
+// Limit indicates an expected call of Limit.
+func (mr *MockInternalUniversalTransferHistoryServiceAPIMockRecorder) Limit(v interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Limit", reflect.TypeOf((*MockInternalUniversalTransferHistoryServiceAPI)(nil).Limit), v)
+}
+
+// Page mocks base method.
+func (m *MockInternalUniversalTransferHistoryServiceAPI) Page(v int) binance.InternalUniversalTransferHistoryServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Page", v)
+	ret0, _ := ret[0].(binance.InternalUniversalTransferHistoryServiceAPI)
+	return ret0
+}
+
+// Page indicates an expected call of Page.
+func (mr *MockInternalUniversalTransferHistoryServiceAPIMockRecorder) Page(v interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Page", reflect.TypeOf((*MockInternalUniversalTransferHistoryServiceAPI)(nil).Page), v)
+}
+
+// StartTime mocks base method.
+func (m *MockInternalUniversalTransferHistoryServiceAPI) StartTime(v int64) binance.InternalUniversalTransferHistoryServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartTime", v)
+	ret0, _ := ret[0].(binance.InternalUniversalTransferHistoryServiceAPI)
+	return ret0
+}
+
+// StartTime indicates an expected call of StartTime.
+func (mr *MockInternalUniversalTransferHistoryServiceAPIMockRecorder) StartTime(v interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartTime", reflect.TypeOf((*MockInternalUniversalTransferHistoryServiceAPI)(nil).StartTime), v)
+}
+
+// ToEmail mocks base method.
+func (m *MockInternalUniversalTransferHistoryServiceAPI) ToEmail(v string) binance.InternalUniversalTransferHistoryServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ToEmail", v)
+	ret0, _ := ret[0].(binance.InternalUniversalTransferHistoryServiceAPI)
+	return ret0
+}
+
+// ToEmail indicates an expected call of ToEmail.
+func (mr *MockInternalUniversalTransferHistoryServiceAPIMockRecorder) ToEmail(v interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ToEmail", reflect.TypeOf((*MockInternalUniversalTransferHistoryServiceAPI)(nil).ToEmail), v)
+}
+
+// MockSubAccountListServiceAPI is a mock of SubAccountListServiceAPI interface.
+type MockSubAccountListServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockSubAccountListServiceAPIMockRecorder
+}
+
+// MockSubAccountListServiceAPIMockRecorder is the mock recorder for MockSubAccountListServiceAPI.
+type MockSubAccountListServiceAPIMockRecorder struct {
+	mock *MockSubAccountListServiceAPI
+}
+
+// NewMockSubAccountListServiceAPI creates a new mock instance.
+func NewMockSubAccountListServiceAPI(ctrl *gomock.Controller) *MockSubAccountListServiceAPI {
+	mock := &MockSubAccountListServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockSubAccountListServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSubAccountListServiceAPI) EXPECT() *MockSubAccountListServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockSubAccountListServiceAPI) Do(ctx context.Context, opts ...binance.RequestOption) (*binance.SubAccountList, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*binance.SubAccountList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockSubAccountListServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockSubAccountListServiceAPI)(nil).Do), varargs...)
+}
+
+// Email mocks base method.
+func (m *MockSubAccountListServiceAPI) Email(v string) binance.SubAccountListServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Email", v)
+	ret0, _ := ret[0].(binance.SubAccountListServiceAPI)
+	return ret0
+}
+
+// Email indicates an expected call of Email.
+func (mr *MockSubAccountListServiceAPIMockRecorder) Email(v interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Email", reflect.TypeOf((*MockSubAccountListServiceAPI)(nil).Email), v)
+}
+
+// IsFreeze mocks base method.
+func (m *MockSubAccountListServiceAPI) IsFreeze(v bool) binance.SubAccountListServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsFreeze", v)
+	ret0, _ := ret[0].(binance.SubAccountListServiceAPI)
+	return ret0
+}
+
+// IsFreeze indicates an expected call of IsFreeze.
+func (mr *MockSubAccountListServiceAPIMockRecorder) IsFreeze(v interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsFreeze", reflect.TypeOf((*MockSubAccountListServiceAPI)(nil).IsFreeze), v)
+}
+
+// Limit mocks base method.
+func (m *MockSubAccountListServiceAPI) Limit(v int) binance.SubAccountListServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Limit", v)
+	ret0, _ := ret[0].(binance.SubAccountListServiceAPI)
+	return ret0
+}
+
+// Limit indicates an expected call of Limit.
+func (mr *MockSubAccountListServiceAPIMockRecorder) Limit(v interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Limit", reflect.TypeOf((*MockSubAccountListServiceAPI)(nil).Limit), v)
+}
+
+// Page mocks base method.
+func (m *MockSubAccountListServiceAPI) Page(v int) binance.SubAccountListServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Page", v)
+	ret0, _ := ret[0].(binance.SubAccountListServiceAPI)
+	return ret0
+}
+
+// Page indicates an expected call of Page.
+func (mr *MockSubAccountListServiceAPIMockRecorder) Page(v interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Page", reflect.TypeOf((*MockSubAccountListServiceAPI)(nil).Page), v)
+}
+
+// MockGetUserAssetServiceAPI is a mock of GetUserAssetServiceAPI interface.
+type MockGetUserAssetServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockGetUserAssetServiceAPIMockRecorder
+}
+
+// MockGetUserAssetServiceAPIMockRecorder is the mock recorder for MockGetUserAssetServiceAPI.
+type MockGetUserAssetServiceAPIMockRecorder struct {
+	mock *MockGetUserAssetServiceAPI
+}
+
+// NewMockGetUserAssetServiceAPI creates a new mock instance.
+func NewMockGetUserAssetServiceAPI(ctrl *gomock.Controller) *MockGetUserAssetServiceAPI {
+	mock := &MockGetUserAssetServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockGetUserAssetServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGetUserAssetServiceAPI) EXPECT() *MockGetUserAssetServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Asset mocks base method.
+func (m *MockGetUserAssetServiceAPI) Asset(asset string) binance.GetUserAssetServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Asset", asset)
+	ret0, _ := ret[0].(binance.GetUserAssetServiceAPI)
+	return ret0
+}
+
+// Asset indicates an expected call of Asset.
+func (mr *MockGetUserAssetServiceAPIMockRecorder) Asset(asset interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Asset", reflect.TypeOf((*MockGetUserAssetServiceAPI)(nil).Asset), asset)
+}
+
+// Do mocks base method.
+func (m *MockGetUserAssetServiceAPI) Do(ctx context.Context) ([]binance.UserAssetRecord, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Do", ctx)
+	ret0, _ := ret[0].([]binance.UserAssetRecord)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockGetUserAssetServiceAPIMockRecorder) Do(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockGetUserAssetServiceAPI)(nil).Do), ctx)
+}
+
+// NeedBtcValuation mocks base method.
+func (m *MockGetUserAssetServiceAPI) NeedBtcValuation(val bool) binance.GetUserAssetServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NeedBtcValuation", val)
+	ret0, _ := ret[0].(binance.GetUserAssetServiceAPI)
+	return ret0
+}
+
+// NeedBtcValuation indicates an expected call of NeedBtcValuation.
+func (mr *MockGetUserAssetServiceAPIMockRecorder) NeedBtcValuation(val interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NeedBtcValuation", reflect.TypeOf((*MockGetUserAssetServiceAPI)(nil).NeedBtcValuation), val)
+}
+
+// MockManagedSubAccountDepositServiceAPI is a mock of ManagedSubAccountDepositServiceAPI interface.
+type MockManagedSubAccountDepositServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockManagedSubAccountDepositServiceAPIMockRecorder
+}
+
+// MockManagedSubAccountDepositServiceAPIMockRecorder is the mock recorder for MockManagedSubAccountDepositServiceAPI.
+type MockManagedSubAccountDepositServiceAPIMockRecorder struct {
+	mock *MockManagedSubAccountDepositServiceAPI
+}
+
+// NewMockManagedSubAccountDepositServiceAPI creates a new mock instance.
+func NewMockManagedSubAccountDepositServiceAPI(ctrl *gomock.Controller) *MockManagedSubAccountDepositServiceAPI {
+	mock := &MockManagedSubAccountDepositServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockManagedSubAccountDepositServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockManagedSubAccountDepositServiceAPI) EXPECT() *MockManagedSubAccountDepositServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Amount mocks base method.
+func (m *MockManagedSubAccountDepositServiceAPI) Amount(amount string) binance.ManagedSubAccountDepositServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Amount", amount)
+	ret0, _ := ret[0].(binance.ManagedSubAccountDepositServiceAPI)
+	return ret0
+}
+
+// Amount indicates an expected call of Amount.
+func (mr *MockManagedSubAccountDepositServiceAPIMockRecorder) Amount(amount interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Amount", reflect.TypeOf((*MockManagedSubAccountDepositServiceAPI)(nil).Amount), amount)
+}
+
+// Asset mocks base method.
+func (m *MockManagedSubAccountDepositServiceAPI) Asset(asset string) binance.ManagedSubAccountDepositServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Asset", asset)
+	ret0, _ := ret[0].(binance.ManagedSubAccountDepositServiceAPI)
+	return ret0
+}
+
+// Asset indicates an expected call of Asset.
+func (mr *MockManagedSubAccountDepositServiceAPIMockRecorder) Asset(asset interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Asset", reflect.TypeOf((*MockManagedSubAccountDepositServiceAPI)(nil).Asset), asset)
+}
+
+// Do mocks base method.
+func (m *MockManagedSubAccountDepositServiceAPI) Do(ctx context.Context, opts ...binance.RequestOption) (*binance.ManagedSubAccountDepositResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*binance.ManagedSubAccountDepositResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockManagedSubAccountDepositServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockManagedSubAccountDepositServiceAPI)(nil).Do), varargs...)
+}
+
+// ToEmail mocks base method.
+func (m *MockManagedSubAccountDepositServiceAPI) ToEmail(email string) binance.ManagedSubAccountDepositServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ToEmail", email)
+	ret0, _ := ret[0].(binance.ManagedSubAccountDepositServiceAPI)
+	return ret0
+}
+
+// ToEmail indicates an expected call of ToEmail.
+func (mr *MockManagedSubAccountDepositServiceAPIMockRecorder) ToEmail(email interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ToEmail", reflect.TypeOf((*MockManagedSubAccountDepositServiceAPI)(nil).ToEmail), email)
+}
+
+// MockManagedSubAccountWithdrawalServiceAPI is a mock of ManagedSubAccountWithdrawalServiceAPI interface.
+type MockManagedSubAccountWithdrawalServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockManagedSubAccountWithdrawalServiceAPIMockRecorder
+}
+
+// MockManagedSubAccountWithdrawalServiceAPIMockRecorder is the mock recorder for MockManagedSubAccountWithdrawalServiceAPI.
+type MockManagedSubAccountWithdrawalServiceAPIMockRecorder struct {
+	mock *MockManagedSubAccountWithdrawalServiceAPI
+}
+
+// NewMockManagedSubAccountWithdrawalServiceAPI creates a new mock instance.
+func NewMockManagedSubAccountWithdrawalServiceAPI(ctrl *gomock.Controller) *MockManagedSubAccountWithdrawalServiceAPI {
+	mock := &MockManagedSubAccountWithdrawalServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockManagedSubAccountWithdrawalServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockManagedSubAccountWithdrawalServiceAPI) EXPECT() *MockManagedSubAccountWithdrawalServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Amount mocks base method.
+func (m *MockManagedSubAccountWithdrawalServiceAPI) Amount(amount string) binance.ManagedSubAccountWithdrawalServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Amount", amount)
+	ret0, _ := ret[0].(binance.ManagedSubAccountWithdrawalServiceAPI)
+	return ret0
+}
+
+// Amount indicates an expected call of Amount.
+func (mr *MockManagedSubAccountWithdrawalServiceAPIMockRecorder) Amount(amount interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Amount", reflect.TypeOf((*MockManagedSubAccountWithdrawalServiceAPI)(nil).Amount), amount)
+}
+
+// Asset mocks base method.
+func (m *MockManagedSubAccountWithdrawalServiceAPI) Asset(asset string) binance.ManagedSubAccountWithdrawalServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Asset", asset)
+	ret0, _ := ret[0].(binance.ManagedSubAccountWithdrawalServiceAPI)
+	return ret0
+}
+
+// Asset indicates an expected call of Asset.
+func (mr *MockManagedSubAccountWithdrawalServiceAPIMockRecorder) Asset(asset interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Asset", reflect.TypeOf((*MockManagedSubAccountWithdrawalServiceAPI)(nil).Asset), asset)
+}
+
+// Do mocks base method.
+func (m *MockManagedSubAccountWithdrawalServiceAPI) Do(ctx context.Context, opts ...binance.RequestOption) (*binance.ManagedSubAccountWithdrawalResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*binance.ManagedSubAccountWithdrawalResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockManagedSubAccountWithdrawalServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockManagedSubAccountWithdrawalServiceAPI)(nil).Do), varargs...)
+}
+
+// FromEmail mocks base method.
+func (m *MockManagedSubAccountWithdrawalServiceAPI) FromEmail(email string) binance.ManagedSubAccountWithdrawalServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FromEmail", email)
+	ret0, _ := ret[0].(binance.ManagedSubAccountWithdrawalServiceAPI)
+	return ret0
+}
+
+// FromEmail indicates an expected call of FromEmail.
+func (mr *MockManagedSubAccountWithdrawalServiceAPIMockRecorder) FromEmail(email interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FromEmail", reflect.TypeOf((*MockManagedSubAccountWithdrawalServiceAPI)(nil).FromEmail), email)
+}
+
+// TransferDate mocks base method.
+func (m *MockManagedSubAccountWithdrawalServiceAPI) TransferDate(val int64) binance.ManagedSubAccountWithdrawalServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TransferDate", val)
+	ret0, _ := ret[0].(binance.ManagedSubAccountWithdrawalServiceAPI)
+	return ret0
+}
+
+// TransferDate indicates an expected call of TransferDate.
+func (mr *MockManagedSubAccountWithdrawalServiceAPIMockRecorder) TransferDate(val interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TransferDate", reflect.TypeOf((*MockManagedSubAccountWithdrawalServiceAPI)(nil).TransferDate), val)
+}
+
+// MockManagedSubAccountAssetsServiceAPI is a mock of ManagedSubAccountAssetsServiceAPI interface.
+type MockManagedSubAccountAssetsServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockManagedSubAccountAssetsServiceAPIMockRecorder
+}
+
+// MockManagedSubAccountAssetsServiceAPIMockRecorder is the mock recorder for MockManagedSubAccountAssetsServiceAPI.
+type MockManagedSubAccountAssetsServiceAPIMockRecorder struct {
+	mock *MockManagedSubAccountAssetsServiceAPI
+}
+
+// NewMockManagedSubAccountAssetsServiceAPI creates a new mock instance.
+func NewMockManagedSubAccountAssetsServiceAPI(ctrl *gomock.Controller) *MockManagedSubAccountAssetsServiceAPI {
+	mock := &MockManagedSubAccountAssetsServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockManagedSubAccountAssetsServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockManagedSubAccountAssetsServiceAPI) EXPECT() *MockManagedSubAccountAssetsServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockManagedSubAccountAssetsServiceAPI) Do(ctx context.Context, opts ...binance.RequestOption) ([]*binance.ManagedSubAccountAsset, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].([]*binance.ManagedSubAccountAsset)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockManagedSubAccountAssetsServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockManagedSubAccountAssetsServiceAPI)(nil).Do), varargs...)
+}
+
+// Email mocks base method.
+func (m *MockManagedSubAccountAssetsServiceAPI) Email(email string) binance.ManagedSubAccountAssetsServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Email", email)
+	ret0, _ := ret[0].(binance.ManagedSubAccountAssetsServiceAPI)
+	return ret0
+}
+
+// Email indicates an expected call of Email.
+func (mr *MockManagedSubAccountAssetsServiceAPIMockRecorder) Email(email interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Email", reflect.TypeOf((*MockManagedSubAccountAssetsServiceAPI)(nil).Email), email)
+}
+
+// MockSubAccountFuturesAccountServiceAPI is a mock of SubAccountFuturesAccountServiceAPI interface.
+type MockSubAccountFuturesAccountServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockSubAccountFuturesAccountServiceAPIMockRecorder
+}
+
+// MockSubAccountFuturesAccountServiceAPIMockRecorder is the mock recorder for MockSubAccountFuturesAccountServiceAPI.
+type MockSubAccountFuturesAccountServiceAPIMockRecorder struct {
+	mock *MockSubAccountFuturesAccountServiceAPI
+}
+
+// NewMockSubAccountFuturesAccountServiceAPI creates a new mock instance.
+func NewMockSubAccountFuturesAccountServiceAPI(ctrl *gomock.Controller) *MockSubAccountFuturesAccountServiceAPI {
+	mock := &MockSubAccountFuturesAccountServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockSubAccountFuturesAccountServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSubAccountFuturesAccountServiceAPI) EXPECT() *MockSubAccountFuturesAccountServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockSubAccountFuturesAccountServiceAPI) Do(ctx context.Context, opts ...binance.RequestOption) (*binance.SubAccountFuturesAccount, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*binance.SubAccountFuturesAccount)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockSubAccountFuturesAccountServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockSubAccountFuturesAccountServiceAPI)(nil).Do), varargs...)
+}
+
+// Email mocks base method.
+func (m *MockSubAccountFuturesAccountServiceAPI) Email(v string) binance.SubAccountFuturesAccountServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Email", v)
+	ret0, _ := ret[0].(binance.SubAccountFuturesAccountServiceAPI)
+	return ret0
+}
+
+// Email indicates an expected call of Email.
+func (mr *MockSubAccountFuturesAccountServiceAPIMockRecorder) Email(v interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Email", reflect.TypeOf((*MockSubAccountFuturesAccountServiceAPI)(nil).Email), v)
+}
+
+// MockSubAccountFuturesSummaryV1ServiceAPI is a mock of SubAccountFuturesSummaryV1ServiceAPI interface.
+type MockSubAccountFuturesSummaryV1ServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockSubAccountFuturesSummaryV1ServiceAPIMockRecorder
+}
+
+// MockSubAccountFuturesSummaryV1ServiceAPIMockRecorder is the mock recorder for MockSubAccountFuturesSummaryV1ServiceAPI.
+type MockSubAccountFuturesSummaryV1ServiceAPIMockRecorder struct {
+	mock *MockSubAccountFuturesSummaryV1ServiceAPI
+}
+
+// NewMockSubAccountFuturesSummaryV1ServiceAPI creates a new mock instance.
+func NewMockSubAccountFuturesSummaryV1ServiceAPI(ctrl *gomock.Controller) *MockSubAccountFuturesSummaryV1ServiceAPI {
+	mock := &MockSubAccountFuturesSummaryV1ServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockSubAccountFuturesSummaryV1ServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSubAccountFuturesSummaryV1ServiceAPI) EXPECT() *MockSubAccountFuturesSummaryV1ServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockSubAccountFuturesSummaryV1ServiceAPI) Do(ctx context.Context, opts ...binance.RequestOption) (*binance.SubAccountFuturesSummaryV1, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*binance.SubAccountFuturesSummaryV1)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockSubAccountFuturesSummaryV1ServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockSubAccountFuturesSummaryV1ServiceAPI)(nil).Do), varargs...)
+}
+
+// MockSubAccountFuturesTransferV1ServiceAPI is a mock of SubAccountFuturesTransferV1ServiceAPI interface.
+type MockSubAccountFuturesTransferV1ServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockSubAccountFuturesTransferV1ServiceAPIMockRecorder
+}
+
+// MockSubAccountFuturesTransferV1ServiceAPIMockRecorder is the mock recorder for MockSubAccountFuturesTransferV1ServiceAPI.
+type MockSubAccountFuturesTransferV1ServiceAPIMockRecorder struct {
+	mock *MockSubAccountFuturesTransferV1ServiceAPI
+}
+
+// NewMockSubAccountFuturesTransferV1ServiceAPI creates a new mock instance.
+func NewMockSubAccountFuturesTransferV1ServiceAPI(ctrl *gomock.Controller) *MockSubAccountFuturesTransferV1ServiceAPI {
+	mock := &MockSubAccountFuturesTransferV1ServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockSubAccountFuturesTransferV1ServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSubAccountFuturesTransferV1ServiceAPI) EXPECT() *MockSubAccountFuturesTransferV1ServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Amount mocks base method.
+func (m *MockSubAccountFuturesTransferV1ServiceAPI) Amount(v float64) binance.SubAccountFuturesTransferV1ServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Amount", v)
+	ret0, _ := ret[0].(binance.SubAccountFuturesTransferV1ServiceAPI)
+	return ret0
+}
+
+// Amount indicates an expected call of Amount.
+func (mr *MockSubAccountFuturesTransferV1ServiceAPIMockRecorder) Amount(v interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Amount", reflect.TypeOf((*MockSubAccountFuturesTransferV1ServiceAPI)(nil).Amount), v)
+}
+
+// Asset mocks base method.
+func (m *MockSubAccountFuturesTransferV1ServiceAPI) Asset(v string) binance.SubAccountFuturesTransferV1ServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Asset", v)
+	ret0, _ := ret[0].(binance.SubAccountFuturesTransferV1ServiceAPI)
+	return ret0
+}
+
+// Asset indicates an expected call of Asset.
+func (mr *MockSubAccountFuturesTransferV1ServiceAPIMockRecorder) Asset(v interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Asset", reflect.TypeOf((*MockSubAccountFuturesTransferV1ServiceAPI)(nil).Asset), v)
+}
+
+// Do mocks base method.
+func (m *MockSubAccountFuturesTransferV1ServiceAPI) Do(ctx context.Context, opts ...binance.RequestOption) (*binance.SubAccountFuturesTransferResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*binance.SubAccountFuturesTransferResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockSubAccountFuturesTransferV1ServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockSubAccountFuturesTransferV1ServiceAPI)(nil).Do), varargs...)
+}
+
+// Email mocks base method.
+func (m *MockSubAccountFuturesTransferV1ServiceAPI) Email(v string) binance.SubAccountFuturesTransferV1ServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Email", v)
+	ret0, _ := ret[0].(binance.SubAccountFuturesTransferV1ServiceAPI)
+	return ret0
+}
+
+// Email indicates an expected call of Email.
+func (mr *MockSubAccountFuturesTransferV1ServiceAPIMockRecorder) Email(v interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Email", reflect.TypeOf((*MockSubAccountFuturesTransferV1ServiceAPI)(nil).Email), v)
+}
+
+// TransferType mocks base method.
+func (m *MockSubAccountFuturesTransferV1ServiceAPI) TransferType(v int) binance.SubAccountFuturesTransferV1ServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TransferType", v)
+	ret0, _ := ret[0].(binance.SubAccountFuturesTransferV1ServiceAPI)
+	return ret0
+}
+
+// TransferType indicates an expected call of TransferType.
+func (mr *MockSubAccountFuturesTransferV1ServiceAPIMockRecorder) TransferType(v interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TransferType", reflect.TypeOf((*MockSubAccountFuturesTransferV1ServiceAPI)(nil).TransferType), v)
+}
+
+// MockSubAccountTransferHistoryServiceAPI is a mock of SubAccountTransferHistoryServiceAPI interface.
+type MockSubAccountTransferHistoryServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockSubAccountTransferHistoryServiceAPIMockRecorder
+}
+
+// MockSubAccountTransferHistoryServiceAPIMockRecorder is the mock recorder for MockSubAccountTransferHistoryServiceAPI.
+type MockSubAccountTransferHistoryServiceAPIMockRecorder struct {
+	mock *MockSubAccountTransferHistoryServiceAPI
+}
+
+// NewMockSubAccountTransferHistoryServiceAPI creates a new mock instance.
+func NewMockSubAccountTransferHistoryServiceAPI(ctrl *gomock.Controller) *MockSubAccountTransferHistoryServiceAPI {
+	mock := &MockSubAccountTransferHistoryServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockSubAccountTransferHistoryServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSubAccountTransferHistoryServiceAPI) EXPECT() *MockSubAccountTransferHistoryServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Asset mocks base method.
+func (m *MockSubAccountTransferHistoryServiceAPI) Asset(v string) binance.SubAccountTransferHistoryServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Asset", v)
+	ret0, _ := ret[0].(binance.SubAccountTransferHistoryServiceAPI)
+	return ret0
+}
+
+// Asset indicates an expected call of Asset.
+func (mr *MockSubAccountTransferHistoryServiceAPIMockRecorder) Asset(v interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Asset", reflect.TypeOf((*MockSubAccountTransferHistoryServiceAPI)(nil).Asset), v)
+}
+
+// Do mocks base method.
+func (m *MockSubAccountTransferHistoryServiceAPI) Do(ctx context.Context, opts ...binance.RequestOption) ([]*binance.SubAccountTransferHistory, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].([]*binance.SubAccountTransferHistory)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockSubAccountTransferHistoryServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockSubAccountTransferHistoryServiceAPI)(nil).Do), varargs...)
+}
+
+// EndTime mocks base method.
+func (m *MockSubAccountTransferHistoryServiceAPI) EndTime(v int64) binance.SubAccountTransferHistoryServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EndTime", v)
+	ret0, _ := ret[0].(binance.SubAccountTransferHistoryServiceAPI)
+	return ret0
+}
+
+// EndTime indicates an expected call of EndTime.
+func (mr *MockSubAccountTransferHistoryServiceAPIMockRecorder) EndTime(v interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EndTime", reflect.TypeOf((*MockSubAccountTransferHistoryServiceAPI)(nil).EndTime), v)
+}
+
+// Limit mocks base method.
+func (m *MockSubAccountTransferHistoryServiceAPI) Limit(v int) binance.SubAccountTransferHistoryServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Limit", v)
+	ret0, _ := ret[0].(binance.SubAccountTransferHistoryServiceAPI)
+	return ret0
+}
+
+// Limit indicates an expected call of Limit.
+func (mr *MockSubAccountTransferHistoryServiceAPIMockRecorder) Limit(v interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Limit", reflect.TypeOf((*MockSubAccountTransferHistoryServiceAPI)(nil).Limit), v)
+}
+
+// ReturnFailHistory mocks base method.
+func (m *MockSubAccountTransferHistoryServiceAPI) ReturnFailHistory(v bool) binance.SubAccountTransferHistoryServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReturnFailHistory", v)
+	ret0, _ := ret[0].(binance.SubAccountTransferHistoryServiceAPI)
+	return ret0
+}
+
+// ReturnFailHistory indicates an expected call of ReturnFailHistory.
+func (mr *MockSubAccountTransferHistoryServiceAPIMockRecorder) ReturnFailHistory(v interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReturnFailHistory", reflect.TypeOf((*MockSubAccountTransferHistoryServiceAPI)(nil).ReturnFailHistory), v)
+}
+
+// StartTime mocks base method.
+func (m *MockSubAccountTransferHistoryServiceAPI) StartTime(v int64) binance.SubAccountTransferHistoryServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartTime", v)
+	ret0, _ := ret[0].(binance.SubAccountTransferHistoryServiceAPI)
+	return ret0
+}
+
+// StartTime indicates an expected call of StartTime.
+func (mr *MockSubAccountTransferHistoryServiceAPIMockRecorder) StartTime(v interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartTime", reflect.TypeOf((*MockSubAccountTransferHistoryServiceAPI)(nil).StartTime), v)
+}
+
+// TransferType mocks base method.
+func (m *MockSubAccountTransferHistoryServiceAPI) TransferType(v binance.SubAccountTransferType) binance.SubAccountTransferHistoryServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TransferType", v)
+	ret0, _ := ret[0].(binance.SubAccountTransferHistoryServiceAPI)
+	return ret0
+}
+
+// TransferType indicates an expected call of TransferType.
+func (mr *MockSubAccountTransferHistoryServiceAPIMockRecorder) TransferType(v interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TransferType", reflect.TypeOf((*MockSubAccountTransferHistoryServiceAPI)(nil).TransferType), v)
+}
+
+// MockListUserUniversalTransferServiceAPI is a mock of ListUserUniversalTransferServiceAPI interface.
+type MockListUserUniversalTransferServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockListUserUniversalTransferServiceAPIMockRecorder
+}
+
+// MockListUserUniversalTransferServiceAPIMockRecorder is the mock recorder for MockListUserUniversalTransferServiceAPI.
+type MockListUserUniversalTransferServiceAPIMockRecorder struct {
+	mock *MockListUserUniversalTransferServiceAPI
+}
+
+// NewMockListUserUniversalTransferServiceAPI creates a new mock instance.
+func NewMockListUserUniversalTransferServiceAPI(ctrl *gomock.Controller) *MockListUserUniversalTransferServiceAPI {
+	mock := &MockListUserUniversalTransferServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockListUserUniversalTransferServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockListUserUniversalTransferServiceAPI) EXPECT() *MockListUserUniversalTransferServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Current mocks base method.
+func (m *MockListUserUniversalTransferServiceAPI) Current(v int) binance.ListUserUniversalTransferServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Current", v)
+	ret0, _ := ret[0].(binance.ListUserUniversalTransferServiceAPI)
+	return ret0
+}
+
+// Current indicates an expected call of Current.
+func (mr *MockListUserUniversalTransferServiceAPIMockRecorder) Current(v interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Current", reflect.TypeOf((*MockListUserUniversalTransferServiceAPI)(nil).Current), v)
+}
+
+// Do mocks base method.
+func (m *MockListUserUniversalTransferServiceAPI) Do(ctx context.Context) (*binance.UserUniversalTransferResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Do", ctx)
+	ret0, _ := ret[0].(*binance.UserUniversalTransferResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockListUserUniversalTransferServiceAPIMockRecorder) Do(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockListUserUniversalTransferServiceAPI)(nil).Do), ctx)
+}
+
+// EndTime mocks base method.
+func (m *MockListUserUniversalTransferServiceAPI) EndTime(v int64) binance.ListUserUniversalTransferServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EndTime", v)
+	ret0, _ := ret[0].(binance.ListUserUniversalTransferServiceAPI)
+	return ret0
+}
+
+// EndTime indicates an expected call of EndTime.
+func (mr *MockListUserUniversalTransferServiceAPIMockRecorder) EndTime(v interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EndTime", reflect.TypeOf((*MockListUserUniversalTransferServiceAPI)(nil).EndTime), v)
+}
+
+// FromSymbol mocks base method.
+func (m *MockListUserUniversalTransferServiceAPI) FromSymbol(v string) binance.ListUserUniversalTransferServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FromSymbol", v)
+	ret0, _ := ret[0].(binance.ListUserUniversalTransferServiceAPI)
+	return ret0
+}
+
+// FromSymbol indicates an expected call of FromSymbol.
+func (mr *MockListUserUniversalTransferServiceAPIMockRecorder) FromSymbol(v interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FromSymbol", reflect.TypeOf((*MockListUserUniversalTransferServiceAPI)(nil).FromSymbol), v)
+}
+
+// Size mocks base method.
+func (m *MockListUserUniversalTransferServiceAPI) Size(v int) binance.ListUserUniversalTransferServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Size", v)
+	ret0, _ := ret[0].(binance.ListUserUniversalTransferServiceAPI)
+	return ret0
+}
+
+// Size indicates an expected call of Size.
+func (mr *MockListUserUniversalTransferServiceAPIMockRecorder) Size(v interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Size", reflect.TypeOf((*MockListUserUniversalTransferServiceAPI)(nil).Size), v)
+}
+
+// StartTime mocks base method.
+func (m *MockListUserUniversalTransferServiceAPI) StartTime(v int64) binance.ListUserUniversalTransferServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartTime", v)
+	ret0, _ := ret[0].(binance.ListUserUniversalTransferServiceAPI)
+	return ret0
+}
+
+// StartTime indicates an expected call of StartTime.
+func (mr *MockListUserUniversalTransferServiceAPIMockRecorder) StartTime(v interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartTime", reflect.TypeOf((*MockListUserUniversalTransferServiceAPI)(nil).StartTime), v)
+}
+
+// ToSymbol mocks base method.
+func (m *MockListUserUniversalTransferServiceAPI) ToSymbol(v string) binance.ListUserUniversalTransferServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ToSymbol", v)
+	ret0, _ := ret[0].(binance.ListUserUniversalTransferServiceAPI)
+	return ret0
+}
+
+// ToSymbol indicates an expected call of ToSymbol.
+func (mr *MockListUserUniversalTransferServiceAPIMockRecorder) ToSymbol(v interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ToSymbol", reflect.TypeOf((*MockListUserUniversalTransferServiceAPI)(nil).ToSymbol), v)
+}
+
+// Type mocks base method.
+func (m *MockListUserUniversalTransferServiceAPI) Type(v binance.UserUniversalTransferType) binance.ListUserUniversalTransferServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Type", v)
+	ret0, _ := ret[0].(binance.ListUserUniversalTransferServiceAPI)
+	return ret0
+}
+
+// Type indicates an expected call of Type.
+func (mr *MockListUserUniversalTransferServiceAPIMockRecorder) Type(v interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Type", reflect.TypeOf((*MockListUserUniversalTransferServiceAPI)(nil).Type), v)
+}
+
+// MockCreateVirtualSubAccountServiceAPI is a mock of CreateVirtualSubAccountServiceAPI interface.
+type MockCreateVirtualSubAccountServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockCreateVirtualSubAccountServiceAPIMockRecorder
+}
+
+// MockCreateVirtualSubAccountServiceAPIMockRecorder is the mock recorder for MockCreateVirtualSubAccountServiceAPI.
+type MockCreateVirtualSubAccountServiceAPIMockRecorder struct {
+	mock *MockCreateVirtualSubAccountServiceAPI
+}
+
+// NewMockCreateVirtualSubAccountServiceAPI creates a new mock instance.
+func NewMockCreateVirtualSubAccountServiceAPI(ctrl *gomock.Controller) *MockCreateVirtualSubAccountServiceAPI {
+	mock := &MockCreateVirtualSubAccountServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockCreateVirtualSubAccountServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCreateVirtualSubAccountServiceAPI) EXPECT() *MockCreateVirtualSubAccountServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockCreateVirtualSubAccountServiceAPI) Do(ctx context.Context, opts ...binance.RequestOption) (*binance.CreateVirtualSubAccountResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*binance.CreateVirtualSubAccountResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockCreateVirtualSubAccountServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockCreateVirtualSubAccountServiceAPI)(nil).Do), varargs...)
+}
+
+// RecvWindow mocks base method.
+func (m *MockCreateVirtualSubAccountServiceAPI) RecvWindow(recvWindow int64) binance.CreateVirtualSubAccountServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecvWindow", recvWindow)
+	ret0, _ := ret[0].(binance.CreateVirtualSubAccountServiceAPI)
+	return ret0
+}
+
+// RecvWindow indicates an expected call of RecvWindow.
+func (mr *MockCreateVirtualSubAccountServiceAPIMockRecorder) RecvWindow(recvWindow interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvWindow", reflect.TypeOf((*MockCreateVirtualSubAccountServiceAPI)(nil).RecvWindow), recvWindow)
+}
+
+// SubAccountString mocks base method.
+func (m *MockCreateVirtualSubAccountServiceAPI) SubAccountString(subAccountString string) binance.CreateVirtualSubAccountServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SubAccountString", subAccountString)
+	ret0, _ := ret[0].(binance.CreateVirtualSubAccountServiceAPI)
+	return ret0
+}
+
+// SubAccountString indicates an expected call of SubAccountString.
+func (mr *MockCreateVirtualSubAccountServiceAPIMockRecorder) SubAccountString(subAccountString interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubAccountString", reflect.TypeOf((*MockCreateVirtualSubAccountServiceAPI)(nil).SubAccountString), subAccountString)
+}
+
+// MockSubAccountSpotTransferHistoryServiceAPI is a mock of SubAccountSpotTransferHistoryServiceAPI interface.
+type MockSubAccountSpotTransferHistoryServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockSubAccountSpotTransferHistoryServiceAPIMockRecorder
+}
+
+// MockSubAccountSpotTransferHistoryServiceAPIMockRecorder is the mock recorder for MockSubAccountSpotTransferHistoryServiceAPI.
+type MockSubAccountSpotTransferHistoryServiceAPIMockRecorder struct {
+	mock *MockSubAccountSpotTransferHistoryServiceAPI
+}
+
+// NewMockSubAccountSpotTransferHistoryServiceAPI creates a new mock instance.
+func NewMockSubAccountSpotTransferHistoryServiceAPI(ctrl *gomock.Controller) *MockSubAccountSpotTransferHistoryServiceAPI {
+	mock := &MockSubAccountSpotTransferHistoryServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockSubAccountSpotTransferHistoryServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSubAccountSpotTransferHistoryServiceAPI) EXPECT() *MockSubAccountSpotTransferHistoryServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockSubAccountSpotTransferHistoryServiceAPI) Do(ctx context.Context, opts ...binance.RequestOption) ([]*binance.SubAccountSpotTransfer, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].([]*binance.SubAccountSpotTransfer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockSubAccountSpotTransferHistoryServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockSubAccountSpotTransferHistoryServiceAPI)(nil).Do), varargs...)
+}
+
+// EndTime mocks base method.
+func (m *MockSubAccountSpotTransferHistoryServiceAPI) EndTime(endTime uint64) binance.SubAccountSpotTransferHistoryServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EndTime", endTime)
+	ret0, _ := ret[0].(binance.SubAccountSpotTransferHistoryServiceAPI)
+	return ret0
+}
+
+// EndTime indicates an expected call of EndTime.
+func (mr *MockSubAccountSpotTransferHistoryServiceAPIMockRecorder) EndTime(endTime interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EndTime", reflect.TypeOf((*MockSubAccountSpotTransferHistoryServiceAPI)(nil).EndTime), endTime)
+}
+
+// FromEmail mocks base method.
+func (m *MockSubAccountSpotTransferHistoryServiceAPI) FromEmail(fromEmail string) binance.SubAccountSpotTransferHistoryServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FromEmail", fromEmail)
+	ret0, _ := ret[0].(binance.SubAccountSpotTransferHistoryServiceAPI)
+	return ret0
+}
+
+// FromEmail indicates an expected call of FromEmail.
+func (mr *MockSubAccountSpotTransferHistoryServiceAPIMockRecorder) FromEmail(fromEmail interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FromEmail", reflect.TypeOf((*MockSubAccountSpotTransferHistoryServiceAPI)(nil).FromEmail), fromEmail)
+}
+
+// Limit mocks base method.
+func (m *MockSubAccountSpotTransferHistoryServiceAPI) Limit(limit int32) binance.SubAccountSpotTransferHistoryServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Limit", limit)
+	ret0, _ := ret[0].(binance.SubAccountSpotTransferHistoryServiceAPI)
+	return ret0
+}
+
+// Limit indicates an expected call of Limit.
+func (mr *MockSubAccountSpotTransferHistoryServiceAPIMockRecorder) Limit(limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Limit", reflect.TypeOf((*MockSubAccountSpotTransferHistoryServiceAPI)(nil).Limit), limit)
+}
+
+// Page mocks base method.
+func (m *MockSubAccountSpotTransferHistoryServiceAPI) Page(page int32) binance.SubAccountSpotTransferHistoryServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Page", page)
+	ret0, _ := ret[0].(binance.SubAccountSpotTransferHistoryServiceAPI)
+	return ret0
+}
+
+// Page indicates an expected call of Page.
+func (mr *MockSubAccountSpotTransferHistoryServiceAPIMockRecorder) Page(page interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Page", reflect.TypeOf((*MockSubAccountSpotTransferHistoryServiceAPI)(nil).Page), page)
+}
+
+// RecvWindow mocks base method.
+func (m *MockSubAccountSpotTransferHistoryServiceAPI) RecvWindow(recvWindow int64) binance.SubAccountSpotTransferHistoryServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecvWindow", recvWindow)
+	ret0, _ := ret[0].(binance.SubAccountSpotTransferHistoryServiceAPI)
+	return ret0
+}
+
+// RecvWindow indicates an expected call of RecvWindow.
+func (mr *MockSubAccountSpotTransferHistoryServiceAPIMockRecorder) RecvWindow(recvWindow interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvWindow", reflect.TypeOf((*MockSubAccountSpotTransferHistoryServiceAPI)(nil).RecvWindow), recvWindow)
+}
+
+// StartTime mocks base method.
+func (m *MockSubAccountSpotTransferHistoryServiceAPI) StartTime(startTime uint64) binance.SubAccountSpotTransferHistoryServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartTime", startTime)
+	ret0, _ := ret[0].(binance.SubAccountSpotTransferHistoryServiceAPI)
+	return ret0
+}
+
+// StartTime indicates an expected call of StartTime.
+func (mr *MockSubAccountSpotTransferHistoryServiceAPIMockRecorder) StartTime(startTime interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartTime", reflect.TypeOf((*MockSubAccountSpotTransferHistoryServiceAPI)(nil).StartTime), startTime)
+}
+
+// ToEmail mocks base method.
+func (m *MockSubAccountSpotTransferHistoryServiceAPI) ToEmail(toEmail string) binance.SubAccountSpotTransferHistoryServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ToEmail", toEmail)
+	ret0, _ := ret[0].(binance.SubAccountSpotTransferHistoryServiceAPI)
+	return ret0
+}
+
+// ToEmail indicates an expected call of ToEmail.
+func (mr *MockSubAccountSpotTransferHistoryServiceAPIMockRecorder) ToEmail(toEmail interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ToEmail", reflect.TypeOf((*MockSubAccountSpotTransferHistoryServiceAPI)(nil).ToEmail), toEmail)
+}
+
+// MockSubAccountFuturesTransferHistoryServiceAPI is a mock of SubAccountFuturesTransferHistoryServiceAPI interface.
+type MockSubAccountFuturesTransferHistoryServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockSubAccountFuturesTransferHistoryServiceAPIMockRecorder
+}
+
+// MockSubAccountFuturesTransferHistoryServiceAPIMockRecorder is the mock recorder for MockSubAccountFuturesTransferHistoryServiceAPI.
+type MockSubAccountFuturesTransferHistoryServiceAPIMockRecorder struct {
+	mock *MockSubAccountFuturesTransferHistoryServiceAPI
+}
+
+// NewMockSubAccountFuturesTransferHistoryServiceAPI creates a new mock instance.
+func NewMockSubAccountFuturesTransferHistoryServiceAPI(ctrl *gomock.Controller) *MockSubAccountFuturesTransferHistoryServiceAPI {
+	mock := &MockSubAccountFuturesTransferHistoryServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockSubAccountFuturesTransferHistoryServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSubAccountFuturesTransferHistoryServiceAPI) EXPECT() *MockSubAccountFuturesTransferHistoryServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockSubAccountFuturesTransferHistoryServiceAPI) Do(ctx context.Context, opts ...binance.RequestOption) (*binance.SubAccountFuturesTransferHistoryResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*binance.SubAccountFuturesTransferHistoryResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockSubAccountFuturesTransferHistoryServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockSubAccountFuturesTransferHistoryServiceAPI)(nil).Do), varargs...)
+}
+
+// Email mocks base method.
+func (m *MockSubAccountFuturesTransferHistoryServiceAPI) Email(email string) binance.SubAccountFuturesTransferHistoryServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Email", email)
+	ret0, _ := ret[0].(binance.SubAccountFuturesTransferHistoryServiceAPI)
+	return ret0
+}
+
+// Email indicates an expected call of Email.
+func (mr *MockSubAccountFuturesTransferHistoryServiceAPIMockRecorder) Email(email interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Email", reflect.TypeOf((*MockSubAccountFuturesTransferHistoryServiceAPI)(nil).Email), email)
+}
+
+// EndTime mocks base method.
+func (m *MockSubAccountFuturesTransferHistoryServiceAPI) EndTime(endTime int64) binance.SubAccountFuturesTransferHistoryServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EndTime", endTime)
+	ret0, _ := ret[0].(binance.SubAccountFuturesTransferHistoryServiceAPI)
+	return ret0
+}
+
+// EndTime indicates an expected call of EndTime.
+func (mr *MockSubAccountFuturesTransferHistoryServiceAPIMockRecorder) EndTime(endTime interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EndTime", reflect.TypeOf((*MockSubAccountFuturesTransferHistoryServiceAPI)(nil).EndTime), endTime)
+}
+
+// FuturesType mocks base method.
+func (m *MockSubAccountFuturesTransferHistoryServiceAPI) FuturesType(futuresType int64) binance.SubAccountFuturesTransferHistoryServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FuturesType", futuresType)
+	ret0, _ := ret[0].(binance.SubAccountFuturesTransferHistoryServiceAPI)
+	return ret0
+}
+
+// FuturesType indicates an expected call of FuturesType.
+func (mr *MockSubAccountFuturesTransferHistoryServiceAPIMockRecorder) FuturesType(futuresType interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FuturesType", reflect.TypeOf((*MockSubAccountFuturesTransferHistoryServiceAPI)(nil).FuturesType), futuresType)
+}
+
+// Limit mocks base method.
+func (m *MockSubAccountFuturesTransferHistoryServiceAPI) Limit(limit int32) binance.SubAccountFuturesTransferHistoryServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Limit", limit)
+	ret0, _ := ret[0].(binance.SubAccountFuturesTransferHistoryServiceAPI)
+	return ret0
+}
+
+// Limit indicates an expected call of Limit.
+func (mr *MockSubAccountFuturesTransferHistoryServiceAPIMockRecorder) Limit(limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Limit", reflect.TypeOf((*MockSubAccountFuturesTransferHistoryServiceAPI)(nil).Limit), limit)
+}
+
+// Page mocks base method.
+func (m *MockSubAccountFuturesTransferHistoryServiceAPI) Page(page int32) binance.SubAccountFuturesTransferHistoryServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Page", page)
+	ret0, _ := ret[0].(binance.SubAccountFuturesTransferHistoryServiceAPI)
+	return ret0
+}
+
+// Page indicates an expected call of Page.
+func (mr *MockSubAccountFuturesTransferHistoryServiceAPIMockRecorder) Page(page interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Page", reflect.TypeOf((*MockSubAccountFuturesTransferHistoryServiceAPI)(nil).Page), page)
+}
+
+// RecvWindow mocks base method.
+func (m *MockSubAccountFuturesTransferHistoryServiceAPI) RecvWindow(recvWindow int64) binance.SubAccountFuturesTransferHistoryServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecvWindow", recvWindow)
+	ret0, _ := ret[0].(binance.SubAccountFuturesTransferHistoryServiceAPI)
+	return ret0
+}
+
+// RecvWindow indicates an expected call of RecvWindow.
+func (mr *MockSubAccountFuturesTransferHistoryServiceAPIMockRecorder) RecvWindow(recvWindow interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvWindow", reflect.TypeOf((*MockSubAccountFuturesTransferHistoryServiceAPI)(nil).RecvWindow), recvWindow)
+}
+
+// StartTime mocks base method.
+func (m *MockSubAccountFuturesTransferHistoryServiceAPI) StartTime(startTime int64) binance.SubAccountFuturesTransferHistoryServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartTime", startTime)
+	ret0, _ := ret[0].(binance.SubAccountFuturesTransferHistoryServiceAPI)
+	return ret0
+}
+
+// StartTime indicates an expected call of StartTime.
+func (mr *MockSubAccountFuturesTransferHistoryServiceAPIMockRecorder) StartTime(startTime interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartTime", reflect.TypeOf((*MockSubAccountFuturesTransferHistoryServiceAPI)(nil).StartTime), startTime)
+}
+
+// MockSubAccountDepositRecordServiceAPI is a mock of SubAccountDepositRecordServiceAPI interface.
+type MockSubAccountDepositRecordServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockSubAccountDepositRecordServiceAPIMockRecorder
+}
+
+// MockSubAccountDepositRecordServiceAPIMockRecorder is the mock recorder for MockSubAccountDepositRecordServiceAPI.
+type MockSubAccountDepositRecordServiceAPIMockRecorder struct {
+	mock *MockSubAccountDepositRecordServiceAPI
+}
+
+// NewMockSubAccountDepositRecordServiceAPI creates a new mock instance.
+func NewMockSubAccountDepositRecordServiceAPI(ctrl *gomock.Controller) *MockSubAccountDepositRecordServiceAPI {
+	mock := &MockSubAccountDepositRecordServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockSubAccountDepositRecordServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSubAccountDepositRecordServiceAPI) EXPECT() *MockSubAccountDepositRecordServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Coin mocks base method.
+func (m *MockSubAccountDepositRecordServiceAPI) Coin(coin string) binance.SubAccountDepositRecordServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Coin", coin)
+	ret0, _ := ret[0].(binance.SubAccountDepositRecordServiceAPI)
+	return ret0
+}
+
+// Coin indicates an expected call of Coin.
+func (mr *MockSubAccountDepositRecordServiceAPIMockRecorder) Coin(coin interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Coin", reflect.TypeOf((*MockSubAccountDepositRecordServiceAPI)(nil).Coin), coin)
+}
+
+// Do mocks base method.
+func (m *MockSubAccountDepositRecordServiceAPI) Do(ctx context.Context, opts ...binance.RequestOption) ([]*binance.SubAccountDepositRecord, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].([]*binance.SubAccountDepositRecord)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockSubAccountDepositRecordServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockSubAccountDepositRecordServiceAPI)(nil).Do), varargs...)
+}
+
+// Email mocks base method.
+func (m *MockSubAccountDepositRecordServiceAPI) Email(email string) binance.SubAccountDepositRecordServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Email", email)
+	ret0, _ := ret[0].(binance.SubAccountDepositRecordServiceAPI)
+	return ret0
+}
+
+// Email indicates an expected call of Email.
+func (mr *MockSubAccountDepositRecordServiceAPIMockRecorder) Email(email interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Email", reflect.TypeOf((*MockSubAccountDepositRecordServiceAPI)(nil).Email), email)
+}
+
+// EndTime mocks base method.
+func (m *MockSubAccountDepositRecordServiceAPI) EndTime(endTime int64) binance.SubAccountDepositRecordServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EndTime", endTime)
+	ret0, _ := ret[0].(binance.SubAccountDepositRecordServiceAPI)
+	return ret0
+}
+
+// EndTime indicates an expected call of EndTime.
+func (mr *MockSubAccountDepositRecordServiceAPIMockRecorder) EndTime(endTime interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EndTime", reflect.TypeOf((*MockSubAccountDepositRecordServiceAPI)(nil).EndTime), endTime)
+}
+
+// Limit mocks base method.
+func (m *MockSubAccountDepositRecordServiceAPI) Limit(limit int) binance.SubAccountDepositRecordServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Limit", limit)
+	ret0, _ := ret[0].(binance.SubAccountDepositRecordServiceAPI)
+	return ret0
+}
+
+// Limit indicates an expected call of Limit.
+func (mr *MockSubAccountDepositRecordServiceAPIMockRecorder) Limit(limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Limit", reflect.TypeOf((*MockSubAccountDepositRecordServiceAPI)(nil).Limit), limit)
+}
+
+// Offset mocks base method.
+func (m *MockSubAccountDepositRecordServiceAPI) Offset(offset int) binance.SubAccountDepositRecordServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Offset", offset)
+	ret0, _ := ret[0].(binance.SubAccountDepositRecordServiceAPI)
+	return ret0
+}
+
+// Offset indicates an expected call of Offset.
+func (mr *MockSubAccountDepositRecordServiceAPIMockRecorder) Offset(offset interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Offset", reflect.TypeOf((*MockSubAccountDepositRecordServiceAPI)(nil).Offset), offset)
+}
+
+// RecvWindow mocks base method.
+func (m *MockSubAccountDepositRecordServiceAPI) RecvWindow(recvWindow int64) binance.SubAccountDepositRecordServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecvWindow", recvWindow)
+	ret0, _ := ret[0].(binance.SubAccountDepositRecordServiceAPI)
+	return ret0
+}
+
+// RecvWindow indicates an expected call of RecvWindow.
+func (mr *MockSubAccountDepositRecordServiceAPIMockRecorder) RecvWindow(recvWindow interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvWindow", reflect.TypeOf((*MockSubAccountDepositRecordServiceAPI)(nil).RecvWindow), recvWindow)
+}
+
+// StartTime mocks base method.
+func (m *MockSubAccountDepositRecordServiceAPI) StartTime(startTime int64) binance.SubAccountDepositRecordServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartTime", startTime)
+	ret0, _ := ret[0].(binance.SubAccountDepositRecordServiceAPI)
+	return ret0
+}
+
+// StartTime indicates an expected call of StartTime.
+func (mr *MockSubAccountDepositRecordServiceAPIMockRecorder) StartTime(startTime interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartTime", reflect.TypeOf((*MockSubAccountDepositRecordServiceAPI)(nil).StartTime), startTime)
+}
+
+// Status mocks base method.
+func (m *MockSubAccountDepositRecordServiceAPI) Status(status int32) binance.SubAccountDepositRecordServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Status", status)
+	ret0, _ := ret[0].(binance.SubAccountDepositRecordServiceAPI)
+	return ret0
+}
+
+// Status indicates an expected call of Status.
+func (mr *MockSubAccountDepositRecordServiceAPIMockRecorder) Status(status interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Status", reflect.TypeOf((*MockSubAccountDepositRecordServiceAPI)(nil).Status), status)
+}
+
+// TxId mocks base method.
+func (m *MockSubAccountDepositRecordServiceAPI) TxId(txId string) binance.SubAccountDepositRecordServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TxId", txId)
+	ret0, _ := ret[0].(binance.SubAccountDepositRecordServiceAPI)
+	return ret0
+}
+
+// TxId indicates an expected call of TxId.
+func (mr *MockSubAccountDepositRecordServiceAPIMockRecorder) TxId(txId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TxId", reflect.TypeOf((*MockSubAccountDepositRecordServiceAPI)(nil).TxId), txId)
+}
+
+// MockSubAccountMarginFuturesStatusServiceAPI is a mock of SubAccountMarginFuturesStatusServiceAPI interface.
+type MockSubAccountMarginFuturesStatusServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockSubAccountMarginFuturesStatusServiceAPIMockRecorder
+}
+
+// MockSubAccountMarginFuturesStatusServiceAPIMockRecorder is the mock recorder for MockSubAccountMarginFuturesStatusServiceAPI.
+type MockSubAccountMarginFuturesStatusServiceAPIMockRecorder struct {
+	mock *MockSubAccountMarginFuturesStatusServiceAPI
+}
+
+// NewMockSubAccountMarginFuturesStatusServiceAPI creates a new mock instance.
+func NewMockSubAccountMarginFuturesStatusServiceAPI(ctrl *gomock.Controller) *MockSubAccountMarginFuturesStatusServiceAPI {
+	mock := &MockSubAccountMarginFuturesStatusServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockSubAccountMarginFuturesStatusServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSubAccountMarginFuturesStatusServiceAPI) EXPECT() *MockSubAccountMarginFuturesStatusServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockSubAccountMarginFuturesStatusServiceAPI) Do(ctx context.Context, opts ...binance.RequestOption) ([]*binance.SubAccountMarginFuturesStatus, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].([]*binance.SubAccountMarginFuturesStatus)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockSubAccountMarginFuturesStatusServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockSubAccountMarginFuturesStatusServiceAPI)(nil).Do), varargs...)
+}
+
+// Email mocks base method.
+func (m *MockSubAccountMarginFuturesStatusServiceAPI) Email(email string) binance.SubAccountMarginFuturesStatusServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Email", email)
+	ret0, _ := ret[0].(binance.SubAccountMarginFuturesStatusServiceAPI)
+	return ret0
+}
+
+// Email indicates an expected call of Email.
+func (mr *MockSubAccountMarginFuturesStatusServiceAPIMockRecorder) Email(email interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Email", reflect.TypeOf((*MockSubAccountMarginFuturesStatusServiceAPI)(nil).Email), email)
+}
+
+// RecvWindow mocks base method.
+func (m *MockSubAccountMarginFuturesStatusServiceAPI) RecvWindow(recvWindow int64) binance.SubAccountMarginFuturesStatusServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecvWindow", recvWindow)
+	ret0, _ := ret[0].(binance.SubAccountMarginFuturesStatusServiceAPI)
+	return ret0
+}
+
+// RecvWindow indicates an expected call of RecvWindow.
+func (mr *MockSubAccountMarginFuturesStatusServiceAPIMockRecorder) RecvWindow(recvWindow interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvWindow", reflect.TypeOf((*MockSubAccountMarginFuturesStatusServiceAPI)(nil).RecvWindow), recvWindow)
+}
+
+// MockSubAccountMarginEnableServiceAPI is a mock of SubAccountMarginEnableServiceAPI interface.
+type MockSubAccountMarginEnableServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockSubAccountMarginEnableServiceAPIMockRecorder
+}
+
+// MockSubAccountMarginEnableServiceAPIMockRecorder is the mock recorder for MockSubAccountMarginEnableServiceAPI.
+type MockSubAccountMarginEnableServiceAPIMockRecorder struct {
+	mock *MockSubAccountMarginEnableServiceAPI
+}
+
+// NewMockSubAccountMarginEnableServiceAPI creates a new mock instance.
+func NewMockSubAccountMarginEnableServiceAPI(ctrl *gomock.Controller) *MockSubAccountMarginEnableServiceAPI {
+	mock := &MockSubAccountMarginEnableServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockSubAccountMarginEnableServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSubAccountMarginEnableServiceAPI) EXPECT() *MockSubAccountMarginEnableServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockSubAccountMarginEnableServiceAPI) Do(ctx context.Context, opts ...binance.RequestOption) (*binance.SubAccountMarginEnableResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*binance.SubAccountMarginEnableResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockSubAccountMarginEnableServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockSubAccountMarginEnableServiceAPI)(nil).Do), varargs...)
+}
+
+// Email mocks base method.
+func (m *MockSubAccountMarginEnableServiceAPI) Email(email string) binance.SubAccountMarginEnableServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Email", email)
+	ret0, _ := ret[0].(binance.SubAccountMarginEnableServiceAPI)
+	return ret0
+}
+
+// Email indicates an expected call of Email.
+func (mr *MockSubAccountMarginEnableServiceAPIMockRecorder) Email(email interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Email", reflect.TypeOf((*MockSubAccountMarginEnableServiceAPI)(nil).Email), email)
+}
+
+// RecvWindow mocks base method.
+func (m *MockSubAccountMarginEnableServiceAPI) RecvWindow(recvWindow int64) binance.SubAccountMarginEnableServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecvWindow", recvWindow)
+	ret0, _ := ret[0].(binance.SubAccountMarginEnableServiceAPI)
+	return ret0
+}
+
+// RecvWindow indicates an expected call of RecvWindow.
+func (mr *MockSubAccountMarginEnableServiceAPIMockRecorder) RecvWindow(recvWindow interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvWindow", reflect.TypeOf((*MockSubAccountMarginEnableServiceAPI)(nil).RecvWindow), recvWindow)
+}
+
+// MockSubAccountMarginAccountInfoServiceAPI is a mock of SubAccountMarginAccountInfoServiceAPI interface.
+type MockSubAccountMarginAccountInfoServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockSubAccountMarginAccountInfoServiceAPIMockRecorder
+}
+
+// MockSubAccountMarginAccountInfoServiceAPIMockRecorder is the mock recorder for MockSubAccountMarginAccountInfoServiceAPI.
+type MockSubAccountMarginAccountInfoServiceAPIMockRecorder struct {
+	mock *MockSubAccountMarginAccountInfoServiceAPI
+}
+
+// NewMockSubAccountMarginAccountInfoServiceAPI creates a new mock instance.
+func NewMockSubAccountMarginAccountInfoServiceAPI(ctrl *gomock.Controller) *MockSubAccountMarginAccountInfoServiceAPI {
+	mock := &MockSubAccountMarginAccountInfoServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockSubAccountMarginAccountInfoServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSubAccountMarginAccountInfoServiceAPI) EXPECT() *MockSubAccountMarginAccountInfoServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockSubAccountMarginAccountInfoServiceAPI) Do(ctx context.Context, opts ...binance.RequestOption) (*binance.SubAccountMarginAccountInfo, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*binance.SubAccountMarginAccountInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockSubAccountMarginAccountInfoServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockSubAccountMarginAccountInfoServiceAPI)(nil).Do), varargs...)
+}
+
+// Email mocks base method.
+func (m *MockSubAccountMarginAccountInfoServiceAPI) Email(email string) binance.SubAccountMarginAccountInfoServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Email", email)
+	ret0, _ := ret[0].(binance.SubAccountMarginAccountInfoServiceAPI)
+	return ret0
+}
+
+// Email indicates an expected call of Email.
+func (mr *MockSubAccountMarginAccountInfoServiceAPIMockRecorder) Email(email interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Email", reflect.TypeOf((*MockSubAccountMarginAccountInfoServiceAPI)(nil).Email), email)
+}
+
+// RecvWindow mocks base method.
+func (m *MockSubAccountMarginAccountInfoServiceAPI) RecvWindow(recvWindow int64) binance.SubAccountMarginAccountInfoServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecvWindow", recvWindow)
+	ret0, _ := ret[0].(binance.SubAccountMarginAccountInfoServiceAPI)
+	return ret0
+}
+
+// RecvWindow indicates an expected call of RecvWindow.
+func (mr *MockSubAccountMarginAccountInfoServiceAPIMockRecorder) RecvWindow(recvWindow interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvWindow", reflect.TypeOf((*MockSubAccountMarginAccountInfoServiceAPI)(nil).RecvWindow), recvWindow)
+}
+
+// MockSubAccountMarginAccountSummaryServiceAPI is a mock of SubAccountMarginAccountSummaryServiceAPI interface.
+type MockSubAccountMarginAccountSummaryServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockSubAccountMarginAccountSummaryServiceAPIMockRecorder
+}
+
+// MockSubAccountMarginAccountSummaryServiceAPIMockRecorder is the mock recorder for MockSubAccountMarginAccountSummaryServiceAPI.
+type MockSubAccountMarginAccountSummaryServiceAPIMockRecorder struct {
+	mock *MockSubAccountMarginAccountSummaryServiceAPI
+}
+
+// NewMockSubAccountMarginAccountSummaryServiceAPI creates a new mock instance.
+func NewMockSubAccountMarginAccountSummaryServiceAPI(ctrl *gomock.Controller) *MockSubAccountMarginAccountSummaryServiceAPI {
+	mock := &MockSubAccountMarginAccountSummaryServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockSubAccountMarginAccountSummaryServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSubAccountMarginAccountSummaryServiceAPI) EXPECT() *MockSubAccountMarginAccountSummaryServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockSubAccountMarginAccountSummaryServiceAPI) Do(ctx context.Context, opts ...binance.RequestOption) (*binance.SubAccountMarginAccountSummary, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*binance.SubAccountMarginAccountSummary)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockSubAccountMarginAccountSummaryServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockSubAccountMarginAccountSummaryServiceAPI)(nil).Do), varargs...)
+}
+
+// RecvWindow mocks base method.
+func (m *MockSubAccountMarginAccountSummaryServiceAPI) RecvWindow(recvWindow int64) binance.SubAccountMarginAccountSummaryServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecvWindow", recvWindow)
+	ret0, _ := ret[0].(binance.SubAccountMarginAccountSummaryServiceAPI)
+	return ret0
+}
+
+// RecvWindow indicates an expected call of RecvWindow.
+func (mr *MockSubAccountMarginAccountSummaryServiceAPIMockRecorder) RecvWindow(recvWindow interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvWindow", reflect.TypeOf((*MockSubAccountMarginAccountSummaryServiceAPI)(nil).RecvWindow), recvWindow)
+}
+
+// MockSubAccountFuturesEnableServiceAPI is a mock of SubAccountFuturesEnableServiceAPI interface.
+type MockSubAccountFuturesEnableServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockSubAccountFuturesEnableServiceAPIMockRecorder
+}
+
+// MockSubAccountFuturesEnableServiceAPIMockRecorder is the mock recorder for MockSubAccountFuturesEnableServiceAPI.
+type MockSubAccountFuturesEnableServiceAPIMockRecorder struct {
+	mock *MockSubAccountFuturesEnableServiceAPI
+}
+
+// NewMockSubAccountFuturesEnableServiceAPI creates a new mock instance.
+func NewMockSubAccountFuturesEnableServiceAPI(ctrl *gomock.Controller) *MockSubAccountFuturesEnableServiceAPI {
+	mock := &MockSubAccountFuturesEnableServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockSubAccountFuturesEnableServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSubAccountFuturesEnableServiceAPI) EXPECT() *MockSubAccountFuturesEnableServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockSubAccountFuturesEnableServiceAPI) Do(ctx context.Context, opts ...binance.RequestOption) (*binance.SubAccountFuturesEnableResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*binance.SubAccountFuturesEnableResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockSubAccountFuturesEnableServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockSubAccountFuturesEnableServiceAPI)(nil).Do), varargs...)
+}
+
+// Email mocks base method.
+func (m *MockSubAccountFuturesEnableServiceAPI) Email(email string) binance.SubAccountFuturesEnableServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Email", email)
+	ret0, _ := ret[0].(binance.SubAccountFuturesEnableServiceAPI)
+	return ret0
+}
+
+// Email indicates an expected call of Email.
+func (mr *MockSubAccountFuturesEnableServiceAPIMockRecorder) Email(email interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Email", reflect.TypeOf((*MockSubAccountFuturesEnableServiceAPI)(nil).Email), email)
+}
+
+// RecvWindow mocks base method.
+func (m *MockSubAccountFuturesEnableServiceAPI) RecvWindow(recvWindow int64) binance.SubAccountFuturesEnableServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecvWindow", recvWindow)
+	ret0, _ := ret[0].(binance.SubAccountFuturesEnableServiceAPI)
+	return ret0
+}
+
+// RecvWindow indicates an expected call of RecvWindow.
+func (mr *MockSubAccountFuturesEnableServiceAPIMockRecorder) RecvWindow(recvWindow interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvWindow", reflect.TypeOf((*MockSubAccountFuturesEnableServiceAPI)(nil).RecvWindow), recvWindow)
+}
+
+// MockSubAccountFuturesAccountSummaryServiceAPI is a mock of SubAccountFuturesAccountSummaryServiceAPI interface.
+type MockSubAccountFuturesAccountSummaryServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockSubAccountFuturesAccountSummaryServiceAPIMockRecorder
+}
+
+// MockSubAccountFuturesAccountSummaryServiceAPIMockRecorder is the mock recorder for MockSubAccountFuturesAccountSummaryServiceAPI.
+type MockSubAccountFuturesAccountSummaryServiceAPIMockRecorder struct {
+	mock *MockSubAccountFuturesAccountSummaryServiceAPI
+}
+
+// NewMockSubAccountFuturesAccountSummaryServiceAPI creates a new mock instance.
+func NewMockSubAccountFuturesAccountSummaryServiceAPI(ctrl *gomock.Controller) *MockSubAccountFuturesAccountSummaryServiceAPI {
+	mock := &MockSubAccountFuturesAccountSummaryServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockSubAccountFuturesAccountSummaryServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSubAccountFuturesAccountSummaryServiceAPI) EXPECT() *MockSubAccountFuturesAccountSummaryServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockSubAccountFuturesAccountSummaryServiceAPI) Do(ctx context.Context, opts ...binance.RequestOption) (*binance.SubAccountFuturesAccountSummaryServiceResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*binance.SubAccountFuturesAccountSummaryServiceResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockSubAccountFuturesAccountSummaryServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockSubAccountFuturesAccountSummaryServiceAPI)(nil).Do), varargs...)
+}
+
+// FuturesType mocks base method.
+func (m *MockSubAccountFuturesAccountSummaryServiceAPI) FuturesType(futuresType int32) binance.SubAccountFuturesAccountSummaryServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FuturesType", futuresType)
+	ret0, _ := ret[0].(binance.SubAccountFuturesAccountSummaryServiceAPI)
+	return ret0
+}
+
+// FuturesType indicates an expected call of FuturesType.
+func (mr *MockSubAccountFuturesAccountSummaryServiceAPIMockRecorder) FuturesType(futuresType interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FuturesType", reflect.TypeOf((*MockSubAccountFuturesAccountSummaryServiceAPI)(nil).FuturesType), futuresType)
+}
+
+// Limit mocks base method.
+func (m *MockSubAccountFuturesAccountSummaryServiceAPI) Limit(limit int32) binance.SubAccountFuturesAccountSummaryServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Limit", limit)
+	ret0, _ := ret[0].(binance.SubAccountFuturesAccountSummaryServiceAPI)
+	return ret0
+}
+
+// Limit indicates an expected call of Limit.
+func (mr *MockSubAccountFuturesAccountSummaryServiceAPIMockRecorder) Limit(limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Limit", reflect.TypeOf((*MockSubAccountFuturesAccountSummaryServiceAPI)(nil).Limit), limit)
+}
+
+// Page mocks base method.
+func (m *MockSubAccountFuturesAccountSummaryServiceAPI) Page(page int32) binance.SubAccountFuturesAccountSummaryServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Page", page)
+	ret0, _ := ret[0].(binance.SubAccountFuturesAccountSummaryServiceAPI)
+	return ret0
+}
+
+// Page indicates an expected call of Page.
+func (mr *MockSubAccountFuturesAccountSummaryServiceAPIMockRecorder) Page(page interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Page", reflect.TypeOf((*MockSubAccountFuturesAccountSummaryServiceAPI)(nil).Page), page)
+}
+
+// RecvWindow mocks base method.
+func (m *MockSubAccountFuturesAccountSummaryServiceAPI) RecvWindow(recvWindow int64) binance.SubAccountFuturesAccountSummaryServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecvWindow", recvWindow)
+	ret0, _ := ret[0].(binance.SubAccountFuturesAccountSummaryServiceAPI)
+	return ret0
+}
+
+// RecvWindow indicates an expected call of RecvWindow.
+func (mr *MockSubAccountFuturesAccountSummaryServiceAPIMockRecorder) RecvWindow(recvWindow interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvWindow", reflect.TypeOf((*MockSubAccountFuturesAccountSummaryServiceAPI)(nil).RecvWindow), recvWindow)
+}
+
+// MockSubAccountFuturesPositionsServiceAPI is a mock of SubAccountFuturesPositionsServiceAPI interface.
+type MockSubAccountFuturesPositionsServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockSubAccountFuturesPositionsServiceAPIMockRecorder
+}
+
+// MockSubAccountFuturesPositionsServiceAPIMockRecorder is the mock recorder for MockSubAccountFuturesPositionsServiceAPI.
+type MockSubAccountFuturesPositionsServiceAPIMockRecorder struct {
+	mock *MockSubAccountFuturesPositionsServiceAPI
+}
+
+// NewMockSubAccountFuturesPositionsServiceAPI creates a new mock instance.
+func NewMockSubAccountFuturesPositionsServiceAPI(ctrl *gomock.Controller) *MockSubAccountFuturesPositionsServiceAPI {
+	mock := &MockSubAccountFuturesPositionsServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockSubAccountFuturesPositionsServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSubAccountFuturesPositionsServiceAPI) EXPECT() *MockSubAccountFuturesPositionsServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockSubAccountFuturesPositionsServiceAPI) Do(ctx context.Context, opts ...binance.RequestOption) (*binance.SubAccountFuturesPositionsServiceResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*binance.SubAccountFuturesPositionsServiceResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockSubAccountFuturesPositionsServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockSubAccountFuturesPositionsServiceAPI)(nil).Do), varargs...)
+}
+
+// Email mocks base method.
+func (m *MockSubAccountFuturesPositionsServiceAPI) Email(email string) binance.SubAccountFuturesPositionsServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Email", email)
+	ret0, _ := ret[0].(binance.SubAccountFuturesPositionsServiceAPI)
+	return ret0
+}
+
+// Email indicates an expected call of Email.
+func (mr *MockSubAccountFuturesPositionsServiceAPIMockRecorder) Email(email interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Email", reflect.TypeOf((*MockSubAccountFuturesPositionsServiceAPI)(nil).Email), email)
+}
+
+// FuturesType mocks base method.
+func (m *MockSubAccountFuturesPositionsServiceAPI) FuturesType(futuresType int32) binance.SubAccountFuturesPositionsServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FuturesType", futuresType)
+	ret0, _ := ret[0].(binance.SubAccountFuturesPositionsServiceAPI)
+	return ret0
+}
+
+// FuturesType indicates an expected call of FuturesType.
+func (mr *MockSubAccountFuturesPositionsServiceAPIMockRecorder) FuturesType(futuresType interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FuturesType", reflect.TypeOf((*MockSubAccountFuturesPositionsServiceAPI)(nil).FuturesType), futuresType)
+}
+
+// RecvWindow mocks base method.
+func (m *MockSubAccountFuturesPositionsServiceAPI) RecvWindow(recvWindow int64) binance.SubAccountFuturesPositionsServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecvWindow", recvWindow)
+	ret0, _ := ret[0].(binance.SubAccountFuturesPositionsServiceAPI)
+	return ret0
+}
+
+// RecvWindow indicates an expected call of RecvWindow.
+func (mr *MockSubAccountFuturesPositionsServiceAPIMockRecorder) RecvWindow(recvWindow interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvWindow", reflect.TypeOf((*MockSubAccountFuturesPositionsServiceAPI)(nil).RecvWindow), recvWindow)
+}
+
+// MockSubAccountMarginTransferServiceAPI is a mock of SubAccountMarginTransferServiceAPI interface.
+type MockSubAccountMarginTransferServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockSubAccountMarginTransferServiceAPIMockRecorder
+}
+
+// MockSubAccountMarginTransferServiceAPIMockRecorder is the mock recorder for MockSubAccountMarginTransferServiceAPI.
+type MockSubAccountMarginTransferServiceAPIMockRecorder struct {
+	mock *MockSubAccountMarginTransferServiceAPI
+}
+
+// NewMockSubAccountMarginTransferServiceAPI creates a new mock instance.
+func NewMockSubAccountMarginTransferServiceAPI(ctrl *gomock.Controller) *MockSubAccountMarginTransferServiceAPI {
+	mock := &MockSubAccountMarginTransferServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockSubAccountMarginTransferServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSubAccountMarginTransferServiceAPI) EXPECT() *MockSubAccountMarginTransferServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Amount mocks base method.
+func (m *MockSubAccountMarginTransferServiceAPI) Amount(amount string) binance.SubAccountMarginTransferServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Amount", amount)
+	ret0, _ := ret[0].(binance.SubAccountMarginTransferServiceAPI)
+	return ret0
+}
+
+// Amount indicates an expected call of Amount.
+func (mr *MockSubAccountMarginTransferServiceAPIMockRecorder) Amount(amount interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Amount", reflect.TypeOf((*MockSubAccountMarginTransferServiceAPI)(nil).Amount), amount)
+}
+
+// Asset mocks base method.
+func (m *MockSubAccountMarginTransferServiceAPI) Asset(asset string) binance.SubAccountMarginTransferServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Asset", asset)
+	ret0, _ := ret[0].(binance.SubAccountMarginTransferServiceAPI)
+	return ret0
+}
+
+// Asset indicates an expected call of Asset.
+func (mr *MockSubAccountMarginTransferServiceAPIMockRecorder) Asset(asset interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Asset", reflect.TypeOf((*MockSubAccountMarginTransferServiceAPI)(nil).Asset), asset)
+}
+
+// Do mocks base method.
+func (m *MockSubAccountMarginTransferServiceAPI) Do(ctx context.Context, opts ...binance.RequestOption) (*binance.SubAccountMarginTransferResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*binance.SubAccountMarginTransferResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockSubAccountMarginTransferServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockSubAccountMarginTransferServiceAPI)(nil).Do), varargs...)
+}
+
+// Email mocks base method.
+func (m *MockSubAccountMarginTransferServiceAPI) Email(email string) binance.SubAccountMarginTransferServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Email", email)
+	ret0, _ := ret[0].(binance.SubAccountMarginTransferServiceAPI)
+	return ret0
+}
+
+// Email indicates an expected call of Email.
+func (mr *MockSubAccountMarginTransferServiceAPIMockRecorder) Email(email interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Email", reflect.TypeOf((*MockSubAccountMarginTransferServiceAPI)(nil).Email), email)
+}
+
+// RecvWindow mocks base method.
+func (m *MockSubAccountMarginTransferServiceAPI) RecvWindow(recvWindow int64) binance.SubAccountMarginTransferServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecvWindow", recvWindow)
+	ret0, _ := ret[0].(binance.SubAccountMarginTransferServiceAPI)
+	return ret0
+}
+
+// RecvWindow indicates an expected call of RecvWindow.
+func (mr *MockSubAccountMarginTransferServiceAPIMockRecorder) RecvWindow(recvWindow interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvWindow", reflect.TypeOf((*MockSubAccountMarginTransferServiceAPI)(nil).RecvWindow), recvWindow)
+}
+
+// TransferType mocks base method.
+func (m *MockSubAccountMarginTransferServiceAPI) TransferType(transferType int32) binance.SubAccountMarginTransferServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TransferType", transferType)
+	ret0, _ := ret[0].(binance.SubAccountMarginTransferServiceAPI)
+	return ret0
+}
+
+// TransferType indicates an expected call of TransferType.
+func (mr *MockSubAccountMarginTransferServiceAPIMockRecorder) TransferType(transferType interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TransferType", reflect.TypeOf((*MockSubAccountMarginTransferServiceAPI)(nil).TransferType), transferType)
+}
+
+// MockSubAccountTransferSubToMasterServiceAPI is a mock of SubAccountTransferSubToMasterServiceAPI interface.
+type MockSubAccountTransferSubToMasterServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockSubAccountTransferSubToMasterServiceAPIMockRecorder
+}
+
+// MockSubAccountTransferSubToMasterServiceAPIMockRecorder is the mock recorder for MockSubAccountTransferSubToMasterServiceAPI.
+type MockSubAccountTransferSubToMasterServiceAPIMockRecorder struct {
+	mock *MockSubAccountTransferSubToMasterServiceAPI
+}
+
+// NewMockSubAccountTransferSubToMasterServiceAPI creates a new mock instance.
+func NewMockSubAccountTransferSubToMasterServiceAPI(ctrl *gomock.Controller) *MockSubAccountTransferSubToMasterServiceAPI {
+	mock := &MockSubAccountTransferSubToMasterServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockSubAccountTransferSubToMasterServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSubAccountTransferSubToMasterServiceAPI) EXPECT() *MockSubAccountTransferSubToMasterServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Amount mocks base method.
+func (m *MockSubAccountTransferSubToMasterServiceAPI) Amount(amount string) binance.SubAccountTransferSubToMasterServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Amount", amount)
+	ret0, _ := ret[0].(binance.SubAccountTransferSubToMasterServiceAPI)
+	return ret0
+}
+
+// Amount indicates an expected call of Amount.
+func (mr *MockSubAccountTransferSubToMasterServiceAPIMockRecorder) Amount(amount interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Amount", reflect.TypeOf((*MockSubAccountTransferSubToMasterServiceAPI)(nil).Amount), amount)
+}
+
+// Asset mocks base method.
+func (m *MockSubAccountTransferSubToMasterServiceAPI) Asset(asset string) binance.SubAccountTransferSubToMasterServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Asset", asset)
+	ret0, _ := ret[0].(binance.SubAccountTransferSubToMasterServiceAPI)
+	return ret0
+}
+
+// Asset indicates an expected call of Asset.
+func (mr *MockSubAccountTransferSubToMasterServiceAPIMockRecorder) Asset(asset interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Asset", reflect.TypeOf((*MockSubAccountTransferSubToMasterServiceAPI)(nil).Asset), asset)
+}
+
+// Do mocks base method.
+func (m *MockSubAccountTransferSubToMasterServiceAPI) Do(ctx context.Context, opts ...binance.RequestOption) (*binance.SubAccountTransferSubToMasterResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*binance.SubAccountTransferSubToMasterResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockSubAccountTransferSubToMasterServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockSubAccountTransferSubToMasterServiceAPI)(nil).Do), varargs...)
+}
+
+// RecvWindow mocks base method.
+func (m *MockSubAccountTransferSubToMasterServiceAPI) RecvWindow(recvWindow int64) binance.SubAccountTransferSubToMasterServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecvWindow", recvWindow)
+	ret0, _ := ret[0].(binance.SubAccountTransferSubToMasterServiceAPI)
+	return ret0
+}
+
+// RecvWindow indicates an expected call of RecvWindow.
+func (mr *MockSubAccountTransferSubToMasterServiceAPIMockRecorder) RecvWindow(recvWindow interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvWindow", reflect.TypeOf((*MockSubAccountTransferSubToMasterServiceAPI)(nil).RecvWindow), recvWindow)
+}
+
+// MockSubAccountUniversalTransferServiceAPI is a mock of SubAccountUniversalTransferServiceAPI interface.
+type MockSubAccountUniversalTransferServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockSubAccountUniversalTransferServiceAPIMockRecorder
+}
+
+// MockSubAccountUniversalTransferServiceAPIMockRecorder is the mock recorder for MockSubAccountUniversalTransferServiceAPI.
+type MockSubAccountUniversalTransferServiceAPIMockRecorder struct {
+	mock *MockSubAccountUniversalTransferServiceAPI
+}
+
+// NewMockSubAccountUniversalTransferServiceAPI creates a new mock instance.
+func NewMockSubAccountUniversalTransferServiceAPI(ctrl *gomock.Controller) *MockSubAccountUniversalTransferServiceAPI {
+	mock := &MockSubAccountUniversalTransferServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockSubAccountUniversalTransferServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSubAccountUniversalTransferServiceAPI) EXPECT() *MockSubAccountUniversalTransferServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Amount mocks base method.
+func (m *MockSubAccountUniversalTransferServiceAPI) Amount(amount string) binance.SubAccountUniversalTransferServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Amount", amount)
+	ret0, _ := ret[0].(binance.SubAccountUniversalTransferServiceAPI)
+	return ret0
+}
+
+// Amount indicates an expected call of Amount.
+func (mr *MockSubAccountUniversalTransferServiceAPIMockRecorder) Amount(amount interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Amount", reflect.TypeOf((*MockSubAccountUniversalTransferServiceAPI)(nil).Amount), amount)
+}
+
+// Asset mocks base method.
+func (m *MockSubAccountUniversalTransferServiceAPI) Asset(asset string) binance.SubAccountUniversalTransferServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Asset", asset)
+	ret0, _ := ret[0].(binance.SubAccountUniversalTransferServiceAPI)
+	return ret0
+}
+
+// Asset indicates an expected call of Asset.
+func (mr *MockSubAccountUniversalTransferServiceAPIMockRecorder) Asset(asset interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Asset", reflect.TypeOf((*MockSubAccountUniversalTransferServiceAPI)(nil).Asset), asset)
+}
+
+// ClientTranId mocks base method.
+func (m *MockSubAccountUniversalTransferServiceAPI) ClientTranId(clientTranId string) binance.SubAccountUniversalTransferServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClientTranId", clientTranId)
+	ret0, _ := ret[0].(binance.SubAccountUniversalTransferServiceAPI)
+	return ret0
+}
+
+// ClientTranId indicates an expected call of ClientTranId.
+func (mr *MockSubAccountUniversalTransferServiceAPIMockRecorder) ClientTranId(clientTranId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClientTranId", reflect.TypeOf((*MockSubAccountUniversalTransferServiceAPI)(nil).ClientTranId), clientTranId)
+}
+
+// Do mocks base method.
+func (m *MockSubAccountUniversalTransferServiceAPI) Do(ctx context.Context, opts ...binance.RequestOption) (*binance.SubAccountUniversalTransferResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*binance.SubAccountUniversalTransferResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockSubAccountUniversalTransferServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockSubAccountUniversalTransferServiceAPI)(nil).Do), varargs...)
+}
+
+// FromAccountType mocks base method.
+func (m *MockSubAccountUniversalTransferServiceAPI) FromAccountType(fromAccountType string) binance.SubAccountUniversalTransferServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FromAccountType", fromAccountType)
+	ret0, _ := ret[0].(binance.SubAccountUniversalTransferServiceAPI)
+	return ret0
+}
+
+// FromAccountType indicates an expected call of FromAccountType.
+func (mr *MockSubAccountUniversalTransferServiceAPIMockRecorder) FromAccountType(fromAccountType interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FromAccountType", reflect.TypeOf((*MockSubAccountUniversalTransferServiceAPI)(nil).FromAccountType), fromAccountType)
+}
+
+// FromEmail mocks base method.
+func (m *MockSubAccountUniversalTransferServiceAPI) FromEmail(fromEmail string) binance.SubAccountUniversalTransferServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FromEmail", fromEmail)
+	ret0, _ := ret[0].(binance.SubAccountUniversalTransferServiceAPI)
+	return ret0
+}
+
+// FromEmail indicates an expected call of FromEmail.
+func (mr *MockSubAccountUniversalTransferServiceAPIMockRecorder) FromEmail(fromEmail interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FromEmail", reflect.TypeOf((*MockSubAccountUniversalTransferServiceAPI)(nil).FromEmail), fromEmail)
+}
+
+// RecvWindow mocks base method.
+func (m *MockSubAccountUniversalTransferServiceAPI) RecvWindow(recvWindow int64) binance.SubAccountUniversalTransferServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecvWindow", recvWindow)
+	ret0, _ := ret[0].(binance.SubAccountUniversalTransferServiceAPI)
+	return ret0
+}
+
+// RecvWindow indicates an expected call of RecvWindow.
+func (mr *MockSubAccountUniversalTransferServiceAPIMockRecorder) RecvWindow(recvWindow interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvWindow", reflect.TypeOf((*MockSubAccountUniversalTransferServiceAPI)(nil).RecvWindow), recvWindow)
+}
+
+// Symbol mocks base method.
+func (m *MockSubAccountUniversalTransferServiceAPI) Symbol(symbol string) binance.SubAccountUniversalTransferServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Symbol", symbol)
+	ret0, _ := ret[0].(binance.SubAccountUniversalTransferServiceAPI)
+	return ret0
+}
+
+// Symbol indicates an expected call of Symbol.
+func (mr *MockSubAccountUniversalTransferServiceAPIMockRecorder) Symbol(symbol interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Symbol", reflect.TypeOf((*MockSubAccountUniversalTransferServiceAPI)(nil).Symbol), symbol)
+}
+
+// ToAccountType mocks base method.
+func (m *MockSubAccountUniversalTransferServiceAPI) ToAccountType(toAccountType string) binance.SubAccountUniversalTransferServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ToAccountType", toAccountType)
+	ret0, _ := ret[0].(binance.SubAccountUniversalTransferServiceAPI)
+	return ret0
+}
+
+// ToAccountType indicates an expected call of ToAccountType.
+func (mr *MockSubAccountUniversalTransferServiceAPIMockRecorder) ToAccountType(toAccountType interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ToAccountType", reflect.TypeOf((*MockSubAccountUniversalTransferServiceAPI)(nil).ToAccountType), toAccountType)
+}
+
+// ToEmail mocks base method.
+func (m *MockSubAccountUniversalTransferServiceAPI) ToEmail(toEmail string) binance.SubAccountUniversalTransferServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ToEmail", toEmail)
+	ret0, _ := ret[0].(binance.SubAccountUniversalTransferServiceAPI)
+	return ret0
+}
+
+// ToEmail indicates an expected call of ToEmail.
+func (mr *MockSubAccountUniversalTransferServiceAPIMockRecorder) ToEmail(toEmail interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ToEmail", reflect.TypeOf((*MockSubAccountUniversalTransferServiceAPI)(nil).ToEmail), toEmail)
+}
+
+// MockSubAccUniversalTransferHistoryServiceAPI is a mock of SubAccUniversalTransferHistoryServiceAPI interface.
+type MockSubAccUniversalTransferHistoryServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockSubAccUniversalTransferHistoryServiceAPIMockRecorder
+}
+
+// MockSubAccUniversalTransferHistoryServiceAPIMockRecorder is the mock recorder for MockSubAccUniversalTransferHistoryServiceAPI.
+type MockSubAccUniversalTransferHistoryServiceAPIMockRecorder struct {
+	mock *MockSubAccUniversalTransferHistoryServiceAPI
+}
+
+// NewMockSubAccUniversalTransferHistoryServiceAPI creates a new mock instance.
+func NewMockSubAccUniversalTransferHistoryServiceAPI(ctrl *gomock.Controller) *MockSubAccUniversalTransferHistoryServiceAPI {
+	mock := &MockSubAccUniversalTransferHistoryServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockSubAccUniversalTransferHistoryServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSubAccUniversalTransferHistoryServiceAPI) EXPECT() *MockSubAccUniversalTransferHistoryServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// ClientTranId mocks base method.
+func (m *MockSubAccUniversalTransferHistoryServiceAPI) ClientTranId(clientTranId string) binance.SubAccUniversalTransferHistoryServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClientTranId", clientTranId)
+	ret0, _ := ret[0].(binance.SubAccUniversalTransferHistoryServiceAPI)
+	return ret0
+}
+
+// ClientTranId indicates an expected call of ClientTranId.
+func (mr *MockSubAccUniversalTransferHistoryServiceAPIMockRecorder) ClientTranId(clientTranId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClientTranId", reflect.TypeOf((*MockSubAccUniversalTransferHistoryServiceAPI)(nil).ClientTranId), clientTranId)
+}
+
+// Do mocks base method.
+func (m *MockSubAccUniversalTransferHistoryServiceAPI) Do(ctx context.Context, opts ...binance.RequestOption) (*binance.SubAccountUniversalTransferHistoryServiceResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*binance.SubAccountUniversalTransferHistoryServiceResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockSubAccUniversalTransferHistoryServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockSubAccUniversalTransferHistoryServiceAPI)(nil).Do), varargs...)
+}
+
+// EndTime mocks base method.
+func (m *MockSubAccUniversalTransferHistoryServiceAPI) EndTime(endTime int64) binance.SubAccUniversalTransferHistoryServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EndTime", endTime)
+	ret0, _ := ret[0].(binance.SubAccUniversalTransferHistoryServiceAPI)
+	return ret0
+}
+
+// EndTime indicates an expected call of EndTime.
+func (mr *MockSubAccUniversalTransferHistoryServiceAPIMockRecorder) EndTime(endTime interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EndTime", reflect.TypeOf((*MockSubAccUniversalTransferHistoryServiceAPI)(nil).EndTime), endTime)
+}
+
+// FromEmail mocks base method.
+func (m *MockSubAccUniversalTransferHistoryServiceAPI) FromEmail(fromEmail string) binance.SubAccUniversalTransferHistoryServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FromEmail", fromEmail)
+	ret0, _ := ret[0].(binance.SubAccUniversalTransferHistoryServiceAPI)
+	return ret0
+}
+
+// FromEmail indicates an expected call of FromEmail.
+func (mr *MockSubAccUniversalTransferHistoryServiceAPIMockRecorder) FromEmail(fromEmail interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FromEmail", reflect.TypeOf((*MockSubAccUniversalTransferHistoryServiceAPI)(nil).FromEmail), fromEmail)
+}
+
+// Limit mocks base method.
+func (m *MockSubAccUniversalTransferHistoryServiceAPI) Limit(limit int32) binance.SubAccUniversalTransferHistoryServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Limit", limit)
+	ret0, _ := ret[0].(binance.SubAccUniversalTransferHistoryServiceAPI)
+	return ret0
+}
+
+// Limit indicates an expected call of Limit.
+func (mr *MockSubAccUniversalTransferHistoryServiceAPIMockRecorder) Limit(limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Limit", reflect.TypeOf((*MockSubAccUniversalTransferHistoryServiceAPI)(nil).Limit), limit)
+}
+
+// Page mocks base method.
+func (m *MockSubAccUniversalTransferHistoryServiceAPI) Page(page int32) binance.SubAccUniversalTransferHistoryServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Page", page)
+	ret0, _ := ret[0].(binance.SubAccUniversalTransferHistoryServiceAPI)
+	return ret0
+}
+
+// Page indicates an expected call of Page.
+func (mr *MockSubAccUniversalTransferHistoryServiceAPIMockRecorder) Page(page interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Page", reflect.TypeOf((*MockSubAccUniversalTransferHistoryServiceAPI)(nil).Page), page)
+}
+
+// RecvWindow mocks base method.
+func (m *MockSubAccUniversalTransferHistoryServiceAPI) RecvWindow(recvWindow int64) binance.SubAccUniversalTransferHistoryServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecvWindow", recvWindow)
+	ret0, _ := ret[0].(binance.SubAccUniversalTransferHistoryServiceAPI)
+	return ret0
+}
+
+// RecvWindow indicates an expected call of RecvWindow.
+func (mr *MockSubAccUniversalTransferHistoryServiceAPIMockRecorder) RecvWindow(recvWindow interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvWindow", reflect.TypeOf((*MockSubAccUniversalTransferHistoryServiceAPI)(nil).RecvWindow), recvWindow)
+}
+
+// StartTime mocks base method.
+func (m *MockSubAccUniversalTransferHistoryServiceAPI) StartTime(startTime int64) binance.SubAccUniversalTransferHistoryServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartTime", startTime)
+	ret0, _ := ret[0].(binance.SubAccUniversalTransferHistoryServiceAPI)
+	return ret0
+}
+
+// StartTime indicates an expected call of StartTime.
+func (mr *MockSubAccUniversalTransferHistoryServiceAPIMockRecorder) StartTime(startTime interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartTime", reflect.TypeOf((*MockSubAccUniversalTransferHistoryServiceAPI)(nil).StartTime), startTime)
+}
+
+// ToEmail mocks base method.
+func (m *MockSubAccUniversalTransferHistoryServiceAPI) ToEmail(toEmail string) binance.SubAccUniversalTransferHistoryServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ToEmail", toEmail)
+	ret0, _ := ret[0].(binance.SubAccUniversalTransferHistoryServiceAPI)
+	return ret0
+}
+
+// ToEmail indicates an expected call of ToEmail.
+func (mr *MockSubAccUniversalTransferHistoryServiceAPIMockRecorder) ToEmail(toEmail interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ToEmail", reflect.TypeOf((*MockSubAccUniversalTransferHistoryServiceAPI)(nil).ToEmail), toEmail)
+}
+
+// MockSubAccountBlvtEnableServiceAPI is a mock of SubAccountBlvtEnableServiceAPI interface.
+type MockSubAccountBlvtEnableServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockSubAccountBlvtEnableServiceAPIMockRecorder
+}
+
+// MockSubAccountBlvtEnableServiceAPIMockRecorder is the mock recorder for MockSubAccountBlvtEnableServiceAPI.
+type MockSubAccountBlvtEnableServiceAPIMockRecorder struct {
+	mock *MockSubAccountBlvtEnableServiceAPI
+}
+
+// NewMockSubAccountBlvtEnableServiceAPI creates a new mock instance.
+func NewMockSubAccountBlvtEnableServiceAPI(ctrl *gomock.Controller) *MockSubAccountBlvtEnableServiceAPI {
+	mock := &MockSubAccountBlvtEnableServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockSubAccountBlvtEnableServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSubAccountBlvtEnableServiceAPI) EXPECT() *MockSubAccountBlvtEnableServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockSubAccountBlvtEnableServiceAPI) Do(ctx context.Context, opts ...binance.RequestOption) (*binance.SubAccountBlvtEnableServiceResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*binance.SubAccountBlvtEnableServiceResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockSubAccountBlvtEnableServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockSubAccountBlvtEnableServiceAPI)(nil).Do), varargs...)
+}
+
+// Email mocks base method.
+func (m *MockSubAccountBlvtEnableServiceAPI) Email(email string) binance.SubAccountBlvtEnableServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Email", email)
+	ret0, _ := ret[0].(binance.SubAccountBlvtEnableServiceAPI)
+	return ret0
+}
+
+// Email indicates an expected call of Email.
+func (mr *MockSubAccountBlvtEnableServiceAPIMockRecorder) Email(email interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Email", reflect.TypeOf((*MockSubAccountBlvtEnableServiceAPI)(nil).Email), email)
+}
+
+// EnableBlvt mocks base method.
+func (m *MockSubAccountBlvtEnableServiceAPI) EnableBlvt(enableBlvt bool) binance.SubAccountBlvtEnableServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EnableBlvt", enableBlvt)
+	ret0, _ := ret[0].(binance.SubAccountBlvtEnableServiceAPI)
+	return ret0
+}
+
+// EnableBlvt indicates an expected call of EnableBlvt.
+func (mr *MockSubAccountBlvtEnableServiceAPIMockRecorder) EnableBlvt(enableBlvt interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnableBlvt", reflect.TypeOf((*MockSubAccountBlvtEnableServiceAPI)(nil).EnableBlvt), enableBlvt)
+}
+
+// RecvWindow mocks base method.
+func (m *MockSubAccountBlvtEnableServiceAPI) RecvWindow(recvWindow int64) binance.SubAccountBlvtEnableServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecvWindow", recvWindow)
+	ret0, _ := ret[0].(binance.SubAccountBlvtEnableServiceAPI)
+	return ret0
+}
+
+// RecvWindow indicates an expected call of RecvWindow.
+func (mr *MockSubAccountBlvtEnableServiceAPIMockRecorder) RecvWindow(recvWindow interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvWindow", reflect.TypeOf((*MockSubAccountBlvtEnableServiceAPI)(nil).RecvWindow), recvWindow)
+}
+
+// MockSubAccountApiIpRestrictionServiceAPI is a mock of SubAccountApiIpRestrictionServiceAPI interface.
+type MockSubAccountApiIpRestrictionServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockSubAccountApiIpRestrictionServiceAPIMockRecorder
+}
+
+// MockSubAccountApiIpRestrictionServiceAPIMockRecorder is the mock recorder for MockSubAccountApiIpRestrictionServiceAPI.
+type MockSubAccountApiIpRestrictionServiceAPIMockRecorder struct {
+	mock *MockSubAccountApiIpRestrictionServiceAPI
+}
+
+// NewMockSubAccountApiIpRestrictionServiceAPI creates a new mock instance.
+func NewMockSubAccountApiIpRestrictionServiceAPI(ctrl *gomock.Controller) *MockSubAccountApiIpRestrictionServiceAPI {
+	mock := &MockSubAccountApiIpRestrictionServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockSubAccountApiIpRestrictionServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSubAccountApiIpRestrictionServiceAPI) EXPECT() *MockSubAccountApiIpRestrictionServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockSubAccountApiIpRestrictionServiceAPI) Do(ctx context.Context, opts ...binance.RequestOption) (*binance.SubAccountApiIpRestrictServiceResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*binance.SubAccountApiIpRestrictServiceResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockSubAccountApiIpRestrictionServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockSubAccountApiIpRestrictionServiceAPI)(nil).Do), varargs...)
+}
+
+// Email mocks base method.
+func (m *MockSubAccountApiIpRestrictionServiceAPI) Email(email string) binance.SubAccountApiIpRestrictionServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Email", email)
+	ret0, _ := ret[0].(binance.SubAccountApiIpRestrictionServiceAPI)
+	return ret0
+}
+
+// Email indicates an expected call of Email.
+func (mr *MockSubAccountApiIpRestrictionServiceAPIMockRecorder) Email(email interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Email", reflect.TypeOf((*MockSubAccountApiIpRestrictionServiceAPI)(nil).Email), email)
+}
+
+// RecvWindow mocks base method.
+func (m *MockSubAccountApiIpRestrictionServiceAPI) RecvWindow(recvWindow int64) binance.SubAccountApiIpRestrictionServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecvWindow", recvWindow)
+	ret0, _ := ret[0].(binance.SubAccountApiIpRestrictionServiceAPI)
+	return ret0
+}
+
+// RecvWindow indicates an expected call of RecvWindow.
+func (mr *MockSubAccountApiIpRestrictionServiceAPIMockRecorder) RecvWindow(recvWindow interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvWindow", reflect.TypeOf((*MockSubAccountApiIpRestrictionServiceAPI)(nil).RecvWindow), recvWindow)
+}
+
+// SubAccountApiKey mocks base method.
+func (m *MockSubAccountApiIpRestrictionServiceAPI) SubAccountApiKey(subAccountApiKey string) binance.SubAccountApiIpRestrictionServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SubAccountApiKey", subAccountApiKey)
+	ret0, _ := ret[0].(binance.SubAccountApiIpRestrictionServiceAPI)
+	return ret0
+}
+
+// SubAccountApiKey indicates an expected call of SubAccountApiKey.
+func (mr *MockSubAccountApiIpRestrictionServiceAPIMockRecorder) SubAccountApiKey(subAccountApiKey interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubAccountApiKey", reflect.TypeOf((*MockSubAccountApiIpRestrictionServiceAPI)(nil).SubAccountApiKey), subAccountApiKey)
+}
+
+// MockSubAccountApiDeleteIpRestrictionServiceAPI is a mock of SubAccountApiDeleteIpRestrictionServiceAPI interface.
+type MockSubAccountApiDeleteIpRestrictionServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockSubAccountApiDeleteIpRestrictionServiceAPIMockRecorder
+}
+
+// MockSubAccountApiDeleteIpRestrictionServiceAPIMockRecorder is the mock recorder for MockSubAccountApiDeleteIpRestrictionServiceAPI.
+type MockSubAccountApiDeleteIpRestrictionServiceAPIMockRecorder struct {
+	mock *MockSubAccountApiDeleteIpRestrictionServiceAPI
+}
+
+// NewMockSubAccountApiDeleteIpRestrictionServiceAPI creates a new mock instance.
+func NewMockSubAccountApiDeleteIpRestrictionServiceAPI(ctrl *gomock.Controller) *MockSubAccountApiDeleteIpRestrictionServiceAPI {
+	mock := &MockSubAccountApiDeleteIpRestrictionServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockSubAccountApiDeleteIpRestrictionServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSubAccountApiDeleteIpRestrictionServiceAPI) EXPECT() *MockSubAccountApiDeleteIpRestrictionServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockSubAccountApiDeleteIpRestrictionServiceAPI) Do(ctx context.Context, opts ...binance.RequestOption) (*binance.SubAccountApiDeleteIpRestrictServiceResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*binance.SubAccountApiDeleteIpRestrictServiceResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockSubAccountApiDeleteIpRestrictionServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockSubAccountApiDeleteIpRestrictionServiceAPI)(nil).Do), varargs...)
+}
+
+// Email mocks base method.
+func (m *MockSubAccountApiDeleteIpRestrictionServiceAPI) Email(email string) binance.SubAccountApiDeleteIpRestrictionServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Email", email)
+	ret0, _ := ret[0].(binance.SubAccountApiDeleteIpRestrictionServiceAPI)
+	return ret0
+}
+
+// Email indicates an expected call of Email.
+func (mr *MockSubAccountApiDeleteIpRestrictionServiceAPIMockRecorder) Email(email interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Email", reflect.TypeOf((*MockSubAccountApiDeleteIpRestrictionServiceAPI)(nil).Email), email)
+}
+
+// IpAddress mocks base method.
+func (m *MockSubAccountApiDeleteIpRestrictionServiceAPI) IpAddress(ipAddress string) binance.SubAccountApiDeleteIpRestrictionServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IpAddress", ipAddress)
+	ret0, _ := ret[0].(binance.SubAccountApiDeleteIpRestrictionServiceAPI)
+	return ret0
+}
+
+// IpAddress indicates an expected call of IpAddress.
+func (mr *MockSubAccountApiDeleteIpRestrictionServiceAPIMockRecorder) IpAddress(ipAddress interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IpAddress", reflect.TypeOf((*MockSubAccountApiDeleteIpRestrictionServiceAPI)(nil).IpAddress), ipAddress)
+}
+
+// RecvWindow mocks base method.
+func (m *MockSubAccountApiDeleteIpRestrictionServiceAPI) RecvWindow(recvWindow int64) binance.SubAccountApiDeleteIpRestrictionServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecvWindow", recvWindow)
+	ret0, _ := ret[0].(binance.SubAccountApiDeleteIpRestrictionServiceAPI)
+	return ret0
+}
+
+// RecvWindow indicates an expected call of RecvWindow.
+func (mr *MockSubAccountApiDeleteIpRestrictionServiceAPIMockRecorder) RecvWindow(recvWindow interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvWindow", reflect.TypeOf((*MockSubAccountApiDeleteIpRestrictionServiceAPI)(nil).RecvWindow), recvWindow)
+}
+
+// SubAccountApiKey mocks base method.
+func (m *MockSubAccountApiDeleteIpRestrictionServiceAPI) SubAccountApiKey(subAccountApiKey string) binance.SubAccountApiDeleteIpRestrictionServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SubAccountApiKey", subAccountApiKey)
+	ret0, _ := ret[0].(binance.SubAccountApiDeleteIpRestrictionServiceAPI)
+	return ret0
+}
+
+// SubAccountApiKey indicates an expected call of SubAccountApiKey.
+func (mr *MockSubAccountApiDeleteIpRestrictionServiceAPIMockRecorder) SubAccountApiKey(subAccountApiKey interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubAccountApiKey", reflect.TypeOf((*MockSubAccountApiDeleteIpRestrictionServiceAPI)(nil).SubAccountApiKey), subAccountApiKey)
+}
+
+// MockSubAccountApiAddIpRestrictionServiceAPI is a mock of SubAccountApiAddIpRestrictionServiceAPI interface.
+type MockSubAccountApiAddIpRestrictionServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockSubAccountApiAddIpRestrictionServiceAPIMockRecorder
+}
+
+// MockSubAccountApiAddIpRestrictionServiceAPIMockRecorder is the mock recorder for MockSubAccountApiAddIpRestrictionServiceAPI.
+type MockSubAccountApiAddIpRestrictionServiceAPIMockRecorder struct {
+	mock *MockSubAccountApiAddIpRestrictionServiceAPI
+}
+
+// NewMockSubAccountApiAddIpRestrictionServiceAPI creates a new mock instance.
+func NewMockSubAccountApiAddIpRestrictionServiceAPI(ctrl *gomock.Controller) *MockSubAccountApiAddIpRestrictionServiceAPI {
+	mock := &MockSubAccountApiAddIpRestrictionServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockSubAccountApiAddIpRestrictionServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSubAccountApiAddIpRestrictionServiceAPI) EXPECT() *MockSubAccountApiAddIpRestrictionServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockSubAccountApiAddIpRestrictionServiceAPI) Do(ctx context.Context, opts ...binance.RequestOption) (*binance.SubAccountApiAddIpRestrictServiceResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*binance.SubAccountApiAddIpRestrictServiceResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockSubAccountApiAddIpRestrictionServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockSubAccountApiAddIpRestrictionServiceAPI)(nil).Do), varargs...)
+}
+
+// Email mocks base method.
+func (m *MockSubAccountApiAddIpRestrictionServiceAPI) Email(email string) binance.SubAccountApiAddIpRestrictionServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Email", email)
+	ret0, _ := ret[0].(binance.SubAccountApiAddIpRestrictionServiceAPI)
+	return ret0
+}
+
+// Email indicates an expected call of Email.
+func (mr *MockSubAccountApiAddIpRestrictionServiceAPIMockRecorder) Email(email interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Email", reflect.TypeOf((*MockSubAccountApiAddIpRestrictionServiceAPI)(nil).Email), email)
+}
+
+// IpAddress mocks base method.
+func (m *MockSubAccountApiAddIpRestrictionServiceAPI) IpAddress(ipAddress string) binance.SubAccountApiAddIpRestrictionServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IpAddress", ipAddress)
+	ret0, _ := ret[0].(binance.SubAccountApiAddIpRestrictionServiceAPI)
+	return ret0
+}
+
+// IpAddress indicates an expected call of IpAddress.
+func (mr *MockSubAccountApiAddIpRestrictionServiceAPIMockRecorder) IpAddress(ipAddress interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IpAddress", reflect.TypeOf((*MockSubAccountApiAddIpRestrictionServiceAPI)(nil).IpAddress), ipAddress)
+}
+
+// RecvWindow mocks base method.
+func (m *MockSubAccountApiAddIpRestrictionServiceAPI) RecvWindow(recvWindow int64) binance.SubAccountApiAddIpRestrictionServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecvWindow", recvWindow)
+	ret0, _ := ret[0].(binance.SubAccountApiAddIpRestrictionServiceAPI)
+	return ret0
+}
+
+// RecvWindow indicates an expected call of RecvWindow.
+func (mr *MockSubAccountApiAddIpRestrictionServiceAPIMockRecorder) RecvWindow(recvWindow interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvWindow", reflect.TypeOf((*MockSubAccountApiAddIpRestrictionServiceAPI)(nil).RecvWindow), recvWindow)
+}
+
+// Status mocks base method.
+func (m *MockSubAccountApiAddIpRestrictionServiceAPI) Status(status string) binance.SubAccountApiAddIpRestrictionServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Status", status)
+	ret0, _ := ret[0].(binance.SubAccountApiAddIpRestrictionServiceAPI)
+	return ret0
+}
+
+// Status indicates an expected call of Status.
+func (mr *MockSubAccountApiAddIpRestrictionServiceAPIMockRecorder) Status(status interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Status", reflect.TypeOf((*MockSubAccountApiAddIpRestrictionServiceAPI)(nil).Status), status)
+}
+
+// SubAccountApiKey mocks base method.
+func (m *MockSubAccountApiAddIpRestrictionServiceAPI) SubAccountApiKey(subAccountApiKey string) binance.SubAccountApiAddIpRestrictionServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SubAccountApiKey", subAccountApiKey)
+	ret0, _ := ret[0].(binance.SubAccountApiAddIpRestrictionServiceAPI)
+	return ret0
+}
+
+// SubAccountApiKey indicates an expected call of SubAccountApiKey.
+func (mr *MockSubAccountApiAddIpRestrictionServiceAPIMockRecorder) SubAccountApiKey(subAccountApiKey interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubAccountApiKey", reflect.TypeOf((*MockSubAccountApiAddIpRestrictionServiceAPI)(nil).SubAccountApiKey), subAccountApiKey)
+}
+
+// MockManagedSubAccountWithdrawServiceAPI is a mock of ManagedSubAccountWithdrawServiceAPI interface.
+type MockManagedSubAccountWithdrawServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockManagedSubAccountWithdrawServiceAPIMockRecorder
+}
+
+// MockManagedSubAccountWithdrawServiceAPIMockRecorder is the mock recorder for MockManagedSubAccountWithdrawServiceAPI.
+type MockManagedSubAccountWithdrawServiceAPIMockRecorder struct {
+	mock *MockManagedSubAccountWithdrawServiceAPI
+}
+
+// NewMockManagedSubAccountWithdrawServiceAPI creates a new mock instance.
+func NewMockManagedSubAccountWithdrawServiceAPI(ctrl *gomock.Controller) *MockManagedSubAccountWithdrawServiceAPI {
+	mock := &MockManagedSubAccountWithdrawServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockManagedSubAccountWithdrawServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockManagedSubAccountWithdrawServiceAPI) EXPECT() *MockManagedSubAccountWithdrawServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Amount mocks base method.
+func (m *MockManagedSubAccountWithdrawServiceAPI) Amount(amount string) binance.ManagedSubAccountWithdrawServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Amount", amount)
+	ret0, _ := ret[0].(binance.ManagedSubAccountWithdrawServiceAPI)
+	return ret0
+}
+
+// Amount indicates an expected call of Amount.
+func (mr *MockManagedSubAccountWithdrawServiceAPIMockRecorder) Amount(amount interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Amount", reflect.TypeOf((*MockManagedSubAccountWithdrawServiceAPI)(nil).Amount), amount)
+}
+
+// Asset mocks base method.
+func (m *MockManagedSubAccountWithdrawServiceAPI) Asset(asset string) binance.ManagedSubAccountWithdrawServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Asset", asset)
+	ret0, _ := ret[0].(binance.ManagedSubAccountWithdrawServiceAPI)
+	return ret0
+}
+
+// Asset indicates an expected call of Asset.
+func (mr *MockManagedSubAccountWithdrawServiceAPIMockRecorder) Asset(asset interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Asset", reflect.TypeOf((*MockManagedSubAccountWithdrawServiceAPI)(nil).Asset), asset)
+}
+
+// Do mocks base method.
+func (m *MockManagedSubAccountWithdrawServiceAPI) Do(ctx context.Context, opts ...binance.RequestOption) (*binance.ManagedSubAccountWithdrawServiceResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*binance.ManagedSubAccountWithdrawServiceResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockManagedSubAccountWithdrawServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockManagedSubAccountWithdrawServiceAPI)(nil).Do), varargs...)
+}
+
+// FromEmail mocks base method.
+func (m *MockManagedSubAccountWithdrawServiceAPI) FromEmail(fromEmail string) binance.ManagedSubAccountWithdrawServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FromEmail", fromEmail)
+	ret0, _ := ret[0].(binance.ManagedSubAccountWithdrawServiceAPI)
+	return ret0
+}
+
+// FromEmail indicates an expected call of FromEmail.
+func (mr *MockManagedSubAccountWithdrawServiceAPIMockRecorder) FromEmail(fromEmail interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FromEmail", reflect.TypeOf((*MockManagedSubAccountWithdrawServiceAPI)(nil).FromEmail), fromEmail)
+}
+
+// RecvWindow mocks base method.
+func (m *MockManagedSubAccountWithdrawServiceAPI) RecvWindow(recvWindow int64) binance.ManagedSubAccountWithdrawServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecvWindow", recvWindow)
+	ret0, _ := ret[0].(binance.ManagedSubAccountWithdrawServiceAPI)
+	return ret0
+}
+
+// RecvWindow indicates an expected call of RecvWindow.
+func (mr *MockManagedSubAccountWithdrawServiceAPIMockRecorder) RecvWindow(recvWindow interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvWindow", reflect.TypeOf((*MockManagedSubAccountWithdrawServiceAPI)(nil).RecvWindow), recvWindow)
+}
+
+// TransferDate mocks base method.
+func (m *MockManagedSubAccountWithdrawServiceAPI) TransferDate(transferDate int64) binance.ManagedSubAccountWithdrawServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TransferDate", transferDate)
+	ret0, _ := ret[0].(binance.ManagedSubAccountWithdrawServiceAPI)
+	return ret0
+}
+
+// TransferDate indicates an expected call of TransferDate.
+func (mr *MockManagedSubAccountWithdrawServiceAPIMockRecorder) TransferDate(transferDate interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TransferDate", reflect.TypeOf((*MockManagedSubAccountWithdrawServiceAPI)(nil).TransferDate), transferDate)
+}
+
+// MockManagedSubAccountSnapshotServiceAPI is a mock of ManagedSubAccountSnapshotServiceAPI interface.
+type MockManagedSubAccountSnapshotServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockManagedSubAccountSnapshotServiceAPIMockRecorder
+}
+
+// MockManagedSubAccountSnapshotServiceAPIMockRecorder is the mock recorder for MockManagedSubAccountSnapshotServiceAPI.
+type MockManagedSubAccountSnapshotServiceAPIMockRecorder struct {
+	mock *MockManagedSubAccountSnapshotServiceAPI
+}
+
+// NewMockManagedSubAccountSnapshotServiceAPI creates a new mock instance.
+func NewMockManagedSubAccountSnapshotServiceAPI(ctrl *gomock.Controller) *MockManagedSubAccountSnapshotServiceAPI {
+	mock := &MockManagedSubAccountSnapshotServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockManagedSubAccountSnapshotServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockManagedSubAccountSnapshotServiceAPI) EXPECT() *MockManagedSubAccountSnapshotServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// AccType mocks base method.
+func (m *MockManagedSubAccountSnapshotServiceAPI) AccType(accType string) binance.ManagedSubAccountSnapshotServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AccType", accType)
+	ret0, _ := ret[0].(binance.ManagedSubAccountSnapshotServiceAPI)
+	return ret0
+}
+
+// AccType indicates an expected call of AccType.
+func (mr *MockManagedSubAccountSnapshotServiceAPIMockRecorder) AccType(accType interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AccType", reflect.TypeOf((*MockManagedSubAccountSnapshotServiceAPI)(nil).AccType), accType)
+}
+
+// Do mocks base method.
+func (m *MockManagedSubAccountSnapshotServiceAPI) Do(ctx context.Context, opts ...binance.RequestOption) (*binance.ManagedSubAccountSnapshotServiceResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*binance.ManagedSubAccountSnapshotServiceResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockManagedSubAccountSnapshotServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockManagedSubAccountSnapshotServiceAPI)(nil).Do), varargs...)
+}
+
+// Email mocks base method.
+func (m *MockManagedSubAccountSnapshotServiceAPI) Email(email string) binance.ManagedSubAccountSnapshotServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Email", email)
+	ret0, _ := ret[0].(binance.ManagedSubAccountSnapshotServiceAPI)
+	return ret0
+}
+
+// Email indicates an expected call of Email.
+func (mr *MockManagedSubAccountSnapshotServiceAPIMockRecorder) Email(email interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Email", reflect.TypeOf((*MockManagedSubAccountSnapshotServiceAPI)(nil).Email), email)
+}
+
+// EndTime mocks base method.
+func (m *MockManagedSubAccountSnapshotServiceAPI) EndTime(endTime int64) binance.ManagedSubAccountSnapshotServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EndTime", endTime)
+	ret0, _ := ret[0].(binance.ManagedSubAccountSnapshotServiceAPI)
+	return ret0
+}
+
+// EndTime indicates an expected call of EndTime.
+func (mr *MockManagedSubAccountSnapshotServiceAPIMockRecorder) EndTime(endTime interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EndTime", reflect.TypeOf((*MockManagedSubAccountSnapshotServiceAPI)(nil).EndTime), endTime)
+}
+
+// Limit mocks base method.
+func (m *MockManagedSubAccountSnapshotServiceAPI) Limit(limit int32) binance.ManagedSubAccountSnapshotServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Limit", limit)
+	ret0, _ := ret[0].(binance.ManagedSubAccountSnapshotServiceAPI)
+	return ret0
+}
+
+// Limit indicates an expected call of Limit.
+func (mr *MockManagedSubAccountSnapshotServiceAPIMockRecorder) Limit(limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Limit", reflect.TypeOf((*MockManagedSubAccountSnapshotServiceAPI)(nil).Limit), limit)
+}
+
+// RecvWindow mocks base method.
+func (m *MockManagedSubAccountSnapshotServiceAPI) RecvWindow(recvWindow int64) binance.ManagedSubAccountSnapshotServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecvWindow", recvWindow)
+	ret0, _ := ret[0].(binance.ManagedSubAccountSnapshotServiceAPI)
+	return ret0
+}
+
+// RecvWindow indicates an expected call of RecvWindow.
+func (mr *MockManagedSubAccountSnapshotServiceAPIMockRecorder) RecvWindow(recvWindow interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvWindow", reflect.TypeOf((*MockManagedSubAccountSnapshotServiceAPI)(nil).RecvWindow), recvWindow)
+}
+
+// StartTime mocks base method.
+func (m *MockManagedSubAccountSnapshotServiceAPI) StartTime(startTime int64) binance.ManagedSubAccountSnapshotServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartTime", startTime)
+	ret0, _ := ret[0].(binance.ManagedSubAccountSnapshotServiceAPI)
+	return ret0
+}
+
+// StartTime indicates an expected call of StartTime.
+func (mr *MockManagedSubAccountSnapshotServiceAPIMockRecorder) StartTime(startTime interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartTime", reflect.TypeOf((*MockManagedSubAccountSnapshotServiceAPI)(nil).StartTime), startTime)
+}
+
+// MockManagedSubAccountQueryTransferLogForInvestorServiceAPI is a mock of ManagedSubAccountQueryTransferLogForInvestorServiceAPI interface.
+type MockManagedSubAccountQueryTransferLogForInvestorServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockManagedSubAccountQueryTransferLogForInvestorServiceAPIMockRecorder
+}
+
+// MockManagedSubAccountQueryTransferLogForInvestorServiceAPIMockRecorder is the mock recorder for MockManagedSubAccountQueryTransferLogForInvestorServiceAPI.
+type MockManagedSubAccountQueryTransferLogForInvestorServiceAPIMockRecorder struct {
+	mock *MockManagedSubAccountQueryTransferLogForInvestorServiceAPI
+}
+
+// NewMockManagedSubAccountQueryTransferLogForInvestorServiceAPI creates a new mock instance.
+func NewMockManagedSubAccountQueryTransferLogForInvestorServiceAPI(ctrl *gomock.Controller) *MockManagedSubAccountQueryTransferLogForInvestorServiceAPI {
+	mock := &MockManagedSubAccountQueryTransferLogForInvestorServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockManagedSubAccountQueryTransferLogForInvestorServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockManagedSubAccountQueryTransferLogForInvestorServiceAPI) EXPECT() *MockManagedSubAccountQueryTransferLogForInvestorServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockManagedSubAccountQueryTransferLogForInvestorServiceAPI) Do(ctx context.Context, opts ...binance.RequestOption) (*binance.ManagedSubAccountQueryTransferLogForInvestorServiceResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*binance.ManagedSubAccountQueryTransferLogForInvestorServiceResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockManagedSubAccountQueryTransferLogForInvestorServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockManagedSubAccountQueryTransferLogForInvestorServiceAPI)(nil).Do), varargs...)
+}
+
+// Email mocks base method.
+func (m *MockManagedSubAccountQueryTransferLogForInvestorServiceAPI) Email(email string) binance.ManagedSubAccountQueryTransferLogForInvestorServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Email", email)
+	ret0, _ := ret[0].(binance.ManagedSubAccountQueryTransferLogForInvestorServiceAPI)
+	return ret0
+}
+
+// Email indicates an expected call of Email.
+func (mr *MockManagedSubAccountQueryTransferLogForInvestorServiceAPIMockRecorder) Email(email interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Email", reflect.TypeOf((*MockManagedSubAccountQueryTransferLogForInvestorServiceAPI)(nil).Email), email)
+}
+
+// EndTime mocks base method.
+func (m *MockManagedSubAccountQueryTransferLogForInvestorServiceAPI) EndTime(endTime int64) binance.ManagedSubAccountQueryTransferLogForInvestorServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EndTime", endTime)
+	ret0, _ := ret[0].(binance.ManagedSubAccountQueryTransferLogForInvestorServiceAPI)
+	return ret0
+}
+
+// EndTime indicates an expected call of EndTime.
+func (mr *MockManagedSubAccountQueryTransferLogForInvestorServiceAPIMockRecorder) EndTime(endTime interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EndTime", reflect.TypeOf((*MockManagedSubAccountQueryTransferLogForInvestorServiceAPI)(nil).EndTime), endTime)
+}
+
+// Limit mocks base method.
+func (m *MockManagedSubAccountQueryTransferLogForInvestorServiceAPI) Limit(limit int32) binance.ManagedSubAccountQueryTransferLogForInvestorServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Limit", limit)
+	ret0, _ := ret[0].(binance.ManagedSubAccountQueryTransferLogForInvestorServiceAPI)
+	return ret0
+}
+
+// Limit indicates an expected call of Limit.
+func (mr *MockManagedSubAccountQueryTransferLogForInvestorServiceAPIMockRecorder) Limit(limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Limit", reflect.TypeOf((*MockManagedSubAccountQueryTransferLogForInvestorServiceAPI)(nil).Limit), limit)
+}
+
+// Page mocks base method.
+func (m *MockManagedSubAccountQueryTransferLogForInvestorServiceAPI) Page(page int32) binance.ManagedSubAccountQueryTransferLogForInvestorServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Page", page)
+	ret0, _ := ret[0].(binance.ManagedSubAccountQueryTransferLogForInvestorServiceAPI)
+	return ret0
+}
+
+// Page indicates an expected call of Page.
+func (mr *MockManagedSubAccountQueryTransferLogForInvestorServiceAPIMockRecorder) Page(page interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Page", reflect.TypeOf((*MockManagedSubAccountQueryTransferLogForInvestorServiceAPI)(nil).Page), page)
+}
+
+// StartTime mocks base method.
+func (m *MockManagedSubAccountQueryTransferLogForInvestorServiceAPI) StartTime(startTime int64) binance.ManagedSubAccountQueryTransferLogForInvestorServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartTime", startTime)
+	ret0, _ := ret[0].(binance.ManagedSubAccountQueryTransferLogForInvestorServiceAPI)
+	return ret0
+}
+
+// StartTime indicates an expected call of StartTime.
+func (mr *MockManagedSubAccountQueryTransferLogForInvestorServiceAPIMockRecorder) StartTime(startTime interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartTime", reflect.TypeOf((*MockManagedSubAccountQueryTransferLogForInvestorServiceAPI)(nil).StartTime), startTime)
+}
+
+// TransferFunctionAccountType mocks base method.
+func (m *MockManagedSubAccountQueryTransferLogForInvestorServiceAPI) TransferFunctionAccountType(transferFunctionAccountType string) binance.ManagedSubAccountQueryTransferLogForInvestorServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TransferFunctionAccountType", transferFunctionAccountType)
+	ret0, _ := ret[0].(binance.ManagedSubAccountQueryTransferLogForInvestorServiceAPI)
+	return ret0
+}
+
+// TransferFunctionAccountType indicates an expected call of TransferFunctionAccountType.
+func (mr *MockManagedSubAccountQueryTransferLogForInvestorServiceAPIMockRecorder) TransferFunctionAccountType(transferFunctionAccountType interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TransferFunctionAccountType", reflect.TypeOf((*MockManagedSubAccountQueryTransferLogForInvestorServiceAPI)(nil).TransferFunctionAccountType), transferFunctionAccountType)
+}
+
+// Transfers mocks base method.
+func (m *MockManagedSubAccountQueryTransferLogForInvestorServiceAPI) Transfers(transfers string) binance.ManagedSubAccountQueryTransferLogForInvestorServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Transfers", transfers)
+	ret0, _ := ret[0].(binance.ManagedSubAccountQueryTransferLogForInvestorServiceAPI)
+	return ret0
+}
+
+// Transfers indicates an expected call of Transfers.
+func (mr *MockManagedSubAccountQueryTransferLogForInvestorServiceAPIMockRecorder) Transfers(transfers interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Transfers", reflect.TypeOf((*MockManagedSubAccountQueryTransferLogForInvestorServiceAPI)(nil).Transfers), transfers)
+}
+
+// MockManagedSubAccountQueryTransferLogForTradeParentServiceAPI is a mock of ManagedSubAccountQueryTransferLogForTradeParentServiceAPI interface.
+type MockManagedSubAccountQueryTransferLogForTradeParentServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockManagedSubAccountQueryTransferLogForTradeParentServiceAPIMockRecorder
+}
+
+// MockManagedSubAccountQueryTransferLogForTradeParentServiceAPIMockRecorder is the mock recorder for MockManagedSubAccountQueryTransferLogForTradeParentServiceAPI.
+type MockManagedSubAccountQueryTransferLogForTradeParentServiceAPIMockRecorder struct {
+	mock *MockManagedSubAccountQueryTransferLogForTradeParentServiceAPI
+}
+
+// NewMockManagedSubAccountQueryTransferLogForTradeParentServiceAPI creates a new mock instance.
+func NewMockManagedSubAccountQueryTransferLogForTradeParentServiceAPI(ctrl *gomock.Controller) *MockManagedSubAccountQueryTransferLogForTradeParentServiceAPI {
+	mock := &MockManagedSubAccountQueryTransferLogForTradeParentServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockManagedSubAccountQueryTransferLogForTradeParentServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockManagedSubAccountQueryTransferLogForTradeParentServiceAPI) EXPECT() *MockManagedSubAccountQueryTransferLogForTradeParentServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockManagedSubAccountQueryTransferLogForTradeParentServiceAPI) Do(ctx context.Context, opts ...binance.RequestOption) (*binance.ManagedSubAccountQueryTransferLogForTradeParentServiceResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*binance.ManagedSubAccountQueryTransferLogForTradeParentServiceResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockManagedSubAccountQueryTransferLogForTradeParentServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockManagedSubAccountQueryTransferLogForTradeParentServiceAPI)(nil).Do), varargs...)
+}
+
+// Email mocks base method.
+func (m *MockManagedSubAccountQueryTransferLogForTradeParentServiceAPI) Email(email string) binance.ManagedSubAccountQueryTransferLogForTradeParentServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Email", email)
+	ret0, _ := ret[0].(binance.ManagedSubAccountQueryTransferLogForTradeParentServiceAPI)
+	return ret0
+}
+
+// Email indicates an expected call of Email.
+func (mr *MockManagedSubAccountQueryTransferLogForTradeParentServiceAPIMockRecorder) Email(email interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Email", reflect.TypeOf((*MockManagedSubAccountQueryTransferLogForTradeParentServiceAPI)(nil).Email), email)
+}
+
+// EndTime mocks base method.
+func (m *MockManagedSubAccountQueryTransferLogForTradeParentServiceAPI) EndTime(endTime int64) binance.ManagedSubAccountQueryTransferLogForTradeParentServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EndTime", endTime)
+	ret0, _ := ret[0].(binance.ManagedSubAccountQueryTransferLogForTradeParentServiceAPI)
+	return ret0
+}
+
+// EndTime indicates an expected call of EndTime.
+func (mr *MockManagedSubAccountQueryTransferLogForTradeParentServiceAPIMockRecorder) EndTime(endTime interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EndTime", reflect.TypeOf((*MockManagedSubAccountQueryTransferLogForTradeParentServiceAPI)(nil).EndTime), endTime)
+}
+
+// Limit mocks base method.
+func (m *MockManagedSubAccountQueryTransferLogForTradeParentServiceAPI) Limit(limit int32) binance.ManagedSubAccountQueryTransferLogForTradeParentServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Limit", limit)
+	ret0, _ := ret[0].(binance.ManagedSubAccountQueryTransferLogForTradeParentServiceAPI)
+	return ret0
+}
+
+// Limit indicates an expected call of Limit.
+func (mr *MockManagedSubAccountQueryTransferLogForTradeParentServiceAPIMockRecorder) Limit(limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Limit", reflect.TypeOf((*MockManagedSubAccountQueryTransferLogForTradeParentServiceAPI)(nil).Limit), limit)
+}
+
+// Page mocks base method.
+func (m *MockManagedSubAccountQueryTransferLogForTradeParentServiceAPI) Page(page int32) binance.ManagedSubAccountQueryTransferLogForTradeParentServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Page", page)
+	ret0, _ := ret[0].(binance.ManagedSubAccountQueryTransferLogForTradeParentServiceAPI)
+	return ret0
+}
+
+// Page indicates an expected call of Page.
+func (mr *MockManagedSubAccountQueryTransferLogForTradeParentServiceAPIMockRecorder) Page(page interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Page", reflect.TypeOf((*MockManagedSubAccountQueryTransferLogForTradeParentServiceAPI)(nil).Page), page)
+}
+
+// StartTime mocks base method.
+func (m *MockManagedSubAccountQueryTransferLogForTradeParentServiceAPI) StartTime(startTime int64) binance.ManagedSubAccountQueryTransferLogForTradeParentServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartTime", startTime)
+	ret0, _ := ret[0].(binance.ManagedSubAccountQueryTransferLogForTradeParentServiceAPI)
+	return ret0
+}
+
+// StartTime indicates an expected call of StartTime.
+func (mr *MockManagedSubAccountQueryTransferLogForTradeParentServiceAPIMockRecorder) StartTime(startTime interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartTime", reflect.TypeOf((*MockManagedSubAccountQueryTransferLogForTradeParentServiceAPI)(nil).StartTime), startTime)
+}
+
+// TransferFunctionAccountType mocks base method.
+func (m *MockManagedSubAccountQueryTransferLogForTradeParentServiceAPI) TransferFunctionAccountType(transferFunctionAccountType string) binance.ManagedSubAccountQueryTransferLogForTradeParentServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TransferFunctionAccountType", transferFunctionAccountType)
+	ret0, _ := ret[0].(binance.ManagedSubAccountQueryTransferLogForTradeParentServiceAPI)
+	return ret0
+}
+
+// TransferFunctionAccountType indicates an expected call of TransferFunctionAccountType.
+func (mr *MockManagedSubAccountQueryTransferLogForTradeParentServiceAPIMockRecorder) TransferFunctionAccountType(transferFunctionAccountType interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TransferFunctionAccountType", reflect.TypeOf((*MockManagedSubAccountQueryTransferLogForTradeParentServiceAPI)(nil).TransferFunctionAccountType), transferFunctionAccountType)
+}
+
+// Transfers mocks base method.
+func (m *MockManagedSubAccountQueryTransferLogForTradeParentServiceAPI) Transfers(transfers string) binance.ManagedSubAccountQueryTransferLogForTradeParentServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Transfers", transfers)
+	ret0, _ := ret[0].(binance.ManagedSubAccountQueryTransferLogForTradeParentServiceAPI)
+	return ret0
+}
+
+// Transfers indicates an expected call of Transfers.
+func (mr *MockManagedSubAccountQueryTransferLogForTradeParentServiceAPIMockRecorder) Transfers(transfers interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Transfers", reflect.TypeOf((*MockManagedSubAccountQueryTransferLogForTradeParentServiceAPI)(nil).Transfers), transfers)
+}
+
+// MockManagedSubAccountQueryFuturesAssetServiceAPI is a mock of ManagedSubAccountQueryFuturesAssetServiceAPI interface.
+type MockManagedSubAccountQueryFuturesAssetServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockManagedSubAccountQueryFuturesAssetServiceAPIMockRecorder
+}
+
+// MockManagedSubAccountQueryFuturesAssetServiceAPIMockRecorder is the mock recorder for MockManagedSubAccountQueryFuturesAssetServiceAPI.
+type MockManagedSubAccountQueryFuturesAssetServiceAPIMockRecorder struct {
+	mock *MockManagedSubAccountQueryFuturesAssetServiceAPI
+}
+
+// NewMockManagedSubAccountQueryFuturesAssetServiceAPI creates a new mock instance.
+func NewMockManagedSubAccountQueryFuturesAssetServiceAPI(ctrl *gomock.Controller) *MockManagedSubAccountQueryFuturesAssetServiceAPI {
+	mock := &MockManagedSubAccountQueryFuturesAssetServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockManagedSubAccountQueryFuturesAssetServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockManagedSubAccountQueryFuturesAssetServiceAPI) EXPECT() *MockManagedSubAccountQueryFuturesAssetServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockManagedSubAccountQueryFuturesAssetServiceAPI) Do(ctx context.Context, opts ...binance.RequestOption) (*binance.ManagedSubAccountQueryFuturesAssetServiceResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*binance.ManagedSubAccountQueryFuturesAssetServiceResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockManagedSubAccountQueryFuturesAssetServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockManagedSubAccountQueryFuturesAssetServiceAPI)(nil).Do), varargs...)
+}
+
+// Email mocks base method.
+func (m *MockManagedSubAccountQueryFuturesAssetServiceAPI) Email(email string) binance.ManagedSubAccountQueryFuturesAssetServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Email", email)
+	ret0, _ := ret[0].(binance.ManagedSubAccountQueryFuturesAssetServiceAPI)
+	return ret0
+}
+
+// Email indicates an expected call of Email.
+func (mr *MockManagedSubAccountQueryFuturesAssetServiceAPIMockRecorder) Email(email interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Email", reflect.TypeOf((*MockManagedSubAccountQueryFuturesAssetServiceAPI)(nil).Email), email)
+}
+
+// MockManagedSubAccountQueryMarginAssetServiceAPI is a mock of ManagedSubAccountQueryMarginAssetServiceAPI interface.
+type MockManagedSubAccountQueryMarginAssetServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockManagedSubAccountQueryMarginAssetServiceAPIMockRecorder
+}
+
+// MockManagedSubAccountQueryMarginAssetServiceAPIMockRecorder is the mock recorder for MockManagedSubAccountQueryMarginAssetServiceAPI.
+type MockManagedSubAccountQueryMarginAssetServiceAPIMockRecorder struct {
+	mock *MockManagedSubAccountQueryMarginAssetServiceAPI
+}
+
+// NewMockManagedSubAccountQueryMarginAssetServiceAPI creates a new mock instance.
+func NewMockManagedSubAccountQueryMarginAssetServiceAPI(ctrl *gomock.Controller) *MockManagedSubAccountQueryMarginAssetServiceAPI {
+	mock := &MockManagedSubAccountQueryMarginAssetServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockManagedSubAccountQueryMarginAssetServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockManagedSubAccountQueryMarginAssetServiceAPI) EXPECT() *MockManagedSubAccountQueryMarginAssetServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockManagedSubAccountQueryMarginAssetServiceAPI) Do(ctx context.Context, opts ...binance.RequestOption) (*binance.ManagedSubAccountQueryMarginAssetServiceResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*binance.ManagedSubAccountQueryMarginAssetServiceResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockManagedSubAccountQueryMarginAssetServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockManagedSubAccountQueryMarginAssetServiceAPI)(nil).Do), varargs...)
+}
+
+// Email mocks base method.
+func (m *MockManagedSubAccountQueryMarginAssetServiceAPI) Email(email string) binance.ManagedSubAccountQueryMarginAssetServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Email", email)
+	ret0, _ := ret[0].(binance.ManagedSubAccountQueryMarginAssetServiceAPI)
+	return ret0
+}
+
+// Email indicates an expected call of Email.
+func (mr *MockManagedSubAccountQueryMarginAssetServiceAPIMockRecorder) Email(email interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Email", reflect.TypeOf((*MockManagedSubAccountQueryMarginAssetServiceAPI)(nil).Email), email)
+}
+
+// MockSubAccountAssetServiceAPI is a mock of SubAccountAssetServiceAPI interface.
+type MockSubAccountAssetServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockSubAccountAssetServiceAPIMockRecorder
+}
+
+// MockSubAccountAssetServiceAPIMockRecorder is the mock recorder for MockSubAccountAssetServiceAPI.
+type MockSubAccountAssetServiceAPIMockRecorder struct {
+	mock *MockSubAccountAssetServiceAPI
+}
+
+// NewMockSubAccountAssetServiceAPI creates a new mock instance.
+func NewMockSubAccountAssetServiceAPI(ctrl *gomock.Controller) *MockSubAccountAssetServiceAPI {
+	mock := &MockSubAccountAssetServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockSubAccountAssetServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSubAccountAssetServiceAPI) EXPECT() *MockSubAccountAssetServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockSubAccountAssetServiceAPI) Do(ctx context.Context, opts ...binance.RequestOption) (*binance.SubAccountAssetServiceResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*binance.SubAccountAssetServiceResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockSubAccountAssetServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockSubAccountAssetServiceAPI)(nil).Do), varargs...)
+}
+
+// Email mocks base method.
+func (m *MockSubAccountAssetServiceAPI) Email(email string) binance.SubAccountAssetServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Email", email)
+	ret0, _ := ret[0].(binance.SubAccountAssetServiceAPI)
+	return ret0
+}
+
+// Email indicates an expected call of Email.
+func (mr *MockSubAccountAssetServiceAPIMockRecorder) Email(email interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Email", reflect.TypeOf((*MockSubAccountAssetServiceAPI)(nil).Email), email)
+}
+
+// RecvWindow mocks base method.
+func (m *MockSubAccountAssetServiceAPI) RecvWindow(recvWindow int64) binance.SubAccountAssetServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecvWindow", recvWindow)
+	ret0, _ := ret[0].(binance.SubAccountAssetServiceAPI)
+	return ret0
+}
+
+// RecvWindow indicates an expected call of RecvWindow.
+func (mr *MockSubAccountAssetServiceAPIMockRecorder) RecvWindow(recvWindow interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvWindow", reflect.TypeOf((*MockSubAccountAssetServiceAPI)(nil).RecvWindow), recvWindow)
+}
+
+// MockManagedSubAccountInfoServiceAPI is a mock of ManagedSubAccountInfoServiceAPI interface.
+type MockManagedSubAccountInfoServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockManagedSubAccountInfoServiceAPIMockRecorder
+}
+
+// MockManagedSubAccountInfoServiceAPIMockRecorder is the mock recorder for MockManagedSubAccountInfoServiceAPI.
+type MockManagedSubAccountInfoServiceAPIMockRecorder struct {
+	mock *MockManagedSubAccountInfoServiceAPI
+}
+
+// NewMockManagedSubAccountInfoServiceAPI creates a new mock instance.
+func NewMockManagedSubAccountInfoServiceAPI(ctrl *gomock.Controller) *MockManagedSubAccountInfoServiceAPI {
+	mock := &MockManagedSubAccountInfoServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockManagedSubAccountInfoServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockManagedSubAccountInfoServiceAPI) EXPECT() *MockManagedSubAccountInfoServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockManagedSubAccountInfoServiceAPI) Do(ctx context.Context, opts ...binance.RequestOption) (*binance.ManagedSubAccountInfoServiceResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*binance.ManagedSubAccountInfoServiceResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockManagedSubAccountInfoServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockManagedSubAccountInfoServiceAPI)(nil).Do), varargs...)
+}
+
+// Email mocks base method.
+func (m *MockManagedSubAccountInfoServiceAPI) Email(email string) binance.ManagedSubAccountInfoServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Email", email)
+	ret0, _ := ret[0].(binance.ManagedSubAccountInfoServiceAPI)
+	return ret0
+}
+
+// Email indicates an expected call of Email.
+func (mr *MockManagedSubAccountInfoServiceAPIMockRecorder) Email(email interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Email", reflect.TypeOf((*MockManagedSubAccountInfoServiceAPI)(nil).Email), email)
+}
+
+// Limit mocks base method.
+func (m *MockManagedSubAccountInfoServiceAPI) Limit(limit int32) binance.ManagedSubAccountInfoServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Limit", limit)
+	ret0, _ := ret[0].(binance.ManagedSubAccountInfoServiceAPI)
+	return ret0
+}
+
+// Limit indicates an expected call of Limit.
+func (mr *MockManagedSubAccountInfoServiceAPIMockRecorder) Limit(limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Limit", reflect.TypeOf((*MockManagedSubAccountInfoServiceAPI)(nil).Limit), limit)
+}
+
+// Page mocks base method.
+func (m *MockManagedSubAccountInfoServiceAPI) Page(page int32) binance.ManagedSubAccountInfoServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Page", page)
+	ret0, _ := ret[0].(binance.ManagedSubAccountInfoServiceAPI)
+	return ret0
+}
+
+// Page indicates an expected call of Page.
+func (mr *MockManagedSubAccountInfoServiceAPIMockRecorder) Page(page interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Page", reflect.TypeOf((*MockManagedSubAccountInfoServiceAPI)(nil).Page), page)
+}
+
+// RecvWindow mocks base method.
+func (m *MockManagedSubAccountInfoServiceAPI) RecvWindow(recvWindow int64) binance.ManagedSubAccountInfoServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecvWindow", recvWindow)
+	ret0, _ := ret[0].(binance.ManagedSubAccountInfoServiceAPI)
+	return ret0
+}
+
+// RecvWindow indicates an expected call of RecvWindow.
+func (mr *MockManagedSubAccountInfoServiceAPIMockRecorder) RecvWindow(recvWindow interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvWindow", reflect.TypeOf((*MockManagedSubAccountInfoServiceAPI)(nil).RecvWindow), recvWindow)
+}
+
+// MockManagedSubAccountDepositAddressServiceAPI is a mock of ManagedSubAccountDepositAddressServiceAPI interface.
+type MockManagedSubAccountDepositAddressServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockManagedSubAccountDepositAddressServiceAPIMockRecorder
+}
+
+// MockManagedSubAccountDepositAddressServiceAPIMockRecorder is the mock recorder for MockManagedSubAccountDepositAddressServiceAPI.
+type MockManagedSubAccountDepositAddressServiceAPIMockRecorder struct {
+	mock *MockManagedSubAccountDepositAddressServiceAPI
+}
+
+// NewMockManagedSubAccountDepositAddressServiceAPI creates a new mock instance.
+func NewMockManagedSubAccountDepositAddressServiceAPI(ctrl *gomock.Controller) *MockManagedSubAccountDepositAddressServiceAPI {
+	mock := &MockManagedSubAccountDepositAddressServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockManagedSubAccountDepositAddressServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockManagedSubAccountDepositAddressServiceAPI) EXPECT() *MockManagedSubAccountDepositAddressServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Coin mocks base method.
+func (m *MockManagedSubAccountDepositAddressServiceAPI) Coin(coin string) binance.ManagedSubAccountDepositAddressServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Coin", coin)
+	ret0, _ := ret[0].(binance.ManagedSubAccountDepositAddressServiceAPI)
+	return ret0
+}
+
+// Coin indicates an expected call of Coin.
+func (mr *MockManagedSubAccountDepositAddressServiceAPIMockRecorder) Coin(coin interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Coin", reflect.TypeOf((*MockManagedSubAccountDepositAddressServiceAPI)(nil).Coin), coin)
+}
+
+// Do mocks base method.
+func (m *MockManagedSubAccountDepositAddressServiceAPI) Do(ctx context.Context, opts ...binance.RequestOption) (*binance.ManagedSubAccountDepositAddressServiceResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*binance.ManagedSubAccountDepositAddressServiceResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockManagedSubAccountDepositAddressServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockManagedSubAccountDepositAddressServiceAPI)(nil).Do), varargs...)
+}
+
+// Email mocks base method.
+func (m *MockManagedSubAccountDepositAddressServiceAPI) Email(email string) binance.ManagedSubAccountDepositAddressServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Email", email)
+	ret0, _ := ret[0].(binance.ManagedSubAccountDepositAddressServiceAPI)
+	return ret0
+}
+
+// Email indicates an expected call of Email.
+func (mr *MockManagedSubAccountDepositAddressServiceAPIMockRecorder) Email(email interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Email", reflect.TypeOf((*MockManagedSubAccountDepositAddressServiceAPI)(nil).Email), email)
+}
+
+// Network mocks base method.
+func (m *MockManagedSubAccountDepositAddressServiceAPI) Network(network string) binance.ManagedSubAccountDepositAddressServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Network", network)
+	ret0, _ := ret[0].(binance.ManagedSubAccountDepositAddressServiceAPI)
+	return ret0
+}
+
+// Network indicates an expected call of Network.
+func (mr *MockManagedSubAccountDepositAddressServiceAPIMockRecorder) Network(network interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Network", reflect.TypeOf((*MockManagedSubAccountDepositAddressServiceAPI)(nil).Network), network)
+}
+
+// RecvWindow mocks base method.
+func (m *MockManagedSubAccountDepositAddressServiceAPI) RecvWindow(recvWindow int64) binance.ManagedSubAccountDepositAddressServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecvWindow", recvWindow)
+	ret0, _ := ret[0].(binance.ManagedSubAccountDepositAddressServiceAPI)
+	return ret0
+}
+
+// RecvWindow indicates an expected call of RecvWindow.
+func (mr *MockManagedSubAccountDepositAddressServiceAPIMockRecorder) RecvWindow(recvWindow interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvWindow", reflect.TypeOf((*MockManagedSubAccountDepositAddressServiceAPI)(nil).RecvWindow), recvWindow)
+}
+
+// MockSubAccountOptionsEnableServiceAPI is a mock of SubAccountOptionsEnableServiceAPI interface.
+type MockSubAccountOptionsEnableServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockSubAccountOptionsEnableServiceAPIMockRecorder
+}
+
+// MockSubAccountOptionsEnableServiceAPIMockRecorder is the mock recorder for MockSubAccountOptionsEnableServiceAPI.
+type MockSubAccountOptionsEnableServiceAPIMockRecorder struct {
+	mock *MockSubAccountOptionsEnableServiceAPI
+}
+
+// NewMockSubAccountOptionsEnableServiceAPI creates a new mock instance.
+func NewMockSubAccountOptionsEnableServiceAPI(ctrl *gomock.Controller) *MockSubAccountOptionsEnableServiceAPI {
+	mock := &MockSubAccountOptionsEnableServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockSubAccountOptionsEnableServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSubAccountOptionsEnableServiceAPI) EXPECT() *MockSubAccountOptionsEnableServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockSubAccountOptionsEnableServiceAPI) Do(ctx context.Context, opts ...binance.RequestOption) (*binance.SubAccountOptionsEnableServiceResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*binance.SubAccountOptionsEnableServiceResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockSubAccountOptionsEnableServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockSubAccountOptionsEnableServiceAPI)(nil).Do), varargs...)
+}
+
+// Email mocks base method.
+func (m *MockSubAccountOptionsEnableServiceAPI) Email(email string) binance.SubAccountOptionsEnableServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Email", email)
+	ret0, _ := ret[0].(binance.SubAccountOptionsEnableServiceAPI)
+	return ret0
+}
+
+// Email indicates an expected call of Email.
+func (mr *MockSubAccountOptionsEnableServiceAPIMockRecorder) Email(email interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Email", reflect.TypeOf((*MockSubAccountOptionsEnableServiceAPI)(nil).Email), email)
+}
+
+// RecvWindow mocks base method.
+func (m *MockSubAccountOptionsEnableServiceAPI) RecvWindow(recvWindow int64) binance.SubAccountOptionsEnableServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecvWindow", recvWindow)
+	ret0, _ := ret[0].(binance.SubAccountOptionsEnableServiceAPI)
+	return ret0
+}
+
+// RecvWindow indicates an expected call of RecvWindow.
+func (mr *MockSubAccountOptionsEnableServiceAPIMockRecorder) RecvWindow(recvWindow interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvWindow", reflect.TypeOf((*MockSubAccountOptionsEnableServiceAPI)(nil).RecvWindow), recvWindow)
+}
+
+// MockManagedSubAccountQueryTransferLogServiceAPI is a mock of ManagedSubAccountQueryTransferLogServiceAPI interface.
+type MockManagedSubAccountQueryTransferLogServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockManagedSubAccountQueryTransferLogServiceAPIMockRecorder
+}
+
+// MockManagedSubAccountQueryTransferLogServiceAPIMockRecorder is the mock recorder for MockManagedSubAccountQueryTransferLogServiceAPI.
+type MockManagedSubAccountQueryTransferLogServiceAPIMockRecorder struct {
+	mock *MockManagedSubAccountQueryTransferLogServiceAPI
+}
+
+// NewMockManagedSubAccountQueryTransferLogServiceAPI creates a new mock instance.
+func NewMockManagedSubAccountQueryTransferLogServiceAPI(ctrl *gomock.Controller) *MockManagedSubAccountQueryTransferLogServiceAPI {
+	mock := &MockManagedSubAccountQueryTransferLogServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockManagedSubAccountQueryTransferLogServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockManagedSubAccountQueryTransferLogServiceAPI) EXPECT() *MockManagedSubAccountQueryTransferLogServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockManagedSubAccountQueryTransferLogServiceAPI) Do(ctx context.Context, opts ...binance.RequestOption) (*binance.ManagedSubAccountQueryTransferLogServiceResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*binance.ManagedSubAccountQueryTransferLogServiceResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockManagedSubAccountQueryTransferLogServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockManagedSubAccountQueryTransferLogServiceAPI)(nil).Do), varargs...)
+}
+
+// EndTime mocks base method.
+func (m *MockManagedSubAccountQueryTransferLogServiceAPI) EndTime(endTime int64) binance.ManagedSubAccountQueryTransferLogServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EndTime", endTime)
+	ret0, _ := ret[0].(binance.ManagedSubAccountQueryTransferLogServiceAPI)
+	return ret0
+}
+
+// EndTime indicates an expected call of EndTime.
+func (mr *MockManagedSubAccountQueryTransferLogServiceAPIMockRecorder) EndTime(endTime interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EndTime", reflect.TypeOf((*MockManagedSubAccountQueryTransferLogServiceAPI)(nil).EndTime), endTime)
+}
+
+// Limit mocks base method.
+func (m *MockManagedSubAccountQueryTransferLogServiceAPI) Limit(limit int32) binance.ManagedSubAccountQueryTransferLogServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Limit", limit)
+	ret0, _ := ret[0].(binance.ManagedSubAccountQueryTransferLogServiceAPI)
+	return ret0
+}
+
+// Limit indicates an expected call of Limit.
+func (mr *MockManagedSubAccountQueryTransferLogServiceAPIMockRecorder) Limit(limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Limit", reflect.TypeOf((*MockManagedSubAccountQueryTransferLogServiceAPI)(nil).Limit), limit)
+}
+
+// Page mocks base method.
+func (m *MockManagedSubAccountQueryTransferLogServiceAPI) Page(page int32) binance.ManagedSubAccountQueryTransferLogServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Page", page)
+	ret0, _ := ret[0].(binance.ManagedSubAccountQueryTransferLogServiceAPI)
+	return ret0
+}
+
+// Page indicates an expected call of Page.
+func (mr *MockManagedSubAccountQueryTransferLogServiceAPIMockRecorder) Page(page interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Page", reflect.TypeOf((*MockManagedSubAccountQueryTransferLogServiceAPI)(nil).Page), page)
+}
+
+// RecvWindow mocks base method.
+func (m *MockManagedSubAccountQueryTransferLogServiceAPI) RecvWindow(recvWindow int64) binance.ManagedSubAccountQueryTransferLogServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecvWindow", recvWindow)
+	ret0, _ := ret[0].(binance.ManagedSubAccountQueryTransferLogServiceAPI)
+	return ret0
+}
+
+// RecvWindow indicates an expected call of RecvWindow.
+func (mr *MockManagedSubAccountQueryTransferLogServiceAPIMockRecorder) RecvWindow(recvWindow interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvWindow", reflect.TypeOf((*MockManagedSubAccountQueryTransferLogServiceAPI)(nil).RecvWindow), recvWindow)
+}
+
+// StartTime mocks base method.
+func (m *MockManagedSubAccountQueryTransferLogServiceAPI) StartTime(startTime int64) binance.ManagedSubAccountQueryTransferLogServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartTime", startTime)
+	ret0, _ := ret[0].(binance.ManagedSubAccountQueryTransferLogServiceAPI)
+	return ret0
+}
+
+// StartTime indicates an expected call of StartTime.
+func (mr *MockManagedSubAccountQueryTransferLogServiceAPIMockRecorder) StartTime(startTime interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartTime", reflect.TypeOf((*MockManagedSubAccountQueryTransferLogServiceAPI)(nil).StartTime), startTime)
+}
+
+// TransferFunctionAccountType mocks base method.
+func (m *MockManagedSubAccountQueryTransferLogServiceAPI) TransferFunctionAccountType(transferFunctionAccountType string) binance.ManagedSubAccountQueryTransferLogServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TransferFunctionAccountType", transferFunctionAccountType)
+	ret0, _ := ret[0].(binance.ManagedSubAccountQueryTransferLogServiceAPI)
+	return ret0
+}
+
+// TransferFunctionAccountType indicates an expected call of TransferFunctionAccountType.
+func (mr *MockManagedSubAccountQueryTransferLogServiceAPIMockRecorder) TransferFunctionAccountType(transferFunctionAccountType interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TransferFunctionAccountType", reflect.TypeOf((*MockManagedSubAccountQueryTransferLogServiceAPI)(nil).TransferFunctionAccountType), transferFunctionAccountType)
+}
+
+// Transfers mocks base method.
+func (m *MockManagedSubAccountQueryTransferLogServiceAPI) Transfers(transfers string) binance.ManagedSubAccountQueryTransferLogServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Transfers", transfers)
+	ret0, _ := ret[0].(binance.ManagedSubAccountQueryTransferLogServiceAPI)
+	return ret0
+}
+
+// Transfers indicates an expected call of Transfers.
+func (mr *MockManagedSubAccountQueryTransferLogServiceAPIMockRecorder) Transfers(transfers interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Transfers", reflect.TypeOf((*MockManagedSubAccountQueryTransferLogServiceAPI)(nil).Transfers), transfers)
+}
+
+// MockSubAccountFuturesInternalTransferServiceAPI is a mock of SubAccountFuturesInternalTransferServiceAPI interface.
+type MockSubAccountFuturesInternalTransferServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockSubAccountFuturesInternalTransferServiceAPIMockRecorder
+}
+
+// MockSubAccountFuturesInternalTransferServiceAPIMockRecorder is the mock recorder for MockSubAccountFuturesInternalTransferServiceAPI.
+type MockSubAccountFuturesInternalTransferServiceAPIMockRecorder struct {
+	mock *MockSubAccountFuturesInternalTransferServiceAPI
+}
+
+// NewMockSubAccountFuturesInternalTransferServiceAPI creates a new mock instance.
+func NewMockSubAccountFuturesInternalTransferServiceAPI(ctrl *gomock.Controller) *MockSubAccountFuturesInternalTransferServiceAPI {
+	mock := &MockSubAccountFuturesInternalTransferServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockSubAccountFuturesInternalTransferServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSubAccountFuturesInternalTransferServiceAPI) EXPECT() *MockSubAccountFuturesInternalTransferServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Amount mocks base method.
+func (m *MockSubAccountFuturesInternalTransferServiceAPI) Amount(amount string) binance.SubAccountFuturesInternalTransferServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Amount", amount)
+	ret0, _ := ret[0].(binance.SubAccountFuturesInternalTransferServiceAPI)
+	return ret0
+}
+
+// Amount indicates an expected call of Amount.
+func (mr *MockSubAccountFuturesInternalTransferServiceAPIMockRecorder) Amount(amount interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Amount", reflect.TypeOf((*MockSubAccountFuturesInternalTransferServiceAPI)(nil).Amount), amount)
+}
+
+// Asset mocks base method.
+func (m *MockSubAccountFuturesInternalTransferServiceAPI) Asset(asset string) binance.SubAccountFuturesInternalTransferServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Asset", asset)
+	ret0, _ := ret[0].(binance.SubAccountFuturesInternalTransferServiceAPI)
+	return ret0
+}
+
+// Asset indicates an expected call of Asset.
+func (mr *MockSubAccountFuturesInternalTransferServiceAPIMockRecorder) Asset(asset interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Asset", reflect.TypeOf((*MockSubAccountFuturesInternalTransferServiceAPI)(nil).Asset), asset)
+}
+
+// Do mocks base method.
+func (m *MockSubAccountFuturesInternalTransferServiceAPI) Do(ctx context.Context, opts ...binance.RequestOption) (*binance.SubAccountFuturesInternalTransferResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*binance.SubAccountFuturesInternalTransferResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockSubAccountFuturesInternalTransferServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockSubAccountFuturesInternalTransferServiceAPI)(nil).Do), varargs...)
+}
+
+// FromEmail mocks base method.
+func (m *MockSubAccountFuturesInternalTransferServiceAPI) FromEmail(fromEmail string) binance.SubAccountFuturesInternalTransferServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FromEmail", fromEmail)
+	ret0, _ := ret[0].(binance.SubAccountFuturesInternalTransferServiceAPI)
+	return ret0
+}
+
+// FromEmail indicates an expected call of FromEmail.
+func (mr *MockSubAccountFuturesInternalTransferServiceAPIMockRecorder) FromEmail(fromEmail interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FromEmail", reflect.TypeOf((*MockSubAccountFuturesInternalTransferServiceAPI)(nil).FromEmail), fromEmail)
+}
+
+// FuturesType mocks base method.
+func (m *MockSubAccountFuturesInternalTransferServiceAPI) FuturesType(futuresType int64) binance.SubAccountFuturesInternalTransferServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FuturesType", futuresType)
+	ret0, _ := ret[0].(binance.SubAccountFuturesInternalTransferServiceAPI)
+	return ret0
+}
+
+// FuturesType indicates an expected call of FuturesType.
+func (mr *MockSubAccountFuturesInternalTransferServiceAPIMockRecorder) FuturesType(futuresType interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FuturesType", reflect.TypeOf((*MockSubAccountFuturesInternalTransferServiceAPI)(nil).FuturesType), futuresType)
+}
+
+// RecvWindow mocks base method.
+func (m *MockSubAccountFuturesInternalTransferServiceAPI) RecvWindow(recvWindow int64) binance.SubAccountFuturesInternalTransferServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecvWindow", recvWindow)
+	ret0, _ := ret[0].(binance.SubAccountFuturesInternalTransferServiceAPI)
+	return ret0
+}
+
+// RecvWindow indicates an expected call of RecvWindow.
+func (mr *MockSubAccountFuturesInternalTransferServiceAPIMockRecorder) RecvWindow(recvWindow interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvWindow", reflect.TypeOf((*MockSubAccountFuturesInternalTransferServiceAPI)(nil).RecvWindow), recvWindow)
+}
+
+// ToEmail mocks base method.
+func (m *MockSubAccountFuturesInternalTransferServiceAPI) ToEmail(toEmail string) binance.SubAccountFuturesInternalTransferServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ToEmail", toEmail)
+	ret0, _ := ret[0].(binance.SubAccountFuturesInternalTransferServiceAPI)
+	return ret0
+}
+
+// ToEmail indicates an expected call of ToEmail.
+func (mr *MockSubAccountFuturesInternalTransferServiceAPIMockRecorder) ToEmail(toEmail interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ToEmail", reflect.TypeOf((*MockSubAccountFuturesInternalTransferServiceAPI)(nil).ToEmail), toEmail)
+}
+
+// MockSubAccountTransactionStatisticsServiceAPI is a mock of SubAccountTransactionStatisticsServiceAPI interface.
+type MockSubAccountTransactionStatisticsServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockSubAccountTransactionStatisticsServiceAPIMockRecorder
+}
+
+// MockSubAccountTransactionStatisticsServiceAPIMockRecorder is the mock recorder for MockSubAccountTransactionStatisticsServiceAPI.
+type MockSubAccountTransactionStatisticsServiceAPIMockRecorder struct {
+	mock *MockSubAccountTransactionStatisticsServiceAPI
+}
+
+// NewMockSubAccountTransactionStatisticsServiceAPI creates a new mock instance.
+func NewMockSubAccountTransactionStatisticsServiceAPI(ctrl *gomock.Controller) *MockSubAccountTransactionStatisticsServiceAPI {
+	mock := &MockSubAccountTransactionStatisticsServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockSubAccountTransactionStatisticsServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSubAccountTransactionStatisticsServiceAPI) EXPECT() *MockSubAccountTransactionStatisticsServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockSubAccountTransactionStatisticsServiceAPI) Do(ctx context.Context, opts ...binance.RequestOption) (*binance.SubAccountTransactionStatisticServiceResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*binance.SubAccountTransactionStatisticServiceResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockSubAccountTransactionStatisticsServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockSubAccountTransactionStatisticsServiceAPI)(nil).Do), varargs...)
+}
+
+// Email mocks base method.
+func (m *MockSubAccountTransactionStatisticsServiceAPI) Email(email string) binance.SubAccountTransactionStatisticsServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Email", email)
+	ret0, _ := ret[0].(binance.SubAccountTransactionStatisticsServiceAPI)
+	return ret0
+}
+
+// Email indicates an expected call of Email.
+func (mr *MockSubAccountTransactionStatisticsServiceAPIMockRecorder) Email(email interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Email", reflect.TypeOf((*MockSubAccountTransactionStatisticsServiceAPI)(nil).Email), email)
+}
+
+// RecvWindow mocks base method.
+func (m *MockSubAccountTransactionStatisticsServiceAPI) RecvWindow(recvWindow int64) binance.SubAccountTransactionStatisticsServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecvWindow", recvWindow)
+	ret0, _ := ret[0].(binance.SubAccountTransactionStatisticsServiceAPI)
+	return ret0
+}
+
+// RecvWindow indicates an expected call of RecvWindow.
+func (mr *MockSubAccountTransactionStatisticsServiceAPIMockRecorder) RecvWindow(recvWindow interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvWindow", reflect.TypeOf((*MockSubAccountTransactionStatisticsServiceAPI)(nil).RecvWindow), recvWindow)
+}
+
+// MockSubAccountFuturesAccountV2ServiceAPI is a mock of SubAccountFuturesAccountV2ServiceAPI interface.
+type MockSubAccountFuturesAccountV2ServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockSubAccountFuturesAccountV2ServiceAPIMockRecorder
+}
+
+// MockSubAccountFuturesAccountV2ServiceAPIMockRecorder is the mock recorder for MockSubAccountFuturesAccountV2ServiceAPI.
+type MockSubAccountFuturesAccountV2ServiceAPIMockRecorder struct {
+	mock *MockSubAccountFuturesAccountV2ServiceAPI
+}
+
+// NewMockSubAccountFuturesAccountV2ServiceAPI creates a new mock instance.
+func NewMockSubAccountFuturesAccountV2ServiceAPI(ctrl *gomock.Controller) *MockSubAccountFuturesAccountV2ServiceAPI {
+	mock := &MockSubAccountFuturesAccountV2ServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockSubAccountFuturesAccountV2ServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSubAccountFuturesAccountV2ServiceAPI) EXPECT() *MockSubAccountFuturesAccountV2ServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockSubAccountFuturesAccountV2ServiceAPI) Do(ctx context.Context, opts ...binance.RequestOption) (*binance.SubAccountFuturesAccountV2ServiceResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*binance.SubAccountFuturesAccountV2ServiceResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockSubAccountFuturesAccountV2ServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockSubAccountFuturesAccountV2ServiceAPI)(nil).Do), varargs...)
+}
+
+// Email mocks base method.
+func (m *MockSubAccountFuturesAccountV2ServiceAPI) Email(email string) binance.SubAccountFuturesAccountV2ServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Email", email)
+	ret0, _ := ret[0].(binance.SubAccountFuturesAccountV2ServiceAPI)
+	return ret0
+}
+
+// Email indicates an expected call of Email.
+func (mr *MockSubAccountFuturesAccountV2ServiceAPIMockRecorder) Email(email interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Email", reflect.TypeOf((*MockSubAccountFuturesAccountV2ServiceAPI)(nil).Email), email)
+}
+
+// FuturesType mocks base method.
+func (m *MockSubAccountFuturesAccountV2ServiceAPI) FuturesType(futuresType int32) binance.SubAccountFuturesAccountV2ServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FuturesType", futuresType)
+	ret0, _ := ret[0].(binance.SubAccountFuturesAccountV2ServiceAPI)
+	return ret0
+}
+
+// FuturesType indicates an expected call of FuturesType.
+func (mr *MockSubAccountFuturesAccountV2ServiceAPIMockRecorder) FuturesType(futuresType interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FuturesType", reflect.TypeOf((*MockSubAccountFuturesAccountV2ServiceAPI)(nil).FuturesType), futuresType)
+}
+
+// RecvWindow mocks base method.
+func (m *MockSubAccountFuturesAccountV2ServiceAPI) RecvWindow(recvWindow int64) binance.SubAccountFuturesAccountV2ServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecvWindow", recvWindow)
+	ret0, _ := ret[0].(binance.SubAccountFuturesAccountV2ServiceAPI)
+	return ret0
+}
+
+// RecvWindow indicates an expected call of RecvWindow.
+func (mr *MockSubAccountFuturesAccountV2ServiceAPIMockRecorder) RecvWindow(recvWindow interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvWindow", reflect.TypeOf((*MockSubAccountFuturesAccountV2ServiceAPI)(nil).RecvWindow), recvWindow)
+}
+
+// MockFuturesOrderBookHistoryServiceAPI is a mock of FuturesOrderBookHistoryServiceAPI interface.
+type MockFuturesOrderBookHistoryServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockFuturesOrderBookHistoryServiceAPIMockRecorder
+}
+
+// MockFuturesOrderBookHistoryServiceAPIMockRecorder is the mock recorder for MockFuturesOrderBookHistoryServiceAPI.
+type MockFuturesOrderBookHistoryServiceAPIMockRecorder struct {
+	mock *MockFuturesOrderBookHistoryServiceAPI
+}
+
+// NewMockFuturesOrderBookHistoryServiceAPI creates a new mock instance.
+func NewMockFuturesOrderBookHistoryServiceAPI(ctrl *gomock.Controller) *MockFuturesOrderBookHistoryServiceAPI {
+	mock := &MockFuturesOrderBookHistoryServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockFuturesOrderBookHistoryServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockFuturesOrderBookHistoryServiceAPI) EXPECT() *MockFuturesOrderBookHistoryServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// DataType mocks base method.
+func (m *MockFuturesOrderBookHistoryServiceAPI) DataType(dataType binance.FuturesOrderBookHistoryDataType) binance.FuturesOrderBookHistoryServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DataType", dataType)
+	ret0, _ := ret[0].(binance.FuturesOrderBookHistoryServiceAPI)
+	return ret0
+}
+
+// DataType indicates an expected call of DataType.
+func (mr *MockFuturesOrderBookHistoryServiceAPIMockRecorder) DataType(dataType interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DataType", reflect.TypeOf((*MockFuturesOrderBookHistoryServiceAPI)(nil).DataType), dataType)
+}
+
+// Do mocks base method.
+func (m *MockFuturesOrderBookHistoryServiceAPI) Do(ctx context.Context, opts ...binance.RequestOption) (*binance.FuturesOrderBookHistory, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*binance.FuturesOrderBookHistory)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockFuturesOrderBookHistoryServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockFuturesOrderBookHistoryServiceAPI)(nil).Do), varargs...)
+}
+
+// EndTime mocks base method.
+func (m *MockFuturesOrderBookHistoryServiceAPI) EndTime(endTime int64) binance.FuturesOrderBookHistoryServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EndTime", endTime)
+	ret0, _ := ret[0].(binance.FuturesOrderBookHistoryServiceAPI)
+	return ret0
+}
+
+// EndTime indicates an expected call of EndTime.
+func (mr *MockFuturesOrderBookHistoryServiceAPIMockRecorder) EndTime(endTime interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EndTime", reflect.TypeOf((*MockFuturesOrderBookHistoryServiceAPI)(nil).EndTime), endTime)
+}
+
+// StartTime mocks base method.
+func (m *MockFuturesOrderBookHistoryServiceAPI) StartTime(startTime int64) binance.FuturesOrderBookHistoryServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartTime", startTime)
+	ret0, _ := ret[0].(binance.FuturesOrderBookHistoryServiceAPI)
+	return ret0
+}
+
+// StartTime indicates an expected call of StartTime.
+func (mr *MockFuturesOrderBookHistoryServiceAPIMockRecorder) StartTime(startTime interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartTime", reflect.TypeOf((*MockFuturesOrderBookHistoryServiceAPI)(nil).StartTime), startTime)
+}
+
+// Symbol mocks base method.
+func (m *MockFuturesOrderBookHistoryServiceAPI) Symbol(symbol string) binance.FuturesOrderBookHistoryServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Symbol", symbol)
+	ret0, _ := ret[0].(binance.FuturesOrderBookHistoryServiceAPI)
+	return ret0
+}
+
+// Symbol indicates an expected call of Symbol.
+func (mr *MockFuturesOrderBookHistoryServiceAPIMockRecorder) Symbol(symbol interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Symbol", reflect.TypeOf((*MockFuturesOrderBookHistoryServiceAPI)(nil).Symbol), symbol)
+}
+
+// MockCreateFuturesAlgoVpOrderServiceAPI is a mock of CreateFuturesAlgoVpOrderServiceAPI interface.
+type MockCreateFuturesAlgoVpOrderServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockCreateFuturesAlgoVpOrderServiceAPIMockRecorder
+}
+
+// MockCreateFuturesAlgoVpOrderServiceAPIMockRecorder is the mock recorder for MockCreateFuturesAlgoVpOrderServiceAPI.
+type MockCreateFuturesAlgoVpOrderServiceAPIMockRecorder struct {
+	mock *MockCreateFuturesAlgoVpOrderServiceAPI
+}
+
+// NewMockCreateFuturesAlgoVpOrderServiceAPI creates a new mock instance.
+func NewMockCreateFuturesAlgoVpOrderServiceAPI(ctrl *gomock.Controller) *MockCreateFuturesAlgoVpOrderServiceAPI {
+	mock := &MockCreateFuturesAlgoVpOrderServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockCreateFuturesAlgoVpOrderServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCreateFuturesAlgoVpOrderServiceAPI) EXPECT() *MockCreateFuturesAlgoVpOrderServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// ClientAlgoId mocks base method.
+func (m *MockCreateFuturesAlgoVpOrderServiceAPI) ClientAlgoId(clientAlgoId string) binance.CreateFuturesAlgoVpOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClientAlgoId", clientAlgoId)
+	ret0, _ := ret[0].(binance.CreateFuturesAlgoVpOrderServiceAPI)
+	return ret0
+}
+
+// ClientAlgoId indicates an expected call of ClientAlgoId.
+func (mr *MockCreateFuturesAlgoVpOrderServiceAPIMockRecorder) ClientAlgoId(clientAlgoId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClientAlgoId", reflect.TypeOf((*MockCreateFuturesAlgoVpOrderServiceAPI)(nil).ClientAlgoId), clientAlgoId)
+}
+
+// Do mocks base method.
+func (m *MockCreateFuturesAlgoVpOrderServiceAPI) Do(ctx context.Context, opts ...binance.RequestOption) (*binance.CreateFuturesAlgoOrderResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*binance.CreateFuturesAlgoOrderResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockCreateFuturesAlgoVpOrderServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockCreateFuturesAlgoVpOrderServiceAPI)(nil).Do), varargs...)
+}
+
+// LimitPrice mocks base method.
+func (m *MockCreateFuturesAlgoVpOrderServiceAPI) LimitPrice(limitPrice float64) binance.CreateFuturesAlgoVpOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LimitPrice", limitPrice)
+	ret0, _ := ret[0].(binance.CreateFuturesAlgoVpOrderServiceAPI)
+	return ret0
+}
+
+// LimitPrice indicates an expected call of LimitPrice.
+func (mr *MockCreateFuturesAlgoVpOrderServiceAPIMockRecorder) LimitPrice(limitPrice interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LimitPrice", reflect.TypeOf((*MockCreateFuturesAlgoVpOrderServiceAPI)(nil).LimitPrice), limitPrice)
+}
+
+// PositionSide mocks base method.
+func (m *MockCreateFuturesAlgoVpOrderServiceAPI) PositionSide(positionSide futures.PositionSideType) binance.CreateFuturesAlgoVpOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PositionSide", positionSide)
+	ret0, _ := ret[0].(binance.CreateFuturesAlgoVpOrderServiceAPI)
+	return ret0
+}
+
+// PositionSide indicates an expected call of PositionSide.
+func (mr *MockCreateFuturesAlgoVpOrderServiceAPIMockRecorder) PositionSide(positionSide interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PositionSide", reflect.TypeOf((*MockCreateFuturesAlgoVpOrderServiceAPI)(nil).PositionSide), positionSide)
+}
+
+// Quantity mocks base method.
+func (m *MockCreateFuturesAlgoVpOrderServiceAPI) Quantity(quantity float64) binance.CreateFuturesAlgoVpOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Quantity", quantity)
+	ret0, _ := ret[0].(binance.CreateFuturesAlgoVpOrderServiceAPI)
+	return ret0
+}
+
+// Quantity indicates an expected call of Quantity.
+func (mr *MockCreateFuturesAlgoVpOrderServiceAPIMockRecorder) Quantity(quantity interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Quantity", reflect.TypeOf((*MockCreateFuturesAlgoVpOrderServiceAPI)(nil).Quantity), quantity)
+}
+
+// ReduceOnly mocks base method.
+func (m *MockCreateFuturesAlgoVpOrderServiceAPI) ReduceOnly(reduceOnly bool) binance.CreateFuturesAlgoVpOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReduceOnly", reduceOnly)
+	ret0, _ := ret[0].(binance.CreateFuturesAlgoVpOrderServiceAPI)
+	return ret0
+}
+
+// ReduceOnly indicates an expected call of ReduceOnly.
+func (mr *MockCreateFuturesAlgoVpOrderServiceAPIMockRecorder) ReduceOnly(reduceOnly interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReduceOnly", reflect.TypeOf((*MockCreateFuturesAlgoVpOrderServiceAPI)(nil).ReduceOnly), reduceOnly)
+}
+
+// Side mocks base method.
+func (m *MockCreateFuturesAlgoVpOrderServiceAPI) Side(side binance.SideType) binance.CreateFuturesAlgoVpOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Side", side)
+	ret0, _ := ret[0].(binance.CreateFuturesAlgoVpOrderServiceAPI)
+	return ret0
+}
+
+// Side indicates an expected call of Side.
+func (mr *MockCreateFuturesAlgoVpOrderServiceAPIMockRecorder) Side(side interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Side", reflect.TypeOf((*MockCreateFuturesAlgoVpOrderServiceAPI)(nil).Side), side)
+}
+
+// Symbol mocks base method.
+func (m *MockCreateFuturesAlgoVpOrderServiceAPI) Symbol(symbol string) binance.CreateFuturesAlgoVpOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Symbol", symbol)
+	ret0, _ := ret[0].(binance.CreateFuturesAlgoVpOrderServiceAPI)
+	return ret0
+}
+
+// Symbol indicates an expected call of Symbol.
+func (mr *MockCreateFuturesAlgoVpOrderServiceAPIMockRecorder) Symbol(symbol interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Symbol", reflect.TypeOf((*MockCreateFuturesAlgoVpOrderServiceAPI)(nil).Symbol), symbol)
+}
+
+// Urgency mocks base method.
+func (m *MockCreateFuturesAlgoVpOrderServiceAPI) Urgency(urgency binance.FuturesAlgoUrgencyType) binance.CreateFuturesAlgoVpOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Urgency", urgency)
+	ret0, _ := ret[0].(binance.CreateFuturesAlgoVpOrderServiceAPI)
+	return ret0
+}
+
+// Urgency indicates an expected call of Urgency.
+func (mr *MockCreateFuturesAlgoVpOrderServiceAPIMockRecorder) Urgency(urgency interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Urgency", reflect.TypeOf((*MockCreateFuturesAlgoVpOrderServiceAPI)(nil).Urgency), urgency)
+}
+
+// MockCreateFuturesAlgoTwapOrderServiceAPI is a mock of CreateFuturesAlgoTwapOrderServiceAPI interface.
+type MockCreateFuturesAlgoTwapOrderServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockCreateFuturesAlgoTwapOrderServiceAPIMockRecorder
+}
+
+// MockCreateFuturesAlgoTwapOrderServiceAPIMockRecorder is the mock recorder for MockCreateFuturesAlgoTwapOrderServiceAPI.
+type MockCreateFuturesAlgoTwapOrderServiceAPIMockRecorder struct {
+	mock *MockCreateFuturesAlgoTwapOrderServiceAPI
+}
+
+// NewMockCreateFuturesAlgoTwapOrderServiceAPI creates a new mock instance.
+func NewMockCreateFuturesAlgoTwapOrderServiceAPI(ctrl *gomock.Controller) *MockCreateFuturesAlgoTwapOrderServiceAPI {
+	mock := &MockCreateFuturesAlgoTwapOrderServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockCreateFuturesAlgoTwapOrderServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCreateFuturesAlgoTwapOrderServiceAPI) EXPECT() *MockCreateFuturesAlgoTwapOrderServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// ClientAlgoId mocks base method.
+func (m *MockCreateFuturesAlgoTwapOrderServiceAPI) ClientAlgoId(clientAlgoId string) binance.CreateFuturesAlgoTwapOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClientAlgoId", clientAlgoId)
+	ret0, _ := ret[0].(binance.CreateFuturesAlgoTwapOrderServiceAPI)
+	return ret0
+}
+
+// ClientAlgoId indicates an expected call of ClientAlgoId.
+func (mr *MockCreateFuturesAlgoTwapOrderServiceAPIMockRecorder) ClientAlgoId(clientAlgoId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClientAlgoId", reflect.TypeOf((*MockCreateFuturesAlgoTwapOrderServiceAPI)(nil).ClientAlgoId), clientAlgoId)
+}
+
+// Do mocks base method.
+func (m *MockCreateFuturesAlgoTwapOrderServiceAPI) Do(ctx context.Context, opts ...binance.RequestOption) (*binance.CreateFuturesAlgoOrderResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*binance.CreateFuturesAlgoOrderResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockCreateFuturesAlgoTwapOrderServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockCreateFuturesAlgoTwapOrderServiceAPI)(nil).Do), varargs...)
+}
+
+// Duration mocks base method.
+func (m *MockCreateFuturesAlgoTwapOrderServiceAPI) Duration(duration int64) binance.CreateFuturesAlgoTwapOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Duration", duration)
+	ret0, _ := ret[0].(binance.CreateFuturesAlgoTwapOrderServiceAPI)
+	return ret0
+}
+
+// Duration indicates an expected call of Duration.
+func (mr *MockCreateFuturesAlgoTwapOrderServiceAPIMockRecorder) Duration(duration interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Duration", reflect.TypeOf((*MockCreateFuturesAlgoTwapOrderServiceAPI)(nil).Duration), duration)
+}
+
+// LimitPrice mocks base method.
+func (m *MockCreateFuturesAlgoTwapOrderServiceAPI) LimitPrice(limitPrice float64) binance.CreateFuturesAlgoTwapOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LimitPrice", limitPrice)
+	ret0, _ := ret[0].(binance.CreateFuturesAlgoTwapOrderServiceAPI)
+	return ret0
+}
+
+// LimitPrice indicates an expected call of LimitPrice.
+func (mr *MockCreateFuturesAlgoTwapOrderServiceAPIMockRecorder) LimitPrice(limitPrice interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LimitPrice", reflect.TypeOf((*MockCreateFuturesAlgoTwapOrderServiceAPI)(nil).LimitPrice), limitPrice)
+}
+
+// PositionSide mocks base method.
+func (m *MockCreateFuturesAlgoTwapOrderServiceAPI) PositionSide(positionSide futures.PositionSideType) binance.CreateFuturesAlgoTwapOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PositionSide", positionSide)
+	ret0, _ := ret[0].(binance.CreateFuturesAlgoTwapOrderServiceAPI)
+	return ret0
+}
+
+// PositionSide indicates an expected call of PositionSide.
+func (mr *MockCreateFuturesAlgoTwapOrderServiceAPIMockRecorder) PositionSide(positionSide interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PositionSide", reflect.TypeOf((*MockCreateFuturesAlgoTwapOrderServiceAPI)(nil).PositionSide), positionSide)
+}
+
+// Quantity mocks base method.
+func (m *MockCreateFuturesAlgoTwapOrderServiceAPI) Quantity(quantity float64) binance.CreateFuturesAlgoTwapOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Quantity", quantity)
+	ret0, _ := ret[0].(binance.CreateFuturesAlgoTwapOrderServiceAPI)
+	return ret0
+}
+
+// Quantity indicates an expected call of Quantity.
+func (mr *MockCreateFuturesAlgoTwapOrderServiceAPIMockRecorder) Quantity(quantity interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Quantity", reflect.TypeOf((*MockCreateFuturesAlgoTwapOrderServiceAPI)(nil).Quantity), quantity)
+}
+
+// ReduceOnly mocks base method.
+func (m *MockCreateFuturesAlgoTwapOrderServiceAPI) ReduceOnly(reduceOnly bool) binance.CreateFuturesAlgoTwapOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReduceOnly", reduceOnly)
+	ret0, _ := ret[0].(binance.CreateFuturesAlgoTwapOrderServiceAPI)
+	return ret0
+}
+
+// ReduceOnly indicates an expected call of ReduceOnly.
+func (mr *MockCreateFuturesAlgoTwapOrderServiceAPIMockRecorder) ReduceOnly(reduceOnly interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReduceOnly", reflect.TypeOf((*MockCreateFuturesAlgoTwapOrderServiceAPI)(nil).ReduceOnly), reduceOnly)
+}
+
+// Side mocks base method.
+func (m *MockCreateFuturesAlgoTwapOrderServiceAPI) Side(side binance.SideType) binance.CreateFuturesAlgoTwapOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Side", side)
+	ret0, _ := ret[0].(binance.CreateFuturesAlgoTwapOrderServiceAPI)
+	return ret0
+}
+
+// Side indicates an expected call of Side.
+func (mr *MockCreateFuturesAlgoTwapOrderServiceAPIMockRecorder) Side(side interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Side", reflect.TypeOf((*MockCreateFuturesAlgoTwapOrderServiceAPI)(nil).Side), side)
+}
+
+// Symbol mocks base method.
+func (m *MockCreateFuturesAlgoTwapOrderServiceAPI) Symbol(symbol string) binance.CreateFuturesAlgoTwapOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Symbol", symbol)
+	ret0, _ := ret[0].(binance.CreateFuturesAlgoTwapOrderServiceAPI)
+	return ret0
+}
+
+// Symbol indicates an expected call of Symbol.
+func (mr *MockCreateFuturesAlgoTwapOrderServiceAPIMockRecorder) Symbol(symbol interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Symbol", reflect.TypeOf((*MockCreateFuturesAlgoTwapOrderServiceAPI)(nil).Symbol), symbol)
+}
+
+// MockListOpenFuturesAlgoOrdersServiceAPI is a mock of ListOpenFuturesAlgoOrdersServiceAPI interface.
+type MockListOpenFuturesAlgoOrdersServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockListOpenFuturesAlgoOrdersServiceAPIMockRecorder
+}
+
+// MockListOpenFuturesAlgoOrdersServiceAPIMockRecorder is the mock recorder for MockListOpenFuturesAlgoOrdersServiceAPI.
+type MockListOpenFuturesAlgoOrdersServiceAPIMockRecorder struct {
+	mock *MockListOpenFuturesAlgoOrdersServiceAPI
+}
+
+// NewMockListOpenFuturesAlgoOrdersServiceAPI creates a new mock instance.
+func NewMockListOpenFuturesAlgoOrdersServiceAPI(ctrl *gomock.Controller) *MockListOpenFuturesAlgoOrdersServiceAPI {
+	mock := &MockListOpenFuturesAlgoOrdersServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockListOpenFuturesAlgoOrdersServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockListOpenFuturesAlgoOrdersServiceAPI) EXPECT() *MockListOpenFuturesAlgoOrdersServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockListOpenFuturesAlgoOrdersServiceAPI) Do(ctx context.Context, opts ...binance.RequestOption) (*binance.ListOpenFuturesAlgoOrdersResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*binance.ListOpenFuturesAlgoOrdersResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockListOpenFuturesAlgoOrdersServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockListOpenFuturesAlgoOrdersServiceAPI)(nil).Do), varargs...)
+}
+
+// MockListHistoryFuturesAlgoOrdersServiceAPI is a mock of ListHistoryFuturesAlgoOrdersServiceAPI interface.
+type MockListHistoryFuturesAlgoOrdersServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockListHistoryFuturesAlgoOrdersServiceAPIMockRecorder
+}
+
+// MockListHistoryFuturesAlgoOrdersServiceAPIMockRecorder is the mock recorder for MockListHistoryFuturesAlgoOrdersServiceAPI.
+type MockListHistoryFuturesAlgoOrdersServiceAPIMockRecorder struct {
+	mock *MockListHistoryFuturesAlgoOrdersServiceAPI
+}
+
+// NewMockListHistoryFuturesAlgoOrdersServiceAPI creates a new mock instance.
+func NewMockListHistoryFuturesAlgoOrdersServiceAPI(ctrl *gomock.Controller) *MockListHistoryFuturesAlgoOrdersServiceAPI {
+	mock := &MockListHistoryFuturesAlgoOrdersServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockListHistoryFuturesAlgoOrdersServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockListHistoryFuturesAlgoOrdersServiceAPI) EXPECT() *MockListHistoryFuturesAlgoOrdersServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockListHistoryFuturesAlgoOrdersServiceAPI) Do(ctx context.Context, opts ...binance.RequestOption) (*binance.ListHistoryFuturesAlgoOrdersResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*binance.ListHistoryFuturesAlgoOrdersResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockListHistoryFuturesAlgoOrdersServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockListHistoryFuturesAlgoOrdersServiceAPI)(nil).Do), varargs...)
+}
+
+// EndTime mocks base method.
+func (m *MockListHistoryFuturesAlgoOrdersServiceAPI) EndTime(endTime int64) binance.ListHistoryFuturesAlgoOrdersServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EndTime", endTime)
+	ret0, _ := ret[0].(binance.ListHistoryFuturesAlgoOrdersServiceAPI)
+	return ret0
+}
+
+// EndTime indicates an expected call of EndTime.
+func (mr *MockListHistoryFuturesAlgoOrdersServiceAPIMockRecorder) EndTime(endTime interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EndTime", reflect.TypeOf((*MockListHistoryFuturesAlgoOrdersServiceAPI)(nil).EndTime), endTime)
+}
+
+// Page mocks base method.
+func (m *MockListHistoryFuturesAlgoOrdersServiceAPI) Page(page int) binance.ListHistoryFuturesAlgoOrdersServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Page", page)
+	ret0, _ := ret[0].(binance.ListHistoryFuturesAlgoOrdersServiceAPI)
+	return ret0
+}
+
+// Page indicates an expected call of Page.
+func (mr *MockListHistoryFuturesAlgoOrdersServiceAPIMockRecorder) Page(page interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Page", reflect.TypeOf((*MockListHistoryFuturesAlgoOrdersServiceAPI)(nil).Page), page)
+}
+
+// PageSize mocks base method.
+func (m *MockListHistoryFuturesAlgoOrdersServiceAPI) PageSize(pageSize int) binance.ListHistoryFuturesAlgoOrdersServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PageSize", pageSize)
+	ret0, _ := ret[0].(binance.ListHistoryFuturesAlgoOrdersServiceAPI)
+	return ret0
+}
+
+// PageSize indicates an expected call of PageSize.
+func (mr *MockListHistoryFuturesAlgoOrdersServiceAPIMockRecorder) PageSize(pageSize interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PageSize", reflect.TypeOf((*MockListHistoryFuturesAlgoOrdersServiceAPI)(nil).PageSize), pageSize)
+}
+
+// Side mocks base method.
+func (m *MockListHistoryFuturesAlgoOrdersServiceAPI) Side(side binance.SideType) binance.ListHistoryFuturesAlgoOrdersServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Side", side)
+	ret0, _ := ret[0].(binance.ListHistoryFuturesAlgoOrdersServiceAPI)
+	return ret0
+}
+
+// Side indicates an expected call of Side.
+func (mr *MockListHistoryFuturesAlgoOrdersServiceAPIMockRecorder) Side(side interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Side", reflect.TypeOf((*MockListHistoryFuturesAlgoOrdersServiceAPI)(nil).Side), side)
+}
+
+// StartTime mocks base method.
+func (m *MockListHistoryFuturesAlgoOrdersServiceAPI) StartTime(startTime int64) binance.ListHistoryFuturesAlgoOrdersServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartTime", startTime)
+	ret0, _ := ret[0].(binance.ListHistoryFuturesAlgoOrdersServiceAPI)
+	return ret0
+}
+
+// StartTime indicates an expected call of StartTime.
+func (mr *MockListHistoryFuturesAlgoOrdersServiceAPIMockRecorder) StartTime(startTime interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartTime", reflect.TypeOf((*MockListHistoryFuturesAlgoOrdersServiceAPI)(nil).StartTime), startTime)
+}
+
+// Symbol mocks base method.
+func (m *MockListHistoryFuturesAlgoOrdersServiceAPI) Symbol(symbol string) binance.ListHistoryFuturesAlgoOrdersServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Symbol", symbol)
+	ret0, _ := ret[0].(binance.ListHistoryFuturesAlgoOrdersServiceAPI)
+	return ret0
+}
+
+// Symbol indicates an expected call of Symbol.
+func (mr *MockListHistoryFuturesAlgoOrdersServiceAPIMockRecorder) Symbol(symbol interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Symbol", reflect.TypeOf((*MockListHistoryFuturesAlgoOrdersServiceAPI)(nil).Symbol), symbol)
+}
+
+// MockCancelFuturesAlgoOrderServiceAPI is a mock of CancelFuturesAlgoOrderServiceAPI interface.
+type MockCancelFuturesAlgoOrderServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockCancelFuturesAlgoOrderServiceAPIMockRecorder
+}
+
+// MockCancelFuturesAlgoOrderServiceAPIMockRecorder is the mock recorder for MockCancelFuturesAlgoOrderServiceAPI.
+type MockCancelFuturesAlgoOrderServiceAPIMockRecorder struct {
+	mock *MockCancelFuturesAlgoOrderServiceAPI
+}
+
+// NewMockCancelFuturesAlgoOrderServiceAPI creates a new mock instance.
+func NewMockCancelFuturesAlgoOrderServiceAPI(ctrl *gomock.Controller) *MockCancelFuturesAlgoOrderServiceAPI {
+	mock := &MockCancelFuturesAlgoOrderServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockCancelFuturesAlgoOrderServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCancelFuturesAlgoOrderServiceAPI) EXPECT() *MockCancelFuturesAlgoOrderServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// AlgoId mocks base method.
+func (m *MockCancelFuturesAlgoOrderServiceAPI) AlgoId(algoId int64) binance.CancelFuturesAlgoOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AlgoId", algoId)
+	ret0, _ := ret[0].(binance.CancelFuturesAlgoOrderServiceAPI)
+	return ret0
+}
+
+// AlgoId indicates an expected call of AlgoId.
+func (mr *MockCancelFuturesAlgoOrderServiceAPIMockRecorder) AlgoId(algoId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AlgoId", reflect.TypeOf((*MockCancelFuturesAlgoOrderServiceAPI)(nil).AlgoId), algoId)
+}
+
+// Do mocks base method.
+func (m *MockCancelFuturesAlgoOrderServiceAPI) Do(ctx context.Context, opts ...binance.RequestOption) (*binance.CancelFuturesAlgoOrderResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*binance.CancelFuturesAlgoOrderResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockCancelFuturesAlgoOrderServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockCancelFuturesAlgoOrderServiceAPI)(nil).Do), varargs...)
+}
+
+// MockGetFuturesAlgoSubOrdersServiceAPI is a mock of GetFuturesAlgoSubOrdersServiceAPI interface.
+type MockGetFuturesAlgoSubOrdersServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockGetFuturesAlgoSubOrdersServiceAPIMockRecorder
+}
+
+// MockGetFuturesAlgoSubOrdersServiceAPIMockRecorder is the mock recorder for MockGetFuturesAlgoSubOrdersServiceAPI.
+type MockGetFuturesAlgoSubOrdersServiceAPIMockRecorder struct {
+	mock *MockGetFuturesAlgoSubOrdersServiceAPI
+}
+
+// NewMockGetFuturesAlgoSubOrdersServiceAPI creates a new mock instance.
+func NewMockGetFuturesAlgoSubOrdersServiceAPI(ctrl *gomock.Controller) *MockGetFuturesAlgoSubOrdersServiceAPI {
+	mock := &MockGetFuturesAlgoSubOrdersServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockGetFuturesAlgoSubOrdersServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGetFuturesAlgoSubOrdersServiceAPI) EXPECT() *MockGetFuturesAlgoSubOrdersServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// AlgoId mocks base method.
+func (m *MockGetFuturesAlgoSubOrdersServiceAPI) AlgoId(algoId int64) binance.GetFuturesAlgoSubOrdersServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AlgoId", algoId)
+	ret0, _ := ret[0].(binance.GetFuturesAlgoSubOrdersServiceAPI)
+	return ret0
+}
+
+// AlgoId indicates an expected call of AlgoId.
+func (mr *MockGetFuturesAlgoSubOrdersServiceAPIMockRecorder) AlgoId(algoId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AlgoId", reflect.TypeOf((*MockGetFuturesAlgoSubOrdersServiceAPI)(nil).AlgoId), algoId)
+}
+
+// Do mocks base method.
+func (m *MockGetFuturesAlgoSubOrdersServiceAPI) Do(ctx context.Context, opts ...binance.RequestOption) (*binance.GetFuturesAlgoSubOrdersResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*binance.GetFuturesAlgoSubOrdersResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockGetFuturesAlgoSubOrdersServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockGetFuturesAlgoSubOrdersServiceAPI)(nil).Do), varargs...)
+}
+
+// Page mocks base method.
+func (m *MockGetFuturesAlgoSubOrdersServiceAPI) Page(page int) binance.GetFuturesAlgoSubOrdersServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Page", page)
+	ret0, _ := ret[0].(binance.GetFuturesAlgoSubOrdersServiceAPI)
+	return ret0
+}
+
+// Page indicates an expected call of Page.
+func (mr *MockGetFuturesAlgoSubOrdersServiceAPIMockRecorder) Page(page interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Page", reflect.TypeOf((*MockGetFuturesAlgoSubOrdersServiceAPI)(nil).Page), page)
+}
+
+// PageSize mocks base method.
+func (m *MockGetFuturesAlgoSubOrdersServiceAPI) PageSize(pageSize int) binance.GetFuturesAlgoSubOrdersServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PageSize", pageSize)
+	ret0, _ := ret[0].(binance.GetFuturesAlgoSubOrdersServiceAPI)
+	return ret0
+}
+
+// PageSize indicates an expected call of PageSize.
+func (mr *MockGetFuturesAlgoSubOrdersServiceAPIMockRecorder) PageSize(pageSize interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PageSize", reflect.TypeOf((*MockGetFuturesAlgoSubOrdersServiceAPI)(nil).PageSize), pageSize)
+}
+
+// MockSimpleEarnServiceAPI is a mock of SimpleEarnServiceAPI interface.
+type MockSimpleEarnServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockSimpleEarnServiceAPIMockRecorder
+}
+
+// MockSimpleEarnServiceAPIMockRecorder is the mock recorder for MockSimpleEarnServiceAPI.
+type MockSimpleEarnServiceAPIMockRecorder struct {
+	mock *MockSimpleEarnServiceAPI
+}
+
+// NewMockSimpleEarnServiceAPI creates a new mock instance.
+func NewMockSimpleEarnServiceAPI(ctrl *gomock.Controller) *MockSimpleEarnServiceAPI {
+	mock := &MockSimpleEarnServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockSimpleEarnServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSimpleEarnServiceAPI) EXPECT() *MockSimpleEarnServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// FlexibleService mocks base method.
+func (m *MockSimpleEarnServiceAPI) FlexibleService() binance.SimpleEarnFlexibleServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FlexibleService")
+	ret0, _ := ret[0].(binance.SimpleEarnFlexibleServiceAPI)
+	return ret0
+}
+
+// FlexibleService indicates an expected call of FlexibleService.
+func (mr *MockSimpleEarnServiceAPIMockRecorder) FlexibleService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FlexibleService", reflect.TypeOf((*MockSimpleEarnServiceAPI)(nil).FlexibleService))
+}
+
+// GetAccount mocks base method.
+func (m *MockSimpleEarnServiceAPI) GetAccount() binance.SimpleEarnGetAccountServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAccount")
+	ret0, _ := ret[0].(binance.SimpleEarnGetAccountServiceAPI)
+	return ret0
+}
+
+// GetAccount indicates an expected call of GetAccount.
+func (mr *MockSimpleEarnServiceAPIMockRecorder) GetAccount() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAccount", reflect.TypeOf((*MockSimpleEarnServiceAPI)(nil).GetAccount))
+}
+
+// LockedService mocks base method.
+func (m *MockSimpleEarnServiceAPI) LockedService() binance.SimpleEarnLockedServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LockedService")
+	ret0, _ := ret[0].(binance.SimpleEarnLockedServiceAPI)
+	return ret0
+}
+
+// LockedService indicates an expected call of LockedService.
+func (mr *MockSimpleEarnServiceAPIMockRecorder) LockedService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LockedService", reflect.TypeOf((*MockSimpleEarnServiceAPI)(nil).LockedService))
+}
+
+// MockDualInvestmentServiceAPI is a mock of DualInvestmentServiceAPI interface.
+type MockDualInvestmentServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockDualInvestmentServiceAPIMockRecorder
+}
+
+// MockDualInvestmentServiceAPIMockRecorder is the mock recorder for MockDualInvestmentServiceAPI.
+type MockDualInvestmentServiceAPIMockRecorder struct {
+	mock *MockDualInvestmentServiceAPI
+}
+
+// NewMockDualInvestmentServiceAPI creates a new mock instance.
+func NewMockDualInvestmentServiceAPI(ctrl *gomock.Controller) *MockDualInvestmentServiceAPI {
+	mock := &MockDualInvestmentServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockDualInvestmentServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDualInvestmentServiceAPI) EXPECT() *MockDualInvestmentServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// EditAutoCompoundStatusService mocks base method.
+func (m *MockDualInvestmentServiceAPI) EditAutoCompoundStatusService() binance.EditAutoCompoundStatusDualInvestmentServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EditAutoCompoundStatusService")
+	ret0, _ := ret[0].(binance.EditAutoCompoundStatusDualInvestmentServiceAPI)
+	return ret0
+}
+
+// EditAutoCompoundStatusService indicates an expected call of EditAutoCompoundStatusService.
+func (mr *MockDualInvestmentServiceAPIMockRecorder) EditAutoCompoundStatusService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EditAutoCompoundStatusService", reflect.TypeOf((*MockDualInvestmentServiceAPI)(nil).EditAutoCompoundStatusService))
+}
+
+// GetAccountService mocks base method.
+func (m *MockDualInvestmentServiceAPI) GetAccountService() binance.GetDualInvestmentAccountsServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAccountService")
+	ret0, _ := ret[0].(binance.GetDualInvestmentAccountsServiceAPI)
+	return ret0
+}
+
+// GetAccountService indicates an expected call of GetAccountService.
+func (mr *MockDualInvestmentServiceAPIMockRecorder) GetAccountService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAccountService", reflect.TypeOf((*MockDualInvestmentServiceAPI)(nil).GetAccountService))
+}
+
+// ListPositionService mocks base method.
+func (m *MockDualInvestmentServiceAPI) ListPositionService() binance.ListDualInvestmentPositionServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPositionService")
+	ret0, _ := ret[0].(binance.ListDualInvestmentPositionServiceAPI)
+	return ret0
+}
+
+// ListPositionService indicates an expected call of ListPositionService.
+func (mr *MockDualInvestmentServiceAPIMockRecorder) ListPositionService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPositionService", reflect.TypeOf((*MockDualInvestmentServiceAPI)(nil).ListPositionService))
+}
+
+// ListProductService mocks base method.
+func (m *MockDualInvestmentServiceAPI) ListProductService() binance.ListDualInvestmentProductServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListProductService")
+	ret0, _ := ret[0].(binance.ListDualInvestmentProductServiceAPI)
+	return ret0
+}
+
+// ListProductService indicates an expected call of ListProductService.
+func (mr *MockDualInvestmentServiceAPIMockRecorder) ListProductService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListProductService", reflect.TypeOf((*MockDualInvestmentServiceAPI)(nil).ListProductService))
+}
+
+// SubscribeService mocks base method.
+func (m *MockDualInvestmentServiceAPI) SubscribeService() binance.SubscribeDualInvestmentServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SubscribeService")
+	ret0, _ := ret[0].(binance.SubscribeDualInvestmentServiceAPI)
+	return ret0
+}
+
+// SubscribeService indicates an expected call of SubscribeService.
+func (mr *MockDualInvestmentServiceAPIMockRecorder) SubscribeService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubscribeService", reflect.TypeOf((*MockDualInvestmentServiceAPI)(nil).SubscribeService))
+}
+
+// MockSimpleEarnGetAccountServiceAPI is a mock of SimpleEarnGetAccountServiceAPI interface.
+type MockSimpleEarnGetAccountServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockSimpleEarnGetAccountServiceAPIMockRecorder
+}
+
+// MockSimpleEarnGetAccountServiceAPIMockRecorder is the mock recorder for MockSimpleEarnGetAccountServiceAPI.
+type MockSimpleEarnGetAccountServiceAPIMockRecorder struct {
+	mock *MockSimpleEarnGetAccountServiceAPI
+}
+
+// NewMockSimpleEarnGetAccountServiceAPI creates a new mock instance.
+func NewMockSimpleEarnGetAccountServiceAPI(ctrl *gomock.Controller) *MockSimpleEarnGetAccountServiceAPI {
+	mock := &MockSimpleEarnGetAccountServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockSimpleEarnGetAccountServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSimpleEarnGetAccountServiceAPI) EXPECT() *MockSimpleEarnGetAccountServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockSimpleEarnGetAccountServiceAPI) Do(ctx context.Context, opts ...binance.RequestOption) (*binance.SimpleEarnAccount, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*binance.SimpleEarnAccount)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockSimpleEarnGetAccountServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockSimpleEarnGetAccountServiceAPI)(nil).Do), varargs...)
+}
+
+// MockSimpleEarnFlexibleServiceAPI is a mock of SimpleEarnFlexibleServiceAPI interface.
+type MockSimpleEarnFlexibleServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockSimpleEarnFlexibleServiceAPIMockRecorder
+}
+
+// MockSimpleEarnFlexibleServiceAPIMockRecorder is the mock recorder for MockSimpleEarnFlexibleServiceAPI.
+type MockSimpleEarnFlexibleServiceAPIMockRecorder struct {
+	mock *MockSimpleEarnFlexibleServiceAPI
+}
+
+// NewMockSimpleEarnFlexibleServiceAPI creates a new mock instance.
+func NewMockSimpleEarnFlexibleServiceAPI(ctrl *gomock.Controller) *MockSimpleEarnFlexibleServiceAPI {
+	mock := &MockSimpleEarnFlexibleServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockSimpleEarnFlexibleServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSimpleEarnFlexibleServiceAPI) EXPECT() *MockSimpleEarnFlexibleServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// GetLeftQuote mocks base method.
+func (m *MockSimpleEarnFlexibleServiceAPI) GetLeftQuote() binance.SimpleEarnGetFlexibleQuotaServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLeftQuote")
+	ret0, _ := ret[0].(binance.SimpleEarnGetFlexibleQuotaServiceAPI)
+	return ret0
+}
+
+// GetLeftQuote indicates an expected call of GetLeftQuote.
+func (mr *MockSimpleEarnFlexibleServiceAPIMockRecorder) GetLeftQuote() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLeftQuote", reflect.TypeOf((*MockSimpleEarnFlexibleServiceAPI)(nil).GetLeftQuote))
+}
+
+// GetPosition mocks base method.
+func (m *MockSimpleEarnFlexibleServiceAPI) GetPosition() binance.SimpleEarnGetFlexiblePositionServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPosition")
+	ret0, _ := ret[0].(binance.SimpleEarnGetFlexiblePositionServiceAPI)
+	return ret0
+}
+
+// GetPosition indicates an expected call of GetPosition.
+func (mr *MockSimpleEarnFlexibleServiceAPIMockRecorder) GetPosition() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPosition", reflect.TypeOf((*MockSimpleEarnFlexibleServiceAPI)(nil).GetPosition))
+}
+
+// GetRewardsHistory mocks base method.
+func (m *MockSimpleEarnFlexibleServiceAPI) GetRewardsHistory() binance.SimpleEarnGetFlexibleRewardsHistoryServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRewardsHistory")
+	ret0, _ := ret[0].(binance.SimpleEarnGetFlexibleRewardsHistoryServiceAPI)
+	return ret0
+}
+
+// GetRewardsHistory indicates an expected call of GetRewardsHistory.
+func (mr *MockSimpleEarnFlexibleServiceAPIMockRecorder) GetRewardsHistory() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRewardsHistory", reflect.TypeOf((*MockSimpleEarnFlexibleServiceAPI)(nil).GetRewardsHistory))
+}
+
+// ListProduct mocks base method.
+func (m *MockSimpleEarnFlexibleServiceAPI) ListProduct() binance.SimpleEarnListFlexibleProductServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListProduct")
+	ret0, _ := ret[0].(binance.SimpleEarnListFlexibleProductServiceAPI)
+	return ret0
+}
+
+// ListProduct indicates an expected call of ListProduct.
+func (mr *MockSimpleEarnFlexibleServiceAPIMockRecorder) ListProduct() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListProduct", reflect.TypeOf((*MockSimpleEarnFlexibleServiceAPI)(nil).ListProduct))
+}
+
+// PreviewSubscribe mocks base method.
+func (m *MockSimpleEarnFlexibleServiceAPI) PreviewSubscribe() binance.SimpleEarnFlexibleSubscriptionPreviewServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PreviewSubscribe")
+	ret0, _ := ret[0].(binance.SimpleEarnFlexibleSubscriptionPreviewServiceAPI)
+	return ret0
+}
+
+// PreviewSubscribe indicates an expected call of PreviewSubscribe.
+func (mr *MockSimpleEarnFlexibleServiceAPIMockRecorder) PreviewSubscribe() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PreviewSubscribe", reflect.TypeOf((*MockSimpleEarnFlexibleServiceAPI)(nil).PreviewSubscribe))
+}
+
+// Redeem mocks base method.
+func (m *MockSimpleEarnFlexibleServiceAPI) Redeem() binance.SimpleEarnRedeemFlexibleProductServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Redeem")
+	ret0, _ := ret[0].(binance.SimpleEarnRedeemFlexibleProductServiceAPI)
+	return ret0
+}
+
+// Redeem indicates an expected call of Redeem.
+func (mr *MockSimpleEarnFlexibleServiceAPIMockRecorder) Redeem() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Redeem", reflect.TypeOf((*MockSimpleEarnFlexibleServiceAPI)(nil).Redeem))
+}
+
+// SetAutoSubscribe mocks base method.
+func (m *MockSimpleEarnFlexibleServiceAPI) SetAutoSubscribe() binance.SimpleEarnSetAutoSubscribeFlexibleProductServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetAutoSubscribe")
+	ret0, _ := ret[0].(binance.SimpleEarnSetAutoSubscribeFlexibleProductServiceAPI)
+	return ret0
+}
+
+// SetAutoSubscribe indicates an expected call of SetAutoSubscribe.
+func (mr *MockSimpleEarnFlexibleServiceAPIMockRecorder) SetAutoSubscribe() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetAutoSubscribe", reflect.TypeOf((*MockSimpleEarnFlexibleServiceAPI)(nil).SetAutoSubscribe))
+}
+
+// Subscribe mocks base method.
+func (m *MockSimpleEarnFlexibleServiceAPI) Subscribe() binance.SimpleEarnSubscribeFlexibleProductServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Subscribe")
+	ret0, _ := ret[0].(binance.SimpleEarnSubscribeFlexibleProductServiceAPI)
+	return ret0
+}
+
+// Subscribe indicates an expected call of Subscribe.
+func (mr *MockSimpleEarnFlexibleServiceAPIMockRecorder) Subscribe() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Subscribe", reflect.TypeOf((*MockSimpleEarnFlexibleServiceAPI)(nil).Subscribe))
+}
+
+// MockSimpleEarnLockedServiceAPI is a mock of SimpleEarnLockedServiceAPI interface.
+type MockSimpleEarnLockedServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockSimpleEarnLockedServiceAPIMockRecorder
+}
+
+// MockSimpleEarnLockedServiceAPIMockRecorder is the mock recorder for MockSimpleEarnLockedServiceAPI.
+type MockSimpleEarnLockedServiceAPIMockRecorder struct {
+	mock *MockSimpleEarnLockedServiceAPI
+}
+
+// NewMockSimpleEarnLockedServiceAPI creates a new mock instance.
+func NewMockSimpleEarnLockedServiceAPI(ctrl *gomock.Controller) *MockSimpleEarnLockedServiceAPI {
+	mock := &MockSimpleEarnLockedServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockSimpleEarnLockedServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSimpleEarnLockedServiceAPI) EXPECT() *MockSimpleEarnLockedServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// GetLeftQuote mocks base method.
+func (m *MockSimpleEarnLockedServiceAPI) GetLeftQuote() binance.SimpleEarnGetLockedQuotaServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLeftQuote")
+	ret0, _ := ret[0].(binance.SimpleEarnGetLockedQuotaServiceAPI)
+	return ret0
+}
+
+// GetLeftQuote indicates an expected call of GetLeftQuote.
+func (mr *MockSimpleEarnLockedServiceAPIMockRecorder) GetLeftQuote() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLeftQuote", reflect.TypeOf((*MockSimpleEarnLockedServiceAPI)(nil).GetLeftQuote))
+}
+
+// GetPosition mocks base method.
+func (m *MockSimpleEarnLockedServiceAPI) GetPosition() binance.SimpleEarnGetLockedPositionServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPosition")
+	ret0, _ := ret[0].(binance.SimpleEarnGetLockedPositionServiceAPI)
+	return ret0
+}
+
+// GetPosition indicates an expected call of GetPosition.
+func (mr *MockSimpleEarnLockedServiceAPIMockRecorder) GetPosition() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPosition", reflect.TypeOf((*MockSimpleEarnLockedServiceAPI)(nil).GetPosition))
+}
+
+// GetRewardsHistory mocks base method.
+func (m *MockSimpleEarnLockedServiceAPI) GetRewardsHistory() binance.SimpleEarnGetLockedRewardsHistoryServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRewardsHistory")
+	ret0, _ := ret[0].(binance.SimpleEarnGetLockedRewardsHistoryServiceAPI)
+	return ret0
+}
+
+// GetRewardsHistory indicates an expected call of GetRewardsHistory.
+func (mr *MockSimpleEarnLockedServiceAPIMockRecorder) GetRewardsHistory() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRewardsHistory", reflect.TypeOf((*MockSimpleEarnLockedServiceAPI)(nil).GetRewardsHistory))
+}
+
+// ListProduct mocks base method.
+func (m *MockSimpleEarnLockedServiceAPI) ListProduct() binance.SimpleEarnListLockedProductServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListProduct")
+	ret0, _ := ret[0].(binance.SimpleEarnListLockedProductServiceAPI)
+	return ret0
+}
+
+// ListProduct indicates an expected call of ListProduct.
+func (mr *MockSimpleEarnLockedServiceAPIMockRecorder) ListProduct() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListProduct", reflect.TypeOf((*MockSimpleEarnLockedServiceAPI)(nil).ListProduct))
+}
+
+// PreviewSubscribe mocks base method.
+func (m *MockSimpleEarnLockedServiceAPI) PreviewSubscribe() binance.SimpleEarnLockedSubscriptionPreviewServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PreviewSubscribe")
+	ret0, _ := ret[0].(binance.SimpleEarnLockedSubscriptionPreviewServiceAPI)
+	return ret0
+}
+
+// PreviewSubscribe indicates an expected call of PreviewSubscribe.
+func (mr *MockSimpleEarnLockedServiceAPIMockRecorder) PreviewSubscribe() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PreviewSubscribe", reflect.TypeOf((*MockSimpleEarnLockedServiceAPI)(nil).PreviewSubscribe))
+}
+
+// Redeem mocks base method.
+func (m *MockSimpleEarnLockedServiceAPI) Redeem() binance.SimpleEarnRedeemLockedProductServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Redeem")
+	ret0, _ := ret[0].(binance.SimpleEarnRedeemLockedProductServiceAPI)
+	return ret0
+}
+
+// Redeem indicates an expected call of Redeem.
+func (mr *MockSimpleEarnLockedServiceAPIMockRecorder) Redeem() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Redeem", reflect.TypeOf((*MockSimpleEarnLockedServiceAPI)(nil).Redeem))
+}
+
+// SetAutoSubscribe mocks base method.
+func (m *MockSimpleEarnLockedServiceAPI) SetAutoSubscribe() binance.SimpleEarnSetAutoSubscribeLockedProductServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetAutoSubscribe")
+	ret0, _ := ret[0].(binance.SimpleEarnSetAutoSubscribeLockedProductServiceAPI)
+	return ret0
+}
+
+// SetAutoSubscribe indicates an expected call of SetAutoSubscribe.
+func (mr *MockSimpleEarnLockedServiceAPIMockRecorder) SetAutoSubscribe() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetAutoSubscribe", reflect.TypeOf((*MockSimpleEarnLockedServiceAPI)(nil).SetAutoSubscribe))
+}
+
+// SetRedeemOption mocks base method.
+func (m *MockSimpleEarnLockedServiceAPI) SetRedeemOption() binance.SimpleEarnSetRedeemOptionServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetRedeemOption")
+	ret0, _ := ret[0].(binance.SimpleEarnSetRedeemOptionServiceAPI)
+	return ret0
+}
+
+// SetRedeemOption indicates an expected call of SetRedeemOption.
+func (mr *MockSimpleEarnLockedServiceAPIMockRecorder) SetRedeemOption() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetRedeemOption", reflect.TypeOf((*MockSimpleEarnLockedServiceAPI)(nil).SetRedeemOption))
+}
+
+// Subscribe mocks base method.
+func (m *MockSimpleEarnLockedServiceAPI) Subscribe() binance.SimpleEarnSubscribeLockedProductServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Subscribe")
+	ret0, _ := ret[0].(binance.SimpleEarnSubscribeLockedProductServiceAPI)
+	return ret0
+}
+
+// Subscribe indicates an expected call of Subscribe.
+func (mr *MockSimpleEarnLockedServiceAPIMockRecorder) Subscribe() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Subscribe", reflect.TypeOf((*MockSimpleEarnLockedServiceAPI)(nil).Subscribe))
+}
+
+// MockListDualInvestmentProductServiceAPI is a mock of ListDualInvestmentProductServiceAPI interface.
+type MockListDualInvestmentProductServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockListDualInvestmentProductServiceAPIMockRecorder
+}
+
+// MockListDualInvestmentProductServiceAPIMockRecorder is the mock recorder for MockListDualInvestmentProductServiceAPI.
+type MockListDualInvestmentProductServiceAPIMockRecorder struct {
+	mock *MockListDualInvestmentProductServiceAPI
+}
+
+// NewMockListDualInvestmentProductServiceAPI creates a new mock instance.
+func NewMockListDualInvestmentProductServiceAPI(ctrl *gomock.Controller) *MockListDualInvestmentProductServiceAPI {
+	mock := &MockListDualInvestmentProductServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockListDualInvestmentProductServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockListDualInvestmentProductServiceAPI) EXPECT() *MockListDualInvestmentProductServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockListDualInvestmentProductServiceAPI) Do(ctx context.Context, opts ...binance.RequestOption) (*binance.DualInvestmentProductListResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*binance.DualInvestmentProductListResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockListDualInvestmentProductServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockListDualInvestmentProductServiceAPI)(nil).Do), varargs...)
+}
+
+// ExercisedCoin mocks base method.
+func (m *MockListDualInvestmentProductServiceAPI) ExercisedCoin(exercisedCoin string) binance.ListDualInvestmentProductServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExercisedCoin", exercisedCoin)
+	ret0, _ := ret[0].(binance.ListDualInvestmentProductServiceAPI)
+	return ret0
+}
+
+// ExercisedCoin indicates an expected call of ExercisedCoin.
+func (mr *MockListDualInvestmentProductServiceAPIMockRecorder) ExercisedCoin(exercisedCoin interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExercisedCoin", reflect.TypeOf((*MockListDualInvestmentProductServiceAPI)(nil).ExercisedCoin), exercisedCoin)
+}
+
+// InvestCoin mocks base method.
+func (m *MockListDualInvestmentProductServiceAPI) InvestCoin(investCoin string) binance.ListDualInvestmentProductServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InvestCoin", investCoin)
+	ret0, _ := ret[0].(binance.ListDualInvestmentProductServiceAPI)
+	return ret0
+}
+
+// InvestCoin indicates an expected call of InvestCoin.
+func (mr *MockListDualInvestmentProductServiceAPIMockRecorder) InvestCoin(investCoin interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InvestCoin", reflect.TypeOf((*MockListDualInvestmentProductServiceAPI)(nil).InvestCoin), investCoin)
+}
+
+// OptionType mocks base method.
+func (m *MockListDualInvestmentProductServiceAPI) OptionType(optionType binance.DualInvestmentOptionType) binance.ListDualInvestmentProductServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OptionType", optionType)
+	ret0, _ := ret[0].(binance.ListDualInvestmentProductServiceAPI)
+	return ret0
+}
+
+// OptionType indicates an expected call of OptionType.
+func (mr *MockListDualInvestmentProductServiceAPIMockRecorder) OptionType(optionType interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OptionType", reflect.TypeOf((*MockListDualInvestmentProductServiceAPI)(nil).OptionType), optionType)
+}
+
+// PageIndex mocks base method.
+func (m *MockListDualInvestmentProductServiceAPI) PageIndex(pageIndex int) binance.ListDualInvestmentProductServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PageIndex", pageIndex)
+	ret0, _ := ret[0].(binance.ListDualInvestmentProductServiceAPI)
+	return ret0
+}
+
+// PageIndex indicates an expected call of PageIndex.
+func (mr *MockListDualInvestmentProductServiceAPIMockRecorder) PageIndex(pageIndex interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PageIndex", reflect.TypeOf((*MockListDualInvestmentProductServiceAPI)(nil).PageIndex), pageIndex)
+}
+
+// PageSize mocks base method.
+func (m *MockListDualInvestmentProductServiceAPI) PageSize(pageSize int) binance.ListDualInvestmentProductServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PageSize", pageSize)
+	ret0, _ := ret[0].(binance.ListDualInvestmentProductServiceAPI)
+	return ret0
+}
+
+// PageSize indicates an expected call of PageSize.
+func (mr *MockListDualInvestmentProductServiceAPIMockRecorder) PageSize(pageSize interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PageSize", reflect.TypeOf((*MockListDualInvestmentProductServiceAPI)(nil).PageSize), pageSize)
+}
+
+// MockListDualInvestmentPositionServiceAPI is a mock of ListDualInvestmentPositionServiceAPI interface.
+type MockListDualInvestmentPositionServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockListDualInvestmentPositionServiceAPIMockRecorder
+}
+
+// MockListDualInvestmentPositionServiceAPIMockRecorder is the mock recorder for MockListDualInvestmentPositionServiceAPI.
+type MockListDualInvestmentPositionServiceAPIMockRecorder struct {
+	mock *MockListDualInvestmentPositionServiceAPI
+}
+
+// NewMockListDualInvestmentPositionServiceAPI creates a new mock instance.
+func NewMockListDualInvestmentPositionServiceAPI(ctrl *gomock.Controller) *MockListDualInvestmentPositionServiceAPI {
+	mock := &MockListDualInvestmentPositionServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockListDualInvestmentPositionServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockListDualInvestmentPositionServiceAPI) EXPECT() *MockListDualInvestmentPositionServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockListDualInvestmentPositionServiceAPI) Do(ctx context.Context, opts ...binance.RequestOption) (*binance.ListDualInvestmentPositionResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*binance.ListDualInvestmentPositionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockListDualInvestmentPositionServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockListDualInvestmentPositionServiceAPI)(nil).Do), varargs...)
+}
+
+// PageIndex mocks base method.
+func (m *MockListDualInvestmentPositionServiceAPI) PageIndex(pageIndex int) binance.ListDualInvestmentPositionServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PageIndex", pageIndex)
+	ret0, _ := ret[0].(binance.ListDualInvestmentPositionServiceAPI)
+	return ret0
+}
+
+// PageIndex indicates an expected call of PageIndex.
+func (mr *MockListDualInvestmentPositionServiceAPIMockRecorder) PageIndex(pageIndex interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PageIndex", reflect.TypeOf((*MockListDualInvestmentPositionServiceAPI)(nil).PageIndex), pageIndex)
+}
+
+// PageSize mocks base method.
+func (m *MockListDualInvestmentPositionServiceAPI) PageSize(pageSize int) binance.ListDualInvestmentPositionServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PageSize", pageSize)
+	ret0, _ := ret[0].(binance.ListDualInvestmentPositionServiceAPI)
+	return ret0
+}
+
+// PageSize indicates an expected call of PageSize.
+func (mr *MockListDualInvestmentPositionServiceAPIMockRecorder) PageSize(pageSize interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PageSize", reflect.TypeOf((*MockListDualInvestmentPositionServiceAPI)(nil).PageSize), pageSize)
+}
+
+// Status mocks base method.
+func (m *MockListDualInvestmentPositionServiceAPI) Status(status binance.ListDualInvestmentPositionStatus) binance.ListDualInvestmentPositionServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Status", status)
+	ret0, _ := ret[0].(binance.ListDualInvestmentPositionServiceAPI)
+	return ret0
+}
+
+// Status indicates an expected call of Status.
+func (mr *MockListDualInvestmentPositionServiceAPIMockRecorder) Status(status interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Status", reflect.TypeOf((*MockListDualInvestmentPositionServiceAPI)(nil).Status), status)
+}
+
+// MockGetDualInvestmentAccountsServiceAPI is a mock of GetDualInvestmentAccountsServiceAPI interface.
+type MockGetDualInvestmentAccountsServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockGetDualInvestmentAccountsServiceAPIMockRecorder
+}
+
+// MockGetDualInvestmentAccountsServiceAPIMockRecorder is the mock recorder for MockGetDualInvestmentAccountsServiceAPI.
+type MockGetDualInvestmentAccountsServiceAPIMockRecorder struct {
+	mock *MockGetDualInvestmentAccountsServiceAPI
+}
+
+// NewMockGetDualInvestmentAccountsServiceAPI creates a new mock instance.
+func NewMockGetDualInvestmentAccountsServiceAPI(ctrl *gomock.Controller) *MockGetDualInvestmentAccountsServiceAPI {
+	mock := &MockGetDualInvestmentAccountsServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockGetDualInvestmentAccountsServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGetDualInvestmentAccountsServiceAPI) EXPECT() *MockGetDualInvestmentAccountsServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockGetDualInvestmentAccountsServiceAPI) Do(ctx context.Context, opts ...binance.RequestOption) (*binance.GetDualInvestmentAccountsResp, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*binance.GetDualInvestmentAccountsResp)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockGetDualInvestmentAccountsServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockGetDualInvestmentAccountsServiceAPI)(nil).Do), varargs...)
+}
+
+// MockSubscribeDualInvestmentServiceAPI is a mock of SubscribeDualInvestmentServiceAPI interface.
+type MockSubscribeDualInvestmentServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockSubscribeDualInvestmentServiceAPIMockRecorder
+}
+
+// MockSubscribeDualInvestmentServiceAPIMockRecorder is the mock recorder for MockSubscribeDualInvestmentServiceAPI.
+type MockSubscribeDualInvestmentServiceAPIMockRecorder struct {
+	mock *MockSubscribeDualInvestmentServiceAPI
+}
+
+// NewMockSubscribeDualInvestmentServiceAPI creates a new mock instance.
+func NewMockSubscribeDualInvestmentServiceAPI(ctrl *gomock.Controller) *MockSubscribeDualInvestmentServiceAPI {
+	mock := &MockSubscribeDualInvestmentServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockSubscribeDualInvestmentServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSubscribeDualInvestmentServiceAPI) EXPECT() *MockSubscribeDualInvestmentServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// AutoCompoundPlan mocks base method.
+func (m *MockSubscribeDualInvestmentServiceAPI) AutoCompoundPlan(autoCompoundPlan binance.DualInvestmentCompoundPlan) binance.SubscribeDualInvestmentServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AutoCompoundPlan", autoCompoundPlan)
+	ret0, _ := ret[0].(binance.SubscribeDualInvestmentServiceAPI)
+	return ret0
+}
+
+// AutoCompoundPlan indicates an expected call of AutoCompoundPlan.
+func (mr *MockSubscribeDualInvestmentServiceAPIMockRecorder) AutoCompoundPlan(autoCompoundPlan interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AutoCompoundPlan", reflect.TypeOf((*MockSubscribeDualInvestmentServiceAPI)(nil).AutoCompoundPlan), autoCompoundPlan)
+}
+
+// DepositAmount mocks base method.
+func (m *MockSubscribeDualInvestmentServiceAPI) DepositAmount(depositAmount string) binance.SubscribeDualInvestmentServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DepositAmount", depositAmount)
+	ret0, _ := ret[0].(binance.SubscribeDualInvestmentServiceAPI)
+	return ret0
+}
+
+// DepositAmount indicates an expected call of DepositAmount.
+func (mr *MockSubscribeDualInvestmentServiceAPIMockRecorder) DepositAmount(depositAmount interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DepositAmount", reflect.TypeOf((*MockSubscribeDualInvestmentServiceAPI)(nil).DepositAmount), depositAmount)
+}
+
+// Do mocks base method.
+func (m *MockSubscribeDualInvestmentServiceAPI) Do(ctx context.Context, opts ...binance.RequestOption) (*binance.SubscribeDualInvestmentResp, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*binance.SubscribeDualInvestmentResp)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockSubscribeDualInvestmentServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockSubscribeDualInvestmentServiceAPI)(nil).Do), varargs...)
+}
+
+// ID mocks base method.
+func (m *MockSubscribeDualInvestmentServiceAPI) ID(id string) binance.SubscribeDualInvestmentServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ID", id)
+	ret0, _ := ret[0].(binance.SubscribeDualInvestmentServiceAPI)
+	return ret0
+}
+
+// ID indicates an expected call of ID.
+func (mr *MockSubscribeDualInvestmentServiceAPIMockRecorder) ID(id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ID", reflect.TypeOf((*MockSubscribeDualInvestmentServiceAPI)(nil).ID), id)
+}
+
+// OrderID mocks base method.
+func (m *MockSubscribeDualInvestmentServiceAPI) OrderID(orderId int64) binance.SubscribeDualInvestmentServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OrderID", orderId)
+	ret0, _ := ret[0].(binance.SubscribeDualInvestmentServiceAPI)
+	return ret0
+}
+
+// OrderID indicates an expected call of OrderID.
+func (mr *MockSubscribeDualInvestmentServiceAPIMockRecorder) OrderID(orderId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OrderID", reflect.TypeOf((*MockSubscribeDualInvestmentServiceAPI)(nil).OrderID), orderId)
+}
+
+// MockEditAutoCompoundStatusDualInvestmentServiceAPI is a mock of EditAutoCompoundStatusDualInvestmentServiceAPI interface.
+type MockEditAutoCompoundStatusDualInvestmentServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockEditAutoCompoundStatusDualInvestmentServiceAPIMockRecorder
+}
+
+// MockEditAutoCompoundStatusDualInvestmentServiceAPIMockRecorder is the mock recorder for MockEditAutoCompoundStatusDualInvestmentServiceAPI.
+type MockEditAutoCompoundStatusDualInvestmentServiceAPIMockRecorder struct {
+	mock *MockEditAutoCompoundStatusDualInvestmentServiceAPI
+}
+
+// NewMockEditAutoCompoundStatusDualInvestmentServiceAPI creates a new mock instance.
+func NewMockEditAutoCompoundStatusDualInvestmentServiceAPI(ctrl *gomock.Controller) *MockEditAutoCompoundStatusDualInvestmentServiceAPI {
+	mock := &MockEditAutoCompoundStatusDualInvestmentServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockEditAutoCompoundStatusDualInvestmentServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockEditAutoCompoundStatusDualInvestmentServiceAPI) EXPECT() *MockEditAutoCompoundStatusDualInvestmentServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// AutoCompoundPlan mocks base method.
+func (m *MockEditAutoCompoundStatusDualInvestmentServiceAPI) AutoCompoundPlan(plan binance.DualInvestmentCompoundPlan) binance.EditAutoCompoundStatusDualInvestmentServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AutoCompoundPlan", plan)
+	ret0, _ := ret[0].(binance.EditAutoCompoundStatusDualInvestmentServiceAPI)
+	return ret0
+}
+
+// AutoCompoundPlan indicates an expected call of AutoCompoundPlan.
+func (mr *MockEditAutoCompoundStatusDualInvestmentServiceAPIMockRecorder) AutoCompoundPlan(plan interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AutoCompoundPlan", reflect.TypeOf((*MockEditAutoCompoundStatusDualInvestmentServiceAPI)(nil).AutoCompoundPlan), plan)
+}
+
+// Do mocks base method.
+func (m *MockEditAutoCompoundStatusDualInvestmentServiceAPI) Do(ctx context.Context, opts ...binance.RequestOption) (*binance.EditAutoCompoundStatusDualInvestmentResp, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*binance.EditAutoCompoundStatusDualInvestmentResp)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockEditAutoCompoundStatusDualInvestmentServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockEditAutoCompoundStatusDualInvestmentServiceAPI)(nil).Do), varargs...)
+}
+
+// PositionID mocks base method.
+func (m *MockEditAutoCompoundStatusDualInvestmentServiceAPI) PositionID(positionID string) binance.EditAutoCompoundStatusDualInvestmentServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PositionID", positionID)
+	ret0, _ := ret[0].(binance.EditAutoCompoundStatusDualInvestmentServiceAPI)
+	return ret0
+}
+
+// PositionID indicates an expected call of PositionID.
+func (mr *MockEditAutoCompoundStatusDualInvestmentServiceAPIMockRecorder) PositionID(positionID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PositionID", reflect.TypeOf((*MockEditAutoCompoundStatusDualInvestmentServiceAPI)(nil).PositionID), positionID)
+}
+
+// MockSimpleEarnListFlexibleProductServiceAPI is a mock of SimpleEarnListFlexibleProductServiceAPI interface.
+type MockSimpleEarnListFlexibleProductServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockSimpleEarnListFlexibleProductServiceAPIMockRecorder
+}
+
+// MockSimpleEarnListFlexibleProductServiceAPIMockRecorder is the mock recorder for MockSimpleEarnListFlexibleProductServiceAPI.
+type MockSimpleEarnListFlexibleProductServiceAPIMockRecorder struct {
+	mock *MockSimpleEarnListFlexibleProductServiceAPI
+}
+
+// NewMockSimpleEarnListFlexibleProductServiceAPI creates a new mock instance.
+func NewMockSimpleEarnListFlexibleProductServiceAPI(ctrl *gomock.Controller) *MockSimpleEarnListFlexibleProductServiceAPI {
+	mock := &MockSimpleEarnListFlexibleProductServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockSimpleEarnListFlexibleProductServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSimpleEarnListFlexibleProductServiceAPI) EXPECT() *MockSimpleEarnListFlexibleProductServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Asset mocks base method.
+func (m *MockSimpleEarnListFlexibleProductServiceAPI) Asset(asset string) binance.SimpleEarnListFlexibleProductServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Asset", asset)
+	ret0, _ := ret[0].(binance.SimpleEarnListFlexibleProductServiceAPI)
+	return ret0
+}
+
+// Asset indicates an expected call of Asset.
+func (mr *MockSimpleEarnListFlexibleProductServiceAPIMockRecorder) Asset(asset interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Asset", reflect.TypeOf((*MockSimpleEarnListFlexibleProductServiceAPI)(nil).Asset), asset)
+}
+
+// Current mocks base method.
+func (m *MockSimpleEarnListFlexibleProductServiceAPI) Current(current int) binance.SimpleEarnListFlexibleProductServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Current", current)
+	ret0, _ := ret[0].(binance.SimpleEarnListFlexibleProductServiceAPI)
+	return ret0
+}
+
+// Current indicates an expected call of Current.
+func (mr *MockSimpleEarnListFlexibleProductServiceAPIMockRecorder) Current(current interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Current", reflect.TypeOf((*MockSimpleEarnListFlexibleProductServiceAPI)(nil).Current), current)
+}
+
+// Do mocks base method.
+func (m *MockSimpleEarnListFlexibleProductServiceAPI) Do(ctx context.Context, opts ...binance.RequestOption) (*binance.SimpleEarnFlexibleProductResp, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*binance.SimpleEarnFlexibleProductResp)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockSimpleEarnListFlexibleProductServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockSimpleEarnListFlexibleProductServiceAPI)(nil).Do), varargs...)
+}
+
+// Size mocks base method.
+func (m *MockSimpleEarnListFlexibleProductServiceAPI) Size(size int) binance.SimpleEarnListFlexibleProductServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Size", size)
+	ret0, _ := ret[0].(binance.SimpleEarnListFlexibleProductServiceAPI)
+	return ret0
+}
+
+// Size indicates an expected call of Size.
+func (mr *MockSimpleEarnListFlexibleProductServiceAPIMockRecorder) Size(size interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Size", reflect.TypeOf((*MockSimpleEarnListFlexibleProductServiceAPI)(nil).Size), size)
+}
+
+// MockSimpleEarnGetFlexiblePositionServiceAPI is a mock of SimpleEarnGetFlexiblePositionServiceAPI interface.
+type MockSimpleEarnGetFlexiblePositionServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockSimpleEarnGetFlexiblePositionServiceAPIMockRecorder
+}
+
+// MockSimpleEarnGetFlexiblePositionServiceAPIMockRecorder is the mock recorder for MockSimpleEarnGetFlexiblePositionServiceAPI.
+type MockSimpleEarnGetFlexiblePositionServiceAPIMockRecorder struct {
+	mock *MockSimpleEarnGetFlexiblePositionServiceAPI
+}
+
+// NewMockSimpleEarnGetFlexiblePositionServiceAPI creates a new mock instance.
+func NewMockSimpleEarnGetFlexiblePositionServiceAPI(ctrl *gomock.Controller) *MockSimpleEarnGetFlexiblePositionServiceAPI {
+	mock := &MockSimpleEarnGetFlexiblePositionServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockSimpleEarnGetFlexiblePositionServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSimpleEarnGetFlexiblePositionServiceAPI) EXPECT() *MockSimpleEarnGetFlexiblePositionServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Asset mocks base method.
+func (m *MockSimpleEarnGetFlexiblePositionServiceAPI) Asset(asset string) binance.SimpleEarnGetFlexiblePositionServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Asset", asset)
+	ret0, _ := ret[0].(binance.SimpleEarnGetFlexiblePositionServiceAPI)
+	return ret0
+}
+
+// Asset indicates an expected call of Asset.
+func (mr *MockSimpleEarnGetFlexiblePositionServiceAPIMockRecorder) Asset(asset interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Asset", reflect.TypeOf((*MockSimpleEarnGetFlexiblePositionServiceAPI)(nil).Asset), asset)
+}
+
+// Current mocks base method.
+func (m *MockSimpleEarnGetFlexiblePositionServiceAPI) Current(current int) binance.SimpleEarnGetFlexiblePositionServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Current", current)
+	ret0, _ := ret[0].(binance.SimpleEarnGetFlexiblePositionServiceAPI)
+	return ret0
+}
+
+// Current indicates an expected call of Current.
+func (mr *MockSimpleEarnGetFlexiblePositionServiceAPIMockRecorder) Current(current interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Current", reflect.TypeOf((*MockSimpleEarnGetFlexiblePositionServiceAPI)(nil).Current), current)
+}
+
+// Do mocks base method.
+func (m *MockSimpleEarnGetFlexiblePositionServiceAPI) Do(ctx context.Context, opts ...binance.RequestOption) (*binance.SimpleEarnFlexiblePositionResp, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*binance.SimpleEarnFlexiblePositionResp)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockSimpleEarnGetFlexiblePositionServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockSimpleEarnGetFlexiblePositionServiceAPI)(nil).Do), varargs...)
+}
+
+// ProductId mocks base method.
+func (m *MockSimpleEarnGetFlexiblePositionServiceAPI) ProductId(productId string) binance.SimpleEarnGetFlexiblePositionServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ProductId", productId)
+	ret0, _ := ret[0].(binance.SimpleEarnGetFlexiblePositionServiceAPI)
+	return ret0
+}
+
+// ProductId indicates an expected call of ProductId.
+func (mr *MockSimpleEarnGetFlexiblePositionServiceAPIMockRecorder) ProductId(productId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ProductId", reflect.TypeOf((*MockSimpleEarnGetFlexiblePositionServiceAPI)(nil).ProductId), productId)
+}
+
+// Size mocks base method.
+func (m *MockSimpleEarnGetFlexiblePositionServiceAPI) Size(size int) binance.SimpleEarnGetFlexiblePositionServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Size", size)
+	ret0, _ := ret[0].(binance.SimpleEarnGetFlexiblePositionServiceAPI)
+	return ret0
+}
+
+// Size indicates an expected call of Size.
+func (mr *MockSimpleEarnGetFlexiblePositionServiceAPIMockRecorder) Size(size interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Size", reflect.TypeOf((*MockSimpleEarnGetFlexiblePositionServiceAPI)(nil).Size), size)
+}
+
+// MockSimpleEarnGetFlexibleQuotaServiceAPI is a mock of SimpleEarnGetFlexibleQuotaServiceAPI interface.
+type MockSimpleEarnGetFlexibleQuotaServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockSimpleEarnGetFlexibleQuotaServiceAPIMockRecorder
+}
+
+// MockSimpleEarnGetFlexibleQuotaServiceAPIMockRecorder is the mock recorder for MockSimpleEarnGetFlexibleQuotaServiceAPI.
+type MockSimpleEarnGetFlexibleQuotaServiceAPIMockRecorder struct {
+	mock *MockSimpleEarnGetFlexibleQuotaServiceAPI
+}
+
+// NewMockSimpleEarnGetFlexibleQuotaServiceAPI creates a new mock instance.
+func NewMockSimpleEarnGetFlexibleQuotaServiceAPI(ctrl *gomock.Controller) *MockSimpleEarnGetFlexibleQuotaServiceAPI {
+	mock := &MockSimpleEarnGetFlexibleQuotaServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockSimpleEarnGetFlexibleQuotaServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSimpleEarnGetFlexibleQuotaServiceAPI) EXPECT() *MockSimpleEarnGetFlexibleQuotaServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockSimpleEarnGetFlexibleQuotaServiceAPI) Do(ctx context.Context, opts ...binance.RequestOption) (*binance.SimpleEarnFlexiblePersonalLeftQuotaResp, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*binance.SimpleEarnFlexiblePersonalLeftQuotaResp)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockSimpleEarnGetFlexibleQuotaServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockSimpleEarnGetFlexibleQuotaServiceAPI)(nil).Do), varargs...)
+}
+
+// ProductId mocks base method.
+func (m *MockSimpleEarnGetFlexibleQuotaServiceAPI) ProductId(productId string) binance.SimpleEarnGetFlexibleQuotaServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ProductId", productId)
+	ret0, _ := ret[0].(binance.SimpleEarnGetFlexibleQuotaServiceAPI)
+	return ret0
+}
+
+// ProductId indicates an expected call of ProductId.
+func (mr *MockSimpleEarnGetFlexibleQuotaServiceAPIMockRecorder) ProductId(productId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ProductId", reflect.TypeOf((*MockSimpleEarnGetFlexibleQuotaServiceAPI)(nil).ProductId), productId)
+}
+
+// MockSimpleEarnSubscribeFlexibleProductServiceAPI is a mock of SimpleEarnSubscribeFlexibleProductServiceAPI interface.
+type MockSimpleEarnSubscribeFlexibleProductServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockSimpleEarnSubscribeFlexibleProductServiceAPIMockRecorder
+}
+
+// MockSimpleEarnSubscribeFlexibleProductServiceAPIMockRecorder is the mock recorder for MockSimpleEarnSubscribeFlexibleProductServiceAPI.
+type MockSimpleEarnSubscribeFlexibleProductServiceAPIMockRecorder struct {
+	mock *MockSimpleEarnSubscribeFlexibleProductServiceAPI
+}
+
+// NewMockSimpleEarnSubscribeFlexibleProductServiceAPI creates a new mock instance.
+func NewMockSimpleEarnSubscribeFlexibleProductServiceAPI(ctrl *gomock.Controller) *MockSimpleEarnSubscribeFlexibleProductServiceAPI {
+	mock := &MockSimpleEarnSubscribeFlexibleProductServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockSimpleEarnSubscribeFlexibleProductServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSimpleEarnSubscribeFlexibleProductServiceAPI) EXPECT() *MockSimpleEarnSubscribeFlexibleProductServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Amount mocks base method.
+func (m *MockSimpleEarnSubscribeFlexibleProductServiceAPI) Amount(amount string) binance.SimpleEarnSubscribeFlexibleProductServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Amount", amount)
+	ret0, _ := ret[0].(binance.SimpleEarnSubscribeFlexibleProductServiceAPI)
+	return ret0
+}
+
+// Amount indicates an expected call of Amount.
+func (mr *MockSimpleEarnSubscribeFlexibleProductServiceAPIMockRecorder) Amount(amount interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Amount", reflect.TypeOf((*MockSimpleEarnSubscribeFlexibleProductServiceAPI)(nil).Amount), amount)
+}
+
+// AutoSubscribe mocks base method.
+func (m *MockSimpleEarnSubscribeFlexibleProductServiceAPI) AutoSubscribe(autoSubscribe bool) binance.SimpleEarnSubscribeFlexibleProductServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AutoSubscribe", autoSubscribe)
+	ret0, _ := ret[0].(binance.SimpleEarnSubscribeFlexibleProductServiceAPI)
+	return ret0
+}
+
+// AutoSubscribe indicates an expected call of AutoSubscribe.
+func (mr *MockSimpleEarnSubscribeFlexibleProductServiceAPIMockRecorder) AutoSubscribe(autoSubscribe interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AutoSubscribe", reflect.TypeOf((*MockSimpleEarnSubscribeFlexibleProductServiceAPI)(nil).AutoSubscribe), autoSubscribe)
+}
+
+// Do mocks base method.
+func (m *MockSimpleEarnSubscribeFlexibleProductServiceAPI) Do(ctx context.Context, opts ...binance.RequestOption) (*binance.SimpleEarnSubscribeFlexibleProductResp, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*binance.SimpleEarnSubscribeFlexibleProductResp)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockSimpleEarnSubscribeFlexibleProductServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockSimpleEarnSubscribeFlexibleProductServiceAPI)(nil).Do), varargs...)
+}
+
+// ProductId mocks base method.
+func (m *MockSimpleEarnSubscribeFlexibleProductServiceAPI) ProductId(productId string) binance.SimpleEarnSubscribeFlexibleProductServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ProductId", productId)
+	ret0, _ := ret[0].(binance.SimpleEarnSubscribeFlexibleProductServiceAPI)
+	return ret0
+}
+
+// ProductId indicates an expected call of ProductId.
+func (mr *MockSimpleEarnSubscribeFlexibleProductServiceAPIMockRecorder) ProductId(productId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ProductId", reflect.TypeOf((*MockSimpleEarnSubscribeFlexibleProductServiceAPI)(nil).ProductId), productId)
+}
+
+// SourceAccount mocks base method.
+func (m *MockSimpleEarnSubscribeFlexibleProductServiceAPI) SourceAccount(sourceAccount binance.SimpleEarnSubscribeSourceAccount) binance.SimpleEarnSubscribeFlexibleProductServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SourceAccount", sourceAccount)
+	ret0, _ := ret[0].(binance.SimpleEarnSubscribeFlexibleProductServiceAPI)
+	return ret0
+}
+
+// SourceAccount indicates an expected call of SourceAccount.
+func (mr *MockSimpleEarnSubscribeFlexibleProductServiceAPIMockRecorder) SourceAccount(sourceAccount interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SourceAccount", reflect.TypeOf((*MockSimpleEarnSubscribeFlexibleProductServiceAPI)(nil).SourceAccount), sourceAccount)
+}
+
+// MockSimpleEarnRedeemFlexibleProductServiceAPI is a mock of SimpleEarnRedeemFlexibleProductServiceAPI interface.
+type MockSimpleEarnRedeemFlexibleProductServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockSimpleEarnRedeemFlexibleProductServiceAPIMockRecorder
+}
+
+// MockSimpleEarnRedeemFlexibleProductServiceAPIMockRecorder is the mock recorder for MockSimpleEarnRedeemFlexibleProductServiceAPI.
+type MockSimpleEarnRedeemFlexibleProductServiceAPIMockRecorder struct {
+	mock *MockSimpleEarnRedeemFlexibleProductServiceAPI
+}
+
+// NewMockSimpleEarnRedeemFlexibleProductServiceAPI creates a new mock instance.
+func NewMockSimpleEarnRedeemFlexibleProductServiceAPI(ctrl *gomock.Controller) *MockSimpleEarnRedeemFlexibleProductServiceAPI {
+	mock := &MockSimpleEarnRedeemFlexibleProductServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockSimpleEarnRedeemFlexibleProductServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSimpleEarnRedeemFlexibleProductServiceAPI) EXPECT() *MockSimpleEarnRedeemFlexibleProductServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Amount mocks base method.
+func (m *MockSimpleEarnRedeemFlexibleProductServiceAPI) Amount(amount string) binance.SimpleEarnRedeemFlexibleProductServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Amount", amount)
+	ret0, _ := ret[0].(binance.SimpleEarnRedeemFlexibleProductServiceAPI)
+	return ret0
+}
+
+// Amount indicates an expected call of Amount.
+func (mr *MockSimpleEarnRedeemFlexibleProductServiceAPIMockRecorder) Amount(amount interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Amount", reflect.TypeOf((*MockSimpleEarnRedeemFlexibleProductServiceAPI)(nil).Amount), amount)
+}
+
+// DestAccount mocks base method.
+func (m *MockSimpleEarnRedeemFlexibleProductServiceAPI) DestAccount(destAccount string) binance.SimpleEarnRedeemFlexibleProductServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DestAccount", destAccount)
+	ret0, _ := ret[0].(binance.SimpleEarnRedeemFlexibleProductServiceAPI)
+	return ret0
+}
+
+// DestAccount indicates an expected call of DestAccount.
+func (mr *MockSimpleEarnRedeemFlexibleProductServiceAPIMockRecorder) DestAccount(destAccount interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DestAccount", reflect.TypeOf((*MockSimpleEarnRedeemFlexibleProductServiceAPI)(nil).DestAccount), destAccount)
+}
+
+// Do mocks base method.
+func (m *MockSimpleEarnRedeemFlexibleProductServiceAPI) Do(ctx context.Context, opts ...binance.RequestOption) (*binance.SimpleEarnRedeemFlexibleProductResp, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*binance.SimpleEarnRedeemFlexibleProductResp)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockSimpleEarnRedeemFlexibleProductServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockSimpleEarnRedeemFlexibleProductServiceAPI)(nil).Do), varargs...)
+}
+
+// ProductId mocks base method.
+func (m *MockSimpleEarnRedeemFlexibleProductServiceAPI) ProductId(productId string) binance.SimpleEarnRedeemFlexibleProductServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ProductId", productId)
+	ret0, _ := ret[0].(binance.SimpleEarnRedeemFlexibleProductServiceAPI)
+	return ret0
+}
+
+// ProductId indicates an expected call of ProductId.
+func (mr *MockSimpleEarnRedeemFlexibleProductServiceAPIMockRecorder) ProductId(productId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ProductId", reflect.TypeOf((*MockSimpleEarnRedeemFlexibleProductServiceAPI)(nil).ProductId), productId)
+}
+
+// RedeemAll mocks base method.
+func (m *MockSimpleEarnRedeemFlexibleProductServiceAPI) RedeemAll(redeemAll bool) binance.SimpleEarnRedeemFlexibleProductServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RedeemAll", redeemAll)
+	ret0, _ := ret[0].(binance.SimpleEarnRedeemFlexibleProductServiceAPI)
+	return ret0
+}
+
+// RedeemAll indicates an expected call of RedeemAll.
+func (mr *MockSimpleEarnRedeemFlexibleProductServiceAPIMockRecorder) RedeemAll(redeemAll interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RedeemAll", reflect.TypeOf((*MockSimpleEarnRedeemFlexibleProductServiceAPI)(nil).RedeemAll), redeemAll)
+}
+
+// MockSimpleEarnSetAutoSubscribeFlexibleProductServiceAPI is a mock of SimpleEarnSetAutoSubscribeFlexibleProductServiceAPI interface.
+type MockSimpleEarnSetAutoSubscribeFlexibleProductServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockSimpleEarnSetAutoSubscribeFlexibleProductServiceAPIMockRecorder
+}
+
+// MockSimpleEarnSetAutoSubscribeFlexibleProductServiceAPIMockRecorder is the mock recorder for MockSimpleEarnSetAutoSubscribeFlexibleProductServiceAPI.
+type MockSimpleEarnSetAutoSubscribeFlexibleProductServiceAPIMockRecorder struct {
+	mock *MockSimpleEarnSetAutoSubscribeFlexibleProductServiceAPI
+}
+
+// NewMockSimpleEarnSetAutoSubscribeFlexibleProductServiceAPI creates a new mock instance.
+func NewMockSimpleEarnSetAutoSubscribeFlexibleProductServiceAPI(ctrl *gomock.Controller) *MockSimpleEarnSetAutoSubscribeFlexibleProductServiceAPI {
+	mock := &MockSimpleEarnSetAutoSubscribeFlexibleProductServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockSimpleEarnSetAutoSubscribeFlexibleProductServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSimpleEarnSetAutoSubscribeFlexibleProductServiceAPI) EXPECT() *MockSimpleEarnSetAutoSubscribeFlexibleProductServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// AutoSubscribe mocks base method.
+func (m *MockSimpleEarnSetAutoSubscribeFlexibleProductServiceAPI) AutoSubscribe(autoSubscribe bool) binance.SimpleEarnSetAutoSubscribeFlexibleProductServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AutoSubscribe", autoSubscribe)
+	ret0, _ := ret[0].(binance.SimpleEarnSetAutoSubscribeFlexibleProductServiceAPI)
+	return ret0
+}
+
+// AutoSubscribe indicates an expected call of AutoSubscribe.
+func (mr *MockSimpleEarnSetAutoSubscribeFlexibleProductServiceAPIMockRecorder) AutoSubscribe(autoSubscribe interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AutoSubscribe", reflect.TypeOf((*MockSimpleEarnSetAutoSubscribeFlexibleProductServiceAPI)(nil).AutoSubscribe), autoSubscribe)
+}
+
+// Do mocks base method.
+func (m *MockSimpleEarnSetAutoSubscribeFlexibleProductServiceAPI) Do(ctx context.Context, opts ...binance.RequestOption) (*binance.SimpleEarnSetAutoSubscribeFlexibleProductResp, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*binance.SimpleEarnSetAutoSubscribeFlexibleProductResp)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockSimpleEarnSetAutoSubscribeFlexibleProductServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockSimpleEarnSetAutoSubscribeFlexibleProductServiceAPI)(nil).Do), varargs...)
+}
+
+// ProductId mocks base method.
+func (m *MockSimpleEarnSetAutoSubscribeFlexibleProductServiceAPI) ProductId(productId string) binance.SimpleEarnSetAutoSubscribeFlexibleProductServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ProductId", productId)
+	ret0, _ := ret[0].(binance.SimpleEarnSetAutoSubscribeFlexibleProductServiceAPI)
+	return ret0
+}
+
+// ProductId indicates an expected call of ProductId.
+func (mr *MockSimpleEarnSetAutoSubscribeFlexibleProductServiceAPIMockRecorder) ProductId(productId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ProductId", reflect.TypeOf((*MockSimpleEarnSetAutoSubscribeFlexibleProductServiceAPI)(nil).ProductId), productId)
+}
+
+// MockSimpleEarnFlexibleSubscriptionPreviewServiceAPI is a mock of SimpleEarnFlexibleSubscriptionPreviewServiceAPI interface.
+type MockSimpleEarnFlexibleSubscriptionPreviewServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockSimpleEarnFlexibleSubscriptionPreviewServiceAPIMockRecorder
+}
+
+// MockSimpleEarnFlexibleSubscriptionPreviewServiceAPIMockRecorder is the mock recorder for MockSimpleEarnFlexibleSubscriptionPreviewServiceAPI.
+type MockSimpleEarnFlexibleSubscriptionPreviewServiceAPIMockRecorder struct {
+	mock *MockSimpleEarnFlexibleSubscriptionPreviewServiceAPI
+}
+
+// NewMockSimpleEarnFlexibleSubscriptionPreviewServiceAPI creates a new mock instance.
+func NewMockSimpleEarnFlexibleSubscriptionPreviewServiceAPI(ctrl *gomock.Controller) *MockSimpleEarnFlexibleSubscriptionPreviewServiceAPI {
+	mock := &MockSimpleEarnFlexibleSubscriptionPreviewServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockSimpleEarnFlexibleSubscriptionPreviewServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSimpleEarnFlexibleSubscriptionPreviewServiceAPI) EXPECT() *MockSimpleEarnFlexibleSubscriptionPreviewServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Amount mocks base method.
+func (m *MockSimpleEarnFlexibleSubscriptionPreviewServiceAPI) Amount(amount string) binance.SimpleEarnFlexibleSubscriptionPreviewServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Amount", amount)
+	ret0, _ := ret[0].(binance.SimpleEarnFlexibleSubscriptionPreviewServiceAPI)
+	return ret0
+}
+
+// Amount indicates an expected call of Amount.
+func (mr *MockSimpleEarnFlexibleSubscriptionPreviewServiceAPIMockRecorder) Amount(amount interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Amount", reflect.TypeOf((*MockSimpleEarnFlexibleSubscriptionPreviewServiceAPI)(nil).Amount), amount)
+}
+
+// Do mocks base method.
+func (m *MockSimpleEarnFlexibleSubscriptionPreviewServiceAPI) Do(ctx context.Context, opts ...binance.RequestOption) (*binance.SimpleEarnFlexibleSubscriptionPreviewResp, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*binance.SimpleEarnFlexibleSubscriptionPreviewResp)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockSimpleEarnFlexibleSubscriptionPreviewServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockSimpleEarnFlexibleSubscriptionPreviewServiceAPI)(nil).Do), varargs...)
+}
+
+// ProductId mocks base method.
+func (m *MockSimpleEarnFlexibleSubscriptionPreviewServiceAPI) ProductId(productId string) binance.SimpleEarnFlexibleSubscriptionPreviewServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ProductId", productId)
+	ret0, _ := ret[0].(binance.SimpleEarnFlexibleSubscriptionPreviewServiceAPI)
+	return ret0
+}
+
+// ProductId indicates an expected call of ProductId.
+func (mr *MockSimpleEarnFlexibleSubscriptionPreviewServiceAPIMockRecorder) ProductId(productId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ProductId", reflect.TypeOf((*MockSimpleEarnFlexibleSubscriptionPreviewServiceAPI)(nil).ProductId), productId)
+}
+
+// MockSimpleEarnGetFlexibleRewardsHistoryServiceAPI is a mock of SimpleEarnGetFlexibleRewardsHistoryServiceAPI interface.
+type MockSimpleEarnGetFlexibleRewardsHistoryServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockSimpleEarnGetFlexibleRewardsHistoryServiceAPIMockRecorder
+}
+
+// MockSimpleEarnGetFlexibleRewardsHistoryServiceAPIMockRecorder is the mock recorder for MockSimpleEarnGetFlexibleRewardsHistoryServiceAPI.
+type MockSimpleEarnGetFlexibleRewardsHistoryServiceAPIMockRecorder struct {
+	mock *MockSimpleEarnGetFlexibleRewardsHistoryServiceAPI
+}
+
+// NewMockSimpleEarnGetFlexibleRewardsHistoryServiceAPI creates a new mock instance.
+func NewMockSimpleEarnGetFlexibleRewardsHistoryServiceAPI(ctrl *gomock.Controller) *MockSimpleEarnGetFlexibleRewardsHistoryServiceAPI {
+	mock := &MockSimpleEarnGetFlexibleRewardsHistoryServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockSimpleEarnGetFlexibleRewardsHistoryServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSimpleEarnGetFlexibleRewardsHistoryServiceAPI) EXPECT() *MockSimpleEarnGetFlexibleRewardsHistoryServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Asset mocks base method.
+func (m *MockSimpleEarnGetFlexibleRewardsHistoryServiceAPI) Asset(asset string) binance.SimpleEarnGetFlexibleRewardsHistoryServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Asset", asset)
+	ret0, _ := ret[0].(binance.SimpleEarnGetFlexibleRewardsHistoryServiceAPI)
+	return ret0
+}
+
+// Asset indicates an expected call of Asset.
+func (mr *MockSimpleEarnGetFlexibleRewardsHistoryServiceAPIMockRecorder) Asset(asset interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Asset", reflect.TypeOf((*MockSimpleEarnGetFlexibleRewardsHistoryServiceAPI)(nil).Asset), asset)
+}
+
+// Current mocks base method.
+func (m *MockSimpleEarnGetFlexibleRewardsHistoryServiceAPI) Current(current int) binance.SimpleEarnGetFlexibleRewardsHistoryServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Current", current)
+	ret0, _ := ret[0].(binance.SimpleEarnGetFlexibleRewardsHistoryServiceAPI)
+	return ret0
+}
+
+// Current indicates an expected call of Current.
+func (mr *MockSimpleEarnGetFlexibleRewardsHistoryServiceAPIMockRecorder) Current(current interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Current", reflect.TypeOf((*MockSimpleEarnGetFlexibleRewardsHistoryServiceAPI)(nil).Current), current)
+}
+
+// Do mocks base method.
+func (m *MockSimpleEarnGetFlexibleRewardsHistoryServiceAPI) Do(ctx context.Context, opts ...binance.RequestOption) (*binance.SimpleEarnFlexibleRewardsHistoryResp, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*binance.SimpleEarnFlexibleRewardsHistoryResp)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockSimpleEarnGetFlexibleRewardsHistoryServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockSimpleEarnGetFlexibleRewardsHistoryServiceAPI)(nil).Do), varargs...)
+}
+
+// EndTime mocks base method.
+func (m *MockSimpleEarnGetFlexibleRewardsHistoryServiceAPI) EndTime(endTime int64) binance.SimpleEarnGetFlexibleRewardsHistoryServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EndTime", endTime)
+	ret0, _ := ret[0].(binance.SimpleEarnGetFlexibleRewardsHistoryServiceAPI)
+	return ret0
+}
+
+// EndTime indicates an expected call of EndTime.
+func (mr *MockSimpleEarnGetFlexibleRewardsHistoryServiceAPIMockRecorder) EndTime(endTime interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EndTime", reflect.TypeOf((*MockSimpleEarnGetFlexibleRewardsHistoryServiceAPI)(nil).EndTime), endTime)
+}
+
+// ProductId mocks base method.
+func (m *MockSimpleEarnGetFlexibleRewardsHistoryServiceAPI) ProductId(productId string) binance.SimpleEarnGetFlexibleRewardsHistoryServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ProductId", productId)
+	ret0, _ := ret[0].(binance.SimpleEarnGetFlexibleRewardsHistoryServiceAPI)
+	return ret0
+}
+
+// ProductId indicates an expected call of ProductId.
+func (mr *MockSimpleEarnGetFlexibleRewardsHistoryServiceAPIMockRecorder) ProductId(productId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ProductId", reflect.TypeOf((*MockSimpleEarnGetFlexibleRewardsHistoryServiceAPI)(nil).ProductId), productId)
+}
+
+// Size mocks base method.
+func (m *MockSimpleEarnGetFlexibleRewardsHistoryServiceAPI) Size(size int) binance.SimpleEarnGetFlexibleRewardsHistoryServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Size", size)
+	ret0, _ := ret[0].(binance.SimpleEarnGetFlexibleRewardsHistoryServiceAPI)
+	return ret0
+}
+
+// Size indicates an expected call of Size.
+func (mr *MockSimpleEarnGetFlexibleRewardsHistoryServiceAPIMockRecorder) Size(size interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Size", reflect.TypeOf((*MockSimpleEarnGetFlexibleRewardsHistoryServiceAPI)(nil).Size), size)
+}
+
+// StartTime mocks base method.
+func (m *MockSimpleEarnGetFlexibleRewardsHistoryServiceAPI) StartTime(startTime int64) binance.SimpleEarnGetFlexibleRewardsHistoryServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartTime", startTime)
+	ret0, _ := ret[0].(binance.SimpleEarnGetFlexibleRewardsHistoryServiceAPI)
+	return ret0
+}
+
+// StartTime indicates an expected call of StartTime.
+func (mr *MockSimpleEarnGetFlexibleRewardsHistoryServiceAPIMockRecorder) StartTime(startTime interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartTime", reflect.TypeOf((*MockSimpleEarnGetFlexibleRewardsHistoryServiceAPI)(nil).StartTime), startTime)
+}
+
+// Type mocks base method.
+func (m *MockSimpleEarnGetFlexibleRewardsHistoryServiceAPI) Type(rewardType binance.SimpleEarnFlexibleRewardType) binance.SimpleEarnGetFlexibleRewardsHistoryServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Type", rewardType)
+	ret0, _ := ret[0].(binance.SimpleEarnGetFlexibleRewardsHistoryServiceAPI)
+	return ret0
+}
+
+// Type indicates an expected call of Type.
+func (mr *MockSimpleEarnGetFlexibleRewardsHistoryServiceAPIMockRecorder) Type(rewardType interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Type", reflect.TypeOf((*MockSimpleEarnGetFlexibleRewardsHistoryServiceAPI)(nil).Type), rewardType)
+}
+
+// MockSimpleEarnListLockedProductServiceAPI is a mock of SimpleEarnListLockedProductServiceAPI interface.
+type MockSimpleEarnListLockedProductServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockSimpleEarnListLockedProductServiceAPIMockRecorder
+}
+
+// MockSimpleEarnListLockedProductServiceAPIMockRecorder is the mock recorder for MockSimpleEarnListLockedProductServiceAPI.
+type MockSimpleEarnListLockedProductServiceAPIMockRecorder struct {
+	mock *MockSimpleEarnListLockedProductServiceAPI
+}
+
+// NewMockSimpleEarnListLockedProductServiceAPI creates a new mock instance.
+func NewMockSimpleEarnListLockedProductServiceAPI(ctrl *gomock.Controller) *MockSimpleEarnListLockedProductServiceAPI {
+	mock := &MockSimpleEarnListLockedProductServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockSimpleEarnListLockedProductServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSimpleEarnListLockedProductServiceAPI) EXPECT() *MockSimpleEarnListLockedProductServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Asset mocks base method.
+func (m *MockSimpleEarnListLockedProductServiceAPI) Asset(asset string) binance.SimpleEarnListLockedProductServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Asset", asset)
+	ret0, _ := ret[0].(binance.SimpleEarnListLockedProductServiceAPI)
+	return ret0
+}
+
+// Asset indicates an expected call of Asset.
+func (mr *MockSimpleEarnListLockedProductServiceAPIMockRecorder) Asset(asset interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Asset", reflect.TypeOf((*MockSimpleEarnListLockedProductServiceAPI)(nil).Asset), asset)
+}
+
+// Current mocks base method.
+func (m *MockSimpleEarnListLockedProductServiceAPI) Current(current int) binance.SimpleEarnListLockedProductServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Current", current)
+	ret0, _ := ret[0].(binance.SimpleEarnListLockedProductServiceAPI)
+	return ret0
+}
+
+// Current indicates an expected call of Current.
+func (mr *MockSimpleEarnListLockedProductServiceAPIMockRecorder) Current(current interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Current", reflect.TypeOf((*MockSimpleEarnListLockedProductServiceAPI)(nil).Current), current)
+}
+
+// Do mocks base method.
+func (m *MockSimpleEarnListLockedProductServiceAPI) Do(ctx context.Context, opts ...binance.RequestOption) (*binance.SimpleEarnLockedProductResp, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*binance.SimpleEarnLockedProductResp)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockSimpleEarnListLockedProductServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockSimpleEarnListLockedProductServiceAPI)(nil).Do), varargs...)
+}
+
+// Size mocks base method.
+func (m *MockSimpleEarnListLockedProductServiceAPI) Size(size int) binance.SimpleEarnListLockedProductServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Size", size)
+	ret0, _ := ret[0].(binance.SimpleEarnListLockedProductServiceAPI)
+	return ret0
+}
+
+// Size indicates an expected call of Size.
+func (mr *MockSimpleEarnListLockedProductServiceAPIMockRecorder) Size(size interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Size", reflect.TypeOf((*MockSimpleEarnListLockedProductServiceAPI)(nil).Size), size)
+}
+
+// MockSimpleEarnGetLockedPositionServiceAPI is a mock of SimpleEarnGetLockedPositionServiceAPI interface.
+type MockSimpleEarnGetLockedPositionServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockSimpleEarnGetLockedPositionServiceAPIMockRecorder
+}
+
+// MockSimpleEarnGetLockedPositionServiceAPIMockRecorder is the mock recorder for MockSimpleEarnGetLockedPositionServiceAPI.
+type MockSimpleEarnGetLockedPositionServiceAPIMockRecorder struct {
+	mock *MockSimpleEarnGetLockedPositionServiceAPI
+}
+
+// NewMockSimpleEarnGetLockedPositionServiceAPI creates a new mock instance.
+func NewMockSimpleEarnGetLockedPositionServiceAPI(ctrl *gomock.Controller) *MockSimpleEarnGetLockedPositionServiceAPI {
+	mock := &MockSimpleEarnGetLockedPositionServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockSimpleEarnGetLockedPositionServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSimpleEarnGetLockedPositionServiceAPI) EXPECT() *MockSimpleEarnGetLockedPositionServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Asset mocks base method.
+func (m *MockSimpleEarnGetLockedPositionServiceAPI) Asset(asset string) binance.SimpleEarnGetLockedPositionServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Asset", asset)
+	ret0, _ := ret[0].(binance.SimpleEarnGetLockedPositionServiceAPI)
+	return ret0
+}
+
+// Asset indicates an expected call of Asset.
+func (mr *MockSimpleEarnGetLockedPositionServiceAPIMockRecorder) Asset(asset interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Asset", reflect.TypeOf((*MockSimpleEarnGetLockedPositionServiceAPI)(nil).Asset), asset)
+}
+
+// Current mocks base method.
+func (m *MockSimpleEarnGetLockedPositionServiceAPI) Current(current int64) binance.SimpleEarnGetLockedPositionServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Current", current)
+	ret0, _ := ret[0].(binance.SimpleEarnGetLockedPositionServiceAPI)
+	return ret0
+}
+
+// Current indicates an expected call of Current.
+func (mr *MockSimpleEarnGetLockedPositionServiceAPIMockRecorder) Current(current interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Current", reflect.TypeOf((*MockSimpleEarnGetLockedPositionServiceAPI)(nil).Current), current)
+}
+
+// Do mocks base method.
+func (m *MockSimpleEarnGetLockedPositionServiceAPI) Do(ctx context.Context, opts ...binance.RequestOption) (*binance.SimpleEarnLockedPositionResp, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*binance.SimpleEarnLockedPositionResp)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockSimpleEarnGetLockedPositionServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockSimpleEarnGetLockedPositionServiceAPI)(nil).Do), varargs...)
+}
+
+// PositionId mocks base method.
+func (m *MockSimpleEarnGetLockedPositionServiceAPI) PositionId(positionId int) binance.SimpleEarnGetLockedPositionServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PositionId", positionId)
+	ret0, _ := ret[0].(binance.SimpleEarnGetLockedPositionServiceAPI)
+	return ret0
+}
+
+// PositionId indicates an expected call of PositionId.
+func (mr *MockSimpleEarnGetLockedPositionServiceAPIMockRecorder) PositionId(positionId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PositionId", reflect.TypeOf((*MockSimpleEarnGetLockedPositionServiceAPI)(nil).PositionId), positionId)
+}
+
+// ProjectId mocks base method.
+func (m *MockSimpleEarnGetLockedPositionServiceAPI) ProjectId(projectId string) binance.SimpleEarnGetLockedPositionServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ProjectId", projectId)
+	ret0, _ := ret[0].(binance.SimpleEarnGetLockedPositionServiceAPI)
+	return ret0
+}
+
+// ProjectId indicates an expected call of ProjectId.
+func (mr *MockSimpleEarnGetLockedPositionServiceAPIMockRecorder) ProjectId(projectId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ProjectId", reflect.TypeOf((*MockSimpleEarnGetLockedPositionServiceAPI)(nil).ProjectId), projectId)
+}
+
+// Size mocks base method.
+func (m *MockSimpleEarnGetLockedPositionServiceAPI) Size(size int64) binance.SimpleEarnGetLockedPositionServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Size", size)
+	ret0, _ := ret[0].(binance.SimpleEarnGetLockedPositionServiceAPI)
+	return ret0
+}
+
+// Size indicates an expected call of Size.
+func (mr *MockSimpleEarnGetLockedPositionServiceAPIMockRecorder) Size(size interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Size", reflect.TypeOf((*MockSimpleEarnGetLockedPositionServiceAPI)(nil).Size), size)
+}
+
+// MockSimpleEarnGetLockedQuotaServiceAPI is a mock of SimpleEarnGetLockedQuotaServiceAPI interface.
+type MockSimpleEarnGetLockedQuotaServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockSimpleEarnGetLockedQuotaServiceAPIMockRecorder
+}
+
+// MockSimpleEarnGetLockedQuotaServiceAPIMockRecorder is the mock recorder for MockSimpleEarnGetLockedQuotaServiceAPI.
+type MockSimpleEarnGetLockedQuotaServiceAPIMockRecorder struct {
+	mock *MockSimpleEarnGetLockedQuotaServiceAPI
+}
+
+// NewMockSimpleEarnGetLockedQuotaServiceAPI creates a new mock instance.
+func NewMockSimpleEarnGetLockedQuotaServiceAPI(ctrl *gomock.Controller) *MockSimpleEarnGetLockedQuotaServiceAPI {
+	mock := &MockSimpleEarnGetLockedQuotaServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockSimpleEarnGetLockedQuotaServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSimpleEarnGetLockedQuotaServiceAPI) EXPECT() *MockSimpleEarnGetLockedQuotaServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockSimpleEarnGetLockedQuotaServiceAPI) Do(ctx context.Context, opts ...binance.RequestOption) (*binance.SimpleEarnLockedPersonalLeftQuotaResp, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*binance.SimpleEarnLockedPersonalLeftQuotaResp)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockSimpleEarnGetLockedQuotaServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockSimpleEarnGetLockedQuotaServiceAPI)(nil).Do), varargs...)
+}
+
+// ProjectId mocks base method.
+func (m *MockSimpleEarnGetLockedQuotaServiceAPI) ProjectId(projectId string) binance.SimpleEarnGetLockedQuotaServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ProjectId", projectId)
+	ret0, _ := ret[0].(binance.SimpleEarnGetLockedQuotaServiceAPI)
+	return ret0
+}
+
+// ProjectId indicates an expected call of ProjectId.
+func (mr *MockSimpleEarnGetLockedQuotaServiceAPIMockRecorder) ProjectId(projectId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ProjectId", reflect.TypeOf((*MockSimpleEarnGetLockedQuotaServiceAPI)(nil).ProjectId), projectId)
+}
+
+// MockSimpleEarnSubscribeLockedProductServiceAPI is a mock of SimpleEarnSubscribeLockedProductServiceAPI interface.
+type MockSimpleEarnSubscribeLockedProductServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockSimpleEarnSubscribeLockedProductServiceAPIMockRecorder
+}
+
+// MockSimpleEarnSubscribeLockedProductServiceAPIMockRecorder is the mock recorder for MockSimpleEarnSubscribeLockedProductServiceAPI.
+type MockSimpleEarnSubscribeLockedProductServiceAPIMockRecorder struct {
+	mock *MockSimpleEarnSubscribeLockedProductServiceAPI
+}
+
+// NewMockSimpleEarnSubscribeLockedProductServiceAPI creates a new mock instance.
+func NewMockSimpleEarnSubscribeLockedProductServiceAPI(ctrl *gomock.Controller) *MockSimpleEarnSubscribeLockedProductServiceAPI {
+	mock := &MockSimpleEarnSubscribeLockedProductServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockSimpleEarnSubscribeLockedProductServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSimpleEarnSubscribeLockedProductServiceAPI) EXPECT() *MockSimpleEarnSubscribeLockedProductServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Amount mocks base method.
+func (m *MockSimpleEarnSubscribeLockedProductServiceAPI) Amount(amount string) binance.SimpleEarnSubscribeLockedProductServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Amount", amount)
+	ret0, _ := ret[0].(binance.SimpleEarnSubscribeLockedProductServiceAPI)
+	return ret0
+}
+
+// Amount indicates an expected call of Amount.
+func (mr *MockSimpleEarnSubscribeLockedProductServiceAPIMockRecorder) Amount(amount interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Amount", reflect.TypeOf((*MockSimpleEarnSubscribeLockedProductServiceAPI)(nil).Amount), amount)
+}
+
+// AutoSubscribe mocks base method.
+func (m *MockSimpleEarnSubscribeLockedProductServiceAPI) AutoSubscribe(autoSubscribe bool) binance.SimpleEarnSubscribeLockedProductServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AutoSubscribe", autoSubscribe)
+	ret0, _ := ret[0].(binance.SimpleEarnSubscribeLockedProductServiceAPI)
+	return ret0
+}
+
+// AutoSubscribe indicates an expected call of AutoSubscribe.
+func (mr *MockSimpleEarnSubscribeLockedProductServiceAPIMockRecorder) AutoSubscribe(autoSubscribe interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AutoSubscribe", reflect.TypeOf((*MockSimpleEarnSubscribeLockedProductServiceAPI)(nil).AutoSubscribe), autoSubscribe)
+}
+
+// Do mocks base method.
+func (m *MockSimpleEarnSubscribeLockedProductServiceAPI) Do(ctx context.Context, opts ...binance.RequestOption) (*binance.SimpleEarnSubscribeLockedProductResp, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*binance.SimpleEarnSubscribeLockedProductResp)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockSimpleEarnSubscribeLockedProductServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockSimpleEarnSubscribeLockedProductServiceAPI)(nil).Do), varargs...)
+}
+
+// ProjectId mocks base method.
+func (m *MockSimpleEarnSubscribeLockedProductServiceAPI) ProjectId(projectId string) binance.SimpleEarnSubscribeLockedProductServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ProjectId", projectId)
+	ret0, _ := ret[0].(binance.SimpleEarnSubscribeLockedProductServiceAPI)
+	return ret0
+}
+
+// ProjectId indicates an expected call of ProjectId.
+func (mr *MockSimpleEarnSubscribeLockedProductServiceAPIMockRecorder) ProjectId(projectId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ProjectId", reflect.TypeOf((*MockSimpleEarnSubscribeLockedProductServiceAPI)(nil).ProjectId), projectId)
+}
+
+// RedeemTo mocks base method.
+func (m *MockSimpleEarnSubscribeLockedProductServiceAPI) RedeemTo(redeemTo string) binance.SimpleEarnSubscribeLockedProductServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RedeemTo", redeemTo)
+	ret0, _ := ret[0].(binance.SimpleEarnSubscribeLockedProductServiceAPI)
+	return ret0
+}
+
+// RedeemTo indicates an expected call of RedeemTo.
+func (mr *MockSimpleEarnSubscribeLockedProductServiceAPIMockRecorder) RedeemTo(redeemTo interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RedeemTo", reflect.TypeOf((*MockSimpleEarnSubscribeLockedProductServiceAPI)(nil).RedeemTo), redeemTo)
+}
+
+// SourceAccount mocks base method.
+func (m *MockSimpleEarnSubscribeLockedProductServiceAPI) SourceAccount(sourceAccount binance.SimpleEarnSubscribeSourceAccount) binance.SimpleEarnSubscribeLockedProductServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SourceAccount", sourceAccount)
+	ret0, _ := ret[0].(binance.SimpleEarnSubscribeLockedProductServiceAPI)
+	return ret0
+}
+
+// SourceAccount indicates an expected call of SourceAccount.
+func (mr *MockSimpleEarnSubscribeLockedProductServiceAPIMockRecorder) SourceAccount(sourceAccount interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SourceAccount", reflect.TypeOf((*MockSimpleEarnSubscribeLockedProductServiceAPI)(nil).SourceAccount), sourceAccount)
+}
+
+// MockSimpleEarnRedeemLockedProductServiceAPI is a mock of SimpleEarnRedeemLockedProductServiceAPI interface.
+type MockSimpleEarnRedeemLockedProductServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockSimpleEarnRedeemLockedProductServiceAPIMockRecorder
+}
+
+// MockSimpleEarnRedeemLockedProductServiceAPIMockRecorder is the mock recorder for MockSimpleEarnRedeemLockedProductServiceAPI.
+type MockSimpleEarnRedeemLockedProductServiceAPIMockRecorder struct {
+	mock *MockSimpleEarnRedeemLockedProductServiceAPI
+}
+
+// NewMockSimpleEarnRedeemLockedProductServiceAPI creates a new mock instance.
+func NewMockSimpleEarnRedeemLockedProductServiceAPI(ctrl *gomock.Controller) *MockSimpleEarnRedeemLockedProductServiceAPI {
+	mock := &MockSimpleEarnRedeemLockedProductServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockSimpleEarnRedeemLockedProductServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSimpleEarnRedeemLockedProductServiceAPI) EXPECT() *MockSimpleEarnRedeemLockedProductServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockSimpleEarnRedeemLockedProductServiceAPI) Do(ctx context.Context, opts ...binance.RequestOption) (*binance.SimpleEarnRedeemLockedProductResp, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*binance.SimpleEarnRedeemLockedProductResp)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockSimpleEarnRedeemLockedProductServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockSimpleEarnRedeemLockedProductServiceAPI)(nil).Do), varargs...)
+}
+
+// PositionId mocks base method.
+func (m *MockSimpleEarnRedeemLockedProductServiceAPI) PositionId(positionId int) binance.SimpleEarnRedeemLockedProductServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PositionId", positionId)
+	ret0, _ := ret[0].(binance.SimpleEarnRedeemLockedProductServiceAPI)
+	return ret0
+}
+
+// PositionId indicates an expected call of PositionId.
+func (mr *MockSimpleEarnRedeemLockedProductServiceAPIMockRecorder) PositionId(positionId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PositionId", reflect.TypeOf((*MockSimpleEarnRedeemLockedProductServiceAPI)(nil).PositionId), positionId)
+}
+
+// MockSimpleEarnSetAutoSubscribeLockedProductServiceAPI is a mock of SimpleEarnSetAutoSubscribeLockedProductServiceAPI interface.
+type MockSimpleEarnSetAutoSubscribeLockedProductServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockSimpleEarnSetAutoSubscribeLockedProductServiceAPIMockRecorder
+}
+
+// MockSimpleEarnSetAutoSubscribeLockedProductServiceAPIMockRecorder is the mock recorder for MockSimpleEarnSetAutoSubscribeLockedProductServiceAPI.
+type MockSimpleEarnSetAutoSubscribeLockedProductServiceAPIMockRecorder struct {
+	mock *MockSimpleEarnSetAutoSubscribeLockedProductServiceAPI
+}
+
+// NewMockSimpleEarnSetAutoSubscribeLockedProductServiceAPI creates a new mock instance.
+func NewMockSimpleEarnSetAutoSubscribeLockedProductServiceAPI(ctrl *gomock.Controller) *MockSimpleEarnSetAutoSubscribeLockedProductServiceAPI {
+	mock := &MockSimpleEarnSetAutoSubscribeLockedProductServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockSimpleEarnSetAutoSubscribeLockedProductServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSimpleEarnSetAutoSubscribeLockedProductServiceAPI) EXPECT() *MockSimpleEarnSetAutoSubscribeLockedProductServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// AutoSubscribe mocks base method.
+func (m *MockSimpleEarnSetAutoSubscribeLockedProductServiceAPI) AutoSubscribe(autoSubscribe bool) binance.SimpleEarnSetAutoSubscribeLockedProductServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AutoSubscribe", autoSubscribe)
+	ret0, _ := ret[0].(binance.SimpleEarnSetAutoSubscribeLockedProductServiceAPI)
+	return ret0
+}
+
+// AutoSubscribe indicates an expected call of AutoSubscribe.
+func (mr *MockSimpleEarnSetAutoSubscribeLockedProductServiceAPIMockRecorder) AutoSubscribe(autoSubscribe interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AutoSubscribe", reflect.TypeOf((*MockSimpleEarnSetAutoSubscribeLockedProductServiceAPI)(nil).AutoSubscribe), autoSubscribe)
+}
+
+// Do mocks base method.
+func (m *MockSimpleEarnSetAutoSubscribeLockedProductServiceAPI) Do(ctx context.Context, opts ...binance.RequestOption) (*binance.SimpleEarnSetAutoSubscribeLockedProductResp, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*binance.SimpleEarnSetAutoSubscribeLockedProductResp)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockSimpleEarnSetAutoSubscribeLockedProductServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockSimpleEarnSetAutoSubscribeLockedProductServiceAPI)(nil).Do), varargs...)
+}
+
+// PositionId mocks base method.
+func (m *MockSimpleEarnSetAutoSubscribeLockedProductServiceAPI) PositionId(positionId int) binance.SimpleEarnSetAutoSubscribeLockedProductServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PositionId", positionId)
+	ret0, _ := ret[0].(binance.SimpleEarnSetAutoSubscribeLockedProductServiceAPI)
+	return ret0
+}
+
+// PositionId indicates an expected call of PositionId.
+func (mr *MockSimpleEarnSetAutoSubscribeLockedProductServiceAPIMockRecorder) PositionId(positionId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PositionId", reflect.TypeOf((*MockSimpleEarnSetAutoSubscribeLockedProductServiceAPI)(nil).PositionId), positionId)
+}
+
+// MockSimpleEarnLockedSubscriptionPreviewServiceAPI is a mock of SimpleEarnLockedSubscriptionPreviewServiceAPI interface.
+type MockSimpleEarnLockedSubscriptionPreviewServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockSimpleEarnLockedSubscriptionPreviewServiceAPIMockRecorder
+}
+
+// MockSimpleEarnLockedSubscriptionPreviewServiceAPIMockRecorder is the mock recorder for MockSimpleEarnLockedSubscriptionPreviewServiceAPI.
+type MockSimpleEarnLockedSubscriptionPreviewServiceAPIMockRecorder struct {
+	mock *MockSimpleEarnLockedSubscriptionPreviewServiceAPI
+}
+
+// NewMockSimpleEarnLockedSubscriptionPreviewServiceAPI creates a new mock instance.
+func NewMockSimpleEarnLockedSubscriptionPreviewServiceAPI(ctrl *gomock.Controller) *MockSimpleEarnLockedSubscriptionPreviewServiceAPI {
+	mock := &MockSimpleEarnLockedSubscriptionPreviewServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockSimpleEarnLockedSubscriptionPreviewServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSimpleEarnLockedSubscriptionPreviewServiceAPI) EXPECT() *MockSimpleEarnLockedSubscriptionPreviewServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Amount mocks base method.
+func (m *MockSimpleEarnLockedSubscriptionPreviewServiceAPI) Amount(amount string) binance.SimpleEarnLockedSubscriptionPreviewServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Amount", amount)
+	ret0, _ := ret[0].(binance.SimpleEarnLockedSubscriptionPreviewServiceAPI)
+	return ret0
+}
+
+// Amount indicates an expected call of Amount.
+func (mr *MockSimpleEarnLockedSubscriptionPreviewServiceAPIMockRecorder) Amount(amount interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Amount", reflect.TypeOf((*MockSimpleEarnLockedSubscriptionPreviewServiceAPI)(nil).Amount), amount)
+}
+
+// AutoSubscribe mocks base method.
+func (m *MockSimpleEarnLockedSubscriptionPreviewServiceAPI) AutoSubscribe(autoSubscribe bool) binance.SimpleEarnLockedSubscriptionPreviewServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AutoSubscribe", autoSubscribe)
+	ret0, _ := ret[0].(binance.SimpleEarnLockedSubscriptionPreviewServiceAPI)
+	return ret0
+}
+
+// AutoSubscribe indicates an expected call of AutoSubscribe.
+func (mr *MockSimpleEarnLockedSubscriptionPreviewServiceAPIMockRecorder) AutoSubscribe(autoSubscribe interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AutoSubscribe", reflect.TypeOf((*MockSimpleEarnLockedSubscriptionPreviewServiceAPI)(nil).AutoSubscribe), autoSubscribe)
+}
+
+// Do mocks base method.
+func (m *MockSimpleEarnLockedSubscriptionPreviewServiceAPI) Do(ctx context.Context, opts ...binance.RequestOption) (*binance.SimpleEarnLockedSubscriptionPreviewResp, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*binance.SimpleEarnLockedSubscriptionPreviewResp)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockSimpleEarnLockedSubscriptionPreviewServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockSimpleEarnLockedSubscriptionPreviewServiceAPI)(nil).Do), varargs...)
+}
+
+// ProjectId mocks base method.
+func (m *MockSimpleEarnLockedSubscriptionPreviewServiceAPI) ProjectId(projectId string) binance.SimpleEarnLockedSubscriptionPreviewServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ProjectId", projectId)
+	ret0, _ := ret[0].(binance.SimpleEarnLockedSubscriptionPreviewServiceAPI)
+	return ret0
+}
+
+// ProjectId indicates an expected call of ProjectId.
+func (mr *MockSimpleEarnLockedSubscriptionPreviewServiceAPIMockRecorder) ProjectId(projectId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ProjectId", reflect.TypeOf((*MockSimpleEarnLockedSubscriptionPreviewServiceAPI)(nil).ProjectId), projectId)
+}
+
+// MockSimpleEarnSetRedeemOptionServiceAPI is a mock of SimpleEarnSetRedeemOptionServiceAPI interface.
+type MockSimpleEarnSetRedeemOptionServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockSimpleEarnSetRedeemOptionServiceAPIMockRecorder
+}
+
+// MockSimpleEarnSetRedeemOptionServiceAPIMockRecorder is the mock recorder for MockSimpleEarnSetRedeemOptionServiceAPI.
+type MockSimpleEarnSetRedeemOptionServiceAPIMockRecorder struct {
+	mock *MockSimpleEarnSetRedeemOptionServiceAPI
+}
+
+// NewMockSimpleEarnSetRedeemOptionServiceAPI creates a new mock instance.
+func NewMockSimpleEarnSetRedeemOptionServiceAPI(ctrl *gomock.Controller) *MockSimpleEarnSetRedeemOptionServiceAPI {
+	mock := &MockSimpleEarnSetRedeemOptionServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockSimpleEarnSetRedeemOptionServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSimpleEarnSetRedeemOptionServiceAPI) EXPECT() *MockSimpleEarnSetRedeemOptionServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockSimpleEarnSetRedeemOptionServiceAPI) Do(ctx context.Context, opts ...binance.RequestOption) (*binance.SimpleEarnSetRedeemOptionResp, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*binance.SimpleEarnSetRedeemOptionResp)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockSimpleEarnSetRedeemOptionServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockSimpleEarnSetRedeemOptionServiceAPI)(nil).Do), varargs...)
+}
+
+// PositionId mocks base method.
+func (m *MockSimpleEarnSetRedeemOptionServiceAPI) PositionId(positionId string) binance.SimpleEarnSetRedeemOptionServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PositionId", positionId)
+	ret0, _ := ret[0].(binance.SimpleEarnSetRedeemOptionServiceAPI)
+	return ret0
+}
+
+// PositionId indicates an expected call of PositionId.
+func (mr *MockSimpleEarnSetRedeemOptionServiceAPIMockRecorder) PositionId(positionId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PositionId", reflect.TypeOf((*MockSimpleEarnSetRedeemOptionServiceAPI)(nil).PositionId), positionId)
+}
+
+// RedeemTo mocks base method.
+func (m *MockSimpleEarnSetRedeemOptionServiceAPI) RedeemTo(redeemTo binance.RedeemTo) binance.SimpleEarnSetRedeemOptionServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RedeemTo", redeemTo)
+	ret0, _ := ret[0].(binance.SimpleEarnSetRedeemOptionServiceAPI)
+	return ret0
+}
+
+// RedeemTo indicates an expected call of RedeemTo.
+func (mr *MockSimpleEarnSetRedeemOptionServiceAPIMockRecorder) RedeemTo(redeemTo interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RedeemTo", reflect.TypeOf((*MockSimpleEarnSetRedeemOptionServiceAPI)(nil).RedeemTo), redeemTo)
+}
+
+// MockSimpleEarnGetLockedRewardsHistoryServiceAPI is a mock of SimpleEarnGetLockedRewardsHistoryServiceAPI interface.
+type MockSimpleEarnGetLockedRewardsHistoryServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockSimpleEarnGetLockedRewardsHistoryServiceAPIMockRecorder
+}
+
+// MockSimpleEarnGetLockedRewardsHistoryServiceAPIMockRecorder is the mock recorder for MockSimpleEarnGetLockedRewardsHistoryServiceAPI.
+type MockSimpleEarnGetLockedRewardsHistoryServiceAPIMockRecorder struct {
+	mock *MockSimpleEarnGetLockedRewardsHistoryServiceAPI
+}
+
+// NewMockSimpleEarnGetLockedRewardsHistoryServiceAPI creates a new mock instance.
+func NewMockSimpleEarnGetLockedRewardsHistoryServiceAPI(ctrl *gomock.Controller) *MockSimpleEarnGetLockedRewardsHistoryServiceAPI {
+	mock := &MockSimpleEarnGetLockedRewardsHistoryServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockSimpleEarnGetLockedRewardsHistoryServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSimpleEarnGetLockedRewardsHistoryServiceAPI) EXPECT() *MockSimpleEarnGetLockedRewardsHistoryServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Asset mocks base method.
+func (m *MockSimpleEarnGetLockedRewardsHistoryServiceAPI) Asset(asset string) binance.SimpleEarnGetLockedRewardsHistoryServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Asset", asset)
+	ret0, _ := ret[0].(binance.SimpleEarnGetLockedRewardsHistoryServiceAPI)
+	return ret0
+}
+
+// Asset indicates an expected call of Asset.
+func (mr *MockSimpleEarnGetLockedRewardsHistoryServiceAPIMockRecorder) Asset(asset interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Asset", reflect.TypeOf((*MockSimpleEarnGetLockedRewardsHistoryServiceAPI)(nil).Asset), asset)
+}
+
+// Current mocks base method.
+func (m *MockSimpleEarnGetLockedRewardsHistoryServiceAPI) Current(current int) binance.SimpleEarnGetLockedRewardsHistoryServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Current", current)
+	ret0, _ := ret[0].(binance.SimpleEarnGetLockedRewardsHistoryServiceAPI)
+	return ret0
+}
+
+// Current indicates an expected call of Current.
+func (mr *MockSimpleEarnGetLockedRewardsHistoryServiceAPIMockRecorder) Current(current interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Current", reflect.TypeOf((*MockSimpleEarnGetLockedRewardsHistoryServiceAPI)(nil).Current), current)
+}
+
+// Do mocks base method.
+func (m *MockSimpleEarnGetLockedRewardsHistoryServiceAPI) Do(ctx context.Context, opts ...binance.RequestOption) (*binance.SimpleEarnLockedRewardsHistoryResp, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*binance.SimpleEarnLockedRewardsHistoryResp)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockSimpleEarnGetLockedRewardsHistoryServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockSimpleEarnGetLockedRewardsHistoryServiceAPI)(nil).Do), varargs...)
+}
+
+// EndTime mocks base method.
+func (m *MockSimpleEarnGetLockedRewardsHistoryServiceAPI) EndTime(endTime int64) binance.SimpleEarnGetLockedRewardsHistoryServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EndTime", endTime)
+	ret0, _ := ret[0].(binance.SimpleEarnGetLockedRewardsHistoryServiceAPI)
+	return ret0
+}
+
+// EndTime indicates an expected call of EndTime.
+func (mr *MockSimpleEarnGetLockedRewardsHistoryServiceAPIMockRecorder) EndTime(endTime interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EndTime", reflect.TypeOf((*MockSimpleEarnGetLockedRewardsHistoryServiceAPI)(nil).EndTime), endTime)
+}
+
+// PositionId mocks base method.
+func (m *MockSimpleEarnGetLockedRewardsHistoryServiceAPI) PositionId(positionId string) binance.SimpleEarnGetLockedRewardsHistoryServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PositionId", positionId)
+	ret0, _ := ret[0].(binance.SimpleEarnGetLockedRewardsHistoryServiceAPI)
+	return ret0
+}
+
+// PositionId indicates an expected call of PositionId.
+func (mr *MockSimpleEarnGetLockedRewardsHistoryServiceAPIMockRecorder) PositionId(positionId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PositionId", reflect.TypeOf((*MockSimpleEarnGetLockedRewardsHistoryServiceAPI)(nil).PositionId), positionId)
+}
+
+// Size mocks base method.
+func (m *MockSimpleEarnGetLockedRewardsHistoryServiceAPI) Size(size int) binance.SimpleEarnGetLockedRewardsHistoryServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Size", size)
+	ret0, _ := ret[0].(binance.SimpleEarnGetLockedRewardsHistoryServiceAPI)
+	return ret0
+}
+
+// Size indicates an expected call of Size.
+func (mr *MockSimpleEarnGetLockedRewardsHistoryServiceAPIMockRecorder) Size(size interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Size", reflect.TypeOf((*MockSimpleEarnGetLockedRewardsHistoryServiceAPI)(nil).Size), size)
+}
+
+// StartTime mocks base method.
+func (m *MockSimpleEarnGetLockedRewardsHistoryServiceAPI) StartTime(startTime int64) binance.SimpleEarnGetLockedRewardsHistoryServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartTime", startTime)
+	ret0, _ := ret[0].(binance.SimpleEarnGetLockedRewardsHistoryServiceAPI)
+	return ret0
+}
+
+// StartTime indicates an expected call of StartTime.
+func (mr *MockSimpleEarnGetLockedRewardsHistoryServiceAPIMockRecorder) StartTime(startTime interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartTime", reflect.TypeOf((*MockSimpleEarnGetLockedRewardsHistoryServiceAPI)(nil).StartTime), startTime)
+}
@@ -0,0 +1,69 @@
+package mock
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/adshao/go-binance/v2"
+)
+
+type serviceAPITestSuite struct {
+	suite.Suite
+}
+
+func TestServiceAPI(t *testing.T) {
+	suite.Run(t, new(serviceAPITestSuite))
+}
+
+// buyMarket is code under test, placing an order through the services of c
+func buyMarket(ctx context.Context, c binance.ClientAPI, symbol, quantity string) (int64, error) {
+	res, err := c.NewCreateOrderService().Symbol(symbol).Side(binance.SideTypeBuy).
+		Type(binance.OrderTypeMarket).Quantity(quantity).Do(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return res.OrderID, nil
+}
+
+func (s *serviceAPITestSuite) TestMockServices() {
+	ctrl := gomock.NewController(s.T())
+	defer ctrl.Finish()
+
+	client := NewMockClientAPI(ctrl)
+	service := NewMockCreateOrderServiceAPI(ctrl)
+	client.EXPECT().NewCreateOrderService().Return(service)
+	service.EXPECT().Symbol("BTCUSDT").Return(service)
+	service.EXPECT().Side(binance.SideTypeBuy).Return(service)
+	service.EXPECT().Type(binance.OrderTypeMarket).Return(service)
+	service.EXPECT().Quantity("0.1").Return(service)
+	service.EXPECT().Do(gomock.Any()).Return(&binance.CreateOrderResponse{OrderID: 42}, nil)
+
+	orderID, err := buyMarket(context.Background(), client, "BTCUSDT", "0.1")
+	s.Require().NoError(err)
+	s.Equal(int64(42), orderID)
+}
+
+func (s *serviceAPITestSuite) TestClientAPI() {
+	var form map[string][]string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		s.Equal("/api/v3/order", r.URL.Path)
+		s.Require().NoError(r.ParseForm())
+		form = r.PostForm
+		w.Write([]byte(`{"symbol":"BTCUSDT","orderId":7}`))
+	}))
+	defer server.Close()
+
+	c := binance.NewClientWithEnvironment("key", "secret", binance.ProductionEnvironment().WithAPIURL(server.URL))
+	orderID, err := buyMarket(context.Background(), binance.NewClientAPI(c), "BTCUSDT", "0.1")
+	s.Require().NoError(err)
+	s.Equal(int64(7), orderID)
+	s.Equal([]string{"BTCUSDT"}, form["symbol"])
+	s.Equal([]string{"BUY"}, form["side"])
+	s.Equal([]string{"MARKET"}, form["type"])
+	s.Equal([]string{"0.1"}, form["quantity"])
+}
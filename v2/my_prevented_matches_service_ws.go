@@ -0,0 +1,217 @@
+package binance
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/adshao/go-binance/v2/common"
+	"github.com/adshao/go-binance/v2/common/websocket"
+)
+
+// MyPreventedMatchesWsService gets orders expired because of self-trade prevention
+type MyPreventedMatchesWsService struct {
+	c          websocket.Client
+	ApiKey     string
+	SecretKey  string
+	KeyType    string
+	Signer     common.Signer       // signs requests instead of SecretKey when set
+	signers    *common.SignerCache // parses SecretKey once
+	TimeOffset int64
+}
+
+// NewMyPreventedMatchesWsService init MyPreventedMatchesWsService
+func NewMyPreventedMatchesWsService(apiKey, secretKey string) (*MyPreventedMatchesWsService, error) {
+	conn, err := websocket.NewConnection(WsApiInitReadWriteConn, WebsocketKeepalive, WebsocketTimeoutReadWriteConnection)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := websocket.NewClient(conn)
+	if err != nil {
+		return nil, err
+	}
+
+	return &MyPreventedMatchesWsService{
+		c:         client,
+		ApiKey:    apiKey,
+		SecretKey: secretKey,
+		KeyType:   common.KeyTypeHmac,
+		signers:   new(common.SignerCache),
+	}, nil
+}
+
+// MyPreventedMatchesWsRequest parameters for 'myPreventedMatches' websocket API
+type MyPreventedMatchesWsRequest struct {
+	symbol               string
+	preventedMatchId     *int64
+	orderId              *int64
+	fromPreventedMatchId *int64
+	limit                *int
+	recvWindow           *uint16
+}
+
+// NewMyPreventedMatchesWsRequest init MyPreventedMatchesWsRequest
+func NewMyPreventedMatchesWsRequest() *MyPreventedMatchesWsRequest {
+	return &MyPreventedMatchesWsRequest{}
+}
+
+func (s *MyPreventedMatchesWsRequest) GetParams() map[string]interface{} {
+	return s.buildParams()
+}
+
+// buildParams builds params
+func (s *MyPreventedMatchesWsRequest) buildParams() params {
+	m := params{
+		"symbol": s.symbol,
+	}
+	if s.preventedMatchId != nil {
+		m["preventedMatchId"] = *s.preventedMatchId
+	}
+	if s.orderId != nil {
+		m["orderId"] = *s.orderId
+	}
+	if s.fromPreventedMatchId != nil {
+		m["fromPreventedMatchId"] = *s.fromPreventedMatchId
+	}
+	if s.limit != nil {
+		m["limit"] = *s.limit
+	}
+	if s.recvWindow != nil {
+		m["recvWindow"] = *s.recvWindow
+	}
+	return m
+}
+
+// Do - sends 'myPreventedMatches' request
+func (s *MyPreventedMatchesWsService) Do(requestID string, request *MyPreventedMatchesWsRequest) error {
+	rawData, err := websocket.CreateRequest(
+		websocket.NewRequestData(
+			requestID,
+			s.ApiKey,
+			s.SecretKey,
+			s.TimeOffset,
+			s.KeyType,
+		).WithSigner(s.Signer).WithSignerCache(s.signers),
+		websocket.MyPreventedMatchesSpotWsApiMethod,
+		request.buildParams(),
+	)
+	if err != nil {
+		return err
+	}
+
+	if err := s.c.Write(requestID, rawData); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// SyncDo - sends 'myPreventedMatches' request and receives response
+func (s *MyPreventedMatchesWsService) SyncDo(requestID string, request *MyPreventedMatchesWsRequest) (*MyPreventedMatchesWsResponse, error) {
+	rawData, err := websocket.CreateRequest(
+		websocket.NewRequestData(
+			requestID,
+			s.ApiKey,
+			s.SecretKey,
+			s.TimeOffset,
+			s.KeyType,
+		).WithSigner(s.Signer).WithSignerCache(s.signers),
+		websocket.MyPreventedMatchesSpotWsApiMethod,
+		request.buildParams(),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := s.c.WriteSync(requestID, rawData, websocket.WriteSyncWsTimeout)
+	if err != nil {
+		return nil, err
+	}
+
+	myPreventedMatchesWsResponse := &MyPreventedMatchesWsResponse{}
+	if err := json.Unmarshal(response, myPreventedMatchesWsResponse); err != nil {
+		return nil, err
+	}
+
+	return myPreventedMatchesWsResponse, nil
+}
+
+// ReceiveAllDataBeforeStop waits until all responses will be received from websocket until timeout expired
+func (s *MyPreventedMatchesWsService) ReceiveAllDataBeforeStop(timeout time.Duration) {
+	s.c.Wait(timeout)
+}
+
+// GetReadChannel returns channel with API response data (including API errors)
+func (s *MyPreventedMatchesWsService) GetReadChannel() <-chan []byte {
+	return s.c.GetReadChannel()
+}
+
+// GetReadErrorChannel returns channel with errors which are occurred while reading websocket connection
+func (s *MyPreventedMatchesWsService) GetReadErrorChannel() <-chan error {
+	return s.c.GetReadErrorChannel()
+}
+
+// GetReconnectCount returns count of reconnect attempts by client
+func (s *MyPreventedMatchesWsService) GetReconnectCount() int64 {
+	return s.c.GetReconnectCount()
+}
+
+// Symbol set symbol
+func (s *MyPreventedMatchesWsRequest) Symbol(symbol string) *MyPreventedMatchesWsRequest {
+	s.symbol = symbol
+	return s
+}
+
+// PreventedMatchID set preventedMatchId
+func (s *MyPreventedMatchesWsRequest) PreventedMatchID(preventedMatchId int64) *MyPreventedMatchesWsRequest {
+	s.preventedMatchId = &preventedMatchId
+	return s
+}
+
+// OrderID set orderId
+func (s *MyPreventedMatchesWsRequest) OrderID(orderId int64) *MyPreventedMatchesWsRequest {
+	s.orderId = &orderId
+	return s
+}
+
+// FromPreventedMatchID set fromPreventedMatchId
+func (s *MyPreventedMatchesWsRequest) FromPreventedMatchID(fromPreventedMatchId int64) *MyPreventedMatchesWsRequest {
+	s.fromPreventedMatchId = &fromPreventedMatchId
+	return s
+}
+
+// Limit set limit
+func (s *MyPreventedMatchesWsRequest) Limit(limit int) *MyPreventedMatchesWsRequest {
+	s.limit = &limit
+	return s
+}
+
+// RecvWindow set recvWindow
+func (s *MyPreventedMatchesWsRequest) RecvWindow(recvWindow uint16) *MyPreventedMatchesWsRequest {
+	s.recvWindow = &recvWindow
+	return s
+}
+
+// PreventedMatch define an order expired because of self-trade prevention
+type PreventedMatch struct {
+	Symbol                  string                  `json:"symbol"`
+	PreventedMatchID        int64                   `json:"preventedMatchId"`
+	TakerOrderID            int64                   `json:"takerOrderId"`
+	MakerSymbol             string                  `json:"makerSymbol"`
+	MakerOrderID            int64                   `json:"makerOrderId"`
+	TradeGroupID            int64                   `json:"tradeGroupId"`
+	SelfTradePreventionMode SelfTradePreventionMode `json:"selfTradePreventionMode"`
+	Price                   string                  `json:"price"`
+	MakerPreventedQuantity  string                  `json:"makerPreventedQuantity"`
+	TransactTime            int64                   `json:"transactTime"`
+}
+
+// MyPreventedMatchesWsResponse define 'myPreventedMatches' websocket API response
+type MyPreventedMatchesWsResponse struct {
+	Id     string            `json:"id"`
+	Status int               `json:"status"`
+	Result []*PreventedMatch `json:"result"`
+
+	// error response
+	Error *common.APIError `json:"error,omitempty"`
+}
@@ -0,0 +1,105 @@
+package binance
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/adshao/go-binance/v2/common/websocket"
+	"github.com/adshao/go-binance/v2/common/websocket/mock"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/suite"
+)
+
+type myPreventedMatchesServiceWsTestSuite struct {
+	suite.Suite
+
+	ctrl   *gomock.Controller
+	client *mock.MockClient
+
+	requestID string
+
+	service *MyPreventedMatchesWsService
+	request *MyPreventedMatchesWsRequest
+}
+
+func (s *myPreventedMatchesServiceWsTestSuite) SetupTest() {
+	s.requestID = "e2a85d9f-07a5-4f94-8d5f-789dc3deb098"
+
+	s.ctrl = gomock.NewController(s.T())
+	s.client = mock.NewMockClient(s.ctrl)
+
+	s.service = &MyPreventedMatchesWsService{
+		c:         s.client,
+		ApiKey:    "dummyApiKey",
+		SecretKey: "dummySecretKey",
+		KeyType:   "HMAC",
+	}
+
+	s.request = NewMyPreventedMatchesWsRequest().Symbol("BTCUSDT").OrderID(5)
+}
+
+func (s *myPreventedMatchesServiceWsTestSuite) TearDownTest() {
+	s.ctrl.Finish()
+}
+
+func TestMyPreventedMatchesWsService(t *testing.T) {
+	suite.Run(t, new(myPreventedMatchesServiceWsTestSuite))
+}
+
+func (s *myPreventedMatchesServiceWsTestSuite) TestDo() {
+	var data []byte
+	s.client.EXPECT().Write(s.requestID, gomock.Any()).DoAndReturn(func(id string, raw []byte) error {
+		data = raw
+		return nil
+	}).Times(1)
+
+	err := s.service.Do(s.requestID, s.request)
+	s.Require().NoError(err)
+
+	req := websocket.WsApiRequest{}
+	s.Require().NoError(json.Unmarshal(data, &req))
+	s.Equal(s.requestID, req.Id)
+	s.Equal(websocket.WsApiMethodType("myPreventedMatches"), req.Method)
+	for k, v := range map[string]interface{}{"symbol": "BTCUSDT", "orderId": float64(5)} {
+		s.Equal(v, req.Params[k], k)
+	}
+	s.Contains(req.Params, "signature")
+}
+
+func (s *myPreventedMatchesServiceWsTestSuite) TestDo_EmptyApiKey() {
+	s.service.ApiKey = ""
+	s.client.EXPECT().Write(gomock.Any(), gomock.Any()).Times(0)
+
+	err := s.service.Do(s.requestID, s.request)
+	s.ErrorIs(err, websocket.ErrorApiKeyIsNotSet)
+}
+
+func (s *myPreventedMatchesServiceWsTestSuite) TestSyncDo() {
+	rawResponseData := []byte(fmt.Sprintf(`{"id": "%s", "status": 200, "result": [{"symbol": "BTCUSDT", "preventedMatchId": 1, "takerOrderId": 5, "makerSymbol": "BTCUSDT", "makerOrderId": 3, "tradeGroupId": 1, "selfTradePreventionMode": "EXPIRE_MAKER", "price": "1.100000", "makerPreventedQuantity": "1.300000", "transactTime": 1669101687094}]}`, s.requestID))
+	s.client.EXPECT().WriteSync(s.requestID, gomock.Any(), gomock.Any()).Return(rawResponseData, nil).Times(1)
+
+	response, err := s.service.SyncDo(s.requestID, s.request)
+	s.Require().NoError(err)
+	s.Equal(s.requestID, response.Id)
+	s.Equal([]*PreventedMatch{{
+		Symbol:                  "BTCUSDT",
+		PreventedMatchID:        1,
+		TakerOrderID:            5,
+		MakerSymbol:             "BTCUSDT",
+		MakerOrderID:            3,
+		TradeGroupID:            1,
+		SelfTradePreventionMode: SelfTradePreventionModeExpireMaker,
+		Price:                   "1.100000",
+		MakerPreventedQuantity:  "1.300000",
+		TransactTime:            1669101687094,
+	}}, response.Result)
+}
+
+func (s *myPreventedMatchesServiceWsTestSuite) TestSyncDo_EmptyRequestID() {
+	s.client.EXPECT().WriteSync(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+	response, err := s.service.SyncDo("", s.request)
+	s.Nil(response)
+	s.ErrorIs(err, websocket.ErrorRequestIDNotSet)
+}
@@ -0,0 +1,213 @@
+package binance
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/adshao/go-binance/v2/common"
+	"github.com/adshao/go-binance/v2/common/websocket"
+)
+
+// MyTradesWsService gets trades of the account
+type MyTradesWsService struct {
+	c          websocket.Client
+	ApiKey     string
+	SecretKey  string
+	KeyType    string
+	Signer     common.Signer       // signs requests instead of SecretKey when set
+	signers    *common.SignerCache // parses SecretKey once
+	TimeOffset int64
+}
+
+// NewMyTradesWsService init MyTradesWsService
+func NewMyTradesWsService(apiKey, secretKey string) (*MyTradesWsService, error) {
+	conn, err := websocket.NewConnection(WsApiInitReadWriteConn, WebsocketKeepalive, WebsocketTimeoutReadWriteConnection)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := websocket.NewClient(conn)
+	if err != nil {
+		return nil, err
+	}
+
+	return &MyTradesWsService{
+		c:         client,
+		ApiKey:    apiKey,
+		SecretKey: secretKey,
+		KeyType:   common.KeyTypeHmac,
+		signers:   new(common.SignerCache),
+	}, nil
+}
+
+// MyTradesWsRequest parameters for 'myTrades' websocket API
+type MyTradesWsRequest struct {
+	symbol     string
+	orderId    *int64
+	startTime  *int64
+	endTime    *int64
+	fromId     *int64
+	limit      *int
+	recvWindow *uint16
+}
+
+// NewMyTradesWsRequest init MyTradesWsRequest
+func NewMyTradesWsRequest() *MyTradesWsRequest {
+	return &MyTradesWsRequest{}
+}
+
+func (s *MyTradesWsRequest) GetParams() map[string]interface{} {
+	return s.buildParams()
+}
+
+// buildParams builds params
+func (s *MyTradesWsRequest) buildParams() params {
+	m := params{
+		"symbol": s.symbol,
+	}
+	if s.orderId != nil {
+		m["orderId"] = *s.orderId
+	}
+	if s.startTime != nil {
+		m["startTime"] = *s.startTime
+	}
+	if s.endTime != nil {
+		m["endTime"] = *s.endTime
+	}
+	if s.fromId != nil {
+		m["fromId"] = *s.fromId
+	}
+	if s.limit != nil {
+		m["limit"] = *s.limit
+	}
+	if s.recvWindow != nil {
+		m["recvWindow"] = *s.recvWindow
+	}
+	return m
+}
+
+// Do - sends 'myTrades' request
+func (s *MyTradesWsService) Do(requestID string, request *MyTradesWsRequest) error {
+	rawData, err := websocket.CreateRequest(
+		websocket.NewRequestData(
+			requestID,
+			s.ApiKey,
+			s.SecretKey,
+			s.TimeOffset,
+			s.KeyType,
+		).WithSigner(s.Signer).WithSignerCache(s.signers),
+		websocket.MyTradesSpotWsApiMethod,
+		request.buildParams(),
+	)
+	if err != nil {
+		return err
+	}
+
+	if err := s.c.Write(requestID, rawData); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// SyncDo - sends 'myTrades' request and receives response
+func (s *MyTradesWsService) SyncDo(requestID string, request *MyTradesWsRequest) (*MyTradesWsResponse, error) {
+	rawData, err := websocket.CreateRequest(
+		websocket.NewRequestData(
+			requestID,
+			s.ApiKey,
+			s.SecretKey,
+			s.TimeOffset,
+			s.KeyType,
+		).WithSigner(s.Signer).WithSignerCache(s.signers),
+		websocket.MyTradesSpotWsApiMethod,
+		request.buildParams(),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := s.c.WriteSync(requestID, rawData, websocket.WriteSyncWsTimeout)
+	if err != nil {
+		return nil, err
+	}
+
+	myTradesWsResponse := &MyTradesWsResponse{}
+	if err := json.Unmarshal(response, myTradesWsResponse); err != nil {
+		return nil, err
+	}
+
+	return myTradesWsResponse, nil
+}
+
+// ReceiveAllDataBeforeStop waits until all responses will be received from websocket until timeout expired
+func (s *MyTradesWsService) ReceiveAllDataBeforeStop(timeout time.Duration) {
+	s.c.Wait(timeout)
+}
+
+// GetReadChannel returns channel with API response data (including API errors)
+func (s *MyTradesWsService) GetReadChannel() <-chan []byte {
+	return s.c.GetReadChannel()
+}
+
+// GetReadErrorChannel returns channel with errors which are occurred while reading websocket connection
+func (s *MyTradesWsService) GetReadErrorChannel() <-chan error {
+	return s.c.GetReadErrorChannel()
+}
+
+// GetReconnectCount returns count of reconnect attempts by client
+func (s *MyTradesWsService) GetReconnectCount() int64 {
+	return s.c.GetReconnectCount()
+}
+
+// Symbol set symbol
+func (s *MyTradesWsRequest) Symbol(symbol string) *MyTradesWsRequest {
+	s.symbol = symbol
+	return s
+}
+
+// OrderID set orderId
+func (s *MyTradesWsRequest) OrderID(orderId int64) *MyTradesWsRequest {
+	s.orderId = &orderId
+	return s
+}
+
+// StartTime set startTime
+func (s *MyTradesWsRequest) StartTime(startTime int64) *MyTradesWsRequest {
+	s.startTime = &startTime
+	return s
+}
+
+// EndTime set endTime
+func (s *MyTradesWsRequest) EndTime(endTime int64) *MyTradesWsRequest {
+	s.endTime = &endTime
+	return s
+}
+
+// FromID set fromId
+func (s *MyTradesWsRequest) FromID(fromId int64) *MyTradesWsRequest {
+	s.fromId = &fromId
+	return s
+}
+
+// Limit set limit
+func (s *MyTradesWsRequest) Limit(limit int) *MyTradesWsRequest {
+	s.limit = &limit
+	return s
+}
+
+// RecvWindow set recvWindow
+func (s *MyTradesWsRequest) RecvWindow(recvWindow uint16) *MyTradesWsRequest {
+	s.recvWindow = &recvWindow
+	return s
+}
+
+// MyTradesWsResponse define 'myTrades' websocket API response
+type MyTradesWsResponse struct {
+	Id     string     `json:"id"`
+	Status int        `json:"status"`
+	Result []*TradeV3 `json:"result"`
+
+	// error response
+	Error *common.APIError `json:"error,omitempty"`
+}
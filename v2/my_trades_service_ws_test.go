@@ -0,0 +1,96 @@
+package binance
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/adshao/go-binance/v2/common/websocket"
+	"github.com/adshao/go-binance/v2/common/websocket/mock"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/suite"
+)
+
+type myTradesServiceWsTestSuite struct {
+	suite.Suite
+
+	ctrl   *gomock.Controller
+	client *mock.MockClient
+
+	requestID string
+
+	service *MyTradesWsService
+	request *MyTradesWsRequest
+}
+
+func (s *myTradesServiceWsTestSuite) SetupTest() {
+	s.requestID = "e2a85d9f-07a5-4f94-8d5f-789dc3deb098"
+
+	s.ctrl = gomock.NewController(s.T())
+	s.client = mock.NewMockClient(s.ctrl)
+
+	s.service = &MyTradesWsService{
+		c:         s.client,
+		ApiKey:    "dummyApiKey",
+		SecretKey: "dummySecretKey",
+		KeyType:   "HMAC",
+	}
+
+	s.request = NewMyTradesWsRequest().Symbol("BTCUSDT").OrderID(12569099453).Limit(10)
+}
+
+func (s *myTradesServiceWsTestSuite) TearDownTest() {
+	s.ctrl.Finish()
+}
+
+func TestMyTradesWsService(t *testing.T) {
+	suite.Run(t, new(myTradesServiceWsTestSuite))
+}
+
+func (s *myTradesServiceWsTestSuite) TestDo() {
+	var data []byte
+	s.client.EXPECT().Write(s.requestID, gomock.Any()).DoAndReturn(func(id string, raw []byte) error {
+		data = raw
+		return nil
+	}).Times(1)
+
+	err := s.service.Do(s.requestID, s.request)
+	s.Require().NoError(err)
+
+	req := websocket.WsApiRequest{}
+	s.Require().NoError(json.Unmarshal(data, &req))
+	s.Equal(s.requestID, req.Id)
+	s.Equal(websocket.WsApiMethodType("myTrades"), req.Method)
+	for k, v := range map[string]interface{}{"symbol": "BTCUSDT", "orderId": float64(12569099453), "limit": float64(10)} {
+		s.Equal(v, req.Params[k], k)
+	}
+	s.Contains(req.Params, "signature")
+}
+
+func (s *myTradesServiceWsTestSuite) TestDo_EmptyApiKey() {
+	s.service.ApiKey = ""
+	s.client.EXPECT().Write(gomock.Any(), gomock.Any()).Times(0)
+
+	err := s.service.Do(s.requestID, s.request)
+	s.ErrorIs(err, websocket.ErrorApiKeyIsNotSet)
+}
+
+func (s *myTradesServiceWsTestSuite) TestSyncDo() {
+	rawResponseData := []byte(fmt.Sprintf(`{"id": "%s", "status": 200, "result": [{"symbol": "BTCUSDT", "id": 1650422481, "orderId": 12569099453, "price": "23416.10", "qty": "0.00635", "commission": "0.000", "commissionAsset": "BNB", "time": 1660801715793, "isBuyer": false}]}`, s.requestID))
+	s.client.EXPECT().WriteSync(s.requestID, gomock.Any(), gomock.Any()).Return(rawResponseData, nil).Times(1)
+
+	response, err := s.service.SyncDo(s.requestID, s.request)
+	s.Require().NoError(err)
+	s.Equal(s.requestID, response.Id)
+	s.Require().Len(response.Result, 1)
+	s.Equal(int64(1650422481), response.Result[0].ID)
+	s.Equal("BNB", response.Result[0].CommissionAsset)
+}
+
+func (s *myTradesServiceWsTestSuite) TestSyncDo_EmptyRequestID() {
+	s.client.EXPECT().WriteSync(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+	response, err := s.service.SyncDo("", s.request)
+	s.Nil(response)
+	s.ErrorIs(err, websocket.ErrorRequestIDNotSet)
+}
@@ -0,0 +1,178 @@
+package binance
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/adshao/go-binance/v2/common"
+	"github.com/adshao/go-binance/v2/common/websocket"
+)
+
+// OpenOrdersCancelAllWsService cancels all open orders of a symbol
+type OpenOrdersCancelAllWsService struct {
+	c          websocket.Client
+	ApiKey     string
+	SecretKey  string
+	KeyType    string
+	Signer     common.Signer       // signs requests instead of SecretKey when set
+	signers    *common.SignerCache // parses SecretKey once
+	TimeOffset int64
+}
+
+// NewOpenOrdersCancelAllWsService init OpenOrdersCancelAllWsService
+func NewOpenOrdersCancelAllWsService(apiKey, secretKey string) (*OpenOrdersCancelAllWsService, error) {
+	conn, err := websocket.NewConnection(WsApiInitReadWriteConn, WebsocketKeepalive, WebsocketTimeoutReadWriteConnection)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := websocket.NewClient(conn)
+	if err != nil {
+		return nil, err
+	}
+
+	return &OpenOrdersCancelAllWsService{
+		c:         client,
+		ApiKey:    apiKey,
+		SecretKey: secretKey,
+		KeyType:   common.KeyTypeHmac,
+		signers:   new(common.SignerCache),
+	}, nil
+}
+
+// OpenOrdersCancelAllWsRequest parameters for 'openOrders.cancelAll' websocket API
+type OpenOrdersCancelAllWsRequest struct {
+	symbol     string
+	recvWindow *uint16
+}
+
+// NewOpenOrdersCancelAllWsRequest init OpenOrdersCancelAllWsRequest
+func NewOpenOrdersCancelAllWsRequest() *OpenOrdersCancelAllWsRequest {
+	return &OpenOrdersCancelAllWsRequest{}
+}
+
+func (s *OpenOrdersCancelAllWsRequest) GetParams() map[string]interface{} {
+	return s.buildParams()
+}
+
+// buildParams builds params
+func (s *OpenOrdersCancelAllWsRequest) buildParams() params {
+	m := params{
+		"symbol": s.symbol,
+	}
+	if s.recvWindow != nil {
+		m["recvWindow"] = *s.recvWindow
+	}
+	return m
+}
+
+// Do - sends 'openOrders.cancelAll' request
+func (s *OpenOrdersCancelAllWsService) Do(requestID string, request *OpenOrdersCancelAllWsRequest) error {
+	rawData, err := websocket.CreateRequest(
+		websocket.NewRequestData(
+			requestID,
+			s.ApiKey,
+			s.SecretKey,
+			s.TimeOffset,
+			s.KeyType,
+		).WithSigner(s.Signer).WithSignerCache(s.signers),
+		websocket.OpenOrdersCancelAllSpotWsApiMethod,
+		request.buildParams(),
+	)
+	if err != nil {
+		return err
+	}
+
+	if err := s.c.Write(requestID, rawData); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// SyncDo - sends 'openOrders.cancelAll' request and receives response
+func (s *OpenOrdersCancelAllWsService) SyncDo(requestID string, request *OpenOrdersCancelAllWsRequest) (*OpenOrdersCancelAllWsResponse, error) {
+	rawData, err := websocket.CreateRequest(
+		websocket.NewRequestData(
+			requestID,
+			s.ApiKey,
+			s.SecretKey,
+			s.TimeOffset,
+			s.KeyType,
+		).WithSigner(s.Signer).WithSignerCache(s.signers),
+		websocket.OpenOrdersCancelAllSpotWsApiMethod,
+		request.buildParams(),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := s.c.WriteSync(requestID, rawData, websocket.WriteSyncWsTimeout)
+	if err != nil {
+		return nil, err
+	}
+
+	openOrdersCancelAllWsResponse := &OpenOrdersCancelAllWsResponse{}
+	if err := json.Unmarshal(response, openOrdersCancelAllWsResponse); err != nil {
+		return nil, err
+	}
+
+	return openOrdersCancelAllWsResponse, nil
+}
+
+// ReceiveAllDataBeforeStop waits until all responses will be received from websocket until timeout expired
+func (s *OpenOrdersCancelAllWsService) ReceiveAllDataBeforeStop(timeout time.Duration) {
+	s.c.Wait(timeout)
+}
+
+// GetReadChannel returns channel with API response data (including API errors)
+func (s *OpenOrdersCancelAllWsService) GetReadChannel() <-chan []byte {
+	return s.c.GetReadChannel()
+}
+
+// GetReadErrorChannel returns channel with errors which are occurred while reading websocket connection
+func (s *OpenOrdersCancelAllWsService) GetReadErrorChannel() <-chan error {
+	return s.c.GetReadErrorChannel()
+}
+
+// GetReconnectCount returns count of reconnect attempts by client
+func (s *OpenOrdersCancelAllWsService) GetReconnectCount() int64 {
+	return s.c.GetReconnectCount()
+}
+
+// Symbol set symbol
+func (s *OpenOrdersCancelAllWsRequest) Symbol(symbol string) *OpenOrdersCancelAllWsRequest {
+	s.symbol = symbol
+	return s
+}
+
+// RecvWindow set recvWindow
+func (s *OpenOrdersCancelAllWsRequest) RecvWindow(recvWindow uint16) *OpenOrdersCancelAllWsRequest {
+	s.recvWindow = &recvWindow
+	return s
+}
+
+// OpenOrdersCancelAllWsResponse define 'openOrders.cancelAll' websocket API response
+type OpenOrdersCancelAllWsResponse struct {
+	Id     string                    `json:"id"`
+	Status int                       `json:"status"`
+	Result *CancelOpenOrdersResponse `json:"result"`
+
+	// error response
+	Error *common.APIError `json:"error,omitempty"`
+}
+
+// UnmarshalJSON decode the response and its result
+func (r *OpenOrdersCancelAllWsResponse) UnmarshalJSON(data []byte) error {
+	raw := wsApiRawResponse{}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+	r.Id, r.Status, r.Error = raw.Id, raw.Status, raw.Error
+	if raw.isEmpty() {
+		return nil
+	}
+	var err error
+	r.Result, err = parseCancelOpenOrdersResponse(raw.Result)
+	return err
+}
@@ -0,0 +1,98 @@
+package binance
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/adshao/go-binance/v2/common/websocket"
+	"github.com/adshao/go-binance/v2/common/websocket/mock"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/suite"
+)
+
+type openOrdersCancelAllServiceWsTestSuite struct {
+	suite.Suite
+
+	ctrl   *gomock.Controller
+	client *mock.MockClient
+
+	requestID string
+
+	service *OpenOrdersCancelAllWsService
+	request *OpenOrdersCancelAllWsRequest
+}
+
+func (s *openOrdersCancelAllServiceWsTestSuite) SetupTest() {
+	s.requestID = "e2a85d9f-07a5-4f94-8d5f-789dc3deb098"
+
+	s.ctrl = gomock.NewController(s.T())
+	s.client = mock.NewMockClient(s.ctrl)
+
+	s.service = &OpenOrdersCancelAllWsService{
+		c:         s.client,
+		ApiKey:    "dummyApiKey",
+		SecretKey: "dummySecretKey",
+		KeyType:   "HMAC",
+	}
+
+	s.request = NewOpenOrdersCancelAllWsRequest().Symbol("BTCUSDT").RecvWindow(5000)
+}
+
+func (s *openOrdersCancelAllServiceWsTestSuite) TearDownTest() {
+	s.ctrl.Finish()
+}
+
+func TestOpenOrdersCancelAllWsService(t *testing.T) {
+	suite.Run(t, new(openOrdersCancelAllServiceWsTestSuite))
+}
+
+func (s *openOrdersCancelAllServiceWsTestSuite) TestDo() {
+	var data []byte
+	s.client.EXPECT().Write(s.requestID, gomock.Any()).DoAndReturn(func(id string, raw []byte) error {
+		data = raw
+		return nil
+	}).Times(1)
+
+	err := s.service.Do(s.requestID, s.request)
+	s.Require().NoError(err)
+
+	req := websocket.WsApiRequest{}
+	s.Require().NoError(json.Unmarshal(data, &req))
+	s.Equal(s.requestID, req.Id)
+	s.Equal(websocket.WsApiMethodType("openOrders.cancelAll"), req.Method)
+	for k, v := range map[string]interface{}{"symbol": "BTCUSDT", "recvWindow": float64(5000)} {
+		s.Equal(v, req.Params[k], k)
+	}
+	s.Contains(req.Params, "signature")
+}
+
+func (s *openOrdersCancelAllServiceWsTestSuite) TestDo_EmptyApiKey() {
+	s.service.ApiKey = ""
+	s.client.EXPECT().Write(gomock.Any(), gomock.Any()).Times(0)
+
+	err := s.service.Do(s.requestID, s.request)
+	s.ErrorIs(err, websocket.ErrorApiKeyIsNotSet)
+}
+
+func (s *openOrdersCancelAllServiceWsTestSuite) TestSyncDo() {
+	rawResponseData := []byte(fmt.Sprintf(`{"id": "%s", "status": 200, "result": [{"symbol": "BTCUSDT", "orderId": 12345, "orderListId": -1, "status": "CANCELED"}, {"orderListId": 19431, "contingencyType": "OCO", "symbol": "BTCUSDT", "orders": [{"symbol": "BTCUSDT", "orderId": 12346}]}]}`, s.requestID))
+	s.client.EXPECT().WriteSync(s.requestID, gomock.Any(), gomock.Any()).Return(rawResponseData, nil).Times(1)
+
+	response, err := s.service.SyncDo(s.requestID, s.request)
+	s.Require().NoError(err)
+	s.Equal(s.requestID, response.Id)
+	s.Require().Len(response.Result.Orders, 1)
+	s.Equal(int64(12345), response.Result.Orders[0].OrderID)
+	s.Equal(OrderStatusTypeCanceled, response.Result.Orders[0].Status)
+	s.Require().Len(response.Result.OCOOrders, 1)
+	s.Equal(int64(19431), response.Result.OCOOrders[0].OrderListID)
+}
+
+func (s *openOrdersCancelAllServiceWsTestSuite) TestSyncDo_EmptyRequestID() {
+	s.client.EXPECT().WriteSync(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+	response, err := s.service.SyncDo("", s.request)
+	s.Nil(response)
+	s.ErrorIs(err, websocket.ErrorRequestIDNotSet)
+}
@@ -0,0 +1,173 @@
+// Package analytics implements option analytics over the options package: Black-76
+// prices and Greeks, implied volatility, an implied volatility surface interpolated by
+// expiry and strike, portfolio Greeks and a live surface tracker.
+//
+// Greeks are computed against the forward F = S * exp(r * T) of the underlying index S.
+// Following the exchange conventions, Vega is the price change for one volatility point
+// (0.01) and Theta is the price change for one calendar day.
+package analytics
+
+import (
+	"errors"
+	"math"
+	"time"
+
+	"github.com/adshao/go-binance/v2/options"
+)
+
+const (
+	daysPerYear  = 365
+	minVol       = 1e-4
+	maxVol       = 10.0
+	volTolerance = 1e-8
+	maxIteration = 100
+)
+
+// ErrNoImpliedVol is returned when a price is outside the no-arbitrage bounds of the option
+var ErrNoImpliedVol = errors.New("analytics: price has no implied volatility")
+
+// Greeks define the sensitivities of an option price
+type Greeks struct {
+	Delta float64
+	Gamma float64
+	Vega  float64 // per volatility point
+	Theta float64 // per calendar day
+}
+
+// Add return the sum of g and o
+func (g Greeks) Add(o Greeks) Greeks {
+	return Greeks{
+		Delta: g.Delta + o.Delta,
+		Gamma: g.Gamma + o.Gamma,
+		Vega:  g.Vega + o.Vega,
+		Theta: g.Theta + o.Theta,
+	}
+}
+
+// Scale return g multiplied by quantity
+func (g Greeks) Scale(quantity float64) Greeks {
+	return Greeks{
+		Delta: g.Delta * quantity,
+		Gamma: g.Gamma * quantity,
+		Vega:  g.Vega * quantity,
+		Theta: g.Theta * quantity,
+	}
+}
+
+// YearFraction return the time from now to expiry in years, it is never negative
+func YearFraction(now, expiry time.Time) float64 {
+	t := expiry.Sub(now).Hours() / 24 / daysPerYear
+	if t < 0 {
+		return 0
+	}
+	return t
+}
+
+// Forward return the forward of the underlying price spot with the rate r over t years
+func Forward(spot, r, t float64) float64 {
+	return spot * math.Exp(r*t)
+}
+
+func normCDF(x float64) float64 {
+	return 0.5 * math.Erfc(-x/math.Sqrt2)
+}
+
+func normPDF(x float64) float64 {
+	return math.Exp(-x*x/2) / math.Sqrt(2*math.Pi)
+}
+
+func d1d2(forward, strike, t, vol float64) (d1, d2 float64) {
+	sd := vol * math.Sqrt(t)
+	d1 = (math.Log(forward/strike) + sd*sd/2) / sd
+	return d1, d1 - sd
+}
+
+func isCall(side options.OptionSideType) bool {
+	return side == options.OptionSideTypeCall
+}
+
+// Price return the Black-76 price of an option of side on forward, with strike, t years
+// to expiry, rate r and volatility vol
+func Price(side options.OptionSideType, forward, strike, t, r, vol float64) float64 {
+	df := math.Exp(-r * t)
+	if t <= 0 || vol <= 0 {
+		if isCall(side) {
+			return df * math.Max(forward-strike, 0)
+		}
+		return df * math.Max(strike-forward, 0)
+	}
+	d1, d2 := d1d2(forward, strike, t, vol)
+	if isCall(side) {
+		return df * (forward*normCDF(d1) - strike*normCDF(d2))
+	}
+	return df * (strike*normCDF(-d2) - forward*normCDF(-d1))
+}
+
+// ComputeGreeks return the Black-76 Greeks of an option, see Price for the parameters.
+// Expired options have no Greeks but the delta of their intrinsic value.
+func ComputeGreeks(side options.OptionSideType, forward, strike, t, r, vol float64) Greeks {
+	df := math.Exp(-r * t)
+	if t <= 0 || vol <= 0 {
+		switch {
+		case isCall(side) && forward > strike:
+			return Greeks{Delta: df}
+		case !isCall(side) && forward < strike:
+			return Greeks{Delta: -df}
+		}
+		return Greeks{}
+	}
+	sqrtT := math.Sqrt(t)
+	d1, _ := d1d2(forward, strike, t, vol)
+	pdf := normPDF(d1)
+	g := Greeks{
+		Gamma: df * pdf / (forward * vol * sqrtT),
+		Vega:  df * forward * pdf * sqrtT / 100,
+	}
+	theta := -df*forward*pdf*vol/(2*sqrtT) + r*Price(side, forward, strike, t, r, vol)
+	g.Theta = theta / daysPerYear
+	if isCall(side) {
+		g.Delta = df * normCDF(d1)
+	} else {
+		g.Delta = -df * normCDF(-d1)
+	}
+	return g
+}
+
+// ImpliedVol return the Black-76 volatility matching price, see Price for the parameters.
+// It returns ErrNoImpliedVol if price is outside the bounds of the option value.
+func ImpliedVol(side options.OptionSideType, price, forward, strike, t, r float64) (float64, error) {
+	if t <= 0 || forward <= 0 || strike <= 0 {
+		return 0, ErrNoImpliedVol
+	}
+	lower := Price(side, forward, strike, t, r, 0)
+	upper := Price(side, forward, strike, t, r, maxVol)
+	if price <= lower || price >= upper {
+		return 0, ErrNoImpliedVol
+	}
+	// Newton iterations safeguarded by bisection, the price is increasing in vol
+	lo, hi := minVol, maxVol
+	vol := 0.5
+	for i := 0; i < maxIteration; i++ {
+		diff := Price(side, forward, strike, t, r, vol) - price
+		if math.Abs(diff) < volTolerance {
+			return vol, nil
+		}
+		if diff > 0 {
+			hi = vol
+		} else {
+			lo = vol
+		}
+		next := vol
+		if vega := ComputeGreeks(side, forward, strike, t, r, vol).Vega * 100; vega > 0 {
+			next = vol - diff/vega
+		}
+		if next <= lo || next >= hi || next == vol {
+			next = (lo + hi) / 2
+		}
+		vol = next
+		if hi-lo < volTolerance {
+			return vol, nil
+		}
+	}
+	return vol, nil
+}
@@ -0,0 +1,78 @@
+package analytics
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/adshao/go-binance/v2/options"
+)
+
+func TestPrice(t *testing.T) {
+	// Hull, Options, Futures and Other Derivatives, example 18.6
+	put := Price(options.OptionSideTypePut, 20, 20, 4.0/12, 0.09, 0.25)
+	assert.InDelta(t, 1.12, put, 0.005)
+
+	// put-call parity: C - P = df * (F - K)
+	f, k, years, r, vol := 30000.0, 32000.0, 0.25, 0.03, 0.6
+	call := Price(options.OptionSideTypeCall, f, k, years, r, vol)
+	put = Price(options.OptionSideTypePut, f, k, years, r, vol)
+	assert.InDelta(t, math.Exp(-r*years)*(f-k), call-put, 1e-8)
+
+	// expired options are worth their intrinsic value
+	assert.Equal(t, 100.0, Price(options.OptionSideTypeCall, 1100, 1000, 0, 0, 0.5))
+	assert.Equal(t, 0.0, Price(options.OptionSideTypePut, 1100, 1000, 0, 0, 0.5))
+}
+
+func TestComputeGreeks(t *testing.T) {
+	f, k, years, r, vol := 30000.0, 32000.0, 0.25, 0.03, 0.6
+	for _, side := range []options.OptionSideType{options.OptionSideTypeCall, options.OptionSideTypePut} {
+		g := ComputeGreeks(side, f, k, years, r, vol)
+		price := func(f, years, vol float64) float64 { return Price(side, f, k, years, r, vol) }
+		// finite differences
+		h := 1.0
+		assert.InDelta(t, (price(f+h, years, vol)-price(f-h, years, vol))/(2*h), g.Delta, 1e-6, side)
+		assert.InDelta(t, (price(f+h, years, vol)-2*price(f, years, vol)+price(f-h, years, vol))/(h*h), g.Gamma, 1e-6, side)
+		assert.InDelta(t, (price(f, years, vol+1e-4)-price(f, years, vol-1e-4))/2e-4/100, g.Vega, 1e-4, side)
+		day := 1.0 / daysPerYear
+		assert.InDelta(t, price(f, years-day, vol)-price(f, years, vol), g.Theta, math.Abs(g.Theta)*0.01, side)
+	}
+	assert.Equal(t, Greeks{Delta: 1}, ComputeGreeks(options.OptionSideTypeCall, 1100, 1000, 0, 0, 0.5))
+	assert.Equal(t, Greeks{}, ComputeGreeks(options.OptionSideTypePut, 1100, 1000, 0, 0, 0.5))
+}
+
+func TestImpliedVol(t *testing.T) {
+	for _, vol := range []float64{0.05, 0.3, 0.8, 2.5} {
+		for _, k := range []float64{20000, 30000, 45000} {
+			for _, side := range []options.OptionSideType{options.OptionSideTypeCall, options.OptionSideTypePut} {
+				price := Price(side, 30000, k, 0.1, 0.01, vol)
+				// no time value left to imply a volatility from
+				if price-Price(side, 30000, k, 0.1, 0.01, 0) < 1e-6 {
+					continue
+				}
+				iv, err := ImpliedVol(side, price, 30000, k, 0.1, 0.01)
+				require.NoError(t, err)
+				assert.InDelta(t, vol, iv, 1e-5, "side=%s, strike=%v, vol=%v", side, k, vol)
+			}
+		}
+	}
+
+	// below the intrinsic value
+	_, err := ImpliedVol(options.OptionSideTypeCall, 50, 1100, 1000, 0.1, 0)
+	assert.ErrorIs(t, err, ErrNoImpliedVol)
+	// above the forward
+	_, err = ImpliedVol(options.OptionSideTypeCall, 1200, 1100, 1000, 0.1, 0)
+	assert.ErrorIs(t, err, ErrNoImpliedVol)
+	// expired
+	_, err = ImpliedVol(options.OptionSideTypeCall, 150, 1100, 1000, 0, 0)
+	assert.ErrorIs(t, err, ErrNoImpliedVol)
+}
+
+func TestYearFraction(t *testing.T) {
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	assert.Equal(t, 1.0, YearFraction(now, now.AddDate(0, 0, daysPerYear)))
+	assert.Equal(t, 0.0, YearFraction(now, now.Add(-time.Hour)))
+}
@@ -0,0 +1,199 @@
+package analytics
+
+import (
+	"errors"
+	"math"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/adshao/go-binance/v2/options"
+)
+
+// ErrEmptySurface is returned when a surface has no unexpired point
+var ErrEmptySurface = errors.New("analytics: empty volatility surface")
+
+// Contract define the terms of an option symbol
+type Contract struct {
+	Symbol     string
+	Underlying string // index symbol, e.g. BTCUSDT
+	QuoteAsset string
+	Side       options.OptionSideType
+	Strike     float64
+	Expiry     time.Time
+	Unit       float64 // underlying quantity of one contract
+}
+
+// NewContract init the contract of an exchange info option symbol
+func NewContract(symbol options.OptionSymbol) (Contract, error) {
+	strike, err := strconv.ParseFloat(symbol.StrikePrice, 64)
+	if err != nil {
+		return Contract{}, err
+	}
+	unit := float64(symbol.Unit)
+	if unit == 0 {
+		unit = 1
+	}
+	return Contract{
+		Symbol:     symbol.Symbol,
+		Underlying: symbol.Underlying,
+		QuoteAsset: symbol.QuoteAsset,
+		Side:       options.OptionSideType(symbol.Side),
+		Strike:     strike,
+		Expiry:     time.UnixMilli(symbol.ExpiryDate),
+		Unit:       unit,
+	}, nil
+}
+
+// NewContracts init the contracts of all the option symbols of info
+func NewContracts(info *options.ExchangeInfo) ([]Contract, error) {
+	contracts := make([]Contract, 0, len(info.OptionSymbols))
+	for _, symbol := range info.OptionSymbols {
+		c, err := NewContract(symbol)
+		if err != nil {
+			return nil, err
+		}
+		contracts = append(contracts, c)
+	}
+	return contracts, nil
+}
+
+// Point define the implied volatility of a contract
+type Point struct {
+	Contract
+	Vol  float64
+	Time time.Time // time of the price the volatility was implied from
+}
+
+// Surface define the implied volatility surface of an underlying, it is safe for
+// concurrent use. Volatilities are interpolated linearly in strike within an expiry and
+// linearly in total variance across expiries, they are extrapolated flat.
+type Surface struct {
+	mu     sync.RWMutex
+	points map[string]Point
+}
+
+// NewSurface init an empty surface
+func NewSurface() *Surface {
+	return &Surface{points: make(map[string]Point)}
+}
+
+// Set set the volatility of a contract, replacing the previous one
+func (s *Surface) Set(p Point) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.points[p.Symbol] = p
+}
+
+// Remove remove the volatility of a contract
+func (s *Surface) Remove(symbol string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.points, symbol)
+}
+
+// Point return the volatility of a contract
+func (s *Surface) Point(symbol string) (Point, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	p, ok := s.points[symbol]
+	return p, ok
+}
+
+// Points return all the points ordered by expiry, strike and symbol
+func (s *Surface) Points() []Point {
+	s.mu.RLock()
+	points := make([]Point, 0, len(s.points))
+	for _, p := range s.points {
+		points = append(points, p)
+	}
+	s.mu.RUnlock()
+	sort.Slice(points, func(i, j int) bool {
+		a, b := points[i], points[j]
+		if !a.Expiry.Equal(b.Expiry) {
+			return a.Expiry.Before(b.Expiry)
+		}
+		if a.Strike != b.Strike {
+			return a.Strike < b.Strike
+		}
+		return a.Symbol < b.Symbol
+	})
+	return points
+}
+
+type smilePoint struct {
+	strike float64
+	vol    float64
+}
+
+type smile struct {
+	expiry time.Time
+	points []smilePoint
+}
+
+// vol interpolate the smile linearly in strike
+func (sm smile) vol(strike float64) float64 {
+	pts := sm.points
+	i := sort.Search(len(pts), func(i int) bool { return pts[i].strike >= strike })
+	switch {
+	case i == 0:
+		return pts[0].vol
+	case i == len(pts):
+		return pts[len(pts)-1].vol
+	}
+	lo, hi := pts[i-1], pts[i]
+	return lo.vol + (hi.vol-lo.vol)*(strike-lo.strike)/(hi.strike-lo.strike)
+}
+
+// smiles group the points expiring after now by expiry, calls and puts of the same
+// strike are averaged
+func (s *Surface) smiles(now time.Time) []smile {
+	type key struct {
+		expiry int64
+		strike float64
+	}
+	sums := make(map[key][2]float64)
+	for _, p := range s.Points() {
+		if !p.Expiry.After(now) {
+			continue
+		}
+		k := key{p.Expiry.UnixMilli(), p.Strike}
+		sum := sums[k]
+		sums[k] = [2]float64{sum[0] + p.Vol, sum[1] + 1}
+	}
+	byExpiry := make(map[int64][]smilePoint)
+	for k, sum := range sums {
+		byExpiry[k.expiry] = append(byExpiry[k.expiry], smilePoint{strike: k.strike, vol: sum[0] / sum[1]})
+	}
+	smiles := make([]smile, 0, len(byExpiry))
+	for expiry, pts := range byExpiry {
+		sort.Slice(pts, func(i, j int) bool { return pts[i].strike < pts[j].strike })
+		smiles = append(smiles, smile{expiry: time.UnixMilli(expiry), points: pts})
+	}
+	sort.Slice(smiles, func(i, j int) bool { return smiles[i].expiry.Before(smiles[j].expiry) })
+	return smiles
+}
+
+// Vol return the volatility interpolated at expiry and strike as of now
+func (s *Surface) Vol(now, expiry time.Time, strike float64) (float64, error) {
+	smiles := s.smiles(now)
+	if len(smiles) == 0 {
+		return 0, ErrEmptySurface
+	}
+	i := sort.Search(len(smiles), func(i int) bool { return !smiles[i].expiry.Before(expiry) })
+	switch {
+	case i == 0:
+		return smiles[0].vol(strike), nil
+	case i == len(smiles):
+		return smiles[len(smiles)-1].vol(strike), nil
+	case smiles[i].expiry.Equal(expiry):
+		return smiles[i].vol(strike), nil
+	}
+	lo, hi := smiles[i-1], smiles[i]
+	t, t1, t2 := YearFraction(now, expiry), YearFraction(now, lo.expiry), YearFraction(now, hi.expiry)
+	v1, v2 := lo.vol(strike), hi.vol(strike)
+	w1, w2 := v1*v1*t1, v2*v2*t2
+	w := w1 + (w2-w1)*(t-t1)/(t2-t1)
+	return math.Sqrt(w / t), nil
+}
@@ -0,0 +1,86 @@
+package analytics
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/adshao/go-binance/v2/options"
+)
+
+func TestNewContracts(t *testing.T) {
+	contracts, err := NewContracts(&options.ExchangeInfo{
+		OptionSymbols: []options.OptionSymbol{
+			{
+				Symbol:      "BTC-240628-60000-C",
+				Side:        "CALL",
+				StrikePrice: "60000.000",
+				Underlying:  "BTCUSDT",
+				QuoteAsset:  "USDT",
+				Unit:        1,
+				ExpiryDate:  1719561600000,
+			},
+		},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []Contract{
+		{
+			Symbol:     "BTC-240628-60000-C",
+			Underlying: "BTCUSDT",
+			QuoteAsset: "USDT",
+			Side:       options.OptionSideTypeCall,
+			Strike:     60000,
+			Expiry:     time.UnixMilli(1719561600000),
+			Unit:       1,
+		},
+	}, contracts)
+
+	_, err = NewContracts(&options.ExchangeInfo{OptionSymbols: []options.OptionSymbol{{StrikePrice: "x"}}})
+	assert.Error(t, err)
+}
+
+func TestSurfaceVol(t *testing.T) {
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	near, far := now.AddDate(0, 0, 30), now.AddDate(0, 0, 90)
+	point := func(symbol string, side options.OptionSideType, expiry time.Time, strike, vol float64) Point {
+		return Point{Contract: Contract{Symbol: symbol, Side: side, Expiry: expiry, Strike: strike}, Vol: vol}
+	}
+
+	s := NewSurface()
+	_, err := s.Vol(now, near, 100)
+	assert.ErrorIs(t, err, ErrEmptySurface)
+
+	s.Set(point("N-90-C", options.OptionSideTypeCall, near, 90, 0.6))
+	s.Set(point("N-110-C", options.OptionSideTypeCall, near, 110, 0.4))
+	s.Set(point("N-110-P", options.OptionSideTypePut, near, 110, 0.5))
+	s.Set(point("F-100-C", options.OptionSideTypeCall, far, 100, 0.3))
+	s.Set(point("OLD-100-C", options.OptionSideTypeCall, now.Add(-time.Hour), 100, 5))
+
+	vol := func(expiry time.Time, strike float64) float64 {
+		v, err := s.Vol(now, expiry, strike)
+		require.NoError(t, err)
+		return v
+	}
+	// calls and puts of a strike are averaged, strikes are interpolated linearly
+	assert.InDelta(t, 0.45, vol(near, 110), 1e-12)
+	assert.InDelta(t, 0.525, vol(near, 100), 1e-12)
+	// flat extrapolation in strike and expiry, expired points are ignored
+	assert.InDelta(t, 0.6, vol(near, 50), 1e-12)
+	assert.InDelta(t, 0.45, vol(near, 200), 1e-12)
+	assert.InDelta(t, 0.525, vol(now.AddDate(0, 0, 1), 100), 1e-12)
+	assert.InDelta(t, 0.3, vol(now.AddDate(1, 0, 0), 100), 1e-12)
+	// linear in total variance across expiries
+	mid := now.AddDate(0, 0, 60)
+	w := (0.525*0.525*30 + 0.3*0.3*90) / 2
+	assert.InDelta(t, math.Sqrt(w/60), vol(mid, 100), 1e-12)
+
+	s.Remove("F-100-C")
+	assert.InDelta(t, 0.525, vol(mid, 100), 1e-12)
+	points := s.Points()
+	require.Len(t, points, 4)
+	assert.Equal(t, "OLD-100-C", points[0].Symbol)
+	assert.Equal(t, "N-110-C", points[2].Symbol)
+}
@@ -0,0 +1,366 @@
+package analytics
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/adshao/go-binance/v2/options"
+)
+
+// ErrUnknownContract is returned for a symbol the tracker has no contract of
+var ErrUnknownContract = errors.New("analytics: unknown contract")
+
+// ErrNoIndexPrice is returned when the index price of an underlying is not known yet
+var ErrNoIndexPrice = errors.New("analytics: no index price")
+
+// UpdateHandler handle the points updated by a mark price event
+type UpdateHandler func(underlying string, points []Point)
+
+// Tracker maintain the live implied volatility surfaces of underlyings. The surfaces are
+// seeded with the exchange mark volatilities of MarkService, then implied locally from
+// the mark prices of WsMarkPriceServe and the index prices of WsIndexServe. It is safe
+// for concurrent use.
+type Tracker struct {
+	mu        sync.RWMutex
+	contracts map[string]Contract
+	surfaces  map[string]*Surface
+	index     map[string]float64
+	rates     map[string]float64
+	handler   UpdateHandler
+	now       func() time.Time
+}
+
+// NewTracker init a tracker of contracts
+func NewTracker(contracts []Contract) *Tracker {
+	t := &Tracker{
+		contracts: make(map[string]Contract, len(contracts)),
+		surfaces:  make(map[string]*Surface),
+		index:     make(map[string]float64),
+		rates:     make(map[string]float64),
+		now:       time.Now,
+	}
+	for _, c := range contracts {
+		t.contracts[c.Symbol] = c
+		if _, ok := t.surfaces[c.Underlying]; !ok {
+			t.surfaces[c.Underlying] = NewSurface()
+		}
+	}
+	return t
+}
+
+// Load init a tracker of all the contracts of the exchange, seeded with the current
+// marks and index prices
+func Load(ctx context.Context, c *options.Client) (*Tracker, error) {
+	info, err := c.NewExchangeInfoService().Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	contracts, err := NewContracts(info)
+	if err != nil {
+		return nil, err
+	}
+	t := NewTracker(contracts)
+	marks, err := c.NewMarkService().Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := t.LoadMarks(marks); err != nil {
+		return nil, err
+	}
+	for _, underlying := range t.Underlyings() {
+		index, err := c.NewIndexService().Underlying(underlying).Do(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if err := t.SetIndexPrice(underlying, index.IndexPrice); err != nil {
+			return nil, err
+		}
+	}
+	return t, nil
+}
+
+// SetUpdateHandler set the handler of the points updated by mark price events
+func (t *Tracker) SetUpdateHandler(handler UpdateHandler) *Tracker {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.handler = handler
+	return t
+}
+
+// Contract return the contract of symbol
+func (t *Tracker) Contract(symbol string) (Contract, bool) {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	c, ok := t.contracts[symbol]
+	return c, ok
+}
+
+// Underlyings return the underlyings of the tracked contracts
+func (t *Tracker) Underlyings() []string {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	underlyings := make([]string, 0, len(t.surfaces))
+	for underlying := range t.surfaces {
+		underlyings = append(underlyings, underlying)
+	}
+	return underlyings
+}
+
+// Surface return the surface of underlying, e.g. BTCUSDT, or nil if no contract of the
+// underlying is tracked
+func (t *Tracker) Surface(underlying string) *Surface {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	return t.surfaces[underlying]
+}
+
+// SetRate set the risk free rate of underlying
+func (t *Tracker) SetRate(underlying string, r float64) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.rates[underlying] = r
+}
+
+// SetIndexPrice set the index price of underlying
+func (t *Tracker) SetIndexPrice(underlying, price string) error {
+	p, err := strconv.ParseFloat(price, 64)
+	if err != nil {
+		return err
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.index[underlying] = p
+	return nil
+}
+
+// IndexPrice return the index price of underlying
+func (t *Tracker) IndexPrice(underlying string) (float64, bool) {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	p, ok := t.index[underlying]
+	return p, ok
+}
+
+// LoadMarks set the exchange mark volatilities and risk free rates of the marks of
+// MarkService, marks of unknown contracts are ignored
+func (t *Tracker) LoadMarks(marks []*options.Mark) error {
+	now := t.now()
+	for _, m := range marks {
+		c, ok := t.Contract(m.Symbol)
+		if !ok {
+			continue
+		}
+		vol, err := strconv.ParseFloat(m.MarkIV, 64)
+		if err != nil {
+			return fmt.Errorf("analytics: mark volatility of %s: %w", m.Symbol, err)
+		}
+		if m.RiskFreeInterest != "" {
+			r, err := strconv.ParseFloat(m.RiskFreeInterest, 64)
+			if err != nil {
+				return fmt.Errorf("analytics: risk free interest of %s: %w", m.Symbol, err)
+			}
+			t.SetRate(c.Underlying, r)
+		}
+		t.Surface(c.Underlying).Set(Point{Contract: c, Vol: vol, Time: now})
+	}
+	return nil
+}
+
+// HandleIndex feeds an index price event, it is a options.WsIndexHandler
+func (t *Tracker) HandleIndex(event *options.WsIndexEvent) {
+	_ = t.SetIndexPrice(event.Symbol, event.Price)
+}
+
+// HandleMarkPrice implies the volatilities of the mark prices of events, it is a
+// options.WsMarkPriceHandler. Marks of unknown contracts, of underlyings without index
+// price and without implied volatility leave the surface unchanged.
+func (t *Tracker) HandleMarkPrice(events []*options.WsMarkPriceEvent) {
+	updated := make(map[string][]Point)
+	for _, e := range events {
+		p, err := t.impliedPoint(e)
+		if err != nil {
+			continue
+		}
+		t.Surface(p.Underlying).Set(p)
+		updated[p.Underlying] = append(updated[p.Underlying], p)
+	}
+	t.mu.RLock()
+	handler := t.handler
+	t.mu.RUnlock()
+	if handler == nil {
+		return
+	}
+	for underlying, points := range updated {
+		handler(underlying, points)
+	}
+}
+
+func (t *Tracker) impliedPoint(e *options.WsMarkPriceEvent) (Point, error) {
+	c, ok := t.Contract(e.Symbol)
+	if !ok {
+		return Point{}, ErrUnknownContract
+	}
+	price, err := strconv.ParseFloat(e.MarkPrice, 64)
+	if err != nil {
+		return Point{}, err
+	}
+	forward, years, r, err := t.forward(c)
+	if err != nil {
+		return Point{}, err
+	}
+	vol, err := ImpliedVol(c.Side, price, forward, c.Strike, years, r)
+	if err != nil {
+		return Point{}, err
+	}
+	return Point{Contract: c, Vol: vol, Time: time.UnixMilli(e.Time)}, nil
+}
+
+// forward return the forward, years to expiry and rate of a contract
+func (t *Tracker) forward(c Contract) (forward, years, r float64, err error) {
+	t.mu.RLock()
+	spot, ok := t.index[c.Underlying]
+	r = t.rates[c.Underlying]
+	t.mu.RUnlock()
+	if !ok {
+		return 0, 0, 0, ErrNoIndexPrice
+	}
+	years = YearFraction(t.now(), c.Expiry)
+	return Forward(spot, r, years), years, r, nil
+}
+
+// Vol return the volatility of symbol, the one of its point if any, else interpolated
+// on the surface of its underlying
+func (t *Tracker) Vol(symbol string) (float64, error) {
+	c, ok := t.Contract(symbol)
+	if !ok {
+		return 0, ErrUnknownContract
+	}
+	surface := t.Surface(c.Underlying)
+	if p, ok := surface.Point(symbol); ok {
+		return p.Vol, nil
+	}
+	return surface.Vol(t.now(), c.Expiry, c.Strike)
+}
+
+// Greeks return the Greeks of one unit of the underlying of symbol
+func (t *Tracker) Greeks(symbol string) (Greeks, error) {
+	c, ok := t.Contract(symbol)
+	if !ok {
+		return Greeks{}, ErrUnknownContract
+	}
+	vol, err := t.Vol(symbol)
+	if err != nil {
+		return Greeks{}, err
+	}
+	forward, years, r, err := t.forward(c)
+	if err != nil {
+		return Greeks{}, err
+	}
+	return ComputeGreeks(c.Side, forward, c.Strike, years, r, vol), nil
+}
+
+// PositionGreeks define the Greeks of a position
+type PositionGreeks struct {
+	Symbol     string
+	Underlying string
+	Quantity   float64 // negative when short
+	Greeks     Greeks
+}
+
+// PortfolioGreeks define the Greeks of positions, aggregated by underlying
+type PortfolioGreeks struct {
+	Positions   []PositionGreeks
+	Underlyings map[string]Greeks
+}
+
+// PortfolioGreeks compute the Greeks of the positions of PositionService
+func (t *Tracker) PortfolioGreeks(positions []*options.Position) (*PortfolioGreeks, error) {
+	res := &PortfolioGreeks{
+		Positions:   make([]PositionGreeks, 0, len(positions)),
+		Underlyings: make(map[string]Greeks),
+	}
+	for _, p := range positions {
+		quantity, err := strconv.ParseFloat(p.Quantity, 64)
+		if err != nil {
+			return nil, fmt.Errorf("analytics: quantity of %s: %w", p.Symbol, err)
+		}
+		quantity = math.Abs(quantity)
+		if strings.EqualFold(p.Side, string(options.PositionSideTypeShort)) {
+			quantity = -quantity
+		}
+		c, ok := t.Contract(p.Symbol)
+		if !ok {
+			return nil, fmt.Errorf("%w: %s", ErrUnknownContract, p.Symbol)
+		}
+		g, err := t.Greeks(p.Symbol)
+		if err != nil {
+			return nil, fmt.Errorf("analytics: greeks of %s: %w", p.Symbol, err)
+		}
+		g = g.Scale(quantity * c.Unit)
+		res.Positions = append(res.Positions, PositionGreeks{
+			Symbol:     p.Symbol,
+			Underlying: c.Underlying,
+			Quantity:   quantity,
+			Greeks:     g,
+		})
+		res.Underlyings[c.Underlying] = res.Underlyings[c.Underlying].Add(g)
+	}
+	return res, nil
+}
+
+// Serve track the live surface of underlying, e.g. BTCUSDT, serving its index price and
+// the mark prices of its options with the options of opts. Closing stopC stops both
+// streams, doneC is closed once both are stopped.
+func (t *Tracker) Serve(underlying string, errHandler options.ErrHandler, opts ...options.WsOptions) (doneC, stopC chan struct{}, err error) {
+	asset := t.baseAsset(underlying)
+	indexDoneC, indexStopC, err := options.WsIndexServe(underlying, t.HandleIndex, errHandler, opts...)
+	if err != nil {
+		return nil, nil, err
+	}
+	markDoneC, markStopC, err := options.WsMarkPriceServe(asset, t.HandleMarkPrice, errHandler, opts...)
+	if err != nil {
+		close(indexStopC)
+		return nil, nil, err
+	}
+	doneC = make(chan struct{})
+	stopC = make(chan struct{})
+	go func() {
+		defer close(doneC)
+		select {
+		case <-stopC:
+		case <-indexDoneC:
+		case <-markDoneC:
+		}
+		close(indexStopC)
+		close(markStopC)
+		<-indexDoneC
+		<-markDoneC
+	}()
+	return doneC, stopC, nil
+}
+
+// ServeContext is like Serve, both streams are stopped when ctx is done or the returned
+// stream is closed
+func (t *Tracker) ServeContext(ctx context.Context, underlying string, errHandler options.ErrHandler) (*options.Stream, error) {
+	return options.ServeStream(ctx, func(errHandler options.ErrHandler, opt options.WsOptions) (chan struct{}, chan struct{}, error) {
+		return t.Serve(underlying, errHandler, opt)
+	}, errHandler)
+}
+
+// baseAsset return the base asset of underlying, the mark price stream is named after it
+func (t *Tracker) baseAsset(underlying string) string {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	for _, c := range t.contracts {
+		if c.Underlying == underlying && c.QuoteAsset != "" {
+			return strings.TrimSuffix(underlying, c.QuoteAsset)
+		}
+	}
+	return underlying
+}
@@ -0,0 +1,153 @@
+package analytics
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/adshao/go-binance/v2/options"
+)
+
+type trackerTestSuite struct {
+	suite.Suite
+	t      *Tracker
+	now    time.Time
+	expiry time.Time
+}
+
+func TestTracker(t *testing.T) {
+	suite.Run(t, new(trackerTestSuite))
+}
+
+func (s *trackerTestSuite) SetupTest() {
+	s.now = time.Date(2024, 1, 1, 8, 0, 0, 0, time.UTC)
+	s.expiry = s.now.AddDate(0, 0, 73)
+	s.t = NewTracker([]Contract{
+		{Symbol: "BTC-C", Underlying: "BTCUSDT", QuoteAsset: "USDT", Side: options.OptionSideTypeCall, Strike: 45000, Expiry: s.expiry, Unit: 1},
+		{Symbol: "BTC-P", Underlying: "BTCUSDT", QuoteAsset: "USDT", Side: options.OptionSideTypePut, Strike: 40000, Expiry: s.expiry, Unit: 1},
+		{Symbol: "ETH-C", Underlying: "ETHUSDT", QuoteAsset: "USDT", Side: options.OptionSideTypeCall, Strike: 2500, Expiry: s.expiry, Unit: 1},
+	})
+	s.t.now = func() time.Time { return s.now }
+}
+
+func (s *trackerTestSuite) TestLoadMarks() {
+	r := s.Require()
+	r.NoError(s.t.LoadMarks([]*options.Mark{
+		{Symbol: "BTC-C", MarkIV: "0.55", RiskFreeInterest: "0.05"},
+		{Symbol: "UNKNOWN", MarkIV: "1"},
+	}))
+	vol, err := s.t.Vol("BTC-C")
+	r.NoError(err)
+	r.Equal(0.55, vol)
+	// interpolated on the surface
+	vol, err = s.t.Vol("BTC-P")
+	r.NoError(err)
+	r.Equal(0.55, vol)
+	_, err = s.t.Vol("ETH-C")
+	r.ErrorIs(err, ErrEmptySurface)
+	_, err = s.t.Vol("UNKNOWN")
+	r.ErrorIs(err, ErrUnknownContract)
+
+	r.Error(s.t.LoadMarks([]*options.Mark{{Symbol: "BTC-C", MarkIV: ""}}))
+}
+
+func (s *trackerTestSuite) TestHandleMarkPrice() {
+	r := s.Require()
+	var updates []Point
+	s.t.SetUpdateHandler(func(underlying string, points []Point) {
+		r.Equal("BTCUSDT", underlying)
+		updates = append(updates, points...)
+	})
+	years := YearFraction(s.now, s.expiry)
+	price := Price(options.OptionSideTypeCall, 42000, 45000, years, 0, 0.6)
+	event := &options.WsMarkPriceEvent{Symbol: "BTC-C", Time: s.now.UnixMilli(), MarkPrice: fmt.Sprint(price)}
+
+	// no index price yet
+	s.t.HandleMarkPrice([]*options.WsMarkPriceEvent{event})
+	r.Empty(updates)
+
+	s.t.HandleIndex(&options.WsIndexEvent{Symbol: "BTCUSDT", Price: "42000"})
+	s.t.HandleMarkPrice([]*options.WsMarkPriceEvent{event, {Symbol: "UNKNOWN", MarkPrice: "1"}})
+	r.Len(updates, 1)
+	r.Equal("BTC-C", updates[0].Symbol)
+	r.InDelta(0.6, updates[0].Vol, 1e-6)
+	r.Equal(s.now.UnixMilli(), updates[0].Time.UnixMilli())
+
+	p, ok := s.t.Surface("BTCUSDT").Point("BTC-C")
+	r.True(ok)
+	r.InDelta(0.6, p.Vol, 1e-6)
+}
+
+func (s *trackerTestSuite) TestPortfolioGreeks() {
+	r := s.Require()
+	r.NoError(s.t.LoadMarks([]*options.Mark{
+		{Symbol: "BTC-C", MarkIV: "0.5"},
+		{Symbol: "BTC-P", MarkIV: "0.7"},
+		{Symbol: "ETH-C", MarkIV: "0.6"},
+	}))
+	r.NoError(s.t.SetIndexPrice("BTCUSDT", "42000"))
+
+	positions := []*options.Position{
+		{Symbol: "BTC-C", Side: "LONG", Quantity: "2"},
+		{Symbol: "BTC-P", Side: "SHORT", Quantity: "-0.5"},
+	}
+	res, err := s.t.PortfolioGreeks(positions)
+	r.NoError(err)
+
+	years := YearFraction(s.now, s.expiry)
+	call := ComputeGreeks(options.OptionSideTypeCall, 42000, 45000, years, 0, 0.5).Scale(2)
+	put := ComputeGreeks(options.OptionSideTypePut, 42000, 40000, years, 0, 0.7).Scale(-0.5)
+	r.Len(res.Positions, 2)
+	r.Equal(PositionGreeks{Symbol: "BTC-C", Underlying: "BTCUSDT", Quantity: 2, Greeks: call}, res.Positions[0])
+	r.Equal(-0.5, res.Positions[1].Quantity)
+	total := res.Underlyings["BTCUSDT"]
+	r.InDelta(call.Delta+put.Delta, total.Delta, 1e-12)
+	r.InDelta(call.Vega+put.Vega, total.Vega, 1e-12)
+	// a short put has a positive delta
+	r.Greater(res.Positions[1].Greeks.Delta, 0.0)
+
+	_, err = s.t.PortfolioGreeks([]*options.Position{{Symbol: "ETH-C", Side: "LONG", Quantity: "1"}})
+	r.ErrorIs(err, ErrNoIndexPrice)
+	_, err = s.t.PortfolioGreeks([]*options.Position{{Symbol: "UNKNOWN", Side: "LONG", Quantity: "1"}})
+	r.ErrorIs(err, ErrUnknownContract)
+}
+
+func (s *trackerTestSuite) TestLoad() {
+	r := s.Require()
+	expiry := time.Now().AddDate(0, 1, 0).UnixMilli()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		switch req.URL.Path {
+		case "/eapi/v1/exchangeInfo":
+			fmt.Fprintf(w, `{"optionSymbols": [{"symbol": "BTC-C", "side": "CALL", "strikePrice": "45000",
+				"underlying": "BTCUSDT", "quoteAsset": "USDT", "unit": 1, "expiryDate": %d}]}`, expiry)
+		case "/eapi/v1/mark":
+			fmt.Fprint(w, `[{"symbol": "BTC-C", "markIV": "0.5", "riskFreeInterest": "0.01"}]`)
+		case "/eapi/v1/index":
+			r.Equal("BTCUSDT", req.URL.Query().Get("underlying"))
+			fmt.Fprint(w, `{"time": 1, "indexPrice": "42000"}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	c := options.NewClient("", "")
+	c.SetApiEndpoint(server.URL)
+	t, err := Load(context.Background(), c)
+	r.NoError(err)
+	index, ok := t.IndexPrice("BTCUSDT")
+	r.True(ok)
+	r.Equal(42000.0, index)
+	vol, err := t.Vol("BTC-C")
+	r.NoError(err)
+	r.Equal(0.5, vol)
+	g, err := t.Greeks("BTC-C")
+	r.NoError(err)
+	r.Greater(g.Delta, 0.0)
+	r.Equal("BTC", t.baseAsset("BTCUSDT"))
+}
@@ -0,0 +1,327 @@
+package options
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+)
+
+// BlockTradeLiquidityType define the liquidity of a block trade
+type BlockTradeLiquidityType string
+
+const (
+	BlockTradeLiquidityTypeTaker BlockTradeLiquidityType = "TAKER"
+	BlockTradeLiquidityTypeMaker BlockTradeLiquidityType = "MAKER"
+)
+
+// BlockTradeLeg define a leg of a block trade order
+type BlockTradeLeg struct {
+	Symbol   string   `json:"symbol"`
+	Side     SideType `json:"side"`
+	Quantity string   `json:"quantity"`
+	Price    string   `json:"price"`
+}
+
+// BlockTradeOrder define a block trade order
+type BlockTradeOrder struct {
+	BlockTradeSettlementKey string                  `json:"blockTradeSettlementKey"`
+	ExpireTime              int64                   `json:"expireTime"`
+	Liquidity               BlockTradeLiquidityType `json:"liquidity"`
+	Status                  string                  `json:"status"`
+	CreateTime              int64                   `json:"createTime"`
+	UpdateTime              int64                   `json:"updateTime"`
+	Legs                    []*BlockTradeLeg        `json:"legs"`
+}
+
+func (c *Client) doBlockTradeOrder(ctx context.Context, r *request, opts ...RequestOption) (res *BlockTradeOrder, err error) {
+	data, _, err := c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(BlockTradeOrder)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// CreateBlockTradeOrderService create a block trade order, the counterparty accepts it
+// with the returned blockTradeSettlementKey
+type CreateBlockTradeOrderService struct {
+	c         *Client
+	liquidity BlockTradeLiquidityType
+	legs      []*BlockTradeLeg
+}
+
+// Liquidity set liquidity
+func (s *CreateBlockTradeOrderService) Liquidity(liquidity BlockTradeLiquidityType) *CreateBlockTradeOrderService {
+	s.liquidity = liquidity
+	return s
+}
+
+// Legs set legs
+func (s *CreateBlockTradeOrderService) Legs(legs ...*BlockTradeLeg) *CreateBlockTradeOrderService {
+	s.legs = legs
+	return s
+}
+
+// Do send request
+func (s *CreateBlockTradeOrderService) Do(ctx context.Context, opts ...RequestOption) (res *BlockTradeOrder, err error) {
+	r := &request{
+		method:   http.MethodPost,
+		endpoint: "/eapi/v1/block/order/create",
+		secType:  secTypeSigned,
+	}
+	legs, err := json.Marshal(s.legs)
+	if err != nil {
+		return nil, err
+	}
+	r.setFormParams(params{
+		"liquidity": s.liquidity,
+		"legs":      string(legs),
+	})
+	return s.c.doBlockTradeOrder(ctx, r, opts...)
+}
+
+// ExtendBlockTradeOrderService extend the expiration of a block trade order by 30 minutes
+type ExtendBlockTradeOrderService struct {
+	c                     *Client
+	blockOrderMatchingKey string
+}
+
+// BlockOrderMatchingKey set blockOrderMatchingKey
+func (s *ExtendBlockTradeOrderService) BlockOrderMatchingKey(key string) *ExtendBlockTradeOrderService {
+	s.blockOrderMatchingKey = key
+	return s
+}
+
+// Do send request
+func (s *ExtendBlockTradeOrderService) Do(ctx context.Context, opts ...RequestOption) (res *BlockTradeOrder, err error) {
+	r := &request{
+		method:   http.MethodPut,
+		endpoint: "/eapi/v1/block/order/create",
+		secType:  secTypeSigned,
+	}
+	r.setFormParam("blockOrderMatchingKey", s.blockOrderMatchingKey)
+	return s.c.doBlockTradeOrder(ctx, r, opts...)
+}
+
+// CancelBlockTradeOrderService cancel a block trade order
+type CancelBlockTradeOrderService struct {
+	c                     *Client
+	blockOrderMatchingKey string
+}
+
+// BlockOrderMatchingKey set blockOrderMatchingKey
+func (s *CancelBlockTradeOrderService) BlockOrderMatchingKey(key string) *CancelBlockTradeOrderService {
+	s.blockOrderMatchingKey = key
+	return s
+}
+
+// Do send request
+func (s *CancelBlockTradeOrderService) Do(ctx context.Context, opts ...RequestOption) (err error) {
+	r := &request{
+		method:   http.MethodDelete,
+		endpoint: "/eapi/v1/block/order/create",
+		secType:  secTypeSigned,
+	}
+	r.setFormParam("blockOrderMatchingKey", s.blockOrderMatchingKey)
+	_, _, err = s.c.callAPI(ctx, r, opts...)
+	return err
+}
+
+// ListBlockTradeOrdersService list the block trade orders created by the user
+type ListBlockTradeOrdersService struct {
+	c                     *Client
+	blockOrderMatchingKey *string
+	underlying            *string
+	startTime             *int64
+	endTime               *int64
+}
+
+// BlockOrderMatchingKey set blockOrderMatchingKey
+func (s *ListBlockTradeOrdersService) BlockOrderMatchingKey(key string) *ListBlockTradeOrdersService {
+	s.blockOrderMatchingKey = &key
+	return s
+}
+
+// Underlying set underlying, e.g. BTCUSDT
+func (s *ListBlockTradeOrdersService) Underlying(underlying string) *ListBlockTradeOrdersService {
+	s.underlying = &underlying
+	return s
+}
+
+// StartTime set startTime
+func (s *ListBlockTradeOrdersService) StartTime(startTime int64) *ListBlockTradeOrdersService {
+	s.startTime = &startTime
+	return s
+}
+
+// EndTime set endTime
+func (s *ListBlockTradeOrdersService) EndTime(endTime int64) *ListBlockTradeOrdersService {
+	s.endTime = &endTime
+	return s
+}
+
+// Do send request
+func (s *ListBlockTradeOrdersService) Do(ctx context.Context, opts ...RequestOption) (res []*BlockTradeOrder, err error) {
+	r := &request{
+		method:   http.MethodGet,
+		endpoint: "/eapi/v1/block/order/orders",
+		secType:  secTypeSigned,
+	}
+	if s.blockOrderMatchingKey != nil {
+		r.setParam("blockOrderMatchingKey", *s.blockOrderMatchingKey)
+	}
+	if s.underlying != nil {
+		r.setParam("underlying", *s.underlying)
+	}
+	if s.startTime != nil {
+		r.setParam("startTime", *s.startTime)
+	}
+	if s.endTime != nil {
+		r.setParam("endTime", *s.endTime)
+	}
+	data, _, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return []*BlockTradeOrder{}, err
+	}
+	res = make([]*BlockTradeOrder, 0)
+	err = json.Unmarshal(data, &res)
+	if err != nil {
+		return []*BlockTradeOrder{}, err
+	}
+	return res, nil
+}
+
+// AcceptBlockTradeOrderService accept a block trade order created by the counterparty
+type AcceptBlockTradeOrderService struct {
+	c                     *Client
+	blockOrderMatchingKey string
+}
+
+// BlockOrderMatchingKey set blockOrderMatchingKey
+func (s *AcceptBlockTradeOrderService) BlockOrderMatchingKey(key string) *AcceptBlockTradeOrderService {
+	s.blockOrderMatchingKey = key
+	return s
+}
+
+// Do send request
+func (s *AcceptBlockTradeOrderService) Do(ctx context.Context, opts ...RequestOption) (res *BlockTradeOrder, err error) {
+	r := &request{
+		method:   http.MethodPost,
+		endpoint: "/eapi/v1/block/order/execute",
+		secType:  secTypeSigned,
+	}
+	r.setFormParam("blockOrderMatchingKey", s.blockOrderMatchingKey)
+	return s.c.doBlockTradeOrder(ctx, r, opts...)
+}
+
+// GetBlockTradeOrderService get a block trade order before accepting it
+type GetBlockTradeOrderService struct {
+	c                     *Client
+	blockOrderMatchingKey string
+}
+
+// BlockOrderMatchingKey set blockOrderMatchingKey
+func (s *GetBlockTradeOrderService) BlockOrderMatchingKey(key string) *GetBlockTradeOrderService {
+	s.blockOrderMatchingKey = key
+	return s
+}
+
+// Do send request
+func (s *GetBlockTradeOrderService) Do(ctx context.Context, opts ...RequestOption) (res *BlockTradeOrder, err error) {
+	r := &request{
+		method:   http.MethodGet,
+		endpoint: "/eapi/v1/block/order/execute",
+		secType:  secTypeSigned,
+	}
+	r.setParam("blockOrderMatchingKey", s.blockOrderMatchingKey)
+	return s.c.doBlockTradeOrder(ctx, r, opts...)
+}
+
+// BlockUserTradeLeg define a leg of an executed block trade
+type BlockUserTradeLeg struct {
+	CreateTime     int64                   `json:"createTime"`
+	UpdateTime     int64                   `json:"updateTime"`
+	Symbol         string                  `json:"symbol"`
+	OrderId        int64                   `json:"orderId"`
+	OrderPrice     string                  `json:"orderPrice"`
+	OrderQuantity  string                  `json:"orderQuantity"`
+	OrderStatus    OrderStatusType         `json:"orderStatus"`
+	ExecutedQty    string                  `json:"executedQty"`
+	ExecutedAmount string                  `json:"executedAmount"`
+	Fee            string                  `json:"fee"`
+	OrderType      string                  `json:"orderType"`
+	OrderSide      SideType                `json:"orderSide"`
+	Id             int64                   `json:"id"`
+	TradeId        int64                   `json:"tradeId"`
+	TradePrice     string                  `json:"tradePrice"`
+	TradeQty       string                  `json:"tradeQty"`
+	TradeTime      int64                   `json:"tradeTime"`
+	Liquidity      BlockTradeLiquidityType `json:"liquidity"`
+	Commission     string                  `json:"commission"`
+}
+
+// BlockUserTrade define an executed block trade
+type BlockUserTrade struct {
+	ParentOrderId           string               `json:"parentOrderId"`
+	CrossType               string               `json:"crossType"`
+	Legs                    []*BlockUserTradeLeg `json:"legs"`
+	BlockTradeSettlementKey string               `json:"blockTradeSettlementKey"`
+}
+
+// ListBlockUserTradesService list the executed block trades of the user
+type ListBlockUserTradesService struct {
+	c          *Client
+	underlying *string
+	startTime  *int64
+	endTime    *int64
+}
+
+// Underlying set underlying, e.g. BTCUSDT
+func (s *ListBlockUserTradesService) Underlying(underlying string) *ListBlockUserTradesService {
+	s.underlying = &underlying
+	return s
+}
+
+// StartTime set startTime
+func (s *ListBlockUserTradesService) StartTime(startTime int64) *ListBlockUserTradesService {
+	s.startTime = &startTime
+	return s
+}
+
+// EndTime set endTime
+func (s *ListBlockUserTradesService) EndTime(endTime int64) *ListBlockUserTradesService {
+	s.endTime = &endTime
+	return s
+}
+
+// Do send request
+func (s *ListBlockUserTradesService) Do(ctx context.Context, opts ...RequestOption) (res []*BlockUserTrade, err error) {
+	r := &request{
+		method:   http.MethodGet,
+		endpoint: "/eapi/v1/block/user-trades",
+		secType:  secTypeSigned,
+	}
+	if s.underlying != nil {
+		r.setParam("underlying", *s.underlying)
+	}
+	if s.startTime != nil {
+		r.setParam("startTime", *s.startTime)
+	}
+	if s.endTime != nil {
+		r.setParam("endTime", *s.endTime)
+	}
+	data, _, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return []*BlockUserTrade{}, err
+	}
+	res = make([]*BlockUserTrade, 0)
+	err = json.Unmarshal(data, &res)
+	if err != nil {
+		return []*BlockUserTrade{}, err
+	}
+	return res, nil
+}
@@ -0,0 +1,216 @@
+package options
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type blockTradeServiceTestSuite struct {
+	baseTestSuite
+}
+
+func TestBlockTradeService(t *testing.T) {
+	suite.Run(t, new(blockTradeServiceTestSuite))
+}
+
+var blockTradeOrderData = []byte(`{
+	"blockTradeSettlementKey": "3668822b8-1baa-4a27-9c5a-d12f9c7e6b2e",
+	"expireTime": 1730171888109,
+	"liquidity": "TAKER",
+	"status": "RECEIVED",
+	"legs": [
+		{
+			"symbol": "BNB-241101-700-C",
+			"side": "BUY",
+			"quantity": "1.2",
+			"price": "2.8"
+		}
+	]
+}`)
+
+func (s *blockTradeServiceTestSuite) assertBlockTradeOrder(res *BlockTradeOrder) {
+	s.r().Equal(&BlockTradeOrder{
+		BlockTradeSettlementKey: "3668822b8-1baa-4a27-9c5a-d12f9c7e6b2e",
+		ExpireTime:              1730171888109,
+		Liquidity:               BlockTradeLiquidityTypeTaker,
+		Status:                  "RECEIVED",
+		Legs: []*BlockTradeLeg{
+			{Symbol: "BNB-241101-700-C", Side: SideTypeBuy, Quantity: "1.2", Price: "2.8"},
+		},
+	}, res)
+}
+
+func (s *blockTradeServiceTestSuite) TestCreateBlockTradeOrder() {
+	s.mockDo(blockTradeOrderData, nil)
+	defer s.assertDo()
+
+	s.assertReq(func(r *request) {
+		s.r().Equal(http.MethodPost, r.method)
+		s.r().Equal("/eapi/v1/block/order/create", r.endpoint)
+		e := newSignedRequest()
+		e.setFormParams(params{
+			"liquidity": "TAKER",
+			"legs":      `[{"symbol":"BNB-241101-700-C","side":"BUY","quantity":"1.2","price":"2.8"}]`,
+		})
+		s.assertRequestEqual(e, r)
+	})
+	res, err := s.client.NewCreateBlockTradeOrderService().Liquidity(BlockTradeLiquidityTypeTaker).
+		Legs(&BlockTradeLeg{Symbol: "BNB-241101-700-C", Side: SideTypeBuy, Quantity: "1.2", Price: "2.8"}).
+		Do(newContext())
+	s.r().NoError(err)
+	s.assertBlockTradeOrder(res)
+}
+
+func (s *blockTradeServiceTestSuite) TestExtendBlockTradeOrder() {
+	s.mockDo(blockTradeOrderData, nil)
+	defer s.assertDo()
+
+	s.assertReq(func(r *request) {
+		s.r().Equal(http.MethodPut, r.method)
+		s.r().Equal("/eapi/v1/block/order/create", r.endpoint)
+		e := newSignedRequest()
+		e.setFormParam("blockOrderMatchingKey", "12345")
+		s.assertRequestEqual(e, r)
+	})
+	res, err := s.client.NewExtendBlockTradeOrderService().BlockOrderMatchingKey("12345").Do(newContext())
+	s.r().NoError(err)
+	s.assertBlockTradeOrder(res)
+}
+
+func (s *blockTradeServiceTestSuite) TestCancelBlockTradeOrder() {
+	s.mockDo([]byte(`{}`), nil)
+	defer s.assertDo()
+
+	s.assertReq(func(r *request) {
+		s.r().Equal(http.MethodDelete, r.method)
+		e := newSignedRequest()
+		e.setFormParam("blockOrderMatchingKey", "12345")
+		s.assertRequestEqual(e, r)
+	})
+	err := s.client.NewCancelBlockTradeOrderService().BlockOrderMatchingKey("12345").Do(newContext())
+	s.r().NoError(err)
+}
+
+func (s *blockTradeServiceTestSuite) TestListBlockTradeOrders() {
+	s.mockDo([]byte(`[`+string(blockTradeOrderData)+`]`), nil)
+	defer s.assertDo()
+
+	s.assertReq(func(r *request) {
+		s.r().Equal("/eapi/v1/block/order/orders", r.endpoint)
+		e := newSignedRequest().setParams(params{
+			"underlying": "BNBUSDT",
+			"startTime":  1730170000000,
+			"endTime":    1730180000000,
+		})
+		s.assertRequestEqual(e, r)
+	})
+	res, err := s.client.NewListBlockTradeOrdersService().Underlying("BNBUSDT").
+		StartTime(1730170000000).EndTime(1730180000000).Do(newContext())
+	s.r().NoError(err)
+	s.r().Len(res, 1)
+	s.assertBlockTradeOrder(res[0])
+}
+
+func (s *blockTradeServiceTestSuite) TestAcceptBlockTradeOrder() {
+	s.mockDo(blockTradeOrderData, nil)
+	defer s.assertDo()
+
+	s.assertReq(func(r *request) {
+		s.r().Equal(http.MethodPost, r.method)
+		s.r().Equal("/eapi/v1/block/order/execute", r.endpoint)
+		e := newSignedRequest()
+		e.setFormParam("blockOrderMatchingKey", "12345")
+		s.assertRequestEqual(e, r)
+	})
+	res, err := s.client.NewAcceptBlockTradeOrderService().BlockOrderMatchingKey("12345").Do(newContext())
+	s.r().NoError(err)
+	s.assertBlockTradeOrder(res)
+}
+
+func (s *blockTradeServiceTestSuite) TestGetBlockTradeOrder() {
+	s.mockDo(blockTradeOrderData, nil)
+	defer s.assertDo()
+
+	s.assertReq(func(r *request) {
+		s.r().Equal(http.MethodGet, r.method)
+		s.r().Equal("/eapi/v1/block/order/execute", r.endpoint)
+		s.assertRequestEqual(newSignedRequest().setParam("blockOrderMatchingKey", "12345"), r)
+	})
+	res, err := s.client.NewGetBlockTradeOrderService().BlockOrderMatchingKey("12345").Do(newContext())
+	s.r().NoError(err)
+	s.assertBlockTradeOrder(res)
+}
+
+func (s *blockTradeServiceTestSuite) TestListBlockUserTrades() {
+	data := []byte(`[
+		{
+			"parentOrderId": "1031232",
+			"crossType": "USER_BLOCK",
+			"legs": [
+				{
+					"createTime": 1730171888109,
+					"updateTime": 1730171888109,
+					"symbol": "BNB-241101-700-C",
+					"orderId": 4611687215398592553,
+					"orderPrice": "2.8",
+					"orderQuantity": "1.2",
+					"orderStatus": "FILLED",
+					"executedQty": "1.2",
+					"executedAmount": "3.36",
+					"fee": "0.00144",
+					"orderType": "PREV_QUOTED",
+					"orderSide": "BUY",
+					"id": 1125899906900937837,
+					"tradeId": 1,
+					"tradePrice": "2.8",
+					"tradeQty": "1.2",
+					"tradeTime": 1730171888109,
+					"liquidity": "TAKER",
+					"commission": "0.00144"
+				}
+			],
+			"blockTradeSettlementKey": "7d046e6e-a429-4335-ab9d-6a681febcde5"
+		}
+	]`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+
+	s.assertReq(func(r *request) {
+		s.r().Equal("/eapi/v1/block/user-trades", r.endpoint)
+		s.assertRequestEqual(newSignedRequest().setParam("underlying", "BNBUSDT"), r)
+	})
+	res, err := s.client.NewListBlockUserTradesService().Underlying("BNBUSDT").Do(newContext())
+	s.r().NoError(err)
+	s.r().Equal([]*BlockUserTrade{
+		{
+			ParentOrderId: "1031232",
+			CrossType:     "USER_BLOCK",
+			Legs: []*BlockUserTradeLeg{
+				{
+					CreateTime:     1730171888109,
+					UpdateTime:     1730171888109,
+					Symbol:         "BNB-241101-700-C",
+					OrderId:        4611687215398592553,
+					OrderPrice:     "2.8",
+					OrderQuantity:  "1.2",
+					OrderStatus:    OrderStatusTypeFilled,
+					ExecutedQty:    "1.2",
+					ExecutedAmount: "3.36",
+					Fee:            "0.00144",
+					OrderType:      "PREV_QUOTED",
+					OrderSide:      SideTypeBuy,
+					Id:             1125899906900937837,
+					TradeId:        1,
+					TradePrice:     "2.8",
+					TradeQty:       "1.2",
+					TradeTime:      1730171888109,
+					Liquidity:      BlockTradeLiquidityTypeTaker,
+					Commission:     "0.00144",
+				},
+			},
+			BlockTradeSettlementKey: "7d046e6e-a429-4335-ab9d-6a681febcde5",
+		},
+	}, res)
+}
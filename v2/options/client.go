@@ -194,28 +194,34 @@ func newJSON(data []byte) (j *simplejson.Json, err error) {
 	return j, nil
 }
 
-// getApiEndpoint return the base endpoint of the WS
-func getApiEndpoint() string {
-	return baseApiMainUrl
-}
-
 // NewClient initialize an API client instance with API key and secret key.
 // You should always call this function before using this SDK.
 // Services will be created by the form client.NewXXXService().
 func NewClient(apiKey, secretKey string) *Client {
+	return NewClientWithEnvironment(apiKey, secretKey, defaultEnvironment())
+}
+
+// NewClientWithEnvironment initialize an API client instance for the endpoints of env
+func NewClientWithEnvironment(apiKey, secretKey string, env Environment) *Client {
 	return &Client{
-		APIKey:     apiKey,
-		SecretKey:  secretKey,
-		KeyType:    common.KeyTypeHmac,
-		BaseURL:    getApiEndpoint(),
-		UserAgent:  "Binance/golang",
-		HTTPClient: http.DefaultClient,
-		Logger:     log.New(os.Stderr, "Binance-golang ", log.LstdFlags),
+		APIKey:      apiKey,
+		SecretKey:   secretKey,
+		KeyType:     common.KeyTypeHmac,
+		BaseURL:     env.APIURL,
+		Environment: env,
+		UserAgent:   "Binance/golang",
+		HTTPClient:  http.DefaultClient,
+		Logger:      log.New(os.Stderr, "Binance-golang ", log.LstdFlags),
 	}
 }
 
 // NewProxiedClient passing a proxy url
 func NewProxiedClient(apiKey, secretKey, proxyUrl string) *Client {
+	return NewProxiedClientWithEnvironment(apiKey, secretKey, proxyUrl, defaultEnvironment())
+}
+
+// NewProxiedClientWithEnvironment passing a proxy url, for the endpoints of env
+func NewProxiedClientWithEnvironment(apiKey, secretKey, proxyUrl string, env Environment) *Client {
 	proxy, err := url.Parse(proxyUrl)
 	if err != nil {
 		log.Fatal(err)
@@ -225,11 +231,12 @@ func NewProxiedClient(apiKey, secretKey, proxyUrl string) *Client {
 		TLSClientConfig: &tls.Config{InsecureSkipVerify: true},
 	}
 	return &Client{
-		APIKey:    apiKey,
-		SecretKey: secretKey,
-		KeyType:   common.KeyTypeHmac,
-		BaseURL:   getApiEndpoint(),
-		UserAgent: "Binance/golang",
+		APIKey:      apiKey,
+		SecretKey:   secretKey,
+		KeyType:     common.KeyTypeHmac,
+		BaseURL:     env.APIURL,
+		Environment: env,
+		UserAgent:   "Binance/golang",
 		HTTPClient: &http.Client{
 			Transport: tr,
 		},
@@ -239,6 +246,9 @@ func NewProxiedClient(apiKey, secretKey, proxyUrl string) *Client {
 
 type doFunc func(req *http.Request) (*http.Response, error)
 
+//go:generate go run github.com/adshao/go-binance/v2/internal/servicegen -output service_api.go
+//go:generate mockgen -source service_api.go -destination mock/service_api.go -package mock
+
 // Client define API client
 type Client struct {
 	APIKey     string
@@ -252,6 +262,18 @@ type Client struct {
 	TimeOffset int64
 	do         doFunc
 
+	// Environment is the environment of the client, its WsOptions connect the streams to the
+	// same environment
+	Environment Environment
+
+	// Middlewares wrap every REST API call, see Use
+	Middlewares   []common.Middleware
+	logMiddleware common.Middleware
+
+	// Signer signs requests instead of SecretKey when set, see SetSigner
+	Signer  common.Signer
+	signers common.SignerCache
+
 	UsedWeight common.UsedWeight
 	OrderCount common.OrderCount
 }
@@ -293,22 +315,17 @@ func (c *Client) parseRequest(r *request, opts ...RequestOption) (err error) {
 	if r.secType == secTypeAPIKey || r.secType == secTypeSigned {
 		header.Set("X-MBX-APIKEY", c.APIKey)
 	}
-	kt := c.KeyType
-	if kt == "" {
-		kt = common.KeyTypeHmac
-	}
-	sf, err := common.SignFunc(kt)
-	if err != nil {
-		return err
-	}
 	if r.secType == secTypeSigned {
-		raw := fmt.Sprintf("%s%s", queryString, bodyString)
-		sign, err := sf(c.SecretKey, raw)
+		signer, err := c.signer()
+		if err != nil {
+			return err
+		}
+		sign, err := signer.Sign([]byte(queryString + bodyString))
 		if err != nil {
 			return err
 		}
 		v := url.Values{}
-		v.Set(signatureKey, *sign)
+		v.Set(signatureKey, sign)
 		if queryString == "" {
 			queryString = v.Encode()
 		} else {
@@ -318,7 +335,7 @@ func (c *Client) parseRequest(r *request, opts ...RequestOption) (err error) {
 	if queryString != "" {
 		fullURL = fmt.Sprintf("%s?%s", fullURL, queryString)
 	}
-	c.debug("full url: %s, body: %s", fullURL, bodyString)
+	c.debug("full url: %s, body: %s", common.RedactString(fullURL), common.RedactString(bodyString))
 
 	r.fullURL = fullURL
 	r.header = header
@@ -337,22 +354,37 @@ func (c *Client) callAPI(ctx context.Context, r *request, opts ...RequestOption)
 	}
 	req = req.WithContext(ctx)
 	req.Header = r.header
-	c.debug("request: %#v\n", req)
+	send := c.send
+	if c.logMiddleware != nil {
+		send = c.logMiddleware(send)
+	}
+	res, err := common.Chain(send, c.Middlewares...)(req)
+	if err != nil {
+		if res != nil {
+			return nil, &res.Header, err
+		}
+		return []byte{}, &http.Header{}, err
+	}
+	return res.Body, &res.Header, nil
+}
+
+// send performs a built request, it is the innermost handler of the middleware chain
+func (c *Client) send(req *http.Request) (resp *common.Response, err error) {
+	c.debug("request: %s %s, header: %v\n", req.Method, common.RedactString(req.URL.String()), common.RedactHeader(req.Header))
 	f := c.do
 	if f == nil {
 		f = c.HTTPClient.Do
 	}
 	res, err := f(req)
 	if err != nil {
-		return []byte{}, &http.Header{}, err
+		return nil, err
 	}
-
 	c.UsedWeight.UpdateByHeader(res.Header)
 	c.OrderCount.UpdateByHeader(res.Header)
 
-	data, err = io.ReadAll(res.Body)
+	data, err := io.ReadAll(res.Body)
 	if err != nil {
-		return []byte{}, &http.Header{}, err
+		return nil, err
 	}
 	defer func() {
 		cerr := res.Body.Close()
@@ -362,10 +394,15 @@ func (c *Client) callAPI(ctx context.Context, r *request, opts ...RequestOption)
 			err = cerr
 		}
 	}()
-	c.debug("response: %#v\n", res)
+	c.debug("response header: %v\n", res.Header)
 	c.debug("response body: %s\n", string(data))
 	c.debug("response status code: %d\n", res.StatusCode)
 
+	resp = &common.Response{
+		StatusCode: res.StatusCode,
+		Header:     res.Header,
+		Body:       data,
+	}
 	if res.StatusCode >= http.StatusBadRequest {
 		apiErr := new(common.APIError)
 		e := json.Unmarshal(data, apiErr)
@@ -375,9 +412,32 @@ func (c *Client) callAPI(ctx context.Context, r *request, opts ...RequestOption)
 		if !apiErr.IsValid() {
 			apiErr.Response = data
 		}
-		return nil, &res.Header, apiErr
+		return resp, apiErr
 	}
-	return data, &res.Header, nil
+	return resp, nil
+}
+
+// SetSigner set the signer of requests, e.g. an external KMS or HSM signer,
+// SecretKey is not used to sign requests anymore
+func (c *Client) SetSigner(signer common.Signer) *Client {
+	c.Signer = signer
+	c.KeyType = signer.KeyType()
+	return c
+}
+
+// signer returns Signer when set, else the signer of SecretKey, parsed once
+func (c *Client) signer() (common.Signer, error) {
+	if c.Signer != nil {
+		return c.Signer, nil
+	}
+	return c.signers.Get(c.KeyType, c.SecretKey)
+}
+
+// Use appends middlewares wrapping every REST API call of the client, the first
+// middleware being the outermost. It must not be called concurrently with requests.
+func (c *Client) Use(middlewares ...common.Middleware) *Client {
+	c.Middlewares = append(c.Middlewares, middlewares...)
+	return c
 }
 
 // SetApiEndpoint set api Endpoint
@@ -539,3 +599,87 @@ func (c *Client) NewKeepaliveUserStreamService() *KeepaliveUserStreamService {
 func (c *Client) NewCloseUserStreamService() *CloseUserStreamService {
 	return &CloseUserStreamService{c: c}
 }
+
+// NewSetMMPService init set market maker protection config service
+// POST /eapi/v1/mmpSet
+func (c *Client) NewSetMMPService() *SetMMPService {
+	return &SetMMPService{c: c}
+}
+
+// NewGetMMPService init get market maker protection config service
+// GET /eapi/v1/mmp
+func (c *Client) NewGetMMPService() *GetMMPService {
+	return &GetMMPService{c: c}
+}
+
+// NewResetMMPService init reset market maker protection service
+// POST /eapi/v1/mmpReset
+func (c *Client) NewResetMMPService() *ResetMMPService {
+	return &ResetMMPService{c: c}
+}
+
+// NewCountdownCancelAllService init auto-cancel all open orders config service
+// POST /eapi/v1/countdownCancelAll
+func (c *Client) NewCountdownCancelAllService() *CountdownCancelAllService {
+	return &CountdownCancelAllService{c: c}
+}
+
+// NewGetCountdownCancelAllService init get auto-cancel all open orders config service
+// GET /eapi/v1/countdownCancelAll
+func (c *Client) NewGetCountdownCancelAllService() *GetCountdownCancelAllService {
+	return &GetCountdownCancelAllService{c: c}
+}
+
+// NewCountdownCancelAllHeartBeatService init auto-cancel all open orders heartbeat service
+// POST /eapi/v1/countdownCancelAllHeartBeat
+func (c *Client) NewCountdownCancelAllHeartBeatService() *CountdownCancelAllHeartBeatService {
+	return &CountdownCancelAllHeartBeatService{c: c}
+}
+
+// NewMarginAccountService init option margin account service
+// GET /eapi/v1/marginAccount
+func (c *Client) NewMarginAccountService() *MarginAccountService {
+	return &MarginAccountService{c: c}
+}
+
+// NewCreateBlockTradeOrderService init create block trade order service
+// POST /eapi/v1/block/order/create
+func (c *Client) NewCreateBlockTradeOrderService() *CreateBlockTradeOrderService {
+	return &CreateBlockTradeOrderService{c: c}
+}
+
+// NewExtendBlockTradeOrderService init extend block trade order service
+// PUT /eapi/v1/block/order/create
+func (c *Client) NewExtendBlockTradeOrderService() *ExtendBlockTradeOrderService {
+	return &ExtendBlockTradeOrderService{c: c}
+}
+
+// NewCancelBlockTradeOrderService init cancel block trade order service
+// DELETE /eapi/v1/block/order/create
+func (c *Client) NewCancelBlockTradeOrderService() *CancelBlockTradeOrderService {
+	return &CancelBlockTradeOrderService{c: c}
+}
+
+// NewListBlockTradeOrdersService init list block trade orders service
+// GET /eapi/v1/block/order/orders
+func (c *Client) NewListBlockTradeOrdersService() *ListBlockTradeOrdersService {
+	return &ListBlockTradeOrdersService{c: c}
+}
+
+// NewAcceptBlockTradeOrderService init accept block trade order service
+// POST /eapi/v1/block/order/execute
+func (c *Client) NewAcceptBlockTradeOrderService() *AcceptBlockTradeOrderService {
+	return &AcceptBlockTradeOrderService{c: c}
+}
+
+// NewGetBlockTradeOrderService init get block trade order service
+// GET /eapi/v1/block/order/execute
+func (c *Client) NewGetBlockTradeOrderService() *GetBlockTradeOrderService {
+	return &GetBlockTradeOrderService{c: c}
+}
+
+// NewListBlockUserTradesService init list block trades service
+// GET /eapi/v1/block/user-trades
+func (c *Client) NewListBlockUserTradesService() *ListBlockUserTradesService {
+	return &ListBlockUserTradesService{c: c}
+}
@@ -0,0 +1,129 @@
+package options
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+)
+
+// CountdownCancelAll define the auto-cancel all open orders config of an underlying
+type CountdownCancelAll struct {
+	Underlying    string `json:"underlying"`
+	CountdownTime int64  `json:"countdownTime"`
+}
+
+// CountdownCancelAllService set the auto-cancel all open orders (kill-switch) config,
+// all open orders of the underlying are canceled if no heartbeat is received in countdownTime
+type CountdownCancelAllService struct {
+	c             *Client
+	underlying    string
+	countdownTime int64
+}
+
+// Underlying set underlying, e.g. BTCUSDT
+func (s *CountdownCancelAllService) Underlying(underlying string) *CountdownCancelAllService {
+	s.underlying = underlying
+	return s
+}
+
+// CountdownTime set countdownTime in milliseconds, at least 5000, 0 disables the countdown
+func (s *CountdownCancelAllService) CountdownTime(countdownTime int64) *CountdownCancelAllService {
+	s.countdownTime = countdownTime
+	return s
+}
+
+// Do send request
+func (s *CountdownCancelAllService) Do(ctx context.Context, opts ...RequestOption) (res *CountdownCancelAll, err error) {
+	r := &request{
+		method:   http.MethodPost,
+		endpoint: "/eapi/v1/countdownCancelAll",
+		secType:  secTypeSigned,
+	}
+	r.setFormParams(params{
+		"underlying":    s.underlying,
+		"countdownTime": s.countdownTime,
+	})
+	data, _, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(CountdownCancelAll)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// GetCountdownCancelAllService get the auto-cancel all open orders config
+type GetCountdownCancelAllService struct {
+	c          *Client
+	underlying *string
+}
+
+// Underlying set underlying, e.g. BTCUSDT
+func (s *GetCountdownCancelAllService) Underlying(underlying string) *GetCountdownCancelAllService {
+	s.underlying = &underlying
+	return s
+}
+
+// Do send request
+func (s *GetCountdownCancelAllService) Do(ctx context.Context, opts ...RequestOption) (res *CountdownCancelAll, err error) {
+	r := &request{
+		method:   http.MethodGet,
+		endpoint: "/eapi/v1/countdownCancelAll",
+		secType:  secTypeSigned,
+	}
+	if s.underlying != nil {
+		r.setParam("underlying", *s.underlying)
+	}
+	data, _, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(CountdownCancelAll)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// CountdownCancelAllHeartBeat define the underlyings whose countdown was reset
+type CountdownCancelAllHeartBeat struct {
+	Underlyings []string `json:"underlyings"`
+}
+
+// CountdownCancelAllHeartBeatService reset the countdown of the underlyings,
+// it should be sent periodically to keep the open orders
+type CountdownCancelAllHeartBeatService struct {
+	c           *Client
+	underlyings []string
+}
+
+// Underlyings set underlyings, e.g. BTCUSDT, ETHUSDT
+func (s *CountdownCancelAllHeartBeatService) Underlyings(underlyings ...string) *CountdownCancelAllHeartBeatService {
+	s.underlyings = underlyings
+	return s
+}
+
+// Do send request
+func (s *CountdownCancelAllHeartBeatService) Do(ctx context.Context, opts ...RequestOption) (res *CountdownCancelAllHeartBeat, err error) {
+	r := &request{
+		method:   http.MethodPost,
+		endpoint: "/eapi/v1/countdownCancelAllHeartBeat",
+		secType:  secTypeSigned,
+	}
+	r.setFormParam("underlyings", strings.Join(s.underlyings, ","))
+	data, _, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(CountdownCancelAllHeartBeat)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
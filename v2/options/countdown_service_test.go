@@ -0,0 +1,66 @@
+package options
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type countdownServiceTestSuite struct {
+	baseTestSuite
+}
+
+func TestCountdownService(t *testing.T) {
+	suite.Run(t, new(countdownServiceTestSuite))
+}
+
+func (s *countdownServiceTestSuite) TestCountdownCancelAll() {
+	data := []byte(`{"underlying": "ETHUSDT", "countdownTime": 100000}`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+
+	s.assertReq(func(r *request) {
+		s.r().Equal(http.MethodPost, r.method)
+		s.r().Equal("/eapi/v1/countdownCancelAll", r.endpoint)
+		e := newSignedRequest()
+		e.setFormParams(params{
+			"underlying":    "ETHUSDT",
+			"countdownTime": 100000,
+		})
+		s.assertRequestEqual(e, r)
+	})
+	res, err := s.client.NewCountdownCancelAllService().Underlying("ETHUSDT").CountdownTime(100000).Do(newContext())
+	s.r().NoError(err)
+	s.r().Equal(&CountdownCancelAll{Underlying: "ETHUSDT", CountdownTime: 100000}, res)
+}
+
+func (s *countdownServiceTestSuite) TestGetCountdownCancelAll() {
+	data := []byte(`{"underlying": "ETHUSDT", "countdownTime": 100000}`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+
+	s.assertReq(func(r *request) {
+		s.r().Equal(http.MethodGet, r.method)
+		s.assertRequestEqual(newSignedRequest().setParam("underlying", "ETHUSDT"), r)
+	})
+	res, err := s.client.NewGetCountdownCancelAllService().Underlying("ETHUSDT").Do(newContext())
+	s.r().NoError(err)
+	s.r().Equal(&CountdownCancelAll{Underlying: "ETHUSDT", CountdownTime: 100000}, res)
+}
+
+func (s *countdownServiceTestSuite) TestCountdownCancelAllHeartBeat() {
+	data := []byte(`{"underlyings": ["BTCUSDT", "ETHUSDT"]}`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+
+	s.assertReq(func(r *request) {
+		s.r().Equal("/eapi/v1/countdownCancelAllHeartBeat", r.endpoint)
+		e := newSignedRequest()
+		e.setFormParam("underlyings", "BTCUSDT,ETHUSDT")
+		s.assertRequestEqual(e, r)
+	})
+	res, err := s.client.NewCountdownCancelAllHeartBeatService().Underlyings("BTCUSDT", "ETHUSDT").Do(newContext())
+	s.r().NoError(err)
+	s.r().Equal([]string{"BTCUSDT", "ETHUSDT"}, res.Underlyings)
+}
@@ -0,0 +1,56 @@
+package options
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+)
+
+// MarginAccountService get the option margin account information
+type MarginAccountService struct {
+	c *Client
+}
+
+// Do send request
+func (s *MarginAccountService) Do(ctx context.Context, opts ...RequestOption) (res *MarginAccount, err error) {
+	r := &request{
+		method:   http.MethodGet,
+		endpoint: "/eapi/v1/marginAccount",
+		secType:  secTypeSigned,
+	}
+	data, _, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(MarginAccount)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// MarginAsset define an asset of the margin account
+type MarginAsset struct {
+	Asset         string `json:"asset"`
+	MarginBalance string `json:"marginBalance"`
+	Equity        string `json:"equity"`
+	Available     string `json:"available"`
+	InitialMargin string `json:"initialMargin"`
+	MaintMargin   string `json:"maintMargin"`
+	UnrealizedPNL string `json:"unrealizedPNL"`
+	LpProfit      string `json:"lpProfit"`
+}
+
+// MarginAccount define the margin account information
+type MarginAccount struct {
+	Asset        []*MarginAsset `json:"asset"`
+	Greek        []*Greek       `json:"greek"`
+	Time         int64          `json:"time"`
+	CanTrade     bool           `json:"canTrade"`
+	CanDeposit   bool           `json:"canDeposit"`
+	CanWithdraw  bool           `json:"canWithdraw"`
+	ReduceOnly   bool           `json:"reduceOnly"`
+	TradeGroupId int64          `json:"tradeGroupId"`
+	RiskLevel    string         `json:"riskLevel"`
+}
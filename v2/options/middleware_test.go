@@ -0,0 +1,41 @@
+package options
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/adshao/go-binance/v2/common"
+)
+
+type middlewareTestSuite struct {
+	baseTestSuite
+}
+
+func TestMiddleware(t *testing.T) {
+	suite.Run(t, new(middlewareTestSuite))
+}
+
+func (s *middlewareTestSuite) TestUse() {
+	data := []byte(`{"code":-1121,"msg":"Invalid symbol."}`)
+	s.mockDo(data, nil, http.StatusBadRequest)
+	defer s.assertDo()
+
+	var seen *common.Response
+	var seenErr error
+	s.client.Use(func(next common.Handler) common.Handler {
+		return func(req *http.Request) (*common.Response, error) {
+			s.r().Equal("/test", req.URL.Path)
+			seen, seenErr = next(req)
+			return seen, seenErr
+		}
+	})
+
+	_, _, err := s.client.callAPI(newContext(), &request{method: http.MethodGet, endpoint: "/test"})
+	r := s.r()
+	r.Error(err)
+	r.Equal(seenErr, err)
+	r.Equal(http.StatusBadRequest, seen.StatusCode)
+	r.Equal(data, seen.Body)
+}
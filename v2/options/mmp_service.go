@@ -0,0 +1,134 @@
+package options
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+)
+
+// MMPConfig define the market maker protection config of an underlying
+type MMPConfig struct {
+	UnderlyingId             int64  `json:"underlyingId"`
+	Underlying               string `json:"underlying"`
+	WindowTimeInMilliseconds int64  `json:"windowTimeInMilliseconds"`
+	FrozenTimeInMilliseconds int64  `json:"frozenTimeInMilliseconds"`
+	QtyLimit                 string `json:"qtyLimit"`
+	DeltaLimit               string `json:"deltaLimit"`
+	LastTriggerTime          int64  `json:"lastTriggerTime"`
+}
+
+func (c *Client) doMMP(ctx context.Context, r *request, opts ...RequestOption) (res *MMPConfig, err error) {
+	data, _, err := c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(MMPConfig)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// SetMMPService set the market maker protection config of an underlying
+type SetMMPService struct {
+	c                        *Client
+	underlying               string
+	windowTimeInMilliseconds int64
+	frozenTimeInMilliseconds int64
+	qtyLimit                 string
+	deltaLimit               string
+}
+
+// Underlying set underlying, e.g. BTCUSDT
+func (s *SetMMPService) Underlying(underlying string) *SetMMPService {
+	s.underlying = underlying
+	return s
+}
+
+// WindowTimeInMilliseconds set the time window in which qtyLimit and deltaLimit are counted, max 5000
+func (s *SetMMPService) WindowTimeInMilliseconds(windowTime int64) *SetMMPService {
+	s.windowTimeInMilliseconds = windowTime
+	return s
+}
+
+// FrozenTimeInMilliseconds set how long the MMP stays triggered, 0 means until reset
+func (s *SetMMPService) FrozenTimeInMilliseconds(frozenTime int64) *SetMMPService {
+	s.frozenTimeInMilliseconds = frozenTime
+	return s
+}
+
+// QtyLimit set qtyLimit
+func (s *SetMMPService) QtyLimit(qtyLimit string) *SetMMPService {
+	s.qtyLimit = qtyLimit
+	return s
+}
+
+// DeltaLimit set deltaLimit
+func (s *SetMMPService) DeltaLimit(deltaLimit string) *SetMMPService {
+	s.deltaLimit = deltaLimit
+	return s
+}
+
+// Do send request
+func (s *SetMMPService) Do(ctx context.Context, opts ...RequestOption) (res *MMPConfig, err error) {
+	r := &request{
+		method:   http.MethodPost,
+		endpoint: "/eapi/v1/mmpSet",
+		secType:  secTypeSigned,
+	}
+	r.setFormParams(params{
+		"underlying":               s.underlying,
+		"windowTimeInMilliseconds": s.windowTimeInMilliseconds,
+		"frozenTimeInMilliseconds": s.frozenTimeInMilliseconds,
+		"qtyLimit":                 s.qtyLimit,
+		"deltaLimit":               s.deltaLimit,
+	})
+	return s.c.doMMP(ctx, r, opts...)
+}
+
+// GetMMPService get the market maker protection config of an underlying
+type GetMMPService struct {
+	c          *Client
+	underlying string
+}
+
+// Underlying set underlying, e.g. BTCUSDT
+func (s *GetMMPService) Underlying(underlying string) *GetMMPService {
+	s.underlying = underlying
+	return s
+}
+
+// Do send request
+func (s *GetMMPService) Do(ctx context.Context, opts ...RequestOption) (res *MMPConfig, err error) {
+	r := &request{
+		method:   http.MethodGet,
+		endpoint: "/eapi/v1/mmp",
+		secType:  secTypeSigned,
+	}
+	r.setParam("underlying", s.underlying)
+	return s.c.doMMP(ctx, r, opts...)
+}
+
+// ResetMMPService reset a triggered market maker protection, quoting is allowed again
+type ResetMMPService struct {
+	c          *Client
+	underlying string
+}
+
+// Underlying set underlying, e.g. BTCUSDT
+func (s *ResetMMPService) Underlying(underlying string) *ResetMMPService {
+	s.underlying = underlying
+	return s
+}
+
+// Do send request
+func (s *ResetMMPService) Do(ctx context.Context, opts ...RequestOption) (res *MMPConfig, err error) {
+	r := &request{
+		method:   http.MethodPost,
+		endpoint: "/eapi/v1/mmpReset",
+		secType:  secTypeSigned,
+	}
+	r.setFormParam("underlying", s.underlying)
+	return s.c.doMMP(ctx, r, opts...)
+}
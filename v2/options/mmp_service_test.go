@@ -0,0 +1,90 @@
+package options
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type mmpServiceTestSuite struct {
+	baseTestSuite
+}
+
+func TestMMPService(t *testing.T) {
+	suite.Run(t, new(mmpServiceTestSuite))
+}
+
+var mmpConfigData = []byte(`{
+	"underlyingId": 2,
+	"underlying": "BTCUSDT",
+	"windowTimeInMilliseconds": 3000,
+	"frozenTimeInMilliseconds": 300000,
+	"qtyLimit": "2",
+	"deltaLimit": "2.3",
+	"lastTriggerTime": 0
+}`)
+
+func (s *mmpServiceTestSuite) assertMMPConfig(res *MMPConfig) {
+	s.r().Equal(&MMPConfig{
+		UnderlyingId:             2,
+		Underlying:               "BTCUSDT",
+		WindowTimeInMilliseconds: 3000,
+		FrozenTimeInMilliseconds: 300000,
+		QtyLimit:                 "2",
+		DeltaLimit:               "2.3",
+	}, res)
+}
+
+func (s *mmpServiceTestSuite) TestSetMMP() {
+	s.mockDo(mmpConfigData, nil)
+	defer s.assertDo()
+
+	s.assertReq(func(r *request) {
+		s.r().Equal(http.MethodPost, r.method)
+		s.r().Equal("/eapi/v1/mmpSet", r.endpoint)
+		e := newSignedRequest()
+		e.setFormParams(params{
+			"underlying":               "BTCUSDT",
+			"windowTimeInMilliseconds": 3000,
+			"frozenTimeInMilliseconds": 300000,
+			"qtyLimit":                 "2",
+			"deltaLimit":               "2.3",
+		})
+		s.assertRequestEqual(e, r)
+	})
+	res, err := s.client.NewSetMMPService().Underlying("BTCUSDT").WindowTimeInMilliseconds(3000).
+		FrozenTimeInMilliseconds(300000).QtyLimit("2").DeltaLimit("2.3").Do(newContext())
+	s.r().NoError(err)
+	s.assertMMPConfig(res)
+}
+
+func (s *mmpServiceTestSuite) TestGetMMP() {
+	s.mockDo(mmpConfigData, nil)
+	defer s.assertDo()
+
+	s.assertReq(func(r *request) {
+		s.r().Equal(http.MethodGet, r.method)
+		s.r().Equal("/eapi/v1/mmp", r.endpoint)
+		s.assertRequestEqual(newSignedRequest().setParam("underlying", "BTCUSDT"), r)
+	})
+	res, err := s.client.NewGetMMPService().Underlying("BTCUSDT").Do(newContext())
+	s.r().NoError(err)
+	s.assertMMPConfig(res)
+}
+
+func (s *mmpServiceTestSuite) TestResetMMP() {
+	s.mockDo(mmpConfigData, nil)
+	defer s.assertDo()
+
+	s.assertReq(func(r *request) {
+		s.r().Equal(http.MethodPost, r.method)
+		s.r().Equal("/eapi/v1/mmpReset", r.endpoint)
+		e := newSignedRequest()
+		e.setFormParam("underlying", "BTCUSDT")
+		s.assertRequestEqual(e, r)
+	})
+	res, err := s.client.NewResetMMPService().Underlying("BTCUSDT").Do(newContext())
+	s.r().NoError(err)
+	s.assertMMPConfig(res)
+}
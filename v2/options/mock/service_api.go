@@ -0,0 +1,3977 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: service_api.go
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	options "github.com/adshao/go-binance/v2/options"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockClientAPI is a mock of ClientAPI interface.
+type MockClientAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockClientAPIMockRecorder
+}
+
+// MockClientAPIMockRecorder is the mock recorder for MockClientAPI.
+type MockClientAPIMockRecorder struct {
+	mock *MockClientAPI
+}
+
+// NewMockClientAPI creates a new mock instance.
+func NewMockClientAPI(ctrl *gomock.Controller) *MockClientAPI {
+	mock := &MockClientAPI{ctrl: ctrl}
+	mock.recorder = &MockClientAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockClientAPI) EXPECT() *MockClientAPIMockRecorder {
+	return m.recorder
+}
+
+// NewAcceptBlockTradeOrderService mocks base method.
+func (m *MockClientAPI) NewAcceptBlockTradeOrderService() options.AcceptBlockTradeOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewAcceptBlockTradeOrderService")
+	ret0, _ := ret[0].(options.AcceptBlockTradeOrderServiceAPI)
+	return ret0
+}
+
+// NewAcceptBlockTradeOrderService indicates an expected call of NewAcceptBlockTradeOrderService.
+func (mr *MockClientAPIMockRecorder) NewAcceptBlockTradeOrderService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewAcceptBlockTradeOrderService", reflect.TypeOf((*MockClientAPI)(nil).NewAcceptBlockTradeOrderService))
+}
+
+// NewAccountService mocks base method.
+func (m *MockClientAPI) NewAccountService() options.AccountServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewAccountService")
+	ret0, _ := ret[0].(options.AccountServiceAPI)
+	return ret0
+}
+
+// NewAccountService indicates an expected call of NewAccountService.
+func (mr *MockClientAPIMockRecorder) NewAccountService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewAccountService", reflect.TypeOf((*MockClientAPI)(nil).NewAccountService))
+}
+
+// NewBillService mocks base method.
+func (m *MockClientAPI) NewBillService() options.BillServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewBillService")
+	ret0, _ := ret[0].(options.BillServiceAPI)
+	return ret0
+}
+
+// NewBillService indicates an expected call of NewBillService.
+func (mr *MockClientAPIMockRecorder) NewBillService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewBillService", reflect.TypeOf((*MockClientAPI)(nil).NewBillService))
+}
+
+// NewCancelAllOpenOrdersByUnderlyingService mocks base method.
+func (m *MockClientAPI) NewCancelAllOpenOrdersByUnderlyingService() options.CancelAllOpenOrdersByUnderlyingServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewCancelAllOpenOrdersByUnderlyingService")
+	ret0, _ := ret[0].(options.CancelAllOpenOrdersByUnderlyingServiceAPI)
+	return ret0
+}
+
+// NewCancelAllOpenOrdersByUnderlyingService indicates an expected call of NewCancelAllOpenOrdersByUnderlyingService.
+func (mr *MockClientAPIMockRecorder) NewCancelAllOpenOrdersByUnderlyingService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewCancelAllOpenOrdersByUnderlyingService", reflect.TypeOf((*MockClientAPI)(nil).NewCancelAllOpenOrdersByUnderlyingService))
+}
+
+// NewCancelAllOpenOrdersService mocks base method.
+func (m *MockClientAPI) NewCancelAllOpenOrdersService() options.CancelAllOpenOrdersServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewCancelAllOpenOrdersService")
+	ret0, _ := ret[0].(options.CancelAllOpenOrdersServiceAPI)
+	return ret0
+}
+
+// NewCancelAllOpenOrdersService indicates an expected call of NewCancelAllOpenOrdersService.
+func (mr *MockClientAPIMockRecorder) NewCancelAllOpenOrdersService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewCancelAllOpenOrdersService", reflect.TypeOf((*MockClientAPI)(nil).NewCancelAllOpenOrdersService))
+}
+
+// NewCancelBatchOrdersService mocks base method.
+func (m *MockClientAPI) NewCancelBatchOrdersService() options.CancelBatchOrdersServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewCancelBatchOrdersService")
+	ret0, _ := ret[0].(options.CancelBatchOrdersServiceAPI)
+	return ret0
+}
+
+// NewCancelBatchOrdersService indicates an expected call of NewCancelBatchOrdersService.
+func (mr *MockClientAPIMockRecorder) NewCancelBatchOrdersService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewCancelBatchOrdersService", reflect.TypeOf((*MockClientAPI)(nil).NewCancelBatchOrdersService))
+}
+
+// NewCancelBlockTradeOrderService mocks base method.
+func (m *MockClientAPI) NewCancelBlockTradeOrderService() options.CancelBlockTradeOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewCancelBlockTradeOrderService")
+	ret0, _ := ret[0].(options.CancelBlockTradeOrderServiceAPI)
+	return ret0
+}
+
+// NewCancelBlockTradeOrderService indicates an expected call of NewCancelBlockTradeOrderService.
+func (mr *MockClientAPIMockRecorder) NewCancelBlockTradeOrderService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewCancelBlockTradeOrderService", reflect.TypeOf((*MockClientAPI)(nil).NewCancelBlockTradeOrderService))
+}
+
+// NewCancelOrderService mocks base method.
+func (m *MockClientAPI) NewCancelOrderService() options.CancelOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewCancelOrderService")
+	ret0, _ := ret[0].(options.CancelOrderServiceAPI)
+	return ret0
+}
+
+// NewCancelOrderService indicates an expected call of NewCancelOrderService.
+func (mr *MockClientAPIMockRecorder) NewCancelOrderService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewCancelOrderService", reflect.TypeOf((*MockClientAPI)(nil).NewCancelOrderService))
+}
+
+// NewCloseUserStreamService mocks base method.
+func (m *MockClientAPI) NewCloseUserStreamService() options.CloseUserStreamServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewCloseUserStreamService")
+	ret0, _ := ret[0].(options.CloseUserStreamServiceAPI)
+	return ret0
+}
+
+// NewCloseUserStreamService indicates an expected call of NewCloseUserStreamService.
+func (mr *MockClientAPIMockRecorder) NewCloseUserStreamService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewCloseUserStreamService", reflect.TypeOf((*MockClientAPI)(nil).NewCloseUserStreamService))
+}
+
+// NewCountdownCancelAllHeartBeatService mocks base method.
+func (m *MockClientAPI) NewCountdownCancelAllHeartBeatService() options.CountdownCancelAllHeartBeatServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewCountdownCancelAllHeartBeatService")
+	ret0, _ := ret[0].(options.CountdownCancelAllHeartBeatServiceAPI)
+	return ret0
+}
+
+// NewCountdownCancelAllHeartBeatService indicates an expected call of NewCountdownCancelAllHeartBeatService.
+func (mr *MockClientAPIMockRecorder) NewCountdownCancelAllHeartBeatService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewCountdownCancelAllHeartBeatService", reflect.TypeOf((*MockClientAPI)(nil).NewCountdownCancelAllHeartBeatService))
+}
+
+// NewCountdownCancelAllService mocks base method.
+func (m *MockClientAPI) NewCountdownCancelAllService() options.CountdownCancelAllServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewCountdownCancelAllService")
+	ret0, _ := ret[0].(options.CountdownCancelAllServiceAPI)
+	return ret0
+}
+
+// NewCountdownCancelAllService indicates an expected call of NewCountdownCancelAllService.
+func (mr *MockClientAPIMockRecorder) NewCountdownCancelAllService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewCountdownCancelAllService", reflect.TypeOf((*MockClientAPI)(nil).NewCountdownCancelAllService))
+}
+
+// NewCreateBatchOrdersService mocks base method.
+func (m *MockClientAPI) NewCreateBatchOrdersService() options.CreateBatchOrdersServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewCreateBatchOrdersService")
+	ret0, _ := ret[0].(options.CreateBatchOrdersServiceAPI)
+	return ret0
+}
+
+// NewCreateBatchOrdersService indicates an expected call of NewCreateBatchOrdersService.
+func (mr *MockClientAPIMockRecorder) NewCreateBatchOrdersService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewCreateBatchOrdersService", reflect.TypeOf((*MockClientAPI)(nil).NewCreateBatchOrdersService))
+}
+
+// NewCreateBlockTradeOrderService mocks base method.
+func (m *MockClientAPI) NewCreateBlockTradeOrderService() options.CreateBlockTradeOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewCreateBlockTradeOrderService")
+	ret0, _ := ret[0].(options.CreateBlockTradeOrderServiceAPI)
+	return ret0
+}
+
+// NewCreateBlockTradeOrderService indicates an expected call of NewCreateBlockTradeOrderService.
+func (mr *MockClientAPIMockRecorder) NewCreateBlockTradeOrderService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewCreateBlockTradeOrderService", reflect.TypeOf((*MockClientAPI)(nil).NewCreateBlockTradeOrderService))
+}
+
+// NewCreateOrderService mocks base method.
+func (m *MockClientAPI) NewCreateOrderService() options.CreateOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewCreateOrderService")
+	ret0, _ := ret[0].(options.CreateOrderServiceAPI)
+	return ret0
+}
+
+// NewCreateOrderService indicates an expected call of NewCreateOrderService.
+func (mr *MockClientAPIMockRecorder) NewCreateOrderService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewCreateOrderService", reflect.TypeOf((*MockClientAPI)(nil).NewCreateOrderService))
+}
+
+// NewDepthService mocks base method.
+func (m *MockClientAPI) NewDepthService() options.DepthServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewDepthService")
+	ret0, _ := ret[0].(options.DepthServiceAPI)
+	return ret0
+}
+
+// NewDepthService indicates an expected call of NewDepthService.
+func (mr *MockClientAPIMockRecorder) NewDepthService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewDepthService", reflect.TypeOf((*MockClientAPI)(nil).NewDepthService))
+}
+
+// NewExchangeInfoService mocks base method.
+func (m *MockClientAPI) NewExchangeInfoService() options.ExchangeInfoServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewExchangeInfoService")
+	ret0, _ := ret[0].(options.ExchangeInfoServiceAPI)
+	return ret0
+}
+
+// NewExchangeInfoService indicates an expected call of NewExchangeInfoService.
+func (mr *MockClientAPIMockRecorder) NewExchangeInfoService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewExchangeInfoService", reflect.TypeOf((*MockClientAPI)(nil).NewExchangeInfoService))
+}
+
+// NewExerciseHistoryService mocks base method.
+func (m *MockClientAPI) NewExerciseHistoryService() options.ExerciseHistoryServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewExerciseHistoryService")
+	ret0, _ := ret[0].(options.ExerciseHistoryServiceAPI)
+	return ret0
+}
+
+// NewExerciseHistoryService indicates an expected call of NewExerciseHistoryService.
+func (mr *MockClientAPIMockRecorder) NewExerciseHistoryService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewExerciseHistoryService", reflect.TypeOf((*MockClientAPI)(nil).NewExerciseHistoryService))
+}
+
+// NewExercistRecordService mocks base method.
+func (m *MockClientAPI) NewExercistRecordService() options.ExerciseRecordServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewExercistRecordService")
+	ret0, _ := ret[0].(options.ExerciseRecordServiceAPI)
+	return ret0
+}
+
+// NewExercistRecordService indicates an expected call of NewExercistRecordService.
+func (mr *MockClientAPIMockRecorder) NewExercistRecordService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewExercistRecordService", reflect.TypeOf((*MockClientAPI)(nil).NewExercistRecordService))
+}
+
+// NewExtendBlockTradeOrderService mocks base method.
+func (m *MockClientAPI) NewExtendBlockTradeOrderService() options.ExtendBlockTradeOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewExtendBlockTradeOrderService")
+	ret0, _ := ret[0].(options.ExtendBlockTradeOrderServiceAPI)
+	return ret0
+}
+
+// NewExtendBlockTradeOrderService indicates an expected call of NewExtendBlockTradeOrderService.
+func (mr *MockClientAPIMockRecorder) NewExtendBlockTradeOrderService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewExtendBlockTradeOrderService", reflect.TypeOf((*MockClientAPI)(nil).NewExtendBlockTradeOrderService))
+}
+
+// NewGetBlockTradeOrderService mocks base method.
+func (m *MockClientAPI) NewGetBlockTradeOrderService() options.GetBlockTradeOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewGetBlockTradeOrderService")
+	ret0, _ := ret[0].(options.GetBlockTradeOrderServiceAPI)
+	return ret0
+}
+
+// NewGetBlockTradeOrderService indicates an expected call of NewGetBlockTradeOrderService.
+func (mr *MockClientAPIMockRecorder) NewGetBlockTradeOrderService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewGetBlockTradeOrderService", reflect.TypeOf((*MockClientAPI)(nil).NewGetBlockTradeOrderService))
+}
+
+// NewGetCountdownCancelAllService mocks base method.
+func (m *MockClientAPI) NewGetCountdownCancelAllService() options.GetCountdownCancelAllServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewGetCountdownCancelAllService")
+	ret0, _ := ret[0].(options.GetCountdownCancelAllServiceAPI)
+	return ret0
+}
+
+// NewGetCountdownCancelAllService indicates an expected call of NewGetCountdownCancelAllService.
+func (mr *MockClientAPIMockRecorder) NewGetCountdownCancelAllService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewGetCountdownCancelAllService", reflect.TypeOf((*MockClientAPI)(nil).NewGetCountdownCancelAllService))
+}
+
+// NewGetMMPService mocks base method.
+func (m *MockClientAPI) NewGetMMPService() options.GetMMPServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewGetMMPService")
+	ret0, _ := ret[0].(options.GetMMPServiceAPI)
+	return ret0
+}
+
+// NewGetMMPService indicates an expected call of NewGetMMPService.
+func (mr *MockClientAPIMockRecorder) NewGetMMPService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewGetMMPService", reflect.TypeOf((*MockClientAPI)(nil).NewGetMMPService))
+}
+
+// NewGetOrderService mocks base method.
+func (m *MockClientAPI) NewGetOrderService() options.GetOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewGetOrderService")
+	ret0, _ := ret[0].(options.GetOrderServiceAPI)
+	return ret0
+}
+
+// NewGetOrderService indicates an expected call of NewGetOrderService.
+func (mr *MockClientAPIMockRecorder) NewGetOrderService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewGetOrderService", reflect.TypeOf((*MockClientAPI)(nil).NewGetOrderService))
+}
+
+// NewHistoricalTradesService mocks base method.
+func (m *MockClientAPI) NewHistoricalTradesService() options.HistoricalTradesServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewHistoricalTradesService")
+	ret0, _ := ret[0].(options.HistoricalTradesServiceAPI)
+	return ret0
+}
+
+// NewHistoricalTradesService indicates an expected call of NewHistoricalTradesService.
+func (mr *MockClientAPIMockRecorder) NewHistoricalTradesService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewHistoricalTradesService", reflect.TypeOf((*MockClientAPI)(nil).NewHistoricalTradesService))
+}
+
+// NewHistoryOrdersService mocks base method.
+func (m *MockClientAPI) NewHistoryOrdersService() options.HistoryOrdersServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewHistoryOrdersService")
+	ret0, _ := ret[0].(options.HistoryOrdersServiceAPI)
+	return ret0
+}
+
+// NewHistoryOrdersService indicates an expected call of NewHistoryOrdersService.
+func (mr *MockClientAPIMockRecorder) NewHistoryOrdersService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewHistoryOrdersService", reflect.TypeOf((*MockClientAPI)(nil).NewHistoryOrdersService))
+}
+
+// NewIncomeDownloadIdService mocks base method.
+func (m *MockClientAPI) NewIncomeDownloadIdService() options.IncomeDownloadIdServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewIncomeDownloadIdService")
+	ret0, _ := ret[0].(options.IncomeDownloadIdServiceAPI)
+	return ret0
+}
+
+// NewIncomeDownloadIdService indicates an expected call of NewIncomeDownloadIdService.
+func (mr *MockClientAPIMockRecorder) NewIncomeDownloadIdService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewIncomeDownloadIdService", reflect.TypeOf((*MockClientAPI)(nil).NewIncomeDownloadIdService))
+}
+
+// NewIncomeDownloadLinkService mocks base method.
+func (m *MockClientAPI) NewIncomeDownloadLinkService() options.IncomeDownloadLinkServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewIncomeDownloadLinkService")
+	ret0, _ := ret[0].(options.IncomeDownloadLinkServiceAPI)
+	return ret0
+}
+
+// NewIncomeDownloadLinkService indicates an expected call of NewIncomeDownloadLinkService.
+func (mr *MockClientAPIMockRecorder) NewIncomeDownloadLinkService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewIncomeDownloadLinkService", reflect.TypeOf((*MockClientAPI)(nil).NewIncomeDownloadLinkService))
+}
+
+// NewIndexService mocks base method.
+func (m *MockClientAPI) NewIndexService() options.IndexServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewIndexService")
+	ret0, _ := ret[0].(options.IndexServiceAPI)
+	return ret0
+}
+
+// NewIndexService indicates an expected call of NewIndexService.
+func (mr *MockClientAPIMockRecorder) NewIndexService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewIndexService", reflect.TypeOf((*MockClientAPI)(nil).NewIndexService))
+}
+
+// NewKeepaliveUserStreamService mocks base method.
+func (m *MockClientAPI) NewKeepaliveUserStreamService() options.KeepaliveUserStreamServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewKeepaliveUserStreamService")
+	ret0, _ := ret[0].(options.KeepaliveUserStreamServiceAPI)
+	return ret0
+}
+
+// NewKeepaliveUserStreamService indicates an expected call of NewKeepaliveUserStreamService.
+func (mr *MockClientAPIMockRecorder) NewKeepaliveUserStreamService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewKeepaliveUserStreamService", reflect.TypeOf((*MockClientAPI)(nil).NewKeepaliveUserStreamService))
+}
+
+// NewKlinesService mocks base method.
+func (m *MockClientAPI) NewKlinesService() options.KlinesServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewKlinesService")
+	ret0, _ := ret[0].(options.KlinesServiceAPI)
+	return ret0
+}
+
+// NewKlinesService indicates an expected call of NewKlinesService.
+func (mr *MockClientAPIMockRecorder) NewKlinesService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewKlinesService", reflect.TypeOf((*MockClientAPI)(nil).NewKlinesService))
+}
+
+// NewListBlockTradeOrdersService mocks base method.
+func (m *MockClientAPI) NewListBlockTradeOrdersService() options.ListBlockTradeOrdersServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewListBlockTradeOrdersService")
+	ret0, _ := ret[0].(options.ListBlockTradeOrdersServiceAPI)
+	return ret0
+}
+
+// NewListBlockTradeOrdersService indicates an expected call of NewListBlockTradeOrdersService.
+func (mr *MockClientAPIMockRecorder) NewListBlockTradeOrdersService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewListBlockTradeOrdersService", reflect.TypeOf((*MockClientAPI)(nil).NewListBlockTradeOrdersService))
+}
+
+// NewListBlockUserTradesService mocks base method.
+func (m *MockClientAPI) NewListBlockUserTradesService() options.ListBlockUserTradesServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewListBlockUserTradesService")
+	ret0, _ := ret[0].(options.ListBlockUserTradesServiceAPI)
+	return ret0
+}
+
+// NewListBlockUserTradesService indicates an expected call of NewListBlockUserTradesService.
+func (mr *MockClientAPIMockRecorder) NewListBlockUserTradesService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewListBlockUserTradesService", reflect.TypeOf((*MockClientAPI)(nil).NewListBlockUserTradesService))
+}
+
+// NewListOpenOrdersService mocks base method.
+func (m *MockClientAPI) NewListOpenOrdersService() options.ListOpenOrdersServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewListOpenOrdersService")
+	ret0, _ := ret[0].(options.ListOpenOrdersServiceAPI)
+	return ret0
+}
+
+// NewListOpenOrdersService indicates an expected call of NewListOpenOrdersService.
+func (mr *MockClientAPIMockRecorder) NewListOpenOrdersService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewListOpenOrdersService", reflect.TypeOf((*MockClientAPI)(nil).NewListOpenOrdersService))
+}
+
+// NewMarginAccountService mocks base method.
+func (m *MockClientAPI) NewMarginAccountService() options.MarginAccountServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewMarginAccountService")
+	ret0, _ := ret[0].(options.MarginAccountServiceAPI)
+	return ret0
+}
+
+// NewMarginAccountService indicates an expected call of NewMarginAccountService.
+func (mr *MockClientAPIMockRecorder) NewMarginAccountService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewMarginAccountService", reflect.TypeOf((*MockClientAPI)(nil).NewMarginAccountService))
+}
+
+// NewMarkService mocks base method.
+func (m *MockClientAPI) NewMarkService() options.MarkServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewMarkService")
+	ret0, _ := ret[0].(options.MarkServiceAPI)
+	return ret0
+}
+
+// NewMarkService indicates an expected call of NewMarkService.
+func (mr *MockClientAPIMockRecorder) NewMarkService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewMarkService", reflect.TypeOf((*MockClientAPI)(nil).NewMarkService))
+}
+
+// NewOpenInterestService mocks base method.
+func (m *MockClientAPI) NewOpenInterestService() options.OpenInterestServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewOpenInterestService")
+	ret0, _ := ret[0].(options.OpenInterestServiceAPI)
+	return ret0
+}
+
+// NewOpenInterestService indicates an expected call of NewOpenInterestService.
+func (mr *MockClientAPIMockRecorder) NewOpenInterestService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewOpenInterestService", reflect.TypeOf((*MockClientAPI)(nil).NewOpenInterestService))
+}
+
+// NewPingService mocks base method.
+func (m *MockClientAPI) NewPingService() options.PingServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewPingService")
+	ret0, _ := ret[0].(options.PingServiceAPI)
+	return ret0
+}
+
+// NewPingService indicates an expected call of NewPingService.
+func (mr *MockClientAPIMockRecorder) NewPingService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewPingService", reflect.TypeOf((*MockClientAPI)(nil).NewPingService))
+}
+
+// NewPositionService mocks base method.
+func (m *MockClientAPI) NewPositionService() options.PositionServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewPositionService")
+	ret0, _ := ret[0].(options.PositionServiceAPI)
+	return ret0
+}
+
+// NewPositionService indicates an expected call of NewPositionService.
+func (mr *MockClientAPIMockRecorder) NewPositionService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewPositionService", reflect.TypeOf((*MockClientAPI)(nil).NewPositionService))
+}
+
+// NewResetMMPService mocks base method.
+func (m *MockClientAPI) NewResetMMPService() options.ResetMMPServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewResetMMPService")
+	ret0, _ := ret[0].(options.ResetMMPServiceAPI)
+	return ret0
+}
+
+// NewResetMMPService indicates an expected call of NewResetMMPService.
+func (mr *MockClientAPIMockRecorder) NewResetMMPService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewResetMMPService", reflect.TypeOf((*MockClientAPI)(nil).NewResetMMPService))
+}
+
+// NewServerTimeService mocks base method.
+func (m *MockClientAPI) NewServerTimeService() options.ServerTimeServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewServerTimeService")
+	ret0, _ := ret[0].(options.ServerTimeServiceAPI)
+	return ret0
+}
+
+// NewServerTimeService indicates an expected call of NewServerTimeService.
+func (mr *MockClientAPIMockRecorder) NewServerTimeService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewServerTimeService", reflect.TypeOf((*MockClientAPI)(nil).NewServerTimeService))
+}
+
+// NewSetMMPService mocks base method.
+func (m *MockClientAPI) NewSetMMPService() options.SetMMPServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewSetMMPService")
+	ret0, _ := ret[0].(options.SetMMPServiceAPI)
+	return ret0
+}
+
+// NewSetMMPService indicates an expected call of NewSetMMPService.
+func (mr *MockClientAPIMockRecorder) NewSetMMPService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewSetMMPService", reflect.TypeOf((*MockClientAPI)(nil).NewSetMMPService))
+}
+
+// NewStartUserStreamService mocks base method.
+func (m *MockClientAPI) NewStartUserStreamService() options.StartUserStreamServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewStartUserStreamService")
+	ret0, _ := ret[0].(options.StartUserStreamServiceAPI)
+	return ret0
+}
+
+// NewStartUserStreamService indicates an expected call of NewStartUserStreamService.
+func (mr *MockClientAPIMockRecorder) NewStartUserStreamService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewStartUserStreamService", reflect.TypeOf((*MockClientAPI)(nil).NewStartUserStreamService))
+}
+
+// NewTickerService mocks base method.
+func (m *MockClientAPI) NewTickerService() options.TickerServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewTickerService")
+	ret0, _ := ret[0].(options.TickerServiceAPI)
+	return ret0
+}
+
+// NewTickerService indicates an expected call of NewTickerService.
+func (mr *MockClientAPIMockRecorder) NewTickerService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewTickerService", reflect.TypeOf((*MockClientAPI)(nil).NewTickerService))
+}
+
+// NewTradesService mocks base method.
+func (m *MockClientAPI) NewTradesService() options.TradesServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewTradesService")
+	ret0, _ := ret[0].(options.TradesServiceAPI)
+	return ret0
+}
+
+// NewTradesService indicates an expected call of NewTradesService.
+func (mr *MockClientAPIMockRecorder) NewTradesService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewTradesService", reflect.TypeOf((*MockClientAPI)(nil).NewTradesService))
+}
+
+// NewUserTradesService mocks base method.
+func (m *MockClientAPI) NewUserTradesService() options.UserTradesServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewUserTradesService")
+	ret0, _ := ret[0].(options.UserTradesServiceAPI)
+	return ret0
+}
+
+// NewUserTradesService indicates an expected call of NewUserTradesService.
+func (mr *MockClientAPIMockRecorder) NewUserTradesService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewUserTradesService", reflect.TypeOf((*MockClientAPI)(nil).NewUserTradesService))
+}
+
+// MockPingServiceAPI is a mock of PingServiceAPI interface.
+type MockPingServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockPingServiceAPIMockRecorder
+}
+
+// MockPingServiceAPIMockRecorder is the mock recorder for MockPingServiceAPI.
+type MockPingServiceAPIMockRecorder struct {
+	mock *MockPingServiceAPI
+}
+
+// NewMockPingServiceAPI creates a new mock instance.
+func NewMockPingServiceAPI(ctrl *gomock.Controller) *MockPingServiceAPI {
+	mock := &MockPingServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockPingServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPingServiceAPI) EXPECT() *MockPingServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockPingServiceAPI) Do(ctx context.Context, opts ...options.RequestOption) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockPingServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockPingServiceAPI)(nil).Do), varargs...)
+}
+
+// MockServerTimeServiceAPI is a mock of ServerTimeServiceAPI interface.
+type MockServerTimeServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockServerTimeServiceAPIMockRecorder
+}
+
+// MockServerTimeServiceAPIMockRecorder is the mock recorder for MockServerTimeServiceAPI.
+type MockServerTimeServiceAPIMockRecorder struct {
+	mock *MockServerTimeServiceAPI
+}
+
+// NewMockServerTimeServiceAPI creates a new mock instance.
+func NewMockServerTimeServiceAPI(ctrl *gomock.Controller) *MockServerTimeServiceAPI {
+	mock := &MockServerTimeServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockServerTimeServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockServerTimeServiceAPI) EXPECT() *MockServerTimeServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockServerTimeServiceAPI) Do(ctx context.Context, opts ...options.RequestOption) (int64, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockServerTimeServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockServerTimeServiceAPI)(nil).Do), varargs...)
+}
+
+// MockExchangeInfoServiceAPI is a mock of ExchangeInfoServiceAPI interface.
+type MockExchangeInfoServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockExchangeInfoServiceAPIMockRecorder
+}
+
+// MockExchangeInfoServiceAPIMockRecorder is the mock recorder for MockExchangeInfoServiceAPI.
+type MockExchangeInfoServiceAPIMockRecorder struct {
+	mock *MockExchangeInfoServiceAPI
+}
+
+// NewMockExchangeInfoServiceAPI creates a new mock instance.
+func NewMockExchangeInfoServiceAPI(ctrl *gomock.Controller) *MockExchangeInfoServiceAPI {
+	mock := &MockExchangeInfoServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockExchangeInfoServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockExchangeInfoServiceAPI) EXPECT() *MockExchangeInfoServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockExchangeInfoServiceAPI) Do(ctx context.Context, opts ...options.RequestOption) (*options.ExchangeInfo, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*options.ExchangeInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockExchangeInfoServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockExchangeInfoServiceAPI)(nil).Do), varargs...)
+}
+
+// MockDepthServiceAPI is a mock of DepthServiceAPI interface.
+type MockDepthServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockDepthServiceAPIMockRecorder
+}
+
+// MockDepthServiceAPIMockRecorder is the mock recorder for MockDepthServiceAPI.
+type MockDepthServiceAPIMockRecorder struct {
+	mock *MockDepthServiceAPI
+}
+
+// NewMockDepthServiceAPI creates a new mock instance.
+func NewMockDepthServiceAPI(ctrl *gomock.Controller) *MockDepthServiceAPI {
+	mock := &MockDepthServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockDepthServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDepthServiceAPI) EXPECT() *MockDepthServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockDepthServiceAPI) Do(ctx context.Context, opts ...options.RequestOption) (*options.DepthResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*options.DepthResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockDepthServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockDepthServiceAPI)(nil).Do), varargs...)
+}
+
+// Limit mocks base method.
+func (m *MockDepthServiceAPI) Limit(limit int) options.DepthServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Limit", limit)
+	ret0, _ := ret[0].(options.DepthServiceAPI)
+	return ret0
+}
+
+// Limit indicates an expected call of Limit.
+func (mr *MockDepthServiceAPIMockRecorder) Limit(limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Limit", reflect.TypeOf((*MockDepthServiceAPI)(nil).Limit), limit)
+}
+
+// Symbol mocks base method.
+func (m *MockDepthServiceAPI) Symbol(symbol string) options.DepthServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Symbol", symbol)
+	ret0, _ := ret[0].(options.DepthServiceAPI)
+	return ret0
+}
+
+// Symbol indicates an expected call of Symbol.
+func (mr *MockDepthServiceAPIMockRecorder) Symbol(symbol interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Symbol", reflect.TypeOf((*MockDepthServiceAPI)(nil).Symbol), symbol)
+}
+
+// MockTradesServiceAPI is a mock of TradesServiceAPI interface.
+type MockTradesServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockTradesServiceAPIMockRecorder
+}
+
+// MockTradesServiceAPIMockRecorder is the mock recorder for MockTradesServiceAPI.
+type MockTradesServiceAPIMockRecorder struct {
+	mock *MockTradesServiceAPI
+}
+
+// NewMockTradesServiceAPI creates a new mock instance.
+func NewMockTradesServiceAPI(ctrl *gomock.Controller) *MockTradesServiceAPI {
+	mock := &MockTradesServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockTradesServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTradesServiceAPI) EXPECT() *MockTradesServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockTradesServiceAPI) Do(ctx context.Context, opts ...options.RequestOption) ([]*options.Trade, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].([]*options.Trade)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockTradesServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockTradesServiceAPI)(nil).Do), varargs...)
+}
+
+// Limit mocks base method.
+func (m *MockTradesServiceAPI) Limit(limit int) options.TradesServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Limit", limit)
+	ret0, _ := ret[0].(options.TradesServiceAPI)
+	return ret0
+}
+
+// Limit indicates an expected call of Limit.
+func (mr *MockTradesServiceAPIMockRecorder) Limit(limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Limit", reflect.TypeOf((*MockTradesServiceAPI)(nil).Limit), limit)
+}
+
+// Symbol mocks base method.
+func (m *MockTradesServiceAPI) Symbol(symbol string) options.TradesServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Symbol", symbol)
+	ret0, _ := ret[0].(options.TradesServiceAPI)
+	return ret0
+}
+
+// Symbol indicates an expected call of Symbol.
+func (mr *MockTradesServiceAPIMockRecorder) Symbol(symbol interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Symbol", reflect.TypeOf((*MockTradesServiceAPI)(nil).Symbol), symbol)
+}
+
+// MockHistoricalTradesServiceAPI is a mock of HistoricalTradesServiceAPI interface.
+type MockHistoricalTradesServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockHistoricalTradesServiceAPIMockRecorder
+}
+
+// MockHistoricalTradesServiceAPIMockRecorder is the mock recorder for MockHistoricalTradesServiceAPI.
+type MockHistoricalTradesServiceAPIMockRecorder struct {
+	mock *MockHistoricalTradesServiceAPI
+}
+
+// NewMockHistoricalTradesServiceAPI creates a new mock instance.
+func NewMockHistoricalTradesServiceAPI(ctrl *gomock.Controller) *MockHistoricalTradesServiceAPI {
+	mock := &MockHistoricalTradesServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockHistoricalTradesServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockHistoricalTradesServiceAPI) EXPECT() *MockHistoricalTradesServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockHistoricalTradesServiceAPI) Do(ctx context.Context, opts ...options.RequestOption) ([]*options.HistoricalTrade, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].([]*options.HistoricalTrade)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockHistoricalTradesServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockHistoricalTradesServiceAPI)(nil).Do), varargs...)
+}
+
+// FromID mocks base method.
+func (m *MockHistoricalTradesServiceAPI) FromID(fromID int64) options.HistoricalTradesServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FromID", fromID)
+	ret0, _ := ret[0].(options.HistoricalTradesServiceAPI)
+	return ret0
+}
+
+// FromID indicates an expected call of FromID.
+func (mr *MockHistoricalTradesServiceAPIMockRecorder) FromID(fromID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FromID", reflect.TypeOf((*MockHistoricalTradesServiceAPI)(nil).FromID), fromID)
+}
+
+// Limit mocks base method.
+func (m *MockHistoricalTradesServiceAPI) Limit(limit int) options.HistoricalTradesServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Limit", limit)
+	ret0, _ := ret[0].(options.HistoricalTradesServiceAPI)
+	return ret0
+}
+
+// Limit indicates an expected call of Limit.
+func (mr *MockHistoricalTradesServiceAPIMockRecorder) Limit(limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Limit", reflect.TypeOf((*MockHistoricalTradesServiceAPI)(nil).Limit), limit)
+}
+
+// Symbol mocks base method.
+func (m *MockHistoricalTradesServiceAPI) Symbol(symbol string) options.HistoricalTradesServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Symbol", symbol)
+	ret0, _ := ret[0].(options.HistoricalTradesServiceAPI)
+	return ret0
+}
+
+// Symbol indicates an expected call of Symbol.
+func (mr *MockHistoricalTradesServiceAPIMockRecorder) Symbol(symbol interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Symbol", reflect.TypeOf((*MockHistoricalTradesServiceAPI)(nil).Symbol), symbol)
+}
+
+// MockKlinesServiceAPI is a mock of KlinesServiceAPI interface.
+type MockKlinesServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockKlinesServiceAPIMockRecorder
+}
+
+// MockKlinesServiceAPIMockRecorder is the mock recorder for MockKlinesServiceAPI.
+type MockKlinesServiceAPIMockRecorder struct {
+	mock *MockKlinesServiceAPI
+}
+
+// NewMockKlinesServiceAPI creates a new mock instance.
+func NewMockKlinesServiceAPI(ctrl *gomock.Controller) *MockKlinesServiceAPI {
+	mock := &MockKlinesServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockKlinesServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockKlinesServiceAPI) EXPECT() *MockKlinesServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockKlinesServiceAPI) Do(ctx context.Context, opts ...options.RequestOption) ([]*options.Kline, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].([]*options.Kline)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockKlinesServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockKlinesServiceAPI)(nil).Do), varargs...)
+}
+
+// EndTime mocks base method.
+func (m *MockKlinesServiceAPI) EndTime(endTime int64) options.KlinesServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EndTime", endTime)
+	ret0, _ := ret[0].(options.KlinesServiceAPI)
+	return ret0
+}
+
+// EndTime indicates an expected call of EndTime.
+func (mr *MockKlinesServiceAPIMockRecorder) EndTime(endTime interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EndTime", reflect.TypeOf((*MockKlinesServiceAPI)(nil).EndTime), endTime)
+}
+
+// Interval mocks base method.
+func (m *MockKlinesServiceAPI) Interval(interval string) options.KlinesServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Interval", interval)
+	ret0, _ := ret[0].(options.KlinesServiceAPI)
+	return ret0
+}
+
+// Interval indicates an expected call of Interval.
+func (mr *MockKlinesServiceAPIMockRecorder) Interval(interval interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Interval", reflect.TypeOf((*MockKlinesServiceAPI)(nil).Interval), interval)
+}
+
+// Limit mocks base method.
+func (m *MockKlinesServiceAPI) Limit(limit int) options.KlinesServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Limit", limit)
+	ret0, _ := ret[0].(options.KlinesServiceAPI)
+	return ret0
+}
+
+// Limit indicates an expected call of Limit.
+func (mr *MockKlinesServiceAPIMockRecorder) Limit(limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Limit", reflect.TypeOf((*MockKlinesServiceAPI)(nil).Limit), limit)
+}
+
+// StartTime mocks base method.
+func (m *MockKlinesServiceAPI) StartTime(startTime int64) options.KlinesServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartTime", startTime)
+	ret0, _ := ret[0].(options.KlinesServiceAPI)
+	return ret0
+}
+
+// StartTime indicates an expected call of StartTime.
+func (mr *MockKlinesServiceAPIMockRecorder) StartTime(startTime interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartTime", reflect.TypeOf((*MockKlinesServiceAPI)(nil).StartTime), startTime)
+}
+
+// Symbol mocks base method.
+func (m *MockKlinesServiceAPI) Symbol(symbol string) options.KlinesServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Symbol", symbol)
+	ret0, _ := ret[0].(options.KlinesServiceAPI)
+	return ret0
+}
+
+// Symbol indicates an expected call of Symbol.
+func (mr *MockKlinesServiceAPIMockRecorder) Symbol(symbol interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Symbol", reflect.TypeOf((*MockKlinesServiceAPI)(nil).Symbol), symbol)
+}
+
+// MockMarkServiceAPI is a mock of MarkServiceAPI interface.
+type MockMarkServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockMarkServiceAPIMockRecorder
+}
+
+// MockMarkServiceAPIMockRecorder is the mock recorder for MockMarkServiceAPI.
+type MockMarkServiceAPIMockRecorder struct {
+	mock *MockMarkServiceAPI
+}
+
+// NewMockMarkServiceAPI creates a new mock instance.
+func NewMockMarkServiceAPI(ctrl *gomock.Controller) *MockMarkServiceAPI {
+	mock := &MockMarkServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockMarkServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockMarkServiceAPI) EXPECT() *MockMarkServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockMarkServiceAPI) Do(ctx context.Context, opts ...options.RequestOption) ([]*options.Mark, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].([]*options.Mark)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockMarkServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockMarkServiceAPI)(nil).Do), varargs...)
+}
+
+// Symbol mocks base method.
+func (m *MockMarkServiceAPI) Symbol(symbol string) options.MarkServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Symbol", symbol)
+	ret0, _ := ret[0].(options.MarkServiceAPI)
+	return ret0
+}
+
+// Symbol indicates an expected call of Symbol.
+func (mr *MockMarkServiceAPIMockRecorder) Symbol(symbol interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Symbol", reflect.TypeOf((*MockMarkServiceAPI)(nil).Symbol), symbol)
+}
+
+// MockTickerServiceAPI is a mock of TickerServiceAPI interface.
+type MockTickerServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockTickerServiceAPIMockRecorder
+}
+
+// MockTickerServiceAPIMockRecorder is the mock recorder for MockTickerServiceAPI.
+type MockTickerServiceAPIMockRecorder struct {
+	mock *MockTickerServiceAPI
+}
+
+// NewMockTickerServiceAPI creates a new mock instance.
+func NewMockTickerServiceAPI(ctrl *gomock.Controller) *MockTickerServiceAPI {
+	mock := &MockTickerServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockTickerServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTickerServiceAPI) EXPECT() *MockTickerServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockTickerServiceAPI) Do(ctx context.Context, opts ...options.RequestOption) ([]*options.Ticker, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].([]*options.Ticker)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockTickerServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockTickerServiceAPI)(nil).Do), varargs...)
+}
+
+// Symbol mocks base method.
+func (m *MockTickerServiceAPI) Symbol(symbol string) options.TickerServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Symbol", symbol)
+	ret0, _ := ret[0].(options.TickerServiceAPI)
+	return ret0
+}
+
+// Symbol indicates an expected call of Symbol.
+func (mr *MockTickerServiceAPIMockRecorder) Symbol(symbol interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Symbol", reflect.TypeOf((*MockTickerServiceAPI)(nil).Symbol), symbol)
+}
+
+// MockIndexServiceAPI is a mock of IndexServiceAPI interface.
+type MockIndexServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockIndexServiceAPIMockRecorder
+}
+
+// MockIndexServiceAPIMockRecorder is the mock recorder for MockIndexServiceAPI.
+type MockIndexServiceAPIMockRecorder struct {
+	mock *MockIndexServiceAPI
+}
+
+// NewMockIndexServiceAPI creates a new mock instance.
+func NewMockIndexServiceAPI(ctrl *gomock.Controller) *MockIndexServiceAPI {
+	mock := &MockIndexServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockIndexServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIndexServiceAPI) EXPECT() *MockIndexServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockIndexServiceAPI) Do(ctx context.Context, opts ...options.RequestOption) (*options.Index, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*options.Index)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockIndexServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockIndexServiceAPI)(nil).Do), varargs...)
+}
+
+// Underlying mocks base method.
+func (m *MockIndexServiceAPI) Underlying(underlying string) options.IndexServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Underlying", underlying)
+	ret0, _ := ret[0].(options.IndexServiceAPI)
+	return ret0
+}
+
+// Underlying indicates an expected call of Underlying.
+func (mr *MockIndexServiceAPIMockRecorder) Underlying(underlying interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Underlying", reflect.TypeOf((*MockIndexServiceAPI)(nil).Underlying), underlying)
+}
+
+// MockExerciseHistoryServiceAPI is a mock of ExerciseHistoryServiceAPI interface.
+type MockExerciseHistoryServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockExerciseHistoryServiceAPIMockRecorder
+}
+
+// MockExerciseHistoryServiceAPIMockRecorder is the mock recorder for MockExerciseHistoryServiceAPI.
+type MockExerciseHistoryServiceAPIMockRecorder struct {
+	mock *MockExerciseHistoryServiceAPI
+}
+
+// NewMockExerciseHistoryServiceAPI creates a new mock instance.
+func NewMockExerciseHistoryServiceAPI(ctrl *gomock.Controller) *MockExerciseHistoryServiceAPI {
+	mock := &MockExerciseHistoryServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockExerciseHistoryServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockExerciseHistoryServiceAPI) EXPECT() *MockExerciseHistoryServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockExerciseHistoryServiceAPI) Do(ctx context.Context, opts ...options.RequestOption) ([]*options.ExerciseHistory, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].([]*options.ExerciseHistory)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockExerciseHistoryServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockExerciseHistoryServiceAPI)(nil).Do), varargs...)
+}
+
+// EndTime mocks base method.
+func (m *MockExerciseHistoryServiceAPI) EndTime(endTime uint64) options.ExerciseHistoryServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EndTime", endTime)
+	ret0, _ := ret[0].(options.ExerciseHistoryServiceAPI)
+	return ret0
+}
+
+// EndTime indicates an expected call of EndTime.
+func (mr *MockExerciseHistoryServiceAPIMockRecorder) EndTime(endTime interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EndTime", reflect.TypeOf((*MockExerciseHistoryServiceAPI)(nil).EndTime), endTime)
+}
+
+// Limit mocks base method.
+func (m *MockExerciseHistoryServiceAPI) Limit(limit uint64) options.ExerciseHistoryServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Limit", limit)
+	ret0, _ := ret[0].(options.ExerciseHistoryServiceAPI)
+	return ret0
+}
+
+// Limit indicates an expected call of Limit.
+func (mr *MockExerciseHistoryServiceAPIMockRecorder) Limit(limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Limit", reflect.TypeOf((*MockExerciseHistoryServiceAPI)(nil).Limit), limit)
+}
+
+// StartTime mocks base method.
+func (m *MockExerciseHistoryServiceAPI) StartTime(startTime uint64) options.ExerciseHistoryServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartTime", startTime)
+	ret0, _ := ret[0].(options.ExerciseHistoryServiceAPI)
+	return ret0
+}
+
+// StartTime indicates an expected call of StartTime.
+func (mr *MockExerciseHistoryServiceAPIMockRecorder) StartTime(startTime interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartTime", reflect.TypeOf((*MockExerciseHistoryServiceAPI)(nil).StartTime), startTime)
+}
+
+// Underlying mocks base method.
+func (m *MockExerciseHistoryServiceAPI) Underlying(underlying string) options.ExerciseHistoryServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Underlying", underlying)
+	ret0, _ := ret[0].(options.ExerciseHistoryServiceAPI)
+	return ret0
+}
+
+// Underlying indicates an expected call of Underlying.
+func (mr *MockExerciseHistoryServiceAPIMockRecorder) Underlying(underlying interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Underlying", reflect.TypeOf((*MockExerciseHistoryServiceAPI)(nil).Underlying), underlying)
+}
+
+// MockOpenInterestServiceAPI is a mock of OpenInterestServiceAPI interface.
+type MockOpenInterestServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockOpenInterestServiceAPIMockRecorder
+}
+
+// MockOpenInterestServiceAPIMockRecorder is the mock recorder for MockOpenInterestServiceAPI.
+type MockOpenInterestServiceAPIMockRecorder struct {
+	mock *MockOpenInterestServiceAPI
+}
+
+// NewMockOpenInterestServiceAPI creates a new mock instance.
+func NewMockOpenInterestServiceAPI(ctrl *gomock.Controller) *MockOpenInterestServiceAPI {
+	mock := &MockOpenInterestServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockOpenInterestServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockOpenInterestServiceAPI) EXPECT() *MockOpenInterestServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockOpenInterestServiceAPI) Do(ctx context.Context, opts ...options.RequestOption) ([]*options.OpenInterest, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].([]*options.OpenInterest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockOpenInterestServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockOpenInterestServiceAPI)(nil).Do), varargs...)
+}
+
+// Expiration mocks base method.
+func (m *MockOpenInterestServiceAPI) Expiration(expiration string) options.OpenInterestServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Expiration", expiration)
+	ret0, _ := ret[0].(options.OpenInterestServiceAPI)
+	return ret0
+}
+
+// Expiration indicates an expected call of Expiration.
+func (mr *MockOpenInterestServiceAPIMockRecorder) Expiration(expiration interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Expiration", reflect.TypeOf((*MockOpenInterestServiceAPI)(nil).Expiration), expiration)
+}
+
+// UnderlyingAsset mocks base method.
+func (m *MockOpenInterestServiceAPI) UnderlyingAsset(underlyingAsset string) options.OpenInterestServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnderlyingAsset", underlyingAsset)
+	ret0, _ := ret[0].(options.OpenInterestServiceAPI)
+	return ret0
+}
+
+// UnderlyingAsset indicates an expected call of UnderlyingAsset.
+func (mr *MockOpenInterestServiceAPIMockRecorder) UnderlyingAsset(underlyingAsset interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnderlyingAsset", reflect.TypeOf((*MockOpenInterestServiceAPI)(nil).UnderlyingAsset), underlyingAsset)
+}
+
+// MockAccountServiceAPI is a mock of AccountServiceAPI interface.
+type MockAccountServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockAccountServiceAPIMockRecorder
+}
+
+// MockAccountServiceAPIMockRecorder is the mock recorder for MockAccountServiceAPI.
+type MockAccountServiceAPIMockRecorder struct {
+	mock *MockAccountServiceAPI
+}
+
+// NewMockAccountServiceAPI creates a new mock instance.
+func NewMockAccountServiceAPI(ctrl *gomock.Controller) *MockAccountServiceAPI {
+	mock := &MockAccountServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockAccountServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAccountServiceAPI) EXPECT() *MockAccountServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockAccountServiceAPI) Do(ctx context.Context, opts ...options.RequestOption) (*options.Account, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*options.Account)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockAccountServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockAccountServiceAPI)(nil).Do), varargs...)
+}
+
+// MockCreateOrderServiceAPI is a mock of CreateOrderServiceAPI interface.
+type MockCreateOrderServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockCreateOrderServiceAPIMockRecorder
+}
+
+// MockCreateOrderServiceAPIMockRecorder is the mock recorder for MockCreateOrderServiceAPI.
+type MockCreateOrderServiceAPIMockRecorder struct {
+	mock *MockCreateOrderServiceAPI
+}
+
+// NewMockCreateOrderServiceAPI creates a new mock instance.
+func NewMockCreateOrderServiceAPI(ctrl *gomock.Controller) *MockCreateOrderServiceAPI {
+	mock := &MockCreateOrderServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockCreateOrderServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCreateOrderServiceAPI) EXPECT() *MockCreateOrderServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// ClientOrderId mocks base method.
+func (m *MockCreateOrderServiceAPI) ClientOrderId(ClientOrderId string) options.CreateOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClientOrderId", ClientOrderId)
+	ret0, _ := ret[0].(options.CreateOrderServiceAPI)
+	return ret0
+}
+
+// ClientOrderId indicates an expected call of ClientOrderId.
+func (mr *MockCreateOrderServiceAPIMockRecorder) ClientOrderId(ClientOrderId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClientOrderId", reflect.TypeOf((*MockCreateOrderServiceAPI)(nil).ClientOrderId), ClientOrderId)
+}
+
+// Do mocks base method.
+func (m *MockCreateOrderServiceAPI) Do(ctx context.Context, opts ...options.RequestOption) (*options.Order, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*options.Order)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockCreateOrderServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockCreateOrderServiceAPI)(nil).Do), varargs...)
+}
+
+// IsMmp mocks base method.
+func (m *MockCreateOrderServiceAPI) IsMmp(isMmp bool) options.CreateOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsMmp", isMmp)
+	ret0, _ := ret[0].(options.CreateOrderServiceAPI)
+	return ret0
+}
+
+// IsMmp indicates an expected call of IsMmp.
+func (mr *MockCreateOrderServiceAPIMockRecorder) IsMmp(isMmp interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsMmp", reflect.TypeOf((*MockCreateOrderServiceAPI)(nil).IsMmp), isMmp)
+}
+
+// NewOrderResponseType mocks base method.
+func (m *MockCreateOrderServiceAPI) NewOrderResponseType(newOrderResponseType options.NewOrderRespType) options.CreateOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewOrderResponseType", newOrderResponseType)
+	ret0, _ := ret[0].(options.CreateOrderServiceAPI)
+	return ret0
+}
+
+// NewOrderResponseType indicates an expected call of NewOrderResponseType.
+func (mr *MockCreateOrderServiceAPIMockRecorder) NewOrderResponseType(newOrderResponseType interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewOrderResponseType", reflect.TypeOf((*MockCreateOrderServiceAPI)(nil).NewOrderResponseType), newOrderResponseType)
+}
+
+// PostOnly mocks base method.
+func (m *MockCreateOrderServiceAPI) PostOnly(postOnly bool) options.CreateOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PostOnly", postOnly)
+	ret0, _ := ret[0].(options.CreateOrderServiceAPI)
+	return ret0
+}
+
+// PostOnly indicates an expected call of PostOnly.
+func (mr *MockCreateOrderServiceAPIMockRecorder) PostOnly(postOnly interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PostOnly", reflect.TypeOf((*MockCreateOrderServiceAPI)(nil).PostOnly), postOnly)
+}
+
+// Price mocks base method.
+func (m *MockCreateOrderServiceAPI) Price(price string) options.CreateOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Price", price)
+	ret0, _ := ret[0].(options.CreateOrderServiceAPI)
+	return ret0
+}
+
+// Price indicates an expected call of Price.
+func (mr *MockCreateOrderServiceAPIMockRecorder) Price(price interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Price", reflect.TypeOf((*MockCreateOrderServiceAPI)(nil).Price), price)
+}
+
+// Quantity mocks base method.
+func (m *MockCreateOrderServiceAPI) Quantity(quantity string) options.CreateOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Quantity", quantity)
+	ret0, _ := ret[0].(options.CreateOrderServiceAPI)
+	return ret0
+}
+
+// Quantity indicates an expected call of Quantity.
+func (mr *MockCreateOrderServiceAPIMockRecorder) Quantity(quantity interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Quantity", reflect.TypeOf((*MockCreateOrderServiceAPI)(nil).Quantity), quantity)
+}
+
+// ReduceOnly mocks base method.
+func (m *MockCreateOrderServiceAPI) ReduceOnly(reduceOnly bool) options.CreateOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReduceOnly", reduceOnly)
+	ret0, _ := ret[0].(options.CreateOrderServiceAPI)
+	return ret0
+}
+
+// ReduceOnly indicates an expected call of ReduceOnly.
+func (mr *MockCreateOrderServiceAPIMockRecorder) ReduceOnly(reduceOnly interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReduceOnly", reflect.TypeOf((*MockCreateOrderServiceAPI)(nil).ReduceOnly), reduceOnly)
+}
+
+// Side mocks base method.
+func (m *MockCreateOrderServiceAPI) Side(side options.SideType) options.CreateOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Side", side)
+	ret0, _ := ret[0].(options.CreateOrderServiceAPI)
+	return ret0
+}
+
+// Side indicates an expected call of Side.
+func (mr *MockCreateOrderServiceAPIMockRecorder) Side(side interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Side", reflect.TypeOf((*MockCreateOrderServiceAPI)(nil).Side), side)
+}
+
+// Symbol mocks base method.
+func (m *MockCreateOrderServiceAPI) Symbol(symbol string) options.CreateOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Symbol", symbol)
+	ret0, _ := ret[0].(options.CreateOrderServiceAPI)
+	return ret0
+}
+
+// Symbol indicates an expected call of Symbol.
+func (mr *MockCreateOrderServiceAPIMockRecorder) Symbol(symbol interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Symbol", reflect.TypeOf((*MockCreateOrderServiceAPI)(nil).Symbol), symbol)
+}
+
+// TimeInForce mocks base method.
+func (m *MockCreateOrderServiceAPI) TimeInForce(timeInForce options.TimeInForceType) options.CreateOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TimeInForce", timeInForce)
+	ret0, _ := ret[0].(options.CreateOrderServiceAPI)
+	return ret0
+}
+
+// TimeInForce indicates an expected call of TimeInForce.
+func (mr *MockCreateOrderServiceAPIMockRecorder) TimeInForce(timeInForce interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TimeInForce", reflect.TypeOf((*MockCreateOrderServiceAPI)(nil).TimeInForce), timeInForce)
+}
+
+// Type mocks base method.
+func (m *MockCreateOrderServiceAPI) Type(orderType options.OrderType) options.CreateOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Type", orderType)
+	ret0, _ := ret[0].(options.CreateOrderServiceAPI)
+	return ret0
+}
+
+// Type indicates an expected call of Type.
+func (mr *MockCreateOrderServiceAPIMockRecorder) Type(orderType interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Type", reflect.TypeOf((*MockCreateOrderServiceAPI)(nil).Type), orderType)
+}
+
+// MockCreateBatchOrdersServiceAPI is a mock of CreateBatchOrdersServiceAPI interface.
+type MockCreateBatchOrdersServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockCreateBatchOrdersServiceAPIMockRecorder
+}
+
+// MockCreateBatchOrdersServiceAPIMockRecorder is the mock recorder for MockCreateBatchOrdersServiceAPI.
+type MockCreateBatchOrdersServiceAPIMockRecorder struct {
+	mock *MockCreateBatchOrdersServiceAPI
+}
+
+// NewMockCreateBatchOrdersServiceAPI creates a new mock instance.
+func NewMockCreateBatchOrdersServiceAPI(ctrl *gomock.Controller) *MockCreateBatchOrdersServiceAPI {
+	mock := &MockCreateBatchOrdersServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockCreateBatchOrdersServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCreateBatchOrdersServiceAPI) EXPECT() *MockCreateBatchOrdersServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockCreateBatchOrdersServiceAPI) Do(ctx context.Context, opts ...options.RequestOption) ([]interface{}, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].([]interface{})
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockCreateBatchOrdersServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockCreateBatchOrdersServiceAPI)(nil).Do), varargs...)
+}
+
+// OrderList mocks base method.
+func (m *MockCreateBatchOrdersServiceAPI) OrderList(orders []*options.CreateOrderService) options.CreateBatchOrdersServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OrderList", orders)
+	ret0, _ := ret[0].(options.CreateBatchOrdersServiceAPI)
+	return ret0
+}
+
+// OrderList indicates an expected call of OrderList.
+func (mr *MockCreateBatchOrdersServiceAPIMockRecorder) OrderList(orders interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OrderList", reflect.TypeOf((*MockCreateBatchOrdersServiceAPI)(nil).OrderList), orders)
+}
+
+// MockGetOrderServiceAPI is a mock of GetOrderServiceAPI interface.
+type MockGetOrderServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockGetOrderServiceAPIMockRecorder
+}
+
+// MockGetOrderServiceAPIMockRecorder is the mock recorder for MockGetOrderServiceAPI.
+type MockGetOrderServiceAPIMockRecorder struct {
+	mock *MockGetOrderServiceAPI
+}
+
+// NewMockGetOrderServiceAPI creates a new mock instance.
+func NewMockGetOrderServiceAPI(ctrl *gomock.Controller) *MockGetOrderServiceAPI {
+	mock := &MockGetOrderServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockGetOrderServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGetOrderServiceAPI) EXPECT() *MockGetOrderServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// ClientOrderId mocks base method.
+func (m *MockGetOrderServiceAPI) ClientOrderId(clientOrderId string) options.GetOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClientOrderId", clientOrderId)
+	ret0, _ := ret[0].(options.GetOrderServiceAPI)
+	return ret0
+}
+
+// ClientOrderId indicates an expected call of ClientOrderId.
+func (mr *MockGetOrderServiceAPIMockRecorder) ClientOrderId(clientOrderId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClientOrderId", reflect.TypeOf((*MockGetOrderServiceAPI)(nil).ClientOrderId), clientOrderId)
+}
+
+// Do mocks base method.
+func (m *MockGetOrderServiceAPI) Do(ctx context.Context, opts ...options.RequestOption) (*options.Order, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*options.Order)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockGetOrderServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockGetOrderServiceAPI)(nil).Do), varargs...)
+}
+
+// OrderId mocks base method.
+func (m *MockGetOrderServiceAPI) OrderId(orderId int64) options.GetOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OrderId", orderId)
+	ret0, _ := ret[0].(options.GetOrderServiceAPI)
+	return ret0
+}
+
+// OrderId indicates an expected call of OrderId.
+func (mr *MockGetOrderServiceAPIMockRecorder) OrderId(orderId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OrderId", reflect.TypeOf((*MockGetOrderServiceAPI)(nil).OrderId), orderId)
+}
+
+// Symbol mocks base method.
+func (m *MockGetOrderServiceAPI) Symbol(symbol string) options.GetOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Symbol", symbol)
+	ret0, _ := ret[0].(options.GetOrderServiceAPI)
+	return ret0
+}
+
+// Symbol indicates an expected call of Symbol.
+func (mr *MockGetOrderServiceAPIMockRecorder) Symbol(symbol interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Symbol", reflect.TypeOf((*MockGetOrderServiceAPI)(nil).Symbol), symbol)
+}
+
+// MockCancelOrderServiceAPI is a mock of CancelOrderServiceAPI interface.
+type MockCancelOrderServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockCancelOrderServiceAPIMockRecorder
+}
+
+// MockCancelOrderServiceAPIMockRecorder is the mock recorder for MockCancelOrderServiceAPI.
+type MockCancelOrderServiceAPIMockRecorder struct {
+	mock *MockCancelOrderServiceAPI
+}
+
+// NewMockCancelOrderServiceAPI creates a new mock instance.
+func NewMockCancelOrderServiceAPI(ctrl *gomock.Controller) *MockCancelOrderServiceAPI {
+	mock := &MockCancelOrderServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockCancelOrderServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCancelOrderServiceAPI) EXPECT() *MockCancelOrderServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// ClientOrderId mocks base method.
+func (m *MockCancelOrderServiceAPI) ClientOrderId(clientOrderId string) options.CancelOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClientOrderId", clientOrderId)
+	ret0, _ := ret[0].(options.CancelOrderServiceAPI)
+	return ret0
+}
+
+// ClientOrderId indicates an expected call of ClientOrderId.
+func (mr *MockCancelOrderServiceAPIMockRecorder) ClientOrderId(clientOrderId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClientOrderId", reflect.TypeOf((*MockCancelOrderServiceAPI)(nil).ClientOrderId), clientOrderId)
+}
+
+// Do mocks base method.
+func (m *MockCancelOrderServiceAPI) Do(ctx context.Context, opts ...options.RequestOption) (*options.Order, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*options.Order)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockCancelOrderServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockCancelOrderServiceAPI)(nil).Do), varargs...)
+}
+
+// OrderId mocks base method.
+func (m *MockCancelOrderServiceAPI) OrderId(orderId int64) options.CancelOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OrderId", orderId)
+	ret0, _ := ret[0].(options.CancelOrderServiceAPI)
+	return ret0
+}
+
+// OrderId indicates an expected call of OrderId.
+func (mr *MockCancelOrderServiceAPIMockRecorder) OrderId(orderId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OrderId", reflect.TypeOf((*MockCancelOrderServiceAPI)(nil).OrderId), orderId)
+}
+
+// Symbol mocks base method.
+func (m *MockCancelOrderServiceAPI) Symbol(symbol string) options.CancelOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Symbol", symbol)
+	ret0, _ := ret[0].(options.CancelOrderServiceAPI)
+	return ret0
+}
+
+// Symbol indicates an expected call of Symbol.
+func (mr *MockCancelOrderServiceAPIMockRecorder) Symbol(symbol interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Symbol", reflect.TypeOf((*MockCancelOrderServiceAPI)(nil).Symbol), symbol)
+}
+
+// MockCancelBatchOrdersServiceAPI is a mock of CancelBatchOrdersServiceAPI interface.
+type MockCancelBatchOrdersServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockCancelBatchOrdersServiceAPIMockRecorder
+}
+
+// MockCancelBatchOrdersServiceAPIMockRecorder is the mock recorder for MockCancelBatchOrdersServiceAPI.
+type MockCancelBatchOrdersServiceAPIMockRecorder struct {
+	mock *MockCancelBatchOrdersServiceAPI
+}
+
+// NewMockCancelBatchOrdersServiceAPI creates a new mock instance.
+func NewMockCancelBatchOrdersServiceAPI(ctrl *gomock.Controller) *MockCancelBatchOrdersServiceAPI {
+	mock := &MockCancelBatchOrdersServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockCancelBatchOrdersServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCancelBatchOrdersServiceAPI) EXPECT() *MockCancelBatchOrdersServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// ClientOrderIds mocks base method.
+func (m *MockCancelBatchOrdersServiceAPI) ClientOrderIds(clientOrderIds []string) options.CancelBatchOrdersServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClientOrderIds", clientOrderIds)
+	ret0, _ := ret[0].(options.CancelBatchOrdersServiceAPI)
+	return ret0
+}
+
+// ClientOrderIds indicates an expected call of ClientOrderIds.
+func (mr *MockCancelBatchOrdersServiceAPIMockRecorder) ClientOrderIds(clientOrderIds interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClientOrderIds", reflect.TypeOf((*MockCancelBatchOrdersServiceAPI)(nil).ClientOrderIds), clientOrderIds)
+}
+
+// Do mocks base method.
+func (m *MockCancelBatchOrdersServiceAPI) Do(ctx context.Context, opts ...options.RequestOption) ([]interface{}, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].([]interface{})
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockCancelBatchOrdersServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockCancelBatchOrdersServiceAPI)(nil).Do), varargs...)
+}
+
+// OrderIds mocks base method.
+func (m *MockCancelBatchOrdersServiceAPI) OrderIds(orderIds []int64) options.CancelBatchOrdersServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OrderIds", orderIds)
+	ret0, _ := ret[0].(options.CancelBatchOrdersServiceAPI)
+	return ret0
+}
+
+// OrderIds indicates an expected call of OrderIds.
+func (mr *MockCancelBatchOrdersServiceAPIMockRecorder) OrderIds(orderIds interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OrderIds", reflect.TypeOf((*MockCancelBatchOrdersServiceAPI)(nil).OrderIds), orderIds)
+}
+
+// Symbol mocks base method.
+func (m *MockCancelBatchOrdersServiceAPI) Symbol(symbol string) options.CancelBatchOrdersServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Symbol", symbol)
+	ret0, _ := ret[0].(options.CancelBatchOrdersServiceAPI)
+	return ret0
+}
+
+// Symbol indicates an expected call of Symbol.
+func (mr *MockCancelBatchOrdersServiceAPIMockRecorder) Symbol(symbol interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Symbol", reflect.TypeOf((*MockCancelBatchOrdersServiceAPI)(nil).Symbol), symbol)
+}
+
+// MockCancelAllOpenOrdersServiceAPI is a mock of CancelAllOpenOrdersServiceAPI interface.
+type MockCancelAllOpenOrdersServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockCancelAllOpenOrdersServiceAPIMockRecorder
+}
+
+// MockCancelAllOpenOrdersServiceAPIMockRecorder is the mock recorder for MockCancelAllOpenOrdersServiceAPI.
+type MockCancelAllOpenOrdersServiceAPIMockRecorder struct {
+	mock *MockCancelAllOpenOrdersServiceAPI
+}
+
+// NewMockCancelAllOpenOrdersServiceAPI creates a new mock instance.
+func NewMockCancelAllOpenOrdersServiceAPI(ctrl *gomock.Controller) *MockCancelAllOpenOrdersServiceAPI {
+	mock := &MockCancelAllOpenOrdersServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockCancelAllOpenOrdersServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCancelAllOpenOrdersServiceAPI) EXPECT() *MockCancelAllOpenOrdersServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockCancelAllOpenOrdersServiceAPI) Do(ctx context.Context, opts ...options.RequestOption) (*options.CancelAllOpenOrdersRsp, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*options.CancelAllOpenOrdersRsp)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockCancelAllOpenOrdersServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockCancelAllOpenOrdersServiceAPI)(nil).Do), varargs...)
+}
+
+// Symbol mocks base method.
+func (m *MockCancelAllOpenOrdersServiceAPI) Symbol(symbol string) options.CancelAllOpenOrdersServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Symbol", symbol)
+	ret0, _ := ret[0].(options.CancelAllOpenOrdersServiceAPI)
+	return ret0
+}
+
+// Symbol indicates an expected call of Symbol.
+func (mr *MockCancelAllOpenOrdersServiceAPIMockRecorder) Symbol(symbol interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Symbol", reflect.TypeOf((*MockCancelAllOpenOrdersServiceAPI)(nil).Symbol), symbol)
+}
+
+// MockCancelAllOpenOrdersByUnderlyingServiceAPI is a mock of CancelAllOpenOrdersByUnderlyingServiceAPI interface.
+type MockCancelAllOpenOrdersByUnderlyingServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockCancelAllOpenOrdersByUnderlyingServiceAPIMockRecorder
+}
+
+// MockCancelAllOpenOrdersByUnderlyingServiceAPIMockRecorder is the mock recorder for MockCancelAllOpenOrdersByUnderlyingServiceAPI.
+type MockCancelAllOpenOrdersByUnderlyingServiceAPIMockRecorder struct {
+	mock *MockCancelAllOpenOrdersByUnderlyingServiceAPI
+}
+
+// NewMockCancelAllOpenOrdersByUnderlyingServiceAPI creates a new mock instance.
+func NewMockCancelAllOpenOrdersByUnderlyingServiceAPI(ctrl *gomock.Controller) *MockCancelAllOpenOrdersByUnderlyingServiceAPI {
+	mock := &MockCancelAllOpenOrdersByUnderlyingServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockCancelAllOpenOrdersByUnderlyingServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCancelAllOpenOrdersByUnderlyingServiceAPI) EXPECT() *MockCancelAllOpenOrdersByUnderlyingServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockCancelAllOpenOrdersByUnderlyingServiceAPI) Do(ctx context.Context, opts ...options.RequestOption) (*options.CancelAllOpenOrdersByUnderlyingRsp, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*options.CancelAllOpenOrdersByUnderlyingRsp)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockCancelAllOpenOrdersByUnderlyingServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockCancelAllOpenOrdersByUnderlyingServiceAPI)(nil).Do), varargs...)
+}
+
+// Underlying mocks base method.
+func (m *MockCancelAllOpenOrdersByUnderlyingServiceAPI) Underlying(underlying string) options.CancelAllOpenOrdersByUnderlyingServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Underlying", underlying)
+	ret0, _ := ret[0].(options.CancelAllOpenOrdersByUnderlyingServiceAPI)
+	return ret0
+}
+
+// Underlying indicates an expected call of Underlying.
+func (mr *MockCancelAllOpenOrdersByUnderlyingServiceAPIMockRecorder) Underlying(underlying interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Underlying", reflect.TypeOf((*MockCancelAllOpenOrdersByUnderlyingServiceAPI)(nil).Underlying), underlying)
+}
+
+// MockListOpenOrdersServiceAPI is a mock of ListOpenOrdersServiceAPI interface.
+type MockListOpenOrdersServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockListOpenOrdersServiceAPIMockRecorder
+}
+
+// MockListOpenOrdersServiceAPIMockRecorder is the mock recorder for MockListOpenOrdersServiceAPI.
+type MockListOpenOrdersServiceAPIMockRecorder struct {
+	mock *MockListOpenOrdersServiceAPI
+}
+
+// NewMockListOpenOrdersServiceAPI creates a new mock instance.
+func NewMockListOpenOrdersServiceAPI(ctrl *gomock.Controller) *MockListOpenOrdersServiceAPI {
+	mock := &MockListOpenOrdersServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockListOpenOrdersServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockListOpenOrdersServiceAPI) EXPECT() *MockListOpenOrdersServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockListOpenOrdersServiceAPI) Do(ctx context.Context, opts ...options.RequestOption) ([]*options.Order, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].([]*options.Order)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockListOpenOrdersServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockListOpenOrdersServiceAPI)(nil).Do), varargs...)
+}
+
+// EndTime mocks base method.
+func (m *MockListOpenOrdersServiceAPI) EndTime(endTime int64) options.ListOpenOrdersServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EndTime", endTime)
+	ret0, _ := ret[0].(options.ListOpenOrdersServiceAPI)
+	return ret0
+}
+
+// EndTime indicates an expected call of EndTime.
+func (mr *MockListOpenOrdersServiceAPIMockRecorder) EndTime(endTime interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EndTime", reflect.TypeOf((*MockListOpenOrdersServiceAPI)(nil).EndTime), endTime)
+}
+
+// Limit mocks base method.
+func (m *MockListOpenOrdersServiceAPI) Limit(limit int) options.ListOpenOrdersServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Limit", limit)
+	ret0, _ := ret[0].(options.ListOpenOrdersServiceAPI)
+	return ret0
+}
+
+// Limit indicates an expected call of Limit.
+func (mr *MockListOpenOrdersServiceAPIMockRecorder) Limit(limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Limit", reflect.TypeOf((*MockListOpenOrdersServiceAPI)(nil).Limit), limit)
+}
+
+// OrderId mocks base method.
+func (m *MockListOpenOrdersServiceAPI) OrderId(orderId int64) options.ListOpenOrdersServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OrderId", orderId)
+	ret0, _ := ret[0].(options.ListOpenOrdersServiceAPI)
+	return ret0
+}
+
+// OrderId indicates an expected call of OrderId.
+func (mr *MockListOpenOrdersServiceAPIMockRecorder) OrderId(orderId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OrderId", reflect.TypeOf((*MockListOpenOrdersServiceAPI)(nil).OrderId), orderId)
+}
+
+// StartTime mocks base method.
+func (m *MockListOpenOrdersServiceAPI) StartTime(startTime int64) options.ListOpenOrdersServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartTime", startTime)
+	ret0, _ := ret[0].(options.ListOpenOrdersServiceAPI)
+	return ret0
+}
+
+// StartTime indicates an expected call of StartTime.
+func (mr *MockListOpenOrdersServiceAPIMockRecorder) StartTime(startTime interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartTime", reflect.TypeOf((*MockListOpenOrdersServiceAPI)(nil).StartTime), startTime)
+}
+
+// Symbol mocks base method.
+func (m *MockListOpenOrdersServiceAPI) Symbol(symbol string) options.ListOpenOrdersServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Symbol", symbol)
+	ret0, _ := ret[0].(options.ListOpenOrdersServiceAPI)
+	return ret0
+}
+
+// Symbol indicates an expected call of Symbol.
+func (mr *MockListOpenOrdersServiceAPIMockRecorder) Symbol(symbol interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Symbol", reflect.TypeOf((*MockListOpenOrdersServiceAPI)(nil).Symbol), symbol)
+}
+
+// MockHistoryOrdersServiceAPI is a mock of HistoryOrdersServiceAPI interface.
+type MockHistoryOrdersServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockHistoryOrdersServiceAPIMockRecorder
+}
+
+// MockHistoryOrdersServiceAPIMockRecorder is the mock recorder for MockHistoryOrdersServiceAPI.
+type MockHistoryOrdersServiceAPIMockRecorder struct {
+	mock *MockHistoryOrdersServiceAPI
+}
+
+// NewMockHistoryOrdersServiceAPI creates a new mock instance.
+func NewMockHistoryOrdersServiceAPI(ctrl *gomock.Controller) *MockHistoryOrdersServiceAPI {
+	mock := &MockHistoryOrdersServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockHistoryOrdersServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockHistoryOrdersServiceAPI) EXPECT() *MockHistoryOrdersServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockHistoryOrdersServiceAPI) Do(ctx context.Context, opts ...options.RequestOption) ([]*options.Order, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].([]*options.Order)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockHistoryOrdersServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockHistoryOrdersServiceAPI)(nil).Do), varargs...)
+}
+
+// EndTime mocks base method.
+func (m *MockHistoryOrdersServiceAPI) EndTime(et uint64) options.HistoryOrdersServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EndTime", et)
+	ret0, _ := ret[0].(options.HistoryOrdersServiceAPI)
+	return ret0
+}
+
+// EndTime indicates an expected call of EndTime.
+func (mr *MockHistoryOrdersServiceAPIMockRecorder) EndTime(et interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EndTime", reflect.TypeOf((*MockHistoryOrdersServiceAPI)(nil).EndTime), et)
+}
+
+// Limit mocks base method.
+func (m *MockHistoryOrdersServiceAPI) Limit(limit int) options.HistoryOrdersServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Limit", limit)
+	ret0, _ := ret[0].(options.HistoryOrdersServiceAPI)
+	return ret0
+}
+
+// Limit indicates an expected call of Limit.
+func (mr *MockHistoryOrdersServiceAPIMockRecorder) Limit(limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Limit", reflect.TypeOf((*MockHistoryOrdersServiceAPI)(nil).Limit), limit)
+}
+
+// OrderId mocks base method.
+func (m *MockHistoryOrdersServiceAPI) OrderId(orderId uint64) options.HistoryOrdersServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OrderId", orderId)
+	ret0, _ := ret[0].(options.HistoryOrdersServiceAPI)
+	return ret0
+}
+
+// OrderId indicates an expected call of OrderId.
+func (mr *MockHistoryOrdersServiceAPIMockRecorder) OrderId(orderId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OrderId", reflect.TypeOf((*MockHistoryOrdersServiceAPI)(nil).OrderId), orderId)
+}
+
+// StartTime mocks base method.
+func (m *MockHistoryOrdersServiceAPI) StartTime(st uint64) options.HistoryOrdersServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartTime", st)
+	ret0, _ := ret[0].(options.HistoryOrdersServiceAPI)
+	return ret0
+}
+
+// StartTime indicates an expected call of StartTime.
+func (mr *MockHistoryOrdersServiceAPIMockRecorder) StartTime(st interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartTime", reflect.TypeOf((*MockHistoryOrdersServiceAPI)(nil).StartTime), st)
+}
+
+// Symbol mocks base method.
+func (m *MockHistoryOrdersServiceAPI) Symbol(symbol string) options.HistoryOrdersServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Symbol", symbol)
+	ret0, _ := ret[0].(options.HistoryOrdersServiceAPI)
+	return ret0
+}
+
+// Symbol indicates an expected call of Symbol.
+func (mr *MockHistoryOrdersServiceAPIMockRecorder) Symbol(symbol interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Symbol", reflect.TypeOf((*MockHistoryOrdersServiceAPI)(nil).Symbol), symbol)
+}
+
+// MockPositionServiceAPI is a mock of PositionServiceAPI interface.
+type MockPositionServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockPositionServiceAPIMockRecorder
+}
+
+// MockPositionServiceAPIMockRecorder is the mock recorder for MockPositionServiceAPI.
+type MockPositionServiceAPIMockRecorder struct {
+	mock *MockPositionServiceAPI
+}
+
+// NewMockPositionServiceAPI creates a new mock instance.
+func NewMockPositionServiceAPI(ctrl *gomock.Controller) *MockPositionServiceAPI {
+	mock := &MockPositionServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockPositionServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPositionServiceAPI) EXPECT() *MockPositionServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockPositionServiceAPI) Do(ctx context.Context, opts ...options.RequestOption) ([]*options.Position, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].([]*options.Position)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockPositionServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockPositionServiceAPI)(nil).Do), varargs...)
+}
+
+// Symbol mocks base method.
+func (m *MockPositionServiceAPI) Symbol(symbol string) options.PositionServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Symbol", symbol)
+	ret0, _ := ret[0].(options.PositionServiceAPI)
+	return ret0
+}
+
+// Symbol indicates an expected call of Symbol.
+func (mr *MockPositionServiceAPIMockRecorder) Symbol(symbol interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Symbol", reflect.TypeOf((*MockPositionServiceAPI)(nil).Symbol), symbol)
+}
+
+// MockUserTradesServiceAPI is a mock of UserTradesServiceAPI interface.
+type MockUserTradesServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockUserTradesServiceAPIMockRecorder
+}
+
+// MockUserTradesServiceAPIMockRecorder is the mock recorder for MockUserTradesServiceAPI.
+type MockUserTradesServiceAPIMockRecorder struct {
+	mock *MockUserTradesServiceAPI
+}
+
+// NewMockUserTradesServiceAPI creates a new mock instance.
+func NewMockUserTradesServiceAPI(ctrl *gomock.Controller) *MockUserTradesServiceAPI {
+	mock := &MockUserTradesServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockUserTradesServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUserTradesServiceAPI) EXPECT() *MockUserTradesServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockUserTradesServiceAPI) Do(ctx context.Context, opts ...options.RequestOption) ([]*options.UserTrade, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].([]*options.UserTrade)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockUserTradesServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockUserTradesServiceAPI)(nil).Do), varargs...)
+}
+
+// EndTime mocks base method.
+func (m *MockUserTradesServiceAPI) EndTime(et uint64) options.UserTradesServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EndTime", et)
+	ret0, _ := ret[0].(options.UserTradesServiceAPI)
+	return ret0
+}
+
+// EndTime indicates an expected call of EndTime.
+func (mr *MockUserTradesServiceAPIMockRecorder) EndTime(et interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EndTime", reflect.TypeOf((*MockUserTradesServiceAPI)(nil).EndTime), et)
+}
+
+// FromId mocks base method.
+func (m *MockUserTradesServiceAPI) FromId(fromId uint64) options.UserTradesServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FromId", fromId)
+	ret0, _ := ret[0].(options.UserTradesServiceAPI)
+	return ret0
+}
+
+// FromId indicates an expected call of FromId.
+func (mr *MockUserTradesServiceAPIMockRecorder) FromId(fromId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FromId", reflect.TypeOf((*MockUserTradesServiceAPI)(nil).FromId), fromId)
+}
+
+// Limit mocks base method.
+func (m *MockUserTradesServiceAPI) Limit(limit int) options.UserTradesServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Limit", limit)
+	ret0, _ := ret[0].(options.UserTradesServiceAPI)
+	return ret0
+}
+
+// Limit indicates an expected call of Limit.
+func (mr *MockUserTradesServiceAPIMockRecorder) Limit(limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Limit", reflect.TypeOf((*MockUserTradesServiceAPI)(nil).Limit), limit)
+}
+
+// StartTime mocks base method.
+func (m *MockUserTradesServiceAPI) StartTime(st uint64) options.UserTradesServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartTime", st)
+	ret0, _ := ret[0].(options.UserTradesServiceAPI)
+	return ret0
+}
+
+// StartTime indicates an expected call of StartTime.
+func (mr *MockUserTradesServiceAPIMockRecorder) StartTime(st interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartTime", reflect.TypeOf((*MockUserTradesServiceAPI)(nil).StartTime), st)
+}
+
+// Symbol mocks base method.
+func (m *MockUserTradesServiceAPI) Symbol(symbol string) options.UserTradesServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Symbol", symbol)
+	ret0, _ := ret[0].(options.UserTradesServiceAPI)
+	return ret0
+}
+
+// Symbol indicates an expected call of Symbol.
+func (mr *MockUserTradesServiceAPIMockRecorder) Symbol(symbol interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Symbol", reflect.TypeOf((*MockUserTradesServiceAPI)(nil).Symbol), symbol)
+}
+
+// MockExerciseRecordServiceAPI is a mock of ExerciseRecordServiceAPI interface.
+type MockExerciseRecordServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockExerciseRecordServiceAPIMockRecorder
+}
+
+// MockExerciseRecordServiceAPIMockRecorder is the mock recorder for MockExerciseRecordServiceAPI.
+type MockExerciseRecordServiceAPIMockRecorder struct {
+	mock *MockExerciseRecordServiceAPI
+}
+
+// NewMockExerciseRecordServiceAPI creates a new mock instance.
+func NewMockExerciseRecordServiceAPI(ctrl *gomock.Controller) *MockExerciseRecordServiceAPI {
+	mock := &MockExerciseRecordServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockExerciseRecordServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockExerciseRecordServiceAPI) EXPECT() *MockExerciseRecordServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockExerciseRecordServiceAPI) Do(ctx context.Context, opts ...options.RequestOption) ([]*options.ExerciseRecord, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].([]*options.ExerciseRecord)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockExerciseRecordServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockExerciseRecordServiceAPI)(nil).Do), varargs...)
+}
+
+// EndTime mocks base method.
+func (m *MockExerciseRecordServiceAPI) EndTime(et uint64) options.ExerciseRecordServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EndTime", et)
+	ret0, _ := ret[0].(options.ExerciseRecordServiceAPI)
+	return ret0
+}
+
+// EndTime indicates an expected call of EndTime.
+func (mr *MockExerciseRecordServiceAPIMockRecorder) EndTime(et interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EndTime", reflect.TypeOf((*MockExerciseRecordServiceAPI)(nil).EndTime), et)
+}
+
+// Limit mocks base method.
+func (m *MockExerciseRecordServiceAPI) Limit(limit int) options.ExerciseRecordServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Limit", limit)
+	ret0, _ := ret[0].(options.ExerciseRecordServiceAPI)
+	return ret0
+}
+
+// Limit indicates an expected call of Limit.
+func (mr *MockExerciseRecordServiceAPIMockRecorder) Limit(limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Limit", reflect.TypeOf((*MockExerciseRecordServiceAPI)(nil).Limit), limit)
+}
+
+// StartTime mocks base method.
+func (m *MockExerciseRecordServiceAPI) StartTime(st uint64) options.ExerciseRecordServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartTime", st)
+	ret0, _ := ret[0].(options.ExerciseRecordServiceAPI)
+	return ret0
+}
+
+// StartTime indicates an expected call of StartTime.
+func (mr *MockExerciseRecordServiceAPIMockRecorder) StartTime(st interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartTime", reflect.TypeOf((*MockExerciseRecordServiceAPI)(nil).StartTime), st)
+}
+
+// Symbol mocks base method.
+func (m *MockExerciseRecordServiceAPI) Symbol(symbol string) options.ExerciseRecordServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Symbol", symbol)
+	ret0, _ := ret[0].(options.ExerciseRecordServiceAPI)
+	return ret0
+}
+
+// Symbol indicates an expected call of Symbol.
+func (mr *MockExerciseRecordServiceAPIMockRecorder) Symbol(symbol interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Symbol", reflect.TypeOf((*MockExerciseRecordServiceAPI)(nil).Symbol), symbol)
+}
+
+// MockBillServiceAPI is a mock of BillServiceAPI interface.
+type MockBillServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockBillServiceAPIMockRecorder
+}
+
+// MockBillServiceAPIMockRecorder is the mock recorder for MockBillServiceAPI.
+type MockBillServiceAPIMockRecorder struct {
+	mock *MockBillServiceAPI
+}
+
+// NewMockBillServiceAPI creates a new mock instance.
+func NewMockBillServiceAPI(ctrl *gomock.Controller) *MockBillServiceAPI {
+	mock := &MockBillServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockBillServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockBillServiceAPI) EXPECT() *MockBillServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Currency mocks base method.
+func (m *MockBillServiceAPI) Currency(c string) options.BillServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Currency", c)
+	ret0, _ := ret[0].(options.BillServiceAPI)
+	return ret0
+}
+
+// Currency indicates an expected call of Currency.
+func (mr *MockBillServiceAPIMockRecorder) Currency(c interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Currency", reflect.TypeOf((*MockBillServiceAPI)(nil).Currency), c)
+}
+
+// Do mocks base method.
+func (m *MockBillServiceAPI) Do(ctx context.Context, opts ...options.RequestOption) ([]*options.Bill, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].([]*options.Bill)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockBillServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockBillServiceAPI)(nil).Do), varargs...)
+}
+
+// EndTime mocks base method.
+func (m *MockBillServiceAPI) EndTime(et uint64) options.BillServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EndTime", et)
+	ret0, _ := ret[0].(options.BillServiceAPI)
+	return ret0
+}
+
+// EndTime indicates an expected call of EndTime.
+func (mr *MockBillServiceAPIMockRecorder) EndTime(et interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EndTime", reflect.TypeOf((*MockBillServiceAPI)(nil).EndTime), et)
+}
+
+// Limit mocks base method.
+func (m *MockBillServiceAPI) Limit(limit int) options.BillServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Limit", limit)
+	ret0, _ := ret[0].(options.BillServiceAPI)
+	return ret0
+}
+
+// Limit indicates an expected call of Limit.
+func (mr *MockBillServiceAPIMockRecorder) Limit(limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Limit", reflect.TypeOf((*MockBillServiceAPI)(nil).Limit), limit)
+}
+
+// RecordId mocks base method.
+func (m *MockBillServiceAPI) RecordId(ri uint64) options.BillServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecordId", ri)
+	ret0, _ := ret[0].(options.BillServiceAPI)
+	return ret0
+}
+
+// RecordId indicates an expected call of RecordId.
+func (mr *MockBillServiceAPIMockRecorder) RecordId(ri interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordId", reflect.TypeOf((*MockBillServiceAPI)(nil).RecordId), ri)
+}
+
+// StartTime mocks base method.
+func (m *MockBillServiceAPI) StartTime(st uint64) options.BillServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartTime", st)
+	ret0, _ := ret[0].(options.BillServiceAPI)
+	return ret0
+}
+
+// StartTime indicates an expected call of StartTime.
+func (mr *MockBillServiceAPIMockRecorder) StartTime(st interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartTime", reflect.TypeOf((*MockBillServiceAPI)(nil).StartTime), st)
+}
+
+// MockIncomeDownloadIdServiceAPI is a mock of IncomeDownloadIdServiceAPI interface.
+type MockIncomeDownloadIdServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockIncomeDownloadIdServiceAPIMockRecorder
+}
+
+// MockIncomeDownloadIdServiceAPIMockRecorder is the mock recorder for MockIncomeDownloadIdServiceAPI.
+type MockIncomeDownloadIdServiceAPIMockRecorder struct {
+	mock *MockIncomeDownloadIdServiceAPI
+}
+
+// NewMockIncomeDownloadIdServiceAPI creates a new mock instance.
+func NewMockIncomeDownloadIdServiceAPI(ctrl *gomock.Controller) *MockIncomeDownloadIdServiceAPI {
+	mock := &MockIncomeDownloadIdServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockIncomeDownloadIdServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIncomeDownloadIdServiceAPI) EXPECT() *MockIncomeDownloadIdServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockIncomeDownloadIdServiceAPI) Do(ctx context.Context, opts ...options.RequestOption) (*options.IncomeDownloadId, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*options.IncomeDownloadId)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockIncomeDownloadIdServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockIncomeDownloadIdServiceAPI)(nil).Do), varargs...)
+}
+
+// EndTime mocks base method.
+func (m *MockIncomeDownloadIdServiceAPI) EndTime(et uint64) options.IncomeDownloadIdServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EndTime", et)
+	ret0, _ := ret[0].(options.IncomeDownloadIdServiceAPI)
+	return ret0
+}
+
+// EndTime indicates an expected call of EndTime.
+func (mr *MockIncomeDownloadIdServiceAPIMockRecorder) EndTime(et interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EndTime", reflect.TypeOf((*MockIncomeDownloadIdServiceAPI)(nil).EndTime), et)
+}
+
+// StartTime mocks base method.
+func (m *MockIncomeDownloadIdServiceAPI) StartTime(st uint64) options.IncomeDownloadIdServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartTime", st)
+	ret0, _ := ret[0].(options.IncomeDownloadIdServiceAPI)
+	return ret0
+}
+
+// StartTime indicates an expected call of StartTime.
+func (mr *MockIncomeDownloadIdServiceAPIMockRecorder) StartTime(st interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartTime", reflect.TypeOf((*MockIncomeDownloadIdServiceAPI)(nil).StartTime), st)
+}
+
+// MockIncomeDownloadLinkServiceAPI is a mock of IncomeDownloadLinkServiceAPI interface.
+type MockIncomeDownloadLinkServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockIncomeDownloadLinkServiceAPIMockRecorder
+}
+
+// MockIncomeDownloadLinkServiceAPIMockRecorder is the mock recorder for MockIncomeDownloadLinkServiceAPI.
+type MockIncomeDownloadLinkServiceAPIMockRecorder struct {
+	mock *MockIncomeDownloadLinkServiceAPI
+}
+
+// NewMockIncomeDownloadLinkServiceAPI creates a new mock instance.
+func NewMockIncomeDownloadLinkServiceAPI(ctrl *gomock.Controller) *MockIncomeDownloadLinkServiceAPI {
+	mock := &MockIncomeDownloadLinkServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockIncomeDownloadLinkServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIncomeDownloadLinkServiceAPI) EXPECT() *MockIncomeDownloadLinkServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockIncomeDownloadLinkServiceAPI) Do(ctx context.Context, opts ...options.RequestOption) (*options.IncomeDownloadLink, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*options.IncomeDownloadLink)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockIncomeDownloadLinkServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockIncomeDownloadLinkServiceAPI)(nil).Do), varargs...)
+}
+
+// DownloadId mocks base method.
+func (m *MockIncomeDownloadLinkServiceAPI) DownloadId(di string) options.IncomeDownloadLinkServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DownloadId", di)
+	ret0, _ := ret[0].(options.IncomeDownloadLinkServiceAPI)
+	return ret0
+}
+
+// DownloadId indicates an expected call of DownloadId.
+func (mr *MockIncomeDownloadLinkServiceAPIMockRecorder) DownloadId(di interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DownloadId", reflect.TypeOf((*MockIncomeDownloadLinkServiceAPI)(nil).DownloadId), di)
+}
+
+// MockStartUserStreamServiceAPI is a mock of StartUserStreamServiceAPI interface.
+type MockStartUserStreamServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockStartUserStreamServiceAPIMockRecorder
+}
+
+// MockStartUserStreamServiceAPIMockRecorder is the mock recorder for MockStartUserStreamServiceAPI.
+type MockStartUserStreamServiceAPIMockRecorder struct {
+	mock *MockStartUserStreamServiceAPI
+}
+
+// NewMockStartUserStreamServiceAPI creates a new mock instance.
+func NewMockStartUserStreamServiceAPI(ctrl *gomock.Controller) *MockStartUserStreamServiceAPI {
+	mock := &MockStartUserStreamServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockStartUserStreamServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockStartUserStreamServiceAPI) EXPECT() *MockStartUserStreamServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockStartUserStreamServiceAPI) Do(ctx context.Context, opts ...options.RequestOption) (string, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockStartUserStreamServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockStartUserStreamServiceAPI)(nil).Do), varargs...)
+}
+
+// MockKeepaliveUserStreamServiceAPI is a mock of KeepaliveUserStreamServiceAPI interface.
+type MockKeepaliveUserStreamServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockKeepaliveUserStreamServiceAPIMockRecorder
+}
+
+// MockKeepaliveUserStreamServiceAPIMockRecorder is the mock recorder for MockKeepaliveUserStreamServiceAPI.
+type MockKeepaliveUserStreamServiceAPIMockRecorder struct {
+	mock *MockKeepaliveUserStreamServiceAPI
+}
+
+// NewMockKeepaliveUserStreamServiceAPI creates a new mock instance.
+func NewMockKeepaliveUserStreamServiceAPI(ctrl *gomock.Controller) *MockKeepaliveUserStreamServiceAPI {
+	mock := &MockKeepaliveUserStreamServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockKeepaliveUserStreamServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockKeepaliveUserStreamServiceAPI) EXPECT() *MockKeepaliveUserStreamServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockKeepaliveUserStreamServiceAPI) Do(ctx context.Context, opts ...options.RequestOption) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockKeepaliveUserStreamServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockKeepaliveUserStreamServiceAPI)(nil).Do), varargs...)
+}
+
+// ListenKey mocks base method.
+func (m *MockKeepaliveUserStreamServiceAPI) ListenKey(listenKey string) options.KeepaliveUserStreamServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListenKey", listenKey)
+	ret0, _ := ret[0].(options.KeepaliveUserStreamServiceAPI)
+	return ret0
+}
+
+// ListenKey indicates an expected call of ListenKey.
+func (mr *MockKeepaliveUserStreamServiceAPIMockRecorder) ListenKey(listenKey interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListenKey", reflect.TypeOf((*MockKeepaliveUserStreamServiceAPI)(nil).ListenKey), listenKey)
+}
+
+// MockCloseUserStreamServiceAPI is a mock of CloseUserStreamServiceAPI interface.
+type MockCloseUserStreamServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockCloseUserStreamServiceAPIMockRecorder
+}
+
+// MockCloseUserStreamServiceAPIMockRecorder is the mock recorder for MockCloseUserStreamServiceAPI.
+type MockCloseUserStreamServiceAPIMockRecorder struct {
+	mock *MockCloseUserStreamServiceAPI
+}
+
+// NewMockCloseUserStreamServiceAPI creates a new mock instance.
+func NewMockCloseUserStreamServiceAPI(ctrl *gomock.Controller) *MockCloseUserStreamServiceAPI {
+	mock := &MockCloseUserStreamServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockCloseUserStreamServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCloseUserStreamServiceAPI) EXPECT() *MockCloseUserStreamServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockCloseUserStreamServiceAPI) Do(ctx context.Context, opts ...options.RequestOption) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockCloseUserStreamServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockCloseUserStreamServiceAPI)(nil).Do), varargs...)
+}
+
+// ListenKey mocks base method.
+func (m *MockCloseUserStreamServiceAPI) ListenKey(listenKey string) options.CloseUserStreamServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListenKey", listenKey)
+	ret0, _ := ret[0].(options.CloseUserStreamServiceAPI)
+	return ret0
+}
+
+// ListenKey indicates an expected call of ListenKey.
+func (mr *MockCloseUserStreamServiceAPIMockRecorder) ListenKey(listenKey interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListenKey", reflect.TypeOf((*MockCloseUserStreamServiceAPI)(nil).ListenKey), listenKey)
+}
+
+// MockSetMMPServiceAPI is a mock of SetMMPServiceAPI interface.
+type MockSetMMPServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockSetMMPServiceAPIMockRecorder
+}
+
+// MockSetMMPServiceAPIMockRecorder is the mock recorder for MockSetMMPServiceAPI.
+type MockSetMMPServiceAPIMockRecorder struct {
+	mock *MockSetMMPServiceAPI
+}
+
+// NewMockSetMMPServiceAPI creates a new mock instance.
+func NewMockSetMMPServiceAPI(ctrl *gomock.Controller) *MockSetMMPServiceAPI {
+	mock := &MockSetMMPServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockSetMMPServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSetMMPServiceAPI) EXPECT() *MockSetMMPServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// DeltaLimit mocks base method.
+func (m *MockSetMMPServiceAPI) DeltaLimit(deltaLimit string) options.SetMMPServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeltaLimit", deltaLimit)
+	ret0, _ := ret[0].(options.SetMMPServiceAPI)
+	return ret0
+}
+
+// DeltaLimit indicates an expected call of DeltaLimit.
+func (mr *MockSetMMPServiceAPIMockRecorder) DeltaLimit(deltaLimit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeltaLimit", reflect.TypeOf((*MockSetMMPServiceAPI)(nil).DeltaLimit), deltaLimit)
+}
+
+// Do mocks base method.
+func (m *MockSetMMPServiceAPI) Do(ctx context.Context, opts ...options.RequestOption) (*options.MMPConfig, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*options.MMPConfig)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockSetMMPServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockSetMMPServiceAPI)(nil).Do), varargs...)
+}
+
+// FrozenTimeInMilliseconds mocks base method.
+func (m *MockSetMMPServiceAPI) FrozenTimeInMilliseconds(frozenTime int64) options.SetMMPServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FrozenTimeInMilliseconds", frozenTime)
+	ret0, _ := ret[0].(options.SetMMPServiceAPI)
+	return ret0
+}
+
+// FrozenTimeInMilliseconds indicates an expected call of FrozenTimeInMilliseconds.
+func (mr *MockSetMMPServiceAPIMockRecorder) FrozenTimeInMilliseconds(frozenTime interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FrozenTimeInMilliseconds", reflect.TypeOf((*MockSetMMPServiceAPI)(nil).FrozenTimeInMilliseconds), frozenTime)
+}
+
+// QtyLimit mocks base method.
+func (m *MockSetMMPServiceAPI) QtyLimit(qtyLimit string) options.SetMMPServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "QtyLimit", qtyLimit)
+	ret0, _ := ret[0].(options.SetMMPServiceAPI)
+	return ret0
+}
+
+// QtyLimit indicates an expected call of QtyLimit.
+func (mr *MockSetMMPServiceAPIMockRecorder) QtyLimit(qtyLimit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QtyLimit", reflect.TypeOf((*MockSetMMPServiceAPI)(nil).QtyLimit), qtyLimit)
+}
+
+// Underlying mocks base method.
+func (m *MockSetMMPServiceAPI) Underlying(underlying string) options.SetMMPServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Underlying", underlying)
+	ret0, _ := ret[0].(options.SetMMPServiceAPI)
+	return ret0
+}
+
+// Underlying indicates an expected call of Underlying.
+func (mr *MockSetMMPServiceAPIMockRecorder) Underlying(underlying interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Underlying", reflect.TypeOf((*MockSetMMPServiceAPI)(nil).Underlying), underlying)
+}
+
+// WindowTimeInMilliseconds mocks base method.
+func (m *MockSetMMPServiceAPI) WindowTimeInMilliseconds(windowTime int64) options.SetMMPServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WindowTimeInMilliseconds", windowTime)
+	ret0, _ := ret[0].(options.SetMMPServiceAPI)
+	return ret0
+}
+
+// WindowTimeInMilliseconds indicates an expected call of WindowTimeInMilliseconds.
+func (mr *MockSetMMPServiceAPIMockRecorder) WindowTimeInMilliseconds(windowTime interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WindowTimeInMilliseconds", reflect.TypeOf((*MockSetMMPServiceAPI)(nil).WindowTimeInMilliseconds), windowTime)
+}
+
+// MockGetMMPServiceAPI is a mock of GetMMPServiceAPI interface.
+type MockGetMMPServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockGetMMPServiceAPIMockRecorder
+}
+
+// MockGetMMPServiceAPIMockRecorder is the mock recorder for MockGetMMPServiceAPI.
+type MockGetMMPServiceAPIMockRecorder struct {
+	mock *MockGetMMPServiceAPI
+}
+
+// NewMockGetMMPServiceAPI creates a new mock instance.
+func NewMockGetMMPServiceAPI(ctrl *gomock.Controller) *MockGetMMPServiceAPI {
+	mock := &MockGetMMPServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockGetMMPServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGetMMPServiceAPI) EXPECT() *MockGetMMPServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockGetMMPServiceAPI) Do(ctx context.Context, opts ...options.RequestOption) (*options.MMPConfig, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*options.MMPConfig)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockGetMMPServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockGetMMPServiceAPI)(nil).Do), varargs...)
+}
+
+// Underlying mocks base method.
+func (m *MockGetMMPServiceAPI) Underlying(underlying string) options.GetMMPServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Underlying", underlying)
+	ret0, _ := ret[0].(options.GetMMPServiceAPI)
+	return ret0
+}
+
+// Underlying indicates an expected call of Underlying.
+func (mr *MockGetMMPServiceAPIMockRecorder) Underlying(underlying interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Underlying", reflect.TypeOf((*MockGetMMPServiceAPI)(nil).Underlying), underlying)
+}
+
+// MockResetMMPServiceAPI is a mock of ResetMMPServiceAPI interface.
+type MockResetMMPServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockResetMMPServiceAPIMockRecorder
+}
+
+// MockResetMMPServiceAPIMockRecorder is the mock recorder for MockResetMMPServiceAPI.
+type MockResetMMPServiceAPIMockRecorder struct {
+	mock *MockResetMMPServiceAPI
+}
+
+// NewMockResetMMPServiceAPI creates a new mock instance.
+func NewMockResetMMPServiceAPI(ctrl *gomock.Controller) *MockResetMMPServiceAPI {
+	mock := &MockResetMMPServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockResetMMPServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockResetMMPServiceAPI) EXPECT() *MockResetMMPServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockResetMMPServiceAPI) Do(ctx context.Context, opts ...options.RequestOption) (*options.MMPConfig, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*options.MMPConfig)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockResetMMPServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockResetMMPServiceAPI)(nil).Do), varargs...)
+}
+
+// Underlying mocks base method.
+func (m *MockResetMMPServiceAPI) Underlying(underlying string) options.ResetMMPServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Underlying", underlying)
+	ret0, _ := ret[0].(options.ResetMMPServiceAPI)
+	return ret0
+}
+
+// Underlying indicates an expected call of Underlying.
+func (mr *MockResetMMPServiceAPIMockRecorder) Underlying(underlying interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Underlying", reflect.TypeOf((*MockResetMMPServiceAPI)(nil).Underlying), underlying)
+}
+
+// MockCountdownCancelAllServiceAPI is a mock of CountdownCancelAllServiceAPI interface.
+type MockCountdownCancelAllServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockCountdownCancelAllServiceAPIMockRecorder
+}
+
+// MockCountdownCancelAllServiceAPIMockRecorder is the mock recorder for MockCountdownCancelAllServiceAPI.
+type MockCountdownCancelAllServiceAPIMockRecorder struct {
+	mock *MockCountdownCancelAllServiceAPI
+}
+
+// NewMockCountdownCancelAllServiceAPI creates a new mock instance.
+func NewMockCountdownCancelAllServiceAPI(ctrl *gomock.Controller) *MockCountdownCancelAllServiceAPI {
+	mock := &MockCountdownCancelAllServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockCountdownCancelAllServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCountdownCancelAllServiceAPI) EXPECT() *MockCountdownCancelAllServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// CountdownTime mocks base method.
+func (m *MockCountdownCancelAllServiceAPI) CountdownTime(countdownTime int64) options.CountdownCancelAllServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountdownTime", countdownTime)
+	ret0, _ := ret[0].(options.CountdownCancelAllServiceAPI)
+	return ret0
+}
+
+// CountdownTime indicates an expected call of CountdownTime.
+func (mr *MockCountdownCancelAllServiceAPIMockRecorder) CountdownTime(countdownTime interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountdownTime", reflect.TypeOf((*MockCountdownCancelAllServiceAPI)(nil).CountdownTime), countdownTime)
+}
+
+// Do mocks base method.
+func (m *MockCountdownCancelAllServiceAPI) Do(ctx context.Context, opts ...options.RequestOption) (*options.CountdownCancelAll, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*options.CountdownCancelAll)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockCountdownCancelAllServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockCountdownCancelAllServiceAPI)(nil).Do), varargs...)
+}
+
+// Underlying mocks base method.
+func (m *MockCountdownCancelAllServiceAPI) Underlying(underlying string) options.CountdownCancelAllServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Underlying", underlying)
+	ret0, _ := ret[0].(options.CountdownCancelAllServiceAPI)
+	return ret0
+}
+
+// Underlying indicates an expected call of Underlying.
+func (mr *MockCountdownCancelAllServiceAPIMockRecorder) Underlying(underlying interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Underlying", reflect.TypeOf((*MockCountdownCancelAllServiceAPI)(nil).Underlying), underlying)
+}
+
+// MockGetCountdownCancelAllServiceAPI is a mock of GetCountdownCancelAllServiceAPI interface.
+type MockGetCountdownCancelAllServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockGetCountdownCancelAllServiceAPIMockRecorder
+}
+
+// MockGetCountdownCancelAllServiceAPIMockRecorder is the mock recorder for MockGetCountdownCancelAllServiceAPI.
+type MockGetCountdownCancelAllServiceAPIMockRecorder struct {
+	mock *MockGetCountdownCancelAllServiceAPI
+}
+
+// NewMockGetCountdownCancelAllServiceAPI creates a new mock instance.
+func NewMockGetCountdownCancelAllServiceAPI(ctrl *gomock.Controller) *MockGetCountdownCancelAllServiceAPI {
+	mock := &MockGetCountdownCancelAllServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockGetCountdownCancelAllServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGetCountdownCancelAllServiceAPI) EXPECT() *MockGetCountdownCancelAllServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockGetCountdownCancelAllServiceAPI) Do(ctx context.Context, opts ...options.RequestOption) (*options.CountdownCancelAll, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*options.CountdownCancelAll)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockGetCountdownCancelAllServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockGetCountdownCancelAllServiceAPI)(nil).Do), varargs...)
+}
+
+// Underlying mocks base method.
+func (m *MockGetCountdownCancelAllServiceAPI) Underlying(underlying string) options.GetCountdownCancelAllServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Underlying", underlying)
+	ret0, _ := ret[0].(options.GetCountdownCancelAllServiceAPI)
+	return ret0
+}
+
+// Underlying indicates an expected call of Underlying.
+func (mr *MockGetCountdownCancelAllServiceAPIMockRecorder) Underlying(underlying interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Underlying", reflect.TypeOf((*MockGetCountdownCancelAllServiceAPI)(nil).Underlying), underlying)
+}
+
+// MockCountdownCancelAllHeartBeatServiceAPI is a mock of CountdownCancelAllHeartBeatServiceAPI interface.
+type MockCountdownCancelAllHeartBeatServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockCountdownCancelAllHeartBeatServiceAPIMockRecorder
+}
+
+// MockCountdownCancelAllHeartBeatServiceAPIMockRecorder is the mock recorder for MockCountdownCancelAllHeartBeatServiceAPI.
+type MockCountdownCancelAllHeartBeatServiceAPIMockRecorder struct {
+	mock *MockCountdownCancelAllHeartBeatServiceAPI
+}
+
+// NewMockCountdownCancelAllHeartBeatServiceAPI creates a new mock instance.
+func NewMockCountdownCancelAllHeartBeatServiceAPI(ctrl *gomock.Controller) *MockCountdownCancelAllHeartBeatServiceAPI {
+	mock := &MockCountdownCancelAllHeartBeatServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockCountdownCancelAllHeartBeatServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCountdownCancelAllHeartBeatServiceAPI) EXPECT() *MockCountdownCancelAllHeartBeatServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockCountdownCancelAllHeartBeatServiceAPI) Do(ctx context.Context, opts ...options.RequestOption) (*options.CountdownCancelAllHeartBeat, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*options.CountdownCancelAllHeartBeat)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockCountdownCancelAllHeartBeatServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockCountdownCancelAllHeartBeatServiceAPI)(nil).Do), varargs...)
+}
+
+// Underlyings mocks base method.
+func (m *MockCountdownCancelAllHeartBeatServiceAPI) Underlyings(underlyings ...string) options.CountdownCancelAllHeartBeatServiceAPI {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{}
+	for _, a := range underlyings {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Underlyings", varargs...)
+	ret0, _ := ret[0].(options.CountdownCancelAllHeartBeatServiceAPI)
+	return ret0
+}
+
+// Underlyings indicates an expected call of Underlyings.
+func (mr *MockCountdownCancelAllHeartBeatServiceAPIMockRecorder) Underlyings(underlyings ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Underlyings", reflect.TypeOf((*MockCountdownCancelAllHeartBeatServiceAPI)(nil).Underlyings), underlyings...)
+}
+
+// MockMarginAccountServiceAPI is a mock of MarginAccountServiceAPI interface.
+type MockMarginAccountServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockMarginAccountServiceAPIMockRecorder
+}
+
+// MockMarginAccountServiceAPIMockRecorder is the mock recorder for MockMarginAccountServiceAPI.
+type MockMarginAccountServiceAPIMockRecorder struct {
+	mock *MockMarginAccountServiceAPI
+}
+
+// NewMockMarginAccountServiceAPI creates a new mock instance.
+func NewMockMarginAccountServiceAPI(ctrl *gomock.Controller) *MockMarginAccountServiceAPI {
+	mock := &MockMarginAccountServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockMarginAccountServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockMarginAccountServiceAPI) EXPECT() *MockMarginAccountServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockMarginAccountServiceAPI) Do(ctx context.Context, opts ...options.RequestOption) (*options.MarginAccount, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*options.MarginAccount)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockMarginAccountServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockMarginAccountServiceAPI)(nil).Do), varargs...)
+}
+
+// MockCreateBlockTradeOrderServiceAPI is a mock of CreateBlockTradeOrderServiceAPI interface.
+type MockCreateBlockTradeOrderServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockCreateBlockTradeOrderServiceAPIMockRecorder
+}
+
+// MockCreateBlockTradeOrderServiceAPIMockRecorder is the mock recorder for MockCreateBlockTradeOrderServiceAPI.
+type MockCreateBlockTradeOrderServiceAPIMockRecorder struct {
+	mock *MockCreateBlockTradeOrderServiceAPI
+}
+
+// NewMockCreateBlockTradeOrderServiceAPI creates a new mock instance.
+func NewMockCreateBlockTradeOrderServiceAPI(ctrl *gomock.Controller) *MockCreateBlockTradeOrderServiceAPI {
+	mock := &MockCreateBlockTradeOrderServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockCreateBlockTradeOrderServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCreateBlockTradeOrderServiceAPI) EXPECT() *MockCreateBlockTradeOrderServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockCreateBlockTradeOrderServiceAPI) Do(ctx context.Context, opts ...options.RequestOption) (*options.BlockTradeOrder, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*options.BlockTradeOrder)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockCreateBlockTradeOrderServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockCreateBlockTradeOrderServiceAPI)(nil).Do), varargs...)
+}
+
+// Legs mocks base method.
+func (m *MockCreateBlockTradeOrderServiceAPI) Legs(legs ...*options.BlockTradeLeg) options.CreateBlockTradeOrderServiceAPI {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{}
+	for _, a := range legs {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Legs", varargs...)
+	ret0, _ := ret[0].(options.CreateBlockTradeOrderServiceAPI)
+	return ret0
+}
+
+// Legs indicates an expected call of Legs.
+func (mr *MockCreateBlockTradeOrderServiceAPIMockRecorder) Legs(legs ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Legs", reflect.TypeOf((*MockCreateBlockTradeOrderServiceAPI)(nil).Legs), legs...)
+}
+
+// Liquidity mocks base method.
+func (m *MockCreateBlockTradeOrderServiceAPI) Liquidity(liquidity options.BlockTradeLiquidityType) options.CreateBlockTradeOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Liquidity", liquidity)
+	ret0, _ := ret[0].(options.CreateBlockTradeOrderServiceAPI)
+	return ret0
+}
+
+// Liquidity indicates an expected call of Liquidity.
+func (mr *MockCreateBlockTradeOrderServiceAPIMockRecorder) Liquidity(liquidity interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Liquidity", reflect.TypeOf((*MockCreateBlockTradeOrderServiceAPI)(nil).Liquidity), liquidity)
+}
+
+// MockExtendBlockTradeOrderServiceAPI is a mock of ExtendBlockTradeOrderServiceAPI interface.
+type MockExtendBlockTradeOrderServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockExtendBlockTradeOrderServiceAPIMockRecorder
+}
+
+// MockExtendBlockTradeOrderServiceAPIMockRecorder is the mock recorder for MockExtendBlockTradeOrderServiceAPI.
+type MockExtendBlockTradeOrderServiceAPIMockRecorder struct {
+	mock *MockExtendBlockTradeOrderServiceAPI
+}
+
+// NewMockExtendBlockTradeOrderServiceAPI creates a new mock instance.
+func NewMockExtendBlockTradeOrderServiceAPI(ctrl *gomock.Controller) *MockExtendBlockTradeOrderServiceAPI {
+	mock := &MockExtendBlockTradeOrderServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockExtendBlockTradeOrderServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockExtendBlockTradeOrderServiceAPI) EXPECT() *MockExtendBlockTradeOrderServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// BlockOrderMatchingKey mocks base method.
+func (m *MockExtendBlockTradeOrderServiceAPI) BlockOrderMatchingKey(key string) options.ExtendBlockTradeOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BlockOrderMatchingKey", key)
+	ret0, _ := ret[0].(options.ExtendBlockTradeOrderServiceAPI)
+	return ret0
+}
+
+// BlockOrderMatchingKey indicates an expected call of BlockOrderMatchingKey.
+func (mr *MockExtendBlockTradeOrderServiceAPIMockRecorder) BlockOrderMatchingKey(key interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BlockOrderMatchingKey", reflect.TypeOf((*MockExtendBlockTradeOrderServiceAPI)(nil).BlockOrderMatchingKey), key)
+}
+
+// Do mocks base method.
+func (m *MockExtendBlockTradeOrderServiceAPI) Do(ctx context.Context, opts ...options.RequestOption) (*options.BlockTradeOrder, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*options.BlockTradeOrder)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockExtendBlockTradeOrderServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockExtendBlockTradeOrderServiceAPI)(nil).Do), varargs...)
+}
+
+// MockCancelBlockTradeOrderServiceAPI is a mock of CancelBlockTradeOrderServiceAPI interface.
+type MockCancelBlockTradeOrderServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockCancelBlockTradeOrderServiceAPIMockRecorder
+}
+
+// MockCancelBlockTradeOrderServiceAPIMockRecorder is the mock recorder for MockCancelBlockTradeOrderServiceAPI.
+type MockCancelBlockTradeOrderServiceAPIMockRecorder struct {
+	mock *MockCancelBlockTradeOrderServiceAPI
+}
+
+// NewMockCancelBlockTradeOrderServiceAPI creates a new mock instance.
+func NewMockCancelBlockTradeOrderServiceAPI(ctrl *gomock.Controller) *MockCancelBlockTradeOrderServiceAPI {
+	mock := &MockCancelBlockTradeOrderServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockCancelBlockTradeOrderServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCancelBlockTradeOrderServiceAPI) EXPECT() *MockCancelBlockTradeOrderServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// BlockOrderMatchingKey mocks base method.
+func (m *MockCancelBlockTradeOrderServiceAPI) BlockOrderMatchingKey(key string) options.CancelBlockTradeOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BlockOrderMatchingKey", key)
+	ret0, _ := ret[0].(options.CancelBlockTradeOrderServiceAPI)
+	return ret0
+}
+
+// BlockOrderMatchingKey indicates an expected call of BlockOrderMatchingKey.
+func (mr *MockCancelBlockTradeOrderServiceAPIMockRecorder) BlockOrderMatchingKey(key interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BlockOrderMatchingKey", reflect.TypeOf((*MockCancelBlockTradeOrderServiceAPI)(nil).BlockOrderMatchingKey), key)
+}
+
+// Do mocks base method.
+func (m *MockCancelBlockTradeOrderServiceAPI) Do(ctx context.Context, opts ...options.RequestOption) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockCancelBlockTradeOrderServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockCancelBlockTradeOrderServiceAPI)(nil).Do), varargs...)
+}
+
+// MockListBlockTradeOrdersServiceAPI is a mock of ListBlockTradeOrdersServiceAPI interface.
+type MockListBlockTradeOrdersServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockListBlockTradeOrdersServiceAPIMockRecorder
+}
+
+// MockListBlockTradeOrdersServiceAPIMockRecorder is the mock recorder for MockListBlockTradeOrdersServiceAPI.
+type MockListBlockTradeOrdersServiceAPIMockRecorder struct {
+	mock *MockListBlockTradeOrdersServiceAPI
+}
+
+// NewMockListBlockTradeOrdersServiceAPI creates a new mock instance.
+func NewMockListBlockTradeOrdersServiceAPI(ctrl *gomock.Controller) *MockListBlockTradeOrdersServiceAPI {
+	mock := &MockListBlockTradeOrdersServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockListBlockTradeOrdersServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockListBlockTradeOrdersServiceAPI) EXPECT() *MockListBlockTradeOrdersServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// BlockOrderMatchingKey mocks base method.
+func (m *MockListBlockTradeOrdersServiceAPI) BlockOrderMatchingKey(key string) options.ListBlockTradeOrdersServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BlockOrderMatchingKey", key)
+	ret0, _ := ret[0].(options.ListBlockTradeOrdersServiceAPI)
+	return ret0
+}
+
+// BlockOrderMatchingKey indicates an expected call of BlockOrderMatchingKey.
+func (mr *MockListBlockTradeOrdersServiceAPIMockRecorder) BlockOrderMatchingKey(key interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BlockOrderMatchingKey", reflect.TypeOf((*MockListBlockTradeOrdersServiceAPI)(nil).BlockOrderMatchingKey), key)
+}
+
+// Do mocks base method.
+func (m *MockListBlockTradeOrdersServiceAPI) Do(ctx context.Context, opts ...options.RequestOption) ([]*options.BlockTradeOrder, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].([]*options.BlockTradeOrder)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockListBlockTradeOrdersServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockListBlockTradeOrdersServiceAPI)(nil).Do), varargs...)
+}
+
+// EndTime mocks base method.
+func (m *MockListBlockTradeOrdersServiceAPI) EndTime(endTime int64) options.ListBlockTradeOrdersServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EndTime", endTime)
+	ret0, _ := ret[0].(options.ListBlockTradeOrdersServiceAPI)
+	return ret0
+}
+
+// EndTime indicates an expected call of EndTime.
+func (mr *MockListBlockTradeOrdersServiceAPIMockRecorder) EndTime(endTime interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EndTime", reflect.TypeOf((*MockListBlockTradeOrdersServiceAPI)(nil).EndTime), endTime)
+}
+
+// StartTime mocks base method.
+func (m *MockListBlockTradeOrdersServiceAPI) StartTime(startTime int64) options.ListBlockTradeOrdersServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartTime", startTime)
+	ret0, _ := ret[0].(options.ListBlockTradeOrdersServiceAPI)
+	return ret0
+}
+
+// StartTime indicates an expected call of StartTime.
+func (mr *MockListBlockTradeOrdersServiceAPIMockRecorder) StartTime(startTime interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartTime", reflect.TypeOf((*MockListBlockTradeOrdersServiceAPI)(nil).StartTime), startTime)
+}
+
+// Underlying mocks base method.
+func (m *MockListBlockTradeOrdersServiceAPI) Underlying(underlying string) options.ListBlockTradeOrdersServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Underlying", underlying)
+	ret0, _ := ret[0].(options.ListBlockTradeOrdersServiceAPI)
+	return ret0
+}
+
+// Underlying indicates an expected call of Underlying.
+func (mr *MockListBlockTradeOrdersServiceAPIMockRecorder) Underlying(underlying interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Underlying", reflect.TypeOf((*MockListBlockTradeOrdersServiceAPI)(nil).Underlying), underlying)
+}
+
+// MockAcceptBlockTradeOrderServiceAPI is a mock of AcceptBlockTradeOrderServiceAPI interface.
+type MockAcceptBlockTradeOrderServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockAcceptBlockTradeOrderServiceAPIMockRecorder
+}
+
+// MockAcceptBlockTradeOrderServiceAPIMockRecorder is the mock recorder for MockAcceptBlockTradeOrderServiceAPI.
+type MockAcceptBlockTradeOrderServiceAPIMockRecorder struct {
+	mock *MockAcceptBlockTradeOrderServiceAPI
+}
+
+// NewMockAcceptBlockTradeOrderServiceAPI creates a new mock instance.
+func NewMockAcceptBlockTradeOrderServiceAPI(ctrl *gomock.Controller) *MockAcceptBlockTradeOrderServiceAPI {
+	mock := &MockAcceptBlockTradeOrderServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockAcceptBlockTradeOrderServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAcceptBlockTradeOrderServiceAPI) EXPECT() *MockAcceptBlockTradeOrderServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// BlockOrderMatchingKey mocks base method.
+func (m *MockAcceptBlockTradeOrderServiceAPI) BlockOrderMatchingKey(key string) options.AcceptBlockTradeOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BlockOrderMatchingKey", key)
+	ret0, _ := ret[0].(options.AcceptBlockTradeOrderServiceAPI)
+	return ret0
+}
+
+// BlockOrderMatchingKey indicates an expected call of BlockOrderMatchingKey.
+func (mr *MockAcceptBlockTradeOrderServiceAPIMockRecorder) BlockOrderMatchingKey(key interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BlockOrderMatchingKey", reflect.TypeOf((*MockAcceptBlockTradeOrderServiceAPI)(nil).BlockOrderMatchingKey), key)
+}
+
+// Do mocks base method.
+func (m *MockAcceptBlockTradeOrderServiceAPI) Do(ctx context.Context, opts ...options.RequestOption) (*options.BlockTradeOrder, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*options.BlockTradeOrder)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockAcceptBlockTradeOrderServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockAcceptBlockTradeOrderServiceAPI)(nil).Do), varargs...)
+}
+
+// MockGetBlockTradeOrderServiceAPI is a mock of GetBlockTradeOrderServiceAPI interface.
+type MockGetBlockTradeOrderServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockGetBlockTradeOrderServiceAPIMockRecorder
+}
+
+// MockGetBlockTradeOrderServiceAPIMockRecorder is the mock recorder for MockGetBlockTradeOrderServiceAPI.
+type MockGetBlockTradeOrderServiceAPIMockRecorder struct {
+	mock *MockGetBlockTradeOrderServiceAPI
+}
+
+// NewMockGetBlockTradeOrderServiceAPI creates a new mock instance.
+func NewMockGetBlockTradeOrderServiceAPI(ctrl *gomock.Controller) *MockGetBlockTradeOrderServiceAPI {
+	mock := &MockGetBlockTradeOrderServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockGetBlockTradeOrderServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGetBlockTradeOrderServiceAPI) EXPECT() *MockGetBlockTradeOrderServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// BlockOrderMatchingKey mocks base method.
+func (m *MockGetBlockTradeOrderServiceAPI) BlockOrderMatchingKey(key string) options.GetBlockTradeOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BlockOrderMatchingKey", key)
+	ret0, _ := ret[0].(options.GetBlockTradeOrderServiceAPI)
+	return ret0
+}
+
+// BlockOrderMatchingKey indicates an expected call of BlockOrderMatchingKey.
+func (mr *MockGetBlockTradeOrderServiceAPIMockRecorder) BlockOrderMatchingKey(key interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BlockOrderMatchingKey", reflect.TypeOf((*MockGetBlockTradeOrderServiceAPI)(nil).BlockOrderMatchingKey), key)
+}
+
+// Do mocks base method.
+func (m *MockGetBlockTradeOrderServiceAPI) Do(ctx context.Context, opts ...options.RequestOption) (*options.BlockTradeOrder, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*options.BlockTradeOrder)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockGetBlockTradeOrderServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockGetBlockTradeOrderServiceAPI)(nil).Do), varargs...)
+}
+
+// MockListBlockUserTradesServiceAPI is a mock of ListBlockUserTradesServiceAPI interface.
+type MockListBlockUserTradesServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockListBlockUserTradesServiceAPIMockRecorder
+}
+
+// MockListBlockUserTradesServiceAPIMockRecorder is the mock recorder for MockListBlockUserTradesServiceAPI.
+type MockListBlockUserTradesServiceAPIMockRecorder struct {
+	mock *MockListBlockUserTradesServiceAPI
+}
+
+// NewMockListBlockUserTradesServiceAPI creates a new mock instance.
+func NewMockListBlockUserTradesServiceAPI(ctrl *gomock.Controller) *MockListBlockUserTradesServiceAPI {
+	mock := &MockListBlockUserTradesServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockListBlockUserTradesServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockListBlockUserTradesServiceAPI) EXPECT() *MockListBlockUserTradesServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockListBlockUserTradesServiceAPI) Do(ctx context.Context, opts ...options.RequestOption) ([]*options.BlockUserTrade, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].([]*options.BlockUserTrade)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockListBlockUserTradesServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockListBlockUserTradesServiceAPI)(nil).Do), varargs...)
+}
+
+// EndTime mocks base method.
+func (m *MockListBlockUserTradesServiceAPI) EndTime(endTime int64) options.ListBlockUserTradesServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EndTime", endTime)
+	ret0, _ := ret[0].(options.ListBlockUserTradesServiceAPI)
+	return ret0
+}
+
+// EndTime indicates an expected call of EndTime.
+func (mr *MockListBlockUserTradesServiceAPIMockRecorder) EndTime(endTime interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EndTime", reflect.TypeOf((*MockListBlockUserTradesServiceAPI)(nil).EndTime), endTime)
+}
+
+// StartTime mocks base method.
+func (m *MockListBlockUserTradesServiceAPI) StartTime(startTime int64) options.ListBlockUserTradesServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartTime", startTime)
+	ret0, _ := ret[0].(options.ListBlockUserTradesServiceAPI)
+	return ret0
+}
+
+// StartTime indicates an expected call of StartTime.
+func (mr *MockListBlockUserTradesServiceAPIMockRecorder) StartTime(startTime interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartTime", reflect.TypeOf((*MockListBlockUserTradesServiceAPI)(nil).StartTime), startTime)
+}
+
+// Underlying mocks base method.
+func (m *MockListBlockUserTradesServiceAPI) Underlying(underlying string) options.ListBlockUserTradesServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Underlying", underlying)
+	ret0, _ := ret[0].(options.ListBlockUserTradesServiceAPI)
+	return ret0
+}
+
+// Underlying indicates an expected call of Underlying.
+func (mr *MockListBlockUserTradesServiceAPIMockRecorder) Underlying(underlying interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Underlying", reflect.TypeOf((*MockListBlockUserTradesServiceAPI)(nil).Underlying), underlying)
+}
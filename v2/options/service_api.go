@@ -0,0 +1,1228 @@
+// Code generated by servicegen. DO NOT EDIT.
+
+package options
+
+import (
+	"context"
+)
+
+// ClientAPI is the interface of the REST services of Client, see NewClientAPI
+type ClientAPI interface {
+	NewPingService() PingServiceAPI
+	NewServerTimeService() ServerTimeServiceAPI
+	NewExchangeInfoService() ExchangeInfoServiceAPI
+	NewDepthService() DepthServiceAPI
+	NewTradesService() TradesServiceAPI
+	NewHistoricalTradesService() HistoricalTradesServiceAPI
+	NewKlinesService() KlinesServiceAPI
+	NewMarkService() MarkServiceAPI
+	NewTickerService() TickerServiceAPI
+	NewIndexService() IndexServiceAPI
+	NewExerciseHistoryService() ExerciseHistoryServiceAPI
+	NewOpenInterestService() OpenInterestServiceAPI
+	NewAccountService() AccountServiceAPI
+	NewCreateOrderService() CreateOrderServiceAPI
+	NewCreateBatchOrdersService() CreateBatchOrdersServiceAPI
+	NewGetOrderService() GetOrderServiceAPI
+	NewCancelOrderService() CancelOrderServiceAPI
+	NewCancelBatchOrdersService() CancelBatchOrdersServiceAPI
+	NewCancelAllOpenOrdersService() CancelAllOpenOrdersServiceAPI
+	NewCancelAllOpenOrdersByUnderlyingService() CancelAllOpenOrdersByUnderlyingServiceAPI
+	NewListOpenOrdersService() ListOpenOrdersServiceAPI
+	NewHistoryOrdersService() HistoryOrdersServiceAPI
+	NewPositionService() PositionServiceAPI
+	NewUserTradesService() UserTradesServiceAPI
+	NewExercistRecordService() ExerciseRecordServiceAPI
+	NewBillService() BillServiceAPI
+	NewIncomeDownloadIdService() IncomeDownloadIdServiceAPI
+	NewIncomeDownloadLinkService() IncomeDownloadLinkServiceAPI
+	NewStartUserStreamService() StartUserStreamServiceAPI
+	NewKeepaliveUserStreamService() KeepaliveUserStreamServiceAPI
+	NewCloseUserStreamService() CloseUserStreamServiceAPI
+	NewSetMMPService() SetMMPServiceAPI
+	NewGetMMPService() GetMMPServiceAPI
+	NewResetMMPService() ResetMMPServiceAPI
+	NewCountdownCancelAllService() CountdownCancelAllServiceAPI
+	NewGetCountdownCancelAllService() GetCountdownCancelAllServiceAPI
+	NewCountdownCancelAllHeartBeatService() CountdownCancelAllHeartBeatServiceAPI
+	NewMarginAccountService() MarginAccountServiceAPI
+	NewCreateBlockTradeOrderService() CreateBlockTradeOrderServiceAPI
+	NewExtendBlockTradeOrderService() ExtendBlockTradeOrderServiceAPI
+	NewCancelBlockTradeOrderService() CancelBlockTradeOrderServiceAPI
+	NewListBlockTradeOrdersService() ListBlockTradeOrdersServiceAPI
+	NewAcceptBlockTradeOrderService() AcceptBlockTradeOrderServiceAPI
+	NewGetBlockTradeOrderService() GetBlockTradeOrderServiceAPI
+	NewListBlockUserTradesService() ListBlockUserTradesServiceAPI
+}
+
+// NewClientAPI returns c as a ClientAPI
+func NewClientAPI(c *Client) ClientAPI {
+	return (*clientAPI)(c)
+}
+
+type clientAPI Client
+
+func (a *clientAPI) NewPingService() PingServiceAPI {
+	return (*pingServiceAPI)((*Client)(a).NewPingService())
+}
+
+func (a *clientAPI) NewServerTimeService() ServerTimeServiceAPI {
+	return (*serverTimeServiceAPI)((*Client)(a).NewServerTimeService())
+}
+
+func (a *clientAPI) NewExchangeInfoService() ExchangeInfoServiceAPI {
+	return (*exchangeInfoServiceAPI)((*Client)(a).NewExchangeInfoService())
+}
+
+func (a *clientAPI) NewDepthService() DepthServiceAPI {
+	return (*depthServiceAPI)((*Client)(a).NewDepthService())
+}
+
+func (a *clientAPI) NewTradesService() TradesServiceAPI {
+	return (*tradesServiceAPI)((*Client)(a).NewTradesService())
+}
+
+func (a *clientAPI) NewHistoricalTradesService() HistoricalTradesServiceAPI {
+	return (*historicalTradesServiceAPI)((*Client)(a).NewHistoricalTradesService())
+}
+
+func (a *clientAPI) NewKlinesService() KlinesServiceAPI {
+	return (*klinesServiceAPI)((*Client)(a).NewKlinesService())
+}
+
+func (a *clientAPI) NewMarkService() MarkServiceAPI {
+	return (*markServiceAPI)((*Client)(a).NewMarkService())
+}
+
+func (a *clientAPI) NewTickerService() TickerServiceAPI {
+	return (*tickerServiceAPI)((*Client)(a).NewTickerService())
+}
+
+func (a *clientAPI) NewIndexService() IndexServiceAPI {
+	return (*indexServiceAPI)((*Client)(a).NewIndexService())
+}
+
+func (a *clientAPI) NewExerciseHistoryService() ExerciseHistoryServiceAPI {
+	return (*exerciseHistoryServiceAPI)((*Client)(a).NewExerciseHistoryService())
+}
+
+func (a *clientAPI) NewOpenInterestService() OpenInterestServiceAPI {
+	return (*openInterestServiceAPI)((*Client)(a).NewOpenInterestService())
+}
+
+func (a *clientAPI) NewAccountService() AccountServiceAPI {
+	return (*accountServiceAPI)((*Client)(a).NewAccountService())
+}
+
+func (a *clientAPI) NewCreateOrderService() CreateOrderServiceAPI {
+	return (*createOrderServiceAPI)((*Client)(a).NewCreateOrderService())
+}
+
+func (a *clientAPI) NewCreateBatchOrdersService() CreateBatchOrdersServiceAPI {
+	return (*createBatchOrdersServiceAPI)((*Client)(a).NewCreateBatchOrdersService())
+}
+
+func (a *clientAPI) NewGetOrderService() GetOrderServiceAPI {
+	return (*getOrderServiceAPI)((*Client)(a).NewGetOrderService())
+}
+
+func (a *clientAPI) NewCancelOrderService() CancelOrderServiceAPI {
+	return (*cancelOrderServiceAPI)((*Client)(a).NewCancelOrderService())
+}
+
+func (a *clientAPI) NewCancelBatchOrdersService() CancelBatchOrdersServiceAPI {
+	return (*cancelBatchOrdersServiceAPI)((*Client)(a).NewCancelBatchOrdersService())
+}
+
+func (a *clientAPI) NewCancelAllOpenOrdersService() CancelAllOpenOrdersServiceAPI {
+	return (*cancelAllOpenOrdersServiceAPI)((*Client)(a).NewCancelAllOpenOrdersService())
+}
+
+func (a *clientAPI) NewCancelAllOpenOrdersByUnderlyingService() CancelAllOpenOrdersByUnderlyingServiceAPI {
+	return (*cancelAllOpenOrdersByUnderlyingServiceAPI)((*Client)(a).NewCancelAllOpenOrdersByUnderlyingService())
+}
+
+func (a *clientAPI) NewListOpenOrdersService() ListOpenOrdersServiceAPI {
+	return (*listOpenOrdersServiceAPI)((*Client)(a).NewListOpenOrdersService())
+}
+
+func (a *clientAPI) NewHistoryOrdersService() HistoryOrdersServiceAPI {
+	return (*historyOrdersServiceAPI)((*Client)(a).NewHistoryOrdersService())
+}
+
+func (a *clientAPI) NewPositionService() PositionServiceAPI {
+	return (*positionServiceAPI)((*Client)(a).NewPositionService())
+}
+
+func (a *clientAPI) NewUserTradesService() UserTradesServiceAPI {
+	return (*userTradesServiceAPI)((*Client)(a).NewUserTradesService())
+}
+
+func (a *clientAPI) NewExercistRecordService() ExerciseRecordServiceAPI {
+	return (*exerciseRecordServiceAPI)((*Client)(a).NewExercistRecordService())
+}
+
+func (a *clientAPI) NewBillService() BillServiceAPI {
+	return (*billServiceAPI)((*Client)(a).NewBillService())
+}
+
+func (a *clientAPI) NewIncomeDownloadIdService() IncomeDownloadIdServiceAPI {
+	return (*incomeDownloadIdServiceAPI)((*Client)(a).NewIncomeDownloadIdService())
+}
+
+func (a *clientAPI) NewIncomeDownloadLinkService() IncomeDownloadLinkServiceAPI {
+	return (*incomeDownloadLinkServiceAPI)((*Client)(a).NewIncomeDownloadLinkService())
+}
+
+func (a *clientAPI) NewStartUserStreamService() StartUserStreamServiceAPI {
+	return (*startUserStreamServiceAPI)((*Client)(a).NewStartUserStreamService())
+}
+
+func (a *clientAPI) NewKeepaliveUserStreamService() KeepaliveUserStreamServiceAPI {
+	return (*keepaliveUserStreamServiceAPI)((*Client)(a).NewKeepaliveUserStreamService())
+}
+
+func (a *clientAPI) NewCloseUserStreamService() CloseUserStreamServiceAPI {
+	return (*closeUserStreamServiceAPI)((*Client)(a).NewCloseUserStreamService())
+}
+
+func (a *clientAPI) NewSetMMPService() SetMMPServiceAPI {
+	return (*setMMPServiceAPI)((*Client)(a).NewSetMMPService())
+}
+
+func (a *clientAPI) NewGetMMPService() GetMMPServiceAPI {
+	return (*getMMPServiceAPI)((*Client)(a).NewGetMMPService())
+}
+
+func (a *clientAPI) NewResetMMPService() ResetMMPServiceAPI {
+	return (*resetMMPServiceAPI)((*Client)(a).NewResetMMPService())
+}
+
+func (a *clientAPI) NewCountdownCancelAllService() CountdownCancelAllServiceAPI {
+	return (*countdownCancelAllServiceAPI)((*Client)(a).NewCountdownCancelAllService())
+}
+
+func (a *clientAPI) NewGetCountdownCancelAllService() GetCountdownCancelAllServiceAPI {
+	return (*getCountdownCancelAllServiceAPI)((*Client)(a).NewGetCountdownCancelAllService())
+}
+
+func (a *clientAPI) NewCountdownCancelAllHeartBeatService() CountdownCancelAllHeartBeatServiceAPI {
+	return (*countdownCancelAllHeartBeatServiceAPI)((*Client)(a).NewCountdownCancelAllHeartBeatService())
+}
+
+func (a *clientAPI) NewMarginAccountService() MarginAccountServiceAPI {
+	return (*marginAccountServiceAPI)((*Client)(a).NewMarginAccountService())
+}
+
+func (a *clientAPI) NewCreateBlockTradeOrderService() CreateBlockTradeOrderServiceAPI {
+	return (*createBlockTradeOrderServiceAPI)((*Client)(a).NewCreateBlockTradeOrderService())
+}
+
+func (a *clientAPI) NewExtendBlockTradeOrderService() ExtendBlockTradeOrderServiceAPI {
+	return (*extendBlockTradeOrderServiceAPI)((*Client)(a).NewExtendBlockTradeOrderService())
+}
+
+func (a *clientAPI) NewCancelBlockTradeOrderService() CancelBlockTradeOrderServiceAPI {
+	return (*cancelBlockTradeOrderServiceAPI)((*Client)(a).NewCancelBlockTradeOrderService())
+}
+
+func (a *clientAPI) NewListBlockTradeOrdersService() ListBlockTradeOrdersServiceAPI {
+	return (*listBlockTradeOrdersServiceAPI)((*Client)(a).NewListBlockTradeOrdersService())
+}
+
+func (a *clientAPI) NewAcceptBlockTradeOrderService() AcceptBlockTradeOrderServiceAPI {
+	return (*acceptBlockTradeOrderServiceAPI)((*Client)(a).NewAcceptBlockTradeOrderService())
+}
+
+func (a *clientAPI) NewGetBlockTradeOrderService() GetBlockTradeOrderServiceAPI {
+	return (*getBlockTradeOrderServiceAPI)((*Client)(a).NewGetBlockTradeOrderService())
+}
+
+func (a *clientAPI) NewListBlockUserTradesService() ListBlockUserTradesServiceAPI {
+	return (*listBlockUserTradesServiceAPI)((*Client)(a).NewListBlockUserTradesService())
+}
+
+// PingServiceAPI is the interface of PingService
+type PingServiceAPI interface {
+	Do(ctx context.Context, opts ...RequestOption) error
+}
+
+type pingServiceAPI PingService
+
+func (a *pingServiceAPI) Do(ctx context.Context, opts ...RequestOption) error {
+	return (*PingService)(a).Do(ctx, opts...)
+}
+
+// ServerTimeServiceAPI is the interface of ServerTimeService
+type ServerTimeServiceAPI interface {
+	Do(ctx context.Context, opts ...RequestOption) (int64, error)
+}
+
+type serverTimeServiceAPI ServerTimeService
+
+func (a *serverTimeServiceAPI) Do(ctx context.Context, opts ...RequestOption) (int64, error) {
+	return (*ServerTimeService)(a).Do(ctx, opts...)
+}
+
+// ExchangeInfoServiceAPI is the interface of ExchangeInfoService
+type ExchangeInfoServiceAPI interface {
+	Do(ctx context.Context, opts ...RequestOption) (*ExchangeInfo, error)
+}
+
+type exchangeInfoServiceAPI ExchangeInfoService
+
+func (a *exchangeInfoServiceAPI) Do(ctx context.Context, opts ...RequestOption) (*ExchangeInfo, error) {
+	return (*ExchangeInfoService)(a).Do(ctx, opts...)
+}
+
+// DepthServiceAPI is the interface of DepthService
+type DepthServiceAPI interface {
+	Symbol(symbol string) DepthServiceAPI
+	Limit(limit int) DepthServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) (*DepthResponse, error)
+}
+
+type depthServiceAPI DepthService
+
+func (a *depthServiceAPI) Symbol(symbol string) DepthServiceAPI {
+	return (*depthServiceAPI)((*DepthService)(a).Symbol(symbol))
+}
+
+func (a *depthServiceAPI) Limit(limit int) DepthServiceAPI {
+	return (*depthServiceAPI)((*DepthService)(a).Limit(limit))
+}
+
+func (a *depthServiceAPI) Do(ctx context.Context, opts ...RequestOption) (*DepthResponse, error) {
+	return (*DepthService)(a).Do(ctx, opts...)
+}
+
+// TradesServiceAPI is the interface of TradesService
+type TradesServiceAPI interface {
+	Symbol(symbol string) TradesServiceAPI
+	Limit(limit int) TradesServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) ([]*Trade, error)
+}
+
+type tradesServiceAPI TradesService
+
+func (a *tradesServiceAPI) Symbol(symbol string) TradesServiceAPI {
+	return (*tradesServiceAPI)((*TradesService)(a).Symbol(symbol))
+}
+
+func (a *tradesServiceAPI) Limit(limit int) TradesServiceAPI {
+	return (*tradesServiceAPI)((*TradesService)(a).Limit(limit))
+}
+
+func (a *tradesServiceAPI) Do(ctx context.Context, opts ...RequestOption) ([]*Trade, error) {
+	return (*TradesService)(a).Do(ctx, opts...)
+}
+
+// HistoricalTradesServiceAPI is the interface of HistoricalTradesService
+type HistoricalTradesServiceAPI interface {
+	Symbol(symbol string) HistoricalTradesServiceAPI
+	Limit(limit int) HistoricalTradesServiceAPI
+	FromID(fromID int64) HistoricalTradesServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) ([]*HistoricalTrade, error)
+}
+
+type historicalTradesServiceAPI HistoricalTradesService
+
+func (a *historicalTradesServiceAPI) Symbol(symbol string) HistoricalTradesServiceAPI {
+	return (*historicalTradesServiceAPI)((*HistoricalTradesService)(a).Symbol(symbol))
+}
+
+func (a *historicalTradesServiceAPI) Limit(limit int) HistoricalTradesServiceAPI {
+	return (*historicalTradesServiceAPI)((*HistoricalTradesService)(a).Limit(limit))
+}
+
+func (a *historicalTradesServiceAPI) FromID(fromID int64) HistoricalTradesServiceAPI {
+	return (*historicalTradesServiceAPI)((*HistoricalTradesService)(a).FromID(fromID))
+}
+
+func (a *historicalTradesServiceAPI) Do(ctx context.Context, opts ...RequestOption) ([]*HistoricalTrade, error) {
+	return (*HistoricalTradesService)(a).Do(ctx, opts...)
+}
+
+// KlinesServiceAPI is the interface of KlinesService
+type KlinesServiceAPI interface {
+	Symbol(symbol string) KlinesServiceAPI
+	Interval(interval string) KlinesServiceAPI
+	Limit(limit int) KlinesServiceAPI
+	StartTime(startTime int64) KlinesServiceAPI
+	EndTime(endTime int64) KlinesServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) ([]*Kline, error)
+}
+
+type klinesServiceAPI KlinesService
+
+func (a *klinesServiceAPI) Symbol(symbol string) KlinesServiceAPI {
+	return (*klinesServiceAPI)((*KlinesService)(a).Symbol(symbol))
+}
+
+func (a *klinesServiceAPI) Interval(interval string) KlinesServiceAPI {
+	return (*klinesServiceAPI)((*KlinesService)(a).Interval(interval))
+}
+
+func (a *klinesServiceAPI) Limit(limit int) KlinesServiceAPI {
+	return (*klinesServiceAPI)((*KlinesService)(a).Limit(limit))
+}
+
+func (a *klinesServiceAPI) StartTime(startTime int64) KlinesServiceAPI {
+	return (*klinesServiceAPI)((*KlinesService)(a).StartTime(startTime))
+}
+
+func (a *klinesServiceAPI) EndTime(endTime int64) KlinesServiceAPI {
+	return (*klinesServiceAPI)((*KlinesService)(a).EndTime(endTime))
+}
+
+func (a *klinesServiceAPI) Do(ctx context.Context, opts ...RequestOption) ([]*Kline, error) {
+	return (*KlinesService)(a).Do(ctx, opts...)
+}
+
+// MarkServiceAPI is the interface of MarkService
+type MarkServiceAPI interface {
+	Symbol(symbol string) MarkServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) ([]*Mark, error)
+}
+
+type markServiceAPI MarkService
+
+func (a *markServiceAPI) Symbol(symbol string) MarkServiceAPI {
+	return (*markServiceAPI)((*MarkService)(a).Symbol(symbol))
+}
+
+func (a *markServiceAPI) Do(ctx context.Context, opts ...RequestOption) ([]*Mark, error) {
+	return (*MarkService)(a).Do(ctx, opts...)
+}
+
+// TickerServiceAPI is the interface of TickerService
+type TickerServiceAPI interface {
+	Symbol(symbol string) TickerServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) ([]*Ticker, error)
+}
+
+type tickerServiceAPI TickerService
+
+func (a *tickerServiceAPI) Symbol(symbol string) TickerServiceAPI {
+	return (*tickerServiceAPI)((*TickerService)(a).Symbol(symbol))
+}
+
+func (a *tickerServiceAPI) Do(ctx context.Context, opts ...RequestOption) ([]*Ticker, error) {
+	return (*TickerService)(a).Do(ctx, opts...)
+}
+
+// IndexServiceAPI is the interface of IndexService
+type IndexServiceAPI interface {
+	Underlying(underlying string) IndexServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) (*Index, error)
+}
+
+type indexServiceAPI IndexService
+
+func (a *indexServiceAPI) Underlying(underlying string) IndexServiceAPI {
+	return (*indexServiceAPI)((*IndexService)(a).Underlying(underlying))
+}
+
+func (a *indexServiceAPI) Do(ctx context.Context, opts ...RequestOption) (*Index, error) {
+	return (*IndexService)(a).Do(ctx, opts...)
+}
+
+// ExerciseHistoryServiceAPI is the interface of ExerciseHistoryService
+type ExerciseHistoryServiceAPI interface {
+	Underlying(underlying string) ExerciseHistoryServiceAPI
+	StartTime(startTime uint64) ExerciseHistoryServiceAPI
+	EndTime(endTime uint64) ExerciseHistoryServiceAPI
+	Limit(limit uint64) ExerciseHistoryServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) ([]*ExerciseHistory, error)
+}
+
+type exerciseHistoryServiceAPI ExerciseHistoryService
+
+func (a *exerciseHistoryServiceAPI) Underlying(underlying string) ExerciseHistoryServiceAPI {
+	return (*exerciseHistoryServiceAPI)((*ExerciseHistoryService)(a).Underlying(underlying))
+}
+
+func (a *exerciseHistoryServiceAPI) StartTime(startTime uint64) ExerciseHistoryServiceAPI {
+	return (*exerciseHistoryServiceAPI)((*ExerciseHistoryService)(a).StartTime(startTime))
+}
+
+func (a *exerciseHistoryServiceAPI) EndTime(endTime uint64) ExerciseHistoryServiceAPI {
+	return (*exerciseHistoryServiceAPI)((*ExerciseHistoryService)(a).EndTime(endTime))
+}
+
+func (a *exerciseHistoryServiceAPI) Limit(limit uint64) ExerciseHistoryServiceAPI {
+	return (*exerciseHistoryServiceAPI)((*ExerciseHistoryService)(a).Limit(limit))
+}
+
+func (a *exerciseHistoryServiceAPI) Do(ctx context.Context, opts ...RequestOption) ([]*ExerciseHistory, error) {
+	return (*ExerciseHistoryService)(a).Do(ctx, opts...)
+}
+
+// OpenInterestServiceAPI is the interface of OpenInterestService
+type OpenInterestServiceAPI interface {
+	UnderlyingAsset(underlyingAsset string) OpenInterestServiceAPI
+	Expiration(expiration string) OpenInterestServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) ([]*OpenInterest, error)
+}
+
+type openInterestServiceAPI OpenInterestService
+
+func (a *openInterestServiceAPI) UnderlyingAsset(underlyingAsset string) OpenInterestServiceAPI {
+	return (*openInterestServiceAPI)((*OpenInterestService)(a).UnderlyingAsset(underlyingAsset))
+}
+
+func (a *openInterestServiceAPI) Expiration(expiration string) OpenInterestServiceAPI {
+	return (*openInterestServiceAPI)((*OpenInterestService)(a).Expiration(expiration))
+}
+
+func (a *openInterestServiceAPI) Do(ctx context.Context, opts ...RequestOption) ([]*OpenInterest, error) {
+	return (*OpenInterestService)(a).Do(ctx, opts...)
+}
+
+// AccountServiceAPI is the interface of AccountService
+type AccountServiceAPI interface {
+	Do(ctx context.Context, opts ...RequestOption) (*Account, error)
+}
+
+type accountServiceAPI AccountService
+
+func (a *accountServiceAPI) Do(ctx context.Context, opts ...RequestOption) (*Account, error) {
+	return (*AccountService)(a).Do(ctx, opts...)
+}
+
+// CreateOrderServiceAPI is the interface of CreateOrderService
+type CreateOrderServiceAPI interface {
+	Symbol(symbol string) CreateOrderServiceAPI
+	Side(side SideType) CreateOrderServiceAPI
+	Type(orderType OrderType) CreateOrderServiceAPI
+	TimeInForce(timeInForce TimeInForceType) CreateOrderServiceAPI
+	Quantity(quantity string) CreateOrderServiceAPI
+	ReduceOnly(reduceOnly bool) CreateOrderServiceAPI
+	PostOnly(postOnly bool) CreateOrderServiceAPI
+	Price(price string) CreateOrderServiceAPI
+	ClientOrderId(ClientOrderId string) CreateOrderServiceAPI
+	NewOrderResponseType(newOrderResponseType NewOrderRespType) CreateOrderServiceAPI
+	IsMmp(isMmp bool) CreateOrderServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) (*Order, error)
+}
+
+type createOrderServiceAPI CreateOrderService
+
+func (a *createOrderServiceAPI) Symbol(symbol string) CreateOrderServiceAPI {
+	return (*createOrderServiceAPI)((*CreateOrderService)(a).Symbol(symbol))
+}
+
+func (a *createOrderServiceAPI) Side(side SideType) CreateOrderServiceAPI {
+	return (*createOrderServiceAPI)((*CreateOrderService)(a).Side(side))
+}
+
+func (a *createOrderServiceAPI) Type(orderType OrderType) CreateOrderServiceAPI {
+	return (*createOrderServiceAPI)((*CreateOrderService)(a).Type(orderType))
+}
+
+func (a *createOrderServiceAPI) TimeInForce(timeInForce TimeInForceType) CreateOrderServiceAPI {
+	return (*createOrderServiceAPI)((*CreateOrderService)(a).TimeInForce(timeInForce))
+}
+
+func (a *createOrderServiceAPI) Quantity(quantity string) CreateOrderServiceAPI {
+	return (*createOrderServiceAPI)((*CreateOrderService)(a).Quantity(quantity))
+}
+
+func (a *createOrderServiceAPI) ReduceOnly(reduceOnly bool) CreateOrderServiceAPI {
+	return (*createOrderServiceAPI)((*CreateOrderService)(a).ReduceOnly(reduceOnly))
+}
+
+func (a *createOrderServiceAPI) PostOnly(postOnly bool) CreateOrderServiceAPI {
+	return (*createOrderServiceAPI)((*CreateOrderService)(a).PostOnly(postOnly))
+}
+
+func (a *createOrderServiceAPI) Price(price string) CreateOrderServiceAPI {
+	return (*createOrderServiceAPI)((*CreateOrderService)(a).Price(price))
+}
+
+func (a *createOrderServiceAPI) ClientOrderId(ClientOrderId string) CreateOrderServiceAPI {
+	return (*createOrderServiceAPI)((*CreateOrderService)(a).ClientOrderId(ClientOrderId))
+}
+
+func (a *createOrderServiceAPI) NewOrderResponseType(newOrderResponseType NewOrderRespType) CreateOrderServiceAPI {
+	return (*createOrderServiceAPI)((*CreateOrderService)(a).NewOrderResponseType(newOrderResponseType))
+}
+
+func (a *createOrderServiceAPI) IsMmp(isMmp bool) CreateOrderServiceAPI {
+	return (*createOrderServiceAPI)((*CreateOrderService)(a).IsMmp(isMmp))
+}
+
+func (a *createOrderServiceAPI) Do(ctx context.Context, opts ...RequestOption) (*Order, error) {
+	return (*CreateOrderService)(a).Do(ctx, opts...)
+}
+
+// CreateBatchOrdersServiceAPI is the interface of CreateBatchOrdersService
+type CreateBatchOrdersServiceAPI interface {
+	OrderList(orders []*CreateOrderService) CreateBatchOrdersServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) ([]interface{}, error)
+}
+
+type createBatchOrdersServiceAPI CreateBatchOrdersService
+
+func (a *createBatchOrdersServiceAPI) OrderList(orders []*CreateOrderService) CreateBatchOrdersServiceAPI {
+	return (*createBatchOrdersServiceAPI)((*CreateBatchOrdersService)(a).OrderList(orders))
+}
+
+func (a *createBatchOrdersServiceAPI) Do(ctx context.Context, opts ...RequestOption) ([]interface{}, error) {
+	return (*CreateBatchOrdersService)(a).Do(ctx, opts...)
+}
+
+// GetOrderServiceAPI is the interface of GetOrderService
+type GetOrderServiceAPI interface {
+	Symbol(symbol string) GetOrderServiceAPI
+	OrderId(orderId int64) GetOrderServiceAPI
+	ClientOrderId(clientOrderId string) GetOrderServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) (*Order, error)
+}
+
+type getOrderServiceAPI GetOrderService
+
+func (a *getOrderServiceAPI) Symbol(symbol string) GetOrderServiceAPI {
+	return (*getOrderServiceAPI)((*GetOrderService)(a).Symbol(symbol))
+}
+
+func (a *getOrderServiceAPI) OrderId(orderId int64) GetOrderServiceAPI {
+	return (*getOrderServiceAPI)((*GetOrderService)(a).OrderId(orderId))
+}
+
+func (a *getOrderServiceAPI) ClientOrderId(clientOrderId string) GetOrderServiceAPI {
+	return (*getOrderServiceAPI)((*GetOrderService)(a).ClientOrderId(clientOrderId))
+}
+
+func (a *getOrderServiceAPI) Do(ctx context.Context, opts ...RequestOption) (*Order, error) {
+	return (*GetOrderService)(a).Do(ctx, opts...)
+}
+
+// CancelOrderServiceAPI is the interface of CancelOrderService
+type CancelOrderServiceAPI interface {
+	Symbol(symbol string) CancelOrderServiceAPI
+	OrderId(orderId int64) CancelOrderServiceAPI
+	ClientOrderId(clientOrderId string) CancelOrderServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) (*Order, error)
+}
+
+type cancelOrderServiceAPI CancelOrderService
+
+func (a *cancelOrderServiceAPI) Symbol(symbol string) CancelOrderServiceAPI {
+	return (*cancelOrderServiceAPI)((*CancelOrderService)(a).Symbol(symbol))
+}
+
+func (a *cancelOrderServiceAPI) OrderId(orderId int64) CancelOrderServiceAPI {
+	return (*cancelOrderServiceAPI)((*CancelOrderService)(a).OrderId(orderId))
+}
+
+func (a *cancelOrderServiceAPI) ClientOrderId(clientOrderId string) CancelOrderServiceAPI {
+	return (*cancelOrderServiceAPI)((*CancelOrderService)(a).ClientOrderId(clientOrderId))
+}
+
+func (a *cancelOrderServiceAPI) Do(ctx context.Context, opts ...RequestOption) (*Order, error) {
+	return (*CancelOrderService)(a).Do(ctx, opts...)
+}
+
+// CancelBatchOrdersServiceAPI is the interface of CancelBatchOrdersService
+type CancelBatchOrdersServiceAPI interface {
+	Symbol(symbol string) CancelBatchOrdersServiceAPI
+	OrderIds(orderIds []int64) CancelBatchOrdersServiceAPI
+	ClientOrderIds(clientOrderIds []string) CancelBatchOrdersServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) ([]interface{}, error)
+}
+
+type cancelBatchOrdersServiceAPI CancelBatchOrdersService
+
+func (a *cancelBatchOrdersServiceAPI) Symbol(symbol string) CancelBatchOrdersServiceAPI {
+	return (*cancelBatchOrdersServiceAPI)((*CancelBatchOrdersService)(a).Symbol(symbol))
+}
+
+func (a *cancelBatchOrdersServiceAPI) OrderIds(orderIds []int64) CancelBatchOrdersServiceAPI {
+	return (*cancelBatchOrdersServiceAPI)((*CancelBatchOrdersService)(a).OrderIds(orderIds))
+}
+
+func (a *cancelBatchOrdersServiceAPI) ClientOrderIds(clientOrderIds []string) CancelBatchOrdersServiceAPI {
+	return (*cancelBatchOrdersServiceAPI)((*CancelBatchOrdersService)(a).ClientOrderIds(clientOrderIds))
+}
+
+func (a *cancelBatchOrdersServiceAPI) Do(ctx context.Context, opts ...RequestOption) ([]interface{}, error) {
+	return (*CancelBatchOrdersService)(a).Do(ctx, opts...)
+}
+
+// CancelAllOpenOrdersServiceAPI is the interface of CancelAllOpenOrdersService
+type CancelAllOpenOrdersServiceAPI interface {
+	Symbol(symbol string) CancelAllOpenOrdersServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) (*CancelAllOpenOrdersRsp, error)
+}
+
+type cancelAllOpenOrdersServiceAPI CancelAllOpenOrdersService
+
+func (a *cancelAllOpenOrdersServiceAPI) Symbol(symbol string) CancelAllOpenOrdersServiceAPI {
+	return (*cancelAllOpenOrdersServiceAPI)((*CancelAllOpenOrdersService)(a).Symbol(symbol))
+}
+
+func (a *cancelAllOpenOrdersServiceAPI) Do(ctx context.Context, opts ...RequestOption) (*CancelAllOpenOrdersRsp, error) {
+	return (*CancelAllOpenOrdersService)(a).Do(ctx, opts...)
+}
+
+// CancelAllOpenOrdersByUnderlyingServiceAPI is the interface of CancelAllOpenOrdersByUnderlyingService
+type CancelAllOpenOrdersByUnderlyingServiceAPI interface {
+	Underlying(underlying string) CancelAllOpenOrdersByUnderlyingServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) (*CancelAllOpenOrdersByUnderlyingRsp, error)
+}
+
+type cancelAllOpenOrdersByUnderlyingServiceAPI CancelAllOpenOrdersByUnderlyingService
+
+func (a *cancelAllOpenOrdersByUnderlyingServiceAPI) Underlying(underlying string) CancelAllOpenOrdersByUnderlyingServiceAPI {
+	return (*cancelAllOpenOrdersByUnderlyingServiceAPI)((*CancelAllOpenOrdersByUnderlyingService)(a).Underlying(underlying))
+}
+
+func (a *cancelAllOpenOrdersByUnderlyingServiceAPI) Do(ctx context.Context, opts ...RequestOption) (*CancelAllOpenOrdersByUnderlyingRsp, error) {
+	return (*CancelAllOpenOrdersByUnderlyingService)(a).Do(ctx, opts...)
+}
+
+// ListOpenOrdersServiceAPI is the interface of ListOpenOrdersService
+type ListOpenOrdersServiceAPI interface {
+	Symbol(symbol string) ListOpenOrdersServiceAPI
+	OrderId(orderId int64) ListOpenOrdersServiceAPI
+	StartTime(startTime int64) ListOpenOrdersServiceAPI
+	EndTime(endTime int64) ListOpenOrdersServiceAPI
+	Limit(limit int) ListOpenOrdersServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) ([]*Order, error)
+}
+
+type listOpenOrdersServiceAPI ListOpenOrdersService
+
+func (a *listOpenOrdersServiceAPI) Symbol(symbol string) ListOpenOrdersServiceAPI {
+	return (*listOpenOrdersServiceAPI)((*ListOpenOrdersService)(a).Symbol(symbol))
+}
+
+func (a *listOpenOrdersServiceAPI) OrderId(orderId int64) ListOpenOrdersServiceAPI {
+	return (*listOpenOrdersServiceAPI)((*ListOpenOrdersService)(a).OrderId(orderId))
+}
+
+func (a *listOpenOrdersServiceAPI) StartTime(startTime int64) ListOpenOrdersServiceAPI {
+	return (*listOpenOrdersServiceAPI)((*ListOpenOrdersService)(a).StartTime(startTime))
+}
+
+func (a *listOpenOrdersServiceAPI) EndTime(endTime int64) ListOpenOrdersServiceAPI {
+	return (*listOpenOrdersServiceAPI)((*ListOpenOrdersService)(a).EndTime(endTime))
+}
+
+func (a *listOpenOrdersServiceAPI) Limit(limit int) ListOpenOrdersServiceAPI {
+	return (*listOpenOrdersServiceAPI)((*ListOpenOrdersService)(a).Limit(limit))
+}
+
+func (a *listOpenOrdersServiceAPI) Do(ctx context.Context, opts ...RequestOption) ([]*Order, error) {
+	return (*ListOpenOrdersService)(a).Do(ctx, opts...)
+}
+
+// HistoryOrdersServiceAPI is the interface of HistoryOrdersService
+type HistoryOrdersServiceAPI interface {
+	Symbol(symbol string) HistoryOrdersServiceAPI
+	OrderId(orderId uint64) HistoryOrdersServiceAPI
+	StartTime(st uint64) HistoryOrdersServiceAPI
+	EndTime(et uint64) HistoryOrdersServiceAPI
+	Limit(limit int) HistoryOrdersServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) ([]*Order, error)
+}
+
+type historyOrdersServiceAPI HistoryOrdersService
+
+func (a *historyOrdersServiceAPI) Symbol(symbol string) HistoryOrdersServiceAPI {
+	return (*historyOrdersServiceAPI)((*HistoryOrdersService)(a).Symbol(symbol))
+}
+
+func (a *historyOrdersServiceAPI) OrderId(orderId uint64) HistoryOrdersServiceAPI {
+	return (*historyOrdersServiceAPI)((*HistoryOrdersService)(a).OrderId(orderId))
+}
+
+func (a *historyOrdersServiceAPI) StartTime(st uint64) HistoryOrdersServiceAPI {
+	return (*historyOrdersServiceAPI)((*HistoryOrdersService)(a).StartTime(st))
+}
+
+func (a *historyOrdersServiceAPI) EndTime(et uint64) HistoryOrdersServiceAPI {
+	return (*historyOrdersServiceAPI)((*HistoryOrdersService)(a).EndTime(et))
+}
+
+func (a *historyOrdersServiceAPI) Limit(limit int) HistoryOrdersServiceAPI {
+	return (*historyOrdersServiceAPI)((*HistoryOrdersService)(a).Limit(limit))
+}
+
+func (a *historyOrdersServiceAPI) Do(ctx context.Context, opts ...RequestOption) ([]*Order, error) {
+	return (*HistoryOrdersService)(a).Do(ctx, opts...)
+}
+
+// PositionServiceAPI is the interface of PositionService
+type PositionServiceAPI interface {
+	Symbol(symbol string) PositionServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) ([]*Position, error)
+}
+
+type positionServiceAPI PositionService
+
+func (a *positionServiceAPI) Symbol(symbol string) PositionServiceAPI {
+	return (*positionServiceAPI)((*PositionService)(a).Symbol(symbol))
+}
+
+func (a *positionServiceAPI) Do(ctx context.Context, opts ...RequestOption) ([]*Position, error) {
+	return (*PositionService)(a).Do(ctx, opts...)
+}
+
+// UserTradesServiceAPI is the interface of UserTradesService
+type UserTradesServiceAPI interface {
+	Symbol(symbol string) UserTradesServiceAPI
+	FromId(fromId uint64) UserTradesServiceAPI
+	StartTime(st uint64) UserTradesServiceAPI
+	EndTime(et uint64) UserTradesServiceAPI
+	Limit(limit int) UserTradesServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) ([]*UserTrade, error)
+}
+
+type userTradesServiceAPI UserTradesService
+
+func (a *userTradesServiceAPI) Symbol(symbol string) UserTradesServiceAPI {
+	return (*userTradesServiceAPI)((*UserTradesService)(a).Symbol(symbol))
+}
+
+func (a *userTradesServiceAPI) FromId(fromId uint64) UserTradesServiceAPI {
+	return (*userTradesServiceAPI)((*UserTradesService)(a).FromId(fromId))
+}
+
+func (a *userTradesServiceAPI) StartTime(st uint64) UserTradesServiceAPI {
+	return (*userTradesServiceAPI)((*UserTradesService)(a).StartTime(st))
+}
+
+func (a *userTradesServiceAPI) EndTime(et uint64) UserTradesServiceAPI {
+	return (*userTradesServiceAPI)((*UserTradesService)(a).EndTime(et))
+}
+
+func (a *userTradesServiceAPI) Limit(limit int) UserTradesServiceAPI {
+	return (*userTradesServiceAPI)((*UserTradesService)(a).Limit(limit))
+}
+
+func (a *userTradesServiceAPI) Do(ctx context.Context, opts ...RequestOption) ([]*UserTrade, error) {
+	return (*UserTradesService)(a).Do(ctx, opts...)
+}
+
+// ExerciseRecordServiceAPI is the interface of ExerciseRecordService
+type ExerciseRecordServiceAPI interface {
+	Symbol(symbol string) ExerciseRecordServiceAPI
+	StartTime(st uint64) ExerciseRecordServiceAPI
+	EndTime(et uint64) ExerciseRecordServiceAPI
+	Limit(limit int) ExerciseRecordServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) ([]*ExerciseRecord, error)
+}
+
+type exerciseRecordServiceAPI ExerciseRecordService
+
+func (a *exerciseRecordServiceAPI) Symbol(symbol string) ExerciseRecordServiceAPI {
+	return (*exerciseRecordServiceAPI)((*ExerciseRecordService)(a).Symbol(symbol))
+}
+
+func (a *exerciseRecordServiceAPI) StartTime(st uint64) ExerciseRecordServiceAPI {
+	return (*exerciseRecordServiceAPI)((*ExerciseRecordService)(a).StartTime(st))
+}
+
+func (a *exerciseRecordServiceAPI) EndTime(et uint64) ExerciseRecordServiceAPI {
+	return (*exerciseRecordServiceAPI)((*ExerciseRecordService)(a).EndTime(et))
+}
+
+func (a *exerciseRecordServiceAPI) Limit(limit int) ExerciseRecordServiceAPI {
+	return (*exerciseRecordServiceAPI)((*ExerciseRecordService)(a).Limit(limit))
+}
+
+func (a *exerciseRecordServiceAPI) Do(ctx context.Context, opts ...RequestOption) ([]*ExerciseRecord, error) {
+	return (*ExerciseRecordService)(a).Do(ctx, opts...)
+}
+
+// BillServiceAPI is the interface of BillService
+type BillServiceAPI interface {
+	Currency(c string) BillServiceAPI
+	RecordId(ri uint64) BillServiceAPI
+	StartTime(st uint64) BillServiceAPI
+	EndTime(et uint64) BillServiceAPI
+	Limit(limit int) BillServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) ([]*Bill, error)
+}
+
+type billServiceAPI BillService
+
+func (a *billServiceAPI) Currency(c string) BillServiceAPI {
+	return (*billServiceAPI)((*BillService)(a).Currency(c))
+}
+
+func (a *billServiceAPI) RecordId(ri uint64) BillServiceAPI {
+	return (*billServiceAPI)((*BillService)(a).RecordId(ri))
+}
+
+func (a *billServiceAPI) StartTime(st uint64) BillServiceAPI {
+	return (*billServiceAPI)((*BillService)(a).StartTime(st))
+}
+
+func (a *billServiceAPI) EndTime(et uint64) BillServiceAPI {
+	return (*billServiceAPI)((*BillService)(a).EndTime(et))
+}
+
+func (a *billServiceAPI) Limit(limit int) BillServiceAPI {
+	return (*billServiceAPI)((*BillService)(a).Limit(limit))
+}
+
+func (a *billServiceAPI) Do(ctx context.Context, opts ...RequestOption) ([]*Bill, error) {
+	return (*BillService)(a).Do(ctx, opts...)
+}
+
+// IncomeDownloadIdServiceAPI is the interface of IncomeDownloadIdService
+type IncomeDownloadIdServiceAPI interface {
+	StartTime(st uint64) IncomeDownloadIdServiceAPI
+	EndTime(et uint64) IncomeDownloadIdServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) (*IncomeDownloadId, error)
+}
+
+type incomeDownloadIdServiceAPI IncomeDownloadIdService
+
+func (a *incomeDownloadIdServiceAPI) StartTime(st uint64) IncomeDownloadIdServiceAPI {
+	return (*incomeDownloadIdServiceAPI)((*IncomeDownloadIdService)(a).StartTime(st))
+}
+
+func (a *incomeDownloadIdServiceAPI) EndTime(et uint64) IncomeDownloadIdServiceAPI {
+	return (*incomeDownloadIdServiceAPI)((*IncomeDownloadIdService)(a).EndTime(et))
+}
+
+func (a *incomeDownloadIdServiceAPI) Do(ctx context.Context, opts ...RequestOption) (*IncomeDownloadId, error) {
+	return (*IncomeDownloadIdService)(a).Do(ctx, opts...)
+}
+
+// IncomeDownloadLinkServiceAPI is the interface of IncomeDownloadLinkService
+type IncomeDownloadLinkServiceAPI interface {
+	DownloadId(di string) IncomeDownloadLinkServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) (*IncomeDownloadLink, error)
+}
+
+type incomeDownloadLinkServiceAPI IncomeDownloadLinkService
+
+func (a *incomeDownloadLinkServiceAPI) DownloadId(di string) IncomeDownloadLinkServiceAPI {
+	return (*incomeDownloadLinkServiceAPI)((*IncomeDownloadLinkService)(a).DownloadId(di))
+}
+
+func (a *incomeDownloadLinkServiceAPI) Do(ctx context.Context, opts ...RequestOption) (*IncomeDownloadLink, error) {
+	return (*IncomeDownloadLinkService)(a).Do(ctx, opts...)
+}
+
+// StartUserStreamServiceAPI is the interface of StartUserStreamService
+type StartUserStreamServiceAPI interface {
+	Do(ctx context.Context, opts ...RequestOption) (string, error)
+}
+
+type startUserStreamServiceAPI StartUserStreamService
+
+func (a *startUserStreamServiceAPI) Do(ctx context.Context, opts ...RequestOption) (string, error) {
+	return (*StartUserStreamService)(a).Do(ctx, opts...)
+}
+
+// KeepaliveUserStreamServiceAPI is the interface of KeepaliveUserStreamService
+type KeepaliveUserStreamServiceAPI interface {
+	ListenKey(listenKey string) KeepaliveUserStreamServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) error
+}
+
+type keepaliveUserStreamServiceAPI KeepaliveUserStreamService
+
+func (a *keepaliveUserStreamServiceAPI) ListenKey(listenKey string) KeepaliveUserStreamServiceAPI {
+	return (*keepaliveUserStreamServiceAPI)((*KeepaliveUserStreamService)(a).ListenKey(listenKey))
+}
+
+func (a *keepaliveUserStreamServiceAPI) Do(ctx context.Context, opts ...RequestOption) error {
+	return (*KeepaliveUserStreamService)(a).Do(ctx, opts...)
+}
+
+// CloseUserStreamServiceAPI is the interface of CloseUserStreamService
+type CloseUserStreamServiceAPI interface {
+	ListenKey(listenKey string) CloseUserStreamServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) error
+}
+
+type closeUserStreamServiceAPI CloseUserStreamService
+
+func (a *closeUserStreamServiceAPI) ListenKey(listenKey string) CloseUserStreamServiceAPI {
+	return (*closeUserStreamServiceAPI)((*CloseUserStreamService)(a).ListenKey(listenKey))
+}
+
+func (a *closeUserStreamServiceAPI) Do(ctx context.Context, opts ...RequestOption) error {
+	return (*CloseUserStreamService)(a).Do(ctx, opts...)
+}
+
+// SetMMPServiceAPI is the interface of SetMMPService
+type SetMMPServiceAPI interface {
+	Underlying(underlying string) SetMMPServiceAPI
+	WindowTimeInMilliseconds(windowTime int64) SetMMPServiceAPI
+	FrozenTimeInMilliseconds(frozenTime int64) SetMMPServiceAPI
+	QtyLimit(qtyLimit string) SetMMPServiceAPI
+	DeltaLimit(deltaLimit string) SetMMPServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) (*MMPConfig, error)
+}
+
+type setMMPServiceAPI SetMMPService
+
+func (a *setMMPServiceAPI) Underlying(underlying string) SetMMPServiceAPI {
+	return (*setMMPServiceAPI)((*SetMMPService)(a).Underlying(underlying))
+}
+
+func (a *setMMPServiceAPI) WindowTimeInMilliseconds(windowTime int64) SetMMPServiceAPI {
+	return (*setMMPServiceAPI)((*SetMMPService)(a).WindowTimeInMilliseconds(windowTime))
+}
+
+func (a *setMMPServiceAPI) FrozenTimeInMilliseconds(frozenTime int64) SetMMPServiceAPI {
+	return (*setMMPServiceAPI)((*SetMMPService)(a).FrozenTimeInMilliseconds(frozenTime))
+}
+
+func (a *setMMPServiceAPI) QtyLimit(qtyLimit string) SetMMPServiceAPI {
+	return (*setMMPServiceAPI)((*SetMMPService)(a).QtyLimit(qtyLimit))
+}
+
+func (a *setMMPServiceAPI) DeltaLimit(deltaLimit string) SetMMPServiceAPI {
+	return (*setMMPServiceAPI)((*SetMMPService)(a).DeltaLimit(deltaLimit))
+}
+
+func (a *setMMPServiceAPI) Do(ctx context.Context, opts ...RequestOption) (*MMPConfig, error) {
+	return (*SetMMPService)(a).Do(ctx, opts...)
+}
+
+// GetMMPServiceAPI is the interface of GetMMPService
+type GetMMPServiceAPI interface {
+	Underlying(underlying string) GetMMPServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) (*MMPConfig, error)
+}
+
+type getMMPServiceAPI GetMMPService
+
+func (a *getMMPServiceAPI) Underlying(underlying string) GetMMPServiceAPI {
+	return (*getMMPServiceAPI)((*GetMMPService)(a).Underlying(underlying))
+}
+
+func (a *getMMPServiceAPI) Do(ctx context.Context, opts ...RequestOption) (*MMPConfig, error) {
+	return (*GetMMPService)(a).Do(ctx, opts...)
+}
+
+// ResetMMPServiceAPI is the interface of ResetMMPService
+type ResetMMPServiceAPI interface {
+	Underlying(underlying string) ResetMMPServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) (*MMPConfig, error)
+}
+
+type resetMMPServiceAPI ResetMMPService
+
+func (a *resetMMPServiceAPI) Underlying(underlying string) ResetMMPServiceAPI {
+	return (*resetMMPServiceAPI)((*ResetMMPService)(a).Underlying(underlying))
+}
+
+func (a *resetMMPServiceAPI) Do(ctx context.Context, opts ...RequestOption) (*MMPConfig, error) {
+	return (*ResetMMPService)(a).Do(ctx, opts...)
+}
+
+// CountdownCancelAllServiceAPI is the interface of CountdownCancelAllService
+type CountdownCancelAllServiceAPI interface {
+	Underlying(underlying string) CountdownCancelAllServiceAPI
+	CountdownTime(countdownTime int64) CountdownCancelAllServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) (*CountdownCancelAll, error)
+}
+
+type countdownCancelAllServiceAPI CountdownCancelAllService
+
+func (a *countdownCancelAllServiceAPI) Underlying(underlying string) CountdownCancelAllServiceAPI {
+	return (*countdownCancelAllServiceAPI)((*CountdownCancelAllService)(a).Underlying(underlying))
+}
+
+func (a *countdownCancelAllServiceAPI) CountdownTime(countdownTime int64) CountdownCancelAllServiceAPI {
+	return (*countdownCancelAllServiceAPI)((*CountdownCancelAllService)(a).CountdownTime(countdownTime))
+}
+
+func (a *countdownCancelAllServiceAPI) Do(ctx context.Context, opts ...RequestOption) (*CountdownCancelAll, error) {
+	return (*CountdownCancelAllService)(a).Do(ctx, opts...)
+}
+
+// GetCountdownCancelAllServiceAPI is the interface of GetCountdownCancelAllService
+type GetCountdownCancelAllServiceAPI interface {
+	Underlying(underlying string) GetCountdownCancelAllServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) (*CountdownCancelAll, error)
+}
+
+type getCountdownCancelAllServiceAPI GetCountdownCancelAllService
+
+func (a *getCountdownCancelAllServiceAPI) Underlying(underlying string) GetCountdownCancelAllServiceAPI {
+	return (*getCountdownCancelAllServiceAPI)((*GetCountdownCancelAllService)(a).Underlying(underlying))
+}
+
+func (a *getCountdownCancelAllServiceAPI) Do(ctx context.Context, opts ...RequestOption) (*CountdownCancelAll, error) {
+	return (*GetCountdownCancelAllService)(a).Do(ctx, opts...)
+}
+
+// CountdownCancelAllHeartBeatServiceAPI is the interface of CountdownCancelAllHeartBeatService
+type CountdownCancelAllHeartBeatServiceAPI interface {
+	Underlyings(underlyings ...string) CountdownCancelAllHeartBeatServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) (*CountdownCancelAllHeartBeat, error)
+}
+
+type countdownCancelAllHeartBeatServiceAPI CountdownCancelAllHeartBeatService
+
+func (a *countdownCancelAllHeartBeatServiceAPI) Underlyings(underlyings ...string) CountdownCancelAllHeartBeatServiceAPI {
+	return (*countdownCancelAllHeartBeatServiceAPI)((*CountdownCancelAllHeartBeatService)(a).Underlyings(underlyings...))
+}
+
+func (a *countdownCancelAllHeartBeatServiceAPI) Do(ctx context.Context, opts ...RequestOption) (*CountdownCancelAllHeartBeat, error) {
+	return (*CountdownCancelAllHeartBeatService)(a).Do(ctx, opts...)
+}
+
+// MarginAccountServiceAPI is the interface of MarginAccountService
+type MarginAccountServiceAPI interface {
+	Do(ctx context.Context, opts ...RequestOption) (*MarginAccount, error)
+}
+
+type marginAccountServiceAPI MarginAccountService
+
+func (a *marginAccountServiceAPI) Do(ctx context.Context, opts ...RequestOption) (*MarginAccount, error) {
+	return (*MarginAccountService)(a).Do(ctx, opts...)
+}
+
+// CreateBlockTradeOrderServiceAPI is the interface of CreateBlockTradeOrderService
+type CreateBlockTradeOrderServiceAPI interface {
+	Liquidity(liquidity BlockTradeLiquidityType) CreateBlockTradeOrderServiceAPI
+	Legs(legs ...*BlockTradeLeg) CreateBlockTradeOrderServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) (*BlockTradeOrder, error)
+}
+
+type createBlockTradeOrderServiceAPI CreateBlockTradeOrderService
+
+func (a *createBlockTradeOrderServiceAPI) Liquidity(liquidity BlockTradeLiquidityType) CreateBlockTradeOrderServiceAPI {
+	return (*createBlockTradeOrderServiceAPI)((*CreateBlockTradeOrderService)(a).Liquidity(liquidity))
+}
+
+func (a *createBlockTradeOrderServiceAPI) Legs(legs ...*BlockTradeLeg) CreateBlockTradeOrderServiceAPI {
+	return (*createBlockTradeOrderServiceAPI)((*CreateBlockTradeOrderService)(a).Legs(legs...))
+}
+
+func (a *createBlockTradeOrderServiceAPI) Do(ctx context.Context, opts ...RequestOption) (*BlockTradeOrder, error) {
+	return (*CreateBlockTradeOrderService)(a).Do(ctx, opts...)
+}
+
+// ExtendBlockTradeOrderServiceAPI is the interface of ExtendBlockTradeOrderService
+type ExtendBlockTradeOrderServiceAPI interface {
+	BlockOrderMatchingKey(key string) ExtendBlockTradeOrderServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) (*BlockTradeOrder, error)
+}
+
+type extendBlockTradeOrderServiceAPI ExtendBlockTradeOrderService
+
+func (a *extendBlockTradeOrderServiceAPI) BlockOrderMatchingKey(key string) ExtendBlockTradeOrderServiceAPI {
+	return (*extendBlockTradeOrderServiceAPI)((*ExtendBlockTradeOrderService)(a).BlockOrderMatchingKey(key))
+}
+
+func (a *extendBlockTradeOrderServiceAPI) Do(ctx context.Context, opts ...RequestOption) (*BlockTradeOrder, error) {
+	return (*ExtendBlockTradeOrderService)(a).Do(ctx, opts...)
+}
+
+// CancelBlockTradeOrderServiceAPI is the interface of CancelBlockTradeOrderService
+type CancelBlockTradeOrderServiceAPI interface {
+	BlockOrderMatchingKey(key string) CancelBlockTradeOrderServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) error
+}
+
+type cancelBlockTradeOrderServiceAPI CancelBlockTradeOrderService
+
+func (a *cancelBlockTradeOrderServiceAPI) BlockOrderMatchingKey(key string) CancelBlockTradeOrderServiceAPI {
+	return (*cancelBlockTradeOrderServiceAPI)((*CancelBlockTradeOrderService)(a).BlockOrderMatchingKey(key))
+}
+
+func (a *cancelBlockTradeOrderServiceAPI) Do(ctx context.Context, opts ...RequestOption) error {
+	return (*CancelBlockTradeOrderService)(a).Do(ctx, opts...)
+}
+
+// ListBlockTradeOrdersServiceAPI is the interface of ListBlockTradeOrdersService
+type ListBlockTradeOrdersServiceAPI interface {
+	BlockOrderMatchingKey(key string) ListBlockTradeOrdersServiceAPI
+	Underlying(underlying string) ListBlockTradeOrdersServiceAPI
+	StartTime(startTime int64) ListBlockTradeOrdersServiceAPI
+	EndTime(endTime int64) ListBlockTradeOrdersServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) ([]*BlockTradeOrder, error)
+}
+
+type listBlockTradeOrdersServiceAPI ListBlockTradeOrdersService
+
+func (a *listBlockTradeOrdersServiceAPI) BlockOrderMatchingKey(key string) ListBlockTradeOrdersServiceAPI {
+	return (*listBlockTradeOrdersServiceAPI)((*ListBlockTradeOrdersService)(a).BlockOrderMatchingKey(key))
+}
+
+func (a *listBlockTradeOrdersServiceAPI) Underlying(underlying string) ListBlockTradeOrdersServiceAPI {
+	return (*listBlockTradeOrdersServiceAPI)((*ListBlockTradeOrdersService)(a).Underlying(underlying))
+}
+
+func (a *listBlockTradeOrdersServiceAPI) StartTime(startTime int64) ListBlockTradeOrdersServiceAPI {
+	return (*listBlockTradeOrdersServiceAPI)((*ListBlockTradeOrdersService)(a).StartTime(startTime))
+}
+
+func (a *listBlockTradeOrdersServiceAPI) EndTime(endTime int64) ListBlockTradeOrdersServiceAPI {
+	return (*listBlockTradeOrdersServiceAPI)((*ListBlockTradeOrdersService)(a).EndTime(endTime))
+}
+
+func (a *listBlockTradeOrdersServiceAPI) Do(ctx context.Context, opts ...RequestOption) ([]*BlockTradeOrder, error) {
+	return (*ListBlockTradeOrdersService)(a).Do(ctx, opts...)
+}
+
+// AcceptBlockTradeOrderServiceAPI is the interface of AcceptBlockTradeOrderService
+type AcceptBlockTradeOrderServiceAPI interface {
+	BlockOrderMatchingKey(key string) AcceptBlockTradeOrderServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) (*BlockTradeOrder, error)
+}
+
+type acceptBlockTradeOrderServiceAPI AcceptBlockTradeOrderService
+
+func (a *acceptBlockTradeOrderServiceAPI) BlockOrderMatchingKey(key string) AcceptBlockTradeOrderServiceAPI {
+	return (*acceptBlockTradeOrderServiceAPI)((*AcceptBlockTradeOrderService)(a).BlockOrderMatchingKey(key))
+}
+
+func (a *acceptBlockTradeOrderServiceAPI) Do(ctx context.Context, opts ...RequestOption) (*BlockTradeOrder, error) {
+	return (*AcceptBlockTradeOrderService)(a).Do(ctx, opts...)
+}
+
+// GetBlockTradeOrderServiceAPI is the interface of GetBlockTradeOrderService
+type GetBlockTradeOrderServiceAPI interface {
+	BlockOrderMatchingKey(key string) GetBlockTradeOrderServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) (*BlockTradeOrder, error)
+}
+
+type getBlockTradeOrderServiceAPI GetBlockTradeOrderService
+
+func (a *getBlockTradeOrderServiceAPI) BlockOrderMatchingKey(key string) GetBlockTradeOrderServiceAPI {
+	return (*getBlockTradeOrderServiceAPI)((*GetBlockTradeOrderService)(a).BlockOrderMatchingKey(key))
+}
+
+func (a *getBlockTradeOrderServiceAPI) Do(ctx context.Context, opts ...RequestOption) (*BlockTradeOrder, error) {
+	return (*GetBlockTradeOrderService)(a).Do(ctx, opts...)
+}
+
+// ListBlockUserTradesServiceAPI is the interface of ListBlockUserTradesService
+type ListBlockUserTradesServiceAPI interface {
+	Underlying(underlying string) ListBlockUserTradesServiceAPI
+	StartTime(startTime int64) ListBlockUserTradesServiceAPI
+	EndTime(endTime int64) ListBlockUserTradesServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) ([]*BlockUserTrade, error)
+}
+
+type listBlockUserTradesServiceAPI ListBlockUserTradesService
+
+func (a *listBlockUserTradesServiceAPI) Underlying(underlying string) ListBlockUserTradesServiceAPI {
+	return (*listBlockUserTradesServiceAPI)((*ListBlockUserTradesService)(a).Underlying(underlying))
+}
+
+func (a *listBlockUserTradesServiceAPI) StartTime(startTime int64) ListBlockUserTradesServiceAPI {
+	return (*listBlockUserTradesServiceAPI)((*ListBlockUserTradesService)(a).StartTime(startTime))
+}
+
+func (a *listBlockUserTradesServiceAPI) EndTime(endTime int64) ListBlockUserTradesServiceAPI {
+	return (*listBlockUserTradesServiceAPI)((*ListBlockUserTradesService)(a).EndTime(endTime))
+}
+
+func (a *listBlockUserTradesServiceAPI) Do(ctx context.Context, opts ...RequestOption) ([]*BlockUserTrade, error) {
+	return (*ListBlockUserTradesService)(a).Do(ctx, opts...)
+}
@@ -0,0 +1,44 @@
+package options
+
+import (
+	"github.com/adshao/go-binance/v2/common"
+)
+
+// The depth events are decoded by scanning the message once, without building a simplejson
+// tree. Each message is converted to a string once and the string fields of its event are
+// substrings of it.
+
+var wsDepthEvents common.EventPool[WsDepthEvent]
+
+// eventPool returns pool when reuse is set, nil otherwise
+func eventPool[T any](reuse bool, pool *common.EventPool[T]) *common.EventPool[T] {
+	if reuse {
+		return pool
+	}
+	return nil
+}
+
+func decodeWsDepthEvent(message string, event *WsDepthEvent) error {
+	*event = WsDepthEvent{Bids: event.Bids[:0], Asks: event.Asks[:0]}
+	return common.ScanObject(message, func(key, value string) (err error) {
+		switch key {
+		case "e":
+			event.Event, err = common.JSONString(value)
+		case "E":
+			event.Time, err = common.JSONInt64(value)
+		case "T":
+			event.TransactionTime, err = common.JSONInt64(value)
+		case "s":
+			event.Symbol, err = common.JSONString(value)
+		case "u":
+			event.LastUpdateID, err = common.JSONInt64(value)
+		case "pu":
+			event.PrevLastUpdateID, err = common.JSONInt64(value)
+		case "b":
+			event.Bids, err = common.AppendPriceLevels(event.Bids, value)
+		case "a":
+			event.Asks, err = common.AppendPriceLevels(event.Asks, value)
+		}
+		return err
+	})
+}
@@ -0,0 +1,109 @@
+package options
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type websocketDecodeTestSuite struct {
+	suite.Suite
+}
+
+func TestWebsocketDecode(t *testing.T) {
+	suite.Run(t, new(websocketDecodeTestSuite))
+}
+
+// benchDepthMessage returns a depth message of n bids and n asks
+func benchDepthMessage(n int) []byte {
+	levels := make([]string, n)
+	for i := range levels {
+		levels[i] = fmt.Sprintf(`["%d.0","%d.00"]`, 100+i, i)
+	}
+	return []byte(fmt.Sprintf(`{"e":"depth","E":1591695934010,"T":1591695934000,"s":"BTC-200630-9000-P","u":162,"pu":162,"b":[%s],"a":[%s]}`,
+		strings.Join(levels, ","), strings.Join(levels, ",")))
+}
+
+// simplejsonDepthEvent is the depth parser the fast decoder replaces
+func simplejsonDepthEvent(message []byte) (*WsDepthEvent, error) {
+	j, err := newJSON(message)
+	if err != nil {
+		return nil, err
+	}
+	event := new(WsDepthEvent)
+	event.Event = j.Get("e").MustString()
+	event.Time = j.Get("E").MustInt64()
+	event.TransactionTime = j.Get("T").MustInt64()
+	event.Symbol = j.Get("s").MustString()
+	event.LastUpdateID = j.Get("u").MustInt64()
+	event.PrevLastUpdateID = j.Get("pu").MustInt64()
+	bidsLen := len(j.Get("b").MustArray())
+	event.Bids = make([]Bid, bidsLen)
+	for i := 0; i < bidsLen; i++ {
+		item := j.Get("b").GetIndex(i)
+		event.Bids[i] = Bid{
+			Price:    item.GetIndex(0).MustString(),
+			Quantity: item.GetIndex(1).MustString(),
+		}
+	}
+	asksLen := len(j.Get("a").MustArray())
+	event.Asks = make([]Ask, asksLen)
+	for i := 0; i < asksLen; i++ {
+		item := j.Get("a").GetIndex(i)
+		event.Asks[i] = Ask{
+			Price:    item.GetIndex(0).MustString(),
+			Quantity: item.GetIndex(1).MustString(),
+		}
+	}
+	return event, nil
+}
+
+func (s *websocketDecodeTestSuite) TestDepthEvent() {
+	message := benchDepthMessage(3)
+	expected, err := simplejsonDepthEvent(message)
+	s.Require().NoError(err)
+	event := new(WsDepthEvent)
+	s.Require().NoError(decodeWsDepthEvent(string(message), event))
+	s.Equal(expected, event)
+	s.Error(decodeWsDepthEvent(`{"e":"depth","u":1.5}`, event))
+}
+
+func (s *websocketDecodeTestSuite) TestReuseEvents() {
+	s.Nil(eventPool(false, &wsDepthEvents))
+	s.Same(&wsDepthEvents, eventPool(true, &wsDepthEvents))
+
+	// handlers of recycled events copy what they keep
+	var bids [][]Bid
+	handler := func(event *WsDepthEvent) {
+		bids = append(bids, append([]Bid(nil), event.Bids...))
+	}
+	wsDepthServeHandler(&wsDepthEvents, benchDepthMessage(2), handler, func(err error) { s.Fail(err.Error()) })
+	wsDepthServeHandler(&wsDepthEvents, benchDepthMessage(1), handler, func(err error) { s.Fail(err.Error()) })
+	s.Equal([][]Bid{
+		{{Price: "100.0", Quantity: "0.00"}, {Price: "101.0", Quantity: "1.00"}},
+		{{Price: "100.0", Quantity: "0.00"}},
+	}, bids)
+}
+
+func BenchmarkDepthEventSimpleJSON(b *testing.B) {
+	message := benchDepthMessage(20)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		if _, err := simplejsonDepthEvent(message); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkDepthEventDecodeReuse(b *testing.B) {
+	message := benchDepthMessage(20)
+	event := new(WsDepthEvent)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		if err := decodeWsDepthEvent(string(message), event); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
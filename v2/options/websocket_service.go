@@ -6,6 +6,9 @@ import (
 	"fmt"
 	"strings"
 	"time"
+
+	"github.com/adshao/go-binance/v2/common"
+	commonws "github.com/adshao/go-binance/v2/common/websocket"
 )
 
 // Endpoints
@@ -23,14 +26,20 @@ var (
 	WebsocketPongTimeout = time.Second * 10
 	// WebsocketKeepalive enables sending ping/pong messages to check the connection stability
 	WebsocketKeepalive = true
-	// UseTestnet switch all the WS streams from production to the testnet
+	// UseTestnet switch all the WS streams from production to the testnet, it only applies to
+	// the streams without options.
+	//
+	// Deprecated: pass WsOptions with the testnet endpoints to the streams.
 	UseTestnet = false
 
 	ProxyUrl = ""
 )
 
-// getWsEndpoint return the base endpoint of the WS according the UseTestnet flag
-func getWsEndpoint() string {
+// getWsEndpoint return the base endpoint of the WS of opts, according the UseTestnet flag when they set none
+func getWsEndpoint(opts ...WsOptions) string {
+	if o := commonws.MergeOptions(opts...); o.BaseURL != "" {
+		return o.BaseURL
+	}
 	if UseTestnet {
 		return baseWsTestnetUrl
 	}
@@ -48,8 +57,11 @@ func SetWsProxyUrl(url string) {
 	ProxyUrl = url
 }
 
-// getCombinedEndpoint return the base endpoint of the combined stream according the UseTestnet flag
-func getCombinedEndpoint() string {
+// getCombinedEndpoint return the base endpoint of the combined stream of opts, according the UseTestnet flag when they set none
+func getCombinedEndpoint(opts ...WsOptions) string {
+	if o := commonws.MergeOptions(opts...); o.CombinedBaseURL != "" {
+		return o.CombinedBaseURL
+	}
 	if UseTestnet {
 		return baseCombinedTestnetURL
 	}
@@ -211,9 +223,9 @@ func wsTradeServeHandler(message []byte, handler WsTradeHandler, errHandler ErrH
 }
 
 // WsTradeServe serve websocket that push trade information that is aggregated for a single taker order.
-func WsTradeServe(symbol string, handler WsTradeHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
-	endpoint := fmt.Sprintf("%s/%s@trade", getWsEndpoint(), strings.ToUpper(symbol))
-	cfg := newWsConfig(endpoint)
+func WsTradeServe(symbol string, handler WsTradeHandler, errHandler ErrHandler, opts ...WsOptions) (doneC, stopC chan struct{}, err error) {
+	endpoint := fmt.Sprintf("%s/%s@trade", getWsEndpoint(opts...), strings.ToUpper(symbol))
+	cfg := newWsConfig(endpoint, opts...)
 	wsHandler := func(message []byte) {
 		wsTradeServeHandler(message, handler, errHandler)
 	}
@@ -239,9 +251,9 @@ func wsIndexServeHandler(message []byte, handler WsIndexHandler, errHandler ErrH
 }
 
 // WsIndexServe serve websocket that push trade information that is aggregated for a single taker order.
-func WsIndexServe(symbol string, handler WsIndexHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
-	endpoint := fmt.Sprintf("%s/%s@index", getWsEndpoint(), strings.ToUpper(symbol))
-	cfg := newWsConfig(endpoint)
+func WsIndexServe(symbol string, handler WsIndexHandler, errHandler ErrHandler, opts ...WsOptions) (doneC, stopC chan struct{}, err error) {
+	endpoint := fmt.Sprintf("%s/%s@index", getWsEndpoint(opts...), strings.ToUpper(symbol))
+	cfg := newWsConfig(endpoint, opts...)
 	wsHandler := func(message []byte) {
 		wsIndexServeHandler(message, handler, errHandler)
 	}
@@ -266,9 +278,9 @@ func wsMarkPriceServeHandler(message []byte, handler WsMarkPriceHandler, errHand
 	handler(event)
 }
 
-func WsMarkPriceServe(symbol string, handler WsMarkPriceHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
-	endpoint := fmt.Sprintf("%s/%s@markPrice", getWsEndpoint(), strings.ToUpper(symbol))
-	cfg := newWsConfig(endpoint)
+func WsMarkPriceServe(symbol string, handler WsMarkPriceHandler, errHandler ErrHandler, opts ...WsOptions) (doneC, stopC chan struct{}, err error) {
+	endpoint := fmt.Sprintf("%s/%s@markPrice", getWsEndpoint(opts...), strings.ToUpper(symbol))
+	cfg := newWsConfig(endpoint, opts...)
 	wsHandler := func(message []byte) {
 		wsMarkPriceServeHandler(message, handler, errHandler)
 	}
@@ -293,9 +305,9 @@ func wsKlineServeHandler(message []byte, handler WsKlineHandler, errHandler ErrH
 	handler(event)
 }
 
-func WsKlineServe(symbol string, interval string, handler WsKlineHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
-	endpoint := fmt.Sprintf("%s/%s@kline_%s", getWsEndpoint(), strings.ToUpper(symbol), interval)
-	cfg := newWsConfig(endpoint)
+func WsKlineServe(symbol string, interval string, handler WsKlineHandler, errHandler ErrHandler, opts ...WsOptions) (doneC, stopC chan struct{}, err error) {
+	endpoint := fmt.Sprintf("%s/%s@kline_%s", getWsEndpoint(opts...), strings.ToUpper(symbol), interval)
+	cfg := newWsConfig(endpoint, opts...)
 	wsHandler := func(message []byte) {
 		wsKlineServeHandler(message, handler, errHandler)
 	}
@@ -320,9 +332,9 @@ func wsTickerServeHandler(message []byte, handler WsTickerHandler, errHandler Er
 	handler(event)
 }
 
-func WsTickerServe(symbol string, handler WsTickerHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
-	endpoint := fmt.Sprintf("%s/%s@ticker", getWsEndpoint(), strings.ToUpper(symbol))
-	cfg := newWsConfig(endpoint)
+func WsTickerServe(symbol string, handler WsTickerHandler, errHandler ErrHandler, opts ...WsOptions) (doneC, stopC chan struct{}, err error) {
+	endpoint := fmt.Sprintf("%s/%s@ticker", getWsEndpoint(opts...), strings.ToUpper(symbol))
+	cfg := newWsConfig(endpoint, opts...)
 	wsHandler := func(message []byte) {
 		wsTickerServeHandler(message, handler, errHandler)
 	}
@@ -349,9 +361,9 @@ func wsTickerExpireServeHandler(message []byte, handler WsTickerHandler, errHand
 
 // expireDate: for example 220930
 // underlying: for example ETH
-func WsTickerWithExpireServe(underlying string, expireDate string, handler WsTickerHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
-	endpoint := fmt.Sprintf("%s/%s@ticker@%s", getWsEndpoint(), strings.ToUpper(underlying), expireDate)
-	cfg := newWsConfig(endpoint)
+func WsTickerWithExpireServe(underlying string, expireDate string, handler WsTickerHandler, errHandler ErrHandler, opts ...WsOptions) (doneC, stopC chan struct{}, err error) {
+	endpoint := fmt.Sprintf("%s/%s@ticker@%s", getWsEndpoint(opts...), strings.ToUpper(underlying), expireDate)
+	cfg := newWsConfig(endpoint, opts...)
 	wsHandler := func(message []byte) {
 		wsTickerExpireServeHandler(message, handler, errHandler)
 	}
@@ -378,9 +390,9 @@ func wsOpenInterestServeHandler(message []byte, handler WsOpenInterestHandler, e
 
 // expireDate: for example 220930
 // underlying: for example ETH
-func WsOpenInterestServe(underlying string, expireDate string, handler WsOpenInterestHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
-	endpoint := fmt.Sprintf("%s/%s@openInterest@%s", getWsEndpoint(), strings.ToUpper(underlying), expireDate)
-	cfg := newWsConfig(endpoint)
+func WsOpenInterestServe(underlying string, expireDate string, handler WsOpenInterestHandler, errHandler ErrHandler, opts ...WsOptions) (doneC, stopC chan struct{}, err error) {
+	endpoint := fmt.Sprintf("%s/%s@openInterest@%s", getWsEndpoint(opts...), strings.ToUpper(underlying), expireDate)
+	cfg := newWsConfig(endpoint, opts...)
 	wsHandler := func(message []byte) {
 		wsOpenInterestServeHandler(message, handler, errHandler)
 	}
@@ -405,51 +417,19 @@ func wsOptionPairServeHandler(message []byte, handler WsOptionPairHandler, errHa
 	handler(event)
 }
 
-func WsOptionPairServe(handler WsOptionPairHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
-	endpoint := fmt.Sprintf("%s/option_pair", getWsEndpoint())
-	cfg := newWsConfig(endpoint)
+func WsOptionPairServe(handler WsOptionPairHandler, errHandler ErrHandler, opts ...WsOptions) (doneC, stopC chan struct{}, err error) {
+	endpoint := fmt.Sprintf("%s/option_pair", getWsEndpoint(opts...))
+	cfg := newWsConfig(endpoint, opts...)
 	wsHandler := func(message []byte) {
 		wsOptionPairServeHandler(message, handler, errHandler)
 	}
 	return wsServe(cfg, wsHandler, errHandler)
 }
 
-func wsDepthParse(message []byte) (*WsDepthEvent, error) {
-	j, err := newJSON(message)
-	if err != nil {
-		return nil, err
-	}
-	event := new(WsDepthEvent)
-	event.Event = j.Get("e").MustString()
-	event.Time = j.Get("E").MustInt64()
-	event.TransactionTime = j.Get("T").MustInt64()
-	event.Symbol = j.Get("s").MustString()
-	event.LastUpdateID = j.Get("u").MustInt64()
-	event.PrevLastUpdateID = j.Get("pu").MustInt64()
-	bidsLen := len(j.Get("b").MustArray())
-	event.Bids = make([]Bid, bidsLen)
-	for i := 0; i < bidsLen; i++ {
-		item := j.Get("b").GetIndex(i)
-		event.Bids[i] = Bid{
-			Price:    item.GetIndex(0).MustString(),
-			Quantity: item.GetIndex(1).MustString(),
-		}
-	}
-	asksLen := len(j.Get("a").MustArray())
-	event.Asks = make([]Ask, asksLen)
-	for i := 0; i < asksLen; i++ {
-		item := j.Get("a").GetIndex(i)
-		event.Asks[i] = Ask{
-			Price:    item.GetIndex(0).MustString(),
-			Quantity: item.GetIndex(1).MustString(),
-		}
-	}
-	return event, nil
-}
-
-func wsDepthServeHandler(message []byte, handler WsDepthHandler, errHandler ErrHandler) {
-	event, err := wsDepthParse(message)
-	if err != nil {
+func wsDepthServeHandler(pool *common.EventPool[WsDepthEvent], message []byte, handler WsDepthHandler, errHandler ErrHandler) {
+	event := pool.Get()
+	defer pool.Put(event)
+	if err := decodeWsDepthEvent(string(message), event); err != nil {
 		errHandler(err)
 		return
 	}
@@ -458,7 +438,7 @@ func wsDepthServeHandler(message []byte, handler WsDepthHandler, errHandler ErrH
 
 // levels: [10, 20, 50, 100, 1000]
 // rate: [100, 500, 100] ms, default 500ms while rate is nil
-func WsDepthServe(symbol string, levels string, rate *time.Duration, handler WsDepthHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+func WsDepthServe(symbol string, levels string, rate *time.Duration, handler WsDepthHandler, errHandler ErrHandler, opts ...WsOptions) (doneC, stopC chan struct{}, err error) {
 	switch levels {
 	case "10":
 	case "20":
@@ -481,10 +461,11 @@ func WsDepthServe(symbol string, levels string, rate *time.Duration, handler WsD
 			return nil, nil, errors.New("invalid rate")
 		}
 	}
-	endpoint := fmt.Sprintf("%s/%s@depth%s%s", getWsEndpoint(), strings.ToUpper(symbol), levels, rateStr)
-	cfg := newWsConfig(endpoint)
+	endpoint := fmt.Sprintf("%s/%s@depth%s%s", getWsEndpoint(opts...), strings.ToUpper(symbol), levels, rateStr)
+	cfg := newWsConfig(endpoint, opts...)
+	pool := eventPool(cfg.Options.ReuseEvents, &wsDepthEvents)
 	wsHandler := func(message []byte) {
-		wsDepthServeHandler(message, handler, errHandler)
+		wsDepthServeHandler(pool, message, handler, errHandler)
 	}
 	return wsServe(cfg, wsHandler, errHandler)
 }
@@ -506,16 +487,17 @@ func WsDepthServe(symbol string, levels string, rate *time.Duration, handler WsD
 //				    map[string]interface{}{"depth": func(*WsDepthEvent) {}, "kline": func(*WsKlineEvent){}}, func(error){})
 //
 // note: the symbol(underlying) of streamName should be upper.
-func WsCombinedServe(streamName []string, handler map[string]interface{}, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+func WsCombinedServe(streamName []string, handler map[string]interface{}, errHandler ErrHandler, opts ...WsOptions) (doneC, stopC chan struct{}, err error) {
 	if len(streamName) <= 0 || len(handler) <= 0 {
 		return nil, nil, errors.New("streamName is empty or handler is empty")
 	}
-	endpoint := getCombinedEndpoint()
+	endpoint := getCombinedEndpoint(opts...)
 	for _, s := range streamName {
 		endpoint += s + "/"
 	}
 	endpoint = endpoint[:len(endpoint)-1]
-	cfg := newWsConfig(endpoint)
+	cfg := newWsConfig(endpoint, opts...)
+	depthEvents := eventPool(cfg.Options.ReuseEvents, &wsDepthEvents)
 
 	// TODO: use template after go 1.8
 	tradeKey := "trade"
@@ -657,7 +639,7 @@ func WsCombinedServe(streamName []string, handler map[string]interface{}, errHan
 				return
 			}
 		}
-		wsDepthServeHandler(jsonData, fn, errHandler)
+		wsDepthServeHandler(depthEvents, jsonData, fn, errHandler)
 	}
 
 	wsHandler := func(message []byte) {
@@ -776,9 +758,9 @@ type WsOrderTradeUpdate struct {
 // WsUserDataHandler handle WsUserDataEvent
 type WsUserDataHandler func(event *WsUserDataEvent)
 
-func WsUserDataServe(listenKey string, handler WsUserDataHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
-	endpoint := fmt.Sprintf("%s/%s", getWsEndpoint(), listenKey)
-	cfg := newWsConfig(endpoint)
+func WsUserDataServe(listenKey string, handler WsUserDataHandler, errHandler ErrHandler, opts ...WsOptions) (doneC, stopC chan struct{}, err error) {
+	endpoint := fmt.Sprintf("%s/%s", getWsEndpoint(opts...), listenKey)
+	cfg := newWsConfig(endpoint, opts...)
 	wsHandler := func(message []byte) {
 		event := new(WsUserDataEvent)
 		err := json.Unmarshal(message, event)
@@ -0,0 +1,104 @@
+package binance
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/adshao/go-binance/v2/common/websocket"
+	"github.com/adshao/go-binance/v2/common/websocket/mock"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/suite"
+)
+
+type orderCancelReplaceServiceWsTestSuite struct {
+	suite.Suite
+
+	ctrl   *gomock.Controller
+	client *mock.MockClient
+
+	requestID string
+
+	service *OrderCancelReplaceWsService
+	request *OrderCancelReplaceWsRequest
+}
+
+func (s *orderCancelReplaceServiceWsTestSuite) SetupTest() {
+	s.requestID = "e2a85d9f-07a5-4f94-8d5f-789dc3deb098"
+
+	s.ctrl = gomock.NewController(s.T())
+	s.client = mock.NewMockClient(s.ctrl)
+
+	s.service = &OrderCancelReplaceWsService{
+		c:         s.client,
+		ApiKey:    "dummyApiKey",
+		SecretKey: "dummySecretKey",
+		KeyType:   "HMAC",
+	}
+
+	s.request = NewOrderCancelReplaceWsRequest().
+		Symbol("BTCUSDT").
+		Side(SideTypeSell).
+		Type(OrderTypeLimit).
+		CancelReplaceMode(CancelReplaceModeAllowFailure).
+		TimeInForce(TimeInForceTypeGTC).
+		Price("23416.10000000").
+		Quantity("0.00847000").
+		CancelOrderID(125690984230)
+}
+
+func (s *orderCancelReplaceServiceWsTestSuite) TearDownTest() {
+	s.ctrl.Finish()
+}
+
+func TestOrderCancelReplaceWsService(t *testing.T) {
+	suite.Run(t, new(orderCancelReplaceServiceWsTestSuite))
+}
+
+func (s *orderCancelReplaceServiceWsTestSuite) TestDo() {
+	var data []byte
+	s.client.EXPECT().Write(s.requestID, gomock.Any()).DoAndReturn(func(id string, raw []byte) error {
+		data = raw
+		return nil
+	}).Times(1)
+
+	err := s.service.Do(s.requestID, s.request)
+	s.Require().NoError(err)
+
+	req := websocket.WsApiRequest{}
+	s.Require().NoError(json.Unmarshal(data, &req))
+	s.Equal(s.requestID, req.Id)
+	s.Equal(websocket.WsApiMethodType("order.cancelReplace"), req.Method)
+	for k, v := range map[string]interface{}{"symbol": "BTCUSDT", "side": "SELL", "type": "LIMIT", "cancelReplaceMode": "ALLOW_FAILURE", "timeInForce": "GTC", "price": "23416.10000000", "quantity": "0.00847000", "cancelOrderId": float64(125690984230)} {
+		s.Equal(v, req.Params[k], k)
+	}
+	s.Contains(req.Params, "signature")
+}
+
+func (s *orderCancelReplaceServiceWsTestSuite) TestDo_EmptyApiKey() {
+	s.service.ApiKey = ""
+	s.client.EXPECT().Write(gomock.Any(), gomock.Any()).Times(0)
+
+	err := s.service.Do(s.requestID, s.request)
+	s.ErrorIs(err, websocket.ErrorApiKeyIsNotSet)
+}
+
+func (s *orderCancelReplaceServiceWsTestSuite) TestSyncDo() {
+	rawResponseData := []byte(fmt.Sprintf(`{"id": "%s", "status": 200, "result": {"cancelResult": "SUCCESS", "newOrderResult": "SUCCESS", "cancelResponse": {"symbol": "BTCUSDT", "orderId": 125690984230, "status": "CANCELED"}, "newOrderResponse": {"symbol": "BTCUSDT", "orderId": 12569099453, "status": "NEW"}}}`, s.requestID))
+	s.client.EXPECT().WriteSync(s.requestID, gomock.Any(), gomock.Any()).Return(rawResponseData, nil).Times(1)
+
+	response, err := s.service.SyncDo(s.requestID, s.request)
+	s.Require().NoError(err)
+	s.Equal(s.requestID, response.Id)
+	s.Equal("SUCCESS", response.Result.CancelResult)
+	s.Equal(int64(125690984230), response.Result.CancelResponse.OrderID)
+	s.Equal(int64(12569099453), response.Result.NewOrderResponse.OrderID)
+}
+
+func (s *orderCancelReplaceServiceWsTestSuite) TestSyncDo_EmptyRequestID() {
+	s.client.EXPECT().WriteSync(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+	response, err := s.service.SyncDo("", s.request)
+	s.Nil(response)
+	s.ErrorIs(err, websocket.ErrorRequestIDNotSet)
+}
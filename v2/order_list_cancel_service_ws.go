@@ -14,6 +14,8 @@ type OrderListCancelWsService struct {
 	ApiKey     string
 	SecretKey  string
 	KeyType    string
+	Signer     common.Signer       // signs requests instead of SecretKey when set
+	signers    *common.SignerCache // parses SecretKey once
 	TimeOffset int64
 }
 
@@ -34,6 +36,7 @@ func NewOrderListCancelWsService(apiKey, secretKey string) (*OrderListCancelWsSe
 		ApiKey:    apiKey,
 		SecretKey: secretKey,
 		KeyType:   common.KeyTypeHmac,
+		signers:   new(common.SignerCache),
 	}, nil
 }
 
@@ -84,7 +87,7 @@ func (s *OrderListCancelWsService) Do(requestID string, request *OrderListCancel
 			s.SecretKey,
 			s.TimeOffset,
 			s.KeyType,
-		),
+		).WithSigner(s.Signer).WithSignerCache(s.signers),
 		websocket.OrderListCancelSpotWsApiMethod,
 		request.buildParams(),
 	)
@@ -108,7 +111,7 @@ func (s *OrderListCancelWsService) SyncDo(requestID string, request *OrderListCa
 			s.SecretKey,
 			s.TimeOffset,
 			s.KeyType,
-		),
+		).WithSigner(s.Signer).WithSignerCache(s.signers),
 		websocket.OrderListCancelSpotWsApiMethod,
 		request.buildParams(),
 	)
@@ -14,6 +14,8 @@ type OrderListPlaceOtoWsService struct {
 	ApiKey     string
 	SecretKey  string
 	KeyType    string
+	Signer     common.Signer       // signs requests instead of SecretKey when set
+	signers    *common.SignerCache // parses SecretKey once
 	TimeOffset int64
 }
 
@@ -34,6 +36,7 @@ func NewOrderListPlaceOtoWsService(apiKey, secretKey string) (*OrderListPlaceOto
 		ApiKey:    apiKey,
 		SecretKey: secretKey,
 		KeyType:   common.KeyTypeHmac,
+		signers:   new(common.SignerCache),
 	}, nil
 }
 
@@ -156,7 +159,7 @@ func (s *OrderListPlaceOtoWsService) Do(requestID string, request *OrderListPlac
 			s.SecretKey,
 			s.TimeOffset,
 			s.KeyType,
-		),
+		).WithSigner(s.Signer).WithSignerCache(s.signers),
 		websocket.OrderListPlaceOtoSpotWsApiMethod,
 		request.buildParams(),
 	)
@@ -180,7 +183,7 @@ func (s *OrderListPlaceOtoWsService) SyncDo(requestID string, request *OrderList
 			s.SecretKey,
 			s.TimeOffset,
 			s.KeyType,
-		),
+		).WithSigner(s.Signer).WithSignerCache(s.signers),
 		websocket.OrderListPlaceOtoSpotWsApiMethod,
 		request.buildParams(),
 	)
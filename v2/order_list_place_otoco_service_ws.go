@@ -14,6 +14,8 @@ type OrderListPlaceOtocoWsService struct {
 	ApiKey     string
 	SecretKey  string
 	KeyType    string
+	Signer     common.Signer       // signs requests instead of SecretKey when set
+	signers    *common.SignerCache // parses SecretKey once
 	TimeOffset int64
 }
 
@@ -34,6 +36,7 @@ func NewOrderListPlaceOtocoWsService(apiKey, secretKey string) (*OrderListPlaceO
 		ApiKey:    apiKey,
 		SecretKey: secretKey,
 		KeyType:   common.KeyTypeHmac,
+		signers:   new(common.SignerCache),
 	}, nil
 }
 
@@ -194,7 +197,7 @@ func (s *OrderListPlaceOtocoWsService) Do(requestID string, request *OrderListPl
 			s.SecretKey,
 			s.TimeOffset,
 			s.KeyType,
-		),
+		).WithSigner(s.Signer).WithSignerCache(s.signers),
 		websocket.OrderListPlaceOtocoSpotWsApiMethod,
 		request.buildParams(),
 	)
@@ -218,7 +221,7 @@ func (s *OrderListPlaceOtocoWsService) SyncDo(requestID string, request *OrderLi
 			s.SecretKey,
 			s.TimeOffset,
 			s.KeyType,
-		),
+		).WithSigner(s.Signer).WithSignerCache(s.signers),
 		websocket.OrderListPlaceOtocoSpotWsApiMethod,
 		request.buildParams(),
 	)
@@ -14,6 +14,8 @@ type OrderListPlaceWsService struct {
 	ApiKey     string
 	SecretKey  string
 	KeyType    string
+	Signer     common.Signer       // signs requests instead of SecretKey when set
+	signers    *common.SignerCache // parses SecretKey once
 	TimeOffset int64
 }
 
@@ -34,6 +36,7 @@ func NewOrderListPlaceWsService(apiKey, secretKey string) (*OrderListPlaceWsServ
 		ApiKey:    apiKey,
 		SecretKey: secretKey,
 		KeyType:   common.KeyTypeHmac,
+		signers:   new(common.SignerCache),
 	}, nil
 }
 
@@ -144,7 +147,7 @@ func (s *OrderListPlaceWsService) Do(requestID string, request *OrderListPlaceWs
 			s.SecretKey,
 			s.TimeOffset,
 			s.KeyType,
-		),
+		).WithSigner(s.Signer).WithSignerCache(s.signers),
 		websocket.OrderListPlaceSpotWsApiMethod,
 		request.buildParams(),
 	)
@@ -168,7 +171,7 @@ func (s *OrderListPlaceWsService) SyncDo(requestID string, request *OrderListPla
 			s.SecretKey,
 			s.TimeOffset,
 			s.KeyType,
-		),
+		).WithSigner(s.Signer).WithSignerCache(s.signers),
 		websocket.OrderListPlaceSpotWsApiMethod,
 		request.buildParams(),
 	)
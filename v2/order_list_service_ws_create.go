@@ -14,6 +14,8 @@ type OrderListCreateWsService struct {
 	ApiKey     string
 	SecretKey  string
 	KeyType    string
+	Signer     common.Signer       // signs requests instead of SecretKey when set
+	signers    *common.SignerCache // parses SecretKey once
 	TimeOffset int64
 }
 
@@ -34,6 +36,7 @@ func NewOrderListCreateWsService(apiKey, secretKey string) (*OrderListCreateWsSe
 		ApiKey:    apiKey,
 		SecretKey: secretKey,
 		KeyType:   common.KeyTypeHmac,
+		signers:   new(common.SignerCache),
 	}, nil
 }
 
@@ -160,7 +163,7 @@ func (s *OrderListCreateWsService) Do(requestID string, request *OrderListCreate
 			s.SecretKey,
 			s.TimeOffset,
 			s.KeyType,
-		),
+		).WithSigner(s.Signer).WithSignerCache(s.signers),
 		websocket.OrderListPlaceOcoSpotWsApiMethod,
 		request.buildParams(),
 	)
@@ -184,7 +187,7 @@ func (s *OrderListCreateWsService) SyncDo(requestID string, request *OrderListCr
 			s.SecretKey,
 			s.TimeOffset,
 			s.KeyType,
-		),
+		).WithSigner(s.Signer).WithSignerCache(s.signers),
 		websocket.OrderListPlaceOcoSpotWsApiMethod,
 		request.buildParams(),
 	)
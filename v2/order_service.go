@@ -771,16 +771,24 @@ func (s *CancelOpenOrdersService) Do(ctx context.Context, opts ...RequestOption)
 	if err != nil {
 		return &CancelOpenOrdersResponse{}, err
 	}
-	rawMessages := make([]*json.RawMessage, 0)
-	err = json.Unmarshal(data, &rawMessages)
+	res, err = parseCancelOpenOrdersResponse(data)
 	if err != nil {
 		return &CancelOpenOrdersResponse{}, err
 	}
+	return res, nil
+}
+
+// parseCancelOpenOrdersResponse split the canceled orders and order lists
+func parseCancelOpenOrdersResponse(data []byte) (*CancelOpenOrdersResponse, error) {
+	rawMessages := make([]*json.RawMessage, 0)
+	if err := json.Unmarshal(data, &rawMessages); err != nil {
+		return nil, err
+	}
 	cancelOpenOrdersResponse := new(CancelOpenOrdersResponse)
 	for _, j := range rawMessages {
 		o := new(CancelOrderResponse)
 		if err := json.Unmarshal(*j, o); err != nil {
-			return &CancelOpenOrdersResponse{}, err
+			return nil, err
 		}
 		// Non-OCO orders guaranteed to have order list ID of -1
 		if o.OrderListID == -1 {
@@ -789,7 +797,7 @@ func (s *CancelOpenOrdersService) Do(ctx context.Context, opts ...RequestOption)
 		}
 		oco := new(CancelOCOResponse)
 		if err := json.Unmarshal(*j, oco); err != nil {
-			return &CancelOpenOrdersResponse{}, err
+			return nil, err
 		}
 		cancelOpenOrdersResponse.OCOOrders = append(cancelOpenOrdersResponse.OCOOrders, oco)
 	}
@@ -14,6 +14,8 @@ type AllOrdersWsService struct {
 	ApiKey     string
 	SecretKey  string
 	KeyType    string
+	Signer     common.Signer       // signs requests instead of SecretKey when set
+	signers    *common.SignerCache // parses SecretKey once
 	TimeOffset int64
 }
 
@@ -34,6 +36,7 @@ func NewAllOrdersWsService(apiKey, secretKey string) (*AllOrdersWsService, error
 		ApiKey:    apiKey,
 		SecretKey: secretKey,
 		KeyType:   common.KeyTypeHmac,
+		signers:   new(common.SignerCache),
 	}, nil
 }
 
@@ -120,7 +123,7 @@ func (s *AllOrdersWsService) Do(requestID string, request *AllOrdersWsRequest) e
 			s.SecretKey,
 			s.TimeOffset,
 			s.KeyType,
-		),
+		).WithSigner(s.Signer).WithSignerCache(s.signers),
 		websocket.AllOrdersSpotWsApiMethod,
 		request.buildParams(),
 	)
@@ -144,7 +147,7 @@ func (s *AllOrdersWsService) SyncDo(requestID string, request *AllOrdersWsReques
 			s.SecretKey,
 			s.TimeOffset,
 			s.KeyType,
-		),
+		).WithSigner(s.Signer).WithSignerCache(s.signers),
 		websocket.AllOrdersSpotWsApiMethod,
 		request.buildParams(),
 	)
@@ -14,6 +14,8 @@ type OrderCancelWsService struct {
 	ApiKey     string
 	SecretKey  string
 	KeyType    string
+	Signer     common.Signer       // signs requests instead of SecretKey when set
+	signers    *common.SignerCache // parses SecretKey once
 	TimeOffset int64
 }
 
@@ -34,6 +36,7 @@ func NewOrderCancelWsService(apiKey, secretKey string) (*OrderCancelWsService, e
 		ApiKey:    apiKey,
 		SecretKey: secretKey,
 		KeyType:   common.KeyTypeHmac,
+		signers:   new(common.SignerCache),
 	}, nil
 }
 
@@ -88,7 +91,7 @@ func (s *OrderCancelWsService) Do(requestID string, request *OrderCancelWsReques
 			s.SecretKey,
 			s.TimeOffset,
 			s.KeyType,
-		),
+		).WithSigner(s.Signer).WithSignerCache(s.signers),
 		websocket.OrderCancelSpotWsApiMethod,
 		request.buildParams(),
 	)
@@ -112,7 +115,7 @@ func (s *OrderCancelWsService) SyncDo(requestID string, request *OrderCancelWsRe
 			s.SecretKey,
 			s.TimeOffset,
 			s.KeyType,
-		),
+		).WithSigner(s.Signer).WithSignerCache(s.signers),
 		websocket.OrderCancelSpotWsApiMethod,
 		request.buildParams(),
 	)
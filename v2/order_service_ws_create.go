@@ -14,6 +14,8 @@ type OrderCreateWsService struct {
 	ApiKey     string
 	SecretKey  string
 	KeyType    string
+	Signer     common.Signer       // signs requests instead of SecretKey when set
+	signers    *common.SignerCache // parses SecretKey once
 	TimeOffset int64
 }
 
@@ -34,6 +36,7 @@ func NewOrderCreateWsService(apiKey, secretKey string) (*OrderCreateWsService, e
 		ApiKey:    apiKey,
 		SecretKey: secretKey,
 		KeyType:   common.KeyTypeHmac,
+		signers:   new(common.SignerCache),
 	}, nil
 }
 
@@ -120,7 +123,7 @@ func (s *OrderCreateWsService) Do(requestID string, request *OrderCreateWsReques
 			s.SecretKey,
 			s.TimeOffset,
 			s.KeyType,
-		),
+		).WithSigner(s.Signer).WithSignerCache(s.signers),
 		websocket.OrderPlaceSpotWsApiMethod,
 		request.buildParams(),
 	)
@@ -144,7 +147,7 @@ func (s *OrderCreateWsService) SyncDo(requestID string, request *OrderCreateWsRe
 			s.SecretKey,
 			s.TimeOffset,
 			s.KeyType,
-		),
+		).WithSigner(s.Signer).WithSignerCache(s.signers),
 		websocket.OrderPlaceSpotWsApiMethod,
 		request.buildParams(),
 	)
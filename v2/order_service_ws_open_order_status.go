@@ -14,6 +14,8 @@ type OpenOrderStatusWsService struct {
 	ApiKey     string
 	SecretKey  string
 	KeyType    string
+	Signer     common.Signer       // signs requests instead of SecretKey when set
+	signers    *common.SignerCache // parses SecretKey once
 	TimeOffset int64
 }
 
@@ -34,6 +36,7 @@ func NewOpenOrderStatusWsService(apiKey, secretKey string) (*OpenOrderStatusWsSe
 		ApiKey:    apiKey,
 		SecretKey: secretKey,
 		KeyType:   common.KeyTypeHmac,
+		signers:   new(common.SignerCache),
 	}, nil
 }
 
@@ -77,7 +80,7 @@ func (s *OpenOrderStatusWsService) Do(requestID string, request *OpenOrderStatus
 			s.SecretKey,
 			s.TimeOffset,
 			s.KeyType,
-		),
+		).WithSigner(s.Signer).WithSignerCache(s.signers),
 		websocket.OrderOpenStatusSpotWsApiMethod,
 		request.buildParams(),
 	)
@@ -101,7 +104,7 @@ func (s *OpenOrderStatusWsService) SyncDo(requestID string, request *OpenOrderSt
 			s.SecretKey,
 			s.TimeOffset,
 			s.KeyType,
-		),
+		).WithSigner(s.Signer).WithSignerCache(s.signers),
 		websocket.OrderOpenStatusSpotWsApiMethod,
 		request.buildParams(),
 	)
@@ -0,0 +1,162 @@
+package binance
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/adshao/go-binance/v2/common"
+	"github.com/adshao/go-binance/v2/common/websocket"
+)
+
+// OrderTestWsService tests order placement without sending the order to the matching engine
+type OrderTestWsService struct {
+	c          websocket.Client
+	ApiKey     string
+	SecretKey  string
+	KeyType    string
+	Signer     common.Signer       // signs requests instead of SecretKey when set
+	signers    *common.SignerCache // parses SecretKey once
+	TimeOffset int64
+}
+
+// NewOrderTestWsService init OrderTestWsService
+func NewOrderTestWsService(apiKey, secretKey string) (*OrderTestWsService, error) {
+	conn, err := websocket.NewConnection(WsApiInitReadWriteConn, WebsocketKeepalive, WebsocketTimeoutReadWriteConnection)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := websocket.NewClient(conn)
+	if err != nil {
+		return nil, err
+	}
+
+	return &OrderTestWsService{
+		c:         client,
+		ApiKey:    apiKey,
+		SecretKey: secretKey,
+		KeyType:   common.KeyTypeHmac,
+		signers:   new(common.SignerCache),
+	}, nil
+}
+
+// OrderTestWsRequest parameters for 'order.test' websocket API
+type OrderTestWsRequest struct {
+	order                  *OrderCreateWsRequest
+	computeCommissionRates *bool
+}
+
+// NewOrderTestWsRequest init OrderTestWsRequest testing the order
+func NewOrderTestWsRequest(order *OrderCreateWsRequest) *OrderTestWsRequest {
+	return &OrderTestWsRequest{order: order}
+}
+
+func (s *OrderTestWsRequest) GetParams() map[string]interface{} {
+	return s.buildParams()
+}
+
+// buildParams builds params
+func (s *OrderTestWsRequest) buildParams() params {
+	m := s.order.buildParams()
+	if s.computeCommissionRates != nil {
+		m["computeCommissionRates"] = *s.computeCommissionRates
+	}
+	return m
+}
+
+// ComputeCommissionRates set computeCommissionRates
+func (s *OrderTestWsRequest) ComputeCommissionRates(computeCommissionRates bool) *OrderTestWsRequest {
+	s.computeCommissionRates = &computeCommissionRates
+	return s
+}
+
+// Do - sends 'order.test' request
+func (s *OrderTestWsService) Do(requestID string, request *OrderTestWsRequest) error {
+	rawData, err := websocket.CreateRequest(
+		websocket.NewRequestData(
+			requestID,
+			s.ApiKey,
+			s.SecretKey,
+			s.TimeOffset,
+			s.KeyType,
+		).WithSigner(s.Signer).WithSignerCache(s.signers),
+		websocket.OrderTestSpotWsApiMethod,
+		request.buildParams(),
+	)
+	if err != nil {
+		return err
+	}
+
+	if err := s.c.Write(requestID, rawData); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// SyncDo - sends 'order.test' request and receives response
+func (s *OrderTestWsService) SyncDo(requestID string, request *OrderTestWsRequest) (*OrderTestWsResponse, error) {
+	rawData, err := websocket.CreateRequest(
+		websocket.NewRequestData(
+			requestID,
+			s.ApiKey,
+			s.SecretKey,
+			s.TimeOffset,
+			s.KeyType,
+		).WithSigner(s.Signer).WithSignerCache(s.signers),
+		websocket.OrderTestSpotWsApiMethod,
+		request.buildParams(),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := s.c.WriteSync(requestID, rawData, websocket.WriteSyncWsTimeout)
+	if err != nil {
+		return nil, err
+	}
+
+	orderTestWsResponse := &OrderTestWsResponse{}
+	if err := json.Unmarshal(response, orderTestWsResponse); err != nil {
+		return nil, err
+	}
+
+	return orderTestWsResponse, nil
+}
+
+// ReceiveAllDataBeforeStop waits until all responses will be received from websocket until timeout expired
+func (s *OrderTestWsService) ReceiveAllDataBeforeStop(timeout time.Duration) {
+	s.c.Wait(timeout)
+}
+
+// GetReadChannel returns channel with API response data (including API errors)
+func (s *OrderTestWsService) GetReadChannel() <-chan []byte {
+	return s.c.GetReadChannel()
+}
+
+// GetReadErrorChannel returns channel with errors which are occurred while reading websocket connection
+func (s *OrderTestWsService) GetReadErrorChannel() <-chan error {
+	return s.c.GetReadErrorChannel()
+}
+
+// GetReconnectCount returns count of reconnect attempts by client
+func (s *OrderTestWsService) GetReconnectCount() int64 {
+	return s.c.GetReconnectCount()
+}
+
+// OrderTestResult define 'order.test' result, empty unless the commission rates are computed
+type OrderTestResult struct {
+	StandardCommissionForOrder *CommissionGroup `json:"standardCommissionForOrder,omitempty"`
+	TaxCommissionForOrder      *CommissionGroup `json:"taxCommissionForOrder,omitempty"`
+	Discount                   *DiscountInfo    `json:"discount,omitempty"`
+}
+
+// OrderTestWsResponse define 'order.test' websocket API response
+type OrderTestWsResponse struct {
+	Id     string          `json:"id"`
+	Status int             `json:"status"`
+	Result OrderTestResult `json:"result"`
+
+	// error response
+	Error *common.APIError `json:"error,omitempty"`
+}
@@ -0,0 +1,102 @@
+package binance
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/adshao/go-binance/v2/common/websocket"
+	"github.com/adshao/go-binance/v2/common/websocket/mock"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/suite"
+)
+
+type orderTestServiceWsTestSuite struct {
+	suite.Suite
+
+	ctrl   *gomock.Controller
+	client *mock.MockClient
+
+	requestID string
+
+	service *OrderTestWsService
+	request *OrderTestWsRequest
+}
+
+func (s *orderTestServiceWsTestSuite) SetupTest() {
+	s.requestID = "e2a85d9f-07a5-4f94-8d5f-789dc3deb098"
+
+	s.ctrl = gomock.NewController(s.T())
+	s.client = mock.NewMockClient(s.ctrl)
+
+	s.service = &OrderTestWsService{
+		c:         s.client,
+		ApiKey:    "dummyApiKey",
+		SecretKey: "dummySecretKey",
+		KeyType:   "HMAC",
+	}
+
+	s.request = NewOrderTestWsRequest(NewOrderCreateWsRequest().
+		Symbol("BTCUSDT").
+		Side(SideTypeBuy).
+		Type(OrderTypeMarket).
+		Quantity("0.1").
+		NewOrderRespType(NewOrderRespTypeACK)).
+		ComputeCommissionRates(true)
+}
+
+func (s *orderTestServiceWsTestSuite) TearDownTest() {
+	s.ctrl.Finish()
+}
+
+func TestOrderTestWsService(t *testing.T) {
+	suite.Run(t, new(orderTestServiceWsTestSuite))
+}
+
+func (s *orderTestServiceWsTestSuite) TestDo() {
+	var data []byte
+	s.client.EXPECT().Write(s.requestID, gomock.Any()).DoAndReturn(func(id string, raw []byte) error {
+		data = raw
+		return nil
+	}).Times(1)
+
+	err := s.service.Do(s.requestID, s.request)
+	s.Require().NoError(err)
+
+	req := websocket.WsApiRequest{}
+	s.Require().NoError(json.Unmarshal(data, &req))
+	s.Equal(s.requestID, req.Id)
+	s.Equal(websocket.WsApiMethodType("order.test"), req.Method)
+	for k, v := range map[string]interface{}{"symbol": "BTCUSDT", "side": "BUY", "type": "MARKET", "quantity": "0.1", "computeCommissionRates": true} {
+		s.Equal(v, req.Params[k], k)
+	}
+	s.Contains(req.Params, "signature")
+}
+
+func (s *orderTestServiceWsTestSuite) TestDo_EmptyApiKey() {
+	s.service.ApiKey = ""
+	s.client.EXPECT().Write(gomock.Any(), gomock.Any()).Times(0)
+
+	err := s.service.Do(s.requestID, s.request)
+	s.ErrorIs(err, websocket.ErrorApiKeyIsNotSet)
+}
+
+func (s *orderTestServiceWsTestSuite) TestSyncDo() {
+	rawResponseData := []byte(fmt.Sprintf(`{"id": "%s", "status": 200, "result": {"standardCommissionForOrder": {"maker": "0.00000112", "taker": "0.00000114"}, "taxCommissionForOrder": {"maker": "0.00000112", "taker": "0.00000114"}, "discount": {"enabledForAccount": true, "enabledForSymbol": true, "discountAsset": "BNB", "discount": "0.25000000"}}}`, s.requestID))
+	s.client.EXPECT().WriteSync(s.requestID, gomock.Any(), gomock.Any()).Return(rawResponseData, nil).Times(1)
+
+	response, err := s.service.SyncDo(s.requestID, s.request)
+	s.Require().NoError(err)
+	s.Equal(s.requestID, response.Id)
+	s.Require().NotNil(response.Result.StandardCommissionForOrder)
+	s.Equal("0.00000114", response.Result.StandardCommissionForOrder.Taker)
+	s.Equal("0.25000000", response.Result.Discount.Discount)
+}
+
+func (s *orderTestServiceWsTestSuite) TestSyncDo_EmptyRequestID() {
+	s.client.EXPECT().WriteSync(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+	response, err := s.service.SyncDo("", s.request)
+	s.Nil(response)
+	s.ErrorIs(err, websocket.ErrorRequestIDNotSet)
+}
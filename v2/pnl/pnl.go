@@ -0,0 +1,388 @@
+// Package pnl computes the realized and unrealized profit and loss of spot fills and
+// futures income.
+//
+// The engine keeps the cost basis of every asset as lots valued in a quote asset, the
+// lots being matched on disposal first in first out, last in first out, or at their
+// average cost. A spot fill acquires an asset and disposes of another, commissions are
+// added to the cost of the acquired asset or deducted from the proceeds of the disposed
+// one, commissions paid in a third asset like BNB disposing of that asset too. Assets
+// other than the quote asset are valued at fill-time prices from a Pricer:
+//
+//	e := pnl.NewEngine(pnl.MethodFIFO, "USDT")
+//	e.Pricer = pnl.NewKlinePricer(client)
+//	fills, err := pnl.Trades(ctx, client, 0, "BTCUSDT", "BNBUSDT")
+//	err = e.Add(ctx, fills...)
+//	report := e.Report(start, end, marks)
+package pnl
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// Method define how disposals are matched against the lots of an asset
+type Method string
+
+// Market define the market of a symbol
+type Market string
+
+// IncomeType define the type of a futures income
+type IncomeType string
+
+// Global enums
+const (
+	// MethodFIFO matches the oldest lots first
+	MethodFIFO Method = "FIFO"
+	// MethodLIFO matches the newest lots first
+	MethodLIFO Method = "LIFO"
+	// MethodAverage matches every lot pro rata, at the average cost
+	MethodAverage Method = "AVERAGE"
+
+	MarketSpot    Market = "SPOT"
+	MarketFutures Market = "FUTURES"
+
+	IncomeTypeRealizedPnL IncomeType = "REALIZED_PNL"
+	IncomeTypeCommission  IncomeType = "COMMISSION"
+)
+
+var (
+	// ErrOutOfOrder is returned when a fill is older than the fills already added
+	ErrOutOfOrder = errors.New("pnl: fill out of order")
+	// ErrNoPrice is returned when an asset must be valued without a pricer
+	ErrNoPrice = errors.New("pnl: no pricer")
+)
+
+// Pricer return the price of an asset in a quote asset at a time
+type Pricer interface {
+	Price(ctx context.Context, asset, quote string, at time.Time) (decimal.Decimal, error)
+}
+
+// PriceFunc adapt a function to a Pricer
+type PriceFunc func(ctx context.Context, asset, quote string, at time.Time) (decimal.Decimal, error)
+
+// Price call f
+func (f PriceFunc) Price(ctx context.Context, asset, quote string, at time.Time) (decimal.Decimal, error) {
+	return f(ctx, asset, quote, at)
+}
+
+// Fill define a spot trade of the account
+type Fill struct {
+	Symbol          string
+	Base            string
+	Quote           string
+	TradeID         int64
+	Time            time.Time
+	IsBuyer         bool
+	Quantity        decimal.Decimal
+	QuoteQuantity   decimal.Decimal
+	Commission      decimal.Decimal
+	CommissionAsset string
+}
+
+// Income define a futures income record
+type Income struct {
+	Symbol string
+	Asset  string
+	Type   IncomeType
+	TranID int64
+	Amount decimal.Decimal
+	Time   time.Time
+}
+
+// Lot define a quantity of an asset acquired at a cost, in the quote asset of the engine
+type Lot struct {
+	Symbol   string // symbol of the acquisition, empty for opening balances
+	Time     time.Time
+	Quantity decimal.Decimal
+	Cost     decimal.Decimal
+}
+
+// Realization define the profit or loss realized by a disposal or a futures income
+type Realization struct {
+	Market   Market
+	Symbol   string
+	Asset    string
+	Time     time.Time
+	Quantity decimal.Decimal
+	Proceeds decimal.Decimal
+	Cost     decimal.Decimal
+	// Unmatched is the quantity disposed of without lots, held before the history. Its
+	// cost basis is unknown, so PnL only counts the proceeds of the matched quantity.
+	Unmatched decimal.Decimal
+	PnL       decimal.Decimal
+}
+
+// Commission define a commission paid, in the quote asset of the engine
+type Commission struct {
+	Market Market
+	Symbol string
+	Time   time.Time
+	Asset  string
+	Amount decimal.Decimal
+	Value  decimal.Decimal
+}
+
+// Engine compute the profit and loss of fills and incomes, it is safe for concurrent use
+type Engine struct {
+	Method Method
+	// Quote is the asset of the cost basis and of the profit and loss
+	Quote string
+	// Pricer values the assets other than Quote, required when trading them
+	Pricer Pricer
+
+	mu          sync.Mutex
+	lots        map[string][]Lot
+	realized    []Realization
+	commissions []Commission
+	seen        map[string]bool
+	last        time.Time
+}
+
+// NewEngine init an engine matching lots with method and valuing them in quote
+func NewEngine(method Method, quote string) *Engine {
+	return &Engine{
+		Method: method,
+		Quote:  quote,
+		lots:   map[string][]Lot{},
+		seen:   map[string]bool{},
+	}
+}
+
+// Open add an opening balance of asset acquired at cost
+func (e *Engine) Open(asset string, quantity, cost decimal.Decimal, at time.Time) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.acquire(asset, Lot{Time: at, Quantity: quantity, Cost: cost})
+}
+
+// Lots return the open lots of asset
+func (e *Engine) Lots(asset string) []Lot {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	return append([]Lot(nil), e.lots[asset]...)
+}
+
+// Realized return the realizations, ordered by time
+func (e *Engine) Realized() []Realization {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	res := append([]Realization(nil), e.realized...)
+	sort.SliceStable(res, func(i, j int) bool { return res[i].Time.Before(res[j].Time) })
+	return res
+}
+
+// Add add fills in time order. Fills already added, like the ones of both the trade
+// history and the user stream, are ignored, fills older than the last added fill fail
+// with ErrOutOfOrder. On failure the fills before the failed one are kept.
+func (e *Engine) Add(ctx context.Context, fills ...Fill) error {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	sorted := append([]Fill(nil), fills...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		if !sorted[i].Time.Equal(sorted[j].Time) {
+			return sorted[i].Time.Before(sorted[j].Time)
+		}
+		return sorted[i].TradeID < sorted[j].TradeID
+	})
+	for _, f := range sorted {
+		key := fmt.Sprintf("fill:%s:%d", f.Symbol, f.TradeID)
+		if e.seen[key] {
+			continue
+		}
+		if f.Time.Before(e.last) {
+			return fmt.Errorf("%w: %s trade %d at %s before %s", ErrOutOfOrder, f.Symbol, f.TradeID, f.Time, e.last)
+		}
+		if err := e.fill(ctx, f); err != nil {
+			return fmt.Errorf("pnl: %s trade %d: %w", f.Symbol, f.TradeID, err)
+		}
+		e.seen[key] = true
+		e.last = f.Time
+	}
+	return nil
+}
+
+// AddIncome add futures income, the incomes already added are ignored
+func (e *Engine) AddIncome(ctx context.Context, incomes ...Income) error {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	for _, in := range incomes {
+		key := fmt.Sprintf("income:%s:%d", in.Type, in.TranID)
+		if e.seen[key] {
+			continue
+		}
+		rate, err := e.price(ctx, in.Asset, in.Time)
+		if err != nil {
+			return fmt.Errorf("pnl: %s income %d: %w", in.Type, in.TranID, err)
+		}
+		value := in.Amount.Mul(rate)
+		e.realized = append(e.realized, Realization{
+			Market: MarketFutures,
+			Symbol: in.Symbol,
+			Asset:  in.Asset,
+			Time:   in.Time,
+			PnL:    value,
+		})
+		if in.Type == IncomeTypeCommission {
+			e.commissions = append(e.commissions, Commission{
+				Market: MarketFutures,
+				Symbol: in.Symbol,
+				Time:   in.Time,
+				Asset:  in.Asset,
+				Amount: in.Amount.Neg(),
+				Value:  value.Neg(),
+			})
+		}
+		e.seen[key] = true
+	}
+	return nil
+}
+
+// price return the price of asset in the quote asset of the engine
+func (e *Engine) price(ctx context.Context, asset string, at time.Time) (decimal.Decimal, error) {
+	if asset == e.Quote {
+		return decimal.NewFromInt(1), nil
+	}
+	if e.Pricer == nil {
+		return decimal.Zero, fmt.Errorf("%w to value %s in %s", ErrNoPrice, asset, e.Quote)
+	}
+	return e.Pricer.Price(ctx, asset, e.Quote, at)
+}
+
+func (e *Engine) fill(ctx context.Context, f Fill) error {
+	rate, err := e.price(ctx, f.Quote, f.Time)
+	if err != nil {
+		return err
+	}
+	value := f.QuoteQuantity.Mul(rate)
+
+	var commission decimal.Decimal
+	switch f.CommissionAsset {
+	case "":
+	case f.Quote:
+		commission = f.Commission.Mul(rate)
+	case f.Base:
+		if f.Quantity.IsPositive() {
+			commission = f.Commission.Mul(value).Div(f.Quantity)
+		}
+	default:
+		p, err := e.price(ctx, f.CommissionAsset, f.Time)
+		if err != nil {
+			return err
+		}
+		commission = f.Commission.Mul(p)
+	}
+	if f.Commission.IsPositive() {
+		e.commissions = append(e.commissions, Commission{
+			Market: MarketSpot,
+			Symbol: f.Symbol,
+			Time:   f.Time,
+			Asset:  f.CommissionAsset,
+			Amount: f.Commission,
+			Value:  commission,
+		})
+	}
+
+	acquired, acquiredQty, disposed, disposedQty := f.Base, f.Quantity, f.Quote, f.QuoteQuantity
+	if !f.IsBuyer {
+		acquired, acquiredQty, disposed, disposedQty = f.Quote, f.QuoteQuantity, f.Base, f.Quantity
+	}
+	cost, proceeds := value, value
+	switch {
+	case f.CommissionAsset == acquired:
+		// the commission is deducted from the quantity acquired
+		acquiredQty = acquiredQty.Sub(f.Commission)
+		if acquired == e.Quote {
+			proceeds = proceeds.Sub(commission)
+		}
+	case acquired == e.Quote:
+		proceeds = proceeds.Sub(commission)
+	default:
+		cost = cost.Add(commission)
+	}
+	if acquired != e.Quote {
+		e.acquire(acquired, Lot{Symbol: f.Symbol, Time: f.Time, Quantity: acquiredQty, Cost: cost})
+	}
+	if disposed != e.Quote {
+		e.dispose(f.Symbol, disposed, disposedQty, proceeds, f.Time)
+	}
+	// a commission paid in a third asset disposes of it at its value
+	if f.Commission.IsPositive() && f.CommissionAsset != acquired && f.CommissionAsset != e.Quote && f.CommissionAsset != "" {
+		e.dispose(f.Symbol, f.CommissionAsset, f.Commission, commission, f.Time)
+	}
+	return nil
+}
+
+func (e *Engine) acquire(asset string, lot Lot) {
+	if !lot.Quantity.IsPositive() {
+		return
+	}
+	e.lots[asset] = append(e.lots[asset], lot)
+}
+
+// dispose match quantity of asset against its lots and record the realization
+func (e *Engine) dispose(symbol, asset string, quantity, proceeds decimal.Decimal, at time.Time) {
+	if !quantity.IsPositive() {
+		return
+	}
+	lots := e.lots[asset]
+	r := Realization{
+		Market:   MarketSpot,
+		Symbol:   symbol,
+		Asset:    asset,
+		Time:     at,
+		Quantity: quantity,
+		Proceeds: proceeds,
+	}
+	remaining := quantity
+	switch e.Method {
+	case MethodAverage:
+		total, cost := decimal.Zero, decimal.Zero
+		for _, l := range lots {
+			total = total.Add(l.Quantity)
+			cost = cost.Add(l.Cost)
+		}
+		if total.LessThanOrEqual(remaining) {
+			r.Cost, remaining, lots = cost, remaining.Sub(total), nil
+			break
+		}
+		// every lot keeps its share of the quantity and of the cost
+		keep := total.Sub(remaining).Div(total)
+		for i := range lots {
+			q := lots[i].Quantity.Mul(keep)
+			c := lots[i].Cost.Mul(keep)
+			r.Cost = r.Cost.Add(lots[i].Cost.Sub(c))
+			lots[i].Quantity, lots[i].Cost = q, c
+		}
+		remaining = decimal.Zero
+	default:
+		for remaining.IsPositive() && len(lots) > 0 {
+			i := 0
+			if e.Method == MethodLIFO {
+				i = len(lots) - 1
+			}
+			l := &lots[i]
+			if l.Quantity.LessThanOrEqual(remaining) {
+				r.Cost = r.Cost.Add(l.Cost)
+				remaining = remaining.Sub(l.Quantity)
+				lots = append(lots[:i], lots[i+1:]...)
+				continue
+			}
+			c := l.Cost.Mul(remaining).Div(l.Quantity)
+			r.Cost = r.Cost.Add(c)
+			l.Cost = l.Cost.Sub(c)
+			l.Quantity = l.Quantity.Sub(remaining)
+			remaining = decimal.Zero
+		}
+	}
+	e.lots[asset] = lots
+	r.Unmatched = remaining
+	matched := quantity.Sub(remaining)
+	r.PnL = r.Proceeds.Mul(matched).Div(quantity).Sub(r.Cost)
+	e.realized = append(e.realized, r)
+}
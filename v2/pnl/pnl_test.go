@@ -0,0 +1,228 @@
+package pnl
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/suite"
+)
+
+type engineTestSuite struct {
+	suite.Suite
+	t0     time.Time
+	prices map[string]string
+	priced []string
+}
+
+func TestEngine(t *testing.T) {
+	suite.Run(t, new(engineTestSuite))
+}
+
+func (s *engineTestSuite) SetupTest() {
+	s.t0 = time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	s.prices = map[string]string{"BNB": "300", "BTC": "50000"}
+	s.priced = nil
+}
+
+func (s *engineTestSuite) dec(v string) decimal.Decimal {
+	return decimal.RequireFromString(v)
+}
+
+func (s *engineTestSuite) assertDec(expected string, actual decimal.Decimal, msgAndArgs ...interface{}) {
+	s.True(s.dec(expected).Equal(actual), "expected %s, actual %s %v", expected, actual, msgAndArgs)
+}
+
+func (s *engineTestSuite) newEngine(method Method) *Engine {
+	e := NewEngine(method, "USDT")
+	e.Pricer = PriceFunc(func(ctx context.Context, asset, quote string, at time.Time) (decimal.Decimal, error) {
+		s.Equal("USDT", quote)
+		s.priced = append(s.priced, asset)
+		p, ok := s.prices[asset]
+		if !ok {
+			return decimal.Zero, errors.New("no price")
+		}
+		return s.dec(p), nil
+	})
+	e.Open("BNB", s.dec("10"), s.dec("2000"), s.t0)
+	return e
+}
+
+func (s *engineTestSuite) fills() []Fill {
+	return []Fill{
+		// the sell is added first, the fills are sorted
+		{Symbol: "BTCUSDT", Base: "BTC", Quote: "USDT", TradeID: 3, Time: s.t0.Add(50 * time.Hour), Quantity: s.dec("1.5"),
+			QuoteQuantity: s.dec("75000"), Commission: s.dec("75"), CommissionAsset: "USDT"},
+		{Symbol: "BTCUSDT", Base: "BTC", Quote: "USDT", TradeID: 1, Time: s.t0.Add(time.Hour), IsBuyer: true, Quantity: s.dec("1"),
+			QuoteQuantity: s.dec("30000"), Commission: s.dec("30"), CommissionAsset: "USDT"},
+		{Symbol: "BTCUSDT", Base: "BTC", Quote: "USDT", TradeID: 2, Time: s.t0.Add(25 * time.Hour), IsBuyer: true, Quantity: s.dec("1"),
+			QuoteQuantity: s.dec("40000"), Commission: s.dec("0.1"), CommissionAsset: "BNB"},
+	}
+}
+
+func (s *engineTestSuite) TestFIFO() {
+	e := s.newEngine(MethodFIFO)
+	s.Require().NoError(e.Add(context.Background(), s.fills()...))
+	s.Equal([]string{"BNB"}, s.priced)
+
+	realized := e.Realized()
+	s.Require().Len(realized, 2)
+	s.Equal("BNB", realized[0].Asset)
+	s.assertDec("30", realized[0].Proceeds)
+	s.assertDec("20", realized[0].Cost)
+	s.assertDec("10", realized[0].PnL)
+	s.Equal("BTC", realized[1].Asset)
+	s.assertDec("74925", realized[1].Proceeds)
+	s.assertDec("50045", realized[1].Cost)
+	s.assertDec("24880", realized[1].PnL)
+	s.True(realized[1].Unmatched.IsZero())
+
+	lots := e.Lots("BTC")
+	s.Require().Len(lots, 1)
+	s.assertDec("0.5", lots[0].Quantity)
+	s.assertDec("20015", lots[0].Cost)
+
+	r := e.Report(s.t0, s.t0.Add(72*time.Hour), map[string]decimal.Decimal{"BTC": s.dec("50000"), "BNB": s.dec("300")})
+	s.assertDec("24890", r.Realized)
+	s.assertDec("135", r.Commission)
+	s.assertDec("5975", r.Unrealized)
+	btc := r.Symbol("BTCUSDT")
+	s.Require().NotNil(btc)
+	s.assertDec("24890", btc.Realized)
+	s.assertDec("135", btc.Commission)
+	s.assertDec("4985", btc.Unrealized)
+	s.Require().Len(r.Positions, 2)
+	s.Equal("BNB", r.Positions[0].Asset)
+	s.assertDec("9.9", r.Positions[0].Quantity)
+	s.assertDec("200", r.Positions[0].AverageCost())
+	s.assertDec("990", r.Positions[0].Unrealized)
+	s.assertDec("25000", r.Positions[1].Value)
+}
+
+func (s *engineTestSuite) TestLIFO() {
+	e := s.newEngine(MethodLIFO)
+	s.Require().NoError(e.Add(context.Background(), s.fills()...))
+	realized := e.Realized()
+	s.assertDec("55045", realized[1].Cost)
+	s.assertDec("19880", realized[1].PnL)
+	lots := e.Lots("BTC")
+	s.Require().Len(lots, 1)
+	s.Equal(s.t0.Add(time.Hour), lots[0].Time)
+	s.assertDec("15015", lots[0].Cost)
+}
+
+func (s *engineTestSuite) TestAverage() {
+	e := s.newEngine(MethodAverage)
+	s.Require().NoError(e.Add(context.Background(), s.fills()...))
+	realized := e.Realized()
+	s.assertDec("52545", realized[1].Cost)
+	s.assertDec("22380", realized[1].PnL)
+	lots := e.Lots("BTC")
+	s.Require().Len(lots, 2)
+	s.assertDec("0.25", lots[0].Quantity)
+	s.assertDec("7507.5", lots[0].Cost)
+	s.assertDec("10007.5", lots[1].Cost)
+}
+
+func (s *engineTestSuite) TestPartialMatch() {
+	e := s.newEngine(MethodFIFO)
+	s.Require().NoError(e.Add(context.Background(), s.fills()[1],
+		Fill{Symbol: "BTCUSDT", Base: "BTC", Quote: "USDT", TradeID: 4, Time: s.t0.Add(2 * time.Hour), Quantity: s.dec("2"),
+			QuoteQuantity: s.dec("100000"), CommissionAsset: "USDT"},
+	))
+	realized := e.Realized()
+	s.Require().Len(realized, 1)
+	s.assertDec("100000", realized[0].Proceeds)
+	s.assertDec("30030", realized[0].Cost)
+	s.assertDec("1", realized[0].Unmatched)
+	// only the proceeds of the matched bitcoin are realized
+	s.assertDec("19970", realized[0].PnL)
+	s.Empty(e.Lots("BTC"))
+}
+
+func (s *engineTestSuite) TestCommissionInBase() {
+	e := s.newEngine(MethodFIFO)
+	s.Require().NoError(e.Add(context.Background(),
+		Fill{Symbol: "ETHBTC", Base: "ETH", Quote: "BTC", TradeID: 1, Time: s.t0, IsBuyer: true, Quantity: s.dec("10"),
+			QuoteQuantity: s.dec("0.5"), Commission: s.dec("0.01"), CommissionAsset: "ETH"},
+	))
+	lots := e.Lots("ETH")
+	s.Require().Len(lots, 1)
+	s.assertDec("9.99", lots[0].Quantity)
+	s.assertDec("25000", lots[0].Cost)
+	// the bitcoins were held before the history
+	realized := e.Realized()
+	s.Require().Len(realized, 1)
+	s.Equal("BTC", realized[0].Asset)
+	s.assertDec("0.5", realized[0].Unmatched)
+	s.True(realized[0].PnL.IsZero())
+	r := e.Report(s.t0, s.t0.Add(time.Hour), nil)
+	s.assertDec("25", r.Commission)
+	s.Empty(r.Positions)
+}
+
+func (s *engineTestSuite) TestAddDuplicateAndOutOfOrder() {
+	e := s.newEngine(MethodFIFO)
+	fills := s.fills()
+	s.Require().NoError(e.Add(context.Background(), fills[1]))
+	s.Require().NoError(e.Add(context.Background(), fills...))
+	s.Len(e.Realized(), 2)
+	s.Require().NoError(e.Add(context.Background(), fills...))
+	s.Len(e.Realized(), 2)
+
+	late := fills[1]
+	late.TradeID = 4
+	s.ErrorIs(e.Add(context.Background(), late), ErrOutOfOrder)
+}
+
+func (s *engineTestSuite) TestNoPrice() {
+	e := NewEngine(MethodFIFO, "USDT")
+	err := e.Add(context.Background(), s.fills()...)
+	s.ErrorIs(err, ErrNoPrice)
+	s.ErrorContains(err, "pnl: BTCUSDT trade 2: pnl: no pricer to value BNB in USDT")
+	// the fills before the failure are kept
+	s.Len(e.Lots("BTC"), 1)
+}
+
+func (s *engineTestSuite) TestIncome() {
+	e := s.newEngine(MethodFIFO)
+	incomes := []Income{
+		{Symbol: "BTCUSDT", Asset: "USDT", Type: IncomeTypeRealizedPnL, TranID: 1, Amount: s.dec("100"), Time: s.t0},
+		{Symbol: "BTCUSDT", Asset: "USDT", Type: IncomeTypeCommission, TranID: 2, Amount: s.dec("-4"), Time: s.t0},
+		{Symbol: "BTCUSDT", Asset: "BNB", Type: IncomeTypeCommission, TranID: 3, Amount: s.dec("-0.01"), Time: s.t0},
+	}
+	s.Require().NoError(e.AddIncome(context.Background(), incomes...))
+	s.Require().NoError(e.AddIncome(context.Background(), incomes...))
+	r := e.Report(s.t0, s.t0.Add(time.Hour), nil)
+	s.Require().Len(r.Symbols, 1)
+	s.Equal(MarketFutures, r.Symbols[0].Market)
+	s.Nil(r.Symbol("BTCUSDT"))
+	s.assertDec("93", r.Symbols[0].Realized)
+	s.assertDec("7", r.Symbols[0].Commission)
+}
+
+func (s *engineTestSuite) TestPeriods() {
+	e := s.newEngine(MethodFIFO)
+	s.Require().NoError(e.Add(context.Background(), s.fills()...))
+	reports := e.Periods(PeriodDay, s.t0.Add(time.Hour), s.t0.Add(72*time.Hour))
+	s.Require().Len(reports, 3)
+	s.Equal(s.t0, reports[0].Start)
+	s.Equal(s.t0.Add(24*time.Hour), reports[0].End)
+	s.True(reports[0].Realized.IsZero())
+	s.assertDec("30", reports[0].Commission)
+	s.assertDec("10", reports[1].Realized)
+	s.assertDec("24880", reports[2].Realized)
+	s.Empty(reports[2].Positions)
+}
+
+func (s *engineTestSuite) TestPeriod() {
+	at := time.Date(2024, 3, 17, 15, 4, 5, 0, time.UTC) // a Sunday
+	s.Equal(time.Date(2024, 3, 17, 0, 0, 0, 0, time.UTC), PeriodDay.Start(at))
+	s.Equal(time.Date(2024, 3, 11, 0, 0, 0, 0, time.UTC), PeriodWeek.Start(at))
+	s.Equal(time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC), PeriodMonth.Start(at))
+	s.Equal(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC), PeriodYear.Start(at))
+	s.Equal(time.Date(2024, 4, 1, 0, 0, 0, 0, time.UTC), PeriodMonth.Next(PeriodMonth.Start(at)))
+	s.Equal(time.Date(2024, 3, 18, 0, 0, 0, 0, time.UTC), PeriodWeek.Next(PeriodWeek.Start(at)))
+}
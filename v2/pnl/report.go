@@ -0,0 +1,192 @@
+package pnl
+
+import (
+	"sort"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// Period define the length of the periods of a report
+type Period string
+
+// Global enums
+const (
+	PeriodDay   Period = "DAY"
+	PeriodWeek  Period = "WEEK"
+	PeriodMonth Period = "MONTH"
+	PeriodYear  Period = "YEAR"
+)
+
+// Start return the start of the period containing t, in UTC. Weeks start on Monday.
+func (p Period) Start(t time.Time) time.Time {
+	t = t.UTC()
+	switch p {
+	case PeriodWeek:
+		day := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+		return day.AddDate(0, 0, -(int(day.Weekday())+6)%7)
+	case PeriodMonth:
+		return time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, time.UTC)
+	case PeriodYear:
+		return time.Date(t.Year(), 1, 1, 0, 0, 0, 0, time.UTC)
+	}
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
+
+// Next return the start of the period following the one starting at start
+func (p Period) Next(start time.Time) time.Time {
+	switch p {
+	case PeriodWeek:
+		return start.AddDate(0, 0, 7)
+	case PeriodMonth:
+		return start.AddDate(0, 1, 0)
+	case PeriodYear:
+		return start.AddDate(1, 0, 0)
+	}
+	return start.AddDate(0, 0, 1)
+}
+
+// SymbolPnL define the profit and loss of a symbol
+type SymbolPnL struct {
+	Market Market
+	Symbol string
+	// Realized is net of the commissions
+	Realized   decimal.Decimal
+	Commission decimal.Decimal
+	Unrealized decimal.Decimal
+}
+
+// Position define the open lots of an asset
+type Position struct {
+	Asset    string
+	Quantity decimal.Decimal
+	Cost     decimal.Decimal
+	Mark     decimal.Decimal
+	Value    decimal.Decimal
+	// Priced is false when no mark was given, the position is then not in the unrealized
+	// profit and loss
+	Priced     bool
+	Unrealized decimal.Decimal
+}
+
+// AverageCost return the cost of a unit of the position
+func (p *Position) AverageCost() decimal.Decimal {
+	if p.Quantity.IsZero() {
+		return decimal.Zero
+	}
+	return p.Cost.Div(p.Quantity)
+}
+
+// Report define the profit and loss realized in a period and unrealized at the end of
+// the fills added
+type Report struct {
+	Quote      string
+	Start      time.Time
+	End        time.Time
+	Symbols    []SymbolPnL
+	Positions  []Position
+	Realized   decimal.Decimal
+	Commission decimal.Decimal
+	Unrealized decimal.Decimal
+}
+
+// Symbol return the profit and loss of the spot symbol, nil if none
+func (r *Report) Symbol(symbol string) *SymbolPnL {
+	for i := range r.Symbols {
+		if r.Symbols[i].Market == MarketSpot && r.Symbols[i].Symbol == symbol {
+			return &r.Symbols[i]
+		}
+	}
+	return nil
+}
+
+// Report return the profit and loss realized in [start, end) and the positions valued
+// at marks, the prices of the assets in the quote asset. Without marks the report has no
+// positions.
+func (e *Engine) Report(start, end time.Time, marks map[string]decimal.Decimal) *Report {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	return e.report(start, end, marks)
+}
+
+// Periods return the reports of the periods between start and end, without positions
+func (e *Engine) Periods(period Period, start, end time.Time) []*Report {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	var res []*Report
+	for s := period.Start(start); s.Before(end); s = period.Next(s) {
+		res = append(res, e.report(s, period.Next(s), nil))
+	}
+	return res
+}
+
+func (e *Engine) report(start, end time.Time, marks map[string]decimal.Decimal) *Report {
+	r := &Report{Quote: e.Quote, Start: start, End: end}
+	type key struct {
+		market Market
+		symbol string
+	}
+	symbols := map[key]*SymbolPnL{}
+	get := func(market Market, symbol string) *SymbolPnL {
+		k := key{market, symbol}
+		if symbols[k] == nil {
+			symbols[k] = &SymbolPnL{Market: market, Symbol: symbol}
+		}
+		return symbols[k]
+	}
+	in := func(t time.Time) bool {
+		return !t.Before(start) && t.Before(end)
+	}
+	for _, x := range e.realized {
+		if in(x.Time) {
+			get(x.Market, x.Symbol).Realized = get(x.Market, x.Symbol).Realized.Add(x.PnL)
+			r.Realized = r.Realized.Add(x.PnL)
+		}
+	}
+	for _, c := range e.commissions {
+		if in(c.Time) {
+			get(c.Market, c.Symbol).Commission = get(c.Market, c.Symbol).Commission.Add(c.Value)
+			r.Commission = r.Commission.Add(c.Value)
+		}
+	}
+	if marks != nil {
+		assets := make([]string, 0, len(e.lots))
+		for asset := range e.lots {
+			assets = append(assets, asset)
+		}
+		sort.Strings(assets)
+		for _, asset := range assets {
+			lots := e.lots[asset]
+			if len(lots) == 0 {
+				continue
+			}
+			p := Position{Asset: asset}
+			p.Mark, p.Priced = marks[asset]
+			for _, l := range lots {
+				p.Quantity = p.Quantity.Add(l.Quantity)
+				p.Cost = p.Cost.Add(l.Cost)
+				if p.Priced && l.Symbol != "" {
+					u := l.Quantity.Mul(p.Mark).Sub(l.Cost)
+					s := get(MarketSpot, l.Symbol)
+					s.Unrealized = s.Unrealized.Add(u)
+				}
+			}
+			if p.Priced {
+				p.Value = p.Quantity.Mul(p.Mark)
+				p.Unrealized = p.Value.Sub(p.Cost)
+				r.Unrealized = r.Unrealized.Add(p.Unrealized)
+			}
+			r.Positions = append(r.Positions, p)
+		}
+	}
+	for _, s := range symbols {
+		r.Symbols = append(r.Symbols, *s)
+	}
+	sort.Slice(r.Symbols, func(i, j int) bool {
+		if r.Symbols[i].Market != r.Symbols[j].Market {
+			return r.Symbols[i].Market > r.Symbols[j].Market
+		}
+		return r.Symbols[i].Symbol < r.Symbols[j].Symbol
+	})
+	return r
+}
@@ -0,0 +1,193 @@
+package pnl
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/shopspring/decimal"
+
+	"github.com/adshao/go-binance/v2"
+	"github.com/adshao/go-binance/v2/common"
+	"github.com/adshao/go-binance/v2/futures"
+	"github.com/adshao/go-binance/v2/internal/decparse"
+)
+
+const (
+	tradesLimit = 1000
+
+	executionTypeTrade = "TRADE"
+
+	// invalidSymbolCode is the API error code of an unknown symbol
+	invalidSymbolCode = -1121
+)
+
+// FillFromTrade return the fill of a trade of the symbol of base and quote assets
+func FillFromTrade(t *binance.TradeV3, base, quote string) (Fill, error) {
+	p := new(decparse.Parser)
+	f := Fill{
+		Symbol:          t.Symbol,
+		Base:            base,
+		Quote:           quote,
+		TradeID:         t.ID,
+		Time:            time.UnixMilli(t.Time).UTC(),
+		IsBuyer:         t.IsBuyer,
+		Quantity:        p.Dec("qty", t.Quantity),
+		QuoteQuantity:   p.Dec("quoteQty", t.QuoteQuantity),
+		Commission:      p.Dec("commission", t.Commission),
+		CommissionAsset: t.CommissionAsset,
+	}
+	return f, p.Err
+}
+
+// FillFromOrderUpdate return the fill of an executionReport event of the user stream,
+// ok is false when the event is not a trade
+func FillFromOrderUpdate(u *binance.WsOrderUpdate, base, quote string) (f Fill, ok bool, err error) {
+	if u.ExecutionType != executionTypeTrade {
+		return Fill{}, false, nil
+	}
+	p := new(decparse.Parser)
+	f = Fill{
+		Symbol:          u.Symbol,
+		Base:            base,
+		Quote:           quote,
+		TradeID:         u.TradeId,
+		Time:            time.UnixMilli(u.TransactionTime).UTC(),
+		IsBuyer:         u.Side == string(binance.SideTypeBuy),
+		Quantity:        p.Dec("l", u.LatestVolume),
+		QuoteQuantity:   p.Dec("Y", u.LatestQuoteVolume),
+		Commission:      p.Dec("n", u.FeeCost),
+		CommissionAsset: u.FeeAsset,
+	}
+	return f, p.Err == nil, p.Err
+}
+
+// IncomeFromHistory return the income of a futures income record, ok is false for the
+// incomes other than realized profit and loss and commissions
+func IncomeFromHistory(h *futures.IncomeHistory) (in Income, ok bool, err error) {
+	switch IncomeType(h.IncomeType) {
+	case IncomeTypeRealizedPnL, IncomeTypeCommission:
+	default:
+		return Income{}, false, nil
+	}
+	p := new(decparse.Parser)
+	in = Income{
+		Symbol: h.Symbol,
+		Asset:  h.Asset,
+		Type:   IncomeType(h.IncomeType),
+		TranID: h.TranID,
+		Amount: p.Dec("income", h.Income),
+		Time:   time.UnixMilli(h.Time).UTC(),
+	}
+	return in, p.Err == nil, p.Err
+}
+
+// Trades return the fills of symbols from the trade history, from the trade id fromID of
+// every symbol, ordered by time
+func Trades(ctx context.Context, c *binance.Client, fromID int64, symbols ...string) ([]Fill, error) {
+	if len(symbols) == 0 {
+		return nil, nil
+	}
+	info, err := c.NewExchangeInfoService().Symbols(symbols...).Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	assets := map[string][2]string{}
+	for _, s := range info.Symbols {
+		assets[s.Symbol] = [2]string{s.BaseAsset, s.QuoteAsset}
+	}
+	var fills []Fill
+	for _, symbol := range symbols {
+		a, ok := assets[symbol]
+		if !ok {
+			return nil, fmt.Errorf("pnl: unknown symbol %s", symbol)
+		}
+		for id := fromID; ; {
+			trades, err := c.NewListTradesService().Symbol(symbol).FromID(id).Limit(tradesLimit).Do(ctx)
+			if err != nil {
+				return nil, err
+			}
+			for _, t := range trades {
+				f, err := FillFromTrade(t, a[0], a[1])
+				if err != nil {
+					return nil, fmt.Errorf("pnl: %s trade %d: %w", symbol, t.ID, err)
+				}
+				fills = append(fills, f)
+				id = t.ID + 1
+			}
+			if len(trades) < tradesLimit {
+				break
+			}
+		}
+	}
+	sort.SliceStable(fills, func(i, j int) bool { return fills[i].Time.Before(fills[j].Time) })
+	return fills, nil
+}
+
+// KlinePricer price assets at the close of the kline containing the time, from the
+// asset+quote symbol or the inverse of the quote+asset symbol
+type KlinePricer struct {
+	Client   *binance.Client
+	Interval string
+
+	mu    sync.Mutex
+	cache map[string]decimal.Decimal
+}
+
+// NewKlinePricer init a pricer of one minute klines
+func NewKlinePricer(c *binance.Client) *KlinePricer {
+	return &KlinePricer{Client: c, Interval: "1m", cache: map[string]decimal.Decimal{}}
+}
+
+// Price return the price of asset in quote at the time at
+func (k *KlinePricer) Price(ctx context.Context, asset, quote string, at time.Time) (decimal.Decimal, error) {
+	if asset == quote {
+		return decimal.NewFromInt(1), nil
+	}
+	p, err := k.close(ctx, asset+quote, at)
+	if err == nil {
+		return p, nil
+	}
+	var apiErr *common.APIError
+	if !errors.As(err, &apiErr) || apiErr.Code != invalidSymbolCode {
+		return decimal.Zero, err
+	}
+	p, err = k.close(ctx, quote+asset, at)
+	if err != nil {
+		return decimal.Zero, err
+	}
+	return decimal.NewFromInt(1).Div(p), nil
+}
+
+func (k *KlinePricer) close(ctx context.Context, symbol string, at time.Time) (decimal.Decimal, error) {
+	ms := at.UnixMilli()
+	// the fills of an order share their time
+	key := fmt.Sprintf("%s:%d", symbol, ms)
+	k.mu.Lock()
+	p, ok := k.cache[key]
+	k.mu.Unlock()
+	if ok {
+		return p, nil
+	}
+	klines, err := k.Client.NewKlinesService().Symbol(symbol).Interval(k.Interval).EndTime(ms).Limit(1).Do(ctx)
+	if err != nil {
+		return decimal.Zero, err
+	}
+	if len(klines) == 0 {
+		return decimal.Zero, fmt.Errorf("pnl: no %s kline at %s", symbol, at)
+	}
+	p, err = decimal.NewFromString(klines[0].Close)
+	if err != nil || !p.IsPositive() {
+		return decimal.Zero, fmt.Errorf("pnl: invalid %s close %q", symbol, klines[0].Close)
+	}
+	k.mu.Lock()
+	defer k.mu.Unlock()
+	if k.cache == nil {
+		k.cache = map[string]decimal.Decimal{}
+	}
+	k.cache[key] = p
+	return p, nil
+}
@@ -0,0 +1,142 @@
+package pnl
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/adshao/go-binance/v2"
+	"github.com/adshao/go-binance/v2/futures"
+)
+
+type sourceTestSuite struct {
+	suite.Suite
+	server *httptest.Server
+	client *binance.Client
+
+	mu    sync.Mutex
+	paths []string
+}
+
+func TestSource(t *testing.T) {
+	suite.Run(t, new(sourceTestSuite))
+}
+
+func (s *sourceTestSuite) SetupTest() {
+	s.paths = nil
+	s.server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_ = r.ParseForm()
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		s.paths = append(s.paths, r.URL.Path+"?"+r.Form.Get("symbol")+":"+r.Form.Get("fromId"))
+		w.Header().Set("Content-Type", "application/json")
+		code, body := s.respond(r.URL.Path, r.Form.Get("symbol"), r.Form.Get("fromId"))
+		w.WriteHeader(code)
+		_, _ = w.Write([]byte(body))
+	}))
+	s.client = binance.NewClient("dummy", "dummy")
+	s.client.BaseURL = s.server.URL
+}
+
+func (s *sourceTestSuite) TearDownTest() {
+	s.server.Close()
+}
+
+func (s *sourceTestSuite) respond(path, symbol, fromID string) (int, string) {
+	switch path {
+	case "/api/v3/exchangeInfo":
+		return http.StatusOK, `{"symbols": [{"symbol": "BTCUSDT", "baseAsset": "BTC", "quoteAsset": "USDT"}]}`
+	case "/api/v3/myTrades":
+		from, _ := strconv.ParseInt(fromID, 10, 64)
+		n := tradesLimit
+		if from > 0 {
+			n = 1
+		}
+		trades := make([]string, n)
+		for i := range trades {
+			id := from + int64(i)
+			trades[i] = fmt.Sprintf(`{"id": %d, "symbol": "BTCUSDT", "qty": "1", "quoteQty": "100", "time": %d, "isBuyer": true}`, id, 1700000000000-id)
+		}
+		return http.StatusOK, "[" + strings.Join(trades, ",") + "]"
+	case "/api/v3/klines":
+		switch symbol {
+		case "BNBUSDT":
+			return http.StatusOK, `[[1700000000000, "299", "301", "298", "300", "10", 1700000059999, "3000", 10, "5", "1500", "0"]]`
+		case "USDTBNB":
+			return http.StatusBadRequest, `{"code": -1121, "msg": "Invalid symbol."}`
+		}
+	}
+	return http.StatusBadRequest, `{"code": -1000, "msg": "unknown"}`
+}
+
+func (s *sourceTestSuite) TestTrades() {
+	fills, err := Trades(context.Background(), s.client, 0, "BTCUSDT")
+	s.Require().NoError(err)
+	s.Require().Len(fills, tradesLimit+1)
+	s.Equal(int64(tradesLimit), fills[0].TradeID)
+	s.Equal("BTC", fills[0].Base)
+	s.Equal("USDT", fills[0].Quote)
+	s.True(fills[0].IsBuyer)
+	s.Equal([]string{"/api/v3/exchangeInfo?:", "/api/v3/myTrades?BTCUSDT:0", "/api/v3/myTrades?BTCUSDT:1000"}, s.paths)
+
+	_, err = Trades(context.Background(), s.client, 0, "ETHUSDT")
+	s.EqualError(err, "pnl: unknown symbol ETHUSDT")
+}
+
+func (s *sourceTestSuite) TestKlinePricer() {
+	k := NewKlinePricer(s.client)
+	at := time.UnixMilli(1700000030000)
+	p, err := k.Price(context.Background(), "BNB", "USDT", at)
+	s.Require().NoError(err)
+	s.True(decimal.NewFromInt(300).Equal(p))
+
+	p, err = k.Price(context.Background(), "USDT", "BNB", at)
+	s.Require().NoError(err)
+	s.Equal("0.0033333333", p.Round(10).String())
+	s.Len(s.paths, 2)
+
+	_, err = k.Price(context.Background(), "BTC", "EUR", at)
+	s.ErrorContains(err, "code=-1000")
+}
+
+func (s *sourceTestSuite) TestFillFromOrderUpdate() {
+	u := &binance.WsOrderUpdate{Symbol: "BTCUSDT", Side: "SELL", ExecutionType: "TRADE", TradeId: 7, TransactionTime: 1700000000000,
+		LatestVolume: "0.5", LatestQuoteVolume: "20000", FeeCost: "0.01", FeeAsset: "BNB"}
+	f, ok, err := FillFromOrderUpdate(u, "BTC", "USDT")
+	s.Require().NoError(err)
+	s.True(ok)
+	s.Equal(Fill{Symbol: "BTCUSDT", Base: "BTC", Quote: "USDT", TradeID: 7, Time: time.UnixMilli(1700000000000).UTC(),
+		Quantity: decimal.RequireFromString("0.5"), QuoteQuantity: decimal.RequireFromString("20000"),
+		Commission: decimal.RequireFromString("0.01"), CommissionAsset: "BNB"}, f)
+
+	u.ExecutionType = "NEW"
+	_, ok, err = FillFromOrderUpdate(u, "BTC", "USDT")
+	s.NoError(err)
+	s.False(ok)
+
+	u.ExecutionType, u.FeeCost = "TRADE", "x"
+	_, ok, err = FillFromOrderUpdate(u, "BTC", "USDT")
+	s.EqualError(err, `invalid n "x": can't convert x to decimal`)
+	s.False(ok)
+}
+
+func (s *sourceTestSuite) TestIncomeFromHistory() {
+	in, ok, err := IncomeFromHistory(&futures.IncomeHistory{Symbol: "BTCUSDT", Asset: "USDT", IncomeType: "COMMISSION", Income: "-1.5", TranID: 9, Time: 1700000000000})
+	s.Require().NoError(err)
+	s.True(ok)
+	s.Equal(IncomeTypeCommission, in.Type)
+	s.Equal("-1.5", in.Amount.String())
+
+	_, ok, err = IncomeFromHistory(&futures.IncomeHistory{IncomeType: "FUNDING_FEE", Income: "1"})
+	s.NoError(err)
+	s.False(ok)
+}
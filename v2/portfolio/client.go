@@ -270,28 +270,34 @@ func newJSON(data []byte) (j *simplejson.Json, err error) {
 	return j, nil
 }
 
-// getApiEndpoint return the base endpoint of the WS according the UseTestnet flag
-func getApiEndpoint() string {
-	return BaseApiMainUrl
-}
-
 // NewClient initialize an API client instance with API key and secret key.
 // You should always call this function before using this SDK.
 // Services will be created by the form client.NewXXXService().
 func NewClient(apiKey, secretKey string) *Client {
+	return NewClientWithEnvironment(apiKey, secretKey, defaultEnvironment())
+}
+
+// NewClientWithEnvironment initialize an API client instance for the endpoints of env
+func NewClientWithEnvironment(apiKey, secretKey string, env Environment) *Client {
 	return &Client{
-		APIKey:     apiKey,
-		SecretKey:  secretKey,
-		KeyType:    common.KeyTypeHmac,
-		BaseURL:    getApiEndpoint(),
-		UserAgent:  "Binance/golang",
-		HTTPClient: http.DefaultClient,
-		Logger:     log.New(os.Stderr, "Binance-golang ", log.LstdFlags),
+		APIKey:      apiKey,
+		SecretKey:   secretKey,
+		KeyType:     common.KeyTypeHmac,
+		BaseURL:     env.APIURL,
+		Environment: env,
+		UserAgent:   "Binance/golang",
+		HTTPClient:  http.DefaultClient,
+		Logger:      log.New(os.Stderr, "Binance-golang ", log.LstdFlags),
 	}
 }
 
 // NewProxiedClient passing a proxy url
 func NewProxiedClient(apiKey, secretKey, proxyUrl string) *Client {
+	return NewProxiedClientWithEnvironment(apiKey, secretKey, proxyUrl, defaultEnvironment())
+}
+
+// NewProxiedClientWithEnvironment passing a proxy url, for the endpoints of env
+func NewProxiedClientWithEnvironment(apiKey, secretKey, proxyUrl string, env Environment) *Client {
 	proxy, err := url.Parse(proxyUrl)
 	if err != nil {
 		log.Fatal(err)
@@ -301,11 +307,12 @@ func NewProxiedClient(apiKey, secretKey, proxyUrl string) *Client {
 		TLSClientConfig: &tls.Config{InsecureSkipVerify: true},
 	}
 	return &Client{
-		APIKey:    apiKey,
-		SecretKey: secretKey,
-		KeyType:   common.KeyTypeHmac,
-		BaseURL:   getApiEndpoint(),
-		UserAgent: "Binance/golang",
+		APIKey:      apiKey,
+		SecretKey:   secretKey,
+		KeyType:     common.KeyTypeHmac,
+		BaseURL:     env.APIURL,
+		Environment: env,
+		UserAgent:   "Binance/golang",
 		HTTPClient: &http.Client{
 			Transport: tr,
 		},
@@ -315,6 +322,9 @@ func NewProxiedClient(apiKey, secretKey, proxyUrl string) *Client {
 
 type doFunc func(req *http.Request) (*http.Response, error)
 
+//go:generate go run github.com/adshao/go-binance/v2/internal/servicegen -output service_api.go
+//go:generate mockgen -source service_api.go -destination mock/service_api.go -package mock
+
 // Client define API client
 type Client struct {
 	APIKey     string
@@ -328,6 +338,18 @@ type Client struct {
 	TimeOffset int64
 	do         doFunc
 
+	// Environment is the environment of the client, its WsOptions connect the streams to the
+	// same environment
+	Environment Environment
+
+	// Middlewares wrap every REST API call, see Use
+	Middlewares   []common.Middleware
+	logMiddleware common.Middleware
+
+	// Signer signs requests instead of SecretKey when set, see SetSigner
+	Signer  common.Signer
+	signers common.SignerCache
+
 	UsedWeight common.UsedWeight
 	OrderCount common.OrderCount
 }
@@ -369,22 +391,17 @@ func (c *Client) parseRequest(r *request, opts ...RequestOption) (err error) {
 	if r.secType == secTypeAPIKey || r.secType == secTypeSigned {
 		header.Set("X-MBX-APIKEY", c.APIKey)
 	}
-	kt := c.KeyType
-	if kt == "" {
-		kt = common.KeyTypeHmac
-	}
-	sf, err := common.SignFunc(kt)
-	if err != nil {
-		return err
-	}
 	if r.secType == secTypeSigned {
-		raw := fmt.Sprintf("%s%s", queryString, bodyString)
-		sign, err := sf(c.SecretKey, raw)
+		signer, err := c.signer()
+		if err != nil {
+			return err
+		}
+		sign, err := signer.Sign([]byte(queryString + bodyString))
 		if err != nil {
 			return err
 		}
 		v := url.Values{}
-		v.Set(signatureKey, *sign)
+		v.Set(signatureKey, sign)
 		if queryString == "" {
 			queryString = v.Encode()
 		} else {
@@ -394,7 +411,7 @@ func (c *Client) parseRequest(r *request, opts ...RequestOption) (err error) {
 	if queryString != "" {
 		fullURL = fmt.Sprintf("%s?%s", fullURL, queryString)
 	}
-	c.debug("full url: %s, body: %s\n", fullURL, bodyString)
+	c.debug("full url: %s, body: %s\n", common.RedactString(fullURL), common.RedactString(bodyString))
 
 	r.fullURL = fullURL
 	r.header = header
@@ -413,20 +430,37 @@ func (c *Client) callAPI(ctx context.Context, r *request, opts ...RequestOption)
 	}
 	req = req.WithContext(ctx)
 	req.Header = r.header
-	c.debug("request: %#v\n", req)
+	send := c.send
+	if c.logMiddleware != nil {
+		send = c.logMiddleware(send)
+	}
+	res, err := common.Chain(send, c.Middlewares...)(req)
+	if err != nil {
+		if res != nil {
+			return nil, &res.Header, err
+		}
+		return []byte{}, &http.Header{}, err
+	}
+	return res.Body, &res.Header, nil
+}
+
+// send performs a built request, it is the innermost handler of the middleware chain
+func (c *Client) send(req *http.Request) (resp *common.Response, err error) {
+	c.debug("request: %s %s, header: %v\n", req.Method, common.RedactString(req.URL.String()), common.RedactHeader(req.Header))
 	f := c.do
 	if f == nil {
 		f = c.HTTPClient.Do
 	}
 	res, err := f(req)
 	if err != nil {
-		return []byte{}, &http.Header{}, err
+		return nil, err
 	}
 	c.UsedWeight.UpdateByHeader(res.Header)
 	c.OrderCount.UpdateByHeader(res.Header)
-	data, err = io.ReadAll(res.Body)
+
+	data, err := io.ReadAll(res.Body)
 	if err != nil {
-		return []byte{}, &http.Header{}, err
+		return nil, err
 	}
 	defer func() {
 		cerr := res.Body.Close()
@@ -436,10 +470,15 @@ func (c *Client) callAPI(ctx context.Context, r *request, opts ...RequestOption)
 			err = cerr
 		}
 	}()
-	c.debug("response: %#v\n", res)
+	c.debug("response header: %v\n", res.Header)
 	c.debug("response body: %s\n", string(data))
 	c.debug("response status code: %d\n", res.StatusCode)
 
+	resp = &common.Response{
+		StatusCode: res.StatusCode,
+		Header:     res.Header,
+		Body:       data,
+	}
 	if res.StatusCode >= http.StatusBadRequest {
 		// Try to parse the error response
 		var apiErr Error
@@ -447,12 +486,35 @@ func (c *Client) callAPI(ctx context.Context, r *request, opts ...RequestOption)
 		if e != nil {
 			c.debug("failed to unmarshal error response: %s\n", e)
 			// If we can't parse the JSON response, return a generic error with the raw response
-			return nil, &res.Header, NewErrorFromResponse(int64(res.StatusCode), res.Status, data)
+			return resp, NewErrorFromResponse(int64(res.StatusCode), res.Status, data)
 		}
 		// Return the parsed error with the raw response included
-		return nil, &res.Header, NewErrorFromResponse(apiErr.Code, apiErr.Message, data)
+		return resp, NewErrorFromResponse(apiErr.Code, apiErr.Message, data)
 	}
-	return data, &res.Header, nil
+	return resp, nil
+}
+
+// SetSigner set the signer of requests, e.g. an external KMS or HSM signer,
+// SecretKey is not used to sign requests anymore
+func (c *Client) SetSigner(signer common.Signer) *Client {
+	c.Signer = signer
+	c.KeyType = signer.KeyType()
+	return c
+}
+
+// signer returns Signer when set, else the signer of SecretKey, parsed once
+func (c *Client) signer() (common.Signer, error) {
+	if c.Signer != nil {
+		return c.Signer, nil
+	}
+	return c.signers.Get(c.KeyType, c.SecretKey)
+}
+
+// Use appends middlewares wrapping every REST API call of the client, the first
+// middleware being the outermost. It must not be called concurrently with requests.
+func (c *Client) Use(middlewares ...common.Middleware) *Client {
+	c.Middlewares = append(c.Middlewares, middlewares...)
+	return c
 }
 
 // SetApiEndpoint set api Endpoint
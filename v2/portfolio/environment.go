@@ -0,0 +1,49 @@
+package portfolio
+
+// EnvironmentType define the type of an environment
+type EnvironmentType string
+
+// Environment types
+const (
+	EnvironmentTypeProduction EnvironmentType = "PRODUCTION"
+	EnvironmentTypeCustom     EnvironmentType = "CUSTOM"
+)
+
+// Environment define the endpoints of the REST API and the streams of an
+// environment. Clients keep their own copy of it, so that clients of different environments
+// can run side by side.
+type Environment struct {
+	Type   EnvironmentType
+	APIURL string
+	WsURL  string
+}
+
+// ProductionEnvironment returns the production environment
+func ProductionEnvironment() Environment {
+	return Environment{
+		Type:   EnvironmentTypeProduction,
+		APIURL: BaseApiMainUrl,
+		WsURL:  BaseWsMainUrl,
+	}
+}
+
+// defaultEnvironment returns the environment of the package endpoints
+func defaultEnvironment() Environment {
+	return ProductionEnvironment()
+}
+
+// WithAPIURL returns a custom environment with the endpoints of e and the REST API at url,
+// e.g. a regional gateway
+func (e Environment) WithAPIURL(url string) Environment {
+	e.Type = EnvironmentTypeCustom
+	e.APIURL = url
+	return e
+}
+
+// WsOptions returns the websocket options connecting the streams to
+// the endpoints of e, to be passed to the stream functions
+func (e Environment) WsOptions() WsOptions {
+	return WsOptions{
+		BaseURL: e.WsURL,
+	}
+}
@@ -33,6 +33,11 @@ func (e Error) Error() string {
 	return e.APIError.Error()
 }
 
+// Unwrap returns the common APIError, so that errors.As finds it
+func (e *Error) Unwrap() error {
+	return &e.APIError
+}
+
 // IsPortfolioError check if e is a Portfolio error
 func IsPortfolioError(e error) bool {
 	_, ok := e.(*Error)
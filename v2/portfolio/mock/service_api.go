@@ -0,0 +1,11041 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: service_api.go
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	portfolio "github.com/adshao/go-binance/v2/portfolio"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockClientAPI is a mock of ClientAPI interface.
+type MockClientAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockClientAPIMockRecorder
+}
+
+// MockClientAPIMockRecorder is the mock recorder for MockClientAPI.
+type MockClientAPIMockRecorder struct {
+	mock *MockClientAPI
+}
+
+// NewMockClientAPI creates a new mock instance.
+func NewMockClientAPI(ctrl *gomock.Controller) *MockClientAPI {
+	mock := &MockClientAPI{ctrl: ctrl}
+	mock.recorder = &MockClientAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockClientAPI) EXPECT() *MockClientAPIMockRecorder {
+	return m.recorder
+}
+
+// NewBNBTransferService mocks base method.
+func (m *MockClientAPI) NewBNBTransferService() portfolio.BNBTransferServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewBNBTransferService")
+	ret0, _ := ret[0].(portfolio.BNBTransferServiceAPI)
+	return ret0
+}
+
+// NewBNBTransferService indicates an expected call of NewBNBTransferService.
+func (mr *MockClientAPIMockRecorder) NewBNBTransferService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewBNBTransferService", reflect.TypeOf((*MockClientAPI)(nil).NewBNBTransferService))
+}
+
+// NewCMADLQuantileService mocks base method.
+func (m *MockClientAPI) NewCMADLQuantileService() portfolio.CMADLQuantileServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewCMADLQuantileService")
+	ret0, _ := ret[0].(portfolio.CMADLQuantileServiceAPI)
+	return ret0
+}
+
+// NewCMADLQuantileService indicates an expected call of NewCMADLQuantileService.
+func (mr *MockClientAPIMockRecorder) NewCMADLQuantileService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewCMADLQuantileService", reflect.TypeOf((*MockClientAPI)(nil).NewCMADLQuantileService))
+}
+
+// NewCMAccountTradeService mocks base method.
+func (m *MockClientAPI) NewCMAccountTradeService() portfolio.CMAccountTradeServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewCMAccountTradeService")
+	ret0, _ := ret[0].(portfolio.CMAccountTradeServiceAPI)
+	return ret0
+}
+
+// NewCMAccountTradeService indicates an expected call of NewCMAccountTradeService.
+func (mr *MockClientAPIMockRecorder) NewCMAccountTradeService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewCMAccountTradeService", reflect.TypeOf((*MockClientAPI)(nil).NewCMAccountTradeService))
+}
+
+// NewCMAccountTradesService mocks base method.
+func (m *MockClientAPI) NewCMAccountTradesService() portfolio.CMAccountTradesServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewCMAccountTradesService")
+	ret0, _ := ret[0].(portfolio.CMAccountTradesServiceAPI)
+	return ret0
+}
+
+// NewCMAccountTradesService indicates an expected call of NewCMAccountTradesService.
+func (mr *MockClientAPIMockRecorder) NewCMAccountTradesService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewCMAccountTradesService", reflect.TypeOf((*MockClientAPI)(nil).NewCMAccountTradesService))
+}
+
+// NewCMAllOrdersService mocks base method.
+func (m *MockClientAPI) NewCMAllOrdersService() portfolio.CMAllOrdersServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewCMAllOrdersService")
+	ret0, _ := ret[0].(portfolio.CMAllOrdersServiceAPI)
+	return ret0
+}
+
+// NewCMAllOrdersService indicates an expected call of NewCMAllOrdersService.
+func (mr *MockClientAPIMockRecorder) NewCMAllOrdersService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewCMAllOrdersService", reflect.TypeOf((*MockClientAPI)(nil).NewCMAllOrdersService))
+}
+
+// NewCMCancelAllConditionalOrdersService mocks base method.
+func (m *MockClientAPI) NewCMCancelAllConditionalOrdersService() portfolio.CMCancelAllConditionalOrdersServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewCMCancelAllConditionalOrdersService")
+	ret0, _ := ret[0].(portfolio.CMCancelAllConditionalOrdersServiceAPI)
+	return ret0
+}
+
+// NewCMCancelAllConditionalOrdersService indicates an expected call of NewCMCancelAllConditionalOrdersService.
+func (mr *MockClientAPIMockRecorder) NewCMCancelAllConditionalOrdersService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewCMCancelAllConditionalOrdersService", reflect.TypeOf((*MockClientAPI)(nil).NewCMCancelAllConditionalOrdersService))
+}
+
+// NewCMCancelAllOrdersService mocks base method.
+func (m *MockClientAPI) NewCMCancelAllOrdersService() portfolio.CMCancelAllOrdersServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewCMCancelAllOrdersService")
+	ret0, _ := ret[0].(portfolio.CMCancelAllOrdersServiceAPI)
+	return ret0
+}
+
+// NewCMCancelAllOrdersService indicates an expected call of NewCMCancelAllOrdersService.
+func (mr *MockClientAPIMockRecorder) NewCMCancelAllOrdersService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewCMCancelAllOrdersService", reflect.TypeOf((*MockClientAPI)(nil).NewCMCancelAllOrdersService))
+}
+
+// NewCMCancelConditionalOrderService mocks base method.
+func (m *MockClientAPI) NewCMCancelConditionalOrderService() portfolio.CMCancelConditionalOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewCMCancelConditionalOrderService")
+	ret0, _ := ret[0].(portfolio.CMCancelConditionalOrderServiceAPI)
+	return ret0
+}
+
+// NewCMCancelConditionalOrderService indicates an expected call of NewCMCancelConditionalOrderService.
+func (mr *MockClientAPIMockRecorder) NewCMCancelConditionalOrderService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewCMCancelConditionalOrderService", reflect.TypeOf((*MockClientAPI)(nil).NewCMCancelConditionalOrderService))
+}
+
+// NewCMCancelOrderService mocks base method.
+func (m *MockClientAPI) NewCMCancelOrderService() portfolio.CMCancelOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewCMCancelOrderService")
+	ret0, _ := ret[0].(portfolio.CMCancelOrderServiceAPI)
+	return ret0
+}
+
+// NewCMCancelOrderService indicates an expected call of NewCMCancelOrderService.
+func (mr *MockClientAPIMockRecorder) NewCMCancelOrderService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewCMCancelOrderService", reflect.TypeOf((*MockClientAPI)(nil).NewCMCancelOrderService))
+}
+
+// NewCMConditionalOrder mocks base method.
+func (m *MockClientAPI) NewCMConditionalOrder() portfolio.CMConditionalOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewCMConditionalOrder")
+	ret0, _ := ret[0].(portfolio.CMConditionalOrderServiceAPI)
+	return ret0
+}
+
+// NewCMConditionalOrder indicates an expected call of NewCMConditionalOrder.
+func (mr *MockClientAPIMockRecorder) NewCMConditionalOrder() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewCMConditionalOrder", reflect.TypeOf((*MockClientAPI)(nil).NewCMConditionalOrder))
+}
+
+// NewCMConditionalOrderHistoryService mocks base method.
+func (m *MockClientAPI) NewCMConditionalOrderHistoryService() portfolio.CMConditionalOrderHistoryServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewCMConditionalOrderHistoryService")
+	ret0, _ := ret[0].(portfolio.CMConditionalOrderHistoryServiceAPI)
+	return ret0
+}
+
+// NewCMConditionalOrderHistoryService indicates an expected call of NewCMConditionalOrderHistoryService.
+func (mr *MockClientAPIMockRecorder) NewCMConditionalOrderHistoryService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewCMConditionalOrderHistoryService", reflect.TypeOf((*MockClientAPI)(nil).NewCMConditionalOrderHistoryService))
+}
+
+// NewCMConditionalOrderService mocks base method.
+func (m *MockClientAPI) NewCMConditionalOrderService() portfolio.CMConditionalOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewCMConditionalOrderService")
+	ret0, _ := ret[0].(portfolio.CMConditionalOrderServiceAPI)
+	return ret0
+}
+
+// NewCMConditionalOrderService indicates an expected call of NewCMConditionalOrderService.
+func (mr *MockClientAPIMockRecorder) NewCMConditionalOrderService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewCMConditionalOrderService", reflect.TypeOf((*MockClientAPI)(nil).NewCMConditionalOrderService))
+}
+
+// NewCMConditionalOrdersService mocks base method.
+func (m *MockClientAPI) NewCMConditionalOrdersService() portfolio.CMConditionalOrdersServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewCMConditionalOrdersService")
+	ret0, _ := ret[0].(portfolio.CMConditionalOrdersServiceAPI)
+	return ret0
+}
+
+// NewCMConditionalOrdersService indicates an expected call of NewCMConditionalOrdersService.
+func (mr *MockClientAPIMockRecorder) NewCMConditionalOrdersService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewCMConditionalOrdersService", reflect.TypeOf((*MockClientAPI)(nil).NewCMConditionalOrdersService))
+}
+
+// NewCMForceOrdersService mocks base method.
+func (m *MockClientAPI) NewCMForceOrdersService() portfolio.CMForceOrdersServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewCMForceOrdersService")
+	ret0, _ := ret[0].(portfolio.CMForceOrdersServiceAPI)
+	return ret0
+}
+
+// NewCMForceOrdersService indicates an expected call of NewCMForceOrdersService.
+func (mr *MockClientAPIMockRecorder) NewCMForceOrdersService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewCMForceOrdersService", reflect.TypeOf((*MockClientAPI)(nil).NewCMForceOrdersService))
+}
+
+// NewCMModifyOrderHistoryService mocks base method.
+func (m *MockClientAPI) NewCMModifyOrderHistoryService() portfolio.CMModifyOrderHistoryServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewCMModifyOrderHistoryService")
+	ret0, _ := ret[0].(portfolio.CMModifyOrderHistoryServiceAPI)
+	return ret0
+}
+
+// NewCMModifyOrderHistoryService indicates an expected call of NewCMModifyOrderHistoryService.
+func (mr *MockClientAPIMockRecorder) NewCMModifyOrderHistoryService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewCMModifyOrderHistoryService", reflect.TypeOf((*MockClientAPI)(nil).NewCMModifyOrderHistoryService))
+}
+
+// NewCMModifyOrderService mocks base method.
+func (m *MockClientAPI) NewCMModifyOrderService() portfolio.CMModifyOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewCMModifyOrderService")
+	ret0, _ := ret[0].(portfolio.CMModifyOrderServiceAPI)
+	return ret0
+}
+
+// NewCMModifyOrderService indicates an expected call of NewCMModifyOrderService.
+func (mr *MockClientAPIMockRecorder) NewCMModifyOrderService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewCMModifyOrderService", reflect.TypeOf((*MockClientAPI)(nil).NewCMModifyOrderService))
+}
+
+// NewCMOpenConditionalOrderService mocks base method.
+func (m *MockClientAPI) NewCMOpenConditionalOrderService() portfolio.CMOpenConditionalOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewCMOpenConditionalOrderService")
+	ret0, _ := ret[0].(portfolio.CMOpenConditionalOrderServiceAPI)
+	return ret0
+}
+
+// NewCMOpenConditionalOrderService indicates an expected call of NewCMOpenConditionalOrderService.
+func (mr *MockClientAPIMockRecorder) NewCMOpenConditionalOrderService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewCMOpenConditionalOrderService", reflect.TypeOf((*MockClientAPI)(nil).NewCMOpenConditionalOrderService))
+}
+
+// NewCMOpenConditionalOrdersService mocks base method.
+func (m *MockClientAPI) NewCMOpenConditionalOrdersService() portfolio.CMOpenConditionalOrdersServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewCMOpenConditionalOrdersService")
+	ret0, _ := ret[0].(portfolio.CMOpenConditionalOrdersServiceAPI)
+	return ret0
+}
+
+// NewCMOpenConditionalOrdersService indicates an expected call of NewCMOpenConditionalOrdersService.
+func (mr *MockClientAPIMockRecorder) NewCMOpenConditionalOrdersService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewCMOpenConditionalOrdersService", reflect.TypeOf((*MockClientAPI)(nil).NewCMOpenConditionalOrdersService))
+}
+
+// NewCMOpenOrderService mocks base method.
+func (m *MockClientAPI) NewCMOpenOrderService() portfolio.CMOpenOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewCMOpenOrderService")
+	ret0, _ := ret[0].(portfolio.CMOpenOrderServiceAPI)
+	return ret0
+}
+
+// NewCMOpenOrderService indicates an expected call of NewCMOpenOrderService.
+func (mr *MockClientAPIMockRecorder) NewCMOpenOrderService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewCMOpenOrderService", reflect.TypeOf((*MockClientAPI)(nil).NewCMOpenOrderService))
+}
+
+// NewCMOpenOrdersService mocks base method.
+func (m *MockClientAPI) NewCMOpenOrdersService() portfolio.CMOpenOrdersServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewCMOpenOrdersService")
+	ret0, _ := ret[0].(portfolio.CMOpenOrdersServiceAPI)
+	return ret0
+}
+
+// NewCMOpenOrdersService indicates an expected call of NewCMOpenOrdersService.
+func (mr *MockClientAPIMockRecorder) NewCMOpenOrdersService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewCMOpenOrdersService", reflect.TypeOf((*MockClientAPI)(nil).NewCMOpenOrdersService))
+}
+
+// NewCMOrderService mocks base method.
+func (m *MockClientAPI) NewCMOrderService() portfolio.CMOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewCMOrderService")
+	ret0, _ := ret[0].(portfolio.CMOrderServiceAPI)
+	return ret0
+}
+
+// NewCMOrderService indicates an expected call of NewCMOrderService.
+func (mr *MockClientAPIMockRecorder) NewCMOrderService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewCMOrderService", reflect.TypeOf((*MockClientAPI)(nil).NewCMOrderService))
+}
+
+// NewCMQueryOrderService mocks base method.
+func (m *MockClientAPI) NewCMQueryOrderService() portfolio.CMQueryOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewCMQueryOrderService")
+	ret0, _ := ret[0].(portfolio.CMQueryOrderServiceAPI)
+	return ret0
+}
+
+// NewCMQueryOrderService indicates an expected call of NewCMQueryOrderService.
+func (mr *MockClientAPIMockRecorder) NewCMQueryOrderService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewCMQueryOrderService", reflect.TypeOf((*MockClientAPI)(nil).NewCMQueryOrderService))
+}
+
+// NewChangeAutoRepayFuturesStatusService mocks base method.
+func (m *MockClientAPI) NewChangeAutoRepayFuturesStatusService() portfolio.ChangeAutoRepayFuturesStatusServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewChangeAutoRepayFuturesStatusService")
+	ret0, _ := ret[0].(portfolio.ChangeAutoRepayFuturesStatusServiceAPI)
+	return ret0
+}
+
+// NewChangeAutoRepayFuturesStatusService indicates an expected call of NewChangeAutoRepayFuturesStatusService.
+func (mr *MockClientAPIMockRecorder) NewChangeAutoRepayFuturesStatusService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewChangeAutoRepayFuturesStatusService", reflect.TypeOf((*MockClientAPI)(nil).NewChangeAutoRepayFuturesStatusService))
+}
+
+// NewChangeCMInitialLeverageService mocks base method.
+func (m *MockClientAPI) NewChangeCMInitialLeverageService() portfolio.ChangeCMInitialLeverageServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewChangeCMInitialLeverageService")
+	ret0, _ := ret[0].(portfolio.ChangeCMInitialLeverageServiceAPI)
+	return ret0
+}
+
+// NewChangeCMInitialLeverageService indicates an expected call of NewChangeCMInitialLeverageService.
+func (mr *MockClientAPIMockRecorder) NewChangeCMInitialLeverageService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewChangeCMInitialLeverageService", reflect.TypeOf((*MockClientAPI)(nil).NewChangeCMInitialLeverageService))
+}
+
+// NewChangeCMPositionModeService mocks base method.
+func (m *MockClientAPI) NewChangeCMPositionModeService() portfolio.ChangeCMPositionModeServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewChangeCMPositionModeService")
+	ret0, _ := ret[0].(portfolio.ChangeCMPositionModeServiceAPI)
+	return ret0
+}
+
+// NewChangeCMPositionModeService indicates an expected call of NewChangeCMPositionModeService.
+func (mr *MockClientAPIMockRecorder) NewChangeCMPositionModeService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewChangeCMPositionModeService", reflect.TypeOf((*MockClientAPI)(nil).NewChangeCMPositionModeService))
+}
+
+// NewChangeUMInitialLeverageService mocks base method.
+func (m *MockClientAPI) NewChangeUMInitialLeverageService() portfolio.ChangeUMInitialLeverageServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewChangeUMInitialLeverageService")
+	ret0, _ := ret[0].(portfolio.ChangeUMInitialLeverageServiceAPI)
+	return ret0
+}
+
+// NewChangeUMInitialLeverageService indicates an expected call of NewChangeUMInitialLeverageService.
+func (mr *MockClientAPIMockRecorder) NewChangeUMInitialLeverageService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewChangeUMInitialLeverageService", reflect.TypeOf((*MockClientAPI)(nil).NewChangeUMInitialLeverageService))
+}
+
+// NewChangeUMPositionModeService mocks base method.
+func (m *MockClientAPI) NewChangeUMPositionModeService() portfolio.ChangeUMPositionModeServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewChangeUMPositionModeService")
+	ret0, _ := ret[0].(portfolio.ChangeUMPositionModeServiceAPI)
+	return ret0
+}
+
+// NewChangeUMPositionModeService indicates an expected call of NewChangeUMPositionModeService.
+func (mr *MockClientAPIMockRecorder) NewChangeUMPositionModeService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewChangeUMPositionModeService", reflect.TypeOf((*MockClientAPI)(nil).NewChangeUMPositionModeService))
+}
+
+// NewCloseUserStreamService mocks base method.
+func (m *MockClientAPI) NewCloseUserStreamService() portfolio.CloseUserStreamServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewCloseUserStreamService")
+	ret0, _ := ret[0].(portfolio.CloseUserStreamServiceAPI)
+	return ret0
+}
+
+// NewCloseUserStreamService indicates an expected call of NewCloseUserStreamService.
+func (mr *MockClientAPIMockRecorder) NewCloseUserStreamService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewCloseUserStreamService", reflect.TypeOf((*MockClientAPI)(nil).NewCloseUserStreamService))
+}
+
+// NewFundAutoCollectionService mocks base method.
+func (m *MockClientAPI) NewFundAutoCollectionService() portfolio.FundAutoCollectionServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewFundAutoCollectionService")
+	ret0, _ := ret[0].(portfolio.FundAutoCollectionServiceAPI)
+	return ret0
+}
+
+// NewFundAutoCollectionService indicates an expected call of NewFundAutoCollectionService.
+func (mr *MockClientAPIMockRecorder) NewFundAutoCollectionService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewFundAutoCollectionService", reflect.TypeOf((*MockClientAPI)(nil).NewFundAutoCollectionService))
+}
+
+// NewFundCollectionByAssetService mocks base method.
+func (m *MockClientAPI) NewFundCollectionByAssetService() portfolio.FundCollectionByAssetServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewFundCollectionByAssetService")
+	ret0, _ := ret[0].(portfolio.FundCollectionByAssetServiceAPI)
+	return ret0
+}
+
+// NewFundCollectionByAssetService indicates an expected call of NewFundCollectionByAssetService.
+func (mr *MockClientAPIMockRecorder) NewFundCollectionByAssetService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewFundCollectionByAssetService", reflect.TypeOf((*MockClientAPI)(nil).NewFundCollectionByAssetService))
+}
+
+// NewGetAccountService mocks base method.
+func (m *MockClientAPI) NewGetAccountService() portfolio.GetAccountServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewGetAccountService")
+	ret0, _ := ret[0].(portfolio.GetAccountServiceAPI)
+	return ret0
+}
+
+// NewGetAccountService indicates an expected call of NewGetAccountService.
+func (mr *MockClientAPIMockRecorder) NewGetAccountService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewGetAccountService", reflect.TypeOf((*MockClientAPI)(nil).NewGetAccountService))
+}
+
+// NewGetAutoRepayFuturesStatusService mocks base method.
+func (m *MockClientAPI) NewGetAutoRepayFuturesStatusService() portfolio.GetAutoRepayFuturesStatusServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewGetAutoRepayFuturesStatusService")
+	ret0, _ := ret[0].(portfolio.GetAutoRepayFuturesStatusServiceAPI)
+	return ret0
+}
+
+// NewGetAutoRepayFuturesStatusService indicates an expected call of NewGetAutoRepayFuturesStatusService.
+func (mr *MockClientAPIMockRecorder) NewGetAutoRepayFuturesStatusService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewGetAutoRepayFuturesStatusService", reflect.TypeOf((*MockClientAPI)(nil).NewGetAutoRepayFuturesStatusService))
+}
+
+// NewGetBalanceService mocks base method.
+func (m *MockClientAPI) NewGetBalanceService() portfolio.GetBalanceServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewGetBalanceService")
+	ret0, _ := ret[0].(portfolio.GetBalanceServiceAPI)
+	return ret0
+}
+
+// NewGetBalanceService indicates an expected call of NewGetBalanceService.
+func (mr *MockClientAPIMockRecorder) NewGetBalanceService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewGetBalanceService", reflect.TypeOf((*MockClientAPI)(nil).NewGetBalanceService))
+}
+
+// NewGetCMAccountDetailService mocks base method.
+func (m *MockClientAPI) NewGetCMAccountDetailService() portfolio.GetCMAccountDetailServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewGetCMAccountDetailService")
+	ret0, _ := ret[0].(portfolio.GetCMAccountDetailServiceAPI)
+	return ret0
+}
+
+// NewGetCMAccountDetailService indicates an expected call of NewGetCMAccountDetailService.
+func (mr *MockClientAPIMockRecorder) NewGetCMAccountDetailService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewGetCMAccountDetailService", reflect.TypeOf((*MockClientAPI)(nil).NewGetCMAccountDetailService))
+}
+
+// NewGetCMCommissionRateService mocks base method.
+func (m *MockClientAPI) NewGetCMCommissionRateService() portfolio.GetCMCommissionRateServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewGetCMCommissionRateService")
+	ret0, _ := ret[0].(portfolio.GetCMCommissionRateServiceAPI)
+	return ret0
+}
+
+// NewGetCMCommissionRateService indicates an expected call of NewGetCMCommissionRateService.
+func (mr *MockClientAPIMockRecorder) NewGetCMCommissionRateService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewGetCMCommissionRateService", reflect.TypeOf((*MockClientAPI)(nil).NewGetCMCommissionRateService))
+}
+
+// NewGetCMIncomeHistoryService mocks base method.
+func (m *MockClientAPI) NewGetCMIncomeHistoryService() portfolio.GetCMIncomeHistoryServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewGetCMIncomeHistoryService")
+	ret0, _ := ret[0].(portfolio.GetCMIncomeHistoryServiceAPI)
+	return ret0
+}
+
+// NewGetCMIncomeHistoryService indicates an expected call of NewGetCMIncomeHistoryService.
+func (mr *MockClientAPIMockRecorder) NewGetCMIncomeHistoryService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewGetCMIncomeHistoryService", reflect.TypeOf((*MockClientAPI)(nil).NewGetCMIncomeHistoryService))
+}
+
+// NewGetCMLeverageBracketService mocks base method.
+func (m *MockClientAPI) NewGetCMLeverageBracketService() portfolio.GetCMLeverageBracketServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewGetCMLeverageBracketService")
+	ret0, _ := ret[0].(portfolio.GetCMLeverageBracketServiceAPI)
+	return ret0
+}
+
+// NewGetCMLeverageBracketService indicates an expected call of NewGetCMLeverageBracketService.
+func (mr *MockClientAPIMockRecorder) NewGetCMLeverageBracketService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewGetCMLeverageBracketService", reflect.TypeOf((*MockClientAPI)(nil).NewGetCMLeverageBracketService))
+}
+
+// NewGetCMPositionModeService mocks base method.
+func (m *MockClientAPI) NewGetCMPositionModeService() portfolio.GetCMPositionModeServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewGetCMPositionModeService")
+	ret0, _ := ret[0].(portfolio.GetCMPositionModeServiceAPI)
+	return ret0
+}
+
+// NewGetCMPositionModeService indicates an expected call of NewGetCMPositionModeService.
+func (mr *MockClientAPIMockRecorder) NewGetCMPositionModeService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewGetCMPositionModeService", reflect.TypeOf((*MockClientAPI)(nil).NewGetCMPositionModeService))
+}
+
+// NewGetCMPositionRiskService mocks base method.
+func (m *MockClientAPI) NewGetCMPositionRiskService() portfolio.GetCMPositionRiskServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewGetCMPositionRiskService")
+	ret0, _ := ret[0].(portfolio.GetCMPositionRiskServiceAPI)
+	return ret0
+}
+
+// NewGetCMPositionRiskService indicates an expected call of NewGetCMPositionRiskService.
+func (mr *MockClientAPIMockRecorder) NewGetCMPositionRiskService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewGetCMPositionRiskService", reflect.TypeOf((*MockClientAPI)(nil).NewGetCMPositionRiskService))
+}
+
+// NewGetMarginAllOrdersService mocks base method.
+func (m *MockClientAPI) NewGetMarginAllOrdersService() portfolio.GetMarginAllOrdersServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewGetMarginAllOrdersService")
+	ret0, _ := ret[0].(portfolio.GetMarginAllOrdersServiceAPI)
+	return ret0
+}
+
+// NewGetMarginAllOrdersService indicates an expected call of NewGetMarginAllOrdersService.
+func (mr *MockClientAPIMockRecorder) NewGetMarginAllOrdersService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewGetMarginAllOrdersService", reflect.TypeOf((*MockClientAPI)(nil).NewGetMarginAllOrdersService))
+}
+
+// NewGetMarginForceOrdersService mocks base method.
+func (m *MockClientAPI) NewGetMarginForceOrdersService() portfolio.GetMarginForceOrdersServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewGetMarginForceOrdersService")
+	ret0, _ := ret[0].(portfolio.GetMarginForceOrdersServiceAPI)
+	return ret0
+}
+
+// NewGetMarginForceOrdersService indicates an expected call of NewGetMarginForceOrdersService.
+func (mr *MockClientAPIMockRecorder) NewGetMarginForceOrdersService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewGetMarginForceOrdersService", reflect.TypeOf((*MockClientAPI)(nil).NewGetMarginForceOrdersService))
+}
+
+// NewGetMarginInterestHistoryService mocks base method.
+func (m *MockClientAPI) NewGetMarginInterestHistoryService() portfolio.GetMarginInterestHistoryServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewGetMarginInterestHistoryService")
+	ret0, _ := ret[0].(portfolio.GetMarginInterestHistoryServiceAPI)
+	return ret0
+}
+
+// NewGetMarginInterestHistoryService indicates an expected call of NewGetMarginInterestHistoryService.
+func (mr *MockClientAPIMockRecorder) NewGetMarginInterestHistoryService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewGetMarginInterestHistoryService", reflect.TypeOf((*MockClientAPI)(nil).NewGetMarginInterestHistoryService))
+}
+
+// NewGetMarginLoanService mocks base method.
+func (m *MockClientAPI) NewGetMarginLoanService() portfolio.GetMarginLoanServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewGetMarginLoanService")
+	ret0, _ := ret[0].(portfolio.GetMarginLoanServiceAPI)
+	return ret0
+}
+
+// NewGetMarginLoanService indicates an expected call of NewGetMarginLoanService.
+func (mr *MockClientAPIMockRecorder) NewGetMarginLoanService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewGetMarginLoanService", reflect.TypeOf((*MockClientAPI)(nil).NewGetMarginLoanService))
+}
+
+// NewGetMarginMaxBorrowService mocks base method.
+func (m *MockClientAPI) NewGetMarginMaxBorrowService() portfolio.GetMarginMaxBorrowServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewGetMarginMaxBorrowService")
+	ret0, _ := ret[0].(portfolio.GetMarginMaxBorrowServiceAPI)
+	return ret0
+}
+
+// NewGetMarginMaxBorrowService indicates an expected call of NewGetMarginMaxBorrowService.
+func (mr *MockClientAPIMockRecorder) NewGetMarginMaxBorrowService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewGetMarginMaxBorrowService", reflect.TypeOf((*MockClientAPI)(nil).NewGetMarginMaxBorrowService))
+}
+
+// NewGetMarginMaxWithdrawService mocks base method.
+func (m *MockClientAPI) NewGetMarginMaxWithdrawService() portfolio.GetMarginMaxWithdrawServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewGetMarginMaxWithdrawService")
+	ret0, _ := ret[0].(portfolio.GetMarginMaxWithdrawServiceAPI)
+	return ret0
+}
+
+// NewGetMarginMaxWithdrawService indicates an expected call of NewGetMarginMaxWithdrawService.
+func (mr *MockClientAPIMockRecorder) NewGetMarginMaxWithdrawService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewGetMarginMaxWithdrawService", reflect.TypeOf((*MockClientAPI)(nil).NewGetMarginMaxWithdrawService))
+}
+
+// NewGetMarginOpenOrdersService mocks base method.
+func (m *MockClientAPI) NewGetMarginOpenOrdersService() portfolio.GetMarginOpenOrdersServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewGetMarginOpenOrdersService")
+	ret0, _ := ret[0].(portfolio.GetMarginOpenOrdersServiceAPI)
+	return ret0
+}
+
+// NewGetMarginOpenOrdersService indicates an expected call of NewGetMarginOpenOrdersService.
+func (mr *MockClientAPIMockRecorder) NewGetMarginOpenOrdersService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewGetMarginOpenOrdersService", reflect.TypeOf((*MockClientAPI)(nil).NewGetMarginOpenOrdersService))
+}
+
+// NewGetMarginRepayService mocks base method.
+func (m *MockClientAPI) NewGetMarginRepayService() portfolio.GetMarginRepayServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewGetMarginRepayService")
+	ret0, _ := ret[0].(portfolio.GetMarginRepayServiceAPI)
+	return ret0
+}
+
+// NewGetMarginRepayService indicates an expected call of NewGetMarginRepayService.
+func (mr *MockClientAPIMockRecorder) NewGetMarginRepayService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewGetMarginRepayService", reflect.TypeOf((*MockClientAPI)(nil).NewGetMarginRepayService))
+}
+
+// NewGetNegativeBalanceExchangeRecordService mocks base method.
+func (m *MockClientAPI) NewGetNegativeBalanceExchangeRecordService() portfolio.GetNegativeBalanceExchangeRecordServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewGetNegativeBalanceExchangeRecordService")
+	ret0, _ := ret[0].(portfolio.GetNegativeBalanceExchangeRecordServiceAPI)
+	return ret0
+}
+
+// NewGetNegativeBalanceExchangeRecordService indicates an expected call of NewGetNegativeBalanceExchangeRecordService.
+func (mr *MockClientAPIMockRecorder) NewGetNegativeBalanceExchangeRecordService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewGetNegativeBalanceExchangeRecordService", reflect.TypeOf((*MockClientAPI)(nil).NewGetNegativeBalanceExchangeRecordService))
+}
+
+// NewGetNegativeBalanceInterestHistoryService mocks base method.
+func (m *MockClientAPI) NewGetNegativeBalanceInterestHistoryService() portfolio.GetNegativeBalanceInterestHistoryServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewGetNegativeBalanceInterestHistoryService")
+	ret0, _ := ret[0].(portfolio.GetNegativeBalanceInterestHistoryServiceAPI)
+	return ret0
+}
+
+// NewGetNegativeBalanceInterestHistoryService indicates an expected call of NewGetNegativeBalanceInterestHistoryService.
+func (mr *MockClientAPIMockRecorder) NewGetNegativeBalanceInterestHistoryService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewGetNegativeBalanceInterestHistoryService", reflect.TypeOf((*MockClientAPI)(nil).NewGetNegativeBalanceInterestHistoryService))
+}
+
+// NewGetRateLimitService mocks base method.
+func (m *MockClientAPI) NewGetRateLimitService() portfolio.GetRateLimitServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewGetRateLimitService")
+	ret0, _ := ret[0].(portfolio.GetRateLimitServiceAPI)
+	return ret0
+}
+
+// NewGetRateLimitService indicates an expected call of NewGetRateLimitService.
+func (mr *MockClientAPIMockRecorder) NewGetRateLimitService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewGetRateLimitService", reflect.TypeOf((*MockClientAPI)(nil).NewGetRateLimitService))
+}
+
+// NewGetUMAccountConfigService mocks base method.
+func (m *MockClientAPI) NewGetUMAccountConfigService() portfolio.UMAccountConfigServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewGetUMAccountConfigService")
+	ret0, _ := ret[0].(portfolio.UMAccountConfigServiceAPI)
+	return ret0
+}
+
+// NewGetUMAccountConfigService indicates an expected call of NewGetUMAccountConfigService.
+func (mr *MockClientAPIMockRecorder) NewGetUMAccountConfigService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewGetUMAccountConfigService", reflect.TypeOf((*MockClientAPI)(nil).NewGetUMAccountConfigService))
+}
+
+// NewGetUMAccountDetailService mocks base method.
+func (m *MockClientAPI) NewGetUMAccountDetailService() portfolio.GetUMAccountDetailServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewGetUMAccountDetailService")
+	ret0, _ := ret[0].(portfolio.GetUMAccountDetailServiceAPI)
+	return ret0
+}
+
+// NewGetUMAccountDetailService indicates an expected call of NewGetUMAccountDetailService.
+func (mr *MockClientAPIMockRecorder) NewGetUMAccountDetailService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewGetUMAccountDetailService", reflect.TypeOf((*MockClientAPI)(nil).NewGetUMAccountDetailService))
+}
+
+// NewGetUMAccountDetailV2Service mocks base method.
+func (m *MockClientAPI) NewGetUMAccountDetailV2Service() portfolio.UMAccountDetailV2ServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewGetUMAccountDetailV2Service")
+	ret0, _ := ret[0].(portfolio.UMAccountDetailV2ServiceAPI)
+	return ret0
+}
+
+// NewGetUMAccountDetailV2Service indicates an expected call of NewGetUMAccountDetailV2Service.
+func (mr *MockClientAPIMockRecorder) NewGetUMAccountDetailV2Service() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewGetUMAccountDetailV2Service", reflect.TypeOf((*MockClientAPI)(nil).NewGetUMAccountDetailV2Service))
+}
+
+// NewGetUMCommissionRateService mocks base method.
+func (m *MockClientAPI) NewGetUMCommissionRateService() portfolio.GetUMCommissionRateServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewGetUMCommissionRateService")
+	ret0, _ := ret[0].(portfolio.GetUMCommissionRateServiceAPI)
+	return ret0
+}
+
+// NewGetUMCommissionRateService indicates an expected call of NewGetUMCommissionRateService.
+func (mr *MockClientAPIMockRecorder) NewGetUMCommissionRateService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewGetUMCommissionRateService", reflect.TypeOf((*MockClientAPI)(nil).NewGetUMCommissionRateService))
+}
+
+// NewGetUMIncomeHistoryService mocks base method.
+func (m *MockClientAPI) NewGetUMIncomeHistoryService() portfolio.GetUMIncomeHistoryServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewGetUMIncomeHistoryService")
+	ret0, _ := ret[0].(portfolio.GetUMIncomeHistoryServiceAPI)
+	return ret0
+}
+
+// NewGetUMIncomeHistoryService indicates an expected call of NewGetUMIncomeHistoryService.
+func (mr *MockClientAPIMockRecorder) NewGetUMIncomeHistoryService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewGetUMIncomeHistoryService", reflect.TypeOf((*MockClientAPI)(nil).NewGetUMIncomeHistoryService))
+}
+
+// NewGetUMLeverageBracketService mocks base method.
+func (m *MockClientAPI) NewGetUMLeverageBracketService() portfolio.GetUMLeverageBracketServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewGetUMLeverageBracketService")
+	ret0, _ := ret[0].(portfolio.GetUMLeverageBracketServiceAPI)
+	return ret0
+}
+
+// NewGetUMLeverageBracketService indicates an expected call of NewGetUMLeverageBracketService.
+func (mr *MockClientAPIMockRecorder) NewGetUMLeverageBracketService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewGetUMLeverageBracketService", reflect.TypeOf((*MockClientAPI)(nil).NewGetUMLeverageBracketService))
+}
+
+// NewGetUMOrderDownloadLinkService mocks base method.
+func (m *MockClientAPI) NewGetUMOrderDownloadLinkService() portfolio.GetUMOrderDownloadLinkServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewGetUMOrderDownloadLinkService")
+	ret0, _ := ret[0].(portfolio.GetUMOrderDownloadLinkServiceAPI)
+	return ret0
+}
+
+// NewGetUMOrderDownloadLinkService indicates an expected call of NewGetUMOrderDownloadLinkService.
+func (mr *MockClientAPIMockRecorder) NewGetUMOrderDownloadLinkService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewGetUMOrderDownloadLinkService", reflect.TypeOf((*MockClientAPI)(nil).NewGetUMOrderDownloadLinkService))
+}
+
+// NewGetUMOrderHistoryDownloadIDService mocks base method.
+func (m *MockClientAPI) NewGetUMOrderHistoryDownloadIDService() portfolio.GetUMOrderHistoryDownloadIDServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewGetUMOrderHistoryDownloadIDService")
+	ret0, _ := ret[0].(portfolio.GetUMOrderHistoryDownloadIDServiceAPI)
+	return ret0
+}
+
+// NewGetUMOrderHistoryDownloadIDService indicates an expected call of NewGetUMOrderHistoryDownloadIDService.
+func (mr *MockClientAPIMockRecorder) NewGetUMOrderHistoryDownloadIDService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewGetUMOrderHistoryDownloadIDService", reflect.TypeOf((*MockClientAPI)(nil).NewGetUMOrderHistoryDownloadIDService))
+}
+
+// NewGetUMPositionModeService mocks base method.
+func (m *MockClientAPI) NewGetUMPositionModeService() portfolio.GetUMPositionModeServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewGetUMPositionModeService")
+	ret0, _ := ret[0].(portfolio.GetUMPositionModeServiceAPI)
+	return ret0
+}
+
+// NewGetUMPositionModeService indicates an expected call of NewGetUMPositionModeService.
+func (mr *MockClientAPIMockRecorder) NewGetUMPositionModeService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewGetUMPositionModeService", reflect.TypeOf((*MockClientAPI)(nil).NewGetUMPositionModeService))
+}
+
+// NewGetUMPositionRiskService mocks base method.
+func (m *MockClientAPI) NewGetUMPositionRiskService() portfolio.GetUMPositionRiskServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewGetUMPositionRiskService")
+	ret0, _ := ret[0].(portfolio.GetUMPositionRiskServiceAPI)
+	return ret0
+}
+
+// NewGetUMPositionRiskService indicates an expected call of NewGetUMPositionRiskService.
+func (mr *MockClientAPIMockRecorder) NewGetUMPositionRiskService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewGetUMPositionRiskService", reflect.TypeOf((*MockClientAPI)(nil).NewGetUMPositionRiskService))
+}
+
+// NewGetUMSymbolConfigService mocks base method.
+func (m *MockClientAPI) NewGetUMSymbolConfigService() portfolio.UMSymbolConfigServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewGetUMSymbolConfigService")
+	ret0, _ := ret[0].(portfolio.UMSymbolConfigServiceAPI)
+	return ret0
+}
+
+// NewGetUMSymbolConfigService indicates an expected call of NewGetUMSymbolConfigService.
+func (mr *MockClientAPIMockRecorder) NewGetUMSymbolConfigService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewGetUMSymbolConfigService", reflect.TypeOf((*MockClientAPI)(nil).NewGetUMSymbolConfigService))
+}
+
+// NewGetUMTradeDownloadLinkService mocks base method.
+func (m *MockClientAPI) NewGetUMTradeDownloadLinkService() portfolio.GetUMTradeDownloadLinkServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewGetUMTradeDownloadLinkService")
+	ret0, _ := ret[0].(portfolio.GetUMTradeDownloadLinkServiceAPI)
+	return ret0
+}
+
+// NewGetUMTradeDownloadLinkService indicates an expected call of NewGetUMTradeDownloadLinkService.
+func (mr *MockClientAPIMockRecorder) NewGetUMTradeDownloadLinkService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewGetUMTradeDownloadLinkService", reflect.TypeOf((*MockClientAPI)(nil).NewGetUMTradeDownloadLinkService))
+}
+
+// NewGetUMTradeHistoryDownloadIDService mocks base method.
+func (m *MockClientAPI) NewGetUMTradeHistoryDownloadIDService() portfolio.GetUMTradeHistoryDownloadIDServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewGetUMTradeHistoryDownloadIDService")
+	ret0, _ := ret[0].(portfolio.GetUMTradeHistoryDownloadIDServiceAPI)
+	return ret0
+}
+
+// NewGetUMTradeHistoryDownloadIDService indicates an expected call of NewGetUMTradeHistoryDownloadIDService.
+func (mr *MockClientAPIMockRecorder) NewGetUMTradeHistoryDownloadIDService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewGetUMTradeHistoryDownloadIDService", reflect.TypeOf((*MockClientAPI)(nil).NewGetUMTradeHistoryDownloadIDService))
+}
+
+// NewGetUMTradingStatusService mocks base method.
+func (m *MockClientAPI) NewGetUMTradingStatusService() portfolio.GetUMTradingStatusServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewGetUMTradingStatusService")
+	ret0, _ := ret[0].(portfolio.GetUMTradingStatusServiceAPI)
+	return ret0
+}
+
+// NewGetUMTradingStatusService indicates an expected call of NewGetUMTradingStatusService.
+func (mr *MockClientAPIMockRecorder) NewGetUMTradingStatusService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewGetUMTradingStatusService", reflect.TypeOf((*MockClientAPI)(nil).NewGetUMTradingStatusService))
+}
+
+// NewGetUMTransactionDownloadLinkService mocks base method.
+func (m *MockClientAPI) NewGetUMTransactionDownloadLinkService() portfolio.GetUMTransactionDownloadLinkServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewGetUMTransactionDownloadLinkService")
+	ret0, _ := ret[0].(portfolio.GetUMTransactionDownloadLinkServiceAPI)
+	return ret0
+}
+
+// NewGetUMTransactionDownloadLinkService indicates an expected call of NewGetUMTransactionDownloadLinkService.
+func (mr *MockClientAPIMockRecorder) NewGetUMTransactionDownloadLinkService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewGetUMTransactionDownloadLinkService", reflect.TypeOf((*MockClientAPI)(nil).NewGetUMTransactionDownloadLinkService))
+}
+
+// NewGetUMTransactionHistoryDownloadIDService mocks base method.
+func (m *MockClientAPI) NewGetUMTransactionHistoryDownloadIDService() portfolio.GetUMTransactionHistoryDownloadIDServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewGetUMTransactionHistoryDownloadIDService")
+	ret0, _ := ret[0].(portfolio.GetUMTransactionHistoryDownloadIDServiceAPI)
+	return ret0
+}
+
+// NewGetUMTransactionHistoryDownloadIDService indicates an expected call of NewGetUMTransactionHistoryDownloadIDService.
+func (mr *MockClientAPIMockRecorder) NewGetUMTransactionHistoryDownloadIDService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewGetUMTransactionHistoryDownloadIDService", reflect.TypeOf((*MockClientAPI)(nil).NewGetUMTransactionHistoryDownloadIDService))
+}
+
+// NewKeepaliveUserStreamService mocks base method.
+func (m *MockClientAPI) NewKeepaliveUserStreamService() portfolio.KeepaliveUserStreamServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewKeepaliveUserStreamService")
+	ret0, _ := ret[0].(portfolio.KeepaliveUserStreamServiceAPI)
+	return ret0
+}
+
+// NewKeepaliveUserStreamService indicates an expected call of NewKeepaliveUserStreamService.
+func (mr *MockClientAPIMockRecorder) NewKeepaliveUserStreamService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewKeepaliveUserStreamService", reflect.TypeOf((*MockClientAPI)(nil).NewKeepaliveUserStreamService))
+}
+
+// NewMarginAccountTradesService mocks base method.
+func (m *MockClientAPI) NewMarginAccountTradesService() portfolio.MarginAccountTradesServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewMarginAccountTradesService")
+	ret0, _ := ret[0].(portfolio.MarginAccountTradesServiceAPI)
+	return ret0
+}
+
+// NewMarginAccountTradesService indicates an expected call of NewMarginAccountTradesService.
+func (mr *MockClientAPIMockRecorder) NewMarginAccountTradesService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewMarginAccountTradesService", reflect.TypeOf((*MockClientAPI)(nil).NewMarginAccountTradesService))
+}
+
+// NewMarginAllOCOService mocks base method.
+func (m *MockClientAPI) NewMarginAllOCOService() portfolio.MarginAllOCOServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewMarginAllOCOService")
+	ret0, _ := ret[0].(portfolio.MarginAllOCOServiceAPI)
+	return ret0
+}
+
+// NewMarginAllOCOService indicates an expected call of NewMarginAllOCOService.
+func (mr *MockClientAPIMockRecorder) NewMarginAllOCOService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewMarginAllOCOService", reflect.TypeOf((*MockClientAPI)(nil).NewMarginAllOCOService))
+}
+
+// NewMarginCancelAllOrdersService mocks base method.
+func (m *MockClientAPI) NewMarginCancelAllOrdersService() portfolio.MarginCancelAllOrdersServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewMarginCancelAllOrdersService")
+	ret0, _ := ret[0].(portfolio.MarginCancelAllOrdersServiceAPI)
+	return ret0
+}
+
+// NewMarginCancelAllOrdersService indicates an expected call of NewMarginCancelAllOrdersService.
+func (mr *MockClientAPIMockRecorder) NewMarginCancelAllOrdersService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewMarginCancelAllOrdersService", reflect.TypeOf((*MockClientAPI)(nil).NewMarginCancelAllOrdersService))
+}
+
+// NewMarginCancelOCOService mocks base method.
+func (m *MockClientAPI) NewMarginCancelOCOService() portfolio.MarginCancelOCOServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewMarginCancelOCOService")
+	ret0, _ := ret[0].(portfolio.MarginCancelOCOServiceAPI)
+	return ret0
+}
+
+// NewMarginCancelOCOService indicates an expected call of NewMarginCancelOCOService.
+func (mr *MockClientAPIMockRecorder) NewMarginCancelOCOService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewMarginCancelOCOService", reflect.TypeOf((*MockClientAPI)(nil).NewMarginCancelOCOService))
+}
+
+// NewMarginCancelOrderService mocks base method.
+func (m *MockClientAPI) NewMarginCancelOrderService() portfolio.MarginCancelOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewMarginCancelOrderService")
+	ret0, _ := ret[0].(portfolio.MarginCancelOrderServiceAPI)
+	return ret0
+}
+
+// NewMarginCancelOrderService indicates an expected call of NewMarginCancelOrderService.
+func (mr *MockClientAPIMockRecorder) NewMarginCancelOrderService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewMarginCancelOrderService", reflect.TypeOf((*MockClientAPI)(nil).NewMarginCancelOrderService))
+}
+
+// NewMarginForceOrdersService mocks base method.
+func (m *MockClientAPI) NewMarginForceOrdersService() portfolio.MarginForceOrdersServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewMarginForceOrdersService")
+	ret0, _ := ret[0].(portfolio.MarginForceOrdersServiceAPI)
+	return ret0
+}
+
+// NewMarginForceOrdersService indicates an expected call of NewMarginForceOrdersService.
+func (mr *MockClientAPIMockRecorder) NewMarginForceOrdersService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewMarginForceOrdersService", reflect.TypeOf((*MockClientAPI)(nil).NewMarginForceOrdersService))
+}
+
+// NewMarginLoanService mocks base method.
+func (m *MockClientAPI) NewMarginLoanService() portfolio.MarginLoanServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewMarginLoanService")
+	ret0, _ := ret[0].(portfolio.MarginLoanServiceAPI)
+	return ret0
+}
+
+// NewMarginLoanService indicates an expected call of NewMarginLoanService.
+func (mr *MockClientAPIMockRecorder) NewMarginLoanService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewMarginLoanService", reflect.TypeOf((*MockClientAPI)(nil).NewMarginLoanService))
+}
+
+// NewMarginOCOQueryService mocks base method.
+func (m *MockClientAPI) NewMarginOCOQueryService() portfolio.MarginOCOQueryServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewMarginOCOQueryService")
+	ret0, _ := ret[0].(portfolio.MarginOCOQueryServiceAPI)
+	return ret0
+}
+
+// NewMarginOCOQueryService indicates an expected call of NewMarginOCOQueryService.
+func (mr *MockClientAPIMockRecorder) NewMarginOCOQueryService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewMarginOCOQueryService", reflect.TypeOf((*MockClientAPI)(nil).NewMarginOCOQueryService))
+}
+
+// NewMarginOCOService mocks base method.
+func (m *MockClientAPI) NewMarginOCOService() portfolio.MarginOCOServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewMarginOCOService")
+	ret0, _ := ret[0].(portfolio.MarginOCOServiceAPI)
+	return ret0
+}
+
+// NewMarginOCOService indicates an expected call of NewMarginOCOService.
+func (mr *MockClientAPIMockRecorder) NewMarginOCOService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewMarginOCOService", reflect.TypeOf((*MockClientAPI)(nil).NewMarginOCOService))
+}
+
+// NewMarginOpenOCOService mocks base method.
+func (m *MockClientAPI) NewMarginOpenOCOService() portfolio.MarginOpenOCOServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewMarginOpenOCOService")
+	ret0, _ := ret[0].(portfolio.MarginOpenOCOServiceAPI)
+	return ret0
+}
+
+// NewMarginOpenOCOService indicates an expected call of NewMarginOpenOCOService.
+func (mr *MockClientAPIMockRecorder) NewMarginOpenOCOService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewMarginOpenOCOService", reflect.TypeOf((*MockClientAPI)(nil).NewMarginOpenOCOService))
+}
+
+// NewMarginOrderService mocks base method.
+func (m *MockClientAPI) NewMarginOrderService() portfolio.MarginOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewMarginOrderService")
+	ret0, _ := ret[0].(portfolio.MarginOrderServiceAPI)
+	return ret0
+}
+
+// NewMarginOrderService indicates an expected call of NewMarginOrderService.
+func (mr *MockClientAPIMockRecorder) NewMarginOrderService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewMarginOrderService", reflect.TypeOf((*MockClientAPI)(nil).NewMarginOrderService))
+}
+
+// NewMarginRepayDebtService mocks base method.
+func (m *MockClientAPI) NewMarginRepayDebtService() portfolio.MarginRepayDebtServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewMarginRepayDebtService")
+	ret0, _ := ret[0].(portfolio.MarginRepayDebtServiceAPI)
+	return ret0
+}
+
+// NewMarginRepayDebtService indicates an expected call of NewMarginRepayDebtService.
+func (mr *MockClientAPIMockRecorder) NewMarginRepayDebtService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewMarginRepayDebtService", reflect.TypeOf((*MockClientAPI)(nil).NewMarginRepayDebtService))
+}
+
+// NewMarginRepayService mocks base method.
+func (m *MockClientAPI) NewMarginRepayService() portfolio.MarginRepayServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewMarginRepayService")
+	ret0, _ := ret[0].(portfolio.MarginRepayServiceAPI)
+	return ret0
+}
+
+// NewMarginRepayService indicates an expected call of NewMarginRepayService.
+func (mr *MockClientAPIMockRecorder) NewMarginRepayService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewMarginRepayService", reflect.TypeOf((*MockClientAPI)(nil).NewMarginRepayService))
+}
+
+// NewPingService mocks base method.
+func (m *MockClientAPI) NewPingService() portfolio.PingServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewPingService")
+	ret0, _ := ret[0].(portfolio.PingServiceAPI)
+	return ret0
+}
+
+// NewPingService indicates an expected call of NewPingService.
+func (mr *MockClientAPIMockRecorder) NewPingService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewPingService", reflect.TypeOf((*MockClientAPI)(nil).NewPingService))
+}
+
+// NewRepayFuturesNegativeBalanceService mocks base method.
+func (m *MockClientAPI) NewRepayFuturesNegativeBalanceService() portfolio.RepayFuturesNegativeBalanceServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewRepayFuturesNegativeBalanceService")
+	ret0, _ := ret[0].(portfolio.RepayFuturesNegativeBalanceServiceAPI)
+	return ret0
+}
+
+// NewRepayFuturesNegativeBalanceService indicates an expected call of NewRepayFuturesNegativeBalanceService.
+func (mr *MockClientAPIMockRecorder) NewRepayFuturesNegativeBalanceService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewRepayFuturesNegativeBalanceService", reflect.TypeOf((*MockClientAPI)(nil).NewRepayFuturesNegativeBalanceService))
+}
+
+// NewStartUserStreamService mocks base method.
+func (m *MockClientAPI) NewStartUserStreamService() portfolio.StartUserStreamServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewStartUserStreamService")
+	ret0, _ := ret[0].(portfolio.StartUserStreamServiceAPI)
+	return ret0
+}
+
+// NewStartUserStreamService indicates an expected call of NewStartUserStreamService.
+func (mr *MockClientAPIMockRecorder) NewStartUserStreamService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewStartUserStreamService", reflect.TypeOf((*MockClientAPI)(nil).NewStartUserStreamService))
+}
+
+// NewUMADLQuantileService mocks base method.
+func (m *MockClientAPI) NewUMADLQuantileService() portfolio.UMADLQuantileServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewUMADLQuantileService")
+	ret0, _ := ret[0].(portfolio.UMADLQuantileServiceAPI)
+	return ret0
+}
+
+// NewUMADLQuantileService indicates an expected call of NewUMADLQuantileService.
+func (mr *MockClientAPIMockRecorder) NewUMADLQuantileService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewUMADLQuantileService", reflect.TypeOf((*MockClientAPI)(nil).NewUMADLQuantileService))
+}
+
+// NewUMAccountTradeService mocks base method.
+func (m *MockClientAPI) NewUMAccountTradeService() portfolio.UMAccountTradeServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewUMAccountTradeService")
+	ret0, _ := ret[0].(portfolio.UMAccountTradeServiceAPI)
+	return ret0
+}
+
+// NewUMAccountTradeService indicates an expected call of NewUMAccountTradeService.
+func (mr *MockClientAPIMockRecorder) NewUMAccountTradeService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewUMAccountTradeService", reflect.TypeOf((*MockClientAPI)(nil).NewUMAccountTradeService))
+}
+
+// NewUMAccountTradesService mocks base method.
+func (m *MockClientAPI) NewUMAccountTradesService() portfolio.UMAccountTradesServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewUMAccountTradesService")
+	ret0, _ := ret[0].(portfolio.UMAccountTradesServiceAPI)
+	return ret0
+}
+
+// NewUMAccountTradesService indicates an expected call of NewUMAccountTradesService.
+func (mr *MockClientAPIMockRecorder) NewUMAccountTradesService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewUMAccountTradesService", reflect.TypeOf((*MockClientAPI)(nil).NewUMAccountTradesService))
+}
+
+// NewUMAllConditionalOrdersService mocks base method.
+func (m *MockClientAPI) NewUMAllConditionalOrdersService() portfolio.UMAllConditionalOrdersServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewUMAllConditionalOrdersService")
+	ret0, _ := ret[0].(portfolio.UMAllConditionalOrdersServiceAPI)
+	return ret0
+}
+
+// NewUMAllConditionalOrdersService indicates an expected call of NewUMAllConditionalOrdersService.
+func (mr *MockClientAPIMockRecorder) NewUMAllConditionalOrdersService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewUMAllConditionalOrdersService", reflect.TypeOf((*MockClientAPI)(nil).NewUMAllConditionalOrdersService))
+}
+
+// NewUMAllOrdersService mocks base method.
+func (m *MockClientAPI) NewUMAllOrdersService() portfolio.UMAllOrdersServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewUMAllOrdersService")
+	ret0, _ := ret[0].(portfolio.UMAllOrdersServiceAPI)
+	return ret0
+}
+
+// NewUMAllOrdersService indicates an expected call of NewUMAllOrdersService.
+func (mr *MockClientAPIMockRecorder) NewUMAllOrdersService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewUMAllOrdersService", reflect.TypeOf((*MockClientAPI)(nil).NewUMAllOrdersService))
+}
+
+// NewUMCancelAllConditionalOrdersService mocks base method.
+func (m *MockClientAPI) NewUMCancelAllConditionalOrdersService() portfolio.UMCancelAllConditionalOrdersServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewUMCancelAllConditionalOrdersService")
+	ret0, _ := ret[0].(portfolio.UMCancelAllConditionalOrdersServiceAPI)
+	return ret0
+}
+
+// NewUMCancelAllConditionalOrdersService indicates an expected call of NewUMCancelAllConditionalOrdersService.
+func (mr *MockClientAPIMockRecorder) NewUMCancelAllConditionalOrdersService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewUMCancelAllConditionalOrdersService", reflect.TypeOf((*MockClientAPI)(nil).NewUMCancelAllConditionalOrdersService))
+}
+
+// NewUMCancelAllOrdersService mocks base method.
+func (m *MockClientAPI) NewUMCancelAllOrdersService() portfolio.UMCancelAllOrdersServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewUMCancelAllOrdersService")
+	ret0, _ := ret[0].(portfolio.UMCancelAllOrdersServiceAPI)
+	return ret0
+}
+
+// NewUMCancelAllOrdersService indicates an expected call of NewUMCancelAllOrdersService.
+func (mr *MockClientAPIMockRecorder) NewUMCancelAllOrdersService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewUMCancelAllOrdersService", reflect.TypeOf((*MockClientAPI)(nil).NewUMCancelAllOrdersService))
+}
+
+// NewUMCancelConditionalOrderService mocks base method.
+func (m *MockClientAPI) NewUMCancelConditionalOrderService() portfolio.UMCancelConditionalOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewUMCancelConditionalOrderService")
+	ret0, _ := ret[0].(portfolio.UMCancelConditionalOrderServiceAPI)
+	return ret0
+}
+
+// NewUMCancelConditionalOrderService indicates an expected call of NewUMCancelConditionalOrderService.
+func (mr *MockClientAPIMockRecorder) NewUMCancelConditionalOrderService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewUMCancelConditionalOrderService", reflect.TypeOf((*MockClientAPI)(nil).NewUMCancelConditionalOrderService))
+}
+
+// NewUMCancelOrderService mocks base method.
+func (m *MockClientAPI) NewUMCancelOrderService() portfolio.UMCancelOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewUMCancelOrderService")
+	ret0, _ := ret[0].(portfolio.UMCancelOrderServiceAPI)
+	return ret0
+}
+
+// NewUMCancelOrderService indicates an expected call of NewUMCancelOrderService.
+func (mr *MockClientAPIMockRecorder) NewUMCancelOrderService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewUMCancelOrderService", reflect.TypeOf((*MockClientAPI)(nil).NewUMCancelOrderService))
+}
+
+// NewUMConditionalOrderHistoryService mocks base method.
+func (m *MockClientAPI) NewUMConditionalOrderHistoryService() portfolio.UMConditionalOrderHistoryServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewUMConditionalOrderHistoryService")
+	ret0, _ := ret[0].(portfolio.UMConditionalOrderHistoryServiceAPI)
+	return ret0
+}
+
+// NewUMConditionalOrderHistoryService indicates an expected call of NewUMConditionalOrderHistoryService.
+func (mr *MockClientAPIMockRecorder) NewUMConditionalOrderHistoryService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewUMConditionalOrderHistoryService", reflect.TypeOf((*MockClientAPI)(nil).NewUMConditionalOrderHistoryService))
+}
+
+// NewUMConditionalOrderService mocks base method.
+func (m *MockClientAPI) NewUMConditionalOrderService() portfolio.UMConditionalOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewUMConditionalOrderService")
+	ret0, _ := ret[0].(portfolio.UMConditionalOrderServiceAPI)
+	return ret0
+}
+
+// NewUMConditionalOrderService indicates an expected call of NewUMConditionalOrderService.
+func (mr *MockClientAPIMockRecorder) NewUMConditionalOrderService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewUMConditionalOrderService", reflect.TypeOf((*MockClientAPI)(nil).NewUMConditionalOrderService))
+}
+
+// NewUMFeeBurnService mocks base method.
+func (m *MockClientAPI) NewUMFeeBurnService() portfolio.UMFeeBurnServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewUMFeeBurnService")
+	ret0, _ := ret[0].(portfolio.UMFeeBurnServiceAPI)
+	return ret0
+}
+
+// NewUMFeeBurnService indicates an expected call of NewUMFeeBurnService.
+func (mr *MockClientAPIMockRecorder) NewUMFeeBurnService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewUMFeeBurnService", reflect.TypeOf((*MockClientAPI)(nil).NewUMFeeBurnService))
+}
+
+// NewUMFeeBurnStatusService mocks base method.
+func (m *MockClientAPI) NewUMFeeBurnStatusService() portfolio.UMFeeBurnStatusServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewUMFeeBurnStatusService")
+	ret0, _ := ret[0].(portfolio.UMFeeBurnStatusServiceAPI)
+	return ret0
+}
+
+// NewUMFeeBurnStatusService indicates an expected call of NewUMFeeBurnStatusService.
+func (mr *MockClientAPIMockRecorder) NewUMFeeBurnStatusService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewUMFeeBurnStatusService", reflect.TypeOf((*MockClientAPI)(nil).NewUMFeeBurnStatusService))
+}
+
+// NewUMForceOrdersService mocks base method.
+func (m *MockClientAPI) NewUMForceOrdersService() portfolio.UMForceOrdersServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewUMForceOrdersService")
+	ret0, _ := ret[0].(portfolio.UMForceOrdersServiceAPI)
+	return ret0
+}
+
+// NewUMForceOrdersService indicates an expected call of NewUMForceOrdersService.
+func (mr *MockClientAPIMockRecorder) NewUMForceOrdersService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewUMForceOrdersService", reflect.TypeOf((*MockClientAPI)(nil).NewUMForceOrdersService))
+}
+
+// NewUMGetADLQuantileService mocks base method.
+func (m *MockClientAPI) NewUMGetADLQuantileService() portfolio.UMGetADLQuantileServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewUMGetADLQuantileService")
+	ret0, _ := ret[0].(portfolio.UMGetADLQuantileServiceAPI)
+	return ret0
+}
+
+// NewUMGetADLQuantileService indicates an expected call of NewUMGetADLQuantileService.
+func (mr *MockClientAPIMockRecorder) NewUMGetADLQuantileService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewUMGetADLQuantileService", reflect.TypeOf((*MockClientAPI)(nil).NewUMGetADLQuantileService))
+}
+
+// NewUMModifyOrderHistoryService mocks base method.
+func (m *MockClientAPI) NewUMModifyOrderHistoryService() portfolio.UMModifyOrderHistoryServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewUMModifyOrderHistoryService")
+	ret0, _ := ret[0].(portfolio.UMModifyOrderHistoryServiceAPI)
+	return ret0
+}
+
+// NewUMModifyOrderHistoryService indicates an expected call of NewUMModifyOrderHistoryService.
+func (mr *MockClientAPIMockRecorder) NewUMModifyOrderHistoryService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewUMModifyOrderHistoryService", reflect.TypeOf((*MockClientAPI)(nil).NewUMModifyOrderHistoryService))
+}
+
+// NewUMModifyOrderService mocks base method.
+func (m *MockClientAPI) NewUMModifyOrderService() portfolio.UMModifyOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewUMModifyOrderService")
+	ret0, _ := ret[0].(portfolio.UMModifyOrderServiceAPI)
+	return ret0
+}
+
+// NewUMModifyOrderService indicates an expected call of NewUMModifyOrderService.
+func (mr *MockClientAPIMockRecorder) NewUMModifyOrderService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewUMModifyOrderService", reflect.TypeOf((*MockClientAPI)(nil).NewUMModifyOrderService))
+}
+
+// NewUMOpenConditionalOrderService mocks base method.
+func (m *MockClientAPI) NewUMOpenConditionalOrderService() portfolio.UMOpenConditionalOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewUMOpenConditionalOrderService")
+	ret0, _ := ret[0].(portfolio.UMOpenConditionalOrderServiceAPI)
+	return ret0
+}
+
+// NewUMOpenConditionalOrderService indicates an expected call of NewUMOpenConditionalOrderService.
+func (mr *MockClientAPIMockRecorder) NewUMOpenConditionalOrderService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewUMOpenConditionalOrderService", reflect.TypeOf((*MockClientAPI)(nil).NewUMOpenConditionalOrderService))
+}
+
+// NewUMOpenConditionalOrdersService mocks base method.
+func (m *MockClientAPI) NewUMOpenConditionalOrdersService() portfolio.UMOpenConditionalOrdersServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewUMOpenConditionalOrdersService")
+	ret0, _ := ret[0].(portfolio.UMOpenConditionalOrdersServiceAPI)
+	return ret0
+}
+
+// NewUMOpenConditionalOrdersService indicates an expected call of NewUMOpenConditionalOrdersService.
+func (mr *MockClientAPIMockRecorder) NewUMOpenConditionalOrdersService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewUMOpenConditionalOrdersService", reflect.TypeOf((*MockClientAPI)(nil).NewUMOpenConditionalOrdersService))
+}
+
+// NewUMOpenOrderService mocks base method.
+func (m *MockClientAPI) NewUMOpenOrderService() portfolio.UMOpenOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewUMOpenOrderService")
+	ret0, _ := ret[0].(portfolio.UMOpenOrderServiceAPI)
+	return ret0
+}
+
+// NewUMOpenOrderService indicates an expected call of NewUMOpenOrderService.
+func (mr *MockClientAPIMockRecorder) NewUMOpenOrderService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewUMOpenOrderService", reflect.TypeOf((*MockClientAPI)(nil).NewUMOpenOrderService))
+}
+
+// NewUMOpenOrdersService mocks base method.
+func (m *MockClientAPI) NewUMOpenOrdersService() portfolio.UMOpenOrdersServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewUMOpenOrdersService")
+	ret0, _ := ret[0].(portfolio.UMOpenOrdersServiceAPI)
+	return ret0
+}
+
+// NewUMOpenOrdersService indicates an expected call of NewUMOpenOrdersService.
+func (mr *MockClientAPIMockRecorder) NewUMOpenOrdersService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewUMOpenOrdersService", reflect.TypeOf((*MockClientAPI)(nil).NewUMOpenOrdersService))
+}
+
+// NewUMOrderService mocks base method.
+func (m *MockClientAPI) NewUMOrderService() portfolio.UMOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewUMOrderService")
+	ret0, _ := ret[0].(portfolio.UMOrderServiceAPI)
+	return ret0
+}
+
+// NewUMOrderService indicates an expected call of NewUMOrderService.
+func (mr *MockClientAPIMockRecorder) NewUMOrderService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewUMOrderService", reflect.TypeOf((*MockClientAPI)(nil).NewUMOrderService))
+}
+
+// NewUMQueryOrderService mocks base method.
+func (m *MockClientAPI) NewUMQueryOrderService() portfolio.UMQueryOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewUMQueryOrderService")
+	ret0, _ := ret[0].(portfolio.UMQueryOrderServiceAPI)
+	return ret0
+}
+
+// NewUMQueryOrderService indicates an expected call of NewUMQueryOrderService.
+func (mr *MockClientAPIMockRecorder) NewUMQueryOrderService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewUMQueryOrderService", reflect.TypeOf((*MockClientAPI)(nil).NewUMQueryOrderService))
+}
+
+// MockPingServiceAPI is a mock of PingServiceAPI interface.
+type MockPingServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockPingServiceAPIMockRecorder
+}
+
+// MockPingServiceAPIMockRecorder is the mock recorder for MockPingServiceAPI.
+type MockPingServiceAPIMockRecorder struct {
+	mock *MockPingServiceAPI
+}
+
+// NewMockPingServiceAPI creates a new mock instance.
+func NewMockPingServiceAPI(ctrl *gomock.Controller) *MockPingServiceAPI {
+	mock := &MockPingServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockPingServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPingServiceAPI) EXPECT() *MockPingServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockPingServiceAPI) Do(ctx context.Context, opts ...portfolio.RequestOption) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockPingServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockPingServiceAPI)(nil).Do), varargs...)
+}
+
+// MockGetBalanceServiceAPI is a mock of GetBalanceServiceAPI interface.
+type MockGetBalanceServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockGetBalanceServiceAPIMockRecorder
+}
+
+// MockGetBalanceServiceAPIMockRecorder is the mock recorder for MockGetBalanceServiceAPI.
+type MockGetBalanceServiceAPIMockRecorder struct {
+	mock *MockGetBalanceServiceAPI
+}
+
+// NewMockGetBalanceServiceAPI creates a new mock instance.
+func NewMockGetBalanceServiceAPI(ctrl *gomock.Controller) *MockGetBalanceServiceAPI {
+	mock := &MockGetBalanceServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockGetBalanceServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGetBalanceServiceAPI) EXPECT() *MockGetBalanceServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Asset mocks base method.
+func (m *MockGetBalanceServiceAPI) Asset(asset string) portfolio.GetBalanceServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Asset", asset)
+	ret0, _ := ret[0].(portfolio.GetBalanceServiceAPI)
+	return ret0
+}
+
+// Asset indicates an expected call of Asset.
+func (mr *MockGetBalanceServiceAPIMockRecorder) Asset(asset interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Asset", reflect.TypeOf((*MockGetBalanceServiceAPI)(nil).Asset), asset)
+}
+
+// Do mocks base method.
+func (m *MockGetBalanceServiceAPI) Do(ctx context.Context, opts ...portfolio.RequestOption) ([]*portfolio.Balance, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].([]*portfolio.Balance)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockGetBalanceServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockGetBalanceServiceAPI)(nil).Do), varargs...)
+}
+
+// MockGetAccountServiceAPI is a mock of GetAccountServiceAPI interface.
+type MockGetAccountServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockGetAccountServiceAPIMockRecorder
+}
+
+// MockGetAccountServiceAPIMockRecorder is the mock recorder for MockGetAccountServiceAPI.
+type MockGetAccountServiceAPIMockRecorder struct {
+	mock *MockGetAccountServiceAPI
+}
+
+// NewMockGetAccountServiceAPI creates a new mock instance.
+func NewMockGetAccountServiceAPI(ctrl *gomock.Controller) *MockGetAccountServiceAPI {
+	mock := &MockGetAccountServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockGetAccountServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGetAccountServiceAPI) EXPECT() *MockGetAccountServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockGetAccountServiceAPI) Do(ctx context.Context, opts ...portfolio.RequestOption) (*portfolio.Account, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*portfolio.Account)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockGetAccountServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockGetAccountServiceAPI)(nil).Do), varargs...)
+}
+
+// MockGetMarginMaxBorrowServiceAPI is a mock of GetMarginMaxBorrowServiceAPI interface.
+type MockGetMarginMaxBorrowServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockGetMarginMaxBorrowServiceAPIMockRecorder
+}
+
+// MockGetMarginMaxBorrowServiceAPIMockRecorder is the mock recorder for MockGetMarginMaxBorrowServiceAPI.
+type MockGetMarginMaxBorrowServiceAPIMockRecorder struct {
+	mock *MockGetMarginMaxBorrowServiceAPI
+}
+
+// NewMockGetMarginMaxBorrowServiceAPI creates a new mock instance.
+func NewMockGetMarginMaxBorrowServiceAPI(ctrl *gomock.Controller) *MockGetMarginMaxBorrowServiceAPI {
+	mock := &MockGetMarginMaxBorrowServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockGetMarginMaxBorrowServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGetMarginMaxBorrowServiceAPI) EXPECT() *MockGetMarginMaxBorrowServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Asset mocks base method.
+func (m *MockGetMarginMaxBorrowServiceAPI) Asset(asset string) portfolio.GetMarginMaxBorrowServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Asset", asset)
+	ret0, _ := ret[0].(portfolio.GetMarginMaxBorrowServiceAPI)
+	return ret0
+}
+
+// Asset indicates an expected call of Asset.
+func (mr *MockGetMarginMaxBorrowServiceAPIMockRecorder) Asset(asset interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Asset", reflect.TypeOf((*MockGetMarginMaxBorrowServiceAPI)(nil).Asset), asset)
+}
+
+// Do mocks base method.
+func (m *MockGetMarginMaxBorrowServiceAPI) Do(ctx context.Context, opts ...portfolio.RequestOption) (*portfolio.MaxBorrow, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*portfolio.MaxBorrow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockGetMarginMaxBorrowServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockGetMarginMaxBorrowServiceAPI)(nil).Do), varargs...)
+}
+
+// MockGetMarginMaxWithdrawServiceAPI is a mock of GetMarginMaxWithdrawServiceAPI interface.
+type MockGetMarginMaxWithdrawServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockGetMarginMaxWithdrawServiceAPIMockRecorder
+}
+
+// MockGetMarginMaxWithdrawServiceAPIMockRecorder is the mock recorder for MockGetMarginMaxWithdrawServiceAPI.
+type MockGetMarginMaxWithdrawServiceAPIMockRecorder struct {
+	mock *MockGetMarginMaxWithdrawServiceAPI
+}
+
+// NewMockGetMarginMaxWithdrawServiceAPI creates a new mock instance.
+func NewMockGetMarginMaxWithdrawServiceAPI(ctrl *gomock.Controller) *MockGetMarginMaxWithdrawServiceAPI {
+	mock := &MockGetMarginMaxWithdrawServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockGetMarginMaxWithdrawServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGetMarginMaxWithdrawServiceAPI) EXPECT() *MockGetMarginMaxWithdrawServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Asset mocks base method.
+func (m *MockGetMarginMaxWithdrawServiceAPI) Asset(asset string) portfolio.GetMarginMaxWithdrawServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Asset", asset)
+	ret0, _ := ret[0].(portfolio.GetMarginMaxWithdrawServiceAPI)
+	return ret0
+}
+
+// Asset indicates an expected call of Asset.
+func (mr *MockGetMarginMaxWithdrawServiceAPIMockRecorder) Asset(asset interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Asset", reflect.TypeOf((*MockGetMarginMaxWithdrawServiceAPI)(nil).Asset), asset)
+}
+
+// Do mocks base method.
+func (m *MockGetMarginMaxWithdrawServiceAPI) Do(ctx context.Context, opts ...portfolio.RequestOption) (*portfolio.MaxWithdraw, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*portfolio.MaxWithdraw)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockGetMarginMaxWithdrawServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockGetMarginMaxWithdrawServiceAPI)(nil).Do), varargs...)
+}
+
+// MockGetUMPositionRiskServiceAPI is a mock of GetUMPositionRiskServiceAPI interface.
+type MockGetUMPositionRiskServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockGetUMPositionRiskServiceAPIMockRecorder
+}
+
+// MockGetUMPositionRiskServiceAPIMockRecorder is the mock recorder for MockGetUMPositionRiskServiceAPI.
+type MockGetUMPositionRiskServiceAPIMockRecorder struct {
+	mock *MockGetUMPositionRiskServiceAPI
+}
+
+// NewMockGetUMPositionRiskServiceAPI creates a new mock instance.
+func NewMockGetUMPositionRiskServiceAPI(ctrl *gomock.Controller) *MockGetUMPositionRiskServiceAPI {
+	mock := &MockGetUMPositionRiskServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockGetUMPositionRiskServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGetUMPositionRiskServiceAPI) EXPECT() *MockGetUMPositionRiskServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockGetUMPositionRiskServiceAPI) Do(ctx context.Context, opts ...portfolio.RequestOption) ([]*portfolio.UMPosition, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].([]*portfolio.UMPosition)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockGetUMPositionRiskServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockGetUMPositionRiskServiceAPI)(nil).Do), varargs...)
+}
+
+// Symbol mocks base method.
+func (m *MockGetUMPositionRiskServiceAPI) Symbol(symbol string) portfolio.GetUMPositionRiskServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Symbol", symbol)
+	ret0, _ := ret[0].(portfolio.GetUMPositionRiskServiceAPI)
+	return ret0
+}
+
+// Symbol indicates an expected call of Symbol.
+func (mr *MockGetUMPositionRiskServiceAPIMockRecorder) Symbol(symbol interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Symbol", reflect.TypeOf((*MockGetUMPositionRiskServiceAPI)(nil).Symbol), symbol)
+}
+
+// MockGetCMPositionRiskServiceAPI is a mock of GetCMPositionRiskServiceAPI interface.
+type MockGetCMPositionRiskServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockGetCMPositionRiskServiceAPIMockRecorder
+}
+
+// MockGetCMPositionRiskServiceAPIMockRecorder is the mock recorder for MockGetCMPositionRiskServiceAPI.
+type MockGetCMPositionRiskServiceAPIMockRecorder struct {
+	mock *MockGetCMPositionRiskServiceAPI
+}
+
+// NewMockGetCMPositionRiskServiceAPI creates a new mock instance.
+func NewMockGetCMPositionRiskServiceAPI(ctrl *gomock.Controller) *MockGetCMPositionRiskServiceAPI {
+	mock := &MockGetCMPositionRiskServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockGetCMPositionRiskServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGetCMPositionRiskServiceAPI) EXPECT() *MockGetCMPositionRiskServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockGetCMPositionRiskServiceAPI) Do(ctx context.Context, opts ...portfolio.RequestOption) ([]*portfolio.CMPosition, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].([]*portfolio.CMPosition)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockGetCMPositionRiskServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockGetCMPositionRiskServiceAPI)(nil).Do), varargs...)
+}
+
+// MarginAsset mocks base method.
+func (m *MockGetCMPositionRiskServiceAPI) MarginAsset(marginAsset string) portfolio.GetCMPositionRiskServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarginAsset", marginAsset)
+	ret0, _ := ret[0].(portfolio.GetCMPositionRiskServiceAPI)
+	return ret0
+}
+
+// MarginAsset indicates an expected call of MarginAsset.
+func (mr *MockGetCMPositionRiskServiceAPIMockRecorder) MarginAsset(marginAsset interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarginAsset", reflect.TypeOf((*MockGetCMPositionRiskServiceAPI)(nil).MarginAsset), marginAsset)
+}
+
+// Pair mocks base method.
+func (m *MockGetCMPositionRiskServiceAPI) Pair(pair string) portfolio.GetCMPositionRiskServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Pair", pair)
+	ret0, _ := ret[0].(portfolio.GetCMPositionRiskServiceAPI)
+	return ret0
+}
+
+// Pair indicates an expected call of Pair.
+func (mr *MockGetCMPositionRiskServiceAPIMockRecorder) Pair(pair interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Pair", reflect.TypeOf((*MockGetCMPositionRiskServiceAPI)(nil).Pair), pair)
+}
+
+// MockChangeUMInitialLeverageServiceAPI is a mock of ChangeUMInitialLeverageServiceAPI interface.
+type MockChangeUMInitialLeverageServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockChangeUMInitialLeverageServiceAPIMockRecorder
+}
+
+// MockChangeUMInitialLeverageServiceAPIMockRecorder is the mock recorder for MockChangeUMInitialLeverageServiceAPI.
+type MockChangeUMInitialLeverageServiceAPIMockRecorder struct {
+	mock *MockChangeUMInitialLeverageServiceAPI
+}
+
+// NewMockChangeUMInitialLeverageServiceAPI creates a new mock instance.
+func NewMockChangeUMInitialLeverageServiceAPI(ctrl *gomock.Controller) *MockChangeUMInitialLeverageServiceAPI {
+	mock := &MockChangeUMInitialLeverageServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockChangeUMInitialLeverageServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockChangeUMInitialLeverageServiceAPI) EXPECT() *MockChangeUMInitialLeverageServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockChangeUMInitialLeverageServiceAPI) Do(ctx context.Context, opts ...portfolio.RequestOption) (*portfolio.UMLeverage, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*portfolio.UMLeverage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockChangeUMInitialLeverageServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockChangeUMInitialLeverageServiceAPI)(nil).Do), varargs...)
+}
+
+// Leverage mocks base method.
+func (m *MockChangeUMInitialLeverageServiceAPI) Leverage(leverage int) portfolio.ChangeUMInitialLeverageServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Leverage", leverage)
+	ret0, _ := ret[0].(portfolio.ChangeUMInitialLeverageServiceAPI)
+	return ret0
+}
+
+// Leverage indicates an expected call of Leverage.
+func (mr *MockChangeUMInitialLeverageServiceAPIMockRecorder) Leverage(leverage interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Leverage", reflect.TypeOf((*MockChangeUMInitialLeverageServiceAPI)(nil).Leverage), leverage)
+}
+
+// Symbol mocks base method.
+func (m *MockChangeUMInitialLeverageServiceAPI) Symbol(symbol string) portfolio.ChangeUMInitialLeverageServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Symbol", symbol)
+	ret0, _ := ret[0].(portfolio.ChangeUMInitialLeverageServiceAPI)
+	return ret0
+}
+
+// Symbol indicates an expected call of Symbol.
+func (mr *MockChangeUMInitialLeverageServiceAPIMockRecorder) Symbol(symbol interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Symbol", reflect.TypeOf((*MockChangeUMInitialLeverageServiceAPI)(nil).Symbol), symbol)
+}
+
+// MockChangeCMInitialLeverageServiceAPI is a mock of ChangeCMInitialLeverageServiceAPI interface.
+type MockChangeCMInitialLeverageServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockChangeCMInitialLeverageServiceAPIMockRecorder
+}
+
+// MockChangeCMInitialLeverageServiceAPIMockRecorder is the mock recorder for MockChangeCMInitialLeverageServiceAPI.
+type MockChangeCMInitialLeverageServiceAPIMockRecorder struct {
+	mock *MockChangeCMInitialLeverageServiceAPI
+}
+
+// NewMockChangeCMInitialLeverageServiceAPI creates a new mock instance.
+func NewMockChangeCMInitialLeverageServiceAPI(ctrl *gomock.Controller) *MockChangeCMInitialLeverageServiceAPI {
+	mock := &MockChangeCMInitialLeverageServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockChangeCMInitialLeverageServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockChangeCMInitialLeverageServiceAPI) EXPECT() *MockChangeCMInitialLeverageServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockChangeCMInitialLeverageServiceAPI) Do(ctx context.Context, opts ...portfolio.RequestOption) (*portfolio.CMLeverage, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*portfolio.CMLeverage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockChangeCMInitialLeverageServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockChangeCMInitialLeverageServiceAPI)(nil).Do), varargs...)
+}
+
+// Leverage mocks base method.
+func (m *MockChangeCMInitialLeverageServiceAPI) Leverage(leverage int) portfolio.ChangeCMInitialLeverageServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Leverage", leverage)
+	ret0, _ := ret[0].(portfolio.ChangeCMInitialLeverageServiceAPI)
+	return ret0
+}
+
+// Leverage indicates an expected call of Leverage.
+func (mr *MockChangeCMInitialLeverageServiceAPIMockRecorder) Leverage(leverage interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Leverage", reflect.TypeOf((*MockChangeCMInitialLeverageServiceAPI)(nil).Leverage), leverage)
+}
+
+// Symbol mocks base method.
+func (m *MockChangeCMInitialLeverageServiceAPI) Symbol(symbol string) portfolio.ChangeCMInitialLeverageServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Symbol", symbol)
+	ret0, _ := ret[0].(portfolio.ChangeCMInitialLeverageServiceAPI)
+	return ret0
+}
+
+// Symbol indicates an expected call of Symbol.
+func (mr *MockChangeCMInitialLeverageServiceAPIMockRecorder) Symbol(symbol interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Symbol", reflect.TypeOf((*MockChangeCMInitialLeverageServiceAPI)(nil).Symbol), symbol)
+}
+
+// MockChangeUMPositionModeServiceAPI is a mock of ChangeUMPositionModeServiceAPI interface.
+type MockChangeUMPositionModeServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockChangeUMPositionModeServiceAPIMockRecorder
+}
+
+// MockChangeUMPositionModeServiceAPIMockRecorder is the mock recorder for MockChangeUMPositionModeServiceAPI.
+type MockChangeUMPositionModeServiceAPIMockRecorder struct {
+	mock *MockChangeUMPositionModeServiceAPI
+}
+
+// NewMockChangeUMPositionModeServiceAPI creates a new mock instance.
+func NewMockChangeUMPositionModeServiceAPI(ctrl *gomock.Controller) *MockChangeUMPositionModeServiceAPI {
+	mock := &MockChangeUMPositionModeServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockChangeUMPositionModeServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockChangeUMPositionModeServiceAPI) EXPECT() *MockChangeUMPositionModeServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockChangeUMPositionModeServiceAPI) Do(ctx context.Context, opts ...portfolio.RequestOption) (*portfolio.APIResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*portfolio.APIResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockChangeUMPositionModeServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockChangeUMPositionModeServiceAPI)(nil).Do), varargs...)
+}
+
+// DualSidePosition mocks base method.
+func (m *MockChangeUMPositionModeServiceAPI) DualSidePosition(dualSidePosition bool) portfolio.ChangeUMPositionModeServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DualSidePosition", dualSidePosition)
+	ret0, _ := ret[0].(portfolio.ChangeUMPositionModeServiceAPI)
+	return ret0
+}
+
+// DualSidePosition indicates an expected call of DualSidePosition.
+func (mr *MockChangeUMPositionModeServiceAPIMockRecorder) DualSidePosition(dualSidePosition interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DualSidePosition", reflect.TypeOf((*MockChangeUMPositionModeServiceAPI)(nil).DualSidePosition), dualSidePosition)
+}
+
+// MockChangeCMPositionModeServiceAPI is a mock of ChangeCMPositionModeServiceAPI interface.
+type MockChangeCMPositionModeServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockChangeCMPositionModeServiceAPIMockRecorder
+}
+
+// MockChangeCMPositionModeServiceAPIMockRecorder is the mock recorder for MockChangeCMPositionModeServiceAPI.
+type MockChangeCMPositionModeServiceAPIMockRecorder struct {
+	mock *MockChangeCMPositionModeServiceAPI
+}
+
+// NewMockChangeCMPositionModeServiceAPI creates a new mock instance.
+func NewMockChangeCMPositionModeServiceAPI(ctrl *gomock.Controller) *MockChangeCMPositionModeServiceAPI {
+	mock := &MockChangeCMPositionModeServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockChangeCMPositionModeServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockChangeCMPositionModeServiceAPI) EXPECT() *MockChangeCMPositionModeServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockChangeCMPositionModeServiceAPI) Do(ctx context.Context, opts ...portfolio.RequestOption) (*portfolio.APIResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*portfolio.APIResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockChangeCMPositionModeServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockChangeCMPositionModeServiceAPI)(nil).Do), varargs...)
+}
+
+// DualSidePosition mocks base method.
+func (m *MockChangeCMPositionModeServiceAPI) DualSidePosition(dualSidePosition bool) portfolio.ChangeCMPositionModeServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DualSidePosition", dualSidePosition)
+	ret0, _ := ret[0].(portfolio.ChangeCMPositionModeServiceAPI)
+	return ret0
+}
+
+// DualSidePosition indicates an expected call of DualSidePosition.
+func (mr *MockChangeCMPositionModeServiceAPIMockRecorder) DualSidePosition(dualSidePosition interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DualSidePosition", reflect.TypeOf((*MockChangeCMPositionModeServiceAPI)(nil).DualSidePosition), dualSidePosition)
+}
+
+// MockGetUMPositionModeServiceAPI is a mock of GetUMPositionModeServiceAPI interface.
+type MockGetUMPositionModeServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockGetUMPositionModeServiceAPIMockRecorder
+}
+
+// MockGetUMPositionModeServiceAPIMockRecorder is the mock recorder for MockGetUMPositionModeServiceAPI.
+type MockGetUMPositionModeServiceAPIMockRecorder struct {
+	mock *MockGetUMPositionModeServiceAPI
+}
+
+// NewMockGetUMPositionModeServiceAPI creates a new mock instance.
+func NewMockGetUMPositionModeServiceAPI(ctrl *gomock.Controller) *MockGetUMPositionModeServiceAPI {
+	mock := &MockGetUMPositionModeServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockGetUMPositionModeServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGetUMPositionModeServiceAPI) EXPECT() *MockGetUMPositionModeServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockGetUMPositionModeServiceAPI) Do(ctx context.Context, opts ...portfolio.RequestOption) (*portfolio.PositionMode, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*portfolio.PositionMode)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockGetUMPositionModeServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockGetUMPositionModeServiceAPI)(nil).Do), varargs...)
+}
+
+// MockGetCMPositionModeServiceAPI is a mock of GetCMPositionModeServiceAPI interface.
+type MockGetCMPositionModeServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockGetCMPositionModeServiceAPIMockRecorder
+}
+
+// MockGetCMPositionModeServiceAPIMockRecorder is the mock recorder for MockGetCMPositionModeServiceAPI.
+type MockGetCMPositionModeServiceAPIMockRecorder struct {
+	mock *MockGetCMPositionModeServiceAPI
+}
+
+// NewMockGetCMPositionModeServiceAPI creates a new mock instance.
+func NewMockGetCMPositionModeServiceAPI(ctrl *gomock.Controller) *MockGetCMPositionModeServiceAPI {
+	mock := &MockGetCMPositionModeServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockGetCMPositionModeServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGetCMPositionModeServiceAPI) EXPECT() *MockGetCMPositionModeServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockGetCMPositionModeServiceAPI) Do(ctx context.Context, opts ...portfolio.RequestOption) (*portfolio.PositionMode, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*portfolio.PositionMode)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockGetCMPositionModeServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockGetCMPositionModeServiceAPI)(nil).Do), varargs...)
+}
+
+// MockGetUMLeverageBracketServiceAPI is a mock of GetUMLeverageBracketServiceAPI interface.
+type MockGetUMLeverageBracketServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockGetUMLeverageBracketServiceAPIMockRecorder
+}
+
+// MockGetUMLeverageBracketServiceAPIMockRecorder is the mock recorder for MockGetUMLeverageBracketServiceAPI.
+type MockGetUMLeverageBracketServiceAPIMockRecorder struct {
+	mock *MockGetUMLeverageBracketServiceAPI
+}
+
+// NewMockGetUMLeverageBracketServiceAPI creates a new mock instance.
+func NewMockGetUMLeverageBracketServiceAPI(ctrl *gomock.Controller) *MockGetUMLeverageBracketServiceAPI {
+	mock := &MockGetUMLeverageBracketServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockGetUMLeverageBracketServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGetUMLeverageBracketServiceAPI) EXPECT() *MockGetUMLeverageBracketServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockGetUMLeverageBracketServiceAPI) Do(ctx context.Context, opts ...portfolio.RequestOption) ([]*portfolio.LeverageBracket, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].([]*portfolio.LeverageBracket)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockGetUMLeverageBracketServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockGetUMLeverageBracketServiceAPI)(nil).Do), varargs...)
+}
+
+// Symbol mocks base method.
+func (m *MockGetUMLeverageBracketServiceAPI) Symbol(symbol string) portfolio.GetUMLeverageBracketServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Symbol", symbol)
+	ret0, _ := ret[0].(portfolio.GetUMLeverageBracketServiceAPI)
+	return ret0
+}
+
+// Symbol indicates an expected call of Symbol.
+func (mr *MockGetUMLeverageBracketServiceAPIMockRecorder) Symbol(symbol interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Symbol", reflect.TypeOf((*MockGetUMLeverageBracketServiceAPI)(nil).Symbol), symbol)
+}
+
+// MockGetCMLeverageBracketServiceAPI is a mock of GetCMLeverageBracketServiceAPI interface.
+type MockGetCMLeverageBracketServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockGetCMLeverageBracketServiceAPIMockRecorder
+}
+
+// MockGetCMLeverageBracketServiceAPIMockRecorder is the mock recorder for MockGetCMLeverageBracketServiceAPI.
+type MockGetCMLeverageBracketServiceAPIMockRecorder struct {
+	mock *MockGetCMLeverageBracketServiceAPI
+}
+
+// NewMockGetCMLeverageBracketServiceAPI creates a new mock instance.
+func NewMockGetCMLeverageBracketServiceAPI(ctrl *gomock.Controller) *MockGetCMLeverageBracketServiceAPI {
+	mock := &MockGetCMLeverageBracketServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockGetCMLeverageBracketServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGetCMLeverageBracketServiceAPI) EXPECT() *MockGetCMLeverageBracketServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockGetCMLeverageBracketServiceAPI) Do(ctx context.Context, opts ...portfolio.RequestOption) ([]*portfolio.CMLeverageBracket, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].([]*portfolio.CMLeverageBracket)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockGetCMLeverageBracketServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockGetCMLeverageBracketServiceAPI)(nil).Do), varargs...)
+}
+
+// Symbol mocks base method.
+func (m *MockGetCMLeverageBracketServiceAPI) Symbol(symbol string) portfolio.GetCMLeverageBracketServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Symbol", symbol)
+	ret0, _ := ret[0].(portfolio.GetCMLeverageBracketServiceAPI)
+	return ret0
+}
+
+// Symbol indicates an expected call of Symbol.
+func (mr *MockGetCMLeverageBracketServiceAPIMockRecorder) Symbol(symbol interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Symbol", reflect.TypeOf((*MockGetCMLeverageBracketServiceAPI)(nil).Symbol), symbol)
+}
+
+// MockGetUMTradingStatusServiceAPI is a mock of GetUMTradingStatusServiceAPI interface.
+type MockGetUMTradingStatusServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockGetUMTradingStatusServiceAPIMockRecorder
+}
+
+// MockGetUMTradingStatusServiceAPIMockRecorder is the mock recorder for MockGetUMTradingStatusServiceAPI.
+type MockGetUMTradingStatusServiceAPIMockRecorder struct {
+	mock *MockGetUMTradingStatusServiceAPI
+}
+
+// NewMockGetUMTradingStatusServiceAPI creates a new mock instance.
+func NewMockGetUMTradingStatusServiceAPI(ctrl *gomock.Controller) *MockGetUMTradingStatusServiceAPI {
+	mock := &MockGetUMTradingStatusServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockGetUMTradingStatusServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGetUMTradingStatusServiceAPI) EXPECT() *MockGetUMTradingStatusServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockGetUMTradingStatusServiceAPI) Do(ctx context.Context, opts ...portfolio.RequestOption) (*portfolio.TradingStatus, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*portfolio.TradingStatus)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockGetUMTradingStatusServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockGetUMTradingStatusServiceAPI)(nil).Do), varargs...)
+}
+
+// Symbol mocks base method.
+func (m *MockGetUMTradingStatusServiceAPI) Symbol(symbol string) portfolio.GetUMTradingStatusServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Symbol", symbol)
+	ret0, _ := ret[0].(portfolio.GetUMTradingStatusServiceAPI)
+	return ret0
+}
+
+// Symbol indicates an expected call of Symbol.
+func (mr *MockGetUMTradingStatusServiceAPIMockRecorder) Symbol(symbol interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Symbol", reflect.TypeOf((*MockGetUMTradingStatusServiceAPI)(nil).Symbol), symbol)
+}
+
+// MockGetUMCommissionRateServiceAPI is a mock of GetUMCommissionRateServiceAPI interface.
+type MockGetUMCommissionRateServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockGetUMCommissionRateServiceAPIMockRecorder
+}
+
+// MockGetUMCommissionRateServiceAPIMockRecorder is the mock recorder for MockGetUMCommissionRateServiceAPI.
+type MockGetUMCommissionRateServiceAPIMockRecorder struct {
+	mock *MockGetUMCommissionRateServiceAPI
+}
+
+// NewMockGetUMCommissionRateServiceAPI creates a new mock instance.
+func NewMockGetUMCommissionRateServiceAPI(ctrl *gomock.Controller) *MockGetUMCommissionRateServiceAPI {
+	mock := &MockGetUMCommissionRateServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockGetUMCommissionRateServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGetUMCommissionRateServiceAPI) EXPECT() *MockGetUMCommissionRateServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockGetUMCommissionRateServiceAPI) Do(ctx context.Context, opts ...portfolio.RequestOption) (*portfolio.CommissionRate, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*portfolio.CommissionRate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockGetUMCommissionRateServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockGetUMCommissionRateServiceAPI)(nil).Do), varargs...)
+}
+
+// Symbol mocks base method.
+func (m *MockGetUMCommissionRateServiceAPI) Symbol(symbol string) portfolio.GetUMCommissionRateServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Symbol", symbol)
+	ret0, _ := ret[0].(portfolio.GetUMCommissionRateServiceAPI)
+	return ret0
+}
+
+// Symbol indicates an expected call of Symbol.
+func (mr *MockGetUMCommissionRateServiceAPIMockRecorder) Symbol(symbol interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Symbol", reflect.TypeOf((*MockGetUMCommissionRateServiceAPI)(nil).Symbol), symbol)
+}
+
+// MockGetCMCommissionRateServiceAPI is a mock of GetCMCommissionRateServiceAPI interface.
+type MockGetCMCommissionRateServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockGetCMCommissionRateServiceAPIMockRecorder
+}
+
+// MockGetCMCommissionRateServiceAPIMockRecorder is the mock recorder for MockGetCMCommissionRateServiceAPI.
+type MockGetCMCommissionRateServiceAPIMockRecorder struct {
+	mock *MockGetCMCommissionRateServiceAPI
+}
+
+// NewMockGetCMCommissionRateServiceAPI creates a new mock instance.
+func NewMockGetCMCommissionRateServiceAPI(ctrl *gomock.Controller) *MockGetCMCommissionRateServiceAPI {
+	mock := &MockGetCMCommissionRateServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockGetCMCommissionRateServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGetCMCommissionRateServiceAPI) EXPECT() *MockGetCMCommissionRateServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockGetCMCommissionRateServiceAPI) Do(ctx context.Context, opts ...portfolio.RequestOption) (*portfolio.CommissionRate, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*portfolio.CommissionRate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockGetCMCommissionRateServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockGetCMCommissionRateServiceAPI)(nil).Do), varargs...)
+}
+
+// Symbol mocks base method.
+func (m *MockGetCMCommissionRateServiceAPI) Symbol(symbol string) portfolio.GetCMCommissionRateServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Symbol", symbol)
+	ret0, _ := ret[0].(portfolio.GetCMCommissionRateServiceAPI)
+	return ret0
+}
+
+// Symbol indicates an expected call of Symbol.
+func (mr *MockGetCMCommissionRateServiceAPIMockRecorder) Symbol(symbol interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Symbol", reflect.TypeOf((*MockGetCMCommissionRateServiceAPI)(nil).Symbol), symbol)
+}
+
+// MockGetMarginLoanServiceAPI is a mock of GetMarginLoanServiceAPI interface.
+type MockGetMarginLoanServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockGetMarginLoanServiceAPIMockRecorder
+}
+
+// MockGetMarginLoanServiceAPIMockRecorder is the mock recorder for MockGetMarginLoanServiceAPI.
+type MockGetMarginLoanServiceAPIMockRecorder struct {
+	mock *MockGetMarginLoanServiceAPI
+}
+
+// NewMockGetMarginLoanServiceAPI creates a new mock instance.
+func NewMockGetMarginLoanServiceAPI(ctrl *gomock.Controller) *MockGetMarginLoanServiceAPI {
+	mock := &MockGetMarginLoanServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockGetMarginLoanServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGetMarginLoanServiceAPI) EXPECT() *MockGetMarginLoanServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Archived mocks base method.
+func (m *MockGetMarginLoanServiceAPI) Archived(archived bool) portfolio.GetMarginLoanServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Archived", archived)
+	ret0, _ := ret[0].(portfolio.GetMarginLoanServiceAPI)
+	return ret0
+}
+
+// Archived indicates an expected call of Archived.
+func (mr *MockGetMarginLoanServiceAPIMockRecorder) Archived(archived interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Archived", reflect.TypeOf((*MockGetMarginLoanServiceAPI)(nil).Archived), archived)
+}
+
+// Asset mocks base method.
+func (m *MockGetMarginLoanServiceAPI) Asset(asset string) portfolio.GetMarginLoanServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Asset", asset)
+	ret0, _ := ret[0].(portfolio.GetMarginLoanServiceAPI)
+	return ret0
+}
+
+// Asset indicates an expected call of Asset.
+func (mr *MockGetMarginLoanServiceAPIMockRecorder) Asset(asset interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Asset", reflect.TypeOf((*MockGetMarginLoanServiceAPI)(nil).Asset), asset)
+}
+
+// Current mocks base method.
+func (m *MockGetMarginLoanServiceAPI) Current(current int64) portfolio.GetMarginLoanServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Current", current)
+	ret0, _ := ret[0].(portfolio.GetMarginLoanServiceAPI)
+	return ret0
+}
+
+// Current indicates an expected call of Current.
+func (mr *MockGetMarginLoanServiceAPIMockRecorder) Current(current interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Current", reflect.TypeOf((*MockGetMarginLoanServiceAPI)(nil).Current), current)
+}
+
+// Do mocks base method.
+func (m *MockGetMarginLoanServiceAPI) Do(ctx context.Context, opts ...portfolio.RequestOption) (*portfolio.GetMarginLoanResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*portfolio.GetMarginLoanResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockGetMarginLoanServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockGetMarginLoanServiceAPI)(nil).Do), varargs...)
+}
+
+// EndTime mocks base method.
+func (m *MockGetMarginLoanServiceAPI) EndTime(endTime int64) portfolio.GetMarginLoanServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EndTime", endTime)
+	ret0, _ := ret[0].(portfolio.GetMarginLoanServiceAPI)
+	return ret0
+}
+
+// EndTime indicates an expected call of EndTime.
+func (mr *MockGetMarginLoanServiceAPIMockRecorder) EndTime(endTime interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EndTime", reflect.TypeOf((*MockGetMarginLoanServiceAPI)(nil).EndTime), endTime)
+}
+
+// Size mocks base method.
+func (m *MockGetMarginLoanServiceAPI) Size(size int64) portfolio.GetMarginLoanServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Size", size)
+	ret0, _ := ret[0].(portfolio.GetMarginLoanServiceAPI)
+	return ret0
+}
+
+// Size indicates an expected call of Size.
+func (mr *MockGetMarginLoanServiceAPIMockRecorder) Size(size interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Size", reflect.TypeOf((*MockGetMarginLoanServiceAPI)(nil).Size), size)
+}
+
+// StartTime mocks base method.
+func (m *MockGetMarginLoanServiceAPI) StartTime(startTime int64) portfolio.GetMarginLoanServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartTime", startTime)
+	ret0, _ := ret[0].(portfolio.GetMarginLoanServiceAPI)
+	return ret0
+}
+
+// StartTime indicates an expected call of StartTime.
+func (mr *MockGetMarginLoanServiceAPIMockRecorder) StartTime(startTime interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartTime", reflect.TypeOf((*MockGetMarginLoanServiceAPI)(nil).StartTime), startTime)
+}
+
+// TxID mocks base method.
+func (m *MockGetMarginLoanServiceAPI) TxID(txID int64) portfolio.GetMarginLoanServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TxID", txID)
+	ret0, _ := ret[0].(portfolio.GetMarginLoanServiceAPI)
+	return ret0
+}
+
+// TxID indicates an expected call of TxID.
+func (mr *MockGetMarginLoanServiceAPIMockRecorder) TxID(txID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TxID", reflect.TypeOf((*MockGetMarginLoanServiceAPI)(nil).TxID), txID)
+}
+
+// MockGetMarginRepayServiceAPI is a mock of GetMarginRepayServiceAPI interface.
+type MockGetMarginRepayServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockGetMarginRepayServiceAPIMockRecorder
+}
+
+// MockGetMarginRepayServiceAPIMockRecorder is the mock recorder for MockGetMarginRepayServiceAPI.
+type MockGetMarginRepayServiceAPIMockRecorder struct {
+	mock *MockGetMarginRepayServiceAPI
+}
+
+// NewMockGetMarginRepayServiceAPI creates a new mock instance.
+func NewMockGetMarginRepayServiceAPI(ctrl *gomock.Controller) *MockGetMarginRepayServiceAPI {
+	mock := &MockGetMarginRepayServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockGetMarginRepayServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGetMarginRepayServiceAPI) EXPECT() *MockGetMarginRepayServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Archived mocks base method.
+func (m *MockGetMarginRepayServiceAPI) Archived(archived bool) portfolio.GetMarginRepayServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Archived", archived)
+	ret0, _ := ret[0].(portfolio.GetMarginRepayServiceAPI)
+	return ret0
+}
+
+// Archived indicates an expected call of Archived.
+func (mr *MockGetMarginRepayServiceAPIMockRecorder) Archived(archived interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Archived", reflect.TypeOf((*MockGetMarginRepayServiceAPI)(nil).Archived), archived)
+}
+
+// Asset mocks base method.
+func (m *MockGetMarginRepayServiceAPI) Asset(asset string) portfolio.GetMarginRepayServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Asset", asset)
+	ret0, _ := ret[0].(portfolio.GetMarginRepayServiceAPI)
+	return ret0
+}
+
+// Asset indicates an expected call of Asset.
+func (mr *MockGetMarginRepayServiceAPIMockRecorder) Asset(asset interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Asset", reflect.TypeOf((*MockGetMarginRepayServiceAPI)(nil).Asset), asset)
+}
+
+// Current mocks base method.
+func (m *MockGetMarginRepayServiceAPI) Current(current int64) portfolio.GetMarginRepayServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Current", current)
+	ret0, _ := ret[0].(portfolio.GetMarginRepayServiceAPI)
+	return ret0
+}
+
+// Current indicates an expected call of Current.
+func (mr *MockGetMarginRepayServiceAPIMockRecorder) Current(current interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Current", reflect.TypeOf((*MockGetMarginRepayServiceAPI)(nil).Current), current)
+}
+
+// Do mocks base method.
+func (m *MockGetMarginRepayServiceAPI) Do(ctx context.Context, opts ...portfolio.RequestOption) (*portfolio.GetMarginRepayResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*portfolio.GetMarginRepayResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockGetMarginRepayServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockGetMarginRepayServiceAPI)(nil).Do), varargs...)
+}
+
+// EndTime mocks base method.
+func (m *MockGetMarginRepayServiceAPI) EndTime(endTime int64) portfolio.GetMarginRepayServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EndTime", endTime)
+	ret0, _ := ret[0].(portfolio.GetMarginRepayServiceAPI)
+	return ret0
+}
+
+// EndTime indicates an expected call of EndTime.
+func (mr *MockGetMarginRepayServiceAPIMockRecorder) EndTime(endTime interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EndTime", reflect.TypeOf((*MockGetMarginRepayServiceAPI)(nil).EndTime), endTime)
+}
+
+// Size mocks base method.
+func (m *MockGetMarginRepayServiceAPI) Size(size int64) portfolio.GetMarginRepayServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Size", size)
+	ret0, _ := ret[0].(portfolio.GetMarginRepayServiceAPI)
+	return ret0
+}
+
+// Size indicates an expected call of Size.
+func (mr *MockGetMarginRepayServiceAPIMockRecorder) Size(size interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Size", reflect.TypeOf((*MockGetMarginRepayServiceAPI)(nil).Size), size)
+}
+
+// StartTime mocks base method.
+func (m *MockGetMarginRepayServiceAPI) StartTime(startTime int64) portfolio.GetMarginRepayServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartTime", startTime)
+	ret0, _ := ret[0].(portfolio.GetMarginRepayServiceAPI)
+	return ret0
+}
+
+// StartTime indicates an expected call of StartTime.
+func (mr *MockGetMarginRepayServiceAPIMockRecorder) StartTime(startTime interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartTime", reflect.TypeOf((*MockGetMarginRepayServiceAPI)(nil).StartTime), startTime)
+}
+
+// TxID mocks base method.
+func (m *MockGetMarginRepayServiceAPI) TxID(txID int64) portfolio.GetMarginRepayServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TxID", txID)
+	ret0, _ := ret[0].(portfolio.GetMarginRepayServiceAPI)
+	return ret0
+}
+
+// TxID indicates an expected call of TxID.
+func (mr *MockGetMarginRepayServiceAPIMockRecorder) TxID(txID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TxID", reflect.TypeOf((*MockGetMarginRepayServiceAPI)(nil).TxID), txID)
+}
+
+// MockGetAutoRepayFuturesStatusServiceAPI is a mock of GetAutoRepayFuturesStatusServiceAPI interface.
+type MockGetAutoRepayFuturesStatusServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockGetAutoRepayFuturesStatusServiceAPIMockRecorder
+}
+
+// MockGetAutoRepayFuturesStatusServiceAPIMockRecorder is the mock recorder for MockGetAutoRepayFuturesStatusServiceAPI.
+type MockGetAutoRepayFuturesStatusServiceAPIMockRecorder struct {
+	mock *MockGetAutoRepayFuturesStatusServiceAPI
+}
+
+// NewMockGetAutoRepayFuturesStatusServiceAPI creates a new mock instance.
+func NewMockGetAutoRepayFuturesStatusServiceAPI(ctrl *gomock.Controller) *MockGetAutoRepayFuturesStatusServiceAPI {
+	mock := &MockGetAutoRepayFuturesStatusServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockGetAutoRepayFuturesStatusServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGetAutoRepayFuturesStatusServiceAPI) EXPECT() *MockGetAutoRepayFuturesStatusServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockGetAutoRepayFuturesStatusServiceAPI) Do(ctx context.Context, opts ...portfolio.RequestOption) (*portfolio.AutoRepayFuturesStatus, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*portfolio.AutoRepayFuturesStatus)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockGetAutoRepayFuturesStatusServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockGetAutoRepayFuturesStatusServiceAPI)(nil).Do), varargs...)
+}
+
+// MockChangeAutoRepayFuturesStatusServiceAPI is a mock of ChangeAutoRepayFuturesStatusServiceAPI interface.
+type MockChangeAutoRepayFuturesStatusServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockChangeAutoRepayFuturesStatusServiceAPIMockRecorder
+}
+
+// MockChangeAutoRepayFuturesStatusServiceAPIMockRecorder is the mock recorder for MockChangeAutoRepayFuturesStatusServiceAPI.
+type MockChangeAutoRepayFuturesStatusServiceAPIMockRecorder struct {
+	mock *MockChangeAutoRepayFuturesStatusServiceAPI
+}
+
+// NewMockChangeAutoRepayFuturesStatusServiceAPI creates a new mock instance.
+func NewMockChangeAutoRepayFuturesStatusServiceAPI(ctrl *gomock.Controller) *MockChangeAutoRepayFuturesStatusServiceAPI {
+	mock := &MockChangeAutoRepayFuturesStatusServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockChangeAutoRepayFuturesStatusServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockChangeAutoRepayFuturesStatusServiceAPI) EXPECT() *MockChangeAutoRepayFuturesStatusServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// AutoRepay mocks base method.
+func (m *MockChangeAutoRepayFuturesStatusServiceAPI) AutoRepay(autoRepay bool) portfolio.ChangeAutoRepayFuturesStatusServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AutoRepay", autoRepay)
+	ret0, _ := ret[0].(portfolio.ChangeAutoRepayFuturesStatusServiceAPI)
+	return ret0
+}
+
+// AutoRepay indicates an expected call of AutoRepay.
+func (mr *MockChangeAutoRepayFuturesStatusServiceAPIMockRecorder) AutoRepay(autoRepay interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AutoRepay", reflect.TypeOf((*MockChangeAutoRepayFuturesStatusServiceAPI)(nil).AutoRepay), autoRepay)
+}
+
+// Do mocks base method.
+func (m *MockChangeAutoRepayFuturesStatusServiceAPI) Do(ctx context.Context, opts ...portfolio.RequestOption) (*portfolio.SuccessResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*portfolio.SuccessResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockChangeAutoRepayFuturesStatusServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockChangeAutoRepayFuturesStatusServiceAPI)(nil).Do), varargs...)
+}
+
+// MockGetMarginInterestHistoryServiceAPI is a mock of GetMarginInterestHistoryServiceAPI interface.
+type MockGetMarginInterestHistoryServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockGetMarginInterestHistoryServiceAPIMockRecorder
+}
+
+// MockGetMarginInterestHistoryServiceAPIMockRecorder is the mock recorder for MockGetMarginInterestHistoryServiceAPI.
+type MockGetMarginInterestHistoryServiceAPIMockRecorder struct {
+	mock *MockGetMarginInterestHistoryServiceAPI
+}
+
+// NewMockGetMarginInterestHistoryServiceAPI creates a new mock instance.
+func NewMockGetMarginInterestHistoryServiceAPI(ctrl *gomock.Controller) *MockGetMarginInterestHistoryServiceAPI {
+	mock := &MockGetMarginInterestHistoryServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockGetMarginInterestHistoryServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGetMarginInterestHistoryServiceAPI) EXPECT() *MockGetMarginInterestHistoryServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Archived mocks base method.
+func (m *MockGetMarginInterestHistoryServiceAPI) Archived(archived bool) portfolio.GetMarginInterestHistoryServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Archived", archived)
+	ret0, _ := ret[0].(portfolio.GetMarginInterestHistoryServiceAPI)
+	return ret0
+}
+
+// Archived indicates an expected call of Archived.
+func (mr *MockGetMarginInterestHistoryServiceAPIMockRecorder) Archived(archived interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Archived", reflect.TypeOf((*MockGetMarginInterestHistoryServiceAPI)(nil).Archived), archived)
+}
+
+// Asset mocks base method.
+func (m *MockGetMarginInterestHistoryServiceAPI) Asset(asset string) portfolio.GetMarginInterestHistoryServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Asset", asset)
+	ret0, _ := ret[0].(portfolio.GetMarginInterestHistoryServiceAPI)
+	return ret0
+}
+
+// Asset indicates an expected call of Asset.
+func (mr *MockGetMarginInterestHistoryServiceAPIMockRecorder) Asset(asset interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Asset", reflect.TypeOf((*MockGetMarginInterestHistoryServiceAPI)(nil).Asset), asset)
+}
+
+// Current mocks base method.
+func (m *MockGetMarginInterestHistoryServiceAPI) Current(current int64) portfolio.GetMarginInterestHistoryServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Current", current)
+	ret0, _ := ret[0].(portfolio.GetMarginInterestHistoryServiceAPI)
+	return ret0
+}
+
+// Current indicates an expected call of Current.
+func (mr *MockGetMarginInterestHistoryServiceAPIMockRecorder) Current(current interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Current", reflect.TypeOf((*MockGetMarginInterestHistoryServiceAPI)(nil).Current), current)
+}
+
+// Do mocks base method.
+func (m *MockGetMarginInterestHistoryServiceAPI) Do(ctx context.Context, opts ...portfolio.RequestOption) (*portfolio.MarginInterestHistoryResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*portfolio.MarginInterestHistoryResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockGetMarginInterestHistoryServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockGetMarginInterestHistoryServiceAPI)(nil).Do), varargs...)
+}
+
+// EndTime mocks base method.
+func (m *MockGetMarginInterestHistoryServiceAPI) EndTime(endTime int64) portfolio.GetMarginInterestHistoryServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EndTime", endTime)
+	ret0, _ := ret[0].(portfolio.GetMarginInterestHistoryServiceAPI)
+	return ret0
+}
+
+// EndTime indicates an expected call of EndTime.
+func (mr *MockGetMarginInterestHistoryServiceAPIMockRecorder) EndTime(endTime interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EndTime", reflect.TypeOf((*MockGetMarginInterestHistoryServiceAPI)(nil).EndTime), endTime)
+}
+
+// Size mocks base method.
+func (m *MockGetMarginInterestHistoryServiceAPI) Size(size int64) portfolio.GetMarginInterestHistoryServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Size", size)
+	ret0, _ := ret[0].(portfolio.GetMarginInterestHistoryServiceAPI)
+	return ret0
+}
+
+// Size indicates an expected call of Size.
+func (mr *MockGetMarginInterestHistoryServiceAPIMockRecorder) Size(size interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Size", reflect.TypeOf((*MockGetMarginInterestHistoryServiceAPI)(nil).Size), size)
+}
+
+// StartTime mocks base method.
+func (m *MockGetMarginInterestHistoryServiceAPI) StartTime(startTime int64) portfolio.GetMarginInterestHistoryServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartTime", startTime)
+	ret0, _ := ret[0].(portfolio.GetMarginInterestHistoryServiceAPI)
+	return ret0
+}
+
+// StartTime indicates an expected call of StartTime.
+func (mr *MockGetMarginInterestHistoryServiceAPIMockRecorder) StartTime(startTime interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartTime", reflect.TypeOf((*MockGetMarginInterestHistoryServiceAPI)(nil).StartTime), startTime)
+}
+
+// MockRepayFuturesNegativeBalanceServiceAPI is a mock of RepayFuturesNegativeBalanceServiceAPI interface.
+type MockRepayFuturesNegativeBalanceServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockRepayFuturesNegativeBalanceServiceAPIMockRecorder
+}
+
+// MockRepayFuturesNegativeBalanceServiceAPIMockRecorder is the mock recorder for MockRepayFuturesNegativeBalanceServiceAPI.
+type MockRepayFuturesNegativeBalanceServiceAPIMockRecorder struct {
+	mock *MockRepayFuturesNegativeBalanceServiceAPI
+}
+
+// NewMockRepayFuturesNegativeBalanceServiceAPI creates a new mock instance.
+func NewMockRepayFuturesNegativeBalanceServiceAPI(ctrl *gomock.Controller) *MockRepayFuturesNegativeBalanceServiceAPI {
+	mock := &MockRepayFuturesNegativeBalanceServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockRepayFuturesNegativeBalanceServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRepayFuturesNegativeBalanceServiceAPI) EXPECT() *MockRepayFuturesNegativeBalanceServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockRepayFuturesNegativeBalanceServiceAPI) Do(ctx context.Context, opts ...portfolio.RequestOption) (*portfolio.SuccessResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*portfolio.SuccessResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockRepayFuturesNegativeBalanceServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockRepayFuturesNegativeBalanceServiceAPI)(nil).Do), varargs...)
+}
+
+// MockGetNegativeBalanceInterestHistoryServiceAPI is a mock of GetNegativeBalanceInterestHistoryServiceAPI interface.
+type MockGetNegativeBalanceInterestHistoryServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockGetNegativeBalanceInterestHistoryServiceAPIMockRecorder
+}
+
+// MockGetNegativeBalanceInterestHistoryServiceAPIMockRecorder is the mock recorder for MockGetNegativeBalanceInterestHistoryServiceAPI.
+type MockGetNegativeBalanceInterestHistoryServiceAPIMockRecorder struct {
+	mock *MockGetNegativeBalanceInterestHistoryServiceAPI
+}
+
+// NewMockGetNegativeBalanceInterestHistoryServiceAPI creates a new mock instance.
+func NewMockGetNegativeBalanceInterestHistoryServiceAPI(ctrl *gomock.Controller) *MockGetNegativeBalanceInterestHistoryServiceAPI {
+	mock := &MockGetNegativeBalanceInterestHistoryServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockGetNegativeBalanceInterestHistoryServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGetNegativeBalanceInterestHistoryServiceAPI) EXPECT() *MockGetNegativeBalanceInterestHistoryServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Asset mocks base method.
+func (m *MockGetNegativeBalanceInterestHistoryServiceAPI) Asset(asset string) portfolio.GetNegativeBalanceInterestHistoryServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Asset", asset)
+	ret0, _ := ret[0].(portfolio.GetNegativeBalanceInterestHistoryServiceAPI)
+	return ret0
+}
+
+// Asset indicates an expected call of Asset.
+func (mr *MockGetNegativeBalanceInterestHistoryServiceAPIMockRecorder) Asset(asset interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Asset", reflect.TypeOf((*MockGetNegativeBalanceInterestHistoryServiceAPI)(nil).Asset), asset)
+}
+
+// Do mocks base method.
+func (m *MockGetNegativeBalanceInterestHistoryServiceAPI) Do(ctx context.Context, opts ...portfolio.RequestOption) ([]*portfolio.NegativeBalanceInterest, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].([]*portfolio.NegativeBalanceInterest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockGetNegativeBalanceInterestHistoryServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockGetNegativeBalanceInterestHistoryServiceAPI)(nil).Do), varargs...)
+}
+
+// EndTime mocks base method.
+func (m *MockGetNegativeBalanceInterestHistoryServiceAPI) EndTime(endTime int64) portfolio.GetNegativeBalanceInterestHistoryServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EndTime", endTime)
+	ret0, _ := ret[0].(portfolio.GetNegativeBalanceInterestHistoryServiceAPI)
+	return ret0
+}
+
+// EndTime indicates an expected call of EndTime.
+func (mr *MockGetNegativeBalanceInterestHistoryServiceAPIMockRecorder) EndTime(endTime interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EndTime", reflect.TypeOf((*MockGetNegativeBalanceInterestHistoryServiceAPI)(nil).EndTime), endTime)
+}
+
+// Size mocks base method.
+func (m *MockGetNegativeBalanceInterestHistoryServiceAPI) Size(size int64) portfolio.GetNegativeBalanceInterestHistoryServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Size", size)
+	ret0, _ := ret[0].(portfolio.GetNegativeBalanceInterestHistoryServiceAPI)
+	return ret0
+}
+
+// Size indicates an expected call of Size.
+func (mr *MockGetNegativeBalanceInterestHistoryServiceAPIMockRecorder) Size(size interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Size", reflect.TypeOf((*MockGetNegativeBalanceInterestHistoryServiceAPI)(nil).Size), size)
+}
+
+// StartTime mocks base method.
+func (m *MockGetNegativeBalanceInterestHistoryServiceAPI) StartTime(startTime int64) portfolio.GetNegativeBalanceInterestHistoryServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartTime", startTime)
+	ret0, _ := ret[0].(portfolio.GetNegativeBalanceInterestHistoryServiceAPI)
+	return ret0
+}
+
+// StartTime indicates an expected call of StartTime.
+func (mr *MockGetNegativeBalanceInterestHistoryServiceAPIMockRecorder) StartTime(startTime interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartTime", reflect.TypeOf((*MockGetNegativeBalanceInterestHistoryServiceAPI)(nil).StartTime), startTime)
+}
+
+// MockFundAutoCollectionServiceAPI is a mock of FundAutoCollectionServiceAPI interface.
+type MockFundAutoCollectionServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockFundAutoCollectionServiceAPIMockRecorder
+}
+
+// MockFundAutoCollectionServiceAPIMockRecorder is the mock recorder for MockFundAutoCollectionServiceAPI.
+type MockFundAutoCollectionServiceAPIMockRecorder struct {
+	mock *MockFundAutoCollectionServiceAPI
+}
+
+// NewMockFundAutoCollectionServiceAPI creates a new mock instance.
+func NewMockFundAutoCollectionServiceAPI(ctrl *gomock.Controller) *MockFundAutoCollectionServiceAPI {
+	mock := &MockFundAutoCollectionServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockFundAutoCollectionServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockFundAutoCollectionServiceAPI) EXPECT() *MockFundAutoCollectionServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockFundAutoCollectionServiceAPI) Do(ctx context.Context, opts ...portfolio.RequestOption) (*portfolio.SuccessResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*portfolio.SuccessResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockFundAutoCollectionServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockFundAutoCollectionServiceAPI)(nil).Do), varargs...)
+}
+
+// MockFundCollectionByAssetServiceAPI is a mock of FundCollectionByAssetServiceAPI interface.
+type MockFundCollectionByAssetServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockFundCollectionByAssetServiceAPIMockRecorder
+}
+
+// MockFundCollectionByAssetServiceAPIMockRecorder is the mock recorder for MockFundCollectionByAssetServiceAPI.
+type MockFundCollectionByAssetServiceAPIMockRecorder struct {
+	mock *MockFundCollectionByAssetServiceAPI
+}
+
+// NewMockFundCollectionByAssetServiceAPI creates a new mock instance.
+func NewMockFundCollectionByAssetServiceAPI(ctrl *gomock.Controller) *MockFundCollectionByAssetServiceAPI {
+	mock := &MockFundCollectionByAssetServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockFundCollectionByAssetServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockFundCollectionByAssetServiceAPI) EXPECT() *MockFundCollectionByAssetServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Asset mocks base method.
+func (m *MockFundCollectionByAssetServiceAPI) Asset(asset string) portfolio.FundCollectionByAssetServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Asset", asset)
+	ret0, _ := ret[0].(portfolio.FundCollectionByAssetServiceAPI)
+	return ret0
+}
+
+// Asset indicates an expected call of Asset.
+func (mr *MockFundCollectionByAssetServiceAPIMockRecorder) Asset(asset interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Asset", reflect.TypeOf((*MockFundCollectionByAssetServiceAPI)(nil).Asset), asset)
+}
+
+// Do mocks base method.
+func (m *MockFundCollectionByAssetServiceAPI) Do(ctx context.Context, opts ...portfolio.RequestOption) (*portfolio.SuccessResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*portfolio.SuccessResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockFundCollectionByAssetServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockFundCollectionByAssetServiceAPI)(nil).Do), varargs...)
+}
+
+// MockBNBTransferServiceAPI is a mock of BNBTransferServiceAPI interface.
+type MockBNBTransferServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockBNBTransferServiceAPIMockRecorder
+}
+
+// MockBNBTransferServiceAPIMockRecorder is the mock recorder for MockBNBTransferServiceAPI.
+type MockBNBTransferServiceAPIMockRecorder struct {
+	mock *MockBNBTransferServiceAPI
+}
+
+// NewMockBNBTransferServiceAPI creates a new mock instance.
+func NewMockBNBTransferServiceAPI(ctrl *gomock.Controller) *MockBNBTransferServiceAPI {
+	mock := &MockBNBTransferServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockBNBTransferServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockBNBTransferServiceAPI) EXPECT() *MockBNBTransferServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Amount mocks base method.
+func (m *MockBNBTransferServiceAPI) Amount(amount string) portfolio.BNBTransferServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Amount", amount)
+	ret0, _ := ret[0].(portfolio.BNBTransferServiceAPI)
+	return ret0
+}
+
+// Amount indicates an expected call of Amount.
+func (mr *MockBNBTransferServiceAPIMockRecorder) Amount(amount interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Amount", reflect.TypeOf((*MockBNBTransferServiceAPI)(nil).Amount), amount)
+}
+
+// Do mocks base method.
+func (m *MockBNBTransferServiceAPI) Do(ctx context.Context, opts ...portfolio.RequestOption) (*portfolio.BNBTransferResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*portfolio.BNBTransferResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockBNBTransferServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockBNBTransferServiceAPI)(nil).Do), varargs...)
+}
+
+// TransferSide mocks base method.
+func (m *MockBNBTransferServiceAPI) TransferSide(transferSide string) portfolio.BNBTransferServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TransferSide", transferSide)
+	ret0, _ := ret[0].(portfolio.BNBTransferServiceAPI)
+	return ret0
+}
+
+// TransferSide indicates an expected call of TransferSide.
+func (mr *MockBNBTransferServiceAPIMockRecorder) TransferSide(transferSide interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TransferSide", reflect.TypeOf((*MockBNBTransferServiceAPI)(nil).TransferSide), transferSide)
+}
+
+// MockGetUMIncomeHistoryServiceAPI is a mock of GetUMIncomeHistoryServiceAPI interface.
+type MockGetUMIncomeHistoryServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockGetUMIncomeHistoryServiceAPIMockRecorder
+}
+
+// MockGetUMIncomeHistoryServiceAPIMockRecorder is the mock recorder for MockGetUMIncomeHistoryServiceAPI.
+type MockGetUMIncomeHistoryServiceAPIMockRecorder struct {
+	mock *MockGetUMIncomeHistoryServiceAPI
+}
+
+// NewMockGetUMIncomeHistoryServiceAPI creates a new mock instance.
+func NewMockGetUMIncomeHistoryServiceAPI(ctrl *gomock.Controller) *MockGetUMIncomeHistoryServiceAPI {
+	mock := &MockGetUMIncomeHistoryServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockGetUMIncomeHistoryServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGetUMIncomeHistoryServiceAPI) EXPECT() *MockGetUMIncomeHistoryServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockGetUMIncomeHistoryServiceAPI) Do(ctx context.Context, opts ...portfolio.RequestOption) ([]*portfolio.Income, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].([]*portfolio.Income)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockGetUMIncomeHistoryServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockGetUMIncomeHistoryServiceAPI)(nil).Do), varargs...)
+}
+
+// EndTime mocks base method.
+func (m *MockGetUMIncomeHistoryServiceAPI) EndTime(endTime int64) portfolio.GetUMIncomeHistoryServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EndTime", endTime)
+	ret0, _ := ret[0].(portfolio.GetUMIncomeHistoryServiceAPI)
+	return ret0
+}
+
+// EndTime indicates an expected call of EndTime.
+func (mr *MockGetUMIncomeHistoryServiceAPIMockRecorder) EndTime(endTime interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EndTime", reflect.TypeOf((*MockGetUMIncomeHistoryServiceAPI)(nil).EndTime), endTime)
+}
+
+// IncomeType mocks base method.
+func (m *MockGetUMIncomeHistoryServiceAPI) IncomeType(incomeType string) portfolio.GetUMIncomeHistoryServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IncomeType", incomeType)
+	ret0, _ := ret[0].(portfolio.GetUMIncomeHistoryServiceAPI)
+	return ret0
+}
+
+// IncomeType indicates an expected call of IncomeType.
+func (mr *MockGetUMIncomeHistoryServiceAPIMockRecorder) IncomeType(incomeType interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IncomeType", reflect.TypeOf((*MockGetUMIncomeHistoryServiceAPI)(nil).IncomeType), incomeType)
+}
+
+// Limit mocks base method.
+func (m *MockGetUMIncomeHistoryServiceAPI) Limit(limit int) portfolio.GetUMIncomeHistoryServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Limit", limit)
+	ret0, _ := ret[0].(portfolio.GetUMIncomeHistoryServiceAPI)
+	return ret0
+}
+
+// Limit indicates an expected call of Limit.
+func (mr *MockGetUMIncomeHistoryServiceAPIMockRecorder) Limit(limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Limit", reflect.TypeOf((*MockGetUMIncomeHistoryServiceAPI)(nil).Limit), limit)
+}
+
+// Page mocks base method.
+func (m *MockGetUMIncomeHistoryServiceAPI) Page(page int) portfolio.GetUMIncomeHistoryServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Page", page)
+	ret0, _ := ret[0].(portfolio.GetUMIncomeHistoryServiceAPI)
+	return ret0
+}
+
+// Page indicates an expected call of Page.
+func (mr *MockGetUMIncomeHistoryServiceAPIMockRecorder) Page(page interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Page", reflect.TypeOf((*MockGetUMIncomeHistoryServiceAPI)(nil).Page), page)
+}
+
+// StartTime mocks base method.
+func (m *MockGetUMIncomeHistoryServiceAPI) StartTime(startTime int64) portfolio.GetUMIncomeHistoryServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartTime", startTime)
+	ret0, _ := ret[0].(portfolio.GetUMIncomeHistoryServiceAPI)
+	return ret0
+}
+
+// StartTime indicates an expected call of StartTime.
+func (mr *MockGetUMIncomeHistoryServiceAPIMockRecorder) StartTime(startTime interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartTime", reflect.TypeOf((*MockGetUMIncomeHistoryServiceAPI)(nil).StartTime), startTime)
+}
+
+// Symbol mocks base method.
+func (m *MockGetUMIncomeHistoryServiceAPI) Symbol(symbol string) portfolio.GetUMIncomeHistoryServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Symbol", symbol)
+	ret0, _ := ret[0].(portfolio.GetUMIncomeHistoryServiceAPI)
+	return ret0
+}
+
+// Symbol indicates an expected call of Symbol.
+func (mr *MockGetUMIncomeHistoryServiceAPIMockRecorder) Symbol(symbol interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Symbol", reflect.TypeOf((*MockGetUMIncomeHistoryServiceAPI)(nil).Symbol), symbol)
+}
+
+// MockGetCMIncomeHistoryServiceAPI is a mock of GetCMIncomeHistoryServiceAPI interface.
+type MockGetCMIncomeHistoryServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockGetCMIncomeHistoryServiceAPIMockRecorder
+}
+
+// MockGetCMIncomeHistoryServiceAPIMockRecorder is the mock recorder for MockGetCMIncomeHistoryServiceAPI.
+type MockGetCMIncomeHistoryServiceAPIMockRecorder struct {
+	mock *MockGetCMIncomeHistoryServiceAPI
+}
+
+// NewMockGetCMIncomeHistoryServiceAPI creates a new mock instance.
+func NewMockGetCMIncomeHistoryServiceAPI(ctrl *gomock.Controller) *MockGetCMIncomeHistoryServiceAPI {
+	mock := &MockGetCMIncomeHistoryServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockGetCMIncomeHistoryServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGetCMIncomeHistoryServiceAPI) EXPECT() *MockGetCMIncomeHistoryServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockGetCMIncomeHistoryServiceAPI) Do(ctx context.Context, opts ...portfolio.RequestOption) ([]*portfolio.Income, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].([]*portfolio.Income)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockGetCMIncomeHistoryServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockGetCMIncomeHistoryServiceAPI)(nil).Do), varargs...)
+}
+
+// EndTime mocks base method.
+func (m *MockGetCMIncomeHistoryServiceAPI) EndTime(endTime int64) portfolio.GetCMIncomeHistoryServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EndTime", endTime)
+	ret0, _ := ret[0].(portfolio.GetCMIncomeHistoryServiceAPI)
+	return ret0
+}
+
+// EndTime indicates an expected call of EndTime.
+func (mr *MockGetCMIncomeHistoryServiceAPIMockRecorder) EndTime(endTime interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EndTime", reflect.TypeOf((*MockGetCMIncomeHistoryServiceAPI)(nil).EndTime), endTime)
+}
+
+// IncomeType mocks base method.
+func (m *MockGetCMIncomeHistoryServiceAPI) IncomeType(incomeType string) portfolio.GetCMIncomeHistoryServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IncomeType", incomeType)
+	ret0, _ := ret[0].(portfolio.GetCMIncomeHistoryServiceAPI)
+	return ret0
+}
+
+// IncomeType indicates an expected call of IncomeType.
+func (mr *MockGetCMIncomeHistoryServiceAPIMockRecorder) IncomeType(incomeType interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IncomeType", reflect.TypeOf((*MockGetCMIncomeHistoryServiceAPI)(nil).IncomeType), incomeType)
+}
+
+// Limit mocks base method.
+func (m *MockGetCMIncomeHistoryServiceAPI) Limit(limit int) portfolio.GetCMIncomeHistoryServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Limit", limit)
+	ret0, _ := ret[0].(portfolio.GetCMIncomeHistoryServiceAPI)
+	return ret0
+}
+
+// Limit indicates an expected call of Limit.
+func (mr *MockGetCMIncomeHistoryServiceAPIMockRecorder) Limit(limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Limit", reflect.TypeOf((*MockGetCMIncomeHistoryServiceAPI)(nil).Limit), limit)
+}
+
+// Page mocks base method.
+func (m *MockGetCMIncomeHistoryServiceAPI) Page(page int) portfolio.GetCMIncomeHistoryServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Page", page)
+	ret0, _ := ret[0].(portfolio.GetCMIncomeHistoryServiceAPI)
+	return ret0
+}
+
+// Page indicates an expected call of Page.
+func (mr *MockGetCMIncomeHistoryServiceAPIMockRecorder) Page(page interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Page", reflect.TypeOf((*MockGetCMIncomeHistoryServiceAPI)(nil).Page), page)
+}
+
+// StartTime mocks base method.
+func (m *MockGetCMIncomeHistoryServiceAPI) StartTime(startTime int64) portfolio.GetCMIncomeHistoryServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartTime", startTime)
+	ret0, _ := ret[0].(portfolio.GetCMIncomeHistoryServiceAPI)
+	return ret0
+}
+
+// StartTime indicates an expected call of StartTime.
+func (mr *MockGetCMIncomeHistoryServiceAPIMockRecorder) StartTime(startTime interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartTime", reflect.TypeOf((*MockGetCMIncomeHistoryServiceAPI)(nil).StartTime), startTime)
+}
+
+// Symbol mocks base method.
+func (m *MockGetCMIncomeHistoryServiceAPI) Symbol(symbol string) portfolio.GetCMIncomeHistoryServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Symbol", symbol)
+	ret0, _ := ret[0].(portfolio.GetCMIncomeHistoryServiceAPI)
+	return ret0
+}
+
+// Symbol indicates an expected call of Symbol.
+func (mr *MockGetCMIncomeHistoryServiceAPIMockRecorder) Symbol(symbol interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Symbol", reflect.TypeOf((*MockGetCMIncomeHistoryServiceAPI)(nil).Symbol), symbol)
+}
+
+// MockGetUMAccountDetailServiceAPI is a mock of GetUMAccountDetailServiceAPI interface.
+type MockGetUMAccountDetailServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockGetUMAccountDetailServiceAPIMockRecorder
+}
+
+// MockGetUMAccountDetailServiceAPIMockRecorder is the mock recorder for MockGetUMAccountDetailServiceAPI.
+type MockGetUMAccountDetailServiceAPIMockRecorder struct {
+	mock *MockGetUMAccountDetailServiceAPI
+}
+
+// NewMockGetUMAccountDetailServiceAPI creates a new mock instance.
+func NewMockGetUMAccountDetailServiceAPI(ctrl *gomock.Controller) *MockGetUMAccountDetailServiceAPI {
+	mock := &MockGetUMAccountDetailServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockGetUMAccountDetailServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGetUMAccountDetailServiceAPI) EXPECT() *MockGetUMAccountDetailServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockGetUMAccountDetailServiceAPI) Do(ctx context.Context, opts ...portfolio.RequestOption) (*portfolio.UMAccountDetail, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*portfolio.UMAccountDetail)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockGetUMAccountDetailServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockGetUMAccountDetailServiceAPI)(nil).Do), varargs...)
+}
+
+// MockGetCMAccountDetailServiceAPI is a mock of GetCMAccountDetailServiceAPI interface.
+type MockGetCMAccountDetailServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockGetCMAccountDetailServiceAPIMockRecorder
+}
+
+// MockGetCMAccountDetailServiceAPIMockRecorder is the mock recorder for MockGetCMAccountDetailServiceAPI.
+type MockGetCMAccountDetailServiceAPIMockRecorder struct {
+	mock *MockGetCMAccountDetailServiceAPI
+}
+
+// NewMockGetCMAccountDetailServiceAPI creates a new mock instance.
+func NewMockGetCMAccountDetailServiceAPI(ctrl *gomock.Controller) *MockGetCMAccountDetailServiceAPI {
+	mock := &MockGetCMAccountDetailServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockGetCMAccountDetailServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGetCMAccountDetailServiceAPI) EXPECT() *MockGetCMAccountDetailServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockGetCMAccountDetailServiceAPI) Do(ctx context.Context, opts ...portfolio.RequestOption) (*portfolio.CMAccountDetail, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*portfolio.CMAccountDetail)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockGetCMAccountDetailServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockGetCMAccountDetailServiceAPI)(nil).Do), varargs...)
+}
+
+// MockUMAccountConfigServiceAPI is a mock of UMAccountConfigServiceAPI interface.
+type MockUMAccountConfigServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockUMAccountConfigServiceAPIMockRecorder
+}
+
+// MockUMAccountConfigServiceAPIMockRecorder is the mock recorder for MockUMAccountConfigServiceAPI.
+type MockUMAccountConfigServiceAPIMockRecorder struct {
+	mock *MockUMAccountConfigServiceAPI
+}
+
+// NewMockUMAccountConfigServiceAPI creates a new mock instance.
+func NewMockUMAccountConfigServiceAPI(ctrl *gomock.Controller) *MockUMAccountConfigServiceAPI {
+	mock := &MockUMAccountConfigServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockUMAccountConfigServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUMAccountConfigServiceAPI) EXPECT() *MockUMAccountConfigServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockUMAccountConfigServiceAPI) Do(ctx context.Context) (*portfolio.UMAccountConfig, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Do", ctx)
+	ret0, _ := ret[0].(*portfolio.UMAccountConfig)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockUMAccountConfigServiceAPIMockRecorder) Do(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockUMAccountConfigServiceAPI)(nil).Do), ctx)
+}
+
+// MockUMSymbolConfigServiceAPI is a mock of UMSymbolConfigServiceAPI interface.
+type MockUMSymbolConfigServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockUMSymbolConfigServiceAPIMockRecorder
+}
+
+// MockUMSymbolConfigServiceAPIMockRecorder is the mock recorder for MockUMSymbolConfigServiceAPI.
+type MockUMSymbolConfigServiceAPIMockRecorder struct {
+	mock *MockUMSymbolConfigServiceAPI
+}
+
+// NewMockUMSymbolConfigServiceAPI creates a new mock instance.
+func NewMockUMSymbolConfigServiceAPI(ctrl *gomock.Controller) *MockUMSymbolConfigServiceAPI {
+	mock := &MockUMSymbolConfigServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockUMSymbolConfigServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUMSymbolConfigServiceAPI) EXPECT() *MockUMSymbolConfigServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockUMSymbolConfigServiceAPI) Do(ctx context.Context) ([]*portfolio.UMSymbolConfig, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Do", ctx)
+	ret0, _ := ret[0].([]*portfolio.UMSymbolConfig)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockUMSymbolConfigServiceAPIMockRecorder) Do(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockUMSymbolConfigServiceAPI)(nil).Do), ctx)
+}
+
+// Symbol mocks base method.
+func (m *MockUMSymbolConfigServiceAPI) Symbol(symbol string) portfolio.UMSymbolConfigServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Symbol", symbol)
+	ret0, _ := ret[0].(portfolio.UMSymbolConfigServiceAPI)
+	return ret0
+}
+
+// Symbol indicates an expected call of Symbol.
+func (mr *MockUMSymbolConfigServiceAPIMockRecorder) Symbol(symbol interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Symbol", reflect.TypeOf((*MockUMSymbolConfigServiceAPI)(nil).Symbol), symbol)
+}
+
+// MockUMAccountDetailV2ServiceAPI is a mock of UMAccountDetailV2ServiceAPI interface.
+type MockUMAccountDetailV2ServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockUMAccountDetailV2ServiceAPIMockRecorder
+}
+
+// MockUMAccountDetailV2ServiceAPIMockRecorder is the mock recorder for MockUMAccountDetailV2ServiceAPI.
+type MockUMAccountDetailV2ServiceAPIMockRecorder struct {
+	mock *MockUMAccountDetailV2ServiceAPI
+}
+
+// NewMockUMAccountDetailV2ServiceAPI creates a new mock instance.
+func NewMockUMAccountDetailV2ServiceAPI(ctrl *gomock.Controller) *MockUMAccountDetailV2ServiceAPI {
+	mock := &MockUMAccountDetailV2ServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockUMAccountDetailV2ServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUMAccountDetailV2ServiceAPI) EXPECT() *MockUMAccountDetailV2ServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockUMAccountDetailV2ServiceAPI) Do(ctx context.Context) (*portfolio.UMAccountDetailV2, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Do", ctx)
+	ret0, _ := ret[0].(*portfolio.UMAccountDetailV2)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockUMAccountDetailV2ServiceAPIMockRecorder) Do(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockUMAccountDetailV2ServiceAPI)(nil).Do), ctx)
+}
+
+// MockGetUMTradeHistoryDownloadIDServiceAPI is a mock of GetUMTradeHistoryDownloadIDServiceAPI interface.
+type MockGetUMTradeHistoryDownloadIDServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockGetUMTradeHistoryDownloadIDServiceAPIMockRecorder
+}
+
+// MockGetUMTradeHistoryDownloadIDServiceAPIMockRecorder is the mock recorder for MockGetUMTradeHistoryDownloadIDServiceAPI.
+type MockGetUMTradeHistoryDownloadIDServiceAPIMockRecorder struct {
+	mock *MockGetUMTradeHistoryDownloadIDServiceAPI
+}
+
+// NewMockGetUMTradeHistoryDownloadIDServiceAPI creates a new mock instance.
+func NewMockGetUMTradeHistoryDownloadIDServiceAPI(ctrl *gomock.Controller) *MockGetUMTradeHistoryDownloadIDServiceAPI {
+	mock := &MockGetUMTradeHistoryDownloadIDServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockGetUMTradeHistoryDownloadIDServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGetUMTradeHistoryDownloadIDServiceAPI) EXPECT() *MockGetUMTradeHistoryDownloadIDServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockGetUMTradeHistoryDownloadIDServiceAPI) Do(ctx context.Context, opts ...portfolio.RequestOption) (*portfolio.UMTradeHistoryDownloadID, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*portfolio.UMTradeHistoryDownloadID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockGetUMTradeHistoryDownloadIDServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockGetUMTradeHistoryDownloadIDServiceAPI)(nil).Do), varargs...)
+}
+
+// EndTime mocks base method.
+func (m *MockGetUMTradeHistoryDownloadIDServiceAPI) EndTime(endTime int64) portfolio.GetUMTradeHistoryDownloadIDServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EndTime", endTime)
+	ret0, _ := ret[0].(portfolio.GetUMTradeHistoryDownloadIDServiceAPI)
+	return ret0
+}
+
+// EndTime indicates an expected call of EndTime.
+func (mr *MockGetUMTradeHistoryDownloadIDServiceAPIMockRecorder) EndTime(endTime interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EndTime", reflect.TypeOf((*MockGetUMTradeHistoryDownloadIDServiceAPI)(nil).EndTime), endTime)
+}
+
+// RecvWindow mocks base method.
+func (m *MockGetUMTradeHistoryDownloadIDServiceAPI) RecvWindow(recvWindow int64) portfolio.GetUMTradeHistoryDownloadIDServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecvWindow", recvWindow)
+	ret0, _ := ret[0].(portfolio.GetUMTradeHistoryDownloadIDServiceAPI)
+	return ret0
+}
+
+// RecvWindow indicates an expected call of RecvWindow.
+func (mr *MockGetUMTradeHistoryDownloadIDServiceAPIMockRecorder) RecvWindow(recvWindow interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvWindow", reflect.TypeOf((*MockGetUMTradeHistoryDownloadIDServiceAPI)(nil).RecvWindow), recvWindow)
+}
+
+// StartTime mocks base method.
+func (m *MockGetUMTradeHistoryDownloadIDServiceAPI) StartTime(startTime int64) portfolio.GetUMTradeHistoryDownloadIDServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartTime", startTime)
+	ret0, _ := ret[0].(portfolio.GetUMTradeHistoryDownloadIDServiceAPI)
+	return ret0
+}
+
+// StartTime indicates an expected call of StartTime.
+func (mr *MockGetUMTradeHistoryDownloadIDServiceAPIMockRecorder) StartTime(startTime interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartTime", reflect.TypeOf((*MockGetUMTradeHistoryDownloadIDServiceAPI)(nil).StartTime), startTime)
+}
+
+// MockGetUMTradeDownloadLinkServiceAPI is a mock of GetUMTradeDownloadLinkServiceAPI interface.
+type MockGetUMTradeDownloadLinkServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockGetUMTradeDownloadLinkServiceAPIMockRecorder
+}
+
+// MockGetUMTradeDownloadLinkServiceAPIMockRecorder is the mock recorder for MockGetUMTradeDownloadLinkServiceAPI.
+type MockGetUMTradeDownloadLinkServiceAPIMockRecorder struct {
+	mock *MockGetUMTradeDownloadLinkServiceAPI
+}
+
+// NewMockGetUMTradeDownloadLinkServiceAPI creates a new mock instance.
+func NewMockGetUMTradeDownloadLinkServiceAPI(ctrl *gomock.Controller) *MockGetUMTradeDownloadLinkServiceAPI {
+	mock := &MockGetUMTradeDownloadLinkServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockGetUMTradeDownloadLinkServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGetUMTradeDownloadLinkServiceAPI) EXPECT() *MockGetUMTradeDownloadLinkServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockGetUMTradeDownloadLinkServiceAPI) Do(ctx context.Context, opts ...portfolio.RequestOption) (*portfolio.UMTradeDownloadLink, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*portfolio.UMTradeDownloadLink)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockGetUMTradeDownloadLinkServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockGetUMTradeDownloadLinkServiceAPI)(nil).Do), varargs...)
+}
+
+// DownloadID mocks base method.
+func (m *MockGetUMTradeDownloadLinkServiceAPI) DownloadID(downloadID string) portfolio.GetUMTradeDownloadLinkServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DownloadID", downloadID)
+	ret0, _ := ret[0].(portfolio.GetUMTradeDownloadLinkServiceAPI)
+	return ret0
+}
+
+// DownloadID indicates an expected call of DownloadID.
+func (mr *MockGetUMTradeDownloadLinkServiceAPIMockRecorder) DownloadID(downloadID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DownloadID", reflect.TypeOf((*MockGetUMTradeDownloadLinkServiceAPI)(nil).DownloadID), downloadID)
+}
+
+// RecvWindow mocks base method.
+func (m *MockGetUMTradeDownloadLinkServiceAPI) RecvWindow(recvWindow int64) portfolio.GetUMTradeDownloadLinkServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecvWindow", recvWindow)
+	ret0, _ := ret[0].(portfolio.GetUMTradeDownloadLinkServiceAPI)
+	return ret0
+}
+
+// RecvWindow indicates an expected call of RecvWindow.
+func (mr *MockGetUMTradeDownloadLinkServiceAPIMockRecorder) RecvWindow(recvWindow interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvWindow", reflect.TypeOf((*MockGetUMTradeDownloadLinkServiceAPI)(nil).RecvWindow), recvWindow)
+}
+
+// MockGetUMOrderHistoryDownloadIDServiceAPI is a mock of GetUMOrderHistoryDownloadIDServiceAPI interface.
+type MockGetUMOrderHistoryDownloadIDServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockGetUMOrderHistoryDownloadIDServiceAPIMockRecorder
+}
+
+// MockGetUMOrderHistoryDownloadIDServiceAPIMockRecorder is the mock recorder for MockGetUMOrderHistoryDownloadIDServiceAPI.
+type MockGetUMOrderHistoryDownloadIDServiceAPIMockRecorder struct {
+	mock *MockGetUMOrderHistoryDownloadIDServiceAPI
+}
+
+// NewMockGetUMOrderHistoryDownloadIDServiceAPI creates a new mock instance.
+func NewMockGetUMOrderHistoryDownloadIDServiceAPI(ctrl *gomock.Controller) *MockGetUMOrderHistoryDownloadIDServiceAPI {
+	mock := &MockGetUMOrderHistoryDownloadIDServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockGetUMOrderHistoryDownloadIDServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGetUMOrderHistoryDownloadIDServiceAPI) EXPECT() *MockGetUMOrderHistoryDownloadIDServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockGetUMOrderHistoryDownloadIDServiceAPI) Do(ctx context.Context, opts ...portfolio.RequestOption) (*portfolio.UMOrderHistoryDownloadID, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*portfolio.UMOrderHistoryDownloadID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockGetUMOrderHistoryDownloadIDServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockGetUMOrderHistoryDownloadIDServiceAPI)(nil).Do), varargs...)
+}
+
+// EndTime mocks base method.
+func (m *MockGetUMOrderHistoryDownloadIDServiceAPI) EndTime(endTime int64) portfolio.GetUMOrderHistoryDownloadIDServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EndTime", endTime)
+	ret0, _ := ret[0].(portfolio.GetUMOrderHistoryDownloadIDServiceAPI)
+	return ret0
+}
+
+// EndTime indicates an expected call of EndTime.
+func (mr *MockGetUMOrderHistoryDownloadIDServiceAPIMockRecorder) EndTime(endTime interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EndTime", reflect.TypeOf((*MockGetUMOrderHistoryDownloadIDServiceAPI)(nil).EndTime), endTime)
+}
+
+// RecvWindow mocks base method.
+func (m *MockGetUMOrderHistoryDownloadIDServiceAPI) RecvWindow(recvWindow int64) portfolio.GetUMOrderHistoryDownloadIDServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecvWindow", recvWindow)
+	ret0, _ := ret[0].(portfolio.GetUMOrderHistoryDownloadIDServiceAPI)
+	return ret0
+}
+
+// RecvWindow indicates an expected call of RecvWindow.
+func (mr *MockGetUMOrderHistoryDownloadIDServiceAPIMockRecorder) RecvWindow(recvWindow interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvWindow", reflect.TypeOf((*MockGetUMOrderHistoryDownloadIDServiceAPI)(nil).RecvWindow), recvWindow)
+}
+
+// StartTime mocks base method.
+func (m *MockGetUMOrderHistoryDownloadIDServiceAPI) StartTime(startTime int64) portfolio.GetUMOrderHistoryDownloadIDServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartTime", startTime)
+	ret0, _ := ret[0].(portfolio.GetUMOrderHistoryDownloadIDServiceAPI)
+	return ret0
+}
+
+// StartTime indicates an expected call of StartTime.
+func (mr *MockGetUMOrderHistoryDownloadIDServiceAPIMockRecorder) StartTime(startTime interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartTime", reflect.TypeOf((*MockGetUMOrderHistoryDownloadIDServiceAPI)(nil).StartTime), startTime)
+}
+
+// MockGetUMOrderDownloadLinkServiceAPI is a mock of GetUMOrderDownloadLinkServiceAPI interface.
+type MockGetUMOrderDownloadLinkServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockGetUMOrderDownloadLinkServiceAPIMockRecorder
+}
+
+// MockGetUMOrderDownloadLinkServiceAPIMockRecorder is the mock recorder for MockGetUMOrderDownloadLinkServiceAPI.
+type MockGetUMOrderDownloadLinkServiceAPIMockRecorder struct {
+	mock *MockGetUMOrderDownloadLinkServiceAPI
+}
+
+// NewMockGetUMOrderDownloadLinkServiceAPI creates a new mock instance.
+func NewMockGetUMOrderDownloadLinkServiceAPI(ctrl *gomock.Controller) *MockGetUMOrderDownloadLinkServiceAPI {
+	mock := &MockGetUMOrderDownloadLinkServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockGetUMOrderDownloadLinkServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGetUMOrderDownloadLinkServiceAPI) EXPECT() *MockGetUMOrderDownloadLinkServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockGetUMOrderDownloadLinkServiceAPI) Do(ctx context.Context, opts ...portfolio.RequestOption) (*portfolio.UMOrderDownloadLink, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*portfolio.UMOrderDownloadLink)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockGetUMOrderDownloadLinkServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockGetUMOrderDownloadLinkServiceAPI)(nil).Do), varargs...)
+}
+
+// DownloadID mocks base method.
+func (m *MockGetUMOrderDownloadLinkServiceAPI) DownloadID(downloadID string) portfolio.GetUMOrderDownloadLinkServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DownloadID", downloadID)
+	ret0, _ := ret[0].(portfolio.GetUMOrderDownloadLinkServiceAPI)
+	return ret0
+}
+
+// DownloadID indicates an expected call of DownloadID.
+func (mr *MockGetUMOrderDownloadLinkServiceAPIMockRecorder) DownloadID(downloadID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DownloadID", reflect.TypeOf((*MockGetUMOrderDownloadLinkServiceAPI)(nil).DownloadID), downloadID)
+}
+
+// RecvWindow mocks base method.
+func (m *MockGetUMOrderDownloadLinkServiceAPI) RecvWindow(recvWindow int64) portfolio.GetUMOrderDownloadLinkServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecvWindow", recvWindow)
+	ret0, _ := ret[0].(portfolio.GetUMOrderDownloadLinkServiceAPI)
+	return ret0
+}
+
+// RecvWindow indicates an expected call of RecvWindow.
+func (mr *MockGetUMOrderDownloadLinkServiceAPIMockRecorder) RecvWindow(recvWindow interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvWindow", reflect.TypeOf((*MockGetUMOrderDownloadLinkServiceAPI)(nil).RecvWindow), recvWindow)
+}
+
+// MockGetUMTransactionHistoryDownloadIDServiceAPI is a mock of GetUMTransactionHistoryDownloadIDServiceAPI interface.
+type MockGetUMTransactionHistoryDownloadIDServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockGetUMTransactionHistoryDownloadIDServiceAPIMockRecorder
+}
+
+// MockGetUMTransactionHistoryDownloadIDServiceAPIMockRecorder is the mock recorder for MockGetUMTransactionHistoryDownloadIDServiceAPI.
+type MockGetUMTransactionHistoryDownloadIDServiceAPIMockRecorder struct {
+	mock *MockGetUMTransactionHistoryDownloadIDServiceAPI
+}
+
+// NewMockGetUMTransactionHistoryDownloadIDServiceAPI creates a new mock instance.
+func NewMockGetUMTransactionHistoryDownloadIDServiceAPI(ctrl *gomock.Controller) *MockGetUMTransactionHistoryDownloadIDServiceAPI {
+	mock := &MockGetUMTransactionHistoryDownloadIDServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockGetUMTransactionHistoryDownloadIDServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGetUMTransactionHistoryDownloadIDServiceAPI) EXPECT() *MockGetUMTransactionHistoryDownloadIDServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockGetUMTransactionHistoryDownloadIDServiceAPI) Do(ctx context.Context, opts ...portfolio.RequestOption) (*portfolio.UMTransactionHistoryDownloadID, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*portfolio.UMTransactionHistoryDownloadID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockGetUMTransactionHistoryDownloadIDServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockGetUMTransactionHistoryDownloadIDServiceAPI)(nil).Do), varargs...)
+}
+
+// EndTime mocks base method.
+func (m *MockGetUMTransactionHistoryDownloadIDServiceAPI) EndTime(endTime int64) portfolio.GetUMTransactionHistoryDownloadIDServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EndTime", endTime)
+	ret0, _ := ret[0].(portfolio.GetUMTransactionHistoryDownloadIDServiceAPI)
+	return ret0
+}
+
+// EndTime indicates an expected call of EndTime.
+func (mr *MockGetUMTransactionHistoryDownloadIDServiceAPIMockRecorder) EndTime(endTime interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EndTime", reflect.TypeOf((*MockGetUMTransactionHistoryDownloadIDServiceAPI)(nil).EndTime), endTime)
+}
+
+// RecvWindow mocks base method.
+func (m *MockGetUMTransactionHistoryDownloadIDServiceAPI) RecvWindow(recvWindow int64) portfolio.GetUMTransactionHistoryDownloadIDServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecvWindow", recvWindow)
+	ret0, _ := ret[0].(portfolio.GetUMTransactionHistoryDownloadIDServiceAPI)
+	return ret0
+}
+
+// RecvWindow indicates an expected call of RecvWindow.
+func (mr *MockGetUMTransactionHistoryDownloadIDServiceAPIMockRecorder) RecvWindow(recvWindow interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvWindow", reflect.TypeOf((*MockGetUMTransactionHistoryDownloadIDServiceAPI)(nil).RecvWindow), recvWindow)
+}
+
+// StartTime mocks base method.
+func (m *MockGetUMTransactionHistoryDownloadIDServiceAPI) StartTime(startTime int64) portfolio.GetUMTransactionHistoryDownloadIDServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartTime", startTime)
+	ret0, _ := ret[0].(portfolio.GetUMTransactionHistoryDownloadIDServiceAPI)
+	return ret0
+}
+
+// StartTime indicates an expected call of StartTime.
+func (mr *MockGetUMTransactionHistoryDownloadIDServiceAPIMockRecorder) StartTime(startTime interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartTime", reflect.TypeOf((*MockGetUMTransactionHistoryDownloadIDServiceAPI)(nil).StartTime), startTime)
+}
+
+// MockGetUMTransactionDownloadLinkServiceAPI is a mock of GetUMTransactionDownloadLinkServiceAPI interface.
+type MockGetUMTransactionDownloadLinkServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockGetUMTransactionDownloadLinkServiceAPIMockRecorder
+}
+
+// MockGetUMTransactionDownloadLinkServiceAPIMockRecorder is the mock recorder for MockGetUMTransactionDownloadLinkServiceAPI.
+type MockGetUMTransactionDownloadLinkServiceAPIMockRecorder struct {
+	mock *MockGetUMTransactionDownloadLinkServiceAPI
+}
+
+// NewMockGetUMTransactionDownloadLinkServiceAPI creates a new mock instance.
+func NewMockGetUMTransactionDownloadLinkServiceAPI(ctrl *gomock.Controller) *MockGetUMTransactionDownloadLinkServiceAPI {
+	mock := &MockGetUMTransactionDownloadLinkServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockGetUMTransactionDownloadLinkServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGetUMTransactionDownloadLinkServiceAPI) EXPECT() *MockGetUMTransactionDownloadLinkServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockGetUMTransactionDownloadLinkServiceAPI) Do(ctx context.Context, opts ...portfolio.RequestOption) (*portfolio.UMTransactionDownloadLink, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*portfolio.UMTransactionDownloadLink)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockGetUMTransactionDownloadLinkServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockGetUMTransactionDownloadLinkServiceAPI)(nil).Do), varargs...)
+}
+
+// DownloadID mocks base method.
+func (m *MockGetUMTransactionDownloadLinkServiceAPI) DownloadID(downloadID string) portfolio.GetUMTransactionDownloadLinkServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DownloadID", downloadID)
+	ret0, _ := ret[0].(portfolio.GetUMTransactionDownloadLinkServiceAPI)
+	return ret0
+}
+
+// DownloadID indicates an expected call of DownloadID.
+func (mr *MockGetUMTransactionDownloadLinkServiceAPIMockRecorder) DownloadID(downloadID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DownloadID", reflect.TypeOf((*MockGetUMTransactionDownloadLinkServiceAPI)(nil).DownloadID), downloadID)
+}
+
+// RecvWindow mocks base method.
+func (m *MockGetUMTransactionDownloadLinkServiceAPI) RecvWindow(recvWindow int64) portfolio.GetUMTransactionDownloadLinkServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecvWindow", recvWindow)
+	ret0, _ := ret[0].(portfolio.GetUMTransactionDownloadLinkServiceAPI)
+	return ret0
+}
+
+// RecvWindow indicates an expected call of RecvWindow.
+func (mr *MockGetUMTransactionDownloadLinkServiceAPIMockRecorder) RecvWindow(recvWindow interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvWindow", reflect.TypeOf((*MockGetUMTransactionDownloadLinkServiceAPI)(nil).RecvWindow), recvWindow)
+}
+
+// MockGetRateLimitServiceAPI is a mock of GetRateLimitServiceAPI interface.
+type MockGetRateLimitServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockGetRateLimitServiceAPIMockRecorder
+}
+
+// MockGetRateLimitServiceAPIMockRecorder is the mock recorder for MockGetRateLimitServiceAPI.
+type MockGetRateLimitServiceAPIMockRecorder struct {
+	mock *MockGetRateLimitServiceAPI
+}
+
+// NewMockGetRateLimitServiceAPI creates a new mock instance.
+func NewMockGetRateLimitServiceAPI(ctrl *gomock.Controller) *MockGetRateLimitServiceAPI {
+	mock := &MockGetRateLimitServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockGetRateLimitServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGetRateLimitServiceAPI) EXPECT() *MockGetRateLimitServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockGetRateLimitServiceAPI) Do(ctx context.Context, opts ...portfolio.RequestOption) ([]*portfolio.RateLimit, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].([]*portfolio.RateLimit)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockGetRateLimitServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockGetRateLimitServiceAPI)(nil).Do), varargs...)
+}
+
+// RecvWindow mocks base method.
+func (m *MockGetRateLimitServiceAPI) RecvWindow(recvWindow int64) portfolio.GetRateLimitServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecvWindow", recvWindow)
+	ret0, _ := ret[0].(portfolio.GetRateLimitServiceAPI)
+	return ret0
+}
+
+// RecvWindow indicates an expected call of RecvWindow.
+func (mr *MockGetRateLimitServiceAPIMockRecorder) RecvWindow(recvWindow interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvWindow", reflect.TypeOf((*MockGetRateLimitServiceAPI)(nil).RecvWindow), recvWindow)
+}
+
+// MockGetNegativeBalanceExchangeRecordServiceAPI is a mock of GetNegativeBalanceExchangeRecordServiceAPI interface.
+type MockGetNegativeBalanceExchangeRecordServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockGetNegativeBalanceExchangeRecordServiceAPIMockRecorder
+}
+
+// MockGetNegativeBalanceExchangeRecordServiceAPIMockRecorder is the mock recorder for MockGetNegativeBalanceExchangeRecordServiceAPI.
+type MockGetNegativeBalanceExchangeRecordServiceAPIMockRecorder struct {
+	mock *MockGetNegativeBalanceExchangeRecordServiceAPI
+}
+
+// NewMockGetNegativeBalanceExchangeRecordServiceAPI creates a new mock instance.
+func NewMockGetNegativeBalanceExchangeRecordServiceAPI(ctrl *gomock.Controller) *MockGetNegativeBalanceExchangeRecordServiceAPI {
+	mock := &MockGetNegativeBalanceExchangeRecordServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockGetNegativeBalanceExchangeRecordServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGetNegativeBalanceExchangeRecordServiceAPI) EXPECT() *MockGetNegativeBalanceExchangeRecordServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockGetNegativeBalanceExchangeRecordServiceAPI) Do(ctx context.Context, opts ...portfolio.RequestOption) (*portfolio.NegativeBalanceExchangeRecord, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*portfolio.NegativeBalanceExchangeRecord)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockGetNegativeBalanceExchangeRecordServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockGetNegativeBalanceExchangeRecordServiceAPI)(nil).Do), varargs...)
+}
+
+// EndTime mocks base method.
+func (m *MockGetNegativeBalanceExchangeRecordServiceAPI) EndTime(endTime int64) portfolio.GetNegativeBalanceExchangeRecordServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EndTime", endTime)
+	ret0, _ := ret[0].(portfolio.GetNegativeBalanceExchangeRecordServiceAPI)
+	return ret0
+}
+
+// EndTime indicates an expected call of EndTime.
+func (mr *MockGetNegativeBalanceExchangeRecordServiceAPIMockRecorder) EndTime(endTime interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EndTime", reflect.TypeOf((*MockGetNegativeBalanceExchangeRecordServiceAPI)(nil).EndTime), endTime)
+}
+
+// RecvWindow mocks base method.
+func (m *MockGetNegativeBalanceExchangeRecordServiceAPI) RecvWindow(recvWindow int64) portfolio.GetNegativeBalanceExchangeRecordServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecvWindow", recvWindow)
+	ret0, _ := ret[0].(portfolio.GetNegativeBalanceExchangeRecordServiceAPI)
+	return ret0
+}
+
+// RecvWindow indicates an expected call of RecvWindow.
+func (mr *MockGetNegativeBalanceExchangeRecordServiceAPIMockRecorder) RecvWindow(recvWindow interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvWindow", reflect.TypeOf((*MockGetNegativeBalanceExchangeRecordServiceAPI)(nil).RecvWindow), recvWindow)
+}
+
+// StartTime mocks base method.
+func (m *MockGetNegativeBalanceExchangeRecordServiceAPI) StartTime(startTime int64) portfolio.GetNegativeBalanceExchangeRecordServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartTime", startTime)
+	ret0, _ := ret[0].(portfolio.GetNegativeBalanceExchangeRecordServiceAPI)
+	return ret0
+}
+
+// StartTime indicates an expected call of StartTime.
+func (mr *MockGetNegativeBalanceExchangeRecordServiceAPIMockRecorder) StartTime(startTime interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartTime", reflect.TypeOf((*MockGetNegativeBalanceExchangeRecordServiceAPI)(nil).StartTime), startTime)
+}
+
+// MockUMOrderServiceAPI is a mock of UMOrderServiceAPI interface.
+type MockUMOrderServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockUMOrderServiceAPIMockRecorder
+}
+
+// MockUMOrderServiceAPIMockRecorder is the mock recorder for MockUMOrderServiceAPI.
+type MockUMOrderServiceAPIMockRecorder struct {
+	mock *MockUMOrderServiceAPI
+}
+
+// NewMockUMOrderServiceAPI creates a new mock instance.
+func NewMockUMOrderServiceAPI(ctrl *gomock.Controller) *MockUMOrderServiceAPI {
+	mock := &MockUMOrderServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockUMOrderServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUMOrderServiceAPI) EXPECT() *MockUMOrderServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockUMOrderServiceAPI) Do(ctx context.Context, opts ...portfolio.RequestOption) (*portfolio.UMOrder, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*portfolio.UMOrder)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockUMOrderServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockUMOrderServiceAPI)(nil).Do), varargs...)
+}
+
+// GoodTillDate mocks base method.
+func (m *MockUMOrderServiceAPI) GoodTillDate(timestamp int64) portfolio.UMOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GoodTillDate", timestamp)
+	ret0, _ := ret[0].(portfolio.UMOrderServiceAPI)
+	return ret0
+}
+
+// GoodTillDate indicates an expected call of GoodTillDate.
+func (mr *MockUMOrderServiceAPIMockRecorder) GoodTillDate(timestamp interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GoodTillDate", reflect.TypeOf((*MockUMOrderServiceAPI)(nil).GoodTillDate), timestamp)
+}
+
+// NewClientOrderID mocks base method.
+func (m *MockUMOrderServiceAPI) NewClientOrderID(newClientOrderID string) portfolio.UMOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewClientOrderID", newClientOrderID)
+	ret0, _ := ret[0].(portfolio.UMOrderServiceAPI)
+	return ret0
+}
+
+// NewClientOrderID indicates an expected call of NewClientOrderID.
+func (mr *MockUMOrderServiceAPIMockRecorder) NewClientOrderID(newClientOrderID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewClientOrderID", reflect.TypeOf((*MockUMOrderServiceAPI)(nil).NewClientOrderID), newClientOrderID)
+}
+
+// NewOrderRespType mocks base method.
+func (m *MockUMOrderServiceAPI) NewOrderRespType(newOrderRespType portfolio.NewOrderRespType) portfolio.UMOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewOrderRespType", newOrderRespType)
+	ret0, _ := ret[0].(portfolio.UMOrderServiceAPI)
+	return ret0
+}
+
+// NewOrderRespType indicates an expected call of NewOrderRespType.
+func (mr *MockUMOrderServiceAPIMockRecorder) NewOrderRespType(newOrderRespType interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewOrderRespType", reflect.TypeOf((*MockUMOrderServiceAPI)(nil).NewOrderRespType), newOrderRespType)
+}
+
+// PositionSide mocks base method.
+func (m *MockUMOrderServiceAPI) PositionSide(positionSide portfolio.PositionSideType) portfolio.UMOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PositionSide", positionSide)
+	ret0, _ := ret[0].(portfolio.UMOrderServiceAPI)
+	return ret0
+}
+
+// PositionSide indicates an expected call of PositionSide.
+func (mr *MockUMOrderServiceAPIMockRecorder) PositionSide(positionSide interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PositionSide", reflect.TypeOf((*MockUMOrderServiceAPI)(nil).PositionSide), positionSide)
+}
+
+// Price mocks base method.
+func (m *MockUMOrderServiceAPI) Price(price string) portfolio.UMOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Price", price)
+	ret0, _ := ret[0].(portfolio.UMOrderServiceAPI)
+	return ret0
+}
+
+// Price indicates an expected call of Price.
+func (mr *MockUMOrderServiceAPIMockRecorder) Price(price interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Price", reflect.TypeOf((*MockUMOrderServiceAPI)(nil).Price), price)
+}
+
+// PriceMatch mocks base method.
+func (m *MockUMOrderServiceAPI) PriceMatch(priceMatch portfolio.PriceMatchType) portfolio.UMOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PriceMatch", priceMatch)
+	ret0, _ := ret[0].(portfolio.UMOrderServiceAPI)
+	return ret0
+}
+
+// PriceMatch indicates an expected call of PriceMatch.
+func (mr *MockUMOrderServiceAPIMockRecorder) PriceMatch(priceMatch interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PriceMatch", reflect.TypeOf((*MockUMOrderServiceAPI)(nil).PriceMatch), priceMatch)
+}
+
+// Quantity mocks base method.
+func (m *MockUMOrderServiceAPI) Quantity(quantity string) portfolio.UMOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Quantity", quantity)
+	ret0, _ := ret[0].(portfolio.UMOrderServiceAPI)
+	return ret0
+}
+
+// Quantity indicates an expected call of Quantity.
+func (mr *MockUMOrderServiceAPIMockRecorder) Quantity(quantity interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Quantity", reflect.TypeOf((*MockUMOrderServiceAPI)(nil).Quantity), quantity)
+}
+
+// ReduceOnly mocks base method.
+func (m *MockUMOrderServiceAPI) ReduceOnly(reduceOnly bool) portfolio.UMOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReduceOnly", reduceOnly)
+	ret0, _ := ret[0].(portfolio.UMOrderServiceAPI)
+	return ret0
+}
+
+// ReduceOnly indicates an expected call of ReduceOnly.
+func (mr *MockUMOrderServiceAPIMockRecorder) ReduceOnly(reduceOnly interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReduceOnly", reflect.TypeOf((*MockUMOrderServiceAPI)(nil).ReduceOnly), reduceOnly)
+}
+
+// SelfTradePreventionMode mocks base method.
+func (m *MockUMOrderServiceAPI) SelfTradePreventionMode(mode portfolio.SelfTradePreventionMode) portfolio.UMOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SelfTradePreventionMode", mode)
+	ret0, _ := ret[0].(portfolio.UMOrderServiceAPI)
+	return ret0
+}
+
+// SelfTradePreventionMode indicates an expected call of SelfTradePreventionMode.
+func (mr *MockUMOrderServiceAPIMockRecorder) SelfTradePreventionMode(mode interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelfTradePreventionMode", reflect.TypeOf((*MockUMOrderServiceAPI)(nil).SelfTradePreventionMode), mode)
+}
+
+// Side mocks base method.
+func (m *MockUMOrderServiceAPI) Side(side portfolio.SideType) portfolio.UMOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Side", side)
+	ret0, _ := ret[0].(portfolio.UMOrderServiceAPI)
+	return ret0
+}
+
+// Side indicates an expected call of Side.
+func (mr *MockUMOrderServiceAPIMockRecorder) Side(side interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Side", reflect.TypeOf((*MockUMOrderServiceAPI)(nil).Side), side)
+}
+
+// Symbol mocks base method.
+func (m *MockUMOrderServiceAPI) Symbol(symbol string) portfolio.UMOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Symbol", symbol)
+	ret0, _ := ret[0].(portfolio.UMOrderServiceAPI)
+	return ret0
+}
+
+// Symbol indicates an expected call of Symbol.
+func (mr *MockUMOrderServiceAPIMockRecorder) Symbol(symbol interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Symbol", reflect.TypeOf((*MockUMOrderServiceAPI)(nil).Symbol), symbol)
+}
+
+// TimeInForce mocks base method.
+func (m *MockUMOrderServiceAPI) TimeInForce(timeInForce portfolio.TimeInForceType) portfolio.UMOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TimeInForce", timeInForce)
+	ret0, _ := ret[0].(portfolio.UMOrderServiceAPI)
+	return ret0
+}
+
+// TimeInForce indicates an expected call of TimeInForce.
+func (mr *MockUMOrderServiceAPIMockRecorder) TimeInForce(timeInForce interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TimeInForce", reflect.TypeOf((*MockUMOrderServiceAPI)(nil).TimeInForce), timeInForce)
+}
+
+// Type mocks base method.
+func (m *MockUMOrderServiceAPI) Type(orderType portfolio.OrderType) portfolio.UMOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Type", orderType)
+	ret0, _ := ret[0].(portfolio.UMOrderServiceAPI)
+	return ret0
+}
+
+// Type indicates an expected call of Type.
+func (mr *MockUMOrderServiceAPIMockRecorder) Type(orderType interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Type", reflect.TypeOf((*MockUMOrderServiceAPI)(nil).Type), orderType)
+}
+
+// MockUMConditionalOrderServiceAPI is a mock of UMConditionalOrderServiceAPI interface.
+type MockUMConditionalOrderServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockUMConditionalOrderServiceAPIMockRecorder
+}
+
+// MockUMConditionalOrderServiceAPIMockRecorder is the mock recorder for MockUMConditionalOrderServiceAPI.
+type MockUMConditionalOrderServiceAPIMockRecorder struct {
+	mock *MockUMConditionalOrderServiceAPI
+}
+
+// NewMockUMConditionalOrderServiceAPI creates a new mock instance.
+func NewMockUMConditionalOrderServiceAPI(ctrl *gomock.Controller) *MockUMConditionalOrderServiceAPI {
+	mock := &MockUMConditionalOrderServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockUMConditionalOrderServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUMConditionalOrderServiceAPI) EXPECT() *MockUMConditionalOrderServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// ActivationPrice mocks base method.
+func (m *MockUMConditionalOrderServiceAPI) ActivationPrice(price string) portfolio.UMConditionalOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ActivationPrice", price)
+	ret0, _ := ret[0].(portfolio.UMConditionalOrderServiceAPI)
+	return ret0
+}
+
+// ActivationPrice indicates an expected call of ActivationPrice.
+func (mr *MockUMConditionalOrderServiceAPIMockRecorder) ActivationPrice(price interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ActivationPrice", reflect.TypeOf((*MockUMConditionalOrderServiceAPI)(nil).ActivationPrice), price)
+}
+
+// CallbackRate mocks base method.
+func (m *MockUMConditionalOrderServiceAPI) CallbackRate(rate string) portfolio.UMConditionalOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CallbackRate", rate)
+	ret0, _ := ret[0].(portfolio.UMConditionalOrderServiceAPI)
+	return ret0
+}
+
+// CallbackRate indicates an expected call of CallbackRate.
+func (mr *MockUMConditionalOrderServiceAPIMockRecorder) CallbackRate(rate interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CallbackRate", reflect.TypeOf((*MockUMConditionalOrderServiceAPI)(nil).CallbackRate), rate)
+}
+
+// Do mocks base method.
+func (m *MockUMConditionalOrderServiceAPI) Do(ctx context.Context, opts ...portfolio.RequestOption) (*portfolio.UMConditionalOrder, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*portfolio.UMConditionalOrder)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockUMConditionalOrderServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockUMConditionalOrderServiceAPI)(nil).Do), varargs...)
+}
+
+// PositionSide mocks base method.
+func (m *MockUMConditionalOrderServiceAPI) PositionSide(positionSide portfolio.PositionSideType) portfolio.UMConditionalOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PositionSide", positionSide)
+	ret0, _ := ret[0].(portfolio.UMConditionalOrderServiceAPI)
+	return ret0
+}
+
+// PositionSide indicates an expected call of PositionSide.
+func (mr *MockUMConditionalOrderServiceAPIMockRecorder) PositionSide(positionSide interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PositionSide", reflect.TypeOf((*MockUMConditionalOrderServiceAPI)(nil).PositionSide), positionSide)
+}
+
+// Price mocks base method.
+func (m *MockUMConditionalOrderServiceAPI) Price(price string) portfolio.UMConditionalOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Price", price)
+	ret0, _ := ret[0].(portfolio.UMConditionalOrderServiceAPI)
+	return ret0
+}
+
+// Price indicates an expected call of Price.
+func (mr *MockUMConditionalOrderServiceAPIMockRecorder) Price(price interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Price", reflect.TypeOf((*MockUMConditionalOrderServiceAPI)(nil).Price), price)
+}
+
+// PriceProtect mocks base method.
+func (m *MockUMConditionalOrderServiceAPI) PriceProtect(protect bool) portfolio.UMConditionalOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PriceProtect", protect)
+	ret0, _ := ret[0].(portfolio.UMConditionalOrderServiceAPI)
+	return ret0
+}
+
+// PriceProtect indicates an expected call of PriceProtect.
+func (mr *MockUMConditionalOrderServiceAPIMockRecorder) PriceProtect(protect interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PriceProtect", reflect.TypeOf((*MockUMConditionalOrderServiceAPI)(nil).PriceProtect), protect)
+}
+
+// Quantity mocks base method.
+func (m *MockUMConditionalOrderServiceAPI) Quantity(quantity string) portfolio.UMConditionalOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Quantity", quantity)
+	ret0, _ := ret[0].(portfolio.UMConditionalOrderServiceAPI)
+	return ret0
+}
+
+// Quantity indicates an expected call of Quantity.
+func (mr *MockUMConditionalOrderServiceAPIMockRecorder) Quantity(quantity interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Quantity", reflect.TypeOf((*MockUMConditionalOrderServiceAPI)(nil).Quantity), quantity)
+}
+
+// Side mocks base method.
+func (m *MockUMConditionalOrderServiceAPI) Side(side portfolio.SideType) portfolio.UMConditionalOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Side", side)
+	ret0, _ := ret[0].(portfolio.UMConditionalOrderServiceAPI)
+	return ret0
+}
+
+// Side indicates an expected call of Side.
+func (mr *MockUMConditionalOrderServiceAPIMockRecorder) Side(side interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Side", reflect.TypeOf((*MockUMConditionalOrderServiceAPI)(nil).Side), side)
+}
+
+// StopPrice mocks base method.
+func (m *MockUMConditionalOrderServiceAPI) StopPrice(stopPrice string) portfolio.UMConditionalOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StopPrice", stopPrice)
+	ret0, _ := ret[0].(portfolio.UMConditionalOrderServiceAPI)
+	return ret0
+}
+
+// StopPrice indicates an expected call of StopPrice.
+func (mr *MockUMConditionalOrderServiceAPIMockRecorder) StopPrice(stopPrice interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StopPrice", reflect.TypeOf((*MockUMConditionalOrderServiceAPI)(nil).StopPrice), stopPrice)
+}
+
+// StrategyType mocks base method.
+func (m *MockUMConditionalOrderServiceAPI) StrategyType(strategyType string) portfolio.UMConditionalOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StrategyType", strategyType)
+	ret0, _ := ret[0].(portfolio.UMConditionalOrderServiceAPI)
+	return ret0
+}
+
+// StrategyType indicates an expected call of StrategyType.
+func (mr *MockUMConditionalOrderServiceAPIMockRecorder) StrategyType(strategyType interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StrategyType", reflect.TypeOf((*MockUMConditionalOrderServiceAPI)(nil).StrategyType), strategyType)
+}
+
+// Symbol mocks base method.
+func (m *MockUMConditionalOrderServiceAPI) Symbol(symbol string) portfolio.UMConditionalOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Symbol", symbol)
+	ret0, _ := ret[0].(portfolio.UMConditionalOrderServiceAPI)
+	return ret0
+}
+
+// Symbol indicates an expected call of Symbol.
+func (mr *MockUMConditionalOrderServiceAPIMockRecorder) Symbol(symbol interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Symbol", reflect.TypeOf((*MockUMConditionalOrderServiceAPI)(nil).Symbol), symbol)
+}
+
+// TimeInForce mocks base method.
+func (m *MockUMConditionalOrderServiceAPI) TimeInForce(timeInForce portfolio.TimeInForceType) portfolio.UMConditionalOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TimeInForce", timeInForce)
+	ret0, _ := ret[0].(portfolio.UMConditionalOrderServiceAPI)
+	return ret0
+}
+
+// TimeInForce indicates an expected call of TimeInForce.
+func (mr *MockUMConditionalOrderServiceAPIMockRecorder) TimeInForce(timeInForce interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TimeInForce", reflect.TypeOf((*MockUMConditionalOrderServiceAPI)(nil).TimeInForce), timeInForce)
+}
+
+// WorkingType mocks base method.
+func (m *MockUMConditionalOrderServiceAPI) WorkingType(workingType string) portfolio.UMConditionalOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WorkingType", workingType)
+	ret0, _ := ret[0].(portfolio.UMConditionalOrderServiceAPI)
+	return ret0
+}
+
+// WorkingType indicates an expected call of WorkingType.
+func (mr *MockUMConditionalOrderServiceAPIMockRecorder) WorkingType(workingType interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WorkingType", reflect.TypeOf((*MockUMConditionalOrderServiceAPI)(nil).WorkingType), workingType)
+}
+
+// MockCMOrderServiceAPI is a mock of CMOrderServiceAPI interface.
+type MockCMOrderServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockCMOrderServiceAPIMockRecorder
+}
+
+// MockCMOrderServiceAPIMockRecorder is the mock recorder for MockCMOrderServiceAPI.
+type MockCMOrderServiceAPIMockRecorder struct {
+	mock *MockCMOrderServiceAPI
+}
+
+// NewMockCMOrderServiceAPI creates a new mock instance.
+func NewMockCMOrderServiceAPI(ctrl *gomock.Controller) *MockCMOrderServiceAPI {
+	mock := &MockCMOrderServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockCMOrderServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCMOrderServiceAPI) EXPECT() *MockCMOrderServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockCMOrderServiceAPI) Do(ctx context.Context, opts ...portfolio.RequestOption) (*portfolio.CMOrder, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*portfolio.CMOrder)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockCMOrderServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockCMOrderServiceAPI)(nil).Do), varargs...)
+}
+
+// NewClientOrderID mocks base method.
+func (m *MockCMOrderServiceAPI) NewClientOrderID(newClientOrderID string) portfolio.CMOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewClientOrderID", newClientOrderID)
+	ret0, _ := ret[0].(portfolio.CMOrderServiceAPI)
+	return ret0
+}
+
+// NewClientOrderID indicates an expected call of NewClientOrderID.
+func (mr *MockCMOrderServiceAPIMockRecorder) NewClientOrderID(newClientOrderID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewClientOrderID", reflect.TypeOf((*MockCMOrderServiceAPI)(nil).NewClientOrderID), newClientOrderID)
+}
+
+// NewOrderRespType mocks base method.
+func (m *MockCMOrderServiceAPI) NewOrderRespType(newOrderRespType portfolio.NewOrderRespType) portfolio.CMOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewOrderRespType", newOrderRespType)
+	ret0, _ := ret[0].(portfolio.CMOrderServiceAPI)
+	return ret0
+}
+
+// NewOrderRespType indicates an expected call of NewOrderRespType.
+func (mr *MockCMOrderServiceAPIMockRecorder) NewOrderRespType(newOrderRespType interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewOrderRespType", reflect.TypeOf((*MockCMOrderServiceAPI)(nil).NewOrderRespType), newOrderRespType)
+}
+
+// PositionSide mocks base method.
+func (m *MockCMOrderServiceAPI) PositionSide(positionSide portfolio.PositionSideType) portfolio.CMOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PositionSide", positionSide)
+	ret0, _ := ret[0].(portfolio.CMOrderServiceAPI)
+	return ret0
+}
+
+// PositionSide indicates an expected call of PositionSide.
+func (mr *MockCMOrderServiceAPIMockRecorder) PositionSide(positionSide interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PositionSide", reflect.TypeOf((*MockCMOrderServiceAPI)(nil).PositionSide), positionSide)
+}
+
+// Price mocks base method.
+func (m *MockCMOrderServiceAPI) Price(price string) portfolio.CMOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Price", price)
+	ret0, _ := ret[0].(portfolio.CMOrderServiceAPI)
+	return ret0
+}
+
+// Price indicates an expected call of Price.
+func (mr *MockCMOrderServiceAPIMockRecorder) Price(price interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Price", reflect.TypeOf((*MockCMOrderServiceAPI)(nil).Price), price)
+}
+
+// Quantity mocks base method.
+func (m *MockCMOrderServiceAPI) Quantity(quantity string) portfolio.CMOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Quantity", quantity)
+	ret0, _ := ret[0].(portfolio.CMOrderServiceAPI)
+	return ret0
+}
+
+// Quantity indicates an expected call of Quantity.
+func (mr *MockCMOrderServiceAPIMockRecorder) Quantity(quantity interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Quantity", reflect.TypeOf((*MockCMOrderServiceAPI)(nil).Quantity), quantity)
+}
+
+// ReduceOnly mocks base method.
+func (m *MockCMOrderServiceAPI) ReduceOnly(reduceOnly bool) portfolio.CMOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReduceOnly", reduceOnly)
+	ret0, _ := ret[0].(portfolio.CMOrderServiceAPI)
+	return ret0
+}
+
+// ReduceOnly indicates an expected call of ReduceOnly.
+func (mr *MockCMOrderServiceAPIMockRecorder) ReduceOnly(reduceOnly interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReduceOnly", reflect.TypeOf((*MockCMOrderServiceAPI)(nil).ReduceOnly), reduceOnly)
+}
+
+// Side mocks base method.
+func (m *MockCMOrderServiceAPI) Side(side portfolio.SideType) portfolio.CMOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Side", side)
+	ret0, _ := ret[0].(portfolio.CMOrderServiceAPI)
+	return ret0
+}
+
+// Side indicates an expected call of Side.
+func (mr *MockCMOrderServiceAPIMockRecorder) Side(side interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Side", reflect.TypeOf((*MockCMOrderServiceAPI)(nil).Side), side)
+}
+
+// Symbol mocks base method.
+func (m *MockCMOrderServiceAPI) Symbol(symbol string) portfolio.CMOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Symbol", symbol)
+	ret0, _ := ret[0].(portfolio.CMOrderServiceAPI)
+	return ret0
+}
+
+// Symbol indicates an expected call of Symbol.
+func (mr *MockCMOrderServiceAPIMockRecorder) Symbol(symbol interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Symbol", reflect.TypeOf((*MockCMOrderServiceAPI)(nil).Symbol), symbol)
+}
+
+// TimeInForce mocks base method.
+func (m *MockCMOrderServiceAPI) TimeInForce(timeInForce portfolio.TimeInForceType) portfolio.CMOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TimeInForce", timeInForce)
+	ret0, _ := ret[0].(portfolio.CMOrderServiceAPI)
+	return ret0
+}
+
+// TimeInForce indicates an expected call of TimeInForce.
+func (mr *MockCMOrderServiceAPIMockRecorder) TimeInForce(timeInForce interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TimeInForce", reflect.TypeOf((*MockCMOrderServiceAPI)(nil).TimeInForce), timeInForce)
+}
+
+// Type mocks base method.
+func (m *MockCMOrderServiceAPI) Type(orderType portfolio.OrderType) portfolio.CMOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Type", orderType)
+	ret0, _ := ret[0].(portfolio.CMOrderServiceAPI)
+	return ret0
+}
+
+// Type indicates an expected call of Type.
+func (mr *MockCMOrderServiceAPIMockRecorder) Type(orderType interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Type", reflect.TypeOf((*MockCMOrderServiceAPI)(nil).Type), orderType)
+}
+
+// MockCMConditionalOrderServiceAPI is a mock of CMConditionalOrderServiceAPI interface.
+type MockCMConditionalOrderServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockCMConditionalOrderServiceAPIMockRecorder
+}
+
+// MockCMConditionalOrderServiceAPIMockRecorder is the mock recorder for MockCMConditionalOrderServiceAPI.
+type MockCMConditionalOrderServiceAPIMockRecorder struct {
+	mock *MockCMConditionalOrderServiceAPI
+}
+
+// NewMockCMConditionalOrderServiceAPI creates a new mock instance.
+func NewMockCMConditionalOrderServiceAPI(ctrl *gomock.Controller) *MockCMConditionalOrderServiceAPI {
+	mock := &MockCMConditionalOrderServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockCMConditionalOrderServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCMConditionalOrderServiceAPI) EXPECT() *MockCMConditionalOrderServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// ActivationPrice mocks base method.
+func (m *MockCMConditionalOrderServiceAPI) ActivationPrice(price string) portfolio.CMConditionalOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ActivationPrice", price)
+	ret0, _ := ret[0].(portfolio.CMConditionalOrderServiceAPI)
+	return ret0
+}
+
+// ActivationPrice indicates an expected call of ActivationPrice.
+func (mr *MockCMConditionalOrderServiceAPIMockRecorder) ActivationPrice(price interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ActivationPrice", reflect.TypeOf((*MockCMConditionalOrderServiceAPI)(nil).ActivationPrice), price)
+}
+
+// CallbackRate mocks base method.
+func (m *MockCMConditionalOrderServiceAPI) CallbackRate(rate string) portfolio.CMConditionalOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CallbackRate", rate)
+	ret0, _ := ret[0].(portfolio.CMConditionalOrderServiceAPI)
+	return ret0
+}
+
+// CallbackRate indicates an expected call of CallbackRate.
+func (mr *MockCMConditionalOrderServiceAPIMockRecorder) CallbackRate(rate interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CallbackRate", reflect.TypeOf((*MockCMConditionalOrderServiceAPI)(nil).CallbackRate), rate)
+}
+
+// Do mocks base method.
+func (m *MockCMConditionalOrderServiceAPI) Do(ctx context.Context, opts ...portfolio.RequestOption) (*portfolio.CMConditionalOrder, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*portfolio.CMConditionalOrder)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockCMConditionalOrderServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockCMConditionalOrderServiceAPI)(nil).Do), varargs...)
+}
+
+// NewClientStrategyID mocks base method.
+func (m *MockCMConditionalOrderServiceAPI) NewClientStrategyID(id string) portfolio.CMConditionalOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewClientStrategyID", id)
+	ret0, _ := ret[0].(portfolio.CMConditionalOrderServiceAPI)
+	return ret0
+}
+
+// NewClientStrategyID indicates an expected call of NewClientStrategyID.
+func (mr *MockCMConditionalOrderServiceAPIMockRecorder) NewClientStrategyID(id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewClientStrategyID", reflect.TypeOf((*MockCMConditionalOrderServiceAPI)(nil).NewClientStrategyID), id)
+}
+
+// PositionSide mocks base method.
+func (m *MockCMConditionalOrderServiceAPI) PositionSide(positionSide portfolio.PositionSideType) portfolio.CMConditionalOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PositionSide", positionSide)
+	ret0, _ := ret[0].(portfolio.CMConditionalOrderServiceAPI)
+	return ret0
+}
+
+// PositionSide indicates an expected call of PositionSide.
+func (mr *MockCMConditionalOrderServiceAPIMockRecorder) PositionSide(positionSide interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PositionSide", reflect.TypeOf((*MockCMConditionalOrderServiceAPI)(nil).PositionSide), positionSide)
+}
+
+// Price mocks base method.
+func (m *MockCMConditionalOrderServiceAPI) Price(price string) portfolio.CMConditionalOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Price", price)
+	ret0, _ := ret[0].(portfolio.CMConditionalOrderServiceAPI)
+	return ret0
+}
+
+// Price indicates an expected call of Price.
+func (mr *MockCMConditionalOrderServiceAPIMockRecorder) Price(price interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Price", reflect.TypeOf((*MockCMConditionalOrderServiceAPI)(nil).Price), price)
+}
+
+// PriceProtect mocks base method.
+func (m *MockCMConditionalOrderServiceAPI) PriceProtect(protect bool) portfolio.CMConditionalOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PriceProtect", protect)
+	ret0, _ := ret[0].(portfolio.CMConditionalOrderServiceAPI)
+	return ret0
+}
+
+// PriceProtect indicates an expected call of PriceProtect.
+func (mr *MockCMConditionalOrderServiceAPIMockRecorder) PriceProtect(protect interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PriceProtect", reflect.TypeOf((*MockCMConditionalOrderServiceAPI)(nil).PriceProtect), protect)
+}
+
+// Quantity mocks base method.
+func (m *MockCMConditionalOrderServiceAPI) Quantity(quantity string) portfolio.CMConditionalOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Quantity", quantity)
+	ret0, _ := ret[0].(portfolio.CMConditionalOrderServiceAPI)
+	return ret0
+}
+
+// Quantity indicates an expected call of Quantity.
+func (mr *MockCMConditionalOrderServiceAPIMockRecorder) Quantity(quantity interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Quantity", reflect.TypeOf((*MockCMConditionalOrderServiceAPI)(nil).Quantity), quantity)
+}
+
+// Side mocks base method.
+func (m *MockCMConditionalOrderServiceAPI) Side(side portfolio.SideType) portfolio.CMConditionalOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Side", side)
+	ret0, _ := ret[0].(portfolio.CMConditionalOrderServiceAPI)
+	return ret0
+}
+
+// Side indicates an expected call of Side.
+func (mr *MockCMConditionalOrderServiceAPIMockRecorder) Side(side interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Side", reflect.TypeOf((*MockCMConditionalOrderServiceAPI)(nil).Side), side)
+}
+
+// StopPrice mocks base method.
+func (m *MockCMConditionalOrderServiceAPI) StopPrice(stopPrice string) portfolio.CMConditionalOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StopPrice", stopPrice)
+	ret0, _ := ret[0].(portfolio.CMConditionalOrderServiceAPI)
+	return ret0
+}
+
+// StopPrice indicates an expected call of StopPrice.
+func (mr *MockCMConditionalOrderServiceAPIMockRecorder) StopPrice(stopPrice interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StopPrice", reflect.TypeOf((*MockCMConditionalOrderServiceAPI)(nil).StopPrice), stopPrice)
+}
+
+// StrategyType mocks base method.
+func (m *MockCMConditionalOrderServiceAPI) StrategyType(strategyType string) portfolio.CMConditionalOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StrategyType", strategyType)
+	ret0, _ := ret[0].(portfolio.CMConditionalOrderServiceAPI)
+	return ret0
+}
+
+// StrategyType indicates an expected call of StrategyType.
+func (mr *MockCMConditionalOrderServiceAPIMockRecorder) StrategyType(strategyType interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StrategyType", reflect.TypeOf((*MockCMConditionalOrderServiceAPI)(nil).StrategyType), strategyType)
+}
+
+// Symbol mocks base method.
+func (m *MockCMConditionalOrderServiceAPI) Symbol(symbol string) portfolio.CMConditionalOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Symbol", symbol)
+	ret0, _ := ret[0].(portfolio.CMConditionalOrderServiceAPI)
+	return ret0
+}
+
+// Symbol indicates an expected call of Symbol.
+func (mr *MockCMConditionalOrderServiceAPIMockRecorder) Symbol(symbol interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Symbol", reflect.TypeOf((*MockCMConditionalOrderServiceAPI)(nil).Symbol), symbol)
+}
+
+// TimeInForce mocks base method.
+func (m *MockCMConditionalOrderServiceAPI) TimeInForce(timeInForce portfolio.TimeInForceType) portfolio.CMConditionalOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TimeInForce", timeInForce)
+	ret0, _ := ret[0].(portfolio.CMConditionalOrderServiceAPI)
+	return ret0
+}
+
+// TimeInForce indicates an expected call of TimeInForce.
+func (mr *MockCMConditionalOrderServiceAPIMockRecorder) TimeInForce(timeInForce interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TimeInForce", reflect.TypeOf((*MockCMConditionalOrderServiceAPI)(nil).TimeInForce), timeInForce)
+}
+
+// WorkingType mocks base method.
+func (m *MockCMConditionalOrderServiceAPI) WorkingType(workingType string) portfolio.CMConditionalOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WorkingType", workingType)
+	ret0, _ := ret[0].(portfolio.CMConditionalOrderServiceAPI)
+	return ret0
+}
+
+// WorkingType indicates an expected call of WorkingType.
+func (mr *MockCMConditionalOrderServiceAPIMockRecorder) WorkingType(workingType interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WorkingType", reflect.TypeOf((*MockCMConditionalOrderServiceAPI)(nil).WorkingType), workingType)
+}
+
+// MockMarginOrderServiceAPI is a mock of MarginOrderServiceAPI interface.
+type MockMarginOrderServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockMarginOrderServiceAPIMockRecorder
+}
+
+// MockMarginOrderServiceAPIMockRecorder is the mock recorder for MockMarginOrderServiceAPI.
+type MockMarginOrderServiceAPIMockRecorder struct {
+	mock *MockMarginOrderServiceAPI
+}
+
+// NewMockMarginOrderServiceAPI creates a new mock instance.
+func NewMockMarginOrderServiceAPI(ctrl *gomock.Controller) *MockMarginOrderServiceAPI {
+	mock := &MockMarginOrderServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockMarginOrderServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockMarginOrderServiceAPI) EXPECT() *MockMarginOrderServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// AutoRepayAtCancel mocks base method.
+func (m *MockMarginOrderServiceAPI) AutoRepayAtCancel(autoRepay bool) portfolio.MarginOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AutoRepayAtCancel", autoRepay)
+	ret0, _ := ret[0].(portfolio.MarginOrderServiceAPI)
+	return ret0
+}
+
+// AutoRepayAtCancel indicates an expected call of AutoRepayAtCancel.
+func (mr *MockMarginOrderServiceAPIMockRecorder) AutoRepayAtCancel(autoRepay interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AutoRepayAtCancel", reflect.TypeOf((*MockMarginOrderServiceAPI)(nil).AutoRepayAtCancel), autoRepay)
+}
+
+// Do mocks base method.
+func (m *MockMarginOrderServiceAPI) Do(ctx context.Context, opts ...portfolio.RequestOption) (*portfolio.MarginOrder, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*portfolio.MarginOrder)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockMarginOrderServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockMarginOrderServiceAPI)(nil).Do), varargs...)
+}
+
+// IcebergQty mocks base method.
+func (m *MockMarginOrderServiceAPI) IcebergQty(icebergQty string) portfolio.MarginOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IcebergQty", icebergQty)
+	ret0, _ := ret[0].(portfolio.MarginOrderServiceAPI)
+	return ret0
+}
+
+// IcebergQty indicates an expected call of IcebergQty.
+func (mr *MockMarginOrderServiceAPIMockRecorder) IcebergQty(icebergQty interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IcebergQty", reflect.TypeOf((*MockMarginOrderServiceAPI)(nil).IcebergQty), icebergQty)
+}
+
+// NewClientOrderID mocks base method.
+func (m *MockMarginOrderServiceAPI) NewClientOrderID(newClientOrderID string) portfolio.MarginOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewClientOrderID", newClientOrderID)
+	ret0, _ := ret[0].(portfolio.MarginOrderServiceAPI)
+	return ret0
+}
+
+// NewClientOrderID indicates an expected call of NewClientOrderID.
+func (mr *MockMarginOrderServiceAPIMockRecorder) NewClientOrderID(newClientOrderID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewClientOrderID", reflect.TypeOf((*MockMarginOrderServiceAPI)(nil).NewClientOrderID), newClientOrderID)
+}
+
+// NewOrderRespType mocks base method.
+func (m *MockMarginOrderServiceAPI) NewOrderRespType(newOrderRespType portfolio.NewOrderRespType) portfolio.MarginOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewOrderRespType", newOrderRespType)
+	ret0, _ := ret[0].(portfolio.MarginOrderServiceAPI)
+	return ret0
+}
+
+// NewOrderRespType indicates an expected call of NewOrderRespType.
+func (mr *MockMarginOrderServiceAPIMockRecorder) NewOrderRespType(newOrderRespType interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewOrderRespType", reflect.TypeOf((*MockMarginOrderServiceAPI)(nil).NewOrderRespType), newOrderRespType)
+}
+
+// Price mocks base method.
+func (m *MockMarginOrderServiceAPI) Price(price string) portfolio.MarginOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Price", price)
+	ret0, _ := ret[0].(portfolio.MarginOrderServiceAPI)
+	return ret0
+}
+
+// Price indicates an expected call of Price.
+func (mr *MockMarginOrderServiceAPIMockRecorder) Price(price interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Price", reflect.TypeOf((*MockMarginOrderServiceAPI)(nil).Price), price)
+}
+
+// Quantity mocks base method.
+func (m *MockMarginOrderServiceAPI) Quantity(quantity string) portfolio.MarginOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Quantity", quantity)
+	ret0, _ := ret[0].(portfolio.MarginOrderServiceAPI)
+	return ret0
+}
+
+// Quantity indicates an expected call of Quantity.
+func (mr *MockMarginOrderServiceAPIMockRecorder) Quantity(quantity interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Quantity", reflect.TypeOf((*MockMarginOrderServiceAPI)(nil).Quantity), quantity)
+}
+
+// QuoteOrderQty mocks base method.
+func (m *MockMarginOrderServiceAPI) QuoteOrderQty(quoteOrderQty string) portfolio.MarginOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "QuoteOrderQty", quoteOrderQty)
+	ret0, _ := ret[0].(portfolio.MarginOrderServiceAPI)
+	return ret0
+}
+
+// QuoteOrderQty indicates an expected call of QuoteOrderQty.
+func (mr *MockMarginOrderServiceAPIMockRecorder) QuoteOrderQty(quoteOrderQty interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QuoteOrderQty", reflect.TypeOf((*MockMarginOrderServiceAPI)(nil).QuoteOrderQty), quoteOrderQty)
+}
+
+// SelfTradePreventionMode mocks base method.
+func (m *MockMarginOrderServiceAPI) SelfTradePreventionMode(mode portfolio.SelfTradePreventionMode) portfolio.MarginOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SelfTradePreventionMode", mode)
+	ret0, _ := ret[0].(portfolio.MarginOrderServiceAPI)
+	return ret0
+}
+
+// SelfTradePreventionMode indicates an expected call of SelfTradePreventionMode.
+func (mr *MockMarginOrderServiceAPIMockRecorder) SelfTradePreventionMode(mode interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelfTradePreventionMode", reflect.TypeOf((*MockMarginOrderServiceAPI)(nil).SelfTradePreventionMode), mode)
+}
+
+// Side mocks base method.
+func (m *MockMarginOrderServiceAPI) Side(side portfolio.SideType) portfolio.MarginOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Side", side)
+	ret0, _ := ret[0].(portfolio.MarginOrderServiceAPI)
+	return ret0
+}
+
+// Side indicates an expected call of Side.
+func (mr *MockMarginOrderServiceAPIMockRecorder) Side(side interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Side", reflect.TypeOf((*MockMarginOrderServiceAPI)(nil).Side), side)
+}
+
+// SideEffectType mocks base method.
+func (m *MockMarginOrderServiceAPI) SideEffectType(sideEffectType string) portfolio.MarginOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SideEffectType", sideEffectType)
+	ret0, _ := ret[0].(portfolio.MarginOrderServiceAPI)
+	return ret0
+}
+
+// SideEffectType indicates an expected call of SideEffectType.
+func (mr *MockMarginOrderServiceAPIMockRecorder) SideEffectType(sideEffectType interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SideEffectType", reflect.TypeOf((*MockMarginOrderServiceAPI)(nil).SideEffectType), sideEffectType)
+}
+
+// StopPrice mocks base method.
+func (m *MockMarginOrderServiceAPI) StopPrice(stopPrice string) portfolio.MarginOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StopPrice", stopPrice)
+	ret0, _ := ret[0].(portfolio.MarginOrderServiceAPI)
+	return ret0
+}
+
+// StopPrice indicates an expected call of StopPrice.
+func (mr *MockMarginOrderServiceAPIMockRecorder) StopPrice(stopPrice interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StopPrice", reflect.TypeOf((*MockMarginOrderServiceAPI)(nil).StopPrice), stopPrice)
+}
+
+// Symbol mocks base method.
+func (m *MockMarginOrderServiceAPI) Symbol(symbol string) portfolio.MarginOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Symbol", symbol)
+	ret0, _ := ret[0].(portfolio.MarginOrderServiceAPI)
+	return ret0
+}
+
+// Symbol indicates an expected call of Symbol.
+func (mr *MockMarginOrderServiceAPIMockRecorder) Symbol(symbol interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Symbol", reflect.TypeOf((*MockMarginOrderServiceAPI)(nil).Symbol), symbol)
+}
+
+// TimeInForce mocks base method.
+func (m *MockMarginOrderServiceAPI) TimeInForce(timeInForce portfolio.TimeInForceType) portfolio.MarginOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TimeInForce", timeInForce)
+	ret0, _ := ret[0].(portfolio.MarginOrderServiceAPI)
+	return ret0
+}
+
+// TimeInForce indicates an expected call of TimeInForce.
+func (mr *MockMarginOrderServiceAPIMockRecorder) TimeInForce(timeInForce interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TimeInForce", reflect.TypeOf((*MockMarginOrderServiceAPI)(nil).TimeInForce), timeInForce)
+}
+
+// Type mocks base method.
+func (m *MockMarginOrderServiceAPI) Type(orderType portfolio.OrderType) portfolio.MarginOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Type", orderType)
+	ret0, _ := ret[0].(portfolio.MarginOrderServiceAPI)
+	return ret0
+}
+
+// Type indicates an expected call of Type.
+func (mr *MockMarginOrderServiceAPIMockRecorder) Type(orderType interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Type", reflect.TypeOf((*MockMarginOrderServiceAPI)(nil).Type), orderType)
+}
+
+// MockMarginLoanServiceAPI is a mock of MarginLoanServiceAPI interface.
+type MockMarginLoanServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockMarginLoanServiceAPIMockRecorder
+}
+
+// MockMarginLoanServiceAPIMockRecorder is the mock recorder for MockMarginLoanServiceAPI.
+type MockMarginLoanServiceAPIMockRecorder struct {
+	mock *MockMarginLoanServiceAPI
+}
+
+// NewMockMarginLoanServiceAPI creates a new mock instance.
+func NewMockMarginLoanServiceAPI(ctrl *gomock.Controller) *MockMarginLoanServiceAPI {
+	mock := &MockMarginLoanServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockMarginLoanServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockMarginLoanServiceAPI) EXPECT() *MockMarginLoanServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Amount mocks base method.
+func (m *MockMarginLoanServiceAPI) Amount(amount string) portfolio.MarginLoanServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Amount", amount)
+	ret0, _ := ret[0].(portfolio.MarginLoanServiceAPI)
+	return ret0
+}
+
+// Amount indicates an expected call of Amount.
+func (mr *MockMarginLoanServiceAPIMockRecorder) Amount(amount interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Amount", reflect.TypeOf((*MockMarginLoanServiceAPI)(nil).Amount), amount)
+}
+
+// Asset mocks base method.
+func (m *MockMarginLoanServiceAPI) Asset(asset string) portfolio.MarginLoanServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Asset", asset)
+	ret0, _ := ret[0].(portfolio.MarginLoanServiceAPI)
+	return ret0
+}
+
+// Asset indicates an expected call of Asset.
+func (mr *MockMarginLoanServiceAPIMockRecorder) Asset(asset interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Asset", reflect.TypeOf((*MockMarginLoanServiceAPI)(nil).Asset), asset)
+}
+
+// Do mocks base method.
+func (m *MockMarginLoanServiceAPI) Do(ctx context.Context, opts ...portfolio.RequestOption) (*portfolio.MarginLoanResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*portfolio.MarginLoanResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockMarginLoanServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockMarginLoanServiceAPI)(nil).Do), varargs...)
+}
+
+// MockMarginRepayServiceAPI is a mock of MarginRepayServiceAPI interface.
+type MockMarginRepayServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockMarginRepayServiceAPIMockRecorder
+}
+
+// MockMarginRepayServiceAPIMockRecorder is the mock recorder for MockMarginRepayServiceAPI.
+type MockMarginRepayServiceAPIMockRecorder struct {
+	mock *MockMarginRepayServiceAPI
+}
+
+// NewMockMarginRepayServiceAPI creates a new mock instance.
+func NewMockMarginRepayServiceAPI(ctrl *gomock.Controller) *MockMarginRepayServiceAPI {
+	mock := &MockMarginRepayServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockMarginRepayServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockMarginRepayServiceAPI) EXPECT() *MockMarginRepayServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Amount mocks base method.
+func (m *MockMarginRepayServiceAPI) Amount(amount string) portfolio.MarginRepayServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Amount", amount)
+	ret0, _ := ret[0].(portfolio.MarginRepayServiceAPI)
+	return ret0
+}
+
+// Amount indicates an expected call of Amount.
+func (mr *MockMarginRepayServiceAPIMockRecorder) Amount(amount interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Amount", reflect.TypeOf((*MockMarginRepayServiceAPI)(nil).Amount), amount)
+}
+
+// Asset mocks base method.
+func (m *MockMarginRepayServiceAPI) Asset(asset string) portfolio.MarginRepayServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Asset", asset)
+	ret0, _ := ret[0].(portfolio.MarginRepayServiceAPI)
+	return ret0
+}
+
+// Asset indicates an expected call of Asset.
+func (mr *MockMarginRepayServiceAPIMockRecorder) Asset(asset interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Asset", reflect.TypeOf((*MockMarginRepayServiceAPI)(nil).Asset), asset)
+}
+
+// Do mocks base method.
+func (m *MockMarginRepayServiceAPI) Do(ctx context.Context, opts ...portfolio.RequestOption) (*portfolio.MarginRepayResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*portfolio.MarginRepayResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockMarginRepayServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockMarginRepayServiceAPI)(nil).Do), varargs...)
+}
+
+// RecvWindow mocks base method.
+func (m *MockMarginRepayServiceAPI) RecvWindow(recvWindow int64) portfolio.MarginRepayServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecvWindow", recvWindow)
+	ret0, _ := ret[0].(portfolio.MarginRepayServiceAPI)
+	return ret0
+}
+
+// RecvWindow indicates an expected call of RecvWindow.
+func (mr *MockMarginRepayServiceAPIMockRecorder) RecvWindow(recvWindow interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvWindow", reflect.TypeOf((*MockMarginRepayServiceAPI)(nil).RecvWindow), recvWindow)
+}
+
+// MockMarginOCOServiceAPI is a mock of MarginOCOServiceAPI interface.
+type MockMarginOCOServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockMarginOCOServiceAPIMockRecorder
+}
+
+// MockMarginOCOServiceAPIMockRecorder is the mock recorder for MockMarginOCOServiceAPI.
+type MockMarginOCOServiceAPIMockRecorder struct {
+	mock *MockMarginOCOServiceAPI
+}
+
+// NewMockMarginOCOServiceAPI creates a new mock instance.
+func NewMockMarginOCOServiceAPI(ctrl *gomock.Controller) *MockMarginOCOServiceAPI {
+	mock := &MockMarginOCOServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockMarginOCOServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockMarginOCOServiceAPI) EXPECT() *MockMarginOCOServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockMarginOCOServiceAPI) Do(ctx context.Context, opts ...portfolio.RequestOption) (*portfolio.MarginOCOResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*portfolio.MarginOCOResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockMarginOCOServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockMarginOCOServiceAPI)(nil).Do), varargs...)
+}
+
+// LimitClientOrderID mocks base method.
+func (m *MockMarginOCOServiceAPI) LimitClientOrderID(limitClientOrderID string) portfolio.MarginOCOServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LimitClientOrderID", limitClientOrderID)
+	ret0, _ := ret[0].(portfolio.MarginOCOServiceAPI)
+	return ret0
+}
+
+// LimitClientOrderID indicates an expected call of LimitClientOrderID.
+func (mr *MockMarginOCOServiceAPIMockRecorder) LimitClientOrderID(limitClientOrderID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LimitClientOrderID", reflect.TypeOf((*MockMarginOCOServiceAPI)(nil).LimitClientOrderID), limitClientOrderID)
+}
+
+// LimitIcebergQty mocks base method.
+func (m *MockMarginOCOServiceAPI) LimitIcebergQty(limitIcebergQty string) portfolio.MarginOCOServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LimitIcebergQty", limitIcebergQty)
+	ret0, _ := ret[0].(portfolio.MarginOCOServiceAPI)
+	return ret0
+}
+
+// LimitIcebergQty indicates an expected call of LimitIcebergQty.
+func (mr *MockMarginOCOServiceAPIMockRecorder) LimitIcebergQty(limitIcebergQty interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LimitIcebergQty", reflect.TypeOf((*MockMarginOCOServiceAPI)(nil).LimitIcebergQty), limitIcebergQty)
+}
+
+// ListClientOrderID mocks base method.
+func (m *MockMarginOCOServiceAPI) ListClientOrderID(listClientOrderID string) portfolio.MarginOCOServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListClientOrderID", listClientOrderID)
+	ret0, _ := ret[0].(portfolio.MarginOCOServiceAPI)
+	return ret0
+}
+
+// ListClientOrderID indicates an expected call of ListClientOrderID.
+func (mr *MockMarginOCOServiceAPIMockRecorder) ListClientOrderID(listClientOrderID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListClientOrderID", reflect.TypeOf((*MockMarginOCOServiceAPI)(nil).ListClientOrderID), listClientOrderID)
+}
+
+// NewOrderRespType mocks base method.
+func (m *MockMarginOCOServiceAPI) NewOrderRespType(newOrderRespType portfolio.NewOrderRespType) portfolio.MarginOCOServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewOrderRespType", newOrderRespType)
+	ret0, _ := ret[0].(portfolio.MarginOCOServiceAPI)
+	return ret0
+}
+
+// NewOrderRespType indicates an expected call of NewOrderRespType.
+func (mr *MockMarginOCOServiceAPIMockRecorder) NewOrderRespType(newOrderRespType interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewOrderRespType", reflect.TypeOf((*MockMarginOCOServiceAPI)(nil).NewOrderRespType), newOrderRespType)
+}
+
+// Price mocks base method.
+func (m *MockMarginOCOServiceAPI) Price(price string) portfolio.MarginOCOServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Price", price)
+	ret0, _ := ret[0].(portfolio.MarginOCOServiceAPI)
+	return ret0
+}
+
+// Price indicates an expected call of Price.
+func (mr *MockMarginOCOServiceAPIMockRecorder) Price(price interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Price", reflect.TypeOf((*MockMarginOCOServiceAPI)(nil).Price), price)
+}
+
+// Quantity mocks base method.
+func (m *MockMarginOCOServiceAPI) Quantity(quantity string) portfolio.MarginOCOServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Quantity", quantity)
+	ret0, _ := ret[0].(portfolio.MarginOCOServiceAPI)
+	return ret0
+}
+
+// Quantity indicates an expected call of Quantity.
+func (mr *MockMarginOCOServiceAPIMockRecorder) Quantity(quantity interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Quantity", reflect.TypeOf((*MockMarginOCOServiceAPI)(nil).Quantity), quantity)
+}
+
+// RecvWindow mocks base method.
+func (m *MockMarginOCOServiceAPI) RecvWindow(recvWindow int64) portfolio.MarginOCOServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecvWindow", recvWindow)
+	ret0, _ := ret[0].(portfolio.MarginOCOServiceAPI)
+	return ret0
+}
+
+// RecvWindow indicates an expected call of RecvWindow.
+func (mr *MockMarginOCOServiceAPIMockRecorder) RecvWindow(recvWindow interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvWindow", reflect.TypeOf((*MockMarginOCOServiceAPI)(nil).RecvWindow), recvWindow)
+}
+
+// Side mocks base method.
+func (m *MockMarginOCOServiceAPI) Side(side portfolio.SideType) portfolio.MarginOCOServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Side", side)
+	ret0, _ := ret[0].(portfolio.MarginOCOServiceAPI)
+	return ret0
+}
+
+// Side indicates an expected call of Side.
+func (mr *MockMarginOCOServiceAPIMockRecorder) Side(side interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Side", reflect.TypeOf((*MockMarginOCOServiceAPI)(nil).Side), side)
+}
+
+// SideEffectType mocks base method.
+func (m *MockMarginOCOServiceAPI) SideEffectType(sideEffectType portfolio.SideEffectType) portfolio.MarginOCOServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SideEffectType", sideEffectType)
+	ret0, _ := ret[0].(portfolio.MarginOCOServiceAPI)
+	return ret0
+}
+
+// SideEffectType indicates an expected call of SideEffectType.
+func (mr *MockMarginOCOServiceAPIMockRecorder) SideEffectType(sideEffectType interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SideEffectType", reflect.TypeOf((*MockMarginOCOServiceAPI)(nil).SideEffectType), sideEffectType)
+}
+
+// StopClientOrderID mocks base method.
+func (m *MockMarginOCOServiceAPI) StopClientOrderID(stopClientOrderID string) portfolio.MarginOCOServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StopClientOrderID", stopClientOrderID)
+	ret0, _ := ret[0].(portfolio.MarginOCOServiceAPI)
+	return ret0
+}
+
+// StopClientOrderID indicates an expected call of StopClientOrderID.
+func (mr *MockMarginOCOServiceAPIMockRecorder) StopClientOrderID(stopClientOrderID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StopClientOrderID", reflect.TypeOf((*MockMarginOCOServiceAPI)(nil).StopClientOrderID), stopClientOrderID)
+}
+
+// StopIcebergQty mocks base method.
+func (m *MockMarginOCOServiceAPI) StopIcebergQty(stopIcebergQty string) portfolio.MarginOCOServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StopIcebergQty", stopIcebergQty)
+	ret0, _ := ret[0].(portfolio.MarginOCOServiceAPI)
+	return ret0
+}
+
+// StopIcebergQty indicates an expected call of StopIcebergQty.
+func (mr *MockMarginOCOServiceAPIMockRecorder) StopIcebergQty(stopIcebergQty interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StopIcebergQty", reflect.TypeOf((*MockMarginOCOServiceAPI)(nil).StopIcebergQty), stopIcebergQty)
+}
+
+// StopLimitPrice mocks base method.
+func (m *MockMarginOCOServiceAPI) StopLimitPrice(stopLimitPrice string) portfolio.MarginOCOServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StopLimitPrice", stopLimitPrice)
+	ret0, _ := ret[0].(portfolio.MarginOCOServiceAPI)
+	return ret0
+}
+
+// StopLimitPrice indicates an expected call of StopLimitPrice.
+func (mr *MockMarginOCOServiceAPIMockRecorder) StopLimitPrice(stopLimitPrice interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StopLimitPrice", reflect.TypeOf((*MockMarginOCOServiceAPI)(nil).StopLimitPrice), stopLimitPrice)
+}
+
+// StopLimitTimeInForce mocks base method.
+func (m *MockMarginOCOServiceAPI) StopLimitTimeInForce(timeInForce portfolio.TimeInForceType) portfolio.MarginOCOServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StopLimitTimeInForce", timeInForce)
+	ret0, _ := ret[0].(portfolio.MarginOCOServiceAPI)
+	return ret0
+}
+
+// StopLimitTimeInForce indicates an expected call of StopLimitTimeInForce.
+func (mr *MockMarginOCOServiceAPIMockRecorder) StopLimitTimeInForce(timeInForce interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StopLimitTimeInForce", reflect.TypeOf((*MockMarginOCOServiceAPI)(nil).StopLimitTimeInForce), timeInForce)
+}
+
+// StopPrice mocks base method.
+func (m *MockMarginOCOServiceAPI) StopPrice(stopPrice string) portfolio.MarginOCOServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StopPrice", stopPrice)
+	ret0, _ := ret[0].(portfolio.MarginOCOServiceAPI)
+	return ret0
+}
+
+// StopPrice indicates an expected call of StopPrice.
+func (mr *MockMarginOCOServiceAPIMockRecorder) StopPrice(stopPrice interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StopPrice", reflect.TypeOf((*MockMarginOCOServiceAPI)(nil).StopPrice), stopPrice)
+}
+
+// Symbol mocks base method.
+func (m *MockMarginOCOServiceAPI) Symbol(symbol string) portfolio.MarginOCOServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Symbol", symbol)
+	ret0, _ := ret[0].(portfolio.MarginOCOServiceAPI)
+	return ret0
+}
+
+// Symbol indicates an expected call of Symbol.
+func (mr *MockMarginOCOServiceAPIMockRecorder) Symbol(symbol interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Symbol", reflect.TypeOf((*MockMarginOCOServiceAPI)(nil).Symbol), symbol)
+}
+
+// MockUMCancelOrderServiceAPI is a mock of UMCancelOrderServiceAPI interface.
+type MockUMCancelOrderServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockUMCancelOrderServiceAPIMockRecorder
+}
+
+// MockUMCancelOrderServiceAPIMockRecorder is the mock recorder for MockUMCancelOrderServiceAPI.
+type MockUMCancelOrderServiceAPIMockRecorder struct {
+	mock *MockUMCancelOrderServiceAPI
+}
+
+// NewMockUMCancelOrderServiceAPI creates a new mock instance.
+func NewMockUMCancelOrderServiceAPI(ctrl *gomock.Controller) *MockUMCancelOrderServiceAPI {
+	mock := &MockUMCancelOrderServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockUMCancelOrderServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUMCancelOrderServiceAPI) EXPECT() *MockUMCancelOrderServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockUMCancelOrderServiceAPI) Do(ctx context.Context, opts ...portfolio.RequestOption) (*portfolio.UMOrder, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*portfolio.UMOrder)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockUMCancelOrderServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockUMCancelOrderServiceAPI)(nil).Do), varargs...)
+}
+
+// OrderID mocks base method.
+func (m *MockUMCancelOrderServiceAPI) OrderID(orderID int64) portfolio.UMCancelOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OrderID", orderID)
+	ret0, _ := ret[0].(portfolio.UMCancelOrderServiceAPI)
+	return ret0
+}
+
+// OrderID indicates an expected call of OrderID.
+func (mr *MockUMCancelOrderServiceAPIMockRecorder) OrderID(orderID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OrderID", reflect.TypeOf((*MockUMCancelOrderServiceAPI)(nil).OrderID), orderID)
+}
+
+// OrigClientOrderID mocks base method.
+func (m *MockUMCancelOrderServiceAPI) OrigClientOrderID(origClientOrderID string) portfolio.UMCancelOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OrigClientOrderID", origClientOrderID)
+	ret0, _ := ret[0].(portfolio.UMCancelOrderServiceAPI)
+	return ret0
+}
+
+// OrigClientOrderID indicates an expected call of OrigClientOrderID.
+func (mr *MockUMCancelOrderServiceAPIMockRecorder) OrigClientOrderID(origClientOrderID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OrigClientOrderID", reflect.TypeOf((*MockUMCancelOrderServiceAPI)(nil).OrigClientOrderID), origClientOrderID)
+}
+
+// RecvWindow mocks base method.
+func (m *MockUMCancelOrderServiceAPI) RecvWindow(recvWindow int64) portfolio.UMCancelOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecvWindow", recvWindow)
+	ret0, _ := ret[0].(portfolio.UMCancelOrderServiceAPI)
+	return ret0
+}
+
+// RecvWindow indicates an expected call of RecvWindow.
+func (mr *MockUMCancelOrderServiceAPIMockRecorder) RecvWindow(recvWindow interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvWindow", reflect.TypeOf((*MockUMCancelOrderServiceAPI)(nil).RecvWindow), recvWindow)
+}
+
+// Symbol mocks base method.
+func (m *MockUMCancelOrderServiceAPI) Symbol(symbol string) portfolio.UMCancelOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Symbol", symbol)
+	ret0, _ := ret[0].(portfolio.UMCancelOrderServiceAPI)
+	return ret0
+}
+
+// Symbol indicates an expected call of Symbol.
+func (mr *MockUMCancelOrderServiceAPIMockRecorder) Symbol(symbol interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Symbol", reflect.TypeOf((*MockUMCancelOrderServiceAPI)(nil).Symbol), symbol)
+}
+
+// MockUMCancelAllOrdersServiceAPI is a mock of UMCancelAllOrdersServiceAPI interface.
+type MockUMCancelAllOrdersServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockUMCancelAllOrdersServiceAPIMockRecorder
+}
+
+// MockUMCancelAllOrdersServiceAPIMockRecorder is the mock recorder for MockUMCancelAllOrdersServiceAPI.
+type MockUMCancelAllOrdersServiceAPIMockRecorder struct {
+	mock *MockUMCancelAllOrdersServiceAPI
+}
+
+// NewMockUMCancelAllOrdersServiceAPI creates a new mock instance.
+func NewMockUMCancelAllOrdersServiceAPI(ctrl *gomock.Controller) *MockUMCancelAllOrdersServiceAPI {
+	mock := &MockUMCancelAllOrdersServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockUMCancelAllOrdersServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUMCancelAllOrdersServiceAPI) EXPECT() *MockUMCancelAllOrdersServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockUMCancelAllOrdersServiceAPI) Do(ctx context.Context, opts ...portfolio.RequestOption) (*portfolio.UMCancelAllOrdersResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*portfolio.UMCancelAllOrdersResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockUMCancelAllOrdersServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockUMCancelAllOrdersServiceAPI)(nil).Do), varargs...)
+}
+
+// RecvWindow mocks base method.
+func (m *MockUMCancelAllOrdersServiceAPI) RecvWindow(recvWindow int64) portfolio.UMCancelAllOrdersServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecvWindow", recvWindow)
+	ret0, _ := ret[0].(portfolio.UMCancelAllOrdersServiceAPI)
+	return ret0
+}
+
+// RecvWindow indicates an expected call of RecvWindow.
+func (mr *MockUMCancelAllOrdersServiceAPIMockRecorder) RecvWindow(recvWindow interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvWindow", reflect.TypeOf((*MockUMCancelAllOrdersServiceAPI)(nil).RecvWindow), recvWindow)
+}
+
+// Symbol mocks base method.
+func (m *MockUMCancelAllOrdersServiceAPI) Symbol(symbol string) portfolio.UMCancelAllOrdersServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Symbol", symbol)
+	ret0, _ := ret[0].(portfolio.UMCancelAllOrdersServiceAPI)
+	return ret0
+}
+
+// Symbol indicates an expected call of Symbol.
+func (mr *MockUMCancelAllOrdersServiceAPIMockRecorder) Symbol(symbol interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Symbol", reflect.TypeOf((*MockUMCancelAllOrdersServiceAPI)(nil).Symbol), symbol)
+}
+
+// MockUMCancelConditionalOrderServiceAPI is a mock of UMCancelConditionalOrderServiceAPI interface.
+type MockUMCancelConditionalOrderServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockUMCancelConditionalOrderServiceAPIMockRecorder
+}
+
+// MockUMCancelConditionalOrderServiceAPIMockRecorder is the mock recorder for MockUMCancelConditionalOrderServiceAPI.
+type MockUMCancelConditionalOrderServiceAPIMockRecorder struct {
+	mock *MockUMCancelConditionalOrderServiceAPI
+}
+
+// NewMockUMCancelConditionalOrderServiceAPI creates a new mock instance.
+func NewMockUMCancelConditionalOrderServiceAPI(ctrl *gomock.Controller) *MockUMCancelConditionalOrderServiceAPI {
+	mock := &MockUMCancelConditionalOrderServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockUMCancelConditionalOrderServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUMCancelConditionalOrderServiceAPI) EXPECT() *MockUMCancelConditionalOrderServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockUMCancelConditionalOrderServiceAPI) Do(ctx context.Context) (*portfolio.UMCancelConditionalOrderResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Do", ctx)
+	ret0, _ := ret[0].(*portfolio.UMCancelConditionalOrderResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockUMCancelConditionalOrderServiceAPIMockRecorder) Do(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockUMCancelConditionalOrderServiceAPI)(nil).Do), ctx)
+}
+
+// NewClientStrategyID mocks base method.
+func (m *MockUMCancelConditionalOrderServiceAPI) NewClientStrategyID(newClientStrategyID string) portfolio.UMCancelConditionalOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewClientStrategyID", newClientStrategyID)
+	ret0, _ := ret[0].(portfolio.UMCancelConditionalOrderServiceAPI)
+	return ret0
+}
+
+// NewClientStrategyID indicates an expected call of NewClientStrategyID.
+func (mr *MockUMCancelConditionalOrderServiceAPIMockRecorder) NewClientStrategyID(newClientStrategyID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewClientStrategyID", reflect.TypeOf((*MockUMCancelConditionalOrderServiceAPI)(nil).NewClientStrategyID), newClientStrategyID)
+}
+
+// RecvWindow mocks base method.
+func (m *MockUMCancelConditionalOrderServiceAPI) RecvWindow(recvWindow int64) portfolio.UMCancelConditionalOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecvWindow", recvWindow)
+	ret0, _ := ret[0].(portfolio.UMCancelConditionalOrderServiceAPI)
+	return ret0
+}
+
+// RecvWindow indicates an expected call of RecvWindow.
+func (mr *MockUMCancelConditionalOrderServiceAPIMockRecorder) RecvWindow(recvWindow interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvWindow", reflect.TypeOf((*MockUMCancelConditionalOrderServiceAPI)(nil).RecvWindow), recvWindow)
+}
+
+// StrategyID mocks base method.
+func (m *MockUMCancelConditionalOrderServiceAPI) StrategyID(strategyID int64) portfolio.UMCancelConditionalOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StrategyID", strategyID)
+	ret0, _ := ret[0].(portfolio.UMCancelConditionalOrderServiceAPI)
+	return ret0
+}
+
+// StrategyID indicates an expected call of StrategyID.
+func (mr *MockUMCancelConditionalOrderServiceAPIMockRecorder) StrategyID(strategyID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StrategyID", reflect.TypeOf((*MockUMCancelConditionalOrderServiceAPI)(nil).StrategyID), strategyID)
+}
+
+// Symbol mocks base method.
+func (m *MockUMCancelConditionalOrderServiceAPI) Symbol(symbol string) portfolio.UMCancelConditionalOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Symbol", symbol)
+	ret0, _ := ret[0].(portfolio.UMCancelConditionalOrderServiceAPI)
+	return ret0
+}
+
+// Symbol indicates an expected call of Symbol.
+func (mr *MockUMCancelConditionalOrderServiceAPIMockRecorder) Symbol(symbol interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Symbol", reflect.TypeOf((*MockUMCancelConditionalOrderServiceAPI)(nil).Symbol), symbol)
+}
+
+// MockUMCancelAllConditionalOrdersServiceAPI is a mock of UMCancelAllConditionalOrdersServiceAPI interface.
+type MockUMCancelAllConditionalOrdersServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockUMCancelAllConditionalOrdersServiceAPIMockRecorder
+}
+
+// MockUMCancelAllConditionalOrdersServiceAPIMockRecorder is the mock recorder for MockUMCancelAllConditionalOrdersServiceAPI.
+type MockUMCancelAllConditionalOrdersServiceAPIMockRecorder struct {
+	mock *MockUMCancelAllConditionalOrdersServiceAPI
+}
+
+// NewMockUMCancelAllConditionalOrdersServiceAPI creates a new mock instance.
+func NewMockUMCancelAllConditionalOrdersServiceAPI(ctrl *gomock.Controller) *MockUMCancelAllConditionalOrdersServiceAPI {
+	mock := &MockUMCancelAllConditionalOrdersServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockUMCancelAllConditionalOrdersServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUMCancelAllConditionalOrdersServiceAPI) EXPECT() *MockUMCancelAllConditionalOrdersServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockUMCancelAllConditionalOrdersServiceAPI) Do(ctx context.Context) (*portfolio.UMCancelAllConditionalOrdersResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Do", ctx)
+	ret0, _ := ret[0].(*portfolio.UMCancelAllConditionalOrdersResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockUMCancelAllConditionalOrdersServiceAPIMockRecorder) Do(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockUMCancelAllConditionalOrdersServiceAPI)(nil).Do), ctx)
+}
+
+// RecvWindow mocks base method.
+func (m *MockUMCancelAllConditionalOrdersServiceAPI) RecvWindow(recvWindow int64) portfolio.UMCancelAllConditionalOrdersServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecvWindow", recvWindow)
+	ret0, _ := ret[0].(portfolio.UMCancelAllConditionalOrdersServiceAPI)
+	return ret0
+}
+
+// RecvWindow indicates an expected call of RecvWindow.
+func (mr *MockUMCancelAllConditionalOrdersServiceAPIMockRecorder) RecvWindow(recvWindow interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvWindow", reflect.TypeOf((*MockUMCancelAllConditionalOrdersServiceAPI)(nil).RecvWindow), recvWindow)
+}
+
+// Symbol mocks base method.
+func (m *MockUMCancelAllConditionalOrdersServiceAPI) Symbol(symbol string) portfolio.UMCancelAllConditionalOrdersServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Symbol", symbol)
+	ret0, _ := ret[0].(portfolio.UMCancelAllConditionalOrdersServiceAPI)
+	return ret0
+}
+
+// Symbol indicates an expected call of Symbol.
+func (mr *MockUMCancelAllConditionalOrdersServiceAPIMockRecorder) Symbol(symbol interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Symbol", reflect.TypeOf((*MockUMCancelAllConditionalOrdersServiceAPI)(nil).Symbol), symbol)
+}
+
+// MockCMCancelOrderServiceAPI is a mock of CMCancelOrderServiceAPI interface.
+type MockCMCancelOrderServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockCMCancelOrderServiceAPIMockRecorder
+}
+
+// MockCMCancelOrderServiceAPIMockRecorder is the mock recorder for MockCMCancelOrderServiceAPI.
+type MockCMCancelOrderServiceAPIMockRecorder struct {
+	mock *MockCMCancelOrderServiceAPI
+}
+
+// NewMockCMCancelOrderServiceAPI creates a new mock instance.
+func NewMockCMCancelOrderServiceAPI(ctrl *gomock.Controller) *MockCMCancelOrderServiceAPI {
+	mock := &MockCMCancelOrderServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockCMCancelOrderServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCMCancelOrderServiceAPI) EXPECT() *MockCMCancelOrderServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockCMCancelOrderServiceAPI) Do(ctx context.Context) (*portfolio.CMCancelOrderResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Do", ctx)
+	ret0, _ := ret[0].(*portfolio.CMCancelOrderResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockCMCancelOrderServiceAPIMockRecorder) Do(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockCMCancelOrderServiceAPI)(nil).Do), ctx)
+}
+
+// OrderID mocks base method.
+func (m *MockCMCancelOrderServiceAPI) OrderID(orderID int64) portfolio.CMCancelOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OrderID", orderID)
+	ret0, _ := ret[0].(portfolio.CMCancelOrderServiceAPI)
+	return ret0
+}
+
+// OrderID indicates an expected call of OrderID.
+func (mr *MockCMCancelOrderServiceAPIMockRecorder) OrderID(orderID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OrderID", reflect.TypeOf((*MockCMCancelOrderServiceAPI)(nil).OrderID), orderID)
+}
+
+// OrigClientOrderID mocks base method.
+func (m *MockCMCancelOrderServiceAPI) OrigClientOrderID(origClientOrderID string) portfolio.CMCancelOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OrigClientOrderID", origClientOrderID)
+	ret0, _ := ret[0].(portfolio.CMCancelOrderServiceAPI)
+	return ret0
+}
+
+// OrigClientOrderID indicates an expected call of OrigClientOrderID.
+func (mr *MockCMCancelOrderServiceAPIMockRecorder) OrigClientOrderID(origClientOrderID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OrigClientOrderID", reflect.TypeOf((*MockCMCancelOrderServiceAPI)(nil).OrigClientOrderID), origClientOrderID)
+}
+
+// RecvWindow mocks base method.
+func (m *MockCMCancelOrderServiceAPI) RecvWindow(recvWindow int64) portfolio.CMCancelOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecvWindow", recvWindow)
+	ret0, _ := ret[0].(portfolio.CMCancelOrderServiceAPI)
+	return ret0
+}
+
+// RecvWindow indicates an expected call of RecvWindow.
+func (mr *MockCMCancelOrderServiceAPIMockRecorder) RecvWindow(recvWindow interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvWindow", reflect.TypeOf((*MockCMCancelOrderServiceAPI)(nil).RecvWindow), recvWindow)
+}
+
+// Symbol mocks base method.
+func (m *MockCMCancelOrderServiceAPI) Symbol(symbol string) portfolio.CMCancelOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Symbol", symbol)
+	ret0, _ := ret[0].(portfolio.CMCancelOrderServiceAPI)
+	return ret0
+}
+
+// Symbol indicates an expected call of Symbol.
+func (mr *MockCMCancelOrderServiceAPIMockRecorder) Symbol(symbol interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Symbol", reflect.TypeOf((*MockCMCancelOrderServiceAPI)(nil).Symbol), symbol)
+}
+
+// MockCMCancelAllOrdersServiceAPI is a mock of CMCancelAllOrdersServiceAPI interface.
+type MockCMCancelAllOrdersServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockCMCancelAllOrdersServiceAPIMockRecorder
+}
+
+// MockCMCancelAllOrdersServiceAPIMockRecorder is the mock recorder for MockCMCancelAllOrdersServiceAPI.
+type MockCMCancelAllOrdersServiceAPIMockRecorder struct {
+	mock *MockCMCancelAllOrdersServiceAPI
+}
+
+// NewMockCMCancelAllOrdersServiceAPI creates a new mock instance.
+func NewMockCMCancelAllOrdersServiceAPI(ctrl *gomock.Controller) *MockCMCancelAllOrdersServiceAPI {
+	mock := &MockCMCancelAllOrdersServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockCMCancelAllOrdersServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCMCancelAllOrdersServiceAPI) EXPECT() *MockCMCancelAllOrdersServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockCMCancelAllOrdersServiceAPI) Do(ctx context.Context) (*portfolio.CMCancelAllOrdersResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Do", ctx)
+	ret0, _ := ret[0].(*portfolio.CMCancelAllOrdersResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockCMCancelAllOrdersServiceAPIMockRecorder) Do(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockCMCancelAllOrdersServiceAPI)(nil).Do), ctx)
+}
+
+// RecvWindow mocks base method.
+func (m *MockCMCancelAllOrdersServiceAPI) RecvWindow(recvWindow int64) portfolio.CMCancelAllOrdersServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecvWindow", recvWindow)
+	ret0, _ := ret[0].(portfolio.CMCancelAllOrdersServiceAPI)
+	return ret0
+}
+
+// RecvWindow indicates an expected call of RecvWindow.
+func (mr *MockCMCancelAllOrdersServiceAPIMockRecorder) RecvWindow(recvWindow interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvWindow", reflect.TypeOf((*MockCMCancelAllOrdersServiceAPI)(nil).RecvWindow), recvWindow)
+}
+
+// Symbol mocks base method.
+func (m *MockCMCancelAllOrdersServiceAPI) Symbol(symbol string) portfolio.CMCancelAllOrdersServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Symbol", symbol)
+	ret0, _ := ret[0].(portfolio.CMCancelAllOrdersServiceAPI)
+	return ret0
+}
+
+// Symbol indicates an expected call of Symbol.
+func (mr *MockCMCancelAllOrdersServiceAPIMockRecorder) Symbol(symbol interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Symbol", reflect.TypeOf((*MockCMCancelAllOrdersServiceAPI)(nil).Symbol), symbol)
+}
+
+// MockCMCancelConditionalOrderServiceAPI is a mock of CMCancelConditionalOrderServiceAPI interface.
+type MockCMCancelConditionalOrderServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockCMCancelConditionalOrderServiceAPIMockRecorder
+}
+
+// MockCMCancelConditionalOrderServiceAPIMockRecorder is the mock recorder for MockCMCancelConditionalOrderServiceAPI.
+type MockCMCancelConditionalOrderServiceAPIMockRecorder struct {
+	mock *MockCMCancelConditionalOrderServiceAPI
+}
+
+// NewMockCMCancelConditionalOrderServiceAPI creates a new mock instance.
+func NewMockCMCancelConditionalOrderServiceAPI(ctrl *gomock.Controller) *MockCMCancelConditionalOrderServiceAPI {
+	mock := &MockCMCancelConditionalOrderServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockCMCancelConditionalOrderServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCMCancelConditionalOrderServiceAPI) EXPECT() *MockCMCancelConditionalOrderServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockCMCancelConditionalOrderServiceAPI) Do(ctx context.Context) (*portfolio.CMCancelConditionalOrderResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Do", ctx)
+	ret0, _ := ret[0].(*portfolio.CMCancelConditionalOrderResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockCMCancelConditionalOrderServiceAPIMockRecorder) Do(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockCMCancelConditionalOrderServiceAPI)(nil).Do), ctx)
+}
+
+// NewClientStrategyID mocks base method.
+func (m *MockCMCancelConditionalOrderServiceAPI) NewClientStrategyID(newClientStrategyID string) portfolio.CMCancelConditionalOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewClientStrategyID", newClientStrategyID)
+	ret0, _ := ret[0].(portfolio.CMCancelConditionalOrderServiceAPI)
+	return ret0
+}
+
+// NewClientStrategyID indicates an expected call of NewClientStrategyID.
+func (mr *MockCMCancelConditionalOrderServiceAPIMockRecorder) NewClientStrategyID(newClientStrategyID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewClientStrategyID", reflect.TypeOf((*MockCMCancelConditionalOrderServiceAPI)(nil).NewClientStrategyID), newClientStrategyID)
+}
+
+// RecvWindow mocks base method.
+func (m *MockCMCancelConditionalOrderServiceAPI) RecvWindow(recvWindow int64) portfolio.CMCancelConditionalOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecvWindow", recvWindow)
+	ret0, _ := ret[0].(portfolio.CMCancelConditionalOrderServiceAPI)
+	return ret0
+}
+
+// RecvWindow indicates an expected call of RecvWindow.
+func (mr *MockCMCancelConditionalOrderServiceAPIMockRecorder) RecvWindow(recvWindow interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvWindow", reflect.TypeOf((*MockCMCancelConditionalOrderServiceAPI)(nil).RecvWindow), recvWindow)
+}
+
+// StrategyID mocks base method.
+func (m *MockCMCancelConditionalOrderServiceAPI) StrategyID(strategyID int64) portfolio.CMCancelConditionalOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StrategyID", strategyID)
+	ret0, _ := ret[0].(portfolio.CMCancelConditionalOrderServiceAPI)
+	return ret0
+}
+
+// StrategyID indicates an expected call of StrategyID.
+func (mr *MockCMCancelConditionalOrderServiceAPIMockRecorder) StrategyID(strategyID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StrategyID", reflect.TypeOf((*MockCMCancelConditionalOrderServiceAPI)(nil).StrategyID), strategyID)
+}
+
+// Symbol mocks base method.
+func (m *MockCMCancelConditionalOrderServiceAPI) Symbol(symbol string) portfolio.CMCancelConditionalOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Symbol", symbol)
+	ret0, _ := ret[0].(portfolio.CMCancelConditionalOrderServiceAPI)
+	return ret0
+}
+
+// Symbol indicates an expected call of Symbol.
+func (mr *MockCMCancelConditionalOrderServiceAPIMockRecorder) Symbol(symbol interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Symbol", reflect.TypeOf((*MockCMCancelConditionalOrderServiceAPI)(nil).Symbol), symbol)
+}
+
+// MockCMCancelAllConditionalOrdersServiceAPI is a mock of CMCancelAllConditionalOrdersServiceAPI interface.
+type MockCMCancelAllConditionalOrdersServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockCMCancelAllConditionalOrdersServiceAPIMockRecorder
+}
+
+// MockCMCancelAllConditionalOrdersServiceAPIMockRecorder is the mock recorder for MockCMCancelAllConditionalOrdersServiceAPI.
+type MockCMCancelAllConditionalOrdersServiceAPIMockRecorder struct {
+	mock *MockCMCancelAllConditionalOrdersServiceAPI
+}
+
+// NewMockCMCancelAllConditionalOrdersServiceAPI creates a new mock instance.
+func NewMockCMCancelAllConditionalOrdersServiceAPI(ctrl *gomock.Controller) *MockCMCancelAllConditionalOrdersServiceAPI {
+	mock := &MockCMCancelAllConditionalOrdersServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockCMCancelAllConditionalOrdersServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCMCancelAllConditionalOrdersServiceAPI) EXPECT() *MockCMCancelAllConditionalOrdersServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockCMCancelAllConditionalOrdersServiceAPI) Do(ctx context.Context) (*portfolio.CMCancelAllConditionalOrdersResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Do", ctx)
+	ret0, _ := ret[0].(*portfolio.CMCancelAllConditionalOrdersResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockCMCancelAllConditionalOrdersServiceAPIMockRecorder) Do(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockCMCancelAllConditionalOrdersServiceAPI)(nil).Do), ctx)
+}
+
+// RecvWindow mocks base method.
+func (m *MockCMCancelAllConditionalOrdersServiceAPI) RecvWindow(recvWindow int64) portfolio.CMCancelAllConditionalOrdersServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecvWindow", recvWindow)
+	ret0, _ := ret[0].(portfolio.CMCancelAllConditionalOrdersServiceAPI)
+	return ret0
+}
+
+// RecvWindow indicates an expected call of RecvWindow.
+func (mr *MockCMCancelAllConditionalOrdersServiceAPIMockRecorder) RecvWindow(recvWindow interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvWindow", reflect.TypeOf((*MockCMCancelAllConditionalOrdersServiceAPI)(nil).RecvWindow), recvWindow)
+}
+
+// Symbol mocks base method.
+func (m *MockCMCancelAllConditionalOrdersServiceAPI) Symbol(symbol string) portfolio.CMCancelAllConditionalOrdersServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Symbol", symbol)
+	ret0, _ := ret[0].(portfolio.CMCancelAllConditionalOrdersServiceAPI)
+	return ret0
+}
+
+// Symbol indicates an expected call of Symbol.
+func (mr *MockCMCancelAllConditionalOrdersServiceAPIMockRecorder) Symbol(symbol interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Symbol", reflect.TypeOf((*MockCMCancelAllConditionalOrdersServiceAPI)(nil).Symbol), symbol)
+}
+
+// MockMarginCancelOrderServiceAPI is a mock of MarginCancelOrderServiceAPI interface.
+type MockMarginCancelOrderServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockMarginCancelOrderServiceAPIMockRecorder
+}
+
+// MockMarginCancelOrderServiceAPIMockRecorder is the mock recorder for MockMarginCancelOrderServiceAPI.
+type MockMarginCancelOrderServiceAPIMockRecorder struct {
+	mock *MockMarginCancelOrderServiceAPI
+}
+
+// NewMockMarginCancelOrderServiceAPI creates a new mock instance.
+func NewMockMarginCancelOrderServiceAPI(ctrl *gomock.Controller) *MockMarginCancelOrderServiceAPI {
+	mock := &MockMarginCancelOrderServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockMarginCancelOrderServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockMarginCancelOrderServiceAPI) EXPECT() *MockMarginCancelOrderServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockMarginCancelOrderServiceAPI) Do(ctx context.Context) (*portfolio.MarginCancelOrderResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Do", ctx)
+	ret0, _ := ret[0].(*portfolio.MarginCancelOrderResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockMarginCancelOrderServiceAPIMockRecorder) Do(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockMarginCancelOrderServiceAPI)(nil).Do), ctx)
+}
+
+// NewClientOrderID mocks base method.
+func (m *MockMarginCancelOrderServiceAPI) NewClientOrderID(newClientOrderID string) portfolio.MarginCancelOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewClientOrderID", newClientOrderID)
+	ret0, _ := ret[0].(portfolio.MarginCancelOrderServiceAPI)
+	return ret0
+}
+
+// NewClientOrderID indicates an expected call of NewClientOrderID.
+func (mr *MockMarginCancelOrderServiceAPIMockRecorder) NewClientOrderID(newClientOrderID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewClientOrderID", reflect.TypeOf((*MockMarginCancelOrderServiceAPI)(nil).NewClientOrderID), newClientOrderID)
+}
+
+// OrderID mocks base method.
+func (m *MockMarginCancelOrderServiceAPI) OrderID(orderID int64) portfolio.MarginCancelOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OrderID", orderID)
+	ret0, _ := ret[0].(portfolio.MarginCancelOrderServiceAPI)
+	return ret0
+}
+
+// OrderID indicates an expected call of OrderID.
+func (mr *MockMarginCancelOrderServiceAPIMockRecorder) OrderID(orderID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OrderID", reflect.TypeOf((*MockMarginCancelOrderServiceAPI)(nil).OrderID), orderID)
+}
+
+// OrigClientOrderID mocks base method.
+func (m *MockMarginCancelOrderServiceAPI) OrigClientOrderID(origClientOrderID string) portfolio.MarginCancelOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OrigClientOrderID", origClientOrderID)
+	ret0, _ := ret[0].(portfolio.MarginCancelOrderServiceAPI)
+	return ret0
+}
+
+// OrigClientOrderID indicates an expected call of OrigClientOrderID.
+func (mr *MockMarginCancelOrderServiceAPIMockRecorder) OrigClientOrderID(origClientOrderID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OrigClientOrderID", reflect.TypeOf((*MockMarginCancelOrderServiceAPI)(nil).OrigClientOrderID), origClientOrderID)
+}
+
+// RecvWindow mocks base method.
+func (m *MockMarginCancelOrderServiceAPI) RecvWindow(recvWindow int64) portfolio.MarginCancelOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecvWindow", recvWindow)
+	ret0, _ := ret[0].(portfolio.MarginCancelOrderServiceAPI)
+	return ret0
+}
+
+// RecvWindow indicates an expected call of RecvWindow.
+func (mr *MockMarginCancelOrderServiceAPIMockRecorder) RecvWindow(recvWindow interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvWindow", reflect.TypeOf((*MockMarginCancelOrderServiceAPI)(nil).RecvWindow), recvWindow)
+}
+
+// Symbol mocks base method.
+func (m *MockMarginCancelOrderServiceAPI) Symbol(symbol string) portfolio.MarginCancelOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Symbol", symbol)
+	ret0, _ := ret[0].(portfolio.MarginCancelOrderServiceAPI)
+	return ret0
+}
+
+// Symbol indicates an expected call of Symbol.
+func (mr *MockMarginCancelOrderServiceAPIMockRecorder) Symbol(symbol interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Symbol", reflect.TypeOf((*MockMarginCancelOrderServiceAPI)(nil).Symbol), symbol)
+}
+
+// MockMarginCancelOCOServiceAPI is a mock of MarginCancelOCOServiceAPI interface.
+type MockMarginCancelOCOServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockMarginCancelOCOServiceAPIMockRecorder
+}
+
+// MockMarginCancelOCOServiceAPIMockRecorder is the mock recorder for MockMarginCancelOCOServiceAPI.
+type MockMarginCancelOCOServiceAPIMockRecorder struct {
+	mock *MockMarginCancelOCOServiceAPI
+}
+
+// NewMockMarginCancelOCOServiceAPI creates a new mock instance.
+func NewMockMarginCancelOCOServiceAPI(ctrl *gomock.Controller) *MockMarginCancelOCOServiceAPI {
+	mock := &MockMarginCancelOCOServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockMarginCancelOCOServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockMarginCancelOCOServiceAPI) EXPECT() *MockMarginCancelOCOServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockMarginCancelOCOServiceAPI) Do(ctx context.Context) (*portfolio.MarginCancelOCOResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Do", ctx)
+	ret0, _ := ret[0].(*portfolio.MarginCancelOCOResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockMarginCancelOCOServiceAPIMockRecorder) Do(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockMarginCancelOCOServiceAPI)(nil).Do), ctx)
+}
+
+// ListClientOrderID mocks base method.
+func (m *MockMarginCancelOCOServiceAPI) ListClientOrderID(listClientOrderID string) portfolio.MarginCancelOCOServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListClientOrderID", listClientOrderID)
+	ret0, _ := ret[0].(portfolio.MarginCancelOCOServiceAPI)
+	return ret0
+}
+
+// ListClientOrderID indicates an expected call of ListClientOrderID.
+func (mr *MockMarginCancelOCOServiceAPIMockRecorder) ListClientOrderID(listClientOrderID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListClientOrderID", reflect.TypeOf((*MockMarginCancelOCOServiceAPI)(nil).ListClientOrderID), listClientOrderID)
+}
+
+// NewClientOrderID mocks base method.
+func (m *MockMarginCancelOCOServiceAPI) NewClientOrderID(newClientOrderID string) portfolio.MarginCancelOCOServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewClientOrderID", newClientOrderID)
+	ret0, _ := ret[0].(portfolio.MarginCancelOCOServiceAPI)
+	return ret0
+}
+
+// NewClientOrderID indicates an expected call of NewClientOrderID.
+func (mr *MockMarginCancelOCOServiceAPIMockRecorder) NewClientOrderID(newClientOrderID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewClientOrderID", reflect.TypeOf((*MockMarginCancelOCOServiceAPI)(nil).NewClientOrderID), newClientOrderID)
+}
+
+// OrderListID mocks base method.
+func (m *MockMarginCancelOCOServiceAPI) OrderListID(orderListID int64) portfolio.MarginCancelOCOServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OrderListID", orderListID)
+	ret0, _ := ret[0].(portfolio.MarginCancelOCOServiceAPI)
+	return ret0
+}
+
+// OrderListID indicates an expected call of OrderListID.
+func (mr *MockMarginCancelOCOServiceAPIMockRecorder) OrderListID(orderListID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OrderListID", reflect.TypeOf((*MockMarginCancelOCOServiceAPI)(nil).OrderListID), orderListID)
+}
+
+// RecvWindow mocks base method.
+func (m *MockMarginCancelOCOServiceAPI) RecvWindow(recvWindow int64) portfolio.MarginCancelOCOServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecvWindow", recvWindow)
+	ret0, _ := ret[0].(portfolio.MarginCancelOCOServiceAPI)
+	return ret0
+}
+
+// RecvWindow indicates an expected call of RecvWindow.
+func (mr *MockMarginCancelOCOServiceAPIMockRecorder) RecvWindow(recvWindow interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvWindow", reflect.TypeOf((*MockMarginCancelOCOServiceAPI)(nil).RecvWindow), recvWindow)
+}
+
+// Symbol mocks base method.
+func (m *MockMarginCancelOCOServiceAPI) Symbol(symbol string) portfolio.MarginCancelOCOServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Symbol", symbol)
+	ret0, _ := ret[0].(portfolio.MarginCancelOCOServiceAPI)
+	return ret0
+}
+
+// Symbol indicates an expected call of Symbol.
+func (mr *MockMarginCancelOCOServiceAPIMockRecorder) Symbol(symbol interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Symbol", reflect.TypeOf((*MockMarginCancelOCOServiceAPI)(nil).Symbol), symbol)
+}
+
+// MockUMModifyOrderServiceAPI is a mock of UMModifyOrderServiceAPI interface.
+type MockUMModifyOrderServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockUMModifyOrderServiceAPIMockRecorder
+}
+
+// MockUMModifyOrderServiceAPIMockRecorder is the mock recorder for MockUMModifyOrderServiceAPI.
+type MockUMModifyOrderServiceAPIMockRecorder struct {
+	mock *MockUMModifyOrderServiceAPI
+}
+
+// NewMockUMModifyOrderServiceAPI creates a new mock instance.
+func NewMockUMModifyOrderServiceAPI(ctrl *gomock.Controller) *MockUMModifyOrderServiceAPI {
+	mock := &MockUMModifyOrderServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockUMModifyOrderServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUMModifyOrderServiceAPI) EXPECT() *MockUMModifyOrderServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockUMModifyOrderServiceAPI) Do(ctx context.Context) (*portfolio.UMModifyOrderResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Do", ctx)
+	ret0, _ := ret[0].(*portfolio.UMModifyOrderResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockUMModifyOrderServiceAPIMockRecorder) Do(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockUMModifyOrderServiceAPI)(nil).Do), ctx)
+}
+
+// OrderID mocks base method.
+func (m *MockUMModifyOrderServiceAPI) OrderID(orderID int64) portfolio.UMModifyOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OrderID", orderID)
+	ret0, _ := ret[0].(portfolio.UMModifyOrderServiceAPI)
+	return ret0
+}
+
+// OrderID indicates an expected call of OrderID.
+func (mr *MockUMModifyOrderServiceAPIMockRecorder) OrderID(orderID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OrderID", reflect.TypeOf((*MockUMModifyOrderServiceAPI)(nil).OrderID), orderID)
+}
+
+// OrigClientOrderID mocks base method.
+func (m *MockUMModifyOrderServiceAPI) OrigClientOrderID(origClientOrderID string) portfolio.UMModifyOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OrigClientOrderID", origClientOrderID)
+	ret0, _ := ret[0].(portfolio.UMModifyOrderServiceAPI)
+	return ret0
+}
+
+// OrigClientOrderID indicates an expected call of OrigClientOrderID.
+func (mr *MockUMModifyOrderServiceAPIMockRecorder) OrigClientOrderID(origClientOrderID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OrigClientOrderID", reflect.TypeOf((*MockUMModifyOrderServiceAPI)(nil).OrigClientOrderID), origClientOrderID)
+}
+
+// Price mocks base method.
+func (m *MockUMModifyOrderServiceAPI) Price(price string) portfolio.UMModifyOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Price", price)
+	ret0, _ := ret[0].(portfolio.UMModifyOrderServiceAPI)
+	return ret0
+}
+
+// Price indicates an expected call of Price.
+func (mr *MockUMModifyOrderServiceAPIMockRecorder) Price(price interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Price", reflect.TypeOf((*MockUMModifyOrderServiceAPI)(nil).Price), price)
+}
+
+// PriceMatch mocks base method.
+func (m *MockUMModifyOrderServiceAPI) PriceMatch(priceMatch string) portfolio.UMModifyOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PriceMatch", priceMatch)
+	ret0, _ := ret[0].(portfolio.UMModifyOrderServiceAPI)
+	return ret0
+}
+
+// PriceMatch indicates an expected call of PriceMatch.
+func (mr *MockUMModifyOrderServiceAPIMockRecorder) PriceMatch(priceMatch interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PriceMatch", reflect.TypeOf((*MockUMModifyOrderServiceAPI)(nil).PriceMatch), priceMatch)
+}
+
+// Quantity mocks base method.
+func (m *MockUMModifyOrderServiceAPI) Quantity(quantity string) portfolio.UMModifyOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Quantity", quantity)
+	ret0, _ := ret[0].(portfolio.UMModifyOrderServiceAPI)
+	return ret0
+}
+
+// Quantity indicates an expected call of Quantity.
+func (mr *MockUMModifyOrderServiceAPIMockRecorder) Quantity(quantity interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Quantity", reflect.TypeOf((*MockUMModifyOrderServiceAPI)(nil).Quantity), quantity)
+}
+
+// RecvWindow mocks base method.
+func (m *MockUMModifyOrderServiceAPI) RecvWindow(recvWindow int64) portfolio.UMModifyOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecvWindow", recvWindow)
+	ret0, _ := ret[0].(portfolio.UMModifyOrderServiceAPI)
+	return ret0
+}
+
+// RecvWindow indicates an expected call of RecvWindow.
+func (mr *MockUMModifyOrderServiceAPIMockRecorder) RecvWindow(recvWindow interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvWindow", reflect.TypeOf((*MockUMModifyOrderServiceAPI)(nil).RecvWindow), recvWindow)
+}
+
+// Side mocks base method.
+func (m *MockUMModifyOrderServiceAPI) Side(side portfolio.SideType) portfolio.UMModifyOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Side", side)
+	ret0, _ := ret[0].(portfolio.UMModifyOrderServiceAPI)
+	return ret0
+}
+
+// Side indicates an expected call of Side.
+func (mr *MockUMModifyOrderServiceAPIMockRecorder) Side(side interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Side", reflect.TypeOf((*MockUMModifyOrderServiceAPI)(nil).Side), side)
+}
+
+// Symbol mocks base method.
+func (m *MockUMModifyOrderServiceAPI) Symbol(symbol string) portfolio.UMModifyOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Symbol", symbol)
+	ret0, _ := ret[0].(portfolio.UMModifyOrderServiceAPI)
+	return ret0
+}
+
+// Symbol indicates an expected call of Symbol.
+func (mr *MockUMModifyOrderServiceAPIMockRecorder) Symbol(symbol interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Symbol", reflect.TypeOf((*MockUMModifyOrderServiceAPI)(nil).Symbol), symbol)
+}
+
+// MockMarginCancelAllOrdersServiceAPI is a mock of MarginCancelAllOrdersServiceAPI interface.
+type MockMarginCancelAllOrdersServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockMarginCancelAllOrdersServiceAPIMockRecorder
+}
+
+// MockMarginCancelAllOrdersServiceAPIMockRecorder is the mock recorder for MockMarginCancelAllOrdersServiceAPI.
+type MockMarginCancelAllOrdersServiceAPIMockRecorder struct {
+	mock *MockMarginCancelAllOrdersServiceAPI
+}
+
+// NewMockMarginCancelAllOrdersServiceAPI creates a new mock instance.
+func NewMockMarginCancelAllOrdersServiceAPI(ctrl *gomock.Controller) *MockMarginCancelAllOrdersServiceAPI {
+	mock := &MockMarginCancelAllOrdersServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockMarginCancelAllOrdersServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockMarginCancelAllOrdersServiceAPI) EXPECT() *MockMarginCancelAllOrdersServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockMarginCancelAllOrdersServiceAPI) Do(ctx context.Context) ([]*portfolio.MarginCancelAllOrdersResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Do", ctx)
+	ret0, _ := ret[0].([]*portfolio.MarginCancelAllOrdersResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockMarginCancelAllOrdersServiceAPIMockRecorder) Do(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockMarginCancelAllOrdersServiceAPI)(nil).Do), ctx)
+}
+
+// RecvWindow mocks base method.
+func (m *MockMarginCancelAllOrdersServiceAPI) RecvWindow(recvWindow int64) portfolio.MarginCancelAllOrdersServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecvWindow", recvWindow)
+	ret0, _ := ret[0].(portfolio.MarginCancelAllOrdersServiceAPI)
+	return ret0
+}
+
+// RecvWindow indicates an expected call of RecvWindow.
+func (mr *MockMarginCancelAllOrdersServiceAPIMockRecorder) RecvWindow(recvWindow interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvWindow", reflect.TypeOf((*MockMarginCancelAllOrdersServiceAPI)(nil).RecvWindow), recvWindow)
+}
+
+// Symbol mocks base method.
+func (m *MockMarginCancelAllOrdersServiceAPI) Symbol(symbol string) portfolio.MarginCancelAllOrdersServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Symbol", symbol)
+	ret0, _ := ret[0].(portfolio.MarginCancelAllOrdersServiceAPI)
+	return ret0
+}
+
+// Symbol indicates an expected call of Symbol.
+func (mr *MockMarginCancelAllOrdersServiceAPIMockRecorder) Symbol(symbol interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Symbol", reflect.TypeOf((*MockMarginCancelAllOrdersServiceAPI)(nil).Symbol), symbol)
+}
+
+// MockCMModifyOrderServiceAPI is a mock of CMModifyOrderServiceAPI interface.
+type MockCMModifyOrderServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockCMModifyOrderServiceAPIMockRecorder
+}
+
+// MockCMModifyOrderServiceAPIMockRecorder is the mock recorder for MockCMModifyOrderServiceAPI.
+type MockCMModifyOrderServiceAPIMockRecorder struct {
+	mock *MockCMModifyOrderServiceAPI
+}
+
+// NewMockCMModifyOrderServiceAPI creates a new mock instance.
+func NewMockCMModifyOrderServiceAPI(ctrl *gomock.Controller) *MockCMModifyOrderServiceAPI {
+	mock := &MockCMModifyOrderServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockCMModifyOrderServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCMModifyOrderServiceAPI) EXPECT() *MockCMModifyOrderServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockCMModifyOrderServiceAPI) Do(ctx context.Context) (*portfolio.CMModifyOrderResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Do", ctx)
+	ret0, _ := ret[0].(*portfolio.CMModifyOrderResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockCMModifyOrderServiceAPIMockRecorder) Do(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockCMModifyOrderServiceAPI)(nil).Do), ctx)
+}
+
+// OrderID mocks base method.
+func (m *MockCMModifyOrderServiceAPI) OrderID(orderID int64) portfolio.CMModifyOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OrderID", orderID)
+	ret0, _ := ret[0].(portfolio.CMModifyOrderServiceAPI)
+	return ret0
+}
+
+// OrderID indicates an expected call of OrderID.
+func (mr *MockCMModifyOrderServiceAPIMockRecorder) OrderID(orderID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OrderID", reflect.TypeOf((*MockCMModifyOrderServiceAPI)(nil).OrderID), orderID)
+}
+
+// OrigClientOrderID mocks base method.
+func (m *MockCMModifyOrderServiceAPI) OrigClientOrderID(origClientOrderID string) portfolio.CMModifyOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OrigClientOrderID", origClientOrderID)
+	ret0, _ := ret[0].(portfolio.CMModifyOrderServiceAPI)
+	return ret0
+}
+
+// OrigClientOrderID indicates an expected call of OrigClientOrderID.
+func (mr *MockCMModifyOrderServiceAPIMockRecorder) OrigClientOrderID(origClientOrderID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OrigClientOrderID", reflect.TypeOf((*MockCMModifyOrderServiceAPI)(nil).OrigClientOrderID), origClientOrderID)
+}
+
+// Price mocks base method.
+func (m *MockCMModifyOrderServiceAPI) Price(price string) portfolio.CMModifyOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Price", price)
+	ret0, _ := ret[0].(portfolio.CMModifyOrderServiceAPI)
+	return ret0
+}
+
+// Price indicates an expected call of Price.
+func (mr *MockCMModifyOrderServiceAPIMockRecorder) Price(price interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Price", reflect.TypeOf((*MockCMModifyOrderServiceAPI)(nil).Price), price)
+}
+
+// Quantity mocks base method.
+func (m *MockCMModifyOrderServiceAPI) Quantity(quantity string) portfolio.CMModifyOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Quantity", quantity)
+	ret0, _ := ret[0].(portfolio.CMModifyOrderServiceAPI)
+	return ret0
+}
+
+// Quantity indicates an expected call of Quantity.
+func (mr *MockCMModifyOrderServiceAPIMockRecorder) Quantity(quantity interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Quantity", reflect.TypeOf((*MockCMModifyOrderServiceAPI)(nil).Quantity), quantity)
+}
+
+// RecvWindow mocks base method.
+func (m *MockCMModifyOrderServiceAPI) RecvWindow(recvWindow int64) portfolio.CMModifyOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecvWindow", recvWindow)
+	ret0, _ := ret[0].(portfolio.CMModifyOrderServiceAPI)
+	return ret0
+}
+
+// RecvWindow indicates an expected call of RecvWindow.
+func (mr *MockCMModifyOrderServiceAPIMockRecorder) RecvWindow(recvWindow interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvWindow", reflect.TypeOf((*MockCMModifyOrderServiceAPI)(nil).RecvWindow), recvWindow)
+}
+
+// Side mocks base method.
+func (m *MockCMModifyOrderServiceAPI) Side(side portfolio.SideType) portfolio.CMModifyOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Side", side)
+	ret0, _ := ret[0].(portfolio.CMModifyOrderServiceAPI)
+	return ret0
+}
+
+// Side indicates an expected call of Side.
+func (mr *MockCMModifyOrderServiceAPIMockRecorder) Side(side interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Side", reflect.TypeOf((*MockCMModifyOrderServiceAPI)(nil).Side), side)
+}
+
+// Symbol mocks base method.
+func (m *MockCMModifyOrderServiceAPI) Symbol(symbol string) portfolio.CMModifyOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Symbol", symbol)
+	ret0, _ := ret[0].(portfolio.CMModifyOrderServiceAPI)
+	return ret0
+}
+
+// Symbol indicates an expected call of Symbol.
+func (mr *MockCMModifyOrderServiceAPIMockRecorder) Symbol(symbol interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Symbol", reflect.TypeOf((*MockCMModifyOrderServiceAPI)(nil).Symbol), symbol)
+}
+
+// MockUMQueryOrderServiceAPI is a mock of UMQueryOrderServiceAPI interface.
+type MockUMQueryOrderServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockUMQueryOrderServiceAPIMockRecorder
+}
+
+// MockUMQueryOrderServiceAPIMockRecorder is the mock recorder for MockUMQueryOrderServiceAPI.
+type MockUMQueryOrderServiceAPIMockRecorder struct {
+	mock *MockUMQueryOrderServiceAPI
+}
+
+// NewMockUMQueryOrderServiceAPI creates a new mock instance.
+func NewMockUMQueryOrderServiceAPI(ctrl *gomock.Controller) *MockUMQueryOrderServiceAPI {
+	mock := &MockUMQueryOrderServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockUMQueryOrderServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUMQueryOrderServiceAPI) EXPECT() *MockUMQueryOrderServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockUMQueryOrderServiceAPI) Do(ctx context.Context) (*portfolio.UMQueryOrderResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Do", ctx)
+	ret0, _ := ret[0].(*portfolio.UMQueryOrderResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockUMQueryOrderServiceAPIMockRecorder) Do(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockUMQueryOrderServiceAPI)(nil).Do), ctx)
+}
+
+// OrderID mocks base method.
+func (m *MockUMQueryOrderServiceAPI) OrderID(orderID int64) portfolio.UMQueryOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OrderID", orderID)
+	ret0, _ := ret[0].(portfolio.UMQueryOrderServiceAPI)
+	return ret0
+}
+
+// OrderID indicates an expected call of OrderID.
+func (mr *MockUMQueryOrderServiceAPIMockRecorder) OrderID(orderID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OrderID", reflect.TypeOf((*MockUMQueryOrderServiceAPI)(nil).OrderID), orderID)
+}
+
+// OrigClientOrderID mocks base method.
+func (m *MockUMQueryOrderServiceAPI) OrigClientOrderID(origClientOrderID string) portfolio.UMQueryOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OrigClientOrderID", origClientOrderID)
+	ret0, _ := ret[0].(portfolio.UMQueryOrderServiceAPI)
+	return ret0
+}
+
+// OrigClientOrderID indicates an expected call of OrigClientOrderID.
+func (mr *MockUMQueryOrderServiceAPIMockRecorder) OrigClientOrderID(origClientOrderID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OrigClientOrderID", reflect.TypeOf((*MockUMQueryOrderServiceAPI)(nil).OrigClientOrderID), origClientOrderID)
+}
+
+// RecvWindow mocks base method.
+func (m *MockUMQueryOrderServiceAPI) RecvWindow(recvWindow int64) portfolio.UMQueryOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecvWindow", recvWindow)
+	ret0, _ := ret[0].(portfolio.UMQueryOrderServiceAPI)
+	return ret0
+}
+
+// RecvWindow indicates an expected call of RecvWindow.
+func (mr *MockUMQueryOrderServiceAPIMockRecorder) RecvWindow(recvWindow interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvWindow", reflect.TypeOf((*MockUMQueryOrderServiceAPI)(nil).RecvWindow), recvWindow)
+}
+
+// Symbol mocks base method.
+func (m *MockUMQueryOrderServiceAPI) Symbol(symbol string) portfolio.UMQueryOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Symbol", symbol)
+	ret0, _ := ret[0].(portfolio.UMQueryOrderServiceAPI)
+	return ret0
+}
+
+// Symbol indicates an expected call of Symbol.
+func (mr *MockUMQueryOrderServiceAPIMockRecorder) Symbol(symbol interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Symbol", reflect.TypeOf((*MockUMQueryOrderServiceAPI)(nil).Symbol), symbol)
+}
+
+// MockUMAllOrdersServiceAPI is a mock of UMAllOrdersServiceAPI interface.
+type MockUMAllOrdersServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockUMAllOrdersServiceAPIMockRecorder
+}
+
+// MockUMAllOrdersServiceAPIMockRecorder is the mock recorder for MockUMAllOrdersServiceAPI.
+type MockUMAllOrdersServiceAPIMockRecorder struct {
+	mock *MockUMAllOrdersServiceAPI
+}
+
+// NewMockUMAllOrdersServiceAPI creates a new mock instance.
+func NewMockUMAllOrdersServiceAPI(ctrl *gomock.Controller) *MockUMAllOrdersServiceAPI {
+	mock := &MockUMAllOrdersServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockUMAllOrdersServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUMAllOrdersServiceAPI) EXPECT() *MockUMAllOrdersServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockUMAllOrdersServiceAPI) Do(ctx context.Context) ([]*portfolio.UMAllOrdersResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Do", ctx)
+	ret0, _ := ret[0].([]*portfolio.UMAllOrdersResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockUMAllOrdersServiceAPIMockRecorder) Do(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockUMAllOrdersServiceAPI)(nil).Do), ctx)
+}
+
+// EndTime mocks base method.
+func (m *MockUMAllOrdersServiceAPI) EndTime(endTime int64) portfolio.UMAllOrdersServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EndTime", endTime)
+	ret0, _ := ret[0].(portfolio.UMAllOrdersServiceAPI)
+	return ret0
+}
+
+// EndTime indicates an expected call of EndTime.
+func (mr *MockUMAllOrdersServiceAPIMockRecorder) EndTime(endTime interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EndTime", reflect.TypeOf((*MockUMAllOrdersServiceAPI)(nil).EndTime), endTime)
+}
+
+// Limit mocks base method.
+func (m *MockUMAllOrdersServiceAPI) Limit(limit int) portfolio.UMAllOrdersServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Limit", limit)
+	ret0, _ := ret[0].(portfolio.UMAllOrdersServiceAPI)
+	return ret0
+}
+
+// Limit indicates an expected call of Limit.
+func (mr *MockUMAllOrdersServiceAPIMockRecorder) Limit(limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Limit", reflect.TypeOf((*MockUMAllOrdersServiceAPI)(nil).Limit), limit)
+}
+
+// OrderID mocks base method.
+func (m *MockUMAllOrdersServiceAPI) OrderID(orderID int64) portfolio.UMAllOrdersServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OrderID", orderID)
+	ret0, _ := ret[0].(portfolio.UMAllOrdersServiceAPI)
+	return ret0
+}
+
+// OrderID indicates an expected call of OrderID.
+func (mr *MockUMAllOrdersServiceAPIMockRecorder) OrderID(orderID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OrderID", reflect.TypeOf((*MockUMAllOrdersServiceAPI)(nil).OrderID), orderID)
+}
+
+// RecvWindow mocks base method.
+func (m *MockUMAllOrdersServiceAPI) RecvWindow(recvWindow int64) portfolio.UMAllOrdersServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecvWindow", recvWindow)
+	ret0, _ := ret[0].(portfolio.UMAllOrdersServiceAPI)
+	return ret0
+}
+
+// RecvWindow indicates an expected call of RecvWindow.
+func (mr *MockUMAllOrdersServiceAPIMockRecorder) RecvWindow(recvWindow interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvWindow", reflect.TypeOf((*MockUMAllOrdersServiceAPI)(nil).RecvWindow), recvWindow)
+}
+
+// StartTime mocks base method.
+func (m *MockUMAllOrdersServiceAPI) StartTime(startTime int64) portfolio.UMAllOrdersServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartTime", startTime)
+	ret0, _ := ret[0].(portfolio.UMAllOrdersServiceAPI)
+	return ret0
+}
+
+// StartTime indicates an expected call of StartTime.
+func (mr *MockUMAllOrdersServiceAPIMockRecorder) StartTime(startTime interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartTime", reflect.TypeOf((*MockUMAllOrdersServiceAPI)(nil).StartTime), startTime)
+}
+
+// Symbol mocks base method.
+func (m *MockUMAllOrdersServiceAPI) Symbol(symbol string) portfolio.UMAllOrdersServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Symbol", symbol)
+	ret0, _ := ret[0].(portfolio.UMAllOrdersServiceAPI)
+	return ret0
+}
+
+// Symbol indicates an expected call of Symbol.
+func (mr *MockUMAllOrdersServiceAPIMockRecorder) Symbol(symbol interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Symbol", reflect.TypeOf((*MockUMAllOrdersServiceAPI)(nil).Symbol), symbol)
+}
+
+// MockUMOpenOrderServiceAPI is a mock of UMOpenOrderServiceAPI interface.
+type MockUMOpenOrderServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockUMOpenOrderServiceAPIMockRecorder
+}
+
+// MockUMOpenOrderServiceAPIMockRecorder is the mock recorder for MockUMOpenOrderServiceAPI.
+type MockUMOpenOrderServiceAPIMockRecorder struct {
+	mock *MockUMOpenOrderServiceAPI
+}
+
+// NewMockUMOpenOrderServiceAPI creates a new mock instance.
+func NewMockUMOpenOrderServiceAPI(ctrl *gomock.Controller) *MockUMOpenOrderServiceAPI {
+	mock := &MockUMOpenOrderServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockUMOpenOrderServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUMOpenOrderServiceAPI) EXPECT() *MockUMOpenOrderServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockUMOpenOrderServiceAPI) Do(ctx context.Context) (*portfolio.UMOpenOrderResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Do", ctx)
+	ret0, _ := ret[0].(*portfolio.UMOpenOrderResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockUMOpenOrderServiceAPIMockRecorder) Do(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockUMOpenOrderServiceAPI)(nil).Do), ctx)
+}
+
+// OrderID mocks base method.
+func (m *MockUMOpenOrderServiceAPI) OrderID(orderID int64) portfolio.UMOpenOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OrderID", orderID)
+	ret0, _ := ret[0].(portfolio.UMOpenOrderServiceAPI)
+	return ret0
+}
+
+// OrderID indicates an expected call of OrderID.
+func (mr *MockUMOpenOrderServiceAPIMockRecorder) OrderID(orderID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OrderID", reflect.TypeOf((*MockUMOpenOrderServiceAPI)(nil).OrderID), orderID)
+}
+
+// OrigClientOrderID mocks base method.
+func (m *MockUMOpenOrderServiceAPI) OrigClientOrderID(origClientOrderID string) portfolio.UMOpenOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OrigClientOrderID", origClientOrderID)
+	ret0, _ := ret[0].(portfolio.UMOpenOrderServiceAPI)
+	return ret0
+}
+
+// OrigClientOrderID indicates an expected call of OrigClientOrderID.
+func (mr *MockUMOpenOrderServiceAPIMockRecorder) OrigClientOrderID(origClientOrderID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OrigClientOrderID", reflect.TypeOf((*MockUMOpenOrderServiceAPI)(nil).OrigClientOrderID), origClientOrderID)
+}
+
+// RecvWindow mocks base method.
+func (m *MockUMOpenOrderServiceAPI) RecvWindow(recvWindow int64) portfolio.UMOpenOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecvWindow", recvWindow)
+	ret0, _ := ret[0].(portfolio.UMOpenOrderServiceAPI)
+	return ret0
+}
+
+// RecvWindow indicates an expected call of RecvWindow.
+func (mr *MockUMOpenOrderServiceAPIMockRecorder) RecvWindow(recvWindow interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvWindow", reflect.TypeOf((*MockUMOpenOrderServiceAPI)(nil).RecvWindow), recvWindow)
+}
+
+// Symbol mocks base method.
+func (m *MockUMOpenOrderServiceAPI) Symbol(symbol string) portfolio.UMOpenOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Symbol", symbol)
+	ret0, _ := ret[0].(portfolio.UMOpenOrderServiceAPI)
+	return ret0
+}
+
+// Symbol indicates an expected call of Symbol.
+func (mr *MockUMOpenOrderServiceAPIMockRecorder) Symbol(symbol interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Symbol", reflect.TypeOf((*MockUMOpenOrderServiceAPI)(nil).Symbol), symbol)
+}
+
+// MockUMOpenOrdersServiceAPI is a mock of UMOpenOrdersServiceAPI interface.
+type MockUMOpenOrdersServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockUMOpenOrdersServiceAPIMockRecorder
+}
+
+// MockUMOpenOrdersServiceAPIMockRecorder is the mock recorder for MockUMOpenOrdersServiceAPI.
+type MockUMOpenOrdersServiceAPIMockRecorder struct {
+	mock *MockUMOpenOrdersServiceAPI
+}
+
+// NewMockUMOpenOrdersServiceAPI creates a new mock instance.
+func NewMockUMOpenOrdersServiceAPI(ctrl *gomock.Controller) *MockUMOpenOrdersServiceAPI {
+	mock := &MockUMOpenOrdersServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockUMOpenOrdersServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUMOpenOrdersServiceAPI) EXPECT() *MockUMOpenOrdersServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockUMOpenOrdersServiceAPI) Do(ctx context.Context) ([]*portfolio.UMOpenOrdersResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Do", ctx)
+	ret0, _ := ret[0].([]*portfolio.UMOpenOrdersResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockUMOpenOrdersServiceAPIMockRecorder) Do(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockUMOpenOrdersServiceAPI)(nil).Do), ctx)
+}
+
+// RecvWindow mocks base method.
+func (m *MockUMOpenOrdersServiceAPI) RecvWindow(recvWindow int64) portfolio.UMOpenOrdersServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecvWindow", recvWindow)
+	ret0, _ := ret[0].(portfolio.UMOpenOrdersServiceAPI)
+	return ret0
+}
+
+// RecvWindow indicates an expected call of RecvWindow.
+func (mr *MockUMOpenOrdersServiceAPIMockRecorder) RecvWindow(recvWindow interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvWindow", reflect.TypeOf((*MockUMOpenOrdersServiceAPI)(nil).RecvWindow), recvWindow)
+}
+
+// Symbol mocks base method.
+func (m *MockUMOpenOrdersServiceAPI) Symbol(symbol string) portfolio.UMOpenOrdersServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Symbol", symbol)
+	ret0, _ := ret[0].(portfolio.UMOpenOrdersServiceAPI)
+	return ret0
+}
+
+// Symbol indicates an expected call of Symbol.
+func (mr *MockUMOpenOrdersServiceAPIMockRecorder) Symbol(symbol interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Symbol", reflect.TypeOf((*MockUMOpenOrdersServiceAPI)(nil).Symbol), symbol)
+}
+
+// MockUMAllConditionalOrdersServiceAPI is a mock of UMAllConditionalOrdersServiceAPI interface.
+type MockUMAllConditionalOrdersServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockUMAllConditionalOrdersServiceAPIMockRecorder
+}
+
+// MockUMAllConditionalOrdersServiceAPIMockRecorder is the mock recorder for MockUMAllConditionalOrdersServiceAPI.
+type MockUMAllConditionalOrdersServiceAPIMockRecorder struct {
+	mock *MockUMAllConditionalOrdersServiceAPI
+}
+
+// NewMockUMAllConditionalOrdersServiceAPI creates a new mock instance.
+func NewMockUMAllConditionalOrdersServiceAPI(ctrl *gomock.Controller) *MockUMAllConditionalOrdersServiceAPI {
+	mock := &MockUMAllConditionalOrdersServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockUMAllConditionalOrdersServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUMAllConditionalOrdersServiceAPI) EXPECT() *MockUMAllConditionalOrdersServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockUMAllConditionalOrdersServiceAPI) Do(ctx context.Context) ([]*portfolio.UMConditionalOrderResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Do", ctx)
+	ret0, _ := ret[0].([]*portfolio.UMConditionalOrderResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockUMAllConditionalOrdersServiceAPIMockRecorder) Do(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockUMAllConditionalOrdersServiceAPI)(nil).Do), ctx)
+}
+
+// EndTime mocks base method.
+func (m *MockUMAllConditionalOrdersServiceAPI) EndTime(endTime int64) portfolio.UMAllConditionalOrdersServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EndTime", endTime)
+	ret0, _ := ret[0].(portfolio.UMAllConditionalOrdersServiceAPI)
+	return ret0
+}
+
+// EndTime indicates an expected call of EndTime.
+func (mr *MockUMAllConditionalOrdersServiceAPIMockRecorder) EndTime(endTime interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EndTime", reflect.TypeOf((*MockUMAllConditionalOrdersServiceAPI)(nil).EndTime), endTime)
+}
+
+// Limit mocks base method.
+func (m *MockUMAllConditionalOrdersServiceAPI) Limit(limit int) portfolio.UMAllConditionalOrdersServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Limit", limit)
+	ret0, _ := ret[0].(portfolio.UMAllConditionalOrdersServiceAPI)
+	return ret0
+}
+
+// Limit indicates an expected call of Limit.
+func (mr *MockUMAllConditionalOrdersServiceAPIMockRecorder) Limit(limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Limit", reflect.TypeOf((*MockUMAllConditionalOrdersServiceAPI)(nil).Limit), limit)
+}
+
+// RecvWindow mocks base method.
+func (m *MockUMAllConditionalOrdersServiceAPI) RecvWindow(recvWindow int64) portfolio.UMAllConditionalOrdersServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecvWindow", recvWindow)
+	ret0, _ := ret[0].(portfolio.UMAllConditionalOrdersServiceAPI)
+	return ret0
+}
+
+// RecvWindow indicates an expected call of RecvWindow.
+func (mr *MockUMAllConditionalOrdersServiceAPIMockRecorder) RecvWindow(recvWindow interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvWindow", reflect.TypeOf((*MockUMAllConditionalOrdersServiceAPI)(nil).RecvWindow), recvWindow)
+}
+
+// StartTime mocks base method.
+func (m *MockUMAllConditionalOrdersServiceAPI) StartTime(startTime int64) portfolio.UMAllConditionalOrdersServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartTime", startTime)
+	ret0, _ := ret[0].(portfolio.UMAllConditionalOrdersServiceAPI)
+	return ret0
+}
+
+// StartTime indicates an expected call of StartTime.
+func (mr *MockUMAllConditionalOrdersServiceAPIMockRecorder) StartTime(startTime interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartTime", reflect.TypeOf((*MockUMAllConditionalOrdersServiceAPI)(nil).StartTime), startTime)
+}
+
+// StrategyID mocks base method.
+func (m *MockUMAllConditionalOrdersServiceAPI) StrategyID(strategyID int64) portfolio.UMAllConditionalOrdersServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StrategyID", strategyID)
+	ret0, _ := ret[0].(portfolio.UMAllConditionalOrdersServiceAPI)
+	return ret0
+}
+
+// StrategyID indicates an expected call of StrategyID.
+func (mr *MockUMAllConditionalOrdersServiceAPIMockRecorder) StrategyID(strategyID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StrategyID", reflect.TypeOf((*MockUMAllConditionalOrdersServiceAPI)(nil).StrategyID), strategyID)
+}
+
+// Symbol mocks base method.
+func (m *MockUMAllConditionalOrdersServiceAPI) Symbol(symbol string) portfolio.UMAllConditionalOrdersServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Symbol", symbol)
+	ret0, _ := ret[0].(portfolio.UMAllConditionalOrdersServiceAPI)
+	return ret0
+}
+
+// Symbol indicates an expected call of Symbol.
+func (mr *MockUMAllConditionalOrdersServiceAPIMockRecorder) Symbol(symbol interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Symbol", reflect.TypeOf((*MockUMAllConditionalOrdersServiceAPI)(nil).Symbol), symbol)
+}
+
+// MockUMOpenConditionalOrdersServiceAPI is a mock of UMOpenConditionalOrdersServiceAPI interface.
+type MockUMOpenConditionalOrdersServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockUMOpenConditionalOrdersServiceAPIMockRecorder
+}
+
+// MockUMOpenConditionalOrdersServiceAPIMockRecorder is the mock recorder for MockUMOpenConditionalOrdersServiceAPI.
+type MockUMOpenConditionalOrdersServiceAPIMockRecorder struct {
+	mock *MockUMOpenConditionalOrdersServiceAPI
+}
+
+// NewMockUMOpenConditionalOrdersServiceAPI creates a new mock instance.
+func NewMockUMOpenConditionalOrdersServiceAPI(ctrl *gomock.Controller) *MockUMOpenConditionalOrdersServiceAPI {
+	mock := &MockUMOpenConditionalOrdersServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockUMOpenConditionalOrdersServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUMOpenConditionalOrdersServiceAPI) EXPECT() *MockUMOpenConditionalOrdersServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockUMOpenConditionalOrdersServiceAPI) Do(ctx context.Context) ([]*portfolio.UMOpenConditionalOrderResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Do", ctx)
+	ret0, _ := ret[0].([]*portfolio.UMOpenConditionalOrderResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockUMOpenConditionalOrdersServiceAPIMockRecorder) Do(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockUMOpenConditionalOrdersServiceAPI)(nil).Do), ctx)
+}
+
+// RecvWindow mocks base method.
+func (m *MockUMOpenConditionalOrdersServiceAPI) RecvWindow(recvWindow int64) portfolio.UMOpenConditionalOrdersServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecvWindow", recvWindow)
+	ret0, _ := ret[0].(portfolio.UMOpenConditionalOrdersServiceAPI)
+	return ret0
+}
+
+// RecvWindow indicates an expected call of RecvWindow.
+func (mr *MockUMOpenConditionalOrdersServiceAPIMockRecorder) RecvWindow(recvWindow interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvWindow", reflect.TypeOf((*MockUMOpenConditionalOrdersServiceAPI)(nil).RecvWindow), recvWindow)
+}
+
+// Symbol mocks base method.
+func (m *MockUMOpenConditionalOrdersServiceAPI) Symbol(symbol string) portfolio.UMOpenConditionalOrdersServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Symbol", symbol)
+	ret0, _ := ret[0].(portfolio.UMOpenConditionalOrdersServiceAPI)
+	return ret0
+}
+
+// Symbol indicates an expected call of Symbol.
+func (mr *MockUMOpenConditionalOrdersServiceAPIMockRecorder) Symbol(symbol interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Symbol", reflect.TypeOf((*MockUMOpenConditionalOrdersServiceAPI)(nil).Symbol), symbol)
+}
+
+// MockUMOpenConditionalOrderServiceAPI is a mock of UMOpenConditionalOrderServiceAPI interface.
+type MockUMOpenConditionalOrderServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockUMOpenConditionalOrderServiceAPIMockRecorder
+}
+
+// MockUMOpenConditionalOrderServiceAPIMockRecorder is the mock recorder for MockUMOpenConditionalOrderServiceAPI.
+type MockUMOpenConditionalOrderServiceAPIMockRecorder struct {
+	mock *MockUMOpenConditionalOrderServiceAPI
+}
+
+// NewMockUMOpenConditionalOrderServiceAPI creates a new mock instance.
+func NewMockUMOpenConditionalOrderServiceAPI(ctrl *gomock.Controller) *MockUMOpenConditionalOrderServiceAPI {
+	mock := &MockUMOpenConditionalOrderServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockUMOpenConditionalOrderServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUMOpenConditionalOrderServiceAPI) EXPECT() *MockUMOpenConditionalOrderServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockUMOpenConditionalOrderServiceAPI) Do(ctx context.Context) (*portfolio.UMOpenConditionalOrderResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Do", ctx)
+	ret0, _ := ret[0].(*portfolio.UMOpenConditionalOrderResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockUMOpenConditionalOrderServiceAPIMockRecorder) Do(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockUMOpenConditionalOrderServiceAPI)(nil).Do), ctx)
+}
+
+// NewClientStrategyID mocks base method.
+func (m *MockUMOpenConditionalOrderServiceAPI) NewClientStrategyID(newClientStrategyID string) portfolio.UMOpenConditionalOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewClientStrategyID", newClientStrategyID)
+	ret0, _ := ret[0].(portfolio.UMOpenConditionalOrderServiceAPI)
+	return ret0
+}
+
+// NewClientStrategyID indicates an expected call of NewClientStrategyID.
+func (mr *MockUMOpenConditionalOrderServiceAPIMockRecorder) NewClientStrategyID(newClientStrategyID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewClientStrategyID", reflect.TypeOf((*MockUMOpenConditionalOrderServiceAPI)(nil).NewClientStrategyID), newClientStrategyID)
+}
+
+// RecvWindow mocks base method.
+func (m *MockUMOpenConditionalOrderServiceAPI) RecvWindow(recvWindow int64) portfolio.UMOpenConditionalOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecvWindow", recvWindow)
+	ret0, _ := ret[0].(portfolio.UMOpenConditionalOrderServiceAPI)
+	return ret0
+}
+
+// RecvWindow indicates an expected call of RecvWindow.
+func (mr *MockUMOpenConditionalOrderServiceAPIMockRecorder) RecvWindow(recvWindow interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvWindow", reflect.TypeOf((*MockUMOpenConditionalOrderServiceAPI)(nil).RecvWindow), recvWindow)
+}
+
+// StrategyID mocks base method.
+func (m *MockUMOpenConditionalOrderServiceAPI) StrategyID(strategyID int64) portfolio.UMOpenConditionalOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StrategyID", strategyID)
+	ret0, _ := ret[0].(portfolio.UMOpenConditionalOrderServiceAPI)
+	return ret0
+}
+
+// StrategyID indicates an expected call of StrategyID.
+func (mr *MockUMOpenConditionalOrderServiceAPIMockRecorder) StrategyID(strategyID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StrategyID", reflect.TypeOf((*MockUMOpenConditionalOrderServiceAPI)(nil).StrategyID), strategyID)
+}
+
+// Symbol mocks base method.
+func (m *MockUMOpenConditionalOrderServiceAPI) Symbol(symbol string) portfolio.UMOpenConditionalOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Symbol", symbol)
+	ret0, _ := ret[0].(portfolio.UMOpenConditionalOrderServiceAPI)
+	return ret0
+}
+
+// Symbol indicates an expected call of Symbol.
+func (mr *MockUMOpenConditionalOrderServiceAPIMockRecorder) Symbol(symbol interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Symbol", reflect.TypeOf((*MockUMOpenConditionalOrderServiceAPI)(nil).Symbol), symbol)
+}
+
+// MockUMConditionalOrderHistoryServiceAPI is a mock of UMConditionalOrderHistoryServiceAPI interface.
+type MockUMConditionalOrderHistoryServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockUMConditionalOrderHistoryServiceAPIMockRecorder
+}
+
+// MockUMConditionalOrderHistoryServiceAPIMockRecorder is the mock recorder for MockUMConditionalOrderHistoryServiceAPI.
+type MockUMConditionalOrderHistoryServiceAPIMockRecorder struct {
+	mock *MockUMConditionalOrderHistoryServiceAPI
+}
+
+// NewMockUMConditionalOrderHistoryServiceAPI creates a new mock instance.
+func NewMockUMConditionalOrderHistoryServiceAPI(ctrl *gomock.Controller) *MockUMConditionalOrderHistoryServiceAPI {
+	mock := &MockUMConditionalOrderHistoryServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockUMConditionalOrderHistoryServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUMConditionalOrderHistoryServiceAPI) EXPECT() *MockUMConditionalOrderHistoryServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockUMConditionalOrderHistoryServiceAPI) Do(ctx context.Context) (*portfolio.UMConditionalOrderHistoryResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Do", ctx)
+	ret0, _ := ret[0].(*portfolio.UMConditionalOrderHistoryResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockUMConditionalOrderHistoryServiceAPIMockRecorder) Do(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockUMConditionalOrderHistoryServiceAPI)(nil).Do), ctx)
+}
+
+// NewClientStrategyID mocks base method.
+func (m *MockUMConditionalOrderHistoryServiceAPI) NewClientStrategyID(newClientStrategyID string) portfolio.UMConditionalOrderHistoryServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewClientStrategyID", newClientStrategyID)
+	ret0, _ := ret[0].(portfolio.UMConditionalOrderHistoryServiceAPI)
+	return ret0
+}
+
+// NewClientStrategyID indicates an expected call of NewClientStrategyID.
+func (mr *MockUMConditionalOrderHistoryServiceAPIMockRecorder) NewClientStrategyID(newClientStrategyID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewClientStrategyID", reflect.TypeOf((*MockUMConditionalOrderHistoryServiceAPI)(nil).NewClientStrategyID), newClientStrategyID)
+}
+
+// RecvWindow mocks base method.
+func (m *MockUMConditionalOrderHistoryServiceAPI) RecvWindow(recvWindow int64) portfolio.UMConditionalOrderHistoryServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecvWindow", recvWindow)
+	ret0, _ := ret[0].(portfolio.UMConditionalOrderHistoryServiceAPI)
+	return ret0
+}
+
+// RecvWindow indicates an expected call of RecvWindow.
+func (mr *MockUMConditionalOrderHistoryServiceAPIMockRecorder) RecvWindow(recvWindow interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvWindow", reflect.TypeOf((*MockUMConditionalOrderHistoryServiceAPI)(nil).RecvWindow), recvWindow)
+}
+
+// StrategyID mocks base method.
+func (m *MockUMConditionalOrderHistoryServiceAPI) StrategyID(strategyID int64) portfolio.UMConditionalOrderHistoryServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StrategyID", strategyID)
+	ret0, _ := ret[0].(portfolio.UMConditionalOrderHistoryServiceAPI)
+	return ret0
+}
+
+// StrategyID indicates an expected call of StrategyID.
+func (mr *MockUMConditionalOrderHistoryServiceAPIMockRecorder) StrategyID(strategyID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StrategyID", reflect.TypeOf((*MockUMConditionalOrderHistoryServiceAPI)(nil).StrategyID), strategyID)
+}
+
+// Symbol mocks base method.
+func (m *MockUMConditionalOrderHistoryServiceAPI) Symbol(symbol string) portfolio.UMConditionalOrderHistoryServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Symbol", symbol)
+	ret0, _ := ret[0].(portfolio.UMConditionalOrderHistoryServiceAPI)
+	return ret0
+}
+
+// Symbol indicates an expected call of Symbol.
+func (mr *MockUMConditionalOrderHistoryServiceAPIMockRecorder) Symbol(symbol interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Symbol", reflect.TypeOf((*MockUMConditionalOrderHistoryServiceAPI)(nil).Symbol), symbol)
+}
+
+// MockCMQueryOrderServiceAPI is a mock of CMQueryOrderServiceAPI interface.
+type MockCMQueryOrderServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockCMQueryOrderServiceAPIMockRecorder
+}
+
+// MockCMQueryOrderServiceAPIMockRecorder is the mock recorder for MockCMQueryOrderServiceAPI.
+type MockCMQueryOrderServiceAPIMockRecorder struct {
+	mock *MockCMQueryOrderServiceAPI
+}
+
+// NewMockCMQueryOrderServiceAPI creates a new mock instance.
+func NewMockCMQueryOrderServiceAPI(ctrl *gomock.Controller) *MockCMQueryOrderServiceAPI {
+	mock := &MockCMQueryOrderServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockCMQueryOrderServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCMQueryOrderServiceAPI) EXPECT() *MockCMQueryOrderServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockCMQueryOrderServiceAPI) Do(ctx context.Context) (*portfolio.CMQueryOrderResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Do", ctx)
+	ret0, _ := ret[0].(*portfolio.CMQueryOrderResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockCMQueryOrderServiceAPIMockRecorder) Do(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockCMQueryOrderServiceAPI)(nil).Do), ctx)
+}
+
+// OrderID mocks base method.
+func (m *MockCMQueryOrderServiceAPI) OrderID(orderID int64) portfolio.CMQueryOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OrderID", orderID)
+	ret0, _ := ret[0].(portfolio.CMQueryOrderServiceAPI)
+	return ret0
+}
+
+// OrderID indicates an expected call of OrderID.
+func (mr *MockCMQueryOrderServiceAPIMockRecorder) OrderID(orderID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OrderID", reflect.TypeOf((*MockCMQueryOrderServiceAPI)(nil).OrderID), orderID)
+}
+
+// OrigClientOrderID mocks base method.
+func (m *MockCMQueryOrderServiceAPI) OrigClientOrderID(origClientOrderID string) portfolio.CMQueryOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OrigClientOrderID", origClientOrderID)
+	ret0, _ := ret[0].(portfolio.CMQueryOrderServiceAPI)
+	return ret0
+}
+
+// OrigClientOrderID indicates an expected call of OrigClientOrderID.
+func (mr *MockCMQueryOrderServiceAPIMockRecorder) OrigClientOrderID(origClientOrderID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OrigClientOrderID", reflect.TypeOf((*MockCMQueryOrderServiceAPI)(nil).OrigClientOrderID), origClientOrderID)
+}
+
+// RecvWindow mocks base method.
+func (m *MockCMQueryOrderServiceAPI) RecvWindow(recvWindow int64) portfolio.CMQueryOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecvWindow", recvWindow)
+	ret0, _ := ret[0].(portfolio.CMQueryOrderServiceAPI)
+	return ret0
+}
+
+// RecvWindow indicates an expected call of RecvWindow.
+func (mr *MockCMQueryOrderServiceAPIMockRecorder) RecvWindow(recvWindow interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvWindow", reflect.TypeOf((*MockCMQueryOrderServiceAPI)(nil).RecvWindow), recvWindow)
+}
+
+// Symbol mocks base method.
+func (m *MockCMQueryOrderServiceAPI) Symbol(symbol string) portfolio.CMQueryOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Symbol", symbol)
+	ret0, _ := ret[0].(portfolio.CMQueryOrderServiceAPI)
+	return ret0
+}
+
+// Symbol indicates an expected call of Symbol.
+func (mr *MockCMQueryOrderServiceAPIMockRecorder) Symbol(symbol interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Symbol", reflect.TypeOf((*MockCMQueryOrderServiceAPI)(nil).Symbol), symbol)
+}
+
+// MockCMAllOrdersServiceAPI is a mock of CMAllOrdersServiceAPI interface.
+type MockCMAllOrdersServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockCMAllOrdersServiceAPIMockRecorder
+}
+
+// MockCMAllOrdersServiceAPIMockRecorder is the mock recorder for MockCMAllOrdersServiceAPI.
+type MockCMAllOrdersServiceAPIMockRecorder struct {
+	mock *MockCMAllOrdersServiceAPI
+}
+
+// NewMockCMAllOrdersServiceAPI creates a new mock instance.
+func NewMockCMAllOrdersServiceAPI(ctrl *gomock.Controller) *MockCMAllOrdersServiceAPI {
+	mock := &MockCMAllOrdersServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockCMAllOrdersServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCMAllOrdersServiceAPI) EXPECT() *MockCMAllOrdersServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockCMAllOrdersServiceAPI) Do(ctx context.Context) ([]*portfolio.CMAllOrdersResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Do", ctx)
+	ret0, _ := ret[0].([]*portfolio.CMAllOrdersResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockCMAllOrdersServiceAPIMockRecorder) Do(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockCMAllOrdersServiceAPI)(nil).Do), ctx)
+}
+
+// EndTime mocks base method.
+func (m *MockCMAllOrdersServiceAPI) EndTime(endTime int64) portfolio.CMAllOrdersServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EndTime", endTime)
+	ret0, _ := ret[0].(portfolio.CMAllOrdersServiceAPI)
+	return ret0
+}
+
+// EndTime indicates an expected call of EndTime.
+func (mr *MockCMAllOrdersServiceAPIMockRecorder) EndTime(endTime interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EndTime", reflect.TypeOf((*MockCMAllOrdersServiceAPI)(nil).EndTime), endTime)
+}
+
+// Limit mocks base method.
+func (m *MockCMAllOrdersServiceAPI) Limit(limit int) portfolio.CMAllOrdersServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Limit", limit)
+	ret0, _ := ret[0].(portfolio.CMAllOrdersServiceAPI)
+	return ret0
+}
+
+// Limit indicates an expected call of Limit.
+func (mr *MockCMAllOrdersServiceAPIMockRecorder) Limit(limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Limit", reflect.TypeOf((*MockCMAllOrdersServiceAPI)(nil).Limit), limit)
+}
+
+// OrderID mocks base method.
+func (m *MockCMAllOrdersServiceAPI) OrderID(orderID int64) portfolio.CMAllOrdersServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OrderID", orderID)
+	ret0, _ := ret[0].(portfolio.CMAllOrdersServiceAPI)
+	return ret0
+}
+
+// OrderID indicates an expected call of OrderID.
+func (mr *MockCMAllOrdersServiceAPIMockRecorder) OrderID(orderID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OrderID", reflect.TypeOf((*MockCMAllOrdersServiceAPI)(nil).OrderID), orderID)
+}
+
+// Pair mocks base method.
+func (m *MockCMAllOrdersServiceAPI) Pair(pair string) portfolio.CMAllOrdersServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Pair", pair)
+	ret0, _ := ret[0].(portfolio.CMAllOrdersServiceAPI)
+	return ret0
+}
+
+// Pair indicates an expected call of Pair.
+func (mr *MockCMAllOrdersServiceAPIMockRecorder) Pair(pair interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Pair", reflect.TypeOf((*MockCMAllOrdersServiceAPI)(nil).Pair), pair)
+}
+
+// RecvWindow mocks base method.
+func (m *MockCMAllOrdersServiceAPI) RecvWindow(recvWindow int64) portfolio.CMAllOrdersServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecvWindow", recvWindow)
+	ret0, _ := ret[0].(portfolio.CMAllOrdersServiceAPI)
+	return ret0
+}
+
+// RecvWindow indicates an expected call of RecvWindow.
+func (mr *MockCMAllOrdersServiceAPIMockRecorder) RecvWindow(recvWindow interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvWindow", reflect.TypeOf((*MockCMAllOrdersServiceAPI)(nil).RecvWindow), recvWindow)
+}
+
+// StartTime mocks base method.
+func (m *MockCMAllOrdersServiceAPI) StartTime(startTime int64) portfolio.CMAllOrdersServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartTime", startTime)
+	ret0, _ := ret[0].(portfolio.CMAllOrdersServiceAPI)
+	return ret0
+}
+
+// StartTime indicates an expected call of StartTime.
+func (mr *MockCMAllOrdersServiceAPIMockRecorder) StartTime(startTime interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartTime", reflect.TypeOf((*MockCMAllOrdersServiceAPI)(nil).StartTime), startTime)
+}
+
+// Symbol mocks base method.
+func (m *MockCMAllOrdersServiceAPI) Symbol(symbol string) portfolio.CMAllOrdersServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Symbol", symbol)
+	ret0, _ := ret[0].(portfolio.CMAllOrdersServiceAPI)
+	return ret0
+}
+
+// Symbol indicates an expected call of Symbol.
+func (mr *MockCMAllOrdersServiceAPIMockRecorder) Symbol(symbol interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Symbol", reflect.TypeOf((*MockCMAllOrdersServiceAPI)(nil).Symbol), symbol)
+}
+
+// MockCMOpenOrderServiceAPI is a mock of CMOpenOrderServiceAPI interface.
+type MockCMOpenOrderServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockCMOpenOrderServiceAPIMockRecorder
+}
+
+// MockCMOpenOrderServiceAPIMockRecorder is the mock recorder for MockCMOpenOrderServiceAPI.
+type MockCMOpenOrderServiceAPIMockRecorder struct {
+	mock *MockCMOpenOrderServiceAPI
+}
+
+// NewMockCMOpenOrderServiceAPI creates a new mock instance.
+func NewMockCMOpenOrderServiceAPI(ctrl *gomock.Controller) *MockCMOpenOrderServiceAPI {
+	mock := &MockCMOpenOrderServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockCMOpenOrderServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCMOpenOrderServiceAPI) EXPECT() *MockCMOpenOrderServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockCMOpenOrderServiceAPI) Do(ctx context.Context) (*portfolio.CMOpenOrderResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Do", ctx)
+	ret0, _ := ret[0].(*portfolio.CMOpenOrderResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockCMOpenOrderServiceAPIMockRecorder) Do(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockCMOpenOrderServiceAPI)(nil).Do), ctx)
+}
+
+// OrderID mocks base method.
+func (m *MockCMOpenOrderServiceAPI) OrderID(orderID int64) portfolio.CMOpenOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OrderID", orderID)
+	ret0, _ := ret[0].(portfolio.CMOpenOrderServiceAPI)
+	return ret0
+}
+
+// OrderID indicates an expected call of OrderID.
+func (mr *MockCMOpenOrderServiceAPIMockRecorder) OrderID(orderID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OrderID", reflect.TypeOf((*MockCMOpenOrderServiceAPI)(nil).OrderID), orderID)
+}
+
+// OrigClientOrderID mocks base method.
+func (m *MockCMOpenOrderServiceAPI) OrigClientOrderID(origClientOrderID string) portfolio.CMOpenOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OrigClientOrderID", origClientOrderID)
+	ret0, _ := ret[0].(portfolio.CMOpenOrderServiceAPI)
+	return ret0
+}
+
+// OrigClientOrderID indicates an expected call of OrigClientOrderID.
+func (mr *MockCMOpenOrderServiceAPIMockRecorder) OrigClientOrderID(origClientOrderID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OrigClientOrderID", reflect.TypeOf((*MockCMOpenOrderServiceAPI)(nil).OrigClientOrderID), origClientOrderID)
+}
+
+// RecvWindow mocks base method.
+func (m *MockCMOpenOrderServiceAPI) RecvWindow(recvWindow int64) portfolio.CMOpenOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecvWindow", recvWindow)
+	ret0, _ := ret[0].(portfolio.CMOpenOrderServiceAPI)
+	return ret0
+}
+
+// RecvWindow indicates an expected call of RecvWindow.
+func (mr *MockCMOpenOrderServiceAPIMockRecorder) RecvWindow(recvWindow interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvWindow", reflect.TypeOf((*MockCMOpenOrderServiceAPI)(nil).RecvWindow), recvWindow)
+}
+
+// Symbol mocks base method.
+func (m *MockCMOpenOrderServiceAPI) Symbol(symbol string) portfolio.CMOpenOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Symbol", symbol)
+	ret0, _ := ret[0].(portfolio.CMOpenOrderServiceAPI)
+	return ret0
+}
+
+// Symbol indicates an expected call of Symbol.
+func (mr *MockCMOpenOrderServiceAPIMockRecorder) Symbol(symbol interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Symbol", reflect.TypeOf((*MockCMOpenOrderServiceAPI)(nil).Symbol), symbol)
+}
+
+// MockCMOpenOrdersServiceAPI is a mock of CMOpenOrdersServiceAPI interface.
+type MockCMOpenOrdersServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockCMOpenOrdersServiceAPIMockRecorder
+}
+
+// MockCMOpenOrdersServiceAPIMockRecorder is the mock recorder for MockCMOpenOrdersServiceAPI.
+type MockCMOpenOrdersServiceAPIMockRecorder struct {
+	mock *MockCMOpenOrdersServiceAPI
+}
+
+// NewMockCMOpenOrdersServiceAPI creates a new mock instance.
+func NewMockCMOpenOrdersServiceAPI(ctrl *gomock.Controller) *MockCMOpenOrdersServiceAPI {
+	mock := &MockCMOpenOrdersServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockCMOpenOrdersServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCMOpenOrdersServiceAPI) EXPECT() *MockCMOpenOrdersServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockCMOpenOrdersServiceAPI) Do(ctx context.Context) ([]*portfolio.CMOpenOrdersResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Do", ctx)
+	ret0, _ := ret[0].([]*portfolio.CMOpenOrdersResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockCMOpenOrdersServiceAPIMockRecorder) Do(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockCMOpenOrdersServiceAPI)(nil).Do), ctx)
+}
+
+// Pair mocks base method.
+func (m *MockCMOpenOrdersServiceAPI) Pair(pair string) portfolio.CMOpenOrdersServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Pair", pair)
+	ret0, _ := ret[0].(portfolio.CMOpenOrdersServiceAPI)
+	return ret0
+}
+
+// Pair indicates an expected call of Pair.
+func (mr *MockCMOpenOrdersServiceAPIMockRecorder) Pair(pair interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Pair", reflect.TypeOf((*MockCMOpenOrdersServiceAPI)(nil).Pair), pair)
+}
+
+// RecvWindow mocks base method.
+func (m *MockCMOpenOrdersServiceAPI) RecvWindow(recvWindow int64) portfolio.CMOpenOrdersServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecvWindow", recvWindow)
+	ret0, _ := ret[0].(portfolio.CMOpenOrdersServiceAPI)
+	return ret0
+}
+
+// RecvWindow indicates an expected call of RecvWindow.
+func (mr *MockCMOpenOrdersServiceAPIMockRecorder) RecvWindow(recvWindow interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvWindow", reflect.TypeOf((*MockCMOpenOrdersServiceAPI)(nil).RecvWindow), recvWindow)
+}
+
+// Symbol mocks base method.
+func (m *MockCMOpenOrdersServiceAPI) Symbol(symbol string) portfolio.CMOpenOrdersServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Symbol", symbol)
+	ret0, _ := ret[0].(portfolio.CMOpenOrdersServiceAPI)
+	return ret0
+}
+
+// Symbol indicates an expected call of Symbol.
+func (mr *MockCMOpenOrdersServiceAPIMockRecorder) Symbol(symbol interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Symbol", reflect.TypeOf((*MockCMOpenOrdersServiceAPI)(nil).Symbol), symbol)
+}
+
+// MockCMOpenConditionalOrdersServiceAPI is a mock of CMOpenConditionalOrdersServiceAPI interface.
+type MockCMOpenConditionalOrdersServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockCMOpenConditionalOrdersServiceAPIMockRecorder
+}
+
+// MockCMOpenConditionalOrdersServiceAPIMockRecorder is the mock recorder for MockCMOpenConditionalOrdersServiceAPI.
+type MockCMOpenConditionalOrdersServiceAPIMockRecorder struct {
+	mock *MockCMOpenConditionalOrdersServiceAPI
+}
+
+// NewMockCMOpenConditionalOrdersServiceAPI creates a new mock instance.
+func NewMockCMOpenConditionalOrdersServiceAPI(ctrl *gomock.Controller) *MockCMOpenConditionalOrdersServiceAPI {
+	mock := &MockCMOpenConditionalOrdersServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockCMOpenConditionalOrdersServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCMOpenConditionalOrdersServiceAPI) EXPECT() *MockCMOpenConditionalOrdersServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockCMOpenConditionalOrdersServiceAPI) Do(ctx context.Context) ([]*portfolio.CMOpenConditionalOrderResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Do", ctx)
+	ret0, _ := ret[0].([]*portfolio.CMOpenConditionalOrderResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockCMOpenConditionalOrdersServiceAPIMockRecorder) Do(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockCMOpenConditionalOrdersServiceAPI)(nil).Do), ctx)
+}
+
+// RecvWindow mocks base method.
+func (m *MockCMOpenConditionalOrdersServiceAPI) RecvWindow(recvWindow int64) portfolio.CMOpenConditionalOrdersServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecvWindow", recvWindow)
+	ret0, _ := ret[0].(portfolio.CMOpenConditionalOrdersServiceAPI)
+	return ret0
+}
+
+// RecvWindow indicates an expected call of RecvWindow.
+func (mr *MockCMOpenConditionalOrdersServiceAPIMockRecorder) RecvWindow(recvWindow interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvWindow", reflect.TypeOf((*MockCMOpenConditionalOrdersServiceAPI)(nil).RecvWindow), recvWindow)
+}
+
+// Symbol mocks base method.
+func (m *MockCMOpenConditionalOrdersServiceAPI) Symbol(symbol string) portfolio.CMOpenConditionalOrdersServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Symbol", symbol)
+	ret0, _ := ret[0].(portfolio.CMOpenConditionalOrdersServiceAPI)
+	return ret0
+}
+
+// Symbol indicates an expected call of Symbol.
+func (mr *MockCMOpenConditionalOrdersServiceAPIMockRecorder) Symbol(symbol interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Symbol", reflect.TypeOf((*MockCMOpenConditionalOrdersServiceAPI)(nil).Symbol), symbol)
+}
+
+// MockCMOpenConditionalOrderServiceAPI is a mock of CMOpenConditionalOrderServiceAPI interface.
+type MockCMOpenConditionalOrderServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockCMOpenConditionalOrderServiceAPIMockRecorder
+}
+
+// MockCMOpenConditionalOrderServiceAPIMockRecorder is the mock recorder for MockCMOpenConditionalOrderServiceAPI.
+type MockCMOpenConditionalOrderServiceAPIMockRecorder struct {
+	mock *MockCMOpenConditionalOrderServiceAPI
+}
+
+// NewMockCMOpenConditionalOrderServiceAPI creates a new mock instance.
+func NewMockCMOpenConditionalOrderServiceAPI(ctrl *gomock.Controller) *MockCMOpenConditionalOrderServiceAPI {
+	mock := &MockCMOpenConditionalOrderServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockCMOpenConditionalOrderServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCMOpenConditionalOrderServiceAPI) EXPECT() *MockCMOpenConditionalOrderServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockCMOpenConditionalOrderServiceAPI) Do(ctx context.Context) (*portfolio.CMOpenConditionalOrderResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Do", ctx)
+	ret0, _ := ret[0].(*portfolio.CMOpenConditionalOrderResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockCMOpenConditionalOrderServiceAPIMockRecorder) Do(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockCMOpenConditionalOrderServiceAPI)(nil).Do), ctx)
+}
+
+// NewClientStrategyID mocks base method.
+func (m *MockCMOpenConditionalOrderServiceAPI) NewClientStrategyID(newClientStrategyID string) portfolio.CMOpenConditionalOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewClientStrategyID", newClientStrategyID)
+	ret0, _ := ret[0].(portfolio.CMOpenConditionalOrderServiceAPI)
+	return ret0
+}
+
+// NewClientStrategyID indicates an expected call of NewClientStrategyID.
+func (mr *MockCMOpenConditionalOrderServiceAPIMockRecorder) NewClientStrategyID(newClientStrategyID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewClientStrategyID", reflect.TypeOf((*MockCMOpenConditionalOrderServiceAPI)(nil).NewClientStrategyID), newClientStrategyID)
+}
+
+// RecvWindow mocks base method.
+func (m *MockCMOpenConditionalOrderServiceAPI) RecvWindow(recvWindow int64) portfolio.CMOpenConditionalOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecvWindow", recvWindow)
+	ret0, _ := ret[0].(portfolio.CMOpenConditionalOrderServiceAPI)
+	return ret0
+}
+
+// RecvWindow indicates an expected call of RecvWindow.
+func (mr *MockCMOpenConditionalOrderServiceAPIMockRecorder) RecvWindow(recvWindow interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvWindow", reflect.TypeOf((*MockCMOpenConditionalOrderServiceAPI)(nil).RecvWindow), recvWindow)
+}
+
+// StrategyID mocks base method.
+func (m *MockCMOpenConditionalOrderServiceAPI) StrategyID(strategyID int64) portfolio.CMOpenConditionalOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StrategyID", strategyID)
+	ret0, _ := ret[0].(portfolio.CMOpenConditionalOrderServiceAPI)
+	return ret0
+}
+
+// StrategyID indicates an expected call of StrategyID.
+func (mr *MockCMOpenConditionalOrderServiceAPIMockRecorder) StrategyID(strategyID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StrategyID", reflect.TypeOf((*MockCMOpenConditionalOrderServiceAPI)(nil).StrategyID), strategyID)
+}
+
+// Symbol mocks base method.
+func (m *MockCMOpenConditionalOrderServiceAPI) Symbol(symbol string) portfolio.CMOpenConditionalOrderServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Symbol", symbol)
+	ret0, _ := ret[0].(portfolio.CMOpenConditionalOrderServiceAPI)
+	return ret0
+}
+
+// Symbol indicates an expected call of Symbol.
+func (mr *MockCMOpenConditionalOrderServiceAPIMockRecorder) Symbol(symbol interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Symbol", reflect.TypeOf((*MockCMOpenConditionalOrderServiceAPI)(nil).Symbol), symbol)
+}
+
+// MockCMConditionalOrdersServiceAPI is a mock of CMConditionalOrdersServiceAPI interface.
+type MockCMConditionalOrdersServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockCMConditionalOrdersServiceAPIMockRecorder
+}
+
+// MockCMConditionalOrdersServiceAPIMockRecorder is the mock recorder for MockCMConditionalOrdersServiceAPI.
+type MockCMConditionalOrdersServiceAPIMockRecorder struct {
+	mock *MockCMConditionalOrdersServiceAPI
+}
+
+// NewMockCMConditionalOrdersServiceAPI creates a new mock instance.
+func NewMockCMConditionalOrdersServiceAPI(ctrl *gomock.Controller) *MockCMConditionalOrdersServiceAPI {
+	mock := &MockCMConditionalOrdersServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockCMConditionalOrdersServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCMConditionalOrdersServiceAPI) EXPECT() *MockCMConditionalOrdersServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockCMConditionalOrdersServiceAPI) Do(ctx context.Context) ([]*portfolio.CMConditionalOrderResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Do", ctx)
+	ret0, _ := ret[0].([]*portfolio.CMConditionalOrderResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockCMConditionalOrdersServiceAPIMockRecorder) Do(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockCMConditionalOrdersServiceAPI)(nil).Do), ctx)
+}
+
+// EndTime mocks base method.
+func (m *MockCMConditionalOrdersServiceAPI) EndTime(endTime int64) portfolio.CMConditionalOrdersServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EndTime", endTime)
+	ret0, _ := ret[0].(portfolio.CMConditionalOrdersServiceAPI)
+	return ret0
+}
+
+// EndTime indicates an expected call of EndTime.
+func (mr *MockCMConditionalOrdersServiceAPIMockRecorder) EndTime(endTime interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EndTime", reflect.TypeOf((*MockCMConditionalOrdersServiceAPI)(nil).EndTime), endTime)
+}
+
+// Limit mocks base method.
+func (m *MockCMConditionalOrdersServiceAPI) Limit(limit int) portfolio.CMConditionalOrdersServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Limit", limit)
+	ret0, _ := ret[0].(portfolio.CMConditionalOrdersServiceAPI)
+	return ret0
+}
+
+// Limit indicates an expected call of Limit.
+func (mr *MockCMConditionalOrdersServiceAPIMockRecorder) Limit(limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Limit", reflect.TypeOf((*MockCMConditionalOrdersServiceAPI)(nil).Limit), limit)
+}
+
+// RecvWindow mocks base method.
+func (m *MockCMConditionalOrdersServiceAPI) RecvWindow(recvWindow int64) portfolio.CMConditionalOrdersServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecvWindow", recvWindow)
+	ret0, _ := ret[0].(portfolio.CMConditionalOrdersServiceAPI)
+	return ret0
+}
+
+// RecvWindow indicates an expected call of RecvWindow.
+func (mr *MockCMConditionalOrdersServiceAPIMockRecorder) RecvWindow(recvWindow interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvWindow", reflect.TypeOf((*MockCMConditionalOrdersServiceAPI)(nil).RecvWindow), recvWindow)
+}
+
+// StartTime mocks base method.
+func (m *MockCMConditionalOrdersServiceAPI) StartTime(startTime int64) portfolio.CMConditionalOrdersServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartTime", startTime)
+	ret0, _ := ret[0].(portfolio.CMConditionalOrdersServiceAPI)
+	return ret0
+}
+
+// StartTime indicates an expected call of StartTime.
+func (mr *MockCMConditionalOrdersServiceAPIMockRecorder) StartTime(startTime interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartTime", reflect.TypeOf((*MockCMConditionalOrdersServiceAPI)(nil).StartTime), startTime)
+}
+
+// StrategyID mocks base method.
+func (m *MockCMConditionalOrdersServiceAPI) StrategyID(strategyID int64) portfolio.CMConditionalOrdersServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StrategyID", strategyID)
+	ret0, _ := ret[0].(portfolio.CMConditionalOrdersServiceAPI)
+	return ret0
+}
+
+// StrategyID indicates an expected call of StrategyID.
+func (mr *MockCMConditionalOrdersServiceAPIMockRecorder) StrategyID(strategyID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StrategyID", reflect.TypeOf((*MockCMConditionalOrdersServiceAPI)(nil).StrategyID), strategyID)
+}
+
+// Symbol mocks base method.
+func (m *MockCMConditionalOrdersServiceAPI) Symbol(symbol string) portfolio.CMConditionalOrdersServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Symbol", symbol)
+	ret0, _ := ret[0].(portfolio.CMConditionalOrdersServiceAPI)
+	return ret0
+}
+
+// Symbol indicates an expected call of Symbol.
+func (mr *MockCMConditionalOrdersServiceAPIMockRecorder) Symbol(symbol interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Symbol", reflect.TypeOf((*MockCMConditionalOrdersServiceAPI)(nil).Symbol), symbol)
+}
+
+// MockCMConditionalOrderHistoryServiceAPI is a mock of CMConditionalOrderHistoryServiceAPI interface.
+type MockCMConditionalOrderHistoryServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockCMConditionalOrderHistoryServiceAPIMockRecorder
+}
+
+// MockCMConditionalOrderHistoryServiceAPIMockRecorder is the mock recorder for MockCMConditionalOrderHistoryServiceAPI.
+type MockCMConditionalOrderHistoryServiceAPIMockRecorder struct {
+	mock *MockCMConditionalOrderHistoryServiceAPI
+}
+
+// NewMockCMConditionalOrderHistoryServiceAPI creates a new mock instance.
+func NewMockCMConditionalOrderHistoryServiceAPI(ctrl *gomock.Controller) *MockCMConditionalOrderHistoryServiceAPI {
+	mock := &MockCMConditionalOrderHistoryServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockCMConditionalOrderHistoryServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCMConditionalOrderHistoryServiceAPI) EXPECT() *MockCMConditionalOrderHistoryServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockCMConditionalOrderHistoryServiceAPI) Do(ctx context.Context) (*portfolio.CMConditionalOrderHistoryResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Do", ctx)
+	ret0, _ := ret[0].(*portfolio.CMConditionalOrderHistoryResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockCMConditionalOrderHistoryServiceAPIMockRecorder) Do(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockCMConditionalOrderHistoryServiceAPI)(nil).Do), ctx)
+}
+
+// NewClientStrategyID mocks base method.
+func (m *MockCMConditionalOrderHistoryServiceAPI) NewClientStrategyID(newClientStrategyID string) portfolio.CMConditionalOrderHistoryServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewClientStrategyID", newClientStrategyID)
+	ret0, _ := ret[0].(portfolio.CMConditionalOrderHistoryServiceAPI)
+	return ret0
+}
+
+// NewClientStrategyID indicates an expected call of NewClientStrategyID.
+func (mr *MockCMConditionalOrderHistoryServiceAPIMockRecorder) NewClientStrategyID(newClientStrategyID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewClientStrategyID", reflect.TypeOf((*MockCMConditionalOrderHistoryServiceAPI)(nil).NewClientStrategyID), newClientStrategyID)
+}
+
+// RecvWindow mocks base method.
+func (m *MockCMConditionalOrderHistoryServiceAPI) RecvWindow(recvWindow int64) portfolio.CMConditionalOrderHistoryServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecvWindow", recvWindow)
+	ret0, _ := ret[0].(portfolio.CMConditionalOrderHistoryServiceAPI)
+	return ret0
+}
+
+// RecvWindow indicates an expected call of RecvWindow.
+func (mr *MockCMConditionalOrderHistoryServiceAPIMockRecorder) RecvWindow(recvWindow interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvWindow", reflect.TypeOf((*MockCMConditionalOrderHistoryServiceAPI)(nil).RecvWindow), recvWindow)
+}
+
+// StrategyID mocks base method.
+func (m *MockCMConditionalOrderHistoryServiceAPI) StrategyID(strategyID int64) portfolio.CMConditionalOrderHistoryServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StrategyID", strategyID)
+	ret0, _ := ret[0].(portfolio.CMConditionalOrderHistoryServiceAPI)
+	return ret0
+}
+
+// StrategyID indicates an expected call of StrategyID.
+func (mr *MockCMConditionalOrderHistoryServiceAPIMockRecorder) StrategyID(strategyID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StrategyID", reflect.TypeOf((*MockCMConditionalOrderHistoryServiceAPI)(nil).StrategyID), strategyID)
+}
+
+// Symbol mocks base method.
+func (m *MockCMConditionalOrderHistoryServiceAPI) Symbol(symbol string) portfolio.CMConditionalOrderHistoryServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Symbol", symbol)
+	ret0, _ := ret[0].(portfolio.CMConditionalOrderHistoryServiceAPI)
+	return ret0
+}
+
+// Symbol indicates an expected call of Symbol.
+func (mr *MockCMConditionalOrderHistoryServiceAPIMockRecorder) Symbol(symbol interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Symbol", reflect.TypeOf((*MockCMConditionalOrderHistoryServiceAPI)(nil).Symbol), symbol)
+}
+
+// MockUMForceOrdersServiceAPI is a mock of UMForceOrdersServiceAPI interface.
+type MockUMForceOrdersServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockUMForceOrdersServiceAPIMockRecorder
+}
+
+// MockUMForceOrdersServiceAPIMockRecorder is the mock recorder for MockUMForceOrdersServiceAPI.
+type MockUMForceOrdersServiceAPIMockRecorder struct {
+	mock *MockUMForceOrdersServiceAPI
+}
+
+// NewMockUMForceOrdersServiceAPI creates a new mock instance.
+func NewMockUMForceOrdersServiceAPI(ctrl *gomock.Controller) *MockUMForceOrdersServiceAPI {
+	mock := &MockUMForceOrdersServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockUMForceOrdersServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUMForceOrdersServiceAPI) EXPECT() *MockUMForceOrdersServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// AutoCloseType mocks base method.
+func (m *MockUMForceOrdersServiceAPI) AutoCloseType(autoCloseType string) portfolio.UMForceOrdersServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AutoCloseType", autoCloseType)
+	ret0, _ := ret[0].(portfolio.UMForceOrdersServiceAPI)
+	return ret0
+}
+
+// AutoCloseType indicates an expected call of AutoCloseType.
+func (mr *MockUMForceOrdersServiceAPIMockRecorder) AutoCloseType(autoCloseType interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AutoCloseType", reflect.TypeOf((*MockUMForceOrdersServiceAPI)(nil).AutoCloseType), autoCloseType)
+}
+
+// Do mocks base method.
+func (m *MockUMForceOrdersServiceAPI) Do(ctx context.Context) ([]*portfolio.UMForceOrderResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Do", ctx)
+	ret0, _ := ret[0].([]*portfolio.UMForceOrderResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockUMForceOrdersServiceAPIMockRecorder) Do(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockUMForceOrdersServiceAPI)(nil).Do), ctx)
+}
+
+// EndTime mocks base method.
+func (m *MockUMForceOrdersServiceAPI) EndTime(endTime int64) portfolio.UMForceOrdersServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EndTime", endTime)
+	ret0, _ := ret[0].(portfolio.UMForceOrdersServiceAPI)
+	return ret0
+}
+
+// EndTime indicates an expected call of EndTime.
+func (mr *MockUMForceOrdersServiceAPIMockRecorder) EndTime(endTime interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EndTime", reflect.TypeOf((*MockUMForceOrdersServiceAPI)(nil).EndTime), endTime)
+}
+
+// Limit mocks base method.
+func (m *MockUMForceOrdersServiceAPI) Limit(limit int) portfolio.UMForceOrdersServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Limit", limit)
+	ret0, _ := ret[0].(portfolio.UMForceOrdersServiceAPI)
+	return ret0
+}
+
+// Limit indicates an expected call of Limit.
+func (mr *MockUMForceOrdersServiceAPIMockRecorder) Limit(limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Limit", reflect.TypeOf((*MockUMForceOrdersServiceAPI)(nil).Limit), limit)
+}
+
+// RecvWindow mocks base method.
+func (m *MockUMForceOrdersServiceAPI) RecvWindow(recvWindow int64) portfolio.UMForceOrdersServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecvWindow", recvWindow)
+	ret0, _ := ret[0].(portfolio.UMForceOrdersServiceAPI)
+	return ret0
+}
+
+// RecvWindow indicates an expected call of RecvWindow.
+func (mr *MockUMForceOrdersServiceAPIMockRecorder) RecvWindow(recvWindow interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvWindow", reflect.TypeOf((*MockUMForceOrdersServiceAPI)(nil).RecvWindow), recvWindow)
+}
+
+// StartTime mocks base method.
+func (m *MockUMForceOrdersServiceAPI) StartTime(startTime int64) portfolio.UMForceOrdersServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartTime", startTime)
+	ret0, _ := ret[0].(portfolio.UMForceOrdersServiceAPI)
+	return ret0
+}
+
+// StartTime indicates an expected call of StartTime.
+func (mr *MockUMForceOrdersServiceAPIMockRecorder) StartTime(startTime interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartTime", reflect.TypeOf((*MockUMForceOrdersServiceAPI)(nil).StartTime), startTime)
+}
+
+// Symbol mocks base method.
+func (m *MockUMForceOrdersServiceAPI) Symbol(symbol string) portfolio.UMForceOrdersServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Symbol", symbol)
+	ret0, _ := ret[0].(portfolio.UMForceOrdersServiceAPI)
+	return ret0
+}
+
+// Symbol indicates an expected call of Symbol.
+func (mr *MockUMForceOrdersServiceAPIMockRecorder) Symbol(symbol interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Symbol", reflect.TypeOf((*MockUMForceOrdersServiceAPI)(nil).Symbol), symbol)
+}
+
+// MockCMForceOrdersServiceAPI is a mock of CMForceOrdersServiceAPI interface.
+type MockCMForceOrdersServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockCMForceOrdersServiceAPIMockRecorder
+}
+
+// MockCMForceOrdersServiceAPIMockRecorder is the mock recorder for MockCMForceOrdersServiceAPI.
+type MockCMForceOrdersServiceAPIMockRecorder struct {
+	mock *MockCMForceOrdersServiceAPI
+}
+
+// NewMockCMForceOrdersServiceAPI creates a new mock instance.
+func NewMockCMForceOrdersServiceAPI(ctrl *gomock.Controller) *MockCMForceOrdersServiceAPI {
+	mock := &MockCMForceOrdersServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockCMForceOrdersServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCMForceOrdersServiceAPI) EXPECT() *MockCMForceOrdersServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// AutoCloseType mocks base method.
+func (m *MockCMForceOrdersServiceAPI) AutoCloseType(autoCloseType string) portfolio.CMForceOrdersServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AutoCloseType", autoCloseType)
+	ret0, _ := ret[0].(portfolio.CMForceOrdersServiceAPI)
+	return ret0
+}
+
+// AutoCloseType indicates an expected call of AutoCloseType.
+func (mr *MockCMForceOrdersServiceAPIMockRecorder) AutoCloseType(autoCloseType interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AutoCloseType", reflect.TypeOf((*MockCMForceOrdersServiceAPI)(nil).AutoCloseType), autoCloseType)
+}
+
+// Do mocks base method.
+func (m *MockCMForceOrdersServiceAPI) Do(ctx context.Context) ([]*portfolio.CMForceOrderResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Do", ctx)
+	ret0, _ := ret[0].([]*portfolio.CMForceOrderResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockCMForceOrdersServiceAPIMockRecorder) Do(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockCMForceOrdersServiceAPI)(nil).Do), ctx)
+}
+
+// EndTime mocks base method.
+func (m *MockCMForceOrdersServiceAPI) EndTime(endTime int64) portfolio.CMForceOrdersServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EndTime", endTime)
+	ret0, _ := ret[0].(portfolio.CMForceOrdersServiceAPI)
+	return ret0
+}
+
+// EndTime indicates an expected call of EndTime.
+func (mr *MockCMForceOrdersServiceAPIMockRecorder) EndTime(endTime interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EndTime", reflect.TypeOf((*MockCMForceOrdersServiceAPI)(nil).EndTime), endTime)
+}
+
+// Limit mocks base method.
+func (m *MockCMForceOrdersServiceAPI) Limit(limit int) portfolio.CMForceOrdersServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Limit", limit)
+	ret0, _ := ret[0].(portfolio.CMForceOrdersServiceAPI)
+	return ret0
+}
+
+// Limit indicates an expected call of Limit.
+func (mr *MockCMForceOrdersServiceAPIMockRecorder) Limit(limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Limit", reflect.TypeOf((*MockCMForceOrdersServiceAPI)(nil).Limit), limit)
+}
+
+// RecvWindow mocks base method.
+func (m *MockCMForceOrdersServiceAPI) RecvWindow(recvWindow int64) portfolio.CMForceOrdersServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecvWindow", recvWindow)
+	ret0, _ := ret[0].(portfolio.CMForceOrdersServiceAPI)
+	return ret0
+}
+
+// RecvWindow indicates an expected call of RecvWindow.
+func (mr *MockCMForceOrdersServiceAPIMockRecorder) RecvWindow(recvWindow interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvWindow", reflect.TypeOf((*MockCMForceOrdersServiceAPI)(nil).RecvWindow), recvWindow)
+}
+
+// StartTime mocks base method.
+func (m *MockCMForceOrdersServiceAPI) StartTime(startTime int64) portfolio.CMForceOrdersServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartTime", startTime)
+	ret0, _ := ret[0].(portfolio.CMForceOrdersServiceAPI)
+	return ret0
+}
+
+// StartTime indicates an expected call of StartTime.
+func (mr *MockCMForceOrdersServiceAPIMockRecorder) StartTime(startTime interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartTime", reflect.TypeOf((*MockCMForceOrdersServiceAPI)(nil).StartTime), startTime)
+}
+
+// Symbol mocks base method.
+func (m *MockCMForceOrdersServiceAPI) Symbol(symbol string) portfolio.CMForceOrdersServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Symbol", symbol)
+	ret0, _ := ret[0].(portfolio.CMForceOrdersServiceAPI)
+	return ret0
+}
+
+// Symbol indicates an expected call of Symbol.
+func (mr *MockCMForceOrdersServiceAPIMockRecorder) Symbol(symbol interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Symbol", reflect.TypeOf((*MockCMForceOrdersServiceAPI)(nil).Symbol), symbol)
+}
+
+// MockUMModifyOrderHistoryServiceAPI is a mock of UMModifyOrderHistoryServiceAPI interface.
+type MockUMModifyOrderHistoryServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockUMModifyOrderHistoryServiceAPIMockRecorder
+}
+
+// MockUMModifyOrderHistoryServiceAPIMockRecorder is the mock recorder for MockUMModifyOrderHistoryServiceAPI.
+type MockUMModifyOrderHistoryServiceAPIMockRecorder struct {
+	mock *MockUMModifyOrderHistoryServiceAPI
+}
+
+// NewMockUMModifyOrderHistoryServiceAPI creates a new mock instance.
+func NewMockUMModifyOrderHistoryServiceAPI(ctrl *gomock.Controller) *MockUMModifyOrderHistoryServiceAPI {
+	mock := &MockUMModifyOrderHistoryServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockUMModifyOrderHistoryServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUMModifyOrderHistoryServiceAPI) EXPECT() *MockUMModifyOrderHistoryServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockUMModifyOrderHistoryServiceAPI) Do(ctx context.Context) ([]*portfolio.UMModifyOrderHistoryResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Do", ctx)
+	ret0, _ := ret[0].([]*portfolio.UMModifyOrderHistoryResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockUMModifyOrderHistoryServiceAPIMockRecorder) Do(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockUMModifyOrderHistoryServiceAPI)(nil).Do), ctx)
+}
+
+// EndTime mocks base method.
+func (m *MockUMModifyOrderHistoryServiceAPI) EndTime(endTime int64) portfolio.UMModifyOrderHistoryServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EndTime", endTime)
+	ret0, _ := ret[0].(portfolio.UMModifyOrderHistoryServiceAPI)
+	return ret0
+}
+
+// EndTime indicates an expected call of EndTime.
+func (mr *MockUMModifyOrderHistoryServiceAPIMockRecorder) EndTime(endTime interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EndTime", reflect.TypeOf((*MockUMModifyOrderHistoryServiceAPI)(nil).EndTime), endTime)
+}
+
+// Limit mocks base method.
+func (m *MockUMModifyOrderHistoryServiceAPI) Limit(limit int) portfolio.UMModifyOrderHistoryServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Limit", limit)
+	ret0, _ := ret[0].(portfolio.UMModifyOrderHistoryServiceAPI)
+	return ret0
+}
+
+// Limit indicates an expected call of Limit.
+func (mr *MockUMModifyOrderHistoryServiceAPIMockRecorder) Limit(limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Limit", reflect.TypeOf((*MockUMModifyOrderHistoryServiceAPI)(nil).Limit), limit)
+}
+
+// OrderID mocks base method.
+func (m *MockUMModifyOrderHistoryServiceAPI) OrderID(orderID int64) portfolio.UMModifyOrderHistoryServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OrderID", orderID)
+	ret0, _ := ret[0].(portfolio.UMModifyOrderHistoryServiceAPI)
+	return ret0
+}
+
+// OrderID indicates an expected call of OrderID.
+func (mr *MockUMModifyOrderHistoryServiceAPIMockRecorder) OrderID(orderID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OrderID", reflect.TypeOf((*MockUMModifyOrderHistoryServiceAPI)(nil).OrderID), orderID)
+}
+
+// OrigClientOrderID mocks base method.
+func (m *MockUMModifyOrderHistoryServiceAPI) OrigClientOrderID(origClientOrderID string) portfolio.UMModifyOrderHistoryServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OrigClientOrderID", origClientOrderID)
+	ret0, _ := ret[0].(portfolio.UMModifyOrderHistoryServiceAPI)
+	return ret0
+}
+
+// OrigClientOrderID indicates an expected call of OrigClientOrderID.
+func (mr *MockUMModifyOrderHistoryServiceAPIMockRecorder) OrigClientOrderID(origClientOrderID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OrigClientOrderID", reflect.TypeOf((*MockUMModifyOrderHistoryServiceAPI)(nil).OrigClientOrderID), origClientOrderID)
+}
+
+// RecvWindow mocks base method.
+func (m *MockUMModifyOrderHistoryServiceAPI) RecvWindow(recvWindow int64) portfolio.UMModifyOrderHistoryServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecvWindow", recvWindow)
+	ret0, _ := ret[0].(portfolio.UMModifyOrderHistoryServiceAPI)
+	return ret0
+}
+
+// RecvWindow indicates an expected call of RecvWindow.
+func (mr *MockUMModifyOrderHistoryServiceAPIMockRecorder) RecvWindow(recvWindow interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvWindow", reflect.TypeOf((*MockUMModifyOrderHistoryServiceAPI)(nil).RecvWindow), recvWindow)
+}
+
+// StartTime mocks base method.
+func (m *MockUMModifyOrderHistoryServiceAPI) StartTime(startTime int64) portfolio.UMModifyOrderHistoryServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartTime", startTime)
+	ret0, _ := ret[0].(portfolio.UMModifyOrderHistoryServiceAPI)
+	return ret0
+}
+
+// StartTime indicates an expected call of StartTime.
+func (mr *MockUMModifyOrderHistoryServiceAPIMockRecorder) StartTime(startTime interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartTime", reflect.TypeOf((*MockUMModifyOrderHistoryServiceAPI)(nil).StartTime), startTime)
+}
+
+// Symbol mocks base method.
+func (m *MockUMModifyOrderHistoryServiceAPI) Symbol(symbol string) portfolio.UMModifyOrderHistoryServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Symbol", symbol)
+	ret0, _ := ret[0].(portfolio.UMModifyOrderHistoryServiceAPI)
+	return ret0
+}
+
+// Symbol indicates an expected call of Symbol.
+func (mr *MockUMModifyOrderHistoryServiceAPIMockRecorder) Symbol(symbol interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Symbol", reflect.TypeOf((*MockUMModifyOrderHistoryServiceAPI)(nil).Symbol), symbol)
+}
+
+// MockCMModifyOrderHistoryServiceAPI is a mock of CMModifyOrderHistoryServiceAPI interface.
+type MockCMModifyOrderHistoryServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockCMModifyOrderHistoryServiceAPIMockRecorder
+}
+
+// MockCMModifyOrderHistoryServiceAPIMockRecorder is the mock recorder for MockCMModifyOrderHistoryServiceAPI.
+type MockCMModifyOrderHistoryServiceAPIMockRecorder struct {
+	mock *MockCMModifyOrderHistoryServiceAPI
+}
+
+// NewMockCMModifyOrderHistoryServiceAPI creates a new mock instance.
+func NewMockCMModifyOrderHistoryServiceAPI(ctrl *gomock.Controller) *MockCMModifyOrderHistoryServiceAPI {
+	mock := &MockCMModifyOrderHistoryServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockCMModifyOrderHistoryServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCMModifyOrderHistoryServiceAPI) EXPECT() *MockCMModifyOrderHistoryServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockCMModifyOrderHistoryServiceAPI) Do(ctx context.Context) ([]*portfolio.CMModifyOrderHistoryResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Do", ctx)
+	ret0, _ := ret[0].([]*portfolio.CMModifyOrderHistoryResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockCMModifyOrderHistoryServiceAPIMockRecorder) Do(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockCMModifyOrderHistoryServiceAPI)(nil).Do), ctx)
+}
+
+// EndTime mocks base method.
+func (m *MockCMModifyOrderHistoryServiceAPI) EndTime(endTime int64) portfolio.CMModifyOrderHistoryServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EndTime", endTime)
+	ret0, _ := ret[0].(portfolio.CMModifyOrderHistoryServiceAPI)
+	return ret0
+}
+
+// EndTime indicates an expected call of EndTime.
+func (mr *MockCMModifyOrderHistoryServiceAPIMockRecorder) EndTime(endTime interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EndTime", reflect.TypeOf((*MockCMModifyOrderHistoryServiceAPI)(nil).EndTime), endTime)
+}
+
+// Limit mocks base method.
+func (m *MockCMModifyOrderHistoryServiceAPI) Limit(limit int) portfolio.CMModifyOrderHistoryServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Limit", limit)
+	ret0, _ := ret[0].(portfolio.CMModifyOrderHistoryServiceAPI)
+	return ret0
+}
+
+// Limit indicates an expected call of Limit.
+func (mr *MockCMModifyOrderHistoryServiceAPIMockRecorder) Limit(limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Limit", reflect.TypeOf((*MockCMModifyOrderHistoryServiceAPI)(nil).Limit), limit)
+}
+
+// OrderID mocks base method.
+func (m *MockCMModifyOrderHistoryServiceAPI) OrderID(orderID int64) portfolio.CMModifyOrderHistoryServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OrderID", orderID)
+	ret0, _ := ret[0].(portfolio.CMModifyOrderHistoryServiceAPI)
+	return ret0
+}
+
+// OrderID indicates an expected call of OrderID.
+func (mr *MockCMModifyOrderHistoryServiceAPIMockRecorder) OrderID(orderID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OrderID", reflect.TypeOf((*MockCMModifyOrderHistoryServiceAPI)(nil).OrderID), orderID)
+}
+
+// OrigClientOrderID mocks base method.
+func (m *MockCMModifyOrderHistoryServiceAPI) OrigClientOrderID(origClientOrderID string) portfolio.CMModifyOrderHistoryServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OrigClientOrderID", origClientOrderID)
+	ret0, _ := ret[0].(portfolio.CMModifyOrderHistoryServiceAPI)
+	return ret0
+}
+
+// OrigClientOrderID indicates an expected call of OrigClientOrderID.
+func (mr *MockCMModifyOrderHistoryServiceAPIMockRecorder) OrigClientOrderID(origClientOrderID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OrigClientOrderID", reflect.TypeOf((*MockCMModifyOrderHistoryServiceAPI)(nil).OrigClientOrderID), origClientOrderID)
+}
+
+// RecvWindow mocks base method.
+func (m *MockCMModifyOrderHistoryServiceAPI) RecvWindow(recvWindow int64) portfolio.CMModifyOrderHistoryServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecvWindow", recvWindow)
+	ret0, _ := ret[0].(portfolio.CMModifyOrderHistoryServiceAPI)
+	return ret0
+}
+
+// RecvWindow indicates an expected call of RecvWindow.
+func (mr *MockCMModifyOrderHistoryServiceAPIMockRecorder) RecvWindow(recvWindow interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvWindow", reflect.TypeOf((*MockCMModifyOrderHistoryServiceAPI)(nil).RecvWindow), recvWindow)
+}
+
+// StartTime mocks base method.
+func (m *MockCMModifyOrderHistoryServiceAPI) StartTime(startTime int64) portfolio.CMModifyOrderHistoryServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartTime", startTime)
+	ret0, _ := ret[0].(portfolio.CMModifyOrderHistoryServiceAPI)
+	return ret0
+}
+
+// StartTime indicates an expected call of StartTime.
+func (mr *MockCMModifyOrderHistoryServiceAPIMockRecorder) StartTime(startTime interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartTime", reflect.TypeOf((*MockCMModifyOrderHistoryServiceAPI)(nil).StartTime), startTime)
+}
+
+// Symbol mocks base method.
+func (m *MockCMModifyOrderHistoryServiceAPI) Symbol(symbol string) portfolio.CMModifyOrderHistoryServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Symbol", symbol)
+	ret0, _ := ret[0].(portfolio.CMModifyOrderHistoryServiceAPI)
+	return ret0
+}
+
+// Symbol indicates an expected call of Symbol.
+func (mr *MockCMModifyOrderHistoryServiceAPIMockRecorder) Symbol(symbol interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Symbol", reflect.TypeOf((*MockCMModifyOrderHistoryServiceAPI)(nil).Symbol), symbol)
+}
+
+// MockMarginForceOrdersServiceAPI is a mock of MarginForceOrdersServiceAPI interface.
+type MockMarginForceOrdersServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockMarginForceOrdersServiceAPIMockRecorder
+}
+
+// MockMarginForceOrdersServiceAPIMockRecorder is the mock recorder for MockMarginForceOrdersServiceAPI.
+type MockMarginForceOrdersServiceAPIMockRecorder struct {
+	mock *MockMarginForceOrdersServiceAPI
+}
+
+// NewMockMarginForceOrdersServiceAPI creates a new mock instance.
+func NewMockMarginForceOrdersServiceAPI(ctrl *gomock.Controller) *MockMarginForceOrdersServiceAPI {
+	mock := &MockMarginForceOrdersServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockMarginForceOrdersServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockMarginForceOrdersServiceAPI) EXPECT() *MockMarginForceOrdersServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Current mocks base method.
+func (m *MockMarginForceOrdersServiceAPI) Current(current int64) portfolio.MarginForceOrdersServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Current", current)
+	ret0, _ := ret[0].(portfolio.MarginForceOrdersServiceAPI)
+	return ret0
+}
+
+// Current indicates an expected call of Current.
+func (mr *MockMarginForceOrdersServiceAPIMockRecorder) Current(current interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Current", reflect.TypeOf((*MockMarginForceOrdersServiceAPI)(nil).Current), current)
+}
+
+// Do mocks base method.
+func (m *MockMarginForceOrdersServiceAPI) Do(ctx context.Context) (*portfolio.MarginForceOrdersResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Do", ctx)
+	ret0, _ := ret[0].(*portfolio.MarginForceOrdersResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockMarginForceOrdersServiceAPIMockRecorder) Do(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockMarginForceOrdersServiceAPI)(nil).Do), ctx)
+}
+
+// EndTime mocks base method.
+func (m *MockMarginForceOrdersServiceAPI) EndTime(endTime int64) portfolio.MarginForceOrdersServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EndTime", endTime)
+	ret0, _ := ret[0].(portfolio.MarginForceOrdersServiceAPI)
+	return ret0
+}
+
+// EndTime indicates an expected call of EndTime.
+func (mr *MockMarginForceOrdersServiceAPIMockRecorder) EndTime(endTime interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EndTime", reflect.TypeOf((*MockMarginForceOrdersServiceAPI)(nil).EndTime), endTime)
+}
+
+// RecvWindow mocks base method.
+func (m *MockMarginForceOrdersServiceAPI) RecvWindow(recvWindow int64) portfolio.MarginForceOrdersServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecvWindow", recvWindow)
+	ret0, _ := ret[0].(portfolio.MarginForceOrdersServiceAPI)
+	return ret0
+}
+
+// RecvWindow indicates an expected call of RecvWindow.
+func (mr *MockMarginForceOrdersServiceAPIMockRecorder) RecvWindow(recvWindow interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvWindow", reflect.TypeOf((*MockMarginForceOrdersServiceAPI)(nil).RecvWindow), recvWindow)
+}
+
+// Size mocks base method.
+func (m *MockMarginForceOrdersServiceAPI) Size(size int64) portfolio.MarginForceOrdersServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Size", size)
+	ret0, _ := ret[0].(portfolio.MarginForceOrdersServiceAPI)
+	return ret0
+}
+
+// Size indicates an expected call of Size.
+func (mr *MockMarginForceOrdersServiceAPIMockRecorder) Size(size interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Size", reflect.TypeOf((*MockMarginForceOrdersServiceAPI)(nil).Size), size)
+}
+
+// StartTime mocks base method.
+func (m *MockMarginForceOrdersServiceAPI) StartTime(startTime int64) portfolio.MarginForceOrdersServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartTime", startTime)
+	ret0, _ := ret[0].(portfolio.MarginForceOrdersServiceAPI)
+	return ret0
+}
+
+// StartTime indicates an expected call of StartTime.
+func (mr *MockMarginForceOrdersServiceAPIMockRecorder) StartTime(startTime interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartTime", reflect.TypeOf((*MockMarginForceOrdersServiceAPI)(nil).StartTime), startTime)
+}
+
+// MockUMAccountTradeServiceAPI is a mock of UMAccountTradeServiceAPI interface.
+type MockUMAccountTradeServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockUMAccountTradeServiceAPIMockRecorder
+}
+
+// MockUMAccountTradeServiceAPIMockRecorder is the mock recorder for MockUMAccountTradeServiceAPI.
+type MockUMAccountTradeServiceAPIMockRecorder struct {
+	mock *MockUMAccountTradeServiceAPI
+}
+
+// NewMockUMAccountTradeServiceAPI creates a new mock instance.
+func NewMockUMAccountTradeServiceAPI(ctrl *gomock.Controller) *MockUMAccountTradeServiceAPI {
+	mock := &MockUMAccountTradeServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockUMAccountTradeServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUMAccountTradeServiceAPI) EXPECT() *MockUMAccountTradeServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockUMAccountTradeServiceAPI) Do(ctx context.Context) ([]*portfolio.UMAccountTrade, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Do", ctx)
+	ret0, _ := ret[0].([]*portfolio.UMAccountTrade)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockUMAccountTradeServiceAPIMockRecorder) Do(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockUMAccountTradeServiceAPI)(nil).Do), ctx)
+}
+
+// EndTime mocks base method.
+func (m *MockUMAccountTradeServiceAPI) EndTime(endTime int64) portfolio.UMAccountTradeServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EndTime", endTime)
+	ret0, _ := ret[0].(portfolio.UMAccountTradeServiceAPI)
+	return ret0
+}
+
+// EndTime indicates an expected call of EndTime.
+func (mr *MockUMAccountTradeServiceAPIMockRecorder) EndTime(endTime interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EndTime", reflect.TypeOf((*MockUMAccountTradeServiceAPI)(nil).EndTime), endTime)
+}
+
+// FromID mocks base method.
+func (m *MockUMAccountTradeServiceAPI) FromID(fromID int64) portfolio.UMAccountTradeServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FromID", fromID)
+	ret0, _ := ret[0].(portfolio.UMAccountTradeServiceAPI)
+	return ret0
+}
+
+// FromID indicates an expected call of FromID.
+func (mr *MockUMAccountTradeServiceAPIMockRecorder) FromID(fromID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FromID", reflect.TypeOf((*MockUMAccountTradeServiceAPI)(nil).FromID), fromID)
+}
+
+// Limit mocks base method.
+func (m *MockUMAccountTradeServiceAPI) Limit(limit int) portfolio.UMAccountTradeServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Limit", limit)
+	ret0, _ := ret[0].(portfolio.UMAccountTradeServiceAPI)
+	return ret0
+}
+
+// Limit indicates an expected call of Limit.
+func (mr *MockUMAccountTradeServiceAPIMockRecorder) Limit(limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Limit", reflect.TypeOf((*MockUMAccountTradeServiceAPI)(nil).Limit), limit)
+}
+
+// RecvWindow mocks base method.
+func (m *MockUMAccountTradeServiceAPI) RecvWindow(recvWindow int64) portfolio.UMAccountTradeServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecvWindow", recvWindow)
+	ret0, _ := ret[0].(portfolio.UMAccountTradeServiceAPI)
+	return ret0
+}
+
+// RecvWindow indicates an expected call of RecvWindow.
+func (mr *MockUMAccountTradeServiceAPIMockRecorder) RecvWindow(recvWindow interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvWindow", reflect.TypeOf((*MockUMAccountTradeServiceAPI)(nil).RecvWindow), recvWindow)
+}
+
+// StartTime mocks base method.
+func (m *MockUMAccountTradeServiceAPI) StartTime(startTime int64) portfolio.UMAccountTradeServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartTime", startTime)
+	ret0, _ := ret[0].(portfolio.UMAccountTradeServiceAPI)
+	return ret0
+}
+
+// StartTime indicates an expected call of StartTime.
+func (mr *MockUMAccountTradeServiceAPIMockRecorder) StartTime(startTime interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartTime", reflect.TypeOf((*MockUMAccountTradeServiceAPI)(nil).StartTime), startTime)
+}
+
+// Symbol mocks base method.
+func (m *MockUMAccountTradeServiceAPI) Symbol(symbol string) portfolio.UMAccountTradeServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Symbol", symbol)
+	ret0, _ := ret[0].(portfolio.UMAccountTradeServiceAPI)
+	return ret0
+}
+
+// Symbol indicates an expected call of Symbol.
+func (mr *MockUMAccountTradeServiceAPIMockRecorder) Symbol(symbol interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Symbol", reflect.TypeOf((*MockUMAccountTradeServiceAPI)(nil).Symbol), symbol)
+}
+
+// MockCMAccountTradeServiceAPI is a mock of CMAccountTradeServiceAPI interface.
+type MockCMAccountTradeServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockCMAccountTradeServiceAPIMockRecorder
+}
+
+// MockCMAccountTradeServiceAPIMockRecorder is the mock recorder for MockCMAccountTradeServiceAPI.
+type MockCMAccountTradeServiceAPIMockRecorder struct {
+	mock *MockCMAccountTradeServiceAPI
+}
+
+// NewMockCMAccountTradeServiceAPI creates a new mock instance.
+func NewMockCMAccountTradeServiceAPI(ctrl *gomock.Controller) *MockCMAccountTradeServiceAPI {
+	mock := &MockCMAccountTradeServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockCMAccountTradeServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCMAccountTradeServiceAPI) EXPECT() *MockCMAccountTradeServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockCMAccountTradeServiceAPI) Do(ctx context.Context) ([]*portfolio.CMAccountTrade, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Do", ctx)
+	ret0, _ := ret[0].([]*portfolio.CMAccountTrade)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockCMAccountTradeServiceAPIMockRecorder) Do(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockCMAccountTradeServiceAPI)(nil).Do), ctx)
+}
+
+// EndTime mocks base method.
+func (m *MockCMAccountTradeServiceAPI) EndTime(endTime int64) portfolio.CMAccountTradeServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EndTime", endTime)
+	ret0, _ := ret[0].(portfolio.CMAccountTradeServiceAPI)
+	return ret0
+}
+
+// EndTime indicates an expected call of EndTime.
+func (mr *MockCMAccountTradeServiceAPIMockRecorder) EndTime(endTime interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EndTime", reflect.TypeOf((*MockCMAccountTradeServiceAPI)(nil).EndTime), endTime)
+}
+
+// FromID mocks base method.
+func (m *MockCMAccountTradeServiceAPI) FromID(fromID int64) portfolio.CMAccountTradeServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FromID", fromID)
+	ret0, _ := ret[0].(portfolio.CMAccountTradeServiceAPI)
+	return ret0
+}
+
+// FromID indicates an expected call of FromID.
+func (mr *MockCMAccountTradeServiceAPIMockRecorder) FromID(fromID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FromID", reflect.TypeOf((*MockCMAccountTradeServiceAPI)(nil).FromID), fromID)
+}
+
+// Limit mocks base method.
+func (m *MockCMAccountTradeServiceAPI) Limit(limit int) portfolio.CMAccountTradeServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Limit", limit)
+	ret0, _ := ret[0].(portfolio.CMAccountTradeServiceAPI)
+	return ret0
+}
+
+// Limit indicates an expected call of Limit.
+func (mr *MockCMAccountTradeServiceAPIMockRecorder) Limit(limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Limit", reflect.TypeOf((*MockCMAccountTradeServiceAPI)(nil).Limit), limit)
+}
+
+// Pair mocks base method.
+func (m *MockCMAccountTradeServiceAPI) Pair(pair string) portfolio.CMAccountTradeServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Pair", pair)
+	ret0, _ := ret[0].(portfolio.CMAccountTradeServiceAPI)
+	return ret0
+}
+
+// Pair indicates an expected call of Pair.
+func (mr *MockCMAccountTradeServiceAPIMockRecorder) Pair(pair interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Pair", reflect.TypeOf((*MockCMAccountTradeServiceAPI)(nil).Pair), pair)
+}
+
+// RecvWindow mocks base method.
+func (m *MockCMAccountTradeServiceAPI) RecvWindow(recvWindow int64) portfolio.CMAccountTradeServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecvWindow", recvWindow)
+	ret0, _ := ret[0].(portfolio.CMAccountTradeServiceAPI)
+	return ret0
+}
+
+// RecvWindow indicates an expected call of RecvWindow.
+func (mr *MockCMAccountTradeServiceAPIMockRecorder) RecvWindow(recvWindow interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvWindow", reflect.TypeOf((*MockCMAccountTradeServiceAPI)(nil).RecvWindow), recvWindow)
+}
+
+// StartTime mocks base method.
+func (m *MockCMAccountTradeServiceAPI) StartTime(startTime int64) portfolio.CMAccountTradeServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartTime", startTime)
+	ret0, _ := ret[0].(portfolio.CMAccountTradeServiceAPI)
+	return ret0
+}
+
+// StartTime indicates an expected call of StartTime.
+func (mr *MockCMAccountTradeServiceAPIMockRecorder) StartTime(startTime interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartTime", reflect.TypeOf((*MockCMAccountTradeServiceAPI)(nil).StartTime), startTime)
+}
+
+// Symbol mocks base method.
+func (m *MockCMAccountTradeServiceAPI) Symbol(symbol string) portfolio.CMAccountTradeServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Symbol", symbol)
+	ret0, _ := ret[0].(portfolio.CMAccountTradeServiceAPI)
+	return ret0
+}
+
+// Symbol indicates an expected call of Symbol.
+func (mr *MockCMAccountTradeServiceAPIMockRecorder) Symbol(symbol interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Symbol", reflect.TypeOf((*MockCMAccountTradeServiceAPI)(nil).Symbol), symbol)
+}
+
+// MockUMADLQuantileServiceAPI is a mock of UMADLQuantileServiceAPI interface.
+type MockUMADLQuantileServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockUMADLQuantileServiceAPIMockRecorder
+}
+
+// MockUMADLQuantileServiceAPIMockRecorder is the mock recorder for MockUMADLQuantileServiceAPI.
+type MockUMADLQuantileServiceAPIMockRecorder struct {
+	mock *MockUMADLQuantileServiceAPI
+}
+
+// NewMockUMADLQuantileServiceAPI creates a new mock instance.
+func NewMockUMADLQuantileServiceAPI(ctrl *gomock.Controller) *MockUMADLQuantileServiceAPI {
+	mock := &MockUMADLQuantileServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockUMADLQuantileServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUMADLQuantileServiceAPI) EXPECT() *MockUMADLQuantileServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockUMADLQuantileServiceAPI) Do(ctx context.Context) ([]*portfolio.UMADLQuantileResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Do", ctx)
+	ret0, _ := ret[0].([]*portfolio.UMADLQuantileResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockUMADLQuantileServiceAPIMockRecorder) Do(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockUMADLQuantileServiceAPI)(nil).Do), ctx)
+}
+
+// RecvWindow mocks base method.
+func (m *MockUMADLQuantileServiceAPI) RecvWindow(recvWindow int64) portfolio.UMADLQuantileServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecvWindow", recvWindow)
+	ret0, _ := ret[0].(portfolio.UMADLQuantileServiceAPI)
+	return ret0
+}
+
+// RecvWindow indicates an expected call of RecvWindow.
+func (mr *MockUMADLQuantileServiceAPIMockRecorder) RecvWindow(recvWindow interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvWindow", reflect.TypeOf((*MockUMADLQuantileServiceAPI)(nil).RecvWindow), recvWindow)
+}
+
+// Symbol mocks base method.
+func (m *MockUMADLQuantileServiceAPI) Symbol(symbol string) portfolio.UMADLQuantileServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Symbol", symbol)
+	ret0, _ := ret[0].(portfolio.UMADLQuantileServiceAPI)
+	return ret0
+}
+
+// Symbol indicates an expected call of Symbol.
+func (mr *MockUMADLQuantileServiceAPIMockRecorder) Symbol(symbol interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Symbol", reflect.TypeOf((*MockUMADLQuantileServiceAPI)(nil).Symbol), symbol)
+}
+
+// MockCMADLQuantileServiceAPI is a mock of CMADLQuantileServiceAPI interface.
+type MockCMADLQuantileServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockCMADLQuantileServiceAPIMockRecorder
+}
+
+// MockCMADLQuantileServiceAPIMockRecorder is the mock recorder for MockCMADLQuantileServiceAPI.
+type MockCMADLQuantileServiceAPIMockRecorder struct {
+	mock *MockCMADLQuantileServiceAPI
+}
+
+// NewMockCMADLQuantileServiceAPI creates a new mock instance.
+func NewMockCMADLQuantileServiceAPI(ctrl *gomock.Controller) *MockCMADLQuantileServiceAPI {
+	mock := &MockCMADLQuantileServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockCMADLQuantileServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCMADLQuantileServiceAPI) EXPECT() *MockCMADLQuantileServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockCMADLQuantileServiceAPI) Do(ctx context.Context) ([]*portfolio.CMADLQuantileResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Do", ctx)
+	ret0, _ := ret[0].([]*portfolio.CMADLQuantileResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockCMADLQuantileServiceAPIMockRecorder) Do(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockCMADLQuantileServiceAPI)(nil).Do), ctx)
+}
+
+// RecvWindow mocks base method.
+func (m *MockCMADLQuantileServiceAPI) RecvWindow(recvWindow int64) portfolio.CMADLQuantileServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecvWindow", recvWindow)
+	ret0, _ := ret[0].(portfolio.CMADLQuantileServiceAPI)
+	return ret0
+}
+
+// RecvWindow indicates an expected call of RecvWindow.
+func (mr *MockCMADLQuantileServiceAPIMockRecorder) RecvWindow(recvWindow interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvWindow", reflect.TypeOf((*MockCMADLQuantileServiceAPI)(nil).RecvWindow), recvWindow)
+}
+
+// Symbol mocks base method.
+func (m *MockCMADLQuantileServiceAPI) Symbol(symbol string) portfolio.CMADLQuantileServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Symbol", symbol)
+	ret0, _ := ret[0].(portfolio.CMADLQuantileServiceAPI)
+	return ret0
+}
+
+// Symbol indicates an expected call of Symbol.
+func (mr *MockCMADLQuantileServiceAPIMockRecorder) Symbol(symbol interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Symbol", reflect.TypeOf((*MockCMADLQuantileServiceAPI)(nil).Symbol), symbol)
+}
+
+// MockUMFeeBurnServiceAPI is a mock of UMFeeBurnServiceAPI interface.
+type MockUMFeeBurnServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockUMFeeBurnServiceAPIMockRecorder
+}
+
+// MockUMFeeBurnServiceAPIMockRecorder is the mock recorder for MockUMFeeBurnServiceAPI.
+type MockUMFeeBurnServiceAPIMockRecorder struct {
+	mock *MockUMFeeBurnServiceAPI
+}
+
+// NewMockUMFeeBurnServiceAPI creates a new mock instance.
+func NewMockUMFeeBurnServiceAPI(ctrl *gomock.Controller) *MockUMFeeBurnServiceAPI {
+	mock := &MockUMFeeBurnServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockUMFeeBurnServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUMFeeBurnServiceAPI) EXPECT() *MockUMFeeBurnServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockUMFeeBurnServiceAPI) Do(ctx context.Context) (*portfolio.UMFeeBurnResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Do", ctx)
+	ret0, _ := ret[0].(*portfolio.UMFeeBurnResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockUMFeeBurnServiceAPIMockRecorder) Do(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockUMFeeBurnServiceAPI)(nil).Do), ctx)
+}
+
+// FeeBurn mocks base method.
+func (m *MockUMFeeBurnServiceAPI) FeeBurn(feeBurn bool) portfolio.UMFeeBurnServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FeeBurn", feeBurn)
+	ret0, _ := ret[0].(portfolio.UMFeeBurnServiceAPI)
+	return ret0
+}
+
+// FeeBurn indicates an expected call of FeeBurn.
+func (mr *MockUMFeeBurnServiceAPIMockRecorder) FeeBurn(feeBurn interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FeeBurn", reflect.TypeOf((*MockUMFeeBurnServiceAPI)(nil).FeeBurn), feeBurn)
+}
+
+// RecvWindow mocks base method.
+func (m *MockUMFeeBurnServiceAPI) RecvWindow(recvWindow int64) portfolio.UMFeeBurnServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecvWindow", recvWindow)
+	ret0, _ := ret[0].(portfolio.UMFeeBurnServiceAPI)
+	return ret0
+}
+
+// RecvWindow indicates an expected call of RecvWindow.
+func (mr *MockUMFeeBurnServiceAPIMockRecorder) RecvWindow(recvWindow interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvWindow", reflect.TypeOf((*MockUMFeeBurnServiceAPI)(nil).RecvWindow), recvWindow)
+}
+
+// MockGetMarginOpenOrdersServiceAPI is a mock of GetMarginOpenOrdersServiceAPI interface.
+type MockGetMarginOpenOrdersServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockGetMarginOpenOrdersServiceAPIMockRecorder
+}
+
+// MockGetMarginOpenOrdersServiceAPIMockRecorder is the mock recorder for MockGetMarginOpenOrdersServiceAPI.
+type MockGetMarginOpenOrdersServiceAPIMockRecorder struct {
+	mock *MockGetMarginOpenOrdersServiceAPI
+}
+
+// NewMockGetMarginOpenOrdersServiceAPI creates a new mock instance.
+func NewMockGetMarginOpenOrdersServiceAPI(ctrl *gomock.Controller) *MockGetMarginOpenOrdersServiceAPI {
+	mock := &MockGetMarginOpenOrdersServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockGetMarginOpenOrdersServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGetMarginOpenOrdersServiceAPI) EXPECT() *MockGetMarginOpenOrdersServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockGetMarginOpenOrdersServiceAPI) Do(ctx context.Context) ([]*portfolio.MarginOrder, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Do", ctx)
+	ret0, _ := ret[0].([]*portfolio.MarginOrder)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockGetMarginOpenOrdersServiceAPIMockRecorder) Do(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockGetMarginOpenOrdersServiceAPI)(nil).Do), ctx)
+}
+
+// RecvWindow mocks base method.
+func (m *MockGetMarginOpenOrdersServiceAPI) RecvWindow(recvWindow int64) portfolio.GetMarginOpenOrdersServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecvWindow", recvWindow)
+	ret0, _ := ret[0].(portfolio.GetMarginOpenOrdersServiceAPI)
+	return ret0
+}
+
+// RecvWindow indicates an expected call of RecvWindow.
+func (mr *MockGetMarginOpenOrdersServiceAPIMockRecorder) RecvWindow(recvWindow interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvWindow", reflect.TypeOf((*MockGetMarginOpenOrdersServiceAPI)(nil).RecvWindow), recvWindow)
+}
+
+// Symbol mocks base method.
+func (m *MockGetMarginOpenOrdersServiceAPI) Symbol(symbol string) portfolio.GetMarginOpenOrdersServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Symbol", symbol)
+	ret0, _ := ret[0].(portfolio.GetMarginOpenOrdersServiceAPI)
+	return ret0
+}
+
+// Symbol indicates an expected call of Symbol.
+func (mr *MockGetMarginOpenOrdersServiceAPIMockRecorder) Symbol(symbol interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Symbol", reflect.TypeOf((*MockGetMarginOpenOrdersServiceAPI)(nil).Symbol), symbol)
+}
+
+// MockGetMarginAllOrdersServiceAPI is a mock of GetMarginAllOrdersServiceAPI interface.
+type MockGetMarginAllOrdersServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockGetMarginAllOrdersServiceAPIMockRecorder
+}
+
+// MockGetMarginAllOrdersServiceAPIMockRecorder is the mock recorder for MockGetMarginAllOrdersServiceAPI.
+type MockGetMarginAllOrdersServiceAPIMockRecorder struct {
+	mock *MockGetMarginAllOrdersServiceAPI
+}
+
+// NewMockGetMarginAllOrdersServiceAPI creates a new mock instance.
+func NewMockGetMarginAllOrdersServiceAPI(ctrl *gomock.Controller) *MockGetMarginAllOrdersServiceAPI {
+	mock := &MockGetMarginAllOrdersServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockGetMarginAllOrdersServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGetMarginAllOrdersServiceAPI) EXPECT() *MockGetMarginAllOrdersServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockGetMarginAllOrdersServiceAPI) Do(ctx context.Context) ([]*portfolio.MarginOrder, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Do", ctx)
+	ret0, _ := ret[0].([]*portfolio.MarginOrder)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockGetMarginAllOrdersServiceAPIMockRecorder) Do(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockGetMarginAllOrdersServiceAPI)(nil).Do), ctx)
+}
+
+// EndTime mocks base method.
+func (m *MockGetMarginAllOrdersServiceAPI) EndTime(endTime int64) portfolio.GetMarginAllOrdersServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EndTime", endTime)
+	ret0, _ := ret[0].(portfolio.GetMarginAllOrdersServiceAPI)
+	return ret0
+}
+
+// EndTime indicates an expected call of EndTime.
+func (mr *MockGetMarginAllOrdersServiceAPIMockRecorder) EndTime(endTime interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EndTime", reflect.TypeOf((*MockGetMarginAllOrdersServiceAPI)(nil).EndTime), endTime)
+}
+
+// Limit mocks base method.
+func (m *MockGetMarginAllOrdersServiceAPI) Limit(limit int) portfolio.GetMarginAllOrdersServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Limit", limit)
+	ret0, _ := ret[0].(portfolio.GetMarginAllOrdersServiceAPI)
+	return ret0
+}
+
+// Limit indicates an expected call of Limit.
+func (mr *MockGetMarginAllOrdersServiceAPIMockRecorder) Limit(limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Limit", reflect.TypeOf((*MockGetMarginAllOrdersServiceAPI)(nil).Limit), limit)
+}
+
+// OrderID mocks base method.
+func (m *MockGetMarginAllOrdersServiceAPI) OrderID(orderID int64) portfolio.GetMarginAllOrdersServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OrderID", orderID)
+	ret0, _ := ret[0].(portfolio.GetMarginAllOrdersServiceAPI)
+	return ret0
+}
+
+// OrderID indicates an expected call of OrderID.
+func (mr *MockGetMarginAllOrdersServiceAPIMockRecorder) OrderID(orderID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OrderID", reflect.TypeOf((*MockGetMarginAllOrdersServiceAPI)(nil).OrderID), orderID)
+}
+
+// RecvWindow mocks base method.
+func (m *MockGetMarginAllOrdersServiceAPI) RecvWindow(recvWindow int64) portfolio.GetMarginAllOrdersServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecvWindow", recvWindow)
+	ret0, _ := ret[0].(portfolio.GetMarginAllOrdersServiceAPI)
+	return ret0
+}
+
+// RecvWindow indicates an expected call of RecvWindow.
+func (mr *MockGetMarginAllOrdersServiceAPIMockRecorder) RecvWindow(recvWindow interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvWindow", reflect.TypeOf((*MockGetMarginAllOrdersServiceAPI)(nil).RecvWindow), recvWindow)
+}
+
+// StartTime mocks base method.
+func (m *MockGetMarginAllOrdersServiceAPI) StartTime(startTime int64) portfolio.GetMarginAllOrdersServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartTime", startTime)
+	ret0, _ := ret[0].(portfolio.GetMarginAllOrdersServiceAPI)
+	return ret0
+}
+
+// StartTime indicates an expected call of StartTime.
+func (mr *MockGetMarginAllOrdersServiceAPIMockRecorder) StartTime(startTime interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartTime", reflect.TypeOf((*MockGetMarginAllOrdersServiceAPI)(nil).StartTime), startTime)
+}
+
+// Symbol mocks base method.
+func (m *MockGetMarginAllOrdersServiceAPI) Symbol(symbol string) portfolio.GetMarginAllOrdersServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Symbol", symbol)
+	ret0, _ := ret[0].(portfolio.GetMarginAllOrdersServiceAPI)
+	return ret0
+}
+
+// Symbol indicates an expected call of Symbol.
+func (mr *MockGetMarginAllOrdersServiceAPIMockRecorder) Symbol(symbol interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Symbol", reflect.TypeOf((*MockGetMarginAllOrdersServiceAPI)(nil).Symbol), symbol)
+}
+
+// MockUMFeeBurnStatusServiceAPI is a mock of UMFeeBurnStatusServiceAPI interface.
+type MockUMFeeBurnStatusServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockUMFeeBurnStatusServiceAPIMockRecorder
+}
+
+// MockUMFeeBurnStatusServiceAPIMockRecorder is the mock recorder for MockUMFeeBurnStatusServiceAPI.
+type MockUMFeeBurnStatusServiceAPIMockRecorder struct {
+	mock *MockUMFeeBurnStatusServiceAPI
+}
+
+// NewMockUMFeeBurnStatusServiceAPI creates a new mock instance.
+func NewMockUMFeeBurnStatusServiceAPI(ctrl *gomock.Controller) *MockUMFeeBurnStatusServiceAPI {
+	mock := &MockUMFeeBurnStatusServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockUMFeeBurnStatusServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUMFeeBurnStatusServiceAPI) EXPECT() *MockUMFeeBurnStatusServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockUMFeeBurnStatusServiceAPI) Do(ctx context.Context) (*portfolio.UMFeeBurnStatusResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Do", ctx)
+	ret0, _ := ret[0].(*portfolio.UMFeeBurnStatusResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockUMFeeBurnStatusServiceAPIMockRecorder) Do(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockUMFeeBurnStatusServiceAPI)(nil).Do), ctx)
+}
+
+// RecvWindow mocks base method.
+func (m *MockUMFeeBurnStatusServiceAPI) RecvWindow(recvWindow int64) portfolio.UMFeeBurnStatusServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecvWindow", recvWindow)
+	ret0, _ := ret[0].(portfolio.UMFeeBurnStatusServiceAPI)
+	return ret0
+}
+
+// RecvWindow indicates an expected call of RecvWindow.
+func (mr *MockUMFeeBurnStatusServiceAPIMockRecorder) RecvWindow(recvWindow interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvWindow", reflect.TypeOf((*MockUMFeeBurnStatusServiceAPI)(nil).RecvWindow), recvWindow)
+}
+
+// MockGetMarginForceOrdersServiceAPI is a mock of GetMarginForceOrdersServiceAPI interface.
+type MockGetMarginForceOrdersServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockGetMarginForceOrdersServiceAPIMockRecorder
+}
+
+// MockGetMarginForceOrdersServiceAPIMockRecorder is the mock recorder for MockGetMarginForceOrdersServiceAPI.
+type MockGetMarginForceOrdersServiceAPIMockRecorder struct {
+	mock *MockGetMarginForceOrdersServiceAPI
+}
+
+// NewMockGetMarginForceOrdersServiceAPI creates a new mock instance.
+func NewMockGetMarginForceOrdersServiceAPI(ctrl *gomock.Controller) *MockGetMarginForceOrdersServiceAPI {
+	mock := &MockGetMarginForceOrdersServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockGetMarginForceOrdersServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGetMarginForceOrdersServiceAPI) EXPECT() *MockGetMarginForceOrdersServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Current mocks base method.
+func (m *MockGetMarginForceOrdersServiceAPI) Current(current int64) portfolio.GetMarginForceOrdersServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Current", current)
+	ret0, _ := ret[0].(portfolio.GetMarginForceOrdersServiceAPI)
+	return ret0
+}
+
+// Current indicates an expected call of Current.
+func (mr *MockGetMarginForceOrdersServiceAPIMockRecorder) Current(current interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Current", reflect.TypeOf((*MockGetMarginForceOrdersServiceAPI)(nil).Current), current)
+}
+
+// Do mocks base method.
+func (m *MockGetMarginForceOrdersServiceAPI) Do(ctx context.Context) (*portfolio.MarginForceOrdersResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Do", ctx)
+	ret0, _ := ret[0].(*portfolio.MarginForceOrdersResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockGetMarginForceOrdersServiceAPIMockRecorder) Do(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockGetMarginForceOrdersServiceAPI)(nil).Do), ctx)
+}
+
+// EndTime mocks base method.
+func (m *MockGetMarginForceOrdersServiceAPI) EndTime(endTime int64) portfolio.GetMarginForceOrdersServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EndTime", endTime)
+	ret0, _ := ret[0].(portfolio.GetMarginForceOrdersServiceAPI)
+	return ret0
+}
+
+// EndTime indicates an expected call of EndTime.
+func (mr *MockGetMarginForceOrdersServiceAPIMockRecorder) EndTime(endTime interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EndTime", reflect.TypeOf((*MockGetMarginForceOrdersServiceAPI)(nil).EndTime), endTime)
+}
+
+// RecvWindow mocks base method.
+func (m *MockGetMarginForceOrdersServiceAPI) RecvWindow(recvWindow int64) portfolio.GetMarginForceOrdersServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecvWindow", recvWindow)
+	ret0, _ := ret[0].(portfolio.GetMarginForceOrdersServiceAPI)
+	return ret0
+}
+
+// RecvWindow indicates an expected call of RecvWindow.
+func (mr *MockGetMarginForceOrdersServiceAPIMockRecorder) RecvWindow(recvWindow interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvWindow", reflect.TypeOf((*MockGetMarginForceOrdersServiceAPI)(nil).RecvWindow), recvWindow)
+}
+
+// Size mocks base method.
+func (m *MockGetMarginForceOrdersServiceAPI) Size(size int64) portfolio.GetMarginForceOrdersServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Size", size)
+	ret0, _ := ret[0].(portfolio.GetMarginForceOrdersServiceAPI)
+	return ret0
+}
+
+// Size indicates an expected call of Size.
+func (mr *MockGetMarginForceOrdersServiceAPIMockRecorder) Size(size interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Size", reflect.TypeOf((*MockGetMarginForceOrdersServiceAPI)(nil).Size), size)
+}
+
+// StartTime mocks base method.
+func (m *MockGetMarginForceOrdersServiceAPI) StartTime(startTime int64) portfolio.GetMarginForceOrdersServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartTime", startTime)
+	ret0, _ := ret[0].(portfolio.GetMarginForceOrdersServiceAPI)
+	return ret0
+}
+
+// StartTime indicates an expected call of StartTime.
+func (mr *MockGetMarginForceOrdersServiceAPIMockRecorder) StartTime(startTime interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartTime", reflect.TypeOf((*MockGetMarginForceOrdersServiceAPI)(nil).StartTime), startTime)
+}
+
+// MockUMAccountTradesServiceAPI is a mock of UMAccountTradesServiceAPI interface.
+type MockUMAccountTradesServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockUMAccountTradesServiceAPIMockRecorder
+}
+
+// MockUMAccountTradesServiceAPIMockRecorder is the mock recorder for MockUMAccountTradesServiceAPI.
+type MockUMAccountTradesServiceAPIMockRecorder struct {
+	mock *MockUMAccountTradesServiceAPI
+}
+
+// NewMockUMAccountTradesServiceAPI creates a new mock instance.
+func NewMockUMAccountTradesServiceAPI(ctrl *gomock.Controller) *MockUMAccountTradesServiceAPI {
+	mock := &MockUMAccountTradesServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockUMAccountTradesServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUMAccountTradesServiceAPI) EXPECT() *MockUMAccountTradesServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockUMAccountTradesServiceAPI) Do(ctx context.Context) ([]*portfolio.UMAccountTrade, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Do", ctx)
+	ret0, _ := ret[0].([]*portfolio.UMAccountTrade)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockUMAccountTradesServiceAPIMockRecorder) Do(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockUMAccountTradesServiceAPI)(nil).Do), ctx)
+}
+
+// EndTime mocks base method.
+func (m *MockUMAccountTradesServiceAPI) EndTime(endTime int64) portfolio.UMAccountTradesServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EndTime", endTime)
+	ret0, _ := ret[0].(portfolio.UMAccountTradesServiceAPI)
+	return ret0
+}
+
+// EndTime indicates an expected call of EndTime.
+func (mr *MockUMAccountTradesServiceAPIMockRecorder) EndTime(endTime interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EndTime", reflect.TypeOf((*MockUMAccountTradesServiceAPI)(nil).EndTime), endTime)
+}
+
+// FromID mocks base method.
+func (m *MockUMAccountTradesServiceAPI) FromID(fromID int64) portfolio.UMAccountTradesServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FromID", fromID)
+	ret0, _ := ret[0].(portfolio.UMAccountTradesServiceAPI)
+	return ret0
+}
+
+// FromID indicates an expected call of FromID.
+func (mr *MockUMAccountTradesServiceAPIMockRecorder) FromID(fromID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FromID", reflect.TypeOf((*MockUMAccountTradesServiceAPI)(nil).FromID), fromID)
+}
+
+// Limit mocks base method.
+func (m *MockUMAccountTradesServiceAPI) Limit(limit int) portfolio.UMAccountTradesServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Limit", limit)
+	ret0, _ := ret[0].(portfolio.UMAccountTradesServiceAPI)
+	return ret0
+}
+
+// Limit indicates an expected call of Limit.
+func (mr *MockUMAccountTradesServiceAPIMockRecorder) Limit(limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Limit", reflect.TypeOf((*MockUMAccountTradesServiceAPI)(nil).Limit), limit)
+}
+
+// RecvWindow mocks base method.
+func (m *MockUMAccountTradesServiceAPI) RecvWindow(recvWindow int64) portfolio.UMAccountTradesServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecvWindow", recvWindow)
+	ret0, _ := ret[0].(portfolio.UMAccountTradesServiceAPI)
+	return ret0
+}
+
+// RecvWindow indicates an expected call of RecvWindow.
+func (mr *MockUMAccountTradesServiceAPIMockRecorder) RecvWindow(recvWindow interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvWindow", reflect.TypeOf((*MockUMAccountTradesServiceAPI)(nil).RecvWindow), recvWindow)
+}
+
+// StartTime mocks base method.
+func (m *MockUMAccountTradesServiceAPI) StartTime(startTime int64) portfolio.UMAccountTradesServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartTime", startTime)
+	ret0, _ := ret[0].(portfolio.UMAccountTradesServiceAPI)
+	return ret0
+}
+
+// StartTime indicates an expected call of StartTime.
+func (mr *MockUMAccountTradesServiceAPIMockRecorder) StartTime(startTime interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartTime", reflect.TypeOf((*MockUMAccountTradesServiceAPI)(nil).StartTime), startTime)
+}
+
+// Symbol mocks base method.
+func (m *MockUMAccountTradesServiceAPI) Symbol(symbol string) portfolio.UMAccountTradesServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Symbol", symbol)
+	ret0, _ := ret[0].(portfolio.UMAccountTradesServiceAPI)
+	return ret0
+}
+
+// Symbol indicates an expected call of Symbol.
+func (mr *MockUMAccountTradesServiceAPIMockRecorder) Symbol(symbol interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Symbol", reflect.TypeOf((*MockUMAccountTradesServiceAPI)(nil).Symbol), symbol)
+}
+
+// MockCMAccountTradesServiceAPI is a mock of CMAccountTradesServiceAPI interface.
+type MockCMAccountTradesServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockCMAccountTradesServiceAPIMockRecorder
+}
+
+// MockCMAccountTradesServiceAPIMockRecorder is the mock recorder for MockCMAccountTradesServiceAPI.
+type MockCMAccountTradesServiceAPIMockRecorder struct {
+	mock *MockCMAccountTradesServiceAPI
+}
+
+// NewMockCMAccountTradesServiceAPI creates a new mock instance.
+func NewMockCMAccountTradesServiceAPI(ctrl *gomock.Controller) *MockCMAccountTradesServiceAPI {
+	mock := &MockCMAccountTradesServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockCMAccountTradesServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCMAccountTradesServiceAPI) EXPECT() *MockCMAccountTradesServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockCMAccountTradesServiceAPI) Do(ctx context.Context) ([]*portfolio.CMAccountTrade, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Do", ctx)
+	ret0, _ := ret[0].([]*portfolio.CMAccountTrade)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockCMAccountTradesServiceAPIMockRecorder) Do(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockCMAccountTradesServiceAPI)(nil).Do), ctx)
+}
+
+// EndTime mocks base method.
+func (m *MockCMAccountTradesServiceAPI) EndTime(endTime int64) portfolio.CMAccountTradesServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EndTime", endTime)
+	ret0, _ := ret[0].(portfolio.CMAccountTradesServiceAPI)
+	return ret0
+}
+
+// EndTime indicates an expected call of EndTime.
+func (mr *MockCMAccountTradesServiceAPIMockRecorder) EndTime(endTime interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EndTime", reflect.TypeOf((*MockCMAccountTradesServiceAPI)(nil).EndTime), endTime)
+}
+
+// FromID mocks base method.
+func (m *MockCMAccountTradesServiceAPI) FromID(fromID int64) portfolio.CMAccountTradesServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FromID", fromID)
+	ret0, _ := ret[0].(portfolio.CMAccountTradesServiceAPI)
+	return ret0
+}
+
+// FromID indicates an expected call of FromID.
+func (mr *MockCMAccountTradesServiceAPIMockRecorder) FromID(fromID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FromID", reflect.TypeOf((*MockCMAccountTradesServiceAPI)(nil).FromID), fromID)
+}
+
+// Limit mocks base method.
+func (m *MockCMAccountTradesServiceAPI) Limit(limit int) portfolio.CMAccountTradesServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Limit", limit)
+	ret0, _ := ret[0].(portfolio.CMAccountTradesServiceAPI)
+	return ret0
+}
+
+// Limit indicates an expected call of Limit.
+func (mr *MockCMAccountTradesServiceAPIMockRecorder) Limit(limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Limit", reflect.TypeOf((*MockCMAccountTradesServiceAPI)(nil).Limit), limit)
+}
+
+// Pair mocks base method.
+func (m *MockCMAccountTradesServiceAPI) Pair(pair string) portfolio.CMAccountTradesServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Pair", pair)
+	ret0, _ := ret[0].(portfolio.CMAccountTradesServiceAPI)
+	return ret0
+}
+
+// Pair indicates an expected call of Pair.
+func (mr *MockCMAccountTradesServiceAPIMockRecorder) Pair(pair interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Pair", reflect.TypeOf((*MockCMAccountTradesServiceAPI)(nil).Pair), pair)
+}
+
+// RecvWindow mocks base method.
+func (m *MockCMAccountTradesServiceAPI) RecvWindow(recvWindow int64) portfolio.CMAccountTradesServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecvWindow", recvWindow)
+	ret0, _ := ret[0].(portfolio.CMAccountTradesServiceAPI)
+	return ret0
+}
+
+// RecvWindow indicates an expected call of RecvWindow.
+func (mr *MockCMAccountTradesServiceAPIMockRecorder) RecvWindow(recvWindow interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvWindow", reflect.TypeOf((*MockCMAccountTradesServiceAPI)(nil).RecvWindow), recvWindow)
+}
+
+// StartTime mocks base method.
+func (m *MockCMAccountTradesServiceAPI) StartTime(startTime int64) portfolio.CMAccountTradesServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartTime", startTime)
+	ret0, _ := ret[0].(portfolio.CMAccountTradesServiceAPI)
+	return ret0
+}
+
+// StartTime indicates an expected call of StartTime.
+func (mr *MockCMAccountTradesServiceAPIMockRecorder) StartTime(startTime interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartTime", reflect.TypeOf((*MockCMAccountTradesServiceAPI)(nil).StartTime), startTime)
+}
+
+// Symbol mocks base method.
+func (m *MockCMAccountTradesServiceAPI) Symbol(symbol string) portfolio.CMAccountTradesServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Symbol", symbol)
+	ret0, _ := ret[0].(portfolio.CMAccountTradesServiceAPI)
+	return ret0
+}
+
+// Symbol indicates an expected call of Symbol.
+func (mr *MockCMAccountTradesServiceAPIMockRecorder) Symbol(symbol interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Symbol", reflect.TypeOf((*MockCMAccountTradesServiceAPI)(nil).Symbol), symbol)
+}
+
+// MockUMGetADLQuantileServiceAPI is a mock of UMGetADLQuantileServiceAPI interface.
+type MockUMGetADLQuantileServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockUMGetADLQuantileServiceAPIMockRecorder
+}
+
+// MockUMGetADLQuantileServiceAPIMockRecorder is the mock recorder for MockUMGetADLQuantileServiceAPI.
+type MockUMGetADLQuantileServiceAPIMockRecorder struct {
+	mock *MockUMGetADLQuantileServiceAPI
+}
+
+// NewMockUMGetADLQuantileServiceAPI creates a new mock instance.
+func NewMockUMGetADLQuantileServiceAPI(ctrl *gomock.Controller) *MockUMGetADLQuantileServiceAPI {
+	mock := &MockUMGetADLQuantileServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockUMGetADLQuantileServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUMGetADLQuantileServiceAPI) EXPECT() *MockUMGetADLQuantileServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockUMGetADLQuantileServiceAPI) Do(ctx context.Context) ([]*portfolio.UMADLQuantileResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Do", ctx)
+	ret0, _ := ret[0].([]*portfolio.UMADLQuantileResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockUMGetADLQuantileServiceAPIMockRecorder) Do(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockUMGetADLQuantileServiceAPI)(nil).Do), ctx)
+}
+
+// RecvWindow mocks base method.
+func (m *MockUMGetADLQuantileServiceAPI) RecvWindow(recvWindow int64) portfolio.UMGetADLQuantileServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecvWindow", recvWindow)
+	ret0, _ := ret[0].(portfolio.UMGetADLQuantileServiceAPI)
+	return ret0
+}
+
+// RecvWindow indicates an expected call of RecvWindow.
+func (mr *MockUMGetADLQuantileServiceAPIMockRecorder) RecvWindow(recvWindow interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvWindow", reflect.TypeOf((*MockUMGetADLQuantileServiceAPI)(nil).RecvWindow), recvWindow)
+}
+
+// Symbol mocks base method.
+func (m *MockUMGetADLQuantileServiceAPI) Symbol(symbol string) portfolio.UMGetADLQuantileServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Symbol", symbol)
+	ret0, _ := ret[0].(portfolio.UMGetADLQuantileServiceAPI)
+	return ret0
+}
+
+// Symbol indicates an expected call of Symbol.
+func (mr *MockUMGetADLQuantileServiceAPIMockRecorder) Symbol(symbol interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Symbol", reflect.TypeOf((*MockUMGetADLQuantileServiceAPI)(nil).Symbol), symbol)
+}
+
+// MockMarginOCOQueryServiceAPI is a mock of MarginOCOQueryServiceAPI interface.
+type MockMarginOCOQueryServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockMarginOCOQueryServiceAPIMockRecorder
+}
+
+// MockMarginOCOQueryServiceAPIMockRecorder is the mock recorder for MockMarginOCOQueryServiceAPI.
+type MockMarginOCOQueryServiceAPIMockRecorder struct {
+	mock *MockMarginOCOQueryServiceAPI
+}
+
+// NewMockMarginOCOQueryServiceAPI creates a new mock instance.
+func NewMockMarginOCOQueryServiceAPI(ctrl *gomock.Controller) *MockMarginOCOQueryServiceAPI {
+	mock := &MockMarginOCOQueryServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockMarginOCOQueryServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockMarginOCOQueryServiceAPI) EXPECT() *MockMarginOCOQueryServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockMarginOCOQueryServiceAPI) Do(ctx context.Context, opts ...portfolio.RequestOption) (*portfolio.MarginOCOResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*portfolio.MarginOCOResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockMarginOCOQueryServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockMarginOCOQueryServiceAPI)(nil).Do), varargs...)
+}
+
+// OrderListID mocks base method.
+func (m *MockMarginOCOQueryServiceAPI) OrderListID(orderListID int64) portfolio.MarginOCOQueryServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OrderListID", orderListID)
+	ret0, _ := ret[0].(portfolio.MarginOCOQueryServiceAPI)
+	return ret0
+}
+
+// OrderListID indicates an expected call of OrderListID.
+func (mr *MockMarginOCOQueryServiceAPIMockRecorder) OrderListID(orderListID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OrderListID", reflect.TypeOf((*MockMarginOCOQueryServiceAPI)(nil).OrderListID), orderListID)
+}
+
+// OrigClientOrderID mocks base method.
+func (m *MockMarginOCOQueryServiceAPI) OrigClientOrderID(origClientOrderID string) portfolio.MarginOCOQueryServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OrigClientOrderID", origClientOrderID)
+	ret0, _ := ret[0].(portfolio.MarginOCOQueryServiceAPI)
+	return ret0
+}
+
+// OrigClientOrderID indicates an expected call of OrigClientOrderID.
+func (mr *MockMarginOCOQueryServiceAPIMockRecorder) OrigClientOrderID(origClientOrderID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OrigClientOrderID", reflect.TypeOf((*MockMarginOCOQueryServiceAPI)(nil).OrigClientOrderID), origClientOrderID)
+}
+
+// MockMarginOpenOCOServiceAPI is a mock of MarginOpenOCOServiceAPI interface.
+type MockMarginOpenOCOServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockMarginOpenOCOServiceAPIMockRecorder
+}
+
+// MockMarginOpenOCOServiceAPIMockRecorder is the mock recorder for MockMarginOpenOCOServiceAPI.
+type MockMarginOpenOCOServiceAPIMockRecorder struct {
+	mock *MockMarginOpenOCOServiceAPI
+}
+
+// NewMockMarginOpenOCOServiceAPI creates a new mock instance.
+func NewMockMarginOpenOCOServiceAPI(ctrl *gomock.Controller) *MockMarginOpenOCOServiceAPI {
+	mock := &MockMarginOpenOCOServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockMarginOpenOCOServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockMarginOpenOCOServiceAPI) EXPECT() *MockMarginOpenOCOServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockMarginOpenOCOServiceAPI) Do(ctx context.Context) ([]*portfolio.MarginOCOResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Do", ctx)
+	ret0, _ := ret[0].([]*portfolio.MarginOCOResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockMarginOpenOCOServiceAPIMockRecorder) Do(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockMarginOpenOCOServiceAPI)(nil).Do), ctx)
+}
+
+// RecvWindow mocks base method.
+func (m *MockMarginOpenOCOServiceAPI) RecvWindow(recvWindow int64) portfolio.MarginOpenOCOServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecvWindow", recvWindow)
+	ret0, _ := ret[0].(portfolio.MarginOpenOCOServiceAPI)
+	return ret0
+}
+
+// RecvWindow indicates an expected call of RecvWindow.
+func (mr *MockMarginOpenOCOServiceAPIMockRecorder) RecvWindow(recvWindow interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvWindow", reflect.TypeOf((*MockMarginOpenOCOServiceAPI)(nil).RecvWindow), recvWindow)
+}
+
+// MockMarginAccountTradesServiceAPI is a mock of MarginAccountTradesServiceAPI interface.
+type MockMarginAccountTradesServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockMarginAccountTradesServiceAPIMockRecorder
+}
+
+// MockMarginAccountTradesServiceAPIMockRecorder is the mock recorder for MockMarginAccountTradesServiceAPI.
+type MockMarginAccountTradesServiceAPIMockRecorder struct {
+	mock *MockMarginAccountTradesServiceAPI
+}
+
+// NewMockMarginAccountTradesServiceAPI creates a new mock instance.
+func NewMockMarginAccountTradesServiceAPI(ctrl *gomock.Controller) *MockMarginAccountTradesServiceAPI {
+	mock := &MockMarginAccountTradesServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockMarginAccountTradesServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockMarginAccountTradesServiceAPI) EXPECT() *MockMarginAccountTradesServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockMarginAccountTradesServiceAPI) Do(ctx context.Context) ([]*portfolio.MarginTrade, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Do", ctx)
+	ret0, _ := ret[0].([]*portfolio.MarginTrade)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockMarginAccountTradesServiceAPIMockRecorder) Do(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockMarginAccountTradesServiceAPI)(nil).Do), ctx)
+}
+
+// EndTime mocks base method.
+func (m *MockMarginAccountTradesServiceAPI) EndTime(endTime int64) portfolio.MarginAccountTradesServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EndTime", endTime)
+	ret0, _ := ret[0].(portfolio.MarginAccountTradesServiceAPI)
+	return ret0
+}
+
+// EndTime indicates an expected call of EndTime.
+func (mr *MockMarginAccountTradesServiceAPIMockRecorder) EndTime(endTime interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EndTime", reflect.TypeOf((*MockMarginAccountTradesServiceAPI)(nil).EndTime), endTime)
+}
+
+// FromID mocks base method.
+func (m *MockMarginAccountTradesServiceAPI) FromID(fromID int64) portfolio.MarginAccountTradesServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FromID", fromID)
+	ret0, _ := ret[0].(portfolio.MarginAccountTradesServiceAPI)
+	return ret0
+}
+
+// FromID indicates an expected call of FromID.
+func (mr *MockMarginAccountTradesServiceAPIMockRecorder) FromID(fromID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FromID", reflect.TypeOf((*MockMarginAccountTradesServiceAPI)(nil).FromID), fromID)
+}
+
+// Limit mocks base method.
+func (m *MockMarginAccountTradesServiceAPI) Limit(limit int) portfolio.MarginAccountTradesServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Limit", limit)
+	ret0, _ := ret[0].(portfolio.MarginAccountTradesServiceAPI)
+	return ret0
+}
+
+// Limit indicates an expected call of Limit.
+func (mr *MockMarginAccountTradesServiceAPIMockRecorder) Limit(limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Limit", reflect.TypeOf((*MockMarginAccountTradesServiceAPI)(nil).Limit), limit)
+}
+
+// OrderID mocks base method.
+func (m *MockMarginAccountTradesServiceAPI) OrderID(orderID int64) portfolio.MarginAccountTradesServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OrderID", orderID)
+	ret0, _ := ret[0].(portfolio.MarginAccountTradesServiceAPI)
+	return ret0
+}
+
+// OrderID indicates an expected call of OrderID.
+func (mr *MockMarginAccountTradesServiceAPIMockRecorder) OrderID(orderID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OrderID", reflect.TypeOf((*MockMarginAccountTradesServiceAPI)(nil).OrderID), orderID)
+}
+
+// RecvWindow mocks base method.
+func (m *MockMarginAccountTradesServiceAPI) RecvWindow(recvWindow int64) portfolio.MarginAccountTradesServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecvWindow", recvWindow)
+	ret0, _ := ret[0].(portfolio.MarginAccountTradesServiceAPI)
+	return ret0
+}
+
+// RecvWindow indicates an expected call of RecvWindow.
+func (mr *MockMarginAccountTradesServiceAPIMockRecorder) RecvWindow(recvWindow interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvWindow", reflect.TypeOf((*MockMarginAccountTradesServiceAPI)(nil).RecvWindow), recvWindow)
+}
+
+// StartTime mocks base method.
+func (m *MockMarginAccountTradesServiceAPI) StartTime(startTime int64) portfolio.MarginAccountTradesServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartTime", startTime)
+	ret0, _ := ret[0].(portfolio.MarginAccountTradesServiceAPI)
+	return ret0
+}
+
+// StartTime indicates an expected call of StartTime.
+func (mr *MockMarginAccountTradesServiceAPIMockRecorder) StartTime(startTime interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartTime", reflect.TypeOf((*MockMarginAccountTradesServiceAPI)(nil).StartTime), startTime)
+}
+
+// Symbol mocks base method.
+func (m *MockMarginAccountTradesServiceAPI) Symbol(symbol string) portfolio.MarginAccountTradesServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Symbol", symbol)
+	ret0, _ := ret[0].(portfolio.MarginAccountTradesServiceAPI)
+	return ret0
+}
+
+// Symbol indicates an expected call of Symbol.
+func (mr *MockMarginAccountTradesServiceAPIMockRecorder) Symbol(symbol interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Symbol", reflect.TypeOf((*MockMarginAccountTradesServiceAPI)(nil).Symbol), symbol)
+}
+
+// MockMarginRepayDebtServiceAPI is a mock of MarginRepayDebtServiceAPI interface.
+type MockMarginRepayDebtServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockMarginRepayDebtServiceAPIMockRecorder
+}
+
+// MockMarginRepayDebtServiceAPIMockRecorder is the mock recorder for MockMarginRepayDebtServiceAPI.
+type MockMarginRepayDebtServiceAPIMockRecorder struct {
+	mock *MockMarginRepayDebtServiceAPI
+}
+
+// NewMockMarginRepayDebtServiceAPI creates a new mock instance.
+func NewMockMarginRepayDebtServiceAPI(ctrl *gomock.Controller) *MockMarginRepayDebtServiceAPI {
+	mock := &MockMarginRepayDebtServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockMarginRepayDebtServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockMarginRepayDebtServiceAPI) EXPECT() *MockMarginRepayDebtServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Amount mocks base method.
+func (m *MockMarginRepayDebtServiceAPI) Amount(amount string) portfolio.MarginRepayDebtServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Amount", amount)
+	ret0, _ := ret[0].(portfolio.MarginRepayDebtServiceAPI)
+	return ret0
+}
+
+// Amount indicates an expected call of Amount.
+func (mr *MockMarginRepayDebtServiceAPIMockRecorder) Amount(amount interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Amount", reflect.TypeOf((*MockMarginRepayDebtServiceAPI)(nil).Amount), amount)
+}
+
+// Asset mocks base method.
+func (m *MockMarginRepayDebtServiceAPI) Asset(asset string) portfolio.MarginRepayDebtServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Asset", asset)
+	ret0, _ := ret[0].(portfolio.MarginRepayDebtServiceAPI)
+	return ret0
+}
+
+// Asset indicates an expected call of Asset.
+func (mr *MockMarginRepayDebtServiceAPIMockRecorder) Asset(asset interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Asset", reflect.TypeOf((*MockMarginRepayDebtServiceAPI)(nil).Asset), asset)
+}
+
+// Do mocks base method.
+func (m *MockMarginRepayDebtServiceAPI) Do(ctx context.Context) (*portfolio.MarginRepayDebtResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Do", ctx)
+	ret0, _ := ret[0].(*portfolio.MarginRepayDebtResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockMarginRepayDebtServiceAPIMockRecorder) Do(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockMarginRepayDebtServiceAPI)(nil).Do), ctx)
+}
+
+// RecvWindow mocks base method.
+func (m *MockMarginRepayDebtServiceAPI) RecvWindow(recvWindow int64) portfolio.MarginRepayDebtServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecvWindow", recvWindow)
+	ret0, _ := ret[0].(portfolio.MarginRepayDebtServiceAPI)
+	return ret0
+}
+
+// RecvWindow indicates an expected call of RecvWindow.
+func (mr *MockMarginRepayDebtServiceAPIMockRecorder) RecvWindow(recvWindow interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvWindow", reflect.TypeOf((*MockMarginRepayDebtServiceAPI)(nil).RecvWindow), recvWindow)
+}
+
+// SpecifyRepayAssets mocks base method.
+func (m *MockMarginRepayDebtServiceAPI) SpecifyRepayAssets(assets string) portfolio.MarginRepayDebtServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SpecifyRepayAssets", assets)
+	ret0, _ := ret[0].(portfolio.MarginRepayDebtServiceAPI)
+	return ret0
+}
+
+// SpecifyRepayAssets indicates an expected call of SpecifyRepayAssets.
+func (mr *MockMarginRepayDebtServiceAPIMockRecorder) SpecifyRepayAssets(assets interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SpecifyRepayAssets", reflect.TypeOf((*MockMarginRepayDebtServiceAPI)(nil).SpecifyRepayAssets), assets)
+}
+
+// MockMarginAllOCOServiceAPI is a mock of MarginAllOCOServiceAPI interface.
+type MockMarginAllOCOServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockMarginAllOCOServiceAPIMockRecorder
+}
+
+// MockMarginAllOCOServiceAPIMockRecorder is the mock recorder for MockMarginAllOCOServiceAPI.
+type MockMarginAllOCOServiceAPIMockRecorder struct {
+	mock *MockMarginAllOCOServiceAPI
+}
+
+// NewMockMarginAllOCOServiceAPI creates a new mock instance.
+func NewMockMarginAllOCOServiceAPI(ctrl *gomock.Controller) *MockMarginAllOCOServiceAPI {
+	mock := &MockMarginAllOCOServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockMarginAllOCOServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockMarginAllOCOServiceAPI) EXPECT() *MockMarginAllOCOServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockMarginAllOCOServiceAPI) Do(ctx context.Context) ([]*portfolio.MarginOCOResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Do", ctx)
+	ret0, _ := ret[0].([]*portfolio.MarginOCOResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockMarginAllOCOServiceAPIMockRecorder) Do(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockMarginAllOCOServiceAPI)(nil).Do), ctx)
+}
+
+// EndTime mocks base method.
+func (m *MockMarginAllOCOServiceAPI) EndTime(endTime int64) portfolio.MarginAllOCOServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EndTime", endTime)
+	ret0, _ := ret[0].(portfolio.MarginAllOCOServiceAPI)
+	return ret0
+}
+
+// EndTime indicates an expected call of EndTime.
+func (mr *MockMarginAllOCOServiceAPIMockRecorder) EndTime(endTime interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EndTime", reflect.TypeOf((*MockMarginAllOCOServiceAPI)(nil).EndTime), endTime)
+}
+
+// FromID mocks base method.
+func (m *MockMarginAllOCOServiceAPI) FromID(fromID int64) portfolio.MarginAllOCOServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FromID", fromID)
+	ret0, _ := ret[0].(portfolio.MarginAllOCOServiceAPI)
+	return ret0
+}
+
+// FromID indicates an expected call of FromID.
+func (mr *MockMarginAllOCOServiceAPIMockRecorder) FromID(fromID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FromID", reflect.TypeOf((*MockMarginAllOCOServiceAPI)(nil).FromID), fromID)
+}
+
+// Limit mocks base method.
+func (m *MockMarginAllOCOServiceAPI) Limit(limit int) portfolio.MarginAllOCOServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Limit", limit)
+	ret0, _ := ret[0].(portfolio.MarginAllOCOServiceAPI)
+	return ret0
+}
+
+// Limit indicates an expected call of Limit.
+func (mr *MockMarginAllOCOServiceAPIMockRecorder) Limit(limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Limit", reflect.TypeOf((*MockMarginAllOCOServiceAPI)(nil).Limit), limit)
+}
+
+// RecvWindow mocks base method.
+func (m *MockMarginAllOCOServiceAPI) RecvWindow(recvWindow int64) portfolio.MarginAllOCOServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecvWindow", recvWindow)
+	ret0, _ := ret[0].(portfolio.MarginAllOCOServiceAPI)
+	return ret0
+}
+
+// RecvWindow indicates an expected call of RecvWindow.
+func (mr *MockMarginAllOCOServiceAPIMockRecorder) RecvWindow(recvWindow interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvWindow", reflect.TypeOf((*MockMarginAllOCOServiceAPI)(nil).RecvWindow), recvWindow)
+}
+
+// StartTime mocks base method.
+func (m *MockMarginAllOCOServiceAPI) StartTime(startTime int64) portfolio.MarginAllOCOServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartTime", startTime)
+	ret0, _ := ret[0].(portfolio.MarginAllOCOServiceAPI)
+	return ret0
+}
+
+// StartTime indicates an expected call of StartTime.
+func (mr *MockMarginAllOCOServiceAPIMockRecorder) StartTime(startTime interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartTime", reflect.TypeOf((*MockMarginAllOCOServiceAPI)(nil).StartTime), startTime)
+}
+
+// MockStartUserStreamServiceAPI is a mock of StartUserStreamServiceAPI interface.
+type MockStartUserStreamServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockStartUserStreamServiceAPIMockRecorder
+}
+
+// MockStartUserStreamServiceAPIMockRecorder is the mock recorder for MockStartUserStreamServiceAPI.
+type MockStartUserStreamServiceAPIMockRecorder struct {
+	mock *MockStartUserStreamServiceAPI
+}
+
+// NewMockStartUserStreamServiceAPI creates a new mock instance.
+func NewMockStartUserStreamServiceAPI(ctrl *gomock.Controller) *MockStartUserStreamServiceAPI {
+	mock := &MockStartUserStreamServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockStartUserStreamServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockStartUserStreamServiceAPI) EXPECT() *MockStartUserStreamServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockStartUserStreamServiceAPI) Do(ctx context.Context, opts ...portfolio.RequestOption) (string, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockStartUserStreamServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockStartUserStreamServiceAPI)(nil).Do), varargs...)
+}
+
+// MockKeepaliveUserStreamServiceAPI is a mock of KeepaliveUserStreamServiceAPI interface.
+type MockKeepaliveUserStreamServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockKeepaliveUserStreamServiceAPIMockRecorder
+}
+
+// MockKeepaliveUserStreamServiceAPIMockRecorder is the mock recorder for MockKeepaliveUserStreamServiceAPI.
+type MockKeepaliveUserStreamServiceAPIMockRecorder struct {
+	mock *MockKeepaliveUserStreamServiceAPI
+}
+
+// NewMockKeepaliveUserStreamServiceAPI creates a new mock instance.
+func NewMockKeepaliveUserStreamServiceAPI(ctrl *gomock.Controller) *MockKeepaliveUserStreamServiceAPI {
+	mock := &MockKeepaliveUserStreamServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockKeepaliveUserStreamServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockKeepaliveUserStreamServiceAPI) EXPECT() *MockKeepaliveUserStreamServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockKeepaliveUserStreamServiceAPI) Do(ctx context.Context, opts ...portfolio.RequestOption) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockKeepaliveUserStreamServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockKeepaliveUserStreamServiceAPI)(nil).Do), varargs...)
+}
+
+// ListenKey mocks base method.
+func (m *MockKeepaliveUserStreamServiceAPI) ListenKey(listenKey string) portfolio.KeepaliveUserStreamServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListenKey", listenKey)
+	ret0, _ := ret[0].(portfolio.KeepaliveUserStreamServiceAPI)
+	return ret0
+}
+
+// ListenKey indicates an expected call of ListenKey.
+func (mr *MockKeepaliveUserStreamServiceAPIMockRecorder) ListenKey(listenKey interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListenKey", reflect.TypeOf((*MockKeepaliveUserStreamServiceAPI)(nil).ListenKey), listenKey)
+}
+
+// MockCloseUserStreamServiceAPI is a mock of CloseUserStreamServiceAPI interface.
+type MockCloseUserStreamServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockCloseUserStreamServiceAPIMockRecorder
+}
+
+// MockCloseUserStreamServiceAPIMockRecorder is the mock recorder for MockCloseUserStreamServiceAPI.
+type MockCloseUserStreamServiceAPIMockRecorder struct {
+	mock *MockCloseUserStreamServiceAPI
+}
+
+// NewMockCloseUserStreamServiceAPI creates a new mock instance.
+func NewMockCloseUserStreamServiceAPI(ctrl *gomock.Controller) *MockCloseUserStreamServiceAPI {
+	mock := &MockCloseUserStreamServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockCloseUserStreamServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCloseUserStreamServiceAPI) EXPECT() *MockCloseUserStreamServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockCloseUserStreamServiceAPI) Do(ctx context.Context, opts ...portfolio.RequestOption) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockCloseUserStreamServiceAPIMockRecorder) Do(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockCloseUserStreamServiceAPI)(nil).Do), varargs...)
+}
+
+// ListenKey mocks base method.
+func (m *MockCloseUserStreamServiceAPI) ListenKey(listenKey string) portfolio.CloseUserStreamServiceAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListenKey", listenKey)
+	ret0, _ := ret[0].(portfolio.CloseUserStreamServiceAPI)
+	return ret0
+}
+
+// ListenKey indicates an expected call of ListenKey.
+func (mr *MockCloseUserStreamServiceAPIMockRecorder) ListenKey(listenKey interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListenKey", reflect.TypeOf((*MockCloseUserStreamServiceAPI)(nil).ListenKey), listenKey)
+}
@@ -0,0 +1,3583 @@
+// Code generated by servicegen. DO NOT EDIT.
+
+package portfolio
+
+import (
+	"context"
+)
+
+// ClientAPI is the interface of the REST services of Client, see NewClientAPI
+type ClientAPI interface {
+	NewPingService() PingServiceAPI
+	NewGetBalanceService() GetBalanceServiceAPI
+	NewGetAccountService() GetAccountServiceAPI
+	NewGetMarginMaxBorrowService() GetMarginMaxBorrowServiceAPI
+	NewGetMarginMaxWithdrawService() GetMarginMaxWithdrawServiceAPI
+	NewGetUMPositionRiskService() GetUMPositionRiskServiceAPI
+	NewGetCMPositionRiskService() GetCMPositionRiskServiceAPI
+	NewChangeUMInitialLeverageService() ChangeUMInitialLeverageServiceAPI
+	NewChangeCMInitialLeverageService() ChangeCMInitialLeverageServiceAPI
+	NewChangeUMPositionModeService() ChangeUMPositionModeServiceAPI
+	NewChangeCMPositionModeService() ChangeCMPositionModeServiceAPI
+	NewGetUMPositionModeService() GetUMPositionModeServiceAPI
+	NewGetCMPositionModeService() GetCMPositionModeServiceAPI
+	NewGetUMLeverageBracketService() GetUMLeverageBracketServiceAPI
+	NewGetCMLeverageBracketService() GetCMLeverageBracketServiceAPI
+	NewGetUMTradingStatusService() GetUMTradingStatusServiceAPI
+	NewGetUMCommissionRateService() GetUMCommissionRateServiceAPI
+	NewGetCMCommissionRateService() GetCMCommissionRateServiceAPI
+	NewGetMarginLoanService() GetMarginLoanServiceAPI
+	NewGetMarginRepayService() GetMarginRepayServiceAPI
+	NewGetAutoRepayFuturesStatusService() GetAutoRepayFuturesStatusServiceAPI
+	NewChangeAutoRepayFuturesStatusService() ChangeAutoRepayFuturesStatusServiceAPI
+	NewGetMarginInterestHistoryService() GetMarginInterestHistoryServiceAPI
+	NewRepayFuturesNegativeBalanceService() RepayFuturesNegativeBalanceServiceAPI
+	NewGetNegativeBalanceInterestHistoryService() GetNegativeBalanceInterestHistoryServiceAPI
+	NewFundAutoCollectionService() FundAutoCollectionServiceAPI
+	NewFundCollectionByAssetService() FundCollectionByAssetServiceAPI
+	NewBNBTransferService() BNBTransferServiceAPI
+	NewGetUMIncomeHistoryService() GetUMIncomeHistoryServiceAPI
+	NewGetCMIncomeHistoryService() GetCMIncomeHistoryServiceAPI
+	NewGetUMAccountDetailService() GetUMAccountDetailServiceAPI
+	NewGetCMAccountDetailService() GetCMAccountDetailServiceAPI
+	NewGetUMAccountConfigService() UMAccountConfigServiceAPI
+	NewGetUMSymbolConfigService() UMSymbolConfigServiceAPI
+	NewGetUMAccountDetailV2Service() UMAccountDetailV2ServiceAPI
+	NewGetUMTradeHistoryDownloadIDService() GetUMTradeHistoryDownloadIDServiceAPI
+	NewGetUMTradeDownloadLinkService() GetUMTradeDownloadLinkServiceAPI
+	NewGetUMOrderHistoryDownloadIDService() GetUMOrderHistoryDownloadIDServiceAPI
+	NewGetUMOrderDownloadLinkService() GetUMOrderDownloadLinkServiceAPI
+	NewGetUMTransactionHistoryDownloadIDService() GetUMTransactionHistoryDownloadIDServiceAPI
+	NewGetUMTransactionDownloadLinkService() GetUMTransactionDownloadLinkServiceAPI
+	NewGetRateLimitService() GetRateLimitServiceAPI
+	NewGetNegativeBalanceExchangeRecordService() GetNegativeBalanceExchangeRecordServiceAPI
+	NewUMOrderService() UMOrderServiceAPI
+	NewUMConditionalOrderService() UMConditionalOrderServiceAPI
+	NewCMOrderService() CMOrderServiceAPI
+	NewCMConditionalOrderService() CMConditionalOrderServiceAPI
+	NewMarginOrderService() MarginOrderServiceAPI
+	NewMarginLoanService() MarginLoanServiceAPI
+	NewMarginRepayService() MarginRepayServiceAPI
+	NewMarginOCOService() MarginOCOServiceAPI
+	NewUMCancelOrderService() UMCancelOrderServiceAPI
+	NewUMCancelAllOrdersService() UMCancelAllOrdersServiceAPI
+	NewUMCancelConditionalOrderService() UMCancelConditionalOrderServiceAPI
+	NewUMCancelAllConditionalOrdersService() UMCancelAllConditionalOrdersServiceAPI
+	NewCMCancelOrderService() CMCancelOrderServiceAPI
+	NewCMCancelAllOrdersService() CMCancelAllOrdersServiceAPI
+	NewCMCancelConditionalOrderService() CMCancelConditionalOrderServiceAPI
+	NewCMCancelAllConditionalOrdersService() CMCancelAllConditionalOrdersServiceAPI
+	NewMarginCancelOrderService() MarginCancelOrderServiceAPI
+	NewMarginCancelOCOService() MarginCancelOCOServiceAPI
+	NewUMModifyOrderService() UMModifyOrderServiceAPI
+	NewMarginCancelAllOrdersService() MarginCancelAllOrdersServiceAPI
+	NewCMModifyOrderService() CMModifyOrderServiceAPI
+	NewUMQueryOrderService() UMQueryOrderServiceAPI
+	NewUMAllOrdersService() UMAllOrdersServiceAPI
+	NewUMOpenOrderService() UMOpenOrderServiceAPI
+	NewUMOpenOrdersService() UMOpenOrdersServiceAPI
+	NewUMAllConditionalOrdersService() UMAllConditionalOrdersServiceAPI
+	NewUMOpenConditionalOrdersService() UMOpenConditionalOrdersServiceAPI
+	NewUMOpenConditionalOrderService() UMOpenConditionalOrderServiceAPI
+	NewUMConditionalOrderHistoryService() UMConditionalOrderHistoryServiceAPI
+	NewCMQueryOrderService() CMQueryOrderServiceAPI
+	NewCMAllOrdersService() CMAllOrdersServiceAPI
+	NewCMOpenOrderService() CMOpenOrderServiceAPI
+	NewCMOpenOrdersService() CMOpenOrdersServiceAPI
+	NewCMOpenConditionalOrdersService() CMOpenConditionalOrdersServiceAPI
+	NewCMOpenConditionalOrderService() CMOpenConditionalOrderServiceAPI
+	NewCMConditionalOrder() CMConditionalOrderServiceAPI
+	NewCMConditionalOrdersService() CMConditionalOrdersServiceAPI
+	NewCMConditionalOrderHistoryService() CMConditionalOrderHistoryServiceAPI
+	NewUMForceOrdersService() UMForceOrdersServiceAPI
+	NewCMForceOrdersService() CMForceOrdersServiceAPI
+	NewUMModifyOrderHistoryService() UMModifyOrderHistoryServiceAPI
+	NewCMModifyOrderHistoryService() CMModifyOrderHistoryServiceAPI
+	NewMarginForceOrdersService() MarginForceOrdersServiceAPI
+	NewUMAccountTradeService() UMAccountTradeServiceAPI
+	NewCMAccountTradeService() CMAccountTradeServiceAPI
+	NewUMADLQuantileService() UMADLQuantileServiceAPI
+	NewCMADLQuantileService() CMADLQuantileServiceAPI
+	NewUMFeeBurnService() UMFeeBurnServiceAPI
+	NewGetMarginOpenOrdersService() GetMarginOpenOrdersServiceAPI
+	NewGetMarginAllOrdersService() GetMarginAllOrdersServiceAPI
+	NewUMFeeBurnStatusService() UMFeeBurnStatusServiceAPI
+	NewGetMarginForceOrdersService() GetMarginForceOrdersServiceAPI
+	NewUMAccountTradesService() UMAccountTradesServiceAPI
+	NewCMAccountTradesService() CMAccountTradesServiceAPI
+	NewUMGetADLQuantileService() UMGetADLQuantileServiceAPI
+	NewMarginOCOQueryService() MarginOCOQueryServiceAPI
+	NewMarginOpenOCOService() MarginOpenOCOServiceAPI
+	NewMarginAccountTradesService() MarginAccountTradesServiceAPI
+	NewMarginRepayDebtService() MarginRepayDebtServiceAPI
+	NewMarginAllOCOService() MarginAllOCOServiceAPI
+	NewStartUserStreamService() StartUserStreamServiceAPI
+	NewKeepaliveUserStreamService() KeepaliveUserStreamServiceAPI
+	NewCloseUserStreamService() CloseUserStreamServiceAPI
+}
+
+// NewClientAPI returns c as a ClientAPI
+func NewClientAPI(c *Client) ClientAPI {
+	return (*clientAPI)(c)
+}
+
+type clientAPI Client
+
+func (a *clientAPI) NewPingService() PingServiceAPI {
+	return (*pingServiceAPI)((*Client)(a).NewPingService())
+}
+
+func (a *clientAPI) NewGetBalanceService() GetBalanceServiceAPI {
+	return (*getBalanceServiceAPI)((*Client)(a).NewGetBalanceService())
+}
+
+func (a *clientAPI) NewGetAccountService() GetAccountServiceAPI {
+	return (*getAccountServiceAPI)((*Client)(a).NewGetAccountService())
+}
+
+func (a *clientAPI) NewGetMarginMaxBorrowService() GetMarginMaxBorrowServiceAPI {
+	return (*getMarginMaxBorrowServiceAPI)((*Client)(a).NewGetMarginMaxBorrowService())
+}
+
+func (a *clientAPI) NewGetMarginMaxWithdrawService() GetMarginMaxWithdrawServiceAPI {
+	return (*getMarginMaxWithdrawServiceAPI)((*Client)(a).NewGetMarginMaxWithdrawService())
+}
+
+func (a *clientAPI) NewGetUMPositionRiskService() GetUMPositionRiskServiceAPI {
+	return (*getUMPositionRiskServiceAPI)((*Client)(a).NewGetUMPositionRiskService())
+}
+
+func (a *clientAPI) NewGetCMPositionRiskService() GetCMPositionRiskServiceAPI {
+	return (*getCMPositionRiskServiceAPI)((*Client)(a).NewGetCMPositionRiskService())
+}
+
+func (a *clientAPI) NewChangeUMInitialLeverageService() ChangeUMInitialLeverageServiceAPI {
+	return (*changeUMInitialLeverageServiceAPI)((*Client)(a).NewChangeUMInitialLeverageService())
+}
+
+func (a *clientAPI) NewChangeCMInitialLeverageService() ChangeCMInitialLeverageServiceAPI {
+	return (*changeCMInitialLeverageServiceAPI)((*Client)(a).NewChangeCMInitialLeverageService())
+}
+
+func (a *clientAPI) NewChangeUMPositionModeService() ChangeUMPositionModeServiceAPI {
+	return (*changeUMPositionModeServiceAPI)((*Client)(a).NewChangeUMPositionModeService())
+}
+
+func (a *clientAPI) NewChangeCMPositionModeService() ChangeCMPositionModeServiceAPI {
+	return (*changeCMPositionModeServiceAPI)((*Client)(a).NewChangeCMPositionModeService())
+}
+
+func (a *clientAPI) NewGetUMPositionModeService() GetUMPositionModeServiceAPI {
+	return (*getUMPositionModeServiceAPI)((*Client)(a).NewGetUMPositionModeService())
+}
+
+func (a *clientAPI) NewGetCMPositionModeService() GetCMPositionModeServiceAPI {
+	return (*getCMPositionModeServiceAPI)((*Client)(a).NewGetCMPositionModeService())
+}
+
+func (a *clientAPI) NewGetUMLeverageBracketService() GetUMLeverageBracketServiceAPI {
+	return (*getUMLeverageBracketServiceAPI)((*Client)(a).NewGetUMLeverageBracketService())
+}
+
+func (a *clientAPI) NewGetCMLeverageBracketService() GetCMLeverageBracketServiceAPI {
+	return (*getCMLeverageBracketServiceAPI)((*Client)(a).NewGetCMLeverageBracketService())
+}
+
+func (a *clientAPI) NewGetUMTradingStatusService() GetUMTradingStatusServiceAPI {
+	return (*getUMTradingStatusServiceAPI)((*Client)(a).NewGetUMTradingStatusService())
+}
+
+func (a *clientAPI) NewGetUMCommissionRateService() GetUMCommissionRateServiceAPI {
+	return (*getUMCommissionRateServiceAPI)((*Client)(a).NewGetUMCommissionRateService())
+}
+
+func (a *clientAPI) NewGetCMCommissionRateService() GetCMCommissionRateServiceAPI {
+	return (*getCMCommissionRateServiceAPI)((*Client)(a).NewGetCMCommissionRateService())
+}
+
+func (a *clientAPI) NewGetMarginLoanService() GetMarginLoanServiceAPI {
+	return (*getMarginLoanServiceAPI)((*Client)(a).NewGetMarginLoanService())
+}
+
+func (a *clientAPI) NewGetMarginRepayService() GetMarginRepayServiceAPI {
+	return (*getMarginRepayServiceAPI)((*Client)(a).NewGetMarginRepayService())
+}
+
+func (a *clientAPI) NewGetAutoRepayFuturesStatusService() GetAutoRepayFuturesStatusServiceAPI {
+	return (*getAutoRepayFuturesStatusServiceAPI)((*Client)(a).NewGetAutoRepayFuturesStatusService())
+}
+
+func (a *clientAPI) NewChangeAutoRepayFuturesStatusService() ChangeAutoRepayFuturesStatusServiceAPI {
+	return (*changeAutoRepayFuturesStatusServiceAPI)((*Client)(a).NewChangeAutoRepayFuturesStatusService())
+}
+
+func (a *clientAPI) NewGetMarginInterestHistoryService() GetMarginInterestHistoryServiceAPI {
+	return (*getMarginInterestHistoryServiceAPI)((*Client)(a).NewGetMarginInterestHistoryService())
+}
+
+func (a *clientAPI) NewRepayFuturesNegativeBalanceService() RepayFuturesNegativeBalanceServiceAPI {
+	return (*repayFuturesNegativeBalanceServiceAPI)((*Client)(a).NewRepayFuturesNegativeBalanceService())
+}
+
+func (a *clientAPI) NewGetNegativeBalanceInterestHistoryService() GetNegativeBalanceInterestHistoryServiceAPI {
+	return (*getNegativeBalanceInterestHistoryServiceAPI)((*Client)(a).NewGetNegativeBalanceInterestHistoryService())
+}
+
+func (a *clientAPI) NewFundAutoCollectionService() FundAutoCollectionServiceAPI {
+	return (*fundAutoCollectionServiceAPI)((*Client)(a).NewFundAutoCollectionService())
+}
+
+func (a *clientAPI) NewFundCollectionByAssetService() FundCollectionByAssetServiceAPI {
+	return (*fundCollectionByAssetServiceAPI)((*Client)(a).NewFundCollectionByAssetService())
+}
+
+func (a *clientAPI) NewBNBTransferService() BNBTransferServiceAPI {
+	return (*bnbTransferServiceAPI)((*Client)(a).NewBNBTransferService())
+}
+
+func (a *clientAPI) NewGetUMIncomeHistoryService() GetUMIncomeHistoryServiceAPI {
+	return (*getUMIncomeHistoryServiceAPI)((*Client)(a).NewGetUMIncomeHistoryService())
+}
+
+func (a *clientAPI) NewGetCMIncomeHistoryService() GetCMIncomeHistoryServiceAPI {
+	return (*getCMIncomeHistoryServiceAPI)((*Client)(a).NewGetCMIncomeHistoryService())
+}
+
+func (a *clientAPI) NewGetUMAccountDetailService() GetUMAccountDetailServiceAPI {
+	return (*getUMAccountDetailServiceAPI)((*Client)(a).NewGetUMAccountDetailService())
+}
+
+func (a *clientAPI) NewGetCMAccountDetailService() GetCMAccountDetailServiceAPI {
+	return (*getCMAccountDetailServiceAPI)((*Client)(a).NewGetCMAccountDetailService())
+}
+
+func (a *clientAPI) NewGetUMAccountConfigService() UMAccountConfigServiceAPI {
+	return (*umAccountConfigServiceAPI)((*Client)(a).NewGetUMAccountConfigService())
+}
+
+func (a *clientAPI) NewGetUMSymbolConfigService() UMSymbolConfigServiceAPI {
+	return (*umSymbolConfigServiceAPI)((*Client)(a).NewGetUMSymbolConfigService())
+}
+
+func (a *clientAPI) NewGetUMAccountDetailV2Service() UMAccountDetailV2ServiceAPI {
+	return (*umAccountDetailV2ServiceAPI)((*Client)(a).NewGetUMAccountDetailV2Service())
+}
+
+func (a *clientAPI) NewGetUMTradeHistoryDownloadIDService() GetUMTradeHistoryDownloadIDServiceAPI {
+	return (*getUMTradeHistoryDownloadIDServiceAPI)((*Client)(a).NewGetUMTradeHistoryDownloadIDService())
+}
+
+func (a *clientAPI) NewGetUMTradeDownloadLinkService() GetUMTradeDownloadLinkServiceAPI {
+	return (*getUMTradeDownloadLinkServiceAPI)((*Client)(a).NewGetUMTradeDownloadLinkService())
+}
+
+func (a *clientAPI) NewGetUMOrderHistoryDownloadIDService() GetUMOrderHistoryDownloadIDServiceAPI {
+	return (*getUMOrderHistoryDownloadIDServiceAPI)((*Client)(a).NewGetUMOrderHistoryDownloadIDService())
+}
+
+func (a *clientAPI) NewGetUMOrderDownloadLinkService() GetUMOrderDownloadLinkServiceAPI {
+	return (*getUMOrderDownloadLinkServiceAPI)((*Client)(a).NewGetUMOrderDownloadLinkService())
+}
+
+func (a *clientAPI) NewGetUMTransactionHistoryDownloadIDService() GetUMTransactionHistoryDownloadIDServiceAPI {
+	return (*getUMTransactionHistoryDownloadIDServiceAPI)((*Client)(a).NewGetUMTransactionHistoryDownloadIDService())
+}
+
+func (a *clientAPI) NewGetUMTransactionDownloadLinkService() GetUMTransactionDownloadLinkServiceAPI {
+	return (*getUMTransactionDownloadLinkServiceAPI)((*Client)(a).NewGetUMTransactionDownloadLinkService())
+}
+
+func (a *clientAPI) NewGetRateLimitService() GetRateLimitServiceAPI {
+	return (*getRateLimitServiceAPI)((*Client)(a).NewGetRateLimitService())
+}
+
+func (a *clientAPI) NewGetNegativeBalanceExchangeRecordService() GetNegativeBalanceExchangeRecordServiceAPI {
+	return (*getNegativeBalanceExchangeRecordServiceAPI)((*Client)(a).NewGetNegativeBalanceExchangeRecordService())
+}
+
+func (a *clientAPI) NewUMOrderService() UMOrderServiceAPI {
+	return (*umOrderServiceAPI)((*Client)(a).NewUMOrderService())
+}
+
+func (a *clientAPI) NewUMConditionalOrderService() UMConditionalOrderServiceAPI {
+	return (*umConditionalOrderServiceAPI)((*Client)(a).NewUMConditionalOrderService())
+}
+
+func (a *clientAPI) NewCMOrderService() CMOrderServiceAPI {
+	return (*cmOrderServiceAPI)((*Client)(a).NewCMOrderService())
+}
+
+func (a *clientAPI) NewCMConditionalOrderService() CMConditionalOrderServiceAPI {
+	return (*cmConditionalOrderServiceAPI)((*Client)(a).NewCMConditionalOrderService())
+}
+
+func (a *clientAPI) NewMarginOrderService() MarginOrderServiceAPI {
+	return (*marginOrderServiceAPI)((*Client)(a).NewMarginOrderService())
+}
+
+func (a *clientAPI) NewMarginLoanService() MarginLoanServiceAPI {
+	return (*marginLoanServiceAPI)((*Client)(a).NewMarginLoanService())
+}
+
+func (a *clientAPI) NewMarginRepayService() MarginRepayServiceAPI {
+	return (*marginRepayServiceAPI)((*Client)(a).NewMarginRepayService())
+}
+
+func (a *clientAPI) NewMarginOCOService() MarginOCOServiceAPI {
+	return (*marginOCOServiceAPI)((*Client)(a).NewMarginOCOService())
+}
+
+func (a *clientAPI) NewUMCancelOrderService() UMCancelOrderServiceAPI {
+	return (*umCancelOrderServiceAPI)((*Client)(a).NewUMCancelOrderService())
+}
+
+func (a *clientAPI) NewUMCancelAllOrdersService() UMCancelAllOrdersServiceAPI {
+	return (*umCancelAllOrdersServiceAPI)((*Client)(a).NewUMCancelAllOrdersService())
+}
+
+func (a *clientAPI) NewUMCancelConditionalOrderService() UMCancelConditionalOrderServiceAPI {
+	return (*umCancelConditionalOrderServiceAPI)((*Client)(a).NewUMCancelConditionalOrderService())
+}
+
+func (a *clientAPI) NewUMCancelAllConditionalOrdersService() UMCancelAllConditionalOrdersServiceAPI {
+	return (*umCancelAllConditionalOrdersServiceAPI)((*Client)(a).NewUMCancelAllConditionalOrdersService())
+}
+
+func (a *clientAPI) NewCMCancelOrderService() CMCancelOrderServiceAPI {
+	return (*cmCancelOrderServiceAPI)((*Client)(a).NewCMCancelOrderService())
+}
+
+func (a *clientAPI) NewCMCancelAllOrdersService() CMCancelAllOrdersServiceAPI {
+	return (*cmCancelAllOrdersServiceAPI)((*Client)(a).NewCMCancelAllOrdersService())
+}
+
+func (a *clientAPI) NewCMCancelConditionalOrderService() CMCancelConditionalOrderServiceAPI {
+	return (*cmCancelConditionalOrderServiceAPI)((*Client)(a).NewCMCancelConditionalOrderService())
+}
+
+func (a *clientAPI) NewCMCancelAllConditionalOrdersService() CMCancelAllConditionalOrdersServiceAPI {
+	return (*cmCancelAllConditionalOrdersServiceAPI)((*Client)(a).NewCMCancelAllConditionalOrdersService())
+}
+
+func (a *clientAPI) NewMarginCancelOrderService() MarginCancelOrderServiceAPI {
+	return (*marginCancelOrderServiceAPI)((*Client)(a).NewMarginCancelOrderService())
+}
+
+func (a *clientAPI) NewMarginCancelOCOService() MarginCancelOCOServiceAPI {
+	return (*marginCancelOCOServiceAPI)((*Client)(a).NewMarginCancelOCOService())
+}
+
+func (a *clientAPI) NewUMModifyOrderService() UMModifyOrderServiceAPI {
+	return (*umModifyOrderServiceAPI)((*Client)(a).NewUMModifyOrderService())
+}
+
+func (a *clientAPI) NewMarginCancelAllOrdersService() MarginCancelAllOrdersServiceAPI {
+	return (*marginCancelAllOrdersServiceAPI)((*Client)(a).NewMarginCancelAllOrdersService())
+}
+
+func (a *clientAPI) NewCMModifyOrderService() CMModifyOrderServiceAPI {
+	return (*cmModifyOrderServiceAPI)((*Client)(a).NewCMModifyOrderService())
+}
+
+func (a *clientAPI) NewUMQueryOrderService() UMQueryOrderServiceAPI {
+	return (*umQueryOrderServiceAPI)((*Client)(a).NewUMQueryOrderService())
+}
+
+func (a *clientAPI) NewUMAllOrdersService() UMAllOrdersServiceAPI {
+	return (*umAllOrdersServiceAPI)((*Client)(a).NewUMAllOrdersService())
+}
+
+func (a *clientAPI) NewUMOpenOrderService() UMOpenOrderServiceAPI {
+	return (*umOpenOrderServiceAPI)((*Client)(a).NewUMOpenOrderService())
+}
+
+func (a *clientAPI) NewUMOpenOrdersService() UMOpenOrdersServiceAPI {
+	return (*umOpenOrdersServiceAPI)((*Client)(a).NewUMOpenOrdersService())
+}
+
+func (a *clientAPI) NewUMAllConditionalOrdersService() UMAllConditionalOrdersServiceAPI {
+	return (*umAllConditionalOrdersServiceAPI)((*Client)(a).NewUMAllConditionalOrdersService())
+}
+
+func (a *clientAPI) NewUMOpenConditionalOrdersService() UMOpenConditionalOrdersServiceAPI {
+	return (*umOpenConditionalOrdersServiceAPI)((*Client)(a).NewUMOpenConditionalOrdersService())
+}
+
+func (a *clientAPI) NewUMOpenConditionalOrderService() UMOpenConditionalOrderServiceAPI {
+	return (*umOpenConditionalOrderServiceAPI)((*Client)(a).NewUMOpenConditionalOrderService())
+}
+
+func (a *clientAPI) NewUMConditionalOrderHistoryService() UMConditionalOrderHistoryServiceAPI {
+	return (*umConditionalOrderHistoryServiceAPI)((*Client)(a).NewUMConditionalOrderHistoryService())
+}
+
+func (a *clientAPI) NewCMQueryOrderService() CMQueryOrderServiceAPI {
+	return (*cmQueryOrderServiceAPI)((*Client)(a).NewCMQueryOrderService())
+}
+
+func (a *clientAPI) NewCMAllOrdersService() CMAllOrdersServiceAPI {
+	return (*cmAllOrdersServiceAPI)((*Client)(a).NewCMAllOrdersService())
+}
+
+func (a *clientAPI) NewCMOpenOrderService() CMOpenOrderServiceAPI {
+	return (*cmOpenOrderServiceAPI)((*Client)(a).NewCMOpenOrderService())
+}
+
+func (a *clientAPI) NewCMOpenOrdersService() CMOpenOrdersServiceAPI {
+	return (*cmOpenOrdersServiceAPI)((*Client)(a).NewCMOpenOrdersService())
+}
+
+func (a *clientAPI) NewCMOpenConditionalOrdersService() CMOpenConditionalOrdersServiceAPI {
+	return (*cmOpenConditionalOrdersServiceAPI)((*Client)(a).NewCMOpenConditionalOrdersService())
+}
+
+func (a *clientAPI) NewCMOpenConditionalOrderService() CMOpenConditionalOrderServiceAPI {
+	return (*cmOpenConditionalOrderServiceAPI)((*Client)(a).NewCMOpenConditionalOrderService())
+}
+
+func (a *clientAPI) NewCMConditionalOrder() CMConditionalOrderServiceAPI {
+	return (*cmConditionalOrderServiceAPI)((*Client)(a).NewCMConditionalOrder())
+}
+
+func (a *clientAPI) NewCMConditionalOrdersService() CMConditionalOrdersServiceAPI {
+	return (*cmConditionalOrdersServiceAPI)((*Client)(a).NewCMConditionalOrdersService())
+}
+
+func (a *clientAPI) NewCMConditionalOrderHistoryService() CMConditionalOrderHistoryServiceAPI {
+	return (*cmConditionalOrderHistoryServiceAPI)((*Client)(a).NewCMConditionalOrderHistoryService())
+}
+
+func (a *clientAPI) NewUMForceOrdersService() UMForceOrdersServiceAPI {
+	return (*umForceOrdersServiceAPI)((*Client)(a).NewUMForceOrdersService())
+}
+
+func (a *clientAPI) NewCMForceOrdersService() CMForceOrdersServiceAPI {
+	return (*cmForceOrdersServiceAPI)((*Client)(a).NewCMForceOrdersService())
+}
+
+func (a *clientAPI) NewUMModifyOrderHistoryService() UMModifyOrderHistoryServiceAPI {
+	return (*umModifyOrderHistoryServiceAPI)((*Client)(a).NewUMModifyOrderHistoryService())
+}
+
+func (a *clientAPI) NewCMModifyOrderHistoryService() CMModifyOrderHistoryServiceAPI {
+	return (*cmModifyOrderHistoryServiceAPI)((*Client)(a).NewCMModifyOrderHistoryService())
+}
+
+func (a *clientAPI) NewMarginForceOrdersService() MarginForceOrdersServiceAPI {
+	return (*marginForceOrdersServiceAPI)((*Client)(a).NewMarginForceOrdersService())
+}
+
+func (a *clientAPI) NewUMAccountTradeService() UMAccountTradeServiceAPI {
+	return (*umAccountTradeServiceAPI)((*Client)(a).NewUMAccountTradeService())
+}
+
+func (a *clientAPI) NewCMAccountTradeService() CMAccountTradeServiceAPI {
+	return (*cmAccountTradeServiceAPI)((*Client)(a).NewCMAccountTradeService())
+}
+
+func (a *clientAPI) NewUMADLQuantileService() UMADLQuantileServiceAPI {
+	return (*umadlQuantileServiceAPI)((*Client)(a).NewUMADLQuantileService())
+}
+
+func (a *clientAPI) NewCMADLQuantileService() CMADLQuantileServiceAPI {
+	return (*cmadlQuantileServiceAPI)((*Client)(a).NewCMADLQuantileService())
+}
+
+func (a *clientAPI) NewUMFeeBurnService() UMFeeBurnServiceAPI {
+	return (*umFeeBurnServiceAPI)((*Client)(a).NewUMFeeBurnService())
+}
+
+func (a *clientAPI) NewGetMarginOpenOrdersService() GetMarginOpenOrdersServiceAPI {
+	return (*getMarginOpenOrdersServiceAPI)((*Client)(a).NewGetMarginOpenOrdersService())
+}
+
+func (a *clientAPI) NewGetMarginAllOrdersService() GetMarginAllOrdersServiceAPI {
+	return (*getMarginAllOrdersServiceAPI)((*Client)(a).NewGetMarginAllOrdersService())
+}
+
+func (a *clientAPI) NewUMFeeBurnStatusService() UMFeeBurnStatusServiceAPI {
+	return (*umFeeBurnStatusServiceAPI)((*Client)(a).NewUMFeeBurnStatusService())
+}
+
+func (a *clientAPI) NewGetMarginForceOrdersService() GetMarginForceOrdersServiceAPI {
+	return (*getMarginForceOrdersServiceAPI)((*Client)(a).NewGetMarginForceOrdersService())
+}
+
+func (a *clientAPI) NewUMAccountTradesService() UMAccountTradesServiceAPI {
+	return (*umAccountTradesServiceAPI)((*Client)(a).NewUMAccountTradesService())
+}
+
+func (a *clientAPI) NewCMAccountTradesService() CMAccountTradesServiceAPI {
+	return (*cmAccountTradesServiceAPI)((*Client)(a).NewCMAccountTradesService())
+}
+
+func (a *clientAPI) NewUMGetADLQuantileService() UMGetADLQuantileServiceAPI {
+	return (*umGetADLQuantileServiceAPI)((*Client)(a).NewUMGetADLQuantileService())
+}
+
+func (a *clientAPI) NewMarginOCOQueryService() MarginOCOQueryServiceAPI {
+	return (*marginOCOQueryServiceAPI)((*Client)(a).NewMarginOCOQueryService())
+}
+
+func (a *clientAPI) NewMarginOpenOCOService() MarginOpenOCOServiceAPI {
+	return (*marginOpenOCOServiceAPI)((*Client)(a).NewMarginOpenOCOService())
+}
+
+func (a *clientAPI) NewMarginAccountTradesService() MarginAccountTradesServiceAPI {
+	return (*marginAccountTradesServiceAPI)((*Client)(a).NewMarginAccountTradesService())
+}
+
+func (a *clientAPI) NewMarginRepayDebtService() MarginRepayDebtServiceAPI {
+	return (*marginRepayDebtServiceAPI)((*Client)(a).NewMarginRepayDebtService())
+}
+
+func (a *clientAPI) NewMarginAllOCOService() MarginAllOCOServiceAPI {
+	return (*marginAllOCOServiceAPI)((*Client)(a).NewMarginAllOCOService())
+}
+
+func (a *clientAPI) NewStartUserStreamService() StartUserStreamServiceAPI {
+	return (*startUserStreamServiceAPI)((*Client)(a).NewStartUserStreamService())
+}
+
+func (a *clientAPI) NewKeepaliveUserStreamService() KeepaliveUserStreamServiceAPI {
+	return (*keepaliveUserStreamServiceAPI)((*Client)(a).NewKeepaliveUserStreamService())
+}
+
+func (a *clientAPI) NewCloseUserStreamService() CloseUserStreamServiceAPI {
+	return (*closeUserStreamServiceAPI)((*Client)(a).NewCloseUserStreamService())
+}
+
+// PingServiceAPI is the interface of PingService
+type PingServiceAPI interface {
+	Do(ctx context.Context, opts ...RequestOption) error
+}
+
+type pingServiceAPI PingService
+
+func (a *pingServiceAPI) Do(ctx context.Context, opts ...RequestOption) error {
+	return (*PingService)(a).Do(ctx, opts...)
+}
+
+// GetBalanceServiceAPI is the interface of GetBalanceService
+type GetBalanceServiceAPI interface {
+	Asset(asset string) GetBalanceServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) ([]*Balance, error)
+}
+
+type getBalanceServiceAPI GetBalanceService
+
+func (a *getBalanceServiceAPI) Asset(asset string) GetBalanceServiceAPI {
+	return (*getBalanceServiceAPI)((*GetBalanceService)(a).Asset(asset))
+}
+
+func (a *getBalanceServiceAPI) Do(ctx context.Context, opts ...RequestOption) ([]*Balance, error) {
+	return (*GetBalanceService)(a).Do(ctx, opts...)
+}
+
+// GetAccountServiceAPI is the interface of GetAccountService
+type GetAccountServiceAPI interface {
+	Do(ctx context.Context, opts ...RequestOption) (*Account, error)
+}
+
+type getAccountServiceAPI GetAccountService
+
+func (a *getAccountServiceAPI) Do(ctx context.Context, opts ...RequestOption) (*Account, error) {
+	return (*GetAccountService)(a).Do(ctx, opts...)
+}
+
+// GetMarginMaxBorrowServiceAPI is the interface of GetMarginMaxBorrowService
+type GetMarginMaxBorrowServiceAPI interface {
+	Asset(asset string) GetMarginMaxBorrowServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) (*MaxBorrow, error)
+}
+
+type getMarginMaxBorrowServiceAPI GetMarginMaxBorrowService
+
+func (a *getMarginMaxBorrowServiceAPI) Asset(asset string) GetMarginMaxBorrowServiceAPI {
+	return (*getMarginMaxBorrowServiceAPI)((*GetMarginMaxBorrowService)(a).Asset(asset))
+}
+
+func (a *getMarginMaxBorrowServiceAPI) Do(ctx context.Context, opts ...RequestOption) (*MaxBorrow, error) {
+	return (*GetMarginMaxBorrowService)(a).Do(ctx, opts...)
+}
+
+// GetMarginMaxWithdrawServiceAPI is the interface of GetMarginMaxWithdrawService
+type GetMarginMaxWithdrawServiceAPI interface {
+	Asset(asset string) GetMarginMaxWithdrawServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) (*MaxWithdraw, error)
+}
+
+type getMarginMaxWithdrawServiceAPI GetMarginMaxWithdrawService
+
+func (a *getMarginMaxWithdrawServiceAPI) Asset(asset string) GetMarginMaxWithdrawServiceAPI {
+	return (*getMarginMaxWithdrawServiceAPI)((*GetMarginMaxWithdrawService)(a).Asset(asset))
+}
+
+func (a *getMarginMaxWithdrawServiceAPI) Do(ctx context.Context, opts ...RequestOption) (*MaxWithdraw, error) {
+	return (*GetMarginMaxWithdrawService)(a).Do(ctx, opts...)
+}
+
+// GetUMPositionRiskServiceAPI is the interface of GetUMPositionRiskService
+type GetUMPositionRiskServiceAPI interface {
+	Symbol(symbol string) GetUMPositionRiskServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) ([]*UMPosition, error)
+}
+
+type getUMPositionRiskServiceAPI GetUMPositionRiskService
+
+func (a *getUMPositionRiskServiceAPI) Symbol(symbol string) GetUMPositionRiskServiceAPI {
+	return (*getUMPositionRiskServiceAPI)((*GetUMPositionRiskService)(a).Symbol(symbol))
+}
+
+func (a *getUMPositionRiskServiceAPI) Do(ctx context.Context, opts ...RequestOption) ([]*UMPosition, error) {
+	return (*GetUMPositionRiskService)(a).Do(ctx, opts...)
+}
+
+// GetCMPositionRiskServiceAPI is the interface of GetCMPositionRiskService
+type GetCMPositionRiskServiceAPI interface {
+	MarginAsset(marginAsset string) GetCMPositionRiskServiceAPI
+	Pair(pair string) GetCMPositionRiskServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) ([]*CMPosition, error)
+}
+
+type getCMPositionRiskServiceAPI GetCMPositionRiskService
+
+func (a *getCMPositionRiskServiceAPI) MarginAsset(marginAsset string) GetCMPositionRiskServiceAPI {
+	return (*getCMPositionRiskServiceAPI)((*GetCMPositionRiskService)(a).MarginAsset(marginAsset))
+}
+
+func (a *getCMPositionRiskServiceAPI) Pair(pair string) GetCMPositionRiskServiceAPI {
+	return (*getCMPositionRiskServiceAPI)((*GetCMPositionRiskService)(a).Pair(pair))
+}
+
+func (a *getCMPositionRiskServiceAPI) Do(ctx context.Context, opts ...RequestOption) ([]*CMPosition, error) {
+	return (*GetCMPositionRiskService)(a).Do(ctx, opts...)
+}
+
+// ChangeUMInitialLeverageServiceAPI is the interface of ChangeUMInitialLeverageService
+type ChangeUMInitialLeverageServiceAPI interface {
+	Symbol(symbol string) ChangeUMInitialLeverageServiceAPI
+	Leverage(leverage int) ChangeUMInitialLeverageServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) (*UMLeverage, error)
+}
+
+type changeUMInitialLeverageServiceAPI ChangeUMInitialLeverageService
+
+func (a *changeUMInitialLeverageServiceAPI) Symbol(symbol string) ChangeUMInitialLeverageServiceAPI {
+	return (*changeUMInitialLeverageServiceAPI)((*ChangeUMInitialLeverageService)(a).Symbol(symbol))
+}
+
+func (a *changeUMInitialLeverageServiceAPI) Leverage(leverage int) ChangeUMInitialLeverageServiceAPI {
+	return (*changeUMInitialLeverageServiceAPI)((*ChangeUMInitialLeverageService)(a).Leverage(leverage))
+}
+
+func (a *changeUMInitialLeverageServiceAPI) Do(ctx context.Context, opts ...RequestOption) (*UMLeverage, error) {
+	return (*ChangeUMInitialLeverageService)(a).Do(ctx, opts...)
+}
+
+// ChangeCMInitialLeverageServiceAPI is the interface of ChangeCMInitialLeverageService
+type ChangeCMInitialLeverageServiceAPI interface {
+	Symbol(symbol string) ChangeCMInitialLeverageServiceAPI
+	Leverage(leverage int) ChangeCMInitialLeverageServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) (*CMLeverage, error)
+}
+
+type changeCMInitialLeverageServiceAPI ChangeCMInitialLeverageService
+
+func (a *changeCMInitialLeverageServiceAPI) Symbol(symbol string) ChangeCMInitialLeverageServiceAPI {
+	return (*changeCMInitialLeverageServiceAPI)((*ChangeCMInitialLeverageService)(a).Symbol(symbol))
+}
+
+func (a *changeCMInitialLeverageServiceAPI) Leverage(leverage int) ChangeCMInitialLeverageServiceAPI {
+	return (*changeCMInitialLeverageServiceAPI)((*ChangeCMInitialLeverageService)(a).Leverage(leverage))
+}
+
+func (a *changeCMInitialLeverageServiceAPI) Do(ctx context.Context, opts ...RequestOption) (*CMLeverage, error) {
+	return (*ChangeCMInitialLeverageService)(a).Do(ctx, opts...)
+}
+
+// ChangeUMPositionModeServiceAPI is the interface of ChangeUMPositionModeService
+type ChangeUMPositionModeServiceAPI interface {
+	DualSidePosition(dualSidePosition bool) ChangeUMPositionModeServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) (*APIResponse, error)
+}
+
+type changeUMPositionModeServiceAPI ChangeUMPositionModeService
+
+func (a *changeUMPositionModeServiceAPI) DualSidePosition(dualSidePosition bool) ChangeUMPositionModeServiceAPI {
+	return (*changeUMPositionModeServiceAPI)((*ChangeUMPositionModeService)(a).DualSidePosition(dualSidePosition))
+}
+
+func (a *changeUMPositionModeServiceAPI) Do(ctx context.Context, opts ...RequestOption) (*APIResponse, error) {
+	return (*ChangeUMPositionModeService)(a).Do(ctx, opts...)
+}
+
+// ChangeCMPositionModeServiceAPI is the interface of ChangeCMPositionModeService
+type ChangeCMPositionModeServiceAPI interface {
+	DualSidePosition(dualSidePosition bool) ChangeCMPositionModeServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) (*APIResponse, error)
+}
+
+type changeCMPositionModeServiceAPI ChangeCMPositionModeService
+
+func (a *changeCMPositionModeServiceAPI) DualSidePosition(dualSidePosition bool) ChangeCMPositionModeServiceAPI {
+	return (*changeCMPositionModeServiceAPI)((*ChangeCMPositionModeService)(a).DualSidePosition(dualSidePosition))
+}
+
+func (a *changeCMPositionModeServiceAPI) Do(ctx context.Context, opts ...RequestOption) (*APIResponse, error) {
+	return (*ChangeCMPositionModeService)(a).Do(ctx, opts...)
+}
+
+// GetUMPositionModeServiceAPI is the interface of GetUMPositionModeService
+type GetUMPositionModeServiceAPI interface {
+	Do(ctx context.Context, opts ...RequestOption) (*PositionMode, error)
+}
+
+type getUMPositionModeServiceAPI GetUMPositionModeService
+
+func (a *getUMPositionModeServiceAPI) Do(ctx context.Context, opts ...RequestOption) (*PositionMode, error) {
+	return (*GetUMPositionModeService)(a).Do(ctx, opts...)
+}
+
+// GetCMPositionModeServiceAPI is the interface of GetCMPositionModeService
+type GetCMPositionModeServiceAPI interface {
+	Do(ctx context.Context, opts ...RequestOption) (*PositionMode, error)
+}
+
+type getCMPositionModeServiceAPI GetCMPositionModeService
+
+func (a *getCMPositionModeServiceAPI) Do(ctx context.Context, opts ...RequestOption) (*PositionMode, error) {
+	return (*GetCMPositionModeService)(a).Do(ctx, opts...)
+}
+
+// GetUMLeverageBracketServiceAPI is the interface of GetUMLeverageBracketService
+type GetUMLeverageBracketServiceAPI interface {
+	Symbol(symbol string) GetUMLeverageBracketServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) ([]*LeverageBracket, error)
+}
+
+type getUMLeverageBracketServiceAPI GetUMLeverageBracketService
+
+func (a *getUMLeverageBracketServiceAPI) Symbol(symbol string) GetUMLeverageBracketServiceAPI {
+	return (*getUMLeverageBracketServiceAPI)((*GetUMLeverageBracketService)(a).Symbol(symbol))
+}
+
+func (a *getUMLeverageBracketServiceAPI) Do(ctx context.Context, opts ...RequestOption) ([]*LeverageBracket, error) {
+	return (*GetUMLeverageBracketService)(a).Do(ctx, opts...)
+}
+
+// GetCMLeverageBracketServiceAPI is the interface of GetCMLeverageBracketService
+type GetCMLeverageBracketServiceAPI interface {
+	Symbol(symbol string) GetCMLeverageBracketServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) ([]*CMLeverageBracket, error)
+}
+
+type getCMLeverageBracketServiceAPI GetCMLeverageBracketService
+
+func (a *getCMLeverageBracketServiceAPI) Symbol(symbol string) GetCMLeverageBracketServiceAPI {
+	return (*getCMLeverageBracketServiceAPI)((*GetCMLeverageBracketService)(a).Symbol(symbol))
+}
+
+func (a *getCMLeverageBracketServiceAPI) Do(ctx context.Context, opts ...RequestOption) ([]*CMLeverageBracket, error) {
+	return (*GetCMLeverageBracketService)(a).Do(ctx, opts...)
+}
+
+// GetUMTradingStatusServiceAPI is the interface of GetUMTradingStatusService
+type GetUMTradingStatusServiceAPI interface {
+	Symbol(symbol string) GetUMTradingStatusServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) (*TradingStatus, error)
+}
+
+type getUMTradingStatusServiceAPI GetUMTradingStatusService
+
+func (a *getUMTradingStatusServiceAPI) Symbol(symbol string) GetUMTradingStatusServiceAPI {
+	return (*getUMTradingStatusServiceAPI)((*GetUMTradingStatusService)(a).Symbol(symbol))
+}
+
+func (a *getUMTradingStatusServiceAPI) Do(ctx context.Context, opts ...RequestOption) (*TradingStatus, error) {
+	return (*GetUMTradingStatusService)(a).Do(ctx, opts...)
+}
+
+// GetUMCommissionRateServiceAPI is the interface of GetUMCommissionRateService
+type GetUMCommissionRateServiceAPI interface {
+	Symbol(symbol string) GetUMCommissionRateServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) (*CommissionRate, error)
+}
+
+type getUMCommissionRateServiceAPI GetUMCommissionRateService
+
+func (a *getUMCommissionRateServiceAPI) Symbol(symbol string) GetUMCommissionRateServiceAPI {
+	return (*getUMCommissionRateServiceAPI)((*GetUMCommissionRateService)(a).Symbol(symbol))
+}
+
+func (a *getUMCommissionRateServiceAPI) Do(ctx context.Context, opts ...RequestOption) (*CommissionRate, error) {
+	return (*GetUMCommissionRateService)(a).Do(ctx, opts...)
+}
+
+// GetCMCommissionRateServiceAPI is the interface of GetCMCommissionRateService
+type GetCMCommissionRateServiceAPI interface {
+	Symbol(symbol string) GetCMCommissionRateServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) (*CommissionRate, error)
+}
+
+type getCMCommissionRateServiceAPI GetCMCommissionRateService
+
+func (a *getCMCommissionRateServiceAPI) Symbol(symbol string) GetCMCommissionRateServiceAPI {
+	return (*getCMCommissionRateServiceAPI)((*GetCMCommissionRateService)(a).Symbol(symbol))
+}
+
+func (a *getCMCommissionRateServiceAPI) Do(ctx context.Context, opts ...RequestOption) (*CommissionRate, error) {
+	return (*GetCMCommissionRateService)(a).Do(ctx, opts...)
+}
+
+// GetMarginLoanServiceAPI is the interface of GetMarginLoanService
+type GetMarginLoanServiceAPI interface {
+	Asset(asset string) GetMarginLoanServiceAPI
+	TxID(txID int64) GetMarginLoanServiceAPI
+	StartTime(startTime int64) GetMarginLoanServiceAPI
+	EndTime(endTime int64) GetMarginLoanServiceAPI
+	Current(current int64) GetMarginLoanServiceAPI
+	Size(size int64) GetMarginLoanServiceAPI
+	Archived(archived bool) GetMarginLoanServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) (*GetMarginLoanResponse, error)
+}
+
+type getMarginLoanServiceAPI GetMarginLoanService
+
+func (a *getMarginLoanServiceAPI) Asset(asset string) GetMarginLoanServiceAPI {
+	return (*getMarginLoanServiceAPI)((*GetMarginLoanService)(a).Asset(asset))
+}
+
+func (a *getMarginLoanServiceAPI) TxID(txID int64) GetMarginLoanServiceAPI {
+	return (*getMarginLoanServiceAPI)((*GetMarginLoanService)(a).TxID(txID))
+}
+
+func (a *getMarginLoanServiceAPI) StartTime(startTime int64) GetMarginLoanServiceAPI {
+	return (*getMarginLoanServiceAPI)((*GetMarginLoanService)(a).StartTime(startTime))
+}
+
+func (a *getMarginLoanServiceAPI) EndTime(endTime int64) GetMarginLoanServiceAPI {
+	return (*getMarginLoanServiceAPI)((*GetMarginLoanService)(a).EndTime(endTime))
+}
+
+func (a *getMarginLoanServiceAPI) Current(current int64) GetMarginLoanServiceAPI {
+	return (*getMarginLoanServiceAPI)((*GetMarginLoanService)(a).Current(current))
+}
+
+func (a *getMarginLoanServiceAPI) Size(size int64) GetMarginLoanServiceAPI {
+	return (*getMarginLoanServiceAPI)((*GetMarginLoanService)(a).Size(size))
+}
+
+func (a *getMarginLoanServiceAPI) Archived(archived bool) GetMarginLoanServiceAPI {
+	return (*getMarginLoanServiceAPI)((*GetMarginLoanService)(a).Archived(archived))
+}
+
+func (a *getMarginLoanServiceAPI) Do(ctx context.Context, opts ...RequestOption) (*GetMarginLoanResponse, error) {
+	return (*GetMarginLoanService)(a).Do(ctx, opts...)
+}
+
+// GetMarginRepayServiceAPI is the interface of GetMarginRepayService
+type GetMarginRepayServiceAPI interface {
+	Asset(asset string) GetMarginRepayServiceAPI
+	TxID(txID int64) GetMarginRepayServiceAPI
+	StartTime(startTime int64) GetMarginRepayServiceAPI
+	EndTime(endTime int64) GetMarginRepayServiceAPI
+	Current(current int64) GetMarginRepayServiceAPI
+	Size(size int64) GetMarginRepayServiceAPI
+	Archived(archived bool) GetMarginRepayServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) (*GetMarginRepayResponse, error)
+}
+
+type getMarginRepayServiceAPI GetMarginRepayService
+
+func (a *getMarginRepayServiceAPI) Asset(asset string) GetMarginRepayServiceAPI {
+	return (*getMarginRepayServiceAPI)((*GetMarginRepayService)(a).Asset(asset))
+}
+
+func (a *getMarginRepayServiceAPI) TxID(txID int64) GetMarginRepayServiceAPI {
+	return (*getMarginRepayServiceAPI)((*GetMarginRepayService)(a).TxID(txID))
+}
+
+func (a *getMarginRepayServiceAPI) StartTime(startTime int64) GetMarginRepayServiceAPI {
+	return (*getMarginRepayServiceAPI)((*GetMarginRepayService)(a).StartTime(startTime))
+}
+
+func (a *getMarginRepayServiceAPI) EndTime(endTime int64) GetMarginRepayServiceAPI {
+	return (*getMarginRepayServiceAPI)((*GetMarginRepayService)(a).EndTime(endTime))
+}
+
+func (a *getMarginRepayServiceAPI) Current(current int64) GetMarginRepayServiceAPI {
+	return (*getMarginRepayServiceAPI)((*GetMarginRepayService)(a).Current(current))
+}
+
+func (a *getMarginRepayServiceAPI) Size(size int64) GetMarginRepayServiceAPI {
+	return (*getMarginRepayServiceAPI)((*GetMarginRepayService)(a).Size(size))
+}
+
+func (a *getMarginRepayServiceAPI) Archived(archived bool) GetMarginRepayServiceAPI {
+	return (*getMarginRepayServiceAPI)((*GetMarginRepayService)(a).Archived(archived))
+}
+
+func (a *getMarginRepayServiceAPI) Do(ctx context.Context, opts ...RequestOption) (*GetMarginRepayResponse, error) {
+	return (*GetMarginRepayService)(a).Do(ctx, opts...)
+}
+
+// GetAutoRepayFuturesStatusServiceAPI is the interface of GetAutoRepayFuturesStatusService
+type GetAutoRepayFuturesStatusServiceAPI interface {
+	Do(ctx context.Context, opts ...RequestOption) (*AutoRepayFuturesStatus, error)
+}
+
+type getAutoRepayFuturesStatusServiceAPI GetAutoRepayFuturesStatusService
+
+func (a *getAutoRepayFuturesStatusServiceAPI) Do(ctx context.Context, opts ...RequestOption) (*AutoRepayFuturesStatus, error) {
+	return (*GetAutoRepayFuturesStatusService)(a).Do(ctx, opts...)
+}
+
+// ChangeAutoRepayFuturesStatusServiceAPI is the interface of ChangeAutoRepayFuturesStatusService
+type ChangeAutoRepayFuturesStatusServiceAPI interface {
+	AutoRepay(autoRepay bool) ChangeAutoRepayFuturesStatusServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) (*SuccessResponse, error)
+}
+
+type changeAutoRepayFuturesStatusServiceAPI ChangeAutoRepayFuturesStatusService
+
+func (a *changeAutoRepayFuturesStatusServiceAPI) AutoRepay(autoRepay bool) ChangeAutoRepayFuturesStatusServiceAPI {
+	return (*changeAutoRepayFuturesStatusServiceAPI)((*ChangeAutoRepayFuturesStatusService)(a).AutoRepay(autoRepay))
+}
+
+func (a *changeAutoRepayFuturesStatusServiceAPI) Do(ctx context.Context, opts ...RequestOption) (*SuccessResponse, error) {
+	return (*ChangeAutoRepayFuturesStatusService)(a).Do(ctx, opts...)
+}
+
+// GetMarginInterestHistoryServiceAPI is the interface of GetMarginInterestHistoryService
+type GetMarginInterestHistoryServiceAPI interface {
+	Asset(asset string) GetMarginInterestHistoryServiceAPI
+	StartTime(startTime int64) GetMarginInterestHistoryServiceAPI
+	EndTime(endTime int64) GetMarginInterestHistoryServiceAPI
+	Current(current int64) GetMarginInterestHistoryServiceAPI
+	Size(size int64) GetMarginInterestHistoryServiceAPI
+	Archived(archived bool) GetMarginInterestHistoryServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) (*MarginInterestHistoryResponse, error)
+}
+
+type getMarginInterestHistoryServiceAPI GetMarginInterestHistoryService
+
+func (a *getMarginInterestHistoryServiceAPI) Asset(asset string) GetMarginInterestHistoryServiceAPI {
+	return (*getMarginInterestHistoryServiceAPI)((*GetMarginInterestHistoryService)(a).Asset(asset))
+}
+
+func (a *getMarginInterestHistoryServiceAPI) StartTime(startTime int64) GetMarginInterestHistoryServiceAPI {
+	return (*getMarginInterestHistoryServiceAPI)((*GetMarginInterestHistoryService)(a).StartTime(startTime))
+}
+
+func (a *getMarginInterestHistoryServiceAPI) EndTime(endTime int64) GetMarginInterestHistoryServiceAPI {
+	return (*getMarginInterestHistoryServiceAPI)((*GetMarginInterestHistoryService)(a).EndTime(endTime))
+}
+
+func (a *getMarginInterestHistoryServiceAPI) Current(current int64) GetMarginInterestHistoryServiceAPI {
+	return (*getMarginInterestHistoryServiceAPI)((*GetMarginInterestHistoryService)(a).Current(current))
+}
+
+func (a *getMarginInterestHistoryServiceAPI) Size(size int64) GetMarginInterestHistoryServiceAPI {
+	return (*getMarginInterestHistoryServiceAPI)((*GetMarginInterestHistoryService)(a).Size(size))
+}
+
+func (a *getMarginInterestHistoryServiceAPI) Archived(archived bool) GetMarginInterestHistoryServiceAPI {
+	return (*getMarginInterestHistoryServiceAPI)((*GetMarginInterestHistoryService)(a).Archived(archived))
+}
+
+func (a *getMarginInterestHistoryServiceAPI) Do(ctx context.Context, opts ...RequestOption) (*MarginInterestHistoryResponse, error) {
+	return (*GetMarginInterestHistoryService)(a).Do(ctx, opts...)
+}
+
+// RepayFuturesNegativeBalanceServiceAPI is the interface of RepayFuturesNegativeBalanceService
+type RepayFuturesNegativeBalanceServiceAPI interface {
+	Do(ctx context.Context, opts ...RequestOption) (*SuccessResponse, error)
+}
+
+type repayFuturesNegativeBalanceServiceAPI RepayFuturesNegativeBalanceService
+
+func (a *repayFuturesNegativeBalanceServiceAPI) Do(ctx context.Context, opts ...RequestOption) (*SuccessResponse, error) {
+	return (*RepayFuturesNegativeBalanceService)(a).Do(ctx, opts...)
+}
+
+// GetNegativeBalanceInterestHistoryServiceAPI is the interface of GetNegativeBalanceInterestHistoryService
+type GetNegativeBalanceInterestHistoryServiceAPI interface {
+	Asset(asset string) GetNegativeBalanceInterestHistoryServiceAPI
+	StartTime(startTime int64) GetNegativeBalanceInterestHistoryServiceAPI
+	EndTime(endTime int64) GetNegativeBalanceInterestHistoryServiceAPI
+	Size(size int64) GetNegativeBalanceInterestHistoryServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) ([]*NegativeBalanceInterest, error)
+}
+
+type getNegativeBalanceInterestHistoryServiceAPI GetNegativeBalanceInterestHistoryService
+
+func (a *getNegativeBalanceInterestHistoryServiceAPI) Asset(asset string) GetNegativeBalanceInterestHistoryServiceAPI {
+	return (*getNegativeBalanceInterestHistoryServiceAPI)((*GetNegativeBalanceInterestHistoryService)(a).Asset(asset))
+}
+
+func (a *getNegativeBalanceInterestHistoryServiceAPI) StartTime(startTime int64) GetNegativeBalanceInterestHistoryServiceAPI {
+	return (*getNegativeBalanceInterestHistoryServiceAPI)((*GetNegativeBalanceInterestHistoryService)(a).StartTime(startTime))
+}
+
+func (a *getNegativeBalanceInterestHistoryServiceAPI) EndTime(endTime int64) GetNegativeBalanceInterestHistoryServiceAPI {
+	return (*getNegativeBalanceInterestHistoryServiceAPI)((*GetNegativeBalanceInterestHistoryService)(a).EndTime(endTime))
+}
+
+func (a *getNegativeBalanceInterestHistoryServiceAPI) Size(size int64) GetNegativeBalanceInterestHistoryServiceAPI {
+	return (*getNegativeBalanceInterestHistoryServiceAPI)((*GetNegativeBalanceInterestHistoryService)(a).Size(size))
+}
+
+func (a *getNegativeBalanceInterestHistoryServiceAPI) Do(ctx context.Context, opts ...RequestOption) ([]*NegativeBalanceInterest, error) {
+	return (*GetNegativeBalanceInterestHistoryService)(a).Do(ctx, opts...)
+}
+
+// FundAutoCollectionServiceAPI is the interface of FundAutoCollectionService
+type FundAutoCollectionServiceAPI interface {
+	Do(ctx context.Context, opts ...RequestOption) (*SuccessResponse, error)
+}
+
+type fundAutoCollectionServiceAPI FundAutoCollectionService
+
+func (a *fundAutoCollectionServiceAPI) Do(ctx context.Context, opts ...RequestOption) (*SuccessResponse, error) {
+	return (*FundAutoCollectionService)(a).Do(ctx, opts...)
+}
+
+// FundCollectionByAssetServiceAPI is the interface of FundCollectionByAssetService
+type FundCollectionByAssetServiceAPI interface {
+	Asset(asset string) FundCollectionByAssetServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) (*SuccessResponse, error)
+}
+
+type fundCollectionByAssetServiceAPI FundCollectionByAssetService
+
+func (a *fundCollectionByAssetServiceAPI) Asset(asset string) FundCollectionByAssetServiceAPI {
+	return (*fundCollectionByAssetServiceAPI)((*FundCollectionByAssetService)(a).Asset(asset))
+}
+
+func (a *fundCollectionByAssetServiceAPI) Do(ctx context.Context, opts ...RequestOption) (*SuccessResponse, error) {
+	return (*FundCollectionByAssetService)(a).Do(ctx, opts...)
+}
+
+// BNBTransferServiceAPI is the interface of BNBTransferService
+type BNBTransferServiceAPI interface {
+	Amount(amount string) BNBTransferServiceAPI
+	TransferSide(transferSide string) BNBTransferServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) (*BNBTransferResponse, error)
+}
+
+type bnbTransferServiceAPI BNBTransferService
+
+func (a *bnbTransferServiceAPI) Amount(amount string) BNBTransferServiceAPI {
+	return (*bnbTransferServiceAPI)((*BNBTransferService)(a).Amount(amount))
+}
+
+func (a *bnbTransferServiceAPI) TransferSide(transferSide string) BNBTransferServiceAPI {
+	return (*bnbTransferServiceAPI)((*BNBTransferService)(a).TransferSide(transferSide))
+}
+
+func (a *bnbTransferServiceAPI) Do(ctx context.Context, opts ...RequestOption) (*BNBTransferResponse, error) {
+	return (*BNBTransferService)(a).Do(ctx, opts...)
+}
+
+// GetUMIncomeHistoryServiceAPI is the interface of GetUMIncomeHistoryService
+type GetUMIncomeHistoryServiceAPI interface {
+	Symbol(symbol string) GetUMIncomeHistoryServiceAPI
+	IncomeType(incomeType string) GetUMIncomeHistoryServiceAPI
+	StartTime(startTime int64) GetUMIncomeHistoryServiceAPI
+	EndTime(endTime int64) GetUMIncomeHistoryServiceAPI
+	Page(page int) GetUMIncomeHistoryServiceAPI
+	Limit(limit int) GetUMIncomeHistoryServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) ([]*Income, error)
+}
+
+type getUMIncomeHistoryServiceAPI GetUMIncomeHistoryService
+
+func (a *getUMIncomeHistoryServiceAPI) Symbol(symbol string) GetUMIncomeHistoryServiceAPI {
+	return (*getUMIncomeHistoryServiceAPI)((*GetUMIncomeHistoryService)(a).Symbol(symbol))
+}
+
+func (a *getUMIncomeHistoryServiceAPI) IncomeType(incomeType string) GetUMIncomeHistoryServiceAPI {
+	return (*getUMIncomeHistoryServiceAPI)((*GetUMIncomeHistoryService)(a).IncomeType(incomeType))
+}
+
+func (a *getUMIncomeHistoryServiceAPI) StartTime(startTime int64) GetUMIncomeHistoryServiceAPI {
+	return (*getUMIncomeHistoryServiceAPI)((*GetUMIncomeHistoryService)(a).StartTime(startTime))
+}
+
+func (a *getUMIncomeHistoryServiceAPI) EndTime(endTime int64) GetUMIncomeHistoryServiceAPI {
+	return (*getUMIncomeHistoryServiceAPI)((*GetUMIncomeHistoryService)(a).EndTime(endTime))
+}
+
+func (a *getUMIncomeHistoryServiceAPI) Page(page int) GetUMIncomeHistoryServiceAPI {
+	return (*getUMIncomeHistoryServiceAPI)((*GetUMIncomeHistoryService)(a).Page(page))
+}
+
+func (a *getUMIncomeHistoryServiceAPI) Limit(limit int) GetUMIncomeHistoryServiceAPI {
+	return (*getUMIncomeHistoryServiceAPI)((*GetUMIncomeHistoryService)(a).Limit(limit))
+}
+
+func (a *getUMIncomeHistoryServiceAPI) Do(ctx context.Context, opts ...RequestOption) ([]*Income, error) {
+	return (*GetUMIncomeHistoryService)(a).Do(ctx, opts...)
+}
+
+// GetCMIncomeHistoryServiceAPI is the interface of GetCMIncomeHistoryService
+type GetCMIncomeHistoryServiceAPI interface {
+	Symbol(symbol string) GetCMIncomeHistoryServiceAPI
+	IncomeType(incomeType string) GetCMIncomeHistoryServiceAPI
+	StartTime(startTime int64) GetCMIncomeHistoryServiceAPI
+	EndTime(endTime int64) GetCMIncomeHistoryServiceAPI
+	Page(page int) GetCMIncomeHistoryServiceAPI
+	Limit(limit int) GetCMIncomeHistoryServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) ([]*Income, error)
+}
+
+type getCMIncomeHistoryServiceAPI GetCMIncomeHistoryService
+
+func (a *getCMIncomeHistoryServiceAPI) Symbol(symbol string) GetCMIncomeHistoryServiceAPI {
+	return (*getCMIncomeHistoryServiceAPI)((*GetCMIncomeHistoryService)(a).Symbol(symbol))
+}
+
+func (a *getCMIncomeHistoryServiceAPI) IncomeType(incomeType string) GetCMIncomeHistoryServiceAPI {
+	return (*getCMIncomeHistoryServiceAPI)((*GetCMIncomeHistoryService)(a).IncomeType(incomeType))
+}
+
+func (a *getCMIncomeHistoryServiceAPI) StartTime(startTime int64) GetCMIncomeHistoryServiceAPI {
+	return (*getCMIncomeHistoryServiceAPI)((*GetCMIncomeHistoryService)(a).StartTime(startTime))
+}
+
+func (a *getCMIncomeHistoryServiceAPI) EndTime(endTime int64) GetCMIncomeHistoryServiceAPI {
+	return (*getCMIncomeHistoryServiceAPI)((*GetCMIncomeHistoryService)(a).EndTime(endTime))
+}
+
+func (a *getCMIncomeHistoryServiceAPI) Page(page int) GetCMIncomeHistoryServiceAPI {
+	return (*getCMIncomeHistoryServiceAPI)((*GetCMIncomeHistoryService)(a).Page(page))
+}
+
+func (a *getCMIncomeHistoryServiceAPI) Limit(limit int) GetCMIncomeHistoryServiceAPI {
+	return (*getCMIncomeHistoryServiceAPI)((*GetCMIncomeHistoryService)(a).Limit(limit))
+}
+
+func (a *getCMIncomeHistoryServiceAPI) Do(ctx context.Context, opts ...RequestOption) ([]*Income, error) {
+	return (*GetCMIncomeHistoryService)(a).Do(ctx, opts...)
+}
+
+// GetUMAccountDetailServiceAPI is the interface of GetUMAccountDetailService
+type GetUMAccountDetailServiceAPI interface {
+	Do(ctx context.Context, opts ...RequestOption) (*UMAccountDetail, error)
+}
+
+type getUMAccountDetailServiceAPI GetUMAccountDetailService
+
+func (a *getUMAccountDetailServiceAPI) Do(ctx context.Context, opts ...RequestOption) (*UMAccountDetail, error) {
+	return (*GetUMAccountDetailService)(a).Do(ctx, opts...)
+}
+
+// GetCMAccountDetailServiceAPI is the interface of GetCMAccountDetailService
+type GetCMAccountDetailServiceAPI interface {
+	Do(ctx context.Context, opts ...RequestOption) (*CMAccountDetail, error)
+}
+
+type getCMAccountDetailServiceAPI GetCMAccountDetailService
+
+func (a *getCMAccountDetailServiceAPI) Do(ctx context.Context, opts ...RequestOption) (*CMAccountDetail, error) {
+	return (*GetCMAccountDetailService)(a).Do(ctx, opts...)
+}
+
+// UMAccountConfigServiceAPI is the interface of UMAccountConfigService
+type UMAccountConfigServiceAPI interface {
+	Do(ctx context.Context) (*UMAccountConfig, error)
+}
+
+type umAccountConfigServiceAPI UMAccountConfigService
+
+func (a *umAccountConfigServiceAPI) Do(ctx context.Context) (*UMAccountConfig, error) {
+	return (*UMAccountConfigService)(a).Do(ctx)
+}
+
+// UMSymbolConfigServiceAPI is the interface of UMSymbolConfigService
+type UMSymbolConfigServiceAPI interface {
+	Symbol(symbol string) UMSymbolConfigServiceAPI
+	Do(ctx context.Context) ([]*UMSymbolConfig, error)
+}
+
+type umSymbolConfigServiceAPI UMSymbolConfigService
+
+func (a *umSymbolConfigServiceAPI) Symbol(symbol string) UMSymbolConfigServiceAPI {
+	return (*umSymbolConfigServiceAPI)((*UMSymbolConfigService)(a).Symbol(symbol))
+}
+
+func (a *umSymbolConfigServiceAPI) Do(ctx context.Context) ([]*UMSymbolConfig, error) {
+	return (*UMSymbolConfigService)(a).Do(ctx)
+}
+
+// UMAccountDetailV2ServiceAPI is the interface of UMAccountDetailV2Service
+type UMAccountDetailV2ServiceAPI interface {
+	Do(ctx context.Context) (*UMAccountDetailV2, error)
+}
+
+type umAccountDetailV2ServiceAPI UMAccountDetailV2Service
+
+func (a *umAccountDetailV2ServiceAPI) Do(ctx context.Context) (*UMAccountDetailV2, error) {
+	return (*UMAccountDetailV2Service)(a).Do(ctx)
+}
+
+// GetUMTradeHistoryDownloadIDServiceAPI is the interface of GetUMTradeHistoryDownloadIDService
+type GetUMTradeHistoryDownloadIDServiceAPI interface {
+	StartTime(startTime int64) GetUMTradeHistoryDownloadIDServiceAPI
+	EndTime(endTime int64) GetUMTradeHistoryDownloadIDServiceAPI
+	RecvWindow(recvWindow int64) GetUMTradeHistoryDownloadIDServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) (*UMTradeHistoryDownloadID, error)
+}
+
+type getUMTradeHistoryDownloadIDServiceAPI GetUMTradeHistoryDownloadIDService
+
+func (a *getUMTradeHistoryDownloadIDServiceAPI) StartTime(startTime int64) GetUMTradeHistoryDownloadIDServiceAPI {
+	return (*getUMTradeHistoryDownloadIDServiceAPI)((*GetUMTradeHistoryDownloadIDService)(a).StartTime(startTime))
+}
+
+func (a *getUMTradeHistoryDownloadIDServiceAPI) EndTime(endTime int64) GetUMTradeHistoryDownloadIDServiceAPI {
+	return (*getUMTradeHistoryDownloadIDServiceAPI)((*GetUMTradeHistoryDownloadIDService)(a).EndTime(endTime))
+}
+
+func (a *getUMTradeHistoryDownloadIDServiceAPI) RecvWindow(recvWindow int64) GetUMTradeHistoryDownloadIDServiceAPI {
+	return (*getUMTradeHistoryDownloadIDServiceAPI)((*GetUMTradeHistoryDownloadIDService)(a).RecvWindow(recvWindow))
+}
+
+func (a *getUMTradeHistoryDownloadIDServiceAPI) Do(ctx context.Context, opts ...RequestOption) (*UMTradeHistoryDownloadID, error) {
+	return (*GetUMTradeHistoryDownloadIDService)(a).Do(ctx, opts...)
+}
+
+// GetUMTradeDownloadLinkServiceAPI is the interface of GetUMTradeDownloadLinkService
+type GetUMTradeDownloadLinkServiceAPI interface {
+	DownloadID(downloadID string) GetUMTradeDownloadLinkServiceAPI
+	RecvWindow(recvWindow int64) GetUMTradeDownloadLinkServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) (*UMTradeDownloadLink, error)
+}
+
+type getUMTradeDownloadLinkServiceAPI GetUMTradeDownloadLinkService
+
+func (a *getUMTradeDownloadLinkServiceAPI) DownloadID(downloadID string) GetUMTradeDownloadLinkServiceAPI {
+	return (*getUMTradeDownloadLinkServiceAPI)((*GetUMTradeDownloadLinkService)(a).DownloadID(downloadID))
+}
+
+func (a *getUMTradeDownloadLinkServiceAPI) RecvWindow(recvWindow int64) GetUMTradeDownloadLinkServiceAPI {
+	return (*getUMTradeDownloadLinkServiceAPI)((*GetUMTradeDownloadLinkService)(a).RecvWindow(recvWindow))
+}
+
+func (a *getUMTradeDownloadLinkServiceAPI) Do(ctx context.Context, opts ...RequestOption) (*UMTradeDownloadLink, error) {
+	return (*GetUMTradeDownloadLinkService)(a).Do(ctx, opts...)
+}
+
+// GetUMOrderHistoryDownloadIDServiceAPI is the interface of GetUMOrderHistoryDownloadIDService
+type GetUMOrderHistoryDownloadIDServiceAPI interface {
+	StartTime(startTime int64) GetUMOrderHistoryDownloadIDServiceAPI
+	EndTime(endTime int64) GetUMOrderHistoryDownloadIDServiceAPI
+	RecvWindow(recvWindow int64) GetUMOrderHistoryDownloadIDServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) (*UMOrderHistoryDownloadID, error)
+}
+
+type getUMOrderHistoryDownloadIDServiceAPI GetUMOrderHistoryDownloadIDService
+
+func (a *getUMOrderHistoryDownloadIDServiceAPI) StartTime(startTime int64) GetUMOrderHistoryDownloadIDServiceAPI {
+	return (*getUMOrderHistoryDownloadIDServiceAPI)((*GetUMOrderHistoryDownloadIDService)(a).StartTime(startTime))
+}
+
+func (a *getUMOrderHistoryDownloadIDServiceAPI) EndTime(endTime int64) GetUMOrderHistoryDownloadIDServiceAPI {
+	return (*getUMOrderHistoryDownloadIDServiceAPI)((*GetUMOrderHistoryDownloadIDService)(a).EndTime(endTime))
+}
+
+func (a *getUMOrderHistoryDownloadIDServiceAPI) RecvWindow(recvWindow int64) GetUMOrderHistoryDownloadIDServiceAPI {
+	return (*getUMOrderHistoryDownloadIDServiceAPI)((*GetUMOrderHistoryDownloadIDService)(a).RecvWindow(recvWindow))
+}
+
+func (a *getUMOrderHistoryDownloadIDServiceAPI) Do(ctx context.Context, opts ...RequestOption) (*UMOrderHistoryDownloadID, error) {
+	return (*GetUMOrderHistoryDownloadIDService)(a).Do(ctx, opts...)
+}
+
+// GetUMOrderDownloadLinkServiceAPI is the interface of GetUMOrderDownloadLinkService
+type GetUMOrderDownloadLinkServiceAPI interface {
+	DownloadID(downloadID string) GetUMOrderDownloadLinkServiceAPI
+	RecvWindow(recvWindow int64) GetUMOrderDownloadLinkServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) (*UMOrderDownloadLink, error)
+}
+
+type getUMOrderDownloadLinkServiceAPI GetUMOrderDownloadLinkService
+
+func (a *getUMOrderDownloadLinkServiceAPI) DownloadID(downloadID string) GetUMOrderDownloadLinkServiceAPI {
+	return (*getUMOrderDownloadLinkServiceAPI)((*GetUMOrderDownloadLinkService)(a).DownloadID(downloadID))
+}
+
+func (a *getUMOrderDownloadLinkServiceAPI) RecvWindow(recvWindow int64) GetUMOrderDownloadLinkServiceAPI {
+	return (*getUMOrderDownloadLinkServiceAPI)((*GetUMOrderDownloadLinkService)(a).RecvWindow(recvWindow))
+}
+
+func (a *getUMOrderDownloadLinkServiceAPI) Do(ctx context.Context, opts ...RequestOption) (*UMOrderDownloadLink, error) {
+	return (*GetUMOrderDownloadLinkService)(a).Do(ctx, opts...)
+}
+
+// GetUMTransactionHistoryDownloadIDServiceAPI is the interface of GetUMTransactionHistoryDownloadIDService
+type GetUMTransactionHistoryDownloadIDServiceAPI interface {
+	StartTime(startTime int64) GetUMTransactionHistoryDownloadIDServiceAPI
+	EndTime(endTime int64) GetUMTransactionHistoryDownloadIDServiceAPI
+	RecvWindow(recvWindow int64) GetUMTransactionHistoryDownloadIDServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) (*UMTransactionHistoryDownloadID, error)
+}
+
+type getUMTransactionHistoryDownloadIDServiceAPI GetUMTransactionHistoryDownloadIDService
+
+func (a *getUMTransactionHistoryDownloadIDServiceAPI) StartTime(startTime int64) GetUMTransactionHistoryDownloadIDServiceAPI {
+	return (*getUMTransactionHistoryDownloadIDServiceAPI)((*GetUMTransactionHistoryDownloadIDService)(a).StartTime(startTime))
+}
+
+func (a *getUMTransactionHistoryDownloadIDServiceAPI) EndTime(endTime int64) GetUMTransactionHistoryDownloadIDServiceAPI {
+	return (*getUMTransactionHistoryDownloadIDServiceAPI)((*GetUMTransactionHistoryDownloadIDService)(a).EndTime(endTime))
+}
+
+func (a *getUMTransactionHistoryDownloadIDServiceAPI) RecvWindow(recvWindow int64) GetUMTransactionHistoryDownloadIDServiceAPI {
+	return (*getUMTransactionHistoryDownloadIDServiceAPI)((*GetUMTransactionHistoryDownloadIDService)(a).RecvWindow(recvWindow))
+}
+
+func (a *getUMTransactionHistoryDownloadIDServiceAPI) Do(ctx context.Context, opts ...RequestOption) (*UMTransactionHistoryDownloadID, error) {
+	return (*GetUMTransactionHistoryDownloadIDService)(a).Do(ctx, opts...)
+}
+
+// GetUMTransactionDownloadLinkServiceAPI is the interface of GetUMTransactionDownloadLinkService
+type GetUMTransactionDownloadLinkServiceAPI interface {
+	DownloadID(downloadID string) GetUMTransactionDownloadLinkServiceAPI
+	RecvWindow(recvWindow int64) GetUMTransactionDownloadLinkServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) (*UMTransactionDownloadLink, error)
+}
+
+type getUMTransactionDownloadLinkServiceAPI GetUMTransactionDownloadLinkService
+
+func (a *getUMTransactionDownloadLinkServiceAPI) DownloadID(downloadID string) GetUMTransactionDownloadLinkServiceAPI {
+	return (*getUMTransactionDownloadLinkServiceAPI)((*GetUMTransactionDownloadLinkService)(a).DownloadID(downloadID))
+}
+
+func (a *getUMTransactionDownloadLinkServiceAPI) RecvWindow(recvWindow int64) GetUMTransactionDownloadLinkServiceAPI {
+	return (*getUMTransactionDownloadLinkServiceAPI)((*GetUMTransactionDownloadLinkService)(a).RecvWindow(recvWindow))
+}
+
+func (a *getUMTransactionDownloadLinkServiceAPI) Do(ctx context.Context, opts ...RequestOption) (*UMTransactionDownloadLink, error) {
+	return (*GetUMTransactionDownloadLinkService)(a).Do(ctx, opts...)
+}
+
+// GetRateLimitServiceAPI is the interface of GetRateLimitService
+type GetRateLimitServiceAPI interface {
+	RecvWindow(recvWindow int64) GetRateLimitServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) ([]*RateLimit, error)
+}
+
+type getRateLimitServiceAPI GetRateLimitService
+
+func (a *getRateLimitServiceAPI) RecvWindow(recvWindow int64) GetRateLimitServiceAPI {
+	return (*getRateLimitServiceAPI)((*GetRateLimitService)(a).RecvWindow(recvWindow))
+}
+
+func (a *getRateLimitServiceAPI) Do(ctx context.Context, opts ...RequestOption) ([]*RateLimit, error) {
+	return (*GetRateLimitService)(a).Do(ctx, opts...)
+}
+
+// GetNegativeBalanceExchangeRecordServiceAPI is the interface of GetNegativeBalanceExchangeRecordService
+type GetNegativeBalanceExchangeRecordServiceAPI interface {
+	StartTime(startTime int64) GetNegativeBalanceExchangeRecordServiceAPI
+	EndTime(endTime int64) GetNegativeBalanceExchangeRecordServiceAPI
+	RecvWindow(recvWindow int64) GetNegativeBalanceExchangeRecordServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) (*NegativeBalanceExchangeRecord, error)
+}
+
+type getNegativeBalanceExchangeRecordServiceAPI GetNegativeBalanceExchangeRecordService
+
+func (a *getNegativeBalanceExchangeRecordServiceAPI) StartTime(startTime int64) GetNegativeBalanceExchangeRecordServiceAPI {
+	return (*getNegativeBalanceExchangeRecordServiceAPI)((*GetNegativeBalanceExchangeRecordService)(a).StartTime(startTime))
+}
+
+func (a *getNegativeBalanceExchangeRecordServiceAPI) EndTime(endTime int64) GetNegativeBalanceExchangeRecordServiceAPI {
+	return (*getNegativeBalanceExchangeRecordServiceAPI)((*GetNegativeBalanceExchangeRecordService)(a).EndTime(endTime))
+}
+
+func (a *getNegativeBalanceExchangeRecordServiceAPI) RecvWindow(recvWindow int64) GetNegativeBalanceExchangeRecordServiceAPI {
+	return (*getNegativeBalanceExchangeRecordServiceAPI)((*GetNegativeBalanceExchangeRecordService)(a).RecvWindow(recvWindow))
+}
+
+func (a *getNegativeBalanceExchangeRecordServiceAPI) Do(ctx context.Context, opts ...RequestOption) (*NegativeBalanceExchangeRecord, error) {
+	return (*GetNegativeBalanceExchangeRecordService)(a).Do(ctx, opts...)
+}
+
+// UMOrderServiceAPI is the interface of UMOrderService
+type UMOrderServiceAPI interface {
+	Symbol(symbol string) UMOrderServiceAPI
+	Side(side SideType) UMOrderServiceAPI
+	PositionSide(positionSide PositionSideType) UMOrderServiceAPI
+	Type(orderType OrderType) UMOrderServiceAPI
+	TimeInForce(timeInForce TimeInForceType) UMOrderServiceAPI
+	Quantity(quantity string) UMOrderServiceAPI
+	ReduceOnly(reduceOnly bool) UMOrderServiceAPI
+	Price(price string) UMOrderServiceAPI
+	NewClientOrderID(newClientOrderID string) UMOrderServiceAPI
+	NewOrderRespType(newOrderRespType NewOrderRespType) UMOrderServiceAPI
+	PriceMatch(priceMatch PriceMatchType) UMOrderServiceAPI
+	SelfTradePreventionMode(mode SelfTradePreventionMode) UMOrderServiceAPI
+	GoodTillDate(timestamp int64) UMOrderServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) (*UMOrder, error)
+}
+
+type umOrderServiceAPI UMOrderService
+
+func (a *umOrderServiceAPI) Symbol(symbol string) UMOrderServiceAPI {
+	return (*umOrderServiceAPI)((*UMOrderService)(a).Symbol(symbol))
+}
+
+func (a *umOrderServiceAPI) Side(side SideType) UMOrderServiceAPI {
+	return (*umOrderServiceAPI)((*UMOrderService)(a).Side(side))
+}
+
+func (a *umOrderServiceAPI) PositionSide(positionSide PositionSideType) UMOrderServiceAPI {
+	return (*umOrderServiceAPI)((*UMOrderService)(a).PositionSide(positionSide))
+}
+
+func (a *umOrderServiceAPI) Type(orderType OrderType) UMOrderServiceAPI {
+	return (*umOrderServiceAPI)((*UMOrderService)(a).Type(orderType))
+}
+
+func (a *umOrderServiceAPI) TimeInForce(timeInForce TimeInForceType) UMOrderServiceAPI {
+	return (*umOrderServiceAPI)((*UMOrderService)(a).TimeInForce(timeInForce))
+}
+
+func (a *umOrderServiceAPI) Quantity(quantity string) UMOrderServiceAPI {
+	return (*umOrderServiceAPI)((*UMOrderService)(a).Quantity(quantity))
+}
+
+func (a *umOrderServiceAPI) ReduceOnly(reduceOnly bool) UMOrderServiceAPI {
+	return (*umOrderServiceAPI)((*UMOrderService)(a).ReduceOnly(reduceOnly))
+}
+
+func (a *umOrderServiceAPI) Price(price string) UMOrderServiceAPI {
+	return (*umOrderServiceAPI)((*UMOrderService)(a).Price(price))
+}
+
+func (a *umOrderServiceAPI) NewClientOrderID(newClientOrderID string) UMOrderServiceAPI {
+	return (*umOrderServiceAPI)((*UMOrderService)(a).NewClientOrderID(newClientOrderID))
+}
+
+func (a *umOrderServiceAPI) NewOrderRespType(newOrderRespType NewOrderRespType) UMOrderServiceAPI {
+	return (*umOrderServiceAPI)((*UMOrderService)(a).NewOrderRespType(newOrderRespType))
+}
+
+func (a *umOrderServiceAPI) PriceMatch(priceMatch PriceMatchType) UMOrderServiceAPI {
+	return (*umOrderServiceAPI)((*UMOrderService)(a).PriceMatch(priceMatch))
+}
+
+func (a *umOrderServiceAPI) SelfTradePreventionMode(mode SelfTradePreventionMode) UMOrderServiceAPI {
+	return (*umOrderServiceAPI)((*UMOrderService)(a).SelfTradePreventionMode(mode))
+}
+
+func (a *umOrderServiceAPI) GoodTillDate(timestamp int64) UMOrderServiceAPI {
+	return (*umOrderServiceAPI)((*UMOrderService)(a).GoodTillDate(timestamp))
+}
+
+func (a *umOrderServiceAPI) Do(ctx context.Context, opts ...RequestOption) (*UMOrder, error) {
+	return (*UMOrderService)(a).Do(ctx, opts...)
+}
+
+// UMConditionalOrderServiceAPI is the interface of UMConditionalOrderService
+type UMConditionalOrderServiceAPI interface {
+	Symbol(symbol string) UMConditionalOrderServiceAPI
+	Side(side SideType) UMConditionalOrderServiceAPI
+	PositionSide(positionSide PositionSideType) UMConditionalOrderServiceAPI
+	StrategyType(strategyType string) UMConditionalOrderServiceAPI
+	TimeInForce(timeInForce TimeInForceType) UMConditionalOrderServiceAPI
+	Quantity(quantity string) UMConditionalOrderServiceAPI
+	Price(price string) UMConditionalOrderServiceAPI
+	StopPrice(stopPrice string) UMConditionalOrderServiceAPI
+	ActivationPrice(price string) UMConditionalOrderServiceAPI
+	CallbackRate(rate string) UMConditionalOrderServiceAPI
+	WorkingType(workingType string) UMConditionalOrderServiceAPI
+	PriceProtect(protect bool) UMConditionalOrderServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) (*UMConditionalOrder, error)
+}
+
+type umConditionalOrderServiceAPI UMConditionalOrderService
+
+func (a *umConditionalOrderServiceAPI) Symbol(symbol string) UMConditionalOrderServiceAPI {
+	return (*umConditionalOrderServiceAPI)((*UMConditionalOrderService)(a).Symbol(symbol))
+}
+
+func (a *umConditionalOrderServiceAPI) Side(side SideType) UMConditionalOrderServiceAPI {
+	return (*umConditionalOrderServiceAPI)((*UMConditionalOrderService)(a).Side(side))
+}
+
+func (a *umConditionalOrderServiceAPI) PositionSide(positionSide PositionSideType) UMConditionalOrderServiceAPI {
+	return (*umConditionalOrderServiceAPI)((*UMConditionalOrderService)(a).PositionSide(positionSide))
+}
+
+func (a *umConditionalOrderServiceAPI) StrategyType(strategyType string) UMConditionalOrderServiceAPI {
+	return (*umConditionalOrderServiceAPI)((*UMConditionalOrderService)(a).StrategyType(strategyType))
+}
+
+func (a *umConditionalOrderServiceAPI) TimeInForce(timeInForce TimeInForceType) UMConditionalOrderServiceAPI {
+	return (*umConditionalOrderServiceAPI)((*UMConditionalOrderService)(a).TimeInForce(timeInForce))
+}
+
+func (a *umConditionalOrderServiceAPI) Quantity(quantity string) UMConditionalOrderServiceAPI {
+	return (*umConditionalOrderServiceAPI)((*UMConditionalOrderService)(a).Quantity(quantity))
+}
+
+func (a *umConditionalOrderServiceAPI) Price(price string) UMConditionalOrderServiceAPI {
+	return (*umConditionalOrderServiceAPI)((*UMConditionalOrderService)(a).Price(price))
+}
+
+func (a *umConditionalOrderServiceAPI) StopPrice(stopPrice string) UMConditionalOrderServiceAPI {
+	return (*umConditionalOrderServiceAPI)((*UMConditionalOrderService)(a).StopPrice(stopPrice))
+}
+
+func (a *umConditionalOrderServiceAPI) ActivationPrice(price string) UMConditionalOrderServiceAPI {
+	return (*umConditionalOrderServiceAPI)((*UMConditionalOrderService)(a).ActivationPrice(price))
+}
+
+func (a *umConditionalOrderServiceAPI) CallbackRate(rate string) UMConditionalOrderServiceAPI {
+	return (*umConditionalOrderServiceAPI)((*UMConditionalOrderService)(a).CallbackRate(rate))
+}
+
+func (a *umConditionalOrderServiceAPI) WorkingType(workingType string) UMConditionalOrderServiceAPI {
+	return (*umConditionalOrderServiceAPI)((*UMConditionalOrderService)(a).WorkingType(workingType))
+}
+
+func (a *umConditionalOrderServiceAPI) PriceProtect(protect bool) UMConditionalOrderServiceAPI {
+	return (*umConditionalOrderServiceAPI)((*UMConditionalOrderService)(a).PriceProtect(protect))
+}
+
+func (a *umConditionalOrderServiceAPI) Do(ctx context.Context, opts ...RequestOption) (*UMConditionalOrder, error) {
+	return (*UMConditionalOrderService)(a).Do(ctx, opts...)
+}
+
+// CMOrderServiceAPI is the interface of CMOrderService
+type CMOrderServiceAPI interface {
+	Symbol(symbol string) CMOrderServiceAPI
+	Side(side SideType) CMOrderServiceAPI
+	PositionSide(positionSide PositionSideType) CMOrderServiceAPI
+	Type(orderType OrderType) CMOrderServiceAPI
+	TimeInForce(timeInForce TimeInForceType) CMOrderServiceAPI
+	Quantity(quantity string) CMOrderServiceAPI
+	ReduceOnly(reduceOnly bool) CMOrderServiceAPI
+	Price(price string) CMOrderServiceAPI
+	NewClientOrderID(newClientOrderID string) CMOrderServiceAPI
+	NewOrderRespType(newOrderRespType NewOrderRespType) CMOrderServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) (*CMOrder, error)
+}
+
+type cmOrderServiceAPI CMOrderService
+
+func (a *cmOrderServiceAPI) Symbol(symbol string) CMOrderServiceAPI {
+	return (*cmOrderServiceAPI)((*CMOrderService)(a).Symbol(symbol))
+}
+
+func (a *cmOrderServiceAPI) Side(side SideType) CMOrderServiceAPI {
+	return (*cmOrderServiceAPI)((*CMOrderService)(a).Side(side))
+}
+
+func (a *cmOrderServiceAPI) PositionSide(positionSide PositionSideType) CMOrderServiceAPI {
+	return (*cmOrderServiceAPI)((*CMOrderService)(a).PositionSide(positionSide))
+}
+
+func (a *cmOrderServiceAPI) Type(orderType OrderType) CMOrderServiceAPI {
+	return (*cmOrderServiceAPI)((*CMOrderService)(a).Type(orderType))
+}
+
+func (a *cmOrderServiceAPI) TimeInForce(timeInForce TimeInForceType) CMOrderServiceAPI {
+	return (*cmOrderServiceAPI)((*CMOrderService)(a).TimeInForce(timeInForce))
+}
+
+func (a *cmOrderServiceAPI) Quantity(quantity string) CMOrderServiceAPI {
+	return (*cmOrderServiceAPI)((*CMOrderService)(a).Quantity(quantity))
+}
+
+func (a *cmOrderServiceAPI) ReduceOnly(reduceOnly bool) CMOrderServiceAPI {
+	return (*cmOrderServiceAPI)((*CMOrderService)(a).ReduceOnly(reduceOnly))
+}
+
+func (a *cmOrderServiceAPI) Price(price string) CMOrderServiceAPI {
+	return (*cmOrderServiceAPI)((*CMOrderService)(a).Price(price))
+}
+
+func (a *cmOrderServiceAPI) NewClientOrderID(newClientOrderID string) CMOrderServiceAPI {
+	return (*cmOrderServiceAPI)((*CMOrderService)(a).NewClientOrderID(newClientOrderID))
+}
+
+func (a *cmOrderServiceAPI) NewOrderRespType(newOrderRespType NewOrderRespType) CMOrderServiceAPI {
+	return (*cmOrderServiceAPI)((*CMOrderService)(a).NewOrderRespType(newOrderRespType))
+}
+
+func (a *cmOrderServiceAPI) Do(ctx context.Context, opts ...RequestOption) (*CMOrder, error) {
+	return (*CMOrderService)(a).Do(ctx, opts...)
+}
+
+// CMConditionalOrderServiceAPI is the interface of CMConditionalOrderService
+type CMConditionalOrderServiceAPI interface {
+	Symbol(symbol string) CMConditionalOrderServiceAPI
+	Side(side SideType) CMConditionalOrderServiceAPI
+	PositionSide(positionSide PositionSideType) CMConditionalOrderServiceAPI
+	StrategyType(strategyType string) CMConditionalOrderServiceAPI
+	TimeInForce(timeInForce TimeInForceType) CMConditionalOrderServiceAPI
+	Quantity(quantity string) CMConditionalOrderServiceAPI
+	Price(price string) CMConditionalOrderServiceAPI
+	StopPrice(stopPrice string) CMConditionalOrderServiceAPI
+	ActivationPrice(price string) CMConditionalOrderServiceAPI
+	CallbackRate(rate string) CMConditionalOrderServiceAPI
+	WorkingType(workingType string) CMConditionalOrderServiceAPI
+	PriceProtect(protect bool) CMConditionalOrderServiceAPI
+	NewClientStrategyID(id string) CMConditionalOrderServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) (*CMConditionalOrder, error)
+}
+
+type cmConditionalOrderServiceAPI CMConditionalOrderService
+
+func (a *cmConditionalOrderServiceAPI) Symbol(symbol string) CMConditionalOrderServiceAPI {
+	return (*cmConditionalOrderServiceAPI)((*CMConditionalOrderService)(a).Symbol(symbol))
+}
+
+func (a *cmConditionalOrderServiceAPI) Side(side SideType) CMConditionalOrderServiceAPI {
+	return (*cmConditionalOrderServiceAPI)((*CMConditionalOrderService)(a).Side(side))
+}
+
+func (a *cmConditionalOrderServiceAPI) PositionSide(positionSide PositionSideType) CMConditionalOrderServiceAPI {
+	return (*cmConditionalOrderServiceAPI)((*CMConditionalOrderService)(a).PositionSide(positionSide))
+}
+
+func (a *cmConditionalOrderServiceAPI) StrategyType(strategyType string) CMConditionalOrderServiceAPI {
+	return (*cmConditionalOrderServiceAPI)((*CMConditionalOrderService)(a).StrategyType(strategyType))
+}
+
+func (a *cmConditionalOrderServiceAPI) TimeInForce(timeInForce TimeInForceType) CMConditionalOrderServiceAPI {
+	return (*cmConditionalOrderServiceAPI)((*CMConditionalOrderService)(a).TimeInForce(timeInForce))
+}
+
+func (a *cmConditionalOrderServiceAPI) Quantity(quantity string) CMConditionalOrderServiceAPI {
+	return (*cmConditionalOrderServiceAPI)((*CMConditionalOrderService)(a).Quantity(quantity))
+}
+
+func (a *cmConditionalOrderServiceAPI) Price(price string) CMConditionalOrderServiceAPI {
+	return (*cmConditionalOrderServiceAPI)((*CMConditionalOrderService)(a).Price(price))
+}
+
+func (a *cmConditionalOrderServiceAPI) StopPrice(stopPrice string) CMConditionalOrderServiceAPI {
+	return (*cmConditionalOrderServiceAPI)((*CMConditionalOrderService)(a).StopPrice(stopPrice))
+}
+
+func (a *cmConditionalOrderServiceAPI) ActivationPrice(price string) CMConditionalOrderServiceAPI {
+	return (*cmConditionalOrderServiceAPI)((*CMConditionalOrderService)(a).ActivationPrice(price))
+}
+
+func (a *cmConditionalOrderServiceAPI) CallbackRate(rate string) CMConditionalOrderServiceAPI {
+	return (*cmConditionalOrderServiceAPI)((*CMConditionalOrderService)(a).CallbackRate(rate))
+}
+
+func (a *cmConditionalOrderServiceAPI) WorkingType(workingType string) CMConditionalOrderServiceAPI {
+	return (*cmConditionalOrderServiceAPI)((*CMConditionalOrderService)(a).WorkingType(workingType))
+}
+
+func (a *cmConditionalOrderServiceAPI) PriceProtect(protect bool) CMConditionalOrderServiceAPI {
+	return (*cmConditionalOrderServiceAPI)((*CMConditionalOrderService)(a).PriceProtect(protect))
+}
+
+func (a *cmConditionalOrderServiceAPI) NewClientStrategyID(id string) CMConditionalOrderServiceAPI {
+	return (*cmConditionalOrderServiceAPI)((*CMConditionalOrderService)(a).NewClientStrategyID(id))
+}
+
+func (a *cmConditionalOrderServiceAPI) Do(ctx context.Context, opts ...RequestOption) (*CMConditionalOrder, error) {
+	return (*CMConditionalOrderService)(a).Do(ctx, opts...)
+}
+
+// MarginOrderServiceAPI is the interface of MarginOrderService
+type MarginOrderServiceAPI interface {
+	Symbol(symbol string) MarginOrderServiceAPI
+	Side(side SideType) MarginOrderServiceAPI
+	Type(orderType OrderType) MarginOrderServiceAPI
+	Quantity(quantity string) MarginOrderServiceAPI
+	QuoteOrderQty(quoteOrderQty string) MarginOrderServiceAPI
+	Price(price string) MarginOrderServiceAPI
+	StopPrice(stopPrice string) MarginOrderServiceAPI
+	NewClientOrderID(newClientOrderID string) MarginOrderServiceAPI
+	NewOrderRespType(newOrderRespType NewOrderRespType) MarginOrderServiceAPI
+	IcebergQty(icebergQty string) MarginOrderServiceAPI
+	SideEffectType(sideEffectType string) MarginOrderServiceAPI
+	TimeInForce(timeInForce TimeInForceType) MarginOrderServiceAPI
+	SelfTradePreventionMode(mode SelfTradePreventionMode) MarginOrderServiceAPI
+	AutoRepayAtCancel(autoRepay bool) MarginOrderServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) (*MarginOrder, error)
+}
+
+type marginOrderServiceAPI MarginOrderService
+
+func (a *marginOrderServiceAPI) Symbol(symbol string) MarginOrderServiceAPI {
+	return (*marginOrderServiceAPI)((*MarginOrderService)(a).Symbol(symbol))
+}
+
+func (a *marginOrderServiceAPI) Side(side SideType) MarginOrderServiceAPI {
+	return (*marginOrderServiceAPI)((*MarginOrderService)(a).Side(side))
+}
+
+func (a *marginOrderServiceAPI) Type(orderType OrderType) MarginOrderServiceAPI {
+	return (*marginOrderServiceAPI)((*MarginOrderService)(a).Type(orderType))
+}
+
+func (a *marginOrderServiceAPI) Quantity(quantity string) MarginOrderServiceAPI {
+	return (*marginOrderServiceAPI)((*MarginOrderService)(a).Quantity(quantity))
+}
+
+func (a *marginOrderServiceAPI) QuoteOrderQty(quoteOrderQty string) MarginOrderServiceAPI {
+	return (*marginOrderServiceAPI)((*MarginOrderService)(a).QuoteOrderQty(quoteOrderQty))
+}
+
+func (a *marginOrderServiceAPI) Price(price string) MarginOrderServiceAPI {
+	return (*marginOrderServiceAPI)((*MarginOrderService)(a).Price(price))
+}
+
+func (a *marginOrderServiceAPI) StopPrice(stopPrice string) MarginOrderServiceAPI {
+	return (*marginOrderServiceAPI)((*MarginOrderService)(a).StopPrice(stopPrice))
+}
+
+func (a *marginOrderServiceAPI) NewClientOrderID(newClientOrderID string) MarginOrderServiceAPI {
+	return (*marginOrderServiceAPI)((*MarginOrderService)(a).NewClientOrderID(newClientOrderID))
+}
+
+func (a *marginOrderServiceAPI) NewOrderRespType(newOrderRespType NewOrderRespType) MarginOrderServiceAPI {
+	return (*marginOrderServiceAPI)((*MarginOrderService)(a).NewOrderRespType(newOrderRespType))
+}
+
+func (a *marginOrderServiceAPI) IcebergQty(icebergQty string) MarginOrderServiceAPI {
+	return (*marginOrderServiceAPI)((*MarginOrderService)(a).IcebergQty(icebergQty))
+}
+
+func (a *marginOrderServiceAPI) SideEffectType(sideEffectType string) MarginOrderServiceAPI {
+	return (*marginOrderServiceAPI)((*MarginOrderService)(a).SideEffectType(sideEffectType))
+}
+
+func (a *marginOrderServiceAPI) TimeInForce(timeInForce TimeInForceType) MarginOrderServiceAPI {
+	return (*marginOrderServiceAPI)((*MarginOrderService)(a).TimeInForce(timeInForce))
+}
+
+func (a *marginOrderServiceAPI) SelfTradePreventionMode(mode SelfTradePreventionMode) MarginOrderServiceAPI {
+	return (*marginOrderServiceAPI)((*MarginOrderService)(a).SelfTradePreventionMode(mode))
+}
+
+func (a *marginOrderServiceAPI) AutoRepayAtCancel(autoRepay bool) MarginOrderServiceAPI {
+	return (*marginOrderServiceAPI)((*MarginOrderService)(a).AutoRepayAtCancel(autoRepay))
+}
+
+func (a *marginOrderServiceAPI) Do(ctx context.Context, opts ...RequestOption) (*MarginOrder, error) {
+	return (*MarginOrderService)(a).Do(ctx, opts...)
+}
+
+// MarginLoanServiceAPI is the interface of MarginLoanService
+type MarginLoanServiceAPI interface {
+	Asset(asset string) MarginLoanServiceAPI
+	Amount(amount string) MarginLoanServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) (*MarginLoanResponse, error)
+}
+
+type marginLoanServiceAPI MarginLoanService
+
+func (a *marginLoanServiceAPI) Asset(asset string) MarginLoanServiceAPI {
+	return (*marginLoanServiceAPI)((*MarginLoanService)(a).Asset(asset))
+}
+
+func (a *marginLoanServiceAPI) Amount(amount string) MarginLoanServiceAPI {
+	return (*marginLoanServiceAPI)((*MarginLoanService)(a).Amount(amount))
+}
+
+func (a *marginLoanServiceAPI) Do(ctx context.Context, opts ...RequestOption) (*MarginLoanResponse, error) {
+	return (*MarginLoanService)(a).Do(ctx, opts...)
+}
+
+// MarginRepayServiceAPI is the interface of MarginRepayService
+type MarginRepayServiceAPI interface {
+	Asset(asset string) MarginRepayServiceAPI
+	Amount(amount string) MarginRepayServiceAPI
+	RecvWindow(recvWindow int64) MarginRepayServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) (*MarginRepayResponse, error)
+}
+
+type marginRepayServiceAPI MarginRepayService
+
+func (a *marginRepayServiceAPI) Asset(asset string) MarginRepayServiceAPI {
+	return (*marginRepayServiceAPI)((*MarginRepayService)(a).Asset(asset))
+}
+
+func (a *marginRepayServiceAPI) Amount(amount string) MarginRepayServiceAPI {
+	return (*marginRepayServiceAPI)((*MarginRepayService)(a).Amount(amount))
+}
+
+func (a *marginRepayServiceAPI) RecvWindow(recvWindow int64) MarginRepayServiceAPI {
+	return (*marginRepayServiceAPI)((*MarginRepayService)(a).RecvWindow(recvWindow))
+}
+
+func (a *marginRepayServiceAPI) Do(ctx context.Context, opts ...RequestOption) (*MarginRepayResponse, error) {
+	return (*MarginRepayService)(a).Do(ctx, opts...)
+}
+
+// MarginOCOServiceAPI is the interface of MarginOCOService
+type MarginOCOServiceAPI interface {
+	Symbol(symbol string) MarginOCOServiceAPI
+	ListClientOrderID(listClientOrderID string) MarginOCOServiceAPI
+	Side(side SideType) MarginOCOServiceAPI
+	Quantity(quantity string) MarginOCOServiceAPI
+	LimitClientOrderID(limitClientOrderID string) MarginOCOServiceAPI
+	Price(price string) MarginOCOServiceAPI
+	LimitIcebergQty(limitIcebergQty string) MarginOCOServiceAPI
+	StopClientOrderID(stopClientOrderID string) MarginOCOServiceAPI
+	StopPrice(stopPrice string) MarginOCOServiceAPI
+	StopLimitPrice(stopLimitPrice string) MarginOCOServiceAPI
+	StopIcebergQty(stopIcebergQty string) MarginOCOServiceAPI
+	StopLimitTimeInForce(timeInForce TimeInForceType) MarginOCOServiceAPI
+	NewOrderRespType(newOrderRespType NewOrderRespType) MarginOCOServiceAPI
+	SideEffectType(sideEffectType SideEffectType) MarginOCOServiceAPI
+	RecvWindow(recvWindow int64) MarginOCOServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) (*MarginOCOResponse, error)
+}
+
+type marginOCOServiceAPI MarginOCOService
+
+func (a *marginOCOServiceAPI) Symbol(symbol string) MarginOCOServiceAPI {
+	return (*marginOCOServiceAPI)((*MarginOCOService)(a).Symbol(symbol))
+}
+
+func (a *marginOCOServiceAPI) ListClientOrderID(listClientOrderID string) MarginOCOServiceAPI {
+	return (*marginOCOServiceAPI)((*MarginOCOService)(a).ListClientOrderID(listClientOrderID))
+}
+
+func (a *marginOCOServiceAPI) Side(side SideType) MarginOCOServiceAPI {
+	return (*marginOCOServiceAPI)((*MarginOCOService)(a).Side(side))
+}
+
+func (a *marginOCOServiceAPI) Quantity(quantity string) MarginOCOServiceAPI {
+	return (*marginOCOServiceAPI)((*MarginOCOService)(a).Quantity(quantity))
+}
+
+func (a *marginOCOServiceAPI) LimitClientOrderID(limitClientOrderID string) MarginOCOServiceAPI {
+	return (*marginOCOServiceAPI)((*MarginOCOService)(a).LimitClientOrderID(limitClientOrderID))
+}
+
+func (a *marginOCOServiceAPI) Price(price string) MarginOCOServiceAPI {
+	return (*marginOCOServiceAPI)((*MarginOCOService)(a).Price(price))
+}
+
+func (a *marginOCOServiceAPI) LimitIcebergQty(limitIcebergQty string) MarginOCOServiceAPI {
+	return (*marginOCOServiceAPI)((*MarginOCOService)(a).LimitIcebergQty(limitIcebergQty))
+}
+
+func (a *marginOCOServiceAPI) StopClientOrderID(stopClientOrderID string) MarginOCOServiceAPI {
+	return (*marginOCOServiceAPI)((*MarginOCOService)(a).StopClientOrderID(stopClientOrderID))
+}
+
+func (a *marginOCOServiceAPI) StopPrice(stopPrice string) MarginOCOServiceAPI {
+	return (*marginOCOServiceAPI)((*MarginOCOService)(a).StopPrice(stopPrice))
+}
+
+func (a *marginOCOServiceAPI) StopLimitPrice(stopLimitPrice string) MarginOCOServiceAPI {
+	return (*marginOCOServiceAPI)((*MarginOCOService)(a).StopLimitPrice(stopLimitPrice))
+}
+
+func (a *marginOCOServiceAPI) StopIcebergQty(stopIcebergQty string) MarginOCOServiceAPI {
+	return (*marginOCOServiceAPI)((*MarginOCOService)(a).StopIcebergQty(stopIcebergQty))
+}
+
+func (a *marginOCOServiceAPI) StopLimitTimeInForce(timeInForce TimeInForceType) MarginOCOServiceAPI {
+	return (*marginOCOServiceAPI)((*MarginOCOService)(a).StopLimitTimeInForce(timeInForce))
+}
+
+func (a *marginOCOServiceAPI) NewOrderRespType(newOrderRespType NewOrderRespType) MarginOCOServiceAPI {
+	return (*marginOCOServiceAPI)((*MarginOCOService)(a).NewOrderRespType(newOrderRespType))
+}
+
+func (a *marginOCOServiceAPI) SideEffectType(sideEffectType SideEffectType) MarginOCOServiceAPI {
+	return (*marginOCOServiceAPI)((*MarginOCOService)(a).SideEffectType(sideEffectType))
+}
+
+func (a *marginOCOServiceAPI) RecvWindow(recvWindow int64) MarginOCOServiceAPI {
+	return (*marginOCOServiceAPI)((*MarginOCOService)(a).RecvWindow(recvWindow))
+}
+
+func (a *marginOCOServiceAPI) Do(ctx context.Context, opts ...RequestOption) (*MarginOCOResponse, error) {
+	return (*MarginOCOService)(a).Do(ctx, opts...)
+}
+
+// UMCancelOrderServiceAPI is the interface of UMCancelOrderService
+type UMCancelOrderServiceAPI interface {
+	Symbol(symbol string) UMCancelOrderServiceAPI
+	OrderID(orderID int64) UMCancelOrderServiceAPI
+	OrigClientOrderID(origClientOrderID string) UMCancelOrderServiceAPI
+	RecvWindow(recvWindow int64) UMCancelOrderServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) (*UMOrder, error)
+}
+
+type umCancelOrderServiceAPI UMCancelOrderService
+
+func (a *umCancelOrderServiceAPI) Symbol(symbol string) UMCancelOrderServiceAPI {
+	return (*umCancelOrderServiceAPI)((*UMCancelOrderService)(a).Symbol(symbol))
+}
+
+func (a *umCancelOrderServiceAPI) OrderID(orderID int64) UMCancelOrderServiceAPI {
+	return (*umCancelOrderServiceAPI)((*UMCancelOrderService)(a).OrderID(orderID))
+}
+
+func (a *umCancelOrderServiceAPI) OrigClientOrderID(origClientOrderID string) UMCancelOrderServiceAPI {
+	return (*umCancelOrderServiceAPI)((*UMCancelOrderService)(a).OrigClientOrderID(origClientOrderID))
+}
+
+func (a *umCancelOrderServiceAPI) RecvWindow(recvWindow int64) UMCancelOrderServiceAPI {
+	return (*umCancelOrderServiceAPI)((*UMCancelOrderService)(a).RecvWindow(recvWindow))
+}
+
+func (a *umCancelOrderServiceAPI) Do(ctx context.Context, opts ...RequestOption) (*UMOrder, error) {
+	return (*UMCancelOrderService)(a).Do(ctx, opts...)
+}
+
+// UMCancelAllOrdersServiceAPI is the interface of UMCancelAllOrdersService
+type UMCancelAllOrdersServiceAPI interface {
+	Symbol(symbol string) UMCancelAllOrdersServiceAPI
+	RecvWindow(recvWindow int64) UMCancelAllOrdersServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) (*UMCancelAllOrdersResponse, error)
+}
+
+type umCancelAllOrdersServiceAPI UMCancelAllOrdersService
+
+func (a *umCancelAllOrdersServiceAPI) Symbol(symbol string) UMCancelAllOrdersServiceAPI {
+	return (*umCancelAllOrdersServiceAPI)((*UMCancelAllOrdersService)(a).Symbol(symbol))
+}
+
+func (a *umCancelAllOrdersServiceAPI) RecvWindow(recvWindow int64) UMCancelAllOrdersServiceAPI {
+	return (*umCancelAllOrdersServiceAPI)((*UMCancelAllOrdersService)(a).RecvWindow(recvWindow))
+}
+
+func (a *umCancelAllOrdersServiceAPI) Do(ctx context.Context, opts ...RequestOption) (*UMCancelAllOrdersResponse, error) {
+	return (*UMCancelAllOrdersService)(a).Do(ctx, opts...)
+}
+
+// UMCancelConditionalOrderServiceAPI is the interface of UMCancelConditionalOrderService
+type UMCancelConditionalOrderServiceAPI interface {
+	Symbol(symbol string) UMCancelConditionalOrderServiceAPI
+	StrategyID(strategyID int64) UMCancelConditionalOrderServiceAPI
+	NewClientStrategyID(newClientStrategyID string) UMCancelConditionalOrderServiceAPI
+	RecvWindow(recvWindow int64) UMCancelConditionalOrderServiceAPI
+	Do(ctx context.Context) (*UMCancelConditionalOrderResponse, error)
+}
+
+type umCancelConditionalOrderServiceAPI UMCancelConditionalOrderService
+
+func (a *umCancelConditionalOrderServiceAPI) Symbol(symbol string) UMCancelConditionalOrderServiceAPI {
+	return (*umCancelConditionalOrderServiceAPI)((*UMCancelConditionalOrderService)(a).Symbol(symbol))
+}
+
+func (a *umCancelConditionalOrderServiceAPI) StrategyID(strategyID int64) UMCancelConditionalOrderServiceAPI {
+	return (*umCancelConditionalOrderServiceAPI)((*UMCancelConditionalOrderService)(a).StrategyID(strategyID))
+}
+
+func (a *umCancelConditionalOrderServiceAPI) NewClientStrategyID(newClientStrategyID string) UMCancelConditionalOrderServiceAPI {
+	return (*umCancelConditionalOrderServiceAPI)((*UMCancelConditionalOrderService)(a).NewClientStrategyID(newClientStrategyID))
+}
+
+func (a *umCancelConditionalOrderServiceAPI) RecvWindow(recvWindow int64) UMCancelConditionalOrderServiceAPI {
+	return (*umCancelConditionalOrderServiceAPI)((*UMCancelConditionalOrderService)(a).RecvWindow(recvWindow))
+}
+
+func (a *umCancelConditionalOrderServiceAPI) Do(ctx context.Context) (*UMCancelConditionalOrderResponse, error) {
+	return (*UMCancelConditionalOrderService)(a).Do(ctx)
+}
+
+// UMCancelAllConditionalOrdersServiceAPI is the interface of UMCancelAllConditionalOrdersService
+type UMCancelAllConditionalOrdersServiceAPI interface {
+	Symbol(symbol string) UMCancelAllConditionalOrdersServiceAPI
+	RecvWindow(recvWindow int64) UMCancelAllConditionalOrdersServiceAPI
+	Do(ctx context.Context) (*UMCancelAllConditionalOrdersResponse, error)
+}
+
+type umCancelAllConditionalOrdersServiceAPI UMCancelAllConditionalOrdersService
+
+func (a *umCancelAllConditionalOrdersServiceAPI) Symbol(symbol string) UMCancelAllConditionalOrdersServiceAPI {
+	return (*umCancelAllConditionalOrdersServiceAPI)((*UMCancelAllConditionalOrdersService)(a).Symbol(symbol))
+}
+
+func (a *umCancelAllConditionalOrdersServiceAPI) RecvWindow(recvWindow int64) UMCancelAllConditionalOrdersServiceAPI {
+	return (*umCancelAllConditionalOrdersServiceAPI)((*UMCancelAllConditionalOrdersService)(a).RecvWindow(recvWindow))
+}
+
+func (a *umCancelAllConditionalOrdersServiceAPI) Do(ctx context.Context) (*UMCancelAllConditionalOrdersResponse, error) {
+	return (*UMCancelAllConditionalOrdersService)(a).Do(ctx)
+}
+
+// CMCancelOrderServiceAPI is the interface of CMCancelOrderService
+type CMCancelOrderServiceAPI interface {
+	Symbol(symbol string) CMCancelOrderServiceAPI
+	OrderID(orderID int64) CMCancelOrderServiceAPI
+	OrigClientOrderID(origClientOrderID string) CMCancelOrderServiceAPI
+	RecvWindow(recvWindow int64) CMCancelOrderServiceAPI
+	Do(ctx context.Context) (*CMCancelOrderResponse, error)
+}
+
+type cmCancelOrderServiceAPI CMCancelOrderService
+
+func (a *cmCancelOrderServiceAPI) Symbol(symbol string) CMCancelOrderServiceAPI {
+	return (*cmCancelOrderServiceAPI)((*CMCancelOrderService)(a).Symbol(symbol))
+}
+
+func (a *cmCancelOrderServiceAPI) OrderID(orderID int64) CMCancelOrderServiceAPI {
+	return (*cmCancelOrderServiceAPI)((*CMCancelOrderService)(a).OrderID(orderID))
+}
+
+func (a *cmCancelOrderServiceAPI) OrigClientOrderID(origClientOrderID string) CMCancelOrderServiceAPI {
+	return (*cmCancelOrderServiceAPI)((*CMCancelOrderService)(a).OrigClientOrderID(origClientOrderID))
+}
+
+func (a *cmCancelOrderServiceAPI) RecvWindow(recvWindow int64) CMCancelOrderServiceAPI {
+	return (*cmCancelOrderServiceAPI)((*CMCancelOrderService)(a).RecvWindow(recvWindow))
+}
+
+func (a *cmCancelOrderServiceAPI) Do(ctx context.Context) (*CMCancelOrderResponse, error) {
+	return (*CMCancelOrderService)(a).Do(ctx)
+}
+
+// CMCancelAllOrdersServiceAPI is the interface of CMCancelAllOrdersService
+type CMCancelAllOrdersServiceAPI interface {
+	Symbol(symbol string) CMCancelAllOrdersServiceAPI
+	RecvWindow(recvWindow int64) CMCancelAllOrdersServiceAPI
+	Do(ctx context.Context) (*CMCancelAllOrdersResponse, error)
+}
+
+type cmCancelAllOrdersServiceAPI CMCancelAllOrdersService
+
+func (a *cmCancelAllOrdersServiceAPI) Symbol(symbol string) CMCancelAllOrdersServiceAPI {
+	return (*cmCancelAllOrdersServiceAPI)((*CMCancelAllOrdersService)(a).Symbol(symbol))
+}
+
+func (a *cmCancelAllOrdersServiceAPI) RecvWindow(recvWindow int64) CMCancelAllOrdersServiceAPI {
+	return (*cmCancelAllOrdersServiceAPI)((*CMCancelAllOrdersService)(a).RecvWindow(recvWindow))
+}
+
+func (a *cmCancelAllOrdersServiceAPI) Do(ctx context.Context) (*CMCancelAllOrdersResponse, error) {
+	return (*CMCancelAllOrdersService)(a).Do(ctx)
+}
+
+// CMCancelConditionalOrderServiceAPI is the interface of CMCancelConditionalOrderService
+type CMCancelConditionalOrderServiceAPI interface {
+	Symbol(symbol string) CMCancelConditionalOrderServiceAPI
+	StrategyID(strategyID int64) CMCancelConditionalOrderServiceAPI
+	NewClientStrategyID(newClientStrategyID string) CMCancelConditionalOrderServiceAPI
+	RecvWindow(recvWindow int64) CMCancelConditionalOrderServiceAPI
+	Do(ctx context.Context) (*CMCancelConditionalOrderResponse, error)
+}
+
+type cmCancelConditionalOrderServiceAPI CMCancelConditionalOrderService
+
+func (a *cmCancelConditionalOrderServiceAPI) Symbol(symbol string) CMCancelConditionalOrderServiceAPI {
+	return (*cmCancelConditionalOrderServiceAPI)((*CMCancelConditionalOrderService)(a).Symbol(symbol))
+}
+
+func (a *cmCancelConditionalOrderServiceAPI) StrategyID(strategyID int64) CMCancelConditionalOrderServiceAPI {
+	return (*cmCancelConditionalOrderServiceAPI)((*CMCancelConditionalOrderService)(a).StrategyID(strategyID))
+}
+
+func (a *cmCancelConditionalOrderServiceAPI) NewClientStrategyID(newClientStrategyID string) CMCancelConditionalOrderServiceAPI {
+	return (*cmCancelConditionalOrderServiceAPI)((*CMCancelConditionalOrderService)(a).NewClientStrategyID(newClientStrategyID))
+}
+
+func (a *cmCancelConditionalOrderServiceAPI) RecvWindow(recvWindow int64) CMCancelConditionalOrderServiceAPI {
+	return (*cmCancelConditionalOrderServiceAPI)((*CMCancelConditionalOrderService)(a).RecvWindow(recvWindow))
+}
+
+func (a *cmCancelConditionalOrderServiceAPI) Do(ctx context.Context) (*CMCancelConditionalOrderResponse, error) {
+	return (*CMCancelConditionalOrderService)(a).Do(ctx)
+}
+
+// CMCancelAllConditionalOrdersServiceAPI is the interface of CMCancelAllConditionalOrdersService
+type CMCancelAllConditionalOrdersServiceAPI interface {
+	Symbol(symbol string) CMCancelAllConditionalOrdersServiceAPI
+	RecvWindow(recvWindow int64) CMCancelAllConditionalOrdersServiceAPI
+	Do(ctx context.Context) (*CMCancelAllConditionalOrdersResponse, error)
+}
+
+type cmCancelAllConditionalOrdersServiceAPI CMCancelAllConditionalOrdersService
+
+func (a *cmCancelAllConditionalOrdersServiceAPI) Symbol(symbol string) CMCancelAllConditionalOrdersServiceAPI {
+	return (*cmCancelAllConditionalOrdersServiceAPI)((*CMCancelAllConditionalOrdersService)(a).Symbol(symbol))
+}
+
+func (a *cmCancelAllConditionalOrdersServiceAPI) RecvWindow(recvWindow int64) CMCancelAllConditionalOrdersServiceAPI {
+	return (*cmCancelAllConditionalOrdersServiceAPI)((*CMCancelAllConditionalOrdersService)(a).RecvWindow(recvWindow))
+}
+
+func (a *cmCancelAllConditionalOrdersServiceAPI) Do(ctx context.Context) (*CMCancelAllConditionalOrdersResponse, error) {
+	return (*CMCancelAllConditionalOrdersService)(a).Do(ctx)
+}
+
+// MarginCancelOrderServiceAPI is the interface of MarginCancelOrderService
+type MarginCancelOrderServiceAPI interface {
+	Symbol(symbol string) MarginCancelOrderServiceAPI
+	OrderID(orderID int64) MarginCancelOrderServiceAPI
+	OrigClientOrderID(origClientOrderID string) MarginCancelOrderServiceAPI
+	NewClientOrderID(newClientOrderID string) MarginCancelOrderServiceAPI
+	RecvWindow(recvWindow int64) MarginCancelOrderServiceAPI
+	Do(ctx context.Context) (*MarginCancelOrderResponse, error)
+}
+
+type marginCancelOrderServiceAPI MarginCancelOrderService
+
+func (a *marginCancelOrderServiceAPI) Symbol(symbol string) MarginCancelOrderServiceAPI {
+	return (*marginCancelOrderServiceAPI)((*MarginCancelOrderService)(a).Symbol(symbol))
+}
+
+func (a *marginCancelOrderServiceAPI) OrderID(orderID int64) MarginCancelOrderServiceAPI {
+	return (*marginCancelOrderServiceAPI)((*MarginCancelOrderService)(a).OrderID(orderID))
+}
+
+func (a *marginCancelOrderServiceAPI) OrigClientOrderID(origClientOrderID string) MarginCancelOrderServiceAPI {
+	return (*marginCancelOrderServiceAPI)((*MarginCancelOrderService)(a).OrigClientOrderID(origClientOrderID))
+}
+
+func (a *marginCancelOrderServiceAPI) NewClientOrderID(newClientOrderID string) MarginCancelOrderServiceAPI {
+	return (*marginCancelOrderServiceAPI)((*MarginCancelOrderService)(a).NewClientOrderID(newClientOrderID))
+}
+
+func (a *marginCancelOrderServiceAPI) RecvWindow(recvWindow int64) MarginCancelOrderServiceAPI {
+	return (*marginCancelOrderServiceAPI)((*MarginCancelOrderService)(a).RecvWindow(recvWindow))
+}
+
+func (a *marginCancelOrderServiceAPI) Do(ctx context.Context) (*MarginCancelOrderResponse, error) {
+	return (*MarginCancelOrderService)(a).Do(ctx)
+}
+
+// MarginCancelOCOServiceAPI is the interface of MarginCancelOCOService
+type MarginCancelOCOServiceAPI interface {
+	Symbol(symbol string) MarginCancelOCOServiceAPI
+	OrderListID(orderListID int64) MarginCancelOCOServiceAPI
+	ListClientOrderID(listClientOrderID string) MarginCancelOCOServiceAPI
+	NewClientOrderID(newClientOrderID string) MarginCancelOCOServiceAPI
+	RecvWindow(recvWindow int64) MarginCancelOCOServiceAPI
+	Do(ctx context.Context) (*MarginCancelOCOResponse, error)
+}
+
+type marginCancelOCOServiceAPI MarginCancelOCOService
+
+func (a *marginCancelOCOServiceAPI) Symbol(symbol string) MarginCancelOCOServiceAPI {
+	return (*marginCancelOCOServiceAPI)((*MarginCancelOCOService)(a).Symbol(symbol))
+}
+
+func (a *marginCancelOCOServiceAPI) OrderListID(orderListID int64) MarginCancelOCOServiceAPI {
+	return (*marginCancelOCOServiceAPI)((*MarginCancelOCOService)(a).OrderListID(orderListID))
+}
+
+func (a *marginCancelOCOServiceAPI) ListClientOrderID(listClientOrderID string) MarginCancelOCOServiceAPI {
+	return (*marginCancelOCOServiceAPI)((*MarginCancelOCOService)(a).ListClientOrderID(listClientOrderID))
+}
+
+func (a *marginCancelOCOServiceAPI) NewClientOrderID(newClientOrderID string) MarginCancelOCOServiceAPI {
+	return (*marginCancelOCOServiceAPI)((*MarginCancelOCOService)(a).NewClientOrderID(newClientOrderID))
+}
+
+func (a *marginCancelOCOServiceAPI) RecvWindow(recvWindow int64) MarginCancelOCOServiceAPI {
+	return (*marginCancelOCOServiceAPI)((*MarginCancelOCOService)(a).RecvWindow(recvWindow))
+}
+
+func (a *marginCancelOCOServiceAPI) Do(ctx context.Context) (*MarginCancelOCOResponse, error) {
+	return (*MarginCancelOCOService)(a).Do(ctx)
+}
+
+// UMModifyOrderServiceAPI is the interface of UMModifyOrderService
+type UMModifyOrderServiceAPI interface {
+	Symbol(symbol string) UMModifyOrderServiceAPI
+	OrderID(orderID int64) UMModifyOrderServiceAPI
+	OrigClientOrderID(origClientOrderID string) UMModifyOrderServiceAPI
+	Side(side SideType) UMModifyOrderServiceAPI
+	Quantity(quantity string) UMModifyOrderServiceAPI
+	Price(price string) UMModifyOrderServiceAPI
+	PriceMatch(priceMatch string) UMModifyOrderServiceAPI
+	RecvWindow(recvWindow int64) UMModifyOrderServiceAPI
+	Do(ctx context.Context) (*UMModifyOrderResponse, error)
+}
+
+type umModifyOrderServiceAPI UMModifyOrderService
+
+func (a *umModifyOrderServiceAPI) Symbol(symbol string) UMModifyOrderServiceAPI {
+	return (*umModifyOrderServiceAPI)((*UMModifyOrderService)(a).Symbol(symbol))
+}
+
+func (a *umModifyOrderServiceAPI) OrderID(orderID int64) UMModifyOrderServiceAPI {
+	return (*umModifyOrderServiceAPI)((*UMModifyOrderService)(a).OrderID(orderID))
+}
+
+func (a *umModifyOrderServiceAPI) OrigClientOrderID(origClientOrderID string) UMModifyOrderServiceAPI {
+	return (*umModifyOrderServiceAPI)((*UMModifyOrderService)(a).OrigClientOrderID(origClientOrderID))
+}
+
+func (a *umModifyOrderServiceAPI) Side(side SideType) UMModifyOrderServiceAPI {
+	return (*umModifyOrderServiceAPI)((*UMModifyOrderService)(a).Side(side))
+}
+
+func (a *umModifyOrderServiceAPI) Quantity(quantity string) UMModifyOrderServiceAPI {
+	return (*umModifyOrderServiceAPI)((*UMModifyOrderService)(a).Quantity(quantity))
+}
+
+func (a *umModifyOrderServiceAPI) Price(price string) UMModifyOrderServiceAPI {
+	return (*umModifyOrderServiceAPI)((*UMModifyOrderService)(a).Price(price))
+}
+
+func (a *umModifyOrderServiceAPI) PriceMatch(priceMatch string) UMModifyOrderServiceAPI {
+	return (*umModifyOrderServiceAPI)((*UMModifyOrderService)(a).PriceMatch(priceMatch))
+}
+
+func (a *umModifyOrderServiceAPI) RecvWindow(recvWindow int64) UMModifyOrderServiceAPI {
+	return (*umModifyOrderServiceAPI)((*UMModifyOrderService)(a).RecvWindow(recvWindow))
+}
+
+func (a *umModifyOrderServiceAPI) Do(ctx context.Context) (*UMModifyOrderResponse, error) {
+	return (*UMModifyOrderService)(a).Do(ctx)
+}
+
+// MarginCancelAllOrdersServiceAPI is the interface of MarginCancelAllOrdersService
+type MarginCancelAllOrdersServiceAPI interface {
+	Symbol(symbol string) MarginCancelAllOrdersServiceAPI
+	RecvWindow(recvWindow int64) MarginCancelAllOrdersServiceAPI
+	Do(ctx context.Context) ([]*MarginCancelAllOrdersResponse, error)
+}
+
+type marginCancelAllOrdersServiceAPI MarginCancelAllOrdersService
+
+func (a *marginCancelAllOrdersServiceAPI) Symbol(symbol string) MarginCancelAllOrdersServiceAPI {
+	return (*marginCancelAllOrdersServiceAPI)((*MarginCancelAllOrdersService)(a).Symbol(symbol))
+}
+
+func (a *marginCancelAllOrdersServiceAPI) RecvWindow(recvWindow int64) MarginCancelAllOrdersServiceAPI {
+	return (*marginCancelAllOrdersServiceAPI)((*MarginCancelAllOrdersService)(a).RecvWindow(recvWindow))
+}
+
+func (a *marginCancelAllOrdersServiceAPI) Do(ctx context.Context) ([]*MarginCancelAllOrdersResponse, error) {
+	return (*MarginCancelAllOrdersService)(a).Do(ctx)
+}
+
+// CMModifyOrderServiceAPI is the interface of CMModifyOrderService
+type CMModifyOrderServiceAPI interface {
+	Symbol(symbol string) CMModifyOrderServiceAPI
+	OrderID(orderID int64) CMModifyOrderServiceAPI
+	OrigClientOrderID(origClientOrderID string) CMModifyOrderServiceAPI
+	Side(side SideType) CMModifyOrderServiceAPI
+	Quantity(quantity string) CMModifyOrderServiceAPI
+	Price(price string) CMModifyOrderServiceAPI
+	RecvWindow(recvWindow int64) CMModifyOrderServiceAPI
+	Do(ctx context.Context) (*CMModifyOrderResponse, error)
+}
+
+type cmModifyOrderServiceAPI CMModifyOrderService
+
+func (a *cmModifyOrderServiceAPI) Symbol(symbol string) CMModifyOrderServiceAPI {
+	return (*cmModifyOrderServiceAPI)((*CMModifyOrderService)(a).Symbol(symbol))
+}
+
+func (a *cmModifyOrderServiceAPI) OrderID(orderID int64) CMModifyOrderServiceAPI {
+	return (*cmModifyOrderServiceAPI)((*CMModifyOrderService)(a).OrderID(orderID))
+}
+
+func (a *cmModifyOrderServiceAPI) OrigClientOrderID(origClientOrderID string) CMModifyOrderServiceAPI {
+	return (*cmModifyOrderServiceAPI)((*CMModifyOrderService)(a).OrigClientOrderID(origClientOrderID))
+}
+
+func (a *cmModifyOrderServiceAPI) Side(side SideType) CMModifyOrderServiceAPI {
+	return (*cmModifyOrderServiceAPI)((*CMModifyOrderService)(a).Side(side))
+}
+
+func (a *cmModifyOrderServiceAPI) Quantity(quantity string) CMModifyOrderServiceAPI {
+	return (*cmModifyOrderServiceAPI)((*CMModifyOrderService)(a).Quantity(quantity))
+}
+
+func (a *cmModifyOrderServiceAPI) Price(price string) CMModifyOrderServiceAPI {
+	return (*cmModifyOrderServiceAPI)((*CMModifyOrderService)(a).Price(price))
+}
+
+func (a *cmModifyOrderServiceAPI) RecvWindow(recvWindow int64) CMModifyOrderServiceAPI {
+	return (*cmModifyOrderServiceAPI)((*CMModifyOrderService)(a).RecvWindow(recvWindow))
+}
+
+func (a *cmModifyOrderServiceAPI) Do(ctx context.Context) (*CMModifyOrderResponse, error) {
+	return (*CMModifyOrderService)(a).Do(ctx)
+}
+
+// UMQueryOrderServiceAPI is the interface of UMQueryOrderService
+type UMQueryOrderServiceAPI interface {
+	Symbol(symbol string) UMQueryOrderServiceAPI
+	OrderID(orderID int64) UMQueryOrderServiceAPI
+	OrigClientOrderID(origClientOrderID string) UMQueryOrderServiceAPI
+	RecvWindow(recvWindow int64) UMQueryOrderServiceAPI
+	Do(ctx context.Context) (*UMQueryOrderResponse, error)
+}
+
+type umQueryOrderServiceAPI UMQueryOrderService
+
+func (a *umQueryOrderServiceAPI) Symbol(symbol string) UMQueryOrderServiceAPI {
+	return (*umQueryOrderServiceAPI)((*UMQueryOrderService)(a).Symbol(symbol))
+}
+
+func (a *umQueryOrderServiceAPI) OrderID(orderID int64) UMQueryOrderServiceAPI {
+	return (*umQueryOrderServiceAPI)((*UMQueryOrderService)(a).OrderID(orderID))
+}
+
+func (a *umQueryOrderServiceAPI) OrigClientOrderID(origClientOrderID string) UMQueryOrderServiceAPI {
+	return (*umQueryOrderServiceAPI)((*UMQueryOrderService)(a).OrigClientOrderID(origClientOrderID))
+}
+
+func (a *umQueryOrderServiceAPI) RecvWindow(recvWindow int64) UMQueryOrderServiceAPI {
+	return (*umQueryOrderServiceAPI)((*UMQueryOrderService)(a).RecvWindow(recvWindow))
+}
+
+func (a *umQueryOrderServiceAPI) Do(ctx context.Context) (*UMQueryOrderResponse, error) {
+	return (*UMQueryOrderService)(a).Do(ctx)
+}
+
+// UMAllOrdersServiceAPI is the interface of UMAllOrdersService
+type UMAllOrdersServiceAPI interface {
+	Symbol(symbol string) UMAllOrdersServiceAPI
+	OrderID(orderID int64) UMAllOrdersServiceAPI
+	StartTime(startTime int64) UMAllOrdersServiceAPI
+	EndTime(endTime int64) UMAllOrdersServiceAPI
+	Limit(limit int) UMAllOrdersServiceAPI
+	RecvWindow(recvWindow int64) UMAllOrdersServiceAPI
+	Do(ctx context.Context) ([]*UMAllOrdersResponse, error)
+}
+
+type umAllOrdersServiceAPI UMAllOrdersService
+
+func (a *umAllOrdersServiceAPI) Symbol(symbol string) UMAllOrdersServiceAPI {
+	return (*umAllOrdersServiceAPI)((*UMAllOrdersService)(a).Symbol(symbol))
+}
+
+func (a *umAllOrdersServiceAPI) OrderID(orderID int64) UMAllOrdersServiceAPI {
+	return (*umAllOrdersServiceAPI)((*UMAllOrdersService)(a).OrderID(orderID))
+}
+
+func (a *umAllOrdersServiceAPI) StartTime(startTime int64) UMAllOrdersServiceAPI {
+	return (*umAllOrdersServiceAPI)((*UMAllOrdersService)(a).StartTime(startTime))
+}
+
+func (a *umAllOrdersServiceAPI) EndTime(endTime int64) UMAllOrdersServiceAPI {
+	return (*umAllOrdersServiceAPI)((*UMAllOrdersService)(a).EndTime(endTime))
+}
+
+func (a *umAllOrdersServiceAPI) Limit(limit int) UMAllOrdersServiceAPI {
+	return (*umAllOrdersServiceAPI)((*UMAllOrdersService)(a).Limit(limit))
+}
+
+func (a *umAllOrdersServiceAPI) RecvWindow(recvWindow int64) UMAllOrdersServiceAPI {
+	return (*umAllOrdersServiceAPI)((*UMAllOrdersService)(a).RecvWindow(recvWindow))
+}
+
+func (a *umAllOrdersServiceAPI) Do(ctx context.Context) ([]*UMAllOrdersResponse, error) {
+	return (*UMAllOrdersService)(a).Do(ctx)
+}
+
+// UMOpenOrderServiceAPI is the interface of UMOpenOrderService
+type UMOpenOrderServiceAPI interface {
+	Symbol(symbol string) UMOpenOrderServiceAPI
+	OrderID(orderID int64) UMOpenOrderServiceAPI
+	OrigClientOrderID(origClientOrderID string) UMOpenOrderServiceAPI
+	RecvWindow(recvWindow int64) UMOpenOrderServiceAPI
+	Do(ctx context.Context) (*UMOpenOrderResponse, error)
+}
+
+type umOpenOrderServiceAPI UMOpenOrderService
+
+func (a *umOpenOrderServiceAPI) Symbol(symbol string) UMOpenOrderServiceAPI {
+	return (*umOpenOrderServiceAPI)((*UMOpenOrderService)(a).Symbol(symbol))
+}
+
+func (a *umOpenOrderServiceAPI) OrderID(orderID int64) UMOpenOrderServiceAPI {
+	return (*umOpenOrderServiceAPI)((*UMOpenOrderService)(a).OrderID(orderID))
+}
+
+func (a *umOpenOrderServiceAPI) OrigClientOrderID(origClientOrderID string) UMOpenOrderServiceAPI {
+	return (*umOpenOrderServiceAPI)((*UMOpenOrderService)(a).OrigClientOrderID(origClientOrderID))
+}
+
+func (a *umOpenOrderServiceAPI) RecvWindow(recvWindow int64) UMOpenOrderServiceAPI {
+	return (*umOpenOrderServiceAPI)((*UMOpenOrderService)(a).RecvWindow(recvWindow))
+}
+
+func (a *umOpenOrderServiceAPI) Do(ctx context.Context) (*UMOpenOrderResponse, error) {
+	return (*UMOpenOrderService)(a).Do(ctx)
+}
+
+// UMOpenOrdersServiceAPI is the interface of UMOpenOrdersService
+type UMOpenOrdersServiceAPI interface {
+	Symbol(symbol string) UMOpenOrdersServiceAPI
+	RecvWindow(recvWindow int64) UMOpenOrdersServiceAPI
+	Do(ctx context.Context) ([]*UMOpenOrdersResponse, error)
+}
+
+type umOpenOrdersServiceAPI UMOpenOrdersService
+
+func (a *umOpenOrdersServiceAPI) Symbol(symbol string) UMOpenOrdersServiceAPI {
+	return (*umOpenOrdersServiceAPI)((*UMOpenOrdersService)(a).Symbol(symbol))
+}
+
+func (a *umOpenOrdersServiceAPI) RecvWindow(recvWindow int64) UMOpenOrdersServiceAPI {
+	return (*umOpenOrdersServiceAPI)((*UMOpenOrdersService)(a).RecvWindow(recvWindow))
+}
+
+func (a *umOpenOrdersServiceAPI) Do(ctx context.Context) ([]*UMOpenOrdersResponse, error) {
+	return (*UMOpenOrdersService)(a).Do(ctx)
+}
+
+// UMAllConditionalOrdersServiceAPI is the interface of UMAllConditionalOrdersService
+type UMAllConditionalOrdersServiceAPI interface {
+	Symbol(symbol string) UMAllConditionalOrdersServiceAPI
+	StrategyID(strategyID int64) UMAllConditionalOrdersServiceAPI
+	StartTime(startTime int64) UMAllConditionalOrdersServiceAPI
+	EndTime(endTime int64) UMAllConditionalOrdersServiceAPI
+	Limit(limit int) UMAllConditionalOrdersServiceAPI
+	RecvWindow(recvWindow int64) UMAllConditionalOrdersServiceAPI
+	Do(ctx context.Context) ([]*UMConditionalOrderResponse, error)
+}
+
+type umAllConditionalOrdersServiceAPI UMAllConditionalOrdersService
+
+func (a *umAllConditionalOrdersServiceAPI) Symbol(symbol string) UMAllConditionalOrdersServiceAPI {
+	return (*umAllConditionalOrdersServiceAPI)((*UMAllConditionalOrdersService)(a).Symbol(symbol))
+}
+
+func (a *umAllConditionalOrdersServiceAPI) StrategyID(strategyID int64) UMAllConditionalOrdersServiceAPI {
+	return (*umAllConditionalOrdersServiceAPI)((*UMAllConditionalOrdersService)(a).StrategyID(strategyID))
+}
+
+func (a *umAllConditionalOrdersServiceAPI) StartTime(startTime int64) UMAllConditionalOrdersServiceAPI {
+	return (*umAllConditionalOrdersServiceAPI)((*UMAllConditionalOrdersService)(a).StartTime(startTime))
+}
+
+func (a *umAllConditionalOrdersServiceAPI) EndTime(endTime int64) UMAllConditionalOrdersServiceAPI {
+	return (*umAllConditionalOrdersServiceAPI)((*UMAllConditionalOrdersService)(a).EndTime(endTime))
+}
+
+func (a *umAllConditionalOrdersServiceAPI) Limit(limit int) UMAllConditionalOrdersServiceAPI {
+	return (*umAllConditionalOrdersServiceAPI)((*UMAllConditionalOrdersService)(a).Limit(limit))
+}
+
+func (a *umAllConditionalOrdersServiceAPI) RecvWindow(recvWindow int64) UMAllConditionalOrdersServiceAPI {
+	return (*umAllConditionalOrdersServiceAPI)((*UMAllConditionalOrdersService)(a).RecvWindow(recvWindow))
+}
+
+func (a *umAllConditionalOrdersServiceAPI) Do(ctx context.Context) ([]*UMConditionalOrderResponse, error) {
+	return (*UMAllConditionalOrdersService)(a).Do(ctx)
+}
+
+// UMOpenConditionalOrdersServiceAPI is the interface of UMOpenConditionalOrdersService
+type UMOpenConditionalOrdersServiceAPI interface {
+	Symbol(symbol string) UMOpenConditionalOrdersServiceAPI
+	RecvWindow(recvWindow int64) UMOpenConditionalOrdersServiceAPI
+	Do(ctx context.Context) ([]*UMOpenConditionalOrderResponse, error)
+}
+
+type umOpenConditionalOrdersServiceAPI UMOpenConditionalOrdersService
+
+func (a *umOpenConditionalOrdersServiceAPI) Symbol(symbol string) UMOpenConditionalOrdersServiceAPI {
+	return (*umOpenConditionalOrdersServiceAPI)((*UMOpenConditionalOrdersService)(a).Symbol(symbol))
+}
+
+func (a *umOpenConditionalOrdersServiceAPI) RecvWindow(recvWindow int64) UMOpenConditionalOrdersServiceAPI {
+	return (*umOpenConditionalOrdersServiceAPI)((*UMOpenConditionalOrdersService)(a).RecvWindow(recvWindow))
+}
+
+func (a *umOpenConditionalOrdersServiceAPI) Do(ctx context.Context) ([]*UMOpenConditionalOrderResponse, error) {
+	return (*UMOpenConditionalOrdersService)(a).Do(ctx)
+}
+
+// UMOpenConditionalOrderServiceAPI is the interface of UMOpenConditionalOrderService
+type UMOpenConditionalOrderServiceAPI interface {
+	Symbol(symbol string) UMOpenConditionalOrderServiceAPI
+	StrategyID(strategyID int64) UMOpenConditionalOrderServiceAPI
+	NewClientStrategyID(newClientStrategyID string) UMOpenConditionalOrderServiceAPI
+	RecvWindow(recvWindow int64) UMOpenConditionalOrderServiceAPI
+	Do(ctx context.Context) (*UMOpenConditionalOrderResponse, error)
+}
+
+type umOpenConditionalOrderServiceAPI UMOpenConditionalOrderService
+
+func (a *umOpenConditionalOrderServiceAPI) Symbol(symbol string) UMOpenConditionalOrderServiceAPI {
+	return (*umOpenConditionalOrderServiceAPI)((*UMOpenConditionalOrderService)(a).Symbol(symbol))
+}
+
+func (a *umOpenConditionalOrderServiceAPI) StrategyID(strategyID int64) UMOpenConditionalOrderServiceAPI {
+	return (*umOpenConditionalOrderServiceAPI)((*UMOpenConditionalOrderService)(a).StrategyID(strategyID))
+}
+
+func (a *umOpenConditionalOrderServiceAPI) NewClientStrategyID(newClientStrategyID string) UMOpenConditionalOrderServiceAPI {
+	return (*umOpenConditionalOrderServiceAPI)((*UMOpenConditionalOrderService)(a).NewClientStrategyID(newClientStrategyID))
+}
+
+func (a *umOpenConditionalOrderServiceAPI) RecvWindow(recvWindow int64) UMOpenConditionalOrderServiceAPI {
+	return (*umOpenConditionalOrderServiceAPI)((*UMOpenConditionalOrderService)(a).RecvWindow(recvWindow))
+}
+
+func (a *umOpenConditionalOrderServiceAPI) Do(ctx context.Context) (*UMOpenConditionalOrderResponse, error) {
+	return (*UMOpenConditionalOrderService)(a).Do(ctx)
+}
+
+// UMConditionalOrderHistoryServiceAPI is the interface of UMConditionalOrderHistoryService
+type UMConditionalOrderHistoryServiceAPI interface {
+	Symbol(symbol string) UMConditionalOrderHistoryServiceAPI
+	StrategyID(strategyID int64) UMConditionalOrderHistoryServiceAPI
+	NewClientStrategyID(newClientStrategyID string) UMConditionalOrderHistoryServiceAPI
+	RecvWindow(recvWindow int64) UMConditionalOrderHistoryServiceAPI
+	Do(ctx context.Context) (*UMConditionalOrderHistoryResponse, error)
+}
+
+type umConditionalOrderHistoryServiceAPI UMConditionalOrderHistoryService
+
+func (a *umConditionalOrderHistoryServiceAPI) Symbol(symbol string) UMConditionalOrderHistoryServiceAPI {
+	return (*umConditionalOrderHistoryServiceAPI)((*UMConditionalOrderHistoryService)(a).Symbol(symbol))
+}
+
+func (a *umConditionalOrderHistoryServiceAPI) StrategyID(strategyID int64) UMConditionalOrderHistoryServiceAPI {
+	return (*umConditionalOrderHistoryServiceAPI)((*UMConditionalOrderHistoryService)(a).StrategyID(strategyID))
+}
+
+func (a *umConditionalOrderHistoryServiceAPI) NewClientStrategyID(newClientStrategyID string) UMConditionalOrderHistoryServiceAPI {
+	return (*umConditionalOrderHistoryServiceAPI)((*UMConditionalOrderHistoryService)(a).NewClientStrategyID(newClientStrategyID))
+}
+
+func (a *umConditionalOrderHistoryServiceAPI) RecvWindow(recvWindow int64) UMConditionalOrderHistoryServiceAPI {
+	return (*umConditionalOrderHistoryServiceAPI)((*UMConditionalOrderHistoryService)(a).RecvWindow(recvWindow))
+}
+
+func (a *umConditionalOrderHistoryServiceAPI) Do(ctx context.Context) (*UMConditionalOrderHistoryResponse, error) {
+	return (*UMConditionalOrderHistoryService)(a).Do(ctx)
+}
+
+// CMQueryOrderServiceAPI is the interface of CMQueryOrderService
+type CMQueryOrderServiceAPI interface {
+	Symbol(symbol string) CMQueryOrderServiceAPI
+	OrderID(orderID int64) CMQueryOrderServiceAPI
+	OrigClientOrderID(origClientOrderID string) CMQueryOrderServiceAPI
+	RecvWindow(recvWindow int64) CMQueryOrderServiceAPI
+	Do(ctx context.Context) (*CMQueryOrderResponse, error)
+}
+
+type cmQueryOrderServiceAPI CMQueryOrderService
+
+func (a *cmQueryOrderServiceAPI) Symbol(symbol string) CMQueryOrderServiceAPI {
+	return (*cmQueryOrderServiceAPI)((*CMQueryOrderService)(a).Symbol(symbol))
+}
+
+func (a *cmQueryOrderServiceAPI) OrderID(orderID int64) CMQueryOrderServiceAPI {
+	return (*cmQueryOrderServiceAPI)((*CMQueryOrderService)(a).OrderID(orderID))
+}
+
+func (a *cmQueryOrderServiceAPI) OrigClientOrderID(origClientOrderID string) CMQueryOrderServiceAPI {
+	return (*cmQueryOrderServiceAPI)((*CMQueryOrderService)(a).OrigClientOrderID(origClientOrderID))
+}
+
+func (a *cmQueryOrderServiceAPI) RecvWindow(recvWindow int64) CMQueryOrderServiceAPI {
+	return (*cmQueryOrderServiceAPI)((*CMQueryOrderService)(a).RecvWindow(recvWindow))
+}
+
+func (a *cmQueryOrderServiceAPI) Do(ctx context.Context) (*CMQueryOrderResponse, error) {
+	return (*CMQueryOrderService)(a).Do(ctx)
+}
+
+// CMAllOrdersServiceAPI is the interface of CMAllOrdersService
+type CMAllOrdersServiceAPI interface {
+	Symbol(symbol string) CMAllOrdersServiceAPI
+	Pair(pair string) CMAllOrdersServiceAPI
+	OrderID(orderID int64) CMAllOrdersServiceAPI
+	StartTime(startTime int64) CMAllOrdersServiceAPI
+	EndTime(endTime int64) CMAllOrdersServiceAPI
+	Limit(limit int) CMAllOrdersServiceAPI
+	RecvWindow(recvWindow int64) CMAllOrdersServiceAPI
+	Do(ctx context.Context) ([]*CMAllOrdersResponse, error)
+}
+
+type cmAllOrdersServiceAPI CMAllOrdersService
+
+func (a *cmAllOrdersServiceAPI) Symbol(symbol string) CMAllOrdersServiceAPI {
+	return (*cmAllOrdersServiceAPI)((*CMAllOrdersService)(a).Symbol(symbol))
+}
+
+func (a *cmAllOrdersServiceAPI) Pair(pair string) CMAllOrdersServiceAPI {
+	return (*cmAllOrdersServiceAPI)((*CMAllOrdersService)(a).Pair(pair))
+}
+
+func (a *cmAllOrdersServiceAPI) OrderID(orderID int64) CMAllOrdersServiceAPI {
+	return (*cmAllOrdersServiceAPI)((*CMAllOrdersService)(a).OrderID(orderID))
+}
+
+func (a *cmAllOrdersServiceAPI) StartTime(startTime int64) CMAllOrdersServiceAPI {
+	return (*cmAllOrdersServiceAPI)((*CMAllOrdersService)(a).StartTime(startTime))
+}
+
+func (a *cmAllOrdersServiceAPI) EndTime(endTime int64) CMAllOrdersServiceAPI {
+	return (*cmAllOrdersServiceAPI)((*CMAllOrdersService)(a).EndTime(endTime))
+}
+
+func (a *cmAllOrdersServiceAPI) Limit(limit int) CMAllOrdersServiceAPI {
+	return (*cmAllOrdersServiceAPI)((*CMAllOrdersService)(a).Limit(limit))
+}
+
+func (a *cmAllOrdersServiceAPI) RecvWindow(recvWindow int64) CMAllOrdersServiceAPI {
+	return (*cmAllOrdersServiceAPI)((*CMAllOrdersService)(a).RecvWindow(recvWindow))
+}
+
+func (a *cmAllOrdersServiceAPI) Do(ctx context.Context) ([]*CMAllOrdersResponse, error) {
+	return (*CMAllOrdersService)(a).Do(ctx)
+}
+
+// CMOpenOrderServiceAPI is the interface of CMOpenOrderService
+type CMOpenOrderServiceAPI interface {
+	Symbol(symbol string) CMOpenOrderServiceAPI
+	OrderID(orderID int64) CMOpenOrderServiceAPI
+	OrigClientOrderID(origClientOrderID string) CMOpenOrderServiceAPI
+	RecvWindow(recvWindow int64) CMOpenOrderServiceAPI
+	Do(ctx context.Context) (*CMOpenOrderResponse, error)
+}
+
+type cmOpenOrderServiceAPI CMOpenOrderService
+
+func (a *cmOpenOrderServiceAPI) Symbol(symbol string) CMOpenOrderServiceAPI {
+	return (*cmOpenOrderServiceAPI)((*CMOpenOrderService)(a).Symbol(symbol))
+}
+
+func (a *cmOpenOrderServiceAPI) OrderID(orderID int64) CMOpenOrderServiceAPI {
+	return (*cmOpenOrderServiceAPI)((*CMOpenOrderService)(a).OrderID(orderID))
+}
+
+func (a *cmOpenOrderServiceAPI) OrigClientOrderID(origClientOrderID string) CMOpenOrderServiceAPI {
+	return (*cmOpenOrderServiceAPI)((*CMOpenOrderService)(a).OrigClientOrderID(origClientOrderID))
+}
+
+func (a *cmOpenOrderServiceAPI) RecvWindow(recvWindow int64) CMOpenOrderServiceAPI {
+	return (*cmOpenOrderServiceAPI)((*CMOpenOrderService)(a).RecvWindow(recvWindow))
+}
+
+func (a *cmOpenOrderServiceAPI) Do(ctx context.Context) (*CMOpenOrderResponse, error) {
+	return (*CMOpenOrderService)(a).Do(ctx)
+}
+
+// CMOpenOrdersServiceAPI is the interface of CMOpenOrdersService
+type CMOpenOrdersServiceAPI interface {
+	Symbol(symbol string) CMOpenOrdersServiceAPI
+	Pair(pair string) CMOpenOrdersServiceAPI
+	RecvWindow(recvWindow int64) CMOpenOrdersServiceAPI
+	Do(ctx context.Context) ([]*CMOpenOrdersResponse, error)
+}
+
+type cmOpenOrdersServiceAPI CMOpenOrdersService
+
+func (a *cmOpenOrdersServiceAPI) Symbol(symbol string) CMOpenOrdersServiceAPI {
+	return (*cmOpenOrdersServiceAPI)((*CMOpenOrdersService)(a).Symbol(symbol))
+}
+
+func (a *cmOpenOrdersServiceAPI) Pair(pair string) CMOpenOrdersServiceAPI {
+	return (*cmOpenOrdersServiceAPI)((*CMOpenOrdersService)(a).Pair(pair))
+}
+
+func (a *cmOpenOrdersServiceAPI) RecvWindow(recvWindow int64) CMOpenOrdersServiceAPI {
+	return (*cmOpenOrdersServiceAPI)((*CMOpenOrdersService)(a).RecvWindow(recvWindow))
+}
+
+func (a *cmOpenOrdersServiceAPI) Do(ctx context.Context) ([]*CMOpenOrdersResponse, error) {
+	return (*CMOpenOrdersService)(a).Do(ctx)
+}
+
+// CMOpenConditionalOrdersServiceAPI is the interface of CMOpenConditionalOrdersService
+type CMOpenConditionalOrdersServiceAPI interface {
+	Symbol(symbol string) CMOpenConditionalOrdersServiceAPI
+	RecvWindow(recvWindow int64) CMOpenConditionalOrdersServiceAPI
+	Do(ctx context.Context) ([]*CMOpenConditionalOrderResponse, error)
+}
+
+type cmOpenConditionalOrdersServiceAPI CMOpenConditionalOrdersService
+
+func (a *cmOpenConditionalOrdersServiceAPI) Symbol(symbol string) CMOpenConditionalOrdersServiceAPI {
+	return (*cmOpenConditionalOrdersServiceAPI)((*CMOpenConditionalOrdersService)(a).Symbol(symbol))
+}
+
+func (a *cmOpenConditionalOrdersServiceAPI) RecvWindow(recvWindow int64) CMOpenConditionalOrdersServiceAPI {
+	return (*cmOpenConditionalOrdersServiceAPI)((*CMOpenConditionalOrdersService)(a).RecvWindow(recvWindow))
+}
+
+func (a *cmOpenConditionalOrdersServiceAPI) Do(ctx context.Context) ([]*CMOpenConditionalOrderResponse, error) {
+	return (*CMOpenConditionalOrdersService)(a).Do(ctx)
+}
+
+// CMOpenConditionalOrderServiceAPI is the interface of CMOpenConditionalOrderService
+type CMOpenConditionalOrderServiceAPI interface {
+	Symbol(symbol string) CMOpenConditionalOrderServiceAPI
+	StrategyID(strategyID int64) CMOpenConditionalOrderServiceAPI
+	NewClientStrategyID(newClientStrategyID string) CMOpenConditionalOrderServiceAPI
+	RecvWindow(recvWindow int64) CMOpenConditionalOrderServiceAPI
+	Do(ctx context.Context) (*CMOpenConditionalOrderResponse, error)
+}
+
+type cmOpenConditionalOrderServiceAPI CMOpenConditionalOrderService
+
+func (a *cmOpenConditionalOrderServiceAPI) Symbol(symbol string) CMOpenConditionalOrderServiceAPI {
+	return (*cmOpenConditionalOrderServiceAPI)((*CMOpenConditionalOrderService)(a).Symbol(symbol))
+}
+
+func (a *cmOpenConditionalOrderServiceAPI) StrategyID(strategyID int64) CMOpenConditionalOrderServiceAPI {
+	return (*cmOpenConditionalOrderServiceAPI)((*CMOpenConditionalOrderService)(a).StrategyID(strategyID))
+}
+
+func (a *cmOpenConditionalOrderServiceAPI) NewClientStrategyID(newClientStrategyID string) CMOpenConditionalOrderServiceAPI {
+	return (*cmOpenConditionalOrderServiceAPI)((*CMOpenConditionalOrderService)(a).NewClientStrategyID(newClientStrategyID))
+}
+
+func (a *cmOpenConditionalOrderServiceAPI) RecvWindow(recvWindow int64) CMOpenConditionalOrderServiceAPI {
+	return (*cmOpenConditionalOrderServiceAPI)((*CMOpenConditionalOrderService)(a).RecvWindow(recvWindow))
+}
+
+func (a *cmOpenConditionalOrderServiceAPI) Do(ctx context.Context) (*CMOpenConditionalOrderResponse, error) {
+	return (*CMOpenConditionalOrderService)(a).Do(ctx)
+}
+
+// CMConditionalOrdersServiceAPI is the interface of CMConditionalOrdersService
+type CMConditionalOrdersServiceAPI interface {
+	Symbol(symbol string) CMConditionalOrdersServiceAPI
+	StrategyID(strategyID int64) CMConditionalOrdersServiceAPI
+	StartTime(startTime int64) CMConditionalOrdersServiceAPI
+	EndTime(endTime int64) CMConditionalOrdersServiceAPI
+	Limit(limit int) CMConditionalOrdersServiceAPI
+	RecvWindow(recvWindow int64) CMConditionalOrdersServiceAPI
+	Do(ctx context.Context) ([]*CMConditionalOrderResponse, error)
+}
+
+type cmConditionalOrdersServiceAPI CMConditionalOrdersService
+
+func (a *cmConditionalOrdersServiceAPI) Symbol(symbol string) CMConditionalOrdersServiceAPI {
+	return (*cmConditionalOrdersServiceAPI)((*CMConditionalOrdersService)(a).Symbol(symbol))
+}
+
+func (a *cmConditionalOrdersServiceAPI) StrategyID(strategyID int64) CMConditionalOrdersServiceAPI {
+	return (*cmConditionalOrdersServiceAPI)((*CMConditionalOrdersService)(a).StrategyID(strategyID))
+}
+
+func (a *cmConditionalOrdersServiceAPI) StartTime(startTime int64) CMConditionalOrdersServiceAPI {
+	return (*cmConditionalOrdersServiceAPI)((*CMConditionalOrdersService)(a).StartTime(startTime))
+}
+
+func (a *cmConditionalOrdersServiceAPI) EndTime(endTime int64) CMConditionalOrdersServiceAPI {
+	return (*cmConditionalOrdersServiceAPI)((*CMConditionalOrdersService)(a).EndTime(endTime))
+}
+
+func (a *cmConditionalOrdersServiceAPI) Limit(limit int) CMConditionalOrdersServiceAPI {
+	return (*cmConditionalOrdersServiceAPI)((*CMConditionalOrdersService)(a).Limit(limit))
+}
+
+func (a *cmConditionalOrdersServiceAPI) RecvWindow(recvWindow int64) CMConditionalOrdersServiceAPI {
+	return (*cmConditionalOrdersServiceAPI)((*CMConditionalOrdersService)(a).RecvWindow(recvWindow))
+}
+
+func (a *cmConditionalOrdersServiceAPI) Do(ctx context.Context) ([]*CMConditionalOrderResponse, error) {
+	return (*CMConditionalOrdersService)(a).Do(ctx)
+}
+
+// CMConditionalOrderHistoryServiceAPI is the interface of CMConditionalOrderHistoryService
+type CMConditionalOrderHistoryServiceAPI interface {
+	Symbol(symbol string) CMConditionalOrderHistoryServiceAPI
+	StrategyID(strategyID int64) CMConditionalOrderHistoryServiceAPI
+	NewClientStrategyID(newClientStrategyID string) CMConditionalOrderHistoryServiceAPI
+	RecvWindow(recvWindow int64) CMConditionalOrderHistoryServiceAPI
+	Do(ctx context.Context) (*CMConditionalOrderHistoryResponse, error)
+}
+
+type cmConditionalOrderHistoryServiceAPI CMConditionalOrderHistoryService
+
+func (a *cmConditionalOrderHistoryServiceAPI) Symbol(symbol string) CMConditionalOrderHistoryServiceAPI {
+	return (*cmConditionalOrderHistoryServiceAPI)((*CMConditionalOrderHistoryService)(a).Symbol(symbol))
+}
+
+func (a *cmConditionalOrderHistoryServiceAPI) StrategyID(strategyID int64) CMConditionalOrderHistoryServiceAPI {
+	return (*cmConditionalOrderHistoryServiceAPI)((*CMConditionalOrderHistoryService)(a).StrategyID(strategyID))
+}
+
+func (a *cmConditionalOrderHistoryServiceAPI) NewClientStrategyID(newClientStrategyID string) CMConditionalOrderHistoryServiceAPI {
+	return (*cmConditionalOrderHistoryServiceAPI)((*CMConditionalOrderHistoryService)(a).NewClientStrategyID(newClientStrategyID))
+}
+
+func (a *cmConditionalOrderHistoryServiceAPI) RecvWindow(recvWindow int64) CMConditionalOrderHistoryServiceAPI {
+	return (*cmConditionalOrderHistoryServiceAPI)((*CMConditionalOrderHistoryService)(a).RecvWindow(recvWindow))
+}
+
+func (a *cmConditionalOrderHistoryServiceAPI) Do(ctx context.Context) (*CMConditionalOrderHistoryResponse, error) {
+	return (*CMConditionalOrderHistoryService)(a).Do(ctx)
+}
+
+// UMForceOrdersServiceAPI is the interface of UMForceOrdersService
+type UMForceOrdersServiceAPI interface {
+	Symbol(symbol string) UMForceOrdersServiceAPI
+	AutoCloseType(autoCloseType string) UMForceOrdersServiceAPI
+	StartTime(startTime int64) UMForceOrdersServiceAPI
+	EndTime(endTime int64) UMForceOrdersServiceAPI
+	Limit(limit int) UMForceOrdersServiceAPI
+	RecvWindow(recvWindow int64) UMForceOrdersServiceAPI
+	Do(ctx context.Context) ([]*UMForceOrderResponse, error)
+}
+
+type umForceOrdersServiceAPI UMForceOrdersService
+
+func (a *umForceOrdersServiceAPI) Symbol(symbol string) UMForceOrdersServiceAPI {
+	return (*umForceOrdersServiceAPI)((*UMForceOrdersService)(a).Symbol(symbol))
+}
+
+func (a *umForceOrdersServiceAPI) AutoCloseType(autoCloseType string) UMForceOrdersServiceAPI {
+	return (*umForceOrdersServiceAPI)((*UMForceOrdersService)(a).AutoCloseType(autoCloseType))
+}
+
+func (a *umForceOrdersServiceAPI) StartTime(startTime int64) UMForceOrdersServiceAPI {
+	return (*umForceOrdersServiceAPI)((*UMForceOrdersService)(a).StartTime(startTime))
+}
+
+func (a *umForceOrdersServiceAPI) EndTime(endTime int64) UMForceOrdersServiceAPI {
+	return (*umForceOrdersServiceAPI)((*UMForceOrdersService)(a).EndTime(endTime))
+}
+
+func (a *umForceOrdersServiceAPI) Limit(limit int) UMForceOrdersServiceAPI {
+	return (*umForceOrdersServiceAPI)((*UMForceOrdersService)(a).Limit(limit))
+}
+
+func (a *umForceOrdersServiceAPI) RecvWindow(recvWindow int64) UMForceOrdersServiceAPI {
+	return (*umForceOrdersServiceAPI)((*UMForceOrdersService)(a).RecvWindow(recvWindow))
+}
+
+func (a *umForceOrdersServiceAPI) Do(ctx context.Context) ([]*UMForceOrderResponse, error) {
+	return (*UMForceOrdersService)(a).Do(ctx)
+}
+
+// CMForceOrdersServiceAPI is the interface of CMForceOrdersService
+type CMForceOrdersServiceAPI interface {
+	Symbol(symbol string) CMForceOrdersServiceAPI
+	AutoCloseType(autoCloseType string) CMForceOrdersServiceAPI
+	StartTime(startTime int64) CMForceOrdersServiceAPI
+	EndTime(endTime int64) CMForceOrdersServiceAPI
+	Limit(limit int) CMForceOrdersServiceAPI
+	RecvWindow(recvWindow int64) CMForceOrdersServiceAPI
+	Do(ctx context.Context) ([]*CMForceOrderResponse, error)
+}
+
+type cmForceOrdersServiceAPI CMForceOrdersService
+
+func (a *cmForceOrdersServiceAPI) Symbol(symbol string) CMForceOrdersServiceAPI {
+	return (*cmForceOrdersServiceAPI)((*CMForceOrdersService)(a).Symbol(symbol))
+}
+
+func (a *cmForceOrdersServiceAPI) AutoCloseType(autoCloseType string) CMForceOrdersServiceAPI {
+	return (*cmForceOrdersServiceAPI)((*CMForceOrdersService)(a).AutoCloseType(autoCloseType))
+}
+
+func (a *cmForceOrdersServiceAPI) StartTime(startTime int64) CMForceOrdersServiceAPI {
+	return (*cmForceOrdersServiceAPI)((*CMForceOrdersService)(a).StartTime(startTime))
+}
+
+func (a *cmForceOrdersServiceAPI) EndTime(endTime int64) CMForceOrdersServiceAPI {
+	return (*cmForceOrdersServiceAPI)((*CMForceOrdersService)(a).EndTime(endTime))
+}
+
+func (a *cmForceOrdersServiceAPI) Limit(limit int) CMForceOrdersServiceAPI {
+	return (*cmForceOrdersServiceAPI)((*CMForceOrdersService)(a).Limit(limit))
+}
+
+func (a *cmForceOrdersServiceAPI) RecvWindow(recvWindow int64) CMForceOrdersServiceAPI {
+	return (*cmForceOrdersServiceAPI)((*CMForceOrdersService)(a).RecvWindow(recvWindow))
+}
+
+func (a *cmForceOrdersServiceAPI) Do(ctx context.Context) ([]*CMForceOrderResponse, error) {
+	return (*CMForceOrdersService)(a).Do(ctx)
+}
+
+// UMModifyOrderHistoryServiceAPI is the interface of UMModifyOrderHistoryService
+type UMModifyOrderHistoryServiceAPI interface {
+	Symbol(symbol string) UMModifyOrderHistoryServiceAPI
+	OrderID(orderID int64) UMModifyOrderHistoryServiceAPI
+	OrigClientOrderID(origClientOrderID string) UMModifyOrderHistoryServiceAPI
+	StartTime(startTime int64) UMModifyOrderHistoryServiceAPI
+	EndTime(endTime int64) UMModifyOrderHistoryServiceAPI
+	Limit(limit int) UMModifyOrderHistoryServiceAPI
+	RecvWindow(recvWindow int64) UMModifyOrderHistoryServiceAPI
+	Do(ctx context.Context) ([]*UMModifyOrderHistoryResponse, error)
+}
+
+type umModifyOrderHistoryServiceAPI UMModifyOrderHistoryService
+
+func (a *umModifyOrderHistoryServiceAPI) Symbol(symbol string) UMModifyOrderHistoryServiceAPI {
+	return (*umModifyOrderHistoryServiceAPI)((*UMModifyOrderHistoryService)(a).Symbol(symbol))
+}
+
+func (a *umModifyOrderHistoryServiceAPI) OrderID(orderID int64) UMModifyOrderHistoryServiceAPI {
+	return (*umModifyOrderHistoryServiceAPI)((*UMModifyOrderHistoryService)(a).OrderID(orderID))
+}
+
+func (a *umModifyOrderHistoryServiceAPI) OrigClientOrderID(origClientOrderID string) UMModifyOrderHistoryServiceAPI {
+	return (*umModifyOrderHistoryServiceAPI)((*UMModifyOrderHistoryService)(a).OrigClientOrderID(origClientOrderID))
+}
+
+func (a *umModifyOrderHistoryServiceAPI) StartTime(startTime int64) UMModifyOrderHistoryServiceAPI {
+	return (*umModifyOrderHistoryServiceAPI)((*UMModifyOrderHistoryService)(a).StartTime(startTime))
+}
+
+func (a *umModifyOrderHistoryServiceAPI) EndTime(endTime int64) UMModifyOrderHistoryServiceAPI {
+	return (*umModifyOrderHistoryServiceAPI)((*UMModifyOrderHistoryService)(a).EndTime(endTime))
+}
+
+func (a *umModifyOrderHistoryServiceAPI) Limit(limit int) UMModifyOrderHistoryServiceAPI {
+	return (*umModifyOrderHistoryServiceAPI)((*UMModifyOrderHistoryService)(a).Limit(limit))
+}
+
+func (a *umModifyOrderHistoryServiceAPI) RecvWindow(recvWindow int64) UMModifyOrderHistoryServiceAPI {
+	return (*umModifyOrderHistoryServiceAPI)((*UMModifyOrderHistoryService)(a).RecvWindow(recvWindow))
+}
+
+func (a *umModifyOrderHistoryServiceAPI) Do(ctx context.Context) ([]*UMModifyOrderHistoryResponse, error) {
+	return (*UMModifyOrderHistoryService)(a).Do(ctx)
+}
+
+// CMModifyOrderHistoryServiceAPI is the interface of CMModifyOrderHistoryService
+type CMModifyOrderHistoryServiceAPI interface {
+	Symbol(symbol string) CMModifyOrderHistoryServiceAPI
+	OrderID(orderID int64) CMModifyOrderHistoryServiceAPI
+	OrigClientOrderID(origClientOrderID string) CMModifyOrderHistoryServiceAPI
+	StartTime(startTime int64) CMModifyOrderHistoryServiceAPI
+	EndTime(endTime int64) CMModifyOrderHistoryServiceAPI
+	Limit(limit int) CMModifyOrderHistoryServiceAPI
+	RecvWindow(recvWindow int64) CMModifyOrderHistoryServiceAPI
+	Do(ctx context.Context) ([]*CMModifyOrderHistoryResponse, error)
+}
+
+type cmModifyOrderHistoryServiceAPI CMModifyOrderHistoryService
+
+func (a *cmModifyOrderHistoryServiceAPI) Symbol(symbol string) CMModifyOrderHistoryServiceAPI {
+	return (*cmModifyOrderHistoryServiceAPI)((*CMModifyOrderHistoryService)(a).Symbol(symbol))
+}
+
+func (a *cmModifyOrderHistoryServiceAPI) OrderID(orderID int64) CMModifyOrderHistoryServiceAPI {
+	return (*cmModifyOrderHistoryServiceAPI)((*CMModifyOrderHistoryService)(a).OrderID(orderID))
+}
+
+func (a *cmModifyOrderHistoryServiceAPI) OrigClientOrderID(origClientOrderID string) CMModifyOrderHistoryServiceAPI {
+	return (*cmModifyOrderHistoryServiceAPI)((*CMModifyOrderHistoryService)(a).OrigClientOrderID(origClientOrderID))
+}
+
+func (a *cmModifyOrderHistoryServiceAPI) StartTime(startTime int64) CMModifyOrderHistoryServiceAPI {
+	return (*cmModifyOrderHistoryServiceAPI)((*CMModifyOrderHistoryService)(a).StartTime(startTime))
+}
+
+func (a *cmModifyOrderHistoryServiceAPI) EndTime(endTime int64) CMModifyOrderHistoryServiceAPI {
+	return (*cmModifyOrderHistoryServiceAPI)((*CMModifyOrderHistoryService)(a).EndTime(endTime))
+}
+
+func (a *cmModifyOrderHistoryServiceAPI) Limit(limit int) CMModifyOrderHistoryServiceAPI {
+	return (*cmModifyOrderHistoryServiceAPI)((*CMModifyOrderHistoryService)(a).Limit(limit))
+}
+
+func (a *cmModifyOrderHistoryServiceAPI) RecvWindow(recvWindow int64) CMModifyOrderHistoryServiceAPI {
+	return (*cmModifyOrderHistoryServiceAPI)((*CMModifyOrderHistoryService)(a).RecvWindow(recvWindow))
+}
+
+func (a *cmModifyOrderHistoryServiceAPI) Do(ctx context.Context) ([]*CMModifyOrderHistoryResponse, error) {
+	return (*CMModifyOrderHistoryService)(a).Do(ctx)
+}
+
+// MarginForceOrdersServiceAPI is the interface of MarginForceOrdersService
+type MarginForceOrdersServiceAPI interface {
+	StartTime(startTime int64) MarginForceOrdersServiceAPI
+	EndTime(endTime int64) MarginForceOrdersServiceAPI
+	Current(current int64) MarginForceOrdersServiceAPI
+	Size(size int64) MarginForceOrdersServiceAPI
+	RecvWindow(recvWindow int64) MarginForceOrdersServiceAPI
+	Do(ctx context.Context) (*MarginForceOrdersResponse, error)
+}
+
+type marginForceOrdersServiceAPI MarginForceOrdersService
+
+func (a *marginForceOrdersServiceAPI) StartTime(startTime int64) MarginForceOrdersServiceAPI {
+	return (*marginForceOrdersServiceAPI)((*MarginForceOrdersService)(a).StartTime(startTime))
+}
+
+func (a *marginForceOrdersServiceAPI) EndTime(endTime int64) MarginForceOrdersServiceAPI {
+	return (*marginForceOrdersServiceAPI)((*MarginForceOrdersService)(a).EndTime(endTime))
+}
+
+func (a *marginForceOrdersServiceAPI) Current(current int64) MarginForceOrdersServiceAPI {
+	return (*marginForceOrdersServiceAPI)((*MarginForceOrdersService)(a).Current(current))
+}
+
+func (a *marginForceOrdersServiceAPI) Size(size int64) MarginForceOrdersServiceAPI {
+	return (*marginForceOrdersServiceAPI)((*MarginForceOrdersService)(a).Size(size))
+}
+
+func (a *marginForceOrdersServiceAPI) RecvWindow(recvWindow int64) MarginForceOrdersServiceAPI {
+	return (*marginForceOrdersServiceAPI)((*MarginForceOrdersService)(a).RecvWindow(recvWindow))
+}
+
+func (a *marginForceOrdersServiceAPI) Do(ctx context.Context) (*MarginForceOrdersResponse, error) {
+	return (*MarginForceOrdersService)(a).Do(ctx)
+}
+
+// UMAccountTradeServiceAPI is the interface of UMAccountTradeService
+type UMAccountTradeServiceAPI interface {
+	Symbol(symbol string) UMAccountTradeServiceAPI
+	StartTime(startTime int64) UMAccountTradeServiceAPI
+	EndTime(endTime int64) UMAccountTradeServiceAPI
+	FromID(fromID int64) UMAccountTradeServiceAPI
+	Limit(limit int) UMAccountTradeServiceAPI
+	RecvWindow(recvWindow int64) UMAccountTradeServiceAPI
+	Do(ctx context.Context) ([]*UMAccountTrade, error)
+}
+
+type umAccountTradeServiceAPI UMAccountTradeService
+
+func (a *umAccountTradeServiceAPI) Symbol(symbol string) UMAccountTradeServiceAPI {
+	return (*umAccountTradeServiceAPI)((*UMAccountTradeService)(a).Symbol(symbol))
+}
+
+func (a *umAccountTradeServiceAPI) StartTime(startTime int64) UMAccountTradeServiceAPI {
+	return (*umAccountTradeServiceAPI)((*UMAccountTradeService)(a).StartTime(startTime))
+}
+
+func (a *umAccountTradeServiceAPI) EndTime(endTime int64) UMAccountTradeServiceAPI {
+	return (*umAccountTradeServiceAPI)((*UMAccountTradeService)(a).EndTime(endTime))
+}
+
+func (a *umAccountTradeServiceAPI) FromID(fromID int64) UMAccountTradeServiceAPI {
+	return (*umAccountTradeServiceAPI)((*UMAccountTradeService)(a).FromID(fromID))
+}
+
+func (a *umAccountTradeServiceAPI) Limit(limit int) UMAccountTradeServiceAPI {
+	return (*umAccountTradeServiceAPI)((*UMAccountTradeService)(a).Limit(limit))
+}
+
+func (a *umAccountTradeServiceAPI) RecvWindow(recvWindow int64) UMAccountTradeServiceAPI {
+	return (*umAccountTradeServiceAPI)((*UMAccountTradeService)(a).RecvWindow(recvWindow))
+}
+
+func (a *umAccountTradeServiceAPI) Do(ctx context.Context) ([]*UMAccountTrade, error) {
+	return (*UMAccountTradeService)(a).Do(ctx)
+}
+
+// CMAccountTradeServiceAPI is the interface of CMAccountTradeService
+type CMAccountTradeServiceAPI interface {
+	Symbol(symbol string) CMAccountTradeServiceAPI
+	Pair(pair string) CMAccountTradeServiceAPI
+	StartTime(startTime int64) CMAccountTradeServiceAPI
+	EndTime(endTime int64) CMAccountTradeServiceAPI
+	FromID(fromID int64) CMAccountTradeServiceAPI
+	Limit(limit int) CMAccountTradeServiceAPI
+	RecvWindow(recvWindow int64) CMAccountTradeServiceAPI
+	Do(ctx context.Context) ([]*CMAccountTrade, error)
+}
+
+type cmAccountTradeServiceAPI CMAccountTradeService
+
+func (a *cmAccountTradeServiceAPI) Symbol(symbol string) CMAccountTradeServiceAPI {
+	return (*cmAccountTradeServiceAPI)((*CMAccountTradeService)(a).Symbol(symbol))
+}
+
+func (a *cmAccountTradeServiceAPI) Pair(pair string) CMAccountTradeServiceAPI {
+	return (*cmAccountTradeServiceAPI)((*CMAccountTradeService)(a).Pair(pair))
+}
+
+func (a *cmAccountTradeServiceAPI) StartTime(startTime int64) CMAccountTradeServiceAPI {
+	return (*cmAccountTradeServiceAPI)((*CMAccountTradeService)(a).StartTime(startTime))
+}
+
+func (a *cmAccountTradeServiceAPI) EndTime(endTime int64) CMAccountTradeServiceAPI {
+	return (*cmAccountTradeServiceAPI)((*CMAccountTradeService)(a).EndTime(endTime))
+}
+
+func (a *cmAccountTradeServiceAPI) FromID(fromID int64) CMAccountTradeServiceAPI {
+	return (*cmAccountTradeServiceAPI)((*CMAccountTradeService)(a).FromID(fromID))
+}
+
+func (a *cmAccountTradeServiceAPI) Limit(limit int) CMAccountTradeServiceAPI {
+	return (*cmAccountTradeServiceAPI)((*CMAccountTradeService)(a).Limit(limit))
+}
+
+func (a *cmAccountTradeServiceAPI) RecvWindow(recvWindow int64) CMAccountTradeServiceAPI {
+	return (*cmAccountTradeServiceAPI)((*CMAccountTradeService)(a).RecvWindow(recvWindow))
+}
+
+func (a *cmAccountTradeServiceAPI) Do(ctx context.Context) ([]*CMAccountTrade, error) {
+	return (*CMAccountTradeService)(a).Do(ctx)
+}
+
+// UMADLQuantileServiceAPI is the interface of UMADLQuantileService
+type UMADLQuantileServiceAPI interface {
+	Symbol(symbol string) UMADLQuantileServiceAPI
+	RecvWindow(recvWindow int64) UMADLQuantileServiceAPI
+	Do(ctx context.Context) ([]*UMADLQuantileResponse, error)
+}
+
+type umadlQuantileServiceAPI UMADLQuantileService
+
+func (a *umadlQuantileServiceAPI) Symbol(symbol string) UMADLQuantileServiceAPI {
+	return (*umadlQuantileServiceAPI)((*UMADLQuantileService)(a).Symbol(symbol))
+}
+
+func (a *umadlQuantileServiceAPI) RecvWindow(recvWindow int64) UMADLQuantileServiceAPI {
+	return (*umadlQuantileServiceAPI)((*UMADLQuantileService)(a).RecvWindow(recvWindow))
+}
+
+func (a *umadlQuantileServiceAPI) Do(ctx context.Context) ([]*UMADLQuantileResponse, error) {
+	return (*UMADLQuantileService)(a).Do(ctx)
+}
+
+// CMADLQuantileServiceAPI is the interface of CMADLQuantileService
+type CMADLQuantileServiceAPI interface {
+	Symbol(symbol string) CMADLQuantileServiceAPI
+	RecvWindow(recvWindow int64) CMADLQuantileServiceAPI
+	Do(ctx context.Context) ([]*CMADLQuantileResponse, error)
+}
+
+type cmadlQuantileServiceAPI CMADLQuantileService
+
+func (a *cmadlQuantileServiceAPI) Symbol(symbol string) CMADLQuantileServiceAPI {
+	return (*cmadlQuantileServiceAPI)((*CMADLQuantileService)(a).Symbol(symbol))
+}
+
+func (a *cmadlQuantileServiceAPI) RecvWindow(recvWindow int64) CMADLQuantileServiceAPI {
+	return (*cmadlQuantileServiceAPI)((*CMADLQuantileService)(a).RecvWindow(recvWindow))
+}
+
+func (a *cmadlQuantileServiceAPI) Do(ctx context.Context) ([]*CMADLQuantileResponse, error) {
+	return (*CMADLQuantileService)(a).Do(ctx)
+}
+
+// UMFeeBurnServiceAPI is the interface of UMFeeBurnService
+type UMFeeBurnServiceAPI interface {
+	FeeBurn(feeBurn bool) UMFeeBurnServiceAPI
+	RecvWindow(recvWindow int64) UMFeeBurnServiceAPI
+	Do(ctx context.Context) (*UMFeeBurnResponse, error)
+}
+
+type umFeeBurnServiceAPI UMFeeBurnService
+
+func (a *umFeeBurnServiceAPI) FeeBurn(feeBurn bool) UMFeeBurnServiceAPI {
+	return (*umFeeBurnServiceAPI)((*UMFeeBurnService)(a).FeeBurn(feeBurn))
+}
+
+func (a *umFeeBurnServiceAPI) RecvWindow(recvWindow int64) UMFeeBurnServiceAPI {
+	return (*umFeeBurnServiceAPI)((*UMFeeBurnService)(a).RecvWindow(recvWindow))
+}
+
+func (a *umFeeBurnServiceAPI) Do(ctx context.Context) (*UMFeeBurnResponse, error) {
+	return (*UMFeeBurnService)(a).Do(ctx)
+}
+
+// GetMarginOpenOrdersServiceAPI is the interface of GetMarginOpenOrdersService
+type GetMarginOpenOrdersServiceAPI interface {
+	Symbol(symbol string) GetMarginOpenOrdersServiceAPI
+	RecvWindow(recvWindow int64) GetMarginOpenOrdersServiceAPI
+	Do(ctx context.Context) ([]*MarginOrder, error)
+}
+
+type getMarginOpenOrdersServiceAPI GetMarginOpenOrdersService
+
+func (a *getMarginOpenOrdersServiceAPI) Symbol(symbol string) GetMarginOpenOrdersServiceAPI {
+	return (*getMarginOpenOrdersServiceAPI)((*GetMarginOpenOrdersService)(a).Symbol(symbol))
+}
+
+func (a *getMarginOpenOrdersServiceAPI) RecvWindow(recvWindow int64) GetMarginOpenOrdersServiceAPI {
+	return (*getMarginOpenOrdersServiceAPI)((*GetMarginOpenOrdersService)(a).RecvWindow(recvWindow))
+}
+
+func (a *getMarginOpenOrdersServiceAPI) Do(ctx context.Context) ([]*MarginOrder, error) {
+	return (*GetMarginOpenOrdersService)(a).Do(ctx)
+}
+
+// GetMarginAllOrdersServiceAPI is the interface of GetMarginAllOrdersService
+type GetMarginAllOrdersServiceAPI interface {
+	Symbol(symbol string) GetMarginAllOrdersServiceAPI
+	OrderID(orderID int64) GetMarginAllOrdersServiceAPI
+	StartTime(startTime int64) GetMarginAllOrdersServiceAPI
+	EndTime(endTime int64) GetMarginAllOrdersServiceAPI
+	Limit(limit int) GetMarginAllOrdersServiceAPI
+	RecvWindow(recvWindow int64) GetMarginAllOrdersServiceAPI
+	Do(ctx context.Context) ([]*MarginOrder, error)
+}
+
+type getMarginAllOrdersServiceAPI GetMarginAllOrdersService
+
+func (a *getMarginAllOrdersServiceAPI) Symbol(symbol string) GetMarginAllOrdersServiceAPI {
+	return (*getMarginAllOrdersServiceAPI)((*GetMarginAllOrdersService)(a).Symbol(symbol))
+}
+
+func (a *getMarginAllOrdersServiceAPI) OrderID(orderID int64) GetMarginAllOrdersServiceAPI {
+	return (*getMarginAllOrdersServiceAPI)((*GetMarginAllOrdersService)(a).OrderID(orderID))
+}
+
+func (a *getMarginAllOrdersServiceAPI) StartTime(startTime int64) GetMarginAllOrdersServiceAPI {
+	return (*getMarginAllOrdersServiceAPI)((*GetMarginAllOrdersService)(a).StartTime(startTime))
+}
+
+func (a *getMarginAllOrdersServiceAPI) EndTime(endTime int64) GetMarginAllOrdersServiceAPI {
+	return (*getMarginAllOrdersServiceAPI)((*GetMarginAllOrdersService)(a).EndTime(endTime))
+}
+
+func (a *getMarginAllOrdersServiceAPI) Limit(limit int) GetMarginAllOrdersServiceAPI {
+	return (*getMarginAllOrdersServiceAPI)((*GetMarginAllOrdersService)(a).Limit(limit))
+}
+
+func (a *getMarginAllOrdersServiceAPI) RecvWindow(recvWindow int64) GetMarginAllOrdersServiceAPI {
+	return (*getMarginAllOrdersServiceAPI)((*GetMarginAllOrdersService)(a).RecvWindow(recvWindow))
+}
+
+func (a *getMarginAllOrdersServiceAPI) Do(ctx context.Context) ([]*MarginOrder, error) {
+	return (*GetMarginAllOrdersService)(a).Do(ctx)
+}
+
+// UMFeeBurnStatusServiceAPI is the interface of UMFeeBurnStatusService
+type UMFeeBurnStatusServiceAPI interface {
+	RecvWindow(recvWindow int64) UMFeeBurnStatusServiceAPI
+	Do(ctx context.Context) (*UMFeeBurnStatusResponse, error)
+}
+
+type umFeeBurnStatusServiceAPI UMFeeBurnStatusService
+
+func (a *umFeeBurnStatusServiceAPI) RecvWindow(recvWindow int64) UMFeeBurnStatusServiceAPI {
+	return (*umFeeBurnStatusServiceAPI)((*UMFeeBurnStatusService)(a).RecvWindow(recvWindow))
+}
+
+func (a *umFeeBurnStatusServiceAPI) Do(ctx context.Context) (*UMFeeBurnStatusResponse, error) {
+	return (*UMFeeBurnStatusService)(a).Do(ctx)
+}
+
+// GetMarginForceOrdersServiceAPI is the interface of GetMarginForceOrdersService
+type GetMarginForceOrdersServiceAPI interface {
+	StartTime(startTime int64) GetMarginForceOrdersServiceAPI
+	EndTime(endTime int64) GetMarginForceOrdersServiceAPI
+	Current(current int64) GetMarginForceOrdersServiceAPI
+	Size(size int64) GetMarginForceOrdersServiceAPI
+	RecvWindow(recvWindow int64) GetMarginForceOrdersServiceAPI
+	Do(ctx context.Context) (*MarginForceOrdersResponse, error)
+}
+
+type getMarginForceOrdersServiceAPI GetMarginForceOrdersService
+
+func (a *getMarginForceOrdersServiceAPI) StartTime(startTime int64) GetMarginForceOrdersServiceAPI {
+	return (*getMarginForceOrdersServiceAPI)((*GetMarginForceOrdersService)(a).StartTime(startTime))
+}
+
+func (a *getMarginForceOrdersServiceAPI) EndTime(endTime int64) GetMarginForceOrdersServiceAPI {
+	return (*getMarginForceOrdersServiceAPI)((*GetMarginForceOrdersService)(a).EndTime(endTime))
+}
+
+func (a *getMarginForceOrdersServiceAPI) Current(current int64) GetMarginForceOrdersServiceAPI {
+	return (*getMarginForceOrdersServiceAPI)((*GetMarginForceOrdersService)(a).Current(current))
+}
+
+func (a *getMarginForceOrdersServiceAPI) Size(size int64) GetMarginForceOrdersServiceAPI {
+	return (*getMarginForceOrdersServiceAPI)((*GetMarginForceOrdersService)(a).Size(size))
+}
+
+func (a *getMarginForceOrdersServiceAPI) RecvWindow(recvWindow int64) GetMarginForceOrdersServiceAPI {
+	return (*getMarginForceOrdersServiceAPI)((*GetMarginForceOrdersService)(a).RecvWindow(recvWindow))
+}
+
+func (a *getMarginForceOrdersServiceAPI) Do(ctx context.Context) (*MarginForceOrdersResponse, error) {
+	return (*GetMarginForceOrdersService)(a).Do(ctx)
+}
+
+// UMAccountTradesServiceAPI is the interface of UMAccountTradesService
+type UMAccountTradesServiceAPI interface {
+	Symbol(symbol string) UMAccountTradesServiceAPI
+	StartTime(startTime int64) UMAccountTradesServiceAPI
+	EndTime(endTime int64) UMAccountTradesServiceAPI
+	FromID(fromID int64) UMAccountTradesServiceAPI
+	Limit(limit int) UMAccountTradesServiceAPI
+	RecvWindow(recvWindow int64) UMAccountTradesServiceAPI
+	Do(ctx context.Context) ([]*UMAccountTrade, error)
+}
+
+type umAccountTradesServiceAPI UMAccountTradesService
+
+func (a *umAccountTradesServiceAPI) Symbol(symbol string) UMAccountTradesServiceAPI {
+	return (*umAccountTradesServiceAPI)((*UMAccountTradesService)(a).Symbol(symbol))
+}
+
+func (a *umAccountTradesServiceAPI) StartTime(startTime int64) UMAccountTradesServiceAPI {
+	return (*umAccountTradesServiceAPI)((*UMAccountTradesService)(a).StartTime(startTime))
+}
+
+func (a *umAccountTradesServiceAPI) EndTime(endTime int64) UMAccountTradesServiceAPI {
+	return (*umAccountTradesServiceAPI)((*UMAccountTradesService)(a).EndTime(endTime))
+}
+
+func (a *umAccountTradesServiceAPI) FromID(fromID int64) UMAccountTradesServiceAPI {
+	return (*umAccountTradesServiceAPI)((*UMAccountTradesService)(a).FromID(fromID))
+}
+
+func (a *umAccountTradesServiceAPI) Limit(limit int) UMAccountTradesServiceAPI {
+	return (*umAccountTradesServiceAPI)((*UMAccountTradesService)(a).Limit(limit))
+}
+
+func (a *umAccountTradesServiceAPI) RecvWindow(recvWindow int64) UMAccountTradesServiceAPI {
+	return (*umAccountTradesServiceAPI)((*UMAccountTradesService)(a).RecvWindow(recvWindow))
+}
+
+func (a *umAccountTradesServiceAPI) Do(ctx context.Context) ([]*UMAccountTrade, error) {
+	return (*UMAccountTradesService)(a).Do(ctx)
+}
+
+// CMAccountTradesServiceAPI is the interface of CMAccountTradesService
+type CMAccountTradesServiceAPI interface {
+	Symbol(symbol string) CMAccountTradesServiceAPI
+	Pair(pair string) CMAccountTradesServiceAPI
+	StartTime(startTime int64) CMAccountTradesServiceAPI
+	EndTime(endTime int64) CMAccountTradesServiceAPI
+	FromID(fromID int64) CMAccountTradesServiceAPI
+	Limit(limit int) CMAccountTradesServiceAPI
+	RecvWindow(recvWindow int64) CMAccountTradesServiceAPI
+	Do(ctx context.Context) ([]*CMAccountTrade, error)
+}
+
+type cmAccountTradesServiceAPI CMAccountTradesService
+
+func (a *cmAccountTradesServiceAPI) Symbol(symbol string) CMAccountTradesServiceAPI {
+	return (*cmAccountTradesServiceAPI)((*CMAccountTradesService)(a).Symbol(symbol))
+}
+
+func (a *cmAccountTradesServiceAPI) Pair(pair string) CMAccountTradesServiceAPI {
+	return (*cmAccountTradesServiceAPI)((*CMAccountTradesService)(a).Pair(pair))
+}
+
+func (a *cmAccountTradesServiceAPI) StartTime(startTime int64) CMAccountTradesServiceAPI {
+	return (*cmAccountTradesServiceAPI)((*CMAccountTradesService)(a).StartTime(startTime))
+}
+
+func (a *cmAccountTradesServiceAPI) EndTime(endTime int64) CMAccountTradesServiceAPI {
+	return (*cmAccountTradesServiceAPI)((*CMAccountTradesService)(a).EndTime(endTime))
+}
+
+func (a *cmAccountTradesServiceAPI) FromID(fromID int64) CMAccountTradesServiceAPI {
+	return (*cmAccountTradesServiceAPI)((*CMAccountTradesService)(a).FromID(fromID))
+}
+
+func (a *cmAccountTradesServiceAPI) Limit(limit int) CMAccountTradesServiceAPI {
+	return (*cmAccountTradesServiceAPI)((*CMAccountTradesService)(a).Limit(limit))
+}
+
+func (a *cmAccountTradesServiceAPI) RecvWindow(recvWindow int64) CMAccountTradesServiceAPI {
+	return (*cmAccountTradesServiceAPI)((*CMAccountTradesService)(a).RecvWindow(recvWindow))
+}
+
+func (a *cmAccountTradesServiceAPI) Do(ctx context.Context) ([]*CMAccountTrade, error) {
+	return (*CMAccountTradesService)(a).Do(ctx)
+}
+
+// UMGetADLQuantileServiceAPI is the interface of UMGetADLQuantileService
+type UMGetADLQuantileServiceAPI interface {
+	Symbol(symbol string) UMGetADLQuantileServiceAPI
+	RecvWindow(recvWindow int64) UMGetADLQuantileServiceAPI
+	Do(ctx context.Context) ([]*UMADLQuantileResponse, error)
+}
+
+type umGetADLQuantileServiceAPI UMGetADLQuantileService
+
+func (a *umGetADLQuantileServiceAPI) Symbol(symbol string) UMGetADLQuantileServiceAPI {
+	return (*umGetADLQuantileServiceAPI)((*UMGetADLQuantileService)(a).Symbol(symbol))
+}
+
+func (a *umGetADLQuantileServiceAPI) RecvWindow(recvWindow int64) UMGetADLQuantileServiceAPI {
+	return (*umGetADLQuantileServiceAPI)((*UMGetADLQuantileService)(a).RecvWindow(recvWindow))
+}
+
+func (a *umGetADLQuantileServiceAPI) Do(ctx context.Context) ([]*UMADLQuantileResponse, error) {
+	return (*UMGetADLQuantileService)(a).Do(ctx)
+}
+
+// MarginOCOQueryServiceAPI is the interface of MarginOCOQueryService
+type MarginOCOQueryServiceAPI interface {
+	OrderListID(orderListID int64) MarginOCOQueryServiceAPI
+	OrigClientOrderID(origClientOrderID string) MarginOCOQueryServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) (*MarginOCOResponse, error)
+}
+
+type marginOCOQueryServiceAPI MarginOCOQueryService
+
+func (a *marginOCOQueryServiceAPI) OrderListID(orderListID int64) MarginOCOQueryServiceAPI {
+	return (*marginOCOQueryServiceAPI)((*MarginOCOQueryService)(a).OrderListID(orderListID))
+}
+
+func (a *marginOCOQueryServiceAPI) OrigClientOrderID(origClientOrderID string) MarginOCOQueryServiceAPI {
+	return (*marginOCOQueryServiceAPI)((*MarginOCOQueryService)(a).OrigClientOrderID(origClientOrderID))
+}
+
+func (a *marginOCOQueryServiceAPI) Do(ctx context.Context, opts ...RequestOption) (*MarginOCOResponse, error) {
+	return (*MarginOCOQueryService)(a).Do(ctx, opts...)
+}
+
+// MarginOpenOCOServiceAPI is the interface of MarginOpenOCOService
+type MarginOpenOCOServiceAPI interface {
+	RecvWindow(recvWindow int64) MarginOpenOCOServiceAPI
+	Do(ctx context.Context) ([]*MarginOCOResponse, error)
+}
+
+type marginOpenOCOServiceAPI MarginOpenOCOService
+
+func (a *marginOpenOCOServiceAPI) RecvWindow(recvWindow int64) MarginOpenOCOServiceAPI {
+	return (*marginOpenOCOServiceAPI)((*MarginOpenOCOService)(a).RecvWindow(recvWindow))
+}
+
+func (a *marginOpenOCOServiceAPI) Do(ctx context.Context) ([]*MarginOCOResponse, error) {
+	return (*MarginOpenOCOService)(a).Do(ctx)
+}
+
+// MarginAccountTradesServiceAPI is the interface of MarginAccountTradesService
+type MarginAccountTradesServiceAPI interface {
+	Symbol(symbol string) MarginAccountTradesServiceAPI
+	OrderID(orderID int64) MarginAccountTradesServiceAPI
+	StartTime(startTime int64) MarginAccountTradesServiceAPI
+	EndTime(endTime int64) MarginAccountTradesServiceAPI
+	FromID(fromID int64) MarginAccountTradesServiceAPI
+	Limit(limit int) MarginAccountTradesServiceAPI
+	RecvWindow(recvWindow int64) MarginAccountTradesServiceAPI
+	Do(ctx context.Context) ([]*MarginTrade, error)
+}
+
+type marginAccountTradesServiceAPI MarginAccountTradesService
+
+func (a *marginAccountTradesServiceAPI) Symbol(symbol string) MarginAccountTradesServiceAPI {
+	return (*marginAccountTradesServiceAPI)((*MarginAccountTradesService)(a).Symbol(symbol))
+}
+
+func (a *marginAccountTradesServiceAPI) OrderID(orderID int64) MarginAccountTradesServiceAPI {
+	return (*marginAccountTradesServiceAPI)((*MarginAccountTradesService)(a).OrderID(orderID))
+}
+
+func (a *marginAccountTradesServiceAPI) StartTime(startTime int64) MarginAccountTradesServiceAPI {
+	return (*marginAccountTradesServiceAPI)((*MarginAccountTradesService)(a).StartTime(startTime))
+}
+
+func (a *marginAccountTradesServiceAPI) EndTime(endTime int64) MarginAccountTradesServiceAPI {
+	return (*marginAccountTradesServiceAPI)((*MarginAccountTradesService)(a).EndTime(endTime))
+}
+
+func (a *marginAccountTradesServiceAPI) FromID(fromID int64) MarginAccountTradesServiceAPI {
+	return (*marginAccountTradesServiceAPI)((*MarginAccountTradesService)(a).FromID(fromID))
+}
+
+func (a *marginAccountTradesServiceAPI) Limit(limit int) MarginAccountTradesServiceAPI {
+	return (*marginAccountTradesServiceAPI)((*MarginAccountTradesService)(a).Limit(limit))
+}
+
+func (a *marginAccountTradesServiceAPI) RecvWindow(recvWindow int64) MarginAccountTradesServiceAPI {
+	return (*marginAccountTradesServiceAPI)((*MarginAccountTradesService)(a).RecvWindow(recvWindow))
+}
+
+func (a *marginAccountTradesServiceAPI) Do(ctx context.Context) ([]*MarginTrade, error) {
+	return (*MarginAccountTradesService)(a).Do(ctx)
+}
+
+// MarginRepayDebtServiceAPI is the interface of MarginRepayDebtService
+type MarginRepayDebtServiceAPI interface {
+	Asset(asset string) MarginRepayDebtServiceAPI
+	Amount(amount string) MarginRepayDebtServiceAPI
+	SpecifyRepayAssets(assets string) MarginRepayDebtServiceAPI
+	RecvWindow(recvWindow int64) MarginRepayDebtServiceAPI
+	Do(ctx context.Context) (*MarginRepayDebtResponse, error)
+}
+
+type marginRepayDebtServiceAPI MarginRepayDebtService
+
+func (a *marginRepayDebtServiceAPI) Asset(asset string) MarginRepayDebtServiceAPI {
+	return (*marginRepayDebtServiceAPI)((*MarginRepayDebtService)(a).Asset(asset))
+}
+
+func (a *marginRepayDebtServiceAPI) Amount(amount string) MarginRepayDebtServiceAPI {
+	return (*marginRepayDebtServiceAPI)((*MarginRepayDebtService)(a).Amount(amount))
+}
+
+func (a *marginRepayDebtServiceAPI) SpecifyRepayAssets(assets string) MarginRepayDebtServiceAPI {
+	return (*marginRepayDebtServiceAPI)((*MarginRepayDebtService)(a).SpecifyRepayAssets(assets))
+}
+
+func (a *marginRepayDebtServiceAPI) RecvWindow(recvWindow int64) MarginRepayDebtServiceAPI {
+	return (*marginRepayDebtServiceAPI)((*MarginRepayDebtService)(a).RecvWindow(recvWindow))
+}
+
+func (a *marginRepayDebtServiceAPI) Do(ctx context.Context) (*MarginRepayDebtResponse, error) {
+	return (*MarginRepayDebtService)(a).Do(ctx)
+}
+
+// MarginAllOCOServiceAPI is the interface of MarginAllOCOService
+type MarginAllOCOServiceAPI interface {
+	FromID(fromID int64) MarginAllOCOServiceAPI
+	StartTime(startTime int64) MarginAllOCOServiceAPI
+	EndTime(endTime int64) MarginAllOCOServiceAPI
+	Limit(limit int) MarginAllOCOServiceAPI
+	RecvWindow(recvWindow int64) MarginAllOCOServiceAPI
+	Do(ctx context.Context) ([]*MarginOCOResponse, error)
+}
+
+type marginAllOCOServiceAPI MarginAllOCOService
+
+func (a *marginAllOCOServiceAPI) FromID(fromID int64) MarginAllOCOServiceAPI {
+	return (*marginAllOCOServiceAPI)((*MarginAllOCOService)(a).FromID(fromID))
+}
+
+func (a *marginAllOCOServiceAPI) StartTime(startTime int64) MarginAllOCOServiceAPI {
+	return (*marginAllOCOServiceAPI)((*MarginAllOCOService)(a).StartTime(startTime))
+}
+
+func (a *marginAllOCOServiceAPI) EndTime(endTime int64) MarginAllOCOServiceAPI {
+	return (*marginAllOCOServiceAPI)((*MarginAllOCOService)(a).EndTime(endTime))
+}
+
+func (a *marginAllOCOServiceAPI) Limit(limit int) MarginAllOCOServiceAPI {
+	return (*marginAllOCOServiceAPI)((*MarginAllOCOService)(a).Limit(limit))
+}
+
+func (a *marginAllOCOServiceAPI) RecvWindow(recvWindow int64) MarginAllOCOServiceAPI {
+	return (*marginAllOCOServiceAPI)((*MarginAllOCOService)(a).RecvWindow(recvWindow))
+}
+
+func (a *marginAllOCOServiceAPI) Do(ctx context.Context) ([]*MarginOCOResponse, error) {
+	return (*MarginAllOCOService)(a).Do(ctx)
+}
+
+// StartUserStreamServiceAPI is the interface of StartUserStreamService
+type StartUserStreamServiceAPI interface {
+	Do(ctx context.Context, opts ...RequestOption) (string, error)
+}
+
+type startUserStreamServiceAPI StartUserStreamService
+
+func (a *startUserStreamServiceAPI) Do(ctx context.Context, opts ...RequestOption) (string, error) {
+	return (*StartUserStreamService)(a).Do(ctx, opts...)
+}
+
+// KeepaliveUserStreamServiceAPI is the interface of KeepaliveUserStreamService
+type KeepaliveUserStreamServiceAPI interface {
+	ListenKey(listenKey string) KeepaliveUserStreamServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) error
+}
+
+type keepaliveUserStreamServiceAPI KeepaliveUserStreamService
+
+func (a *keepaliveUserStreamServiceAPI) ListenKey(listenKey string) KeepaliveUserStreamServiceAPI {
+	return (*keepaliveUserStreamServiceAPI)((*KeepaliveUserStreamService)(a).ListenKey(listenKey))
+}
+
+func (a *keepaliveUserStreamServiceAPI) Do(ctx context.Context, opts ...RequestOption) error {
+	return (*KeepaliveUserStreamService)(a).Do(ctx, opts...)
+}
+
+// CloseUserStreamServiceAPI is the interface of CloseUserStreamService
+type CloseUserStreamServiceAPI interface {
+	ListenKey(listenKey string) CloseUserStreamServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) error
+}
+
+type closeUserStreamServiceAPI CloseUserStreamService
+
+func (a *closeUserStreamServiceAPI) ListenKey(listenKey string) CloseUserStreamServiceAPI {
+	return (*closeUserStreamServiceAPI)((*CloseUserStreamService)(a).ListenKey(listenKey))
+}
+
+func (a *closeUserStreamServiceAPI) Do(ctx context.Context, opts ...RequestOption) error {
+	return (*CloseUserStreamService)(a).Do(ctx, opts...)
+}
@@ -6,6 +6,8 @@ import (
 	"time"
 
 	"github.com/bitly/go-simplejson"
+
+	commonws "github.com/adshao/go-binance/v2/common/websocket"
 )
 
 // Endpoints
@@ -37,8 +39,11 @@ func SetWsProxyUrl(url string) {
 	ProxyUrl = url
 }
 
-// getWsEndpoint return the base endpoint of the WS according the UseTestnet flag
-func getWsEndpoint() string {
+// getWsEndpoint return the base endpoint of the WS of opts, according the UseTestnet flag when they set none
+func getWsEndpoint(opts ...WsOptions) string {
+	if o := commonws.MergeOptions(opts...); o.BaseURL != "" {
+		return o.BaseURL
+	}
 	return BaseWsMainUrl
 }
 
@@ -563,9 +568,15 @@ func wsUserDataHandler(handler WsUserDataHandler) func(message []byte) {
 }
 
 // WsUserDataServe enhanced with automatic listen key renewal
-func WsUserDataServe(listenKey string, handler WsUserDataHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
-	endpoint := fmt.Sprintf("%s/ws/%s", getWsEndpoint(), listenKey)
-	cfg := newWsConfig(endpoint)
+func WsUserDataServe(listenKey string, handler WsUserDataHandler, errHandler ErrHandler, opts ...WsOptions) (doneC, stopC chan struct{}, err error) {
+	return WsUserDataServeEndpoint(getWsEndpoint(opts...), listenKey, handler, errHandler, opts...)
+}
+
+// WsUserDataServeEndpoint serve the user data stream of listenKey from the base endpoint
+// baseEndpoint, e.g. the one of Portfolio Margin Pro accounts
+func WsUserDataServeEndpoint(baseEndpoint, listenKey string, handler WsUserDataHandler, errHandler ErrHandler, opts ...WsOptions) (doneC, stopC chan struct{}, err error) {
+	endpoint := fmt.Sprintf("%s/ws/%s", baseEndpoint, listenKey)
+	cfg := newWsConfig(endpoint, opts...)
 	wsHandler := func(message []byte) {
 		var event struct {
 			EventType string `json:"e"`
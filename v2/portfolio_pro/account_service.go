@@ -0,0 +1,86 @@
+package portfolio_pro
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+)
+
+// GetAccountService get Portfolio Margin Pro account info
+type GetAccountService struct {
+	c *Client
+}
+
+// Do send request
+func (s *GetAccountService) Do(ctx context.Context, opts ...RequestOption) (*Account, error) {
+	r := &request{
+		method:   http.MethodGet,
+		endpoint: "/sapi/v1/portfolio/account",
+		secType:  secTypeSigned,
+	}
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res := new(Account)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// Account define Portfolio Margin Pro account info
+type Account struct {
+	UniMMR                string `json:"uniMMR"`
+	AccountEquity         string `json:"accountEquity"`
+	ActualEquity          string `json:"actualEquity"`
+	AccountMaintMargin    string `json:"accountMaintMargin"`
+	AccountInitialMargin  string `json:"accountInitialMargin"`
+	TotalAvailableBalance string `json:"totalAvailableBalance"`
+	AccountStatus         string `json:"accountStatus"`
+	AccountType           string `json:"accountType"`
+}
+
+// GetSpanAccountService get Portfolio Margin Pro SPAN account info
+type GetSpanAccountService struct {
+	c *Client
+}
+
+// Do send request
+func (s *GetSpanAccountService) Do(ctx context.Context, opts ...RequestOption) (*SpanAccount, error) {
+	r := &request{
+		method:   http.MethodGet,
+		endpoint: "/sapi/v2/portfolio/account",
+		secType:  secTypeSigned,
+	}
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res := new(SpanAccount)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// SpanAccount define Portfolio Margin Pro SPAN account info
+type SpanAccount struct {
+	UniMMR             string        `json:"uniMMR"`
+	AccountEquity      string        `json:"accountEquity"`
+	ActualEquity       string        `json:"actualEquity"`
+	AccountMaintMargin string        `json:"accountMaintMargin"`
+	RiskUnitMMList     []*RiskUnitMM `json:"riskUnitMMList"`
+	MarginMM           string        `json:"marginMM"`
+	OtherMM            string        `json:"otherMM"`
+	AccountStatus      string        `json:"accountStatus"`
+	AccountType        string        `json:"accountType"`
+}
+
+// RiskUnitMM define the maintenance margin of a risk unit
+type RiskUnitMM struct {
+	Asset          string `json:"asset"`
+	UniMaintainUsd string `json:"uniMaintainUsd"`
+}
@@ -0,0 +1,86 @@
+package portfolio_pro
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type accountServiceTestSuite struct {
+	baseTestSuite
+}
+
+func TestAccountService(t *testing.T) {
+	suite.Run(t, new(accountServiceTestSuite))
+}
+
+func (s *accountServiceTestSuite) TestGetAccount() {
+	data := []byte(`{
+		"uniMMR": "5167.92171923",
+		"accountEquity": "122607.35137903",
+		"actualEquity": "142607.35137903",
+		"accountMaintMargin": "23.72469206",
+		"accountInitialMargin": "47.44938412",
+		"totalAvailableBalance": "122559.90199491",
+		"accountStatus": "NORMAL",
+		"accountType": "PM_1"
+	}`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+
+	s.assertReq(func(r *request) {
+		s.r().Equal("/sapi/v1/portfolio/account", r.endpoint)
+		s.assertRequestEqual(newSignedRequest(), r)
+	})
+	res, err := s.client.NewGetAccountService().Do(newContext())
+	s.r().NoError(err)
+	s.r().Equal(&Account{
+		UniMMR:                "5167.92171923",
+		AccountEquity:         "122607.35137903",
+		ActualEquity:          "142607.35137903",
+		AccountMaintMargin:    "23.72469206",
+		AccountInitialMargin:  "47.44938412",
+		TotalAvailableBalance: "122559.90199491",
+		AccountStatus:         "NORMAL",
+		AccountType:           "PM_1",
+	}, res)
+}
+
+func (s *accountServiceTestSuite) TestGetSpanAccount() {
+	data := []byte(`{
+		"uniMMR": "5167.92171923",
+		"accountEquity": "122607.35137903",
+		"actualEquity": "142607.35137903",
+		"accountMaintMargin": "23.72469206",
+		"riskUnitMMList": [
+			{
+				"asset": "BTC",
+				"uniMaintainUsd": "23.72469206"
+			}
+		],
+		"marginMM": "0.00000000",
+		"otherMM": "0.00000000",
+		"accountStatus": "NORMAL",
+		"accountType": "PM_3"
+	}`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+
+	s.assertReq(func(r *request) {
+		s.r().Equal("/sapi/v2/portfolio/account", r.endpoint)
+		s.assertRequestEqual(newSignedRequest(), r)
+	})
+	res, err := s.client.NewGetSpanAccountService().Do(newContext())
+	s.r().NoError(err)
+	s.r().Equal(&SpanAccount{
+		UniMMR:             "5167.92171923",
+		AccountEquity:      "122607.35137903",
+		ActualEquity:       "142607.35137903",
+		AccountMaintMargin: "23.72469206",
+		RiskUnitMMList:     []*RiskUnitMM{{Asset: "BTC", UniMaintainUsd: "23.72469206"}},
+		MarginMM:           "0.00000000",
+		OtherMM:            "0.00000000",
+		AccountStatus:      "NORMAL",
+		AccountType:        "PM_3",
+	}, res)
+}
@@ -0,0 +1,60 @@
+package portfolio_pro
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+)
+
+// GetBalanceService get Portfolio Margin Pro account balance
+type GetBalanceService struct {
+	c     *Client
+	asset *string
+}
+
+// Asset set asset
+func (s *GetBalanceService) Asset(asset string) *GetBalanceService {
+	s.asset = &asset
+	return s
+}
+
+// Do send request
+func (s *GetBalanceService) Do(ctx context.Context, opts ...RequestOption) ([]*Balance, error) {
+	r := &request{
+		method:   http.MethodGet,
+		endpoint: "/sapi/v1/portfolio/balance",
+		secType:  secTypeSigned,
+	}
+	if s.asset != nil {
+		r.setParam("asset", *s.asset)
+	}
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res := make([]*Balance, 0)
+	err = json.Unmarshal(data, &res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// Balance define the balance of an asset
+type Balance struct {
+	Asset               string `json:"asset"`
+	TotalWalletBalance  string `json:"totalWalletBalance"`
+	CrossMarginAsset    string `json:"crossMarginAsset"`
+	CrossMarginBorrowed string `json:"crossMarginBorrowed"`
+	CrossMarginFree     string `json:"crossMarginFree"`
+	CrossMarginInterest string `json:"crossMarginInterest"`
+	CrossMarginLocked   string `json:"crossMarginLocked"`
+	UMWalletBalance     string `json:"umWalletBalance"`
+	UMUnrealizedPNL     string `json:"umUnrealizedPNL"`
+	CMWalletBalance     string `json:"cmWalletBalance"`
+	CMUnrealizedPNL     string `json:"cmUnrealizedPNL"`
+	UpdateTime          int64  `json:"updateTime"`
+	NegativeBalance     string `json:"negativeBalance"`
+	OptionWalletBalance string `json:"optionWalletBalance"`
+	OptionEquity        string `json:"optionEquity"`
+}
@@ -0,0 +1,64 @@
+package portfolio_pro
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type balanceServiceTestSuite struct {
+	baseTestSuite
+}
+
+func TestBalanceService(t *testing.T) {
+	suite.Run(t, new(balanceServiceTestSuite))
+}
+
+func (s *balanceServiceTestSuite) TestGetBalance() {
+	data := []byte(`[
+		{
+			"asset": "BTC",
+			"totalWalletBalance": "100",
+			"crossMarginAsset": "100",
+			"crossMarginBorrowed": "0",
+			"crossMarginFree": "100",
+			"crossMarginInterest": "0",
+			"crossMarginLocked": "0",
+			"umWalletBalance": "0",
+			"umUnrealizedPNL": "0",
+			"cmWalletBalance": "0",
+			"cmUnrealizedPNL": "0",
+			"updateTime": 0,
+			"negativeBalance": "0",
+			"optionWalletBalance": "0",
+			"optionEquity": "0"
+		}
+	]`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+
+	s.assertReq(func(r *request) {
+		s.r().Equal("/sapi/v1/portfolio/balance", r.endpoint)
+		s.assertRequestEqual(newSignedRequest().setParam("asset", "BTC"), r)
+	})
+	res, err := s.client.NewGetBalanceService().Asset("BTC").Do(newContext())
+	s.r().NoError(err)
+	s.r().Equal([]*Balance{
+		{
+			Asset:               "BTC",
+			TotalWalletBalance:  "100",
+			CrossMarginAsset:    "100",
+			CrossMarginBorrowed: "0",
+			CrossMarginFree:     "100",
+			CrossMarginInterest: "0",
+			CrossMarginLocked:   "0",
+			UMWalletBalance:     "0",
+			UMUnrealizedPNL:     "0",
+			CMWalletBalance:     "0",
+			CMUnrealizedPNL:     "0",
+			NegativeBalance:     "0",
+			OptionWalletBalance: "0",
+			OptionEquity:        "0",
+		},
+	}, res)
+}
@@ -19,6 +19,8 @@ import (
 
 const (
 	BaseApiMainUrl = "https://api.binance.com"
+	// BaseUserStreamApiUrl is the endpoint of the listen key services of the user data stream
+	BaseUserStreamApiUrl = "https://papi.binance.com"
 )
 
 // Global enums
@@ -95,6 +97,17 @@ type Client struct {
 	TimeOffset int64
 	do         doFunc
 
+	// UserStreamBaseURL overrides BaseUserStreamApiUrl when set
+	UserStreamBaseURL string
+
+	// Middlewares wrap every REST API call, see Use
+	Middlewares   []common.Middleware
+	logMiddleware common.Middleware
+
+	// Signer signs requests instead of SecretKey when set, see SetSigner
+	Signer  common.Signer
+	signers common.SignerCache
+
 	UsedWeight common.UsedWeight
 	OrderCount common.OrderCount
 }
@@ -115,7 +128,11 @@ func (c *Client) parseRequest(r *request, opts ...RequestOption) (err error) {
 		return err
 	}
 
-	fullURL := fmt.Sprintf("%s%s", c.BaseURL, r.endpoint)
+	baseURL := c.BaseURL
+	if r.baseURL != "" {
+		baseURL = r.baseURL
+	}
+	fullURL := fmt.Sprintf("%s%s", baseURL, r.endpoint)
 	if r.recvWindow > 0 {
 		r.setParam(recvWindowKey, r.recvWindow)
 	}
@@ -140,22 +157,17 @@ func (c *Client) parseRequest(r *request, opts ...RequestOption) (err error) {
 	if r.secType == secTypeAPIKey || r.secType == secTypeSigned {
 		header.Set("X-MBX-APIKEY", c.APIKey)
 	}
-	kt := c.KeyType
-	if kt == "" {
-		kt = common.KeyTypeHmac
-	}
-	sf, err := common.SignFunc(kt)
-	if err != nil {
-		return err
-	}
 	if r.secType == secTypeSigned {
-		raw := fmt.Sprintf("%s%s", queryString, bodyString)
-		sign, err := sf(c.SecretKey, raw)
+		signer, err := c.signer()
+		if err != nil {
+			return err
+		}
+		sign, err := signer.Sign([]byte(queryString + bodyString))
 		if err != nil {
 			return err
 		}
 		v := url.Values{}
-		v.Set(signatureKey, *sign)
+		v.Set(signatureKey, sign)
 		if queryString == "" {
 			queryString = v.Encode()
 		} else {
@@ -165,7 +177,7 @@ func (c *Client) parseRequest(r *request, opts ...RequestOption) (err error) {
 	if queryString != "" {
 		fullURL = fmt.Sprintf("%s?%s", fullURL, queryString)
 	}
-	c.debug("full url: %s, body: %s\n", fullURL, bodyString)
+	c.debug("full url: %s, body: %s\n", common.RedactString(fullURL), common.RedactString(bodyString))
 
 	r.fullURL = fullURL
 	r.header = header
@@ -184,21 +196,37 @@ func (c *Client) callAPI(ctx context.Context, r *request, opts ...RequestOption)
 	}
 	req = req.WithContext(ctx)
 	req.Header = r.header
-	c.debug("request: %#v\n", req)
+	send := c.send
+	if c.logMiddleware != nil {
+		send = c.logMiddleware(send)
+	}
+	res, err := common.Chain(send, c.Middlewares...)(req)
+	if err != nil {
+		if res != nil {
+			return nil, err
+		}
+		return []byte{}, err
+	}
+	return res.Body, nil
+}
+
+// send performs a built request, it is the innermost handler of the middleware chain
+func (c *Client) send(req *http.Request) (resp *common.Response, err error) {
+	c.debug("request: %s %s, header: %v\n", req.Method, common.RedactString(req.URL.String()), common.RedactHeader(req.Header))
 	f := c.do
 	if f == nil {
 		f = c.HTTPClient.Do
 	}
 	res, err := f(req)
 	if err != nil {
-		return []byte{}, err
+		return nil, err
 	}
 	c.UsedWeight.UpdateByHeader(res.Header)
 	c.OrderCount.UpdateByHeader(res.Header)
 
-	data, err = io.ReadAll(res.Body)
+	data, err := io.ReadAll(res.Body)
 	if err != nil {
-		return []byte{}, err
+		return nil, err
 	}
 	defer func() {
 		cerr := res.Body.Close()
@@ -208,10 +236,15 @@ func (c *Client) callAPI(ctx context.Context, r *request, opts ...RequestOption)
 			err = cerr
 		}
 	}()
-	c.debug("response: %#v\n", res)
+	c.debug("response header: %v\n", res.Header)
 	c.debug("response body: %s\n", string(data))
 	c.debug("response status code: %d\n", res.StatusCode)
 
+	resp = &common.Response{
+		StatusCode: res.StatusCode,
+		Header:     res.Header,
+		Body:       data,
+	}
 	if res.StatusCode >= http.StatusBadRequest {
 		apiErr := new(common.APIError)
 		e := json.Unmarshal(data, apiErr)
@@ -221,9 +254,32 @@ func (c *Client) callAPI(ctx context.Context, r *request, opts ...RequestOption)
 		if !apiErr.IsValid() {
 			apiErr.Response = data
 		}
-		return nil, apiErr
+		return resp, apiErr
 	}
-	return data, nil
+	return resp, nil
+}
+
+// SetSigner set the signer of requests, e.g. an external KMS or HSM signer,
+// SecretKey is not used to sign requests anymore
+func (c *Client) SetSigner(signer common.Signer) *Client {
+	c.Signer = signer
+	c.KeyType = signer.KeyType()
+	return c
+}
+
+// signer returns Signer when set, else the signer of SecretKey, parsed once
+func (c *Client) signer() (common.Signer, error) {
+	if c.Signer != nil {
+		return c.Signer, nil
+	}
+	return c.signers.Get(c.KeyType, c.SecretKey)
+}
+
+// Use appends middlewares wrapping every REST API call of the client, the first
+// middleware being the outermost. It must not be called concurrently with requests.
+func (c *Client) Use(middlewares ...common.Middleware) *Client {
+	c.Middlewares = append(c.Middlewares, middlewares...)
+	return c
 }
 
 func (c *Client) NewMintBFUSDService() *MintBFUSDService {
@@ -233,3 +289,81 @@ func (c *Client) NewMintBFUSDService() *MintBFUSDService {
 func (c *Client) NewRedeemBFUSDService() *RedeemBFUSDService {
 	return &RedeemBFUSDService{c: c}
 }
+
+// NewGetAccountService init get account info service
+// GET /sapi/v1/portfolio/account
+func (c *Client) NewGetAccountService() *GetAccountService {
+	return &GetAccountService{c: c}
+}
+
+// NewGetSpanAccountService init get SPAN account info service
+// GET /sapi/v2/portfolio/account
+func (c *Client) NewGetSpanAccountService() *GetSpanAccountService {
+	return &GetSpanAccountService{c: c}
+}
+
+// NewGetBalanceService init get account balance service
+// GET /sapi/v1/portfolio/balance
+func (c *Client) NewGetBalanceService() *GetBalanceService {
+	return &GetBalanceService{c: c}
+}
+
+// NewGetCollateralRateService init get collateral rate service
+// GET /sapi/v1/portfolio/collateralRate
+func (c *Client) NewGetCollateralRateService() *GetCollateralRateService {
+	return &GetCollateralRateService{c: c}
+}
+
+// NewGetTieredCollateralRateService init get tiered collateral rate service
+// GET /sapi/v2/portfolio/collateralRate
+func (c *Client) NewGetTieredCollateralRateService() *GetTieredCollateralRateService {
+	return &GetTieredCollateralRateService{c: c}
+}
+
+// NewEarnAssetTransferService init earn asset transfer service
+// POST /sapi/v1/portfolio/earn-asset-transfer
+func (c *Client) NewEarnAssetTransferService() *EarnAssetTransferService {
+	return &EarnAssetTransferService{c: c}
+}
+
+// NewGetEarnAssetBalanceService init get transferable earn asset balance service
+// GET /sapi/v1/portfolio/earn-asset-balance
+func (c *Client) NewGetEarnAssetBalanceService() *GetEarnAssetBalanceService {
+	return &GetEarnAssetBalanceService{c: c}
+}
+
+// NewBNBTransferService init BNB transfer service
+// POST /sapi/v1/portfolio/bnb-transfer
+func (c *Client) NewBNBTransferService() *BNBTransferService {
+	return &BNBTransferService{c: c}
+}
+
+// NewFundAutoCollectionService init fund auto-collection service
+// POST /sapi/v1/portfolio/auto-collection
+func (c *Client) NewFundAutoCollectionService() *FundAutoCollectionService {
+	return &FundAutoCollectionService{c: c}
+}
+
+// NewFundCollectionByAssetService init fund collection by asset service
+// POST /sapi/v1/portfolio/asset-collection
+func (c *Client) NewFundCollectionByAssetService() *FundCollectionByAssetService {
+	return &FundCollectionByAssetService{c: c}
+}
+
+// NewStartUserStreamService init starting user stream service
+// POST /papi/v1/listenKey
+func (c *Client) NewStartUserStreamService() *StartUserStreamService {
+	return &StartUserStreamService{c: c}
+}
+
+// NewKeepaliveUserStreamService init keep alive user stream service
+// PUT /papi/v1/listenKey
+func (c *Client) NewKeepaliveUserStreamService() *KeepaliveUserStreamService {
+	return &KeepaliveUserStreamService{c: c}
+}
+
+// NewCloseUserStreamService init closing user stream service
+// DELETE /papi/v1/listenKey
+func (c *Client) NewCloseUserStreamService() *CloseUserStreamService {
+	return &CloseUserStreamService{c: c}
+}
@@ -0,0 +1,75 @@
+package portfolio_pro
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+)
+
+// GetCollateralRateService get Portfolio Margin collateral rates
+type GetCollateralRateService struct {
+	c *Client
+}
+
+// Do send request
+func (s *GetCollateralRateService) Do(ctx context.Context, opts ...RequestOption) ([]*CollateralRate, error) {
+	r := &request{
+		method:   http.MethodGet,
+		endpoint: "/sapi/v1/portfolio/collateralRate",
+		secType:  secTypeAPIKey,
+	}
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res := make([]*CollateralRate, 0)
+	err = json.Unmarshal(data, &res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// CollateralRate define the collateral rate of an asset
+type CollateralRate struct {
+	Asset          string `json:"asset"`
+	CollateralRate string `json:"collateralRate"`
+}
+
+// GetTieredCollateralRateService get Portfolio Margin Pro tiered collateral rates
+type GetTieredCollateralRateService struct {
+	c *Client
+}
+
+// Do send request
+func (s *GetTieredCollateralRateService) Do(ctx context.Context, opts ...RequestOption) ([]*TieredCollateralRate, error) {
+	r := &request{
+		method:   http.MethodGet,
+		endpoint: "/sapi/v2/portfolio/collateralRate",
+		secType:  secTypeSigned,
+	}
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res := make([]*TieredCollateralRate, 0)
+	err = json.Unmarshal(data, &res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// TieredCollateralRate define the collateral rate tiers of an asset
+type TieredCollateralRate struct {
+	Asset          string            `json:"asset"`
+	CollateralInfo []*CollateralTier `json:"collateralInfo"`
+}
+
+// CollateralTier define the collateral rate of an asset quantity tier
+type CollateralTier struct {
+	TierFloor      string `json:"tierFloor"`
+	TierCap        string `json:"tierCap"`
+	CollateralRate string `json:"collateralRate"`
+	Cum            string `json:"cum"`
+}
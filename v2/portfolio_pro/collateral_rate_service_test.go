@@ -0,0 +1,75 @@
+package portfolio_pro
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type collateralRateServiceTestSuite struct {
+	baseTestSuite
+}
+
+func TestCollateralRateService(t *testing.T) {
+	suite.Run(t, new(collateralRateServiceTestSuite))
+}
+
+func (s *collateralRateServiceTestSuite) TestGetCollateralRate() {
+	data := []byte(`[
+		{"asset": "USDC", "collateralRate": "1.0000"},
+		{"asset": "BUSD", "collateralRate": "1.0000"}
+	]`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+
+	s.assertReq(func(r *request) {
+		s.r().Equal("/sapi/v1/portfolio/collateralRate", r.endpoint)
+		s.assertRequestEqual(newRequest(), r)
+	})
+	res, err := s.client.NewGetCollateralRateService().Do(newContext())
+	s.r().NoError(err)
+	s.r().Equal([]*CollateralRate{
+		{Asset: "USDC", CollateralRate: "1.0000"},
+		{Asset: "BUSD", CollateralRate: "1.0000"},
+	}, res)
+}
+
+func (s *collateralRateServiceTestSuite) TestGetTieredCollateralRate() {
+	data := []byte(`[
+		{
+			"asset": "BNB",
+			"collateralInfo": [
+				{
+					"tierFloor": "0.0000",
+					"tierCap": "1000.0000",
+					"collateralRate": "1.0000",
+					"cum": "0.0000"
+				},
+				{
+					"tierFloor": "1000.0000",
+					"tierCap": "2000.0000",
+					"collateralRate": "0.9000",
+					"cum": "0.0000"
+				}
+			]
+		}
+	]`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+
+	s.assertReq(func(r *request) {
+		s.r().Equal("/sapi/v2/portfolio/collateralRate", r.endpoint)
+		s.assertRequestEqual(newSignedRequest(), r)
+	})
+	res, err := s.client.NewGetTieredCollateralRateService().Do(newContext())
+	s.r().NoError(err)
+	s.r().Equal([]*TieredCollateralRate{
+		{
+			Asset: "BNB",
+			CollateralInfo: []*CollateralTier{
+				{TierFloor: "0.0000", TierCap: "1000.0000", CollateralRate: "1.0000", Cum: "0.0000"},
+				{TierFloor: "1000.0000", TierCap: "2000.0000", CollateralRate: "0.9000", Cum: "0.0000"},
+			},
+		},
+	}, res)
+}
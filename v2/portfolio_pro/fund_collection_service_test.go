@@ -0,0 +1,43 @@
+package portfolio_pro
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type fundCollectionServiceTestSuite struct {
+	baseTestSuite
+}
+
+func TestFundCollectionService(t *testing.T) {
+	suite.Run(t, new(fundCollectionServiceTestSuite))
+}
+
+func (s *fundCollectionServiceTestSuite) TestFundAutoCollection() {
+	data := []byte(`{"msg": "success"}`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+
+	s.assertReq(func(r *request) {
+		s.r().Equal("/sapi/v1/portfolio/auto-collection", r.endpoint)
+		s.assertRequestEqual(newSignedRequest(), r)
+	})
+	res, err := s.client.NewFundAutoCollectionService().Do(newContext())
+	s.r().NoError(err)
+	s.r().Equal("success", res.Msg)
+}
+
+func (s *fundCollectionServiceTestSuite) TestFundCollectionByAsset() {
+	data := []byte(`{"msg": "success"}`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+
+	s.assertReq(func(r *request) {
+		s.r().Equal("/sapi/v1/portfolio/asset-collection", r.endpoint)
+		s.assertRequestEqual(newSignedRequest().setParam("asset", "USDT"), r)
+	})
+	res, err := s.client.NewFundCollectionByAssetService().Asset("USDT").Do(newContext())
+	s.r().NoError(err)
+	s.r().Equal("success", res.Msg)
+}
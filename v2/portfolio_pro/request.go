@@ -19,6 +19,7 @@ type params map[string]interface{}
 
 // request define an API request
 type request struct {
+	baseURL    string // overrides the base URL of the client when set
 	method     string
 	endpoint   string
 	query      url.Values
@@ -0,0 +1,166 @@
+package portfolio_pro
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+)
+
+// EarnAssetTransferType define the direction of an earn asset transfer
+type EarnAssetTransferType string
+
+// Constants for earn asset transfer type
+const (
+	EarnAssetTransferTypeEarnToFuture EarnAssetTransferType = "EARN_TO_FUTURE"
+	EarnAssetTransferTypeFutureToEarn EarnAssetTransferType = "FUTURE_TO_EARN"
+)
+
+// SuccessResponse define a response only carrying a message
+type SuccessResponse struct {
+	Msg string `json:"msg"`
+}
+
+// EarnAssetTransferService transfer LDUSDT or RWUSD between earn and the Portfolio Margin Pro account
+type EarnAssetTransferService struct {
+	c            *Client
+	asset        string
+	transferType EarnAssetTransferType
+	amount       string
+}
+
+// Asset set asset, e.g. LDUSDT
+func (s *EarnAssetTransferService) Asset(asset string) *EarnAssetTransferService {
+	s.asset = asset
+	return s
+}
+
+// TransferType set transferType
+func (s *EarnAssetTransferService) TransferType(transferType EarnAssetTransferType) *EarnAssetTransferService {
+	s.transferType = transferType
+	return s
+}
+
+// Amount set amount
+func (s *EarnAssetTransferService) Amount(amount string) *EarnAssetTransferService {
+	s.amount = amount
+	return s
+}
+
+// Do send request
+func (s *EarnAssetTransferService) Do(ctx context.Context, opts ...RequestOption) (*SuccessResponse, error) {
+	r := &request{
+		method:   http.MethodPost,
+		endpoint: "/sapi/v1/portfolio/earn-asset-transfer",
+		secType:  secTypeSigned,
+	}
+	r.setParam("asset", s.asset)
+	r.setParam("transferType", s.transferType)
+	r.setParam("amount", s.amount)
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res := new(SuccessResponse)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// GetEarnAssetBalanceService get the transferable balance of an earn asset
+type GetEarnAssetBalanceService struct {
+	c            *Client
+	asset        string
+	transferType EarnAssetTransferType
+}
+
+// Asset set asset, e.g. LDUSDT
+func (s *GetEarnAssetBalanceService) Asset(asset string) *GetEarnAssetBalanceService {
+	s.asset = asset
+	return s
+}
+
+// TransferType set transferType
+func (s *GetEarnAssetBalanceService) TransferType(transferType EarnAssetTransferType) *GetEarnAssetBalanceService {
+	s.transferType = transferType
+	return s
+}
+
+// Do send request
+func (s *GetEarnAssetBalanceService) Do(ctx context.Context, opts ...RequestOption) (*EarnAssetBalance, error) {
+	r := &request{
+		method:   http.MethodGet,
+		endpoint: "/sapi/v1/portfolio/earn-asset-balance",
+		secType:  secTypeSigned,
+	}
+	r.setParam("asset", s.asset)
+	r.setParam("transferType", s.transferType)
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res := new(EarnAssetBalance)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// EarnAssetBalance define the transferable balance of an earn asset
+type EarnAssetBalance struct {
+	Asset  string `json:"asset"`
+	Amount string `json:"amount"`
+}
+
+// BNBTransferService transfer BNB in and out of UM
+type BNBTransferService struct {
+	c            *Client
+	amount       string
+	transferSide string
+}
+
+// Amount set amount
+func (s *BNBTransferService) Amount(amount string) *BNBTransferService {
+	s.amount = amount
+	return s
+}
+
+// TransferSide set transfer side, TransferSideToUM or TransferSideFromUM
+func (s *BNBTransferService) TransferSide(transferSide string) *BNBTransferService {
+	s.transferSide = transferSide
+	return s
+}
+
+// Do send request
+func (s *BNBTransferService) Do(ctx context.Context, opts ...RequestOption) (*BNBTransferResponse, error) {
+	r := &request{
+		method:   http.MethodPost,
+		endpoint: "/sapi/v1/portfolio/bnb-transfer",
+		secType:  secTypeSigned,
+	}
+	r.setParam("amount", s.amount)
+	r.setParam("transferSide", s.transferSide)
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res := new(BNBTransferResponse)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// BNBTransferResponse define bnb transfer response
+type BNBTransferResponse struct {
+	TranID int64 `json:"tranId"` // transaction id
+}
+
+// Constants for transfer side
+const (
+	TransferSideToUM   = "TO_UM"
+	TransferSideFromUM = "FROM_UM"
+)
@@ -0,0 +1,75 @@
+package portfolio_pro
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type transferServiceTestSuite struct {
+	baseTestSuite
+}
+
+func TestTransferService(t *testing.T) {
+	suite.Run(t, new(transferServiceTestSuite))
+}
+
+func (s *transferServiceTestSuite) TestEarnAssetTransfer() {
+	data := []byte(`{"msg": "success"}`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+
+	s.assertReq(func(r *request) {
+		s.r().Equal(http.MethodPost, r.method)
+		s.r().Equal("/sapi/v1/portfolio/earn-asset-transfer", r.endpoint)
+		e := newSignedRequest().setParams(params{
+			"asset":        "LDUSDT",
+			"transferType": "EARN_TO_FUTURE",
+			"amount":       "100",
+		})
+		s.assertRequestEqual(e, r)
+	})
+	res, err := s.client.NewEarnAssetTransferService().Asset("LDUSDT").
+		TransferType(EarnAssetTransferTypeEarnToFuture).Amount("100").Do(newContext())
+	s.r().NoError(err)
+	s.r().Equal("success", res.Msg)
+}
+
+func (s *transferServiceTestSuite) TestGetEarnAssetBalance() {
+	data := []byte(`{"asset": "LDUSDT", "amount": "0.55"}`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+
+	s.assertReq(func(r *request) {
+		s.r().Equal(http.MethodGet, r.method)
+		s.r().Equal("/sapi/v1/portfolio/earn-asset-balance", r.endpoint)
+		e := newSignedRequest().setParams(params{
+			"asset":        "LDUSDT",
+			"transferType": "FUTURE_TO_EARN",
+		})
+		s.assertRequestEqual(e, r)
+	})
+	res, err := s.client.NewGetEarnAssetBalanceService().Asset("LDUSDT").
+		TransferType(EarnAssetTransferTypeFutureToEarn).Do(newContext())
+	s.r().NoError(err)
+	s.r().Equal(&EarnAssetBalance{Asset: "LDUSDT", Amount: "0.55"}, res)
+}
+
+func (s *transferServiceTestSuite) TestBNBTransfer() {
+	data := []byte(`{"tranId": 100000001}`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+
+	s.assertReq(func(r *request) {
+		s.r().Equal("/sapi/v1/portfolio/bnb-transfer", r.endpoint)
+		e := newSignedRequest().setParams(params{
+			"amount":       "1.5",
+			"transferSide": TransferSideToUM,
+		})
+		s.assertRequestEqual(e, r)
+	})
+	res, err := s.client.NewBNBTransferService().Amount("1.5").TransferSide(TransferSideToUM).Do(newContext())
+	s.r().NoError(err)
+	s.r().Equal(int64(100000001), res.TranID)
+}
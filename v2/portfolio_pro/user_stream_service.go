@@ -0,0 +1,92 @@
+package portfolio_pro
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+)
+
+// userStreamBaseURL return the base URL of the listen key services
+func (c *Client) userStreamBaseURL() string {
+	if c.UserStreamBaseURL != "" {
+		return c.UserStreamBaseURL
+	}
+	return BaseUserStreamApiUrl
+}
+
+// StartUserStreamService create listen key for user stream service
+type StartUserStreamService struct {
+	c *Client
+}
+
+// Do send request
+func (s *StartUserStreamService) Do(ctx context.Context, opts ...RequestOption) (listenKey string, err error) {
+	r := &request{
+		baseURL:  s.c.userStreamBaseURL(),
+		method:   http.MethodPost,
+		endpoint: "/papi/v1/listenKey",
+		secType:  secTypeAPIKey,
+	}
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return "", err
+	}
+	res := new(struct {
+		ListenKey string `json:"listenKey"`
+	})
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return "", err
+	}
+	return res.ListenKey, nil
+}
+
+// KeepaliveUserStreamService update listen key
+type KeepaliveUserStreamService struct {
+	c         *Client
+	listenKey string
+}
+
+// ListenKey set listen key
+func (s *KeepaliveUserStreamService) ListenKey(listenKey string) *KeepaliveUserStreamService {
+	s.listenKey = listenKey
+	return s
+}
+
+// Do send request
+func (s *KeepaliveUserStreamService) Do(ctx context.Context, opts ...RequestOption) (err error) {
+	r := &request{
+		baseURL:  s.c.userStreamBaseURL(),
+		method:   http.MethodPut,
+		endpoint: "/papi/v1/listenKey",
+		secType:  secTypeAPIKey,
+	}
+	r.setFormParam("listenKey", s.listenKey)
+	_, err = s.c.callAPI(ctx, r, opts...)
+	return err
+}
+
+// CloseUserStreamService delete listen key
+type CloseUserStreamService struct {
+	c         *Client
+	listenKey string
+}
+
+// ListenKey set listen key
+func (s *CloseUserStreamService) ListenKey(listenKey string) *CloseUserStreamService {
+	s.listenKey = listenKey
+	return s
+}
+
+// Do send request
+func (s *CloseUserStreamService) Do(ctx context.Context, opts ...RequestOption) (err error) {
+	r := &request{
+		baseURL:  s.c.userStreamBaseURL(),
+		method:   http.MethodDelete,
+		endpoint: "/papi/v1/listenKey",
+		secType:  secTypeAPIKey,
+	}
+	r.setFormParam("listenKey", s.listenKey)
+	_, err = s.c.callAPI(ctx, r, opts...)
+	return err
+}
@@ -0,0 +1,62 @@
+package portfolio_pro
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type userStreamServiceTestSuite struct {
+	baseTestSuite
+}
+
+func TestUserStreamService(t *testing.T) {
+	suite.Run(t, new(userStreamServiceTestSuite))
+}
+
+func (s *userStreamServiceTestSuite) TestStartUserStream() {
+	data := []byte(`{"listenKey": "pqia91ma19a5s61cv6a81va65sdf19v8a65a1a5s61cv6a81va65sdf19v8a65a1"}`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+
+	s.assertReq(func(r *request) {
+		s.r().Equal(BaseUserStreamApiUrl, r.baseURL)
+		s.r().Equal(http.MethodPost, r.method)
+		s.r().Equal("/papi/v1/listenKey", r.endpoint)
+		s.assertRequestEqual(newRequest(), r)
+	})
+	listenKey, err := s.client.NewStartUserStreamService().Do(newContext())
+	s.r().NoError(err)
+	s.r().Equal("pqia91ma19a5s61cv6a81va65sdf19v8a65a1a5s61cv6a81va65sdf19v8a65a1", listenKey)
+}
+
+func (s *userStreamServiceTestSuite) TestKeepaliveUserStream() {
+	s.client.UserStreamBaseURL = "https://example.com"
+	s.mockDo([]byte(`{}`), nil)
+	defer s.assertDo()
+
+	s.assertReq(func(r *request) {
+		s.r().Equal("https://example.com", r.baseURL)
+		s.r().Equal(http.MethodPut, r.method)
+		e := newRequest()
+		e.setFormParam("listenKey", "dummyKey")
+		s.assertRequestEqual(e, r)
+	})
+	err := s.client.NewKeepaliveUserStreamService().ListenKey("dummyKey").Do(newContext())
+	s.r().NoError(err)
+}
+
+func (s *userStreamServiceTestSuite) TestCloseUserStream() {
+	s.mockDo([]byte(`{}`), nil)
+	defer s.assertDo()
+
+	s.assertReq(func(r *request) {
+		s.r().Equal(http.MethodDelete, r.method)
+		e := newRequest()
+		e.setFormParam("listenKey", "dummyKey")
+		s.assertRequestEqual(e, r)
+	})
+	err := s.client.NewCloseUserStreamService().ListenKey("dummyKey").Do(newContext())
+	s.r().NoError(err)
+}
@@ -0,0 +1,16 @@
+package portfolio_pro
+
+import (
+	"github.com/adshao/go-binance/v2/portfolio"
+)
+
+// Endpoints
+var (
+	BaseWsMainUrl = "wss://fstream.binance.com/pm-classic"
+)
+
+// WsUserDataServe serve the user data stream of a Portfolio Margin Pro account, listenKey
+// is created by StartUserStreamService. The events are the ones of Portfolio Margin accounts.
+func WsUserDataServe(listenKey string, handler portfolio.WsUserDataHandler, errHandler portfolio.ErrHandler) (doneC, stopC chan struct{}, err error) {
+	return portfolio.WsUserDataServeEndpoint(BaseWsMainUrl, listenKey, handler, errHandler)
+}
@@ -0,0 +1,107 @@
+package risk
+
+import (
+	"context"
+
+	"github.com/adshao/go-binance/v2"
+	"github.com/adshao/go-binance/v2/delivery"
+	"github.com/adshao/go-binance/v2/futures"
+	"github.com/adshao/go-binance/v2/portfolio"
+)
+
+// SpotCanceler returns a canceler cancelling the open orders of every symbol of a spot client
+func SpotCanceler(c *binance.Client) Canceler {
+	return func(ctx context.Context) error {
+		orders, err := c.NewListOpenOrdersService().Do(ctx)
+		if err != nil {
+			return err
+		}
+		for _, symbol := range uniqueSymbols(len(orders), func(i int) string { return orders[i].Symbol }) {
+			if _, err := c.NewCancelOpenOrdersService().Symbol(symbol).Do(ctx); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+}
+
+// FuturesCanceler returns a canceler cancelling the open orders of every symbol of a USD-M futures client
+func FuturesCanceler(c *futures.Client) Canceler {
+	return func(ctx context.Context) error {
+		orders, err := c.NewListOpenOrdersService().Do(ctx)
+		if err != nil {
+			return err
+		}
+		for _, symbol := range uniqueSymbols(len(orders), func(i int) string { return orders[i].Symbol }) {
+			if err := c.NewCancelAllOpenOrdersService().Symbol(symbol).Do(ctx); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+}
+
+// DeliveryCanceler returns a canceler cancelling the open orders of every symbol of a COIN-M futures client
+func DeliveryCanceler(c *delivery.Client) Canceler {
+	return func(ctx context.Context) error {
+		orders, err := c.NewListOpenOrdersService().Do(ctx)
+		if err != nil {
+			return err
+		}
+		for _, symbol := range uniqueSymbols(len(orders), func(i int) string { return orders[i].Symbol }) {
+			if err := c.NewCancelAllOpenOrdersService().Symbol(symbol).Do(ctx); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+}
+
+// PortfolioCanceler returns a canceler cancelling the UM, CM and margin open orders of
+// every symbol of a portfolio margin client
+func PortfolioCanceler(c *portfolio.Client) Canceler {
+	return func(ctx context.Context) error {
+		um, err := c.NewUMOpenOrdersService().Do(ctx)
+		if err != nil {
+			return err
+		}
+		for _, symbol := range uniqueSymbols(len(um), func(i int) string { return um[i].Symbol }) {
+			if _, err := c.NewUMCancelAllOrdersService().Symbol(symbol).Do(ctx); err != nil {
+				return err
+			}
+		}
+		cm, err := c.NewCMOpenOrdersService().Do(ctx)
+		if err != nil {
+			return err
+		}
+		for _, symbol := range uniqueSymbols(len(cm), func(i int) string { return cm[i].Symbol }) {
+			if _, err := c.NewCMCancelAllOrdersService().Symbol(symbol).Do(ctx); err != nil {
+				return err
+			}
+		}
+		margin, err := c.NewGetMarginOpenOrdersService().Do(ctx)
+		if err != nil {
+			return err
+		}
+		for _, symbol := range uniqueSymbols(len(margin), func(i int) string { return margin[i].Symbol }) {
+			if _, err := c.NewMarginCancelAllOrdersService().Symbol(symbol).Do(ctx); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+}
+
+func uniqueSymbols(n int, symbol func(int) string) []string {
+	seen := make(map[string]struct{}, n)
+	res := make([]string, 0, n)
+	for i := 0; i < n; i++ {
+		s := symbol(i)
+		if _, ok := seen[s]; ok {
+			continue
+		}
+		seen[s] = struct{}{}
+		res = append(res, s)
+	}
+	return res
+}
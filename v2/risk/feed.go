@@ -0,0 +1,172 @@
+package risk
+
+import (
+	"github.com/shopspring/decimal"
+
+	"github.com/adshao/go-binance/v2"
+	"github.com/adshao/go-binance/v2/delivery"
+	"github.com/adshao/go-binance/v2/futures"
+	"github.com/adshao/go-binance/v2/portfolio"
+)
+
+// HandleSpotUserData feeds a spot or margin user data event, tracking open orders and
+// positions from execution reports. Spot realized PnL is not reported by the exchange,
+// use AddRealizedPnL to account for it.
+func (e *Engine) HandleSpotUserData(event *binance.WsUserDataEvent) {
+	if event.Event != binance.UserDataEventTypeExecutionReport {
+		return
+	}
+	o := event.OrderUpdate
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.updateOrder(ProductSpot, o.Symbol, originalClientOrderID(o.ClientOrderId, o.OrigCustomOrderId), o.Status)
+	if o.ExecutionType == "TRADE" {
+		e.addFill(ProductSpot, o.Symbol, o.Side, o.LatestVolume)
+	}
+}
+
+// HandleFuturesUserData feeds a USD-M futures user data event, tracking open orders,
+// positions and realized PnL
+func (e *Engine) HandleFuturesUserData(event *futures.WsUserDataEvent) {
+	switch event.Event {
+	case futures.UserDataEventTypeOrderTradeUpdate:
+		o := event.OrderTradeUpdate
+		e.handleFuturesOrder(ProductUSDM, o.Symbol, o.ClientOrderID, string(o.Status), o.RealizedPnL, "")
+	case futures.UserDataEventTypeAccountUpdate:
+		for _, p := range event.AccountUpdate.Positions {
+			_ = e.SetPosition(ProductUSDM, p.Symbol, string(p.Side), p.Amount)
+		}
+	}
+}
+
+// HandleDeliveryUserData feeds a COIN-M futures user data event, tracking open orders,
+// positions and realized PnL. Realized PnL is reported in the margin asset and converted
+// to quote with the reference price of the symbol.
+func (e *Engine) HandleDeliveryUserData(event *delivery.WsUserDataEvent) {
+	switch event.Event {
+	case delivery.UserDataEventTypeOrderTradeUpdate:
+		o := event.OrderTradeUpdate
+		e.handleFuturesOrder(ProductCOINM, o.Symbol, o.ClientOrderID, string(o.Status), o.RealizedPnL, o.Symbol)
+	case delivery.UserDataEventTypeAccountUpdate:
+		for _, p := range event.AccountUpdate.Positions {
+			_ = e.SetPosition(ProductCOINM, p.Symbol, string(p.Side), p.Amount)
+		}
+	}
+}
+
+// handleFuturesOrder tracks a futures order update. When priceSymbol is set, the realized
+// PnL is converted with its reference price.
+func (e *Engine) handleFuturesOrder(product Product, symbol, clientOrderID, status, realizedPnL, priceSymbol string) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.updateOrder(product, symbol, clientOrderID, status)
+	pnl, err := decimal.NewFromString(realizedPnL)
+	if err != nil || pnl.IsZero() {
+		return
+	}
+	if priceSymbol != "" {
+		price := e.state(product, priceSymbol).price
+		if price == nil {
+			return
+		}
+		pnl = pnl.Mul(*price)
+	}
+	e.rollDay()
+	e.realizedPnL = e.realizedPnL.Add(pnl)
+}
+
+// originalClientOrderID returns the client order id of the order the update refers to,
+// cancellations carry a new client order id and the original one apart
+func originalClientOrderID(clientOrderID, origClientOrderID string) string {
+	if origClientOrderID != "" {
+		return origClientOrderID
+	}
+	return clientOrderID
+}
+
+// HandleSpotAggTrade feeds the last price of a spot symbol
+func (e *Engine) HandleSpotAggTrade(event *binance.WsAggTradeEvent) {
+	_ = e.SetReferencePrice(ProductSpot, event.Symbol, event.Price)
+}
+
+// HandleFuturesMarkPrice feeds the mark price of a USD-M futures symbol
+func (e *Engine) HandleFuturesMarkPrice(event *futures.WsMarkPriceEvent) {
+	_ = e.SetReferencePrice(ProductUSDM, event.Symbol, event.MarkPrice)
+}
+
+// HandleDeliveryMarkPrice feeds the mark price of a COIN-M futures symbol
+func (e *Engine) HandleDeliveryMarkPrice(event *delivery.WsMarkPriceEvent) {
+	_ = e.SetReferencePrice(ProductCOINM, event.Symbol, event.MarkPrice)
+}
+
+// PortfolioUserDataHandler define a portfolio.WsUserDataHandler feeding the engine.
+// Events not used by the engine are handled by the embedded handler only.
+type PortfolioUserDataHandler struct {
+	portfolio.WsUserDataHandler
+	e *Engine
+}
+
+// NewPortfolioUserDataHandler init a portfolio margin user data handler feeding the engine
+// and forwarding every event to next, which may be nil
+func (e *Engine) NewPortfolioUserDataHandler(next portfolio.WsUserDataHandler) *PortfolioUserDataHandler {
+	if next == nil {
+		next = nopPortfolioHandler{}
+	}
+	return &PortfolioUserDataHandler{WsUserDataHandler: next, e: e}
+}
+
+func portfolioProduct(businessUnit string) Product {
+	if businessUnit == "CM" {
+		return ProductCOINM
+	}
+	return ProductUSDM
+}
+
+// HandleFuturesOrderUpdate implements portfolio.WsUserDataHandler
+func (h *PortfolioUserDataHandler) HandleFuturesOrderUpdate(event *portfolio.WsFuturesOrderUpdate) {
+	product := portfolioProduct(event.BusinessUnit)
+	o := event.Order
+	priceSymbol := ""
+	if product == ProductCOINM {
+		priceSymbol = o.Symbol
+	}
+	h.e.handleFuturesOrder(product, o.Symbol, o.ClientOrderID, string(o.OrderStatus), o.RealizedProfit, priceSymbol)
+	h.WsUserDataHandler.HandleFuturesOrderUpdate(event)
+}
+
+// HandleFuturesAccountUpdate implements portfolio.WsUserDataHandler
+func (h *PortfolioUserDataHandler) HandleFuturesAccountUpdate(event *portfolio.WsFuturesAccountUpdate) {
+	product := portfolioProduct(event.BusinessUnit)
+	for _, p := range event.AccountData.Positions {
+		_ = h.e.SetPosition(product, p.Symbol, string(p.PositionSide), p.PositionAmount)
+	}
+	h.WsUserDataHandler.HandleFuturesAccountUpdate(event)
+}
+
+// HandleMarginOrderUpdate implements portfolio.WsUserDataHandler
+func (h *PortfolioUserDataHandler) HandleMarginOrderUpdate(event *portfolio.WsMarginOrderUpdate) {
+	h.e.mu.Lock()
+	h.e.updateOrder(ProductSpot, event.Symbol, originalClientOrderID(event.ClientOrderID, event.OrigClientOrderID), event.OrderStatus)
+	if event.ExecutionType == "TRADE" {
+		h.e.addFill(ProductSpot, event.Symbol, event.Side, event.LastExecutedQty)
+	}
+	h.e.mu.Unlock()
+	h.WsUserDataHandler.HandleMarginOrderUpdate(event)
+}
+
+// nopPortfolioHandler ignores every event
+type nopPortfolioHandler struct{}
+
+func (nopPortfolioHandler) HandleListenKeyExpired(*portfolio.WsListenKeyExpired)       {}
+func (nopPortfolioHandler) HandleMarginBalanceUpdate(*portfolio.WsMarginBalanceUpdate) {}
+func (nopPortfolioHandler) HandleRiskLevelChange(*portfolio.WsRiskLevelChange)         {}
+func (nopPortfolioHandler) HandleFuturesAccountConfigUpdate(*portfolio.WsFuturesAccountConfigUpdate) {
+}
+func (nopPortfolioHandler) HandleFuturesAccountUpdate(*portfolio.WsFuturesAccountUpdate) {}
+func (nopPortfolioHandler) HandleFuturesOrderUpdate(*portfolio.WsFuturesOrderUpdate)     {}
+func (nopPortfolioHandler) HandleMarginOrderUpdate(*portfolio.WsMarginOrderUpdate)       {}
+func (nopPortfolioHandler) HandleLiabilityUpdate(*portfolio.WsLiabilityUpdate)           {}
+func (nopPortfolioHandler) HandleMarginAccountUpdate(*portfolio.WsMarginAccountUpdate)   {}
+func (nopPortfolioHandler) HandleOpenOrderLossUpdate(*portfolio.WsOpenOrderLossUpdate)   {}
+func (nopPortfolioHandler) HandleConditionalOrderTradeUpdate(*portfolio.WsConditionalOrderTradeUpdate) {
+}
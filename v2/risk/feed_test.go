@@ -0,0 +1,118 @@
+package risk
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/adshao/go-binance/v2"
+	"github.com/adshao/go-binance/v2/delivery"
+	"github.com/adshao/go-binance/v2/futures"
+	"github.com/adshao/go-binance/v2/portfolio"
+)
+
+type feedTestSuite struct {
+	suite.Suite
+	e *Engine
+}
+
+func TestFeed(t *testing.T) {
+	suite.Run(t, new(feedTestSuite))
+}
+
+func (s *feedTestSuite) SetupTest() {
+	s.e = NewEngine()
+}
+
+func (s *feedTestSuite) TestSpotUserData() {
+	r := s.Require()
+	event := &binance.WsUserDataEvent{Event: binance.UserDataEventTypeExecutionReport}
+	event.OrderUpdate.Symbol = "BTCUSDT"
+	event.OrderUpdate.ClientOrderId = "abc"
+	event.OrderUpdate.Side = "BUY"
+	event.OrderUpdate.ExecutionType = "NEW"
+	event.OrderUpdate.Status = "NEW"
+	s.e.HandleSpotUserData(event)
+	r.Equal(1, s.e.OpenOrders(ProductSpot, "BTCUSDT"))
+
+	event.OrderUpdate.ExecutionType = "TRADE"
+	event.OrderUpdate.Status = "FILLED"
+	event.OrderUpdate.LatestVolume = "0.5"
+	s.e.HandleSpotUserData(event)
+	r.Equal(0, s.e.OpenOrders(ProductSpot, "BTCUSDT"))
+	r.Equal("0.5", s.e.Position(ProductSpot, "BTCUSDT"))
+}
+
+func (s *feedTestSuite) TestFuturesUserData() {
+	r := s.Require()
+	event := &futures.WsUserDataEvent{Event: futures.UserDataEventTypeOrderTradeUpdate}
+	event.OrderTradeUpdate.Symbol = "BTCUSDT"
+	event.OrderTradeUpdate.ClientOrderID = "abc"
+	event.OrderTradeUpdate.Status = futures.OrderStatusTypePartiallyFilled
+	event.OrderTradeUpdate.RealizedPnL = "-12.5"
+	s.e.HandleFuturesUserData(event)
+	r.Equal(1, s.e.OpenOrders(ProductUSDM, "BTCUSDT"))
+	r.Equal("-12.5", s.e.RealizedPnL())
+
+	account := &futures.WsUserDataEvent{Event: futures.UserDataEventTypeAccountUpdate}
+	account.AccountUpdate.Positions = []futures.WsPosition{{Symbol: "BTCUSDT", Side: futures.PositionSideTypeBoth, Amount: "-0.3"}}
+	s.e.HandleFuturesUserData(account)
+	r.Equal("-0.3", s.e.Position(ProductUSDM, "BTCUSDT"))
+
+	s.e.HandleFuturesMarkPrice(&futures.WsMarkPriceEvent{Symbol: "BTCUSDT", MarkPrice: "30000"})
+	r.NoError(s.e.SetLimits(ProductUSDM, "BTCUSDT", Limits{MaxNotional: "1000"}))
+	s.Error(s.e.Check(&Order{Product: ProductUSDM, Symbol: "BTCUSDT", Side: "BUY", Quantity: "0.1"}))
+}
+
+func (s *feedTestSuite) TestDeliveryUserData() {
+	r := s.Require()
+	event := &delivery.WsUserDataEvent{Event: delivery.UserDataEventTypeOrderTradeUpdate}
+	event.OrderTradeUpdate.Symbol = "BTCUSD_PERP"
+	event.OrderTradeUpdate.Status = delivery.OrderStatusTypeFilled
+	event.OrderTradeUpdate.RealizedPnL = "-0.001"
+
+	// the loss is ignored until the mark price is known
+	s.e.HandleDeliveryUserData(event)
+	r.Equal("0", s.e.RealizedPnL())
+
+	s.e.HandleDeliveryMarkPrice(&delivery.WsMarkPriceEvent{Symbol: "BTCUSD_PERP", MarkPrice: "30000"})
+	s.e.HandleDeliveryUserData(event)
+	r.Equal("-30", s.e.RealizedPnL())
+}
+
+type recordingPortfolioHandler struct {
+	nopPortfolioHandler
+	orders int
+}
+
+func (h *recordingPortfolioHandler) HandleFuturesOrderUpdate(*portfolio.WsFuturesOrderUpdate) {
+	h.orders++
+}
+
+func (s *feedTestSuite) TestPortfolioUserData() {
+	r := s.Require()
+	next := &recordingPortfolioHandler{}
+	h := s.e.NewPortfolioUserDataHandler(next)
+
+	event := &portfolio.WsFuturesOrderUpdate{BusinessUnit: "UM"}
+	event.Order.Symbol = "BTCUSDT"
+	event.Order.ClientOrderID = "abc"
+	event.Order.OrderStatus = "NEW"
+	event.Order.RealizedProfit = "-5"
+	h.HandleFuturesOrderUpdate(event)
+	r.Equal(1, next.orders)
+	r.Equal(1, s.e.OpenOrders(ProductUSDM, "BTCUSDT"))
+	r.Equal("-5", s.e.RealizedPnL())
+
+	account := &portfolio.WsFuturesAccountUpdate{BusinessUnit: "CM"}
+	account.AccountData.Positions = []portfolio.WsFuturesPosition{{Symbol: "BTCUSD_PERP", PositionAmount: "3"}}
+	h.HandleFuturesAccountUpdate(account)
+	r.Equal("3", s.e.Position(ProductCOINM, "BTCUSD_PERP"))
+
+	h.HandleMarginOrderUpdate(&portfolio.WsMarginOrderUpdate{Symbol: "BNBUSDT", ClientOrderID: "x", OrderStatus: "NEW"})
+	r.Equal(1, s.e.OpenOrders(ProductSpot, "BNBUSDT"))
+
+	// the handler satisfies portfolio.WsUserDataHandler
+	var _ portfolio.WsUserDataHandler = h
+	h.HandleListenKeyExpired(&portfolio.WsListenKeyExpired{})
+}
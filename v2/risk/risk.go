@@ -0,0 +1,507 @@
+// Package risk implements pre-trade risk checks shared by the spot, USD-M futures,
+// COIN-M futures and portfolio margin clients.
+//
+// An Engine holds per-symbol limits together with the live state needed to enforce
+// them: open orders, positions, reference (mark or last) prices and the realized PnL of
+// the current UTC day. The state is fed by the user data and market streams of each
+// package. Order requests are checked by installing the engine transport on a client:
+//
+//	client.HTTPClient = engine.HTTPClient(client.HTTPClient)
+//
+// Rejected orders never reach the exchange, the client call returns an error wrapping
+// a *risk.Error instead. The kill switch blocks every new order and cancels all open
+// orders through the registered cancelers.
+package risk
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// Product define the product line an order belongs to
+type Product string
+
+// RuleType define the risk rule that rejected an order
+type RuleType string
+
+// Global enums
+const (
+	ProductSpot  Product = "SPOT"
+	ProductUSDM  Product = "USDM"
+	ProductCOINM Product = "COINM"
+
+	RuleTypeKillSwitch    RuleType = "KILL_SWITCH"
+	RuleTypeDailyLoss     RuleType = "DAILY_LOSS"
+	RuleTypeMaxNotional   RuleType = "MAX_NOTIONAL"
+	RuleTypeMaxPosition   RuleType = "MAX_POSITION"
+	RuleTypeMaxOpenOrders RuleType = "MAX_OPEN_ORDERS"
+	RuleTypePriceBand     RuleType = "PRICE_BAND"
+	RuleTypeNoPrice       RuleType = "NO_REFERENCE_PRICE"
+	RuleTypeInvalidOrder  RuleType = "INVALID_ORDER"
+
+	sideBuy  = "BUY"
+	sideSell = "SELL"
+)
+
+// Error define the rejection of an order by a risk rule
+type Error struct {
+	Rule    RuleType
+	Product Product
+	Symbol  string
+	Message string
+}
+
+// Error return rule and message
+func (e Error) Error() string {
+	return fmt.Sprintf("<RiskError> rule=%s, product=%s, symbol=%s, msg=%s", e.Rule, e.Product, e.Symbol, e.Message)
+}
+
+// IsRiskError check if e is a risk rejection, possibly wrapped by the HTTP client
+func IsRiskError(e error) bool {
+	var riskErr *Error
+	return errors.As(e, &riskErr)
+}
+
+// Limits define the limits applied to a symbol. Empty values are not enforced.
+type Limits struct {
+	// MaxNotional is the maximum notional of a single order, in quote asset
+	MaxNotional string
+	// MaxPosition is the maximum absolute net position after the order, in base asset or contracts
+	MaxPosition string
+	// MaxOpenOrders is the maximum number of open orders
+	MaxOpenOrders int
+	// PriceBand is the maximum relative distance between the order price and the reference price, e.g. "0.05"
+	PriceBand string
+	// ContractSize is the quote notional of one contract for COIN-M symbols, e.g. "100" for BTCUSD_PERP.
+	// When set, the order notional is quantity * contract size.
+	ContractSize string
+}
+
+// limits define parsed Limits
+type limits struct {
+	maxNotional   *decimal.Decimal
+	maxPosition   *decimal.Decimal
+	maxOpenOrders int
+	priceBand     *decimal.Decimal
+	contractSize  *decimal.Decimal
+}
+
+func (l Limits) parse() (*limits, error) {
+	res := &limits{maxOpenOrders: l.MaxOpenOrders}
+	for _, f := range []struct {
+		v string
+		p **decimal.Decimal
+	}{
+		{l.MaxNotional, &res.maxNotional},
+		{l.MaxPosition, &res.maxPosition},
+		{l.PriceBand, &res.priceBand},
+		{l.ContractSize, &res.contractSize},
+	} {
+		if f.v == "" {
+			continue
+		}
+		d, err := decimal.NewFromString(f.v)
+		if err != nil {
+			return nil, err
+		}
+		*f.p = &d
+	}
+	return res, nil
+}
+
+// Order define an order submitted to pre-trade checks
+type Order struct {
+	Product       Product
+	Symbol        string
+	Side          string
+	Type          string
+	Quantity      string
+	QuoteQuantity string
+	Price         string
+	ReduceOnly    bool
+	ClosePosition bool
+	// Amend is set for order modifications, which do not add an open order
+	Amend bool
+}
+
+// Canceler cancels all open orders of one client, used by the kill switch
+type Canceler func(ctx context.Context) error
+
+// symbolKey identifies a symbol of a product
+type symbolKey struct {
+	product Product
+	symbol  string
+}
+
+// symbolState define the live state of a symbol
+type symbolState struct {
+	open      map[string]struct{}
+	pending   int
+	positions map[string]decimal.Decimal // by position side
+	price     *decimal.Decimal
+}
+
+func (s *symbolState) position() decimal.Decimal {
+	p := decimal.Zero
+	for _, v := range s.positions {
+		p = p.Add(v)
+	}
+	return p
+}
+
+// Engine enforces pre-trade limits. All methods are safe for concurrent use.
+type Engine struct {
+	mu             sync.Mutex
+	defaults       map[Product]*limits
+	limits         map[symbolKey]*limits
+	states         map[symbolKey]*symbolState
+	dailyLossLimit *decimal.Decimal
+	day            string
+	realizedPnL    decimal.Decimal
+	killed         bool
+	cancelers      []Canceler
+	now            func() time.Time
+}
+
+// NewEngine init a risk engine without any limits
+func NewEngine() *Engine {
+	return &Engine{
+		defaults: make(map[Product]*limits),
+		limits:   make(map[symbolKey]*limits),
+		states:   make(map[symbolKey]*symbolState),
+		now:      time.Now,
+	}
+}
+
+// SetDefaultLimits set the limits of every symbol of product without specific limits
+func (e *Engine) SetDefaultLimits(product Product, l Limits) error {
+	parsed, err := l.parse()
+	if err != nil {
+		return err
+	}
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.defaults[product] = parsed
+	return nil
+}
+
+// SetLimits set the limits of a symbol
+func (e *Engine) SetLimits(product Product, symbol string, l Limits) error {
+	parsed, err := l.parse()
+	if err != nil {
+		return err
+	}
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.limits[symbolKey{product, strings.ToUpper(symbol)}] = parsed
+	return nil
+}
+
+// SetDailyLossLimit set the maximum realized loss of the current UTC day, e.g. "1000".
+// Once reached, only reduce-only orders are accepted until the next day.
+func (e *Engine) SetDailyLossLimit(limit string) error {
+	d, err := decimal.NewFromString(limit)
+	if err != nil {
+		return err
+	}
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	d = d.Abs()
+	e.dailyLossLimit = &d
+	return nil
+}
+
+// state returns the state of a symbol, creating it if needed. Must be called with the lock held.
+func (e *Engine) state(product Product, symbol string) *symbolState {
+	k := symbolKey{product, strings.ToUpper(symbol)}
+	s, ok := e.states[k]
+	if !ok {
+		s = &symbolState{
+			open:      make(map[string]struct{}),
+			positions: make(map[string]decimal.Decimal),
+		}
+		e.states[k] = s
+	}
+	return s
+}
+
+// limitsFor returns the limits of a symbol. Must be called with the lock held.
+func (e *Engine) limitsFor(product Product, symbol string) *limits {
+	if l, ok := e.limits[symbolKey{product, strings.ToUpper(symbol)}]; ok {
+		return l
+	}
+	return e.defaults[product]
+}
+
+// rollDay resets the realized PnL when the UTC day changes. Must be called with the lock held.
+func (e *Engine) rollDay() {
+	day := e.now().UTC().Format("2006-01-02")
+	if day != e.day {
+		e.day = day
+		e.realizedPnL = decimal.Zero
+	}
+}
+
+// Check runs the pre-trade checks against an order, returning a *Error on rejection
+func (e *Engine) Check(o *Order) error {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	return e.check(o)
+}
+
+func (e *Engine) check(o *Order) error {
+	reject := func(rule RuleType, format string, v ...interface{}) error {
+		return &Error{Rule: rule, Product: o.Product, Symbol: o.Symbol, Message: fmt.Sprintf(format, v...)}
+	}
+	if e.killed {
+		return reject(RuleTypeKillSwitch, "kill switch is active")
+	}
+	side := strings.ToUpper(o.Side)
+	if side != sideBuy && side != sideSell {
+		return reject(RuleTypeInvalidOrder, "invalid side %q", o.Side)
+	}
+	qty, err := parseOptional(o.Quantity)
+	if err != nil {
+		return reject(RuleTypeInvalidOrder, "invalid quantity %q", o.Quantity)
+	}
+	quoteQty, err := parseOptional(o.QuoteQuantity)
+	if err != nil {
+		return reject(RuleTypeInvalidOrder, "invalid quote quantity %q", o.QuoteQuantity)
+	}
+	price, err := parseOptional(o.Price)
+	if err != nil {
+		return reject(RuleTypeInvalidOrder, "invalid price %q", o.Price)
+	}
+	reducing := o.ReduceOnly || o.ClosePosition
+
+	e.rollDay()
+	if e.dailyLossLimit != nil && !reducing && e.realizedPnL.Neg().GreaterThanOrEqual(*e.dailyLossLimit) {
+		return reject(RuleTypeDailyLoss, "daily realized loss %s reached limit %s", e.realizedPnL.Neg(), e.dailyLossLimit)
+	}
+
+	l := e.limitsFor(o.Product, o.Symbol)
+	if l == nil {
+		return nil
+	}
+	s := e.state(o.Product, o.Symbol)
+
+	if open := len(s.open) + s.pending; l.maxOpenOrders > 0 && !o.Amend && open >= l.maxOpenOrders {
+		return reject(RuleTypeMaxOpenOrders, "%d open orders, limit %d", open, l.maxOpenOrders)
+	}
+
+	// the price band is checked against the order price, market orders have none
+	if l.priceBand != nil && price.IsPositive() {
+		if s.price == nil {
+			return reject(RuleTypeNoPrice, "no reference price to check the price band")
+		}
+		deviation := price.Sub(*s.price).Abs().Div(*s.price)
+		if deviation.GreaterThan(*l.priceBand) {
+			return reject(RuleTypePriceBand, "price %s deviates %s from reference %s, band %s",
+				price, deviation.StringFixed(4), s.price, l.priceBand)
+		}
+	}
+
+	if l.maxNotional != nil {
+		var notional decimal.Decimal
+		switch {
+		case l.contractSize != nil:
+			notional = qty.Mul(*l.contractSize)
+		case quoteQty.IsPositive():
+			notional = quoteQty
+		case price.IsPositive():
+			notional = qty.Mul(price)
+		case s.price != nil:
+			notional = qty.Mul(*s.price)
+		default:
+			return reject(RuleTypeNoPrice, "no price to compute the notional of a market order")
+		}
+		if notional.GreaterThan(*l.maxNotional) {
+			return reject(RuleTypeMaxNotional, "notional %s exceeds limit %s", notional, l.maxNotional)
+		}
+	}
+
+	if l.maxPosition != nil && !o.ClosePosition {
+		signed := qty
+		if !qty.IsPositive() && quoteQty.IsPositive() && s.price != nil {
+			signed = quoteQty.Div(*s.price)
+		}
+		if side == sideSell {
+			signed = signed.Neg()
+		}
+		current := s.position()
+		next := current.Add(signed)
+		if next.Abs().GreaterThan(*l.maxPosition) && next.Abs().GreaterThan(current.Abs()) {
+			return reject(RuleTypeMaxPosition, "position would be %s, limit %s", next, l.maxPosition)
+		}
+	}
+	return nil
+}
+
+func parseOptional(v string) (decimal.Decimal, error) {
+	if v == "" {
+		return decimal.Zero, nil
+	}
+	return decimal.NewFromString(v)
+}
+
+// SetReferencePrice set the mark or last price used by the price band and market order notional checks
+func (e *Engine) SetReferencePrice(product Product, symbol, price string) error {
+	p, err := decimal.NewFromString(price)
+	if err != nil {
+		return err
+	}
+	if !p.IsPositive() {
+		return nil
+	}
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.state(product, symbol).price = &p
+	return nil
+}
+
+// SetPosition set the net position of a symbol for a position side ("BOTH", "LONG" or "SHORT").
+// Short positions are negative.
+func (e *Engine) SetPosition(product Product, symbol, positionSide, amount string) error {
+	a, err := decimal.NewFromString(amount)
+	if err != nil {
+		return err
+	}
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.state(product, symbol).positions[positionSideKey(positionSide)] = a
+	return nil
+}
+
+// Position returns the net position of a symbol
+func (e *Engine) Position(product Product, symbol string) string {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	return e.state(product, symbol).position().String()
+}
+
+// addFill adds a signed fill to the position of a symbol. Must be called with the lock held.
+func (e *Engine) addFill(product Product, symbol, side, qty string) {
+	q, err := decimal.NewFromString(qty)
+	if err != nil || q.IsZero() {
+		return
+	}
+	if strings.ToUpper(side) == sideSell {
+		q = q.Neg()
+	}
+	s := e.state(product, symbol)
+	k := positionSideKey("")
+	s.positions[k] = s.positions[k].Add(q)
+}
+
+func positionSideKey(positionSide string) string {
+	if positionSide == "" {
+		return "BOTH"
+	}
+	return strings.ToUpper(positionSide)
+}
+
+// SetOpenOrders replace the open orders of a symbol, e.g. after loading them through the REST API
+func (e *Engine) SetOpenOrders(product Product, symbol string, clientOrderIDs []string) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	s := e.state(product, symbol)
+	s.open = make(map[string]struct{}, len(clientOrderIDs))
+	for _, id := range clientOrderIDs {
+		s.open[id] = struct{}{}
+	}
+}
+
+// OpenOrders returns the number of open orders of a symbol
+func (e *Engine) OpenOrders(product Product, symbol string) int {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	return len(e.state(product, symbol).open)
+}
+
+// updateOrder tracks an order status. Must be called with the lock held.
+func (e *Engine) updateOrder(product Product, symbol, clientOrderID, status string) {
+	if clientOrderID == "" {
+		return
+	}
+	s := e.state(product, symbol)
+	switch status {
+	case "NEW", "PARTIALLY_FILLED":
+		s.open[clientOrderID] = struct{}{}
+	default:
+		delete(s.open, clientOrderID)
+	}
+}
+
+// AddRealizedPnL add realized PnL in quote asset to the current UTC day, losses are negative
+func (e *Engine) AddRealizedPnL(pnl string) error {
+	p, err := decimal.NewFromString(pnl)
+	if err != nil {
+		return err
+	}
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.rollDay()
+	e.realizedPnL = e.realizedPnL.Add(p)
+	return nil
+}
+
+// RealizedPnL returns the realized PnL of the current UTC day
+func (e *Engine) RealizedPnL() string {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.rollDay()
+	return e.realizedPnL.String()
+}
+
+// RegisterCanceler add a canceler run by the kill switch
+func (e *Engine) RegisterCanceler(c Canceler) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.cancelers = append(e.cancelers, c)
+}
+
+// Kill activates the kill switch: new orders are rejected and all registered cancelers
+// run concurrently. The switch stays active until Resume is called.
+func (e *Engine) Kill(ctx context.Context) error {
+	e.mu.Lock()
+	e.killed = true
+	cancelers := append([]Canceler(nil), e.cancelers...)
+	e.mu.Unlock()
+
+	errC := make(chan error, len(cancelers))
+	for _, c := range cancelers {
+		go func(c Canceler) {
+			errC <- c(ctx)
+		}(c)
+	}
+	var msgs []string
+	for range cancelers {
+		if err := <-errC; err != nil {
+			msgs = append(msgs, err.Error())
+		}
+	}
+	if len(msgs) > 0 {
+		return fmt.Errorf("risk: kill switch cancel failed: %s", strings.Join(msgs, "; "))
+	}
+	return nil
+}
+
+// Resume deactivates the kill switch
+func (e *Engine) Resume() {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.killed = false
+}
+
+// Killed reports whether the kill switch is active
+func (e *Engine) Killed() bool {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	return e.killed
+}
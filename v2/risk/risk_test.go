@@ -0,0 +1,177 @@
+package risk
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type engineTestSuite struct {
+	suite.Suite
+	e   *Engine
+	now time.Time
+}
+
+func TestEngine(t *testing.T) {
+	suite.Run(t, new(engineTestSuite))
+}
+
+func (s *engineTestSuite) SetupTest() {
+	s.e = NewEngine()
+	s.now = time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	s.e.now = func() time.Time { return s.now }
+}
+
+func (s *engineTestSuite) assertRule(err error, rule RuleType) {
+	r := s.Require()
+	var riskErr *Error
+	r.True(errors.As(err, &riskErr), "expected risk error, got %v", err)
+	r.Equal(rule, riskErr.Rule)
+}
+
+func (s *engineTestSuite) TestNoLimits() {
+	err := s.e.Check(&Order{Product: ProductSpot, Symbol: "BTCUSDT", Side: "BUY", Quantity: "1000"})
+	s.Require().NoError(err)
+}
+
+func (s *engineTestSuite) TestInvalidOrder() {
+	err := s.e.Check(&Order{Product: ProductSpot, Symbol: "BTCUSDT", Side: "HOLD"})
+	s.assertRule(err, RuleTypeInvalidOrder)
+	err = s.e.Check(&Order{Product: ProductSpot, Symbol: "BTCUSDT", Side: "BUY", Quantity: "abc"})
+	s.assertRule(err, RuleTypeInvalidOrder)
+}
+
+func (s *engineTestSuite) TestMaxNotional() {
+	r := s.Require()
+	r.NoError(s.e.SetLimits(ProductSpot, "btcusdt", Limits{MaxNotional: "1000"}))
+
+	r.NoError(s.e.Check(&Order{Product: ProductSpot, Symbol: "BTCUSDT", Side: "BUY", Quantity: "0.1", Price: "10000"}))
+	s.assertRule(s.e.Check(&Order{Product: ProductSpot, Symbol: "BTCUSDT", Side: "BUY", Quantity: "0.2", Price: "10000"}), RuleTypeMaxNotional)
+	s.assertRule(s.e.Check(&Order{Product: ProductSpot, Symbol: "BTCUSDT", Side: "BUY", QuoteQuantity: "1001"}), RuleTypeMaxNotional)
+
+	// market orders need a reference price
+	s.assertRule(s.e.Check(&Order{Product: ProductSpot, Symbol: "BTCUSDT", Side: "BUY", Type: "MARKET", Quantity: "0.1"}), RuleTypeNoPrice)
+	r.NoError(s.e.SetReferencePrice(ProductSpot, "BTCUSDT", "20000"))
+	s.assertRule(s.e.Check(&Order{Product: ProductSpot, Symbol: "BTCUSDT", Side: "BUY", Type: "MARKET", Quantity: "0.1"}), RuleTypeMaxNotional)
+	r.NoError(s.e.Check(&Order{Product: ProductSpot, Symbol: "BTCUSDT", Side: "BUY", Type: "MARKET", Quantity: "0.05"}))
+
+	// other symbols are not limited
+	r.NoError(s.e.Check(&Order{Product: ProductSpot, Symbol: "ETHUSDT", Side: "BUY", Quantity: "100", Price: "10000"}))
+}
+
+func (s *engineTestSuite) TestContractSize() {
+	r := s.Require()
+	r.NoError(s.e.SetLimits(ProductCOINM, "BTCUSD_PERP", Limits{MaxNotional: "1000", ContractSize: "100"}))
+	r.NoError(s.e.Check(&Order{Product: ProductCOINM, Symbol: "BTCUSD_PERP", Side: "SELL", Quantity: "10", Price: "30000"}))
+	s.assertRule(s.e.Check(&Order{Product: ProductCOINM, Symbol: "BTCUSD_PERP", Side: "SELL", Quantity: "11"}), RuleTypeMaxNotional)
+}
+
+func (s *engineTestSuite) TestDefaultLimits() {
+	r := s.Require()
+	r.NoError(s.e.SetDefaultLimits(ProductUSDM, Limits{MaxPosition: "1"}))
+	r.NoError(s.e.SetLimits(ProductUSDM, "BTCUSDT", Limits{MaxPosition: "2"}))
+	s.assertRule(s.e.Check(&Order{Product: ProductUSDM, Symbol: "ETHUSDT", Side: "BUY", Quantity: "1.5"}), RuleTypeMaxPosition)
+	r.NoError(s.e.Check(&Order{Product: ProductUSDM, Symbol: "BTCUSDT", Side: "BUY", Quantity: "1.5"}))
+	r.Error(s.e.SetDefaultLimits(ProductUSDM, Limits{MaxPosition: "x"}))
+}
+
+func (s *engineTestSuite) TestMaxPosition() {
+	r := s.Require()
+	r.NoError(s.e.SetLimits(ProductUSDM, "BTCUSDT", Limits{MaxPosition: "1"}))
+	r.NoError(s.e.SetPosition(ProductUSDM, "BTCUSDT", "", "0.8"))
+
+	s.assertRule(s.e.Check(&Order{Product: ProductUSDM, Symbol: "BTCUSDT", Side: "BUY", Quantity: "0.3"}), RuleTypeMaxPosition)
+	r.NoError(s.e.Check(&Order{Product: ProductUSDM, Symbol: "BTCUSDT", Side: "BUY", Quantity: "0.2"}))
+	r.NoError(s.e.Check(&Order{Product: ProductUSDM, Symbol: "BTCUSDT", Side: "SELL", Quantity: "1.8"}))
+	s.assertRule(s.e.Check(&Order{Product: ProductUSDM, Symbol: "BTCUSDT", Side: "SELL", Quantity: "1.9"}), RuleTypeMaxPosition)
+
+	// hedge mode positions are netted
+	r.NoError(s.e.SetPosition(ProductUSDM, "BTCUSDT", "LONG", "0.5"))
+	r.NoError(s.e.SetPosition(ProductUSDM, "BTCUSDT", "SHORT", "-0.5"))
+	r.Equal("0.8", s.e.Position(ProductUSDM, "BTCUSDT"))
+
+	// orders reducing an oversized position are accepted
+	r.NoError(s.e.SetPosition(ProductUSDM, "BTCUSDT", "", "3"))
+	r.NoError(s.e.Check(&Order{Product: ProductUSDM, Symbol: "BTCUSDT", Side: "SELL", Quantity: "0.5"}))
+}
+
+func (s *engineTestSuite) TestMaxOpenOrders() {
+	r := s.Require()
+	r.NoError(s.e.SetLimits(ProductSpot, "BTCUSDT", Limits{MaxOpenOrders: 2}))
+	s.e.SetOpenOrders(ProductSpot, "BTCUSDT", []string{"a", "b"})
+	r.Equal(2, s.e.OpenOrders(ProductSpot, "BTCUSDT"))
+
+	s.assertRule(s.e.Check(&Order{Product: ProductSpot, Symbol: "BTCUSDT", Side: "BUY", Quantity: "1", Price: "1"}), RuleTypeMaxOpenOrders)
+	r.NoError(s.e.Check(&Order{Product: ProductSpot, Symbol: "BTCUSDT", Side: "BUY", Quantity: "1", Price: "1", Amend: true}))
+}
+
+func (s *engineTestSuite) TestPriceBand() {
+	r := s.Require()
+	r.NoError(s.e.SetLimits(ProductUSDM, "BTCUSDT", Limits{PriceBand: "0.05"}))
+
+	// fail closed without reference price
+	s.assertRule(s.e.Check(&Order{Product: ProductUSDM, Symbol: "BTCUSDT", Side: "BUY", Quantity: "1", Price: "100"}), RuleTypeNoPrice)
+
+	r.NoError(s.e.SetReferencePrice(ProductUSDM, "BTCUSDT", "100"))
+	r.NoError(s.e.Check(&Order{Product: ProductUSDM, Symbol: "BTCUSDT", Side: "BUY", Quantity: "1", Price: "105"}))
+	r.NoError(s.e.Check(&Order{Product: ProductUSDM, Symbol: "BTCUSDT", Side: "SELL", Quantity: "1", Price: "95"}))
+	s.assertRule(s.e.Check(&Order{Product: ProductUSDM, Symbol: "BTCUSDT", Side: "BUY", Quantity: "1", Price: "106"}), RuleTypePriceBand)
+	s.assertRule(s.e.Check(&Order{Product: ProductUSDM, Symbol: "BTCUSDT", Side: "SELL", Quantity: "1", Price: "10"}), RuleTypePriceBand)
+	r.NoError(s.e.Check(&Order{Product: ProductUSDM, Symbol: "BTCUSDT", Side: "SELL", Type: "MARKET", Quantity: "1"}))
+}
+
+func (s *engineTestSuite) TestDailyLoss() {
+	r := s.Require()
+	r.NoError(s.e.SetDailyLossLimit("100"))
+	r.NoError(s.e.AddRealizedPnL("-60"))
+	r.NoError(s.e.Check(&Order{Product: ProductUSDM, Symbol: "BTCUSDT", Side: "BUY", Quantity: "1"}))
+
+	r.NoError(s.e.AddRealizedPnL("-40"))
+	r.Equal("-100", s.e.RealizedPnL())
+	s.assertRule(s.e.Check(&Order{Product: ProductUSDM, Symbol: "BTCUSDT", Side: "BUY", Quantity: "1"}), RuleTypeDailyLoss)
+	r.NoError(s.e.Check(&Order{Product: ProductUSDM, Symbol: "BTCUSDT", Side: "SELL", Quantity: "1", ReduceOnly: true}))
+
+	// the loss resets on the next UTC day
+	s.now = s.now.Add(12 * time.Hour)
+	r.Equal("0", s.e.RealizedPnL())
+	r.NoError(s.e.Check(&Order{Product: ProductUSDM, Symbol: "BTCUSDT", Side: "BUY", Quantity: "1"}))
+}
+
+func (s *engineTestSuite) TestKillSwitch() {
+	r := s.Require()
+	var cancelled []string
+	done := make(chan string, 2)
+	s.e.RegisterCanceler(func(ctx context.Context) error {
+		done <- "spot"
+		return nil
+	})
+	s.e.RegisterCanceler(func(ctx context.Context) error {
+		done <- "futures"
+		return errors.New("dummy error")
+	})
+
+	err := s.e.Kill(context.Background())
+	r.Error(err)
+	r.Contains(err.Error(), "dummy error")
+	close(done)
+	for name := range done {
+		cancelled = append(cancelled, name)
+	}
+	r.ElementsMatch([]string{"spot", "futures"}, cancelled)
+	r.True(s.e.Killed())
+
+	s.assertRule(s.e.Check(&Order{Product: ProductUSDM, Symbol: "BTCUSDT", Side: "SELL", Quantity: "1", ReduceOnly: true}), RuleTypeKillSwitch)
+
+	s.e.Resume()
+	r.False(s.e.Killed())
+	r.NoError(s.e.Check(&Order{Product: ProductUSDM, Symbol: "BTCUSDT", Side: "SELL", Quantity: "1"}))
+}
+
+func (s *engineTestSuite) TestIsRiskError() {
+	r := s.Require()
+	r.True(IsRiskError(&Error{Rule: RuleTypeKillSwitch}))
+	r.False(IsRiskError(errors.New("dummy error")))
+}
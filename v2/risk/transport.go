@@ -0,0 +1,281 @@
+package risk
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// orderRoutes define the REST endpoints placing or cancelling orders
+var orderRoutes = map[string]Product{
+	"/api/v3/order":                 ProductSpot,
+	"/api/v3/order/cancelReplace":   ProductSpot,
+	"/api/v3/order/oco":             ProductSpot,
+	"/api/v3/orderList/oco":         ProductSpot,
+	"/api/v3/orderList/oto":         ProductSpot,
+	"/api/v3/orderList/otoco":       ProductSpot,
+	"/api/v3/sor/order":             ProductSpot,
+	"/sapi/v1/margin/order":         ProductSpot,
+	"/sapi/v1/margin/order/oco":     ProductSpot,
+	"/fapi/v1/order":                ProductUSDM,
+	"/fapi/v1/batchOrders":          ProductUSDM,
+	"/dapi/v1/order":                ProductCOINM,
+	"/dapi/v1/batchOrders":          ProductCOINM,
+	"/papi/v1/um/order":             ProductUSDM,
+	"/papi/v1/um/conditional/order": ProductUSDM,
+	"/papi/v1/cm/order":             ProductCOINM,
+	"/papi/v1/cm/conditional/order": ProductCOINM,
+	"/papi/v1/margin/order":         ProductSpot,
+	"/papi/v1/margin/order/oco":     ProductSpot,
+}
+
+// transport define a http.RoundTripper running pre-trade checks on order requests
+type transport struct {
+	e    *Engine
+	next http.RoundTripper
+}
+
+// Transport wraps next so order requests are checked before being sent.
+// A nil next uses http.DefaultTransport.
+func (e *Engine) Transport(next http.RoundTripper) http.RoundTripper {
+	if next == nil {
+		next = http.DefaultTransport
+	}
+	return &transport{e: e, next: next}
+}
+
+// HTTPClient returns a copy of hc whose transport runs pre-trade checks, e.g.
+//
+//	client.HTTPClient = engine.HTTPClient(client.HTTPClient)
+//
+// A nil hc is treated as http.DefaultClient.
+func (e *Engine) HTTPClient(hc *http.Client) *http.Client {
+	if hc == nil {
+		hc = http.DefaultClient
+	}
+	c := *hc
+	c.Transport = e.Transport(hc.Transport)
+	return &c
+}
+
+// RoundTrip implements http.RoundTripper
+func (t *transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	product, ok := orderRoutes[req.URL.Path]
+	if !ok {
+		return t.next.RoundTrip(req)
+	}
+	var orders []*Order
+	if req.Method == http.MethodPost || req.Method == http.MethodPut {
+		values, err := requestValues(req)
+		if err != nil {
+			return nil, err
+		}
+		orders, err = parseOrders(product, req, values)
+		if err != nil {
+			return nil, err
+		}
+		if err := t.e.reserve(orders); err != nil {
+			return nil, err
+		}
+		defer t.e.release(orders)
+	}
+	res, err := t.next.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+	// a cancelReplace with one of its two steps failed answers 409 with the orders of the other
+	if res.StatusCode/100 == 2 || res.StatusCode == http.StatusConflict {
+		if err := t.e.trackResponse(product, res); err != nil {
+			res.Body.Close()
+			return nil, err
+		}
+	}
+	return res, nil
+}
+
+// requestValues returns query and form parameters, restoring the request body
+func requestValues(req *http.Request) (url.Values, error) {
+	values := req.URL.Query()
+	if req.Body == nil || req.Body == http.NoBody {
+		return values, nil
+	}
+	body, err := io.ReadAll(req.Body)
+	req.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	req.Body = io.NopCloser(bytes.NewReader(body))
+	form, err := url.ParseQuery(string(body))
+	if err != nil {
+		return nil, err
+	}
+	for k, v := range form {
+		values[k] = append(values[k], v...)
+	}
+	return values, nil
+}
+
+// parseOrders builds the orders of a request, batch orders are sent as a JSON array
+func parseOrders(product Product, req *http.Request, values url.Values) ([]*Order, error) {
+	amend := req.Method == http.MethodPut || strings.HasSuffix(req.URL.Path, "/cancelReplace")
+	batch := values.Get("batchOrders")
+	if batch == "" {
+		o := newOrder(product, amend, values.Get)
+		return []*Order{o}, nil
+	}
+	var items []map[string]interface{}
+	if err := json.Unmarshal([]byte(batch), &items); err != nil {
+		return nil, err
+	}
+	orders := make([]*Order, 0, len(items))
+	for _, item := range items {
+		item := item
+		orders = append(orders, newOrder(product, amend, func(key string) string {
+			v, ok := item[key]
+			if !ok || v == nil {
+				return ""
+			}
+			return fmt.Sprint(v)
+		}))
+	}
+	return orders, nil
+}
+
+func newOrder(product Product, amend bool, get func(string) string) *Order {
+	quantity := get("quantity")
+	if quantity == "" {
+		// oco order lists
+		quantity = get("aboveQuantity")
+	}
+	if quantity == "" {
+		quantity = get("workingQuantity")
+	}
+	price := get("price")
+	if price == "" {
+		price = get("workingPrice")
+	}
+	return &Order{
+		Product:       product,
+		Symbol:        get("symbol"),
+		Side:          get("side"),
+		Type:          get("type"),
+		Quantity:      quantity,
+		QuoteQuantity: get("quoteOrderQty"),
+		Price:         price,
+		ReduceOnly:    strings.EqualFold(get("reduceOnly"), "true"),
+		ClosePosition: strings.EqualFold(get("closePosition"), "true"),
+		Amend:         amend,
+	}
+}
+
+// reserve checks orders and counts them as pending open orders until released,
+// so that concurrent requests cannot exceed the open orders limit together
+func (e *Engine) reserve(orders []*Order) error {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	for i, o := range orders {
+		if err := e.check(o); err != nil {
+			e.unreserve(orders[:i])
+			return err
+		}
+		if !o.Amend {
+			e.state(o.Product, o.Symbol).pending++
+		}
+	}
+	return nil
+}
+
+// release drops pending open orders once the exchange answered
+func (e *Engine) release(orders []*Order) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.unreserve(orders)
+}
+
+// unreserve must be called with the lock held
+func (e *Engine) unreserve(orders []*Order) {
+	for _, o := range orders {
+		if !o.Amend {
+			e.state(o.Product, o.Symbol).pending--
+		}
+	}
+}
+
+// orderResponse define the fields tracked in order responses. Spot cancels return the id of
+// the cancelled order in origClientOrderId, cancelReplace responses nest the cancelled and
+// the new orders, under data when one of them failed.
+type orderResponse struct {
+	Symbol            string         `json:"symbol"`
+	ClientOrderID     string         `json:"clientOrderId"`
+	OrigClientOrderID string         `json:"origClientOrderId"`
+	Status            string         `json:"status"`
+	CancelResponse    *orderResponse `json:"cancelResponse"`
+	NewOrderResponse  *orderResponse `json:"newOrderResponse"`
+	Data              *orderResponse `json:"data"`
+}
+
+// orders returns the order of the response and the orders nested in it
+func (r *orderResponse) orders() []*orderResponse {
+	if r == nil {
+		return nil
+	}
+	res := []*orderResponse{r}
+	for _, nested := range []*orderResponse{r.CancelResponse, r.NewOrderResponse, r.Data} {
+		res = append(res, nested.orders()...)
+	}
+	return res
+}
+
+// orderID returns the client order id of the order the response is about
+func (r *orderResponse) orderID() string {
+	if r.OrigClientOrderID != "" {
+		return r.OrigClientOrderID
+	}
+	return r.ClientOrderID
+}
+
+// trackResponse records the open orders returned by the exchange, restoring the response body
+func (e *Engine) trackResponse(product Product, res *http.Response) error {
+	body, err := io.ReadAll(res.Body)
+	res.Body.Close()
+	if err != nil {
+		return err
+	}
+	res.Body = io.NopCloser(bytes.NewReader(body))
+
+	var items []*orderResponse
+	body = bytes.TrimSpace(body)
+	if len(body) > 0 && body[0] == '[' {
+		// batch responses mix orders and errors, skip what is not an order
+		var raws []json.RawMessage
+		if json.Unmarshal(body, &raws) != nil {
+			return nil
+		}
+		for _, raw := range raws {
+			item := new(orderResponse)
+			if json.Unmarshal(raw, item) == nil {
+				items = append(items, item.orders()...)
+			}
+		}
+	} else {
+		item := new(orderResponse)
+		if json.Unmarshal(body, item) != nil {
+			return nil
+		}
+		items = item.orders()
+	}
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	for _, item := range items {
+		if item.Symbol == "" || item.Status == "" {
+			continue
+		}
+		e.updateOrder(product, item.Symbol, item.orderID(), item.Status)
+	}
+	return nil
+}
@@ -0,0 +1,221 @@
+package risk
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/adshao/go-binance/v2"
+	"github.com/adshao/go-binance/v2/futures"
+)
+
+type transportTestSuite struct {
+	suite.Suite
+	e        *Engine
+	server   *httptest.Server
+	mu       sync.Mutex
+	requests []*http.Request
+	forms    []url.Values
+	response string
+	status   int
+}
+
+func TestTransport(t *testing.T) {
+	suite.Run(t, new(transportTestSuite))
+}
+
+func (s *transportTestSuite) SetupTest() {
+	s.e = NewEngine()
+	s.requests = nil
+	s.forms = nil
+	s.status = http.StatusOK
+	s.server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		form, _ := url.ParseQuery(string(body))
+		s.mu.Lock()
+		s.requests = append(s.requests, r)
+		s.forms = append(s.forms, form)
+		response, status := s.response, s.status
+		s.mu.Unlock()
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(status)
+		_, _ = w.Write([]byte(response))
+	}))
+}
+
+func (s *transportTestSuite) TearDownTest() {
+	s.server.Close()
+}
+
+func (s *transportTestSuite) futuresClient() *futures.Client {
+	c := futures.NewClient("dummy", "dummy")
+	c.BaseURL = s.server.URL
+	c.HTTPClient = s.e.HTTPClient(c.HTTPClient)
+	return c
+}
+
+func (s *transportTestSuite) spotClient() *binance.Client {
+	c := binance.NewClient("dummy", "dummy")
+	c.BaseURL = s.server.URL
+	c.HTTPClient = s.e.HTTPClient(nil)
+	return c
+}
+
+func (s *transportTestSuite) TestRejectedOrderIsNotSent() {
+	r := s.Require()
+	r.NoError(s.e.SetLimits(ProductUSDM, "BTCUSDT", Limits{MaxPosition: "1"}))
+
+	_, err := s.futuresClient().NewCreateOrderService().Symbol("BTCUSDT").
+		Side(futures.SideTypeBuy).Type(futures.OrderTypeMarket).Quantity("2").
+		Do(context.Background())
+	r.Error(err)
+	r.True(IsRiskError(err))
+	var riskErr *Error
+	r.True(errors.As(err, &riskErr))
+	r.Equal(RuleTypeMaxPosition, riskErr.Rule)
+	r.Empty(s.requests)
+}
+
+func (s *transportTestSuite) TestAcceptedOrderIsTracked() {
+	r := s.Require()
+	r.NoError(s.e.SetLimits(ProductUSDM, "BTCUSDT", Limits{MaxOpenOrders: 1}))
+	s.response = `{"symbol":"BTCUSDT","clientOrderId":"abc","status":"NEW","side":"BUY"}`
+
+	c := s.futuresClient()
+	res, err := c.NewCreateOrderService().Symbol("BTCUSDT").
+		Side(futures.SideTypeBuy).Type(futures.OrderTypeLimit).TimeInForce(futures.TimeInForceTypeGTC).
+		Quantity("1").Price("100").NewClientOrderID("abc").
+		Do(context.Background())
+	r.NoError(err)
+	r.Equal("abc", res.ClientOrderID)
+	r.Len(s.requests, 1)
+	r.Equal("1", s.forms[0].Get("quantity"))
+	r.Equal(1, s.e.OpenOrders(ProductUSDM, "BTCUSDT"))
+
+	_, err = c.NewCreateOrderService().Symbol("BTCUSDT").
+		Side(futures.SideTypeBuy).Type(futures.OrderTypeLimit).TimeInForce(futures.TimeInForceTypeGTC).
+		Quantity("1").Price("100").
+		Do(context.Background())
+	r.True(IsRiskError(err))
+	r.Len(s.requests, 1)
+
+	// cancels always pass through and release the open order
+	s.response = `{"symbol":"BTCUSDT","clientOrderId":"abc","status":"CANCELED"}`
+	_, err = c.NewCancelOrderService().Symbol("BTCUSDT").OrigClientOrderID("abc").Do(context.Background())
+	r.NoError(err)
+	r.Equal(0, s.e.OpenOrders(ProductUSDM, "BTCUSDT"))
+}
+
+func (s *transportTestSuite) TestBatchOrders() {
+	r := s.Require()
+	r.NoError(s.e.SetLimits(ProductUSDM, "BTCUSDT", Limits{MaxNotional: "1000"}))
+
+	c := s.futuresClient()
+	order := func(quantity string) *futures.CreateOrderService {
+		return c.NewCreateOrderService().Symbol("BTCUSDT").Side(futures.SideTypeBuy).
+			Type(futures.OrderTypeLimit).TimeInForce(futures.TimeInForceTypeGTC).
+			Quantity(quantity).Price("100")
+	}
+	_, err := c.NewCreateBatchOrdersService().OrderList([]*futures.CreateOrderService{order("1"), order("20")}).
+		Do(context.Background())
+	r.True(IsRiskError(err))
+	r.Empty(s.requests)
+
+	s.response = `[{"symbol":"BTCUSDT","clientOrderId":"a","status":"NEW"},{"code":-2010,"msg":"rejected"}]`
+	res, err := c.NewCreateBatchOrdersService().OrderList([]*futures.CreateOrderService{order("1"), order("2")}).
+		Do(context.Background())
+	r.NoError(err)
+	r.Len(res.Orders, 1)
+	r.Len(s.requests, 1)
+	r.Equal(1, s.e.OpenOrders(ProductUSDM, "BTCUSDT"))
+}
+
+func (s *transportTestSuite) TestSpotOrder() {
+	r := s.Require()
+	r.NoError(s.e.SetLimits(ProductSpot, "BTCUSDT", Limits{MaxNotional: "1000"}))
+
+	_, err := s.spotClient().NewCreateOrderService().Symbol("BTCUSDT").
+		Side(binance.SideTypeBuy).Type(binance.OrderTypeMarket).QuoteOrderQty("2000").
+		Do(context.Background())
+	r.True(IsRiskError(err))
+	r.Empty(s.requests)
+
+	s.response = `{"symbol":"BTCUSDT","clientOrderId":"abc","status":"FILLED"}`
+	_, err = s.spotClient().NewCreateOrderService().Symbol("BTCUSDT").
+		Side(binance.SideTypeBuy).Type(binance.OrderTypeMarket).QuoteOrderQty("500").
+		Do(context.Background())
+	r.NoError(err)
+	r.Len(s.requests, 1)
+	r.Equal(0, s.e.OpenOrders(ProductSpot, "BTCUSDT"))
+}
+
+func (s *transportTestSuite) TestSpotCancel() {
+	r := s.Require()
+	c := s.spotClient()
+	s.response = `{"symbol":"BTCUSDT","clientOrderId":"abc","status":"NEW"}`
+	_, err := c.NewCreateOrderService().Symbol("BTCUSDT").
+		Side(binance.SideTypeBuy).Type(binance.OrderTypeLimit).TimeInForce(binance.TimeInForceTypeGTC).
+		Quantity("1").Price("100").NewClientOrderID("abc").
+		Do(context.Background())
+	r.NoError(err)
+	r.Equal(1, s.e.OpenOrders(ProductSpot, "BTCUSDT"))
+
+	// the id of the cancel is in clientOrderId, the cancelled order in origClientOrderId
+	s.response = `{"symbol":"BTCUSDT","origClientOrderId":"abc","clientOrderId":"cancel","status":"CANCELED"}`
+	_, err = c.NewCancelOrderService().Symbol("BTCUSDT").OrigClientOrderID("abc").Do(context.Background())
+	r.NoError(err)
+	r.Equal(0, s.e.OpenOrders(ProductSpot, "BTCUSDT"))
+}
+
+func (s *transportTestSuite) TestSpotCancelReplace() {
+	r := s.Require()
+	r.NoError(s.e.SetLimits(ProductSpot, "BTCUSDT", Limits{MaxOpenOrders: 1}))
+	c := s.spotClient()
+	s.response = `{"symbol":"BTCUSDT","clientOrderId":"abc","status":"NEW"}`
+	_, err := c.NewCreateOrderService().Symbol("BTCUSDT").
+		Side(binance.SideTypeBuy).Type(binance.OrderTypeLimit).TimeInForce(binance.TimeInForceTypeGTC).
+		Quantity("1").Price("100").NewClientOrderID("abc").
+		Do(context.Background())
+	r.NoError(err)
+
+	replace := func(id string) error {
+		_, err := c.NewCancelReplaceOrderService().Symbol("BTCUSDT").
+			Side(binance.SideTypeBuy).Type(binance.OrderTypeLimit).TimeInForce(binance.TimeInForceTypeGTC).
+			CancelReplaceMode(binance.CancelReplaceModeAllowFailure).CancelOrigClientOrderID("abc").
+			Quantity("1").Price("101").NewClientOrderID(id).
+			Do(context.Background())
+		return err
+	}
+	s.response = `{"cancelResult":"SUCCESS","newOrderResult":"SUCCESS",
+		"cancelResponse":{"symbol":"BTCUSDT","origClientOrderId":"abc","clientOrderId":"cancel","status":"CANCELED"},
+		"newOrderResponse":{"symbol":"BTCUSDT","clientOrderId":"def","status":"NEW"}}`
+	r.NoError(replace("def"))
+	r.Equal(1, s.e.OpenOrders(ProductSpot, "BTCUSDT"))
+	s.mu.Lock()
+	r.Equal("abc", s.forms[1].Get("cancelOrigClientOrderId"))
+	s.mu.Unlock()
+
+	// the new order of a failed cancel is tracked too
+	s.status = http.StatusConflict
+	s.response = `{"code":-2021,"msg":"Order cancel-replace partially failed.","data":{"cancelResult":"FAILURE","newOrderResult":"SUCCESS",
+		"cancelResponse":{"code":-2011,"msg":"Unknown order sent."},
+		"newOrderResponse":{"symbol":"BTCUSDT","clientOrderId":"ghi","status":"NEW"}}}`
+	r.Error(replace("ghi"))
+	r.Equal(2, s.e.OpenOrders(ProductSpot, "BTCUSDT"))
+}
+
+func (s *transportTestSuite) TestOtherRequestsPassThrough() {
+	r := s.Require()
+	s.e.killed = true
+	s.response = `{"serverTime":1}`
+	_, err := s.futuresClient().NewServerTimeService().Do(context.Background())
+	r.NoError(err)
+	r.Len(s.requests, 1)
+}
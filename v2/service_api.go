@@ -0,0 +1,6748 @@
+// Code generated by servicegen. DO NOT EDIT.
+
+package binance
+
+import (
+	"context"
+
+	"github.com/adshao/go-binance/v2/futures"
+)
+
+// ClientAPI is the interface of the REST services of Client, see NewClientAPI
+type ClientAPI interface {
+	NewPingService() PingServiceAPI
+	NewServerTimeService() ServerTimeServiceAPI
+	NewSetServerTimeService() SetServerTimeServiceAPI
+	NewDepthService() DepthServiceAPI
+	NewAggTradesService() AggTradesServiceAPI
+	NewRecentTradesService() RecentTradesServiceAPI
+	NewKlinesService() KlinesServiceAPI
+	NewUiKlinesService() UiKlinesServiceAPI
+	NewListPriceChangeStatsService() ListPriceChangeStatsServiceAPI
+	NewListPricesService() ListPricesServiceAPI
+	NewTradingDayTickerService() TradingDayTickerServiceAPI
+	NewListBookTickersService() ListBookTickersServiceAPI
+	NewListSymbolTickerService() ListSymbolTickerServiceAPI
+	NewCreateOrderService() CreateOrderServiceAPI
+	NewCreateOCOService() CreateOCOServiceAPI
+	NewCancelOCOService() CancelOCOServiceAPI
+	NewGetOrderService() GetOrderServiceAPI
+	NewCancelOrderService() CancelOrderServiceAPI
+	NewCancelReplaceOrderService() CancelReplaceOrderServiceAPI
+	NewCancelOpenOrdersService() CancelOpenOrdersServiceAPI
+	NewListOpenOrdersService() ListOpenOrdersServiceAPI
+	NewListOpenOcoService() ListOpenOcoServiceAPI
+	NewListOrdersService() ListOrdersServiceAPI
+	NewGetAccountService() GetAccountServiceAPI
+	NewGetCommissionRatesService() GetCommissionRatesServiceAPI
+	NewGetAPIKeyPermission() GetAPIKeyPermissionAPI
+	NewSavingFlexibleProductPositionsService() SavingFlexibleProductPositionsServiceAPI
+	NewSavingFixedProjectPositionsService() SavingFixedProjectPositionsServiceAPI
+	NewListSavingsFlexibleProductsService() ListSavingsFlexibleProductsServiceAPI
+	NewPurchaseSavingsFlexibleProductService() PurchaseSavingsFlexibleProductServiceAPI
+	NewRedeemSavingsFlexibleProductService() RedeemSavingsFlexibleProductServiceAPI
+	NewListSavingsFixedAndActivityProductsService() ListSavingsFixedAndActivityProductsServiceAPI
+	NewGetAccountSnapshotService() GetAccountSnapshotServiceAPI
+	NewListTradesService() ListTradesServiceAPI
+	NewHistoricalTradesService() HistoricalTradesServiceAPI
+	NewListDepositsService() ListDepositsServiceAPI
+	NewGetDepositAddressService() GetDepositsAddressServiceAPI
+	NewCreateWithdrawService() CreateWithdrawServiceAPI
+	NewListWithdrawsService() ListWithdrawsServiceAPI
+	NewStartUserStreamService() StartUserStreamServiceAPI
+	NewKeepaliveUserStreamService() KeepaliveUserStreamServiceAPI
+	NewCloseUserStreamService() CloseUserStreamServiceAPI
+	NewExchangeInfoService() ExchangeInfoServiceAPI
+	NewRateLimitService() RateLimitServiceAPI
+	NewGetAssetDetailService() GetAssetDetailServiceAPI
+	NewWalletBalanceService() WalletBalanceServiceAPI
+	NewGetFundingAssetService() GetFundingAssetServiceAPI
+	NewAveragePriceService() AveragePriceServiceAPI
+	NewMarginTransferService() MarginTransferServiceAPI
+	NewMarginLoanService() MarginLoanServiceAPI
+	NewMarginRepayService() MarginRepayServiceAPI
+	NewMarginBorrowRepayService() MarginBorrowRepayServiceAPI
+	NewListMarginBorrowRepayService() ListMarginBorrowRepayServiceAPI
+	NewCreateMarginOrderService() CreateMarginOrderServiceAPI
+	NewCancelMarginOrderService() CancelMarginOrderServiceAPI
+	NewCancelAllMarginOrdersService() CancelAllMarginOrdersServiceAPI
+	NewCreateMarginOCOService() CreateMarginOCOServiceAPI
+	NewCancelMarginOCOService() CancelMarginOCOServiceAPI
+	NewGetMarginOrderService() GetMarginOrderServiceAPI
+	NewListMarginLoansService() ListMarginLoansServiceAPI
+	NewListMarginRepaysService() ListMarginRepaysServiceAPI
+	NewGetMarginAccountService() GetMarginAccountServiceAPI
+	NewGetIsolatedMarginAccountService() GetIsolatedMarginAccountServiceAPI
+	NewIsolatedMarginTransferService() IsolatedMarginTransferServiceAPI
+	NewGetMarginAssetService() GetMarginAssetServiceAPI
+	NewGetMarginPairService() GetMarginPairServiceAPI
+	NewGetMarginAllPairsService() GetMarginAllPairsServiceAPI
+	NewGetMarginPriceIndexService() GetMarginPriceIndexServiceAPI
+	NewListMarginOpenOrdersService() ListMarginOpenOrdersServiceAPI
+	NewListMarginOrdersService() ListMarginOrdersServiceAPI
+	NewListMarginTradesService() ListMarginTradesServiceAPI
+	NewGetMaxBorrowableService() GetMaxBorrowableServiceAPI
+	NewGetMaxTransferableService() GetMaxTransferableServiceAPI
+	NewStartMarginUserStreamService() StartMarginUserStreamServiceAPI
+	NewKeepaliveMarginUserStreamService() KeepaliveMarginUserStreamServiceAPI
+	NewCloseMarginUserStreamService() CloseMarginUserStreamServiceAPI
+	NewStartIsolatedMarginUserStreamService() StartIsolatedMarginUserStreamServiceAPI
+	NewKeepaliveIsolatedMarginUserStreamService() KeepaliveIsolatedMarginUserStreamServiceAPI
+	NewCloseIsolatedMarginUserStreamService() CloseIsolatedMarginUserStreamServiceAPI
+	NewMarginInterestHistoryService() MarginInterestHistoryServiceAPI
+	NewMarginInterestRateHistoryService() MarginInterestRateHistoryServiceAPI
+	NewMarginNextHourlyInterestRateService() MarginNextHourlyInterestRateServiceAPI
+	NewMarginAvailableInventoryService() MarginAvailableInventoryServiceAPI
+	NewFuturesTransferService() FuturesTransferServiceAPI
+	NewListFuturesTransferService() ListFuturesTransferServiceAPI
+	NewListDustLogService() ListDustLogServiceAPI
+	NewDustTransferService() DustTransferServiceAPI
+	NewListDustService() ListDustServiceAPI
+	NewTransferToSubAccountService() TransferToSubAccountServiceAPI
+	NewSubaccountAssetsService() SubaccountAssetsServiceAPI
+	NewSubaccountSpotSummaryService() SubaccountSpotSummaryServiceAPI
+	NewSubaccountDepositAddressService() SubaccountDepositAddressServiceAPI
+	NewAssetDividendService() AssetDividendServiceAPI
+	NewUserUniversalTransferService() CreateUserUniversalTransferServiceAPI
+	NewGetAllCoinsInfoService() GetAllCoinsInfoServiceAPI
+	NewGetAllMarginAssetsService() GetAllMarginAssetsServiceAPI
+	NewFiatDepositWithdrawHistoryService() FiatDepositWithdrawHistoryServiceAPI
+	NewFiatPaymentsHistoryService() FiatPaymentsHistoryServiceAPI
+	NewPayTradeHistoryService() PayTradeHistoryServiceAPI
+	NewSpotRebateHistoryService() SpotRebateHistoryServiceAPI
+	NewConvertTradeHistoryService() ConvertTradeHistoryServiceAPI
+	NewConvertExchangeInfoService() ConvertExchangeInfoServiceAPI
+	NewConvertAssetInfoService() ConvertAssetInfoServiceAPI
+	NewConvertQuoteService() ConvertGetQuoteServiceAPI
+	NewConvertAcceptQuoteService() ConvertAcceptQuoteServiceAPI
+	NewConvertOrderStatusService() ConvertOrderStatusServiceAPI
+	NewGetIsolatedMarginAllPairsService() GetIsolatedMarginAllPairsServiceAPI
+	NewInterestHistoryService() InterestHistoryServiceAPI
+	NewTradeFeeService() TradeFeeServiceAPI
+	NewC2CTradeHistoryService() C2CTradeHistoryServiceAPI
+	NewStakingProductPositionService() StakingProductPositionServiceAPI
+	NewStakingHistoryService() StakingHistoryServiceAPI
+	NewGetAllLiquidityPoolService() GetAllLiquidityPoolServiceAPI
+	NewGetLiquidityPoolDetailService() GetLiquidityPoolDetailServiceAPI
+	NewAddLiquidityPreviewService() AddLiquidityPreviewServiceAPI
+	NewGetSwapQuoteService() GetSwapQuoteServiceAPI
+	NewSwapService() SwapServiceAPI
+	NewAddLiquidityService() AddLiquidityServiceAPI
+	NewGetUserSwapRecordsService() GetUserSwapRecordsServiceAPI
+	NewClaimRewardService() ClaimRewardServiceAPI
+	NewRemoveLiquidityService() RemoveLiquidityServiceAPI
+	NewQueryClaimedRewardHistoryService() QueryClaimedRewardHistoryServiceAPI
+	NewGetBNBBurnService() GetBNBBurnServiceAPI
+	NewToggleBNBBurnService() ToggleBNBBurnServiceAPI
+	NewInternalUniversalTransferService() InternalUniversalTransferServiceAPI
+	NewInternalUniversalTransferHistoryService() InternalUniversalTransferHistoryServiceAPI
+	NewSubAccountListService() SubAccountListServiceAPI
+	NewGetUserAsset() GetUserAssetServiceAPI
+	NewManagedSubAccountDepositService() ManagedSubAccountDepositServiceAPI
+	NewManagedSubAccountWithdrawalService() ManagedSubAccountWithdrawalServiceAPI
+	NewManagedSubAccountAssetsService() ManagedSubAccountAssetsServiceAPI
+	NewSubAccountFuturesAccountService() SubAccountFuturesAccountServiceAPI
+	NewSubAccountFuturesSummaryV1Service() SubAccountFuturesSummaryV1ServiceAPI
+	NewSubAccountFuturesTransferV1Service() SubAccountFuturesTransferV1ServiceAPI
+	NewSubAccountTransferHistoryService() SubAccountTransferHistoryServiceAPI
+	NewListUserUniversalTransferService() ListUserUniversalTransferServiceAPI
+	NewCreateVirtualSubAccountService() CreateVirtualSubAccountServiceAPI
+	NewSubAccountSpotTransferHistoryService() SubAccountSpotTransferHistoryServiceAPI
+	NewSubAccountFuturesTransferHistoryService() SubAccountFuturesTransferHistoryServiceAPI
+	NewSubAccountDepositRecordService() SubAccountDepositRecordServiceAPI
+	NewSubAccountMarginFuturesStatusService() SubAccountMarginFuturesStatusServiceAPI
+	NewSubAccountMarginEnableService() SubAccountMarginEnableServiceAPI
+	NewSubAccountMarginAccountInfoService() SubAccountMarginAccountInfoServiceAPI
+	NewSubAccountMarginAccountSummaryService() SubAccountMarginAccountSummaryServiceAPI
+	NewSubAccountFuturesEnableService() SubAccountFuturesEnableServiceAPI
+	NewSubAccountFuturesAccountSummaryService() SubAccountFuturesAccountSummaryServiceAPI
+	NewSubAccountFuturesPositionsService() SubAccountFuturesPositionsServiceAPI
+	NewSubAccountMarginTransferService() SubAccountMarginTransferServiceAPI
+	NewSubAccountTransferSubToMasterService() SubAccountTransferSubToMasterServiceAPI
+	NewSubAccountUniversalTransferService() SubAccountUniversalTransferServiceAPI
+	NewSubAccUniversalTransferHistoryService() SubAccUniversalTransferHistoryServiceAPI
+	NewSubAccountBlvtEnableService() SubAccountBlvtEnableServiceAPI
+	NewSubAccountApiIpRestrictionService() SubAccountApiIpRestrictionServiceAPI
+	NewSubAccountApiDeleteIpRestrictionService() SubAccountApiDeleteIpRestrictionServiceAPI
+	NewSubAccountApiAddIpRestrictionService() SubAccountApiAddIpRestrictionServiceAPI
+	NewManagedSubAccountWithdrawService() ManagedSubAccountWithdrawServiceAPI
+	NewManagedSubAccountSnapshotService() ManagedSubAccountSnapshotServiceAPI
+	NewManagedSubAccountQueryTransferLogForInvestorService() ManagedSubAccountQueryTransferLogForInvestorServiceAPI
+	NewManagedSubAccountQueryTransferLogForTradeParentService() ManagedSubAccountQueryTransferLogForTradeParentServiceAPI
+	NewManagedSubAccountQueryFuturesAssetService() ManagedSubAccountQueryFuturesAssetServiceAPI
+	NewManagedSubAccountQueryMarginAssetService() ManagedSubAccountQueryMarginAssetServiceAPI
+	NewSubAccountAssetService() SubAccountAssetServiceAPI
+	NewManagedSubAccountInfoService() ManagedSubAccountInfoServiceAPI
+	NewManagedSubAccountDepositAddressService() ManagedSubAccountDepositAddressServiceAPI
+	NewSubAccountOptionsEnableService() SubAccountOptionsEnableServiceAPI
+	NewManagedSubAccountQueryTransferLogService() ManagedSubAccountQueryTransferLogServiceAPI
+	NewSubAccountFuturesInternalTransferService() SubAccountFuturesInternalTransferServiceAPI
+	NewSubAccountTransactionStatisticsService() SubAccountTransactionStatisticsServiceAPI
+	NewSubAccountFuturesAccountV2Service() SubAccountFuturesAccountV2ServiceAPI
+	NewFuturesOrderBookHistoryService() FuturesOrderBookHistoryServiceAPI
+	NewCreateFuturesAlgoVpOrderService() CreateFuturesAlgoVpOrderServiceAPI
+	NewCreateFuturesAlgoTwapOrderService() CreateFuturesAlgoTwapOrderServiceAPI
+	NewListOpenFuturesAlgoOrdersService() ListOpenFuturesAlgoOrdersServiceAPI
+	NewListHistoryFuturesAlgoOrdersService() ListHistoryFuturesAlgoOrdersServiceAPI
+	NewCancelFuturesAlgoOrderService() CancelFuturesAlgoOrderServiceAPI
+	NewGetFuturesAlgoSubOrdersService() GetFuturesAlgoSubOrdersServiceAPI
+	NewSimpleEarnService() SimpleEarnServiceAPI
+	NewDualInvestmentService() DualInvestmentServiceAPI
+}
+
+// NewClientAPI returns c as a ClientAPI
+func NewClientAPI(c *Client) ClientAPI {
+	return (*clientAPI)(c)
+}
+
+type clientAPI Client
+
+func (a *clientAPI) NewPingService() PingServiceAPI {
+	return (*pingServiceAPI)((*Client)(a).NewPingService())
+}
+
+func (a *clientAPI) NewServerTimeService() ServerTimeServiceAPI {
+	return (*serverTimeServiceAPI)((*Client)(a).NewServerTimeService())
+}
+
+func (a *clientAPI) NewSetServerTimeService() SetServerTimeServiceAPI {
+	return (*setServerTimeServiceAPI)((*Client)(a).NewSetServerTimeService())
+}
+
+func (a *clientAPI) NewDepthService() DepthServiceAPI {
+	return (*depthServiceAPI)((*Client)(a).NewDepthService())
+}
+
+func (a *clientAPI) NewAggTradesService() AggTradesServiceAPI {
+	return (*aggTradesServiceAPI)((*Client)(a).NewAggTradesService())
+}
+
+func (a *clientAPI) NewRecentTradesService() RecentTradesServiceAPI {
+	return (*recentTradesServiceAPI)((*Client)(a).NewRecentTradesService())
+}
+
+func (a *clientAPI) NewKlinesService() KlinesServiceAPI {
+	return (*klinesServiceAPI)((*Client)(a).NewKlinesService())
+}
+
+func (a *clientAPI) NewUiKlinesService() UiKlinesServiceAPI {
+	return (*uiKlinesServiceAPI)((*Client)(a).NewUiKlinesService())
+}
+
+func (a *clientAPI) NewListPriceChangeStatsService() ListPriceChangeStatsServiceAPI {
+	return (*listPriceChangeStatsServiceAPI)((*Client)(a).NewListPriceChangeStatsService())
+}
+
+func (a *clientAPI) NewListPricesService() ListPricesServiceAPI {
+	return (*listPricesServiceAPI)((*Client)(a).NewListPricesService())
+}
+
+func (a *clientAPI) NewTradingDayTickerService() TradingDayTickerServiceAPI {
+	return (*tradingDayTickerServiceAPI)((*Client)(a).NewTradingDayTickerService())
+}
+
+func (a *clientAPI) NewListBookTickersService() ListBookTickersServiceAPI {
+	return (*listBookTickersServiceAPI)((*Client)(a).NewListBookTickersService())
+}
+
+func (a *clientAPI) NewListSymbolTickerService() ListSymbolTickerServiceAPI {
+	return (*listSymbolTickerServiceAPI)((*Client)(a).NewListSymbolTickerService())
+}
+
+func (a *clientAPI) NewCreateOrderService() CreateOrderServiceAPI {
+	return (*createOrderServiceAPI)((*Client)(a).NewCreateOrderService())
+}
+
+func (a *clientAPI) NewCreateOCOService() CreateOCOServiceAPI {
+	return (*createOCOServiceAPI)((*Client)(a).NewCreateOCOService())
+}
+
+func (a *clientAPI) NewCancelOCOService() CancelOCOServiceAPI {
+	return (*cancelOCOServiceAPI)((*Client)(a).NewCancelOCOService())
+}
+
+func (a *clientAPI) NewGetOrderService() GetOrderServiceAPI {
+	return (*getOrderServiceAPI)((*Client)(a).NewGetOrderService())
+}
+
+func (a *clientAPI) NewCancelOrderService() CancelOrderServiceAPI {
+	return (*cancelOrderServiceAPI)((*Client)(a).NewCancelOrderService())
+}
+
+func (a *clientAPI) NewCancelReplaceOrderService() CancelReplaceOrderServiceAPI {
+	return (*cancelReplaceOrderServiceAPI)((*Client)(a).NewCancelReplaceOrderService())
+}
+
+func (a *clientAPI) NewCancelOpenOrdersService() CancelOpenOrdersServiceAPI {
+	return (*cancelOpenOrdersServiceAPI)((*Client)(a).NewCancelOpenOrdersService())
+}
+
+func (a *clientAPI) NewListOpenOrdersService() ListOpenOrdersServiceAPI {
+	return (*listOpenOrdersServiceAPI)((*Client)(a).NewListOpenOrdersService())
+}
+
+func (a *clientAPI) NewListOpenOcoService() ListOpenOcoServiceAPI {
+	return (*listOpenOcoServiceAPI)((*Client)(a).NewListOpenOcoService())
+}
+
+func (a *clientAPI) NewListOrdersService() ListOrdersServiceAPI {
+	return (*listOrdersServiceAPI)((*Client)(a).NewListOrdersService())
+}
+
+func (a *clientAPI) NewGetAccountService() GetAccountServiceAPI {
+	return (*getAccountServiceAPI)((*Client)(a).NewGetAccountService())
+}
+
+func (a *clientAPI) NewGetCommissionRatesService() GetCommissionRatesServiceAPI {
+	return (*getCommissionRatesServiceAPI)((*Client)(a).NewGetCommissionRatesService())
+}
+
+func (a *clientAPI) NewGetAPIKeyPermission() GetAPIKeyPermissionAPI {
+	return (*getAPIKeyPermissionAPI)((*Client)(a).NewGetAPIKeyPermission())
+}
+
+func (a *clientAPI) NewSavingFlexibleProductPositionsService() SavingFlexibleProductPositionsServiceAPI {
+	return (*savingFlexibleProductPositionsServiceAPI)((*Client)(a).NewSavingFlexibleProductPositionsService())
+}
+
+func (a *clientAPI) NewSavingFixedProjectPositionsService() SavingFixedProjectPositionsServiceAPI {
+	return (*savingFixedProjectPositionsServiceAPI)((*Client)(a).NewSavingFixedProjectPositionsService())
+}
+
+func (a *clientAPI) NewListSavingsFlexibleProductsService() ListSavingsFlexibleProductsServiceAPI {
+	return (*listSavingsFlexibleProductsServiceAPI)((*Client)(a).NewListSavingsFlexibleProductsService())
+}
+
+func (a *clientAPI) NewPurchaseSavingsFlexibleProductService() PurchaseSavingsFlexibleProductServiceAPI {
+	return (*purchaseSavingsFlexibleProductServiceAPI)((*Client)(a).NewPurchaseSavingsFlexibleProductService())
+}
+
+func (a *clientAPI) NewRedeemSavingsFlexibleProductService() RedeemSavingsFlexibleProductServiceAPI {
+	return (*redeemSavingsFlexibleProductServiceAPI)((*Client)(a).NewRedeemSavingsFlexibleProductService())
+}
+
+func (a *clientAPI) NewListSavingsFixedAndActivityProductsService() ListSavingsFixedAndActivityProductsServiceAPI {
+	return (*listSavingsFixedAndActivityProductsServiceAPI)((*Client)(a).NewListSavingsFixedAndActivityProductsService())
+}
+
+func (a *clientAPI) NewGetAccountSnapshotService() GetAccountSnapshotServiceAPI {
+	return (*getAccountSnapshotServiceAPI)((*Client)(a).NewGetAccountSnapshotService())
+}
+
+func (a *clientAPI) NewListTradesService() ListTradesServiceAPI {
+	return (*listTradesServiceAPI)((*Client)(a).NewListTradesService())
+}
+
+func (a *clientAPI) NewHistoricalTradesService() HistoricalTradesServiceAPI {
+	return (*historicalTradesServiceAPI)((*Client)(a).NewHistoricalTradesService())
+}
+
+func (a *clientAPI) NewListDepositsService() ListDepositsServiceAPI {
+	return (*listDepositsServiceAPI)((*Client)(a).NewListDepositsService())
+}
+
+func (a *clientAPI) NewGetDepositAddressService() GetDepositsAddressServiceAPI {
+	return (*getDepositsAddressServiceAPI)((*Client)(a).NewGetDepositAddressService())
+}
+
+func (a *clientAPI) NewCreateWithdrawService() CreateWithdrawServiceAPI {
+	return (*createWithdrawServiceAPI)((*Client)(a).NewCreateWithdrawService())
+}
+
+func (a *clientAPI) NewListWithdrawsService() ListWithdrawsServiceAPI {
+	return (*listWithdrawsServiceAPI)((*Client)(a).NewListWithdrawsService())
+}
+
+func (a *clientAPI) NewStartUserStreamService() StartUserStreamServiceAPI {
+	return (*startUserStreamServiceAPI)((*Client)(a).NewStartUserStreamService())
+}
+
+func (a *clientAPI) NewKeepaliveUserStreamService() KeepaliveUserStreamServiceAPI {
+	return (*keepaliveUserStreamServiceAPI)((*Client)(a).NewKeepaliveUserStreamService())
+}
+
+func (a *clientAPI) NewCloseUserStreamService() CloseUserStreamServiceAPI {
+	return (*closeUserStreamServiceAPI)((*Client)(a).NewCloseUserStreamService())
+}
+
+func (a *clientAPI) NewExchangeInfoService() ExchangeInfoServiceAPI {
+	return (*exchangeInfoServiceAPI)((*Client)(a).NewExchangeInfoService())
+}
+
+func (a *clientAPI) NewRateLimitService() RateLimitServiceAPI {
+	return (*rateLimitServiceAPI)((*Client)(a).NewRateLimitService())
+}
+
+func (a *clientAPI) NewGetAssetDetailService() GetAssetDetailServiceAPI {
+	return (*getAssetDetailServiceAPI)((*Client)(a).NewGetAssetDetailService())
+}
+
+func (a *clientAPI) NewWalletBalanceService() WalletBalanceServiceAPI {
+	return (*walletBalanceServiceAPI)((*Client)(a).NewWalletBalanceService())
+}
+
+func (a *clientAPI) NewGetFundingAssetService() GetFundingAssetServiceAPI {
+	return (*getFundingAssetServiceAPI)((*Client)(a).NewGetFundingAssetService())
+}
+
+func (a *clientAPI) NewAveragePriceService() AveragePriceServiceAPI {
+	return (*averagePriceServiceAPI)((*Client)(a).NewAveragePriceService())
+}
+
+func (a *clientAPI) NewMarginTransferService() MarginTransferServiceAPI {
+	return (*marginTransferServiceAPI)((*Client)(a).NewMarginTransferService())
+}
+
+func (a *clientAPI) NewMarginLoanService() MarginLoanServiceAPI {
+	return (*marginLoanServiceAPI)((*Client)(a).NewMarginLoanService())
+}
+
+func (a *clientAPI) NewMarginRepayService() MarginRepayServiceAPI {
+	return (*marginRepayServiceAPI)((*Client)(a).NewMarginRepayService())
+}
+
+func (a *clientAPI) NewMarginBorrowRepayService() MarginBorrowRepayServiceAPI {
+	return (*marginBorrowRepayServiceAPI)((*Client)(a).NewMarginBorrowRepayService())
+}
+
+func (a *clientAPI) NewListMarginBorrowRepayService() ListMarginBorrowRepayServiceAPI {
+	return (*listMarginBorrowRepayServiceAPI)((*Client)(a).NewListMarginBorrowRepayService())
+}
+
+func (a *clientAPI) NewCreateMarginOrderService() CreateMarginOrderServiceAPI {
+	return (*createMarginOrderServiceAPI)((*Client)(a).NewCreateMarginOrderService())
+}
+
+func (a *clientAPI) NewCancelMarginOrderService() CancelMarginOrderServiceAPI {
+	return (*cancelMarginOrderServiceAPI)((*Client)(a).NewCancelMarginOrderService())
+}
+
+func (a *clientAPI) NewCancelAllMarginOrdersService() CancelAllMarginOrdersServiceAPI {
+	return (*cancelAllMarginOrdersServiceAPI)((*Client)(a).NewCancelAllMarginOrdersService())
+}
+
+func (a *clientAPI) NewCreateMarginOCOService() CreateMarginOCOServiceAPI {
+	return (*createMarginOCOServiceAPI)((*Client)(a).NewCreateMarginOCOService())
+}
+
+func (a *clientAPI) NewCancelMarginOCOService() CancelMarginOCOServiceAPI {
+	return (*cancelMarginOCOServiceAPI)((*Client)(a).NewCancelMarginOCOService())
+}
+
+func (a *clientAPI) NewGetMarginOrderService() GetMarginOrderServiceAPI {
+	return (*getMarginOrderServiceAPI)((*Client)(a).NewGetMarginOrderService())
+}
+
+func (a *clientAPI) NewListMarginLoansService() ListMarginLoansServiceAPI {
+	return (*listMarginLoansServiceAPI)((*Client)(a).NewListMarginLoansService())
+}
+
+func (a *clientAPI) NewListMarginRepaysService() ListMarginRepaysServiceAPI {
+	return (*listMarginRepaysServiceAPI)((*Client)(a).NewListMarginRepaysService())
+}
+
+func (a *clientAPI) NewGetMarginAccountService() GetMarginAccountServiceAPI {
+	return (*getMarginAccountServiceAPI)((*Client)(a).NewGetMarginAccountService())
+}
+
+func (a *clientAPI) NewGetIsolatedMarginAccountService() GetIsolatedMarginAccountServiceAPI {
+	return (*getIsolatedMarginAccountServiceAPI)((*Client)(a).NewGetIsolatedMarginAccountService())
+}
+
+func (a *clientAPI) NewIsolatedMarginTransferService() IsolatedMarginTransferServiceAPI {
+	return (*isolatedMarginTransferServiceAPI)((*Client)(a).NewIsolatedMarginTransferService())
+}
+
+func (a *clientAPI) NewGetMarginAssetService() GetMarginAssetServiceAPI {
+	return (*getMarginAssetServiceAPI)((*Client)(a).NewGetMarginAssetService())
+}
+
+func (a *clientAPI) NewGetMarginPairService() GetMarginPairServiceAPI {
+	return (*getMarginPairServiceAPI)((*Client)(a).NewGetMarginPairService())
+}
+
+func (a *clientAPI) NewGetMarginAllPairsService() GetMarginAllPairsServiceAPI {
+	return (*getMarginAllPairsServiceAPI)((*Client)(a).NewGetMarginAllPairsService())
+}
+
+func (a *clientAPI) NewGetMarginPriceIndexService() GetMarginPriceIndexServiceAPI {
+	return (*getMarginPriceIndexServiceAPI)((*Client)(a).NewGetMarginPriceIndexService())
+}
+
+func (a *clientAPI) NewListMarginOpenOrdersService() ListMarginOpenOrdersServiceAPI {
+	return (*listMarginOpenOrdersServiceAPI)((*Client)(a).NewListMarginOpenOrdersService())
+}
+
+func (a *clientAPI) NewListMarginOrdersService() ListMarginOrdersServiceAPI {
+	return (*listMarginOrdersServiceAPI)((*Client)(a).NewListMarginOrdersService())
+}
+
+func (a *clientAPI) NewListMarginTradesService() ListMarginTradesServiceAPI {
+	return (*listMarginTradesServiceAPI)((*Client)(a).NewListMarginTradesService())
+}
+
+func (a *clientAPI) NewGetMaxBorrowableService() GetMaxBorrowableServiceAPI {
+	return (*getMaxBorrowableServiceAPI)((*Client)(a).NewGetMaxBorrowableService())
+}
+
+func (a *clientAPI) NewGetMaxTransferableService() GetMaxTransferableServiceAPI {
+	return (*getMaxTransferableServiceAPI)((*Client)(a).NewGetMaxTransferableService())
+}
+
+func (a *clientAPI) NewStartMarginUserStreamService() StartMarginUserStreamServiceAPI {
+	return (*startMarginUserStreamServiceAPI)((*Client)(a).NewStartMarginUserStreamService())
+}
+
+func (a *clientAPI) NewKeepaliveMarginUserStreamService() KeepaliveMarginUserStreamServiceAPI {
+	return (*keepaliveMarginUserStreamServiceAPI)((*Client)(a).NewKeepaliveMarginUserStreamService())
+}
+
+func (a *clientAPI) NewCloseMarginUserStreamService() CloseMarginUserStreamServiceAPI {
+	return (*closeMarginUserStreamServiceAPI)((*Client)(a).NewCloseMarginUserStreamService())
+}
+
+func (a *clientAPI) NewStartIsolatedMarginUserStreamService() StartIsolatedMarginUserStreamServiceAPI {
+	return (*startIsolatedMarginUserStreamServiceAPI)((*Client)(a).NewStartIsolatedMarginUserStreamService())
+}
+
+func (a *clientAPI) NewKeepaliveIsolatedMarginUserStreamService() KeepaliveIsolatedMarginUserStreamServiceAPI {
+	return (*keepaliveIsolatedMarginUserStreamServiceAPI)((*Client)(a).NewKeepaliveIsolatedMarginUserStreamService())
+}
+
+func (a *clientAPI) NewCloseIsolatedMarginUserStreamService() CloseIsolatedMarginUserStreamServiceAPI {
+	return (*closeIsolatedMarginUserStreamServiceAPI)((*Client)(a).NewCloseIsolatedMarginUserStreamService())
+}
+
+func (a *clientAPI) NewMarginInterestHistoryService() MarginInterestHistoryServiceAPI {
+	return (*marginInterestHistoryServiceAPI)((*Client)(a).NewMarginInterestHistoryService())
+}
+
+func (a *clientAPI) NewMarginInterestRateHistoryService() MarginInterestRateHistoryServiceAPI {
+	return (*marginInterestRateHistoryServiceAPI)((*Client)(a).NewMarginInterestRateHistoryService())
+}
+
+func (a *clientAPI) NewMarginNextHourlyInterestRateService() MarginNextHourlyInterestRateServiceAPI {
+	return (*marginNextHourlyInterestRateServiceAPI)((*Client)(a).NewMarginNextHourlyInterestRateService())
+}
+
+func (a *clientAPI) NewMarginAvailableInventoryService() MarginAvailableInventoryServiceAPI {
+	return (*marginAvailableInventoryServiceAPI)((*Client)(a).NewMarginAvailableInventoryService())
+}
+
+func (a *clientAPI) NewFuturesTransferService() FuturesTransferServiceAPI {
+	return (*futuresTransferServiceAPI)((*Client)(a).NewFuturesTransferService())
+}
+
+func (a *clientAPI) NewListFuturesTransferService() ListFuturesTransferServiceAPI {
+	return (*listFuturesTransferServiceAPI)((*Client)(a).NewListFuturesTransferService())
+}
+
+func (a *clientAPI) NewListDustLogService() ListDustLogServiceAPI {
+	return (*listDustLogServiceAPI)((*Client)(a).NewListDustLogService())
+}
+
+func (a *clientAPI) NewDustTransferService() DustTransferServiceAPI {
+	return (*dustTransferServiceAPI)((*Client)(a).NewDustTransferService())
+}
+
+func (a *clientAPI) NewListDustService() ListDustServiceAPI {
+	return (*listDustServiceAPI)((*Client)(a).NewListDustService())
+}
+
+func (a *clientAPI) NewTransferToSubAccountService() TransferToSubAccountServiceAPI {
+	return (*transferToSubAccountServiceAPI)((*Client)(a).NewTransferToSubAccountService())
+}
+
+func (a *clientAPI) NewSubaccountAssetsService() SubaccountAssetsServiceAPI {
+	return (*subaccountAssetsServiceAPI)((*Client)(a).NewSubaccountAssetsService())
+}
+
+func (a *clientAPI) NewSubaccountSpotSummaryService() SubaccountSpotSummaryServiceAPI {
+	return (*subaccountSpotSummaryServiceAPI)((*Client)(a).NewSubaccountSpotSummaryService())
+}
+
+func (a *clientAPI) NewSubaccountDepositAddressService() SubaccountDepositAddressServiceAPI {
+	return (*subaccountDepositAddressServiceAPI)((*Client)(a).NewSubaccountDepositAddressService())
+}
+
+func (a *clientAPI) NewAssetDividendService() AssetDividendServiceAPI {
+	return (*assetDividendServiceAPI)((*Client)(a).NewAssetDividendService())
+}
+
+func (a *clientAPI) NewUserUniversalTransferService() CreateUserUniversalTransferServiceAPI {
+	return (*createUserUniversalTransferServiceAPI)((*Client)(a).NewUserUniversalTransferService())
+}
+
+func (a *clientAPI) NewGetAllCoinsInfoService() GetAllCoinsInfoServiceAPI {
+	return (*getAllCoinsInfoServiceAPI)((*Client)(a).NewGetAllCoinsInfoService())
+}
+
+func (a *clientAPI) NewGetAllMarginAssetsService() GetAllMarginAssetsServiceAPI {
+	return (*getAllMarginAssetsServiceAPI)((*Client)(a).NewGetAllMarginAssetsService())
+}
+
+func (a *clientAPI) NewFiatDepositWithdrawHistoryService() FiatDepositWithdrawHistoryServiceAPI {
+	return (*fiatDepositWithdrawHistoryServiceAPI)((*Client)(a).NewFiatDepositWithdrawHistoryService())
+}
+
+func (a *clientAPI) NewFiatPaymentsHistoryService() FiatPaymentsHistoryServiceAPI {
+	return (*fiatPaymentsHistoryServiceAPI)((*Client)(a).NewFiatPaymentsHistoryService())
+}
+
+func (a *clientAPI) NewPayTradeHistoryService() PayTradeHistoryServiceAPI {
+	return (*payTradeHistoryServiceAPI)((*Client)(a).NewPayTradeHistoryService())
+}
+
+func (a *clientAPI) NewSpotRebateHistoryService() SpotRebateHistoryServiceAPI {
+	return (*spotRebateHistoryServiceAPI)((*Client)(a).NewSpotRebateHistoryService())
+}
+
+func (a *clientAPI) NewConvertTradeHistoryService() ConvertTradeHistoryServiceAPI {
+	return (*convertTradeHistoryServiceAPI)((*Client)(a).NewConvertTradeHistoryService())
+}
+
+func (a *clientAPI) NewConvertExchangeInfoService() ConvertExchangeInfoServiceAPI {
+	return (*convertExchangeInfoServiceAPI)((*Client)(a).NewConvertExchangeInfoService())
+}
+
+func (a *clientAPI) NewConvertAssetInfoService() ConvertAssetInfoServiceAPI {
+	return (*convertAssetInfoServiceAPI)((*Client)(a).NewConvertAssetInfoService())
+}
+
+func (a *clientAPI) NewConvertQuoteService() ConvertGetQuoteServiceAPI {
+	return (*convertGetQuoteServiceAPI)((*Client)(a).NewConvertQuoteService())
+}
+
+func (a *clientAPI) NewConvertAcceptQuoteService() ConvertAcceptQuoteServiceAPI {
+	return (*convertAcceptQuoteServiceAPI)((*Client)(a).NewConvertAcceptQuoteService())
+}
+
+func (a *clientAPI) NewConvertOrderStatusService() ConvertOrderStatusServiceAPI {
+	return (*convertOrderStatusServiceAPI)((*Client)(a).NewConvertOrderStatusService())
+}
+
+func (a *clientAPI) NewGetIsolatedMarginAllPairsService() GetIsolatedMarginAllPairsServiceAPI {
+	return (*getIsolatedMarginAllPairsServiceAPI)((*Client)(a).NewGetIsolatedMarginAllPairsService())
+}
+
+func (a *clientAPI) NewInterestHistoryService() InterestHistoryServiceAPI {
+	return (*interestHistoryServiceAPI)((*Client)(a).NewInterestHistoryService())
+}
+
+func (a *clientAPI) NewTradeFeeService() TradeFeeServiceAPI {
+	return (*tradeFeeServiceAPI)((*Client)(a).NewTradeFeeService())
+}
+
+func (a *clientAPI) NewC2CTradeHistoryService() C2CTradeHistoryServiceAPI {
+	return (*c2CTradeHistoryServiceAPI)((*Client)(a).NewC2CTradeHistoryService())
+}
+
+func (a *clientAPI) NewStakingProductPositionService() StakingProductPositionServiceAPI {
+	return (*stakingProductPositionServiceAPI)((*Client)(a).NewStakingProductPositionService())
+}
+
+func (a *clientAPI) NewStakingHistoryService() StakingHistoryServiceAPI {
+	return (*stakingHistoryServiceAPI)((*Client)(a).NewStakingHistoryService())
+}
+
+func (a *clientAPI) NewGetAllLiquidityPoolService() GetAllLiquidityPoolServiceAPI {
+	return (*getAllLiquidityPoolServiceAPI)((*Client)(a).NewGetAllLiquidityPoolService())
+}
+
+func (a *clientAPI) NewGetLiquidityPoolDetailService() GetLiquidityPoolDetailServiceAPI {
+	return (*getLiquidityPoolDetailServiceAPI)((*Client)(a).NewGetLiquidityPoolDetailService())
+}
+
+func (a *clientAPI) NewAddLiquidityPreviewService() AddLiquidityPreviewServiceAPI {
+	return (*addLiquidityPreviewServiceAPI)((*Client)(a).NewAddLiquidityPreviewService())
+}
+
+func (a *clientAPI) NewGetSwapQuoteService() GetSwapQuoteServiceAPI {
+	return (*getSwapQuoteServiceAPI)((*Client)(a).NewGetSwapQuoteService())
+}
+
+func (a *clientAPI) NewSwapService() SwapServiceAPI {
+	return (*swapServiceAPI)((*Client)(a).NewSwapService())
+}
+
+func (a *clientAPI) NewAddLiquidityService() AddLiquidityServiceAPI {
+	return (*addLiquidityServiceAPI)((*Client)(a).NewAddLiquidityService())
+}
+
+func (a *clientAPI) NewGetUserSwapRecordsService() GetUserSwapRecordsServiceAPI {
+	return (*getUserSwapRecordsServiceAPI)((*Client)(a).NewGetUserSwapRecordsService())
+}
+
+func (a *clientAPI) NewClaimRewardService() ClaimRewardServiceAPI {
+	return (*claimRewardServiceAPI)((*Client)(a).NewClaimRewardService())
+}
+
+func (a *clientAPI) NewRemoveLiquidityService() RemoveLiquidityServiceAPI {
+	return (*removeLiquidityServiceAPI)((*Client)(a).NewRemoveLiquidityService())
+}
+
+func (a *clientAPI) NewQueryClaimedRewardHistoryService() QueryClaimedRewardHistoryServiceAPI {
+	return (*queryClaimedRewardHistoryServiceAPI)((*Client)(a).NewQueryClaimedRewardHistoryService())
+}
+
+func (a *clientAPI) NewGetBNBBurnService() GetBNBBurnServiceAPI {
+	return (*getBNBBurnServiceAPI)((*Client)(a).NewGetBNBBurnService())
+}
+
+func (a *clientAPI) NewToggleBNBBurnService() ToggleBNBBurnServiceAPI {
+	return (*toggleBNBBurnServiceAPI)((*Client)(a).NewToggleBNBBurnService())
+}
+
+func (a *clientAPI) NewInternalUniversalTransferService() InternalUniversalTransferServiceAPI {
+	return (*internalUniversalTransferServiceAPI)((*Client)(a).NewInternalUniversalTransferService())
+}
+
+func (a *clientAPI) NewInternalUniversalTransferHistoryService() InternalUniversalTransferHistoryServiceAPI {
+	return (*internalUniversalTransferHistoryServiceAPI)((*Client)(a).NewInternalUniversalTransferHistoryService())
+}
+
+func (a *clientAPI) NewSubAccountListService() SubAccountListServiceAPI {
+	return (*subAccountListServiceAPI)((*Client)(a).NewSubAccountListService())
+}
+
+func (a *clientAPI) NewGetUserAsset() GetUserAssetServiceAPI {
+	return (*getUserAssetServiceAPI)((*Client)(a).NewGetUserAsset())
+}
+
+func (a *clientAPI) NewManagedSubAccountDepositService() ManagedSubAccountDepositServiceAPI {
+	return (*managedSubAccountDepositServiceAPI)((*Client)(a).NewManagedSubAccountDepositService())
+}
+
+func (a *clientAPI) NewManagedSubAccountWithdrawalService() ManagedSubAccountWithdrawalServiceAPI {
+	return (*managedSubAccountWithdrawalServiceAPI)((*Client)(a).NewManagedSubAccountWithdrawalService())
+}
+
+func (a *clientAPI) NewManagedSubAccountAssetsService() ManagedSubAccountAssetsServiceAPI {
+	return (*managedSubAccountAssetsServiceAPI)((*Client)(a).NewManagedSubAccountAssetsService())
+}
+
+func (a *clientAPI) NewSubAccountFuturesAccountService() SubAccountFuturesAccountServiceAPI {
+	return (*subAccountFuturesAccountServiceAPI)((*Client)(a).NewSubAccountFuturesAccountService())
+}
+
+func (a *clientAPI) NewSubAccountFuturesSummaryV1Service() SubAccountFuturesSummaryV1ServiceAPI {
+	return (*subAccountFuturesSummaryV1ServiceAPI)((*Client)(a).NewSubAccountFuturesSummaryV1Service())
+}
+
+func (a *clientAPI) NewSubAccountFuturesTransferV1Service() SubAccountFuturesTransferV1ServiceAPI {
+	return (*subAccountFuturesTransferV1ServiceAPI)((*Client)(a).NewSubAccountFuturesTransferV1Service())
+}
+
+func (a *clientAPI) NewSubAccountTransferHistoryService() SubAccountTransferHistoryServiceAPI {
+	return (*subAccountTransferHistoryServiceAPI)((*Client)(a).NewSubAccountTransferHistoryService())
+}
+
+func (a *clientAPI) NewListUserUniversalTransferService() ListUserUniversalTransferServiceAPI {
+	return (*listUserUniversalTransferServiceAPI)((*Client)(a).NewListUserUniversalTransferService())
+}
+
+func (a *clientAPI) NewCreateVirtualSubAccountService() CreateVirtualSubAccountServiceAPI {
+	return (*createVirtualSubAccountServiceAPI)((*Client)(a).NewCreateVirtualSubAccountService())
+}
+
+func (a *clientAPI) NewSubAccountSpotTransferHistoryService() SubAccountSpotTransferHistoryServiceAPI {
+	return (*subAccountSpotTransferHistoryServiceAPI)((*Client)(a).NewSubAccountSpotTransferHistoryService())
+}
+
+func (a *clientAPI) NewSubAccountFuturesTransferHistoryService() SubAccountFuturesTransferHistoryServiceAPI {
+	return (*subAccountFuturesTransferHistoryServiceAPI)((*Client)(a).NewSubAccountFuturesTransferHistoryService())
+}
+
+func (a *clientAPI) NewSubAccountDepositRecordService() SubAccountDepositRecordServiceAPI {
+	return (*subAccountDepositRecordServiceAPI)((*Client)(a).NewSubAccountDepositRecordService())
+}
+
+func (a *clientAPI) NewSubAccountMarginFuturesStatusService() SubAccountMarginFuturesStatusServiceAPI {
+	return (*subAccountMarginFuturesStatusServiceAPI)((*Client)(a).NewSubAccountMarginFuturesStatusService())
+}
+
+func (a *clientAPI) NewSubAccountMarginEnableService() SubAccountMarginEnableServiceAPI {
+	return (*subAccountMarginEnableServiceAPI)((*Client)(a).NewSubAccountMarginEnableService())
+}
+
+func (a *clientAPI) NewSubAccountMarginAccountInfoService() SubAccountMarginAccountInfoServiceAPI {
+	return (*subAccountMarginAccountInfoServiceAPI)((*Client)(a).NewSubAccountMarginAccountInfoService())
+}
+
+func (a *clientAPI) NewSubAccountMarginAccountSummaryService() SubAccountMarginAccountSummaryServiceAPI {
+	return (*subAccountMarginAccountSummaryServiceAPI)((*Client)(a).NewSubAccountMarginAccountSummaryService())
+}
+
+func (a *clientAPI) NewSubAccountFuturesEnableService() SubAccountFuturesEnableServiceAPI {
+	return (*subAccountFuturesEnableServiceAPI)((*Client)(a).NewSubAccountFuturesEnableService())
+}
+
+func (a *clientAPI) NewSubAccountFuturesAccountSummaryService() SubAccountFuturesAccountSummaryServiceAPI {
+	return (*subAccountFuturesAccountSummaryServiceAPI)((*Client)(a).NewSubAccountFuturesAccountSummaryService())
+}
+
+func (a *clientAPI) NewSubAccountFuturesPositionsService() SubAccountFuturesPositionsServiceAPI {
+	return (*subAccountFuturesPositionsServiceAPI)((*Client)(a).NewSubAccountFuturesPositionsService())
+}
+
+func (a *clientAPI) NewSubAccountMarginTransferService() SubAccountMarginTransferServiceAPI {
+	return (*subAccountMarginTransferServiceAPI)((*Client)(a).NewSubAccountMarginTransferService())
+}
+
+func (a *clientAPI) NewSubAccountTransferSubToMasterService() SubAccountTransferSubToMasterServiceAPI {
+	return (*subAccountTransferSubToMasterServiceAPI)((*Client)(a).NewSubAccountTransferSubToMasterService())
+}
+
+func (a *clientAPI) NewSubAccountUniversalTransferService() SubAccountUniversalTransferServiceAPI {
+	return (*subAccountUniversalTransferServiceAPI)((*Client)(a).NewSubAccountUniversalTransferService())
+}
+
+func (a *clientAPI) NewSubAccUniversalTransferHistoryService() SubAccUniversalTransferHistoryServiceAPI {
+	return (*subAccUniversalTransferHistoryServiceAPI)((*Client)(a).NewSubAccUniversalTransferHistoryService())
+}
+
+func (a *clientAPI) NewSubAccountBlvtEnableService() SubAccountBlvtEnableServiceAPI {
+	return (*subAccountBlvtEnableServiceAPI)((*Client)(a).NewSubAccountBlvtEnableService())
+}
+
+func (a *clientAPI) NewSubAccountApiIpRestrictionService() SubAccountApiIpRestrictionServiceAPI {
+	return (*subAccountApiIpRestrictionServiceAPI)((*Client)(a).NewSubAccountApiIpRestrictionService())
+}
+
+func (a *clientAPI) NewSubAccountApiDeleteIpRestrictionService() SubAccountApiDeleteIpRestrictionServiceAPI {
+	return (*subAccountApiDeleteIpRestrictionServiceAPI)((*Client)(a).NewSubAccountApiDeleteIpRestrictionService())
+}
+
+func (a *clientAPI) NewSubAccountApiAddIpRestrictionService() SubAccountApiAddIpRestrictionServiceAPI {
+	return (*subAccountApiAddIpRestrictionServiceAPI)((*Client)(a).NewSubAccountApiAddIpRestrictionService())
+}
+
+func (a *clientAPI) NewManagedSubAccountWithdrawService() ManagedSubAccountWithdrawServiceAPI {
+	return (*managedSubAccountWithdrawServiceAPI)((*Client)(a).NewManagedSubAccountWithdrawService())
+}
+
+func (a *clientAPI) NewManagedSubAccountSnapshotService() ManagedSubAccountSnapshotServiceAPI {
+	return (*managedSubAccountSnapshotServiceAPI)((*Client)(a).NewManagedSubAccountSnapshotService())
+}
+
+func (a *clientAPI) NewManagedSubAccountQueryTransferLogForInvestorService() ManagedSubAccountQueryTransferLogForInvestorServiceAPI {
+	return (*managedSubAccountQueryTransferLogForInvestorServiceAPI)((*Client)(a).NewManagedSubAccountQueryTransferLogForInvestorService())
+}
+
+func (a *clientAPI) NewManagedSubAccountQueryTransferLogForTradeParentService() ManagedSubAccountQueryTransferLogForTradeParentServiceAPI {
+	return (*managedSubAccountQueryTransferLogForTradeParentServiceAPI)((*Client)(a).NewManagedSubAccountQueryTransferLogForTradeParentService())
+}
+
+func (a *clientAPI) NewManagedSubAccountQueryFuturesAssetService() ManagedSubAccountQueryFuturesAssetServiceAPI {
+	return (*managedSubAccountQueryFuturesAssetServiceAPI)((*Client)(a).NewManagedSubAccountQueryFuturesAssetService())
+}
+
+func (a *clientAPI) NewManagedSubAccountQueryMarginAssetService() ManagedSubAccountQueryMarginAssetServiceAPI {
+	return (*managedSubAccountQueryMarginAssetServiceAPI)((*Client)(a).NewManagedSubAccountQueryMarginAssetService())
+}
+
+func (a *clientAPI) NewSubAccountAssetService() SubAccountAssetServiceAPI {
+	return (*subAccountAssetServiceAPI)((*Client)(a).NewSubAccountAssetService())
+}
+
+func (a *clientAPI) NewManagedSubAccountInfoService() ManagedSubAccountInfoServiceAPI {
+	return (*managedSubAccountInfoServiceAPI)((*Client)(a).NewManagedSubAccountInfoService())
+}
+
+func (a *clientAPI) NewManagedSubAccountDepositAddressService() ManagedSubAccountDepositAddressServiceAPI {
+	return (*managedSubAccountDepositAddressServiceAPI)((*Client)(a).NewManagedSubAccountDepositAddressService())
+}
+
+func (a *clientAPI) NewSubAccountOptionsEnableService() SubAccountOptionsEnableServiceAPI {
+	return (*subAccountOptionsEnableServiceAPI)((*Client)(a).NewSubAccountOptionsEnableService())
+}
+
+func (a *clientAPI) NewManagedSubAccountQueryTransferLogService() ManagedSubAccountQueryTransferLogServiceAPI {
+	return (*managedSubAccountQueryTransferLogServiceAPI)((*Client)(a).NewManagedSubAccountQueryTransferLogService())
+}
+
+func (a *clientAPI) NewSubAccountFuturesInternalTransferService() SubAccountFuturesInternalTransferServiceAPI {
+	return (*subAccountFuturesInternalTransferServiceAPI)((*Client)(a).NewSubAccountFuturesInternalTransferService())
+}
+
+func (a *clientAPI) NewSubAccountTransactionStatisticsService() SubAccountTransactionStatisticsServiceAPI {
+	return (*subAccountTransactionStatisticsServiceAPI)((*Client)(a).NewSubAccountTransactionStatisticsService())
+}
+
+func (a *clientAPI) NewSubAccountFuturesAccountV2Service() SubAccountFuturesAccountV2ServiceAPI {
+	return (*subAccountFuturesAccountV2ServiceAPI)((*Client)(a).NewSubAccountFuturesAccountV2Service())
+}
+
+func (a *clientAPI) NewFuturesOrderBookHistoryService() FuturesOrderBookHistoryServiceAPI {
+	return (*futuresOrderBookHistoryServiceAPI)((*Client)(a).NewFuturesOrderBookHistoryService())
+}
+
+func (a *clientAPI) NewCreateFuturesAlgoVpOrderService() CreateFuturesAlgoVpOrderServiceAPI {
+	return (*createFuturesAlgoVpOrderServiceAPI)((*Client)(a).NewCreateFuturesAlgoVpOrderService())
+}
+
+func (a *clientAPI) NewCreateFuturesAlgoTwapOrderService() CreateFuturesAlgoTwapOrderServiceAPI {
+	return (*createFuturesAlgoTwapOrderServiceAPI)((*Client)(a).NewCreateFuturesAlgoTwapOrderService())
+}
+
+func (a *clientAPI) NewListOpenFuturesAlgoOrdersService() ListOpenFuturesAlgoOrdersServiceAPI {
+	return (*listOpenFuturesAlgoOrdersServiceAPI)((*Client)(a).NewListOpenFuturesAlgoOrdersService())
+}
+
+func (a *clientAPI) NewListHistoryFuturesAlgoOrdersService() ListHistoryFuturesAlgoOrdersServiceAPI {
+	return (*listHistoryFuturesAlgoOrdersServiceAPI)((*Client)(a).NewListHistoryFuturesAlgoOrdersService())
+}
+
+func (a *clientAPI) NewCancelFuturesAlgoOrderService() CancelFuturesAlgoOrderServiceAPI {
+	return (*cancelFuturesAlgoOrderServiceAPI)((*Client)(a).NewCancelFuturesAlgoOrderService())
+}
+
+func (a *clientAPI) NewGetFuturesAlgoSubOrdersService() GetFuturesAlgoSubOrdersServiceAPI {
+	return (*getFuturesAlgoSubOrdersServiceAPI)((*Client)(a).NewGetFuturesAlgoSubOrdersService())
+}
+
+func (a *clientAPI) NewSimpleEarnService() SimpleEarnServiceAPI {
+	return (*simpleEarnServiceAPI)((*Client)(a).NewSimpleEarnService())
+}
+
+func (a *clientAPI) NewDualInvestmentService() DualInvestmentServiceAPI {
+	return (*dualInvestmentServiceAPI)((*Client)(a).NewDualInvestmentService())
+}
+
+// PingServiceAPI is the interface of PingService
+type PingServiceAPI interface {
+	Do(ctx context.Context, opts ...RequestOption) error
+}
+
+type pingServiceAPI PingService
+
+func (a *pingServiceAPI) Do(ctx context.Context, opts ...RequestOption) error {
+	return (*PingService)(a).Do(ctx, opts...)
+}
+
+// ServerTimeServiceAPI is the interface of ServerTimeService
+type ServerTimeServiceAPI interface {
+	Do(ctx context.Context, opts ...RequestOption) (int64, error)
+}
+
+type serverTimeServiceAPI ServerTimeService
+
+func (a *serverTimeServiceAPI) Do(ctx context.Context, opts ...RequestOption) (int64, error) {
+	return (*ServerTimeService)(a).Do(ctx, opts...)
+}
+
+// SetServerTimeServiceAPI is the interface of SetServerTimeService
+type SetServerTimeServiceAPI interface {
+	Do(ctx context.Context, opts ...RequestOption) (int64, error)
+}
+
+type setServerTimeServiceAPI SetServerTimeService
+
+func (a *setServerTimeServiceAPI) Do(ctx context.Context, opts ...RequestOption) (int64, error) {
+	return (*SetServerTimeService)(a).Do(ctx, opts...)
+}
+
+// DepthServiceAPI is the interface of DepthService
+type DepthServiceAPI interface {
+	Symbol(symbol string) DepthServiceAPI
+	Limit(limit int) DepthServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) (*DepthResponse, error)
+}
+
+type depthServiceAPI DepthService
+
+func (a *depthServiceAPI) Symbol(symbol string) DepthServiceAPI {
+	return (*depthServiceAPI)((*DepthService)(a).Symbol(symbol))
+}
+
+func (a *depthServiceAPI) Limit(limit int) DepthServiceAPI {
+	return (*depthServiceAPI)((*DepthService)(a).Limit(limit))
+}
+
+func (a *depthServiceAPI) Do(ctx context.Context, opts ...RequestOption) (*DepthResponse, error) {
+	return (*DepthService)(a).Do(ctx, opts...)
+}
+
+// AggTradesServiceAPI is the interface of AggTradesService
+type AggTradesServiceAPI interface {
+	Symbol(symbol string) AggTradesServiceAPI
+	FromID(fromID int64) AggTradesServiceAPI
+	StartTime(startTime int64) AggTradesServiceAPI
+	EndTime(endTime int64) AggTradesServiceAPI
+	Limit(limit int) AggTradesServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) ([]*AggTrade, error)
+}
+
+type aggTradesServiceAPI AggTradesService
+
+func (a *aggTradesServiceAPI) Symbol(symbol string) AggTradesServiceAPI {
+	return (*aggTradesServiceAPI)((*AggTradesService)(a).Symbol(symbol))
+}
+
+func (a *aggTradesServiceAPI) FromID(fromID int64) AggTradesServiceAPI {
+	return (*aggTradesServiceAPI)((*AggTradesService)(a).FromID(fromID))
+}
+
+func (a *aggTradesServiceAPI) StartTime(startTime int64) AggTradesServiceAPI {
+	return (*aggTradesServiceAPI)((*AggTradesService)(a).StartTime(startTime))
+}
+
+func (a *aggTradesServiceAPI) EndTime(endTime int64) AggTradesServiceAPI {
+	return (*aggTradesServiceAPI)((*AggTradesService)(a).EndTime(endTime))
+}
+
+func (a *aggTradesServiceAPI) Limit(limit int) AggTradesServiceAPI {
+	return (*aggTradesServiceAPI)((*AggTradesService)(a).Limit(limit))
+}
+
+func (a *aggTradesServiceAPI) Do(ctx context.Context, opts ...RequestOption) ([]*AggTrade, error) {
+	return (*AggTradesService)(a).Do(ctx, opts...)
+}
+
+// RecentTradesServiceAPI is the interface of RecentTradesService
+type RecentTradesServiceAPI interface {
+	Symbol(symbol string) RecentTradesServiceAPI
+	Limit(limit int) RecentTradesServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) ([]*Trade, error)
+}
+
+type recentTradesServiceAPI RecentTradesService
+
+func (a *recentTradesServiceAPI) Symbol(symbol string) RecentTradesServiceAPI {
+	return (*recentTradesServiceAPI)((*RecentTradesService)(a).Symbol(symbol))
+}
+
+func (a *recentTradesServiceAPI) Limit(limit int) RecentTradesServiceAPI {
+	return (*recentTradesServiceAPI)((*RecentTradesService)(a).Limit(limit))
+}
+
+func (a *recentTradesServiceAPI) Do(ctx context.Context, opts ...RequestOption) ([]*Trade, error) {
+	return (*RecentTradesService)(a).Do(ctx, opts...)
+}
+
+// KlinesServiceAPI is the interface of KlinesService
+type KlinesServiceAPI interface {
+	Symbol(symbol string) KlinesServiceAPI
+	Interval(interval string) KlinesServiceAPI
+	Limit(limit int) KlinesServiceAPI
+	StartTime(startTime int64) KlinesServiceAPI
+	EndTime(endTime int64) KlinesServiceAPI
+	TimeZone(timeZone string) KlinesServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) ([]*Kline, error)
+}
+
+type klinesServiceAPI KlinesService
+
+func (a *klinesServiceAPI) Symbol(symbol string) KlinesServiceAPI {
+	return (*klinesServiceAPI)((*KlinesService)(a).Symbol(symbol))
+}
+
+func (a *klinesServiceAPI) Interval(interval string) KlinesServiceAPI {
+	return (*klinesServiceAPI)((*KlinesService)(a).Interval(interval))
+}
+
+func (a *klinesServiceAPI) Limit(limit int) KlinesServiceAPI {
+	return (*klinesServiceAPI)((*KlinesService)(a).Limit(limit))
+}
+
+func (a *klinesServiceAPI) StartTime(startTime int64) KlinesServiceAPI {
+	return (*klinesServiceAPI)((*KlinesService)(a).StartTime(startTime))
+}
+
+func (a *klinesServiceAPI) EndTime(endTime int64) KlinesServiceAPI {
+	return (*klinesServiceAPI)((*KlinesService)(a).EndTime(endTime))
+}
+
+func (a *klinesServiceAPI) TimeZone(timeZone string) KlinesServiceAPI {
+	return (*klinesServiceAPI)((*KlinesService)(a).TimeZone(timeZone))
+}
+
+func (a *klinesServiceAPI) Do(ctx context.Context, opts ...RequestOption) ([]*Kline, error) {
+	return (*KlinesService)(a).Do(ctx, opts...)
+}
+
+// UiKlinesServiceAPI is the interface of UiKlinesService
+type UiKlinesServiceAPI interface {
+	Symbol(symbol string) UiKlinesServiceAPI
+	Interval(interval string) UiKlinesServiceAPI
+	StartTime(startTime uint64) UiKlinesServiceAPI
+	EndTime(endTime uint64) UiKlinesServiceAPI
+	TimeZone(timeZone string) UiKlinesServiceAPI
+	Limit(limit uint32) UiKlinesServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) ([]*UiKline, error)
+}
+
+type uiKlinesServiceAPI UiKlinesService
+
+func (a *uiKlinesServiceAPI) Symbol(symbol string) UiKlinesServiceAPI {
+	return (*uiKlinesServiceAPI)((*UiKlinesService)(a).Symbol(symbol))
+}
+
+func (a *uiKlinesServiceAPI) Interval(interval string) UiKlinesServiceAPI {
+	return (*uiKlinesServiceAPI)((*UiKlinesService)(a).Interval(interval))
+}
+
+func (a *uiKlinesServiceAPI) StartTime(startTime uint64) UiKlinesServiceAPI {
+	return (*uiKlinesServiceAPI)((*UiKlinesService)(a).StartTime(startTime))
+}
+
+func (a *uiKlinesServiceAPI) EndTime(endTime uint64) UiKlinesServiceAPI {
+	return (*uiKlinesServiceAPI)((*UiKlinesService)(a).EndTime(endTime))
+}
+
+func (a *uiKlinesServiceAPI) TimeZone(timeZone string) UiKlinesServiceAPI {
+	return (*uiKlinesServiceAPI)((*UiKlinesService)(a).TimeZone(timeZone))
+}
+
+func (a *uiKlinesServiceAPI) Limit(limit uint32) UiKlinesServiceAPI {
+	return (*uiKlinesServiceAPI)((*UiKlinesService)(a).Limit(limit))
+}
+
+func (a *uiKlinesServiceAPI) Do(ctx context.Context, opts ...RequestOption) ([]*UiKline, error) {
+	return (*UiKlinesService)(a).Do(ctx, opts...)
+}
+
+// ListPriceChangeStatsServiceAPI is the interface of ListPriceChangeStatsService
+type ListPriceChangeStatsServiceAPI interface {
+	Symbol(symbol string) ListPriceChangeStatsServiceAPI
+	Symbols(symbols []string) ListPriceChangeStatsServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) ([]*PriceChangeStats, error)
+}
+
+type listPriceChangeStatsServiceAPI ListPriceChangeStatsService
+
+func (a *listPriceChangeStatsServiceAPI) Symbol(symbol string) ListPriceChangeStatsServiceAPI {
+	return (*listPriceChangeStatsServiceAPI)((*ListPriceChangeStatsService)(a).Symbol(symbol))
+}
+
+func (a *listPriceChangeStatsServiceAPI) Symbols(symbols []string) ListPriceChangeStatsServiceAPI {
+	return (*listPriceChangeStatsServiceAPI)((*ListPriceChangeStatsService)(a).Symbols(symbols))
+}
+
+func (a *listPriceChangeStatsServiceAPI) Do(ctx context.Context, opts ...RequestOption) ([]*PriceChangeStats, error) {
+	return (*ListPriceChangeStatsService)(a).Do(ctx, opts...)
+}
+
+// ListPricesServiceAPI is the interface of ListPricesService
+type ListPricesServiceAPI interface {
+	Symbol(symbol string) ListPricesServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) ([]*SymbolPrice, error)
+	Symbols(symbols []string) ListPricesServiceAPI
+}
+
+type listPricesServiceAPI ListPricesService
+
+func (a *listPricesServiceAPI) Symbol(symbol string) ListPricesServiceAPI {
+	return (*listPricesServiceAPI)((*ListPricesService)(a).Symbol(symbol))
+}
+
+func (a *listPricesServiceAPI) Do(ctx context.Context, opts ...RequestOption) ([]*SymbolPrice, error) {
+	return (*ListPricesService)(a).Do(ctx, opts...)
+}
+
+func (a *listPricesServiceAPI) Symbols(symbols []string) ListPricesServiceAPI {
+	return (*listPricesServiceAPI)((*ListPricesService)(a).Symbols(symbols))
+}
+
+// TradingDayTickerServiceAPI is the interface of TradingDayTickerService
+type TradingDayTickerServiceAPI interface {
+	Symbol(symbol string) TradingDayTickerServiceAPI
+	Symbols(symbols []string) TradingDayTickerServiceAPI
+	TimeZone(timeZone string) TradingDayTickerServiceAPI
+	TickerType(tickerType string) TradingDayTickerServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) ([]*TradingDayTicker, error)
+}
+
+type tradingDayTickerServiceAPI TradingDayTickerService
+
+func (a *tradingDayTickerServiceAPI) Symbol(symbol string) TradingDayTickerServiceAPI {
+	return (*tradingDayTickerServiceAPI)((*TradingDayTickerService)(a).Symbol(symbol))
+}
+
+func (a *tradingDayTickerServiceAPI) Symbols(symbols []string) TradingDayTickerServiceAPI {
+	return (*tradingDayTickerServiceAPI)((*TradingDayTickerService)(a).Symbols(symbols))
+}
+
+func (a *tradingDayTickerServiceAPI) TimeZone(timeZone string) TradingDayTickerServiceAPI {
+	return (*tradingDayTickerServiceAPI)((*TradingDayTickerService)(a).TimeZone(timeZone))
+}
+
+func (a *tradingDayTickerServiceAPI) TickerType(tickerType string) TradingDayTickerServiceAPI {
+	return (*tradingDayTickerServiceAPI)((*TradingDayTickerService)(a).TickerType(tickerType))
+}
+
+func (a *tradingDayTickerServiceAPI) Do(ctx context.Context, opts ...RequestOption) ([]*TradingDayTicker, error) {
+	return (*TradingDayTickerService)(a).Do(ctx, opts...)
+}
+
+// ListBookTickersServiceAPI is the interface of ListBookTickersService
+type ListBookTickersServiceAPI interface {
+	Symbol(symbol string) ListBookTickersServiceAPI
+	Symbols(symbols ...string) ListBookTickersServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) ([]*BookTicker, error)
+}
+
+type listBookTickersServiceAPI ListBookTickersService
+
+func (a *listBookTickersServiceAPI) Symbol(symbol string) ListBookTickersServiceAPI {
+	return (*listBookTickersServiceAPI)((*ListBookTickersService)(a).Symbol(symbol))
+}
+
+func (a *listBookTickersServiceAPI) Symbols(symbols ...string) ListBookTickersServiceAPI {
+	return (*listBookTickersServiceAPI)((*ListBookTickersService)(a).Symbols(symbols...))
+}
+
+func (a *listBookTickersServiceAPI) Do(ctx context.Context, opts ...RequestOption) ([]*BookTicker, error) {
+	return (*ListBookTickersService)(a).Do(ctx, opts...)
+}
+
+// ListSymbolTickerServiceAPI is the interface of ListSymbolTickerService
+type ListSymbolTickerServiceAPI interface {
+	Symbol(symbol string) ListSymbolTickerServiceAPI
+	Symbols(symbols []string) ListSymbolTickerServiceAPI
+	WindowSize(windowSize string) ListSymbolTickerServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) ([]*SymbolTicker, error)
+}
+
+type listSymbolTickerServiceAPI ListSymbolTickerService
+
+func (a *listSymbolTickerServiceAPI) Symbol(symbol string) ListSymbolTickerServiceAPI {
+	return (*listSymbolTickerServiceAPI)((*ListSymbolTickerService)(a).Symbol(symbol))
+}
+
+func (a *listSymbolTickerServiceAPI) Symbols(symbols []string) ListSymbolTickerServiceAPI {
+	return (*listSymbolTickerServiceAPI)((*ListSymbolTickerService)(a).Symbols(symbols))
+}
+
+func (a *listSymbolTickerServiceAPI) WindowSize(windowSize string) ListSymbolTickerServiceAPI {
+	return (*listSymbolTickerServiceAPI)((*ListSymbolTickerService)(a).WindowSize(windowSize))
+}
+
+func (a *listSymbolTickerServiceAPI) Do(ctx context.Context, opts ...RequestOption) ([]*SymbolTicker, error) {
+	return (*ListSymbolTickerService)(a).Do(ctx, opts...)
+}
+
+// CreateOrderServiceAPI is the interface of CreateOrderService
+type CreateOrderServiceAPI interface {
+	Symbol(symbol string) CreateOrderServiceAPI
+	Side(side SideType) CreateOrderServiceAPI
+	Type(orderType OrderType) CreateOrderServiceAPI
+	TimeInForce(timeInForce TimeInForceType) CreateOrderServiceAPI
+	Quantity(quantity string) CreateOrderServiceAPI
+	QuoteOrderQty(quoteOrderQty string) CreateOrderServiceAPI
+	Price(price string) CreateOrderServiceAPI
+	NewClientOrderID(newClientOrderID string) CreateOrderServiceAPI
+	StopPrice(stopPrice string) CreateOrderServiceAPI
+	TrailingDelta(trailingDelta string) CreateOrderServiceAPI
+	IcebergQuantity(icebergQuantity string) CreateOrderServiceAPI
+	NewOrderRespType(newOrderRespType NewOrderRespType) CreateOrderServiceAPI
+	SelfTradePreventionMode(selfTradePreventionMode SelfTradePreventionMode) CreateOrderServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) (*CreateOrderResponse, error)
+	Test(ctx context.Context, opts ...RequestOption) error
+}
+
+type createOrderServiceAPI CreateOrderService
+
+func (a *createOrderServiceAPI) Symbol(symbol string) CreateOrderServiceAPI {
+	return (*createOrderServiceAPI)((*CreateOrderService)(a).Symbol(symbol))
+}
+
+func (a *createOrderServiceAPI) Side(side SideType) CreateOrderServiceAPI {
+	return (*createOrderServiceAPI)((*CreateOrderService)(a).Side(side))
+}
+
+func (a *createOrderServiceAPI) Type(orderType OrderType) CreateOrderServiceAPI {
+	return (*createOrderServiceAPI)((*CreateOrderService)(a).Type(orderType))
+}
+
+func (a *createOrderServiceAPI) TimeInForce(timeInForce TimeInForceType) CreateOrderServiceAPI {
+	return (*createOrderServiceAPI)((*CreateOrderService)(a).TimeInForce(timeInForce))
+}
+
+func (a *createOrderServiceAPI) Quantity(quantity string) CreateOrderServiceAPI {
+	return (*createOrderServiceAPI)((*CreateOrderService)(a).Quantity(quantity))
+}
+
+func (a *createOrderServiceAPI) QuoteOrderQty(quoteOrderQty string) CreateOrderServiceAPI {
+	return (*createOrderServiceAPI)((*CreateOrderService)(a).QuoteOrderQty(quoteOrderQty))
+}
+
+func (a *createOrderServiceAPI) Price(price string) CreateOrderServiceAPI {
+	return (*createOrderServiceAPI)((*CreateOrderService)(a).Price(price))
+}
+
+func (a *createOrderServiceAPI) NewClientOrderID(newClientOrderID string) CreateOrderServiceAPI {
+	return (*createOrderServiceAPI)((*CreateOrderService)(a).NewClientOrderID(newClientOrderID))
+}
+
+func (a *createOrderServiceAPI) StopPrice(stopPrice string) CreateOrderServiceAPI {
+	return (*createOrderServiceAPI)((*CreateOrderService)(a).StopPrice(stopPrice))
+}
+
+func (a *createOrderServiceAPI) TrailingDelta(trailingDelta string) CreateOrderServiceAPI {
+	return (*createOrderServiceAPI)((*CreateOrderService)(a).TrailingDelta(trailingDelta))
+}
+
+func (a *createOrderServiceAPI) IcebergQuantity(icebergQuantity string) CreateOrderServiceAPI {
+	return (*createOrderServiceAPI)((*CreateOrderService)(a).IcebergQuantity(icebergQuantity))
+}
+
+func (a *createOrderServiceAPI) NewOrderRespType(newOrderRespType NewOrderRespType) CreateOrderServiceAPI {
+	return (*createOrderServiceAPI)((*CreateOrderService)(a).NewOrderRespType(newOrderRespType))
+}
+
+func (a *createOrderServiceAPI) SelfTradePreventionMode(selfTradePreventionMode SelfTradePreventionMode) CreateOrderServiceAPI {
+	return (*createOrderServiceAPI)((*CreateOrderService)(a).SelfTradePreventionMode(selfTradePreventionMode))
+}
+
+func (a *createOrderServiceAPI) Do(ctx context.Context, opts ...RequestOption) (*CreateOrderResponse, error) {
+	return (*CreateOrderService)(a).Do(ctx, opts...)
+}
+
+func (a *createOrderServiceAPI) Test(ctx context.Context, opts ...RequestOption) error {
+	return (*CreateOrderService)(a).Test(ctx, opts...)
+}
+
+// CreateOCOServiceAPI is the interface of CreateOCOService
+type CreateOCOServiceAPI interface {
+	Symbol(symbol string) CreateOCOServiceAPI
+	Side(side SideType) CreateOCOServiceAPI
+	Quantity(quantity string) CreateOCOServiceAPI
+	ListClientOrderID(listClientOrderID string) CreateOCOServiceAPI
+	LimitClientOrderID(limitClientOrderID string) CreateOCOServiceAPI
+	Price(price string) CreateOCOServiceAPI
+	LimitIcebergQuantity(limitIcebergQty string) CreateOCOServiceAPI
+	StopClientOrderID(stopClientOrderID string) CreateOCOServiceAPI
+	StopPrice(stopPrice string) CreateOCOServiceAPI
+	StopLimitPrice(stopLimitPrice string) CreateOCOServiceAPI
+	StopIcebergQty(stopIcebergQty string) CreateOCOServiceAPI
+	StopLimitTimeInForce(stopLimitTimeInForce TimeInForceType) CreateOCOServiceAPI
+	NewOrderRespType(newOrderRespType NewOrderRespType) CreateOCOServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) (*CreateOCOResponse, error)
+}
+
+type createOCOServiceAPI CreateOCOService
+
+func (a *createOCOServiceAPI) Symbol(symbol string) CreateOCOServiceAPI {
+	return (*createOCOServiceAPI)((*CreateOCOService)(a).Symbol(symbol))
+}
+
+func (a *createOCOServiceAPI) Side(side SideType) CreateOCOServiceAPI {
+	return (*createOCOServiceAPI)((*CreateOCOService)(a).Side(side))
+}
+
+func (a *createOCOServiceAPI) Quantity(quantity string) CreateOCOServiceAPI {
+	return (*createOCOServiceAPI)((*CreateOCOService)(a).Quantity(quantity))
+}
+
+func (a *createOCOServiceAPI) ListClientOrderID(listClientOrderID string) CreateOCOServiceAPI {
+	return (*createOCOServiceAPI)((*CreateOCOService)(a).ListClientOrderID(listClientOrderID))
+}
+
+func (a *createOCOServiceAPI) LimitClientOrderID(limitClientOrderID string) CreateOCOServiceAPI {
+	return (*createOCOServiceAPI)((*CreateOCOService)(a).LimitClientOrderID(limitClientOrderID))
+}
+
+func (a *createOCOServiceAPI) Price(price string) CreateOCOServiceAPI {
+	return (*createOCOServiceAPI)((*CreateOCOService)(a).Price(price))
+}
+
+func (a *createOCOServiceAPI) LimitIcebergQuantity(limitIcebergQty string) CreateOCOServiceAPI {
+	return (*createOCOServiceAPI)((*CreateOCOService)(a).LimitIcebergQuantity(limitIcebergQty))
+}
+
+func (a *createOCOServiceAPI) StopClientOrderID(stopClientOrderID string) CreateOCOServiceAPI {
+	return (*createOCOServiceAPI)((*CreateOCOService)(a).StopClientOrderID(stopClientOrderID))
+}
+
+func (a *createOCOServiceAPI) StopPrice(stopPrice string) CreateOCOServiceAPI {
+	return (*createOCOServiceAPI)((*CreateOCOService)(a).StopPrice(stopPrice))
+}
+
+func (a *createOCOServiceAPI) StopLimitPrice(stopLimitPrice string) CreateOCOServiceAPI {
+	return (*createOCOServiceAPI)((*CreateOCOService)(a).StopLimitPrice(stopLimitPrice))
+}
+
+func (a *createOCOServiceAPI) StopIcebergQty(stopIcebergQty string) CreateOCOServiceAPI {
+	return (*createOCOServiceAPI)((*CreateOCOService)(a).StopIcebergQty(stopIcebergQty))
+}
+
+func (a *createOCOServiceAPI) StopLimitTimeInForce(stopLimitTimeInForce TimeInForceType) CreateOCOServiceAPI {
+	return (*createOCOServiceAPI)((*CreateOCOService)(a).StopLimitTimeInForce(stopLimitTimeInForce))
+}
+
+func (a *createOCOServiceAPI) NewOrderRespType(newOrderRespType NewOrderRespType) CreateOCOServiceAPI {
+	return (*createOCOServiceAPI)((*CreateOCOService)(a).NewOrderRespType(newOrderRespType))
+}
+
+func (a *createOCOServiceAPI) Do(ctx context.Context, opts ...RequestOption) (*CreateOCOResponse, error) {
+	return (*CreateOCOService)(a).Do(ctx, opts...)
+}
+
+// CancelOCOServiceAPI is the interface of CancelOCOService
+type CancelOCOServiceAPI interface {
+	Symbol(symbol string) CancelOCOServiceAPI
+	ListClientOrderID(listClientOrderID string) CancelOCOServiceAPI
+	OrderListID(orderListID int64) CancelOCOServiceAPI
+	NewClientOrderID(newClientOrderID string) CancelOCOServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) (*CancelOCOResponse, error)
+}
+
+type cancelOCOServiceAPI CancelOCOService
+
+func (a *cancelOCOServiceAPI) Symbol(symbol string) CancelOCOServiceAPI {
+	return (*cancelOCOServiceAPI)((*CancelOCOService)(a).Symbol(symbol))
+}
+
+func (a *cancelOCOServiceAPI) ListClientOrderID(listClientOrderID string) CancelOCOServiceAPI {
+	return (*cancelOCOServiceAPI)((*CancelOCOService)(a).ListClientOrderID(listClientOrderID))
+}
+
+func (a *cancelOCOServiceAPI) OrderListID(orderListID int64) CancelOCOServiceAPI {
+	return (*cancelOCOServiceAPI)((*CancelOCOService)(a).OrderListID(orderListID))
+}
+
+func (a *cancelOCOServiceAPI) NewClientOrderID(newClientOrderID string) CancelOCOServiceAPI {
+	return (*cancelOCOServiceAPI)((*CancelOCOService)(a).NewClientOrderID(newClientOrderID))
+}
+
+func (a *cancelOCOServiceAPI) Do(ctx context.Context, opts ...RequestOption) (*CancelOCOResponse, error) {
+	return (*CancelOCOService)(a).Do(ctx, opts...)
+}
+
+// GetOrderServiceAPI is the interface of GetOrderService
+type GetOrderServiceAPI interface {
+	Symbol(symbol string) GetOrderServiceAPI
+	OrderID(orderID int64) GetOrderServiceAPI
+	OrigClientOrderID(origClientOrderID string) GetOrderServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) (*Order, error)
+}
+
+type getOrderServiceAPI GetOrderService
+
+func (a *getOrderServiceAPI) Symbol(symbol string) GetOrderServiceAPI {
+	return (*getOrderServiceAPI)((*GetOrderService)(a).Symbol(symbol))
+}
+
+func (a *getOrderServiceAPI) OrderID(orderID int64) GetOrderServiceAPI {
+	return (*getOrderServiceAPI)((*GetOrderService)(a).OrderID(orderID))
+}
+
+func (a *getOrderServiceAPI) OrigClientOrderID(origClientOrderID string) GetOrderServiceAPI {
+	return (*getOrderServiceAPI)((*GetOrderService)(a).OrigClientOrderID(origClientOrderID))
+}
+
+func (a *getOrderServiceAPI) Do(ctx context.Context, opts ...RequestOption) (*Order, error) {
+	return (*GetOrderService)(a).Do(ctx, opts...)
+}
+
+// CancelOrderServiceAPI is the interface of CancelOrderService
+type CancelOrderServiceAPI interface {
+	Symbol(symbol string) CancelOrderServiceAPI
+	OrderID(orderID int64) CancelOrderServiceAPI
+	OrigClientOrderID(origClientOrderID string) CancelOrderServiceAPI
+	NewClientOrderID(newClientOrderID string) CancelOrderServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) (*CancelOrderResponse, error)
+}
+
+type cancelOrderServiceAPI CancelOrderService
+
+func (a *cancelOrderServiceAPI) Symbol(symbol string) CancelOrderServiceAPI {
+	return (*cancelOrderServiceAPI)((*CancelOrderService)(a).Symbol(symbol))
+}
+
+func (a *cancelOrderServiceAPI) OrderID(orderID int64) CancelOrderServiceAPI {
+	return (*cancelOrderServiceAPI)((*CancelOrderService)(a).OrderID(orderID))
+}
+
+func (a *cancelOrderServiceAPI) OrigClientOrderID(origClientOrderID string) CancelOrderServiceAPI {
+	return (*cancelOrderServiceAPI)((*CancelOrderService)(a).OrigClientOrderID(origClientOrderID))
+}
+
+func (a *cancelOrderServiceAPI) NewClientOrderID(newClientOrderID string) CancelOrderServiceAPI {
+	return (*cancelOrderServiceAPI)((*CancelOrderService)(a).NewClientOrderID(newClientOrderID))
+}
+
+func (a *cancelOrderServiceAPI) Do(ctx context.Context, opts ...RequestOption) (*CancelOrderResponse, error) {
+	return (*CancelOrderService)(a).Do(ctx, opts...)
+}
+
+// CancelReplaceOrderServiceAPI is the interface of CancelReplaceOrderService
+type CancelReplaceOrderServiceAPI interface {
+	Symbol(symbol string) CancelReplaceOrderServiceAPI
+	Side(side SideType) CancelReplaceOrderServiceAPI
+	Type(orderType OrderType) CancelReplaceOrderServiceAPI
+	CancelReplaceMode(cancelReplaceMode CancelReplaceMode) CancelReplaceOrderServiceAPI
+	TimeInForce(timeInForce TimeInForceType) CancelReplaceOrderServiceAPI
+	Quantity(quantity string) CancelReplaceOrderServiceAPI
+	QuoteOrderQty(quoteOrderQty string) CancelReplaceOrderServiceAPI
+	Price(price string) CancelReplaceOrderServiceAPI
+	NewClientOrderID(newClientOrderID string) CancelReplaceOrderServiceAPI
+	StopPrice(stopPrice string) CancelReplaceOrderServiceAPI
+	TrailingDelta(trailingDelta string) CancelReplaceOrderServiceAPI
+	IcebergQuantity(icebergQuantity string) CancelReplaceOrderServiceAPI
+	NewOrderRespType(newOrderRespType NewOrderRespType) CancelReplaceOrderServiceAPI
+	SelfTradePreventionMode(selfTradePreventionMode SelfTradePreventionMode) CancelReplaceOrderServiceAPI
+	CancelOrderID(cancelOrderID int64) CancelReplaceOrderServiceAPI
+	CancelOrigClientOrderID(cancelOrigClientOrderID string) CancelReplaceOrderServiceAPI
+	CancelNewClientOrderID(cancelNewClientOrderID string) CancelReplaceOrderServiceAPI
+	StrategyId(strategyId int64) CancelReplaceOrderServiceAPI
+	StrategyType(strategyType int64) CancelReplaceOrderServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) (*CancelReplaceOrderResponse, error)
+}
+
+type cancelReplaceOrderServiceAPI CancelReplaceOrderService
+
+func (a *cancelReplaceOrderServiceAPI) Symbol(symbol string) CancelReplaceOrderServiceAPI {
+	return (*cancelReplaceOrderServiceAPI)((*CancelReplaceOrderService)(a).Symbol(symbol))
+}
+
+func (a *cancelReplaceOrderServiceAPI) Side(side SideType) CancelReplaceOrderServiceAPI {
+	return (*cancelReplaceOrderServiceAPI)((*CancelReplaceOrderService)(a).Side(side))
+}
+
+func (a *cancelReplaceOrderServiceAPI) Type(orderType OrderType) CancelReplaceOrderServiceAPI {
+	return (*cancelReplaceOrderServiceAPI)((*CancelReplaceOrderService)(a).Type(orderType))
+}
+
+func (a *cancelReplaceOrderServiceAPI) CancelReplaceMode(cancelReplaceMode CancelReplaceMode) CancelReplaceOrderServiceAPI {
+	return (*cancelReplaceOrderServiceAPI)((*CancelReplaceOrderService)(a).CancelReplaceMode(cancelReplaceMode))
+}
+
+func (a *cancelReplaceOrderServiceAPI) TimeInForce(timeInForce TimeInForceType) CancelReplaceOrderServiceAPI {
+	return (*cancelReplaceOrderServiceAPI)((*CancelReplaceOrderService)(a).TimeInForce(timeInForce))
+}
+
+func (a *cancelReplaceOrderServiceAPI) Quantity(quantity string) CancelReplaceOrderServiceAPI {
+	return (*cancelReplaceOrderServiceAPI)((*CancelReplaceOrderService)(a).Quantity(quantity))
+}
+
+func (a *cancelReplaceOrderServiceAPI) QuoteOrderQty(quoteOrderQty string) CancelReplaceOrderServiceAPI {
+	return (*cancelReplaceOrderServiceAPI)((*CancelReplaceOrderService)(a).QuoteOrderQty(quoteOrderQty))
+}
+
+func (a *cancelReplaceOrderServiceAPI) Price(price string) CancelReplaceOrderServiceAPI {
+	return (*cancelReplaceOrderServiceAPI)((*CancelReplaceOrderService)(a).Price(price))
+}
+
+func (a *cancelReplaceOrderServiceAPI) NewClientOrderID(newClientOrderID string) CancelReplaceOrderServiceAPI {
+	return (*cancelReplaceOrderServiceAPI)((*CancelReplaceOrderService)(a).NewClientOrderID(newClientOrderID))
+}
+
+func (a *cancelReplaceOrderServiceAPI) StopPrice(stopPrice string) CancelReplaceOrderServiceAPI {
+	return (*cancelReplaceOrderServiceAPI)((*CancelReplaceOrderService)(a).StopPrice(stopPrice))
+}
+
+func (a *cancelReplaceOrderServiceAPI) TrailingDelta(trailingDelta string) CancelReplaceOrderServiceAPI {
+	return (*cancelReplaceOrderServiceAPI)((*CancelReplaceOrderService)(a).TrailingDelta(trailingDelta))
+}
+
+func (a *cancelReplaceOrderServiceAPI) IcebergQuantity(icebergQuantity string) CancelReplaceOrderServiceAPI {
+	return (*cancelReplaceOrderServiceAPI)((*CancelReplaceOrderService)(a).IcebergQuantity(icebergQuantity))
+}
+
+func (a *cancelReplaceOrderServiceAPI) NewOrderRespType(newOrderRespType NewOrderRespType) CancelReplaceOrderServiceAPI {
+	return (*cancelReplaceOrderServiceAPI)((*CancelReplaceOrderService)(a).NewOrderRespType(newOrderRespType))
+}
+
+func (a *cancelReplaceOrderServiceAPI) SelfTradePreventionMode(selfTradePreventionMode SelfTradePreventionMode) CancelReplaceOrderServiceAPI {
+	return (*cancelReplaceOrderServiceAPI)((*CancelReplaceOrderService)(a).SelfTradePreventionMode(selfTradePreventionMode))
+}
+
+func (a *cancelReplaceOrderServiceAPI) CancelOrderID(cancelOrderID int64) CancelReplaceOrderServiceAPI {
+	return (*cancelReplaceOrderServiceAPI)((*CancelReplaceOrderService)(a).CancelOrderID(cancelOrderID))
+}
+
+func (a *cancelReplaceOrderServiceAPI) CancelOrigClientOrderID(cancelOrigClientOrderID string) CancelReplaceOrderServiceAPI {
+	return (*cancelReplaceOrderServiceAPI)((*CancelReplaceOrderService)(a).CancelOrigClientOrderID(cancelOrigClientOrderID))
+}
+
+func (a *cancelReplaceOrderServiceAPI) CancelNewClientOrderID(cancelNewClientOrderID string) CancelReplaceOrderServiceAPI {
+	return (*cancelReplaceOrderServiceAPI)((*CancelReplaceOrderService)(a).CancelNewClientOrderID(cancelNewClientOrderID))
+}
+
+func (a *cancelReplaceOrderServiceAPI) StrategyId(strategyId int64) CancelReplaceOrderServiceAPI {
+	return (*cancelReplaceOrderServiceAPI)((*CancelReplaceOrderService)(a).StrategyId(strategyId))
+}
+
+func (a *cancelReplaceOrderServiceAPI) StrategyType(strategyType int64) CancelReplaceOrderServiceAPI {
+	return (*cancelReplaceOrderServiceAPI)((*CancelReplaceOrderService)(a).StrategyType(strategyType))
+}
+
+func (a *cancelReplaceOrderServiceAPI) Do(ctx context.Context, opts ...RequestOption) (*CancelReplaceOrderResponse, error) {
+	return (*CancelReplaceOrderService)(a).Do(ctx, opts...)
+}
+
+// CancelOpenOrdersServiceAPI is the interface of CancelOpenOrdersService
+type CancelOpenOrdersServiceAPI interface {
+	Symbol(symbol string) CancelOpenOrdersServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) (*CancelOpenOrdersResponse, error)
+}
+
+type cancelOpenOrdersServiceAPI CancelOpenOrdersService
+
+func (a *cancelOpenOrdersServiceAPI) Symbol(symbol string) CancelOpenOrdersServiceAPI {
+	return (*cancelOpenOrdersServiceAPI)((*CancelOpenOrdersService)(a).Symbol(symbol))
+}
+
+func (a *cancelOpenOrdersServiceAPI) Do(ctx context.Context, opts ...RequestOption) (*CancelOpenOrdersResponse, error) {
+	return (*CancelOpenOrdersService)(a).Do(ctx, opts...)
+}
+
+// ListOpenOrdersServiceAPI is the interface of ListOpenOrdersService
+type ListOpenOrdersServiceAPI interface {
+	Symbol(symbol string) ListOpenOrdersServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) ([]*Order, error)
+}
+
+type listOpenOrdersServiceAPI ListOpenOrdersService
+
+func (a *listOpenOrdersServiceAPI) Symbol(symbol string) ListOpenOrdersServiceAPI {
+	return (*listOpenOrdersServiceAPI)((*ListOpenOrdersService)(a).Symbol(symbol))
+}
+
+func (a *listOpenOrdersServiceAPI) Do(ctx context.Context, opts ...RequestOption) ([]*Order, error) {
+	return (*ListOpenOrdersService)(a).Do(ctx, opts...)
+}
+
+// ListOpenOcoServiceAPI is the interface of ListOpenOcoService
+type ListOpenOcoServiceAPI interface {
+	Do(ctx context.Context, opts ...RequestOption) ([]*Oco, error)
+}
+
+type listOpenOcoServiceAPI ListOpenOcoService
+
+func (a *listOpenOcoServiceAPI) Do(ctx context.Context, opts ...RequestOption) ([]*Oco, error) {
+	return (*ListOpenOcoService)(a).Do(ctx, opts...)
+}
+
+// ListOrdersServiceAPI is the interface of ListOrdersService
+type ListOrdersServiceAPI interface {
+	Symbol(symbol string) ListOrdersServiceAPI
+	OrderID(orderID int64) ListOrdersServiceAPI
+	StartTime(startTime int64) ListOrdersServiceAPI
+	EndTime(endTime int64) ListOrdersServiceAPI
+	Limit(limit int) ListOrdersServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) ([]*Order, error)
+}
+
+type listOrdersServiceAPI ListOrdersService
+
+func (a *listOrdersServiceAPI) Symbol(symbol string) ListOrdersServiceAPI {
+	return (*listOrdersServiceAPI)((*ListOrdersService)(a).Symbol(symbol))
+}
+
+func (a *listOrdersServiceAPI) OrderID(orderID int64) ListOrdersServiceAPI {
+	return (*listOrdersServiceAPI)((*ListOrdersService)(a).OrderID(orderID))
+}
+
+func (a *listOrdersServiceAPI) StartTime(startTime int64) ListOrdersServiceAPI {
+	return (*listOrdersServiceAPI)((*ListOrdersService)(a).StartTime(startTime))
+}
+
+func (a *listOrdersServiceAPI) EndTime(endTime int64) ListOrdersServiceAPI {
+	return (*listOrdersServiceAPI)((*ListOrdersService)(a).EndTime(endTime))
+}
+
+func (a *listOrdersServiceAPI) Limit(limit int) ListOrdersServiceAPI {
+	return (*listOrdersServiceAPI)((*ListOrdersService)(a).Limit(limit))
+}
+
+func (a *listOrdersServiceAPI) Do(ctx context.Context, opts ...RequestOption) ([]*Order, error) {
+	return (*ListOrdersService)(a).Do(ctx, opts...)
+}
+
+// GetAccountServiceAPI is the interface of GetAccountService
+type GetAccountServiceAPI interface {
+	OmitZeroBalances(v bool) GetAccountServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) (*Account, error)
+}
+
+type getAccountServiceAPI GetAccountService
+
+func (a *getAccountServiceAPI) OmitZeroBalances(v bool) GetAccountServiceAPI {
+	return (*getAccountServiceAPI)((*GetAccountService)(a).OmitZeroBalances(v))
+}
+
+func (a *getAccountServiceAPI) Do(ctx context.Context, opts ...RequestOption) (*Account, error) {
+	return (*GetAccountService)(a).Do(ctx, opts...)
+}
+
+// GetCommissionRatesServiceAPI is the interface of GetCommissionRatesService
+type GetCommissionRatesServiceAPI interface {
+	Symbol(symbol string) GetCommissionRatesServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) (*CommissionRatesResponse, error)
+}
+
+type getCommissionRatesServiceAPI GetCommissionRatesService
+
+func (a *getCommissionRatesServiceAPI) Symbol(symbol string) GetCommissionRatesServiceAPI {
+	return (*getCommissionRatesServiceAPI)((*GetCommissionRatesService)(a).Symbol(symbol))
+}
+
+func (a *getCommissionRatesServiceAPI) Do(ctx context.Context, opts ...RequestOption) (*CommissionRatesResponse, error) {
+	return (*GetCommissionRatesService)(a).Do(ctx, opts...)
+}
+
+// GetAPIKeyPermissionAPI is the interface of GetAPIKeyPermission
+type GetAPIKeyPermissionAPI interface {
+	Do(ctx context.Context, opts ...RequestOption) (*APIKeyPermission, error)
+}
+
+type getAPIKeyPermissionAPI GetAPIKeyPermission
+
+func (a *getAPIKeyPermissionAPI) Do(ctx context.Context, opts ...RequestOption) (*APIKeyPermission, error) {
+	return (*GetAPIKeyPermission)(a).Do(ctx, opts...)
+}
+
+// SavingFlexibleProductPositionsServiceAPI is the interface of SavingFlexibleProductPositionsService
+type SavingFlexibleProductPositionsServiceAPI interface {
+	Asset(asset string) SavingFlexibleProductPositionsServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) ([]*SavingFlexibleProductPosition, error)
+}
+
+type savingFlexibleProductPositionsServiceAPI SavingFlexibleProductPositionsService
+
+func (a *savingFlexibleProductPositionsServiceAPI) Asset(asset string) SavingFlexibleProductPositionsServiceAPI {
+	return (*savingFlexibleProductPositionsServiceAPI)((*SavingFlexibleProductPositionsService)(a).Asset(asset))
+}
+
+func (a *savingFlexibleProductPositionsServiceAPI) Do(ctx context.Context, opts ...RequestOption) ([]*SavingFlexibleProductPosition, error) {
+	return (*SavingFlexibleProductPositionsService)(a).Do(ctx, opts...)
+}
+
+// SavingFixedProjectPositionsServiceAPI is the interface of SavingFixedProjectPositionsService
+type SavingFixedProjectPositionsServiceAPI interface {
+	Asset(asset string) SavingFixedProjectPositionsServiceAPI
+	Status(status string) SavingFixedProjectPositionsServiceAPI
+	ProjectID(projectId string) SavingFixedProjectPositionsServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) ([]*SavingFixedProjectPosition, error)
+}
+
+type savingFixedProjectPositionsServiceAPI SavingFixedProjectPositionsService
+
+func (a *savingFixedProjectPositionsServiceAPI) Asset(asset string) SavingFixedProjectPositionsServiceAPI {
+	return (*savingFixedProjectPositionsServiceAPI)((*SavingFixedProjectPositionsService)(a).Asset(asset))
+}
+
+func (a *savingFixedProjectPositionsServiceAPI) Status(status string) SavingFixedProjectPositionsServiceAPI {
+	return (*savingFixedProjectPositionsServiceAPI)((*SavingFixedProjectPositionsService)(a).Status(status))
+}
+
+func (a *savingFixedProjectPositionsServiceAPI) ProjectID(projectId string) SavingFixedProjectPositionsServiceAPI {
+	return (*savingFixedProjectPositionsServiceAPI)((*SavingFixedProjectPositionsService)(a).ProjectID(projectId))
+}
+
+func (a *savingFixedProjectPositionsServiceAPI) Do(ctx context.Context, opts ...RequestOption) ([]*SavingFixedProjectPosition, error) {
+	return (*SavingFixedProjectPositionsService)(a).Do(ctx, opts...)
+}
+
+// ListSavingsFlexibleProductsServiceAPI is the interface of ListSavingsFlexibleProductsService
+type ListSavingsFlexibleProductsServiceAPI interface {
+	Status(status string) ListSavingsFlexibleProductsServiceAPI
+	Featured(featured string) ListSavingsFlexibleProductsServiceAPI
+	Current(current int64) ListSavingsFlexibleProductsServiceAPI
+	Size(size int64) ListSavingsFlexibleProductsServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) ([]*SavingsFlexibleProduct, error)
+}
+
+type listSavingsFlexibleProductsServiceAPI ListSavingsFlexibleProductsService
+
+func (a *listSavingsFlexibleProductsServiceAPI) Status(status string) ListSavingsFlexibleProductsServiceAPI {
+	return (*listSavingsFlexibleProductsServiceAPI)((*ListSavingsFlexibleProductsService)(a).Status(status))
+}
+
+func (a *listSavingsFlexibleProductsServiceAPI) Featured(featured string) ListSavingsFlexibleProductsServiceAPI {
+	return (*listSavingsFlexibleProductsServiceAPI)((*ListSavingsFlexibleProductsService)(a).Featured(featured))
+}
+
+func (a *listSavingsFlexibleProductsServiceAPI) Current(current int64) ListSavingsFlexibleProductsServiceAPI {
+	return (*listSavingsFlexibleProductsServiceAPI)((*ListSavingsFlexibleProductsService)(a).Current(current))
+}
+
+func (a *listSavingsFlexibleProductsServiceAPI) Size(size int64) ListSavingsFlexibleProductsServiceAPI {
+	return (*listSavingsFlexibleProductsServiceAPI)((*ListSavingsFlexibleProductsService)(a).Size(size))
+}
+
+func (a *listSavingsFlexibleProductsServiceAPI) Do(ctx context.Context, opts ...RequestOption) ([]*SavingsFlexibleProduct, error) {
+	return (*ListSavingsFlexibleProductsService)(a).Do(ctx, opts...)
+}
+
+// PurchaseSavingsFlexibleProductServiceAPI is the interface of PurchaseSavingsFlexibleProductService
+type PurchaseSavingsFlexibleProductServiceAPI interface {
+	ProductId(productId string) PurchaseSavingsFlexibleProductServiceAPI
+	Amount(amount float64) PurchaseSavingsFlexibleProductServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) (uint64, error)
+}
+
+type purchaseSavingsFlexibleProductServiceAPI PurchaseSavingsFlexibleProductService
+
+func (a *purchaseSavingsFlexibleProductServiceAPI) ProductId(productId string) PurchaseSavingsFlexibleProductServiceAPI {
+	return (*purchaseSavingsFlexibleProductServiceAPI)((*PurchaseSavingsFlexibleProductService)(a).ProductId(productId))
+}
+
+func (a *purchaseSavingsFlexibleProductServiceAPI) Amount(amount float64) PurchaseSavingsFlexibleProductServiceAPI {
+	return (*purchaseSavingsFlexibleProductServiceAPI)((*PurchaseSavingsFlexibleProductService)(a).Amount(amount))
+}
+
+func (a *purchaseSavingsFlexibleProductServiceAPI) Do(ctx context.Context, opts ...RequestOption) (uint64, error) {
+	return (*PurchaseSavingsFlexibleProductService)(a).Do(ctx, opts...)
+}
+
+// RedeemSavingsFlexibleProductServiceAPI is the interface of RedeemSavingsFlexibleProductService
+type RedeemSavingsFlexibleProductServiceAPI interface {
+	ProductId(productId string) RedeemSavingsFlexibleProductServiceAPI
+	Amount(amount float64) RedeemSavingsFlexibleProductServiceAPI
+	Type(redeemType string) RedeemSavingsFlexibleProductServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) error
+}
+
+type redeemSavingsFlexibleProductServiceAPI RedeemSavingsFlexibleProductService
+
+func (a *redeemSavingsFlexibleProductServiceAPI) ProductId(productId string) RedeemSavingsFlexibleProductServiceAPI {
+	return (*redeemSavingsFlexibleProductServiceAPI)((*RedeemSavingsFlexibleProductService)(a).ProductId(productId))
+}
+
+func (a *redeemSavingsFlexibleProductServiceAPI) Amount(amount float64) RedeemSavingsFlexibleProductServiceAPI {
+	return (*redeemSavingsFlexibleProductServiceAPI)((*RedeemSavingsFlexibleProductService)(a).Amount(amount))
+}
+
+func (a *redeemSavingsFlexibleProductServiceAPI) Type(redeemType string) RedeemSavingsFlexibleProductServiceAPI {
+	return (*redeemSavingsFlexibleProductServiceAPI)((*RedeemSavingsFlexibleProductService)(a).Type(redeemType))
+}
+
+func (a *redeemSavingsFlexibleProductServiceAPI) Do(ctx context.Context, opts ...RequestOption) error {
+	return (*RedeemSavingsFlexibleProductService)(a).Do(ctx, opts...)
+}
+
+// ListSavingsFixedAndActivityProductsServiceAPI is the interface of ListSavingsFixedAndActivityProductsService
+type ListSavingsFixedAndActivityProductsServiceAPI interface {
+	Asset(asset string) ListSavingsFixedAndActivityProductsServiceAPI
+	Type(projectType string) ListSavingsFixedAndActivityProductsServiceAPI
+	IsSortAsc(isSortAsc bool) ListSavingsFixedAndActivityProductsServiceAPI
+	Status(status string) ListSavingsFixedAndActivityProductsServiceAPI
+	SortBy(sortBy string) ListSavingsFixedAndActivityProductsServiceAPI
+	Current(current int64) ListSavingsFixedAndActivityProductsServiceAPI
+	Size(size int64) ListSavingsFixedAndActivityProductsServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) ([]*SavingsFixedProduct, error)
+}
+
+type listSavingsFixedAndActivityProductsServiceAPI ListSavingsFixedAndActivityProductsService
+
+func (a *listSavingsFixedAndActivityProductsServiceAPI) Asset(asset string) ListSavingsFixedAndActivityProductsServiceAPI {
+	return (*listSavingsFixedAndActivityProductsServiceAPI)((*ListSavingsFixedAndActivityProductsService)(a).Asset(asset))
+}
+
+func (a *listSavingsFixedAndActivityProductsServiceAPI) Type(projectType string) ListSavingsFixedAndActivityProductsServiceAPI {
+	return (*listSavingsFixedAndActivityProductsServiceAPI)((*ListSavingsFixedAndActivityProductsService)(a).Type(projectType))
+}
+
+func (a *listSavingsFixedAndActivityProductsServiceAPI) IsSortAsc(isSortAsc bool) ListSavingsFixedAndActivityProductsServiceAPI {
+	return (*listSavingsFixedAndActivityProductsServiceAPI)((*ListSavingsFixedAndActivityProductsService)(a).IsSortAsc(isSortAsc))
+}
+
+func (a *listSavingsFixedAndActivityProductsServiceAPI) Status(status string) ListSavingsFixedAndActivityProductsServiceAPI {
+	return (*listSavingsFixedAndActivityProductsServiceAPI)((*ListSavingsFixedAndActivityProductsService)(a).Status(status))
+}
+
+func (a *listSavingsFixedAndActivityProductsServiceAPI) SortBy(sortBy string) ListSavingsFixedAndActivityProductsServiceAPI {
+	return (*listSavingsFixedAndActivityProductsServiceAPI)((*ListSavingsFixedAndActivityProductsService)(a).SortBy(sortBy))
+}
+
+func (a *listSavingsFixedAndActivityProductsServiceAPI) Current(current int64) ListSavingsFixedAndActivityProductsServiceAPI {
+	return (*listSavingsFixedAndActivityProductsServiceAPI)((*ListSavingsFixedAndActivityProductsService)(a).Current(current))
+}
+
+func (a *listSavingsFixedAndActivityProductsServiceAPI) Size(size int64) ListSavingsFixedAndActivityProductsServiceAPI {
+	return (*listSavingsFixedAndActivityProductsServiceAPI)((*ListSavingsFixedAndActivityProductsService)(a).Size(size))
+}
+
+func (a *listSavingsFixedAndActivityProductsServiceAPI) Do(ctx context.Context, opts ...RequestOption) ([]*SavingsFixedProduct, error) {
+	return (*ListSavingsFixedAndActivityProductsService)(a).Do(ctx, opts...)
+}
+
+// GetAccountSnapshotServiceAPI is the interface of GetAccountSnapshotService
+type GetAccountSnapshotServiceAPI interface {
+	Type(accountType string) GetAccountSnapshotServiceAPI
+	StartTime(startTime int64) GetAccountSnapshotServiceAPI
+	EndTime(endTime int64) GetAccountSnapshotServiceAPI
+	Limit(limit int) GetAccountSnapshotServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) (*Snapshot, error)
+}
+
+type getAccountSnapshotServiceAPI GetAccountSnapshotService
+
+func (a *getAccountSnapshotServiceAPI) Type(accountType string) GetAccountSnapshotServiceAPI {
+	return (*getAccountSnapshotServiceAPI)((*GetAccountSnapshotService)(a).Type(accountType))
+}
+
+func (a *getAccountSnapshotServiceAPI) StartTime(startTime int64) GetAccountSnapshotServiceAPI {
+	return (*getAccountSnapshotServiceAPI)((*GetAccountSnapshotService)(a).StartTime(startTime))
+}
+
+func (a *getAccountSnapshotServiceAPI) EndTime(endTime int64) GetAccountSnapshotServiceAPI {
+	return (*getAccountSnapshotServiceAPI)((*GetAccountSnapshotService)(a).EndTime(endTime))
+}
+
+func (a *getAccountSnapshotServiceAPI) Limit(limit int) GetAccountSnapshotServiceAPI {
+	return (*getAccountSnapshotServiceAPI)((*GetAccountSnapshotService)(a).Limit(limit))
+}
+
+func (a *getAccountSnapshotServiceAPI) Do(ctx context.Context, opts ...RequestOption) (*Snapshot, error) {
+	return (*GetAccountSnapshotService)(a).Do(ctx, opts...)
+}
+
+// ListTradesServiceAPI is the interface of ListTradesService
+type ListTradesServiceAPI interface {
+	Symbol(symbol string) ListTradesServiceAPI
+	StartTime(startTime int64) ListTradesServiceAPI
+	EndTime(endTime int64) ListTradesServiceAPI
+	Limit(limit int) ListTradesServiceAPI
+	FromID(fromID int64) ListTradesServiceAPI
+	OrderId(OrderId int64) ListTradesServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) ([]*TradeV3, error)
+}
+
+type listTradesServiceAPI ListTradesService
+
+func (a *listTradesServiceAPI) Symbol(symbol string) ListTradesServiceAPI {
+	return (*listTradesServiceAPI)((*ListTradesService)(a).Symbol(symbol))
+}
+
+func (a *listTradesServiceAPI) StartTime(startTime int64) ListTradesServiceAPI {
+	return (*listTradesServiceAPI)((*ListTradesService)(a).StartTime(startTime))
+}
+
+func (a *listTradesServiceAPI) EndTime(endTime int64) ListTradesServiceAPI {
+	return (*listTradesServiceAPI)((*ListTradesService)(a).EndTime(endTime))
+}
+
+func (a *listTradesServiceAPI) Limit(limit int) ListTradesServiceAPI {
+	return (*listTradesServiceAPI)((*ListTradesService)(a).Limit(limit))
+}
+
+func (a *listTradesServiceAPI) FromID(fromID int64) ListTradesServiceAPI {
+	return (*listTradesServiceAPI)((*ListTradesService)(a).FromID(fromID))
+}
+
+func (a *listTradesServiceAPI) OrderId(OrderId int64) ListTradesServiceAPI {
+	return (*listTradesServiceAPI)((*ListTradesService)(a).OrderId(OrderId))
+}
+
+func (a *listTradesServiceAPI) Do(ctx context.Context, opts ...RequestOption) ([]*TradeV3, error) {
+	return (*ListTradesService)(a).Do(ctx, opts...)
+}
+
+// HistoricalTradesServiceAPI is the interface of HistoricalTradesService
+type HistoricalTradesServiceAPI interface {
+	Symbol(symbol string) HistoricalTradesServiceAPI
+	Limit(limit int) HistoricalTradesServiceAPI
+	FromID(fromID int64) HistoricalTradesServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) ([]*Trade, error)
+}
+
+type historicalTradesServiceAPI HistoricalTradesService
+
+func (a *historicalTradesServiceAPI) Symbol(symbol string) HistoricalTradesServiceAPI {
+	return (*historicalTradesServiceAPI)((*HistoricalTradesService)(a).Symbol(symbol))
+}
+
+func (a *historicalTradesServiceAPI) Limit(limit int) HistoricalTradesServiceAPI {
+	return (*historicalTradesServiceAPI)((*HistoricalTradesService)(a).Limit(limit))
+}
+
+func (a *historicalTradesServiceAPI) FromID(fromID int64) HistoricalTradesServiceAPI {
+	return (*historicalTradesServiceAPI)((*HistoricalTradesService)(a).FromID(fromID))
+}
+
+func (a *historicalTradesServiceAPI) Do(ctx context.Context, opts ...RequestOption) ([]*Trade, error) {
+	return (*HistoricalTradesService)(a).Do(ctx, opts...)
+}
+
+// ListDepositsServiceAPI is the interface of ListDepositsService
+type ListDepositsServiceAPI interface {
+	Coin(coin string) ListDepositsServiceAPI
+	Status(status int) ListDepositsServiceAPI
+	StartTime(startTime int64) ListDepositsServiceAPI
+	EndTime(endTime int64) ListDepositsServiceAPI
+	Offset(offset int) ListDepositsServiceAPI
+	Limit(limit int) ListDepositsServiceAPI
+	TxID(id string) ListDepositsServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) ([]*Deposit, error)
+}
+
+type listDepositsServiceAPI ListDepositsService
+
+func (a *listDepositsServiceAPI) Coin(coin string) ListDepositsServiceAPI {
+	return (*listDepositsServiceAPI)((*ListDepositsService)(a).Coin(coin))
+}
+
+func (a *listDepositsServiceAPI) Status(status int) ListDepositsServiceAPI {
+	return (*listDepositsServiceAPI)((*ListDepositsService)(a).Status(status))
+}
+
+func (a *listDepositsServiceAPI) StartTime(startTime int64) ListDepositsServiceAPI {
+	return (*listDepositsServiceAPI)((*ListDepositsService)(a).StartTime(startTime))
+}
+
+func (a *listDepositsServiceAPI) EndTime(endTime int64) ListDepositsServiceAPI {
+	return (*listDepositsServiceAPI)((*ListDepositsService)(a).EndTime(endTime))
+}
+
+func (a *listDepositsServiceAPI) Offset(offset int) ListDepositsServiceAPI {
+	return (*listDepositsServiceAPI)((*ListDepositsService)(a).Offset(offset))
+}
+
+func (a *listDepositsServiceAPI) Limit(limit int) ListDepositsServiceAPI {
+	return (*listDepositsServiceAPI)((*ListDepositsService)(a).Limit(limit))
+}
+
+func (a *listDepositsServiceAPI) TxID(id string) ListDepositsServiceAPI {
+	return (*listDepositsServiceAPI)((*ListDepositsService)(a).TxID(id))
+}
+
+func (a *listDepositsServiceAPI) Do(ctx context.Context, opts ...RequestOption) ([]*Deposit, error) {
+	return (*ListDepositsService)(a).Do(ctx, opts...)
+}
+
+// GetDepositsAddressServiceAPI is the interface of GetDepositsAddressService
+type GetDepositsAddressServiceAPI interface {
+	Coin(coin string) GetDepositsAddressServiceAPI
+	Network(network string) GetDepositsAddressServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) (*GetDepositAddressResponse, error)
+}
+
+type getDepositsAddressServiceAPI GetDepositsAddressService
+
+func (a *getDepositsAddressServiceAPI) Coin(coin string) GetDepositsAddressServiceAPI {
+	return (*getDepositsAddressServiceAPI)((*GetDepositsAddressService)(a).Coin(coin))
+}
+
+func (a *getDepositsAddressServiceAPI) Network(network string) GetDepositsAddressServiceAPI {
+	return (*getDepositsAddressServiceAPI)((*GetDepositsAddressService)(a).Network(network))
+}
+
+func (a *getDepositsAddressServiceAPI) Do(ctx context.Context, opts ...RequestOption) (*GetDepositAddressResponse, error) {
+	return (*GetDepositsAddressService)(a).Do(ctx, opts...)
+}
+
+// CreateWithdrawServiceAPI is the interface of CreateWithdrawService
+type CreateWithdrawServiceAPI interface {
+	Coin(v string) CreateWithdrawServiceAPI
+	WithdrawOrderID(v string) CreateWithdrawServiceAPI
+	Network(v string) CreateWithdrawServiceAPI
+	Address(v string) CreateWithdrawServiceAPI
+	AddressTag(v string) CreateWithdrawServiceAPI
+	Amount(v string) CreateWithdrawServiceAPI
+	TransactionFeeFlag(v bool) CreateWithdrawServiceAPI
+	Name(v string) CreateWithdrawServiceAPI
+	WalletType(walletType int) CreateWithdrawServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) (*CreateWithdrawResponse, error)
+}
+
+type createWithdrawServiceAPI CreateWithdrawService
+
+func (a *createWithdrawServiceAPI) Coin(v string) CreateWithdrawServiceAPI {
+	return (*createWithdrawServiceAPI)((*CreateWithdrawService)(a).Coin(v))
+}
+
+func (a *createWithdrawServiceAPI) WithdrawOrderID(v string) CreateWithdrawServiceAPI {
+	return (*createWithdrawServiceAPI)((*CreateWithdrawService)(a).WithdrawOrderID(v))
+}
+
+func (a *createWithdrawServiceAPI) Network(v string) CreateWithdrawServiceAPI {
+	return (*createWithdrawServiceAPI)((*CreateWithdrawService)(a).Network(v))
+}
+
+func (a *createWithdrawServiceAPI) Address(v string) CreateWithdrawServiceAPI {
+	return (*createWithdrawServiceAPI)((*CreateWithdrawService)(a).Address(v))
+}
+
+func (a *createWithdrawServiceAPI) AddressTag(v string) CreateWithdrawServiceAPI {
+	return (*createWithdrawServiceAPI)((*CreateWithdrawService)(a).AddressTag(v))
+}
+
+func (a *createWithdrawServiceAPI) Amount(v string) CreateWithdrawServiceAPI {
+	return (*createWithdrawServiceAPI)((*CreateWithdrawService)(a).Amount(v))
+}
+
+func (a *createWithdrawServiceAPI) TransactionFeeFlag(v bool) CreateWithdrawServiceAPI {
+	return (*createWithdrawServiceAPI)((*CreateWithdrawService)(a).TransactionFeeFlag(v))
+}
+
+func (a *createWithdrawServiceAPI) Name(v string) CreateWithdrawServiceAPI {
+	return (*createWithdrawServiceAPI)((*CreateWithdrawService)(a).Name(v))
+}
+
+func (a *createWithdrawServiceAPI) WalletType(walletType int) CreateWithdrawServiceAPI {
+	return (*createWithdrawServiceAPI)((*CreateWithdrawService)(a).WalletType(walletType))
+}
+
+func (a *createWithdrawServiceAPI) Do(ctx context.Context, opts ...RequestOption) (*CreateWithdrawResponse, error) {
+	return (*CreateWithdrawService)(a).Do(ctx, opts...)
+}
+
+// ListWithdrawsServiceAPI is the interface of ListWithdrawsService
+type ListWithdrawsServiceAPI interface {
+	Coin(coin string) ListWithdrawsServiceAPI
+	WithdrawOrderId(withdrawOrderId string) ListWithdrawsServiceAPI
+	Status(status int) ListWithdrawsServiceAPI
+	StartTime(startTime int64) ListWithdrawsServiceAPI
+	EndTime(endTime int64) ListWithdrawsServiceAPI
+	Offset(offset int) ListWithdrawsServiceAPI
+	Limit(limit int) ListWithdrawsServiceAPI
+	IdList(ids string) ListWithdrawsServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) ([]*Withdraw, error)
+}
+
+type listWithdrawsServiceAPI ListWithdrawsService
+
+func (a *listWithdrawsServiceAPI) Coin(coin string) ListWithdrawsServiceAPI {
+	return (*listWithdrawsServiceAPI)((*ListWithdrawsService)(a).Coin(coin))
+}
+
+func (a *listWithdrawsServiceAPI) WithdrawOrderId(withdrawOrderId string) ListWithdrawsServiceAPI {
+	return (*listWithdrawsServiceAPI)((*ListWithdrawsService)(a).WithdrawOrderId(withdrawOrderId))
+}
+
+func (a *listWithdrawsServiceAPI) Status(status int) ListWithdrawsServiceAPI {
+	return (*listWithdrawsServiceAPI)((*ListWithdrawsService)(a).Status(status))
+}
+
+func (a *listWithdrawsServiceAPI) StartTime(startTime int64) ListWithdrawsServiceAPI {
+	return (*listWithdrawsServiceAPI)((*ListWithdrawsService)(a).StartTime(startTime))
+}
+
+func (a *listWithdrawsServiceAPI) EndTime(endTime int64) ListWithdrawsServiceAPI {
+	return (*listWithdrawsServiceAPI)((*ListWithdrawsService)(a).EndTime(endTime))
+}
+
+func (a *listWithdrawsServiceAPI) Offset(offset int) ListWithdrawsServiceAPI {
+	return (*listWithdrawsServiceAPI)((*ListWithdrawsService)(a).Offset(offset))
+}
+
+func (a *listWithdrawsServiceAPI) Limit(limit int) ListWithdrawsServiceAPI {
+	return (*listWithdrawsServiceAPI)((*ListWithdrawsService)(a).Limit(limit))
+}
+
+func (a *listWithdrawsServiceAPI) IdList(ids string) ListWithdrawsServiceAPI {
+	return (*listWithdrawsServiceAPI)((*ListWithdrawsService)(a).IdList(ids))
+}
+
+func (a *listWithdrawsServiceAPI) Do(ctx context.Context, opts ...RequestOption) ([]*Withdraw, error) {
+	return (*ListWithdrawsService)(a).Do(ctx, opts...)
+}
+
+// StartUserStreamServiceAPI is the interface of StartUserStreamService
+type StartUserStreamServiceAPI interface {
+	Do(ctx context.Context, opts ...RequestOption) (string, error)
+}
+
+type startUserStreamServiceAPI StartUserStreamService
+
+func (a *startUserStreamServiceAPI) Do(ctx context.Context, opts ...RequestOption) (string, error) {
+	return (*StartUserStreamService)(a).Do(ctx, opts...)
+}
+
+// KeepaliveUserStreamServiceAPI is the interface of KeepaliveUserStreamService
+type KeepaliveUserStreamServiceAPI interface {
+	ListenKey(listenKey string) KeepaliveUserStreamServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) error
+}
+
+type keepaliveUserStreamServiceAPI KeepaliveUserStreamService
+
+func (a *keepaliveUserStreamServiceAPI) ListenKey(listenKey string) KeepaliveUserStreamServiceAPI {
+	return (*keepaliveUserStreamServiceAPI)((*KeepaliveUserStreamService)(a).ListenKey(listenKey))
+}
+
+func (a *keepaliveUserStreamServiceAPI) Do(ctx context.Context, opts ...RequestOption) error {
+	return (*KeepaliveUserStreamService)(a).Do(ctx, opts...)
+}
+
+// CloseUserStreamServiceAPI is the interface of CloseUserStreamService
+type CloseUserStreamServiceAPI interface {
+	ListenKey(listenKey string) CloseUserStreamServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) error
+}
+
+type closeUserStreamServiceAPI CloseUserStreamService
+
+func (a *closeUserStreamServiceAPI) ListenKey(listenKey string) CloseUserStreamServiceAPI {
+	return (*closeUserStreamServiceAPI)((*CloseUserStreamService)(a).ListenKey(listenKey))
+}
+
+func (a *closeUserStreamServiceAPI) Do(ctx context.Context, opts ...RequestOption) error {
+	return (*CloseUserStreamService)(a).Do(ctx, opts...)
+}
+
+// ExchangeInfoServiceAPI is the interface of ExchangeInfoService
+type ExchangeInfoServiceAPI interface {
+	Symbol(symbol string) ExchangeInfoServiceAPI
+	Symbols(symbols ...string) ExchangeInfoServiceAPI
+	Permissions(permissions ...string) ExchangeInfoServiceAPI
+	ShowPermissionSets(showPermissionSets *bool) ExchangeInfoServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) (*ExchangeInfo, error)
+}
+
+type exchangeInfoServiceAPI ExchangeInfoService
+
+func (a *exchangeInfoServiceAPI) Symbol(symbol string) ExchangeInfoServiceAPI {
+	return (*exchangeInfoServiceAPI)((*ExchangeInfoService)(a).Symbol(symbol))
+}
+
+func (a *exchangeInfoServiceAPI) Symbols(symbols ...string) ExchangeInfoServiceAPI {
+	return (*exchangeInfoServiceAPI)((*ExchangeInfoService)(a).Symbols(symbols...))
+}
+
+func (a *exchangeInfoServiceAPI) Permissions(permissions ...string) ExchangeInfoServiceAPI {
+	return (*exchangeInfoServiceAPI)((*ExchangeInfoService)(a).Permissions(permissions...))
+}
+
+func (a *exchangeInfoServiceAPI) ShowPermissionSets(showPermissionSets *bool) ExchangeInfoServiceAPI {
+	return (*exchangeInfoServiceAPI)((*ExchangeInfoService)(a).ShowPermissionSets(showPermissionSets))
+}
+
+func (a *exchangeInfoServiceAPI) Do(ctx context.Context, opts ...RequestOption) (*ExchangeInfo, error) {
+	return (*ExchangeInfoService)(a).Do(ctx, opts...)
+}
+
+// RateLimitServiceAPI is the interface of RateLimitService
+type RateLimitServiceAPI interface {
+	Do(ctx context.Context, opts ...RequestOption) ([]*RateLimitFull, error)
+}
+
+type rateLimitServiceAPI RateLimitService
+
+func (a *rateLimitServiceAPI) Do(ctx context.Context, opts ...RequestOption) ([]*RateLimitFull, error) {
+	return (*RateLimitService)(a).Do(ctx, opts...)
+}
+
+// GetAssetDetailServiceAPI is the interface of GetAssetDetailService
+type GetAssetDetailServiceAPI interface {
+	Asset(asset string) GetAssetDetailServiceAPI
+	Do(ctx context.Context) (map[string]AssetDetail, error)
+}
+
+type getAssetDetailServiceAPI GetAssetDetailService
+
+func (a *getAssetDetailServiceAPI) Asset(asset string) GetAssetDetailServiceAPI {
+	return (*getAssetDetailServiceAPI)((*GetAssetDetailService)(a).Asset(asset))
+}
+
+func (a *getAssetDetailServiceAPI) Do(ctx context.Context) (map[string]AssetDetail, error) {
+	return (*GetAssetDetailService)(a).Do(ctx)
+}
+
+// WalletBalanceServiceAPI is the interface of WalletBalanceService
+type WalletBalanceServiceAPI interface {
+	QuoteAsset(asset string) WalletBalanceServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) ([]*WalletBalance, error)
+}
+
+type walletBalanceServiceAPI WalletBalanceService
+
+func (a *walletBalanceServiceAPI) QuoteAsset(asset string) WalletBalanceServiceAPI {
+	return (*walletBalanceServiceAPI)((*WalletBalanceService)(a).QuoteAsset(asset))
+}
+
+func (a *walletBalanceServiceAPI) Do(ctx context.Context, opts ...RequestOption) ([]*WalletBalance, error) {
+	return (*WalletBalanceService)(a).Do(ctx, opts...)
+}
+
+// GetFundingAssetServiceAPI is the interface of GetFundingAssetService
+type GetFundingAssetServiceAPI interface {
+	Asset(asset string) GetFundingAssetServiceAPI
+	NeedBtcValuation(needBtcValuation string) GetFundingAssetServiceAPI
+	Do(ctx context.Context) ([]FundingAsset, error)
+}
+
+type getFundingAssetServiceAPI GetFundingAssetService
+
+func (a *getFundingAssetServiceAPI) Asset(asset string) GetFundingAssetServiceAPI {
+	return (*getFundingAssetServiceAPI)((*GetFundingAssetService)(a).Asset(asset))
+}
+
+func (a *getFundingAssetServiceAPI) NeedBtcValuation(needBtcValuation string) GetFundingAssetServiceAPI {
+	return (*getFundingAssetServiceAPI)((*GetFundingAssetService)(a).NeedBtcValuation(needBtcValuation))
+}
+
+func (a *getFundingAssetServiceAPI) Do(ctx context.Context) ([]FundingAsset, error) {
+	return (*GetFundingAssetService)(a).Do(ctx)
+}
+
+// AveragePriceServiceAPI is the interface of AveragePriceService
+type AveragePriceServiceAPI interface {
+	Symbol(symbol string) AveragePriceServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) (*AvgPrice, error)
+}
+
+type averagePriceServiceAPI AveragePriceService
+
+func (a *averagePriceServiceAPI) Symbol(symbol string) AveragePriceServiceAPI {
+	return (*averagePriceServiceAPI)((*AveragePriceService)(a).Symbol(symbol))
+}
+
+func (a *averagePriceServiceAPI) Do(ctx context.Context, opts ...RequestOption) (*AvgPrice, error) {
+	return (*AveragePriceService)(a).Do(ctx, opts...)
+}
+
+// MarginTransferServiceAPI is the interface of MarginTransferService
+type MarginTransferServiceAPI interface {
+	Asset(asset string) MarginTransferServiceAPI
+	Amount(amount string) MarginTransferServiceAPI
+	Type(transferType MarginTransferType) MarginTransferServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) (*TransactionResponse, error)
+}
+
+type marginTransferServiceAPI MarginTransferService
+
+func (a *marginTransferServiceAPI) Asset(asset string) MarginTransferServiceAPI {
+	return (*marginTransferServiceAPI)((*MarginTransferService)(a).Asset(asset))
+}
+
+func (a *marginTransferServiceAPI) Amount(amount string) MarginTransferServiceAPI {
+	return (*marginTransferServiceAPI)((*MarginTransferService)(a).Amount(amount))
+}
+
+func (a *marginTransferServiceAPI) Type(transferType MarginTransferType) MarginTransferServiceAPI {
+	return (*marginTransferServiceAPI)((*MarginTransferService)(a).Type(transferType))
+}
+
+func (a *marginTransferServiceAPI) Do(ctx context.Context, opts ...RequestOption) (*TransactionResponse, error) {
+	return (*MarginTransferService)(a).Do(ctx, opts...)
+}
+
+// MarginLoanServiceAPI is the interface of MarginLoanService
+type MarginLoanServiceAPI interface {
+	Asset(asset string) MarginLoanServiceAPI
+	Amount(amount string) MarginLoanServiceAPI
+	IsIsolated(isIsolated bool) MarginLoanServiceAPI
+	Symbol(symbol string) MarginLoanServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) (*TransactionResponse, error)
+}
+
+type marginLoanServiceAPI MarginLoanService
+
+func (a *marginLoanServiceAPI) Asset(asset string) MarginLoanServiceAPI {
+	return (*marginLoanServiceAPI)((*MarginLoanService)(a).Asset(asset))
+}
+
+func (a *marginLoanServiceAPI) Amount(amount string) MarginLoanServiceAPI {
+	return (*marginLoanServiceAPI)((*MarginLoanService)(a).Amount(amount))
+}
+
+func (a *marginLoanServiceAPI) IsIsolated(isIsolated bool) MarginLoanServiceAPI {
+	return (*marginLoanServiceAPI)((*MarginLoanService)(a).IsIsolated(isIsolated))
+}
+
+func (a *marginLoanServiceAPI) Symbol(symbol string) MarginLoanServiceAPI {
+	return (*marginLoanServiceAPI)((*MarginLoanService)(a).Symbol(symbol))
+}
+
+func (a *marginLoanServiceAPI) Do(ctx context.Context, opts ...RequestOption) (*TransactionResponse, error) {
+	return (*MarginLoanService)(a).Do(ctx, opts...)
+}
+
+// MarginRepayServiceAPI is the interface of MarginRepayService
+type MarginRepayServiceAPI interface {
+	Asset(asset string) MarginRepayServiceAPI
+	Amount(amount string) MarginRepayServiceAPI
+	IsIsolated(isIsolated bool) MarginRepayServiceAPI
+	Symbol(symbol string) MarginRepayServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) (*TransactionResponse, error)
+}
+
+type marginRepayServiceAPI MarginRepayService
+
+func (a *marginRepayServiceAPI) Asset(asset string) MarginRepayServiceAPI {
+	return (*marginRepayServiceAPI)((*MarginRepayService)(a).Asset(asset))
+}
+
+func (a *marginRepayServiceAPI) Amount(amount string) MarginRepayServiceAPI {
+	return (*marginRepayServiceAPI)((*MarginRepayService)(a).Amount(amount))
+}
+
+func (a *marginRepayServiceAPI) IsIsolated(isIsolated bool) MarginRepayServiceAPI {
+	return (*marginRepayServiceAPI)((*MarginRepayService)(a).IsIsolated(isIsolated))
+}
+
+func (a *marginRepayServiceAPI) Symbol(symbol string) MarginRepayServiceAPI {
+	return (*marginRepayServiceAPI)((*MarginRepayService)(a).Symbol(symbol))
+}
+
+func (a *marginRepayServiceAPI) Do(ctx context.Context, opts ...RequestOption) (*TransactionResponse, error) {
+	return (*MarginRepayService)(a).Do(ctx, opts...)
+}
+
+// MarginBorrowRepayServiceAPI is the interface of MarginBorrowRepayService
+type MarginBorrowRepayServiceAPI interface {
+	Asset(asset string) MarginBorrowRepayServiceAPI
+	Amount(amount string) MarginBorrowRepayServiceAPI
+	IsIsolated(isIsolated bool) MarginBorrowRepayServiceAPI
+	Symbol(symbol string) MarginBorrowRepayServiceAPI
+	Type(marginBorrowRepayType MarginAccountBorrowRepayType) MarginBorrowRepayServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) (*TransactionResponse, error)
+}
+
+type marginBorrowRepayServiceAPI MarginBorrowRepayService
+
+func (a *marginBorrowRepayServiceAPI) Asset(asset string) MarginBorrowRepayServiceAPI {
+	return (*marginBorrowRepayServiceAPI)((*MarginBorrowRepayService)(a).Asset(asset))
+}
+
+func (a *marginBorrowRepayServiceAPI) Amount(amount string) MarginBorrowRepayServiceAPI {
+	return (*marginBorrowRepayServiceAPI)((*MarginBorrowRepayService)(a).Amount(amount))
+}
+
+func (a *marginBorrowRepayServiceAPI) IsIsolated(isIsolated bool) MarginBorrowRepayServiceAPI {
+	return (*marginBorrowRepayServiceAPI)((*MarginBorrowRepayService)(a).IsIsolated(isIsolated))
+}
+
+func (a *marginBorrowRepayServiceAPI) Symbol(symbol string) MarginBorrowRepayServiceAPI {
+	return (*marginBorrowRepayServiceAPI)((*MarginBorrowRepayService)(a).Symbol(symbol))
+}
+
+func (a *marginBorrowRepayServiceAPI) Type(marginBorrowRepayType MarginAccountBorrowRepayType) MarginBorrowRepayServiceAPI {
+	return (*marginBorrowRepayServiceAPI)((*MarginBorrowRepayService)(a).Type(marginBorrowRepayType))
+}
+
+func (a *marginBorrowRepayServiceAPI) Do(ctx context.Context, opts ...RequestOption) (*TransactionResponse, error) {
+	return (*MarginBorrowRepayService)(a).Do(ctx, opts...)
+}
+
+// ListMarginBorrowRepayServiceAPI is the interface of ListMarginBorrowRepayService
+type ListMarginBorrowRepayServiceAPI interface {
+	Asset(asset string) ListMarginBorrowRepayServiceAPI
+	IsolatedSymbol(isolatedSymbol string) ListMarginBorrowRepayServiceAPI
+	TxId(txId int64) ListMarginBorrowRepayServiceAPI
+	StartTime(startTime int64) ListMarginBorrowRepayServiceAPI
+	EndTime(endTime int64) ListMarginBorrowRepayServiceAPI
+	Current(current int64) ListMarginBorrowRepayServiceAPI
+	Size(size int64) ListMarginBorrowRepayServiceAPI
+	Type(marginBorrowRepayType MarginAccountBorrowRepayType) ListMarginBorrowRepayServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) (*MarginBorrowRepayResponse, error)
+}
+
+type listMarginBorrowRepayServiceAPI ListMarginBorrowRepayService
+
+func (a *listMarginBorrowRepayServiceAPI) Asset(asset string) ListMarginBorrowRepayServiceAPI {
+	return (*listMarginBorrowRepayServiceAPI)((*ListMarginBorrowRepayService)(a).Asset(asset))
+}
+
+func (a *listMarginBorrowRepayServiceAPI) IsolatedSymbol(isolatedSymbol string) ListMarginBorrowRepayServiceAPI {
+	return (*listMarginBorrowRepayServiceAPI)((*ListMarginBorrowRepayService)(a).IsolatedSymbol(isolatedSymbol))
+}
+
+func (a *listMarginBorrowRepayServiceAPI) TxId(txId int64) ListMarginBorrowRepayServiceAPI {
+	return (*listMarginBorrowRepayServiceAPI)((*ListMarginBorrowRepayService)(a).TxId(txId))
+}
+
+func (a *listMarginBorrowRepayServiceAPI) StartTime(startTime int64) ListMarginBorrowRepayServiceAPI {
+	return (*listMarginBorrowRepayServiceAPI)((*ListMarginBorrowRepayService)(a).StartTime(startTime))
+}
+
+func (a *listMarginBorrowRepayServiceAPI) EndTime(endTime int64) ListMarginBorrowRepayServiceAPI {
+	return (*listMarginBorrowRepayServiceAPI)((*ListMarginBorrowRepayService)(a).EndTime(endTime))
+}
+
+func (a *listMarginBorrowRepayServiceAPI) Current(current int64) ListMarginBorrowRepayServiceAPI {
+	return (*listMarginBorrowRepayServiceAPI)((*ListMarginBorrowRepayService)(a).Current(current))
+}
+
+func (a *listMarginBorrowRepayServiceAPI) Size(size int64) ListMarginBorrowRepayServiceAPI {
+	return (*listMarginBorrowRepayServiceAPI)((*ListMarginBorrowRepayService)(a).Size(size))
+}
+
+func (a *listMarginBorrowRepayServiceAPI) Type(marginBorrowRepayType MarginAccountBorrowRepayType) ListMarginBorrowRepayServiceAPI {
+	return (*listMarginBorrowRepayServiceAPI)((*ListMarginBorrowRepayService)(a).Type(marginBorrowRepayType))
+}
+
+func (a *listMarginBorrowRepayServiceAPI) Do(ctx context.Context, opts ...RequestOption) (*MarginBorrowRepayResponse, error) {
+	return (*ListMarginBorrowRepayService)(a).Do(ctx, opts...)
+}
+
+// CreateMarginOrderServiceAPI is the interface of CreateMarginOrderService
+type CreateMarginOrderServiceAPI interface {
+	Symbol(symbol string) CreateMarginOrderServiceAPI
+	IsIsolated(isIsolated bool) CreateMarginOrderServiceAPI
+	Side(side SideType) CreateMarginOrderServiceAPI
+	Type(orderType OrderType) CreateMarginOrderServiceAPI
+	TimeInForce(timeInForce TimeInForceType) CreateMarginOrderServiceAPI
+	Quantity(quantity string) CreateMarginOrderServiceAPI
+	QuoteOrderQty(quoteOrderQty string) CreateMarginOrderServiceAPI
+	Price(price string) CreateMarginOrderServiceAPI
+	NewClientOrderID(newClientOrderID string) CreateMarginOrderServiceAPI
+	StopPrice(stopPrice string) CreateMarginOrderServiceAPI
+	IcebergQuantity(icebergQuantity string) CreateMarginOrderServiceAPI
+	NewOrderRespType(newOrderRespType NewOrderRespType) CreateMarginOrderServiceAPI
+	SideEffectType(sideEffectType SideEffectType) CreateMarginOrderServiceAPI
+	SelfTradePreventionMode(selfTradePreventionMode SelfTradePreventionMode) CreateMarginOrderServiceAPI
+	AutoRepayAtCancel(autoRepayAtCancel bool) CreateMarginOrderServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) (*CreateOrderResponse, error)
+}
+
+type createMarginOrderServiceAPI CreateMarginOrderService
+
+func (a *createMarginOrderServiceAPI) Symbol(symbol string) CreateMarginOrderServiceAPI {
+	return (*createMarginOrderServiceAPI)((*CreateMarginOrderService)(a).Symbol(symbol))
+}
+
+func (a *createMarginOrderServiceAPI) IsIsolated(isIsolated bool) CreateMarginOrderServiceAPI {
+	return (*createMarginOrderServiceAPI)((*CreateMarginOrderService)(a).IsIsolated(isIsolated))
+}
+
+func (a *createMarginOrderServiceAPI) Side(side SideType) CreateMarginOrderServiceAPI {
+	return (*createMarginOrderServiceAPI)((*CreateMarginOrderService)(a).Side(side))
+}
+
+func (a *createMarginOrderServiceAPI) Type(orderType OrderType) CreateMarginOrderServiceAPI {
+	return (*createMarginOrderServiceAPI)((*CreateMarginOrderService)(a).Type(orderType))
+}
+
+func (a *createMarginOrderServiceAPI) TimeInForce(timeInForce TimeInForceType) CreateMarginOrderServiceAPI {
+	return (*createMarginOrderServiceAPI)((*CreateMarginOrderService)(a).TimeInForce(timeInForce))
+}
+
+func (a *createMarginOrderServiceAPI) Quantity(quantity string) CreateMarginOrderServiceAPI {
+	return (*createMarginOrderServiceAPI)((*CreateMarginOrderService)(a).Quantity(quantity))
+}
+
+func (a *createMarginOrderServiceAPI) QuoteOrderQty(quoteOrderQty string) CreateMarginOrderServiceAPI {
+	return (*createMarginOrderServiceAPI)((*CreateMarginOrderService)(a).QuoteOrderQty(quoteOrderQty))
+}
+
+func (a *createMarginOrderServiceAPI) Price(price string) CreateMarginOrderServiceAPI {
+	return (*createMarginOrderServiceAPI)((*CreateMarginOrderService)(a).Price(price))
+}
+
+func (a *createMarginOrderServiceAPI) NewClientOrderID(newClientOrderID string) CreateMarginOrderServiceAPI {
+	return (*createMarginOrderServiceAPI)((*CreateMarginOrderService)(a).NewClientOrderID(newClientOrderID))
+}
+
+func (a *createMarginOrderServiceAPI) StopPrice(stopPrice string) CreateMarginOrderServiceAPI {
+	return (*createMarginOrderServiceAPI)((*CreateMarginOrderService)(a).StopPrice(stopPrice))
+}
+
+func (a *createMarginOrderServiceAPI) IcebergQuantity(icebergQuantity string) CreateMarginOrderServiceAPI {
+	return (*createMarginOrderServiceAPI)((*CreateMarginOrderService)(a).IcebergQuantity(icebergQuantity))
+}
+
+func (a *createMarginOrderServiceAPI) NewOrderRespType(newOrderRespType NewOrderRespType) CreateMarginOrderServiceAPI {
+	return (*createMarginOrderServiceAPI)((*CreateMarginOrderService)(a).NewOrderRespType(newOrderRespType))
+}
+
+func (a *createMarginOrderServiceAPI) SideEffectType(sideEffectType SideEffectType) CreateMarginOrderServiceAPI {
+	return (*createMarginOrderServiceAPI)((*CreateMarginOrderService)(a).SideEffectType(sideEffectType))
+}
+
+func (a *createMarginOrderServiceAPI) SelfTradePreventionMode(selfTradePreventionMode SelfTradePreventionMode) CreateMarginOrderServiceAPI {
+	return (*createMarginOrderServiceAPI)((*CreateMarginOrderService)(a).SelfTradePreventionMode(selfTradePreventionMode))
+}
+
+func (a *createMarginOrderServiceAPI) AutoRepayAtCancel(autoRepayAtCancel bool) CreateMarginOrderServiceAPI {
+	return (*createMarginOrderServiceAPI)((*CreateMarginOrderService)(a).AutoRepayAtCancel(autoRepayAtCancel))
+}
+
+func (a *createMarginOrderServiceAPI) Do(ctx context.Context, opts ...RequestOption) (*CreateOrderResponse, error) {
+	return (*CreateMarginOrderService)(a).Do(ctx, opts...)
+}
+
+// CancelMarginOrderServiceAPI is the interface of CancelMarginOrderService
+type CancelMarginOrderServiceAPI interface {
+	Symbol(symbol string) CancelMarginOrderServiceAPI
+	IsIsolated(isIsolated bool) CancelMarginOrderServiceAPI
+	OrderID(orderID int64) CancelMarginOrderServiceAPI
+	OrigClientOrderID(origClientOrderID string) CancelMarginOrderServiceAPI
+	NewClientOrderID(newClientOrderID string) CancelMarginOrderServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) (*CancelMarginOrderResponse, error)
+}
+
+type cancelMarginOrderServiceAPI CancelMarginOrderService
+
+func (a *cancelMarginOrderServiceAPI) Symbol(symbol string) CancelMarginOrderServiceAPI {
+	return (*cancelMarginOrderServiceAPI)((*CancelMarginOrderService)(a).Symbol(symbol))
+}
+
+func (a *cancelMarginOrderServiceAPI) IsIsolated(isIsolated bool) CancelMarginOrderServiceAPI {
+	return (*cancelMarginOrderServiceAPI)((*CancelMarginOrderService)(a).IsIsolated(isIsolated))
+}
+
+func (a *cancelMarginOrderServiceAPI) OrderID(orderID int64) CancelMarginOrderServiceAPI {
+	return (*cancelMarginOrderServiceAPI)((*CancelMarginOrderService)(a).OrderID(orderID))
+}
+
+func (a *cancelMarginOrderServiceAPI) OrigClientOrderID(origClientOrderID string) CancelMarginOrderServiceAPI {
+	return (*cancelMarginOrderServiceAPI)((*CancelMarginOrderService)(a).OrigClientOrderID(origClientOrderID))
+}
+
+func (a *cancelMarginOrderServiceAPI) NewClientOrderID(newClientOrderID string) CancelMarginOrderServiceAPI {
+	return (*cancelMarginOrderServiceAPI)((*CancelMarginOrderService)(a).NewClientOrderID(newClientOrderID))
+}
+
+func (a *cancelMarginOrderServiceAPI) Do(ctx context.Context, opts ...RequestOption) (*CancelMarginOrderResponse, error) {
+	return (*CancelMarginOrderService)(a).Do(ctx, opts...)
+}
+
+// CancelAllMarginOrdersServiceAPI is the interface of CancelAllMarginOrdersService
+type CancelAllMarginOrdersServiceAPI interface {
+	Symbol(symbol string) CancelAllMarginOrdersServiceAPI
+	IsIsolated(isIsolated bool) CancelAllMarginOrdersServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) ([]*CancelAllMarginOrdersResponse, error)
+}
+
+type cancelAllMarginOrdersServiceAPI CancelAllMarginOrdersService
+
+func (a *cancelAllMarginOrdersServiceAPI) Symbol(symbol string) CancelAllMarginOrdersServiceAPI {
+	return (*cancelAllMarginOrdersServiceAPI)((*CancelAllMarginOrdersService)(a).Symbol(symbol))
+}
+
+func (a *cancelAllMarginOrdersServiceAPI) IsIsolated(isIsolated bool) CancelAllMarginOrdersServiceAPI {
+	return (*cancelAllMarginOrdersServiceAPI)((*CancelAllMarginOrdersService)(a).IsIsolated(isIsolated))
+}
+
+func (a *cancelAllMarginOrdersServiceAPI) Do(ctx context.Context, opts ...RequestOption) ([]*CancelAllMarginOrdersResponse, error) {
+	return (*CancelAllMarginOrdersService)(a).Do(ctx, opts...)
+}
+
+// CreateMarginOCOServiceAPI is the interface of CreateMarginOCOService
+type CreateMarginOCOServiceAPI interface {
+	Symbol(symbol string) CreateMarginOCOServiceAPI
+	IsIsolated(isIsolated bool) CreateMarginOCOServiceAPI
+	Side(side SideType) CreateMarginOCOServiceAPI
+	Quantity(quantity string) CreateMarginOCOServiceAPI
+	ListClientOrderID(listClientOrderID string) CreateMarginOCOServiceAPI
+	LimitClientOrderID(limitClientOrderID string) CreateMarginOCOServiceAPI
+	Price(price string) CreateMarginOCOServiceAPI
+	LimitIcebergQuantity(limitIcebergQty string) CreateMarginOCOServiceAPI
+	StopClientOrderID(stopClientOrderID string) CreateMarginOCOServiceAPI
+	StopPrice(stopPrice string) CreateMarginOCOServiceAPI
+	StopLimitPrice(stopLimitPrice string) CreateMarginOCOServiceAPI
+	StopIcebergQty(stopIcebergQty string) CreateMarginOCOServiceAPI
+	StopLimitTimeInForce(stopLimitTimeInForce TimeInForceType) CreateMarginOCOServiceAPI
+	NewOrderRespType(newOrderRespType NewOrderRespType) CreateMarginOCOServiceAPI
+	SideEffectType(sideEffectType SideEffectType) CreateMarginOCOServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) (*CreateMarginOCOResponse, error)
+}
+
+type createMarginOCOServiceAPI CreateMarginOCOService
+
+func (a *createMarginOCOServiceAPI) Symbol(symbol string) CreateMarginOCOServiceAPI {
+	return (*createMarginOCOServiceAPI)((*CreateMarginOCOService)(a).Symbol(symbol))
+}
+
+func (a *createMarginOCOServiceAPI) IsIsolated(isIsolated bool) CreateMarginOCOServiceAPI {
+	return (*createMarginOCOServiceAPI)((*CreateMarginOCOService)(a).IsIsolated(isIsolated))
+}
+
+func (a *createMarginOCOServiceAPI) Side(side SideType) CreateMarginOCOServiceAPI {
+	return (*createMarginOCOServiceAPI)((*CreateMarginOCOService)(a).Side(side))
+}
+
+func (a *createMarginOCOServiceAPI) Quantity(quantity string) CreateMarginOCOServiceAPI {
+	return (*createMarginOCOServiceAPI)((*CreateMarginOCOService)(a).Quantity(quantity))
+}
+
+func (a *createMarginOCOServiceAPI) ListClientOrderID(listClientOrderID string) CreateMarginOCOServiceAPI {
+	return (*createMarginOCOServiceAPI)((*CreateMarginOCOService)(a).ListClientOrderID(listClientOrderID))
+}
+
+func (a *createMarginOCOServiceAPI) LimitClientOrderID(limitClientOrderID string) CreateMarginOCOServiceAPI {
+	return (*createMarginOCOServiceAPI)((*CreateMarginOCOService)(a).LimitClientOrderID(limitClientOrderID))
+}
+
+func (a *createMarginOCOServiceAPI) Price(price string) CreateMarginOCOServiceAPI {
+	return (*createMarginOCOServiceAPI)((*CreateMarginOCOService)(a).Price(price))
+}
+
+func (a *createMarginOCOServiceAPI) LimitIcebergQuantity(limitIcebergQty string) CreateMarginOCOServiceAPI {
+	return (*createMarginOCOServiceAPI)((*CreateMarginOCOService)(a).LimitIcebergQuantity(limitIcebergQty))
+}
+
+func (a *createMarginOCOServiceAPI) StopClientOrderID(stopClientOrderID string) CreateMarginOCOServiceAPI {
+	return (*createMarginOCOServiceAPI)((*CreateMarginOCOService)(a).StopClientOrderID(stopClientOrderID))
+}
+
+func (a *createMarginOCOServiceAPI) StopPrice(stopPrice string) CreateMarginOCOServiceAPI {
+	return (*createMarginOCOServiceAPI)((*CreateMarginOCOService)(a).StopPrice(stopPrice))
+}
+
+func (a *createMarginOCOServiceAPI) StopLimitPrice(stopLimitPrice string) CreateMarginOCOServiceAPI {
+	return (*createMarginOCOServiceAPI)((*CreateMarginOCOService)(a).StopLimitPrice(stopLimitPrice))
+}
+
+func (a *createMarginOCOServiceAPI) StopIcebergQty(stopIcebergQty string) CreateMarginOCOServiceAPI {
+	return (*createMarginOCOServiceAPI)((*CreateMarginOCOService)(a).StopIcebergQty(stopIcebergQty))
+}
+
+func (a *createMarginOCOServiceAPI) StopLimitTimeInForce(stopLimitTimeInForce TimeInForceType) CreateMarginOCOServiceAPI {
+	return (*createMarginOCOServiceAPI)((*CreateMarginOCOService)(a).StopLimitTimeInForce(stopLimitTimeInForce))
+}
+
+func (a *createMarginOCOServiceAPI) NewOrderRespType(newOrderRespType NewOrderRespType) CreateMarginOCOServiceAPI {
+	return (*createMarginOCOServiceAPI)((*CreateMarginOCOService)(a).NewOrderRespType(newOrderRespType))
+}
+
+func (a *createMarginOCOServiceAPI) SideEffectType(sideEffectType SideEffectType) CreateMarginOCOServiceAPI {
+	return (*createMarginOCOServiceAPI)((*CreateMarginOCOService)(a).SideEffectType(sideEffectType))
+}
+
+func (a *createMarginOCOServiceAPI) Do(ctx context.Context, opts ...RequestOption) (*CreateMarginOCOResponse, error) {
+	return (*CreateMarginOCOService)(a).Do(ctx, opts...)
+}
+
+// CancelMarginOCOServiceAPI is the interface of CancelMarginOCOService
+type CancelMarginOCOServiceAPI interface {
+	Symbol(symbol string) CancelMarginOCOServiceAPI
+	IsIsolated(isIsolated bool) CancelMarginOCOServiceAPI
+	ListClientOrderID(listClientOrderID string) CancelMarginOCOServiceAPI
+	OrderListID(orderListID int64) CancelMarginOCOServiceAPI
+	NewClientOrderID(newClientOrderID string) CancelMarginOCOServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) (*CancelMarginOCOResponse, error)
+}
+
+type cancelMarginOCOServiceAPI CancelMarginOCOService
+
+func (a *cancelMarginOCOServiceAPI) Symbol(symbol string) CancelMarginOCOServiceAPI {
+	return (*cancelMarginOCOServiceAPI)((*CancelMarginOCOService)(a).Symbol(symbol))
+}
+
+func (a *cancelMarginOCOServiceAPI) IsIsolated(isIsolated bool) CancelMarginOCOServiceAPI {
+	return (*cancelMarginOCOServiceAPI)((*CancelMarginOCOService)(a).IsIsolated(isIsolated))
+}
+
+func (a *cancelMarginOCOServiceAPI) ListClientOrderID(listClientOrderID string) CancelMarginOCOServiceAPI {
+	return (*cancelMarginOCOServiceAPI)((*CancelMarginOCOService)(a).ListClientOrderID(listClientOrderID))
+}
+
+func (a *cancelMarginOCOServiceAPI) OrderListID(orderListID int64) CancelMarginOCOServiceAPI {
+	return (*cancelMarginOCOServiceAPI)((*CancelMarginOCOService)(a).OrderListID(orderListID))
+}
+
+func (a *cancelMarginOCOServiceAPI) NewClientOrderID(newClientOrderID string) CancelMarginOCOServiceAPI {
+	return (*cancelMarginOCOServiceAPI)((*CancelMarginOCOService)(a).NewClientOrderID(newClientOrderID))
+}
+
+func (a *cancelMarginOCOServiceAPI) Do(ctx context.Context, opts ...RequestOption) (*CancelMarginOCOResponse, error) {
+	return (*CancelMarginOCOService)(a).Do(ctx, opts...)
+}
+
+// GetMarginOrderServiceAPI is the interface of GetMarginOrderService
+type GetMarginOrderServiceAPI interface {
+	IsIsolated(isIsolated bool) GetMarginOrderServiceAPI
+	Symbol(symbol string) GetMarginOrderServiceAPI
+	OrderID(orderID int64) GetMarginOrderServiceAPI
+	OrigClientOrderID(origClientOrderID string) GetMarginOrderServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) (*Order, error)
+}
+
+type getMarginOrderServiceAPI GetMarginOrderService
+
+func (a *getMarginOrderServiceAPI) IsIsolated(isIsolated bool) GetMarginOrderServiceAPI {
+	return (*getMarginOrderServiceAPI)((*GetMarginOrderService)(a).IsIsolated(isIsolated))
+}
+
+func (a *getMarginOrderServiceAPI) Symbol(symbol string) GetMarginOrderServiceAPI {
+	return (*getMarginOrderServiceAPI)((*GetMarginOrderService)(a).Symbol(symbol))
+}
+
+func (a *getMarginOrderServiceAPI) OrderID(orderID int64) GetMarginOrderServiceAPI {
+	return (*getMarginOrderServiceAPI)((*GetMarginOrderService)(a).OrderID(orderID))
+}
+
+func (a *getMarginOrderServiceAPI) OrigClientOrderID(origClientOrderID string) GetMarginOrderServiceAPI {
+	return (*getMarginOrderServiceAPI)((*GetMarginOrderService)(a).OrigClientOrderID(origClientOrderID))
+}
+
+func (a *getMarginOrderServiceAPI) Do(ctx context.Context, opts ...RequestOption) (*Order, error) {
+	return (*GetMarginOrderService)(a).Do(ctx, opts...)
+}
+
+// ListMarginLoansServiceAPI is the interface of ListMarginLoansService
+type ListMarginLoansServiceAPI interface {
+	Asset(asset string) ListMarginLoansServiceAPI
+	TxID(txID int64) ListMarginLoansServiceAPI
+	StartTime(startTime int64) ListMarginLoansServiceAPI
+	EndTime(endTime int64) ListMarginLoansServiceAPI
+	Current(current int64) ListMarginLoansServiceAPI
+	Size(size int64) ListMarginLoansServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) (*MarginLoanResponse, error)
+}
+
+type listMarginLoansServiceAPI ListMarginLoansService
+
+func (a *listMarginLoansServiceAPI) Asset(asset string) ListMarginLoansServiceAPI {
+	return (*listMarginLoansServiceAPI)((*ListMarginLoansService)(a).Asset(asset))
+}
+
+func (a *listMarginLoansServiceAPI) TxID(txID int64) ListMarginLoansServiceAPI {
+	return (*listMarginLoansServiceAPI)((*ListMarginLoansService)(a).TxID(txID))
+}
+
+func (a *listMarginLoansServiceAPI) StartTime(startTime int64) ListMarginLoansServiceAPI {
+	return (*listMarginLoansServiceAPI)((*ListMarginLoansService)(a).StartTime(startTime))
+}
+
+func (a *listMarginLoansServiceAPI) EndTime(endTime int64) ListMarginLoansServiceAPI {
+	return (*listMarginLoansServiceAPI)((*ListMarginLoansService)(a).EndTime(endTime))
+}
+
+func (a *listMarginLoansServiceAPI) Current(current int64) ListMarginLoansServiceAPI {
+	return (*listMarginLoansServiceAPI)((*ListMarginLoansService)(a).Current(current))
+}
+
+func (a *listMarginLoansServiceAPI) Size(size int64) ListMarginLoansServiceAPI {
+	return (*listMarginLoansServiceAPI)((*ListMarginLoansService)(a).Size(size))
+}
+
+func (a *listMarginLoansServiceAPI) Do(ctx context.Context, opts ...RequestOption) (*MarginLoanResponse, error) {
+	return (*ListMarginLoansService)(a).Do(ctx, opts...)
+}
+
+// ListMarginRepaysServiceAPI is the interface of ListMarginRepaysService
+type ListMarginRepaysServiceAPI interface {
+	Asset(asset string) ListMarginRepaysServiceAPI
+	TxID(txID int64) ListMarginRepaysServiceAPI
+	StartTime(startTime int64) ListMarginRepaysServiceAPI
+	EndTime(endTime int64) ListMarginRepaysServiceAPI
+	Current(current int64) ListMarginRepaysServiceAPI
+	Size(size int64) ListMarginRepaysServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) (*MarginRepayResponse, error)
+}
+
+type listMarginRepaysServiceAPI ListMarginRepaysService
+
+func (a *listMarginRepaysServiceAPI) Asset(asset string) ListMarginRepaysServiceAPI {
+	return (*listMarginRepaysServiceAPI)((*ListMarginRepaysService)(a).Asset(asset))
+}
+
+func (a *listMarginRepaysServiceAPI) TxID(txID int64) ListMarginRepaysServiceAPI {
+	return (*listMarginRepaysServiceAPI)((*ListMarginRepaysService)(a).TxID(txID))
+}
+
+func (a *listMarginRepaysServiceAPI) StartTime(startTime int64) ListMarginRepaysServiceAPI {
+	return (*listMarginRepaysServiceAPI)((*ListMarginRepaysService)(a).StartTime(startTime))
+}
+
+func (a *listMarginRepaysServiceAPI) EndTime(endTime int64) ListMarginRepaysServiceAPI {
+	return (*listMarginRepaysServiceAPI)((*ListMarginRepaysService)(a).EndTime(endTime))
+}
+
+func (a *listMarginRepaysServiceAPI) Current(current int64) ListMarginRepaysServiceAPI {
+	return (*listMarginRepaysServiceAPI)((*ListMarginRepaysService)(a).Current(current))
+}
+
+func (a *listMarginRepaysServiceAPI) Size(size int64) ListMarginRepaysServiceAPI {
+	return (*listMarginRepaysServiceAPI)((*ListMarginRepaysService)(a).Size(size))
+}
+
+func (a *listMarginRepaysServiceAPI) Do(ctx context.Context, opts ...RequestOption) (*MarginRepayResponse, error) {
+	return (*ListMarginRepaysService)(a).Do(ctx, opts...)
+}
+
+// GetMarginAccountServiceAPI is the interface of GetMarginAccountService
+type GetMarginAccountServiceAPI interface {
+	Do(ctx context.Context, opts ...RequestOption) (*MarginAccount, error)
+}
+
+type getMarginAccountServiceAPI GetMarginAccountService
+
+func (a *getMarginAccountServiceAPI) Do(ctx context.Context, opts ...RequestOption) (*MarginAccount, error) {
+	return (*GetMarginAccountService)(a).Do(ctx, opts...)
+}
+
+// GetIsolatedMarginAccountServiceAPI is the interface of GetIsolatedMarginAccountService
+type GetIsolatedMarginAccountServiceAPI interface {
+	Symbols(symbols ...string) GetIsolatedMarginAccountServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) (*IsolatedMarginAccount, error)
+}
+
+type getIsolatedMarginAccountServiceAPI GetIsolatedMarginAccountService
+
+func (a *getIsolatedMarginAccountServiceAPI) Symbols(symbols ...string) GetIsolatedMarginAccountServiceAPI {
+	return (*getIsolatedMarginAccountServiceAPI)((*GetIsolatedMarginAccountService)(a).Symbols(symbols...))
+}
+
+func (a *getIsolatedMarginAccountServiceAPI) Do(ctx context.Context, opts ...RequestOption) (*IsolatedMarginAccount, error) {
+	return (*GetIsolatedMarginAccountService)(a).Do(ctx, opts...)
+}
+
+// IsolatedMarginTransferServiceAPI is the interface of IsolatedMarginTransferService
+type IsolatedMarginTransferServiceAPI interface {
+	Symbol(symbol string) IsolatedMarginTransferServiceAPI
+	Asset(asset string) IsolatedMarginTransferServiceAPI
+	TransFrom(transFrom AccountType) IsolatedMarginTransferServiceAPI
+	TransTo(transTo AccountType) IsolatedMarginTransferServiceAPI
+	Amount(amount string) IsolatedMarginTransferServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) (*TransactionResponse, error)
+}
+
+type isolatedMarginTransferServiceAPI IsolatedMarginTransferService
+
+func (a *isolatedMarginTransferServiceAPI) Symbol(symbol string) IsolatedMarginTransferServiceAPI {
+	return (*isolatedMarginTransferServiceAPI)((*IsolatedMarginTransferService)(a).Symbol(symbol))
+}
+
+func (a *isolatedMarginTransferServiceAPI) Asset(asset string) IsolatedMarginTransferServiceAPI {
+	return (*isolatedMarginTransferServiceAPI)((*IsolatedMarginTransferService)(a).Asset(asset))
+}
+
+func (a *isolatedMarginTransferServiceAPI) TransFrom(transFrom AccountType) IsolatedMarginTransferServiceAPI {
+	return (*isolatedMarginTransferServiceAPI)((*IsolatedMarginTransferService)(a).TransFrom(transFrom))
+}
+
+func (a *isolatedMarginTransferServiceAPI) TransTo(transTo AccountType) IsolatedMarginTransferServiceAPI {
+	return (*isolatedMarginTransferServiceAPI)((*IsolatedMarginTransferService)(a).TransTo(transTo))
+}
+
+func (a *isolatedMarginTransferServiceAPI) Amount(amount string) IsolatedMarginTransferServiceAPI {
+	return (*isolatedMarginTransferServiceAPI)((*IsolatedMarginTransferService)(a).Amount(amount))
+}
+
+func (a *isolatedMarginTransferServiceAPI) Do(ctx context.Context, opts ...RequestOption) (*TransactionResponse, error) {
+	return (*IsolatedMarginTransferService)(a).Do(ctx, opts...)
+}
+
+// GetMarginAssetServiceAPI is the interface of GetMarginAssetService
+type GetMarginAssetServiceAPI interface {
+	Asset(asset string) GetMarginAssetServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) (*MarginAsset, error)
+}
+
+type getMarginAssetServiceAPI GetMarginAssetService
+
+func (a *getMarginAssetServiceAPI) Asset(asset string) GetMarginAssetServiceAPI {
+	return (*getMarginAssetServiceAPI)((*GetMarginAssetService)(a).Asset(asset))
+}
+
+func (a *getMarginAssetServiceAPI) Do(ctx context.Context, opts ...RequestOption) (*MarginAsset, error) {
+	return (*GetMarginAssetService)(a).Do(ctx, opts...)
+}
+
+// GetMarginPairServiceAPI is the interface of GetMarginPairService
+type GetMarginPairServiceAPI interface {
+	Symbol(symbol string) GetMarginPairServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) (*MarginPair, error)
+}
+
+type getMarginPairServiceAPI GetMarginPairService
+
+func (a *getMarginPairServiceAPI) Symbol(symbol string) GetMarginPairServiceAPI {
+	return (*getMarginPairServiceAPI)((*GetMarginPairService)(a).Symbol(symbol))
+}
+
+func (a *getMarginPairServiceAPI) Do(ctx context.Context, opts ...RequestOption) (*MarginPair, error) {
+	return (*GetMarginPairService)(a).Do(ctx, opts...)
+}
+
+// GetMarginAllPairsServiceAPI is the interface of GetMarginAllPairsService
+type GetMarginAllPairsServiceAPI interface {
+	Do(ctx context.Context, opts ...RequestOption) ([]*MarginAllPair, error)
+}
+
+type getMarginAllPairsServiceAPI GetMarginAllPairsService
+
+func (a *getMarginAllPairsServiceAPI) Do(ctx context.Context, opts ...RequestOption) ([]*MarginAllPair, error) {
+	return (*GetMarginAllPairsService)(a).Do(ctx, opts...)
+}
+
+// GetMarginPriceIndexServiceAPI is the interface of GetMarginPriceIndexService
+type GetMarginPriceIndexServiceAPI interface {
+	Symbol(symbol string) GetMarginPriceIndexServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) (*MarginPriceIndex, error)
+}
+
+type getMarginPriceIndexServiceAPI GetMarginPriceIndexService
+
+func (a *getMarginPriceIndexServiceAPI) Symbol(symbol string) GetMarginPriceIndexServiceAPI {
+	return (*getMarginPriceIndexServiceAPI)((*GetMarginPriceIndexService)(a).Symbol(symbol))
+}
+
+func (a *getMarginPriceIndexServiceAPI) Do(ctx context.Context, opts ...RequestOption) (*MarginPriceIndex, error) {
+	return (*GetMarginPriceIndexService)(a).Do(ctx, opts...)
+}
+
+// ListMarginOpenOrdersServiceAPI is the interface of ListMarginOpenOrdersService
+type ListMarginOpenOrdersServiceAPI interface {
+	Symbol(symbol string) ListMarginOpenOrdersServiceAPI
+	IsIsolated(isIsolated bool) ListMarginOpenOrdersServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) ([]*Order, error)
+}
+
+type listMarginOpenOrdersServiceAPI ListMarginOpenOrdersService
+
+func (a *listMarginOpenOrdersServiceAPI) Symbol(symbol string) ListMarginOpenOrdersServiceAPI {
+	return (*listMarginOpenOrdersServiceAPI)((*ListMarginOpenOrdersService)(a).Symbol(symbol))
+}
+
+func (a *listMarginOpenOrdersServiceAPI) IsIsolated(isIsolated bool) ListMarginOpenOrdersServiceAPI {
+	return (*listMarginOpenOrdersServiceAPI)((*ListMarginOpenOrdersService)(a).IsIsolated(isIsolated))
+}
+
+func (a *listMarginOpenOrdersServiceAPI) Do(ctx context.Context, opts ...RequestOption) ([]*Order, error) {
+	return (*ListMarginOpenOrdersService)(a).Do(ctx, opts...)
+}
+
+// ListMarginOrdersServiceAPI is the interface of ListMarginOrdersService
+type ListMarginOrdersServiceAPI interface {
+	Symbol(symbol string) ListMarginOrdersServiceAPI
+	IsIsolated(isIsolated bool) ListMarginOrdersServiceAPI
+	OrderID(orderID int64) ListMarginOrdersServiceAPI
+	StartTime(startTime int64) ListMarginOrdersServiceAPI
+	EndTime(endTime int64) ListMarginOrdersServiceAPI
+	Limit(limit int) ListMarginOrdersServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) ([]*Order, error)
+}
+
+type listMarginOrdersServiceAPI ListMarginOrdersService
+
+func (a *listMarginOrdersServiceAPI) Symbol(symbol string) ListMarginOrdersServiceAPI {
+	return (*listMarginOrdersServiceAPI)((*ListMarginOrdersService)(a).Symbol(symbol))
+}
+
+func (a *listMarginOrdersServiceAPI) IsIsolated(isIsolated bool) ListMarginOrdersServiceAPI {
+	return (*listMarginOrdersServiceAPI)((*ListMarginOrdersService)(a).IsIsolated(isIsolated))
+}
+
+func (a *listMarginOrdersServiceAPI) OrderID(orderID int64) ListMarginOrdersServiceAPI {
+	return (*listMarginOrdersServiceAPI)((*ListMarginOrdersService)(a).OrderID(orderID))
+}
+
+func (a *listMarginOrdersServiceAPI) StartTime(startTime int64) ListMarginOrdersServiceAPI {
+	return (*listMarginOrdersServiceAPI)((*ListMarginOrdersService)(a).StartTime(startTime))
+}
+
+func (a *listMarginOrdersServiceAPI) EndTime(endTime int64) ListMarginOrdersServiceAPI {
+	return (*listMarginOrdersServiceAPI)((*ListMarginOrdersService)(a).EndTime(endTime))
+}
+
+func (a *listMarginOrdersServiceAPI) Limit(limit int) ListMarginOrdersServiceAPI {
+	return (*listMarginOrdersServiceAPI)((*ListMarginOrdersService)(a).Limit(limit))
+}
+
+func (a *listMarginOrdersServiceAPI) Do(ctx context.Context, opts ...RequestOption) ([]*Order, error) {
+	return (*ListMarginOrdersService)(a).Do(ctx, opts...)
+}
+
+// ListMarginTradesServiceAPI is the interface of ListMarginTradesService
+type ListMarginTradesServiceAPI interface {
+	Symbol(symbol string) ListMarginTradesServiceAPI
+	IsIsolated(isIsolated bool) ListMarginTradesServiceAPI
+	StartTime(startTime int64) ListMarginTradesServiceAPI
+	EndTime(endTime int64) ListMarginTradesServiceAPI
+	Limit(limit int) ListMarginTradesServiceAPI
+	FromID(fromID int64) ListMarginTradesServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) ([]*TradeV3, error)
+}
+
+type listMarginTradesServiceAPI ListMarginTradesService
+
+func (a *listMarginTradesServiceAPI) Symbol(symbol string) ListMarginTradesServiceAPI {
+	return (*listMarginTradesServiceAPI)((*ListMarginTradesService)(a).Symbol(symbol))
+}
+
+func (a *listMarginTradesServiceAPI) IsIsolated(isIsolated bool) ListMarginTradesServiceAPI {
+	return (*listMarginTradesServiceAPI)((*ListMarginTradesService)(a).IsIsolated(isIsolated))
+}
+
+func (a *listMarginTradesServiceAPI) StartTime(startTime int64) ListMarginTradesServiceAPI {
+	return (*listMarginTradesServiceAPI)((*ListMarginTradesService)(a).StartTime(startTime))
+}
+
+func (a *listMarginTradesServiceAPI) EndTime(endTime int64) ListMarginTradesServiceAPI {
+	return (*listMarginTradesServiceAPI)((*ListMarginTradesService)(a).EndTime(endTime))
+}
+
+func (a *listMarginTradesServiceAPI) Limit(limit int) ListMarginTradesServiceAPI {
+	return (*listMarginTradesServiceAPI)((*ListMarginTradesService)(a).Limit(limit))
+}
+
+func (a *listMarginTradesServiceAPI) FromID(fromID int64) ListMarginTradesServiceAPI {
+	return (*listMarginTradesServiceAPI)((*ListMarginTradesService)(a).FromID(fromID))
+}
+
+func (a *listMarginTradesServiceAPI) Do(ctx context.Context, opts ...RequestOption) ([]*TradeV3, error) {
+	return (*ListMarginTradesService)(a).Do(ctx, opts...)
+}
+
+// GetMaxBorrowableServiceAPI is the interface of GetMaxBorrowableService
+type GetMaxBorrowableServiceAPI interface {
+	Asset(asset string) GetMaxBorrowableServiceAPI
+	IsolatedSymbol(isolatedSymbol string) GetMaxBorrowableServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) (*MaxBorrowable, error)
+}
+
+type getMaxBorrowableServiceAPI GetMaxBorrowableService
+
+func (a *getMaxBorrowableServiceAPI) Asset(asset string) GetMaxBorrowableServiceAPI {
+	return (*getMaxBorrowableServiceAPI)((*GetMaxBorrowableService)(a).Asset(asset))
+}
+
+func (a *getMaxBorrowableServiceAPI) IsolatedSymbol(isolatedSymbol string) GetMaxBorrowableServiceAPI {
+	return (*getMaxBorrowableServiceAPI)((*GetMaxBorrowableService)(a).IsolatedSymbol(isolatedSymbol))
+}
+
+func (a *getMaxBorrowableServiceAPI) Do(ctx context.Context, opts ...RequestOption) (*MaxBorrowable, error) {
+	return (*GetMaxBorrowableService)(a).Do(ctx, opts...)
+}
+
+// GetMaxTransferableServiceAPI is the interface of GetMaxTransferableService
+type GetMaxTransferableServiceAPI interface {
+	Asset(asset string) GetMaxTransferableServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) (*MaxTransferable, error)
+}
+
+type getMaxTransferableServiceAPI GetMaxTransferableService
+
+func (a *getMaxTransferableServiceAPI) Asset(asset string) GetMaxTransferableServiceAPI {
+	return (*getMaxTransferableServiceAPI)((*GetMaxTransferableService)(a).Asset(asset))
+}
+
+func (a *getMaxTransferableServiceAPI) Do(ctx context.Context, opts ...RequestOption) (*MaxTransferable, error) {
+	return (*GetMaxTransferableService)(a).Do(ctx, opts...)
+}
+
+// StartMarginUserStreamServiceAPI is the interface of StartMarginUserStreamService
+type StartMarginUserStreamServiceAPI interface {
+	Do(ctx context.Context, opts ...RequestOption) (string, error)
+}
+
+type startMarginUserStreamServiceAPI StartMarginUserStreamService
+
+func (a *startMarginUserStreamServiceAPI) Do(ctx context.Context, opts ...RequestOption) (string, error) {
+	return (*StartMarginUserStreamService)(a).Do(ctx, opts...)
+}
+
+// KeepaliveMarginUserStreamServiceAPI is the interface of KeepaliveMarginUserStreamService
+type KeepaliveMarginUserStreamServiceAPI interface {
+	ListenKey(listenKey string) KeepaliveMarginUserStreamServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) error
+}
+
+type keepaliveMarginUserStreamServiceAPI KeepaliveMarginUserStreamService
+
+func (a *keepaliveMarginUserStreamServiceAPI) ListenKey(listenKey string) KeepaliveMarginUserStreamServiceAPI {
+	return (*keepaliveMarginUserStreamServiceAPI)((*KeepaliveMarginUserStreamService)(a).ListenKey(listenKey))
+}
+
+func (a *keepaliveMarginUserStreamServiceAPI) Do(ctx context.Context, opts ...RequestOption) error {
+	return (*KeepaliveMarginUserStreamService)(a).Do(ctx, opts...)
+}
+
+// CloseMarginUserStreamServiceAPI is the interface of CloseMarginUserStreamService
+type CloseMarginUserStreamServiceAPI interface {
+	ListenKey(listenKey string) CloseMarginUserStreamServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) error
+}
+
+type closeMarginUserStreamServiceAPI CloseMarginUserStreamService
+
+func (a *closeMarginUserStreamServiceAPI) ListenKey(listenKey string) CloseMarginUserStreamServiceAPI {
+	return (*closeMarginUserStreamServiceAPI)((*CloseMarginUserStreamService)(a).ListenKey(listenKey))
+}
+
+func (a *closeMarginUserStreamServiceAPI) Do(ctx context.Context, opts ...RequestOption) error {
+	return (*CloseMarginUserStreamService)(a).Do(ctx, opts...)
+}
+
+// StartIsolatedMarginUserStreamServiceAPI is the interface of StartIsolatedMarginUserStreamService
+type StartIsolatedMarginUserStreamServiceAPI interface {
+	Symbol(symbol string) StartIsolatedMarginUserStreamServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) (string, error)
+}
+
+type startIsolatedMarginUserStreamServiceAPI StartIsolatedMarginUserStreamService
+
+func (a *startIsolatedMarginUserStreamServiceAPI) Symbol(symbol string) StartIsolatedMarginUserStreamServiceAPI {
+	return (*startIsolatedMarginUserStreamServiceAPI)((*StartIsolatedMarginUserStreamService)(a).Symbol(symbol))
+}
+
+func (a *startIsolatedMarginUserStreamServiceAPI) Do(ctx context.Context, opts ...RequestOption) (string, error) {
+	return (*StartIsolatedMarginUserStreamService)(a).Do(ctx, opts...)
+}
+
+// KeepaliveIsolatedMarginUserStreamServiceAPI is the interface of KeepaliveIsolatedMarginUserStreamService
+type KeepaliveIsolatedMarginUserStreamServiceAPI interface {
+	Symbol(symbol string) KeepaliveIsolatedMarginUserStreamServiceAPI
+	ListenKey(listenKey string) KeepaliveIsolatedMarginUserStreamServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) error
+}
+
+type keepaliveIsolatedMarginUserStreamServiceAPI KeepaliveIsolatedMarginUserStreamService
+
+func (a *keepaliveIsolatedMarginUserStreamServiceAPI) Symbol(symbol string) KeepaliveIsolatedMarginUserStreamServiceAPI {
+	return (*keepaliveIsolatedMarginUserStreamServiceAPI)((*KeepaliveIsolatedMarginUserStreamService)(a).Symbol(symbol))
+}
+
+func (a *keepaliveIsolatedMarginUserStreamServiceAPI) ListenKey(listenKey string) KeepaliveIsolatedMarginUserStreamServiceAPI {
+	return (*keepaliveIsolatedMarginUserStreamServiceAPI)((*KeepaliveIsolatedMarginUserStreamService)(a).ListenKey(listenKey))
+}
+
+func (a *keepaliveIsolatedMarginUserStreamServiceAPI) Do(ctx context.Context, opts ...RequestOption) error {
+	return (*KeepaliveIsolatedMarginUserStreamService)(a).Do(ctx, opts...)
+}
+
+// CloseIsolatedMarginUserStreamServiceAPI is the interface of CloseIsolatedMarginUserStreamService
+type CloseIsolatedMarginUserStreamServiceAPI interface {
+	ListenKey(listenKey string) CloseIsolatedMarginUserStreamServiceAPI
+	Symbol(symbol string) CloseIsolatedMarginUserStreamServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) error
+}
+
+type closeIsolatedMarginUserStreamServiceAPI CloseIsolatedMarginUserStreamService
+
+func (a *closeIsolatedMarginUserStreamServiceAPI) ListenKey(listenKey string) CloseIsolatedMarginUserStreamServiceAPI {
+	return (*closeIsolatedMarginUserStreamServiceAPI)((*CloseIsolatedMarginUserStreamService)(a).ListenKey(listenKey))
+}
+
+func (a *closeIsolatedMarginUserStreamServiceAPI) Symbol(symbol string) CloseIsolatedMarginUserStreamServiceAPI {
+	return (*closeIsolatedMarginUserStreamServiceAPI)((*CloseIsolatedMarginUserStreamService)(a).Symbol(symbol))
+}
+
+func (a *closeIsolatedMarginUserStreamServiceAPI) Do(ctx context.Context, opts ...RequestOption) error {
+	return (*CloseIsolatedMarginUserStreamService)(a).Do(ctx, opts...)
+}
+
+// MarginInterestHistoryServiceAPI is the interface of MarginInterestHistoryService
+type MarginInterestHistoryServiceAPI interface {
+	Asset(asset string) MarginInterestHistoryServiceAPI
+	IsolatedSymbol(symbol string) MarginInterestHistoryServiceAPI
+	StartTime(startTime int64) MarginInterestHistoryServiceAPI
+	EndTime(endTime int64) MarginInterestHistoryServiceAPI
+	Current(current int64) MarginInterestHistoryServiceAPI
+	Size(size int64) MarginInterestHistoryServiceAPI
+	Do(ctx context.Context) (*MarginInterestHistory, error)
+}
+
+type marginInterestHistoryServiceAPI MarginInterestHistoryService
+
+func (a *marginInterestHistoryServiceAPI) Asset(asset string) MarginInterestHistoryServiceAPI {
+	return (*marginInterestHistoryServiceAPI)((*MarginInterestHistoryService)(a).Asset(asset))
+}
+
+func (a *marginInterestHistoryServiceAPI) IsolatedSymbol(symbol string) MarginInterestHistoryServiceAPI {
+	return (*marginInterestHistoryServiceAPI)((*MarginInterestHistoryService)(a).IsolatedSymbol(symbol))
+}
+
+func (a *marginInterestHistoryServiceAPI) StartTime(startTime int64) MarginInterestHistoryServiceAPI {
+	return (*marginInterestHistoryServiceAPI)((*MarginInterestHistoryService)(a).StartTime(startTime))
+}
+
+func (a *marginInterestHistoryServiceAPI) EndTime(endTime int64) MarginInterestHistoryServiceAPI {
+	return (*marginInterestHistoryServiceAPI)((*MarginInterestHistoryService)(a).EndTime(endTime))
+}
+
+func (a *marginInterestHistoryServiceAPI) Current(current int64) MarginInterestHistoryServiceAPI {
+	return (*marginInterestHistoryServiceAPI)((*MarginInterestHistoryService)(a).Current(current))
+}
+
+func (a *marginInterestHistoryServiceAPI) Size(size int64) MarginInterestHistoryServiceAPI {
+	return (*marginInterestHistoryServiceAPI)((*MarginInterestHistoryService)(a).Size(size))
+}
+
+func (a *marginInterestHistoryServiceAPI) Do(ctx context.Context) (*MarginInterestHistory, error) {
+	return (*MarginInterestHistoryService)(a).Do(ctx)
+}
+
+// MarginInterestRateHistoryServiceAPI is the interface of MarginInterestRateHistoryService
+type MarginInterestRateHistoryServiceAPI interface {
+	Asset(asset string) MarginInterestRateHistoryServiceAPI
+	VipLevel(vipLevel int64) MarginInterestRateHistoryServiceAPI
+	StartTime(startTime int64) MarginInterestRateHistoryServiceAPI
+	EndTime(endTime int64) MarginInterestRateHistoryServiceAPI
+	Do(ctx context.Context) (*MarginInterestRateHistory, error)
+}
+
+type marginInterestRateHistoryServiceAPI MarginInterestRateHistoryService
+
+func (a *marginInterestRateHistoryServiceAPI) Asset(asset string) MarginInterestRateHistoryServiceAPI {
+	return (*marginInterestRateHistoryServiceAPI)((*MarginInterestRateHistoryService)(a).Asset(asset))
+}
+
+func (a *marginInterestRateHistoryServiceAPI) VipLevel(vipLevel int64) MarginInterestRateHistoryServiceAPI {
+	return (*marginInterestRateHistoryServiceAPI)((*MarginInterestRateHistoryService)(a).VipLevel(vipLevel))
+}
+
+func (a *marginInterestRateHistoryServiceAPI) StartTime(startTime int64) MarginInterestRateHistoryServiceAPI {
+	return (*marginInterestRateHistoryServiceAPI)((*MarginInterestRateHistoryService)(a).StartTime(startTime))
+}
+
+func (a *marginInterestRateHistoryServiceAPI) EndTime(endTime int64) MarginInterestRateHistoryServiceAPI {
+	return (*marginInterestRateHistoryServiceAPI)((*MarginInterestRateHistoryService)(a).EndTime(endTime))
+}
+
+func (a *marginInterestRateHistoryServiceAPI) Do(ctx context.Context) (*MarginInterestRateHistory, error) {
+	return (*MarginInterestRateHistoryService)(a).Do(ctx)
+}
+
+// MarginNextHourlyInterestRateServiceAPI is the interface of MarginNextHourlyInterestRateService
+type MarginNextHourlyInterestRateServiceAPI interface {
+	Assets(assets string) MarginNextHourlyInterestRateServiceAPI
+	Isolated(isolated bool) MarginNextHourlyInterestRateServiceAPI
+	Do(ctx context.Context) (*MarginNextHourlyInterestRate, error)
+}
+
+type marginNextHourlyInterestRateServiceAPI MarginNextHourlyInterestRateService
+
+func (a *marginNextHourlyInterestRateServiceAPI) Assets(assets string) MarginNextHourlyInterestRateServiceAPI {
+	return (*marginNextHourlyInterestRateServiceAPI)((*MarginNextHourlyInterestRateService)(a).Assets(assets))
+}
+
+func (a *marginNextHourlyInterestRateServiceAPI) Isolated(isolated bool) MarginNextHourlyInterestRateServiceAPI {
+	return (*marginNextHourlyInterestRateServiceAPI)((*MarginNextHourlyInterestRateService)(a).Isolated(isolated))
+}
+
+func (a *marginNextHourlyInterestRateServiceAPI) Do(ctx context.Context) (*MarginNextHourlyInterestRate, error) {
+	return (*MarginNextHourlyInterestRateService)(a).Do(ctx)
+}
+
+// MarginAvailableInventoryServiceAPI is the interface of MarginAvailableInventoryService
+type MarginAvailableInventoryServiceAPI interface {
+	MarginType(marginType string) MarginAvailableInventoryServiceAPI
+}
+
+type marginAvailableInventoryServiceAPI MarginAvailableInventoryService
+
+func (a *marginAvailableInventoryServiceAPI) MarginType(marginType string) MarginAvailableInventoryServiceAPI {
+	return (*marginAvailableInventoryServiceAPI)((*MarginAvailableInventoryService)(a).MarginType(marginType))
+}
+
+// FuturesTransferServiceAPI is the interface of FuturesTransferService
+type FuturesTransferServiceAPI interface {
+	Asset(asset string) FuturesTransferServiceAPI
+	Amount(amount string) FuturesTransferServiceAPI
+	Type(transferType FuturesTransferType) FuturesTransferServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) (*TransactionResponse, error)
+}
+
+type futuresTransferServiceAPI FuturesTransferService
+
+func (a *futuresTransferServiceAPI) Asset(asset string) FuturesTransferServiceAPI {
+	return (*futuresTransferServiceAPI)((*FuturesTransferService)(a).Asset(asset))
+}
+
+func (a *futuresTransferServiceAPI) Amount(amount string) FuturesTransferServiceAPI {
+	return (*futuresTransferServiceAPI)((*FuturesTransferService)(a).Amount(amount))
+}
+
+func (a *futuresTransferServiceAPI) Type(transferType FuturesTransferType) FuturesTransferServiceAPI {
+	return (*futuresTransferServiceAPI)((*FuturesTransferService)(a).Type(transferType))
+}
+
+func (a *futuresTransferServiceAPI) Do(ctx context.Context, opts ...RequestOption) (*TransactionResponse, error) {
+	return (*FuturesTransferService)(a).Do(ctx, opts...)
+}
+
+// ListFuturesTransferServiceAPI is the interface of ListFuturesTransferService
+type ListFuturesTransferServiceAPI interface {
+	Asset(asset string) ListFuturesTransferServiceAPI
+	StartTime(startTime int64) ListFuturesTransferServiceAPI
+	EndTime(endTime int64) ListFuturesTransferServiceAPI
+	Current(current int64) ListFuturesTransferServiceAPI
+	Size(size int64) ListFuturesTransferServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) (*FuturesTransferHistory, error)
+}
+
+type listFuturesTransferServiceAPI ListFuturesTransferService
+
+func (a *listFuturesTransferServiceAPI) Asset(asset string) ListFuturesTransferServiceAPI {
+	return (*listFuturesTransferServiceAPI)((*ListFuturesTransferService)(a).Asset(asset))
+}
+
+func (a *listFuturesTransferServiceAPI) StartTime(startTime int64) ListFuturesTransferServiceAPI {
+	return (*listFuturesTransferServiceAPI)((*ListFuturesTransferService)(a).StartTime(startTime))
+}
+
+func (a *listFuturesTransferServiceAPI) EndTime(endTime int64) ListFuturesTransferServiceAPI {
+	return (*listFuturesTransferServiceAPI)((*ListFuturesTransferService)(a).EndTime(endTime))
+}
+
+func (a *listFuturesTransferServiceAPI) Current(current int64) ListFuturesTransferServiceAPI {
+	return (*listFuturesTransferServiceAPI)((*ListFuturesTransferService)(a).Current(current))
+}
+
+func (a *listFuturesTransferServiceAPI) Size(size int64) ListFuturesTransferServiceAPI {
+	return (*listFuturesTransferServiceAPI)((*ListFuturesTransferService)(a).Size(size))
+}
+
+func (a *listFuturesTransferServiceAPI) Do(ctx context.Context, opts ...RequestOption) (*FuturesTransferHistory, error) {
+	return (*ListFuturesTransferService)(a).Do(ctx, opts...)
+}
+
+// ListDustLogServiceAPI is the interface of ListDustLogService
+type ListDustLogServiceAPI interface {
+	StartTime(startTime int64) ListDustLogServiceAPI
+	EndTime(endTime int64) ListDustLogServiceAPI
+	Do(ctx context.Context) (*DustResult, error)
+}
+
+type listDustLogServiceAPI ListDustLogService
+
+func (a *listDustLogServiceAPI) StartTime(startTime int64) ListDustLogServiceAPI {
+	return (*listDustLogServiceAPI)((*ListDustLogService)(a).StartTime(startTime))
+}
+
+func (a *listDustLogServiceAPI) EndTime(endTime int64) ListDustLogServiceAPI {
+	return (*listDustLogServiceAPI)((*ListDustLogService)(a).EndTime(endTime))
+}
+
+func (a *listDustLogServiceAPI) Do(ctx context.Context) (*DustResult, error) {
+	return (*ListDustLogService)(a).Do(ctx)
+}
+
+// DustTransferServiceAPI is the interface of DustTransferService
+type DustTransferServiceAPI interface {
+	Asset(asset []string) DustTransferServiceAPI
+	Do(ctx context.Context) (*DustTransferResponse, error)
+}
+
+type dustTransferServiceAPI DustTransferService
+
+func (a *dustTransferServiceAPI) Asset(asset []string) DustTransferServiceAPI {
+	return (*dustTransferServiceAPI)((*DustTransferService)(a).Asset(asset))
+}
+
+func (a *dustTransferServiceAPI) Do(ctx context.Context) (*DustTransferResponse, error) {
+	return (*DustTransferService)(a).Do(ctx)
+}
+
+// ListDustServiceAPI is the interface of ListDustService
+type ListDustServiceAPI interface {
+	Do(ctx context.Context) (*ListDustResponse, error)
+}
+
+type listDustServiceAPI ListDustService
+
+func (a *listDustServiceAPI) Do(ctx context.Context) (*ListDustResponse, error) {
+	return (*ListDustService)(a).Do(ctx)
+}
+
+// TransferToSubAccountServiceAPI is the interface of TransferToSubAccountService
+type TransferToSubAccountServiceAPI interface {
+	ToEmail(toEmail string) TransferToSubAccountServiceAPI
+	Asset(asset string) TransferToSubAccountServiceAPI
+	Amount(amount string) TransferToSubAccountServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) (*TransferToSubAccountResponse, error)
+}
+
+type transferToSubAccountServiceAPI TransferToSubAccountService
+
+func (a *transferToSubAccountServiceAPI) ToEmail(toEmail string) TransferToSubAccountServiceAPI {
+	return (*transferToSubAccountServiceAPI)((*TransferToSubAccountService)(a).ToEmail(toEmail))
+}
+
+func (a *transferToSubAccountServiceAPI) Asset(asset string) TransferToSubAccountServiceAPI {
+	return (*transferToSubAccountServiceAPI)((*TransferToSubAccountService)(a).Asset(asset))
+}
+
+func (a *transferToSubAccountServiceAPI) Amount(amount string) TransferToSubAccountServiceAPI {
+	return (*transferToSubAccountServiceAPI)((*TransferToSubAccountService)(a).Amount(amount))
+}
+
+func (a *transferToSubAccountServiceAPI) Do(ctx context.Context, opts ...RequestOption) (*TransferToSubAccountResponse, error) {
+	return (*TransferToSubAccountService)(a).Do(ctx, opts...)
+}
+
+// SubaccountAssetsServiceAPI is the interface of SubaccountAssetsService
+type SubaccountAssetsServiceAPI interface {
+	Email(email string) SubaccountAssetsServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) (*SubaccountAssetsResponse, error)
+}
+
+type subaccountAssetsServiceAPI SubaccountAssetsService
+
+func (a *subaccountAssetsServiceAPI) Email(email string) SubaccountAssetsServiceAPI {
+	return (*subaccountAssetsServiceAPI)((*SubaccountAssetsService)(a).Email(email))
+}
+
+func (a *subaccountAssetsServiceAPI) Do(ctx context.Context, opts ...RequestOption) (*SubaccountAssetsResponse, error) {
+	return (*SubaccountAssetsService)(a).Do(ctx, opts...)
+}
+
+// SubaccountSpotSummaryServiceAPI is the interface of SubaccountSpotSummaryService
+type SubaccountSpotSummaryServiceAPI interface {
+	Email(email string) SubaccountSpotSummaryServiceAPI
+	Page(page int32) SubaccountSpotSummaryServiceAPI
+	Size(size int32) SubaccountSpotSummaryServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) (*SubaccountSpotSummaryResponse, error)
+}
+
+type subaccountSpotSummaryServiceAPI SubaccountSpotSummaryService
+
+func (a *subaccountSpotSummaryServiceAPI) Email(email string) SubaccountSpotSummaryServiceAPI {
+	return (*subaccountSpotSummaryServiceAPI)((*SubaccountSpotSummaryService)(a).Email(email))
+}
+
+func (a *subaccountSpotSummaryServiceAPI) Page(page int32) SubaccountSpotSummaryServiceAPI {
+	return (*subaccountSpotSummaryServiceAPI)((*SubaccountSpotSummaryService)(a).Page(page))
+}
+
+func (a *subaccountSpotSummaryServiceAPI) Size(size int32) SubaccountSpotSummaryServiceAPI {
+	return (*subaccountSpotSummaryServiceAPI)((*SubaccountSpotSummaryService)(a).Size(size))
+}
+
+func (a *subaccountSpotSummaryServiceAPI) Do(ctx context.Context, opts ...RequestOption) (*SubaccountSpotSummaryResponse, error) {
+	return (*SubaccountSpotSummaryService)(a).Do(ctx, opts...)
+}
+
+// SubaccountDepositAddressServiceAPI is the interface of SubaccountDepositAddressService
+type SubaccountDepositAddressServiceAPI interface {
+	Email(email string) SubaccountDepositAddressServiceAPI
+	Coin(coin string) SubaccountDepositAddressServiceAPI
+	Network(network string) SubaccountDepositAddressServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) (*SubaccountDepositAddressResponse, error)
+}
+
+type subaccountDepositAddressServiceAPI SubaccountDepositAddressService
+
+func (a *subaccountDepositAddressServiceAPI) Email(email string) SubaccountDepositAddressServiceAPI {
+	return (*subaccountDepositAddressServiceAPI)((*SubaccountDepositAddressService)(a).Email(email))
+}
+
+func (a *subaccountDepositAddressServiceAPI) Coin(coin string) SubaccountDepositAddressServiceAPI {
+	return (*subaccountDepositAddressServiceAPI)((*SubaccountDepositAddressService)(a).Coin(coin))
+}
+
+func (a *subaccountDepositAddressServiceAPI) Network(network string) SubaccountDepositAddressServiceAPI {
+	return (*subaccountDepositAddressServiceAPI)((*SubaccountDepositAddressService)(a).Network(network))
+}
+
+func (a *subaccountDepositAddressServiceAPI) Do(ctx context.Context, opts ...RequestOption) (*SubaccountDepositAddressResponse, error) {
+	return (*SubaccountDepositAddressService)(a).Do(ctx, opts...)
+}
+
+// AssetDividendServiceAPI is the interface of AssetDividendService
+type AssetDividendServiceAPI interface {
+	Asset(asset string) AssetDividendServiceAPI
+	Limit(limit int) AssetDividendServiceAPI
+	StartTime(startTime int64) AssetDividendServiceAPI
+	EndTime(endTime int64) AssetDividendServiceAPI
+	Do(ctx context.Context) (*DividendResponseWrapper, error)
+}
+
+type assetDividendServiceAPI AssetDividendService
+
+func (a *assetDividendServiceAPI) Asset(asset string) AssetDividendServiceAPI {
+	return (*assetDividendServiceAPI)((*AssetDividendService)(a).Asset(asset))
+}
+
+func (a *assetDividendServiceAPI) Limit(limit int) AssetDividendServiceAPI {
+	return (*assetDividendServiceAPI)((*AssetDividendService)(a).Limit(limit))
+}
+
+func (a *assetDividendServiceAPI) StartTime(startTime int64) AssetDividendServiceAPI {
+	return (*assetDividendServiceAPI)((*AssetDividendService)(a).StartTime(startTime))
+}
+
+func (a *assetDividendServiceAPI) EndTime(endTime int64) AssetDividendServiceAPI {
+	return (*assetDividendServiceAPI)((*AssetDividendService)(a).EndTime(endTime))
+}
+
+func (a *assetDividendServiceAPI) Do(ctx context.Context) (*DividendResponseWrapper, error) {
+	return (*AssetDividendService)(a).Do(ctx)
+}
+
+// CreateUserUniversalTransferServiceAPI is the interface of CreateUserUniversalTransferService
+type CreateUserUniversalTransferServiceAPI interface {
+	Type(v UserUniversalTransferType) CreateUserUniversalTransferServiceAPI
+	Asset(v string) CreateUserUniversalTransferServiceAPI
+	Amount(v string) CreateUserUniversalTransferServiceAPI
+	FromSymbol(v string) CreateUserUniversalTransferServiceAPI
+	ToSymbol(v string) CreateUserUniversalTransferServiceAPI
+	Do(ctx context.Context) (*CreateUserUniversalTransferResponse, error)
+}
+
+type createUserUniversalTransferServiceAPI CreateUserUniversalTransferService
+
+func (a *createUserUniversalTransferServiceAPI) Type(v UserUniversalTransferType) CreateUserUniversalTransferServiceAPI {
+	return (*createUserUniversalTransferServiceAPI)((*CreateUserUniversalTransferService)(a).Type(v))
+}
+
+func (a *createUserUniversalTransferServiceAPI) Asset(v string) CreateUserUniversalTransferServiceAPI {
+	return (*createUserUniversalTransferServiceAPI)((*CreateUserUniversalTransferService)(a).Asset(v))
+}
+
+func (a *createUserUniversalTransferServiceAPI) Amount(v string) CreateUserUniversalTransferServiceAPI {
+	return (*createUserUniversalTransferServiceAPI)((*CreateUserUniversalTransferService)(a).Amount(v))
+}
+
+func (a *createUserUniversalTransferServiceAPI) FromSymbol(v string) CreateUserUniversalTransferServiceAPI {
+	return (*createUserUniversalTransferServiceAPI)((*CreateUserUniversalTransferService)(a).FromSymbol(v))
+}
+
+func (a *createUserUniversalTransferServiceAPI) ToSymbol(v string) CreateUserUniversalTransferServiceAPI {
+	return (*createUserUniversalTransferServiceAPI)((*CreateUserUniversalTransferService)(a).ToSymbol(v))
+}
+
+func (a *createUserUniversalTransferServiceAPI) Do(ctx context.Context) (*CreateUserUniversalTransferResponse, error) {
+	return (*CreateUserUniversalTransferService)(a).Do(ctx)
+}
+
+// GetAllCoinsInfoServiceAPI is the interface of GetAllCoinsInfoService
+type GetAllCoinsInfoServiceAPI interface {
+	Do(ctx context.Context) ([]*CoinInfo, error)
+}
+
+type getAllCoinsInfoServiceAPI GetAllCoinsInfoService
+
+func (a *getAllCoinsInfoServiceAPI) Do(ctx context.Context) ([]*CoinInfo, error) {
+	return (*GetAllCoinsInfoService)(a).Do(ctx)
+}
+
+// GetAllMarginAssetsServiceAPI is the interface of GetAllMarginAssetsService
+type GetAllMarginAssetsServiceAPI interface {
+	Do(ctx context.Context, opts ...RequestOption) ([]*MarginAsset, error)
+}
+
+type getAllMarginAssetsServiceAPI GetAllMarginAssetsService
+
+func (a *getAllMarginAssetsServiceAPI) Do(ctx context.Context, opts ...RequestOption) ([]*MarginAsset, error) {
+	return (*GetAllMarginAssetsService)(a).Do(ctx, opts...)
+}
+
+// FiatDepositWithdrawHistoryServiceAPI is the interface of FiatDepositWithdrawHistoryService
+type FiatDepositWithdrawHistoryServiceAPI interface {
+	TransactionType(transactionType TransactionType) FiatDepositWithdrawHistoryServiceAPI
+	BeginTime(beginTime int64) FiatDepositWithdrawHistoryServiceAPI
+	EndTime(endTime int64) FiatDepositWithdrawHistoryServiceAPI
+	Page(page int32) FiatDepositWithdrawHistoryServiceAPI
+	Rows(rows int32) FiatDepositWithdrawHistoryServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) (*FiatDepositWithdrawHistory, error)
+}
+
+type fiatDepositWithdrawHistoryServiceAPI FiatDepositWithdrawHistoryService
+
+func (a *fiatDepositWithdrawHistoryServiceAPI) TransactionType(transactionType TransactionType) FiatDepositWithdrawHistoryServiceAPI {
+	return (*fiatDepositWithdrawHistoryServiceAPI)((*FiatDepositWithdrawHistoryService)(a).TransactionType(transactionType))
+}
+
+func (a *fiatDepositWithdrawHistoryServiceAPI) BeginTime(beginTime int64) FiatDepositWithdrawHistoryServiceAPI {
+	return (*fiatDepositWithdrawHistoryServiceAPI)((*FiatDepositWithdrawHistoryService)(a).BeginTime(beginTime))
+}
+
+func (a *fiatDepositWithdrawHistoryServiceAPI) EndTime(endTime int64) FiatDepositWithdrawHistoryServiceAPI {
+	return (*fiatDepositWithdrawHistoryServiceAPI)((*FiatDepositWithdrawHistoryService)(a).EndTime(endTime))
+}
+
+func (a *fiatDepositWithdrawHistoryServiceAPI) Page(page int32) FiatDepositWithdrawHistoryServiceAPI {
+	return (*fiatDepositWithdrawHistoryServiceAPI)((*FiatDepositWithdrawHistoryService)(a).Page(page))
+}
+
+func (a *fiatDepositWithdrawHistoryServiceAPI) Rows(rows int32) FiatDepositWithdrawHistoryServiceAPI {
+	return (*fiatDepositWithdrawHistoryServiceAPI)((*FiatDepositWithdrawHistoryService)(a).Rows(rows))
+}
+
+func (a *fiatDepositWithdrawHistoryServiceAPI) Do(ctx context.Context, opts ...RequestOption) (*FiatDepositWithdrawHistory, error) {
+	return (*FiatDepositWithdrawHistoryService)(a).Do(ctx, opts...)
+}
+
+// FiatPaymentsHistoryServiceAPI is the interface of FiatPaymentsHistoryService
+type FiatPaymentsHistoryServiceAPI interface {
+	TransactionType(transactionType TransactionType) FiatPaymentsHistoryServiceAPI
+	BeginTime(beginTime int64) FiatPaymentsHistoryServiceAPI
+	EndTime(endTime int64) FiatPaymentsHistoryServiceAPI
+	Page(page int32) FiatPaymentsHistoryServiceAPI
+	Rows(rows int32) FiatPaymentsHistoryServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) (*FiatPaymentsHistory, error)
+}
+
+type fiatPaymentsHistoryServiceAPI FiatPaymentsHistoryService
+
+func (a *fiatPaymentsHistoryServiceAPI) TransactionType(transactionType TransactionType) FiatPaymentsHistoryServiceAPI {
+	return (*fiatPaymentsHistoryServiceAPI)((*FiatPaymentsHistoryService)(a).TransactionType(transactionType))
+}
+
+func (a *fiatPaymentsHistoryServiceAPI) BeginTime(beginTime int64) FiatPaymentsHistoryServiceAPI {
+	return (*fiatPaymentsHistoryServiceAPI)((*FiatPaymentsHistoryService)(a).BeginTime(beginTime))
+}
+
+func (a *fiatPaymentsHistoryServiceAPI) EndTime(endTime int64) FiatPaymentsHistoryServiceAPI {
+	return (*fiatPaymentsHistoryServiceAPI)((*FiatPaymentsHistoryService)(a).EndTime(endTime))
+}
+
+func (a *fiatPaymentsHistoryServiceAPI) Page(page int32) FiatPaymentsHistoryServiceAPI {
+	return (*fiatPaymentsHistoryServiceAPI)((*FiatPaymentsHistoryService)(a).Page(page))
+}
+
+func (a *fiatPaymentsHistoryServiceAPI) Rows(rows int32) FiatPaymentsHistoryServiceAPI {
+	return (*fiatPaymentsHistoryServiceAPI)((*FiatPaymentsHistoryService)(a).Rows(rows))
+}
+
+func (a *fiatPaymentsHistoryServiceAPI) Do(ctx context.Context, opts ...RequestOption) (*FiatPaymentsHistory, error) {
+	return (*FiatPaymentsHistoryService)(a).Do(ctx, opts...)
+}
+
+// PayTradeHistoryServiceAPI is the interface of PayTradeHistoryService
+type PayTradeHistoryServiceAPI interface {
+	StartTimestamp(startTimestamp int64) PayTradeHistoryServiceAPI
+	EndTimestamp(endTimestamp int64) PayTradeHistoryServiceAPI
+	Limit(limit int32) PayTradeHistoryServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) (*PayTradeHistory, error)
+}
+
+type payTradeHistoryServiceAPI PayTradeHistoryService
+
+func (a *payTradeHistoryServiceAPI) StartTimestamp(startTimestamp int64) PayTradeHistoryServiceAPI {
+	return (*payTradeHistoryServiceAPI)((*PayTradeHistoryService)(a).StartTimestamp(startTimestamp))
+}
+
+func (a *payTradeHistoryServiceAPI) EndTimestamp(endTimestamp int64) PayTradeHistoryServiceAPI {
+	return (*payTradeHistoryServiceAPI)((*PayTradeHistoryService)(a).EndTimestamp(endTimestamp))
+}
+
+func (a *payTradeHistoryServiceAPI) Limit(limit int32) PayTradeHistoryServiceAPI {
+	return (*payTradeHistoryServiceAPI)((*PayTradeHistoryService)(a).Limit(limit))
+}
+
+func (a *payTradeHistoryServiceAPI) Do(ctx context.Context, opts ...RequestOption) (*PayTradeHistory, error) {
+	return (*PayTradeHistoryService)(a).Do(ctx, opts...)
+}
+
+// SpotRebateHistoryServiceAPI is the interface of SpotRebateHistoryService
+type SpotRebateHistoryServiceAPI interface {
+	StartTime(startTime int64) SpotRebateHistoryServiceAPI
+	EndTime(endTime int64) SpotRebateHistoryServiceAPI
+	Page(page int32) SpotRebateHistoryServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) (*SpotRebateHistory, error)
+}
+
+type spotRebateHistoryServiceAPI SpotRebateHistoryService
+
+func (a *spotRebateHistoryServiceAPI) StartTime(startTime int64) SpotRebateHistoryServiceAPI {
+	return (*spotRebateHistoryServiceAPI)((*SpotRebateHistoryService)(a).StartTime(startTime))
+}
+
+func (a *spotRebateHistoryServiceAPI) EndTime(endTime int64) SpotRebateHistoryServiceAPI {
+	return (*spotRebateHistoryServiceAPI)((*SpotRebateHistoryService)(a).EndTime(endTime))
+}
+
+func (a *spotRebateHistoryServiceAPI) Page(page int32) SpotRebateHistoryServiceAPI {
+	return (*spotRebateHistoryServiceAPI)((*SpotRebateHistoryService)(a).Page(page))
+}
+
+func (a *spotRebateHistoryServiceAPI) Do(ctx context.Context, opts ...RequestOption) (*SpotRebateHistory, error) {
+	return (*SpotRebateHistoryService)(a).Do(ctx, opts...)
+}
+
+// ConvertTradeHistoryServiceAPI is the interface of ConvertTradeHistoryService
+type ConvertTradeHistoryServiceAPI interface {
+	StartTime(startTime int64) ConvertTradeHistoryServiceAPI
+	EndTime(endTime int64) ConvertTradeHistoryServiceAPI
+	Limit(limit int32) ConvertTradeHistoryServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) (*ConvertTradeHistory, error)
+}
+
+type convertTradeHistoryServiceAPI ConvertTradeHistoryService
+
+func (a *convertTradeHistoryServiceAPI) StartTime(startTime int64) ConvertTradeHistoryServiceAPI {
+	return (*convertTradeHistoryServiceAPI)((*ConvertTradeHistoryService)(a).StartTime(startTime))
+}
+
+func (a *convertTradeHistoryServiceAPI) EndTime(endTime int64) ConvertTradeHistoryServiceAPI {
+	return (*convertTradeHistoryServiceAPI)((*ConvertTradeHistoryService)(a).EndTime(endTime))
+}
+
+func (a *convertTradeHistoryServiceAPI) Limit(limit int32) ConvertTradeHistoryServiceAPI {
+	return (*convertTradeHistoryServiceAPI)((*ConvertTradeHistoryService)(a).Limit(limit))
+}
+
+func (a *convertTradeHistoryServiceAPI) Do(ctx context.Context, opts ...RequestOption) (*ConvertTradeHistory, error) {
+	return (*ConvertTradeHistoryService)(a).Do(ctx, opts...)
+}
+
+// ConvertExchangeInfoServiceAPI is the interface of ConvertExchangeInfoService
+type ConvertExchangeInfoServiceAPI interface {
+	FromAsset(fromAsset string) ConvertExchangeInfoServiceAPI
+	ToAsset(toAsset string) ConvertExchangeInfoServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) ([]*ConvertExchangeInfo, error)
+}
+
+type convertExchangeInfoServiceAPI ConvertExchangeInfoService
+
+func (a *convertExchangeInfoServiceAPI) FromAsset(fromAsset string) ConvertExchangeInfoServiceAPI {
+	return (*convertExchangeInfoServiceAPI)((*ConvertExchangeInfoService)(a).FromAsset(fromAsset))
+}
+
+func (a *convertExchangeInfoServiceAPI) ToAsset(toAsset string) ConvertExchangeInfoServiceAPI {
+	return (*convertExchangeInfoServiceAPI)((*ConvertExchangeInfoService)(a).ToAsset(toAsset))
+}
+
+func (a *convertExchangeInfoServiceAPI) Do(ctx context.Context, opts ...RequestOption) ([]*ConvertExchangeInfo, error) {
+	return (*ConvertExchangeInfoService)(a).Do(ctx, opts...)
+}
+
+// ConvertAssetInfoServiceAPI is the interface of ConvertAssetInfoService
+type ConvertAssetInfoServiceAPI interface {
+	Do(ctx context.Context, opts ...RequestOption) ([]*ConvertAssetInfo, error)
+}
+
+type convertAssetInfoServiceAPI ConvertAssetInfoService
+
+func (a *convertAssetInfoServiceAPI) Do(ctx context.Context, opts ...RequestOption) ([]*ConvertAssetInfo, error) {
+	return (*ConvertAssetInfoService)(a).Do(ctx, opts...)
+}
+
+// ConvertGetQuoteServiceAPI is the interface of ConvertGetQuoteService
+type ConvertGetQuoteServiceAPI interface {
+	FromAsset(fromAsset string) ConvertGetQuoteServiceAPI
+	ToAsset(toAsset string) ConvertGetQuoteServiceAPI
+	FromAmount(fromAmount string) ConvertGetQuoteServiceAPI
+	ToAmount(toAmount string) ConvertGetQuoteServiceAPI
+	WalletType(walletType string) ConvertGetQuoteServiceAPI
+	ValidTime(validTime string) ConvertGetQuoteServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) (*ConvertQuote, error)
+}
+
+type convertGetQuoteServiceAPI ConvertGetQuoteService
+
+func (a *convertGetQuoteServiceAPI) FromAsset(fromAsset string) ConvertGetQuoteServiceAPI {
+	return (*convertGetQuoteServiceAPI)((*ConvertGetQuoteService)(a).FromAsset(fromAsset))
+}
+
+func (a *convertGetQuoteServiceAPI) ToAsset(toAsset string) ConvertGetQuoteServiceAPI {
+	return (*convertGetQuoteServiceAPI)((*ConvertGetQuoteService)(a).ToAsset(toAsset))
+}
+
+func (a *convertGetQuoteServiceAPI) FromAmount(fromAmount string) ConvertGetQuoteServiceAPI {
+	return (*convertGetQuoteServiceAPI)((*ConvertGetQuoteService)(a).FromAmount(fromAmount))
+}
+
+func (a *convertGetQuoteServiceAPI) ToAmount(toAmount string) ConvertGetQuoteServiceAPI {
+	return (*convertGetQuoteServiceAPI)((*ConvertGetQuoteService)(a).ToAmount(toAmount))
+}
+
+func (a *convertGetQuoteServiceAPI) WalletType(walletType string) ConvertGetQuoteServiceAPI {
+	return (*convertGetQuoteServiceAPI)((*ConvertGetQuoteService)(a).WalletType(walletType))
+}
+
+func (a *convertGetQuoteServiceAPI) ValidTime(validTime string) ConvertGetQuoteServiceAPI {
+	return (*convertGetQuoteServiceAPI)((*ConvertGetQuoteService)(a).ValidTime(validTime))
+}
+
+func (a *convertGetQuoteServiceAPI) Do(ctx context.Context, opts ...RequestOption) (*ConvertQuote, error) {
+	return (*ConvertGetQuoteService)(a).Do(ctx, opts...)
+}
+
+// ConvertAcceptQuoteServiceAPI is the interface of ConvertAcceptQuoteService
+type ConvertAcceptQuoteServiceAPI interface {
+	QuoteId(quoteId string) ConvertAcceptQuoteServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) (*ConvertAcceptQuote, error)
+}
+
+type convertAcceptQuoteServiceAPI ConvertAcceptQuoteService
+
+func (a *convertAcceptQuoteServiceAPI) QuoteId(quoteId string) ConvertAcceptQuoteServiceAPI {
+	return (*convertAcceptQuoteServiceAPI)((*ConvertAcceptQuoteService)(a).QuoteId(quoteId))
+}
+
+func (a *convertAcceptQuoteServiceAPI) Do(ctx context.Context, opts ...RequestOption) (*ConvertAcceptQuote, error) {
+	return (*ConvertAcceptQuoteService)(a).Do(ctx, opts...)
+}
+
+// ConvertOrderStatusServiceAPI is the interface of ConvertOrderStatusService
+type ConvertOrderStatusServiceAPI interface {
+	OrderId(orderId string) ConvertOrderStatusServiceAPI
+	QuoteId(quoteId string) ConvertOrderStatusServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) (*ConvertOrderStatus, error)
+}
+
+type convertOrderStatusServiceAPI ConvertOrderStatusService
+
+func (a *convertOrderStatusServiceAPI) OrderId(orderId string) ConvertOrderStatusServiceAPI {
+	return (*convertOrderStatusServiceAPI)((*ConvertOrderStatusService)(a).OrderId(orderId))
+}
+
+func (a *convertOrderStatusServiceAPI) QuoteId(quoteId string) ConvertOrderStatusServiceAPI {
+	return (*convertOrderStatusServiceAPI)((*ConvertOrderStatusService)(a).QuoteId(quoteId))
+}
+
+func (a *convertOrderStatusServiceAPI) Do(ctx context.Context, opts ...RequestOption) (*ConvertOrderStatus, error) {
+	return (*ConvertOrderStatusService)(a).Do(ctx, opts...)
+}
+
+// GetIsolatedMarginAllPairsServiceAPI is the interface of GetIsolatedMarginAllPairsService
+type GetIsolatedMarginAllPairsServiceAPI interface {
+	Do(ctx context.Context, opts ...RequestOption) ([]*IsolatedMarginAllPair, error)
+}
+
+type getIsolatedMarginAllPairsServiceAPI GetIsolatedMarginAllPairsService
+
+func (a *getIsolatedMarginAllPairsServiceAPI) Do(ctx context.Context, opts ...RequestOption) ([]*IsolatedMarginAllPair, error) {
+	return (*GetIsolatedMarginAllPairsService)(a).Do(ctx, opts...)
+}
+
+// InterestHistoryServiceAPI is the interface of InterestHistoryService
+type InterestHistoryServiceAPI interface {
+	LendingType(lendingType LendingType) InterestHistoryServiceAPI
+	Asset(asset string) InterestHistoryServiceAPI
+	StartTime(startTime int64) InterestHistoryServiceAPI
+	EndTime(endTime int64) InterestHistoryServiceAPI
+	Current(current int32) InterestHistoryServiceAPI
+	Size(size int32) InterestHistoryServiceAPI
+	Do(ctx context.Context) (*InterestHistory, error)
+}
+
+type interestHistoryServiceAPI InterestHistoryService
+
+func (a *interestHistoryServiceAPI) LendingType(lendingType LendingType) InterestHistoryServiceAPI {
+	return (*interestHistoryServiceAPI)((*InterestHistoryService)(a).LendingType(lendingType))
+}
+
+func (a *interestHistoryServiceAPI) Asset(asset string) InterestHistoryServiceAPI {
+	return (*interestHistoryServiceAPI)((*InterestHistoryService)(a).Asset(asset))
+}
+
+func (a *interestHistoryServiceAPI) StartTime(startTime int64) InterestHistoryServiceAPI {
+	return (*interestHistoryServiceAPI)((*InterestHistoryService)(a).StartTime(startTime))
+}
+
+func (a *interestHistoryServiceAPI) EndTime(endTime int64) InterestHistoryServiceAPI {
+	return (*interestHistoryServiceAPI)((*InterestHistoryService)(a).EndTime(endTime))
+}
+
+func (a *interestHistoryServiceAPI) Current(current int32) InterestHistoryServiceAPI {
+	return (*interestHistoryServiceAPI)((*InterestHistoryService)(a).Current(current))
+}
+
+func (a *interestHistoryServiceAPI) Size(size int32) InterestHistoryServiceAPI {
+	return (*interestHistoryServiceAPI)((*InterestHistoryService)(a).Size(size))
+}
+
+func (a *interestHistoryServiceAPI) Do(ctx context.Context) (*InterestHistory, error) {
+	return (*InterestHistoryService)(a).Do(ctx)
+}
+
+// TradeFeeServiceAPI is the interface of TradeFeeService
+type TradeFeeServiceAPI interface {
+	Symbol(symbol string) TradeFeeServiceAPI
+	Do(ctx context.Context) ([]*TradeFeeDetails, error)
+}
+
+type tradeFeeServiceAPI TradeFeeService
+
+func (a *tradeFeeServiceAPI) Symbol(symbol string) TradeFeeServiceAPI {
+	return (*tradeFeeServiceAPI)((*TradeFeeService)(a).Symbol(symbol))
+}
+
+func (a *tradeFeeServiceAPI) Do(ctx context.Context) ([]*TradeFeeDetails, error) {
+	return (*TradeFeeService)(a).Do(ctx)
+}
+
+// C2CTradeHistoryServiceAPI is the interface of C2CTradeHistoryService
+type C2CTradeHistoryServiceAPI interface {
+	TradeType(tradeType SideType) C2CTradeHistoryServiceAPI
+	StartTimestamp(startTimestamp int64) C2CTradeHistoryServiceAPI
+	EndTime(endTimestamp int64) C2CTradeHistoryServiceAPI
+	Page(page int32) C2CTradeHistoryServiceAPI
+	Rows(rows int32) C2CTradeHistoryServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) (*C2CTradeHistory, error)
+}
+
+type c2CTradeHistoryServiceAPI C2CTradeHistoryService
+
+func (a *c2CTradeHistoryServiceAPI) TradeType(tradeType SideType) C2CTradeHistoryServiceAPI {
+	return (*c2CTradeHistoryServiceAPI)((*C2CTradeHistoryService)(a).TradeType(tradeType))
+}
+
+func (a *c2CTradeHistoryServiceAPI) StartTimestamp(startTimestamp int64) C2CTradeHistoryServiceAPI {
+	return (*c2CTradeHistoryServiceAPI)((*C2CTradeHistoryService)(a).StartTimestamp(startTimestamp))
+}
+
+func (a *c2CTradeHistoryServiceAPI) EndTime(endTimestamp int64) C2CTradeHistoryServiceAPI {
+	return (*c2CTradeHistoryServiceAPI)((*C2CTradeHistoryService)(a).EndTime(endTimestamp))
+}
+
+func (a *c2CTradeHistoryServiceAPI) Page(page int32) C2CTradeHistoryServiceAPI {
+	return (*c2CTradeHistoryServiceAPI)((*C2CTradeHistoryService)(a).Page(page))
+}
+
+func (a *c2CTradeHistoryServiceAPI) Rows(rows int32) C2CTradeHistoryServiceAPI {
+	return (*c2CTradeHistoryServiceAPI)((*C2CTradeHistoryService)(a).Rows(rows))
+}
+
+func (a *c2CTradeHistoryServiceAPI) Do(ctx context.Context, opts ...RequestOption) (*C2CTradeHistory, error) {
+	return (*C2CTradeHistoryService)(a).Do(ctx, opts...)
+}
+
+// StakingProductPositionServiceAPI is the interface of StakingProductPositionService
+type StakingProductPositionServiceAPI interface {
+	Product(product StakingProduct) StakingProductPositionServiceAPI
+	ProductId(productId string) StakingProductPositionServiceAPI
+	Asset(asset string) StakingProductPositionServiceAPI
+	Current(current int32) StakingProductPositionServiceAPI
+	Size(size int32) StakingProductPositionServiceAPI
+	Do(ctx context.Context) (*StakingProductPositions, error)
+}
+
+type stakingProductPositionServiceAPI StakingProductPositionService
+
+func (a *stakingProductPositionServiceAPI) Product(product StakingProduct) StakingProductPositionServiceAPI {
+	return (*stakingProductPositionServiceAPI)((*StakingProductPositionService)(a).Product(product))
+}
+
+func (a *stakingProductPositionServiceAPI) ProductId(productId string) StakingProductPositionServiceAPI {
+	return (*stakingProductPositionServiceAPI)((*StakingProductPositionService)(a).ProductId(productId))
+}
+
+func (a *stakingProductPositionServiceAPI) Asset(asset string) StakingProductPositionServiceAPI {
+	return (*stakingProductPositionServiceAPI)((*StakingProductPositionService)(a).Asset(asset))
+}
+
+func (a *stakingProductPositionServiceAPI) Current(current int32) StakingProductPositionServiceAPI {
+	return (*stakingProductPositionServiceAPI)((*StakingProductPositionService)(a).Current(current))
+}
+
+func (a *stakingProductPositionServiceAPI) Size(size int32) StakingProductPositionServiceAPI {
+	return (*stakingProductPositionServiceAPI)((*StakingProductPositionService)(a).Size(size))
+}
+
+func (a *stakingProductPositionServiceAPI) Do(ctx context.Context) (*StakingProductPositions, error) {
+	return (*StakingProductPositionService)(a).Do(ctx)
+}
+
+// StakingHistoryServiceAPI is the interface of StakingHistoryService
+type StakingHistoryServiceAPI interface {
+	Product(product StakingProduct) StakingHistoryServiceAPI
+	TransactionType(transactionType StakingTransactionType) StakingHistoryServiceAPI
+	Asset(asset string) StakingHistoryServiceAPI
+	StartTime(startTime int64) StakingHistoryServiceAPI
+	EndTime(endTime int64) StakingHistoryServiceAPI
+	Current(current int32) StakingHistoryServiceAPI
+	Size(size int32) StakingHistoryServiceAPI
+	Do(ctx context.Context) (*StakingHistory, error)
+}
+
+type stakingHistoryServiceAPI StakingHistoryService
+
+func (a *stakingHistoryServiceAPI) Product(product StakingProduct) StakingHistoryServiceAPI {
+	return (*stakingHistoryServiceAPI)((*StakingHistoryService)(a).Product(product))
+}
+
+func (a *stakingHistoryServiceAPI) TransactionType(transactionType StakingTransactionType) StakingHistoryServiceAPI {
+	return (*stakingHistoryServiceAPI)((*StakingHistoryService)(a).TransactionType(transactionType))
+}
+
+func (a *stakingHistoryServiceAPI) Asset(asset string) StakingHistoryServiceAPI {
+	return (*stakingHistoryServiceAPI)((*StakingHistoryService)(a).Asset(asset))
+}
+
+func (a *stakingHistoryServiceAPI) StartTime(startTime int64) StakingHistoryServiceAPI {
+	return (*stakingHistoryServiceAPI)((*StakingHistoryService)(a).StartTime(startTime))
+}
+
+func (a *stakingHistoryServiceAPI) EndTime(endTime int64) StakingHistoryServiceAPI {
+	return (*stakingHistoryServiceAPI)((*StakingHistoryService)(a).EndTime(endTime))
+}
+
+func (a *stakingHistoryServiceAPI) Current(current int32) StakingHistoryServiceAPI {
+	return (*stakingHistoryServiceAPI)((*StakingHistoryService)(a).Current(current))
+}
+
+func (a *stakingHistoryServiceAPI) Size(size int32) StakingHistoryServiceAPI {
+	return (*stakingHistoryServiceAPI)((*StakingHistoryService)(a).Size(size))
+}
+
+func (a *stakingHistoryServiceAPI) Do(ctx context.Context) (*StakingHistory, error) {
+	return (*StakingHistoryService)(a).Do(ctx)
+}
+
+// GetAllLiquidityPoolServiceAPI is the interface of GetAllLiquidityPoolService
+type GetAllLiquidityPoolServiceAPI interface {
+	Do(ctx context.Context, opts ...RequestOption) ([]*LiquidityPool, error)
+}
+
+type getAllLiquidityPoolServiceAPI GetAllLiquidityPoolService
+
+func (a *getAllLiquidityPoolServiceAPI) Do(ctx context.Context, opts ...RequestOption) ([]*LiquidityPool, error) {
+	return (*GetAllLiquidityPoolService)(a).Do(ctx, opts...)
+}
+
+// GetLiquidityPoolDetailServiceAPI is the interface of GetLiquidityPoolDetailService
+type GetLiquidityPoolDetailServiceAPI interface {
+	PoolId(poolId int64) GetLiquidityPoolDetailServiceAPI
+	Do(ctx context.Context) ([]*LiquidityPoolDetail, error)
+}
+
+type getLiquidityPoolDetailServiceAPI GetLiquidityPoolDetailService
+
+func (a *getLiquidityPoolDetailServiceAPI) PoolId(poolId int64) GetLiquidityPoolDetailServiceAPI {
+	return (*getLiquidityPoolDetailServiceAPI)((*GetLiquidityPoolDetailService)(a).PoolId(poolId))
+}
+
+func (a *getLiquidityPoolDetailServiceAPI) Do(ctx context.Context) ([]*LiquidityPoolDetail, error) {
+	return (*GetLiquidityPoolDetailService)(a).Do(ctx)
+}
+
+// AddLiquidityPreviewServiceAPI is the interface of AddLiquidityPreviewService
+type AddLiquidityPreviewServiceAPI interface {
+	PoolId(poolId int64) AddLiquidityPreviewServiceAPI
+	QuoteAsset(quoteAsset string) AddLiquidityPreviewServiceAPI
+	QuoteQty(quoteQty float64) AddLiquidityPreviewServiceAPI
+	OperationType(operationType LiquidityOperationType) AddLiquidityPreviewServiceAPI
+	Do(ctx context.Context) (*AddLiquidityPreviewResponse, error)
+}
+
+type addLiquidityPreviewServiceAPI AddLiquidityPreviewService
+
+func (a *addLiquidityPreviewServiceAPI) PoolId(poolId int64) AddLiquidityPreviewServiceAPI {
+	return (*addLiquidityPreviewServiceAPI)((*AddLiquidityPreviewService)(a).PoolId(poolId))
+}
+
+func (a *addLiquidityPreviewServiceAPI) QuoteAsset(quoteAsset string) AddLiquidityPreviewServiceAPI {
+	return (*addLiquidityPreviewServiceAPI)((*AddLiquidityPreviewService)(a).QuoteAsset(quoteAsset))
+}
+
+func (a *addLiquidityPreviewServiceAPI) QuoteQty(quoteQty float64) AddLiquidityPreviewServiceAPI {
+	return (*addLiquidityPreviewServiceAPI)((*AddLiquidityPreviewService)(a).QuoteQty(quoteQty))
+}
+
+func (a *addLiquidityPreviewServiceAPI) OperationType(operationType LiquidityOperationType) AddLiquidityPreviewServiceAPI {
+	return (*addLiquidityPreviewServiceAPI)((*AddLiquidityPreviewService)(a).OperationType(operationType))
+}
+
+func (a *addLiquidityPreviewServiceAPI) Do(ctx context.Context) (*AddLiquidityPreviewResponse, error) {
+	return (*AddLiquidityPreviewService)(a).Do(ctx)
+}
+
+// GetSwapQuoteServiceAPI is the interface of GetSwapQuoteService
+type GetSwapQuoteServiceAPI interface {
+	QuoteAsset(quoteAsset string) GetSwapQuoteServiceAPI
+	QuoteQty(quoteQty float64) GetSwapQuoteServiceAPI
+	BaseAsset(baseAsset string) GetSwapQuoteServiceAPI
+	Do(ctx context.Context) (*GetSwapQuoteResponse, error)
+}
+
+type getSwapQuoteServiceAPI GetSwapQuoteService
+
+func (a *getSwapQuoteServiceAPI) QuoteAsset(quoteAsset string) GetSwapQuoteServiceAPI {
+	return (*getSwapQuoteServiceAPI)((*GetSwapQuoteService)(a).QuoteAsset(quoteAsset))
+}
+
+func (a *getSwapQuoteServiceAPI) QuoteQty(quoteQty float64) GetSwapQuoteServiceAPI {
+	return (*getSwapQuoteServiceAPI)((*GetSwapQuoteService)(a).QuoteQty(quoteQty))
+}
+
+func (a *getSwapQuoteServiceAPI) BaseAsset(baseAsset string) GetSwapQuoteServiceAPI {
+	return (*getSwapQuoteServiceAPI)((*GetSwapQuoteService)(a).BaseAsset(baseAsset))
+}
+
+func (a *getSwapQuoteServiceAPI) Do(ctx context.Context) (*GetSwapQuoteResponse, error) {
+	return (*GetSwapQuoteService)(a).Do(ctx)
+}
+
+// SwapServiceAPI is the interface of SwapService
+type SwapServiceAPI interface {
+	QuoteAsset(quoteAsset string) SwapServiceAPI
+	QuoteQty(quoteQty float64) SwapServiceAPI
+	BaseAsset(baseAsset string) SwapServiceAPI
+	Do(ctx context.Context) (*SwapResponse, error)
+}
+
+type swapServiceAPI SwapService
+
+func (a *swapServiceAPI) QuoteAsset(quoteAsset string) SwapServiceAPI {
+	return (*swapServiceAPI)((*SwapService)(a).QuoteAsset(quoteAsset))
+}
+
+func (a *swapServiceAPI) QuoteQty(quoteQty float64) SwapServiceAPI {
+	return (*swapServiceAPI)((*SwapService)(a).QuoteQty(quoteQty))
+}
+
+func (a *swapServiceAPI) BaseAsset(baseAsset string) SwapServiceAPI {
+	return (*swapServiceAPI)((*SwapService)(a).BaseAsset(baseAsset))
+}
+
+func (a *swapServiceAPI) Do(ctx context.Context) (*SwapResponse, error) {
+	return (*SwapService)(a).Do(ctx)
+}
+
+// AddLiquidityServiceAPI is the interface of AddLiquidityService
+type AddLiquidityServiceAPI interface {
+	PoolId(poolId int64) AddLiquidityServiceAPI
+	QuoteAsset(quoteAsset string) AddLiquidityServiceAPI
+	QuoteQty(quoteQty float64) AddLiquidityServiceAPI
+	OperationType(operationType LiquidityOperationType) AddLiquidityServiceAPI
+	Do(ctx context.Context) (*AddLiquidityResponse, error)
+}
+
+type addLiquidityServiceAPI AddLiquidityService
+
+func (a *addLiquidityServiceAPI) PoolId(poolId int64) AddLiquidityServiceAPI {
+	return (*addLiquidityServiceAPI)((*AddLiquidityService)(a).PoolId(poolId))
+}
+
+func (a *addLiquidityServiceAPI) QuoteAsset(quoteAsset string) AddLiquidityServiceAPI {
+	return (*addLiquidityServiceAPI)((*AddLiquidityService)(a).QuoteAsset(quoteAsset))
+}
+
+func (a *addLiquidityServiceAPI) QuoteQty(quoteQty float64) AddLiquidityServiceAPI {
+	return (*addLiquidityServiceAPI)((*AddLiquidityService)(a).QuoteQty(quoteQty))
+}
+
+func (a *addLiquidityServiceAPI) OperationType(operationType LiquidityOperationType) AddLiquidityServiceAPI {
+	return (*addLiquidityServiceAPI)((*AddLiquidityService)(a).OperationType(operationType))
+}
+
+func (a *addLiquidityServiceAPI) Do(ctx context.Context) (*AddLiquidityResponse, error) {
+	return (*AddLiquidityService)(a).Do(ctx)
+}
+
+// GetUserSwapRecordsServiceAPI is the interface of GetUserSwapRecordsService
+type GetUserSwapRecordsServiceAPI interface {
+	SwapId(swapId int64) GetUserSwapRecordsServiceAPI
+	StartTime(startTime int64) GetUserSwapRecordsServiceAPI
+	EndTime(endTime int64) GetUserSwapRecordsServiceAPI
+	Status(status SwappingStatus) GetUserSwapRecordsServiceAPI
+	QuoteAsset(quoteAsset string) GetUserSwapRecordsServiceAPI
+	BaseAsset(baseAsset string) GetUserSwapRecordsServiceAPI
+	ResultSize(resultSize int64) GetUserSwapRecordsServiceAPI
+	Do(ctx context.Context) ([]*SwapRecord, error)
+}
+
+type getUserSwapRecordsServiceAPI GetUserSwapRecordsService
+
+func (a *getUserSwapRecordsServiceAPI) SwapId(swapId int64) GetUserSwapRecordsServiceAPI {
+	return (*getUserSwapRecordsServiceAPI)((*GetUserSwapRecordsService)(a).SwapId(swapId))
+}
+
+func (a *getUserSwapRecordsServiceAPI) StartTime(startTime int64) GetUserSwapRecordsServiceAPI {
+	return (*getUserSwapRecordsServiceAPI)((*GetUserSwapRecordsService)(a).StartTime(startTime))
+}
+
+func (a *getUserSwapRecordsServiceAPI) EndTime(endTime int64) GetUserSwapRecordsServiceAPI {
+	return (*getUserSwapRecordsServiceAPI)((*GetUserSwapRecordsService)(a).EndTime(endTime))
+}
+
+func (a *getUserSwapRecordsServiceAPI) Status(status SwappingStatus) GetUserSwapRecordsServiceAPI {
+	return (*getUserSwapRecordsServiceAPI)((*GetUserSwapRecordsService)(a).Status(status))
+}
+
+func (a *getUserSwapRecordsServiceAPI) QuoteAsset(quoteAsset string) GetUserSwapRecordsServiceAPI {
+	return (*getUserSwapRecordsServiceAPI)((*GetUserSwapRecordsService)(a).QuoteAsset(quoteAsset))
+}
+
+func (a *getUserSwapRecordsServiceAPI) BaseAsset(baseAsset string) GetUserSwapRecordsServiceAPI {
+	return (*getUserSwapRecordsServiceAPI)((*GetUserSwapRecordsService)(a).BaseAsset(baseAsset))
+}
+
+func (a *getUserSwapRecordsServiceAPI) ResultSize(resultSize int64) GetUserSwapRecordsServiceAPI {
+	return (*getUserSwapRecordsServiceAPI)((*GetUserSwapRecordsService)(a).ResultSize(resultSize))
+}
+
+func (a *getUserSwapRecordsServiceAPI) Do(ctx context.Context) ([]*SwapRecord, error) {
+	return (*GetUserSwapRecordsService)(a).Do(ctx)
+}
+
+// ClaimRewardServiceAPI is the interface of ClaimRewardService
+type ClaimRewardServiceAPI interface {
+	RewardType(t LiquidityRewardType) ClaimRewardServiceAPI
+	Do(ctx context.Context) (*ClaimRewardResponse, error)
+}
+
+type claimRewardServiceAPI ClaimRewardService
+
+func (a *claimRewardServiceAPI) RewardType(t LiquidityRewardType) ClaimRewardServiceAPI {
+	return (*claimRewardServiceAPI)((*ClaimRewardService)(a).RewardType(t))
+}
+
+func (a *claimRewardServiceAPI) Do(ctx context.Context) (*ClaimRewardResponse, error) {
+	return (*ClaimRewardService)(a).Do(ctx)
+}
+
+// RemoveLiquidityServiceAPI is the interface of RemoveLiquidityService
+type RemoveLiquidityServiceAPI interface {
+	PoolId(poolId int64) RemoveLiquidityServiceAPI
+	ShareAmount(amt float64) RemoveLiquidityServiceAPI
+	AddAesst(asset string) RemoveLiquidityServiceAPI
+	OperationType(operationType LiquidityOperationType) RemoveLiquidityServiceAPI
+	Do(ctx context.Context) (*RemoveLiquidityResponse, error)
+}
+
+type removeLiquidityServiceAPI RemoveLiquidityService
+
+func (a *removeLiquidityServiceAPI) PoolId(poolId int64) RemoveLiquidityServiceAPI {
+	return (*removeLiquidityServiceAPI)((*RemoveLiquidityService)(a).PoolId(poolId))
+}
+
+func (a *removeLiquidityServiceAPI) ShareAmount(amt float64) RemoveLiquidityServiceAPI {
+	return (*removeLiquidityServiceAPI)((*RemoveLiquidityService)(a).ShareAmount(amt))
+}
+
+func (a *removeLiquidityServiceAPI) AddAesst(asset string) RemoveLiquidityServiceAPI {
+	return (*removeLiquidityServiceAPI)((*RemoveLiquidityService)(a).AddAesst(asset))
+}
+
+func (a *removeLiquidityServiceAPI) OperationType(operationType LiquidityOperationType) RemoveLiquidityServiceAPI {
+	return (*removeLiquidityServiceAPI)((*RemoveLiquidityService)(a).OperationType(operationType))
+}
+
+func (a *removeLiquidityServiceAPI) Do(ctx context.Context) (*RemoveLiquidityResponse, error) {
+	return (*RemoveLiquidityService)(a).Do(ctx)
+}
+
+// QueryClaimedRewardHistoryServiceAPI is the interface of QueryClaimedRewardHistoryService
+type QueryClaimedRewardHistoryServiceAPI interface {
+	RewardType(t LiquidityRewardType) QueryClaimedRewardHistoryServiceAPI
+	PoolId(poolId int64) QueryClaimedRewardHistoryServiceAPI
+	AssetRewards(assetRewards string) QueryClaimedRewardHistoryServiceAPI
+	StartTime(startTime int64) QueryClaimedRewardHistoryServiceAPI
+	EndTime(endTime int64) QueryClaimedRewardHistoryServiceAPI
+	ResultSize(resultSize int64) QueryClaimedRewardHistoryServiceAPI
+	Do(ctx context.Context) ([]*ClaimedRewardHistory, error)
+}
+
+type queryClaimedRewardHistoryServiceAPI QueryClaimedRewardHistoryService
+
+func (a *queryClaimedRewardHistoryServiceAPI) RewardType(t LiquidityRewardType) QueryClaimedRewardHistoryServiceAPI {
+	return (*queryClaimedRewardHistoryServiceAPI)((*QueryClaimedRewardHistoryService)(a).RewardType(t))
+}
+
+func (a *queryClaimedRewardHistoryServiceAPI) PoolId(poolId int64) QueryClaimedRewardHistoryServiceAPI {
+	return (*queryClaimedRewardHistoryServiceAPI)((*QueryClaimedRewardHistoryService)(a).PoolId(poolId))
+}
+
+func (a *queryClaimedRewardHistoryServiceAPI) AssetRewards(assetRewards string) QueryClaimedRewardHistoryServiceAPI {
+	return (*queryClaimedRewardHistoryServiceAPI)((*QueryClaimedRewardHistoryService)(a).AssetRewards(assetRewards))
+}
+
+func (a *queryClaimedRewardHistoryServiceAPI) StartTime(startTime int64) QueryClaimedRewardHistoryServiceAPI {
+	return (*queryClaimedRewardHistoryServiceAPI)((*QueryClaimedRewardHistoryService)(a).StartTime(startTime))
+}
+
+func (a *queryClaimedRewardHistoryServiceAPI) EndTime(endTime int64) QueryClaimedRewardHistoryServiceAPI {
+	return (*queryClaimedRewardHistoryServiceAPI)((*QueryClaimedRewardHistoryService)(a).EndTime(endTime))
+}
+
+func (a *queryClaimedRewardHistoryServiceAPI) ResultSize(resultSize int64) QueryClaimedRewardHistoryServiceAPI {
+	return (*queryClaimedRewardHistoryServiceAPI)((*QueryClaimedRewardHistoryService)(a).ResultSize(resultSize))
+}
+
+func (a *queryClaimedRewardHistoryServiceAPI) Do(ctx context.Context) ([]*ClaimedRewardHistory, error) {
+	return (*QueryClaimedRewardHistoryService)(a).Do(ctx)
+}
+
+// GetBNBBurnServiceAPI is the interface of GetBNBBurnService
+type GetBNBBurnServiceAPI interface {
+	Do(ctx context.Context, opts ...RequestOption) (*BNBBurn, error)
+}
+
+type getBNBBurnServiceAPI GetBNBBurnService
+
+func (a *getBNBBurnServiceAPI) Do(ctx context.Context, opts ...RequestOption) (*BNBBurn, error) {
+	return (*GetBNBBurnService)(a).Do(ctx, opts...)
+}
+
+// ToggleBNBBurnServiceAPI is the interface of ToggleBNBBurnService
+type ToggleBNBBurnServiceAPI interface {
+	SpotBNBBurn(v bool) ToggleBNBBurnServiceAPI
+	InterestBNBBurn(v bool) ToggleBNBBurnServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) (*BNBBurn, error)
+}
+
+type toggleBNBBurnServiceAPI ToggleBNBBurnService
+
+func (a *toggleBNBBurnServiceAPI) SpotBNBBurn(v bool) ToggleBNBBurnServiceAPI {
+	return (*toggleBNBBurnServiceAPI)((*ToggleBNBBurnService)(a).SpotBNBBurn(v))
+}
+
+func (a *toggleBNBBurnServiceAPI) InterestBNBBurn(v bool) ToggleBNBBurnServiceAPI {
+	return (*toggleBNBBurnServiceAPI)((*ToggleBNBBurnService)(a).InterestBNBBurn(v))
+}
+
+func (a *toggleBNBBurnServiceAPI) Do(ctx context.Context, opts ...RequestOption) (*BNBBurn, error) {
+	return (*ToggleBNBBurnService)(a).Do(ctx, opts...)
+}
+
+// InternalUniversalTransferServiceAPI is the interface of InternalUniversalTransferService
+type InternalUniversalTransferServiceAPI interface {
+	FromEmail(v string) InternalUniversalTransferServiceAPI
+	ToEmail(v string) InternalUniversalTransferServiceAPI
+	FromAccountType(v string) InternalUniversalTransferServiceAPI
+	ToAccountType(v string) InternalUniversalTransferServiceAPI
+	Symbol(v string) InternalUniversalTransferServiceAPI
+	Asset(v string) InternalUniversalTransferServiceAPI
+	Amount(v string) InternalUniversalTransferServiceAPI
+	ClientTranId(v string) InternalUniversalTransferServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) (*InternalUniversalTransferResponse, error)
+}
+
+type internalUniversalTransferServiceAPI InternalUniversalTransferService
+
+func (a *internalUniversalTransferServiceAPI) FromEmail(v string) InternalUniversalTransferServiceAPI {
+	return (*internalUniversalTransferServiceAPI)((*InternalUniversalTransferService)(a).FromEmail(v))
+}
+
+func (a *internalUniversalTransferServiceAPI) ToEmail(v string) InternalUniversalTransferServiceAPI {
+	return (*internalUniversalTransferServiceAPI)((*InternalUniversalTransferService)(a).ToEmail(v))
+}
+
+func (a *internalUniversalTransferServiceAPI) FromAccountType(v string) InternalUniversalTransferServiceAPI {
+	return (*internalUniversalTransferServiceAPI)((*InternalUniversalTransferService)(a).FromAccountType(v))
+}
+
+func (a *internalUniversalTransferServiceAPI) ToAccountType(v string) InternalUniversalTransferServiceAPI {
+	return (*internalUniversalTransferServiceAPI)((*InternalUniversalTransferService)(a).ToAccountType(v))
+}
+
+func (a *internalUniversalTransferServiceAPI) Symbol(v string) InternalUniversalTransferServiceAPI {
+	return (*internalUniversalTransferServiceAPI)((*InternalUniversalTransferService)(a).Symbol(v))
+}
+
+func (a *internalUniversalTransferServiceAPI) Asset(v string) InternalUniversalTransferServiceAPI {
+	return (*internalUniversalTransferServiceAPI)((*InternalUniversalTransferService)(a).Asset(v))
+}
+
+func (a *internalUniversalTransferServiceAPI) Amount(v string) InternalUniversalTransferServiceAPI {
+	return (*internalUniversalTransferServiceAPI)((*InternalUniversalTransferService)(a).Amount(v))
+}
+
+func (a *internalUniversalTransferServiceAPI) ClientTranId(v string) InternalUniversalTransferServiceAPI {
+	return (*internalUniversalTransferServiceAPI)((*InternalUniversalTransferService)(a).ClientTranId(v))
+}
+
+func (a *internalUniversalTransferServiceAPI) Do(ctx context.Context, opts ...RequestOption) (*InternalUniversalTransferResponse, error) {
+	return (*InternalUniversalTransferService)(a).Do(ctx, opts...)
+}
+
+// InternalUniversalTransferHistoryServiceAPI is the interface of InternalUniversalTransferHistoryService
+type InternalUniversalTransferHistoryServiceAPI interface {
+	FromEmail(v string) InternalUniversalTransferHistoryServiceAPI
+	ToEmail(v string) InternalUniversalTransferHistoryServiceAPI
+	StartTime(v int64) InternalUniversalTransferHistoryServiceAPI
+	EndTime(v int64) InternalUniversalTransferHistoryServiceAPI
+	Page(v int) InternalUniversalTransferHistoryServiceAPI
+	Limit(v int) InternalUniversalTransferHistoryServiceAPI
+	ClientTranId(v string) InternalUniversalTransferHistoryServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) (InternalUniversalTransferHistoryResponse, error)
+}
+
+type internalUniversalTransferHistoryServiceAPI InternalUniversalTransferHistoryService
+
+func (a *internalUniversalTransferHistoryServiceAPI) FromEmail(v string) InternalUniversalTransferHistoryServiceAPI {
+	return (*internalUniversalTransferHistoryServiceAPI)((*InternalUniversalTransferHistoryService)(a).FromEmail(v))
+}
+
+func (a *internalUniversalTransferHistoryServiceAPI) ToEmail(v string) InternalUniversalTransferHistoryServiceAPI {
+	return (*internalUniversalTransferHistoryServiceAPI)((*InternalUniversalTransferHistoryService)(a).ToEmail(v))
+}
+
+func (a *internalUniversalTransferHistoryServiceAPI) StartTime(v int64) InternalUniversalTransferHistoryServiceAPI {
+	return (*internalUniversalTransferHistoryServiceAPI)((*InternalUniversalTransferHistoryService)(a).StartTime(v))
+}
+
+func (a *internalUniversalTransferHistoryServiceAPI) EndTime(v int64) InternalUniversalTransferHistoryServiceAPI {
+	return (*internalUniversalTransferHistoryServiceAPI)((*InternalUniversalTransferHistoryService)(a).EndTime(v))
+}
+
+func (a *internalUniversalTransferHistoryServiceAPI) Page(v int) InternalUniversalTransferHistoryServiceAPI {
+	return (*internalUniversalTransferHistoryServiceAPI)((*InternalUniversalTransferHistoryService)(a).Page(v))
+}
+
+func (a *internalUniversalTransferHistoryServiceAPI) Limit(v int) InternalUniversalTransferHistoryServiceAPI {
+	return (*internalUniversalTransferHistoryServiceAPI)((*InternalUniversalTransferHistoryService)(a).Limit(v))
+}
+
+func (a *internalUniversalTransferHistoryServiceAPI) ClientTranId(v string) InternalUniversalTransferHistoryServiceAPI {
+	return (*internalUniversalTransferHistoryServiceAPI)((*InternalUniversalTransferHistoryService)(a).ClientTranId(v))
+}
+
+func (a *internalUniversalTransferHistoryServiceAPI) Do(ctx context.Context, opts ...RequestOption) (InternalUniversalTransferHistoryResponse, error) {
+	return (*InternalUniversalTransferHistoryService)(a).Do(ctx, opts...)
+}
+
+// SubAccountListServiceAPI is the interface of SubAccountListService
+type SubAccountListServiceAPI interface {
+	Email(v string) SubAccountListServiceAPI
+	IsFreeze(v bool) SubAccountListServiceAPI
+	Page(v int) SubAccountListServiceAPI
+	Limit(v int) SubAccountListServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) (*SubAccountList, error)
+}
+
+type subAccountListServiceAPI SubAccountListService
+
+func (a *subAccountListServiceAPI) Email(v string) SubAccountListServiceAPI {
+	return (*subAccountListServiceAPI)((*SubAccountListService)(a).Email(v))
+}
+
+func (a *subAccountListServiceAPI) IsFreeze(v bool) SubAccountListServiceAPI {
+	return (*subAccountListServiceAPI)((*SubAccountListService)(a).IsFreeze(v))
+}
+
+func (a *subAccountListServiceAPI) Page(v int) SubAccountListServiceAPI {
+	return (*subAccountListServiceAPI)((*SubAccountListService)(a).Page(v))
+}
+
+func (a *subAccountListServiceAPI) Limit(v int) SubAccountListServiceAPI {
+	return (*subAccountListServiceAPI)((*SubAccountListService)(a).Limit(v))
+}
+
+func (a *subAccountListServiceAPI) Do(ctx context.Context, opts ...RequestOption) (*SubAccountList, error) {
+	return (*SubAccountListService)(a).Do(ctx, opts...)
+}
+
+// GetUserAssetServiceAPI is the interface of GetUserAssetService
+type GetUserAssetServiceAPI interface {
+	Asset(asset string) GetUserAssetServiceAPI
+	NeedBtcValuation(val bool) GetUserAssetServiceAPI
+	Do(ctx context.Context) ([]UserAssetRecord, error)
+}
+
+type getUserAssetServiceAPI GetUserAssetService
+
+func (a *getUserAssetServiceAPI) Asset(asset string) GetUserAssetServiceAPI {
+	return (*getUserAssetServiceAPI)((*GetUserAssetService)(a).Asset(asset))
+}
+
+func (a *getUserAssetServiceAPI) NeedBtcValuation(val bool) GetUserAssetServiceAPI {
+	return (*getUserAssetServiceAPI)((*GetUserAssetService)(a).NeedBtcValuation(val))
+}
+
+func (a *getUserAssetServiceAPI) Do(ctx context.Context) ([]UserAssetRecord, error) {
+	return (*GetUserAssetService)(a).Do(ctx)
+}
+
+// ManagedSubAccountDepositServiceAPI is the interface of ManagedSubAccountDepositService
+type ManagedSubAccountDepositServiceAPI interface {
+	ToEmail(email string) ManagedSubAccountDepositServiceAPI
+	Asset(asset string) ManagedSubAccountDepositServiceAPI
+	Amount(amount string) ManagedSubAccountDepositServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) (*ManagedSubAccountDepositResponse, error)
+}
+
+type managedSubAccountDepositServiceAPI ManagedSubAccountDepositService
+
+func (a *managedSubAccountDepositServiceAPI) ToEmail(email string) ManagedSubAccountDepositServiceAPI {
+	return (*managedSubAccountDepositServiceAPI)((*ManagedSubAccountDepositService)(a).ToEmail(email))
+}
+
+func (a *managedSubAccountDepositServiceAPI) Asset(asset string) ManagedSubAccountDepositServiceAPI {
+	return (*managedSubAccountDepositServiceAPI)((*ManagedSubAccountDepositService)(a).Asset(asset))
+}
+
+func (a *managedSubAccountDepositServiceAPI) Amount(amount string) ManagedSubAccountDepositServiceAPI {
+	return (*managedSubAccountDepositServiceAPI)((*ManagedSubAccountDepositService)(a).Amount(amount))
+}
+
+func (a *managedSubAccountDepositServiceAPI) Do(ctx context.Context, opts ...RequestOption) (*ManagedSubAccountDepositResponse, error) {
+	return (*ManagedSubAccountDepositService)(a).Do(ctx, opts...)
+}
+
+// ManagedSubAccountWithdrawalServiceAPI is the interface of ManagedSubAccountWithdrawalService
+type ManagedSubAccountWithdrawalServiceAPI interface {
+	FromEmail(email string) ManagedSubAccountWithdrawalServiceAPI
+	Asset(asset string) ManagedSubAccountWithdrawalServiceAPI
+	Amount(amount string) ManagedSubAccountWithdrawalServiceAPI
+	TransferDate(val int64) ManagedSubAccountWithdrawalServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) (*ManagedSubAccountWithdrawalResponse, error)
+}
+
+type managedSubAccountWithdrawalServiceAPI ManagedSubAccountWithdrawalService
+
+func (a *managedSubAccountWithdrawalServiceAPI) FromEmail(email string) ManagedSubAccountWithdrawalServiceAPI {
+	return (*managedSubAccountWithdrawalServiceAPI)((*ManagedSubAccountWithdrawalService)(a).FromEmail(email))
+}
+
+func (a *managedSubAccountWithdrawalServiceAPI) Asset(asset string) ManagedSubAccountWithdrawalServiceAPI {
+	return (*managedSubAccountWithdrawalServiceAPI)((*ManagedSubAccountWithdrawalService)(a).Asset(asset))
+}
+
+func (a *managedSubAccountWithdrawalServiceAPI) Amount(amount string) ManagedSubAccountWithdrawalServiceAPI {
+	return (*managedSubAccountWithdrawalServiceAPI)((*ManagedSubAccountWithdrawalService)(a).Amount(amount))
+}
+
+func (a *managedSubAccountWithdrawalServiceAPI) TransferDate(val int64) ManagedSubAccountWithdrawalServiceAPI {
+	return (*managedSubAccountWithdrawalServiceAPI)((*ManagedSubAccountWithdrawalService)(a).TransferDate(val))
+}
+
+func (a *managedSubAccountWithdrawalServiceAPI) Do(ctx context.Context, opts ...RequestOption) (*ManagedSubAccountWithdrawalResponse, error) {
+	return (*ManagedSubAccountWithdrawalService)(a).Do(ctx, opts...)
+}
+
+// ManagedSubAccountAssetsServiceAPI is the interface of ManagedSubAccountAssetsService
+type ManagedSubAccountAssetsServiceAPI interface {
+	Email(email string) ManagedSubAccountAssetsServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) ([]*ManagedSubAccountAsset, error)
+}
+
+type managedSubAccountAssetsServiceAPI ManagedSubAccountAssetsService
+
+func (a *managedSubAccountAssetsServiceAPI) Email(email string) ManagedSubAccountAssetsServiceAPI {
+	return (*managedSubAccountAssetsServiceAPI)((*ManagedSubAccountAssetsService)(a).Email(email))
+}
+
+func (a *managedSubAccountAssetsServiceAPI) Do(ctx context.Context, opts ...RequestOption) ([]*ManagedSubAccountAsset, error) {
+	return (*ManagedSubAccountAssetsService)(a).Do(ctx, opts...)
+}
+
+// SubAccountFuturesAccountServiceAPI is the interface of SubAccountFuturesAccountService
+type SubAccountFuturesAccountServiceAPI interface {
+	Email(v string) SubAccountFuturesAccountServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) (*SubAccountFuturesAccount, error)
+}
+
+type subAccountFuturesAccountServiceAPI SubAccountFuturesAccountService
+
+func (a *subAccountFuturesAccountServiceAPI) Email(v string) SubAccountFuturesAccountServiceAPI {
+	return (*subAccountFuturesAccountServiceAPI)((*SubAccountFuturesAccountService)(a).Email(v))
+}
+
+func (a *subAccountFuturesAccountServiceAPI) Do(ctx context.Context, opts ...RequestOption) (*SubAccountFuturesAccount, error) {
+	return (*SubAccountFuturesAccountService)(a).Do(ctx, opts...)
+}
+
+// SubAccountFuturesSummaryV1ServiceAPI is the interface of SubAccountFuturesSummaryV1Service
+type SubAccountFuturesSummaryV1ServiceAPI interface {
+	Do(ctx context.Context, opts ...RequestOption) (*SubAccountFuturesSummaryV1, error)
+}
+
+type subAccountFuturesSummaryV1ServiceAPI SubAccountFuturesSummaryV1Service
+
+func (a *subAccountFuturesSummaryV1ServiceAPI) Do(ctx context.Context, opts ...RequestOption) (*SubAccountFuturesSummaryV1, error) {
+	return (*SubAccountFuturesSummaryV1Service)(a).Do(ctx, opts...)
+}
+
+// SubAccountFuturesTransferV1ServiceAPI is the interface of SubAccountFuturesTransferV1Service
+type SubAccountFuturesTransferV1ServiceAPI interface {
+	Email(v string) SubAccountFuturesTransferV1ServiceAPI
+	Asset(v string) SubAccountFuturesTransferV1ServiceAPI
+	Amount(v float64) SubAccountFuturesTransferV1ServiceAPI
+	TransferType(v int) SubAccountFuturesTransferV1ServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) (*SubAccountFuturesTransferResponse, error)
+}
+
+type subAccountFuturesTransferV1ServiceAPI SubAccountFuturesTransferV1Service
+
+func (a *subAccountFuturesTransferV1ServiceAPI) Email(v string) SubAccountFuturesTransferV1ServiceAPI {
+	return (*subAccountFuturesTransferV1ServiceAPI)((*SubAccountFuturesTransferV1Service)(a).Email(v))
+}
+
+func (a *subAccountFuturesTransferV1ServiceAPI) Asset(v string) SubAccountFuturesTransferV1ServiceAPI {
+	return (*subAccountFuturesTransferV1ServiceAPI)((*SubAccountFuturesTransferV1Service)(a).Asset(v))
+}
+
+func (a *subAccountFuturesTransferV1ServiceAPI) Amount(v float64) SubAccountFuturesTransferV1ServiceAPI {
+	return (*subAccountFuturesTransferV1ServiceAPI)((*SubAccountFuturesTransferV1Service)(a).Amount(v))
+}
+
+func (a *subAccountFuturesTransferV1ServiceAPI) TransferType(v int) SubAccountFuturesTransferV1ServiceAPI {
+	return (*subAccountFuturesTransferV1ServiceAPI)((*SubAccountFuturesTransferV1Service)(a).TransferType(v))
+}
+
+func (a *subAccountFuturesTransferV1ServiceAPI) Do(ctx context.Context, opts ...RequestOption) (*SubAccountFuturesTransferResponse, error) {
+	return (*SubAccountFuturesTransferV1Service)(a).Do(ctx, opts...)
+}
+
+// SubAccountTransferHistoryServiceAPI is the interface of SubAccountTransferHistoryService
+type SubAccountTransferHistoryServiceAPI interface {
+	Asset(v string) SubAccountTransferHistoryServiceAPI
+	TransferType(v SubAccountTransferType) SubAccountTransferHistoryServiceAPI
+	StartTime(v int64) SubAccountTransferHistoryServiceAPI
+	EndTime(v int64) SubAccountTransferHistoryServiceAPI
+	Limit(v int) SubAccountTransferHistoryServiceAPI
+	ReturnFailHistory(v bool) SubAccountTransferHistoryServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) ([]*SubAccountTransferHistory, error)
+}
+
+type subAccountTransferHistoryServiceAPI SubAccountTransferHistoryService
+
+func (a *subAccountTransferHistoryServiceAPI) Asset(v string) SubAccountTransferHistoryServiceAPI {
+	return (*subAccountTransferHistoryServiceAPI)((*SubAccountTransferHistoryService)(a).Asset(v))
+}
+
+func (a *subAccountTransferHistoryServiceAPI) TransferType(v SubAccountTransferType) SubAccountTransferHistoryServiceAPI {
+	return (*subAccountTransferHistoryServiceAPI)((*SubAccountTransferHistoryService)(a).TransferType(v))
+}
+
+func (a *subAccountTransferHistoryServiceAPI) StartTime(v int64) SubAccountTransferHistoryServiceAPI {
+	return (*subAccountTransferHistoryServiceAPI)((*SubAccountTransferHistoryService)(a).StartTime(v))
+}
+
+func (a *subAccountTransferHistoryServiceAPI) EndTime(v int64) SubAccountTransferHistoryServiceAPI {
+	return (*subAccountTransferHistoryServiceAPI)((*SubAccountTransferHistoryService)(a).EndTime(v))
+}
+
+func (a *subAccountTransferHistoryServiceAPI) Limit(v int) SubAccountTransferHistoryServiceAPI {
+	return (*subAccountTransferHistoryServiceAPI)((*SubAccountTransferHistoryService)(a).Limit(v))
+}
+
+func (a *subAccountTransferHistoryServiceAPI) ReturnFailHistory(v bool) SubAccountTransferHistoryServiceAPI {
+	return (*subAccountTransferHistoryServiceAPI)((*SubAccountTransferHistoryService)(a).ReturnFailHistory(v))
+}
+
+func (a *subAccountTransferHistoryServiceAPI) Do(ctx context.Context, opts ...RequestOption) ([]*SubAccountTransferHistory, error) {
+	return (*SubAccountTransferHistoryService)(a).Do(ctx, opts...)
+}
+
+// ListUserUniversalTransferServiceAPI is the interface of ListUserUniversalTransferService
+type ListUserUniversalTransferServiceAPI interface {
+	Type(v UserUniversalTransferType) ListUserUniversalTransferServiceAPI
+	StartTime(v int64) ListUserUniversalTransferServiceAPI
+	EndTime(v int64) ListUserUniversalTransferServiceAPI
+	Current(v int) ListUserUniversalTransferServiceAPI
+	Size(v int) ListUserUniversalTransferServiceAPI
+	FromSymbol(v string) ListUserUniversalTransferServiceAPI
+	ToSymbol(v string) ListUserUniversalTransferServiceAPI
+	Do(ctx context.Context) (*UserUniversalTransferResponse, error)
+}
+
+type listUserUniversalTransferServiceAPI ListUserUniversalTransferService
+
+func (a *listUserUniversalTransferServiceAPI) Type(v UserUniversalTransferType) ListUserUniversalTransferServiceAPI {
+	return (*listUserUniversalTransferServiceAPI)((*ListUserUniversalTransferService)(a).Type(v))
+}
+
+func (a *listUserUniversalTransferServiceAPI) StartTime(v int64) ListUserUniversalTransferServiceAPI {
+	return (*listUserUniversalTransferServiceAPI)((*ListUserUniversalTransferService)(a).StartTime(v))
+}
+
+func (a *listUserUniversalTransferServiceAPI) EndTime(v int64) ListUserUniversalTransferServiceAPI {
+	return (*listUserUniversalTransferServiceAPI)((*ListUserUniversalTransferService)(a).EndTime(v))
+}
+
+func (a *listUserUniversalTransferServiceAPI) Current(v int) ListUserUniversalTransferServiceAPI {
+	return (*listUserUniversalTransferServiceAPI)((*ListUserUniversalTransferService)(a).Current(v))
+}
+
+func (a *listUserUniversalTransferServiceAPI) Size(v int) ListUserUniversalTransferServiceAPI {
+	return (*listUserUniversalTransferServiceAPI)((*ListUserUniversalTransferService)(a).Size(v))
+}
+
+func (a *listUserUniversalTransferServiceAPI) FromSymbol(v string) ListUserUniversalTransferServiceAPI {
+	return (*listUserUniversalTransferServiceAPI)((*ListUserUniversalTransferService)(a).FromSymbol(v))
+}
+
+func (a *listUserUniversalTransferServiceAPI) ToSymbol(v string) ListUserUniversalTransferServiceAPI {
+	return (*listUserUniversalTransferServiceAPI)((*ListUserUniversalTransferService)(a).ToSymbol(v))
+}
+
+func (a *listUserUniversalTransferServiceAPI) Do(ctx context.Context) (*UserUniversalTransferResponse, error) {
+	return (*ListUserUniversalTransferService)(a).Do(ctx)
+}
+
+// CreateVirtualSubAccountServiceAPI is the interface of CreateVirtualSubAccountService
+type CreateVirtualSubAccountServiceAPI interface {
+	SubAccountString(subAccountString string) CreateVirtualSubAccountServiceAPI
+	RecvWindow(recvWindow int64) CreateVirtualSubAccountServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) (*CreateVirtualSubAccountResponse, error)
+}
+
+type createVirtualSubAccountServiceAPI CreateVirtualSubAccountService
+
+func (a *createVirtualSubAccountServiceAPI) SubAccountString(subAccountString string) CreateVirtualSubAccountServiceAPI {
+	return (*createVirtualSubAccountServiceAPI)((*CreateVirtualSubAccountService)(a).SubAccountString(subAccountString))
+}
+
+func (a *createVirtualSubAccountServiceAPI) RecvWindow(recvWindow int64) CreateVirtualSubAccountServiceAPI {
+	return (*createVirtualSubAccountServiceAPI)((*CreateVirtualSubAccountService)(a).RecvWindow(recvWindow))
+}
+
+func (a *createVirtualSubAccountServiceAPI) Do(ctx context.Context, opts ...RequestOption) (*CreateVirtualSubAccountResponse, error) {
+	return (*CreateVirtualSubAccountService)(a).Do(ctx, opts...)
+}
+
+// SubAccountSpotTransferHistoryServiceAPI is the interface of SubAccountSpotTransferHistoryService
+type SubAccountSpotTransferHistoryServiceAPI interface {
+	FromEmail(fromEmail string) SubAccountSpotTransferHistoryServiceAPI
+	ToEmail(toEmail string) SubAccountSpotTransferHistoryServiceAPI
+	StartTime(startTime uint64) SubAccountSpotTransferHistoryServiceAPI
+	EndTime(endTime uint64) SubAccountSpotTransferHistoryServiceAPI
+	Page(page int32) SubAccountSpotTransferHistoryServiceAPI
+	Limit(limit int32) SubAccountSpotTransferHistoryServiceAPI
+	RecvWindow(recvWindow int64) SubAccountSpotTransferHistoryServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) ([]*SubAccountSpotTransfer, error)
+}
+
+type subAccountSpotTransferHistoryServiceAPI SubAccountSpotTransferHistoryService
+
+func (a *subAccountSpotTransferHistoryServiceAPI) FromEmail(fromEmail string) SubAccountSpotTransferHistoryServiceAPI {
+	return (*subAccountSpotTransferHistoryServiceAPI)((*SubAccountSpotTransferHistoryService)(a).FromEmail(fromEmail))
+}
+
+func (a *subAccountSpotTransferHistoryServiceAPI) ToEmail(toEmail string) SubAccountSpotTransferHistoryServiceAPI {
+	return (*subAccountSpotTransferHistoryServiceAPI)((*SubAccountSpotTransferHistoryService)(a).ToEmail(toEmail))
+}
+
+func (a *subAccountSpotTransferHistoryServiceAPI) StartTime(startTime uint64) SubAccountSpotTransferHistoryServiceAPI {
+	return (*subAccountSpotTransferHistoryServiceAPI)((*SubAccountSpotTransferHistoryService)(a).StartTime(startTime))
+}
+
+func (a *subAccountSpotTransferHistoryServiceAPI) EndTime(endTime uint64) SubAccountSpotTransferHistoryServiceAPI {
+	return (*subAccountSpotTransferHistoryServiceAPI)((*SubAccountSpotTransferHistoryService)(a).EndTime(endTime))
+}
+
+func (a *subAccountSpotTransferHistoryServiceAPI) Page(page int32) SubAccountSpotTransferHistoryServiceAPI {
+	return (*subAccountSpotTransferHistoryServiceAPI)((*SubAccountSpotTransferHistoryService)(a).Page(page))
+}
+
+func (a *subAccountSpotTransferHistoryServiceAPI) Limit(limit int32) SubAccountSpotTransferHistoryServiceAPI {
+	return (*subAccountSpotTransferHistoryServiceAPI)((*SubAccountSpotTransferHistoryService)(a).Limit(limit))
+}
+
+func (a *subAccountSpotTransferHistoryServiceAPI) RecvWindow(recvWindow int64) SubAccountSpotTransferHistoryServiceAPI {
+	return (*subAccountSpotTransferHistoryServiceAPI)((*SubAccountSpotTransferHistoryService)(a).RecvWindow(recvWindow))
+}
+
+func (a *subAccountSpotTransferHistoryServiceAPI) Do(ctx context.Context, opts ...RequestOption) ([]*SubAccountSpotTransfer, error) {
+	return (*SubAccountSpotTransferHistoryService)(a).Do(ctx, opts...)
+}
+
+// SubAccountFuturesTransferHistoryServiceAPI is the interface of SubAccountFuturesTransferHistoryService
+type SubAccountFuturesTransferHistoryServiceAPI interface {
+	Email(email string) SubAccountFuturesTransferHistoryServiceAPI
+	FuturesType(futuresType int64) SubAccountFuturesTransferHistoryServiceAPI
+	StartTime(startTime int64) SubAccountFuturesTransferHistoryServiceAPI
+	EndTime(endTime int64) SubAccountFuturesTransferHistoryServiceAPI
+	Page(page int32) SubAccountFuturesTransferHistoryServiceAPI
+	Limit(limit int32) SubAccountFuturesTransferHistoryServiceAPI
+	RecvWindow(recvWindow int64) SubAccountFuturesTransferHistoryServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) (*SubAccountFuturesTransferHistoryResponse, error)
+}
+
+type subAccountFuturesTransferHistoryServiceAPI SubAccountFuturesTransferHistoryService
+
+func (a *subAccountFuturesTransferHistoryServiceAPI) Email(email string) SubAccountFuturesTransferHistoryServiceAPI {
+	return (*subAccountFuturesTransferHistoryServiceAPI)((*SubAccountFuturesTransferHistoryService)(a).Email(email))
+}
+
+func (a *subAccountFuturesTransferHistoryServiceAPI) FuturesType(futuresType int64) SubAccountFuturesTransferHistoryServiceAPI {
+	return (*subAccountFuturesTransferHistoryServiceAPI)((*SubAccountFuturesTransferHistoryService)(a).FuturesType(futuresType))
+}
+
+func (a *subAccountFuturesTransferHistoryServiceAPI) StartTime(startTime int64) SubAccountFuturesTransferHistoryServiceAPI {
+	return (*subAccountFuturesTransferHistoryServiceAPI)((*SubAccountFuturesTransferHistoryService)(a).StartTime(startTime))
+}
+
+func (a *subAccountFuturesTransferHistoryServiceAPI) EndTime(endTime int64) SubAccountFuturesTransferHistoryServiceAPI {
+	return (*subAccountFuturesTransferHistoryServiceAPI)((*SubAccountFuturesTransferHistoryService)(a).EndTime(endTime))
+}
+
+func (a *subAccountFuturesTransferHistoryServiceAPI) Page(page int32) SubAccountFuturesTransferHistoryServiceAPI {
+	return (*subAccountFuturesTransferHistoryServiceAPI)((*SubAccountFuturesTransferHistoryService)(a).Page(page))
+}
+
+func (a *subAccountFuturesTransferHistoryServiceAPI) Limit(limit int32) SubAccountFuturesTransferHistoryServiceAPI {
+	return (*subAccountFuturesTransferHistoryServiceAPI)((*SubAccountFuturesTransferHistoryService)(a).Limit(limit))
+}
+
+func (a *subAccountFuturesTransferHistoryServiceAPI) RecvWindow(recvWindow int64) SubAccountFuturesTransferHistoryServiceAPI {
+	return (*subAccountFuturesTransferHistoryServiceAPI)((*SubAccountFuturesTransferHistoryService)(a).RecvWindow(recvWindow))
+}
+
+func (a *subAccountFuturesTransferHistoryServiceAPI) Do(ctx context.Context, opts ...RequestOption) (*SubAccountFuturesTransferHistoryResponse, error) {
+	return (*SubAccountFuturesTransferHistoryService)(a).Do(ctx, opts...)
+}
+
+// SubAccountDepositRecordServiceAPI is the interface of SubAccountDepositRecordService
+type SubAccountDepositRecordServiceAPI interface {
+	Email(email string) SubAccountDepositRecordServiceAPI
+	Coin(coin string) SubAccountDepositRecordServiceAPI
+	Status(status int32) SubAccountDepositRecordServiceAPI
+	StartTime(startTime int64) SubAccountDepositRecordServiceAPI
+	EndTime(endTime int64) SubAccountDepositRecordServiceAPI
+	Limit(limit int) SubAccountDepositRecordServiceAPI
+	Offset(offset int) SubAccountDepositRecordServiceAPI
+	TxId(txId string) SubAccountDepositRecordServiceAPI
+	RecvWindow(recvWindow int64) SubAccountDepositRecordServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) ([]*SubAccountDepositRecord, error)
+}
+
+type subAccountDepositRecordServiceAPI SubAccountDepositRecordService
+
+func (a *subAccountDepositRecordServiceAPI) Email(email string) SubAccountDepositRecordServiceAPI {
+	return (*subAccountDepositRecordServiceAPI)((*SubAccountDepositRecordService)(a).Email(email))
+}
+
+func (a *subAccountDepositRecordServiceAPI) Coin(coin string) SubAccountDepositRecordServiceAPI {
+	return (*subAccountDepositRecordServiceAPI)((*SubAccountDepositRecordService)(a).Coin(coin))
+}
+
+func (a *subAccountDepositRecordServiceAPI) Status(status int32) SubAccountDepositRecordServiceAPI {
+	return (*subAccountDepositRecordServiceAPI)((*SubAccountDepositRecordService)(a).Status(status))
+}
+
+func (a *subAccountDepositRecordServiceAPI) StartTime(startTime int64) SubAccountDepositRecordServiceAPI {
+	return (*subAccountDepositRecordServiceAPI)((*SubAccountDepositRecordService)(a).StartTime(startTime))
+}
+
+func (a *subAccountDepositRecordServiceAPI) EndTime(endTime int64) SubAccountDepositRecordServiceAPI {
+	return (*subAccountDepositRecordServiceAPI)((*SubAccountDepositRecordService)(a).EndTime(endTime))
+}
+
+func (a *subAccountDepositRecordServiceAPI) Limit(limit int) SubAccountDepositRecordServiceAPI {
+	return (*subAccountDepositRecordServiceAPI)((*SubAccountDepositRecordService)(a).Limit(limit))
+}
+
+func (a *subAccountDepositRecordServiceAPI) Offset(offset int) SubAccountDepositRecordServiceAPI {
+	return (*subAccountDepositRecordServiceAPI)((*SubAccountDepositRecordService)(a).Offset(offset))
+}
+
+func (a *subAccountDepositRecordServiceAPI) TxId(txId string) SubAccountDepositRecordServiceAPI {
+	return (*subAccountDepositRecordServiceAPI)((*SubAccountDepositRecordService)(a).TxId(txId))
+}
+
+func (a *subAccountDepositRecordServiceAPI) RecvWindow(recvWindow int64) SubAccountDepositRecordServiceAPI {
+	return (*subAccountDepositRecordServiceAPI)((*SubAccountDepositRecordService)(a).RecvWindow(recvWindow))
+}
+
+func (a *subAccountDepositRecordServiceAPI) Do(ctx context.Context, opts ...RequestOption) ([]*SubAccountDepositRecord, error) {
+	return (*SubAccountDepositRecordService)(a).Do(ctx, opts...)
+}
+
+// SubAccountMarginFuturesStatusServiceAPI is the interface of SubAccountMarginFuturesStatusService
+type SubAccountMarginFuturesStatusServiceAPI interface {
+	Email(email string) SubAccountMarginFuturesStatusServiceAPI
+	RecvWindow(recvWindow int64) SubAccountMarginFuturesStatusServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) ([]*SubAccountMarginFuturesStatus, error)
+}
+
+type subAccountMarginFuturesStatusServiceAPI SubAccountMarginFuturesStatusService
+
+func (a *subAccountMarginFuturesStatusServiceAPI) Email(email string) SubAccountMarginFuturesStatusServiceAPI {
+	return (*subAccountMarginFuturesStatusServiceAPI)((*SubAccountMarginFuturesStatusService)(a).Email(email))
+}
+
+func (a *subAccountMarginFuturesStatusServiceAPI) RecvWindow(recvWindow int64) SubAccountMarginFuturesStatusServiceAPI {
+	return (*subAccountMarginFuturesStatusServiceAPI)((*SubAccountMarginFuturesStatusService)(a).RecvWindow(recvWindow))
+}
+
+func (a *subAccountMarginFuturesStatusServiceAPI) Do(ctx context.Context, opts ...RequestOption) ([]*SubAccountMarginFuturesStatus, error) {
+	return (*SubAccountMarginFuturesStatusService)(a).Do(ctx, opts...)
+}
+
+// SubAccountMarginEnableServiceAPI is the interface of SubAccountMarginEnableService
+type SubAccountMarginEnableServiceAPI interface {
+	Email(email string) SubAccountMarginEnableServiceAPI
+	RecvWindow(recvWindow int64) SubAccountMarginEnableServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) (*SubAccountMarginEnableResponse, error)
+}
+
+type subAccountMarginEnableServiceAPI SubAccountMarginEnableService
+
+func (a *subAccountMarginEnableServiceAPI) Email(email string) SubAccountMarginEnableServiceAPI {
+	return (*subAccountMarginEnableServiceAPI)((*SubAccountMarginEnableService)(a).Email(email))
+}
+
+func (a *subAccountMarginEnableServiceAPI) RecvWindow(recvWindow int64) SubAccountMarginEnableServiceAPI {
+	return (*subAccountMarginEnableServiceAPI)((*SubAccountMarginEnableService)(a).RecvWindow(recvWindow))
+}
+
+func (a *subAccountMarginEnableServiceAPI) Do(ctx context.Context, opts ...RequestOption) (*SubAccountMarginEnableResponse, error) {
+	return (*SubAccountMarginEnableService)(a).Do(ctx, opts...)
+}
+
+// SubAccountMarginAccountInfoServiceAPI is the interface of SubAccountMarginAccountInfoService
+type SubAccountMarginAccountInfoServiceAPI interface {
+	Email(email string) SubAccountMarginAccountInfoServiceAPI
+	RecvWindow(recvWindow int64) SubAccountMarginAccountInfoServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) (*SubAccountMarginAccountInfo, error)
+}
+
+type subAccountMarginAccountInfoServiceAPI SubAccountMarginAccountInfoService
+
+func (a *subAccountMarginAccountInfoServiceAPI) Email(email string) SubAccountMarginAccountInfoServiceAPI {
+	return (*subAccountMarginAccountInfoServiceAPI)((*SubAccountMarginAccountInfoService)(a).Email(email))
+}
+
+func (a *subAccountMarginAccountInfoServiceAPI) RecvWindow(recvWindow int64) SubAccountMarginAccountInfoServiceAPI {
+	return (*subAccountMarginAccountInfoServiceAPI)((*SubAccountMarginAccountInfoService)(a).RecvWindow(recvWindow))
+}
+
+func (a *subAccountMarginAccountInfoServiceAPI) Do(ctx context.Context, opts ...RequestOption) (*SubAccountMarginAccountInfo, error) {
+	return (*SubAccountMarginAccountInfoService)(a).Do(ctx, opts...)
+}
+
+// SubAccountMarginAccountSummaryServiceAPI is the interface of SubAccountMarginAccountSummaryService
+type SubAccountMarginAccountSummaryServiceAPI interface {
+	RecvWindow(recvWindow int64) SubAccountMarginAccountSummaryServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) (*SubAccountMarginAccountSummary, error)
+}
+
+type subAccountMarginAccountSummaryServiceAPI SubAccountMarginAccountSummaryService
+
+func (a *subAccountMarginAccountSummaryServiceAPI) RecvWindow(recvWindow int64) SubAccountMarginAccountSummaryServiceAPI {
+	return (*subAccountMarginAccountSummaryServiceAPI)((*SubAccountMarginAccountSummaryService)(a).RecvWindow(recvWindow))
+}
+
+func (a *subAccountMarginAccountSummaryServiceAPI) Do(ctx context.Context, opts ...RequestOption) (*SubAccountMarginAccountSummary, error) {
+	return (*SubAccountMarginAccountSummaryService)(a).Do(ctx, opts...)
+}
+
+// SubAccountFuturesEnableServiceAPI is the interface of SubAccountFuturesEnableService
+type SubAccountFuturesEnableServiceAPI interface {
+	Email(email string) SubAccountFuturesEnableServiceAPI
+	RecvWindow(recvWindow int64) SubAccountFuturesEnableServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) (*SubAccountFuturesEnableResponse, error)
+}
+
+type subAccountFuturesEnableServiceAPI SubAccountFuturesEnableService
+
+func (a *subAccountFuturesEnableServiceAPI) Email(email string) SubAccountFuturesEnableServiceAPI {
+	return (*subAccountFuturesEnableServiceAPI)((*SubAccountFuturesEnableService)(a).Email(email))
+}
+
+func (a *subAccountFuturesEnableServiceAPI) RecvWindow(recvWindow int64) SubAccountFuturesEnableServiceAPI {
+	return (*subAccountFuturesEnableServiceAPI)((*SubAccountFuturesEnableService)(a).RecvWindow(recvWindow))
+}
+
+func (a *subAccountFuturesEnableServiceAPI) Do(ctx context.Context, opts ...RequestOption) (*SubAccountFuturesEnableResponse, error) {
+	return (*SubAccountFuturesEnableService)(a).Do(ctx, opts...)
+}
+
+// SubAccountFuturesAccountSummaryServiceAPI is the interface of SubAccountFuturesAccountSummaryService
+type SubAccountFuturesAccountSummaryServiceAPI interface {
+	FuturesType(futuresType int32) SubAccountFuturesAccountSummaryServiceAPI
+	Page(page int32) SubAccountFuturesAccountSummaryServiceAPI
+	Limit(limit int32) SubAccountFuturesAccountSummaryServiceAPI
+	RecvWindow(recvWindow int64) SubAccountFuturesAccountSummaryServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) (*SubAccountFuturesAccountSummaryServiceResponse, error)
+}
+
+type subAccountFuturesAccountSummaryServiceAPI SubAccountFuturesAccountSummaryService
+
+func (a *subAccountFuturesAccountSummaryServiceAPI) FuturesType(futuresType int32) SubAccountFuturesAccountSummaryServiceAPI {
+	return (*subAccountFuturesAccountSummaryServiceAPI)((*SubAccountFuturesAccountSummaryService)(a).FuturesType(futuresType))
+}
+
+func (a *subAccountFuturesAccountSummaryServiceAPI) Page(page int32) SubAccountFuturesAccountSummaryServiceAPI {
+	return (*subAccountFuturesAccountSummaryServiceAPI)((*SubAccountFuturesAccountSummaryService)(a).Page(page))
+}
+
+func (a *subAccountFuturesAccountSummaryServiceAPI) Limit(limit int32) SubAccountFuturesAccountSummaryServiceAPI {
+	return (*subAccountFuturesAccountSummaryServiceAPI)((*SubAccountFuturesAccountSummaryService)(a).Limit(limit))
+}
+
+func (a *subAccountFuturesAccountSummaryServiceAPI) RecvWindow(recvWindow int64) SubAccountFuturesAccountSummaryServiceAPI {
+	return (*subAccountFuturesAccountSummaryServiceAPI)((*SubAccountFuturesAccountSummaryService)(a).RecvWindow(recvWindow))
+}
+
+func (a *subAccountFuturesAccountSummaryServiceAPI) Do(ctx context.Context, opts ...RequestOption) (*SubAccountFuturesAccountSummaryServiceResponse, error) {
+	return (*SubAccountFuturesAccountSummaryService)(a).Do(ctx, opts...)
+}
+
+// SubAccountFuturesPositionsServiceAPI is the interface of SubAccountFuturesPositionsService
+type SubAccountFuturesPositionsServiceAPI interface {
+	Email(email string) SubAccountFuturesPositionsServiceAPI
+	FuturesType(futuresType int32) SubAccountFuturesPositionsServiceAPI
+	RecvWindow(recvWindow int64) SubAccountFuturesPositionsServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) (*SubAccountFuturesPositionsServiceResponse, error)
+}
+
+type subAccountFuturesPositionsServiceAPI SubAccountFuturesPositionsService
+
+func (a *subAccountFuturesPositionsServiceAPI) Email(email string) SubAccountFuturesPositionsServiceAPI {
+	return (*subAccountFuturesPositionsServiceAPI)((*SubAccountFuturesPositionsService)(a).Email(email))
+}
+
+func (a *subAccountFuturesPositionsServiceAPI) FuturesType(futuresType int32) SubAccountFuturesPositionsServiceAPI {
+	return (*subAccountFuturesPositionsServiceAPI)((*SubAccountFuturesPositionsService)(a).FuturesType(futuresType))
+}
+
+func (a *subAccountFuturesPositionsServiceAPI) RecvWindow(recvWindow int64) SubAccountFuturesPositionsServiceAPI {
+	return (*subAccountFuturesPositionsServiceAPI)((*SubAccountFuturesPositionsService)(a).RecvWindow(recvWindow))
+}
+
+func (a *subAccountFuturesPositionsServiceAPI) Do(ctx context.Context, opts ...RequestOption) (*SubAccountFuturesPositionsServiceResponse, error) {
+	return (*SubAccountFuturesPositionsService)(a).Do(ctx, opts...)
+}
+
+// SubAccountMarginTransferServiceAPI is the interface of SubAccountMarginTransferService
+type SubAccountMarginTransferServiceAPI interface {
+	Email(email string) SubAccountMarginTransferServiceAPI
+	Asset(asset string) SubAccountMarginTransferServiceAPI
+	Amount(amount string) SubAccountMarginTransferServiceAPI
+	TransferType(transferType int32) SubAccountMarginTransferServiceAPI
+	RecvWindow(recvWindow int64) SubAccountMarginTransferServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) (*SubAccountMarginTransferResponse, error)
+}
+
+type subAccountMarginTransferServiceAPI SubAccountMarginTransferService
+
+func (a *subAccountMarginTransferServiceAPI) Email(email string) SubAccountMarginTransferServiceAPI {
+	return (*subAccountMarginTransferServiceAPI)((*SubAccountMarginTransferService)(a).Email(email))
+}
+
+func (a *subAccountMarginTransferServiceAPI) Asset(asset string) SubAccountMarginTransferServiceAPI {
+	return (*subAccountMarginTransferServiceAPI)((*SubAccountMarginTransferService)(a).Asset(asset))
+}
+
+func (a *subAccountMarginTransferServiceAPI) Amount(amount string) SubAccountMarginTransferServiceAPI {
+	return (*subAccountMarginTransferServiceAPI)((*SubAccountMarginTransferService)(a).Amount(amount))
+}
+
+func (a *subAccountMarginTransferServiceAPI) TransferType(transferType int32) SubAccountMarginTransferServiceAPI {
+	return (*subAccountMarginTransferServiceAPI)((*SubAccountMarginTransferService)(a).TransferType(transferType))
+}
+
+func (a *subAccountMarginTransferServiceAPI) RecvWindow(recvWindow int64) SubAccountMarginTransferServiceAPI {
+	return (*subAccountMarginTransferServiceAPI)((*SubAccountMarginTransferService)(a).RecvWindow(recvWindow))
+}
+
+func (a *subAccountMarginTransferServiceAPI) Do(ctx context.Context, opts ...RequestOption) (*SubAccountMarginTransferResponse, error) {
+	return (*SubAccountMarginTransferService)(a).Do(ctx, opts...)
+}
+
+// SubAccountTransferSubToMasterServiceAPI is the interface of SubAccountTransferSubToMasterService
+type SubAccountTransferSubToMasterServiceAPI interface {
+	Asset(asset string) SubAccountTransferSubToMasterServiceAPI
+	Amount(amount string) SubAccountTransferSubToMasterServiceAPI
+	RecvWindow(recvWindow int64) SubAccountTransferSubToMasterServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) (*SubAccountTransferSubToMasterResponse, error)
+}
+
+type subAccountTransferSubToMasterServiceAPI SubAccountTransferSubToMasterService
+
+func (a *subAccountTransferSubToMasterServiceAPI) Asset(asset string) SubAccountTransferSubToMasterServiceAPI {
+	return (*subAccountTransferSubToMasterServiceAPI)((*SubAccountTransferSubToMasterService)(a).Asset(asset))
+}
+
+func (a *subAccountTransferSubToMasterServiceAPI) Amount(amount string) SubAccountTransferSubToMasterServiceAPI {
+	return (*subAccountTransferSubToMasterServiceAPI)((*SubAccountTransferSubToMasterService)(a).Amount(amount))
+}
+
+func (a *subAccountTransferSubToMasterServiceAPI) RecvWindow(recvWindow int64) SubAccountTransferSubToMasterServiceAPI {
+	return (*subAccountTransferSubToMasterServiceAPI)((*SubAccountTransferSubToMasterService)(a).RecvWindow(recvWindow))
+}
+
+func (a *subAccountTransferSubToMasterServiceAPI) Do(ctx context.Context, opts ...RequestOption) (*SubAccountTransferSubToMasterResponse, error) {
+	return (*SubAccountTransferSubToMasterService)(a).Do(ctx, opts...)
+}
+
+// SubAccountUniversalTransferServiceAPI is the interface of SubAccountUniversalTransferService
+type SubAccountUniversalTransferServiceAPI interface {
+	FromEmail(fromEmail string) SubAccountUniversalTransferServiceAPI
+	ToEmail(toEmail string) SubAccountUniversalTransferServiceAPI
+	FromAccountType(fromAccountType string) SubAccountUniversalTransferServiceAPI
+	ToAccountType(toAccountType string) SubAccountUniversalTransferServiceAPI
+	ClientTranId(clientTranId string) SubAccountUniversalTransferServiceAPI
+	Symbol(symbol string) SubAccountUniversalTransferServiceAPI
+	Asset(asset string) SubAccountUniversalTransferServiceAPI
+	Amount(amount string) SubAccountUniversalTransferServiceAPI
+	RecvWindow(recvWindow int64) SubAccountUniversalTransferServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) (*SubAccountUniversalTransferResponse, error)
+}
+
+type subAccountUniversalTransferServiceAPI SubAccountUniversalTransferService
+
+func (a *subAccountUniversalTransferServiceAPI) FromEmail(fromEmail string) SubAccountUniversalTransferServiceAPI {
+	return (*subAccountUniversalTransferServiceAPI)((*SubAccountUniversalTransferService)(a).FromEmail(fromEmail))
+}
+
+func (a *subAccountUniversalTransferServiceAPI) ToEmail(toEmail string) SubAccountUniversalTransferServiceAPI {
+	return (*subAccountUniversalTransferServiceAPI)((*SubAccountUniversalTransferService)(a).ToEmail(toEmail))
+}
+
+func (a *subAccountUniversalTransferServiceAPI) FromAccountType(fromAccountType string) SubAccountUniversalTransferServiceAPI {
+	return (*subAccountUniversalTransferServiceAPI)((*SubAccountUniversalTransferService)(a).FromAccountType(fromAccountType))
+}
+
+func (a *subAccountUniversalTransferServiceAPI) ToAccountType(toAccountType string) SubAccountUniversalTransferServiceAPI {
+	return (*subAccountUniversalTransferServiceAPI)((*SubAccountUniversalTransferService)(a).ToAccountType(toAccountType))
+}
+
+func (a *subAccountUniversalTransferServiceAPI) ClientTranId(clientTranId string) SubAccountUniversalTransferServiceAPI {
+	return (*subAccountUniversalTransferServiceAPI)((*SubAccountUniversalTransferService)(a).ClientTranId(clientTranId))
+}
+
+func (a *subAccountUniversalTransferServiceAPI) Symbol(symbol string) SubAccountUniversalTransferServiceAPI {
+	return (*subAccountUniversalTransferServiceAPI)((*SubAccountUniversalTransferService)(a).Symbol(symbol))
+}
+
+func (a *subAccountUniversalTransferServiceAPI) Asset(asset string) SubAccountUniversalTransferServiceAPI {
+	return (*subAccountUniversalTransferServiceAPI)((*SubAccountUniversalTransferService)(a).Asset(asset))
+}
+
+func (a *subAccountUniversalTransferServiceAPI) Amount(amount string) SubAccountUniversalTransferServiceAPI {
+	return (*subAccountUniversalTransferServiceAPI)((*SubAccountUniversalTransferService)(a).Amount(amount))
+}
+
+func (a *subAccountUniversalTransferServiceAPI) RecvWindow(recvWindow int64) SubAccountUniversalTransferServiceAPI {
+	return (*subAccountUniversalTransferServiceAPI)((*SubAccountUniversalTransferService)(a).RecvWindow(recvWindow))
+}
+
+func (a *subAccountUniversalTransferServiceAPI) Do(ctx context.Context, opts ...RequestOption) (*SubAccountUniversalTransferResponse, error) {
+	return (*SubAccountUniversalTransferService)(a).Do(ctx, opts...)
+}
+
+// SubAccUniversalTransferHistoryServiceAPI is the interface of SubAccUniversalTransferHistoryService
+type SubAccUniversalTransferHistoryServiceAPI interface {
+	FromEmail(fromEmail string) SubAccUniversalTransferHistoryServiceAPI
+	ToEmail(toEmail string) SubAccUniversalTransferHistoryServiceAPI
+	ClientTranId(clientTranId string) SubAccUniversalTransferHistoryServiceAPI
+	StartTime(startTime int64) SubAccUniversalTransferHistoryServiceAPI
+	EndTime(endTime int64) SubAccUniversalTransferHistoryServiceAPI
+	Page(page int32) SubAccUniversalTransferHistoryServiceAPI
+	Limit(limit int32) SubAccUniversalTransferHistoryServiceAPI
+	RecvWindow(recvWindow int64) SubAccUniversalTransferHistoryServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) (*SubAccountUniversalTransferHistoryServiceResponse, error)
+}
+
+type subAccUniversalTransferHistoryServiceAPI SubAccUniversalTransferHistoryService
+
+func (a *subAccUniversalTransferHistoryServiceAPI) FromEmail(fromEmail string) SubAccUniversalTransferHistoryServiceAPI {
+	return (*subAccUniversalTransferHistoryServiceAPI)((*SubAccUniversalTransferHistoryService)(a).FromEmail(fromEmail))
+}
+
+func (a *subAccUniversalTransferHistoryServiceAPI) ToEmail(toEmail string) SubAccUniversalTransferHistoryServiceAPI {
+	return (*subAccUniversalTransferHistoryServiceAPI)((*SubAccUniversalTransferHistoryService)(a).ToEmail(toEmail))
+}
+
+func (a *subAccUniversalTransferHistoryServiceAPI) ClientTranId(clientTranId string) SubAccUniversalTransferHistoryServiceAPI {
+	return (*subAccUniversalTransferHistoryServiceAPI)((*SubAccUniversalTransferHistoryService)(a).ClientTranId(clientTranId))
+}
+
+func (a *subAccUniversalTransferHistoryServiceAPI) StartTime(startTime int64) SubAccUniversalTransferHistoryServiceAPI {
+	return (*subAccUniversalTransferHistoryServiceAPI)((*SubAccUniversalTransferHistoryService)(a).StartTime(startTime))
+}
+
+func (a *subAccUniversalTransferHistoryServiceAPI) EndTime(endTime int64) SubAccUniversalTransferHistoryServiceAPI {
+	return (*subAccUniversalTransferHistoryServiceAPI)((*SubAccUniversalTransferHistoryService)(a).EndTime(endTime))
+}
+
+func (a *subAccUniversalTransferHistoryServiceAPI) Page(page int32) SubAccUniversalTransferHistoryServiceAPI {
+	return (*subAccUniversalTransferHistoryServiceAPI)((*SubAccUniversalTransferHistoryService)(a).Page(page))
+}
+
+func (a *subAccUniversalTransferHistoryServiceAPI) Limit(limit int32) SubAccUniversalTransferHistoryServiceAPI {
+	return (*subAccUniversalTransferHistoryServiceAPI)((*SubAccUniversalTransferHistoryService)(a).Limit(limit))
+}
+
+func (a *subAccUniversalTransferHistoryServiceAPI) RecvWindow(recvWindow int64) SubAccUniversalTransferHistoryServiceAPI {
+	return (*subAccUniversalTransferHistoryServiceAPI)((*SubAccUniversalTransferHistoryService)(a).RecvWindow(recvWindow))
+}
+
+func (a *subAccUniversalTransferHistoryServiceAPI) Do(ctx context.Context, opts ...RequestOption) (*SubAccountUniversalTransferHistoryServiceResponse, error) {
+	return (*SubAccUniversalTransferHistoryService)(a).Do(ctx, opts...)
+}
+
+// SubAccountBlvtEnableServiceAPI is the interface of SubAccountBlvtEnableService
+type SubAccountBlvtEnableServiceAPI interface {
+	Email(email string) SubAccountBlvtEnableServiceAPI
+	EnableBlvt(enableBlvt bool) SubAccountBlvtEnableServiceAPI
+	RecvWindow(recvWindow int64) SubAccountBlvtEnableServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) (*SubAccountBlvtEnableServiceResponse, error)
+}
+
+type subAccountBlvtEnableServiceAPI SubAccountBlvtEnableService
+
+func (a *subAccountBlvtEnableServiceAPI) Email(email string) SubAccountBlvtEnableServiceAPI {
+	return (*subAccountBlvtEnableServiceAPI)((*SubAccountBlvtEnableService)(a).Email(email))
+}
+
+func (a *subAccountBlvtEnableServiceAPI) EnableBlvt(enableBlvt bool) SubAccountBlvtEnableServiceAPI {
+	return (*subAccountBlvtEnableServiceAPI)((*SubAccountBlvtEnableService)(a).EnableBlvt(enableBlvt))
+}
+
+func (a *subAccountBlvtEnableServiceAPI) RecvWindow(recvWindow int64) SubAccountBlvtEnableServiceAPI {
+	return (*subAccountBlvtEnableServiceAPI)((*SubAccountBlvtEnableService)(a).RecvWindow(recvWindow))
+}
+
+func (a *subAccountBlvtEnableServiceAPI) Do(ctx context.Context, opts ...RequestOption) (*SubAccountBlvtEnableServiceResponse, error) {
+	return (*SubAccountBlvtEnableService)(a).Do(ctx, opts...)
+}
+
+// SubAccountApiIpRestrictionServiceAPI is the interface of SubAccountApiIpRestrictionService
+type SubAccountApiIpRestrictionServiceAPI interface {
+	Email(email string) SubAccountApiIpRestrictionServiceAPI
+	SubAccountApiKey(subAccountApiKey string) SubAccountApiIpRestrictionServiceAPI
+	RecvWindow(recvWindow int64) SubAccountApiIpRestrictionServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) (*SubAccountApiIpRestrictServiceResponse, error)
+}
+
+type subAccountApiIpRestrictionServiceAPI SubAccountApiIpRestrictionService
+
+func (a *subAccountApiIpRestrictionServiceAPI) Email(email string) SubAccountApiIpRestrictionServiceAPI {
+	return (*subAccountApiIpRestrictionServiceAPI)((*SubAccountApiIpRestrictionService)(a).Email(email))
+}
+
+func (a *subAccountApiIpRestrictionServiceAPI) SubAccountApiKey(subAccountApiKey string) SubAccountApiIpRestrictionServiceAPI {
+	return (*subAccountApiIpRestrictionServiceAPI)((*SubAccountApiIpRestrictionService)(a).SubAccountApiKey(subAccountApiKey))
+}
+
+func (a *subAccountApiIpRestrictionServiceAPI) RecvWindow(recvWindow int64) SubAccountApiIpRestrictionServiceAPI {
+	return (*subAccountApiIpRestrictionServiceAPI)((*SubAccountApiIpRestrictionService)(a).RecvWindow(recvWindow))
+}
+
+func (a *subAccountApiIpRestrictionServiceAPI) Do(ctx context.Context, opts ...RequestOption) (*SubAccountApiIpRestrictServiceResponse, error) {
+	return (*SubAccountApiIpRestrictionService)(a).Do(ctx, opts...)
+}
+
+// SubAccountApiDeleteIpRestrictionServiceAPI is the interface of SubAccountApiDeleteIpRestrictionService
+type SubAccountApiDeleteIpRestrictionServiceAPI interface {
+	Email(email string) SubAccountApiDeleteIpRestrictionServiceAPI
+	SubAccountApiKey(subAccountApiKey string) SubAccountApiDeleteIpRestrictionServiceAPI
+	IpAddress(ipAddress string) SubAccountApiDeleteIpRestrictionServiceAPI
+	RecvWindow(recvWindow int64) SubAccountApiDeleteIpRestrictionServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) (*SubAccountApiDeleteIpRestrictServiceResponse, error)
+}
+
+type subAccountApiDeleteIpRestrictionServiceAPI SubAccountApiDeleteIpRestrictionService
+
+func (a *subAccountApiDeleteIpRestrictionServiceAPI) Email(email string) SubAccountApiDeleteIpRestrictionServiceAPI {
+	return (*subAccountApiDeleteIpRestrictionServiceAPI)((*SubAccountApiDeleteIpRestrictionService)(a).Email(email))
+}
+
+func (a *subAccountApiDeleteIpRestrictionServiceAPI) SubAccountApiKey(subAccountApiKey string) SubAccountApiDeleteIpRestrictionServiceAPI {
+	return (*subAccountApiDeleteIpRestrictionServiceAPI)((*SubAccountApiDeleteIpRestrictionService)(a).SubAccountApiKey(subAccountApiKey))
+}
+
+func (a *subAccountApiDeleteIpRestrictionServiceAPI) IpAddress(ipAddress string) SubAccountApiDeleteIpRestrictionServiceAPI {
+	return (*subAccountApiDeleteIpRestrictionServiceAPI)((*SubAccountApiDeleteIpRestrictionService)(a).IpAddress(ipAddress))
+}
+
+func (a *subAccountApiDeleteIpRestrictionServiceAPI) RecvWindow(recvWindow int64) SubAccountApiDeleteIpRestrictionServiceAPI {
+	return (*subAccountApiDeleteIpRestrictionServiceAPI)((*SubAccountApiDeleteIpRestrictionService)(a).RecvWindow(recvWindow))
+}
+
+func (a *subAccountApiDeleteIpRestrictionServiceAPI) Do(ctx context.Context, opts ...RequestOption) (*SubAccountApiDeleteIpRestrictServiceResponse, error) {
+	return (*SubAccountApiDeleteIpRestrictionService)(a).Do(ctx, opts...)
+}
+
+// SubAccountApiAddIpRestrictionServiceAPI is the interface of SubAccountApiAddIpRestrictionService
+type SubAccountApiAddIpRestrictionServiceAPI interface {
+	Email(email string) SubAccountApiAddIpRestrictionServiceAPI
+	SubAccountApiKey(subAccountApiKey string) SubAccountApiAddIpRestrictionServiceAPI
+	Status(status string) SubAccountApiAddIpRestrictionServiceAPI
+	IpAddress(ipAddress string) SubAccountApiAddIpRestrictionServiceAPI
+	RecvWindow(recvWindow int64) SubAccountApiAddIpRestrictionServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) (*SubAccountApiAddIpRestrictServiceResponse, error)
+}
+
+type subAccountApiAddIpRestrictionServiceAPI SubAccountApiAddIpRestrictionService
+
+func (a *subAccountApiAddIpRestrictionServiceAPI) Email(email string) SubAccountApiAddIpRestrictionServiceAPI {
+	return (*subAccountApiAddIpRestrictionServiceAPI)((*SubAccountApiAddIpRestrictionService)(a).Email(email))
+}
+
+func (a *subAccountApiAddIpRestrictionServiceAPI) SubAccountApiKey(subAccountApiKey string) SubAccountApiAddIpRestrictionServiceAPI {
+	return (*subAccountApiAddIpRestrictionServiceAPI)((*SubAccountApiAddIpRestrictionService)(a).SubAccountApiKey(subAccountApiKey))
+}
+
+func (a *subAccountApiAddIpRestrictionServiceAPI) Status(status string) SubAccountApiAddIpRestrictionServiceAPI {
+	return (*subAccountApiAddIpRestrictionServiceAPI)((*SubAccountApiAddIpRestrictionService)(a).Status(status))
+}
+
+func (a *subAccountApiAddIpRestrictionServiceAPI) IpAddress(ipAddress string) SubAccountApiAddIpRestrictionServiceAPI {
+	return (*subAccountApiAddIpRestrictionServiceAPI)((*SubAccountApiAddIpRestrictionService)(a).IpAddress(ipAddress))
+}
+
+func (a *subAccountApiAddIpRestrictionServiceAPI) RecvWindow(recvWindow int64) SubAccountApiAddIpRestrictionServiceAPI {
+	return (*subAccountApiAddIpRestrictionServiceAPI)((*SubAccountApiAddIpRestrictionService)(a).RecvWindow(recvWindow))
+}
+
+func (a *subAccountApiAddIpRestrictionServiceAPI) Do(ctx context.Context, opts ...RequestOption) (*SubAccountApiAddIpRestrictServiceResponse, error) {
+	return (*SubAccountApiAddIpRestrictionService)(a).Do(ctx, opts...)
+}
+
+// ManagedSubAccountWithdrawServiceAPI is the interface of ManagedSubAccountWithdrawService
+type ManagedSubAccountWithdrawServiceAPI interface {
+	FromEmail(fromEmail string) ManagedSubAccountWithdrawServiceAPI
+	Asset(asset string) ManagedSubAccountWithdrawServiceAPI
+	Amount(amount string) ManagedSubAccountWithdrawServiceAPI
+	TransferDate(transferDate int64) ManagedSubAccountWithdrawServiceAPI
+	RecvWindow(recvWindow int64) ManagedSubAccountWithdrawServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) (*ManagedSubAccountWithdrawServiceResponse, error)
+}
+
+type managedSubAccountWithdrawServiceAPI ManagedSubAccountWithdrawService
+
+func (a *managedSubAccountWithdrawServiceAPI) FromEmail(fromEmail string) ManagedSubAccountWithdrawServiceAPI {
+	return (*managedSubAccountWithdrawServiceAPI)((*ManagedSubAccountWithdrawService)(a).FromEmail(fromEmail))
+}
+
+func (a *managedSubAccountWithdrawServiceAPI) Asset(asset string) ManagedSubAccountWithdrawServiceAPI {
+	return (*managedSubAccountWithdrawServiceAPI)((*ManagedSubAccountWithdrawService)(a).Asset(asset))
+}
+
+func (a *managedSubAccountWithdrawServiceAPI) Amount(amount string) ManagedSubAccountWithdrawServiceAPI {
+	return (*managedSubAccountWithdrawServiceAPI)((*ManagedSubAccountWithdrawService)(a).Amount(amount))
+}
+
+func (a *managedSubAccountWithdrawServiceAPI) TransferDate(transferDate int64) ManagedSubAccountWithdrawServiceAPI {
+	return (*managedSubAccountWithdrawServiceAPI)((*ManagedSubAccountWithdrawService)(a).TransferDate(transferDate))
+}
+
+func (a *managedSubAccountWithdrawServiceAPI) RecvWindow(recvWindow int64) ManagedSubAccountWithdrawServiceAPI {
+	return (*managedSubAccountWithdrawServiceAPI)((*ManagedSubAccountWithdrawService)(a).RecvWindow(recvWindow))
+}
+
+func (a *managedSubAccountWithdrawServiceAPI) Do(ctx context.Context, opts ...RequestOption) (*ManagedSubAccountWithdrawServiceResponse, error) {
+	return (*ManagedSubAccountWithdrawService)(a).Do(ctx, opts...)
+}
+
+// ManagedSubAccountSnapshotServiceAPI is the interface of ManagedSubAccountSnapshotService
+type ManagedSubAccountSnapshotServiceAPI interface {
+	Email(email string) ManagedSubAccountSnapshotServiceAPI
+	AccType(accType string) ManagedSubAccountSnapshotServiceAPI
+	StartTime(startTime int64) ManagedSubAccountSnapshotServiceAPI
+	EndTime(endTime int64) ManagedSubAccountSnapshotServiceAPI
+	Limit(limit int32) ManagedSubAccountSnapshotServiceAPI
+	RecvWindow(recvWindow int64) ManagedSubAccountSnapshotServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) (*ManagedSubAccountSnapshotServiceResponse, error)
+}
+
+type managedSubAccountSnapshotServiceAPI ManagedSubAccountSnapshotService
+
+func (a *managedSubAccountSnapshotServiceAPI) Email(email string) ManagedSubAccountSnapshotServiceAPI {
+	return (*managedSubAccountSnapshotServiceAPI)((*ManagedSubAccountSnapshotService)(a).Email(email))
+}
+
+func (a *managedSubAccountSnapshotServiceAPI) AccType(accType string) ManagedSubAccountSnapshotServiceAPI {
+	return (*managedSubAccountSnapshotServiceAPI)((*ManagedSubAccountSnapshotService)(a).AccType(accType))
+}
+
+func (a *managedSubAccountSnapshotServiceAPI) StartTime(startTime int64) ManagedSubAccountSnapshotServiceAPI {
+	return (*managedSubAccountSnapshotServiceAPI)((*ManagedSubAccountSnapshotService)(a).StartTime(startTime))
+}
+
+func (a *managedSubAccountSnapshotServiceAPI) EndTime(endTime int64) ManagedSubAccountSnapshotServiceAPI {
+	return (*managedSubAccountSnapshotServiceAPI)((*ManagedSubAccountSnapshotService)(a).EndTime(endTime))
+}
+
+func (a *managedSubAccountSnapshotServiceAPI) Limit(limit int32) ManagedSubAccountSnapshotServiceAPI {
+	return (*managedSubAccountSnapshotServiceAPI)((*ManagedSubAccountSnapshotService)(a).Limit(limit))
+}
+
+func (a *managedSubAccountSnapshotServiceAPI) RecvWindow(recvWindow int64) ManagedSubAccountSnapshotServiceAPI {
+	return (*managedSubAccountSnapshotServiceAPI)((*ManagedSubAccountSnapshotService)(a).RecvWindow(recvWindow))
+}
+
+func (a *managedSubAccountSnapshotServiceAPI) Do(ctx context.Context, opts ...RequestOption) (*ManagedSubAccountSnapshotServiceResponse, error) {
+	return (*ManagedSubAccountSnapshotService)(a).Do(ctx, opts...)
+}
+
+// ManagedSubAccountQueryTransferLogForInvestorServiceAPI is the interface of ManagedSubAccountQueryTransferLogForInvestorService
+type ManagedSubAccountQueryTransferLogForInvestorServiceAPI interface {
+	Email(email string) ManagedSubAccountQueryTransferLogForInvestorServiceAPI
+	StartTime(startTime int64) ManagedSubAccountQueryTransferLogForInvestorServiceAPI
+	EndTime(endTime int64) ManagedSubAccountQueryTransferLogForInvestorServiceAPI
+	Page(page int32) ManagedSubAccountQueryTransferLogForInvestorServiceAPI
+	Limit(limit int32) ManagedSubAccountQueryTransferLogForInvestorServiceAPI
+	Transfers(transfers string) ManagedSubAccountQueryTransferLogForInvestorServiceAPI
+	TransferFunctionAccountType(transferFunctionAccountType string) ManagedSubAccountQueryTransferLogForInvestorServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) (*ManagedSubAccountQueryTransferLogForInvestorServiceResponse, error)
+}
+
+type managedSubAccountQueryTransferLogForInvestorServiceAPI ManagedSubAccountQueryTransferLogForInvestorService
+
+func (a *managedSubAccountQueryTransferLogForInvestorServiceAPI) Email(email string) ManagedSubAccountQueryTransferLogForInvestorServiceAPI {
+	return (*managedSubAccountQueryTransferLogForInvestorServiceAPI)((*ManagedSubAccountQueryTransferLogForInvestorService)(a).Email(email))
+}
+
+func (a *managedSubAccountQueryTransferLogForInvestorServiceAPI) StartTime(startTime int64) ManagedSubAccountQueryTransferLogForInvestorServiceAPI {
+	return (*managedSubAccountQueryTransferLogForInvestorServiceAPI)((*ManagedSubAccountQueryTransferLogForInvestorService)(a).StartTime(startTime))
+}
+
+func (a *managedSubAccountQueryTransferLogForInvestorServiceAPI) EndTime(endTime int64) ManagedSubAccountQueryTransferLogForInvestorServiceAPI {
+	return (*managedSubAccountQueryTransferLogForInvestorServiceAPI)((*ManagedSubAccountQueryTransferLogForInvestorService)(a).EndTime(endTime))
+}
+
+func (a *managedSubAccountQueryTransferLogForInvestorServiceAPI) Page(page int32) ManagedSubAccountQueryTransferLogForInvestorServiceAPI {
+	return (*managedSubAccountQueryTransferLogForInvestorServiceAPI)((*ManagedSubAccountQueryTransferLogForInvestorService)(a).Page(page))
+}
+
+func (a *managedSubAccountQueryTransferLogForInvestorServiceAPI) Limit(limit int32) ManagedSubAccountQueryTransferLogForInvestorServiceAPI {
+	return (*managedSubAccountQueryTransferLogForInvestorServiceAPI)((*ManagedSubAccountQueryTransferLogForInvestorService)(a).Limit(limit))
+}
+
+func (a *managedSubAccountQueryTransferLogForInvestorServiceAPI) Transfers(transfers string) ManagedSubAccountQueryTransferLogForInvestorServiceAPI {
+	return (*managedSubAccountQueryTransferLogForInvestorServiceAPI)((*ManagedSubAccountQueryTransferLogForInvestorService)(a).Transfers(transfers))
+}
+
+func (a *managedSubAccountQueryTransferLogForInvestorServiceAPI) TransferFunctionAccountType(transferFunctionAccountType string) ManagedSubAccountQueryTransferLogForInvestorServiceAPI {
+	return (*managedSubAccountQueryTransferLogForInvestorServiceAPI)((*ManagedSubAccountQueryTransferLogForInvestorService)(a).TransferFunctionAccountType(transferFunctionAccountType))
+}
+
+func (a *managedSubAccountQueryTransferLogForInvestorServiceAPI) Do(ctx context.Context, opts ...RequestOption) (*ManagedSubAccountQueryTransferLogForInvestorServiceResponse, error) {
+	return (*ManagedSubAccountQueryTransferLogForInvestorService)(a).Do(ctx, opts...)
+}
+
+// ManagedSubAccountQueryTransferLogForTradeParentServiceAPI is the interface of ManagedSubAccountQueryTransferLogForTradeParentService
+type ManagedSubAccountQueryTransferLogForTradeParentServiceAPI interface {
+	Email(email string) ManagedSubAccountQueryTransferLogForTradeParentServiceAPI
+	StartTime(startTime int64) ManagedSubAccountQueryTransferLogForTradeParentServiceAPI
+	EndTime(endTime int64) ManagedSubAccountQueryTransferLogForTradeParentServiceAPI
+	Page(page int32) ManagedSubAccountQueryTransferLogForTradeParentServiceAPI
+	Limit(limit int32) ManagedSubAccountQueryTransferLogForTradeParentServiceAPI
+	Transfers(transfers string) ManagedSubAccountQueryTransferLogForTradeParentServiceAPI
+	TransferFunctionAccountType(transferFunctionAccountType string) ManagedSubAccountQueryTransferLogForTradeParentServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) (*ManagedSubAccountQueryTransferLogForTradeParentServiceResponse, error)
+}
+
+type managedSubAccountQueryTransferLogForTradeParentServiceAPI ManagedSubAccountQueryTransferLogForTradeParentService
+
+func (a *managedSubAccountQueryTransferLogForTradeParentServiceAPI) Email(email string) ManagedSubAccountQueryTransferLogForTradeParentServiceAPI {
+	return (*managedSubAccountQueryTransferLogForTradeParentServiceAPI)((*ManagedSubAccountQueryTransferLogForTradeParentService)(a).Email(email))
+}
+
+func (a *managedSubAccountQueryTransferLogForTradeParentServiceAPI) StartTime(startTime int64) ManagedSubAccountQueryTransferLogForTradeParentServiceAPI {
+	return (*managedSubAccountQueryTransferLogForTradeParentServiceAPI)((*ManagedSubAccountQueryTransferLogForTradeParentService)(a).StartTime(startTime))
+}
+
+func (a *managedSubAccountQueryTransferLogForTradeParentServiceAPI) EndTime(endTime int64) ManagedSubAccountQueryTransferLogForTradeParentServiceAPI {
+	return (*managedSubAccountQueryTransferLogForTradeParentServiceAPI)((*ManagedSubAccountQueryTransferLogForTradeParentService)(a).EndTime(endTime))
+}
+
+func (a *managedSubAccountQueryTransferLogForTradeParentServiceAPI) Page(page int32) ManagedSubAccountQueryTransferLogForTradeParentServiceAPI {
+	return (*managedSubAccountQueryTransferLogForTradeParentServiceAPI)((*ManagedSubAccountQueryTransferLogForTradeParentService)(a).Page(page))
+}
+
+func (a *managedSubAccountQueryTransferLogForTradeParentServiceAPI) Limit(limit int32) ManagedSubAccountQueryTransferLogForTradeParentServiceAPI {
+	return (*managedSubAccountQueryTransferLogForTradeParentServiceAPI)((*ManagedSubAccountQueryTransferLogForTradeParentService)(a).Limit(limit))
+}
+
+func (a *managedSubAccountQueryTransferLogForTradeParentServiceAPI) Transfers(transfers string) ManagedSubAccountQueryTransferLogForTradeParentServiceAPI {
+	return (*managedSubAccountQueryTransferLogForTradeParentServiceAPI)((*ManagedSubAccountQueryTransferLogForTradeParentService)(a).Transfers(transfers))
+}
+
+func (a *managedSubAccountQueryTransferLogForTradeParentServiceAPI) TransferFunctionAccountType(transferFunctionAccountType string) ManagedSubAccountQueryTransferLogForTradeParentServiceAPI {
+	return (*managedSubAccountQueryTransferLogForTradeParentServiceAPI)((*ManagedSubAccountQueryTransferLogForTradeParentService)(a).TransferFunctionAccountType(transferFunctionAccountType))
+}
+
+func (a *managedSubAccountQueryTransferLogForTradeParentServiceAPI) Do(ctx context.Context, opts ...RequestOption) (*ManagedSubAccountQueryTransferLogForTradeParentServiceResponse, error) {
+	return (*ManagedSubAccountQueryTransferLogForTradeParentService)(a).Do(ctx, opts...)
+}
+
+// ManagedSubAccountQueryFuturesAssetServiceAPI is the interface of ManagedSubAccountQueryFuturesAssetService
+type ManagedSubAccountQueryFuturesAssetServiceAPI interface {
+	Email(email string) ManagedSubAccountQueryFuturesAssetServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) (*ManagedSubAccountQueryFuturesAssetServiceResponse, error)
+}
+
+type managedSubAccountQueryFuturesAssetServiceAPI ManagedSubAccountQueryFuturesAssetService
+
+func (a *managedSubAccountQueryFuturesAssetServiceAPI) Email(email string) ManagedSubAccountQueryFuturesAssetServiceAPI {
+	return (*managedSubAccountQueryFuturesAssetServiceAPI)((*ManagedSubAccountQueryFuturesAssetService)(a).Email(email))
+}
+
+func (a *managedSubAccountQueryFuturesAssetServiceAPI) Do(ctx context.Context, opts ...RequestOption) (*ManagedSubAccountQueryFuturesAssetServiceResponse, error) {
+	return (*ManagedSubAccountQueryFuturesAssetService)(a).Do(ctx, opts...)
+}
+
+// ManagedSubAccountQueryMarginAssetServiceAPI is the interface of ManagedSubAccountQueryMarginAssetService
+type ManagedSubAccountQueryMarginAssetServiceAPI interface {
+	Email(email string) ManagedSubAccountQueryMarginAssetServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) (*ManagedSubAccountQueryMarginAssetServiceResponse, error)
+}
+
+type managedSubAccountQueryMarginAssetServiceAPI ManagedSubAccountQueryMarginAssetService
+
+func (a *managedSubAccountQueryMarginAssetServiceAPI) Email(email string) ManagedSubAccountQueryMarginAssetServiceAPI {
+	return (*managedSubAccountQueryMarginAssetServiceAPI)((*ManagedSubAccountQueryMarginAssetService)(a).Email(email))
+}
+
+func (a *managedSubAccountQueryMarginAssetServiceAPI) Do(ctx context.Context, opts ...RequestOption) (*ManagedSubAccountQueryMarginAssetServiceResponse, error) {
+	return (*ManagedSubAccountQueryMarginAssetService)(a).Do(ctx, opts...)
+}
+
+// SubAccountAssetServiceAPI is the interface of SubAccountAssetService
+type SubAccountAssetServiceAPI interface {
+	Email(email string) SubAccountAssetServiceAPI
+	RecvWindow(recvWindow int64) SubAccountAssetServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) (*SubAccountAssetServiceResponse, error)
+}
+
+type subAccountAssetServiceAPI SubAccountAssetService
+
+func (a *subAccountAssetServiceAPI) Email(email string) SubAccountAssetServiceAPI {
+	return (*subAccountAssetServiceAPI)((*SubAccountAssetService)(a).Email(email))
+}
+
+func (a *subAccountAssetServiceAPI) RecvWindow(recvWindow int64) SubAccountAssetServiceAPI {
+	return (*subAccountAssetServiceAPI)((*SubAccountAssetService)(a).RecvWindow(recvWindow))
+}
+
+func (a *subAccountAssetServiceAPI) Do(ctx context.Context, opts ...RequestOption) (*SubAccountAssetServiceResponse, error) {
+	return (*SubAccountAssetService)(a).Do(ctx, opts...)
+}
+
+// ManagedSubAccountInfoServiceAPI is the interface of ManagedSubAccountInfoService
+type ManagedSubAccountInfoServiceAPI interface {
+	Email(email string) ManagedSubAccountInfoServiceAPI
+	Page(page int32) ManagedSubAccountInfoServiceAPI
+	Limit(limit int32) ManagedSubAccountInfoServiceAPI
+	RecvWindow(recvWindow int64) ManagedSubAccountInfoServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) (*ManagedSubAccountInfoServiceResponse, error)
+}
+
+type managedSubAccountInfoServiceAPI ManagedSubAccountInfoService
+
+func (a *managedSubAccountInfoServiceAPI) Email(email string) ManagedSubAccountInfoServiceAPI {
+	return (*managedSubAccountInfoServiceAPI)((*ManagedSubAccountInfoService)(a).Email(email))
+}
+
+func (a *managedSubAccountInfoServiceAPI) Page(page int32) ManagedSubAccountInfoServiceAPI {
+	return (*managedSubAccountInfoServiceAPI)((*ManagedSubAccountInfoService)(a).Page(page))
+}
+
+func (a *managedSubAccountInfoServiceAPI) Limit(limit int32) ManagedSubAccountInfoServiceAPI {
+	return (*managedSubAccountInfoServiceAPI)((*ManagedSubAccountInfoService)(a).Limit(limit))
+}
+
+func (a *managedSubAccountInfoServiceAPI) RecvWindow(recvWindow int64) ManagedSubAccountInfoServiceAPI {
+	return (*managedSubAccountInfoServiceAPI)((*ManagedSubAccountInfoService)(a).RecvWindow(recvWindow))
+}
+
+func (a *managedSubAccountInfoServiceAPI) Do(ctx context.Context, opts ...RequestOption) (*ManagedSubAccountInfoServiceResponse, error) {
+	return (*ManagedSubAccountInfoService)(a).Do(ctx, opts...)
+}
+
+// ManagedSubAccountDepositAddressServiceAPI is the interface of ManagedSubAccountDepositAddressService
+type ManagedSubAccountDepositAddressServiceAPI interface {
+	Email(email string) ManagedSubAccountDepositAddressServiceAPI
+	Coin(coin string) ManagedSubAccountDepositAddressServiceAPI
+	Network(network string) ManagedSubAccountDepositAddressServiceAPI
+	RecvWindow(recvWindow int64) ManagedSubAccountDepositAddressServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) (*ManagedSubAccountDepositAddressServiceResponse, error)
+}
+
+type managedSubAccountDepositAddressServiceAPI ManagedSubAccountDepositAddressService
+
+func (a *managedSubAccountDepositAddressServiceAPI) Email(email string) ManagedSubAccountDepositAddressServiceAPI {
+	return (*managedSubAccountDepositAddressServiceAPI)((*ManagedSubAccountDepositAddressService)(a).Email(email))
+}
+
+func (a *managedSubAccountDepositAddressServiceAPI) Coin(coin string) ManagedSubAccountDepositAddressServiceAPI {
+	return (*managedSubAccountDepositAddressServiceAPI)((*ManagedSubAccountDepositAddressService)(a).Coin(coin))
+}
+
+func (a *managedSubAccountDepositAddressServiceAPI) Network(network string) ManagedSubAccountDepositAddressServiceAPI {
+	return (*managedSubAccountDepositAddressServiceAPI)((*ManagedSubAccountDepositAddressService)(a).Network(network))
+}
+
+func (a *managedSubAccountDepositAddressServiceAPI) RecvWindow(recvWindow int64) ManagedSubAccountDepositAddressServiceAPI {
+	return (*managedSubAccountDepositAddressServiceAPI)((*ManagedSubAccountDepositAddressService)(a).RecvWindow(recvWindow))
+}
+
+func (a *managedSubAccountDepositAddressServiceAPI) Do(ctx context.Context, opts ...RequestOption) (*ManagedSubAccountDepositAddressServiceResponse, error) {
+	return (*ManagedSubAccountDepositAddressService)(a).Do(ctx, opts...)
+}
+
+// SubAccountOptionsEnableServiceAPI is the interface of SubAccountOptionsEnableService
+type SubAccountOptionsEnableServiceAPI interface {
+	Email(email string) SubAccountOptionsEnableServiceAPI
+	RecvWindow(recvWindow int64) SubAccountOptionsEnableServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) (*SubAccountOptionsEnableServiceResponse, error)
+}
+
+type subAccountOptionsEnableServiceAPI SubAccountOptionsEnableService
+
+func (a *subAccountOptionsEnableServiceAPI) Email(email string) SubAccountOptionsEnableServiceAPI {
+	return (*subAccountOptionsEnableServiceAPI)((*SubAccountOptionsEnableService)(a).Email(email))
+}
+
+func (a *subAccountOptionsEnableServiceAPI) RecvWindow(recvWindow int64) SubAccountOptionsEnableServiceAPI {
+	return (*subAccountOptionsEnableServiceAPI)((*SubAccountOptionsEnableService)(a).RecvWindow(recvWindow))
+}
+
+func (a *subAccountOptionsEnableServiceAPI) Do(ctx context.Context, opts ...RequestOption) (*SubAccountOptionsEnableServiceResponse, error) {
+	return (*SubAccountOptionsEnableService)(a).Do(ctx, opts...)
+}
+
+// ManagedSubAccountQueryTransferLogServiceAPI is the interface of ManagedSubAccountQueryTransferLogService
+type ManagedSubAccountQueryTransferLogServiceAPI interface {
+	StartTime(startTime int64) ManagedSubAccountQueryTransferLogServiceAPI
+	EndTime(endTime int64) ManagedSubAccountQueryTransferLogServiceAPI
+	Page(page int32) ManagedSubAccountQueryTransferLogServiceAPI
+	Limit(limit int32) ManagedSubAccountQueryTransferLogServiceAPI
+	Transfers(transfers string) ManagedSubAccountQueryTransferLogServiceAPI
+	TransferFunctionAccountType(transferFunctionAccountType string) ManagedSubAccountQueryTransferLogServiceAPI
+	RecvWindow(recvWindow int64) ManagedSubAccountQueryTransferLogServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) (*ManagedSubAccountQueryTransferLogServiceResponse, error)
+}
+
+type managedSubAccountQueryTransferLogServiceAPI ManagedSubAccountQueryTransferLogService
+
+func (a *managedSubAccountQueryTransferLogServiceAPI) StartTime(startTime int64) ManagedSubAccountQueryTransferLogServiceAPI {
+	return (*managedSubAccountQueryTransferLogServiceAPI)((*ManagedSubAccountQueryTransferLogService)(a).StartTime(startTime))
+}
+
+func (a *managedSubAccountQueryTransferLogServiceAPI) EndTime(endTime int64) ManagedSubAccountQueryTransferLogServiceAPI {
+	return (*managedSubAccountQueryTransferLogServiceAPI)((*ManagedSubAccountQueryTransferLogService)(a).EndTime(endTime))
+}
+
+func (a *managedSubAccountQueryTransferLogServiceAPI) Page(page int32) ManagedSubAccountQueryTransferLogServiceAPI {
+	return (*managedSubAccountQueryTransferLogServiceAPI)((*ManagedSubAccountQueryTransferLogService)(a).Page(page))
+}
+
+func (a *managedSubAccountQueryTransferLogServiceAPI) Limit(limit int32) ManagedSubAccountQueryTransferLogServiceAPI {
+	return (*managedSubAccountQueryTransferLogServiceAPI)((*ManagedSubAccountQueryTransferLogService)(a).Limit(limit))
+}
+
+func (a *managedSubAccountQueryTransferLogServiceAPI) Transfers(transfers string) ManagedSubAccountQueryTransferLogServiceAPI {
+	return (*managedSubAccountQueryTransferLogServiceAPI)((*ManagedSubAccountQueryTransferLogService)(a).Transfers(transfers))
+}
+
+func (a *managedSubAccountQueryTransferLogServiceAPI) TransferFunctionAccountType(transferFunctionAccountType string) ManagedSubAccountQueryTransferLogServiceAPI {
+	return (*managedSubAccountQueryTransferLogServiceAPI)((*ManagedSubAccountQueryTransferLogService)(a).TransferFunctionAccountType(transferFunctionAccountType))
+}
+
+func (a *managedSubAccountQueryTransferLogServiceAPI) RecvWindow(recvWindow int64) ManagedSubAccountQueryTransferLogServiceAPI {
+	return (*managedSubAccountQueryTransferLogServiceAPI)((*ManagedSubAccountQueryTransferLogService)(a).RecvWindow(recvWindow))
+}
+
+func (a *managedSubAccountQueryTransferLogServiceAPI) Do(ctx context.Context, opts ...RequestOption) (*ManagedSubAccountQueryTransferLogServiceResponse, error) {
+	return (*ManagedSubAccountQueryTransferLogService)(a).Do(ctx, opts...)
+}
+
+// SubAccountFuturesInternalTransferServiceAPI is the interface of SubAccountFuturesInternalTransferService
+type SubAccountFuturesInternalTransferServiceAPI interface {
+	FromEmail(fromEmail string) SubAccountFuturesInternalTransferServiceAPI
+	ToEmail(toEmail string) SubAccountFuturesInternalTransferServiceAPI
+	FuturesType(futuresType int64) SubAccountFuturesInternalTransferServiceAPI
+	Asset(asset string) SubAccountFuturesInternalTransferServiceAPI
+	Amount(amount string) SubAccountFuturesInternalTransferServiceAPI
+	RecvWindow(recvWindow int64) SubAccountFuturesInternalTransferServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) (*SubAccountFuturesInternalTransferResponse, error)
+}
+
+type subAccountFuturesInternalTransferServiceAPI SubAccountFuturesInternalTransferService
+
+func (a *subAccountFuturesInternalTransferServiceAPI) FromEmail(fromEmail string) SubAccountFuturesInternalTransferServiceAPI {
+	return (*subAccountFuturesInternalTransferServiceAPI)((*SubAccountFuturesInternalTransferService)(a).FromEmail(fromEmail))
+}
+
+func (a *subAccountFuturesInternalTransferServiceAPI) ToEmail(toEmail string) SubAccountFuturesInternalTransferServiceAPI {
+	return (*subAccountFuturesInternalTransferServiceAPI)((*SubAccountFuturesInternalTransferService)(a).ToEmail(toEmail))
+}
+
+func (a *subAccountFuturesInternalTransferServiceAPI) FuturesType(futuresType int64) SubAccountFuturesInternalTransferServiceAPI {
+	return (*subAccountFuturesInternalTransferServiceAPI)((*SubAccountFuturesInternalTransferService)(a).FuturesType(futuresType))
+}
+
+func (a *subAccountFuturesInternalTransferServiceAPI) Asset(asset string) SubAccountFuturesInternalTransferServiceAPI {
+	return (*subAccountFuturesInternalTransferServiceAPI)((*SubAccountFuturesInternalTransferService)(a).Asset(asset))
+}
+
+func (a *subAccountFuturesInternalTransferServiceAPI) Amount(amount string) SubAccountFuturesInternalTransferServiceAPI {
+	return (*subAccountFuturesInternalTransferServiceAPI)((*SubAccountFuturesInternalTransferService)(a).Amount(amount))
+}
+
+func (a *subAccountFuturesInternalTransferServiceAPI) RecvWindow(recvWindow int64) SubAccountFuturesInternalTransferServiceAPI {
+	return (*subAccountFuturesInternalTransferServiceAPI)((*SubAccountFuturesInternalTransferService)(a).RecvWindow(recvWindow))
+}
+
+func (a *subAccountFuturesInternalTransferServiceAPI) Do(ctx context.Context, opts ...RequestOption) (*SubAccountFuturesInternalTransferResponse, error) {
+	return (*SubAccountFuturesInternalTransferService)(a).Do(ctx, opts...)
+}
+
+// SubAccountTransactionStatisticsServiceAPI is the interface of SubAccountTransactionStatisticsService
+type SubAccountTransactionStatisticsServiceAPI interface {
+	Email(email string) SubAccountTransactionStatisticsServiceAPI
+	RecvWindow(recvWindow int64) SubAccountTransactionStatisticsServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) (*SubAccountTransactionStatisticServiceResponse, error)
+}
+
+type subAccountTransactionStatisticsServiceAPI SubAccountTransactionStatisticsService
+
+func (a *subAccountTransactionStatisticsServiceAPI) Email(email string) SubAccountTransactionStatisticsServiceAPI {
+	return (*subAccountTransactionStatisticsServiceAPI)((*SubAccountTransactionStatisticsService)(a).Email(email))
+}
+
+func (a *subAccountTransactionStatisticsServiceAPI) RecvWindow(recvWindow int64) SubAccountTransactionStatisticsServiceAPI {
+	return (*subAccountTransactionStatisticsServiceAPI)((*SubAccountTransactionStatisticsService)(a).RecvWindow(recvWindow))
+}
+
+func (a *subAccountTransactionStatisticsServiceAPI) Do(ctx context.Context, opts ...RequestOption) (*SubAccountTransactionStatisticServiceResponse, error) {
+	return (*SubAccountTransactionStatisticsService)(a).Do(ctx, opts...)
+}
+
+// SubAccountFuturesAccountV2ServiceAPI is the interface of SubAccountFuturesAccountV2Service
+type SubAccountFuturesAccountV2ServiceAPI interface {
+	Email(email string) SubAccountFuturesAccountV2ServiceAPI
+	FuturesType(futuresType int32) SubAccountFuturesAccountV2ServiceAPI
+	RecvWindow(recvWindow int64) SubAccountFuturesAccountV2ServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) (*SubAccountFuturesAccountV2ServiceResponse, error)
+}
+
+type subAccountFuturesAccountV2ServiceAPI SubAccountFuturesAccountV2Service
+
+func (a *subAccountFuturesAccountV2ServiceAPI) Email(email string) SubAccountFuturesAccountV2ServiceAPI {
+	return (*subAccountFuturesAccountV2ServiceAPI)((*SubAccountFuturesAccountV2Service)(a).Email(email))
+}
+
+func (a *subAccountFuturesAccountV2ServiceAPI) FuturesType(futuresType int32) SubAccountFuturesAccountV2ServiceAPI {
+	return (*subAccountFuturesAccountV2ServiceAPI)((*SubAccountFuturesAccountV2Service)(a).FuturesType(futuresType))
+}
+
+func (a *subAccountFuturesAccountV2ServiceAPI) RecvWindow(recvWindow int64) SubAccountFuturesAccountV2ServiceAPI {
+	return (*subAccountFuturesAccountV2ServiceAPI)((*SubAccountFuturesAccountV2Service)(a).RecvWindow(recvWindow))
+}
+
+func (a *subAccountFuturesAccountV2ServiceAPI) Do(ctx context.Context, opts ...RequestOption) (*SubAccountFuturesAccountV2ServiceResponse, error) {
+	return (*SubAccountFuturesAccountV2Service)(a).Do(ctx, opts...)
+}
+
+// FuturesOrderBookHistoryServiceAPI is the interface of FuturesOrderBookHistoryService
+type FuturesOrderBookHistoryServiceAPI interface {
+	Symbol(symbol string) FuturesOrderBookHistoryServiceAPI
+	DataType(dataType FuturesOrderBookHistoryDataType) FuturesOrderBookHistoryServiceAPI
+	StartTime(startTime int64) FuturesOrderBookHistoryServiceAPI
+	EndTime(endTime int64) FuturesOrderBookHistoryServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) (*FuturesOrderBookHistory, error)
+}
+
+type futuresOrderBookHistoryServiceAPI FuturesOrderBookHistoryService
+
+func (a *futuresOrderBookHistoryServiceAPI) Symbol(symbol string) FuturesOrderBookHistoryServiceAPI {
+	return (*futuresOrderBookHistoryServiceAPI)((*FuturesOrderBookHistoryService)(a).Symbol(symbol))
+}
+
+func (a *futuresOrderBookHistoryServiceAPI) DataType(dataType FuturesOrderBookHistoryDataType) FuturesOrderBookHistoryServiceAPI {
+	return (*futuresOrderBookHistoryServiceAPI)((*FuturesOrderBookHistoryService)(a).DataType(dataType))
+}
+
+func (a *futuresOrderBookHistoryServiceAPI) StartTime(startTime int64) FuturesOrderBookHistoryServiceAPI {
+	return (*futuresOrderBookHistoryServiceAPI)((*FuturesOrderBookHistoryService)(a).StartTime(startTime))
+}
+
+func (a *futuresOrderBookHistoryServiceAPI) EndTime(endTime int64) FuturesOrderBookHistoryServiceAPI {
+	return (*futuresOrderBookHistoryServiceAPI)((*FuturesOrderBookHistoryService)(a).EndTime(endTime))
+}
+
+func (a *futuresOrderBookHistoryServiceAPI) Do(ctx context.Context, opts ...RequestOption) (*FuturesOrderBookHistory, error) {
+	return (*FuturesOrderBookHistoryService)(a).Do(ctx, opts...)
+}
+
+// CreateFuturesAlgoVpOrderServiceAPI is the interface of CreateFuturesAlgoVpOrderService
+type CreateFuturesAlgoVpOrderServiceAPI interface {
+	Symbol(symbol string) CreateFuturesAlgoVpOrderServiceAPI
+	Side(side SideType) CreateFuturesAlgoVpOrderServiceAPI
+	PositionSide(positionSide futures.PositionSideType) CreateFuturesAlgoVpOrderServiceAPI
+	Quantity(quantity float64) CreateFuturesAlgoVpOrderServiceAPI
+	Urgency(urgency FuturesAlgoUrgencyType) CreateFuturesAlgoVpOrderServiceAPI
+	ClientAlgoId(clientAlgoId string) CreateFuturesAlgoVpOrderServiceAPI
+	ReduceOnly(reduceOnly bool) CreateFuturesAlgoVpOrderServiceAPI
+	LimitPrice(limitPrice float64) CreateFuturesAlgoVpOrderServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) (*CreateFuturesAlgoOrderResponse, error)
+}
+
+type createFuturesAlgoVpOrderServiceAPI CreateFuturesAlgoVpOrderService
+
+func (a *createFuturesAlgoVpOrderServiceAPI) Symbol(symbol string) CreateFuturesAlgoVpOrderServiceAPI {
+	return (*createFuturesAlgoVpOrderServiceAPI)((*CreateFuturesAlgoVpOrderService)(a).Symbol(symbol))
+}
+
+func (a *createFuturesAlgoVpOrderServiceAPI) Side(side SideType) CreateFuturesAlgoVpOrderServiceAPI {
+	return (*createFuturesAlgoVpOrderServiceAPI)((*CreateFuturesAlgoVpOrderService)(a).Side(side))
+}
+
+func (a *createFuturesAlgoVpOrderServiceAPI) PositionSide(positionSide futures.PositionSideType) CreateFuturesAlgoVpOrderServiceAPI {
+	return (*createFuturesAlgoVpOrderServiceAPI)((*CreateFuturesAlgoVpOrderService)(a).PositionSide(positionSide))
+}
+
+func (a *createFuturesAlgoVpOrderServiceAPI) Quantity(quantity float64) CreateFuturesAlgoVpOrderServiceAPI {
+	return (*createFuturesAlgoVpOrderServiceAPI)((*CreateFuturesAlgoVpOrderService)(a).Quantity(quantity))
+}
+
+func (a *createFuturesAlgoVpOrderServiceAPI) Urgency(urgency FuturesAlgoUrgencyType) CreateFuturesAlgoVpOrderServiceAPI {
+	return (*createFuturesAlgoVpOrderServiceAPI)((*CreateFuturesAlgoVpOrderService)(a).Urgency(urgency))
+}
+
+func (a *createFuturesAlgoVpOrderServiceAPI) ClientAlgoId(clientAlgoId string) CreateFuturesAlgoVpOrderServiceAPI {
+	return (*createFuturesAlgoVpOrderServiceAPI)((*CreateFuturesAlgoVpOrderService)(a).ClientAlgoId(clientAlgoId))
+}
+
+func (a *createFuturesAlgoVpOrderServiceAPI) ReduceOnly(reduceOnly bool) CreateFuturesAlgoVpOrderServiceAPI {
+	return (*createFuturesAlgoVpOrderServiceAPI)((*CreateFuturesAlgoVpOrderService)(a).ReduceOnly(reduceOnly))
+}
+
+func (a *createFuturesAlgoVpOrderServiceAPI) LimitPrice(limitPrice float64) CreateFuturesAlgoVpOrderServiceAPI {
+	return (*createFuturesAlgoVpOrderServiceAPI)((*CreateFuturesAlgoVpOrderService)(a).LimitPrice(limitPrice))
+}
+
+func (a *createFuturesAlgoVpOrderServiceAPI) Do(ctx context.Context, opts ...RequestOption) (*CreateFuturesAlgoOrderResponse, error) {
+	return (*CreateFuturesAlgoVpOrderService)(a).Do(ctx, opts...)
+}
+
+// CreateFuturesAlgoTwapOrderServiceAPI is the interface of CreateFuturesAlgoTwapOrderService
+type CreateFuturesAlgoTwapOrderServiceAPI interface {
+	Symbol(symbol string) CreateFuturesAlgoTwapOrderServiceAPI
+	Side(side SideType) CreateFuturesAlgoTwapOrderServiceAPI
+	PositionSide(positionSide futures.PositionSideType) CreateFuturesAlgoTwapOrderServiceAPI
+	Quantity(quantity float64) CreateFuturesAlgoTwapOrderServiceAPI
+	Duration(duration int64) CreateFuturesAlgoTwapOrderServiceAPI
+	ClientAlgoId(clientAlgoId string) CreateFuturesAlgoTwapOrderServiceAPI
+	ReduceOnly(reduceOnly bool) CreateFuturesAlgoTwapOrderServiceAPI
+	LimitPrice(limitPrice float64) CreateFuturesAlgoTwapOrderServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) (*CreateFuturesAlgoOrderResponse, error)
+}
+
+type createFuturesAlgoTwapOrderServiceAPI CreateFuturesAlgoTwapOrderService
+
+func (a *createFuturesAlgoTwapOrderServiceAPI) Symbol(symbol string) CreateFuturesAlgoTwapOrderServiceAPI {
+	return (*createFuturesAlgoTwapOrderServiceAPI)((*CreateFuturesAlgoTwapOrderService)(a).Symbol(symbol))
+}
+
+func (a *createFuturesAlgoTwapOrderServiceAPI) Side(side SideType) CreateFuturesAlgoTwapOrderServiceAPI {
+	return (*createFuturesAlgoTwapOrderServiceAPI)((*CreateFuturesAlgoTwapOrderService)(a).Side(side))
+}
+
+func (a *createFuturesAlgoTwapOrderServiceAPI) PositionSide(positionSide futures.PositionSideType) CreateFuturesAlgoTwapOrderServiceAPI {
+	return (*createFuturesAlgoTwapOrderServiceAPI)((*CreateFuturesAlgoTwapOrderService)(a).PositionSide(positionSide))
+}
+
+func (a *createFuturesAlgoTwapOrderServiceAPI) Quantity(quantity float64) CreateFuturesAlgoTwapOrderServiceAPI {
+	return (*createFuturesAlgoTwapOrderServiceAPI)((*CreateFuturesAlgoTwapOrderService)(a).Quantity(quantity))
+}
+
+func (a *createFuturesAlgoTwapOrderServiceAPI) Duration(duration int64) CreateFuturesAlgoTwapOrderServiceAPI {
+	return (*createFuturesAlgoTwapOrderServiceAPI)((*CreateFuturesAlgoTwapOrderService)(a).Duration(duration))
+}
+
+func (a *createFuturesAlgoTwapOrderServiceAPI) ClientAlgoId(clientAlgoId string) CreateFuturesAlgoTwapOrderServiceAPI {
+	return (*createFuturesAlgoTwapOrderServiceAPI)((*CreateFuturesAlgoTwapOrderService)(a).ClientAlgoId(clientAlgoId))
+}
+
+func (a *createFuturesAlgoTwapOrderServiceAPI) ReduceOnly(reduceOnly bool) CreateFuturesAlgoTwapOrderServiceAPI {
+	return (*createFuturesAlgoTwapOrderServiceAPI)((*CreateFuturesAlgoTwapOrderService)(a).ReduceOnly(reduceOnly))
+}
+
+func (a *createFuturesAlgoTwapOrderServiceAPI) LimitPrice(limitPrice float64) CreateFuturesAlgoTwapOrderServiceAPI {
+	return (*createFuturesAlgoTwapOrderServiceAPI)((*CreateFuturesAlgoTwapOrderService)(a).LimitPrice(limitPrice))
+}
+
+func (a *createFuturesAlgoTwapOrderServiceAPI) Do(ctx context.Context, opts ...RequestOption) (*CreateFuturesAlgoOrderResponse, error) {
+	return (*CreateFuturesAlgoTwapOrderService)(a).Do(ctx, opts...)
+}
+
+// ListOpenFuturesAlgoOrdersServiceAPI is the interface of ListOpenFuturesAlgoOrdersService
+type ListOpenFuturesAlgoOrdersServiceAPI interface {
+	Do(ctx context.Context, opts ...RequestOption) (*ListOpenFuturesAlgoOrdersResponse, error)
+}
+
+type listOpenFuturesAlgoOrdersServiceAPI ListOpenFuturesAlgoOrdersService
+
+func (a *listOpenFuturesAlgoOrdersServiceAPI) Do(ctx context.Context, opts ...RequestOption) (*ListOpenFuturesAlgoOrdersResponse, error) {
+	return (*ListOpenFuturesAlgoOrdersService)(a).Do(ctx, opts...)
+}
+
+// ListHistoryFuturesAlgoOrdersServiceAPI is the interface of ListHistoryFuturesAlgoOrdersService
+type ListHistoryFuturesAlgoOrdersServiceAPI interface {
+	Symbol(symbol string) ListHistoryFuturesAlgoOrdersServiceAPI
+	Side(side SideType) ListHistoryFuturesAlgoOrdersServiceAPI
+	StartTime(startTime int64) ListHistoryFuturesAlgoOrdersServiceAPI
+	EndTime(endTime int64) ListHistoryFuturesAlgoOrdersServiceAPI
+	Page(page int) ListHistoryFuturesAlgoOrdersServiceAPI
+	PageSize(pageSize int) ListHistoryFuturesAlgoOrdersServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) (*ListHistoryFuturesAlgoOrdersResponse, error)
+}
+
+type listHistoryFuturesAlgoOrdersServiceAPI ListHistoryFuturesAlgoOrdersService
+
+func (a *listHistoryFuturesAlgoOrdersServiceAPI) Symbol(symbol string) ListHistoryFuturesAlgoOrdersServiceAPI {
+	return (*listHistoryFuturesAlgoOrdersServiceAPI)((*ListHistoryFuturesAlgoOrdersService)(a).Symbol(symbol))
+}
+
+func (a *listHistoryFuturesAlgoOrdersServiceAPI) Side(side SideType) ListHistoryFuturesAlgoOrdersServiceAPI {
+	return (*listHistoryFuturesAlgoOrdersServiceAPI)((*ListHistoryFuturesAlgoOrdersService)(a).Side(side))
+}
+
+func (a *listHistoryFuturesAlgoOrdersServiceAPI) StartTime(startTime int64) ListHistoryFuturesAlgoOrdersServiceAPI {
+	return (*listHistoryFuturesAlgoOrdersServiceAPI)((*ListHistoryFuturesAlgoOrdersService)(a).StartTime(startTime))
+}
+
+func (a *listHistoryFuturesAlgoOrdersServiceAPI) EndTime(endTime int64) ListHistoryFuturesAlgoOrdersServiceAPI {
+	return (*listHistoryFuturesAlgoOrdersServiceAPI)((*ListHistoryFuturesAlgoOrdersService)(a).EndTime(endTime))
+}
+
+func (a *listHistoryFuturesAlgoOrdersServiceAPI) Page(page int) ListHistoryFuturesAlgoOrdersServiceAPI {
+	return (*listHistoryFuturesAlgoOrdersServiceAPI)((*ListHistoryFuturesAlgoOrdersService)(a).Page(page))
+}
+
+func (a *listHistoryFuturesAlgoOrdersServiceAPI) PageSize(pageSize int) ListHistoryFuturesAlgoOrdersServiceAPI {
+	return (*listHistoryFuturesAlgoOrdersServiceAPI)((*ListHistoryFuturesAlgoOrdersService)(a).PageSize(pageSize))
+}
+
+func (a *listHistoryFuturesAlgoOrdersServiceAPI) Do(ctx context.Context, opts ...RequestOption) (*ListHistoryFuturesAlgoOrdersResponse, error) {
+	return (*ListHistoryFuturesAlgoOrdersService)(a).Do(ctx, opts...)
+}
+
+// CancelFuturesAlgoOrderServiceAPI is the interface of CancelFuturesAlgoOrderService
+type CancelFuturesAlgoOrderServiceAPI interface {
+	AlgoId(algoId int64) CancelFuturesAlgoOrderServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) (*CancelFuturesAlgoOrderResponse, error)
+}
+
+type cancelFuturesAlgoOrderServiceAPI CancelFuturesAlgoOrderService
+
+func (a *cancelFuturesAlgoOrderServiceAPI) AlgoId(algoId int64) CancelFuturesAlgoOrderServiceAPI {
+	return (*cancelFuturesAlgoOrderServiceAPI)((*CancelFuturesAlgoOrderService)(a).AlgoId(algoId))
+}
+
+func (a *cancelFuturesAlgoOrderServiceAPI) Do(ctx context.Context, opts ...RequestOption) (*CancelFuturesAlgoOrderResponse, error) {
+	return (*CancelFuturesAlgoOrderService)(a).Do(ctx, opts...)
+}
+
+// GetFuturesAlgoSubOrdersServiceAPI is the interface of GetFuturesAlgoSubOrdersService
+type GetFuturesAlgoSubOrdersServiceAPI interface {
+	AlgoId(algoId int64) GetFuturesAlgoSubOrdersServiceAPI
+	Page(page int) GetFuturesAlgoSubOrdersServiceAPI
+	PageSize(pageSize int) GetFuturesAlgoSubOrdersServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) (*GetFuturesAlgoSubOrdersResponse, error)
+}
+
+type getFuturesAlgoSubOrdersServiceAPI GetFuturesAlgoSubOrdersService
+
+func (a *getFuturesAlgoSubOrdersServiceAPI) AlgoId(algoId int64) GetFuturesAlgoSubOrdersServiceAPI {
+	return (*getFuturesAlgoSubOrdersServiceAPI)((*GetFuturesAlgoSubOrdersService)(a).AlgoId(algoId))
+}
+
+func (a *getFuturesAlgoSubOrdersServiceAPI) Page(page int) GetFuturesAlgoSubOrdersServiceAPI {
+	return (*getFuturesAlgoSubOrdersServiceAPI)((*GetFuturesAlgoSubOrdersService)(a).Page(page))
+}
+
+func (a *getFuturesAlgoSubOrdersServiceAPI) PageSize(pageSize int) GetFuturesAlgoSubOrdersServiceAPI {
+	return (*getFuturesAlgoSubOrdersServiceAPI)((*GetFuturesAlgoSubOrdersService)(a).PageSize(pageSize))
+}
+
+func (a *getFuturesAlgoSubOrdersServiceAPI) Do(ctx context.Context, opts ...RequestOption) (*GetFuturesAlgoSubOrdersResponse, error) {
+	return (*GetFuturesAlgoSubOrdersService)(a).Do(ctx, opts...)
+}
+
+// SimpleEarnServiceAPI is the interface of SimpleEarnService
+type SimpleEarnServiceAPI interface {
+	GetAccount() SimpleEarnGetAccountServiceAPI
+	FlexibleService() SimpleEarnFlexibleServiceAPI
+	LockedService() SimpleEarnLockedServiceAPI
+}
+
+type simpleEarnServiceAPI SimpleEarnService
+
+func (a *simpleEarnServiceAPI) GetAccount() SimpleEarnGetAccountServiceAPI {
+	return (*simpleEarnGetAccountServiceAPI)((*SimpleEarnService)(a).GetAccount())
+}
+
+func (a *simpleEarnServiceAPI) FlexibleService() SimpleEarnFlexibleServiceAPI {
+	return (*simpleEarnFlexibleServiceAPI)((*SimpleEarnService)(a).FlexibleService())
+}
+
+func (a *simpleEarnServiceAPI) LockedService() SimpleEarnLockedServiceAPI {
+	return (*simpleEarnLockedServiceAPI)((*SimpleEarnService)(a).LockedService())
+}
+
+// DualInvestmentServiceAPI is the interface of DualInvestmentService
+type DualInvestmentServiceAPI interface {
+	ListProductService() ListDualInvestmentProductServiceAPI
+	ListPositionService() ListDualInvestmentPositionServiceAPI
+	GetAccountService() GetDualInvestmentAccountsServiceAPI
+	SubscribeService() SubscribeDualInvestmentServiceAPI
+	EditAutoCompoundStatusService() EditAutoCompoundStatusDualInvestmentServiceAPI
+}
+
+type dualInvestmentServiceAPI DualInvestmentService
+
+func (a *dualInvestmentServiceAPI) ListProductService() ListDualInvestmentProductServiceAPI {
+	return (*listDualInvestmentProductServiceAPI)((*DualInvestmentService)(a).ListProductService())
+}
+
+func (a *dualInvestmentServiceAPI) ListPositionService() ListDualInvestmentPositionServiceAPI {
+	return (*listDualInvestmentPositionServiceAPI)((*DualInvestmentService)(a).ListPositionService())
+}
+
+func (a *dualInvestmentServiceAPI) GetAccountService() GetDualInvestmentAccountsServiceAPI {
+	return (*getDualInvestmentAccountsServiceAPI)((*DualInvestmentService)(a).GetAccountService())
+}
+
+func (a *dualInvestmentServiceAPI) SubscribeService() SubscribeDualInvestmentServiceAPI {
+	return (*subscribeDualInvestmentServiceAPI)((*DualInvestmentService)(a).SubscribeService())
+}
+
+func (a *dualInvestmentServiceAPI) EditAutoCompoundStatusService() EditAutoCompoundStatusDualInvestmentServiceAPI {
+	return (*editAutoCompoundStatusDualInvestmentServiceAPI)((*DualInvestmentService)(a).EditAutoCompoundStatusService())
+}
+
+// SimpleEarnGetAccountServiceAPI is the interface of SimpleEarnGetAccountService
+type SimpleEarnGetAccountServiceAPI interface {
+	Do(ctx context.Context, opts ...RequestOption) (*SimpleEarnAccount, error)
+}
+
+type simpleEarnGetAccountServiceAPI SimpleEarnGetAccountService
+
+func (a *simpleEarnGetAccountServiceAPI) Do(ctx context.Context, opts ...RequestOption) (*SimpleEarnAccount, error) {
+	return (*SimpleEarnGetAccountService)(a).Do(ctx, opts...)
+}
+
+// SimpleEarnFlexibleServiceAPI is the interface of SimpleEarnFlexibleService
+type SimpleEarnFlexibleServiceAPI interface {
+	ListProduct() SimpleEarnListFlexibleProductServiceAPI
+	GetPosition() SimpleEarnGetFlexiblePositionServiceAPI
+	GetLeftQuote() SimpleEarnGetFlexibleQuotaServiceAPI
+	Subscribe() SimpleEarnSubscribeFlexibleProductServiceAPI
+	Redeem() SimpleEarnRedeemFlexibleProductServiceAPI
+	SetAutoSubscribe() SimpleEarnSetAutoSubscribeFlexibleProductServiceAPI
+	PreviewSubscribe() SimpleEarnFlexibleSubscriptionPreviewServiceAPI
+	GetRewardsHistory() SimpleEarnGetFlexibleRewardsHistoryServiceAPI
+}
+
+type simpleEarnFlexibleServiceAPI SimpleEarnFlexibleService
+
+func (a *simpleEarnFlexibleServiceAPI) ListProduct() SimpleEarnListFlexibleProductServiceAPI {
+	return (*simpleEarnListFlexibleProductServiceAPI)((*SimpleEarnFlexibleService)(a).ListProduct())
+}
+
+func (a *simpleEarnFlexibleServiceAPI) GetPosition() SimpleEarnGetFlexiblePositionServiceAPI {
+	return (*simpleEarnGetFlexiblePositionServiceAPI)((*SimpleEarnFlexibleService)(a).GetPosition())
+}
+
+func (a *simpleEarnFlexibleServiceAPI) GetLeftQuote() SimpleEarnGetFlexibleQuotaServiceAPI {
+	return (*simpleEarnGetFlexibleQuotaServiceAPI)((*SimpleEarnFlexibleService)(a).GetLeftQuote())
+}
+
+func (a *simpleEarnFlexibleServiceAPI) Subscribe() SimpleEarnSubscribeFlexibleProductServiceAPI {
+	return (*simpleEarnSubscribeFlexibleProductServiceAPI)((*SimpleEarnFlexibleService)(a).Subscribe())
+}
+
+func (a *simpleEarnFlexibleServiceAPI) Redeem() SimpleEarnRedeemFlexibleProductServiceAPI {
+	return (*simpleEarnRedeemFlexibleProductServiceAPI)((*SimpleEarnFlexibleService)(a).Redeem())
+}
+
+func (a *simpleEarnFlexibleServiceAPI) SetAutoSubscribe() SimpleEarnSetAutoSubscribeFlexibleProductServiceAPI {
+	return (*simpleEarnSetAutoSubscribeFlexibleProductServiceAPI)((*SimpleEarnFlexibleService)(a).SetAutoSubscribe())
+}
+
+func (a *simpleEarnFlexibleServiceAPI) PreviewSubscribe() SimpleEarnFlexibleSubscriptionPreviewServiceAPI {
+	return (*simpleEarnFlexibleSubscriptionPreviewServiceAPI)((*SimpleEarnFlexibleService)(a).PreviewSubscribe())
+}
+
+func (a *simpleEarnFlexibleServiceAPI) GetRewardsHistory() SimpleEarnGetFlexibleRewardsHistoryServiceAPI {
+	return (*simpleEarnGetFlexibleRewardsHistoryServiceAPI)((*SimpleEarnFlexibleService)(a).GetRewardsHistory())
+}
+
+// SimpleEarnLockedServiceAPI is the interface of SimpleEarnLockedService
+type SimpleEarnLockedServiceAPI interface {
+	ListProduct() SimpleEarnListLockedProductServiceAPI
+	GetPosition() SimpleEarnGetLockedPositionServiceAPI
+	GetLeftQuote() SimpleEarnGetLockedQuotaServiceAPI
+	Subscribe() SimpleEarnSubscribeLockedProductServiceAPI
+	Redeem() SimpleEarnRedeemLockedProductServiceAPI
+	SetAutoSubscribe() SimpleEarnSetAutoSubscribeLockedProductServiceAPI
+	PreviewSubscribe() SimpleEarnLockedSubscriptionPreviewServiceAPI
+	SetRedeemOption() SimpleEarnSetRedeemOptionServiceAPI
+	GetRewardsHistory() SimpleEarnGetLockedRewardsHistoryServiceAPI
+}
+
+type simpleEarnLockedServiceAPI SimpleEarnLockedService
+
+func (a *simpleEarnLockedServiceAPI) ListProduct() SimpleEarnListLockedProductServiceAPI {
+	return (*simpleEarnListLockedProductServiceAPI)((*SimpleEarnLockedService)(a).ListProduct())
+}
+
+func (a *simpleEarnLockedServiceAPI) GetPosition() SimpleEarnGetLockedPositionServiceAPI {
+	return (*simpleEarnGetLockedPositionServiceAPI)((*SimpleEarnLockedService)(a).GetPosition())
+}
+
+func (a *simpleEarnLockedServiceAPI) GetLeftQuote() SimpleEarnGetLockedQuotaServiceAPI {
+	return (*simpleEarnGetLockedQuotaServiceAPI)((*SimpleEarnLockedService)(a).GetLeftQuote())
+}
+
+func (a *simpleEarnLockedServiceAPI) Subscribe() SimpleEarnSubscribeLockedProductServiceAPI {
+	return (*simpleEarnSubscribeLockedProductServiceAPI)((*SimpleEarnLockedService)(a).Subscribe())
+}
+
+func (a *simpleEarnLockedServiceAPI) Redeem() SimpleEarnRedeemLockedProductServiceAPI {
+	return (*simpleEarnRedeemLockedProductServiceAPI)((*SimpleEarnLockedService)(a).Redeem())
+}
+
+func (a *simpleEarnLockedServiceAPI) SetAutoSubscribe() SimpleEarnSetAutoSubscribeLockedProductServiceAPI {
+	return (*simpleEarnSetAutoSubscribeLockedProductServiceAPI)((*SimpleEarnLockedService)(a).SetAutoSubscribe())
+}
+
+func (a *simpleEarnLockedServiceAPI) PreviewSubscribe() SimpleEarnLockedSubscriptionPreviewServiceAPI {
+	return (*simpleEarnLockedSubscriptionPreviewServiceAPI)((*SimpleEarnLockedService)(a).PreviewSubscribe())
+}
+
+func (a *simpleEarnLockedServiceAPI) SetRedeemOption() SimpleEarnSetRedeemOptionServiceAPI {
+	return (*simpleEarnSetRedeemOptionServiceAPI)((*SimpleEarnLockedService)(a).SetRedeemOption())
+}
+
+func (a *simpleEarnLockedServiceAPI) GetRewardsHistory() SimpleEarnGetLockedRewardsHistoryServiceAPI {
+	return (*simpleEarnGetLockedRewardsHistoryServiceAPI)((*SimpleEarnLockedService)(a).GetRewardsHistory())
+}
+
+// ListDualInvestmentProductServiceAPI is the interface of ListDualInvestmentProductService
+type ListDualInvestmentProductServiceAPI interface {
+	OptionType(optionType DualInvestmentOptionType) ListDualInvestmentProductServiceAPI
+	ExercisedCoin(exercisedCoin string) ListDualInvestmentProductServiceAPI
+	InvestCoin(investCoin string) ListDualInvestmentProductServiceAPI
+	PageSize(pageSize int) ListDualInvestmentProductServiceAPI
+	PageIndex(pageIndex int) ListDualInvestmentProductServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) (*DualInvestmentProductListResponse, error)
+}
+
+type listDualInvestmentProductServiceAPI ListDualInvestmentProductService
+
+func (a *listDualInvestmentProductServiceAPI) OptionType(optionType DualInvestmentOptionType) ListDualInvestmentProductServiceAPI {
+	return (*listDualInvestmentProductServiceAPI)((*ListDualInvestmentProductService)(a).OptionType(optionType))
+}
+
+func (a *listDualInvestmentProductServiceAPI) ExercisedCoin(exercisedCoin string) ListDualInvestmentProductServiceAPI {
+	return (*listDualInvestmentProductServiceAPI)((*ListDualInvestmentProductService)(a).ExercisedCoin(exercisedCoin))
+}
+
+func (a *listDualInvestmentProductServiceAPI) InvestCoin(investCoin string) ListDualInvestmentProductServiceAPI {
+	return (*listDualInvestmentProductServiceAPI)((*ListDualInvestmentProductService)(a).InvestCoin(investCoin))
+}
+
+func (a *listDualInvestmentProductServiceAPI) PageSize(pageSize int) ListDualInvestmentProductServiceAPI {
+	return (*listDualInvestmentProductServiceAPI)((*ListDualInvestmentProductService)(a).PageSize(pageSize))
+}
+
+func (a *listDualInvestmentProductServiceAPI) PageIndex(pageIndex int) ListDualInvestmentProductServiceAPI {
+	return (*listDualInvestmentProductServiceAPI)((*ListDualInvestmentProductService)(a).PageIndex(pageIndex))
+}
+
+func (a *listDualInvestmentProductServiceAPI) Do(ctx context.Context, opts ...RequestOption) (*DualInvestmentProductListResponse, error) {
+	return (*ListDualInvestmentProductService)(a).Do(ctx, opts...)
+}
+
+// ListDualInvestmentPositionServiceAPI is the interface of ListDualInvestmentPositionService
+type ListDualInvestmentPositionServiceAPI interface {
+	Status(status ListDualInvestmentPositionStatus) ListDualInvestmentPositionServiceAPI
+	PageSize(pageSize int) ListDualInvestmentPositionServiceAPI
+	PageIndex(pageIndex int) ListDualInvestmentPositionServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) (*ListDualInvestmentPositionResponse, error)
+}
+
+type listDualInvestmentPositionServiceAPI ListDualInvestmentPositionService
+
+func (a *listDualInvestmentPositionServiceAPI) Status(status ListDualInvestmentPositionStatus) ListDualInvestmentPositionServiceAPI {
+	return (*listDualInvestmentPositionServiceAPI)((*ListDualInvestmentPositionService)(a).Status(status))
+}
+
+func (a *listDualInvestmentPositionServiceAPI) PageSize(pageSize int) ListDualInvestmentPositionServiceAPI {
+	return (*listDualInvestmentPositionServiceAPI)((*ListDualInvestmentPositionService)(a).PageSize(pageSize))
+}
+
+func (a *listDualInvestmentPositionServiceAPI) PageIndex(pageIndex int) ListDualInvestmentPositionServiceAPI {
+	return (*listDualInvestmentPositionServiceAPI)((*ListDualInvestmentPositionService)(a).PageIndex(pageIndex))
+}
+
+func (a *listDualInvestmentPositionServiceAPI) Do(ctx context.Context, opts ...RequestOption) (*ListDualInvestmentPositionResponse, error) {
+	return (*ListDualInvestmentPositionService)(a).Do(ctx, opts...)
+}
+
+// GetDualInvestmentAccountsServiceAPI is the interface of GetDualInvestmentAccountsService
+type GetDualInvestmentAccountsServiceAPI interface {
+	Do(ctx context.Context, opts ...RequestOption) (*GetDualInvestmentAccountsResp, error)
+}
+
+type getDualInvestmentAccountsServiceAPI GetDualInvestmentAccountsService
+
+func (a *getDualInvestmentAccountsServiceAPI) Do(ctx context.Context, opts ...RequestOption) (*GetDualInvestmentAccountsResp, error) {
+	return (*GetDualInvestmentAccountsService)(a).Do(ctx, opts...)
+}
+
+// SubscribeDualInvestmentServiceAPI is the interface of SubscribeDualInvestmentService
+type SubscribeDualInvestmentServiceAPI interface {
+	ID(id string) SubscribeDualInvestmentServiceAPI
+	OrderID(orderId int64) SubscribeDualInvestmentServiceAPI
+	DepositAmount(depositAmount string) SubscribeDualInvestmentServiceAPI
+	AutoCompoundPlan(autoCompoundPlan DualInvestmentCompoundPlan) SubscribeDualInvestmentServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) (*SubscribeDualInvestmentResp, error)
+}
+
+type subscribeDualInvestmentServiceAPI SubscribeDualInvestmentService
+
+func (a *subscribeDualInvestmentServiceAPI) ID(id string) SubscribeDualInvestmentServiceAPI {
+	return (*subscribeDualInvestmentServiceAPI)((*SubscribeDualInvestmentService)(a).ID(id))
+}
+
+func (a *subscribeDualInvestmentServiceAPI) OrderID(orderId int64) SubscribeDualInvestmentServiceAPI {
+	return (*subscribeDualInvestmentServiceAPI)((*SubscribeDualInvestmentService)(a).OrderID(orderId))
+}
+
+func (a *subscribeDualInvestmentServiceAPI) DepositAmount(depositAmount string) SubscribeDualInvestmentServiceAPI {
+	return (*subscribeDualInvestmentServiceAPI)((*SubscribeDualInvestmentService)(a).DepositAmount(depositAmount))
+}
+
+func (a *subscribeDualInvestmentServiceAPI) AutoCompoundPlan(autoCompoundPlan DualInvestmentCompoundPlan) SubscribeDualInvestmentServiceAPI {
+	return (*subscribeDualInvestmentServiceAPI)((*SubscribeDualInvestmentService)(a).AutoCompoundPlan(autoCompoundPlan))
+}
+
+func (a *subscribeDualInvestmentServiceAPI) Do(ctx context.Context, opts ...RequestOption) (*SubscribeDualInvestmentResp, error) {
+	return (*SubscribeDualInvestmentService)(a).Do(ctx, opts...)
+}
+
+// EditAutoCompoundStatusDualInvestmentServiceAPI is the interface of EditAutoCompoundStatusDualInvestmentService
+type EditAutoCompoundStatusDualInvestmentServiceAPI interface {
+	PositionID(positionID string) EditAutoCompoundStatusDualInvestmentServiceAPI
+	AutoCompoundPlan(plan DualInvestmentCompoundPlan) EditAutoCompoundStatusDualInvestmentServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) (*EditAutoCompoundStatusDualInvestmentResp, error)
+}
+
+type editAutoCompoundStatusDualInvestmentServiceAPI EditAutoCompoundStatusDualInvestmentService
+
+func (a *editAutoCompoundStatusDualInvestmentServiceAPI) PositionID(positionID string) EditAutoCompoundStatusDualInvestmentServiceAPI {
+	return (*editAutoCompoundStatusDualInvestmentServiceAPI)((*EditAutoCompoundStatusDualInvestmentService)(a).PositionID(positionID))
+}
+
+func (a *editAutoCompoundStatusDualInvestmentServiceAPI) AutoCompoundPlan(plan DualInvestmentCompoundPlan) EditAutoCompoundStatusDualInvestmentServiceAPI {
+	return (*editAutoCompoundStatusDualInvestmentServiceAPI)((*EditAutoCompoundStatusDualInvestmentService)(a).AutoCompoundPlan(plan))
+}
+
+func (a *editAutoCompoundStatusDualInvestmentServiceAPI) Do(ctx context.Context, opts ...RequestOption) (*EditAutoCompoundStatusDualInvestmentResp, error) {
+	return (*EditAutoCompoundStatusDualInvestmentService)(a).Do(ctx, opts...)
+}
+
+// SimpleEarnListFlexibleProductServiceAPI is the interface of SimpleEarnListFlexibleProductService
+type SimpleEarnListFlexibleProductServiceAPI interface {
+	Asset(asset string) SimpleEarnListFlexibleProductServiceAPI
+	Current(current int) SimpleEarnListFlexibleProductServiceAPI
+	Size(size int) SimpleEarnListFlexibleProductServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) (*SimpleEarnFlexibleProductResp, error)
+}
+
+type simpleEarnListFlexibleProductServiceAPI SimpleEarnListFlexibleProductService
+
+func (a *simpleEarnListFlexibleProductServiceAPI) Asset(asset string) SimpleEarnListFlexibleProductServiceAPI {
+	return (*simpleEarnListFlexibleProductServiceAPI)((*SimpleEarnListFlexibleProductService)(a).Asset(asset))
+}
+
+func (a *simpleEarnListFlexibleProductServiceAPI) Current(current int) SimpleEarnListFlexibleProductServiceAPI {
+	return (*simpleEarnListFlexibleProductServiceAPI)((*SimpleEarnListFlexibleProductService)(a).Current(current))
+}
+
+func (a *simpleEarnListFlexibleProductServiceAPI) Size(size int) SimpleEarnListFlexibleProductServiceAPI {
+	return (*simpleEarnListFlexibleProductServiceAPI)((*SimpleEarnListFlexibleProductService)(a).Size(size))
+}
+
+func (a *simpleEarnListFlexibleProductServiceAPI) Do(ctx context.Context, opts ...RequestOption) (*SimpleEarnFlexibleProductResp, error) {
+	return (*SimpleEarnListFlexibleProductService)(a).Do(ctx, opts...)
+}
+
+// SimpleEarnGetFlexiblePositionServiceAPI is the interface of SimpleEarnGetFlexiblePositionService
+type SimpleEarnGetFlexiblePositionServiceAPI interface {
+	Asset(asset string) SimpleEarnGetFlexiblePositionServiceAPI
+	ProductId(productId string) SimpleEarnGetFlexiblePositionServiceAPI
+	Current(current int) SimpleEarnGetFlexiblePositionServiceAPI
+	Size(size int) SimpleEarnGetFlexiblePositionServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) (*SimpleEarnFlexiblePositionResp, error)
+}
+
+type simpleEarnGetFlexiblePositionServiceAPI SimpleEarnGetFlexiblePositionService
+
+func (a *simpleEarnGetFlexiblePositionServiceAPI) Asset(asset string) SimpleEarnGetFlexiblePositionServiceAPI {
+	return (*simpleEarnGetFlexiblePositionServiceAPI)((*SimpleEarnGetFlexiblePositionService)(a).Asset(asset))
+}
+
+func (a *simpleEarnGetFlexiblePositionServiceAPI) ProductId(productId string) SimpleEarnGetFlexiblePositionServiceAPI {
+	return (*simpleEarnGetFlexiblePositionServiceAPI)((*SimpleEarnGetFlexiblePositionService)(a).ProductId(productId))
+}
+
+func (a *simpleEarnGetFlexiblePositionServiceAPI) Current(current int) SimpleEarnGetFlexiblePositionServiceAPI {
+	return (*simpleEarnGetFlexiblePositionServiceAPI)((*SimpleEarnGetFlexiblePositionService)(a).Current(current))
+}
+
+func (a *simpleEarnGetFlexiblePositionServiceAPI) Size(size int) SimpleEarnGetFlexiblePositionServiceAPI {
+	return (*simpleEarnGetFlexiblePositionServiceAPI)((*SimpleEarnGetFlexiblePositionService)(a).Size(size))
+}
+
+func (a *simpleEarnGetFlexiblePositionServiceAPI) Do(ctx context.Context, opts ...RequestOption) (*SimpleEarnFlexiblePositionResp, error) {
+	return (*SimpleEarnGetFlexiblePositionService)(a).Do(ctx, opts...)
+}
+
+// SimpleEarnGetFlexibleQuotaServiceAPI is the interface of SimpleEarnGetFlexibleQuotaService
+type SimpleEarnGetFlexibleQuotaServiceAPI interface {
+	ProductId(productId string) SimpleEarnGetFlexibleQuotaServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) (*SimpleEarnFlexiblePersonalLeftQuotaResp, error)
+}
+
+type simpleEarnGetFlexibleQuotaServiceAPI SimpleEarnGetFlexibleQuotaService
+
+func (a *simpleEarnGetFlexibleQuotaServiceAPI) ProductId(productId string) SimpleEarnGetFlexibleQuotaServiceAPI {
+	return (*simpleEarnGetFlexibleQuotaServiceAPI)((*SimpleEarnGetFlexibleQuotaService)(a).ProductId(productId))
+}
+
+func (a *simpleEarnGetFlexibleQuotaServiceAPI) Do(ctx context.Context, opts ...RequestOption) (*SimpleEarnFlexiblePersonalLeftQuotaResp, error) {
+	return (*SimpleEarnGetFlexibleQuotaService)(a).Do(ctx, opts...)
+}
+
+// SimpleEarnSubscribeFlexibleProductServiceAPI is the interface of SimpleEarnSubscribeFlexibleProductService
+type SimpleEarnSubscribeFlexibleProductServiceAPI interface {
+	ProductId(productId string) SimpleEarnSubscribeFlexibleProductServiceAPI
+	Amount(amount string) SimpleEarnSubscribeFlexibleProductServiceAPI
+	AutoSubscribe(autoSubscribe bool) SimpleEarnSubscribeFlexibleProductServiceAPI
+	SourceAccount(sourceAccount SimpleEarnSubscribeSourceAccount) SimpleEarnSubscribeFlexibleProductServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) (*SimpleEarnSubscribeFlexibleProductResp, error)
+}
+
+type simpleEarnSubscribeFlexibleProductServiceAPI SimpleEarnSubscribeFlexibleProductService
+
+func (a *simpleEarnSubscribeFlexibleProductServiceAPI) ProductId(productId string) SimpleEarnSubscribeFlexibleProductServiceAPI {
+	return (*simpleEarnSubscribeFlexibleProductServiceAPI)((*SimpleEarnSubscribeFlexibleProductService)(a).ProductId(productId))
+}
+
+func (a *simpleEarnSubscribeFlexibleProductServiceAPI) Amount(amount string) SimpleEarnSubscribeFlexibleProductServiceAPI {
+	return (*simpleEarnSubscribeFlexibleProductServiceAPI)((*SimpleEarnSubscribeFlexibleProductService)(a).Amount(amount))
+}
+
+func (a *simpleEarnSubscribeFlexibleProductServiceAPI) AutoSubscribe(autoSubscribe bool) SimpleEarnSubscribeFlexibleProductServiceAPI {
+	return (*simpleEarnSubscribeFlexibleProductServiceAPI)((*SimpleEarnSubscribeFlexibleProductService)(a).AutoSubscribe(autoSubscribe))
+}
+
+func (a *simpleEarnSubscribeFlexibleProductServiceAPI) SourceAccount(sourceAccount SimpleEarnSubscribeSourceAccount) SimpleEarnSubscribeFlexibleProductServiceAPI {
+	return (*simpleEarnSubscribeFlexibleProductServiceAPI)((*SimpleEarnSubscribeFlexibleProductService)(a).SourceAccount(sourceAccount))
+}
+
+func (a *simpleEarnSubscribeFlexibleProductServiceAPI) Do(ctx context.Context, opts ...RequestOption) (*SimpleEarnSubscribeFlexibleProductResp, error) {
+	return (*SimpleEarnSubscribeFlexibleProductService)(a).Do(ctx, opts...)
+}
+
+// SimpleEarnRedeemFlexibleProductServiceAPI is the interface of SimpleEarnRedeemFlexibleProductService
+type SimpleEarnRedeemFlexibleProductServiceAPI interface {
+	ProductId(productId string) SimpleEarnRedeemFlexibleProductServiceAPI
+	RedeemAll(redeemAll bool) SimpleEarnRedeemFlexibleProductServiceAPI
+	Amount(amount string) SimpleEarnRedeemFlexibleProductServiceAPI
+	DestAccount(destAccount string) SimpleEarnRedeemFlexibleProductServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) (*SimpleEarnRedeemFlexibleProductResp, error)
+}
+
+type simpleEarnRedeemFlexibleProductServiceAPI SimpleEarnRedeemFlexibleProductService
+
+func (a *simpleEarnRedeemFlexibleProductServiceAPI) ProductId(productId string) SimpleEarnRedeemFlexibleProductServiceAPI {
+	return (*simpleEarnRedeemFlexibleProductServiceAPI)((*SimpleEarnRedeemFlexibleProductService)(a).ProductId(productId))
+}
+
+func (a *simpleEarnRedeemFlexibleProductServiceAPI) RedeemAll(redeemAll bool) SimpleEarnRedeemFlexibleProductServiceAPI {
+	return (*simpleEarnRedeemFlexibleProductServiceAPI)((*SimpleEarnRedeemFlexibleProductService)(a).RedeemAll(redeemAll))
+}
+
+func (a *simpleEarnRedeemFlexibleProductServiceAPI) Amount(amount string) SimpleEarnRedeemFlexibleProductServiceAPI {
+	return (*simpleEarnRedeemFlexibleProductServiceAPI)((*SimpleEarnRedeemFlexibleProductService)(a).Amount(amount))
+}
+
+func (a *simpleEarnRedeemFlexibleProductServiceAPI) DestAccount(destAccount string) SimpleEarnRedeemFlexibleProductServiceAPI {
+	return (*simpleEarnRedeemFlexibleProductServiceAPI)((*SimpleEarnRedeemFlexibleProductService)(a).DestAccount(destAccount))
+}
+
+func (a *simpleEarnRedeemFlexibleProductServiceAPI) Do(ctx context.Context, opts ...RequestOption) (*SimpleEarnRedeemFlexibleProductResp, error) {
+	return (*SimpleEarnRedeemFlexibleProductService)(a).Do(ctx, opts...)
+}
+
+// SimpleEarnSetAutoSubscribeFlexibleProductServiceAPI is the interface of SimpleEarnSetAutoSubscribeFlexibleProductService
+type SimpleEarnSetAutoSubscribeFlexibleProductServiceAPI interface {
+	ProductId(productId string) SimpleEarnSetAutoSubscribeFlexibleProductServiceAPI
+	AutoSubscribe(autoSubscribe bool) SimpleEarnSetAutoSubscribeFlexibleProductServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) (*SimpleEarnSetAutoSubscribeFlexibleProductResp, error)
+}
+
+type simpleEarnSetAutoSubscribeFlexibleProductServiceAPI SimpleEarnSetAutoSubscribeFlexibleProductService
+
+func (a *simpleEarnSetAutoSubscribeFlexibleProductServiceAPI) ProductId(productId string) SimpleEarnSetAutoSubscribeFlexibleProductServiceAPI {
+	return (*simpleEarnSetAutoSubscribeFlexibleProductServiceAPI)((*SimpleEarnSetAutoSubscribeFlexibleProductService)(a).ProductId(productId))
+}
+
+func (a *simpleEarnSetAutoSubscribeFlexibleProductServiceAPI) AutoSubscribe(autoSubscribe bool) SimpleEarnSetAutoSubscribeFlexibleProductServiceAPI {
+	return (*simpleEarnSetAutoSubscribeFlexibleProductServiceAPI)((*SimpleEarnSetAutoSubscribeFlexibleProductService)(a).AutoSubscribe(autoSubscribe))
+}
+
+func (a *simpleEarnSetAutoSubscribeFlexibleProductServiceAPI) Do(ctx context.Context, opts ...RequestOption) (*SimpleEarnSetAutoSubscribeFlexibleProductResp, error) {
+	return (*SimpleEarnSetAutoSubscribeFlexibleProductService)(a).Do(ctx, opts...)
+}
+
+// SimpleEarnFlexibleSubscriptionPreviewServiceAPI is the interface of SimpleEarnFlexibleSubscriptionPreviewService
+type SimpleEarnFlexibleSubscriptionPreviewServiceAPI interface {
+	ProductId(productId string) SimpleEarnFlexibleSubscriptionPreviewServiceAPI
+	Amount(amount string) SimpleEarnFlexibleSubscriptionPreviewServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) (*SimpleEarnFlexibleSubscriptionPreviewResp, error)
+}
+
+type simpleEarnFlexibleSubscriptionPreviewServiceAPI SimpleEarnFlexibleSubscriptionPreviewService
+
+func (a *simpleEarnFlexibleSubscriptionPreviewServiceAPI) ProductId(productId string) SimpleEarnFlexibleSubscriptionPreviewServiceAPI {
+	return (*simpleEarnFlexibleSubscriptionPreviewServiceAPI)((*SimpleEarnFlexibleSubscriptionPreviewService)(a).ProductId(productId))
+}
+
+func (a *simpleEarnFlexibleSubscriptionPreviewServiceAPI) Amount(amount string) SimpleEarnFlexibleSubscriptionPreviewServiceAPI {
+	return (*simpleEarnFlexibleSubscriptionPreviewServiceAPI)((*SimpleEarnFlexibleSubscriptionPreviewService)(a).Amount(amount))
+}
+
+func (a *simpleEarnFlexibleSubscriptionPreviewServiceAPI) Do(ctx context.Context, opts ...RequestOption) (*SimpleEarnFlexibleSubscriptionPreviewResp, error) {
+	return (*SimpleEarnFlexibleSubscriptionPreviewService)(a).Do(ctx, opts...)
+}
+
+// SimpleEarnGetFlexibleRewardsHistoryServiceAPI is the interface of SimpleEarnGetFlexibleRewardsHistoryService
+type SimpleEarnGetFlexibleRewardsHistoryServiceAPI interface {
+	ProductId(productId string) SimpleEarnGetFlexibleRewardsHistoryServiceAPI
+	Asset(asset string) SimpleEarnGetFlexibleRewardsHistoryServiceAPI
+	Type(rewardType SimpleEarnFlexibleRewardType) SimpleEarnGetFlexibleRewardsHistoryServiceAPI
+	StartTime(startTime int64) SimpleEarnGetFlexibleRewardsHistoryServiceAPI
+	EndTime(endTime int64) SimpleEarnGetFlexibleRewardsHistoryServiceAPI
+	Current(current int) SimpleEarnGetFlexibleRewardsHistoryServiceAPI
+	Size(size int) SimpleEarnGetFlexibleRewardsHistoryServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) (*SimpleEarnFlexibleRewardsHistoryResp, error)
+}
+
+type simpleEarnGetFlexibleRewardsHistoryServiceAPI SimpleEarnGetFlexibleRewardsHistoryService
+
+func (a *simpleEarnGetFlexibleRewardsHistoryServiceAPI) ProductId(productId string) SimpleEarnGetFlexibleRewardsHistoryServiceAPI {
+	return (*simpleEarnGetFlexibleRewardsHistoryServiceAPI)((*SimpleEarnGetFlexibleRewardsHistoryService)(a).ProductId(productId))
+}
+
+func (a *simpleEarnGetFlexibleRewardsHistoryServiceAPI) Asset(asset string) SimpleEarnGetFlexibleRewardsHistoryServiceAPI {
+	return (*simpleEarnGetFlexibleRewardsHistoryServiceAPI)((*SimpleEarnGetFlexibleRewardsHistoryService)(a).Asset(asset))
+}
+
+func (a *simpleEarnGetFlexibleRewardsHistoryServiceAPI) Type(rewardType SimpleEarnFlexibleRewardType) SimpleEarnGetFlexibleRewardsHistoryServiceAPI {
+	return (*simpleEarnGetFlexibleRewardsHistoryServiceAPI)((*SimpleEarnGetFlexibleRewardsHistoryService)(a).Type(rewardType))
+}
+
+func (a *simpleEarnGetFlexibleRewardsHistoryServiceAPI) StartTime(startTime int64) SimpleEarnGetFlexibleRewardsHistoryServiceAPI {
+	return (*simpleEarnGetFlexibleRewardsHistoryServiceAPI)((*SimpleEarnGetFlexibleRewardsHistoryService)(a).StartTime(startTime))
+}
+
+func (a *simpleEarnGetFlexibleRewardsHistoryServiceAPI) EndTime(endTime int64) SimpleEarnGetFlexibleRewardsHistoryServiceAPI {
+	return (*simpleEarnGetFlexibleRewardsHistoryServiceAPI)((*SimpleEarnGetFlexibleRewardsHistoryService)(a).EndTime(endTime))
+}
+
+func (a *simpleEarnGetFlexibleRewardsHistoryServiceAPI) Current(current int) SimpleEarnGetFlexibleRewardsHistoryServiceAPI {
+	return (*simpleEarnGetFlexibleRewardsHistoryServiceAPI)((*SimpleEarnGetFlexibleRewardsHistoryService)(a).Current(current))
+}
+
+func (a *simpleEarnGetFlexibleRewardsHistoryServiceAPI) Size(size int) SimpleEarnGetFlexibleRewardsHistoryServiceAPI {
+	return (*simpleEarnGetFlexibleRewardsHistoryServiceAPI)((*SimpleEarnGetFlexibleRewardsHistoryService)(a).Size(size))
+}
+
+func (a *simpleEarnGetFlexibleRewardsHistoryServiceAPI) Do(ctx context.Context, opts ...RequestOption) (*SimpleEarnFlexibleRewardsHistoryResp, error) {
+	return (*SimpleEarnGetFlexibleRewardsHistoryService)(a).Do(ctx, opts...)
+}
+
+// SimpleEarnListLockedProductServiceAPI is the interface of SimpleEarnListLockedProductService
+type SimpleEarnListLockedProductServiceAPI interface {
+	Asset(asset string) SimpleEarnListLockedProductServiceAPI
+	Current(current int) SimpleEarnListLockedProductServiceAPI
+	Size(size int) SimpleEarnListLockedProductServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) (*SimpleEarnLockedProductResp, error)
+}
+
+type simpleEarnListLockedProductServiceAPI SimpleEarnListLockedProductService
+
+func (a *simpleEarnListLockedProductServiceAPI) Asset(asset string) SimpleEarnListLockedProductServiceAPI {
+	return (*simpleEarnListLockedProductServiceAPI)((*SimpleEarnListLockedProductService)(a).Asset(asset))
+}
+
+func (a *simpleEarnListLockedProductServiceAPI) Current(current int) SimpleEarnListLockedProductServiceAPI {
+	return (*simpleEarnListLockedProductServiceAPI)((*SimpleEarnListLockedProductService)(a).Current(current))
+}
+
+func (a *simpleEarnListLockedProductServiceAPI) Size(size int) SimpleEarnListLockedProductServiceAPI {
+	return (*simpleEarnListLockedProductServiceAPI)((*SimpleEarnListLockedProductService)(a).Size(size))
+}
+
+func (a *simpleEarnListLockedProductServiceAPI) Do(ctx context.Context, opts ...RequestOption) (*SimpleEarnLockedProductResp, error) {
+	return (*SimpleEarnListLockedProductService)(a).Do(ctx, opts...)
+}
+
+// SimpleEarnGetLockedPositionServiceAPI is the interface of SimpleEarnGetLockedPositionService
+type SimpleEarnGetLockedPositionServiceAPI interface {
+	Asset(asset string) SimpleEarnGetLockedPositionServiceAPI
+	PositionId(positionId int) SimpleEarnGetLockedPositionServiceAPI
+	ProjectId(projectId string) SimpleEarnGetLockedPositionServiceAPI
+	Current(current int64) SimpleEarnGetLockedPositionServiceAPI
+	Size(size int64) SimpleEarnGetLockedPositionServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) (*SimpleEarnLockedPositionResp, error)
+}
+
+type simpleEarnGetLockedPositionServiceAPI SimpleEarnGetLockedPositionService
+
+func (a *simpleEarnGetLockedPositionServiceAPI) Asset(asset string) SimpleEarnGetLockedPositionServiceAPI {
+	return (*simpleEarnGetLockedPositionServiceAPI)((*SimpleEarnGetLockedPositionService)(a).Asset(asset))
+}
+
+func (a *simpleEarnGetLockedPositionServiceAPI) PositionId(positionId int) SimpleEarnGetLockedPositionServiceAPI {
+	return (*simpleEarnGetLockedPositionServiceAPI)((*SimpleEarnGetLockedPositionService)(a).PositionId(positionId))
+}
+
+func (a *simpleEarnGetLockedPositionServiceAPI) ProjectId(projectId string) SimpleEarnGetLockedPositionServiceAPI {
+	return (*simpleEarnGetLockedPositionServiceAPI)((*SimpleEarnGetLockedPositionService)(a).ProjectId(projectId))
+}
+
+func (a *simpleEarnGetLockedPositionServiceAPI) Current(current int64) SimpleEarnGetLockedPositionServiceAPI {
+	return (*simpleEarnGetLockedPositionServiceAPI)((*SimpleEarnGetLockedPositionService)(a).Current(current))
+}
+
+func (a *simpleEarnGetLockedPositionServiceAPI) Size(size int64) SimpleEarnGetLockedPositionServiceAPI {
+	return (*simpleEarnGetLockedPositionServiceAPI)((*SimpleEarnGetLockedPositionService)(a).Size(size))
+}
+
+func (a *simpleEarnGetLockedPositionServiceAPI) Do(ctx context.Context, opts ...RequestOption) (*SimpleEarnLockedPositionResp, error) {
+	return (*SimpleEarnGetLockedPositionService)(a).Do(ctx, opts...)
+}
+
+// SimpleEarnGetLockedQuotaServiceAPI is the interface of SimpleEarnGetLockedQuotaService
+type SimpleEarnGetLockedQuotaServiceAPI interface {
+	ProjectId(projectId string) SimpleEarnGetLockedQuotaServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) (*SimpleEarnLockedPersonalLeftQuotaResp, error)
+}
+
+type simpleEarnGetLockedQuotaServiceAPI SimpleEarnGetLockedQuotaService
+
+func (a *simpleEarnGetLockedQuotaServiceAPI) ProjectId(projectId string) SimpleEarnGetLockedQuotaServiceAPI {
+	return (*simpleEarnGetLockedQuotaServiceAPI)((*SimpleEarnGetLockedQuotaService)(a).ProjectId(projectId))
+}
+
+func (a *simpleEarnGetLockedQuotaServiceAPI) Do(ctx context.Context, opts ...RequestOption) (*SimpleEarnLockedPersonalLeftQuotaResp, error) {
+	return (*SimpleEarnGetLockedQuotaService)(a).Do(ctx, opts...)
+}
+
+// SimpleEarnSubscribeLockedProductServiceAPI is the interface of SimpleEarnSubscribeLockedProductService
+type SimpleEarnSubscribeLockedProductServiceAPI interface {
+	ProjectId(projectId string) SimpleEarnSubscribeLockedProductServiceAPI
+	Amount(amount string) SimpleEarnSubscribeLockedProductServiceAPI
+	AutoSubscribe(autoSubscribe bool) SimpleEarnSubscribeLockedProductServiceAPI
+	SourceAccount(sourceAccount SimpleEarnSubscribeSourceAccount) SimpleEarnSubscribeLockedProductServiceAPI
+	RedeemTo(redeemTo string) SimpleEarnSubscribeLockedProductServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) (*SimpleEarnSubscribeLockedProductResp, error)
+}
+
+type simpleEarnSubscribeLockedProductServiceAPI SimpleEarnSubscribeLockedProductService
+
+func (a *simpleEarnSubscribeLockedProductServiceAPI) ProjectId(projectId string) SimpleEarnSubscribeLockedProductServiceAPI {
+	return (*simpleEarnSubscribeLockedProductServiceAPI)((*SimpleEarnSubscribeLockedProductService)(a).ProjectId(projectId))
+}
+
+func (a *simpleEarnSubscribeLockedProductServiceAPI) Amount(amount string) SimpleEarnSubscribeLockedProductServiceAPI {
+	return (*simpleEarnSubscribeLockedProductServiceAPI)((*SimpleEarnSubscribeLockedProductService)(a).Amount(amount))
+}
+
+func (a *simpleEarnSubscribeLockedProductServiceAPI) AutoSubscribe(autoSubscribe bool) SimpleEarnSubscribeLockedProductServiceAPI {
+	return (*simpleEarnSubscribeLockedProductServiceAPI)((*SimpleEarnSubscribeLockedProductService)(a).AutoSubscribe(autoSubscribe))
+}
+
+func (a *simpleEarnSubscribeLockedProductServiceAPI) SourceAccount(sourceAccount SimpleEarnSubscribeSourceAccount) SimpleEarnSubscribeLockedProductServiceAPI {
+	return (*simpleEarnSubscribeLockedProductServiceAPI)((*SimpleEarnSubscribeLockedProductService)(a).SourceAccount(sourceAccount))
+}
+
+func (a *simpleEarnSubscribeLockedProductServiceAPI) RedeemTo(redeemTo string) SimpleEarnSubscribeLockedProductServiceAPI {
+	return (*simpleEarnSubscribeLockedProductServiceAPI)((*SimpleEarnSubscribeLockedProductService)(a).RedeemTo(redeemTo))
+}
+
+func (a *simpleEarnSubscribeLockedProductServiceAPI) Do(ctx context.Context, opts ...RequestOption) (*SimpleEarnSubscribeLockedProductResp, error) {
+	return (*SimpleEarnSubscribeLockedProductService)(a).Do(ctx, opts...)
+}
+
+// SimpleEarnRedeemLockedProductServiceAPI is the interface of SimpleEarnRedeemLockedProductService
+type SimpleEarnRedeemLockedProductServiceAPI interface {
+	PositionId(positionId int) SimpleEarnRedeemLockedProductServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) (*SimpleEarnRedeemLockedProductResp, error)
+}
+
+type simpleEarnRedeemLockedProductServiceAPI SimpleEarnRedeemLockedProductService
+
+func (a *simpleEarnRedeemLockedProductServiceAPI) PositionId(positionId int) SimpleEarnRedeemLockedProductServiceAPI {
+	return (*simpleEarnRedeemLockedProductServiceAPI)((*SimpleEarnRedeemLockedProductService)(a).PositionId(positionId))
+}
+
+func (a *simpleEarnRedeemLockedProductServiceAPI) Do(ctx context.Context, opts ...RequestOption) (*SimpleEarnRedeemLockedProductResp, error) {
+	return (*SimpleEarnRedeemLockedProductService)(a).Do(ctx, opts...)
+}
+
+// SimpleEarnSetAutoSubscribeLockedProductServiceAPI is the interface of SimpleEarnSetAutoSubscribeLockedProductService
+type SimpleEarnSetAutoSubscribeLockedProductServiceAPI interface {
+	PositionId(positionId int) SimpleEarnSetAutoSubscribeLockedProductServiceAPI
+	AutoSubscribe(autoSubscribe bool) SimpleEarnSetAutoSubscribeLockedProductServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) (*SimpleEarnSetAutoSubscribeLockedProductResp, error)
+}
+
+type simpleEarnSetAutoSubscribeLockedProductServiceAPI SimpleEarnSetAutoSubscribeLockedProductService
+
+func (a *simpleEarnSetAutoSubscribeLockedProductServiceAPI) PositionId(positionId int) SimpleEarnSetAutoSubscribeLockedProductServiceAPI {
+	return (*simpleEarnSetAutoSubscribeLockedProductServiceAPI)((*SimpleEarnSetAutoSubscribeLockedProductService)(a).PositionId(positionId))
+}
+
+func (a *simpleEarnSetAutoSubscribeLockedProductServiceAPI) AutoSubscribe(autoSubscribe bool) SimpleEarnSetAutoSubscribeLockedProductServiceAPI {
+	return (*simpleEarnSetAutoSubscribeLockedProductServiceAPI)((*SimpleEarnSetAutoSubscribeLockedProductService)(a).AutoSubscribe(autoSubscribe))
+}
+
+func (a *simpleEarnSetAutoSubscribeLockedProductServiceAPI) Do(ctx context.Context, opts ...RequestOption) (*SimpleEarnSetAutoSubscribeLockedProductResp, error) {
+	return (*SimpleEarnSetAutoSubscribeLockedProductService)(a).Do(ctx, opts...)
+}
+
+// SimpleEarnLockedSubscriptionPreviewServiceAPI is the interface of SimpleEarnLockedSubscriptionPreviewService
+type SimpleEarnLockedSubscriptionPreviewServiceAPI interface {
+	ProjectId(projectId string) SimpleEarnLockedSubscriptionPreviewServiceAPI
+	Amount(amount string) SimpleEarnLockedSubscriptionPreviewServiceAPI
+	AutoSubscribe(autoSubscribe bool) SimpleEarnLockedSubscriptionPreviewServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) (*SimpleEarnLockedSubscriptionPreviewResp, error)
+}
+
+type simpleEarnLockedSubscriptionPreviewServiceAPI SimpleEarnLockedSubscriptionPreviewService
+
+func (a *simpleEarnLockedSubscriptionPreviewServiceAPI) ProjectId(projectId string) SimpleEarnLockedSubscriptionPreviewServiceAPI {
+	return (*simpleEarnLockedSubscriptionPreviewServiceAPI)((*SimpleEarnLockedSubscriptionPreviewService)(a).ProjectId(projectId))
+}
+
+func (a *simpleEarnLockedSubscriptionPreviewServiceAPI) Amount(amount string) SimpleEarnLockedSubscriptionPreviewServiceAPI {
+	return (*simpleEarnLockedSubscriptionPreviewServiceAPI)((*SimpleEarnLockedSubscriptionPreviewService)(a).Amount(amount))
+}
+
+func (a *simpleEarnLockedSubscriptionPreviewServiceAPI) AutoSubscribe(autoSubscribe bool) SimpleEarnLockedSubscriptionPreviewServiceAPI {
+	return (*simpleEarnLockedSubscriptionPreviewServiceAPI)((*SimpleEarnLockedSubscriptionPreviewService)(a).AutoSubscribe(autoSubscribe))
+}
+
+func (a *simpleEarnLockedSubscriptionPreviewServiceAPI) Do(ctx context.Context, opts ...RequestOption) (*SimpleEarnLockedSubscriptionPreviewResp, error) {
+	return (*SimpleEarnLockedSubscriptionPreviewService)(a).Do(ctx, opts...)
+}
+
+// SimpleEarnSetRedeemOptionServiceAPI is the interface of SimpleEarnSetRedeemOptionService
+type SimpleEarnSetRedeemOptionServiceAPI interface {
+	PositionId(positionId string) SimpleEarnSetRedeemOptionServiceAPI
+	RedeemTo(redeemTo RedeemTo) SimpleEarnSetRedeemOptionServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) (*SimpleEarnSetRedeemOptionResp, error)
+}
+
+type simpleEarnSetRedeemOptionServiceAPI SimpleEarnSetRedeemOptionService
+
+func (a *simpleEarnSetRedeemOptionServiceAPI) PositionId(positionId string) SimpleEarnSetRedeemOptionServiceAPI {
+	return (*simpleEarnSetRedeemOptionServiceAPI)((*SimpleEarnSetRedeemOptionService)(a).PositionId(positionId))
+}
+
+func (a *simpleEarnSetRedeemOptionServiceAPI) RedeemTo(redeemTo RedeemTo) SimpleEarnSetRedeemOptionServiceAPI {
+	return (*simpleEarnSetRedeemOptionServiceAPI)((*SimpleEarnSetRedeemOptionService)(a).RedeemTo(redeemTo))
+}
+
+func (a *simpleEarnSetRedeemOptionServiceAPI) Do(ctx context.Context, opts ...RequestOption) (*SimpleEarnSetRedeemOptionResp, error) {
+	return (*SimpleEarnSetRedeemOptionService)(a).Do(ctx, opts...)
+}
+
+// SimpleEarnGetLockedRewardsHistoryServiceAPI is the interface of SimpleEarnGetLockedRewardsHistoryService
+type SimpleEarnGetLockedRewardsHistoryServiceAPI interface {
+	PositionId(positionId string) SimpleEarnGetLockedRewardsHistoryServiceAPI
+	Asset(asset string) SimpleEarnGetLockedRewardsHistoryServiceAPI
+	StartTime(startTime int64) SimpleEarnGetLockedRewardsHistoryServiceAPI
+	EndTime(endTime int64) SimpleEarnGetLockedRewardsHistoryServiceAPI
+	Current(current int) SimpleEarnGetLockedRewardsHistoryServiceAPI
+	Size(size int) SimpleEarnGetLockedRewardsHistoryServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) (*SimpleEarnLockedRewardsHistoryResp, error)
+}
+
+type simpleEarnGetLockedRewardsHistoryServiceAPI SimpleEarnGetLockedRewardsHistoryService
+
+func (a *simpleEarnGetLockedRewardsHistoryServiceAPI) PositionId(positionId string) SimpleEarnGetLockedRewardsHistoryServiceAPI {
+	return (*simpleEarnGetLockedRewardsHistoryServiceAPI)((*SimpleEarnGetLockedRewardsHistoryService)(a).PositionId(positionId))
+}
+
+func (a *simpleEarnGetLockedRewardsHistoryServiceAPI) Asset(asset string) SimpleEarnGetLockedRewardsHistoryServiceAPI {
+	return (*simpleEarnGetLockedRewardsHistoryServiceAPI)((*SimpleEarnGetLockedRewardsHistoryService)(a).Asset(asset))
+}
+
+func (a *simpleEarnGetLockedRewardsHistoryServiceAPI) StartTime(startTime int64) SimpleEarnGetLockedRewardsHistoryServiceAPI {
+	return (*simpleEarnGetLockedRewardsHistoryServiceAPI)((*SimpleEarnGetLockedRewardsHistoryService)(a).StartTime(startTime))
+}
+
+func (a *simpleEarnGetLockedRewardsHistoryServiceAPI) EndTime(endTime int64) SimpleEarnGetLockedRewardsHistoryServiceAPI {
+	return (*simpleEarnGetLockedRewardsHistoryServiceAPI)((*SimpleEarnGetLockedRewardsHistoryService)(a).EndTime(endTime))
+}
+
+func (a *simpleEarnGetLockedRewardsHistoryServiceAPI) Current(current int) SimpleEarnGetLockedRewardsHistoryServiceAPI {
+	return (*simpleEarnGetLockedRewardsHistoryServiceAPI)((*SimpleEarnGetLockedRewardsHistoryService)(a).Current(current))
+}
+
+func (a *simpleEarnGetLockedRewardsHistoryServiceAPI) Size(size int) SimpleEarnGetLockedRewardsHistoryServiceAPI {
+	return (*simpleEarnGetLockedRewardsHistoryServiceAPI)((*SimpleEarnGetLockedRewardsHistoryService)(a).Size(size))
+}
+
+func (a *simpleEarnGetLockedRewardsHistoryServiceAPI) Do(ctx context.Context, opts ...RequestOption) (*SimpleEarnLockedRewardsHistoryResp, error) {
+	return (*SimpleEarnGetLockedRewardsHistoryService)(a).Do(ctx, opts...)
+}
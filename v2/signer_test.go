@@ -0,0 +1,65 @@
+package binance
+
+import (
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/adshao/go-binance/v2/common"
+)
+
+type signerTestSuite struct {
+	baseTestSuite
+}
+
+func TestSigner(t *testing.T) {
+	suite.Run(t, new(signerTestSuite))
+}
+
+func (s *signerTestSuite) signature(r *request) string {
+	u, err := url.Parse(r.fullURL)
+	s.r().NoError(err)
+	return u.Query().Get(signatureKey)
+}
+
+// signedQuery returns the query string preceding the signature
+func (s *signerTestSuite) signedQuery(r *request) string {
+	u, err := url.Parse(r.fullURL)
+	s.r().NoError(err)
+	return strings.Split(u.RawQuery, "&"+signatureKey+"=")[0]
+}
+
+func (s *signerTestSuite) TestSecretKey() {
+	r := &request{method: http.MethodGet, endpoint: "/api/v3/account", secType: secTypeSigned}
+	s.r().NoError(s.client.parseRequest(r))
+	expected, err := common.NewHmacSigner(s.secretKey).Sign([]byte(s.signedQuery(r)))
+	s.r().NoError(err)
+	s.r().Equal(expected, s.signature(r))
+}
+
+func (s *signerTestSuite) TestSigner() {
+	var payloads []string
+	signer, err := common.NewExternalSigner(common.KeyTypeEd25519, func(payload []byte) ([]byte, error) {
+		payloads = append(payloads, string(payload))
+		return []byte{1, 2, 3}, nil
+	})
+	s.r().NoError(err)
+	s.client.SecretKey = ""
+	s.client.SetSigner(signer)
+	s.r().Equal(common.KeyTypeEd25519, s.client.KeyType)
+
+	r := &request{method: http.MethodPost, endpoint: "/api/v3/order", secType: secTypeSigned}
+	r.setFormParam("symbol", "BTCUSDT")
+	s.r().NoError(s.client.parseRequest(r))
+	s.r().Equal("AQID", s.signature(r))
+	s.r().Equal([]string{s.signedQuery(r) + "symbol=BTCUSDT"}, payloads)
+
+	// unsigned requests are not signed
+	r = &request{method: http.MethodGet, endpoint: "/api/v3/ping", secType: secTypeNone}
+	s.r().NoError(s.client.parseRequest(r))
+	s.r().Empty(s.signature(r))
+	s.r().Len(payloads, 1)
+}
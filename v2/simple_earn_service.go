@@ -55,6 +55,10 @@ func (s *SimpleEarnFlexibleService) PreviewSubscribe() *SimpleEarnFlexibleSubscr
 	return &SimpleEarnFlexibleSubscriptionPreviewService{c: s.c}
 }
 
+func (s *SimpleEarnFlexibleService) GetRewardsHistory() *SimpleEarnGetFlexibleRewardsHistoryService {
+	return &SimpleEarnGetFlexibleRewardsHistoryService{c: s.c}
+}
+
 // --
 
 type SimpleEarnLockedService struct {
@@ -88,6 +92,10 @@ func (s *SimpleEarnLockedService) SetRedeemOption() *SimpleEarnSetRedeemOptionSe
 	return &SimpleEarnSetRedeemOptionService{c: s.c}
 }
 
+func (s *SimpleEarnLockedService) GetRewardsHistory() *SimpleEarnGetLockedRewardsHistoryService {
+	return &SimpleEarnGetLockedRewardsHistoryService{c: s.c}
+}
+
 // --------------------
 
 type SimpleEarnGetAccountService struct {
@@ -1039,3 +1047,207 @@ func (s *SimpleEarnSetRedeemOptionService) Do(ctx context.Context, opts ...Reque
 	}
 	return res, nil
 }
+
+// --------------------
+
+type SimpleEarnFlexibleRewardType string
+
+const (
+	SimpleEarnFlexibleRewardTypeBonus    SimpleEarnFlexibleRewardType = "BONUS"
+	SimpleEarnFlexibleRewardTypeRealTime SimpleEarnFlexibleRewardType = "REALTIME"
+	SimpleEarnFlexibleRewardTypeRewards  SimpleEarnFlexibleRewardType = "REWARDS"
+	SimpleEarnFlexibleRewardTypeAll      SimpleEarnFlexibleRewardType = "ALL"
+)
+
+type SimpleEarnGetFlexibleRewardsHistoryService struct {
+	c          *Client
+	productId  string
+	asset      string
+	rewardType SimpleEarnFlexibleRewardType
+	startTime  int64
+	endTime    int64
+	current    int
+	size       int
+}
+
+func (s *SimpleEarnGetFlexibleRewardsHistoryService) ProductId(productId string) *SimpleEarnGetFlexibleRewardsHistoryService {
+	s.productId = productId
+	return s
+}
+
+func (s *SimpleEarnGetFlexibleRewardsHistoryService) Asset(asset string) *SimpleEarnGetFlexibleRewardsHistoryService {
+	s.asset = asset
+	return s
+}
+
+// Type set the reward type, mandatory
+func (s *SimpleEarnGetFlexibleRewardsHistoryService) Type(rewardType SimpleEarnFlexibleRewardType) *SimpleEarnGetFlexibleRewardsHistoryService {
+	s.rewardType = rewardType
+	return s
+}
+
+func (s *SimpleEarnGetFlexibleRewardsHistoryService) StartTime(startTime int64) *SimpleEarnGetFlexibleRewardsHistoryService {
+	s.startTime = startTime
+	return s
+}
+
+func (s *SimpleEarnGetFlexibleRewardsHistoryService) EndTime(endTime int64) *SimpleEarnGetFlexibleRewardsHistoryService {
+	s.endTime = endTime
+	return s
+}
+
+func (s *SimpleEarnGetFlexibleRewardsHistoryService) Current(current int) *SimpleEarnGetFlexibleRewardsHistoryService {
+	s.current = current
+	return s
+}
+
+func (s *SimpleEarnGetFlexibleRewardsHistoryService) Size(size int) *SimpleEarnGetFlexibleRewardsHistoryService {
+	s.size = size
+	return s
+}
+
+type SimpleEarnFlexibleRewardsHistoryResp struct {
+	Rows  []SimpleEarnFlexibleReward `json:"rows"`
+	Total int                        `json:"total"`
+}
+
+type SimpleEarnFlexibleReward struct {
+	Asset     string `json:"asset"`
+	Rewards   string `json:"rewards"`
+	ProjectId string `json:"projectId"`
+	Type      string `json:"type"`
+	Time      int64  `json:"time"`
+}
+
+func (s *SimpleEarnGetFlexibleRewardsHistoryService) Do(ctx context.Context, opts ...RequestOption) (res *SimpleEarnFlexibleRewardsHistoryResp, err error) {
+	r := &request{
+		method:   http.MethodGet,
+		endpoint: "/sapi/v1/simple-earn/flexible/history/rewardsRecord",
+		secType:  secTypeSigned,
+	}
+
+	r.setParam("type", string(s.rewardType))
+	if s.productId != "" {
+		r.setParam("productId", s.productId)
+	}
+	if s.asset != "" {
+		r.setParam("asset", s.asset)
+	}
+	if s.startTime != 0 {
+		r.setParam("startTime", s.startTime)
+	}
+	if s.endTime != 0 {
+		r.setParam("endTime", s.endTime)
+	}
+	if s.current != 0 {
+		r.setParam("current", s.current)
+	}
+	if s.size != 0 {
+		r.setParam("size", s.size)
+	}
+
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	res = new(SimpleEarnFlexibleRewardsHistoryResp)
+	if err := json.Unmarshal(data, res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// --------------------
+
+type SimpleEarnGetLockedRewardsHistoryService struct {
+	c          *Client
+	positionId string
+	asset      string
+	startTime  int64
+	endTime    int64
+	current    int
+	size       int
+}
+
+func (s *SimpleEarnGetLockedRewardsHistoryService) PositionId(positionId string) *SimpleEarnGetLockedRewardsHistoryService {
+	s.positionId = positionId
+	return s
+}
+
+func (s *SimpleEarnGetLockedRewardsHistoryService) Asset(asset string) *SimpleEarnGetLockedRewardsHistoryService {
+	s.asset = asset
+	return s
+}
+
+func (s *SimpleEarnGetLockedRewardsHistoryService) StartTime(startTime int64) *SimpleEarnGetLockedRewardsHistoryService {
+	s.startTime = startTime
+	return s
+}
+
+func (s *SimpleEarnGetLockedRewardsHistoryService) EndTime(endTime int64) *SimpleEarnGetLockedRewardsHistoryService {
+	s.endTime = endTime
+	return s
+}
+
+func (s *SimpleEarnGetLockedRewardsHistoryService) Current(current int) *SimpleEarnGetLockedRewardsHistoryService {
+	s.current = current
+	return s
+}
+
+func (s *SimpleEarnGetLockedRewardsHistoryService) Size(size int) *SimpleEarnGetLockedRewardsHistoryService {
+	s.size = size
+	return s
+}
+
+type SimpleEarnLockedRewardsHistoryResp struct {
+	Rows  []SimpleEarnLockedReward `json:"rows"`
+	Total int                      `json:"total"`
+}
+
+type SimpleEarnLockedReward struct {
+	PositionId string `json:"positionId"`
+	Time       int64  `json:"time"`
+	Asset      string `json:"asset"`
+	LockPeriod string `json:"lockPeriod"`
+	Amount     string `json:"amount"`
+	Type       string `json:"type"`
+}
+
+func (s *SimpleEarnGetLockedRewardsHistoryService) Do(ctx context.Context, opts ...RequestOption) (res *SimpleEarnLockedRewardsHistoryResp, err error) {
+	r := &request{
+		method:   http.MethodGet,
+		endpoint: "/sapi/v1/simple-earn/locked/history/rewardsRecord",
+		secType:  secTypeSigned,
+	}
+
+	if s.positionId != "" {
+		r.setParam("positionId", s.positionId)
+	}
+	if s.asset != "" {
+		r.setParam("asset", s.asset)
+	}
+	if s.startTime != 0 {
+		r.setParam("startTime", s.startTime)
+	}
+	if s.endTime != 0 {
+		r.setParam("endTime", s.endTime)
+	}
+	if s.current != 0 {
+		r.setParam("current", s.current)
+	}
+	if s.size != 0 {
+		r.setParam("size", s.size)
+	}
+
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	res = new(SimpleEarnLockedRewardsHistoryResp)
+	if err := json.Unmarshal(data, res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
@@ -593,3 +593,88 @@ func (s *simpleEarnServiceTestSuite) TestLockedSetRedeemOption() {
 	s.r().NoError(err)
 	s.r().Equal(true, resp.Success)
 }
+
+func (s *simpleEarnServiceTestSuite) TestGetFlexibleRewardsHistory() {
+	data := []byte(`{
+		"rows": [
+			{
+				"asset": "BUSD",
+				"rewards": "0.00006408",
+				"projectId": "USDT001",
+				"type": "BONUS",
+				"time": 1577233578000
+			}
+		],
+		"total": 1
+	}`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+
+	s.assertReq(func(r *request) {
+		e := newSignedRequest().setParams(params{
+			"type":      "BONUS",
+			"asset":     "BUSD",
+			"startTime": int64(1577233578000),
+			"endTime":   int64(1577233579000),
+			"current":   2,
+			"size":      100,
+		})
+		s.assertRequestEqual(e, r)
+	})
+
+	res, err := s.client.NewSimpleEarnService().FlexibleService().GetRewardsHistory().
+		Type(SimpleEarnFlexibleRewardTypeBonus).
+		Asset("BUSD").
+		StartTime(1577233578000).
+		EndTime(1577233579000).
+		Current(2).
+		Size(100).
+		Do(newContext())
+	s.r().NoError(err)
+	s.r().Equal(&SimpleEarnFlexibleRewardsHistoryResp{
+		Rows: []SimpleEarnFlexibleReward{
+			{Asset: "BUSD", Rewards: "0.00006408", ProjectId: "USDT001", Type: "BONUS", Time: 1577233578000},
+		},
+		Total: 1,
+	}, res)
+}
+
+func (s *simpleEarnServiceTestSuite) TestGetLockedRewardsHistory() {
+	data := []byte(`{
+		"rows": [
+			{
+				"positionId": "123123",
+				"time": 1575018453000,
+				"asset": "BNB",
+				"lockPeriod": "30",
+				"amount": "21312.23223",
+				"type": "Locked Rewards"
+			}
+		],
+		"total": 1
+	}`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+
+	s.assertReq(func(r *request) {
+		e := newSignedRequest().setParams(params{
+			"positionId": "123123",
+			"startTime":  int64(1575018453000),
+			"current":    1,
+		})
+		s.assertRequestEqual(e, r)
+	})
+
+	res, err := s.client.NewSimpleEarnService().LockedService().GetRewardsHistory().
+		PositionId("123123").
+		StartTime(1575018453000).
+		Current(1).
+		Do(newContext())
+	s.r().NoError(err)
+	s.r().Equal(&SimpleEarnLockedRewardsHistoryResp{
+		Rows: []SimpleEarnLockedReward{
+			{PositionId: "123123", Time: 1575018453000, Asset: "BNB", LockPeriod: "30", Amount: "21312.23223", Type: "Locked Rewards"},
+		},
+		Total: 1,
+	}, res)
+}
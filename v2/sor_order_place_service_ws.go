@@ -14,6 +14,8 @@ type SorOrderPlaceWsService struct {
 	ApiKey     string
 	SecretKey  string
 	KeyType    string
+	Signer     common.Signer       // signs requests instead of SecretKey when set
+	signers    *common.SignerCache // parses SecretKey once
 	TimeOffset int64
 }
 
@@ -34,6 +36,7 @@ func NewSorOrderPlaceWsService(apiKey, secretKey string) (*SorOrderPlaceWsServic
 		ApiKey:    apiKey,
 		SecretKey: secretKey,
 		KeyType:   common.KeyTypeHmac,
+		signers:   new(common.SignerCache),
 	}, nil
 }
 
@@ -112,7 +115,7 @@ func (s *SorOrderPlaceWsService) Do(requestID string, request *SorOrderPlaceWsRe
 			s.SecretKey,
 			s.TimeOffset,
 			s.KeyType,
-		),
+		).WithSigner(s.Signer).WithSignerCache(s.signers),
 		websocket.SorOrderPlaceSpotWsApiMethod,
 		request.buildParams(),
 	)
@@ -136,7 +139,7 @@ func (s *SorOrderPlaceWsService) SyncDo(requestID string, request *SorOrderPlace
 			s.SecretKey,
 			s.TimeOffset,
 			s.KeyType,
-		),
+		).WithSigner(s.Signer).WithSignerCache(s.signers),
 		websocket.SorOrderPlaceSpotWsApiMethod,
 		request.buildParams(),
 	)
@@ -14,6 +14,8 @@ type SorOrderTestWsService struct {
 	ApiKey     string
 	SecretKey  string
 	KeyType    string
+	Signer     common.Signer       // signs requests instead of SecretKey when set
+	signers    *common.SignerCache // parses SecretKey once
 	TimeOffset int64
 }
 
@@ -34,6 +36,7 @@ func NewSorOrderTestWsService(apiKey, secretKey string) (*SorOrderTestWsService,
 		ApiKey:    apiKey,
 		SecretKey: secretKey,
 		KeyType:   common.KeyTypeHmac,
+		signers:   new(common.SignerCache),
 	}, nil
 }
 
@@ -110,7 +113,7 @@ func (s *SorOrderTestWsService) Do(requestID string, request *SorOrderTestWsRequ
 			s.SecretKey,
 			s.TimeOffset,
 			s.KeyType,
-		),
+		).WithSigner(s.Signer).WithSignerCache(s.signers),
 		websocket.SorOrderTestSpotWsApiMethod,
 		request.buildParams(),
 	)
@@ -134,7 +137,7 @@ func (s *SorOrderTestWsService) SyncDo(requestID string, request *SorOrderTestWs
 			s.SecretKey,
 			s.TimeOffset,
 			s.KeyType,
-		),
+		).WithSigner(s.Signer).WithSignerCache(s.signers),
 		websocket.SorOrderTestSpotWsApiMethod,
 		request.buildParams(),
 	)
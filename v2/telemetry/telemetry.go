@@ -0,0 +1,217 @@
+// Package telemetry provides optional OpenTelemetry instrumentation for the REST clients,
+// the WebSocket API clients of common/websocket and the market and user data streams.
+//
+// REST calls are instrumented through the client middleware chain:
+//
+//	t, err := telemetry.New(nil, nil) // global tracer and meter providers
+//	client.Use(t.Middleware(telemetry.ClientSpot))
+//	t.ObserveUsage(telemetry.ClientSpot, &client.UsedWeight, &client.OrderCount)
+//
+// WebSocket API requests are instrumented by wrapping the clients created by common/websocket:
+//
+//	wsClient, err := client.NewWsApiClient(binance.WsOptions{WrapClient: t.WrapWsClient})
+package telemetry
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/adshao/go-binance/v2/common"
+)
+
+// ScopeName is the instrumentation scope of the tracer and meter
+const ScopeName = "github.com/adshao/go-binance/v2/telemetry"
+
+// Client names used as the binance.client attribute
+const (
+	ClientSpot         = "spot"
+	ClientFutures      = "futures"
+	ClientDelivery     = "delivery"
+	ClientOptions      = "options"
+	ClientPortfolio    = "portfolio"
+	ClientPortfolioPro = "portfolio_pro"
+)
+
+// Attribute keys
+const (
+	AttributeClient      = attribute.Key("binance.client")
+	AttributeEndpoint    = attribute.Key("binance.endpoint")
+	AttributeMethod      = attribute.Key("binance.ws.method")
+	AttributeStream      = attribute.Key("binance.stream")
+	AttributeErrorCode   = attribute.Key("binance.error.code")
+	AttributeUsedWeight  = attribute.Key("binance.used_weight")
+	AttributeOrderCount  = attribute.Key("binance.order_count")
+	AttributeInterval    = attribute.Key("binance.interval")
+	AttributeHTTPMethod  = attribute.Key("http.request.method")
+	AttributeHTTPStatus  = attribute.Key("http.response.status_code")
+	AttributeWsAPIStatus = attribute.Key("binance.ws.status")
+)
+
+// Telemetry define the tracer and instruments shared by all instrumented clients
+type Telemetry struct {
+	tracer          trace.Tracer
+	meter           metric.Meter
+	restDuration    metric.Float64Histogram
+	wsAPIDuration   metric.Float64Histogram
+	messageLag      metric.Float64Histogram
+	usedWeight      metric.Int64ObservableGauge
+	orderCount      metric.Int64ObservableGauge
+	reconnectsTotal metric.Int64ObservableCounter
+
+	mu         sync.Mutex
+	wsClients  map[*wsClient]struct{}
+	reconnects int64 // reconnections of closed clients
+}
+
+// New init telemetry from the given providers, nil providers use the global ones
+func New(tp trace.TracerProvider, mp metric.MeterProvider) (*Telemetry, error) {
+	if tp == nil {
+		tp = otel.GetTracerProvider()
+	}
+	if mp == nil {
+		mp = otel.GetMeterProvider()
+	}
+	t := &Telemetry{
+		tracer:    tp.Tracer(ScopeName),
+		meter:     mp.Meter(ScopeName),
+		wsClients: make(map[*wsClient]struct{}),
+	}
+	var err error
+	if t.restDuration, err = t.meter.Float64Histogram("binance.rest.duration",
+		metric.WithDescription("Duration of REST API calls"), metric.WithUnit("s")); err != nil {
+		return nil, err
+	}
+	if t.wsAPIDuration, err = t.meter.Float64Histogram("binance.ws.api.duration",
+		metric.WithDescription("Duration of WebSocket API requests"), metric.WithUnit("s")); err != nil {
+		return nil, err
+	}
+	if t.messageLag, err = t.meter.Float64Histogram("binance.ws.message.lag",
+		metric.WithDescription("Delay between the event time of a stream message and its reception"), metric.WithUnit("s")); err != nil {
+		return nil, err
+	}
+	if t.usedWeight, err = t.meter.Int64ObservableGauge("binance.rest.used_weight",
+		metric.WithDescription("Request weight used in the current interval, as reported by the API")); err != nil {
+		return nil, err
+	}
+	if t.orderCount, err = t.meter.Int64ObservableGauge("binance.rest.order_count",
+		metric.WithDescription("Orders placed in the current interval, as reported by the API")); err != nil {
+		return nil, err
+	}
+	if t.reconnectsTotal, err = t.meter.Int64ObservableCounter("binance.ws.reconnects",
+		metric.WithDescription("WebSocket API reconnection attempts")); err != nil {
+		return nil, err
+	}
+	if _, err = t.meter.RegisterCallback(t.observeReconnects, t.reconnectsTotal); err != nil {
+		return nil, err
+	}
+	return t, nil
+}
+
+// Middleware returns a REST middleware creating a span per call and recording its duration,
+// the used weight and order count headers and the API error code
+func (t *Telemetry) Middleware(client string) common.Middleware {
+	return func(next common.Handler) common.Handler {
+		return func(req *http.Request) (*common.Response, error) {
+			endpoint := req.URL.Path
+			attrs := []attribute.KeyValue{
+				AttributeClient.String(client),
+				AttributeHTTPMethod.String(req.Method),
+				AttributeEndpoint.String(endpoint),
+			}
+			ctx, span := t.tracer.Start(req.Context(), req.Method+" "+endpoint,
+				trace.WithSpanKind(trace.SpanKindClient), trace.WithAttributes(attrs...))
+			defer span.End()
+
+			start := time.Now()
+			res, err := next(req.WithContext(ctx))
+			elapsed := time.Since(start)
+
+			if res != nil {
+				attrs = append(attrs, AttributeHTTPStatus.Int(res.StatusCode))
+				span.SetAttributes(AttributeHTTPStatus.Int(res.StatusCode))
+				if w, ok := headerInt(res.Header, "X-Mbx-Used-Weight-1m"); ok {
+					span.SetAttributes(AttributeUsedWeight.Int64(w))
+				}
+				if n, ok := headerInt(res.Header, "X-Mbx-Order-Count-10s"); ok {
+					span.SetAttributes(AttributeOrderCount.Int64(n))
+				}
+			}
+			if err != nil {
+				if code, ok := apiErrorCode(err); ok {
+					span.SetAttributes(AttributeErrorCode.Int64(code))
+				}
+				span.RecordError(err)
+				span.SetStatus(codes.Error, err.Error())
+			}
+			t.restDuration.Record(ctx, elapsed.Seconds(), metric.WithAttributes(attrs...))
+			return res, err
+		}
+	}
+}
+
+func headerInt(header http.Header, key string) (int64, bool) {
+	v := header.Get(key)
+	if v == "" {
+		return 0, false
+	}
+	n, err := strconv.ParseInt(v, 10, 64)
+	return n, err == nil
+}
+
+// apiErrorCode returns the code of API errors, including the errors wrapping them
+func apiErrorCode(err error) (int64, bool) {
+	var apiErr *common.APIError
+	if errors.As(err, &apiErr) {
+		return apiErr.Code, true
+	}
+	return 0, false
+}
+
+// ObserveUsage reports the used weight and order count of a client as gauges, read from
+// the UsedWeight and OrderCount fields of the client on each collection
+func (t *Telemetry) ObserveUsage(client string, weight *common.UsedWeight, orders *common.OrderCount) (metric.Registration, error) {
+	return t.meter.RegisterCallback(func(_ context.Context, o metric.Observer) error {
+		if weight != nil {
+			o.ObserveInt64(t.usedWeight, atomic.LoadInt64(&weight.Used1M),
+				metric.WithAttributes(AttributeClient.String(client), AttributeInterval.String("1m")))
+		}
+		if orders != nil {
+			o.ObserveInt64(t.orderCount, atomic.LoadInt64(&orders.Count10s),
+				metric.WithAttributes(AttributeClient.String(client), AttributeInterval.String("10s")))
+			o.ObserveInt64(t.orderCount, atomic.LoadInt64(&orders.Count1d),
+				metric.WithAttributes(AttributeClient.String(client), AttributeInterval.String("1d")))
+		}
+		return nil
+	}, t.usedWeight, t.orderCount)
+}
+
+// RecordMessageLag records the delay between the event time of a stream message,
+// in milliseconds, and now
+func (t *Telemetry) RecordMessageLag(ctx context.Context, stream string, eventTime int64) {
+	if eventTime <= 0 {
+		return
+	}
+	lag := time.Since(time.UnixMilli(eventTime))
+	t.messageLag.Record(ctx, lag.Seconds(), metric.WithAttributes(AttributeStream.String(stream)))
+}
+
+// LagHandler wraps a stream handler to record the lag of each event, e.g.
+//
+//	handler = telemetry.LagHandler(t, "depth", func(e *binance.WsDepthEvent) int64 { return e.Time }, handler)
+func LagHandler[E any](t *Telemetry, stream string, eventTime func(*E) int64, next func(*E)) func(*E) {
+	return func(event *E) {
+		t.RecordMessageLag(context.Background(), stream, eventTime(event))
+		next(event)
+	}
+}
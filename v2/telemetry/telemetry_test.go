@@ -0,0 +1,192 @@
+package telemetry
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+
+	"github.com/adshao/go-binance/v2/common"
+	"github.com/adshao/go-binance/v2/portfolio"
+)
+
+type baseTestSuite struct {
+	suite.Suite
+	spans  *tracetest.SpanRecorder
+	reader *sdkmetric.ManualReader
+	t      *Telemetry
+}
+
+func (s *baseTestSuite) SetupTest() {
+	s.spans = tracetest.NewSpanRecorder()
+	s.reader = sdkmetric.NewManualReader()
+	t, err := New(
+		sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(s.spans)),
+		sdkmetric.NewMeterProvider(sdkmetric.WithReader(s.reader)),
+	)
+	s.Require().NoError(err)
+	s.t = t
+}
+
+// metric returns the collected metric with the given name
+func (s *baseTestSuite) metric(name string) metricdata.Metrics {
+	rm := metricdata.ResourceMetrics{}
+	s.Require().NoError(s.reader.Collect(context.Background(), &rm))
+	for _, sm := range rm.ScopeMetrics {
+		for _, m := range sm.Metrics {
+			if m.Name == name {
+				return m
+			}
+		}
+	}
+	s.FailNow("metric not found", name)
+	return metricdata.Metrics{}
+}
+
+func (s *baseTestSuite) spanAttribute(span sdktrace.ReadOnlySpan, key attribute.Key) (attribute.Value, bool) {
+	for _, kv := range span.Attributes() {
+		if kv.Key == key {
+			return kv.Value, true
+		}
+	}
+	return attribute.Value{}, false
+}
+
+type telemetryTestSuite struct {
+	baseTestSuite
+}
+
+func TestTelemetry(t *testing.T) {
+	suite.Run(t, new(telemetryTestSuite))
+}
+
+func (s *telemetryTestSuite) TestMiddleware() {
+	r := s.Require()
+	header := http.Header{}
+	header.Set("X-Mbx-Used-Weight-1m", "42")
+	header.Set("X-Mbx-Order-Count-10s", "3")
+	h := common.Chain(func(req *http.Request) (*common.Response, error) {
+		return &common.Response{StatusCode: http.StatusOK, Header: header, Body: []byte(`{}`)}, nil
+	}, s.t.Middleware(ClientSpot))
+
+	req, _ := http.NewRequest(http.MethodPost, "https://api.binance.com/api/v3/order?symbol=BTCUSDT", nil)
+	res, err := h(req)
+	r.NoError(err)
+	r.Equal(http.StatusOK, res.StatusCode)
+
+	spans := s.spans.Ended()
+	r.Len(spans, 1)
+	r.Equal("POST /api/v3/order", spans[0].Name())
+	v, ok := s.spanAttribute(spans[0], AttributeUsedWeight)
+	r.True(ok)
+	r.Equal(int64(42), v.AsInt64())
+	v, ok = s.spanAttribute(spans[0], AttributeOrderCount)
+	r.True(ok)
+	r.Equal(int64(3), v.AsInt64())
+	v, ok = s.spanAttribute(spans[0], AttributeClient)
+	r.True(ok)
+	r.Equal(ClientSpot, v.AsString())
+
+	hist := s.metric("binance.rest.duration").Data.(metricdata.Histogram[float64])
+	r.Len(hist.DataPoints, 1)
+	r.Equal(uint64(1), hist.DataPoints[0].Count)
+	endpoint, _ := hist.DataPoints[0].Attributes.Value(AttributeEndpoint)
+	r.Equal("/api/v3/order", endpoint.AsString())
+}
+
+func (s *telemetryTestSuite) TestMiddlewareAPIError() {
+	r := s.Require()
+	h := common.Chain(func(req *http.Request) (*common.Response, error) {
+		return &common.Response{StatusCode: http.StatusBadRequest, Header: http.Header{}},
+			&common.APIError{Code: -1121, Message: "Invalid symbol."}
+	}, s.t.Middleware(ClientFutures))
+
+	req, _ := http.NewRequest(http.MethodGet, "https://fapi.binance.com/fapi/v1/depth", nil)
+	_, err := h(req)
+	r.Error(err)
+
+	spans := s.spans.Ended()
+	r.Len(spans, 1)
+	r.Equal(codes.Error, spans[0].Status().Code)
+	v, ok := s.spanAttribute(spans[0], AttributeErrorCode)
+	r.True(ok)
+	r.Equal(int64(-1121), v.AsInt64())
+	v, ok = s.spanAttribute(spans[0], AttributeHTTPStatus)
+	r.True(ok)
+	r.Equal(int64(http.StatusBadRequest), v.AsInt64())
+
+	// transport errors have no response
+	h = common.Chain(func(req *http.Request) (*common.Response, error) {
+		return nil, errors.New("dummy error")
+	}, s.t.Middleware(ClientFutures))
+	_, err = h(req)
+	r.Error(err)
+	spans = s.spans.Ended()
+	r.Len(spans, 2)
+	_, ok = s.spanAttribute(spans[1], AttributeErrorCode)
+	r.False(ok)
+
+	// errors wrapping an APIError
+	h = common.Chain(func(req *http.Request) (*common.Response, error) {
+		return &common.Response{StatusCode: http.StatusBadRequest, Header: http.Header{}},
+			portfolio.NewError(portfolio.ErrNoSuchOrder, "Order does not exist.")
+	}, s.t.Middleware(ClientPortfolio))
+	_, err = h(req)
+	r.Error(err)
+	spans = s.spans.Ended()
+	r.Len(spans, 3)
+	v, ok = s.spanAttribute(spans[2], AttributeErrorCode)
+	r.True(ok)
+	r.Equal(int64(portfolio.ErrNoSuchOrder), v.AsInt64())
+}
+
+func (s *telemetryTestSuite) TestObserveUsage() {
+	r := s.Require()
+	weight := &common.UsedWeight{Used1M: 120}
+	orders := &common.OrderCount{Count10s: 5, Count1d: 1000}
+	_, err := s.t.ObserveUsage(ClientSpot, weight, orders)
+	r.NoError(err)
+
+	gauge := s.metric("binance.rest.used_weight").Data.(metricdata.Gauge[int64])
+	r.Len(gauge.DataPoints, 1)
+	r.Equal(int64(120), gauge.DataPoints[0].Value)
+
+	gauge = s.metric("binance.rest.order_count").Data.(metricdata.Gauge[int64])
+	r.Len(gauge.DataPoints, 2)
+	values := map[string]int64{}
+	for _, dp := range gauge.DataPoints {
+		interval, _ := dp.Attributes.Value(AttributeInterval)
+		values[interval.AsString()] = dp.Value
+	}
+	r.Equal(map[string]int64{"10s": 5, "1d": 1000}, values)
+}
+
+type event struct {
+	Time int64
+}
+
+func (s *telemetryTestSuite) TestMessageLag() {
+	r := s.Require()
+	var received *event
+	handler := LagHandler(s.t, "depth", func(e *event) int64 { return e.Time }, func(e *event) {
+		received = e
+	})
+	e := &event{Time: time.Now().Add(-time.Second).UnixMilli()}
+	handler(e)
+	r.Equal(e, received)
+
+	hist := s.metric("binance.ws.message.lag").Data.(metricdata.Histogram[float64])
+	r.Len(hist.DataPoints, 1)
+	r.GreaterOrEqual(hist.DataPoints[0].Sum, 1.0)
+	stream, _ := hist.DataPoints[0].Attributes.Value(AttributeStream)
+	r.Equal("depth", stream.AsString())
+}
@@ -0,0 +1,190 @@
+package telemetry
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/adshao/go-binance/v2/common/websocket"
+)
+
+// wsRequest define the fields of a WebSocket API request used by telemetry
+type wsRequest struct {
+	Method string `json:"method"`
+}
+
+// wsResponse define the fields of a WebSocket API response used by telemetry
+type wsResponse struct {
+	Id     string `json:"id"`
+	Status int    `json:"status"`
+	Error  *struct {
+		Code    int64  `json:"code"`
+		Message string `json:"msg"`
+	} `json:"error"`
+	RateLimits []struct {
+		RateLimitType string `json:"rateLimitType"`
+		Interval      string `json:"interval"`
+		IntervalNum   int    `json:"intervalNum"`
+		Count         int64  `json:"count"`
+	} `json:"rateLimits"`
+}
+
+// pendingRequest define an asynchronous request waiting for its response
+type pendingRequest struct {
+	span   trace.Span
+	method string
+	start  time.Time
+}
+
+// wsClient define a websocket.Client creating a span per WebSocket API request
+type wsClient struct {
+	websocket.Client
+	t *Telemetry
+
+	mu       sync.Mutex
+	pending  map[string]*pendingRequest
+	readOnce sync.Once
+	readC    chan []byte
+}
+
+// WrapWsClient wraps a WebSocket API client to create a span per request and report its
+// reconnections. It is installed with the WrapClient connection option, e.g.:
+//
+//	wsClient, err := client.NewWsApiClient(binance.WsOptions{WrapClient: t.WrapWsClient})
+func (t *Telemetry) WrapWsClient(c websocket.Client) websocket.Client {
+	w := &wsClient{
+		Client:  c,
+		t:       t,
+		pending: make(map[string]*pendingRequest),
+	}
+	t.mu.Lock()
+	t.wsClients[w] = struct{}{}
+	t.mu.Unlock()
+	return w
+}
+
+// observeReconnects reports the reconnections of every wrapped client, closed ones included
+func (t *Telemetry) observeReconnects(_ context.Context, o metric.Observer) error {
+	t.mu.Lock()
+	total := t.reconnects
+	for c := range t.wsClients {
+		total += c.GetReconnectCount()
+	}
+	t.mu.Unlock()
+	o.ObserveInt64(t.reconnectsTotal, total)
+	return nil
+}
+
+func (c *wsClient) start(data []byte) (trace.Span, string) {
+	req := wsRequest{}
+	_ = json.Unmarshal(data, &req)
+	_, span := c.t.tracer.Start(context.Background(), "ws "+req.Method,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(AttributeMethod.String(req.Method)))
+	return span, req.Method
+}
+
+// end completes the span of a request with its response or error
+func (c *wsClient) end(span trace.Span, method string, start time.Time, data []byte, err error) {
+	attrs := []attribute.KeyValue{AttributeMethod.String(method)}
+	if err == nil {
+		res := wsResponse{}
+		if e := json.Unmarshal(data, &res); e == nil {
+			attrs = append(attrs, AttributeWsAPIStatus.Int(res.Status))
+			span.SetAttributes(AttributeWsAPIStatus.Int(res.Status))
+			for _, l := range res.RateLimits {
+				if l.RateLimitType == "REQUEST_WEIGHT" {
+					span.SetAttributes(AttributeUsedWeight.Int64(l.Count),
+						AttributeInterval.String(strconv.Itoa(l.IntervalNum)+l.Interval))
+					break
+				}
+			}
+			if res.Error != nil {
+				span.SetAttributes(AttributeErrorCode.Int64(res.Error.Code))
+				span.SetStatus(codes.Error, res.Error.Message)
+			}
+		}
+	} else {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	c.t.wsAPIDuration.Record(context.Background(), time.Since(start).Seconds(), metric.WithAttributes(attrs...))
+	span.End()
+}
+
+// Write sends a request asynchronously, its span ends when the response is read from GetReadChannel
+func (c *wsClient) Write(id string, data []byte) error {
+	span, method := c.start(data)
+	start := time.Now()
+	c.mu.Lock()
+	c.pending[id] = &pendingRequest{span: span, method: method, start: start}
+	c.mu.Unlock()
+	if err := c.Client.Write(id, data); err != nil {
+		c.mu.Lock()
+		delete(c.pending, id)
+		c.mu.Unlock()
+		c.end(span, method, start, nil, err)
+		return err
+	}
+	return nil
+}
+
+// WriteSync sends a request and waits for its response
+func (c *wsClient) WriteSync(id string, data []byte, timeout time.Duration) ([]byte, error) {
+	span, method := c.start(data)
+	start := time.Now()
+	res, err := c.Client.WriteSync(id, data, timeout)
+	c.end(span, method, start, res, err)
+	return res, err
+}
+
+// GetReadChannel returns the responses of asynchronous requests. Responses are forwarded
+// from the wrapped client to end the span of their request.
+func (c *wsClient) GetReadChannel() <-chan []byte {
+	c.readOnce.Do(func() {
+		c.readC = make(chan []byte)
+		go func() {
+			for msg := range c.Client.GetReadChannel() {
+				res := wsResponse{}
+				if json.Unmarshal(msg, &res) == nil {
+					c.mu.Lock()
+					p, ok := c.pending[res.Id]
+					delete(c.pending, res.Id)
+					c.mu.Unlock()
+					if ok {
+						c.end(p.span, p.method, p.start, msg, nil)
+					}
+				}
+				c.readC <- msg
+			}
+			close(c.readC)
+		}()
+	})
+	return c.readC
+}
+
+// Close closes the wrapped client and ends the spans still waiting for a response
+func (c *wsClient) Close() error {
+	c.t.mu.Lock()
+	if _, ok := c.t.wsClients[c]; ok {
+		delete(c.t.wsClients, c)
+		c.t.reconnects += c.GetReconnectCount()
+	}
+	c.t.mu.Unlock()
+	c.mu.Lock()
+	pending := c.pending
+	c.pending = make(map[string]*pendingRequest)
+	c.mu.Unlock()
+	for _, p := range pending {
+		p.span.SetStatus(codes.Error, "closed before response")
+		p.span.End()
+	}
+	return c.Client.Close()
+}
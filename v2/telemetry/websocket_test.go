@@ -0,0 +1,124 @@
+package telemetry
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+)
+
+// fakeWsClient define a websocket.Client answering requests with fixed responses
+type fakeWsClient struct {
+	responses  map[string][]byte
+	readC      chan []byte
+	writeErr   error
+	reconnects int64
+	closed     bool
+}
+
+func newFakeWsClient() *fakeWsClient {
+	return &fakeWsClient{responses: make(map[string][]byte), readC: make(chan []byte, 10)}
+}
+
+func (c *fakeWsClient) Write(id string, data []byte) error {
+	if c.writeErr != nil {
+		return c.writeErr
+	}
+	c.readC <- c.responses[id]
+	return nil
+}
+
+func (c *fakeWsClient) WriteSync(id string, data []byte, timeout time.Duration) ([]byte, error) {
+	if c.writeErr != nil {
+		return nil, c.writeErr
+	}
+	return c.responses[id], nil
+}
+
+func (c *fakeWsClient) GetReadChannel() <-chan []byte     { return c.readC }
+func (c *fakeWsClient) GetReadErrorChannel() <-chan error { return nil }
+func (c *fakeWsClient) GetReconnectCount() int64          { return c.reconnects }
+func (c *fakeWsClient) Wait(timeout time.Duration)        {}
+func (c *fakeWsClient) Close() error {
+	c.closed = true
+	close(c.readC)
+	return nil
+}
+
+type websocketTestSuite struct {
+	baseTestSuite
+}
+
+func TestWebsocket(t *testing.T) {
+	suite.Run(t, new(websocketTestSuite))
+}
+
+func (s *websocketTestSuite) TestWriteSync() {
+	r := s.Require()
+	fake := newFakeWsClient()
+	fake.responses["1"] = []byte(`{"id":"1","status":400,"error":{"code":-2010,"msg":"Account has insufficient balance."},"rateLimits":[{"rateLimitType":"REQUEST_WEIGHT","interval":"MINUTE","intervalNum":1,"limit":6000,"count":12}]}`)
+	c := s.t.WrapWsClient(fake)
+
+	res, err := c.WriteSync("1", []byte(`{"id":"1","method":"order.place","params":{}}`), time.Second)
+	r.NoError(err)
+	r.Equal(fake.responses["1"], res)
+
+	spans := s.spans.Ended()
+	r.Len(spans, 1)
+	r.Equal("ws order.place", spans[0].Name())
+	r.Equal(codes.Error, spans[0].Status().Code)
+	v, ok := s.spanAttribute(spans[0], AttributeErrorCode)
+	r.True(ok)
+	r.Equal(int64(-2010), v.AsInt64())
+	v, ok = s.spanAttribute(spans[0], AttributeUsedWeight)
+	r.True(ok)
+	r.Equal(int64(12), v.AsInt64())
+	v, ok = s.spanAttribute(spans[0], AttributeInterval)
+	r.True(ok)
+	r.Equal("1MINUTE", v.AsString())
+
+	hist := s.metric("binance.ws.api.duration").Data.(metricdata.Histogram[float64])
+	r.Len(hist.DataPoints, 1)
+}
+
+func (s *websocketTestSuite) TestWriteAsync() {
+	r := s.Require()
+	fake := newFakeWsClient()
+	fake.responses["1"] = []byte(`{"id":"1","status":200,"result":{}}`)
+	c := s.t.WrapWsClient(fake)
+	readC := c.GetReadChannel()
+
+	r.NoError(c.Write("1", []byte(`{"id":"1","method":"order.status","params":{}}`)))
+	r.Equal(fake.responses["1"], <-readC)
+	r.Eventually(func() bool { return len(s.spans.Ended()) == 1 }, time.Second, 10*time.Millisecond)
+	r.Equal("ws order.status", s.spans.Ended()[0].Name())
+
+	// write errors end the span immediately
+	fake.writeErr = errors.New("dummy error")
+	r.Error(c.Write("2", []byte(`{"id":"2","method":"order.cancel","params":{}}`)))
+	spans := s.spans.Ended()
+	r.Len(spans, 2)
+	r.Equal(codes.Error, spans[1].Status().Code)
+}
+
+func (s *websocketTestSuite) TestReconnects() {
+	r := s.Require()
+	first, second := newFakeWsClient(), newFakeWsClient()
+	first.reconnects, second.reconnects = 2, 3
+	c := s.t.WrapWsClient(first)
+	s.t.WrapWsClient(second)
+
+	sum := s.metric("binance.ws.reconnects").Data.(metricdata.Sum[int64])
+	r.Len(sum.DataPoints, 1)
+	r.Equal(int64(5), sum.DataPoints[0].Value)
+
+	// reconnections of closed clients are kept
+	r.NoError(c.Close())
+	r.True(first.closed)
+	second.reconnects = 4
+	sum = s.metric("binance.ws.reconnects").Data.(metricdata.Sum[int64])
+	r.Equal(int64(6), sum.DataPoints[0].Value)
+}
@@ -213,8 +213,9 @@ func (s *AveragePriceService) Do(ctx context.Context, opts ...RequestOption) (re
 
 // AvgPrice define average price
 type AvgPrice struct {
-	Mins  int64  `json:"mins"`
-	Price string `json:"price"`
+	Mins      int64  `json:"mins"`
+	Price     string `json:"price"`
+	CloseTime int64  `json:"closeTime"`
 }
 
 type ListSymbolTickerService struct {
@@ -0,0 +1,167 @@
+package binance
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/adshao/go-binance/v2/common"
+	"github.com/adshao/go-binance/v2/common/websocket"
+)
+
+// Ticker24hrWsService gets 24 hours rolling window price change statistics
+type Ticker24hrWsService struct {
+	c websocket.Client
+}
+
+// NewTicker24hrWsService init Ticker24hrWsService
+func NewTicker24hrWsService() (*Ticker24hrWsService, error) {
+	conn, err := websocket.NewConnection(WsApiInitReadWriteConn, WebsocketKeepalive, WebsocketTimeoutReadWriteConnection)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := websocket.NewClient(conn)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Ticker24hrWsService{
+		c: client,
+	}, nil
+}
+
+// Ticker24hrWsRequest parameters for 'ticker.24hr' websocket API
+type Ticker24hrWsRequest struct {
+	symbol     *string
+	symbols    []string
+	tickerType *string
+}
+
+// NewTicker24hrWsRequest init Ticker24hrWsRequest
+func NewTicker24hrWsRequest() *Ticker24hrWsRequest {
+	return &Ticker24hrWsRequest{}
+}
+
+func (s *Ticker24hrWsRequest) GetParams() map[string]interface{} {
+	return s.buildParams()
+}
+
+// buildParams builds params
+func (s *Ticker24hrWsRequest) buildParams() params {
+	m := params{}
+	if s.symbol != nil {
+		m["symbol"] = *s.symbol
+	}
+	if len(s.symbols) > 0 {
+		m["symbols"] = s.symbols
+	}
+	if s.tickerType != nil {
+		m["type"] = *s.tickerType
+	}
+	return m
+}
+
+// Do - sends 'ticker.24hr' request
+func (s *Ticker24hrWsService) Do(requestID string, request *Ticker24hrWsRequest) error {
+	rawData, err := websocket.CreateRequestWithSigned(
+		requestID,
+		websocket.Ticker24hrSpotWsApiMethod,
+		request.buildParams(),
+	)
+	if err != nil {
+		return err
+	}
+
+	if err := s.c.Write(requestID, rawData); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// SyncDo - sends 'ticker.24hr' request and receives response
+func (s *Ticker24hrWsService) SyncDo(requestID string, request *Ticker24hrWsRequest) (*Ticker24hrWsResponse, error) {
+	rawData, err := websocket.CreateRequestWithSigned(
+		requestID,
+		websocket.Ticker24hrSpotWsApiMethod,
+		request.buildParams(),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := s.c.WriteSync(requestID, rawData, websocket.WriteSyncWsTimeout)
+	if err != nil {
+		return nil, err
+	}
+
+	ticker24hrWsResponse := &Ticker24hrWsResponse{}
+	if err := json.Unmarshal(response, ticker24hrWsResponse); err != nil {
+		return nil, err
+	}
+
+	return ticker24hrWsResponse, nil
+}
+
+// ReceiveAllDataBeforeStop waits until all responses will be received from websocket until timeout expired
+func (s *Ticker24hrWsService) ReceiveAllDataBeforeStop(timeout time.Duration) {
+	s.c.Wait(timeout)
+}
+
+// GetReadChannel returns channel with API response data (including API errors)
+func (s *Ticker24hrWsService) GetReadChannel() <-chan []byte {
+	return s.c.GetReadChannel()
+}
+
+// GetReadErrorChannel returns channel with errors which are occurred while reading websocket connection
+func (s *Ticker24hrWsService) GetReadErrorChannel() <-chan error {
+	return s.c.GetReadErrorChannel()
+}
+
+// GetReconnectCount returns count of reconnect attempts by client
+func (s *Ticker24hrWsService) GetReconnectCount() int64 {
+	return s.c.GetReconnectCount()
+}
+
+// Symbol set symbol
+func (s *Ticker24hrWsRequest) Symbol(symbol string) *Ticker24hrWsRequest {
+	s.symbol = &symbol
+	return s
+}
+
+// Symbols set symbols
+func (s *Ticker24hrWsRequest) Symbols(symbols []string) *Ticker24hrWsRequest {
+	s.symbols = symbols
+	return s
+}
+
+// Type set type
+func (s *Ticker24hrWsRequest) Type(tickerType string) *Ticker24hrWsRequest {
+	s.tickerType = &tickerType
+	return s
+}
+
+// Ticker24hrWsResponse define 'ticker.24hr' websocket API response
+type Ticker24hrWsResponse struct {
+	Id     string              `json:"id"`
+	Status int                 `json:"status"`
+	Result []*PriceChangeStats `json:"result"`
+
+	// error response
+	Error *common.APIError `json:"error,omitempty"`
+}
+
+// UnmarshalJSON decode the response and its result
+func (r *Ticker24hrWsResponse) UnmarshalJSON(data []byte) error {
+	raw := wsApiRawResponse{}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+	r.Id, r.Status, r.Error = raw.Id, raw.Status, raw.Error
+	if raw.isEmpty() {
+		return nil
+	}
+	var err error
+	r.Result, err = unmarshalOneOrMany[PriceChangeStats](raw.Result)
+	return err
+}
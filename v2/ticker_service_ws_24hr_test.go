@@ -0,0 +1,77 @@
+package binance
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/adshao/go-binance/v2/common/websocket"
+	"github.com/adshao/go-binance/v2/common/websocket/mock"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/suite"
+)
+
+type ticker24hrServiceWsTestSuite struct {
+	suite.Suite
+
+	ctrl   *gomock.Controller
+	client *mock.MockClient
+
+	requestID string
+
+	service *Ticker24hrWsService
+	request *Ticker24hrWsRequest
+}
+
+func (s *ticker24hrServiceWsTestSuite) SetupTest() {
+	s.requestID = "e2a85d9f-07a5-4f94-8d5f-789dc3deb098"
+
+	s.ctrl = gomock.NewController(s.T())
+	s.client = mock.NewMockClient(s.ctrl)
+
+	s.service = &Ticker24hrWsService{
+		c: s.client,
+	}
+
+	s.request = NewTicker24hrWsRequest().Symbols([]string{"BTCUSDT", "BNBUSDT"}).Type("MINI")
+}
+
+func (s *ticker24hrServiceWsTestSuite) TearDownTest() {
+	s.ctrl.Finish()
+}
+
+func TestTicker24hrWsService(t *testing.T) {
+	suite.Run(t, new(ticker24hrServiceWsTestSuite))
+}
+
+func (s *ticker24hrServiceWsTestSuite) TestDo() {
+	var data []byte
+	s.client.EXPECT().Write(s.requestID, gomock.Any()).DoAndReturn(func(id string, raw []byte) error {
+		data = raw
+		return nil
+	}).Times(1)
+
+	err := s.service.Do(s.requestID, s.request)
+	s.Require().NoError(err)
+
+	req := websocket.WsApiRequest{}
+	s.Require().NoError(json.Unmarshal(data, &req))
+	s.Equal(s.requestID, req.Id)
+	s.Equal(websocket.WsApiMethodType("ticker.24hr"), req.Method)
+	for k, v := range map[string]interface{}{"symbols": []interface{}{"BTCUSDT", "BNBUSDT"}, "type": "MINI"} {
+		s.Equal(v, req.Params[k], k)
+	}
+	s.NotContains(req.Params, "apiKey")
+}
+
+func (s *ticker24hrServiceWsTestSuite) TestSyncDo() {
+	rawResponseData := []byte(fmt.Sprintf(`{"id": "%s", "status": 200, "result": [{"symbol": "BTCUSDT", "lastPrice": "0.01"}, {"symbol": "BNBUSDT", "lastPrice": "0.02"}]}`, s.requestID))
+	s.client.EXPECT().WriteSync(s.requestID, gomock.Any(), gomock.Any()).Return(rawResponseData, nil).Times(1)
+
+	response, err := s.service.SyncDo(s.requestID, s.request)
+	s.Require().NoError(err)
+	s.Equal(s.requestID, response.Id)
+	s.Require().Len(response.Result, 2)
+	s.Equal("BNBUSDT", response.Result[1].Symbol)
+	s.Equal("0.02", response.Result[1].LastPrice)
+}
@@ -0,0 +1,177 @@
+package binance
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/adshao/go-binance/v2/common"
+	"github.com/adshao/go-binance/v2/common/websocket"
+)
+
+// TickerWsService gets rolling window price change statistics
+type TickerWsService struct {
+	c websocket.Client
+}
+
+// NewTickerWsService init TickerWsService
+func NewTickerWsService() (*TickerWsService, error) {
+	conn, err := websocket.NewConnection(WsApiInitReadWriteConn, WebsocketKeepalive, WebsocketTimeoutReadWriteConnection)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := websocket.NewClient(conn)
+	if err != nil {
+		return nil, err
+	}
+
+	return &TickerWsService{
+		c: client,
+	}, nil
+}
+
+// TickerWsRequest parameters for 'ticker' websocket API
+type TickerWsRequest struct {
+	symbol     *string
+	symbols    []string
+	windowSize *string
+	tickerType *string
+}
+
+// NewTickerWsRequest init TickerWsRequest
+func NewTickerWsRequest() *TickerWsRequest {
+	return &TickerWsRequest{}
+}
+
+func (s *TickerWsRequest) GetParams() map[string]interface{} {
+	return s.buildParams()
+}
+
+// buildParams builds params
+func (s *TickerWsRequest) buildParams() params {
+	m := params{}
+	if s.symbol != nil {
+		m["symbol"] = *s.symbol
+	}
+	if len(s.symbols) > 0 {
+		m["symbols"] = s.symbols
+	}
+	if s.windowSize != nil {
+		m["windowSize"] = *s.windowSize
+	}
+	if s.tickerType != nil {
+		m["type"] = *s.tickerType
+	}
+	return m
+}
+
+// Do - sends 'ticker' request
+func (s *TickerWsService) Do(requestID string, request *TickerWsRequest) error {
+	rawData, err := websocket.CreateRequestWithSigned(
+		requestID,
+		websocket.TickerSpotWsApiMethod,
+		request.buildParams(),
+	)
+	if err != nil {
+		return err
+	}
+
+	if err := s.c.Write(requestID, rawData); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// SyncDo - sends 'ticker' request and receives response
+func (s *TickerWsService) SyncDo(requestID string, request *TickerWsRequest) (*TickerWsResponse, error) {
+	rawData, err := websocket.CreateRequestWithSigned(
+		requestID,
+		websocket.TickerSpotWsApiMethod,
+		request.buildParams(),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := s.c.WriteSync(requestID, rawData, websocket.WriteSyncWsTimeout)
+	if err != nil {
+		return nil, err
+	}
+
+	tickerWsResponse := &TickerWsResponse{}
+	if err := json.Unmarshal(response, tickerWsResponse); err != nil {
+		return nil, err
+	}
+
+	return tickerWsResponse, nil
+}
+
+// ReceiveAllDataBeforeStop waits until all responses will be received from websocket until timeout expired
+func (s *TickerWsService) ReceiveAllDataBeforeStop(timeout time.Duration) {
+	s.c.Wait(timeout)
+}
+
+// GetReadChannel returns channel with API response data (including API errors)
+func (s *TickerWsService) GetReadChannel() <-chan []byte {
+	return s.c.GetReadChannel()
+}
+
+// GetReadErrorChannel returns channel with errors which are occurred while reading websocket connection
+func (s *TickerWsService) GetReadErrorChannel() <-chan error {
+	return s.c.GetReadErrorChannel()
+}
+
+// GetReconnectCount returns count of reconnect attempts by client
+func (s *TickerWsService) GetReconnectCount() int64 {
+	return s.c.GetReconnectCount()
+}
+
+// Symbol set symbol
+func (s *TickerWsRequest) Symbol(symbol string) *TickerWsRequest {
+	s.symbol = &symbol
+	return s
+}
+
+// Symbols set symbols
+func (s *TickerWsRequest) Symbols(symbols []string) *TickerWsRequest {
+	s.symbols = symbols
+	return s
+}
+
+// WindowSize set windowSize
+func (s *TickerWsRequest) WindowSize(windowSize string) *TickerWsRequest {
+	s.windowSize = &windowSize
+	return s
+}
+
+// Type set type
+func (s *TickerWsRequest) Type(tickerType string) *TickerWsRequest {
+	s.tickerType = &tickerType
+	return s
+}
+
+// TickerWsResponse define 'ticker' websocket API response
+type TickerWsResponse struct {
+	Id     string          `json:"id"`
+	Status int             `json:"status"`
+	Result []*SymbolTicker `json:"result"`
+
+	// error response
+	Error *common.APIError `json:"error,omitempty"`
+}
+
+// UnmarshalJSON decode the response and its result
+func (r *TickerWsResponse) UnmarshalJSON(data []byte) error {
+	raw := wsApiRawResponse{}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+	r.Id, r.Status, r.Error = raw.Id, raw.Status, raw.Error
+	if raw.isEmpty() {
+		return nil
+	}
+	var err error
+	r.Result, err = unmarshalOneOrMany[SymbolTicker](raw.Result)
+	return err
+}
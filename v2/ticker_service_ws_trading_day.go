@@ -0,0 +1,177 @@
+package binance
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/adshao/go-binance/v2/common"
+	"github.com/adshao/go-binance/v2/common/websocket"
+)
+
+// TickerTradingDayWsService gets price change statistics of a trading day
+type TickerTradingDayWsService struct {
+	c websocket.Client
+}
+
+// NewTickerTradingDayWsService init TickerTradingDayWsService
+func NewTickerTradingDayWsService() (*TickerTradingDayWsService, error) {
+	conn, err := websocket.NewConnection(WsApiInitReadWriteConn, WebsocketKeepalive, WebsocketTimeoutReadWriteConnection)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := websocket.NewClient(conn)
+	if err != nil {
+		return nil, err
+	}
+
+	return &TickerTradingDayWsService{
+		c: client,
+	}, nil
+}
+
+// TickerTradingDayWsRequest parameters for 'ticker.tradingDay' websocket API
+type TickerTradingDayWsRequest struct {
+	symbol     *string
+	symbols    []string
+	timeZone   *string
+	tickerType *string
+}
+
+// NewTickerTradingDayWsRequest init TickerTradingDayWsRequest
+func NewTickerTradingDayWsRequest() *TickerTradingDayWsRequest {
+	return &TickerTradingDayWsRequest{}
+}
+
+func (s *TickerTradingDayWsRequest) GetParams() map[string]interface{} {
+	return s.buildParams()
+}
+
+// buildParams builds params
+func (s *TickerTradingDayWsRequest) buildParams() params {
+	m := params{}
+	if s.symbol != nil {
+		m["symbol"] = *s.symbol
+	}
+	if len(s.symbols) > 0 {
+		m["symbols"] = s.symbols
+	}
+	if s.timeZone != nil {
+		m["timeZone"] = *s.timeZone
+	}
+	if s.tickerType != nil {
+		m["type"] = *s.tickerType
+	}
+	return m
+}
+
+// Do - sends 'ticker.tradingDay' request
+func (s *TickerTradingDayWsService) Do(requestID string, request *TickerTradingDayWsRequest) error {
+	rawData, err := websocket.CreateRequestWithSigned(
+		requestID,
+		websocket.TickerTradingDaySpotWsApiMethod,
+		request.buildParams(),
+	)
+	if err != nil {
+		return err
+	}
+
+	if err := s.c.Write(requestID, rawData); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// SyncDo - sends 'ticker.tradingDay' request and receives response
+func (s *TickerTradingDayWsService) SyncDo(requestID string, request *TickerTradingDayWsRequest) (*TickerTradingDayWsResponse, error) {
+	rawData, err := websocket.CreateRequestWithSigned(
+		requestID,
+		websocket.TickerTradingDaySpotWsApiMethod,
+		request.buildParams(),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := s.c.WriteSync(requestID, rawData, websocket.WriteSyncWsTimeout)
+	if err != nil {
+		return nil, err
+	}
+
+	tickerTradingDayWsResponse := &TickerTradingDayWsResponse{}
+	if err := json.Unmarshal(response, tickerTradingDayWsResponse); err != nil {
+		return nil, err
+	}
+
+	return tickerTradingDayWsResponse, nil
+}
+
+// ReceiveAllDataBeforeStop waits until all responses will be received from websocket until timeout expired
+func (s *TickerTradingDayWsService) ReceiveAllDataBeforeStop(timeout time.Duration) {
+	s.c.Wait(timeout)
+}
+
+// GetReadChannel returns channel with API response data (including API errors)
+func (s *TickerTradingDayWsService) GetReadChannel() <-chan []byte {
+	return s.c.GetReadChannel()
+}
+
+// GetReadErrorChannel returns channel with errors which are occurred while reading websocket connection
+func (s *TickerTradingDayWsService) GetReadErrorChannel() <-chan error {
+	return s.c.GetReadErrorChannel()
+}
+
+// GetReconnectCount returns count of reconnect attempts by client
+func (s *TickerTradingDayWsService) GetReconnectCount() int64 {
+	return s.c.GetReconnectCount()
+}
+
+// Symbol set symbol
+func (s *TickerTradingDayWsRequest) Symbol(symbol string) *TickerTradingDayWsRequest {
+	s.symbol = &symbol
+	return s
+}
+
+// Symbols set symbols
+func (s *TickerTradingDayWsRequest) Symbols(symbols []string) *TickerTradingDayWsRequest {
+	s.symbols = symbols
+	return s
+}
+
+// TimeZone set timeZone
+func (s *TickerTradingDayWsRequest) TimeZone(timeZone string) *TickerTradingDayWsRequest {
+	s.timeZone = &timeZone
+	return s
+}
+
+// Type set type
+func (s *TickerTradingDayWsRequest) Type(tickerType string) *TickerTradingDayWsRequest {
+	s.tickerType = &tickerType
+	return s
+}
+
+// TickerTradingDayWsResponse define 'ticker.tradingDay' websocket API response
+type TickerTradingDayWsResponse struct {
+	Id     string              `json:"id"`
+	Status int                 `json:"status"`
+	Result []*TradingDayTicker `json:"result"`
+
+	// error response
+	Error *common.APIError `json:"error,omitempty"`
+}
+
+// UnmarshalJSON decode the response and its result
+func (r *TickerTradingDayWsResponse) UnmarshalJSON(data []byte) error {
+	raw := wsApiRawResponse{}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+	r.Id, r.Status, r.Error = raw.Id, raw.Status, raw.Error
+	if raw.isEmpty() {
+		return nil
+	}
+	var err error
+	r.Result, err = unmarshalOneOrMany[TradingDayTicker](raw.Result)
+	return err
+}
@@ -0,0 +1,171 @@
+package binance
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/adshao/go-binance/v2/common"
+	"github.com/adshao/go-binance/v2/common/websocket"
+)
+
+// TradesAggregateWsService gets aggregate trades
+type TradesAggregateWsService struct {
+	c websocket.Client
+}
+
+// NewTradesAggregateWsService init TradesAggregateWsService
+func NewTradesAggregateWsService() (*TradesAggregateWsService, error) {
+	conn, err := websocket.NewConnection(WsApiInitReadWriteConn, WebsocketKeepalive, WebsocketTimeoutReadWriteConnection)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := websocket.NewClient(conn)
+	if err != nil {
+		return nil, err
+	}
+
+	return &TradesAggregateWsService{
+		c: client,
+	}, nil
+}
+
+// TradesAggregateWsRequest parameters for 'trades.aggregate' websocket API
+type TradesAggregateWsRequest struct {
+	symbol    string
+	fromId    *int64
+	startTime *int64
+	endTime   *int64
+	limit     *int
+}
+
+// NewTradesAggregateWsRequest init TradesAggregateWsRequest
+func NewTradesAggregateWsRequest() *TradesAggregateWsRequest {
+	return &TradesAggregateWsRequest{}
+}
+
+func (s *TradesAggregateWsRequest) GetParams() map[string]interface{} {
+	return s.buildParams()
+}
+
+// buildParams builds params
+func (s *TradesAggregateWsRequest) buildParams() params {
+	m := params{
+		"symbol": s.symbol,
+	}
+	if s.fromId != nil {
+		m["fromId"] = *s.fromId
+	}
+	if s.startTime != nil {
+		m["startTime"] = *s.startTime
+	}
+	if s.endTime != nil {
+		m["endTime"] = *s.endTime
+	}
+	if s.limit != nil {
+		m["limit"] = *s.limit
+	}
+	return m
+}
+
+// Do - sends 'trades.aggregate' request
+func (s *TradesAggregateWsService) Do(requestID string, request *TradesAggregateWsRequest) error {
+	rawData, err := websocket.CreateRequestWithSigned(
+		requestID,
+		websocket.TradesAggregateSpotWsApiMethod,
+		request.buildParams(),
+	)
+	if err != nil {
+		return err
+	}
+
+	if err := s.c.Write(requestID, rawData); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// SyncDo - sends 'trades.aggregate' request and receives response
+func (s *TradesAggregateWsService) SyncDo(requestID string, request *TradesAggregateWsRequest) (*TradesAggregateWsResponse, error) {
+	rawData, err := websocket.CreateRequestWithSigned(
+		requestID,
+		websocket.TradesAggregateSpotWsApiMethod,
+		request.buildParams(),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := s.c.WriteSync(requestID, rawData, websocket.WriteSyncWsTimeout)
+	if err != nil {
+		return nil, err
+	}
+
+	tradesAggregateWsResponse := &TradesAggregateWsResponse{}
+	if err := json.Unmarshal(response, tradesAggregateWsResponse); err != nil {
+		return nil, err
+	}
+
+	return tradesAggregateWsResponse, nil
+}
+
+// ReceiveAllDataBeforeStop waits until all responses will be received from websocket until timeout expired
+func (s *TradesAggregateWsService) ReceiveAllDataBeforeStop(timeout time.Duration) {
+	s.c.Wait(timeout)
+}
+
+// GetReadChannel returns channel with API response data (including API errors)
+func (s *TradesAggregateWsService) GetReadChannel() <-chan []byte {
+	return s.c.GetReadChannel()
+}
+
+// GetReadErrorChannel returns channel with errors which are occurred while reading websocket connection
+func (s *TradesAggregateWsService) GetReadErrorChannel() <-chan error {
+	return s.c.GetReadErrorChannel()
+}
+
+// GetReconnectCount returns count of reconnect attempts by client
+func (s *TradesAggregateWsService) GetReconnectCount() int64 {
+	return s.c.GetReconnectCount()
+}
+
+// Symbol set symbol
+func (s *TradesAggregateWsRequest) Symbol(symbol string) *TradesAggregateWsRequest {
+	s.symbol = symbol
+	return s
+}
+
+// FromID set fromId
+func (s *TradesAggregateWsRequest) FromID(fromId int64) *TradesAggregateWsRequest {
+	s.fromId = &fromId
+	return s
+}
+
+// StartTime set startTime
+func (s *TradesAggregateWsRequest) StartTime(startTime int64) *TradesAggregateWsRequest {
+	s.startTime = &startTime
+	return s
+}
+
+// EndTime set endTime
+func (s *TradesAggregateWsRequest) EndTime(endTime int64) *TradesAggregateWsRequest {
+	s.endTime = &endTime
+	return s
+}
+
+// Limit set limit
+func (s *TradesAggregateWsRequest) Limit(limit int) *TradesAggregateWsRequest {
+	s.limit = &limit
+	return s
+}
+
+// TradesAggregateWsResponse define 'trades.aggregate' websocket API response
+type TradesAggregateWsResponse struct {
+	Id     string      `json:"id"`
+	Status int         `json:"status"`
+	Result []*AggTrade `json:"result"`
+
+	// error response
+	Error *common.APIError `json:"error,omitempty"`
+}
@@ -0,0 +1,77 @@
+package binance
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/adshao/go-binance/v2/common/websocket"
+	"github.com/adshao/go-binance/v2/common/websocket/mock"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/suite"
+)
+
+type tradesAggregateServiceWsTestSuite struct {
+	suite.Suite
+
+	ctrl   *gomock.Controller
+	client *mock.MockClient
+
+	requestID string
+
+	service *TradesAggregateWsService
+	request *TradesAggregateWsRequest
+}
+
+func (s *tradesAggregateServiceWsTestSuite) SetupTest() {
+	s.requestID = "e2a85d9f-07a5-4f94-8d5f-789dc3deb098"
+
+	s.ctrl = gomock.NewController(s.T())
+	s.client = mock.NewMockClient(s.ctrl)
+
+	s.service = &TradesAggregateWsService{
+		c: s.client,
+	}
+
+	s.request = NewTradesAggregateWsRequest().Symbol("BTCUSDT").StartTime(1565877971222).EndTime(1565877971999).Limit(1)
+}
+
+func (s *tradesAggregateServiceWsTestSuite) TearDownTest() {
+	s.ctrl.Finish()
+}
+
+func TestTradesAggregateWsService(t *testing.T) {
+	suite.Run(t, new(tradesAggregateServiceWsTestSuite))
+}
+
+func (s *tradesAggregateServiceWsTestSuite) TestDo() {
+	var data []byte
+	s.client.EXPECT().Write(s.requestID, gomock.Any()).DoAndReturn(func(id string, raw []byte) error {
+		data = raw
+		return nil
+	}).Times(1)
+
+	err := s.service.Do(s.requestID, s.request)
+	s.Require().NoError(err)
+
+	req := websocket.WsApiRequest{}
+	s.Require().NoError(json.Unmarshal(data, &req))
+	s.Equal(s.requestID, req.Id)
+	s.Equal(websocket.WsApiMethodType("trades.aggregate"), req.Method)
+	for k, v := range map[string]interface{}{"symbol": "BTCUSDT", "startTime": float64(1565877971222), "endTime": float64(1565877971999), "limit": float64(1)} {
+		s.Equal(v, req.Params[k], k)
+	}
+	s.NotContains(req.Params, "apiKey")
+}
+
+func (s *tradesAggregateServiceWsTestSuite) TestSyncDo() {
+	rawResponseData := []byte(fmt.Sprintf(`{"id": "%s", "status": 200, "result": [{"a": 50000000, "p": "0.00274100", "q": "57.19000000", "f": 59120167, "l": 59120170, "T": 1565877971222, "m": true, "M": true}]}`, s.requestID))
+	s.client.EXPECT().WriteSync(s.requestID, gomock.Any(), gomock.Any()).Return(rawResponseData, nil).Times(1)
+
+	response, err := s.service.SyncDo(s.requestID, s.request)
+	s.Require().NoError(err)
+	s.Equal(s.requestID, response.Id)
+	s.Require().Len(response.Result, 1)
+	s.Equal(int64(50000000), response.Result[0].AggTradeID)
+	s.Equal(int64(59120170), response.Result[0].LastTradeID)
+}
@@ -0,0 +1,151 @@
+package binance
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/adshao/go-binance/v2/common"
+	"github.com/adshao/go-binance/v2/common/websocket"
+)
+
+// TradesHistoricalWsService gets historical trades
+type TradesHistoricalWsService struct {
+	c websocket.Client
+}
+
+// NewTradesHistoricalWsService init TradesHistoricalWsService
+func NewTradesHistoricalWsService() (*TradesHistoricalWsService, error) {
+	conn, err := websocket.NewConnection(WsApiInitReadWriteConn, WebsocketKeepalive, WebsocketTimeoutReadWriteConnection)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := websocket.NewClient(conn)
+	if err != nil {
+		return nil, err
+	}
+
+	return &TradesHistoricalWsService{
+		c: client,
+	}, nil
+}
+
+// TradesHistoricalWsRequest parameters for 'trades.historical' websocket API
+type TradesHistoricalWsRequest struct {
+	symbol string
+	fromId *int64
+	limit  *int
+}
+
+// NewTradesHistoricalWsRequest init TradesHistoricalWsRequest
+func NewTradesHistoricalWsRequest() *TradesHistoricalWsRequest {
+	return &TradesHistoricalWsRequest{}
+}
+
+func (s *TradesHistoricalWsRequest) GetParams() map[string]interface{} {
+	return s.buildParams()
+}
+
+// buildParams builds params
+func (s *TradesHistoricalWsRequest) buildParams() params {
+	m := params{
+		"symbol": s.symbol,
+	}
+	if s.fromId != nil {
+		m["fromId"] = *s.fromId
+	}
+	if s.limit != nil {
+		m["limit"] = *s.limit
+	}
+	return m
+}
+
+// Do - sends 'trades.historical' request
+func (s *TradesHistoricalWsService) Do(requestID string, request *TradesHistoricalWsRequest) error {
+	rawData, err := websocket.CreateRequestWithSigned(
+		requestID,
+		websocket.TradesHistoricalSpotWsApiMethod,
+		request.buildParams(),
+	)
+	if err != nil {
+		return err
+	}
+
+	if err := s.c.Write(requestID, rawData); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// SyncDo - sends 'trades.historical' request and receives response
+func (s *TradesHistoricalWsService) SyncDo(requestID string, request *TradesHistoricalWsRequest) (*TradesHistoricalWsResponse, error) {
+	rawData, err := websocket.CreateRequestWithSigned(
+		requestID,
+		websocket.TradesHistoricalSpotWsApiMethod,
+		request.buildParams(),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := s.c.WriteSync(requestID, rawData, websocket.WriteSyncWsTimeout)
+	if err != nil {
+		return nil, err
+	}
+
+	tradesHistoricalWsResponse := &TradesHistoricalWsResponse{}
+	if err := json.Unmarshal(response, tradesHistoricalWsResponse); err != nil {
+		return nil, err
+	}
+
+	return tradesHistoricalWsResponse, nil
+}
+
+// ReceiveAllDataBeforeStop waits until all responses will be received from websocket until timeout expired
+func (s *TradesHistoricalWsService) ReceiveAllDataBeforeStop(timeout time.Duration) {
+	s.c.Wait(timeout)
+}
+
+// GetReadChannel returns channel with API response data (including API errors)
+func (s *TradesHistoricalWsService) GetReadChannel() <-chan []byte {
+	return s.c.GetReadChannel()
+}
+
+// GetReadErrorChannel returns channel with errors which are occurred while reading websocket connection
+func (s *TradesHistoricalWsService) GetReadErrorChannel() <-chan error {
+	return s.c.GetReadErrorChannel()
+}
+
+// GetReconnectCount returns count of reconnect attempts by client
+func (s *TradesHistoricalWsService) GetReconnectCount() int64 {
+	return s.c.GetReconnectCount()
+}
+
+// Symbol set symbol
+func (s *TradesHistoricalWsRequest) Symbol(symbol string) *TradesHistoricalWsRequest {
+	s.symbol = symbol
+	return s
+}
+
+// FromID set fromId
+func (s *TradesHistoricalWsRequest) FromID(fromId int64) *TradesHistoricalWsRequest {
+	s.fromId = &fromId
+	return s
+}
+
+// Limit set limit
+func (s *TradesHistoricalWsRequest) Limit(limit int) *TradesHistoricalWsRequest {
+	s.limit = &limit
+	return s
+}
+
+// TradesHistoricalWsResponse define 'trades.historical' websocket API response
+type TradesHistoricalWsResponse struct {
+	Id     string   `json:"id"`
+	Status int      `json:"status"`
+	Result []*Trade `json:"result"`
+
+	// error response
+	Error *common.APIError `json:"error,omitempty"`
+}
@@ -0,0 +1,141 @@
+package binance
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/adshao/go-binance/v2/common"
+	"github.com/adshao/go-binance/v2/common/websocket"
+)
+
+// TradesRecentWsService gets recent trades
+type TradesRecentWsService struct {
+	c websocket.Client
+}
+
+// NewTradesRecentWsService init TradesRecentWsService
+func NewTradesRecentWsService() (*TradesRecentWsService, error) {
+	conn, err := websocket.NewConnection(WsApiInitReadWriteConn, WebsocketKeepalive, WebsocketTimeoutReadWriteConnection)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := websocket.NewClient(conn)
+	if err != nil {
+		return nil, err
+	}
+
+	return &TradesRecentWsService{
+		c: client,
+	}, nil
+}
+
+// TradesRecentWsRequest parameters for 'trades.recent' websocket API
+type TradesRecentWsRequest struct {
+	symbol string
+	limit  *int
+}
+
+// NewTradesRecentWsRequest init TradesRecentWsRequest
+func NewTradesRecentWsRequest() *TradesRecentWsRequest {
+	return &TradesRecentWsRequest{}
+}
+
+func (s *TradesRecentWsRequest) GetParams() map[string]interface{} {
+	return s.buildParams()
+}
+
+// buildParams builds params
+func (s *TradesRecentWsRequest) buildParams() params {
+	m := params{
+		"symbol": s.symbol,
+	}
+	if s.limit != nil {
+		m["limit"] = *s.limit
+	}
+	return m
+}
+
+// Do - sends 'trades.recent' request
+func (s *TradesRecentWsService) Do(requestID string, request *TradesRecentWsRequest) error {
+	rawData, err := websocket.CreateRequestWithSigned(
+		requestID,
+		websocket.TradesRecentSpotWsApiMethod,
+		request.buildParams(),
+	)
+	if err != nil {
+		return err
+	}
+
+	if err := s.c.Write(requestID, rawData); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// SyncDo - sends 'trades.recent' request and receives response
+func (s *TradesRecentWsService) SyncDo(requestID string, request *TradesRecentWsRequest) (*TradesRecentWsResponse, error) {
+	rawData, err := websocket.CreateRequestWithSigned(
+		requestID,
+		websocket.TradesRecentSpotWsApiMethod,
+		request.buildParams(),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := s.c.WriteSync(requestID, rawData, websocket.WriteSyncWsTimeout)
+	if err != nil {
+		return nil, err
+	}
+
+	tradesRecentWsResponse := &TradesRecentWsResponse{}
+	if err := json.Unmarshal(response, tradesRecentWsResponse); err != nil {
+		return nil, err
+	}
+
+	return tradesRecentWsResponse, nil
+}
+
+// ReceiveAllDataBeforeStop waits until all responses will be received from websocket until timeout expired
+func (s *TradesRecentWsService) ReceiveAllDataBeforeStop(timeout time.Duration) {
+	s.c.Wait(timeout)
+}
+
+// GetReadChannel returns channel with API response data (including API errors)
+func (s *TradesRecentWsService) GetReadChannel() <-chan []byte {
+	return s.c.GetReadChannel()
+}
+
+// GetReadErrorChannel returns channel with errors which are occurred while reading websocket connection
+func (s *TradesRecentWsService) GetReadErrorChannel() <-chan error {
+	return s.c.GetReadErrorChannel()
+}
+
+// GetReconnectCount returns count of reconnect attempts by client
+func (s *TradesRecentWsService) GetReconnectCount() int64 {
+	return s.c.GetReconnectCount()
+}
+
+// Symbol set symbol
+func (s *TradesRecentWsRequest) Symbol(symbol string) *TradesRecentWsRequest {
+	s.symbol = symbol
+	return s
+}
+
+// Limit set limit
+func (s *TradesRecentWsRequest) Limit(limit int) *TradesRecentWsRequest {
+	s.limit = &limit
+	return s
+}
+
+// TradesRecentWsResponse define 'trades.recent' websocket API response
+type TradesRecentWsResponse struct {
+	Id     string   `json:"id"`
+	Status int      `json:"status"`
+	Result []*Trade `json:"result"`
+
+	// error response
+	Error *common.APIError `json:"error,omitempty"`
+}
@@ -0,0 +1,331 @@
+package treasury
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/shopspring/decimal"
+
+	"github.com/adshao/go-binance/v2"
+	"github.com/adshao/go-binance/v2/internal/idempotency"
+	"github.com/adshao/go-binance/v2/internal/multierr"
+)
+
+// ActionStatus define the status of an action in a report
+type ActionStatus string
+
+// Global enums
+const (
+	ActionStatusExecuted ActionStatus = "EXECUTED"
+	ActionStatusSkipped  ActionStatus = "SKIPPED" // already executed or attempted in the period
+	ActionStatusFailed   ActionStatus = "FAILED"
+	ActionStatusUnknown  ActionStatus = "UNKNOWN" // may have been executed, not retried in the period
+	ActionStatusAborted  ActionStatus = "ABORTED" // not attempted after a failure
+
+	// lookupSlack is how long before it was sent a transfer is looked up in the history
+	lookupSlack = time.Minute
+	lookupSize  = 100
+)
+
+var (
+	// ErrNoProduct is returned when no flexible product can be subscribed for an asset
+	ErrNoProduct = errors.New("treasury: no flexible product")
+	// ErrUnsupportedTransfer is returned when no universal transfer moves funds between two accounts
+	ErrUnsupportedTransfer = errors.New("treasury: unsupported transfer")
+	// ErrUnresolvedTransfer is returned when the outcome of a transfer is still unknown
+	ErrUnresolvedTransfer = errors.New("treasury: unresolved transfer")
+)
+
+// unknownTransfer define a transfer with an unknown outcome
+type unknownTransfer struct {
+	action Action
+	sent   time.Time
+}
+
+// transferTypes map the accounts of a transfer to its universal transfer type
+var transferTypes = map[[2]Account]binance.UserUniversalTransferType{
+	{AccountSpot, AccountFunding}:   binance.UserUniversalTransferTypeMainToFunding,
+	{AccountSpot, AccountMargin}:    binance.UserUniversalTransferTypeMainToMargin,
+	{AccountSpot, AccountUSDM}:      binance.UserUniversalTransferTypeMainToUmFutures,
+	{AccountSpot, AccountCOINM}:     binance.UserUniversalTransferTypeMainToCmFutures,
+	{AccountFunding, AccountSpot}:   binance.UserUniversalTransferTypeFundingToMain,
+	{AccountFunding, AccountMargin}: binance.UserUniversalTransferTypeFundingToMargin,
+	{AccountFunding, AccountUSDM}:   binance.UserUniversalTransferTypeFundingToUmFutures,
+	{AccountFunding, AccountCOINM}:  binance.UserUniversalTransferTypeFundingToCmFutures,
+	{AccountMargin, AccountSpot}:    binance.UserUniversalTransferTypeMarginToMain,
+	{AccountMargin, AccountFunding}: binance.UserUniversalTransferTypeMarginToFunding,
+	{AccountMargin, AccountUSDM}:    binance.UserUniversalTransferTypeMarginToUmFutures,
+	{AccountMargin, AccountCOINM}:   binance.UserUniversalTransferTypeMarginToCmFutures,
+	{AccountUSDM, AccountSpot}:      binance.UserUniversalTransferTypeUmFuturesToMain,
+	{AccountUSDM, AccountFunding}:   binance.UserUniversalTransferTypeUmFuturesToFunding,
+	{AccountUSDM, AccountMargin}:    binance.UserUniversalTransferTypeUmFuturesToMargin,
+	{AccountCOINM, AccountSpot}:     binance.UserUniversalTransferTypeCmFuturesToMain,
+	{AccountCOINM, AccountFunding}:  binance.UserUniversalTransferTypeCmFuturesToFunding,
+	{AccountCOINM, AccountMargin}:   binance.UserUniversalTransferTypeCmFuturesToMargin,
+}
+
+// ActionResult define the outcome of an action
+type ActionResult struct {
+	Action Action
+	Status ActionStatus
+	ID     int64 // transfer or purchase id
+	Err    error
+}
+
+// Report define the outcome of a plan
+type Report struct {
+	Time    time.Time
+	Results []ActionResult
+}
+
+// Count return the number of actions with status
+func (r *Report) Count(status ActionStatus) int {
+	n := 0
+	for _, res := range r.Results {
+		if res.Status == status {
+			n++
+		}
+	}
+	return n
+}
+
+// Err return the failures of the actions joined
+func (r *Report) Err() error {
+	var errs []error
+	for _, res := range r.Results {
+		if res.Err != nil {
+			errs = append(errs, fmt.Errorf("treasury: %s %s: %w", res.Action.Policy, res.Action.Type, res.Err))
+		}
+	}
+	return multierr.Join(errs...)
+}
+
+// String return a summary of the report
+func (r *Report) String() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "%d executed, %d skipped, %d failed, %d unknown, %d aborted",
+		r.Count(ActionStatusExecuted),
+		r.Count(ActionStatusSkipped),
+		r.Count(ActionStatusFailed),
+		r.Count(ActionStatusUnknown),
+		r.Count(ActionStatusAborted),
+	)
+	for _, res := range r.Results {
+		a := res.Action
+		fmt.Fprintf(&b, "\n%s %s %s %s %s %s", res.Status, a.Policy, a.Type, a.Amount, a.Asset, a.From)
+		if a.To != "" {
+			fmt.Fprintf(&b, " -> %s", a.To)
+		}
+		if res.Err != nil {
+			fmt.Fprintf(&b, ": %v", res.Err)
+		}
+	}
+	return b.String()
+}
+
+// Store define the storage of the attempted actions, keyed by idempotency key
+type Store interface {
+	// Reserve record an attempt of key, false when already reserved
+	Reserve(ctx context.Context, key string) (bool, error)
+	// Release forget an attempt rejected by the exchange, it may be retried
+	Release(ctx context.Context, key string) error
+}
+
+// MemoryStore store the attempted actions in memory
+type MemoryStore struct {
+	keys idempotency.Map[struct{}]
+}
+
+// NewMemoryStore init an empty memory store
+func NewMemoryStore() *MemoryStore {
+	return new(MemoryStore)
+}
+
+// Reserve record an attempt of key, false when already reserved
+func (s *MemoryStore) Reserve(ctx context.Context, key string) (bool, error) {
+	return s.keys.StoreNew(key, struct{}{}), nil
+}
+
+// Release forget an attempt
+func (s *MemoryStore) Release(ctx context.Context, key string) error {
+	s.keys.Delete(key)
+	return nil
+}
+
+// Execute execute the actions of plan in order, stopping at the first failure. An
+// action is reserved in Store before being sent and released only when rejected by the
+// exchange, so that an action with an unknown outcome is not repeated in the period.
+func (e *Engine) Execute(ctx context.Context, plan *Plan) *Report {
+	report := &Report{Time: plan.Time}
+	stopped := false
+	for _, a := range plan.Actions {
+		res := ActionResult{Action: a}
+		if stopped {
+			res.Status = ActionStatusAborted
+		} else {
+			e.execute(ctx, &res)
+			stopped = res.Status == ActionStatusFailed || res.Status == ActionStatusUnknown
+		}
+		report.Results = append(report.Results, res)
+	}
+	return report
+}
+
+func (e *Engine) execute(ctx context.Context, res *ActionResult) {
+	a := res.Action
+	if err := e.prepare(ctx, &a); err != nil {
+		res.Status, res.Err = ActionStatusFailed, err
+		return
+	}
+	res.Action = a
+	ok, err := e.Store.Reserve(ctx, a.Key)
+	if err != nil {
+		res.Status, res.Err = ActionStatusFailed, err
+		return
+	}
+	if !ok {
+		res.Status = ActionStatusSkipped
+		return
+	}
+	sent := e.now()
+	switch a.Type {
+	case ActionTypeTransfer:
+		res.ID, err = e.transfer(ctx, a)
+	case ActionTypeEarnSubscribe:
+		res.ID, err = e.subscribe(ctx, a)
+	default:
+		err = fmt.Errorf("unsupported action type %s", a.Type)
+	}
+	switch {
+	case err == nil:
+		res.Status = ActionStatusExecuted
+	case idempotency.Rejected(err):
+		res.Status, res.Err = ActionStatusFailed, err
+		if err := e.Store.Release(ctx, a.Key); err != nil {
+			res.Err = multierr.Join(res.Err, err)
+		}
+	default:
+		res.Status, res.Err = ActionStatusUnknown, err
+		if a.Type == ActionTypeTransfer {
+			e.mu.Lock()
+			e.unknown = append(e.unknown, unknownTransfer{action: a, sent: sent})
+			e.mu.Unlock()
+		}
+	}
+}
+
+// resolve look up the transfers with an unknown outcome in the transfer history. A
+// transfer found confirmed was executed and its key stays reserved, a transfer not found
+// or failed was not and its key is released. ErrUnresolvedTransfer is returned while a
+// transfer is pending or the history cannot be fetched, so that no plan is built on
+// balances the transfer may not be reflected in yet.
+func (e *Engine) resolve(ctx context.Context) error {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	var errs []error
+	claimed := map[int64]bool{}
+	unknown := e.unknown[:0]
+	for _, t := range e.unknown {
+		status, err := e.lookup(ctx, t, claimed)
+		switch {
+		case err != nil:
+			errs = append(errs, fmt.Errorf("%w: %s %s %s: %v", ErrUnresolvedTransfer, t.action.Policy, t.action.Amount, t.action.Asset, err))
+		case status == binance.UserUniversalTransferStatusTypePending:
+			errs = append(errs, fmt.Errorf("%w: %s %s %s pending", ErrUnresolvedTransfer, t.action.Policy, t.action.Amount, t.action.Asset))
+		case status == binance.UserUniversalTransferStatusTypeConfirmed:
+			continue
+		default:
+			err := e.Store.Release(ctx, t.action.Key)
+			if err == nil {
+				continue
+			}
+			errs = append(errs, err)
+		}
+		unknown = append(unknown, t)
+	}
+	e.unknown = unknown
+	return multierr.Join(errs...)
+}
+
+// lookup return the status of the first transfer of the history matching t not claimed
+// yet, empty when not found
+func (e *Engine) lookup(ctx context.Context, t unknownTransfer, claimed map[int64]bool) (binance.UserUniversalTransferStatusType, error) {
+	a := t.action
+	start := t.sent.Add(-lookupSlack).UnixMilli()
+	res, err := e.Client.NewListUserUniversalTransferService().
+		Type(transferTypes[[2]Account{a.From, a.To}]).
+		StartTime(start).
+		Size(lookupSize).
+		Do(ctx)
+	if err != nil {
+		return "", err
+	}
+	for _, r := range res.Results {
+		if claimed[r.TranId] || r.Asset != a.Asset || r.Timestamp < start {
+			continue
+		}
+		if amount, err := decimal.NewFromString(r.Amount); err != nil || !amount.Equal(a.Amount) {
+			continue
+		}
+		claimed[r.TranId] = true
+		return r.Status, nil
+	}
+	return "", nil
+}
+
+// prepare check the transfer route and look up the product subscribed by an action
+func (e *Engine) prepare(ctx context.Context, a *Action) error {
+	switch a.Type {
+	case ActionTypeTransfer:
+		if _, ok := transferTypes[[2]Account{a.From, a.To}]; !ok {
+			return fmt.Errorf("%w from %s to %s", ErrUnsupportedTransfer, a.From, a.To)
+		}
+	case ActionTypeEarnSubscribe:
+		if a.ProductID != "" {
+			return nil
+		}
+		res, err := e.Client.NewSimpleEarnService().FlexibleService().ListProduct().Asset(a.Asset).Do(ctx)
+		if err != nil {
+			return err
+		}
+		for _, p := range res.Rows {
+			if p.Asset == a.Asset && p.CanPurchase && !p.IsSoldOut {
+				a.ProductID = p.ProductId
+				return nil
+			}
+		}
+		return fmt.Errorf("%w for %s", ErrNoProduct, a.Asset)
+	}
+	return nil
+}
+
+func (e *Engine) transfer(ctx context.Context, a Action) (int64, error) {
+	res, err := e.Client.NewUserUniversalTransferService().
+		Type(transferTypes[[2]Account{a.From, a.To}]).
+		Asset(a.Asset).
+		Amount(a.Amount.String()).
+		Do(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return res.ID, nil
+}
+
+func (e *Engine) subscribe(ctx context.Context, a Action) (int64, error) {
+	sourceAccount := binance.SourceAccountSpot
+	if a.From == AccountFunding {
+		sourceAccount = binance.SourceAccountFund
+	}
+	res, err := e.Client.NewSimpleEarnService().FlexibleService().Subscribe().
+		ProductId(a.ProductID).
+		Amount(a.Amount.String()).
+		SourceAccount(sourceAccount).
+		Do(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return int64(res.PurchaseId), nil
+}
@@ -0,0 +1,185 @@
+package treasury
+
+import (
+	"fmt"
+
+	"github.com/shopspring/decimal"
+)
+
+func parseAmount(field, v string) (decimal.Decimal, error) {
+	if v == "" {
+		return decimal.Zero, nil
+	}
+	d, err := decimal.NewFromString(v)
+	if err != nil {
+		return decimal.Zero, fmt.Errorf("invalid %s %q: %w", field, v, err)
+	}
+	if d.IsNegative() {
+		return decimal.Zero, fmt.Errorf("negative %s %q", field, v)
+	}
+	return d, nil
+}
+
+// CapBalance keep at most Max of Asset in Account, moving the excess to To. When Keep is
+// set, the balance is brought down to Keep instead of Max once Max is exceeded.
+type CapBalance struct {
+	ID      string
+	Account Account
+	Asset   string
+	Max     string
+	Keep    string
+	To      Account
+}
+
+// Name return the policy id
+func (p *CapBalance) Name() string {
+	return p.ID
+}
+
+// Accounts return the capped account
+func (p *CapBalance) Accounts() []Account {
+	return []Account{p.Account}
+}
+
+// Evaluate return the transfer of the excess balance
+func (p *CapBalance) Evaluate(state *State) ([]Action, error) {
+	max, err := parseAmount("max", p.Max)
+	if err != nil {
+		return nil, err
+	}
+	keep := max
+	if p.Keep != "" {
+		if keep, err = parseAmount("keep", p.Keep); err != nil {
+			return nil, err
+		}
+		if keep.GreaterThan(max) {
+			return nil, fmt.Errorf("keep %s above max %s", keep, max)
+		}
+	}
+	free := state.Free(p.Account, p.Asset)
+	if !free.GreaterThan(max) {
+		return nil, nil
+	}
+	return []Action{{
+		Type:   ActionTypeTransfer,
+		From:   p.Account,
+		To:     p.To,
+		Asset:  p.Asset,
+		Amount: free.Sub(keep),
+		Reason: fmt.Sprintf("%s %s balance %s above %s", p.Account, p.Asset, free, max),
+	}}, nil
+}
+
+// MarginTopUp transfer Asset from From to the USD-M futures account when the margin
+// balance falls below MinRatio times the maintenance margin, bringing it back to
+// TargetRatio times the maintenance margin. Amounts are capped by MaxAmount when set.
+type MarginTopUp struct {
+	ID          string
+	From        Account
+	Asset       string
+	MinRatio    string
+	TargetRatio string
+	MaxAmount   string
+}
+
+// Name return the policy id
+func (p *MarginTopUp) Name() string {
+	return p.ID
+}
+
+// Accounts return the funding and USD-M accounts
+func (p *MarginTopUp) Accounts() []Account {
+	return []Account{p.From, AccountUSDM}
+}
+
+// Evaluate return the transfer topping up the margin
+func (p *MarginTopUp) Evaluate(state *State) ([]Action, error) {
+	if state.USDM == nil {
+		return nil, errNoFuturesState
+	}
+	minRatio, err := parseAmount("min ratio", p.MinRatio)
+	if err != nil {
+		return nil, err
+	}
+	target, err := parseAmount("target ratio", p.TargetRatio)
+	if err != nil {
+		return nil, err
+	}
+	if target.LessThan(minRatio) {
+		target = minRatio
+	}
+	maxAmount, err := parseAmount("max amount", p.MaxAmount)
+	if err != nil {
+		return nil, err
+	}
+	ratio, ok := state.USDM.Ratio()
+	if !ok || !ratio.LessThan(minRatio) {
+		return nil, nil
+	}
+	amount := target.Mul(state.USDM.MaintMargin).Sub(state.USDM.MarginBalance)
+	amount = decimal.Min(amount, state.Free(p.From, p.Asset))
+	if !maxAmount.IsZero() {
+		amount = decimal.Min(amount, maxAmount)
+	}
+	if !amount.IsPositive() {
+		return nil, nil
+	}
+	return []Action{{
+		Type:   ActionTypeTransfer,
+		From:   p.From,
+		To:     AccountUSDM,
+		Asset:  p.Asset,
+		Amount: amount,
+		Reason: fmt.Sprintf("USD-M margin ratio %s below %s", ratio.StringFixed(4), minRatio),
+	}}, nil
+}
+
+// IdleToEarn subscribe the balance of Asset in Account above Keep to a Simple Earn
+// flexible product, when at least MinAmount. Account is SPOT or FUNDING.
+type IdleToEarn struct {
+	ID        string
+	Account   Account
+	Asset     string
+	Keep      string
+	MinAmount string
+	// ProductID is the flexible product subscribed, looked up by asset when empty
+	ProductID string
+}
+
+// Name return the policy id
+func (p *IdleToEarn) Name() string {
+	return p.ID
+}
+
+// Accounts return the account of the idle funds
+func (p *IdleToEarn) Accounts() []Account {
+	return []Account{p.Account}
+}
+
+// Evaluate return the subscription of the idle funds
+func (p *IdleToEarn) Evaluate(state *State) ([]Action, error) {
+	if p.Account != AccountSpot && p.Account != AccountFunding {
+		return nil, fmt.Errorf("unsupported earn source account %s", p.Account)
+	}
+	keep, err := parseAmount("keep", p.Keep)
+	if err != nil {
+		return nil, err
+	}
+	minAmount, err := parseAmount("min amount", p.MinAmount)
+	if err != nil {
+		return nil, err
+	}
+	free := state.Free(p.Account, p.Asset)
+	amount := free.Sub(keep)
+	if !amount.IsPositive() || amount.LessThan(minAmount) {
+		return nil, nil
+	}
+	return []Action{{
+		Type:      ActionTypeEarnSubscribe,
+		From:      p.Account,
+		Asset:     p.Asset,
+		Amount:    amount,
+		ProductID: p.ProductID,
+		Reason:    fmt.Sprintf("%s %s idle balance %s above %s", p.Account, p.Asset, free, keep),
+	}}, nil
+}
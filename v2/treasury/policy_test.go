@@ -0,0 +1,151 @@
+package treasury
+
+import (
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/suite"
+)
+
+type policyTestSuite struct {
+	suite.Suite
+	state *State
+}
+
+func TestPolicy(t *testing.T) {
+	suite.Run(t, new(policyTestSuite))
+}
+
+func (s *policyTestSuite) SetupTest() {
+	s.state = NewState(time.Unix(1700000000, 0))
+	s.state.SetFree(AccountSpot, "USDT", s.dec("15000"))
+	s.state.SetFree(AccountFunding, "USDT", s.dec("500"))
+	s.state.USDM = &MarginState{MarginBalance: s.dec("1000"), MaintMargin: s.dec("800")}
+}
+
+func (s *policyTestSuite) dec(v string) decimal.Decimal {
+	return decimal.RequireFromString(v)
+}
+
+func (s *policyTestSuite) assertAction(expected Action, actual Action) {
+	s.Require().True(expected.Amount.Equal(actual.Amount), "expected %s, got %s", expected.Amount, actual.Amount)
+	expected.Amount, actual.Amount = decimal.Zero, decimal.Zero
+	actual.Reason = ""
+	s.Equal(expected, actual)
+}
+
+func (s *policyTestSuite) TestCapBalance() {
+	p := &CapBalance{ID: "cap", Account: AccountSpot, Asset: "USDT", Max: "10000", To: AccountFunding}
+	actions, err := p.Evaluate(s.state)
+	s.Require().NoError(err)
+	s.Require().Len(actions, 1)
+	s.assertAction(Action{Type: ActionTypeTransfer, From: AccountSpot, To: AccountFunding, Asset: "USDT", Amount: s.dec("5000")}, actions[0])
+	s.Equal("SPOT USDT balance 15000 above 10000", actions[0].Reason)
+
+	p.Keep = "8000"
+	actions, err = p.Evaluate(s.state)
+	s.Require().NoError(err)
+	s.True(actions[0].Amount.Equal(s.dec("7000")))
+
+	p.Max = "20000"
+	actions, err = p.Evaluate(s.state)
+	s.Require().NoError(err)
+	s.Empty(actions)
+
+	p.Keep = "30000"
+	_, err = p.Evaluate(s.state)
+	s.Error(err)
+	p.Max = "x"
+	_, err = p.Evaluate(s.state)
+	s.Error(err)
+}
+
+func (s *policyTestSuite) TestMarginTopUp() {
+	p := &MarginTopUp{ID: "margin", From: AccountSpot, Asset: "USDT", MinRatio: "1.5", TargetRatio: "2"}
+	s.ElementsMatch([]Account{AccountSpot, AccountUSDM}, p.Accounts())
+	actions, err := p.Evaluate(s.state)
+	s.Require().NoError(err)
+	s.Require().Len(actions, 1)
+	s.assertAction(Action{Type: ActionTypeTransfer, From: AccountSpot, To: AccountUSDM, Asset: "USDT", Amount: s.dec("600")}, actions[0])
+	s.Equal("USD-M margin ratio 1.2500 below 1.5", actions[0].Reason)
+
+	p.MaxAmount = "100"
+	actions, err = p.Evaluate(s.state)
+	s.Require().NoError(err)
+	s.True(actions[0].Amount.Equal(s.dec("100")))
+
+	// capped by the free balance
+	p.MaxAmount = ""
+	p.From = AccountFunding
+	actions, err = p.Evaluate(s.state)
+	s.Require().NoError(err)
+	s.True(actions[0].Amount.Equal(s.dec("500")))
+
+	s.state.USDM.MarginBalance = s.dec("1200")
+	actions, err = p.Evaluate(s.state)
+	s.Require().NoError(err)
+	s.Empty(actions)
+
+	s.state.USDM.MaintMargin = decimal.Zero
+	actions, err = p.Evaluate(s.state)
+	s.Require().NoError(err)
+	s.Empty(actions)
+
+	s.state.USDM = nil
+	_, err = p.Evaluate(s.state)
+	s.Error(err)
+}
+
+func (s *policyTestSuite) TestIdleToEarn() {
+	p := &IdleToEarn{ID: "earn", Account: AccountFunding, Asset: "USDT", Keep: "100", MinAmount: "10"}
+	actions, err := p.Evaluate(s.state)
+	s.Require().NoError(err)
+	s.Require().Len(actions, 1)
+	s.assertAction(Action{Type: ActionTypeEarnSubscribe, From: AccountFunding, Asset: "USDT", Amount: s.dec("400")}, actions[0])
+
+	p.Keep = "495"
+	actions, err = p.Evaluate(s.state)
+	s.Require().NoError(err)
+	s.Empty(actions)
+
+	p.Account = AccountUSDM
+	_, err = p.Evaluate(s.state)
+	s.Error(err)
+}
+
+func (s *policyTestSuite) TestPlanState() {
+	e := NewEngine(nil,
+		&CapBalance{ID: "cap", Account: AccountSpot, Asset: "USDT", Max: "10000", To: AccountFunding},
+		&MarginTopUp{ID: "margin", From: AccountFunding, Asset: "USDT", MinRatio: "1.5", TargetRatio: "2"},
+		&IdleToEarn{ID: "earn", Account: AccountFunding, Asset: "USDT", Keep: "1000"},
+	)
+	plan, err := e.PlanState(s.state)
+	s.Require().NoError(err)
+	s.Equal(s.state, plan.State)
+	s.True(s.state.Free(AccountSpot, "USDT").Equal(s.dec("15000")), "the state is not modified")
+	s.Require().Len(plan.Actions, 3)
+	// the funding account received 5000 from spot, sent 600 to USD-M and keeps 1000
+	s.True(plan.Actions[0].Amount.Equal(s.dec("5000")))
+	s.Equal("cap", plan.Actions[0].Policy)
+	s.True(plan.Actions[1].Amount.Equal(s.dec("600")))
+	s.True(plan.Actions[2].Amount.Equal(s.dec("3900")))
+	s.Equal("earn", plan.Actions[2].Policy)
+	for _, a := range plan.Actions {
+		s.Len(a.Key, keyLength)
+	}
+
+	// the keys are stable within the evaluation period
+	s.state.Time = s.state.Time.Add(10 * time.Second)
+	again, err := e.PlanState(s.state)
+	s.Require().NoError(err)
+	s.Equal(plan.Actions[0].Key, again.Actions[0].Key)
+	s.state.Time = s.state.Time.Add(time.Minute)
+	later, err := e.PlanState(s.state)
+	s.Require().NoError(err)
+	s.NotEqual(plan.Actions[0].Key, later.Actions[0].Key)
+
+	e.Policies = append(e.Policies, &CapBalance{ID: "cap"})
+	_, err = e.PlanState(s.state)
+	s.ErrorContains(err, `duplicate policy "cap"`)
+}
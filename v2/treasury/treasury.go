@@ -0,0 +1,355 @@
+// Package treasury sweeps funds between the accounts of a user following policies, e.g.
+// keep at most 10000 USDT in spot, top up the USD-M futures margin when it runs low and
+// subscribe idle funds to Simple Earn flexible products.
+//
+// An Engine fetches the balances of the accounts the policies act on, evaluates the
+// policies in order and executes the resulting transfers and subscriptions:
+//
+//	e := treasury.NewEngine(client,
+//		&treasury.CapBalance{ID: "spot-usdt", Account: treasury.AccountSpot, Asset: "USDT", Max: "10000", To: treasury.AccountFunding},
+//		&treasury.IdleToEarn{ID: "earn-usdt", Account: treasury.AccountFunding, Asset: "USDT", Keep: "1000"},
+//	)
+//	plan, err := e.Plan(ctx) // dry run
+//	err = e.Run(ctx, func(report *treasury.Report, err error) { ... })
+//
+// Every action has an idempotency key derived from the policy, the action and the
+// evaluation period, so that an action is executed at most once per period even when
+// the balances have not been updated yet or the outcome of a transfer is unknown. A
+// transfer with an unknown outcome is looked up in the transfer history before the next
+// evaluation.
+package treasury
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/shopspring/decimal"
+
+	"github.com/adshao/go-binance/v2"
+	"github.com/adshao/go-binance/v2/futures"
+)
+
+// Account define an account funds are swept between
+type Account string
+
+// ActionType define the type of an action
+type ActionType string
+
+// Global enums
+const (
+	AccountSpot    Account = "SPOT"
+	AccountFunding Account = "FUNDING"
+	AccountMargin  Account = "MARGIN"
+	AccountUSDM    Account = "USDM"
+	AccountCOINM   Account = "COINM"
+
+	ActionTypeTransfer      ActionType = "TRANSFER"
+	ActionTypeEarnSubscribe ActionType = "EARN_SUBSCRIBE"
+
+	defaultInterval = time.Minute
+	keyLength       = 32
+)
+
+// Action define a transfer or a subscription required by a policy
+type Action struct {
+	Key    string // idempotency key
+	Policy string
+	Type   ActionType
+	From   Account
+	To     Account // empty for subscriptions
+	Asset  string
+	Amount decimal.Decimal
+	// ProductID is the flexible product subscribed, looked up by asset when empty
+	ProductID string
+	Reason    string
+}
+
+// MarginState define the margin of the USD-M futures account
+type MarginState struct {
+	MarginBalance decimal.Decimal
+	MaintMargin   decimal.Decimal
+}
+
+// Ratio return the margin balance over the maintenance margin, the inverse of the margin
+// ratio shown by Binance, false without maintenance margin
+func (m MarginState) Ratio() (decimal.Decimal, bool) {
+	if !m.MaintMargin.IsPositive() {
+		return decimal.Zero, false
+	}
+	return m.MarginBalance.Div(m.MaintMargin), true
+}
+
+// State define the balances policies are evaluated against
+type State struct {
+	Time     time.Time
+	Balances map[Account]map[string]decimal.Decimal // free balances
+	USDM     *MarginState                           // nil when the USD-M account is not fetched
+}
+
+// NewState init an empty state
+func NewState(t time.Time) *State {
+	return &State{Time: t, Balances: map[Account]map[string]decimal.Decimal{}}
+}
+
+// Free return the free balance of asset in account
+func (s *State) Free(account Account, asset string) decimal.Decimal {
+	return s.Balances[account][asset]
+}
+
+// SetFree set the free balance of asset in account
+func (s *State) SetFree(account Account, asset string, amount decimal.Decimal) {
+	if s.Balances[account] == nil {
+		s.Balances[account] = map[string]decimal.Decimal{}
+	}
+	s.Balances[account][asset] = amount
+}
+
+// apply update the state with the outcome of an action, so that the following policies
+// are evaluated after it. The USD-M margin balance is updated 1:1 with the amount.
+func (s *State) apply(a Action) {
+	s.SetFree(a.From, a.Asset, s.Free(a.From, a.Asset).Sub(a.Amount))
+	if a.Type != ActionTypeTransfer {
+		return
+	}
+	s.SetFree(a.To, a.Asset, s.Free(a.To, a.Asset).Add(a.Amount))
+	if s.USDM == nil {
+		return
+	}
+	if a.To == AccountUSDM {
+		s.USDM.MarginBalance = s.USDM.MarginBalance.Add(a.Amount)
+	}
+	if a.From == AccountUSDM {
+		s.USDM.MarginBalance = s.USDM.MarginBalance.Sub(a.Amount)
+	}
+}
+
+// Policy define a sweep policy
+type Policy interface {
+	// Name identifies the policy in the idempotency keys, it must be unique
+	Name() string
+	// Accounts return the accounts the policy reads
+	Accounts() []Account
+	// Evaluate return the actions required by the state
+	Evaluate(state *State) ([]Action, error)
+}
+
+// Plan define the actions required by the policies
+type Plan struct {
+	Time    time.Time
+	State   *State // before the actions
+	Actions []Action
+}
+
+// Engine evaluate the policies and execute the actions
+type Engine struct {
+	Client   *binance.Client
+	Futures  *futures.Client // required by the policies reading the USD-M account
+	Policies []Policy
+	// Store tracks the executed actions, in memory when not set
+	Store Store
+	// Interval is the evaluation period of Run and of the idempotency keys, 1 minute when not set
+	Interval time.Duration
+
+	now     func() time.Time
+	mu      sync.Mutex
+	unknown []unknownTransfer
+}
+
+// NewEngine init an engine of policies executed with c
+func NewEngine(c *binance.Client, policies ...Policy) *Engine {
+	return &Engine{
+		Client:   c,
+		Policies: policies,
+		Store:    NewMemoryStore(),
+		now:      time.Now,
+	}
+}
+
+func (e *Engine) interval() time.Duration {
+	if e.Interval <= 0 {
+		return defaultInterval
+	}
+	return e.Interval
+}
+
+// Fetch fetch the balances of the accounts read by the policies
+func (e *Engine) Fetch(ctx context.Context) (*State, error) {
+	accounts := map[Account]bool{}
+	for _, p := range e.Policies {
+		for _, a := range p.Accounts() {
+			accounts[a] = true
+		}
+	}
+	state := NewState(e.now())
+	if accounts[AccountSpot] {
+		res, err := e.Client.NewGetAccountService().OmitZeroBalances(true).Do(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("treasury: fetch %s: %w", AccountSpot, err)
+		}
+		for _, b := range res.Balances {
+			if err := setFree(state, AccountSpot, b.Asset, b.Free); err != nil {
+				return nil, err
+			}
+		}
+	}
+	if accounts[AccountFunding] {
+		res, err := e.Client.NewGetFundingAssetService().Do(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("treasury: fetch %s: %w", AccountFunding, err)
+		}
+		for _, b := range res {
+			if err := setFree(state, AccountFunding, b.Asset, b.Free); err != nil {
+				return nil, err
+			}
+		}
+	}
+	if accounts[AccountMargin] {
+		res, err := e.Client.NewGetMarginAccountService().Do(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("treasury: fetch %s: %w", AccountMargin, err)
+		}
+		for _, b := range res.UserAssets {
+			if err := setFree(state, AccountMargin, b.Asset, b.Free); err != nil {
+				return nil, err
+			}
+		}
+	}
+	if accounts[AccountUSDM] {
+		if e.Futures == nil {
+			return nil, fmt.Errorf("treasury: no futures client to fetch %s", AccountUSDM)
+		}
+		res, err := e.Futures.NewGetAccountService().Do(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("treasury: fetch %s: %w", AccountUSDM, err)
+		}
+		for _, b := range res.Assets {
+			if err := setFree(state, AccountUSDM, b.Asset, b.MaxWithdrawAmount); err != nil {
+				return nil, err
+			}
+		}
+		margin := new(MarginState)
+		if margin.MarginBalance, err = decimal.NewFromString(res.TotalMarginBalance); err != nil {
+			return nil, fmt.Errorf("treasury: invalid margin balance %q: %w", res.TotalMarginBalance, err)
+		}
+		if margin.MaintMargin, err = decimal.NewFromString(res.TotalMaintMargin); err != nil {
+			return nil, fmt.Errorf("treasury: invalid maint margin %q: %w", res.TotalMaintMargin, err)
+		}
+		state.USDM = margin
+	}
+	return state, nil
+}
+
+func setFree(state *State, account Account, asset, free string) error {
+	d, err := decimal.NewFromString(free)
+	if err != nil {
+		return fmt.Errorf("treasury: invalid %s %s balance %q: %w", account, asset, free, err)
+	}
+	if !d.IsZero() {
+		state.SetFree(account, asset, d)
+	}
+	return nil
+}
+
+// PlanState evaluate the policies in order against state, each policy sees the actions of
+// the previous ones applied
+func (e *Engine) PlanState(state *State) (*Plan, error) {
+	plan := &Plan{Time: state.Time, State: state}
+	current := state.clone()
+	period := state.Time.Truncate(e.interval()).Unix()
+	names := map[string]bool{}
+	for _, p := range e.Policies {
+		if names[p.Name()] {
+			return nil, fmt.Errorf("treasury: duplicate policy %q", p.Name())
+		}
+		names[p.Name()] = true
+		actions, err := p.Evaluate(current)
+		if err != nil {
+			return nil, fmt.Errorf("treasury: policy %s: %w", p.Name(), err)
+		}
+		for _, a := range actions {
+			if !a.Amount.IsPositive() {
+				continue
+			}
+			a.Policy = p.Name()
+			a.Key = actionKey(period, a)
+			current.apply(a)
+			plan.Actions = append(plan.Actions, a)
+		}
+	}
+	return plan, nil
+}
+
+// Plan fetch the balances and return the actions required by the policies, without
+// executing them
+func (e *Engine) Plan(ctx context.Context) (*Plan, error) {
+	state, err := e.Fetch(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return e.PlanState(state)
+}
+
+// Evaluate plan and execute the actions required by the policies. The transfers with an
+// unknown outcome are first looked up in the transfer history, no plan is built until
+// they are resolved.
+func (e *Engine) Evaluate(ctx context.Context) (*Report, error) {
+	if err := e.resolve(ctx); err != nil {
+		return nil, err
+	}
+	plan, err := e.Plan(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return e.Execute(ctx, plan), nil
+}
+
+// Run evaluate the policies at every interval until ctx is done, handler receives the
+// outcome of every evaluation
+func (e *Engine) Run(ctx context.Context, handler func(report *Report, err error)) error {
+	ticker := time.NewTicker(e.interval())
+	defer ticker.Stop()
+	for {
+		report, err := e.Evaluate(ctx)
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		if handler != nil {
+			handler(report, err)
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+func (s *State) clone() *State {
+	c := NewState(s.Time)
+	for account, balances := range s.Balances {
+		for asset, amount := range balances {
+			c.SetFree(account, asset, amount)
+		}
+	}
+	if s.USDM != nil {
+		margin := *s.USDM
+		c.USDM = &margin
+	}
+	return c
+}
+
+// actionKey return the idempotency key of an action in an evaluation period
+func actionKey(period int64, a Action) string {
+	h := sha256.Sum256([]byte(strings.Join([]string{
+		fmt.Sprint(period), a.Policy, string(a.Type), string(a.From), string(a.To), a.Asset, a.Amount.String(),
+	}, "|")))
+	return hex.EncodeToString(h[:])[:keyLength]
+}
+
+var errNoFuturesState = errors.New("USD-M account not fetched")
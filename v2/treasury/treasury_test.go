@@ -0,0 +1,245 @@
+package treasury
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/adshao/go-binance/v2"
+	"github.com/adshao/go-binance/v2/futures"
+)
+
+type engineTestSuite struct {
+	suite.Suite
+	server *httptest.Server
+	e      *Engine
+
+	mu            sync.Mutex
+	paths         []string
+	transfers     []url.Values
+	subscriptions []url.Values
+	transferResp  func() (int, string)
+	history       []string
+}
+
+func TestEngine(t *testing.T) {
+	suite.Run(t, new(engineTestSuite))
+}
+
+func (s *engineTestSuite) SetupTest() {
+	s.paths = nil
+	s.transfers = nil
+	s.subscriptions = nil
+	s.transferResp = nil
+	s.history = nil
+	s.server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_ = r.ParseForm()
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		s.paths = append(s.paths, r.Method+" "+r.URL.Path)
+		code, body := s.respond(r.Method+" "+r.URL.Path, r.Form)
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(code)
+		_, _ = w.Write([]byte(body))
+	}))
+	c := binance.NewClient("dummy", "dummy")
+	c.BaseURL = s.server.URL
+	s.e = NewEngine(c,
+		&CapBalance{ID: "cap", Account: AccountSpot, Asset: "USDT", Max: "10000", To: AccountFunding},
+		&MarginTopUp{ID: "margin", From: AccountFunding, Asset: "USDT", MinRatio: "1.5", TargetRatio: "2"},
+		&IdleToEarn{ID: "earn", Account: AccountFunding, Asset: "USDT", Keep: "1000"},
+	)
+	s.e.Futures = futures.NewClient("dummy", "dummy")
+	s.e.Futures.BaseURL = s.server.URL
+	s.e.now = func() time.Time { return time.Unix(1700000000, 0) }
+}
+
+func (s *engineTestSuite) TearDownTest() {
+	s.server.Close()
+}
+
+func (s *engineTestSuite) respond(path string, params url.Values) (int, string) {
+	switch path {
+	case "GET /api/v3/account":
+		return http.StatusOK, `{"balances": [{"asset": "USDT", "free": "15000", "locked": "10"}, {"asset": "BTC", "free": "0", "locked": "0"}]}`
+	case "POST /sapi/v1/asset/get-funding-asset":
+		return http.StatusOK, `[{"asset": "USDT", "free": "500", "locked": "0"}]`
+	case "GET /fapi/v2/account":
+		return http.StatusOK, `{"totalMarginBalance": "1000", "totalMaintMargin": "800", "assets": [{"asset": "USDT", "maxWithdrawAmount": "200"}]}`
+	case "GET /sapi/v1/simple-earn/flexible/list":
+		return http.StatusOK, `{"rows": [
+			{"asset": "USDT", "productId": "USDT001", "canPurchase": false, "isSoldOut": false},
+			{"asset": "USDT", "productId": "USDT002", "canPurchase": true, "isSoldOut": false}
+		], "total": 2}`
+	case "POST /sapi/v1/simple-earn/flexible/subscribe":
+		s.subscriptions = append(s.subscriptions, params)
+		return http.StatusOK, fmt.Sprintf(`{"purchaseId": %d, "success": true}`, len(s.subscriptions))
+	case "GET /sapi/v1/asset/transfer":
+		return http.StatusOK, fmt.Sprintf(`{"total": %d, "rows": [%s]}`, len(s.history), strings.Join(s.history, ","))
+	case "POST /sapi/v1/asset/transfer":
+		if s.transferResp != nil {
+			if code, body := s.transferResp(); code != http.StatusOK {
+				return code, body
+			}
+		}
+		s.transfers = append(s.transfers, params)
+		return http.StatusOK, fmt.Sprintf(`{"tranId": %d}`, len(s.transfers))
+	}
+	return http.StatusNotFound, `{"code": -1000, "msg": "not found"}`
+}
+
+func (s *engineTestSuite) TestFetch() {
+	state, err := s.e.Fetch(context.Background())
+	s.Require().NoError(err)
+	s.Equal("15000", state.Free(AccountSpot, "USDT").String())
+	s.NotContains(state.Balances[AccountSpot], "BTC")
+	s.Equal("500", state.Free(AccountFunding, "USDT").String())
+	s.Equal("200", state.Free(AccountUSDM, "USDT").String())
+	s.Equal("1000", state.USDM.MarginBalance.String())
+	s.ElementsMatch([]string{"GET /api/v3/account", "POST /sapi/v1/asset/get-funding-asset", "GET /fapi/v2/account"}, s.paths)
+
+	s.e.Futures = nil
+	_, err = s.e.Fetch(context.Background())
+	s.ErrorContains(err, "no futures client")
+}
+
+func (s *engineTestSuite) TestPlan() {
+	plan, err := s.e.Plan(context.Background())
+	s.Require().NoError(err)
+	s.Len(plan.Actions, 3)
+	s.Empty(s.transfers)
+	s.Empty(s.subscriptions)
+}
+
+func (s *engineTestSuite) TestEvaluate() {
+	report, err := s.e.Evaluate(context.Background())
+	s.Require().NoError(err)
+	s.NoError(report.Err())
+	s.Equal(3, report.Count(ActionStatusExecuted))
+	s.Require().Len(s.transfers, 2)
+	s.Equal("MAIN_FUNDING", s.transfers[0].Get("type"))
+	s.Equal("5000", s.transfers[0].Get("amount"))
+	s.Equal("FUNDING_UMFUTURE", s.transfers[1].Get("type"))
+	s.Equal("600", s.transfers[1].Get("amount"))
+	s.Require().Len(s.subscriptions, 1)
+	s.Equal("USDT002", s.subscriptions[0].Get("productId"))
+	s.Equal("3900", s.subscriptions[0].Get("amount"))
+	s.Equal("FUND", s.subscriptions[0].Get("sourceAccount"))
+	s.Equal("USDT002", report.Results[2].Action.ProductID)
+	s.Equal(int64(1), report.Results[2].ID)
+
+	// the balances are not updated yet, the actions are not repeated in the period
+	report, err = s.e.Evaluate(context.Background())
+	s.Require().NoError(err)
+	s.Equal(3, report.Count(ActionStatusSkipped))
+	s.Len(s.transfers, 2)
+	s.Contains(report.String(), "0 executed, 3 skipped")
+
+	// a new period evaluates the policies again
+	s.e.now = func() time.Time { return time.Unix(1700000000, 0).Add(time.Minute) }
+	report, err = s.e.Evaluate(context.Background())
+	s.Require().NoError(err)
+	s.Equal(3, report.Count(ActionStatusExecuted))
+}
+
+func (s *engineTestSuite) TestEvaluateRejected() {
+	s.transferResp = func() (int, string) {
+		return http.StatusBadRequest, `{"code": -5002, "msg": "insufficient balance"}`
+	}
+	report, err := s.e.Evaluate(context.Background())
+	s.Require().NoError(err)
+	s.Equal(ActionStatusFailed, report.Results[0].Status)
+	s.Equal(2, report.Count(ActionStatusAborted))
+	s.ErrorContains(report.Err(), "treasury: cap TRANSFER: <APIError> code=-5002")
+	s.Contains(report.String(), "FAILED cap TRANSFER 5000 USDT SPOT -> FUNDING")
+
+	// a rejected action is retried
+	s.transferResp = nil
+	report, err = s.e.Evaluate(context.Background())
+	s.Require().NoError(err)
+	s.Equal(3, report.Count(ActionStatusExecuted))
+}
+
+func (s *engineTestSuite) TestEvaluateUnknown() {
+	s.transferResp = func() (int, string) {
+		return http.StatusBadGateway, `<html>bad gateway</html>`
+	}
+	report, err := s.e.Evaluate(context.Background())
+	s.Require().NoError(err)
+	s.Equal(ActionStatusUnknown, report.Results[0].Status)
+	s.Equal(2, report.Count(ActionStatusAborted))
+
+	// an action with an unknown outcome is not retried in the period
+	s.transferResp = nil
+	s.history = []string{`{"asset": "USDT", "amount": "5000", "type": "MAIN_FUNDING", "status": "CONFIRMED", "tranId": 7, "timestamp": 1700000000500}`}
+	report, err = s.e.Evaluate(context.Background())
+	s.Require().NoError(err)
+	s.Equal(ActionStatusSkipped, report.Results[0].Status)
+	s.Equal(2, report.Count(ActionStatusExecuted))
+	s.Empty(s.e.unknown)
+}
+
+func (s *engineTestSuite) TestEvaluateUnknownResolved() {
+	s.transferResp = func() (int, string) {
+		return http.StatusBadGateway, `<html>bad gateway</html>`
+	}
+	report, err := s.e.Evaluate(context.Background())
+	s.Require().NoError(err)
+	s.Equal(ActionStatusUnknown, report.Results[0].Status)
+	s.Require().Len(s.e.unknown, 1)
+
+	// no plan is built while the transfer is pending
+	s.transferResp = nil
+	s.paths = nil
+	s.history = []string{
+		`{"asset": "USDT", "amount": "5000", "type": "MAIN_FUNDING", "status": "CONFIRMED", "tranId": 6, "timestamp": 1699999000000}`,
+		`{"asset": "USDT", "amount": "5000", "type": "MAIN_FUNDING", "status": "PENDING", "tranId": 7, "timestamp": 1700000000500}`,
+	}
+	s.e.now = func() time.Time { return time.Unix(1700000000, 0).Add(time.Minute) }
+	_, err = s.e.Evaluate(context.Background())
+	s.ErrorIs(err, ErrUnresolvedTransfer)
+	s.ErrorContains(err, "cap 5000 USDT pending")
+	s.Empty(s.transfers)
+	s.Equal([]string{"GET /sapi/v1/asset/transfer"}, s.paths)
+
+	// a transfer failed or not found was not executed, it is retried in the period
+	s.history = []string{`{"asset": "USDT", "amount": "5000", "type": "MAIN_FUNDING", "status": "FAILED", "tranId": 7, "timestamp": 1700000000500}`}
+	s.e.now = func() time.Time { return time.Unix(1700000000, 0) }
+	report, err = s.e.Evaluate(context.Background())
+	s.Require().NoError(err)
+	s.Equal(3, report.Count(ActionStatusExecuted))
+	s.Empty(s.e.unknown)
+}
+
+func (s *engineTestSuite) TestEvaluateUnsupportedTransfer() {
+	s.e.Policies = []Policy{&CapBalance{ID: "cap", Account: AccountSpot, Asset: "USDT", Max: "10000", To: AccountSpot}}
+	report, err := s.e.Evaluate(context.Background())
+	s.Require().NoError(err)
+	s.ErrorIs(report.Results[0].Err, ErrUnsupportedTransfer)
+	s.Empty(s.transfers)
+}
+
+func (s *engineTestSuite) TestRun() {
+	s.e.Interval = 10 * time.Millisecond
+	ctx, cancel := context.WithCancel(context.Background())
+	var reports []*Report
+	err := s.e.Run(ctx, func(report *Report, err error) {
+		s.NoError(err)
+		reports = append(reports, report)
+		if len(reports) == 2 {
+			cancel()
+		}
+	})
+	s.ErrorIs(err, context.Canceled)
+	s.Require().Len(reports, 2)
+	s.Equal(3, reports[0].Count(ActionStatusExecuted))
+	s.Equal(3, reports[1].Count(ActionStatusSkipped))
+}
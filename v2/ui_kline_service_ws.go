@@ -0,0 +1,194 @@
+package binance
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/adshao/go-binance/v2/common"
+	"github.com/adshao/go-binance/v2/common/websocket"
+)
+
+// UiKlinesWsService gets klines optimized for presentation
+type UiKlinesWsService struct {
+	c websocket.Client
+}
+
+// NewUiKlinesWsService init UiKlinesWsService
+func NewUiKlinesWsService() (*UiKlinesWsService, error) {
+	conn, err := websocket.NewConnection(WsApiInitReadWriteConn, WebsocketKeepalive, WebsocketTimeoutReadWriteConnection)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := websocket.NewClient(conn)
+	if err != nil {
+		return nil, err
+	}
+
+	return &UiKlinesWsService{
+		c: client,
+	}, nil
+}
+
+// UiKlinesWsRequest parameters for 'uiKlines' websocket API
+type UiKlinesWsRequest struct {
+	symbol    string
+	interval  string
+	startTime *int64
+	endTime   *int64
+	timeZone  *string
+	limit     *int
+}
+
+// NewUiKlinesWsRequest init UiKlinesWsRequest
+func NewUiKlinesWsRequest() *UiKlinesWsRequest {
+	return &UiKlinesWsRequest{}
+}
+
+func (s *UiKlinesWsRequest) GetParams() map[string]interface{} {
+	return s.buildParams()
+}
+
+// buildParams builds params
+func (s *UiKlinesWsRequest) buildParams() params {
+	m := params{
+		"symbol":   s.symbol,
+		"interval": s.interval,
+	}
+	if s.startTime != nil {
+		m["startTime"] = *s.startTime
+	}
+	if s.endTime != nil {
+		m["endTime"] = *s.endTime
+	}
+	if s.timeZone != nil {
+		m["timeZone"] = *s.timeZone
+	}
+	if s.limit != nil {
+		m["limit"] = *s.limit
+	}
+	return m
+}
+
+// Do - sends 'uiKlines' request
+func (s *UiKlinesWsService) Do(requestID string, request *UiKlinesWsRequest) error {
+	rawData, err := websocket.CreateRequestWithSigned(
+		requestID,
+		websocket.UiKlinesSpotWsApiMethod,
+		request.buildParams(),
+	)
+	if err != nil {
+		return err
+	}
+
+	if err := s.c.Write(requestID, rawData); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// SyncDo - sends 'uiKlines' request and receives response
+func (s *UiKlinesWsService) SyncDo(requestID string, request *UiKlinesWsRequest) (*UiKlinesWsResponse, error) {
+	rawData, err := websocket.CreateRequestWithSigned(
+		requestID,
+		websocket.UiKlinesSpotWsApiMethod,
+		request.buildParams(),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := s.c.WriteSync(requestID, rawData, websocket.WriteSyncWsTimeout)
+	if err != nil {
+		return nil, err
+	}
+
+	uiKlinesWsResponse := &UiKlinesWsResponse{}
+	if err := json.Unmarshal(response, uiKlinesWsResponse); err != nil {
+		return nil, err
+	}
+
+	return uiKlinesWsResponse, nil
+}
+
+// ReceiveAllDataBeforeStop waits until all responses will be received from websocket until timeout expired
+func (s *UiKlinesWsService) ReceiveAllDataBeforeStop(timeout time.Duration) {
+	s.c.Wait(timeout)
+}
+
+// GetReadChannel returns channel with API response data (including API errors)
+func (s *UiKlinesWsService) GetReadChannel() <-chan []byte {
+	return s.c.GetReadChannel()
+}
+
+// GetReadErrorChannel returns channel with errors which are occurred while reading websocket connection
+func (s *UiKlinesWsService) GetReadErrorChannel() <-chan error {
+	return s.c.GetReadErrorChannel()
+}
+
+// GetReconnectCount returns count of reconnect attempts by client
+func (s *UiKlinesWsService) GetReconnectCount() int64 {
+	return s.c.GetReconnectCount()
+}
+
+// Symbol set symbol
+func (s *UiKlinesWsRequest) Symbol(symbol string) *UiKlinesWsRequest {
+	s.symbol = symbol
+	return s
+}
+
+// Interval set interval
+func (s *UiKlinesWsRequest) Interval(interval string) *UiKlinesWsRequest {
+	s.interval = interval
+	return s
+}
+
+// StartTime set startTime
+func (s *UiKlinesWsRequest) StartTime(startTime int64) *UiKlinesWsRequest {
+	s.startTime = &startTime
+	return s
+}
+
+// EndTime set endTime
+func (s *UiKlinesWsRequest) EndTime(endTime int64) *UiKlinesWsRequest {
+	s.endTime = &endTime
+	return s
+}
+
+// TimeZone set timeZone
+func (s *UiKlinesWsRequest) TimeZone(timeZone string) *UiKlinesWsRequest {
+	s.timeZone = &timeZone
+	return s
+}
+
+// Limit set limit
+func (s *UiKlinesWsRequest) Limit(limit int) *UiKlinesWsRequest {
+	s.limit = &limit
+	return s
+}
+
+// UiKlinesWsResponse define 'uiKlines' websocket API response
+type UiKlinesWsResponse struct {
+	Id     string     `json:"id"`
+	Status int        `json:"status"`
+	Result []*UiKline `json:"result"`
+
+	// error response
+	Error *common.APIError `json:"error,omitempty"`
+}
+
+// UnmarshalJSON decode the response and its result
+func (r *UiKlinesWsResponse) UnmarshalJSON(data []byte) error {
+	raw := wsApiRawResponse{}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+	r.Id, r.Status, r.Error = raw.Id, raw.Status, raw.Error
+	if raw.isEmpty() {
+		return nil
+	}
+	var err error
+	r.Result, err = parseWsUiKlines(raw.Result)
+	return err
+}
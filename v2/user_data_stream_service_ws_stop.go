@@ -0,0 +1,135 @@
+package binance
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/adshao/go-binance/v2/common"
+	"github.com/adshao/go-binance/v2/common/websocket"
+)
+
+// UserDataStreamStopWsService closes a user data stream
+type UserDataStreamStopWsService struct {
+	c      websocket.Client
+	ApiKey string
+}
+
+// NewUserDataStreamStopWsService init UserDataStreamStopWsService
+func NewUserDataStreamStopWsService(apiKey string) (*UserDataStreamStopWsService, error) {
+	conn, err := websocket.NewConnection(WsApiInitReadWriteConn, WebsocketKeepalive, WebsocketTimeoutReadWriteConnection)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := websocket.NewClient(conn)
+	if err != nil {
+		return nil, err
+	}
+
+	return &UserDataStreamStopWsService{
+		c:      client,
+		ApiKey: apiKey,
+	}, nil
+}
+
+// UserDataStreamStopWsRequest parameters for 'userDataStream.stop' websocket API
+type UserDataStreamStopWsRequest struct {
+	listenKey string
+}
+
+// NewUserDataStreamStopWsRequest init UserDataStreamStopWsRequest
+func NewUserDataStreamStopWsRequest() *UserDataStreamStopWsRequest {
+	return &UserDataStreamStopWsRequest{}
+}
+
+func (s *UserDataStreamStopWsRequest) GetParams() map[string]interface{} {
+	return s.buildParams()
+}
+
+// buildParams builds params
+func (s *UserDataStreamStopWsRequest) buildParams() params {
+	m := params{
+		"listenKey": s.listenKey,
+	}
+	return m
+}
+
+// Do - sends 'userDataStream.stop' request
+func (s *UserDataStreamStopWsService) Do(requestID string, request *UserDataStreamStopWsRequest) error {
+	rawData, err := websocket.CreateRequestWithApiKey(
+		requestID,
+		s.ApiKey,
+		websocket.UserDataStreamStopSpotWsApiMethod,
+		request.buildParams(),
+	)
+	if err != nil {
+		return err
+	}
+
+	if err := s.c.Write(requestID, rawData); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// SyncDo - sends 'userDataStream.stop' request and receives response
+func (s *UserDataStreamStopWsService) SyncDo(requestID string, request *UserDataStreamStopWsRequest) (*UserDataStreamStopWsResponse, error) {
+	rawData, err := websocket.CreateRequestWithApiKey(
+		requestID,
+		s.ApiKey,
+		websocket.UserDataStreamStopSpotWsApiMethod,
+		request.buildParams(),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := s.c.WriteSync(requestID, rawData, websocket.WriteSyncWsTimeout)
+	if err != nil {
+		return nil, err
+	}
+
+	userDataStreamStopWsResponse := &UserDataStreamStopWsResponse{}
+	if err := json.Unmarshal(response, userDataStreamStopWsResponse); err != nil {
+		return nil, err
+	}
+
+	return userDataStreamStopWsResponse, nil
+}
+
+// ReceiveAllDataBeforeStop waits until all responses will be received from websocket until timeout expired
+func (s *UserDataStreamStopWsService) ReceiveAllDataBeforeStop(timeout time.Duration) {
+	s.c.Wait(timeout)
+}
+
+// GetReadChannel returns channel with API response data (including API errors)
+func (s *UserDataStreamStopWsService) GetReadChannel() <-chan []byte {
+	return s.c.GetReadChannel()
+}
+
+// GetReadErrorChannel returns channel with errors which are occurred while reading websocket connection
+func (s *UserDataStreamStopWsService) GetReadErrorChannel() <-chan error {
+	return s.c.GetReadErrorChannel()
+}
+
+// GetReconnectCount returns count of reconnect attempts by client
+func (s *UserDataStreamStopWsService) GetReconnectCount() int64 {
+	return s.c.GetReconnectCount()
+}
+
+// ListenKey set listenKey
+func (s *UserDataStreamStopWsRequest) ListenKey(listenKey string) *UserDataStreamStopWsRequest {
+	s.listenKey = listenKey
+	return s
+}
+
+// UserDataStreamStopWsResponse define 'userDataStream.stop' websocket API response
+type UserDataStreamStopWsResponse struct {
+	Id     string   `json:"id"`
+	Status int      `json:"status"`
+	Result struct{} `json:"result"`
+
+	// error response
+	Error *common.APIError `json:"error,omitempty"`
+}
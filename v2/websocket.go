@@ -8,6 +8,8 @@ import (
 	"time"
 
 	"github.com/gorilla/websocket"
+
+	commonws "github.com/adshao/go-binance/v2/common/websocket"
 )
 
 // WsHandler handle raw websocket message
@@ -16,28 +18,51 @@ type WsHandler func(message []byte)
 // ErrHandler handles errors
 type ErrHandler func(err error)
 
+// WsOptions define the connection options accepted by the streams and the Websocket API
+// client, they take precedence over the package variables
+type WsOptions = commonws.Options
+
 // WsConfig webservice configuration
 type WsConfig struct {
 	Endpoint string
 	Header   http.Header
 	Proxy    *string
+	Delivery commonws.DeliveryConfig
+	Options  WsOptions
 }
 
-func newWsConfig(endpoint string) *WsConfig {
-	return &WsConfig{
+func newWsConfig(endpoint string, opts ...WsOptions) *WsConfig {
+	o := commonws.MergeOptions(opts...)
+	cfg := &WsConfig{
 		Endpoint: endpoint,
 		Proxy:    getWsProxyUrl(),
 		Header:   make(http.Header),
+		Options:  o,
+	}
+	for k, v := range o.Header {
+		cfg.Header[k] = v
+	}
+	if o.ProxyURL != "" {
+		cfg.Proxy = &o.ProxyURL
+	}
+	if o.Delivery != nil {
+		cfg.Delivery = *o.Delivery
+	}
+	return cfg
+}
+
+// keepaliveStrategy returns the keepalive strategy of the streams without options
+func keepaliveStrategy() commonws.KeepaliveStrategy {
+	if WebsocketKeepalive {
+		return commonws.KeepalivePong
 	}
+	return commonws.KeepaliveNone
 }
 
 func wsServe(cfg *WsConfig, handler WsHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
 	return wsServeWithConnHandler(cfg, handler, errHandler, func(ctx context.Context, c *websocket.Conn) {
-		if WebsocketKeepalive {
-			// This function overwrites the default ping frame handler
-			// sent by the websocket API server
-			keepAliveWithPong(ctx, c, WebsocketTimeout)
-		}
+		// The pong strategy overwrites the default ping frame handler
+		cfg.Options.KeepAlive(ctx, c, keepaliveStrategy(), WebsocketTimeout, WebsocketPongTimeout)
 	})
 }
 
@@ -45,65 +70,41 @@ type ConnHandler func(context.Context, *websocket.Conn)
 
 // WsServeWithConnHandler serves websocket with custom connection handler, useful for custom keepalive
 var wsServeWithConnHandler = func(cfg *WsConfig, handler WsHandler, errHandler ErrHandler, connHandler ConnHandler) (doneC, stopC chan struct{}, err error) {
+	ctx, cancel := context.WithCancel(cfg.Options.StreamContext())
+	doneC, err = wsServeContext(ctx, cfg, handler, errHandler, connHandler)
+	if err != nil {
+		cancel()
+		return nil, nil, err
+	}
+	stopC = make(chan struct{})
+	go func() {
+		// The connection stops when the client closes stopC
+		select {
+		case <-stopC:
+		case <-doneC:
+		}
+		cancel()
+	}()
+	return doneC, stopC, nil
+}
+
+// wsServeContext serves the websocket of cfg until ctx is done
+func wsServeContext(ctx context.Context, cfg *WsConfig, handler WsHandler, errHandler ErrHandler, connHandler ConnHandler) (doneC chan struct{}, err error) {
 	proxy := http.ProxyFromEnvironment
 	if cfg.Proxy != nil {
 		u, err := url.Parse(*cfg.Proxy)
 		if err != nil {
-			return nil, nil, err
+			return nil, err
 		}
 		proxy = http.ProxyURL(u)
 	}
-	Dialer := websocket.Dialer{
-		Proxy:             proxy,
-		HandshakeTimeout:  45 * time.Second,
-		EnableCompression: true,
-	}
-
-	c, _, err := Dialer.Dial(cfg.Endpoint, cfg.Header)
+	c, _, err := cfg.Options.Dialer(proxy, true).DialContext(ctx, cfg.Endpoint, cfg.Header)
 	if err != nil {
-		return nil, nil, err
+		return nil, err
 	}
-	c.SetReadLimit(655350)
-	doneC = make(chan struct{})
-	stopC = make(chan struct{})
-	go func() {
-		// This function will exit either on error from
-		// websocket.Conn.ReadMessage or when the stopC channel is
-		// closed by the client.
-
-		defer close(doneC)
-
-		// Custom connection handling, useful in active keepalive scenarios
-		if connHandler != nil {
-			ctx, cancel := context.WithCancel(context.Background())
-			defer cancel()
-			go connHandler(ctx, c)
-		}
-
-		// Wait for the stopC channel to be closed.  We do that in a
-		// separate goroutine because ReadMessage is a blocking
-		// operation.
-		silent := false
-		go func() {
-			select {
-			case <-stopC:
-				silent = true
-			case <-doneC:
-			}
-			c.Close()
-		}()
-		for {
-			_, message, err := c.ReadMessage()
-			if err != nil {
-				if !silent {
-					errHandler(err)
-				}
-				return
-			}
-			handler(message)
-		}
-	}()
-	return
+	cfg.Options.SetReadLimit(c, 655350)
+	// Custom connection handling, useful in active keepalive scenarios
+	return commonws.ServeConn(ctx, c, cfg.Delivery, handler, errHandler, connHandler), nil
 }
 
 // keepAliveWithPing Keepalive by actively sending ping messages
@@ -140,43 +141,6 @@ func keepAliveWithPing(interval time.Duration, pongTimeout time.Duration) ConnHa
 	}
 }
 
-// keepAliveWithPong Keepalive by responding to ping messages
-func keepAliveWithPong(ctx context.Context, c *websocket.Conn, timeout time.Duration) {
-	ticker := time.NewTicker(timeout)
-	defer ticker.Stop()
-
-	var lastResponse int64
-	atomic.StoreInt64(&lastResponse, time.Now().Unix())
-
-	c.SetPingHandler(func(pingData string) error {
-		// Respond with Pong using the server's PING payload
-		err := c.WriteControl(
-			websocket.PongMessage,
-			[]byte(pingData),
-			time.Now().Add(WebsocketPongTimeout), // Short deadline to ensure timely response
-		)
-		if err != nil {
-			return err
-		}
-
-		atomic.StoreInt64(&lastResponse, time.Now().Unix())
-
-		return nil
-	})
-
-	for {
-		select {
-		case <-ctx.Done():
-			return
-		case <-ticker.C:
-			if time.Since(time.Unix(atomic.LoadInt64(&lastResponse), 0)) > timeout {
-				c.Close()
-				return
-			}
-		}
-	}
-}
-
 var WsGetReadWriteConnection = func(cfg *WsConfig) (*websocket.Conn, error) {
 	proxy := http.ProxyFromEnvironment
 	if cfg.Proxy != nil {
@@ -187,13 +151,7 @@ var WsGetReadWriteConnection = func(cfg *WsConfig) (*websocket.Conn, error) {
 		proxy = http.ProxyURL(u)
 	}
 
-	Dialer := websocket.Dialer{
-		Proxy:             proxy,
-		HandshakeTimeout:  45 * time.Second,
-		EnableCompression: false,
-	}
-
-	c, _, err := Dialer.Dial(cfg.Endpoint, cfg.Header)
+	c, _, err := cfg.Options.Dialer(proxy, false).Dial(cfg.Endpoint, cfg.Header)
 	if err != nil {
 		return nil, err
 	}
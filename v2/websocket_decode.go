@@ -0,0 +1,158 @@
+package binance
+
+import (
+	"strings"
+
+	"github.com/adshao/go-binance/v2/common"
+)
+
+// The events of the high volume streams are decoded by scanning the message once, without
+// building a simplejson tree nor going through reflection. Each message is converted to a
+// string once and the string fields of its event are substrings of it.
+
+var (
+	wsDepthEvents      common.EventPool[WsDepthEvent]
+	wsAggTradeEvents   common.EventPool[WsAggTradeEvent]
+	wsBookTickerEvents common.EventPool[WsBookTickerEvent]
+)
+
+// eventPool returns pool when reuse is set, nil otherwise
+func eventPool[T any](reuse bool, pool *common.EventPool[T]) *common.EventPool[T] {
+	if reuse {
+		return pool
+	}
+	return nil
+}
+
+// decodeHandler returns a WsHandler decoding messages into events of pool and passing them
+// to handler, the events are recycled once handler returns when reuse is set
+func decodeHandler[T any](reuse bool, pool *common.EventPool[T], decode func(message string, event *T) error, handler func(event *T), errHandler ErrHandler) WsHandler {
+	pool = eventPool(reuse, pool)
+	return func(message []byte) {
+		event := pool.Get()
+		defer pool.Put(event)
+		if err := decode(string(message), event); err != nil {
+			errHandler(err)
+			return
+		}
+		handler(event)
+	}
+}
+
+// combinedStreamSymbol returns the upper case symbol of a combined stream name, e.g. BTCUSDT
+// for btcusdt@depth
+func combinedStreamSymbol(stream string) string {
+	if i := strings.IndexByte(stream, '@'); i >= 0 {
+		stream = stream[:i]
+	}
+	return strings.ToUpper(stream)
+}
+
+func decodeWsDepthEvent(message string, event *WsDepthEvent) error {
+	*event = WsDepthEvent{Bids: event.Bids[:0], Asks: event.Asks[:0]}
+	return common.ScanObject(message, func(key, value string) (err error) {
+		switch key {
+		case "e":
+			event.Event, err = common.JSONString(value)
+		case "E":
+			event.Time, err = common.JSONInt64(value)
+		case "s":
+			event.Symbol, err = common.JSONString(value)
+		case "u":
+			event.LastUpdateID, err = common.JSONInt64(value)
+		case "U":
+			event.FirstUpdateID, err = common.JSONInt64(value)
+		case "b":
+			event.Bids, err = common.AppendPriceLevels(event.Bids, value)
+		case "a":
+			event.Asks, err = common.AppendPriceLevels(event.Asks, value)
+		}
+		return err
+	})
+}
+
+func decodeWsCombinedDepthEvent(message string, event *WsDepthEvent) error {
+	stream, data, err := common.SplitCombinedMessage(message)
+	if err != nil {
+		return err
+	}
+	if err := decodeWsDepthEvent(data, event); err != nil {
+		return err
+	}
+	// events of the combined depth streams have always come without their type
+	event.Event = ""
+	event.Symbol = combinedStreamSymbol(stream)
+	return nil
+}
+
+func decodeWsAggTradeEvent(message string, event *WsAggTradeEvent) error {
+	*event = WsAggTradeEvent{}
+	return common.ScanObject(message, func(key, value string) (err error) {
+		switch key {
+		case "e":
+			event.Event, err = common.JSONString(value)
+		case "E":
+			event.Time, err = common.JSONInt64(value)
+		case "s":
+			event.Symbol, err = common.JSONString(value)
+		case "a":
+			event.AggTradeID, err = common.JSONInt64(value)
+		case "p":
+			event.Price, err = common.JSONString(value)
+		case "q":
+			event.Quantity, err = common.JSONString(value)
+		case "f":
+			event.FirstBreakdownTradeID, err = common.JSONInt64(value)
+		case "l":
+			event.LastBreakdownTradeID, err = common.JSONInt64(value)
+		case "T":
+			event.TradeTime, err = common.JSONInt64(value)
+		case "m":
+			event.IsBuyerMaker, err = common.JSONBool(value)
+		case "M":
+			event.Placeholder, err = common.JSONBool(value)
+		}
+		return err
+	})
+}
+
+func decodeWsCombinedAggTradeEvent(message string, event *WsAggTradeEvent) error {
+	stream, data, err := common.SplitCombinedMessage(message)
+	if err != nil {
+		return err
+	}
+	if err := decodeWsAggTradeEvent(data, event); err != nil {
+		return err
+	}
+	event.Symbol = combinedStreamSymbol(stream)
+	return nil
+}
+
+func decodeWsBookTickerEvent(message string, event *WsBookTickerEvent) error {
+	*event = WsBookTickerEvent{}
+	return common.ScanObject(message, func(key, value string) (err error) {
+		switch key {
+		case "u":
+			event.UpdateID, err = common.JSONInt64(value)
+		case "s":
+			event.Symbol, err = common.JSONString(value)
+		case "b":
+			event.BestBidPrice, err = common.JSONString(value)
+		case "B":
+			event.BestBidQty, err = common.JSONString(value)
+		case "a":
+			event.BestAskPrice, err = common.JSONString(value)
+		case "A":
+			event.BestAskQty, err = common.JSONString(value)
+		}
+		return err
+	})
+}
+
+func decodeWsCombinedBookTickerEvent(message string, event *WsBookTickerEvent) error {
+	_, data, err := common.SplitCombinedMessage(message)
+	if err != nil {
+		return err
+	}
+	return decodeWsBookTickerEvent(data, event)
+}
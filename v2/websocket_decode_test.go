@@ -0,0 +1,192 @@
+package binance
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/adshao/go-binance/v2/common"
+	"github.com/stretchr/testify/suite"
+)
+
+type websocketDecodeTestSuite struct {
+	suite.Suite
+}
+
+func TestWebsocketDecode(t *testing.T) {
+	suite.Run(t, new(websocketDecodeTestSuite))
+}
+
+// benchDepthMessage returns a depth diff message of n bids and n asks
+func benchDepthMessage(n int) []byte {
+	levels := make([]string, n)
+	for i := range levels {
+		levels[i] = fmt.Sprintf(`["%d.01000000","%d.50000000"]`, 60000+i, i)
+	}
+	return []byte(fmt.Sprintf(`{"e":"depthUpdate","E":1672515782136,"s":"BTCUSDT","U":157,"u":160,"b":[%s],"a":[%s]}`,
+		strings.Join(levels, ","), strings.Join(levels, ",")))
+}
+
+var (
+	benchAggTradeMessage   = []byte(`{"e":"aggTrade","E":1672515782136,"s":"BNBBTC","a":12345,"p":"0.001","q":"100","f":100,"l":105,"T":1672515782136,"m":true,"M":true}`)
+	benchBookTickerMessage = []byte(`{"u":400900217,"s":"BNBUSDT","b":"25.35190000","B":"31.21000000","a":"25.36520000","A":"40.66000000"}`)
+)
+
+// simplejsonDepthEvent is the depth parser the fast decoder replaces
+func simplejsonDepthEvent(message []byte) (*WsDepthEvent, error) {
+	j, err := newJSON(message)
+	if err != nil {
+		return nil, err
+	}
+	event := new(WsDepthEvent)
+	event.Event = j.Get("e").MustString()
+	event.Time = j.Get("E").MustInt64()
+	event.Symbol = j.Get("s").MustString()
+	event.LastUpdateID = j.Get("u").MustInt64()
+	event.FirstUpdateID = j.Get("U").MustInt64()
+	bidsLen := len(j.Get("b").MustArray())
+	event.Bids = make([]Bid, bidsLen)
+	for i := 0; i < bidsLen; i++ {
+		item := j.Get("b").GetIndex(i)
+		event.Bids[i] = Bid{
+			Price:    item.GetIndex(0).MustString(),
+			Quantity: item.GetIndex(1).MustString(),
+		}
+	}
+	asksLen := len(j.Get("a").MustArray())
+	event.Asks = make([]Ask, asksLen)
+	for i := 0; i < asksLen; i++ {
+		item := j.Get("a").GetIndex(i)
+		event.Asks[i] = Ask{
+			Price:    item.GetIndex(0).MustString(),
+			Quantity: item.GetIndex(1).MustString(),
+		}
+	}
+	return event, nil
+}
+
+func (s *websocketDecodeTestSuite) TestDepthEvent() {
+	message := benchDepthMessage(3)
+	expected, err := simplejsonDepthEvent(message)
+	s.Require().NoError(err)
+	event := new(WsDepthEvent)
+	s.Require().NoError(decodeWsDepthEvent(string(message), event))
+	s.Equal(expected, event)
+
+	// a recycled event is reset
+	s.Require().NoError(decodeWsDepthEvent(`{"e":"depthUpdate","b":[["1","2"]],"a":[]}`, event))
+	s.Equal(&WsDepthEvent{Event: "depthUpdate", Bids: []Bid{{Price: "1", Quantity: "2"}}, Asks: []Ask{}}, event)
+
+	s.Error(decodeWsDepthEvent(`{"e":"depthUpdate","u":"a"}`, event))
+	s.Error(decodeWsDepthEvent(`{"e":`, event))
+}
+
+func (s *websocketDecodeTestSuite) TestAggTradeEvent() {
+	expected := new(WsAggTradeEvent)
+	s.Require().NoError(json.Unmarshal(benchAggTradeMessage, expected))
+	event := new(WsAggTradeEvent)
+	s.Require().NoError(decodeWsAggTradeEvent(string(benchAggTradeMessage), event))
+	s.Equal(expected, event)
+}
+
+func (s *websocketDecodeTestSuite) TestBookTickerEvent() {
+	expected := new(WsBookTickerEvent)
+	s.Require().NoError(json.Unmarshal(benchBookTickerMessage, expected))
+	event := new(WsBookTickerEvent)
+	s.Require().NoError(decodeWsBookTickerEvent(string(benchBookTickerMessage), event))
+	s.Equal(expected, event)
+
+	message := fmt.Sprintf(`{"stream":"bnbusdt@bookTicker","data":%s}`, benchBookTickerMessage)
+	s.Require().NoError(decodeWsCombinedBookTickerEvent(message, event))
+	s.Equal(expected, event)
+}
+
+func (s *websocketDecodeTestSuite) TestReuseEvents() {
+	pool := new(common.EventPool[WsBookTickerEvent])
+	s.Nil(eventPool(false, pool))
+	s.Same(pool, eventPool(true, pool))
+
+	// handlers of recycled events copy what they keep
+	var symbols []string
+	var errs []error
+	wsHandler := decodeHandler(true, pool, decodeWsBookTickerEvent, func(event *WsBookTickerEvent) {
+		symbols = append(symbols, event.Symbol)
+	}, func(err error) {
+		errs = append(errs, err)
+	})
+	wsHandler(benchBookTickerMessage)
+	wsHandler([]byte(`{"u":1`))
+	s.Equal([]string{"BNBUSDT"}, symbols)
+	s.Len(errs, 1)
+	s.ErrorIs(errs[0], common.ErrInvalidJSON)
+}
+
+func BenchmarkDepthEventSimpleJSON(b *testing.B) {
+	message := benchDepthMessage(20)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		if _, err := simplejsonDepthEvent(message); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkDepthEventDecode(b *testing.B) {
+	message := benchDepthMessage(20)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		if err := decodeWsDepthEvent(string(message), new(WsDepthEvent)); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkDepthEventDecodeReuse(b *testing.B) {
+	message := benchDepthMessage(20)
+	event := new(WsDepthEvent)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		if err := decodeWsDepthEvent(string(message), event); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkAggTradeEventUnmarshal(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		if err := json.Unmarshal(benchAggTradeMessage, new(WsAggTradeEvent)); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkAggTradeEventDecode(b *testing.B) {
+	event := new(WsAggTradeEvent)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		if err := decodeWsAggTradeEvent(string(benchAggTradeMessage), event); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkBookTickerEventUnmarshal(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		if err := json.Unmarshal(benchBookTickerMessage, new(WsBookTickerEvent)); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkBookTickerEventDecode(b *testing.B) {
+	event := new(WsBookTickerEvent)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		if err := decodeWsBookTickerEvent(string(benchBookTickerMessage), event); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
@@ -1,12 +1,14 @@
 package binance
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"strings"
 	"time"
 
+	"github.com/adshao/go-binance/v2/common"
 	"github.com/adshao/go-binance/v2/common/websocket"
 	"github.com/google/uuid"
 	gorilla "github.com/gorilla/websocket"
@@ -47,16 +49,22 @@ func SetWsProxyUrl(url string) {
 	ProxyUrl = url
 }
 
-// getWsEndpoint return the base endpoint of the WS according the UseTestnet flag
-func getWsEndpoint() string {
+// getWsEndpoint return the base endpoint of the WS of opts, according the UseTestnet flag when they set none
+func getWsEndpoint(opts ...WsOptions) string {
+	if o := websocket.MergeOptions(opts...); o.BaseURL != "" {
+		return o.BaseURL
+	}
 	if UseTestnet {
 		return BaseWsTestnetURL
 	}
 	return BaseWsMainURL
 }
 
-// getCombinedEndpoint return the base endpoint of the combined stream according the UseTestnet flag
-func getCombinedEndpoint() string {
+// getCombinedEndpoint return the base endpoint of the combined stream of opts, according the UseTestnet flag when they set none
+func getCombinedEndpoint(opts ...WsOptions) string {
+	if o := websocket.MergeOptions(opts...); o.CombinedBaseURL != "" {
+		return o.CombinedBaseURL
+	}
 	if UseTestnet {
 		return BaseCombinedTestnetURL
 	}
@@ -75,20 +83,20 @@ type WsPartialDepthEvent struct {
 type WsPartialDepthHandler func(event *WsPartialDepthEvent)
 
 // WsPartialDepthServe serve websocket partial depth handler with a symbol, using 1sec updates
-func WsPartialDepthServe(symbol string, levels string, handler WsPartialDepthHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
-	endpoint := fmt.Sprintf("%s/%s@depth%s", getWsEndpoint(), strings.ToLower(symbol), levels)
-	return wsPartialDepthServe(endpoint, symbol, handler, errHandler)
+func WsPartialDepthServe(symbol string, levels string, handler WsPartialDepthHandler, errHandler ErrHandler, opts ...WsOptions) (doneC, stopC chan struct{}, err error) {
+	endpoint := fmt.Sprintf("%s/%s@depth%s", getWsEndpoint(opts...), strings.ToLower(symbol), levels)
+	return wsPartialDepthServe(endpoint, symbol, handler, errHandler, opts...)
 }
 
 // WsPartialDepthServe100Ms serve websocket partial depth handler with a symbol, using 100msec updates
-func WsPartialDepthServe100Ms(symbol string, levels string, handler WsPartialDepthHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
-	endpoint := fmt.Sprintf("%s/%s@depth%s@100ms", getWsEndpoint(), strings.ToLower(symbol), levels)
-	return wsPartialDepthServe(endpoint, symbol, handler, errHandler)
+func WsPartialDepthServe100Ms(symbol string, levels string, handler WsPartialDepthHandler, errHandler ErrHandler, opts ...WsOptions) (doneC, stopC chan struct{}, err error) {
+	endpoint := fmt.Sprintf("%s/%s@depth%s@100ms", getWsEndpoint(opts...), strings.ToLower(symbol), levels)
+	return wsPartialDepthServe(endpoint, symbol, handler, errHandler, opts...)
 }
 
 // WsPartialDepthServe serve websocket partial depth handler with a symbol
-func wsPartialDepthServe(endpoint string, symbol string, handler WsPartialDepthHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
-	cfg := newWsConfig(endpoint)
+func wsPartialDepthServe(endpoint string, symbol string, handler WsPartialDepthHandler, errHandler ErrHandler, opts ...WsOptions) (doneC, stopC chan struct{}, err error) {
+	cfg := newWsConfig(endpoint, opts...)
 	wsHandler := func(message []byte) {
 		j, err := newJSON(message)
 		if err != nil {
@@ -122,13 +130,13 @@ func wsPartialDepthServe(endpoint string, symbol string, handler WsPartialDepthH
 }
 
 // WsCombinedPartialDepthServe is similar to WsPartialDepthServe, but it for multiple symbols
-func WsCombinedPartialDepthServe(symbolLevels map[string]string, handler WsPartialDepthHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
-	endpoint := getCombinedEndpoint()
+func WsCombinedPartialDepthServe(symbolLevels map[string]string, handler WsPartialDepthHandler, errHandler ErrHandler, opts ...WsOptions) (doneC, stopC chan struct{}, err error) {
+	endpoint := getCombinedEndpoint(opts...)
 	for s, l := range symbolLevels {
 		endpoint += fmt.Sprintf("%s@depth%s", strings.ToLower(s), l) + "/"
 	}
 	endpoint = endpoint[:len(endpoint)-1]
-	cfg := newWsConfig(endpoint)
+	cfg := newWsConfig(endpoint, opts...)
 	wsHandler := func(message []byte) {
 		j, err := newJSON(message)
 		if err != nil {
@@ -169,52 +177,21 @@ func WsCombinedPartialDepthServe(symbolLevels map[string]string, handler WsParti
 type WsDepthHandler func(event *WsDepthEvent)
 
 // WsDepthServe serve websocket depth handler with a symbol, using 1sec updates
-func WsDepthServe(symbol string, handler WsDepthHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
-	endpoint := fmt.Sprintf("%s/%s@depth", getWsEndpoint(), strings.ToLower(symbol))
-	return wsDepthServe(endpoint, handler, errHandler)
+func WsDepthServe(symbol string, handler WsDepthHandler, errHandler ErrHandler, opts ...WsOptions) (doneC, stopC chan struct{}, err error) {
+	endpoint := fmt.Sprintf("%s/%s@depth", getWsEndpoint(opts...), strings.ToLower(symbol))
+	return wsDepthServe(endpoint, handler, errHandler, opts...)
 }
 
 // WsDepthServe100Ms serve websocket depth handler with a symbol, using 100msec updates
-func WsDepthServe100Ms(symbol string, handler WsDepthHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
-	endpoint := fmt.Sprintf("%s/%s@depth@100ms", getWsEndpoint(), strings.ToLower(symbol))
-	return wsDepthServe(endpoint, handler, errHandler)
+func WsDepthServe100Ms(symbol string, handler WsDepthHandler, errHandler ErrHandler, opts ...WsOptions) (doneC, stopC chan struct{}, err error) {
+	endpoint := fmt.Sprintf("%s/%s@depth@100ms", getWsEndpoint(opts...), strings.ToLower(symbol))
+	return wsDepthServe(endpoint, handler, errHandler, opts...)
 }
 
 // WsDepthServe serve websocket depth handler with an arbitrary endpoint address
-func wsDepthServe(endpoint string, handler WsDepthHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
-	cfg := newWsConfig(endpoint)
-	wsHandler := func(message []byte) {
-		j, err := newJSON(message)
-		if err != nil {
-			errHandler(err)
-			return
-		}
-		event := new(WsDepthEvent)
-		event.Event = j.Get("e").MustString()
-		event.Time = j.Get("E").MustInt64()
-		event.Symbol = j.Get("s").MustString()
-		event.LastUpdateID = j.Get("u").MustInt64()
-		event.FirstUpdateID = j.Get("U").MustInt64()
-		bidsLen := len(j.Get("b").MustArray())
-		event.Bids = make([]Bid, bidsLen)
-		for i := 0; i < bidsLen; i++ {
-			item := j.Get("b").GetIndex(i)
-			event.Bids[i] = Bid{
-				Price:    item.GetIndex(0).MustString(),
-				Quantity: item.GetIndex(1).MustString(),
-			}
-		}
-		asksLen := len(j.Get("a").MustArray())
-		event.Asks = make([]Ask, asksLen)
-		for i := 0; i < asksLen; i++ {
-			item := j.Get("a").GetIndex(i)
-			event.Asks[i] = Ask{
-				Price:    item.GetIndex(0).MustString(),
-				Quantity: item.GetIndex(1).MustString(),
-			}
-		}
-		handler(event)
-	}
+func wsDepthServe(endpoint string, handler WsDepthHandler, errHandler ErrHandler, opts ...WsOptions) (doneC, stopC chan struct{}, err error) {
+	cfg := newWsConfig(endpoint, opts...)
+	wsHandler := decodeHandler(cfg.Options.ReuseEvents, &wsDepthEvents, decodeWsDepthEvent, handler, errHandler)
 	return wsServe(cfg, wsHandler, errHandler)
 }
 
@@ -230,61 +207,27 @@ type WsDepthEvent struct {
 }
 
 // WsCombinedDepthServe is similar to WsDepthServe, but it for multiple symbols
-func WsCombinedDepthServe(symbols []string, handler WsDepthHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
-	endpoint := getCombinedEndpoint()
+func WsCombinedDepthServe(symbols []string, handler WsDepthHandler, errHandler ErrHandler, opts ...WsOptions) (doneC, stopC chan struct{}, err error) {
+	endpoint := getCombinedEndpoint(opts...)
 	for _, s := range symbols {
 		endpoint += fmt.Sprintf("%s@depth", strings.ToLower(s)) + "/"
 	}
 	endpoint = endpoint[:len(endpoint)-1]
-	return wsCombinedDepthServe(endpoint, handler, errHandler)
+	return wsCombinedDepthServe(endpoint, handler, errHandler, opts...)
 }
 
-func WsCombinedDepthServe100Ms(symbols []string, handler WsDepthHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
-	endpoint := getCombinedEndpoint()
+func WsCombinedDepthServe100Ms(symbols []string, handler WsDepthHandler, errHandler ErrHandler, opts ...WsOptions) (doneC, stopC chan struct{}, err error) {
+	endpoint := getCombinedEndpoint(opts...)
 	for _, s := range symbols {
 		endpoint += fmt.Sprintf("%s@depth@100ms", strings.ToLower(s)) + "/"
 	}
 	endpoint = endpoint[:len(endpoint)-1]
-	return wsCombinedDepthServe(endpoint, handler, errHandler)
+	return wsCombinedDepthServe(endpoint, handler, errHandler, opts...)
 }
 
-func wsCombinedDepthServe(endpoint string, handler WsDepthHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
-	cfg := newWsConfig(endpoint)
-	wsHandler := func(message []byte) {
-		j, err := newJSON(message)
-		if err != nil {
-			errHandler(err)
-			return
-		}
-		event := new(WsDepthEvent)
-		stream := j.Get("stream").MustString()
-		symbol := strings.Split(stream, "@")[0]
-		event.Symbol = strings.ToUpper(symbol)
-		data := j.Get("data").MustMap()
-		event.Time, _ = data["E"].(json.Number).Int64()
-		event.LastUpdateID, _ = data["u"].(json.Number).Int64()
-		event.FirstUpdateID, _ = data["U"].(json.Number).Int64()
-		bidsLen := len(data["b"].([]any))
-		event.Bids = make([]Bid, bidsLen)
-		for i := 0; i < bidsLen; i++ {
-			item := data["b"].([]any)[i].([]any)
-			event.Bids[i] = Bid{
-				Price:    item[0].(string),
-				Quantity: item[1].(string),
-			}
-		}
-		asksLen := len(data["a"].([]any))
-		event.Asks = make([]Ask, asksLen)
-		for i := 0; i < asksLen; i++ {
-
-			item := data["a"].([]any)[i].([]any)
-			event.Asks[i] = Ask{
-				Price:    item[0].(string),
-				Quantity: item[1].(string),
-			}
-		}
-		handler(event)
-	}
+func wsCombinedDepthServe(endpoint string, handler WsDepthHandler, errHandler ErrHandler, opts ...WsOptions) (doneC, stopC chan struct{}, err error) {
+	cfg := newWsConfig(endpoint, opts...)
+	wsHandler := decodeHandler(cfg.Options.ReuseEvents, &wsDepthEvents, decodeWsCombinedDepthEvent, handler, errHandler)
 	return wsServe(cfg, wsHandler, errHandler)
 }
 
@@ -292,13 +235,13 @@ func wsCombinedDepthServe(endpoint string, handler WsDepthHandler, errHandler Er
 type WsKlineHandler func(event *WsKlineEvent)
 
 // WsCombinedKlineServe is similar to WsKlineServe, but it handles multiple symbols with it interval
-func WsCombinedKlineServe(symbolIntervalPair map[string]string, handler WsKlineHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
-	endpoint := getCombinedEndpoint()
+func WsCombinedKlineServe(symbolIntervalPair map[string]string, handler WsKlineHandler, errHandler ErrHandler, opts ...WsOptions) (doneC, stopC chan struct{}, err error) {
+	endpoint := getCombinedEndpoint(opts...)
 	for symbol, interval := range symbolIntervalPair {
 		endpoint += fmt.Sprintf("%s@kline_%s", strings.ToLower(symbol), interval) + "/"
 	}
 	endpoint = endpoint[:len(endpoint)-1]
-	cfg := newWsConfig(endpoint)
+	cfg := newWsConfig(endpoint, opts...)
 	wsHandler := func(message []byte) {
 		j, err := newJSON(message)
 		if err != nil {
@@ -327,15 +270,15 @@ func WsCombinedKlineServe(symbolIntervalPair map[string]string, handler WsKlineH
 }
 
 // WsCombinedKlineServeMultiInterval is similar to WsCombinedKlineServe, but it supports multiple intervals per symbol
-func WsCombinedKlineServeMultiInterval(symbolIntervals map[string][]string, handler WsKlineHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
-	endpoint := getCombinedEndpoint()
+func WsCombinedKlineServeMultiInterval(symbolIntervals map[string][]string, handler WsKlineHandler, errHandler ErrHandler, opts ...WsOptions) (doneC, stopC chan struct{}, err error) {
+	endpoint := getCombinedEndpoint(opts...)
 	for symbol, intervals := range symbolIntervals {
 		for _, interval := range intervals {
 			endpoint += fmt.Sprintf("%s@kline_%s", strings.ToLower(symbol), interval) + "/"
 		}
 	}
 	endpoint = endpoint[:len(endpoint)-1]
-	cfg := newWsConfig(endpoint)
+	cfg := newWsConfig(endpoint, opts...)
 	wsHandler := func(message []byte) {
 		j, err := newJSON(message)
 		if err != nil {
@@ -364,9 +307,9 @@ func WsCombinedKlineServeMultiInterval(symbolIntervals map[string][]string, hand
 }
 
 // WsKlineServe serve websocket kline handler with a symbol and interval like 15m, 30s
-func WsKlineServe(symbol string, interval string, handler WsKlineHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
-	endpoint := fmt.Sprintf("%s/%s@kline_%s", getWsEndpoint(), strings.ToLower(symbol), interval)
-	cfg := newWsConfig(endpoint)
+func WsKlineServe(symbol string, interval string, handler WsKlineHandler, errHandler ErrHandler, opts ...WsOptions) (doneC, stopC chan struct{}, err error) {
+	endpoint := fmt.Sprintf("%s/%s@kline_%s", getWsEndpoint(opts...), strings.ToLower(symbol), interval)
+	cfg := newWsConfig(endpoint, opts...)
 	wsHandler := func(message []byte) {
 		event := new(WsKlineEvent)
 		err := json.Unmarshal(message, event)
@@ -411,54 +354,22 @@ type WsKline struct {
 type WsAggTradeHandler func(event *WsAggTradeEvent)
 
 // WsAggTradeServe serve websocket aggregate handler with a symbol
-func WsAggTradeServe(symbol string, handler WsAggTradeHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
-	endpoint := fmt.Sprintf("%s/%s@aggTrade", getWsEndpoint(), strings.ToLower(symbol))
-	cfg := newWsConfig(endpoint)
-	wsHandler := func(message []byte) {
-		event := new(WsAggTradeEvent)
-		err := json.Unmarshal(message, event)
-		if err != nil {
-			errHandler(err)
-			return
-		}
-		handler(event)
-	}
+func WsAggTradeServe(symbol string, handler WsAggTradeHandler, errHandler ErrHandler, opts ...WsOptions) (doneC, stopC chan struct{}, err error) {
+	endpoint := fmt.Sprintf("%s/%s@aggTrade", getWsEndpoint(opts...), strings.ToLower(symbol))
+	cfg := newWsConfig(endpoint, opts...)
+	wsHandler := decodeHandler(cfg.Options.ReuseEvents, &wsAggTradeEvents, decodeWsAggTradeEvent, handler, errHandler)
 	return wsServe(cfg, wsHandler, errHandler)
 }
 
 // WsCombinedAggTradeServe is similar to WsAggTradeServe, but it handles multiple symbolx
-func WsCombinedAggTradeServe(symbols []string, handler WsAggTradeHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
-	endpoint := getCombinedEndpoint()
+func WsCombinedAggTradeServe(symbols []string, handler WsAggTradeHandler, errHandler ErrHandler, opts ...WsOptions) (doneC, stopC chan struct{}, err error) {
+	endpoint := getCombinedEndpoint(opts...)
 	for s := range symbols {
 		endpoint += fmt.Sprintf("%s@aggTrade", strings.ToLower(symbols[s])) + "/"
 	}
 	endpoint = endpoint[:len(endpoint)-1]
-	cfg := newWsConfig(endpoint)
-	wsHandler := func(message []byte) {
-		j, err := newJSON(message)
-		if err != nil {
-			errHandler(err)
-			return
-		}
-
-		stream := j.Get("stream").MustString()
-		data := j.Get("data").MustMap()
-
-		symbol := strings.Split(stream, "@")[0]
-
-		jsonData, _ := json.Marshal(data)
-
-		event := new(WsAggTradeEvent)
-		err = json.Unmarshal(jsonData, event)
-		if err != nil {
-			errHandler(err)
-			return
-		}
-
-		event.Symbol = strings.ToUpper(symbol)
-
-		handler(event)
-	}
+	cfg := newWsConfig(endpoint, opts...)
+	wsHandler := decodeHandler(cfg.Options.ReuseEvents, &wsAggTradeEvents, decodeWsCombinedAggTradeEvent, handler, errHandler)
 	return wsServe(cfg, wsHandler, errHandler)
 }
 
@@ -482,9 +393,9 @@ type WsTradeHandler func(event *WsTradeEvent)
 type WsCombinedTradeHandler func(event *WsCombinedTradeEvent)
 
 // WsTradeServe serve websocket handler with a symbol
-func WsTradeServe(symbol string, handler WsTradeHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
-	endpoint := fmt.Sprintf("%s/%s@trade", getWsEndpoint(), strings.ToLower(symbol))
-	cfg := newWsConfig(endpoint)
+func WsTradeServe(symbol string, handler WsTradeHandler, errHandler ErrHandler, opts ...WsOptions) (doneC, stopC chan struct{}, err error) {
+	endpoint := fmt.Sprintf("%s/%s@trade", getWsEndpoint(opts...), strings.ToLower(symbol))
+	cfg := newWsConfig(endpoint, opts...)
 	wsHandler := func(message []byte) {
 		event := new(WsTradeEvent)
 		err := json.Unmarshal(message, event)
@@ -497,13 +408,13 @@ func WsTradeServe(symbol string, handler WsTradeHandler, errHandler ErrHandler)
 	return wsServe(cfg, wsHandler, errHandler)
 }
 
-func WsCombinedTradeServe(symbols []string, handler WsCombinedTradeHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
-	endpoint := getCombinedEndpoint()
+func WsCombinedTradeServe(symbols []string, handler WsCombinedTradeHandler, errHandler ErrHandler, opts ...WsOptions) (doneC, stopC chan struct{}, err error) {
+	endpoint := getCombinedEndpoint(opts...)
 	for _, s := range symbols {
 		endpoint += fmt.Sprintf("%s@trade/", strings.ToLower(s))
 	}
 	endpoint = endpoint[:len(endpoint)-1]
-	cfg := newWsConfig(endpoint)
+	cfg := newWsConfig(endpoint, opts...)
 	wsHandler := func(message []byte) {
 		event := new(WsCombinedTradeEvent)
 		err := json.Unmarshal(message, event)
@@ -645,9 +556,9 @@ type WsUserDataHandler func(event *WsUserDataEvent)
 
 // WsUserDataServe serve user data handler with listen key
 // Deprecated: Listen key management is deprecated. Use WsUserDataServeSignature instead.
-func WsUserDataServe(listenKey string, handler WsUserDataHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
-	endpoint := fmt.Sprintf("%s/%s", getWsEndpoint(), listenKey)
-	cfg := newWsConfig(endpoint)
+func WsUserDataServe(listenKey string, handler WsUserDataHandler, errHandler ErrHandler, opts ...WsOptions) (doneC, stopC chan struct{}, err error) {
+	endpoint := fmt.Sprintf("%s/%s", getWsEndpoint(opts...), listenKey)
+	cfg := newWsConfig(endpoint, opts...)
 	wsHandler := func(message []byte) {
 		j, err := newJSON(message)
 		if err != nil {
@@ -698,18 +609,7 @@ func WsUserDataServe(listenKey string, handler WsUserDataHandler, errHandler Err
 // WsUserDataServeSignature serves user data handler using signature-based subscription via WebSocket API.
 // This is the recommended method as listen key management has been deprecated by Binance.
 // It connects to the WebSocket API endpoint and subscribes to user data stream using signature authentication.
-func WsUserDataServeSignature(apiKey, secretKey string, keyType string, timeOffset int64, handler WsUserDataHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
-	cfg := newWsConfig(getWsApiEndpoint())
-
-	doneC = make(chan struct{})
-	stopC = make(chan struct{})
-
-	conn, err := WsGetReadWriteConnection(cfg)
-	if err != nil {
-		return nil, nil, err
-	}
-
-	// Subscribe to user data stream using signature
+func WsUserDataServeSignature(apiKey, secretKey string, keyType string, timeOffset int64, handler WsUserDataHandler, errHandler ErrHandler, opts ...WsOptions) (doneC, stopC chan struct{}, err error) {
 	reqData := websocket.NewRequestData(
 		uuid.New().String(),
 		apiKey,
@@ -717,13 +617,40 @@ func WsUserDataServeSignature(apiKey, secretKey string, keyType string, timeOffs
 		timeOffset,
 		keyType,
 	)
+	return wsUserDataServeSignature(reqData, handler, errHandler, opts...)
+}
+
+// WsUserDataServeSigner serves user data handler like WsUserDataServeSignature, the subscription
+// being signed by signer, e.g. an external KMS or HSM signer
+func WsUserDataServeSigner(apiKey string, signer common.Signer, timeOffset int64, handler WsUserDataHandler, errHandler ErrHandler, opts ...WsOptions) (doneC, stopC chan struct{}, err error) {
+	reqData := websocket.NewRequestData(
+		uuid.New().String(),
+		apiKey,
+		"",
+		timeOffset,
+		signer.KeyType(),
+	).WithSigner(signer)
+	return wsUserDataServeSignature(reqData, handler, errHandler, opts...)
+}
+
+func wsUserDataServeSignature(reqData websocket.RequestData, handler WsUserDataHandler, errHandler ErrHandler, opts ...WsOptions) (doneC, stopC chan struct{}, err error) {
+	cfg := newWsConfig(getWsApiEndpoint(opts...), opts...)
+
+	doneC = make(chan struct{})
+	stopC = make(chan struct{})
+
+	// Subscribe to user data stream using signature
 	subscribeRequest, err := websocket.CreateRequest(
 		reqData,
 		websocket.UserDataStreamSubscribeSignatureSpotWsApiMethod,
 		map[string]interface{}{},
 	)
 	if err != nil {
-		conn.Close()
+		return nil, nil, err
+	}
+
+	conn, err := WsGetReadWriteConnection(cfg)
+	if err != nil {
 		return nil, nil, err
 	}
 
@@ -736,7 +663,15 @@ func WsUserDataServeSignature(apiKey, secretKey string, keyType string, timeOffs
 
 	go func() {
 		defer close(doneC)
-		defer conn.Close()
+
+		// Close the connection when the stopC channel is closed, ReadMessage is blocking
+		go func() {
+			select {
+			case <-stopC:
+			case <-doneC:
+			}
+			conn.Close()
+		}()
 
 		for {
 			_, message, err := conn.ReadMessage()
@@ -871,13 +806,13 @@ func WsUserDataServeSignature(apiKey, secretKey string, keyType string, timeOffs
 type WsMarketStatHandler func(event *WsMarketStatEvent)
 
 // WsCombinedMarketStatServe is similar to WsMarketStatServe, but it handles multiple symbolx
-func WsCombinedMarketStatServe(symbols []string, handler WsMarketStatHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
-	endpoint := getCombinedEndpoint()
+func WsCombinedMarketStatServe(symbols []string, handler WsMarketStatHandler, errHandler ErrHandler, opts ...WsOptions) (doneC, stopC chan struct{}, err error) {
+	endpoint := getCombinedEndpoint(opts...)
 	for s := range symbols {
 		endpoint += fmt.Sprintf("%s@ticker", strings.ToLower(symbols[s])) + "/"
 	}
 	endpoint = endpoint[:len(endpoint)-1]
-	cfg := newWsConfig(endpoint)
+	cfg := newWsConfig(endpoint, opts...)
 
 	wsHandler := func(message []byte) {
 		j, err := newJSON(message)
@@ -908,9 +843,9 @@ func WsCombinedMarketStatServe(symbols []string, handler WsMarketStatHandler, er
 }
 
 // WsMarketStatServe serve websocket that push 24hr statistics for single market every second
-func WsMarketStatServe(symbol string, handler WsMarketStatHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
-	endpoint := fmt.Sprintf("%s/%s@ticker", getWsEndpoint(), strings.ToLower(symbol))
-	cfg := newWsConfig(endpoint)
+func WsMarketStatServe(symbol string, handler WsMarketStatHandler, errHandler ErrHandler, opts ...WsOptions) (doneC, stopC chan struct{}, err error) {
+	endpoint := fmt.Sprintf("%s/%s@ticker", getWsEndpoint(opts...), strings.ToLower(symbol))
+	cfg := newWsConfig(endpoint, opts...)
 	wsHandler := func(message []byte) {
 		var event WsMarketStatEvent
 		err := json.Unmarshal(message, &event)
@@ -927,9 +862,9 @@ func WsMarketStatServe(symbol string, handler WsMarketStatHandler, errHandler Er
 type WsAllMarketsStatHandler func(event WsAllMarketsStatEvent)
 
 // WsAllMarketsStatServe serve websocket that push 24hr statistics for all market every second
-func WsAllMarketsStatServe(handler WsAllMarketsStatHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
-	endpoint := fmt.Sprintf("%s/!ticker@arr", getWsEndpoint())
-	cfg := newWsConfig(endpoint)
+func WsAllMarketsStatServe(handler WsAllMarketsStatHandler, errHandler ErrHandler, opts ...WsOptions) (doneC, stopC chan struct{}, err error) {
+	endpoint := fmt.Sprintf("%s/!ticker@arr", getWsEndpoint(opts...))
+	cfg := newWsConfig(endpoint, opts...)
 	wsHandler := func(message []byte) {
 		var event WsAllMarketsStatEvent
 		err := json.Unmarshal(message, &event)
@@ -976,9 +911,9 @@ type WsMarketStatEvent struct {
 type WsAllMiniMarketsStatServeHandler func(event WsAllMiniMarketsStatEvent)
 
 // WsAllMiniMarketsStatServe serve websocket that push mini version of 24hr statistics for all market every second
-func WsAllMiniMarketsStatServe(handler WsAllMiniMarketsStatServeHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
-	endpoint := fmt.Sprintf("%s/!miniTicker@arr", getWsEndpoint())
-	cfg := newWsConfig(endpoint)
+func WsAllMiniMarketsStatServe(handler WsAllMiniMarketsStatServeHandler, errHandler ErrHandler, opts ...WsOptions) (doneC, stopC chan struct{}, err error) {
+	endpoint := fmt.Sprintf("%s/!miniTicker@arr", getWsEndpoint(opts...))
+	cfg := newWsConfig(endpoint, opts...)
 	wsHandler := func(message []byte) {
 		var event WsAllMiniMarketsStatEvent
 		err := json.Unmarshal(message, &event)
@@ -1026,54 +961,30 @@ type WsCombinedBookTickerEvent struct {
 type WsBookTickerHandler func(event *WsBookTickerEvent)
 
 // WsBookTickerServe serve websocket that pushes updates to the best bid or ask price or quantity in real-time for a specified symbol.
-func WsBookTickerServe(symbol string, handler WsBookTickerHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
-	endpoint := fmt.Sprintf("%s/%s@bookTicker", getWsEndpoint(), strings.ToLower(symbol))
-	cfg := newWsConfig(endpoint)
-	wsHandler := func(message []byte) {
-		event := new(WsBookTickerEvent)
-		err := json.Unmarshal(message, &event)
-		if err != nil {
-			errHandler(err)
-			return
-		}
-		handler(event)
-	}
+func WsBookTickerServe(symbol string, handler WsBookTickerHandler, errHandler ErrHandler, opts ...WsOptions) (doneC, stopC chan struct{}, err error) {
+	endpoint := fmt.Sprintf("%s/%s@bookTicker", getWsEndpoint(opts...), strings.ToLower(symbol))
+	cfg := newWsConfig(endpoint, opts...)
+	wsHandler := decodeHandler(cfg.Options.ReuseEvents, &wsBookTickerEvents, decodeWsBookTickerEvent, handler, errHandler)
 	return wsServe(cfg, wsHandler, errHandler)
 }
 
 // WsCombinedBookTickerServe is similar to WsBookTickerServe, but it is for multiple symbols
-func WsCombinedBookTickerServe(symbols []string, handler WsBookTickerHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
-	endpoint := getCombinedEndpoint()
+func WsCombinedBookTickerServe(symbols []string, handler WsBookTickerHandler, errHandler ErrHandler, opts ...WsOptions) (doneC, stopC chan struct{}, err error) {
+	endpoint := getCombinedEndpoint(opts...)
 	for _, s := range symbols {
 		endpoint += fmt.Sprintf("%s@bookTicker", strings.ToLower(s)) + "/"
 	}
 	endpoint = endpoint[:len(endpoint)-1]
-	cfg := newWsConfig(endpoint)
-	wsHandler := func(message []byte) {
-		event := new(WsCombinedBookTickerEvent)
-		err := json.Unmarshal(message, event)
-		if err != nil {
-			errHandler(err)
-			return
-		}
-		handler(event.Data)
-	}
+	cfg := newWsConfig(endpoint, opts...)
+	wsHandler := decodeHandler(cfg.Options.ReuseEvents, &wsBookTickerEvents, decodeWsCombinedBookTickerEvent, handler, errHandler)
 	return wsServe(cfg, wsHandler, errHandler)
 }
 
 // WsAllBookTickerServe serve websocket that pushes updates to the best bid or ask price or quantity in real-time for all symbols.
-func WsAllBookTickerServe(handler WsBookTickerHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
-	endpoint := fmt.Sprintf("%s/!bookTicker", getWsEndpoint())
-	cfg := newWsConfig(endpoint)
-	wsHandler := func(message []byte) {
-		event := new(WsBookTickerEvent)
-		err := json.Unmarshal(message, &event)
-		if err != nil {
-			errHandler(err)
-			return
-		}
-		handler(event)
-	}
+func WsAllBookTickerServe(handler WsBookTickerHandler, errHandler ErrHandler, opts ...WsOptions) (doneC, stopC chan struct{}, err error) {
+	endpoint := fmt.Sprintf("%s/!bookTicker", getWsEndpoint(opts...))
+	cfg := newWsConfig(endpoint, opts...)
+	wsHandler := decodeHandler(cfg.Options.ReuseEvents, &wsBookTickerEvents, decodeWsBookTickerEvent, handler, errHandler)
 	return wsServe(cfg, wsHandler, errHandler)
 }
 
@@ -1088,6 +999,15 @@ func WsApiInitReadWriteConn() (*gorilla.Conn, error) {
 	return conn, err
 }
 
+// newWsApiConnection create a websocket API connection dialed with the options of opts
+func newWsApiConnection(opts ...WsOptions) (websocket.Connection, error) {
+	o := websocket.MergeOptions(opts...)
+	keepalive, timeout := o.APIKeepAlive(WebsocketKeepalive, WebsocketTimeoutReadWriteConnection)
+	return websocket.NewConnection(func() (*gorilla.Conn, error) {
+		return WsGetReadWriteConnection(newWsConfig(getWsApiEndpoint(opts...), o))
+	}, keepalive, timeout)
+}
+
 type WsAnnouncementEvent struct {
 	CatalogID   int64  `json:"catalogId"`
 	CatalogName string `json:"catalogName"`
@@ -1121,7 +1041,7 @@ type WsAnnouncementHandler func(event *WsAnnouncementEvent)
 //	doneC - Channel that closes when the connection terminates
 //	stopC - Channel that can be closed to stop the connection
 //	err - Any initial connection error
-func WsAnnouncementServe(params WsAnnouncementParam, handler WsAnnouncementHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+func WsAnnouncementServe(params WsAnnouncementParam, handler WsAnnouncementHandler, errHandler ErrHandler, opts ...WsOptions) (doneC, stopC chan struct{}, err error) {
 	if UseTestnet {
 		return nil, nil, errors.New("testnet is not supported")
 	}
@@ -1129,7 +1049,7 @@ func WsAnnouncementServe(params WsAnnouncementParam, handler WsAnnouncementHandl
 		BaseWsAnnouncementURL, params.Random, params.Topic, params.RecvWindow, params.Timestamp, params.Signature,
 	)
 
-	cfg := newWsConfig(endpoint)
+	cfg := newWsConfig(endpoint, opts...)
 	cfg.Header.Set("X-MBX-APIKEY", params.ApiKey)
 	wsHandler := func(message []byte) {
 		event := struct {
@@ -1161,11 +1081,20 @@ func WsAnnouncementServe(params WsAnnouncementParam, handler WsAnnouncementHandl
 		}
 		handler(e)
 	}
-	return wsServeWithConnHandler(cfg, wsHandler, errHandler, keepAliveWithPing(30*time.Second, WebsocketTimeout))
+	keepalive := keepAliveWithPing(30*time.Second, WebsocketTimeout)
+	if cfg.Options.Keepalive != "" {
+		keepalive = func(ctx context.Context, c *gorilla.Conn) {
+			cfg.Options.KeepAlive(ctx, c, cfg.Options.Keepalive, 30*time.Second, WebsocketPingTimeout)
+		}
+	}
+	return wsServeWithConnHandler(cfg, wsHandler, errHandler, keepalive)
 }
 
-// getWsApiEndpoint return the base endpoint of the API WS according the UseTestnet flag
-func getWsApiEndpoint() string {
+// getWsApiEndpoint return the base endpoint of the API WS of opts, according the UseTestnet flag when they set none
+func getWsApiEndpoint(opts ...WsOptions) string {
+	if o := websocket.MergeOptions(opts...); o.APIURL != "" {
+		return o.APIURL
+	}
 	if UseTestnet {
 		return BaseWsApiTestnetURL
 	}
@@ -0,0 +1,209 @@
+package binance
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/adshao/go-binance/v2/common"
+	commonws "github.com/adshao/go-binance/v2/common/websocket"
+)
+
+type websocketTestSuite struct {
+	suite.Suite
+	server   *httptest.Server
+	messages []string
+	requests chan *http.Request
+}
+
+func TestWebsocket(t *testing.T) {
+	suite.Run(t, new(websocketTestSuite))
+}
+
+func (s *websocketTestSuite) SetupTest() {
+	s.messages = []string{
+		`{"e":"depthUpdate","s":"BTCUSDT","u":1}`,
+		`{"e":"depthUpdate","s":"ETHUSDT","u":2}`,
+		`{"e":"depthUpdate","s":"BTCUSDT","u":3}`,
+	}
+	s.requests = make(chan *http.Request, 10)
+	upgrader := websocket.Upgrader{}
+	s.server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		s.requests <- r
+		c, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+		defer c.Close()
+		for _, m := range s.messages {
+			if err := c.WriteMessage(websocket.TextMessage, []byte(m)); err != nil {
+				return
+			}
+		}
+		if strings.HasPrefix(r.URL.Path, "/hold/") {
+			// the connection is held open until the client closes it
+			for {
+				if _, _, err := c.ReadMessage(); err != nil {
+					return
+				}
+			}
+		}
+		c.WriteMessage(websocket.CloseMessage, websocket.FormatCloseMessage(websocket.CloseNormalClosure, ""))
+	}))
+}
+
+func (s *websocketTestSuite) TearDownTest() {
+	s.server.Close()
+}
+
+func (s *websocketTestSuite) config(delivery commonws.DeliveryConfig) *WsConfig {
+	cfg := newWsConfig("ws" + strings.TrimPrefix(s.server.URL, "http"))
+	cfg.Proxy = nil
+	cfg.Delivery = delivery
+	return cfg
+}
+
+func (s *websocketTestSuite) TestBufferedDelivery() {
+	stats := new(commonws.DeliveryStats)
+	var received []string
+	doneC, _, err := wsServeWithConnHandler(s.config(commonws.DeliveryConfig{
+		Mode:      commonws.DeliveryModeBuffered,
+		QueueSize: 10,
+		Stats:     stats,
+	}), func(message []byte) {
+		// a slow handler does not stall the read loop
+		time.Sleep(10 * time.Millisecond)
+		received = append(received, string(message))
+	}, func(err error) {}, nil)
+	s.Require().NoError(err)
+
+	select {
+	case <-doneC:
+	case <-time.After(5 * time.Second):
+		s.FailNow("stream not stopped")
+	}
+	// the queued messages are delivered before doneC is closed
+	s.Equal(s.messages, received)
+	s.Equal(uint64(3), stats.Received())
+	s.Equal(uint64(3), stats.Delivered())
+	s.Equal(int64(0), stats.QueueDepth())
+}
+
+func (s *websocketTestSuite) TestSyncDelivery() {
+	var received []string
+	doneC, _, err := wsServeWithConnHandler(s.config(commonws.DeliveryConfig{}), func(message []byte) {
+		received = append(received, string(message))
+	}, func(err error) {}, nil)
+	s.Require().NoError(err)
+
+	select {
+	case <-doneC:
+	case <-time.After(5 * time.Second):
+		s.FailNow("stream not stopped")
+	}
+	s.Equal(s.messages, received)
+}
+
+func (s *websocketTestSuite) TestOptions() {
+	testnet := WsOptions{
+		BaseURL: "ws" + strings.TrimPrefix(s.server.URL, "http") + "/ws",
+		Header:  http.Header{"X-Test": []string{"testnet"}},
+	}
+	var received []string
+	doneC, _, err := WsDepthServe("BTCUSDT", func(event *WsDepthEvent) {
+		received = append(received, event.Symbol)
+	}, func(err error) {}, testnet, WsOptions{ReadLimit: 1 << 10})
+	s.Require().NoError(err)
+
+	select {
+	case <-doneC:
+	case <-time.After(5 * time.Second):
+		s.FailNow("stream not stopped")
+	}
+	r := <-s.requests
+	s.Equal("/ws/btcusdt@depth", r.URL.Path)
+	s.Equal("testnet", r.Header.Get("X-Test"))
+	s.Equal([]string{"BTCUSDT", "ETHUSDT", "BTCUSDT"}, received)
+	// the options of a stream leave the package defaults unchanged
+	s.Equal(BaseWsMainURL+"/btcusdt@depth", newWsConfig(getWsEndpoint()+"/btcusdt@depth").Endpoint)
+}
+
+func (s *websocketTestSuite) TestEnvironmentOptions() {
+	env := ProductionEnvironment()
+	env.WsURL = "ws" + strings.TrimPrefix(s.server.URL, "http") + "/ws"
+	// the endpoints of the environment do not depend on the UseTestnet flag
+	UseTestnet = true
+	defer func() { UseTestnet = false }()
+
+	doneC, _, err := WsDepthServe("BTCUSDT", func(event *WsDepthEvent) {}, func(err error) {}, env.WsOptions())
+	s.Require().NoError(err)
+
+	select {
+	case <-doneC:
+	case <-time.After(5 * time.Second):
+		s.FailNow("stream not stopped")
+	}
+	r := <-s.requests
+	s.Equal("/ws/btcusdt@depth", r.URL.Path)
+}
+
+func (s *websocketTestSuite) TestClientWsApiClient() {
+	signer, err := common.NewSigner(common.KeyTypeHmac, "secret")
+	s.Require().NoError(err)
+	env := ProductionEnvironment()
+	env.WsApiURL = "ws" + strings.TrimPrefix(s.server.URL, "http") + "/ws-api/v3"
+	c := NewClientWithEnvironment("key", "", env).SetSigner(signer)
+	c.KeyType = common.KeyTypeEd25519
+	c.TimeOffset = 42
+
+	client, err := c.NewWsApiClient()
+	s.Require().NoError(err)
+	defer client.c.Close()
+
+	r := <-s.requests
+	s.Equal("/ws-api/v3", r.URL.Path)
+	s.Equal(common.KeyTypeEd25519, client.KeyType)
+	s.Equal(signer, client.Signer)
+	s.Equal(int64(42), client.TimeOffset)
+	// the services parse the secret key with the cache of the client
+	s.Same(&c.signers, client.signers)
+	s.Same(&c.signers, client.NewOrderCreateWsService().signers)
+}
+
+func (s *websocketTestSuite) TestServeStream() {
+	held := WsOptions{BaseURL: "ws" + strings.TrimPrefix(s.server.URL, "http") + "/hold"}
+	events := make(chan string, len(s.messages))
+	var errs []error
+	stream, err := ServeStream(context.Background(), func(errHandler ErrHandler, opt WsOptions) (chan struct{}, chan struct{}, error) {
+		return WsDepthServe("BTCUSDT", func(event *WsDepthEvent) {
+			events <- event.Symbol
+		}, errHandler, held, opt)
+	}, func(err error) {
+		errs = append(errs, err)
+	})
+	s.Require().NoError(err)
+	for range s.messages {
+		select {
+		case <-events:
+		case <-time.After(5 * time.Second):
+			s.FailNow("event not received")
+		}
+	}
+
+	stream.Close()
+	select {
+	case <-stream.Done():
+	case <-time.After(5 * time.Second):
+		s.FailNow("stream not stopped")
+	}
+	// the connection closed by the stream is not reported
+	s.NoError(stream.Err())
+	s.Empty(errs)
+	s.Equal("/hold/btcusdt@depth", (<-s.requests).URL.Path)
+}
@@ -0,0 +1,425 @@
+package binance
+
+import (
+	"time"
+
+	"github.com/adshao/go-binance/v2/common"
+	"github.com/adshao/go-binance/v2/common/websocket"
+)
+
+// WsApiClient shares one websocket API connection between the websocket API services,
+// the responses of every service are read from the same channels
+type WsApiClient struct {
+	c          websocket.Client
+	ApiKey     string
+	SecretKey  string
+	KeyType    string
+	Signer     common.Signer // signs requests instead of SecretKey when set
+	signers    *common.SignerCache
+	TimeOffset int64
+}
+
+// NewWsApiClient init WsApiClient with a new connection, dialed with the options of opts
+func NewWsApiClient(apiKey, secretKey string, opts ...WsOptions) (*WsApiClient, error) {
+	conn, err := newWsApiConnection(opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := websocket.NewClient(conn, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewWsApiClientWithClient(client, apiKey, secretKey), nil
+}
+
+// NewWsApiClient init WsApiClient with the keys, the signer and in the environment of the client, opts
+// take precedence over the environment
+func (c *Client) NewWsApiClient(opts ...WsOptions) (*WsApiClient, error) {
+	client, err := NewWsApiClient(c.APIKey, c.SecretKey, append([]WsOptions{c.Environment.WsOptions()}, opts...)...)
+	if err != nil {
+		return nil, err
+	}
+	client.KeyType = c.KeyType
+	client.Signer = c.Signer
+	client.signers = &c.signers
+	client.TimeOffset = c.TimeOffset
+	return client, nil
+}
+
+// NewWsApiClientWithClient init WsApiClient on an existing websocket client
+func NewWsApiClientWithClient(client websocket.Client, apiKey, secretKey string) *WsApiClient {
+	return &WsApiClient{
+		c:         client,
+		ApiKey:    apiKey,
+		SecretKey: secretKey,
+		KeyType:   common.KeyTypeHmac,
+		signers:   new(common.SignerCache),
+	}
+}
+
+// ReceiveAllDataBeforeStop waits until all responses will be received from websocket until timeout expired
+func (c *WsApiClient) ReceiveAllDataBeforeStop(timeout time.Duration) {
+	c.c.Wait(timeout)
+}
+
+// GetReadChannel returns channel with API response data (including API errors)
+func (c *WsApiClient) GetReadChannel() <-chan []byte {
+	return c.c.GetReadChannel()
+}
+
+// GetReadErrorChannel returns channel with errors which are occurred while reading websocket connection
+func (c *WsApiClient) GetReadErrorChannel() <-chan error {
+	return c.c.GetReadErrorChannel()
+}
+
+// GetReconnectCount returns count of reconnect attempts by client
+func (c *WsApiClient) GetReconnectCount() int64 {
+	return c.c.GetReconnectCount()
+}
+
+// NewAvgPriceWsService init AvgPriceWsService on the connection of the client
+func (c *WsApiClient) NewAvgPriceWsService() *AvgPriceWsService {
+	return &AvgPriceWsService{c: c.c}
+}
+
+// NewDepthWsService init DepthWsService on the connection of the client
+func (c *WsApiClient) NewDepthWsService() *DepthWsService {
+	return &DepthWsService{c: c.c}
+}
+
+// NewExchangeInfoWsService init ExchangeInfoWsService on the connection of the client
+func (c *WsApiClient) NewExchangeInfoWsService() *ExchangeInfoWsService {
+	return &ExchangeInfoWsService{c: c.c}
+}
+
+// NewKlinesWsService init KlinesWsService on the connection of the client
+func (c *WsApiClient) NewKlinesWsService() *KlinesWsService {
+	return &KlinesWsService{c: c.c}
+}
+
+// NewTicker24hrWsService init Ticker24hrWsService on the connection of the client
+func (c *WsApiClient) NewTicker24hrWsService() *Ticker24hrWsService {
+	return &Ticker24hrWsService{c: c.c}
+}
+
+// NewTickerBookWsService init TickerBookWsService on the connection of the client
+func (c *WsApiClient) NewTickerBookWsService() *TickerBookWsService {
+	return &TickerBookWsService{c: c.c}
+}
+
+// NewTickerPriceWsService init TickerPriceWsService on the connection of the client
+func (c *WsApiClient) NewTickerPriceWsService() *TickerPriceWsService {
+	return &TickerPriceWsService{c: c.c}
+}
+
+// NewTickerWsService init TickerWsService on the connection of the client
+func (c *WsApiClient) NewTickerWsService() *TickerWsService {
+	return &TickerWsService{c: c.c}
+}
+
+// NewTickerTradingDayWsService init TickerTradingDayWsService on the connection of the client
+func (c *WsApiClient) NewTickerTradingDayWsService() *TickerTradingDayWsService {
+	return &TickerTradingDayWsService{c: c.c}
+}
+
+// NewTimeCheckWsService init TimeCheckWsService on the connection of the client
+func (c *WsApiClient) NewTimeCheckWsService() *TimeCheckWsService {
+	return &TimeCheckWsService{c: c.c}
+}
+
+// NewTradesAggregateWsService init TradesAggregateWsService on the connection of the client
+func (c *WsApiClient) NewTradesAggregateWsService() *TradesAggregateWsService {
+	return &TradesAggregateWsService{c: c.c}
+}
+
+// NewTradesHistoricalWsService init TradesHistoricalWsService on the connection of the client
+func (c *WsApiClient) NewTradesHistoricalWsService() *TradesHistoricalWsService {
+	return &TradesHistoricalWsService{c: c.c}
+}
+
+// NewTradesRecentWsService init TradesRecentWsService on the connection of the client
+func (c *WsApiClient) NewTradesRecentWsService() *TradesRecentWsService {
+	return &TradesRecentWsService{c: c.c}
+}
+
+// NewUiKlinesWsService init UiKlinesWsService on the connection of the client
+func (c *WsApiClient) NewUiKlinesWsService() *UiKlinesWsService {
+	return &UiKlinesWsService{c: c.c}
+}
+
+// NewAccountCommissionWsService init AccountCommissionWsService on the connection of the client
+func (c *WsApiClient) NewAccountCommissionWsService() *AccountCommissionWsService {
+	return &AccountCommissionWsService{
+		c:          c.c,
+		ApiKey:     c.ApiKey,
+		SecretKey:  c.SecretKey,
+		KeyType:    c.KeyType,
+		Signer:     c.Signer,
+		signers:    c.signers,
+		TimeOffset: c.TimeOffset,
+	}
+}
+
+// NewAccountRateLimitsOrdersWsService init AccountRateLimitsOrdersWsService on the connection of the client
+func (c *WsApiClient) NewAccountRateLimitsOrdersWsService() *AccountRateLimitsOrdersWsService {
+	return &AccountRateLimitsOrdersWsService{
+		c:          c.c,
+		ApiKey:     c.ApiKey,
+		SecretKey:  c.SecretKey,
+		KeyType:    c.KeyType,
+		Signer:     c.Signer,
+		signers:    c.signers,
+		TimeOffset: c.TimeOffset,
+	}
+}
+
+// NewAccountStatusWsService init AccountStatusWsService on the connection of the client
+func (c *WsApiClient) NewAccountStatusWsService() *AccountStatusWsService {
+	return &AccountStatusWsService{
+		c:          c.c,
+		ApiKey:     c.ApiKey,
+		SecretKey:  c.SecretKey,
+		KeyType:    c.KeyType,
+		Signer:     c.Signer,
+		signers:    c.signers,
+		TimeOffset: c.TimeOffset,
+	}
+}
+
+// NewMyPreventedMatchesWsService init MyPreventedMatchesWsService on the connection of the client
+func (c *WsApiClient) NewMyPreventedMatchesWsService() *MyPreventedMatchesWsService {
+	return &MyPreventedMatchesWsService{
+		c:          c.c,
+		ApiKey:     c.ApiKey,
+		SecretKey:  c.SecretKey,
+		KeyType:    c.KeyType,
+		Signer:     c.Signer,
+		signers:    c.signers,
+		TimeOffset: c.TimeOffset,
+	}
+}
+
+// NewMyTradesWsService init MyTradesWsService on the connection of the client
+func (c *WsApiClient) NewMyTradesWsService() *MyTradesWsService {
+	return &MyTradesWsService{
+		c:          c.c,
+		ApiKey:     c.ApiKey,
+		SecretKey:  c.SecretKey,
+		KeyType:    c.KeyType,
+		Signer:     c.Signer,
+		signers:    c.signers,
+		TimeOffset: c.TimeOffset,
+	}
+}
+
+// NewOpenOrdersCancelAllWsService init OpenOrdersCancelAllWsService on the connection of the client
+func (c *WsApiClient) NewOpenOrdersCancelAllWsService() *OpenOrdersCancelAllWsService {
+	return &OpenOrdersCancelAllWsService{
+		c:          c.c,
+		ApiKey:     c.ApiKey,
+		SecretKey:  c.SecretKey,
+		KeyType:    c.KeyType,
+		Signer:     c.Signer,
+		signers:    c.signers,
+		TimeOffset: c.TimeOffset,
+	}
+}
+
+// NewOrderCancelReplaceWsService init OrderCancelReplaceWsService on the connection of the client
+func (c *WsApiClient) NewOrderCancelReplaceWsService() *OrderCancelReplaceWsService {
+	return &OrderCancelReplaceWsService{
+		c:          c.c,
+		ApiKey:     c.ApiKey,
+		SecretKey:  c.SecretKey,
+		KeyType:    c.KeyType,
+		Signer:     c.Signer,
+		signers:    c.signers,
+		TimeOffset: c.TimeOffset,
+	}
+}
+
+// NewOrderListCancelWsService init OrderListCancelWsService on the connection of the client
+func (c *WsApiClient) NewOrderListCancelWsService() *OrderListCancelWsService {
+	return &OrderListCancelWsService{
+		c:          c.c,
+		ApiKey:     c.ApiKey,
+		SecretKey:  c.SecretKey,
+		KeyType:    c.KeyType,
+		Signer:     c.Signer,
+		signers:    c.signers,
+		TimeOffset: c.TimeOffset,
+	}
+}
+
+// NewOrderListPlaceOtoWsService init OrderListPlaceOtoWsService on the connection of the client
+func (c *WsApiClient) NewOrderListPlaceOtoWsService() *OrderListPlaceOtoWsService {
+	return &OrderListPlaceOtoWsService{
+		c:          c.c,
+		ApiKey:     c.ApiKey,
+		SecretKey:  c.SecretKey,
+		KeyType:    c.KeyType,
+		Signer:     c.Signer,
+		signers:    c.signers,
+		TimeOffset: c.TimeOffset,
+	}
+}
+
+// NewOrderListPlaceOtocoWsService init OrderListPlaceOtocoWsService on the connection of the client
+func (c *WsApiClient) NewOrderListPlaceOtocoWsService() *OrderListPlaceOtocoWsService {
+	return &OrderListPlaceOtocoWsService{
+		c:          c.c,
+		ApiKey:     c.ApiKey,
+		SecretKey:  c.SecretKey,
+		KeyType:    c.KeyType,
+		Signer:     c.Signer,
+		signers:    c.signers,
+		TimeOffset: c.TimeOffset,
+	}
+}
+
+// NewOrderListPlaceWsService init OrderListPlaceWsService on the connection of the client
+func (c *WsApiClient) NewOrderListPlaceWsService() *OrderListPlaceWsService {
+	return &OrderListPlaceWsService{
+		c:          c.c,
+		ApiKey:     c.ApiKey,
+		SecretKey:  c.SecretKey,
+		KeyType:    c.KeyType,
+		Signer:     c.Signer,
+		signers:    c.signers,
+		TimeOffset: c.TimeOffset,
+	}
+}
+
+// NewOrderListCreateWsService init OrderListCreateWsService on the connection of the client
+func (c *WsApiClient) NewOrderListCreateWsService() *OrderListCreateWsService {
+	return &OrderListCreateWsService{
+		c:          c.c,
+		ApiKey:     c.ApiKey,
+		SecretKey:  c.SecretKey,
+		KeyType:    c.KeyType,
+		Signer:     c.Signer,
+		signers:    c.signers,
+		TimeOffset: c.TimeOffset,
+	}
+}
+
+// NewAllOrdersWsService init AllOrdersWsService on the connection of the client
+func (c *WsApiClient) NewAllOrdersWsService() *AllOrdersWsService {
+	return &AllOrdersWsService{
+		c:          c.c,
+		ApiKey:     c.ApiKey,
+		SecretKey:  c.SecretKey,
+		KeyType:    c.KeyType,
+		Signer:     c.Signer,
+		signers:    c.signers,
+		TimeOffset: c.TimeOffset,
+	}
+}
+
+// NewOrderCancelWsService init OrderCancelWsService on the connection of the client
+func (c *WsApiClient) NewOrderCancelWsService() *OrderCancelWsService {
+	return &OrderCancelWsService{
+		c:          c.c,
+		ApiKey:     c.ApiKey,
+		SecretKey:  c.SecretKey,
+		KeyType:    c.KeyType,
+		Signer:     c.Signer,
+		signers:    c.signers,
+		TimeOffset: c.TimeOffset,
+	}
+}
+
+// NewOrderCreateWsService init OrderCreateWsService on the connection of the client
+func (c *WsApiClient) NewOrderCreateWsService() *OrderCreateWsService {
+	return &OrderCreateWsService{
+		c:          c.c,
+		ApiKey:     c.ApiKey,
+		SecretKey:  c.SecretKey,
+		KeyType:    c.KeyType,
+		Signer:     c.Signer,
+		signers:    c.signers,
+		TimeOffset: c.TimeOffset,
+	}
+}
+
+// NewOpenOrderStatusWsService init OpenOrderStatusWsService on the connection of the client
+func (c *WsApiClient) NewOpenOrderStatusWsService() *OpenOrderStatusWsService {
+	return &OpenOrderStatusWsService{
+		c:          c.c,
+		ApiKey:     c.ApiKey,
+		SecretKey:  c.SecretKey,
+		KeyType:    c.KeyType,
+		Signer:     c.Signer,
+		signers:    c.signers,
+		TimeOffset: c.TimeOffset,
+	}
+}
+
+// NewOrderStatusWsService init OrderStatusWsService on the connection of the client
+func (c *WsApiClient) NewOrderStatusWsService() *OrderStatusWsService {
+	return &OrderStatusWsService{
+		c:          c.c,
+		ApiKey:     c.ApiKey,
+		SecretKey:  c.SecretKey,
+		KeyType:    c.KeyType,
+		Signer:     c.Signer,
+		signers:    c.signers,
+		TimeOffset: c.TimeOffset,
+	}
+}
+
+// NewOrderTestWsService init OrderTestWsService on the connection of the client
+func (c *WsApiClient) NewOrderTestWsService() *OrderTestWsService {
+	return &OrderTestWsService{
+		c:          c.c,
+		ApiKey:     c.ApiKey,
+		SecretKey:  c.SecretKey,
+		KeyType:    c.KeyType,
+		Signer:     c.Signer,
+		signers:    c.signers,
+		TimeOffset: c.TimeOffset,
+	}
+}
+
+// NewSorOrderPlaceWsService init SorOrderPlaceWsService on the connection of the client
+func (c *WsApiClient) NewSorOrderPlaceWsService() *SorOrderPlaceWsService {
+	return &SorOrderPlaceWsService{
+		c:          c.c,
+		ApiKey:     c.ApiKey,
+		SecretKey:  c.SecretKey,
+		KeyType:    c.KeyType,
+		Signer:     c.Signer,
+		signers:    c.signers,
+		TimeOffset: c.TimeOffset,
+	}
+}
+
+// NewSorOrderTestWsService init SorOrderTestWsService on the connection of the client
+func (c *WsApiClient) NewSorOrderTestWsService() *SorOrderTestWsService {
+	return &SorOrderTestWsService{
+		c:          c.c,
+		ApiKey:     c.ApiKey,
+		SecretKey:  c.SecretKey,
+		KeyType:    c.KeyType,
+		Signer:     c.Signer,
+		signers:    c.signers,
+		TimeOffset: c.TimeOffset,
+	}
+}
+
+// NewUserDataStreamStartWsService init UserDataStreamStartWsService on the connection of the client
+func (c *WsApiClient) NewUserDataStreamStartWsService() *UserDataStreamStartWsService {
+	return &UserDataStreamStartWsService{c: c.c, ApiKey: c.ApiKey}
+}
+
+// NewUserDataStreamPingWsService init UserDataStreamPingWsService on the connection of the client
+func (c *WsApiClient) NewUserDataStreamPingWsService() *UserDataStreamPingWsService {
+	return &UserDataStreamPingWsService{c: c.c, ApiKey: c.ApiKey}
+}
+
+// NewUserDataStreamStopWsService init UserDataStreamStopWsService on the connection of the client
+func (c *WsApiClient) NewUserDataStreamStopWsService() *UserDataStreamStopWsService {
+	return &UserDataStreamStopWsService{c: c.c, ApiKey: c.ApiKey}
+}
@@ -0,0 +1,69 @@
+package binance
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/adshao/go-binance/v2/common/websocket"
+	"github.com/adshao/go-binance/v2/common/websocket/mock"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/suite"
+)
+
+type wsApiClientTestSuite struct {
+	suite.Suite
+
+	ctrl   *gomock.Controller
+	client *mock.MockClient
+	c      *WsApiClient
+}
+
+func (s *wsApiClientTestSuite) SetupTest() {
+	s.ctrl = gomock.NewController(s.T())
+	s.client = mock.NewMockClient(s.ctrl)
+	s.c = NewWsApiClientWithClient(s.client, "dummyApiKey", "dummySecretKey")
+}
+
+func (s *wsApiClientTestSuite) TearDownTest() {
+	s.ctrl.Finish()
+}
+
+func TestWsApiClient(t *testing.T) {
+	suite.Run(t, new(wsApiClientTestSuite))
+}
+
+func (s *wsApiClientTestSuite) TestSharedConnection() {
+	var methods []websocket.WsApiMethodType
+	var params []map[string]interface{}
+	s.client.EXPECT().Write(gomock.Any(), gomock.Any()).DoAndReturn(func(id string, raw []byte) error {
+		req := websocket.WsApiRequest{}
+		s.Require().NoError(json.Unmarshal(raw, &req))
+		methods = append(methods, req.Method)
+		params = append(params, req.Params)
+		return nil
+	}).Times(3)
+
+	s.Require().NoError(s.c.NewDepthWsService().Do("1", NewDepthWsRequest().Symbol("BTCUSDT")))
+	s.Require().NoError(s.c.NewMyTradesWsService().Do("2", NewMyTradesWsRequest().Symbol("BTCUSDT")))
+	s.Require().NoError(s.c.NewUserDataStreamStartWsService().Do("3", NewUserDataStreamStartWsRequest()))
+
+	s.Equal([]websocket.WsApiMethodType{
+		websocket.DepthSpotWsApiMethod,
+		websocket.MyTradesSpotWsApiMethod,
+		websocket.UserDataStreamStartSpotWsApiMethod,
+	}, methods)
+	s.NotContains(params[0], "apiKey")
+	s.Equal("dummyApiKey", params[1]["apiKey"])
+	s.Contains(params[1], "signature")
+	s.Equal("dummyApiKey", params[2]["apiKey"])
+	s.NotContains(params[2], "signature")
+}
+
+func (s *wsApiClientTestSuite) TestChannels() {
+	readC := make(chan []byte)
+	s.client.EXPECT().GetReadChannel().Return(readC).Times(1)
+	s.client.EXPECT().GetReconnectCount().Return(int64(2)).Times(1)
+
+	s.Equal((<-chan []byte)(readC), s.c.GetReadChannel())
+	s.Equal(int64(2), s.c.GetReconnectCount())
+}
@@ -0,0 +1,130 @@
+package binance
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+
+	"github.com/adshao/go-binance/v2/common"
+)
+
+// wsApiRawResponse define websocket API response with the result not decoded yet
+type wsApiRawResponse struct {
+	Id     string          `json:"id"`
+	Status int             `json:"status"`
+	Result json.RawMessage `json:"result"`
+
+	// error response
+	Error *common.APIError `json:"error,omitempty"`
+}
+
+// isEmpty return whether the response has no result, like error responses
+func (r *wsApiRawResponse) isEmpty() bool {
+	return len(r.Result) == 0 || string(r.Result) == "null"
+}
+
+// unmarshalOneOrMany decode a result which is an object when one symbol is requested
+// and an array otherwise
+func unmarshalOneOrMany[T any](data []byte) ([]*T, error) {
+	if trimmed := bytes.TrimSpace(data); len(trimmed) > 0 && trimmed[0] == '{' {
+		v := new(T)
+		if err := json.Unmarshal(trimmed, v); err != nil {
+			return nil, err
+		}
+		return []*T{v}, nil
+	}
+	res := make([]*T, 0)
+	if err := json.Unmarshal(data, &res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// parseWsDepth decode 'depth' result
+func parseWsDepth(data []byte) (*DepthResponse, error) {
+	j, err := newJSON(data)
+	if err != nil {
+		return nil, err
+	}
+	res := new(DepthResponse)
+	res.LastUpdateID = j.Get("lastUpdateId").MustInt64()
+	bidsLen := len(j.Get("bids").MustArray())
+	res.Bids = make([]Bid, bidsLen)
+	for i := 0; i < bidsLen; i++ {
+		item := j.Get("bids").GetIndex(i)
+		res.Bids[i] = Bid{
+			Price:    item.GetIndex(0).MustString(),
+			Quantity: item.GetIndex(1).MustString(),
+		}
+	}
+	asksLen := len(j.Get("asks").MustArray())
+	res.Asks = make([]Ask, asksLen)
+	for i := 0; i < asksLen; i++ {
+		item := j.Get("asks").GetIndex(i)
+		res.Asks[i] = Ask{
+			Price:    item.GetIndex(0).MustString(),
+			Quantity: item.GetIndex(1).MustString(),
+		}
+	}
+	return res, nil
+}
+
+// parseWsKlines decode 'klines' result
+func parseWsKlines(data []byte) ([]*Kline, error) {
+	j, err := newJSON(data)
+	if err != nil {
+		return nil, err
+	}
+	num := len(j.MustArray())
+	res := make([]*Kline, num)
+	for i := 0; i < num; i++ {
+		item := j.GetIndex(i)
+		if len(item.MustArray()) < 11 {
+			return nil, fmt.Errorf("invalid kline response")
+		}
+		res[i] = &Kline{
+			OpenTime:                 item.GetIndex(0).MustInt64(),
+			Open:                     item.GetIndex(1).MustString(),
+			High:                     item.GetIndex(2).MustString(),
+			Low:                      item.GetIndex(3).MustString(),
+			Close:                    item.GetIndex(4).MustString(),
+			Volume:                   item.GetIndex(5).MustString(),
+			CloseTime:                item.GetIndex(6).MustInt64(),
+			QuoteAssetVolume:         item.GetIndex(7).MustString(),
+			TradeNum:                 item.GetIndex(8).MustInt64(),
+			TakerBuyBaseAssetVolume:  item.GetIndex(9).MustString(),
+			TakerBuyQuoteAssetVolume: item.GetIndex(10).MustString(),
+		}
+	}
+	return res, nil
+}
+
+// parseWsUiKlines decode 'uiKlines' result
+func parseWsUiKlines(data []byte) ([]*UiKline, error) {
+	j, err := newJSON(data)
+	if err != nil {
+		return nil, err
+	}
+	num := len(j.MustArray())
+	res := make([]*UiKline, num)
+	for i := 0; i < num; i++ {
+		item := j.GetIndex(i)
+		if len(item.MustArray()) < 11 {
+			return nil, fmt.Errorf("invalid UiKline response")
+		}
+		res[i] = &UiKline{
+			OpenTime:                 item.GetIndex(0).MustUint64(),
+			Open:                     item.GetIndex(1).MustString(),
+			High:                     item.GetIndex(2).MustString(),
+			Low:                      item.GetIndex(3).MustString(),
+			Close:                    item.GetIndex(4).MustString(),
+			Volume:                   item.GetIndex(5).MustString(),
+			CloseTime:                item.GetIndex(6).MustUint64(),
+			QuoteVolume:              item.GetIndex(7).MustString(),
+			TradeNum:                 item.GetIndex(8).MustUint64(),
+			TakerBuyBaseAssetVolume:  item.GetIndex(9).MustString(),
+			TakerBuyQuoteAssetVolume: item.GetIndex(10).MustString(),
+		}
+	}
+	return res, nil
+}